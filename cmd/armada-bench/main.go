@@ -0,0 +1,96 @@
+package main
+
+import (
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+
+	"github.com/armadaproject/armada/internal/armada"
+	"github.com/armadaproject/armada/internal/armada/benchmark"
+	"github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/internal/common"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+)
+
+const CustomConfigLocation string = "config"
+
+var (
+	benchmarkConfig benchmark.Config
+	seedCluster     bool
+	outputDir       string
+	baselinePath    string
+	tolerance       float64
+)
+
+func init() {
+	pflag.StringSlice(
+		CustomConfigLocation,
+		[]string{},
+		"Fully qualified path to Armada server configuration file (for multiple config files repeat this arg or separate paths with commas)",
+	)
+	pflag.StringVar(&benchmarkConfig.Queue, "queue", "armada-benchmark", "Queue to submit to; created if it doesn't exist")
+	pflag.StringVar(&benchmarkConfig.JobSetId, "jobset", "armada-benchmark", "Job set to submit to")
+	pflag.Float64Var(&benchmarkConfig.SubmitRate, "rate", 10, "SubmitJobs requests per second")
+	pflag.IntVar(&benchmarkConfig.JobsPerRequest, "jobs-per-request", 10, "Jobs per SubmitJobs request")
+	pflag.Float64Var(&benchmarkConfig.CancelFraction, "cancel-fraction", 0.1, "Fraction of submitted jobs to cancel")
+	pflag.DurationVar(&benchmarkConfig.Duration, "duration", time.Minute, "How long to drive load for; use long durations to soak test")
+	pflag.IntVar(&benchmarkConfig.Concurrency, "concurrency", 16, "Maximum number of requests in flight")
+	pflag.BoolVar(&seedCluster, "seed-cluster", true, "Report a synthetic cluster the benchmark jobs fit on")
+	pflag.StringVar(&outputDir, "output", "", "Directory to write the report and CPU, heap, and allocation profiles to")
+	pflag.StringVar(&baselinePath, "baseline", "", "Report of an earlier run to compare against; exits non-zero on regressions")
+	pflag.Float64Var(&tolerance, "tolerance", 0.2, "Fraction by which results may be worse than the baseline")
+	pflag.Parse()
+}
+
+func main() {
+	common.ConfigureLogging()
+	common.BindCommandlineArguments()
+
+	var config configuration.ArmadaConfig
+	userSpecifiedConfigs := viper.GetStringSlice(CustomConfigLocation)
+	common.LoadConfig(&config, "./config/armada", userSpecifiedConfigs)
+
+	ctx, cancel := armadacontext.WithCancel(armadacontext.Background())
+	defer cancel()
+	stopSignal := make(chan os.Signal, 1)
+	signal.Notify(stopSignal, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		// Stop driving load early, but still report on the load driven so far.
+		<-stopSignal
+		cancel()
+	}()
+
+	if outputDir != "" {
+		benchmarkConfig.ProfileDir = outputDir
+	}
+	report, err := armada.RunSubmitBenchmark(ctx, &config, benchmarkConfig, seedCluster)
+	if err != nil {
+		log.WithError(err).Fatal("benchmark failed")
+	}
+	log.Infof("benchmark completed in %s\n%s", report.Elapsed, report)
+	if outputDir != "" {
+		if err := benchmark.WriteReport(report, filepath.Join(outputDir, "report.json")); err != nil {
+			log.WithError(err).Fatal("failed to write report")
+		}
+	}
+
+	if baselinePath != "" {
+		baseline, err := benchmark.ReadReport(baselinePath)
+		if err != nil {
+			log.WithError(err).Fatal("failed to read baseline")
+		}
+		if regressions := benchmark.Regressions(baseline, report, tolerance); len(regressions) > 0 {
+			for _, regression := range regressions {
+				log.Errorf("regression: %s", regression)
+			}
+			os.Exit(1)
+		}
+		log.Infof("no regressions relative to %s", baselinePath)
+	}
+}
@@ -93,6 +93,7 @@ func main() {
 		api.SwaggerJsonTemplate(),
 		api.RegisterSubmitHandler,
 		api.RegisterEventHandler,
+		api.RegisterQueryHandler,
 	)
 	defer shutdownGateway()
 
@@ -9,7 +9,7 @@ import (
 func createCmd(a *armadactl.App) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "create",
-		Short: "Create Armada resource. Supported: queue",
+		Short: "Create Armada resource. Supported: queue, maintenance-window",
 		PreRunE: func(cmd *cobra.Command, args []string) error {
 			return initParams(cmd, a.Params)
 		},
@@ -26,24 +26,63 @@ func createCmd(a *armadactl.App) *cobra.Command {
 	}
 	cmd.Flags().Bool("dry-run", false, "Validate the input file and exit without making any changes.")
 	cmd.AddCommand(queueCreateCmd())
+	cmd.AddCommand(maintenanceWindowCreateCmd())
 	return cmd
 }
 
 func deleteCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "delete",
-		Short: "Delete Armada resource. Supported: queue",
+		Short: "Delete Armada resource. Supported: queue, maintenance-window",
 	}
 	cmd.AddCommand(queueDeleteCmd())
+	cmd.AddCommand(maintenanceWindowDeleteCmd())
+	return cmd
+}
+
+func exportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export Armada resources as a declarative YAML document. Supported: queues",
+	}
+	cmd.AddCommand(queuesExportCmd())
+	return cmd
+}
+
+func importCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import",
+		Short: "Make Armada resources match a declarative YAML document. Supported: queues",
+	}
+	cmd.AddCommand(queuesImportCmd())
+	return cmd
+}
+
+func archiveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "archive",
+		Short: "Archive Armada resource. Supported: queue",
+	}
+	cmd.AddCommand(queueArchiveCmd())
+	return cmd
+}
+
+func restoreCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "restore",
+		Short: "Restore archived Armada resource. Supported: queue",
+	}
+	cmd.AddCommand(queueRestoreCmd())
 	return cmd
 }
 
 func updateCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "update",
-		Short: "Update Armada resource. Supported: queue",
+		Short: "Update Armada resource. Supported: queue, fair-share-weight",
 	}
 	cmd.AddCommand(queueUpdateCmd())
+	cmd.AddCommand(fairShareWeightUpdateCmd())
 	return cmd
 }
 
@@ -59,8 +98,17 @@ func describeCmd() *cobra.Command {
 func getCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "get",
-		Short: "Retrieve information about armada resource. Supported: queue",
+		Short: "Retrieve information about armada resource. Supported: queue, queue-budgets, usage-report, fair-share-weights, fair-shares, cordons, maintenance-windows, executors, node-types, job-placements",
 	}
 	cmd.AddCommand(queueGetCmd())
+	cmd.AddCommand(queueBudgetsGetCmd())
+	cmd.AddCommand(usageReportGetCmd())
+	cmd.AddCommand(fairShareWeightsGetCmd())
+	cmd.AddCommand(fairSharesGetCmd())
+	cmd.AddCommand(cordonsGetCmd())
+	cmd.AddCommand(maintenanceWindowsGetCmd())
+	cmd.AddCommand(executorsGetCmd())
+	cmd.AddCommand(nodeTypesGetCmd())
+	cmd.AddCommand(jobPlacementsGetCmd())
 	return cmd
 }
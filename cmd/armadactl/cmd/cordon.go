@@ -0,0 +1,113 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/armadaproject/armada/internal/armadactl"
+)
+
+func cordonCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cordon",
+		Short: "Stop new jobs from being leased to Armada resource. Supported: queue, executor",
+	}
+	cmd.AddCommand(queueCordonCmd())
+	cmd.AddCommand(executorCordonCmd())
+	return cmd
+}
+
+func uncordonCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "uncordon",
+		Short: "Allow new jobs to be leased to cordoned Armada resource. Supported: queue, executor",
+	}
+	cmd.AddCommand(queueUncordonCmd())
+	cmd.AddCommand(executorUncordonCmd())
+	return cmd
+}
+
+func queueCordonCmd() *cobra.Command {
+	a := armadactl.New()
+	cmd := &cobra.Command{
+		Use:   "queue <queueName>",
+		Short: "Stops new jobs of a queue from being leased.",
+		Long:  "Stops new jobs of a queue from being leased. Running jobs of the queue are unaffected.",
+		Args:  cobra.ExactArgs(1),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initParams(cmd, a.Params)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			reason, _ := cmd.Flags().GetString("reason")
+			return a.CordonQueue(args[0], reason)
+		},
+	}
+	cmd.Flags().String("reason", "", "Why the queue is cordoned.")
+	return cmd
+}
+
+func queueUncordonCmd() *cobra.Command {
+	a := armadactl.New()
+	cmd := &cobra.Command{
+		Use:   "queue <queueName>",
+		Short: "Allows jobs of a cordoned queue to be leased again.",
+		Args:  cobra.ExactArgs(1),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initParams(cmd, a.Params)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return a.UncordonQueue(args[0])
+		},
+	}
+	return cmd
+}
+
+func executorCordonCmd() *cobra.Command {
+	a := armadactl.New()
+	cmd := &cobra.Command{
+		Use:   "executor <executorId>",
+		Short: "Stops new jobs from being leased to an executor.",
+		Long:  "Stops new jobs from being leased to an executor, i.e., a cluster. Jobs running on it are unaffected.",
+		Args:  cobra.ExactArgs(1),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initParams(cmd, a.Params)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			reason, _ := cmd.Flags().GetString("reason")
+			return a.CordonExecutor(args[0], reason)
+		},
+	}
+	cmd.Flags().String("reason", "", "Why the executor is cordoned.")
+	return cmd
+}
+
+func executorUncordonCmd() *cobra.Command {
+	a := armadactl.New()
+	cmd := &cobra.Command{
+		Use:   "executor <executorId>",
+		Short: "Allows jobs to be leased to a cordoned executor again.",
+		Args:  cobra.ExactArgs(1),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initParams(cmd, a.Params)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return a.UncordonExecutor(args[0])
+		},
+	}
+	return cmd
+}
+
+func cordonsGetCmd() *cobra.Command {
+	a := armadactl.New()
+	cmd := &cobra.Command{
+		Use:   "cordons",
+		Short: "Prints out the cordoned queues and executors.",
+		Args:  cobra.ExactArgs(0),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initParams(cmd, a.Params)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return a.GetCordons()
+		},
+	}
+	return cmd
+}
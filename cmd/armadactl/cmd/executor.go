@@ -0,0 +1,24 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/armadaproject/armada/internal/armadactl"
+)
+
+func executorsGetCmd() *cobra.Command {
+	a := armadactl.New()
+	cmd := &cobra.Command{
+		Use:   "executors",
+		Short: "Prints out the executors that have heartbeated, along with their health.",
+		Long:  "Prints out each executor that has heartbeated, i.e., requested leases, along with the time of its last heartbeat, its version, and its allocated resources and capacity.",
+		Args:  cobra.ExactArgs(0),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initParams(cmd, a.Params)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return a.GetExecutors()
+		},
+	}
+	return cmd
+}
@@ -0,0 +1,65 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/armadaproject/armada/internal/armadactl"
+)
+
+func fairShareWeightsGetCmd() *cobra.Command {
+	a := armadactl.New()
+	cmd := &cobra.Command{
+		Use:   "fair-share-weights",
+		Short: "Prints out the fair-share weight of each queue.",
+		Long:  "Prints out the fair-share weight of each queue, i.e., the inverse of its priority factor, and its weight once divided among the queues of its hierarchy.",
+		Args:  cobra.ExactArgs(0),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initParams(cmd, a.Params)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return a.GetFairShareWeights()
+		},
+	}
+	return cmd
+}
+
+func fairSharesGetCmd() *cobra.Command {
+	a := armadactl.New()
+	cmd := &cobra.Command{
+		Use:   "fair-shares",
+		Short: "Prints out the fair share and dominant resource share of each queue.",
+		Long:  "Prints out the fair share and dominant resource share of each queue as computed by the most recent scheduling round of each executor.",
+		Args:  cobra.ExactArgs(0),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initParams(cmd, a.Params)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return a.GetFairShares()
+		},
+	}
+	return cmd
+}
+
+func fairShareWeightUpdateCmd() *cobra.Command {
+	a := armadactl.New()
+	cmd := &cobra.Command{
+		Use:   "fair-share-weight <queueName> <weight>",
+		Short: "Sets the fair-share weight of a queue.",
+		Long:  "Sets the fair-share weight of a queue, which must be in (0, 1], by setting its priority factor to 1/weight.",
+		Args:  cobra.ExactArgs(2),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initParams(cmd, a.Params)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			weight, err := strconv.ParseFloat(args[1], 64)
+			if err != nil {
+				return fmt.Errorf("error parsing weight %q: %s", args[1], err)
+			}
+			return a.SetFairShareWeight(args[0], weight)
+		},
+	}
+	return cmd
+}
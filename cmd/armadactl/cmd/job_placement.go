@@ -0,0 +1,44 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/armadaproject/armada/internal/armadactl"
+)
+
+func jobPlacementsGetCmd() *cobra.Command {
+	a := armadactl.New()
+	cmd := &cobra.Command{
+		Use:   "job-placements <jobId>",
+		Short: "Prints out the cluster, pool, and node each run of a job was placed on.",
+		Long: `Prints out the cluster, pool, and node each run of a job was placed on, along with the tracked labels
+of the node and how the run ended, e.g., to find failures correlated with hardware:
+
+$ armadactl get job-placements <jobId> --queue <queue> --jobSet <jobSet>`,
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initParams(cmd, a.Params)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			queue, err := cmd.Flags().GetString("queue")
+			if err != nil {
+				return err
+			}
+			jobSetId, err := cmd.Flags().GetString("jobSet")
+			if err != nil {
+				return err
+			}
+			return a.GetJobPlacements(queue, jobSetId, args[0])
+		},
+	}
+	cmd.Flags().String("queue", "", "Queue of the job.")
+	cmd.Flags().String("jobSet", "", "Job set of the job.")
+	if err := cmd.MarkFlagRequired("queue"); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired("jobSet"); err != nil {
+		panic(err)
+	}
+	return cmd
+}
@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/armadaproject/armada/internal/armadactl"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+func logsCmd() *cobra.Command {
+	a := armadactl.New()
+	cmd := &cobra.Command{
+		Use:   "logs <jobId>",
+		Short: "Prints out the logs of a job.",
+		Long: `Prints out the container logs of the most recent run of a job, which must be running or have recently finished.
+With --follow, new logs are printed until the run ends:
+
+$ armadactl logs <jobId> --queue <queue> --jobSet <jobSet> --follow --tail 100`,
+		Args:         cobra.ExactArgs(1),
+		SilenceUsage: true,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initParams(cmd, a.Params)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			req := &api.JobLogsRequest{JobId: args[0]}
+			var err error
+			if req.Queue, err = cmd.Flags().GetString("queue"); err != nil {
+				return err
+			}
+			if req.JobSetId, err = cmd.Flags().GetString("jobSet"); err != nil {
+				return err
+			}
+			if req.PodNumber, err = cmd.Flags().GetInt32("podNumber"); err != nil {
+				return err
+			}
+			if req.Container, err = cmd.Flags().GetString("container"); err != nil {
+				return err
+			}
+			if req.Follow, err = cmd.Flags().GetBool("follow"); err != nil {
+				return err
+			}
+			if req.TailLines, err = cmd.Flags().GetInt64("tail"); err != nil {
+				return err
+			}
+			return a.GetJobLogs(req)
+		},
+	}
+	cmd.Flags().String("queue", "", "Queue of the job.")
+	cmd.Flags().String("jobSet", "", "Job set of the job.")
+	cmd.Flags().Int32("podNumber", 0, "Pod of the job to print the logs of.")
+	cmd.Flags().String("container", "", "Container to print the logs of; required if the pod has more than one.")
+	cmd.Flags().BoolP("follow", "f", false, "Print new logs until the run of the job ends.")
+	cmd.Flags().Int64("tail", 0, "Number of most recent lines to print; all lines if 0.")
+	if err := cmd.MarkFlagRequired("queue"); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired("jobSet"); err != nil {
+		panic(err)
+	}
+	return cmd
+}
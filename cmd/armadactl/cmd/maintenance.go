@@ -0,0 +1,114 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/armadaproject/armada/internal/armadactl"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+func maintenanceWindowCreateCmd() *cobra.Command {
+	a := armadactl.New()
+	cmd := &cobra.Command{
+		Use:   "maintenance-window",
+		Short: "Schedules a maintenance window of an executor or a queue.",
+		Long: `Schedules a maintenance window of an executor or a queue, during which no new jobs are leased to the executor
+or no new jobs of the queue are leased. If --drain is set, jobs leased to the executor, or leased jobs of the queue,
+are preempted and requeued when the window starts, e.g.:
+
+$ armadactl create maintenance-window --executor cluster-1 --start 2023-06-01T22:00:00Z --duration 2h --drain`,
+		Args:         cobra.ExactArgs(0),
+		SilenceUsage: true,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initParams(cmd, a.Params)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			request := &api.MaintenanceWindowCreateRequest{}
+			var err error
+			if request.Executor, err = cmd.Flags().GetString("executor"); err != nil {
+				return err
+			}
+			if request.Queue, err = cmd.Flags().GetString("queue"); err != nil {
+				return err
+			}
+			if request.Drain, err = cmd.Flags().GetBool("drain"); err != nil {
+				return err
+			}
+			if request.Reason, err = cmd.Flags().GetString("reason"); err != nil {
+				return err
+			}
+			start, err := cmd.Flags().GetString("start")
+			if err != nil {
+				return err
+			}
+			end, err := cmd.Flags().GetString("end")
+			if err != nil {
+				return err
+			}
+			duration, err := cmd.Flags().GetDuration("duration")
+			if err != nil {
+				return err
+			}
+
+			request.Start = time.Now()
+			if start != "" {
+				if request.Start, err = time.Parse(time.RFC3339, start); err != nil {
+					return fmt.Errorf("error parsing start %q: %s", start, err)
+				}
+			}
+			if (end == "") == (duration == 0) {
+				return fmt.Errorf("exactly one of --end and --duration must be provided")
+			} else if end != "" {
+				if request.End, err = time.Parse(time.RFC3339, end); err != nil {
+					return fmt.Errorf("error parsing end %q: %s", end, err)
+				}
+			} else {
+				request.End = request.Start.Add(duration)
+			}
+			return a.CreateMaintenanceWindow(request)
+		},
+	}
+	cmd.Flags().String("executor", "", "Executor the window applies to.")
+	cmd.Flags().String("queue", "", "Queue the window applies to.")
+	cmd.Flags().String("start", "", "Start of the window, in RFC 3339 format, defaults to now.")
+	cmd.Flags().String("end", "", "End of the window, in RFC 3339 format.")
+	cmd.Flags().Duration("duration", 0, "Duration of the window, as an alternative to --end.")
+	cmd.Flags().Bool("drain", false, "Preempt and requeue leased jobs when the window starts.")
+	cmd.Flags().String("reason", "", "Why the window is scheduled.")
+	return cmd
+}
+
+func maintenanceWindowsGetCmd() *cobra.Command {
+	a := armadactl.New()
+	cmd := &cobra.Command{
+		Use:   "maintenance-windows",
+		Short: "Prints out the maintenance windows that haven't ended yet.",
+		Args:  cobra.ExactArgs(0),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initParams(cmd, a.Params)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return a.GetMaintenanceWindows()
+		},
+	}
+	return cmd
+}
+
+func maintenanceWindowDeleteCmd() *cobra.Command {
+	a := armadactl.New()
+	cmd := &cobra.Command{
+		Use:   "maintenance-window <id>",
+		Short: "Deletes a maintenance window, ending it if it has started.",
+		Args:  cobra.ExactArgs(1),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initParams(cmd, a.Params)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return a.DeleteMaintenanceWindow(args[0])
+		},
+	}
+	return cmd
+}
@@ -0,0 +1,48 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/armadaproject/armada/internal/armadactl"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+func nodeTypesGetCmd() *cobra.Command {
+	a := armadactl.New()
+	cmd := &cobra.Command{
+		Use:   "node-types",
+		Short: "Prints out the types of nodes of each cluster.",
+		Long: `Prints out the types of nodes of each recently active cluster, i.e., groups of nodes with the same
+allocatable resources, labels, and taints, along with the number of nodes of each type.
+Use it to check whether any cluster could run a job before submitting it, e.g.:
+
+$ armadactl get node-types --min-allocatable nvidia.com/gpu=8 --label gpu=a100`,
+		Args:         cobra.ExactArgs(0),
+		SilenceUsage: true,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initParams(cmd, a.Params)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			request := &api.NodeTypesRequest{}
+			var err error
+			if request.ClusterId, err = cmd.Flags().GetString("cluster"); err != nil {
+				return err
+			}
+			if request.Pool, err = cmd.Flags().GetString("pool"); err != nil {
+				return err
+			}
+			if request.Labels, err = cmd.Flags().GetStringToString("label"); err != nil {
+				return err
+			}
+			if request.MinAllocatable, err = cmd.Flags().GetStringToString("min-allocatable"); err != nil {
+				return err
+			}
+			return a.GetNodeTypes(request)
+		},
+	}
+	cmd.Flags().String("cluster", "", "Only print out node types of this cluster.")
+	cmd.Flags().String("pool", "", "Only print out node types of clusters of this pool.")
+	cmd.Flags().StringToString("label", map[string]string{}, "Only print out node types with these labels, e.g., --label gpu=a100")
+	cmd.Flags().StringToString("min-allocatable", map[string]string{}, "Only print out node types with at least this much of each resource allocatable per node, e.g., --min-allocatable nvidia.com/gpu=8")
+	return cmd
+}
@@ -0,0 +1,41 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/armadaproject/armada/internal/armadactl"
+)
+
+func pauseCmd() *cobra.Command {
+	a := armadactl.New()
+	cmd := &cobra.Command{
+		Use:   "pause <queue> <jobSet>",
+		Short: "Pauses a job set in armada.",
+		Long:  `Suspends the queued jobs of a job set, such that they aren't scheduled until the job set is resumed. Running jobs are unaffected.`,
+		Args:  cobra.ExactArgs(2),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initParams(cmd, a.Params)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return a.Pause(args[0], args[1])
+		},
+	}
+	return cmd
+}
+
+func resumeCmd() *cobra.Command {
+	a := armadactl.New()
+	cmd := &cobra.Command{
+		Use:   "resume <queue> <jobSet>",
+		Short: "Resumes a paused job set in armada.",
+		Long:  `Returns the suspended jobs of a paused job set to the queue.`,
+		Args:  cobra.ExactArgs(2),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initParams(cmd, a.Params)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return a.Resume(args[0], args[1])
+		},
+	}
+	return cmd
+}
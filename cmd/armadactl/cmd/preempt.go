@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/armadaproject/armada/internal/armadactl"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+func preemptCmd() *cobra.Command {
+	a := armadactl.New()
+	cmd := &cobra.Command{
+		Use:   "preempt <queue>",
+		Short: "Preempts running jobs in armada.",
+		Long: `Evicts leased jobs of a queue, selected either by jobId or by job set and/or labels.
+Preempted jobs fail, unless --requeue is set, in which case they're returned to the queue to be scheduled again.`,
+		Args: cobra.ExactArgs(1),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initParams(cmd, a.Params)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			jobIds, err := cmd.Flags().GetStringSlice("jobId")
+			if err != nil {
+				return err
+			}
+			jobSetId, _ := cmd.Flags().GetString("jobSet")
+			labels, err := cmd.Flags().GetStringToString("labels")
+			if err != nil {
+				return err
+			}
+			requeue, _ := cmd.Flags().GetBool("requeue")
+			reason, _ := cmd.Flags().GetString("reason")
+			return a.Preempt(&api.JobPreemptRequest{
+				Queue:         args[0],
+				JobIds:        jobIds,
+				JobSetId:      jobSetId,
+				LabelSelector: labels,
+				Requeue:       requeue,
+				Reason:        reason,
+			})
+		},
+	}
+	cmd.Flags().StringSlice("jobId", []string{}, "Comma separated list of jobs to preempt")
+	cmd.Flags().String("jobSet", "", "job set to preempt jobs of")
+	cmd.Flags().StringToString("labels", map[string]string{}, "Labels of jobs to preempt, e.g., --labels team=ml")
+	cmd.Flags().Bool("requeue", false, "return preempted jobs to the queue instead of failing them")
+	cmd.Flags().String("reason", "", "reason for preempting the jobs")
+	return cmd
+}
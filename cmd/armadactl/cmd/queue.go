@@ -3,8 +3,12 @@ package cmd
 import (
 	"fmt"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 
 	"github.com/armadaproject/armada/internal/armadactl"
 	"github.com/armadaproject/armada/pkg/api"
@@ -52,12 +56,114 @@ Job priority is evaluated inside queue, queue has its own priority.`,
 				return fmt.Errorf("error reading resourceLimits: %s", err)
 			}
 
+			maxJobSizeBytes, err := cmd.Flags().GetUint32("maxJobSizeBytes")
+			if err != nil {
+				return fmt.Errorf("error reading maxJobSizeBytes: %s", err)
+			}
+
+			maxContainersPerJob, err := cmd.Flags().GetUint32("maxContainersPerJob")
+			if err != nil {
+				return fmt.Errorf("error reading maxContainersPerJob: %s", err)
+			}
+
+			maxJobRuntimeSeconds, err := cmd.Flags().GetUint32("maxJobRuntimeSeconds")
+			if err != nil {
+				return fmt.Errorf("error reading maxJobRuntimeSeconds: %s", err)
+			}
+
+			allowedNamespaces, err := cmd.Flags().GetStringSlice("allowedNamespaces")
+			if err != nil {
+				return fmt.Errorf("error reading allowedNamespaces: %s", err)
+			}
+
+			ownersCanManageOwnJobs, err := cmd.Flags().GetBool("ownersCanManageOwnJobs")
+			if err != nil {
+				return fmt.Errorf("error reading ownersCanManageOwnJobs: %s", err)
+			}
+
+			podSpecPolicy, err := podSpecPolicyFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			jobPriorityPolicy, err := jobPriorityPolicyFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			ingressClassPolicy, err := ingressClassPolicyFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			submissionWindows, err := submissionWindowsFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			resourceBudgets, err := resourceBudgetsFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			resourceQuotas, err := flagGetStringToString(cmd.Flags().GetStringToString).toQuantity("resourceQuotas")
+			if err != nil {
+				return fmt.Errorf("error reading resourceQuotas: %s", err)
+			}
+
+			parent, err := cmd.Flags().GetString("parent")
+			if err != nil {
+				return fmt.Errorf("error reading parent: %s", err)
+			}
+
+			labels, err := cmd.Flags().GetStringToString("labels")
+			if err != nil {
+				return fmt.Errorf("error reading labels: %s", err)
+			}
+
+			requiredAnnotations, err := cmd.Flags().GetStringToString("requiredAnnotations")
+			if err != nil {
+				return fmt.Errorf("error reading requiredAnnotations: %s", err)
+			}
+
+			description, err := cmd.Flags().GetString("description")
+			if err != nil {
+				return fmt.Errorf("error reading description: %s", err)
+			}
+
+			contact, err := cmd.Flags().GetString("contact")
+			if err != nil {
+				return fmt.Errorf("error reading contact: %s", err)
+			}
+
+			documentationUrl, err := cmd.Flags().GetString("documentationUrl")
+			if err != nil {
+				return fmt.Errorf("error reading documentationUrl: %s", err)
+			}
+
 			queue, err := queue.NewQueue(&api.Queue{
-				Name:           name,
-				PriorityFactor: priorityFactor,
-				UserOwners:     owners,
-				GroupOwners:    groups,
-				ResourceLimits: resourceLimits,
+				Name:                   name,
+				PriorityFactor:         priorityFactor,
+				UserOwners:             owners,
+				GroupOwners:            groups,
+				ResourceLimits:         resourceLimits,
+				MaxJobSizeBytes:        maxJobSizeBytes,
+				MaxContainersPerJob:    maxContainersPerJob,
+				MaxJobRuntimeSeconds:   maxJobRuntimeSeconds,
+				AllowedNamespaces:      allowedNamespaces,
+				PodSpecPolicy:          podSpecPolicy,
+				JobPriorityPolicy:      jobPriorityPolicy,
+				IngressClassPolicy:     ingressClassPolicy,
+				OwnersCanManageOwnJobs: ownersCanManageOwnJobs,
+				SubmissionWindows:      submissionWindows,
+				ResourceBudgets:        resourceBudgets,
+				ResourceQuotas:         resourceQuotas,
+				Parent:                 parent,
+				Labels:                 labels,
+				RequiredAnnotations:    requiredAnnotations,
+				Description:            description,
+				Contact:                contact,
+				DocumentationUrl:       documentationUrl,
 			})
 			if err != nil {
 				return fmt.Errorf("invalid queue data: %s", err)
@@ -66,12 +172,34 @@ Job priority is evaluated inside queue, queue has its own priority.`,
 			return a.CreateQueue(queue)
 		},
 	}
-	cmd.Flags().Float64("priorityFactor", 1, "Set queue priority factor - lower number makes queue more important, must be > 0.")
+	cmd.Flags().Float64("priorityFactor", 1, "Set queue priority factor - lower number makes queue more important, must be > 0, or 0 to inherit the priority factor of the parent.")
 	cmd.Flags().StringSlice("owners", []string{}, "Comma separated list of queue owners, defaults to current user.")
 	cmd.Flags().StringSlice("groupOwners", []string{}, "Comma separated list of queue group owners, defaults to empty list.")
 	cmd.Flags().StringToString("resourceLimits", map[string]string{},
 		"Command separated list of resource limits pairs, defaults to empty list.\nExample: --resourceLimits cpu=0.3,memory=0.2",
 	)
+	cmd.Flags().Uint32("maxJobSizeBytes", 0, "Maximum size in bytes of jobs submitted to the queue, defaults to only the server-wide limit applying.")
+	cmd.Flags().Uint32("maxContainersPerJob", 0, "Maximum number of containers of jobs submitted to the queue, defaults to only the server-wide limit applying.")
+	cmd.Flags().Uint32("maxJobRuntimeSeconds", 0, "Maximum runtime in seconds of jobs submitted to the queue, which is also the runtime of jobs submitted without one, defaults to unbounded.")
+	cmd.Flags().StringSlice("allowedNamespaces", []string{},
+		"Comma separated list of namespaces jobs submitted to the queue may be created in, including \"default\" for jobs submitted without one, defaults to any namespace. Example: --allowedNamespaces team-a,team-b",
+	)
+	cmd.Flags().Bool("ownersCanManageOwnJobs", false, "Allow users to cancel and reprioritize the jobs they submitted to the queue without being permitted to do so for all jobs of the queue.")
+	cmd.Flags().StringToString("resourceQuotas", map[string]string{},
+		"Comma separated list of resource quotas limiting the total resources of queued and running jobs, defaults to empty list. Example: --resourceQuotas cpu=1000,nvidia.com/gpu=16",
+	)
+	cmd.Flags().String("parent", "", "Name of the parent queue, from which the queue inherits permissions and among whose children its fair share is divided, defaults to none.")
+	cmd.Flags().StringToString("labels", map[string]string{}, "Comma separated list of labels by which the queue can be selected, defaults to empty list. Example: --labels team=ml,env=prod")
+	cmd.Flags().StringToString(
+		"requiredAnnotations", map[string]string{},
+		"Comma separated list of annotations jobs must have, mapped to regular expressions their values must match in full, defaults to empty list. Example: --requiredAnnotations cost-center=[0-9]{4},team=",
+	)
+	addPodSpecPolicyFlags(cmd)
+	addJobPriorityPolicyFlags(cmd)
+	addIngressClassPolicyFlags(cmd)
+	addSubmissionWindowFlags(cmd)
+	addResourceBudgetFlags(cmd)
+	addDocumentationFlags(cmd)
 	return cmd
 }
 
@@ -84,16 +212,129 @@ func queueDeleteCmdWithApp(a *armadactl.App) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "queue <queueName>",
 		Short: "Delete existing queue",
-		Long:  "Deletes queue if it exists, the queue needs to be empty at the time of deletion.",
-		Args:  cobra.ExactArgs(1),
+		Long: `Deletes queue if it exists, the queue needs to be empty at the time of deletion.
+With --cascade, the jobs of the queue are cancelled first and the queue is deleted in the background once they're gone.`,
+		Args: cobra.ExactArgs(1),
 		PreRunE: func(cmd *cobra.Command, args []string) error {
 			return initParams(cmd, a.Params)
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			name := args[0]
+			cascade, err := cmd.Flags().GetBool("cascade")
+			if err != nil {
+				return fmt.Errorf("error reading cascade: %s", err)
+			}
+			if cascade {
+				return a.CascadeDeleteQueue(name)
+			}
 			return a.DeleteQueue(name)
 		},
 	}
+	cmd.Flags().Bool("cascade", false, "Cancel all jobs of the queue and delete it once they're gone")
+	return cmd
+}
+
+func queueArchiveCmd() *cobra.Command {
+	a := armadactl.New()
+	cmd := &cobra.Command{
+		Use:   "queue <queueName>",
+		Short: "Archive existing queue",
+		Long:  "Archives queue, such that it rejects new jobs while retaining its configuration and existing jobs. Archived queues can be restored.",
+		Args:  cobra.ExactArgs(1),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initParams(cmd, a.Params)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			reason, err := cmd.Flags().GetString("reason")
+			if err != nil {
+				return fmt.Errorf("error reading reason: %s", err)
+			}
+			return a.ArchiveQueue(args[0], reason)
+		},
+	}
+	cmd.Flags().String("reason", "", "Why the queue is archived, recorded together with who archived it and when.")
+	return cmd
+}
+
+func queueRestoreCmd() *cobra.Command {
+	a := armadactl.New()
+	cmd := &cobra.Command{
+		Use:   "queue <queueName>",
+		Short: "Restore archived queue",
+		Long:  "Restores archived queue, such that it accepts new jobs again.",
+		Args:  cobra.ExactArgs(1),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initParams(cmd, a.Params)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return a.RestoreQueue(args[0])
+		},
+	}
+	return cmd
+}
+
+func queuesExportCmd() *cobra.Command {
+	a := armadactl.New()
+	cmd := &cobra.Command{
+		Use:   "queues",
+		Short: "Prints a YAML document of queues",
+		Long: `Prints a YAML document of all queues, including their permissions and limits, which can be kept in Git and
+imported with "armadactl import queues".`,
+		Args: cobra.NoArgs,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initParams(cmd, a.Params)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			namePrefix, err := cmd.Flags().GetString("name-prefix")
+			if err != nil {
+				return fmt.Errorf("error reading name-prefix: %s", err)
+			}
+			return a.ExportQueues(namePrefix)
+		},
+	}
+	cmd.Flags().String("name-prefix", "", "Only export queues whose names start with this prefix.")
+	return cmd
+}
+
+func queuesImportCmd() *cobra.Command {
+	a := armadactl.New()
+	cmd := &cobra.Command{
+		Use:   "queues -f <file>",
+		Short: "Makes queues match a YAML document",
+		Long: `Creates and updates queues such that they match a YAML document produced by "armadactl export queues".
+With --prune, queues not in the document are deleted. With --dry-run, the changes are printed but not applied,
+e.g., to detect drift.`,
+		Args: cobra.NoArgs,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initParams(cmd, a.Params)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			fileName, err := cmd.Flags().GetString("file")
+			if err != nil {
+				return fmt.Errorf("error reading file: %s", err)
+			}
+			prune, err := cmd.Flags().GetBool("prune")
+			if err != nil {
+				return fmt.Errorf("error reading prune: %s", err)
+			}
+			namePrefix, err := cmd.Flags().GetString("name-prefix")
+			if err != nil {
+				return fmt.Errorf("error reading name-prefix: %s", err)
+			}
+			dryRun, err := cmd.Flags().GetBool("dry-run")
+			if err != nil {
+				return fmt.Errorf("error reading dry-run: %s", err)
+			}
+			return a.ImportQueues(fileName, prune, namePrefix, dryRun)
+		},
+	}
+	cmd.Flags().StringP("file", "f", "", "YAML document of the queues.")
+	if err := cmd.MarkFlagRequired("file"); err != nil {
+		panic(err)
+	}
+	cmd.Flags().Bool("prune", false, "Delete queues not in the document.")
+	cmd.Flags().String("name-prefix", "", "Only queues whose names start with this prefix may be in the document or be deleted.")
+	cmd.Flags().Bool("dry-run", false, "Print the changes needed for the queues to match the document without applying them.")
 	return cmd
 }
 
@@ -141,6 +382,28 @@ func queueGetCmdWithApp(a *armadactl.App) *cobra.Command {
 	return cmd
 }
 
+func queueBudgetsGetCmd() *cobra.Command {
+	a := armadactl.New()
+	cmd := &cobra.Command{
+		Use:   "queue-budgets <queueName>",
+		Short: "Prints out the usage of the resource budgets of a queue.",
+		Long:  "Prints out the resource-hours used and remaining within the window of each resource budget of a queue.",
+		Args:  cobra.ExactArgs(1),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initParams(cmd, a.Params)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			owner, err := cmd.Flags().GetString("owner")
+			if err != nil {
+				return fmt.Errorf("error reading owner: %s", err)
+			}
+			return a.GetQueueBudgets(args[0], owner)
+		},
+	}
+	cmd.Flags().String("owner", "", "Owner whose usage of budgets that apply to each owner separately is printed, defaults to the current user.")
+	return cmd
+}
+
 func queueUpdateCmd() *cobra.Command {
 	return queueUpdateCmdWithApp(armadactl.New())
 }
@@ -178,12 +441,114 @@ func queueUpdateCmdWithApp(a *armadactl.App) *cobra.Command {
 				return fmt.Errorf("error reading resourceLimits: %s", err)
 			}
 
+			maxJobSizeBytes, err := cmd.Flags().GetUint32("maxJobSizeBytes")
+			if err != nil {
+				return fmt.Errorf("error reading maxJobSizeBytes: %s", err)
+			}
+
+			maxContainersPerJob, err := cmd.Flags().GetUint32("maxContainersPerJob")
+			if err != nil {
+				return fmt.Errorf("error reading maxContainersPerJob: %s", err)
+			}
+
+			maxJobRuntimeSeconds, err := cmd.Flags().GetUint32("maxJobRuntimeSeconds")
+			if err != nil {
+				return fmt.Errorf("error reading maxJobRuntimeSeconds: %s", err)
+			}
+
+			allowedNamespaces, err := cmd.Flags().GetStringSlice("allowedNamespaces")
+			if err != nil {
+				return fmt.Errorf("error reading allowedNamespaces: %s", err)
+			}
+
+			ownersCanManageOwnJobs, err := cmd.Flags().GetBool("ownersCanManageOwnJobs")
+			if err != nil {
+				return fmt.Errorf("error reading ownersCanManageOwnJobs: %s", err)
+			}
+
+			podSpecPolicy, err := podSpecPolicyFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			jobPriorityPolicy, err := jobPriorityPolicyFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			ingressClassPolicy, err := ingressClassPolicyFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			submissionWindows, err := submissionWindowsFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			resourceBudgets, err := resourceBudgetsFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			resourceQuotas, err := flagGetStringToString(cmd.Flags().GetStringToString).toQuantity("resourceQuotas")
+			if err != nil {
+				return fmt.Errorf("error reading resourceQuotas: %s", err)
+			}
+
+			parent, err := cmd.Flags().GetString("parent")
+			if err != nil {
+				return fmt.Errorf("error reading parent: %s", err)
+			}
+
+			labels, err := cmd.Flags().GetStringToString("labels")
+			if err != nil {
+				return fmt.Errorf("error reading labels: %s", err)
+			}
+
+			requiredAnnotations, err := cmd.Flags().GetStringToString("requiredAnnotations")
+			if err != nil {
+				return fmt.Errorf("error reading requiredAnnotations: %s", err)
+			}
+
+			description, err := cmd.Flags().GetString("description")
+			if err != nil {
+				return fmt.Errorf("error reading description: %s", err)
+			}
+
+			contact, err := cmd.Flags().GetString("contact")
+			if err != nil {
+				return fmt.Errorf("error reading contact: %s", err)
+			}
+
+			documentationUrl, err := cmd.Flags().GetString("documentationUrl")
+			if err != nil {
+				return fmt.Errorf("error reading documentationUrl: %s", err)
+			}
+
 			queue, err := queue.NewQueue(&api.Queue{
-				Name:           name,
-				PriorityFactor: priorityFactor,
-				UserOwners:     owners,
-				GroupOwners:    groups,
-				ResourceLimits: resourceLimits,
+				Name:                   name,
+				PriorityFactor:         priorityFactor,
+				UserOwners:             owners,
+				GroupOwners:            groups,
+				ResourceLimits:         resourceLimits,
+				MaxJobSizeBytes:        maxJobSizeBytes,
+				MaxContainersPerJob:    maxContainersPerJob,
+				MaxJobRuntimeSeconds:   maxJobRuntimeSeconds,
+				AllowedNamespaces:      allowedNamespaces,
+				PodSpecPolicy:          podSpecPolicy,
+				JobPriorityPolicy:      jobPriorityPolicy,
+				IngressClassPolicy:     ingressClassPolicy,
+				OwnersCanManageOwnJobs: ownersCanManageOwnJobs,
+				SubmissionWindows:      submissionWindows,
+				ResourceBudgets:        resourceBudgets,
+				ResourceQuotas:         resourceQuotas,
+				Parent:                 parent,
+				Labels:                 labels,
+				RequiredAnnotations:    requiredAnnotations,
+				Description:            description,
+				Contact:                contact,
+				DocumentationUrl:       documentationUrl,
 			})
 			if err != nil {
 				return fmt.Errorf("invalid queue data: %s", err)
@@ -193,15 +558,301 @@ func queueUpdateCmdWithApp(a *armadactl.App) *cobra.Command {
 		},
 	}
 	// TODO this will overwrite existing values with default values if not all flags are provided
-	cmd.Flags().Float64("priorityFactor", 1, "Set queue priority factor - lower number makes queue more important, must be > 0.")
+	cmd.Flags().Float64("priorityFactor", 1, "Set queue priority factor - lower number makes queue more important, must be > 0, or 0 to inherit the priority factor of the parent.")
 	cmd.Flags().StringSlice("owners", []string{}, "Comma separated list of queue owners, defaults to current user.")
 	cmd.Flags().StringSlice("groupOwners", []string{}, "Comma separated list of queue group owners, defaults to empty list.")
 	cmd.Flags().StringToString("resourceLimits", map[string]string{},
 		"Command separated list of resource limits pairs, defaults to empty list. Example: --resourceLimits cpu=0.3,memory=0.2",
 	)
+	cmd.Flags().Uint32("maxJobSizeBytes", 0, "Maximum size in bytes of jobs submitted to the queue, defaults to only the server-wide limit applying.")
+	cmd.Flags().Uint32("maxContainersPerJob", 0, "Maximum number of containers of jobs submitted to the queue, defaults to only the server-wide limit applying.")
+	cmd.Flags().Uint32("maxJobRuntimeSeconds", 0, "Maximum runtime in seconds of jobs submitted to the queue, which is also the runtime of jobs submitted without one, defaults to unbounded.")
+	cmd.Flags().StringSlice("allowedNamespaces", []string{},
+		"Comma separated list of namespaces jobs submitted to the queue may be created in, including \"default\" for jobs submitted without one, defaults to any namespace. Example: --allowedNamespaces team-a,team-b",
+	)
+	cmd.Flags().Bool("ownersCanManageOwnJobs", false, "Allow users to cancel and reprioritize the jobs they submitted to the queue without being permitted to do so for all jobs of the queue.")
+	cmd.Flags().StringToString("resourceQuotas", map[string]string{},
+		"Comma separated list of resource quotas limiting the total resources of queued and running jobs, defaults to empty list. Example: --resourceQuotas cpu=1000,nvidia.com/gpu=16",
+	)
+	cmd.Flags().String("parent", "", "Name of the parent queue, from which the queue inherits permissions and among whose children its fair share is divided, defaults to none.")
+	cmd.Flags().StringToString("labels", map[string]string{}, "Comma separated list of labels by which the queue can be selected, defaults to empty list. Example: --labels team=ml,env=prod")
+	cmd.Flags().StringToString(
+		"requiredAnnotations", map[string]string{},
+		"Comma separated list of annotations jobs must have, mapped to regular expressions their values must match in full, defaults to empty list. Example: --requiredAnnotations cost-center=[0-9]{4},team=",
+	)
+	addPodSpecPolicyFlags(cmd)
+	addJobPriorityPolicyFlags(cmd)
+	addIngressClassPolicyFlags(cmd)
+	addSubmissionWindowFlags(cmd)
+	addResourceBudgetFlags(cmd)
+	addDocumentationFlags(cmd)
 	return cmd
 }
 
+func addPodSpecPolicyFlags(cmd *cobra.Command) {
+	cmd.Flags().Uint32("defaultTerminationGracePeriodSeconds", 0, "Termination grace period of pods that don't set one, defaults to the server-wide default.")
+	cmd.Flags().Uint32("minTerminationGracePeriodSeconds", 0, "Minimum termination grace period pods may set, defaults to only the server-wide minimum applying.")
+	cmd.Flags().Uint32("maxTerminationGracePeriodSeconds", 0, "Maximum termination grace period pods may set, defaults to only the server-wide maximum applying.")
+	cmd.Flags().String("defaultRestartPolicy", "", "Restart policy of pods that don't set one, defaults to the server-wide default.")
+	cmd.Flags().StringSlice("allowedRestartPolicies", []string{}, "Comma separated list of restart policies pods may set, defaults to only the server-wide restriction applying.")
+	cmd.Flags().StringSlice("allowedServiceAccounts", []string{}, "Comma separated list of service accounts pods may run as, defaults to only the server-wide restriction applying.")
+	cmd.Flags().StringToString("requiredNodeSelector", map[string]string{}, "Node selector labels set on all pods, e.g., pool=team-a, replacing any values pods set for them.")
+	cmd.Flags().StringSlice("requiredTolerations", []string{}, "Comma separated list of tolerations added to all pods, in the form key=value:Effect, or key:Effect to tolerate any value.")
+	cmd.Flags().StringSlice("forbiddenNodeSelectorLabels", []string{}, "Comma separated list of labels pods may not select nodes by.")
+	cmd.Flags().StringSlice("forbiddenTaints", []string{}, "Comma separated list of taints pods may not tolerate, in the form key=value:Effect.")
+	cmd.Flags().Bool("requestsEqualLimits", false, "Lower the resource limits of containers to their requests, defaults to only the server-wide setting applying.")
+}
+
+func podSpecPolicyFromFlags(cmd *cobra.Command) (*api.PodSpecPolicy, error) {
+	defaultTerminationGracePeriodSeconds, err := cmd.Flags().GetUint32("defaultTerminationGracePeriodSeconds")
+	if err != nil {
+		return nil, fmt.Errorf("error reading defaultTerminationGracePeriodSeconds: %s", err)
+	}
+
+	minTerminationGracePeriodSeconds, err := cmd.Flags().GetUint32("minTerminationGracePeriodSeconds")
+	if err != nil {
+		return nil, fmt.Errorf("error reading minTerminationGracePeriodSeconds: %s", err)
+	}
+
+	maxTerminationGracePeriodSeconds, err := cmd.Flags().GetUint32("maxTerminationGracePeriodSeconds")
+	if err != nil {
+		return nil, fmt.Errorf("error reading maxTerminationGracePeriodSeconds: %s", err)
+	}
+
+	defaultRestartPolicy, err := cmd.Flags().GetString("defaultRestartPolicy")
+	if err != nil {
+		return nil, fmt.Errorf("error reading defaultRestartPolicy: %s", err)
+	}
+
+	allowedRestartPolicies, err := cmd.Flags().GetStringSlice("allowedRestartPolicies")
+	if err != nil {
+		return nil, fmt.Errorf("error reading allowedRestartPolicies: %s", err)
+	}
+
+	allowedServiceAccounts, err := cmd.Flags().GetStringSlice("allowedServiceAccounts")
+	if err != nil {
+		return nil, fmt.Errorf("error reading allowedServiceAccounts: %s", err)
+	}
+
+	requiredNodeSelector, err := cmd.Flags().GetStringToString("requiredNodeSelector")
+	if err != nil {
+		return nil, fmt.Errorf("error reading requiredNodeSelector: %s", err)
+	}
+
+	requiredTolerationFlags, err := cmd.Flags().GetStringSlice("requiredTolerations")
+	if err != nil {
+		return nil, fmt.Errorf("error reading requiredTolerations: %s", err)
+	}
+	var requiredTolerations []v1.Toleration
+	for _, flag := range requiredTolerationFlags {
+		key, value, effect := parseTaint(flag)
+		operator := v1.TolerationOpEqual
+		if value == "" {
+			operator = v1.TolerationOpExists
+		}
+		requiredTolerations = append(requiredTolerations, v1.Toleration{Key: key, Operator: operator, Value: value, Effect: effect})
+	}
+
+	forbiddenNodeSelectorLabels, err := cmd.Flags().GetStringSlice("forbiddenNodeSelectorLabels")
+	if err != nil {
+		return nil, fmt.Errorf("error reading forbiddenNodeSelectorLabels: %s", err)
+	}
+
+	forbiddenTaintFlags, err := cmd.Flags().GetStringSlice("forbiddenTaints")
+	if err != nil {
+		return nil, fmt.Errorf("error reading forbiddenTaints: %s", err)
+	}
+	var forbiddenTaints []v1.Taint
+	for _, flag := range forbiddenTaintFlags {
+		key, value, effect := parseTaint(flag)
+		forbiddenTaints = append(forbiddenTaints, v1.Taint{Key: key, Value: value, Effect: effect})
+	}
+
+	requestsEqualLimits, err := cmd.Flags().GetBool("requestsEqualLimits")
+	if err != nil {
+		return nil, fmt.Errorf("error reading requestsEqualLimits: %s", err)
+	}
+
+	return &api.PodSpecPolicy{
+		DefaultTerminationGracePeriodSeconds: defaultTerminationGracePeriodSeconds,
+		MinTerminationGracePeriodSeconds:     minTerminationGracePeriodSeconds,
+		MaxTerminationGracePeriodSeconds:     maxTerminationGracePeriodSeconds,
+		DefaultRestartPolicy:                 defaultRestartPolicy,
+		AllowedRestartPolicies:               allowedRestartPolicies,
+		AllowedServiceAccounts:               allowedServiceAccounts,
+		RequiredNodeSelector:                 requiredNodeSelector,
+		RequiredTolerations:                  requiredTolerations,
+		ForbiddenNodeSelectorLabels:          forbiddenNodeSelectorLabels,
+		ForbiddenTaints:                      forbiddenTaints,
+		RequestsEqualLimits:                  requestsEqualLimits,
+	}, nil
+}
+
+// parseTaint splits a taint of the form key=value:Effect, as used by kubectl, into its parts.
+// The value and effect are optional. The parts are validated by the server.
+func parseTaint(taint string) (string, string, v1.TaintEffect) {
+	keyValue, effect, _ := strings.Cut(taint, ":")
+	key, value, _ := strings.Cut(keyValue, "=")
+	return key, value, v1.TaintEffect(effect)
+}
+
+func addDocumentationFlags(cmd *cobra.Command) {
+	cmd.Flags().String("description", "", "What the queue is for, shown to users discovering queues, defaults to none.")
+	cmd.Flags().String("contact", "", "Whom to contact about the queue, e.g., an email address or chat channel, defaults to none.")
+	cmd.Flags().String("documentationUrl", "", "Absolute http(s) URL of documentation about the queue, defaults to none.")
+}
+
+func addJobPriorityPolicyFlags(cmd *cobra.Command) {
+	cmd.Flags().Float64("defaultJobPriority", 0, "Priority of jobs submitted with priority 0, defaults to 0.")
+	cmd.Flags().Float64("minJobPriority", 0, "Minimum priority jobs may be submitted or reprioritised with, defaults to no minimum.")
+	cmd.Flags().Float64("maxJobPriority", 0, "Maximum priority jobs may be submitted or reprioritised with, defaults to no maximum.")
+}
+
+func jobPriorityPolicyFromFlags(cmd *cobra.Command) (*api.JobPriorityPolicy, error) {
+	defaultJobPriority, err := cmd.Flags().GetFloat64("defaultJobPriority")
+	if err != nil {
+		return nil, fmt.Errorf("error reading defaultJobPriority: %s", err)
+	}
+
+	minJobPriority, err := cmd.Flags().GetFloat64("minJobPriority")
+	if err != nil {
+		return nil, fmt.Errorf("error reading minJobPriority: %s", err)
+	}
+
+	maxJobPriority, err := cmd.Flags().GetFloat64("maxJobPriority")
+	if err != nil {
+		return nil, fmt.Errorf("error reading maxJobPriority: %s", err)
+	}
+
+	return &api.JobPriorityPolicy{
+		DefaultPriority: defaultJobPriority,
+		MinPriority:     minJobPriority,
+		MaxPriority:     maxJobPriority,
+	}, nil
+}
+
+func addIngressClassPolicyFlags(cmd *cobra.Command) {
+	cmd.Flags().StringSlice("allowedIngressClasses", []string{},
+		"Comma separated list of ingress classes the ingresses of jobs submitted to the queue may use, defaults to any class. Example: --allowedIngressClasses internal,nginx",
+	)
+	cmd.Flags().String("defaultIngressClass", "", "Ingress class of ingresses submitted without one, defaults to the default class of the cluster.")
+}
+
+func ingressClassPolicyFromFlags(cmd *cobra.Command) (*api.IngressClassPolicy, error) {
+	allowedIngressClasses, err := cmd.Flags().GetStringSlice("allowedIngressClasses")
+	if err != nil {
+		return nil, fmt.Errorf("error reading allowedIngressClasses: %s", err)
+	}
+
+	defaultIngressClass, err := cmd.Flags().GetString("defaultIngressClass")
+	if err != nil {
+		return nil, fmt.Errorf("error reading defaultIngressClass: %s", err)
+	}
+
+	return &api.IngressClassPolicy{
+		AllowedClasses: allowedIngressClasses,
+		DefaultClass:   defaultIngressClass,
+	}, nil
+}
+
+func addSubmissionWindowFlags(cmd *cobra.Command) {
+	cmd.Flags().StringArray("submissionWindow", []string{},
+		"Period during which jobs may be submitted, given as a cron expression of its start times, its duration, and optionally a time zone, separated by semicolons; may be repeated. "+
+			"Defaults to jobs being accepted at any time. Example: --submissionWindow \"0 18 * * 1-5;14h;Europe/London\"",
+	)
+	cmd.Flags().Bool("holdOutsideSubmissionWindows", false, "Hold jobs submitted outside of all submission windows until the next window starts instead of rejecting them.")
+}
+
+func submissionWindowsFromFlags(cmd *cobra.Command) (*api.SubmissionWindowPolicy, error) {
+	windowFlags, err := cmd.Flags().GetStringArray("submissionWindow")
+	if err != nil {
+		return nil, fmt.Errorf("error reading submissionWindow: %s", err)
+	}
+
+	hold, err := cmd.Flags().GetBool("holdOutsideSubmissionWindows")
+	if err != nil {
+		return nil, fmt.Errorf("error reading holdOutsideSubmissionWindows: %s", err)
+	}
+
+	policy := &api.SubmissionWindowPolicy{HoldOutsideWindows: hold}
+	for _, windowFlag := range windowFlags {
+		parts := strings.Split(windowFlag, ";")
+		if len(parts) != 2 && len(parts) != 3 {
+			return nil, fmt.Errorf("error reading submissionWindow %q: expected a cron expression, a duration, and optionally a time zone, separated by semicolons", windowFlag)
+		}
+		duration, err := time.ParseDuration(strings.TrimSpace(parts[1]))
+		if err != nil {
+			return nil, fmt.Errorf("error reading submissionWindow %q: %s", windowFlag, err)
+		}
+		if duration%time.Minute != 0 {
+			return nil, fmt.Errorf("error reading submissionWindow %q: duration must be a whole number of minutes", windowFlag)
+		}
+		window := &api.SubmissionWindow{
+			Start:           strings.TrimSpace(parts[0]),
+			DurationMinutes: uint32(duration / time.Minute),
+		}
+		if len(parts) == 3 {
+			window.TimeZone = strings.TrimSpace(parts[2])
+		}
+		policy.Windows = append(policy.Windows, window)
+	}
+	return policy, nil
+}
+
+func addResourceBudgetFlags(cmd *cobra.Command) {
+	cmd.Flags().StringArray("resourceBudget", []string{},
+		"Limit on the resource-hours used by jobs of the queue over a rolling window, given as a resource, a number of hours, a window, and optionally "+
+			"\"perOwner\" to apply it to each owner separately and \"deprioritize=<priority>\" to raise the priority of jobs instead of rejecting them once it's exhausted, "+
+			"separated by semicolons; may be repeated. Defaults to no budgets. Example: --resourceBudget \"nvidia.com/gpu;100;24h;perOwner\"",
+	)
+}
+
+func resourceBudgetsFromFlags(cmd *cobra.Command) ([]*api.ResourceBudget, error) {
+	budgetFlags, err := cmd.Flags().GetStringArray("resourceBudget")
+	if err != nil {
+		return nil, fmt.Errorf("error reading resourceBudget: %s", err)
+	}
+
+	var budgets []*api.ResourceBudget
+	for _, budgetFlag := range budgetFlags {
+		parts := strings.Split(budgetFlag, ";")
+		if len(parts) < 3 {
+			return nil, fmt.Errorf("error reading resourceBudget %q: expected a resource, a number of hours, a window, and optionally options, separated by semicolons", budgetFlag)
+		}
+		hours, err := strconv.ParseFloat(strings.TrimSpace(parts[1]), 64)
+		if err != nil {
+			return nil, fmt.Errorf("error reading resourceBudget %q: %s", budgetFlag, err)
+		}
+		window, err := time.ParseDuration(strings.TrimSpace(parts[2]))
+		if err != nil {
+			return nil, fmt.Errorf("error reading resourceBudget %q: %s", budgetFlag, err)
+		}
+		if window%time.Hour != 0 {
+			return nil, fmt.Errorf("error reading resourceBudget %q: window must be a whole number of hours", budgetFlag)
+		}
+		budget := &api.ResourceBudget{
+			Resource:    strings.TrimSpace(parts[0]),
+			Hours:       hours,
+			WindowHours: uint32(window / time.Hour),
+		}
+		for _, option := range parts[3:] {
+			option = strings.TrimSpace(option)
+			if option == "perOwner" {
+				budget.PerOwner = true
+			} else if priority, ok := strings.CutPrefix(option, "deprioritize="); ok {
+				budget.Action = api.ResourceBudget_DEPRIORITIZE
+				if budget.DeprioritizedPriority, err = strconv.ParseFloat(priority, 64); err != nil {
+					return nil, fmt.Errorf("error reading resourceBudget %q: %s", budgetFlag, err)
+				}
+			} else {
+				return nil, fmt.Errorf("error reading resourceBudget %q: unknown option %q", budgetFlag, option)
+			}
+		}
+		budgets = append(budgets, budget)
+	}
+	return budgets, nil
+}
+
 type flagGetStringToString func(string) (map[string]string, error)
 
 func (f flagGetStringToString) toFloat64(flagName string) (map[string]float64, error) {
@@ -221,3 +872,21 @@ func (f flagGetStringToString) toFloat64(flagName string) (map[string]float64, e
 
 	return result, nil
 }
+
+func (f flagGetStringToString) toQuantity(flagName string) (map[string]resource.Quantity, error) {
+	quantities, err := f(flagName)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]resource.Quantity, len(quantities))
+	for resourceName, quantity := range quantities {
+		q, err := resource.ParseQuantity(quantity)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s as quantity. %s", resourceName, err)
+		}
+		result[resourceName] = q
+	}
+
+	return result, nil
+}
@@ -63,7 +63,7 @@ func TestCreate(t *testing.T) {
 					permissions := []queue.Permissions{
 						{
 							Subjects: queue.NewPermissionSubjectsFromOwners(test.Owners, test.GroupOwners),
-							Verbs:    queue.AllPermissionVerbs(),
+							Verbs:    queue.OwnerPermissionVerbs(),
 						},
 					}
 
@@ -172,7 +172,7 @@ func TestUpdate(t *testing.T) {
 					permissions := []queue.Permissions{
 						{
 							Subjects: queue.NewPermissionSubjectsFromOwners(test.Owners, test.GroupOwners),
-							Verbs:    queue.AllPermissionVerbs(),
+							Verbs:    queue.OwnerPermissionVerbs(),
 						},
 					}
 
@@ -30,16 +30,28 @@ The location of this file can be passed in using --config argument or picked fro
 
 	cmd.AddCommand(
 		analyzeCmd(),
+		archiveCmd(),
 		cancelCmd(),
+		cordonCmd(),
 		createCmd(armadactl.New()),
 		deleteCmd(),
 		updateCmd(),
 		describeCmd(),
+		execCmd(),
+		exportCmd(),
 		getCmd(),
+		importCmd(),
 		kubeCmd(),
+		logsCmd(),
+		pauseCmd(),
+		portForwardCmd(),
+		preemptCmd(),
 		reprioritizeCmd(),
 		resourcesCmd(),
+		restoreCmd(),
+		resumeCmd(),
 		submitCmd(),
+		uncordonCmd(),
 		versionCmd(),
 		watchCmd(),
 		getSchedulingReportCmd(armadactl.New()),
@@ -0,0 +1,130 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/armadaproject/armada/internal/armadactl"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+func execCmd() *cobra.Command {
+	a := armadactl.New()
+	cmd := &cobra.Command{
+		Use:   "exec <jobId> -- <command> [args...]",
+		Short: "Runs a command in a running job.",
+		Long: `Runs a command in a container of a running job, relaying stdin, stdout and stderr, and exits with its exit code.
+With --tty, the command runs in a terminal, e.g., to open an interactive shell:
+
+$ armadactl exec <jobId> --queue <queue> --jobSet <jobSet> --tty -- sh`,
+		Args:         cobra.MinimumNArgs(2),
+		SilenceUsage: true,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initParams(cmd, a.Params)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			req, err := jobSessionOpenRequest(cmd, args[0])
+			if err != nil {
+				return err
+			}
+			exec := &api.JobExecOptions{Command: args[1:]}
+			if exec.Container, err = cmd.Flags().GetString("container"); err != nil {
+				return err
+			}
+			if exec.Tty, err = cmd.Flags().GetBool("tty"); err != nil {
+				return err
+			}
+			req.Session = &api.JobSessionOpenRequest_Exec{Exec: exec}
+			exitCode, err := a.ExecJob(req, os.Stdin, os.Stderr)
+			if err != nil {
+				return err
+			}
+			if exitCode != 0 {
+				os.Exit(int(exitCode))
+			}
+			return nil
+		},
+	}
+	addJobSessionFlags(cmd)
+	cmd.Flags().String("container", "", "Container to run the command in; required if the pod has more than one.")
+	cmd.Flags().BoolP("tty", "t", false, "Run the command in a terminal.")
+	return cmd
+}
+
+func portForwardCmd() *cobra.Command {
+	a := armadactl.New()
+	cmd := &cobra.Command{
+		Use:   "port-forward <jobId> <localPort>:<podPort>",
+		Short: "Forwards a local port to a port of a running job.",
+		Long: `Forwards connections to a local port to a port of a pod of a running job, until interrupted:
+
+$ armadactl port-forward <jobId> 8080:80 --queue <queue> --jobSet <jobSet>`,
+		Args:         cobra.ExactArgs(2),
+		SilenceUsage: true,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initParams(cmd, a.Params)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			localPort, podPort, err := parsePortMapping(args[1])
+			if err != nil {
+				return err
+			}
+			req, err := jobSessionOpenRequest(cmd, args[0])
+			if err != nil {
+				return err
+			}
+			req.Session = &api.JobSessionOpenRequest_PortForward{PortForward: &api.JobPortForwardOptions{Port: uint32(podPort)}}
+			return a.PortForwardJob(req, localPort)
+		},
+	}
+	addJobSessionFlags(cmd)
+	return cmd
+}
+
+func addJobSessionFlags(cmd *cobra.Command) {
+	cmd.Flags().String("queue", "", "Queue of the job.")
+	cmd.Flags().String("jobSet", "", "Job set of the job.")
+	cmd.Flags().Int32("podNumber", 0, "Pod of the job to open the session into.")
+	if err := cmd.MarkFlagRequired("queue"); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired("jobSet"); err != nil {
+		panic(err)
+	}
+}
+
+func jobSessionOpenRequest(cmd *cobra.Command, jobId string) (*api.JobSessionOpenRequest, error) {
+	req := &api.JobSessionOpenRequest{JobId: jobId}
+	var err error
+	if req.Queue, err = cmd.Flags().GetString("queue"); err != nil {
+		return nil, err
+	}
+	if req.JobSetId, err = cmd.Flags().GetString("jobSet"); err != nil {
+		return nil, err
+	}
+	if req.PodNumber, err = cmd.Flags().GetInt32("podNumber"); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// parsePortMapping parses ports of the form <localPort>:<podPort>, or <port> if both are the same.
+func parsePortMapping(mapping string) (uint16, uint16, error) {
+	local, remote, found := strings.Cut(mapping, ":")
+	if !found {
+		remote = local
+	}
+	localPort, err := strconv.ParseUint(local, 10, 16)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid local port %q", local)
+	}
+	podPort, err := strconv.ParseUint(remote, 10, 16)
+	if err != nil || podPort == 0 {
+		return 0, 0, fmt.Errorf("invalid pod port %q", remote)
+	}
+	return uint16(localPort), uint16(podPort), nil
+}
@@ -2,16 +2,19 @@ package cmd
 
 import (
 	"fmt"
+	"os"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/armadaproject/armada/internal/armadactl"
+	"github.com/armadaproject/armada/pkg/client"
 )
 
 func submitCmd() *cobra.Command {
 	a := armadactl.New()
 	cmd := &cobra.Command{
-		Use:   "submit ./path/to/jobs.yaml",
+		Use:   "submit ./path/to/jobs.yaml | ./path/to/directory | './path/to/*.yaml'",
 		Short: "Submit jobs to armada",
 		Long: `Submit jobs to armada from file.
 
@@ -22,7 +25,15 @@ jobs:
 	priority: 0
 	jobSetId: set1
 	podSpec:
-	... kubernetes pod spec ...`,
+	... kubernetes pod spec ...
+
+If given a directory, the .yaml, .yml and .json files it contains are submitted;
+if given a glob pattern, the files matching it are. Up to --parallelism files are
+submitted at a time, and a report of the files that failed is printed at the end.
+With --resume-file, the requests submitted are recorded in the given file, such
+that rerunning the command with the same --resume-file submits only the jobs that
+weren't submitted before. With --max-attempts, requests failing with transient
+errors are retried without submitting their jobs twice.`,
 		Args: cobra.ExactArgs(1),
 		PreRunE: func(cmd *cobra.Command, args []string) error {
 			return initParams(cmd, a.Params)
@@ -33,11 +44,50 @@ jobs:
 				return fmt.Errorf("error reading flag dry-run: %s", err)
 			}
 
+			parallelism, err := cmd.Flags().GetInt("parallelism")
+			if err != nil {
+				return fmt.Errorf("error reading parallelism: %s", err)
+			}
+			if parallelism < 1 {
+				return fmt.Errorf("parallelism must be at least 1, got %d", parallelism)
+			}
+
+			resumeFile, err := cmd.Flags().GetString("resume-file")
+			if err != nil {
+				return fmt.Errorf("error reading resume-file: %s", err)
+			}
+
+			maxAttempts, err := cmd.Flags().GetInt("max-attempts")
+			if err != nil {
+				return fmt.Errorf("error reading max-attempts: %s", err)
+			}
+			retryBackoff, err := cmd.Flags().GetDuration("retry-backoff")
+			if err != nil {
+				return fmt.Errorf("error reading retry-backoff: %s", err)
+			}
+			a.SubmitterOptions = []client.SubmitterOption{
+				client.WithMaxAttempts(maxAttempts),
+				client.WithBackoff(retryBackoff, 10*retryBackoff),
+			}
+
 			path := args[0]
 
-			return a.Submit(path, dryRun)
+			// Submitting a single file without a resume file behaves as it always has.
+			if info, err := os.Stat(path); err == nil && !info.IsDir() && resumeFile == "" {
+				return a.Submit(path, dryRun)
+			}
+
+			files, err := armadactl.ExpandSubmitPath(path)
+			if err != nil {
+				return err
+			}
+			return a.SubmitFiles(files, dryRun, parallelism, resumeFile)
 		},
 	}
 	cmd.Flags().Bool("dry-run", false, "Performs basic validation on the submitted file. Does no actual submission of jobs to the server.")
+	cmd.Flags().Int("parallelism", 4, "Maximum number of files submitted at a time when submitting a directory or glob pattern.")
+	cmd.Flags().String("resume-file", "", "File recording the requests submitted, such that rerunning the command with it skips them.")
+	cmd.Flags().Int("max-attempts", 1, "Number of attempts made at each request, including the first; requests failing with transient errors are retried.")
+	cmd.Flags().Duration("retry-backoff", time.Second, "Time waited before retrying a request, doubling with each retry up to 10 times this.")
 	return cmd
 }
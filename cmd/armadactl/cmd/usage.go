@@ -0,0 +1,57 @@
+package cmd
+
+import (
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/armadaproject/armada/internal/armadactl"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+func usageReportGetCmd() *cobra.Command {
+	a := armadactl.New()
+	cmd := &cobra.Command{
+		Use:   "usage-report",
+		Short: "Prints out the daily resource usage of queues and their owners.",
+		Long: `Prints out the resource-seconds used by the jobs of each owner of each queue per day, in UTC.
+Reports may be exported as CSV or Parquet for chargeback, e.g.:
+
+$ armadactl get usage-report --start 2023-01-01 --end 2023-01-31 --format parquet > usage.parquet`,
+		Args:         cobra.ExactArgs(0),
+		SilenceUsage: true,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			return initParams(cmd, a.Params)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			request := &api.UsageReportRequest{}
+			var err error
+			if request.StartDate, err = cmd.Flags().GetString("start"); err != nil {
+				return err
+			}
+			if request.EndDate, err = cmd.Flags().GetString("end"); err != nil {
+				return err
+			}
+			if request.Queue, err = cmd.Flags().GetString("queue"); err != nil {
+				return err
+			}
+			if request.Owner, err = cmd.Flags().GetString("owner"); err != nil {
+				return err
+			}
+			format, err := cmd.Flags().GetString("format")
+			if err != nil {
+				return err
+			}
+			if request.StartDate == "" {
+				request.StartDate = time.Now().UTC().Format("2006-01-02")
+			}
+			return a.GetUsageReport(request, format)
+		},
+	}
+	cmd.Flags().String("start", "", "First day of the report, as YYYY-MM-DD in UTC, defaults to today.")
+	cmd.Flags().String("end", "", "Last day of the report, as YYYY-MM-DD in UTC, defaults to the first day.")
+	cmd.Flags().String("queue", "", "Only report usage of this queue.")
+	cmd.Flags().String("owner", "", "Only report usage of jobs submitted by this owner.")
+	cmd.Flags().String("format", armadactl.UsageReportFormatTable, "Format of the report: table, csv, or parquet.")
+	return cmd
+}
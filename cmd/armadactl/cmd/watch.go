@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -13,8 +14,11 @@ func watchCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "watch <queue> <jobSet>",
 		Short: "Watch job events in job set.",
-		Long:  "Listens for and prints events associated with a particular queue and jobset.",
-		Args:  cobra.ExactArgs(2),
+		Long: `Listens for and prints events associated with a particular queue and jobset.
+
+With --progress, prints the number of jobs of the job set in each state, why jobs failed, and when the jobs are
+expected to finish instead, redrawn as events arrive. With --json, the progress is printed as a JSON object per line.`,
+		Args: cobra.ExactArgs(2),
 		PreRunE: func(cmd *cobra.Command, args []string) error {
 			return initParams(cmd, a.Params)
 		},
@@ -46,6 +50,30 @@ func watchCmd() *cobra.Command {
 				return fmt.Errorf("force-new-events and force-legacy-events are exclusive")
 			}
 
+			progress, err := cmd.Flags().GetBool("progress")
+			if err != nil {
+				return fmt.Errorf("error reading progress: %s", err)
+			}
+
+			jsonOutput, err := cmd.Flags().GetBool("json")
+			if err != nil {
+				return fmt.Errorf("error reading json: %s", err)
+			}
+
+			interval, err := cmd.Flags().GetDuration("interval")
+			if err != nil {
+				return fmt.Errorf("error reading interval: %s", err)
+			}
+
+			if progress || jsonOutput {
+				if raw {
+					return fmt.Errorf("raw is exclusive with progress and json")
+				}
+				if interval <= 0 {
+					return fmt.Errorf("interval must be positive")
+				}
+				return a.WatchProgress(queue, jobSetId, jsonOutput, interval, exitOnInactive, forceNewEvents, forceLegacyEvents)
+			}
 			return a.Watch(queue, jobSetId, raw, exitOnInactive, forceNewEvents, forceLegacyEvents)
 		},
 	}
@@ -53,5 +81,8 @@ func watchCmd() *cobra.Command {
 	cmd.Flags().Bool("exit-if-inactive", false, "Exit if there are no more active jobs")
 	cmd.Flags().Bool("force-new-events", false, "Debug Option to tell Armada server to serve events from the new redis repository")
 	cmd.Flags().Bool("force-legacy-events", false, "Debug Option to tell Armada server to serve events from the old redis repository")
+	cmd.Flags().Bool("progress", false, "Print the progress of the job set rather than its events")
+	cmd.Flags().Bool("json", false, "Print the progress of the job set as a JSON object per line; implies --progress")
+	cmd.Flags().Duration("interval", time.Second, "Minimum interval between updates of the progress of the job set")
 	return cmd
 }
@@ -17,12 +17,16 @@ func TestWatch(t *testing.T) {
 		exit_if_inactive    bool
 		force_new_events    bool
 		force_legacy_events bool
+		progress            bool
+		json                bool
 	}{
-		"default flags":             {nil, false, false, false, false},
-		"valid raw":                 {[]flag{{"raw", "true"}}, true, false, false, false},
-		"valid exit-if-inactive":    {[]flag{{"exit-if-inactive", "true"}}, false, true, false, false},
-		"valid force-new-events":    {[]flag{{"force-new-events", "true"}}, false, false, true, false},
-		"valid force-legacy-events": {[]flag{{"force-legacy-events", "true"}}, false, false, false, true},
+		"default flags":             {nil, false, false, false, false, false, false},
+		"valid raw":                 {[]flag{{"raw", "true"}}, true, false, false, false, false, false},
+		"valid exit-if-inactive":    {[]flag{{"exit-if-inactive", "true"}}, false, true, false, false, false, false},
+		"valid force-new-events":    {[]flag{{"force-new-events", "true"}}, false, false, true, false, false, false},
+		"valid force-legacy-events": {[]flag{{"force-legacy-events", "true"}}, false, false, false, true, false, false},
+		"valid progress":            {[]flag{{"progress", "true"}}, false, false, false, false, true, false},
+		"valid json":                {[]flag{{"json", "true"}}, false, false, false, false, false, true},
 	}
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -53,6 +57,16 @@ func TestWatch(t *testing.T) {
 					require.NoError(t, err)
 					require.Equal(t, test.raw, forceLegacyEventsFlag)
 				}
+				if test.progress {
+					progressFlag, err := cmd.Flags().GetBool("progress")
+					require.NoError(t, err)
+					require.Equal(t, test.progress, progressFlag)
+				}
+				if test.json {
+					jsonFlag, err := cmd.Flags().GetBool("json")
+					require.NoError(t, err)
+					require.Equal(t, test.json, jsonFlag)
+				}
 				return nil
 			}
 			cmd.SetArgs([]string{"arbitrary", "jobSetId1"})
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"os"
+	"os/signal"
+	"syscall"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+
+	"github.com/armadaproject/armada/internal/common"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/jobsetoperator"
+	"github.com/armadaproject/armada/internal/jobsetoperator/configuration"
+)
+
+const CustomConfigLocation string = "config"
+
+func init() {
+	pflag.StringSlice(
+		CustomConfigLocation,
+		[]string{},
+		"Fully qualified path to application configuration file (for multiple config files repeat this arg or separate paths with commas)",
+	)
+	pflag.Parse()
+}
+
+func main() {
+	common.ConfigureLogging()
+	common.BindCommandlineArguments()
+
+	var config configuration.JobSetOperatorConfig
+	userSpecifiedConfigs := viper.GetStringSlice(CustomConfigLocation)
+	common.LoadConfig(&config, "./config/jobsetoperator", userSpecifiedConfigs)
+
+	log.Info("Starting...")
+
+	shutdownMetricServer := common.ServeMetrics(config.MetricsPort)
+	defer shutdownMetricServer()
+
+	ctx, cancel := armadacontext.WithCancel(armadacontext.Background())
+	stopSignal := make(chan os.Signal, 1)
+	signal.Notify(stopSignal, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		<-stopSignal
+		cancel()
+	}()
+
+	if err := jobsetoperator.Run(ctx, &config); err != nil {
+		log.Errorf("JobSet operator failed: %s", err)
+		os.Exit(-1)
+	}
+}
@@ -0,0 +1,34 @@
+{{- define "jobset-operator.name" -}}
+{{- default .Chart.Name .Values.nameOverride | trunc 63 | trimSuffix "-" -}}
+{{- end -}}
+
+{{- define "jobset-operator.config.name" -}}
+{{- printf "%s-%s" ( include "jobset-operator.name" .) "config" -}}
+{{- end }}
+
+{{- define "jobset-operator.config.filename" -}}
+{{- printf "%s%s" ( include "jobset-operator.config.name" .) ".yaml" -}}
+{{- end }}
+
+{{/*
+Create chart name and version as used by the chart label.
+*/}}
+{{- define "jobset-operator.chart" -}}
+{{- printf "%s-%s" .Chart.Name .Chart.Version | replace "+" "_" | trunc 63 | trimSuffix "-" -}}
+{{- end -}}
+
+{{- define "jobset-operator.labels.identity" -}}
+app: {{ include "jobset-operator.name" . }}
+{{- end -}}
+
+{{/*
+Common labels
+*/}}
+{{- define "jobset-operator.labels.all" -}}
+{{ include "jobset-operator.labels.identity" . }}
+chart: {{ include "jobset-operator.chart" . }}
+release: {{ .Release.Name }}
+{{- if .Values.additionalLabels }}
+{{ toYaml .Values.additionalLabels }}
+{{- end }}
+{{- end -}}
@@ -0,0 +1,227 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/exp/slices"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/armadaerrors"
+	"github.com/armadaproject/armada/internal/common/auth/authorization"
+	"github.com/armadaproject/armada/internal/common/requestid"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+// Decision is the outcome of a recorded call.
+type Decision string
+
+const (
+	// The call succeeded.
+	DecisionAllowed Decision = "allowed"
+	// The principal wasn't permitted to make the call.
+	DecisionDenied Decision = "denied"
+	// The call failed for another reason, e.g., invalid jobs were submitted; see Record.Code.
+	DecisionFailed Decision = "failed"
+)
+
+// Record of a single call of a mutating API method.
+type Record struct {
+	Time      time.Time `json:"time"`
+	Method    string    `json:"method"`
+	RequestId string    `json:"requestId,omitempty"`
+	Principal string    `json:"principal"`
+	Groups    []string  `json:"groups,omitempty"`
+	// Principal that made the call on behalf of Principal, if any.
+	Impersonator string `json:"impersonator,omitempty"`
+	// Summary of the request, e.g., the queue and job set jobs were submitted to and how many were submitted.
+	Request  json.RawMessage `json:"request"`
+	Decision Decision        `json:"decision"`
+	// gRPC status code of the call, and the error it failed with, if any.
+	Code    string        `json:"code"`
+	Error   string        `json:"error,omitempty"`
+	Latency time.Duration `json:"latencyNanoseconds"`
+}
+
+// Sink stores records. Records are never modified once written.
+type Sink interface {
+	Write(ctx *armadacontext.Context, record *Record) error
+	Close() error
+}
+
+// UnaryServerInterceptor returns an interceptor that writes a record of each call of methods to sink.
+// It must come after the authentication interceptor, such that the principal making the call is known.
+// Failing to write a record is logged rather than failing the call, since the call has already taken effect.
+func UnaryServerInterceptor(sink Sink, methods []string) grpc.UnaryServerInterceptor {
+	recordedMethods := toSet(methods)
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		markAuthenticated(ctx)
+		if !recordedMethods[info.FullMethod] {
+			return handler(ctx, req)
+		}
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		write(ctx, sink, newRecord(ctx, info.FullMethod, req, err, start))
+		return resp, err
+	}
+}
+
+// StreamServerInterceptor is UnaryServerInterceptor for streaming calls, e.g., of job sessions.
+// The record is written once the call ends; its request is the first message the client sent, if any.
+func StreamServerInterceptor(sink Sink, methods []string) grpc.StreamServerInterceptor {
+	recordedMethods := toSet(methods)
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		markAuthenticated(stream.Context())
+		if !recordedMethods[info.FullMethod] {
+			return handler(srv, stream)
+		}
+		start := time.Now()
+		recordingStream := &firstMessageRecordingStream{ServerStream: stream}
+		err := handler(srv, recordingStream)
+		write(stream.Context(), sink, newRecord(stream.Context(), info.FullMethod, recordingStream.firstMessage, err, start))
+		return err
+	}
+}
+
+// UnauthenticatedUnaryServerInterceptor returns an interceptor that writes a record of each call of any method
+// rejected before reaching UnaryServerInterceptor for failing to authenticate or being denied, e.g., impersonating
+// another user. It must come before the authentication interceptor; the principal of such records is anonymous.
+func UnauthenticatedUnaryServerInterceptor(sink Sink) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, authenticated := withAuthenticatedMarker(ctx)
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		if !*authenticated && isDenial(err) {
+			write(ctx, sink, newRecord(ctx, info.FullMethod, req, err, start))
+		}
+		return resp, err
+	}
+}
+
+// UnauthenticatedStreamServerInterceptor is UnauthenticatedUnaryServerInterceptor for streaming calls.
+func UnauthenticatedStreamServerInterceptor(sink Sink) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, authenticated := withAuthenticatedMarker(stream.Context())
+		start := time.Now()
+		err := handler(srv, &contextStream{ServerStream: stream, ctx: ctx})
+		if !*authenticated && isDenial(err) {
+			write(ctx, sink, newRecord(ctx, info.FullMethod, nil, err, start))
+		}
+		return err
+	}
+}
+
+type authenticatedMarkerKey struct{}
+
+// withAuthenticatedMarker returns a context carrying a marker that the interceptors recording authenticated calls set,
+// such that calls rejected before reaching them can be told apart from those they recorded.
+func withAuthenticatedMarker(ctx context.Context) (context.Context, *bool) {
+	authenticated := new(bool)
+	return context.WithValue(ctx, authenticatedMarkerKey{}, authenticated), authenticated
+}
+
+func markAuthenticated(ctx context.Context) {
+	if authenticated, ok := ctx.Value(authenticatedMarkerKey{}).(*bool); ok {
+		*authenticated = true
+	}
+}
+
+func isDenial(err error) bool {
+	code := armadaerrors.CodeFromError(errors.Cause(err))
+	return code == codes.Unauthenticated || code == codes.PermissionDenied
+}
+
+func write(ctx context.Context, sink Sink, record *Record) {
+	armadaCtx := armadacontext.FromGrpcCtx(ctx)
+	if err := sink.Write(armadaCtx, record); err != nil {
+		armadaCtx.WithError(err).Errorf("failed to write audit record of call of %s", record.Method)
+	}
+}
+
+func toSet(methods []string) map[string]bool {
+	set := make(map[string]bool, len(methods))
+	for _, method := range methods {
+		set[method] = true
+	}
+	return set
+}
+
+// firstMessageRecordingStream keeps the first message received from the client.
+type firstMessageRecordingStream struct {
+	grpc.ServerStream
+	firstMessage interface{}
+}
+
+func (s *firstMessageRecordingStream) RecvMsg(m interface{}) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err == nil && s.firstMessage == nil {
+		s.firstMessage = m
+	}
+	return err
+}
+
+// contextStream replaces the context of a stream.
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextStream) Context() context.Context {
+	return s.ctx
+}
+
+func newRecord(ctx context.Context, method string, req interface{}, err error, start time.Time) *Record {
+	principal := authorization.GetPrincipal(ctx)
+	record := &Record{
+		Time:      start.UTC(),
+		Method:    method,
+		Principal: principal.GetName(),
+		Groups:    principal.GetGroupNames(),
+		Decision:  DecisionAllowed,
+		Code:      codes.OK.String(),
+		Latency:   time.Since(start),
+	}
+	slices.Sort(record.Groups)
+	if impersonator, ok := authorization.GetImpersonator(ctx); ok {
+		record.Impersonator = impersonator.GetName()
+	}
+	if id, ok := requestid.FromContext(ctx); ok {
+		record.RequestId = id
+	}
+	if request, marshalErr := json.Marshal(summarise(req)); marshalErr == nil {
+		record.Request = request
+	} else {
+		record.Request, _ = json.Marshal(map[string]string{"error": marshalErr.Error()})
+	}
+	if err != nil {
+		code := armadaerrors.CodeFromError(errors.Cause(err))
+		record.Code = code.String()
+		record.Error = err.Error()
+		var permErr *armadaerrors.ErrUnauthorized
+		if code == codes.PermissionDenied || code == codes.Unauthenticated || errors.As(err, &permErr) {
+			record.Decision = DecisionDenied
+		} else {
+			record.Decision = DecisionFailed
+		}
+	}
+	return record
+}
+
+// summarise returns what's recorded of req. Requests are recorded as they are, except for those carrying jobs,
+// whose pod specs would make records large without telling who did what.
+func summarise(req interface{}) interface{} {
+	switch req := req.(type) {
+	case *api.JobSubmitRequest:
+		return map[string]interface{}{
+			"queue":           req.Queue,
+			"jobSetId":        req.JobSetId,
+			"jobRequestItems": len(req.JobRequestItems),
+		}
+	default:
+		return req
+	}
+}
@@ -0,0 +1,228 @@
+package audit
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/auth/authorization"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+type testSink struct {
+	records []*Record
+}
+
+func (s *testSink) Write(_ *armadacontext.Context, record *Record) error {
+	s.records = append(s.records, record)
+	return nil
+}
+
+func (s *testSink) Close() error {
+	return nil
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	sink := &testSink{}
+	interceptor := UnaryServerInterceptor(sink, []string{"/api.Submit/SubmitJobs", "/api.Submit/CreateQueue"})
+	ctx := authorization.WithPrincipal(context.Background(), authorization.NewStaticPrincipal("alice", []string{"team"}))
+
+	submitRequest := &api.JobSubmitRequest{
+		Queue:           "queue",
+		JobSetId:        "job-set",
+		JobRequestItems: []*api.JobSubmitRequestItem{{}, {}},
+	}
+	_, err := interceptor(ctx, submitRequest, &grpc.UnaryServerInfo{FullMethod: "/api.Submit/SubmitJobs"}, okHandler)
+	require.NoError(t, err)
+	_, err = interceptor(ctx, &api.Queue{Name: "queue"}, &grpc.UnaryServerInfo{FullMethod: "/api.Submit/CreateQueue"},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, status.Error(codes.PermissionDenied, "alice lacks permission create_queue")
+		},
+	)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = interceptor(ctx, &api.QueueGetRequest{Name: "queue"}, &grpc.UnaryServerInfo{FullMethod: "/api.Submit/GetQueue"}, okHandler)
+	require.NoError(t, err)
+
+	require.Len(t, sink.records, 2)
+	submitRecord := sink.records[0]
+	assert.Equal(t, "/api.Submit/SubmitJobs", submitRecord.Method)
+	assert.Equal(t, "alice", submitRecord.Principal)
+	assert.ElementsMatch(t, []string{"team", authorization.EveryoneGroup}, submitRecord.Groups)
+	assert.Equal(t, DecisionAllowed, submitRecord.Decision)
+	assert.Equal(t, "OK", submitRecord.Code)
+	assert.JSONEq(t, `{"queue": "queue", "jobSetId": "job-set", "jobRequestItems": 2}`, string(submitRecord.Request))
+
+	createQueueRecord := sink.records[1]
+	assert.Equal(t, DecisionDenied, createQueueRecord.Decision)
+	assert.Equal(t, "PermissionDenied", createQueueRecord.Code)
+	assert.Contains(t, createQueueRecord.Error, "lacks permission")
+	var queue api.Queue
+	require.NoError(t, json.Unmarshal(createQueueRecord.Request, &queue))
+	assert.Equal(t, "queue", queue.Name)
+}
+
+func TestUnaryServerInterceptor_RecordsImpersonator(t *testing.T) {
+	sink := &testSink{}
+	interceptor := UnaryServerInterceptor(sink, []string{"/api.Submit/SubmitJobs"})
+	engine := authorization.NewStaticPrincipal("engine", []string{"workflow-engines"})
+	ctx := authorization.WithPrincipal(context.Background(), authorization.NewImpersonatedPrincipal("alice", nil, engine))
+
+	_, err := interceptor(ctx, &api.JobSubmitRequest{Queue: "queue"}, &grpc.UnaryServerInfo{FullMethod: "/api.Submit/SubmitJobs"}, okHandler)
+	require.NoError(t, err)
+	require.Len(t, sink.records, 1)
+	assert.Equal(t, "alice", sink.records[0].Principal)
+	assert.Equal(t, "engine", sink.records[0].Impersonator)
+}
+
+func TestStreamServerInterceptor(t *testing.T) {
+	sink := &testSink{}
+	interceptor := StreamServerInterceptor(sink, []string{"/api.JobSessions/OpenJobSession"})
+	ctx := authorization.WithPrincipal(context.Background(), authorization.NewStaticPrincipal("alice", nil))
+	stream := &testStream{ctx: ctx, messages: []*api.JobSessionOpenRequest{{JobId: "job"}, {JobId: "other"}}}
+
+	err := interceptor(nil, stream, &grpc.StreamServerInfo{FullMethod: "/api.JobSessions/OpenJobSession"},
+		func(srv interface{}, stream grpc.ServerStream) error {
+			for i := 0; i < 2; i++ {
+				require.NoError(t, stream.RecvMsg(&api.JobSessionOpenRequest{}))
+			}
+			return nil
+		},
+	)
+	require.NoError(t, err)
+	require.Len(t, sink.records, 1)
+	assert.Equal(t, "alice", sink.records[0].Principal)
+	assert.Equal(t, DecisionAllowed, sink.records[0].Decision)
+	var request api.JobSessionOpenRequest
+	require.NoError(t, json.Unmarshal(sink.records[0].Request, &request))
+	assert.Equal(t, "job", request.JobId)
+}
+
+func TestUnauthenticatedUnaryServerInterceptor(t *testing.T) {
+	sink := &testSink{}
+	unauthenticatedInterceptor := UnauthenticatedUnaryServerInterceptor(sink)
+	interceptor := UnaryServerInterceptor(sink, []string{"/api.Submit/CreateQueue"})
+	info := &grpc.UnaryServerInfo{FullMethod: "/api.Submit/CreateQueue"}
+	denied := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, status.Error(codes.PermissionDenied, "alice lacks permission create_queue")
+	}
+
+	// Calls failing to authenticate are recorded, even of methods that aren't recorded otherwise.
+	_, err := unauthenticatedInterceptor(context.Background(), &api.QueueGetRequest{Name: "queue"}, &grpc.UnaryServerInfo{FullMethod: "/api.Submit/GetQueue"},
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, status.Error(codes.Unauthenticated, "invalid token")
+		},
+	)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	require.Len(t, sink.records, 1)
+	assert.Equal(t, "/api.Submit/GetQueue", sink.records[0].Method)
+	assert.Equal(t, "anonymous", sink.records[0].Principal)
+	assert.Equal(t, DecisionDenied, sink.records[0].Decision)
+	assert.Equal(t, "Unauthenticated", sink.records[0].Code)
+
+	// Calls denied after authentication are recorded once.
+	_, err = unauthenticatedInterceptor(context.Background(), &api.Queue{Name: "queue"}, info,
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			ctx = authorization.WithPrincipal(ctx, authorization.NewStaticPrincipal("alice", nil))
+			return interceptor(ctx, req, info, denied)
+		},
+	)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	require.Len(t, sink.records, 2)
+	assert.Equal(t, "alice", sink.records[1].Principal)
+
+	// Other errors before authentication, e.g., exceeding concurrency limits, aren't recorded.
+	_, err = unauthenticatedInterceptor(context.Background(), &api.Queue{Name: "queue"}, info,
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return nil, status.Error(codes.ResourceExhausted, "too many calls")
+		},
+	)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Len(t, sink.records, 2)
+}
+
+func TestFileSink(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	for i := 0; i < 2; i++ {
+		// Reopening the file appends to it.
+		sink, err := NewFileSink(path)
+		require.NoError(t, err)
+		require.NoError(t, sink.Write(armadacontext.Background(), &Record{
+			Method:   "/api.Submit/CancelJobs",
+			Request:  json.RawMessage(`{"jobId": "a"}`),
+			Decision: DecisionAllowed,
+		}))
+		require.NoError(t, sink.Close())
+	}
+
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	defer file.Close()
+	var records []*Record
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var record Record
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &record))
+		records = append(records, &record)
+	}
+	require.Len(t, records, 2)
+	assert.Equal(t, "/api.Submit/CancelJobs", records[1].Method)
+	assert.JSONEq(t, `{"jobId": "a"}`, string(records[1].Request))
+}
+
+func TestPostgresSink(t *testing.T) {
+	err := WithTestDb(func(db *pgxpool.Pool) error {
+		ctx := armadacontext.Background()
+		sink := NewPostgresSink(db)
+		require.NoError(t, sink.Write(ctx, &Record{
+			Method:       "/api.Submit/CancelJobs",
+			Principal:    "alice",
+			Impersonator: "engine",
+			Request:      json.RawMessage(`{"jobId": "a"}`),
+			Decision:     DecisionAllowed,
+			Code:         "OK",
+		}))
+
+		var principal, impersonator, jobId string
+		require.NoError(t, db.QueryRow(ctx, "SELECT principal, impersonator, request->>'jobId' FROM audit_records").Scan(&principal, &impersonator, &jobId))
+		assert.Equal(t, "alice", principal)
+		assert.Equal(t, "engine", impersonator)
+		assert.Equal(t, "a", jobId)
+
+		// Records can't be modified.
+		_, err := db.Exec(ctx, "DELETE FROM audit_records")
+		assert.Error(t, err)
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+type testStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	messages []*api.JobSessionOpenRequest
+}
+
+func (s *testStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *testStream) RecvMsg(m interface{}) error {
+	*m.(*api.JobSessionOpenRequest) = *s.messages[0]
+	s.messages = s.messages[1:]
+	return nil
+}
+
+func okHandler(ctx context.Context, req interface{}) (interface{}, error) {
+	return nil, nil
+}
@@ -0,0 +1,45 @@
+package audit
+
+import (
+	"encoding/json"
+	"os"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+)
+
+// FileSink appends records to a file as lines of JSON. The file is only ever appended to,
+// such that it can be shipped by log collectors and rotated by renaming it and restarting the server.
+type FileSink struct {
+	file *os.File
+	// Serializes writes, such that lines of concurrent calls aren't interleaved.
+	mu sync.Mutex
+}
+
+func NewFileSink(path string) (*FileSink, error) {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error opening audit log %s", path)
+	}
+	return &FileSink{file: file}, nil
+}
+
+func (s *FileSink) Write(_ *armadacontext.Context, record *Record) error {
+	line, err := json.Marshal(record)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	line = append(line, '\n')
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, err := s.file.Write(line); err != nil {
+		return errors.WithStack(err)
+	}
+	return nil
+}
+
+func (s *FileSink) Close() error {
+	return errors.WithStack(s.file.Close())
+}
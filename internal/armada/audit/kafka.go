@@ -0,0 +1,52 @@
+package audit
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/segmentio/kafka-go"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+)
+
+// KafkaSink publishes records to a Kafka topic as JSON, keyed by principal, such that the records of each principal
+// are kept in order. Records are acknowledged once written to all in-sync replicas.
+type KafkaSink struct {
+	writer *kafka.Writer
+}
+
+// NewKafkaSink returns a sink publishing to topic on the Kafka cluster of brokers.
+// Writes time out after writeTimeout. If useTls is true, brokers are connected to via TLS.
+func NewKafkaSink(brokers []string, topic string, writeTimeout time.Duration, useTls bool) *KafkaSink {
+	transport := &kafka.Transport{}
+	if useTls {
+		transport.TLS = &tls.Config{MinVersion: tls.VersionTLS12}
+	}
+	return &KafkaSink{
+		writer: &kafka.Writer{
+			Addr:         kafka.TCP(brokers...),
+			Topic:        topic,
+			Balancer:     &kafka.Hash{},
+			RequiredAcks: kafka.RequireAll,
+			WriteTimeout: writeTimeout,
+			// Writes are synchronous, so they needn't wait for more records to batch them with.
+			BatchTimeout: time.Millisecond,
+			Transport:    transport,
+		},
+	}
+}
+
+func (s *KafkaSink) Write(ctx *armadacontext.Context, record *Record) error {
+	value, err := json.Marshal(record)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	return errors.WithStack(s.writer.WriteMessages(ctx, kafka.Message{Key: []byte(record.Principal), Value: value}))
+}
+
+// Close flushes pending writes and closes the connections of the sink.
+func (s *KafkaSink) Close() error {
+	return errors.WithStack(s.writer.Close())
+}
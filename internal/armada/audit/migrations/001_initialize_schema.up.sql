@@ -0,0 +1,27 @@
+CREATE TABLE audit_records (
+    id bigserial PRIMARY KEY,
+    time timestamptz NOT NULL,
+    method text NOT NULL,
+    request_id text NOT NULL,
+    principal text NOT NULL,
+    groups text[] NOT NULL,
+    request jsonb NOT NULL,
+    decision text NOT NULL,
+    code text NOT NULL,
+    error text NOT NULL,
+    latency_ns bigint NOT NULL
+);
+
+CREATE INDEX idx_audit_records_principal_time ON audit_records (principal, time);
+CREATE INDEX idx_audit_records_time ON audit_records (time);
+
+-- Records are immutable; they may only be inserted.
+CREATE FUNCTION reject_audit_record_modification() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'audit records can''t be modified';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER audit_records_immutable
+    BEFORE UPDATE OR DELETE OR TRUNCATE ON audit_records
+    FOR EACH STATEMENT EXECUTE FUNCTION reject_audit_record_modification();
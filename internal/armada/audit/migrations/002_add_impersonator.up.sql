@@ -0,0 +1,2 @@
+-- Principal that made the call on behalf of the recorded principal, if any.
+ALTER TABLE audit_records ADD COLUMN impersonator text NOT NULL DEFAULT '';
@@ -0,0 +1,66 @@
+package audit
+
+import (
+	"embed"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/database"
+)
+
+//go:embed migrations/*.sql
+var fs embed.FS
+
+// PostgresSink inserts records into postgres. The schema rejects updating or deleting records.
+type PostgresSink struct {
+	db *pgxpool.Pool
+}
+
+func NewPostgresSink(db *pgxpool.Pool) *PostgresSink {
+	return &PostgresSink{db: db}
+}
+
+func (s *PostgresSink) Write(ctx *armadacontext.Context, record *Record) error {
+	groups := record.Groups
+	if groups == nil {
+		groups = []string{}
+	}
+	_, err := s.db.Exec(
+		ctx,
+		`INSERT INTO audit_records (time, method, request_id, principal, groups, impersonator, request, decision, code, error, latency_ns)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)`,
+		record.Time, record.Method, record.RequestId, record.Principal, groups, record.Impersonator,
+		string(record.Request), string(record.Decision), record.Code, record.Error, record.Latency.Nanoseconds(),
+	)
+	return errors.WithStack(err)
+}
+
+func (s *PostgresSink) Close() error {
+	s.db.Close()
+	return nil
+}
+
+func Migrate(ctx *armadacontext.Context, db database.Querier) error {
+	start := time.Now()
+	migrations, err := database.ReadMigrations(fs, "migrations")
+	if err != nil {
+		return err
+	}
+	err = database.UpdateDatabase(ctx, db, migrations)
+	if err != nil {
+		return err
+	}
+	ctx.Infof("Updated audit database in %s", time.Now().Sub(start))
+	return nil
+}
+
+func WithTestDb(action func(db *pgxpool.Pool) error) error {
+	migrations, err := database.ReadMigrations(fs, "migrations")
+	if err != nil {
+		return err
+	}
+	return database.WithTestDb(migrations, action)
+}
@@ -0,0 +1,112 @@
+package armada
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/armadaproject/armada/internal/armada/benchmark"
+	"github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/internal/armada/repository"
+	"github.com/armadaproject/armada/internal/armada/server"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/auth/authorization"
+	"github.com/armadaproject/armada/internal/common/auth/permission"
+	armadaresource "github.com/armadaproject/armada/internal/common/resource"
+	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/client/queue"
+)
+
+// Id of the synthetic cluster reported by submit benchmarks that seed one.
+const benchmarkClusterId = "armada-benchmark"
+
+// RunSubmitBenchmark runs benchmark.RunSubmitBenchmark against a submit server backed by the repositories configured
+// in config, creating the benchmark queue if necessary. Events are discarded rather than published, and requests skip
+// authorization. If seedCluster is set, a synthetic cluster large enough for the benchmark jobs is reported first,
+// such that the benchmark can run against a database no executor reports to.
+// Jobs submitted by the benchmark are left in the database, so it should be run against a dedicated one.
+func RunSubmitBenchmark(
+	ctx *armadacontext.Context,
+	config *configuration.ArmadaConfig,
+	benchmarkConfig benchmark.Config,
+	seedCluster bool,
+) (*benchmark.Report, error) {
+	db := createRedisClient(&config.Redis)
+	defer func() {
+		if err := db.Close(); err != nil {
+			log.WithError(err).Error("failed to close Redis client")
+		}
+	}()
+	jobRepository, closeJobRepository, err := createJobRepository(ctx, config.JobRepository, config.PodSpecStorage, db)
+	if err != nil {
+		return nil, err
+	}
+	defer closeJobRepository()
+	queueRepository, closeQueueRepository, err := createQueueRepository(ctx, config.QueueRepository, db)
+	if err != nil {
+		return nil, err
+	}
+	defer closeQueueRepository()
+	schedulingInfoRepository := repository.NewRedisSchedulingInfoRepository(db)
+
+	err = queueRepository.CreateQueue(queue.Queue{Name: benchmarkConfig.Queue, PriorityFactor: 1})
+	var alreadyExists *repository.ErrQueueAlreadyExists
+	if err != nil && !errors.As(err, &alreadyExists) {
+		return nil, errors.WithMessagef(err, "error creating benchmark queue %s", benchmarkConfig.Queue)
+	}
+	if seedCluster {
+		err := schedulingInfoRepository.UpdateClusterSchedulingInfo(&api.ClusterSchedulingInfoReport{
+			ClusterId:  benchmarkClusterId,
+			ReportTime: time.Now(),
+			NodeTypes: []*api.NodeType{{
+				AllocatableResources: armadaresource.ComputeResources{
+					"cpu":               resource.MustParse("1000"),
+					"memory":            resource.MustParse("1000Ti"),
+					"ephemeral-storage": resource.MustParse("1000Ti"),
+				},
+			}},
+		})
+		if err != nil {
+			return nil, errors.WithMessage(err, "error reporting benchmark cluster")
+		}
+	}
+
+	submitServer := server.NewSubmitServer(
+		allowAllAuthorizer{},
+		jobRepository,
+		queueRepository,
+		discardingEventStore{},
+		schedulingInfoRepository,
+		repository.NewRedisBarrierRepository(db),
+		repository.NewRedisOperationRepository(db),
+		repository.NewRedisSubmitFailureReportRepository(db),
+		repository.NewRedisOwnershipGroupsRepository(db),
+		config.CancelJobsBatchSize,
+		config.CancelJobsParallelism,
+		&config.QueueManagement,
+		&config.Scheduling,
+		&config.SubmitFailures,
+		&config.Compression,
+	)
+	principal := authorization.NewStaticPrincipal("armada-benchmark", []string{})
+	ctx = &armadacontext.Context{Context: authorization.WithPrincipal(ctx, principal), FieldLogger: ctx.FieldLogger}
+	return benchmark.RunSubmitBenchmark(ctx, submitServer, benchmarkConfig)
+}
+
+type allowAllAuthorizer struct{}
+
+func (allowAllAuthorizer) AuthorizeAction(*armadacontext.Context, permission.Permission) error {
+	return nil
+}
+
+func (allowAllAuthorizer) AuthorizeQueueAction(*armadacontext.Context, queue.Queue, permission.Permission, queue.PermissionVerb) error {
+	return nil
+}
+
+type discardingEventStore struct{}
+
+func (discardingEventStore) ReportEvents(*armadacontext.Context, []*api.EventMessage) error {
+	return nil
+}
@@ -0,0 +1,90 @@
+package benchmark
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+func (report *Report) String() string {
+	return fmt.Sprintf(
+		"submit: %s\ncancel: %s\nallocated per job: %.0f bytes in %.1f objects",
+		report.Submit, report.Cancel, report.BytesAllocatedPerJob, report.AllocationsPerJob,
+	)
+}
+
+func (report OperationReport) String() string {
+	s := fmt.Sprintf(
+		"%d requests (%d failed), %.1f jobs/s, latency p50 %s, p90 %s, p99 %s, max %s",
+		report.Requests, report.Errors, report.JobsPerSecond, report.LatencyP50, report.LatencyP90, report.LatencyP99, report.LatencyMax,
+	)
+	if report.FirstError != "" {
+		s += "; first error: " + report.FirstError
+	}
+	return s
+}
+
+func WriteReport(report *Report, path string) error {
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	return errors.WithStack(os.WriteFile(path, data, 0o644))
+}
+
+func ReadReport(path string) (*Report, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	report := &Report{}
+	if err := json.Unmarshal(data, report); err != nil {
+		return nil, errors.Wrapf(err, "error parsing benchmark report %s", path)
+	}
+	return report, nil
+}
+
+// Regressions returns a description of each way in which report performs worse than baseline by more than tolerance,
+// i.e., a fraction of the baseline. Throughput, p99 latency, error rates, and allocations per job are compared.
+func Regressions(baseline *Report, report *Report, tolerance float64) []string {
+	var regressions []string
+	lower := func(name string, baselineValue, value float64) {
+		if value < baselineValue*(1-tolerance) {
+			regressions = append(regressions, fmt.Sprintf("%s dropped from %.4g to %.4g", name, baselineValue, value))
+		}
+	}
+	higher := func(name string, baselineValue, value float64) {
+		if value > baselineValue*(1+tolerance) {
+			regressions = append(regressions, fmt.Sprintf("%s rose from %.4g to %.4g", name, baselineValue, value))
+		}
+	}
+	for _, op := range []struct {
+		name     string
+		baseline OperationReport
+		report   OperationReport
+	}{
+		{"submit", baseline.Submit, report.Submit},
+		{"cancel", baseline.Cancel, report.Cancel},
+	} {
+		lower(op.name+" jobs/s", op.baseline.JobsPerSecond, op.report.JobsPerSecond)
+		higher(op.name+" p99 latency (ms)", ms(op.baseline.LatencyP99), ms(op.report.LatencyP99))
+		higher(op.name+" error rate", errorRate(op.baseline), errorRate(op.report))
+	}
+	higher("bytes allocated per job", baseline.BytesAllocatedPerJob, report.BytesAllocatedPerJob)
+	higher("allocations per job", baseline.AllocationsPerJob, report.AllocationsPerJob)
+	return regressions
+}
+
+func ms(d time.Duration) float64 {
+	return d.Seconds() * 1000
+}
+
+func errorRate(report OperationReport) float64 {
+	if report.Requests == 0 {
+		return 0
+	}
+	return float64(report.Errors) / float64(report.Requests)
+}
@@ -0,0 +1,219 @@
+package benchmark
+
+import (
+	"runtime"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/time/rate"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/profiling"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+// Config controls the load a submit benchmark drives.
+type Config struct {
+	Queue    string
+	JobSetId string
+	// Number of SubmitJobs requests per second.
+	SubmitRate     float64
+	JobsPerRequest int
+	// Fraction of submitted jobs that are subsequently cancelled, one CancelJobs request per job.
+	CancelFraction float64
+	Duration       time.Duration
+	// Maximum number of requests in flight.
+	Concurrency int
+	// If non-empty, CPU, heap, and allocation profiles of the run are written to this directory.
+	ProfileDir string
+}
+
+// Report summarises a submit benchmark run.
+type Report struct {
+	Elapsed time.Duration
+	Submit  OperationReport
+	Cancel  OperationReport
+	// Bytes and objects allocated by the process per job submitted, including allocations made by the benchmark itself.
+	BytesAllocatedPerJob float64
+	AllocationsPerJob    float64
+}
+
+type OperationReport struct {
+	Requests int
+	Errors   int
+	// Number of jobs submitted or cancelled by successful requests.
+	Jobs          int
+	JobsPerSecond float64
+	LatencyP50    time.Duration
+	LatencyP90    time.Duration
+	LatencyP99    time.Duration
+	LatencyMax    time.Duration
+	// Error returned by the first failed request, if any.
+	FirstError string `json:",omitempty"`
+}
+
+// RunSubmitBenchmark drives SubmitJobs and CancelJobs requests against server at the configured rate until
+// config.Duration has passed or ctx is cancelled, and reports the throughput, latency, and allocations observed.
+// Requests are made with ctx, which must thus carry a principal permitted to submit to and cancel jobs of config.Queue.
+func RunSubmitBenchmark(ctx *armadacontext.Context, server api.SubmitServer, config Config) (*Report, error) {
+	if config.SubmitRate <= 0 || config.JobsPerRequest <= 0 || config.Concurrency <= 0 {
+		return nil, errors.Errorf("submit rate, jobs per request, and concurrency must be positive")
+	}
+	if config.CancelFraction < 0 || config.CancelFraction > 1 {
+		return nil, errors.Errorf("cancel fraction must be between 0 and 1, but is %f", config.CancelFraction)
+	}
+	if config.ProfileDir != "" {
+		stopProfiling, err := profiling.StartFileProfiles(config.ProfileDir)
+		if err != nil {
+			return nil, err
+		}
+		defer func() {
+			if err := stopProfiling(); err != nil {
+				ctx.Errorf("failed to write profiles to %s: %s", config.ProfileDir, err)
+			}
+		}()
+	}
+
+	var before runtime.MemStats
+	runtime.ReadMemStats(&before)
+	start := time.Now()
+	runCtx, cancel := armadacontext.WithTimeout(ctx, config.Duration)
+	defer cancel()
+
+	var submits, cancels operationRecorder
+	var submitted atomic.Int64
+	limiter := rate.NewLimiter(rate.Limit(config.SubmitRate), 1)
+	slots := make(chan struct{}, config.Concurrency)
+	wg := sync.WaitGroup{}
+	for limiter.Wait(runCtx) == nil {
+		select {
+		case slots <- struct{}{}:
+		case <-runCtx.Done():
+		}
+		if runCtx.Err() != nil {
+			break
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-slots }()
+
+			requestStart := time.Now()
+			response, err := server.SubmitJobs(ctx, submitRequest(config))
+			submits.record(time.Since(requestStart), len(response.GetJobResponseItems()), err)
+			if err != nil {
+				return
+			}
+			for _, item := range response.JobResponseItems {
+				// Job i is cancelled if the number of jobs to cancel increases with it, such that the expected fraction is cancelled.
+				i := submitted.Add(1)
+				if int64(float64(i)*config.CancelFraction) == int64(float64(i-1)*config.CancelFraction) {
+					continue
+				}
+				requestStart := time.Now()
+				cancelResponse, err := server.CancelJobs(ctx, &api.JobCancelRequest{
+					JobId:    item.JobId,
+					JobSetId: config.JobSetId,
+					Queue:    config.Queue,
+				})
+				cancels.record(time.Since(requestStart), len(cancelResponse.GetCancelledIds()), err)
+			}
+		}()
+	}
+	wg.Wait()
+	elapsed := time.Since(start)
+
+	var after runtime.MemStats
+	runtime.ReadMemStats(&after)
+	report := &Report{
+		Elapsed: elapsed,
+		Submit:  submits.report(elapsed),
+		Cancel:  cancels.report(elapsed),
+	}
+	if report.Submit.Jobs > 0 {
+		report.BytesAllocatedPerJob = float64(after.TotalAlloc-before.TotalAlloc) / float64(report.Submit.Jobs)
+		report.AllocationsPerJob = float64(after.Mallocs-before.Mallocs) / float64(report.Submit.Jobs)
+	}
+	return report, nil
+}
+
+func submitRequest(config Config) *api.JobSubmitRequest {
+	resources := v1.ResourceList{
+		v1.ResourceCPU:    resource.MustParse("100m"),
+		v1.ResourceMemory: resource.MustParse("64Mi"),
+	}
+	items := make([]*api.JobSubmitRequestItem, config.JobsPerRequest)
+	for i := range items {
+		items[i] = &api.JobSubmitRequestItem{
+			Priority: 1,
+			PodSpec: &v1.PodSpec{
+				RestartPolicy: v1.RestartPolicyNever,
+				Containers: []v1.Container{{
+					Name:      "benchmark",
+					Image:     "alpine:3.18",
+					Command:   []string{"true"},
+					Resources: v1.ResourceRequirements{Requests: resources, Limits: resources},
+				}},
+			},
+		}
+	}
+	return &api.JobSubmitRequest{
+		Queue:           config.Queue,
+		JobSetId:        config.JobSetId,
+		JobRequestItems: items,
+	}
+}
+
+// operationRecorder collects the latencies of requests of one kind.
+type operationRecorder struct {
+	latencies  []time.Duration
+	errors     int
+	firstError string
+	jobs       int
+	mu         sync.Mutex
+}
+
+func (r *operationRecorder) record(latency time.Duration, jobs int, err error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.latencies = append(r.latencies, latency)
+	if err != nil {
+		if r.errors == 0 {
+			r.firstError = err.Error()
+		}
+		r.errors++
+	} else {
+		r.jobs += jobs
+	}
+}
+
+func (r *operationRecorder) report(elapsed time.Duration) OperationReport {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	report := OperationReport{
+		Requests:   len(r.latencies),
+		Errors:     r.errors,
+		FirstError: r.firstError,
+		Jobs:       r.jobs,
+	}
+	if elapsed > 0 {
+		report.JobsPerSecond = float64(r.jobs) / elapsed.Seconds()
+	}
+	if len(r.latencies) == 0 {
+		return report
+	}
+	sort.Slice(r.latencies, func(i, j int) bool { return r.latencies[i] < r.latencies[j] })
+	quantile := func(q float64) time.Duration {
+		return r.latencies[int(q*float64(len(r.latencies)-1))]
+	}
+	report.LatencyP50 = quantile(0.5)
+	report.LatencyP90 = quantile(0.9)
+	report.LatencyP99 = quantile(0.99)
+	report.LatencyMax = r.latencies[len(r.latencies)-1]
+	return report
+}
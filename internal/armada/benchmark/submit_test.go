@@ -0,0 +1,108 @@
+package benchmark
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+type fakeSubmitServer struct {
+	api.SubmitServer
+	submitted int
+	cancelled []string
+	mu        sync.Mutex
+}
+
+func (s *fakeSubmitServer) SubmitJobs(_ context.Context, req *api.JobSubmitRequest) (*api.JobSubmitResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	response := &api.JobSubmitResponse{}
+	for range req.JobRequestItems {
+		s.submitted++
+		response.JobResponseItems = append(response.JobResponseItems, &api.JobSubmitResponseItem{JobId: fmt.Sprintf("job-%d", s.submitted)})
+	}
+	return response, nil
+}
+
+func (s *fakeSubmitServer) CancelJobs(_ context.Context, req *api.JobCancelRequest) (*api.CancellationResult, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.cancelled = append(s.cancelled, req.JobId)
+	return &api.CancellationResult{CancelledIds: []string{req.JobId}}, nil
+}
+
+func TestRunSubmitBenchmark(t *testing.T) {
+	server := &fakeSubmitServer{}
+	profileDir := t.TempDir()
+	report, err := RunSubmitBenchmark(armadacontext.Background(), server, Config{
+		Queue:          "benchmark",
+		JobSetId:       "benchmark",
+		SubmitRate:     100,
+		JobsPerRequest: 4,
+		CancelFraction: 0.25,
+		Duration:       500 * time.Millisecond,
+		Concurrency:    2,
+		ProfileDir:     profileDir,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, server.submitted, report.Submit.Jobs)
+	assert.Equal(t, 4*report.Submit.Requests, report.Submit.Jobs)
+	assert.Greater(t, report.Submit.Requests, 10)
+	assert.Equal(t, server.submitted/4, len(server.cancelled))
+	assert.Equal(t, len(server.cancelled), report.Cancel.Requests)
+	assert.Zero(t, report.Submit.Errors)
+	assert.Greater(t, report.AllocationsPerJob, 0.0)
+	assert.LessOrEqual(t, report.Submit.LatencyP50, report.Submit.LatencyMax)
+	for _, name := range []string{"cpu.pprof", "heap.pprof", "allocs.pprof"} {
+		assert.FileExists(t, filepath.Join(profileDir, name))
+	}
+}
+
+func TestRunSubmitBenchmark_InvalidConfig(t *testing.T) {
+	_, err := RunSubmitBenchmark(armadacontext.Background(), &fakeSubmitServer{}, Config{SubmitRate: 1, JobsPerRequest: 1, Concurrency: 1, CancelFraction: 2})
+	assert.Error(t, err)
+}
+
+func TestRegressions(t *testing.T) {
+	baseline := &Report{
+		Submit:               OperationReport{Requests: 100, JobsPerSecond: 1000, LatencyP99: 10 * time.Millisecond},
+		Cancel:               OperationReport{Requests: 100, JobsPerSecond: 100, LatencyP99: 5 * time.Millisecond},
+		BytesAllocatedPerJob: 1000,
+		AllocationsPerJob:    10,
+	}
+	report := *baseline
+	assert.Empty(t, Regressions(baseline, &report, 0.1))
+
+	report.Submit.JobsPerSecond = 950
+	report.Cancel.LatencyP99 = 5200 * time.Microsecond
+	assert.Empty(t, Regressions(baseline, &report, 0.1))
+
+	report.Submit.JobsPerSecond = 800
+	report.Cancel.LatencyP99 = 7 * time.Millisecond
+	report.Cancel.Errors = 1
+	report.BytesAllocatedPerJob = 2000
+	assert.Len(t, Regressions(baseline, &report, 0.1), 4)
+}
+
+func TestWriteReport(t *testing.T) {
+	report := &Report{Elapsed: time.Second, Submit: OperationReport{Requests: 1, Jobs: 1, JobsPerSecond: 1, LatencyP99: time.Millisecond}}
+	path := filepath.Join(t.TempDir(), "report.json")
+	require.NoError(t, WriteReport(report, path))
+	read, err := ReadReport(path)
+	require.NoError(t, err)
+	assert.Equal(t, report, read)
+
+	_, err = ReadReport(filepath.Join(os.TempDir(), "missing-benchmark-report.json"))
+	assert.Error(t, err)
+}
@@ -18,6 +18,58 @@ const (
 	// Pods for which this annotation has value "true" are not retried.
 	// Instead, the job the pod is part of fails immediately.
 	FailFastAnnotation = "armadaproject.io/failFast"
+	// BarrierIdAnnotation Jobs with equal value for this annotation within a queue are members of the same barrier.
+	// Members may be submitted across several job sets and requests.
+	// No member of a barrier is scheduled until all members of the barrier have been submitted.
+	BarrierIdAnnotation = "armadaproject.io/barrierId"
+	// BarrierCardinalityAnnotation All jobs in a barrier must specify the total number of jobs in the barrier via this annotation.
+	// The cardinality should be expressed as a positive integer, e.g., "3".
+	BarrierCardinalityAnnotation = "armadaproject.io/barrierCardinality"
+	// If this annotation has value "true", the members of a barrier are additionally gang-scheduled once released,
+	// i.e., they're scheduled onto the same cluster at the same time.
+	BarrierGangAnnotation = "armadaproject.io/barrierGang"
+	// MaxConcurrentJobsAnnotation Jobs with this annotation are only scheduled while fewer than this many jobs
+	// of their job set are running. Set by the server for jobs submitted with MaxConcurrentJobs.
+	// The limit should be expressed as a positive integer, e.g., "10".
+	MaxConcurrentJobsAnnotation = "armadaproject.io/maxConcurrentJobs"
+	// JobSetResourceLimitsAnnotation Jobs with this annotation are only scheduled while the total resource requests
+	// of the running jobs of their job set, including the job itself, are within these limits.
+	// Set by the server for jobs submitted with JobSetResourceLimits.
+	// The limits should be expressed as comma-separated resource=quantity pairs, e.g., "cpu=2000,memory=4Ti".
+	JobSetResourceLimitsAnnotation = "armadaproject.io/jobSetResourceLimits"
+	// HeldUntilAnnotation Jobs with this annotation aren't scheduled before the given time.
+	// Set by the server for jobs submitted outside of the submission windows of their queue.
+	// The time should be expressed in RFC 3339 format, e.g., "2023-05-17T16:00:00Z".
+	HeldUntilAnnotation = "armadaproject.io/heldUntil"
+	// RetryMaxAttemptsAnnotation Failed runs of jobs with this annotation are retried until this many runs have failed.
+	// Set by the server for jobs submitted with a RetryPolicy, as are the other retry annotations.
+	// The number of attempts should be expressed as a positive integer, e.g., "3".
+	RetryMaxAttemptsAnnotation = "armadaproject.io/retryMaxAttempts"
+	// RetryBackoffSecondsAnnotation Jobs with a failed run to be retried aren't scheduled until this many seconds later.
+	RetryBackoffSecondsAnnotation = "armadaproject.io/retryBackoffSeconds"
+	// RetryOnExitCodesAnnotation If set, failed runs are only retried if a container exited with one of these exit codes.
+	// The exit codes should be expressed as a comma-separated list, e.g., "1,137".
+	RetryOnExitCodesAnnotation = "armadaproject.io/retryOnExitCodes"
+	// PreemptibleAnnotation Jobs with this annotation set to "true" may be evicted whenever higher-priority work needs
+	// their resources, regardless of their priority class, and are returned to the queue rather than failed when evicted.
+	// Set by the server for jobs submitted with is_preemptible.
+	PreemptibleAnnotation = "armadaproject.io/preemptible"
+	// MaxRuntimeSecondsAnnotation Runs of jobs with this annotation are cancelled once they've been running for this many seconds.
+	// Set by the server for jobs submitted with max_runtime_seconds, or to queues with a maximum job runtime.
+	// The runtime should be expressed as a positive integer, e.g., "3600".
+	MaxRuntimeSecondsAnnotation = "armadaproject.io/maxRuntimeSeconds"
+	// ResubmittedFromAnnotation Set by the server to the id of the job a job was resubmitted from using ResubmitJobs.
+	// The annotation is dropped from jobs submitted with it.
+	ResubmittedFromAnnotation = "armadaproject.io/resubmittedFrom"
+	// OriginalResourcesAnnotation Set by the server for jobs the resource requests and limits of which were normalized
+	// at submission to the requests and limits of their containers before normalization, as a JSON object by container name.
+	OriginalResourcesAnnotation = "armadaproject.io/originalResources"
+	// RequiredClustersAnnotation, PreferredClustersAnnotation, and ExcludedClustersAnnotation
+	// Set by the server for jobs submitted with cluster_targeting to the comma-separated clusters of its fields,
+	// each given by cluster id or pool, e.g., "cluster-a,pool-b".
+	RequiredClustersAnnotation  = "armadaproject.io/requiredClusters"
+	PreferredClustersAnnotation = "armadaproject.io/preferredClusters"
+	ExcludedClustersAnnotation  = "armadaproject.io/excludedClusters"
 )
 
 var ReturnLeaseRequestTrackedAnnotations = map[string]struct{}{
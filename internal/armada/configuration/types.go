@@ -35,15 +35,42 @@ type ArmadaConfig struct {
 
 	PriorityHalfTime                  time.Duration
 	CancelJobsBatchSize               int
+	CancelJobsParallelism             int           // Max number of batches of jobs cancelled concurrently when cancelling a job set
+	JobSetExpiryLoopInterval          time.Duration // How often jobs of job sets that outlived their TTL are cancelled
+	MaxRuntimeLoopInterval            time.Duration // How often jobs running for longer than their maximum runtime are cancelled
+	UnschedulableJobsLoopInterval     time.Duration // How often queued jobs are re-checked against the scheduling info of clusters
+	BudgetAccountingLoopInterval      time.Duration // How often runs of jobs that are no longer leased are finished and old runs are pruned
+	UsageAccrualLoopInterval          time.Duration // How often the usage of running jobs is added to the daily usage records
+	UsageRecordRetention              time.Duration // How long daily usage records are kept for after the end of their day
+	MaintenanceWindowLoopInterval     time.Duration // How often the start and end of maintenance windows are announced
+	EventOutboxRelayInterval          time.Duration // How often events of submitted jobs that failed to be published are retried
 	Redis                             redis.UniversalOptions
 	EventsApiRedis                    redis.UniversalOptions
+	ReadReplicas                      ReadReplicaConfig // Used to serve read-heavy operations, e.g., listing queues and reading events
+	PodSpecStorage                    PodSpecStorageConfig
 	Scheduling                        SchedulingConfig
 	NewScheduler                      NewSchedulerConfig
 	QueueManagement                   QueueManagementConfig
+	QueueRepository                   QueueRepositoryConfig
+	JobRepository                     JobRepositoryConfig
 	Pulsar                            PulsarConfig
 	Postgres                          PostgresConfig // Used for Pulsar submit API deduplication
 	EventApi                          EventApiConfig
 	Metrics                           MetricsConfig
+	TestMode                          TestModeConfig
+	ExecutorCredentials               ExecutorCredentialsConfig
+	ApiTokens                         ApiTokensConfig
+	EventJournal                      EventJournalConfig
+	EventMirror                       EventMirrorConfig
+	SubmitFailures                    SubmitFailureConfig
+	SubmissionPolicy                  SubmissionPolicyConfig
+	ExecutorHealth                    ExecutorHealthConfig
+	JobLogs                           JobLogsConfig
+	JobSessions                       JobSessionsConfig
+	QueuePermissionSync               QueuePermissionSyncConfig
+	ImageResolver                     ImageResolverConfig
+	AuditLog                          AuditLogConfig
+	Compression                       CompressionConfig
 	IgnoreJobSubmitChecks             bool // Temporary flag to stop us rejecting jobs on switch over
 	PulsarSchedulerEnabled            bool
 	ProbabilityOfUsingPulsarScheduler float64
@@ -82,6 +109,10 @@ type PulsarConfig struct {
 	// Log all pulsar events
 	EventsPrinterSubscription string
 	EventsPrinter             bool
+	// Subscription from which the events published for the Pulsar scheduler are mirrored, if EventMirror is enabled.
+	EventMirrorSubscription string
+	// Subscription from which the usage of jobs of the Pulsar scheduler is recorded.
+	UsageRecorderSubscription string
 	// Maximum allowed message size in bytes
 	MaxAllowedMessageSize uint
 	// Timeout when polling pulsar for messages
@@ -159,14 +190,34 @@ type SchedulingConfig struct {
 	MaxJobSchedulingContextsPerExecutor uint
 	Lease                               LeaseSettings
 	DefaultJobLimits                    armadaresource.ComputeResources
+	// Increments the resource requests and limits of containers are rounded up to, by resource name,
+	// e.g., {"cpu": "100m", "memory": "256Mi"}, such that irregular requests don't fragment nodes.
+	// Resources without an increment aren't rounded.
+	ResourceRequestIncrements armadaresource.ComputeResources
+	// If true, the resource limits of containers are lowered to their requests. Queues may enable this for their jobs.
+	RequestsEqualLimits bool
 	// Set of tolerations added to all submitted pods.
 	DefaultJobTolerations []v1.Toleration
 	// Set of tolerations added to all submitted pods of a given priority class.
 	DefaultJobTolerationsByPriorityClass map[string][]v1.Toleration
 	// Set of tolerations added to all submitted pods with a given resource request.
 	DefaultJobTolerationsByResourceRequest map[string][]v1.Toleration
+	// Node selector labels set on all submitted pods, replacing any values pods set for them.
+	// Queues may add to these, but can't override them.
+	RequiredJobNodeSelector map[string]string
+	// Labels pods may not select nodes by, neither in their node selector nor in the node affinity they require.
+	// Queues may add to these.
+	ForbiddenJobNodeSelectorLabels []string
+	// Taints pods may not tolerate, including through default tolerations. Queues may add to these.
+	ForbiddenJobTaints []v1.Taint
+	// Tolerations and labels applied to pods leased to executors of a given pool, indexed by pool name.
+	// Taints of nodes in the pool tolerated by its template are ignored when checking if jobs can be scheduled,
+	// such that jobs don't need to include pool-specific tolerations.
+	PoolTemplates map[string]PoolTemplate
 	// Maximum number of times a job is retried before considered failed.
 	MaxRetries uint
+	// Controls quarantining jobs the leases of which are returned repeatedly, regardless of whether they ran.
+	Quarantine QuarantineConfig
 	// Controls how fairness is calculated. Can be either AssetFairness or DominantResourceFairness.
 	FairnessModel FairnessModel
 	// List of resource names, e.g., []string{"cpu", "memory"}, to consider when computing DominantResourceFairness.
@@ -178,7 +229,16 @@ type SchedulingConfig struct {
 	// Applies only to the old scheduler.
 	PoolResourceScarcity map[string]map[string]float64
 	MaxPodSpecSizeBytes  uint
-	MinJobResources      v1.ResourceList
+	// Controls how queued jobs of different owners in the same queue are ordered relative to each other.
+	// Applies only to the old scheduler.
+	UserFairShare UserFairShareConfig
+	// Maximum size in bytes of a serialized job submit request item, including labels, annotations, and all pod specs.
+	// Queues may set a stricter limit. If 0, job size is not limited server-wide.
+	MaxJobSizeBytes uint
+	// Maximum number of containers, including init containers, across all pod specs of a job.
+	// Queues may set a stricter limit. If 0, the number of containers is not limited server-wide.
+	MaxContainersPerJob uint
+	MinJobResources     v1.ResourceList
 	// Once a node has been found on which a pod can be scheduled,
 	// the scheduler will consider up to the next maxExtraNodesToConsider nodes.
 	// The scheduler selects the node with the best score out of the considered nodes.
@@ -226,12 +286,56 @@ type SchedulingConfig struct {
 	// The grace period of pods that either
 	// - do not set a grace period, or
 	// - explicitly set a grace period of 0 seconds,
-	// is automatically set to MinTerminationGracePeriod.
+	// is automatically set to DefaultTerminationGracePeriod, or to MinTerminationGracePeriod if there's no default.
 	MinTerminationGracePeriod time.Duration
 	// Max allowed grace period.
 	// Should normally not be set greater than single-digit minutes,
 	// since cancellation and preemption may need to wait for this amount of time.
 	MaxTerminationGracePeriod time.Duration
+	// Grace period of pods that don't set one.
+	// If 0, or outside [MinTerminationGracePeriod, MaxTerminationGracePeriod], MinTerminationGracePeriod is used instead.
+	// Queues may override this default within the allowed range.
+	DefaultTerminationGracePeriod time.Duration
+	// Restart policy of pods that don't set one. Queues may override this default.
+	DefaultRestartPolicy v1.RestartPolicy
+	// Restart policies pods are allowed to set. Queues may restrict these further.
+	// If empty, pods may set any restart policy.
+	AllowedRestartPolicies []v1.RestartPolicy
+	// Service accounts pods are allowed to run as. Pods that don't set a service account run as "default".
+	// Queues may restrict these further. If empty, pods may set any service account.
+	AllowedServiceAccounts []string
+	// If true, pods may not run as any service account. Set by the server, rather than configured, for queues none of
+	// whose allowed service accounts are allowed server-wide, since an empty AllowedServiceAccounts allows any.
+	DenyAllServiceAccounts bool
+	// Service account of pods that don't set one. If empty, such pods run as "default".
+	DefaultServiceAccount string
+	// Service accounts the pods of jobs of each queue, by queue name, may run as, replacing AllowedServiceAccounts
+	// and DefaultServiceAccount for those queues. Queues may restrict these further, but never extend them,
+	// such that tenants can't escalate their privileges by running as another tenant's service account.
+	// If any queue is mapped, pods of queues that aren't may only run as DefaultServiceAccount.
+	QueueServiceAccounts map[string]QueueServiceAccounts
+	// If true, jobs are rejected at submission if their service account doesn't exist in their namespace
+	// on any cluster that reports its service accounts. Clusters that don't report service accounts are not considered.
+	VerifyServiceAccountsExist bool
+	// Domains external-dns hostnames, and targets that aren't IP addresses, of ingresses must be within.
+	// If empty, ingresses may only set IP targets.
+	ExternalDnsDomains []string
+	// Domains replacing ExternalDnsDomains for jobs of the listed queues.
+	QueueExternalDnsDomains map[string][]string
+	// Secrets and config maps pods may reference, e.g., as volumes or environment variables.
+	// If empty, pods may reference any secret or config map, respectively.
+	AllowedSecrets    []string
+	AllowedConfigMaps []string
+	// If true, jobs are rejected at submission if they reference secrets or config maps, other than optionally,
+	// that don't exist in their namespace on any cluster that reports them. Clusters that don't report them are not considered.
+	VerifySecretsAndConfigMapsExist bool
+	// Scheduling info is considered stale if no cluster has reported for this long, in which case jobs
+	// that appear unschedulable may only be so because clusters haven't reported, e.g., while executors restart.
+	// Zero disables the check.
+	SchedulingInfoStaleAfter time.Duration
+	// If true, jobs that appear unschedulable while scheduling info is stale are accepted with a warning.
+	// Otherwise, they're rejected with an error indicating that the submission may be retried.
+	AcceptJobsWithStaleSchedulingInfo bool
 	// If an executor hasn't heartbeated in this time period, it will be considered stale
 	ExecutorTimeout time.Duration
 	// Default activeDeadline for all pods that don't explicitly set activeDeadlineSeconds.
@@ -301,6 +405,19 @@ const (
 	DominantResourceFairness FairnessModel = "DominantResourceFairness"
 )
 
+// UserFairShareConfig controls the order in which the queued jobs of a queue are considered for scheduling.
+// If enabled, jobs of different owners are interleaved such that each owner is offered a number of jobs
+// proportional to its weight. Otherwise, jobs are considered in order of priority and submission time.
+// The relative order of jobs of the same owner is the same in either case.
+type UserFairShareConfig struct {
+	Enabled bool
+	// Weight of owners not in UserWeights.
+	// Jobs of owners with weight 0 are considered only after the jobs of all other owners.
+	DefaultUserWeight float64 `validate:"gte=0"`
+	// Weights of specific owners, indexed by owner.
+	UserWeights map[string]float64
+}
+
 type IndexedResource struct {
 	// Resource name. E.g., "cpu", "memory", or "nvidia.com/gpu".
 	Name string
@@ -308,6 +425,14 @@ type IndexedResource struct {
 	Resolution resource.Quantity
 }
 
+// QueueServiceAccounts are the service accounts the pods of jobs of a queue may run as.
+type QueueServiceAccounts struct {
+	// Pods may also run as the default service account of the queue.
+	Allowed []string
+	// Service account of pods that don't set one. If empty, such pods run as "default".
+	Default string
+}
+
 // A WellKnownNodeType defines a set of nodes; see AwayNodeType.
 type WellKnownNodeType struct {
 	// Name is the unique identifier for this node type.
@@ -354,11 +479,50 @@ type PreemptionConfig struct {
 	PriorityClassNameOverride *string
 }
 
+// PoolTemplate specifies tolerations and labels applied to all pods leased to executors of a pool.
+type PoolTemplate struct {
+	// Tolerations added to pods; tolerations already present on a pod aren't duplicated.
+	Tolerations []v1.Toleration
+	// Labels added to pods; labels set by the job take precedence.
+	Labels map[string]string
+}
+
 type LeaseSettings struct {
 	ExpireAfter        time.Duration
 	ExpiryLoopInterval time.Duration
 }
 
+// ReadReplicaConfig configures Redis read replicas that read-heavy operations are routed to,
+// such that they don't add load to the primaries used for submitting and scheduling jobs.
+// Writes, and reads that must observe all previous writes, always go to the primaries.
+// PodSpecStorageConfig controls storing very large pod specs outside of Redis, such that the memory usage of Redis
+// remains predictable while very large jobs can still be submitted, subject to SchedulingConfig.MaxPodSpecSizeBytes,
+// and storing identical pod specs in Redis only once.
+type PodSpecStorageConfig struct {
+	// The pod specs of jobs whose pod specs are larger than this many bytes are stored in Directory rather than in Redis.
+	// If zero, all pod specs are stored in Redis.
+	ThresholdBytes uint
+	// Directory in which pod specs are stored, e.g., one onto which an object storage bucket is mounted.
+	// Must be shared by all Armada server replicas.
+	Directory string
+	// If true, the pod specs of jobs stored in Redis are stored once, compressed, for all jobs with identical pod specs,
+	// e.g., the jobs of a parametric workload. Pod specs shared this way are stored in Redis regardless of ThresholdBytes.
+	Deduplicate bool
+}
+
+type ReadReplicaConfig struct {
+	// Read replica of Redis, used to list queues and to read job details for event enrichment and metrics.
+	// If no addresses are provided, such reads go to the primary.
+	Redis redis.UniversalOptions
+	// Read replica of EventsApiRedis, used to read events.
+	// If no addresses are provided, events are read from the primary.
+	EventsApiRedis redis.UniversalOptions
+	// Reads go to the primary while a replica lags behind it by more than MaxStaleness.
+	MaxStaleness time.Duration
+	// How often the replication lag of replicas is checked.
+	StalenessCheckInterval time.Duration
+}
+
 type PostgresConfig struct {
 	MaxOpenConns    int
 	MaxIdleConns    int
@@ -366,10 +530,303 @@ type PostgresConfig struct {
 	Connection      map[string]string
 }
 
+const (
+	QueueRepositoryBackendRedis    = "redis"
+	QueueRepositoryBackendPostgres = "postgres"
+)
+
+// QueueRepositoryConfig selects where queues are stored.
+type QueueRepositoryConfig struct {
+	// Either "redis", in which case queues are stored in the Redis database the server is configured with, or "postgres".
+	Backend string
+	// Database queues are stored in if Backend is "postgres". The server migrates its schema on startup.
+	// Since migrations are versioned per database, it mustn't be a database used by another component, e.g., Lookout.
+	Postgres PostgresConfig
+}
+
+const (
+	JobRepositoryBackendRedis    = "redis"
+	JobRepositoryBackendPostgres = "postgres"
+)
+
+// JobRepositoryConfig selects where jobs are stored. Pod specs are stored outside either as per PodSpecStorageConfig.
+type JobRepositoryConfig struct {
+	// Either "redis", in which case jobs are stored in the Redis database the server is configured with, or "postgres".
+	Backend string
+	// Database jobs are stored in if Backend is "postgres". The server migrates its schema on startup.
+	// As for QueueRepositoryConfig.Postgres, it mustn't be a database used by another component.
+	Postgres PostgresConfig
+}
+
 type QueueManagementConfig struct {
 	AutoCreateQueues       bool
 	DefaultPriorityFactor  float64
 	DefaultQueuedJobsLimit int
+	// How often a cascading queue deletion checks whether the jobs of the queue are gone.
+	CascadingDeletePollInterval time.Duration
+	// Cascading queue deletions fail if the jobs of the queue aren't gone after this long.
+	// Deletions that haven't made progress for this long are considered abandoned and may be restarted.
+	CascadingDeleteTimeout time.Duration
+	// Number of queues ListQueues returns if the request doesn't specify a page size.
+	DefaultQueuePageSize int
+	// Maximum number of queues ListQueues returns at a time. If 0, page sizes aren't capped.
+	MaxQueuePageSize int
+}
+
+// TestModeConfig controls the synthetic load and fault injection subsystem,
+// used to validate the capacity and failure handling of a deployment before onboarding tenants.
+type TestModeConfig struct {
+	// If true, the TestMode service is served and faults may be injected into repositories.
+	// Should never be enabled for deployments serving real workloads.
+	Enabled bool
+	// Queue synthetic load is submitted to. Load can't be submitted to any other queue.
+	SandboxQueue string
+	// Maximum number of jobs a single GenerateLoad call may submit. Must be positive if test mode is enabled.
+	MaxJobsPerRun uint32
+}
+
+// ExecutorCredentialsConfig controls credentials minted by the server for individual executors.
+// Such credentials can only be used for the RPCs executors need and only act on behalf of the cluster they were minted for.
+type ExecutorCredentialsConfig struct {
+	// If true, requests may authenticate with executor credentials and the ExecutorCredentials service is served.
+	Enabled bool
+	// Credentials expire this long after being minted, unless rotated or revoked earlier.
+	Lifetime time.Duration
+	// After a credential is rotated, it remains valid for this long such that in-flight requests don't fail.
+	RotationGracePeriod time.Duration
+	// Groups executors authenticating with executor credentials are members of.
+	// Should be mapped to the execute_jobs permission.
+	Groups []string
+}
+
+// ApiTokensConfig controls long-lived tokens users issue to act on their behalf, e.g., from CI systems.
+// Such tokens can only perform the queue actions they're scoped to.
+type ApiTokensConfig struct {
+	// If true, requests may authenticate with API tokens and the ApiTokens service is served.
+	Enabled bool
+	// Lifetime of tokens created without one.
+	DefaultLifetime time.Duration
+	// Tokens may not be created with a longer lifetime than this.
+	MaxLifetime time.Duration
+	// Tokens carry the groups their owner was a member of when they were created. Such tokens may not be used for longer
+	// than this after being created, such that removing the owner from a group takes effect on their tokens within this
+	// time. Zero means tokens carrying groups are only limited by MaxLifetime.
+	MaxLifetimeWithGroups time.Duration
+}
+
+// EventJournalConfig controls the journal events are written to before being published,
+// from which events can be replayed via the EventJournal service.
+type EventJournalConfig struct {
+	// If true, reported events are journaled in Redis and the EventJournal service is served.
+	Enabled bool
+	// Approximate number of most recently reported events retained by the journal.
+	MaxEvents int64
+	// Number of events read from the journal and published together while replaying.
+	ReplayBatchSize int
+}
+
+// EventMirrorConfig controls mirroring reported events to Kafka, such that they can be ingested by other platforms.
+type EventMirrorConfig struct {
+	// If true, events are published to Topic once reported.
+	Enabled bool
+	// Addresses of the Kafka brokers, e.g., "kafka:9092".
+	Brokers []string
+	// If true, brokers are connected to via TLS.
+	Tls bool
+	// Topic events are published to, keyed by their queue and job set.
+	Topic string
+	// Topic events are published to if they still can't be published to Topic after MaxAttempts attempts.
+	DeadLetterTopic string
+	MaxAttempts     int
+	// Time waited before the second attempt, which doubles with each attempt after.
+	Backoff time.Duration
+	// Interval at which events due to be published again are retried.
+	RetryInterval time.Duration
+	// Time after which an attempt to publish events times out.
+	WriteTimeout time.Duration
+}
+
+// SubmitFailureConfig controls how the errors of individual jobs of rejected submissions are returned.
+type SubmitFailureConfig struct {
+	// Maximum number of job errors included in the status of a rejected submission. If 0, all are included.
+	MaxResponseItems int
+	// If a rejected submission has more job errors, all of them are stored as a failure report for this long,
+	// which can be retrieved with GetSubmitFailureReport using the id included in the status.
+	ReportRetention time.Duration
+}
+
+// QuarantineConfig controls quarantining jobs the leases of which are returned repeatedly, e.g., because their pods
+// pass validation but can't be created or scheduled. Such leases don't count as retries, so jobs would otherwise be
+// retried indefinitely. Quarantined jobs are failed, and a diagnostic record of them can be retrieved with
+// GetQuarantinedJob.
+type QuarantineConfig struct {
+	// Number of times the lease of a job may be returned before it's quarantined. If 0, jobs are never quarantined.
+	MaxLeaseReturns uint
+	// Maximum number of the most recent lease returns of a job included in its diagnostic record.
+	MaxRecordedLeaseReturns int
+	// Time for which the lease returns of a job and the diagnostic records of quarantined jobs are retained.
+	// Lease returns further apart than this aren't counted together.
+	RecordRetention time.Duration
+}
+
+// SubmissionPolicyConfig configures evaluating submitted jobs against policies written by operators in Rego,
+// served by an Open Policy Agent. Jobs for which the policy returns deny reasons are rejected with those reasons.
+type SubmissionPolicyConfig struct {
+	// Url of the Open Policy Agent, e.g., "http://localhost:8181". If empty, jobs aren't evaluated against any policy.
+	OpaUrl string
+	// Path of the rule returning the set of deny reasons of a job, e.g., "armada/submission/deny".
+	Path string
+	// Timeout of evaluating the jobs of a single submission.
+	Timeout time.Duration
+	// Maximum number of evaluations in flight at once for a single submission.
+	// Jobs with identical inputs, e.g., those of a parametric workload, are evaluated once.
+	MaxConcurrentEvaluations int
+}
+
+// ExecutorHealthConfig configures how executors are found unhealthy, i.e., to have stopped heartbeating,
+// and how operators are alerted when they do.
+type ExecutorHealthConfig struct {
+	// Executors that haven't requested leases for this long are unhealthy. Zero disables health checks.
+	UnhealthyAfter time.Duration
+	// How often executors are checked for changes of health.
+	CheckInterval time.Duration
+	// If set, an alert is posted to this URL, as JSON, whenever an executor becomes unhealthy or recovers.
+	AlertWebhookUrl string
+	// Timeout of posting a single alert.
+	AlertTimeout time.Duration
+}
+
+// JobLogsConfig configures proxying the logs of jobs from the binoculars service of the cluster they ran on.
+type JobLogsConfig struct {
+	// Connection to the binoculars service of each cluster, where "{CLUSTER_ID}" in the URL is replaced by the id of
+	// the cluster, e.g., "armada-binoculars-{CLUSTER_ID}:50051". If the URL is empty, logs of jobs can't be retrieved.
+	BinocularsConnection client.ApiConnectionDetails
+	// How often new logs are requested when following the logs of a running job.
+	FollowPollInterval time.Duration
+}
+
+// JobSessionsConfig configures brokering exec and port-forward sessions into the pods of running jobs.
+type JobSessionsConfig struct {
+	// If true, the JobSessions service is served. Since sessions are brokered in memory, it should only be enabled
+	// on a single replica, to which both clients and executors connect.
+	Enabled bool
+	// Sessions fail if the executor of the cluster of the job doesn't attach to them within this long.
+	AttachTimeout time.Duration
+}
+
+// QueuePermissionSyncConfig configures granting groups of users, e.g., those in the groups claim of OIDC tokens,
+// permissions on queues by rules rather than queue by queue. The permissions of managed groups, i.e., those named by
+// any rule or by ManagedGroups, are managed by the rules: the queues are checked periodically, and permissions that
+// drifted from the rules, e.g., because queues were created or edited since, are updated to match them.
+type QueuePermissionSyncConfig struct {
+	// If empty, and so is ManagedGroups, the permissions of queues aren't synced.
+	Rules []QueuePermissionRule
+	// Groups managed in addition to those named by rules. Groups should be listed here before removing their last
+	// rule, such that the permissions granted by it are revoked rather than left in place.
+	ManagedGroups []string
+	// How often the permissions of queues are checked against the rules.
+	Interval time.Duration
+}
+
+// QueuePermissionRule grants a group permissions on the queues with names matching a pattern.
+type QueuePermissionRule struct {
+	Group string
+	// Pattern the names of queues are matched against, as by path.Match, e.g., "ml-*".
+	QueuePattern string
+	// Verbs granted, e.g., ["submit", "cancel", "watch"].
+	Verbs []string
+}
+
+// ImageResolverConfig configures checking the images of submitted jobs against the registries they're pulled from,
+// such that jobs with images that can't be pulled are rejected at submission rather than failing on a cluster.
+type ImageResolverConfig struct {
+	// If false, images aren't checked.
+	Enabled bool
+	// Registries images may be pulled from, e.g., "docker.io" or "registry.example.com:5000".
+	// Images without a registry are pulled from "docker.io". If empty, images may be pulled from any registry.
+	AllowedRegistries []string
+	// If true, images must be referenced by digest, e.g., "registry.example.com/image@sha256:...".
+	RequireDigest bool
+	// If true, the manifests of images are requested from their registries, and jobs with images that don't exist are
+	// rejected. Images are admitted if their registry can't be reached or denies access, since clusters may have
+	// credentials the server doesn't. Requires AllowedRegistries, since only those are requested. Registries at
+	// private, loopback or link-local addresses aren't requested either.
+	VerifyExistence bool
+	// Credentials used to verify the existence of images, by registry.
+	Credentials map[string]RegistryCredentials
+	// Registries accessed over plain http, rather than https, e.g., registries local to a test cluster.
+	InsecureRegistries []string
+	// Timeout of verifying the existence of a single image.
+	Timeout time.Duration
+	// Maximum number of images verified at once for a single submission.
+	MaxConcurrentRequests int
+	// Number of images whose existence is remembered, and for how long.
+	// Only images that exist are remembered, such that images can be submitted as soon as they've been pushed.
+	CacheSize int
+	CacheTtl  time.Duration
+}
+
+type RegistryCredentials struct {
+	Username string
+	Password string
+}
+
+// CompressionConfig configures compressing data the server stores, e.g., the queue ownership groups of jobs.
+type CompressionConfig struct {
+	// Either "zlib", "zstd" or "snappy". Data is decompressed with the codec it was compressed with,
+	// so the codec may be changed at any time, but servers of earlier versions can only read data compressed with zlib.
+	Codec string
+	// Data of up to this many bytes is stored uncompressed. Only applies to zlib.
+	MinCompressSize int
+	// Pool of compressors shared by the calls of the submit server.
+	CompressorPool ObjectPoolConfig
+}
+
+// ObjectPoolConfig configures a pool of reusable objects, e.g., compressors.
+type ObjectPoolConfig struct {
+	// Maximum number of objects borrowed and idle at once.
+	MaxTotal int
+	// Maximum and minimum number of idle objects.
+	MaxIdle int
+	MinIdle int
+	// If true, borrowing an object blocks while MaxTotal objects are borrowed; otherwise, borrowing fails.
+	BlockWhenExhausted bool
+	// Idle objects are evicted after this long.
+	MinEvictableIdleTime time.Duration
+}
+
+const (
+	AuditLogSinkFile     = "file"
+	AuditLogSinkPostgres = "postgres"
+	AuditLogSinkKafka    = "kafka"
+)
+
+// AuditLogConfig configures recording calls of mutating API methods, with the principal that made them and their
+// outcome, to an append-only trail for compliance.
+type AuditLogConfig struct {
+	// If false, calls aren't recorded.
+	Enabled bool
+	// Full names of the gRPC methods whose calls are recorded, e.g., "/api.Submit/SubmitJobs".
+	// Calls of any method that fail to authenticate, or are denied before reaching the method, are always recorded.
+	Methods []string
+	// Either "file", in which case records are appended to File as lines of JSON, "postgres" or "kafka".
+	Sink string
+	File string
+	// Database records are inserted into if Sink is "postgres". The server migrates its schema on startup.
+	// As for QueueRepositoryConfig.Postgres, it mustn't be a database used by another component.
+	Postgres PostgresConfig
+	// Topic records are published to if Sink is "kafka".
+	Kafka AuditLogKafkaConfig
+}
+
+type AuditLogKafkaConfig struct {
+	// Addresses of the Kafka brokers, e.g., "kafka:9092".
+	Brokers []string
+	Topic   string
+	// If true, brokers are connected to via TLS.
+	Tls bool
+	// Writing a record times out after this long.
+	WriteTimeout time.Duration
 }
 
 type MetricsConfig struct {
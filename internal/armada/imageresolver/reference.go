@@ -0,0 +1,95 @@
+package imageresolver
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// Registry of images referenced without a registry.
+	defaultRegistry = "docker.io"
+	// Host serving the registry API of defaultRegistry.
+	defaultRegistryHost = "registry-1.docker.io"
+	defaultTag          = "latest"
+)
+
+var (
+	pathComponentRegex = regexp.MustCompile(`^[a-z0-9]+(?:(?:[._]|__|-+)[a-z0-9]+)*$`)
+	tagRegex           = regexp.MustCompile(`^[\w][\w.-]{0,127}$`)
+	digestRegex        = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9]*(?:[-_+.][A-Za-z][A-Za-z0-9]*)*:[0-9a-fA-F]{32,}$`)
+)
+
+// Reference is a parsed image reference, e.g., "registry.example.com:5000/team/image:1.0".
+type Reference struct {
+	// Registry the image is pulled from, e.g., "registry.example.com:5000".
+	Registry string
+	// Repository of the image within its registry, e.g., "team/image".
+	Repository string
+	// Tag of the image, if any; "latest" if neither a tag nor a digest is given.
+	Tag string
+	// Digest of the image, if referenced by digest, e.g., "sha256:...".
+	Digest string
+}
+
+// ParseReference parses image as container runtimes do, e.g., "ubuntu" refers to "docker.io/library/ubuntu:latest".
+func ParseReference(image string) (*Reference, error) {
+	if image == "" {
+		return nil, errors.New("image is empty")
+	}
+	ref := &Reference{}
+	name := image
+	if i := strings.Index(name, "@"); i >= 0 {
+		name, ref.Digest = name[:i], name[i+1:]
+		if !digestRegex.MatchString(ref.Digest) {
+			return nil, errors.Errorf("image %s has invalid digest %q", image, ref.Digest)
+		}
+	}
+	if i := strings.LastIndex(name, ":"); i > strings.LastIndex(name, "/") {
+		name, ref.Tag = name[:i], name[i+1:]
+		if !tagRegex.MatchString(ref.Tag) {
+			return nil, errors.Errorf("image %s has invalid tag %q", image, ref.Tag)
+		}
+	}
+	if ref.Tag == "" && ref.Digest == "" {
+		ref.Tag = defaultTag
+	}
+
+	// The first component is a registry only if it looks like a host; "team/image" refers to docker.io/team/image.
+	ref.Registry = defaultRegistry
+	ref.Repository = name
+	if i := strings.Index(name, "/"); i >= 0 {
+		if first := name[:i]; strings.ContainsAny(first, ".:") || first == "localhost" {
+			ref.Registry, ref.Repository = first, name[i+1:]
+		}
+	}
+	if ref.Registry == defaultRegistry && !strings.Contains(ref.Repository, "/") {
+		ref.Repository = "library/" + ref.Repository
+	}
+	for _, component := range strings.Split(ref.Repository, "/") {
+		if !pathComponentRegex.MatchString(component) {
+			return nil, errors.Errorf("image %s has invalid repository %q", image, ref.Repository)
+		}
+	}
+	return ref, nil
+}
+
+// ManifestReference returns the digest of ref if referenced by digest, and its tag otherwise.
+func (ref *Reference) ManifestReference() string {
+	if ref.Digest != "" {
+		return ref.Digest
+	}
+	return ref.Tag
+}
+
+func (ref *Reference) String() string {
+	s := ref.Registry + "/" + ref.Repository
+	if ref.Tag != "" {
+		s += ":" + ref.Tag
+	}
+	if ref.Digest != "" {
+		s += "@" + ref.Digest
+	}
+	return s
+}
@@ -0,0 +1,48 @@
+package imageresolver
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseReference(t *testing.T) {
+	digest := "sha256:" + strings.Repeat("a", 64)
+	tests := map[string]*Reference{
+		"ubuntu":                          {Registry: "docker.io", Repository: "library/ubuntu", Tag: "latest"},
+		"ubuntu:22.04":                    {Registry: "docker.io", Repository: "library/ubuntu", Tag: "22.04"},
+		"team/image":                      {Registry: "docker.io", Repository: "team/image", Tag: "latest"},
+		"registry.example.com/team/image": {Registry: "registry.example.com", Repository: "team/image", Tag: "latest"},
+		"localhost/image:1.0":             {Registry: "localhost", Repository: "image", Tag: "1.0"},
+		"registry:5000/image":             {Registry: "registry:5000", Repository: "image", Tag: "latest"},
+		"registry:5000/team/image:1.0":    {Registry: "registry:5000", Repository: "team/image", Tag: "1.0"},
+		"image@" + digest:                 {Registry: "docker.io", Repository: "library/image", Digest: digest},
+		"image:1.0@" + digest:             {Registry: "docker.io", Repository: "library/image", Tag: "1.0", Digest: digest},
+	}
+	for image, expected := range tests {
+		t.Run(image, func(t *testing.T) {
+			ref, err := ParseReference(image)
+			require.NoError(t, err)
+			assert.Equal(t, expected, ref)
+		})
+	}
+}
+
+func TestParseReference_Invalid(t *testing.T) {
+	for _, image := range []string{
+		"",
+		"Image",
+		"image:",
+		"image:-tag",
+		"image@sha256:abc",
+		"registry.example.com/",
+		"team//image",
+	} {
+		t.Run(image, func(t *testing.T) {
+			_, err := ParseReference(image)
+			assert.Error(t, err)
+		})
+	}
+}
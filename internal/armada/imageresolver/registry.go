@@ -0,0 +1,162 @@
+package imageresolver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+	"golang.org/x/exp/slices"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+)
+
+// Media types of the manifests and indexes images may be stored as.
+var manifestMediaTypes = []string{
+	"application/vnd.oci.image.index.v1+json",
+	"application/vnd.oci.image.manifest.v1+json",
+	"application/vnd.docker.distribution.manifest.list.v2+json",
+	"application/vnd.docker.distribution.manifest.v2+json",
+}
+
+// verifyExistence returns true if the registry of ref has a manifest for ref, and false if it reports it has none.
+// Returns an error if the registry can't be reached or denies access.
+func (r *Resolver) verifyExistence(ctx *armadacontext.Context, ref *Reference) (bool, error) {
+	if r.config.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = armadacontext.WithTimeout(ctx, r.config.Timeout)
+		defer cancel()
+	}
+	manifestUrl := fmt.Sprintf("%s/v2/%s/manifests/%s", r.registryUrl(ref.Registry), ref.Repository, ref.ManifestReference())
+	resp, err := r.headManifest(ctx, manifestUrl, "")
+	if err != nil {
+		return false, err
+	}
+	if resp.StatusCode == http.StatusUnauthorized {
+		authorization, err := r.authorize(ctx, ref, resp.Header.Get("WWW-Authenticate"))
+		if err != nil {
+			return false, err
+		}
+		if resp, err = r.headManifest(ctx, manifestUrl, authorization); err != nil {
+			return false, err
+		}
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return true, nil
+	case http.StatusNotFound:
+		return false, nil
+	default:
+		return false, errors.Errorf("unexpected status %s requesting manifest of image %s", resp.Status, ref)
+	}
+}
+
+func (r *Resolver) headManifest(ctx *armadacontext.Context, manifestUrl string, authorization string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, manifestUrl, nil)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	req.Header.Set("Accept", strings.Join(manifestMediaTypes, ", "))
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	resp.Body.Close()
+	return resp, nil
+}
+
+// authorize returns the authorization header to request the manifests of ref with, as per the challenge of its registry.
+// Registries either accept basic authentication, or bearer tokens issued by a token service the challenge refers to.
+func (r *Resolver) authorize(ctx *armadacontext.Context, ref *Reference, challenge string) (string, error) {
+	credentials, hasCredentials := r.config.Credentials[ref.Registry]
+	scheme, params := parseChallenge(challenge)
+	switch scheme {
+	case "basic":
+		if !hasCredentials {
+			return "", errors.Errorf("registry %s requires credentials", ref.Registry)
+		}
+		req := &http.Request{Header: make(http.Header)}
+		req.SetBasicAuth(credentials.Username, credentials.Password)
+		return req.Header.Get("Authorization"), nil
+	case "bearer":
+		tokenUrl, err := url.Parse(params["realm"])
+		if err != nil || params["realm"] == "" {
+			return "", errors.Errorf("registry %s issued bearer challenge without valid realm: %s", ref.Registry, challenge)
+		}
+		// Credentials are only sent in the clear to registries configured to be accessed over plain http.
+		if tokenUrl.Scheme != "https" && !(tokenUrl.Scheme == "http" && slices.Contains(r.config.InsecureRegistries, ref.Registry)) {
+			return "", errors.Errorf("registry %s issued bearer challenge with realm that isn't https: %s", ref.Registry, challenge)
+		}
+		query := tokenUrl.Query()
+		if service, ok := params["service"]; ok {
+			query.Set("service", service)
+		}
+		query.Set("scope", fmt.Sprintf("repository:%s:pull", ref.Repository))
+		tokenUrl.RawQuery = query.Encode()
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, tokenUrl.String(), nil)
+		if err != nil {
+			return "", errors.WithStack(err)
+		}
+		if hasCredentials {
+			req.SetBasicAuth(credentials.Username, credentials.Password)
+		}
+		resp, err := r.client.Do(req)
+		if err != nil {
+			return "", errors.WithStack(err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return "", errors.Errorf("unexpected status %s requesting token for image %s", resp.Status, ref)
+		}
+		var token struct {
+			Token       string `json:"token"`
+			AccessToken string `json:"access_token"`
+		}
+		if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+			return "", errors.Wrapf(err, "error decoding token for image %s", ref)
+		}
+		if token.Token == "" {
+			token.Token = token.AccessToken
+		}
+		return "Bearer " + token.Token, nil
+	default:
+		return "", errors.Errorf("registry %s issued unsupported challenge: %s", ref.Registry, challenge)
+	}
+}
+
+// parseChallenge parses a WWW-Authenticate header, e.g., `Bearer realm="https://auth.docker.io/token",service="x"`,
+// into its lower-cased scheme and its parameters.
+func parseChallenge(challenge string) (string, map[string]string) {
+	scheme, rest, _ := strings.Cut(strings.TrimSpace(challenge), " ")
+	params := make(map[string]string)
+	for rest != "" {
+		var key, value string
+		key, rest, _ = strings.Cut(strings.TrimLeft(rest, " ,"), "=")
+		if strings.HasPrefix(rest, `"`) {
+			value, rest, _ = strings.Cut(rest[1:], `"`)
+		} else {
+			value, rest, _ = strings.Cut(rest, ",")
+		}
+		if key != "" {
+			params[strings.ToLower(strings.TrimSpace(key))] = value
+		}
+	}
+	return strings.ToLower(scheme), params
+}
+
+func (r *Resolver) registryUrl(registry string) string {
+	host := registry
+	if registry == defaultRegistry {
+		host = defaultRegistryHost
+	}
+	if slices.Contains(r.config.InsecureRegistries, registry) {
+		return "http://" + host
+	}
+	return "https://" + host
+}
@@ -0,0 +1,173 @@
+package imageresolver
+
+import (
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/pkg/errors"
+	"golang.org/x/exp/slices"
+	"k8s.io/utils/clock"
+
+	"github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+)
+
+// Resolver checks the images of submitted jobs against the registries they're pulled from, as configured,
+// such that jobs with images that can't be pulled fail at submission rather than with ImagePullBackOff on a cluster.
+type Resolver struct {
+	config configuration.ImageResolverConfig
+	client *http.Client
+	// Images known to exist, mapped to when their existence was verified. Nil if no images are remembered.
+	verifiedImages *lru.Cache
+	clock          clock.Clock
+}
+
+func New(config configuration.ImageResolverConfig) (*Resolver, error) {
+	// Images are pulled from registries named by users, so only the registries operators allow are requested.
+	if config.VerifyExistence && len(config.AllowedRegistries) == 0 {
+		return nil, errors.New("verifying the existence of images requires allowed registries to be configured")
+	}
+	r := &Resolver{
+		config: config,
+		client: newRegistryClient(),
+		clock:  clock.RealClock{},
+	}
+	if config.CacheSize > 0 {
+		cache, err := lru.New(config.CacheSize)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		r.verifiedImages = cache
+	}
+	return r, nil
+}
+
+// newRegistryClient returns a client of registries that refuses to connect to private, loopback and link-local
+// addresses, such that registries, or the token services they refer to, can't be used to reach internal services.
+// Addresses are checked as connections are made, i.e., after resolving host names and for each redirect.
+// Proxies aren't used, since connections to them would be checked instead.
+func newRegistryClient() *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = (&net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+		Control:   refuseNonPublicAddresses,
+	}).DialContext
+	return &http.Client{Transport: transport}
+}
+
+func refuseNonPublicAddresses(_ string, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return errors.Errorf("refusing to connect to invalid address %s", address)
+	}
+	if !isPublic(ip) {
+		return errors.Errorf("refusing to connect to non-public address %s", ip)
+	}
+	return nil
+}
+
+// isPublic returns false for addresses that aren't routable on the internet,
+// e.g., those of the network of the server and of cloud metadata services.
+func isPublic(ip net.IP) bool {
+	return !ip.IsPrivate() &&
+		!ip.IsLoopback() &&
+		!ip.IsLinkLocalUnicast() &&
+		!ip.IsLinkLocalMulticast() &&
+		!ip.IsInterfaceLocalMulticast() &&
+		!ip.IsMulticast() &&
+		!ip.IsUnspecified()
+}
+
+// CheckImages returns the reason for rejecting each of images that violates the registry policy or doesn't exist.
+// Images that aren't rejected are omitted. Each distinct image is checked once.
+func (r *Resolver) CheckImages(ctx *armadacontext.Context, images []string) map[string]error {
+	rejected := make(map[string]error)
+	refsToVerify := make(map[string]*Reference)
+	for _, image := range images {
+		if _, ok := rejected[image]; ok {
+			continue
+		} else if _, ok := refsToVerify[image]; ok {
+			continue
+		}
+		ref, err := r.checkPolicy(image)
+		if err != nil {
+			rejected[image] = err
+		} else if r.config.VerifyExistence && !r.isVerified(ref) {
+			refsToVerify[image] = ref
+		}
+	}
+	if len(refsToVerify) == 0 {
+		return rejected
+	}
+
+	missing := make(chan string, len(refsToVerify))
+	g, ctx := armadacontext.ErrGroup(ctx)
+	if r.config.MaxConcurrentRequests > 0 {
+		g.SetLimit(r.config.MaxConcurrentRequests)
+	}
+	for image, ref := range refsToVerify {
+		image, ref := image, ref
+		g.Go(func() error {
+			exists, err := r.verifyExistence(ctx, ref)
+			if err != nil {
+				// The image may still be pulled by clusters, e.g., with credentials the server doesn't have.
+				ctx.WithError(err).Warnf("admitting image %s without verifying its existence", image)
+			} else if !exists {
+				missing <- image
+			} else if r.verifiedImages != nil {
+				r.verifiedImages.Add(ref.String(), r.clock.Now())
+			}
+			return nil
+		})
+	}
+	_ = g.Wait()
+	close(missing)
+	for image := range missing {
+		ref := refsToVerify[image]
+		rejected[image] = errors.Errorf("image %s doesn't exist in registry %s", image, ref.Registry)
+	}
+	return rejected
+}
+
+// checkPolicy returns the parsed reference of image, or an error if image may not be pulled as per the configuration.
+func (r *Resolver) checkPolicy(image string) (*Reference, error) {
+	ref, err := ParseReference(image)
+	if err != nil {
+		return nil, err
+	}
+	if len(r.config.AllowedRegistries) > 0 && !slices.Contains(r.config.AllowedRegistries, ref.Registry) {
+		return nil, errors.Errorf(
+			"image %s is pulled from registry %s, which isn't one of the allowed registries %v",
+			image, ref.Registry, r.config.AllowedRegistries,
+		)
+	}
+	if r.config.RequireDigest && ref.Digest == "" {
+		return nil, errors.Errorf("image %s must be referenced by digest, e.g., %s@sha256:<digest>", image, image)
+	}
+	return ref, nil
+}
+
+// isVerified returns true if ref was recently verified to exist.
+func (r *Resolver) isVerified(ref *Reference) bool {
+	if r.verifiedImages == nil {
+		return false
+	}
+	verifiedAt, ok := r.verifiedImages.Get(ref.String())
+	if !ok {
+		return false
+	}
+	if r.clock.Since(verifiedAt.(time.Time)) > r.config.CacheTtl {
+		r.verifiedImages.Remove(ref.String())
+		return false
+	}
+	return true
+}
@@ -0,0 +1,169 @@
+package imageresolver
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clock "k8s.io/utils/clock/testing"
+
+	"github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+)
+
+func TestResolver_CheckImages_Policy(t *testing.T) {
+	digest := "sha256:" + strings.Repeat("a", 64)
+	r, err := New(configuration.ImageResolverConfig{
+		AllowedRegistries: []string{"registry.example.com", "docker.io"},
+		RequireDigest:     true,
+	})
+	require.NoError(t, err)
+
+	rejected := r.CheckImages(armadacontext.Background(), []string{
+		"registry.example.com/image@" + digest,
+		"ubuntu@" + digest,
+		"ubuntu:22.04",
+		"other.example.com/image@" + digest,
+		"Invalid",
+	})
+	assert.Len(t, rejected, 3)
+	assert.ErrorContains(t, rejected["ubuntu:22.04"], "must be referenced by digest")
+	assert.ErrorContains(t, rejected["other.example.com/image@"+digest], "isn't one of the allowed registries")
+	assert.ErrorContains(t, rejected["Invalid"], "invalid repository")
+}
+
+func TestResolver_CheckImages_VerifiesExistence(t *testing.T) {
+	registry := newTestRegistry(t, map[string]bool{"/v2/team/image/manifests/1.0": true})
+	defer registry.Close()
+	host := strings.TrimPrefix(registry.URL, "https://")
+
+	r, err := New(configuration.ImageResolverConfig{
+		AllowedRegistries:     []string{host},
+		VerifyExistence:       true,
+		Credentials:           map[string]configuration.RegistryCredentials{host: {Username: "user", Password: "password"}},
+		Timeout:               time.Second,
+		MaxConcurrentRequests: 2,
+		CacheSize:             10,
+		CacheTtl:              time.Minute,
+	})
+	require.NoError(t, err)
+	r.client = registry.Client()
+	now := time.Now()
+	fakeClock := clock.NewFakeClock(now)
+	r.clock = fakeClock
+
+	existing := host + "/team/image:1.0"
+	missing := host + "/team/image:2.0"
+	rejected := r.CheckImages(armadacontext.Background(), []string{existing, missing, existing})
+	assert.Len(t, rejected, 1)
+	assert.ErrorContains(t, rejected[missing], "doesn't exist")
+	assert.Equal(t, int32(2), registry.manifestRequests.Load())
+
+	// Images that exist are remembered until the cache ttl has passed; images that don't exist are checked again.
+	rejected = r.CheckImages(armadacontext.Background(), []string{existing, missing})
+	assert.Len(t, rejected, 1)
+	assert.Equal(t, int32(3), registry.manifestRequests.Load())
+	fakeClock.SetTime(now.Add(2 * time.Minute))
+	rejected = r.CheckImages(armadacontext.Background(), []string{existing})
+	assert.Empty(t, rejected)
+	assert.Equal(t, int32(4), registry.manifestRequests.Load())
+}
+
+func TestResolver_CheckImages_AdmitsImagesOfUnreachableRegistries(t *testing.T) {
+	registry := newTestRegistry(t, nil)
+	host := strings.TrimPrefix(registry.URL, "https://")
+	registry.Close()
+
+	r, err := New(configuration.ImageResolverConfig{AllowedRegistries: []string{host}, VerifyExistence: true, Timeout: time.Second})
+	require.NoError(t, err)
+	rejected := r.CheckImages(armadacontext.Background(), []string{host + "/image"})
+	assert.Empty(t, rejected)
+}
+
+func TestResolver_CheckImages_RefusesToRequestNonPublicAddresses(t *testing.T) {
+	var requests atomic.Int32
+	registry := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer registry.Close()
+	host := strings.TrimPrefix(registry.URL, "http://")
+
+	r, err := New(configuration.ImageResolverConfig{
+		AllowedRegistries:  []string{host},
+		InsecureRegistries: []string{host},
+		VerifyExistence:    true,
+		Timeout:            time.Second,
+	})
+	require.NoError(t, err)
+	rejected := r.CheckImages(armadacontext.Background(), []string{host + "/image"})
+	assert.Empty(t, rejected)
+	assert.Equal(t, int32(0), requests.Load())
+}
+
+func TestNew_VerifyingExistenceRequiresAllowedRegistries(t *testing.T) {
+	_, err := New(configuration.ImageResolverConfig{VerifyExistence: true})
+	assert.Error(t, err)
+}
+
+func TestIsPublic(t *testing.T) {
+	for address, expected := range map[string]bool{
+		"8.8.8.8":         true,
+		"2001:4860::8888": true,
+		"10.0.0.1":        false,
+		"172.16.0.1":      false,
+		"192.168.1.1":     false,
+		"127.0.0.1":       false,
+		"169.254.169.254": false,
+		"0.0.0.0":         false,
+		"::1":             false,
+		"fe80::1":         false,
+		"fd00::1":         false,
+		"::ffff:10.0.0.1": false,
+	} {
+		assert.Equal(t, expected, isPublic(net.ParseIP(address)), address)
+	}
+}
+
+func TestParseChallenge(t *testing.T) {
+	scheme, params := parseChallenge(`Bearer realm="https://auth.docker.io/token",service="registry.docker.io", scope=x`)
+	assert.Equal(t, "bearer", scheme)
+	assert.Equal(t, map[string]string{"realm": "https://auth.docker.io/token", "service": "registry.docker.io", "scope": "x"}, params)
+}
+
+type testRegistry struct {
+	*httptest.Server
+	manifestRequests atomic.Int32
+}
+
+// newTestRegistry returns a registry with the given manifest paths, requiring bearer tokens issued for user.
+func newTestRegistry(t *testing.T, manifests map[string]bool) *testRegistry {
+	registry := &testRegistry{}
+	registry.Server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path == "/token" {
+			username, password, ok := req.BasicAuth()
+			assert.True(t, ok && username == "user" && password == "password")
+			assert.Equal(t, "registry", req.URL.Query().Get("service"))
+			_, _ = fmt.Fprint(w, `{"token": "secret"}`)
+			return
+		}
+		if req.Header.Get("Authorization") != "Bearer secret" {
+			w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="%s/token",service="registry"`, registry.URL))
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		assert.Equal(t, http.MethodHead, req.Method)
+		registry.manifestRequests.Add(1)
+		if !manifests[req.URL.Path] {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	return registry
+}
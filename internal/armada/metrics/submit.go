@@ -0,0 +1,165 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Reasons submitted jobs are rejected for.
+const (
+	// Jobs are invalid, e.g., they have invalid pod specs, or they're submitted to an archived queue.
+	RejectionReasonValidation = "validation"
+	// Jobs have images that violate the registry policy or don't exist.
+	RejectionReasonImage = "image"
+	// Jobs are denied by the submission policy.
+	RejectionReasonPolicy = "policy"
+	// Jobs would exceed the resource quotas of their queue.
+	RejectionReasonQuota = "quota"
+	// Jobs request resources of exhausted budgets of their queue.
+	RejectionReasonBudget = "budget"
+	// Jobs can't be scheduled onto any cluster.
+	RejectionReasonUnschedulable = "unschedulable"
+)
+
+// Stages of SubmitJobs, whose latencies are measured separately.
+const (
+	SubmitStageAuthorize        = "authorize"
+	SubmitStageValidate         = "validate"
+	SubmitStageCheckImages      = "check_images"
+	SubmitStageEvaluatePolicy   = "evaluate_policy"
+	SubmitStageCheckSchedulable = "check_schedulable"
+	SubmitStageDeduplicate      = "deduplicate"
+	SubmitStageCreateEvents     = "create_events"
+	SubmitStagePublish          = "publish"
+)
+
+// SubmitMetrics counts the outcomes of submitting, cancelling and reprioritizing jobs, by queue and user,
+// and measures the latency of the stages of SubmitJobs.
+// It's a prometheus.Collector, to be registered by the caller. A nil *SubmitMetrics records nothing.
+type SubmitMetrics struct {
+	jobsSubmitted        *prometheus.CounterVec
+	jobsRejected         *prometheus.CounterVec
+	jobsDuplicate        *prometheus.CounterVec
+	jobsCancelled        *prometheus.CounterVec
+	jobSetsCancelled     *prometheus.CounterVec
+	jobsReprioritized    *prometheus.CounterVec
+	jobSetsReprioritized *prometheus.CounterVec
+	submitStageLatencies *prometheus.HistogramVec
+}
+
+func NewSubmitMetrics() *SubmitMetrics {
+	newCounterVec := func(name string, help string, labels ...string) *prometheus.CounterVec {
+		return prometheus.NewCounterVec(
+			prometheus.CounterOpts{Namespace: "armada", Subsystem: "submit", Name: name, Help: help},
+			append([]string{"queue", "user"}, labels...),
+		)
+	}
+	return &SubmitMetrics{
+		jobsSubmitted: newCounterVec("jobs_submitted", "Number of jobs submitted."),
+		jobsRejected: newCounterVec(
+			"jobs_rejected", "Number of jobs rejected at submission, by reason the submission was rejected for.", "reason",
+		),
+		jobsDuplicate:        newCounterVec("jobs_duplicate", "Number of jobs submitted with the client id of a previously submitted job."),
+		jobsCancelled:        newCounterVec("jobs_cancelled", "Number of jobs cancelled by id."),
+		jobSetsCancelled:     newCounterVec("job_sets_cancelled", "Number of job sets cancelled."),
+		jobsReprioritized:    newCounterVec("jobs_reprioritized", "Number of jobs reprioritized by id."),
+		jobSetsReprioritized: newCounterVec("job_sets_reprioritized", "Number of job sets reprioritized."),
+		submitStageLatencies: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Namespace: "armada",
+				Subsystem: "submit",
+				Name:      "stage_latency_seconds",
+				Help:      "Latency of the stages of SubmitJobs calls.",
+				Buckets:   prometheus.ExponentialBuckets(0.001, 2, 15),
+			},
+			[]string{"stage"},
+		),
+	}
+}
+
+func (m *SubmitMetrics) collectors() []prometheus.Collector {
+	return []prometheus.Collector{
+		m.jobsSubmitted,
+		m.jobsRejected,
+		m.jobsDuplicate,
+		m.jobsCancelled,
+		m.jobSetsCancelled,
+		m.jobsReprioritized,
+		m.jobSetsReprioritized,
+		m.submitStageLatencies,
+	}
+}
+
+func (m *SubmitMetrics) Describe(desc chan<- *prometheus.Desc) {
+	for _, collector := range m.collectors() {
+		collector.Describe(desc)
+	}
+}
+
+func (m *SubmitMetrics) Collect(metrics chan<- prometheus.Metric) {
+	for _, collector := range m.collectors() {
+		collector.Collect(metrics)
+	}
+}
+
+func (m *SubmitMetrics) RecordJobsSubmitted(queue string, user string, n int) {
+	if m != nil {
+		m.jobsSubmitted.WithLabelValues(queue, user).Add(float64(n))
+	}
+}
+
+func (m *SubmitMetrics) RecordJobsRejected(queue string, user string, reason string, n int) {
+	if m != nil {
+		m.jobsRejected.WithLabelValues(queue, user, reason).Add(float64(n))
+	}
+}
+
+func (m *SubmitMetrics) RecordJobsDuplicate(queue string, user string, n int) {
+	if m != nil {
+		m.jobsDuplicate.WithLabelValues(queue, user).Add(float64(n))
+	}
+}
+
+func (m *SubmitMetrics) RecordJobsCancelled(queue string, user string, n int) {
+	if m != nil {
+		m.jobsCancelled.WithLabelValues(queue, user).Add(float64(n))
+	}
+}
+
+func (m *SubmitMetrics) RecordJobSetCancelled(queue string, user string) {
+	if m != nil {
+		m.jobSetsCancelled.WithLabelValues(queue, user).Inc()
+	}
+}
+
+func (m *SubmitMetrics) RecordJobsReprioritized(queue string, user string, n int) {
+	if m != nil {
+		m.jobsReprioritized.WithLabelValues(queue, user).Add(float64(n))
+	}
+}
+
+func (m *SubmitMetrics) RecordJobSetReprioritized(queue string, user string) {
+	if m != nil {
+		m.jobSetsReprioritized.WithLabelValues(queue, user).Inc()
+	}
+}
+
+// SubmitStageTimer measures the latencies of the consecutive stages of a single SubmitJobs call.
+type SubmitStageTimer struct {
+	metrics    *SubmitMetrics
+	stageStart time.Time
+}
+
+func (m *SubmitMetrics) NewSubmitStageTimer() *SubmitStageTimer {
+	return &SubmitStageTimer{metrics: m, stageStart: time.Now()}
+}
+
+// Done records the latency of stage, i.e., the time since the previous stage was done, and starts the next stage.
+func (t *SubmitStageTimer) Done(stage string) {
+	now := time.Now()
+	if t.metrics != nil {
+		t.metrics.submitStageLatencies.WithLabelValues(stage).Observe(now.Sub(t.stageStart).Seconds())
+	}
+	t.stageStart = now
+}
@@ -0,0 +1,36 @@
+package metrics
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubmitMetrics(t *testing.T) {
+	m := NewSubmitMetrics()
+	registry := prometheus.NewRegistry()
+	require.NoError(t, registry.Register(m))
+
+	m.RecordJobsSubmitted("queue", "alice", 3)
+	m.RecordJobsSubmitted("queue", "alice", 2)
+	m.RecordJobsRejected("queue", "alice", RejectionReasonPolicy, 4)
+	m.RecordJobSetCancelled("queue", "bob")
+	timer := m.NewSubmitStageTimer()
+	timer.Done(SubmitStageAuthorize)
+	timer.Done(SubmitStageValidate)
+
+	assert.Equal(t, 5.0, testutil.ToFloat64(m.jobsSubmitted.WithLabelValues("queue", "alice")))
+	assert.Equal(t, 4.0, testutil.ToFloat64(m.jobsRejected.WithLabelValues("queue", "alice", RejectionReasonPolicy)))
+	assert.Equal(t, 1.0, testutil.ToFloat64(m.jobSetsCancelled.WithLabelValues("queue", "bob")))
+	assert.Equal(t, 2, testutil.CollectAndCount(m.submitStageLatencies))
+}
+
+func TestSubmitMetrics_Nil(t *testing.T) {
+	var m *SubmitMetrics
+	m.RecordJobsSubmitted("queue", "alice", 1)
+	m.RecordJobsReprioritized("queue", "alice", 1)
+	m.NewSubmitStageTimer().Done(SubmitStageAuthorize)
+}
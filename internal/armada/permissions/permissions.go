@@ -6,12 +6,25 @@ import "github.com/armadaproject/armada/internal/common/auth/permission"
 // These are the possible permissions.
 // For each gRPC call, the call handler first checks if the user has permissions for that call.
 const (
-	SubmitAnyJobs       permission.Permission = "submit_any_jobs"
-	CancelAnyJobs                             = "cancel_any_jobs"
-	ReprioritizeAnyJobs                       = "reprioritize_any_jobs"
-	WatchAllEvents                            = "watch_all_events"
-	CreateQueue                               = "create_queue"
-	DeleteQueue                               = "delete_queue"
-	ExecuteJobs                               = "execute_jobs"
-	CordonNodes                               = "cordon_nodes"
+	SubmitAnyJobs            permission.Permission = "submit_any_jobs"
+	CancelAnyJobs                                  = "cancel_any_jobs"
+	ReprioritizeAnyJobs                            = "reprioritize_any_jobs"
+	WatchAllEvents                                 = "watch_all_events"
+	CreateQueue                                    = "create_queue"
+	DeleteQueue                                    = "delete_queue"
+	ExecuteJobs                                    = "execute_jobs"
+	CordonNodes                                    = "cordon_nodes"
+	RunTestMode                                    = "run_test_mode"
+	ManageExecutorKeys                             = "manage_executor_keys"
+	ReplayEvents                                   = "replay_events"
+	PreemptAnyJobs                                 = "preempt_any_jobs"
+	ViewUsageReports                               = "view_usage_reports"
+	SetFairShareWeights                            = "set_fair_share_weights"
+	CordonQueues                                   = "cordon_queues"
+	CordonExecutors                                = "cordon_executors"
+	ManageMaintenanceWindows                       = "manage_maintenance_windows"
+	ExecAnyJobs                                    = "exec_any_jobs"
+	ImpersonateUsers                               = "impersonate_users"
+	ManageApiTokens                                = "manage_api_tokens"
+	ViewExecutors                                  = "view_executors"
 )
@@ -0,0 +1,182 @@
+package repository
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+const (
+	apiTokenPrefix      = "ApiToken:"
+	apiTokensByOwnerKey = "ApiTokens:"
+	// Tokens are kept for this long after they expire, such that they can still be listed.
+	expiredApiTokenRetention = 7 * 24 * time.Hour
+)
+
+type ErrApiTokenNotFound struct {
+	Id string
+}
+
+func (err *ErrApiTokenNotFound) Error() string {
+	return fmt.Sprintf("could not find api token %q", err.Id)
+}
+
+// ApiTokenRepository stores tokens issued by users to act on their behalf.
+// Only a hash of the secret part of each token is stored.
+type ApiTokenRepository interface {
+	AddApiToken(token *api.ApiToken, secretHash []byte) error
+	// UpdateApiToken overwrites the metadata of an existing token, e.g., to revoke it.
+	UpdateApiToken(token *api.ApiToken) error
+	// GetApiToken returns the token with the given id and the hash of its secret.
+	GetApiToken(id string) (*api.ApiToken, []byte, error)
+	// GetApiTokens returns all tokens of the given owner that haven't yet been cleaned up.
+	GetApiTokens(owner string) ([]*api.ApiToken, error)
+	// MarkApiTokenUsed sets the time at which a token was most recently used.
+	MarkApiTokenUsed(id string, t time.Time) error
+}
+
+type RedisApiTokenRepository struct {
+	db redis.UniversalClient
+}
+
+func NewRedisApiTokenRepository(db redis.UniversalClient) *RedisApiTokenRepository {
+	return &RedisApiTokenRepository{db: db}
+}
+
+func (r *RedisApiTokenRepository) AddApiToken(token *api.ApiToken, secretHash []byte) error {
+	data, err := proto.Marshal(token)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	key := apiTokenKey(token.Id)
+	pipe := r.db.TxPipeline()
+	pipe.HMSet(key, map[string]interface{}{
+		"token":      data,
+		"secretHash": secretHash,
+	})
+	pipe.ExpireAt(key, token.Expires.Add(expiredApiTokenRetention))
+	pipe.SAdd(apiTokensByOwnerKey+token.Owner, token.Id)
+	if _, err := pipe.Exec(); err != nil {
+		return errors.Wrapf(err, "[RedisApiTokenRepository.AddApiToken] error writing token %s", token.Id)
+	}
+	return nil
+}
+
+func (r *RedisApiTokenRepository) UpdateApiToken(token *api.ApiToken) error {
+	data, err := proto.Marshal(token)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	updated, err := updateApiTokenScript.Run(
+		r.db,
+		[]string{apiTokenKey(token.Id)},
+		data,
+		token.Expires.Add(expiredApiTokenRetention).Unix(),
+	).Int()
+	if err != nil {
+		return errors.Wrapf(err, "[RedisApiTokenRepository.UpdateApiToken] error writing token %s", token.Id)
+	}
+	if updated == 0 {
+		return &ErrApiTokenNotFound{Id: token.Id}
+	}
+	return nil
+}
+
+func (r *RedisApiTokenRepository) GetApiToken(id string) (*api.ApiToken, []byte, error) {
+	fields, err := r.db.HGetAll(apiTokenKey(id)).Result()
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "[RedisApiTokenRepository.GetApiToken] error reading token %s", id)
+	}
+	if len(fields) == 0 {
+		return nil, nil, &ErrApiTokenNotFound{Id: id}
+	}
+	token, err := apiTokenFromFields(fields)
+	if err != nil {
+		return nil, nil, errors.WithMessagef(err, "[RedisApiTokenRepository.GetApiToken] error decoding token %s", id)
+	}
+	return token, []byte(fields["secretHash"]), nil
+}
+
+func (r *RedisApiTokenRepository) GetApiTokens(owner string) ([]*api.ApiToken, error) {
+	ids, err := r.db.SMembers(apiTokensByOwnerKey + owner).Result()
+	if err != nil {
+		return nil, errors.Wrapf(err, "[RedisApiTokenRepository.GetApiTokens] error reading tokens of %s", owner)
+	}
+	pipe := r.db.Pipeline()
+	cmds := make([]*redis.StringStringMapCmd, len(ids))
+	for i, id := range ids {
+		cmds[i] = pipe.HGetAll(apiTokenKey(id))
+	}
+	if _, err := pipe.Exec(); err != nil {
+		return nil, errors.Wrapf(err, "[RedisApiTokenRepository.GetApiTokens] error reading tokens of %s", owner)
+	}
+	tokens := make([]*api.ApiToken, 0, len(ids))
+	var expiredIds []interface{}
+	for i, cmd := range cmds {
+		fields := cmd.Val()
+		if len(fields) == 0 {
+			expiredIds = append(expiredIds, ids[i])
+			continue
+		}
+		token, err := apiTokenFromFields(fields)
+		if err != nil {
+			return nil, errors.WithMessagef(err, "[RedisApiTokenRepository.GetApiTokens] error decoding token %s", ids[i])
+		}
+		tokens = append(tokens, token)
+	}
+	if len(expiredIds) > 0 {
+		if err := r.db.SRem(apiTokensByOwnerKey+owner, expiredIds...).Err(); err != nil {
+			return nil, errors.Wrapf(err, "[RedisApiTokenRepository.GetApiTokens] error removing expired tokens of %s", owner)
+		}
+	}
+	return tokens, nil
+}
+
+func (r *RedisApiTokenRepository) MarkApiTokenUsed(id string, t time.Time) error {
+	if err := markApiTokenUsedScript.Run(r.db, []string{apiTokenKey(id)}, t.UnixNano()).Err(); err != nil {
+		return errors.Wrapf(err, "[RedisApiTokenRepository.MarkApiTokenUsed] error updating token %s", id)
+	}
+	return nil
+}
+
+func apiTokenFromFields(fields map[string]string) (*api.ApiToken, error) {
+	token := &api.ApiToken{}
+	if err := proto.Unmarshal([]byte(fields["token"]), token); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if lastUsed, ok := fields["lastUsed"]; ok {
+		nanos, err := strconv.ParseInt(lastUsed, 10, 64)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		t := time.Unix(0, nanos).UTC()
+		token.LastUsed = &t
+	}
+	return token, nil
+}
+
+func apiTokenKey(id string) string {
+	return apiTokenPrefix + id
+}
+
+var updateApiTokenScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 0 then
+	return 0
+end
+redis.call('HSET', KEYS[1], 'token', ARGV[1])
+redis.call('EXPIREAT', KEYS[1], ARGV[2])
+return 1
+`)
+
+var markApiTokenUsedScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 1 then
+	redis.call('HSET', KEYS[1], 'lastUsed', ARGV[1])
+end
+return 0
+`)
@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+func TestApiTokens(t *testing.T) {
+	withApiTokenRepository(func(r *RedisApiTokenRepository) {
+		created := time.Now().UTC().Truncate(time.Second)
+		token := &api.ApiToken{
+			Id:          "token-1",
+			Name:        "ci",
+			Owner:       "alice",
+			OwnerGroups: []string{"ml-team"},
+			Scopes:      []*api.ApiTokenScope{{Queue: "ml-training", Verbs: []string{"submit"}}},
+			Created:     created,
+			Expires:     created.Add(24 * time.Hour),
+		}
+		require.NoError(t, r.AddApiToken(token, []byte("hash")))
+		require.NoError(t, r.AddApiToken(&api.ApiToken{
+			Id:      "token-2",
+			Owner:   "bob",
+			Created: created,
+			Expires: created.Add(24 * time.Hour),
+		}, []byte("otherHash")))
+
+		actual, hash, err := r.GetApiToken("token-1")
+		require.NoError(t, err)
+		assert.Equal(t, token, actual)
+		assert.Equal(t, []byte("hash"), hash)
+
+		lastUsed := created.Add(time.Hour)
+		require.NoError(t, r.MarkApiTokenUsed("token-1", lastUsed))
+		revoked := created.Add(2 * time.Hour)
+		token.Revoked = &revoked
+		require.NoError(t, r.UpdateApiToken(token))
+
+		tokens, err := r.GetApiTokens("alice")
+		require.NoError(t, err)
+		require.Len(t, tokens, 1)
+		assert.Equal(t, revoked, *tokens[0].Revoked)
+		assert.Equal(t, lastUsed, *tokens[0].LastUsed)
+
+		// Updating the metadata doesn't affect the secret.
+		_, hash, err = r.GetApiToken("token-1")
+		require.NoError(t, err)
+		assert.Equal(t, []byte("hash"), hash)
+	})
+}
+
+func TestApiTokens_NotFound(t *testing.T) {
+	withApiTokenRepository(func(r *RedisApiTokenRepository) {
+		var notFoundErr *ErrApiTokenNotFound
+		_, _, err := r.GetApiToken("unknown")
+		assert.ErrorAs(t, err, &notFoundErr)
+		err = r.UpdateApiToken(&api.ApiToken{Id: "unknown"})
+		assert.ErrorAs(t, err, &notFoundErr)
+
+		// Marking unknown tokens as used must not create them.
+		require.NoError(t, r.MarkApiTokenUsed("unknown", time.Now()))
+		_, _, err = r.GetApiToken("unknown")
+		assert.ErrorAs(t, err, &notFoundErr)
+
+		tokens, err := r.GetApiTokens("alice")
+		require.NoError(t, err)
+		assert.Empty(t, tokens)
+	})
+}
+
+func withApiTokenRepository(action func(r *RedisApiTokenRepository)) {
+	client := redis.NewClient(&redis.Options{Addr: "localhost:6379", DB: 10})
+	defer client.FlushDB()
+	defer client.Close()
+	client.FlushDB()
+	action(NewRedisApiTokenRepository(client))
+}
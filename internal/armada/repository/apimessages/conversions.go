@@ -51,6 +51,28 @@ func FromEventSequence(es *armadaevents.EventSequence) ([]*api.EventMessage, err
 			convertedEvents, err = FromInternalStandaloneIngressInfo(es.Queue, es.JobSetName, *event.Created, esEvent.StandaloneIngressInfo)
 		case *armadaevents.EventSequence_Event_JobRunPreempted:
 			convertedEvents, err = FromInternalJobRunPreempted(es.Queue, es.JobSetName, *event.Created, esEvent.JobRunPreempted)
+		case *armadaevents.EventSequence_Event_JobSetExpired:
+			convertedEvents, err = FromInternalJobSetExpired(es.Queue, es.JobSetName, *event.Created, esEvent.JobSetExpired)
+		case *armadaevents.EventSequence_Event_JobSuspended:
+			convertedEvents, err = FromInternalJobSuspended(es.UserId, es.Queue, es.JobSetName, *event.Created, esEvent.JobSuspended)
+		case *armadaevents.EventSequence_Event_JobResumed:
+			convertedEvents, err = FromInternalJobResumed(es.UserId, es.Queue, es.JobSetName, *event.Created, esEvent.JobResumed)
+		case *armadaevents.EventSequence_Event_JobEvictedForCapacity:
+			convertedEvents, err = FromInternalJobEvictedForCapacity(es.Queue, es.JobSetName, *event.Created, esEvent.JobEvictedForCapacity)
+		case *armadaevents.EventSequence_Event_JobRuntimeExceeded:
+			convertedEvents, err = FromInternalJobRuntimeExceeded(es.Queue, es.JobSetName, *event.Created, esEvent.JobRuntimeExceeded)
+		case *armadaevents.EventSequence_Event_JobResourcesNormalized:
+			convertedEvents, err = FromInternalJobResourcesNormalized(es.Queue, es.JobSetName, *event.Created, esEvent.JobResourcesNormalized)
+		case *armadaevents.EventSequence_Event_JobUnschedulable:
+			convertedEvents, err = FromInternalJobUnschedulable(es.Queue, es.JobSetName, *event.Created, esEvent.JobUnschedulable)
+		case *armadaevents.EventSequence_Event_JobMaintenanceWindowStarted:
+			convertedEvents, err = FromInternalJobMaintenanceWindowStarted(es.Queue, es.JobSetName, *event.Created, esEvent.JobMaintenanceWindowStarted)
+		case *armadaevents.EventSequence_Event_JobMaintenanceWindowEnded:
+			convertedEvents, err = FromInternalJobMaintenanceWindowEnded(es.Queue, es.JobSetName, *event.Created, esEvent.JobMaintenanceWindowEnded)
+		case *armadaevents.EventSequence_Event_JobCordoned:
+			convertedEvents, err = FromInternalJobCordoned(es.Queue, es.JobSetName, *event.Created, esEvent.JobCordoned)
+		case *armadaevents.EventSequence_Event_JobUncordoned:
+			convertedEvents, err = FromInternalJobUncordoned(es.Queue, es.JobSetName, *event.Created, esEvent.JobUncordoned)
 		case *armadaevents.EventSequence_Event_ReprioritiseJobSet,
 			*armadaevents.EventSequence_Event_CancelJobSet,
 			*armadaevents.EventSequence_Event_JobRunSucceeded,
@@ -153,6 +175,247 @@ func FromInternalCancelled(userId string, queueName string, jobSetName string, t
 	}, nil
 }
 
+func FromInternalJobSetExpired(queueName string, jobSetName string, time time.Time, e *armadaevents.JobSetExpired) ([]*api.EventMessage, error) {
+	jobId, err := armadaevents.UlidStringFromProtoUuid(e.JobId)
+	if err != nil {
+		return nil, err
+	}
+
+	return []*api.EventMessage{
+		{
+			Events: &api.EventMessage_JobSetExpired{
+				JobSetExpired: &api.JobSetExpiredEvent{
+					JobId:            jobId,
+					JobSetId:         jobSetName,
+					Queue:            queueName,
+					Created:          time,
+					JobSetTtlSeconds: e.JobSetTtlSeconds,
+				},
+			},
+		},
+	}, nil
+}
+
+func FromInternalJobSuspended(userId string, queueName string, jobSetName string, time time.Time, e *armadaevents.JobSuspended) ([]*api.EventMessage, error) {
+	jobId, err := armadaevents.UlidStringFromProtoUuid(e.JobId)
+	if err != nil {
+		return nil, err
+	}
+
+	return []*api.EventMessage{
+		{
+			Events: &api.EventMessage_Suspended{
+				Suspended: &api.JobSuspendedEvent{
+					JobId:     jobId,
+					JobSetId:  jobSetName,
+					Queue:     queueName,
+					Created:   time,
+					Requestor: userId,
+				},
+			},
+		},
+	}, nil
+}
+
+func FromInternalJobResumed(userId string, queueName string, jobSetName string, time time.Time, e *armadaevents.JobResumed) ([]*api.EventMessage, error) {
+	jobId, err := armadaevents.UlidStringFromProtoUuid(e.JobId)
+	if err != nil {
+		return nil, err
+	}
+
+	return []*api.EventMessage{
+		{
+			Events: &api.EventMessage_Resumed{
+				Resumed: &api.JobResumedEvent{
+					JobId:     jobId,
+					JobSetId:  jobSetName,
+					Queue:     queueName,
+					Created:   time,
+					Requestor: userId,
+				},
+			},
+		},
+	}, nil
+}
+
+func FromInternalJobEvictedForCapacity(queueName string, jobSetName string, time time.Time, e *armadaevents.JobEvictedForCapacity) ([]*api.EventMessage, error) {
+	jobId, err := armadaevents.UlidStringFromProtoUuid(e.JobId)
+	if err != nil {
+		return nil, err
+	}
+
+	return []*api.EventMessage{
+		{
+			Events: &api.EventMessage_EvictedForCapacity{
+				EvictedForCapacity: &api.JobEvictedForCapacityEvent{
+					JobId:     jobId,
+					JobSetId:  jobSetName,
+					Queue:     queueName,
+					Created:   time,
+					ClusterId: e.ExecutorId,
+				},
+			},
+		},
+	}, nil
+}
+
+func FromInternalJobRuntimeExceeded(queueName string, jobSetName string, time time.Time, e *armadaevents.JobRuntimeExceeded) ([]*api.EventMessage, error) {
+	jobId, err := armadaevents.UlidStringFromProtoUuid(e.JobId)
+	if err != nil {
+		return nil, err
+	}
+
+	return []*api.EventMessage{
+		{
+			Events: &api.EventMessage_RuntimeExceeded{
+				RuntimeExceeded: &api.JobRuntimeExceededEvent{
+					JobId:             jobId,
+					JobSetId:          jobSetName,
+					Queue:             queueName,
+					Created:           time,
+					ClusterId:         e.ExecutorId,
+					MaxRuntimeSeconds: e.MaxRuntimeSeconds,
+				},
+			},
+		},
+	}, nil
+}
+
+func FromInternalJobResourcesNormalized(queueName string, jobSetName string, time time.Time, e *armadaevents.JobResourcesNormalized) ([]*api.EventMessage, error) {
+	jobId, err := armadaevents.UlidStringFromProtoUuid(e.JobId)
+	if err != nil {
+		return nil, err
+	}
+
+	return []*api.EventMessage{
+		{
+			Events: &api.EventMessage_ResourcesNormalized{
+				ResourcesNormalized: &api.JobResourcesNormalizedEvent{
+					JobId:               jobId,
+					JobSetId:            jobSetName,
+					Queue:               queueName,
+					Created:             time,
+					OriginalResources:   e.OriginalResources,
+					NormalizedResources: e.NormalizedResources,
+				},
+			},
+		},
+	}, nil
+}
+
+func FromInternalJobUnschedulable(queueName string, jobSetName string, time time.Time, e *armadaevents.JobUnschedulable) ([]*api.EventMessage, error) {
+	jobId, err := armadaevents.UlidStringFromProtoUuid(e.JobId)
+	if err != nil {
+		return nil, err
+	}
+
+	return []*api.EventMessage{
+		{
+			Events: &api.EventMessage_Unschedulable{
+				Unschedulable: &api.JobUnschedulableEvent{
+					JobId:    jobId,
+					JobSetId: jobSetName,
+					Queue:    queueName,
+					Created:  time,
+					Reason:   e.Reason,
+				},
+			},
+		},
+	}, nil
+}
+
+func FromInternalJobMaintenanceWindowStarted(queueName string, jobSetName string, time time.Time, e *armadaevents.JobMaintenanceWindowStarted) ([]*api.EventMessage, error) {
+	jobId, err := armadaevents.UlidStringFromProtoUuid(e.JobId)
+	if err != nil {
+		return nil, err
+	}
+
+	return []*api.EventMessage{
+		{
+			Events: &api.EventMessage_MaintenanceWindowStarted{
+				MaintenanceWindowStarted: &api.JobMaintenanceWindowStartedEvent{
+					JobId:               jobId,
+					JobSetId:            jobSetName,
+					Queue:               queueName,
+					Created:             time,
+					MaintenanceWindowId: e.MaintenanceWindowId,
+					Executor:            e.Executor,
+					End:                 e.End,
+					Drain:               e.Drain,
+					Reason:              e.Reason,
+				},
+			},
+		},
+	}, nil
+}
+
+func FromInternalJobMaintenanceWindowEnded(queueName string, jobSetName string, time time.Time, e *armadaevents.JobMaintenanceWindowEnded) ([]*api.EventMessage, error) {
+	jobId, err := armadaevents.UlidStringFromProtoUuid(e.JobId)
+	if err != nil {
+		return nil, err
+	}
+
+	return []*api.EventMessage{
+		{
+			Events: &api.EventMessage_MaintenanceWindowEnded{
+				MaintenanceWindowEnded: &api.JobMaintenanceWindowEndedEvent{
+					JobId:               jobId,
+					JobSetId:            jobSetName,
+					Queue:               queueName,
+					Created:             time,
+					MaintenanceWindowId: e.MaintenanceWindowId,
+					Executor:            e.Executor,
+				},
+			},
+		},
+	}, nil
+}
+
+func FromInternalJobCordoned(queueName string, jobSetName string, time time.Time, e *armadaevents.JobCordoned) ([]*api.EventMessage, error) {
+	jobId, err := armadaevents.UlidStringFromProtoUuid(e.JobId)
+	if err != nil {
+		return nil, err
+	}
+
+	return []*api.EventMessage{
+		{
+			Events: &api.EventMessage_Cordoned{
+				Cordoned: &api.JobCordonedEvent{
+					JobId:      jobId,
+					JobSetId:   jobSetName,
+					Queue:      queueName,
+					Created:    time,
+					Executor:   e.Executor,
+					CordonedBy: e.CordonedBy,
+					Reason:     e.Reason,
+				},
+			},
+		},
+	}, nil
+}
+
+func FromInternalJobUncordoned(queueName string, jobSetName string, time time.Time, e *armadaevents.JobUncordoned) ([]*api.EventMessage, error) {
+	jobId, err := armadaevents.UlidStringFromProtoUuid(e.JobId)
+	if err != nil {
+		return nil, err
+	}
+
+	return []*api.EventMessage{
+		{
+			Events: &api.EventMessage_Uncordoned{
+				Uncordoned: &api.JobUncordonedEvent{
+					JobId:        jobId,
+					JobSetId:     jobSetName,
+					Queue:        queueName,
+					Created:      time,
+					Executor:     e.Executor,
+					UncordonedBy: e.UncordonedBy,
+				},
+			},
+		},
+	}, nil
+}
+
 func FromInternalReprioritiseJob(userId string, queueName string, jobSetName string, time time.Time, e *armadaevents.ReprioritiseJob) ([]*api.EventMessage, error) {
 	jobId, err := armadaevents.UlidStringFromProtoUuid(e.JobId)
 	if err != nil {
@@ -233,6 +496,7 @@ func FromInternalLogJobRunLeased(queueName string, jobSetName string, time time.
 					Queue:     queueName,
 					Created:   time,
 					ClusterId: e.ExecutorId,
+					Pool:      e.Pool,
 				},
 			},
 		},
@@ -452,6 +716,8 @@ func FromInternalJobRunRunning(queueName string, jobSetName string, time time.Ti
 		apiEvent.KubernetesId = ri.GetObjectMeta().GetKubernetesId()
 		apiEvent.NodeName = ri.GetPodInfo().GetNodeName()
 		apiEvent.PodNumber = ri.GetPodInfo().GetPodNumber()
+		apiEvent.Pool = ri.GetPodInfo().GetPool()
+		apiEvent.NodeLabels = ri.GetPodInfo().GetNodeLabels()
 	}
 
 	return []*api.EventMessage{
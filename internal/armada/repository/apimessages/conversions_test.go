@@ -180,6 +180,341 @@ func TestConvertCancelled(t *testing.T) {
 	assert.Equal(t, expected, apiEvents)
 }
 
+func TestConvertJobSetExpired(t *testing.T) {
+	expired := &armadaevents.EventSequence_Event{
+		Created: &baseTime,
+		Event: &armadaevents.EventSequence_Event_JobSetExpired{
+			JobSetExpired: &armadaevents.JobSetExpired{
+				JobId:            jobIdProto,
+				JobSetTtlSeconds: 3600,
+			},
+		},
+	}
+
+	expected := []*api.EventMessage{
+		{
+			Events: &api.EventMessage_JobSetExpired{
+				JobSetExpired: &api.JobSetExpiredEvent{
+					JobId:            jobIdString,
+					JobSetId:         jobSetName,
+					Queue:            queue,
+					Created:          baseTime,
+					JobSetTtlSeconds: 3600,
+				},
+			},
+		},
+	}
+
+	apiEvents, err := FromEventSequence(toEventSeq(expired))
+	assert.NoError(t, err)
+	assert.Equal(t, expected, apiEvents)
+}
+
+func TestConvertJobSuspended(t *testing.T) {
+	suspended := &armadaevents.EventSequence_Event{
+		Created: &baseTime,
+		Event: &armadaevents.EventSequence_Event_JobSuspended{
+			JobSuspended: &armadaevents.JobSuspended{
+				JobId: jobIdProto,
+			},
+		},
+	}
+
+	expected := []*api.EventMessage{
+		{
+			Events: &api.EventMessage_Suspended{
+				Suspended: &api.JobSuspendedEvent{
+					JobId:     jobIdString,
+					JobSetId:  jobSetName,
+					Queue:     queue,
+					Created:   baseTime,
+					Requestor: userId,
+				},
+			},
+		},
+	}
+
+	apiEvents, err := FromEventSequence(toEventSeq(suspended))
+	assert.NoError(t, err)
+	assert.Equal(t, expected, apiEvents)
+}
+
+func TestConvertJobResumed(t *testing.T) {
+	resumed := &armadaevents.EventSequence_Event{
+		Created: &baseTime,
+		Event: &armadaevents.EventSequence_Event_JobResumed{
+			JobResumed: &armadaevents.JobResumed{
+				JobId: jobIdProto,
+			},
+		},
+	}
+
+	expected := []*api.EventMessage{
+		{
+			Events: &api.EventMessage_Resumed{
+				Resumed: &api.JobResumedEvent{
+					JobId:     jobIdString,
+					JobSetId:  jobSetName,
+					Queue:     queue,
+					Created:   baseTime,
+					Requestor: userId,
+				},
+			},
+		},
+	}
+
+	apiEvents, err := FromEventSequence(toEventSeq(resumed))
+	assert.NoError(t, err)
+	assert.Equal(t, expected, apiEvents)
+}
+
+func TestConvertJobEvictedForCapacity(t *testing.T) {
+	evicted := &armadaevents.EventSequence_Event{
+		Created: &baseTime,
+		Event: &armadaevents.EventSequence_Event_JobEvictedForCapacity{
+			JobEvictedForCapacity: &armadaevents.JobEvictedForCapacity{
+				JobId:      jobIdProto,
+				ExecutorId: executorId,
+			},
+		},
+	}
+
+	expected := []*api.EventMessage{
+		{
+			Events: &api.EventMessage_EvictedForCapacity{
+				EvictedForCapacity: &api.JobEvictedForCapacityEvent{
+					JobId:     jobIdString,
+					JobSetId:  jobSetName,
+					Queue:     queue,
+					Created:   baseTime,
+					ClusterId: executorId,
+				},
+			},
+		},
+	}
+
+	apiEvents, err := FromEventSequence(toEventSeq(evicted))
+	assert.NoError(t, err)
+	assert.Equal(t, expected, apiEvents)
+}
+
+func TestConvertJobRuntimeExceeded(t *testing.T) {
+	exceeded := &armadaevents.EventSequence_Event{
+		Created: &baseTime,
+		Event: &armadaevents.EventSequence_Event_JobRuntimeExceeded{
+			JobRuntimeExceeded: &armadaevents.JobRuntimeExceeded{
+				JobId:             jobIdProto,
+				ExecutorId:        executorId,
+				MaxRuntimeSeconds: 3600,
+			},
+		},
+	}
+
+	expected := []*api.EventMessage{
+		{
+			Events: &api.EventMessage_RuntimeExceeded{
+				RuntimeExceeded: &api.JobRuntimeExceededEvent{
+					JobId:             jobIdString,
+					JobSetId:          jobSetName,
+					Queue:             queue,
+					Created:           baseTime,
+					ClusterId:         executorId,
+					MaxRuntimeSeconds: 3600,
+				},
+			},
+		},
+	}
+
+	apiEvents, err := FromEventSequence(toEventSeq(exceeded))
+	assert.NoError(t, err)
+	assert.Equal(t, expected, apiEvents)
+}
+
+func TestConvertJobResourcesNormalized(t *testing.T) {
+	original := map[string]v1.ResourceRequirements{
+		"main": {Requests: v1.ResourceList{"cpu": resource.MustParse("150m")}, Limits: v1.ResourceList{"cpu": resource.MustParse("150m")}},
+	}
+	normalized := map[string]v1.ResourceRequirements{
+		"main": {Requests: v1.ResourceList{"cpu": resource.MustParse("200m")}, Limits: v1.ResourceList{"cpu": resource.MustParse("200m")}},
+	}
+	normalizedEvent := &armadaevents.EventSequence_Event{
+		Created: &baseTime,
+		Event: &armadaevents.EventSequence_Event_JobResourcesNormalized{
+			JobResourcesNormalized: &armadaevents.JobResourcesNormalized{
+				JobId:               jobIdProto,
+				OriginalResources:   original,
+				NormalizedResources: normalized,
+			},
+		},
+	}
+
+	expected := []*api.EventMessage{
+		{
+			Events: &api.EventMessage_ResourcesNormalized{
+				ResourcesNormalized: &api.JobResourcesNormalizedEvent{
+					JobId:               jobIdString,
+					JobSetId:            jobSetName,
+					Queue:               queue,
+					Created:             baseTime,
+					OriginalResources:   original,
+					NormalizedResources: normalized,
+				},
+			},
+		},
+	}
+
+	apiEvents, err := FromEventSequence(toEventSeq(normalizedEvent))
+	assert.NoError(t, err)
+	assert.Equal(t, expected, apiEvents)
+}
+
+func TestConvertJobUnschedulable(t *testing.T) {
+	unschedulableEvent := &armadaevents.EventSequence_Event{
+		Created: &baseTime,
+		Event: &armadaevents.EventSequence_Event_JobUnschedulable{
+			JobUnschedulable: &armadaevents.JobUnschedulable{
+				JobId:  jobIdProto,
+				Reason: "no node type has resource nvidia.com/gpu",
+			},
+		},
+	}
+
+	expected := []*api.EventMessage{
+		{
+			Events: &api.EventMessage_Unschedulable{
+				Unschedulable: &api.JobUnschedulableEvent{
+					JobId:    jobIdString,
+					JobSetId: jobSetName,
+					Queue:    queue,
+					Created:  baseTime,
+					Reason:   "no node type has resource nvidia.com/gpu",
+				},
+			},
+		},
+	}
+
+	apiEvents, err := FromEventSequence(toEventSeq(unschedulableEvent))
+	assert.NoError(t, err)
+	assert.Equal(t, expected, apiEvents)
+}
+
+func TestConvertJobMaintenanceWindows(t *testing.T) {
+	end := baseTime.Add(time.Hour)
+	started := &armadaevents.EventSequence_Event{
+		Created: &baseTime,
+		Event: &armadaevents.EventSequence_Event_JobMaintenanceWindowStarted{
+			JobMaintenanceWindowStarted: &armadaevents.JobMaintenanceWindowStarted{
+				JobId:               jobIdProto,
+				MaintenanceWindowId: "window",
+				Executor:            executorId,
+				End:                 end,
+				Drain:               true,
+				Reason:              "upgrade",
+			},
+		},
+	}
+	ended := &armadaevents.EventSequence_Event{
+		Created: &end,
+		Event: &armadaevents.EventSequence_Event_JobMaintenanceWindowEnded{
+			JobMaintenanceWindowEnded: &armadaevents.JobMaintenanceWindowEnded{
+				JobId:               jobIdProto,
+				MaintenanceWindowId: "window",
+				Executor:            executorId,
+			},
+		},
+	}
+
+	expected := []*api.EventMessage{
+		{
+			Events: &api.EventMessage_MaintenanceWindowStarted{
+				MaintenanceWindowStarted: &api.JobMaintenanceWindowStartedEvent{
+					JobId:               jobIdString,
+					JobSetId:            jobSetName,
+					Queue:               queue,
+					Created:             baseTime,
+					MaintenanceWindowId: "window",
+					Executor:            executorId,
+					End:                 end,
+					Drain:               true,
+					Reason:              "upgrade",
+				},
+			},
+		},
+		{
+			Events: &api.EventMessage_MaintenanceWindowEnded{
+				MaintenanceWindowEnded: &api.JobMaintenanceWindowEndedEvent{
+					JobId:               jobIdString,
+					JobSetId:            jobSetName,
+					Queue:               queue,
+					Created:             end,
+					MaintenanceWindowId: "window",
+					Executor:            executorId,
+				},
+			},
+		},
+	}
+
+	apiEvents, err := FromEventSequence(toEventSeq(started, ended))
+	assert.NoError(t, err)
+	assert.Equal(t, expected, apiEvents)
+}
+
+func TestConvertJobCordons(t *testing.T) {
+	cordoned := &armadaevents.EventSequence_Event{
+		Created: &baseTime,
+		Event: &armadaevents.EventSequence_Event_JobCordoned{
+			JobCordoned: &armadaevents.JobCordoned{
+				JobId:      jobIdProto,
+				Executor:   executorId,
+				CordonedBy: "admin",
+				Reason:     "upgrade",
+			},
+		},
+	}
+	uncordoned := &armadaevents.EventSequence_Event{
+		Created: &baseTime,
+		Event: &armadaevents.EventSequence_Event_JobUncordoned{
+			JobUncordoned: &armadaevents.JobUncordoned{
+				JobId:        jobIdProto,
+				Executor:     executorId,
+				UncordonedBy: "admin",
+			},
+		},
+	}
+
+	expected := []*api.EventMessage{
+		{
+			Events: &api.EventMessage_Cordoned{
+				Cordoned: &api.JobCordonedEvent{
+					JobId:      jobIdString,
+					JobSetId:   jobSetName,
+					Queue:      queue,
+					Created:    baseTime,
+					Executor:   executorId,
+					CordonedBy: "admin",
+					Reason:     "upgrade",
+				},
+			},
+		},
+		{
+			Events: &api.EventMessage_Uncordoned{
+				Uncordoned: &api.JobUncordonedEvent{
+					JobId:        jobIdString,
+					JobSetId:     jobSetName,
+					Queue:        queue,
+					Created:      baseTime,
+					Executor:     executorId,
+					UncordonedBy: "admin",
+				},
+			},
+		},
+	}
+
+	apiEvents, err := FromEventSequence(toEventSeq(cordoned, uncordoned))
+	assert.NoError(t, err)
+	assert.Equal(t, expected, apiEvents)
+}
+
 func TestConvertReprioritising(t *testing.T) {
 	reprioritising := &armadaevents.EventSequence_Event{
 		Created: &baseTime,
@@ -278,6 +613,7 @@ func TestConvertLeased(t *testing.T) {
 			JobRunLeased: &armadaevents.JobRunLeased{
 				JobId:      jobIdProto,
 				ExecutorId: executorId,
+				Pool:       "cpu",
 			},
 		},
 	}
@@ -291,6 +627,7 @@ func TestConvertLeased(t *testing.T) {
 					Queue:     queue,
 					Created:   baseTime,
 					ClusterId: executorId,
+					Pool:      "cpu",
 				},
 			},
 		},
@@ -670,8 +1007,10 @@ func TestConvertJobRunning(t *testing.T) {
 						},
 						Info: &armadaevents.KubernetesResourceInfo_PodInfo{
 							PodInfo: &armadaevents.PodInfo{
-								NodeName:  nodeName,
-								PodNumber: podNumber,
+								NodeName:   nodeName,
+								PodNumber:  podNumber,
+								Pool:       "cpu",
+								NodeLabels: map[string]string{"zone": "a"},
 							},
 						},
 					},
@@ -694,6 +1033,8 @@ func TestConvertJobRunning(t *testing.T) {
 					PodNumber:    podNumber,
 					PodName:      podName,
 					PodNamespace: namespace,
+					Pool:         "cpu",
+					NodeLabels:   map[string]string{"zone": "a"},
 				},
 			},
 		},
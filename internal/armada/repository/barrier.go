@@ -0,0 +1,212 @@
+package repository
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+const (
+	barrierPrefix        = "Barrier:"
+	barrierMembersSuffix = ":Members"
+	// Released barriers are kept for this long, such that their state can still be inspected.
+	releasedBarrierRetention = 7 * 24 * time.Hour
+)
+
+type ErrBarrierNotFound struct {
+	Queue     string
+	BarrierId string
+}
+
+func (err *ErrBarrierNotFound) Error() string {
+	return fmt.Sprintf("could not find barrier %q in queue %q", err.BarrierId, err.Queue)
+}
+
+// ErrBarrierMembership is returned when jobs can't join a barrier,
+// either because they disagree with the barrier on its cardinality or because the barrier is already complete.
+type ErrBarrierMembership struct {
+	Queue       string
+	BarrierId   string
+	Cardinality int
+	Message     string
+}
+
+func (err *ErrBarrierMembership) Error() string {
+	return fmt.Sprintf("can't join barrier %q of cardinality %d in queue %q: %s", err.BarrierId, err.Cardinality, err.Queue, err.Message)
+}
+
+// BarrierRepository stores the membership of barriers.
+// A barrier is identified by its queue and id and is released once it has as many members as its cardinality.
+// Members of barriers that are not yet released must not be scheduled.
+type BarrierRepository interface {
+	// AddBarrierMembers adds jobIds to the barrier, creating it if it doesn't exist,
+	// and returns the state of the barrier after the jobs were added.
+	AddBarrierMembers(queue string, barrierId string, cardinality int, jobIds []string) (*api.Barrier, error)
+	// RemoveBarrierMembers undoes adding jobIds to the barrier, e.g., if the jobs couldn't be stored.
+	// The barrier is no longer released if it's left incomplete, and it's deleted if it's left without members.
+	RemoveBarrierMembers(queue string, barrierId string, jobIds []string) error
+	GetBarrier(queue string, barrierId string) (*api.Barrier, error)
+	// GetReleasedBarriers returns the subset of the provided barriers of queue that are released.
+	// Barriers that don't exist are considered released, since incomplete barriers are never deleted.
+	GetReleasedBarriers(queue string, barrierIds []string) (map[string]bool, error)
+}
+
+type RedisBarrierRepository struct {
+	db redis.UniversalClient
+}
+
+func NewRedisBarrierRepository(db redis.UniversalClient) *RedisBarrierRepository {
+	return &RedisBarrierRepository{db: db}
+}
+
+func (r *RedisBarrierRepository) AddBarrierMembers(queue string, barrierId string, cardinality int, jobIds []string) (*api.Barrier, error) {
+	args := make([]interface{}, 0, len(jobIds)+2)
+	args = append(args, cardinality, int(releasedBarrierRetention.Seconds()))
+	for _, jobId := range jobIds {
+		args = append(args, jobId)
+	}
+	result, err := addBarrierMembersScript.Run(
+		r.db,
+		[]string{barrierKey(queue, barrierId), barrierKey(queue, barrierId) + barrierMembersSuffix},
+		args...,
+	).Int()
+	if err != nil {
+		return nil, errors.Wrapf(err, "[RedisBarrierRepository.AddBarrierMembers] error adding members to barrier %s", barrierId)
+	}
+	switch result {
+	case barrierCardinalityMismatch:
+		return nil, &ErrBarrierMembership{Queue: queue, BarrierId: barrierId, Cardinality: cardinality, Message: "barrier exists with a different cardinality"}
+	case barrierTooManyMembers:
+		return nil, &ErrBarrierMembership{Queue: queue, BarrierId: barrierId, Cardinality: cardinality, Message: "barrier would have more members than its cardinality"}
+	}
+	return r.GetBarrier(queue, barrierId)
+}
+
+func (r *RedisBarrierRepository) RemoveBarrierMembers(queue string, barrierId string, jobIds []string) error {
+	args := make([]interface{}, len(jobIds))
+	for i, jobId := range jobIds {
+		args[i] = jobId
+	}
+	err := removeBarrierMembersScript.Run(
+		r.db,
+		[]string{barrierKey(queue, barrierId), barrierKey(queue, barrierId) + barrierMembersSuffix},
+		args...,
+	).Err()
+	if err != nil && err != redis.Nil {
+		return errors.Wrapf(err, "[RedisBarrierRepository.RemoveBarrierMembers] error removing members from barrier %s", barrierId)
+	}
+	return nil
+}
+
+func (r *RedisBarrierRepository) GetBarrier(queue string, barrierId string) (*api.Barrier, error) {
+	pipe := r.db.Pipeline()
+	fieldsCmd := pipe.HGetAll(barrierKey(queue, barrierId))
+	membersCmd := pipe.SMembers(barrierKey(queue, barrierId) + barrierMembersSuffix)
+	if _, err := pipe.Exec(); err != nil {
+		return nil, errors.Wrapf(err, "[RedisBarrierRepository.GetBarrier] error reading barrier %s from database", barrierId)
+	}
+	fields := fieldsCmd.Val()
+	if len(fields) == 0 {
+		return nil, &ErrBarrierNotFound{Queue: queue, BarrierId: barrierId}
+	}
+	cardinality, err := strconv.ParseUint(fields["cardinality"], 10, 32)
+	if err != nil {
+		return nil, errors.Wrapf(err, "[RedisBarrierRepository.GetBarrier] error parsing cardinality of barrier %s", barrierId)
+	}
+	return &api.Barrier{
+		Queue:       queue,
+		Id:          barrierId,
+		Cardinality: uint32(cardinality),
+		JobIds:      membersCmd.Val(),
+		Released:    fields["released"] == "1",
+	}, nil
+}
+
+func (r *RedisBarrierRepository) GetReleasedBarriers(queue string, barrierIds []string) (map[string]bool, error) {
+	pipe := r.db.Pipeline()
+	cmds := make(map[string]*redis.SliceCmd, len(barrierIds))
+	for _, barrierId := range barrierIds {
+		cmds[barrierId] = pipe.HMGet(barrierKey(queue, barrierId), "cardinality", "released")
+	}
+	if _, err := pipe.Exec(); err != nil {
+		return nil, errors.Wrapf(err, "[RedisBarrierRepository.GetReleasedBarriers] error reading from database")
+	}
+	released := make(map[string]bool, len(barrierIds))
+	for barrierId, cmd := range cmds {
+		fields := cmd.Val()
+		if fields[0] == nil || fields[1] == "1" {
+			released[barrierId] = true
+		}
+	}
+	return released, nil
+}
+
+func barrierKey(queue string, barrierId string) string {
+	return barrierPrefix + queue + ":" + barrierId
+}
+
+const (
+	barrierCardinalityMismatch = -1
+	barrierTooManyMembers      = -2
+)
+
+var addBarrierMembersScript = redis.NewScript(`
+local barrierKey = KEYS[1]
+local membersKey = KEYS[2]
+
+local cardinality = tonumber(ARGV[1])
+local retentionSeconds = ARGV[2]
+
+local existingCardinality = redis.call('HGET', barrierKey, 'cardinality')
+if existingCardinality and tonumber(existingCardinality) ~= cardinality then
+	return -1
+end
+
+local numNewMembers = 0
+for i = 3, #ARGV do
+	if redis.call('SISMEMBER', membersKey, ARGV[i]) == 0 then
+		numNewMembers = numNewMembers + 1
+	end
+end
+if redis.call('SCARD', membersKey) + numNewMembers > cardinality then
+	return -2
+end
+
+redis.call('HSET', barrierKey, 'cardinality', cardinality)
+for i = 3, #ARGV do
+	redis.call('SADD', membersKey, ARGV[i])
+end
+
+local numMembers = redis.call('SCARD', membersKey)
+if numMembers == cardinality then
+	redis.call('HSET', barrierKey, 'released', '1')
+	redis.call('EXPIRE', barrierKey, retentionSeconds)
+	redis.call('EXPIRE', membersKey, retentionSeconds)
+end
+return numMembers
+`)
+
+var removeBarrierMembersScript = redis.NewScript(`
+local barrierKey = KEYS[1]
+local membersKey = KEYS[2]
+
+for i = 1, #ARGV do
+	redis.call('SREM', membersKey, ARGV[i])
+end
+
+local numMembers = redis.call('SCARD', membersKey)
+local cardinality = redis.call('HGET', barrierKey, 'cardinality')
+if numMembers == 0 then
+	redis.call('DEL', barrierKey)
+elseif cardinality and numMembers < tonumber(cardinality) then
+	redis.call('HDEL', barrierKey, 'released')
+	redis.call('PERSIST', barrierKey)
+	redis.call('PERSIST', membersKey)
+end
+return numMembers
+`)
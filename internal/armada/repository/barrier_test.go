@@ -0,0 +1,93 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBarrierReleasedOnceComplete(t *testing.T) {
+	withBarrierRepository(func(r *RedisBarrierRepository) {
+		barrier, err := r.AddBarrierMembers("queue", "barrier", 3, []string{"a", "b"})
+		require.NoError(t, err)
+		assert.False(t, barrier.Released)
+		assert.ElementsMatch(t, []string{"a", "b"}, barrier.JobIds)
+
+		released, err := r.GetReleasedBarriers("queue", []string{"barrier", "unknown"})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]bool{"unknown": true}, released)
+
+		barrier, err = r.AddBarrierMembers("queue", "barrier", 3, []string{"c"})
+		require.NoError(t, err)
+		assert.True(t, barrier.Released)
+		assert.Equal(t, uint32(3), barrier.Cardinality)
+
+		released, err = r.GetReleasedBarriers("queue", []string{"barrier"})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]bool{"barrier": true}, released)
+	})
+}
+
+func TestBarrierMembershipErrors(t *testing.T) {
+	withBarrierRepository(func(r *RedisBarrierRepository) {
+		_, err := r.AddBarrierMembers("queue", "barrier", 2, []string{"a"})
+		require.NoError(t, err)
+
+		var membershipErr *ErrBarrierMembership
+		_, err = r.AddBarrierMembers("queue", "barrier", 3, []string{"b"})
+		assert.ErrorAs(t, err, &membershipErr)
+
+		_, err = r.AddBarrierMembers("queue", "barrier", 2, []string{"b", "c"})
+		assert.ErrorAs(t, err, &membershipErr)
+
+		barrier, err := r.GetBarrier("queue", "barrier")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"a"}, barrier.JobIds)
+
+		// Barriers are scoped to queues.
+		var notFoundErr *ErrBarrierNotFound
+		_, err = r.GetBarrier("otherQueue", "barrier")
+		assert.ErrorAs(t, err, &notFoundErr)
+	})
+}
+
+func TestRemoveBarrierMembers(t *testing.T) {
+	withBarrierRepository(func(r *RedisBarrierRepository) {
+		_, err := r.AddBarrierMembers("queue", "barrier", 2, []string{"a"})
+		require.NoError(t, err)
+		_, err = r.AddBarrierMembers("queue", "barrier", 2, []string{"b"})
+		require.NoError(t, err)
+
+		// Barriers left incomplete are no longer released.
+		require.NoError(t, r.RemoveBarrierMembers("queue", "barrier", []string{"b"}))
+		barrier, err := r.GetBarrier("queue", "barrier")
+		require.NoError(t, err)
+		assert.False(t, barrier.Released)
+		assert.Equal(t, []string{"a"}, barrier.JobIds)
+		ttl, err := r.db.TTL(barrierKey("queue", "barrier")).Result()
+		require.NoError(t, err)
+		assert.Less(t, ttl, time.Duration(0))
+
+		// Barriers left without members are deleted, such that they may be created with another cardinality.
+		require.NoError(t, r.RemoveBarrierMembers("queue", "barrier", []string{"a"}))
+		var notFoundErr *ErrBarrierNotFound
+		_, err = r.GetBarrier("queue", "barrier")
+		assert.ErrorAs(t, err, &notFoundErr)
+		_, err = r.AddBarrierMembers("queue", "barrier", 3, []string{"c"})
+		require.NoError(t, err)
+
+		// Removing members of barriers that don't exist does nothing.
+		require.NoError(t, r.RemoveBarrierMembers("queue", "unknown", []string{"a"}))
+	})
+}
+
+func withBarrierRepository(action func(r *RedisBarrierRepository)) {
+	client := redis.NewClient(&redis.Options{Addr: "localhost:6379", DB: 10})
+	defer client.FlushDB()
+	defer client.Close()
+	client.FlushDB()
+	action(NewRedisBarrierRepository(client))
+}
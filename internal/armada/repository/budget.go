@@ -0,0 +1,179 @@
+package repository
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/pkg/errors"
+)
+
+const (
+	budgetRunsInProgressPrefix = "Budget:RunsInProgress:"
+	budgetFinishedRunsPrefix   = "Budget:FinishedRuns:"
+)
+
+// BudgetedRun is a run of a job accounted against the resource budgets of its queue.
+type BudgetedRun struct {
+	JobId string
+	Owner string
+	// Resources requested by the job, e.g., {"cpu": 2, "nvidia.com/gpu": 1}, in units of each resource.
+	Resources map[string]float64
+	Started   time.Time
+	// Zero if the run is in progress. Stored separately from the other fields, which are immutable.
+	Finished time.Time `json:"-"`
+}
+
+// UsedHours returns the number of hours of one unit of resource used by the run between since and now,
+// counting runs in progress as running until now.
+func (r *BudgetedRun) UsedHours(resource string, since time.Time, now time.Time) float64 {
+	quantity := r.Resources[resource]
+	if quantity <= 0 {
+		return 0
+	}
+	start := r.Started
+	if start.Before(since) {
+		start = since
+	}
+	end := r.Finished
+	if end.IsZero() || end.After(now) {
+		end = now
+	}
+	if !end.After(start) {
+		return 0
+	}
+	return quantity * end.Sub(start).Hours()
+}
+
+// BudgetRepository stores the runs of jobs of queues with resource budgets, from which the usage of the budgets is derived.
+type BudgetRepository interface {
+	// StartRun records that a run of a job of queue started, unless a run of the same job is already in progress.
+	StartRun(queue string, run *BudgetedRun) error
+	// FinishRun records that the run in progress of the job with id jobId of queue finished at finished.
+	// Does nothing if no run of the job is in progress.
+	FinishRun(queue string, jobId string, finished time.Time) error
+	// GetRuns returns the runs of jobs of queue that are in progress or finished at or after finishedSince.
+	GetRuns(queue string, finishedSince time.Time) ([]*BudgetedRun, error)
+	// GetRunsInProgress returns the runs of jobs of queue that are in progress.
+	GetRunsInProgress(queue string) ([]*BudgetedRun, error)
+	// PruneRuns removes the runs of jobs of queue that finished before finishedBefore.
+	PruneRuns(queue string, finishedBefore time.Time) error
+	// DeleteRuns removes all runs of jobs of queue, e.g., once it no longer has budgets.
+	DeleteRuns(queue string) error
+}
+
+type RedisBudgetRepository struct {
+	db redis.UniversalClient
+}
+
+func NewRedisBudgetRepository(db redis.UniversalClient) *RedisBudgetRepository {
+	return &RedisBudgetRepository{db: db}
+}
+
+func (r *RedisBudgetRepository) StartRun(queue string, run *BudgetedRun) error {
+	data, err := json.Marshal(run)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if err := r.db.HSetNX(budgetRunsInProgressPrefix+queue, run.JobId, data).Err(); err != nil {
+		return errors.Wrapf(err, "[RedisBudgetRepository.StartRun] error starting run of job %s", run.JobId)
+	}
+	return nil
+}
+
+func (r *RedisBudgetRepository) FinishRun(queue string, jobId string, finished time.Time) error {
+	_, err := finishBudgetedRunScript.Run(
+		r.db,
+		[]string{budgetRunsInProgressPrefix + queue, budgetFinishedRunsPrefix + queue},
+		jobId, finished.UnixMilli(),
+	).Result()
+	if err != nil {
+		return errors.Wrapf(err, "[RedisBudgetRepository.FinishRun] error finishing run of job %s", jobId)
+	}
+	return nil
+}
+
+func (r *RedisBudgetRepository) GetRuns(queue string, finishedSince time.Time) ([]*BudgetedRun, error) {
+	pipe := r.db.Pipeline()
+	inProgressCmd := pipe.HVals(budgetRunsInProgressPrefix + queue)
+	finishedCmd := pipe.ZRangeByScoreWithScores(budgetFinishedRunsPrefix+queue, redis.ZRangeBy{
+		Min: strconv.FormatInt(finishedSince.UnixMilli(), 10),
+		Max: "+inf",
+	})
+	if _, err := pipe.Exec(); err != nil {
+		return nil, errors.Wrapf(err, "[RedisBudgetRepository.GetRuns] error getting runs of queue %s", queue)
+	}
+
+	runs, err := unmarshalBudgetedRuns(inProgressCmd.Val())
+	if err != nil {
+		return nil, err
+	}
+	for _, member := range finishedCmd.Val() {
+		data, ok := member.Member.(string)
+		if !ok {
+			return nil, errors.Errorf("[RedisBudgetRepository.GetRuns] invalid run %v of queue %s", member.Member, queue)
+		}
+		run := &BudgetedRun{}
+		if err := json.Unmarshal([]byte(data), run); err != nil {
+			return nil, errors.WithStack(err)
+		}
+		run.Finished = time.UnixMilli(int64(member.Score))
+		runs = append(runs, run)
+	}
+	return runs, nil
+}
+
+func (r *RedisBudgetRepository) GetRunsInProgress(queue string) ([]*BudgetedRun, error) {
+	values, err := r.db.HVals(budgetRunsInProgressPrefix + queue).Result()
+	if err != nil {
+		return nil, errors.Wrapf(err, "[RedisBudgetRepository.GetRunsInProgress] error getting runs of queue %s", queue)
+	}
+	return unmarshalBudgetedRuns(values)
+}
+
+func (r *RedisBudgetRepository) PruneRuns(queue string, finishedBefore time.Time) error {
+	// Scores are whole milliseconds, so the exclusive bound removes exactly the runs that finished before finishedBefore.
+	max := "(" + strconv.FormatInt(finishedBefore.UnixMilli(), 10)
+	if err := r.db.ZRemRangeByScore(budgetFinishedRunsPrefix+queue, "-inf", max).Err(); err != nil {
+		return errors.Wrapf(err, "[RedisBudgetRepository.PruneRuns] error pruning runs of queue %s", queue)
+	}
+	return nil
+}
+
+func (r *RedisBudgetRepository) DeleteRuns(queue string) error {
+	if err := r.db.Del(budgetRunsInProgressPrefix+queue, budgetFinishedRunsPrefix+queue).Err(); err != nil {
+		return errors.Wrapf(err, "[RedisBudgetRepository.DeleteRuns] error deleting runs of queue %s", queue)
+	}
+	return nil
+}
+
+func unmarshalBudgetedRuns(values []string) ([]*BudgetedRun, error) {
+	runs := make([]*BudgetedRun, 0, len(values))
+	for _, value := range values {
+		run := &BudgetedRun{}
+		if err := json.Unmarshal([]byte(value), run); err != nil {
+			return nil, errors.WithStack(err)
+		}
+		runs = append(runs, run)
+	}
+	return runs, nil
+}
+
+// Moves a run from the runs in progress to the finished runs, scored by the time it finished.
+// Since runs record when they started, the members of the finished runs are unique even if a job runs several times.
+var finishBudgetedRunScript = redis.NewScript(`
+local inProgressKey = KEYS[1]
+local finishedKey = KEYS[2]
+
+local jobId = ARGV[1]
+local finished = ARGV[2]
+
+local run = redis.call('HGET', inProgressKey, jobId)
+if not run then
+	return 0
+end
+redis.call('HDEL', inProgressKey, jobId)
+redis.call('ZADD', finishedKey, finished, run)
+return 1
+`)
@@ -0,0 +1,119 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBudgetRepository_RunLifecycle(t *testing.T) {
+	withBudgetRepository(func(r *RedisBudgetRepository) {
+		started := time.UnixMilli(time.Now().UnixMilli())
+		first := &BudgetedRun{JobId: "first", Owner: "alice", Resources: map[string]float64{"cpu": 2}, Started: started}
+		second := &BudgetedRun{JobId: "second", Owner: "bob", Resources: map[string]float64{"cpu": 1}, Started: started}
+		require.NoError(t, r.StartRun("queue", first))
+		require.NoError(t, r.StartRun("queue", second))
+
+		// Runs in progress aren't restarted.
+		require.NoError(t, r.StartRun("queue", &BudgetedRun{JobId: "first", Started: started.Add(time.Hour)}))
+
+		inProgress, err := r.GetRunsInProgress("queue")
+		require.NoError(t, err)
+		assertRunsEqual(t, []*BudgetedRun{first, second}, inProgress)
+
+		finished := started.Add(time.Hour)
+		require.NoError(t, r.FinishRun("queue", "first", finished))
+		// Finishing a run that isn't in progress does nothing.
+		require.NoError(t, r.FinishRun("queue", "first", finished.Add(time.Hour)))
+		require.NoError(t, r.FinishRun("queue", "unknown", finished))
+
+		inProgress, err = r.GetRunsInProgress("queue")
+		require.NoError(t, err)
+		assertRunsEqual(t, []*BudgetedRun{second}, inProgress)
+
+		runs, err := r.GetRuns("queue", finished)
+		require.NoError(t, err)
+		first.Finished = finished
+		assertRunsEqual(t, []*BudgetedRun{first, second}, runs)
+
+		runs, err = r.GetRuns("queue", finished.Add(time.Millisecond))
+		require.NoError(t, err)
+		assertRunsEqual(t, []*BudgetedRun{second}, runs)
+
+		// Runs of other queues are separate.
+		runs, err = r.GetRuns("other", time.Time{})
+		require.NoError(t, err)
+		assert.Empty(t, runs)
+	})
+}
+
+func TestBudgetRepository_PruneRuns(t *testing.T) {
+	withBudgetRepository(func(r *RedisBudgetRepository) {
+		started := time.UnixMilli(time.Now().UnixMilli())
+		for _, jobId := range []string{"early", "late", "running"} {
+			require.NoError(t, r.StartRun("queue", &BudgetedRun{JobId: jobId, Started: started}))
+		}
+		require.NoError(t, r.FinishRun("queue", "early", started.Add(time.Minute)))
+		require.NoError(t, r.FinishRun("queue", "late", started.Add(time.Hour)))
+
+		require.NoError(t, r.PruneRuns("queue", started.Add(time.Hour)))
+		runs, err := r.GetRuns("queue", time.Time{})
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{"late", "running"}, runJobIds(runs))
+
+		require.NoError(t, r.DeleteRuns("queue"))
+		runs, err = r.GetRuns("queue", time.Time{})
+		require.NoError(t, err)
+		assert.Empty(t, runs)
+	})
+}
+
+func TestBudgetedRun_UsedHours(t *testing.T) {
+	now := time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC)
+	run := &BudgetedRun{Resources: map[string]float64{"cpu": 2}, Started: now.Add(-3 * time.Hour)}
+
+	// Runs in progress are counted until now, but only within the window.
+	assert.Equal(t, 6.0, run.UsedHours("cpu", now.Add(-24*time.Hour), now))
+	assert.Equal(t, 2.0, run.UsedHours("cpu", now.Add(-time.Hour), now))
+	assert.Equal(t, 0.0, run.UsedHours("nvidia.com/gpu", now.Add(-24*time.Hour), now))
+
+	run.Finished = now.Add(-2 * time.Hour)
+	assert.Equal(t, 2.0, run.UsedHours("cpu", now.Add(-24*time.Hour), now))
+	assert.Equal(t, 0.0, run.UsedHours("cpu", now.Add(-time.Hour), now))
+}
+
+func assertRunsEqual(t *testing.T, expected []*BudgetedRun, actual []*BudgetedRun) {
+	require.Equal(t, len(expected), len(actual))
+	actualByJobId := make(map[string]*BudgetedRun, len(actual))
+	for _, run := range actual {
+		actualByJobId[run.JobId] = run
+	}
+	for _, run := range expected {
+		a, ok := actualByJobId[run.JobId]
+		if assert.True(t, ok, "missing run of job %s", run.JobId) {
+			assert.Equal(t, run.Owner, a.Owner)
+			assert.Equal(t, run.Resources, a.Resources)
+			assert.True(t, run.Started.Equal(a.Started), "started %s, expected %s", a.Started, run.Started)
+			assert.True(t, run.Finished.Equal(a.Finished), "finished %s, expected %s", a.Finished, run.Finished)
+		}
+	}
+}
+
+func runJobIds(runs []*BudgetedRun) []string {
+	jobIds := make([]string, len(runs))
+	for i, run := range runs {
+		jobIds[i] = run.JobId
+	}
+	return jobIds
+}
+
+func withBudgetRepository(action func(r *RedisBudgetRepository)) {
+	client := redis.NewClient(&redis.Options{Addr: "localhost:6379", DB: 10})
+	defer client.FlushDB()
+	defer client.Close()
+	client.FlushDB()
+	action(NewRedisBudgetRepository(client))
+}
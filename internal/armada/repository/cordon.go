@@ -0,0 +1,97 @@
+package repository
+
+import (
+	"github.com/go-redis/redis"
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+const (
+	cordonedQueuesKey    = "Cordon:Queues"    // cordons of queues, by queue name
+	cordonedExecutorsKey = "Cordon:Executors" // cordons of executors, by executor id
+)
+
+// CordonRepository stores the cordons of queues and executors, to which no new leases are given while cordoned.
+type CordonRepository interface {
+	// CordonQueue stores the cordon of a queue, replacing any existing cordon of it.
+	CordonQueue(cordon *api.Cordon) error
+	// UncordonQueue removes the cordon of the queue with the given name. Returns false if the queue wasn't cordoned.
+	UncordonQueue(name string) (bool, error)
+	// GetCordonedQueues returns the cordons of queues, indexed by queue name.
+	GetCordonedQueues() (map[string]*api.Cordon, error)
+	// CordonExecutor stores the cordon of an executor, replacing any existing cordon of it.
+	CordonExecutor(cordon *api.Cordon) error
+	// UncordonExecutor removes the cordon of the executor with the given id. Returns false if it wasn't cordoned.
+	UncordonExecutor(name string) (bool, error)
+	// GetCordonedExecutors returns the cordons of executors, indexed by executor id.
+	GetCordonedExecutors() (map[string]*api.Cordon, error)
+}
+
+type RedisCordonRepository struct {
+	db redis.UniversalClient
+}
+
+func NewRedisCordonRepository(db redis.UniversalClient) *RedisCordonRepository {
+	return &RedisCordonRepository{db: db}
+}
+
+func (r *RedisCordonRepository) CordonQueue(cordon *api.Cordon) error {
+	return r.cordon(cordonedQueuesKey, cordon)
+}
+
+func (r *RedisCordonRepository) UncordonQueue(name string) (bool, error) {
+	return r.uncordon(cordonedQueuesKey, name)
+}
+
+func (r *RedisCordonRepository) GetCordonedQueues() (map[string]*api.Cordon, error) {
+	return r.getCordons(cordonedQueuesKey)
+}
+
+func (r *RedisCordonRepository) CordonExecutor(cordon *api.Cordon) error {
+	return r.cordon(cordonedExecutorsKey, cordon)
+}
+
+func (r *RedisCordonRepository) UncordonExecutor(name string) (bool, error) {
+	return r.uncordon(cordonedExecutorsKey, name)
+}
+
+func (r *RedisCordonRepository) GetCordonedExecutors() (map[string]*api.Cordon, error) {
+	return r.getCordons(cordonedExecutorsKey)
+}
+
+func (r *RedisCordonRepository) cordon(key string, cordon *api.Cordon) error {
+	data, err := proto.Marshal(cordon)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if err := r.db.HSet(key, cordon.Name, data).Err(); err != nil {
+		return errors.Wrapf(err, "[RedisCordonRepository.cordon] error cordoning %s", cordon.Name)
+	}
+	return nil
+}
+
+func (r *RedisCordonRepository) uncordon(key string, name string) (bool, error) {
+	deleted, err := r.db.HDel(key, name).Result()
+	if err != nil {
+		return false, errors.Wrapf(err, "[RedisCordonRepository.uncordon] error uncordoning %s", name)
+	}
+	return deleted > 0, nil
+}
+
+func (r *RedisCordonRepository) getCordons(key string) (map[string]*api.Cordon, error) {
+	values, err := r.db.HGetAll(key).Result()
+	if err != nil {
+		return nil, errors.Wrap(err, "[RedisCordonRepository.getCordons] error getting cordons")
+	}
+	cordons := make(map[string]*api.Cordon, len(values))
+	for name, data := range values {
+		cordon := &api.Cordon{}
+		if err := proto.Unmarshal([]byte(data), cordon); err != nil {
+			return nil, errors.Wrapf(err, "[RedisCordonRepository.getCordons] error unmarshalling cordon of %s", name)
+		}
+		cordons[name] = cordon
+	}
+	return cordons, nil
+}
@@ -0,0 +1,62 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+func TestCordonRepository(t *testing.T) {
+	withCordonRepository(func(r *RedisCordonRepository) {
+		cordoned := time.Now().UTC().Truncate(time.Second)
+		queueCordon := &api.Cordon{Name: "queue", Reason: "maintenance", CordonedBy: "alice", Cordoned: cordoned}
+		executorCordon := &api.Cordon{Name: "cluster", Reason: "upgrade", CordonedBy: "bob", Cordoned: cordoned}
+		require.NoError(t, r.CordonQueue(queueCordon))
+		require.NoError(t, r.CordonExecutor(executorCordon))
+
+		queues, err := r.GetCordonedQueues()
+		require.NoError(t, err)
+		assert.Equal(t, map[string]*api.Cordon{"queue": queueCordon}, queues)
+		executors, err := r.GetCordonedExecutors()
+		require.NoError(t, err)
+		assert.Equal(t, map[string]*api.Cordon{"cluster": executorCordon}, executors)
+
+		// Cordoning again replaces the cordon.
+		queueCordon = &api.Cordon{Name: "queue", Reason: "still maintenance", CordonedBy: "bob", Cordoned: cordoned.Add(time.Hour)}
+		require.NoError(t, r.CordonQueue(queueCordon))
+		queues, err = r.GetCordonedQueues()
+		require.NoError(t, err)
+		assert.Equal(t, map[string]*api.Cordon{"queue": queueCordon}, queues)
+
+		uncordoned, err := r.UncordonQueue("queue")
+		require.NoError(t, err)
+		assert.True(t, uncordoned)
+		uncordoned, err = r.UncordonQueue("queue")
+		require.NoError(t, err)
+		assert.False(t, uncordoned)
+		queues, err = r.GetCordonedQueues()
+		require.NoError(t, err)
+		assert.Empty(t, queues)
+
+		// Queues and executors are cordoned separately.
+		uncordoned, err = r.UncordonQueue("cluster")
+		require.NoError(t, err)
+		assert.False(t, uncordoned)
+		uncordoned, err = r.UncordonExecutor("cluster")
+		require.NoError(t, err)
+		assert.True(t, uncordoned)
+	})
+}
+
+func withCordonRepository(action func(r *RedisCordonRepository)) {
+	client := redis.NewClient(&redis.Options{Addr: "localhost:6379", DB: 10})
+	defer client.FlushDB()
+	defer client.Close()
+	client.FlushDB()
+	action(NewRedisCordonRepository(client))
+}
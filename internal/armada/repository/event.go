@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"strconv"
 	"time"
 
 	"github.com/go-redis/redis"
@@ -13,16 +14,20 @@ import (
 	log "github.com/sirupsen/logrus"
 
 	"github.com/armadaproject/armada/internal/armada/repository/apimessages"
-	"github.com/armadaproject/armada/internal/armada/repository/sequence"
 	"github.com/armadaproject/armada/internal/common/armadacontext"
 	"github.com/armadaproject/armada/internal/common/compress"
+	"github.com/armadaproject/armada/internal/common/eventschema"
 	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/api/sequence"
 	"github.com/armadaproject/armada/pkg/armadaevents"
 )
 
 const (
 	eventStreamPrefix = "Events:"
 	dataKey           = "message"
+	// Version of the event schema the events of an entry were stored with. Entries stored before versioning was
+	// introduced don't have a version and are of eventschema.MinVersion.
+	schemaVersionKey = "schemaVersion"
 )
 
 type EventRepository interface {
@@ -31,9 +36,12 @@ type EventRepository interface {
 	GetLastMessageId(queue, jobSetId string) (string, error)
 }
 
+// RedisEventRepository reads events stored in Redis by the event ingester.
+// Events are upgraded to the latest version of the event schema as they're read.
 type RedisEventRepository struct {
 	db               redis.UniversalClient
 	decompressorPool *pool.ObjectPool
+	translator       *eventschema.Translator
 }
 
 func NewEventRepository(db redis.UniversalClient) *RedisEventRepository {
@@ -54,7 +62,7 @@ func NewEventRepository(db redis.UniversalClient) *RedisEventRepository {
 			return compress.NewZlibDecompressor(), nil
 		}), &poolConfig)
 
-	return &RedisEventRepository{db: db, decompressorPool: decompressorPool}
+	return &RedisEventRepository{db: db, decompressorPool: decompressorPool, translator: eventschema.Default}
 }
 
 func (repo *RedisEventRepository) CheckStreamExists(queue string, jobSetId string) (bool, error) {
@@ -94,6 +102,15 @@ func (repo *RedisEventRepository) ReadEvents(queue string, jobSetId string, last
 		if err != nil {
 			return nil, nil, err
 		}
+		storedVersion, err := entrySchemaVersion(m)
+		if err != nil {
+			return nil, nil, err
+		}
+		// Events stored by later versions of Armada can't be upgraded and are read as they are.
+		schemaVersion := storedVersion
+		if latest := repo.translator.LatestVersion(); schemaVersion < latest {
+			schemaVersion = latest
+		}
 		// Set a default id for the message, if there are apiEvents produced by this message then they'll overwrite this value
 		lastMessageId, err = sequence.FromRedisId(m.ID, 0, true)
 		if err != nil {
@@ -105,8 +122,15 @@ func (repo *RedisEventRepository) ReadEvents(queue string, jobSetId string, last
 				return nil, nil, err
 			}
 			lastMessageId = msgId
-			if msgId.IsAfter(from) {
-				messages = append(messages, &api.EventStreamMessage{Id: msgId.String(), Message: msg})
+			if !msgId.IsAfter(from) {
+				continue
+			}
+			msg, err = repo.translator.Translate(msg, storedVersion, schemaVersion)
+			if err != nil {
+				return nil, nil, errors.WithMessagef(err, "error upgrading event %s", msgId)
+			}
+			if msg != nil {
+				messages = append(messages, &api.EventStreamMessage{Id: msgId.String(), Message: msg, SchemaVersion: schemaVersion})
 			}
 		}
 	}
@@ -160,6 +184,18 @@ func (repo *RedisEventRepository) extractEvents(msg redis.XMessage, queue, jobSe
 	return apimessages.FromEventSequence(es)
 }
 
+func entrySchemaVersion(msg redis.XMessage) (uint32, error) {
+	value, ok := msg.Values[schemaVersionKey]
+	if !ok {
+		return eventschema.MinVersion, nil
+	}
+	version, err := strconv.ParseUint(value.(string), 10, 32)
+	if err != nil {
+		return 0, errors.Wrapf(err, "invalid schema version of stream entry %s", msg.ID)
+	}
+	return uint32(version), nil
+}
+
 func getJobSetEventsKey(queue, jobSetId string) string {
 	return eventStreamPrefix + queue + ":" + jobSetId
 }
@@ -0,0 +1,158 @@
+package repository
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/go-redis/redis"
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+const eventJournalKey = "EventJournal"
+
+// JournaledEvent is an event read from an EventJournal, along with its offset.
+type JournaledEvent struct {
+	Offset string
+	Event  *api.EventMessage
+}
+
+// EventJournal is a durable, append-only log of the events reported to the server.
+// Events are retained even after being published, such that they can be replayed if publishing them failed.
+type EventJournal interface {
+	Append(events []*api.EventMessage) error
+	// Read returns, in the order they were appended, up to limit events with offsets in [from, to].
+	// An empty from or to leaves the range unbounded on that side.
+	Read(from string, to string, limit int64) ([]*JournaledEvent, error)
+	Info() (*api.EventJournalInfo, error)
+}
+
+// RedisEventJournal stores events in a Redis stream, one entry per event.
+// The stream is trimmed to approximately maxEvents entries as events are appended.
+type RedisEventJournal struct {
+	db        redis.UniversalClient
+	maxEvents int64
+}
+
+func NewRedisEventJournal(db redis.UniversalClient, maxEvents int64) *RedisEventJournal {
+	return &RedisEventJournal{db: db, maxEvents: maxEvents}
+}
+
+func (j *RedisEventJournal) Append(events []*api.EventMessage) error {
+	if len(events) == 0 {
+		return nil
+	}
+	pipe := j.db.TxPipeline()
+	for _, event := range events {
+		data, err := proto.Marshal(event)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		pipe.XAdd(&redis.XAddArgs{
+			Stream:       eventJournalKey,
+			MaxLenApprox: j.maxEvents,
+			Values:       map[string]interface{}{dataKey: data},
+		})
+	}
+	if _, err := pipe.Exec(); err != nil {
+		return errors.Wrapf(err, "[RedisEventJournal.Append] error appending %d events", len(events))
+	}
+	return nil
+}
+
+func (j *RedisEventJournal) Read(from string, to string, limit int64) ([]*JournaledEvent, error) {
+	start, stop := "-", "+"
+	if from != "" {
+		if err := ValidateEventJournalOffset(from); err != nil {
+			return nil, err
+		}
+		start = from
+	}
+	if to != "" {
+		if err := ValidateEventJournalOffset(to); err != nil {
+			return nil, err
+		}
+		stop = to
+	}
+	messages, err := j.db.XRangeN(eventJournalKey, start, stop, limit).Result()
+	if err != nil {
+		return nil, errors.Wrapf(err, "[RedisEventJournal.Read] error reading events from %s to %s", start, stop)
+	}
+	events := make([]*JournaledEvent, 0, len(messages))
+	for _, message := range messages {
+		event, err := unmarshalStreamEvent(message)
+		if err != nil {
+			return nil, errors.WithMessage(err, "[RedisEventJournal.Read] error reading journal")
+		}
+		events = append(events, &JournaledEvent{Offset: message.ID, Event: event})
+	}
+	return events, nil
+}
+
+func (j *RedisEventJournal) Info() (*api.EventJournalInfo, error) {
+	pipe := j.db.Pipeline()
+	lenCmd := pipe.XLen(eventJournalKey)
+	firstCmd := pipe.XRangeN(eventJournalKey, "-", "+", 1)
+	lastCmd := pipe.XRevRangeN(eventJournalKey, "+", "-", 1)
+	if _, err := pipe.Exec(); err != nil && err != redis.Nil {
+		return nil, errors.Wrap(err, "[RedisEventJournal.Info] error reading event journal")
+	}
+	info := &api.EventJournalInfo{NumEvents: lenCmd.Val()}
+	if first := firstCmd.Val(); len(first) > 0 {
+		info.FirstOffset = first[0].ID
+	}
+	if last := lastCmd.Val(); len(last) > 0 {
+		info.LastOffset = last[0].ID
+	}
+	return info, nil
+}
+
+// NextEventJournalOffset returns the smallest offset greater than offset,
+// such that reading can be resumed after the last event read.
+func NextEventJournalOffset(offset string) (string, error) {
+	if err := ValidateEventJournalOffset(offset); err != nil {
+		return "", err
+	}
+	millis, seq, found := strings.Cut(offset, "-")
+	if !found {
+		// A bare timestamp includes all events reported in that millisecond.
+		ms, _ := strconv.ParseUint(millis, 10, 64)
+		return fmt.Sprintf("%d-0", ms+1), nil
+	}
+	s, _ := strconv.ParseUint(seq, 10, 64)
+	return fmt.Sprintf("%s-%d", millis, s+1), nil
+}
+
+// ValidateEventJournalOffset returns an error if offset isn't of the form <milliseconds>[-<sequence number>].
+func ValidateEventJournalOffset(offset string) error {
+	millis, seq, found := strings.Cut(offset, "-")
+	if _, err := strconv.ParseUint(millis, 10, 64); err != nil {
+		return errors.Errorf("invalid event journal offset %q", offset)
+	}
+	if _, err := strconv.ParseUint(seq, 10, 64); found && err != nil {
+		return errors.Errorf("invalid event journal offset %q", offset)
+	}
+	return nil
+}
+
+// JournalingEventStore appends events to an EventJournal before reporting them to the wrapped EventStore.
+// If reporting fails, or the server crashes before doing so, the events can be replayed from the journal.
+type JournalingEventStore struct {
+	journal EventJournal
+	store   EventStore
+}
+
+func NewJournalingEventStore(journal EventJournal, store EventStore) *JournalingEventStore {
+	return &JournalingEventStore{journal: journal, store: store}
+}
+
+func (s *JournalingEventStore) ReportEvents(ctx *armadacontext.Context, events []*api.EventMessage) error {
+	if err := s.journal.Append(events); err != nil {
+		return err
+	}
+	return s.store.ReportEvents(ctx, events)
+}
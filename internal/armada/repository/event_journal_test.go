@@ -0,0 +1,113 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/go-redis/redis"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+func TestEventJournal_AppendAndRead(t *testing.T) {
+	withEventJournal(func(j *RedisEventJournal) {
+		info, err := j.Info()
+		require.NoError(t, err)
+		assert.Equal(t, &api.EventJournalInfo{}, info)
+
+		events := journalTestEvents("job-1", "job-2", "job-3")
+		require.NoError(t, j.Append(events[:2]))
+		require.NoError(t, j.Append(events[2:]))
+
+		read, err := j.Read("", "", 0)
+		require.NoError(t, err)
+		require.Len(t, read, 3)
+		for i, event := range read {
+			assert.Equal(t, events[i], event.Event)
+		}
+
+		info, err = j.Info()
+		require.NoError(t, err)
+		assert.Equal(t, &api.EventJournalInfo{FirstOffset: read[0].Offset, LastOffset: read[2].Offset, NumEvents: 3}, info)
+
+		next, err := NextEventJournalOffset(read[0].Offset)
+		require.NoError(t, err)
+		rest, err := j.Read(next, read[1].Offset, 0)
+		require.NoError(t, err)
+		require.Len(t, rest, 1)
+		assert.Equal(t, read[1], rest[0])
+
+		limited, err := j.Read("", "", 2)
+		require.NoError(t, err)
+		assert.Equal(t, read[:2], limited)
+
+		_, err = j.Read("not-an-offset", "", 0)
+		assert.Error(t, err)
+	})
+}
+
+func TestNextEventJournalOffset(t *testing.T) {
+	tests := map[string]struct {
+		offset   string
+		expected string
+		err      bool
+	}{
+		"with sequence number": {offset: "1700000000000-5", expected: "1700000000000-6"},
+		"bare timestamp":       {offset: "1700000000000", expected: "1700000000001-0"},
+		"invalid":              {offset: "abc-1", err: true},
+		"invalid sequence":     {offset: "1700000000000-x", err: true},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			next, err := NextEventJournalOffset(tc.offset)
+			if tc.err {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, next)
+		})
+	}
+}
+
+func TestJournalingEventStore_JournalsEventsReportingFails(t *testing.T) {
+	withEventJournal(func(j *RedisEventJournal) {
+		store := NewJournalingEventStore(j, failingEventStore{})
+		events := journalTestEvents("job-1")
+		assert.Error(t, store.ReportEvents(armadacontext.Background(), events))
+
+		read, err := j.Read("", "", 0)
+		require.NoError(t, err)
+		require.Len(t, read, 1)
+		assert.Equal(t, events[0], read[0].Event)
+	})
+}
+
+type failingEventStore struct{}
+
+func (failingEventStore) ReportEvents(*armadacontext.Context, []*api.EventMessage) error {
+	return errors.New("failed to report events")
+}
+
+func journalTestEvents(jobIds ...string) []*api.EventMessage {
+	events := make([]*api.EventMessage, len(jobIds))
+	for i, jobId := range jobIds {
+		events[i] = &api.EventMessage{
+			Events: &api.EventMessage_Cancelled{
+				Cancelled: &api.JobCancelledEvent{JobId: jobId, JobSetId: "set", Queue: "queue"},
+			},
+		}
+	}
+	return events
+}
+
+func withEventJournal(action func(j *RedisEventJournal)) {
+	client := redis.NewClient(&redis.Options{Addr: "localhost:6379", DB: 10})
+	defer client.FlushDB()
+	defer client.Close()
+	client.FlushDB()
+	action(NewRedisEventJournal(client, 1000))
+}
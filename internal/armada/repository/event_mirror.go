@@ -0,0 +1,226 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"k8s.io/utils/clock"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+// Number of retries read from the EventMirrorRetryQueue at a time.
+const eventMirrorRetryBatchSize = 10
+
+var eventsNotMirrored = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Namespace: "armada",
+		Subsystem: "event_mirror",
+		Name:      "events_not_mirrored",
+		Help:      "Number of reported events that couldn't be mirrored, by whether they were queued to be retried, dead-lettered or lost.",
+	},
+	[]string{"outcome"},
+)
+
+// Outcomes of events that couldn't be mirrored.
+const (
+	// The events were queued to be published again later.
+	eventMirrorOutcomeRetried = "retried"
+	// The events were published to the dead-letter topic instead.
+	eventMirrorOutcomeDeadLettered = "dead_lettered"
+	// The events could neither be queued nor dead-lettered once reported, and hence aren't mirrored.
+	eventMirrorOutcomeLost = "lost"
+)
+
+// EventMirrorMessage is an event published by an EventMirrorProducer.
+type EventMirrorMessage struct {
+	// Queue and job set of the event, separated by a colon,
+	// such that the events of a job set are published to the same partition, in order.
+	Key []byte
+	// The api.EventMessage, marshalled as protobuf.
+	Value []byte
+}
+
+// EventMirrorProducer publishes messages to the topics of a streaming platform, e.g., Kafka.
+type EventMirrorProducer interface {
+	// Produce publishes messages to topic, returning once all of them are acknowledged.
+	// Messages with equal keys must be published to the same partition, in the order given.
+	Produce(ctx *armadacontext.Context, topic string, messages []*EventMirrorMessage) error
+}
+
+// MirroringEventStore reports events to an EventStore and then mirrors them to a topic of an EventMirrorProducer,
+// such that they can be ingested by platforms other than Armada.
+//
+// Events are only mirrored once reported, and publishing them is attempted once while reporting them. Events that can't
+// be published are queued to retries, from which RetryMirroring publishes them again in the background, up to
+// maxAttempts attempts in total, and then publishes events that still can't be published to deadLetterTopic instead.
+// Reported events that can neither be published, queued, nor dead-lettered are logged and counted as lost, rather than
+// failing ReportEvents, since events reported again would be stored twice.
+type MirroringEventStore struct {
+	store           EventStore
+	producer        EventMirrorProducer
+	retries         EventMirrorRetryQueue
+	topic           string
+	deadLetterTopic string
+	maxAttempts     int
+	// Time waited before the second attempt, which doubles with each attempt after.
+	backoff time.Duration
+	clock   clock.Clock
+}
+
+func NewMirroringEventStore(
+	store EventStore,
+	producer EventMirrorProducer,
+	retries EventMirrorRetryQueue,
+	topic string,
+	deadLetterTopic string,
+	maxAttempts int,
+	backoff time.Duration,
+) *MirroringEventStore {
+	return &MirroringEventStore{
+		store:           store,
+		producer:        producer,
+		retries:         retries,
+		topic:           topic,
+		deadLetterTopic: deadLetterTopic,
+		maxAttempts:     maxAttempts,
+		backoff:         backoff,
+		clock:           clock.RealClock{},
+	}
+}
+
+func (s *MirroringEventStore) ReportEvents(ctx *armadacontext.Context, events []*api.EventMessage) error {
+	if err := s.store.ReportEvents(ctx, events); err != nil {
+		return err
+	}
+	if err := s.Mirror(ctx, events); err != nil {
+		ctx.WithError(err).Errorf("error mirroring %d reported events to %s; they won't be mirrored", len(events), s.topic)
+		eventsNotMirrored.WithLabelValues(eventMirrorOutcomeLost).Add(float64(len(events)))
+	}
+	return nil
+}
+
+// Mirror publishes events to the topic of s, e.g., events published to Pulsar without being reported to s.
+// If they can't be published, they're queued to be retried by RetryMirroring, or, if they can't be queued or have no
+// attempts left, published to the dead-letter topic. Returns an error if events are published to neither.
+func (s *MirroringEventStore) Mirror(ctx *armadacontext.Context, events []*api.EventMessage) error {
+	if len(events) == 0 {
+		return nil
+	}
+	messages, err := eventMirrorMessages(events)
+	if err != nil {
+		return err
+	}
+	err = s.producer.Produce(ctx, s.topic, messages)
+	if err == nil {
+		return nil
+	}
+	if s.maxAttempts > 1 {
+		retry := &EventMirrorRetry{Events: events, Attempts: 1, Due: s.clock.Now().Add(s.backoffAfter(1))}
+		if queueErr := s.retries.AddEventMirrorRetry(retry); queueErr != nil {
+			err = errors.WithMessagef(queueErr, "error queueing events to be mirrored again after failing to mirror them: %s", err)
+		} else {
+			ctx.WithError(err).Warnf("error mirroring %d events to %s; retrying from %s", len(events), s.topic, retry.Due)
+			eventsNotMirrored.WithLabelValues(eventMirrorOutcomeRetried).Add(float64(len(events)))
+			return nil
+		}
+	}
+	return s.deadLetter(ctx, messages, err)
+}
+
+// RetryMirroring publishes the events queued by Mirror that are due to be retried.
+func (s *MirroringEventStore) RetryMirroring() {
+	ctx := armadacontext.Background()
+	retried, err := s.retryMirroring(ctx)
+	if err != nil {
+		ctx.WithError(err).Error("failed to retry mirroring events")
+	}
+	if retried > 0 {
+		ctx.Infof("retried mirroring %d batches of events", retried)
+	}
+}
+
+// retryMirroring processes due retries until none are left, or another caller holds the retry queue.
+// Retries that fail are requeued with backoff; once they've no attempts left, their events are dead-lettered,
+// and if that fails too, they're requeued to be dead-lettered later. Returns the number of retries processed.
+func (s *MirroringEventStore) retryMirroring(ctx *armadacontext.Context) (int, error) {
+	process := func(retry *EventMirrorRetry) bool {
+		messages, err := eventMirrorMessages(retry.Events)
+		if err != nil {
+			ctx.WithError(err).Errorf("error mirroring %d events; discarding them", len(retry.Events))
+			return true
+		}
+		err = errors.Errorf("failed to mirror events %d times", retry.Attempts)
+		if retry.Attempts < s.maxAttempts {
+			if err = s.producer.Produce(ctx, s.topic, messages); err == nil {
+				return true
+			}
+			retry.Attempts++
+			if retry.Attempts < s.maxAttempts {
+				retry.Due = s.clock.Now().Add(s.backoffAfter(retry.Attempts))
+				ctx.WithError(err).Warnf("error mirroring %d events to %s; retrying from %s", len(messages), s.topic, retry.Due)
+				return false
+			}
+		}
+		if err := s.deadLetter(ctx, messages, err); err != nil {
+			retry.Due = s.clock.Now().Add(s.backoffAfter(retry.Attempts))
+			ctx.WithError(err).Errorf("error dead-lettering %d events; retrying from %s", len(messages), retry.Due)
+			return false
+		}
+		return true
+	}
+	retried := 0
+	for {
+		n, err := s.retries.ProcessEventMirrorRetries(s.clock.Now(), eventMirrorRetryBatchSize, process)
+		retried += n
+		if err != nil || n < eventMirrorRetryBatchSize {
+			return retried, err
+		}
+	}
+}
+
+// deadLetter publishes messages that couldn't be mirrored because of err to the dead-letter topic of s.
+// Returns an error if that fails too.
+func (s *MirroringEventStore) deadLetter(ctx *armadacontext.Context, messages []*EventMirrorMessage, err error) error {
+	ctx.WithError(err).Errorf("error mirroring %d events to %s; publishing them to %s", len(messages), s.topic, s.deadLetterTopic)
+	if deadLetterErr := s.producer.Produce(ctx, s.deadLetterTopic, messages); deadLetterErr != nil {
+		return errors.Wrapf(deadLetterErr, "error publishing events to %s after failing to mirror them: %s", s.deadLetterTopic, err)
+	}
+	eventsNotMirrored.WithLabelValues(eventMirrorOutcomeDeadLettered).Add(float64(len(messages)))
+	return nil
+}
+
+// backoffAfter returns the time waited after the given number of failed attempts.
+func (s *MirroringEventStore) backoffAfter(attempts int) time.Duration {
+	if attempts > s.maxAttempts {
+		attempts = s.maxAttempts
+	}
+	backoff := s.backoff
+	for i := 1; i < attempts; i++ {
+		backoff *= 2
+	}
+	return backoff
+}
+
+func eventMirrorMessages(events []*api.EventMessage) ([]*EventMirrorMessage, error) {
+	messages := make([]*EventMirrorMessage, len(events))
+	for i, event := range events {
+		unwrapped, err := api.UnwrapEvent(event)
+		if err != nil {
+			return nil, err
+		}
+		value, err := proto.Marshal(event)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		messages[i] = &EventMirrorMessage{
+			Key:   []byte(unwrapped.GetQueue() + ":" + unwrapped.GetJobSetId()),
+			Value: value,
+		}
+	}
+	return messages, nil
+}
@@ -0,0 +1,135 @@
+package repository
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/internal/common/util"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+const (
+	eventMirrorRetriesKey       = "EventMirror:Retries"
+	eventMirrorRetryEventsKey   = "EventMirror:Retries:Events"
+	eventMirrorRetryAttemptsKey = "EventMirror:Retries:Attempts"
+	eventMirrorRetriesLockKey   = "EventMirror:Retries:Lock"
+	// The lock expires in case its holder dies, so it must outlive retrying a batch of retries.
+	eventMirrorRetriesLockTtl = 5 * time.Minute
+)
+
+// EventMirrorRetry is a batch of events that couldn't be mirrored yet.
+type EventMirrorRetry struct {
+	Events []*api.EventMessage
+	// Number of times publishing the events was attempted.
+	Attempts int
+	// Time from which publishing the events is attempted again.
+	Due time.Time
+}
+
+// EventMirrorRetryQueue durably holds the events a MirroringEventStore couldn't mirror until they're mirrored
+// or dead-lettered, such that they're retried in the background rather than while reporting them.
+type EventMirrorRetryQueue interface {
+	// AddEventMirrorRetry queues retry until it's due.
+	AddEventMirrorRetry(retry *EventMirrorRetry) error
+	// ProcessEventMirrorRetries calls process with up to limit of the retries due by now, in the order they're due.
+	// Retries for which process returns true are removed; others are kept, with the Attempts and Due set by process.
+	// Only one caller processes retries at a time; others return immediately without processing any.
+	// Returns the number of retries processed.
+	ProcessEventMirrorRetries(now time.Time, limit int, process func(retry *EventMirrorRetry) bool) (int, error)
+}
+
+type RedisEventMirrorRetryQueue struct {
+	db redis.UniversalClient
+}
+
+func NewRedisEventMirrorRetryQueue(db redis.UniversalClient) *RedisEventMirrorRetryQueue {
+	return &RedisEventMirrorRetryQueue{db: db}
+}
+
+func (q *RedisEventMirrorRetryQueue) AddEventMirrorRetry(retry *EventMirrorRetry) error {
+	data, err := proto.Marshal(&api.EventList{Events: retry.Events})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	id := util.NewULID()
+	pipe := q.db.TxPipeline()
+	pipe.HSet(eventMirrorRetryEventsKey, id, data)
+	pipe.HSet(eventMirrorRetryAttemptsKey, id, retry.Attempts)
+	pipe.ZAdd(eventMirrorRetriesKey, redis.Z{Member: id, Score: float64(retry.Due.UnixMilli())})
+	if _, err := pipe.Exec(); err != nil {
+		return errors.Wrapf(err, "[RedisEventMirrorRetryQueue.AddEventMirrorRetry] error adding retry of %d events", len(retry.Events))
+	}
+	return nil
+}
+
+func (q *RedisEventMirrorRetryQueue) ProcessEventMirrorRetries(now time.Time, limit int, process func(retry *EventMirrorRetry) bool) (int, error) {
+	token := util.NewULID()
+	acquired, err := q.db.SetNX(eventMirrorRetriesLockKey, token, eventMirrorRetriesLockTtl).Result()
+	if err != nil {
+		return 0, errors.Wrap(err, "[RedisEventMirrorRetryQueue.ProcessEventMirrorRetries] error acquiring lock")
+	} else if !acquired {
+		return 0, nil
+	}
+	// Failing to release the lock only delays retrying until it expires.
+	defer releaseLockScript.Run(q.db, []string{eventMirrorRetriesLockKey}, token)
+
+	ids, err := q.db.ZRangeByScore(eventMirrorRetriesKey, redis.ZRangeBy{
+		Min:   "-inf",
+		Max:   strconv.FormatInt(now.UnixMilli(), 10),
+		Count: int64(limit),
+	}).Result()
+	if err != nil {
+		return 0, errors.Wrap(err, "[RedisEventMirrorRetryQueue.ProcessEventMirrorRetries] error reading due retries")
+	}
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	data, err := q.db.HMGet(eventMirrorRetryEventsKey, ids...).Result()
+	if err != nil {
+		return 0, errors.Wrap(err, "[RedisEventMirrorRetryQueue.ProcessEventMirrorRetries] error reading events")
+	}
+	attempts, err := q.db.HMGet(eventMirrorRetryAttemptsKey, ids...).Result()
+	if err != nil {
+		return 0, errors.Wrap(err, "[RedisEventMirrorRetryQueue.ProcessEventMirrorRetries] error reading attempts")
+	}
+
+	for i, id := range ids {
+		retry, err := unmarshalEventMirrorRetry(data[i], attempts[i])
+		if err != nil {
+			return i, errors.Wrapf(err, "[RedisEventMirrorRetryQueue.ProcessEventMirrorRetries] error reading retry %s", id)
+		}
+		pipe := q.db.TxPipeline()
+		if retry == nil || process(retry) {
+			pipe.HDel(eventMirrorRetryEventsKey, id)
+			pipe.HDel(eventMirrorRetryAttemptsKey, id)
+			pipe.ZRem(eventMirrorRetriesKey, id)
+		} else {
+			pipe.HSet(eventMirrorRetryAttemptsKey, id, retry.Attempts)
+			pipe.ZAdd(eventMirrorRetriesKey, redis.Z{Member: id, Score: float64(retry.Due.UnixMilli())})
+		}
+		if _, err := pipe.Exec(); err != nil {
+			return i, errors.Wrapf(err, "[RedisEventMirrorRetryQueue.ProcessEventMirrorRetries] error updating retry %s", id)
+		}
+	}
+	return len(ids), nil
+}
+
+// unmarshalEventMirrorRetry returns the retry stored as data and attempts, or nil if it was removed while being read.
+func unmarshalEventMirrorRetry(data interface{}, attempts interface{}) (*EventMirrorRetry, error) {
+	if data == nil || attempts == nil {
+		return nil, nil
+	}
+	events := &api.EventList{}
+	if err := proto.Unmarshal([]byte(data.(string)), events); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	n, err := strconv.Atoi(attempts.(string))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &EventMirrorRetry{Events: events.Events, Attempts: n}, nil
+}
@@ -0,0 +1,186 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-redis/redis"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clock "k8s.io/utils/clock/testing"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+func TestMirroringEventStore_MirrorsReportedEvents(t *testing.T) {
+	withEventMirrorRetryQueue(func(retries *RedisEventMirrorRetryQueue) {
+		store := &TestEventStore{}
+		producer := &fakeEventMirrorProducer{}
+		s := NewMirroringEventStore(store, producer, retries, "events", "dead-letter", 3, time.Second)
+		events := journalTestEvents("job-1", "job-2")
+
+		require.NoError(t, s.ReportEvents(armadacontext.Background(), events))
+		assert.Equal(t, events, store.ReceivedEvents)
+		require.Len(t, producer.produced["events"], 2)
+		for i, message := range producer.produced["events"] {
+			assert.Equal(t, []byte("queue:set"), message.Key)
+			event := &api.EventMessage{}
+			require.NoError(t, proto.Unmarshal(message.Value, event))
+			assert.Equal(t, events[i], event)
+		}
+		assert.Empty(t, producer.produced["dead-letter"])
+	})
+}
+
+func TestMirroringEventStore_RetriesPublishingInTheBackground(t *testing.T) {
+	withEventMirrorRetryQueue(func(retries *RedisEventMirrorRetryQueue) {
+		producer := &fakeEventMirrorProducer{failures: map[string]int{"events": 2}}
+		s := NewMirroringEventStore(&TestEventStore{}, producer, retries, "events", "dead-letter", 3, time.Second)
+		testClock := clock.NewFakeClock(time.Now())
+		s.clock = testClock
+		ctx := armadacontext.Background()
+
+		require.NoError(t, s.ReportEvents(ctx, journalTestEvents("job-1")))
+		assert.Empty(t, producer.produced)
+
+		// Retries are only attempted once due, waiting longer after each attempt.
+		retried, err := s.retryMirroring(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 0, retried)
+		testClock.Step(time.Second)
+		retried, err = s.retryMirroring(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, retried)
+		assert.Empty(t, producer.produced)
+		testClock.Step(time.Second)
+		retried, err = s.retryMirroring(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 0, retried)
+		testClock.Step(time.Second)
+		retried, err = s.retryMirroring(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, retried)
+		assert.Len(t, producer.produced["events"], 1)
+		assert.Empty(t, producer.produced["dead-letter"])
+
+		testClock.Step(time.Hour)
+		retried, err = s.retryMirroring(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 0, retried)
+	})
+}
+
+func TestMirroringEventStore_PublishesToDeadLetterTopic(t *testing.T) {
+	withEventMirrorRetryQueue(func(retries *RedisEventMirrorRetryQueue) {
+		producer := &fakeEventMirrorProducer{failures: map[string]int{"events": 3}}
+		s := NewMirroringEventStore(&TestEventStore{}, producer, retries, "events", "dead-letter", 3, 0)
+		ctx := armadacontext.Background()
+
+		require.NoError(t, s.ReportEvents(ctx, journalTestEvents("job-1")))
+		for i := 0; i < 2; i++ {
+			retried, err := s.retryMirroring(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, 1, retried)
+		}
+		assert.Empty(t, producer.produced["events"])
+		assert.Len(t, producer.produced["dead-letter"], 1)
+	})
+}
+
+func TestMirroringEventStore_KeepsRetriesThatCannotBeDeadLettered(t *testing.T) {
+	withEventMirrorRetryQueue(func(retries *RedisEventMirrorRetryQueue) {
+		producer := &fakeEventMirrorProducer{failures: map[string]int{"events": 2, "dead-letter": 1}}
+		s := NewMirroringEventStore(&TestEventStore{}, producer, retries, "events", "dead-letter", 2, 0)
+		ctx := armadacontext.Background()
+
+		require.NoError(t, s.ReportEvents(ctx, journalTestEvents("job-1", "job-2")))
+		_, err := s.retryMirroring(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, producer.produced)
+
+		retried, err := s.retryMirroring(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, retried)
+		assert.Empty(t, producer.produced["events"])
+		assert.Len(t, producer.produced["dead-letter"], 2)
+	})
+}
+
+func TestMirroringEventStore_PublishesEventsThatCannotBeQueuedToDeadLetterTopic(t *testing.T) {
+	producer := &fakeEventMirrorProducer{failures: map[string]int{"events": 1}}
+	s := NewMirroringEventStore(&TestEventStore{}, producer, failingEventMirrorRetryQueue{}, "events", "dead-letter", 3, 0)
+
+	require.NoError(t, s.ReportEvents(armadacontext.Background(), journalTestEvents("job-1")))
+	assert.Empty(t, producer.produced["events"])
+	assert.Len(t, producer.produced["dead-letter"], 1)
+}
+
+func TestMirroringEventStore_CountsReportedEventsThatAreNeitherPublishedNorDeadLetteredAsLost(t *testing.T) {
+	producer := &fakeEventMirrorProducer{failures: map[string]int{"events": 1, "dead-letter": 1}}
+	store := &TestEventStore{}
+	s := NewMirroringEventStore(store, producer, failingEventMirrorRetryQueue{}, "events", "dead-letter", 3, 0)
+	lost := testutil.ToFloat64(eventsNotMirrored.WithLabelValues(eventMirrorOutcomeLost))
+
+	// The events are stored, so reporting them doesn't fail, since they'd be stored again.
+	require.NoError(t, s.ReportEvents(armadacontext.Background(), journalTestEvents("job-1", "job-2")))
+	assert.Len(t, store.ReceivedEvents, 2)
+	assert.Empty(t, producer.produced)
+	assert.Equal(t, lost+2, testutil.ToFloat64(eventsNotMirrored.WithLabelValues(eventMirrorOutcomeLost)))
+}
+
+func TestMirroringEventStore_Mirror_FailsIfEventsAreNeitherPublishedNorDeadLettered(t *testing.T) {
+	producer := &fakeEventMirrorProducer{failures: map[string]int{"events": 1, "dead-letter": 1}}
+	s := NewMirroringEventStore(&TestEventStore{}, producer, failingEventMirrorRetryQueue{}, "events", "dead-letter", 3, 0)
+
+	assert.Error(t, s.Mirror(armadacontext.Background(), journalTestEvents("job-1", "job-2")))
+	assert.Empty(t, producer.produced)
+}
+
+func TestMirroringEventStore_DoesNotMirrorUnreportedEvents(t *testing.T) {
+	producer := &fakeEventMirrorProducer{}
+	s := NewMirroringEventStore(failingEventStore{}, producer, failingEventMirrorRetryQueue{}, "events", "dead-letter", 3, 0)
+
+	assert.Error(t, s.ReportEvents(armadacontext.Background(), journalTestEvents("job-1")))
+	assert.Empty(t, producer.produced)
+}
+
+func withEventMirrorRetryQueue(action func(retries *RedisEventMirrorRetryQueue)) {
+	client := redis.NewClient(&redis.Options{Addr: "localhost:6379", DB: 10})
+	defer client.FlushDB()
+	defer client.Close()
+	client.FlushDB()
+	action(NewRedisEventMirrorRetryQueue(client))
+}
+
+type failingEventMirrorRetryQueue struct{}
+
+func (failingEventMirrorRetryQueue) AddEventMirrorRetry(*EventMirrorRetry) error {
+	return errors.New("failed to add retry")
+}
+
+func (failingEventMirrorRetryQueue) ProcessEventMirrorRetries(time.Time, int, func(*EventMirrorRetry) bool) (int, error) {
+	return 0, errors.New("failed to process retries")
+}
+
+// fakeEventMirrorProducer fails the given number of calls to produce to each topic before succeeding.
+type fakeEventMirrorProducer struct {
+	failures map[string]int
+	produced map[string][]*EventMirrorMessage
+}
+
+func (p *fakeEventMirrorProducer) Produce(_ *armadacontext.Context, topic string, messages []*EventMirrorMessage) error {
+	if p.failures[topic] > 0 {
+		p.failures[topic]--
+		return errors.Errorf("failed to produce to %s", topic)
+	}
+	if p.produced == nil {
+		p.produced = make(map[string][]*EventMirrorMessage)
+	}
+	p.produced[topic] = append(p.produced[topic], messages...)
+	return nil
+}
@@ -0,0 +1,104 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/internal/common/util"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+const (
+	eventOutboxKey     = "EventOutbox"
+	eventOutboxLockKey = "EventOutbox:RelayLock"
+	// The relay lock expires in case its holder dies, so it must outlive publishing a batch of events.
+	eventOutboxLockTtl = time.Minute
+)
+
+// EventOutbox holds events written atomically with the changes they report until they've been published.
+// Events are written to the outbox of the repository storing the changes, e.g., by JobRepository.AddJobsWithEvents.
+type EventOutbox interface {
+	// AddOutboxEvents writes events to the outbox.
+	AddOutboxEvents(events []*api.EventMessage) error
+	// RelayOutboxEvents calls publish with up to limit of the oldest events in the outbox, in the order they were written,
+	// and removes them from the outbox if publish succeeds. Returns the number of events removed.
+	// Only one caller relays events at a time; others return immediately without relaying any.
+	RelayOutboxEvents(limit int, publish func([]*api.EventMessage) error) (int, error)
+}
+
+func (repo *RedisJobRepository) AddOutboxEvents(events []*api.EventMessage) error {
+	if len(events) == 0 {
+		return nil
+	}
+	pipe := repo.db.TxPipeline()
+	for _, event := range events {
+		data, err := proto.Marshal(event)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		pipe.XAdd(&redis.XAddArgs{Stream: eventOutboxKey, Values: map[string]interface{}{dataKey: data}})
+	}
+	if _, err := pipe.Exec(); err != nil {
+		return errors.Wrapf(err, "[RedisJobRepository.AddOutboxEvents] error adding %d events", len(events))
+	}
+	return nil
+}
+
+func (repo *RedisJobRepository) RelayOutboxEvents(limit int, publish func([]*api.EventMessage) error) (int, error) {
+	token := util.NewULID()
+	acquired, err := repo.db.SetNX(eventOutboxLockKey, token, eventOutboxLockTtl).Result()
+	if err != nil {
+		return 0, errors.Wrap(err, "[RedisJobRepository.RelayOutboxEvents] error acquiring relay lock")
+	} else if !acquired {
+		return 0, nil
+	}
+	// Failing to release the lock only delays relaying until it expires.
+	defer releaseLockScript.Run(repo.db, []string{eventOutboxLockKey}, token)
+
+	messages, err := repo.db.XRangeN(eventOutboxKey, "-", "+", int64(limit)).Result()
+	if err != nil {
+		return 0, errors.Wrap(err, "[RedisJobRepository.RelayOutboxEvents] error reading events")
+	}
+	if len(messages) == 0 {
+		return 0, nil
+	}
+	events := make([]*api.EventMessage, len(messages))
+	ids := make([]string, len(messages))
+	for i, message := range messages {
+		if events[i], err = unmarshalStreamEvent(message); err != nil {
+			return 0, err
+		}
+		ids[i] = message.ID
+	}
+	if err := publish(events); err != nil {
+		return 0, err
+	}
+	if err := repo.db.XDel(eventOutboxKey, ids...).Err(); err != nil {
+		return 0, errors.Wrap(err, "[RedisJobRepository.RelayOutboxEvents] error removing published events")
+	}
+	return len(events), nil
+}
+
+// unmarshalStreamEvent returns the event stored in a Redis stream entry by the event journal or outbox.
+func unmarshalStreamEvent(message redis.XMessage) (*api.EventMessage, error) {
+	data, ok := message.Values[dataKey].(string)
+	if !ok {
+		return nil, errors.Errorf("stream entry %s has no event", message.ID)
+	}
+	event := &api.EventMessage{}
+	if err := proto.Unmarshal([]byte(data), event); err != nil {
+		return nil, errors.Wrapf(err, "error unmarshalling stream entry %s", message.ID)
+	}
+	return event, nil
+}
+
+// Deletes the lock KEYS[1] if it's still held by the holder with token ARGV[1].
+var releaseLockScript = redis.NewScript(`
+if redis.call('GET', KEYS[1]) == ARGV[1] then
+	return redis.call('DEL', KEYS[1])
+end
+return 0
+`)
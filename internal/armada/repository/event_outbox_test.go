@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/armadaproject/armada/internal/common/util"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+func TestAddJobsWithEvents_WritesEventsOfStoredJobsOnly(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		existing := addTestJob(t, r, "queue")
+		jobs := []*api.Job{
+			{Id: util.NewULID(), Queue: "queue", JobSetId: "set"},
+			{Id: existing.Id, Queue: "queue", JobSetId: "set"},
+			{Id: util.NewULID(), Queue: "queue", JobSetId: "set"},
+		}
+		events := [][]*api.EventMessage{
+			journalTestEvents("job-1a", "job-1b"),
+			journalTestEvents("job-2"),
+			journalTestEvents("job-3"),
+		}
+		results, err := r.AddJobsWithEvents(jobs, events)
+		require.NoError(t, err)
+		require.Len(t, results, 3)
+		assert.False(t, results[0].AlreadyProcessed)
+		assert.True(t, results[1].AlreadyProcessed)
+		assert.False(t, results[2].AlreadyProcessed)
+
+		require.NoError(t, r.AddOutboxEvents(journalTestEvents("job-4")))
+
+		var published []*api.EventMessage
+		relayed, err := r.RelayOutboxEvents(10, func(events []*api.EventMessage) error {
+			published = append(published, events...)
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 4, relayed)
+		assert.Equal(t, journalTestEvents("job-1a", "job-1b", "job-3", "job-4"), published)
+
+		relayed, err = r.RelayOutboxEvents(10, func([]*api.EventMessage) error { return nil })
+		require.NoError(t, err)
+		assert.Equal(t, 0, relayed)
+	})
+}
+
+func TestRelayOutboxEvents_KeepsEventsIfPublishingFails(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		require.NoError(t, r.AddOutboxEvents(journalTestEvents("job-1", "job-2", "job-3")))
+
+		_, err := r.RelayOutboxEvents(10, func([]*api.EventMessage) error {
+			return errors.New("failed to publish")
+		})
+		assert.Error(t, err)
+
+		var published []*api.EventMessage
+		relayed, err := r.RelayOutboxEvents(2, func(events []*api.EventMessage) error {
+			published = append(published, events...)
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 2, relayed)
+		assert.Equal(t, journalTestEvents("job-1", "job-2"), published)
+	})
+}
+
+func TestRelayOutboxEvents_OneRelayAtATime(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		require.NoError(t, r.AddOutboxEvents(journalTestEvents("job-1")))
+
+		relayed, err := r.RelayOutboxEvents(10, func([]*api.EventMessage) error {
+			concurrentlyRelayed, err := r.RelayOutboxEvents(10, func([]*api.EventMessage) error { return nil })
+			require.NoError(t, err)
+			assert.Equal(t, 0, concurrentlyRelayed)
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 1, relayed)
+	})
+}
@@ -1,6 +1,8 @@
 package repository
 
 import (
+	"sync"
+
 	"github.com/apache/pulsar-client-go/pulsar"
 
 	"github.com/armadaproject/armada/internal/common/armadacontext"
@@ -16,9 +18,12 @@ type EventStore interface {
 
 type TestEventStore struct {
 	ReceivedEvents []*api.EventMessage
+	mu             sync.Mutex
 }
 
 func (es *TestEventStore) ReportEvents(_ *armadacontext.Context, message []*api.EventMessage) error {
+	es.mu.Lock()
+	defer es.mu.Unlock()
 	es.ReceivedEvents = append(es.ReceivedEvents, message...)
 	return nil
 }
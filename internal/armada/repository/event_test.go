@@ -8,10 +8,12 @@ import (
 	"github.com/gogo/protobuf/proto"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
-	"github.com/armadaproject/armada/internal/armada/repository/sequence"
 	"github.com/armadaproject/armada/internal/common/compress"
+	"github.com/armadaproject/armada/internal/common/eventschema"
 	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/api/sequence"
 	"github.com/armadaproject/armada/pkg/armadaevents"
 )
 
@@ -178,6 +180,41 @@ func TestRead(t *testing.T) {
 	})
 }
 
+func TestRead_UpgradesEvents(t *testing.T) {
+	withRedisEventRepository(func(r *RedisEventRepository) {
+		translator, err := eventschema.NewTranslator([]eventschema.Migration{{
+			Version: 2,
+			Upgrade: func(event *api.EventMessage) (*api.EventMessage, error) {
+				upgraded := proto.Clone(event).(*api.EventMessage)
+				if running := upgraded.GetRunning(); running != nil {
+					running.NodeName = "upgraded-" + running.NodeName
+				}
+				return upgraded, nil
+			},
+			Downgrade: func(event *api.EventMessage) (*api.EventMessage, error) {
+				return event, nil
+			},
+		}})
+		require.NoError(t, err)
+		r.translator = translator
+
+		// Events stored without a version are of version 1; events stored by later versions aren't translated.
+		require.NoError(t, storeEvents(r, running))
+		require.NoError(t, storeEventsWithSchemaVersion(r, 2, running))
+		require.NoError(t, storeEventsWithSchemaVersion(r, 3, running))
+
+		events, _, err := r.ReadEvents(testQueue, jobSetName, "", 500, 1*time.Second)
+		require.NoError(t, err)
+		require.Len(t, events, 3)
+		assert.Equal(t, uint32(2), events[0].SchemaVersion)
+		assert.Equal(t, "upgraded-"+nodeName, events[0].Message.GetRunning().NodeName)
+		assert.Equal(t, uint32(2), events[1].SchemaVersion)
+		assert.Equal(t, nodeName, events[1].Message.GetRunning().NodeName)
+		assert.Equal(t, uint32(3), events[2].SchemaVersion)
+		assert.Equal(t, nodeName, events[2].Message.GetRunning().NodeName)
+	})
+}
+
 func TestGetLastId(t *testing.T) {
 	withRedisEventRepository(func(r *RedisEventRepository) {
 		// Event doesn't exist- should be "0"
@@ -235,6 +272,12 @@ func assertExpected(t *testing.T, actual []*api.EventStreamMessage, lastMessageI
 }
 
 func storeEvents(r *RedisEventRepository, events ...*armadaevents.EventSequence_Event) error {
+	return storeEventsWithSchemaVersion(r, 0, events...)
+}
+
+// storeEventsWithSchemaVersion stores events as stored with version schemaVersion of the event schema,
+// or without a version if it's 0.
+func storeEventsWithSchemaVersion(r *RedisEventRepository, schemaVersion uint32, events ...*armadaevents.EventSequence_Event) error {
 	// create an eventSequence
 	es := &armadaevents.EventSequence{Events: events}
 
@@ -251,12 +294,12 @@ func storeEvents(r *RedisEventRepository, events ...*armadaevents.EventSequence_
 		return err
 	}
 
-	r.db.XAdd(&redis.XAddArgs{
+	values := map[string]interface{}{dataKey: compressed}
+	if schemaVersion != 0 {
+		values[schemaVersionKey] = schemaVersion
+	}
+	return r.db.XAdd(&redis.XAddArgs{
 		Stream: eventStreamPrefix + testQueue + ":" + jobSetName,
-		Values: map[string]interface{}{
-			dataKey: compressed,
-		},
-	})
-
-	return nil
+		Values: values,
+	}).Err()
 }
@@ -0,0 +1,228 @@
+package repository
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+const (
+	executorCredentialPrefix         = "ExecutorCredential:"
+	executorCredentialsByExecutorKey = "ExecutorCredentials:"
+	// Credentials are kept for this long after they expire, such that they can still be inspected.
+	expiredExecutorCredentialRetention = 7 * 24 * time.Hour
+	// The last used time of executor credentials is written at most this often per credential.
+	executorCredentialLastUsedResolution = time.Minute
+)
+
+type ErrExecutorCredentialNotFound struct {
+	Id string
+}
+
+func (err *ErrExecutorCredentialNotFound) Error() string {
+	return fmt.Sprintf("could not find executor credential %q", err.Id)
+}
+
+// ExecutorCredentialRepository stores credentials minted for executors.
+// Only a hash of the secret part of each credential is stored.
+type ExecutorCredentialRepository interface {
+	AddExecutorCredential(credential *api.ExecutorCredential, secretHash []byte) error
+	// UpdateExecutorCredential overwrites the metadata of an existing credential, e.g., to revoke it.
+	UpdateExecutorCredential(credential *api.ExecutorCredential) error
+	// GetExecutorCredential returns the credential with the given id and the hash of its secret.
+	GetExecutorCredential(id string) (*api.ExecutorCredential, []byte, error)
+	// GetExecutorCredentials returns all credentials of the given executor that haven't yet been cleaned up.
+	GetExecutorCredentials(executorId string) ([]*api.ExecutorCredential, error)
+	// MarkExecutorCredentialUsed sets the time at which a credential was most recently used.
+	MarkExecutorCredentialUsed(id string, t time.Time) error
+}
+
+type RedisExecutorCredentialRepository struct {
+	db redis.UniversalClient
+}
+
+func NewRedisExecutorCredentialRepository(db redis.UniversalClient) *RedisExecutorCredentialRepository {
+	return &RedisExecutorCredentialRepository{db: db}
+}
+
+func (r *RedisExecutorCredentialRepository) AddExecutorCredential(credential *api.ExecutorCredential, secretHash []byte) error {
+	data, err := proto.Marshal(credential)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	key := executorCredentialKey(credential.Id)
+	pipe := r.db.TxPipeline()
+	pipe.HMSet(key, map[string]interface{}{
+		"credential": data,
+		"secretHash": secretHash,
+	})
+	pipe.ExpireAt(key, credential.Expires.Add(expiredExecutorCredentialRetention))
+	pipe.SAdd(executorCredentialsByExecutorKey+credential.ExecutorId, credential.Id)
+	if _, err := pipe.Exec(); err != nil {
+		return errors.Wrapf(err, "[RedisExecutorCredentialRepository.AddExecutorCredential] error writing credential %s", credential.Id)
+	}
+	return nil
+}
+
+func (r *RedisExecutorCredentialRepository) UpdateExecutorCredential(credential *api.ExecutorCredential) error {
+	data, err := proto.Marshal(credential)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	updated, err := updateExecutorCredentialScript.Run(
+		r.db,
+		[]string{executorCredentialKey(credential.Id)},
+		data,
+		credential.Expires.Add(expiredExecutorCredentialRetention).Unix(),
+	).Int()
+	if err != nil {
+		return errors.Wrapf(err, "[RedisExecutorCredentialRepository.UpdateExecutorCredential] error writing credential %s", credential.Id)
+	}
+	if updated == 0 {
+		return &ErrExecutorCredentialNotFound{Id: credential.Id}
+	}
+	return nil
+}
+
+func (r *RedisExecutorCredentialRepository) GetExecutorCredential(id string) (*api.ExecutorCredential, []byte, error) {
+	fields, err := r.db.HGetAll(executorCredentialKey(id)).Result()
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "[RedisExecutorCredentialRepository.GetExecutorCredential] error reading credential %s", id)
+	}
+	if len(fields) == 0 {
+		return nil, nil, &ErrExecutorCredentialNotFound{Id: id}
+	}
+	credential, err := executorCredentialFromFields(fields)
+	if err != nil {
+		return nil, nil, errors.WithMessagef(err, "[RedisExecutorCredentialRepository.GetExecutorCredential] error decoding credential %s", id)
+	}
+	return credential, []byte(fields["secretHash"]), nil
+}
+
+func (r *RedisExecutorCredentialRepository) GetExecutorCredentials(executorId string) ([]*api.ExecutorCredential, error) {
+	ids, err := r.db.SMembers(executorCredentialsByExecutorKey + executorId).Result()
+	if err != nil {
+		return nil, errors.Wrapf(err, "[RedisExecutorCredentialRepository.GetExecutorCredentials] error reading credentials of executor %s", executorId)
+	}
+	pipe := r.db.Pipeline()
+	cmds := make([]*redis.StringStringMapCmd, len(ids))
+	for i, id := range ids {
+		cmds[i] = pipe.HGetAll(executorCredentialKey(id))
+	}
+	if _, err := pipe.Exec(); err != nil {
+		return nil, errors.Wrapf(err, "[RedisExecutorCredentialRepository.GetExecutorCredentials] error reading credentials of executor %s", executorId)
+	}
+	credentials := make([]*api.ExecutorCredential, 0, len(ids))
+	var expiredIds []interface{}
+	for i, cmd := range cmds {
+		fields := cmd.Val()
+		if len(fields) == 0 {
+			expiredIds = append(expiredIds, ids[i])
+			continue
+		}
+		credential, err := executorCredentialFromFields(fields)
+		if err != nil {
+			return nil, errors.WithMessagef(err, "[RedisExecutorCredentialRepository.GetExecutorCredentials] error decoding credential %s", ids[i])
+		}
+		credentials = append(credentials, credential)
+	}
+	if len(expiredIds) > 0 {
+		if err := r.db.SRem(executorCredentialsByExecutorKey+executorId, expiredIds...).Err(); err != nil {
+			return nil, errors.Wrapf(err, "[RedisExecutorCredentialRepository.GetExecutorCredentials] error removing expired credentials of executor %s", executorId)
+		}
+	}
+	return credentials, nil
+}
+
+func (r *RedisExecutorCredentialRepository) MarkExecutorCredentialUsed(id string, t time.Time) error {
+	// HSet on a credential that has since been cleaned up would create a partial record; only update existing ones.
+	if err := markExecutorCredentialUsedScript.Run(r.db, []string{executorCredentialKey(id)}, t.UnixNano()).Err(); err != nil {
+		return errors.Wrapf(err, "[RedisExecutorCredentialRepository.MarkExecutorCredentialUsed] error updating credential %s", id)
+	}
+	return nil
+}
+
+func executorCredentialFromFields(fields map[string]string) (*api.ExecutorCredential, error) {
+	credential := &api.ExecutorCredential{}
+	if err := proto.Unmarshal([]byte(fields["credential"]), credential); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if lastUsed, ok := fields["lastUsed"]; ok {
+		nanos, err := strconv.ParseInt(lastUsed, 10, 64)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		t := time.Unix(0, nanos).UTC()
+		credential.LastUsed = &t
+	}
+	return credential, nil
+}
+
+func executorCredentialKey(id string) string {
+	return executorCredentialPrefix + id
+}
+
+var updateExecutorCredentialScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 0 then
+	return 0
+end
+redis.call('HSET', KEYS[1], 'credential', ARGV[1])
+redis.call('EXPIREAT', KEYS[1], ARGV[2])
+return 1
+`)
+
+var markExecutorCredentialUsedScript = redis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 1 then
+	redis.call('HSET', KEYS[1], 'lastUsed', ARGV[1])
+end
+return 0
+`)
+
+// VerifyExecutorCredential returns the id of the executor the credential with the given id was minted for,
+// or an error if secret doesn't match the credential or if the credential is expired or revoked as of now.
+func VerifyExecutorCredential(repository ExecutorCredentialRepository, now time.Time, credentialId string, secret string) (string, error) {
+	credential, secretHash, err := repository.GetExecutorCredential(credentialId)
+	if err != nil {
+		return "", err
+	}
+	hash := sha256.Sum256([]byte(secret))
+	if subtle.ConstantTimeCompare(hash[:], secretHash) != 1 {
+		return "", errors.Errorf("invalid secret for executor credential %s", credentialId)
+	}
+	if credential.Revoked != nil {
+		return "", errors.Errorf("executor credential %s was revoked at %s", credentialId, credential.Revoked)
+	}
+	if !now.Before(credential.Expires) {
+		return "", errors.Errorf("executor credential %s expired at %s", credentialId, credential.Expires)
+	}
+	if credential.LastUsed == nil || now.Sub(*credential.LastUsed) >= executorCredentialLastUsedResolution {
+		if err := repository.MarkExecutorCredentialUsed(credentialId, now); err != nil {
+			log.WithError(err).Warnf("error recording use of executor credential %s", credentialId)
+		}
+	}
+	return credential.ExecutorId, nil
+}
+
+// ExecutorCredentialVerifier implements authorization.ExecutorCredentialVerifier for the credentials stored in a
+// repository, such that components other than the server minting them, e.g., the scheduler, can accept them.
+type ExecutorCredentialVerifier struct {
+	repository ExecutorCredentialRepository
+}
+
+func NewExecutorCredentialVerifier(repository ExecutorCredentialRepository) *ExecutorCredentialVerifier {
+	return &ExecutorCredentialVerifier{repository: repository}
+}
+
+func (v *ExecutorCredentialVerifier) VerifyExecutorCredential(_ context.Context, credentialId string, secret string) (string, error) {
+	return VerifyExecutorCredential(v.repository, time.Now(), credentialId, secret)
+}
@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+func TestExecutorCredentials(t *testing.T) {
+	withExecutorCredentialRepository(func(r *RedisExecutorCredentialRepository) {
+		created := time.Now().UTC().Truncate(time.Second)
+		credential := &api.ExecutorCredential{
+			Id:         "cred-1",
+			ExecutorId: "cluster-1",
+			Created:    created,
+			Expires:    created.Add(24 * time.Hour),
+			CreatedBy:  "admin",
+		}
+		require.NoError(t, r.AddExecutorCredential(credential, []byte("hash")))
+		require.NoError(t, r.AddExecutorCredential(&api.ExecutorCredential{
+			Id:         "cred-2",
+			ExecutorId: "cluster-2",
+			Created:    created,
+			Expires:    created.Add(24 * time.Hour),
+		}, []byte("otherHash")))
+
+		actual, hash, err := r.GetExecutorCredential("cred-1")
+		require.NoError(t, err)
+		assert.Equal(t, credential, actual)
+		assert.Equal(t, []byte("hash"), hash)
+
+		lastUsed := created.Add(time.Hour)
+		require.NoError(t, r.MarkExecutorCredentialUsed("cred-1", lastUsed))
+		revoked := created.Add(2 * time.Hour)
+		credential.Revoked = &revoked
+		require.NoError(t, r.UpdateExecutorCredential(credential))
+
+		credentials, err := r.GetExecutorCredentials("cluster-1")
+		require.NoError(t, err)
+		require.Len(t, credentials, 1)
+		assert.Equal(t, revoked, *credentials[0].Revoked)
+		assert.Equal(t, lastUsed, *credentials[0].LastUsed)
+
+		// Updating the metadata doesn't affect the secret.
+		_, hash, err = r.GetExecutorCredential("cred-1")
+		require.NoError(t, err)
+		assert.Equal(t, []byte("hash"), hash)
+	})
+}
+
+func TestExecutorCredentials_NotFound(t *testing.T) {
+	withExecutorCredentialRepository(func(r *RedisExecutorCredentialRepository) {
+		var notFoundErr *ErrExecutorCredentialNotFound
+		_, _, err := r.GetExecutorCredential("unknown")
+		assert.ErrorAs(t, err, &notFoundErr)
+		err = r.UpdateExecutorCredential(&api.ExecutorCredential{Id: "unknown"})
+		assert.ErrorAs(t, err, &notFoundErr)
+
+		// Marking unknown credentials as used must not create them.
+		require.NoError(t, r.MarkExecutorCredentialUsed("unknown", time.Now()))
+		_, _, err = r.GetExecutorCredential("unknown")
+		assert.ErrorAs(t, err, &notFoundErr)
+
+		credentials, err := r.GetExecutorCredentials("cluster-1")
+		require.NoError(t, err)
+		assert.Empty(t, credentials)
+	})
+}
+
+func withExecutorCredentialRepository(action func(r *RedisExecutorCredentialRepository)) {
+	client := redis.NewClient(&redis.Options{Addr: "localhost:6379", DB: 10})
+	defer client.FlushDB()
+	defer client.Close()
+	client.FlushDB()
+	action(NewRedisExecutorCredentialRepository(client))
+}
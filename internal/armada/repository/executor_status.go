@@ -0,0 +1,120 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+	"golang.org/x/exp/slices"
+
+	"github.com/armadaproject/armada/internal/common/util"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+const (
+	executorStatusKey     = "Executor:Status"     // status of each executor as of its most recent heartbeat, by executor id
+	unhealthyExecutorsKey = "Executor:Unhealthy"  // set of ids of executors alerted on as unhealthy
+	executorHealthLockKey = "Executor:HealthLock" // held while checking the health of executors
+	// The lock expires in case its holder dies, so it must outlive checking executors, including alerting on them.
+	executorHealthLockTtl = 5 * time.Minute
+)
+
+// ExecutorStatusRepository stores the status of executors as of their most recent heartbeat,
+// along with which executors have been found unhealthy.
+type ExecutorStatusRepository interface {
+	// StoreExecutorStatus stores the status of an executor, replacing its previous status.
+	StoreExecutorStatus(status *api.ExecutorStatus) error
+	// GetExecutorStatuses returns the status of each executor, ordered by id.
+	GetExecutorStatuses() ([]*api.ExecutorStatus, error)
+	// GetUnhealthyExecutors returns the ids of the executors marked unhealthy.
+	GetUnhealthyExecutors() (map[string]bool, error)
+	// ProcessExecutorHealth calls process. Only one caller processes the health of executors at a time;
+	// others return false immediately without calling process.
+	ProcessExecutorHealth(process func()) (bool, error)
+	// MarkExecutorUnhealthy records that the executor with the given id is unhealthy.
+	// Returns false if it was already marked unhealthy, such that each change of health is reported once.
+	MarkExecutorUnhealthy(id string) (bool, error)
+	// MarkExecutorHealthy records that the executor with the given id is healthy.
+	// Returns false if it wasn't marked unhealthy.
+	MarkExecutorHealthy(id string) (bool, error)
+}
+
+type RedisExecutorStatusRepository struct {
+	db redis.UniversalClient
+}
+
+func NewRedisExecutorStatusRepository(db redis.UniversalClient) *RedisExecutorStatusRepository {
+	return &RedisExecutorStatusRepository{db: db}
+}
+
+func (r *RedisExecutorStatusRepository) StoreExecutorStatus(status *api.ExecutorStatus) error {
+	data, err := proto.Marshal(status)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if err := r.db.HSet(executorStatusKey, status.Id, data).Err(); err != nil {
+		return errors.Wrapf(err, "[RedisExecutorStatusRepository.StoreExecutorStatus] error storing status of executor %s", status.Id)
+	}
+	return nil
+}
+
+func (r *RedisExecutorStatusRepository) GetExecutorStatuses() ([]*api.ExecutorStatus, error) {
+	values, err := r.db.HGetAll(executorStatusKey).Result()
+	if err != nil {
+		return nil, errors.Wrap(err, "[RedisExecutorStatusRepository.GetExecutorStatuses] error getting executor statuses")
+	}
+	statuses := make([]*api.ExecutorStatus, 0, len(values))
+	for id, data := range values {
+		status := &api.ExecutorStatus{}
+		if err := proto.Unmarshal([]byte(data), status); err != nil {
+			return nil, errors.Wrapf(err, "[RedisExecutorStatusRepository.GetExecutorStatuses] error unmarshalling status of executor %s", id)
+		}
+		statuses = append(statuses, status)
+	}
+	slices.SortFunc(statuses, func(a, b *api.ExecutorStatus) bool { return a.Id < b.Id })
+	return statuses, nil
+}
+
+func (r *RedisExecutorStatusRepository) MarkExecutorUnhealthy(id string) (bool, error) {
+	added, err := r.db.SAdd(unhealthyExecutorsKey, id).Result()
+	if err != nil {
+		return false, errors.Wrapf(err, "[RedisExecutorStatusRepository.MarkExecutorUnhealthy] error marking executor %s unhealthy", id)
+	}
+	return added > 0, nil
+}
+
+func (r *RedisExecutorStatusRepository) MarkExecutorHealthy(id string) (bool, error) {
+	removed, err := r.db.SRem(unhealthyExecutorsKey, id).Result()
+	if err != nil {
+		return false, errors.Wrapf(err, "[RedisExecutorStatusRepository.MarkExecutorHealthy] error marking executor %s healthy", id)
+	}
+	return removed > 0, nil
+}
+
+func (r *RedisExecutorStatusRepository) GetUnhealthyExecutors() (map[string]bool, error) {
+	ids, err := r.db.SMembers(unhealthyExecutorsKey).Result()
+	if err != nil {
+		return nil, errors.Wrap(err, "[RedisExecutorStatusRepository.GetUnhealthyExecutors] error getting unhealthy executors")
+	}
+	unhealthy := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		unhealthy[id] = true
+	}
+	return unhealthy, nil
+}
+
+func (r *RedisExecutorStatusRepository) ProcessExecutorHealth(process func()) (bool, error) {
+	token := util.NewULID()
+	acquired, err := r.db.SetNX(executorHealthLockKey, token, executorHealthLockTtl).Result()
+	if err != nil {
+		return false, errors.Wrap(err, "[RedisExecutorStatusRepository.ProcessExecutorHealth] error acquiring lock")
+	} else if !acquired {
+		return false, nil
+	}
+	// Failing to release the lock only delays checking executors until it expires.
+	defer releaseLockScript.Run(r.db, []string{executorHealthLockKey}, token)
+
+	process()
+	return true, nil
+}
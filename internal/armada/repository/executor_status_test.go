@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+func TestExecutorStatusRepository(t *testing.T) {
+	withExecutorStatusRepository(func(r *RedisExecutorStatusRepository) {
+		now := time.Now().UTC().Truncate(time.Second)
+		b := &api.ExecutorStatus{Id: "b", Pool: "cpu", LastHeartbeat: now, Version: "v1.2.3"}
+		a := &api.ExecutorStatus{
+			Id:            "a",
+			Pool:          "gpu",
+			LastHeartbeat: now,
+			Capacity:      map[string]resource.Quantity{"cpu": resource.MustParse("10")},
+			Allocated:     map[string]resource.Quantity{"cpu": resource.MustParse("4")},
+		}
+		require.NoError(t, r.StoreExecutorStatus(b))
+		require.NoError(t, r.StoreExecutorStatus(a))
+
+		statuses, err := r.GetExecutorStatuses()
+		require.NoError(t, err)
+		require.Len(t, statuses, 2)
+		assert.Equal(t, "a", statuses[0].Id)
+		assert.True(t, a.Capacity["cpu"].Equal(statuses[0].Capacity["cpu"]))
+		assert.True(t, a.Allocated["cpu"].Equal(statuses[0].Allocated["cpu"]))
+		assert.Equal(t, b, statuses[1])
+
+		// Each change of health is reported once.
+		marked, err := r.MarkExecutorUnhealthy("a")
+		require.NoError(t, err)
+		assert.True(t, marked)
+		marked, err = r.MarkExecutorUnhealthy("a")
+		require.NoError(t, err)
+		assert.False(t, marked)
+		unhealthy, err := r.GetUnhealthyExecutors()
+		require.NoError(t, err)
+		assert.Equal(t, map[string]bool{"a": true}, unhealthy)
+		marked, err = r.MarkExecutorHealthy("a")
+		require.NoError(t, err)
+		assert.True(t, marked)
+		marked, err = r.MarkExecutorHealthy("a")
+		require.NoError(t, err)
+		assert.False(t, marked)
+	})
+}
+
+func TestProcessExecutorHealth_OneCallerAtATime(t *testing.T) {
+	withExecutorStatusRepository(func(r *RedisExecutorStatusRepository) {
+		processed := false
+		acquired, err := r.ProcessExecutorHealth(func() {
+			processed = true
+			concurrentlyAcquired, err := r.ProcessExecutorHealth(func() {
+				t.Error("executors processed concurrently")
+			})
+			require.NoError(t, err)
+			assert.False(t, concurrentlyAcquired)
+		})
+		require.NoError(t, err)
+		assert.True(t, acquired)
+		assert.True(t, processed)
+
+		acquired, err = r.ProcessExecutorHealth(func() {})
+		require.NoError(t, err)
+		assert.True(t, acquired)
+	})
+}
+
+func withExecutorStatusRepository(action func(r *RedisExecutorStatusRepository)) {
+	client := redis.NewClient(&redis.Options{Addr: "localhost:6379", DB: 10})
+	defer client.FlushDB()
+	defer client.Close()
+	client.FlushDB()
+	action(NewRedisExecutorStatusRepository(client))
+}
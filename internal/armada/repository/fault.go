@@ -0,0 +1,174 @@
+package repository
+
+import (
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/client/queue"
+)
+
+// ErrInjectedFault is returned by repositories wrapped with a FaultInjector when a fault is injected.
+type ErrInjectedFault struct {
+	Target    api.FaultTarget
+	Operation string
+}
+
+func (err *ErrInjectedFault) Error() string {
+	return fmt.Sprintf("injected fault in %s for operation %s", err.Target, err.Operation)
+}
+
+// FaultInjector stores the faults currently injected into each target.
+// It's used by the server test mode to check how a deployment behaves when its dependencies misbehave.
+type FaultInjector struct {
+	faults map[api.FaultTarget]api.Fault
+	rand   *rand.Rand
+	mu     sync.Mutex
+}
+
+func NewFaultInjector() *FaultInjector {
+	return &FaultInjector{
+		faults: make(map[api.FaultTarget]api.Fault),
+		rand:   rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+}
+
+// SetFaults replaces the faults for the targets in the provided faults.
+// Faults for other targets are left unchanged.
+func (f *FaultInjector) SetFaults(faults []*api.Fault) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, fault := range faults {
+		f.faults[fault.Target] = *fault
+	}
+}
+
+// Clear removes all injected faults.
+func (f *FaultInjector) Clear() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.faults = make(map[api.FaultTarget]api.Fault)
+}
+
+// Inject applies any fault for target, i.e., it sleeps for the configured latency
+// and returns an ErrInjectedFault with the configured probability.
+func (f *FaultInjector) Inject(target api.FaultTarget, operation string) error {
+	f.mu.Lock()
+	fault, ok := f.faults[target]
+	fail := ok && f.rand.Float64() < fault.ErrorProbability
+	f.mu.Unlock()
+	if !ok {
+		return nil
+	}
+	if fault.Latency > 0 {
+		time.Sleep(fault.Latency)
+	}
+	if fail {
+		return &ErrInjectedFault{Target: target, Operation: operation}
+	}
+	return nil
+}
+
+// FaultInjectingJobRepository is a JobRepository into which faults may be injected.
+// Faults are injected into the operations used by the submit path.
+type FaultInjectingJobRepository struct {
+	JobRepository
+	injector *FaultInjector
+}
+
+func NewFaultInjectingJobRepository(jobRepository JobRepository, injector *FaultInjector) *FaultInjectingJobRepository {
+	return &FaultInjectingJobRepository{JobRepository: jobRepository, injector: injector}
+}
+
+func (repo *FaultInjectingJobRepository) AddJobs(jobs []*api.Job) ([]*SubmitJobResult, error) {
+	if err := repo.injector.Inject(api.FaultTarget_JOB_REPOSITORY, "AddJobs"); err != nil {
+		return nil, err
+	}
+	return repo.JobRepository.AddJobs(jobs)
+}
+
+func (repo *FaultInjectingJobRepository) AddJobsWithEvents(jobs []*api.Job, events [][]*api.EventMessage) ([]*SubmitJobResult, error) {
+	if err := repo.injector.Inject(api.FaultTarget_JOB_REPOSITORY, "AddJobsWithEvents"); err != nil {
+		return nil, err
+	}
+	return repo.JobRepository.AddJobsWithEvents(jobs, events)
+}
+
+func (repo *FaultInjectingJobRepository) GetExistingJobsByIds(ids []string) ([]*api.Job, error) {
+	if err := repo.injector.Inject(api.FaultTarget_JOB_REPOSITORY, "GetExistingJobsByIds"); err != nil {
+		return nil, err
+	}
+	return repo.JobRepository.GetExistingJobsByIds(ids)
+}
+
+func (repo *FaultInjectingJobRepository) DeleteJobs(jobs []*api.Job) (map[*api.Job]error, error) {
+	if err := repo.injector.Inject(api.FaultTarget_JOB_REPOSITORY, "DeleteJobs"); err != nil {
+		return nil, err
+	}
+	return repo.JobRepository.DeleteJobs(jobs)
+}
+
+func (repo *FaultInjectingJobRepository) UpdateJobs(ids []string, mutator func([]*api.Job)) ([]UpdateJobResult, error) {
+	if err := repo.injector.Inject(api.FaultTarget_JOB_REPOSITORY, "UpdateJobs"); err != nil {
+		return nil, err
+	}
+	return repo.JobRepository.UpdateJobs(ids, mutator)
+}
+
+func (repo *FaultInjectingJobRepository) GetQueueSizes(queues []*api.Queue) ([]int64, error) {
+	if err := repo.injector.Inject(api.FaultTarget_JOB_REPOSITORY, "GetQueueSizes"); err != nil {
+		return nil, err
+	}
+	return repo.JobRepository.GetQueueSizes(queues)
+}
+
+// FaultInjectingQueueRepository is a QueueRepository into which faults may be injected.
+type FaultInjectingQueueRepository struct {
+	QueueRepository
+	injector *FaultInjector
+}
+
+func NewFaultInjectingQueueRepository(queueRepository QueueRepository, injector *FaultInjector) *FaultInjectingQueueRepository {
+	return &FaultInjectingQueueRepository{QueueRepository: queueRepository, injector: injector}
+}
+
+func (r *FaultInjectingQueueRepository) GetAllQueues() ([]queue.Queue, error) {
+	if err := r.injector.Inject(api.FaultTarget_QUEUE_REPOSITORY, "GetAllQueues"); err != nil {
+		return nil, err
+	}
+	return r.QueueRepository.GetAllQueues()
+}
+
+func (r *FaultInjectingQueueRepository) GetQueueSnapshot() ([]queue.Queue, uint64, error) {
+	if err := r.injector.Inject(api.FaultTarget_QUEUE_REPOSITORY, "GetQueueSnapshot"); err != nil {
+		return nil, 0, err
+	}
+	return r.QueueRepository.GetQueueSnapshot()
+}
+
+func (r *FaultInjectingQueueRepository) GetQueue(name string) (queue.Queue, error) {
+	if err := r.injector.Inject(api.FaultTarget_QUEUE_REPOSITORY, "GetQueue"); err != nil {
+		return queue.Queue{}, err
+	}
+	return r.QueueRepository.GetQueue(name)
+}
+
+// FaultInjectingEventStore is an EventStore into which faults may be injected.
+type FaultInjectingEventStore struct {
+	EventStore
+	injector *FaultInjector
+}
+
+func NewFaultInjectingEventStore(eventStore EventStore, injector *FaultInjector) *FaultInjectingEventStore {
+	return &FaultInjectingEventStore{EventStore: eventStore, injector: injector}
+}
+
+func (es *FaultInjectingEventStore) ReportEvents(ctx *armadacontext.Context, events []*api.EventMessage) error {
+	if err := es.injector.Inject(api.FaultTarget_EVENT_STORE, "ReportEvents"); err != nil {
+		return err
+	}
+	return es.EventStore.ReportEvents(ctx, events)
+}
@@ -0,0 +1,44 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+func TestFaultInjector_Inject(t *testing.T) {
+	injector := NewFaultInjector()
+	assert.NoError(t, injector.Inject(api.FaultTarget_JOB_REPOSITORY, "AddJobs"))
+
+	injector.SetFaults([]*api.Fault{
+		{Target: api.FaultTarget_JOB_REPOSITORY, ErrorProbability: 1},
+		{Target: api.FaultTarget_EVENT_STORE, ErrorProbability: 0},
+	})
+	for i := 0; i < 10; i++ {
+		err := injector.Inject(api.FaultTarget_JOB_REPOSITORY, "AddJobs")
+		var faultErr *ErrInjectedFault
+		assert.ErrorAs(t, err, &faultErr)
+		assert.NoError(t, injector.Inject(api.FaultTarget_EVENT_STORE, "ReportEvents"))
+		assert.NoError(t, injector.Inject(api.FaultTarget_QUEUE_REPOSITORY, "GetQueue"))
+	}
+
+	injector.Clear()
+	assert.NoError(t, injector.Inject(api.FaultTarget_JOB_REPOSITORY, "AddJobs"))
+}
+
+func TestFaultInjectingEventStore(t *testing.T) {
+	injector := NewFaultInjector()
+	eventStore := &TestEventStore{}
+	faultInjectingEventStore := NewFaultInjectingEventStore(eventStore, injector)
+	events := []*api.EventMessage{{Events: &api.EventMessage_Queued{Queued: &api.JobQueuedEvent{JobId: "a"}}}}
+
+	assert.NoError(t, faultInjectingEventStore.ReportEvents(armadacontext.Background(), events))
+	assert.Len(t, eventStore.ReceivedEvents, 1)
+
+	injector.SetFaults([]*api.Fault{{Target: api.FaultTarget_EVENT_STORE, ErrorProbability: 1}})
+	assert.Error(t, faultInjectingEventStore.ReportEvents(armadacontext.Background(), events))
+	assert.Len(t, eventStore.ReceivedEvents, 1)
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -11,26 +12,47 @@ import (
 	"github.com/hashicorp/go-multierror"
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/api/resource"
 
 	"github.com/armadaproject/armada/internal/common/armadaerrors"
 	protoutil "github.com/armadaproject/armada/internal/common/proto"
+	armadaresource "github.com/armadaproject/armada/internal/common/resource"
 	"github.com/armadaproject/armada/internal/common/util"
 	"github.com/armadaproject/armada/internal/scheduler/schedulerobjects"
 	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/client/queue"
 )
 
 const (
-	jobObjectPrefix    = "Job:"          // {jobId}            - job protobuf object
-	jobStartTimePrefix = "Job:StartTime" // {jobId}            - map clusterId -> startTime
-	jobQueuePrefix     = "Job:Queue:"    // {queue}            - sorted set of jobIds by priority
-	jobLeasedPrefix    = "Job:Leased:"   // {queue}            - sorted set of jobIds by lease renewal time
-	jobSetPrefix       = "Job:Set:"      // {jobSetId}         - set of jobIds
-	jobClusterMapKey   = "Job:ClusterId" //                    - map jobId -> cluster
-	jobRetriesPrefix   = "Job:Retries:"  // {jobId}            - number of retry attempts
-	jobClientIdPrefix  = "job:ClientId:" // {queue}:{clientId} - corresponding jobId
-	jobExistsPrefix    = "Job:added"     // {jobId}            - flag to say we've added the job
+	jobObjectPrefix    = "Job:"           // {jobId}            - job protobuf object
+	jobStartTimePrefix = "Job:StartTime"  // {jobId}            - map clusterId -> startTime
+	jobQueuePrefix     = "Job:Queue:"     // {queue}            - sorted set of jobIds by priority
+	jobOwnerPrefix     = "Job:Owner:"     // {queue}            - map jobId -> owner
+	jobLeasedPrefix    = "Job:Leased:"    // {queue}            - sorted set of jobIds by lease renewal time
+	jobSuspendedPrefix = "Job:Suspended:" // {queue}            - sorted set of suspended jobIds by priority
+	jobSetPrefix       = "Job:Set:"       // {jobSetId}         - set of jobIds
+	jobSetLabelPrefix  = "Job:SetLabel:"  // {queue}:{jobSetId}:{labelKey}={labelValue} - set of jobIds
+	jobClusterMapKey   = "Job:ClusterId"  //                    - map jobId -> cluster
+	jobRetriesPrefix   = "Job:Retries:"   // {jobId}            - number of retry attempts
+	jobClientIdPrefix  = "job:ClientId:"  // {queue}:{clientId} - corresponding jobId
+	jobExistsPrefix    = "Job:added"      // {jobId}            - flag to say we've added the job
 	keySeparator       = ":"
 	pulsarJobPrefix    = "PulsarJob:" // {jobId}            - pulsarjob protobuf object
+
+	sharedPodSpecsPrefix = "Job:PodSpecs:"    // {hash}  - map with the compressed pod specs and the ids of the jobs sharing them
+	jobResourcesPrefix   = "Job:Resources:"   // {queue} - map jobId -> resources requested by the job, as per encodeJobResources
+	queueResourcesPrefix = "Queue:Resources:" // {queue} - map resource name -> milli-units requested by queued and leased jobs
+
+	jobSetLabelIndexedPrefix = "Job:SetLabelIndexed:" // {queue}:{jobSetId} - set of the jobIds indexed by label, i.e., not added before the index existed
+)
+
+// JobState is the state of a job stored in a JobRepository.
+type JobState int
+
+const (
+	JobStateQueued JobState = iota
+	JobStateLeased
+	JobStateSuspended
 )
 
 type ErrJobNotFound struct {
@@ -63,22 +85,49 @@ type JobRepository interface {
 	// Returns a map from queue name to ids of successfully leased jobs for that queue.
 	TryLeaseJobs(clusterId string, jobIdsByQueue map[string][]string) (map[string][]string, error)
 	AddJobs(job []*api.Job) ([]*SubmitJobResult, error)
+	// AddJobsWithEvents stores jobs as AddJobs does and, atomically with storing jobs[i], writes events[i] to the event outbox.
+	// The events of jobs that aren't stored, e.g., because they were already processed, are discarded.
+	AddJobsWithEvents(jobs []*api.Job, events [][]*api.EventMessage) ([]*SubmitJobResult, error)
+	EventOutbox
 	GetJobsByIds(ids []string) ([]*JobResult, error)
 	GetExistingJobsByIds(ids []string) ([]*api.Job, error)
 	FilterActiveQueues(queues []*api.Queue) ([]*api.Queue, error)
 	GetQueueSizes(queues []*api.Queue) (sizes []int64, e error)
 	GetQueueJobIds(queueName string) ([]string, error)
+	// GetQueueJobIdsPage returns at most limit ids of the queued jobs of the given queue, skipping the first offset ids,
+	// in the same order as returned by GetQueueJobIds.
+	GetQueueJobIdsPage(queueName string, offset int64, limit int64) ([]string, error)
+	// GetQueueJobIdsByOwner returns the ids of the queued jobs of the given queue indexed by the owner of each job.
+	// The ids of each owner are ordered by priority, i.e., in the same order as returned by GetQueueJobIds.
+	GetQueueJobIdsByOwner(queueName string) (map[string][]string, error)
+	// GetQueueResources returns the resources requested by the queued and leased jobs of the given queue,
+	// without reading the jobs.
+	GetQueueResources(queueName string) (armadaresource.ComputeResources, error)
 	RenewLease(clusterId string, jobIds []string) (renewed []string, e error)
 	ExpireLeases(queue string, deadline time.Time) (expired []*api.Job, e error)
 	ExpireLeasesById(jobIds []string, deadline time.Time) (expired []*api.Job, e error)
 	ReturnLease(clusterId string, jobId string) (returnedJob *api.Job, err error)
 	DeleteJobs(jobs []*api.Job) (map[*api.Job]error, error)
+	// SuspendJobs moves queued jobs of the given queue out of the queue, such that they aren't leased until resumed.
+	// Returns the ids of the jobs that were suspended; jobs that aren't queued are ignored.
+	SuspendJobs(queue string, jobIds []string) ([]string, error)
+	// ResumeJobs returns suspended jobs of the given queue to the queue.
+	// Returns the ids of the jobs that were resumed; jobs that aren't suspended are ignored.
+	ResumeJobs(queue string, jobIds []string) ([]string, error)
 	GetActiveJobIds(queue string, jobSetId string) ([]string, error)
 	GetJobSetJobIds(queue string, jobSetId string, filter *JobSetFilter) ([]string, error)
 	GetLeasedJobIds(queue string) ([]string, error)
 	UpdateStartTime(jobStartInfos []*JobStartInfo) ([]error, error)
+	// UpdateJobs applies mutator to the jobs with the given ids under an optimistic lock.
+	// mutator may be called several times and must not have side effects; act on the returned results instead.
 	UpdateJobs(ids []string, mutator func([]*api.Job)) ([]UpdateJobResult, error)
 	GetJobRunInfos(jobIds []string) (map[string]*RunInfo, error)
+	// GetLeasedJobClusterIds returns the id of the cluster each of the provided jobs is leased to.
+	// Jobs that aren't leased are omitted.
+	GetLeasedJobClusterIds(jobIds []string) (map[string]string, error)
+	// GetJobStates returns the state of each of the provided jobs of the given queue.
+	// Jobs that aren't queued, leased, or suspended are omitted.
+	GetJobStates(queue string, jobIds []string) (map[string]JobState, error)
 	GetQueueActiveJobSets(queue string) ([]*api.JobSetInfo, error)
 	AddRetryAttempt(jobId string) error
 	GetNumberOfRetryAttempts(jobId string) (int, error)
@@ -88,7 +137,9 @@ type JobRepository interface {
 }
 
 type RedisJobRepository struct {
-	db redis.UniversalClient
+	db                  redis.UniversalClient
+	codec               JobCodec
+	deduplicatePodSpecs bool
 }
 
 func NewRedisJobRepository(
@@ -97,6 +148,28 @@ func NewRedisJobRepository(
 	return &RedisJobRepository{db: db}
 }
 
+// NewRedisJobRepositoryWithPodSpecStore returns a RedisJobRepository that stores the pod specs of jobs in podSpecStore,
+// rather than in Redis, if they are larger than podSpecSizeThresholdBytes, such that occasional very large jobs don't
+// affect the memory usage of Redis. Pod specs are restored transparently when jobs are read, e.g., when leased.
+func NewRedisJobRepositoryWithPodSpecStore(
+	db redis.UniversalClient,
+	podSpecStore ObjectStore,
+	podSpecSizeThresholdBytes uint,
+) *RedisJobRepository {
+	return &RedisJobRepository{
+		db:    db,
+		codec: NewJobCodec(podSpecStore, podSpecSizeThresholdBytes),
+	}
+}
+
+// WithPodSpecDeduplication makes repo store the pod specs of jobs once for all jobs with identical pod specs, compressed,
+// such that e.g. the many jobs of a parametric workload submitted with the same pod spec take little memory in Redis.
+// Pod specs are deleted once no job references them anymore. Returns repo.
+func (repo *RedisJobRepository) WithPodSpecDeduplication() *RedisJobRepository {
+	repo.deduplicatePodSpecs = true
+	return repo
+}
+
 // TODO DuplicateDetected should be remove in favour of setting the error to
 // indicate the job already exists (e.g., by creating ErrJobExists).
 type SubmitJobResult struct {
@@ -108,21 +181,39 @@ type SubmitJobResult struct {
 }
 
 func (repo *RedisJobRepository) AddJobs(jobs []*api.Job) ([]*SubmitJobResult, error) {
+	return repo.AddJobsWithEvents(jobs, nil)
+}
+
+func (repo *RedisJobRepository) AddJobsWithEvents(jobs []*api.Job, events [][]*api.EventMessage) ([]*SubmitJobResult, error) {
 	pipe := repo.db.Pipeline()
 	addJobScript.Load(pipe)
 
+	sharedPodSpecs, err := repo.sharePodSpecs(jobs)
+	if err != nil {
+		return nil, err
+	}
+
 	saveResults := make([]*redis.Cmd, 0, len(jobs))
-	for _, job := range jobs {
-		jobData, err := proto.Marshal(job)
+	for i, job := range jobs {
+		jobData, err := repo.marshalJob(job)
 		if err != nil {
-			return nil, errors.WithStack(err)
+			return nil, err
+		}
+		var eventData [][]byte
+		if events != nil {
+			eventData = make([][]byte, len(events[i]))
+			for j, event := range events[i] {
+				if eventData[j], err = proto.Marshal(event); err != nil {
+					return nil, errors.WithStack(err)
+				}
+			}
 		}
 
-		result := addJob(pipe, job, &jobData)
+		result := addJob(pipe, job, &jobData, sharedPodSpecs[job.PodSpecsHash], eventData)
 		saveResults = append(saveResults, result)
 	}
 
-	_, err := pipe.Exec()
+	_, err = pipe.Exec()
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
@@ -140,6 +231,11 @@ func (repo *RedisJobRepository) AddJobs(jobs []*api.Job) ([]*SubmitJobResult, er
 			AlreadyProcessed:  alreadyProcessed,
 		}
 		result = append(result, submitJobResult)
+
+		// Duplicate jobs aren't stored, so neither should their pod specs be.
+		if duplicatedDetected && jobs[i].PodSpecsHash == "" {
+			repo.codec.DeleteExternalPodSpecs(jobs[i])
+		}
 	}
 	return result, nil
 }
@@ -195,8 +291,8 @@ func (repo *RedisJobRepository) ReturnLease(clusterId string, jobId string) (ret
 
 type deleteJobRedisResponse struct {
 	job                            *api.Job
-	removeFromLeasedResult         *redis.IntCmd
-	removeFromQueueResult          *redis.IntCmd
+	removeFromQueueOrLeasedResult  *redis.Cmd
+	removeFromSuspendedResult      *redis.IntCmd
 	removeClusterAssociationResult *redis.IntCmd
 	removeStartTimeResult          *redis.IntCmd
 	deleteJobSetIndexResult        *redis.IntCmd
@@ -206,12 +302,19 @@ type deleteJobRedisResponse struct {
 
 func (repo *RedisJobRepository) DeleteJobs(jobs []*api.Job) (map[*api.Job]error, error) {
 	pipe := repo.db.TxPipeline()
+	releaseSharedPodSpecsScript.Load(pipe)
+	removeQueuedOrLeasedJobScript.Load(pipe)
 	deletionResults := make([]*deleteJobRedisResponse, 0, len(jobs))
 	for _, job := range jobs {
 		// This is safe because attempting to delete non-existing keys results in a no-op.
 		deletionResult := &deleteJobRedisResponse{job: job}
-		deletionResult.removeFromQueueResult = pipe.ZRem(jobQueuePrefix+job.Queue, job.Id)
-		deletionResult.removeFromLeasedResult = pipe.ZRem(jobLeasedPrefix+job.Queue, job.Id)
+		deletionResult.removeFromQueueOrLeasedResult = removeQueuedOrLeasedJobScript.Run(
+			pipe,
+			[]string{jobQueuePrefix + job.Queue, jobLeasedPrefix + job.Queue, jobResourcesPrefix + job.Queue, queueResourcesPrefix + job.Queue},
+			job.Id,
+		)
+		deletionResult.removeFromSuspendedResult = pipe.ZRem(jobSuspendedPrefix+job.Queue, job.Id)
+		pipe.HDel(jobOwnerPrefix+job.Queue, job.Id)
 		deletionResult.removeClusterAssociationResult = pipe.HDel(jobClusterMapKey, job.Id)
 		deletionResult.removeStartTimeResult = pipe.Del(jobStartTimePrefix + job.Id)
 		deletionResult.deleteJobSetIndexResult = pipe.SRem(jobSetPrefix+job.JobSetId, job.Id)
@@ -220,6 +323,13 @@ func (repo *RedisJobRepository) DeleteJobs(jobs []*api.Job) (map[*api.Job]error,
 
 		// Don't care if deletion fails during compatibility period
 		pipe.SRem(jobSetPrefix+job.Queue+keySeparator+job.JobSetId, job.Id)
+		for _, key := range jobSetLabelKeys(job.Queue, job.JobSetId, job.Labels) {
+			pipe.SRem(key, job.Id)
+		}
+		pipe.SRem(jobSetLabelIndexedPrefix+job.Queue+keySeparator+job.JobSetId, job.Id)
+		if job.PodSpecsHash != "" {
+			releaseSharedPodSpecs(pipe, job.PodSpecsHash, job.Id)
+		}
 
 		deletionResults = append(deletionResults, deletionResult)
 	}
@@ -233,6 +343,9 @@ func (repo *RedisJobRepository) DeleteJobs(jobs []*api.Job) (map[*api.Job]error,
 
 		if numberOfUpdates > 0 {
 			cancelledJobs[deletionResult.job] = nil
+			if deletionResult.job.PodSpecsHash == "" {
+				repo.codec.DeleteExternalPodSpecs(deletionResult.job)
+			}
 		}
 
 		if err != nil {
@@ -246,11 +359,11 @@ func processDeletionResponse(deletionResponse *deleteJobRedisResponse) (int64, e
 	var totalUpdates int64 = 0
 	var result *multierror.Error
 
-	modified, err := deletionResponse.removeFromLeasedResult.Result()
+	modified, err := deletionResponse.removeFromQueueOrLeasedResult.Int64()
 	totalUpdates += modified
 	result = multierror.Append(result, err)
 
-	modified, err = deletionResponse.removeFromQueueResult.Result()
+	modified, err = deletionResponse.removeFromSuspendedResult.Result()
 	totalUpdates += modified
 	result = multierror.Append(result, err)
 
@@ -277,6 +390,80 @@ func processDeletionResponse(deletionResponse *deleteJobRedisResponse) (int64, e
 	return totalUpdates, result.ErrorOrNil()
 }
 
+// removeQueuedOrLeasedJobScript removes a job from the queued and leased jobs of its queue, subtracting its resources
+// from the resources of the queue if it was either, and removes the record of its resources.
+// Returns the number of sorted sets the job was removed from.
+var removeQueuedOrLeasedJobScript = redis.NewScript(countQueueResourcesFunction + `
+local queueKey = KEYS[1]
+local leasedKey = KEYS[2]
+local jobResourcesKey = KEYS[3]
+local queueResourcesKey = KEYS[4]
+
+local jobId = ARGV[1]
+
+local removed = redis.call('ZREM', queueKey, jobId) + redis.call('ZREM', leasedKey, jobId)
+if removed > 0 then
+	countQueueResources(queueResourcesKey, redis.call('HGET', jobResourcesKey, jobId), '-')
+end
+redis.call('HDEL', jobResourcesKey, jobId)
+return removed
+`)
+
+func (repo *RedisJobRepository) SuspendJobs(queue string, jobIds []string) ([]string, error) {
+	return repo.moveQueuedJobs(queue, jobQueuePrefix+queue, jobSuspendedPrefix+queue, "-", jobIds)
+}
+
+func (repo *RedisJobRepository) ResumeJobs(queue string, jobIds []string) ([]string, error) {
+	return repo.moveQueuedJobs(queue, jobSuspendedPrefix+queue, jobQueuePrefix+queue, "", jobIds)
+}
+
+// moveQueuedJobs moves jobs of queue between the sorted sets from and to, preserving their priority.
+// The resources of moved jobs are added to the resources of the queue, or subtracted if resourcesSign is "-".
+// Returns the ids of the jobs that were moved.
+func (repo *RedisJobRepository) moveQueuedJobs(queue string, from string, to string, resourcesSign string, jobIds []string) ([]string, error) {
+	pipe := repo.db.Pipeline()
+	moveQueuedJobScript.Load(pipe)
+	keys := []string{from, to, jobResourcesPrefix + queue, queueResourcesPrefix + queue}
+	cmds := make([]*redis.Cmd, len(jobIds))
+	for i, jobId := range jobIds {
+		cmds[i] = moveQueuedJobScript.Run(pipe, keys, jobId, resourcesSign)
+	}
+	if _, err := pipe.Exec(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var movedJobIds []string
+	for i, cmd := range cmds {
+		moved, err := cmd.Int()
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		if moved > 0 {
+			movedJobIds = append(movedJobIds, jobIds[i])
+		}
+	}
+	return movedJobIds, nil
+}
+
+var moveQueuedJobScript = redis.NewScript(countQueueResourcesFunction + `
+local from = KEYS[1]
+local to = KEYS[2]
+local jobResourcesKey = KEYS[3]
+local queueResourcesKey = KEYS[4]
+
+local jobId = ARGV[1]
+local resourcesSign = ARGV[2]
+
+local priority = redis.call('ZSCORE', from, jobId)
+if priority then
+	redis.call('ZREM', from, jobId)
+	redis.call('ZADD', to, priority, jobId)
+	countQueueResources(queueResourcesKey, redis.call('HGET', jobResourcesKey, jobId), resourcesSign)
+	return 1
+end
+return 0
+`)
+
 // PeekQueue returns the highest-priority jobs in the given queue.
 // At most limits jobs are returned.
 func (repo *RedisJobRepository) PeekQueue(queue string, limit int64) ([]*api.Job, error) {
@@ -310,34 +497,7 @@ func (repo *RedisJobRepository) GetExistingJobsByIds(ids []string) ([]*api.Job,
 	if err != nil {
 		return nil, err
 	}
-	var result *multierror.Error
-	jobs := make([]*api.Job, 0, len(jobResults))
-	for _, jobResult := range jobResults {
-		var errJobNotFound *ErrJobNotFound
-		var errNotFound *armadaerrors.ErrNotFound
-		if errors.As(jobResult.Error, &errJobNotFound) || errors.As(jobResult.Error, &errNotFound) {
-			continue
-		} else if jobResult.Error != nil {
-			err = errors.WithMessagef(jobResult.Error, "error getting job with id %s from database", jobResult.JobId)
-			result = multierror.Append(result, err)
-			continue
-		}
-		// Ensure job.GetAnnotations and podSpec.NodeSelector are initialised.
-		// Necessary to mutate these in-place during scheduling.
-		if jobResult.Job.Annotations == nil {
-			jobResult.Job.Annotations = make(map[string]string)
-		}
-		if jobResult.Job.PodSpec != nil && jobResult.Job.PodSpec.NodeSelector == nil {
-			jobResult.Job.PodSpec.NodeSelector = make(map[string]string)
-		}
-		for _, podSpec := range jobResult.Job.PodSpecs {
-			if podSpec != nil && podSpec.NodeSelector == nil {
-				jobResult.Job.PodSpec.NodeSelector = make(map[string]string)
-			}
-		}
-		jobs = append(jobs, jobResult.Job)
-	}
-	return jobs, result.ErrorOrNil()
+	return ExistingJobs(jobResults)
 }
 
 // GetJobsByIds attempts to get all requested jobs from the database.
@@ -372,24 +532,13 @@ func (repo *RedisJobRepository) GetJobsByIds(ids []string) ([]*JobResult, error)
 		}
 
 		d, _ := cmd.Bytes() // we already checked the error above
-		result.Job = &api.Job{}
-		err = proto.Unmarshal(d, result.Job)
-		if err != nil {
-			err = errors.WithMessagef(err, "job id %s", ids[index])
-			return nil, errors.WithStack(err)
-		}
-
-		// TODO This shouldn't be here. We write these when creating the job,
-		// and the getter shouldn't mutate the object read from the database.
-		podSpec := result.Job.GetMainPodSpec()
-		// TODO: remove, RequiredNodeLabels is deprecated and will be removed in future versions
-		for k, v := range result.Job.RequiredNodeLabels {
-			if podSpec.NodeSelector == nil {
-				podSpec.NodeSelector = map[string]string{}
-			}
-			podSpec.NodeSelector[k] = v
+		if err := repo.codec.Unmarshal(d, result); err != nil {
+			return nil, err
 		}
 	}
+	if err := repo.loadSharedPodSpecs(results); err != nil {
+		return nil, err
+	}
 
 	return results, nil
 }
@@ -442,6 +591,41 @@ func (repo *RedisJobRepository) GetLeasedJobIds(queue string) ([]string, error)
 	return val, nil
 }
 
+func (repo *RedisJobRepository) GetLeasedJobClusterIds(jobIds []string) (map[string]string, error) {
+	return repo.getAssociatedCluster(jobIds)
+}
+
+func (repo *RedisJobRepository) GetJobStates(queue string, jobIds []string) (map[string]JobState, error) {
+	keys := map[JobState]string{
+		JobStateQueued:    jobQueuePrefix + queue,
+		JobStateLeased:    jobLeasedPrefix + queue,
+		JobStateSuspended: jobSuspendedPrefix + queue,
+	}
+	pipe := repo.db.Pipeline()
+	cmds := make(map[JobState][]*redis.FloatCmd, len(keys))
+	for state, key := range keys {
+		for _, jobId := range jobIds {
+			cmds[state] = append(cmds[state], pipe.ZScore(key, jobId))
+		}
+	}
+	if _, err := pipe.Exec(); err != nil && err != redis.Nil {
+		return nil, errors.WithStack(err)
+	}
+
+	states := make(map[string]JobState, len(jobIds))
+	for state, stateCmds := range cmds {
+		for i, cmd := range stateCmds {
+			if err := cmd.Err(); err == redis.Nil {
+				continue
+			} else if err != nil {
+				return nil, errors.WithStack(err)
+			}
+			states[jobIds[i]] = state
+		}
+	}
+	return states, nil
+}
+
 func (repo *RedisJobRepository) getAssociatedCluster(jobIds []string) (map[string]string, error) {
 	associatedCluster := make(map[string]string, len(jobIds))
 	pipe := repo.db.Pipeline()
@@ -560,6 +744,10 @@ end
 return redis.call('HSET', startTimeKey, clusterId, startTime)
 `, updateStartTimeJobNotFound))
 
+// UpdateJobs applies mutator to the jobs with the given ids and writes them back to Redis; see updateJobBatchWithRetry.
+// Since mutator may be called several times, e.g., after losing the optimistic lock, it must not have side effects.
+// Instead, callers should act on the returned results, the jobs of which are the updated jobs as written.
+//
 // TODO Redis supports setting a retry parameter. Why do we re-implement that functionality?
 func (repo *RedisJobRepository) UpdateJobs(ids []string, mutator func([]*api.Job)) ([]UpdateJobResult, error) {
 	return repo.updateJobs(ids, mutator, 250, 3, 100*time.Millisecond), nil
@@ -619,16 +807,25 @@ func (repo *RedisJobRepository) updateJobBatchWithRetry(
 		if err != nil {
 			return err
 		}
+		sharedPodSpecs, err := sharedPodSpecsSnapshot(jobs)
+		if err != nil {
+			return err
+		}
 
 		// Operation to run (locally in optimistic lock)
 		mutator(jobs)
 
+		unsharedPodSpecHashes, err := unshareModifiedPodSpecs(jobs, sharedPodSpecs)
+		if err != nil {
+			return err
+		}
+
 		// Marshal the resulting jobs in preparation for writing back to Redis
 		jobDatas := make([][]byte, len(jobs))
 		for i, job := range jobs {
-			jobData, err := proto.Marshal(job)
+			jobData, err := repo.marshalJob(job)
 			if err != nil {
-				return errors.Wrapf(err, "job id %s", job.Id)
+				return errors.WithMessagef(err, "job id %s", job.Id)
 			}
 			jobDatas[i] = jobData
 		}
@@ -643,14 +840,25 @@ func (repo *RedisJobRepository) updateJobBatchWithRetry(
 		commands := make([]*redis.Cmd, len(jobs))
 		pipe := tx.TxPipeline()
 		updateJobAndPriorityScript.Load(pipe)
+		releaseSharedPodSpecsScript.Load(pipe)
 		for i, job := range jobs {
 			newPriority := job.Priority
 			jobData := &jobDatas[i]
 			commands[i] = updateJobAndPriorityScript.Run(
 				pipe,
-				[]string{jobQueuePrefix + job.Queue, jobObjectPrefix + job.Id},
-				job.Id, newPriority, *jobData,
+				[]string{
+					jobQueuePrefix + job.Queue,
+					jobObjectPrefix + job.Id,
+					jobSuspendedPrefix + job.Queue,
+					jobLeasedPrefix + job.Queue,
+					jobResourcesPrefix + job.Queue,
+					queueResourcesPrefix + job.Queue,
+				},
+				job.Id, newPriority, *jobData, encodeJobResources(job),
 			)
+			if hash, ok := unsharedPodSpecHashes[job.Id]; ok {
+				releaseSharedPodSpecs(pipe, hash, job.Id)
+			}
 		}
 
 		// TODO We append to results even if an error occurs. However, we only return results if
@@ -700,17 +908,24 @@ func (repo *RedisJobRepository) updateJobBatchWithRetry(
 	return nil, redis.TxFailedErr
 }
 
-// If the job key has a defined TTL, it implies that the job has finished and updating is irrelevant
-var updateJobAndPriorityScript = redis.NewScript(`
+// If the job key has a defined TTL, it implies that the job has finished and updating is irrelevant.
+// If the resources of the job are recorded and changed, the resources counted for its queue are updated too.
+var updateJobAndPriorityScript = redis.NewScript(countQueueResourcesFunction + `
 local queue = KEYS[1]
 local job = KEYS[2]
+local suspended = KEYS[3]
+local leased = KEYS[4]
+local jobResourcesKey = KEYS[5]
+local queueResourcesKey = KEYS[6]
 
 local jobId = ARGV[1]
 local newPriority = ARGV[2]
 local jobData = ARGV[3]
+local newJobResources = ARGV[4]
 
 local exists = redis.call('GET', job)
 local existsQueued = redis.call('ZSCORE', queue, jobId)
+local existsSuspended = redis.call('ZSCORE', suspended, jobId)
 
 if exists then
 	local ttl = redis.call('TTL', job)
@@ -723,6 +938,19 @@ if existsQueued then
 	redis.call('ZADD', queue, newPriority, jobId)
 end
 
+if existsSuspended then
+	redis.call('ZADD', suspended, newPriority, jobId)
+end
+
+local jobResources = redis.call('HGET', jobResourcesKey, jobId)
+if jobResources and jobResources ~= newJobResources then
+	redis.call('HSET', jobResourcesKey, jobId, newJobResources)
+	if existsQueued or redis.call('ZSCORE', leased, jobId) then
+		countQueueResources(queueResourcesKey, jobResources, '-')
+		countQueueResources(queueResourcesKey, newJobResources, '')
+	end
+end
+
 return 0
 `)
 
@@ -780,21 +1008,58 @@ func (repo *RedisJobRepository) GetQueueJobIds(queueName string) ([]string, erro
 	return queuedIds, nil
 }
 
+func (repo *RedisJobRepository) GetQueueJobIdsPage(queueName string, offset int64, limit int64) ([]string, error) {
+	if limit <= 0 {
+		return nil, nil
+	}
+	queuedIds, err := repo.db.ZRange(jobQueuePrefix+queueName, offset, offset+limit-1).Result()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return queuedIds, nil
+}
+
+func (repo *RedisJobRepository) GetQueueJobIdsByOwner(queueName string) (map[string][]string, error) {
+	var queuedIdsCmd *redis.StringSliceCmd
+	var ownerByJobIdCmd *redis.StringStringMapCmd
+	_, err := repo.db.TxPipelined(func(tx redis.Pipeliner) error {
+		queuedIdsCmd = tx.ZRange(jobQueuePrefix+queueName, 0, -1)
+		ownerByJobIdCmd = tx.HGetAll(jobOwnerPrefix + queueName)
+		return nil
+	})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	ownerByJobId := ownerByJobIdCmd.Val()
+	jobIdsByOwner := make(map[string][]string)
+	for _, jobId := range queuedIdsCmd.Val() {
+		// Jobs submitted before jobs were indexed by owner are attributed to the empty owner.
+		owner := ownerByJobId[jobId]
+		jobIdsByOwner[owner] = append(jobIdsByOwner[owner], jobId)
+	}
+	return jobIdsByOwner, nil
+}
+
 func (repo *RedisJobRepository) GetActiveJobIds(queue string, jobSetId string) ([]string, error) {
 	return repo.GetJobSetJobIds(queue, jobSetId, &JobSetFilter{
-		IncludeLeased: true,
-		IncludeQueued: true,
+		IncludeLeased:    true,
+		IncludeQueued:    true,
+		IncludeSuspended: true,
 	})
 }
 
 type JobSetFilter struct {
-	IncludeQueued bool
-	IncludeLeased bool
+	IncludeQueued    bool
+	IncludeLeased    bool
+	IncludeSuspended bool
+	// If non-empty, only jobs with all of these labels are included.
+	Labels map[string]string
 }
 
 func (repo *RedisJobRepository) GetJobSetJobIds(queue string, jobSetId string, filter *JobSetFilter) ([]string, error) {
 	var queuedIdsCommandResult *redis.StringSliceCmd
 	var leasedIdsCommandResult *redis.StringSliceCmd
+	var suspendedIdsCommandResult *redis.StringSliceCmd
 
 	tx := repo.db.TxPipeline()
 	if filter == nil || filter.IncludeQueued {
@@ -803,7 +1068,17 @@ func (repo *RedisJobRepository) GetJobSetJobIds(queue string, jobSetId string, f
 	if filter == nil || filter.IncludeLeased {
 		leasedIdsCommandResult = tx.ZRange(jobLeasedPrefix+queue, 0, -1)
 	}
+	if filter == nil || filter.IncludeSuspended {
+		suspendedIdsCommandResult = tx.ZRange(jobSuspendedPrefix+queue, 0, -1)
+	}
 	jobSetIdsCommand := tx.SMembers(jobSetPrefix + jobSetId)
+	var matchingIndexedIdsCommand *redis.StringSliceCmd
+	var indexedIdsCommand *redis.StringSliceCmd
+	filterByLabels := filter != nil && len(filter.Labels) > 0
+	if filterByLabels {
+		matchingIndexedIdsCommand = tx.SInter(jobSetLabelKeys(queue, jobSetId, filter.Labels)...)
+		indexedIdsCommand = tx.SMembers(jobSetLabelIndexedPrefix + queue + keySeparator + jobSetId)
+	}
 
 	_, err := tx.Exec()
 	if err != nil {
@@ -825,6 +1100,13 @@ func (repo *RedisJobRepository) GetJobSetJobIds(queue string, jobSetId string, f
 		}
 		activeJobIds = append(activeJobIds, leasedIds...)
 	}
+	if filter == nil || filter.IncludeSuspended {
+		suspendedIds, err := suspendedIdsCommandResult.Result()
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		activeJobIds = append(activeJobIds, suspendedIds...)
+	}
 
 	jobSetIds, err := jobSetIdsCommand.Result()
 	if err != nil {
@@ -838,15 +1120,51 @@ func (repo *RedisJobRepository) GetJobSetJobIds(queue string, jobSetId string, f
 			activeJobSetIds = append(activeJobSetIds, id)
 		}
 	}
-	return activeJobSetIds, nil
+	if !filterByLabels {
+		return activeJobSetIds, nil
+	}
+	return repo.filterJobIdsByLabels(activeJobSetIds, matchingIndexedIdsCommand.Val(), indexedIdsCommand.Val(), filter.Labels)
+}
+
+// filterJobIdsByLabels returns those of jobIds of jobs with all of labels. Jobs indexed by label, i.e., in indexedIds,
+// have these labels if in matchingIndexedIds, whereas the labels of other jobs, added before jobs were indexed by label,
+// are read from the jobs themselves.
+func (repo *RedisJobRepository) filterJobIdsByLabels(jobIds []string, matchingIndexedIds []string, indexedIds []string, labels map[string]string) ([]string, error) {
+	matchingIds := util.StringListToSet(matchingIndexedIds)
+	indexed := util.StringListToSet(indexedIds)
+	var unindexedIds []string
+	for _, id := range jobIds {
+		if !indexed[id] {
+			unindexedIds = append(unindexedIds, id)
+		}
+	}
+	if len(unindexedIds) > 0 {
+		unindexedJobs, err := repo.GetExistingJobsByIds(unindexedIds)
+		if err != nil {
+			return nil, errors.WithMessage(err, "error getting jobs not indexed by label")
+		}
+		for _, job := range unindexedJobs {
+			if queue.Labels(job.Labels).Matches(labels) {
+				matchingIds[job.Id] = true
+			}
+		}
+	}
+	matchingJobIds := []string{}
+	for _, id := range jobIds {
+		if matchingIds[id] {
+			matchingJobIds = append(matchingJobIds, id)
+		}
+	}
+	return matchingJobIds, nil
 }
 
 // GetQueueActiveJobSets returns a list of length equal to the number of unique job sets
-// in the given queue, where each element contains the number of queued and leased jobs
-// that are part of that job set.
+// in the given queue, where each element contains the number of queued, suspended, pending, and running jobs
+// that are part of that job set and the total resources requested by its leased jobs.
 func (repo *RedisJobRepository) GetQueueActiveJobSets(queue string) ([]*api.JobSetInfo, error) {
 	tx := repo.db.TxPipeline()
 	queuedIdsCommand := tx.ZRange(jobQueuePrefix+queue, 0, -1)
+	suspendedIdsCommand := tx.ZRange(jobSuspendedPrefix+queue, 0, -1)
 	leasedIdsCommand := tx.ZRange(jobLeasedPrefix+queue, 0, -1)
 
 	// If there's an error internal to Exec, an error is returned
@@ -860,49 +1178,81 @@ func (repo *RedisJobRepository) GetQueueActiveJobSets(queue string) ([]*api.JobS
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
+	suspendedIds, err := suspendedIdsCommand.Result()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
 	leasedIds, err := leasedIdsCommand.Result()
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
 
-	// Maps job set IDs to objects containing the number of queued and leased jobs for each job set
-	jobSets := map[string]*api.JobSetInfo{}
-
-	// Count number of leased jobs
-	leasedJobs, err := repo.GetExistingJobsByIds(leasedIds)
+	// Load queued, suspended and leased jobs together; the ids of the three are disjoint.
+	ids := make([]string, 0, len(queuedIds)+len(suspendedIds)+len(leasedIds))
+	ids = append(append(append(ids, queuedIds...), suspendedIds...), leasedIds...)
+	jobs, err := repo.GetExistingJobsByIds(ids)
 	if err != nil {
 		return nil, err
 	}
-	for _, job := range leasedJobs {
-		info, ok := jobSets[job.JobSetId]
-		if !ok {
-			info = &api.JobSetInfo{Name: job.JobSetId}
-			jobSets[job.JobSetId] = info
+	isLeased := make(map[string]bool, len(leasedIds))
+	for _, jobId := range leasedIds {
+		isLeased[jobId] = true
+	}
+	isSuspended := make(map[string]bool, len(suspendedIds))
+	for _, jobId := range suspendedIds {
+		isSuspended[jobId] = true
+	}
+	var queuedJobs, suspendedJobs, leasedJobs []*api.Job
+	for _, job := range jobs {
+		if isLeased[job.Id] {
+			leasedJobs = append(leasedJobs, job)
+		} else if isSuspended[job.Id] {
+			suspendedJobs = append(suspendedJobs, job)
+		} else {
+			queuedJobs = append(queuedJobs, job)
 		}
-		info.LeasedJobs++
 	}
-
-	// Count number of queued jobs
-	queuedJobs, err := repo.GetExistingJobsByIds(queuedIds)
+	runInfos, err := repo.GetJobRunInfos(leasedIds)
 	if err != nil {
 		return nil, err
 	}
-	for _, job := range queuedJobs {
-		info, ok := jobSets[job.JobSetId]
+	return JobSetInfos(queuedJobs, suspendedJobs, leasedJobs, runInfos), nil
+}
+
+// JobSetInfos summarises the given queued, suspended and leased jobs by job set, sorted by job set name.
+// Leased jobs are considered running if runInfos contains a start time for that job, and pending otherwise.
+func JobSetInfos(queuedJobs []*api.Job, suspendedJobs []*api.Job, leasedJobs []*api.Job, runInfos map[string]*RunInfo) []*api.JobSetInfo {
+	jobSets := make(map[string]*api.JobSetInfo)
+	getJobSet := func(jobSetId string) *api.JobSetInfo {
+		info, ok := jobSets[jobSetId]
 		if !ok {
-			info = &api.JobSetInfo{Name: job.JobSetId}
-			jobSets[job.JobSetId] = info
+			info = &api.JobSetInfo{Name: jobSetId, LeasedResources: make(map[string]resource.Quantity)}
+			jobSets[jobSetId] = info
 		}
-		info.QueuedJobs++
+		return info
 	}
-
-	// Flatten the map
-	result := []*api.JobSetInfo{}
-	for _, i := range jobSets {
-		result = append(result, i)
+	for _, job := range leasedJobs {
+		info := getJobSet(job.JobSetId)
+		info.LeasedJobs++
+		if _, ok := runInfos[job.Id]; ok {
+			info.RunningJobs++
+		} else {
+			info.PendingJobs++
+		}
+		armadaresource.ComputeResources(info.LeasedResources).Add(job.TotalResourceRequest())
 	}
-
-	return result, nil
+	for _, job := range queuedJobs {
+		getJobSet(job.JobSetId).QueuedJobs++
+	}
+	for _, job := range suspendedJobs {
+		getJobSet(job.JobSetId).SuspendedJobs++
+	}
+	result := make([]*api.JobSetInfo, 0, len(jobSets))
+	for _, info := range jobSets {
+		result = append(result, info)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+	return result
 }
 
 // ExpireLeases expires the leases on all jobs for the provided queue.
@@ -1074,30 +1424,63 @@ func (repo *RedisJobRepository) leaseJobs(clusterId string, jobIdsByQueue map[st
 	return leasedJobIdsByQueue, nil
 }
 
-func addJob(db redis.Cmdable, job *api.Job, jobData *[]byte) *redis.Cmd {
-	return addJobScript.Run(db,
-		[]string{
-			jobQueuePrefix + job.Queue,
-			jobObjectPrefix + job.Id,
-			jobSetPrefix + job.JobSetId,
-			jobSetPrefix + job.Queue + keySeparator + job.JobSetId,
-			jobExistsPrefix + job.Id,
-		},
-		job.Id, job.Priority, *jobData)
+// addJob adds a command to db storing job. If job shares its pod specs, sharedPodSpecs are its compressed pod specs,
+// which are stored along with the reference of job to them unless already stored.
+func addJob(db redis.Cmdable, job *api.Job, jobData *[]byte, sharedPodSpecs []byte, eventData [][]byte) *redis.Cmd {
+	keys := []string{
+		jobQueuePrefix + job.Queue,
+		jobObjectPrefix + job.Id,
+		jobSetPrefix + job.JobSetId,
+		jobSetPrefix + job.Queue + keySeparator + job.JobSetId,
+		jobExistsPrefix + job.Id,
+		jobOwnerPrefix + job.Queue,
+		eventOutboxKey,
+		"",
+		jobResourcesPrefix + job.Queue,
+		queueResourcesPrefix + job.Queue,
+	}
+	if job.PodSpecsHash != "" {
+		keys[7] = sharedPodSpecsPrefix + job.PodSpecsHash
+	}
+	// The job is added to each set of keys from the 11th on.
+	keys = append(keys, jobSetLabelIndexedPrefix+job.Queue+keySeparator+job.JobSetId)
+	keys = append(keys, jobSetLabelKeys(job.Queue, job.JobSetId, job.Labels)...)
+	args := []interface{}{job.Id, job.Priority, *jobData, job.Owner, sharedPodSpecs, encodeJobResources(job)}
+	for _, data := range eventData {
+		args = append(args, data)
+	}
+	return addJobScript.Run(db, keys, args...)
+}
+
+// jobSetLabelKeys returns the keys of the sets indexing the jobs of a job set by each of the provided labels.
+func jobSetLabelKeys(queue string, jobSetId string, labels map[string]string) []string {
+	keys := make([]string, 0, len(labels))
+	for key, value := range labels {
+		keys = append(keys, jobSetLabelPrefix+queue+keySeparator+jobSetId+keySeparator+key+"="+value)
+	}
+	return keys
 }
 
 // This script will create the queue if it doesn't already exist.
 // To avoid creating queues implicitly, code executing this script must ensure that the queue already exists.
-var addJobScript = redis.NewScript(`
+var addJobScript = redis.NewScript(countQueueResourcesFunction + `
 local queueKey = KEYS[1]
 local jobKey = KEYS[2]
 local jobSetKey = KEYS[3]
 local jobSetQueueKey = KEYS[4]
 local jobExistsKey = KEYS[5]
+local jobOwnerKey = KEYS[6]
+local eventOutboxKey = KEYS[7]
+local sharedPodSpecsKey = KEYS[8]
+local jobResourcesKey = KEYS[9]
+local queueResourcesKey = KEYS[10]
 
 local jobId = ARGV[1]
 local jobPriority = ARGV[2]
 local jobData = ARGV[3]
+local jobOwner = ARGV[4]
+local sharedPodSpecs = ARGV[5]
+local jobResources = ARGV[6]
 
 local jobExists = redis.call('EXISTS', jobExistsKey)
 if jobExists == 1 then
@@ -1109,6 +1492,19 @@ redis.call('SET', jobKey, jobData)
 redis.call('SADD', jobSetKey, jobId)
 redis.call('SADD', jobSetQueueKey, jobId)
 redis.call('ZADD', queueKey, jobPriority, jobId)
+redis.call('HSET', jobOwnerKey, jobId, jobOwner)
+if sharedPodSpecsKey ~= '' then
+	redis.call('HSETNX', sharedPodSpecsKey, 'podSpecs', sharedPodSpecs)
+	redis.call('HSET', sharedPodSpecsKey, jobId, '1')
+end
+redis.call('HSET', jobResourcesKey, jobId, jobResources)
+countQueueResources(queueResourcesKey, jobResources, '')
+for i = 11, #KEYS do
+	redis.call('SADD', KEYS[i], jobId)
+end
+for i = 7, #ARGV do
+	redis.call('XADD', eventOutboxKey, '*', 'message', ARGV[i])
+end
 
 return jobId
 `)
@@ -0,0 +1,168 @@
+package repository
+
+import (
+	"github.com/gogo/protobuf/proto"
+	"github.com/hashicorp/go-multierror"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/armadaproject/armada/internal/common/armadaerrors"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+// JobCodec serializes jobs for storage by a job repository. If a pod spec store is configured, pod specs larger than
+// the threshold are stored in the pod spec store instead and only a reference to them is stored with the job,
+// such that occasional very large jobs don't affect the memory usage of the database.
+type JobCodec struct {
+	podSpecStore              ObjectStore
+	podSpecSizeThresholdBytes uint
+}
+
+// NewJobCodec returns a JobCodec storing pod specs larger than podSpecSizeThresholdBytes in podSpecStore, if non-nil.
+func NewJobCodec(podSpecStore ObjectStore, podSpecSizeThresholdBytes uint) JobCodec {
+	return JobCodec{
+		podSpecStore:              podSpecStore,
+		podSpecSizeThresholdBytes: podSpecSizeThresholdBytes,
+	}
+}
+
+// Marshal marshals job for storing. Pod specs already stored in the pod spec store are stored there again,
+// since they may have been updated.
+func (c JobCodec) Marshal(job *api.Job) ([]byte, error) {
+	if c.podSpecStore == nil {
+		jobData, err := proto.Marshal(job)
+		return jobData, errors.WithStack(err)
+	}
+	podSpecs := &api.Job{PodSpec: job.PodSpec, PodSpecs: job.PodSpecs}
+	if job.PodSpecsObjectKey == "" && uint(podSpecs.Size()) <= c.podSpecSizeThresholdBytes {
+		jobData, err := proto.Marshal(job)
+		return jobData, errors.WithStack(err)
+	}
+	objectKey := job.PodSpecsObjectKey
+	if objectKey == "" {
+		objectKey = job.Id
+	}
+	podSpecData, err := proto.Marshal(podSpecs)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if err := c.podSpecStore.Put(objectKey, podSpecData); err != nil {
+		return nil, errors.WithMessagef(err, "error storing pod specs of job %s", job.Id)
+	}
+	// Marshal a shallow copy, such that the job of the caller retains its pod specs.
+	storedJob := *job
+	storedJob.PodSpec = nil
+	storedJob.PodSpecs = nil
+	storedJob.PodSpecsObjectKey = objectKey
+	jobData, err := proto.Marshal(&storedJob)
+	return jobData, errors.WithStack(err)
+}
+
+// Unmarshal unmarshals a job stored with Marshal into result.Job. Failing to restore externally stored pod specs is
+// recorded in result.Error, whereas failing to unmarshal the job itself is returned.
+func (c JobCodec) Unmarshal(jobData []byte, result *JobResult) error {
+	result.Job = &api.Job{}
+	if err := proto.Unmarshal(jobData, result.Job); err != nil {
+		return errors.WithStack(errors.WithMessagef(err, "job id %s", result.JobId))
+	}
+	if err := c.loadExternalPodSpecs(result.Job); err != nil {
+		result.Job = nil
+		result.Error = errors.WithMessagef(err, "job id %s", result.JobId)
+		return nil
+	}
+
+	// Pod specs shared with other jobs are restored later, together with the required node labels.
+	if result.Job.PodSpecsHash == "" {
+		addRequiredNodeLabels(result.Job)
+	}
+	return nil
+}
+
+// TODO This shouldn't be here. We write these when creating the job,
+// and the getter shouldn't mutate the object read from the database.
+func addRequiredNodeLabels(job *api.Job) {
+	podSpec := job.GetMainPodSpec()
+	if podSpec == nil {
+		return
+	}
+	// TODO: remove, RequiredNodeLabels is deprecated and will be removed in future versions
+	for k, v := range job.RequiredNodeLabels {
+		if podSpec.NodeSelector == nil {
+			podSpec.NodeSelector = map[string]string{}
+		}
+		podSpec.NodeSelector[k] = v
+	}
+}
+
+// loadExternalPodSpecs restores the pod specs of job if they're stored in the pod spec store.
+func (c JobCodec) loadExternalPodSpecs(job *api.Job) error {
+	if job.PodSpecsObjectKey == "" {
+		return nil
+	}
+	if c.podSpecStore == nil {
+		return errors.Errorf("pod specs are stored externally under key %s, but no pod spec store is configured", job.PodSpecsObjectKey)
+	}
+	podSpecData, err := c.podSpecStore.Get(job.PodSpecsObjectKey)
+	if err != nil {
+		return err
+	}
+	podSpecs := &api.Job{}
+	if err := proto.Unmarshal(podSpecData, podSpecs); err != nil {
+		return errors.WithStack(err)
+	}
+	job.PodSpec = podSpecs.PodSpec
+	job.PodSpecs = podSpecs.PodSpecs
+	return nil
+}
+
+// DeleteExternalPodSpecs deletes the pod specs of job from the pod spec store, if stored there.
+// Failing to do so only leaks storage, so errors are logged rather than returned.
+func (c JobCodec) DeleteExternalPodSpecs(job *api.Job) {
+	if c.podSpecStore == nil {
+		return
+	}
+	objectKey := job.PodSpecsObjectKey
+	if objectKey == "" {
+		// Jobs submitted with large pod specs don't yet carry the key; it's derived from the job id.
+		if uint((&api.Job{PodSpec: job.PodSpec, PodSpecs: job.PodSpecs}).Size()) <= c.podSpecSizeThresholdBytes {
+			return
+		}
+		objectKey = job.Id
+	}
+	if err := c.podSpecStore.Delete(objectKey); err != nil {
+		log.WithError(err).Warnf("failed to delete pod specs of job %s", job.Id)
+	}
+}
+
+// ExistingJobs returns the jobs of jobResults, omitting those that weren't found,
+// as JobRepository.GetExistingJobsByIds does.
+func ExistingJobs(jobResults []*JobResult) ([]*api.Job, error) {
+	var result *multierror.Error
+	jobs := make([]*api.Job, 0, len(jobResults))
+	for _, jobResult := range jobResults {
+		var errJobNotFound *ErrJobNotFound
+		var errNotFound *armadaerrors.ErrNotFound
+		if errors.As(jobResult.Error, &errJobNotFound) || errors.As(jobResult.Error, &errNotFound) {
+			continue
+		} else if jobResult.Error != nil {
+			err := errors.WithMessagef(jobResult.Error, "error getting job with id %s from database", jobResult.JobId)
+			result = multierror.Append(result, err)
+			continue
+		}
+		// Ensure job.GetAnnotations and podSpec.NodeSelector are initialised.
+		// Necessary to mutate these in-place during scheduling.
+		if jobResult.Job.Annotations == nil {
+			jobResult.Job.Annotations = make(map[string]string)
+		}
+		if jobResult.Job.PodSpec != nil && jobResult.Job.PodSpec.NodeSelector == nil {
+			jobResult.Job.PodSpec.NodeSelector = make(map[string]string)
+		}
+		for _, podSpec := range jobResult.Job.PodSpecs {
+			if podSpec != nil && podSpec.NodeSelector == nil {
+				jobResult.Job.PodSpec.NodeSelector = make(map[string]string)
+			}
+		}
+		jobs = append(jobs, jobResult.Job)
+	}
+	return jobs, result.ErrorOrNil()
+}
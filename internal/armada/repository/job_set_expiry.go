@@ -0,0 +1,130 @@
+package repository
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/pkg/errors"
+)
+
+const (
+	jobSetExpiryKey           = "JobSetExpiry"
+	jobSetExpiryTtlSecondsKey = "JobSetExpiry:TtlSeconds"
+)
+
+// ExpiredJobSet is a job set that didn't complete within its TTL.
+type ExpiredJobSet struct {
+	Queue      string
+	JobSetId   string
+	TtlSeconds int64
+}
+
+// JobSetExpiryRepository stores the deadlines of job sets submitted with a TTL.
+type JobSetExpiryRepository interface {
+	// SetJobSetDeadline sets the deadline by which the job set must complete, unless the job set already has a deadline.
+	// ttl is the TTL the deadline was derived from and is returned along with the job set once it has expired.
+	SetJobSetDeadline(queue string, jobSetId string, deadline time.Time, ttl time.Duration) error
+	// ClaimExpiredJobSets removes and returns up to limit job sets with a deadline before now.
+	// Each expired job set is returned to exactly one caller, even if several servers claim concurrently.
+	ClaimExpiredJobSets(now time.Time, limit int) ([]*ExpiredJobSet, error)
+}
+
+type RedisJobSetExpiryRepository struct {
+	db redis.UniversalClient
+}
+
+func NewRedisJobSetExpiryRepository(db redis.UniversalClient) *RedisJobSetExpiryRepository {
+	return &RedisJobSetExpiryRepository{db: db}
+}
+
+func (r *RedisJobSetExpiryRepository) SetJobSetDeadline(queue string, jobSetId string, deadline time.Time, ttl time.Duration) error {
+	member, err := jobSetExpiryMember(queue, jobSetId)
+	if err != nil {
+		return err
+	}
+	_, err = setJobSetDeadlineScript.Run(
+		r.db,
+		[]string{jobSetExpiryKey, jobSetExpiryTtlSecondsKey},
+		member, deadline.Unix(), int64(ttl.Seconds()),
+	).Result()
+	if err != nil {
+		return errors.Wrapf(err, "[RedisJobSetExpiryRepository.SetJobSetDeadline] error setting deadline of job set %s", jobSetId)
+	}
+	return nil
+}
+
+func (r *RedisJobSetExpiryRepository) ClaimExpiredJobSets(now time.Time, limit int) ([]*ExpiredJobSet, error) {
+	result, err := claimExpiredJobSetsScript.Run(
+		r.db,
+		[]string{jobSetExpiryKey, jobSetExpiryTtlSecondsKey},
+		now.Unix(), limit,
+	).Result()
+	if err != nil {
+		return nil, errors.Wrap(err, "[RedisJobSetExpiryRepository.ClaimExpiredJobSets] error claiming expired job sets")
+	}
+	values := make([]string, 0)
+	for _, value := range result.([]interface{}) {
+		values = append(values, value.(string))
+	}
+
+	// The script returns alternating members and TTLs.
+	expiredJobSets := make([]*ExpiredJobSet, 0, len(values)/2)
+	for i := 0; i+1 < len(values); i += 2 {
+		var key []string
+		if err := json.Unmarshal([]byte(values[i]), &key); err != nil || len(key) != 2 {
+			return nil, errors.Errorf("[RedisJobSetExpiryRepository.ClaimExpiredJobSets] invalid job set %q", values[i])
+		}
+		ttlSeconds, err := strconv.ParseInt(values[i+1], 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "[RedisJobSetExpiryRepository.ClaimExpiredJobSets] error parsing TTL of job set %s", key[1])
+		}
+		expiredJobSets = append(expiredJobSets, &ExpiredJobSet{Queue: key[0], JobSetId: key[1], TtlSeconds: ttlSeconds})
+	}
+	return expiredJobSets, nil
+}
+
+// jobSetExpiryMember encodes queue and job set id such that they can be decoded unambiguously.
+func jobSetExpiryMember(queue string, jobSetId string) (string, error) {
+	member, err := json.Marshal([]string{queue, jobSetId})
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+	return string(member), nil
+}
+
+var setJobSetDeadlineScript = redis.NewScript(`
+local expiryKey = KEYS[1]
+local ttlSecondsKey = KEYS[2]
+
+local member = ARGV[1]
+local deadline = ARGV[2]
+local ttlSeconds = ARGV[3]
+
+if redis.call('ZSCORE', expiryKey, member) then
+	return 0
+end
+redis.call('ZADD', expiryKey, deadline, member)
+redis.call('HSET', ttlSecondsKey, member, ttlSeconds)
+return 1
+`)
+
+var claimExpiredJobSetsScript = redis.NewScript(`
+local expiryKey = KEYS[1]
+local ttlSecondsKey = KEYS[2]
+
+local now = ARGV[1]
+local limit = ARGV[2]
+
+local result = {}
+local members = redis.call('ZRANGEBYSCORE', expiryKey, '-inf', now, 'LIMIT', 0, limit)
+for _, member in ipairs(members) do
+	local ttlSeconds = redis.call('HGET', ttlSecondsKey, member) or '0'
+	redis.call('ZREM', expiryKey, member)
+	redis.call('HDEL', ttlSecondsKey, member)
+	table.insert(result, member)
+	table.insert(result, ttlSeconds)
+end
+return result
+`)
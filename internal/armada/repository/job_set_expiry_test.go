@@ -0,0 +1,56 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClaimExpiredJobSets(t *testing.T) {
+	withJobSetExpiryRepository(func(r *RedisJobSetExpiryRepository) {
+		now := time.Now()
+		require.NoError(t, r.SetJobSetDeadline("queue", "short", now.Add(time.Minute), time.Minute))
+		require.NoError(t, r.SetJobSetDeadline("queue", "long", now.Add(time.Hour), time.Hour))
+		// Job set ids may contain any characters.
+		require.NoError(t, r.SetJobSetDeadline("other:queue", "a:b", now.Add(time.Minute), time.Minute))
+
+		expired, err := r.ClaimExpiredJobSets(now, 10)
+		require.NoError(t, err)
+		assert.Empty(t, expired)
+
+		expired, err = r.ClaimExpiredJobSets(now.Add(2*time.Minute), 10)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []*ExpiredJobSet{
+			{Queue: "queue", JobSetId: "short", TtlSeconds: 60},
+			{Queue: "other:queue", JobSetId: "a:b", TtlSeconds: 60},
+		}, expired)
+
+		// Expired job sets are only claimed once.
+		expired, err = r.ClaimExpiredJobSets(now.Add(2*time.Minute), 10)
+		require.NoError(t, err)
+		assert.Empty(t, expired)
+	})
+}
+
+func TestSetJobSetDeadline_DoesNotExtendDeadline(t *testing.T) {
+	withJobSetExpiryRepository(func(r *RedisJobSetExpiryRepository) {
+		now := time.Now()
+		require.NoError(t, r.SetJobSetDeadline("queue", "jobSet", now.Add(time.Minute), time.Minute))
+		require.NoError(t, r.SetJobSetDeadline("queue", "jobSet", now.Add(time.Hour), time.Hour))
+
+		expired, err := r.ClaimExpiredJobSets(now.Add(2*time.Minute), 10)
+		require.NoError(t, err)
+		assert.Equal(t, []*ExpiredJobSet{{Queue: "queue", JobSetId: "jobSet", TtlSeconds: 60}}, expired)
+	})
+}
+
+func withJobSetExpiryRepository(action func(r *RedisJobSetExpiryRepository)) {
+	client := redis.NewClient(&redis.Options{Addr: "localhost:6379", DB: 10})
+	defer client.FlushDB()
+	defer client.Close()
+	client.FlushDB()
+	action(NewRedisJobSetExpiryRepository(client))
+}
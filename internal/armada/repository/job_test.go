@@ -3,15 +3,19 @@ package repository
 import (
 	"errors"
 	"math"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/go-redis/redis"
+	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 
+	"github.com/armadaproject/armada/internal/common/armadaerrors"
+	armadaresource "github.com/armadaproject/armada/internal/common/resource"
 	"github.com/armadaproject/armada/internal/common/util"
 	"github.com/armadaproject/armada/internal/scheduler/schedulerobjects"
 	"github.com/armadaproject/armada/pkg/api"
@@ -258,6 +262,191 @@ func TestGetJobSetJobIds(t *testing.T) {
 	})
 }
 
+func TestGetJobSetJobIds_FiltersByLabels(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		labelledJob := func(labels map[string]string) *api.Job {
+			return &api.Job{
+				Id:       util.NewULID(),
+				Queue:    "queue1",
+				JobSetId: "set1",
+				Labels:   labels,
+				PodSpec:  &v1.PodSpec{},
+				Created:  time.Now(),
+			}
+		}
+		sweepA := labelledJob(map[string]string{"sweep": "a", "size": "small"})
+		sweepALarge := labelledJob(map[string]string{"sweep": "a", "size": "large"})
+		sweepB := labelledJob(map[string]string{"sweep": "b", "size": "small"})
+		results, err := r.AddJobs([]*api.Job{sweepA, sweepALarge, sweepB})
+		require.NoError(t, err)
+		for _, result := range results {
+			require.NoError(t, result.Error)
+		}
+
+		ids, err := r.GetJobSetJobIds("queue1", "set1", &JobSetFilter{
+			IncludeQueued: true,
+			IncludeLeased: true,
+			Labels:        map[string]string{"sweep": "a"},
+		})
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{sweepA.Id, sweepALarge.Id}, ids)
+
+		ids, err = r.GetJobSetJobIds("queue1", "set1", &JobSetFilter{
+			IncludeQueued: true,
+			IncludeLeased: true,
+			Labels:        map[string]string{"sweep": "a", "size": "small"},
+		})
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{sweepA.Id}, ids)
+
+		// Deleted jobs are removed from the label index.
+		_, err = r.DeleteJobs([]*api.Job{sweepA})
+		require.NoError(t, err)
+		ids, err = r.GetJobSetJobIds("queue1", "set1", &JobSetFilter{
+			IncludeQueued: true,
+			IncludeLeased: true,
+			Labels:        map[string]string{"size": "small"},
+		})
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{sweepB.Id}, ids)
+	})
+}
+
+func TestGetJobSetJobIds_FiltersByLabels_OfJobsAddedBeforeIndexing(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		labelledJob := func(labels map[string]string) *api.Job {
+			return &api.Job{
+				Id:       util.NewULID(),
+				Queue:    "queue1",
+				JobSetId: "set1",
+				Labels:   labels,
+				PodSpec:  &v1.PodSpec{},
+				Created:  time.Now(),
+			}
+		}
+		unindexedSweepA := labelledJob(map[string]string{"sweep": "a"})
+		unindexedSweepB := labelledJob(map[string]string{"sweep": "b"})
+		indexedSweepA := labelledJob(map[string]string{"sweep": "a"})
+		results, err := r.AddJobs([]*api.Job{unindexedSweepA, unindexedSweepB, indexedSweepA})
+		require.NoError(t, err)
+		for _, result := range results {
+			require.NoError(t, result.Error)
+		}
+		// Jobs added before jobs were indexed by label aren't in the index.
+		for _, job := range []*api.Job{unindexedSweepA, unindexedSweepB} {
+			keys := append(jobSetLabelKeys(job.Queue, job.JobSetId, job.Labels), jobSetLabelIndexedPrefix+job.Queue+keySeparator+job.JobSetId)
+			for _, key := range keys {
+				require.NoError(t, r.db.SRem(key, job.Id).Err())
+			}
+		}
+
+		ids, err := r.GetJobSetJobIds("queue1", "set1", &JobSetFilter{
+			IncludeQueued: true,
+			IncludeLeased: true,
+			Labels:        map[string]string{"sweep": "a"},
+		})
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{unindexedSweepA.Id, indexedSweepA.Id}, ids)
+	})
+}
+
+func TestGetQueueJobIdsByOwner(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		job1 := addTestJob(t, r, "queue1")
+		job2 := addTestJob(t, r, "queue1")
+		addLeasedJob(t, r, "queue1", "cluster1")
+		deletedJob := addTestJob(t, r, "queue1")
+		addTestJob(t, r, "queue2")
+		otherOwnerJob := &api.Job{Id: util.NewULID(), Queue: "queue1", JobSetId: "set1", Owner: "other-user", Created: time.Now()}
+		_, err := r.AddJobs([]*api.Job{otherOwnerJob})
+		require.NoError(t, err)
+		_, err = r.DeleteJobs([]*api.Job{deletedJob})
+		require.NoError(t, err)
+
+		jobIdsByOwner, err := r.GetQueueJobIdsByOwner("queue1")
+		require.NoError(t, err)
+		assert.Equal(t, map[string][]string{
+			"user":       {job1.Id, job2.Id},
+			"other-user": {otherOwnerJob.Id},
+		}, jobIdsByOwner)
+	})
+}
+
+func TestGetQueueJobIdsPage(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		job1 := addTestJob(t, r, "queue1")
+		job2 := addTestJob(t, r, "queue1")
+		job3 := addTestJob(t, r, "queue1")
+		addTestJob(t, r, "queue2")
+
+		allIds, err := r.GetQueueJobIds("queue1")
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{job1.Id, job2.Id, job3.Id}, allIds)
+
+		page, err := r.GetQueueJobIdsPage("queue1", 0, 2)
+		require.NoError(t, err)
+		assert.Equal(t, allIds[:2], page)
+		page, err = r.GetQueueJobIdsPage("queue1", 2, 2)
+		require.NoError(t, err)
+		assert.Equal(t, allIds[2:], page)
+		page, err = r.GetQueueJobIdsPage("queue1", 3, 2)
+		require.NoError(t, err)
+		assert.Empty(t, page)
+	})
+}
+
+func TestSuspendAndResumeJobs(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		queuedJob := addTestJob(t, r, "queue1")
+		leasedJob := addLeasedJob(t, r, "queue1", "cluster1")
+
+		suspended, err := r.SuspendJobs("queue1", []string{queuedJob.Id, leasedJob.Id})
+		require.NoError(t, err)
+		assert.Equal(t, []string{queuedJob.Id}, suspended)
+
+		// Suspended jobs can't be leased.
+		queued, err := r.PeekQueue("queue1", 10)
+		require.NoError(t, err)
+		assert.Empty(t, queued)
+		leased, err := r.TryLeaseJobs("cluster1", map[string][]string{"queue1": {queuedJob.Id}})
+		require.NoError(t, err)
+		assert.Empty(t, leased)
+
+		ids, err := r.GetJobSetJobIds("queue1", "set1", &JobSetFilter{IncludeSuspended: true})
+		require.NoError(t, err)
+		assert.Equal(t, []string{queuedJob.Id}, ids)
+		ids, err = r.GetActiveJobIds("queue1", "set1")
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{queuedJob.Id, leasedJob.Id}, ids)
+
+		resumed, err := r.ResumeJobs("queue1", []string{queuedJob.Id, leasedJob.Id})
+		require.NoError(t, err)
+		assert.Equal(t, []string{queuedJob.Id}, resumed)
+		queued, err = r.PeekQueue("queue1", 10)
+		require.NoError(t, err)
+		require.Len(t, queued, 1)
+		assert.Equal(t, queuedJob.Id, queued[0].Id)
+	})
+}
+
+func TestDeleteSuspendedJob(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		job := addTestJob(t, r, "queue1")
+		_, err := r.SuspendJobs("queue1", []string{job.Id})
+		require.NoError(t, err)
+
+		result, err := r.DeleteJobs([]*api.Job{job})
+		require.NoError(t, err)
+		err, deletionOccurred := result[job]
+		assert.Nil(t, err)
+		assert.True(t, deletionOccurred)
+
+		ids, err := r.GetJobSetJobIds("queue1", "set1", nil)
+		require.NoError(t, err)
+		assert.Empty(t, ids)
+	})
+}
+
 func TestReturnLeaseForDeletedJobShouldKeepJobDeleted(t *testing.T) {
 	withRepository(func(r *RedisJobRepository) {
 		job := addLeasedJob(t, r, "cancel-test-queue", "cluster")
@@ -422,6 +611,37 @@ func TestGetJobRunInfos(t *testing.T) {
 	})
 }
 
+func TestGetLeasedJobClusterIds(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		queuedJob := addTestJob(t, r, "queue1")
+		leasedJob1 := addLeasedJob(t, r, "queue1", "cluster1")
+		leasedJob2 := addLeasedJob(t, r, "queue1", "cluster2")
+
+		clusterIds, err := r.GetLeasedJobClusterIds([]string{queuedJob.Id, leasedJob1.Id, leasedJob2.Id})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{leasedJob1.Id: "cluster1", leasedJob2.Id: "cluster2"}, clusterIds)
+	})
+}
+
+func TestGetJobStates(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		queuedJob := addTestJob(t, r, "queue1")
+		leasedJob := addLeasedJob(t, r, "queue1", "cluster1")
+		suspendedJob := addTestJob(t, r, "queue1")
+		otherQueueJob := addTestJob(t, r, "queue2")
+		_, err := r.SuspendJobs("queue1", []string{suspendedJob.Id})
+		require.NoError(t, err)
+
+		states, err := r.GetJobStates("queue1", []string{queuedJob.Id, leasedJob.Id, suspendedJob.Id, otherQueueJob.Id, "missing"})
+		require.NoError(t, err)
+		assert.Equal(
+			t,
+			map[string]JobState{queuedJob.Id: JobStateQueued, leasedJob.Id: JobStateLeased, suspendedJob.Id: JobStateSuspended},
+			states,
+		)
+	})
+}
+
 func TestGetJobRunInfos_HandlesJobWithoutClusterAssociation(t *testing.T) {
 	withRepository(func(r *RedisJobRepository) {
 		job1 := addTestJob(t, r, "queue1")
@@ -478,16 +698,29 @@ func TestGetJobRunInfos_ReturnStartTimeForCurrentAssociatedCluster(t *testing.T)
 func TestGetQueueActiveJobSets(t *testing.T) {
 	withRepository(func(r *RedisJobRepository) {
 		addTestJob(t, r, "queue1")
+		suspendedJob := addTestJob(t, r, "queue1")
+		_, err := r.SuspendJobs("queue1", []string{suspendedJob.Id})
+		require.NoError(t, err)
 		addLeasedJob(t, r, "queue1", "cluster1")
+		runningJob := addLeasedJob(t, r, "queue1", "cluster1")
 		addTestJob(t, r, "queue2")
+		jobErrors, err := r.UpdateStartTime([]*JobStartInfo{{
+			JobId:     runningJob.Id,
+			ClusterId: "cluster1",
+			StartTime: time.Now(),
+		}})
+		AssertUpdateStartTimeNoErrors(t, jobErrors, err)
 
 		infos, e := r.GetQueueActiveJobSets("queue1")
 		require.NoError(t, e)
-		assert.Equal(t, []*api.JobSetInfo{{
-			Name:       "set1",
-			QueuedJobs: 1,
-			LeasedJobs: 1,
-		}}, infos)
+		require.Len(t, infos, 1)
+		assert.Equal(t, "set1", infos[0].Name)
+		assert.Equal(t, int32(1), infos[0].QueuedJobs)
+		assert.Equal(t, int32(1), infos[0].SuspendedJobs)
+		assert.Equal(t, int32(2), infos[0].LeasedJobs)
+		assert.Equal(t, int32(1), infos[0].PendingJobs)
+		assert.Equal(t, int32(1), infos[0].RunningJobs)
+		assert.Equal(t, "cpu: 2, memory: 1Gi", armadaresource.ComputeResources(infos[0].LeasedResources).String())
 	})
 }
 
@@ -769,6 +1002,252 @@ func whenOneOfThreeJobsIsMissing_SkipsMissingJob_OtherChangesSucceed(t *testing.
 	})
 }
 
+func TestPodSpecStore_LargePodSpecsAreStoredOutsideOfRedis(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		podSpecStore, err := NewFileObjectStore(t.TempDir())
+		require.NoError(t, err)
+		r = NewRedisJobRepositoryWithPodSpecStore(r.db, podSpecStore, 1)
+		job := addTestJob(t, r, "queue1")
+
+		// Only a reference to the pod spec is stored in Redis.
+		jobData, err := r.db.Get(jobObjectPrefix + job.Id).Bytes()
+		require.NoError(t, err)
+		storedJob := &api.Job{}
+		require.NoError(t, proto.Unmarshal(jobData, storedJob))
+		assert.Nil(t, storedJob.PodSpec)
+		assert.Equal(t, job.Id, storedJob.PodSpecsObjectKey)
+		assert.NotNil(t, job.PodSpec, "the pod spec of the submitted job must not be removed")
+
+		// The pod spec is restored when the job is leased.
+		leasedJobIds, err := r.TryLeaseJobs("cluster1", map[string][]string{"queue1": {job.Id}})
+		require.NoError(t, err)
+		assert.Equal(t, []string{job.Id}, leasedJobIds["queue1"])
+		leasedJobs, err := r.GetExistingJobsByIds([]string{job.Id})
+		require.NoError(t, err)
+		require.Len(t, leasedJobs, 1)
+		assert.Equal(t, job.PodSpec.Containers[0].Resources, leasedJobs[0].PodSpec.Containers[0].Resources)
+
+		// Updates to the pod spec are stored too.
+		_, err = r.UpdateJobs([]string{job.Id}, func(jobs []*api.Job) {
+			jobs[0].PodSpec.SchedulerName = "custom"
+		})
+		require.NoError(t, err)
+		updatedJobs, err := r.GetExistingJobsByIds([]string{job.Id})
+		require.NoError(t, err)
+		require.Len(t, updatedJobs, 1)
+		assert.Equal(t, "custom", updatedJobs[0].PodSpec.SchedulerName)
+
+		// Deleting the job deletes its pod spec.
+		_, err = r.DeleteJobs(updatedJobs)
+		require.NoError(t, err)
+		_, err = podSpecStore.Get(job.Id)
+		var errNotFound *armadaerrors.ErrNotFound
+		assert.True(t, errors.As(err, &errNotFound))
+	})
+}
+
+func TestPodSpecStore_SmallPodSpecsAreStoredInRedis(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		podSpecStore, err := NewFileObjectStore(t.TempDir())
+		require.NoError(t, err)
+		r = NewRedisJobRepositoryWithPodSpecStore(r.db, podSpecStore, 65535)
+		job := addTestJob(t, r, "queue1")
+
+		jobData, err := r.db.Get(jobObjectPrefix + job.Id).Bytes()
+		require.NoError(t, err)
+		storedJob := &api.Job{}
+		require.NoError(t, proto.Unmarshal(jobData, storedJob))
+		assert.NotNil(t, storedJob.PodSpec)
+		assert.Empty(t, storedJob.PodSpecsObjectKey)
+	})
+}
+
+func TestJobArrays_PodSpecsAreSharedByMembers(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		arrayId := util.NewULID()
+		jobs := make([]*api.Job, 3)
+		for i := range jobs {
+			podSpec := &v1.PodSpec{Containers: []v1.Container{{Name: "container", Image: "image"}}}
+			api.SetJobArrayIndexEnvVar(podSpec, uint32(i))
+			jobs[i] = &api.Job{
+				Id:            util.NewULID(),
+				Queue:         "queue1",
+				JobSetId:      "set1",
+				PodSpec:       podSpec,
+				Created:       time.Now(),
+				JobArrayId:    arrayId,
+				JobArrayIndex: uint32(i),
+			}
+		}
+		results, err := r.AddJobs(jobs)
+		require.NoError(t, err)
+		for _, result := range results {
+			require.NoError(t, result.Error)
+		}
+
+		// The pod specs are stored once, rather than with each member.
+		hash := jobs[0].PodSpecsHash
+		require.NotEmpty(t, hash)
+		for _, job := range jobs {
+			assert.Equal(t, hash, job.PodSpecsHash)
+			assert.Nil(t, getStoredJob(t, r, job.Id).PodSpec)
+		}
+		assertSharedPodSpecReferences(t, r, hash, jobs[0].Id, jobs[1].Id, jobs[2].Id)
+
+		// Each member is read with its own index.
+		readJobs, err := r.GetExistingJobsByIds([]string{jobs[2].Id, jobs[1].Id})
+		require.NoError(t, err)
+		require.Len(t, readJobs, 2)
+		assert.Equal(t, jobs[2].PodSpec.Containers, readJobs[0].PodSpec.Containers)
+		assert.Equal(t, jobs[1].PodSpec.Containers, readJobs[1].PodSpec.Containers)
+
+		// Members whose pod specs are updated store them on their own.
+		_, err = r.UpdateJobs([]string{jobs[0].Id}, func(jobs []*api.Job) {
+			jobs[0].PodSpec.SchedulerName = "custom"
+		})
+		require.NoError(t, err)
+		readJobs, err = r.GetExistingJobsByIds([]string{jobs[0].Id, jobs[1].Id})
+		require.NoError(t, err)
+		require.Len(t, readJobs, 2)
+		assert.Equal(t, "custom", readJobs[0].PodSpec.SchedulerName)
+		assert.Equal(t, jobs[0].PodSpec.Containers, readJobs[0].PodSpec.Containers)
+		assert.Empty(t, readJobs[0].PodSpecsHash)
+		assert.Empty(t, readJobs[1].PodSpec.SchedulerName)
+		assertSharedPodSpecReferences(t, r, hash, jobs[1].Id, jobs[2].Id)
+
+		// The pod specs are deleted with the last member.
+		_, err = r.DeleteJobs(jobs[1:2])
+		require.NoError(t, err)
+		assertSharedPodSpecReferences(t, r, hash, jobs[2].Id)
+		_, err = r.DeleteJobs(jobs[1:])
+		require.NoError(t, err)
+		exists, err := r.db.Exists(sharedPodSpecsPrefix + hash).Result()
+		require.NoError(t, err)
+		assert.Zero(t, exists)
+	})
+}
+
+func TestPodSpecDeduplication(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		r.WithPodSpecDeduplication()
+		newJob := func(image string) *api.Job {
+			return &api.Job{
+				Id:       util.NewULID(),
+				Queue:    "queue1",
+				JobSetId: "set1",
+				PodSpec:  &v1.PodSpec{Containers: []v1.Container{{Name: "container", Image: image}}},
+				Created:  time.Now(),
+			}
+		}
+		jobs := []*api.Job{newJob("image"), newJob("image"), newJob("other")}
+		results, err := r.AddJobs(jobs)
+		require.NoError(t, err)
+		for _, result := range results {
+			require.NoError(t, result.Error)
+		}
+
+		// Identical pod specs are stored once.
+		assert.Equal(t, jobs[0].PodSpecsHash, jobs[1].PodSpecsHash)
+		assert.NotEqual(t, jobs[0].PodSpecsHash, jobs[2].PodSpecsHash)
+		for _, job := range jobs {
+			assert.Nil(t, getStoredJob(t, r, job.Id).PodSpec)
+		}
+		assertSharedPodSpecReferences(t, r, jobs[0].PodSpecsHash, jobs[0].Id, jobs[1].Id)
+		assertSharedPodSpecReferences(t, r, jobs[2].PodSpecsHash, jobs[2].Id)
+
+		// Each job is read with its own copy of the pod specs.
+		readJobs, err := r.GetExistingJobsByIds([]string{jobs[0].Id, jobs[1].Id, jobs[2].Id})
+		require.NoError(t, err)
+		require.Len(t, readJobs, 3)
+		for i, job := range readJobs {
+			assert.Equal(t, jobs[i].PodSpec.Containers, job.PodSpec.Containers)
+		}
+		assert.NotSame(t, readJobs[0].PodSpec, readJobs[1].PodSpec)
+
+		// The pod specs of jobs that aren't stored aren't stored either.
+		duplicate := newJob("duplicate")
+		duplicate.Id = jobs[0].Id
+		results, err = r.AddJobs([]*api.Job{duplicate})
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.True(t, results[0].AlreadyProcessed)
+		exists, err := r.db.Exists(sharedPodSpecsPrefix + duplicate.PodSpecsHash).Result()
+		require.NoError(t, err)
+		assert.Zero(t, exists)
+
+		// Pod specs are deleted with the last job referencing them, even if deleted more than once.
+		_, err = r.DeleteJobs(jobs[:1])
+		require.NoError(t, err)
+		_, err = r.DeleteJobs(jobs[:1])
+		require.NoError(t, err)
+		assertSharedPodSpecReferences(t, r, jobs[1].PodSpecsHash, jobs[1].Id)
+		_, err = r.DeleteJobs(jobs[1:])
+		require.NoError(t, err)
+		for _, job := range jobs {
+			exists, err := r.db.Exists(sharedPodSpecsPrefix + job.PodSpecsHash).Result()
+			require.NoError(t, err)
+			assert.Zero(t, exists)
+		}
+	})
+}
+
+func TestPodSpecDeduplication_ConcurrentAddAndDelete(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		r.WithPodSpecDeduplication()
+		newJob := func() *api.Job {
+			return &api.Job{
+				Id:       util.NewULID(),
+				Queue:    "queue1",
+				JobSetId: "set1",
+				PodSpec:  &v1.PodSpec{Containers: []v1.Container{{Name: "container", Image: "image"}}},
+				Created:  time.Now(),
+			}
+		}
+
+		// Deleting the only job referencing the pod specs while adding another job sharing them
+		// mustn't delete the pod specs the added job references.
+		previous := newJob()
+		_, err := r.AddJobs([]*api.Job{previous})
+		require.NoError(t, err)
+		for i := 0; i < 200; i++ {
+			job := newJob()
+			var wg sync.WaitGroup
+			wg.Add(2)
+			go func() {
+				defer wg.Done()
+				_, err := r.AddJobs([]*api.Job{job})
+				assert.NoError(t, err)
+			}()
+			go func(previous *api.Job) {
+				defer wg.Done()
+				_, err := r.DeleteJobs([]*api.Job{previous})
+				assert.NoError(t, err)
+			}(previous)
+			wg.Wait()
+
+			readJobs, err := r.GetExistingJobsByIds([]string{job.Id})
+			require.NoError(t, err)
+			require.Len(t, readJobs, 1)
+			assertSharedPodSpecReferences(t, r, job.PodSpecsHash, job.Id)
+			previous = job
+		}
+	})
+}
+
+func getStoredJob(t *testing.T, r *RedisJobRepository, jobId string) *api.Job {
+	jobData, err := r.db.Get(jobObjectPrefix + jobId).Bytes()
+	require.NoError(t, err)
+	storedJob := &api.Job{}
+	require.NoError(t, proto.Unmarshal(jobData, storedJob))
+	return storedJob
+}
+
+func assertSharedPodSpecReferences(t *testing.T, r *RedisJobRepository, hash string, jobIds ...string) {
+	fields, err := r.db.HKeys(sharedPodSpecsPrefix + hash).Result()
+	require.NoError(t, err)
+	assert.ElementsMatch(t, append([]string{sharedPodSpecsField}, jobIds...), fields)
+}
+
 func addLeasedJob(t *testing.T, r *RedisJobRepository, queue string, cluster string) *api.Job {
 	job := addTestJob(t, r, queue)
 	leased, e := r.TryLeaseJobs(cluster, map[string][]string{queue: {job.Id}})
@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"crypto/tls"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/segmentio/kafka-go"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+)
+
+// KafkaEventMirrorProducer is an EventMirrorProducer publishing messages to Kafka.
+// Messages are assigned to partitions by the hash of their keys, and are acknowledged once written to all in-sync
+// replicas. Failed writes aren't retried, since MirroringEventStore retries them.
+type KafkaEventMirrorProducer struct {
+	writer *kafka.Writer
+}
+
+// NewKafkaEventMirrorProducer returns a producer publishing to the Kafka cluster of brokers.
+// Writes time out after writeTimeout. If useTls is true, brokers are connected to via TLS.
+func NewKafkaEventMirrorProducer(brokers []string, writeTimeout time.Duration, useTls bool) *KafkaEventMirrorProducer {
+	transport := &kafka.Transport{}
+	if useTls {
+		transport.TLS = &tls.Config{MinVersion: tls.VersionTLS12}
+	}
+	return &KafkaEventMirrorProducer{
+		writer: &kafka.Writer{
+			Addr:         kafka.TCP(brokers...),
+			Balancer:     &kafka.Hash{},
+			RequiredAcks: kafka.RequireAll,
+			MaxAttempts:  1,
+			WriteTimeout: writeTimeout,
+			// Writes are synchronous, so they needn't wait for more messages to batch them with.
+			BatchTimeout: time.Millisecond,
+			Transport:    transport,
+		},
+	}
+}
+
+func (p *KafkaEventMirrorProducer) Produce(ctx *armadacontext.Context, topic string, messages []*EventMirrorMessage) error {
+	kafkaMessages := make([]kafka.Message, len(messages))
+	for i, message := range messages {
+		kafkaMessages[i] = kafka.Message{Topic: topic, Key: message.Key, Value: message.Value}
+	}
+	return errors.WithStack(p.writer.WriteMessages(ctx, kafkaMessages...))
+}
+
+// Close flushes pending writes and closes the connections of the producer.
+func (p *KafkaEventMirrorProducer) Close() error {
+	return errors.WithStack(p.writer.Close())
+}
@@ -0,0 +1,170 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+	"golang.org/x/exp/slices"
+
+	"github.com/armadaproject/armada/internal/common/util"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+const (
+	maintenanceWindowsKey       = "MaintenanceWindows"      // maintenance windows, by id
+	maintenanceWindowJobsPrefix = "MaintenanceWindowJobs:"  // ids of the jobs affected by a started window, by window id
+	maintenanceWindowsLockKey   = "MaintenanceWindows:Lock" // held while processing windows
+	// The lock expires in case its holder dies, so it must outlive processing windows, e.g., draining their jobs.
+	maintenanceWindowsLockTtl = 5 * time.Minute
+)
+
+// MaintenanceWindowRepository stores the maintenance windows of executors and queues.
+type MaintenanceWindowRepository interface {
+	// StoreMaintenanceWindow stores a new maintenance window.
+	StoreMaintenanceWindow(window *api.MaintenanceWindow) error
+	// StartMaintenanceWindow stores window as started, along with the ids of the jobs affected by it, unless it has
+	// been changed or deleted since it was read. Returns false if so, in which case nothing is stored.
+	StartMaintenanceWindow(window *api.MaintenanceWindow, jobIds []string) (bool, error)
+	// DeleteMaintenanceWindow deletes the maintenance window with the given id, returning it along with the ids of the
+	// jobs stored when it started. Returns a nil window if there's no such window.
+	DeleteMaintenanceWindow(id string) (*api.MaintenanceWindow, []string, error)
+	// GetMaintenanceWindows returns all maintenance windows, ordered by start.
+	GetMaintenanceWindows() ([]*api.MaintenanceWindow, error)
+	// ProcessMaintenanceWindows calls process with all maintenance windows, ordered by start.
+	// Only one caller processes windows at a time; others return false immediately without calling process.
+	ProcessMaintenanceWindows(process func(windows []*api.MaintenanceWindow)) (bool, error)
+}
+
+type RedisMaintenanceWindowRepository struct {
+	db redis.UniversalClient
+}
+
+func NewRedisMaintenanceWindowRepository(db redis.UniversalClient) *RedisMaintenanceWindowRepository {
+	return &RedisMaintenanceWindowRepository{db: db}
+}
+
+func (r *RedisMaintenanceWindowRepository) StoreMaintenanceWindow(window *api.MaintenanceWindow) error {
+	data, err := proto.Marshal(window)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if err := r.db.HSet(maintenanceWindowsKey, window.Id, data).Err(); err != nil {
+		return errors.Wrapf(err, "[RedisMaintenanceWindowRepository.StoreMaintenanceWindow] error storing window %s", window.Id)
+	}
+	return nil
+}
+
+func (r *RedisMaintenanceWindowRepository) StartMaintenanceWindow(window *api.MaintenanceWindow, jobIds []string) (bool, error) {
+	data, err := proto.Marshal(window)
+	if err != nil {
+		return false, errors.WithStack(err)
+	}
+	started := proto.Clone(window).(*api.MaintenanceWindow)
+	started.Started = true
+	startedData, err := proto.Marshal(started)
+	if err != nil {
+		return false, errors.WithStack(err)
+	}
+	args := make([]interface{}, 0, len(jobIds)+3)
+	args = append(args, window.Id, data, startedData)
+	for _, jobId := range jobIds {
+		args = append(args, jobId)
+	}
+	result, err := startMaintenanceWindowScript.Run(
+		r.db, []string{maintenanceWindowsKey, maintenanceWindowJobsPrefix + window.Id}, args...,
+	).Int()
+	if err != nil {
+		return false, errors.Wrapf(err, "[RedisMaintenanceWindowRepository.StartMaintenanceWindow] error starting window %s", window.Id)
+	}
+	return result == 1, nil
+}
+
+func (r *RedisMaintenanceWindowRepository) DeleteMaintenanceWindow(id string) (*api.MaintenanceWindow, []string, error) {
+	result, err := deleteMaintenanceWindowScript.Run(r.db, []string{maintenanceWindowsKey, maintenanceWindowJobsPrefix + id}, id).Result()
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "[RedisMaintenanceWindowRepository.DeleteMaintenanceWindow] error deleting window %s", id)
+	}
+	values, ok := result.([]interface{})
+	if !ok || len(values) != 2 {
+		return nil, nil, nil
+	}
+	window := &api.MaintenanceWindow{}
+	if err := proto.Unmarshal([]byte(values[0].(string)), window); err != nil {
+		return nil, nil, errors.Wrapf(err, "[RedisMaintenanceWindowRepository.DeleteMaintenanceWindow] error unmarshalling window %s", id)
+	}
+	members := values[1].([]interface{})
+	jobIds := make([]string, len(members))
+	for i, member := range members {
+		jobIds[i] = member.(string)
+	}
+	return window, jobIds, nil
+}
+
+func (r *RedisMaintenanceWindowRepository) ProcessMaintenanceWindows(process func(windows []*api.MaintenanceWindow)) (bool, error) {
+	token := util.NewULID()
+	acquired, err := r.db.SetNX(maintenanceWindowsLockKey, token, maintenanceWindowsLockTtl).Result()
+	if err != nil {
+		return false, errors.Wrap(err, "[RedisMaintenanceWindowRepository.ProcessMaintenanceWindows] error acquiring lock")
+	} else if !acquired {
+		return false, nil
+	}
+	// Failing to release the lock only delays processing windows until it expires.
+	defer releaseLockScript.Run(r.db, []string{maintenanceWindowsLockKey}, token)
+
+	windows, err := r.GetMaintenanceWindows()
+	if err != nil {
+		return true, err
+	}
+	process(windows)
+	return true, nil
+}
+
+func (r *RedisMaintenanceWindowRepository) GetMaintenanceWindows() ([]*api.MaintenanceWindow, error) {
+	values, err := r.db.HGetAll(maintenanceWindowsKey).Result()
+	if err != nil {
+		return nil, errors.Wrap(err, "[RedisMaintenanceWindowRepository.GetMaintenanceWindows] error getting windows")
+	}
+	windows := make([]*api.MaintenanceWindow, 0, len(values))
+	for id, data := range values {
+		window := &api.MaintenanceWindow{}
+		if err := proto.Unmarshal([]byte(data), window); err != nil {
+			return nil, errors.Wrapf(err, "[RedisMaintenanceWindowRepository.GetMaintenanceWindows] error unmarshalling window %s", id)
+		}
+		windows = append(windows, window)
+	}
+	slices.SortFunc(windows, func(a, b *api.MaintenanceWindow) bool {
+		if !a.Start.Equal(b.Start) {
+			return a.Start.Before(b.Start)
+		}
+		return a.Id < b.Id
+	})
+	return windows, nil
+}
+
+// Replaces the window ARGV[1] in the hash KEYS[1] with ARGV[3] if it's still ARGV[2], and adds the job ids ARGV[4:] to
+// the set KEYS[2]. Returns 1 if the window was replaced, or 0 if it was changed or deleted in the meantime.
+var startMaintenanceWindowScript = redis.NewScript(`
+if redis.call('HGET', KEYS[1], ARGV[1]) ~= ARGV[2] then
+	return 0
+end
+redis.call('HSET', KEYS[1], ARGV[1], ARGV[3])
+for i = 4, #ARGV do
+	redis.call('SADD', KEYS[2], ARGV[i])
+end
+return 1
+`)
+
+// Deletes the window ARGV[1] from the hash KEYS[1], along with the set of job ids KEYS[2].
+// Returns the window and the job ids, or an empty array if there's no such window.
+var deleteMaintenanceWindowScript = redis.NewScript(`
+local window = redis.call('HGET', KEYS[1], ARGV[1])
+if not window then
+	return {}
+end
+local jobIds = redis.call('SMEMBERS', KEYS[2])
+redis.call('HDEL', KEYS[1], ARGV[1])
+redis.call('DEL', KEYS[2])
+return {window, jobIds}
+`)
@@ -0,0 +1,93 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+func TestMaintenanceWindowRepository(t *testing.T) {
+	withMaintenanceWindowRepository(func(r *RedisMaintenanceWindowRepository) {
+		start := time.Now().UTC().Truncate(time.Second)
+		later := &api.MaintenanceWindow{Id: "a", Executor: "cluster", Start: start.Add(time.Hour), End: start.Add(2 * time.Hour)}
+		earlier := &api.MaintenanceWindow{Id: "b", Queue: "queue", Start: start, End: start.Add(time.Hour), Drain: true}
+		require.NoError(t, r.StoreMaintenanceWindow(later))
+		require.NoError(t, r.StoreMaintenanceWindow(earlier))
+
+		windows, err := r.GetMaintenanceWindows()
+		require.NoError(t, err)
+		assert.Equal(t, []*api.MaintenanceWindow{earlier, later}, windows)
+
+		started, err := r.StartMaintenanceWindow(earlier, []string{"job-1", "job-2"})
+		require.NoError(t, err)
+		assert.True(t, started)
+		// Windows are only started once.
+		started, err = r.StartMaintenanceWindow(earlier, nil)
+		require.NoError(t, err)
+		assert.False(t, started)
+		earlier.Started = true
+		windows, err = r.GetMaintenanceWindows()
+		require.NoError(t, err)
+		assert.Equal(t, []*api.MaintenanceWindow{earlier, later}, windows)
+
+		deleted, jobIds, err := r.DeleteMaintenanceWindow("b")
+		require.NoError(t, err)
+		assert.Equal(t, earlier, deleted)
+		assert.ElementsMatch(t, []string{"job-1", "job-2"}, jobIds)
+		deleted, _, err = r.DeleteMaintenanceWindow("b")
+		require.NoError(t, err)
+		assert.Nil(t, deleted)
+		windows, err = r.GetMaintenanceWindows()
+		require.NoError(t, err)
+		assert.Equal(t, []*api.MaintenanceWindow{later}, windows)
+
+		// Deleted windows aren't stored again when starting them.
+		deleted, jobIds, err = r.DeleteMaintenanceWindow("a")
+		require.NoError(t, err)
+		assert.Equal(t, later, deleted)
+		assert.Empty(t, jobIds)
+		started, err = r.StartMaintenanceWindow(later, []string{"job-3"})
+		require.NoError(t, err)
+		assert.False(t, started)
+		windows, err = r.GetMaintenanceWindows()
+		require.NoError(t, err)
+		assert.Empty(t, windows)
+	})
+}
+
+func TestProcessMaintenanceWindows_OneCallerAtATime(t *testing.T) {
+	withMaintenanceWindowRepository(func(r *RedisMaintenanceWindowRepository) {
+		window := &api.MaintenanceWindow{Id: "a", Queue: "queue", Start: time.Now().UTC().Truncate(time.Second)}
+		require.NoError(t, r.StoreMaintenanceWindow(window))
+
+		var processed []*api.MaintenanceWindow
+		acquired, err := r.ProcessMaintenanceWindows(func(windows []*api.MaintenanceWindow) {
+			processed = windows
+			concurrentlyAcquired, err := r.ProcessMaintenanceWindows(func([]*api.MaintenanceWindow) {
+				t.Error("windows processed concurrently")
+			})
+			require.NoError(t, err)
+			assert.False(t, concurrentlyAcquired)
+		})
+		require.NoError(t, err)
+		assert.True(t, acquired)
+		assert.Equal(t, []*api.MaintenanceWindow{window}, processed)
+
+		acquired, err = r.ProcessMaintenanceWindows(func([]*api.MaintenanceWindow) {})
+		require.NoError(t, err)
+		assert.True(t, acquired)
+	})
+}
+
+func withMaintenanceWindowRepository(action func(r *RedisMaintenanceWindowRepository)) {
+	client := redis.NewClient(&redis.Options{Addr: "localhost:6379", DB: 10})
+	defer client.FlushDB()
+	defer client.Close()
+	client.FlushDB()
+	action(NewRedisMaintenanceWindowRepository(client))
+}
@@ -0,0 +1,88 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/internal/common/util"
+)
+
+const (
+	runtimeExceededPrefix = "MaxRuntime:Exceeded:" // set for jobs reported as exceeding their maximum runtime, by job id
+	maxRuntimeLockKey     = "MaxRuntime:Lock"      // held while checking the runtime of jobs
+	// The lock expires in case its holder dies, so it must outlive checking the runtime of all leased jobs.
+	maxRuntimeLockTtl = 5 * time.Minute
+)
+
+// MaxRuntimeRepository stores which jobs were found to exceed their maximum runtime, such that each job is reported
+// and cancelled once, even though cancelling it takes effect asynchronously.
+type MaxRuntimeRepository interface {
+	// ProcessMaxRuntimes calls process. Only one caller processes maximum runtimes at a time;
+	// others return false immediately without calling process.
+	ProcessMaxRuntimes(process func()) (bool, error)
+	// MarkRuntimeExceeded marks the given jobs as exceeding their maximum runtime, and returns the ids of those
+	// that weren't marked before. Marks expire after ttl, by when the jobs are expected to have been cancelled.
+	MarkRuntimeExceeded(jobIds []string, ttl time.Duration) ([]string, error)
+	// UnmarkRuntimeExceeded removes the marks of the given jobs, e.g., because cancelling them failed.
+	UnmarkRuntimeExceeded(jobIds []string) error
+}
+
+type RedisMaxRuntimeRepository struct {
+	db redis.UniversalClient
+}
+
+func NewRedisMaxRuntimeRepository(db redis.UniversalClient) *RedisMaxRuntimeRepository {
+	return &RedisMaxRuntimeRepository{db: db}
+}
+
+func (r *RedisMaxRuntimeRepository) ProcessMaxRuntimes(process func()) (bool, error) {
+	token := util.NewULID()
+	acquired, err := r.db.SetNX(maxRuntimeLockKey, token, maxRuntimeLockTtl).Result()
+	if err != nil {
+		return false, errors.Wrap(err, "[RedisMaxRuntimeRepository.ProcessMaxRuntimes] error acquiring lock")
+	} else if !acquired {
+		return false, nil
+	}
+	// Failing to release the lock only delays checking maximum runtimes until it expires.
+	defer releaseLockScript.Run(r.db, []string{maxRuntimeLockKey}, token)
+
+	process()
+	return true, nil
+}
+
+func (r *RedisMaxRuntimeRepository) MarkRuntimeExceeded(jobIds []string, ttl time.Duration) ([]string, error) {
+	if len(jobIds) == 0 {
+		return nil, nil
+	}
+	pipe := r.db.Pipeline()
+	cmds := make([]*redis.BoolCmd, len(jobIds))
+	for i, jobId := range jobIds {
+		cmds[i] = pipe.SetNX(runtimeExceededPrefix+jobId, 1, ttl)
+	}
+	if _, err := pipe.Exec(); err != nil {
+		return nil, errors.Wrap(err, "[RedisMaxRuntimeRepository.MarkRuntimeExceeded] error storing marks")
+	}
+	var marked []string
+	for i, cmd := range cmds {
+		if cmd.Val() {
+			marked = append(marked, jobIds[i])
+		}
+	}
+	return marked, nil
+}
+
+func (r *RedisMaxRuntimeRepository) UnmarkRuntimeExceeded(jobIds []string) error {
+	if len(jobIds) == 0 {
+		return nil
+	}
+	keys := make([]string, len(jobIds))
+	for i, jobId := range jobIds {
+		keys[i] = runtimeExceededPrefix + jobId
+	}
+	if err := r.db.Del(keys...).Err(); err != nil {
+		return errors.Wrap(err, "[RedisMaxRuntimeRepository.UnmarkRuntimeExceeded] error deleting marks")
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMarkRuntimeExceeded_ReturnsNewlyMarkedJobs(t *testing.T) {
+	withMaxRuntimeRepository(func(r *RedisMaxRuntimeRepository) {
+		marked, err := r.MarkRuntimeExceeded([]string{"a", "b"}, time.Hour)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"a", "b"}, marked)
+
+		marked, err = r.MarkRuntimeExceeded([]string{"a", "c"}, time.Hour)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"c"}, marked)
+
+		require.NoError(t, r.UnmarkRuntimeExceeded([]string{"a"}))
+		marked, err = r.MarkRuntimeExceeded([]string{"a", "b"}, time.Hour)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"a"}, marked)
+	})
+}
+
+func TestProcessMaxRuntimes_OneCallerAtATime(t *testing.T) {
+	withMaxRuntimeRepository(func(r *RedisMaxRuntimeRepository) {
+		processed := false
+		acquired, err := r.ProcessMaxRuntimes(func() {
+			processed = true
+			concurrentlyAcquired, err := r.ProcessMaxRuntimes(func() {
+				t.Error("maximum runtimes processed concurrently")
+			})
+			require.NoError(t, err)
+			assert.False(t, concurrentlyAcquired)
+		})
+		require.NoError(t, err)
+		assert.True(t, acquired)
+		assert.True(t, processed)
+	})
+}
+
+func withMaxRuntimeRepository(action func(r *RedisMaxRuntimeRepository)) {
+	client := redis.NewClient(&redis.Options{Addr: "localhost:6379", DB: 10})
+	defer client.FlushDB()
+	defer client.Close()
+	client.FlushDB()
+	action(NewRedisMaxRuntimeRepository(client))
+}
@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/internal/common/armadaerrors"
+)
+
+// ObjectStore stores objects too large to be kept in Redis, e.g., in a bucket of an object storage service.
+type ObjectStore interface {
+	Put(key string, data []byte) error
+	// Get returns an *armadaerrors.ErrNotFound if there's no object with the given key.
+	Get(key string) ([]byte, error)
+	// Delete deletes the object with the given key. Deleting an object that doesn't exist is not an error.
+	Delete(key string) error
+}
+
+// FileObjectStore is an ObjectStore storing each object as a file in a directory,
+// e.g., a directory onto which an object storage bucket is mounted.
+type FileObjectStore struct {
+	directory string
+}
+
+func NewFileObjectStore(directory string) (*FileObjectStore, error) {
+	if err := os.MkdirAll(directory, 0o755); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &FileObjectStore{directory: directory}, nil
+}
+
+func (store *FileObjectStore) Put(key string, data []byte) error {
+	path, err := store.path(key)
+	if err != nil {
+		return err
+	}
+	// Write to a temporary file first, such that readers never observe partially written objects.
+	tmp, err := os.CreateTemp(store.directory, ".tmp-*")
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return errors.WithStack(err)
+	}
+	if err := tmp.Close(); err != nil {
+		return errors.WithStack(err)
+	}
+	return errors.WithStack(os.Rename(tmp.Name(), path))
+}
+
+func (store *FileObjectStore) Get(key string) ([]byte, error) {
+	path, err := store.path(key)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, &armadaerrors.ErrNotFound{Type: "object", Value: key}
+	}
+	return data, errors.WithStack(err)
+}
+
+func (store *FileObjectStore) Delete(key string) error {
+	path, err := store.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return errors.WithStack(err)
+	}
+	return nil
+}
+
+func (store *FileObjectStore) path(key string) (string, error) {
+	if key == "" || key != filepath.Base(key) || strings.HasPrefix(key, ".") {
+		return "", &armadaerrors.ErrInvalidArgument{Name: "key", Value: key, Message: "object keys must be valid file names"}
+	}
+	return filepath.Join(store.directory, key), nil
+}
@@ -0,0 +1,116 @@
+package repository
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+const (
+	operationPrefix = "Operation:"
+	// Done operations are kept for this long, such that their outcome can still be inspected.
+	doneOperationRetention = 7 * 24 * time.Hour
+)
+
+type ErrOperationNotFound struct {
+	Name string
+}
+
+func (err *ErrOperationNotFound) Error() string {
+	return fmt.Sprintf("could not find operation %q", err.Name)
+}
+
+// ErrOperationInProgress is returned when creating an operation while an operation with the same name is in progress.
+type ErrOperationInProgress struct {
+	Name string
+}
+
+func (err *ErrOperationInProgress) Error() string {
+	return fmt.Sprintf("operation %q is already in progress", err.Name)
+}
+
+// OperationRepository stores the state of long-running operations carried out in the background.
+type OperationRepository interface {
+	// CreateOperation stores op, unless an operation with the same name is in progress, in which case
+	// ErrOperationInProgress is returned. Operations that haven't been updated for longer than abandonedAfter
+	// are considered abandoned, e.g., because the server carrying them out was restarted, and are replaced.
+	CreateOperation(op *api.Operation, abandonedAfter time.Duration) error
+	// UpdateOperation replaces the stored operation. Done operations expire after a week.
+	UpdateOperation(op *api.Operation) error
+	GetOperation(name string) (*api.Operation, error)
+}
+
+type RedisOperationRepository struct {
+	db redis.UniversalClient
+}
+
+func NewRedisOperationRepository(db redis.UniversalClient) *RedisOperationRepository {
+	return &RedisOperationRepository{db: db}
+}
+
+func (r *RedisOperationRepository) CreateOperation(op *api.Operation, abandonedAfter time.Duration) error {
+	key := operationPrefix + op.Name
+	data, err := proto.Marshal(op)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	txf := func(tx *redis.Tx) error {
+		existing, err := r.getOperation(tx, op.Name)
+		var e *ErrOperationNotFound
+		if err != nil && !errors.As(err, &e) {
+			return err
+		}
+		if existing != nil && !existing.Done && op.UpdateTime.Sub(existing.UpdateTime) < abandonedAfter {
+			return &ErrOperationInProgress{Name: op.Name}
+		}
+		_, err = tx.TxPipelined(func(pipe redis.Pipeliner) error {
+			pipe.Set(key, data, 0)
+			return nil
+		})
+		return err
+	}
+	err = r.db.Watch(txf, key)
+	if err == redis.TxFailedErr {
+		// The operation was created concurrently.
+		return &ErrOperationInProgress{Name: op.Name}
+	}
+	return err
+}
+
+func (r *RedisOperationRepository) UpdateOperation(op *api.Operation) error {
+	data, err := proto.Marshal(op)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	var expiration time.Duration
+	if op.Done {
+		expiration = doneOperationRetention
+	}
+	if err := r.db.Set(operationPrefix+op.Name, data, expiration).Err(); err != nil {
+		return errors.Wrapf(err, "[RedisOperationRepository.UpdateOperation] error updating operation %s", op.Name)
+	}
+	return nil
+}
+
+func (r *RedisOperationRepository) GetOperation(name string) (*api.Operation, error) {
+	return r.getOperation(r.db, name)
+}
+
+func (r *RedisOperationRepository) getOperation(db redis.Cmdable, name string) (*api.Operation, error) {
+	data, err := db.Get(operationPrefix + name).Bytes()
+	if err == redis.Nil {
+		return nil, &ErrOperationNotFound{Name: name}
+	} else if err != nil {
+		return nil, errors.Wrapf(err, "[RedisOperationRepository.GetOperation] error reading operation %s", name)
+	}
+	op := &api.Operation{}
+	if err := proto.Unmarshal(data, op); err != nil {
+		return nil, errors.Wrapf(err, "[RedisOperationRepository.GetOperation] error unmarshalling operation %s", name)
+	}
+	return op, nil
+}
@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+func TestOperation_CreateUpdateAndGet(t *testing.T) {
+	withOperationRepository(func(r *RedisOperationRepository) {
+		_, err := r.GetOperation("op")
+		var notFound *ErrOperationNotFound
+		assert.ErrorAs(t, err, &notFound)
+
+		now := time.Now().UTC()
+		op := &api.Operation{Name: "op", Progress: "starting", CreateTime: now, UpdateTime: now}
+		require.NoError(t, r.CreateOperation(op, time.Minute))
+
+		op.Progress = "halfway there"
+		require.NoError(t, r.UpdateOperation(op))
+		stored, err := r.GetOperation("op")
+		require.NoError(t, err)
+		assert.Equal(t, op, stored)
+	})
+}
+
+func TestOperation_CreateWhileInProgress(t *testing.T) {
+	withOperationRepository(func(r *RedisOperationRepository) {
+		now := time.Now().UTC()
+		require.NoError(t, r.CreateOperation(&api.Operation{Name: "op", CreateTime: now, UpdateTime: now}, time.Minute))
+
+		var inProgress *ErrOperationInProgress
+		err := r.CreateOperation(&api.Operation{Name: "op", CreateTime: now, UpdateTime: now}, time.Minute)
+		assert.ErrorAs(t, err, &inProgress)
+
+		// Operations that haven't been updated in a while are abandoned and may be replaced.
+		later := now.Add(2 * time.Minute)
+		require.NoError(t, r.CreateOperation(&api.Operation{Name: "op", CreateTime: later, UpdateTime: later}, time.Minute))
+
+		// Done operations may be replaced.
+		require.NoError(t, r.UpdateOperation(&api.Operation{Name: "op", Done: true, CreateTime: later, UpdateTime: later}))
+		require.NoError(t, r.CreateOperation(&api.Operation{Name: "op", CreateTime: later, UpdateTime: later}, time.Minute))
+	})
+}
+
+func withOperationRepository(action func(r *RedisOperationRepository)) {
+	client := redis.NewClient(&redis.Options{Addr: "localhost:6379", DB: 10})
+	defer client.FlushDB()
+	defer client.Close()
+	client.FlushDB()
+	action(NewRedisOperationRepository(client))
+}
@@ -0,0 +1,66 @@
+package repository
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/go-redis/redis"
+	"github.com/pkg/errors"
+)
+
+const ownershipGroupsPrefix = "OwnershipGroups:"
+
+// OwnershipGroupsRepository stores the compressed ownership groups of jobs once for all jobs with identical groups,
+// rather than with each job, since owners may be members of hundreds of groups. Groups are stored under their hash,
+// which jobs reference, such that the groups of a job never change once it's stored.
+type OwnershipGroupsRepository interface {
+	// StoreOwnershipGroups stores compressedGroups, unless already stored, and returns the hash they're stored under.
+	StoreOwnershipGroups(compressedGroups []byte) (string, error)
+	// GetOwnershipGroups returns the compressed groups stored under hashes. Hashes without any groups stored are omitted.
+	GetOwnershipGroups(hashes []string) (map[string][]byte, error)
+}
+
+// OwnershipGroupsHash returns the hash compressedGroups are stored under.
+func OwnershipGroupsHash(compressedGroups []byte) string {
+	hash := sha256.Sum256(compressedGroups)
+	return hex.EncodeToString(hash[:])
+}
+
+// RedisOwnershipGroupsRepository is an OwnershipGroupsRepository storing groups in Redis.
+// Groups are never deleted, since they're few; there's one set of groups per owner and change of their groups.
+type RedisOwnershipGroupsRepository struct {
+	db redis.UniversalClient
+}
+
+func NewRedisOwnershipGroupsRepository(db redis.UniversalClient) *RedisOwnershipGroupsRepository {
+	return &RedisOwnershipGroupsRepository{db: db}
+}
+
+func (r *RedisOwnershipGroupsRepository) StoreOwnershipGroups(compressedGroups []byte) (string, error) {
+	hash := OwnershipGroupsHash(compressedGroups)
+	if err := r.db.SetNX(ownershipGroupsPrefix+hash, compressedGroups, 0).Err(); err != nil {
+		return "", errors.Wrapf(err, "[RedisOwnershipGroupsRepository.StoreOwnershipGroups] error storing groups with hash %s", hash)
+	}
+	return hash, nil
+}
+
+func (r *RedisOwnershipGroupsRepository) GetOwnershipGroups(hashes []string) (map[string][]byte, error) {
+	groups := make(map[string][]byte, len(hashes))
+	if len(hashes) == 0 {
+		return groups, nil
+	}
+	keys := make([]string, len(hashes))
+	for i, hash := range hashes {
+		keys[i] = ownershipGroupsPrefix + hash
+	}
+	values, err := r.db.MGet(keys...).Result()
+	if err != nil {
+		return nil, errors.Wrap(err, "[RedisOwnershipGroupsRepository.GetOwnershipGroups] error reading from database")
+	}
+	for i, value := range values {
+		if value, ok := value.(string); ok {
+			groups[hashes[i]] = []byte(value)
+		}
+	}
+	return groups, nil
+}
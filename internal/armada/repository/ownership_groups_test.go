@@ -0,0 +1,44 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/go-redis/redis"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOwnershipGroups(t *testing.T) {
+	withOwnershipGroupsRepository(func(r *RedisOwnershipGroupsRepository) {
+		hash1, err := r.StoreOwnershipGroups([]byte{1, 2})
+		require.NoError(t, err)
+		hash2, err := r.StoreOwnershipGroups([]byte{3})
+		require.NoError(t, err)
+		// Identical groups are stored once, under the same hash.
+		hash3, err := r.StoreOwnershipGroups([]byte{1, 2})
+		require.NoError(t, err)
+		assert.Equal(t, hash1, hash3)
+		assert.NotEqual(t, hash1, hash2)
+		assert.Equal(t, OwnershipGroupsHash([]byte{1, 2}), hash1)
+
+		groups, err := r.GetOwnershipGroups([]string{hash1, hash2, "missing"})
+		require.NoError(t, err)
+		assert.Equal(t, map[string][]byte{hash1: {1, 2}, hash2: {3}}, groups)
+	})
+}
+
+func TestOwnershipGroups_NoHashes(t *testing.T) {
+	withOwnershipGroupsRepository(func(r *RedisOwnershipGroupsRepository) {
+		groups, err := r.GetOwnershipGroups(nil)
+		require.NoError(t, err)
+		assert.Empty(t, groups)
+	})
+}
+
+func withOwnershipGroupsRepository(action func(r *RedisOwnershipGroupsRepository)) {
+	client := redis.NewClient(&redis.Options{Addr: "localhost:6379", DB: 10})
+	defer client.FlushDB()
+	defer client.Close()
+	client.FlushDB()
+	action(NewRedisOwnershipGroupsRepository(client))
+}
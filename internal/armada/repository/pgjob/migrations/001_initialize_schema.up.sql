@@ -0,0 +1,49 @@
+-- Jobs that are queued, leased, or suspended. Rows are deleted once jobs finish or are cancelled.
+CREATE TABLE jobs (
+    job_id text PRIMARY KEY,
+    queue text NOT NULL,
+    job_set_id text NOT NULL,
+    owner text NOT NULL,
+    priority double precision NOT NULL,
+    -- 0: queued, 1: leased, 2: suspended.
+    state smallint NOT NULL,
+    -- Serialized api.Job, as produced by repository.JobCodec.
+    job bytea NOT NULL,
+    labels jsonb NOT NULL,
+    -- Cluster the job is leased to and when the lease was last renewed, if leased.
+    cluster_id text,
+    leased_at timestamptz
+);
+
+-- Queued jobs are ordered by priority, and by id among jobs of equal priority.
+CREATE INDEX idx_jobs_queue_state_priority ON jobs (queue, state, priority, job_id);
+CREATE INDEX idx_jobs_queue_job_set_id ON jobs (queue, job_set_id);
+CREATE INDEX idx_jobs_queue_leased_at ON jobs (queue, leased_at) WHERE state = 1;
+
+-- Earliest start time of each job on each cluster it's been leased to.
+CREATE TABLE job_start_times (
+    job_id text NOT NULL,
+    cluster_id text NOT NULL,
+    start_time timestamptz NOT NULL,
+    PRIMARY KEY (job_id, cluster_id)
+);
+
+CREATE TABLE job_retries (
+    job_id text PRIMARY KEY,
+    retries integer NOT NULL
+);
+
+-- Ids of jobs submitted recently, such that submitting a job again is detected even if it has since finished.
+CREATE TABLE job_submissions (
+    job_id text PRIMARY KEY,
+    submitted timestamptz NOT NULL
+);
+
+CREATE INDEX idx_job_submissions_submitted ON job_submissions (submitted);
+
+CREATE TABLE pulsar_scheduler_job_details (
+    job_id text PRIMARY KEY,
+    -- Serialized schedulerobjects.PulsarSchedulerJobDetails.
+    details bytea NOT NULL,
+    expires timestamptz NOT NULL
+);
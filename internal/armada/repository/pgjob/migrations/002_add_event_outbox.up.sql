@@ -0,0 +1,4 @@
+CREATE TABLE event_outbox (
+    id bigserial PRIMARY KEY,
+    event bytea NOT NULL
+);
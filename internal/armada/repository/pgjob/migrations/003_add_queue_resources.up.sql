@@ -0,0 +1,39 @@
+-- Resources requested by each job, in milli-units by resource name, as returned by repository.JobQueueResources.
+-- Jobs stored before this column was added request no resources.
+ALTER TABLE jobs ADD COLUMN resources jsonb NOT NULL DEFAULT '{}';
+
+-- Resources requested by the queued and leased jobs of each queue, maintained by the triggers below, such that quotas
+-- can be checked without reading the jobs of the queue.
+CREATE TABLE queue_resources (
+    queue text NOT NULL,
+    resource text NOT NULL,
+    milli bigint NOT NULL,
+    PRIMARY KEY (queue, resource)
+);
+
+CREATE FUNCTION count_queue_resources() RETURNS trigger AS $$
+BEGIN
+    IF TG_OP IN ('UPDATE', 'DELETE') AND OLD.state IN (0, 1) THEN
+        INSERT INTO queue_resources (queue, resource, milli)
+        SELECT OLD.queue, key, -value::bigint FROM jsonb_each_text(OLD.resources)
+        ON CONFLICT (queue, resource) DO UPDATE SET milli = queue_resources.milli + excluded.milli;
+    END IF;
+    IF TG_OP IN ('INSERT', 'UPDATE') AND NEW.state IN (0, 1) THEN
+        INSERT INTO queue_resources (queue, resource, milli)
+        SELECT NEW.queue, key, value::bigint FROM jsonb_each_text(NEW.resources)
+        ON CONFLICT (queue, resource) DO UPDATE SET milli = queue_resources.milli + excluded.milli;
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER jobs_count_queue_resources_on_insert_or_delete
+    AFTER INSERT OR DELETE ON jobs
+    FOR EACH ROW EXECUTE FUNCTION count_queue_resources();
+
+-- Leasing jobs and returning their leases doesn't change the resources counted, so such updates are skipped.
+CREATE TRIGGER jobs_count_queue_resources_on_update
+    AFTER UPDATE OF queue, state, resources ON jobs
+    FOR EACH ROW
+    WHEN (OLD.queue <> NEW.queue OR (OLD.state IN (0, 1)) <> (NEW.state IN (0, 1)) OR OLD.resources <> NEW.resources)
+    EXECUTE FUNCTION count_queue_resources();
@@ -0,0 +1,13 @@
+-- Jobs stored before resources were recorded have NULL resources until PostgresJobRepository.BackfillQueueResources
+-- records them, rather than being taken as requesting no resources, such that they count towards their queues.
+ALTER TABLE jobs ALTER COLUMN resources DROP DEFAULT;
+ALTER TABLE jobs ALTER COLUMN resources DROP NOT NULL;
+UPDATE jobs SET resources = NULL WHERE resources = '{}';
+
+-- Recording the resources of such jobs changes them from NULL, which <> doesn't detect.
+DROP TRIGGER jobs_count_queue_resources_on_update ON jobs;
+CREATE TRIGGER jobs_count_queue_resources_on_update
+    AFTER UPDATE OF queue, state, resources ON jobs
+    FOR EACH ROW
+    WHEN (OLD.queue <> NEW.queue OR (OLD.state IN (0, 1)) <> (NEW.state IN (0, 1)) OR OLD.resources IS DISTINCT FROM NEW.resources)
+    EXECUTE FUNCTION count_queue_resources();
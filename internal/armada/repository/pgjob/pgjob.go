@@ -0,0 +1,943 @@
+package pgjob
+
+import (
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/armadaproject/armada/internal/armada/repository"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/armadaerrors"
+	"github.com/armadaproject/armada/internal/common/database"
+	armadaresource "github.com/armadaproject/armada/internal/common/resource"
+	"github.com/armadaproject/armada/internal/common/util"
+	"github.com/armadaproject/armada/internal/scheduler/schedulerobjects"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+// Values of the state column of the jobs table.
+const (
+	stateQueued    = 0
+	stateLeased    = 1
+	stateSuspended = 2
+)
+
+const (
+	// Submitting a job with the same id as one submitted within this period is reported as already processed.
+	submissionRetention = 7 * 24 * time.Hour
+	// How long pulsar scheduler job details are retained for after being stored, and after being expired.
+	pulsarSchedulerJobDetailsRetention        = 375 * 24 * time.Hour
+	expiredPulsarSchedulerJobDetailsRetention = time.Hour
+	// Number of jobs updated per transaction by UpdateJobs.
+	updateJobsBatchSize = 250
+	// Number of jobs the resources of which are recorded per transaction by BackfillQueueResources.
+	backfillQueueResourcesBatchSize = 1000
+)
+
+// PostgresJobRepository is a repository.JobRepository backed by postgres, with the same semantics as the Redis one.
+// Jobs are stored as serialized api.Job messages alongside the columns needed to query them,
+// and pod specs are optionally stored outside the database, as configured by the codec.
+type PostgresJobRepository struct {
+	db    *pgxpool.Pool
+	codec repository.JobCodec
+}
+
+func NewPostgresJobRepository(db *pgxpool.Pool, codec repository.JobCodec) *PostgresJobRepository {
+	return &PostgresJobRepository{db: db, codec: codec}
+}
+
+func (r *PostgresJobRepository) AddJobs(jobs []*api.Job) ([]*repository.SubmitJobResult, error) {
+	return r.AddJobsWithEvents(jobs, nil)
+}
+
+func (r *PostgresJobRepository) AddJobsWithEvents(jobs []*api.Job, events [][]*api.EventMessage) ([]*repository.SubmitJobResult, error) {
+	ctx := armadacontext.Background()
+	now := time.Now()
+	batch := &pgx.Batch{}
+	batch.Queue("DELETE FROM job_submissions WHERE submitted < $1", now.Add(-submissionRetention))
+	for _, job := range jobs {
+		jobData, err := r.codec.Marshal(job)
+		if err != nil {
+			return nil, err
+		}
+		// The job is only stored if it hasn't been submitted recently.
+		batch.Queue(`
+			WITH submission AS (
+				INSERT INTO job_submissions (job_id, submitted) VALUES ($1, $2) ON CONFLICT (job_id) DO NOTHING RETURNING job_id
+			)
+			INSERT INTO jobs (job_id, queue, job_set_id, owner, priority, state, job, labels, resources)
+			SELECT job_id, $3::text, $4::text, $5::text, $6::double precision, $7::smallint, $8::bytea, $9::jsonb, $10::jsonb
+			FROM submission
+			ON CONFLICT (job_id) DO UPDATE SET
+				queue = excluded.queue, job_set_id = excluded.job_set_id, owner = excluded.owner, priority = excluded.priority,
+				state = excluded.state, job = excluded.job, labels = excluded.labels, resources = excluded.resources,
+				cluster_id = NULL, leased_at = NULL`,
+			job.Id, now, job.Queue, job.JobSetId, job.Owner, job.Priority, stateQueued, jobData, labels(job.Labels),
+			repository.JobQueueResources(job),
+		)
+	}
+
+	var result []*repository.SubmitJobResult
+	err := pgx.BeginTxFunc(ctx, r.db, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		result = make([]*repository.SubmitJobResult, 0, len(jobs))
+		var storedEvents []*api.EventMessage
+		results := tx.SendBatch(ctx, batch)
+		defer results.Close()
+		if _, err := results.Exec(); err != nil {
+			return errors.WithStack(err)
+		}
+		for i, job := range jobs {
+			tag, err := results.Exec()
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			submitJobResult := &repository.SubmitJobResult{JobId: job.Id, SubmittedJob: job}
+			if tag.RowsAffected() == 0 {
+				// Reported as such by the Redis repository too.
+				submitJobResult.JobId = "-1"
+				submitJobResult.AlreadyProcessed = true
+			} else if events != nil {
+				storedEvents = append(storedEvents, events[i]...)
+			}
+			result = append(result, submitJobResult)
+		}
+		if err := results.Close(); err != nil {
+			return errors.WithStack(err)
+		}
+		return addOutboxEvents(ctx, tx, storedEvents)
+	})
+	if err != nil {
+		return nil, errors.WithMessage(err, "[PostgresJobRepository.AddJobs] error writing to database")
+	}
+	return result, nil
+}
+
+// GetJobsByIds attempts to get all requested jobs from the database.
+// Any error in getting a job is set to the Err field of the corresponding JobResult.
+func (r *PostgresJobRepository) GetJobsByIds(ids []string) ([]*repository.JobResult, error) {
+	return r.getJobsByIds(armadacontext.Background(), r.db, ids, false)
+}
+
+// getJobsByIds reads the jobs with the given ids as GetJobsByIds does, locking them if lock is set.
+func (r *PostgresJobRepository) getJobsByIds(ctx *armadacontext.Context, db database.Querier, ids []string, lock bool) ([]*repository.JobResult, error) {
+	sql := "SELECT job_id, job FROM jobs WHERE job_id = any($1)"
+	if lock {
+		// Locking in a consistent order avoids deadlocks between concurrent writers.
+		sql += " ORDER BY job_id FOR UPDATE"
+	}
+	rows, err := db.Query(ctx, sql, ids)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	jobDataById := make(map[string][]byte, len(ids))
+	var jobId string
+	var jobData []byte
+	_, err = pgx.ForEachRow(rows, []any{&jobId, &jobData}, func() error {
+		jobDataById[jobId] = jobData
+		return nil
+	})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	results := make([]*repository.JobResult, 0, len(ids))
+	for _, id := range ids {
+		result := &repository.JobResult{JobId: id}
+		results = append(results, result)
+		jobData, ok := jobDataById[id]
+		if !ok {
+			result.Error = &armadaerrors.ErrNotFound{
+				Type:  "job",
+				Value: id,
+			}
+			continue
+		}
+		if err := r.codec.Unmarshal(jobData, result); err != nil {
+			return nil, err
+		}
+	}
+	return results, nil
+}
+
+// GetExistingJobsByIds returns the jobs with the given ids. Missing jobs are omitted, i.e.,
+// the returned list may be shorter than the provided list of IDs.
+func (r *PostgresJobRepository) GetExistingJobsByIds(ids []string) ([]*api.Job, error) {
+	jobResults, err := r.GetJobsByIds(ids)
+	if err != nil {
+		return nil, err
+	}
+	return repository.ExistingJobs(jobResults)
+}
+
+// PeekQueue returns the highest-priority jobs in the given queue.
+// At most limits jobs are returned.
+func (r *PostgresJobRepository) PeekQueue(queue string, limit int64) ([]*api.Job, error) {
+	ids, err := r.queryIds(
+		"SELECT job_id FROM jobs WHERE queue = $1 AND state = $2 ORDER BY priority, job_id LIMIT $3",
+		queue, stateQueued, limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return r.GetExistingJobsByIds(ids)
+}
+
+// TryLeaseJobs attempts to assign jobs to a given cluster and returns a list composed of the jobs
+// that were successfully leased.
+func (r *PostgresJobRepository) TryLeaseJobs(clusterId string, jobIdsByQueue map[string][]string) (map[string][]string, error) {
+	ctx := armadacontext.Background()
+	now := time.Now()
+	leasedJobIdsByQueue := make(map[string][]string, len(jobIdsByQueue))
+	for queue, jobIds := range jobIdsByQueue {
+		leasedJobIds, err := r.leaseJobs(ctx, clusterId, jobIds, now, &queue)
+		if err != nil {
+			return nil, err
+		}
+		if len(leasedJobIds) > 0 {
+			leasedJobIdsByQueue[queue] = leasedJobIds
+		}
+	}
+	return leasedJobIdsByQueue, nil
+}
+
+func (r *PostgresJobRepository) RenewLease(clusterId string, jobIds []string) ([]string, error) {
+	return r.leaseJobs(armadacontext.Background(), clusterId, jobIds, time.Now(), nil)
+}
+
+// leaseJobs leases the given jobs to the given cluster, if they're queued or already leased to that cluster,
+// and returns the ids of the jobs leased, in the order given. If queue is non-nil, only jobs of that queue are leased.
+func (r *PostgresJobRepository) leaseJobs(ctx *armadacontext.Context, clusterId string, jobIds []string, now time.Time, queue *string) ([]string, error) {
+	rows, err := r.db.Query(
+		ctx, `
+		UPDATE jobs SET state = $1, cluster_id = $2, leased_at = $3
+		WHERE job_id = any($4) AND ($5::text IS NULL OR queue = $5)
+		AND (state = $6 OR (state = $1 AND cluster_id = $2))
+		RETURNING job_id`,
+		stateLeased, clusterId, now, jobIds, queue, stateQueued,
+	)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	leased, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	isLeased := util.StringListToSet(leased)
+	leasedJobIds := make([]string, 0, len(leased))
+	for _, jobId := range jobIds {
+		if isLeased[jobId] {
+			leasedJobIds = append(leasedJobIds, jobId)
+		} else {
+			log.WithField("jobId", jobId).Infof("job not leased, since it's neither queued nor leased to cluster %s", clusterId)
+		}
+	}
+	return leasedJobIds, nil
+}
+
+// ExpireLeases expires the leases on all jobs for the provided queue.
+func (r *PostgresJobRepository) ExpireLeases(queue string, deadline time.Time) ([]*api.Job, error) {
+	ids, err := r.queryIds(
+		"SELECT job_id FROM jobs WHERE queue = $1 AND state = $2 AND leased_at < $3",
+		queue, stateLeased, deadline,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return r.ExpireLeasesById(ids, deadline)
+}
+
+func (r *PostgresJobRepository) ExpireLeasesById(jobIds []string, deadline time.Time) ([]*api.Job, error) {
+	if len(jobIds) == 0 {
+		return make([]*api.Job, 0), nil
+	}
+	return r.returnJobs(
+		"WHERE job_id = any($3) AND state = $2 AND leased_at < $4",
+		jobIds, deadline,
+	)
+}
+
+func (r *PostgresJobRepository) ReturnLease(clusterId string, jobId string) (*api.Job, error) {
+	jobs, err := r.returnJobs(
+		"WHERE job_id = $3 AND state = $2 AND cluster_id = $4",
+		jobId, clusterId,
+	)
+	if err != nil {
+		return nil, errors.WithMessagef(err, "error returning lease for job %s and cluster %s", jobId, clusterId)
+	}
+	if len(jobs) == 0 {
+		return nil, nil
+	}
+	return jobs[0], nil
+}
+
+// returnJobs returns the leased jobs matching where to their queue, and returns those jobs.
+// where may refer to the leased state as $2; its own arguments are numbered from $3.
+func (r *PostgresJobRepository) returnJobs(where string, args ...any) ([]*api.Job, error) {
+	ctx := armadacontext.Background()
+	rows, err := r.db.Query(
+		ctx,
+		"UPDATE jobs SET state = $1, cluster_id = NULL, leased_at = NULL "+where+" RETURNING job_id, job",
+		append([]any{stateQueued, stateLeased}, args...)...,
+	)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	jobs := make([]*api.Job, 0)
+	var jobId string
+	var jobData []byte
+	_, err = pgx.ForEachRow(rows, []any{&jobId, &jobData}, func() error {
+		result := &repository.JobResult{JobId: jobId}
+		if err := r.codec.Unmarshal(jobData, result); err != nil {
+			return err
+		}
+		if result.Error != nil {
+			log.WithError(result.Error).Errorf("error reading returned job %s", jobId)
+			return nil
+		}
+		jobs = append(jobs, result.Job)
+		return nil
+	})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return jobs, nil
+}
+
+func (r *PostgresJobRepository) DeleteJobs(jobs []*api.Job) (map[*api.Job]error, error) {
+	ctx := armadacontext.Background()
+	ids := make([]string, len(jobs))
+	for i, job := range jobs {
+		ids[i] = job.Id
+	}
+	var deletedIds []string
+	err := pgx.BeginTxFunc(ctx, r.db, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		// As with Redis, a job counts as deleted if anything stored about it was deleted.
+		rows, err := tx.Query(ctx, `
+			WITH deleted_jobs AS (DELETE FROM jobs WHERE job_id = any($1) RETURNING job_id),
+			deleted_start_times AS (DELETE FROM job_start_times WHERE job_id = any($1) RETURNING job_id),
+			deleted_retries AS (DELETE FROM job_retries WHERE job_id = any($1) RETURNING job_id)
+			SELECT job_id FROM deleted_jobs
+			UNION SELECT job_id FROM deleted_start_times
+			UNION SELECT job_id FROM deleted_retries`,
+			ids,
+		)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		deletedIds, err = pgx.CollectRows(rows, pgx.RowTo[string])
+		return errors.WithStack(err)
+	})
+	if err != nil {
+		return nil, errors.WithMessage(err, "[PostgresJobRepository.DeleteJobs] error writing to database")
+	}
+
+	isDeleted := util.StringListToSet(deletedIds)
+	deletedJobs := map[*api.Job]error{}
+	for _, job := range jobs {
+		if isDeleted[job.Id] {
+			deletedJobs[job] = nil
+			r.codec.DeleteExternalPodSpecs(job)
+		}
+	}
+	return deletedJobs, nil
+}
+
+func (r *PostgresJobRepository) SuspendJobs(queue string, jobIds []string) ([]string, error) {
+	return r.moveQueuedJobs(queue, jobIds, stateQueued, stateSuspended)
+}
+
+func (r *PostgresJobRepository) ResumeJobs(queue string, jobIds []string) ([]string, error) {
+	return r.moveQueuedJobs(queue, jobIds, stateSuspended, stateQueued)
+}
+
+// moveQueuedJobs moves jobs of the given queue from one state to another, preserving their priority.
+// Returns the ids of the jobs that were moved, in the order given.
+func (r *PostgresJobRepository) moveQueuedJobs(queue string, jobIds []string, from int, to int) ([]string, error) {
+	movedIds, err := r.queryIds(
+		"UPDATE jobs SET state = $1 WHERE queue = $2 AND job_id = any($3) AND state = $4 RETURNING job_id",
+		to, queue, jobIds, from,
+	)
+	if err != nil {
+		return nil, err
+	}
+	isMoved := util.StringListToSet(movedIds)
+	var movedJobIds []string
+	for _, jobId := range jobIds {
+		if isMoved[jobId] {
+			movedJobIds = append(movedJobIds, jobId)
+		}
+	}
+	return movedJobIds, nil
+}
+
+func (r *PostgresJobRepository) FilterActiveQueues(queues []*api.Queue) ([]*api.Queue, error) {
+	names := make([]string, len(queues))
+	for i, queue := range queues {
+		names[i] = queue.Name
+	}
+	activeNames, err := r.queryIds(
+		"SELECT DISTINCT queue FROM jobs WHERE queue = any($1) AND state = $2",
+		names, stateQueued,
+	)
+	if err != nil {
+		return nil, err
+	}
+	isActive := util.StringListToSet(activeNames)
+	var active []*api.Queue
+	for _, queue := range queues {
+		if isActive[queue.Name] {
+			active = append(active, queue)
+		}
+	}
+	return active, nil
+}
+
+func (r *PostgresJobRepository) GetQueueSizes(queues []*api.Queue) ([]int64, error) {
+	ctx := armadacontext.Background()
+	names := make([]string, len(queues))
+	for i, queue := range queues {
+		names[i] = queue.Name
+	}
+	rows, err := r.db.Query(
+		ctx,
+		"SELECT queue, count(*) FROM jobs WHERE queue = any($1) AND state = $2 GROUP BY queue",
+		names, stateQueued,
+	)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	sizeByQueue := make(map[string]int64, len(queues))
+	var queue string
+	var size int64
+	if _, err := pgx.ForEachRow(rows, []any{&queue, &size}, func() error {
+		sizeByQueue[queue] = size
+		return nil
+	}); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	sizes := make([]int64, len(queues))
+	for i, name := range names {
+		sizes[i] = sizeByQueue[name]
+	}
+	return sizes, nil
+}
+
+func (r *PostgresJobRepository) GetQueueJobIds(queueName string) ([]string, error) {
+	return r.queryIds(
+		"SELECT job_id FROM jobs WHERE queue = $1 AND state = $2 ORDER BY priority, job_id",
+		queueName, stateQueued,
+	)
+}
+
+func (r *PostgresJobRepository) GetQueueJobIdsPage(queueName string, offset int64, limit int64) ([]string, error) {
+	if limit <= 0 {
+		return nil, nil
+	}
+	return r.queryIds(
+		"SELECT job_id FROM jobs WHERE queue = $1 AND state = $2 ORDER BY priority, job_id OFFSET $3 LIMIT $4",
+		queueName, stateQueued, offset, limit,
+	)
+}
+
+func (r *PostgresJobRepository) GetQueueJobIdsByOwner(queueName string) (map[string][]string, error) {
+	ctx := armadacontext.Background()
+	rows, err := r.db.Query(
+		ctx,
+		"SELECT job_id, owner FROM jobs WHERE queue = $1 AND state = $2 ORDER BY priority, job_id",
+		queueName, stateQueued,
+	)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	jobIdsByOwner := make(map[string][]string)
+	var jobId, owner string
+	if _, err := pgx.ForEachRow(rows, []any{&jobId, &owner}, func() error {
+		jobIdsByOwner[owner] = append(jobIdsByOwner[owner], jobId)
+		return nil
+	}); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return jobIdsByOwner, nil
+}
+
+// GetQueueResources returns the resources counted for the queue by the triggers of the jobs table.
+func (r *PostgresJobRepository) GetQueueResources(queueName string) (armadaresource.ComputeResources, error) {
+	ctx := armadacontext.Background()
+	rows, err := r.db.Query(ctx, "SELECT resource, milli FROM queue_resources WHERE queue = $1", queueName)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	milliValues := make(map[string]int64)
+	var name string
+	var milliValue int64
+	if _, err := pgx.ForEachRow(rows, []any{&name, &milliValue}, func() error {
+		milliValues[name] = milliValue
+		return nil
+	}); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return repository.QueueResourcesFromMilliValues(milliValues), nil
+}
+
+// BackfillQueueResources records the resources of the jobs of the given queues stored before resources were recorded,
+// such that the triggers of the jobs table count them towards the resources of their queues, and returns the number
+// of jobs backfilled. It may run concurrently with itself and with other operations on the jobs.
+func (r *PostgresJobRepository) BackfillQueueResources(queues []string) (int, error) {
+	ctx := armadacontext.Background()
+	backfilled := 0
+	// Jobs are visited in order of id, such that jobs that can't be decoded are only visited once.
+	lastJobId := ""
+	for {
+		rows, err := r.db.Query(
+			ctx,
+			"SELECT job_id, job FROM jobs WHERE resources IS NULL AND queue = ANY($1) AND job_id > $2 ORDER BY job_id LIMIT $3",
+			queues, lastJobId, backfillQueueResourcesBatchSize,
+		)
+		if err != nil {
+			return backfilled, errors.WithStack(err)
+		}
+		batch := &pgx.Batch{}
+		var jobId string
+		var jobData []byte
+		if _, err := pgx.ForEachRow(rows, []any{&jobId, &jobData}, func() error {
+			lastJobId = jobId
+			result := &repository.JobResult{JobId: jobId}
+			if err := r.codec.Unmarshal(jobData, result); err != nil {
+				return err
+			}
+			if result.Error != nil {
+				log.WithError(result.Error).Warnf("skipped recording the resources of job %s", jobId)
+				return nil
+			}
+			batch.Queue("UPDATE jobs SET resources = $2 WHERE job_id = $1 AND resources IS NULL", jobId, repository.JobQueueResources(result.Job))
+			return nil
+		}); err != nil {
+			return backfilled, errors.WithStack(err)
+		}
+		if batch.Len() == 0 {
+			if lastJobId == "" || rows.CommandTag().RowsAffected() < backfillQueueResourcesBatchSize {
+				return backfilled, nil
+			}
+			continue
+		}
+		results := r.db.SendBatch(ctx, batch)
+		for i := 0; i < batch.Len(); i++ {
+			tag, err := results.Exec()
+			if err != nil {
+				results.Close()
+				return backfilled, errors.WithStack(err)
+			}
+			backfilled += int(tag.RowsAffected())
+		}
+		if err := results.Close(); err != nil {
+			return backfilled, errors.WithStack(err)
+		}
+		if rows.CommandTag().RowsAffected() < backfillQueueResourcesBatchSize {
+			return backfilled, nil
+		}
+	}
+}
+
+func (r *PostgresJobRepository) GetActiveJobIds(queue string, jobSetId string) ([]string, error) {
+	return r.GetJobSetJobIds(queue, jobSetId, &repository.JobSetFilter{
+		IncludeLeased:    true,
+		IncludeQueued:    true,
+		IncludeSuspended: true,
+	})
+}
+
+func (r *PostgresJobRepository) GetJobSetJobIds(queue string, jobSetId string, filter *repository.JobSetFilter) ([]string, error) {
+	states := []int{stateQueued, stateLeased, stateSuspended}
+	var jobLabels map[string]string
+	if filter != nil {
+		states = states[:0]
+		if filter.IncludeQueued {
+			states = append(states, stateQueued)
+		}
+		if filter.IncludeLeased {
+			states = append(states, stateLeased)
+		}
+		if filter.IncludeSuspended {
+			states = append(states, stateSuspended)
+		}
+		jobLabels = filter.Labels
+	}
+	ids, err := r.queryIds(
+		"SELECT job_id FROM jobs WHERE queue = $1 AND job_set_id = $2 AND state = any($3) AND labels @> $4",
+		queue, jobSetId, states, labels(jobLabels),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if ids == nil {
+		ids = []string{}
+	}
+	return ids, nil
+}
+
+func (r *PostgresJobRepository) GetLeasedJobIds(queue string) ([]string, error) {
+	return r.queryIds(
+		"SELECT job_id FROM jobs WHERE queue = $1 AND state = $2 ORDER BY leased_at, job_id",
+		queue, stateLeased,
+	)
+}
+
+func (r *PostgresJobRepository) UpdateStartTime(jobStartInfos []*repository.JobStartInfo) ([]error, error) {
+	ctx := armadacontext.Background()
+	batch := &pgx.Batch{}
+	for _, jobStartInfo := range jobStartInfos {
+		// The earliest start time reported is retained.
+		batch.Queue(`
+			INSERT INTO job_start_times (job_id, cluster_id, start_time)
+			SELECT job_id, $2::text, $3::timestamptz FROM jobs WHERE job_id = $1
+			ON CONFLICT (job_id, cluster_id) DO UPDATE SET start_time = least(job_start_times.start_time, excluded.start_time)`,
+			jobStartInfo.JobId, jobStartInfo.ClusterId, jobStartInfo.StartTime.UTC(),
+		)
+	}
+	results := r.db.SendBatch(ctx, batch)
+	defer results.Close()
+	jobErrors := make([]error, len(jobStartInfos))
+	for i, jobStartInfo := range jobStartInfos {
+		tag, err := results.Exec()
+		if err != nil {
+			jobErrors[i] = errors.Wrapf(err, "error updating start time for job with id %s", jobStartInfo.JobId)
+		} else if tag.RowsAffected() == 0 {
+			jobErrors[i] = &repository.ErrJobNotFound{JobId: jobStartInfo.JobId, ClusterId: jobStartInfo.ClusterId}
+		}
+	}
+	if err := results.Close(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return jobErrors, nil
+}
+
+// UpdateJobs applies mutator to the jobs with the given ids and writes them back, in batches.
+// The jobs of each batch are locked while mutator is applied, so it's called once per batch. Missing jobs are ignored.
+func (r *PostgresJobRepository) UpdateJobs(ids []string, mutator func([]*api.Job)) ([]repository.UpdateJobResult, error) {
+	result := make([]repository.UpdateJobResult, 0, len(ids))
+	for _, batch := range util.Batch(ids, updateJobsBatchSize) {
+		jobs, err := r.updateJobBatch(batch, mutator)
+		if err != nil {
+			for _, id := range batch {
+				result = append(result, repository.UpdateJobResult{JobId: id, Job: nil, Error: err})
+			}
+			continue
+		}
+		for _, job := range jobs {
+			result = append(result, repository.UpdateJobResult{JobId: job.Id, Job: job, Error: nil})
+		}
+	}
+	return result, nil
+}
+
+func (r *PostgresJobRepository) updateJobBatch(ids []string, mutator func([]*api.Job)) ([]*api.Job, error) {
+	ctx := armadacontext.Background()
+	var jobs []*api.Job
+	err := pgx.BeginTxFunc(ctx, r.db, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		jobResults, err := r.getJobsByIds(ctx, tx, ids, true)
+		if err != nil {
+			return err
+		}
+		if jobs, err = repository.ExistingJobs(jobResults); err != nil {
+			return err
+		}
+
+		mutator(jobs)
+
+		batch := &pgx.Batch{}
+		for _, job := range jobs {
+			jobData, err := r.codec.Marshal(job)
+			if err != nil {
+				return errors.WithMessagef(err, "job id %s", job.Id)
+			}
+			batch.Queue(
+				"UPDATE jobs SET priority = $2, job = $3, resources = $4 WHERE job_id = $1",
+				job.Id, job.Priority, jobData, repository.JobQueueResources(job),
+			)
+		}
+		return errors.WithStack(tx.SendBatch(ctx, batch).Close())
+	})
+	if err != nil {
+		return nil, errors.WithMessagef(err, "[PostgresJobRepository.UpdateJobs] error updating jobs")
+	}
+	return jobs, nil
+}
+
+// GetJobRunInfos returns run info for the cluster that each of the provided jobs is leased to.
+// Jobs not leased to any cluster or that does not have a start time are omitted.
+func (r *PostgresJobRepository) GetJobRunInfos(jobIds []string) (map[string]*repository.RunInfo, error) {
+	ctx := armadacontext.Background()
+	rows, err := r.db.Query(ctx, `
+		SELECT j.job_id, j.cluster_id, s.start_time FROM jobs j
+		JOIN job_start_times s ON s.job_id = j.job_id AND s.cluster_id = j.cluster_id
+		WHERE j.job_id = any($1)`,
+		jobIds,
+	)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	runInfos := make(map[string]*repository.RunInfo, len(jobIds))
+	var jobId, clusterId string
+	var startTime time.Time
+	if _, err := pgx.ForEachRow(rows, []any{&jobId, &clusterId, &startTime}, func() error {
+		runInfos[jobId] = &repository.RunInfo{StartTime: startTime, CurrentClusterId: clusterId}
+		return nil
+	}); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return runInfos, nil
+}
+
+func (r *PostgresJobRepository) GetLeasedJobClusterIds(jobIds []string) (map[string]string, error) {
+	ctx := armadacontext.Background()
+	rows, err := r.db.Query(ctx, "SELECT job_id, cluster_id FROM jobs WHERE job_id = any($1) AND state = $2", jobIds, stateLeased)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	clusterIds := make(map[string]string, len(jobIds))
+	var jobId, clusterId string
+	if _, err := pgx.ForEachRow(rows, []any{&jobId, &clusterId}, func() error {
+		clusterIds[jobId] = clusterId
+		return nil
+	}); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return clusterIds, nil
+}
+
+func (r *PostgresJobRepository) GetJobStates(queue string, jobIds []string) (map[string]repository.JobState, error) {
+	ctx := armadacontext.Background()
+	rows, err := r.db.Query(ctx, "SELECT job_id, state FROM jobs WHERE queue = $1 AND job_id = any($2)", queue, jobIds)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	states := make(map[string]repository.JobState, len(jobIds))
+	var jobId string
+	var state int
+	if _, err := pgx.ForEachRow(rows, []any{&jobId, &state}, func() error {
+		switch state {
+		case stateQueued:
+			states[jobId] = repository.JobStateQueued
+		case stateLeased:
+			states[jobId] = repository.JobStateLeased
+		case stateSuspended:
+			states[jobId] = repository.JobStateSuspended
+		}
+		return nil
+	}); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return states, nil
+}
+
+// GetQueueActiveJobSets returns a list of length equal to the number of unique job sets
+// in the given queue, where each element contains the number of queued, suspended, pending, and running jobs
+// that are part of that job set and the total resources requested by its leased jobs.
+func (r *PostgresJobRepository) GetQueueActiveJobSets(queue string) ([]*api.JobSetInfo, error) {
+	queuedIds, err := r.GetQueueJobIds(queue)
+	if err != nil {
+		return nil, err
+	}
+	suspendedIds, err := r.queryIds("SELECT job_id FROM jobs WHERE queue = $1 AND state = $2", queue, stateSuspended)
+	if err != nil {
+		return nil, err
+	}
+	leasedIds, err := r.GetLeasedJobIds(queue)
+	if err != nil {
+		return nil, err
+	}
+	queuedJobs, err := r.GetExistingJobsByIds(queuedIds)
+	if err != nil {
+		return nil, err
+	}
+	suspendedJobs, err := r.GetExistingJobsByIds(suspendedIds)
+	if err != nil {
+		return nil, err
+	}
+	leasedJobs, err := r.GetExistingJobsByIds(leasedIds)
+	if err != nil {
+		return nil, err
+	}
+	runInfos, err := r.GetJobRunInfos(leasedIds)
+	if err != nil {
+		return nil, err
+	}
+	return repository.JobSetInfos(queuedJobs, suspendedJobs, leasedJobs, runInfos), nil
+}
+
+func (r *PostgresJobRepository) AddRetryAttempt(jobId string) error {
+	_, err := r.db.Exec(
+		armadacontext.Background(),
+		"INSERT INTO job_retries (job_id, retries) VALUES ($1, 1) ON CONFLICT (job_id) DO UPDATE SET retries = job_retries.retries + 1",
+		jobId,
+	)
+	return errors.WithStack(err)
+}
+
+func (r *PostgresJobRepository) GetNumberOfRetryAttempts(jobId string) (int, error) {
+	var retries int
+	err := r.db.QueryRow(armadacontext.Background(), "SELECT retries FROM job_retries WHERE job_id = $1", jobId).Scan(&retries)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, nil
+	} else if err != nil {
+		return 0, errors.WithStack(err)
+	}
+	return retries, nil
+}
+
+func (r *PostgresJobRepository) StorePulsarSchedulerJobDetails(jobDetails []*schedulerobjects.PulsarSchedulerJobDetails) error {
+	expires := time.Now().Add(pulsarSchedulerJobDetailsRetention)
+	batch := &pgx.Batch{}
+	for _, job := range jobDetails {
+		jobData, err := proto.Marshal(job)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		batch.Queue(
+			"INSERT INTO pulsar_scheduler_job_details (job_id, details, expires) VALUES ($1, $2, $3) "+
+				"ON CONFLICT (job_id) DO UPDATE SET details = excluded.details, expires = excluded.expires",
+			job.JobId, jobData, expires,
+		)
+	}
+	if err := r.db.SendBatch(armadacontext.Background(), batch).Close(); err != nil {
+		return errors.Wrapf(err, "error storing pulsar job details in postgres")
+	}
+	return nil
+}
+
+func (r *PostgresJobRepository) GetPulsarSchedulerJobDetails(jobId string) (*schedulerobjects.PulsarSchedulerJobDetails, error) {
+	var jobData []byte
+	err := r.db.QueryRow(
+		armadacontext.Background(),
+		"SELECT details FROM pulsar_scheduler_job_details WHERE job_id = $1 AND expires > $2",
+		jobId, time.Now(),
+	).Scan(&jobData)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	} else if err != nil {
+		return nil, errors.Wrapf(err, "error retrieving job details for %s in postgres", jobId)
+	}
+	details := &schedulerobjects.PulsarSchedulerJobDetails{}
+	if err := proto.Unmarshal(jobData, details); err != nil {
+		return nil, errors.Wrapf(err, "error unmarshalling job details for %s in postgres", jobId)
+	}
+	return details, nil
+}
+
+func (r *PostgresJobRepository) ExpirePulsarSchedulerJobDetails(jobIds []string) error {
+	if len(jobIds) == 0 {
+		return nil
+	}
+	now := time.Now()
+	batch := &pgx.Batch{}
+	// Expire as opposed to delete so that we are permissive of race conditions.
+	batch.Queue(
+		"UPDATE pulsar_scheduler_job_details SET expires = least(expires, $2) WHERE job_id = any($1)",
+		jobIds, now.Add(expiredPulsarSchedulerJobDetailsRetention),
+	)
+	batch.Queue("DELETE FROM pulsar_scheduler_job_details WHERE expires <= $1", now)
+	if err := r.db.SendBatch(armadacontext.Background(), batch).Close(); err != nil {
+		return errors.Wrap(err, "failed to expire pulsar job details in postgres")
+	}
+	return nil
+}
+
+// queryIds returns the single text column of the rows returned by sql.
+func (r *PostgresJobRepository) queryIds(sql string, args ...any) ([]string, error) {
+	rows, err := r.db.Query(armadacontext.Background(), sql, args...)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	ids, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return ids, nil
+}
+
+// labels returns the labels of a job as stored, such that jobs without labels match filters without labels.
+func labels(jobLabels map[string]string) map[string]string {
+	if jobLabels == nil {
+		return map[string]string{}
+	}
+	return jobLabels
+}
+
+// Key of the advisory lock held while relaying outbox events, such that only one server relays them at a time.
+const eventOutboxLockId = 0x6f7574626f78
+
+func (r *PostgresJobRepository) AddOutboxEvents(events []*api.EventMessage) error {
+	err := addOutboxEvents(armadacontext.Background(), r.db, events)
+	return errors.WithMessage(err, "[PostgresJobRepository.AddOutboxEvents] error writing to database")
+}
+
+// RelayOutboxEvents relays events in the order of their ids. Since ids are assigned before transactions commit,
+// events written by concurrent transactions may be relayed out of order, but those of each transaction are relayed in order.
+func (r *PostgresJobRepository) RelayOutboxEvents(limit int, publish func([]*api.EventMessage) error) (int, error) {
+	ctx := armadacontext.Background()
+	relayed := 0
+	err := pgx.BeginTxFunc(ctx, r.db, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		var acquired bool
+		if err := tx.QueryRow(ctx, "SELECT pg_try_advisory_xact_lock($1)", eventOutboxLockId).Scan(&acquired); err != nil {
+			return errors.WithStack(err)
+		} else if !acquired {
+			return nil
+		}
+		rows, err := tx.Query(ctx, "SELECT id, event FROM event_outbox ORDER BY id LIMIT $1", limit)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		var ids []int64
+		var events []*api.EventMessage
+		var id int64
+		var data []byte
+		_, err = pgx.ForEachRow(rows, []any{&id, &data}, func() error {
+			event := &api.EventMessage{}
+			if err := proto.Unmarshal(data, event); err != nil {
+				return errors.Wrapf(err, "error unmarshalling outbox event %d", id)
+			}
+			ids = append(ids, id)
+			events = append(events, event)
+			return nil
+		})
+		if err != nil || len(events) == 0 {
+			return errors.WithStack(err)
+		}
+		if err := publish(events); err != nil {
+			return err
+		}
+		if _, err := tx.Exec(ctx, "DELETE FROM event_outbox WHERE id = any($1)", ids); err != nil {
+			return errors.WithStack(err)
+		}
+		relayed = len(events)
+		return nil
+	})
+	if err != nil {
+		return 0, errors.WithMessage(err, "[PostgresJobRepository.RelayOutboxEvents] error relaying events")
+	}
+	return relayed, nil
+}
+
+// addOutboxEvents writes events to the event outbox, in order.
+func addOutboxEvents(ctx *armadacontext.Context, db database.Querier, events []*api.EventMessage) error {
+	if len(events) == 0 {
+		return nil
+	}
+	data := make([][]byte, len(events))
+	for i, event := range events {
+		var err error
+		if data[i], err = proto.Marshal(event); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+	_, err := db.Exec(
+		ctx,
+		"INSERT INTO event_outbox (event) SELECT event FROM unnest($1::bytea[]) WITH ORDINALITY AS e(event, i) ORDER BY i",
+		data,
+	)
+	return errors.WithStack(err)
+}
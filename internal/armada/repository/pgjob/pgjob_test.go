@@ -0,0 +1,410 @@
+package pgjob
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/armadaproject/armada/internal/armada/repository"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/util"
+	"github.com/armadaproject/armada/internal/scheduler/schedulerobjects"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+func TestAddJobs_DoubleSubmit(t *testing.T) {
+	withJobRepository(t, func(r *PostgresJobRepository) {
+		job := testJob("queue", 1)
+		results, err := r.AddJobs([]*api.Job{job, job})
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+		assert.Equal(t, job.Id, results[0].JobId)
+		assert.False(t, results[0].AlreadyProcessed)
+		assert.True(t, results[1].AlreadyProcessed)
+
+		jobs, err := r.GetExistingJobsByIds([]string{job.Id, "missing"})
+		require.NoError(t, err)
+		require.Len(t, jobs, 1)
+		assert.Equal(t, job.Id, jobs[0].Id)
+		assert.NotNil(t, jobs[0].Annotations)
+	})
+}
+
+func TestPeekQueue_OrdersByPriority(t *testing.T) {
+	withJobRepository(t, func(r *PostgresJobRepository) {
+		low := addJob(t, r, "queue", 2)
+		high := addJob(t, r, "queue", 1)
+		addJob(t, r, "other", 0)
+
+		jobs, err := r.PeekQueue("queue", 10)
+		require.NoError(t, err)
+		assert.Equal(t, []string{high.Id, low.Id}, jobIds(jobs))
+
+		sizes, err := r.GetQueueSizes([]*api.Queue{{Name: "queue"}, {Name: "empty"}})
+		require.NoError(t, err)
+		assert.Equal(t, []int64{2, 0}, sizes)
+
+		active, err := r.FilterActiveQueues([]*api.Queue{{Name: "queue"}, {Name: "empty"}})
+		require.NoError(t, err)
+		require.Len(t, active, 1)
+		assert.Equal(t, "queue", active[0].Name)
+	})
+}
+
+func TestLeases(t *testing.T) {
+	withJobRepository(t, func(r *PostgresJobRepository) {
+		job := addJob(t, r, "queue", 1)
+
+		leased, err := r.TryLeaseJobs("cluster", map[string][]string{"queue": {job.Id}})
+		require.NoError(t, err)
+		assert.Equal(t, map[string][]string{"queue": {job.Id}}, leased)
+		leased, err = r.TryLeaseJobs("other-cluster", map[string][]string{"queue": {job.Id}})
+		require.NoError(t, err)
+		assert.Empty(t, leased)
+
+		renewed, err := r.RenewLease("cluster", []string{job.Id})
+		require.NoError(t, err)
+		assert.Equal(t, []string{job.Id}, renewed)
+		renewed, err = r.RenewLease("other-cluster", []string{job.Id})
+		require.NoError(t, err)
+		assert.Empty(t, renewed)
+
+		leasedIds, err := r.GetLeasedJobIds("queue")
+		require.NoError(t, err)
+		assert.Equal(t, []string{job.Id}, leasedIds)
+
+		returned, err := r.ReturnLease("other-cluster", job.Id)
+		require.NoError(t, err)
+		assert.Nil(t, returned)
+		returned, err = r.ReturnLease("cluster", job.Id)
+		require.NoError(t, err)
+		require.NotNil(t, returned)
+		assert.Equal(t, job.Id, returned.Id)
+
+		queuedIds, err := r.GetQueueJobIds("queue")
+		require.NoError(t, err)
+		assert.Equal(t, []string{job.Id}, queuedIds)
+	})
+}
+
+func TestExpireLeases(t *testing.T) {
+	withJobRepository(t, func(r *PostgresJobRepository) {
+		job := addJob(t, r, "queue", 1)
+		_, err := r.TryLeaseJobs("cluster", map[string][]string{"queue": {job.Id}})
+		require.NoError(t, err)
+
+		expired, err := r.ExpireLeases("queue", time.Now().Add(-time.Minute))
+		require.NoError(t, err)
+		assert.Empty(t, expired)
+
+		expired, err = r.ExpireLeases("queue", time.Now().Add(time.Minute))
+		require.NoError(t, err)
+		assert.Equal(t, []string{job.Id}, jobIds(expired))
+
+		queuedIds, err := r.GetQueueJobIds("queue")
+		require.NoError(t, err)
+		assert.Equal(t, []string{job.Id}, queuedIds)
+	})
+}
+
+func TestDeleteJobs(t *testing.T) {
+	withJobRepository(t, func(r *PostgresJobRepository) {
+		job := addJob(t, r, "queue", 1)
+		missing := testJob("queue", 1)
+
+		deleted, err := r.DeleteJobs([]*api.Job{job, missing})
+		require.NoError(t, err)
+		assert.Equal(t, map[*api.Job]error{job: nil}, deleted)
+
+		jobs, err := r.GetExistingJobsByIds([]string{job.Id})
+		require.NoError(t, err)
+		assert.Empty(t, jobs)
+
+		// Deleted jobs can't be resubmitted, just as with Redis.
+		results, err := r.AddJobs([]*api.Job{job})
+		require.NoError(t, err)
+		assert.True(t, results[0].AlreadyProcessed)
+	})
+}
+
+func TestSuspendAndResumeJobs(t *testing.T) {
+	withJobRepository(t, func(r *PostgresJobRepository) {
+		job := addJob(t, r, "queue", 1)
+
+		suspended, err := r.SuspendJobs("queue", []string{job.Id, "missing"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{job.Id}, suspended)
+		leased, err := r.TryLeaseJobs("cluster", map[string][]string{"queue": {job.Id}})
+		require.NoError(t, err)
+		assert.Empty(t, leased)
+
+		activeIds, err := r.GetActiveJobIds("queue", job.JobSetId)
+		require.NoError(t, err)
+		assert.Equal(t, []string{job.Id}, activeIds)
+
+		resumed, err := r.ResumeJobs("queue", []string{job.Id})
+		require.NoError(t, err)
+		assert.Equal(t, []string{job.Id}, resumed)
+		queuedIds, err := r.GetQueueJobIds("queue")
+		require.NoError(t, err)
+		assert.Equal(t, []string{job.Id}, queuedIds)
+	})
+}
+
+func TestGetQueueResources(t *testing.T) {
+	withJobRepository(t, func(r *PostgresJobRepository) {
+		assertCpu := func(expected string) {
+			t.Helper()
+			resources, err := r.GetQueueResources("queue")
+			require.NoError(t, err)
+			cpu := resources["cpu"]
+			assert.Equal(t, 0, cpu.Cmp(resource.MustParse(expected)), "expected %s cpu, but got %s", expected, cpu.String())
+		}
+		queued := addJob(t, r, "queue", 1)
+		leased := addJob(t, r, "queue", 1)
+		addJob(t, r, "other", 1)
+		_, err := r.TryLeaseJobs("cluster", map[string][]string{"queue": {leased.Id}})
+		require.NoError(t, err)
+		assertCpu("2")
+
+		_, err = r.SuspendJobs("queue", []string{queued.Id})
+		require.NoError(t, err)
+		assertCpu("1")
+		_, err = r.ResumeJobs("queue", []string{queued.Id})
+		require.NoError(t, err)
+		assertCpu("2")
+
+		_, err = r.UpdateJobs([]string{queued.Id}, func(jobs []*api.Job) {
+			jobs[0].PodSpec.Containers[0].Resources.Requests["cpu"] = resource.MustParse("3")
+		})
+		require.NoError(t, err)
+		assertCpu("4")
+
+		_, err = r.DeleteJobs([]*api.Job{queued, leased})
+		require.NoError(t, err)
+		assertCpu("0")
+	})
+}
+
+func TestBackfillQueueResources(t *testing.T) {
+	withJobRepository(t, func(r *PostgresJobRepository) {
+		queued := addJob(t, r, "queue", 1)
+		leased := addJob(t, r, "queue", 1)
+		_, err := r.TryLeaseJobs("cluster", map[string][]string{"queue": {leased.Id}})
+		require.NoError(t, err)
+		// Like jobs stored before resources were recorded.
+		_, err = r.db.Exec(armadacontext.Background(), "UPDATE jobs SET resources = NULL")
+		require.NoError(t, err)
+		_, err = r.db.Exec(armadacontext.Background(), "DELETE FROM queue_resources")
+		require.NoError(t, err)
+
+		backfilled, err := r.BackfillQueueResources([]string{"queue"})
+		require.NoError(t, err)
+		assert.Equal(t, 2, backfilled)
+		resources, err := r.GetQueueResources("queue")
+		require.NoError(t, err)
+		cpu := resources["cpu"]
+		assert.Equal(t, 0, cpu.Cmp(resource.MustParse("2")), "expected 2 cpu, but got %s", cpu.String())
+
+		// Backfilling again changes nothing.
+		backfilled, err = r.BackfillQueueResources([]string{"queue"})
+		require.NoError(t, err)
+		assert.Equal(t, 0, backfilled)
+
+		_, err = r.DeleteJobs([]*api.Job{queued, leased})
+		require.NoError(t, err)
+		resources, err = r.GetQueueResources("queue")
+		require.NoError(t, err)
+		cpu = resources["cpu"]
+		assert.True(t, cpu.IsZero(), "expected no cpu, but got %s", cpu.String())
+	})
+}
+
+func TestGetJobSetJobIds_FiltersByStateAndLabels(t *testing.T) {
+	withJobRepository(t, func(r *PostgresJobRepository) {
+		labelled := testJob("queue", 1)
+		labelled.Labels = map[string]string{"a": "1", "b": "2"}
+		unlabelled := testJob("queue", 1)
+		_, err := r.AddJobs([]*api.Job{labelled, unlabelled})
+		require.NoError(t, err)
+		_, err = r.TryLeaseJobs("cluster", map[string][]string{"queue": {unlabelled.Id}})
+		require.NoError(t, err)
+
+		ids, err := r.GetJobSetJobIds("queue", "set", &repository.JobSetFilter{IncludeQueued: true})
+		require.NoError(t, err)
+		assert.Equal(t, []string{labelled.Id}, ids)
+
+		ids, err = r.GetJobSetJobIds("queue", "set", &repository.JobSetFilter{IncludeQueued: true, IncludeLeased: true, Labels: map[string]string{"a": "1"}})
+		require.NoError(t, err)
+		assert.Equal(t, []string{labelled.Id}, ids)
+
+		ids, err = r.GetJobSetJobIds("queue", "set", nil)
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{labelled.Id, unlabelled.Id}, ids)
+
+		ids, err = r.GetJobSetJobIds("queue", "set", &repository.JobSetFilter{})
+		require.NoError(t, err)
+		assert.Equal(t, []string{}, ids)
+	})
+}
+
+func TestUpdateStartTimeAndGetJobRunInfos(t *testing.T) {
+	withJobRepository(t, func(r *PostgresJobRepository) {
+		job := addJob(t, r, "queue", 1)
+		_, err := r.TryLeaseJobs("cluster", map[string][]string{"queue": {job.Id}})
+		require.NoError(t, err)
+
+		startTime := time.Now().UTC().Truncate(time.Microsecond)
+		jobErrors, err := r.UpdateStartTime([]*repository.JobStartInfo{
+			{JobId: job.Id, ClusterId: "cluster", StartTime: startTime.Add(time.Minute)},
+			{JobId: job.Id, ClusterId: "cluster", StartTime: startTime},
+			{JobId: "missing", ClusterId: "cluster", StartTime: startTime},
+		})
+		require.NoError(t, err)
+		assert.NoError(t, jobErrors[0])
+		assert.NoError(t, jobErrors[1])
+		var notFoundErr *repository.ErrJobNotFound
+		assert.ErrorAs(t, jobErrors[2], &notFoundErr)
+
+		runInfos, err := r.GetJobRunInfos([]string{job.Id})
+		require.NoError(t, err)
+		require.Contains(t, runInfos, job.Id)
+		assert.Equal(t, "cluster", runInfos[job.Id].CurrentClusterId)
+		assert.True(t, startTime.Equal(runInfos[job.Id].StartTime))
+
+		clusterIds, err := r.GetLeasedJobClusterIds([]string{job.Id, "missing"})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{job.Id: "cluster"}, clusterIds)
+
+		jobSets, err := r.GetQueueActiveJobSets("queue")
+		require.NoError(t, err)
+		require.Len(t, jobSets, 1)
+		assert.Equal(t, int32(1), jobSets[0].RunningJobs)
+	})
+}
+
+func TestUpdateJobs(t *testing.T) {
+	withJobRepository(t, func(r *PostgresJobRepository) {
+		job := addJob(t, r, "queue", 1)
+		other := addJob(t, r, "queue", 2)
+
+		results, err := r.UpdateJobs([]string{job.Id, "missing"}, func(jobs []*api.Job) {
+			for _, job := range jobs {
+				job.Priority = 3
+			}
+		})
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.NoError(t, results[0].Error)
+		assert.Equal(t, float64(3), results[0].Job.Priority)
+
+		queuedIds, err := r.GetQueueJobIds("queue")
+		require.NoError(t, err)
+		assert.Equal(t, []string{other.Id, job.Id}, queuedIds)
+	})
+}
+
+func TestRetryAttempts(t *testing.T) {
+	withJobRepository(t, func(r *PostgresJobRepository) {
+		retries, err := r.GetNumberOfRetryAttempts("job")
+		require.NoError(t, err)
+		assert.Equal(t, 0, retries)
+
+		require.NoError(t, r.AddRetryAttempt("job"))
+		require.NoError(t, r.AddRetryAttempt("job"))
+		retries, err = r.GetNumberOfRetryAttempts("job")
+		require.NoError(t, err)
+		assert.Equal(t, 2, retries)
+	})
+}
+
+func TestStoreAndGetPulsarSchedulerJobDetails(t *testing.T) {
+	withJobRepository(t, func(r *PostgresJobRepository) {
+		details := &schedulerobjects.PulsarSchedulerJobDetails{JobId: "job", Queue: "queue", JobSet: "set"}
+		require.NoError(t, r.StorePulsarSchedulerJobDetails([]*schedulerobjects.PulsarSchedulerJobDetails{details}))
+
+		stored, err := r.GetPulsarSchedulerJobDetails("job")
+		require.NoError(t, err)
+		assert.Equal(t, details, stored)
+
+		missing, err := r.GetPulsarSchedulerJobDetails("missing")
+		require.NoError(t, err)
+		assert.Nil(t, missing)
+	})
+}
+
+func TestAddJobsWithEvents_RelaysEventsOfStoredJobs(t *testing.T) {
+	withJobRepository(t, func(r *PostgresJobRepository) {
+		existing := addJob(t, r, "queue", 1)
+		job := testJob("queue", 1)
+		events := [][]*api.EventMessage{outboxTestEvents(job.Id), outboxTestEvents(existing.Id)}
+		results, err := r.AddJobsWithEvents([]*api.Job{job, existing}, events)
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+		assert.True(t, results[1].AlreadyProcessed)
+		require.NoError(t, r.AddOutboxEvents(outboxTestEvents("other")))
+
+		var published []*api.EventMessage
+		relayed, err := r.RelayOutboxEvents(10, func(events []*api.EventMessage) error {
+			published = append(published, events...)
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 2, relayed)
+		assert.Equal(t, append(outboxTestEvents(job.Id), outboxTestEvents("other")...), published)
+
+		relayed, err = r.RelayOutboxEvents(10, func([]*api.EventMessage) error { return nil })
+		require.NoError(t, err)
+		assert.Equal(t, 0, relayed)
+	})
+}
+
+func outboxTestEvents(jobId string) []*api.EventMessage {
+	return []*api.EventMessage{{
+		Events: &api.EventMessage_Cancelled{Cancelled: &api.JobCancelledEvent{JobId: jobId, JobSetId: "set", Queue: "queue"}},
+	}}
+}
+
+func addJob(t *testing.T, r *PostgresJobRepository, queue string, priority float64) *api.Job {
+	job := testJob(queue, priority)
+	results, err := r.AddJobs([]*api.Job{job})
+	require.NoError(t, err)
+	require.False(t, results[0].AlreadyProcessed)
+	return job
+}
+
+func testJob(queue string, priority float64) *api.Job {
+	resources := v1.ResourceList{"cpu": resource.MustParse("1"), "memory": resource.MustParse("512Mi")}
+	return &api.Job{
+		Id:       util.NewULID(),
+		Queue:    queue,
+		JobSetId: "set",
+		Owner:    "user",
+		Priority: priority,
+		PodSpec: &v1.PodSpec{
+			Containers: []v1.Container{{Resources: v1.ResourceRequirements{Requests: resources, Limits: resources}}},
+		},
+		Created: time.Now(),
+	}
+}
+
+func jobIds(jobs []*api.Job) []string {
+	ids := make([]string, len(jobs))
+	for i, job := range jobs {
+		ids[i] = job.Id
+	}
+	return ids
+}
+
+func withJobRepository(t *testing.T, action func(r *PostgresJobRepository)) {
+	err := WithTestDb(func(db *pgxpool.Pool) error {
+		action(NewPostgresJobRepository(db, repository.NewJobCodec(nil, 0)))
+		return nil
+	})
+	require.NoError(t, err)
+}
@@ -0,0 +1,36 @@
+package pgjob
+
+import (
+	"embed"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/database"
+)
+
+//go:embed migrations/*.sql
+var fs embed.FS
+
+func Migrate(ctx *armadacontext.Context, db database.Querier) error {
+	start := time.Now()
+	migrations, err := database.ReadMigrations(fs, "migrations")
+	if err != nil {
+		return err
+	}
+	err = database.UpdateDatabase(ctx, db, migrations)
+	if err != nil {
+		return err
+	}
+	ctx.Infof("Updated job database in %s", time.Now().Sub(start))
+	return nil
+}
+
+func WithTestDb(action func(db *pgxpool.Pool) error) error {
+	migrations, err := database.ReadMigrations(fs, "migrations")
+	if err != nil {
+		return err
+	}
+	return database.WithTestDb(migrations, action)
+}
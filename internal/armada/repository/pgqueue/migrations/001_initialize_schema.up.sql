@@ -0,0 +1,19 @@
+CREATE TABLE queues (
+    name text PRIMARY KEY,
+    -- Serialized api.Queue.
+    definition bytea NOT NULL
+);
+
+-- Single row holding the resource version of the repository. Writers lock it, such that writes are serialized.
+CREATE TABLE queue_resource_version (
+    id integer PRIMARY KEY CHECK (id = 1),
+    resource_version bigint NOT NULL
+);
+
+INSERT INTO queue_resource_version (id, resource_version) VALUES (1, 0);
+
+CREATE TABLE queue_changes (
+    resource_version bigint PRIMARY KEY,
+    -- Serialized api.QueueChange.
+    change bytea NOT NULL
+);
@@ -0,0 +1,324 @@
+package pgqueue
+
+import (
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/internal/armada/repository"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/client/queue"
+)
+
+const (
+	// Number of changes to queues retained for watching.
+	maxQueueChangesRetained = 10000
+	// Interval at which GetQueueChanges polls for changes while waiting for one.
+	queueChangesPollInterval = 500 * time.Millisecond
+)
+
+// PostgresQueueRepository is a repository.QueueRepository backed by postgres.
+// Queues are stored as serialized api.Queue messages, such that both repositories store the same queue definitions.
+// Writes are serialized by locking the row holding the resource version of the repository,
+// and reads are consistent snapshots.
+type PostgresQueueRepository struct {
+	db *pgxpool.Pool
+}
+
+func NewPostgresQueueRepository(db *pgxpool.Pool) *PostgresQueueRepository {
+	return &PostgresQueueRepository{db: db}
+}
+
+func (r *PostgresQueueRepository) GetAllQueues() ([]queue.Queue, error) {
+	queues, _, err := r.GetQueueSnapshot()
+	return queues, err
+}
+
+func (r *PostgresQueueRepository) GetQueueSnapshot() ([]queue.Queue, uint64, error) {
+	var queues []queue.Queue
+	var resourceVersion uint64
+	err := r.read(func(ctx *armadacontext.Context, tx pgx.Tx) error {
+		rows, err := tx.Query(ctx, "SELECT definition FROM queues")
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		queueByName, err := scanQueues(rows)
+		if err != nil {
+			return err
+		}
+		if resourceVersion, err = readResourceVersion(ctx, tx, false); err != nil {
+			return err
+		}
+		queues = make([]queue.Queue, 0, len(queueByName))
+		for _, q := range queueByName {
+			queues = append(queues, *q)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, 0, errors.WithMessage(err, "[PostgresQueueRepository.GetQueueSnapshot] error reading from database")
+	}
+
+	queueByName := make(map[string]queue.Queue, len(queues))
+	for _, q := range queues {
+		queueByName[q.Name] = q
+	}
+	for i, q := range queues {
+		for _, ancestor := range queue.Ancestors(q.Name, queueByName) {
+			queues[i].InheritedPermissions = append(queues[i].InheritedPermissions, ancestor.Permissions...)
+		}
+	}
+	return queues, resourceVersion, nil
+}
+
+// GetQueue returns the queue with the given name, including the permissions it inherits from its ancestors.
+func (r *PostgresQueueRepository) GetQueue(name string) (queue.Queue, error) {
+	var q *queue.Queue
+	err := r.read(func(ctx *armadacontext.Context, tx pgx.Tx) error {
+		var err error
+		if q, err = getQueue(ctx, tx, name); err != nil || q == nil {
+			return err
+		}
+		seen := map[string]bool{q.Name: true}
+		for parentName := q.Parent; parentName != "" && !seen[parentName]; {
+			parent, err := getQueue(ctx, tx, parentName)
+			if err != nil {
+				return err
+			} else if parent == nil {
+				break
+			}
+			q.InheritedPermissions = append(q.InheritedPermissions, parent.Permissions...)
+			seen[parentName] = true
+			parentName = parent.Parent
+		}
+		return nil
+	})
+	if err != nil {
+		return queue.Queue{}, errors.WithMessage(err, "[PostgresQueueRepository.GetQueue] error reading from database")
+	}
+	if q == nil {
+		return queue.Queue{}, &repository.ErrQueueNotFound{QueueName: name}
+	}
+	return *q, nil
+}
+
+func (r *PostgresQueueRepository) CreateQueue(q queue.Queue) error {
+	return r.CreateQueues([]queue.Queue{q})
+}
+
+func (r *PostgresQueueRepository) CreateQueues(queues []queue.Queue) error {
+	writes := make([]repository.QueueWrite, len(queues))
+	for i, q := range queues {
+		writes[i] = repository.CreateQueueWrite(q)
+	}
+	return r.writeQueues(writes)
+}
+
+func (r *PostgresQueueRepository) UpdateQueue(q queue.Queue) error {
+	return r.UpdateQueues([]queue.Queue{q})
+}
+
+func (r *PostgresQueueRepository) UpdateQueues(queues []queue.Queue) error {
+	writes := make([]repository.QueueWrite, len(queues))
+	for i, q := range queues {
+		writes[i] = repository.ReplaceQueueWrite(q)
+	}
+	return r.writeQueues(writes)
+}
+
+func (r *PostgresQueueRepository) SetQueueArchival(name string, archival *queue.Archival) error {
+	return r.writeQueues([]repository.QueueWrite{repository.SetQueueArchivalWrite(name, archival)})
+}
+
+func (r *PostgresQueueRepository) DeleteQueue(name string) error {
+	return r.writeQueues([]repository.QueueWrite{repository.DeleteQueueWrite(name)})
+}
+
+// writeQueues applies writes as repository.ApplyQueueWrites does and stores the result in a single transaction
+// if all of them succeed.
+func (r *PostgresQueueRepository) writeQueues(writes []repository.QueueWrite) error {
+	names := make([]string, 0, len(writes))
+	for _, w := range writes {
+		names = append(names, w.Name)
+	}
+	if len(names) == 0 {
+		return nil
+	}
+
+	ctx := armadacontext.Background()
+	return pgx.BeginTxFunc(ctx, r.db, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		// Locking the resource version first serializes writers; queues read afterwards are thus up-to-date.
+		resourceVersion, err := readResourceVersion(ctx, tx, true)
+		if err != nil {
+			return errors.WithMessage(err, "[PostgresQueueRepository.writeQueues] error reading resource version")
+		}
+		rows, err := tx.Query(ctx, "SELECT definition FROM queues WHERE name = any($1)", names)
+		if err != nil {
+			return errors.Wrap(err, "[PostgresQueueRepository.writeQueues] error reading from database")
+		}
+		queueByName, err := scanQueues(rows)
+		if err != nil {
+			return errors.WithMessage(err, "[PostgresQueueRepository.writeQueues] error reading from database")
+		}
+
+		changes, err := repository.ApplyQueueWrites(queueByName, resourceVersion, writes)
+		if err != nil || len(changes) == 0 {
+			return err
+		}
+		batch := &pgx.Batch{}
+		for name, q := range queueByName {
+			if q == nil {
+				batch.Queue("DELETE FROM queues WHERE name = $1", name)
+				continue
+			}
+			data, err := proto.Marshal(q.ToAPI())
+			if err != nil {
+				return errors.Wrap(err, "[PostgresQueueRepository.writeQueues] error marshalling queue")
+			}
+			batch.Queue(
+				"INSERT INTO queues (name, definition) VALUES ($1, $2) ON CONFLICT (name) DO UPDATE SET definition = excluded.definition",
+				name, data,
+			)
+		}
+		for _, change := range changes {
+			data, err := proto.Marshal(change)
+			if err != nil {
+				return errors.Wrap(err, "[PostgresQueueRepository.writeQueues] error marshalling queue change")
+			}
+			batch.Queue("INSERT INTO queue_changes (resource_version, change) VALUES ($1, $2)", int64(change.ResourceVersion), data)
+		}
+		resourceVersion = changes[len(changes)-1].ResourceVersion
+		batch.Queue("UPDATE queue_resource_version SET resource_version = $1 WHERE id = 1", int64(resourceVersion))
+		batch.Queue("DELETE FROM queue_changes WHERE resource_version <= $1", int64(resourceVersion)-maxQueueChangesRetained)
+		if err := tx.SendBatch(ctx, batch).Close(); err != nil {
+			return errors.Wrap(err, "[PostgresQueueRepository.writeQueues] error writing to database")
+		}
+		return nil
+	})
+}
+
+func (r *PostgresQueueRepository) GetQueueChanges(fromResourceVersion uint64, limit int64, block time.Duration) ([]repository.QueueChange, error) {
+	deadline := time.Now().Add(block)
+	for {
+		changes, err := r.getQueueChanges(fromResourceVersion, limit)
+		if err != nil || len(changes) > 0 || !time.Now().Add(queueChangesPollInterval).Before(deadline) {
+			return changes, err
+		}
+		time.Sleep(queueChangesPollInterval)
+	}
+}
+
+func (r *PostgresQueueRepository) getQueueChanges(fromResourceVersion uint64, limit int64) ([]repository.QueueChange, error) {
+	var changes []repository.QueueChange
+	err := r.read(func(ctx *armadacontext.Context, tx pgx.Tx) error {
+		resourceVersion, err := readResourceVersion(ctx, tx, false)
+		if err != nil {
+			return err
+		}
+		// Changes are retained as long as the oldest change retained is at most the one directly after fromResourceVersion.
+		var oldest *int64
+		if err := tx.QueryRow(ctx, "SELECT min(resource_version) FROM queue_changes").Scan(&oldest); err != nil {
+			return errors.WithStack(err)
+		}
+		if (oldest != nil && uint64(*oldest) > fromResourceVersion+1) || (oldest == nil && resourceVersion > fromResourceVersion) {
+			return &repository.ErrQueueChangesExpired{FromResourceVersion: fromResourceVersion}
+		}
+
+		rows, err := tx.Query(
+			ctx,
+			"SELECT change FROM queue_changes WHERE resource_version > $1 ORDER BY resource_version LIMIT $2",
+			int64(fromResourceVersion), limit,
+		)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var data []byte
+			if err := rows.Scan(&data); err != nil {
+				return errors.WithStack(err)
+			}
+			apiChange := &api.QueueChange{}
+			if err := proto.Unmarshal(data, apiChange); err != nil {
+				return errors.Wrap(err, "error unmarshalling queue change")
+			}
+			q, err := queue.NewQueue(apiChange.Queue)
+			if err != nil {
+				return err
+			}
+			changes = append(changes, repository.QueueChange{
+				Type:            apiChange.Type,
+				Queue:           q,
+				ResourceVersion: apiChange.ResourceVersion,
+			})
+		}
+		return errors.WithStack(rows.Err())
+	})
+	var expired *repository.ErrQueueChangesExpired
+	if errors.As(err, &expired) {
+		return nil, err
+	} else if err != nil {
+		return nil, errors.WithMessage(err, "[PostgresQueueRepository.GetQueueChanges] error reading from database")
+	}
+	return changes, nil
+}
+
+// read calls f within a read-only transaction, such that everything f reads is consistent.
+func (r *PostgresQueueRepository) read(f func(ctx *armadacontext.Context, tx pgx.Tx) error) error {
+	ctx := armadacontext.Background()
+	return pgx.BeginTxFunc(ctx, r.db, pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly}, func(tx pgx.Tx) error {
+		return f(ctx, tx)
+	})
+}
+
+// readResourceVersion returns the resource version of the repository, locking it until the end of the transaction if lock is set.
+func readResourceVersion(ctx *armadacontext.Context, tx pgx.Tx, lock bool) (uint64, error) {
+	sql := "SELECT resource_version FROM queue_resource_version WHERE id = 1"
+	if lock {
+		sql += " FOR UPDATE"
+	}
+	var resourceVersion int64
+	if err := tx.QueryRow(ctx, sql).Scan(&resourceVersion); err != nil {
+		return 0, errors.WithStack(err)
+	}
+	return uint64(resourceVersion), nil
+}
+
+// getQueue returns the queue with the given name, or nil if there's none.
+func getQueue(ctx *armadacontext.Context, tx pgx.Tx, name string) (*queue.Queue, error) {
+	rows, err := tx.Query(ctx, "SELECT definition FROM queues WHERE name = $1", name)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	queueByName, err := scanQueues(rows)
+	if err != nil {
+		return nil, err
+	}
+	return queueByName[name], nil
+}
+
+// scanQueues unmarshals the queue definitions in rows and closes them.
+func scanQueues(rows pgx.Rows) (map[string]*queue.Queue, error) {
+	defer rows.Close()
+	queueByName := make(map[string]*queue.Queue)
+	for rows.Next() {
+		var data []byte
+		if err := rows.Scan(&data); err != nil {
+			return nil, errors.WithStack(err)
+		}
+		apiQueue := &api.Queue{}
+		if err := proto.Unmarshal(data, apiQueue); err != nil {
+			return nil, errors.Wrap(err, "error unmarshalling queue")
+		}
+		q, err := queue.NewQueue(apiQueue)
+		if err != nil {
+			return nil, err
+		}
+		queueByName[q.Name] = &q
+	}
+	return queueByName, errors.WithStack(rows.Err())
+}
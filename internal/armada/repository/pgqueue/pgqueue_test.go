@@ -0,0 +1,122 @@
+package pgqueue
+
+import (
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/armadaproject/armada/internal/armada/repository"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/client/queue"
+)
+
+func TestGetQueue(t *testing.T) {
+	withQueueRepository(t, func(r *PostgresQueueRepository, db *pgxpool.Pool) {
+		parent := queue.Queue{
+			Name:           "parent",
+			PriorityFactor: 1,
+			Permissions:    []queue.Permissions{{Subjects: []queue.PermissionSubject{{Kind: queue.PermissionSubjectKindGroup, Name: "team"}}}},
+		}
+		require.NoError(t, r.CreateQueue(parent))
+		require.NoError(t, r.CreateQueue(queue.Queue{Name: "child", Parent: "parent", PriorityFactor: 2}))
+
+		child, err := r.GetQueue("child")
+		require.NoError(t, err)
+		assert.Equal(t, queue.PriorityFactor(2), child.PriorityFactor)
+		assert.Equal(t, uint64(1), child.Revision)
+		assert.Equal(t, uint64(2), child.ResourceVersion)
+		assert.Len(t, child.InheritedPermissions, 1)
+
+		_, err = r.GetQueue("missing")
+		var notFoundErr *repository.ErrQueueNotFound
+		assert.ErrorAs(t, err, &notFoundErr)
+	})
+}
+
+func TestGetQueueChanges(t *testing.T) {
+	withQueueRepository(t, func(r *PostgresQueueRepository, db *pgxpool.Pool) {
+		require.NoError(t, r.CreateQueue(queue.Queue{Name: "a", PriorityFactor: 1}))
+		require.NoError(t, r.CreateQueue(queue.Queue{Name: "b", PriorityFactor: 1}))
+		require.NoError(t, r.UpdateQueue(queue.Queue{Name: "a", PriorityFactor: 2}))
+		require.NoError(t, r.DeleteQueue("b"))
+		// Deleting a queue that doesn't exist isn't a change.
+		require.NoError(t, r.DeleteQueue("b"))
+
+		changes, err := r.GetQueueChanges(0, 10, 0)
+		require.NoError(t, err)
+		require.Len(t, changes, 4)
+		expected := []struct {
+			changeType     api.QueueChangeType
+			name           string
+			priorityFactor queue.PriorityFactor
+		}{
+			{api.QueueChangeType_QueueCreated, "a", 1},
+			{api.QueueChangeType_QueueCreated, "b", 1},
+			{api.QueueChangeType_QueueUpdated, "a", 2},
+			{api.QueueChangeType_QueueDeleted, "b", 1},
+		}
+		for i, change := range changes {
+			assert.Equal(t, uint64(i+1), change.ResourceVersion)
+			assert.Equal(t, expected[i].changeType, change.Type)
+			assert.Equal(t, expected[i].name, change.Queue.Name)
+			assert.Equal(t, expected[i].priorityFactor, change.Queue.PriorityFactor)
+		}
+
+		changes, err = r.GetQueueChanges(4, 10, 0)
+		require.NoError(t, err)
+		assert.Empty(t, changes)
+
+		_, err = db.Exec(armadacontext.Background(), "DELETE FROM queue_changes WHERE resource_version = 1")
+		require.NoError(t, err)
+		_, err = r.GetQueueChanges(0, 10, 0)
+		var expiredErr *repository.ErrQueueChangesExpired
+		assert.ErrorAs(t, err, &expiredErr)
+	})
+}
+
+func TestCreateQueues_AllOrNothing(t *testing.T) {
+	withQueueRepository(t, func(r *PostgresQueueRepository, db *pgxpool.Pool) {
+		require.NoError(t, r.CreateQueue(queue.Queue{Name: "b", PriorityFactor: 1}))
+
+		var alreadyExistsErr *repository.ErrQueueAlreadyExists
+		err := r.CreateQueues([]queue.Queue{{Name: "a", PriorityFactor: 1}, {Name: "b", PriorityFactor: 2}})
+		assert.ErrorAs(t, err, &alreadyExistsErr)
+		queues, resourceVersion, err := r.GetQueueSnapshot()
+		require.NoError(t, err)
+		require.Len(t, queues, 1)
+		assert.Equal(t, queue.PriorityFactor(1), queues[0].PriorityFactor)
+		assert.Equal(t, uint64(1), resourceVersion)
+	})
+}
+
+func TestUpdateQueues_AllOrNothing(t *testing.T) {
+	withQueueRepository(t, func(r *PostgresQueueRepository, db *pgxpool.Pool) {
+		require.NoError(t, r.CreateQueues([]queue.Queue{{Name: "a", PriorityFactor: 1}, {Name: "b", PriorityFactor: 1}}))
+
+		var revisionMismatchErr *repository.ErrQueueRevisionMismatch
+		err := r.UpdateQueues([]queue.Queue{{Name: "a", PriorityFactor: 2}, {Name: "b", PriorityFactor: 2, Revision: 2}})
+		assert.ErrorAs(t, err, &revisionMismatchErr)
+		a, err := r.GetQueue("a")
+		require.NoError(t, err)
+		assert.Equal(t, queue.PriorityFactor(1), a.PriorityFactor)
+
+		require.NoError(t, r.UpdateQueues([]queue.Queue{{Name: "a", PriorityFactor: 2}, {Name: "b", PriorityFactor: 3, Revision: 1}}))
+		queues, resourceVersion, err := r.GetQueueSnapshot()
+		require.NoError(t, err)
+		assert.Equal(t, uint64(4), resourceVersion)
+		for _, q := range queues {
+			assert.Equal(t, uint64(2), q.Revision)
+		}
+	})
+}
+
+func withQueueRepository(t *testing.T, action func(r *PostgresQueueRepository, db *pgxpool.Pool)) {
+	err := WithTestDb(func(db *pgxpool.Pool) error {
+		action(NewPostgresQueueRepository(db), db)
+		return nil
+	})
+	require.NoError(t, err)
+}
@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+const (
+	leaseReturnCountPrefix = "Quarantine:LeaseReturnCount:" // {jobId} - number of times the lease of the job was returned
+	leaseReturnsPrefix     = "Quarantine:LeaseReturns:"     // {jobId} - most recent lease returns of the job
+	quarantinedJobPrefix   = "Quarantine:Job:"              // {jobId} - diagnostic record of the quarantined job
+)
+
+type ErrQuarantinedJobNotFound struct {
+	JobId string
+}
+
+func (err *ErrQuarantinedJobNotFound) Error() string {
+	return fmt.Sprintf("could not find quarantined job %q", err.JobId)
+}
+
+// QuarantineRepository records the lease returns of jobs, such that jobs the leases of which are returned repeatedly
+// can be quarantined, and stores the diagnostic records of quarantined jobs.
+type QuarantineRepository interface {
+	// RecordLeaseReturn records that the lease of a job was returned, retaining at most maxRecorded of its most recent
+	// lease returns. Lease returns of a job are deleted if none is recorded for retention.
+	// Returns the number of times the lease of the job was returned, along with its most recent lease returns, oldest first.
+	RecordLeaseReturn(event *api.JobLeaseReturnedEvent, maxRecorded int, retention time.Duration) (int, []*api.JobLeaseReturnedEvent, error)
+	// QuarantineJob stores the diagnostic record of a quarantined job, which is deleted after retention,
+	// and deletes the lease returns recorded for it.
+	QuarantineJob(record *api.QuarantinedJob, retention time.Duration) error
+	// GetQuarantinedJob returns the diagnostic record of a quarantined job.
+	GetQuarantinedJob(jobId string) (*api.QuarantinedJob, error)
+}
+
+type RedisQuarantineRepository struct {
+	db redis.UniversalClient
+}
+
+func NewRedisQuarantineRepository(db redis.UniversalClient) *RedisQuarantineRepository {
+	return &RedisQuarantineRepository{db: db}
+}
+
+func (r *RedisQuarantineRepository) RecordLeaseReturn(
+	event *api.JobLeaseReturnedEvent,
+	maxRecorded int,
+	retention time.Duration,
+) (int, []*api.JobLeaseReturnedEvent, error) {
+	data, err := proto.Marshal(event)
+	if err != nil {
+		return 0, nil, errors.WithStack(err)
+	}
+	countKey := leaseReturnCountPrefix + event.JobId
+	returnsKey := leaseReturnsPrefix + event.JobId
+	pipe := r.db.TxPipeline()
+	count := pipe.Incr(countKey)
+	pipe.Expire(countKey, retention)
+	pipe.RPush(returnsKey, data)
+	pipe.LTrim(returnsKey, int64(-maxRecorded), -1)
+	pipe.Expire(returnsKey, retention)
+	recorded := pipe.LRange(returnsKey, 0, -1)
+	if _, err := pipe.Exec(); err != nil {
+		return 0, nil, errors.Wrapf(err, "[RedisQuarantineRepository.RecordLeaseReturn] error recording lease return of job %s", event.JobId)
+	}
+
+	leaseReturns := make([]*api.JobLeaseReturnedEvent, len(recorded.Val()))
+	for i, data := range recorded.Val() {
+		leaseReturns[i] = &api.JobLeaseReturnedEvent{}
+		if err := proto.Unmarshal([]byte(data), leaseReturns[i]); err != nil {
+			return 0, nil, errors.Wrapf(err, "[RedisQuarantineRepository.RecordLeaseReturn] error unmarshalling lease return of job %s", event.JobId)
+		}
+	}
+	return int(count.Val()), leaseReturns, nil
+}
+
+func (r *RedisQuarantineRepository) QuarantineJob(record *api.QuarantinedJob, retention time.Duration) error {
+	data, err := proto.Marshal(record)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	pipe := r.db.TxPipeline()
+	pipe.Set(quarantinedJobPrefix+record.JobId, data, retention)
+	pipe.Del(leaseReturnCountPrefix+record.JobId, leaseReturnsPrefix+record.JobId)
+	if _, err := pipe.Exec(); err != nil {
+		return errors.Wrapf(err, "[RedisQuarantineRepository.QuarantineJob] error storing record of job %s", record.JobId)
+	}
+	return nil
+}
+
+func (r *RedisQuarantineRepository) GetQuarantinedJob(jobId string) (*api.QuarantinedJob, error) {
+	data, err := r.db.Get(quarantinedJobPrefix + jobId).Bytes()
+	if err == redis.Nil {
+		return nil, &ErrQuarantinedJobNotFound{JobId: jobId}
+	} else if err != nil {
+		return nil, errors.Wrapf(err, "[RedisQuarantineRepository.GetQuarantinedJob] error reading record of job %s", jobId)
+	}
+	record := &api.QuarantinedJob{}
+	if err := proto.Unmarshal(data, record); err != nil {
+		return nil, errors.Wrapf(err, "[RedisQuarantineRepository.GetQuarantinedJob] error unmarshalling record of job %s", jobId)
+	}
+	return record, nil
+}
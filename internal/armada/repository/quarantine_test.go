@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+func TestQuarantine_RecordLeaseReturn(t *testing.T) {
+	withQuarantineRepository(func(r *RedisQuarantineRepository) {
+		events := []*api.JobLeaseReturnedEvent{
+			leaseReturnedEvent("job", "pod creation failed"),
+			leaseReturnedEvent("job", "pod creation failed again"),
+			leaseReturnedEvent("job", "pod creation failed once more"),
+		}
+		for i, event := range events {
+			count, recorded, err := r.RecordLeaseReturn(event, 2, time.Hour)
+			require.NoError(t, err)
+			assert.Equal(t, i+1, count)
+			if i == 0 {
+				assert.Equal(t, events[:1], recorded)
+			} else {
+				assert.Equal(t, events[i-1:i+1], recorded)
+			}
+		}
+
+		count, recorded, err := r.RecordLeaseReturn(leaseReturnedEvent("other-job", "unschedulable"), 2, time.Hour)
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+		assert.Len(t, recorded, 1)
+
+		ttl, err := r.db.TTL(leaseReturnsPrefix + "job").Result()
+		require.NoError(t, err)
+		assert.Greater(t, ttl, time.Duration(0))
+		assert.LessOrEqual(t, ttl, time.Hour)
+	})
+}
+
+func TestQuarantine_QuarantineAndGet(t *testing.T) {
+	withQuarantineRepository(func(r *RedisQuarantineRepository) {
+		_, err := r.GetQuarantinedJob("job")
+		var notFound *ErrQuarantinedJobNotFound
+		assert.ErrorAs(t, err, &notFound)
+
+		_, recorded, err := r.RecordLeaseReturn(leaseReturnedEvent("job", "pod creation failed"), 10, time.Hour)
+		require.NoError(t, err)
+		record := &api.QuarantinedJob{
+			JobId:              "job",
+			Queue:              "queue",
+			JobSetId:           "set",
+			Quarantined:        time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+			LeaseReturns:       1,
+			RecentLeaseReturns: recorded,
+		}
+		require.NoError(t, r.QuarantineJob(record, time.Hour))
+
+		stored, err := r.GetQuarantinedJob("job")
+		require.NoError(t, err)
+		assert.Equal(t, record, stored)
+
+		// Lease returns recorded before the job was quarantined are deleted.
+		count, _, err := r.RecordLeaseReturn(leaseReturnedEvent("job", "pod creation failed"), 10, time.Hour)
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+	})
+}
+
+func leaseReturnedEvent(jobId string, reason string) *api.JobLeaseReturnedEvent {
+	return &api.JobLeaseReturnedEvent{
+		JobId:     jobId,
+		JobSetId:  "set",
+		Queue:     "queue",
+		Created:   time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+		ClusterId: "cluster",
+		Reason:    reason,
+	}
+}
+
+func withQuarantineRepository(action func(r *RedisQuarantineRepository)) {
+	client := redis.NewClient(&redis.Options{Addr: "localhost:6379", DB: 10})
+	defer client.FlushDB()
+	defer client.Close()
+	client.FlushDB()
+	action(NewRedisQuarantineRepository(client))
+}
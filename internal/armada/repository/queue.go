@@ -2,6 +2,7 @@ package repository
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/go-redis/redis"
 	"github.com/gogo/protobuf/proto"
@@ -10,7 +11,19 @@ import (
 	"github.com/armadaproject/armada/pkg/client/queue"
 )
 
-const queueHashKey = "Queue"
+const (
+	queueHashKey = "Queue"
+	// Incremented whenever any queue is created, changed, or deleted.
+	queueResourceVersionKey = "QueueResourceVersion"
+	// Sorted set of serialized api.QueueChange messages by resource version.
+	queueChangesKey = "QueueChanges"
+	// Number of changes to queues retained for watching.
+	maxQueueChangesRetained = 10000
+	// Interval at which GetQueueChanges polls for changes while waiting for one.
+	queueChangesPollInterval = 500 * time.Millisecond
+	// Number of attempts at updating a queue while other queues are changed concurrently.
+	maxQueueUpdateRetries = 10
+)
 
 type ErrQueueNotFound struct {
 	QueueName string
@@ -28,12 +41,59 @@ func (err *ErrQueueAlreadyExists) Error() string {
 	return fmt.Sprintf("queue %s already exists", err.QueueName)
 }
 
+// ErrQueueRevisionMismatch is returned when updating a queue that has been changed since the revision the update is based on.
+type ErrQueueRevisionMismatch struct {
+	QueueName        string
+	ExpectedRevision uint64
+	ActualRevision   uint64
+}
+
+func (err *ErrQueueRevisionMismatch) Error() string {
+	return fmt.Sprintf("queue %s has revision %d rather than the expected revision %d", err.QueueName, err.ActualRevision, err.ExpectedRevision)
+}
+
+// ErrQueueChangesExpired is returned when reading changes to queues that are no longer retained.
+type ErrQueueChangesExpired struct {
+	FromResourceVersion uint64
+}
+
+func (err *ErrQueueChangesExpired) Error() string {
+	return fmt.Sprintf("changes to queues after resource version %d are no longer retained", err.FromResourceVersion)
+}
+
+// QueueChange is a change to a queue, recorded by the queue repository when the queue is written.
+type QueueChange struct {
+	Type api.QueueChangeType
+	// The queue after the change or, if it was deleted, before it.
+	Queue queue.Queue
+	// Resource version of the repository at the change.
+	ResourceVersion uint64
+}
+
 type QueueRepository interface {
 	GetAllQueues() ([]queue.Queue, error)
+	// GetQueueSnapshot returns all queues together with the resource version of the repository they were read at.
+	// Every change to a queue increments the resource version of the repository and sets the resource version of
+	// the queue to it, so the returned queues have at most the returned resource version and later changes a greater one.
+	GetQueueSnapshot() ([]queue.Queue, uint64, error)
 	GetQueue(name string) (queue.Queue, error)
+	// CreateQueue stores the queue with revision 1.
 	CreateQueue(queue.Queue) error
+	// CreateQueues creates all of the provided queues as CreateQueue does or, if any of them can't be created, none of them.
+	CreateQueues([]queue.Queue) error
+	// UpdateQueue replaces the stored queue and increments its revision. If the revision of the provided queue is
+	// non-zero, the queue is only updated if its stored revision is equal to it; otherwise, ErrQueueRevisionMismatch is returned.
+	// The archival of the stored queue is retained, since it's only changed by SetQueueArchival.
 	UpdateQueue(queue.Queue) error
+	// UpdateQueues updates all of the provided queues as UpdateQueue does or, if any of them can't be updated, none of them.
+	UpdateQueues([]queue.Queue) error
+	// SetQueueArchival archives the queue with the given name, or restores it if archival is nil, and increments its revision.
+	SetQueueArchival(name string, archival *queue.Archival) error
 	DeleteQueue(name string) error
+	// GetQueueChanges returns up to limit changes to queues made after fromResourceVersion, ordered by resource version.
+	// If there are none, it waits up to block for one. Returns ErrQueueChangesExpired if changes made after
+	// fromResourceVersion are no longer retained.
+	GetQueueChanges(fromResourceVersion uint64, limit int64, block time.Duration) ([]QueueChange, error)
 }
 
 type RedisQueueRepository struct {
@@ -45,29 +105,77 @@ func NewRedisQueueRepository(db redis.UniversalClient) *RedisQueueRepository {
 }
 
 func (r *RedisQueueRepository) GetAllQueues() ([]queue.Queue, error) {
-	result, err := r.db.HGetAll(queueHashKey).Result()
-	if err != nil {
-		return nil, fmt.Errorf("[RedisQueueRepository.GetAllQueues] error reading from database: %s", err)
+	queues, _, err := r.GetQueueSnapshot()
+	return queues, err
+}
+
+func (r *RedisQueueRepository) GetQueueSnapshot() ([]queue.Queue, uint64, error) {
+	// Queues and the resource version are read in a transaction, such that they're consistent.
+	var queuesCmd *redis.StringStringMapCmd
+	var resourceVersionCmd *redis.StringCmd
+	_, err := r.db.TxPipelined(func(pipe redis.Pipeliner) error {
+		queuesCmd = pipe.HGetAll(queueHashKey)
+		resourceVersionCmd = pipe.Get(queueResourceVersionKey)
+		return nil
+	})
+	if err != nil && err != redis.Nil {
+		return nil, 0, fmt.Errorf("[RedisQueueRepository.GetQueueSnapshot] error reading from database: %s", err)
+	}
+	resourceVersion, err := resourceVersionCmd.Uint64()
+	if err != nil && err != redis.Nil {
+		return nil, 0, fmt.Errorf("[RedisQueueRepository.GetQueueSnapshot] error parsing resource version: %s", err)
 	}
 
 	queues := make([]queue.Queue, 0)
-	for _, v := range result {
+	for _, v := range queuesCmd.Val() {
 		apiQueue := &api.Queue{}
 		e := proto.Unmarshal([]byte(v), apiQueue)
 		if e != nil {
-			return nil, fmt.Errorf("[RedisQueueRepository.GetAllQueues] error unmarshalling queue: %s", err)
+			return nil, 0, fmt.Errorf("[RedisQueueRepository.GetQueueSnapshot] error unmarshalling queue: %s", e)
 		}
 		queue, err := queue.NewQueue(apiQueue)
 		if err != nil {
-			return nil, err
+			return nil, 0, err
 		}
 
 		queues = append(queues, queue)
 	}
-	return queues, nil
+
+	queueByName := make(map[string]queue.Queue, len(queues))
+	for _, q := range queues {
+		queueByName[q.Name] = q
+	}
+	for i, q := range queues {
+		for _, ancestor := range queue.Ancestors(q.Name, queueByName) {
+			queues[i].InheritedPermissions = append(queues[i].InheritedPermissions, ancestor.Permissions...)
+		}
+	}
+	return queues, resourceVersion, nil
 }
 
+// GetQueue returns the queue with the given name, including the permissions it inherits from its ancestors.
 func (r *RedisQueueRepository) GetQueue(name string) (queue.Queue, error) {
+	q, err := r.getQueue(name)
+	if err != nil {
+		return queue.Queue{}, err
+	}
+
+	seen := map[string]bool{q.Name: true}
+	for parentName := q.Parent; parentName != "" && !seen[parentName]; {
+		parent, err := r.getQueue(parentName)
+		if _, ok := err.(*ErrQueueNotFound); ok {
+			break
+		} else if err != nil {
+			return queue.Queue{}, err
+		}
+		q.InheritedPermissions = append(q.InheritedPermissions, parent.Permissions...)
+		seen[parentName] = true
+		parentName = parent.Parent
+	}
+	return q, nil
+}
+
+func (r *RedisQueueRepository) getQueue(name string) (queue.Queue, error) {
 	result, err := r.db.HGet(queueHashKey, name).Result()
 	if err == redis.Nil {
 		return queue.Queue{}, &ErrQueueNotFound{QueueName: name}
@@ -84,53 +192,187 @@ func (r *RedisQueueRepository) GetQueue(name string) (queue.Queue, error) {
 	return queue.NewQueue(apiQueue)
 }
 
-func (r *RedisQueueRepository) CreateQueue(queue queue.Queue) error {
-	data, err := proto.Marshal(queue.ToAPI())
-	if err != nil {
-		return fmt.Errorf("[RedisQueueRepository.CreateQueue] error marshalling queue: %s", err)
-	}
+func (r *RedisQueueRepository) CreateQueue(q queue.Queue) error {
+	return r.CreateQueues([]queue.Queue{q})
+}
 
-	// HSetNX sets a key-value pair if the key doesn't already exist.
-	// If the key exists, this is a no-op, and result is false.
-	result, err := r.db.HSetNX(queueHashKey, queue.Name, data).Result()
-	if err != nil {
-		return fmt.Errorf("[RedisQueueRepository.CreateQueue] error writing to database: %s", err)
+func (r *RedisQueueRepository) CreateQueues(queues []queue.Queue) error {
+	writes := make([]QueueWrite, len(queues))
+	for i, q := range queues {
+		writes[i] = CreateQueueWrite(q)
 	}
-	if !result {
-		return &ErrQueueAlreadyExists{QueueName: queue.Name}
+	return r.writeQueues(writes)
+}
+
+func (r *RedisQueueRepository) UpdateQueue(q queue.Queue) error {
+	return r.UpdateQueues([]queue.Queue{q})
+}
+
+func (r *RedisQueueRepository) UpdateQueues(queues []queue.Queue) error {
+	writes := make([]QueueWrite, len(queues))
+	for i, q := range queues {
+		writes[i] = ReplaceQueueWrite(q)
 	}
+	return r.writeQueues(writes)
+}
 
-	return nil
+func (r *RedisQueueRepository) SetQueueArchival(name string, archival *queue.Archival) error {
+	return r.writeQueues([]QueueWrite{SetQueueArchivalWrite(name, archival)})
 }
 
-// TODO If the queue to be updated is deleted between this method checking if the queue exists and
-// making the update, the deleted queue is re-added to Redis. There's no "update if exists"
-// operation in Redis, so we need to do this with a script or transaction.
-func (r *RedisQueueRepository) UpdateQueue(queue queue.Queue) error {
-	existsResult, err := r.db.HExists(queueHashKey, queue.Name).Result()
-	if err != nil {
-		return fmt.Errorf("[RedisQueueRepository.UpdateQueue] error reading from database: %s", err)
-	} else if !existsResult {
-		return &ErrQueueNotFound{QueueName: queue.Name}
+func (r *RedisQueueRepository) DeleteQueue(name string) error {
+	return r.writeQueues([]QueueWrite{DeleteQueueWrite(name)})
+}
+
+// writeQueues applies writes as ApplyQueueWrites does and stores the result if all of them succeed.
+func (r *RedisQueueRepository) writeQueues(writes []QueueWrite) error {
+	names := make([]string, 0, len(writes))
+	for _, w := range writes {
+		names = append(names, w.Name)
+	}
+	if len(names) == 0 {
+		return nil
 	}
 
-	data, err := proto.Marshal(queue.ToAPI())
-	if err != nil {
-		return fmt.Errorf("[RedisQueueRepository.UpdateQueue] error marshalling queue: %s", err)
+	// Queues and the resource version are read and written under an optimistic lock, such that concurrent changes
+	// are applied in sequence, a queue deleted concurrently isn't re-added, and resource versions are unique.
+	txf := func(tx *redis.Tx) error {
+		queueByName := make(map[string]*queue.Queue, len(names))
+		values, err := tx.HMGet(queueHashKey, names...).Result()
+		if err != nil {
+			return fmt.Errorf("[RedisQueueRepository.writeQueues] error reading from database: %s", err)
+		}
+		for i, value := range values {
+			data, ok := value.(string)
+			if !ok {
+				continue
+			}
+			apiQueue := &api.Queue{}
+			if err := proto.Unmarshal([]byte(data), apiQueue); err != nil {
+				return fmt.Errorf("[RedisQueueRepository.writeQueues] error unmarshalling queue: %s", err)
+			}
+			q, err := queue.NewQueue(apiQueue)
+			if err != nil {
+				return err
+			}
+			queueByName[names[i]] = &q
+		}
+		resourceVersion, err := tx.Get(queueResourceVersionKey).Uint64()
+		if err != nil && err != redis.Nil {
+			return fmt.Errorf("[RedisQueueRepository.writeQueues] error reading resource version: %s", err)
+		}
+
+		changes, err := ApplyQueueWrites(queueByName, resourceVersion, writes)
+		if err != nil || len(changes) == 0 {
+			return err
+		}
+		queueData := make(map[string][]byte, len(changes))
+		changeData := make([]redis.Z, len(changes))
+		for i, change := range changes {
+			if q := queueByName[change.Queue.Name]; q != nil {
+				if queueData[q.Name], err = proto.Marshal(q.ToAPI()); err != nil {
+					return fmt.Errorf("[RedisQueueRepository.writeQueues] error marshalling queue: %s", err)
+				}
+			} else {
+				queueData[change.Queue.Name] = nil
+			}
+			data, err := proto.Marshal(change)
+			if err != nil {
+				return fmt.Errorf("[RedisQueueRepository.writeQueues] error marshalling queue change: %s", err)
+			}
+			changeData[i] = redis.Z{Score: float64(change.ResourceVersion), Member: data}
+		}
+
+		_, err = tx.TxPipelined(func(pipe redis.Pipeliner) error {
+			for name, data := range queueData {
+				if data != nil {
+					pipe.HSet(queueHashKey, name, data)
+				} else {
+					pipe.HDel(queueHashKey, name)
+				}
+			}
+			pipe.Set(queueResourceVersionKey, changes[len(changes)-1].ResourceVersion, 0)
+			pipe.ZAdd(queueChangesKey, changeData...)
+			pipe.ZRemRangeByRank(queueChangesKey, 0, -maxQueueChangesRetained-1)
+			return nil
+		})
+		if err != nil && err != redis.TxFailedErr {
+			return fmt.Errorf("[RedisQueueRepository.writeQueues] error writing to database: %s", err)
+		}
+		return err
 	}
 
-	result := r.db.HSet(queueHashKey, queue.Name, data)
-	if err := result.Err(); err != nil {
-		return fmt.Errorf("[RedisQueueRepository.UpdateQueue] error writing to database: %s", err)
+	for retries := 0; retries < maxQueueUpdateRetries; retries++ {
+		err := r.db.Watch(txf, queueHashKey, queueResourceVersionKey)
+		if err == redis.TxFailedErr {
+			// Another queue was changed concurrently; retry.
+			continue
+		} else if err != nil {
+			return err
+		}
+		return nil
 	}
+	return fmt.Errorf("[RedisQueueRepository.writeQueues] error writing to database: too many concurrent changes to queues")
+}
 
-	return nil
+func (r *RedisQueueRepository) GetQueueChanges(fromResourceVersion uint64, limit int64, block time.Duration) ([]QueueChange, error) {
+	deadline := time.Now().Add(block)
+	for {
+		changes, err := r.getQueueChanges(fromResourceVersion, limit)
+		if err != nil || len(changes) > 0 || !time.Now().Add(queueChangesPollInterval).Before(deadline) {
+			return changes, err
+		}
+		time.Sleep(queueChangesPollInterval)
+	}
 }
 
-func (r *RedisQueueRepository) DeleteQueue(name string) error {
-	result := r.db.HDel(queueHashKey, name)
-	if err := result.Err(); err != nil {
-		return fmt.Errorf("[RedisQueueRepository.DeleteQueue] error deleting queue: %s", err)
+func (r *RedisQueueRepository) getQueueChanges(fromResourceVersion uint64, limit int64) ([]QueueChange, error) {
+	var oldestCmd *redis.ZSliceCmd
+	var resourceVersionCmd *redis.StringCmd
+	var changesCmd *redis.StringSliceCmd
+	_, err := r.db.TxPipelined(func(pipe redis.Pipeliner) error {
+		oldestCmd = pipe.ZRangeWithScores(queueChangesKey, 0, 0)
+		resourceVersionCmd = pipe.Get(queueResourceVersionKey)
+		changesCmd = pipe.ZRangeByScore(queueChangesKey, redis.ZRangeBy{
+			Min:   fmt.Sprintf("(%d", fromResourceVersion),
+			Max:   "+inf",
+			Count: limit,
+		})
+		return nil
+	})
+	if err != nil && err != redis.Nil {
+		return nil, fmt.Errorf("[RedisQueueRepository.GetQueueChanges] error reading from database: %s", err)
+	}
+	resourceVersion, err := resourceVersionCmd.Uint64()
+	if err != nil && err != redis.Nil {
+		return nil, fmt.Errorf("[RedisQueueRepository.GetQueueChanges] error parsing resource version: %s", err)
+	}
+
+	// Changes are retained as long as the oldest change retained is at most the one directly after fromResourceVersion.
+	// If there are none, changes may never have been recorded, e.g., since queues were written by an earlier version.
+	if oldest := oldestCmd.Val(); len(oldest) > 0 {
+		if uint64(oldest[0].Score) > fromResourceVersion+1 {
+			return nil, &ErrQueueChangesExpired{FromResourceVersion: fromResourceVersion}
+		}
+	} else if resourceVersion > fromResourceVersion {
+		return nil, &ErrQueueChangesExpired{FromResourceVersion: fromResourceVersion}
+	}
+
+	changes := make([]QueueChange, 0, len(changesCmd.Val()))
+	for _, data := range changesCmd.Val() {
+		apiChange := &api.QueueChange{}
+		if err := proto.Unmarshal([]byte(data), apiChange); err != nil {
+			return nil, fmt.Errorf("[RedisQueueRepository.GetQueueChanges] error unmarshalling queue change: %s", err)
+		}
+		q, err := queue.NewQueue(apiChange.Queue)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, QueueChange{
+			Type:            apiChange.Type,
+			Queue:           q,
+			ResourceVersion: apiChange.ResourceVersion,
+		})
 	}
-	return nil
+	return changes, nil
 }
@@ -0,0 +1,183 @@
+package repository
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/go-redis/redis"
+	"github.com/pkg/errors"
+	"golang.org/x/exp/maps"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	armadaresource "github.com/armadaproject/armada/internal/common/resource"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+// JobQueueResources returns the resources requested by job that count towards the resources of its queue,
+// in milli-units by resource name. Resources of which nothing is requested are omitted, as are all resources of jobs
+// without pod specs.
+func JobQueueResources(job *api.Job) map[string]int64 {
+	milliValues := make(map[string]int64)
+	if job.GetMainPodSpec() == nil {
+		return milliValues
+	}
+	for name, quantity := range job.TotalResourceRequest() {
+		if milliValue := quantity.MilliValue(); milliValue > 0 {
+			milliValues[name] = milliValue
+		}
+	}
+	return milliValues
+}
+
+// QueueResourcesFromMilliValues returns the resources with the given milli-units by resource name.
+// Negative values, which only result from counting errors, are taken as zero.
+func QueueResourcesFromMilliValues(milliValues map[string]int64) armadaresource.ComputeResources {
+	resources := make(armadaresource.ComputeResources, len(milliValues))
+	for name, milliValue := range milliValues {
+		if milliValue < 0 {
+			milliValue = 0
+		}
+		resources[name] = *resource.NewMilliQuantity(milliValue, resource.DecimalSI)
+	}
+	return resources
+}
+
+// encodeJobResources encodes the resources of job as name=milliValue pairs separated by commas, as parsed by
+// countQueueResourcesFunction.
+func encodeJobResources(job *api.Job) string {
+	milliValues := JobQueueResources(job)
+	names := maps.Keys(milliValues)
+	sort.Strings(names)
+	pairs := make([]string, len(names))
+	for i, name := range names {
+		pairs[i] = name + "=" + strconv.FormatInt(milliValues[name], 10)
+	}
+	return strings.Join(pairs, ",")
+}
+
+// GetQueueResources returns the resources requested by the queued and leased jobs of queue.
+//
+// Resources are counted at queueResourcesPrefix+queue as jobs are added, suspended, resumed, updated and deleted,
+// atomically with doing so, such that quotas can be checked without reading the jobs of the queue. Leasing jobs and
+// returning their leases doesn't change the resources counted. The resources of each job are recorded at
+// jobResourcesPrefix+queue, such that they can be subtracted by job id. Jobs stored before resources were counted
+// have no resources recorded until BackfillQueueResources records them, so they're neither counted nor subtracted.
+func (repo *RedisJobRepository) GetQueueResources(queue string) (armadaresource.ComputeResources, error) {
+	values, err := repo.db.HGetAll(queueResourcesPrefix + queue).Result()
+	if err != nil && err != redis.Nil {
+		return nil, errors.WithStack(err)
+	}
+	milliValues := make(map[string]int64, len(values))
+	for name, value := range values {
+		milliValue, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid count of resource %s of queue %s", name, queue)
+		}
+		milliValues[name] = milliValue
+	}
+	return QueueResourcesFromMilliValues(milliValues), nil
+}
+
+// BackfillQueueResources records the resources of the queued, leased and suspended jobs of the given queues stored
+// before resources were counted, counting those of queued and leased jobs towards the resources of their queues, and
+// returns the number of jobs backfilled. It may run concurrently with itself and with other operations on the jobs.
+func (repo *RedisJobRepository) BackfillQueueResources(queues []string) (int, error) {
+	backfilled := 0
+	for _, queue := range queues {
+		jobResourcesKey := jobResourcesPrefix + queue
+		var jobIds []string
+		for _, key := range []string{jobQueuePrefix + queue, jobLeasedPrefix + queue, jobSuspendedPrefix + queue} {
+			ids, err := repo.db.ZRange(key, 0, -1).Result()
+			if err != nil {
+				return backfilled, errors.WithStack(err)
+			}
+			jobIds = append(jobIds, ids...)
+		}
+		if len(jobIds) == 0 {
+			continue
+		}
+		recordedIds, err := repo.db.HKeys(jobResourcesKey).Result()
+		if err != nil {
+			return backfilled, errors.WithStack(err)
+		}
+		recorded := make(map[string]bool, len(recordedIds))
+		for _, id := range recordedIds {
+			recorded[id] = true
+		}
+		var unrecordedIds []string
+		for _, id := range jobIds {
+			if !recorded[id] {
+				unrecordedIds = append(unrecordedIds, id)
+				recorded[id] = true
+			}
+		}
+		if len(unrecordedIds) == 0 {
+			continue
+		}
+
+		jobs, err := repo.GetExistingJobsByIds(unrecordedIds)
+		if err != nil {
+			return backfilled, err
+		}
+		pipe := repo.db.Pipeline()
+		backfillJobResourcesScript.Load(pipe)
+		keys := []string{jobQueuePrefix + queue, jobLeasedPrefix + queue, jobSuspendedPrefix + queue, jobResourcesKey, queueResourcesPrefix + queue}
+		cmds := make([]*redis.Cmd, len(jobs))
+		for i, job := range jobs {
+			cmds[i] = backfillJobResourcesScript.Run(pipe, keys, job.Id, encodeJobResources(job))
+		}
+		if _, err := pipe.Exec(); err != nil {
+			return backfilled, errors.WithStack(err)
+		}
+		for _, cmd := range cmds {
+			recordedResources, err := cmd.Int()
+			if err != nil {
+				return backfilled, errors.WithStack(err)
+			}
+			backfilled += recordedResources
+		}
+	}
+	return backfilled, nil
+}
+
+// backfillJobResourcesScript records the resources of a job if it's queued, leased or suspended and has no resources
+// recorded, counting them towards the resources of its queue unless it's suspended.
+// Returns 1 if the resources of the job were recorded and 0 otherwise.
+var backfillJobResourcesScript = redis.NewScript(countQueueResourcesFunction + `
+local queueKey = KEYS[1]
+local leasedKey = KEYS[2]
+local suspendedKey = KEYS[3]
+local jobResourcesKey = KEYS[4]
+local queueResourcesKey = KEYS[5]
+
+local jobId = ARGV[1]
+local jobResources = ARGV[2]
+
+if redis.call('HEXISTS', jobResourcesKey, jobId) == 1 then
+	return 0
+end
+local counted = redis.call('ZSCORE', queueKey, jobId) or redis.call('ZSCORE', leasedKey, jobId)
+if not counted and not redis.call('ZSCORE', suspendedKey, jobId) then
+	return 0
+end
+redis.call('HSET', jobResourcesKey, jobId, jobResources)
+if counted then
+	countQueueResources(queueResourcesKey, jobResources, '')
+end
+return 1
+`)
+
+// countQueueResourcesFunction is a Lua function for scripts to add the resources of a job, as per encodeJobResources,
+// to the resources of its queue, or to subtract them if sign is '-'. jobResources may be false if the job has no
+// resources recorded.
+const countQueueResourcesFunction = `
+local function countQueueResources(queueResourcesKey, jobResources, sign)
+	if not jobResources then
+		return
+	end
+	for name, milliValue in string.gmatch(jobResources, '([^,=]+)=(%d+)') do
+		redis.call('HINCRBY', queueResourcesKey, name, sign .. milliValue)
+	end
+end
+`
@@ -0,0 +1,132 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+func TestGetQueueResources_CountsQueuedAndLeasedJobs(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		assertQueueResources(t, r, "queue1", 0, 0)
+
+		queuedJob := addTestJob(t, r, "queue1")
+		leasedJob := addLeasedJob(t, r, "queue1", "cluster1")
+		addTestJob(t, r, "queue2")
+		assertQueueResources(t, r, "queue1", 2, 1024)
+
+		// Returning leases doesn't change the resources counted.
+		_, err := r.ReturnLease("cluster1", leasedJob.Id)
+		require.NoError(t, err)
+		assertQueueResources(t, r, "queue1", 2, 1024)
+		_, err = r.TryLeaseJobs("cluster1", map[string][]string{"queue1": {leasedJob.Id}})
+		require.NoError(t, err)
+
+		// Suspended jobs aren't counted.
+		_, err = r.SuspendJobs("queue1", []string{queuedJob.Id, leasedJob.Id})
+		require.NoError(t, err)
+		assertQueueResources(t, r, "queue1", 1, 512)
+		_, err = r.ResumeJobs("queue1", []string{queuedJob.Id, leasedJob.Id})
+		require.NoError(t, err)
+		assertQueueResources(t, r, "queue1", 2, 1024)
+
+		// Deleted jobs aren't counted, including if they were suspended, and deleting them again changes nothing.
+		_, err = r.SuspendJobs("queue1", []string{queuedJob.Id})
+		require.NoError(t, err)
+		_, err = r.DeleteJobs([]*api.Job{queuedJob, leasedJob})
+		require.NoError(t, err)
+		assertQueueResources(t, r, "queue1", 0, 0)
+		_, err = r.DeleteJobs([]*api.Job{queuedJob, leasedJob})
+		require.NoError(t, err)
+		assertQueueResources(t, r, "queue1", 0, 0)
+		assertQueueResources(t, r, "queue2", 1, 512)
+	})
+}
+
+func TestGetQueueResources_CountsUpdatedResources(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		queuedJob := addTestJob(t, r, "queue1")
+		leasedJob := addLeasedJob(t, r, "queue1", "cluster1")
+		suspendedJob := addTestJob(t, r, "queue1")
+		_, err := r.SuspendJobs("queue1", []string{suspendedJob.Id})
+		require.NoError(t, err)
+
+		_, err = r.UpdateJobs([]string{queuedJob.Id, leasedJob.Id, suspendedJob.Id}, func(jobs []*api.Job) {
+			for _, job := range jobs {
+				job.PodSpec.Containers[0].Resources.Requests[v1.ResourceCPU] = resource.MustParse("2")
+			}
+		})
+		require.NoError(t, err)
+		assertQueueResources(t, r, "queue1", 4, 1024)
+
+		// The updated resources of suspended jobs are counted once they're resumed.
+		_, err = r.ResumeJobs("queue1", []string{suspendedJob.Id})
+		require.NoError(t, err)
+		assertQueueResources(t, r, "queue1", 6, 1536)
+	})
+}
+
+func TestGetQueueResources_IgnoresJobsWithoutRecordedResources(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		job := addTestJob(t, r, "queue1")
+		// Like jobs stored before resources were counted.
+		require.NoError(t, r.db.HDel(jobResourcesPrefix+"queue1", job.Id).Err())
+		require.NoError(t, r.db.Del(queueResourcesPrefix+"queue1").Err())
+
+		_, err := r.DeleteJobs([]*api.Job{job})
+		require.NoError(t, err)
+		assertQueueResources(t, r, "queue1", 0, 0)
+	})
+}
+
+func TestBackfillQueueResources_CountsJobsWithoutRecordedResources(t *testing.T) {
+	withRepository(func(r *RedisJobRepository) {
+		queuedJob := addTestJob(t, r, "queue1")
+		leasedJob := addLeasedJob(t, r, "queue1", "cluster1")
+		suspendedJob := addTestJob(t, r, "queue1")
+		_, err := r.SuspendJobs("queue1", []string{suspendedJob.Id})
+		require.NoError(t, err)
+		recordedJob := addTestJob(t, r, "queue2")
+		// Like jobs stored before resources were counted.
+		for _, job := range []*api.Job{queuedJob, leasedJob, suspendedJob} {
+			require.NoError(t, r.db.HDel(jobResourcesPrefix+"queue1", job.Id).Err())
+		}
+		require.NoError(t, r.db.Del(queueResourcesPrefix+"queue1").Err())
+
+		backfilled, err := r.BackfillQueueResources([]string{"queue1", "queue2"})
+		require.NoError(t, err)
+		assert.Equal(t, 3, backfilled)
+		assertQueueResources(t, r, "queue1", 2, 1024)
+		assertQueueResources(t, r, "queue2", 1, 512)
+
+		// Backfilling again changes nothing.
+		backfilled, err = r.BackfillQueueResources([]string{"queue1", "queue2"})
+		require.NoError(t, err)
+		assert.Equal(t, 0, backfilled)
+		assertQueueResources(t, r, "queue1", 2, 1024)
+
+		// The resources of backfilled jobs are counted as they're resumed and deleted.
+		_, err = r.ResumeJobs("queue1", []string{suspendedJob.Id})
+		require.NoError(t, err)
+		assertQueueResources(t, r, "queue1", 3, 1536)
+		_, err = r.DeleteJobs([]*api.Job{queuedJob, leasedJob, suspendedJob, recordedJob})
+		require.NoError(t, err)
+		assertQueueResources(t, r, "queue1", 0, 0)
+		assertQueueResources(t, r, "queue2", 0, 0)
+	})
+}
+
+func assertQueueResources(t *testing.T, r *RedisJobRepository, queue string, cpu int64, memoryMi int64) {
+	t.Helper()
+	resources, err := r.GetQueueResources(queue)
+	require.NoError(t, err)
+	cpuQuantity := resources["cpu"]
+	memoryQuantity := resources["memory"]
+	assert.Equal(t, cpu*1000, cpuQuantity.MilliValue(), "cpu")
+	assert.Equal(t, memoryMi*1024*1024*1000, memoryQuantity.MilliValue(), "memory")
+}
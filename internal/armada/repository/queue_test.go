@@ -0,0 +1,146 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/client/queue"
+)
+
+func TestGetQueueChanges(t *testing.T) {
+	withQueueRepository(func(r *RedisQueueRepository, db *redis.Client) {
+		require.NoError(t, r.CreateQueue(queue.Queue{Name: "a", PriorityFactor: 1}))
+		require.NoError(t, r.CreateQueue(queue.Queue{Name: "b", PriorityFactor: 1}))
+		require.NoError(t, r.UpdateQueue(queue.Queue{Name: "a", PriorityFactor: 2}))
+		require.NoError(t, r.DeleteQueue("b"))
+		// Deleting a queue that doesn't exist isn't a change.
+		require.NoError(t, r.DeleteQueue("b"))
+
+		changes, err := r.GetQueueChanges(0, 10, 0)
+		require.NoError(t, err)
+		require.Len(t, changes, 4)
+		expected := []struct {
+			changeType     api.QueueChangeType
+			name           string
+			priorityFactor queue.PriorityFactor
+		}{
+			{api.QueueChangeType_QueueCreated, "a", 1},
+			{api.QueueChangeType_QueueCreated, "b", 1},
+			{api.QueueChangeType_QueueUpdated, "a", 2},
+			{api.QueueChangeType_QueueDeleted, "b", 1},
+		}
+		for i, change := range changes {
+			assert.Equal(t, uint64(i+1), change.ResourceVersion)
+			assert.Equal(t, expected[i].changeType, change.Type)
+			assert.Equal(t, expected[i].name, change.Queue.Name)
+			assert.Equal(t, expected[i].priorityFactor, change.Queue.PriorityFactor)
+		}
+
+		changes, err = r.GetQueueChanges(2, 1, 0)
+		require.NoError(t, err)
+		require.Len(t, changes, 1)
+		assert.Equal(t, uint64(3), changes[0].ResourceVersion)
+
+		changes, err = r.GetQueueChanges(4, 10, 0)
+		require.NoError(t, err)
+		assert.Empty(t, changes)
+	})
+}
+
+func TestGetQueueChanges_Blocks(t *testing.T) {
+	withQueueRepository(func(r *RedisQueueRepository, db *redis.Client) {
+		go func() {
+			time.Sleep(100 * time.Millisecond)
+			_ = r.CreateQueue(queue.Queue{Name: "a", PriorityFactor: 1})
+		}()
+		changes, err := r.GetQueueChanges(0, 10, 10*time.Second)
+		require.NoError(t, err)
+		require.Len(t, changes, 1)
+		assert.Equal(t, "a", changes[0].Queue.Name)
+	})
+}
+
+func TestGetQueueChanges_Expired(t *testing.T) {
+	withQueueRepository(func(r *RedisQueueRepository, db *redis.Client) {
+		require.NoError(t, r.CreateQueue(queue.Queue{Name: "a", PriorityFactor: 1}))
+		require.NoError(t, r.CreateQueue(queue.Queue{Name: "b", PriorityFactor: 1}))
+		require.NoError(t, db.ZRemRangeByScore(queueChangesKey, "1", "1").Err())
+
+		_, err := r.GetQueueChanges(0, 10, 0)
+		var expiredErr *ErrQueueChangesExpired
+		assert.ErrorAs(t, err, &expiredErr)
+		changes, err := r.GetQueueChanges(1, 10, 0)
+		require.NoError(t, err)
+		assert.Len(t, changes, 1)
+
+		// Queues written before changes were recorded.
+		require.NoError(t, db.Del(queueChangesKey).Err())
+		_, err = r.GetQueueChanges(1, 10, 0)
+		assert.ErrorAs(t, err, &expiredErr)
+		changes, err = r.GetQueueChanges(2, 10, 0)
+		require.NoError(t, err)
+		assert.Empty(t, changes)
+	})
+}
+
+func TestCreateQueues_AllOrNothing(t *testing.T) {
+	withQueueRepository(func(r *RedisQueueRepository, db *redis.Client) {
+		require.NoError(t, r.CreateQueue(queue.Queue{Name: "b", PriorityFactor: 1}))
+
+		var alreadyExistsErr *ErrQueueAlreadyExists
+		err := r.CreateQueues([]queue.Queue{{Name: "a", PriorityFactor: 1}, {Name: "b", PriorityFactor: 2}})
+		assert.ErrorAs(t, err, &alreadyExistsErr)
+		queues, resourceVersion, err := r.GetQueueSnapshot()
+		require.NoError(t, err)
+		require.Len(t, queues, 1)
+		assert.Equal(t, queue.PriorityFactor(1), queues[0].PriorityFactor)
+		assert.Equal(t, uint64(1), resourceVersion)
+
+		require.NoError(t, r.CreateQueues([]queue.Queue{{Name: "a", PriorityFactor: 1}, {Name: "c", PriorityFactor: 1}}))
+		changes, err := r.GetQueueChanges(1, 10, 0)
+		require.NoError(t, err)
+		require.Len(t, changes, 2)
+		assert.Equal(t, "a", changes[0].Queue.Name)
+		assert.Equal(t, uint64(2), changes[0].ResourceVersion)
+		assert.Equal(t, "c", changes[1].Queue.Name)
+		assert.Equal(t, uint64(3), changes[1].ResourceVersion)
+	})
+}
+
+func TestUpdateQueues_AllOrNothing(t *testing.T) {
+	withQueueRepository(func(r *RedisQueueRepository, db *redis.Client) {
+		require.NoError(t, r.CreateQueues([]queue.Queue{{Name: "a", PriorityFactor: 1}, {Name: "b", PriorityFactor: 1}}))
+
+		var notFoundErr *ErrQueueNotFound
+		err := r.UpdateQueues([]queue.Queue{{Name: "a", PriorityFactor: 2}, {Name: "c", PriorityFactor: 2}})
+		assert.ErrorAs(t, err, &notFoundErr)
+		var revisionMismatchErr *ErrQueueRevisionMismatch
+		err = r.UpdateQueues([]queue.Queue{{Name: "a", PriorityFactor: 2}, {Name: "b", PriorityFactor: 2, Revision: 2}})
+		assert.ErrorAs(t, err, &revisionMismatchErr)
+		a, err := r.GetQueue("a")
+		require.NoError(t, err)
+		assert.Equal(t, queue.PriorityFactor(1), a.PriorityFactor)
+		assert.Equal(t, uint64(1), a.Revision)
+
+		require.NoError(t, r.UpdateQueues([]queue.Queue{{Name: "a", PriorityFactor: 2}, {Name: "b", PriorityFactor: 3, Revision: 1}}))
+		queues, resourceVersion, err := r.GetQueueSnapshot()
+		require.NoError(t, err)
+		assert.Equal(t, uint64(4), resourceVersion)
+		for _, q := range queues {
+			assert.Equal(t, uint64(2), q.Revision)
+		}
+	})
+}
+
+func withQueueRepository(action func(r *RedisQueueRepository, db *redis.Client)) {
+	client := redis.NewClient(&redis.Options{Addr: "localhost:6379", DB: 10})
+	defer client.FlushDB()
+	defer client.Close()
+	client.FlushDB()
+	action(NewRedisQueueRepository(client), client)
+}
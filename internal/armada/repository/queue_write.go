@@ -0,0 +1,123 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/client/queue"
+)
+
+// QueueWrite replaces the queue with the given name with the result of applying Write to the stored queue,
+// or to nil if there's none. If Write returns nil, the queue is deleted.
+// Queue repositories express all changes to queues as writes, such that they share the semantics of each change.
+type QueueWrite struct {
+	Name  string
+	Write func(existing *queue.Queue) (*queue.Queue, error)
+}
+
+// CreateQueueWrite creates q with revision 1 and the current time as creation time, or fails with ErrQueueAlreadyExists if it exists.
+func CreateQueueWrite(q queue.Queue) QueueWrite {
+	return QueueWrite{
+		Name: q.Name,
+		Write: func(existing *queue.Queue) (*queue.Queue, error) {
+			if existing != nil {
+				return nil, &ErrQueueAlreadyExists{QueueName: q.Name}
+			}
+			q.Revision = 1
+			q.Created = &queue.CreationTime{Time: time.Now().UTC()}
+			return &q, nil
+		},
+	}
+}
+
+// ReplaceQueueWrite replaces the stored queue with q as QueueRepository.UpdateQueue does.
+func ReplaceQueueWrite(q queue.Queue) QueueWrite {
+	return UpdateQueueWrite(q.Name, func(existing queue.Queue) (queue.Queue, error) {
+		if q.Revision != 0 && q.Revision != existing.Revision {
+			return queue.Queue{}, &ErrQueueRevisionMismatch{
+				QueueName:        q.Name,
+				ExpectedRevision: q.Revision,
+				ActualRevision:   existing.Revision,
+			}
+		}
+		q.Archival = existing.Archival
+		q.Created = existing.Created
+		return q, nil
+	})
+}
+
+// SetQueueArchivalWrite archives the queue with the given name, or restores it if archival is nil.
+func SetQueueArchivalWrite(name string, archival *queue.Archival) QueueWrite {
+	return UpdateQueueWrite(name, func(existing queue.Queue) (queue.Queue, error) {
+		existing.Archival = archival
+		return existing, nil
+	})
+}
+
+// UpdateQueueWrite replaces the queue with the given name with the result of applying update to it,
+// and increments the revision of the queue. Fails with ErrQueueNotFound if the queue doesn't exist.
+func UpdateQueueWrite(name string, update func(existing queue.Queue) (queue.Queue, error)) QueueWrite {
+	return QueueWrite{
+		Name: name,
+		Write: func(existing *queue.Queue) (*queue.Queue, error) {
+			if existing == nil {
+				return nil, &ErrQueueNotFound{QueueName: name}
+			}
+			updated, err := update(*existing)
+			if err != nil {
+				return nil, err
+			}
+			updated.Revision = existing.Revision + 1
+			return &updated, nil
+		},
+	}
+}
+
+// DeleteQueueWrite deletes the queue with the given name, if it exists.
+func DeleteQueueWrite(name string) QueueWrite {
+	return QueueWrite{
+		Name: name,
+		Write: func(existing *queue.Queue) (*queue.Queue, error) {
+			return nil, nil
+		},
+	}
+}
+
+// ApplyQueueWrites applies writes in order to the queues in queueByName, each to the result of the writes before it,
+// and sets the queues in queueByName to the result; deleted queues are set to nil. queueByName must contain the
+// stored queue, if any, of each queue written. Unless a queue neither existed nor is created, each write increments
+// resourceVersion and assigns it to the written queue. Returns the resulting changes, ordered by resource version,
+// or the first error returned by any write.
+func ApplyQueueWrites(queueByName map[string]*queue.Queue, resourceVersion uint64, writes []QueueWrite) ([]*api.QueueChange, error) {
+	var changes []*api.QueueChange
+	for _, w := range writes {
+		existing := queueByName[w.Name]
+		written, err := w.Write(existing)
+		if err != nil {
+			return nil, err
+		}
+		if existing == nil && written == nil {
+			continue
+		}
+		resourceVersion++
+		change := &api.QueueChange{
+			Type:            api.QueueChangeType_QueueUpdated,
+			ResourceVersion: resourceVersion,
+		}
+		if existing == nil {
+			change.Type = api.QueueChangeType_QueueCreated
+		} else if written == nil {
+			change.Type = api.QueueChangeType_QueueDeleted
+		}
+		if written != nil {
+			written.ResourceVersion = resourceVersion
+			written.InheritedPermissions = nil
+			change.Queue = written.ToAPI()
+		} else {
+			change.Queue = existing.ToAPI()
+		}
+		queueByName[w.Name] = written
+		changes = append(changes, change)
+	}
+	return changes, nil
+}
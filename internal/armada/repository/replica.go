@@ -0,0 +1,149 @@
+package repository
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/replica"
+	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/api/sequence"
+	"github.com/armadaproject/armada/pkg/client/queue"
+)
+
+// RedisReplicationLag returns a replica.LagFunc reporting how far the Redis instance db connects to lags behind its primary.
+//
+// Lag is approximated by the time since the replica last heard from its primary.
+// Since primaries ping their replicas every 10 seconds by default (repl-ping-replica-period),
+// the reported lag of replicas of idle primaries may be up to that period.
+func RedisReplicationLag(db redis.UniversalClient) replica.LagFunc {
+	return func(_ *armadacontext.Context) (time.Duration, error) {
+		info, err := db.Info("replication").Result()
+		if err != nil {
+			return 0, errors.WithStack(err)
+		}
+		return redisReplicationLagFromInfo(info)
+	}
+}
+
+func redisReplicationLagFromInfo(info string) (time.Duration, error) {
+	fields := make(map[string]string)
+	for _, line := range strings.Split(info, "\n") {
+		if k, v, ok := strings.Cut(strings.TrimSpace(line), ":"); ok {
+			fields[k] = v
+		}
+	}
+	switch fields["role"] {
+	case "master":
+		return 0, nil
+	case "slave":
+	default:
+		return 0, errors.Errorf("unknown replication role %q", fields["role"])
+	}
+	if status := fields["master_link_status"]; status != "up" {
+		return 0, errors.Errorf("replica not connected to primary: link status is %q", status)
+	}
+	seconds, err := strconv.Atoi(fields["master_last_io_seconds_ago"])
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to parse master_last_io_seconds_ago")
+	}
+	return time.Duration(seconds) * time.Second, nil
+}
+
+// ReplicaReadingQueueRepository is a QueueRepository that routes GetAllQueues and GetQueueSnapshot, used to list queues,
+// to a read replica. All other operations, including GetQueue, which the submit path relies on, go to the primary.
+type ReplicaReadingQueueRepository struct {
+	QueueRepository
+	readers *replica.Router[QueueRepository]
+}
+
+func NewReplicaReadingQueueRepository(readers *replica.Router[QueueRepository]) *ReplicaReadingQueueRepository {
+	return &ReplicaReadingQueueRepository{
+		QueueRepository: readers.Primary(),
+		readers:         readers,
+	}
+}
+
+// Primary returns the repository of the primary, for reads that must observe all previous writes,
+// e.g., to validate writes against.
+func (r *ReplicaReadingQueueRepository) Primary() QueueRepository {
+	return r.readers.Primary()
+}
+
+func (r *ReplicaReadingQueueRepository) GetAllQueues() ([]queue.Queue, error) {
+	return r.readers.Reader().GetAllQueues()
+}
+
+// GetQueueSnapshot returns a snapshot read from a replica. Since replicas apply changes in order, it's consistent,
+// but its resource version may be lower than that of a snapshot read from the primary at the same time.
+func (r *ReplicaReadingQueueRepository) GetQueueSnapshot() ([]queue.Queue, uint64, error) {
+	return r.readers.Reader().GetQueueSnapshot()
+}
+
+// ReplicaReadingJobRepository is a JobRepository that routes the reads of status APIs and metrics to a read replica.
+// Since results may be stale, it must not be used by the scheduler or the submit path.
+type ReplicaReadingJobRepository struct {
+	JobRepository
+	readers *replica.Router[JobRepository]
+}
+
+func NewReplicaReadingJobRepository(readers *replica.Router[JobRepository]) *ReplicaReadingJobRepository {
+	return &ReplicaReadingJobRepository{
+		JobRepository: readers.Primary(),
+		readers:       readers,
+	}
+}
+
+func (r *ReplicaReadingJobRepository) GetJobsByIds(ids []string) ([]*JobResult, error) {
+	return r.readers.Reader().GetJobsByIds(ids)
+}
+
+func (r *ReplicaReadingJobRepository) GetExistingJobsByIds(ids []string) ([]*api.Job, error) {
+	return r.readers.Reader().GetExistingJobsByIds(ids)
+}
+
+func (r *ReplicaReadingJobRepository) GetQueueSizes(queues []*api.Queue) ([]int64, error) {
+	return r.readers.Reader().GetQueueSizes(queues)
+}
+
+func (r *ReplicaReadingJobRepository) GetQueueJobIds(queueName string) ([]string, error) {
+	return r.readers.Reader().GetQueueJobIds(queueName)
+}
+
+func (r *ReplicaReadingJobRepository) GetQueueJobIdsPage(queueName string, offset int64, limit int64) ([]string, error) {
+	return r.readers.Reader().GetQueueJobIdsPage(queueName, offset, limit)
+}
+
+func (r *ReplicaReadingJobRepository) GetLeasedJobIds(queue string) ([]string, error) {
+	return r.readers.Reader().GetLeasedJobIds(queue)
+}
+
+func (r *ReplicaReadingJobRepository) GetJobRunInfos(jobIds []string) (map[string]*RunInfo, error) {
+	return r.readers.Reader().GetJobRunInfos(jobIds)
+}
+
+// ReplicaReadingEventRepository is an EventRepository that routes all reads to a read replica.
+// Since event ids are preserved by replication, clients may read from the primary and replica interchangeably.
+type ReplicaReadingEventRepository struct {
+	readers *replica.Router[EventRepository]
+}
+
+func NewReplicaReadingEventRepository(readers *replica.Router[EventRepository]) *ReplicaReadingEventRepository {
+	return &ReplicaReadingEventRepository{readers: readers}
+}
+
+func (r *ReplicaReadingEventRepository) CheckStreamExists(queue string, jobSetId string) (bool, error) {
+	return r.readers.Reader().CheckStreamExists(queue, jobSetId)
+}
+
+func (r *ReplicaReadingEventRepository) ReadEvents(queue, jobSetId string, lastId string, limit int64, block time.Duration) ([]*api.EventStreamMessage, *sequence.ExternalSeqNo, error) {
+	return r.readers.Reader().ReadEvents(queue, jobSetId, lastId, limit, block)
+}
+
+func (r *ReplicaReadingEventRepository) GetLastMessageId(queue, jobSetId string) (string, error) {
+	return r.readers.Reader().GetLastMessageId(queue, jobSetId)
+}
@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/replica"
+)
+
+func TestRedisReplicationLagFromInfo(t *testing.T) {
+	tests := map[string]struct {
+		info        string
+		expectedLag time.Duration
+		expectError bool
+	}{
+		"primary": {
+			info:        "# Replication\r\nrole:master\r\nconnected_slaves:1\r\n",
+			expectedLag: 0,
+		},
+		"replica": {
+			info:        "# Replication\r\nrole:slave\r\nmaster_link_status:up\r\nmaster_last_io_seconds_ago:3\r\n",
+			expectedLag: 3 * time.Second,
+		},
+		"disconnected replica": {
+			info:        "# Replication\r\nrole:slave\r\nmaster_link_status:down\r\nmaster_last_io_seconds_ago:-1\r\n",
+			expectError: true,
+		},
+		"unknown role": {
+			info:        "# Replication\r\n",
+			expectError: true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			lag, err := redisReplicationLagFromInfo(tc.info)
+			if tc.expectError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedLag, lag)
+		})
+	}
+}
+
+func TestReplicaReadingJobRepository(t *testing.T) {
+	withRepository(func(primary *RedisJobRepository) {
+		replicaClient := redis.NewClient(&redis.Options{Addr: "localhost:6379", DB: 11})
+		defer replicaClient.FlushDB()
+		defer replicaClient.Close()
+		replicaClient.FlushDB()
+
+		lag := time.Duration(0)
+		readers := replica.NewRouter[JobRepository](
+			primary,
+			NewRedisJobRepository(replicaClient),
+			func(_ *armadacontext.Context) (time.Duration, error) { return lag, nil },
+			time.Second,
+			0,
+		)
+		repo := NewReplicaReadingJobRepository(readers)
+
+		// Writes go to the primary, whereas reads go to the replica, which hasn't caught up yet.
+		job := addTestJob(t, primary, "queue")
+		jobs, err := repo.GetExistingJobsByIds([]string{job.Id})
+		require.NoError(t, err)
+		assert.Empty(t, jobs)
+
+		// Reads go to the primary while the replica lags behind by too much.
+		lag = time.Minute
+		jobs, err = repo.GetExistingJobsByIds([]string{job.Id})
+		require.NoError(t, err)
+		require.Len(t, jobs, 1)
+		assert.Equal(t, job.Id, jobs[0].Id)
+	})
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/go-redis/redis"
 	"github.com/gogo/protobuf/proto"
@@ -9,11 +10,17 @@ import (
 	"github.com/armadaproject/armada/pkg/api"
 )
 
-const clusterSchedulingInfoReportKey = "Cluster:SchedulingInfo"
+const (
+	clusterSchedulingInfoReportKey     = "Cluster:SchedulingInfo"
+	clusterSchedulingInfoLastUpdateKey = "Cluster:SchedulingInfo:LastUpdate"
+)
 
 type SchedulingInfoRepository interface {
 	GetClusterSchedulingInfo() (map[string]*api.ClusterSchedulingInfoReport, error)
 	UpdateClusterSchedulingInfo(report *api.ClusterSchedulingInfoReport) error
+	// GetLastUpdateTime returns the report time of the most recently stored report of any cluster,
+	// or the zero time if no cluster has reported.
+	GetLastUpdateTime() (time.Time, error)
 }
 
 type RedisSchedulingInfoRepository struct {
@@ -48,10 +55,36 @@ func (r *RedisSchedulingInfoRepository) UpdateClusterSchedulingInfo(report *api.
 		return fmt.Errorf("[RedisSchedulingInfoRepository.UpdateClusterSchedulingInfo] error marshalling: %s", err)
 	}
 
-	_, err = r.db.HSet(clusterSchedulingInfoReportKey, report.ClusterId, data).Result()
+	pipe := r.db.TxPipeline()
+	pipe.HSet(clusterSchedulingInfoReportKey, report.ClusterId, data)
+	updateLastUpdateTimeScript.Load(pipe)
+	updateLastUpdateTimeScript.Run(pipe, []string{clusterSchedulingInfoLastUpdateKey}, report.ReportTime.UnixNano())
+	_, err = pipe.Exec()
 	if err != nil {
 		return fmt.Errorf("[RedisSchedulingInfoRepository.UpdateClusterSchedulingInfo] error writing to database: %s", err)
 	}
 
 	return nil
 }
+
+func (r *RedisSchedulingInfoRepository) GetLastUpdateTime() (time.Time, error) {
+	nanos, err := r.db.Get(clusterSchedulingInfoLastUpdateKey).Int64()
+	if err == redis.Nil {
+		return time.Time{}, nil
+	} else if err != nil {
+		return time.Time{}, fmt.Errorf("[RedisSchedulingInfoRepository.GetLastUpdateTime] error reading from database: %s", err)
+	}
+	return time.Unix(0, nanos), nil
+}
+
+// updateLastUpdateTimeScript sets the last update time to ARGV[1] unless a later time is stored, such that a delayed
+// report of one cluster doesn't make the reports of others appear stale. Times are compared as decimal strings of
+// nanoseconds rather than as Lua numbers, which can't represent them exactly.
+var updateLastUpdateTimeScript = redis.NewScript(`
+local stored = redis.call('GET', KEYS[1])
+local new = ARGV[1]
+if not stored or #new > #stored or (#new == #stored and new > stored) then
+	redis.call('SET', KEYS[1], new)
+end
+return 0
+`)
@@ -0,0 +1,137 @@
+package repository
+
+import (
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/pkg/errors"
+)
+
+const (
+	schedulingRoundPrefix       = "SchedulingRound:"          // {executorId} - map with the outcome of the most recent round of the executor
+	schedulingRoundExecutorsKey = "SchedulingRound:Executors" //              - set of executors with a round stored
+
+	schedulingRoundStartedField           = "started"
+	schedulingRoundTerminationReasonField = "terminationReason"
+	schedulingRoundQueuePrefix            = "queue:"
+	schedulingRoundScheduledPrefix        = "scheduled:"
+	schedulingRoundUnschedulablePrefix    = "unschedulable:"
+
+	// Rounds of executors that stopped leasing jobs are forgotten after this long.
+	schedulingRoundRetention = 24 * time.Hour
+)
+
+// SchedulingRound is the outcome of a scheduling round of an executor, as far as needed to explain why jobs are waiting.
+type SchedulingRound struct {
+	ExecutorId        string
+	Started           time.Time
+	TerminationReason string
+	// Queues considered by the round.
+	Queues []string
+	// Ids of the jobs scheduled by the round.
+	ScheduledJobIds []string
+	// Reason each job the round considered but couldn't schedule wasn't scheduled, indexed by job id.
+	UnschedulableReasons map[string]string
+}
+
+// SchedulingRoundRepository stores the most recent scheduling round of each executor, such that any server replica
+// can explain why jobs are waiting, regardless of which replica ran the round.
+type SchedulingRoundRepository interface {
+	// StoreSchedulingRound replaces the round stored for the executor of round.
+	StoreSchedulingRound(round *SchedulingRound) error
+	// GetSchedulingRoundsOfJob returns the most recent round of each executor, sorted by executor id, restricted to
+	// the given job and its queue; i.e., Queues contains at most queue and ScheduledJobIds and UnschedulableReasons
+	// contain at most jobId.
+	GetSchedulingRoundsOfJob(queue string, jobId string) ([]*SchedulingRound, error)
+}
+
+type RedisSchedulingRoundRepository struct {
+	db redis.UniversalClient
+}
+
+func NewRedisSchedulingRoundRepository(db redis.UniversalClient) *RedisSchedulingRoundRepository {
+	return &RedisSchedulingRoundRepository{db: db}
+}
+
+func (r *RedisSchedulingRoundRepository) StoreSchedulingRound(round *SchedulingRound) error {
+	fields := make(map[string]interface{}, 2+len(round.Queues)+len(round.ScheduledJobIds)+len(round.UnschedulableReasons))
+	fields[schedulingRoundStartedField] = round.Started.UnixNano()
+	fields[schedulingRoundTerminationReasonField] = round.TerminationReason
+	for _, queue := range round.Queues {
+		fields[schedulingRoundQueuePrefix+queue] = ""
+	}
+	for _, jobId := range round.ScheduledJobIds {
+		fields[schedulingRoundScheduledPrefix+jobId] = ""
+	}
+	for jobId, reason := range round.UnschedulableReasons {
+		fields[schedulingRoundUnschedulablePrefix+jobId] = reason
+	}
+
+	key := schedulingRoundPrefix + round.ExecutorId
+	pipe := r.db.TxPipeline()
+	pipe.Del(key)
+	pipe.HMSet(key, fields)
+	pipe.Expire(key, schedulingRoundRetention)
+	pipe.SAdd(schedulingRoundExecutorsKey, round.ExecutorId)
+	if _, err := pipe.Exec(); err != nil {
+		return errors.Wrapf(err, "[RedisSchedulingRoundRepository.StoreSchedulingRound] error storing round of executor %s", round.ExecutorId)
+	}
+	return nil
+}
+
+func (r *RedisSchedulingRoundRepository) GetSchedulingRoundsOfJob(queue string, jobId string) ([]*SchedulingRound, error) {
+	executorIds, err := r.db.SMembers(schedulingRoundExecutorsKey).Result()
+	if err != nil {
+		return nil, errors.Wrap(err, "[RedisSchedulingRoundRepository.GetSchedulingRoundsOfJob] error reading executors")
+	}
+	sort.Strings(executorIds)
+
+	pipe := r.db.Pipeline()
+	cmds := make([]*redis.SliceCmd, len(executorIds))
+	for i, executorId := range executorIds {
+		cmds[i] = pipe.HMGet(
+			schedulingRoundPrefix+executorId,
+			schedulingRoundStartedField,
+			schedulingRoundTerminationReasonField,
+			schedulingRoundQueuePrefix+queue,
+			schedulingRoundScheduledPrefix+jobId,
+			schedulingRoundUnschedulablePrefix+jobId,
+		)
+	}
+	if _, err := pipe.Exec(); err != nil {
+		return nil, errors.Wrap(err, "[RedisSchedulingRoundRepository.GetSchedulingRoundsOfJob] error reading rounds")
+	}
+
+	var rounds []*SchedulingRound
+	for i, cmd := range cmds {
+		values := cmd.Val()
+		started, ok := values[0].(string)
+		if !ok {
+			// The round has expired.
+			continue
+		}
+		startedNanos, err := strconv.ParseInt(started, 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "[RedisSchedulingRoundRepository.GetSchedulingRoundsOfJob] invalid round of executor %s", executorIds[i])
+		}
+		round := &SchedulingRound{
+			ExecutorId:           executorIds[i],
+			Started:              time.Unix(0, startedNanos),
+			UnschedulableReasons: map[string]string{},
+		}
+		round.TerminationReason, _ = values[1].(string)
+		if _, ok := values[2].(string); ok {
+			round.Queues = []string{queue}
+		}
+		if _, ok := values[3].(string); ok {
+			round.ScheduledJobIds = []string{jobId}
+		}
+		if reason, ok := values[4].(string); ok {
+			round.UnschedulableReasons[jobId] = reason
+		}
+		rounds = append(rounds, round)
+	}
+	return rounds, nil
+}
@@ -0,0 +1,59 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchedulingRounds(t *testing.T) {
+	withSchedulingRoundRepository(func(r *RedisSchedulingRoundRepository) {
+		started := time.Unix(0, 1000)
+		require.NoError(t, r.StoreSchedulingRound(&SchedulingRound{
+			ExecutorId:           "foo",
+			Started:              started,
+			TerminationReason:    "done",
+			Queues:               []string{"A", "B"},
+			ScheduledJobIds:      []string{"job1"},
+			UnschedulableReasons: map[string]string{"job2": "does not fit", "job3": "quota"},
+		}))
+		require.NoError(t, r.StoreSchedulingRound(&SchedulingRound{ExecutorId: "bar", Started: started}))
+
+		rounds, err := r.GetSchedulingRoundsOfJob("A", "job2")
+		require.NoError(t, err)
+		assert.Equal(
+			t,
+			[]*SchedulingRound{
+				{ExecutorId: "bar", Started: started, UnschedulableReasons: map[string]string{}},
+				{
+					ExecutorId:           "foo",
+					Started:              started,
+					TerminationReason:    "done",
+					Queues:               []string{"A"},
+					UnschedulableReasons: map[string]string{"job2": "does not fit"},
+				},
+			},
+			rounds,
+		)
+
+		// Storing a round replaces the previous round of the executor.
+		require.NoError(t, r.StoreSchedulingRound(&SchedulingRound{ExecutorId: "foo", Started: started, ScheduledJobIds: []string{"job2"}}))
+		rounds, err = r.GetSchedulingRoundsOfJob("A", "job2")
+		require.NoError(t, err)
+		require.Len(t, rounds, 2)
+		assert.Empty(t, rounds[1].Queues)
+		assert.Empty(t, rounds[1].UnschedulableReasons)
+		assert.Equal(t, []string{"job2"}, rounds[1].ScheduledJobIds)
+	})
+}
+
+func withSchedulingRoundRepository(action func(r *RedisSchedulingRoundRepository)) {
+	client := redis.NewClient(&redis.Options{Addr: "localhost:6379", DB: 10})
+	defer client.FlushDB()
+	defer client.Close()
+	client.FlushDB()
+	action(NewRedisSchedulingRoundRepository(client))
+}
@@ -0,0 +1,64 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+func TestSchedulingInfoLastUpdateTime(t *testing.T) {
+	withSchedulingInfoRepository(func(r *RedisSchedulingInfoRepository) {
+		lastUpdate, err := r.GetLastUpdateTime()
+		require.NoError(t, err)
+		assert.True(t, lastUpdate.IsZero())
+
+		reportTime := time.Now().Add(-time.Minute)
+		err = r.UpdateClusterSchedulingInfo(&api.ClusterSchedulingInfoReport{ClusterId: "a", ReportTime: reportTime})
+		require.NoError(t, err)
+
+		lastUpdate, err = r.GetLastUpdateTime()
+		require.NoError(t, err)
+		assert.True(t, reportTime.Equal(lastUpdate))
+
+		reports, err := r.GetClusterSchedulingInfo()
+		require.NoError(t, err)
+		assert.Contains(t, reports, "a")
+	})
+}
+
+func TestSchedulingInfoLastUpdateTime_IsNotMovedBackByDelayedReports(t *testing.T) {
+	withSchedulingInfoRepository(func(r *RedisSchedulingInfoRepository) {
+		reportTime := time.Now()
+		err := r.UpdateClusterSchedulingInfo(&api.ClusterSchedulingInfoReport{ClusterId: "a", ReportTime: reportTime})
+		require.NoError(t, err)
+		err = r.UpdateClusterSchedulingInfo(&api.ClusterSchedulingInfoReport{ClusterId: "b", ReportTime: reportTime.Add(-time.Hour)})
+		require.NoError(t, err)
+
+		lastUpdate, err := r.GetLastUpdateTime()
+		require.NoError(t, err)
+		assert.True(t, reportTime.Equal(lastUpdate))
+		reports, err := r.GetClusterSchedulingInfo()
+		require.NoError(t, err)
+		assert.Contains(t, reports, "b")
+
+		// Times differing by a nanosecond are ordered correctly.
+		err = r.UpdateClusterSchedulingInfo(&api.ClusterSchedulingInfoReport{ClusterId: "b", ReportTime: reportTime.Add(time.Nanosecond)})
+		require.NoError(t, err)
+		lastUpdate, err = r.GetLastUpdateTime()
+		require.NoError(t, err)
+		assert.True(t, reportTime.Add(time.Nanosecond).Equal(lastUpdate))
+	})
+}
+
+func withSchedulingInfoRepository(action func(r *RedisSchedulingInfoRepository)) {
+	client := redis.NewClient(&redis.Options{Addr: "localhost:6379", DB: 10})
+	defer client.FlushDB()
+	defer client.Close()
+	client.FlushDB()
+	action(NewRedisSchedulingInfoRepository(client))
+}
@@ -0,0 +1,232 @@
+package repository
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/go-redis/redis"
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/armadaproject/armada/internal/common/compress"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+// Field of the compressed pod specs in the map storing shared pod specs.
+// The other fields of the map are the ids of the jobs referencing the pod specs. Also hardcoded in addJobScript.
+const sharedPodSpecsField = "podSpecs"
+
+// sharesPodSpecs returns true if the pod specs of job are to be stored once for all jobs with identical pod specs.
+// The pod specs of members of job arrays are always shared, since they're equal but for the index of each member.
+func (repo *RedisJobRepository) sharesPodSpecs(job *api.Job) bool {
+	if job.PodSpec == nil && len(job.PodSpecs) == 0 {
+		return false
+	}
+	return repo.deduplicatePodSpecs || job.JobArrayId != ""
+}
+
+// marshalJob marshals job for storing. The pod specs of jobs sharing their pod specs aren't stored with the job,
+// since they're stored once as per sharePodSpecs; jobs whose pod specs are updated stop sharing them first,
+// as per unshareModifiedPodSpecs.
+func (repo *RedisJobRepository) marshalJob(job *api.Job) ([]byte, error) {
+	if job.PodSpecsHash == "" {
+		return repo.codec.Marshal(job)
+	}
+	storedJob := *job
+	storedJob.PodSpec = nil
+	storedJob.PodSpecs = nil
+	return repo.codec.Marshal(&storedJob)
+}
+
+// sharePodSpecs sets the hash under which the pod specs of each of jobs sharing its pod specs are stored, and
+// returns the compressed pod specs by hash. The pod specs are stored, unless already, as the jobs referencing them
+// are, by addJob, such that they can't be released in between.
+func (repo *RedisJobRepository) sharePodSpecs(jobs []*api.Job) (map[string][]byte, error) {
+	compressedByHash := make(map[string][]byte)
+	compressor := compress.NewThreadSafeZlibCompressor(0)
+	for _, job := range jobs {
+		job.PodSpecsHash = ""
+		if !repo.sharesPodSpecs(job) {
+			continue
+		}
+		podSpecData, err := sharedPodSpecData(job)
+		if err != nil {
+			return nil, err
+		}
+		hash := sha256.Sum256(podSpecData)
+		job.PodSpecsHash = hex.EncodeToString(hash[:])
+		if _, ok := compressedByHash[job.PodSpecsHash]; ok {
+			continue
+		}
+		compressed, err := compressor.Compress(podSpecData)
+		if err != nil {
+			return nil, errors.WithMessagef(err, "error compressing pod specs of job %s", job.Id)
+		}
+		compressedByHash[job.PodSpecsHash] = compressed
+	}
+	return compressedByHash, nil
+}
+
+// sharedPodSpecData returns the marshalled pod specs of job as shared with other jobs.
+func sharedPodSpecData(job *api.Job) ([]byte, error) {
+	podSpecs := &api.Job{PodSpec: job.PodSpec, PodSpecs: job.PodSpecs}
+	if job.JobArrayId != "" && job.JobArrayIndex != 0 {
+		// The pod specs of all members of an array are shared as those of the first; the index is set when read.
+		podSpecs = &api.Job{PodSpec: jobArrayMemberPodSpec(job.PodSpec, 0)}
+		for _, podSpec := range job.PodSpecs {
+			podSpecs.PodSpecs = append(podSpecs.PodSpecs, jobArrayMemberPodSpec(podSpec, 0))
+		}
+	}
+	podSpecData, err := proto.Marshal(podSpecs)
+	return podSpecData, errors.WithStack(err)
+}
+
+// sharedPodSpecsSnapshot returns the marshalled pod specs of each of jobs sharing its pod specs, by job id.
+func sharedPodSpecsSnapshot(jobs []*api.Job) (map[string][]byte, error) {
+	podSpecDataByJobId := make(map[string][]byte)
+	for _, job := range jobs {
+		if job.PodSpecsHash == "" {
+			continue
+		}
+		podSpecData, err := proto.Marshal(&api.Job{PodSpec: job.PodSpec, PodSpecs: job.PodSpecs})
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		podSpecDataByJobId[job.Id] = podSpecData
+	}
+	return podSpecDataByJobId, nil
+}
+
+// unshareModifiedPodSpecs stops those of jobs whose pod specs no longer equal podSpecDataByJobId from sharing them,
+// such that their pod specs are stored with them from now on.
+// Returns the hash of the pod specs each such job no longer references, by job id.
+func unshareModifiedPodSpecs(jobs []*api.Job, podSpecDataByJobId map[string][]byte) (map[string]string, error) {
+	hashByJobId := make(map[string]string)
+	for _, job := range jobs {
+		if job.PodSpecsHash == "" {
+			continue
+		}
+		podSpecData, err := proto.Marshal(&api.Job{PodSpec: job.PodSpec, PodSpecs: job.PodSpecs})
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		if !bytes.Equal(podSpecData, podSpecDataByJobId[job.Id]) {
+			hashByJobId[job.Id] = job.PodSpecsHash
+			job.PodSpecsHash = ""
+		}
+	}
+	return hashByJobId, nil
+}
+
+// releaseSharedPodSpecs adds a command to db removing the references of the given jobs to the pod specs with the
+// given hash, and deleting the pod specs if no references are left. Removing references that don't exist is a no-op.
+func releaseSharedPodSpecs(db redis.Cmdable, hash string, jobIds ...string) *redis.Cmd {
+	args := make([]interface{}, len(jobIds))
+	for i, jobId := range jobIds {
+		args[i] = jobId
+	}
+	return releaseSharedPodSpecsScript.EvalSha(db, []string{sharedPodSpecsPrefix + hash}, args...)
+}
+
+var releaseSharedPodSpecsScript = redis.NewScript(`
+local podSpecsKey = KEYS[1]
+
+for i = 1, #ARGV do
+	redis.call('HDEL', podSpecsKey, ARGV[i])
+end
+local fields = redis.call('HLEN', podSpecsKey)
+if fields <= 1 then
+	redis.call('DEL', podSpecsKey)
+end
+return fields
+`)
+
+// loadSharedPodSpecs restores the pod specs of those jobs among results sharing their pod specs,
+// setting the index of members of job arrays.
+// Failing to restore the pod specs of a job is recorded in the error of its result.
+func (repo *RedisJobRepository) loadSharedPodSpecs(results []*JobResult) error {
+	cmdsByHash := make(map[string]*redis.StringCmd)
+	pipe := repo.db.Pipeline()
+	for _, result := range results {
+		if result.Job == nil || result.Job.PodSpecsHash == "" {
+			continue
+		}
+		if _, ok := cmdsByHash[result.Job.PodSpecsHash]; !ok {
+			cmdsByHash[result.Job.PodSpecsHash] = pipe.HGet(sharedPodSpecsPrefix+result.Job.PodSpecsHash, sharedPodSpecsField)
+		}
+	}
+	if len(cmdsByHash) == 0 {
+		return nil
+	}
+	if _, err := pipe.Exec(); err != nil && err != redis.Nil {
+		return errors.WithStack(err)
+	}
+
+	decompressor := compress.NewZlibDecompressor()
+	podSpecsByHash := make(map[string]*api.Job, len(cmdsByHash))
+	errByHash := make(map[string]error)
+	for hash, cmd := range cmdsByHash {
+		compressed, err := cmd.Bytes()
+		if err == redis.Nil {
+			errByHash[hash] = errors.Errorf("shared pod specs %s not found", hash)
+			continue
+		} else if err != nil {
+			errByHash[hash] = errors.WithMessagef(err, "error reading shared pod specs %s", hash)
+			continue
+		}
+		podSpecData, err := decompressor.Decompress(compressed)
+		if err != nil {
+			errByHash[hash] = errors.WithMessagef(err, "error decompressing shared pod specs %s", hash)
+			continue
+		}
+		podSpecs := &api.Job{}
+		if err := proto.Unmarshal(podSpecData, podSpecs); err != nil {
+			errByHash[hash] = errors.WithStack(err)
+			continue
+		}
+		podSpecsByHash[hash] = podSpecs
+	}
+
+	for _, result := range results {
+		job := result.Job
+		if job == nil || job.PodSpecsHash == "" {
+			continue
+		}
+		if err := errByHash[job.PodSpecsHash]; err != nil {
+			result.Job = nil
+			result.Error = errors.WithMessagef(err, "job id %s", result.JobId)
+			continue
+		}
+		// Each job gets a copy of the pod specs, since jobs are mutated in-place during scheduling.
+		podSpecs := podSpecsByHash[job.PodSpecsHash]
+		job.PodSpec = sharedPodSpec(podSpecs.PodSpec, job)
+		job.PodSpecs = nil
+		for _, podSpec := range podSpecs.PodSpecs {
+			job.PodSpecs = append(job.PodSpecs, sharedPodSpec(podSpec, job))
+		}
+		addRequiredNodeLabels(job)
+	}
+	return nil
+}
+
+// sharedPodSpec returns a copy of podSpec as shared by job.
+func sharedPodSpec(podSpec *v1.PodSpec, job *api.Job) *v1.PodSpec {
+	if job.JobArrayId != "" {
+		return jobArrayMemberPodSpec(podSpec, job.JobArrayIndex)
+	}
+	return podSpec.DeepCopy()
+}
+
+func jobArrayMemberPodSpec(podSpec *v1.PodSpec, index uint32) *v1.PodSpec {
+	if podSpec == nil {
+		return nil
+	}
+	podSpec = podSpec.DeepCopy()
+	api.SetJobArrayIndexEnvVar(podSpec, index)
+	if podSpec.NodeSelector == nil {
+		podSpec.NodeSelector = make(map[string]string)
+	}
+	return podSpec
+}
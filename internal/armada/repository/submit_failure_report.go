@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+const (
+	submitFailureReportPrefix = "SubmitFailureReport:"
+	submitFailureReportOwner  = "owner"
+	submitFailureReportData   = "report"
+)
+
+type ErrSubmitFailureReportNotFound struct {
+	Id string
+}
+
+func (err *ErrSubmitFailureReportNotFound) Error() string {
+	return fmt.Sprintf("could not find submit failure report %q", err.Id)
+}
+
+// SubmitFailureReportRepository stores the errors of all jobs of rejected submissions,
+// such that they can be retrieved even if too many to be returned in the status of the submission.
+type SubmitFailureReportRepository interface {
+	// StoreSubmitFailureReport stores report, which is deleted after retention.
+	StoreSubmitFailureReport(id string, owner string, report *api.JobSubmitResponse, retention time.Duration) error
+	// GetSubmitFailureReport returns the report with the given id, along with the principal that made the submission.
+	GetSubmitFailureReport(id string) (*api.JobSubmitResponse, string, error)
+}
+
+type RedisSubmitFailureReportRepository struct {
+	db redis.UniversalClient
+}
+
+func NewRedisSubmitFailureReportRepository(db redis.UniversalClient) *RedisSubmitFailureReportRepository {
+	return &RedisSubmitFailureReportRepository{db: db}
+}
+
+func (r *RedisSubmitFailureReportRepository) StoreSubmitFailureReport(id string, owner string, report *api.JobSubmitResponse, retention time.Duration) error {
+	data, err := proto.Marshal(report)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	key := submitFailureReportPrefix + id
+	pipe := r.db.TxPipeline()
+	pipe.HMSet(key, map[string]interface{}{submitFailureReportOwner: owner, submitFailureReportData: data})
+	pipe.Expire(key, retention)
+	if _, err := pipe.Exec(); err != nil {
+		return errors.Wrapf(err, "[RedisSubmitFailureReportRepository.StoreSubmitFailureReport] error storing report %s", id)
+	}
+	return nil
+}
+
+func (r *RedisSubmitFailureReportRepository) GetSubmitFailureReport(id string) (*api.JobSubmitResponse, string, error) {
+	values, err := r.db.HMGet(submitFailureReportPrefix+id, submitFailureReportOwner, submitFailureReportData).Result()
+	if err != nil {
+		return nil, "", errors.Wrapf(err, "[RedisSubmitFailureReportRepository.GetSubmitFailureReport] error reading report %s", id)
+	}
+	owner, ownerOk := values[0].(string)
+	data, dataOk := values[1].(string)
+	if !ownerOk || !dataOk {
+		return nil, "", &ErrSubmitFailureReportNotFound{Id: id}
+	}
+	report := &api.JobSubmitResponse{}
+	if err := proto.Unmarshal([]byte(data), report); err != nil {
+		return nil, "", errors.Wrapf(err, "[RedisSubmitFailureReportRepository.GetSubmitFailureReport] error unmarshalling report %s", id)
+	}
+	return report, owner, nil
+}
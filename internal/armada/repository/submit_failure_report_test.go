@@ -0,0 +1,46 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+func TestSubmitFailureReport_StoreAndGet(t *testing.T) {
+	withSubmitFailureReportRepository(func(r *RedisSubmitFailureReportRepository) {
+		_, _, err := r.GetSubmitFailureReport("report")
+		var notFound *ErrSubmitFailureReportNotFound
+		assert.ErrorAs(t, err, &notFound)
+
+		report := &api.JobSubmitResponse{
+			JobResponseItems: []*api.JobSubmitResponseItem{
+				api.NewFailedJobSubmitResponseItem("job-1", api.JobSubmitError_INVALID_POD_SPEC, "podSpec", "invalid"),
+				api.NewFailedJobSubmitResponseItem("job-2", api.JobSubmitError_UNSCHEDULABLE, "", "unschedulable"),
+			},
+		}
+		require.NoError(t, r.StoreSubmitFailureReport("report", "alice", report, time.Hour))
+
+		stored, owner, err := r.GetSubmitFailureReport("report")
+		require.NoError(t, err)
+		assert.Equal(t, report, stored)
+		assert.Equal(t, "alice", owner)
+
+		ttl, err := r.db.TTL(submitFailureReportPrefix + "report").Result()
+		require.NoError(t, err)
+		assert.Greater(t, ttl, time.Duration(0))
+		assert.LessOrEqual(t, ttl, time.Hour)
+	})
+}
+
+func withSubmitFailureReportRepository(action func(r *RedisSubmitFailureReportRepository)) {
+	client := redis.NewClient(&redis.Options{Addr: "localhost:6379", DB: 10})
+	defer client.FlushDB()
+	defer client.Close()
+	client.FlushDB()
+	action(NewRedisSubmitFailureReportRepository(client))
+}
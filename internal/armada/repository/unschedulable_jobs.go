@@ -0,0 +1,130 @@
+package repository
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/internal/common/util"
+)
+
+const (
+	unschedulableJobPrefix     = "UnschedulableJob:"        // set for jobs reported as unschedulable, by job id
+	unschedulableJobsCursorKey = "UnschedulableJobs:Cursor" // offset of the next queued job to check, by queue
+	unschedulableJobsLockKey   = "UnschedulableJobs:Lock"   // held while checking jobs
+	unschedulableJobsLockTtl   = 5 * time.Minute            // must outlive checking one page of jobs of each queue
+)
+
+// UnschedulableJobRepository stores which queued jobs were reported as unschedulable, such that each is reported once,
+// along with the position up to which the queued jobs of each queue have been checked.
+type UnschedulableJobRepository interface {
+	// ProcessUnschedulableJobs calls process. Only one caller processes jobs at a time;
+	// others return false immediately without calling process.
+	ProcessUnschedulableJobs(process func()) (bool, error)
+	// GetReportedUnschedulableJobs returns the subset of the given job ids that are marked as reported.
+	GetReportedUnschedulableJobs(jobIds []string) (map[string]bool, error)
+	// MarkUnschedulableJobsReported marks the given jobs as reported. Marks expire after ttl, such that the marks of
+	// jobs that are no longer queued don't accumulate; jobs still unschedulable must be marked again before then.
+	MarkUnschedulableJobsReported(jobIds []string, ttl time.Duration) error
+	// UnmarkUnschedulableJobsReported removes the marks of the given jobs, e.g., since they became schedulable.
+	UnmarkUnschedulableJobsReported(jobIds []string) error
+	// GetUnschedulableJobsCursor returns the offset of the next queued job of the queue to check.
+	GetUnschedulableJobsCursor(queue string) (int64, error)
+	// SetUnschedulableJobsCursor stores the offset of the next queued job of the queue to check.
+	SetUnschedulableJobsCursor(queue string, offset int64) error
+}
+
+type RedisUnschedulableJobRepository struct {
+	db redis.UniversalClient
+}
+
+func NewRedisUnschedulableJobRepository(db redis.UniversalClient) *RedisUnschedulableJobRepository {
+	return &RedisUnschedulableJobRepository{db: db}
+}
+
+func (r *RedisUnschedulableJobRepository) ProcessUnschedulableJobs(process func()) (bool, error) {
+	token := util.NewULID()
+	acquired, err := r.db.SetNX(unschedulableJobsLockKey, token, unschedulableJobsLockTtl).Result()
+	if err != nil {
+		return false, errors.Wrap(err, "[RedisUnschedulableJobRepository.ProcessUnschedulableJobs] error acquiring lock")
+	} else if !acquired {
+		return false, nil
+	}
+	// Failing to release the lock only delays checking jobs until it expires.
+	defer releaseLockScript.Run(r.db, []string{unschedulableJobsLockKey}, token)
+
+	process()
+	return true, nil
+}
+
+func (r *RedisUnschedulableJobRepository) GetReportedUnschedulableJobs(jobIds []string) (map[string]bool, error) {
+	reported := make(map[string]bool)
+	if len(jobIds) == 0 {
+		return reported, nil
+	}
+	keys := make([]string, len(jobIds))
+	for i, jobId := range jobIds {
+		keys[i] = unschedulableJobPrefix + jobId
+	}
+	values, err := r.db.MGet(keys...).Result()
+	if err != nil {
+		return nil, errors.Wrap(err, "[RedisUnschedulableJobRepository.GetReportedUnschedulableJobs] error getting marks")
+	}
+	for i, value := range values {
+		if value != nil {
+			reported[jobIds[i]] = true
+		}
+	}
+	return reported, nil
+}
+
+func (r *RedisUnschedulableJobRepository) MarkUnschedulableJobsReported(jobIds []string, ttl time.Duration) error {
+	if len(jobIds) == 0 {
+		return nil
+	}
+	pipe := r.db.Pipeline()
+	for _, jobId := range jobIds {
+		pipe.Set(unschedulableJobPrefix+jobId, 1, ttl)
+	}
+	if _, err := pipe.Exec(); err != nil {
+		return errors.Wrap(err, "[RedisUnschedulableJobRepository.MarkUnschedulableJobsReported] error storing marks")
+	}
+	return nil
+}
+
+func (r *RedisUnschedulableJobRepository) UnmarkUnschedulableJobsReported(jobIds []string) error {
+	if len(jobIds) == 0 {
+		return nil
+	}
+	keys := make([]string, len(jobIds))
+	for i, jobId := range jobIds {
+		keys[i] = unschedulableJobPrefix + jobId
+	}
+	if err := r.db.Del(keys...).Err(); err != nil {
+		return errors.Wrap(err, "[RedisUnschedulableJobRepository.UnmarkUnschedulableJobsReported] error deleting marks")
+	}
+	return nil
+}
+
+func (r *RedisUnschedulableJobRepository) GetUnschedulableJobsCursor(queue string) (int64, error) {
+	value, err := r.db.HGet(unschedulableJobsCursorKey, queue).Result()
+	if err == redis.Nil {
+		return 0, nil
+	} else if err != nil {
+		return 0, errors.Wrapf(err, "[RedisUnschedulableJobRepository.GetUnschedulableJobsCursor] error getting cursor of queue %s", queue)
+	}
+	offset, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, errors.Wrapf(err, "[RedisUnschedulableJobRepository.GetUnschedulableJobsCursor] error parsing cursor of queue %s", queue)
+	}
+	return offset, nil
+}
+
+func (r *RedisUnschedulableJobRepository) SetUnschedulableJobsCursor(queue string, offset int64) error {
+	if err := r.db.HSet(unschedulableJobsCursorKey, queue, offset).Err(); err != nil {
+		return errors.Wrapf(err, "[RedisUnschedulableJobRepository.SetUnschedulableJobsCursor] error storing cursor of queue %s", queue)
+	}
+	return nil
+}
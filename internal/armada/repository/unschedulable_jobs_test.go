@@ -0,0 +1,73 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnschedulableJobs_MarkAndUnmark(t *testing.T) {
+	withUnschedulableJobRepository(func(r *RedisUnschedulableJobRepository) {
+		require.NoError(t, r.MarkUnschedulableJobsReported([]string{"a", "b"}, time.Hour))
+		require.NoError(t, r.UnmarkUnschedulableJobsReported([]string{"b"}))
+
+		reported, err := r.GetReportedUnschedulableJobs([]string{"a", "b", "c"})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]bool{"a": true}, reported)
+	})
+}
+
+func TestUnschedulableJobs_MarksExpire(t *testing.T) {
+	withUnschedulableJobRepository(func(r *RedisUnschedulableJobRepository) {
+		require.NoError(t, r.MarkUnschedulableJobsReported([]string{"a"}, time.Hour))
+
+		ttl, err := r.db.TTL(unschedulableJobPrefix + "a").Result()
+		require.NoError(t, err)
+		assert.True(t, ttl > 0 && ttl <= time.Hour, "unexpected ttl %s", ttl)
+	})
+}
+
+func TestUnschedulableJobs_Cursor(t *testing.T) {
+	withUnschedulableJobRepository(func(r *RedisUnschedulableJobRepository) {
+		offset, err := r.GetUnschedulableJobsCursor("queue")
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), offset)
+
+		require.NoError(t, r.SetUnschedulableJobsCursor("queue", 10000))
+		offset, err = r.GetUnschedulableJobsCursor("queue")
+		require.NoError(t, err)
+		assert.Equal(t, int64(10000), offset)
+	})
+}
+
+func TestProcessUnschedulableJobs_OneCallerAtATime(t *testing.T) {
+	withUnschedulableJobRepository(func(r *RedisUnschedulableJobRepository) {
+		processed := false
+		acquired, err := r.ProcessUnschedulableJobs(func() {
+			processed = true
+			concurrentlyAcquired, err := r.ProcessUnschedulableJobs(func() {
+				t.Error("jobs processed concurrently")
+			})
+			require.NoError(t, err)
+			assert.False(t, concurrentlyAcquired)
+		})
+		require.NoError(t, err)
+		assert.True(t, acquired)
+		assert.True(t, processed)
+
+		acquired, err = r.ProcessUnschedulableJobs(func() {})
+		require.NoError(t, err)
+		assert.True(t, acquired)
+	})
+}
+
+func withUnschedulableJobRepository(action func(r *RedisUnschedulableJobRepository)) {
+	client := redis.NewClient(&redis.Options{Addr: "localhost:6379", DB: 10})
+	defer client.FlushDB()
+	defer client.Close()
+	client.FlushDB()
+	action(NewRedisUnschedulableJobRepository(client))
+}
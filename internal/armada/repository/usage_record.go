@@ -0,0 +1,287 @@
+package repository
+
+import (
+	"encoding/json"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+const (
+	usageRunPrefix          = "Usage:Run:"           // {jobId} - run of the job the usage of which is being accrued, or that finished
+	usageRunsInProgressKey  = "Usage:RunsInProgress" // ids of jobs with runs the usage of which is being accrued
+	usageRecordsPrefix      = "Usage:Records:"       // {date} - resource-seconds used on the date, by queue, owner, and resource
+	usageRecordDateLayout   = "2006-01-02"
+	usageFinishedRunExpiry  = 24 * time.Hour // time for which finished runs are kept, such that they aren't started again
+	maxUsageRecordRetries   = 5
+	usageRecordRetryBackoff = 10 * time.Millisecond
+)
+
+// AccruingRun is a run of a job the resource usage of which is being accrued into daily usage records.
+type AccruingRun struct {
+	JobId string
+	Queue string
+	Owner string
+	// Resources requested by the job, e.g., {"cpu": 2, "nvidia.com/gpu": 1}, in units of each resource.
+	Resources map[string]float64
+	// Time until which the usage of the run has been accrued, which is initially the time the run started.
+	AccruedUntil time.Time
+	// Whether the job is scheduled by the Pulsar scheduler, rather than leased by the legacy scheduler.
+	PulsarScheduler bool `json:",omitempty"`
+	// Whether the run finished, in which case AccruedUntil is the time it finished.
+	Finished bool `json:",omitempty"`
+}
+
+// UsageRecordRepository accrues the resource usage of runs of jobs into records of the resource-seconds used
+// by each owner of each queue per day, in UTC.
+type UsageRecordRepository interface {
+	// StartRun starts accruing the usage of a run, unless the usage of a run of the same job is already being accrued,
+	// or a run of the job finished at or after run started, such that a run started again, because the events starting
+	// and finishing it are reported again, isn't accrued twice.
+	StartRun(run *AccruingRun) error
+	// AccrueRun adds the usage of the run of the job with id jobId since it was last accrued until until to the
+	// usage records. If finish is true, the usage of the run is no longer accrued afterwards.
+	// Does nothing if the usage of no run of the job is being accrued.
+	AccrueRun(jobId string, until time.Time, finish bool) error
+	// GetRunsInProgress returns the runs the usage of which is being accrued.
+	GetRunsInProgress() ([]*AccruingRun, error)
+	// GetUsageRecords returns the usage records of the days from start to end, inclusive,
+	// ordered by date, queue, owner, and resource.
+	GetUsageRecords(start time.Time, end time.Time) ([]*api.UsageRecord, error)
+}
+
+type RedisUsageRecordRepository struct {
+	db redis.UniversalClient
+	// Time after the end of a day for which its usage records are kept.
+	retention time.Duration
+}
+
+func NewRedisUsageRecordRepository(db redis.UniversalClient, retention time.Duration) *RedisUsageRecordRepository {
+	return &RedisUsageRecordRepository{db: db, retention: retention}
+}
+
+func (r *RedisUsageRecordRepository) StartRun(run *AccruingRun) error {
+	data, err := json.Marshal(run)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	key := usageRunPrefix + run.JobId
+	txf := func(tx *redis.Tx) error {
+		existing, err := getAccruingRun(tx, key)
+		if err != nil {
+			return err
+		}
+		if existing != nil && (!existing.Finished || !run.AccruedUntil.After(existing.AccruedUntil)) {
+			return nil
+		}
+		_, err = tx.TxPipelined(func(pipe redis.Pipeliner) error {
+			pipe.Set(key, data, 0)
+			pipe.SAdd(usageRunsInProgressKey, run.JobId)
+			return nil
+		})
+		return err
+	}
+	if err := r.watchRun(key, txf); err != nil {
+		return errors.WithMessagef(err, "[RedisUsageRecordRepository.StartRun] error starting run of job %s", run.JobId)
+	}
+	return nil
+}
+
+func (r *RedisUsageRecordRepository) AccrueRun(jobId string, until time.Time, finish bool) error {
+	key := usageRunPrefix + jobId
+	txf := func(tx *redis.Tx) error {
+		run, err := getAccruingRun(tx, key)
+		if err != nil {
+			return err
+		} else if run == nil {
+			return tx.SRem(usageRunsInProgressKey, jobId).Err()
+		} else if run.Finished {
+			return nil
+		}
+		_, err = tx.TxPipelined(func(pipe redis.Pipeliner) error {
+			for _, day := range splitByDay(run.AccruedUntil, until) {
+				recordsKey := usageRecordsPrefix + day.start.Format(usageRecordDateLayout)
+				seconds := day.end.Sub(day.start).Seconds()
+				for resource, quantity := range run.Resources {
+					field, err := json.Marshal([]string{run.Queue, run.Owner, resource})
+					if err != nil {
+						return errors.WithStack(err)
+					}
+					pipe.HIncrByFloat(recordsKey, string(field), quantity*seconds)
+				}
+				pipe.ExpireAt(recordsKey, startOfDay(day.start).AddDate(0, 0, 1).Add(r.retention))
+			}
+			if until.After(run.AccruedUntil) {
+				run.AccruedUntil = until
+			}
+			if finish {
+				// The finished run is kept for a while, such that it isn't started again if its events are reported again.
+				run.Finished = true
+				data, err := json.Marshal(run)
+				if err != nil {
+					return errors.WithStack(err)
+				}
+				pipe.Set(key, data, usageFinishedRunExpiry)
+				pipe.SRem(usageRunsInProgressKey, jobId)
+				return nil
+			}
+			data, err := json.Marshal(run)
+			if err != nil {
+				return errors.WithStack(err)
+			}
+			pipe.Set(key, data, 0)
+			return nil
+		})
+		return err
+	}
+	if err := r.watchRun(key, txf); err != nil {
+		return errors.WithMessagef(err, "[RedisUsageRecordRepository.AccrueRun] error accruing run of job %s", jobId)
+	}
+	return nil
+}
+
+// watchRun runs txf in a transaction watching the run stored at key, retrying if the run changed concurrently.
+func (r *RedisUsageRecordRepository) watchRun(key string, txf func(tx *redis.Tx) error) error {
+	for i := 0; i < maxUsageRecordRetries; i++ {
+		err := r.db.Watch(txf, key)
+		if err == redis.TxFailedErr {
+			// The run was changed concurrently.
+			time.Sleep(usageRecordRetryBackoff)
+			continue
+		} else if err != nil {
+			return errors.WithStack(err)
+		}
+		return nil
+	}
+	return errors.Errorf("run was changed concurrently %d times", maxUsageRecordRetries)
+}
+
+// getAccruingRun returns the run stored at key, or nil if there is none.
+func getAccruingRun(tx *redis.Tx, key string) (*AccruingRun, error) {
+	data, err := tx.Get(key).Result()
+	if err == redis.Nil {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	run := &AccruingRun{}
+	if err := json.Unmarshal([]byte(data), run); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return run, nil
+}
+
+func (r *RedisUsageRecordRepository) GetRunsInProgress() ([]*AccruingRun, error) {
+	jobIds, err := r.db.SMembers(usageRunsInProgressKey).Result()
+	if err != nil {
+		return nil, errors.Wrap(err, "[RedisUsageRecordRepository.GetRunsInProgress] error getting ids of jobs with runs in progress")
+	}
+	if len(jobIds) == 0 {
+		return nil, nil
+	}
+	keys := make([]string, len(jobIds))
+	for i, jobId := range jobIds {
+		keys[i] = usageRunPrefix + jobId
+	}
+	values, err := r.db.MGet(keys...).Result()
+	if err != nil {
+		return nil, errors.Wrap(err, "[RedisUsageRecordRepository.GetRunsInProgress] error getting runs in progress")
+	}
+	runs := make([]*AccruingRun, 0, len(values))
+	for _, value := range values {
+		data, ok := value.(string)
+		if !ok {
+			// The finished run expired since the ids were read.
+			continue
+		}
+		run := &AccruingRun{}
+		if err := json.Unmarshal([]byte(data), run); err != nil {
+			return nil, errors.WithStack(err)
+		}
+		if run.Finished {
+			// The run finished since the ids were read.
+			continue
+		}
+		runs = append(runs, run)
+	}
+	return runs, nil
+}
+
+func (r *RedisUsageRecordRepository) GetUsageRecords(start time.Time, end time.Time) ([]*api.UsageRecord, error) {
+	pipe := r.db.Pipeline()
+	var dates []string
+	var cmds []*redis.StringStringMapCmd
+	for day := startOfDay(start); !day.After(end); day = day.AddDate(0, 0, 1) {
+		date := day.Format(usageRecordDateLayout)
+		dates = append(dates, date)
+		cmds = append(cmds, pipe.HGetAll(usageRecordsPrefix+date))
+	}
+	if _, err := pipe.Exec(); err != nil {
+		return nil, errors.Wrap(err, "[RedisUsageRecordRepository.GetUsageRecords] error getting usage records")
+	}
+
+	var records []*api.UsageRecord
+	for i, cmd := range cmds {
+		for field, value := range cmd.Val() {
+			var key []string
+			if err := json.Unmarshal([]byte(field), &key); err != nil || len(key) != 3 {
+				return nil, errors.Errorf("[RedisUsageRecordRepository.GetUsageRecords] invalid usage record %q of %s", field, dates[i])
+			}
+			seconds, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return nil, errors.WithStack(err)
+			}
+			records = append(records, &api.UsageRecord{
+				Date:            dates[i],
+				Queue:           key[0],
+				Owner:           key[1],
+				Resource:        key[2],
+				ResourceSeconds: seconds,
+			})
+		}
+	}
+	sort.Slice(records, func(i, j int) bool {
+		a, b := records[i], records[j]
+		if a.Date != b.Date {
+			return a.Date < b.Date
+		}
+		if a.Queue != b.Queue {
+			return a.Queue < b.Queue
+		}
+		if a.Owner != b.Owner {
+			return a.Owner < b.Owner
+		}
+		return a.Resource < b.Resource
+	})
+	return records, nil
+}
+
+type dayInterval struct {
+	start time.Time
+	end   time.Time
+}
+
+// splitByDay splits the interval from start to end at each midnight, in UTC.
+func splitByDay(start time.Time, end time.Time) []dayInterval {
+	var intervals []dayInterval
+	start, end = start.UTC(), end.UTC()
+	for start.Before(end) {
+		midnight := startOfDay(start).AddDate(0, 0, 1)
+		if midnight.After(end) {
+			midnight = end
+		}
+		intervals = append(intervals, dayInterval{start: start, end: midnight})
+		start = midnight
+	}
+	return intervals
+}
+
+func startOfDay(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,119 @@
+package repository
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+func TestUsageRecordRepository_AccrueRun(t *testing.T) {
+	withUsageRecordRepository(func(r *RedisUsageRecordRepository) {
+		// Records of days further in the past than the retention would have expired.
+		yesterday := startOfDay(time.Now()).AddDate(0, 0, -1)
+		today := yesterday.AddDate(0, 0, 1)
+		started := yesterday.Add(22 * time.Hour)
+		run := &AccruingRun{JobId: "job", Queue: "queue", Owner: "alice", Resources: map[string]float64{"cpu": 2}, AccruedUntil: started}
+		require.NoError(t, r.StartRun(run))
+		// Runs in progress aren't restarted.
+		require.NoError(t, r.StartRun(&AccruingRun{JobId: "job", Queue: "queue", AccruedUntil: started.Add(time.Hour)}))
+
+		// Usage is split at midnight.
+		require.NoError(t, r.AccrueRun("job", started.Add(3*time.Hour), false))
+		runs, err := r.GetRunsInProgress()
+		require.NoError(t, err)
+		require.Len(t, runs, 1)
+		assert.True(t, started.Add(3*time.Hour).Equal(runs[0].AccruedUntil))
+
+		require.NoError(t, r.AccrueRun("job", started.Add(4*time.Hour), true))
+		runs, err = r.GetRunsInProgress()
+		require.NoError(t, err)
+		assert.Empty(t, runs)
+		// Accruing a run that isn't in progress does nothing.
+		require.NoError(t, r.AccrueRun("job", started.Add(5*time.Hour), true))
+		// Nor does starting the finished run again, e.g., because its events are reported again.
+		require.NoError(t, r.StartRun(run))
+		require.NoError(t, r.AccrueRun("job", started.Add(4*time.Hour), true))
+		runs, err = r.GetRunsInProgress()
+		require.NoError(t, err)
+		assert.Empty(t, runs)
+
+		records, err := r.GetUsageRecords(started, started.AddDate(0, 0, 1))
+		require.NoError(t, err)
+		assert.Equal(t, []*api.UsageRecord{
+			{Date: yesterday.Format("2006-01-02"), Queue: "queue", Owner: "alice", Resource: "cpu", ResourceSeconds: 2 * 2 * 3600},
+			{Date: today.Format("2006-01-02"), Queue: "queue", Owner: "alice", Resource: "cpu", ResourceSeconds: 2 * 2 * 3600},
+		}, records)
+
+		records, err = r.GetUsageRecords(today, today)
+		require.NoError(t, err)
+		assert.Len(t, records, 1)
+	})
+}
+
+func TestUsageRecordRepository_StartRun_AfterFinishedRun(t *testing.T) {
+	withUsageRecordRepository(func(r *RedisUsageRecordRepository) {
+		started := startOfDay(time.Now())
+		run := &AccruingRun{JobId: "job", Queue: "queue", Owner: "alice", Resources: map[string]float64{"cpu": 1}, AccruedUntil: started}
+		require.NoError(t, r.StartRun(run))
+		require.NoError(t, r.AccrueRun("job", started.Add(time.Minute), true))
+
+		// The next run of the job, e.g., once it's retried, starts after the previous run finished.
+		require.NoError(t, r.StartRun(&AccruingRun{JobId: "job", Queue: "queue", Owner: "alice", Resources: map[string]float64{"cpu": 1}, AccruedUntil: started.Add(2 * time.Minute)}))
+		require.NoError(t, r.AccrueRun("job", started.Add(4*time.Minute), true))
+
+		records, err := r.GetUsageRecords(started, started)
+		require.NoError(t, err)
+		assert.Equal(t, []*api.UsageRecord{
+			{Date: started.Format("2006-01-02"), Queue: "queue", Owner: "alice", Resource: "cpu", ResourceSeconds: 180},
+		}, records)
+	})
+}
+
+func TestUsageRecordRepository_GetUsageRecords_Ordered(t *testing.T) {
+	withUsageRecordRepository(func(r *RedisUsageRecordRepository) {
+		started := startOfDay(time.Now())
+		date := started.Format("2006-01-02")
+		runs := []*AccruingRun{
+			{JobId: "a", Queue: "b-queue", Owner: "alice", Resources: map[string]float64{"cpu": 1, "memory": 1024}, AccruedUntil: started},
+			{JobId: "b", Queue: "a-queue", Owner: "bob", Resources: map[string]float64{"cpu": 1}, AccruedUntil: started},
+			{JobId: "c", Queue: "a-queue", Owner: "bob", Resources: map[string]float64{"cpu": 3}, AccruedUntil: started},
+		}
+		for _, run := range runs {
+			require.NoError(t, r.StartRun(run))
+			require.NoError(t, r.AccrueRun(run.JobId, started.Add(time.Second), true))
+		}
+
+		records, err := r.GetUsageRecords(started, started)
+		require.NoError(t, err)
+		assert.Equal(t, []*api.UsageRecord{
+			{Date: date, Queue: "a-queue", Owner: "bob", Resource: "cpu", ResourceSeconds: 4},
+			{Date: date, Queue: "b-queue", Owner: "alice", Resource: "cpu", ResourceSeconds: 1},
+			{Date: date, Queue: "b-queue", Owner: "alice", Resource: "memory", ResourceSeconds: 1024},
+		}, records)
+	})
+}
+
+func TestSplitByDay(t *testing.T) {
+	start := time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC)
+	intervals := splitByDay(start, start.Add(48*time.Hour))
+	require.Len(t, intervals, 3)
+	assert.Equal(t, time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC), intervals[0].end)
+	assert.Equal(t, time.Date(2023, 1, 3, 0, 0, 0, 0, time.UTC), intervals[1].end)
+	assert.Equal(t, start.Add(48*time.Hour), intervals[2].end)
+
+	assert.Empty(t, splitByDay(start, start))
+	assert.Empty(t, splitByDay(start, start.Add(-time.Hour)))
+}
+
+func withUsageRecordRepository(action func(r *RedisUsageRecordRepository)) {
+	client := redis.NewClient(&redis.Options{Addr: "localhost:6379", DB: 10})
+	defer client.FlushDB()
+	defer client.Close()
+	client.FlushDB()
+	action(NewRedisUsageRecordRepository(client, 24*time.Hour))
+}
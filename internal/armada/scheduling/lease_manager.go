@@ -45,6 +45,7 @@ func (l *LeaseManager) ExpireLeases() {
 		if e != nil {
 			log.Error(e)
 		} else {
+			l.clearPendingRetries(jobs)
 			for _, job := range jobs {
 				event, e := api.Wrap(&api.JobLeaseExpiredEvent{
 					JobId:    job.Id,
@@ -64,3 +65,31 @@ func (l *LeaseManager) ExpireLeases() {
 		}
 	}
 }
+
+// clearPendingRetries clears the pending retry of those of jobs that are pending retry. Expiring their leases
+// returned them to the queue, i.e., they're being retried, and their executor won't report them done.
+func (l *LeaseManager) clearPendingRetries(jobs []*api.Job) {
+	var jobIds []string
+	for _, job := range jobs {
+		if job.RetryPending {
+			jobIds = append(jobIds, job.Id)
+		}
+	}
+	if len(jobIds) == 0 {
+		return
+	}
+	results, err := l.jobRepository.UpdateJobs(jobIds, func(jobs []*api.Job) {
+		for _, job := range jobs {
+			job.RetryPending = false
+		}
+	})
+	if err != nil {
+		log.WithError(err).Error("failed to clear pending retries of jobs with expired leases")
+		return
+	}
+	for _, result := range results {
+		if result.Error != nil {
+			log.WithError(result.Error).Errorf("failed to clear pending retry of job %s", result.JobId)
+		}
+	}
+}
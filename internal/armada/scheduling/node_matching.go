@@ -17,11 +17,13 @@ import (
 
 func CreateClusterSchedulingInfoReport(leaseRequest *api.StreamingLeaseRequest, nodeAllocations []*nodeTypeAllocation) *api.ClusterSchedulingInfoReport {
 	return &api.ClusterSchedulingInfoReport{
-		ClusterId:      leaseRequest.ClusterId,
-		Pool:           leaseRequest.Pool,
-		ReportTime:     time.Now(),
-		NodeTypes:      extractNodeTypes(nodeAllocations),
-		MinimumJobSize: leaseRequest.MinimumJobSize,
+		ClusterId:            leaseRequest.ClusterId,
+		Pool:                 leaseRequest.Pool,
+		ReportTime:           time.Now(),
+		NodeTypes:            extractNodeTypes(nodeAllocations),
+		MinimumJobSize:       leaseRequest.MinimumJobSize,
+		ServiceAccounts:      leaseRequest.ServiceAccounts,
+		SecretsAndConfigMaps: leaseRequest.SecretsAndConfigMaps,
 	}
 }
 
@@ -77,18 +79,145 @@ func MatchSchedulingRequirements(
 	return true, nil
 }
 
+// DiagnoseSchedulingRequirementsOnAnyCluster is the diagnostic mode of MatchSchedulingRequirementsOnAnyCluster.
+// It returns true if the provided job can be scheduled. If returning false, it explains for each cluster,
+// ordered by cluster id, why the job can't be scheduled there.
+func DiagnoseSchedulingRequirementsOnAnyCluster(
+	job *api.Job,
+	allClusterSchedulingInfos map[string]*api.ClusterSchedulingInfoReport,
+) (bool, []*api.ClusterUnschedulableReasons) {
+	result := make([]*api.ClusterUnschedulableReasons, 0, len(allClusterSchedulingInfos))
+	for _, schedulingInfo := range allClusterSchedulingInfos {
+		reasons := DiagnoseSchedulingRequirements(job, schedulingInfo)
+		if len(reasons) == 0 {
+			return true, nil
+		}
+		result = append(result, &api.ClusterUnschedulableReasons{
+			ClusterId: schedulingInfo.ClusterId,
+			Pool:      schedulingInfo.Pool,
+			Reasons:   reasons,
+		})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].ClusterId < result[j].ClusterId
+	})
+	return false, result
+}
+
+// DiagnoseSchedulingRequirements is the diagnostic mode of MatchSchedulingRequirements.
+// It returns the reasons the job can't be scheduled on any node type of the cluster, or nil if it can.
+// Reasons excluding every node type, such as the job being larger than the largest node type, take precedence;
+// otherwise, node types are grouped by the reason they're excluded for, the most common reason first.
+func DiagnoseSchedulingRequirements(
+	job *api.Job,
+	schedulingInfo *api.ClusterSchedulingInfoReport,
+) []*api.UnschedulableReason {
+	numNodeTypes := int32(len(schedulingInfo.NodeTypes))
+	if resourceType, ok := belowMinimumJobSize(job, schedulingInfo.MinimumJobSize); ok {
+		return []*api.UnschedulableReason{{
+			Code:      api.UnschedulableReason_BELOW_MINIMUM_JOB_SIZE,
+			Resource:  resourceType,
+			Message:   fmt.Sprintf("pod resource requests too low; the minimum allowed is %v", schedulingInfo.MinimumJobSize),
+			NodeTypes: numNodeTypes,
+		}}
+	}
+	if numNodeTypes == 0 {
+		return []*api.UnschedulableReason{{
+			Code:    api.UnschedulableReason_NO_NODE_TYPES,
+			Message: "no node types available",
+		}}
+	}
+
+	podMatchingContext := NewPodMatchingContext(job.GetMainPodSpec())
+	if reasons := exceedsLargestNodeType(podMatchingContext.totalPodResourceRequest, schedulingInfo.NodeTypes); len(reasons) > 0 {
+		return reasons
+	}
+
+	var reasons []*api.UnschedulableReason
+	reasonsByMessage := make(map[string]*api.UnschedulableReason)
+	for _, nodeType := range schedulingInfo.NodeTypes {
+		nodeResources := armadaresource.ComputeResources(nodeType.AllocatableResources).AsFloat().DeepCopy()
+		reason := podMatchingContext.Mismatch(nodeType, nodeResources)
+		if reason == nil {
+			return nil
+		}
+		if existing, ok := reasonsByMessage[reason.Message]; ok {
+			existing.NodeTypes++
+			continue
+		}
+		reason.NodeTypes = 1
+		reasonsByMessage[reason.Message] = reason
+		reasons = append(reasons, reason)
+	}
+	sort.SliceStable(reasons, func(i, j int) bool {
+		return reasons[i].NodeTypes > reasons[j].NodeTypes
+	})
+	return reasons
+}
+
+// exceedsLargestNodeType returns a reason for each resource of which more is requested than any of the provided
+// node types has, ordered by resource name.
+func exceedsLargestNodeType(resourceRequest armadaresource.ComputeResourcesFloat, nodeTypes []*api.NodeType) []*api.UnschedulableReason {
+	resourceTypes := make([]string, 0, len(resourceRequest))
+	for resourceType := range resourceRequest {
+		resourceTypes = append(resourceTypes, resourceType)
+	}
+	sort.Strings(resourceTypes)
+
+	var reasons []*api.UnschedulableReason
+	for _, resourceType := range resourceTypes {
+		requested := resourceRequest[resourceType]
+		if requested <= 0 {
+			continue
+		}
+		largest := 0.0
+		for _, nodeType := range nodeTypes {
+			if q, ok := nodeType.AllocatableResources[resourceType]; ok && q.AsApproximateFloat64() > largest {
+				largest = q.AsApproximateFloat64()
+			}
+		}
+		switch {
+		case largest == 0:
+			reasons = append(reasons, &api.UnschedulableReason{
+				Code:      api.UnschedulableReason_INSUFFICIENT_RESOURCE,
+				Resource:  resourceType,
+				Message:   fmt.Sprintf("pod requested resource %s, but no node type has any", resourceType),
+				NodeTypes: int32(len(nodeTypes)),
+			})
+		case largest < requested:
+			reasons = append(reasons, &api.UnschedulableReason{
+				Code:     api.UnschedulableReason_EXCEEDS_MAX_JOB_SIZE,
+				Resource: resourceType,
+				Message: fmt.Sprintf(
+					"pod requested %f of resource %s, but the largest node type has %f",
+					requested, resourceType, largest,
+				),
+				NodeTypes: int32(len(nodeTypes)),
+			})
+		}
+	}
+	return reasons
+}
+
 func isLargeEnough(job *api.Job, minimumJobSize armadaresource.ComputeResources) bool {
+	_, below := belowMinimumJobSize(job, minimumJobSize)
+	return !below
+}
+
+// belowMinimumJobSize returns true, and a resource of which the job requests too little, if the job
+// is smaller than the provided minimum job size.
+func belowMinimumJobSize(job *api.Job, minimumJobSize armadaresource.ComputeResources) (string, bool) {
 	if len(minimumJobSize) == 0 {
-		return true
+		return "", false
 	}
 	rl := job.TotalResourceRequest()
 	for t, limit := range minimumJobSize {
 		q := rl[t]
 		if limit.Cmp(q) == 1 {
-			return false
+			return t, true
 		}
 	}
-	return true
+	return "", false
 }
 
 // matchAnyNodeType returns true if the pod can be scheduled on at least one node type.
@@ -169,6 +298,7 @@ func AggregateNodeTypeAllocations(nodes []api.NodeInfo) []*nodeTypeAllocation {
 					Taints:               n.Taints,
 					Labels:               n.Labels,
 					AllocatableResources: n.AllocatableResources,
+					Capacity:             armadaresource.ComputeResources(n.TotalResources).DeepCopy(),
 				},
 				availableResources: nodeAvailableResources,
 				totalResources:     nodeTotalResources,
@@ -176,6 +306,7 @@ func AggregateNodeTypeAllocations(nodes []api.NodeInfo) []*nodeTypeAllocation {
 			}
 		} else {
 			typeDescription.totalResources.Add(nodeTotalResources)
+			armadaresource.ComputeResources(typeDescription.nodeType.Capacity).Add(n.TotalResources)
 			typeDescription.availableResources.Add(nodeAvailableResources)
 
 			for priority, resources := range nodeAllocatedResources {
@@ -187,6 +318,7 @@ func AggregateNodeTypeAllocations(nodes []api.NodeInfo) []*nodeTypeAllocation {
 				}
 			}
 		}
+		typeDescription.nodeType.NodeCount++
 		nodeTypesIndex[description] = typeDescription
 	}
 
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 
@@ -89,6 +90,110 @@ func Test_MatchSchedulingRequirements_isAbleToFitOnAvailableNodes(t *testing.T)
 	assert.NoError(t, err)
 }
 
+func Test_DiagnoseSchedulingRequirements(t *testing.T) {
+	request := v1.ResourceList{"cpu": resource.MustParse("2"), "nvidia.com/gpu": resource.MustParse("1")}
+	job := &api.Job{PodSpec: &v1.PodSpec{
+		NodeSelector: map[string]string{"armada/zone": "1"},
+		Containers:   []v1.Container{{Resources: v1.ResourceRequirements{Limits: request, Requests: request}}},
+	}}
+	gpuNodeType := func(labels map[string]string, taints ...v1.Taint) *api.NodeType {
+		return &api.NodeType{
+			Labels: labels,
+			Taints: taints,
+			AllocatableResources: armadaresource.ComputeResources{
+				"cpu":            resource.MustParse("4"),
+				"nvidia.com/gpu": resource.MustParse("1"),
+			},
+		}
+	}
+
+	tests := map[string]struct {
+		schedulingInfo *api.ClusterSchedulingInfoReport
+		expectedCodes  []api.UnschedulableReason_Code
+		expectedCounts []int32
+	}{
+		"schedulable": {
+			schedulingInfo: &api.ClusterSchedulingInfoReport{NodeTypes: []*api.NodeType{
+				gpuNodeType(map[string]string{"armada/zone": "2"}),
+				gpuNodeType(map[string]string{"armada/zone": "1"}),
+			}},
+		},
+		"no node types": {
+			schedulingInfo: &api.ClusterSchedulingInfoReport{},
+			expectedCodes:  []api.UnschedulableReason_Code{api.UnschedulableReason_NO_NODE_TYPES},
+			expectedCounts: []int32{0},
+		},
+		"below minimum job size": {
+			schedulingInfo: &api.ClusterSchedulingInfoReport{
+				MinimumJobSize: armadaresource.ComputeResources{"nvidia.com/gpu": resource.MustParse("2")},
+				NodeTypes:      []*api.NodeType{gpuNodeType(map[string]string{"armada/zone": "1"})},
+			},
+			expectedCodes:  []api.UnschedulableReason_Code{api.UnschedulableReason_BELOW_MINIMUM_JOB_SIZE},
+			expectedCounts: []int32{1},
+		},
+		"insufficient gpu": {
+			schedulingInfo: &api.ClusterSchedulingInfoReport{NodeTypes: []*api.NodeType{
+				{AllocatableResources: armadaresource.ComputeResources{"cpu": resource.MustParse("4")}},
+			}},
+			expectedCodes:  []api.UnschedulableReason_Code{api.UnschedulableReason_INSUFFICIENT_RESOURCE},
+			expectedCounts: []int32{1},
+		},
+		"exceeds max job size": {
+			schedulingInfo: &api.ClusterSchedulingInfoReport{NodeTypes: []*api.NodeType{
+				{AllocatableResources: armadaresource.ComputeResources{"cpu": resource.MustParse("1"), "nvidia.com/gpu": resource.MustParse("1")}},
+			}},
+			expectedCodes:  []api.UnschedulableReason_Code{api.UnschedulableReason_EXCEEDS_MAX_JOB_SIZE},
+			expectedCounts: []int32{1},
+		},
+		"taint and node selector mismatches": {
+			schedulingInfo: &api.ClusterSchedulingInfoReport{NodeTypes: []*api.NodeType{
+				gpuNodeType(map[string]string{"armada/zone": "1"}, v1.Taint{Key: "a", Value: "b", Effect: v1.TaintEffectNoSchedule}),
+				gpuNodeType(map[string]string{"armada/zone": "2"}),
+				gpuNodeType(map[string]string{"armada/zone": "2"}),
+			}},
+			expectedCodes:  []api.UnschedulableReason_Code{api.UnschedulableReason_NODE_SELECTOR_MISMATCH, api.UnschedulableReason_UNTOLERATED_TAINT},
+			expectedCounts: []int32{2, 1},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			reasons := DiagnoseSchedulingRequirements(job, tc.schedulingInfo)
+			var codes []api.UnschedulableReason_Code
+			var counts []int32
+			for _, reason := range reasons {
+				codes = append(codes, reason.Code)
+				counts = append(counts, reason.NodeTypes)
+				assert.NotEmpty(t, reason.Message)
+			}
+			assert.Equal(t, tc.expectedCodes, codes)
+			assert.Equal(t, tc.expectedCounts, counts)
+		})
+	}
+}
+
+func Test_DiagnoseSchedulingRequirementsOnAnyCluster(t *testing.T) {
+	job := &api.Job{PodSpec: &v1.PodSpec{NodeSelector: map[string]string{"armada/zone": "1"}}}
+	infos := map[string]*api.ClusterSchedulingInfoReport{
+		"b": {ClusterId: "b", NodeTypes: []*api.NodeType{{Labels: map[string]string{"armada/zone": "2"}}}},
+		"a": {ClusterId: "a", Pool: "cpu"},
+	}
+
+	ok, reasons := DiagnoseSchedulingRequirementsOnAnyCluster(job, infos)
+	assert.False(t, ok)
+	if assert.Len(t, reasons, 2) {
+		assert.Equal(t, "a", reasons[0].ClusterId)
+		assert.Equal(t, "cpu", reasons[0].Pool)
+		assert.Equal(t, api.UnschedulableReason_NO_NODE_TYPES, reasons[0].Reasons[0].Code)
+		assert.Equal(t, "b", reasons[1].ClusterId)
+		assert.Equal(t, api.UnschedulableReason_NODE_SELECTOR_MISMATCH, reasons[1].Reasons[0].Code)
+	}
+
+	infos["c"] = &api.ClusterSchedulingInfoReport{ClusterId: "c", NodeTypes: []*api.NodeType{{Labels: map[string]string{"armada/zone": "1"}}}}
+	ok, reasons = DiagnoseSchedulingRequirementsOnAnyCluster(job, infos)
+	assert.True(t, ok)
+	assert.Empty(t, reasons)
+}
+
 func Test_AggregateNodeTypesAllocations(t *testing.T) {
 	nodes := []api.NodeInfo{
 		{
@@ -127,6 +232,11 @@ func Test_AggregateNodeTypesAllocations(t *testing.T) {
 	}
 
 	aggregated := AggregateNodeTypeAllocations(nodes)
+	// The capacity of a node type is the total of its nodes.
+	assertNodeTypeCapacity(t, aggregated, []armadaresource.ComputeResources{
+		{"cpu": resource.MustParse("4"), "memory": resource.MustParse("4Gi")},
+		{"cpu": resource.MustParse("6"), "memory": resource.MustParse("6Gi")},
+	}, []int32{2, 1})
 	expected := []*nodeTypeAllocation{
 		{
 			nodeType: api.NodeType{
@@ -158,6 +268,18 @@ func Test_AggregateNodeTypesAllocations(t *testing.T) {
 	}
 }
 
+// assertNodeTypeCapacity asserts the capacity and node count of each of allocations,
+// which are then cleared such that the remaining fields may be compared directly.
+func assertNodeTypeCapacity(t *testing.T, allocations []*nodeTypeAllocation, capacity []armadaresource.ComputeResources, nodeCounts []int32) {
+	require.Len(t, allocations, len(capacity))
+	for i, allocation := range allocations {
+		assert.True(t, armadaresource.ComputeResources(allocation.nodeType.Capacity).Equal(capacity[i]), "capacity of node type %d", i)
+		assert.Equal(t, nodeCounts[i], allocation.nodeType.NodeCount, "node count of node type %d", i)
+		allocation.nodeType.Capacity = nil
+		allocation.nodeType.NodeCount = 0
+	}
+}
+
 func Test_AggregateNodeTypesAllocations_NodesWithMoreTaintsGoFirst(t *testing.T) {
 	nodes := []api.NodeInfo{
 		{
@@ -187,6 +309,10 @@ func Test_AggregateNodeTypesAllocations_NodesWithMoreTaintsGoFirst(t *testing.T)
 	}
 
 	aggregated := AggregateNodeTypeAllocations(nodes)
+	assertNodeTypeCapacity(t, aggregated, []armadaresource.ComputeResources{
+		{"cpu": resource.MustParse("6"), "memory": resource.MustParse("6Gi")},
+		{"cpu": resource.MustParse("2"), "memory": resource.MustParse("1Gi")},
+	}, []int32{1, 1})
 	expected := []*nodeTypeAllocation{
 		{
 			nodeType: api.NodeType{
@@ -24,23 +24,41 @@ func NewPodMatchingContext(podSpec *v1.PodSpec) *PodMatchingContext {
 }
 
 func (podCtx *PodMatchingContext) Matches(nodeType *api.NodeType, availableResources armadaresource.ComputeResourcesFloat) (bool, error) {
-	if ok, err := fits(podCtx.totalPodResourceRequest, availableResources); !ok {
-		return false, err
+	if reason := podCtx.Mismatch(nodeType, availableResources); reason != nil {
+		return false, errors.New(reason.Message)
+	}
+	return true, nil
+}
+
+// Mismatch returns the reason the pod can't be scheduled on a node of the provided type with the provided
+// available resources, or nil if it can. The NodeTypes field of the reason is left unset.
+func (podCtx *PodMatchingContext) Mismatch(nodeType *api.NodeType, availableResources armadaresource.ComputeResourcesFloat) *api.UnschedulableReason {
+	if resourceType, err := unfitResource(podCtx.totalPodResourceRequest, availableResources); err != nil {
+		return &api.UnschedulableReason{Code: api.UnschedulableReason_INSUFFICIENT_RESOURCE, Resource: resourceType, Message: err.Error()}
 	}
 	if ok, err := matchNodeSelector(podCtx.podSpec, nodeType.Labels); !ok {
-		return false, err
+		return &api.UnschedulableReason{Code: api.UnschedulableReason_NODE_SELECTOR_MISMATCH, Message: err.Error()}
 	}
 	if ok, err := tolerates(podCtx.podSpec, nodeType.Taints); !ok {
-		return false, err
+		return &api.UnschedulableReason{Code: api.UnschedulableReason_UNTOLERATED_TAINT, Message: err.Error()}
 	}
 	if ok, err := matchesRequiredNodeAffinity(podCtx.requiredNodeAffinitySelector, nodeType); !ok {
-		return false, err
+		return &api.UnschedulableReason{Code: api.UnschedulableReason_NODE_SELECTOR_MISMATCH, Message: err.Error()}
 	}
-	return true, nil
+	return nil
 }
 
 // fits returns true if the requested resources are no greater than the available resources.
 func fits(resourceRequest, availableResources armadaresource.ComputeResourcesFloat) (bool, error) {
+	if _, err := unfitResource(resourceRequest, availableResources); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// unfitResource returns a resource of which more is requested than available, and an error explaining so,
+// or a nil error if the requested resources are no greater than the available resources.
+func unfitResource(resourceRequest, availableResources armadaresource.ComputeResourcesFloat) (string, error) {
 	for resourceType, requestedResourceQuantity := range resourceRequest {
 		// Do not return error on requesting zero of some resource.
 		if requestedResourceQuantity <= 0 {
@@ -48,10 +66,10 @@ func fits(resourceRequest, availableResources armadaresource.ComputeResourcesFlo
 		}
 		availableResourceQuantity, ok := availableResources[resourceType]
 		if !ok {
-			return false, errors.Errorf("pod requested resource %s, but none is available", resourceType)
+			return resourceType, errors.Errorf("pod requested resource %s, but none is available", resourceType)
 		}
 		if availableResourceQuantity < requestedResourceQuantity {
-			return false, errors.Errorf(
+			return resourceType, errors.Errorf(
 				"pod requested %f of resource %s, but only %f is available",
 				requestedResourceQuantity,
 				resourceType,
@@ -59,7 +77,7 @@ func fits(resourceRequest, availableResources armadaresource.ComputeResourcesFlo
 			)
 		}
 	}
-	return true, nil
+	return "", nil
 }
 
 // matchNodeSelector returns true if the NodeSelector includes nodes with the provided labels,
@@ -11,13 +11,19 @@ import (
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
 
+	"github.com/armadaproject/armada/internal/armada/audit"
 	"github.com/armadaproject/armada/internal/armada/cache"
 	"github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/internal/armada/imageresolver"
 	"github.com/armadaproject/armada/internal/armada/metrics"
+	"github.com/armadaproject/armada/internal/armada/permissions"
 	"github.com/armadaproject/armada/internal/armada/repository"
+	"github.com/armadaproject/armada/internal/armada/repository/pgjob"
+	"github.com/armadaproject/armada/internal/armada/repository/pgqueue"
 	"github.com/armadaproject/armada/internal/armada/scheduling"
 	"github.com/armadaproject/armada/internal/armada/server"
 	"github.com/armadaproject/armada/internal/common/armadacontext"
@@ -29,15 +35,22 @@ import (
 	commonmetrics "github.com/armadaproject/armada/internal/common/metrics"
 	"github.com/armadaproject/armada/internal/common/pgkeyvalue"
 	"github.com/armadaproject/armada/internal/common/pulsarutils"
+	"github.com/armadaproject/armada/internal/common/replica"
 	"github.com/armadaproject/armada/internal/common/task"
 	"github.com/armadaproject/armada/internal/common/util"
 	"github.com/armadaproject/armada/internal/scheduler"
 	schedulerdb "github.com/armadaproject/armada/internal/scheduler/database"
 	"github.com/armadaproject/armada/internal/scheduler/schedulerobjects"
 	"github.com/armadaproject/armada/pkg/api"
+	apiv2 "github.com/armadaproject/armada/pkg/api/v2"
 	"github.com/armadaproject/armada/pkg/client"
 )
 
+// Methods principals with permission ImpersonateUsers may call on behalf of other users.
+var impersonationMethods = []string{
+	"/api.Submit/SubmitJobs",
+}
+
 func Serve(ctx *armadacontext.Context, config *configuration.ArmadaConfig, healthChecks *health.MultiChecker) error {
 	log.Info("Armada server starting")
 	log.Infof("Armada priority classes: %v", config.Scheduling.Preemption.PriorityClasses)
@@ -69,6 +82,26 @@ func Serve(ctx *armadacontext.Context, config *configuration.ArmadaConfig, healt
 		return err
 	}
 
+	err = validateTestModeConfig(config.TestMode)
+	if err != nil {
+		return err
+	}
+
+	// Setup Redis
+	db := createRedisClient(&config.Redis)
+	defer func() {
+		if err := db.Close(); err != nil {
+			log.WithError(err).Error("failed to close Redis client")
+		}
+	}()
+
+	permissionChecker := authorization.NewPrincipalPermissionChecker(
+		config.Auth.PermissionGroupMapping,
+		config.Auth.PermissionScopeMapping,
+		config.Auth.PermissionClaimMapping,
+	)
+	authorizer := server.NewAuthorizer(permissionChecker)
+
 	// We support multiple simultaneous authentication services (e.g., username/password  OpenId).
 	// For each gRPC request, we try them all until one succeeds, at which point the process is
 	// short-circuited.
@@ -76,7 +109,59 @@ func Serve(ctx *armadacontext.Context, config *configuration.ArmadaConfig, healt
 	if err != nil {
 		return err
 	}
-	grpcServer := grpcCommon.CreateGrpcServer(config.Grpc.KeepaliveParams, config.Grpc.KeepaliveEnforcementPolicy, authServices, config.Grpc.Tls)
+	// Executor credentials minted by this server are tried first, since Kerberos must remain the last auth service.
+	executorCredentialsServer := server.NewExecutorCredentialsServer(
+		authorizer,
+		repository.NewRedisExecutorCredentialRepository(db),
+		config.ExecutorCredentials,
+	)
+	if config.ExecutorCredentials.Enabled {
+		authServices = append([]authorization.AuthService{
+			authorization.NewExecutorAuthService(executorCredentialsServer, config.ExecutorCredentials.Groups, server.ExecutorCredentialMethods),
+		}, authServices...)
+	}
+	// API tokens issued by users are likewise tried before the configured auth services.
+	apiTokensServer := server.NewApiTokensServer(
+		authorizer,
+		repository.NewRedisApiTokenRepository(db),
+		config.ApiTokens,
+	)
+	if config.ApiTokens.Enabled {
+		authServices = append([]authorization.AuthService{
+			authorization.NewApiTokenAuthService(apiTokensServer, server.ApiTokenMethods),
+		}, authServices...)
+	}
+	// Calls beyond the concurrency limits are rejected before they're recorded, since they have no effect.
+	// Calls made on behalf of other users are recorded as theirs, together with the principal that made them.
+	interceptors := grpcCommon.AdditionalInterceptors{
+		Unary: []grpc.UnaryServerInterceptor{
+			grpcCommon.ConcurrencyLimitingUnaryServerInterceptor(config.Grpc.ConcurrencyLimits),
+			authorization.ImpersonationUnaryServerInterceptor(
+				permissionChecker,
+				permissions.ImpersonateUsers,
+				auth.ConfigureUserGroupLookup(config.Auth),
+				impersonationMethods,
+			),
+		},
+	}
+	if config.AuditLog.Enabled {
+		auditSink, err := createAuditSink(ctx, config.AuditLog)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if err := auditSink.Close(); err != nil {
+				log.WithError(err).Error("failed to close audit log")
+			}
+		}()
+		interceptors.UnauthenticatedUnary = append(interceptors.UnauthenticatedUnary, audit.UnauthenticatedUnaryServerInterceptor(auditSink))
+		interceptors.UnauthenticatedStream = append(interceptors.UnauthenticatedStream, audit.UnauthenticatedStreamServerInterceptor(auditSink))
+		interceptors.Unary = append(interceptors.Unary, audit.UnaryServerInterceptor(auditSink, config.AuditLog.Methods))
+		interceptors.Stream = append(interceptors.Stream, audit.StreamServerInterceptor(auditSink, config.AuditLog.Methods))
+	}
+	grpcServer := grpcCommon.CreateGrpcServerWithInterceptors(
+		config.Grpc.KeepaliveParams, config.Grpc.KeepaliveEnforcementPolicy, authServices, config.Grpc.Tls, interceptors,
+	)
 
 	// Shut down grpcServer if the context is cancelled.
 	// Give the server 5 seconds to shut down gracefully.
@@ -90,14 +175,6 @@ func Serve(ctx *armadacontext.Context, config *configuration.ArmadaConfig, healt
 		return nil
 	})
 
-	// Setup Redis
-	db := createRedisClient(&config.Redis)
-	defer func() {
-		if err := db.Close(); err != nil {
-			log.WithError(err).Error("failed to close Redis client")
-		}
-	}()
-
 	eventDb := createRedisClient(&config.EventsApiRedis)
 	defer func() {
 		if err := eventDb.Close(); err != nil {
@@ -105,21 +182,117 @@ func Serve(ctx *armadacontext.Context, config *configuration.ArmadaConfig, healt
 		}
 	}()
 
-	jobRepository := repository.NewRedisJobRepository(db)
+	jobRepository, closeJobRepository, err := createJobRepository(ctx, config.JobRepository, config.PodSpecStorage, db)
+	if err != nil {
+		return err
+	}
+	defer closeJobRepository()
+	_, jobsInRedis := jobRepository.(*repository.RedisJobRepository)
 	usageRepository := repository.NewRedisUsageRepository(db)
-	queueRepository := repository.NewRedisQueueRepository(db)
+	queueRepository, closeQueueRepository, err := createQueueRepository(ctx, config.QueueRepository, db)
+	if err != nil {
+		return err
+	}
+	defer closeQueueRepository()
+	_, queuesInRedis := queueRepository.(*repository.RedisQueueRepository)
+	if err := backfillQueueResources(jobRepository, queueRepository); err != nil {
+		return err
+	}
 	schedulingInfoRepository := repository.NewRedisSchedulingInfoRepository(db)
+	barrierRepository := repository.NewRedisBarrierRepository(db)
+	operationRepository := repository.NewRedisOperationRepository(db)
+	submitFailureReportRepository := repository.NewRedisSubmitFailureReportRepository(db)
+	ownershipGroupsRepository := repository.NewRedisOwnershipGroupsRepository(db)
+	quarantineRepository := repository.NewRedisQuarantineRepository(db)
+	jobSetExpiryRepository := repository.NewRedisJobSetExpiryRepository(db)
+	budgetRepository := repository.NewRedisBudgetRepository(db)
+	usageRecordRepository := repository.NewRedisUsageRecordRepository(db, config.UsageRecordRetention)
+	cordonRepository := repository.NewRedisCordonRepository(db)
+	maintenanceWindowRepository := repository.NewRedisMaintenanceWindowRepository(db)
+	unschedulableJobRepository := repository.NewRedisUnschedulableJobRepository(db)
+	schedulingRoundRepository := repository.NewRedisSchedulingRoundRepository(db)
+	// Executor Repositories for pulsar and legacy schedulers respectively
+	pulsarExecutorRepo := schedulerdb.NewRedisExecutorRepository(db, "pulsar")
+	legacyExecutorRepo := schedulerdb.NewRedisExecutorRepository(db, "legacy")
+
+	var executorHealthAlerter server.ExecutorHealthAlerter
+	if config.ExecutorHealth.AlertWebhookUrl != "" {
+		executorHealthAlerter = server.NewWebhookExecutorHealthAlerter(config.ExecutorHealth)
+	}
+	executorHealthMonitor := server.NewExecutorHealthMonitor(
+		repository.NewRedisExecutorStatusRepository(db),
+		pulsarExecutorRepo,
+		executorHealthAlerter,
+		config.ExecutorHealth,
+	)
 	healthChecks.Add(repository.NewRedisHealth(db))
 
+	// In test mode, operators may inject faults into the repositories and event store via the TestMode service.
+	faultInjector := repository.NewFaultInjector()
+	if config.TestMode.Enabled {
+		log.Warnf("Test mode enabled; synthetic load may be submitted to queue %s and faults may be injected", config.TestMode.SandboxQueue)
+		jobRepository = repository.NewFaultInjectingJobRepository(jobRepository, faultInjector)
+		queueRepository = repository.NewFaultInjectingQueueRepository(queueRepository, faultInjector)
+	}
+
 	eventRepository := repository.NewEventRepository(eventDb)
 
-	authorizer := server.NewAuthorizer(
-		authorization.NewPrincipalPermissionChecker(
-			config.Auth.PermissionGroupMapping,
-			config.Auth.PermissionScopeMapping,
-			config.Auth.PermissionClaimMapping,
-		),
-	)
+	// Read-heavy operations, e.g., listing queues and reading events, are optionally routed to read replicas.
+	queueReaders := replica.NewPrimaryRouter(queueRepository)
+	jobReaders := replica.NewPrimaryRouter(jobRepository)
+	eventReaders := replica.NewPrimaryRouter[repository.EventRepository](eventRepository)
+	if len(config.ReadReplicas.Redis.Addrs) > 0 {
+		replicaDb := createRedisClient(&config.ReadReplicas.Redis)
+		defer func() {
+			if err := replicaDb.Close(); err != nil {
+				log.WithError(err).Error("failed to close Redis read replica client")
+			}
+		}()
+		lag := repository.RedisReplicationLag(replicaDb)
+		// Queues and jobs stored in postgres aren't replicated to the Redis replicas.
+		if queuesInRedis {
+			queueReaders = replica.NewRouter[repository.QueueRepository](
+				queueRepository,
+				repository.NewRedisQueueRepository(replicaDb),
+				lag,
+				config.ReadReplicas.MaxStaleness,
+				config.ReadReplicas.StalenessCheckInterval,
+			)
+		}
+		if jobsInRedis {
+			newJobRepository, err := jobRepositoryFactory(config.PodSpecStorage)
+			if err != nil {
+				return err
+			}
+			jobReaders = replica.NewRouter[repository.JobRepository](
+				jobRepository,
+				newJobRepository(replicaDb),
+				lag,
+				config.ReadReplicas.MaxStaleness,
+				config.ReadReplicas.StalenessCheckInterval,
+			)
+		}
+	}
+	if len(config.ReadReplicas.EventsApiRedis.Addrs) > 0 {
+		eventReplicaDb := createRedisClient(&config.ReadReplicas.EventsApiRedis)
+		defer func() {
+			if err := eventReplicaDb.Close(); err != nil {
+				log.WithError(err).Error("failed to close events api Redis read replica client")
+			}
+		}()
+		eventReaders = replica.NewRouter[repository.EventRepository](
+			eventRepository,
+			repository.NewEventRepository(eventReplicaDb),
+			repository.RedisReplicationLag(eventReplicaDb),
+			config.ReadReplicas.MaxStaleness,
+			config.ReadReplicas.StalenessCheckInterval,
+		)
+	}
+	// Only GetAllQueues is routed to the replica; GetQueue, which the submit path relies on, reads from the primary.
+	replicaReadingQueueRepository := repository.NewReplicaReadingQueueRepository(queueReaders)
+	// Must not be used by the scheduler or the submit path, since reads may be stale.
+	replicaReadingJobRepository := repository.NewReplicaReadingJobRepository(jobReaders)
+	replicaReadingEventRepository := repository.NewReplicaReadingEventRepository(eventReaders)
 
 	// If pool settings are provided, open a connection pool to be shared by all services.
 	var pool *pgxpool.Pool
@@ -131,10 +304,6 @@ func Serve(ctx *armadacontext.Context, config *configuration.ArmadaConfig, healt
 		defer pool.Close()
 	}
 
-	// Executor Repositories for pulsar and legacy schedulers respectively
-	pulsarExecutorRepo := schedulerdb.NewRedisExecutorRepository(db, "pulsar")
-	legacyExecutorRepo := schedulerdb.NewRedisExecutorRepository(db, "legacy")
-
 	pulsarSchedulerSubmitChecker := scheduler.NewSubmitChecker(
 		30*time.Minute,
 		config.Scheduling,
@@ -174,18 +343,68 @@ func Serve(ctx *armadacontext.Context, config *configuration.ArmadaConfig, healt
 	}
 	defer producer.Close()
 
-	eventStore := repository.NewEventStore(producer, config.Pulsar.MaxAllowedMessageSize)
+	var eventStore repository.EventStore = repository.NewEventStore(producer, config.Pulsar.MaxAllowedMessageSize)
+	if config.TestMode.Enabled {
+		eventStore = repository.NewFaultInjectingEventStore(eventStore, faultInjector)
+	}
+	// Events are journaled before being published, such that they can be replayed if publishing them fails.
+	// Replayed events are published directly, bypassing the journal.
+	var eventJournalServer *server.EventJournalServer
+	if config.EventJournal.Enabled {
+		eventJournal := repository.NewRedisEventJournal(db, config.EventJournal.MaxEvents)
+		eventJournalServer = server.NewEventJournalServer(authorizer, eventJournal, eventStore, config.EventJournal.ReplayBatchSize)
+		eventStore = repository.NewJournalingEventStore(eventJournal, eventStore)
+	}
+	var mirroringEventStore *repository.MirroringEventStore
+	if config.EventMirror.Enabled {
+		eventMirrorProducer := repository.NewKafkaEventMirrorProducer(
+			config.EventMirror.Brokers,
+			config.EventMirror.WriteTimeout,
+			config.EventMirror.Tls,
+		)
+		defer func() {
+			if err := eventMirrorProducer.Close(); err != nil {
+				log.WithError(err).Warn("error closing event mirror producer")
+			}
+		}()
+		mirroringEventStore = repository.NewMirroringEventStore(
+			eventStore,
+			eventMirrorProducer,
+			repository.NewRedisEventMirrorRetryQueue(db),
+			config.EventMirror.Topic,
+			config.EventMirror.DeadLetterTopic,
+			config.EventMirror.MaxAttempts,
+			config.EventMirror.Backoff,
+		)
+		eventStore = mirroringEventStore
+	}
 
 	submitServer := server.NewSubmitServer(
 		authorizer,
 		jobRepository,
-		queueRepository,
+		replicaReadingQueueRepository,
 		eventStore,
 		schedulingInfoRepository,
+		barrierRepository,
+		operationRepository,
+		submitFailureReportRepository,
+		ownershipGroupsRepository,
 		config.CancelJobsBatchSize,
+		config.CancelJobsParallelism,
 		&config.QueueManagement,
 		&config.Scheduling,
+		&config.SubmitFailures,
+		&config.Compression,
 	)
+	submitServer.EventRepository = replicaReadingEventRepository
+	budgetAccountant := server.NewBudgetAccountant(queueRepository, jobRepository, budgetRepository)
+	submitServer.BudgetAccountant = budgetAccountant
+	submitServer.CordonRepository = cordonRepository
+	submitServer.MaintenanceWindowRepository = maintenanceWindowRepository
+	submitServer.ExecutorHealthMonitor = executorHealthMonitor
+	if config.JobLogs.BinocularsConnection.ArmadaUrl != "" {
+		submitServer.JobLogProxy = server.NewJobLogProxy(config.JobLogs)
+	}
 
 	pulsarSubmitServer := &server.PulsarSubmitServer{
 		Producer:                          producer,
@@ -199,7 +418,11 @@ func Serve(ctx *armadacontext.Context, config *configuration.ArmadaConfig, healt
 		Rand:                              util.NewThreadsafeRand(time.Now().UnixNano()),
 		GangIdAnnotation:                  configuration.GangIdAnnotation,
 		IgnoreJobSubmitChecks:             config.IgnoreJobSubmitChecks,
+		JobSetExpiryRepository:            jobSetExpiryRepository,
+		MaxRuntimeRepository:              repository.NewRedisMaxRuntimeRepository(db),
+		Metrics:                           metrics.NewSubmitMetrics(),
 	}
+	prometheus.MustRegister(pulsarSubmitServer.Metrics)
 	submitServerToRegister := pulsarSubmitServer
 
 	// If postgres details were provided, enable deduplication.
@@ -243,6 +466,28 @@ func Serve(ctx *armadacontext.Context, config *configuration.ArmadaConfig, healt
 		return submitFromLog.Run(ctx)
 	})
 
+	// Service that mirrors the events published to Pulsar for the Pulsar scheduler,
+	// which, unlike those of the legacy scheduler, bypass the event store.
+	if mirroringEventStore != nil {
+		eventMirrorConsumer, err := pulsarClient.Subscribe(pulsar.ConsumerOptions{
+			Topic:             config.Pulsar.JobsetEventsTopic,
+			SubscriptionName:  config.Pulsar.EventMirrorSubscription,
+			Type:              pulsar.KeyShared,
+			ReceiverQueueSize: config.Pulsar.ReceiverQueueSize,
+		})
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		defer eventMirrorConsumer.Close()
+		eventMirrorFromLog := server.EventMirrorFromLog{
+			Consumer: eventMirrorConsumer,
+			Mirror:   mirroringEventStore,
+		}
+		services = append(services, func() error {
+			return eventMirrorFromLog.Run(ctx)
+		})
+	}
+
 	// Service that reads from Pulsar and logs events.
 	if config.Pulsar.EventsPrinter {
 		eventsPrinter := server.EventsPrinter{
@@ -255,7 +500,7 @@ func Serve(ctx *armadacontext.Context, config *configuration.ArmadaConfig, healt
 		})
 	}
 
-	usageServer := server.NewUsageServer(authorizer, config.PriorityHalfTime, &config.Scheduling, usageRepository, queueRepository)
+	usageServer := server.NewUsageServer(authorizer, config.PriorityHalfTime, &config.Scheduling, usageRepository, replicaReadingQueueRepository)
 
 	aggregatedQueueServer := server.NewAggregatedQueueServer(
 		authorizer,
@@ -265,6 +510,8 @@ func Serve(ctx *armadacontext.Context, config *configuration.ArmadaConfig, healt
 		usageRepository,
 		eventStore,
 		schedulingInfoRepository,
+		barrierRepository,
+		ownershipGroupsRepository,
 		producer,
 		config.Pulsar.MaxAllowedMessageSize,
 		legacyExecutorRepo,
@@ -275,6 +522,23 @@ func Serve(ctx *armadacontext.Context, config *configuration.ArmadaConfig, healt
 		return err
 	}
 	aggregatedQueueServer.SchedulingContextRepository = schedulingContextRepository
+	aggregatedQueueServer.SchedulingRoundRepository = schedulingRoundRepository
+	aggregatedQueueServer.QuarantineRepository = quarantineRepository
+	aggregatedQueueServer.CordonRepository = cordonRepository
+	aggregatedQueueServer.MaintenanceWindowRepository = maintenanceWindowRepository
+	aggregatedQueueServer.ExecutorHealthMonitor = executorHealthMonitor
+	submitServer.SchedulingContextRepository = schedulingContextRepository
+	submitServer.SchedulingRoundRepository = schedulingRoundRepository
+	if config.ImageResolver.Enabled {
+		imageResolver, err := imageresolver.New(config.ImageResolver)
+		if err != nil {
+			return err
+		}
+		submitServer.ImageResolver = imageResolver
+	}
+	if config.SubmissionPolicy.OpaUrl != "" {
+		submitServer.SubmissionPolicy = server.NewOpaSubmissionPolicy(config.SubmissionPolicy)
+	}
 
 	var schedulingReportsServer schedulerobjects.SchedulerReportingServer
 	if config.PulsarSchedulerEnabled {
@@ -290,30 +554,102 @@ func Serve(ctx *armadacontext.Context, config *configuration.ArmadaConfig, healt
 
 	eventServer := server.NewEventServer(
 		authorizer,
-		eventRepository,
+		replicaReadingEventRepository,
 		eventStore,
 		queueRepository,
-		jobRepository,
+		replicaReadingJobRepository,
 	)
+	eventServer.BudgetAccountant = budgetAccountant
+	usageRecorder := server.NewUsageRecorder(jobRepository, usageRecordRepository)
+	eventServer.UsageRecorder = usageRecorder
+
+	// Service that records the usage of jobs of the Pulsar scheduler, the events of which bypass the event server.
+	usageRecorderConsumer, err := pulsarClient.Subscribe(pulsar.ConsumerOptions{
+		Topic:             config.Pulsar.JobsetEventsTopic,
+		SubscriptionName:  config.Pulsar.UsageRecorderSubscription,
+		Type:              pulsar.KeyShared,
+		ReceiverQueueSize: config.Pulsar.ReceiverQueueSize,
+	})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer usageRecorderConsumer.Close()
+	usageRecorderFromLog := server.EventMirrorFromLog{
+		Consumer: usageRecorderConsumer,
+		Mirror:   usageRecorder,
+	}
+	services = append(services, func() error {
+		return usageRecorderFromLog.Run(ctx)
+	})
+	queryServer := server.NewQueryServer(authorizer, replicaReadingQueueRepository, replicaReadingEventRepository)
+	queryServer.QuarantineRepository = quarantineRepository
+	queryServer.JobRepository = jobRepository
+	queryServer.UsageRecordRepository = usageRecordRepository
+	queryServer.SchedulingInfoRepository = schedulingInfoRepository
 	leaseManager := scheduling.NewLeaseManager(jobRepository, queueRepository, eventStore, config.Scheduling.Lease.ExpireAfter)
 
 	// Allows for registering functions to be run periodically in the background.
 	taskManager := task.NewBackgroundTaskManager(commonmetrics.MetricPrefix)
 	defer taskManager.StopAll(time.Second * 2)
 	taskManager.Register(leaseManager.ExpireLeases, config.Scheduling.Lease.ExpiryLoopInterval, "lease_expiry")
+	taskManager.Register(pulsarSubmitServer.ExpireJobSets, config.JobSetExpiryLoopInterval, "job_set_expiry")
+	taskManager.Register(pulsarSubmitServer.CancelJobsExceedingMaxRuntime, config.MaxRuntimeLoopInterval, "max_runtime")
+	taskManager.Register(submitServer.RelayOutboxEvents, config.EventOutboxRelayInterval, "event_outbox_relay")
+	if mirroringEventStore != nil {
+		taskManager.Register(mirroringEventStore.RetryMirroring, config.EventMirror.RetryInterval, "event_mirror_retry")
+	}
+	unschedulableJobReporter := server.NewUnschedulableJobReporter(queueRepository, jobRepository, schedulingInfoRepository, unschedulableJobRepository, eventStore)
+	taskManager.Register(unschedulableJobReporter.ReportUnschedulableJobs, config.UnschedulableJobsLoopInterval, "unschedulable_jobs")
+	taskManager.Register(budgetAccountant.AccountRuns, config.BudgetAccountingLoopInterval, "budget_accounting")
+	taskManager.Register(usageRecorder.AccrueUsage, config.UsageAccrualLoopInterval, "usage_accrual")
+	taskManager.Register(submitServer.AnnounceMaintenanceWindows, config.MaintenanceWindowLoopInterval, "maintenance_windows")
+	taskManager.Register(executorHealthMonitor.CheckExecutors, config.ExecutorHealth.CheckInterval, "executor_health")
+	if len(config.QueuePermissionSync.Rules) > 0 || len(config.QueuePermissionSync.ManagedGroups) > 0 {
+		queuePermissionSynchronizer, err := server.NewQueuePermissionSynchronizer(queueRepository, config.QueuePermissionSync)
+		if err != nil {
+			return err
+		}
+		taskManager.Register(queuePermissionSynchronizer.SyncPermissions, config.QueuePermissionSync.Interval, "queue_permission_sync")
+	}
 
 	if config.Metrics.ExposeSchedulingMetrics {
-		queueCache := cache.NewQueueCache(&util.UTCClock{}, queueRepository, jobRepository, schedulingInfoRepository)
+		queueCache := cache.NewQueueCache(&util.UTCClock{}, replicaReadingQueueRepository, replicaReadingJobRepository, schedulingInfoRepository)
 		taskManager.Register(queueCache.Refresh, config.Metrics.RefreshInterval, "refresh_queue_cache")
-		metrics.ExposeDataMetrics(queueRepository, jobRepository, usageRepository, schedulingInfoRepository, queueCache)
+		metrics.ExposeDataMetrics(replicaReadingQueueRepository, replicaReadingJobRepository, usageRepository, schedulingInfoRepository, queueCache)
 	}
 
 	api.RegisterSubmitServer(grpcServer, submitServerToRegister)
+	apiv2.RegisterSubmitServer(grpcServer, server.NewSubmitServerV2(submitServerToRegister))
+	apiv2.RegisterJobsServer(grpcServer, server.NewJobsServerV2(authorizer, replicaReadingQueueRepository, replicaReadingJobRepository))
 	api.RegisterUsageServer(grpcServer, usageServer)
 	api.RegisterEventServer(grpcServer, eventServer)
+	api.RegisterQueryServer(grpcServer, queryServer)
 	schedulerobjects.RegisterSchedulerReportingServer(grpcServer, schedulingReportsServer)
 
 	api.RegisterAggregatedQueueServer(grpcServer, aggregatedQueueServer)
+	if config.TestMode.Enabled {
+		testModeServer := server.NewTestModeServer(
+			authorizer,
+			submitServerToRegister,
+			faultInjector,
+			&config.TestMode,
+			config.Scheduling.DefaultJobLimits,
+		)
+		api.RegisterTestModeServer(grpcServer, testModeServer)
+	}
+	if config.ExecutorCredentials.Enabled {
+		api.RegisterExecutorCredentialsServer(grpcServer, executorCredentialsServer)
+	}
+	if config.ApiTokens.Enabled {
+		api.RegisterApiTokensServer(grpcServer, apiTokensServer)
+	}
+	if config.EventJournal.Enabled {
+		api.RegisterEventJournalServer(grpcServer, eventJournalServer)
+	}
+	if config.JobSessions.Enabled {
+		jobSessionServer := server.NewJobSessionServer(authorizer, queueRepository, eventRepository, config.JobSessions.AttachTimeout)
+		api.RegisterJobSessionsServer(grpcServer, jobSessionServer)
+	}
 	grpc_prometheus.Register(grpcServer)
 
 	// Cancel the errgroup if grpcServer.Serve returns an error.
@@ -337,6 +673,144 @@ func Serve(ctx *armadacontext.Context, config *configuration.ArmadaConfig, healt
 	return g.Wait()
 }
 
+// jobRepositoryFactory returns a function creating job repositories storing jobs in the given database,
+// and pod specs as configured.
+func jobRepositoryFactory(config configuration.PodSpecStorageConfig) (func(db redis.UniversalClient) *repository.RedisJobRepository, error) {
+	newJobRepository := repository.NewRedisJobRepository
+	if config.ThresholdBytes > 0 {
+		podSpecStore, err := repository.NewFileObjectStore(config.Directory)
+		if err != nil {
+			return nil, errors.WithMessage(err, "error creating pod spec store")
+		}
+		newJobRepository = func(db redis.UniversalClient) *repository.RedisJobRepository {
+			return repository.NewRedisJobRepositoryWithPodSpecStore(db, podSpecStore, config.ThresholdBytes)
+		}
+	}
+	if !config.Deduplicate {
+		return newJobRepository, nil
+	}
+	return func(db redis.UniversalClient) *repository.RedisJobRepository {
+		return newJobRepository(db).WithPodSpecDeduplication()
+	}, nil
+}
+
+// createJobRepository returns the job repository of the configured backend, storing jobs in db if that's Redis,
+// and a function releasing its resources. Very large pod specs are optionally stored outside of either.
+func createJobRepository(
+	ctx *armadacontext.Context,
+	config configuration.JobRepositoryConfig,
+	podSpecStorage configuration.PodSpecStorageConfig,
+	db redis.UniversalClient,
+) (repository.JobRepository, func(), error) {
+	switch config.Backend {
+	case "", configuration.JobRepositoryBackendRedis:
+		newJobRepository, err := jobRepositoryFactory(podSpecStorage)
+		if err != nil {
+			return nil, nil, err
+		}
+		return newJobRepository(db), func() {}, nil
+	case configuration.JobRepositoryBackendPostgres:
+		codec := repository.NewJobCodec(nil, 0)
+		if podSpecStorage.ThresholdBytes > 0 {
+			podSpecStore, err := repository.NewFileObjectStore(podSpecStorage.Directory)
+			if err != nil {
+				return nil, nil, errors.WithMessage(err, "error creating pod spec store")
+			}
+			codec = repository.NewJobCodec(podSpecStore, podSpecStorage.ThresholdBytes)
+		}
+		jobDb, err := database.OpenPgxPool(config.Postgres)
+		if err != nil {
+			return nil, nil, errors.WithMessage(err, "error opening connection to job database")
+		}
+		if err := pgjob.Migrate(ctx, jobDb); err != nil {
+			jobDb.Close()
+			return nil, nil, errors.WithMessage(err, "error migrating job database")
+		}
+		return pgjob.NewPostgresJobRepository(jobDb, codec), jobDb.Close, nil
+	default:
+		return nil, nil, errors.Errorf("unknown job repository backend %q", config.Backend)
+	}
+}
+
+// queueResourcesBackfiller is implemented by job repositories that may store jobs without recorded resources.
+type queueResourcesBackfiller interface {
+	BackfillQueueResources(queues []string) (int, error)
+}
+
+// backfillQueueResources records the resources of jobs stored before resources were counted towards their queues,
+// such that queue quotas account for them.
+func backfillQueueResources(jobRepository repository.JobRepository, queueRepository repository.QueueRepository) error {
+	backfiller, ok := jobRepository.(queueResourcesBackfiller)
+	if !ok {
+		return nil
+	}
+	queues, err := queueRepository.GetAllQueues()
+	if err != nil {
+		return errors.WithMessage(err, "error getting queues to backfill the resources of")
+	}
+	queueNames := make([]string, len(queues))
+	for i, q := range queues {
+		queueNames[i] = q.Name
+	}
+	backfilled, err := backfiller.BackfillQueueResources(queueNames)
+	if err != nil {
+		return errors.WithMessage(err, "error backfilling queue resources")
+	}
+	if backfilled > 0 {
+		log.Infof("Backfilled the resources of %d jobs stored before queue resources were counted", backfilled)
+	}
+	return nil
+}
+
+// createQueueRepository returns the queue repository of the configured backend, storing queues in db if that's Redis,
+// and a function releasing its resources.
+func createQueueRepository(
+	ctx *armadacontext.Context,
+	config configuration.QueueRepositoryConfig,
+	db redis.UniversalClient,
+) (repository.QueueRepository, func(), error) {
+	switch config.Backend {
+	case "", configuration.QueueRepositoryBackendRedis:
+		return repository.NewRedisQueueRepository(db), func() {}, nil
+	case configuration.QueueRepositoryBackendPostgres:
+		queueDb, err := database.OpenPgxPool(config.Postgres)
+		if err != nil {
+			return nil, nil, errors.WithMessage(err, "error opening connection to queue database")
+		}
+		if err := pgqueue.Migrate(ctx, queueDb); err != nil {
+			queueDb.Close()
+			return nil, nil, errors.WithMessage(err, "error migrating queue database")
+		}
+		return pgqueue.NewPostgresQueueRepository(queueDb), queueDb.Close, nil
+	default:
+		return nil, nil, errors.Errorf("unknown queue repository backend %q", config.Backend)
+	}
+}
+
+func createAuditSink(ctx *armadacontext.Context, config configuration.AuditLogConfig) (audit.Sink, error) {
+	switch config.Sink {
+	case "", configuration.AuditLogSinkFile:
+		return audit.NewFileSink(config.File)
+	case configuration.AuditLogSinkPostgres:
+		auditDb, err := database.OpenPgxPool(config.Postgres)
+		if err != nil {
+			return nil, errors.WithMessage(err, "error opening connection to audit database")
+		}
+		if err := audit.Migrate(ctx, auditDb); err != nil {
+			auditDb.Close()
+			return nil, errors.WithMessage(err, "error migrating audit database")
+		}
+		return audit.NewPostgresSink(auditDb), nil
+	case configuration.AuditLogSinkKafka:
+		if len(config.Kafka.Brokers) == 0 || config.Kafka.Topic == "" {
+			return nil, errors.New("audit log kafka brokers and topic must be set")
+		}
+		return audit.NewKafkaSink(config.Kafka.Brokers, config.Kafka.Topic, config.Kafka.WriteTimeout, config.Kafka.Tls), nil
+	default:
+		return nil, errors.Errorf("unknown audit log sink %q", config.Sink)
+	}
+}
+
 func createRedisClient(config *redis.UniversalOptions) redis.UniversalClient {
 	return redis.NewUniversalClient(config)
 }
@@ -349,6 +823,13 @@ func validateCancelJobsBatchSizeConfig(config *configuration.ArmadaConfig) error
 	return nil
 }
 
+func validateTestModeConfig(config configuration.TestModeConfig) error {
+	if config.Enabled && config.MaxJobsPerRun == 0 {
+		return errors.WithStack(fmt.Errorf("test mode max jobs per run should be greater than 0 if test mode is enabled"))
+	}
+	return nil
+}
+
 func validatePreemptionConfig(config configuration.PreemptionConfig) error {
 	// Check that the default priority class is in the priority class map.
 	if config.DefaultPriorityClass != "" {
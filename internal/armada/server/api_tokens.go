@@ -0,0 +1,284 @@
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/strings/slices"
+
+	"github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/internal/armada/permissions"
+	"github.com/armadaproject/armada/internal/armada/repository"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/armadaerrors"
+	"github.com/armadaproject/armada/internal/common/auth/authorization"
+	"github.com/armadaproject/armada/internal/common/auth/permission"
+	"github.com/armadaproject/armada/internal/common/util"
+	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/client/queue"
+)
+
+// The last used time of API tokens is written at most this often per token.
+const apiTokenLastUsedResolution = time.Minute
+
+// ApiTokenMethods are the methods requests authenticated with API tokens may call.
+// Queue actions are further restricted to the scopes of the token.
+var ApiTokenMethods = []string{
+	"/api.Submit/SubmitJobs",
+	"/api.Submit/CancelJobs",
+	"/api.Submit/CancelJobSet",
+	"/api.Submit/ReprioritizeJobs",
+	"/api.Submit/GetQueue",
+	"/api.Submit/GetSubmitFailureReport",
+	"/api.Submit/GetOperation",
+	"/api.Event/GetJobSetEvents",
+	"/api.Event/Watch",
+}
+
+// ApiTokensServer issues, lists, and revokes long-lived tokens users may hand to, e.g., CI systems.
+// It also verifies such tokens on behalf of authorization.ApiTokenAuthService.
+type ApiTokensServer struct {
+	authorizer ActionAuthorizer
+	repository repository.ApiTokenRepository
+	config     configuration.ApiTokensConfig
+	clock      clock.Clock
+}
+
+func NewApiTokensServer(
+	authorizer ActionAuthorizer,
+	repository repository.ApiTokenRepository,
+	config configuration.ApiTokensConfig,
+) *ApiTokensServer {
+	return &ApiTokensServer{
+		authorizer: authorizer,
+		repository: repository,
+		config:     config,
+		clock:      clock.RealClock{},
+	}
+}
+
+func (s *ApiTokensServer) CreateToken(grpcCtx context.Context, req *api.ApiTokenCreateRequest) (*api.ApiTokenSecret, error) {
+	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+	principal := authorization.GetPrincipal(ctx)
+	if err := authorizeApiTokenManagement(principal); err != nil {
+		return nil, status.Errorf(codes.PermissionDenied, "[CreateToken] %s", err)
+	}
+	if err := validateApiTokenScopes(req.Scopes); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "[CreateToken] %s", err)
+	}
+	ownerGroups := slices.Filter(nil, principal.GetGroupNames(), func(group string) bool {
+		return group != authorization.EveryoneGroup
+	})
+	maxLifetime := s.maxLifetime(len(ownerGroups) > 0)
+	lifetime := req.Lifetime
+	if lifetime == 0 {
+		lifetime = s.config.DefaultLifetime
+		if maxLifetime > 0 && lifetime > maxLifetime {
+			lifetime = maxLifetime
+		}
+	}
+	if lifetime <= 0 || (maxLifetime > 0 && lifetime > maxLifetime) {
+		return nil, status.Errorf(codes.InvalidArgument, "[CreateToken] lifetime must be positive and at most %s, but is %s", maxLifetime, lifetime)
+	}
+
+	secretBytes := make([]byte, 32)
+	if _, err := rand.Read(secretBytes); err != nil {
+		return nil, status.Errorf(codes.Internal, "[CreateToken] error generating secret: %s", err)
+	}
+	secret := base64.RawURLEncoding.EncodeToString(secretBytes)
+	secretHash := sha256.Sum256([]byte(secret))
+	now := s.clock.Now().UTC()
+	token := &api.ApiToken{
+		Id:          util.NewULID(),
+		Name:        req.Name,
+		Owner:       principal.GetName(),
+		OwnerGroups: ownerGroups,
+		Scopes:      req.Scopes,
+		Created:     now,
+		Expires:     now.Add(lifetime),
+	}
+	if err := s.repository.AddApiToken(token, secretHash[:]); err != nil {
+		return nil, status.Errorf(codes.Unavailable, "[CreateToken] error storing token: %s", err)
+	}
+	log.Infof("%s created api token %s (%s) expiring at %s", token.Owner, token.Id, token.Name, token.Expires)
+	return &api.ApiTokenSecret{
+		ApiToken: token,
+		Token:    token.Id + "." + secret,
+	}, nil
+}
+
+func (s *ApiTokensServer) ListTokens(grpcCtx context.Context, req *api.ApiTokenListRequest) (*api.ApiTokenList, error) {
+	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+	if err := authorizeApiTokenManagement(authorization.GetPrincipal(ctx)); err != nil {
+		return nil, status.Errorf(codes.PermissionDenied, "[ListTokens] %s", err)
+	}
+	owner := req.Owner
+	if owner == "" {
+		owner = authorization.GetPrincipal(ctx).GetName()
+	}
+	if err := s.authorizeOwner(ctx, owner); err != nil {
+		return nil, status.Errorf(codes.PermissionDenied, "[ListTokens] error: %s", err)
+	}
+	tokens, err := s.repository.GetApiTokens(owner)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "[ListTokens] error getting tokens of %s: %s", owner, err)
+	}
+	return &api.ApiTokenList{Tokens: tokens}, nil
+}
+
+func (s *ApiTokensServer) RevokeTokens(grpcCtx context.Context, req *api.ApiTokenRevokeRequest) (*types.Empty, error) {
+	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+	if err := authorizeApiTokenManagement(authorization.GetPrincipal(ctx)); err != nil {
+		return nil, status.Errorf(codes.PermissionDenied, "[RevokeTokens] %s", err)
+	}
+	for _, id := range req.Ids {
+		token, _, err := s.repository.GetApiToken(id)
+		var notFoundErr *repository.ErrApiTokenNotFound
+		if errors.As(err, &notFoundErr) {
+			return nil, status.Errorf(codes.NotFound, "[RevokeTokens] %s", err)
+		} else if err != nil {
+			return nil, status.Errorf(codes.Unavailable, "[RevokeTokens] error getting token %s: %s", id, err)
+		}
+		if err := s.authorizeOwner(ctx, token.Owner); err != nil {
+			return nil, status.Errorf(codes.PermissionDenied, "[RevokeTokens] error: %s", err)
+		}
+		if token.Revoked == nil {
+			now := s.clock.Now().UTC()
+			token.Revoked = &now
+			if err := s.repository.UpdateApiToken(token); err != nil {
+				return nil, status.Errorf(codes.Unavailable, "[RevokeTokens] error revoking token %s: %s", id, err)
+			}
+		}
+		log.Infof("%s revoked api token %s of %s", authorization.GetPrincipal(ctx).GetName(), token.Id, token.Owner)
+	}
+	return &types.Empty{}, nil
+}
+
+// VerifyApiToken implements authorization.ApiTokenVerifier.
+func (s *ApiTokensServer) VerifyApiToken(_ context.Context, tokenId string, secret string) (*authorization.ApiTokenPrincipal, error) {
+	token, secretHash, err := s.repository.GetApiToken(tokenId)
+	if err != nil {
+		return nil, err
+	}
+	hash := sha256.Sum256([]byte(secret))
+	if subtle.ConstantTimeCompare(hash[:], secretHash) != 1 {
+		return nil, errors.Errorf("invalid secret for api token %s", tokenId)
+	}
+	now := s.clock.Now()
+	if token.Revoked != nil {
+		return nil, errors.Errorf("api token %s was revoked at %s", tokenId, token.Revoked)
+	}
+	if expires := s.expires(token); !now.Before(expires) {
+		return nil, errors.Errorf("api token %s expired at %s", tokenId, expires)
+	}
+	if token.LastUsed == nil || now.Sub(*token.LastUsed) >= apiTokenLastUsedResolution {
+		if err := s.repository.MarkApiTokenUsed(tokenId, now); err != nil {
+			log.WithError(err).Warnf("error recording use of api token %s", tokenId)
+		}
+	}
+	scopes := make([]authorization.ApiTokenScope, len(token.Scopes))
+	for i, scope := range token.Scopes {
+		scopes[i] = authorization.ApiTokenScope{Queue: scope.Queue, Verbs: scope.Verbs}
+	}
+	return authorization.NewApiTokenPrincipal(token.Id, token.Owner, token.OwnerGroups, scopes), nil
+}
+
+// maxLifetime returns the maximum lifetime of tokens, which is shorter for tokens carrying the groups of their owner.
+// Zero means tokens don't have a maximum lifetime.
+func (s *ApiTokensServer) maxLifetime(withGroups bool) time.Duration {
+	maxLifetime := s.config.MaxLifetime
+	if withGroups && s.config.MaxLifetimeWithGroups > 0 && (maxLifetime == 0 || s.config.MaxLifetimeWithGroups < maxLifetime) {
+		maxLifetime = s.config.MaxLifetimeWithGroups
+	}
+	return maxLifetime
+}
+
+// expires returns when token expires. Groups are copied into tokens when they're created, so tokens carrying groups
+// expire once they've outlived MaxLifetimeWithGroups, also if they were created before it was lowered.
+func (s *ApiTokensServer) expires(token *api.ApiToken) time.Time {
+	expires := token.Expires
+	if len(token.OwnerGroups) > 0 && s.config.MaxLifetimeWithGroups > 0 {
+		if groupsExpire := token.Created.Add(s.config.MaxLifetimeWithGroups); groupsExpire.Before(expires) {
+			expires = groupsExpire
+		}
+	}
+	return expires
+}
+
+// authorizeApiTokenManagement returns an error if principal acts for someone else, i.e., is an API token or a user
+// impersonated by another principal, which may neither mint long-lived credentials nor manage those of the user.
+func authorizeApiTokenManagement(principal authorization.Principal) error {
+	switch principal.(type) {
+	case *authorization.ApiTokenPrincipal:
+		return errors.New("requests authenticated with an api token may not manage api tokens")
+	case *authorization.ImpersonatedPrincipal:
+		return errors.New("requests made on behalf of other users may not manage api tokens")
+	}
+	return nil
+}
+
+// authorizeOwner returns an error unless the principal of ctx is owner or has the manage_api_tokens permission.
+func (s *ApiTokensServer) authorizeOwner(ctx *armadacontext.Context, owner string) error {
+	if authorization.GetPrincipal(ctx).GetName() == owner {
+		return nil
+	}
+	return s.authorizer.AuthorizeAction(ctx, permissions.ManageApiTokens)
+}
+
+func validateApiTokenScopes(scopes []*api.ApiTokenScope) error {
+	if len(scopes) == 0 {
+		return errors.New("at least one scope must be given")
+	}
+	for i, scope := range scopes {
+		if scope.Queue == "" {
+			return errors.Errorf("scope %d has no queue", i)
+		}
+		if len(scope.Verbs) == 0 {
+			return errors.Errorf("scope %d has no verbs", i)
+		}
+		if _, err := queue.NewPermissionVerbs(scope.Verbs); err != nil {
+			return errors.Wrapf(err, "scope %d has invalid verbs", i)
+		}
+	}
+	return nil
+}
+
+// authorizeApiTokenAction returns an error if principal is an API token, since tokens may only perform queue actions.
+func authorizeApiTokenAction(principal authorization.Principal, perm permission.Permission) error {
+	token, ok := principal.(*authorization.ApiTokenPrincipal)
+	if !ok {
+		return nil
+	}
+	return &armadaerrors.ErrUnauthorized{
+		Principal:  principal.GetName(),
+		Permission: string(perm),
+		Action:     string(perm),
+		Message:    fmt.Sprintf("api token %s may only perform the queue actions it's scoped to", token.TokenId),
+	}
+}
+
+// authorizeApiTokenQueueAction returns an error if principal is an API token not scoped to perform verb on the queue
+// with the given name. Other principals are left to the usual permission checks.
+func authorizeApiTokenQueueAction(principal authorization.Principal, queueName string, verb queue.PermissionVerb) error {
+	token, ok := principal.(*authorization.ApiTokenPrincipal)
+	if !ok || token.AllowsQueueAction(queueName, string(verb)) {
+		return nil
+	}
+	return &armadaerrors.ErrUnauthorized{
+		Principal:  principal.GetName(),
+		Permission: string(verb),
+		Action:     string(verb) + " for queue " + queueName,
+		Message:    fmt.Sprintf("api token %s isn't scoped to %s on queue %s", token.TokenId, verb, queueName),
+	}
+}
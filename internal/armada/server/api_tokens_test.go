@@ -0,0 +1,193 @@
+package server
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	clock "k8s.io/utils/clock/testing"
+
+	"github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/internal/armada/repository"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/auth/authorization"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+func TestApiTokensServer_CreateAndVerify(t *testing.T) {
+	withApiTokensServer(&FakeActionAuthorizer{}, func(s *ApiTokensServer, fakeClock *clock.FakeClock) {
+		ctx := contextWithPrincipal(authorization.NewStaticPrincipal("alice", []string{"ml-team"}))
+		secret, err := s.CreateToken(ctx, &api.ApiTokenCreateRequest{
+			Name:   "ci",
+			Scopes: []*api.ApiTokenScope{{Queue: "ml-training", Verbs: []string{"submit"}}},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "alice", secret.ApiToken.Owner)
+		assert.Equal(t, []string{"ml-team"}, secret.ApiToken.OwnerGroups)
+		assert.Equal(t, fakeClock.Now().Add(24*time.Hour), secret.ApiToken.Expires)
+
+		tokenId, token, ok := strings.Cut(secret.Token, ".")
+		require.True(t, ok)
+		assert.Equal(t, secret.ApiToken.Id, tokenId)
+
+		principal, err := s.VerifyApiToken(ctx, tokenId, token)
+		require.NoError(t, err)
+		assert.Equal(t, "alice", principal.GetName())
+		assert.True(t, principal.IsInGroup("ml-team"))
+		assert.True(t, principal.AllowsQueueAction("ml-training", "submit"))
+		assert.False(t, principal.AllowsQueueAction("ml-training", "cancel"))
+
+		_, err = s.VerifyApiToken(ctx, tokenId, token+"x")
+		assert.Error(t, err)
+
+		tokens, err := s.ListTokens(ctx, &api.ApiTokenListRequest{})
+		require.NoError(t, err)
+		require.Len(t, tokens.Tokens, 1)
+		require.NotNil(t, tokens.Tokens[0].LastUsed)
+		assert.Equal(t, fakeClock.Now(), *tokens.Tokens[0].LastUsed)
+
+		// Tokens can't be used to create further tokens.
+		_, err = s.CreateToken(contextWithPrincipal(principal), &api.ApiTokenCreateRequest{
+			Scopes: []*api.ApiTokenScope{{Queue: "ml-training", Verbs: []string{"submit"}}},
+		})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		fakeClock.Step(24 * time.Hour)
+		_, err = s.VerifyApiToken(ctx, tokenId, token)
+		assert.Error(t, err)
+	})
+}
+
+func TestApiTokensServer_LimitsLifetimeOfTokensWithGroups(t *testing.T) {
+	withApiTokensServer(&FakeActionAuthorizer{}, func(s *ApiTokensServer, fakeClock *clock.FakeClock) {
+		scopes := []*api.ApiTokenScope{{Queue: "ml-training", Verbs: []string{"submit"}}}
+		create := func(groups []string, lifetime time.Duration) (*api.ApiTokenSecret, error) {
+			ctx := contextWithPrincipal(authorization.NewStaticPrincipal("alice", groups))
+			return s.CreateToken(ctx, &api.ApiTokenCreateRequest{Scopes: scopes, Lifetime: lifetime})
+		}
+		verify := func(secret *api.ApiTokenSecret) error {
+			tokenId, token, _ := strings.Cut(secret.Token, ".")
+			_, err := s.VerifyApiToken(armadacontext.Background(), tokenId, token)
+			return err
+		}
+
+		// Tokens created before the lifetime of tokens with groups was limited expire once they exceed it.
+		withGroups, err := create([]string{"ml-team"}, 0)
+		require.NoError(t, err)
+		withoutGroups, err := create(nil, 0)
+		require.NoError(t, err)
+		s.config.MaxLifetimeWithGroups = time.Hour
+		fakeClock.Step(time.Hour)
+		assert.Error(t, verify(withGroups))
+		assert.NoError(t, verify(withoutGroups))
+
+		// Tokens with groups are created with at most that lifetime.
+		withGroups, err = create([]string{"ml-team"}, 0)
+		require.NoError(t, err)
+		assert.Equal(t, fakeClock.Now().Add(time.Hour), withGroups.ApiToken.Expires)
+		_, err = create([]string{"ml-team"}, 2*time.Hour)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		withoutGroups, err = create(nil, 2*time.Hour)
+		require.NoError(t, err)
+		assert.Equal(t, fakeClock.Now().Add(2*time.Hour), withoutGroups.ApiToken.Expires)
+	})
+}
+
+func TestApiTokensServer_CreateToken_Invalid(t *testing.T) {
+	withApiTokensServer(&FakeActionAuthorizer{}, func(s *ApiTokensServer, _ *clock.FakeClock) {
+		ctx := contextWithPrincipal(authorization.NewStaticPrincipal("alice", nil))
+		for name, req := range map[string]*api.ApiTokenCreateRequest{
+			"no scopes":         {},
+			"no queue":          {Scopes: []*api.ApiTokenScope{{Verbs: []string{"submit"}}}},
+			"no verbs":          {Scopes: []*api.ApiTokenScope{{Queue: "ml-training"}}},
+			"unknown verb":      {Scopes: []*api.ApiTokenScope{{Queue: "ml-training", Verbs: []string{"delete"}}}},
+			"lifetime too long": {Scopes: []*api.ApiTokenScope{{Queue: "ml-training", Verbs: []string{"submit"}}}, Lifetime: 48 * time.Hour},
+		} {
+			t.Run(name, func(t *testing.T) {
+				_, err := s.CreateToken(ctx, req)
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			})
+		}
+	})
+}
+
+func TestApiTokensServer_Revoke(t *testing.T) {
+	withApiTokensServer(&FakeDenyAllActionAuthorizer{}, func(s *ApiTokensServer, _ *clock.FakeClock) {
+		ctx := contextWithPrincipal(authorization.NewStaticPrincipal("alice", nil))
+		secret, err := s.CreateToken(ctx, &api.ApiTokenCreateRequest{
+			Scopes: []*api.ApiTokenScope{{Queue: "ml-training", Verbs: []string{"submit"}}},
+		})
+		require.NoError(t, err)
+
+		// Only the owner or principals with the manage_api_tokens permission may revoke or list tokens.
+		otherCtx := contextWithPrincipal(authorization.NewStaticPrincipal("bob", nil))
+		_, err = s.RevokeTokens(otherCtx, &api.ApiTokenRevokeRequest{Ids: []string{secret.ApiToken.Id}})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		_, err = s.ListTokens(otherCtx, &api.ApiTokenListRequest{Owner: "alice"})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		_, err = s.RevokeTokens(ctx, &api.ApiTokenRevokeRequest{Ids: []string{secret.ApiToken.Id}})
+		require.NoError(t, err)
+		tokenId, token, _ := strings.Cut(secret.Token, ".")
+		_, err = s.VerifyApiToken(ctx, tokenId, token)
+		assert.Error(t, err)
+
+		_, err = s.RevokeTokens(ctx, &api.ApiTokenRevokeRequest{Ids: []string{"unknown"}})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
+
+func TestApiTokensServer_RejectsImpersonatedPrincipals(t *testing.T) {
+	withApiTokensServer(&FakeActionAuthorizer{}, func(s *ApiTokensServer, _ *clock.FakeClock) {
+		ctx := contextWithPrincipal(authorization.NewStaticPrincipal("alice", nil))
+		secret, err := s.CreateToken(ctx, &api.ApiTokenCreateRequest{
+			Scopes: []*api.ApiTokenScope{{Queue: "ml-training", Verbs: []string{"submit"}}},
+		})
+		require.NoError(t, err)
+
+		engine := authorization.NewStaticPrincipal("engine", []string{"workflow-engines"})
+		impersonatedCtx := contextWithPrincipal(authorization.NewImpersonatedPrincipal("alice", []string{"admins"}, engine))
+		_, err = s.CreateToken(impersonatedCtx, &api.ApiTokenCreateRequest{
+			Scopes: []*api.ApiTokenScope{{Queue: "ml-training", Verbs: []string{"submit"}}},
+		})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		_, err = s.ListTokens(impersonatedCtx, &api.ApiTokenListRequest{})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		_, err = s.RevokeTokens(impersonatedCtx, &api.ApiTokenRevokeRequest{Ids: []string{secret.ApiToken.Id}})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		tokens, err := s.ListTokens(ctx, &api.ApiTokenListRequest{})
+		require.NoError(t, err)
+		require.Len(t, tokens.Tokens, 1)
+		assert.Nil(t, tokens.Tokens[0].Revoked)
+	})
+}
+
+func contextWithPrincipal(principal authorization.Principal) *armadacontext.Context {
+	return armadacontext.FromGrpcCtx(authorization.WithPrincipal(armadacontext.Background(), principal))
+}
+
+func withApiTokensServer(authorizer ActionAuthorizer, action func(s *ApiTokensServer, fakeClock *clock.FakeClock)) {
+	client := redis.NewClient(&redis.Options{Addr: "localhost:6379", DB: 10})
+	defer client.FlushDB()
+	defer client.Close()
+	client.FlushDB()
+
+	fakeClock := clock.NewFakeClock(time.Now().UTC().Truncate(time.Second))
+	s := NewApiTokensServer(
+		authorizer,
+		repository.NewRedisApiTokenRepository(client),
+		configuration.ApiTokensConfig{
+			Enabled:         true,
+			DefaultLifetime: 24 * time.Hour,
+			MaxLifetime:     24 * time.Hour,
+		},
+	)
+	s.clock = fakeClock
+	action(s, fakeClock)
+}
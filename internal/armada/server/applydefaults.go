@@ -14,9 +14,27 @@ func applyDefaultsToAnnotations(annotations map[string]string, config configurat
 	if annotations == nil {
 		return
 	}
+	applyBarrierGangAnnotations(annotations)
 	applyDefaultNodeUniformityLabelAnnotation(annotations, config)
 }
 
+// applyBarrierGangAnnotations turns the members of a barrier marked for gang-scheduling into a gang
+// with id and cardinality equal to that of the barrier. Jobs that explicitly set a gang id are left unchanged.
+func applyBarrierGangAnnotations(annotations map[string]string) {
+	if annotations[configuration.BarrierGangAnnotation] != "true" {
+		return
+	}
+	barrierId, ok := annotations[configuration.BarrierIdAnnotation]
+	if !ok {
+		return
+	}
+	if _, ok := annotations[configuration.GangIdAnnotation]; ok {
+		return
+	}
+	annotations[configuration.GangIdAnnotation] = barrierId
+	annotations[configuration.GangCardinalityAnnotation] = annotations[configuration.BarrierCardinalityAnnotation]
+}
+
 func applyDefaultNodeUniformityLabelAnnotation(annotations map[string]string, config configuration.SchedulingConfig) {
 	if _, ok := annotations[configuration.GangIdAnnotation]; ok {
 		if _, ok := annotations[configuration.GangNodeUniformityLabelAnnotation]; !ok {
@@ -31,9 +49,24 @@ func applyDefaultsToPodSpec(spec *v1.PodSpec, config configuration.SchedulingCon
 	}
 	applyDefaultPriorityClassNameToPodSpec(spec, config)
 	applyDefaultRequestsAndLimitsToPodSpec(spec, config)
+	applyRequiredNodeSelectorToPodSpec(spec, config)
 	applyDefaultTolerationsToPodSpec(spec, config)
 	applyDefaultActiveDeadlineSecondsToPodSpec(spec, config)
 	applyDefaultTerminationGracePeriodToPodSpec(spec, config)
+	applyDefaultRestartPolicyToPodSpec(spec, config)
+	applyDefaultServiceAccountToPodSpec(spec, config)
+}
+
+func applyRequiredNodeSelectorToPodSpec(spec *v1.PodSpec, config configuration.SchedulingConfig) {
+	if len(config.RequiredJobNodeSelector) == 0 {
+		return
+	}
+	if spec.NodeSelector == nil {
+		spec.NodeSelector = make(map[string]string, len(config.RequiredJobNodeSelector))
+	}
+	for label, value := range config.RequiredJobNodeSelector {
+		spec.NodeSelector[label] = value
+	}
 }
 
 func applyDefaultRequestsAndLimitsToPodSpec(spec *v1.PodSpec, config configuration.SchedulingConfig) {
@@ -84,11 +117,17 @@ func applyDefaultPriorityClassNameToPodSpec(spec *v1.PodSpec, config configurati
 }
 
 // applyDefaultTerminationGracePeriodToPodSpec sets the termination grace period
-// of the pod equal to the minimum if
+// of the pod equal to the default if
 // - the pod does not explicitly set a termination period, or
 // - the pod explicitly sets a termination period of 0.
+// The minimum is used as the default if no default within the allowed range is configured.
 func applyDefaultTerminationGracePeriodToPodSpec(spec *v1.PodSpec, config configuration.SchedulingConfig) {
-	if config.MinTerminationGracePeriod.Seconds() == 0 {
+	defaultTerminationGracePeriod := config.DefaultTerminationGracePeriod
+	if defaultTerminationGracePeriod < config.MinTerminationGracePeriod ||
+		(config.MaxTerminationGracePeriod != 0 && defaultTerminationGracePeriod > config.MaxTerminationGracePeriod) {
+		defaultTerminationGracePeriod = config.MinTerminationGracePeriod
+	}
+	if defaultTerminationGracePeriod.Seconds() == 0 {
 		return
 	}
 	var podTerminationGracePeriodSeconds int64
@@ -97,12 +136,24 @@ func applyDefaultTerminationGracePeriodToPodSpec(spec *v1.PodSpec, config config
 	}
 	if podTerminationGracePeriodSeconds == 0 {
 		defaultTerminationGracePeriodSeconds := int64(
-			config.MinTerminationGracePeriod.Seconds(),
+			defaultTerminationGracePeriod.Seconds(),
 		)
 		spec.TerminationGracePeriodSeconds = &defaultTerminationGracePeriodSeconds
 	}
 }
 
+func applyDefaultRestartPolicyToPodSpec(spec *v1.PodSpec, config configuration.SchedulingConfig) {
+	if spec.RestartPolicy == "" {
+		spec.RestartPolicy = config.DefaultRestartPolicy
+	}
+}
+
+func applyDefaultServiceAccountToPodSpec(spec *v1.PodSpec, config configuration.SchedulingConfig) {
+	if spec.ServiceAccountName == "" && spec.DeprecatedServiceAccount == "" {
+		spec.ServiceAccountName = config.DefaultServiceAccount
+	}
+}
+
 func applyDefaultActiveDeadlineSecondsToPodSpec(spec *v1.PodSpec, config configuration.SchedulingConfig) {
 	if spec.ActiveDeadlineSeconds != nil {
 		return
@@ -130,7 +181,11 @@ func applyDefaultActiveDeadlineSecondsToPodSpec(spec *v1.PodSpec, config configu
 // memory limit, but does not specify a memory request, assign a memory request that matches the limit.
 // Similarly, if a Container specifies its own CPU limit, but does not specify a CPU request, automatically
 // assigns a CPU request that matches the limit.
-func fillContainerRequestsAndLimits(containers []v1.Container) {
+// Requests and limits are then normalized according to config, see normalizeContainerResources.
+// Returns the requests and limits of the containers before normalization, by container name, if normalization
+// changed any of them, or nil otherwise.
+func fillContainerRequestsAndLimits(containers []v1.Container, config configuration.SchedulingConfig) map[string]v1.ResourceRequirements {
+	var originalResources map[string]v1.ResourceRequirements
 	for index := range containers {
 		if containers[index].Resources.Limits == nil {
 			containers[index].Resources.Limits = v1.ResourceList{}
@@ -149,5 +204,52 @@ func fillContainerRequestsAndLimits(containers []v1.Container) {
 				containers[index].Resources.Limits[resourceName] = quantity
 			}
 		}
+
+		resources := *containers[index].Resources.DeepCopy()
+		if normalizeContainerResources(&containers[index], config) {
+			if originalResources == nil {
+				originalResources = make(map[string]v1.ResourceRequirements, len(containers))
+			}
+			originalResources[containers[index].Name] = resources
+		}
+	}
+	return originalResources
+}
+
+// normalizeContainerResources rounds the requests and limits of container up to the increments of
+// config.ResourceRequestIncrements and, if config.RequestsEqualLimits is set, lowers its limits to its requests.
+// Returns true if any request or limit of container changed.
+func normalizeContainerResources(container *v1.Container, config configuration.SchedulingConfig) bool {
+	changed := false
+	for _, resources := range []v1.ResourceList{container.Resources.Requests, container.Resources.Limits} {
+		for resourceName, quantity := range resources {
+			increment, ok := config.ResourceRequestIncrements[string(resourceName)]
+			if !ok {
+				continue
+			}
+			if rounded := roundUpToIncrement(quantity, increment); rounded.Cmp(quantity) != 0 {
+				resources[resourceName] = rounded
+				changed = true
+			}
+		}
+	}
+	if config.RequestsEqualLimits {
+		for resourceName, limit := range container.Resources.Limits {
+			if request, ok := container.Resources.Requests[resourceName]; ok && request.Cmp(limit) != 0 {
+				container.Resources.Limits[resourceName] = request.DeepCopy()
+				changed = true
+			}
+		}
+	}
+	return changed
+}
+
+// roundUpToIncrement returns the smallest multiple of increment no less than quantity.
+// Non-positive quantities and increments leave quantity unchanged.
+func roundUpToIncrement(quantity resource.Quantity, increment resource.Quantity) resource.Quantity {
+	value, step := quantity.MilliValue(), increment.MilliValue()
+	if value <= 0 || step <= 0 || value%step == 0 {
+		return quantity
 	}
+	return *resource.NewMilliQuantity((value/step+1)*step, quantity.Format)
 }
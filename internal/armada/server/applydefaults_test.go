@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 
@@ -49,6 +50,38 @@ func TestApplyDefaultsToAnnotations(t *testing.T) {
 				configuration.GangNodeUniformityLabelAnnotation: "foo",
 			},
 		},
+		"BarrierGangAnnotation": {
+			Annotations: map[string]string{
+				configuration.BarrierIdAnnotation:          "bar",
+				configuration.BarrierCardinalityAnnotation: "3",
+				configuration.BarrierGangAnnotation:        "true",
+			},
+			Expected: map[string]string{
+				configuration.BarrierIdAnnotation:               "bar",
+				configuration.BarrierCardinalityAnnotation:      "3",
+				configuration.BarrierGangAnnotation:             "true",
+				configuration.GangIdAnnotation:                  "bar",
+				configuration.GangCardinalityAnnotation:         "3",
+				configuration.GangNodeUniformityLabelAnnotation: "",
+			},
+		},
+		"BarrierGangAnnotation no change for explicit gang": {
+			Annotations: map[string]string{
+				configuration.BarrierIdAnnotation:          "bar",
+				configuration.BarrierCardinalityAnnotation: "3",
+				configuration.BarrierGangAnnotation:        "true",
+				configuration.GangIdAnnotation:             "foo",
+				configuration.GangCardinalityAnnotation:    "1",
+			},
+			Expected: map[string]string{
+				configuration.BarrierIdAnnotation:               "bar",
+				configuration.BarrierCardinalityAnnotation:      "3",
+				configuration.BarrierGangAnnotation:             "true",
+				configuration.GangIdAnnotation:                  "foo",
+				configuration.GangCardinalityAnnotation:         "1",
+				configuration.GangNodeUniformityLabelAnnotation: "",
+			},
+		},
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -58,6 +91,80 @@ func TestApplyDefaultsToAnnotations(t *testing.T) {
 	}
 }
 
+func TestFillContainerRequestsAndLimits_Normalization(t *testing.T) {
+	tests := map[string]struct {
+		Config            configuration.SchedulingConfig
+		Resources         v1.ResourceRequirements
+		ExpectedResources v1.ResourceRequirements
+		Normalized        bool
+	}{
+		"no normalization": {
+			Resources: v1.ResourceRequirements{
+				Requests: v1.ResourceList{"cpu": resource.MustParse("150m")},
+				Limits:   v1.ResourceList{"cpu": resource.MustParse("300m")},
+			},
+			ExpectedResources: v1.ResourceRequirements{
+				Requests: v1.ResourceList{"cpu": resource.MustParse("150m")},
+				Limits:   v1.ResourceList{"cpu": resource.MustParse("300m")},
+			},
+		},
+		"rounded up to increment": {
+			Config: configuration.SchedulingConfig{
+				ResourceRequestIncrements: map[string]resource.Quantity{
+					"cpu":    resource.MustParse("100m"),
+					"memory": resource.MustParse("256Mi"),
+				},
+			},
+			Resources: v1.ResourceRequirements{
+				Requests: v1.ResourceList{"cpu": resource.MustParse("150m"), "memory": resource.MustParse("1Gi"), "gpu": resource.MustParse("1")},
+				Limits:   v1.ResourceList{"cpu": resource.MustParse("200m"), "memory": resource.MustParse("1100Mi")},
+			},
+			ExpectedResources: v1.ResourceRequirements{
+				Requests: v1.ResourceList{"cpu": resource.MustParse("200m"), "memory": resource.MustParse("1Gi"), "gpu": resource.MustParse("1")},
+				Limits:   v1.ResourceList{"cpu": resource.MustParse("200m"), "memory": resource.MustParse("1280Mi"), "gpu": resource.MustParse("1")},
+			},
+			Normalized: true,
+		},
+		"limits lowered to requests": {
+			Config: configuration.SchedulingConfig{
+				RequestsEqualLimits: true,
+			},
+			Resources: v1.ResourceRequirements{
+				Requests: v1.ResourceList{"cpu": resource.MustParse("1")},
+				Limits:   v1.ResourceList{"cpu": resource.MustParse("2"), "memory": resource.MustParse("1Gi")},
+			},
+			ExpectedResources: v1.ResourceRequirements{
+				Requests: v1.ResourceList{"cpu": resource.MustParse("1"), "memory": resource.MustParse("1Gi")},
+				Limits:   v1.ResourceList{"cpu": resource.MustParse("1"), "memory": resource.MustParse("1Gi")},
+			},
+			Normalized: true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			containers := []v1.Container{{Name: "main", Resources: tc.Resources}}
+			original := *containers[0].Resources.DeepCopy()
+			originalResources := fillContainerRequestsAndLimits(containers, tc.Config)
+			assertResourceListsEqual(t, tc.ExpectedResources.Requests, containers[0].Resources.Requests)
+			assertResourceListsEqual(t, tc.ExpectedResources.Limits, containers[0].Resources.Limits)
+			if tc.Normalized {
+				require.Contains(t, originalResources, "main")
+				assert.True(t, original.Limits["cpu"].Equal(originalResources["main"].Limits["cpu"]))
+			} else {
+				assert.Nil(t, originalResources)
+			}
+		})
+	}
+}
+
+func assertResourceListsEqual(t *testing.T, expected v1.ResourceList, actual v1.ResourceList) {
+	t.Helper()
+	assert.Equal(t, len(expected), len(actual))
+	for name, quantity := range expected {
+		assert.True(t, quantity.Equal(actual[name]), "unexpected %s", name)
+	}
+}
+
 func TestApplyDefaultsToPodSpec(t *testing.T) {
 	tests := map[string]struct {
 		Config   configuration.SchedulingConfig
@@ -75,6 +182,17 @@ func TestApplyDefaultsToPodSpec(t *testing.T) {
 				PriorityClassName: "pc",
 			},
 		},
+		"RequiredJobNodeSelector": {
+			Config: configuration.SchedulingConfig{
+				RequiredJobNodeSelector: map[string]string{"pool": "a"},
+			},
+			PodSpec: v1.PodSpec{
+				NodeSelector: map[string]string{"pool": "b", "disk": "ssd"},
+			},
+			Expected: v1.PodSpec{
+				NodeSelector: map[string]string{"pool": "a", "disk": "ssd"},
+			},
+		},
 		"DefaultJobLimits": {
 			Config: configuration.SchedulingConfig{
 				DefaultJobLimits: map[string]resource.Quantity{
@@ -389,6 +507,64 @@ func TestApplyDefaultsToPodSpec(t *testing.T) {
 				TerminationGracePeriodSeconds: pointerFromValue(int64(1)),
 			},
 		},
+		"DefaultTerminationGracePeriod": {
+			Config: configuration.SchedulingConfig{
+				MinTerminationGracePeriod:     time.Second,
+				MaxTerminationGracePeriod:     time.Minute,
+				DefaultTerminationGracePeriod: 30 * time.Second,
+			},
+			Expected: v1.PodSpec{
+				TerminationGracePeriodSeconds: pointerFromValue(int64(30)),
+			},
+		},
+		"DefaultTerminationGracePeriod outside allowed range": {
+			Config: configuration.SchedulingConfig{
+				MinTerminationGracePeriod:     time.Second,
+				MaxTerminationGracePeriod:     time.Minute,
+				DefaultTerminationGracePeriod: time.Hour,
+			},
+			Expected: v1.PodSpec{
+				TerminationGracePeriodSeconds: pointerFromValue(int64(1)),
+			},
+		},
+		"DefaultRestartPolicy": {
+			Config: configuration.SchedulingConfig{
+				DefaultRestartPolicy: v1.RestartPolicyNever,
+			},
+			Expected: v1.PodSpec{
+				RestartPolicy: v1.RestartPolicyNever,
+			},
+		},
+		"DefaultRestartPolicy existing": {
+			Config: configuration.SchedulingConfig{
+				DefaultRestartPolicy: v1.RestartPolicyNever,
+			},
+			PodSpec: v1.PodSpec{
+				RestartPolicy: v1.RestartPolicyOnFailure,
+			},
+			Expected: v1.PodSpec{
+				RestartPolicy: v1.RestartPolicyOnFailure,
+			},
+		},
+		"DefaultServiceAccount": {
+			Config: configuration.SchedulingConfig{
+				DefaultServiceAccount: "pipeline-runner",
+			},
+			Expected: v1.PodSpec{
+				ServiceAccountName: "pipeline-runner",
+			},
+		},
+		"DefaultServiceAccount existing": {
+			Config: configuration.SchedulingConfig{
+				DefaultServiceAccount: "pipeline-runner",
+			},
+			PodSpec: v1.PodSpec{
+				DeprecatedServiceAccount: "reader",
+			},
+			Expected: v1.PodSpec{
+				DeprecatedServiceAccount: "reader",
+			},
+		},
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -27,6 +27,9 @@ func NewAuthorizer(permissionChecker authorization.PermissionChecker) *Authorize
 
 func (b *Authorizer) AuthorizeAction(ctx *armadacontext.Context, perm permission.Permission) error {
 	principal := authorization.GetPrincipal(ctx)
+	if err := authorizeApiTokenAction(principal, perm); err != nil {
+		return err
+	}
 	if !b.permissionChecker.UserHasPermission(ctx, perm) {
 		return &armadaerrors.ErrUnauthorized{
 			Principal:  principal.GetName(),
@@ -45,6 +48,9 @@ func (b *Authorizer) AuthorizeQueueAction(
 	perm queue.PermissionVerb,
 ) error {
 	principal := authorization.GetPrincipal(ctx)
+	if err := authorizeApiTokenQueueAction(principal, queue.Name, perm); err != nil {
+		return err
+	}
 	hasAnyPerm := b.permissionChecker.UserHasPermission(ctx, anyPerm)
 	hasQueuePerm := principalHasQueuePermissions(principal, queue, perm)
 	if !hasAnyPerm && !hasQueuePerm {
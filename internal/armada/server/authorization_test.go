@@ -42,6 +42,14 @@ func TestAuthorizer_AuthorizeAction(t *testing.T) {
 			permissionCheckerResult: false,
 			expectAuthorized:        false,
 		},
+		"ContextWithApiToken_Denied": {
+			ctx: armadacontext.FromGrpcCtx(authorization.WithPrincipal(
+				context.Background(),
+				authorization.NewApiTokenPrincipal("token-1", "alice", nil, nil),
+			)),
+			permissionCheckerResult: true,
+			expectAuthorized:        false,
+		},
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -74,6 +82,12 @@ func TestAuthorizer_AuthorizeQueueAction(t *testing.T) {
 
 	authorizedPrincipal := authorization.NewStaticPrincipal("alice", []string{"submit-job-group"})
 	unauthorizedPrincipcal := authorization.NewStaticPrincipal("alice", []string{})
+	scopedToken := authorization.NewApiTokenPrincipal("token-1", "alice", []string{"submit-job-group"}, []authorization.ApiTokenScope{
+		{Queue: "test-queue", Verbs: []string{"submit"}},
+	})
+	otherQueueToken := authorization.NewApiTokenPrincipal("token-2", "alice", []string{"submit-job-group"}, []authorization.ApiTokenScope{
+		{Queue: "other-queue", Verbs: []string{"submit"}},
+	})
 
 	tests := map[string]struct {
 		ctx                     *armadacontext.Context
@@ -100,6 +114,16 @@ func TestAuthorizer_AuthorizeQueueAction(t *testing.T) {
 			permissionCheckerResult: true,
 			expectAuthorized:        true,
 		},
+		"api token scoped to queue": {
+			ctx:                     armadacontext.FromGrpcCtx(authorization.WithPrincipal(context.Background(), scopedToken)),
+			permissionCheckerResult: false,
+			expectAuthorized:        true,
+		},
+		"api token scoped to other queue": {
+			ctx:                     armadacontext.FromGrpcCtx(authorization.WithPrincipal(context.Background(), otherQueueToken)),
+			permissionCheckerResult: true,
+			expectAuthorized:        false,
+		},
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -0,0 +1,249 @@
+package server
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/exp/maps"
+	"k8s.io/utils/clock"
+
+	"github.com/armadaproject/armada/internal/armada/repository"
+	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/client/queue"
+)
+
+// BudgetAccountant accounts the runs of jobs of queues with resource budgets and enforces the budgets on submission.
+// A run starts once its job is reported running and finishes once it's reported done, or once the job is no longer
+// leased, e.g., because it was cancelled or its lease expired. Only runs of jobs of the legacy scheduler are accounted.
+type BudgetAccountant struct {
+	queueRepository  repository.QueueRepository
+	jobRepository    repository.JobRepository
+	budgetRepository repository.BudgetRepository
+	clock            clock.Clock
+}
+
+func NewBudgetAccountant(
+	queueRepository repository.QueueRepository,
+	jobRepository repository.JobRepository,
+	budgetRepository repository.BudgetRepository,
+) *BudgetAccountant {
+	return &BudgetAccountant{
+		queueRepository:  queueRepository,
+		jobRepository:    jobRepository,
+		budgetRepository: budgetRepository,
+		clock:            clock.RealClock{},
+	}
+}
+
+// HandleEvents starts a run for each running event, and finishes the run of each event that ends a run,
+// of jobs of queues with resource budgets. Events are handled in order, such that a batch may end one run
+// of a job and start the next. Handling the same events again has no further effect, since runs in progress
+// aren't started again, and a run started and finished again is recorded as the same finished run.
+func (a *BudgetAccountant) HandleEvents(events []*api.EventMessage) error {
+	var runEvents []api.Event
+	queueNames := make(map[string]bool)
+	for _, message := range events {
+		switch message.Events.(type) {
+		case *api.EventMessage_Running,
+			*api.EventMessage_Succeeded,
+			*api.EventMessage_Failed,
+			*api.EventMessage_LeaseReturned,
+			*api.EventMessage_LeaseExpired,
+			*api.EventMessage_Preempted,
+			*api.EventMessage_Cancelled,
+			*api.EventMessage_Terminated:
+			event, err := api.UnwrapEvent(message)
+			if err != nil {
+				return errors.WithMessage(err, "[BudgetAccountant.HandleEvents] error unwrapping event")
+			}
+			runEvents = append(runEvents, event)
+			queueNames[event.GetQueue()] = true
+		}
+	}
+	if len(runEvents) == 0 {
+		return nil
+	}
+
+	budgetedQueues := make(map[string]bool)
+	for queueName := range queueNames {
+		q, err := a.queueRepository.GetQueue(queueName)
+		var e *repository.ErrQueueNotFound
+		if errors.As(err, &e) {
+			continue
+		} else if err != nil {
+			return errors.WithMessagef(err, "[BudgetAccountant.HandleEvents] error getting queue %s", queueName)
+		}
+		if len(q.ResourceBudgets) > 0 {
+			budgetedQueues[q.Name] = true
+		}
+	}
+
+	runningJobIds := make(map[string]bool)
+	for _, event := range runEvents {
+		if _, ok := event.(*api.JobRunningEvent); ok && budgetedQueues[event.GetQueue()] {
+			runningJobIds[event.GetJobId()] = true
+		}
+	}
+	jobsById := make(map[string]*api.Job)
+	if len(runningJobIds) > 0 {
+		jobs, err := a.jobRepository.GetExistingJobsByIds(maps.Keys(runningJobIds))
+		if err != nil {
+			return errors.WithMessage(err, "[BudgetAccountant.HandleEvents] error getting jobs of running events")
+		}
+		for _, job := range jobs {
+			jobsById[job.Id] = job
+		}
+	}
+
+	for _, event := range runEvents {
+		if !budgetedQueues[event.GetQueue()] {
+			continue
+		}
+		if _, ok := event.(*api.JobRunningEvent); !ok {
+			if err := a.budgetRepository.FinishRun(event.GetQueue(), event.GetJobId(), event.GetCreated()); err != nil {
+				return err
+			}
+			continue
+		}
+		job, ok := jobsById[event.GetJobId()]
+		if !ok {
+			// The job is already done; there's nothing to account.
+			continue
+		}
+		run := &repository.BudgetedRun{
+			JobId:     job.Id,
+			Owner:     job.Owner,
+			Resources: job.TotalResourceRequest().AsFloat(),
+			Started:   event.GetCreated(),
+		}
+		if err := a.budgetRepository.StartRun(job.Queue, run); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AccountRuns finishes the runs of jobs that are no longer leased, e.g., because they were cancelled, and removes runs
+// that finished before the longest window of any budget of their queue. Runs of queues without budgets are removed.
+func (a *BudgetAccountant) AccountRuns() {
+	queues, err := a.queueRepository.GetAllQueues()
+	if err != nil {
+		log.WithError(err).Error("[BudgetAccountant.AccountRuns] error getting queues")
+		return
+	}
+	for _, q := range queues {
+		if err := a.accountRuns(q); err != nil {
+			log.WithError(err).Errorf("[BudgetAccountant.AccountRuns] error accounting runs of queue %s", q.Name)
+		}
+	}
+}
+
+func (a *BudgetAccountant) accountRuns(q queue.Queue) error {
+	if len(q.ResourceBudgets) == 0 {
+		return a.budgetRepository.DeleteRuns(q.Name)
+	}
+	now := a.clock.Now()
+	runs, err := a.budgetRepository.GetRunsInProgress(q.Name)
+	if err != nil {
+		return err
+	}
+	if len(runs) > 0 {
+		leasedJobIds, err := a.jobRepository.GetLeasedJobIds(q.Name)
+		if err != nil {
+			return err
+		}
+		leased := make(map[string]bool, len(leasedJobIds))
+		for _, jobId := range leasedJobIds {
+			leased[jobId] = true
+		}
+		for _, run := range runs {
+			if !leased[run.JobId] {
+				if err := a.budgetRepository.FinishRun(q.Name, run.JobId, now); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return a.budgetRepository.PruneRuns(q.Name, now.Add(-q.ResourceBudgets.LongestWindow()))
+}
+
+// BudgetStatuses returns the usage of each resource budget of q. Budgets that apply to each owner separately
+// are reported for owner.
+func (a *BudgetAccountant) BudgetStatuses(q queue.Queue, owner string) ([]*api.ResourceBudgetStatus, error) {
+	if len(q.ResourceBudgets) == 0 {
+		return nil, nil
+	}
+	now := a.clock.Now()
+	runs, err := a.budgetRepository.GetRuns(q.Name, now.Add(-q.ResourceBudgets.LongestWindow()))
+	if err != nil {
+		return nil, err
+	}
+	statuses := make([]*api.ResourceBudgetStatus, len(q.ResourceBudgets))
+	for i, budget := range q.ResourceBudgets {
+		status := &api.ResourceBudgetStatus{Budget: budget.ToAPI()}
+		since := now.Add(-budget.Window())
+		for _, run := range runs {
+			if budget.PerOwner && run.Owner != owner {
+				continue
+			}
+			status.UsedHours += run.UsedHours(budget.Resource, since, now)
+		}
+		if budget.PerOwner {
+			status.Owner = owner
+		}
+		if status.UsedHours < budget.Hours {
+			status.RemainingHours = budget.Hours - status.UsedHours
+		}
+		statuses[i] = status
+	}
+	return statuses, nil
+}
+
+// ApplyBudgets enforces the exhausted resource budgets of q on jobs submitted to it by owner.
+// Jobs requesting the resource of an exhausted budget that rejects jobs are returned as failed response items.
+// Otherwise, the priority of jobs requesting the resource of an exhausted budget that deprioritizes jobs
+// is raised to the deprioritized priority of the budget, and the returned warnings explain why, indexed by job id.
+func (a *BudgetAccountant) ApplyBudgets(q queue.Queue, owner string, jobs []*api.Job) ([]*api.JobSubmitResponseItem, map[string]string, error) {
+	statuses, err := a.BudgetStatuses(q, owner)
+	if err != nil {
+		return nil, nil, err
+	}
+	var responseItems []*api.JobSubmitResponseItem
+	rejected := make(map[string]bool)
+	warnings := make(map[string]string)
+	for i, status := range statuses {
+		if status.RemainingHours > 0 {
+			continue
+		}
+		budget := q.ResourceBudgets[i]
+		for _, job := range jobs {
+			if quantity := job.TotalResourceRequest()[budget.Resource]; quantity.Sign() <= 0 || rejected[job.Id] {
+				continue
+			}
+			reason := exhaustedBudgetReason(q.Name, budget, status)
+			if budget.Action == queue.ResourceBudgetActionReject {
+				rejected[job.Id] = true
+				responseItems = append(responseItems, api.NewFailedJobSubmitResponseItem(job.Id, api.JobSubmitError_EXCEEDS_QUEUE_LIMIT, "", reason))
+			} else if job.Priority < budget.DeprioritizedPriority {
+				job.Priority = budget.DeprioritizedPriority
+				warnings[job.Id] = fmt.Sprintf("priority raised to %g since %s", job.Priority, reason)
+			}
+		}
+	}
+	if len(responseItems) > 0 {
+		return responseItems, nil, nil
+	}
+	return nil, warnings, nil
+}
+
+func exhaustedBudgetReason(queueName string, budget queue.ResourceBudget, status *api.ResourceBudgetStatus) string {
+	scope := fmt.Sprintf("queue %s", queueName)
+	if budget.PerOwner {
+		scope = fmt.Sprintf("owner %s of queue %s", status.Owner, queueName)
+	}
+	return fmt.Sprintf(
+		"%s has used %.2f of its %g %s-hours over the last %d hours",
+		scope, status.UsedHours, budget.Hours, budget.Resource, budget.WindowHours,
+	)
+}
@@ -0,0 +1,203 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	clock "k8s.io/utils/clock/testing"
+
+	"github.com/armadaproject/armada/internal/armada/repository"
+	"github.com/armadaproject/armada/internal/common/util"
+	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/client/queue"
+)
+
+func TestSubmitServer_SubmitJobs_EnforcesResourceBudgets(t *testing.T) {
+	withBudgetAccountant(func(s *SubmitServer, jobRepo repository.JobRepository, a *BudgetAccountant, fakeClock *clock.FakeClock) {
+		setBudgets := func(budgets ...queue.ResourceBudget) {
+			require.NoError(t, s.queueRepository.UpdateQueue(queue.Queue{Name: "test", PriorityFactor: 1, ResourceBudgets: budgets}))
+		}
+		setBudgets(queue.ResourceBudget{Resource: "cpu", Hours: 2, WindowHours: 24, Action: queue.ResourceBudgetActionReject})
+
+		response, err := s.SubmitJobs(context.Background(), createJobRequest(util.NewULID(), 1))
+		require.NoError(t, err)
+		jobId := response.JobResponseItems[0].JobId
+
+		// The job has used 3 CPU-hours, exceeding the budget of 2.
+		now := fakeClock.Now()
+		require.NoError(t, a.HandleEvents(wrapEvents(t,
+			&api.JobRunningEvent{JobId: jobId, Queue: "test", Created: now.Add(-3 * time.Hour)},
+		)))
+		_, err = s.SubmitJobs(context.Background(), createJobRequest(util.NewULID(), 1))
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+		assert.Equal(t, []api.JobSubmitError_Code{api.JobSubmitError_EXCEEDS_QUEUE_LIMIT}, jobSubmitErrorCodes(err))
+
+		budgets, err := s.GetQueueBudgets(context.Background(), &api.QueueBudgetsRequest{Queue: "test"})
+		require.NoError(t, err)
+		require.Len(t, budgets.Budgets, 1)
+		assert.InDelta(t, 3.0, budgets.Budgets[0].UsedHours, 0.001)
+		assert.Equal(t, 0.0, budgets.Budgets[0].RemainingHours)
+
+		// Once the run finishes, it has only used 1 CPU-hour.
+		require.NoError(t, a.HandleEvents(wrapEvents(t,
+			&api.JobSucceededEvent{JobId: jobId, Queue: "test", Created: now.Add(-2 * time.Hour)},
+		)))
+		_, err = s.SubmitJobs(context.Background(), createJobRequest(util.NewULID(), 1))
+		assert.NoError(t, err)
+
+		// Usage outside of the window isn't counted.
+		setBudgets(queue.ResourceBudget{Resource: "cpu", Hours: 0.5, WindowHours: 1, Action: queue.ResourceBudgetActionReject})
+		_, err = s.SubmitJobs(context.Background(), createJobRequest(util.NewULID(), 1))
+		assert.NoError(t, err)
+
+		// Exhausted budgets that deprioritize jobs raise their priority.
+		setBudgets(queue.ResourceBudget{
+			Resource: "cpu", Hours: 0.5, WindowHours: 24, Action: queue.ResourceBudgetActionDeprioritize, DeprioritizedPriority: 100,
+		})
+		response, err = s.SubmitJobs(context.Background(), createJobRequest(util.NewULID(), 1))
+		require.NoError(t, err)
+		assert.Contains(t, response.JobResponseItems[0].Warning, "priority raised to 100")
+		jobs, err := jobRepo.GetExistingJobsByIds([]string{response.JobResponseItems[0].JobId})
+		require.NoError(t, err)
+		require.Len(t, jobs, 1)
+		assert.Equal(t, 100.0, jobs[0].Priority)
+	})
+}
+
+func TestBudgetAccountant_PerOwnerBudgets(t *testing.T) {
+	withBudgetAccountant(func(s *SubmitServer, jobRepo repository.JobRepository, a *BudgetAccountant, fakeClock *clock.FakeClock) {
+		q := queue.Queue{
+			Name:            "test",
+			PriorityFactor:  1,
+			ResourceBudgets: queue.ResourceBudgets{{Resource: "cpu", Hours: 2, WindowHours: 24, PerOwner: true, Action: queue.ResourceBudgetActionReject}},
+		}
+		require.NoError(t, s.queueRepository.UpdateQueue(q))
+		response, err := s.SubmitJobs(context.Background(), createJobRequest(util.NewULID(), 1))
+		require.NoError(t, err)
+		jobs, err := jobRepo.GetExistingJobsByIds([]string{response.JobResponseItems[0].JobId})
+		require.NoError(t, err)
+		owner := jobs[0].Owner
+
+		require.NoError(t, a.HandleEvents(wrapEvents(t,
+			&api.JobRunningEvent{JobId: jobs[0].Id, Queue: "test", Created: fakeClock.Now().Add(-3 * time.Hour)},
+		)))
+
+		statuses, err := a.BudgetStatuses(q, owner)
+		require.NoError(t, err)
+		assert.Equal(t, owner, statuses[0].Owner)
+		assert.Equal(t, 0.0, statuses[0].RemainingHours)
+
+		statuses, err = a.BudgetStatuses(q, "someone-else")
+		require.NoError(t, err)
+		assert.Equal(t, 2.0, statuses[0].RemainingHours)
+	})
+}
+
+func TestBudgetAccountant_AccountRuns(t *testing.T) {
+	withBudgetAccountant(func(s *SubmitServer, jobRepo repository.JobRepository, a *BudgetAccountant, fakeClock *clock.FakeClock) {
+		q := queue.Queue{
+			Name:            "test",
+			PriorityFactor:  1,
+			ResourceBudgets: queue.ResourceBudgets{{Resource: "cpu", Hours: 100, WindowHours: 24, Action: queue.ResourceBudgetActionReject}},
+		}
+		require.NoError(t, s.queueRepository.UpdateQueue(q))
+		response, err := s.SubmitJobs(context.Background(), createJobRequest(util.NewULID(), 1))
+		require.NoError(t, err)
+		jobId := response.JobResponseItems[0].JobId
+
+		require.NoError(t, a.HandleEvents(wrapEvents(t,
+			&api.JobRunningEvent{JobId: jobId, Queue: "test", Created: fakeClock.Now().Add(-time.Hour)},
+		)))
+
+		// The job isn't leased, e.g., because it was returned to the queue without being reported done,
+		// so its run is finished.
+		a.AccountRuns()
+		runs, err := a.budgetRepository.GetRunsInProgress("test")
+		require.NoError(t, err)
+		assert.Empty(t, runs)
+
+		statuses, err := a.BudgetStatuses(q, "")
+		require.NoError(t, err)
+		assert.InDelta(t, 1.0, statuses[0].UsedHours, 0.001)
+
+		// Runs are pruned once they've left the longest window.
+		fakeClock.Step(25 * time.Hour)
+		a.AccountRuns()
+		runs, err = a.budgetRepository.GetRuns("test", time.Time{})
+		require.NoError(t, err)
+		assert.Empty(t, runs)
+	})
+}
+
+func TestBudgetAccountant_HandleEvents_HandlesEventsReportedAgain(t *testing.T) {
+	withBudgetAccountant(func(s *SubmitServer, jobRepo repository.JobRepository, a *BudgetAccountant, fakeClock *clock.FakeClock) {
+		q := queue.Queue{
+			Name:            "test",
+			PriorityFactor:  1,
+			ResourceBudgets: queue.ResourceBudgets{{Resource: "cpu", Hours: 100, WindowHours: 24, Action: queue.ResourceBudgetActionReject}},
+		}
+		require.NoError(t, s.queueRepository.UpdateQueue(q))
+		response, err := s.SubmitJobs(context.Background(), createJobRequest(util.NewULID(), 1))
+		require.NoError(t, err)
+		jobId := response.JobResponseItems[0].JobId
+
+		// The events are reported again, e.g., because storing them failed once they were handled.
+		started := time.UnixMilli(fakeClock.Now().Add(-time.Hour).UnixMilli())
+		for i := 0; i < 2; i++ {
+			require.NoError(t, a.HandleEvents(wrapEvents(t,
+				&api.JobRunningEvent{JobId: jobId, Queue: "test", Created: started},
+				&api.JobFailedEvent{JobId: jobId, Queue: "test", Created: started.Add(30 * time.Minute)},
+			)))
+		}
+
+		runs, err := a.budgetRepository.GetRuns("test", time.Time{})
+		require.NoError(t, err)
+		assert.Len(t, runs, 1)
+		statuses, err := a.BudgetStatuses(q, "")
+		require.NoError(t, err)
+		assert.InDelta(t, 0.5, statuses[0].UsedHours, 0.001)
+	})
+}
+
+func TestBudgetAccountant_HandleEvents_IgnoresQueuesWithoutBudgets(t *testing.T) {
+	withBudgetAccountant(func(s *SubmitServer, jobRepo repository.JobRepository, a *BudgetAccountant, fakeClock *clock.FakeClock) {
+		response, err := s.SubmitJobs(context.Background(), createJobRequest(util.NewULID(), 1))
+		require.NoError(t, err)
+
+		require.NoError(t, a.HandleEvents(wrapEvents(t,
+			&api.JobRunningEvent{JobId: response.JobResponseItems[0].JobId, Queue: "test", Created: fakeClock.Now()},
+		)))
+		runs, err := a.budgetRepository.GetRunsInProgress("test")
+		require.NoError(t, err)
+		assert.Empty(t, runs)
+	})
+}
+
+func wrapEvents(t *testing.T, events ...api.Event) []*api.EventMessage {
+	messages := make([]*api.EventMessage, len(events))
+	for i, event := range events {
+		message, err := api.Wrap(event)
+		require.NoError(t, err)
+		messages[i] = message
+	}
+	return messages
+}
+
+func withBudgetAccountant(action func(s *SubmitServer, jobRepo repository.JobRepository, a *BudgetAccountant, fakeClock *clock.FakeClock)) {
+	withSubmitServerAndRepos(func(s *SubmitServer, jobRepo repository.JobRepository, events *repository.TestEventStore) {
+		client := redis.NewClient(&redis.Options{Addr: "localhost:6379", DB: 10})
+		defer client.Close()
+
+		fakeClock := clock.NewFakeClock(time.Now())
+		a := NewBudgetAccountant(s.queueRepository, jobRepo, repository.NewRedisBudgetRepository(client))
+		a.clock = fakeClock
+		s.BudgetAccountant = a
+		action(s, jobRepo, a, fakeClock)
+	})
+}
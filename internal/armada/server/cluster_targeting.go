@@ -0,0 +1,153 @@
+package server
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	"golang.org/x/exp/slices"
+
+	"github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+// clusterTargetingAnnotationKeys are the annotations the clusters targeted by a job are stored in.
+var clusterTargetingAnnotationKeys = []string{
+	configuration.RequiredClustersAnnotation,
+	configuration.PreferredClustersAnnotation,
+	configuration.ExcludedClustersAnnotation,
+}
+
+// clusterTargets are the clusters a job is required or preferred to run on, or excluded from,
+// each given by cluster id or pool.
+type clusterTargets struct {
+	required  []string
+	preferred []string
+	excluded  []string
+}
+
+// clusterTargetingAnnotations returns the annotations by which the scheduler identifies the clusters targeted by
+// targeting. Returns an error if any cluster is empty or given more than once, if a required or preferred cluster
+// is also excluded, or if annotations already contain any of the cluster targeting annotations.
+func clusterTargetingAnnotations(targeting *api.ClusterTargeting, annotations map[string]string) (map[string]string, error) {
+	if targeting == nil {
+		return nil, nil
+	}
+	targets := clusterTargets{required: targeting.Required, preferred: targeting.Preferred, excluded: targeting.Excluded}
+	result := make(map[string]string)
+	for _, key := range clusterTargetingAnnotationKeys {
+		clusters := targets.byAnnotation()[key]
+		if _, ok := annotations[key]; ok {
+			return nil, errors.Errorf("clusterTargeting may not be set together with annotation %s", key)
+		}
+		for i, cluster := range clusters {
+			if cluster == "" || strings.Contains(cluster, ",") {
+				return nil, errors.Errorf("cluster %q is invalid", cluster)
+			}
+			if slices.Contains(clusters[:i], cluster) {
+				return nil, errors.Errorf("cluster %s is given more than once", cluster)
+			}
+		}
+		if len(clusters) > 0 {
+			result[key] = strings.Join(clusters, ",")
+		}
+	}
+	for _, cluster := range targets.excluded {
+		if slices.Contains(targets.required, cluster) || slices.Contains(targets.preferred, cluster) {
+			return nil, errors.Errorf("cluster %s is both targeted and excluded", cluster)
+		}
+	}
+	if len(result) == 0 {
+		return nil, nil
+	}
+	return result, nil
+}
+
+// clusterTargetsFromAnnotations returns the clusters targeted by a job with the given annotations.
+func clusterTargetsFromAnnotations(annotations map[string]string) clusterTargets {
+	split := func(key string) []string {
+		if value := annotations[key]; value != "" {
+			return strings.Split(value, ",")
+		}
+		return nil
+	}
+	return clusterTargets{
+		required:  split(configuration.RequiredClustersAnnotation),
+		preferred: split(configuration.PreferredClustersAnnotation),
+		excluded:  split(configuration.ExcludedClustersAnnotation),
+	}
+}
+
+func (t clusterTargets) byAnnotation() map[string][]string {
+	return map[string][]string{
+		configuration.RequiredClustersAnnotation:  t.required,
+		configuration.PreferredClustersAnnotation: t.preferred,
+		configuration.ExcludedClustersAnnotation:  t.excluded,
+	}
+}
+
+func (t clusterTargets) isEmpty() bool {
+	return len(t.required) == 0 && len(t.preferred) == 0 && len(t.excluded) == 0
+}
+
+// allows returns true if a job targeting t may run on the cluster with the given id and pool. Preferred clusters are
+// required while any of them is among the active clusters, given as the pool of each active cluster by cluster id.
+func (t clusterTargets) allows(clusterId string, pool string, activePoolByClusterId map[string]string) bool {
+	if matchesAnyCluster(t.excluded, clusterId, pool) {
+		return false
+	}
+	if len(t.required) > 0 && !matchesAnyCluster(t.required, clusterId, pool) {
+		return false
+	}
+	if len(t.preferred) > 0 && !matchesAnyCluster(t.preferred, clusterId, pool) {
+		for activeClusterId, activePool := range activePoolByClusterId {
+			if matchesAnyCluster(t.preferred, activeClusterId, activePool) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func matchesAnyCluster(clusters []string, clusterId string, pool string) bool {
+	for _, cluster := range clusters {
+		if cluster == clusterId || (pool != "" && cluster == pool) {
+			return true
+		}
+	}
+	return false
+}
+
+// validateClusterTargetsExist returns a boolean indicating if each required and preferred cluster of each of the
+// provided jobs is the id or pool of a cluster known to the server, i.e., among allClusterSchedulingInfo.
+// Excluded clusters need not exist, e.g., since they may have been decommissioned.
+func validateClusterTargetsExist(
+	jobs []*api.Job,
+	allClusterSchedulingInfo map[string]*api.ClusterSchedulingInfoReport,
+) (bool, []*api.JobSubmitResponseItem, error) {
+	responseItems := make([]*api.JobSubmitResponseItem, 0)
+	for i, job := range jobs {
+		targets := clusterTargetsFromAnnotations(job.Annotations)
+		for _, cluster := range append(slices.Clone(targets.required), targets.preferred...) {
+			if !clusterExists(cluster, allClusterSchedulingInfo) {
+				response := api.NewFailedJobSubmitResponseItem(job.Id, api.JobSubmitError_UNSCHEDULABLE, "clusterTargeting",
+					fmt.Sprintf("%d-th job targets cluster %s, which is neither the id nor the pool of any known cluster", i, cluster))
+				responseItems = append(responseItems, response)
+				break
+			}
+		}
+	}
+	if len(responseItems) > 0 {
+		return false, responseItems, errors.Errorf("%d job(s) target unknown clusters; first error: %s", len(responseItems), responseItems[0].Error)
+	}
+	return true, nil, nil
+}
+
+func clusterExists(cluster string, allClusterSchedulingInfo map[string]*api.ClusterSchedulingInfoReport) bool {
+	for _, report := range allClusterSchedulingInfo {
+		if cluster == report.ClusterId || (report.Pool != "" && cluster == report.Pool) {
+			return true
+		}
+	}
+	return false
+}
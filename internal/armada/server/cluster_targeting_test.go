@@ -0,0 +1,120 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+func TestClusterTargetingAnnotations(t *testing.T) {
+	tests := map[string]struct {
+		targeting   *api.ClusterTargeting
+		annotations map[string]string
+		expected    map[string]string
+		valid       bool
+	}{
+		"nil": {
+			valid: true,
+		},
+		"empty": {
+			targeting: &api.ClusterTargeting{},
+			valid:     true,
+		},
+		"valid": {
+			targeting: &api.ClusterTargeting{Required: []string{"pool-a"}, Preferred: []string{"cluster-a", "cluster-b"}, Excluded: []string{"cluster-c"}},
+			expected: map[string]string{
+				configuration.RequiredClustersAnnotation:  "pool-a",
+				configuration.PreferredClustersAnnotation: "cluster-a,cluster-b",
+				configuration.ExcludedClustersAnnotation:  "cluster-c",
+			},
+			valid: true,
+		},
+		"empty cluster": {
+			targeting: &api.ClusterTargeting{Required: []string{""}},
+		},
+		"cluster containing comma": {
+			targeting: &api.ClusterTargeting{Required: []string{"cluster-a,cluster-b"}},
+		},
+		"duplicate cluster": {
+			targeting: &api.ClusterTargeting{Excluded: []string{"cluster-a", "cluster-a"}},
+		},
+		"targeted and excluded": {
+			targeting: &api.ClusterTargeting{Preferred: []string{"cluster-a"}, Excluded: []string{"cluster-a"}},
+		},
+		"annotation already set": {
+			targeting:   &api.ClusterTargeting{Required: []string{"cluster-a"}},
+			annotations: map[string]string{configuration.RequiredClustersAnnotation: "cluster-b"},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			annotations, err := clusterTargetingAnnotations(tc.targeting, tc.annotations)
+			if !tc.valid {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, annotations)
+			if annotations != nil {
+				targets := clusterTargetsFromAnnotations(annotations)
+				assert.Equal(t, tc.targeting.Required, targets.required)
+				assert.Equal(t, tc.targeting.Preferred, targets.preferred)
+				assert.Equal(t, tc.targeting.Excluded, targets.excluded)
+			}
+		})
+	}
+}
+
+func TestClusterTargets_Allows(t *testing.T) {
+	activePoolByClusterId := map[string]string{"cluster-a": "pool-a", "cluster-b": "pool-b"}
+	tests := map[string]struct {
+		targets   clusterTargets
+		clusterId string
+		pool      string
+		expected  bool
+	}{
+		"no targets": {
+			clusterId: "cluster-a",
+			expected:  true,
+		},
+		"required cluster": {
+			targets:   clusterTargets{required: []string{"cluster-a"}},
+			clusterId: "cluster-a",
+			expected:  true,
+		},
+		"required pool": {
+			targets:   clusterTargets{required: []string{"pool-a"}},
+			clusterId: "cluster-c",
+			pool:      "pool-a",
+			expected:  true,
+		},
+		"not required": {
+			targets:   clusterTargets{required: []string{"cluster-a"}},
+			clusterId: "cluster-b",
+		},
+		"excluded": {
+			targets:   clusterTargets{excluded: []string{"pool-b"}},
+			clusterId: "cluster-b",
+			pool:      "pool-b",
+		},
+		"not preferred while preferred cluster active": {
+			targets:   clusterTargets{preferred: []string{"pool-a"}},
+			clusterId: "cluster-b",
+			pool:      "pool-b",
+		},
+		"not preferred while no preferred cluster active": {
+			targets:   clusterTargets{preferred: []string{"cluster-c"}},
+			clusterId: "cluster-b",
+			pool:      "pool-b",
+			expected:  true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.targets.allows(tc.clusterId, tc.pool, activePoolByClusterId))
+		})
+	}
+}
@@ -0,0 +1,172 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/armadaproject/armada/internal/armada/permissions"
+	"github.com/armadaproject/armada/internal/armada/repository"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/armadaerrors"
+	"github.com/armadaproject/armada/internal/common/auth/authorization"
+	"github.com/armadaproject/armada/internal/common/auth/permission"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+// CordonQueue stops new leases from being given to jobs of a queue. Running jobs of the queue are unaffected.
+func (server *SubmitServer) CordonQueue(grpcCtx context.Context, req *api.CordonRequest) (*api.Cordon, error) {
+	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+	if err := server.authorizeCordon(ctx, "CordonQueue", permissions.CordonQueues); err != nil {
+		return nil, err
+	}
+	if req.Name == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "[CordonQueue] queue name must not be empty")
+	}
+	_, err := server.queueRepository.GetQueue(req.Name)
+	var e *repository.ErrQueueNotFound
+	if errors.As(err, &e) {
+		return nil, status.Errorf(codes.NotFound, "[CordonQueue] error: %s", err)
+	} else if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "[CordonQueue] error getting queue %q: %s", req.Name, err)
+	}
+
+	cordon := newCordon(ctx, req)
+	if err := server.CordonRepository.CordonQueue(cordon); err != nil {
+		return nil, status.Errorf(codes.Unavailable, "[CordonQueue] error cordoning queue %q: %s", req.Name, err)
+	}
+	log.WithFields(log.Fields{"queue": cordon.Name, "reason": cordon.Reason}).
+		Infof("queue %s cordoned by %s", cordon.Name, cordon.CordonedBy)
+	server.reportCordonEvents(cordon.Name, "", func(jobs []*api.Job) error {
+		return reportJobsCordoned(server.eventStore, jobs, cordon, "")
+	})
+	return cordon, nil
+}
+
+// UncordonQueue allows new leases to be given to jobs of a cordoned queue again.
+func (server *SubmitServer) UncordonQueue(grpcCtx context.Context, req *api.UncordonRequest) (*types.Empty, error) {
+	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+	if err := server.authorizeCordon(ctx, "UncordonQueue", permissions.CordonQueues); err != nil {
+		return nil, err
+	}
+	uncordoned, err := server.CordonRepository.UncordonQueue(req.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "[UncordonQueue] error uncordoning queue %q: %s", req.Name, err)
+	} else if !uncordoned {
+		return nil, status.Errorf(codes.NotFound, "[UncordonQueue] queue %q isn't cordoned", req.Name)
+	}
+	uncordonedBy := authorization.GetPrincipal(ctx).GetName()
+	log.WithFields(log.Fields{"queue": req.Name}).Infof("queue %s uncordoned by %s", req.Name, uncordonedBy)
+	server.reportCordonEvents(req.Name, "", func(jobs []*api.Job) error {
+		return reportJobsUncordoned(server.eventStore, jobs, "", uncordonedBy)
+	})
+	return &types.Empty{}, nil
+}
+
+// CordonExecutor stops new leases from being given to an executor, i.e., a cluster. Jobs running on it are unaffected.
+// Executors may be cordoned before they first connect.
+func (server *SubmitServer) CordonExecutor(grpcCtx context.Context, req *api.CordonRequest) (*api.Cordon, error) {
+	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+	if err := server.authorizeCordon(ctx, "CordonExecutor", permissions.CordonExecutors); err != nil {
+		return nil, err
+	}
+	if req.Name == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "[CordonExecutor] executor name must not be empty")
+	}
+
+	cordon := newCordon(ctx, req)
+	if err := server.CordonRepository.CordonExecutor(cordon); err != nil {
+		return nil, status.Errorf(codes.Unavailable, "[CordonExecutor] error cordoning executor %q: %s", req.Name, err)
+	}
+	log.WithFields(log.Fields{"executor": cordon.Name, "reason": cordon.Reason}).
+		Infof("executor %s cordoned by %s", cordon.Name, cordon.CordonedBy)
+	server.reportCordonEvents("", cordon.Name, func(jobs []*api.Job) error {
+		return reportJobsCordoned(server.eventStore, jobs, cordon, cordon.Name)
+	})
+	return cordon, nil
+}
+
+// UncordonExecutor allows new leases to be given to a cordoned executor again.
+func (server *SubmitServer) UncordonExecutor(grpcCtx context.Context, req *api.UncordonRequest) (*types.Empty, error) {
+	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+	if err := server.authorizeCordon(ctx, "UncordonExecutor", permissions.CordonExecutors); err != nil {
+		return nil, err
+	}
+	uncordoned, err := server.CordonRepository.UncordonExecutor(req.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "[UncordonExecutor] error uncordoning executor %q: %s", req.Name, err)
+	} else if !uncordoned {
+		return nil, status.Errorf(codes.NotFound, "[UncordonExecutor] executor %q isn't cordoned", req.Name)
+	}
+	uncordonedBy := authorization.GetPrincipal(ctx).GetName()
+	log.WithFields(log.Fields{"executor": req.Name}).Infof("executor %s uncordoned by %s", req.Name, uncordonedBy)
+	server.reportCordonEvents("", req.Name, func(jobs []*api.Job) error {
+		return reportJobsUncordoned(server.eventStore, jobs, req.Name, uncordonedBy)
+	})
+	return &types.Empty{}, nil
+}
+
+// GetCordons returns the cordons of all cordoned queues and executors, sorted by name.
+func (server *SubmitServer) GetCordons(_ context.Context, _ *api.CordonListRequest) (*api.CordonList, error) {
+	if server.CordonRepository == nil {
+		return nil, status.Errorf(codes.Unimplemented, "[GetCordons] cordoning isn't supported by this server")
+	}
+	queues, err := server.CordonRepository.GetCordonedQueues()
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "[GetCordons] error getting cordoned queues: %s", err)
+	}
+	executors, err := server.CordonRepository.GetCordonedExecutors()
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "[GetCordons] error getting cordoned executors: %s", err)
+	}
+	return &api.CordonList{Queues: sortedCordons(queues), Executors: sortedCordons(executors)}, nil
+}
+
+func (server *SubmitServer) authorizeCordon(ctx *armadacontext.Context, method string, perm permission.Permission) error {
+	if server.CordonRepository == nil {
+		return status.Errorf(codes.Unimplemented, "[%s] cordoning isn't supported by this server", method)
+	}
+	err := server.authorizer.AuthorizeAction(ctx, perm)
+	var ep *armadaerrors.ErrUnauthorized
+	if errors.As(err, &ep) {
+		return status.Errorf(codes.PermissionDenied, "[%s] error: %s", method, ep)
+	} else if err != nil {
+		return status.Errorf(codes.Unavailable, "[%s] error checking permissions: %s", method, err)
+	}
+	return nil
+}
+
+// reportCordonEvents calls report with the queued and leased jobs of queueName, or the jobs leased to executor.
+// The cordon has taken effect either way, so failing to report it is logged rather than failing the request.
+func (server *SubmitServer) reportCordonEvents(queueName string, executor string, report func(jobs []*api.Job) error) {
+	jobIds, err := server.affectedJobIds(queueName, executor)
+	if err == nil {
+		err = server.reportToExistingJobs(jobIds, report)
+	}
+	if err != nil {
+		log.WithError(err).WithFields(log.Fields{"queue": queueName, "executor": executor}).
+			Error("failed to report cordon events")
+	}
+}
+
+func newCordon(ctx *armadacontext.Context, req *api.CordonRequest) *api.Cordon {
+	return &api.Cordon{
+		Name:       req.Name,
+		Reason:     req.Reason,
+		CordonedBy: authorization.GetPrincipal(ctx).GetName(),
+		Cordoned:   time.Now().UTC(),
+	}
+}
+
+func sortedCordons(cordonsByName map[string]*api.Cordon) []*api.Cordon {
+	cordons := maps.Values(cordonsByName)
+	slices.SortFunc(cordons, func(a, b *api.Cordon) bool { return a.Name < b.Name })
+	return cordons
+}
@@ -0,0 +1,117 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-redis/redis"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/armadaproject/armada/internal/armada/repository"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+func TestSubmitServer_Cordons(t *testing.T) {
+	withSubmitServerAndRepos(func(s *SubmitServer, jobRepo repository.JobRepository, events *repository.TestEventStore) {
+		_, err := s.CordonQueue(context.Background(), &api.CordonRequest{Name: "test"})
+		assert.Equal(t, codes.Unimplemented, status.Code(err))
+
+		client := redis.NewClient(&redis.Options{Addr: "localhost:6379", DB: 10})
+		defer client.Close()
+		s.CordonRepository = repository.NewRedisCordonRepository(client)
+
+		queueCordon, err := s.CordonQueue(context.Background(), &api.CordonRequest{Name: "test", Reason: "maintenance"})
+		require.NoError(t, err)
+		assert.Equal(t, "test", queueCordon.Name)
+		assert.Equal(t, "maintenance", queueCordon.Reason)
+		assert.False(t, queueCordon.Cordoned.IsZero())
+		_, err = s.CordonQueue(context.Background(), &api.CordonRequest{Name: "missing"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		_, err = s.CordonExecutor(context.Background(), &api.CordonRequest{})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		executorCordon, err := s.CordonExecutor(context.Background(), &api.CordonRequest{Name: "test-cluster", Reason: "upgrade"})
+		require.NoError(t, err)
+
+		cordons, err := s.GetCordons(context.Background(), &api.CordonListRequest{})
+		require.NoError(t, err)
+		assert.Equal(t, []*api.Cordon{queueCordon}, cordons.Queues)
+		assert.Equal(t, []*api.Cordon{executorCordon}, cordons.Executors)
+
+		// Queued jobs of cordoned queues aren't leased.
+		_, err = s.SubmitJobs(context.Background(), createJobRequest("set", 1))
+		require.NoError(t, err)
+		adapter := &SchedulerJobRepositoryAdapter{r: jobRepo, cordonedQueues: map[string]bool{"test": true}}
+		ids, err := adapter.GetQueueJobIds("test")
+		require.NoError(t, err)
+		assert.Empty(t, ids)
+		adapter.cordonedQueues = nil
+		ids, err = adapter.GetQueueJobIds("test")
+		require.NoError(t, err)
+		assert.Len(t, ids, 1)
+
+		_, err = s.UncordonQueue(context.Background(), &api.UncordonRequest{Name: "test"})
+		require.NoError(t, err)
+		_, err = s.UncordonQueue(context.Background(), &api.UncordonRequest{Name: "test"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		_, err = s.UncordonExecutor(context.Background(), &api.UncordonRequest{Name: "test-cluster"})
+		require.NoError(t, err)
+		cordons, err = s.GetCordons(context.Background(), &api.CordonListRequest{})
+		require.NoError(t, err)
+		assert.Empty(t, cordons.Queues)
+		assert.Empty(t, cordons.Executors)
+
+		s.authorizer = &FakeDenyAllActionAuthorizer{}
+		_, err = s.CordonQueue(context.Background(), &api.CordonRequest{Name: "test"})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		_, err = s.UncordonExecutor(context.Background(), &api.UncordonRequest{Name: "test-cluster"})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+}
+
+func TestSubmitServer_Cordons_ReportEventsToAffectedJobs(t *testing.T) {
+	withSubmitServerAndRepos(func(s *SubmitServer, jobRepo repository.JobRepository, events *repository.TestEventStore) {
+		client := redis.NewClient(&redis.Options{Addr: "localhost:6379", DB: 10})
+		defer client.Close()
+		s.CordonRepository = repository.NewRedisCordonRepository(client)
+
+		response, err := s.SubmitJobs(context.Background(), createJobRequest("set", 2))
+		require.NoError(t, err)
+		leasedJobId := response.JobResponseItems[0].JobId
+		queuedJobId := response.JobResponseItems[1].JobId
+		_, err = jobRepo.TryLeaseJobs("test-cluster", map[string][]string{"test": {leasedJobId}})
+		require.NoError(t, err)
+
+		_, err = s.CordonQueue(context.Background(), &api.CordonRequest{Name: "test", Reason: "maintenance"})
+		require.NoError(t, err)
+		_, err = s.CordonExecutor(context.Background(), &api.CordonRequest{Name: "test-cluster", Reason: "upgrade"})
+		require.NoError(t, err)
+		var cordoned []*api.JobCordonedEvent
+		for _, event := range events.ReceivedEvents {
+			if e := event.GetCordoned(); e != nil {
+				cordoned = append(cordoned, e)
+			}
+		}
+		require.Len(t, cordoned, 3)
+		assert.ElementsMatch(t, []string{leasedJobId, queuedJobId}, []string{cordoned[0].JobId, cordoned[1].JobId})
+		assert.Equal(t, "", cordoned[0].Executor)
+		assert.Equal(t, "maintenance", cordoned[0].Reason)
+		assert.Equal(t, leasedJobId, cordoned[2].JobId)
+		assert.Equal(t, "test-cluster", cordoned[2].Executor)
+		assert.Equal(t, "upgrade", cordoned[2].Reason)
+
+		_, err = s.UncordonQueue(context.Background(), &api.UncordonRequest{Name: "test"})
+		require.NoError(t, err)
+		_, err = s.UncordonExecutor(context.Background(), &api.UncordonRequest{Name: "test-cluster"})
+		require.NoError(t, err)
+		var uncordonedJobIds []string
+		for _, event := range events.ReceivedEvents {
+			if e := event.GetUncordoned(); e != nil {
+				uncordonedJobIds = append(uncordonedJobIds, e.JobId)
+			}
+		}
+		assert.ElementsMatch(t, []string{leasedJobId, queuedJobId, leasedJobId}, uncordonedJobIds)
+	})
+}
@@ -12,10 +12,12 @@ import (
 
 	"github.com/armadaproject/armada/internal/armada/permissions"
 	"github.com/armadaproject/armada/internal/armada/repository"
-	"github.com/armadaproject/armada/internal/armada/repository/sequence"
 	"github.com/armadaproject/armada/internal/common/armadacontext"
 	"github.com/armadaproject/armada/internal/common/armadaerrors"
+	"github.com/armadaproject/armada/internal/common/auth/authorization"
+	"github.com/armadaproject/armada/internal/common/eventschema"
 	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/api/sequence"
 	"github.com/armadaproject/armada/pkg/client/queue"
 )
 
@@ -25,6 +27,13 @@ type EventServer struct {
 	queueRepository repository.QueueRepository
 	jobRepository   repository.JobRepository
 	eventStore      repository.EventStore
+	retryController *RetryController
+	// Accounts the runs of jobs against the resource budgets of their queues. If nil, runs aren't accounted.
+	BudgetAccountant *BudgetAccountant
+	// Records the resource usage of job runs for usage reports. If nil, usage isn't recorded.
+	UsageRecorder *UsageRecorder
+	// Translates events to the version of the event schema requested by clients.
+	translator *eventschema.Translator
 }
 
 func NewEventServer(
@@ -40,16 +49,41 @@ func NewEventServer(
 		eventStore:      eventStore,
 		queueRepository: queueRepository,
 		jobRepository:   jobRepository,
+		retryController: NewRetryController(jobRepository, eventStore),
+		translator:      eventschema.Default,
 	}
 }
 
+// Report and ReportMultiple retry failed runs, account runs and record usage before storing events, since the retry
+// controller sets whether failed runs are retried on their failed events. If any step fails, executors report the
+// same events again; hence each of these handlers must have no further effect when handling events again.
 func (s *EventServer) Report(grpcCtx context.Context, message *api.EventMessage) (*types.Empty, error) {
 	ctx := armadacontext.FromGrpcCtx(grpcCtx)
 	if err := s.authorizer.AuthorizeAction(ctx, permissions.ExecuteJobs); err != nil {
 		return nil, status.Errorf(codes.PermissionDenied, "[Report] error: %s", err)
 	}
+	messages, err := s.authorizeExecutorEvents(ctx, []*api.EventMessage{message})
+	var permErr *armadaerrors.ErrUnauthorized
+	if errors.As(err, &permErr) {
+		return nil, status.Errorf(codes.PermissionDenied, "[Report] error: %s", err)
+	} else if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "[Report] error: %s", err)
+	}
+	if len(messages) == 0 {
+		return &types.Empty{}, nil
+	}
+
+	if err := s.retryController.HandleFailedEvents(messages); err != nil {
+		return nil, status.Errorf(codes.Unavailable, "[Report] error handling failed events: %s", err)
+	}
+	if err := s.handleBudgetedEvents(messages); err != nil {
+		return nil, status.Errorf(codes.Unavailable, "[Report] error accounting runs: %s", err)
+	}
+	if err := s.recordUsage(messages); err != nil {
+		return nil, status.Errorf(codes.Unavailable, "[Report] error recording usage: %s", err)
+	}
 
-	return &types.Empty{}, s.eventStore.ReportEvents(ctx, []*api.EventMessage{message})
+	return &types.Empty{}, s.eventStore.ReportEvents(ctx, messages)
 }
 
 func (s *EventServer) ReportMultiple(grpcCtx context.Context, message *api.EventList) (*types.Empty, error) {
@@ -57,14 +91,101 @@ func (s *EventServer) ReportMultiple(grpcCtx context.Context, message *api.Event
 	if err := s.authorizer.AuthorizeAction(ctx, permissions.ExecuteJobs); err != nil {
 		return nil, status.Errorf(codes.PermissionDenied, "[ReportMultiple] error: %s", err)
 	}
+	events, err := s.authorizeExecutorEvents(ctx, message.Events)
+	var permErr *armadaerrors.ErrUnauthorized
+	if errors.As(err, &permErr) {
+		return nil, status.Errorf(codes.PermissionDenied, "[ReportMultiple] error: %s", err)
+	} else if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "[ReportMultiple] error: %s", err)
+	}
+	message = &api.EventList{Events: events}
 
 	if err := s.checkForPreemptedEvents(message); err != nil {
 		return &types.Empty{}, err
 	}
+	if err := s.retryController.HandleFailedEvents(message.Events); err != nil {
+		return nil, status.Errorf(codes.Unavailable, "[ReportMultiple] error handling failed events: %s", err)
+	}
+	if err := s.handleBudgetedEvents(message.Events); err != nil {
+		return nil, status.Errorf(codes.Unavailable, "[ReportMultiple] error accounting runs: %s", err)
+	}
+	if err := s.recordUsage(message.Events); err != nil {
+		return nil, status.Errorf(codes.Unavailable, "[ReportMultiple] error recording usage: %s", err)
+	}
 
 	return &types.Empty{}, s.eventStore.ReportEvents(ctx, message.Events)
 }
 
+// authorizeExecutorEvents returns the events an executor may report, applying authorizeExecutor to the cluster of
+// each event that has one, and returning an error if any event is of a job leased to another executor, since
+// executors may only report on the jobs leased to them. Events of jobs that are queued or suspended, e.g., reported
+// after the lease of the job expired, are dropped; those of jobs that no longer exist are reported, since they can't
+// affect any job. All events are returned for requests not authenticated with executor credentials.
+func (s *EventServer) authorizeExecutorEvents(ctx *armadacontext.Context, messages []*api.EventMessage) ([]*api.EventMessage, error) {
+	if _, ok := authorization.GetPrincipal(ctx).(*authorization.ExecutorPrincipal); !ok {
+		return messages, nil
+	}
+	jobIds := make([]string, 0, len(messages))
+	for _, message := range messages {
+		event, err := api.UnwrapEvent(message)
+		if err != nil {
+			return nil, err
+		}
+		if clusterEvent, ok := event.(interface{ GetClusterId() string }); ok {
+			if err := authorizeExecutor(ctx, clusterEvent.GetClusterId()); err != nil {
+				return nil, err
+			}
+		}
+		jobIds = append(jobIds, event.GetJobId())
+	}
+	clusterIds, err := s.jobRepository.GetLeasedJobClusterIds(jobIds)
+	if err != nil {
+		return nil, err
+	}
+	var unleasedJobIds []string
+	for _, jobId := range jobIds {
+		if clusterId, ok := clusterIds[jobId]; !ok {
+			unleasedJobIds = append(unleasedJobIds, jobId)
+		} else if err := authorizeExecutor(ctx, clusterId); err != nil {
+			return nil, errors.WithMessagef(err, "job %s isn't leased to the executor", jobId)
+		}
+	}
+	if len(unleasedJobIds) == 0 {
+		return messages, nil
+	}
+	unleasedJobs, err := s.jobRepository.GetExistingJobsByIds(unleasedJobIds)
+	if err != nil {
+		return nil, err
+	}
+	existingUnleasedJobIds := make(map[string]bool, len(unleasedJobs))
+	for _, job := range unleasedJobs {
+		existingUnleasedJobIds[job.Id] = true
+	}
+	authorized := make([]*api.EventMessage, 0, len(messages))
+	for i, message := range messages {
+		if existingUnleasedJobIds[jobIds[i]] {
+			log.Warnf("dropping %T event of job %s, which isn't leased to the executor", message.Events, jobIds[i])
+			continue
+		}
+		authorized = append(authorized, message)
+	}
+	return authorized, nil
+}
+
+func (s *EventServer) handleBudgetedEvents(events []*api.EventMessage) error {
+	if s.BudgetAccountant == nil {
+		return nil
+	}
+	return s.BudgetAccountant.HandleEvents(events)
+}
+
+func (s *EventServer) recordUsage(events []*api.EventMessage) error {
+	if s.UsageRecorder == nil {
+		return nil
+	}
+	return s.UsageRecorder.HandleEvents(events)
+}
+
 func (s *EventServer) checkForPreemptedEvents(message *api.EventList) error {
 	var preemptedEvents []*api.EventMessage_Preempted
 	var jobIds []string
@@ -137,7 +258,7 @@ func (s *EventServer) GetJobSetEvents(request *api.JobSetRequest, stream api.Eve
 	if !sequence.IsValid(request.FromMessageId) {
 		convertedSeqId, err := sequence.FromRedisId(request.FromMessageId, 0, true)
 		if err != nil {
-			return errors.Wrapf(err, "Could not convert legacy message id over to new message id for request for queue %s, jobset %s", request.Queue, request.Id)
+			return status.Errorf(codes.InvalidArgument, "[GetJobSetEvents] invalid message id %s for queue %s, jobset %s: %s", request.FromMessageId, request.Queue, request.Id, err)
 		}
 		log.Warnf("Converted legacy sequene id [%s] for queues %s, jobset %s to new sequenceId [%s]", request.Id, request.Queue, request.Id, convertedSeqId)
 		request.FromMessageId = convertedSeqId.String()
@@ -159,6 +280,7 @@ func (s *EventServer) Watch(req *api.WatchRequest, stream api.Event_WatchServer)
 		ErrorIfMissing: true,
 		ForceLegacy:    req.ForceLegacy,
 		ForceNew:       req.ForceNew,
+		SchemaVersion:  req.SchemaVersion,
 	}
 	return s.GetJobSetEvents(request, stream)
 }
@@ -176,7 +298,13 @@ func (s *EventServer) serveEventsFromRepository(request *api.JobSetRequest, even
 		}
 	}
 
+	filter, err := newJobSetEventFilter(request)
+	if err != nil {
+		return err
+	}
+
 	fromId := request.FromMessageId
+	schemaVersion := s.translator.ResolveVersion(request.SchemaVersion)
 
 	var timeout time.Duration = -1
 	stopAfter := ""
@@ -213,6 +341,14 @@ func (s *EventServer) serveEventsFromRepository(request *api.JobSetRequest, even
 				if fromId == stopAfter {
 					stop = true
 				}
+				msg, err = s.translateEventStreamMessage(msg, schemaVersion)
+				if err != nil {
+					return status.Errorf(codes.Internal, "[GetJobSetEvents] error translating event %s: %s", fromId, err)
+				}
+				if msg == nil || !filter.matches(msg.Message) {
+					// The event has no equivalent in the version requested, or wasn't requested.
+					continue
+				}
 				err = stream.Send(msg)
 				if err != nil {
 					return status.Errorf(codes.Unavailable, "[GetJobSetEvents] error sending event: %s", err)
@@ -226,6 +362,70 @@ func (s *EventServer) serveEventsFromRepository(request *api.JobSetRequest, even
 	}
 }
 
+// jobSetEventFilter selects the events of a job set sent to clients, as requested by a JobSetRequest.
+type jobSetEventFilter struct {
+	fromTime     *time.Time
+	terminalOnly bool
+	eventTypes   map[string]bool
+}
+
+func newJobSetEventFilter(request *api.JobSetRequest) (*jobSetEventFilter, error) {
+	filter := &jobSetEventFilter{fromTime: request.FromTime, terminalOnly: request.TerminalOnly}
+	if len(request.EventTypes) > 0 {
+		filter.eventTypes = make(map[string]bool, len(request.EventTypes))
+		for _, eventType := range request.EventTypes {
+			if !api.IsEventType(eventType) {
+				return nil, status.Errorf(codes.InvalidArgument, "[GetJobSetEvents] unknown event type %q", eventType)
+			}
+			filter.eventTypes[eventType] = true
+		}
+	}
+	return filter, nil
+}
+
+func (f *jobSetEventFilter) matches(message *api.EventMessage) bool {
+	if f.eventTypes != nil && !f.eventTypes[api.EventType(message)] {
+		return false
+	}
+	if f.terminalOnly && !isTerminalEvent(message) {
+		return false
+	}
+	if f.fromTime != nil {
+		event, err := api.UnwrapEvent(message)
+		if err != nil || event.GetCreated().Before(*f.fromTime) {
+			return false
+		}
+	}
+	return true
+}
+
+// isTerminalEvent returns true if message ends its job.
+func isTerminalEvent(message *api.EventMessage) bool {
+	switch e := message.Events.(type) {
+	case *api.EventMessage_Succeeded, *api.EventMessage_Cancelled, *api.EventMessage_FailedCompressed:
+		return true
+	case *api.EventMessage_Failed:
+		return !e.Failed.WillRetry
+	}
+	return false
+}
+
+// translateEventStreamMessage translates msg to schemaVersion, returning nil if it has no equivalent in that version.
+func (s *EventServer) translateEventStreamMessage(msg *api.EventStreamMessage, schemaVersion uint32) (*api.EventStreamMessage, error) {
+	if msg.SchemaVersion == schemaVersion {
+		return msg, nil
+	}
+	event, err := s.translator.Translate(msg.Message, msg.SchemaVersion, schemaVersion)
+	if err != nil || event == nil {
+		return nil, err
+	}
+	// Events of versions after the latest one aren't translated.
+	if msg.SchemaVersion <= s.translator.LatestVersion() {
+		return &api.EventStreamMessage{Id: msg.Id, Message: event, SchemaVersion: schemaVersion}, nil
+	}
+	return msg, nil
+}
+
 func validateUserHasWatchPermissions(ctx *armadacontext.Context, authorizer ActionAuthorizer, q queue.Queue, jobSetId string) error {
 	err := authorizer.AuthorizeQueueAction(ctx, q, permissions.WatchAllEvents, queue.PermissionVerbWatch)
 	var permErr *armadaerrors.ErrUnauthorized
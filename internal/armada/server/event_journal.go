@@ -0,0 +1,108 @@
+package server
+
+import (
+	"context"
+
+	"github.com/gogo/protobuf/types"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/armadaproject/armada/internal/armada/permissions"
+	"github.com/armadaproject/armada/internal/armada/repository"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/auth/authorization"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+// EventJournalServer replays events from the event journal into the event store, i.e., to downstream consumers.
+// Replayed events aren't journaled again.
+type EventJournalServer struct {
+	authorizer ActionAuthorizer
+	journal    repository.EventJournal
+	eventStore repository.EventStore
+	batchSize  int
+}
+
+func NewEventJournalServer(
+	authorizer ActionAuthorizer,
+	journal repository.EventJournal,
+	eventStore repository.EventStore,
+	batchSize int,
+) *EventJournalServer {
+	return &EventJournalServer{
+		authorizer: authorizer,
+		journal:    journal,
+		eventStore: eventStore,
+		batchSize:  batchSize,
+	}
+}
+
+func (s *EventJournalServer) GetEventJournalInfo(grpcCtx context.Context, _ *types.Empty) (*api.EventJournalInfo, error) {
+	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+	if err := s.authorizer.AuthorizeAction(ctx, permissions.ReplayEvents); err != nil {
+		return nil, status.Errorf(codes.PermissionDenied, "[GetEventJournalInfo] error: %s", err)
+	}
+	info, err := s.journal.Info()
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "[GetEventJournalInfo] error reading event journal: %s", err)
+	}
+	return info, nil
+}
+
+func (s *EventJournalServer) ReplayEvents(grpcCtx context.Context, req *api.EventReplayRequest) (*api.EventReplayResponse, error) {
+	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+	if err := s.authorizer.AuthorizeAction(ctx, permissions.ReplayEvents); err != nil {
+		return nil, status.Errorf(codes.PermissionDenied, "[ReplayEvents] error: %s", err)
+	}
+	for _, offset := range []string{req.FromOffset, req.ToOffset} {
+		if offset == "" {
+			continue
+		}
+		if err := repository.ValidateEventJournalOffset(offset); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "[ReplayEvents] %s", err)
+		}
+	}
+
+	response := &api.EventReplayResponse{}
+	from := req.FromOffset
+	for req.MaxEvents == 0 || response.EventsReplayed < req.MaxEvents {
+		limit := s.batchSize
+		if remaining := int(req.MaxEvents - response.EventsReplayed); req.MaxEvents > 0 && remaining < limit {
+			limit = remaining
+		}
+		journaled, err := s.journal.Read(from, req.ToOffset, int64(limit))
+		if err != nil {
+			return nil, status.Errorf(codes.Unavailable, "[ReplayEvents] error reading event journal: %s", err)
+		}
+		if len(journaled) == 0 {
+			break
+		}
+		events := make([]*api.EventMessage, len(journaled))
+		for i, event := range journaled {
+			events[i] = event.Event
+		}
+		if err := s.eventStore.ReportEvents(ctx, events); err != nil {
+			// Events replayed so far have been published, so return an error that allows resuming the replay.
+			return nil, status.Errorf(
+				codes.Unavailable,
+				"[ReplayEvents] error publishing events after replaying %d events up to offset %q: %s",
+				response.EventsReplayed, response.LastOffset, err,
+			)
+		}
+		response.EventsReplayed += uint32(len(events))
+		response.LastOffset = journaled[len(journaled)-1].Offset
+		if len(journaled) < limit {
+			break
+		}
+		from, err = repository.NextEventJournalOffset(response.LastOffset)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "[ReplayEvents] %s", err)
+		}
+	}
+	log.Infof(
+		"%s replayed %d events from the event journal, from offset %q up to offset %q",
+		authorization.GetPrincipal(ctx).GetName(), response.EventsReplayed, req.FromOffset, response.LastOffset,
+	)
+	return response, nil
+}
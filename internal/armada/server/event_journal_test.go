@@ -0,0 +1,80 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/go-redis/redis"
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/armadaproject/armada/internal/armada/repository"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+func TestEventJournalServer_ReplayEvents(t *testing.T) {
+	withEventJournalServer(&FakeActionAuthorizer{}, func(s *EventJournalServer, journal repository.EventJournal, eventStore *repository.TestEventStore) {
+		ctx := armadacontext.Background()
+		events := make([]*api.EventMessage, 5)
+		for i := range events {
+			events[i] = &api.EventMessage{
+				Events: &api.EventMessage_Cancelled{Cancelled: &api.JobCancelledEvent{JobId: string(rune('a' + i)), JobSetId: "set", Queue: "queue"}},
+			}
+		}
+		require.NoError(t, journal.Append(events))
+		journaled, err := journal.Read("", "", 0)
+		require.NoError(t, err)
+
+		info, err := s.GetEventJournalInfo(ctx, &types.Empty{})
+		require.NoError(t, err)
+		assert.Equal(t, &api.EventJournalInfo{FirstOffset: journaled[0].Offset, LastOffset: journaled[4].Offset, NumEvents: 5}, info)
+
+		// Replay crosses the batch size of 2.
+		response, err := s.ReplayEvents(ctx, &api.EventReplayRequest{FromOffset: journaled[1].Offset})
+		require.NoError(t, err)
+		assert.Equal(t, &api.EventReplayResponse{EventsReplayed: 4, LastOffset: journaled[4].Offset}, response)
+		assert.Equal(t, events[1:], eventStore.ReceivedEvents)
+
+		eventStore.ReceivedEvents = nil
+		response, err = s.ReplayEvents(ctx, &api.EventReplayRequest{ToOffset: journaled[3].Offset, MaxEvents: 3})
+		require.NoError(t, err)
+		assert.Equal(t, &api.EventReplayResponse{EventsReplayed: 3, LastOffset: journaled[2].Offset}, response)
+		assert.Equal(t, events[:3], eventStore.ReceivedEvents)
+
+		eventStore.ReceivedEvents = nil
+		response, err = s.ReplayEvents(ctx, &api.EventReplayRequest{FromOffset: journaled[3].Offset, ToOffset: journaled[3].Offset})
+		require.NoError(t, err)
+		assert.Equal(t, &api.EventReplayResponse{EventsReplayed: 1, LastOffset: journaled[3].Offset}, response)
+		assert.Equal(t, events[3:4], eventStore.ReceivedEvents)
+
+		_, err = s.ReplayEvents(ctx, &api.EventReplayRequest{FromOffset: "yesterday"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestEventJournalServer_PermissionDenied(t *testing.T) {
+	withEventJournalServer(&FakeDenyAllActionAuthorizer{}, func(s *EventJournalServer, _ repository.EventJournal, _ *repository.TestEventStore) {
+		ctx := armadacontext.Background()
+		_, err := s.GetEventJournalInfo(ctx, &types.Empty{})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		_, err = s.ReplayEvents(ctx, &api.EventReplayRequest{})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+}
+
+func withEventJournalServer(
+	authorizer ActionAuthorizer,
+	action func(s *EventJournalServer, journal repository.EventJournal, eventStore *repository.TestEventStore),
+) {
+	client := redis.NewClient(&redis.Options{Addr: "localhost:6379", DB: 10})
+	defer client.FlushDB()
+	defer client.Close()
+	client.FlushDB()
+
+	journal := repository.NewRedisEventJournal(client, 1000)
+	eventStore := &repository.TestEventStore{}
+	action(NewEventJournalServer(authorizer, journal, eventStore, 2), journal, eventStore)
+}
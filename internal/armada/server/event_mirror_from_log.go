@@ -0,0 +1,82 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/internal/armada/repository/apimessages"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/eventutil"
+	"github.com/armadaproject/armada/internal/common/logging"
+	"github.com/armadaproject/armada/internal/common/schedulers"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+// EventMirror publishes events to a platform other than Armada, e.g., repository.MirroringEventStore.
+type EventMirror interface {
+	// Mirror publishes events, returning an error if they may not have been published.
+	Mirror(ctx *armadacontext.Context, events []*api.EventMessage) error
+}
+
+// EventMirrorFromLog is a service that mirrors the events published to Pulsar for the Pulsar scheduler,
+// which, unlike those of the legacy scheduler, aren't reported to the event store of the server.
+type EventMirrorFromLog struct {
+	Consumer pulsar.Consumer
+	Mirror   EventMirror
+}
+
+// Run the service that reads from Pulsar and mirrors events until the provided context is cancelled.
+func (srv *EventMirrorFromLog) Run(ctx *armadacontext.Context) error {
+	log := ctx.WithField("service", "EventMirrorFromLog")
+	log.Info("service started")
+	defer log.Info("service stopped")
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		default:
+		}
+		ctxWithTimeout, cancel := armadacontext.WithTimeout(ctx, 10*time.Second)
+		msg, err := srv.Consumer.Receive(ctxWithTimeout)
+		cancel()
+		if errors.Is(err, context.DeadlineExceeded) {
+			continue // expected
+		} else if err != nil {
+			logging.WithStacktrace(log, err).Warnf("Pulsar receive failed; backing off")
+			time.Sleep(100 * time.Millisecond)
+			continue
+		}
+		if err := srv.mirrorMessage(armadacontext.WithLogField(ctx, "messageId", msg.ID()), msg); err != nil {
+			// The message is redelivered, such that its events are mirrored at least once.
+			logging.WithStacktrace(log, err).WithField("messageId", msg.ID()).Warnf("mirroring events failed; retrying")
+			srv.Consumer.Nack(msg)
+			continue
+		}
+		if err := srv.Consumer.Ack(msg); err != nil {
+			logging.WithStacktrace(log, err).WithField("messageId", msg.ID()).Warnf("acking Pulsar message failed")
+		}
+	}
+}
+
+// mirrorMessage mirrors the events of msg if it's published for the Pulsar scheduler only.
+// Messages for the legacy scheduler are processed by SubmitFromLog, which reports their events to the event store,
+// and the Pulsar scheduler publishes the outcome of requests for all schedulers, e.g., cancellations, for its jobs.
+func (srv *EventMirrorFromLog) mirrorMessage(ctx *armadacontext.Context, msg pulsar.Message) error {
+	if schedulers.SchedulerFromMsg(msg) != schedulers.Pulsar {
+		return nil
+	}
+	sequence, err := eventutil.UnmarshalEventSequence(ctx, msg.Payload())
+	if err != nil {
+		logging.WithStacktrace(ctx, err).Warnf("unmarshalling Pulsar message failed; ignoring")
+		return nil
+	}
+	events, err := apimessages.FromEventSequence(sequence)
+	if err != nil {
+		logging.WithStacktrace(ctx, err).Warnf("converting Pulsar message failed; ignoring")
+		return nil
+	}
+	return srv.Mirror.Mirror(ctx, events)
+}
@@ -0,0 +1,108 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/schedulers"
+	"github.com/armadaproject/armada/internal/common/util"
+	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/armadaevents"
+)
+
+func TestEventMirrorFromLog_MirrorsEventsForPulsarSchedulerOnly(t *testing.T) {
+	jobId := util.NewULID()
+	payload := cancelledJobSequencePayload(t, jobId)
+	tests := map[string]struct {
+		scheduler schedulers.Scheduler
+		mirrored  bool
+	}{
+		"pulsar": {scheduler: schedulers.Pulsar, mirrored: true},
+		"legacy": {scheduler: schedulers.Legacy},
+		"all":    {scheduler: schedulers.All},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			mirror := &fakeEventMirror{}
+			srv := &EventMirrorFromLog{Mirror: mirror}
+			msg := &testPulsarMessage{
+				payload:    payload,
+				properties: map[string]string{schedulers.PropertyName: schedulers.MsgPropertyFromScheduler(tc.scheduler)},
+			}
+
+			require.NoError(t, srv.mirrorMessage(armadacontext.Background(), msg))
+			if tc.mirrored {
+				require.Len(t, mirror.mirrored, 1)
+				assert.Equal(t, jobId, mirror.mirrored[0].GetCancelled().JobId)
+			} else {
+				assert.Empty(t, mirror.mirrored)
+			}
+		})
+	}
+}
+
+func TestEventMirrorFromLog_FailsIfEventsCannotBeMirrored(t *testing.T) {
+	payload := cancelledJobSequencePayload(t, util.NewULID())
+	srv := &EventMirrorFromLog{Mirror: &fakeEventMirror{err: errors.New("failed to mirror")}}
+	msg := &testPulsarMessage{
+		payload:    payload,
+		properties: map[string]string{schedulers.PropertyName: schedulers.PulsarSchedulerAttribute},
+	}
+
+	assert.Error(t, srv.mirrorMessage(armadacontext.Background(), msg))
+}
+
+func cancelledJobSequencePayload(t *testing.T, jobId string) []byte {
+	protoJobId, err := armadaevents.ProtoUuidFromUlidString(jobId)
+	require.NoError(t, err)
+	created := time.Now().UTC()
+	payload, err := proto.Marshal(&armadaevents.EventSequence{
+		Queue:      "queue",
+		JobSetName: "set",
+		UserId:     "user",
+		Events: []*armadaevents.EventSequence_Event{
+			{
+				Created: &created,
+				Event: &armadaevents.EventSequence_Event_CancelledJob{
+					CancelledJob: &armadaevents.CancelledJob{JobId: protoJobId},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	return payload
+}
+
+type fakeEventMirror struct {
+	err      error
+	mirrored []*api.EventMessage
+}
+
+func (m *fakeEventMirror) Mirror(_ *armadacontext.Context, events []*api.EventMessage) error {
+	if m.err != nil {
+		return m.err
+	}
+	m.mirrored = append(m.mirrored, events...)
+	return nil
+}
+
+type testPulsarMessage struct {
+	pulsar.Message
+	payload    []byte
+	properties map[string]string
+}
+
+func (m *testPulsarMessage) Payload() []byte {
+	return m.payload
+}
+
+func (m *testPulsarMessage) Properties() map[string]string {
+	return m.properties
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/armadaproject/armada/internal/common/auth/authorization"
 	"github.com/armadaproject/armada/internal/common/auth/permission"
 	"github.com/armadaproject/armada/internal/common/compress"
+	"github.com/armadaproject/armada/internal/common/eventschema"
 	"github.com/armadaproject/armada/pkg/api"
 	"github.com/armadaproject/armada/pkg/armadaevents"
 	"github.com/armadaproject/armada/pkg/client/queue"
@@ -335,6 +336,182 @@ func TestEventServer_GetJobSetEvents_Permissions(t *testing.T) {
 	})
 }
 
+func TestEventServer_GetJobSetEvents_Filters(t *testing.T) {
+	withEventServer(t, func(s *EventServer) {
+		jobIdProto, _ := armadaevents.ProtoUuidFromUlidString("01f3j0g1md4qx7z5qb148qnh4r")
+		runIdProto := armadaevents.ProtoUuidFromUuid(uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"))
+		assignedTime, _ := time.Parse(time.RFC3339, "2022-03-01T15:04:05Z")
+		runningTime := assignedTime.Add(time.Minute)
+		err := reportPulsarEvent(&armadaevents.EventSequence{
+			JobSetName: "set",
+			Events: []*armadaevents.EventSequence_Event{
+				{
+					Created: &assignedTime,
+					Event: &armadaevents.EventSequence_Event_JobRunAssigned{
+						JobRunAssigned: &armadaevents.JobRunAssigned{RunId: runIdProto, JobId: jobIdProto},
+					},
+				},
+				{
+					Created: &runningTime,
+					Event: &armadaevents.EventSequence_Event_JobRunRunning{
+						JobRunRunning: &armadaevents.JobRunRunning{RunId: runIdProto, JobId: jobIdProto},
+					},
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		tests := map[string]struct {
+			request       *api.JobSetRequest
+			expectedTypes []string
+		}{
+			"no filters":  {request: &api.JobSetRequest{}, expectedTypes: []string{"pending", "running"}},
+			"event types": {request: &api.JobSetRequest{EventTypes: []string{"running", "failed"}}, expectedTypes: []string{"running"}},
+			"from time":   {request: &api.JobSetRequest{FromTime: &runningTime}, expectedTypes: []string{"running"}},
+			"terminal":    {request: &api.JobSetRequest{TerminalOnly: true}},
+		}
+		for name, tc := range tests {
+			t.Run(name, func(t *testing.T) {
+				tc.request.Id = "set"
+				stream := &eventStreamMock{}
+				require.NoError(t, s.GetJobSetEvents(tc.request, stream))
+				var types []string
+				for _, msg := range stream.sendMessages {
+					types = append(types, api.EventType(msg.Message))
+				}
+				assert.Equal(t, tc.expectedTypes, types)
+			})
+		}
+
+		err = s.GetJobSetEvents(&api.JobSetRequest{Id: "set", EventTypes: []string{"unknown"}}, &eventStreamMock{})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestEventServer_GetJobSetEvents_ResumesAfterMessageId(t *testing.T) {
+	withEventServer(t, func(s *EventServer) {
+		jobIdProto, _ := armadaevents.ProtoUuidFromUlidString("01f3j0g1md4qx7z5qb148qnh4r")
+		runIdProto := armadaevents.ProtoUuidFromUuid(uuid.MustParse("123e4567-e89b-12d3-a456-426614174000"))
+		created := time.Now()
+		err := reportPulsarEvent(&armadaevents.EventSequence{
+			JobSetName: "set",
+			Events: []*armadaevents.EventSequence_Event{
+				{
+					Created: &created,
+					Event: &armadaevents.EventSequence_Event_JobRunAssigned{
+						JobRunAssigned: &armadaevents.JobRunAssigned{RunId: runIdProto, JobId: jobIdProto},
+					},
+				},
+				{
+					Created: &created,
+					Event: &armadaevents.EventSequence_Event_JobRunRunning{
+						JobRunRunning: &armadaevents.JobRunRunning{RunId: runIdProto, JobId: jobIdProto},
+					},
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		stream := &eventStreamMock{}
+		require.NoError(t, s.GetJobSetEvents(&api.JobSetRequest{Id: "set"}, stream))
+		require.Len(t, stream.sendMessages, 2)
+
+		resumed := &eventStreamMock{}
+		require.NoError(t, s.GetJobSetEvents(&api.JobSetRequest{Id: "set", FromMessageId: stream.sendMessages[0].Id}, resumed))
+		assert.Equal(t, stream.sendMessages[1:], resumed.sendMessages)
+
+		err = s.GetJobSetEvents(&api.JobSetRequest{Id: "set", FromMessageId: "invalid"}, &eventStreamMock{})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestEventServer_ExecutorsMayOnlyReportOnJobsLeasedToThem(t *testing.T) {
+	withEventServer(t, func(s *EventServer) {
+		eventStore := &repository.TestEventStore{}
+		s.eventStore = eventStore
+		s.retryController = NewRetryController(s.jobRepository, eventStore)
+		ctx := authorization.WithPrincipal(context.Background(), authorization.NewExecutorPrincipal("cred-1", "cluster-1", nil))
+
+		_, err := s.jobRepository.AddJobs([]*api.Job{
+			{Id: "own", Queue: "queue", JobSetId: "set", Priority: 1},
+			{Id: "other", Queue: "queue", JobSetId: "set", Priority: 1},
+			{Id: "queued", Queue: "queue", JobSetId: "set", Priority: 1},
+		})
+		require.NoError(t, err)
+		_, err = s.jobRepository.TryLeaseJobs("cluster-1", map[string][]string{"queue": {"own"}})
+		require.NoError(t, err)
+		_, err = s.jobRepository.TryLeaseJobs("cluster-2", map[string][]string{"queue": {"other"}})
+		require.NoError(t, err)
+		created := time.Now()
+		running := func(jobId string) *api.EventMessage {
+			return &api.EventMessage{Events: &api.EventMessage_Running{Running: &api.JobRunningEvent{
+				JobId: jobId, Queue: "queue", JobSetId: "set", ClusterId: "cluster-1", Created: created,
+			}}}
+		}
+
+		// Events of jobs leased to other executors are denied.
+		_, err = s.Report(ctx, running("other"))
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		_, err = s.ReportMultiple(ctx, &api.EventList{Events: []*api.EventMessage{running("own"), running("other")}})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		assert.Empty(t, eventStore.ReceivedEvents)
+
+		// Events of queued jobs are dropped, while those of jobs that no longer exist are reported.
+		_, err = s.ReportMultiple(ctx, &api.EventList{Events: []*api.EventMessage{running("own"), running("queued"), running("deleted")}})
+		require.NoError(t, err)
+		_, err = s.Report(ctx, running("queued"))
+		require.NoError(t, err)
+		assert.Equal(t, []*api.EventMessage{running("own"), running("deleted")}, eventStore.ReceivedEvents)
+	})
+}
+
+func TestIsTerminalEvent(t *testing.T) {
+	assert.True(t, isTerminalEvent(&api.EventMessage{Events: &api.EventMessage_Succeeded{Succeeded: &api.JobSucceededEvent{}}}))
+	assert.True(t, isTerminalEvent(&api.EventMessage{Events: &api.EventMessage_Cancelled{Cancelled: &api.JobCancelledEvent{}}}))
+	assert.True(t, isTerminalEvent(&api.EventMessage{Events: &api.EventMessage_Failed{Failed: &api.JobFailedEvent{}}}))
+	assert.False(t, isTerminalEvent(&api.EventMessage{Events: &api.EventMessage_Failed{Failed: &api.JobFailedEvent{WillRetry: true}}}))
+	assert.False(t, isTerminalEvent(&api.EventMessage{Events: &api.EventMessage_Running{Running: &api.JobRunningEvent{}}}))
+}
+
+func TestEventServer_TranslateEventStreamMessage(t *testing.T) {
+	// Version 2 introduces preempted events, which have no equivalent in version 1.
+	translator, err := eventschema.NewTranslator([]eventschema.Migration{{
+		Version: 2,
+		Upgrade: func(event *api.EventMessage) (*api.EventMessage, error) {
+			return event, nil
+		},
+		Downgrade: func(event *api.EventMessage) (*api.EventMessage, error) {
+			if event.GetPreempted() != nil {
+				return nil, nil
+			}
+			return event, nil
+		},
+	}})
+	require.NoError(t, err)
+	s := &EventServer{translator: translator}
+
+	preempted := &api.EventStreamMessage{
+		Id:            "1",
+		Message:       &api.EventMessage{Events: &api.EventMessage_Preempted{Preempted: &api.JobPreemptedEvent{JobId: "job"}}},
+		SchemaVersion: 2,
+	}
+	msg, err := s.translateEventStreamMessage(preempted, 2)
+	require.NoError(t, err)
+	assert.Equal(t, preempted, msg)
+	msg, err = s.translateEventStreamMessage(preempted, translator.ResolveVersion(0))
+	require.NoError(t, err)
+	assert.Nil(t, msg)
+
+	cancelled := &api.EventStreamMessage{
+		Id:            "2",
+		Message:       &api.EventMessage{Events: &api.EventMessage_Cancelled{Cancelled: &api.JobCancelledEvent{JobId: "job"}}},
+		SchemaVersion: 2,
+	}
+	msg, err = s.translateEventStreamMessage(cancelled, 1)
+	require.NoError(t, err)
+	assert.Equal(t, &api.EventStreamMessage{Id: "2", Message: cancelled.Message, SchemaVersion: 1}, msg)
+}
+
 func reportPulsarEvent(es *armadaevents.EventSequence) error {
 	bytes, err := proto.Marshal(es)
 	if err != nil {
@@ -0,0 +1,179 @@
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/utils/clock"
+
+	"github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/internal/armada/permissions"
+	"github.com/armadaproject/armada/internal/armada/repository"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/armadaerrors"
+	"github.com/armadaproject/armada/internal/common/auth/authorization"
+	"github.com/armadaproject/armada/internal/common/util"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+// ExecutorCredentialMethods are the methods requests authenticated with executor credentials may call.
+var ExecutorCredentialMethods = []string{
+	"/api.AggregatedQueue/StreamingLeaseJobs",
+	"/api.AggregatedQueue/RenewLease",
+	"/api.AggregatedQueue/ReturnLease",
+	"/api.AggregatedQueue/ReportDone",
+	"/api.Event/Report",
+	"/api.Event/ReportMultiple",
+	"/api.Usage/ReportUsage",
+	"/api.ExecutorCredentials/RotateExecutorCredential",
+	"/api.JobSessions/WatchJobSessions",
+	"/api.JobSessions/ServeJobSession",
+}
+
+// ExecutorCredentialsServer mints, rotates, and revokes credentials scoped to individual executors.
+// It also verifies such credentials on behalf of authorization.ExecutorAuthService.
+type ExecutorCredentialsServer struct {
+	authorizer ActionAuthorizer
+	repository repository.ExecutorCredentialRepository
+	config     configuration.ExecutorCredentialsConfig
+	clock      clock.Clock
+}
+
+func NewExecutorCredentialsServer(
+	authorizer ActionAuthorizer,
+	repository repository.ExecutorCredentialRepository,
+	config configuration.ExecutorCredentialsConfig,
+) *ExecutorCredentialsServer {
+	return &ExecutorCredentialsServer{
+		authorizer: authorizer,
+		repository: repository,
+		config:     config,
+		clock:      clock.RealClock{},
+	}
+}
+
+func (s *ExecutorCredentialsServer) CreateExecutorCredential(grpcCtx context.Context, req *api.ExecutorCredentialCreateRequest) (*api.ExecutorCredentialSecret, error) {
+	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+	if err := s.authorizer.AuthorizeAction(ctx, permissions.ManageExecutorKeys); err != nil {
+		return nil, status.Errorf(codes.PermissionDenied, "[CreateExecutorCredential] error: %s", err)
+	}
+	if req.ExecutorId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "[CreateExecutorCredential] executor id must not be empty")
+	}
+	secret, err := s.mintExecutorCredential(req.ExecutorId, authorization.GetPrincipal(ctx).GetName())
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "[CreateExecutorCredential] error minting credential for executor %s: %s", req.ExecutorId, err)
+	}
+	return secret, nil
+}
+
+func (s *ExecutorCredentialsServer) RotateExecutorCredential(grpcCtx context.Context, _ *types.Empty) (*api.ExecutorCredentialSecret, error) {
+	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+	principal, ok := authorization.GetPrincipal(ctx).(*authorization.ExecutorPrincipal)
+	if !ok {
+		return nil, status.Errorf(codes.PermissionDenied, "[RotateExecutorCredential] only requests authenticated with an executor credential may rotate it")
+	}
+	current, _, err := s.repository.GetExecutorCredential(principal.CredentialId)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "[RotateExecutorCredential] error getting credential %s: %s", principal.CredentialId, err)
+	}
+	secret, err := s.mintExecutorCredential(principal.ExecutorId, principal.GetName())
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "[RotateExecutorCredential] error minting credential for executor %s: %s", principal.ExecutorId, err)
+	}
+	if gracePeriodEnd := s.clock.Now().Add(s.config.RotationGracePeriod); gracePeriodEnd.Before(current.Expires) {
+		current.Expires = gracePeriodEnd
+		if err := s.repository.UpdateExecutorCredential(current); err != nil {
+			return nil, status.Errorf(codes.Unavailable, "[RotateExecutorCredential] error expiring credential %s: %s", current.Id, err)
+		}
+	}
+	log.Infof("executor %s rotated credential %s; new credential is %s", principal.ExecutorId, current.Id, secret.Credential.Id)
+	return secret, nil
+}
+
+func (s *ExecutorCredentialsServer) RevokeExecutorCredential(grpcCtx context.Context, req *api.ExecutorCredentialRevokeRequest) (*types.Empty, error) {
+	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+	if err := s.authorizer.AuthorizeAction(ctx, permissions.ManageExecutorKeys); err != nil {
+		return nil, status.Errorf(codes.PermissionDenied, "[RevokeExecutorCredential] error: %s", err)
+	}
+	credential, _, err := s.repository.GetExecutorCredential(req.Id)
+	var notFoundErr *repository.ErrExecutorCredentialNotFound
+	if errors.As(err, &notFoundErr) {
+		return nil, status.Errorf(codes.NotFound, "[RevokeExecutorCredential] %s", err)
+	} else if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "[RevokeExecutorCredential] error getting credential %s: %s", req.Id, err)
+	}
+	if credential.Revoked == nil {
+		now := s.clock.Now().UTC()
+		credential.Revoked = &now
+		if err := s.repository.UpdateExecutorCredential(credential); err != nil {
+			return nil, status.Errorf(codes.Unavailable, "[RevokeExecutorCredential] error revoking credential %s: %s", req.Id, err)
+		}
+	}
+	log.Infof("%s revoked credential %s of executor %s", authorization.GetPrincipal(ctx).GetName(), credential.Id, credential.ExecutorId)
+	return &types.Empty{}, nil
+}
+
+func (s *ExecutorCredentialsServer) GetExecutorCredentials(grpcCtx context.Context, req *api.ExecutorCredentialGetRequest) (*api.ExecutorCredentialList, error) {
+	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+	if err := s.authorizer.AuthorizeAction(ctx, permissions.ManageExecutorKeys); err != nil {
+		return nil, status.Errorf(codes.PermissionDenied, "[GetExecutorCredentials] error: %s", err)
+	}
+	credentials, err := s.repository.GetExecutorCredentials(req.ExecutorId)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "[GetExecutorCredentials] error getting credentials of executor %s: %s", req.ExecutorId, err)
+	}
+	return &api.ExecutorCredentialList{Credentials: credentials}, nil
+}
+
+// VerifyExecutorCredential implements authorization.ExecutorCredentialVerifier.
+func (s *ExecutorCredentialsServer) VerifyExecutorCredential(_ context.Context, credentialId string, secret string) (string, error) {
+	return repository.VerifyExecutorCredential(s.repository, s.clock.Now(), credentialId, secret)
+}
+
+func (s *ExecutorCredentialsServer) mintExecutorCredential(executorId string, createdBy string) (*api.ExecutorCredentialSecret, error) {
+	secretBytes := make([]byte, 32)
+	if _, err := rand.Read(secretBytes); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	secret := base64.RawURLEncoding.EncodeToString(secretBytes)
+	secretHash := sha256.Sum256([]byte(secret))
+	now := s.clock.Now().UTC()
+	credential := &api.ExecutorCredential{
+		Id:         util.NewULID(),
+		ExecutorId: executorId,
+		Created:    now,
+		Expires:    now.Add(s.config.Lifetime),
+		CreatedBy:  createdBy,
+	}
+	if err := s.repository.AddExecutorCredential(credential, secretHash[:]); err != nil {
+		return nil, err
+	}
+	return &api.ExecutorCredentialSecret{
+		Credential: credential,
+		Token:      credential.Id + "." + secret,
+	}, nil
+}
+
+// authorizeExecutor returns an error if the request is authenticated with an executor credential
+// minted for an executor other than clusterId. Requests authenticated otherwise are left to the usual permission checks.
+func authorizeExecutor(ctx *armadacontext.Context, clusterId string) error {
+	principal, ok := authorization.GetPrincipal(ctx).(*authorization.ExecutorPrincipal)
+	if !ok || principal.ExecutorId == clusterId {
+		return nil
+	}
+	return &armadaerrors.ErrUnauthorized{
+		Principal:  principal.GetName(),
+		Permission: permissions.ExecuteJobs,
+		Action:     "act on behalf of executor " + clusterId,
+		Message:    fmt.Sprintf("credential %s may only act on behalf of executor %s", principal.CredentialId, principal.ExecutorId),
+	}
+}
@@ -0,0 +1,138 @@
+package server
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/api/resource"
+	clock "k8s.io/utils/clock/testing"
+
+	"github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/internal/armada/repository"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/auth/authorization"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+func TestExecutorCredentialsServer_CreateAndVerify(t *testing.T) {
+	withExecutorCredentialsServer(func(s *ExecutorCredentialsServer, fakeClock *clock.FakeClock) {
+		ctx := armadacontext.Background()
+		secret, err := s.CreateExecutorCredential(ctx, &api.ExecutorCredentialCreateRequest{ExecutorId: "cluster-1"})
+		require.NoError(t, err)
+		assert.Equal(t, "cluster-1", secret.Credential.ExecutorId)
+		assert.Equal(t, fakeClock.Now().Add(24*time.Hour), secret.Credential.Expires)
+
+		credentialId, token, ok := strings.Cut(secret.Token, ".")
+		require.True(t, ok)
+		assert.Equal(t, secret.Credential.Id, credentialId)
+
+		executorId, err := s.VerifyExecutorCredential(ctx, credentialId, token)
+		require.NoError(t, err)
+		assert.Equal(t, "cluster-1", executorId)
+
+		_, err = s.VerifyExecutorCredential(ctx, credentialId, token+"x")
+		assert.Error(t, err)
+
+		credentials, err := s.GetExecutorCredentials(ctx, &api.ExecutorCredentialGetRequest{ExecutorId: "cluster-1"})
+		require.NoError(t, err)
+		require.Len(t, credentials.Credentials, 1)
+		require.NotNil(t, credentials.Credentials[0].LastUsed)
+		assert.Equal(t, fakeClock.Now(), *credentials.Credentials[0].LastUsed)
+
+		fakeClock.Step(24 * time.Hour)
+		_, err = s.VerifyExecutorCredential(ctx, credentialId, token)
+		assert.Error(t, err)
+	})
+}
+
+func TestExecutorCredentialsServer_Rotate(t *testing.T) {
+	withExecutorCredentialsServer(func(s *ExecutorCredentialsServer, fakeClock *clock.FakeClock) {
+		ctx := armadacontext.Background()
+		original, err := s.CreateExecutorCredential(ctx, &api.ExecutorCredentialCreateRequest{ExecutorId: "cluster-1"})
+		require.NoError(t, err)
+
+		// Only executors may rotate their own credential.
+		_, err = s.RotateExecutorCredential(ctx, &types.Empty{})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		executorCtx := authorization.WithPrincipal(ctx, authorization.NewExecutorPrincipal(original.Credential.Id, "cluster-1", nil))
+		rotated, err := s.RotateExecutorCredential(executorCtx, &types.Empty{})
+		require.NoError(t, err)
+		assert.Equal(t, "cluster-1", rotated.Credential.ExecutorId)
+		assert.NotEqual(t, original.Credential.Id, rotated.Credential.Id)
+
+		// The original credential remains valid during the grace period only.
+		originalId, originalSecret, _ := strings.Cut(original.Token, ".")
+		rotatedId, rotatedSecret, _ := strings.Cut(rotated.Token, ".")
+		fakeClock.Step(30 * time.Minute)
+		_, err = s.VerifyExecutorCredential(ctx, originalId, originalSecret)
+		assert.NoError(t, err)
+		fakeClock.Step(time.Hour)
+		_, err = s.VerifyExecutorCredential(ctx, originalId, originalSecret)
+		assert.Error(t, err)
+		_, err = s.VerifyExecutorCredential(ctx, rotatedId, rotatedSecret)
+		assert.NoError(t, err)
+	})
+}
+
+func TestExecutorCredentialsServer_Revoke(t *testing.T) {
+	withExecutorCredentialsServer(func(s *ExecutorCredentialsServer, fakeClock *clock.FakeClock) {
+		ctx := armadacontext.Background()
+		secret, err := s.CreateExecutorCredential(ctx, &api.ExecutorCredentialCreateRequest{ExecutorId: "cluster-1"})
+		require.NoError(t, err)
+
+		_, err = s.RevokeExecutorCredential(ctx, &api.ExecutorCredentialRevokeRequest{Id: secret.Credential.Id})
+		require.NoError(t, err)
+		credentialId, token, _ := strings.Cut(secret.Token, ".")
+		_, err = s.VerifyExecutorCredential(ctx, credentialId, token)
+		assert.Error(t, err)
+
+		_, err = s.RevokeExecutorCredential(ctx, &api.ExecutorCredentialRevokeRequest{Id: "unknown"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
+
+func TestAuthorizeExecutor(t *testing.T) {
+	ctx := armadacontext.Background()
+	assert.NoError(t, authorizeExecutor(ctx, "cluster-1"))
+
+	executorCtx := armadacontext.FromGrpcCtx(
+		authorization.WithPrincipal(ctx, authorization.NewExecutorPrincipal("cred-1", "cluster-1", nil)),
+	)
+	assert.NoError(t, authorizeExecutor(executorCtx, "cluster-1"))
+	assert.Error(t, authorizeExecutor(executorCtx, "cluster-2"))
+
+	withUsageServer(&configuration.SchedulingConfig{}, func(s *UsageServer) {
+		report := oneQueueReport(time.Now(), resource.MustParse("1"), resource.MustParse("1Gi"))
+		report.ClusterId = "cluster-2"
+		_, err := s.ReportUsage(executorCtx, report)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+}
+
+func withExecutorCredentialsServer(action func(s *ExecutorCredentialsServer, fakeClock *clock.FakeClock)) {
+	client := redis.NewClient(&redis.Options{Addr: "localhost:6379", DB: 10})
+	defer client.FlushDB()
+	defer client.Close()
+	client.FlushDB()
+
+	fakeClock := clock.NewFakeClock(time.Now().UTC().Truncate(time.Second))
+	s := NewExecutorCredentialsServer(
+		&FakeActionAuthorizer{},
+		repository.NewRedisExecutorCredentialRepository(client),
+		configuration.ExecutorCredentialsConfig{
+			Enabled:             true,
+			Lifetime:            24 * time.Hour,
+			RotationGracePeriod: time.Hour,
+		},
+	)
+	s.clock = fakeClock
+	action(s, fakeClock)
+}
@@ -0,0 +1,287 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/clock"
+
+	"github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/internal/armada/permissions"
+	"github.com/armadaproject/armada/internal/armada/repository"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/armadaerrors"
+	"github.com/armadaproject/armada/internal/common/util"
+	schedulerdb "github.com/armadaproject/armada/internal/scheduler/database"
+	"github.com/armadaproject/armada/internal/scheduler/schedulerobjects"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+// ExecutorHealthAlerter is notified whenever an executor becomes unhealthy, i.e., stops heartbeating, or recovers.
+type ExecutorHealthAlerter interface {
+	// Alert notifies of the change of health of the executor with the given status.
+	Alert(ctx *armadacontext.Context, status *api.ExecutorStatus) error
+}
+
+// ExecutorHealthMonitor records the heartbeats of executors and finds executors that stopped heartbeating.
+// Executors of the Pulsar scheduler heartbeat to the scheduler, which stores them in pulsarExecutorRepository.
+// Changes of health are logged and, if an alerter is provided, alerted on once across all replicas of the server.
+type ExecutorHealthMonitor struct {
+	repository               repository.ExecutorStatusRepository
+	pulsarExecutorRepository schedulerdb.ExecutorRepository
+	alerter                  ExecutorHealthAlerter
+	unhealthyAfter           time.Duration
+	clock                    clock.Clock
+}
+
+func NewExecutorHealthMonitor(
+	repository repository.ExecutorStatusRepository,
+	pulsarExecutorRepository schedulerdb.ExecutorRepository,
+	alerter ExecutorHealthAlerter,
+	config configuration.ExecutorHealthConfig,
+) *ExecutorHealthMonitor {
+	return &ExecutorHealthMonitor{
+		repository:               repository,
+		pulsarExecutorRepository: pulsarExecutorRepository,
+		alerter:                  alerter,
+		unhealthyAfter:           config.UnhealthyAfter,
+		clock:                    clock.RealClock{},
+	}
+}
+
+// RecordHeartbeat stores the status of the executor making a lease request.
+func (m *ExecutorHealthMonitor) RecordHeartbeat(req *api.StreamingLeaseRequest) error {
+	allocated := make(map[string]resource.Quantity)
+	for _, queueReport := range req.ClusterLeasedReport.Queues {
+		for t, q := range queueReport.ResourcesLeased {
+			total := allocated[t]
+			total.Add(q)
+			allocated[t] = total
+		}
+	}
+	return m.repository.StoreExecutorStatus(&api.ExecutorStatus{
+		Id:            req.ClusterId,
+		Pool:          req.Pool,
+		LastHeartbeat: m.clock.Now().UTC(),
+		Version:       req.ExecutorVersion,
+		Capacity:      req.Resources,
+		Allocated:     allocated,
+	})
+}
+
+// GetExecutorStatuses returns the status of each executor that has heartbeated, ordered by id.
+// Executors of the Pulsar scheduler don't report their version.
+func (m *ExecutorHealthMonitor) GetExecutorStatuses() ([]*api.ExecutorStatus, error) {
+	statuses, err := m.repository.GetExecutorStatuses()
+	if err != nil {
+		return nil, err
+	}
+	if m.pulsarExecutorRepository != nil {
+		executors, err := m.pulsarExecutorRepository.GetExecutors(armadacontext.Background())
+		if err != nil {
+			return nil, err
+		}
+		statuses = mergeExecutorStatuses(statuses, util.Map(executors, executorStatusFromPulsarExecutor))
+	}
+	now := m.clock.Now()
+	for _, status := range statuses {
+		status.Healthy = m.unhealthyAfter == 0 || now.Sub(status.LastHeartbeat) <= m.unhealthyAfter
+	}
+	return statuses, nil
+}
+
+func executorStatusFromPulsarExecutor(executor *schedulerobjects.Executor) *api.ExecutorStatus {
+	var capacity, allocated schedulerobjects.ResourceList
+	for _, node := range executor.Nodes {
+		capacity.Add(node.TotalResources)
+		for _, rl := range node.AllocatedByQueue {
+			allocated.Add(rl)
+		}
+	}
+	return &api.ExecutorStatus{
+		Id:            executor.Id,
+		Pool:          executor.Pool,
+		LastHeartbeat: executor.LastUpdateTime.UTC(),
+		Capacity:      capacity.Resources,
+		Allocated:     allocated.Resources,
+	}
+}
+
+// mergeExecutorStatuses returns the statuses of both slices ordered by id. If an executor heartbeated to both
+// schedulers, e.g., while being migrated, its most recent status is kept.
+func mergeExecutorStatuses(a, b []*api.ExecutorStatus) []*api.ExecutorStatus {
+	statusById := make(map[string]*api.ExecutorStatus, len(a)+len(b))
+	for _, status := range append(a, b...) {
+		if existing, ok := statusById[status.Id]; !ok || status.LastHeartbeat.After(existing.LastHeartbeat) {
+			statusById[status.Id] = status
+		}
+	}
+	statuses := maps.Values(statusById)
+	slices.SortFunc(statuses, func(a, b *api.ExecutorStatus) bool { return a.Id < b.Id })
+	return statuses
+}
+
+// CheckExecutors logs and alerts on executors that became unhealthy or recovered since the previous check,
+// unless another replica is checking executors. A change of health is only recorded once alerted on,
+// such that failed alerts are retried by the next check.
+func (m *ExecutorHealthMonitor) CheckExecutors() {
+	if m.unhealthyAfter == 0 {
+		return
+	}
+	if _, err := m.repository.ProcessExecutorHealth(m.checkExecutors); err != nil {
+		log.WithError(err).Error("failed to check executors")
+	}
+}
+
+func (m *ExecutorHealthMonitor) checkExecutors() {
+	statuses, err := m.GetExecutorStatuses()
+	if err != nil {
+		log.WithError(err).Error("failed to get executor statuses")
+		return
+	}
+	unhealthy, err := m.repository.GetUnhealthyExecutors()
+	if err != nil {
+		log.WithError(err).Error("failed to get unhealthy executors")
+		return
+	}
+	ctx := armadacontext.Background()
+	for _, status := range statuses {
+		if status.Healthy != unhealthy[status.Id] {
+			continue
+		}
+		logger := log.WithFields(log.Fields{"executor": status.Id, "pool": status.Pool, "lastHeartbeat": status.LastHeartbeat})
+		if status.Healthy {
+			logger.Infof("executor %s recovered", status.Id)
+		} else {
+			logger.Warnf("executor %s is unhealthy: no heartbeat since %s", status.Id, status.LastHeartbeat)
+		}
+		if m.alerter != nil {
+			if err := m.alerter.Alert(ctx, status); err != nil {
+				logger.WithError(err).Errorf("failed to alert on health of executor %s", status.Id)
+				continue
+			}
+		}
+		if status.Healthy {
+			_, err = m.repository.MarkExecutorHealthy(status.Id)
+		} else {
+			_, err = m.repository.MarkExecutorUnhealthy(status.Id)
+		}
+		if err != nil {
+			logger.WithError(err).Errorf("failed to record health of executor %s", status.Id)
+		}
+	}
+}
+
+// ExecutorHealthAlert is the body of alerts posted by WebhookExecutorHealthAlerter.
+type ExecutorHealthAlert struct {
+	Executor      string    `json:"executor"`
+	Pool          string    `json:"pool"`
+	Healthy       bool      `json:"healthy"`
+	LastHeartbeat time.Time `json:"lastHeartbeat"`
+	Version       string    `json:"version"`
+}
+
+// WebhookExecutorHealthAlerter posts alerts as JSON to a URL, e.g., of an incoming webhook of a chat or paging service.
+type WebhookExecutorHealthAlerter struct {
+	url     string
+	client  *http.Client
+	timeout time.Duration
+}
+
+func NewWebhookExecutorHealthAlerter(config configuration.ExecutorHealthConfig) *WebhookExecutorHealthAlerter {
+	return &WebhookExecutorHealthAlerter{
+		url:     config.AlertWebhookUrl,
+		client:  &http.Client{},
+		timeout: config.AlertTimeout,
+	}
+}
+
+func (a *WebhookExecutorHealthAlerter) Alert(ctx *armadacontext.Context, status *api.ExecutorStatus) error {
+	body, err := json.Marshal(&ExecutorHealthAlert{
+		Executor:      status.Id,
+		Pool:          status.Pool,
+		Healthy:       status.Healthy,
+		LastHeartbeat: status.LastHeartbeat,
+		Version:       status.Version,
+	})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if a.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = armadacontext.WithTimeout(ctx, a.timeout)
+		defer cancel()
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, a.url, bytes.NewReader(body))
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "error posting executor health alert")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		message, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return errors.Errorf("error posting executor health alert: status %s: %s", resp.Status, message)
+	}
+	return nil
+}
+
+// GetExecutors returns the status of each executor that has heartbeated, ordered by id.
+func (server *SubmitServer) GetExecutors(grpcCtx context.Context, _ *api.ExecutorListRequest) (*api.ExecutorList, error) {
+	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+	if err := server.authorizeViewExecutors(ctx, "GetExecutors"); err != nil {
+		return nil, err
+	}
+	statuses, err := server.ExecutorHealthMonitor.GetExecutorStatuses()
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "[GetExecutors] error getting executors: %s", err)
+	}
+	return &api.ExecutorList{Executors: statuses}, nil
+}
+
+// GetExecutor returns the status of the executor with the given id.
+func (server *SubmitServer) GetExecutor(grpcCtx context.Context, req *api.ExecutorGetRequest) (*api.ExecutorStatus, error) {
+	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+	if err := server.authorizeViewExecutors(ctx, "GetExecutor"); err != nil {
+		return nil, err
+	}
+	statuses, err := server.ExecutorHealthMonitor.GetExecutorStatuses()
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "[GetExecutor] error getting executors: %s", err)
+	}
+	for _, executorStatus := range statuses {
+		if executorStatus.Id == req.Id {
+			return executorStatus, nil
+		}
+	}
+	return nil, status.Errorf(codes.NotFound, "[GetExecutor] executor %q has never heartbeated", req.Id)
+}
+
+// authorizeViewExecutors checks that the principal may view executors, which reveals the capacity of each cluster.
+func (server *SubmitServer) authorizeViewExecutors(ctx *armadacontext.Context, method string) error {
+	if server.ExecutorHealthMonitor == nil {
+		return status.Errorf(codes.Unimplemented, "[%s] executor heartbeats aren't recorded by this server", method)
+	}
+	err := server.authorizer.AuthorizeAction(ctx, permissions.ViewExecutors)
+	var ep *armadaerrors.ErrUnauthorized
+	if errors.As(err, &ep) {
+		return status.Errorf(codes.PermissionDenied, "[%s] error: %s", method, ep)
+	} else if err != nil {
+		return status.Errorf(codes.Unavailable, "[%s] error checking permissions: %s", method, err)
+	}
+	return nil
+}
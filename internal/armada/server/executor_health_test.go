@@ -0,0 +1,224 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/api/resource"
+	clock "k8s.io/utils/clock/testing"
+
+	"github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/internal/armada/repository"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	schedulerdb "github.com/armadaproject/armada/internal/scheduler/database"
+	"github.com/armadaproject/armada/internal/scheduler/schedulerobjects"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+type fakeExecutorHealthAlerter struct {
+	alerts []*api.ExecutorStatus
+	// If set, alerts fail with err and aren't recorded.
+	err error
+}
+
+func (a *fakeExecutorHealthAlerter) Alert(_ *armadacontext.Context, status *api.ExecutorStatus) error {
+	if a.err != nil {
+		return a.err
+	}
+	a.alerts = append(a.alerts, status)
+	return nil
+}
+
+func TestExecutorHealthMonitor(t *testing.T) {
+	withExecutorHealthMonitor(func(m *ExecutorHealthMonitor, alerter *fakeExecutorHealthAlerter, fakeClock *clock.FakeClock) {
+		require.NoError(t, m.RecordHeartbeat(&api.StreamingLeaseRequest{
+			ClusterId:       "cluster",
+			Pool:            "cpu",
+			ExecutorVersion: "v1.2.3",
+			Resources:       map[string]resource.Quantity{"cpu": resource.MustParse("10")},
+			ClusterLeasedReport: api.ClusterLeasedReport{Queues: []*api.QueueLeasedReport{
+				{Name: "a", ResourcesLeased: map[string]resource.Quantity{"cpu": resource.MustParse("2")}},
+				{Name: "b", ResourcesLeased: map[string]resource.Quantity{"cpu": resource.MustParse("3")}},
+			}},
+		}))
+		statuses, err := m.GetExecutorStatuses()
+		require.NoError(t, err)
+		require.Len(t, statuses, 1)
+		assert.Equal(t, "v1.2.3", statuses[0].Version)
+		assert.True(t, statuses[0].Healthy)
+		capacity := statuses[0].Capacity["cpu"]
+		assert.Equal(t, int64(10), capacity.Value())
+		allocated := statuses[0].Allocated["cpu"]
+		assert.Equal(t, int64(5), allocated.Value())
+
+		m.CheckExecutors()
+		assert.Empty(t, alerter.alerts)
+
+		// Unhealthy executors are alerted on once.
+		fakeClock.Step(10 * time.Minute)
+		m.CheckExecutors()
+		m.CheckExecutors()
+		require.Len(t, alerter.alerts, 1)
+		assert.False(t, alerter.alerts[0].Healthy)
+
+		// As are recovered executors.
+		require.NoError(t, m.RecordHeartbeat(&api.StreamingLeaseRequest{ClusterId: "cluster", Pool: "cpu"}))
+		m.CheckExecutors()
+		m.CheckExecutors()
+		require.Len(t, alerter.alerts, 2)
+		assert.True(t, alerter.alerts[1].Healthy)
+	})
+}
+
+func TestExecutorHealthMonitor_RetriesFailedAlerts(t *testing.T) {
+	withExecutorHealthMonitor(func(m *ExecutorHealthMonitor, alerter *fakeExecutorHealthAlerter, fakeClock *clock.FakeClock) {
+		require.NoError(t, m.RecordHeartbeat(&api.StreamingLeaseRequest{ClusterId: "cluster"}))
+		fakeClock.Step(10 * time.Minute)
+
+		alerter.err = errors.New("webhook unavailable")
+		m.CheckExecutors()
+		assert.Empty(t, alerter.alerts)
+
+		alerter.err = nil
+		m.CheckExecutors()
+		m.CheckExecutors()
+		require.Len(t, alerter.alerts, 1)
+		assert.False(t, alerter.alerts[0].Healthy)
+	})
+}
+
+func TestExecutorHealthMonitor_OneReplicaAtATime(t *testing.T) {
+	withExecutorHealthMonitor(func(m *ExecutorHealthMonitor, alerter *fakeExecutorHealthAlerter, fakeClock *clock.FakeClock) {
+		require.NoError(t, m.RecordHeartbeat(&api.StreamingLeaseRequest{ClusterId: "cluster"}))
+		fakeClock.Step(10 * time.Minute)
+
+		acquired, err := m.repository.ProcessExecutorHealth(m.CheckExecutors)
+		require.NoError(t, err)
+		assert.True(t, acquired)
+		assert.Empty(t, alerter.alerts)
+
+		m.CheckExecutors()
+		assert.Len(t, alerter.alerts, 1)
+	})
+}
+
+func TestExecutorHealthMonitor_IncludesPulsarExecutors(t *testing.T) {
+	withExecutorHealthMonitor(func(m *ExecutorHealthMonitor, alerter *fakeExecutorHealthAlerter, fakeClock *clock.FakeClock) {
+		require.NoError(t, m.RecordHeartbeat(&api.StreamingLeaseRequest{ClusterId: "legacy"}))
+		err := m.pulsarExecutorRepository.StoreExecutor(armadacontext.Background(), &schedulerobjects.Executor{
+			Id:             "pulsar",
+			Pool:           "cpu",
+			LastUpdateTime: fakeClock.Now(),
+			Nodes: []*schedulerobjects.Node{
+				{
+					TotalResources:   schedulerobjects.ResourceList{Resources: map[string]resource.Quantity{"cpu": resource.MustParse("10")}},
+					AllocatedByQueue: map[string]schedulerobjects.ResourceList{"a": {Resources: map[string]resource.Quantity{"cpu": resource.MustParse("2")}}},
+				},
+				{
+					TotalResources:   schedulerobjects.ResourceList{Resources: map[string]resource.Quantity{"cpu": resource.MustParse("6")}},
+					AllocatedByQueue: map[string]schedulerobjects.ResourceList{"b": {Resources: map[string]resource.Quantity{"cpu": resource.MustParse("3")}}},
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		statuses, err := m.GetExecutorStatuses()
+		require.NoError(t, err)
+		require.Len(t, statuses, 2)
+		assert.Equal(t, "legacy", statuses[0].Id)
+		assert.Equal(t, "pulsar", statuses[1].Id)
+		assert.Equal(t, "cpu", statuses[1].Pool)
+		assert.True(t, statuses[1].Healthy)
+		capacity := statuses[1].Capacity["cpu"]
+		assert.Equal(t, int64(16), capacity.Value())
+		allocated := statuses[1].Allocated["cpu"]
+		assert.Equal(t, int64(5), allocated.Value())
+
+		fakeClock.Step(10 * time.Minute)
+		m.CheckExecutors()
+		require.Len(t, alerter.alerts, 2)
+		assert.ElementsMatch(t, []string{"legacy", "pulsar"}, []string{alerter.alerts[0].Id, alerter.alerts[1].Id})
+	})
+}
+
+func TestSubmitServer_GetExecutors(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
+		_, err := s.GetExecutors(context.Background(), &api.ExecutorListRequest{})
+		assert.Equal(t, codes.Unimplemented, status.Code(err))
+
+		withExecutorHealthMonitor(func(m *ExecutorHealthMonitor, _ *fakeExecutorHealthAlerter, _ *clock.FakeClock) {
+			s.ExecutorHealthMonitor = m
+			require.NoError(t, m.RecordHeartbeat(&api.StreamingLeaseRequest{ClusterId: "b"}))
+			require.NoError(t, m.RecordHeartbeat(&api.StreamingLeaseRequest{ClusterId: "a"}))
+
+			executors, err := s.GetExecutors(context.Background(), &api.ExecutorListRequest{})
+			require.NoError(t, err)
+			require.Len(t, executors.Executors, 2)
+			assert.Equal(t, "a", executors.Executors[0].Id)
+			assert.Equal(t, "b", executors.Executors[1].Id)
+
+			executor, err := s.GetExecutor(context.Background(), &api.ExecutorGetRequest{Id: "b"})
+			require.NoError(t, err)
+			assert.Equal(t, "b", executor.Id)
+			_, err = s.GetExecutor(context.Background(), &api.ExecutorGetRequest{Id: "missing"})
+			assert.Equal(t, codes.NotFound, status.Code(err))
+
+			// Viewing executors requires the view_executors permission.
+			s.authorizer = &FakeDenyAllActionAuthorizer{}
+			_, err = s.GetExecutors(context.Background(), &api.ExecutorListRequest{})
+			assert.Equal(t, codes.PermissionDenied, status.Code(err))
+			_, err = s.GetExecutor(context.Background(), &api.ExecutorGetRequest{Id: "b"})
+			assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		})
+	})
+}
+
+func TestWebhookExecutorHealthAlerter(t *testing.T) {
+	var alert ExecutorHealthAlert
+	webhook := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&alert))
+	}))
+	defer webhook.Close()
+
+	lastHeartbeat := time.Now().UTC().Truncate(time.Second)
+	alerter := NewWebhookExecutorHealthAlerter(configuration.ExecutorHealthConfig{AlertWebhookUrl: webhook.URL, AlertTimeout: time.Second})
+	err := alerter.Alert(armadacontext.Background(), &api.ExecutorStatus{Id: "cluster", Pool: "cpu", LastHeartbeat: lastHeartbeat})
+	require.NoError(t, err)
+	assert.Equal(t, ExecutorHealthAlert{Executor: "cluster", Pool: "cpu", LastHeartbeat: lastHeartbeat}, alert)
+
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer failing.Close()
+	alerter = NewWebhookExecutorHealthAlerter(configuration.ExecutorHealthConfig{AlertWebhookUrl: failing.URL})
+	assert.Error(t, alerter.Alert(armadacontext.Background(), &api.ExecutorStatus{Id: "cluster"}))
+}
+
+func withExecutorHealthMonitor(action func(m *ExecutorHealthMonitor, alerter *fakeExecutorHealthAlerter, fakeClock *clock.FakeClock)) {
+	client := redis.NewClient(&redis.Options{Addr: "localhost:6379", DB: 10})
+	defer client.FlushDB()
+	defer client.Close()
+	client.FlushDB()
+
+	alerter := &fakeExecutorHealthAlerter{}
+	fakeClock := clock.NewFakeClock(time.Now())
+	m := NewExecutorHealthMonitor(
+		repository.NewRedisExecutorStatusRepository(client),
+		schedulerdb.NewRedisExecutorRepository(client, "pulsar"),
+		alerter,
+		configuration.ExecutorHealthConfig{UnhealthyAfter: 5 * time.Minute},
+	)
+	m.clock = fakeClock
+	action(m, alerter, fakeClock)
+}
@@ -0,0 +1,140 @@
+package server
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/armadaproject/armada/internal/armada/permissions"
+	"github.com/armadaproject/armada/internal/armada/repository"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/armadaerrors"
+	schedulercontext "github.com/armadaproject/armada/internal/scheduler/context"
+	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/client/queue"
+)
+
+// GetFairShareWeights returns the fair-share weight of each queue, i.e., the inverse of its priority factor.
+func (server *SubmitServer) GetFairShareWeights(grpcCtx context.Context, _ *api.FairShareWeightsRequest) (*api.FairShareWeights, error) {
+	queues, err := server.queueRepository.GetAllQueues()
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "[GetFairShareWeights] error getting queues: %s", err)
+	}
+	slices.SortFunc(queues, func(a, b queue.Queue) bool { return a.Name < b.Name })
+	effectivePriorityFactors := queue.EffectivePriorityFactors(queues, nil)
+	weights := make([]*api.QueueFairShareWeight, len(queues))
+	for i, q := range queues {
+		weights[i] = fairShareWeight(q, effectivePriorityFactors[q.Name])
+	}
+	return &api.FairShareWeights{Weights: weights}, nil
+}
+
+// SetFairShareWeight sets the fair-share weight of a queue by setting its priority factor to the inverse of the weight.
+// Weights are in (0, 1], since priority factors are at least 1.
+func (server *SubmitServer) SetFairShareWeight(grpcCtx context.Context, req *api.SetFairShareWeightRequest) (*api.QueueFairShareWeight, error) {
+	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+	err := server.authorizer.AuthorizeAction(ctx, permissions.SetFairShareWeights)
+	var ep *armadaerrors.ErrUnauthorized
+	if errors.As(err, &ep) {
+		return nil, status.Errorf(codes.PermissionDenied, "[SetFairShareWeight] error setting weight of queue %s: %s", req.Queue, ep)
+	} else if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "[SetFairShareWeight] error checking permissions: %s", err)
+	}
+	if req.Weight <= 0 || req.Weight > 1 {
+		return nil, status.Errorf(codes.InvalidArgument, "[SetFairShareWeight] weight must be in (0, 1], but got %g", req.Weight)
+	}
+
+	for attempt := 1; ; attempt++ {
+		q, err := server.queueRepository.GetQueue(req.Queue)
+		var e *repository.ErrQueueNotFound
+		if errors.As(err, &e) {
+			return nil, status.Errorf(codes.NotFound, "[SetFairShareWeight] error: %s", err)
+		} else if err != nil {
+			return nil, status.Errorf(codes.Unavailable, "[SetFairShareWeight] error getting queue %q: %s", req.Queue, err)
+		}
+
+		// The queue is updated based on the revision read, such that changes made since aren't overwritten.
+		q.PriorityFactor = queue.PriorityFactor(1 / req.Weight)
+		err = server.queueRepository.UpdateQueue(q)
+		var em *repository.ErrQueueRevisionMismatch
+		if errors.As(err, &em) && attempt < maxPatchQueueAttempts {
+			continue
+		} else if errors.As(err, &em) {
+			return nil, status.Errorf(codes.Aborted, "[SetFairShareWeight] error: %s", err)
+		} else if errors.As(err, &e) {
+			return nil, status.Errorf(codes.NotFound, "[SetFairShareWeight] error: %s", err)
+		} else if err != nil {
+			return nil, status.Errorf(codes.Unavailable, "[SetFairShareWeight] error updating queue %q: %s", req.Queue, err)
+		}
+
+		queues, err := server.queueRepository.GetAllQueues()
+		if err != nil {
+			return nil, status.Errorf(codes.Unavailable, "[SetFairShareWeight] error getting queues: %s", err)
+		}
+		return fairShareWeight(q, queue.EffectivePriorityFactors(queues, nil)[q.Name]), nil
+	}
+}
+
+func fairShareWeight(q queue.Queue, effectivePriorityFactor float64) *api.QueueFairShareWeight {
+	weight := &api.QueueFairShareWeight{Queue: q.Name}
+	if q.PriorityFactor > 0 {
+		weight.Weight = 1 / float64(q.PriorityFactor)
+	}
+	if effectivePriorityFactor > 0 {
+		weight.EffectiveWeight = 1 / effectivePriorityFactor
+	}
+	return weight
+}
+
+// GetFairShares returns the fair share and dominant resource share of each queue considered by the most recent
+// scheduling round of each executor. Shares are computed by the legacy scheduler only.
+func (server *SubmitServer) GetFairShares(grpcCtx context.Context, _ *api.FairSharesRequest) (*api.FairShares, error) {
+	if server.SchedulingContextRepository == nil {
+		return nil, status.Errorf(codes.Unimplemented, "[GetFairShares] scheduling rounds aren't recorded by this server")
+	}
+	sctxByExecutor := server.SchedulingContextRepository.GetMostRecentSchedulingContextByExecutor()
+	executorIds := maps.Keys(sctxByExecutor)
+	slices.Sort(executorIds)
+	shares := &api.FairShares{Executors: make([]*api.ExecutorFairShares, 0, len(executorIds))}
+	for _, executorId := range executorIds {
+		shares.Executors = append(shares.Executors, executorFairShares(sctxByExecutor[executorId]))
+	}
+	return shares, nil
+}
+
+func executorFairShares(sctx *schedulercontext.SchedulingContext) *api.ExecutorFairShares {
+	queueNames := maps.Keys(sctx.QueueSchedulingContexts)
+	slices.Sort(queueNames)
+	executorShares := &api.ExecutorFairShares{
+		ExecutorId: sctx.ExecutorId,
+		Pool:       sctx.Pool,
+		Computed:   sctx.Started,
+		Shares:     make([]*api.QueueFairShare, 0, len(queueNames)),
+	}
+	resourceTypes := maps.Keys(sctx.TotalResources.Resources)
+	slices.Sort(resourceTypes)
+	for _, queueName := range queueNames {
+		qctx := sctx.QueueSchedulingContexts[queueName]
+		share := &api.QueueFairShare{Queue: queueName, Weight: qctx.Weight}
+		if sctx.WeightSum > 0 {
+			share.FairShare = qctx.Weight / sctx.WeightSum
+		}
+		for _, t := range resourceTypes {
+			capacity := sctx.TotalResources.Get(t)
+			if capacity.Sign() <= 0 {
+				continue
+			}
+			allocated := qctx.Allocated.Get(t)
+			if s := float64(allocated.MilliValue()) / float64(capacity.MilliValue()); s > share.DominantResourceShare {
+				share.DominantResourceShare = s
+				share.DominantResource = t
+			}
+		}
+		executorShares.Shares = append(executorShares.Shares, share)
+	}
+	return executorShares
+}
@@ -0,0 +1,92 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/armadaproject/armada/internal/armada/repository"
+	"github.com/armadaproject/armada/internal/scheduler"
+	schedulercontext "github.com/armadaproject/armada/internal/scheduler/context"
+	"github.com/armadaproject/armada/internal/scheduler/schedulerobjects"
+	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/client/queue"
+)
+
+func TestSubmitServer_FairShareWeights(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
+		require.NoError(t, s.queueRepository.CreateQueue(queue.Queue{Name: "child", PriorityFactor: 4, Parent: "test"}))
+
+		weights, err := s.GetFairShareWeights(context.Background(), &api.FairShareWeightsRequest{})
+		require.NoError(t, err)
+		assert.Equal(t, []*api.QueueFairShareWeight{
+			// The weight of a parent is divided between itself and its children in proportion to their weights.
+			{Queue: "child", Weight: 0.25, EffectiveWeight: 0.2},
+			{Queue: "test", Weight: 1, EffectiveWeight: 0.8},
+		}, weights.Weights)
+
+		weight, err := s.SetFairShareWeight(context.Background(), &api.SetFairShareWeightRequest{Queue: "test", Weight: 0.5})
+		require.NoError(t, err)
+		assert.Equal(t, 0.5, weight.Weight)
+		q, err := s.queueRepository.GetQueue("test")
+		require.NoError(t, err)
+		assert.Equal(t, queue.PriorityFactor(2), q.PriorityFactor)
+
+		for _, w := range []float64{0, -1, 2} {
+			_, err = s.SetFairShareWeight(context.Background(), &api.SetFairShareWeightRequest{Queue: "test", Weight: w})
+			assert.Equal(t, codes.InvalidArgument, status.Code(err), "weight %g", w)
+		}
+		_, err = s.SetFairShareWeight(context.Background(), &api.SetFairShareWeightRequest{Queue: "missing", Weight: 1})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+
+		s.authorizer = &FakeDenyAllActionAuthorizer{}
+		_, err = s.SetFairShareWeight(context.Background(), &api.SetFairShareWeightRequest{Queue: "test", Weight: 1})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+}
+
+func TestSubmitServer_GetFairShares(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
+		_, err := s.GetFairShares(context.Background(), &api.FairSharesRequest{})
+		assert.Equal(t, codes.Unimplemented, status.Code(err))
+
+		repo, err := scheduler.NewSchedulingContextRepository(10)
+		require.NoError(t, err)
+		s.SchedulingContextRepository = repo
+		sctx := schedulercontext.NewSchedulingContext(
+			"test-cluster",
+			"cpu",
+			nil,
+			"",
+			nil,
+			nil,
+			schedulerobjects.ResourceList{Resources: map[string]resource.Quantity{
+				"cpu":    resource.MustParse("10"),
+				"memory": resource.MustParse("100Gi"),
+			}},
+		)
+		allocated := schedulerobjects.QuantityByTAndResourceType[string]{
+			"armada-default": schedulerobjects.ResourceList{Resources: map[string]resource.Quantity{
+				"cpu":    resource.MustParse("2"),
+				"memory": resource.MustParse("50Gi"),
+			}},
+		}
+		require.NoError(t, sctx.AddQueueSchedulingContext("a", 1, allocated, nil))
+		require.NoError(t, sctx.AddQueueSchedulingContext("b", 3, nil, nil))
+		require.NoError(t, repo.AddSchedulingContext(sctx))
+
+		shares, err := s.GetFairShares(context.Background(), &api.FairSharesRequest{})
+		require.NoError(t, err)
+		require.Len(t, shares.Executors, 1)
+		assert.Equal(t, "test-cluster", shares.Executors[0].ExecutorId)
+		assert.Equal(t, []*api.QueueFairShare{
+			{Queue: "a", Weight: 1, FairShare: 0.25, DominantResourceShare: 0.5, DominantResource: "memory"},
+			{Queue: "b", Weight: 3, FairShare: 0.75},
+		}, shares.Executors[0].Shares)
+	})
+}
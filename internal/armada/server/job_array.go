@@ -0,0 +1,73 @@
+package server
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+// Maximum number of jobs of a job array.
+const maxJobArrayCount = 100000
+
+// jobArrayMember identifies the job array a job request item was expanded from.
+type jobArrayMember struct {
+	arrayId string
+	index   uint32
+}
+
+// expandJobArrays appends the jobs of the job arrays of request to its job request items, and clears its job arrays.
+// Returns for each job request item the job array it's a member of, if any.
+func expandJobArrays(request *api.JobSubmitRequest, getUlid func() string) ([]*jobArrayMember, error) {
+	members := make([]*jobArrayMember, len(request.JobRequestItems))
+	for i, array := range request.JobArrays {
+		if array.Template == nil {
+			return nil, errors.Errorf("job array %d has no template", i)
+		}
+		if array.Count == 0 || array.Count > maxJobArrayCount {
+			return nil, errors.Errorf("number of jobs of job array %d must be between 1 and %d, but is %d", i, maxJobArrayCount, array.Count)
+		}
+		if _, ok := array.Template.Labels[api.JobArrayIndexLabel]; ok {
+			return nil, errors.Errorf("template of job array %d may not set label %s", i, api.JobArrayIndexLabel)
+		}
+		// Each job is unmarshalled from the template, such that it's a deep copy.
+		templateData, err := proto.Marshal(array.Template)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		arrayId := getUlid()
+		for index := uint32(0); index < array.Count; index++ {
+			item := &api.JobSubmitRequestItem{}
+			if err := proto.Unmarshal(templateData, item); err != nil {
+				return nil, errors.WithStack(err)
+			}
+			if item.ClientId != "" {
+				item.ClientId = fmt.Sprintf("%s-%d", item.ClientId, index)
+			}
+			if item.Labels == nil {
+				item.Labels = make(map[string]string)
+			}
+			item.Labels[api.JobArrayIndexLabel] = strconv.FormatUint(uint64(index), 10)
+			request.JobRequestItems = append(request.JobRequestItems, item)
+			members = append(members, &jobArrayMember{arrayId: arrayId, index: index})
+		}
+	}
+	request.JobArrays = nil
+	return members, nil
+}
+
+// setJobArrayIndex sets the job array index env var of each pod spec of item, if it's a member of a job array.
+func setJobArrayIndex(item *api.JobSubmitRequestItem, member *jobArrayMember) {
+	if member == nil {
+		return
+	}
+	if item.PodSpec != nil {
+		api.SetJobArrayIndexEnvVar(item.PodSpec, member.index)
+	}
+	for _, podSpec := range item.PodSpecs {
+		api.SetJobArrayIndexEnvVar(podSpec, member.index)
+	}
+}
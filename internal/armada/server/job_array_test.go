@@ -0,0 +1,74 @@
+package server
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/armadaproject/armada/internal/armada/repository"
+	"github.com/armadaproject/armada/internal/common/util"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+func TestSubmitServer_SubmitJobs_ExpandsJobArrays(t *testing.T) {
+	withSubmitServerAndRepos(func(s *SubmitServer, jobRepo repository.JobRepository, events *repository.TestEventStore) {
+		request := createJobRequest(util.NewULID(), 1)
+		template := createJobRequestItems(1)[0]
+		template.ClientId = "sweep"
+		template.Labels = map[string]string{"sweep": "lr"}
+		request.JobArrays = []*api.JobArray{{Template: template, Count: 3}}
+
+		response, err := s.SubmitJobs(context.Background(), request)
+		require.NoError(t, err)
+		require.Len(t, response.JobResponseItems, 4)
+		jobIds := util.Map(response.JobResponseItems, func(item *api.JobSubmitResponseItem) string { return item.JobId })
+		jobs, err := jobRepo.GetExistingJobsByIds(jobIds)
+		require.NoError(t, err)
+		require.Len(t, jobs, 4)
+
+		assert.Empty(t, jobs[0].JobArrayId)
+		assert.NotContains(t, jobs[0].Labels, api.JobArrayIndexLabel)
+		for i, job := range jobs[1:] {
+			index := strconv.Itoa(i)
+			assert.Equal(t, jobs[1].JobArrayId, job.JobArrayId)
+			assert.NotEmpty(t, job.JobArrayId)
+			assert.Equal(t, uint32(i), job.JobArrayIndex)
+			assert.Equal(t, "sweep-"+index, job.ClientId)
+			assert.Equal(t, map[string]string{"sweep": "lr", api.JobArrayIndexLabel: index}, job.Labels)
+			assert.Contains(t, job.PodSpec.Containers[0].Env, v1.EnvVar{Name: api.JobArrayIndexEnvVar, Value: index})
+		}
+	})
+}
+
+func TestSubmitServer_CreateJobs_ValidatesJobArrays(t *testing.T) {
+	tests := map[string]*api.JobArray{
+		"no template": {Count: 2},
+		"zero count":  {Template: createJobRequestItems(1)[0]},
+		"count too large": {
+			Template: createJobRequestItems(1)[0],
+			Count:    maxJobArrayCount + 1,
+		},
+		"index label set": {
+			Template: &api.JobSubmitRequestItem{
+				Labels:   map[string]string{api.JobArrayIndexLabel: "1"},
+				PodSpecs: createJobRequestItems(1)[0].PodSpecs,
+			},
+			Count: 2,
+		},
+	}
+	for name, array := range tests {
+		t.Run(name, func(t *testing.T) {
+			withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
+				request := createJobRequest("jobSetId", 0)
+				request.JobArrays = []*api.JobArray{array}
+
+				_, _, err := s.createJobs(request, "owner")
+				assert.Error(t, err)
+			})
+		})
+	}
+}
@@ -0,0 +1,172 @@
+package server
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/internal/armada/repository"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/api/binoculars"
+	"github.com/armadaproject/armada/pkg/client"
+)
+
+// JobLogProxy retrieves the container logs of jobs from the binoculars service of the cluster they ran on.
+type JobLogProxy struct {
+	// Returns a client of the binoculars service of the given cluster.
+	clientForCluster   func(clusterId string) (binoculars.BinocularsClient, error)
+	followPollInterval time.Duration
+}
+
+func NewJobLogProxy(config configuration.JobLogsConfig) *JobLogProxy {
+	var mu sync.Mutex
+	clients := make(map[string]binoculars.BinocularsClient)
+	return &JobLogProxy{
+		clientForCluster: func(clusterId string) (binoculars.BinocularsClient, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			if c, ok := clients[clusterId]; ok {
+				return c, nil
+			}
+			connectionDetails := config.BinocularsConnection
+			connectionDetails.ArmadaUrl = strings.ReplaceAll(connectionDetails.ArmadaUrl, "{CLUSTER_ID}", clusterId)
+			conn, err := client.CreateApiConnection(&connectionDetails)
+			if err != nil {
+				return nil, errors.WithMessagef(err, "error connecting to binoculars of cluster %s", clusterId)
+			}
+			clients[clusterId] = binoculars.NewBinocularsClient(conn)
+			return clients[clusterId], nil
+		},
+		followPollInterval: config.FollowPollInterval,
+	}
+}
+
+// GetJobLogs streams the container logs of the most recent run of a pod of a job, provided the caller may watch its
+// queue. If the request is to follow the logs, new logs are streamed until the run ends or the client disconnects.
+func (server *SubmitServer) GetJobLogs(req *api.JobLogsRequest, stream api.Submit_GetJobLogsServer) error {
+	ctx := armadacontext.FromGrpcCtx(stream.Context())
+	if server.JobLogProxy == nil || server.EventRepository == nil {
+		return status.Errorf(codes.Unimplemented, "[GetJobLogs] logs of jobs can't be retrieved from this server")
+	}
+	if req.Queue == "" || req.JobSetId == "" || req.JobId == "" {
+		return status.Errorf(codes.InvalidArgument, "[GetJobLogs] queue, job set id, and job id must not be empty")
+	}
+	q, err := server.queueRepository.GetQueue(req.Queue)
+	var queueNotFound *repository.ErrQueueNotFound
+	if errors.As(err, &queueNotFound) {
+		return status.Errorf(codes.NotFound, "[GetJobLogs] queue %s does not exist", req.Queue)
+	} else if err != nil {
+		return status.Errorf(codes.Unavailable, "[GetJobLogs] error getting queue %s: %s", req.Queue, err)
+	}
+	if err := validateUserHasWatchPermissions(ctx, server.authorizer, q, req.JobSetId); err != nil {
+		return status.Errorf(status.Code(err), "[GetJobLogs] %s", status.Convert(err).Message())
+	}
+
+	tracker := &jobRunTracker{jobId: req.JobId, podNumber: req.PodNumber}
+	fromId, err := readJobSetEvents(server.EventRepository, req.Queue, req.JobSetId, firstJobEventId([]string{req.JobId}), "", tracker.apply)
+	if err != nil {
+		return jobSetEventsError("GetJobLogs", err)
+	}
+	if tracker.clusterId == "" {
+		return status.Errorf(codes.FailedPrecondition, "[GetJobLogs] pod %d of job %s has never run", req.PodNumber, req.JobId)
+	}
+	run := *tracker
+	binocularsClient, err := server.JobLogProxy.clientForCluster(run.clusterId)
+	if err != nil {
+		return status.Errorf(codes.Unavailable, "[GetJobLogs] %s", err)
+	}
+
+	logOptions := &v1.PodLogOptions{Container: req.Container}
+	if req.TailLines > 0 {
+		logOptions.TailLines = &req.TailLines
+	}
+	var since time.Time
+	for {
+		// Logs written before the run ended are all returned by the request following the end.
+		ended := tracker.finished || tracker.kubernetesId != run.kubernetesId
+		request := &binoculars.LogRequest{
+			JobId:        req.JobId,
+			PodNumber:    req.PodNumber,
+			PodNamespace: run.podNamespace,
+			LogOptions:   logOptions,
+		}
+		if !since.IsZero() {
+			request.SinceTime = since.Format(time.RFC3339Nano)
+		}
+		response, err := binocularsClient.Logs(ctx, request)
+		if err != nil {
+			return status.Errorf(codes.Unavailable, "[GetJobLogs] error getting logs from cluster %s: %s", run.clusterId, err)
+		}
+		var lines []*api.JobLogLine
+		lines, since = newJobLogLines(response.Log, since)
+		if len(lines) > 0 {
+			if err := stream.Send(&api.JobLogs{Lines: lines}); err != nil {
+				return status.Errorf(codes.Unavailable, "[GetJobLogs] error sending logs: %s", err)
+			}
+		}
+		if !req.Follow || ended {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(server.JobLogProxy.followPollInterval):
+		}
+		logOptions = &v1.PodLogOptions{Container: req.Container}
+		if fromId, err = readJobSetEvents(server.EventRepository, req.Queue, req.JobSetId, fromId, "", tracker.apply); err != nil {
+			return jobSetEventsError("GetJobLogs", err)
+		}
+	}
+}
+
+// newJobLogLines returns the lines of logLines written after since, along with the time the last of them was written.
+// Since logs are requested from the start of the second of since, lines already returned are requested again.
+func newJobLogLines(logLines []*binoculars.LogLine, since time.Time) ([]*api.JobLogLine, time.Time) {
+	var lines []*api.JobLogLine
+	for _, logLine := range logLines {
+		written, err := time.Parse(time.RFC3339Nano, logLine.Timestamp)
+		if err != nil || !written.After(since) {
+			continue
+		}
+		lines = append(lines, &api.JobLogLine{Timestamp: logLine.Timestamp, Line: logLine.Line})
+		since = written
+	}
+	return lines, since
+}
+
+// jobRunTracker finds the most recent run of a pod of a job, and whether it has ended, from the events of its job set.
+type jobRunTracker struct {
+	jobId        string
+	podNumber    int32
+	clusterId    string
+	podNamespace string
+	kubernetesId string
+	finished     bool
+}
+
+func (t *jobRunTracker) apply(message *api.EventStreamMessage) {
+	event, err := api.UnwrapEvent(message.Message)
+	if err != nil || event.GetJobId() != t.jobId {
+		return
+	}
+	switch e := message.Message.Events.(type) {
+	case *api.EventMessage_Running:
+		if e.Running.PodNumber == t.podNumber {
+			t.clusterId = e.Running.ClusterId
+			t.podNamespace = e.Running.PodNamespace
+			t.kubernetesId = e.Running.KubernetesId
+			t.finished = false
+		}
+	case *api.EventMessage_Succeeded, *api.EventMessage_Failed, *api.EventMessage_Cancelled,
+		*api.EventMessage_LeaseReturned, *api.EventMessage_LeaseExpired:
+		t.finished = true
+	}
+}
@@ -0,0 +1,127 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/armadaproject/armada/internal/armada/repository"
+	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/api/binoculars"
+	"github.com/armadaproject/armada/pkg/client/queue"
+)
+
+// fakeBinocularsClient returns the logs in responses, one per call, calling onLogs before each.
+type fakeBinocularsClient struct {
+	responses [][]*binoculars.LogLine
+	requests  []*binoculars.LogRequest
+	onLogs    func(call int)
+}
+
+func (c *fakeBinocularsClient) Logs(_ context.Context, req *binoculars.LogRequest, _ ...grpc.CallOption) (*binoculars.LogResponse, error) {
+	call := len(c.requests)
+	c.requests = append(c.requests, req)
+	if c.onLogs != nil {
+		c.onLogs(call)
+	}
+	return &binoculars.LogResponse{Log: c.responses[call]}, nil
+}
+
+func (c *fakeBinocularsClient) Cordon(context.Context, *binoculars.CordonRequest, ...grpc.CallOption) (*types.Empty, error) {
+	return &types.Empty{}, nil
+}
+
+type jobLogsStreamMock struct {
+	grpc.ServerStream
+	sent []*api.JobLogs
+}
+
+func (s *jobLogsStreamMock) Send(logs *api.JobLogs) error {
+	s.sent = append(s.sent, logs)
+	return nil
+}
+
+func (s *jobLogsStreamMock) Context() context.Context {
+	return context.Background()
+}
+
+func TestSubmitServer_GetJobLogs(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, _ *repository.TestEventStore) {
+		req := &api.JobLogsRequest{Queue: "queue", JobSetId: "set", JobId: "job-1", TailLines: 10}
+		err := s.GetJobLogs(req, &jobLogsStreamMock{})
+		assert.Equal(t, codes.Unimplemented, status.Code(err))
+
+		require.NoError(t, s.queueRepository.CreateQueue(queue.Queue{Name: "queue", PriorityFactor: 1}))
+		events := &fakeEventRepository{}
+		events.add(
+			&api.EventMessage{Events: &api.EventMessage_Leased{Leased: &api.JobLeasedEvent{JobId: "job-1", ClusterId: "cluster-1"}}},
+			&api.EventMessage{Events: &api.EventMessage_Running{Running: &api.JobRunningEvent{
+				JobId: "job-1", ClusterId: "cluster-1", PodNamespace: "namespace", KubernetesId: "run-1",
+			}}},
+		)
+		s.EventRepository = events
+		line := func(second int, text string) *binoculars.LogLine {
+			return &binoculars.LogLine{Timestamp: time.Date(2023, 6, 1, 0, 0, second, 0, time.UTC).Format(time.RFC3339Nano), Line: text}
+		}
+		binocularsClient := &fakeBinocularsClient{
+			responses: [][]*binoculars.LogLine{
+				{line(1, "a"), line(2, "b")},
+				{line(2, "b"), line(3, "c")},
+				{line(3, "c"), line(4, "d")},
+			},
+		}
+		var clusterIds []string
+		s.JobLogProxy = &JobLogProxy{
+			clientForCluster: func(clusterId string) (binoculars.BinocularsClient, error) {
+				clusterIds = append(clusterIds, clusterId)
+				return binocularsClient, nil
+			},
+			followPollInterval: time.Millisecond,
+		}
+
+		stream := &jobLogsStreamMock{}
+		require.NoError(t, s.GetJobLogs(req, stream))
+		assert.Equal(t, []string{"cluster-1"}, clusterIds)
+		require.Len(t, stream.sent, 1)
+		assert.Equal(t, []*api.JobLogLine{{Timestamp: line(1, "").Timestamp, Line: "a"}, {Timestamp: line(2, "").Timestamp, Line: "b"}}, stream.sent[0].Lines)
+		require.Len(t, binocularsClient.requests, 1)
+		assert.Equal(t, "namespace", binocularsClient.requests[0].PodNamespace)
+		assert.Equal(t, int64(10), *binocularsClient.requests[0].LogOptions.TailLines)
+
+		// Following streams new logs until the run ends.
+		binocularsClient.requests = nil
+		binocularsClient.onLogs = func(call int) {
+			if call == 1 {
+				events.add(&api.EventMessage{Events: &api.EventMessage_Succeeded{Succeeded: &api.JobSucceededEvent{JobId: "job-1"}}})
+			}
+		}
+		req.Follow = true
+		stream = &jobLogsStreamMock{}
+		require.NoError(t, s.GetJobLogs(req, stream))
+		require.Len(t, stream.sent, 3)
+		assert.Equal(t, "c", stream.sent[1].Lines[0].Line)
+		require.Len(t, stream.sent[1].Lines, 1)
+		assert.Equal(t, "d", stream.sent[2].Lines[0].Line)
+		require.Len(t, binocularsClient.requests, 3)
+		assert.Equal(t, line(2, "").Timestamp, binocularsClient.requests[1].SinceTime)
+		assert.Nil(t, binocularsClient.requests[1].LogOptions.TailLines)
+
+		err = s.GetJobLogs(&api.JobLogsRequest{Queue: "queue", JobSetId: "set", JobId: "job-2"}, &jobLogsStreamMock{})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		err = s.GetJobLogs(&api.JobLogsRequest{Queue: "missing", JobSetId: "set", JobId: "job-1"}, &jobLogsStreamMock{})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		err = s.GetJobLogs(&api.JobLogsRequest{Queue: "queue", JobId: "job-1"}, &jobLogsStreamMock{})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		s.authorizer = &FakeDenyAllActionAuthorizer{}
+		err = s.GetJobLogs(req, &jobLogsStreamMock{})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+}
@@ -0,0 +1,113 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+// GetJobPlacements returns the cluster, pool, and node each run of a job was placed on,
+// as reconstructed from the events of its job set, provided the caller may watch its queue.
+func (s *QueryServer) GetJobPlacements(grpcCtx context.Context, req *api.JobPlacementsRequest) (*api.JobPlacements, error) {
+	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+	if req.JobId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "[GetJobPlacements] job id must not be empty")
+	}
+	statusReq := &api.JobStatusRequest{Queue: req.Queue, JobSetId: req.JobSetId, JobIds: []string{req.JobId}}
+	if err := s.authorizeJobStatusRequest(ctx, "GetJobPlacements", statusReq); err != nil {
+		return nil, err
+	}
+	tracker := &jobPlacementTracker{jobId: req.JobId}
+	lastId, err := s.eventRepository.GetLastMessageId(req.Queue, req.JobSetId)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "[GetJobPlacements] error getting id of last event: %s", err)
+	}
+	if _, err := readJobSetEvents(s.eventRepository, req.Queue, req.JobSetId, firstJobEventId(statusReq.JobIds), lastId, tracker.apply); err != nil {
+		return nil, jobSetEventsError("GetJobPlacements", err)
+	}
+	return &api.JobPlacements{JobId: req.JobId, Placements: tracker.placements}, nil
+}
+
+// jobPlacementTracker reconstructs where each run of a job was placed from the events of its job set.
+type jobPlacementTracker struct {
+	jobId      string
+	placements []*api.JobPlacement
+}
+
+func (t *jobPlacementTracker) apply(message *api.EventStreamMessage) {
+	event, err := api.UnwrapEvent(message.Message)
+	if err != nil || event.GetJobId() != t.jobId {
+		return
+	}
+	created := event.GetCreated()
+	switch e := message.Message.Events.(type) {
+	case *api.EventMessage_Leased:
+		t.placements = append(t.placements, &api.JobPlacement{
+			ClusterId: e.Leased.ClusterId,
+			Pool:      e.Leased.Pool,
+			Leased:    &created,
+			State:     api.JobState_PENDING,
+		})
+	case *api.EventMessage_Pending:
+		placement := t.activePlacement(e.Pending.ClusterId)
+		placement.KubernetesId = e.Pending.KubernetesId
+	case *api.EventMessage_Running:
+		placement := t.activePlacement(e.Running.ClusterId)
+		placement.KubernetesId = e.Running.KubernetesId
+		placement.NodeName = e.Running.NodeName
+		placement.NodeLabels = e.Running.NodeLabels
+		setIfNotEmpty(&placement.Pool, e.Running.Pool)
+		placement.Started = &created
+		placement.State = api.JobState_RUNNING
+	case *api.EventMessage_Succeeded:
+		placement := t.activePlacement(e.Succeeded.ClusterId)
+		setIfNotEmpty(&placement.NodeName, e.Succeeded.NodeName)
+		finishPlacement(placement, created, api.JobState_SUCCEEDED, "")
+	case *api.EventMessage_Failed:
+		placement := t.activePlacement(e.Failed.ClusterId)
+		setIfNotEmpty(&placement.NodeName, e.Failed.NodeName)
+		finishPlacement(placement, created, api.JobState_FAILED, e.Failed.Reason)
+	case *api.EventMessage_LeaseReturned:
+		finishPlacement(t.activePlacement(e.LeaseReturned.ClusterId), created, api.JobState_QUEUED, e.LeaseReturned.Reason)
+	case *api.EventMessage_LeaseExpired:
+		finishPlacement(t.activePlacement(""), created, api.JobState_QUEUED, "lease expired")
+	case *api.EventMessage_Cancelled:
+		if placement := t.lastActivePlacement(); placement != nil {
+			finishPlacement(placement, created, api.JobState_CANCELLED, e.Cancelled.Reason)
+		}
+	}
+}
+
+// activePlacement returns the placement of the active run of the job, adding one placed on clusterId if there's none,
+// e.g., because the job set's events from before the run was leased have expired.
+func (t *jobPlacementTracker) activePlacement(clusterId string) *api.JobPlacement {
+	if placement := t.lastActivePlacement(); placement != nil {
+		return placement
+	}
+	placement := &api.JobPlacement{ClusterId: clusterId, State: api.JobState_PENDING}
+	t.placements = append(t.placements, placement)
+	return placement
+}
+
+// lastActivePlacement returns the placement of the active run of the job, or nil if no run is active.
+func (t *jobPlacementTracker) lastActivePlacement() *api.JobPlacement {
+	if len(t.placements) == 0 {
+		return nil
+	}
+	placement := t.placements[len(t.placements)-1]
+	if placement.State != api.JobState_PENDING && placement.State != api.JobState_RUNNING {
+		return nil
+	}
+	return placement
+}
+
+func finishPlacement(placement *api.JobPlacement, finished time.Time, state api.JobState, reason string) {
+	placement.Finished = &finished
+	placement.State = state
+	placement.Reason = reason
+}
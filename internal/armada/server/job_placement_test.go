@@ -0,0 +1,76 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+func TestQueryServer_GetJobPlacements(t *testing.T) {
+	t0 := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+	at := func(minutes int) time.Time { return t0.Add(time.Duration(minutes) * time.Minute) }
+	labels := map[string]string{"gpu": "a100"}
+	events := &fakeEventRepository{}
+	events.add(
+		&api.EventMessage{Events: &api.EventMessage_Submitted{Submitted: &api.JobSubmittedEvent{JobId: "job-1", Created: at(0)}}},
+		&api.EventMessage{Events: &api.EventMessage_Leased{Leased: &api.JobLeasedEvent{JobId: "job-1", ClusterId: "cluster-1", Pool: "gpu", Created: at(1)}}},
+		&api.EventMessage{Events: &api.EventMessage_Leased{Leased: &api.JobLeasedEvent{JobId: "job-2", ClusterId: "cluster-1", Created: at(1)}}},
+		&api.EventMessage{Events: &api.EventMessage_Running{Running: &api.JobRunningEvent{
+			JobId: "job-1", ClusterId: "cluster-1", Pool: "gpu", KubernetesId: "run-1", NodeName: "node-1", NodeLabels: labels, Created: at(2),
+		}}},
+		&api.EventMessage{Events: &api.EventMessage_Failed{Failed: &api.JobFailedEvent{
+			JobId: "job-1", ClusterId: "cluster-1", NodeName: "node-1", Reason: "Xid 79", WillRetry: true, Created: at(3),
+		}}},
+		&api.EventMessage{Events: &api.EventMessage_Leased{Leased: &api.JobLeasedEvent{JobId: "job-1", ClusterId: "cluster-2", Pool: "gpu", Created: at(4)}}},
+		&api.EventMessage{Events: &api.EventMessage_LeaseReturned{LeaseReturned: &api.JobLeaseReturnedEvent{
+			JobId: "job-1", ClusterId: "cluster-2", Reason: "pod creation failed", Created: at(5),
+		}}},
+		&api.EventMessage{Events: &api.EventMessage_Leased{Leased: &api.JobLeasedEvent{JobId: "job-1", ClusterId: "cluster-2", Pool: "gpu", Created: at(6)}}},
+		&api.EventMessage{Events: &api.EventMessage_Running{Running: &api.JobRunningEvent{
+			JobId: "job-1", ClusterId: "cluster-2", KubernetesId: "run-3", NodeName: "node-2", Created: at(7),
+		}}},
+	)
+	s := newTestQueryServer(t, &FakeActionAuthorizer{}, events)
+	ctx := armadacontext.Background()
+
+	placements, err := s.GetJobPlacements(ctx, &api.JobPlacementsRequest{Queue: "queue", JobSetId: "set", JobId: "job-1"})
+	require.NoError(t, err)
+	assert.Equal(t, "job-1", placements.JobId)
+	t1, t2, t3, t4, t5, t6, t7 := at(1), at(2), at(3), at(4), at(5), at(6), at(7)
+	assert.Equal(t, []*api.JobPlacement{
+		{
+			ClusterId:    "cluster-1",
+			Pool:         "gpu",
+			NodeName:     "node-1",
+			NodeLabels:   labels,
+			KubernetesId: "run-1",
+			Leased:       &t1,
+			Started:      &t2,
+			Finished:     &t3,
+			State:        api.JobState_FAILED,
+			Reason:       "Xid 79",
+		},
+		{ClusterId: "cluster-2", Pool: "gpu", Leased: &t4, Finished: &t5, State: api.JobState_QUEUED, Reason: "pod creation failed"},
+		{ClusterId: "cluster-2", Pool: "gpu", NodeName: "node-2", KubernetesId: "run-3", Leased: &t6, Started: &t7, State: api.JobState_RUNNING},
+	}, placements.Placements)
+
+	placements, err = s.GetJobPlacements(ctx, &api.JobPlacementsRequest{Queue: "queue", JobSetId: "set", JobId: "job-3"})
+	require.NoError(t, err)
+	assert.Empty(t, placements.Placements)
+
+	_, err = s.GetJobPlacements(ctx, &api.JobPlacementsRequest{Queue: "queue", JobSetId: "set"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = s.GetJobPlacements(ctx, &api.JobPlacementsRequest{Queue: "missing", JobSetId: "set", JobId: "job-1"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	s = newTestQueryServer(t, &FakeDenyAllActionAuthorizer{}, events)
+	_, err = s.GetJobPlacements(ctx, &api.JobPlacementsRequest{Queue: "queue", JobSetId: "set", JobId: "job-1"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
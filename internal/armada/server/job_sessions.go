@@ -0,0 +1,295 @@
+package server
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/armadaproject/armada/internal/armada/permissions"
+	"github.com/armadaproject/armada/internal/armada/repository"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/armadaerrors"
+	"github.com/armadaproject/armada/internal/common/auth/authorization"
+	"github.com/armadaproject/armada/internal/common/util"
+	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/client/queue"
+)
+
+// Number of sessions that may be awaiting delivery to each executor watching for them.
+const jobSessionAssignmentBufferSize = 16
+
+// JobSessionServer brokers exec and port-forward sessions into the pods of running jobs. Sessions are relayed through
+// the executor of the cluster a job runs on: executors watch for the sessions assigned to them, and attach to each to
+// relay its data to and from the pod. Sessions are brokered in memory, so clients and executors must connect to the
+// same replica of the server.
+type JobSessionServer struct {
+	authorizer      ActionAuthorizer
+	queueRepository repository.QueueRepository
+	eventRepository repository.EventRepository
+	// Sessions fail if the executor of the cluster doesn't attach to them within this long.
+	attachTimeout time.Duration
+
+	mu sync.Mutex
+	// Channels on which sessions are assigned to the executors watching for them, by cluster.
+	watchers map[string]map[chan *api.JobSessionAssignment]bool
+	// Sessions awaiting an executor to attach to them, by id.
+	pending map[string]*pendingJobSession
+}
+
+type pendingJobSession struct {
+	clusterId string
+	// Context of the client's request; executors detach from the session once it's done.
+	clientCtx context.Context
+	// Receives the stream of the executor attaching to the session.
+	attached chan api.JobSessions_ServeJobSessionServer
+}
+
+func NewJobSessionServer(
+	authorizer ActionAuthorizer,
+	queueRepository repository.QueueRepository,
+	eventRepository repository.EventRepository,
+	attachTimeout time.Duration,
+) *JobSessionServer {
+	return &JobSessionServer{
+		authorizer:      authorizer,
+		queueRepository: queueRepository,
+		eventRepository: eventRepository,
+		attachTimeout:   attachTimeout,
+		watchers:        make(map[string]map[chan *api.JobSessionAssignment]bool),
+		pending:         make(map[string]*pendingJobSession),
+	}
+}
+
+// OpenJobSession opens a session into a pod of a running job, provided the caller may exec into jobs of its queue,
+// and relays frames between the caller and the executor of its cluster until either ends the session.
+func (s *JobSessionServer) OpenJobSession(stream api.JobSessions_OpenJobSessionServer) error {
+	ctx := armadacontext.FromGrpcCtx(stream.Context())
+	msg, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	req := msg.GetOpen()
+	if err := validateJobSessionOpenRequest(req); err != nil {
+		return status.Errorf(codes.InvalidArgument, "[OpenJobSession] %s", err)
+	}
+	q, err := s.queueRepository.GetQueue(req.Queue)
+	var queueNotFound *repository.ErrQueueNotFound
+	if errors.As(err, &queueNotFound) {
+		return status.Errorf(codes.NotFound, "[OpenJobSession] queue %s does not exist", req.Queue)
+	} else if err != nil {
+		return status.Errorf(codes.Unavailable, "[OpenJobSession] error getting queue %s: %s", req.Queue, err)
+	}
+	err = s.authorizer.AuthorizeQueueAction(ctx, q, permissions.ExecAnyJobs, queue.PermissionVerbExec)
+	var permErr *armadaerrors.ErrUnauthorized
+	if errors.As(err, &permErr) {
+		return status.Errorf(codes.PermissionDenied, "[OpenJobSession] error opening session into job %s: %s", req.JobId, permErr)
+	} else if err != nil {
+		return status.Errorf(codes.Unavailable, "[OpenJobSession] error checking permissions: %s", err)
+	}
+
+	run := &jobRunTracker{jobId: req.JobId, podNumber: req.PodNumber}
+	if _, err := readJobSetEvents(s.eventRepository, req.Queue, req.JobSetId, firstJobEventId([]string{req.JobId}), "", run.apply); err != nil {
+		return jobSetEventsError("OpenJobSession", err)
+	}
+	if run.clusterId == "" || run.finished {
+		return status.Errorf(codes.FailedPrecondition, "[OpenJobSession] pod %d of job %s is not running", req.PodNumber, req.JobId)
+	}
+
+	assignment := &api.JobSessionAssignment{
+		SessionId:    util.NewULID(),
+		JobId:        req.JobId,
+		PodNumber:    req.PodNumber,
+		KubernetesId: run.kubernetesId,
+	}
+	switch session := req.Session.(type) {
+	case *api.JobSessionOpenRequest_Exec:
+		assignment.Session = &api.JobSessionAssignment_Exec{Exec: session.Exec}
+	case *api.JobSessionOpenRequest_PortForward:
+		assignment.Session = &api.JobSessionAssignment_PortForward{PortForward: session.PortForward}
+	}
+	session := &pendingJobSession{
+		clusterId: run.clusterId,
+		clientCtx: stream.Context(),
+		attached:  make(chan api.JobSessions_ServeJobSessionServer, 1),
+	}
+	defer s.removePendingSession(assignment.SessionId)
+	if !s.assignSession(assignment, session) {
+		return status.Errorf(codes.Unavailable, "[OpenJobSession] no executor of cluster %s is connected to this server", run.clusterId)
+	}
+	ctx.Infof(
+		"%s opened session %s into pod %d of job %s on cluster %s",
+		authorization.GetPrincipal(ctx).GetName(), assignment.SessionId, req.PodNumber, req.JobId, run.clusterId,
+	)
+
+	var executorStream api.JobSessions_ServeJobSessionServer
+	select {
+	case executorStream = <-session.attached:
+	case <-time.After(s.attachTimeout):
+		return status.Errorf(codes.DeadlineExceeded, "[OpenJobSession] executor of cluster %s didn't attach to the session within %s", run.clusterId, s.attachTimeout)
+	case <-ctx.Done():
+		return nil
+	}
+
+	// Frames are relayed to the executor until the client stops sending them; the executor ends the session.
+	go func() {
+		for {
+			msg, err := stream.Recv()
+			if err == io.EOF {
+				// The client closed stdin, but still waits for the output of the session.
+				_ = executorStream.Send(&api.JobSessionFrame{Frame: &api.JobSessionFrame_StdinClosed{StdinClosed: true}})
+				return
+			} else if err != nil {
+				return
+			}
+			if frame := msg.GetFrame(); frame != nil {
+				if err := executorStream.Send(frame); err != nil {
+					return
+				}
+			}
+		}
+	}()
+	for {
+		msg, err := executorStream.Recv()
+		if err != nil {
+			return status.Errorf(codes.Unavailable, "[OpenJobSession] executor of cluster %s disconnected: %s", run.clusterId, err)
+		}
+		frame := msg.GetFrame()
+		if frame == nil {
+			continue
+		}
+		if err := stream.Send(frame); err != nil {
+			return err
+		}
+		if frame.GetEnd() != nil {
+			return nil
+		}
+	}
+}
+
+// WatchJobSessions streams the sessions assigned to the executor of a cluster until it disconnects.
+func (s *JobSessionServer) WatchJobSessions(req *api.JobSessionWatchRequest, stream api.JobSessions_WatchJobSessionsServer) error {
+	ctx := armadacontext.FromGrpcCtx(stream.Context())
+	if err := s.authorizer.AuthorizeAction(ctx, permissions.ExecuteJobs); err != nil {
+		return status.Errorf(codes.PermissionDenied, "[WatchJobSessions] error: %s", err)
+	}
+	if req.ClusterId == "" {
+		return status.Errorf(codes.InvalidArgument, "[WatchJobSessions] cluster id must not be empty")
+	}
+	if err := authorizeExecutor(ctx, req.ClusterId); err != nil {
+		return status.Errorf(codes.PermissionDenied, "[WatchJobSessions] error: %s", err)
+	}
+
+	assignments := make(chan *api.JobSessionAssignment, jobSessionAssignmentBufferSize)
+	s.mu.Lock()
+	if s.watchers[req.ClusterId] == nil {
+		s.watchers[req.ClusterId] = make(map[chan *api.JobSessionAssignment]bool)
+	}
+	s.watchers[req.ClusterId][assignments] = true
+	s.mu.Unlock()
+	defer func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		delete(s.watchers[req.ClusterId], assignments)
+		if len(s.watchers[req.ClusterId]) == 0 {
+			delete(s.watchers, req.ClusterId)
+		}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case assignment := <-assignments:
+			if err := stream.Send(assignment); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// ServeJobSession attaches the calling executor to a session assigned to its cluster,
+// such that the client's frames are relayed to it, until the client ends the session.
+func (s *JobSessionServer) ServeJobSession(stream api.JobSessions_ServeJobSessionServer) error {
+	ctx := armadacontext.FromGrpcCtx(stream.Context())
+	if err := s.authorizer.AuthorizeAction(ctx, permissions.ExecuteJobs); err != nil {
+		return status.Errorf(codes.PermissionDenied, "[ServeJobSession] error: %s", err)
+	}
+	msg, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	attach := msg.GetAttach()
+	if attach == nil {
+		return status.Errorf(codes.InvalidArgument, "[ServeJobSession] the first message must attach to a session")
+	}
+
+	s.mu.Lock()
+	session, ok := s.pending[attach.SessionId]
+	if ok {
+		if err := authorizeExecutor(ctx, session.clusterId); err != nil {
+			s.mu.Unlock()
+			return status.Errorf(codes.PermissionDenied, "[ServeJobSession] error: %s", err)
+		}
+		delete(s.pending, attach.SessionId)
+	}
+	s.mu.Unlock()
+	if !ok {
+		return status.Errorf(codes.NotFound, "[ServeJobSession] session %s does not exist or already has an executor attached", attach.SessionId)
+	}
+	session.attached <- stream
+
+	select {
+	case <-session.clientCtx.Done():
+	case <-ctx.Done():
+	}
+	return nil
+}
+
+// assignSession assigns session to one of the executors of its cluster watching for sessions.
+// Returns false if there's no such executor able to accept it.
+func (s *JobSessionServer) assignSession(assignment *api.JobSessionAssignment, session *pendingJobSession) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pending[assignment.SessionId] = session
+	for assignments := range s.watchers[session.clusterId] {
+		select {
+		case assignments <- assignment:
+			return true
+		default:
+		}
+	}
+	return false
+}
+
+func (s *JobSessionServer) removePendingSession(sessionId string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.pending, sessionId)
+}
+
+func validateJobSessionOpenRequest(req *api.JobSessionOpenRequest) error {
+	if req == nil {
+		return errors.New("the first message must open a session")
+	}
+	if req.Queue == "" || req.JobSetId == "" || req.JobId == "" {
+		return errors.New("queue, job set id, and job id must not be empty")
+	}
+	switch session := req.Session.(type) {
+	case *api.JobSessionOpenRequest_Exec:
+		if len(session.Exec.GetCommand()) == 0 {
+			return errors.New("the command of exec sessions must not be empty")
+		}
+	case *api.JobSessionOpenRequest_PortForward:
+		if port := session.PortForward.GetPort(); port == 0 || port > 65535 {
+			return errors.Errorf("port %d is invalid", port)
+		}
+	default:
+		return errors.New("either exec or port-forward options must be provided")
+	}
+	return nil
+}
@@ -0,0 +1,235 @@
+package server
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/armadaproject/armada/internal/armada/repository"
+	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/client/queue"
+)
+
+func TestJobSessionServer_RelaysSessions(t *testing.T) {
+	s := newTestJobSessionServer(t, &FakeActionAuthorizer{}, time.Minute)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	watch := &watchJobSessionsStreamMock{ctx: ctx, sent: make(chan *api.JobSessionAssignment, 1)}
+	go func() { _ = s.WatchJobSessions(&api.JobSessionWatchRequest{ClusterId: "cluster-1"}, watch) }()
+	waitForJobSessionWatcher(t, s, "cluster-1")
+
+	exec := &api.JobExecOptions{Container: "main", Command: []string{"sh"}, Tty: true}
+	client := newOpenJobSessionStreamMock(ctx)
+	client.recv <- &api.JobSessionClientMessage{Message: &api.JobSessionClientMessage_Open{Open: &api.JobSessionOpenRequest{
+		Queue: "queue", JobSetId: "set", JobId: "job-1", Session: &api.JobSessionOpenRequest_Exec{Exec: exec},
+	}}}
+	clientErr := make(chan error, 1)
+	go func() { clientErr <- s.OpenJobSession(client) }()
+
+	assignment := <-watch.sent
+	assert.Equal(t, "job-1", assignment.JobId)
+	assert.Equal(t, "run-1", assignment.KubernetesId)
+	assert.Equal(t, exec, assignment.GetExec())
+
+	executor := &serveJobSessionStreamMock{
+		ctx:  ctx,
+		recv: make(chan *api.JobSessionExecutorMessage, 2),
+		sent: make(chan *api.JobSessionFrame, 1),
+	}
+	executor.recv <- &api.JobSessionExecutorMessage{Message: &api.JobSessionExecutorMessage_Attach{Attach: &api.JobSessionAttach{SessionId: assignment.SessionId}}}
+	go func() { _ = s.ServeJobSession(executor) }()
+
+	stdin := &api.JobSessionFrame{Frame: &api.JobSessionFrame_Stdin{Stdin: []byte("ls\n")}}
+	client.recv <- &api.JobSessionClientMessage{Message: &api.JobSessionClientMessage_Frame{Frame: stdin}}
+	assert.Equal(t, stdin, <-executor.sent)
+	// Closing stdin is relayed, whereas the session continues until the executor ends it.
+	close(client.recv)
+	assert.Equal(t, &api.JobSessionFrame{Frame: &api.JobSessionFrame_StdinClosed{StdinClosed: true}}, <-executor.sent)
+
+	stdout := &api.JobSessionFrame{Frame: &api.JobSessionFrame_Stdout{Stdout: []byte("file\n")}}
+	end := &api.JobSessionFrame{Frame: &api.JobSessionFrame_End{End: &api.JobSessionEnd{ExitCode: 2}}}
+	executor.recv <- &api.JobSessionExecutorMessage{Message: &api.JobSessionExecutorMessage_Frame{Frame: stdout}}
+	executor.recv <- &api.JobSessionExecutorMessage{Message: &api.JobSessionExecutorMessage_Frame{Frame: end}}
+	assert.Equal(t, stdout, <-client.sent)
+	assert.Equal(t, end, <-client.sent)
+	require.NoError(t, <-clientErr)
+
+	// Sessions can only be attached to once.
+	executor = &serveJobSessionStreamMock{ctx: ctx, recv: make(chan *api.JobSessionExecutorMessage, 1)}
+	executor.recv <- &api.JobSessionExecutorMessage{Message: &api.JobSessionExecutorMessage_Attach{Attach: &api.JobSessionAttach{SessionId: assignment.SessionId}}}
+	assert.Equal(t, codes.NotFound, status.Code(s.ServeJobSession(executor)))
+}
+
+func TestJobSessionServer_OpenJobSessionErrors(t *testing.T) {
+	portForward := &api.JobSessionOpenRequest_PortForward{PortForward: &api.JobPortForwardOptions{Port: 8080}}
+	tests := map[string]struct {
+		authorizer   ActionAuthorizer
+		watchCluster string
+		request      *api.JobSessionOpenRequest
+		expected     codes.Code
+	}{
+		"missing options": {
+			request:  &api.JobSessionOpenRequest{Queue: "queue", JobSetId: "set", JobId: "job-1"},
+			expected: codes.InvalidArgument,
+		},
+		"invalid port": {
+			request: &api.JobSessionOpenRequest{
+				Queue: "queue", JobSetId: "set", JobId: "job-1",
+				Session: &api.JobSessionOpenRequest_PortForward{PortForward: &api.JobPortForwardOptions{Port: 70000}},
+			},
+			expected: codes.InvalidArgument,
+		},
+		"missing queue": {
+			request:  &api.JobSessionOpenRequest{Queue: "missing", JobSetId: "set", JobId: "job-1", Session: portForward},
+			expected: codes.NotFound,
+		},
+		"permission denied": {
+			authorizer: &FakeDenyAllActionAuthorizer{},
+			request:    &api.JobSessionOpenRequest{Queue: "queue", JobSetId: "set", JobId: "job-1", Session: portForward},
+			expected:   codes.PermissionDenied,
+		},
+		"job not running": {
+			watchCluster: "cluster-1",
+			request:      &api.JobSessionOpenRequest{Queue: "queue", JobSetId: "set", JobId: "job-2", Session: portForward},
+			expected:     codes.FailedPrecondition,
+		},
+		"executor not connected": {
+			watchCluster: "cluster-2",
+			request:      &api.JobSessionOpenRequest{Queue: "queue", JobSetId: "set", JobId: "job-1", Session: portForward},
+			expected:     codes.Unavailable,
+		},
+		"executor doesn't attach": {
+			watchCluster: "cluster-1",
+			request:      &api.JobSessionOpenRequest{Queue: "queue", JobSetId: "set", JobId: "job-1", Session: portForward},
+			expected:     codes.DeadlineExceeded,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			authorizer := tc.authorizer
+			if authorizer == nil {
+				authorizer = &FakeActionAuthorizer{}
+			}
+			s := newTestJobSessionServer(t, authorizer, 10*time.Millisecond)
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			if tc.watchCluster != "" {
+				watch := &watchJobSessionsStreamMock{ctx: ctx, sent: make(chan *api.JobSessionAssignment, 1)}
+				go func() { _ = s.WatchJobSessions(&api.JobSessionWatchRequest{ClusterId: tc.watchCluster}, watch) }()
+				waitForJobSessionWatcher(t, s, tc.watchCluster)
+			}
+
+			client := newOpenJobSessionStreamMock(ctx)
+			client.recv <- &api.JobSessionClientMessage{Message: &api.JobSessionClientMessage_Open{Open: tc.request}}
+			assert.Equal(t, tc.expected, status.Code(s.OpenJobSession(client)))
+		})
+	}
+}
+
+func newTestJobSessionServer(t *testing.T, authorizer ActionAuthorizer, attachTimeout time.Duration) *JobSessionServer {
+	t.Helper()
+	client := redis.NewClient(&redis.Options{Addr: "localhost:6379", DB: 11})
+	client.FlushDB()
+	t.Cleanup(func() { client.FlushDB() })
+
+	queueRepository := repository.NewRedisQueueRepository(client)
+	require.NoError(t, queueRepository.CreateQueue(queue.Queue{Name: "queue", PriorityFactor: 1}))
+	events := &fakeEventRepository{}
+	events.add(&api.EventMessage{Events: &api.EventMessage_Running{Running: &api.JobRunningEvent{
+		JobId: "job-1", ClusterId: "cluster-1", PodNamespace: "namespace", KubernetesId: "run-1",
+	}}})
+	return NewJobSessionServer(authorizer, queueRepository, events, attachTimeout)
+}
+
+func waitForJobSessionWatcher(t *testing.T, s *JobSessionServer, clusterId string) {
+	require.Eventually(t, func() bool {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		return len(s.watchers[clusterId]) > 0
+	}, time.Second, time.Millisecond)
+}
+
+type openJobSessionStreamMock struct {
+	grpc.ServerStream
+	ctx  context.Context
+	recv chan *api.JobSessionClientMessage
+	sent chan *api.JobSessionFrame
+}
+
+func newOpenJobSessionStreamMock(ctx context.Context) *openJobSessionStreamMock {
+	return &openJobSessionStreamMock{
+		ctx:  ctx,
+		recv: make(chan *api.JobSessionClientMessage, 1),
+		sent: make(chan *api.JobSessionFrame, 2),
+	}
+}
+
+func (s *openJobSessionStreamMock) Recv() (*api.JobSessionClientMessage, error) {
+	select {
+	case msg, ok := <-s.recv:
+		if !ok {
+			return nil, io.EOF
+		}
+		return msg, nil
+	case <-s.ctx.Done():
+		return nil, s.ctx.Err()
+	}
+}
+
+func (s *openJobSessionStreamMock) Send(frame *api.JobSessionFrame) error {
+	s.sent <- frame
+	return nil
+}
+
+func (s *openJobSessionStreamMock) Context() context.Context {
+	return s.ctx
+}
+
+type watchJobSessionsStreamMock struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent chan *api.JobSessionAssignment
+}
+
+func (s *watchJobSessionsStreamMock) Send(assignment *api.JobSessionAssignment) error {
+	s.sent <- assignment
+	return nil
+}
+
+func (s *watchJobSessionsStreamMock) Context() context.Context {
+	return s.ctx
+}
+
+type serveJobSessionStreamMock struct {
+	grpc.ServerStream
+	ctx  context.Context
+	recv chan *api.JobSessionExecutorMessage
+	sent chan *api.JobSessionFrame
+}
+
+func (s *serveJobSessionStreamMock) Recv() (*api.JobSessionExecutorMessage, error) {
+	select {
+	case msg := <-s.recv:
+		return msg, nil
+	case <-s.ctx.Done():
+		return nil, s.ctx.Err()
+	}
+}
+
+func (s *serveJobSessionStreamMock) Send(frame *api.JobSessionFrame) error {
+	s.sent <- frame
+	return nil
+}
+
+func (s *serveJobSessionStreamMock) Context() context.Context {
+	return s.ctx
+}
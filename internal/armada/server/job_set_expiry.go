@@ -0,0 +1,50 @@
+package server
+
+import (
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/armadaproject/armada/internal/armada/repository"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+)
+
+// Maximum number of job sets expired per call to ExpireJobSets,
+// such that a backlog of expired job sets doesn't block the background task for long.
+const maxJobSetsToExpirePerRun = 100
+
+// ExpireJobSets cancels the jobs of all job sets that didn't complete within their TTL.
+// A JobSetExpired event is reported for each job known to the legacy scheduler before it's cancelled;
+// jobs of the Pulsar scheduler are cancelled with the job set, with the expiry given as the reason.
+func (srv *PulsarSubmitServer) ExpireJobSets() {
+	ctx := armadacontext.Background()
+	expiredJobSets, err := srv.JobSetExpiryRepository.ClaimExpiredJobSets(time.Now(), maxJobSetsToExpirePerRun)
+	if err != nil {
+		log.WithError(err).Error("failed to claim expired job sets")
+		return
+	}
+	for _, jobSet := range expiredJobSets {
+		if err := srv.expireJobSet(ctx, jobSet); err != nil {
+			log.WithError(err).Errorf("failed to expire job set %s in queue %s; retrying", jobSet.JobSetId, jobSet.Queue)
+			// Make the job set expired again, such that it's retried next time.
+			ttl := time.Duration(jobSet.TtlSeconds) * time.Second
+			if err := srv.JobSetExpiryRepository.SetJobSetDeadline(jobSet.Queue, jobSet.JobSetId, time.Now(), ttl); err != nil {
+				log.WithError(err).Errorf("failed to reset deadline of job set %s in queue %s", jobSet.JobSetId, jobSet.Queue)
+			}
+		}
+	}
+}
+
+func (srv *PulsarSubmitServer) expireJobSet(ctx *armadacontext.Context, jobSet *repository.ExpiredJobSet) error {
+	ids, err := srv.SubmitServer.jobRepository.GetJobSetJobIds(jobSet.Queue, jobSet.JobSetId, nil)
+	if err != nil {
+		return err
+	}
+	if err := reportJobSetExpired(srv.SubmitServer.eventStore, jobSet.Queue, jobSet.JobSetId, ids, jobSet.TtlSeconds); err != nil {
+		return err
+	}
+	reason := fmt.Sprintf("job set didn't complete within its TTL of %d seconds", jobSet.TtlSeconds)
+	// Cancellations are attributed to Armada itself, hence the empty user.
+	return srv.cancelJobSet(ctx, jobSet.Queue, jobSet.JobSetId, ids, nil, nil, reason, "", nil)
+}
@@ -0,0 +1,90 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/go-redis/redis"
+	"github.com/gogo/protobuf/proto"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/armadaproject/armada/internal/armada/repository"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/mocks"
+	"github.com/armadaproject/armada/internal/common/pulsarutils"
+	"github.com/armadaproject/armada/internal/common/util"
+	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/armadaevents"
+)
+
+func TestPulsarSubmitServer_ExpireJobSets(t *testing.T) {
+	withSubmitServerAndRepos(func(s *SubmitServer, jobRepo repository.JobRepository, events *repository.TestEventStore) {
+		ctrl := gomock.NewController(t)
+		producer := mocks.NewMockProducer(ctrl)
+		var published []*armadaevents.EventSequence
+		producer.
+			EXPECT().
+			SendAsync(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ *armadacontext.Context, msg *pulsar.ProducerMessage, callback func(pulsar.MessageID, *pulsar.ProducerMessage, error)) {
+				es := &armadaevents.EventSequence{}
+				require.NoError(t, proto.Unmarshal(msg.Payload, es))
+				published = append(published, es)
+				callback(pulsarutils.NewMessageId(len(published)), msg, nil)
+			}).AnyTimes()
+		producer.EXPECT().Flush().Return(nil).AnyTimes()
+
+		client := redis.NewClient(&redis.Options{Addr: "localhost:6379", DB: 10})
+		defer client.Close()
+		srv := &PulsarSubmitServer{
+			Producer:               producer,
+			SubmitServer:           s,
+			MaxAllowedMessageSize:  4 * 1024 * 1024,
+			JobSetExpiryRepository: repository.NewRedisJobSetExpiryRepository(client),
+		}
+
+		jobSetId := util.NewULID()
+		response, err := s.SubmitJobs(context.Background(), createJobRequest(jobSetId, 2))
+		require.NoError(t, err)
+		jobIds := []string{response.JobResponseItems[0].JobId, response.JobResponseItems[1].JobId}
+
+		// Job sets are only expired once their deadline has passed.
+		err = srv.JobSetExpiryRepository.SetJobSetDeadline("test", "later", time.Now().Add(time.Hour), time.Hour)
+		require.NoError(t, err)
+		err = srv.JobSetExpiryRepository.SetJobSetDeadline("test", jobSetId, time.Now().Add(-time.Second), time.Hour)
+		require.NoError(t, err)
+		srv.ExpireJobSets()
+
+		var expiredJobIds []string
+		for _, event := range events.ReceivedEvents {
+			if e, ok := event.Events.(*api.EventMessage_JobSetExpired); ok {
+				assert.Equal(t, jobSetId, e.JobSetExpired.JobSetId)
+				assert.Equal(t, int64(3600), e.JobSetExpired.JobSetTtlSeconds)
+				expiredJobIds = append(expiredJobIds, e.JobSetExpired.JobId)
+			}
+		}
+		assert.ElementsMatch(t, jobIds, expiredJobIds)
+
+		var cancelledJobIds []string
+		for _, sequence := range published {
+			assert.Empty(t, sequence.UserId)
+			for _, event := range sequence.Events {
+				if e, ok := event.Event.(*armadaevents.EventSequence_Event_CancelJob); ok {
+					assert.Equal(t, jobSetId, sequence.JobSetName)
+					jobId, err := armadaevents.UlidStringFromProtoUuid(e.CancelJob.JobId)
+					require.NoError(t, err)
+					cancelledJobIds = append(cancelledJobIds, jobId)
+				}
+			}
+		}
+		assert.ElementsMatch(t, jobIds, cancelledJobIds)
+
+		// Expired job sets are only expired once.
+		expired, err := srv.JobSetExpiryRepository.ClaimExpiredJobSets(time.Now(), 10)
+		require.NoError(t, err)
+		assert.Empty(t, expired)
+	})
+}
@@ -0,0 +1,106 @@
+package server
+
+import (
+	log "github.com/sirupsen/logrus"
+
+	"github.com/armadaproject/armada/internal/armada/repository"
+	armadaresource "github.com/armadaproject/armada/internal/common/resource"
+	"github.com/armadaproject/armada/internal/common/util"
+	"github.com/armadaproject/armada/internal/scheduler"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+// jobSetThrottler limits the jobs running concurrently of job sets submitted with MaxConcurrentJobs or JobSetResourceLimits.
+// Queued jobs of such a job set are only admitted for scheduling while fewer than MaxConcurrentJobs of its jobs are leased
+// and while the resources requested by its leased jobs, including the job to be admitted, are within JobSetResourceLimits;
+// further jobs are admitted in later scheduling rounds as earlier ones finish.
+//
+// A jobSetThrottler tracks the jobs admitted during a single scheduling round and must not be reused across rounds.
+type jobSetThrottler struct {
+	jobRepository repository.JobRepository
+	// Throttling state of each job set seen during this round, indexed by queue and job set id.
+	jobSets map[[2]string]*throttledJobSet
+}
+
+type throttledJobSet struct {
+	// Ids of jobs of the job set leased at the start of the round.
+	leasedJobIds map[string]bool
+	// Ids of queued jobs of the job set admitted for scheduling during the round.
+	admittedJobIds map[string]bool
+	// Total resources requested by leased and admitted jobs.
+	resources armadaresource.ComputeResources
+}
+
+func newJobSetThrottler(jobRepository repository.JobRepository) *jobSetThrottler {
+	return &jobSetThrottler{
+		jobRepository: jobRepository,
+		jobSets:       make(map[[2]string]*throttledJobSet),
+	}
+}
+
+// admit returns true if job may be considered for scheduling.
+// Leased jobs are always admitted, such that they can be rescheduled, e.g., after being evicted.
+func (t *jobSetThrottler) admit(job *api.Job) (bool, error) {
+	maxConcurrentJobs, hasMaxConcurrentJobs, err := scheduler.MaxConcurrentJobsFromAnnotations(job.Annotations)
+	if err != nil {
+		// Jobs are validated at submit time; don't let a single malformed job hold up scheduling.
+		log.WithError(err).Warnf("ignoring invalid max concurrent jobs of job %s", job.Id)
+		hasMaxConcurrentJobs = false
+	}
+	resourceLimits, hasResourceLimits, err := scheduler.JobSetResourceLimitsFromAnnotations(job.Annotations)
+	if err != nil {
+		log.WithError(err).Warnf("ignoring invalid job set resource limits of job %s", job.Id)
+		hasResourceLimits = false
+	}
+	if !hasMaxConcurrentJobs && !hasResourceLimits {
+		return true, nil
+	}
+	jobSet, err := t.getJobSet(job.Queue, job.JobSetId)
+	if err != nil {
+		return false, err
+	}
+	if jobSet.leasedJobIds[job.Id] || jobSet.admittedJobIds[job.Id] {
+		return true, nil
+	}
+	if hasMaxConcurrentJobs && len(jobSet.leasedJobIds)+len(jobSet.admittedJobIds) >= maxConcurrentJobs {
+		return false, nil
+	}
+	request := job.TotalResourceRequest()
+	if hasResourceLimits {
+		resources := jobSet.resources.DeepCopy()
+		resources.Add(request)
+		for name, limit := range resourceLimits {
+			if quantity, ok := resources[name]; ok && quantity.Cmp(limit) > 0 {
+				return false, nil
+			}
+		}
+	}
+	jobSet.admittedJobIds[job.Id] = true
+	jobSet.resources.Add(request)
+	return true, nil
+}
+
+func (t *jobSetThrottler) getJobSet(queue string, jobSetId string) (*throttledJobSet, error) {
+	key := [2]string{queue, jobSetId}
+	if jobSet, ok := t.jobSets[key]; ok {
+		return jobSet, nil
+	}
+	leasedJobIds, err := t.jobRepository.GetJobSetJobIds(queue, jobSetId, &repository.JobSetFilter{IncludeLeased: true})
+	if err != nil {
+		return nil, err
+	}
+	leasedJobs, err := t.jobRepository.GetExistingJobsByIds(leasedJobIds)
+	if err != nil {
+		return nil, err
+	}
+	jobSet := &throttledJobSet{
+		leasedJobIds:   util.StringListToSet(leasedJobIds),
+		admittedJobIds: make(map[string]bool),
+		resources:      make(armadaresource.ComputeResources),
+	}
+	for _, leasedJob := range leasedJobs {
+		jobSet.resources.Add(leasedJob.TotalResourceRequest())
+	}
+	t.jobSets[key] = jobSet
+	return jobSet, nil
+}
@@ -2,43 +2,697 @@ package server
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/pkg/errors"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+	v1 "k8s.io/api/core/v1"
 
+	"github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/internal/armada/imageresolver"
+	"github.com/armadaproject/armada/internal/armada/repository"
 	"github.com/armadaproject/armada/internal/armada/scheduling"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	armadaresource "github.com/armadaproject/armada/internal/common/resource"
+	"github.com/armadaproject/armada/internal/common/validation"
 	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/client/queue"
 )
 
 // validateJobsCanBeScheduled returns a boolean indicating if all pods that make up the provided jobs
-// can be scheduled. If it returns false, it also returns an error with information about which job
-// can't be scheduled and why.
+// can be scheduled. If it returns false, it also returns an error, and a response item for each job
+// that can't be scheduled explaining for each cluster why not.
 func validateJobsCanBeScheduled(
 	jobs []*api.Job,
 	allClusterSchedulingInfo map[string]*api.ClusterSchedulingInfoReport,
 ) (bool, []*api.JobSubmitResponseItem, error) {
 	activeClusterSchedulingInfo := scheduling.FilterActiveClusterSchedulingInfoReports(allClusterSchedulingInfo)
+	activePoolByClusterId := poolByClusterId(activeClusterSchedulingInfo)
 	responseItems := make([]*api.JobSubmitResponseItem, 0, len(jobs))
 	for i, job := range jobs {
-		if ok, err := scheduling.MatchSchedulingRequirementsOnAnyCluster(job, activeClusterSchedulingInfo); !ok {
-			if err != nil {
-				response := &api.JobSubmitResponseItem{
-					JobId: job.Id,
-					Error: fmt.Sprintf("%d-th job can't be scheduled: %v", i, err),
-				}
-				responseItems = append(responseItems, response)
+		if field, explanation, reasons := explainUnschedulableJob(job, activeClusterSchedulingInfo, activePoolByClusterId); explanation != "" {
+			response := api.NewFailedJobSubmitResponseItem(job.Id, api.JobSubmitError_UNSCHEDULABLE, field,
+				fmt.Sprintf("%d-th job can't be scheduled: %s", i, explanation))
+			response.UnschedulableReasons = reasons
+			responseItems = append(responseItems, response)
+		}
+	}
+
+	if len(responseItems) > 0 {
+		return false, responseItems, errors.New("[createJobs] Failed to validate jobs can be scheduled")
+	}
+
+	return true, nil, nil
+}
+
+// explainUnschedulableJob returns an explanation of why the job can't be scheduled on any of the provided active
+// clusters, along with the field of the job the explanation relates to, if any, and the reasons for each cluster.
+// The explanation is empty if the job can be scheduled.
+func explainUnschedulableJob(
+	job *api.Job,
+	activeClusterSchedulingInfo map[string]*api.ClusterSchedulingInfoReport,
+	activePoolByClusterId map[string]string,
+) (string, string, []*api.ClusterUnschedulableReasons) {
+	// Jobs targeting clusters need only be schedulable on those.
+	clusterSchedulingInfo := activeClusterSchedulingInfo
+	if targets := clusterTargetsFromAnnotations(job.Annotations); !targets.isEmpty() {
+		clusterSchedulingInfo = make(map[string]*api.ClusterSchedulingInfoReport)
+		for id, report := range activeClusterSchedulingInfo {
+			if targets.allows(report.ClusterId, report.Pool, activePoolByClusterId) {
+				clusterSchedulingInfo[id] = report
+			}
+		}
+		if len(clusterSchedulingInfo) == 0 {
+			return "clusterTargeting", "none of the clusters it may run on is active", nil
+		}
+	}
+	if ok, reasons := scheduling.DiagnoseSchedulingRequirementsOnAnyCluster(job, clusterSchedulingInfo); !ok {
+		return "", unschedulableReasonsString(reasons), reasons
+	}
+	return "", "", nil
+}
+
+func poolByClusterId(clusterSchedulingInfo map[string]*api.ClusterSchedulingInfoReport) map[string]string {
+	result := make(map[string]string, len(clusterSchedulingInfo))
+	for _, report := range clusterSchedulingInfo {
+		result[report.ClusterId] = report.Pool
+	}
+	return result
+}
+
+// unschedulableReasonsString summarises why a job can't be scheduled on any of the clusters reasons are given for.
+func unschedulableReasonsString(reasons []*api.ClusterUnschedulableReasons) string {
+	if len(reasons) == 0 {
+		return "no cluster is active"
+	}
+	var b strings.Builder
+	for i, clusterReasons := range reasons {
+		if i > 0 {
+			b.WriteString("; ")
+		}
+		fmt.Fprintf(&b, "on cluster %s, ", clusterReasons.ClusterId)
+		for j, reason := range clusterReasons.Reasons {
+			if j > 0 {
+				b.WriteString(", ")
+			}
+			if reason.NodeTypes > 0 {
+				fmt.Fprintf(&b, "%d node type(s) excluded because %s", reason.NodeTypes, reason.Message)
 			} else {
-				response := &api.JobSubmitResponseItem{
-					JobId: job.Id,
-					Error: fmt.Sprintf("%d-th job can't be scheduled", i),
-				}
-				responseItems = append(responseItems, response)
+				b.WriteString(reason.Message)
 			}
 		}
 	}
+	return b.String()
+}
+
+// validateServiceAccountsExist returns a boolean indicating if the service account of each of the provided jobs
+// exists in the namespace of the job on at least one cluster. Clusters that don't report their service accounts
+// may have any service account, so jobs are only rejected if every active cluster reports service accounts
+// and none of them has the required one.
+func validateServiceAccountsExist(
+	jobs []*api.Job,
+	allClusterSchedulingInfo map[string]*api.ClusterSchedulingInfoReport,
+) (bool, []*api.JobSubmitResponseItem, error) {
+	activeClusterSchedulingInfo := scheduling.FilterActiveClusterSchedulingInfoReports(allClusterSchedulingInfo)
+	responseItems := make([]*api.JobSubmitResponseItem, 0)
+	for i, job := range jobs {
+		podSpec := job.GetMainPodSpec()
+		if podSpec == nil {
+			continue
+		}
+		serviceAccount := validation.ServiceAccountName(podSpec)
+		if !serviceAccountExistsOnAnyCluster(job.Namespace, serviceAccount, activeClusterSchedulingInfo) {
+			response := api.NewFailedJobSubmitResponseItem(job.Id, api.JobSubmitError_UNSCHEDULABLE, "",
+				fmt.Sprintf("%d-th job can't be scheduled: service account %s doesn't exist in namespace %s on any cluster", i, serviceAccount, job.Namespace))
+			responseItems = append(responseItems, response)
+		}
+	}
 
 	if len(responseItems) > 0 {
-		return false, responseItems, errors.New("[createJobs] Failed to validate jobs can be scheduled")
+		return false, responseItems, errors.New("[createJobs] Failed to validate service accounts exist")
+	}
+
+	return true, nil, nil
+}
+
+func serviceAccountExistsOnAnyCluster(namespace string, serviceAccount string, clusterSchedulingInfo map[string]*api.ClusterSchedulingInfoReport) bool {
+	if len(clusterSchedulingInfo) == 0 {
+		return true
+	}
+	for _, info := range clusterSchedulingInfo {
+		if info.ServiceAccounts == nil {
+			return true
+		}
+		if serviceAccounts, ok := info.ServiceAccounts[namespace]; ok && slices.Contains(serviceAccounts.Names, serviceAccount) {
+			return true
+		}
+	}
+	return false
+}
+
+// validateReferencesExist returns a boolean indicating if each secret and config map referenced by the provided jobs
+// exists in the namespace of the job on at least one cluster. As for service accounts, clusters that don't report
+// their secrets and config maps may have any, and optional references are never rejected, since pods start without them.
+func validateReferencesExist(
+	jobs []*api.Job,
+	allClusterSchedulingInfo map[string]*api.ClusterSchedulingInfoReport,
+) (bool, []*api.JobSubmitResponseItem, error) {
+	activeClusterSchedulingInfo := scheduling.FilterActiveClusterSchedulingInfoReports(allClusterSchedulingInfo)
+	responseItems := make([]*api.JobSubmitResponseItem, 0)
+	for i, job := range jobs {
+		podSpec := job.GetMainPodSpec()
+		if podSpec == nil {
+			continue
+		}
+		for _, reference := range validation.SecretAndConfigMapReferences(podSpec) {
+			if reference.Optional || referenceExistsOnAnyCluster(job.Namespace, reference, activeClusterSchedulingInfo) {
+				continue
+			}
+			response := api.NewFailedJobSubmitResponseItem(job.Id, api.JobSubmitError_INVALID_REFERENCE, mainJobPodSpecField(job)+"."+reference.Field,
+				fmt.Sprintf("%d-th job can't be run: %s doesn't exist in namespace %s on any cluster", i, reference, job.Namespace))
+			responseItems = append(responseItems, response)
+			break
+		}
+	}
+
+	if len(responseItems) > 0 {
+		return false, responseItems, errors.New("[createJobs] Failed to validate secrets and config maps exist")
 	}
 
 	return true, nil, nil
 }
+
+func referenceExistsOnAnyCluster(namespace string, reference validation.Reference, clusterSchedulingInfo map[string]*api.ClusterSchedulingInfoReport) bool {
+	if len(clusterSchedulingInfo) == 0 {
+		return true
+	}
+	for _, info := range clusterSchedulingInfo {
+		if info.SecretsAndConfigMaps == nil {
+			return true
+		}
+		objects, ok := info.SecretsAndConfigMaps[namespace]
+		if !ok {
+			continue
+		}
+		names := objects.SecretNames
+		if reference.Kind == validation.ConfigMapReference {
+			names = objects.ConfigMapNames
+		}
+		if slices.Contains(names, reference.Name) {
+			return true
+		}
+	}
+	return false
+}
+
+// disallowedReference returns the first reference of spec to a secret or config map not allowed by config, if any.
+func disallowedReference(spec *v1.PodSpec, config configuration.SchedulingConfig) (validation.Reference, bool) {
+	for _, reference := range validation.SecretAndConfigMapReferences(spec) {
+		allowed := config.AllowedSecrets
+		if reference.Kind == validation.ConfigMapReference {
+			allowed = config.AllowedConfigMaps
+		}
+		if len(allowed) > 0 && !slices.Contains(allowed, reference.Name) {
+			return reference, true
+		}
+	}
+	return validation.Reference{}, false
+}
+
+// gangAnnotations returns the annotations by which the scheduler identifies members of gang, after validating it.
+// Returns an error if annotations already contain any gang annotations, which gang replaces.
+func gangAnnotations(gang *api.Gang, annotations map[string]string) (map[string]string, error) {
+	for _, key := range []string{
+		configuration.GangIdAnnotation,
+		configuration.GangCardinalityAnnotation,
+		configuration.GangMinimumCardinalityAnnotation,
+		configuration.GangNodeUniformityLabelAnnotation,
+	} {
+		if _, ok := annotations[key]; ok {
+			return nil, errors.Errorf("gang may not be set together with annotation %s", key)
+		}
+	}
+	if gang.Id == "" {
+		return nil, errors.New("gang id must not be empty")
+	}
+	if gang.Cardinality == 0 {
+		return nil, errors.New("gang cardinality must be positive")
+	}
+	if gang.MinCardinality > gang.Cardinality {
+		return nil, errors.Errorf("gang minimum cardinality %d may not exceed gang cardinality %d", gang.MinCardinality, gang.Cardinality)
+	}
+	result := map[string]string{
+		configuration.GangIdAnnotation:          gang.Id,
+		configuration.GangCardinalityAnnotation: strconv.FormatUint(uint64(gang.Cardinality), 10),
+	}
+	if gang.MinCardinality > 0 {
+		result[configuration.GangMinimumCardinalityAnnotation] = strconv.FormatUint(uint64(gang.MinCardinality), 10)
+	}
+	if gang.NodeUniformityLabel != "" {
+		result[configuration.GangNodeUniformityLabelAnnotation] = gang.NodeUniformityLabel
+	}
+	return result, nil
+}
+
+// maxRuntimeAnnotations returns the annotations by which the server identifies the maximum runtime of a job, if any,
+// which is maxRuntimeSeconds or, if 0, the maximum job runtime of the queue of the job, queueMaxRuntimeSeconds.
+// Returns an error if the runtime exceeds that of the queue, or if annotations already contain the max runtime annotation.
+func maxRuntimeAnnotations(maxRuntimeSeconds uint32, queueMaxRuntimeSeconds uint32, annotations map[string]string) (map[string]string, error) {
+	if maxRuntimeSeconds == 0 {
+		maxRuntimeSeconds = queueMaxRuntimeSeconds
+	}
+	if maxRuntimeSeconds == 0 {
+		return nil, nil
+	}
+	if queueMaxRuntimeSeconds > 0 && maxRuntimeSeconds > queueMaxRuntimeSeconds {
+		return nil, errors.Errorf("maximum runtime of %d seconds exceeds the maximum job runtime of the queue of %d seconds", maxRuntimeSeconds, queueMaxRuntimeSeconds)
+	}
+	if _, ok := annotations[configuration.MaxRuntimeSecondsAnnotation]; ok {
+		return nil, errors.Errorf("maximum runtime may not be set together with annotation %s", configuration.MaxRuntimeSecondsAnnotation)
+	}
+	return map[string]string{configuration.MaxRuntimeSecondsAnnotation: strconv.FormatUint(uint64(maxRuntimeSeconds), 10)}, nil
+}
+
+// preemptibleAnnotations returns the annotations by which the scheduler identifies jobs submitted as preemptible.
+// Returns an error if annotations already contain the preemptible annotation, or if the job is a member of a gang,
+// since evicting a single member would requeue it without the rest of its gang.
+func preemptibleAnnotations(annotations map[string]string) (map[string]string, error) {
+	if _, ok := annotations[configuration.PreemptibleAnnotation]; ok {
+		return nil, errors.Errorf("isPreemptible may not be set together with annotation %s", configuration.PreemptibleAnnotation)
+	}
+	if _, ok := annotations[configuration.GangIdAnnotation]; ok {
+		return nil, errors.New("members of gangs may not be preemptible")
+	}
+	return map[string]string{configuration.PreemptibleAnnotation: "true"}, nil
+}
+
+// validateGangMembers returns an error for each job of request that's a member of a gang but inconsistent with the
+// first member of that gang in request, and for the first member of each gang with more or fewer members than its
+// cardinality. Since all members of a gang must be submitted together, the gang is otherwise complete.
+// jobIds are the ids of the jobs created from the items of request.
+func validateGangMembers(request *api.JobSubmitRequest, jobIds []string) []*api.JobSubmitResponseItem {
+	var responseItems []*api.JobSubmitResponseItem
+	firstMemberByGangId := make(map[string]int)
+	numMembersByGangId := make(map[string]uint32)
+	for i, item := range request.JobRequestItems {
+		if item.Gang == nil || item.Gang.Id == "" {
+			continue
+		}
+		gangId := item.Gang.Id
+		numMembersByGangId[gangId]++
+		first, ok := firstMemberByGangId[gangId]
+		if !ok {
+			firstMemberByGangId[gangId] = i
+			continue
+		}
+		if field, reason := gangMemberInconsistency(request.JobRequestItems[first], item); field != "" {
+			responseItems = append(responseItems, api.NewFailedJobSubmitResponseItem(jobIds[i], api.JobSubmitError_INVALID_GANG, field, fmt.Sprintf(
+				"[createJobs] %d-th job of job set %s is inconsistent with the %d-th job, both of which are members of gang %s: %s",
+				i, request.JobSetId, first, gangId, reason,
+			)))
+		}
+	}
+	for gangId, first := range firstMemberByGangId {
+		cardinality := request.JobRequestItems[first].Gang.Cardinality
+		if numMembers := numMembersByGangId[gangId]; numMembers != cardinality {
+			responseItems = append(responseItems, api.NewFailedJobSubmitResponseItem(jobIds[first], api.JobSubmitError_INVALID_GANG, "gang.cardinality", fmt.Sprintf(
+				"[createJobs] gang %s has cardinality %d, but %d of its members were submitted; all members of a gang must be submitted together",
+				gangId, cardinality, numMembers,
+			)))
+		}
+	}
+	slices.SortStableFunc(responseItems, func(a, b *api.JobSubmitResponseItem) bool {
+		return slices.Index(jobIds, a.JobId) < slices.Index(jobIds, b.JobId)
+	})
+	return responseItems
+}
+
+// gangMemberInconsistency returns the path of the first field of item that differs from that of first,
+// among those that must be equal for all members of a gang, together with a description of the difference.
+// Returns an empty path if item is consistent with first.
+func gangMemberInconsistency(first *api.JobSubmitRequestItem, item *api.JobSubmitRequestItem) (string, string) {
+	if first.Gang.Cardinality != item.Gang.Cardinality {
+		return "gang.cardinality", fmt.Sprintf("gang cardinality %d differs from %d", item.Gang.Cardinality, first.Gang.Cardinality)
+	}
+	if first.Gang.MinCardinality != item.Gang.MinCardinality {
+		return "gang.minCardinality", fmt.Sprintf("gang minimum cardinality %d differs from %d", item.Gang.MinCardinality, first.Gang.MinCardinality)
+	}
+	if first.Gang.NodeUniformityLabel != item.Gang.NodeUniformityLabel {
+		return "gang.nodeUniformityLabel", fmt.Sprintf(
+			"gang node uniformity label %q differs from %q", item.Gang.NodeUniformityLabel, first.Gang.NodeUniformityLabel,
+		)
+	}
+	if first.Priority != item.Priority {
+		return "priority", fmt.Sprintf("priority %v differs from %v", item.Priority, first.Priority)
+	}
+	firstPodSpec, podSpec := first.GetMainPodSpec(), item.GetMainPodSpec()
+	if firstPodSpec == nil || podSpec == nil {
+		// Jobs without a pod spec are rejected regardless.
+		return "", ""
+	}
+	if firstPodSpec.PriorityClassName != podSpec.PriorityClassName {
+		return mainPodSpecField(item) + ".priorityClassName", fmt.Sprintf(
+			"priority class %q differs from %q", podSpec.PriorityClassName, firstPodSpec.PriorityClassName,
+		)
+	}
+	firstRequests, requests := armadaresource.TotalPodResourceRequest(firstPodSpec), armadaresource.TotalPodResourceRequest(podSpec)
+	if !firstRequests.Equal(requests) {
+		return mainPodSpecField(item) + ".containers", fmt.Sprintf("resource requests %s differ from %s", requests, firstRequests)
+	}
+	return "", ""
+}
+
+// jobSizeLimits are the limits on the size of jobs submitted to a particular queue.
+// A limit of 0 means no limit.
+type jobSizeLimits struct {
+	maxJobSizeBytes     uint
+	maxContainersPerJob uint
+}
+
+// getQueueIfExists returns the queue with the provided name, or nil if no such queue exists yet.
+func (server *SubmitServer) getQueueIfExists(queueName string) (*queue.Queue, error) {
+	q, err := server.queueRepository.GetQueue(queueName)
+	var e *repository.ErrQueueNotFound
+	if errors.As(err, &e) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return &q, nil
+}
+
+// jobSizeLimitsForQueue returns the stricter of the server-wide and queue-specific job size limits.
+// Only the server-wide limits apply to queues that don't exist yet.
+func (server *SubmitServer) jobSizeLimitsForQueue(q *queue.Queue) jobSizeLimits {
+	limits := jobSizeLimits{
+		maxJobSizeBytes:     server.schedulingConfig.MaxJobSizeBytes,
+		maxContainersPerJob: server.schedulingConfig.MaxContainersPerJob,
+	}
+	if q == nil {
+		return limits
+	}
+	limits.maxJobSizeBytes = stricterLimit(limits.maxJobSizeBytes, uint(q.MaxJobSizeBytes))
+	limits.maxContainersPerJob = stricterLimit(limits.maxContainersPerJob, uint(q.MaxContainersPerJob))
+	return limits
+}
+
+// schedulingConfigForQueue returns the scheduling config with the service accounts and external-dns domains configured
+// for the queue, and the pod spec defaults and limits narrowed by the pod spec policy of the queue, if it exists.
+// Queue settings outside the server-wide limits are ignored.
+func (server *SubmitServer) schedulingConfigForQueue(queueName string, q *queue.Queue) configuration.SchedulingConfig {
+	config := *server.schedulingConfig
+	if serviceAccounts, ok := config.QueueServiceAccounts[queueName]; ok {
+		config.DefaultServiceAccount = serviceAccounts.Default
+		config.AllowedServiceAccounts = slices.Clone(serviceAccounts.Allowed)
+		if serviceAccount := defaultServiceAccount(config); !slices.Contains(config.AllowedServiceAccounts, serviceAccount) {
+			config.AllowedServiceAccounts = append(config.AllowedServiceAccounts, serviceAccount)
+		}
+	} else if len(config.QueueServiceAccounts) > 0 {
+		// Once service accounts are mapped to queues, the global ones may belong to some tenant,
+		// so pods of queues without a mapping may only run as the default service account.
+		config.AllowedServiceAccounts = []string{defaultServiceAccount(config)}
+	}
+	if domains, ok := config.QueueExternalDnsDomains[queueName]; ok {
+		config.ExternalDnsDomains = domains
+	}
+	if q == nil {
+		return config
+	}
+	policy := q.PodSpecPolicy
+
+	minTerminationGracePeriod := time.Duration(policy.MinTerminationGracePeriodSeconds) * time.Second
+	maxTerminationGracePeriod := time.Duration(policy.MaxTerminationGracePeriodSeconds) * time.Second
+	if minTerminationGracePeriod > config.MinTerminationGracePeriod && minTerminationGracePeriod <= config.MaxTerminationGracePeriod {
+		config.MinTerminationGracePeriod = minTerminationGracePeriod
+	}
+	if maxTerminationGracePeriod != 0 && maxTerminationGracePeriod < config.MaxTerminationGracePeriod && maxTerminationGracePeriod >= config.MinTerminationGracePeriod {
+		config.MaxTerminationGracePeriod = maxTerminationGracePeriod
+	}
+	if policy.DefaultTerminationGracePeriodSeconds != 0 {
+		config.DefaultTerminationGracePeriod = time.Duration(policy.DefaultTerminationGracePeriodSeconds) * time.Second
+	}
+
+	if len(policy.AllowedRestartPolicies) > 0 {
+		allowedRestartPolicies := make([]v1.RestartPolicy, 0, len(policy.AllowedRestartPolicies))
+		for _, restartPolicy := range policy.AllowedRestartPolicies {
+			if slices.Contains(config.AllowedRestartPolicies, restartPolicy) || len(config.AllowedRestartPolicies) == 0 {
+				allowedRestartPolicies = append(allowedRestartPolicies, restartPolicy)
+			}
+		}
+		if len(allowedRestartPolicies) > 0 {
+			config.AllowedRestartPolicies = allowedRestartPolicies
+		}
+	}
+	if policy.DefaultRestartPolicy != "" {
+		config.DefaultRestartPolicy = policy.DefaultRestartPolicy
+	}
+
+	if len(policy.AllowedServiceAccounts) > 0 {
+		allowedServiceAccounts := make([]string, 0, len(policy.AllowedServiceAccounts))
+		for _, serviceAccount := range policy.AllowedServiceAccounts {
+			if slices.Contains(config.AllowedServiceAccounts, serviceAccount) || len(config.AllowedServiceAccounts) == 0 {
+				allowedServiceAccounts = append(allowedServiceAccounts, serviceAccount)
+			}
+		}
+		if len(allowedServiceAccounts) > 0 {
+			config.AllowedServiceAccounts = allowedServiceAccounts
+		} else {
+			// Pods of the queue may only run as service accounts allowed by both the queue and the server.
+			config.AllowedServiceAccounts = nil
+			config.DenyAllServiceAccounts = true
+		}
+	}
+
+	if policy.RequestsEqualLimits {
+		config.RequestsEqualLimits = true
+	}
+
+	// Node constraints of the queue add to the server-wide ones, which take precedence where they conflict.
+	if len(policy.RequiredNodeSelector) > 0 {
+		requiredNodeSelector := maps.Clone(policy.RequiredNodeSelector)
+		maps.Copy(requiredNodeSelector, config.RequiredJobNodeSelector)
+		config.RequiredJobNodeSelector = requiredNodeSelector
+	}
+	if len(policy.RequiredTolerations) > 0 {
+		config.DefaultJobTolerations = append(slices.Clone(config.DefaultJobTolerations), policy.RequiredTolerations...)
+	}
+	if len(policy.ForbiddenNodeSelectorLabels) > 0 {
+		config.ForbiddenJobNodeSelectorLabels = append(slices.Clone(config.ForbiddenJobNodeSelectorLabels), policy.ForbiddenNodeSelectorLabels...)
+	}
+	if len(policy.ForbiddenTaints) > 0 {
+		config.ForbiddenJobTaints = append(slices.Clone(config.ForbiddenJobTaints), policy.ForbiddenTaints...)
+	}
+	return config
+}
+
+// defaultServiceAccount returns the service account pods that don't set one run as.
+func defaultServiceAccount(config configuration.SchedulingConfig) string {
+	if config.DefaultServiceAccount != "" {
+		return config.DefaultServiceAccount
+	}
+	return "default"
+}
+
+func stricterLimit(a, b uint) uint {
+	if a == 0 || (b != 0 && b < a) {
+		return b
+	}
+	return a
+}
+
+// validateJobSize returns a JobSizeLimitViolation describing which field of item causes it to exceed limits
+// together with an error explaining the violation, or nil if item is within limits.
+func validateJobSize(item *api.JobSubmitRequestItem, limits jobSizeLimits) (*api.JobSizeLimitViolation, error) {
+	if limits.maxContainersPerJob > 0 {
+		podSpecFields := jobPodSpecFields(item)
+		numContainers := 0
+		var largestPodSpec jobField
+		for _, field := range podSpecFields {
+			field.size = len(field.podSpec.Containers) + len(field.podSpec.InitContainers)
+			numContainers += field.size
+			if field.size > largestPodSpec.size {
+				largestPodSpec = field
+			}
+		}
+		if uint(numContainers) > limits.maxContainersPerJob {
+			path := largestPodSpec.path + ".containers"
+			if len(podSpecFields) > 1 {
+				path = "podSpecs"
+			}
+			violation := &api.JobSizeLimitViolation{
+				Field:   path,
+				JobSize: uint64(numContainers),
+				Limit:   uint64(limits.maxContainersPerJob),
+			}
+			return violation, errors.Errorf(
+				"job has %d containers but may have at most %d; the most containers are in %s",
+				violation.JobSize, violation.Limit, violation.Field,
+			)
+		}
+	}
+	if limits.maxJobSizeBytes > 0 {
+		if size := item.Size(); uint(size) > limits.maxJobSizeBytes {
+			violation := &api.JobSizeLimitViolation{
+				Field:   largestJobField(jobSubmitRequestItemFields(item)),
+				JobSize: uint64(size),
+				Limit:   uint64(limits.maxJobSizeBytes),
+			}
+			return violation, errors.Errorf(
+				"job has a size of %d bytes but may be at most %d bytes; the largest field is %s",
+				violation.JobSize, violation.Limit, violation.Field,
+			)
+		}
+	}
+	return nil, nil
+}
+
+// jobField is a field of a job submit request item, used to find which field causes a job to exceed a size limit.
+type jobField struct {
+	path string
+	size int
+	// Set for fields that are pod specs.
+	podSpec *v1.PodSpec
+	// Set for fields that are containers.
+	container *v1.Container
+}
+
+// largestJobField returns the path of the largest of the provided fields.
+// If that field is a pod spec or container, returns the path of the largest field within it.
+func largestJobField(fields []jobField) string {
+	if len(fields) == 0 {
+		return ""
+	}
+	largest := fields[0]
+	for _, field := range fields[1:] {
+		if field.size > largest.size {
+			largest = field
+		}
+	}
+	if largest.podSpec != nil {
+		return largestJobField(podSpecFields(largest.path, largest.podSpec))
+	}
+	if largest.container != nil {
+		return largestJobField(containerFields(largest.path, largest.container))
+	}
+	return largest.path
+}
+
+func jobSubmitRequestItemFields(item *api.JobSubmitRequestItem) []jobField {
+	fields := []jobField{
+		{path: "labels", size: (&api.JobSubmitRequestItem{Labels: item.Labels}).Size()},
+		{path: "annotations", size: (&api.JobSubmitRequestItem{Annotations: item.Annotations}).Size()},
+		{path: "requiredNodeLabels", size: (&api.JobSubmitRequestItem{RequiredNodeLabels: item.RequiredNodeLabels}).Size()},
+		{path: "ingress", size: (&api.JobSubmitRequestItem{Ingress: item.Ingress}).Size()},
+		{path: "services", size: (&api.JobSubmitRequestItem{Services: item.Services}).Size()},
+	}
+	for _, field := range jobPodSpecFields(item) {
+		field.size = field.podSpec.Size()
+		fields = append(fields, field)
+	}
+	return fields
+}
+
+func jobPodSpecFields(item *api.JobSubmitRequestItem) []jobField {
+	var fields []jobField
+	if item.PodSpec != nil {
+		fields = append(fields, jobField{path: "podSpec", podSpec: item.PodSpec})
+	}
+	for i, podSpec := range item.PodSpecs {
+		if podSpec != nil {
+			fields = append(fields, jobField{path: fmt.Sprintf("podSpecs[%d]", i), podSpec: podSpec})
+		}
+	}
+	return fields
+}
+
+// mainPodSpecField returns the path of the pod spec of item returned by its GetMainPodSpec method.
+func mainPodSpecField(item *api.JobSubmitRequestItem) string {
+	if item.PodSpec != nil {
+		return "podSpec"
+	}
+	return "podSpecs[0]"
+}
+
+// mainJobPodSpecField returns the path of the pod spec of the item job was created from returned by its GetMainPodSpec method.
+func mainJobPodSpecField(job *api.Job) string {
+	if job.PodSpec != nil {
+		return "podSpec"
+	}
+	return "podSpecs[0]"
+}
+
+func podSpecFields(path string, podSpec *v1.PodSpec) []jobField {
+	fields := []jobField{
+		{path: path + ".volumes", size: (&v1.PodSpec{Volumes: podSpec.Volumes}).Size()},
+		{path: path + ".affinity", size: (&v1.PodSpec{Affinity: podSpec.Affinity}).Size()},
+		{path: path + ".tolerations", size: (&v1.PodSpec{Tolerations: podSpec.Tolerations}).Size()},
+		{path: path + ".nodeSelector", size: (&v1.PodSpec{NodeSelector: podSpec.NodeSelector}).Size()},
+	}
+	for i := range podSpec.Containers {
+		container := &podSpec.Containers[i]
+		fields = append(fields, jobField{path: fmt.Sprintf("%s.containers[%d]", path, i), size: container.Size(), container: container})
+	}
+	for i := range podSpec.InitContainers {
+		container := &podSpec.InitContainers[i]
+		fields = append(fields, jobField{path: fmt.Sprintf("%s.initContainers[%d]", path, i), size: container.Size(), container: container})
+	}
+	return fields
+}
+
+func containerFields(path string, container *v1.Container) []jobField {
+	return []jobField{
+		{path: path + ".command", size: (&v1.Container{Command: container.Command}).Size()},
+		{path: path + ".args", size: (&v1.Container{Args: container.Args}).Size()},
+		{path: path + ".env", size: (&v1.Container{Env: container.Env}).Size()},
+		{path: path + ".envFrom", size: (&v1.Container{EnvFrom: container.EnvFrom}).Size()},
+		{path: path + ".volumeMounts", size: (&v1.Container{VolumeMounts: container.VolumeMounts}).Size()},
+		{path: path + ".image", size: (&v1.Container{Image: container.Image}).Size()},
+	}
+}
+
+// validateImages returns a response item for each of jobs with an image rejected by resolver, if non-nil,
+// e.g., because it's pulled from a registry that isn't allowed or doesn't exist.
+// jobs[i] must have been created from the i-th job request item of request.
+func validateImages(
+	ctx *armadacontext.Context,
+	resolver *imageresolver.Resolver,
+	request *api.JobSubmitRequest,
+	jobs []*api.Job,
+) []*api.JobSubmitResponseItem {
+	if resolver == nil {
+		return nil
+	}
+	type imageField struct {
+		path  string
+		image string
+	}
+	fieldsByJobId := make(map[string][]imageField, len(jobs))
+	var images []string
+	for i, item := range request.JobRequestItems {
+		for _, podSpecField := range jobPodSpecFields(item) {
+			for _, field := range podSpecFields(podSpecField.path, podSpecField.podSpec) {
+				if field.container != nil {
+					fieldsByJobId[jobs[i].Id] = append(fieldsByJobId[jobs[i].Id], imageField{path: field.path + ".image", image: field.container.Image})
+					images = append(images, field.container.Image)
+				}
+			}
+		}
+	}
+	rejected := resolver.CheckImages(ctx, images)
+	if len(rejected) == 0 {
+		return nil
+	}
+	var responseItems []*api.JobSubmitResponseItem
+	for _, job := range jobs {
+		for _, field := range fieldsByJobId[job.Id] {
+			if err, ok := rejected[field.image]; ok {
+				responseItems = append(responseItems, api.NewFailedJobSubmitResponseItem(job.Id, api.JobSubmitError_INVALID_POD_SPEC, field.path, err.Error()))
+				break
+			}
+		}
+	}
+	return responseItems
+}
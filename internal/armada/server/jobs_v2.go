@@ -0,0 +1,241 @@
+package server
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/gogo/status"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/armadaproject/armada/internal/armada/repository"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/armadaerrors"
+	"github.com/armadaproject/armada/pkg/api"
+	apiv2 "github.com/armadaproject/armada/pkg/api/v2"
+)
+
+const (
+	defaultListJobsPageSize = 100
+	maxListJobsPageSize     = 1000
+)
+
+// Functions clearing each field of apiv2.Job that may be included in read masks.
+var jobFieldClearersV2 = map[string]func(job *apiv2.Job){
+	"id":          func(job *apiv2.Job) { job.Id = "" },
+	"queue":       func(job *apiv2.Job) { job.Queue = "" },
+	"job_set_id":  func(job *apiv2.Job) { job.JobSetId = "" },
+	"owner":       func(job *apiv2.Job) { job.Owner = "" },
+	"priority":    func(job *apiv2.Job) { job.Priority = 0 },
+	"state":       func(job *apiv2.Job) { job.State = apiv2.JobState_JOB_STATE_UNSPECIFIED },
+	"created":     func(job *apiv2.Job) { job.Created = time.Time{} },
+	"namespace":   func(job *apiv2.Job) { job.Namespace = "" },
+	"labels":      func(job *apiv2.Job) { job.Labels = nil },
+	"annotations": func(job *apiv2.Job) { job.Annotations = nil },
+	"pod_specs":   func(job *apiv2.Job) { job.PodSpecs = nil },
+}
+
+// JobsServerV2 serves the Jobs service of version 2 of the submit API, which reads jobs of the legacy scheduler.
+type JobsServerV2 struct {
+	authorizer      ActionAuthorizer
+	queueRepository repository.QueueRepository
+	jobRepository   repository.JobRepository
+}
+
+func NewJobsServerV2(
+	authorizer ActionAuthorizer,
+	queueRepository repository.QueueRepository,
+	jobRepository repository.JobRepository,
+) *JobsServerV2 {
+	return &JobsServerV2{
+		authorizer:      authorizer,
+		queueRepository: queueRepository,
+		jobRepository:   jobRepository,
+	}
+}
+
+func (s *JobsServerV2) GetJobs(grpcCtx context.Context, req *apiv2.GetJobsRequest) (*apiv2.GetJobsResponse, error) {
+	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+	if err := validateReadMaskV2(req.ReadMask); err != nil {
+		return nil, err
+	}
+	jobResults, err := s.jobRepository.GetJobsByIds(req.JobIds)
+	if err != nil {
+		return nil, errorV2(status.Errorf(codes.Unavailable, "error getting jobs: %s", err))
+	}
+	permissionErrByQueue := make(map[string]error)
+	statesByJobSet := make(map[[2]string]map[string]apiv2.JobState)
+	results := make([]*apiv2.GetJobsResult, len(jobResults))
+	for i, jobResult := range jobResults {
+		field := fmt.Sprintf("job_ids[%d]", i)
+		if jobResult.Error != nil {
+			results[i] = &apiv2.GetJobsResult{Error: jobErrorV2(jobResult.Error, field)}
+			continue
+		}
+		job := jobResult.Job
+		permissionErr, ok := permissionErrByQueue[job.Queue]
+		if !ok {
+			permissionErr = s.authorizeWatch(ctx, job.Queue, job.JobSetId)
+			permissionErrByQueue[job.Queue] = permissionErr
+		}
+		if permissionErr != nil {
+			results[i] = &apiv2.GetJobsResult{Error: jobErrorV2(permissionErr, field)}
+			continue
+		}
+		key := [2]string{job.Queue, job.JobSetId}
+		states, ok := statesByJobSet[key]
+		if !ok {
+			states, err = s.getJobStates(job.Queue, job.JobSetId)
+			if err != nil {
+				return nil, errorV2(status.Errorf(codes.Unavailable, "error getting job states: %s", err))
+			}
+			statesByJobSet[key] = states
+		}
+		results[i] = &apiv2.GetJobsResult{Job: jobToV2(job, states[job.Id], req.ReadMask)}
+	}
+	return &apiv2.GetJobsResponse{Results: results}, nil
+}
+
+func (s *JobsServerV2) ListJobs(grpcCtx context.Context, req *apiv2.ListJobsRequest) (*apiv2.ListJobsResponse, error) {
+	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+	if err := validateJobSetV2(req.Queue, req.JobSetId); err != nil {
+		return nil, err
+	}
+	if err := validateReadMaskV2(req.ReadMask); err != nil {
+		return nil, err
+	}
+	pageSize := int(req.PageSize)
+	if pageSize < 0 {
+		return nil, invalidArgumentV2("page_size", "page size must not be negative, but is %d", req.PageSize)
+	} else if pageSize == 0 {
+		pageSize = defaultListJobsPageSize
+	} else if pageSize > maxListJobsPageSize {
+		pageSize = maxListJobsPageSize
+	}
+	lastJobId, err := base64.RawURLEncoding.DecodeString(req.PageToken)
+	if err != nil {
+		return nil, invalidArgumentV2("page_token", "invalid page token %q", req.PageToken)
+	}
+	if err := s.authorizeWatch(ctx, req.Queue, req.JobSetId); err != nil {
+		return nil, errorV2(err)
+	}
+
+	states, err := s.getJobStates(req.Queue, req.JobSetId)
+	if err != nil {
+		return nil, errorV2(status.Errorf(codes.Unavailable, "error getting job ids: %s", err))
+	}
+	// Job ids are ULIDs, such that jobs are listed in the order they were submitted.
+	jobIds := make([]string, 0, len(states))
+	for jobId := range states {
+		jobIds = append(jobIds, jobId)
+	}
+	sort.Strings(jobIds)
+	start := sort.Search(len(jobIds), func(i int) bool { return jobIds[i] > string(lastJobId) })
+	end := start + pageSize
+	if end > len(jobIds) {
+		end = len(jobIds)
+	}
+	jobs, err := s.jobRepository.GetExistingJobsByIds(jobIds[start:end])
+	if err != nil {
+		return nil, errorV2(status.Errorf(codes.Unavailable, "error getting jobs: %s", err))
+	}
+
+	res := &apiv2.ListJobsResponse{Jobs: make([]*apiv2.Job, len(jobs))}
+	for i, job := range jobs {
+		res.Jobs[i] = jobToV2(job, states[job.Id], req.ReadMask)
+	}
+	if end < len(jobIds) {
+		res.NextPageToken = base64.RawURLEncoding.EncodeToString([]byte(jobIds[end-1]))
+	}
+	return res, nil
+}
+
+func (s *JobsServerV2) authorizeWatch(ctx *armadacontext.Context, queueName string, jobSetId string) error {
+	q, err := s.queueRepository.GetQueue(queueName)
+	var expected *repository.ErrQueueNotFound
+	if errors.As(err, &expected) {
+		return status.Errorf(codes.NotFound, "queue %s not found", queueName)
+	} else if err != nil {
+		return status.Errorf(codes.Unavailable, "error getting queue %s: %s", queueName, err)
+	}
+	return validateUserHasWatchPermissions(ctx, s.authorizer, q, jobSetId)
+}
+
+// getJobStates returns the state of each active job of a job set, indexed by job id.
+func (s *JobsServerV2) getJobStates(queueName string, jobSetId string) (map[string]apiv2.JobState, error) {
+	states := make(map[string]apiv2.JobState)
+	for state, filter := range map[apiv2.JobState]*repository.JobSetFilter{
+		apiv2.JobState_JOB_STATE_QUEUED:    {IncludeQueued: true},
+		apiv2.JobState_JOB_STATE_LEASED:    {IncludeLeased: true},
+		apiv2.JobState_JOB_STATE_SUSPENDED: {IncludeSuspended: true},
+	} {
+		jobIds, err := s.jobRepository.GetJobSetJobIds(queueName, jobSetId, filter)
+		if err != nil {
+			return nil, err
+		}
+		for _, jobId := range jobIds {
+			states[jobId] = state
+		}
+	}
+	return states, nil
+}
+
+func validateReadMaskV2(mask *types.FieldMask) error {
+	for i, path := range mask.GetPaths() {
+		if _, ok := jobFieldClearersV2[path]; !ok {
+			return invalidArgumentV2(fmt.Sprintf("read_mask.paths[%d]", i), "unknown field %q of job", path)
+		}
+	}
+	return nil
+}
+
+// jobToV2 converts job into a v2 job, including only the fields in mask, or all fields if mask is empty.
+func jobToV2(job *api.Job, state apiv2.JobState, mask *types.FieldMask) *apiv2.Job {
+	podSpecs := job.PodSpecs
+	if len(podSpecs) == 0 && job.PodSpec != nil {
+		podSpecs = []*v1.PodSpec{job.PodSpec}
+	}
+	jobV2 := &apiv2.Job{
+		Id:          job.Id,
+		Queue:       job.Queue,
+		JobSetId:    job.JobSetId,
+		Owner:       job.Owner,
+		Priority:    job.Priority,
+		State:       state,
+		Created:     job.Created,
+		Namespace:   job.Namespace,
+		Labels:      job.Labels,
+		Annotations: job.Annotations,
+		PodSpecs:    podSpecs,
+	}
+	if len(mask.GetPaths()) == 0 {
+		return jobV2
+	}
+	included := make(map[string]bool, len(mask.GetPaths()))
+	for _, path := range mask.GetPaths() {
+		included[path] = true
+	}
+	for field, clear := range jobFieldClearersV2 {
+		if !included[field] {
+			clear(jobV2)
+		}
+	}
+	return jobV2
+}
+
+func jobErrorV2(err error, field string) *apiv2.Error {
+	st, ok := status.FromError(err)
+	if !ok {
+		st = status.New(armadaerrors.CodeFromError(err), err.Error())
+	}
+	return &apiv2.Error{
+		Code:    errorCodeV2(st.Code()),
+		Message: st.Message(),
+		Field:   field,
+	}
+}
@@ -0,0 +1,112 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+
+	"github.com/armadaproject/armada/internal/armada/repository"
+	"github.com/armadaproject/armada/internal/common/util"
+	apiv2 "github.com/armadaproject/armada/pkg/api/v2"
+)
+
+func TestJobsServerV2_GetJobs(t *testing.T) {
+	withSubmitServerAndRepos(func(s *SubmitServer, jobRepo repository.JobRepository, events *repository.TestEventStore) {
+		jobSetId := util.NewULID()
+		submitted, err := s.SubmitJobs(context.Background(), createJobRequest(jobSetId, 2))
+		require.NoError(t, err)
+		leasedJobId := submitted.JobResponseItems[1].JobId
+		_, err = jobRepo.TryLeaseJobs("cluster", map[string][]string{"test": {leasedJobId}})
+		require.NoError(t, err)
+
+		jobsServer := NewJobsServerV2(s.authorizer, s.queueRepository, jobRepo)
+		res, err := jobsServer.GetJobs(context.Background(), &apiv2.GetJobsRequest{
+			JobIds:   []string{submitted.JobResponseItems[0].JobId, leasedJobId, "missing"},
+			ReadMask: &types.FieldMask{Paths: []string{"id", "job_set_id", "state"}},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []*apiv2.GetJobsResult{
+			{Job: &apiv2.Job{Id: submitted.JobResponseItems[0].JobId, JobSetId: jobSetId, State: apiv2.JobState_JOB_STATE_QUEUED}},
+			{Job: &apiv2.Job{Id: leasedJobId, JobSetId: jobSetId, State: apiv2.JobState_JOB_STATE_LEASED}},
+			{Error: &apiv2.Error{
+				Code:    apiv2.ErrorCode_ERROR_CODE_NOT_FOUND,
+				Message: `resource "missing" of type "job" does not exist`,
+				Field:   "job_ids[2]",
+			}},
+		}, res.Results)
+
+		// Without a read mask, all fields are returned.
+		res, err = jobsServer.GetJobs(context.Background(), &apiv2.GetJobsRequest{JobIds: []string{leasedJobId}})
+		require.NoError(t, err)
+		require.Len(t, res.Results, 1)
+		assert.Equal(t, "test", res.Results[0].Job.Queue)
+		assert.Len(t, res.Results[0].Job.PodSpecs, 1)
+		assert.False(t, res.Results[0].Job.Created.IsZero())
+
+		_, err = jobsServer.GetJobs(context.Background(), &apiv2.GetJobsRequest{
+			JobIds:   []string{leasedJobId},
+			ReadMask: &types.FieldMask{Paths: []string{"id", "podSpecs"}},
+		})
+		assertErrorV2(t, err, codes.InvalidArgument, &apiv2.Error{
+			Code:    apiv2.ErrorCode_ERROR_CODE_INVALID_ARGUMENT,
+			Message: `unknown field "podSpecs" of job`,
+			Field:   "read_mask.paths[1]",
+		})
+	})
+}
+
+func TestJobsServerV2_ListJobs(t *testing.T) {
+	withSubmitServerAndRepos(func(s *SubmitServer, jobRepo repository.JobRepository, events *repository.TestEventStore) {
+		jobSetId := util.NewULID()
+		submitted, err := s.SubmitJobs(context.Background(), createJobRequest(jobSetId, 5))
+		require.NoError(t, err)
+		var expectedJobIds []string
+		for _, item := range submitted.JobResponseItems {
+			expectedJobIds = append(expectedJobIds, item.JobId)
+		}
+
+		jobsServer := NewJobsServerV2(s.authorizer, s.queueRepository, jobRepo)
+		var jobIds []string
+		pageToken := ""
+		numPages := 0
+		for {
+			res, err := jobsServer.ListJobs(context.Background(), &apiv2.ListJobsRequest{
+				Queue:     "test",
+				JobSetId:  jobSetId,
+				PageSize:  2,
+				PageToken: pageToken,
+				ReadMask:  &types.FieldMask{Paths: []string{"id"}},
+			})
+			require.NoError(t, err)
+			numPages++
+			for _, job := range res.Jobs {
+				assert.Equal(t, &apiv2.Job{Id: job.Id}, job)
+				jobIds = append(jobIds, job.Id)
+			}
+			if res.NextPageToken == "" {
+				break
+			}
+			pageToken = res.NextPageToken
+		}
+		assert.Equal(t, 3, numPages)
+		assert.ElementsMatch(t, expectedJobIds, jobIds)
+		assert.IsIncreasing(t, jobIds)
+
+		_, err = jobsServer.ListJobs(context.Background(), &apiv2.ListJobsRequest{Queue: "test", JobSetId: jobSetId, PageToken: "!"})
+		assertErrorV2(t, err, codes.InvalidArgument, &apiv2.Error{
+			Code:    apiv2.ErrorCode_ERROR_CODE_INVALID_ARGUMENT,
+			Message: `invalid page token "!"`,
+			Field:   "page_token",
+		})
+
+		_, err = jobsServer.ListJobs(context.Background(), &apiv2.ListJobsRequest{Queue: "missing", JobSetId: jobSetId})
+		assertErrorV2(t, err, codes.NotFound, &apiv2.Error{
+			Code:    apiv2.ErrorCode_ERROR_CODE_NOT_FOUND,
+			Message: "queue missing not found",
+		})
+	})
+}
@@ -48,6 +48,7 @@ import (
 	"github.com/armadaproject/armada/internal/scheduler/schedulerobjects"
 	"github.com/armadaproject/armada/pkg/api"
 	"github.com/armadaproject/armada/pkg/armadaevents"
+	"github.com/armadaproject/armada/pkg/client/queue"
 )
 
 type AggregatedQueueServer struct {
@@ -58,17 +59,33 @@ type AggregatedQueueServer struct {
 	usageRepository          repository.UsageRepository
 	eventStore               repository.EventStore
 	schedulingInfoRepository repository.SchedulingInfoRepository
-	decompressorPool         *pool.ObjectPool
-	clock                    clock.Clock
+	barrierRepository        repository.BarrierRepository
+	// Ownership groups of jobs, stored once for all jobs with identical groups.
+	ownershipGroupsRepository repository.OwnershipGroupsRepository
+	retryController           *RetryController
+	decompressorPool          *pool.ObjectPool
+	clock                     clock.Clock
 	// Global job scheduling rate-limiter.
 	limiter *rate.Limiter
 	// Per-queue job scheduling rate-limiters.
 	limiterByQueue map[string]*rate.Limiter
 	// For storing reports of scheduling attempts.
 	SchedulingContextRepository *scheduler.SchedulingContextRepository
+	// Stores the outcome of the most recent scheduling round of each executor, shared by all replicas,
+	// to explain why queued jobs haven't been scheduled. If nil, outcomes aren't stored.
+	SchedulingRoundRepository repository.SchedulingRoundRepository
 	// Stores the most recent NodeDb for each executor.
 	// Used to check if a job could ever be scheduled at job submit time.
 	SubmitChecker *scheduler.SubmitChecker
+	// Records lease returns of jobs in order to quarantine jobs the leases of which are returned repeatedly.
+	// If nil, jobs aren't quarantined.
+	QuarantineRepository repository.QuarantineRepository
+	// Cordoned queues and executors, to which no new leases are given. If nil, nothing is cordoned.
+	CordonRepository repository.CordonRepository
+	// Maintenance windows of queues and executors, during which no new leases are given to them. If nil, there are none.
+	MaintenanceWindowRepository repository.MaintenanceWindowRepository
+	// Records each lease request as a heartbeat of the executor making it. If nil, heartbeats aren't recorded.
+	ExecutorHealthMonitor *ExecutorHealthMonitor
 	// Necessary to generate preempted messages.
 	pulsarProducer       pulsar.Producer
 	maxPulsarMessageSize uint
@@ -83,6 +100,8 @@ func NewAggregatedQueueServer(
 	usageRepository repository.UsageRepository,
 	eventStore repository.EventStore,
 	schedulingInfoRepository repository.SchedulingInfoRepository,
+	barrierRepository repository.BarrierRepository,
+	ownershipGroupsRepository repository.OwnershipGroupsRepository,
 	pulsarProducer pulsar.Producer,
 	maxPulsarMessageSize uint,
 	executorRepository database.ExecutorRepository,
@@ -100,7 +119,7 @@ func NewAggregatedQueueServer(
 
 	decompressorPool := pool.NewObjectPool(context.Background(), pool.NewPooledObjectFactorySimple(
 		func(context.Context) (interface{}, error) {
-			return compress.NewZlibDecompressor(), nil
+			return compress.NewCodecDecompressor(), nil
 		}), &poolConfig)
 	return &AggregatedQueueServer{
 		authorizer:       authorizer,
@@ -113,13 +132,16 @@ func NewAggregatedQueueServer(
 			rate.Limit(schedulingConfig.MaximumSchedulingRate),
 			schedulingConfig.MaximumSchedulingBurst,
 		),
-		limiterByQueue:           make(map[string]*rate.Limiter),
-		schedulingInfoRepository: schedulingInfoRepository,
-		decompressorPool:         decompressorPool,
-		executorRepository:       executorRepository,
-		clock:                    clock.RealClock{},
-		pulsarProducer:           pulsarProducer,
-		maxPulsarMessageSize:     maxPulsarMessageSize,
+		limiterByQueue:            make(map[string]*rate.Limiter),
+		schedulingInfoRepository:  schedulingInfoRepository,
+		barrierRepository:         barrierRepository,
+		ownershipGroupsRepository: ownershipGroupsRepository,
+		retryController:           NewRetryController(jobRepository, eventStore),
+		decompressorPool:          decompressorPool,
+		executorRepository:        executorRepository,
+		clock:                     clock.RealClock{},
+		pulsarProducer:            pulsarProducer,
+		maxPulsarMessageSize:      maxPulsarMessageSize,
 	}
 }
 
@@ -138,6 +160,15 @@ func (q *AggregatedQueueServer) StreamingLeaseJobs(stream api.AggregatedQueue_St
 	if err != nil {
 		return errors.WithStack(err)
 	}
+	if err := authorizeExecutor(armadacontext.FromGrpcCtx(stream.Context()), req.ClusterId); err != nil {
+		return err
+	}
+
+	// Taints tolerated by the template of the pool don't prevent jobs from being scheduled on its nodes.
+	poolTemplate := q.schedulingConfig.PoolTemplates[req.Pool]
+	for i := range req.Nodes {
+		scheduler.RemovePoolTemplateTaints(&req.Nodes[i], poolTemplate)
+	}
 
 	// Old scheduler resource accounting logic.
 	err = q.usageRepository.UpdateClusterLeased(&req.ClusterLeasedReport)
@@ -226,7 +257,7 @@ func (q *AggregatedQueueServer) StreamingLeaseJobs(stream api.AggregatedQueue_St
 
 	// Create job leased events and write a leased report into Redis for all acked jobs.
 	ackedJobs := jobs[:numAcked]
-	reportJobsLeased(q.eventStore, ackedJobs, req.ClusterId)
+	reportJobsLeased(q.eventStore, ackedJobs, req.ClusterId, req.Pool)
 
 	var result *multierror.Error
 	clusterLeasedReport := scheduling.CreateClusterLeasedReport(req.ClusterLeasedReport.ClusterId, &req.ClusterLeasedReport, ackedJobs)
@@ -244,25 +275,156 @@ func (q *AggregatedQueueServer) StreamingLeaseJobs(stream api.AggregatedQueue_St
 }
 
 type SchedulerJobRepositoryAdapter struct {
-	r repository.JobRepository
+	r                 repository.JobRepository
+	barrierRepository repository.BarrierRepository
+	// If set, queued jobs of job sets with a concurrency limit are only returned while the job set is within its limit.
+	throttler *jobSetThrottler
+	// If set, jobs targeting clusters are only returned if they may run on the cluster with this id, which is in pool.
+	// Jobs preferring other clusters may run on it if none of those is among activePoolByClusterId.
+	clusterId             string
+	pool                  string
+	activePoolByClusterId map[string]string
+	// No queued jobs are returned for cordoned queues, or queues in a maintenance window, such that no new leases are given to them.
+	// Running jobs of cordoned queues are unaffected.
+	cordonedQueues map[string]bool
 }
 
 func (repo *SchedulerJobRepositoryAdapter) GetQueueJobIds(queue string) ([]string, error) {
+	if repo.cordonedQueues[queue] {
+		return nil, nil
+	}
 	return repo.r.GetQueueJobIds(queue)
 }
 
+func (repo *SchedulerJobRepositoryAdapter) GetQueueJobIdsByOwner(queue string) (map[string][]string, error) {
+	if repo.cordonedQueues[queue] {
+		return nil, nil
+	}
+	return repo.r.GetQueueJobIdsByOwner(queue)
+}
+
+// GetExistingJobsByIds omits members of barriers that are not yet released,
+// thus holding those jobs until all members of the barrier have been submitted.
+// It also omits jobs of job sets that have reached their limit on concurrently running jobs
+// and jobs held until the next submission window of their queue, as well as jobs that may not run on the cluster
+// of the adapter, if any.
 func (repo *SchedulerJobRepositoryAdapter) GetExistingJobsByIds(ids []string) ([]schedulerinterfaces.LegacySchedulerJob, error) {
 	jobs, err := repo.r.GetExistingJobsByIds(ids)
 	if err != nil {
 		return nil, err
 	}
-	rv := make([]schedulerinterfaces.LegacySchedulerJob, len(jobs))
-	for i, job := range jobs {
-		rv[i] = job
+	isReleasedByQueueAndBarrierId, err := repo.getReleasedBarriers(jobs)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	rv := make([]schedulerinterfaces.LegacySchedulerJob, 0, len(jobs))
+	for _, job := range jobs {
+		if barrierId, ok := job.Annotations[configuration.BarrierIdAnnotation]; ok {
+			if !isReleasedByQueueAndBarrierId[job.Queue][barrierId] {
+				continue
+			}
+		}
+		if isHeld(job, now) {
+			continue
+		}
+		if repo.clusterId != "" && !clusterTargetsFromAnnotations(job.Annotations).allows(repo.clusterId, repo.pool, repo.activePoolByClusterId) {
+			continue
+		}
+		if repo.throttler != nil {
+			admitted, err := repo.throttler.admit(job)
+			if err != nil {
+				return nil, err
+			}
+			if !admitted {
+				continue
+			}
+		}
+		rv = append(rv, job)
+	}
+	return rv, nil
+}
+
+// heldUntil returns the time until which job is held, or the zero time if it isn't held.
+func heldUntil(job *api.Job) time.Time {
+	value, ok := job.Annotations[configuration.HeldUntilAnnotation]
+	if !ok {
+		return time.Time{}
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		log.Warnf("ignoring invalid %s annotation %q of job %s", configuration.HeldUntilAnnotation, value, job.Id)
+		return time.Time{}
+	}
+	return t
+}
+
+// isHeld returns true if job is held at time now.
+func isHeld(job *api.Job, now time.Time) bool {
+	return heldUntil(job).After(now)
+}
+
+func (repo *SchedulerJobRepositoryAdapter) getReleasedBarriers(jobs []*api.Job) (map[string]map[string]bool, error) {
+	barrierIdsByQueue := make(map[string][]string)
+	for _, job := range jobs {
+		if barrierId, ok := job.Annotations[configuration.BarrierIdAnnotation]; ok {
+			barrierIdsByQueue[job.Queue] = append(barrierIdsByQueue[job.Queue], barrierId)
+		}
+	}
+	if len(barrierIdsByQueue) == 0 {
+		return nil, nil
+	}
+	rv := make(map[string]map[string]bool, len(barrierIdsByQueue))
+	for queue, barrierIds := range barrierIdsByQueue {
+		isReleasedByBarrierId, err := repo.barrierRepository.GetReleasedBarriers(queue, armadaslices.Unique(barrierIds))
+		if err != nil {
+			return nil, err
+		}
+		rv[queue] = isReleasedByBarrierId
 	}
 	return rv, nil
 }
 
+// getCordons returns the queues no new leases are given to, since they're cordoned or in a maintenance window.
+// If no new leases are given to the executor with the given id at all, a non-empty reason is returned.
+func (q *AggregatedQueueServer) getCordons(executorId string) (string, map[string]bool, error) {
+	cordonedQueues := make(map[string]bool)
+	if q.CordonRepository != nil {
+		cordonedExecutors, err := q.CordonRepository.GetCordonedExecutors()
+		if err != nil {
+			return "", nil, err
+		}
+		if cordon, ok := cordonedExecutors[executorId]; ok {
+			return fmt.Sprintf("executor cordoned by %s: %s", cordon.CordonedBy, cordon.Reason), nil, nil
+		}
+		cordons, err := q.CordonRepository.GetCordonedQueues()
+		if err != nil {
+			return "", nil, err
+		}
+		for queue := range cordons {
+			cordonedQueues[queue] = true
+		}
+	}
+	if q.MaintenanceWindowRepository != nil {
+		windows, err := q.MaintenanceWindowRepository.GetMaintenanceWindows()
+		if err != nil {
+			return "", nil, err
+		}
+		now := q.clock.Now()
+		for _, window := range windows {
+			if !maintenanceWindowActive(window, now) {
+				continue
+			}
+			if window.Executor == executorId {
+				return fmt.Sprintf("executor in maintenance window %s until %s: %s", window.Id, window.End, window.Reason), nil, nil
+			} else if window.Queue != "" {
+				cordonedQueues[window.Queue] = true
+			}
+		}
+	}
+	return "", cordonedQueues, nil
+}
+
 func (q *AggregatedQueueServer) getJobs(ctx *armadacontext.Context, req *api.StreamingLeaseRequest) ([]*api.Job, error) {
 	ctx = armadacontext.
 		WithLogFields(ctx, map[string]interface{}{
@@ -270,6 +432,13 @@ func (q *AggregatedQueueServer) getJobs(ctx *armadacontext.Context, req *api.Str
 			"pool":    req.Pool,
 		})
 
+	if q.ExecutorHealthMonitor != nil {
+		if err := q.ExecutorHealthMonitor.RecordHeartbeat(req); err != nil {
+			// This is not fatal; we can still schedule if it doesn't happen.
+			log.WithError(err).Warnf("could not record heartbeat of cluster %s", req.ClusterId)
+		}
+	}
+
 	// Get the total capacity available across all clusters.
 	usageReports, err := q.usageRepository.GetClusterUsageReports()
 	if err != nil {
@@ -277,7 +446,9 @@ func (q *AggregatedQueueServer) getJobs(ctx *armadacontext.Context, req *api.Str
 	}
 	activeClusterReports := scheduling.FilterActiveClusters(usageReports)
 	totalCapacity := make(armadaresource.ComputeResources)
+	activePoolByClusterId := make(map[string]string, len(activeClusterReports))
 	for _, clusterReport := range activeClusterReports {
+		activePoolByClusterId[clusterReport.ClusterId] = clusterReport.Pool
 		if clusterReport.Pool == req.Pool {
 			totalCapacity.Add(util.GetClusterAvailableCapacity(clusterReport))
 		}
@@ -299,10 +470,8 @@ func (q *AggregatedQueueServer) getJobs(ctx *armadacontext.Context, req *api.Str
 	if err != nil {
 		return nil, err
 	}
-	priorityFactorByQueue := make(map[string]float64, len(queues))
 	apiQueues := make([]*api.Queue, len(queues))
 	for i, queue := range queues {
-		priorityFactorByQueue[queue.Name] = float64(queue.PriorityFactor)
 		apiQueues[i] = &api.Queue{Name: queue.Name}
 	}
 
@@ -316,6 +485,11 @@ func (q *AggregatedQueueServer) getJobs(ctx *armadacontext.Context, req *api.Str
 		isActiveByQueueName[queue.Name] = true
 	}
 
+	// Fair share is divided hierarchically among active queues.
+	priorityFactorByQueue := queue.EffectivePriorityFactors(queues, func(queueName string) bool {
+		return isActiveByQueueName[queueName]
+	})
+
 	// Nodes to be considered by the scheduler.
 	lastSeen := q.clock.Now()
 
@@ -470,6 +644,13 @@ func (q *AggregatedQueueServer) getJobs(ctx *armadacontext.Context, req *api.Str
 		log.Infof("skipping scheduling on %s - scheduling disabled", req.ClusterId)
 		return make([]*api.Job, 0), nil
 	}
+	executorCordonReason, cordonedQueues, err := q.getCordons(req.ClusterId)
+	if err != nil {
+		return nil, err
+	} else if executorCordonReason != "" {
+		log.Infof("skipping scheduling on %s - %s", req.ClusterId, executorCordonReason)
+		return make([]*api.Job, 0), nil
+	}
 
 	// Give Schedule() a 3 second shorter deadline than ctx to give it a chance to finish up before ctx deadline.
 	if deadline, ok := ctx.Deadline(); ok {
@@ -538,7 +719,13 @@ func (q *AggregatedQueueServer) getJobs(ctx *armadacontext.Context, req *api.Str
 		q.schedulingConfig.Preemption.NodeOversubscriptionEvictionProbability,
 		q.schedulingConfig.Preemption.ProtectedFractionOfFairShare,
 		&SchedulerJobRepositoryAdapter{
-			r: q.jobRepository,
+			r:                     q.jobRepository,
+			barrierRepository:     q.barrierRepository,
+			throttler:             newJobSetThrottler(q.jobRepository),
+			clusterId:             req.ClusterId,
+			pool:                  req.Pool,
+			activePoolByClusterId: activePoolByClusterId,
+			cordonedQueues:        cordonedQueues,
 		},
 		nodeDb,
 		nodeIdByJobId,
@@ -554,6 +741,9 @@ func (q *AggregatedQueueServer) getJobs(ctx *armadacontext.Context, req *api.Str
 	if q.schedulingConfig.EnableNewPreemptionStrategy {
 		sch.EnableNewPreemptionStrategy()
 	}
+	if q.schedulingConfig.UserFairShare.Enabled {
+		sch.EnableUserFairShare(q.schedulingConfig.UserFairShare)
+	}
 	log.Infof(
 		"starting scheduling with total resources %s",
 		schedulerobjects.ResourceList{Resources: totalCapacity}.CompactString(),
@@ -571,17 +761,26 @@ func (q *AggregatedQueueServer) getJobs(ctx *armadacontext.Context, req *api.Str
 			logging.WithStacktrace(ctx, err).Error("failed to store scheduling context")
 		}
 	}
+	if q.SchedulingRoundRepository != nil {
+		if err := q.SchedulingRoundRepository.StoreSchedulingRound(schedulingRoundFromContext(sctx)); err != nil {
+			logging.WithStacktrace(ctx, err).Error("failed to store scheduling round")
+		}
+	}
 
 	// Publish preempted + failed messages.
-	sequences := make([]*armadaevents.EventSequence, len(result.PreemptedJobs))
-	for i, jctx := range result.PreemptedJobs {
+	// Jobs submitted as preemptible are instead returned to the queue below.
+	sequences := make([]*armadaevents.EventSequence, 0, len(result.PreemptedJobs))
+	for _, jctx := range result.PreemptedJobs {
 		job := jctx.Job
+		if scheduler.IsPreemptibleFromAnnotations(job.GetAnnotations()) {
+			continue
+		}
 		jobId, err := armadaevents.ProtoUuidFromUlidString(job.GetId())
 		if err != nil {
 			return nil, err
 		}
 		created := q.clock.Now()
-		sequences[i] = &armadaevents.EventSequence{
+		sequences = append(sequences, &armadaevents.EventSequence{
 			Queue:      job.GetQueue(),
 			JobSetName: job.GetJobSet(),
 			Events: []*armadaevents.EventSequence_Event{
@@ -612,7 +811,7 @@ func (q *AggregatedQueueServer) getJobs(ctx *armadacontext.Context, req *api.Str
 					},
 				},
 			},
-		}
+		})
 	}
 	err = pulsarutils.CompactAndPublishSequences(ctx, sequences, q.pulsarProducer, q.maxPulsarMessageSize, schedulers.All)
 	if err != nil {
@@ -620,12 +819,15 @@ func (q *AggregatedQueueServer) getJobs(ctx *armadacontext.Context, req *api.Str
 	}
 
 	preemptedApiJobsById := make(map[string]*api.Job)
+	var evictedApiJobs []*api.Job
 	for _, jctx := range result.PreemptedJobs {
 		job := jctx.Job
-		if apiJob, ok := job.(*api.Job); ok {
-			preemptedApiJobsById[job.GetId()] = apiJob
-		} else {
+		if apiJob, ok := job.(*api.Job); !ok {
 			log.Errorf("failed to convert job %s to api job", job.GetId())
+		} else if scheduler.IsPreemptibleFromAnnotations(apiJob.Annotations) {
+			evictedApiJobs = append(evictedApiJobs, apiJob)
+		} else {
+			preemptedApiJobsById[job.GetId()] = apiJob
 		}
 	}
 	scheduledApiJobsById := make(map[string]*api.Job)
@@ -659,6 +861,12 @@ func (q *AggregatedQueueServer) getJobs(ctx *armadacontext.Context, req *api.Str
 		}
 	}
 
+	// Return jobs submitted as preemptible to the queue, such that they're re-scheduled once there's capacity.
+	// Their executor kills their pods once it fails to renew their leases.
+	if len(evictedApiJobs) > 0 {
+		q.requeueEvictedJobs(ctx, req.ClusterId, evictedApiJobs)
+	}
+
 	// Create leases by writing into Redis.
 	leasedJobIdsByQueue, err := q.jobRepository.TryLeaseJobs(
 		req.ClusterId,
@@ -751,6 +959,16 @@ func (q *AggregatedQueueServer) getJobs(ctx *armadacontext.Context, req *api.Str
 		}
 	}
 
+	// Apply the template of the pool to scheduled jobs.
+	if poolTemplate, ok := q.schedulingConfig.PoolTemplates[req.Pool]; ok {
+		for _, apiJob := range successfullyLeasedApiJobs {
+			if apiJob == nil {
+				continue
+			}
+			apiJob.Labels = scheduler.ApplyPoolTemplate(poolTemplate, apiJob.GetMainPodSpec(), apiJob.Labels)
+		}
+	}
+
 	// Optionally set node names on scheduled jobs.
 	if q.schedulingConfig.Preemption.SetNodeName {
 		for _, apiJob := range successfullyLeasedApiJobs {
@@ -829,6 +1047,25 @@ func updateAllocatedByQueueAndPriorityClass[T interfaces.LegacySchedulerJob](
 	return allocatedByQueueAndPriorityClass
 }
 
+// requeueEvictedJobs returns jobs submitted as preemptible, which were evicted from clusterId to make room for
+// higher-priority jobs, to the queue and reports them as evicted. Errors are logged, since the jobs were already evicted.
+func (q *AggregatedQueueServer) requeueEvictedJobs(ctx *armadacontext.Context, clusterId string, jobs []*api.Job) {
+	var requeuedJobs []*api.Job
+	for _, job := range jobs {
+		// The lease is only returned if the job is still leased to clusterId.
+		returnedJob, err := q.jobRepository.ReturnLease(clusterId, job.Id)
+		if err != nil {
+			logging.WithStacktrace(ctx, err).Errorf("failed to return evicted job %s to the queue", job.Id)
+		} else if returnedJob != nil {
+			requeuedJobs = append(requeuedJobs, job)
+		}
+	}
+	log.Infof("returned evicted jobs to the queue: %v", util.Map(requeuedJobs, func(job *api.Job) string { return job.Id }))
+	if err := reportJobsEvictedForCapacity(q.eventStore, requeuedJobs, clusterId); err != nil {
+		logging.WithStacktrace(ctx, err).Error("failed to report evicted jobs")
+	}
+}
+
 func (q *AggregatedQueueServer) aggregateAllocationAcrossExecutor(reportsByExecutor map[string]*schedulerobjects.ClusterResourceUsageReport, pool string) map[string]schedulerobjects.QuantityByTAndResourceType[string] {
 	now := q.clock.Now()
 	allocatedByQueueAndPriorityClass := make(map[string]schedulerobjects.QuantityByTAndResourceType[string])
@@ -860,20 +1097,49 @@ func (q *AggregatedQueueServer) aggregateAllocationAcrossExecutor(reportsByExecu
 	return allocatedByQueueAndPriorityClass
 }
 
+// decompressJobOwnershipGroups sets the ownership groups of jobs. The groups of jobs stored with compressed groups
+// are decompressed. The groups referenced by any other jobs are read from the ownershipGroupsRepository and
+// decompressed once per hash.
 func (q *AggregatedQueueServer) decompressJobOwnershipGroups(jobs []*api.Job) error {
+	var hashes []string
+	jobsByHash := make(map[string][]*api.Job)
 	for _, j := range jobs {
-		// No need to decompress, if compressed groups not set
-		if len(j.CompressedQueueOwnershipUserGroups) == 0 {
+		// Jobs submitted before groups were stored once for all jobs with identical groups have their groups stored with them.
+		if len(j.CompressedQueueOwnershipUserGroups) > 0 {
+			groups, err := q.decompressOwnershipGroups(j.CompressedQueueOwnershipUserGroups)
+			if err != nil {
+				return fmt.Errorf("failed to decompress ownership groups for job %s because %s", j.Id, err)
+			}
+			j.QueueOwnershipUserGroups = groups
+			j.CompressedQueueOwnershipUserGroups = nil
 			continue
 		}
-		groups, err := q.decompressOwnershipGroups(j.CompressedQueueOwnershipUserGroups)
-		if err != nil {
-			return fmt.Errorf("failed to decompress ownership groups for job %s because %s", j.Id, err)
+		if j.OwnershipGroupsHash == "" {
+			continue
+		}
+		if _, ok := jobsByHash[j.OwnershipGroupsHash]; !ok {
+			hashes = append(hashes, j.OwnershipGroupsHash)
 		}
-		j.QueueOwnershipUserGroups = groups
-		j.CompressedQueueOwnershipUserGroups = nil
+		jobsByHash[j.OwnershipGroupsHash] = append(jobsByHash[j.OwnershipGroupsHash], j)
+	}
+	if len(hashes) == 0 {
+		return nil
 	}
 
+	compressedGroupsByHash, err := q.ownershipGroupsRepository.GetOwnershipGroups(hashes)
+	if err != nil {
+		return err
+	}
+	for hash, compressedGroups := range compressedGroupsByHash {
+		groups, err := q.decompressOwnershipGroups(compressedGroups)
+		if err != nil {
+			return fmt.Errorf("failed to decompress ownership groups with hash %s because %s", hash, err)
+		}
+		// Jobs referencing the same groups share them; they're never modified.
+		for _, j := range jobsByHash[hash] {
+			j.QueueOwnershipUserGroups = groups
+		}
+	}
 	return nil
 }
 
@@ -898,6 +1164,9 @@ func (q *AggregatedQueueServer) RenewLease(grpcCtx context.Context, request *api
 	if err := q.authorizer.AuthorizeAction(ctx, permissions.ExecuteJobs); err != nil {
 		return nil, status.Errorf(codes.PermissionDenied, err.Error())
 	}
+	if err := authorizeExecutor(ctx, request.ClusterId); err != nil {
+		return nil, status.Errorf(codes.PermissionDenied, err.Error())
+	}
 	renewed, e := q.jobRepository.RenewLease(request.ClusterId, request.Ids)
 	return &api.IdList{Ids: renewed}, e
 }
@@ -907,6 +1176,13 @@ func (q *AggregatedQueueServer) ReturnLease(grpcCtx context.Context, request *ap
 	if err := q.authorizer.AuthorizeAction(ctx, permissions.ExecuteJobs); err != nil {
 		return nil, status.Errorf(codes.PermissionDenied, err.Error())
 	}
+	if err := authorizeExecutor(ctx, request.ClusterId); err != nil {
+		return nil, status.Errorf(codes.PermissionDenied, err.Error())
+	}
+	// Returning the lease may fail the job and delete it, so it must be leased to the executor returning it.
+	if err := q.authorizeExecutorJobs(ctx, []string{request.JobId}); err != nil {
+		return nil, err
+	}
 
 	// Check how many times the same job has been retried already
 	retries, err := q.jobRepository.GetNumberOfRetryAttempts(request.JobId)
@@ -938,6 +1214,13 @@ func (q *AggregatedQueueServer) ReturnLease(grpcCtx context.Context, request *ap
 		return &prototypes.Empty{}, nil
 	}
 
+	quarantined, err := q.quarantineIfReturnedRepeatedly(ctx, request)
+	if err != nil {
+		return nil, err
+	} else if quarantined {
+		return &prototypes.Empty{}, nil
+	}
+
 	if request.AvoidNodeLabels != nil && len(request.AvoidNodeLabels.Entries) > 0 {
 		err = q.addAvoidNodeAffinity(request.JobId, request.AvoidNodeLabels, authorization.GetPrincipal(ctx).GetName())
 		if err != nil {
@@ -956,6 +1239,63 @@ func (q *AggregatedQueueServer) ReturnLease(grpcCtx context.Context, request *ap
 	return &prototypes.Empty{}, nil
 }
 
+// quarantineIfReturnedRepeatedly records the lease return of request and quarantines the job if its lease has been
+// returned more often than configured, i.e., fails it and stores a diagnostic record of it, rather than retrying it
+// indefinitely. Returns true if the job was quarantined.
+func (q *AggregatedQueueServer) quarantineIfReturnedRepeatedly(ctx *armadacontext.Context, request *api.ReturnLeaseRequest) (bool, error) {
+	config := q.schedulingConfig.Quarantine
+	if q.QuarantineRepository == nil || config.MaxLeaseReturns == 0 {
+		return false, nil
+	}
+	job, err := q.getJobById(request.JobId)
+	if err != nil {
+		return false, err
+	}
+	if job == nil {
+		// Job already deleted; nothing to do.
+		return false, nil
+	}
+
+	now := q.clock.Now()
+	leaseReturns, recentLeaseReturns, err := q.QuarantineRepository.RecordLeaseReturn(
+		leaseReturnedEvent(job, request, now),
+		config.MaxRecordedLeaseReturns,
+		config.RecordRetention,
+	)
+	if err != nil {
+		// Failing to record the lease return only delays quarantining the job, so the lease is returned regardless.
+		ctx.WithError(err).Warnf("failed to record lease return of job %s", job.Id)
+		return false, nil
+	}
+	if uint(leaseReturns) <= config.MaxLeaseReturns {
+		return false, nil
+	}
+
+	record := &api.QuarantinedJob{
+		JobId:              job.Id,
+		Queue:              job.Queue,
+		JobSetId:           job.JobSetId,
+		Quarantined:        now,
+		LeaseReturns:       uint32(leaseReturns),
+		RecentLeaseReturns: recentLeaseReturns,
+	}
+	ctx.Warnf("quarantining job %s after its lease was returned %d times", job.Id, leaseReturns)
+
+	// The job is failed before the record is stored, such that no job is recorded as quarantined without having failed.
+	failureReason := fmt.Sprintf("Quarantined after its lease was returned %d times, most recently because: %s", leaseReturns, request.Reason)
+	if err := reportFailed(q.eventStore, request.ClusterId, []*jobFailure{{job: job, reason: failureReason}}); err != nil {
+		return false, err
+	}
+	if err := q.QuarantineRepository.QuarantineJob(record, config.RecordRetention); err != nil {
+		// The record is only diagnostic, and failing the call would fail the job again when the lease is returned again.
+		ctx.WithError(err).Errorf("failed to store quarantine record of job %s", job.Id)
+	}
+	if _, err := q.ReportDone(ctx, &api.IdList{Ids: []string{job.Id}}); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
 func (q *AggregatedQueueServer) addAvoidNodeAffinity(
 	jobId string,
 	labels *api.OrderedStringMap,
@@ -966,13 +1306,16 @@ func (q *AggregatedQueueServer) addAvoidNodeAffinity(
 		return fmt.Errorf("[AggregatedQueueServer.addAvoidNodeAffinity] error getting scheduling information: %w", err)
 	}
 
+	// Set by the most recent call to the mutator, which may be called several times.
+	changed := false
 	res, err := q.jobRepository.UpdateJobs([]string{jobId}, func(jobs []*api.Job) {
+		changed = false
 		if len(jobs) < 1 {
 			log.Warnf("[AggregatedQueueServer.addAvoidNodeAffinity] job %s not found", jobId)
 			return
 		}
 
-		changed := addAvoidNodeAffinity(jobs[0], labels, func(jobsToValidate []*api.Job) error {
+		changed = addAvoidNodeAffinity(jobs[0], labels, func(jobsToValidate []*api.Job) error {
 			if ok, responseItems, err := validateJobsCanBeScheduled(jobsToValidate, allClusterSchedulingInfo); !ok {
 				if err != nil {
 					return errors.WithMessagef(err, "can't schedule %d (out of %d submitted) job(s)", len(responseItems), len(jobsToValidate))
@@ -982,13 +1325,6 @@ func (q *AggregatedQueueServer) addAvoidNodeAffinity(
 			}
 			return nil
 		})
-
-		if changed {
-			err := reportJobsUpdated(q.eventStore, principalName, jobs)
-			if err != nil {
-				log.Warnf("[AggregatedQueueServer.addAvoidNodeAffinity] error reporting job updated event for job %s: %s", jobId, err)
-			}
-		}
 	})
 	if err != nil {
 		return fmt.Errorf("[AggregatedQueueServer.addAvoidNodeAffinity] error updating job with ID %s: %s", jobId, err)
@@ -999,6 +1335,14 @@ func (q *AggregatedQueueServer) addAvoidNodeAffinity(
 		return fmt.Errorf("[AggregatedQueueServer.addAvoidNodeAffinity] error: %w", errJobNotFound)
 	}
 
+	// Only report the update once it's been written.
+	if res[0].Error == nil && changed {
+		err := reportJobsUpdated(q.eventStore, principalName, []*api.Job{res[0].Job})
+		if err != nil {
+			log.Warnf("[AggregatedQueueServer.addAvoidNodeAffinity] error reporting job updated event for job %s: %s", jobId, err)
+		}
+	}
+
 	return res[0].Error
 }
 
@@ -1011,12 +1355,21 @@ func (q *AggregatedQueueServer) ReportDone(grpcCtx context.Context, idList *api.
 	if e != nil {
 		return nil, status.Errorf(codes.Internal, e.Error())
 	}
+	if err := q.authorizeExecutorJobs(ctx, util.Map(jobs, func(job *api.Job) string { return job.Id })); err != nil {
+		return nil, err
+	}
+	// Jobs with a failed run to be retried are returned to the queue instead of being deleted.
+	jobs, requeuedIds, err := q.retryController.RequeueRetriedJobs(jobs)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "[ReportDone] error requeuing jobs to be retried: %s", err)
+	}
 	deletionResult, err := q.jobRepository.DeleteJobs(jobs)
 	if err != nil {
 		return nil, fmt.Errorf("[AggregatedQueueServer.ReportDone] error deleting jobs: %s", err)
 	}
 
-	cleanedIds := make([]string, 0, len(deletionResult))
+	cleanedIds := make([]string, 0, len(requeuedIds)+len(deletionResult))
+	cleanedIds = append(cleanedIds, requeuedIds...)
 	var returnedError error = nil
 	for job, err := range deletionResult {
 		if err != nil {
@@ -1028,6 +1381,24 @@ func (q *AggregatedQueueServer) ReportDone(grpcCtx context.Context, idList *api.
 	return &api.IdList{Ids: cleanedIds}, returnedError
 }
 
+// authorizeExecutorJobs returns a PermissionDenied error if the principal of ctx is an executor credential and any of
+// the jobs with jobIds isn't leased to its executor, since executors may only act on the jobs leased to them.
+func (q *AggregatedQueueServer) authorizeExecutorJobs(ctx *armadacontext.Context, jobIds []string) error {
+	if _, ok := authorization.GetPrincipal(ctx).(*authorization.ExecutorPrincipal); !ok || len(jobIds) == 0 {
+		return nil
+	}
+	clusterIds, err := q.jobRepository.GetLeasedJobClusterIds(jobIds)
+	if err != nil {
+		return status.Errorf(codes.Unavailable, "error getting clusters jobs are leased to: %s", err)
+	}
+	for _, jobId := range jobIds {
+		if err := authorizeExecutor(ctx, clusterIds[jobId]); err != nil {
+			return status.Errorf(codes.PermissionDenied, "job %s isn't leased to the executor: %s", jobId, err)
+		}
+	}
+	return nil
+}
+
 func (q *AggregatedQueueServer) reportLeaseReturned(ctx *armadacontext.Context, leaseReturnRequest *api.ReturnLeaseRequest) error {
 	job, err := q.getJobById(leaseReturnRequest.JobId)
 	if err != nil {
@@ -1073,3 +1444,23 @@ func (q *AggregatedQueueServer) getJobById(jobId string) (*api.Job, error) {
 	}
 	return jobs[0], err
 }
+
+// schedulingRoundFromContext returns the outcome of the scheduling round sctx is the context of.
+func schedulingRoundFromContext(sctx *schedulercontext.SchedulingContext) *repository.SchedulingRound {
+	round := &repository.SchedulingRound{
+		ExecutorId:           sctx.ExecutorId,
+		Started:              sctx.Started,
+		TerminationReason:    sctx.TerminationReason,
+		UnschedulableReasons: make(map[string]string),
+	}
+	for queue, qctx := range sctx.QueueSchedulingContexts {
+		round.Queues = append(round.Queues, queue)
+		for jobId := range qctx.SuccessfulJobSchedulingContexts {
+			round.ScheduledJobIds = append(round.ScheduledJobIds, jobId)
+		}
+		for jobId, jctx := range qctx.UnsuccessfulJobSchedulingContexts {
+			round.UnschedulableReasons[jobId] = jctx.UnschedulableReason
+		}
+	}
+	return round
+}
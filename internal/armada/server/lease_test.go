@@ -5,11 +5,18 @@ import (
 	"testing"
 	"time"
 
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/armadaproject/armada/internal/armada/configuration"
 	"github.com/armadaproject/armada/internal/armada/repository"
 	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/auth/authorization"
+	"github.com/armadaproject/armada/internal/common/compress"
+	armadaresource "github.com/armadaproject/armada/internal/common/resource"
 	"github.com/armadaproject/armada/internal/scheduler/schedulerobjects"
 	"github.com/armadaproject/armada/pkg/api"
 	"github.com/armadaproject/armada/pkg/client/queue"
@@ -36,6 +43,37 @@ func TestAggregatedQueueServer_ReturnLeaseCallsRepositoryMethod(t *testing.T) {
 	assert.Equal(t, jobId, mockJobRepository.returnLeaseArg2)
 }
 
+func TestAggregatedQueueServer_ExecutorsMayOnlyActOnJobsLeasedToThem(t *testing.T) {
+	mockJobRepository, _, aggregatedQueueClient := makeAggregatedQueueServerWithTestDoubles(5)
+	ctx := armadacontext.FromGrpcCtx(
+		authorization.WithPrincipal(armadacontext.Background(), authorization.NewExecutorPrincipal("cred-1", "cluster-1", nil)),
+	)
+
+	_, err := mockJobRepository.AddJobs([]*api.Job{{Id: "own"}, {Id: "other"}, {Id: "queued"}})
+	require.NoError(t, err)
+	mockJobRepository.leasedClusterIds["own"] = "cluster-1"
+	mockJobRepository.leasedClusterIds["other"] = "cluster-2"
+
+	for _, jobId := range []string{"other", "queued"} {
+		_, err = aggregatedQueueClient.ReportDone(ctx, &api.IdList{Ids: []string{"own", jobId}})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		_, err = aggregatedQueueClient.ReturnLease(ctx, &api.ReturnLeaseRequest{ClusterId: "cluster-1", JobId: jobId})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	}
+	assert.Equal(t, 0, mockJobRepository.deleteJobsCalls)
+	assert.Equal(t, 0, mockJobRepository.returnLeaseCalls)
+
+	_, err = aggregatedQueueClient.ReturnLease(ctx, &api.ReturnLeaseRequest{ClusterId: "cluster-1", JobId: "own"})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, mockJobRepository.returnLeaseCalls)
+
+	_, err = aggregatedQueueClient.ReportDone(ctx, &api.IdList{Ids: []string{"own"}})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, mockJobRepository.deleteJobsCalls)
+	assert.Equal(t, []*api.Job{{Id: "own"}}, mockJobRepository.deleteJobsArg)
+}
+
 func TestAggregatedQueueServer_ReturnLeaseCallsSendsJobLeaseReturnedEvent(t *testing.T) {
 	mockJobRepository, fakeEventStore, aggregatedQueueClient := makeAggregatedQueueServerWithTestDoubles(5)
 
@@ -191,6 +229,146 @@ func TestAggregatedQueueServer_ReturningLease_IncrementsRetries(t *testing.T) {
 	assert.Equal(t, 1, numberOfRetries)
 }
 
+func TestAggregatedQueueServer_ReturningLeaseRepeatedlyQuarantinesJob(t *testing.T) {
+	mockJobRepository, fakeEventStore, aggregatedQueueServer := makeAggregatedQueueServerWithTestDoubles(uint(5))
+	aggregatedQueueServer.schedulingConfig.Quarantine = configuration.QuarantineConfig{
+		MaxLeaseReturns:         3,
+		MaxRecordedLeaseReturns: 2,
+		RecordRetention:         time.Hour,
+	}
+	quarantineRepository := newFakeQuarantineRepository()
+	aggregatedQueueServer.QuarantineRepository = quarantineRepository
+
+	job := &api.Job{Id: "job-id-1", JobSetId: "job-set-id-1", Queue: "queue-1"}
+	_, err := mockJobRepository.AddJobs([]*api.Job{job})
+	require.NoError(t, err)
+
+	// Lease returns of jobs that didn't run don't count as retries, but count towards quarantining them.
+	for i := 0; i < 3; i++ {
+		_, err := aggregatedQueueServer.ReturnLease(armadacontext.TODO(), &api.ReturnLeaseRequest{
+			ClusterId: "cluster-1",
+			JobId:     job.Id,
+			Reason:    fmt.Sprintf("pod creation failed %d", i),
+		})
+		require.NoError(t, err)
+		assert.Contains(t, mockJobRepository.jobs, job.Id)
+	}
+	_, err = quarantineRepository.GetQuarantinedJob(job.Id)
+	assert.Error(t, err)
+
+	fakeEventStore.events = []*api.EventMessage{}
+	_, err = aggregatedQueueServer.ReturnLease(armadacontext.TODO(), &api.ReturnLeaseRequest{
+		ClusterId: "cluster-1",
+		JobId:     job.Id,
+		Reason:    "pod creation failed 3",
+	})
+	require.NoError(t, err)
+	assert.NotContains(t, mockJobRepository.jobs, job.Id)
+
+	require.Len(t, fakeEventStore.events, 2)
+	assert.NotNil(t, fakeEventStore.events[0].GetLeaseReturned())
+	failedEvent := fakeEventStore.events[1].GetFailed()
+	require.NotNil(t, failedEvent)
+	assert.Equal(t, "Quarantined after its lease was returned 4 times, most recently because: pod creation failed 3", failedEvent.Reason)
+
+	record, err := quarantineRepository.GetQuarantinedJob(job.Id)
+	require.NoError(t, err)
+	assert.Equal(t, job.Queue, record.Queue)
+	assert.Equal(t, job.JobSetId, record.JobSetId)
+	assert.Equal(t, uint32(4), record.LeaseReturns)
+	require.Len(t, record.RecentLeaseReturns, 2)
+	assert.Equal(t, "pod creation failed 2", record.RecentLeaseReturns[0].Reason)
+	assert.Equal(t, "pod creation failed 3", record.RecentLeaseReturns[1].Reason)
+}
+
+func TestAggregatedQueueServer_QuarantineIsNotRecordedIfFailingJobFails(t *testing.T) {
+	mockJobRepository, _, aggregatedQueueServer := makeAggregatedQueueServerWithTestDoubles(uint(5))
+	aggregatedQueueServer.schedulingConfig.Quarantine = configuration.QuarantineConfig{
+		MaxLeaseReturns:         1,
+		MaxRecordedLeaseReturns: 2,
+		RecordRetention:         time.Hour,
+	}
+	quarantineRepository := newFakeQuarantineRepository()
+	aggregatedQueueServer.QuarantineRepository = quarantineRepository
+	job := &api.Job{Id: "job-id-1", JobSetId: "job-set-id-1", Queue: "queue-1"}
+	_, err := mockJobRepository.AddJobs([]*api.Job{job})
+	require.NoError(t, err)
+	_, err = aggregatedQueueServer.ReturnLease(armadacontext.TODO(), &api.ReturnLeaseRequest{ClusterId: "cluster-1", JobId: job.Id})
+	require.NoError(t, err)
+
+	aggregatedQueueServer.eventStore = &failingEventStore{}
+	_, err = aggregatedQueueServer.ReturnLease(armadacontext.TODO(), &api.ReturnLeaseRequest{ClusterId: "cluster-1", JobId: job.Id})
+	assert.Error(t, err)
+	_, err = quarantineRepository.GetQuarantinedJob(job.Id)
+	assert.Error(t, err)
+	assert.Contains(t, mockJobRepository.jobs, job.Id)
+}
+
+func TestAggregatedQueueServer_RequeueEvictedJobs(t *testing.T) {
+	mockJobRepository, fakeEventStore, aggregatedQueueServer := makeAggregatedQueueServerWithTestDoubles(5)
+	job := &api.Job{
+		Id:          "job-id-1",
+		JobSetId:    "job-set-id-1",
+		Queue:       "queue-1",
+		Annotations: map[string]string{configuration.PreemptibleAnnotation: "true"},
+	}
+	_, err := mockJobRepository.AddJobs([]*api.Job{job})
+	require.NoError(t, err)
+
+	aggregatedQueueServer.requeueEvictedJobs(armadacontext.TODO(), "cluster-1", []*api.Job{job})
+
+	// Evicted jobs are returned to the queue rather than deleted.
+	assert.Equal(t, 1, mockJobRepository.returnLeaseCalls)
+	assert.Equal(t, "cluster-1", mockJobRepository.returnLeaseArg1)
+	assert.Equal(t, job.Id, mockJobRepository.returnLeaseArg2)
+	assert.Contains(t, mockJobRepository.jobs, job.Id)
+
+	require.Len(t, fakeEventStore.events, 2)
+	evicted := fakeEventStore.events[0].GetEvictedForCapacity()
+	require.NotNil(t, evicted)
+	assert.Equal(t, job.Id, evicted.JobId)
+	assert.Equal(t, job.JobSetId, evicted.JobSetId)
+	assert.Equal(t, job.Queue, evicted.Queue)
+	assert.Equal(t, "cluster-1", evicted.ClusterId)
+	assert.Equal(t, job.Id, fakeEventStore.events[1].GetQueued().GetJobId())
+}
+
+func TestAggregatedQueueServer_DecompressJobOwnershipGroups(t *testing.T) {
+	_, _, aggregatedQueueServer := makeAggregatedQueueServerWithTestDoubles(5)
+	compressor, err := compress.NewZlibCompressor(0)
+	require.NoError(t, err)
+	compressGroups := func(groups ...string) []byte {
+		compressedGroups, err := compress.CompressStringArray(groups, compressor)
+		require.NoError(t, err)
+		return compressedGroups
+	}
+	ownershipGroupsRepository := fakeOwnershipGroupsRepository{}
+	aggregatedQueueServer.ownershipGroupsRepository = ownershipGroupsRepository
+	hash1, err := ownershipGroupsRepository.StoreOwnershipGroups(compressGroups("a", "b"))
+	require.NoError(t, err)
+	hash2, err := ownershipGroupsRepository.StoreOwnershipGroups(compressGroups("c"))
+	require.NoError(t, err)
+
+	jobs := []*api.Job{
+		{Id: "1", Queue: "queue", Owner: "alice", OwnershipGroupsHash: hash1},
+		{Id: "2", Queue: "queue", Owner: "bob", OwnershipGroupsHash: hash2},
+		{Id: "3", Queue: "queue", Owner: "alice", OwnershipGroupsHash: hash1},
+		// Groups stored with the job take precedence.
+		{Id: "4", Queue: "queue", Owner: "alice", OwnershipGroupsHash: hash1, CompressedQueueOwnershipUserGroups: compressGroups("d")},
+		// The job references no groups, or groups that aren't stored.
+		{Id: "5", Queue: "other", Owner: "alice"},
+		{Id: "6", Queue: "other", Owner: "alice", OwnershipGroupsHash: "missing"},
+	}
+	require.NoError(t, aggregatedQueueServer.decompressJobOwnershipGroups(jobs))
+	assert.Equal(t, []string{"a", "b"}, jobs[0].QueueOwnershipUserGroups)
+	assert.Equal(t, []string{"c"}, jobs[1].QueueOwnershipUserGroups)
+	assert.Equal(t, []string{"a", "b"}, jobs[2].QueueOwnershipUserGroups)
+	assert.Equal(t, []string{"d"}, jobs[3].QueueOwnershipUserGroups)
+	assert.Nil(t, jobs[3].CompressedQueueOwnershipUserGroups)
+	assert.Empty(t, jobs[4].QueueOwnershipUserGroups)
+	assert.Empty(t, jobs[5].QueueOwnershipUserGroups)
+}
+
 func makeAggregatedQueueServerWithTestDoubles(maxRetries uint) (*mockJobRepository, *fakeEventStore, *AggregatedQueueServer) {
 	mockJobRepository := newMockJobRepository()
 	fakeEventStore := &fakeEventStore{}
@@ -207,11 +385,31 @@ func makeAggregatedQueueServerWithTestDoubles(maxRetries uint) (*mockJobReposito
 		fakeEventStore,
 		fakeSchedulingInfoRepository,
 		nil,
+		fakeOwnershipGroupsRepository{},
+		nil,
 		0,
 		fakeExecutorRepository{},
 	)
 }
 
+type fakeOwnershipGroupsRepository map[string][]byte
+
+func (r fakeOwnershipGroupsRepository) StoreOwnershipGroups(compressedGroups []byte) (string, error) {
+	hash := repository.OwnershipGroupsHash(compressedGroups)
+	r[hash] = compressedGroups
+	return hash, nil
+}
+
+func (r fakeOwnershipGroupsRepository) GetOwnershipGroups(hashes []string) (map[string][]byte, error) {
+	groups := make(map[string][]byte)
+	for _, hash := range hashes {
+		if compressedGroups, ok := r[hash]; ok {
+			groups[hash] = compressedGroups
+		}
+	}
+	return groups, nil
+}
+
 type mockJobRepository struct {
 	jobs          map[string]*api.Job
 	jobRetries    map[string]int
@@ -227,6 +425,8 @@ type mockJobRepository struct {
 	jobStartTimeInfos       map[string]*repository.JobStartInfo
 	updateJobStartTimeError error
 	redisError              error
+	// Ids of the clusters jobs are leased to, by job id.
+	leasedClusterIds map[string]string
 }
 
 func (repo *mockJobRepository) StorePulsarSchedulerJobDetails(jobDetails []*schedulerobjects.PulsarSchedulerJobDetails) error {
@@ -275,6 +475,7 @@ func newMockJobRepository() *mockJobRepository {
 		returnLeaseArg2:   "",
 		deleteJobsArg:     nil,
 		jobStartTimeInfos: map[string]*repository.JobStartInfo{},
+		leasedClusterIds:  map[string]string{},
 	}
 }
 
@@ -282,6 +483,18 @@ func (repo *mockJobRepository) GetQueueJobIds(queueName string) ([]string, error
 	return []string{}, nil
 }
 
+func (repo *mockJobRepository) GetQueueJobIdsPage(queueName string, offset int64, limit int64) ([]string, error) {
+	return []string{}, nil
+}
+
+func (repo *mockJobRepository) GetQueueJobIdsByOwner(queueName string) (map[string][]string, error) {
+	return map[string][]string{}, nil
+}
+
+func (repo *mockJobRepository) GetQueueResources(queueName string) (armadaresource.ComputeResources, error) {
+	return armadaresource.ComputeResources{}, nil
+}
+
 func (repo *mockJobRepository) CreateJobs(request *api.JobSubmitRequest, owner string, ownershipGroups []string) ([]*api.Job, error) {
 	return []*api.Job{}, nil
 }
@@ -293,6 +506,18 @@ func (repo *mockJobRepository) AddJobs(job []*api.Job) ([]*repository.SubmitJobR
 	return []*repository.SubmitJobResult{}, nil
 }
 
+func (repo *mockJobRepository) AddJobsWithEvents(jobs []*api.Job, _ [][]*api.EventMessage) ([]*repository.SubmitJobResult, error) {
+	return repo.AddJobs(jobs)
+}
+
+func (repo *mockJobRepository) AddOutboxEvents(events []*api.EventMessage) error {
+	return nil
+}
+
+func (repo *mockJobRepository) RelayOutboxEvents(limit int, publish func([]*api.EventMessage) error) (int, error) {
+	return 0, nil
+}
+
 func (repo *mockJobRepository) GetExistingJobsByIds(ids []string) ([]*api.Job, error) {
 	jobs := make([]*api.Job, 0)
 	for _, id := range ids {
@@ -337,7 +562,7 @@ func (repo *mockJobRepository) ReturnLease(clusterId string, jobId string) (retu
 	repo.returnLeaseCalls++
 	repo.returnLeaseArg1 = clusterId
 	repo.returnLeaseArg2 = jobId
-	return nil, nil
+	return repo.jobs[jobId], nil
 }
 
 func (repo *mockJobRepository) DeleteJobs(jobs []*api.Job) (map[*api.Job]error, error) {
@@ -351,6 +576,14 @@ func (repo *mockJobRepository) DeleteJobs(jobs []*api.Job) (map[*api.Job]error,
 	return map[*api.Job]error{}, nil
 }
 
+func (repo *mockJobRepository) SuspendJobs(queue string, jobIds []string) ([]string, error) {
+	return []string{}, nil
+}
+
+func (repo *mockJobRepository) ResumeJobs(queue string, jobIds []string) ([]string, error) {
+	return []string{}, nil
+}
+
 func (repo *mockJobRepository) GetActiveJobIds(queue string, jobSetId string) ([]string, error) {
 	return []string{}, nil
 }
@@ -359,6 +592,10 @@ func (repo *mockJobRepository) GetJobSetJobIds(queue string, jobSetId string, fi
 	return []string{}, nil
 }
 
+func (repo *mockJobRepository) GetJobStates(queue string, jobIds []string) (map[string]repository.JobState, error) {
+	return map[string]repository.JobState{}, nil
+}
+
 func (repo *mockJobRepository) GetQueueActiveJobSets(queue string) ([]*api.JobSetInfo, error) {
 	return []*api.JobSetInfo{}, nil
 }
@@ -417,12 +654,26 @@ func (repo *mockJobRepository) GetJobRunInfos(jobIds []string) (map[string]*repo
 	return map[string]*repository.RunInfo{}, nil
 }
 
+func (repo *mockJobRepository) GetLeasedJobClusterIds(jobIds []string) (map[string]string, error) {
+	clusterIds := map[string]string{}
+	for _, jobId := range jobIds {
+		if clusterId, ok := repo.leasedClusterIds[jobId]; ok {
+			clusterIds[jobId] = clusterId
+		}
+	}
+	return clusterIds, nil
+}
+
 type fakeQueueRepository struct{}
 
 func (repo *fakeQueueRepository) GetAllQueues() ([]queue.Queue, error) {
 	return []queue.Queue{}, nil
 }
 
+func (repo *fakeQueueRepository) GetQueueSnapshot() ([]queue.Queue, uint64, error) {
+	return []queue.Queue{}, 0, nil
+}
+
 func (repo *fakeQueueRepository) GetQueue(name string) (queue.Queue, error) {
 	return queue.Queue{}, nil
 }
@@ -431,14 +682,30 @@ func (repo *fakeQueueRepository) CreateQueue(queue queue.Queue) error {
 	return nil
 }
 
+func (repo *fakeQueueRepository) CreateQueues(queues []queue.Queue) error {
+	return nil
+}
+
 func (repo *fakeQueueRepository) UpdateQueue(queue queue.Queue) error {
 	return nil
 }
 
+func (repo *fakeQueueRepository) UpdateQueues(queues []queue.Queue) error {
+	return nil
+}
+
+func (repo *fakeQueueRepository) SetQueueArchival(name string, archival *queue.Archival) error {
+	return nil
+}
+
 func (repo *fakeQueueRepository) DeleteQueue(name string) error {
 	return nil
 }
 
+func (repo *fakeQueueRepository) GetQueueChanges(fromResourceVersion uint64, limit int64, block time.Duration) ([]repository.QueueChange, error) {
+	return nil, nil
+}
+
 type fakeUsageRepository struct{}
 
 func (repo *fakeUsageRepository) GetClusterUsageReports() (map[string]*api.ClusterUsageReport, error) {
@@ -482,6 +749,12 @@ func (es *fakeEventStore) ReportEvents(_ *armadacontext.Context, message []*api.
 	return nil
 }
 
+type failingEventStore struct{}
+
+func (es *failingEventStore) ReportEvents(*armadacontext.Context, []*api.EventMessage) error {
+	return errors.New("event store unavailable")
+}
+
 type fakeSchedulingInfoRepository struct{}
 
 func (repo *fakeSchedulingInfoRepository) GetClusterSchedulingInfo() (map[string]*api.ClusterSchedulingInfoReport, error) {
@@ -492,6 +765,10 @@ func (repo *fakeSchedulingInfoRepository) UpdateClusterSchedulingInfo(report *ap
 	return nil
 }
 
+func (repo *fakeSchedulingInfoRepository) GetLastUpdateTime() (time.Time, error) {
+	return time.Time{}, nil
+}
+
 type fakeExecutorRepository struct{}
 
 func (f fakeExecutorRepository) GetExecutors(ctx *armadacontext.Context) ([]*schedulerobjects.Executor, error) {
@@ -505,3 +782,46 @@ func (f fakeExecutorRepository) GetLastUpdateTimes(ctx *armadacontext.Context) (
 func (f fakeExecutorRepository) StoreExecutor(ctx *armadacontext.Context, executor *schedulerobjects.Executor) error {
 	return nil
 }
+
+type fakeQuarantineRepository struct {
+	leaseReturns      map[string][]*api.JobLeaseReturnedEvent
+	leaseReturnCounts map[string]int
+	quarantinedJobs   map[string]*api.QuarantinedJob
+}
+
+func newFakeQuarantineRepository() *fakeQuarantineRepository {
+	return &fakeQuarantineRepository{
+		leaseReturns:      make(map[string][]*api.JobLeaseReturnedEvent),
+		leaseReturnCounts: make(map[string]int),
+		quarantinedJobs:   make(map[string]*api.QuarantinedJob),
+	}
+}
+
+func (r *fakeQuarantineRepository) RecordLeaseReturn(
+	event *api.JobLeaseReturnedEvent,
+	maxRecorded int,
+	_ time.Duration,
+) (int, []*api.JobLeaseReturnedEvent, error) {
+	leaseReturns := append(r.leaseReturns[event.JobId], event)
+	if len(leaseReturns) > maxRecorded {
+		leaseReturns = leaseReturns[len(leaseReturns)-maxRecorded:]
+	}
+	r.leaseReturns[event.JobId] = leaseReturns
+	r.leaseReturnCounts[event.JobId]++
+	return r.leaseReturnCounts[event.JobId], leaseReturns, nil
+}
+
+func (r *fakeQuarantineRepository) QuarantineJob(record *api.QuarantinedJob, _ time.Duration) error {
+	r.quarantinedJobs[record.JobId] = record
+	delete(r.leaseReturns, record.JobId)
+	delete(r.leaseReturnCounts, record.JobId)
+	return nil
+}
+
+func (r *fakeQuarantineRepository) GetQuarantinedJob(jobId string) (*api.QuarantinedJob, error) {
+	record, ok := r.quarantinedJobs[jobId]
+	if !ok {
+		return nil, &repository.ErrQuarantinedJobNotFound{JobId: jobId}
+	}
+	return record, nil
+}
@@ -0,0 +1,158 @@
+package server
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/exp/slices"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/client/queue"
+)
+
+// Metadata key of the number of queues matching the filters of a ListQueues request across all pages.
+const totalCountHeader = "x-total-count"
+
+// ListQueues returns a page of the queues matching the filters of req, sorted as requested.
+// Pages are continued with the next page token of the previous page, which records the position of the last queue of it.
+func (server *SubmitServer) ListQueues(ctx context.Context, req *api.QueueListRequest) (*api.QueuePage, error) {
+	pageSize := int(req.PageSize)
+	if pageSize == 0 {
+		pageSize = server.queueManagementConfig.DefaultQueuePageSize
+	}
+	if maxPageSize := server.queueManagementConfig.MaxQueuePageSize; maxPageSize > 0 && pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+	var after *queuePageToken
+	if req.PageToken != "" {
+		token, err := parseQueuePageToken(req.PageToken)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "[ListQueues] invalid page token: %s", err)
+		}
+		if token.SortBy != req.SortBy || token.Descending != req.Descending {
+			return nil, status.Errorf(codes.InvalidArgument, "[ListQueues] page token was issued for a different sort order")
+		}
+		after = token
+	}
+
+	queues, _, err := server.queueRepository.GetQueueSnapshot()
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "[ListQueues] error getting queues: %s", err)
+	}
+	matching := make([]queue.Queue, 0, len(queues))
+	for _, q := range queues {
+		if strings.HasPrefix(q.Name, req.NamePrefix) && q.Labels.Matches(req.Labels) && q.MatchesSearch(req.Search) {
+			matching = append(matching, q)
+		}
+	}
+	slices.SortFunc(matching, func(a, b queue.Queue) bool {
+		return compareQueues(newQueuePageToken(a, req), newQueuePageToken(b, req)) < 0
+	})
+
+	start := 0
+	if after != nil {
+		start = len(matching)
+		for i, q := range matching {
+			if compareQueues(newQueuePageToken(q, req), after) > 0 {
+				start = i
+				break
+			}
+		}
+	}
+	end := len(matching)
+	if pageSize > 0 && start+pageSize < end {
+		end = start + pageSize
+	}
+	page := &api.QueuePage{
+		Queues:     queue.QueuesToAPI(matching[start:end]),
+		TotalCount: uint32(len(matching)),
+	}
+	if end < len(matching) {
+		page.NextPageToken, err = newQueuePageToken(matching[end-1], req).encode()
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "[ListQueues] error creating page token: %s", err)
+		}
+	}
+
+	if err := grpc.SetHeader(ctx, metadata.Pairs(totalCountHeader, strconv.Itoa(len(matching)))); err != nil {
+		log.WithError(err).Debug("failed to set total count header")
+	}
+	return page, nil
+}
+
+// queuePageToken is the position of a queue in the sort order of a ListQueues request.
+// Encoded, it's the page token of the page following that queue.
+type queuePageToken struct {
+	SortBy         api.QueueListRequest_SortField `json:"sortBy"`
+	Descending     bool                           `json:"descending"`
+	Name           string                         `json:"name"`
+	Created        time.Time                      `json:"created"`
+	PriorityFactor float64                        `json:"priorityFactor"`
+}
+
+func newQueuePageToken(q queue.Queue, req *api.QueueListRequest) *queuePageToken {
+	token := &queuePageToken{
+		SortBy:         req.SortBy,
+		Descending:     req.Descending,
+		Name:           q.Name,
+		PriorityFactor: float64(q.PriorityFactor),
+	}
+	// Queues created before creation times were recorded sort as if created first.
+	if q.Created != nil {
+		token.Created = q.Created.Time
+	}
+	return token
+}
+
+func parseQueuePageToken(s string) (*queuePageToken, error) {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	token := &queuePageToken{}
+	if err := json.Unmarshal(data, token); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return token, nil
+}
+
+func (token *queuePageToken) encode() (string, error) {
+	data, err := json.Marshal(token)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// compareQueues returns a negative number if the queue at position a comes before the one at position b, a positive
+// number if it comes after it, and 0 if they're the same queue. Queues with equal sort keys are ordered by name.
+func compareQueues(a, b *queuePageToken) int {
+	result := 0
+	switch a.SortBy {
+	case api.QueueListRequest_CREATED:
+		result = a.Created.Compare(b.Created)
+	case api.QueueListRequest_PRIORITY:
+		if a.PriorityFactor < b.PriorityFactor {
+			result = -1
+		} else if a.PriorityFactor > b.PriorityFactor {
+			result = 1
+		}
+	}
+	if result == 0 {
+		result = strings.Compare(a.Name, b.Name)
+	}
+	if a.Descending {
+		return -result
+	}
+	return result
+}
@@ -0,0 +1,153 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/armadaproject/armada/internal/armada/repository"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+func TestSubmitServer_ListQueues(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
+		// Created after the queue "test" created by withSubmitServer.
+		for _, q := range []*api.Queue{
+			{Name: "c", PriorityFactor: 2},
+			{Name: "a", PriorityFactor: 3, Labels: map[string]string{"team": "ml"}},
+			{Name: "b", PriorityFactor: 2, Labels: map[string]string{"team": "ml"}},
+		} {
+			_, err := s.CreateQueue(context.Background(), q)
+			require.NoError(t, err)
+		}
+
+		tests := map[string]struct {
+			req      *api.QueueListRequest
+			expected [][]string
+		}{
+			"by name": {
+				req:      &api.QueueListRequest{PageSize: 3},
+				expected: [][]string{{"a", "b", "c"}, {"test"}},
+			},
+			"by name descending": {
+				req:      &api.QueueListRequest{PageSize: 3, Descending: true},
+				expected: [][]string{{"test", "c", "b"}, {"a"}},
+			},
+			"by creation time": {
+				req:      &api.QueueListRequest{PageSize: 2, SortBy: api.QueueListRequest_CREATED},
+				expected: [][]string{{"test", "c"}, {"a", "b"}},
+			},
+			"by priority with ties by name": {
+				req:      &api.QueueListRequest{PageSize: 2, SortBy: api.QueueListRequest_PRIORITY},
+				expected: [][]string{{"test", "b"}, {"c", "a"}},
+			},
+			"by priority descending": {
+				req:      &api.QueueListRequest{PageSize: 1, SortBy: api.QueueListRequest_PRIORITY, Descending: true},
+				expected: [][]string{{"a"}, {"c"}, {"b"}, {"test"}},
+			},
+			"filtered": {
+				req:      &api.QueueListRequest{PageSize: 1, Labels: map[string]string{"team": "ml"}},
+				expected: [][]string{{"a"}, {"b"}},
+			},
+			"all at once": {
+				req:      &api.QueueListRequest{},
+				expected: [][]string{{"a", "b", "c", "test"}},
+			},
+		}
+		for name, tc := range tests {
+			t.Run(name, func(t *testing.T) {
+				var actual [][]string
+				req := *tc.req
+				for {
+					page, err := s.ListQueues(context.Background(), &req)
+					require.NoError(t, err)
+					assert.Equal(t, uint32(len(flatten(tc.expected))), page.TotalCount)
+					var names []string
+					for _, q := range page.Queues {
+						names = append(names, q.Name)
+					}
+					actual = append(actual, names)
+					if page.NextPageToken == "" {
+						break
+					}
+					require.Less(t, len(actual), 10)
+					req.PageToken = page.NextPageToken
+				}
+				assert.Equal(t, tc.expected, actual)
+			})
+		}
+	})
+}
+
+func TestSubmitServer_ListQueues_SetsCreationTime(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
+		_, err := s.CreateQueue(context.Background(), &api.Queue{Name: "a", PriorityFactor: 1})
+		require.NoError(t, err)
+		page, err := s.ListQueues(context.Background(), &api.QueueListRequest{NamePrefix: "a"})
+		require.NoError(t, err)
+		require.Len(t, page.Queues, 1)
+		require.NotNil(t, page.Queues[0].Created)
+		created := *page.Queues[0].Created
+
+		// Updates retain the creation time.
+		_, err = s.UpdateQueue(context.Background(), &api.Queue{Name: "a", PriorityFactor: 2})
+		require.NoError(t, err)
+		page, err = s.ListQueues(context.Background(), &api.QueueListRequest{NamePrefix: "a"})
+		require.NoError(t, err)
+		require.Len(t, page.Queues, 1)
+		assert.Equal(t, created, *page.Queues[0].Created)
+	})
+}
+
+func TestSubmitServer_ListQueues_PageSizeIsCapped(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
+		s.queueManagementConfig.DefaultQueuePageSize = 1
+		s.queueManagementConfig.MaxQueuePageSize = 2
+		for _, name := range []string{"a", "b"} {
+			_, err := s.CreateQueue(context.Background(), &api.Queue{Name: name, PriorityFactor: 1})
+			require.NoError(t, err)
+		}
+
+		page, err := s.ListQueues(context.Background(), &api.QueueListRequest{})
+		require.NoError(t, err)
+		assert.Len(t, page.Queues, 1)
+
+		page, err = s.ListQueues(context.Background(), &api.QueueListRequest{PageSize: 100})
+		require.NoError(t, err)
+		assert.Len(t, page.Queues, 2)
+		assert.Equal(t, uint32(3), page.TotalCount)
+	})
+}
+
+func TestSubmitServer_ListQueues_InvalidPageToken(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
+		_, err := s.CreateQueue(context.Background(), &api.Queue{Name: "a", PriorityFactor: 1})
+		require.NoError(t, err)
+		page, err := s.ListQueues(context.Background(), &api.QueueListRequest{PageSize: 1})
+		require.NoError(t, err)
+		require.NotEmpty(t, page.NextPageToken)
+
+		for name, req := range map[string]*api.QueueListRequest{
+			"malformed":          {PageToken: "not a token"},
+			"different sort":     {PageToken: page.NextPageToken, SortBy: api.QueueListRequest_PRIORITY},
+			"different ordering": {PageToken: page.NextPageToken, Descending: true},
+		} {
+			t.Run(name, func(t *testing.T) {
+				_, err := s.ListQueues(context.Background(), req)
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			})
+		}
+	})
+}
+
+func flatten(pages [][]string) []string {
+	var result []string
+	for _, page := range pages {
+		result = append(result, page...)
+	}
+	return result
+}
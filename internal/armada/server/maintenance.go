@@ -0,0 +1,331 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/armadaproject/armada/internal/armada/permissions"
+	"github.com/armadaproject/armada/internal/armada/repository"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/armadaerrors"
+	"github.com/armadaproject/armada/internal/common/auth/authorization"
+	armadaslices "github.com/armadaproject/armada/internal/common/slices"
+	"github.com/armadaproject/armada/internal/common/util"
+	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/client/queue"
+)
+
+// CreateMaintenanceWindow schedules a maintenance window of an executor or a queue,
+// during which no new leases are given to the executor or to jobs of the queue.
+func (server *SubmitServer) CreateMaintenanceWindow(grpcCtx context.Context, req *api.MaintenanceWindowCreateRequest) (*api.MaintenanceWindow, error) {
+	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+	if err := server.authorizeMaintenanceWindows(ctx, "CreateMaintenanceWindow"); err != nil {
+		return nil, err
+	}
+	if (req.Executor == "") == (req.Queue == "") {
+		return nil, status.Errorf(codes.InvalidArgument, "[CreateMaintenanceWindow] exactly one of executor and queue must be provided")
+	}
+	if !req.End.After(req.Start) {
+		return nil, status.Errorf(codes.InvalidArgument, "[CreateMaintenanceWindow] end %s must be after start %s", req.End, req.Start)
+	}
+	if !req.End.After(time.Now()) {
+		return nil, status.Errorf(codes.InvalidArgument, "[CreateMaintenanceWindow] end %s must be in the future", req.End)
+	}
+	if req.Queue != "" {
+		_, err := server.queueRepository.GetQueue(req.Queue)
+		var e *repository.ErrQueueNotFound
+		if errors.As(err, &e) {
+			return nil, status.Errorf(codes.NotFound, "[CreateMaintenanceWindow] error: %s", err)
+		} else if err != nil {
+			return nil, status.Errorf(codes.Unavailable, "[CreateMaintenanceWindow] error getting queue %q: %s", req.Queue, err)
+		}
+	}
+
+	window := &api.MaintenanceWindow{
+		Id:        util.NewULID(),
+		Executor:  req.Executor,
+		Queue:     req.Queue,
+		Start:     req.Start.UTC(),
+		End:       req.End.UTC(),
+		Drain:     req.Drain,
+		Reason:    req.Reason,
+		CreatedBy: authorization.GetPrincipal(ctx).GetName(),
+	}
+	if err := server.MaintenanceWindowRepository.StoreMaintenanceWindow(window); err != nil {
+		return nil, status.Errorf(codes.Unavailable, "[CreateMaintenanceWindow] error storing window: %s", err)
+	}
+	maintenanceWindowLogger(window).Infof(
+		"maintenance window %s of %s scheduled by %s from %s to %s",
+		window.Id, maintenanceWindowTarget(window), window.CreatedBy, window.Start, window.End,
+	)
+	return window, nil
+}
+
+// GetMaintenanceWindows returns all maintenance windows that haven't ended yet, ordered by start.
+func (server *SubmitServer) GetMaintenanceWindows(_ context.Context, _ *api.MaintenanceWindowListRequest) (*api.MaintenanceWindowList, error) {
+	if server.MaintenanceWindowRepository == nil {
+		return nil, status.Errorf(codes.Unimplemented, "[GetMaintenanceWindows] maintenance windows aren't supported by this server")
+	}
+	windows, err := server.MaintenanceWindowRepository.GetMaintenanceWindows()
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "[GetMaintenanceWindows] error getting windows: %s", err)
+	}
+	return &api.MaintenanceWindowList{Windows: windows}, nil
+}
+
+// DeleteMaintenanceWindow deletes a maintenance window, ending it immediately if it has already started.
+func (server *SubmitServer) DeleteMaintenanceWindow(grpcCtx context.Context, req *api.MaintenanceWindowDeleteRequest) (*types.Empty, error) {
+	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+	if err := server.authorizeMaintenanceWindows(ctx, "DeleteMaintenanceWindow"); err != nil {
+		return nil, err
+	}
+	window, jobIds, err := server.MaintenanceWindowRepository.DeleteMaintenanceWindow(req.Id)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "[DeleteMaintenanceWindow] error deleting window %q: %s", req.Id, err)
+	} else if window == nil {
+		return nil, status.Errorf(codes.NotFound, "[DeleteMaintenanceWindow] window %q does not exist", req.Id)
+	}
+	maintenanceWindowLogger(window).Infof("maintenance window %s deleted by %s", req.Id, authorization.GetPrincipal(ctx).GetName())
+	if window.Started {
+		// The window is deleted either way, so failing to announce its end doesn't fail the request.
+		if err := server.endMaintenanceWindow(window, jobIds); err != nil {
+			maintenanceWindowLogger(window).WithError(err).Errorf("failed to announce the end of maintenance window %s", window.Id)
+		}
+	}
+	return &types.Empty{}, nil
+}
+
+// AnnounceMaintenanceWindows reports the start and end of maintenance windows to the jobs affected by them, drains the
+// jobs of windows that request it once they start, and deletes windows once they end. Leases are withheld during
+// windows regardless of when they're announced. Only one server announces windows at a time.
+func (server *SubmitServer) AnnounceMaintenanceWindows() {
+	if server.MaintenanceWindowRepository == nil {
+		return
+	}
+	_, err := server.MaintenanceWindowRepository.ProcessMaintenanceWindows(func(windows []*api.MaintenanceWindow) {
+		now := time.Now()
+		for _, window := range windows {
+			if err := server.announceMaintenanceWindow(window, now); err != nil {
+				maintenanceWindowLogger(window).WithError(err).Errorf("failed to announce maintenance window %s", window.Id)
+			}
+		}
+	})
+	if err != nil {
+		log.WithError(err).Error("failed to announce maintenance windows")
+	}
+}
+
+func (server *SubmitServer) announceMaintenanceWindow(window *api.MaintenanceWindow, now time.Time) error {
+	if now.Before(window.Start) {
+		return nil
+	}
+	if !window.Started && now.Before(window.End) {
+		return server.startMaintenanceWindow(window)
+	}
+	if !now.Before(window.End) {
+		deleted, jobIds, err := server.MaintenanceWindowRepository.DeleteMaintenanceWindow(window.Id)
+		if err != nil {
+			return err
+		}
+		// Windows deleted in the meantime were ended by whoever deleted them.
+		if deleted != nil && deleted.Started {
+			return server.endMaintenanceWindow(deleted, jobIds)
+		}
+	}
+	return nil
+}
+
+// startMaintenanceWindow reports the start of window to the jobs affected by it, drains them if requested,
+// and then stores window as started. If starting it fails, it's started again by the next announcement.
+func (server *SubmitServer) startMaintenanceWindow(window *api.MaintenanceWindow) error {
+	leasedJobIds, err := server.leasedJobIds(window.Queue, window.Executor)
+	if err != nil {
+		return err
+	}
+	jobIds := leasedJobIds
+	if window.Queue != "" {
+		queuedJobIds, err := server.jobRepository.GetQueueJobIds(window.Queue)
+		if err != nil {
+			return err
+		}
+		jobIds = append(queuedJobIds, leasedJobIds...)
+	}
+	if err := server.reportToExistingJobs(jobIds, func(jobs []*api.Job) error {
+		return reportJobsMaintenanceWindowStarted(server.eventStore, jobs, window)
+	}); err != nil {
+		return err
+	}
+	maintenanceWindowLogger(window).Infof("maintenance window %s of %s started", window.Id, maintenanceWindowTarget(window))
+	if window.Drain {
+		drained, err := server.drainMaintenanceWindow(window, leasedJobIds)
+		if err != nil {
+			return err
+		}
+		maintenanceWindowLogger(window).Infof("drained %d jobs of %s", drained, maintenanceWindowTarget(window))
+	}
+
+	// The end of windows of queues is reported to the jobs of the queue once it ends, including jobs submitted
+	// in the meantime, whereas the jobs leased to an executor may have been drained by then, so they're stored.
+	var startedJobIds []string
+	if window.Executor != "" {
+		startedJobIds = jobIds
+	}
+	started, err := server.MaintenanceWindowRepository.StartMaintenanceWindow(window, startedJobIds)
+	if err != nil {
+		return err
+	}
+	if !started {
+		// The window was deleted while starting it, and whoever deleted it didn't report its end.
+		return server.endMaintenanceWindow(window, startedJobIds)
+	}
+	return nil
+}
+
+// endMaintenanceWindow reports the end of window to the jobs affected by it, i.e., the jobs of its queue,
+// or the jobs stored when it started for windows of executors.
+func (server *SubmitServer) endMaintenanceWindow(window *api.MaintenanceWindow, jobIds []string) error {
+	if window.Queue != "" {
+		var err error
+		if jobIds, err = server.affectedJobIds(window.Queue, ""); err != nil {
+			return err
+		}
+	}
+	if err := server.reportToExistingJobs(jobIds, func(jobs []*api.Job) error {
+		return reportJobsMaintenanceWindowEnded(server.eventStore, jobs, window)
+	}); err != nil {
+		return err
+	}
+	maintenanceWindowLogger(window).Infof("maintenance window %s of %s ended", window.Id, maintenanceWindowTarget(window))
+	return nil
+}
+
+// reportToExistingJobs calls report with the jobs with the given ids that still exist, in batches.
+func (server *SubmitServer) reportToExistingJobs(jobIds []string, report func(jobs []*api.Job) error) error {
+	for _, batch := range util.Batch(jobIds, server.cancelJobsBatchSize) {
+		jobs, err := server.jobRepository.GetExistingJobsByIds(batch)
+		if err != nil {
+			return err
+		}
+		if len(jobs) == 0 {
+			continue
+		}
+		if err := report(jobs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// affectedJobIds returns the ids of the queued and leased jobs of queue, or the ids of the jobs leased to executor if
+// queue is empty, i.e., the jobs affected by withholding leases from either.
+func (server *SubmitServer) affectedJobIds(queueName string, executor string) ([]string, error) {
+	leasedJobIds, err := server.leasedJobIds(queueName, executor)
+	if err != nil || queueName == "" {
+		return leasedJobIds, err
+	}
+	queuedJobIds, err := server.jobRepository.GetQueueJobIds(queueName)
+	if err != nil {
+		return nil, err
+	}
+	return append(queuedJobIds, leasedJobIds...), nil
+}
+
+// leasedJobIds returns the ids of the jobs leased to executor, or the leased jobs of queue if executor is empty.
+func (server *SubmitServer) leasedJobIds(queueName string, executor string) ([]string, error) {
+	queues := []string{queueName}
+	if executor != "" {
+		allQueues, err := server.queueRepository.GetAllQueues()
+		if err != nil {
+			return nil, err
+		}
+		queues = util.Map(allQueues, func(q queue.Queue) string { return q.Name })
+	}
+	var jobIds []string
+	for _, q := range queues {
+		ids, err := server.jobRepository.GetLeasedJobIds(q)
+		if err != nil {
+			return nil, err
+		}
+		if executor != "" && len(ids) > 0 {
+			clusterIds, err := server.jobRepository.GetLeasedJobClusterIds(ids)
+			if err != nil {
+				return nil, err
+			}
+			ids = armadaslices.Filter(ids, func(id string) bool { return clusterIds[id] == executor })
+		}
+		jobIds = append(jobIds, ids...)
+	}
+	return jobIds, nil
+}
+
+// drainMaintenanceWindow preempts and requeues the given jobs leased to the executor of window, or the leased jobs of
+// its queue, such that they're leased again once the window ends. Returns the number of jobs preempted.
+func (server *SubmitServer) drainMaintenanceWindow(window *api.MaintenanceWindow, jobIds []string) (int, error) {
+	jobs, err := server.jobRepository.GetExistingJobsByIds(jobIds)
+	if err != nil {
+		return 0, err
+	}
+	jobIdsByQueue := make(map[string][]string)
+	for _, job := range jobs {
+		jobIdsByQueue[job.Queue] = append(jobIdsByQueue[job.Queue], job.Id)
+	}
+	request := &api.JobPreemptRequest{
+		Requeue: true,
+		Reason:  fmt.Sprintf("maintenance window %s: %s", window.Id, window.Reason),
+	}
+	drained := 0
+	for q, ids := range jobIdsByQueue {
+		request.Queue = q
+		for _, batch := range util.Batch(ids, server.cancelJobsBatchSize) {
+			preempted, err := server.preemptJobs(window.CreatedBy, request, batch)
+			drained += len(preempted)
+			if err != nil {
+				return drained, err
+			}
+		}
+	}
+	return drained, nil
+}
+
+func (server *SubmitServer) authorizeMaintenanceWindows(ctx *armadacontext.Context, method string) error {
+	if server.MaintenanceWindowRepository == nil {
+		return status.Errorf(codes.Unimplemented, "[%s] maintenance windows aren't supported by this server", method)
+	}
+	err := server.authorizer.AuthorizeAction(ctx, permissions.ManageMaintenanceWindows)
+	var ep *armadaerrors.ErrUnauthorized
+	if errors.As(err, &ep) {
+		return status.Errorf(codes.PermissionDenied, "[%s] error: %s", method, ep)
+	} else if err != nil {
+		return status.Errorf(codes.Unavailable, "[%s] error checking permissions: %s", method, err)
+	}
+	return nil
+}
+
+// maintenanceWindowActive returns true if window has started but not yet ended at time now.
+func maintenanceWindowActive(window *api.MaintenanceWindow, now time.Time) bool {
+	return !now.Before(window.Start) && now.Before(window.End)
+}
+
+func maintenanceWindowTarget(window *api.MaintenanceWindow) string {
+	if window.Queue != "" {
+		return "queue " + window.Queue
+	}
+	return "executor " + window.Executor
+}
+
+func maintenanceWindowLogger(window *api.MaintenanceWindow) *log.Entry {
+	fields := log.Fields{"maintenanceWindow": window.Id, "reason": window.Reason}
+	if window.Queue != "" {
+		fields["queue"] = window.Queue
+	} else {
+		fields["executor"] = window.Executor
+	}
+	return log.WithFields(fields)
+}
@@ -0,0 +1,231 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	clock "k8s.io/utils/clock/testing"
+
+	"github.com/armadaproject/armada/internal/armada/repository"
+	"github.com/armadaproject/armada/internal/common/util"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+func TestSubmitServer_MaintenanceWindows(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
+		now := time.Now().UTC().Truncate(time.Second)
+		_, err := s.GetMaintenanceWindows(context.Background(), &api.MaintenanceWindowListRequest{})
+		assert.Equal(t, codes.Unimplemented, status.Code(err))
+
+		client := redis.NewClient(&redis.Options{Addr: "localhost:6379", DB: 10})
+		defer client.Close()
+		s.MaintenanceWindowRepository = repository.NewRedisMaintenanceWindowRepository(client)
+
+		for name, req := range map[string]*api.MaintenanceWindowCreateRequest{
+			"no target":       {Start: now, End: now.Add(time.Hour)},
+			"two targets":     {Executor: "test-cluster", Queue: "test", Start: now, End: now.Add(time.Hour)},
+			"end not after":   {Executor: "test-cluster", Start: now, End: now},
+			"end in the past": {Executor: "test-cluster", Start: now.Add(-2 * time.Hour), End: now.Add(-time.Hour)},
+		} {
+			_, err = s.CreateMaintenanceWindow(context.Background(), req)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err), name)
+		}
+		_, err = s.CreateMaintenanceWindow(context.Background(), &api.MaintenanceWindowCreateRequest{Queue: "missing", Start: now, End: now.Add(time.Hour)})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+
+		later, err := s.CreateMaintenanceWindow(context.Background(), &api.MaintenanceWindowCreateRequest{
+			Queue: "test", Start: now.Add(time.Hour), End: now.Add(2 * time.Hour), Reason: "migration",
+		})
+		require.NoError(t, err)
+		assert.NotEmpty(t, later.Id)
+		earlier, err := s.CreateMaintenanceWindow(context.Background(), &api.MaintenanceWindowCreateRequest{
+			Executor: "test-cluster", Start: now, End: now.Add(time.Hour), Drain: true, Reason: "upgrade",
+		})
+		require.NoError(t, err)
+		windows, err := s.GetMaintenanceWindows(context.Background(), &api.MaintenanceWindowListRequest{})
+		require.NoError(t, err)
+		assert.Equal(t, []*api.MaintenanceWindow{earlier, later}, windows.Windows)
+
+		_, err = s.DeleteMaintenanceWindow(context.Background(), &api.MaintenanceWindowDeleteRequest{Id: later.Id})
+		require.NoError(t, err)
+		_, err = s.DeleteMaintenanceWindow(context.Background(), &api.MaintenanceWindowDeleteRequest{Id: later.Id})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+
+		s.authorizer = &FakeDenyAllActionAuthorizer{}
+		_, err = s.CreateMaintenanceWindow(context.Background(), &api.MaintenanceWindowCreateRequest{Executor: "test-cluster", Start: now, End: now.Add(time.Hour)})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		_, err = s.DeleteMaintenanceWindow(context.Background(), &api.MaintenanceWindowDeleteRequest{Id: earlier.Id})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+}
+
+func TestSubmitServer_AnnounceMaintenanceWindows_DrainsJobs(t *testing.T) {
+	withSubmitServerAndRepos(func(s *SubmitServer, jobRepo repository.JobRepository, events *repository.TestEventStore) {
+		client := redis.NewClient(&redis.Options{Addr: "localhost:6379", DB: 10})
+		defer client.Close()
+		s.MaintenanceWindowRepository = repository.NewRedisMaintenanceWindowRepository(client)
+
+		response, err := s.SubmitJobs(context.Background(), createJobRequest(util.NewULID(), 2))
+		require.NoError(t, err)
+		drainedJobId := response.JobResponseItems[0].JobId
+		otherJobId := response.JobResponseItems[1].JobId
+		_, err = jobRepo.TryLeaseJobs("test-cluster", map[string][]string{"test": {drainedJobId}})
+		require.NoError(t, err)
+		_, err = jobRepo.TryLeaseJobs("other-cluster", map[string][]string{"test": {otherJobId}})
+		require.NoError(t, err)
+
+		now := time.Now()
+		window, err := s.CreateMaintenanceWindow(context.Background(), &api.MaintenanceWindowCreateRequest{
+			Executor: "test-cluster", Start: now.Add(-time.Minute), End: now.Add(time.Hour), Drain: true,
+		})
+		require.NoError(t, err)
+		s.AnnounceMaintenanceWindows()
+
+		// Only the job leased to the executor in maintenance is preempted and requeued.
+		var preemptedJobIds []string
+		for _, event := range events.ReceivedEvents {
+			if e, ok := event.Events.(*api.EventMessage_Preempted); ok {
+				preemptedJobIds = append(preemptedJobIds, e.Preempted.JobId)
+			}
+		}
+		assert.Equal(t, []string{drainedJobId}, preemptedJobIds)
+		leasedJobIds, err := jobRepo.GetLeasedJobIds("test")
+		require.NoError(t, err)
+		assert.Equal(t, []string{otherJobId}, leasedJobIds)
+
+		assert.Equal(t, []string{drainedJobId}, maintenanceWindowEventJobIds(events.ReceivedEvents, window.Id, false))
+
+		windows, err := s.MaintenanceWindowRepository.GetMaintenanceWindows()
+		require.NoError(t, err)
+		require.Len(t, windows, 1)
+		assert.True(t, windows[0].Started)
+
+		// Windows are deleted once they end, and their end is reported to the jobs their start was reported to.
+		require.NoError(t, s.announceMaintenanceWindow(windows[0], window.End))
+		windows, err = s.MaintenanceWindowRepository.GetMaintenanceWindows()
+		require.NoError(t, err)
+		assert.Empty(t, windows)
+		assert.Equal(t, []string{drainedJobId}, maintenanceWindowEventJobIds(events.ReceivedEvents, window.Id, true))
+	})
+}
+
+func TestSubmitServer_MaintenanceWindowsOfQueues_ReportStartAndEnd(t *testing.T) {
+	withSubmitServerAndRepos(func(s *SubmitServer, jobRepo repository.JobRepository, events *repository.TestEventStore) {
+		client := redis.NewClient(&redis.Options{Addr: "localhost:6379", DB: 10})
+		defer client.Close()
+		s.MaintenanceWindowRepository = repository.NewRedisMaintenanceWindowRepository(client)
+
+		response, err := s.SubmitJobs(context.Background(), createJobRequest(util.NewULID(), 2))
+		require.NoError(t, err)
+		leasedJobId := response.JobResponseItems[0].JobId
+		queuedJobId := response.JobResponseItems[1].JobId
+		_, err = jobRepo.TryLeaseJobs("test-cluster", map[string][]string{"test": {leasedJobId}})
+		require.NoError(t, err)
+
+		now := time.Now()
+		window, err := s.CreateMaintenanceWindow(context.Background(), &api.MaintenanceWindowCreateRequest{
+			Queue: "test", Start: now.Add(-time.Minute), End: now.Add(time.Hour), Reason: "migration",
+		})
+		require.NoError(t, err)
+		s.AnnounceMaintenanceWindows()
+		assert.ElementsMatch(t, []string{leasedJobId, queuedJobId}, maintenanceWindowEventJobIds(events.ReceivedEvents, window.Id, false))
+		assert.Empty(t, maintenanceWindowEventJobIds(events.ReceivedEvents, window.Id, true))
+
+		// Deleting a started window ends it.
+		response, err = s.SubmitJobs(context.Background(), createJobRequest(util.NewULID(), 1))
+		require.NoError(t, err)
+		laterJobId := response.JobResponseItems[0].JobId
+		_, err = s.DeleteMaintenanceWindow(context.Background(), &api.MaintenanceWindowDeleteRequest{Id: window.Id})
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{leasedJobId, queuedJobId, laterJobId}, maintenanceWindowEventJobIds(events.ReceivedEvents, window.Id, true))
+	})
+}
+
+func TestSubmitServer_MaintenanceWindowsDeletedWhileStarting_AreEnded(t *testing.T) {
+	withSubmitServerAndRepos(func(s *SubmitServer, jobRepo repository.JobRepository, events *repository.TestEventStore) {
+		client := redis.NewClient(&redis.Options{Addr: "localhost:6379", DB: 10})
+		defer client.Close()
+		s.MaintenanceWindowRepository = repository.NewRedisMaintenanceWindowRepository(client)
+
+		response, err := s.SubmitJobs(context.Background(), createJobRequest(util.NewULID(), 1))
+		require.NoError(t, err)
+		jobId := response.JobResponseItems[0].JobId
+		_, err = jobRepo.TryLeaseJobs("test-cluster", map[string][]string{"test": {jobId}})
+		require.NoError(t, err)
+		now := time.Now()
+		_, err = s.CreateMaintenanceWindow(context.Background(), &api.MaintenanceWindowCreateRequest{
+			Executor: "test-cluster", Start: now.Add(-time.Minute), End: now.Add(time.Hour),
+		})
+		require.NoError(t, err)
+		windows, err := s.MaintenanceWindowRepository.GetMaintenanceWindows()
+		require.NoError(t, err)
+		require.Len(t, windows, 1)
+
+		_, err = s.DeleteMaintenanceWindow(context.Background(), &api.MaintenanceWindowDeleteRequest{Id: windows[0].Id})
+		require.NoError(t, err)
+		assert.Empty(t, maintenanceWindowEventJobIds(events.ReceivedEvents, windows[0].Id, true))
+
+		require.NoError(t, s.announceMaintenanceWindow(windows[0], now))
+		assert.Equal(t, []string{jobId}, maintenanceWindowEventJobIds(events.ReceivedEvents, windows[0].Id, false))
+		assert.Equal(t, []string{jobId}, maintenanceWindowEventJobIds(events.ReceivedEvents, windows[0].Id, true))
+		windows, err = s.MaintenanceWindowRepository.GetMaintenanceWindows()
+		require.NoError(t, err)
+		assert.Empty(t, windows)
+	})
+}
+
+// maintenanceWindowEventJobIds returns the ids of the jobs the start, or end, of the window with the given id was
+// reported to.
+func maintenanceWindowEventJobIds(events []*api.EventMessage, windowId string, ended bool) []string {
+	var jobIds []string
+	for _, event := range events {
+		if e := event.GetMaintenanceWindowStarted(); e != nil && !ended && e.MaintenanceWindowId == windowId {
+			jobIds = append(jobIds, e.JobId)
+		}
+		if e := event.GetMaintenanceWindowEnded(); e != nil && ended && e.MaintenanceWindowId == windowId {
+			jobIds = append(jobIds, e.JobId)
+		}
+	}
+	return jobIds
+}
+
+func TestAggregatedQueueServer_GetCordons(t *testing.T) {
+	withMaintenanceWindowRepository(func(cordonRepository repository.CordonRepository, windowRepository repository.MaintenanceWindowRepository) {
+		now := time.Now().UTC().Truncate(time.Second)
+		q := &AggregatedQueueServer{
+			CordonRepository:            cordonRepository,
+			MaintenanceWindowRepository: windowRepository,
+			clock:                       clock.NewFakeClock(now),
+		}
+		require.NoError(t, cordonRepository.CordonQueue(&api.Cordon{Name: "cordoned"}))
+		require.NoError(t, windowRepository.StoreMaintenanceWindow(&api.MaintenanceWindow{Id: "1", Queue: "in-maintenance", Start: now, End: now.Add(time.Hour)}))
+		require.NoError(t, windowRepository.StoreMaintenanceWindow(&api.MaintenanceWindow{Id: "2", Queue: "later", Start: now.Add(time.Hour), End: now.Add(2 * time.Hour)}))
+		require.NoError(t, windowRepository.StoreMaintenanceWindow(&api.MaintenanceWindow{Id: "3", Executor: "in-maintenance", Start: now.Add(-time.Hour), End: now.Add(time.Minute)}))
+		require.NoError(t, cordonRepository.CordonExecutor(&api.Cordon{Name: "cordoned"}))
+
+		reason, cordonedQueues, err := q.getCordons("cluster")
+		require.NoError(t, err)
+		assert.Empty(t, reason)
+		assert.Equal(t, map[string]bool{"cordoned": true, "in-maintenance": true}, cordonedQueues)
+
+		for _, executorId := range []string{"cordoned", "in-maintenance"} {
+			reason, _, err = q.getCordons(executorId)
+			require.NoError(t, err)
+			assert.NotEmpty(t, reason, executorId)
+		}
+	})
+}
+
+func withMaintenanceWindowRepository(action func(repository.CordonRepository, repository.MaintenanceWindowRepository)) {
+	client := redis.NewClient(&redis.Options{Addr: "localhost:6379", DB: 10})
+	defer client.FlushDB()
+	defer client.Close()
+	client.FlushDB()
+	action(repository.NewRedisCordonRepository(client), repository.NewRedisMaintenanceWindowRepository(client))
+}
@@ -0,0 +1,172 @@
+package server
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/pointer"
+	"github.com/armadaproject/armada/internal/common/schedulers"
+	"github.com/armadaproject/armada/internal/common/util"
+	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/armadaevents"
+)
+
+const (
+	// Leased jobs are loaded in batches of this many jobs to check their runtime.
+	maxRuntimeBatchSize = 10000
+	// Jobs found to exceed their maximum runtime are reported and cancelled again if they're still leased this long
+	// after, e.g., because the cancellation was lost.
+	runtimeExceededTtl = time.Hour
+)
+
+// CancelJobsExceedingMaxRuntime cancels all running jobs that have been running for longer than their maximum runtime.
+// A JobRuntimeExceeded event is reported for each such job before it's cancelled. Only one server checks jobs at a
+// time, and each job is reported and cancelled once, even though it stays leased until the cancellation takes effect.
+// Only the legacy scheduler supports maximum runtimes, hence only its jobs are considered.
+func (srv *PulsarSubmitServer) CancelJobsExceedingMaxRuntime() {
+	if _, err := srv.MaxRuntimeRepository.ProcessMaxRuntimes(srv.cancelJobsExceedingMaxRuntimeOfQueues); err != nil {
+		log.WithError(err).Error("failed to check the runtime of jobs")
+	}
+}
+
+func (srv *PulsarSubmitServer) cancelJobsExceedingMaxRuntimeOfQueues() {
+	ctx := armadacontext.Background()
+	queues, err := srv.SubmitServer.queueRepository.GetAllQueues()
+	if err != nil {
+		log.WithError(err).Error("failed to get queues")
+		return
+	}
+	now := time.Now()
+	for _, q := range queues {
+		if err := srv.cancelJobsExceedingMaxRuntime(ctx, q.Name, now); err != nil {
+			log.WithError(err).Errorf("failed to cancel jobs exceeding their maximum runtime in queue %s", q.Name)
+		}
+	}
+}
+
+func (srv *PulsarSubmitServer) cancelJobsExceedingMaxRuntime(ctx *armadacontext.Context, queue string, now time.Time) error {
+	leasedIds, err := srv.SubmitServer.jobRepository.GetLeasedJobIds(queue)
+	if err != nil {
+		return err
+	}
+	var result error
+	for _, batch := range util.Batch(leasedIds, maxRuntimeBatchSize) {
+		if err := srv.cancelBatchExceedingMaxRuntime(ctx, queue, batch, now); err != nil {
+			result = err
+		}
+	}
+	return result
+}
+
+func (srv *PulsarSubmitServer) cancelBatchExceedingMaxRuntime(ctx *armadacontext.Context, queue string, leasedIds []string, now time.Time) error {
+	jobs, err := srv.SubmitServer.jobRepository.GetExistingJobsByIds(leasedIds)
+	if err != nil {
+		return err
+	}
+
+	maxRuntimeSeconds := make(map[string]uint32)
+	limitedIds := make([]string, 0)
+	for _, job := range jobs {
+		value, ok := job.Annotations[configuration.MaxRuntimeSecondsAnnotation]
+		if !ok {
+			continue
+		}
+		seconds, err := strconv.ParseUint(value, 10, 32)
+		if err != nil {
+			log.WithError(err).Warnf("invalid maximum runtime of job %s", job.Id)
+			continue
+		}
+		maxRuntimeSeconds[job.Id] = uint32(seconds)
+		limitedIds = append(limitedIds, job.Id)
+	}
+	if len(limitedIds) == 0 {
+		return nil
+	}
+
+	runInfos, err := srv.SubmitServer.jobRepository.GetJobRunInfos(limitedIds)
+	if err != nil {
+		return err
+	}
+	var exceededIds []string
+	clusterIds := make(map[string]string)
+	for _, jobId := range limitedIds {
+		runInfo, ok := runInfos[jobId]
+		if !ok || runInfo.StartTime.IsZero() {
+			continue
+		}
+		if now.Sub(runInfo.StartTime) > time.Duration(maxRuntimeSeconds[jobId])*time.Second {
+			exceededIds = append(exceededIds, jobId)
+			clusterIds[jobId] = runInfo.CurrentClusterId
+		}
+	}
+	// Jobs reported before are still leased because cancelling them hasn't taken effect yet.
+	exceededIds, err = srv.MaxRuntimeRepository.MarkRuntimeExceeded(exceededIds, runtimeExceededTtl)
+	if err != nil {
+		return err
+	}
+	exceeded := make(map[string]bool, len(exceededIds))
+	for _, jobId := range exceededIds {
+		exceeded[jobId] = true
+	}
+	exceededByJobSet := make(map[string][]*api.Job)
+	for _, job := range jobs {
+		if exceeded[job.Id] {
+			exceededByJobSet[job.JobSetId] = append(exceededByJobSet[job.JobSetId], job)
+		}
+	}
+
+	var result error
+	for jobSetId, exceeded := range exceededByJobSet {
+		if err := srv.cancelJobsWithExceededRuntime(ctx, queue, jobSetId, exceeded, clusterIds, maxRuntimeSeconds); err != nil {
+			log.WithError(err).Errorf("failed to cancel jobs of job set %s exceeding their maximum runtime", jobSetId)
+			result = err
+			// The jobs are reported and cancelled again by the next check.
+			jobIds := util.Map(exceeded, func(job *api.Job) string { return job.Id })
+			if err := srv.MaxRuntimeRepository.UnmarkRuntimeExceeded(jobIds); err != nil {
+				log.WithError(err).Errorf("failed to unmark jobs of job set %s exceeding their maximum runtime", jobSetId)
+			}
+		}
+	}
+	return result
+}
+
+func (srv *PulsarSubmitServer) cancelJobsWithExceededRuntime(
+	ctx *armadacontext.Context,
+	queue string,
+	jobSetId string,
+	jobs []*api.Job,
+	clusterIds map[string]string,
+	maxRuntimeSeconds map[string]uint32,
+) error {
+	if err := reportJobsRuntimeExceeded(srv.SubmitServer.eventStore, jobs, clusterIds, maxRuntimeSeconds); err != nil {
+		return err
+	}
+	// Cancellations are attributed to Armada itself, hence the empty user.
+	sequence := &armadaevents.EventSequence{
+		Queue:      queue,
+		JobSetName: jobSetId,
+		Events:     make([]*armadaevents.EventSequence_Event, 0, len(jobs)),
+	}
+	for _, job := range jobs {
+		jobId, err := armadaevents.ProtoUuidFromUlidString(job.Id)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		sequence.Events = append(sequence.Events, &armadaevents.EventSequence_Event{
+			Created: pointer.Now(),
+			Event: &armadaevents.EventSequence_Event_CancelJob{
+				CancelJob: &armadaevents.CancelJob{
+					JobId:  jobId,
+					Reason: fmt.Sprintf("run exceeded the maximum runtime of %d seconds", maxRuntimeSeconds[job.Id]),
+				},
+			},
+		})
+	}
+	return srv.publishToPulsar(ctx, []*armadaevents.EventSequence{sequence}, schedulers.Legacy)
+}
@@ -0,0 +1,103 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/go-redis/redis"
+	"github.com/gogo/protobuf/proto"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/armadaproject/armada/internal/armada/repository"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/mocks"
+	"github.com/armadaproject/armada/internal/common/pulsarutils"
+	"github.com/armadaproject/armada/internal/common/util"
+	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/armadaevents"
+)
+
+func TestPulsarSubmitServer_CancelJobsExceedingMaxRuntime(t *testing.T) {
+	withSubmitServerAndRepos(func(s *SubmitServer, jobRepo repository.JobRepository, events *repository.TestEventStore) {
+		ctrl := gomock.NewController(t)
+		producer := mocks.NewMockProducer(ctrl)
+		var published []*armadaevents.EventSequence
+		producer.
+			EXPECT().
+			SendAsync(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ *armadacontext.Context, msg *pulsar.ProducerMessage, callback func(pulsar.MessageID, *pulsar.ProducerMessage, error)) {
+				es := &armadaevents.EventSequence{}
+				require.NoError(t, proto.Unmarshal(msg.Payload, es))
+				published = append(published, es)
+				callback(pulsarutils.NewMessageId(len(published)), msg, nil)
+			}).AnyTimes()
+		producer.EXPECT().Flush().Return(nil).AnyTimes()
+		client := redis.NewClient(&redis.Options{Addr: "localhost:6379", DB: 10})
+		defer client.Close()
+		srv := &PulsarSubmitServer{
+			Producer:              producer,
+			SubmitServer:          s,
+			MaxAllowedMessageSize: 4 * 1024 * 1024,
+			MaxRuntimeRepository:  repository.NewRedisMaxRuntimeRepository(client),
+		}
+
+		// Of the jobs started an hour ago, only the one with a maximum runtime of a minute exceeds it.
+		jobSetId := util.NewULID()
+		request := createJobRequest(jobSetId, 3)
+		request.JobRequestItems[0].MaxRuntimeSeconds = 60
+		request.JobRequestItems[1].MaxRuntimeSeconds = 7200
+		response, err := s.SubmitJobs(context.Background(), request)
+		require.NoError(t, err)
+		var jobIds []string
+		for _, item := range response.JobResponseItems {
+			jobIds = append(jobIds, item.JobId)
+		}
+		_, err = jobRepo.TryLeaseJobs("cluster", map[string][]string{"test": jobIds})
+		require.NoError(t, err)
+		var startInfos []*repository.JobStartInfo
+		for _, jobId := range jobIds {
+			startInfos = append(startInfos, &repository.JobStartInfo{JobId: jobId, ClusterId: "cluster", StartTime: time.Now().Add(-time.Hour)})
+		}
+		_, err = jobRepo.UpdateStartTime(startInfos)
+		require.NoError(t, err)
+
+		// Nothing is checked while another server is checking jobs.
+		acquired, err := srv.MaxRuntimeRepository.ProcessMaxRuntimes(srv.CancelJobsExceedingMaxRuntime)
+		require.NoError(t, err)
+		assert.True(t, acquired)
+		assert.Empty(t, published)
+
+		// Jobs are reported and cancelled once, even though they stay leased until the cancellation takes effect.
+		srv.CancelJobsExceedingMaxRuntime()
+		srv.CancelJobsExceedingMaxRuntime()
+
+		var exceededJobIds []string
+		for _, event := range events.ReceivedEvents {
+			if e, ok := event.Events.(*api.EventMessage_RuntimeExceeded); ok {
+				assert.Equal(t, jobSetId, e.RuntimeExceeded.JobSetId)
+				assert.Equal(t, "cluster", e.RuntimeExceeded.ClusterId)
+				assert.Equal(t, uint32(60), e.RuntimeExceeded.MaxRuntimeSeconds)
+				exceededJobIds = append(exceededJobIds, e.RuntimeExceeded.JobId)
+			}
+		}
+		assert.Equal(t, []string{jobIds[0]}, exceededJobIds)
+
+		var cancelledJobIds []string
+		for _, sequence := range published {
+			assert.Empty(t, sequence.UserId)
+			for _, event := range sequence.Events {
+				if e, ok := event.Event.(*armadaevents.EventSequence_Event_CancelJob); ok {
+					assert.Equal(t, jobSetId, sequence.JobSetName)
+					jobId, err := armadaevents.UlidStringFromProtoUuid(e.CancelJob.JobId)
+					require.NoError(t, err)
+					cancelledJobIds = append(cancelledJobIds, jobId)
+				}
+			}
+		}
+		assert.Equal(t, []string{jobIds[0]}, cancelledJobIds)
+	})
+}
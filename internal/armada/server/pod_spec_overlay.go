@@ -0,0 +1,65 @@
+package server
+
+import (
+	"encoding/json"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	"github.com/pkg/errors"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+// composePodSpecs sets the pod spec of item to the base pod spec of the request with the overlays of item applied.
+// Items that set a pod spec of their own are left unchanged.
+func composePodSpecs(request *api.JobSubmitRequest, item *api.JobSubmitRequestItem) error {
+	hasPodSpec := item.PodSpec != nil || len(item.PodSpecs) > 0
+	if len(item.PodSpecOverlays) > 0 {
+		if request.BasePodSpec == nil {
+			return errors.New("podSpecOverlays may only be used if the request has a basePodSpec")
+		}
+		if hasPodSpec {
+			return errors.New("podSpecOverlays may not be used together with podSpec or podSpecs")
+		}
+	}
+	if request.BasePodSpec == nil || hasPodSpec {
+		return nil
+	}
+	podSpec, err := applyPodSpecOverlays(request.BasePodSpec, item.PodSpecOverlays)
+	if err != nil {
+		return err
+	}
+	item.PodSpecs = []*v1.PodSpec{podSpec}
+	return nil
+}
+
+// applyPodSpecOverlays returns a copy of base with overlays applied in order.
+func applyPodSpecOverlays(base *v1.PodSpec, overlays []*api.PodSpecOverlay) (*v1.PodSpec, error) {
+	podSpecJson, err := json.Marshal(base)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	for i, overlay := range overlays {
+		switch overlay.Type {
+		case api.PodSpecOverlayType_StrategicMergePatch:
+			podSpecJson, err = strategicpatch.StrategicMergePatch(podSpecJson, []byte(overlay.Patch), v1.PodSpec{})
+		case api.PodSpecOverlayType_JsonPatch:
+			var patch jsonpatch.Patch
+			patch, err = jsonpatch.DecodePatch([]byte(overlay.Patch))
+			if err == nil {
+				podSpecJson, err = patch.Apply(podSpecJson)
+			}
+		default:
+			err = errors.Errorf("unknown overlay type %s", overlay.Type)
+		}
+		if err != nil {
+			return nil, errors.WithMessagef(err, "error applying the %d-th podSpecOverlay", i)
+		}
+	}
+	podSpec := &v1.PodSpec{}
+	if err := json.Unmarshal(podSpecJson, podSpec); err != nil {
+		return nil, errors.WithMessage(err, "error decoding the pod spec obtained by applying podSpecOverlays")
+	}
+	return podSpec, nil
+}
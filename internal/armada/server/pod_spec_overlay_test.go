@@ -0,0 +1,110 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+func TestApplyPodSpecOverlays(t *testing.T) {
+	base := &v1.PodSpec{
+		Containers: []v1.Container{
+			{Name: "main", Image: "app:latest", Env: []v1.EnvVar{{Name: "ENV", Value: "dev"}}},
+			{Name: "sidecar", Image: "sidecar:latest"},
+		},
+		NodeSelector: map[string]string{"pool": "cpu"},
+	}
+	tests := map[string]struct {
+		overlays    []*api.PodSpecOverlay
+		expected    *v1.PodSpec
+		expectError bool
+	}{
+		"no overlays": {
+			expected: base,
+		},
+		"strategic merge patch merges containers by name": {
+			overlays: []*api.PodSpecOverlay{{
+				Type:  api.PodSpecOverlayType_StrategicMergePatch,
+				Patch: `{"containers": [{"name": "main", "env": [{"name": "ENV", "value": "prod"}]}], "nodeSelector": {"zone": "a"}}`,
+			}},
+			expected: &v1.PodSpec{
+				Containers: []v1.Container{
+					{Name: "main", Image: "app:latest", Env: []v1.EnvVar{{Name: "ENV", Value: "prod"}}},
+					{Name: "sidecar", Image: "sidecar:latest"},
+				},
+				NodeSelector: map[string]string{"pool": "cpu", "zone": "a"},
+			},
+		},
+		"overlays are applied in order": {
+			overlays: []*api.PodSpecOverlay{
+				{
+					Type:  api.PodSpecOverlayType_JsonPatch,
+					Patch: `[{"op": "replace", "path": "/containers/0/image", "value": "app:v1"}, {"op": "remove", "path": "/containers/1"}]`,
+				},
+				{
+					Type:  api.PodSpecOverlayType_StrategicMergePatch,
+					Patch: `{"containers": [{"name": "main", "image": "app:v2"}]}`,
+				},
+			},
+			expected: &v1.PodSpec{
+				Containers: []v1.Container{
+					{Name: "main", Image: "app:v2", Env: []v1.EnvVar{{Name: "ENV", Value: "dev"}}},
+				},
+				NodeSelector: map[string]string{"pool": "cpu"},
+			},
+		},
+		"invalid json patch": {
+			overlays: []*api.PodSpecOverlay{{
+				Type:  api.PodSpecOverlayType_JsonPatch,
+				Patch: `[{"op": "remove", "path": "/containers/5"}]`,
+			}},
+			expectError: true,
+		},
+		"malformed strategic merge patch": {
+			overlays: []*api.PodSpecOverlay{{
+				Type:  api.PodSpecOverlayType_StrategicMergePatch,
+				Patch: `{"containers": `,
+			}},
+			expectError: true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			actual, err := applyPodSpecOverlays(base, tc.overlays)
+			if tc.expectError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+	// The base pod spec is never modified.
+	assert.Equal(t, "app:latest", base.Containers[0].Image)
+}
+
+func TestComposePodSpecs(t *testing.T) {
+	base := &v1.PodSpec{Containers: []v1.Container{{Name: "main", Image: "app:latest"}}}
+	overlay := &api.PodSpecOverlay{Type: api.PodSpecOverlayType_StrategicMergePatch, Patch: `{"containers": [{"name": "main", "image": "app:v1"}]}`}
+
+	item := &api.JobSubmitRequestItem{PodSpecOverlays: []*api.PodSpecOverlay{overlay}}
+	require.NoError(t, composePodSpecs(&api.JobSubmitRequest{BasePodSpec: base}, item))
+	require.Len(t, item.PodSpecs, 1)
+	assert.Equal(t, "app:v1", item.PodSpecs[0].Containers[0].Image)
+
+	// Items with a pod spec of their own don't use the base pod spec.
+	podSpec := &v1.PodSpec{Containers: []v1.Container{{Name: "other"}}}
+	item = &api.JobSubmitRequestItem{PodSpecs: []*v1.PodSpec{podSpec}}
+	require.NoError(t, composePodSpecs(&api.JobSubmitRequest{BasePodSpec: base}, item))
+	assert.Equal(t, []*v1.PodSpec{podSpec}, item.PodSpecs)
+
+	item = &api.JobSubmitRequestItem{PodSpecs: []*v1.PodSpec{podSpec}, PodSpecOverlays: []*api.PodSpecOverlay{overlay}}
+	assert.Error(t, composePodSpecs(&api.JobSubmitRequest{BasePodSpec: base}, item))
+
+	item = &api.JobSubmitRequestItem{PodSpecOverlays: []*api.PodSpecOverlay{overlay}}
+	assert.Error(t, composePodSpecs(&api.JobSubmitRequest{}, item))
+}
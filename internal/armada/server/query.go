@@ -0,0 +1,548 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/oklog/ulid"
+	"github.com/pkg/errors"
+	"golang.org/x/exp/slices"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/armadaproject/armada/internal/armada/permissions"
+	"github.com/armadaproject/armada/internal/armada/repository"
+	"github.com/armadaproject/armada/internal/armada/scheduling"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/armadaerrors"
+	"github.com/armadaproject/armada/internal/common/compress"
+	armadaresource "github.com/armadaproject/armada/internal/common/resource"
+	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/api/sequence"
+	"github.com/armadaproject/armada/pkg/client/queue"
+)
+
+const (
+	// Maximum number of jobs whose status may be requested at once.
+	maxJobStatusJobIds = 1000
+	// Number of events read from the event stream of a job set at a time.
+	jobStatusEventsBatchSize = 500
+	// Maximum number of events read from the event stream of a job set to reconstruct the state of some of its jobs.
+	maxJobSetEventsReplayed = 1000000
+	// Job ids are created by servers whose clocks may be ahead of that of Redis, which assigns the ids of events.
+	jobIdClockSkewAllowance = 10 * time.Minute
+	// Time for which WatchJobs waits for new events before checking whether the client has disconnected.
+	watchJobsBlockTime = 5 * time.Second
+	// Maximum number of days a usage report may span.
+	maxUsageReportDays = 366
+)
+
+// QueryServer reconstructs the status of jobs from the events of their job sets.
+type QueryServer struct {
+	authorizer      ActionAuthorizer
+	queueRepository repository.QueueRepository
+	eventRepository repository.EventRepository
+	// Diagnostic records of quarantined jobs. If nil, GetQuarantinedJob fails with Unimplemented.
+	QuarantineRepository repository.QuarantineRepository
+	// Jobs of the legacy scheduler. If nil, GetJobDetails fails with Unimplemented.
+	JobRepository repository.JobRepository
+	// Daily resource usage records. If nil, GetUsageReport fails with Unimplemented.
+	UsageRecordRepository repository.UsageRecordRepository
+	// Nodes reported by executors. If nil, GetNodeTypes fails with Unimplemented.
+	SchedulingInfoRepository repository.SchedulingInfoRepository
+}
+
+func NewQueryServer(
+	authorizer ActionAuthorizer,
+	queueRepository repository.QueueRepository,
+	eventRepository repository.EventRepository,
+) *QueryServer {
+	return &QueryServer{
+		authorizer:      authorizer,
+		queueRepository: queueRepository,
+		eventRepository: eventRepository,
+	}
+}
+
+func (s *QueryServer) GetJobStatus(grpcCtx context.Context, req *api.JobStatusRequest) (*api.JobStatusResponse, error) {
+	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+	if err := s.authorizeJobStatusRequest(ctx, "GetJobStatus", req); err != nil {
+		return nil, err
+	}
+	tracker := newJobStatusTracker(req.JobIds)
+	lastId, err := s.eventRepository.GetLastMessageId(req.Queue, req.JobSetId)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "[GetJobStatus] error getting id of last event: %s", err)
+	}
+	if _, err := readJobSetEvents(s.eventRepository, req.Queue, req.JobSetId, firstJobEventId(req.JobIds), lastId, func(message *api.EventStreamMessage) { tracker.apply(message) }); err != nil {
+		return nil, jobSetEventsError("GetJobStatus", err)
+	}
+	return &api.JobStatusResponse{JobStatuses: tracker.statuses()}, nil
+}
+
+func (s *QueryServer) WatchJobs(req *api.JobStatusRequest, stream api.Query_WatchJobsServer) error {
+	ctx := armadacontext.FromGrpcCtx(stream.Context())
+	if err := s.authorizeJobStatusRequest(ctx, "WatchJobs", req); err != nil {
+		return err
+	}
+	tracker := newJobStatusTracker(req.JobIds)
+	lastId, err := s.eventRepository.GetLastMessageId(req.Queue, req.JobSetId)
+	if err != nil {
+		return status.Errorf(codes.Unavailable, "[WatchJobs] error getting id of last event: %s", err)
+	}
+	fromId, err := readJobSetEvents(s.eventRepository, req.Queue, req.JobSetId, firstJobEventId(req.JobIds), lastId, func(message *api.EventStreamMessage) { tracker.apply(message) })
+	if err != nil {
+		return jobSetEventsError("WatchJobs", err)
+	}
+	for _, jobStatus := range tracker.statuses() {
+		if err := stream.Send(jobStatus); err != nil {
+			return status.Errorf(codes.Unavailable, "[WatchJobs] error sending job status: %s", err)
+		}
+	}
+
+	for !tracker.allTerminal() {
+		select {
+		case <-ctx.Done():
+			return nil
+		default:
+		}
+		messages, lastMessageId, err := s.eventRepository.ReadEvents(req.Queue, req.JobSetId, fromId, jobStatusEventsBatchSize, watchJobsBlockTime)
+		if err != nil {
+			return status.Errorf(codes.Unavailable, "[WatchJobs] error reading events: %s", err)
+		}
+		if len(messages) == 0 && lastMessageId != nil {
+			fromId = lastMessageId.String()
+		}
+		for _, message := range messages {
+			fromId = message.Id
+			if jobStatus := tracker.apply(message); jobStatus != nil {
+				if err := stream.Send(jobStatus); err != nil {
+					return status.Errorf(codes.Unavailable, "[WatchJobs] error sending job status: %s", err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// errTooManyJobSetEvents is returned by readJobSetEvents if it would read more than maxJobSetEventsReplayed events.
+var errTooManyJobSetEvents = errors.Errorf("more than %d events would have to be read", maxJobSetEventsReplayed)
+
+// readJobSetEvents calls apply with each event of the given job set after fromId, until the event with id lastId
+// or the end of the stream is reached. Returns the id from which to read subsequent events.
+// Fails with errTooManyJobSetEvents once more than maxJobSetEventsReplayed events have been read.
+func readJobSetEvents(
+	eventRepository repository.EventRepository,
+	queue string,
+	jobSetId string,
+	fromId string,
+	lastId string,
+	apply func(message *api.EventStreamMessage),
+) (string, error) {
+	read := 0
+	for {
+		messages, lastMessageId, err := eventRepository.ReadEvents(queue, jobSetId, fromId, jobStatusEventsBatchSize, -1)
+		if err != nil {
+			return "", err
+		}
+		if len(messages) == 0 {
+			if lastMessageId == nil || lastMessageId.String() == fromId {
+				return fromId, nil
+			}
+			fromId = lastMessageId.String()
+			continue
+		}
+		if read += len(messages); read > maxJobSetEventsReplayed {
+			return "", errTooManyJobSetEvents
+		}
+		for _, message := range messages {
+			apply(message)
+			fromId = message.Id
+			if fromId == lastId {
+				return fromId, nil
+			}
+		}
+	}
+}
+
+// firstJobEventId returns the id from which to read the events of a job set to read all events of the given jobs.
+// Job ids are ULIDs, which start with the time the job was created at, and events are assigned ids by the time they're
+// stored at, so no event of a job precedes its id. Returns "", i.e., the start of the stream, if any id isn't a ULID.
+func firstJobEventId(jobIds []string) string {
+	var first uint64
+	for i, jobId := range jobIds {
+		id, err := ulid.Parse(jobId)
+		if err != nil {
+			return ""
+		}
+		if i == 0 || id.Time() < first {
+			first = id.Time()
+		}
+	}
+	allowance := uint64(jobIdClockSkewAllowance.Milliseconds())
+	if first <= allowance {
+		return ""
+	}
+	return (&sequence.ExternalSeqNo{Time: int64(first - allowance), Last: true}).String()
+}
+
+// jobSetEventsError returns the status with which method fails if it fails to read the events of a job set.
+func jobSetEventsError(method string, err error) error {
+	if errors.Is(err, errTooManyJobSetEvents) {
+		return status.Errorf(codes.ResourceExhausted, "[%s] error reading events: %s; request the status of more recently submitted jobs", method, err)
+	}
+	return status.Errorf(codes.Unavailable, "[%s] error reading events: %s", method, err)
+}
+
+// GetQuarantinedJob returns the diagnostic record of a quarantined job, provided the caller may watch its queue.
+func (s *QueryServer) GetQuarantinedJob(grpcCtx context.Context, req *api.QuarantinedJobRequest) (*api.QuarantinedJob, error) {
+	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+	if s.QuarantineRepository == nil {
+		return nil, status.Errorf(codes.Unimplemented, "[GetQuarantinedJob] jobs aren't quarantined by this server")
+	}
+	record, err := s.QuarantineRepository.GetQuarantinedJob(req.JobId)
+	var notFound *repository.ErrQuarantinedJobNotFound
+	if errors.As(err, &notFound) {
+		return nil, status.Errorf(codes.NotFound, "[GetQuarantinedJob] quarantined job %s not found", req.JobId)
+	} else if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "[GetQuarantinedJob] error getting quarantined job %s: %s", req.JobId, err)
+	}
+	if err := s.authorizeWatchJob(ctx, record.Queue, record.JobSetId); err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, status.Errorf(codes.NotFound, "[GetQuarantinedJob] quarantined job %s not found", req.JobId)
+		}
+		return nil, status.Errorf(status.Code(err), "[GetQuarantinedJob] %s", status.Convert(err).Message())
+	}
+	return record, nil
+}
+
+// GetJobDetails returns the stored spec and the state of a job, provided the caller may watch its queue.
+func (s *QueryServer) GetJobDetails(grpcCtx context.Context, req *api.JobDetailsRequest) (*api.JobDetails, error) {
+	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+	if s.JobRepository == nil {
+		return nil, status.Errorf(codes.Unimplemented, "[GetJobDetails] jobs aren't stored by this server")
+	}
+	jobs, err := s.JobRepository.GetExistingJobsByIds([]string{req.JobId})
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "[GetJobDetails] error getting job %s: %s", req.JobId, err)
+	}
+	if len(jobs) == 0 {
+		return nil, status.Errorf(codes.NotFound, "[GetJobDetails] job %s not found", req.JobId)
+	}
+	job := jobs[0]
+	if err := s.authorizeWatchJob(ctx, job.Queue, job.JobSetId); err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, status.Errorf(codes.NotFound, "[GetJobDetails] job %s not found", req.JobId)
+		}
+		return nil, status.Errorf(status.Code(err), "[GetJobDetails] %s", status.Convert(err).Message())
+	}
+
+	if len(job.CompressedQueueOwnershipUserGroups) > 0 {
+		groups, err := compress.DecompressStringArray(job.CompressedQueueOwnershipUserGroups, compress.NewCodecDecompressor())
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "[GetJobDetails] error decompressing queue ownership user groups of job %s: %s", req.JobId, err)
+		}
+		job.QueueOwnershipUserGroups = groups
+		job.CompressedQueueOwnershipUserGroups = nil
+	}
+	details, err := s.getJobDetails(job)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "[GetJobDetails] error getting state of job %s: %s", req.JobId, err)
+	}
+	return details, nil
+}
+
+// authorizeWatchJob checks that the caller may watch the job set of a job requested by id. Returns NotFound if the
+// caller may not watch it, or its queue no longer exists, such that callers can't tell whether jobs of queues they
+// can't watch exist.
+func (s *QueryServer) authorizeWatchJob(ctx *armadacontext.Context, queueName string, jobSetId string) error {
+	q, err := s.queueRepository.GetQueue(queueName)
+	var queueNotFound *repository.ErrQueueNotFound
+	if errors.As(err, &queueNotFound) {
+		return status.Errorf(codes.NotFound, "queue %s does not exist", queueName)
+	} else if err != nil {
+		return status.Errorf(codes.Unavailable, "error getting queue %s: %s", queueName, err)
+	}
+	if err := validateUserHasWatchPermissions(ctx, s.authorizer, q, jobSetId); status.Code(err) == codes.PermissionDenied {
+		return status.Errorf(codes.NotFound, "%s", status.Convert(err).Message())
+	} else if err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetUsageReport returns the daily resource usage of queues and their owners over a range of days.
+// Reports of all queues require the view_usage_reports permission; reports of a single queue may also be
+// requested by those who may watch it.
+func (s *QueryServer) GetUsageReport(grpcCtx context.Context, req *api.UsageReportRequest) (*api.UsageReport, error) {
+	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+	if s.UsageRecordRepository == nil {
+		return nil, status.Errorf(codes.Unimplemented, "[GetUsageReport] usage isn't recorded by this server")
+	}
+	start, err := time.Parse("2006-01-02", req.StartDate)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "[GetUsageReport] invalid start date %q: must be of the form YYYY-MM-DD", req.StartDate)
+	}
+	end := start
+	if req.EndDate != "" {
+		if end, err = time.Parse("2006-01-02", req.EndDate); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "[GetUsageReport] invalid end date %q: must be of the form YYYY-MM-DD", req.EndDate)
+		}
+	}
+	if end.Before(start) {
+		return nil, status.Errorf(codes.InvalidArgument, "[GetUsageReport] end date %s is before start date %s", req.EndDate, req.StartDate)
+	}
+	if days := int(end.Sub(start).Hours()/24) + 1; days > maxUsageReportDays {
+		return nil, status.Errorf(codes.InvalidArgument, "[GetUsageReport] reports may span at most %d days, but %d were requested", maxUsageReportDays, days)
+	}
+	if err := s.authorizeUsageReportRequest(ctx, req); err != nil {
+		return nil, err
+	}
+
+	records, err := s.UsageRecordRepository.GetUsageRecords(start, end)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "[GetUsageReport] error getting usage records: %s", err)
+	}
+	report := &api.UsageReport{}
+	for _, record := range records {
+		if (req.Queue == "" || record.Queue == req.Queue) && (req.Owner == "" || record.Owner == req.Owner) {
+			report.Records = append(report.Records, record)
+		}
+	}
+	return report, nil
+}
+
+// GetNodeTypes returns the types of nodes of each recently active cluster, optionally filtered by cluster, pool,
+// labels, and allocatable resources, such that users may check whether any cluster could run a job before submitting it.
+func (s *QueryServer) GetNodeTypes(_ context.Context, req *api.NodeTypesRequest) (*api.NodeTypesResponse, error) {
+	if s.SchedulingInfoRepository == nil {
+		return nil, status.Errorf(codes.Unimplemented, "[GetNodeTypes] nodes aren't reported to this server")
+	}
+	minAllocatable := make(armadaresource.ComputeResources, len(req.MinAllocatable))
+	for t, value := range req.MinAllocatable {
+		q, err := resource.ParseQuantity(value)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "[GetNodeTypes] invalid quantity %q of resource %s: %s", value, t, err)
+		}
+		minAllocatable[t] = q
+	}
+
+	reports, err := s.SchedulingInfoRepository.GetClusterSchedulingInfo()
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "[GetNodeTypes] error getting cluster scheduling info: %s", err)
+	}
+	response := &api.NodeTypesResponse{}
+	for _, report := range scheduling.FilterActiveClusterSchedulingInfoReports(reports) {
+		if (req.ClusterId != "" && report.ClusterId != req.ClusterId) || (req.Pool != "" && report.Pool != req.Pool) {
+			continue
+		}
+		var nodeTypes []*api.NodeType
+		for _, nodeType := range report.NodeTypes {
+			if nodeTypeMatches(nodeType, req.Labels, minAllocatable) {
+				nodeTypes = append(nodeTypes, nodeType)
+			}
+		}
+		if len(nodeTypes) > 0 {
+			response.Clusters = append(response.Clusters, &api.ClusterNodeTypes{
+				ClusterId:  report.ClusterId,
+				Pool:       report.Pool,
+				ReportTime: report.ReportTime,
+				NodeTypes:  nodeTypes,
+			})
+		}
+	}
+	slices.SortFunc(response.Clusters, func(a, b *api.ClusterNodeTypes) bool { return a.ClusterId < b.ClusterId })
+	return response, nil
+}
+
+// nodeTypeMatches returns true if nodeType has all the given labels and at least minAllocatable resources allocatable.
+func nodeTypeMatches(nodeType *api.NodeType, labels map[string]string, minAllocatable armadaresource.ComputeResources) bool {
+	for k, v := range labels {
+		if value, ok := nodeType.Labels[k]; !ok || value != v {
+			return false
+		}
+	}
+	for t, minimum := range minAllocatable {
+		allocatable, ok := nodeType.AllocatableResources[t]
+		if !ok || allocatable.Cmp(minimum) < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func (s *QueryServer) authorizeUsageReportRequest(ctx *armadacontext.Context, req *api.UsageReportRequest) error {
+	var err error
+	if req.Queue == "" {
+		err = s.authorizer.AuthorizeAction(ctx, permissions.ViewUsageReports)
+	} else {
+		q, getErr := s.queueRepository.GetQueue(req.Queue)
+		var queueNotFound *repository.ErrQueueNotFound
+		if errors.As(getErr, &queueNotFound) {
+			return status.Errorf(codes.NotFound, "[GetUsageReport] queue %s does not exist", req.Queue)
+		} else if getErr != nil {
+			return status.Errorf(codes.Unavailable, "[GetUsageReport] error getting queue %s: %s", req.Queue, getErr)
+		}
+		err = s.authorizer.AuthorizeQueueAction(ctx, q, permissions.ViewUsageReports, queue.PermissionVerbWatch)
+	}
+	var permErr *armadaerrors.ErrUnauthorized
+	if errors.As(err, &permErr) {
+		return status.Errorf(codes.PermissionDenied, "[GetUsageReport] error: %s", permErr)
+	} else if err != nil {
+		return status.Errorf(codes.Unavailable, "[GetUsageReport] error checking permissions: %s", err)
+	}
+	return nil
+}
+
+// getJobDetails returns job together with its state, which is derived from where the job is stored.
+func (s *QueryServer) getJobDetails(job *api.Job) (*api.JobDetails, error) {
+	details := &api.JobDetails{Job: job, State: api.JobState_QUEUED}
+	clusterIds, err := s.JobRepository.GetLeasedJobClusterIds([]string{job.Id})
+	if err != nil {
+		return nil, err
+	}
+	if clusterId, ok := clusterIds[job.Id]; ok {
+		details.State = api.JobState_PENDING
+		details.ClusterId = clusterId
+		runInfos, err := s.JobRepository.GetJobRunInfos([]string{job.Id})
+		if err != nil {
+			return nil, err
+		}
+		if runInfo, ok := runInfos[job.Id]; ok && !runInfo.StartTime.IsZero() {
+			details.State = api.JobState_RUNNING
+			details.Started = &runInfo.StartTime
+		}
+		return details, nil
+	}
+	suspendedIds, err := s.JobRepository.GetJobSetJobIds(job.Queue, job.JobSetId, &repository.JobSetFilter{IncludeSuspended: true})
+	if err != nil {
+		return nil, err
+	}
+	if slices.Contains(suspendedIds, job.Id) {
+		details.State = api.JobState_SUSPENDED
+	}
+	return details, nil
+}
+
+func (s *QueryServer) authorizeJobStatusRequest(ctx *armadacontext.Context, method string, req *api.JobStatusRequest) error {
+	if req.Queue == "" || req.JobSetId == "" {
+		return status.Errorf(codes.InvalidArgument, "[%s] queue and job set id must not be empty", method)
+	}
+	if len(req.JobIds) == 0 || len(req.JobIds) > maxJobStatusJobIds {
+		return status.Errorf(codes.InvalidArgument, "[%s] between 1 and %d job ids must be provided, but got %d", method, maxJobStatusJobIds, len(req.JobIds))
+	}
+	q, err := s.queueRepository.GetQueue(req.Queue)
+	var expected *repository.ErrQueueNotFound
+	if errors.As(err, &expected) {
+		return status.Errorf(codes.NotFound, "[%s] queue %s does not exist", method, req.Queue)
+	} else if err != nil {
+		return status.Errorf(codes.Unavailable, "[%s] error getting queue %s: %s", method, req.Queue, err)
+	}
+	if err := validateUserHasWatchPermissions(ctx, s.authorizer, q, req.JobSetId); err != nil {
+		return status.Errorf(status.Code(err), "[%s] %s", method, status.Convert(err).Message())
+	}
+	return nil
+}
+
+// jobStatusTracker reconstructs the status of a set of jobs from the events of their job set.
+type jobStatusTracker struct {
+	jobIds        []string
+	statusByJobId map[string]*api.JobStatus
+}
+
+func newJobStatusTracker(jobIds []string) *jobStatusTracker {
+	statusByJobId := make(map[string]*api.JobStatus, len(jobIds))
+	for _, jobId := range jobIds {
+		statusByJobId[jobId] = &api.JobStatus{JobId: jobId, State: api.JobState_UNKNOWN}
+	}
+	return &jobStatusTracker{jobIds: jobIds, statusByJobId: statusByJobId}
+}
+
+// apply updates the status of the job message is an event of, if it's tracked.
+// Returns a copy of the updated status, or nil if message isn't an event of a tracked job or doesn't change its state.
+func (t *jobStatusTracker) apply(message *api.EventStreamMessage) *api.JobStatus {
+	event, err := api.UnwrapEvent(message.Message)
+	if err != nil {
+		return nil
+	}
+	jobStatus, ok := t.statusByJobId[event.GetJobId()]
+	if !ok {
+		return nil
+	}
+	previous := *jobStatus
+	jobStatus.LastEvent = message.Message
+	jobStatus.LastEventId = message.Id
+	switch e := message.Message.Events.(type) {
+	case *api.EventMessage_Submitted, *api.EventMessage_Queued, *api.EventMessage_Resumed,
+		*api.EventMessage_LeaseReturned, *api.EventMessage_LeaseExpired, *api.EventMessage_EvictedForCapacity:
+		jobStatus.State = api.JobState_QUEUED
+		jobStatus.ClusterId = ""
+		jobStatus.NodeName = ""
+	case *api.EventMessage_Suspended:
+		jobStatus.State = api.JobState_SUSPENDED
+	case *api.EventMessage_Leased:
+		jobStatus.State = api.JobState_PENDING
+		jobStatus.ClusterId = e.Leased.ClusterId
+		jobStatus.NodeName = ""
+	case *api.EventMessage_Pending:
+		jobStatus.State = api.JobState_PENDING
+		jobStatus.ClusterId = e.Pending.ClusterId
+	case *api.EventMessage_Running:
+		jobStatus.State = api.JobState_RUNNING
+		jobStatus.ClusterId = e.Running.ClusterId
+		jobStatus.NodeName = e.Running.NodeName
+	case *api.EventMessage_Succeeded:
+		jobStatus.State = api.JobState_SUCCEEDED
+		setIfNotEmpty(&jobStatus.ClusterId, e.Succeeded.ClusterId)
+		setIfNotEmpty(&jobStatus.NodeName, e.Succeeded.NodeName)
+	case *api.EventMessage_Failed:
+		if e.Failed.WillRetry {
+			// The job is returned to the queue once its executor has cleaned up the failed run.
+			jobStatus.State = api.JobState_QUEUED
+			jobStatus.ClusterId = ""
+			jobStatus.NodeName = ""
+			break
+		}
+		jobStatus.State = api.JobState_FAILED
+		setIfNotEmpty(&jobStatus.ClusterId, e.Failed.ClusterId)
+		setIfNotEmpty(&jobStatus.NodeName, e.Failed.NodeName)
+	case *api.EventMessage_FailedCompressed:
+		jobStatus.State = api.JobState_FAILED
+	case *api.EventMessage_Cancelled:
+		jobStatus.State = api.JobState_CANCELLED
+	}
+	if jobStatus.State == previous.State && jobStatus.ClusterId == previous.ClusterId && jobStatus.NodeName == previous.NodeName {
+		return nil
+	}
+	updated := *jobStatus
+	return &updated
+}
+
+// statuses returns the status of each tracked job, in the order of the job ids the tracker was created with.
+func (t *jobStatusTracker) statuses() []*api.JobStatus {
+	statuses := make([]*api.JobStatus, len(t.jobIds))
+	for i, jobId := range t.jobIds {
+		jobStatus := *t.statusByJobId[jobId]
+		statuses[i] = &jobStatus
+	}
+	return statuses
+}
+
+// allTerminal returns true if every tracked job has succeeded, failed, or been cancelled.
+func (t *jobStatusTracker) allTerminal() bool {
+	for _, jobStatus := range t.statusByJobId {
+		switch jobStatus.State {
+		case api.JobState_SUCCEEDED, api.JobState_FAILED, api.JobState_CANCELLED:
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+func setIfNotEmpty(field *string, value string) {
+	if value != "" {
+		*field = value
+	}
+}
@@ -0,0 +1,425 @@
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/oklog/ulid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/armadaproject/armada/internal/armada/repository"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/api/sequence"
+	"github.com/armadaproject/armada/pkg/client/queue"
+)
+
+func TestQueryServer_GetJobStatus(t *testing.T) {
+	events := &fakeEventRepository{}
+	events.add(
+		&api.EventMessage{Events: &api.EventMessage_Submitted{Submitted: &api.JobSubmittedEvent{JobId: "job-1"}}},
+		&api.EventMessage{Events: &api.EventMessage_Submitted{Submitted: &api.JobSubmittedEvent{JobId: "job-2"}}},
+		&api.EventMessage{Events: &api.EventMessage_Submitted{Submitted: &api.JobSubmittedEvent{JobId: "job-3"}}},
+		&api.EventMessage{Events: &api.EventMessage_Leased{Leased: &api.JobLeasedEvent{JobId: "job-1", ClusterId: "cluster-1"}}},
+		&api.EventMessage{Events: &api.EventMessage_Leased{Leased: &api.JobLeasedEvent{JobId: "job-2", ClusterId: "cluster-1"}}},
+		&api.EventMessage{Events: &api.EventMessage_Running{Running: &api.JobRunningEvent{JobId: "job-1", ClusterId: "cluster-1", NodeName: "node-1"}}},
+		&api.EventMessage{Events: &api.EventMessage_Succeeded{Succeeded: &api.JobSucceededEvent{JobId: "job-1", ClusterId: "cluster-1"}}},
+		&api.EventMessage{Events: &api.EventMessage_LeaseReturned{LeaseReturned: &api.JobLeaseReturnedEvent{JobId: "job-2", ClusterId: "cluster-1"}}},
+		&api.EventMessage{Events: &api.EventMessage_Cancelled{Cancelled: &api.JobCancelledEvent{JobId: "job-3"}}},
+	)
+	s := newTestQueryServer(t, &FakeActionAuthorizer{}, events)
+
+	response, err := s.GetJobStatus(armadacontext.Background(), &api.JobStatusRequest{
+		Queue:    "queue",
+		JobSetId: "set",
+		JobIds:   []string{"job-2", "job-1", "job-3", "job-4"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []*api.JobStatus{
+		{JobId: "job-2", State: api.JobState_QUEUED, LastEvent: events.messages[7].Message, LastEventId: events.messages[7].Id},
+		{
+			JobId:       "job-1",
+			State:       api.JobState_SUCCEEDED,
+			LastEvent:   events.messages[6].Message,
+			LastEventId: events.messages[6].Id,
+			ClusterId:   "cluster-1",
+			NodeName:    "node-1",
+		},
+		{JobId: "job-3", State: api.JobState_CANCELLED, LastEvent: events.messages[8].Message, LastEventId: events.messages[8].Id},
+		{JobId: "job-4", State: api.JobState_UNKNOWN},
+	}, response.JobStatuses)
+}
+
+func TestQueryServer_GetJobStatus_InvalidRequest(t *testing.T) {
+	s := newTestQueryServer(t, &FakeActionAuthorizer{}, &fakeEventRepository{})
+	ctx := armadacontext.Background()
+
+	_, err := s.GetJobStatus(ctx, &api.JobStatusRequest{Queue: "queue", JobIds: []string{"job-1"}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = s.GetJobStatus(ctx, &api.JobStatusRequest{Queue: "queue", JobSetId: "set"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = s.GetJobStatus(ctx, &api.JobStatusRequest{Queue: "missing", JobSetId: "set", JobIds: []string{"job-1"}})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	s = newTestQueryServer(t, &FakeDenyAllActionAuthorizer{}, &fakeEventRepository{})
+	_, err = s.GetJobStatus(ctx, &api.JobStatusRequest{Queue: "queue", JobSetId: "set", JobIds: []string{"job-1"}})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestFirstJobEventId(t *testing.T) {
+	earlier := ulid.MustNew(ulid.Timestamp(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)), rand.Reader)
+	later := ulid.MustNew(ulid.Timestamp(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)), rand.Reader)
+
+	fromId := firstJobEventId([]string{strings.ToLower(later.String()), strings.ToLower(earlier.String())})
+	from, err := sequence.Parse(fromId)
+	require.NoError(t, err)
+	assert.Equal(t, int64(earlier.Time())-jobIdClockSkewAllowance.Milliseconds(), from.Time)
+
+	// The events of jobs whose ids aren't ULIDs are read from the start of the stream.
+	assert.Equal(t, "", firstJobEventId([]string{earlier.String(), "job-1"}))
+}
+
+func TestQueryServer_WatchJobs(t *testing.T) {
+	events := &fakeEventRepository{}
+	events.add(
+		&api.EventMessage{Events: &api.EventMessage_Submitted{Submitted: &api.JobSubmittedEvent{JobId: "job-1"}}},
+		&api.EventMessage{Events: &api.EventMessage_Submitted{Submitted: &api.JobSubmittedEvent{JobId: "job-2"}}},
+		&api.EventMessage{Events: &api.EventMessage_Failed{Failed: &api.JobFailedEvent{JobId: "job-2", ClusterId: "cluster-1"}}},
+	)
+	// Events reported once the watch has started.
+	events.pending = []*api.EventMessage{
+		{Events: &api.EventMessage_Queued{Queued: &api.JobQueuedEvent{JobId: "job-1"}}},
+		{Events: &api.EventMessage_Leased{Leased: &api.JobLeasedEvent{JobId: "job-1", ClusterId: "cluster-1"}}},
+		{Events: &api.EventMessage_Utilisation{Utilisation: &api.JobUtilisationEvent{JobId: "job-1"}}},
+		{Events: &api.EventMessage_Running{Running: &api.JobRunningEvent{JobId: "job-1", ClusterId: "cluster-1", NodeName: "node-1"}}},
+		{Events: &api.EventMessage_Succeeded{Succeeded: &api.JobSucceededEvent{JobId: "job-1", ClusterId: "cluster-1", NodeName: "node-1"}}},
+	}
+	s := newTestQueryServer(t, &FakeActionAuthorizer{}, events)
+
+	stream := &jobStatusStreamMock{ctx: armadacontext.Background()}
+	err := s.WatchJobs(&api.JobStatusRequest{Queue: "queue", JobSetId: "set", JobIds: []string{"job-1", "job-2"}}, stream)
+	require.NoError(t, err)
+
+	var states []string
+	for _, jobStatus := range stream.sent {
+		states = append(states, fmt.Sprintf("%s %s %s %s", jobStatus.JobId, jobStatus.State, jobStatus.ClusterId, jobStatus.NodeName))
+	}
+	assert.Equal(t, []string{
+		"job-1 QUEUED  ",
+		"job-2 FAILED cluster-1 ",
+		"job-1 PENDING cluster-1 ",
+		"job-1 RUNNING cluster-1 node-1",
+		"job-1 SUCCEEDED cluster-1 node-1",
+	}, states)
+}
+
+func TestQueryServer_WatchJobs_StopsWhenClientDisconnects(t *testing.T) {
+	events := &fakeEventRepository{}
+	events.add(&api.EventMessage{Events: &api.EventMessage_Submitted{Submitted: &api.JobSubmittedEvent{JobId: "job-1"}}})
+	s := newTestQueryServer(t, &FakeActionAuthorizer{}, events)
+
+	ctx, cancel := armadacontext.WithTimeout(armadacontext.Background(), 100*time.Millisecond)
+	defer cancel()
+	stream := &jobStatusStreamMock{ctx: ctx}
+	err := s.WatchJobs(&api.JobStatusRequest{Queue: "queue", JobSetId: "set", JobIds: []string{"job-1"}}, stream)
+	require.NoError(t, err)
+	require.Len(t, stream.sent, 1)
+	assert.Equal(t, api.JobState_QUEUED, stream.sent[0].State)
+}
+
+func TestQueryServer_GetQuarantinedJob(t *testing.T) {
+	ctx := armadacontext.Background()
+	record := &api.QuarantinedJob{
+		JobId:              "job-1",
+		Queue:              "queue",
+		JobSetId:           "set",
+		LeaseReturns:       3,
+		RecentLeaseReturns: []*api.JobLeaseReturnedEvent{{JobId: "job-1", Reason: "pod creation failed"}},
+	}
+	quarantineRepository := newFakeQuarantineRepository()
+	require.NoError(t, quarantineRepository.QuarantineJob(record, time.Hour))
+
+	s := newTestQueryServer(t, &FakeActionAuthorizer{}, &fakeEventRepository{})
+	_, err := s.GetQuarantinedJob(ctx, &api.QuarantinedJobRequest{JobId: "job-1"})
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+
+	s.QuarantineRepository = quarantineRepository
+	quarantined, err := s.GetQuarantinedJob(ctx, &api.QuarantinedJobRequest{JobId: "job-1"})
+	require.NoError(t, err)
+	assert.Equal(t, record, quarantined)
+	_, err = s.GetQuarantinedJob(ctx, &api.QuarantinedJobRequest{JobId: "job-2"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// Callers that may not watch the queue of a job can't tell whether it's quarantined.
+	s = newTestQueryServer(t, &FakeDenyAllActionAuthorizer{}, &fakeEventRepository{})
+	s.QuarantineRepository = quarantineRepository
+	_, err = s.GetQuarantinedJob(ctx, &api.QuarantinedJobRequest{JobId: "job-1"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, notFoundErr := s.GetQuarantinedJob(ctx, &api.QuarantinedJobRequest{JobId: "job-2"})
+	assert.Equal(t, strings.Replace(status.Convert(notFoundErr).Message(), "job-2", "job-1", 1), status.Convert(err).Message())
+}
+
+func TestQueryServer_GetJobDetails(t *testing.T) {
+	ctx := armadacontext.Background()
+	s := newTestQueryServer(t, &FakeActionAuthorizer{}, &fakeEventRepository{})
+	_, err := s.GetJobDetails(ctx, &api.JobDetailsRequest{JobId: "job-1"})
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+
+	client := redis.NewClient(&redis.Options{Addr: "localhost:6379", DB: 11})
+	defer client.Close()
+	jobRepository := repository.NewRedisJobRepository(client)
+	s.JobRepository = jobRepository
+	var jobs []*api.Job
+	for _, jobId := range []string{"job-1", "job-2", "job-3"} {
+		jobs = append(jobs, &api.Job{
+			Id:          jobId,
+			Queue:       "queue",
+			JobSetId:    "set",
+			Owner:       "owner",
+			Annotations: map[string]string{"a": "b"},
+			PodSpec:     &v1.PodSpec{Containers: []v1.Container{{Name: "container", Image: "image"}}},
+			Created:     time.Now().UTC(),
+		})
+	}
+	_, err = jobRepository.AddJobs(jobs)
+	require.NoError(t, err)
+	_, err = jobRepository.TryLeaseJobs("cluster-1", map[string][]string{"queue": {"job-2", "job-3"}})
+	require.NoError(t, err)
+	started := time.Now().Add(-time.Minute).UTC()
+	_, err = jobRepository.UpdateStartTime([]*repository.JobStartInfo{{JobId: "job-3", ClusterId: "cluster-1", StartTime: started}})
+	require.NoError(t, err)
+
+	details, err := s.GetJobDetails(ctx, &api.JobDetailsRequest{JobId: "job-1"})
+	require.NoError(t, err)
+	assert.Equal(t, api.JobState_QUEUED, details.State)
+	assert.Equal(t, "owner", details.Job.Owner)
+	assert.Equal(t, map[string]string{"a": "b"}, details.Job.Annotations)
+	assert.Equal(t, "image", details.Job.GetMainPodSpec().Containers[0].Image)
+	assert.Empty(t, details.ClusterId)
+
+	details, err = s.GetJobDetails(ctx, &api.JobDetailsRequest{JobId: "job-2"})
+	require.NoError(t, err)
+	assert.Equal(t, api.JobState_PENDING, details.State)
+	assert.Equal(t, "cluster-1", details.ClusterId)
+	assert.Nil(t, details.Started)
+
+	details, err = s.GetJobDetails(ctx, &api.JobDetailsRequest{JobId: "job-3"})
+	require.NoError(t, err)
+	assert.Equal(t, api.JobState_RUNNING, details.State)
+	require.NotNil(t, details.Started)
+	assert.True(t, started.Equal(*details.Started))
+
+	_, err = s.GetJobDetails(ctx, &api.JobDetailsRequest{JobId: "job-4"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// Callers that may not watch the queue of a job can't tell whether it exists.
+	s.authorizer = &FakeDenyAllActionAuthorizer{}
+	_, err = s.GetJobDetails(ctx, &api.JobDetailsRequest{JobId: "job-1"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, notFoundErr := s.GetJobDetails(ctx, &api.JobDetailsRequest{JobId: "job-4"})
+	assert.Equal(t, strings.Replace(status.Convert(notFoundErr).Message(), "job-4", "job-1", 1), status.Convert(err).Message())
+}
+
+func TestQueryServer_GetUsageReport(t *testing.T) {
+	ctx := armadacontext.Background()
+	s := newTestQueryServer(t, &FakeActionAuthorizer{}, &fakeEventRepository{})
+	_, err := s.GetUsageReport(ctx, &api.UsageReportRequest{StartDate: "2023-01-01"})
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+
+	client := redis.NewClient(&redis.Options{Addr: "localhost:6379", DB: 11})
+	defer client.Close()
+	usageRecordRepository := repository.NewRedisUsageRecordRepository(client, time.Hour)
+	s.UsageRecordRepository = usageRecordRepository
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	date := today.Format("2006-01-02")
+	for _, run := range []*repository.AccruingRun{
+		{JobId: "job-1", Queue: "queue", Owner: "alice", Resources: map[string]float64{"cpu": 2}, AccruedUntil: today},
+		{JobId: "job-2", Queue: "queue", Owner: "bob", Resources: map[string]float64{"cpu": 1}, AccruedUntil: today},
+		{JobId: "job-3", Queue: "other", Owner: "alice", Resources: map[string]float64{"cpu": 1}, AccruedUntil: today},
+	} {
+		require.NoError(t, usageRecordRepository.StartRun(run))
+		require.NoError(t, usageRecordRepository.AccrueRun(run.JobId, today.Add(time.Minute), true))
+	}
+
+	report, err := s.GetUsageReport(ctx, &api.UsageReportRequest{StartDate: date})
+	require.NoError(t, err)
+	assert.Len(t, report.Records, 3)
+
+	report, err = s.GetUsageReport(ctx, &api.UsageReportRequest{StartDate: date, EndDate: date, Queue: "queue", Owner: "alice"})
+	require.NoError(t, err)
+	assert.Equal(t, []*api.UsageRecord{
+		{Date: date, Queue: "queue", Owner: "alice", Resource: "cpu", ResourceSeconds: 120},
+	}, report.Records)
+
+	_, err = s.GetUsageReport(ctx, &api.UsageReportRequest{StartDate: date, Queue: "missing"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	for name, req := range map[string]*api.UsageReportRequest{
+		"no start date":     {},
+		"invalid end date":  {StartDate: "2023-01-01", EndDate: "01/02/2023"},
+		"end before start":  {StartDate: "2023-01-02", EndDate: "2023-01-01"},
+		"range is too long": {StartDate: "2022-01-01", EndDate: "2023-01-02"},
+	} {
+		_, err = s.GetUsageReport(ctx, req)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), name)
+	}
+
+	s.authorizer = &FakeDenyAllActionAuthorizer{}
+	_, err = s.GetUsageReport(ctx, &api.UsageReportRequest{StartDate: date})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	_, err = s.GetUsageReport(ctx, &api.UsageReportRequest{StartDate: date, Queue: "queue"})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+}
+
+func TestQueryServer_GetNodeTypes(t *testing.T) {
+	ctx := armadacontext.Background()
+	s := newTestQueryServer(t, &FakeActionAuthorizer{}, &fakeEventRepository{})
+	_, err := s.GetNodeTypes(ctx, &api.NodeTypesRequest{})
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+
+	client := redis.NewClient(&redis.Options{Addr: "localhost:6379", DB: 11})
+	defer client.Close()
+	schedulingInfoRepository := repository.NewRedisSchedulingInfoRepository(client)
+	s.SchedulingInfoRepository = schedulingInfoRepository
+	cpuNode := &api.NodeType{
+		Labels:               map[string]string{"type": "cpu"},
+		AllocatableResources: map[string]resource.Quantity{"cpu": resource.MustParse("64")},
+		NodeCount:            10,
+	}
+	gpuNode := &api.NodeType{
+		Labels:               map[string]string{"type": "gpu", "gpu": "a100"},
+		AllocatableResources: map[string]resource.Quantity{"cpu": resource.MustParse("32"), "nvidia.com/gpu": resource.MustParse("8")},
+		NodeCount:            2,
+	}
+	for _, report := range []*api.ClusterSchedulingInfoReport{
+		{ClusterId: "b", Pool: "cpu", ReportTime: time.Now(), NodeTypes: []*api.NodeType{cpuNode}},
+		{ClusterId: "a", Pool: "gpu", ReportTime: time.Now(), NodeTypes: []*api.NodeType{cpuNode, gpuNode}},
+		{ClusterId: "inactive", Pool: "gpu", ReportTime: time.Now().Add(-time.Hour), NodeTypes: []*api.NodeType{gpuNode}},
+	} {
+		require.NoError(t, schedulingInfoRepository.UpdateClusterSchedulingInfo(report))
+	}
+
+	clusterIdsAndNodeCounts := func(response *api.NodeTypesResponse) map[string][]int32 {
+		result := make(map[string][]int32)
+		for _, cluster := range response.Clusters {
+			for _, nodeType := range cluster.NodeTypes {
+				result[cluster.ClusterId] = append(result[cluster.ClusterId], nodeType.NodeCount)
+			}
+		}
+		return result
+	}
+	for name, tc := range map[string]struct {
+		req      *api.NodeTypesRequest
+		expected map[string][]int32
+	}{
+		"all":             {req: &api.NodeTypesRequest{}, expected: map[string][]int32{"a": {10, 2}, "b": {10}}},
+		"cluster":         {req: &api.NodeTypesRequest{ClusterId: "b"}, expected: map[string][]int32{"b": {10}}},
+		"pool":            {req: &api.NodeTypesRequest{Pool: "gpu"}, expected: map[string][]int32{"a": {10, 2}}},
+		"labels":          {req: &api.NodeTypesRequest{Labels: map[string]string{"gpu": "a100"}}, expected: map[string][]int32{"a": {2}}},
+		"min allocatable": {req: &api.NodeTypesRequest{MinAllocatable: map[string]string{"nvidia.com/gpu": "8"}}, expected: map[string][]int32{"a": {2}}},
+		"none":            {req: &api.NodeTypesRequest{MinAllocatable: map[string]string{"cpu": "128"}}, expected: map[string][]int32{}},
+	} {
+		response, err := s.GetNodeTypes(ctx, tc.req)
+		require.NoError(t, err, name)
+		assert.Equal(t, tc.expected, clusterIdsAndNodeCounts(response), name)
+	}
+
+	response, err := s.GetNodeTypes(ctx, &api.NodeTypesRequest{})
+	require.NoError(t, err)
+	require.Len(t, response.Clusters, 2)
+	assert.Equal(t, "a", response.Clusters[0].ClusterId)
+	assert.Equal(t, "b", response.Clusters[1].ClusterId)
+
+	_, err = s.GetNodeTypes(ctx, &api.NodeTypesRequest{MinAllocatable: map[string]string{"cpu": "lots"}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func newTestQueryServer(t *testing.T, authorizer ActionAuthorizer, events *fakeEventRepository) *QueryServer {
+	t.Helper()
+	client := redis.NewClient(&redis.Options{Addr: "localhost:6379", DB: 11})
+	client.FlushDB()
+	t.Cleanup(func() { client.FlushDB() })
+
+	queueRepository := repository.NewRedisQueueRepository(client)
+	require.NoError(t, queueRepository.CreateQueue(queue.Queue{Name: "queue", PriorityFactor: 1}))
+	return NewQueryServer(authorizer, queueRepository, events)
+}
+
+// fakeEventRepository serves the events of a single job set.
+// Events in pending are added one per call to ReadEvents, once all other events have been read.
+type fakeEventRepository struct {
+	messages []*api.EventStreamMessage
+	pending  []*api.EventMessage
+}
+
+func (r *fakeEventRepository) add(events ...*api.EventMessage) {
+	for _, event := range events {
+		id, err := sequence.FromRedisId(fmt.Sprintf("%d-0", len(r.messages)+1), 0, true)
+		if err != nil {
+			panic(err)
+		}
+		r.messages = append(r.messages, &api.EventStreamMessage{Id: id.String(), Message: event})
+	}
+}
+
+func (r *fakeEventRepository) CheckStreamExists(string, string) (bool, error) {
+	return len(r.messages) > 0, nil
+}
+
+func (r *fakeEventRepository) ReadEvents(_ string, _ string, lastId string, limit int64, _ time.Duration) ([]*api.EventStreamMessage, *sequence.ExternalSeqNo, error) {
+	start := 0
+	for i, message := range r.messages {
+		if message.Id == lastId {
+			start = i + 1
+		}
+	}
+	if start == len(r.messages) && len(r.pending) > 0 {
+		r.add(r.pending[0])
+		r.pending = r.pending[1:]
+	}
+	end := start + int(limit)
+	if end > len(r.messages) {
+		end = len(r.messages)
+	}
+	messages := r.messages[start:end]
+	if len(messages) == 0 {
+		return messages, nil, nil
+	}
+	lastMessageId, err := sequence.Parse(messages[len(messages)-1].Id)
+	return messages, lastMessageId, err
+}
+
+func (r *fakeEventRepository) GetLastMessageId(string, string) (string, error) {
+	if len(r.messages) == 0 {
+		return "0", nil
+	}
+	return r.messages[len(r.messages)-1].Id, nil
+}
+
+var _ repository.EventRepository = &fakeEventRepository{}
+
+type jobStatusStreamMock struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent []*api.JobStatus
+}
+
+func (s *jobStatusStreamMock) Send(jobStatus *api.JobStatus) error {
+	s.sent = append(s.sent, jobStatus)
+	return nil
+}
+
+func (s *jobStatusStreamMock) Context() context.Context {
+	return s.ctx
+}
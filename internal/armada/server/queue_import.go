@@ -0,0 +1,171 @@
+package server
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/armadaproject/armada/internal/armada/permissions"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/armadaerrors"
+	"github.com/armadaproject/armada/internal/common/auth/authorization"
+	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/client/queue"
+)
+
+// ExportQueues returns a YAML document of the queues whose names start with the prefix of req, ordered by name.
+func (server *SubmitServer) ExportQueues(_ context.Context, req *api.QueueExportRequest) (*api.QueueDocument, error) {
+	queues, err := server.queueRepository.GetAllQueues()
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "[ExportQueues] error getting queues: %s", err)
+	}
+	exported := make([]queue.Queue, 0, len(queues))
+	for _, q := range queues {
+		if strings.HasPrefix(q.Name, req.NamePrefix) {
+			exported = append(exported, q)
+		}
+	}
+	sort.Slice(exported, func(i, j int) bool { return exported[i].Name < exported[j].Name })
+	data, err := queue.MarshalDocument(exported)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "[ExportQueues] error marshalling queues: %s", err)
+	}
+	return &api.QueueDocument{Yaml: string(data)}, nil
+}
+
+// ImportQueues creates and updates queues such that they match the document of req and, if req.Prune is set, deletes
+// queues with the prefix of req not in the document. Creations and updates are each applied atomically; deletions are
+// applied one at a time as DeleteQueue does. Changes that fail are returned with their error, such that importing the
+// document again retries them.
+func (server *SubmitServer) ImportQueues(grpcCtx context.Context, req *api.QueueImportRequest) (*api.QueueImportResponse, error) {
+	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+	desired, err := queue.UnmarshalDocument([]byte(req.Yaml))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "[ImportQueues] %s", err)
+	}
+	for i, q := range desired {
+		if !strings.HasPrefix(q.Name, req.NamePrefix) {
+			return nil, status.Errorf(codes.InvalidArgument, "[ImportQueues] name of queue %s doesn't start with %q", q.Name, req.NamePrefix)
+		}
+		if desired[i], err = normalizeQueue(q); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "[ImportQueues] error validating queue %s: %s", q.Name, err)
+		}
+	}
+	parentErrs, err := server.validateQueueParents(desired)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "[ImportQueues] error validating queues: %s", err)
+	}
+	for i, err := range parentErrs {
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "[ImportQueues] error validating queue %s: %s", desired[i].Name, err)
+		}
+	}
+
+	existingQueues, err := server.primaryQueueRepository().GetAllQueues()
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "[ImportQueues] error getting queues: %s", err)
+	}
+	existingByName := make(map[string]queue.Queue, len(existingQueues))
+	for _, q := range existingQueues {
+		existingByName[q.Name] = q
+	}
+	var creates, updates []queue.Queue
+	var deletes []string
+	changeByName := make(map[string]*api.QueueDiff)
+	declared := make(map[string]bool, len(desired))
+	for _, q := range desired {
+		declared[q.Name] = true
+		existing, ok := existingByName[q.Name]
+		if !ok {
+			creates = append(creates, q)
+			changeByName[q.Name] = &api.QueueDiff{Name: q.Name, Type: api.QueueChangeType_QueueCreated}
+			continue
+		}
+		fields, err := queue.ChangedFields(existing, q)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "[ImportQueues] error comparing queue %s: %s", q.Name, err)
+		}
+		if len(fields) == 0 {
+			continue
+		}
+		// Changes made since the queues were compared aren't overwritten.
+		q.Revision = existing.Revision
+		updates = append(updates, q)
+		changeByName[q.Name] = &api.QueueDiff{Name: q.Name, Type: api.QueueChangeType_QueueUpdated, Fields: fields}
+	}
+	if req.Prune {
+		for _, q := range existingQueues {
+			if declared[q.Name] || !strings.HasPrefix(q.Name, req.NamePrefix) {
+				continue
+			}
+			deletes = append(deletes, q.Name)
+			changeByName[q.Name] = &api.QueueDiff{Name: q.Name, Type: api.QueueChangeType_QueueDeleted}
+		}
+	}
+	changes := make([]*api.QueueDiff, 0, len(changeByName))
+	for _, change := range changeByName {
+		changes = append(changes, change)
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Name < changes[j].Name })
+	if req.DryRun || len(changes) == 0 {
+		return &api.QueueImportResponse{Changes: changes}, nil
+	}
+
+	if len(creates) > 0 || len(updates) > 0 {
+		err := server.authorizer.AuthorizeAction(ctx, permissions.CreateQueue)
+		var ep *armadaerrors.ErrUnauthorized
+		if errors.As(err, &ep) {
+			return nil, status.Errorf(codes.PermissionDenied, "[ImportQueues] error importing queues: %s", ep)
+		} else if err != nil {
+			return nil, status.Errorf(codes.Unavailable, "[ImportQueues] error checking permissions: %s", err)
+		}
+	}
+	if len(creates) > 0 {
+		if err := server.queueRepository.CreateQueues(creates); err != nil {
+			for _, q := range creates {
+				changeByName[q.Name].Error = err.Error()
+			}
+		}
+	}
+	if len(updates) > 0 {
+		if err := server.queueRepository.UpdateQueues(updates); err != nil {
+			for _, q := range updates {
+				changeByName[q.Name].Error = err.Error()
+			}
+		}
+	}
+	// Children are deleted before their parents, which can't be deleted while they have children.
+	sort.Slice(deletes, func(i, j int) bool {
+		return len(queue.Ancestors(deletes[i], existingByName)) > len(queue.Ancestors(deletes[j], existingByName))
+	})
+	for _, name := range deletes {
+		if _, err := server.DeleteQueue(ctx, &api.QueueDeleteRequest{Name: name}); err != nil {
+			changeByName[name].Error = err.Error()
+		}
+	}
+	log.Infof(
+		"%s imported queues: %d created, %d updated, %d deleted",
+		authorization.GetPrincipal(ctx).GetName(), len(creates), len(updates), len(deletes),
+	)
+	return &api.QueueImportResponse{Changes: changes}, nil
+}
+
+// normalizeQueue validates q and returns it as the queue repository stores it, such that it may be compared with
+// stored queues.
+func normalizeQueue(q queue.Queue) (queue.Queue, error) {
+	data, err := proto.Marshal(q.ToAPI())
+	if err != nil {
+		return queue.Queue{}, errors.WithStack(err)
+	}
+	apiQueue := &api.Queue{}
+	if err := proto.Unmarshal(data, apiQueue); err != nil {
+		return queue.Queue{}, errors.WithStack(err)
+	}
+	return queue.NewQueue(apiQueue)
+}
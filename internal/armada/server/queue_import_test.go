@@ -0,0 +1,161 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/armadaproject/armada/internal/armada/repository"
+	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/client/queue"
+)
+
+func TestSubmitServer_ExportQueues_ImportsUnchanged(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
+		_, err := s.CreateQueue(context.Background(), &api.Queue{
+			Name:              "ml",
+			PriorityFactor:    2,
+			UserOwners:        []string{"alice"},
+			ResourceLimits:    map[string]float64{"cpu": 0.5},
+			Labels:            map[string]string{"team": "ml"},
+			Description:       "Training jobs",
+			AllowedNamespaces: []string{"ml"},
+		})
+		require.NoError(t, err)
+		_, err = s.CreateQueue(context.Background(), &api.Queue{Name: "ml-dev", Parent: "ml"})
+		require.NoError(t, err)
+
+		document, err := s.ExportQueues(context.Background(), &api.QueueExportRequest{})
+		require.NoError(t, err)
+		queues, err := queue.UnmarshalDocument([]byte(document.Yaml))
+		require.NoError(t, err)
+		var names []string
+		for _, q := range queues {
+			names = append(names, q.Name)
+		}
+		assert.Equal(t, []string{"ml", "ml-dev", "test"}, names)
+
+		response, err := s.ImportQueues(context.Background(), &api.QueueImportRequest{Yaml: document.Yaml, Prune: true})
+		require.NoError(t, err)
+		assert.Empty(t, response.Changes)
+
+		document, err = s.ExportQueues(context.Background(), &api.QueueExportRequest{NamePrefix: "ml-"})
+		require.NoError(t, err)
+		queues, err = queue.UnmarshalDocument([]byte(document.Yaml))
+		require.NoError(t, err)
+		require.Len(t, queues, 1)
+		assert.Equal(t, "ml-dev", queues[0].Name)
+	})
+}
+
+func TestSubmitServer_ImportQueues(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
+		_, err := s.CreateQueue(context.Background(), &api.Queue{Name: "ml", PriorityFactor: 1, Labels: map[string]string{"team": "ml"}})
+		require.NoError(t, err)
+		_, err = s.CreateQueue(context.Background(), &api.Queue{Name: "ml-old", PriorityFactor: 1})
+		require.NoError(t, err)
+		document := `
+apiVersion: armadaproject.io/v1beta1
+kind: QueueList
+queues:
+- name: ml
+  priorityFactor: 2
+  permissions:
+  - subjects:
+    - kind: Group
+      name: ml-team
+    verbs: [submit, watch]
+- name: ml-new
+  parent: ml
+`
+		expected := []*api.QueueDiff{
+			{Name: "ml", Type: api.QueueChangeType_QueueUpdated, Fields: []string{"labels", "permissions", "priorityFactor"}},
+			{Name: "ml-new", Type: api.QueueChangeType_QueueCreated},
+			{Name: "ml-old", Type: api.QueueChangeType_QueueDeleted},
+		}
+
+		// Dry runs report the changes without applying them.
+		response, err := s.ImportQueues(context.Background(), &api.QueueImportRequest{Yaml: document, Prune: true, NamePrefix: "ml", DryRun: true})
+		require.NoError(t, err)
+		assert.Equal(t, expected, response.Changes)
+		_, err = s.GetQueue(context.Background(), &api.QueueGetRequest{Name: "ml-new"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+
+		response, err = s.ImportQueues(context.Background(), &api.QueueImportRequest{Yaml: document, Prune: true, NamePrefix: "ml"})
+		require.NoError(t, err)
+		assert.Equal(t, expected, response.Changes)
+
+		ml, err := s.GetQueue(context.Background(), &api.QueueGetRequest{Name: "ml"})
+		require.NoError(t, err)
+		assert.Equal(t, 2.0, ml.PriorityFactor)
+		assert.Empty(t, ml.Labels)
+		assert.Equal(t, []*api.Queue_Permissions{{
+			Subjects: []*api.Queue_Permissions_Subject{{Kind: "Group", Name: "ml-team"}},
+			Verbs:    []string{"submit", "watch"},
+		}}, ml.Permissions)
+		_, err = s.GetQueue(context.Background(), &api.QueueGetRequest{Name: "ml-new"})
+		assert.NoError(t, err)
+		_, err = s.GetQueue(context.Background(), &api.QueueGetRequest{Name: "ml-old"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		// Queues without the prefix aren't pruned.
+		_, err = s.GetQueue(context.Background(), &api.QueueGetRequest{Name: "test"})
+		assert.NoError(t, err)
+
+		// Once applied, the queues match the document.
+		response, err = s.ImportQueues(context.Background(), &api.QueueImportRequest{Yaml: document, Prune: true, NamePrefix: "ml"})
+		require.NoError(t, err)
+		assert.Empty(t, response.Changes)
+	})
+}
+
+func TestSubmitServer_ImportQueues_PruneReportsFailedDeletions(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
+		_, err := s.SubmitJobs(context.Background(), createJobRequest("set", 1))
+		require.NoError(t, err)
+
+		document := "apiVersion: armadaproject.io/v1beta1\nkind: QueueList\nqueues: []\n"
+		response, err := s.ImportQueues(context.Background(), &api.QueueImportRequest{Yaml: document, Prune: true})
+		require.NoError(t, err)
+		require.Len(t, response.Changes, 1)
+		assert.Equal(t, api.QueueChangeType_QueueDeleted, response.Changes[0].Type)
+		assert.Contains(t, response.Changes[0].Error, "not empty")
+	})
+}
+
+func TestSubmitServer_ImportQueues_Invalid(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
+		tests := map[string]*api.QueueImportRequest{
+			"malformed document": {Yaml: "queues: ["},
+			"invalid queue":      {Yaml: "apiVersion: armadaproject.io/v1beta1\nkind: QueueList\nqueues:\n- name: a\n  priorityFactor: 0.5\n"},
+			"missing parent":     {Yaml: "apiVersion: armadaproject.io/v1beta1\nkind: QueueList\nqueues:\n- name: a\n  parent: b\n"},
+			"outside prefix":     {Yaml: "apiVersion: armadaproject.io/v1beta1\nkind: QueueList\nqueues:\n- name: a\n  priorityFactor: 1\n", NamePrefix: "ml-"},
+		}
+		for name, req := range tests {
+			t.Run(name, func(t *testing.T) {
+				_, err := s.ImportQueues(context.Background(), req)
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			})
+		}
+	})
+}
+
+func TestSubmitServer_ImportQueues_PermissionDenied(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
+		s.authorizer = &FakeDenyAllActionAuthorizer{}
+		document := "apiVersion: armadaproject.io/v1beta1\nkind: QueueList\nqueues:\n- name: a\n  priorityFactor: 1\n"
+
+		// Detecting drift requires no permissions.
+		response, err := s.ImportQueues(context.Background(), &api.QueueImportRequest{Yaml: document, DryRun: true})
+		require.NoError(t, err)
+		assert.Len(t, response.Changes, 1)
+
+		_, err = s.ImportQueues(context.Background(), &api.QueueImportRequest{Yaml: document})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		_, err = s.GetQueue(context.Background(), &api.QueueGetRequest{Name: "a"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
@@ -0,0 +1,159 @@
+package server
+
+import (
+	"path"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+
+	"github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/internal/armada/repository"
+	"github.com/armadaproject/armada/pkg/client/queue"
+)
+
+// QueuePermissionSynchronizer grants groups permissions on queues according to rules, e.g., such that the group
+// ml-team may submit to all queues with names matching ml-*. Permissions of queues consisting of a single group subject
+// named by any rule, or configured as managed, are managed by the synchronizer; all other permissions are left as they are.
+type QueuePermissionSynchronizer struct {
+	queueRepository repository.QueueRepository
+	rules           []configuration.QueuePermissionRule
+	// Groups named by any rule or configured as managed, the permissions of which are managed by the synchronizer.
+	managedGroups map[string]bool
+}
+
+// NewQueuePermissionSynchronizer returns a synchronizer applying the rules of config to the queues of queueRepository.
+// An error is returned if any rule has no group, an invalid queue pattern, or an unknown verb, or if any managed group is empty.
+func NewQueuePermissionSynchronizer(
+	queueRepository repository.QueueRepository,
+	config configuration.QueuePermissionSyncConfig,
+) (*QueuePermissionSynchronizer, error) {
+	managedGroups := make(map[string]bool)
+	for i, rule := range config.Rules {
+		if rule.Group == "" {
+			return nil, errors.Errorf("queue permission rule %d has no group", i)
+		}
+		if _, err := path.Match(rule.QueuePattern, ""); err != nil {
+			return nil, errors.Wrapf(err, "queue permission rule %d has invalid queue pattern %q", i, rule.QueuePattern)
+		}
+		if _, err := queue.NewPermissionVerbs(rule.Verbs); err != nil {
+			return nil, errors.Wrapf(err, "queue permission rule %d has invalid verbs", i)
+		}
+		managedGroups[rule.Group] = true
+	}
+	for i, group := range config.ManagedGroups {
+		if group == "" {
+			return nil, errors.Errorf("managed group %d is empty", i)
+		}
+		managedGroups[group] = true
+	}
+	return &QueuePermissionSynchronizer{
+		queueRepository: queueRepository,
+		rules:           config.Rules,
+		managedGroups:   managedGroups,
+	}, nil
+}
+
+// SyncPermissions updates the permissions of each queue that drifted from the rules to match them.
+// Queues changed concurrently are skipped and synced the next time.
+func (s *QueuePermissionSynchronizer) SyncPermissions() {
+	if len(s.managedGroups) == 0 {
+		return
+	}
+	queues, err := s.queueRepository.GetAllQueues()
+	if err != nil {
+		log.WithError(err).Error("failed to get queues to sync permissions of")
+		return
+	}
+	for _, q := range queues {
+		if q.Archival != nil {
+			continue
+		}
+		permissions, drifted := s.syncedPermissions(q)
+		if !drifted {
+			continue
+		}
+		q.Permissions = permissions
+		logger := log.WithField("queue", q.Name)
+		if err := s.queueRepository.UpdateQueue(q); errors.As(err, new(*repository.ErrQueueRevisionMismatch)) {
+			logger.Infof("skipped syncing permissions of queue %s, as it changed concurrently", q.Name)
+		} else if err != nil {
+			logger.WithError(err).Errorf("failed to sync permissions of queue %s", q.Name)
+		} else {
+			logger.Infof("updated permissions of queue %s, which drifted from the permission rules", q.Name)
+		}
+	}
+}
+
+// syncedPermissions returns the permissions of q with those of managed groups replaced by the ones granted by the
+// rules, and whether these differ from the current permissions of q.
+func (s *QueuePermissionSynchronizer) syncedPermissions(q queue.Queue) ([]queue.Permissions, bool) {
+	granted := s.grantedVerbs(q.Name)
+	current := make(map[string]map[queue.PermissionVerb]bool)
+	permissions := make([]queue.Permissions, 0, len(q.Permissions)+len(granted))
+	for _, permission := range q.Permissions {
+		group, ok := s.managedGroup(permission)
+		if !ok {
+			permissions = append(permissions, permission)
+			continue
+		}
+		if current[group] == nil {
+			current[group] = make(map[queue.PermissionVerb]bool)
+		}
+		for _, verb := range permission.Verbs {
+			current[group][verb] = true
+		}
+	}
+
+	groups := maps.Keys(granted)
+	slices.Sort(groups)
+	for _, group := range groups {
+		var verbs queue.PermissionVerbs
+		for _, verb := range queue.AllPermissionVerbs() {
+			if granted[group][verb] {
+				verbs = append(verbs, verb)
+			}
+		}
+		permissions = append(permissions, queue.Permissions{
+			Subjects: queue.PermissionSubjects{{Kind: queue.PermissionSubjectKindGroup, Name: group}},
+			Verbs:    verbs,
+		})
+	}
+
+	// Duplicate or differently ordered permissions of managed groups don't count as drift.
+	drifted := len(current) != len(granted)
+	for group, verbs := range granted {
+		drifted = drifted || !maps.Equal(verbs, current[group])
+	}
+	return permissions, drifted
+}
+
+// grantedVerbs returns the verbs granted to each group on the queue with the given name by the rules.
+func (s *QueuePermissionSynchronizer) grantedVerbs(queueName string) map[string]map[queue.PermissionVerb]bool {
+	granted := make(map[string]map[queue.PermissionVerb]bool)
+	for _, rule := range s.rules {
+		if ok, _ := path.Match(rule.QueuePattern, queueName); !ok || len(rule.Verbs) == 0 {
+			continue
+		}
+		if granted[rule.Group] == nil {
+			granted[rule.Group] = make(map[queue.PermissionVerb]bool)
+		}
+		for _, verb := range rule.Verbs {
+			granted[rule.Group][queue.PermissionVerb(verb)] = true
+		}
+	}
+	return granted
+}
+
+// managedGroup returns the group of permission if it consists of a single group subject managed by the rules.
+func (s *QueuePermissionSynchronizer) managedGroup(permission queue.Permissions) (string, bool) {
+	if len(permission.Subjects) != 1 {
+		return "", false
+	}
+	subject := permission.Subjects[0]
+	if subject.Kind != queue.PermissionSubjectKindGroup || !s.managedGroups[subject.Name] {
+		return "", false
+	}
+	return subject.Name, true
+}
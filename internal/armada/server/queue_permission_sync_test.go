@@ -0,0 +1,127 @@
+package server
+
+import (
+	"testing"
+
+	"github.com/go-redis/redis"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/internal/armada/repository"
+	"github.com/armadaproject/armada/pkg/client/queue"
+)
+
+func TestQueuePermissionSynchronizer_SyncPermissions(t *testing.T) {
+	withQueuePermissionSynchronizer(func(s *QueuePermissionSynchronizer, queueRepository repository.QueueRepository) {
+		owner := queue.Permissions{
+			Subjects: queue.PermissionSubjects{{Kind: queue.PermissionSubjectKindUser, Name: "alice"}},
+			Verbs:    queue.AllPermissionVerbs(),
+		}
+		require.NoError(t, queueRepository.CreateQueue(queue.Queue{Name: "ml-training", PriorityFactor: 1, Permissions: []queue.Permissions{
+			owner,
+			// Drifted from the rules, e.g., by a manual edit.
+			{
+				Subjects: queue.PermissionSubjects{{Kind: queue.PermissionSubjectKindGroup, Name: "ml-team"}},
+				Verbs:    queue.AllPermissionVerbs(),
+			},
+		}}))
+		require.NoError(t, queueRepository.CreateQueue(queue.Queue{Name: "batch", PriorityFactor: 1, Permissions: []queue.Permissions{
+			{
+				Subjects: queue.PermissionSubjects{{Kind: queue.PermissionSubjectKindGroup, Name: "ops"}},
+				Verbs:    queue.PermissionVerbs{queue.PermissionVerbWatch},
+			},
+		}}))
+
+		s.SyncPermissions()
+
+		q, err := queueRepository.GetQueue("ml-training")
+		require.NoError(t, err)
+		assert.Equal(t, []queue.Permissions{
+			owner,
+			{
+				Subjects: queue.PermissionSubjects{{Kind: queue.PermissionSubjectKindGroup, Name: "ml-team"}},
+				Verbs:    queue.PermissionVerbs{queue.PermissionVerbSubmit, queue.PermissionVerbCancel, queue.PermissionVerbWatch},
+			},
+			{
+				Subjects: queue.PermissionSubjects{{Kind: queue.PermissionSubjectKindGroup, Name: "ops"}},
+				Verbs:    queue.PermissionVerbs{queue.PermissionVerbCancel, queue.PermissionVerbWatch},
+			},
+		}, q.Permissions)
+		revision := q.Revision
+
+		// Managed groups lose permissions on queues no rule grants them any on.
+		q, err = queueRepository.GetQueue("batch")
+		require.NoError(t, err)
+		assert.Empty(t, q.Permissions)
+
+		// Queues that didn't drift aren't updated.
+		s.SyncPermissions()
+		q, err = queueRepository.GetQueue("ml-training")
+		require.NoError(t, err)
+		assert.Equal(t, revision, q.Revision)
+	})
+}
+
+func TestQueuePermissionSynchronizer_RevokesPermissionsOfManagedGroupsWithoutRules(t *testing.T) {
+	client := redis.NewClient(&redis.Options{Addr: "localhost:6379", DB: 10})
+	defer client.FlushDB()
+	defer client.Close()
+	client.FlushDB()
+	queueRepository := repository.NewRedisQueueRepository(client)
+	other := queue.Permissions{
+		Subjects: queue.PermissionSubjects{{Kind: queue.PermissionSubjectKindGroup, Name: "ops"}},
+		Verbs:    queue.PermissionVerbs{queue.PermissionVerbWatch},
+	}
+	require.NoError(t, queueRepository.CreateQueue(queue.Queue{Name: "ml-training", PriorityFactor: 1, Permissions: []queue.Permissions{
+		other,
+		// Granted by a rule since removed.
+		{
+			Subjects: queue.PermissionSubjects{{Kind: queue.PermissionSubjectKindGroup, Name: "ml-team"}},
+			Verbs:    queue.PermissionVerbs{queue.PermissionVerbSubmit},
+		},
+	}}))
+
+	s, err := NewQueuePermissionSynchronizer(queueRepository, configuration.QueuePermissionSyncConfig{ManagedGroups: []string{"ml-team"}})
+	require.NoError(t, err)
+	s.SyncPermissions()
+
+	q, err := queueRepository.GetQueue("ml-training")
+	require.NoError(t, err)
+	assert.Equal(t, []queue.Permissions{other}, q.Permissions)
+}
+
+func TestNewQueuePermissionSynchronizer_RejectsInvalidRules(t *testing.T) {
+	for name, rule := range map[string]configuration.QueuePermissionRule{
+		"no group":        {QueuePattern: "*", Verbs: []string{"submit"}},
+		"invalid pattern": {Group: "ml-team", QueuePattern: "ml-[", Verbs: []string{"submit"}},
+		"unknown verb":    {Group: "ml-team", QueuePattern: "*", Verbs: []string{"delete"}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := NewQueuePermissionSynchronizer(nil, configuration.QueuePermissionSyncConfig{
+				Rules: []configuration.QueuePermissionRule{rule},
+			})
+			assert.Error(t, err)
+		})
+	}
+}
+
+func withQueuePermissionSynchronizer(action func(s *QueuePermissionSynchronizer, queueRepository repository.QueueRepository)) {
+	client := redis.NewClient(&redis.Options{Addr: "localhost:6379", DB: 10})
+	defer client.FlushDB()
+	defer client.Close()
+	client.FlushDB()
+
+	queueRepository := repository.NewRedisQueueRepository(client)
+	s, err := NewQueuePermissionSynchronizer(queueRepository, configuration.QueuePermissionSyncConfig{
+		Rules: []configuration.QueuePermissionRule{
+			{Group: "ml-team", QueuePattern: "ml-*", Verbs: []string{"submit", "watch"}},
+			{Group: "ml-team", QueuePattern: "ml-*", Verbs: []string{"cancel"}},
+			{Group: "ops", QueuePattern: "ml-*", Verbs: []string{"watch", "cancel"}},
+		},
+	})
+	if err != nil {
+		panic(err)
+	}
+	action(s, queueRepository)
+}
@@ -1,19 +1,22 @@
 package server
 
 import (
+	"encoding/json"
 	"fmt"
 	"time"
 
 	log "github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
 
+	"github.com/armadaproject/armada/internal/armada/configuration"
 	"github.com/armadaproject/armada/internal/armada/repository"
 	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/eventutil"
 	"github.com/armadaproject/armada/pkg/api"
 )
 
-func reportQueued(repository repository.EventStore, jobs []*api.Job) error {
+func queuedEvents(jobs []*api.Job, now time.Time) ([]*api.EventMessage, error) {
 	events := []*api.EventMessage{}
-	now := time.Now()
 	for _, job := range jobs {
 		event, err := api.Wrap(&api.JobQueuedEvent{
 			JobId:    job.Id,
@@ -22,22 +25,15 @@ func reportQueued(repository repository.EventStore, jobs []*api.Job) error {
 			Created:  now,
 		})
 		if err != nil {
-			return fmt.Errorf("[reportQueued] error wrapping event: %w", err)
+			return nil, err
 		}
 		events = append(events, event)
 	}
-
-	err := repository.ReportEvents(armadacontext.Background(), events)
-	if err != nil {
-		return fmt.Errorf("[reportQueued] error reporting events: %w", err)
-	}
-
-	return nil
+	return events, nil
 }
 
-func reportDuplicateDetected(repository repository.EventStore, results []*repository.SubmitJobResult) error {
+func duplicateDetectedEvents(results []*repository.SubmitJobResult, now time.Time) ([]*api.EventMessage, error) {
 	events := []*api.EventMessage{}
-	now := time.Now()
 	for _, result := range results {
 		event, err := api.Wrap(&api.JobDuplicateFoundEvent{
 			JobId:         result.SubmittedJob.Id,
@@ -47,22 +43,15 @@ func reportDuplicateDetected(repository repository.EventStore, results []*reposi
 			OriginalJobId: result.JobId,
 		})
 		if err != nil {
-			return fmt.Errorf("[reportDuplicateDetected] error wrapping event: %w", err)
+			return nil, err
 		}
 		events = append(events, event)
 	}
-
-	err := repository.ReportEvents(armadacontext.Background(), events)
-	if err != nil {
-		return fmt.Errorf("[reportDuplicateDetected] error reporting events: %w", err)
-	}
-
-	return nil
+	return events, nil
 }
 
-func reportSubmitted(repository repository.EventStore, jobs []*api.Job) error {
+func submittedEvents(jobs []*api.Job, now time.Time) ([]*api.EventMessage, error) {
 	events := []*api.EventMessage{}
-	now := time.Now()
 	for _, job := range jobs {
 		event, err := api.Wrap(&api.JobSubmittedEvent{
 			JobId:    job.Id,
@@ -72,22 +61,66 @@ func reportSubmitted(repository repository.EventStore, jobs []*api.Job) error {
 			Job:      *job,
 		})
 		if err != nil {
-			return fmt.Errorf("[reportSubmitted] error wrapping event: %w", err)
+			return nil, err
 		}
 		events = append(events, event)
 	}
+	return events, nil
+}
 
-	err := repository.ReportEvents(armadacontext.Background(), events)
-	if err != nil {
-		return fmt.Errorf("[reportSubmitted] error reporting events: %w", err)
+// resourcesNormalizedEvents returns the resources-normalized event of each job, which is nil for jobs the resources of
+// which weren't normalized at submission.
+func resourcesNormalizedEvents(jobs []*api.Job, now time.Time) ([]*api.EventMessage, error) {
+	events := make([]*api.EventMessage, len(jobs))
+	for i, job := range jobs {
+		normalized, err := resourcesNormalizedEvent(job, now)
+		if err != nil {
+			return nil, err
+		}
+		if normalized == nil {
+			continue
+		}
+		event, err := api.Wrap(normalized)
+		if err != nil {
+			return nil, err
+		}
+		events[i] = event
 	}
+	return events, nil
+}
 
-	return nil
+// resourcesNormalizedEvent returns the resources-normalized event of job, as given by its original resources
+// annotation and the resources of its containers, or nil if the resources of job weren't normalized at submission.
+func resourcesNormalizedEvent(job *api.Job, now time.Time) (*api.JobResourcesNormalizedEvent, error) {
+	annotation, ok := job.Annotations[configuration.OriginalResourcesAnnotation]
+	if !ok {
+		return nil, nil
+	}
+	var originalResources map[string]v1.ResourceRequirements
+	if err := json.Unmarshal([]byte(annotation), &originalResources); err != nil {
+		return nil, fmt.Errorf("invalid %s annotation of job %s: %w", configuration.OriginalResourcesAnnotation, job.Id, err)
+	}
+	normalizedResources := make(map[string]v1.ResourceRequirements, len(originalResources))
+	if podSpec := job.GetMainPodSpec(); podSpec != nil {
+		for _, container := range podSpec.Containers {
+			if _, ok := originalResources[container.Name]; ok {
+				normalizedResources[container.Name] = container.Resources
+			}
+		}
+	}
+	return &api.JobResourcesNormalizedEvent{
+		JobId:               job.Id,
+		Queue:               job.Queue,
+		JobSetId:            job.JobSetId,
+		Created:             now,
+		OriginalResources:   originalResources,
+		NormalizedResources: normalizedResources,
+	}, nil
 }
 
 // TODO This function behaves differently from the rest in this file.
 // We should consolidate so that they all behave in the same way.
-func reportJobsLeased(repository repository.EventStore, jobs []*api.Job, clusterId string) {
+func reportJobsLeased(repository repository.EventStore, jobs []*api.Job, clusterId string, pool string) {
 	events := []*api.EventMessage{}
 	now := time.Now()
 	for _, job := range jobs {
@@ -97,6 +130,7 @@ func reportJobsLeased(repository repository.EventStore, jobs []*api.Job, cluster
 			JobSetId:  job.JobSetId,
 			Created:   now,
 			ClusterId: clusterId,
+			Pool:      pool,
 		})
 		if err != nil {
 			err = fmt.Errorf("[reportJobsLeased] error wrapping event: %w", err)
@@ -114,23 +148,211 @@ func reportJobsLeased(repository repository.EventStore, jobs []*api.Job, cluster
 }
 
 func reportJobLeaseReturned(repository repository.EventStore, job *api.Job, leaseReturnRequest *api.ReturnLeaseRequest) error {
-	event, err := api.Wrap(&api.JobLeaseReturnedEvent{
+	event, err := api.Wrap(leaseReturnedEvent(job, leaseReturnRequest, time.Now()))
+	if err != nil {
+		return fmt.Errorf("error wrapping event: %w", err)
+	}
+
+	err = repository.ReportEvents(armadacontext.Background(), []*api.EventMessage{event})
+	if err != nil {
+		return fmt.Errorf("error reporting lease returned event: %w", err)
+	}
+
+	return nil
+}
+
+func leaseReturnedEvent(job *api.Job, leaseReturnRequest *api.ReturnLeaseRequest, created time.Time) *api.JobLeaseReturnedEvent {
+	return &api.JobLeaseReturnedEvent{
 		JobId:        job.Id,
 		JobSetId:     job.JobSetId,
 		Queue:        job.Queue,
-		Created:      time.Now(),
+		Created:      created,
 		ClusterId:    leaseReturnRequest.ClusterId,
 		Reason:       leaseReturnRequest.Reason,
 		KubernetesId: leaseReturnRequest.KubernetesId,
 		RunAttempted: leaseReturnRequest.JobRunAttempted,
-	})
+	}
+}
+
+func reportJobSetExpired(repository repository.EventStore, queue string, jobSetId string, jobIds []string, ttlSeconds int64) error {
+	events := []*api.EventMessage{}
+	now := time.Now()
+	for _, jobId := range jobIds {
+		event, err := api.Wrap(&api.JobSetExpiredEvent{
+			JobId:            jobId,
+			Queue:            queue,
+			JobSetId:         jobSetId,
+			Created:          now,
+			JobSetTtlSeconds: ttlSeconds,
+		})
+		if err != nil {
+			return fmt.Errorf("[reportJobSetExpired] error wrapping event: %w", err)
+		}
+		events = append(events, event)
+	}
+
+	err := repository.ReportEvents(armadacontext.Background(), events)
 	if err != nil {
-		return fmt.Errorf("error wrapping event: %w", err)
+		return fmt.Errorf("[reportJobSetExpired] error reporting events: %w", err)
 	}
 
-	err = repository.ReportEvents(armadacontext.Background(), []*api.EventMessage{event})
+	return nil
+}
+
+func reportJobsRuntimeExceeded(repository repository.EventStore, jobs []*api.Job, clusterIds map[string]string, maxRuntimeSeconds map[string]uint32) error {
+	events := []*api.EventMessage{}
+	now := time.Now()
+	for _, job := range jobs {
+		event, err := api.Wrap(&api.JobRuntimeExceededEvent{
+			JobId:             job.Id,
+			Queue:             job.Queue,
+			JobSetId:          job.JobSetId,
+			Created:           now,
+			ClusterId:         clusterIds[job.Id],
+			MaxRuntimeSeconds: maxRuntimeSeconds[job.Id],
+		})
+		if err != nil {
+			return fmt.Errorf("[reportJobsRuntimeExceeded] error wrapping event: %w", err)
+		}
+		events = append(events, event)
+	}
+
+	err := repository.ReportEvents(armadacontext.Background(), events)
 	if err != nil {
-		return fmt.Errorf("error reporting lease returned event: %w", err)
+		return fmt.Errorf("[reportJobsRuntimeExceeded] error reporting events: %w", err)
+	}
+
+	return nil
+}
+
+func reportJobsUnschedulable(repository repository.EventStore, jobs []*api.Job, reasons map[string]string) error {
+	events := []*api.EventMessage{}
+	now := time.Now()
+	for _, job := range jobs {
+		event, err := api.Wrap(&api.JobUnschedulableEvent{
+			JobId:    job.Id,
+			Queue:    job.Queue,
+			JobSetId: job.JobSetId,
+			Created:  now,
+			Reason:   reasons[job.Id],
+		})
+		if err != nil {
+			return fmt.Errorf("[reportJobsUnschedulable] error wrapping event: %w", err)
+		}
+		events = append(events, event)
+	}
+
+	err := repository.ReportEvents(armadacontext.Background(), events)
+	if err != nil {
+		return fmt.Errorf("[reportJobsUnschedulable] error reporting events: %w", err)
+	}
+
+	return nil
+}
+
+func reportJobsMaintenanceWindowStarted(repository repository.EventStore, jobs []*api.Job, window *api.MaintenanceWindow) error {
+	events := []*api.EventMessage{}
+	now := time.Now()
+	for _, job := range jobs {
+		event, err := api.Wrap(&api.JobMaintenanceWindowStartedEvent{
+			JobId:               job.Id,
+			Queue:               job.Queue,
+			JobSetId:            job.JobSetId,
+			Created:             now,
+			MaintenanceWindowId: window.Id,
+			Executor:            window.Executor,
+			End:                 window.End,
+			Drain:               window.Drain,
+			Reason:              window.Reason,
+		})
+		if err != nil {
+			return fmt.Errorf("[reportJobsMaintenanceWindowStarted] error wrapping event: %w", err)
+		}
+		events = append(events, event)
+	}
+
+	err := repository.ReportEvents(armadacontext.Background(), events)
+	if err != nil {
+		return fmt.Errorf("[reportJobsMaintenanceWindowStarted] error reporting events: %w", err)
+	}
+
+	return nil
+}
+
+func reportJobsMaintenanceWindowEnded(repository repository.EventStore, jobs []*api.Job, window *api.MaintenanceWindow) error {
+	events := []*api.EventMessage{}
+	now := time.Now()
+	for _, job := range jobs {
+		event, err := api.Wrap(&api.JobMaintenanceWindowEndedEvent{
+			JobId:               job.Id,
+			Queue:               job.Queue,
+			JobSetId:            job.JobSetId,
+			Created:             now,
+			MaintenanceWindowId: window.Id,
+			Executor:            window.Executor,
+		})
+		if err != nil {
+			return fmt.Errorf("[reportJobsMaintenanceWindowEnded] error wrapping event: %w", err)
+		}
+		events = append(events, event)
+	}
+
+	err := repository.ReportEvents(armadacontext.Background(), events)
+	if err != nil {
+		return fmt.Errorf("[reportJobsMaintenanceWindowEnded] error reporting events: %w", err)
+	}
+
+	return nil
+}
+
+func reportJobsCordoned(repository repository.EventStore, jobs []*api.Job, cordon *api.Cordon, executor string) error {
+	events := []*api.EventMessage{}
+	now := time.Now()
+	for _, job := range jobs {
+		event, err := api.Wrap(&api.JobCordonedEvent{
+			JobId:      job.Id,
+			Queue:      job.Queue,
+			JobSetId:   job.JobSetId,
+			Created:    now,
+			Executor:   executor,
+			CordonedBy: cordon.CordonedBy,
+			Reason:     cordon.Reason,
+		})
+		if err != nil {
+			return fmt.Errorf("[reportJobsCordoned] error wrapping event: %w", err)
+		}
+		events = append(events, event)
+	}
+
+	err := repository.ReportEvents(armadacontext.Background(), events)
+	if err != nil {
+		return fmt.Errorf("[reportJobsCordoned] error reporting events: %w", err)
+	}
+
+	return nil
+}
+
+func reportJobsUncordoned(repository repository.EventStore, jobs []*api.Job, executor string, uncordonedBy string) error {
+	events := []*api.EventMessage{}
+	now := time.Now()
+	for _, job := range jobs {
+		event, err := api.Wrap(&api.JobUncordonedEvent{
+			JobId:        job.Id,
+			Queue:        job.Queue,
+			JobSetId:     job.JobSetId,
+			Created:      now,
+			Executor:     executor,
+			UncordonedBy: uncordonedBy,
+		})
+		if err != nil {
+			return fmt.Errorf("[reportJobsUncordoned] error wrapping event: %w", err)
+		}
+		events = append(events, event)
+	}
+
+	err := repository.ReportEvents(armadacontext.Background(), events)
+	if err != nil {
+		return fmt.Errorf("[reportJobsUncordoned] error reporting events: %w", err)
 	}
 
 	return nil
@@ -162,7 +384,7 @@ func reportJobsCancelling(repository repository.EventStore, requestorName string
 	return nil
 }
 
-func reportJobsReprioritizing(repository repository.EventStore, requestorName string, jobs []*api.Job, newPriority float64) error {
+func reportJobsReprioritizing(repository repository.EventStore, requestorName string, jobs []*api.Job, update priorityUpdate) error {
 	events := []*api.EventMessage{}
 	now := time.Now()
 	for _, job := range jobs {
@@ -171,7 +393,7 @@ func reportJobsReprioritizing(repository repository.EventStore, requestorName st
 			Queue:       job.Queue,
 			JobSetId:    job.JobSetId,
 			Created:     now,
-			NewPriority: newPriority,
+			NewPriority: update(job),
 			Requestor:   requestorName,
 		})
 		if err != nil {
@@ -188,7 +410,8 @@ func reportJobsReprioritizing(repository repository.EventStore, requestorName st
 	return nil
 }
 
-func reportJobsReprioritized(repository repository.EventStore, requestorName string, jobs []*api.Job, newPriority float64) error {
+// reportJobsReprioritized reports the current priority of each of jobs, which have already been reprioritised.
+func reportJobsReprioritized(repository repository.EventStore, requestorName string, jobs []*api.Job) error {
 	events := []*api.EventMessage{}
 	now := time.Now()
 	for _, job := range jobs {
@@ -197,7 +420,7 @@ func reportJobsReprioritized(repository repository.EventStore, requestorName str
 			Queue:       job.Queue,
 			JobSetId:    job.JobSetId,
 			Created:     now,
-			NewPriority: newPriority,
+			NewPriority: job.Priority,
 			Requestor:   requestorName,
 		})
 		if err != nil {
@@ -214,6 +437,56 @@ func reportJobsReprioritized(repository repository.EventStore, requestorName str
 	return nil
 }
 
+func reportJobsSuspended(repository repository.EventStore, requestorName string, jobs []*api.Job) error {
+	events := []*api.EventMessage{}
+	now := time.Now()
+	for _, job := range jobs {
+		event, err := api.Wrap(&api.JobSuspendedEvent{
+			JobId:     job.Id,
+			Queue:     job.Queue,
+			JobSetId:  job.JobSetId,
+			Created:   now,
+			Requestor: requestorName,
+		})
+		if err != nil {
+			return fmt.Errorf("[reportJobsSuspended] error wrapping event: %w", err)
+		}
+		events = append(events, event)
+	}
+
+	err := repository.ReportEvents(armadacontext.Background(), events)
+	if err != nil {
+		return fmt.Errorf("[reportJobsSuspended] error reporting events: %w", err)
+	}
+
+	return nil
+}
+
+func reportJobsResumed(repository repository.EventStore, requestorName string, jobs []*api.Job) error {
+	events := []*api.EventMessage{}
+	now := time.Now()
+	for _, job := range jobs {
+		event, err := api.Wrap(&api.JobResumedEvent{
+			JobId:     job.Id,
+			Queue:     job.Queue,
+			JobSetId:  job.JobSetId,
+			Created:   now,
+			Requestor: requestorName,
+		})
+		if err != nil {
+			return fmt.Errorf("[reportJobsResumed] error wrapping event: %w", err)
+		}
+		events = append(events, event)
+	}
+
+	err := repository.ReportEvents(armadacontext.Background(), events)
+	if err != nil {
+		return fmt.Errorf("[reportJobsResumed] error reporting events: %w", err)
+	}
+
+	return nil
+}
+
 func reportJobsUpdated(repository repository.EventStore, requestorName string, jobs []*api.Job) error {
 	events := []*api.EventMessage{}
 	now := time.Now()
@@ -267,14 +540,154 @@ func reportJobsCancelled(repository repository.EventStore, requestorName string,
 	return nil
 }
 
+// reportJobsPreempted reports that jobs were preempted on request, along with a queued event for each job if it was
+// requeued and a failed event otherwise. clusterIds are the ids of the clusters the jobs were leased to.
+func reportJobsPreempted(
+	repository repository.EventStore,
+	requestorName string,
+	jobs []*api.Job,
+	clusterIds map[string]string,
+	requeued bool,
+	reason string,
+) error {
+	events := []*api.EventMessage{}
+	now := time.Now()
+	for _, job := range jobs {
+		event, err := api.Wrap(&api.JobPreemptedEvent{
+			JobId:     job.Id,
+			JobSetId:  job.JobSetId,
+			Queue:     job.Queue,
+			Created:   now,
+			ClusterId: clusterIds[job.Id],
+			// Until the executor supports runs properly, runs of the legacy scheduler have no id.
+			RunId:     eventutil.LEGACY_RUN_ID,
+			Requestor: requestorName,
+			Reason:    reason,
+		})
+		if err != nil {
+			return fmt.Errorf("[reportJobsPreempted] error wrapping event: %w", err)
+		}
+		events = append(events, event)
+		if requeued {
+			event, err = api.Wrap(&api.JobQueuedEvent{
+				JobId:    job.Id,
+				Queue:    job.Queue,
+				JobSetId: job.JobSetId,
+				Created:  now,
+			})
+		} else {
+			event, err = api.Wrap(&api.JobFailedEvent{
+				JobId:     job.Id,
+				JobSetId:  job.JobSetId,
+				Queue:     job.Queue,
+				Created:   now,
+				ClusterId: clusterIds[job.Id],
+				Reason:    fmt.Sprintf("preempted by %s: %s", requestorName, reason),
+				ExitCodes: make(map[string]int32),
+			})
+		}
+		if err != nil {
+			return fmt.Errorf("[reportJobsPreempted] error wrapping event: %w", err)
+		}
+		events = append(events, event)
+	}
+
+	err := repository.ReportEvents(armadacontext.Background(), events)
+	if err != nil {
+		return fmt.Errorf("[reportJobsPreempted] error reporting events: %w", err)
+	}
+
+	return nil
+}
+
+// reportJobsEvictedForCapacity reports that jobs submitted as preemptible were evicted from clusterId to make room for
+// higher-priority jobs, along with a queued event for each job, since evicted jobs are returned to the queue.
+func reportJobsEvictedForCapacity(repository repository.EventStore, jobs []*api.Job, clusterId string) error {
+	events := make([]*api.EventMessage, 0, 2*len(jobs))
+	now := time.Now()
+	for _, job := range jobs {
+		event, err := api.Wrap(&api.JobEvictedForCapacityEvent{
+			JobId:     job.Id,
+			JobSetId:  job.JobSetId,
+			Queue:     job.Queue,
+			Created:   now,
+			ClusterId: clusterId,
+		})
+		if err != nil {
+			return fmt.Errorf("[reportJobsEvictedForCapacity] error wrapping event: %w", err)
+		}
+		events = append(events, event)
+		event, err = api.Wrap(&api.JobQueuedEvent{
+			JobId:    job.Id,
+			JobSetId: job.JobSetId,
+			Queue:    job.Queue,
+			Created:  now,
+		})
+		if err != nil {
+			return fmt.Errorf("[reportJobsEvictedForCapacity] error wrapping event: %w", err)
+		}
+		events = append(events, event)
+	}
+	if len(events) == 0 {
+		return nil
+	}
+	err := repository.ReportEvents(armadacontext.Background(), events)
+	if err != nil {
+		return fmt.Errorf("[reportJobsEvictedForCapacity] error reporting events: %w", err)
+	}
+
+	return nil
+}
+
+// reportJobsRetried reports a queued event for each job returned to the queue to retry a failed run.
+func reportJobsRetried(repository repository.EventStore, jobs []*api.Job) error {
+	events := make([]*api.EventMessage, 0, len(jobs))
+	now := time.Now()
+	for _, job := range jobs {
+		event, err := api.Wrap(&api.JobQueuedEvent{
+			JobId:    job.Id,
+			JobSetId: job.JobSetId,
+			Queue:    job.Queue,
+			Created:  now,
+			Attempt:  job.FailedAttempts + 1,
+		})
+		if err != nil {
+			return fmt.Errorf("[reportJobsRetried] error wrapping event: %w", err)
+		}
+		events = append(events, event)
+	}
+	if len(events) == 0 {
+		return nil
+	}
+	err := repository.ReportEvents(armadacontext.Background(), events)
+	if err != nil {
+		return fmt.Errorf("[reportJobsRetried] error reporting events: %w", err)
+	}
+
+	return nil
+}
+
 type jobFailure struct {
 	job    *api.Job
 	reason string
 }
 
 func reportFailed(repository repository.EventStore, clusterId string, jobFailures []*jobFailure) error {
+	events, err := failedEvents(clusterId, jobFailures, time.Now())
+	if err != nil {
+		return fmt.Errorf("[reportFailed] error wrapping event: %w", err)
+	}
+
+	err = repository.ReportEvents(armadacontext.Background(), events)
+	if err != nil {
+		return fmt.Errorf("[reportFailed] error reporting events: %w", err)
+	}
+
+	return nil
+}
+
+func failedEvents(clusterId string, jobFailures []*jobFailure, now time.Time) ([]*api.EventMessage, error) {
 	events := []*api.EventMessage{}
-	now := time.Now()
 	for _, jobFailure := range jobFailures {
 		event, err := api.Wrap(&api.JobFailedEvent{
 			JobId:        jobFailure.job.Id,
@@ -288,15 +701,9 @@ func reportFailed(repository repository.EventStore, clusterId string, jobFailure
 			NodeName:     "",
 		})
 		if err != nil {
-			return fmt.Errorf("[reportFailed] error wrapping event: %w", err)
+			return nil, err
 		}
 		events = append(events, event)
 	}
-
-	err := repository.ReportEvents(armadacontext.Background(), events)
-	if err != nil {
-		return fmt.Errorf("[reportFailed] error reporting events: %w", err)
-	}
-
-	return nil
+	return events, nil
 }
@@ -0,0 +1,310 @@
+package server
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/internal/armada/repository"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+// Maximum number of jobs that may be resubmitted at once.
+const maxResubmitJobIds = 1000
+
+// Annotations set by the server that don't carry over to resubmitted jobs, either because they're set again from
+// the fields of the new job submit request items, or because they relate to the submission of the original jobs.
+var resubmitDroppedAnnotations = []string{
+	configuration.MaxConcurrentJobsAnnotation,
+	configuration.JobSetResourceLimitsAnnotation,
+	configuration.HeldUntilAnnotation,
+	configuration.RetryMaxAttemptsAnnotation,
+	configuration.RetryBackoffSecondsAnnotation,
+	configuration.RetryOnExitCodesAnnotation,
+	configuration.PreemptibleAnnotation,
+	configuration.MaxRuntimeSecondsAnnotation,
+	configuration.OriginalResourcesAnnotation,
+	configuration.RequiredClustersAnnotation,
+	configuration.PreferredClustersAnnotation,
+	configuration.ExcludedClustersAnnotation,
+	configuration.ResubmittedFromAnnotation,
+}
+
+// resubmittedFromKey is the context key of the ids of the jobs resubmitted by a job submit request, one per item.
+// Since only the server sets it, as opposed to the annotations of items, jobs can't claim to be resubmitted.
+type resubmittedFromKey struct{}
+
+// annotateResubmittedJobs annotates jobs, created from a job submit request submitted with ctx, with the ids of the
+// jobs they were resubmitted from, if the request was submitted by resubmitJobs.
+func annotateResubmittedJobs(ctx context.Context, jobs []*api.Job) {
+	jobIds, ok := ctx.Value(resubmittedFromKey{}).([]string)
+	if !ok || len(jobIds) != len(jobs) {
+		return
+	}
+	for i, job := range jobs {
+		if job.Annotations == nil {
+			job.Annotations = make(map[string]string)
+		}
+		job.Annotations[configuration.ResubmittedFromAnnotation] = jobIds[i]
+	}
+}
+
+// submitJobsFunc submits the jobs of a request, i.e., SubmitJobs of the submit server in use.
+type submitJobsFunc func(ctx context.Context, req *api.JobSubmitRequest) (*api.JobSubmitResponse, error)
+
+func (server *SubmitServer) ResubmitJobs(ctx context.Context, req *api.JobResubmitRequest) (*api.JobResubmitResponse, error) {
+	return server.resubmitJobs(ctx, req, server.SubmitJobs)
+}
+
+// resubmitJobs submits the jobs selected by req again using submit, which validates and authorizes the submission
+// as for any other jobs. The specs of the jobs are read from their submitted events.
+func (server *SubmitServer) resubmitJobs(grpcCtx context.Context, req *api.JobResubmitRequest, submit submitJobsFunc) (*api.JobResubmitResponse, error) {
+	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+	if server.EventRepository == nil {
+		return nil, status.Errorf(codes.Unimplemented, "[ResubmitJobs] jobs can't be resubmitted by this server")
+	}
+	if req.Queue == "" || req.JobSetId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "[ResubmitJobs] queue and job set id must not be empty")
+	}
+	if len(req.JobIds) == 0 || len(req.JobIds) > maxResubmitJobIds {
+		return nil, status.Errorf(codes.InvalidArgument, "[ResubmitJobs] between 1 and %d job ids must be provided, but got %d", maxResubmitJobIds, len(req.JobIds))
+	}
+	for i, jobId := range req.JobIds {
+		if slices.Index(req.JobIds[:i], jobId) >= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "[ResubmitJobs] job %s is given more than once", jobId)
+		}
+	}
+
+	q, err := server.queueRepository.GetQueue(req.Queue)
+	var expected *repository.ErrQueueNotFound
+	if errors.As(err, &expected) {
+		return nil, status.Errorf(codes.NotFound, "[ResubmitJobs] queue %s does not exist", req.Queue)
+	} else if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "[ResubmitJobs] error getting queue %s: %s", req.Queue, err)
+	}
+	// Resubmitting jobs reveals their specs, hence the caller must be allowed to watch their job set.
+	if err := validateUserHasWatchPermissions(ctx, server.authorizer, q, req.JobSetId); err != nil {
+		return nil, status.Errorf(status.Code(err), "[ResubmitJobs] %s", status.Convert(err).Message())
+	}
+
+	jobs, err := server.readSubmittedJobs(req.Queue, req.JobSetId, req.JobIds)
+	if err != nil {
+		return nil, jobSetEventsError("ResubmitJobs", err)
+	}
+	items := make([]*api.JobSubmitRequestItem, len(req.JobIds))
+	for i, jobId := range req.JobIds {
+		job, ok := jobs[jobId]
+		if !ok {
+			return nil, status.Errorf(codes.NotFound, "[ResubmitJobs] job %s wasn't submitted to job set %s", jobId, req.JobSetId)
+		}
+		item, err := resubmitRequestItem(job)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "[ResubmitJobs] error resubmitting job %s: %s", jobId, err)
+		}
+		applyJobSpecOverrides(item, req.Overrides)
+		items[i] = item
+	}
+
+	jobSetId := req.NewJobSetId
+	if jobSetId == "" {
+		jobSetId = req.JobSetId
+	}
+	response, err := submit(context.WithValue(grpcCtx, resubmittedFromKey{}, req.JobIds), &api.JobSubmitRequest{
+		Queue:           req.Queue,
+		JobSetId:        jobSetId,
+		JobRequestItems: items,
+	})
+	if err != nil {
+		return nil, err
+	}
+	jobIds := make(map[string]string, len(req.JobIds))
+	for i, responseItem := range response.JobResponseItems {
+		jobIds[req.JobIds[i]] = responseItem.JobId
+	}
+	return &api.JobResubmitResponse{JobIds: jobIds}, nil
+}
+
+// readSubmittedJobs returns those of the jobs with the provided ids that were submitted to the given job set,
+// as given by their submitted events, by id.
+func (server *SubmitServer) readSubmittedJobs(queue string, jobSetId string, jobIds []string) (map[string]*api.Job, error) {
+	jobs := make(map[string]*api.Job, len(jobIds))
+	lastId, err := server.EventRepository.GetLastMessageId(queue, jobSetId)
+	if err != nil || lastId == "" {
+		return jobs, err
+	}
+	fromId := firstJobEventId(jobIds)
+	read := 0
+	for len(jobs) < len(jobIds) {
+		messages, lastMessageId, err := server.EventRepository.ReadEvents(queue, jobSetId, fromId, jobStatusEventsBatchSize, -1)
+		if err != nil {
+			return nil, err
+		}
+		if len(messages) == 0 {
+			if lastMessageId == nil || lastMessageId.String() == fromId {
+				return jobs, nil
+			}
+			fromId = lastMessageId.String()
+			continue
+		}
+		if read += len(messages); read > maxJobSetEventsReplayed {
+			return nil, errTooManyJobSetEvents
+		}
+		for _, message := range messages {
+			if event := message.Message.GetSubmitted(); event != nil && slices.Contains(jobIds, event.JobId) {
+				job := event.Job
+				jobs[event.JobId] = &job
+			}
+			fromId = message.Id
+			if fromId == lastId {
+				return jobs, nil
+			}
+		}
+	}
+	return jobs, nil
+}
+
+// resubmitRequestItem returns a job submit request item that submits job again.
+// Server-set annotations are converted back into the fields of the item they were set from, or dropped.
+// The client id of job isn't retained, as the new job would otherwise be considered a duplicate of job.
+func resubmitRequestItem(job *api.Job) (*api.JobSubmitRequestItem, error) {
+	annotations := maps.Clone(job.Annotations)
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	item := &api.JobSubmitRequestItem{
+		Priority:           job.Priority,
+		Namespace:          job.Namespace,
+		Labels:             maps.Clone(job.Labels),
+		RequiredNodeLabels: maps.Clone(job.RequiredNodeLabels),
+		PodSpec:            job.PodSpec.DeepCopy(),
+		Ingress:            job.Ingress,
+		Services:           job.Services,
+		Scheduler:          job.Scheduler,
+		QueueTtlSeconds:    job.QueueTtlSeconds,
+		IsPreemptible:      annotations[configuration.PreemptibleAnnotation] == "true",
+	}
+	for _, podSpec := range job.PodSpecs {
+		item.PodSpecs = append(item.PodSpecs, podSpec.DeepCopy())
+	}
+
+	policy, err := retryPolicyFromAnnotations(annotations)
+	if err != nil {
+		return nil, err
+	}
+	if policy != nil {
+		item.RetryPolicy = &api.RetryPolicy{
+			MaxAttempts:      policy.maxAttempts,
+			BackoffSeconds:   int64(policy.backoff / time.Second),
+			RetryOnExitCodes: policy.exitCodes,
+		}
+	}
+	if targets := clusterTargetsFromAnnotations(annotations); !targets.isEmpty() {
+		item.ClusterTargeting = &api.ClusterTargeting{
+			Required:  targets.required,
+			Preferred: targets.preferred,
+			Excluded:  targets.excluded,
+		}
+	}
+	if value, ok := annotations[configuration.MaxRuntimeSecondsAnnotation]; ok {
+		maxRuntimeSeconds, err := strconv.ParseUint(value, 10, 32)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid %s annotation %q", configuration.MaxRuntimeSecondsAnnotation, value)
+		}
+		item.MaxRuntimeSeconds = uint32(maxRuntimeSeconds)
+	}
+
+	for _, key := range resubmitDroppedAnnotations {
+		delete(annotations, key)
+	}
+	item.Annotations = annotations
+	return item, nil
+}
+
+// applyJobSpecOverrides applies overrides, if any, to the priority and the containers of the pod specs of item.
+func applyJobSpecOverrides(item *api.JobSubmitRequestItem, overrides *api.JobSpecOverrides) {
+	if overrides == nil {
+		return
+	}
+	if overrides.Priority != nil {
+		item.Priority = overrides.Priority.Value
+	}
+	podSpecs := item.PodSpecs
+	if item.PodSpec != nil {
+		podSpecs = append(podSpecs, item.PodSpec)
+	}
+	envNames := maps.Keys(overrides.Env)
+	slices.Sort(envNames)
+	for _, podSpec := range podSpecs {
+		for i := range podSpec.Containers {
+			container := &podSpec.Containers[i]
+			if overrides.ImageTag != "" {
+				container.Image = imageWithTag(container.Image, overrides.ImageTag)
+			}
+			for _, name := range envNames {
+				setEnvVar(container, name, overrides.Env[name])
+			}
+			if overrides.Resources != nil {
+				setResources(container, overrides.Resources)
+			}
+		}
+	}
+}
+
+// imageWithTag returns image with its tag or digest, if any, replaced by tag.
+// Tags containing a colon, e.g., "sha256:4b8e...", are considered digests.
+func imageWithTag(image string, tag string) string {
+	if i := strings.Index(image, "@"); i >= 0 {
+		image = image[:i]
+	}
+	// Colons before the last slash separate the port of the registry rather than the tag.
+	if i := strings.LastIndex(image, ":"); i > strings.LastIndex(image, "/") {
+		image = image[:i]
+	}
+	if strings.Contains(tag, ":") {
+		return image + "@" + tag
+	}
+	return image + ":" + tag
+}
+
+func setEnvVar(container *v1.Container, name string, value string) {
+	for i := range container.Env {
+		if container.Env[i].Name == name {
+			container.Env[i] = v1.EnvVar{Name: name, Value: value}
+			return
+		}
+	}
+	container.Env = append(container.Env, v1.EnvVar{Name: name, Value: value})
+}
+
+// setResources replaces the requests and limits of container of the resources of resources.
+// Resources with only a request or a limit in resources are given equal request and limit.
+func setResources(container *v1.Container, resources *v1.ResourceRequirements) {
+	if container.Resources.Requests == nil {
+		container.Resources.Requests = make(v1.ResourceList)
+	}
+	if container.Resources.Limits == nil {
+		container.Resources.Limits = make(v1.ResourceList)
+	}
+	for name, quantity := range resources.Requests {
+		container.Resources.Requests[name] = quantity
+		if _, ok := resources.Limits[name]; !ok {
+			container.Resources.Limits[name] = quantity
+		}
+	}
+	for name, quantity := range resources.Limits {
+		container.Resources.Limits[name] = quantity
+		if _, ok := resources.Requests[name]; !ok {
+			container.Resources.Requests[name] = quantity
+		}
+	}
+}
@@ -0,0 +1,115 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/internal/armada/repository"
+	"github.com/armadaproject/armada/internal/common/util"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+func TestSubmitServer_ResubmitJobs(t *testing.T) {
+	withSubmitServerAndRepos(func(s *SubmitServer, jobRepo repository.JobRepository, events *repository.TestEventStore) {
+		jobSetId := util.NewULID()
+		request := createJobRequest(jobSetId, 2)
+		request.JobRequestItems[0].RetryPolicy = &api.RetryPolicy{MaxAttempts: 3, BackoffSeconds: 10}
+		request.JobRequestItems[0].Labels = map[string]string{"a": "b"}
+		// Only the server may annotate jobs as resubmitted.
+		request.JobRequestItems[0].Annotations = map[string]string{configuration.ResubmittedFromAnnotation: "forged"}
+		response, err := s.SubmitJobs(context.Background(), request)
+		require.NoError(t, err)
+		originalId := response.JobResponseItems[0].JobId
+		originalJobs, err := jobRepo.GetExistingJobsByIds([]string{originalId})
+		require.NoError(t, err)
+		require.Len(t, originalJobs, 1)
+		assert.NotContains(t, originalJobs[0].Annotations, configuration.ResubmittedFromAnnotation)
+
+		eventRepository := &fakeEventRepository{}
+		eventRepository.add(events.ReceivedEvents...)
+		s.EventRepository = eventRepository
+
+		memory := resource.MustParse("2Gi")
+		resubmitted, err := s.ResubmitJobs(context.Background(), &api.JobResubmitRequest{
+			Queue:       "test",
+			JobSetId:    jobSetId,
+			JobIds:      []string{originalId},
+			NewJobSetId: "retried",
+			Overrides: &api.JobSpecOverrides{
+				Priority:  &types.DoubleValue{Value: 3},
+				Resources: &v1.ResourceRequirements{Requests: v1.ResourceList{"memory": memory}},
+				ImageTag:  "22.04",
+				Env:       map[string]string{"DEBUG": "1"},
+			},
+		})
+		require.NoError(t, err)
+		require.Len(t, resubmitted.JobIds, 1)
+		newId := resubmitted.JobIds[originalId]
+		assert.NotEqual(t, originalId, newId)
+
+		jobs, err := jobRepo.GetExistingJobsByIds([]string{newId})
+		require.NoError(t, err)
+		require.Len(t, jobs, 1)
+		job := jobs[0]
+		assert.Equal(t, "retried", job.JobSetId)
+		assert.Empty(t, job.ClientId)
+		assert.Equal(t, float64(3), job.Priority)
+		assert.Equal(t, map[string]string{"a": "b"}, job.Labels)
+		assert.Equal(t, originalId, job.Annotations[configuration.ResubmittedFromAnnotation])
+		assert.Equal(t, "3", job.Annotations[configuration.RetryMaxAttemptsAnnotation])
+		assert.Equal(t, "10", job.Annotations[configuration.RetryBackoffSecondsAnnotation])
+
+		container := job.GetMainPodSpec().Containers[0]
+		assert.Equal(t, "index.docker.io/library/ubuntu:22.04", container.Image)
+		assert.Contains(t, container.Env, v1.EnvVar{Name: "DEBUG", Value: "1"})
+		assert.True(t, memory.Equal(container.Resources.Requests["memory"]))
+		assert.True(t, memory.Equal(container.Resources.Limits["memory"]))
+		assert.True(t, resource.MustParse("1").Equal(container.Resources.Requests["cpu"]))
+	})
+}
+
+func TestSubmitServer_ResubmitJobs_InvalidRequest(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
+		ctx := context.Background()
+		_, err := s.ResubmitJobs(ctx, &api.JobResubmitRequest{Queue: "test", JobSetId: "set", JobIds: []string{"job-1"}})
+		assert.Equal(t, codes.Unimplemented, status.Code(err))
+
+		s.EventRepository = &fakeEventRepository{}
+		_, err = s.ResubmitJobs(ctx, &api.JobResubmitRequest{Queue: "test", JobIds: []string{"job-1"}})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = s.ResubmitJobs(ctx, &api.JobResubmitRequest{Queue: "test", JobSetId: "set", JobIds: []string{"job-1", "job-1"}})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = s.ResubmitJobs(ctx, &api.JobResubmitRequest{Queue: "missing", JobSetId: "set", JobIds: []string{"job-1"}})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		_, err = s.ResubmitJobs(ctx, &api.JobResubmitRequest{Queue: "test", JobSetId: "set", JobIds: []string{"job-1"}})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
+
+func TestImageWithTag(t *testing.T) {
+	tests := map[string]struct {
+		image    string
+		tag      string
+		expected string
+	}{
+		"no tag":             {image: "ubuntu", tag: "22.04", expected: "ubuntu:22.04"},
+		"tag":                {image: "ubuntu:20.04", tag: "22.04", expected: "ubuntu:22.04"},
+		"registry with port": {image: "registry:5000/team/app:1", tag: "2", expected: "registry:5000/team/app:2"},
+		"digest":             {image: "app@sha256:abc", tag: "2", expected: "app:2"},
+		"to digest":          {image: "app:1", tag: "sha256:def", expected: "app@sha256:def"},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, imageWithTag(tc.image, tc.tag))
+		})
+	}
+}
@@ -0,0 +1,265 @@
+package server
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+	"k8s.io/utils/clock"
+
+	"github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/internal/armada/repository"
+	"github.com/armadaproject/armada/internal/common/util"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+// retryPolicy is the retry policy of a job, as stored in its annotations.
+type retryPolicy struct {
+	maxAttempts uint32
+	backoff     time.Duration
+	exitCodes   []int32
+}
+
+// retryPolicyAnnotations returns the annotations by which the server identifies the retry policy of a job, if any,
+// after validating policy. Returns an error if annotations already contain any retry annotations, since these are
+// only set by the server, such that retry policies are always validated.
+func retryPolicyAnnotations(policy *api.RetryPolicy, annotations map[string]string) (map[string]string, error) {
+	for _, key := range []string{
+		configuration.RetryMaxAttemptsAnnotation,
+		configuration.RetryBackoffSecondsAnnotation,
+		configuration.RetryOnExitCodesAnnotation,
+	} {
+		if _, ok := annotations[key]; ok {
+			return nil, errors.Errorf("annotation %s is set by the server and may not be set directly; use retryPolicy instead", key)
+		}
+	}
+	if policy == nil {
+		return nil, nil
+	}
+	if _, ok := annotations[configuration.GangIdAnnotation]; ok {
+		return nil, errors.New("retry policy may not be set for members of gangs")
+	}
+	if policy.MaxAttempts == 0 {
+		return nil, errors.New("retry policy max attempts must be positive")
+	}
+	if policy.BackoffSeconds < 0 {
+		return nil, errors.Errorf("retry policy backoff of %d seconds must not be negative", policy.BackoffSeconds)
+	}
+	exitCodes := make([]string, len(policy.RetryOnExitCodes))
+	for i, exitCode := range policy.RetryOnExitCodes {
+		if exitCode == 0 {
+			return nil, errors.New("retry policy exit codes must not include 0, the exit code of successful containers")
+		}
+		exitCodes[i] = strconv.Itoa(int(exitCode))
+	}
+	result := map[string]string{
+		configuration.RetryMaxAttemptsAnnotation:    strconv.FormatUint(uint64(policy.MaxAttempts), 10),
+		configuration.RetryBackoffSecondsAnnotation: strconv.FormatInt(policy.BackoffSeconds, 10),
+	}
+	if len(exitCodes) > 0 {
+		result[configuration.RetryOnExitCodesAnnotation] = strings.Join(exitCodes, ",")
+	}
+	return result, nil
+}
+
+// retryPolicyFromAnnotations returns the retry policy stored in annotations, or nil if there is none.
+func retryPolicyFromAnnotations(annotations map[string]string) (*retryPolicy, error) {
+	value, ok := annotations[configuration.RetryMaxAttemptsAnnotation]
+	if !ok {
+		return nil, nil
+	}
+	maxAttempts, err := strconv.ParseUint(value, 10, 32)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid %s annotation %q", configuration.RetryMaxAttemptsAnnotation, value)
+	}
+	policy := &retryPolicy{maxAttempts: uint32(maxAttempts)}
+	if value, ok := annotations[configuration.RetryBackoffSecondsAnnotation]; ok {
+		backoffSeconds, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid %s annotation %q", configuration.RetryBackoffSecondsAnnotation, value)
+		}
+		policy.backoff = time.Duration(backoffSeconds) * time.Second
+	}
+	if value, ok := annotations[configuration.RetryOnExitCodesAnnotation]; ok && value != "" {
+		for _, s := range strings.Split(value, ",") {
+			exitCode, err := strconv.ParseInt(s, 10, 32)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid %s annotation %q", configuration.RetryOnExitCodesAnnotation, value)
+			}
+			policy.exitCodes = append(policy.exitCodes, int32(exitCode))
+		}
+	}
+	return policy, nil
+}
+
+// shouldRetry returns true if the run of job that failed as described by event is to be retried.
+func (p *retryPolicy) shouldRetry(job *api.Job, event *api.JobFailedEvent) bool {
+	if job.FailedAttempts+1 >= p.maxAttempts {
+		return false
+	}
+	if len(p.exitCodes) == 0 {
+		return true
+	}
+	for _, containerStatus := range event.ContainerStatuses {
+		if slices.Contains(p.exitCodes, containerStatus.ExitCode) {
+			return true
+		}
+	}
+	for _, exitCode := range event.ExitCodes {
+		if slices.Contains(p.exitCodes, exitCode) {
+			return true
+		}
+	}
+	return false
+}
+
+// RetryController retries failed runs of jobs of the legacy scheduler as per the retry policies of the jobs.
+// Once a failed event of a run to be retried is reported, the job is marked as pending retry. Then, instead of being
+// deleted once its executor reports it done, the job is returned to the queue, held until the backoff has passed.
+type RetryController struct {
+	jobRepository repository.JobRepository
+	eventStore    repository.EventStore
+	clock         clock.Clock
+}
+
+func NewRetryController(jobRepository repository.JobRepository, eventStore repository.EventStore) *RetryController {
+	return &RetryController{
+		jobRepository: jobRepository,
+		eventStore:    eventStore,
+		clock:         clock.RealClock{},
+	}
+}
+
+// HandleFailedEvents marks the jobs of those failed events among events that are to be retried as pending retry,
+// and sets the attempt, and whether the run is retried, of the failed events of jobs with a retry policy.
+// Must be called before events are reported. Since events are reported again if reporting them fails, failed events
+// of jobs already pending retry are those of the run that was marked to be retried, and are marked as retried again.
+func (c *RetryController) HandleFailedEvents(events []*api.EventMessage) error {
+	failedEventsByJobId := make(map[string]*api.JobFailedEvent)
+	for _, event := range events {
+		if failed := event.GetFailed(); failed != nil {
+			failedEventsByJobId[failed.JobId] = failed
+		}
+	}
+	if len(failedEventsByJobId) == 0 {
+		return nil
+	}
+	jobs, err := c.jobRepository.GetExistingJobsByIds(maps.Keys(failedEventsByJobId))
+	if err != nil {
+		return errors.WithMessage(err, "[RetryController.HandleFailedEvents] error getting jobs of failed events")
+	}
+
+	var retriedJobIds []string
+	for _, job := range jobs {
+		policy, err := retryPolicyFromAnnotations(job.Annotations)
+		if err != nil {
+			log.WithError(err).Warnf("[RetryController.HandleFailedEvents] ignoring invalid retry policy of job %s", job.Id)
+			continue
+		} else if policy == nil {
+			continue
+		}
+		failed := failedEventsByJobId[job.Id]
+		if job.RetryPending {
+			failed.Attempt = job.FailedAttempts
+			failed.WillRetry = true
+			continue
+		}
+		failed.Attempt = job.FailedAttempts + 1
+		if policy.shouldRetry(job, failed) {
+			retriedJobIds = append(retriedJobIds, job.Id)
+		}
+	}
+	if len(retriedJobIds) == 0 {
+		return nil
+	}
+
+	now := c.clock.Now()
+	results, err := c.jobRepository.UpdateJobs(retriedJobIds, func(jobs []*api.Job) {
+		for _, job := range jobs {
+			if job.RetryPending {
+				continue
+			}
+			policy, err := retryPolicyFromAnnotations(job.Annotations)
+			if err != nil || policy == nil {
+				continue
+			}
+			job.FailedAttempts++
+			job.RetryPending = true
+			if policy.backoff > 0 {
+				if job.Annotations == nil {
+					job.Annotations = make(map[string]string)
+				}
+				job.Annotations[configuration.HeldUntilAnnotation] = now.Add(policy.backoff).UTC().Format(time.RFC3339)
+			}
+		}
+	})
+	if err != nil {
+		return errors.WithMessage(err, "[RetryController.HandleFailedEvents] error marking jobs as pending retry")
+	}
+	for _, result := range results {
+		if result.Error != nil {
+			log.WithError(result.Error).Warnf("[RetryController.HandleFailedEvents] error marking job %s as pending retry", result.JobId)
+			continue
+		}
+		failed := failedEventsByJobId[result.JobId]
+		failed.WillRetry = result.Job.RetryPending && result.Job.FailedAttempts == failed.Attempt
+	}
+	return nil
+}
+
+// RequeueRetriedJobs returns those of jobs that are pending retry to the queue, and reports a queued event for each.
+// Returns the jobs that aren't pending retry, and the ids of the jobs that were returned to the queue.
+func (c *RetryController) RequeueRetriedJobs(jobs []*api.Job) ([]*api.Job, []string, error) {
+	var remainingJobs []*api.Job
+	var pendingJobIds []string
+	for _, job := range jobs {
+		if job.RetryPending {
+			pendingJobIds = append(pendingJobIds, job.Id)
+		} else {
+			remainingJobs = append(remainingJobs, job)
+		}
+	}
+	if len(pendingJobIds) == 0 {
+		return remainingJobs, nil, nil
+	}
+	clusterIds, err := c.jobRepository.GetLeasedJobClusterIds(pendingJobIds)
+	if err != nil {
+		return nil, nil, errors.WithMessage(err, "[RetryController.RequeueRetriedJobs] error getting clusters of jobs")
+	}
+	results, err := c.jobRepository.UpdateJobs(pendingJobIds, func(jobs []*api.Job) {
+		for _, job := range jobs {
+			job.RetryPending = false
+		}
+	})
+	if err != nil {
+		return nil, nil, errors.WithMessage(err, "[RetryController.RequeueRetriedJobs] error clearing pending retries")
+	}
+
+	var requeuedJobs []*api.Job
+	for _, result := range results {
+		if result.Error != nil {
+			return nil, nil, errors.WithMessagef(result.Error, "[RetryController.RequeueRetriedJobs] error clearing pending retry of job %s", result.JobId)
+		}
+		clusterId, ok := clusterIds[result.JobId]
+		if !ok {
+			// The job is no longer leased, e.g., because its lease expired; it's done as far as its executor is concerned.
+			remainingJobs = append(remainingJobs, result.Job)
+			continue
+		}
+		returnedJob, err := c.jobRepository.ReturnLease(clusterId, result.JobId)
+		if err != nil {
+			return nil, nil, errors.WithMessagef(err, "[RetryController.RequeueRetriedJobs] error returning job %s to the queue", result.JobId)
+		}
+		if returnedJob != nil {
+			requeuedJobs = append(requeuedJobs, result.Job)
+		}
+	}
+	if err := reportJobsRetried(c.eventStore, requeuedJobs); err != nil {
+		return nil, nil, err
+	}
+	return remainingJobs, util.Map(requeuedJobs, func(job *api.Job) string { return job.Id }), nil
+}
@@ -0,0 +1,217 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clock "k8s.io/utils/clock/testing"
+
+	"github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/internal/armada/repository"
+	"github.com/armadaproject/armada/internal/armada/scheduling"
+	"github.com/armadaproject/armada/internal/common/util"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+func TestSubmitServer_CreateJobs_SetsRetryPolicyAnnotations(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
+		request := createJobRequest("jobSetId", 1)
+		request.JobRequestItems[0].RetryPolicy = &api.RetryPolicy{MaxAttempts: 3, BackoffSeconds: 30, RetryOnExitCodes: []int32{137, 1}}
+
+		jobs, responseItems, err := s.createJobs(request, "owner")
+		require.NoError(t, err)
+		require.Empty(t, responseItems)
+		require.Len(t, jobs, 1)
+		assert.Equal(t, "3", jobs[0].Annotations[configuration.RetryMaxAttemptsAnnotation])
+		assert.Equal(t, "30", jobs[0].Annotations[configuration.RetryBackoffSecondsAnnotation])
+		assert.Equal(t, "137,1", jobs[0].Annotations[configuration.RetryOnExitCodesAnnotation])
+	})
+}
+
+func TestSubmitServer_CreateJobs_ValidatesRetryPolicy(t *testing.T) {
+	tests := map[string]struct {
+		policy      *api.RetryPolicy
+		annotations map[string]string
+	}{
+		"zero max attempts": {
+			policy: &api.RetryPolicy{},
+		},
+		"negative backoff": {
+			policy: &api.RetryPolicy{MaxAttempts: 2, BackoffSeconds: -1},
+		},
+		"zero exit code": {
+			policy: &api.RetryPolicy{MaxAttempts: 2, RetryOnExitCodes: []int32{0}},
+		},
+		"retry annotation set": {
+			policy:      &api.RetryPolicy{MaxAttempts: 2},
+			annotations: map[string]string{configuration.RetryMaxAttemptsAnnotation: "5"},
+		},
+		"retry annotation set without retry policy": {
+			annotations: map[string]string{configuration.RetryMaxAttemptsAnnotation: "5"},
+		},
+		"retry exit codes annotation set without retry policy": {
+			annotations: map[string]string{configuration.RetryOnExitCodesAnnotation: "1"},
+		},
+		"gang member": {
+			policy:      &api.RetryPolicy{MaxAttempts: 2},
+			annotations: map[string]string{configuration.GangIdAnnotation: "gang", configuration.GangCardinalityAnnotation: "1"},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
+				request := createJobRequest("jobSetId", 1)
+				request.JobRequestItems[0].RetryPolicy = tc.policy
+				request.JobRequestItems[0].Annotations = tc.annotations
+
+				_, responseItems, err := s.createJobs(request, "owner")
+				assert.Error(t, err)
+				require.Len(t, responseItems, 1)
+				assert.Equal(t, api.JobSubmitError_INVALID_JOB, responseItems[0].ErrorDetails.Code)
+				assert.Equal(t, "retryPolicy", responseItems[0].ErrorDetails.Field)
+			})
+		})
+	}
+}
+
+func TestRetryController_RetriesFailedRuns(t *testing.T) {
+	withSubmitServerAndRepos(func(s *SubmitServer, jobRepo repository.JobRepository, events *repository.TestEventStore) {
+		request := createJobRequest(util.NewULID(), 3)
+		request.JobRequestItems[0].RetryPolicy = &api.RetryPolicy{MaxAttempts: 2, BackoffSeconds: 60}
+		request.JobRequestItems[1].RetryPolicy = &api.RetryPolicy{MaxAttempts: 2, RetryOnExitCodes: []int32{137}}
+		result, err := s.SubmitJobs(context.Background(), request)
+		require.NoError(t, err)
+		jobIds := util.Map(result.JobResponseItems, func(item *api.JobSubmitResponseItem) string { return item.JobId })
+		_, err = jobRepo.TryLeaseJobs("cluster", map[string][]string{"test": jobIds})
+		require.NoError(t, err)
+		events.ReceivedEvents = nil
+
+		now := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+		c := NewRetryController(jobRepo, events)
+		c.clock = clock.NewFakeClock(now)
+
+		// Only the first job is retried, since the second didn't fail with a matching exit code
+		// and the third has no retry policy.
+		failedEvents := util.Map(jobIds, func(jobId string) *api.EventMessage {
+			return newFailedEventMessage(jobId, 1)
+		})
+		require.NoError(t, c.HandleFailedEvents(failedEvents))
+		assert.Equal(t, uint32(1), failedEvents[0].GetFailed().Attempt)
+		assert.True(t, failedEvents[0].GetFailed().WillRetry)
+		assert.Equal(t, uint32(1), failedEvents[1].GetFailed().Attempt)
+		assert.False(t, failedEvents[1].GetFailed().WillRetry)
+		assert.Zero(t, failedEvents[2].GetFailed().Attempt)
+		assert.False(t, failedEvents[2].GetFailed().WillRetry)
+
+		jobs, err := jobRepo.GetExistingJobsByIds(jobIds)
+		require.NoError(t, err)
+		remaining, requeuedIds, err := c.RequeueRetriedJobs(jobs)
+		require.NoError(t, err)
+		assert.Equal(t, []string{jobIds[0]}, requeuedIds)
+		assert.Equal(t, jobIds[1:], util.Map(remaining, func(job *api.Job) string { return job.Id }))
+		require.Len(t, events.ReceivedEvents, 1)
+		assert.Equal(t, jobIds[0], events.ReceivedEvents[0].GetQueued().GetJobId())
+		assert.Equal(t, uint32(2), events.ReceivedEvents[0].GetQueued().GetAttempt())
+
+		queuedIds, err := jobRepo.GetQueueJobIds("test")
+		require.NoError(t, err)
+		assert.Equal(t, []string{jobIds[0]}, queuedIds)
+		retried, err := jobRepo.GetExistingJobsByIds(jobIds[:1])
+		require.NoError(t, err)
+		require.Len(t, retried, 1)
+		assert.Equal(t, uint32(1), retried[0].FailedAttempts)
+		assert.False(t, retried[0].RetryPending)
+		assert.Equal(t, now.Add(time.Minute).Format(time.RFC3339), retried[0].Annotations[configuration.HeldUntilAnnotation])
+
+		// The job isn't retried beyond its max attempts.
+		_, err = jobRepo.TryLeaseJobs("cluster", map[string][]string{"test": jobIds[:1]})
+		require.NoError(t, err)
+		failedEvents = []*api.EventMessage{newFailedEventMessage(jobIds[0], 1)}
+		require.NoError(t, c.HandleFailedEvents(failedEvents))
+		assert.Equal(t, uint32(2), failedEvents[0].GetFailed().Attempt)
+		assert.False(t, failedEvents[0].GetFailed().WillRetry)
+
+		events.ReceivedEvents = nil
+		remaining, requeuedIds, err = c.RequeueRetriedJobs(retried)
+		require.NoError(t, err)
+		assert.Empty(t, requeuedIds)
+		assert.Len(t, remaining, 1)
+		assert.Empty(t, events.ReceivedEvents)
+	})
+}
+
+func TestRetryController_HandlesFailedEventsReportedAgain(t *testing.T) {
+	withSubmitServerAndRepos(func(s *SubmitServer, jobRepo repository.JobRepository, events *repository.TestEventStore) {
+		request := createJobRequest(util.NewULID(), 1)
+		request.JobRequestItems[0].RetryPolicy = &api.RetryPolicy{MaxAttempts: 3}
+		result, err := s.SubmitJobs(context.Background(), request)
+		require.NoError(t, err)
+		jobId := result.JobResponseItems[0].JobId
+		_, err = jobRepo.TryLeaseJobs("cluster", map[string][]string{"test": {jobId}})
+		require.NoError(t, err)
+
+		c := NewRetryController(jobRepo, events)
+		failedEvents := []*api.EventMessage{newFailedEventMessage(jobId, 1)}
+		require.NoError(t, c.HandleFailedEvents(failedEvents))
+		assert.Equal(t, uint32(1), failedEvents[0].GetFailed().Attempt)
+		assert.True(t, failedEvents[0].GetFailed().WillRetry)
+
+		// The executor reports the same failed event again, e.g., because reporting it failed after the job was marked
+		// as pending retry; it's marked as retried, with the same attempt, and the job isn't marked again.
+		failedEvents = []*api.EventMessage{newFailedEventMessage(jobId, 1)}
+		require.NoError(t, c.HandleFailedEvents(failedEvents))
+		assert.Equal(t, uint32(1), failedEvents[0].GetFailed().Attempt)
+		assert.True(t, failedEvents[0].GetFailed().WillRetry)
+
+		jobs, err := jobRepo.GetExistingJobsByIds([]string{jobId})
+		require.NoError(t, err)
+		require.Len(t, jobs, 1)
+		assert.True(t, jobs[0].RetryPending)
+		assert.Equal(t, uint32(1), jobs[0].FailedAttempts)
+	})
+}
+
+func TestLeaseManager_ExpireLeases_ClearsPendingRetries(t *testing.T) {
+	withSubmitServerAndRepos(func(s *SubmitServer, jobRepo repository.JobRepository, events *repository.TestEventStore) {
+		request := createJobRequest(util.NewULID(), 1)
+		request.JobRequestItems[0].RetryPolicy = &api.RetryPolicy{MaxAttempts: 3}
+		result, err := s.SubmitJobs(context.Background(), request)
+		require.NoError(t, err)
+		jobId := result.JobResponseItems[0].JobId
+		_, err = jobRepo.TryLeaseJobs("cluster", map[string][]string{"test": {jobId}})
+		require.NoError(t, err)
+
+		c := NewRetryController(jobRepo, events)
+		require.NoError(t, c.HandleFailedEvents([]*api.EventMessage{newFailedEventMessage(jobId, 1)}))
+
+		// The executor never reports the job done, so its lease expires, returning it to the queue.
+		scheduling.NewLeaseManager(jobRepo, s.queueRepository, events, -time.Minute).ExpireLeases()
+		queuedIds, err := jobRepo.GetQueueJobIds("test")
+		require.NoError(t, err)
+		assert.Equal(t, []string{jobId}, queuedIds)
+		jobs, err := jobRepo.GetExistingJobsByIds([]string{jobId})
+		require.NoError(t, err)
+		require.Len(t, jobs, 1)
+		assert.False(t, jobs[0].RetryPending)
+		assert.Equal(t, uint32(1), jobs[0].FailedAttempts)
+
+		// Hence its next failed run is retried as well.
+		_, err = jobRepo.TryLeaseJobs("cluster", map[string][]string{"test": {jobId}})
+		require.NoError(t, err)
+		failedEvents := []*api.EventMessage{newFailedEventMessage(jobId, 1)}
+		require.NoError(t, c.HandleFailedEvents(failedEvents))
+		assert.Equal(t, uint32(2), failedEvents[0].GetFailed().Attempt)
+		assert.True(t, failedEvents[0].GetFailed().WillRetry)
+	})
+}
+
+func newFailedEventMessage(jobId string, exitCode int32) *api.EventMessage {
+	return &api.EventMessage{Events: &api.EventMessage_Failed{Failed: &api.JobFailedEvent{
+		JobId:     jobId,
+		ClusterId: "cluster",
+		ExitCodes: map[string]int32{"container": exitCode},
+	}}}
+}
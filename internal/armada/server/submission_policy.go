@@ -0,0 +1,201 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/auth/authorization"
+	armadaresource "github.com/armadaproject/armada/internal/common/resource"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+// SubmissionPolicy decides whether submitted jobs are admitted, complementing the static validation of jobs.
+type SubmissionPolicy interface {
+	// Evaluate returns the reasons for denying each of inputs. Jobs without any deny reasons are admitted.
+	Evaluate(ctx *armadacontext.Context, inputs []*SubmissionPolicyInput) ([][]string, error)
+}
+
+// SubmissionPolicyInput describes a submitted job to a submission policy.
+type SubmissionPolicyInput struct {
+	Queue             string                     `json:"queue"`
+	JobSetId          string                     `json:"jobSetId"`
+	Principal         *SubmissionPolicyPrincipal `json:"principal"`
+	Namespace         string                     `json:"namespace"`
+	PriorityClassName string                     `json:"priorityClassName"`
+	// Images of all containers of the job, including init containers.
+	Images []string `json:"images"`
+	// Total resource requests of the job, e.g., {"cpu": "500m", "memory": "1Gi"}.
+	Resources   map[string]string `json:"resources"`
+	Labels      map[string]string `json:"labels"`
+	Annotations map[string]string `json:"annotations"`
+}
+
+// SubmissionPolicyPrincipal is the user submitting a job.
+type SubmissionPolicyPrincipal struct {
+	Name   string   `json:"name"`
+	Groups []string `json:"groups"`
+}
+
+func newSubmissionPolicyInput(job *api.Job, principal authorization.Principal) *SubmissionPolicyInput {
+	input := &SubmissionPolicyInput{
+		Queue:             job.Queue,
+		JobSetId:          job.JobSetId,
+		Principal:         &SubmissionPolicyPrincipal{Name: principal.GetName(), Groups: principal.GetGroupNames()},
+		Namespace:         job.Namespace,
+		PriorityClassName: job.GetPriorityClassName(),
+		Images:            []string{},
+		Resources:         make(map[string]string),
+		Labels:            job.Labels,
+		Annotations:       job.Annotations,
+	}
+	podSpecs := job.PodSpecs
+	if job.PodSpec != nil {
+		podSpecs = append([]*v1.PodSpec{job.PodSpec}, podSpecs...)
+	}
+	resources := armadaresource.ComputeResources{}
+	for _, podSpec := range podSpecs {
+		for _, container := range podSpec.InitContainers {
+			input.Images = append(input.Images, container.Image)
+		}
+		for _, container := range podSpec.Containers {
+			input.Images = append(input.Images, container.Image)
+		}
+		resources.Add(armadaresource.TotalPodResourceRequest(podSpec))
+	}
+	for name, quantity := range resources {
+		input.Resources[name] = quantity.String()
+	}
+	return input
+}
+
+// evaluateSubmissionPolicy returns a response item for each of jobs denied by the submission policy of the server,
+// if any. Returns an error if the policy couldn't be evaluated.
+func (server *SubmitServer) evaluateSubmissionPolicy(
+	ctx *armadacontext.Context,
+	jobs []*api.Job,
+	principal authorization.Principal,
+) ([]*api.JobSubmitResponseItem, error) {
+	if server.SubmissionPolicy == nil || len(jobs) == 0 {
+		return nil, nil
+	}
+	inputs := make([]*SubmissionPolicyInput, len(jobs))
+	for i, job := range jobs {
+		inputs[i] = newSubmissionPolicyInput(job, principal)
+	}
+	denyReasons, err := server.SubmissionPolicy.Evaluate(ctx, inputs)
+	if err != nil {
+		return nil, err
+	}
+	var responseItems []*api.JobSubmitResponseItem
+	for i, job := range jobs {
+		if len(denyReasons[i]) == 0 {
+			continue
+		}
+		message := fmt.Sprintf("denied by submission policy: %s", strings.Join(denyReasons[i], "; "))
+		responseItems = append(responseItems, api.NewFailedJobSubmitResponseItem(job.Id, api.JobSubmitError_POLICY_VIOLATION, "", message))
+	}
+	return responseItems, nil
+}
+
+// OpaSubmissionPolicy evaluates jobs against a rule of an Open Policy Agent, via its data API.
+// The rule is evaluated with a SubmissionPolicyInput as input, and must return a set of deny reasons, e.g.,
+//
+//	package armada.submission
+//
+//	deny contains msg if {
+//		some image in input.images
+//		not startswith(image, "registry.example.com/")
+//		msg := sprintf("image %s is not from the internal registry", [image])
+//	}
+type OpaSubmissionPolicy struct {
+	url                      string
+	client                   *http.Client
+	timeout                  time.Duration
+	maxConcurrentEvaluations int
+}
+
+func NewOpaSubmissionPolicy(config configuration.SubmissionPolicyConfig) *OpaSubmissionPolicy {
+	return &OpaSubmissionPolicy{
+		url:                      strings.TrimSuffix(config.OpaUrl, "/") + "/v1/data/" + strings.Trim(config.Path, "/"),
+		client:                   &http.Client{},
+		timeout:                  config.Timeout,
+		maxConcurrentEvaluations: config.MaxConcurrentEvaluations,
+	}
+}
+
+// Evaluate evaluates each distinct input once, such that submitting many identical jobs requires a single evaluation.
+func (p *OpaSubmissionPolicy) Evaluate(ctx *armadacontext.Context, inputs []*SubmissionPolicyInput) ([][]string, error) {
+	requests := make(map[string][]int)
+	for i, input := range inputs {
+		body, err := json.Marshal(map[string]*SubmissionPolicyInput{"input": input})
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		requests[string(body)] = append(requests[string(body)], i)
+	}
+
+	if p.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = armadacontext.WithTimeout(ctx, p.timeout)
+		defer cancel()
+	}
+	result := make([][]string, len(inputs))
+	g, ctx := armadacontext.ErrGroup(ctx)
+	if p.maxConcurrentEvaluations > 0 {
+		g.SetLimit(p.maxConcurrentEvaluations)
+	}
+	for body, indices := range requests {
+		body, indices := body, indices
+		g.Go(func() error {
+			denyReasons, err := p.evaluate(ctx, []byte(body))
+			if err != nil {
+				return err
+			}
+			// Each index is written by a single goroutine.
+			for _, i := range indices {
+				result[i] = denyReasons
+			}
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (p *OpaSubmissionPolicy) evaluate(ctx *armadacontext.Context, body []byte) ([]string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.url, bytes.NewReader(body))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "error evaluating submission policy")
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		message, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return nil, errors.Errorf("error evaluating submission policy: status %s: %s", resp.Status, message)
+	}
+	// The result is omitted if the rule is undefined, i.e., if there are no deny reasons.
+	var response struct {
+		Result []string `json:"result"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&response); err != nil {
+		return nil, errors.Wrap(err, "error decoding submission policy result; the rule must return a set of strings")
+	}
+	return response.Result, nil
+}
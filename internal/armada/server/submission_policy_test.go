@@ -0,0 +1,123 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/internal/armada/repository"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/util"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+func TestOpaSubmissionPolicy_Evaluate(t *testing.T) {
+	var requests atomic.Int32
+	opa := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		assert.Equal(t, "/v1/data/armada/submission/deny", r.URL.Path)
+		var body struct {
+			Input *SubmissionPolicyInput `json:"input"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		if body.Input.Namespace == "forbidden" {
+			_, _ = w.Write([]byte(`{"result": ["namespace forbidden is reserved", "no really"]}`))
+		} else {
+			_, _ = w.Write([]byte(`{}`))
+		}
+	}))
+	defer opa.Close()
+
+	policy := NewOpaSubmissionPolicy(configuration.SubmissionPolicyConfig{
+		OpaUrl:                   opa.URL + "/",
+		Path:                     "/armada/submission/deny",
+		Timeout:                  time.Second,
+		MaxConcurrentEvaluations: 2,
+	})
+	inputs := []*SubmissionPolicyInput{
+		{Queue: "queue", Namespace: "forbidden"},
+		{Queue: "queue", Namespace: "default"},
+		{Queue: "queue", Namespace: "forbidden"},
+	}
+	denyReasons, err := policy.Evaluate(armadacontext.Background(), inputs)
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{{"namespace forbidden is reserved", "no really"}, nil, {"namespace forbidden is reserved", "no really"}}, denyReasons)
+	// Identical inputs are evaluated once.
+	assert.Equal(t, int32(2), requests.Load())
+}
+
+func TestOpaSubmissionPolicy_Evaluate_ReturnsErrorOfOpa(t *testing.T) {
+	opa := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "policy compilation failed", http.StatusInternalServerError)
+	}))
+	defer opa.Close()
+
+	policy := NewOpaSubmissionPolicy(configuration.SubmissionPolicyConfig{OpaUrl: opa.URL, Path: "armada/submission/deny"})
+	_, err := policy.Evaluate(armadacontext.Background(), []*SubmissionPolicyInput{{Queue: "queue"}})
+	assert.ErrorContains(t, err, "policy compilation failed")
+}
+
+func TestSubmitServer_SubmitJobs_RejectsJobsDeniedBySubmissionPolicy(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
+		policy := &testSubmissionPolicy{deny: func(input *SubmissionPolicyInput) []string {
+			if input.Labels["team"] != "" {
+				return nil
+			}
+			return []string{"jobs must be labelled with their team"}
+		}}
+		s.SubmissionPolicy = policy
+
+		request := createJobRequest(util.NewULID(), 2)
+		request.JobRequestItems[0].Labels = map[string]string{"team": "ml"}
+		_, err := s.SubmitJobs(context.Background(), request)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Equal(t, []api.JobSubmitError_Code{api.JobSubmitError_POLICY_VIOLATION}, jobSubmitErrorCodes(err))
+		assert.ErrorContains(t, err, "jobs must be labelled with their team")
+
+		// The policy is evaluated on the images and resources of jobs.
+		require.Len(t, policy.inputs, 2)
+		assert.Equal(t, "test", policy.inputs[0].Queue)
+		assert.NotEmpty(t, policy.inputs[0].Images)
+		assert.NotEmpty(t, policy.inputs[0].Resources)
+
+		request.JobRequestItems[1].Labels = map[string]string{"team": "ml"}
+		_, err = s.SubmitJobs(context.Background(), request)
+		assert.NoError(t, err)
+	})
+}
+
+func TestSubmitServer_SubmitJobs_EvaluatesSubmissionPolicyOnlyForAuthorizedSubmissions(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
+		policy := &testSubmissionPolicy{deny: func(input *SubmissionPolicyInput) []string { return nil }}
+		s.SubmissionPolicy = policy
+		s.authorizer = &FakeDenyAllActionAuthorizer{}
+
+		_, err := s.SubmitJobs(context.Background(), createJobRequest(util.NewULID(), 1))
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		assert.Nil(t, policy.inputs)
+	})
+}
+
+type testSubmissionPolicy struct {
+	deny   func(input *SubmissionPolicyInput) []string
+	inputs []*SubmissionPolicyInput
+}
+
+func (p *testSubmissionPolicy) Evaluate(_ *armadacontext.Context, inputs []*SubmissionPolicyInput) ([][]string, error) {
+	p.inputs = inputs
+	result := make([][]string, len(inputs))
+	for i, input := range inputs {
+		result[i] = p.deny(input)
+	}
+	return result, nil
+}
@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"math"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -13,19 +15,27 @@ import (
 	pool "github.com/jolestar/go-commons-pool"
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
+	"golang.org/x/exp/maps"
 	"google.golang.org/grpc/codes"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/clock"
 	"k8s.io/utils/strings/slices"
 
 	"github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/internal/armada/imageresolver"
 	"github.com/armadaproject/armada/internal/armada/permissions"
 	"github.com/armadaproject/armada/internal/armada/repository"
 	servervalidation "github.com/armadaproject/armada/internal/armada/validation"
 	"github.com/armadaproject/armada/internal/common/armadacontext"
 	"github.com/armadaproject/armada/internal/common/armadaerrors"
 	"github.com/armadaproject/armada/internal/common/auth/authorization"
+	"github.com/armadaproject/armada/internal/common/auth/permission"
 	"github.com/armadaproject/armada/internal/common/compress"
+	armadaresource "github.com/armadaproject/armada/internal/common/resource"
 	"github.com/armadaproject/armada/internal/common/util"
 	"github.com/armadaproject/armada/internal/common/validation"
+	"github.com/armadaproject/armada/internal/scheduler"
 	"github.com/armadaproject/armada/pkg/api"
 	"github.com/armadaproject/armada/pkg/client/queue"
 )
@@ -36,10 +46,40 @@ type SubmitServer struct {
 	queueRepository          repository.QueueRepository
 	eventStore               repository.EventStore
 	schedulingInfoRepository repository.SchedulingInfoRepository
-	cancelJobsBatchSize      int
-	queueManagementConfig    *configuration.QueueManagementConfig
-	schedulingConfig         *configuration.SchedulingConfig
-	compressorPool           *pool.ObjectPool
+	barrierRepository        repository.BarrierRepository
+	operationRepository      repository.OperationRepository
+	// Stores the errors of all jobs of rejected submissions, if there are too many to include in the response.
+	submitFailureReportRepository repository.SubmitFailureReportRepository
+	ownershipGroupsRepository     repository.OwnershipGroupsRepository
+	cancelJobsBatchSize           int
+	cancelJobsParallelism         int
+	queueManagementConfig         *configuration.QueueManagementConfig
+	schedulingConfig              *configuration.SchedulingConfig
+	submitFailureConfig           *configuration.SubmitFailureConfig
+	compressorPool                *pool.ObjectPool
+	clock                         clock.Clock
+	// Scheduling contexts of recent rounds of the legacy scheduler of this replica.
+	SchedulingContextRepository *scheduler.SchedulingContextRepository
+	// Most recent rounds of the legacy scheduler across all replicas, used to explain why queued jobs haven't been scheduled.
+	// If nil, such explanations are derived from the state of the job only.
+	SchedulingRoundRepository repository.SchedulingRoundRepository
+	// Checks the images of submitted jobs against their registries. If nil, images aren't checked.
+	ImageResolver *imageresolver.Resolver
+	// Policy submitted jobs are evaluated against after validation. If nil, jobs aren't evaluated against any policy.
+	SubmissionPolicy SubmissionPolicy
+	// Used to read the specs of jobs to resubmit from the events of their job sets.
+	// If nil, ResubmitJobs fails with Unimplemented.
+	EventRepository repository.EventRepository
+	// Accounts the usage of the resource budgets of queues. If nil, budgets aren't enforced and GetQueueBudgets fails with Unimplemented.
+	BudgetAccountant *BudgetAccountant
+	// Stores the cordons of queues and executors. If nil, cordoning fails with Unimplemented.
+	CordonRepository repository.CordonRepository
+	// Stores the maintenance windows of queues and executors. If nil, maintenance windows fail with Unimplemented.
+	MaintenanceWindowRepository repository.MaintenanceWindowRepository
+	// Records the heartbeats and health of executors. If nil, GetExecutors and GetExecutor fail with Unimplemented.
+	ExecutorHealthMonitor *ExecutorHealthMonitor
+	// Retrieves the logs of jobs from the clusters they ran on. If nil, GetJobLogs fails with Unimplemented.
+	JobLogProxy *JobLogProxy
 }
 
 type JobSubmitError struct {
@@ -57,22 +97,39 @@ func (e *JobSubmitError) Error() string {
 	return output
 }
 
+// duplicateJobError returns the error details of the response item of a job that wasn't created,
+// since a job with the same client id was submitted before.
+func duplicateJobError(job *api.Job) *api.JobSubmitError {
+	return &api.JobSubmitError{
+		Code:    api.JobSubmitError_DUPLICATE,
+		Field:   "clientId",
+		Message: fmt.Sprintf("a job with client id %s was submitted before", job.ClientId),
+	}
+}
+
 func NewSubmitServer(
 	authorizer ActionAuthorizer,
 	jobRepository repository.JobRepository,
 	queueRepository repository.QueueRepository,
 	eventStore repository.EventStore,
 	schedulingInfoRepository repository.SchedulingInfoRepository,
+	barrierRepository repository.BarrierRepository,
+	operationRepository repository.OperationRepository,
+	submitFailureReportRepository repository.SubmitFailureReportRepository,
+	ownershipGroupsRepository repository.OwnershipGroupsRepository,
 	cancelJobsBatchSize int,
+	cancelJobsParallelism int,
 	queueManagementConfig *configuration.QueueManagementConfig,
 	schedulingConfig *configuration.SchedulingConfig,
+	submitFailureConfig *configuration.SubmitFailureConfig,
+	compressionConfig *configuration.CompressionConfig,
 ) *SubmitServer {
 	poolConfig := pool.ObjectPoolConfig{
-		MaxTotal:                 100,
-		MaxIdle:                  50,
-		MinIdle:                  10,
-		BlockWhenExhausted:       true,
-		MinEvictableIdleTime:     30 * time.Minute,
+		MaxTotal:                 compressionConfig.CompressorPool.MaxTotal,
+		MaxIdle:                  compressionConfig.CompressorPool.MaxIdle,
+		MinIdle:                  compressionConfig.CompressorPool.MinIdle,
+		BlockWhenExhausted:       compressionConfig.CompressorPool.BlockWhenExhausted,
+		MinEvictableIdleTime:     compressionConfig.CompressorPool.MinEvictableIdleTime,
 		SoftMinEvictableIdleTime: math.MaxInt64,
 		TimeBetweenEvictionRuns:  0,
 		NumTestsPerEvictionRun:   10,
@@ -80,19 +137,26 @@ func NewSubmitServer(
 
 	compressorPool := pool.NewObjectPool(armadacontext.Background(), pool.NewPooledObjectFactorySimple(
 		func(context.Context) (interface{}, error) {
-			return compress.NewZlibCompressor(512)
+			return compress.NewCompressor(compressionConfig.Codec, compressionConfig.MinCompressSize)
 		}), &poolConfig)
 
 	return &SubmitServer{
-		authorizer:               authorizer,
-		jobRepository:            jobRepository,
-		queueRepository:          queueRepository,
-		eventStore:               eventStore,
-		schedulingInfoRepository: schedulingInfoRepository,
-		cancelJobsBatchSize:      cancelJobsBatchSize,
-		queueManagementConfig:    queueManagementConfig,
-		schedulingConfig:         schedulingConfig,
-		compressorPool:           compressorPool,
+		authorizer:                    authorizer,
+		jobRepository:                 jobRepository,
+		queueRepository:               queueRepository,
+		eventStore:                    eventStore,
+		schedulingInfoRepository:      schedulingInfoRepository,
+		barrierRepository:             barrierRepository,
+		operationRepository:           operationRepository,
+		submitFailureReportRepository: submitFailureReportRepository,
+		ownershipGroupsRepository:     ownershipGroupsRepository,
+		cancelJobsBatchSize:           cancelJobsBatchSize,
+		cancelJobsParallelism:         cancelJobsParallelism,
+		queueManagementConfig:         queueManagementConfig,
+		schedulingConfig:              schedulingConfig,
+		submitFailureConfig:           submitFailureConfig,
+		compressorPool:                compressorPool,
+		clock:                         clock.RealClock{},
 	}
 }
 
@@ -125,10 +189,197 @@ func (server *SubmitServer) GetQueueInfo(grpcCtx context.Context, req *api.Queue
 		return nil, status.Errorf(codes.Unavailable, "[GetQueueInfo] error getting job sets for queue %s: %s", req.Name, err)
 	}
 
-	return &api.QueueInfo{
-		Name:          req.Name,
-		ActiveJobSets: jobSets,
-	}, nil
+	info := &api.QueueInfo{
+		Name:            req.Name,
+		ActiveJobSets:   jobSets,
+		LeasedResources: make(map[string]resource.Quantity),
+	}
+	for _, jobSet := range jobSets {
+		info.QueuedJobs += jobSet.QueuedJobs
+		info.SuspendedJobs += jobSet.SuspendedJobs
+		info.LeasedJobs += jobSet.LeasedJobs
+		info.PendingJobs += jobSet.PendingJobs
+		info.RunningJobs += jobSet.RunningJobs
+		armadaresource.ComputeResources(info.LeasedResources).Add(jobSet.LeasedResources)
+	}
+	return info, nil
+}
+
+// GetQueueBudgets returns the usage of the resource budgets of a queue. Budgets that apply to each owner separately
+// are reported for the owner given by the request, or for the caller if none is given.
+func (server *SubmitServer) GetQueueBudgets(grpcCtx context.Context, req *api.QueueBudgetsRequest) (*api.QueueBudgets, error) {
+	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+	if server.BudgetAccountant == nil {
+		return nil, status.Errorf(codes.Unimplemented, "[GetQueueBudgets] resource budgets aren't enabled")
+	}
+	q, err := server.queueRepository.GetQueue(req.Queue)
+	var e *repository.ErrQueueNotFound
+	if errors.As(err, &e) {
+		return nil, status.Errorf(codes.NotFound, "[GetQueueBudgets] error: %s", err)
+	} else if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "[GetQueueBudgets] error getting queue %q: %s", req.Queue, err)
+	}
+
+	err = server.authorizer.AuthorizeQueueAction(ctx, q, permissions.WatchAllEvents, queue.PermissionVerbWatch)
+	var permErr *armadaerrors.ErrUnauthorized
+	if errors.As(err, &permErr) {
+		return nil, status.Errorf(codes.PermissionDenied, "[GetQueueBudgets] error getting budgets of queue %s: %s", req.Queue, permErr)
+	} else if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "[GetQueueBudgets] error checking permissions: %s", err)
+	}
+
+	owner := req.Owner
+	if owner == "" {
+		owner = authorization.GetPrincipal(ctx).GetName()
+	}
+	statuses, err := server.BudgetAccountant.BudgetStatuses(q, owner)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "[GetQueueBudgets] error getting usage of budgets of queue %s: %s", req.Queue, err)
+	}
+	return &api.QueueBudgets{Queue: q.Name, Budgets: statuses}, nil
+}
+
+func (server *SubmitServer) GetBarrier(grpcCtx context.Context, req *api.BarrierGetRequest) (*api.Barrier, error) {
+	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+	q, err := server.queueRepository.GetQueue(req.Queue)
+	var e *repository.ErrQueueNotFound
+	if errors.As(err, &e) {
+		return nil, status.Errorf(codes.NotFound, "[GetBarrier] error: %s", err)
+	} else if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "[GetBarrier] error getting queue %q: %s", req.Queue, err)
+	}
+
+	err = server.authorizer.AuthorizeQueueAction(ctx, q, permissions.WatchAllEvents, queue.PermissionVerbWatch)
+	var permErr *armadaerrors.ErrUnauthorized
+	if errors.As(err, &permErr) {
+		return nil, status.Errorf(codes.PermissionDenied, "[GetBarrier] error getting barrier %s in queue %s: %s", req.Id, req.Queue, permErr)
+	} else if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "[GetBarrier] error checking permissions: %s", err)
+	}
+
+	barrier, err := server.barrierRepository.GetBarrier(req.Queue, req.Id)
+	var notFoundErr *repository.ErrBarrierNotFound
+	if errors.As(err, &notFoundErr) {
+		return nil, status.Errorf(codes.NotFound, "[GetBarrier] error: %s", err)
+	} else if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "[GetBarrier] error getting barrier %s in queue %s: %s", req.Id, req.Queue, err)
+	}
+	return barrier, nil
+}
+
+func (server *SubmitServer) GetJobWaitReasons(grpcCtx context.Context, req *api.JobWaitReasonsRequest) (*api.JobWaitReasons, error) {
+	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+	jobs, err := server.jobRepository.GetExistingJobsByIds([]string{req.JobId})
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "[GetJobWaitReasons] error getting job %s: %s", req.JobId, err)
+	}
+	if len(jobs) == 0 {
+		return nil, status.Errorf(codes.NotFound, "[GetJobWaitReasons] job %s not found", req.JobId)
+	}
+	job := jobs[0]
+
+	q, err := server.queueRepository.GetQueue(job.Queue)
+	var e *repository.ErrQueueNotFound
+	if errors.As(err, &e) {
+		return nil, status.Errorf(codes.NotFound, "[GetJobWaitReasons] error: %s", err)
+	} else if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "[GetJobWaitReasons] error getting queue %q: %s", job.Queue, err)
+	}
+	err = server.authorizer.AuthorizeQueueAction(ctx, q, permissions.WatchAllEvents, queue.PermissionVerbWatch)
+	var permErr *armadaerrors.ErrUnauthorized
+	if errors.As(err, &permErr) {
+		return nil, status.Errorf(codes.PermissionDenied, "[GetJobWaitReasons] error getting wait reasons of job %s: %s", req.JobId, permErr)
+	} else if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "[GetJobWaitReasons] error checking permissions: %s", err)
+	}
+
+	reasons, err := server.getJobWaitReasons(job)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "[GetJobWaitReasons] error getting wait reasons of job %s: %s", req.JobId, err)
+	}
+	return &api.JobWaitReasons{JobId: job.Id, Reasons: reasons}, nil
+}
+
+// getJobWaitReasons returns the reasons job hasn't been scheduled.
+// Reasons that prevent the scheduler from considering job at all take precedence over those reported by the scheduler.
+func (server *SubmitServer) getJobWaitReasons(job *api.Job) ([]*api.JobWaitReason, error) {
+	states, err := server.jobRepository.GetJobStates(job.Queue, []string{job.Id})
+	if err != nil {
+		return nil, err
+	}
+	if state, ok := states[job.Id]; !ok || state == repository.JobStateLeased {
+		return nil, nil
+	} else if state == repository.JobStateSuspended {
+		return []*api.JobWaitReason{{
+			Type:    api.JobWaitReasonType_QueuePaused,
+			Message: fmt.Sprintf("job set %s is paused", job.JobSetId),
+		}}, nil
+	}
+
+	if t := heldUntil(job); t.After(time.Now()) {
+		return []*api.JobWaitReason{{
+			Type:    api.JobWaitReasonType_OutsideSubmissionWindow,
+			Message: fmt.Sprintf("job was submitted outside of the submission windows of queue %s and is held until %s", job.Queue, t.Format(time.RFC3339)),
+		}}, nil
+	}
+	if barrierId, ok := job.Annotations[configuration.BarrierIdAnnotation]; ok {
+		barrier, err := server.barrierRepository.GetBarrier(job.Queue, barrierId)
+		var notFoundErr *repository.ErrBarrierNotFound
+		if err != nil && !errors.As(err, &notFoundErr) {
+			return nil, err
+		}
+		if err == nil && !barrier.Released {
+			return []*api.JobWaitReason{{
+				Type:    api.JobWaitReasonType_GangIncomplete,
+				Message: fmt.Sprintf("%d of %d members of barrier %s have been submitted", len(barrier.JobIds), barrier.Cardinality, barrierId),
+			}}, nil
+		}
+	}
+	if admitted, err := newJobSetThrottler(server.jobRepository).admit(job); err != nil {
+		return nil, err
+	} else if !admitted {
+		return []*api.JobWaitReason{{
+			Type:    api.JobWaitReasonType_QuotaExhausted,
+			Message: fmt.Sprintf("job set %s has reached its limit on concurrently running jobs or resources", job.JobSetId),
+		}}, nil
+	}
+
+	if server.SchedulingRoundRepository == nil {
+		return nil, nil
+	}
+	rounds, err := server.SchedulingRoundRepository.GetSchedulingRoundsOfJob(job.Queue, job.Id)
+	if err != nil {
+		return nil, err
+	}
+	return jobWaitReasonsFromSchedulingRounds(rounds, job), nil
+}
+
+// jobWaitReasonsFromSchedulingRounds returns the reasons the given scheduling rounds didn't schedule job.
+// Rounds that started before the job was submitted or that didn't consider the queue of the job are ignored,
+// as are rounds that scheduled the job.
+func jobWaitReasonsFromSchedulingRounds(rounds []*repository.SchedulingRound, job *api.Job) []*api.JobWaitReason {
+	var reasons []*api.JobWaitReason
+	for _, round := range rounds {
+		if round.Started.Before(job.Created) || !slices.Contains(round.Queues, job.Queue) {
+			continue
+		}
+		if reason, ok := round.UnschedulableReasons[job.Id]; ok {
+			reasons = append(reasons, &api.JobWaitReason{
+				Type:       scheduler.JobWaitReasonTypeFromUnschedulableReason(reason),
+				Message:    reason,
+				ExecutorId: round.ExecutorId,
+			})
+		} else if !slices.Contains(round.ScheduledJobIds, job.Id) {
+			// The round ended before getting to this job, e.g., since jobs of queues further below their fair share
+			// or jobs of this queue with higher priority were considered first.
+			reasons = append(reasons, &api.JobWaitReason{
+				Type:       api.JobWaitReasonType_FairShareDeficit,
+				Message:    fmt.Sprintf("the scheduling round ended before the job was considered: %s", round.TerminationReason),
+				ExecutorId: round.ExecutorId,
+			})
+		}
+	}
+	return reasons
 }
 
 func (server *SubmitServer) GetQueue(grpcCtx context.Context, req *api.QueueGetRequest) (*api.Queue, error) {
@@ -149,23 +400,29 @@ func (server *SubmitServer) GetQueues(req *api.StreamingQueueGetRequest, stream
 		numToReturn = math.MaxUint32
 	}
 
-	queues, err := server.queueRepository.GetAllQueues()
+	queues, resourceVersion, err := server.queueRepository.GetQueueSnapshot()
 	if err != nil {
 		return err
 	}
-	for i, queue := range queues {
-		if uint32(i) < numToReturn {
-			err := stream.Send(&api.StreamingQueueMessage{
-				Event: &api.StreamingQueueMessage_Queue{Queue: queue.ToAPI()},
-			})
-			if err != nil {
-				return err
-			}
+	var numSent uint32
+	for _, queue := range queues {
+		if numSent >= numToReturn {
+			break
+		}
+		if !strings.HasPrefix(queue.Name, req.GetNamePrefix()) || !queue.Labels.Matches(req.GetLabels()) || !queue.MatchesSearch(req.GetSearch()) {
+			continue
+		}
+		err := stream.Send(&api.StreamingQueueMessage{
+			Event: &api.StreamingQueueMessage_Queue{Queue: queue.ToAPI()},
+		})
+		if err != nil {
+			return err
 		}
+		numSent++
 	}
 	err = stream.Send(&api.StreamingQueueMessage{
 		Event: &api.StreamingQueueMessage_End{
-			End: &api.EndMarker{},
+			End: &api.EndMarker{ResourceVersion: resourceVersion},
 		},
 	})
 	if err != nil {
@@ -174,6 +431,60 @@ func (server *SubmitServer) GetQueues(req *api.StreamingQueueGetRequest, stream
 	return nil
 }
 
+const (
+	// Maximum number of queue changes read from the queue repository at a time by WatchQueues.
+	watchQueuesBatchSize = 100
+	// Time for which WatchQueues waits for queue changes before checking whether the client has disconnected.
+	watchQueuesBlockTime = 5 * time.Second
+)
+
+func (server *SubmitServer) WatchQueues(req *api.WatchQueuesRequest, stream api.Submit_WatchQueuesServer) error {
+	resourceVersion := req.ResourceVersion
+	if resourceVersion == 0 {
+		queues, snapshotResourceVersion, err := server.queueRepository.GetQueueSnapshot()
+		if err != nil {
+			return status.Errorf(codes.Unavailable, "[WatchQueues] error getting queues: %s", err)
+		}
+		resourceVersion = snapshotResourceVersion
+		for _, q := range queues {
+			err := stream.Send(&api.QueueChange{
+				Type:            api.QueueChangeType_QueueCreated,
+				Queue:           q.ToAPI(),
+				ResourceVersion: resourceVersion,
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		default:
+		}
+		changes, err := server.queueRepository.GetQueueChanges(resourceVersion, watchQueuesBatchSize, watchQueuesBlockTime)
+		var expiredErr *repository.ErrQueueChangesExpired
+		if errors.As(err, &expiredErr) {
+			return status.Errorf(codes.OutOfRange, "[WatchQueues] %s; watch from resource version 0 instead", expiredErr)
+		} else if err != nil {
+			return status.Errorf(codes.Unavailable, "[WatchQueues] error getting queue changes: %s", err)
+		}
+		for _, change := range changes {
+			err := stream.Send(&api.QueueChange{
+				Type:            change.Type,
+				Queue:           change.Queue.ToAPI(),
+				ResourceVersion: change.ResourceVersion,
+			})
+			if err != nil {
+				return err
+			}
+			resourceVersion = change.ResourceVersion
+		}
+	}
+}
+
 func (server *SubmitServer) CreateQueue(grpcCtx context.Context, request *api.Queue) (*types.Empty, error) {
 	ctx := armadacontext.FromGrpcCtx(grpcCtx)
 	err := server.authorizer.AuthorizeAction(ctx, permissions.CreateQueue)
@@ -193,6 +504,9 @@ func (server *SubmitServer) CreateQueue(grpcCtx context.Context, request *api.Qu
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "[CreateQueue] error validating queue: %s", err)
 	}
+	if err := server.validateQueueParent(queue); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "[CreateQueue] error validating queue: %s", err)
+	}
 
 	err = server.queueRepository.CreateQueue(queue)
 	var eq *repository.ErrQueueAlreadyExists
@@ -207,6 +521,9 @@ func (server *SubmitServer) CreateQueue(grpcCtx context.Context, request *api.Qu
 
 func (server *SubmitServer) CreateQueues(grpcCtx context.Context, request *api.QueueList) (*api.BatchQueueCreateResponse, error) {
 	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+	if request.Atomic {
+		return server.createQueuesAtomically(ctx, request.Queues)
+	}
 	var failedQueues []*api.QueueCreateResponse
 	// Create a queue for each element of the request body and return the failures.
 	for _, queue := range request.Queues {
@@ -224,6 +541,60 @@ func (server *SubmitServer) CreateQueues(grpcCtx context.Context, request *api.Q
 	}, nil
 }
 
+// createQueuesAtomically creates either all of the provided queues or none of them.
+// Queues that are invalid are returned as failed, in which case no queue is created.
+func (server *SubmitServer) createQueuesAtomically(ctx *armadacontext.Context, apiQueues []*api.Queue) (*api.BatchQueueCreateResponse, error) {
+	err := server.authorizer.AuthorizeAction(ctx, permissions.CreateQueue)
+	var ep *armadaerrors.ErrUnauthorized
+	if errors.As(err, &ep) {
+		return nil, status.Errorf(codes.PermissionDenied, "[CreateQueues] error creating queues: %s", ep)
+	} else if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "[CreateQueues] error checking permissions: %s", err)
+	}
+
+	principal := authorization.GetPrincipal(ctx)
+	queues := make([]queue.Queue, 0, len(apiQueues))
+	var failedQueues []*api.QueueCreateResponse
+	for _, apiQueue := range apiQueues {
+		if len(apiQueue.UserOwners) == 0 {
+			apiQueue.UserOwners = []string{principal.GetName()}
+		}
+		q, err := queue.NewQueue(apiQueue)
+		if err != nil {
+			failedQueues = append(failedQueues, &api.QueueCreateResponse{
+				Queue: apiQueue,
+				Error: fmt.Sprintf("error validating queue: %s", err),
+			})
+			continue
+		}
+		queues = append(queues, q)
+	}
+	parentErrs, err := server.validateQueueParents(queues)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "[CreateQueues] error validating queues: %s", err)
+	}
+	for i, err := range parentErrs {
+		if err != nil {
+			failedQueues = append(failedQueues, &api.QueueCreateResponse{
+				Queue: queues[i].ToAPI(),
+				Error: fmt.Sprintf("error validating queue: %s", err),
+			})
+		}
+	}
+	if len(failedQueues) > 0 {
+		return &api.BatchQueueCreateResponse{FailedQueues: failedQueues}, nil
+	}
+
+	err = server.queueRepository.CreateQueues(queues)
+	var eq *repository.ErrQueueAlreadyExists
+	if errors.As(err, &eq) {
+		return nil, status.Errorf(codes.AlreadyExists, "[CreateQueues] error creating queues: %s", err)
+	} else if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "[CreateQueues] error creating queues: %s", err)
+	}
+	return &api.BatchQueueCreateResponse{}, nil
+}
+
 func (server *SubmitServer) UpdateQueue(grpcCtx context.Context, request *api.Queue) (*types.Empty, error) {
 	ctx := armadacontext.FromGrpcCtx(grpcCtx)
 	err := server.authorizer.AuthorizeAction(ctx, permissions.CreateQueue)
@@ -238,11 +609,17 @@ func (server *SubmitServer) UpdateQueue(grpcCtx context.Context, request *api.Qu
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "[UpdateQueue] error: %s", err)
 	}
+	if err := server.validateQueueParent(queue); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "[UpdateQueue] error: %s", err)
+	}
 
 	err = server.queueRepository.UpdateQueue(queue)
 	var e *repository.ErrQueueNotFound
+	var em *repository.ErrQueueRevisionMismatch
 	if errors.As(err, &e) {
 		return nil, status.Errorf(codes.NotFound, "[UpdateQueue] error: %s", err)
+	} else if errors.As(err, &em) {
+		return nil, status.Errorf(codes.Aborted, "[UpdateQueue] error: %s", err)
 	} else if err != nil {
 		return nil, status.Errorf(codes.Unavailable, "[UpdateQueue] error getting queue %q: %s", queue.Name, err)
 	}
@@ -250,8 +627,135 @@ func (server *SubmitServer) UpdateQueue(grpcCtx context.Context, request *api.Qu
 	return &types.Empty{}, nil
 }
 
+// Number of attempts at patching a queue that's changed concurrently, if the patch doesn't specify a revision.
+const maxPatchQueueAttempts = 3
+
+// PatchQueue updates the fields of a queue given by the update mask of the request, leaving all other fields unchanged.
+// If the request doesn't specify a revision, the patch is retried if the queue is changed concurrently,
+// such that concurrent changes to other fields aren't lost.
+func (server *SubmitServer) PatchQueue(grpcCtx context.Context, request *api.QueuePatchRequest) (*api.Queue, error) {
+	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+	patch := request.GetQueue()
+	if patch.GetName() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "[PatchQueue] queue name must be provided")
+	}
+	err := server.authorizer.AuthorizeAction(ctx, permissions.CreateQueue)
+	var ep *armadaerrors.ErrUnauthorized
+	if errors.As(err, &ep) {
+		return nil, status.Errorf(codes.PermissionDenied, "[PatchQueue] error updating queue %s: %s", patch.Name, ep)
+	} else if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "[PatchQueue] error checking permissions: %s", err)
+	}
+	if len(request.GetUpdateMask().GetPaths()) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "[PatchQueue] update mask must not be empty")
+	}
+
+	for attempt := 1; ; attempt++ {
+		existing, err := server.queueRepository.GetQueue(patch.Name)
+		var e *repository.ErrQueueNotFound
+		if errors.As(err, &e) {
+			return nil, status.Errorf(codes.NotFound, "[PatchQueue] error: %s", err)
+		} else if err != nil {
+			return nil, status.Errorf(codes.Unavailable, "[PatchQueue] error getting queue %q: %s", patch.Name, err)
+		}
+		if request.Revision != 0 && request.Revision != existing.Revision {
+			err := &repository.ErrQueueRevisionMismatch{QueueName: patch.Name, ExpectedRevision: request.Revision, ActualRevision: existing.Revision}
+			return nil, status.Errorf(codes.Aborted, "[PatchQueue] error: %s", err)
+		}
+
+		patched := existing.ToAPI()
+		if err := patchQueue(patched, patch, request.UpdateMask.Paths); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "[PatchQueue] error: %s", err)
+		}
+		q, err := queue.NewQueue(patched)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "[PatchQueue] error validating queue: %s", err)
+		}
+		if err := server.validateQueueParent(q); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "[PatchQueue] error validating queue: %s", err)
+		}
+
+		// Update based on the revision read, such that changes made since aren't overwritten.
+		q.Revision = existing.Revision
+		err = server.queueRepository.UpdateQueue(q)
+		var em *repository.ErrQueueRevisionMismatch
+		if errors.As(err, &em) && request.Revision == 0 && attempt < maxPatchQueueAttempts {
+			continue
+		} else if errors.As(err, &em) {
+			return nil, status.Errorf(codes.Aborted, "[PatchQueue] error: %s", err)
+		} else if errors.As(err, &e) {
+			return nil, status.Errorf(codes.NotFound, "[PatchQueue] error: %s", err)
+		} else if err != nil {
+			return nil, status.Errorf(codes.Unavailable, "[PatchQueue] error updating queue %q: %s", patch.Name, err)
+		}
+		// The queue is read back, since its resource version is assigned by the repository.
+		updated, err := server.queueRepository.GetQueue(patch.Name)
+		if err != nil {
+			return nil, status.Errorf(codes.Unavailable, "[PatchQueue] error getting updated queue %q: %s", patch.Name, err)
+		}
+		return updated.ToAPI(), nil
+	}
+}
+
+// patchQueue copies the fields given by paths from src to dst.
+func patchQueue(dst *api.Queue, src *api.Queue, paths []string) error {
+	for _, path := range paths {
+		switch path {
+		case "priority_factor":
+			dst.PriorityFactor = src.PriorityFactor
+		case "permissions":
+			dst.Permissions = src.Permissions
+		case "resource_limits":
+			dst.ResourceLimits = src.ResourceLimits
+		case "max_job_size_bytes":
+			dst.MaxJobSizeBytes = src.MaxJobSizeBytes
+		case "max_containers_per_job":
+			dst.MaxContainersPerJob = src.MaxContainersPerJob
+		case "max_job_runtime_seconds":
+			dst.MaxJobRuntimeSeconds = src.MaxJobRuntimeSeconds
+		case "allowed_namespaces":
+			dst.AllowedNamespaces = src.AllowedNamespaces
+		case "pod_spec_policy":
+			dst.PodSpecPolicy = src.PodSpecPolicy
+		case "resource_quotas":
+			dst.ResourceQuotas = src.ResourceQuotas
+		case "resource_budgets":
+			dst.ResourceBudgets = src.ResourceBudgets
+		case "ingress_class_policy":
+			dst.IngressClassPolicy = src.IngressClassPolicy
+		case "owners_can_manage_own_jobs":
+			dst.OwnersCanManageOwnJobs = src.OwnersCanManageOwnJobs
+		case "parent":
+			dst.Parent = src.Parent
+		case "labels":
+			dst.Labels = src.Labels
+		case "required_annotations":
+			dst.RequiredAnnotations = src.RequiredAnnotations
+		case "job_priority_policy":
+			dst.JobPriorityPolicy = src.JobPriorityPolicy
+		case "submission_windows":
+			dst.SubmissionWindows = src.SubmissionWindows
+		case "description":
+			dst.Description = src.Description
+		case "contact":
+			dst.Contact = src.Contact
+		case "documentation_url":
+			dst.DocumentationUrl = src.DocumentationUrl
+		case "user_owners", "group_owners":
+			// Owners are stored as permissions, so they can't be updated separately from other permissions.
+			return errors.Errorf("field %q can't be patched; patch permissions instead", path)
+		default:
+			return errors.Errorf("unknown or immutable field %q of queue", path)
+		}
+	}
+	return nil
+}
+
 func (server *SubmitServer) UpdateQueues(grpcCtx context.Context, request *api.QueueList) (*api.BatchQueueUpdateResponse, error) {
 	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+	if request.Atomic {
+		return server.updateQueuesAtomically(ctx, request.Queues)
+	}
 	var failedQueues []*api.QueueUpdateResponse
 
 	// Create a queue for each element of the request body and return the failures.
@@ -270,8 +774,75 @@ func (server *SubmitServer) UpdateQueues(grpcCtx context.Context, request *api.Q
 	}, nil
 }
 
+// updateQueuesAtomically updates either all of the provided queues or none of them.
+// Queues that are invalid are returned as failed, in which case no queue is updated.
+func (server *SubmitServer) updateQueuesAtomically(ctx *armadacontext.Context, apiQueues []*api.Queue) (*api.BatchQueueUpdateResponse, error) {
+	err := server.authorizer.AuthorizeAction(ctx, permissions.CreateQueue)
+	var ep *armadaerrors.ErrUnauthorized
+	if errors.As(err, &ep) {
+		return nil, status.Errorf(codes.PermissionDenied, "[UpdateQueues] error updating queues: %s", ep)
+	} else if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "[UpdateQueues] error checking permissions: %s", err)
+	}
+
+	queues := make([]queue.Queue, 0, len(apiQueues))
+	var failedQueues []*api.QueueUpdateResponse
+	for _, apiQueue := range apiQueues {
+		q, err := queue.NewQueue(apiQueue)
+		if err != nil {
+			failedQueues = append(failedQueues, &api.QueueUpdateResponse{
+				Queue: apiQueue,
+				Error: fmt.Sprintf("error validating queue: %s", err),
+			})
+			continue
+		}
+		queues = append(queues, q)
+	}
+	parentErrs, err := server.validateQueueParents(queues)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "[UpdateQueues] error validating queues: %s", err)
+	}
+	for i, err := range parentErrs {
+		if err != nil {
+			failedQueues = append(failedQueues, &api.QueueUpdateResponse{
+				Queue: queues[i].ToAPI(),
+				Error: fmt.Sprintf("error validating queue: %s", err),
+			})
+		}
+	}
+	if len(failedQueues) > 0 {
+		return &api.BatchQueueUpdateResponse{FailedQueues: failedQueues}, nil
+	}
+
+	err = server.queueRepository.UpdateQueues(queues)
+	var e *repository.ErrQueueNotFound
+	var em *repository.ErrQueueRevisionMismatch
+	if errors.As(err, &e) {
+		return nil, status.Errorf(codes.NotFound, "[UpdateQueues] error updating queues: %s", err)
+	} else if errors.As(err, &em) {
+		return nil, status.Errorf(codes.Aborted, "[UpdateQueues] error updating queues: %s", err)
+	} else if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "[UpdateQueues] error updating queues: %s", err)
+	}
+	return &api.BatchQueueUpdateResponse{}, nil
+}
+
+// cancelJobSetFunc cancels all jobs of a job set. Cascading queue deletions use it to empty the queue.
+type cancelJobSetFunc func(ctx *armadacontext.Context, queueName string, jobSetId string, reason string) error
+
+// DeleteQueue deletes an empty queue. If request.Cascade is set, the jobs of the queue are instead cancelled
+// in the background and the queue is deleted once they're gone. Its progress is reported by the operation
+// named api.QueueDeletionOperationName(request.Name).
 func (server *SubmitServer) DeleteQueue(grpcCtx context.Context, request *api.QueueDeleteRequest) (*types.Empty, error) {
-	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+	return server.deleteQueue(armadacontext.FromGrpcCtx(grpcCtx), request, server.cancelJobSetOfDeletedQueue)
+}
+
+func (server *SubmitServer) cancelJobSetOfDeletedQueue(ctx *armadacontext.Context, queueName string, jobSetId string, reason string) error {
+	_, err := server.cancelJobsByQueueAndSet(ctx, queueName, jobSetId, nil, reason)
+	return err
+}
+
+func (server *SubmitServer) deleteQueue(ctx *armadacontext.Context, request *api.QueueDeleteRequest, cancelJobSet cancelJobSetFunc) (*types.Empty, error) {
 	err := server.authorizer.AuthorizeAction(ctx, permissions.DeleteQueue)
 	var ep *armadaerrors.ErrUnauthorized
 	if errors.As(err, &ep) {
@@ -280,12 +851,31 @@ func (server *SubmitServer) DeleteQueue(grpcCtx context.Context, request *api.Qu
 		return nil, status.Errorf(codes.Unavailable, "[DeleteQueue] error checking permissions: %s", err)
 	}
 
-	active, err := server.jobRepository.GetQueueActiveJobSets(request.Name)
+	if !request.Cascade {
+		active, err := server.jobRepository.GetQueueActiveJobSets(request.Name)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "[DeleteQueue] error getting active job sets for queue %s: %s", request.Name, err)
+		}
+		if len(active) > 0 {
+			return nil, status.Errorf(codes.FailedPrecondition, "[DeleteQueue] error deleting queue %s: queue is not empty", request.Name)
+		}
+	}
+
+	queues, err := server.primaryQueueRepository().GetAllQueues()
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "[DeleteQueue] error getting active job sets for queue %s: %s", request.Name, err)
+		return nil, status.Errorf(codes.Unavailable, "[DeleteQueue] error getting queues: %s", err)
+	}
+	for _, q := range queues {
+		if q.Parent == request.Name {
+			return nil, status.Errorf(codes.FailedPrecondition, "[DeleteQueue] error deleting queue %s: queue is the parent of queue %s", request.Name, q.Name)
+		}
 	}
-	if len(active) > 0 {
-		return nil, status.Errorf(codes.FailedPrecondition, "[DeleteQueue] error deleting queue %s: queue is not empty", request.Name)
+
+	if request.Cascade {
+		if err := server.startCascadingQueueDeletion(ctx, request.Name, cancelJobSet); err != nil {
+			return nil, err
+		}
+		return &types.Empty{}, nil
 	}
 
 	err = server.queueRepository.DeleteQueue(request.Name)
@@ -296,53 +886,381 @@ func (server *SubmitServer) DeleteQueue(grpcCtx context.Context, request *api.Qu
 	return &types.Empty{}, nil
 }
 
-func (server *SubmitServer) SubmitJobs(grpcCtx context.Context, req *api.JobSubmitRequest) (*api.JobSubmitResponse, error) {
+// startCascadingQueueDeletion archives the queue, such that no new jobs are submitted to it,
+// and starts deleting it in the background.
+func (server *SubmitServer) startCascadingQueueDeletion(ctx *armadacontext.Context, queueName string, cancelJobSet cancelJobSetFunc) error {
+	q, err := server.queueRepository.GetQueue(queueName)
+	var e *repository.ErrQueueNotFound
+	if errors.As(err, &e) {
+		return status.Errorf(codes.NotFound, "[DeleteQueue] error: %s", err)
+	} else if err != nil {
+		return status.Errorf(codes.Unavailable, "[DeleteQueue] error getting queue %s: %s", queueName, err)
+	}
+
+	err = server.authorizer.AuthorizeQueueAction(ctx, q, permissions.CancelAnyJobs, queue.PermissionVerbCancel)
+	var ep *armadaerrors.ErrUnauthorized
+	if errors.As(err, &ep) {
+		return status.Errorf(codes.PermissionDenied, "[DeleteQueue] error cancelling jobs of queue %s: %s", queueName, ep)
+	} else if err != nil {
+		return status.Errorf(codes.Unavailable, "[DeleteQueue] error checking permissions: %s", err)
+	}
+
+	now := time.Now().UTC()
+	op := &api.Operation{
+		Name:       api.QueueDeletionOperationName(queueName),
+		Progress:   "cancelling jobs",
+		CreateTime: now,
+		UpdateTime: now,
+	}
+	err = server.operationRepository.CreateOperation(op, server.queueManagementConfig.CascadingDeleteTimeout)
+	var eip *repository.ErrOperationInProgress
+	if errors.As(err, &eip) {
+		return status.Errorf(codes.AlreadyExists, "[DeleteQueue] error deleting queue %s: %s", queueName, err)
+	} else if err != nil {
+		return status.Errorf(codes.Unavailable, "[DeleteQueue] error creating operation %s: %s", op.Name, err)
+	}
+
+	principal := authorization.GetPrincipal(ctx)
+	if q.Archival == nil {
+		archival := &queue.Archival{
+			ArchivedAt: now,
+			ArchivedBy: principal.GetName(),
+			Reason:     "queue is being deleted",
+		}
+		if err := server.queueRepository.SetQueueArchival(queueName, archival); err != nil {
+			return status.Errorf(codes.Unavailable, "[DeleteQueue] error archiving queue %s: %s", queueName, err)
+		}
+	}
+
+	// The deletion outlives the request, but jobs are still cancelled on behalf of the caller.
+	deletionCtx := armadacontext.New(
+		authorization.WithPrincipal(context.Background(), principal),
+		log.WithField("operation", op.Name),
+	)
+	go server.cascadeQueueDeletion(deletionCtx, queueName, op, cancelJobSet)
+	return nil
+}
+
+// cascadeQueueDeletion cancels all active job sets of the queue, waits for their jobs to be gone,
+// i.e., for executors to return their leases, and then deletes the queue, recording its progress in op.
+func (server *SubmitServer) cascadeQueueDeletion(ctx *armadacontext.Context, queueName string, op *api.Operation, cancelJobSet cancelJobSetFunc) {
+	err := server.emptyQueue(ctx, queueName, op, cancelJobSet)
+	if err == nil {
+		err = server.queueRepository.DeleteQueue(queueName)
+	}
+	op.Done = true
+	op.UpdateTime = time.Now().UTC()
+	if err != nil {
+		ctx.WithError(err).Errorf("failed to delete queue %s", queueName)
+		op.Error = err.Error()
+	} else {
+		op.Progress = "queue deleted"
+	}
+	if err := server.operationRepository.UpdateOperation(op); err != nil {
+		ctx.WithError(err).Errorf("failed to update operation %s", op.Name)
+	}
+}
+
+func (server *SubmitServer) emptyQueue(ctx *armadacontext.Context, queueName string, op *api.Operation, cancelJobSet cancelJobSetFunc) error {
+	deadline := op.CreateTime.Add(server.queueManagementConfig.CascadingDeleteTimeout)
+	cancelled := map[string]bool{}
+	for {
+		jobSets, err := server.jobRepository.GetQueueActiveJobSets(queueName)
+		if err != nil {
+			return errors.WithMessagef(err, "error getting active job sets of queue %s", queueName)
+		}
+		if len(jobSets) == 0 {
+			return nil
+		}
+
+		remaining := 0
+		for _, jobSet := range jobSets {
+			remaining += int(jobSet.QueuedJobs + jobSet.SuspendedJobs + jobSet.LeasedJobs)
+			if cancelled[jobSet.Name] {
+				continue
+			}
+			if err := cancelJobSet(ctx, queueName, jobSet.Name, "queue is being deleted"); err != nil {
+				return errors.WithMessagef(err, "error cancelling job set %s", jobSet.Name)
+			}
+			cancelled[jobSet.Name] = true
+		}
+
+		op.Progress = fmt.Sprintf("cancelled %d job sets; waiting for %d jobs to finish", len(cancelled), remaining)
+		op.UpdateTime = time.Now().UTC()
+		if err := server.operationRepository.UpdateOperation(op); err != nil {
+			ctx.WithError(err).Warnf("failed to update operation %s", op.Name)
+		}
+
+		if op.UpdateTime.After(deadline) {
+			return errors.Errorf("jobs of queue %s weren't gone after %s", queueName, server.queueManagementConfig.CascadingDeleteTimeout)
+		}
+		time.Sleep(server.queueManagementConfig.CascadingDeletePollInterval)
+	}
+}
+
+// GetOperation returns the state of a long-running operation, e.g., a cascading queue deletion.
+// Callers must be allowed to delete the queue deleted by the operation, as for DeleteQueue, such that operations
+// don't reveal the deletion of queues to other users. Permissions are checked before looking up the operation,
+// such that callers can't tell whether an operation exists unless they're allowed to see it.
+func (server *SubmitServer) GetOperation(grpcCtx context.Context, request *api.OperationGetRequest) (*api.Operation, error) {
+	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+	if err := server.authorizeGetOperation(ctx, request.Name); err != nil {
+		return nil, err
+	}
+	op, err := server.operationRepository.GetOperation(request.Name)
+	var e *repository.ErrOperationNotFound
+	if errors.As(err, &e) {
+		return nil, status.Errorf(codes.NotFound, "[GetOperation] error: %s", err)
+	} else if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "[GetOperation] error getting operation %s: %s", request.Name, err)
+	}
+	return op, nil
+}
+
+// authorizeGetOperation checks that the caller may delete the queue deleted by the operation with the given name,
+// as DeleteQueue does for cascading deletions. Once the queue is deleted, only the permission to delete queues is
+// checked.
+func (server *SubmitServer) authorizeGetOperation(ctx *armadacontext.Context, name string) error {
+	err := server.authorizer.AuthorizeAction(ctx, permissions.DeleteQueue)
+	var ep *armadaerrors.ErrUnauthorized
+	if errors.As(err, &ep) {
+		return status.Errorf(codes.PermissionDenied, "[GetOperation] error getting operation %s: %s", name, ep)
+	} else if err != nil {
+		return status.Errorf(codes.Unavailable, "[GetOperation] error checking permissions: %s", err)
+	}
+
+	queueName, ok := api.QueueOfDeletionOperation(name)
+	if !ok {
+		return nil
+	}
+	q, err := server.queueRepository.GetQueue(queueName)
+	var e *repository.ErrQueueNotFound
+	if errors.As(err, &e) {
+		return nil
+	} else if err != nil {
+		return status.Errorf(codes.Unavailable, "[GetOperation] error getting queue %s: %s", queueName, err)
+	}
+	err = server.authorizer.AuthorizeQueueAction(ctx, q, permissions.CancelAnyJobs, queue.PermissionVerbCancel)
+	if errors.As(err, &ep) {
+		return status.Errorf(codes.PermissionDenied, "[GetOperation] error getting operation %s: %s", name, ep)
+	} else if err != nil {
+		return status.Errorf(codes.Unavailable, "[GetOperation] error checking permissions: %s", err)
+	}
+	return nil
+}
+
+// GetSubmitFailureReport returns the errors of all jobs of a rejected submission made by the calling principal.
+func (server *SubmitServer) GetSubmitFailureReport(grpcCtx context.Context, request *api.SubmitFailureReportRequest) (*api.JobSubmitResponse, error) {
+	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+	report, owner, err := server.submitFailureReportRepository.GetSubmitFailureReport(request.Id)
+	var e *repository.ErrSubmitFailureReportNotFound
+	if errors.As(err, &e) {
+		return nil, status.Errorf(codes.NotFound, "[GetSubmitFailureReport] error: %s", err)
+	} else if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "[GetSubmitFailureReport] error getting report %s: %s", request.Id, err)
+	}
+	if principal := authorization.GetPrincipal(ctx); principal.GetName() != owner {
+		return nil, status.Errorf(codes.PermissionDenied, "[GetSubmitFailureReport] report %s is of a submission made by another user", request.Id)
+	}
+	return report, nil
+}
+
+// submitFailureDetails returns the details of the status of a submission rejected because of the errors of individual
+// jobs in responseItems. At most the configured maximum number of errors are included; if there are more,
+// all are stored as a failure report, the id of which is included, such that they can be retrieved in full.
+func (server *SubmitServer) submitFailureDetails(ctx *armadacontext.Context, responseItems []*api.JobSubmitResponseItem) *api.JobSubmitResponse {
+	maxResponseItems := server.submitFailureConfig.MaxResponseItems
+	if maxResponseItems <= 0 || len(responseItems) <= maxResponseItems {
+		return &api.JobSubmitResponse{JobResponseItems: responseItems}
+	}
+	details := &api.JobSubmitResponse{JobResponseItems: responseItems[:maxResponseItems]}
+	reportId := util.NewULID()
+	owner := authorization.GetPrincipal(ctx).GetName()
+	report := &api.JobSubmitResponse{JobResponseItems: responseItems}
+	err := server.submitFailureReportRepository.StoreSubmitFailureReport(reportId, owner, report, server.submitFailureConfig.ReportRetention)
+	if err != nil {
+		// The submission is rejected regardless; only the errors not included are lost.
+		ctx.WithError(err).Warnf("failed to store failure report of submission with %d job errors", len(responseItems))
+		return details
+	}
+	details.FailureReportId = reportId
+	return details
+}
+
+// ArchiveQueue archives a queue, such that it rejects new submissions while retaining its configuration and jobs.
+// The archival records who archived the queue, when, and why.
+func (server *SubmitServer) ArchiveQueue(grpcCtx context.Context, request *api.QueueArchiveRequest) (*types.Empty, error) {
 	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+	err := server.authorizer.AuthorizeAction(ctx, permissions.DeleteQueue)
+	var ep *armadaerrors.ErrUnauthorized
+	if errors.As(err, &ep) {
+		return nil, status.Errorf(codes.PermissionDenied, "[ArchiveQueue] error archiving queue %s: %s", request.Name, ep)
+	} else if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "[ArchiveQueue] error checking permissions: %s", err)
+	}
+
 	principal := authorization.GetPrincipal(ctx)
+	archival := &queue.Archival{
+		ArchivedAt: time.Now().UTC(),
+		ArchivedBy: principal.GetName(),
+		Reason:     request.Reason,
+	}
+	err = server.queueRepository.SetQueueArchival(request.Name, archival)
+	var e *repository.ErrQueueNotFound
+	if errors.As(err, &e) {
+		return nil, status.Errorf(codes.NotFound, "[ArchiveQueue] error: %s", err)
+	} else if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "[ArchiveQueue] error archiving queue %s: %s", request.Name, err)
+	}
+	log.Infof("queue %s archived by %s: %s", request.Name, archival.ArchivedBy, archival.Reason)
 
-	const maxResponseItems = 5
-	var lastIdx int
+	return &types.Empty{}, nil
+}
 
-	jobs, responseItems, e := server.createJobs(req, principal.GetName(), principal.GetGroupNames())
-	if e != nil {
-		if len(responseItems) > maxResponseItems {
-			lastIdx = maxResponseItems
-		} else {
-			lastIdx = len(responseItems)
+// RestoreQueue restores an archived queue, such that it accepts submissions again.
+func (server *SubmitServer) RestoreQueue(grpcCtx context.Context, request *api.QueueRestoreRequest) (*types.Empty, error) {
+	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+	err := server.authorizer.AuthorizeAction(ctx, permissions.CreateQueue)
+	var ep *armadaerrors.ErrUnauthorized
+	if errors.As(err, &ep) {
+		return nil, status.Errorf(codes.PermissionDenied, "[RestoreQueue] error restoring queue %s: %s", request.Name, ep)
+	} else if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "[RestoreQueue] error checking permissions: %s", err)
+	}
+
+	err = server.queueRepository.SetQueueArchival(request.Name, nil)
+	var e *repository.ErrQueueNotFound
+	if errors.As(err, &e) {
+		return nil, status.Errorf(codes.NotFound, "[RestoreQueue] error: %s", err)
+	} else if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "[RestoreQueue] error restoring queue %s: %s", request.Name, err)
+	}
+	log.Infof("queue %s restored by %s", request.Name, authorization.GetPrincipal(ctx).GetName())
+
+	return &types.Empty{}, nil
+}
+
+// validateQueueParent returns an error if the parent of q doesn't exist or if q would be among its own ancestors.
+func (server *SubmitServer) validateQueueParent(q queue.Queue) error {
+	errs, err := server.validateQueueParents([]queue.Queue{q})
+	if err != nil {
+		return err
+	}
+	return errs[0]
+}
+
+// validateQueueParents checks the parents of queues as if all of them were written together,
+// such that queues may have parents written along with them. It returns an error for each queue,
+// which is nil if its parent is valid.
+func (server *SubmitServer) validateQueueParents(queues []queue.Queue) ([]error, error) {
+	errs := make([]error, len(queues))
+	hasParent := false
+	for _, q := range queues {
+		hasParent = hasParent || q.Parent != ""
+	}
+	if !hasParent {
+		return errs, nil
+	}
+	existingQueues, err := server.primaryQueueRepository().GetAllQueues()
+	if err != nil {
+		return nil, err
+	}
+	queueByName := make(map[string]queue.Queue, len(existingQueues)+len(queues))
+	for _, existing := range existingQueues {
+		queueByName[existing.Name] = existing
+	}
+	for _, q := range queues {
+		queueByName[q.Name] = q
+	}
+	for i, q := range queues {
+		if q.Parent == "" {
+			continue
+		}
+		if _, ok := queueByName[q.Parent]; !ok {
+			errs[i] = errors.Errorf("parent queue %s does not exist", q.Parent)
+			continue
+		}
+		for _, ancestor := range queue.Ancestors(q.Name, queueByName) {
+			if queueByName[ancestor.Name].Parent == q.Name {
+				errs[i] = errors.Errorf("queue %s cannot be its own ancestor", q.Name)
+				break
+			}
 		}
+	}
+	return errs, nil
+}
 
+// primaryQueueRepository returns the queue repository reading from the primary if queues are otherwise read from a replica.
+// Writes must be validated against it, since replicas may lag behind, e.g., not yet have the parent of a queue just created.
+func (server *SubmitServer) primaryQueueRepository() repository.QueueRepository {
+	if r, ok := server.queueRepository.(*repository.ReplicaReadingQueueRepository); ok {
+		return r.Primary()
+	}
+	return server.queueRepository
+}
+
+// schedulingInfoIsStale returns true if no cluster has reported scheduling info within SchedulingInfoStaleAfter.
+func (server *SubmitServer) schedulingInfoIsStale() (bool, error) {
+	staleAfter := server.schedulingConfig.SchedulingInfoStaleAfter
+	if staleAfter <= 0 {
+		return false, nil
+	}
+	lastUpdate, err := server.schedulingInfoRepository.GetLastUpdateTime()
+	if err != nil {
+		return false, err
+	}
+	return server.clock.Since(lastUpdate) > staleAfter, nil
+}
+
+func (server *SubmitServer) staleSchedulingInfoReason() string {
+	return fmt.Sprintf("no cluster has reported scheduling info in the last %s", server.schedulingConfig.SchedulingInfoStaleAfter)
+}
+
+// staleSchedulingInfoResponseItems returns copies of the provided response items of unschedulable jobs
+// indicating that the jobs may only appear unschedulable because scheduling info is stale.
+func staleSchedulingInfoResponseItems(items []*api.JobSubmitResponseItem, reason string) []*api.JobSubmitResponseItem {
+	result := make([]*api.JobSubmitResponseItem, len(items))
+	for i, item := range items {
+		result[i] = api.NewFailedJobSubmitResponseItem(item.JobId, api.JobSubmitError_SCHEDULING_INFO_STALE, item.ErrorDetails.GetField(),
+			fmt.Sprintf("%s; this may be since %s, so the submission may be retried later", item.Error, reason))
+		result[i].UnschedulableReasons = item.UnschedulableReasons
+	}
+	return result
+}
+
+func (server *SubmitServer) SubmitJobs(grpcCtx context.Context, req *api.JobSubmitRequest) (*api.JobSubmitResponse, error) {
+	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+	principal := authorization.GetPrincipal(ctx)
+
+	jobs, responseItems, e := server.createJobs(req, principal.GetName())
+	if e != nil {
 		reqJson, _ := json.Marshal(req)
 		createJobsErrFmt := "[SubmitJobs] error creating %d of %d job(s) submitted; %s for user %s; first %d errors:%v"
 		numFails := len(responseItems)
 		numSubmitted := numFails + len(jobs)
-		details := &api.JobSubmitResponse{JobResponseItems: responseItems[:lastIdx]}
+		details := server.submitFailureDetails(ctx, responseItems)
 
 		st, err := status.Newf(codes.InvalidArgument, createJobsErrFmt, numFails, numSubmitted, reqJson,
-			principal.GetName(), maxResponseItems, e).WithDetails(details)
+			principal.GetName(), len(details.JobResponseItems), e).WithDetails(details)
 		if err != nil {
 			subJobUserFmt := "[SubmitJobs] error submitting job %s for user %s; : %v"
 			return nil, status.Errorf(codes.InvalidArgument, subJobUserFmt, reqJson, principal.GetName(), e)
 		}
 		return nil, st.Err()
 	}
+	annotateResubmittedJobs(grpcCtx, jobs)
 
 	if responseItems, err := validation.ValidateApiJobs(jobs, *server.schedulingConfig); err != nil {
 		reqJson, _ := json.Marshal(req)
 		numFails := len(responseItems)
 		numSubmitted := len(jobs)
-		if len(responseItems) > maxResponseItems {
-			lastIdx = maxResponseItems
-		} else {
-			lastIdx = len(responseItems)
-		}
-
-		details := &api.JobSubmitResponse{JobResponseItems: responseItems[:lastIdx]}
+		details := server.submitFailureDetails(ctx, responseItems)
 		validJobsErrFmt := "[SubmitJobs] error validating %d of %d job(s) submitted; %s for user %s; first %d errors:%v"
-		st, err := status.Newf(codes.InvalidArgument, validJobsErrFmt, numFails, numSubmitted, reqJson,
-			principal.GetName(), e).WithDetails(details)
-		if err != nil {
+		st, e := status.Newf(codes.InvalidArgument, validJobsErrFmt, numFails, numSubmitted, reqJson,
+			principal.GetName(), len(details.JobResponseItems), err).WithDetails(details)
+		if e != nil {
 			return nil, status.Errorf(codes.InvalidArgument, validJobsErrFmt, numFails, numSubmitted, reqJson,
-				principal.GetName(), e)
+				principal.GetName(), len(details.JobResponseItems), err)
 		}
 		return nil, st.Err()
 	}
@@ -351,10 +1269,27 @@ func (server *SubmitServer) SubmitJobs(grpcCtx context.Context, req *api.JobSubm
 	if err != nil {
 		return nil, status.Errorf(armadaerrors.CodeFromError(err), "couldn't get/make queue: %s", err)
 	}
+	if q.IsArchived() {
+		return nil, status.Errorf(codes.FailedPrecondition, "[SubmitJobs] queue %s is archived and doesn't accept new jobs", req.Queue)
+	}
 
-	err = server.submittingJobsWouldSurpassLimit(*q, req)
+	err = server.submittingJobsWouldSurpassLimit(*q, jobs)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "[SubmitJobs] error checking queue limit: %s", err)
+		// The limit applies to all jobs of the request, so each is reported as exceeding it.
+		// Since the errors are identical, no failure report is stored if they're not all included.
+		var responseItems []*api.JobSubmitResponseItem
+		for _, job := range jobs {
+			if maxResponseItems := server.submitFailureConfig.MaxResponseItems; maxResponseItems > 0 && len(responseItems) == maxResponseItems {
+				break
+			}
+			responseItems = append(responseItems, api.NewFailedJobSubmitResponseItem(job.Id, api.JobSubmitError_EXCEEDS_QUEUE_LIMIT, "", err.Error()))
+		}
+		details := &api.JobSubmitResponse{JobResponseItems: responseItems}
+		st, e := status.Newf(codes.InvalidArgument, "[SubmitJobs] error checking queue limit: %s", err).WithDetails(details)
+		if e != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "[SubmitJobs] error checking queue limit: %s", err)
+		}
+		return nil, st.Err()
 	}
 
 	err = server.authorizer.AuthorizeQueueAction(ctx, *q, permissions.SubmitAnyJobs, queue.PermissionVerbSubmit)
@@ -365,6 +1300,50 @@ func (server *SubmitServer) SubmitJobs(grpcCtx context.Context, req *api.JobSubm
 		return nil, status.Errorf(codes.Unavailable, "[SubmitJobs] error checking permissions: %s", err)
 	}
 
+	// The policy is only evaluated for authorized submissions, since evaluating it calls out to the policy agent.
+	if responseItems, err := server.evaluateSubmissionPolicy(ctx, jobs, principal); err != nil {
+		return nil, status.Errorf(codes.Unavailable, "[SubmitJobs] error evaluating submission policy: %s", err)
+	} else if len(responseItems) > 0 {
+		details := server.submitFailureDetails(ctx, responseItems)
+		deniedJobsErrFmt := "[SubmitJobs] %d of %d job(s) submitted for user %s denied; first error: %s"
+		st, e := status.Newf(codes.InvalidArgument, deniedJobsErrFmt, len(responseItems), len(jobs),
+			principal.GetName(), responseItems[0].Error).WithDetails(details)
+		if e != nil {
+			return nil, status.Errorf(codes.InvalidArgument, deniedJobsErrFmt, len(responseItems), len(jobs),
+				principal.GetName(), responseItems[0].Error)
+		}
+		return nil, st.Err()
+	}
+
+	// Jobs deprioritized by exhausted budgets are accepted with a warning, in addition to any added below.
+	budgetResponseItems, warnings, err := server.applyBudgets(*q, principal.GetName(), jobs)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "[SubmitJobs] error checking resource budgets: %s", err)
+	} else if len(budgetResponseItems) > 0 {
+		details := server.submitFailureDetails(ctx, budgetResponseItems)
+		exhaustedBudgetErrFmt := "[SubmitJobs] %d of %d job(s) submitted for user %s exceed resource budgets; first error: %s"
+		st, e := status.Newf(codes.ResourceExhausted, exhaustedBudgetErrFmt, len(budgetResponseItems), len(jobs),
+			principal.GetName(), budgetResponseItems[0].Error).WithDetails(details)
+		if e != nil {
+			return nil, status.Errorf(codes.ResourceExhausted, exhaustedBudgetErrFmt, len(budgetResponseItems), len(jobs),
+				principal.GetName(), budgetResponseItems[0].Error)
+		}
+		return nil, st.Err()
+	}
+
+	// Images are only checked for authorized submissions within the limits of the queue, since checking them may call registries.
+	if responseItems := validateImages(ctx, server.ImageResolver, req, jobs); len(responseItems) > 0 {
+		details := server.submitFailureDetails(ctx, responseItems)
+		invalidImagesErrFmt := "[SubmitJobs] %d of %d job(s) submitted for user %s have invalid images; first error: %s"
+		st, e := status.Newf(codes.InvalidArgument, invalidImagesErrFmt, len(responseItems), len(jobs),
+			principal.GetName(), responseItems[0].Error).WithDetails(details)
+		if e != nil {
+			return nil, status.Errorf(codes.InvalidArgument, invalidImagesErrFmt, len(responseItems), len(jobs),
+				principal.GetName(), responseItems[0].Error)
+		}
+		return nil, st.Err()
+	}
+
 	// Check if the job would fit on any executor,
 	// to avoid having users wait for a job that may never be scheduled
 	allClusterSchedulingInfo, err := server.schedulingInfoRepository.GetClusterSchedulingInfo()
@@ -372,42 +1351,108 @@ func (server *SubmitServer) SubmitJobs(grpcCtx context.Context, req *api.JobSubm
 		return nil, status.Errorf(codes.InvalidArgument, "error getting scheduling info: %s", err)
 	}
 
+	if ok, responseItems, err := validateClusterTargetsExist(jobs, allClusterSchedulingInfo); !ok {
+		details := server.submitFailureDetails(ctx, responseItems)
+		st, e := status.Newf(codes.InvalidArgument, "[SubmitJobs] error validating jobs: %s", err).WithDetails(details)
+		if e != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "[SubmitJobs] error validating jobs: %s", err)
+		}
+		return nil, st.Err()
+	}
+
+	// Jobs may only appear unschedulable because clusters haven't reported recently, e.g., while executors restart.
+	// Depending on configuration, such jobs are then accepted with a warning or rejected such that clients may retry.
 	if ok, responseItems, err := validateJobsCanBeScheduled(jobs, allClusterSchedulingInfo); !ok {
-		if err != nil {
+		stale, e := server.schedulingInfoIsStale()
+		if e != nil {
+			return nil, status.Errorf(codes.Unavailable, "[SubmitJobs] error checking freshness of scheduling info: %s", e)
+		}
+		if stale && server.schedulingConfig.AcceptJobsWithStaleSchedulingInfo {
+			for _, item := range responseItems {
+				warnings[item.JobId] = joinWarnings(warnings[item.JobId], fmt.Sprintf("%s; accepted since %s", item.Error, server.staleSchedulingInfoReason()))
+			}
+		} else {
+			code := codes.InvalidArgument
+			if stale {
+				code = codes.Unavailable
+				responseItems = staleSchedulingInfoResponseItems(responseItems, server.staleSchedulingInfoReason())
+			}
 			numFails := len(responseItems)
 			numSubmitted := len(jobs)
-			if len(responseItems) > maxResponseItems {
-				lastIdx = maxResponseItems
-			} else {
-				lastIdx = len(responseItems)
-			}
-			details := &api.JobSubmitResponse{JobResponseItems: responseItems[:lastIdx]}
+			details := server.submitFailureDetails(ctx, responseItems)
 			validJobsErrFmt := "[SubmitJobs] error validating %d of %d job(s) submitted for user %s; first %d errors:%v"
 
-			st, e := status.Newf(codes.InvalidArgument, validJobsErrFmt, numFails, numSubmitted,
-				principal.GetName(), maxResponseItems, err).WithDetails(details)
+			st, e := status.Newf(code, validJobsErrFmt, numFails, numSubmitted,
+				principal.GetName(), len(details.JobResponseItems), err).WithDetails(details)
+			if e != nil {
+				return nil, status.Errorf(code, "[SubmitJobs] error validating jobs: %s", err)
+			}
+			return nil, st.Err()
+		}
+	}
+
+	if server.schedulingConfig.VerifyServiceAccountsExist {
+		if ok, responseItems, err := validateServiceAccountsExist(jobs, allClusterSchedulingInfo); !ok {
+			details := server.submitFailureDetails(ctx, responseItems)
+			st, e := status.Newf(codes.InvalidArgument, "[SubmitJobs] error validating jobs: %s", err).WithDetails(details)
+			if e != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "[SubmitJobs] error validating jobs: %s", err)
+			}
+			return nil, st.Err()
+		}
+	}
+
+	if server.schedulingConfig.VerifySecretsAndConfigMapsExist {
+		if ok, responseItems, err := validateReferencesExist(jobs, allClusterSchedulingInfo); !ok {
+			details := server.submitFailureDetails(ctx, responseItems)
+			st, e := status.Newf(codes.InvalidArgument, "[SubmitJobs] error validating jobs: %s", err).WithDetails(details)
 			if e != nil {
 				return nil, status.Errorf(codes.InvalidArgument, "[SubmitJobs] error validating jobs: %s", err)
 			}
 			return nil, st.Err()
 		}
-		return nil, errors.Errorf("can't schedule job for user %s", principal.GetName())
 	}
 
-	// Create events marking the jobs as submitted
-	err = reportSubmitted(server.eventStore, jobs)
+	// The events of each job are written to the event outbox atomically with the job,
+	// such that they're published if and only if the job is stored.
+	now := time.Now()
+	submitted, err := submittedEvents(jobs, now)
 	if err != nil {
-		return nil, status.Errorf(codes.Aborted, "[SubmitJobs] error getting submitted report: %s", err)
+		return nil, status.Errorf(codes.Internal, "[SubmitJobs] error creating submitted events: %s", err)
+	}
+	queued, err := queuedEvents(jobs, now)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "[SubmitJobs] error creating queued events: %s", err)
+	}
+	normalized, err := resourcesNormalizedEvents(jobs, now)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "[SubmitJobs] error creating resources-normalized events: %s", err)
+	}
+	jobEvents := make([][]*api.EventMessage, len(jobs))
+	for i := range jobs {
+		jobEvents[i] = []*api.EventMessage{submitted[i]}
+		if normalized[i] != nil {
+			jobEvents[i] = append(jobEvents[i], normalized[i])
+		}
+		jobEvents[i] = append(jobEvents[i], queued[i])
+	}
+
+	// Groups must be stored before the jobs, since jobs may be leased as soon as they're stored.
+	if err := server.storeOwnershipGroups(jobs, principal.GetGroupNames()); err != nil {
+		return nil, status.Errorf(codes.Unavailable, "[SubmitJobs] error storing ownership groups: %s", err)
+	}
+
+	// Barrier membership must be recorded before the jobs are stored, since jobs of unknown barriers are schedulable.
+	// It's undone for jobs that aren't stored, such that they don't count towards their barriers.
+	if err := server.addBarrierMembers(jobs); err != nil {
+		return nil, err
 	}
 
 	// Submit the jobs by writing them to the database
-	submissionResults, err := server.jobRepository.AddJobs(jobs)
+	submissionResults, err := server.jobRepository.AddJobsWithEvents(jobs, jobEvents)
 	if err != nil {
-		jobFailures := createJobFailuresWithReason(jobs, fmt.Sprintf("Failed to save job in Armada: %s", e))
-		reportErr := reportFailed(server.eventStore, "", jobFailures)
-		if reportErr != nil {
-			return nil, status.Errorf(codes.Internal, "[SubmitJobs] error reporting failure event: %v", reportErr)
-		}
+		// Whether any jobs were stored is unknown, so no events are reported; the client should retry.
+		server.removeBarrierMembers(jobs)
 		return nil, status.Errorf(codes.Aborted, "[SubmitJobs] error saving jobs in Armada: %s", err)
 	}
 
@@ -416,51 +1461,177 @@ func (server *SubmitServer) SubmitJobs(grpcCtx context.Context, req *api.JobSubm
 		JobResponseItems: make([]*api.JobSubmitResponseItem, 0, len(submissionResults)),
 	}
 
-	var createdJobs []*api.Job
+	// Jobs that weren't stored are reported as submitted and then as failed or duplicate.
+	var unstoredJobs []*api.Job
+	var unstoredJobEvents []*api.EventMessage
 	var jobFailures []*jobFailure
-	var doubleSubmits []*repository.SubmitJobResult
 
 	for i, submissionResult := range submissionResults {
 		jobResponse := &api.JobSubmitResponseItem{JobId: submissionResult.JobId}
 
+		var events []*api.EventMessage
 		if submissionResult.Error != nil {
-			jobResponse.Error = submissionResult.Error.Error()
-			jobFailures = append(jobFailures, &jobFailure{
+			jobResponse = api.NewFailedJobSubmitResponseItem(submissionResult.JobId, api.JobSubmitError_INTERNAL, "", submissionResult.Error.Error())
+			failure := &jobFailure{
 				job:    jobs[i],
 				reason: fmt.Sprintf("Failed to save job in Armada: %s", submissionResult.Error.Error()),
-			})
+			}
+			jobFailures = append(jobFailures, failure)
+			events, err = failedEvents("", []*jobFailure{failure}, now)
 		} else if submissionResult.DuplicateDetected {
-			doubleSubmits = append(doubleSubmits, submissionResult)
+			jobResponse.ErrorDetails = duplicateJobError(jobs[i])
+			events, err = duplicateDetectedEvents([]*repository.SubmitJobResult{submissionResult}, now)
 		} else {
-			createdJobs = append(createdJobs, jobs[i])
+			jobResponse.Warning = warnings[submissionResult.JobId]
+		}
+		if err != nil {
+			return result, status.Errorf(codes.Internal, "[SubmitJobs] error creating events of job %s: %s", jobs[i].Id, err)
+		}
+		if len(events) > 0 {
+			unstoredJobs = append(unstoredJobs, jobs[i])
+			unstoredJobEvents = append(unstoredJobEvents, submitted[i])
+			unstoredJobEvents = append(unstoredJobEvents, events...)
 		}
 
 		result.JobResponseItems = append(result.JobResponseItems, jobResponse)
 	}
+	server.removeBarrierMembers(unstoredJobs)
 
-	err = reportFailed(server.eventStore, "", jobFailures)
+	err = server.jobRepository.AddOutboxEvents(unstoredJobEvents)
 	if err != nil {
-		return result, status.Errorf(codes.Internal, fmt.Sprintf("[SubmitJobs] error reporting failed jobs: %s", err))
+		return result, status.Errorf(codes.Internal, "[SubmitJobs] error reporting failed and duplicate jobs: %s", err)
+	}
+
+	// Publish the events right away; the background relay publishes any that aren't, e.g., if publishing fails.
+	if _, err := server.relayOutboxEvents(ctx); err != nil {
+		log.WithError(err).Warn("[SubmitJobs] failed to publish events of submitted jobs; they will be published by the event outbox relay")
+	}
+
+	if len(jobFailures) > 0 {
+		return result, status.Errorf(codes.Internal, "[SubmitJobs] error submitting some or all jobs")
+	}
+
+	return result, nil
+}
+
+// Maximum number of events published at a time when relaying events from the event outbox.
+const eventOutboxRelayBatchSize = 1000
+
+// RelayOutboxEvents publishes the events in the event outbox of the job repository, i.e., events of submitted jobs
+// that weren't published when the jobs were submitted, e.g., because publishing failed or the server crashed.
+func (server *SubmitServer) RelayOutboxEvents() {
+	ctx := armadacontext.Background()
+	relayed, err := server.relayOutboxEvents(ctx)
+	if err != nil {
+		log.WithError(err).Error("failed to relay events from the event outbox")
+	}
+	if relayed > 0 {
+		log.Infof("relayed %d events from the event outbox", relayed)
+	}
+}
+
+// relayOutboxEvents publishes events from the event outbox until it's empty, or another relay holds it.
+// Returns the number of events published.
+func (server *SubmitServer) relayOutboxEvents(ctx *armadacontext.Context) (int, error) {
+	publish := func(events []*api.EventMessage) error {
+		return server.eventStore.ReportEvents(ctx, events)
+	}
+	relayed := 0
+	for {
+		n, err := server.jobRepository.RelayOutboxEvents(eventOutboxRelayBatchSize, publish)
+		relayed += n
+		if err != nil || n < eventOutboxRelayBatchSize {
+			return relayed, err
+		}
 	}
+}
 
-	err = reportDuplicateDetected(server.eventStore, doubleSubmits)
+// addBarrierMembers records the membership of the provided jobs in the barriers they declare via annotations.
+// Either all memberships are recorded or, if an error is returned, none are.
+// The jobs must have been validated beforehand.
+func (server *SubmitServer) addBarrierMembers(jobs []*api.Job) error {
+	jobIdsByQueueAndBarrierId, cardinalityByQueueAndBarrierId, err := barrierMembers(jobs)
 	if err != nil {
-		return result, status.Errorf(codes.Internal, fmt.Sprintf("[SubmitJobs] error reporting duplicate jobs: %s", err))
+		return status.Errorf(codes.InvalidArgument, "[SubmitJobs] %s", err)
+	}
+	added := make(map[[2]string][]string, len(jobIdsByQueueAndBarrierId))
+	for key, jobIds := range jobIdsByQueueAndBarrierId {
+		_, err := server.barrierRepository.AddBarrierMembers(key[0], key[1], cardinalityByQueueAndBarrierId[key], jobIds)
+		var membershipErr *repository.ErrBarrierMembership
+		if errors.As(err, &membershipErr) {
+			server.removeBarrierMembersByBarrier(added)
+			return status.Errorf(codes.InvalidArgument, "[SubmitJobs] error: %s", membershipErr)
+		} else if err != nil {
+			server.removeBarrierMembersByBarrier(added)
+			return status.Errorf(codes.Unavailable, "[SubmitJobs] error adding jobs to barrier %s: %s", key[1], err)
+		}
+		added[key] = jobIds
 	}
+	return nil
+}
 
-	err = reportQueued(server.eventStore, createdJobs)
+// removeBarrierMembers undoes addBarrierMembers for the provided jobs, e.g., if they couldn't be stored,
+// such that they neither count towards nor release their barriers.
+// Errors are logged rather than returned, since this is done while handling another error.
+func (server *SubmitServer) removeBarrierMembers(jobs []*api.Job) {
+	jobIdsByQueueAndBarrierId, _, err := barrierMembers(jobs)
 	if err != nil {
-		return result, status.Errorf(codes.Internal, fmt.Sprintf("[SubmitJobs] error reporting queued jobs: %s", err))
+		log.WithError(err).Error("failed to remove jobs from their barriers")
+		return
 	}
+	server.removeBarrierMembersByBarrier(jobIdsByQueueAndBarrierId)
+}
 
-	if len(jobFailures) > 0 {
-		return result, status.Errorf(codes.Internal, fmt.Sprintf("[SubmitJobs] error submitting some or all jobs: %s", err))
+func (server *SubmitServer) removeBarrierMembersByBarrier(jobIdsByQueueAndBarrierId map[[2]string][]string) {
+	for key, jobIds := range jobIdsByQueueAndBarrierId {
+		if err := server.barrierRepository.RemoveBarrierMembers(key[0], key[1], jobIds); err != nil {
+			log.WithError(err).Errorf("failed to remove jobs %v from barrier %s", jobIds, key[1])
+		}
 	}
+}
 
-	return result, nil
+// barrierMembers returns the ids of the provided jobs by the queue and id of the barrier they declare via annotations,
+// and the cardinality of each barrier.
+func barrierMembers(jobs []*api.Job) (map[[2]string][]string, map[[2]string]int, error) {
+	jobIdsByQueueAndBarrierId := make(map[[2]string][]string)
+	cardinalityByQueueAndBarrierId := make(map[[2]string]int)
+	for _, job := range jobs {
+		barrierId, barrierCardinality, isBarrierJob, err := scheduler.BarrierIdAndCardinalityFromAnnotations(job.Annotations)
+		if err != nil {
+			return nil, nil, errors.Errorf("error parsing barrier of job %s: %s", job.Id, err)
+		}
+		if !isBarrierJob {
+			continue
+		}
+		key := [2]string{job.Queue, barrierId}
+		jobIdsByQueueAndBarrierId[key] = append(jobIdsByQueueAndBarrierId[key], job.Id)
+		cardinalityByQueueAndBarrierId[key] = barrierCardinality
+	}
+	return jobIdsByQueueAndBarrierId, cardinalityByQueueAndBarrierId, nil
+}
+
+// applyBudgets enforces the resource budgets of q on jobs submitted to it by owner; see BudgetAccountant.ApplyBudgets.
+// Returns no warnings, rather than nil, if budgets aren't enabled.
+func (server *SubmitServer) applyBudgets(q queue.Queue, owner string, jobs []*api.Job) ([]*api.JobSubmitResponseItem, map[string]string, error) {
+	if server.BudgetAccountant == nil || len(q.ResourceBudgets) == 0 {
+		return nil, make(map[string]string), nil
+	}
+	return server.BudgetAccountant.ApplyBudgets(q, owner, jobs)
+}
+
+// joinWarnings returns the warnings of a job, i.e., warning added to existing, separated by a semicolon.
+func joinWarnings(existing string, warning string) string {
+	if existing == "" {
+		return warning
+	}
+	return existing + "; " + warning
 }
 
-func (server *SubmitServer) submittingJobsWouldSurpassLimit(q queue.Queue, jobSubmitRequest *api.JobSubmitRequest) error {
+func (server *SubmitServer) submittingJobsWouldSurpassLimit(q queue.Queue, jobs []*api.Job) error {
+	if err := server.submittingJobsWouldSurpassQuotas(q, jobs); err != nil {
+		return err
+	}
+
 	limit := server.queueManagementConfig.DefaultQueuedJobsLimit
 	if limit <= 0 {
 		return nil
@@ -471,7 +1642,7 @@ func (server *SubmitServer) submittingJobsWouldSurpassLimit(q queue.Queue, jobSu
 		return err
 	}
 
-	queuedAfterSubmission := queued + int64(len(jobSubmitRequest.JobRequestItems))
+	queuedAfterSubmission := queued + int64(len(jobs))
 	if queuedAfterSubmission > int64(limit) {
 		return errors.Errorf(
 			"too many queued jobs: currently have %d, would have %d with new submission, limit is %d",
@@ -481,6 +1652,42 @@ func (server *SubmitServer) submittingJobsWouldSurpassLimit(q queue.Queue, jobSu
 	return nil
 }
 
+// submittingJobsWouldSurpassQuotas returns an error if submitting jobs would cause the total resources
+// requested by the queued and leased jobs of q to exceed any of its resource quotas.
+func (server *SubmitServer) submittingJobsWouldSurpassQuotas(q queue.Queue, jobs []*api.Job) error {
+	if len(q.ResourceQuotas) == 0 {
+		return nil
+	}
+
+	// Counted by the repository as jobs are stored, such that the jobs of the queue needn't be read.
+	current, err := server.jobRepository.GetQueueResources(q.Name)
+	if err != nil {
+		return err
+	}
+	afterSubmission := current.DeepCopy()
+	for _, job := range jobs {
+		afterSubmission.Add(job.TotalResourceRequest())
+	}
+
+	resourceNames := maps.Keys(q.ResourceQuotas)
+	sort.Strings(resourceNames)
+	for _, resourceName := range resourceNames {
+		quota := q.ResourceQuotas[resourceName]
+		if quantity := afterSubmission[resourceName]; quantity.Cmp(quota) > 0 {
+			// Counted quantities are formatted like the quota, which is formatted as configured.
+			currentQuantity := current[resourceName]
+			return errors.Errorf(
+				"queue %s would exceed its %s quota: currently have %s, would have %s with new submission, quota is %s",
+				q.Name, resourceName,
+				resource.NewMilliQuantity(currentQuantity.MilliValue(), quota.Format).String(),
+				resource.NewMilliQuantity(quantity.MilliValue(), quota.Format).String(),
+				quota.String())
+		}
+	}
+
+	return nil
+}
+
 func (server *SubmitServer) countQueuedJobs(q queue.Queue) (int64, error) {
 	sizes, err := server.jobRepository.GetQueueSizes(queue.QueuesToAPI([]queue.Queue{q}))
 	if err != nil {
@@ -511,17 +1718,114 @@ func (server *SubmitServer) CancelJobSet(grpcCtx context.Context, request *api.J
 	if err != nil {
 		return nil, err
 	}
-	_, err = server.cancelJobsByQueueAndSet(ctx, request.Queue, request.JobSetId, createJobSetFilter(request.Filter), request.Reason)
-	return &types.Empty{}, err
+	filter := createJobSetFilter(request.Filter, request.LabelSelector)
+	_, err = server.cancelJobsByQueueAndSet(ctx, request.Queue, request.JobSetId, filter, request.Reason)
+	return &types.Empty{}, err
+}
+
+// PauseJobSet suspends all queued jobs of a job set, such that they aren't leased until the job set is resumed.
+// Leased jobs are unaffected.
+func (server *SubmitServer) PauseJobSet(grpcCtx context.Context, request *api.JobSetPauseRequest) (*types.Empty, error) {
+	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+	if request.Queue == "" || request.JobSetId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "[PauseJobSet] both queue name and job set ID must be provided")
+	}
+	ids, err := server.jobRepository.GetJobSetJobIds(request.Queue, request.JobSetId, &repository.JobSetFilter{IncludeQueued: true})
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "[PauseJobSet] error getting job IDs: %s", err)
+	}
+	err = server.moveJobSetJobs(ctx, ids, server.jobRepository.SuspendJobs, reportJobsSuspended)
+	var e *armadaerrors.ErrUnauthorized
+	if errors.As(err, &e) {
+		return nil, status.Errorf(codes.PermissionDenied, "[PauseJobSet] error: %s", e)
+	} else if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "[PauseJobSet] error suspending jobs: %s", err)
+	}
+	return &types.Empty{}, nil
+}
+
+// ResumeJobSet returns all suspended jobs of a job set to the queue.
+func (server *SubmitServer) ResumeJobSet(grpcCtx context.Context, request *api.JobSetResumeRequest) (*types.Empty, error) {
+	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+	if request.Queue == "" || request.JobSetId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "[ResumeJobSet] both queue name and job set ID must be provided")
+	}
+	ids, err := server.jobRepository.GetJobSetJobIds(request.Queue, request.JobSetId, &repository.JobSetFilter{IncludeSuspended: true})
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "[ResumeJobSet] error getting job IDs: %s", err)
+	}
+	err = server.moveJobSetJobs(ctx, ids, server.jobRepository.ResumeJobs, reportJobsResumed)
+	var e *armadaerrors.ErrUnauthorized
+	if errors.As(err, &e) {
+		return nil, status.Errorf(codes.PermissionDenied, "[ResumeJobSet] error: %s", e)
+	} else if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "[ResumeJobSet] error resuming jobs: %s", err)
+	}
+	return &types.Empty{}, nil
+}
+
+// moveJobSetJobs suspends or resumes the jobs with the provided ids using move,
+// reporting an event for each job that was moved using report.
+// Pausing a job set only changes when its jobs run; hence, it requires the same permissions as reprioritising.
+func (server *SubmitServer) moveJobSetJobs(
+	ctx *armadacontext.Context,
+	ids []string,
+	move func(queue string, jobIds []string) ([]string, error),
+	report func(repository repository.EventStore, requestorName string, jobs []*api.Job) error,
+) error {
+	principalName := authorization.GetPrincipal(ctx).GetName()
+	for _, batch := range util.Batch(ids, server.cancelJobsBatchSize) {
+		jobs, err := server.jobRepository.GetExistingJobsByIds(batch)
+		if err != nil {
+			return err
+		}
+		if err := server.checkReprioritizePerms(ctx, jobs); err != nil {
+			return err
+		}
+		jobIdsByQueue := make(map[string][]string)
+		for _, job := range jobs {
+			jobIdsByQueue[job.Queue] = append(jobIdsByQueue[job.Queue], job.Id)
+		}
+		movedJobIds := make(map[string]bool)
+		for queue, jobIds := range jobIdsByQueue {
+			movedIds, err := move(queue, jobIds)
+			if err != nil {
+				return err
+			}
+			for _, jobId := range movedIds {
+				movedJobIds[jobId] = true
+			}
+		}
+		var movedJobs []*api.Job
+		for _, job := range jobs {
+			if movedJobIds[job.Id] {
+				movedJobs = append(movedJobs, job)
+			}
+		}
+		if err := report(server.eventStore, principalName, movedJobs); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-func createJobSetFilter(filter *api.JobSetFilter) *repository.JobSetFilter {
+// createJobSetFilter returns a filter matching jobs in the provided states with all labels in labelSelector.
+func createJobSetFilter(filter *api.JobSetFilter, labelSelector map[string]string) *repository.JobSetFilter {
 	if filter == nil {
-		return nil
+		if len(labelSelector) == 0 {
+			return nil
+		}
+		return &repository.JobSetFilter{
+			IncludeQueued:    true,
+			IncludeLeased:    true,
+			IncludeSuspended: true,
+			Labels:           labelSelector,
+		}
 	}
 	jobSetFilter := &repository.JobSetFilter{
 		IncludeQueued: false,
 		IncludeLeased: false,
+		Labels:        labelSelector,
 	}
 
 	for _, state := range filter.States {
@@ -531,6 +1835,9 @@ func createJobSetFilter(filter *api.JobSetFilter) *repository.JobSetFilter {
 		if state == api.JobState_PENDING || state == api.JobState_RUNNING {
 			jobSetFilter.IncludeLeased = true
 		}
+		if state == api.JobState_SUSPENDED {
+			jobSetFilter.IncludeSuspended = true
+		}
 	}
 
 	return jobSetFilter
@@ -570,35 +1877,68 @@ func (server *SubmitServer) cancelJobsByQueueAndSet(
 		return nil, status.Errorf(codes.Unavailable, "[cancelJobsBySetAndQueue] error getting job IDs: %s", err)
 	}
 
-	// Split IDs into batches and process one batch at a time
-	// To reduce the number of jobs stored in memory
+	// Split IDs into batches to reduce the number of jobs stored in memory,
+	// and process up to cancelJobsParallelism batches concurrently.
 	batches := util.Batch(ids, server.cancelJobsBatchSize)
-	var cancelledIds []string
-	for _, batch := range batches {
-		jobs, err := server.jobRepository.GetExistingJobsByIds(batch)
-		if err != nil {
-			result := &api.CancellationResult{CancelledIds: cancelledIds}
-			return result, status.Errorf(codes.Internal, "[cancelJobsBySetAndQueue] error getting jobs: %s", err)
-		}
-
-		result, err := server.cancelJobs(ctx, jobs, reason)
-		var e *armadaerrors.ErrUnauthorized
-		if errors.As(err, &e) {
-			return nil, status.Errorf(codes.PermissionDenied, "[cancelJobsBySetAndQueue] error canceling jobs: %s", e)
-		} else if err != nil {
-			result := &api.CancellationResult{CancelledIds: cancelledIds}
-			return result, status.Errorf(codes.Unavailable, "[cancelJobsBySetAndQueue] error checking permissions: %s", err)
-		}
-		cancelledIds = append(cancelledIds, result.CancelledIds...)
-
+	results := make([]*api.CancellationResult, len(batches))
+	parallelism := server.cancelJobsParallelism
+	if parallelism < 1 {
+		parallelism = 1
+	}
+	g, groupCtx := armadacontext.ErrGroup(ctx)
+	g.SetLimit(parallelism)
+	var stopErr error
+	for i, batch := range batches {
 		// TODO I think the right way to do this is to include a timeout with the call to Redis
 		// Then, we can check for a deadline exceeded error here
 		if util.CloseToDeadline(ctx, time.Second*1) {
-			result := &api.CancellationResult{CancelledIds: cancelledIds}
-			return result, status.Errorf(codes.DeadlineExceeded, "[cancelJobsBySetAndQueue] deadline exceeded")
+			stopErr = status.Errorf(codes.DeadlineExceeded, "[cancelJobsBySetAndQueue] deadline exceeded")
+			break
+		}
+		if err := ctx.Err(); err != nil {
+			stopErr = status.Errorf(codes.Canceled, "[cancelJobsBySetAndQueue] request cancelled: %s", err)
+			break
+		}
+		if groupCtx.Err() != nil {
+			// Another batch failed; its error is returned by g.Wait().
+			break
 		}
+		i, batch := i, batch
+		g.Go(func() error {
+			jobs, err := server.jobRepository.GetExistingJobsByIds(batch)
+			if err != nil {
+				return status.Errorf(codes.Internal, "[cancelJobsBySetAndQueue] error getting jobs: %s", err)
+			}
+
+			result, err := server.cancelJobs(groupCtx, jobs, reason)
+			var e *armadaerrors.ErrUnauthorized
+			if errors.As(err, &e) {
+				return status.Errorf(codes.PermissionDenied, "[cancelJobsBySetAndQueue] error canceling jobs: %s", e)
+			} else if err != nil {
+				return status.Errorf(codes.Unavailable, "[cancelJobsBySetAndQueue] error checking permissions: %s", err)
+			}
+			results[i] = result
+			return nil
+		})
+	}
+	err = g.Wait()
+	if status.Code(err) == codes.PermissionDenied {
+		return nil, err
 	}
 
+	// Aggregate in batch order, so that cancelled IDs are returned in the same order regardless of parallelism.
+	var cancelledIds []string
+	for _, result := range results {
+		if result != nil {
+			cancelledIds = append(cancelledIds, result.CancelledIds...)
+		}
+	}
+	if err == nil {
+		err = stopErr
+	}
+	if err != nil {
+		return &api.CancellationResult{CancelledIds: cancelledIds}, err
+	}
 	return &api.CancellationResult{CancelledIds: cancelledIds}, nil
 }
 
@@ -645,19 +1985,30 @@ func (server *SubmitServer) cancelJobs(ctx *armadacontext.Context, jobs []*api.J
 }
 
 func (server *SubmitServer) checkCancelPerms(ctx *armadacontext.Context, jobs []*api.Job) error {
-	queueNames := make(map[string]struct{})
+	return server.checkJobPerms(ctx, jobs, permissions.CancelAnyJobs, queue.PermissionVerbCancel)
+}
+
+// checkJobPerms returns an error unless the principal may perform the action given by perm on the jobs of each queue
+// of jobs, or the queue lets owners manage their own jobs and the principal owns all of its jobs among jobs.
+func (server *SubmitServer) checkJobPerms(ctx *armadacontext.Context, jobs []*api.Job, anyPerm permission.Permission, perm queue.PermissionVerb) error {
+	jobsByQueue := make(map[string][]*api.Job)
 	for _, job := range jobs {
-		queueNames[job.Queue] = struct{}{}
+		jobsByQueue[job.Queue] = append(jobsByQueue[job.Queue], job)
 	}
-	for queueName := range queueNames {
+	principal := authorization.GetPrincipal(ctx)
+	for queueName, queueJobs := range jobsByQueue {
 		q, err := server.queueRepository.GetQueue(queueName)
 		if err != nil {
 			return err
 		}
 
-		err = server.authorizer.AuthorizeQueueAction(ctx, q, permissions.CancelAnyJobs, queue.PermissionVerbCancel)
+		err = server.authorizer.AuthorizeQueueAction(ctx, q, anyPerm, perm)
 		var permErr *armadaerrors.ErrUnauthorized
 		if errors.As(err, &permErr) {
+			canManageOwnJobs := q.OwnersCanManageOwnJobs && authorizeApiTokenQueueAction(principal, q.Name, perm) == nil
+			if canManageOwnJobs && ownsAllJobs(principal.GetName(), queueJobs) {
+				continue
+			}
 			return permErr
 		} else if err != nil {
 			return err
@@ -666,10 +2017,147 @@ func (server *SubmitServer) checkCancelPerms(ctx *armadacontext.Context, jobs []
 	return nil
 }
 
+func ownsAllJobs(owner string, jobs []*api.Job) bool {
+	for _, job := range jobs {
+		if job.Owner != owner {
+			return false
+		}
+	}
+	return true
+}
+
+// PreemptJobs evicts leased jobs of a queue, returning them to the queue if requested and failing them otherwise.
+// Executors stop the pods of preempted jobs once they find the jobs are no longer leased to them.
+func (server *SubmitServer) PreemptJobs(grpcCtx context.Context, request *api.JobPreemptRequest) (*api.JobPreemptResponse, error) {
+	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+	if request.Queue == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "[PreemptJobs] queue name must be provided")
+	}
+	if len(request.JobIds) > 0 && (request.JobSetId != "" || len(request.LabelSelector) > 0) {
+		return nil, status.Errorf(codes.InvalidArgument, "[PreemptJobs] job IDs may not be provided together with a job set ID or label selector")
+	}
+	if len(request.JobIds) == 0 && request.JobSetId == "" && len(request.LabelSelector) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "[PreemptJobs] job IDs, a job set ID, or a label selector must be provided")
+	}
+
+	q, err := server.queueRepository.GetQueue(request.Queue)
+	var expected *repository.ErrQueueNotFound
+	if errors.As(err, &expected) {
+		return nil, status.Errorf(codes.NotFound, "[PreemptJobs] queue %s does not exist", request.Queue)
+	} else if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "[PreemptJobs] error getting queue %s: %s", request.Queue, err)
+	}
+	err = server.authorizer.AuthorizeQueueAction(ctx, q, permissions.PreemptAnyJobs, queue.PermissionVerbPreempt)
+	var permErr *armadaerrors.ErrUnauthorized
+	if errors.As(err, &permErr) {
+		return nil, status.Errorf(codes.PermissionDenied, "[PreemptJobs] error preempting jobs in queue %s: %s", request.Queue, permErr)
+	} else if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "[PreemptJobs] error checking permissions: %s", err)
+	}
+
+	ids := request.JobIds
+	if len(ids) == 0 {
+		if request.JobSetId != "" {
+			ids, err = server.jobRepository.GetJobSetJobIds(
+				request.Queue, request.JobSetId, &repository.JobSetFilter{IncludeLeased: true, Labels: request.LabelSelector},
+			)
+		} else {
+			ids, err = server.jobRepository.GetLeasedJobIds(request.Queue)
+		}
+		if err != nil {
+			return nil, status.Errorf(codes.Unavailable, "[PreemptJobs] error getting job IDs: %s", err)
+		}
+	}
+
+	principalName := authorization.GetPrincipal(ctx).GetName()
+	var preemptedIds []string
+	for _, batch := range util.Batch(ids, server.cancelJobsBatchSize) {
+		preempted, err := server.preemptJobs(principalName, request, batch)
+		preemptedIds = append(preemptedIds, preempted...)
+		if err != nil {
+			return &api.JobPreemptResponse{PreemptedIds: preemptedIds}, status.Errorf(codes.Unavailable, "[PreemptJobs] error preempting jobs: %s", err)
+		}
+	}
+	return &api.JobPreemptResponse{PreemptedIds: preemptedIds}, nil
+}
+
+// preemptJobs preempts those of the jobs with the provided ids that are leased and selected by request.
+// Returns the ids of the jobs that were preempted.
+func (server *SubmitServer) preemptJobs(principalName string, request *api.JobPreemptRequest, ids []string) ([]string, error) {
+	jobs, err := server.jobRepository.GetExistingJobsByIds(ids)
+	if err != nil {
+		return nil, err
+	}
+	// Jobs given by id may belong to other queues, which the principal may not be allowed to preempt jobs of.
+	var selectedIds []string
+	selectedJobs := make(map[string]*api.Job)
+	for _, job := range jobs {
+		if job.Queue == request.Queue && queue.Labels(job.Labels).Matches(request.LabelSelector) {
+			selectedIds = append(selectedIds, job.Id)
+			selectedJobs[job.Id] = job
+		}
+	}
+	if len(selectedIds) == 0 {
+		return nil, nil
+	}
+	clusterIds, err := server.jobRepository.GetLeasedJobClusterIds(selectedIds)
+	if err != nil {
+		return nil, err
+	}
+
+	var preempted []*api.Job
+	if request.Requeue {
+		for _, jobId := range selectedIds {
+			clusterId, ok := clusterIds[jobId]
+			if !ok {
+				continue
+			}
+			// The lease is only returned if the job is still leased to the same cluster.
+			returnedJob, err := server.jobRepository.ReturnLease(clusterId, jobId)
+			if err != nil {
+				return nil, err
+			}
+			if returnedJob != nil {
+				preempted = append(preempted, returnedJob)
+			}
+		}
+	} else {
+		var leasedJobs []*api.Job
+		for _, jobId := range selectedIds {
+			if _, ok := clusterIds[jobId]; ok {
+				leasedJobs = append(leasedJobs, selectedJobs[jobId])
+			}
+		}
+		if len(leasedJobs) > 0 {
+			deletionResult, err := server.jobRepository.DeleteJobs(leasedJobs)
+			if err != nil {
+				return nil, err
+			}
+			for _, job := range leasedJobs {
+				if err := deletionResult[job]; err != nil {
+					log.Errorf("[preemptJobs] error deleting job with ID %s: %s", job.Id, err)
+				} else {
+					preempted = append(preempted, job)
+				}
+			}
+		}
+	}
+
+	if err := reportJobsPreempted(server.eventStore, principalName, preempted, clusterIds, request.Requeue, request.Reason); err != nil {
+		return nil, err
+	}
+	return util.Map(preempted, func(job *api.Job) string { return job.Id }), nil
+}
+
 // ReprioritizeJobs updates the priority of one of more jobs.
 // Returns a map from job ID to any error (or nil if the call succeeded).
 func (server *SubmitServer) ReprioritizeJobs(grpcCtx context.Context, request *api.JobReprioritizeRequest) (*api.JobReprioritizeResponse, error) {
 	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+	update, err := priorityUpdateFromRequest(request)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "[ReprioritizeJobs] error: %s", err)
+	}
+
 	var jobs []*api.Job
 	if len(request.JobIds) > 0 {
 		existingJobs, err := server.jobRepository.GetExistingJobsByIds(request.JobIds)
@@ -692,7 +2180,7 @@ func (server *SubmitServer) ReprioritizeJobs(grpcCtx context.Context, request *a
 		jobs = existingJobs
 	}
 
-	err := server.checkReprioritizePerms(ctx, jobs)
+	err = server.checkReprioritizePerms(ctx, jobs)
 	var e *armadaerrors.ErrUnauthorized
 	if errors.As(err, &e) {
 		return nil, status.Errorf(codes.PermissionDenied, "[ReprioritizeJobs] error: %s", e)
@@ -700,37 +2188,105 @@ func (server *SubmitServer) ReprioritizeJobs(grpcCtx context.Context, request *a
 		return nil, status.Errorf(codes.Unavailable, "[ReprioritizeJobs] error checking permissions: %s", err)
 	}
 
-	principalName := authorization.GetPrincipal(ctx).GetName()
-	err = reportJobsReprioritizing(server.eventStore, principalName, jobs, request.NewPriority)
+	// Jobs are only set to priorities allowed by their queues, whereas relative adjustments are clamped to them.
+	policies, err := server.jobPriorityPolicies(jobs)
 	if err != nil {
-		return nil, status.Errorf(codes.Unavailable, "[ReprioritizeJobs] error reporting job re-prioritisation: %s", err)
+		return nil, status.Errorf(codes.Unavailable, "[ReprioritizeJobs] error getting queues: %s", err)
+	}
+	rejected := map[string]string{}
+	if !isRelativeReprioritization(request) {
+		allowedJobs := make([]*api.Job, 0, len(jobs))
+		for _, job := range jobs {
+			if err := policies[job.Queue].Validate(request.NewPriority); err != nil {
+				rejected[job.Id] = err.Error()
+				continue
+			}
+			allowedJobs = append(allowedJobs, job)
+		}
+		jobs = allowedJobs
+	}
+	update = boundedPriorityUpdate(update, policies)
+
+	// The priorities relative adjustments result in are only known once written,
+	// hence reprioritizing events of relative adjustments are reported once they are.
+	principalName := authorization.GetPrincipal(ctx).GetName()
+	relative := isRelativeReprioritization(request)
+	if !relative {
+		err = reportJobsReprioritizing(server.eventStore, principalName, jobs, update)
+		if err != nil {
+			return nil, status.Errorf(codes.Unavailable, "[ReprioritizeJobs] error reporting job re-prioritisation: %s", err)
+		}
 	}
 
 	var jobIds []string
 	for _, job := range jobs {
 		jobIds = append(jobIds, job.Id)
 	}
-	results, err := server.reprioritizeJobs(jobIds, request.NewPriority, principalName)
+	results, err := server.reprioritizeJobs(jobIds, update, principalName, relative)
 	if err != nil {
 		return nil, status.Errorf(codes.Unavailable, "[ReprioritizeJobs] error re-prioritising jobs: %s", err)
 	}
+	for jobId, reason := range rejected {
+		results[jobId] = reason
+	}
 
 	return &api.JobReprioritizeResponse{ReprioritizationResults: results}, nil
 }
 
-func (server *SubmitServer) reprioritizeJobs(jobIds []string, newPriority float64, principalName string) (map[string]string, error) {
-	// TODO There's a bug here.
-	// The function passed to UpdateJobs is called under an optimistic lock.
-	// If the jobs to be updated are mutated by another thread concurrently,
-	// the changes are not written to Redis. However, this function has side effects
-	// (creating reprioritized events) that would not be rolled back.
+// priorityUpdate computes the new priority of a job, e.g., from its current priority.
+type priorityUpdate func(job *api.Job) float64
+
+// setPriority returns a priorityUpdate setting the priority of jobs to newPriority.
+func setPriority(newPriority float64) priorityUpdate {
+	return func(*api.Job) float64 {
+		return newPriority
+	}
+}
+
+// boundedPriorityUpdate returns a priorityUpdate clamping the priorities computed by update
+// to the priority bounds of the queues of jobs, given by policies.
+func boundedPriorityUpdate(update priorityUpdate, policies map[string]queue.JobPriorityPolicy) priorityUpdate {
+	return func(job *api.Job) float64 {
+		return policies[job.Queue].Clamp(update(job))
+	}
+}
+
+// priorityUpdateFromRequest returns the priorityUpdate requested by request,
+// which either sets the priority of jobs or adjusts it relative to their current priority.
+func priorityUpdateFromRequest(request *api.JobReprioritizeRequest) (priorityUpdate, error) {
+	if !isRelativeReprioritization(request) {
+		return setPriority(request.NewPriority), nil
+	}
+	if request.NewPriority != 0 {
+		return nil, errors.New("a new priority can't be combined with a priority delta or multiplier")
+	}
+	if request.PriorityMultiplier < 0 {
+		return nil, errors.Errorf("priority multiplier %f is negative", request.PriorityMultiplier)
+	}
+	multiplier := request.PriorityMultiplier
+	if multiplier == 0 {
+		multiplier = 1
+	}
+	delta := request.PriorityDelta
+	return func(job *api.Job) float64 {
+		return math.Max(0, job.Priority*multiplier+delta)
+	}, nil
+}
+
+// isRelativeReprioritization returns true if request adjusts the priority of jobs relative to their current priority.
+func isRelativeReprioritization(request *api.JobReprioritizeRequest) bool {
+	return request.PriorityDelta != 0 || request.PriorityMultiplier != 0
+}
+
+// reprioritizeJobs applies update to the jobs with the given ids and reports the jobs that were reprioritized,
+// preceded by reprioritizing events with the written priorities if reportReprioritizing is true.
+func (server *SubmitServer) reprioritizeJobs(jobIds []string, update priorityUpdate, principalName string, reportReprioritizing bool) (map[string]string, error) {
+	// The mutator is called under an optimistic lock and may be called several times, e.g., if the lock is lost.
+	// Since the new priority is computed from the priority read under the lock,
+	// relative updates are never applied to outdated priorities.
 	updateJobResults, err := server.jobRepository.UpdateJobs(jobIds, func(jobs []*api.Job) {
 		for _, job := range jobs {
-			job.Priority = newPriority
-		}
-		err := server.reportReprioritizedJobEvents(jobs, newPriority, principalName)
-		if err != nil {
-			log.Warnf("Failed to report events for reprioritize of jobs %s: %v", strings.Join(jobIds, ", "), err)
+			job.Priority = update(job)
 		}
 	})
 	if err != nil {
@@ -738,23 +2294,38 @@ func (server *SubmitServer) reprioritizeJobs(jobIds []string, newPriority float6
 	}
 
 	results := map[string]string{}
+	reprioritizedJobs := make([]*api.Job, 0, len(updateJobResults))
 	for _, r := range updateJobResults {
 		if r.Error == nil {
 			results[r.JobId] = ""
+			reprioritizedJobs = append(reprioritizedJobs, r.Job)
 		} else {
 			results[r.JobId] = r.Error.Error()
 		}
 	}
+
+	// Events are only reported for jobs whose update was written.
+	if len(reprioritizedJobs) > 0 {
+		if reportReprioritizing {
+			writtenPriority := func(job *api.Job) float64 { return job.Priority }
+			if err := reportJobsReprioritizing(server.eventStore, principalName, reprioritizedJobs, writtenPriority); err != nil {
+				log.Warnf("Failed to report reprioritizing events for jobs %s: %v", strings.Join(jobIds, ", "), err)
+			}
+		}
+		if err := server.reportReprioritizedJobEvents(reprioritizedJobs, principalName); err != nil {
+			log.Warnf("Failed to report events for reprioritize of jobs %s: %v", strings.Join(jobIds, ", "), err)
+		}
+	}
 	return results, nil
 }
 
-func (server *SubmitServer) reportReprioritizedJobEvents(reprioritizedJobs []*api.Job, newPriority float64, principalName string) error {
+func (server *SubmitServer) reportReprioritizedJobEvents(reprioritizedJobs []*api.Job, principalName string) error {
 	err := reportJobsUpdated(server.eventStore, principalName, reprioritizedJobs)
 	if err != nil {
 		return errors.Errorf("[reportReprioritizedJobEvents] error reporting jobs updated: %v", err)
 	}
 
-	err = reportJobsReprioritized(server.eventStore, principalName, reprioritizedJobs, newPriority)
+	err = reportJobsReprioritized(server.eventStore, principalName, reprioritizedJobs)
 	if err != nil {
 		return errors.Errorf("[reportReprioritizedJobEvents] error reporting jobs reprioritized: %v", err)
 	}
@@ -763,25 +2334,28 @@ func (server *SubmitServer) reportReprioritizedJobEvents(reprioritizedJobs []*ap
 }
 
 func (server *SubmitServer) checkReprioritizePerms(ctx *armadacontext.Context, jobs []*api.Job) error {
-	queueNames := make(map[string]struct{})
+	return server.checkJobPerms(ctx, jobs, permissions.ReprioritizeAnyJobs, queue.PermissionVerbReprioritize)
+}
+
+// jobPriorityPolicies returns the job priority policies of the queues of jobs, indexed by queue name.
+// Queues that don't exist don't bound priorities and hence have the zero policy.
+func (server *SubmitServer) jobPriorityPolicies(jobs []*api.Job) (map[string]queue.JobPriorityPolicy, error) {
+	policies := make(map[string]queue.JobPriorityPolicy)
 	for _, job := range jobs {
-		queueNames[job.Queue] = struct{}{}
-	}
-	for queueName := range queueNames {
-		q, err := server.queueRepository.GetQueue(queueName)
+		if _, ok := policies[job.Queue]; ok {
+			continue
+		}
+		q, err := server.getQueueIfExists(job.Queue)
 		if err != nil {
-			return err
+			return nil, err
 		}
-
-		err = server.authorizer.AuthorizeQueueAction(ctx, q, permissions.ReprioritizeAnyJobs, queue.PermissionVerbReprioritize)
-		var permErr *armadaerrors.ErrUnauthorized
-		if errors.As(err, &permErr) {
-			return permErr
-		} else if err != nil {
-			return err
+		if q != nil {
+			policies[job.Queue] = q.JobPriorityPolicy
+		} else {
+			policies[job.Queue] = queue.JobPriorityPolicy{}
 		}
 	}
-	return nil
+	return policies, nil
 }
 
 func (server *SubmitServer) getQueueOrCreate(ctx *armadacontext.Context, queueName string) (*queue.Queue, error) {
@@ -825,19 +2399,15 @@ func (server *SubmitServer) getQueueOrCreate(ctx *armadacontext.Context, queueNa
 	return nil, status.Errorf(codes.Unavailable, "Couldn't load queue %s: %s", queueName, e.Error())
 }
 
-// createJobs returns a list of objects representing the jobs in a JobSubmitRequest.
-// This function validates the jobs in the request and the pod specs. in each job.
-// If any job or pod in invalid, an error is returned.
-func (server *SubmitServer) createJobs(request *api.JobSubmitRequest, owner string, ownershipGroups []string) ([]*api.Job, []*api.JobSubmitResponseItem, error) {
-	return server.createJobsObjects(request, owner, ownershipGroups, time.Now, util.NewULID)
-}
-
-func (server *SubmitServer) createJobsObjects(request *api.JobSubmitRequest, owner string, ownershipGroups []string,
-	getTime func() time.Time, getUlid func() string,
-) ([]*api.Job, []*api.JobSubmitResponseItem, error) {
+// storeOwnershipGroups compresses groups, stores them once for all jobs with identical groups, and sets the hash they're
+// stored under on each of jobs, from which they're read when the jobs are leased.
+// Groups are sorted first, since principals return them in no particular order.
+func (server *SubmitServer) storeOwnershipGroups(jobs []*api.Job, groups []string) error {
+	groups = append([]string(nil), groups...)
+	sort.Strings(groups)
 	compressor, err := server.compressorPool.BorrowObject(armadacontext.Background())
 	if err != nil {
-		return nil, nil, err
+		return err
 	}
 	defer func(compressorPool *pool.ObjectPool, ctx *armadacontext.Context, object interface{}) {
 		err := compressorPool.ReturnObject(ctx, object)
@@ -845,13 +2415,31 @@ func (server *SubmitServer) createJobsObjects(request *api.JobSubmitRequest, own
 			log.WithError(err).Errorf("Error returning compressor to pool")
 		}
 	}(server.compressorPool, armadacontext.Background(), compressor)
-	compressedOwnershipGroups, err := compress.CompressStringArray(ownershipGroups, compressor.(compress.Compressor))
+	compressedGroups, err := compress.CompressStringArray(groups, compressor.(compress.Compressor))
+	if err != nil {
+		return err
+	}
+	hash, err := server.ownershipGroupsRepository.StoreOwnershipGroups(compressedGroups)
 	if err != nil {
-		return nil, nil, err
+		return err
+	}
+	for _, job := range jobs {
+		job.OwnershipGroupsHash = hash
 	}
+	return nil
+}
 
-	jobs := make([]*api.Job, 0, len(request.JobRequestItems))
+// createJobs returns a list of objects representing the jobs in a JobSubmitRequest.
+// This function validates the jobs in the request and the pod specs. in each job.
+// If any job or pod in invalid, an error is returned.
+// The ownership groups of the jobs aren't set; they're stored once for all jobs with identical groups by storeOwnershipGroups.
+func (server *SubmitServer) createJobs(request *api.JobSubmitRequest, owner string) ([]*api.Job, []*api.JobSubmitResponseItem, error) {
+	return server.createJobsObjects(request, owner, time.Now, util.NewULID)
+}
 
+func (server *SubmitServer) createJobsObjects(request *api.JobSubmitRequest, owner string,
+	getTime func() time.Time, getUlid func() string,
+) ([]*api.Job, []*api.JobSubmitResponseItem, error) {
 	if request.JobSetId == "" {
 		return nil, nil, errors.Errorf("[createJobs] job set not specified")
 	}
@@ -860,55 +2448,213 @@ func (server *SubmitServer) createJobsObjects(request *api.JobSubmitRequest, own
 		return nil, nil, errors.Errorf("[createJobs] queue not specified")
 	}
 
+	arrayMembers, err := expandJobArrays(request, getUlid)
+	if err != nil {
+		return nil, nil, errors.WithMessage(err, "[createJobs] error expanding job arrays")
+	}
+	jobs := make([]*api.Job, 0, len(request.JobRequestItems))
+
+	q, err := server.getQueueIfExists(request.Queue)
+	if err != nil {
+		return nil, nil, errors.WithMessagef(err, "[createJobs] error getting queue %s", request.Queue)
+	}
+	sizeLimits := server.jobSizeLimitsForQueue(q)
+	schedulingConfig := server.schedulingConfigForQueue(request.Queue, q)
+	var heldUntil time.Time
+	if q != nil {
+		heldUntil, err = q.SubmissionWindows.Admit(getTime())
+		if err != nil {
+			return nil, nil, errors.WithMessagef(err, "[createJobs] queue %s doesn't accept submissions at this time", request.Queue)
+		}
+	}
+
 	responseItems := make([]*api.JobSubmitResponseItem, 0, len(request.JobRequestItems))
+	jobIds := make([]string, len(request.JobRequestItems))
 	for i, item := range request.JobRequestItems {
 		jobId := getUlid()
+		jobIds[i] = jobId
+
+		if err := composePodSpecs(request, item); err != nil {
+			response := api.NewFailedJobSubmitResponseItem(jobId, api.JobSubmitError_INVALID_POD_SPEC, "podSpecOverlays",
+				fmt.Sprintf("[createJobs] error composing the pod spec of the %d-th job of job set %s: %v", i, request.JobSetId, err))
+			responseItems = append(responseItems, response)
+			continue
+		}
+		setJobArrayIndex(item, arrayMembers[i])
+		if err := applyPriorityClassName(item); err != nil {
+			response := api.NewFailedJobSubmitResponseItem(jobId, api.JobSubmitError_INVALID_POD_SPEC, "priorityClassName",
+				fmt.Sprintf("[createJobs] error setting the priority class of the %d-th job of job set %s: %v", i, request.JobSetId, err))
+			responseItems = append(responseItems, response)
+			continue
+		}
+
+		if violation, err := validateJobSize(item, sizeLimits); err != nil {
+			response := api.NewFailedJobSubmitResponseItem(jobId, api.JobSubmitError_EXCEEDS_SIZE_LIMIT, violation.Field,
+				fmt.Sprintf("[createJobs] job %d in job set %s is too large: %v", i, request.JobSetId, err))
+			response.SizeLimitViolation = violation
+			responseItems = append(responseItems, response)
+			continue
+		}
 
 		if item.PodSpec != nil && len(item.PodSpecs) > 0 {
-			response := &api.JobSubmitResponseItem{
-				JobId: jobId,
-				Error: fmt.Sprintf("[createJobs] job %d in job set %s contains both podSpec and podSpecs, but may only contain either", i, request.JobSetId),
-			}
+			response := api.NewFailedJobSubmitResponseItem(jobId, api.JobSubmitError_INVALID_POD_SPEC, "podSpecs",
+				fmt.Sprintf("[createJobs] job %d in job set %s contains both podSpec and podSpecs, but may only contain either", i, request.JobSetId))
 			responseItems = append(responseItems, response)
 		}
 		podSpec := item.GetMainPodSpec()
 		if podSpec == nil {
-			response := &api.JobSubmitResponseItem{
-				JobId: jobId,
-				Error: fmt.Sprintf("[createJobs] job %d in job set %s contains no podSpec", i, request.JobSetId),
-			}
+			response := api.NewFailedJobSubmitResponseItem(jobId, api.JobSubmitError_INVALID_POD_SPEC, "podSpec",
+				fmt.Sprintf("[createJobs] job %d in job set %s contains no podSpec", i, request.JobSetId))
 			responseItems = append(responseItems, response)
 			continue // Safety check, to avoid possible nil pointer dereference below
 		}
-		if err := validation.ValidateJobSubmitRequestItem(item); err != nil {
-			response := &api.JobSubmitResponseItem{
-				JobId: jobId,
-				Error: fmt.Sprintf("[createJobs] error validating the %d-th job of job set %s: %v", i, request.JobSetId, err),
+		if err := validation.ValidateJobSubmitRequestItem(item, schedulingConfig.ExternalDnsDomains); err != nil {
+			response := api.NewFailedJobSubmitResponseItem(jobId, api.JobSubmitError_INVALID_JOB, "ingress",
+				fmt.Sprintf("[createJobs] error validating the %d-th job of job set %s: %v", i, request.JobSetId, err))
+			responseItems = append(responseItems, response)
+		}
+		if q != nil {
+			if err := q.RequiredAnnotations.Validate(item.Annotations); err != nil {
+				response := api.NewFailedJobSubmitResponseItem(jobId, api.JobSubmitError_INVALID_JOB, "annotations",
+					fmt.Sprintf("[createJobs] error validating the annotations of the %d-th job of job set %s: %v", i, request.JobSetId, err))
+				responseItems = append(responseItems, response)
+			}
+			item.Priority = q.JobPriorityPolicy.PriorityOrDefault(item.Priority)
+			if err := q.JobPriorityPolicy.Validate(item.Priority); err != nil {
+				response := api.NewFailedJobSubmitResponseItem(jobId, api.JobSubmitError_INVALID_JOB, "priority",
+					fmt.Sprintf("[createJobs] error validating the priority of the %d-th job of job set %s: %v", i, request.JobSetId, err))
+				responseItems = append(responseItems, response)
+			}
+		}
+		if item.Gang != nil {
+			if annotations, err := gangAnnotations(item.Gang, item.Annotations); err != nil {
+				response := api.NewFailedJobSubmitResponseItem(jobId, api.JobSubmitError_INVALID_GANG, "gang",
+					fmt.Sprintf("[createJobs] error validating the gang of the %d-th job of job set %s: %v", i, request.JobSetId, err))
+				responseItems = append(responseItems, response)
+			} else {
+				if item.Annotations == nil {
+					item.Annotations = make(map[string]string)
+				}
+				maps.Copy(item.Annotations, annotations)
+			}
+		}
+		if annotations, err := retryPolicyAnnotations(item.RetryPolicy, item.Annotations); err != nil {
+			response := api.NewFailedJobSubmitResponseItem(jobId, api.JobSubmitError_INVALID_JOB, "retryPolicy",
+				fmt.Sprintf("[createJobs] error validating the retry policy of the %d-th job of job set %s: %v", i, request.JobSetId, err))
+			responseItems = append(responseItems, response)
+		} else if annotations != nil {
+			if item.Annotations == nil {
+				item.Annotations = make(map[string]string)
+			}
+			maps.Copy(item.Annotations, annotations)
+		}
+		if item.IsPreemptible {
+			if annotations, err := preemptibleAnnotations(item.Annotations); err != nil {
+				response := api.NewFailedJobSubmitResponseItem(jobId, api.JobSubmitError_INVALID_JOB, "isPreemptible",
+					fmt.Sprintf("[createJobs] error validating the %d-th job of job set %s as preemptible: %v", i, request.JobSetId, err))
+				responseItems = append(responseItems, response)
+			} else {
+				if item.Annotations == nil {
+					item.Annotations = make(map[string]string)
+				}
+				maps.Copy(item.Annotations, annotations)
+			}
+		}
+		var queueMaxRuntimeSeconds uint32
+		if q != nil {
+			queueMaxRuntimeSeconds = q.MaxJobRuntimeSeconds
+		}
+		if annotations, err := maxRuntimeAnnotations(item.MaxRuntimeSeconds, queueMaxRuntimeSeconds, item.Annotations); err != nil {
+			response := api.NewFailedJobSubmitResponseItem(jobId, api.JobSubmitError_INVALID_JOB, "maxRuntimeSeconds",
+				fmt.Sprintf("[createJobs] error validating the maximum runtime of the %d-th job of job set %s: %v", i, request.JobSetId, err))
+			responseItems = append(responseItems, response)
+		} else if annotations != nil {
+			if item.Annotations == nil {
+				item.Annotations = make(map[string]string)
 			}
+			maps.Copy(item.Annotations, annotations)
+		}
+		if annotations, err := clusterTargetingAnnotations(item.ClusterTargeting, item.Annotations); err != nil {
+			response := api.NewFailedJobSubmitResponseItem(jobId, api.JobSubmitError_INVALID_JOB, "clusterTargeting",
+				fmt.Sprintf("[createJobs] error validating the cluster targeting of the %d-th job of job set %s: %v", i, request.JobSetId, err))
 			responseItems = append(responseItems, response)
+		} else if annotations != nil {
+			if item.Annotations == nil {
+				item.Annotations = make(map[string]string)
+			}
+			maps.Copy(item.Annotations, annotations)
 		}
 		namespace := item.Namespace
 		if namespace == "" {
 			namespace = "default"
 		}
-		fillContainerRequestsAndLimits(podSpec.Containers)
-		applyDefaultsToAnnotations(item.Annotations, *server.schedulingConfig)
-		applyDefaultsToPodSpec(podSpec, *server.schedulingConfig)
-		if err := validation.ValidatePodSpec(podSpec, server.schedulingConfig); err != nil {
-			response := &api.JobSubmitResponseItem{
-				JobId: jobId,
-				Error: fmt.Sprintf("[createJobs] error validating the %d-th job of job set %s: %v", i, request.JobSetId, err),
+		if q != nil {
+			if err := q.AllowedNamespaces.Validate(namespace); err != nil {
+				response := api.NewFailedJobSubmitResponseItem(jobId, api.JobSubmitError_INVALID_JOB, "namespace",
+					fmt.Sprintf("[createJobs] error validating the namespace of the %d-th job of job set %s: %v", i, request.JobSetId, err))
+				responseItems = append(responseItems, response)
+			}
+			for _, ingress := range item.Ingress {
+				ingress.IngressClassName = q.IngressClassPolicy.ClassOrDefault(ingress.IngressClassName)
+				if err := q.IngressClassPolicy.Validate(ingress.IngressClassName); err != nil {
+					response := api.NewFailedJobSubmitResponseItem(jobId, api.JobSubmitError_INVALID_JOB, "ingress",
+						fmt.Sprintf("[createJobs] error validating the ingress class of the %d-th job of job set %s: %v", i, request.JobSetId, err))
+					responseItems = append(responseItems, response)
+					break
+				}
 			}
-			responseItems = append(responseItems, response)
 		}
-
+		// The annotations are only ever set by the server, since they're reported as events.
+		delete(item.Annotations, configuration.OriginalResourcesAnnotation)
+		delete(item.Annotations, configuration.ResubmittedFromAnnotation)
+		if originalResources := fillContainerRequestsAndLimits(podSpec.Containers, schedulingConfig); originalResources != nil {
+			annotation, err := json.Marshal(originalResources)
+			if err != nil {
+				return nil, nil, errors.Wrapf(err, "[createJobs] error marshalling the original resources of the %d-th job of job set %s", i, request.JobSetId)
+			}
+			if item.Annotations == nil {
+				item.Annotations = make(map[string]string)
+			}
+			item.Annotations[configuration.OriginalResourcesAnnotation] = string(annotation)
+		}
+		if request.MaxConcurrentJobs > 0 {
+			if item.Annotations == nil {
+				item.Annotations = make(map[string]string)
+			}
+			item.Annotations[configuration.MaxConcurrentJobsAnnotation] = strconv.FormatUint(uint64(request.MaxConcurrentJobs), 10)
+		}
+		if len(request.JobSetResourceLimits) > 0 {
+			if item.Annotations == nil {
+				item.Annotations = make(map[string]string)
+			}
+			item.Annotations[configuration.JobSetResourceLimitsAnnotation] = scheduler.JobSetResourceLimitsAnnotationValue(request.JobSetResourceLimits)
+		}
+		if !heldUntil.IsZero() {
+			if item.Annotations == nil {
+				item.Annotations = make(map[string]string)
+			}
+			item.Annotations[configuration.HeldUntilAnnotation] = heldUntil.UTC().Format(time.RFC3339)
+		}
+		applyDefaultsToAnnotations(item.Annotations, schedulingConfig)
 		// TODO: remove, RequiredNodeLabels is deprecated and will be removed in future versions
+		// Merged before defaults are applied, such that required node selectors take precedence and are validated.
 		for k, v := range item.RequiredNodeLabels {
 			if podSpec.NodeSelector == nil {
 				podSpec.NodeSelector = map[string]string{}
 			}
 			podSpec.NodeSelector[k] = v
 		}
+		applyDefaultsToPodSpec(podSpec, schedulingConfig)
+		if err := validation.ValidatePodSpec(podSpec, &schedulingConfig); err != nil {
+			response := api.NewFailedJobSubmitResponseItem(jobId, api.JobSubmitError_INVALID_POD_SPEC, mainPodSpecField(item),
+				fmt.Sprintf("[createJobs] error validating the %d-th job of job set %s: %v", i, request.JobSetId, err))
+			responseItems = append(responseItems, response)
+		}
+		if reference, ok := disallowedReference(podSpec, schedulingConfig); ok {
+			response := api.NewFailedJobSubmitResponseItem(jobId, api.JobSubmitError_INVALID_REFERENCE, mainPodSpecField(item)+"."+reference.Field,
+				fmt.Sprintf("[createJobs] error validating the %d-th job of job set %s: %s isn't allowed", i, request.JobSetId, reference))
+			responseItems = append(responseItems, response)
+		}
 
 		enrichText(item.Labels, jobId)
 		enrichText(item.Annotations, jobId)
@@ -928,17 +2674,20 @@ func (server *SubmitServer) createJobsObjects(request *api.JobSubmitRequest, own
 
 			Priority: item.Priority,
 
-			Scheduler:                          item.Scheduler,
-			PodSpec:                            item.PodSpec,
-			PodSpecs:                           item.PodSpecs,
-			Created:                            getTime(), // Replaced with now for mocking unit test
-			Owner:                              owner,
-			QueueOwnershipUserGroups:           nil,
-			CompressedQueueOwnershipUserGroups: compressedOwnershipGroups,
-			QueueTtlSeconds:                    item.QueueTtlSeconds,
+			Scheduler:       item.Scheduler,
+			PodSpec:         item.PodSpec,
+			PodSpecs:        item.PodSpecs,
+			Created:         getTime(), // Replaced with now for mocking unit test
+			Owner:           owner,
+			QueueTtlSeconds: item.QueueTtlSeconds,
+		}
+		if member := arrayMembers[i]; member != nil {
+			j.JobArrayId = member.arrayId
+			j.JobArrayIndex = member.index
 		}
 		jobs = append(jobs, j)
 	}
+	responseItems = append(responseItems, validateGangMembers(request, jobIds)...)
 
 	if len(responseItems) > 0 {
 		return nil, responseItems, errors.New("[createJobs] error creating jobs, check JobSubmitResponse for details")
@@ -946,6 +2695,26 @@ func (server *SubmitServer) createJobsObjects(request *api.JobSubmitRequest, own
 	return jobs, nil, nil
 }
 
+// applyPriorityClassName sets the priority class of each pod spec of item to that named by item, if any.
+// Returns an error if a pod spec sets a different priority class.
+func applyPriorityClassName(item *api.JobSubmitRequestItem) error {
+	if item.PriorityClassName == "" {
+		return nil
+	}
+	podSpecs := make([]*v1.PodSpec, 0, len(item.PodSpecs)+1)
+	if item.PodSpec != nil {
+		podSpecs = append(podSpecs, item.PodSpec)
+	}
+	podSpecs = append(podSpecs, item.PodSpecs...)
+	for _, podSpec := range podSpecs {
+		if podSpec.PriorityClassName != "" && podSpec.PriorityClassName != item.PriorityClassName {
+			return errors.Errorf("priority class %q differs from %q, the priority class of the pod spec", item.PriorityClassName, podSpec.PriorityClassName)
+		}
+		podSpec.PriorityClassName = item.PriorityClassName
+	}
+	return nil
+}
+
 func enrichText(labels map[string]string, jobId string) {
 	for key, value := range labels {
 		value := strings.ReplaceAll(value, "{{JobId}}", ` \z`) // \z cannot be entered manually, hence its use
@@ -8,14 +8,12 @@ import (
 
 	"github.com/apache/pulsar-client-go/pulsar"
 	"github.com/hashicorp/go-multierror"
-	pool "github.com/jolestar/go-commons-pool"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 
 	"github.com/armadaproject/armada/internal/armada/repository"
 	"github.com/armadaproject/armada/internal/common/armadacontext"
 	"github.com/armadaproject/armada/internal/common/armadaerrors"
-	"github.com/armadaproject/armada/internal/common/compress"
 	"github.com/armadaproject/armada/internal/common/eventutil"
 	"github.com/armadaproject/armada/internal/common/logging"
 	"github.com/armadaproject/armada/internal/common/schedulers"
@@ -404,31 +402,31 @@ func (srv *SubmitFromLog) SubmitJobs(
 		return true, err
 	}
 
-	log := srv.getLogger()
-	compressor, err := srv.SubmitServer.compressorPool.BorrowObject(armadacontext.Background())
-	if err != nil {
-		return false, err
+	// The groups of the jobs are stored once for all of them, rather than with each job.
+	// The jobs can't be leased without their groups, so, as for storing the jobs, network errors are retried.
+	if err := srv.SubmitServer.storeOwnershipGroups(jobs, groups); err != nil {
+		return !armadaerrors.IsNetworkError(err), err
+	}
+	for _, job := range jobs {
+		job.QueueOwnershipUserGroups = nil
 	}
-	defer func(compressorPool *pool.ObjectPool, ctx *armadacontext.Context, object interface{}) {
-		err := compressorPool.ReturnObject(ctx, object)
-		if err != nil {
-			log.WithError(err).Errorf("Error returning compressor to pool")
-		}
-	}(srv.SubmitServer.compressorPool, armadacontext.Background(), compressor)
 
-	compressedOwnershipGroups, err := compress.CompressStringArray(groups, compressor.(compress.Compressor))
+	// The queued event of each job is written to the event outbox atomically with the job,
+	// such that it's published if and only if the job is stored.
+	now := time.Now()
+	queued, err := queuedEvents(jobs, now)
 	if err != nil {
 		return true, err
 	}
-	for _, job := range jobs {
-		job.QueueOwnershipUserGroups = nil
-		job.CompressedQueueOwnershipUserGroups = compressedOwnershipGroups
+	jobEvents := make([][]*api.EventMessage, len(jobs))
+	for i := range jobs {
+		jobEvents[i] = []*api.EventMessage{queued[i]}
 	}
 
 	// Submit the jobs by writing them to the database.
 	// If an error occurs here, there was a problem writing to the database and we mark all jobs as failed.
 	// Unless the error is network-related, in which case we return an error so that the caller can try again later.
-	submissionResults, err := srv.SubmitServer.jobRepository.AddJobs(jobs)
+	submissionResults, err := srv.SubmitServer.jobRepository.AddJobsWithEvents(jobs, jobEvents)
 	if armadaerrors.IsNetworkError(err) {
 		return false, err
 	} else if err != nil {
@@ -441,7 +439,7 @@ func (srv *SubmitFromLog) SubmitJobs(
 	}
 
 	// Create events that report what happened.
-	var createdJobs []*api.Job
+	log := srv.getLogger()
 	var jobFailures []*jobFailure
 	var doubleSubmits []*repository.SubmitJobResult
 	for i, submissionResult := range submissionResults {
@@ -456,26 +454,30 @@ func (srv *SubmitFromLog) SubmitJobs(
 			log.Warnf("Already Processed job id %s, this job submission will be discarded", submissionResult.JobId)
 		} else if submissionResult.DuplicateDetected {
 			doubleSubmits = append(doubleSubmits, submissionResult)
-		} else {
-			createdJobs = append(createdJobs, jobs[i])
 		}
 	}
 
-	// Write events to the database.
-	// We consider the events to have been processed even if there are failures at this point.
+	// Jobs that weren't stored are reported as failed or duplicate through the event outbox too.
+	// We consider the events to have been processed even if writing these fails.
 	// If that happens, some messages may have gone missing.
 	// The alternative would be to re-process the job submit events, which could result in duplicated jobs.
-	var result *multierror.Error
-	err = reportFailed(srv.SubmitServer.eventStore, "", jobFailures)
-	result = multierror.Append(result, err)
-
-	err = reportDuplicateDetected(srv.SubmitServer.eventStore, doubleSubmits)
-	result = multierror.Append(result, err)
-
-	err = reportQueued(srv.SubmitServer.eventStore, createdJobs)
-	result = multierror.Append(result, err)
+	failed, err := failedEvents("", jobFailures, now)
+	if err != nil {
+		return true, err
+	}
+	duplicates, err := duplicateDetectedEvents(doubleSubmits, now)
+	if err != nil {
+		return true, err
+	}
+	if err := srv.SubmitServer.jobRepository.AddOutboxEvents(append(failed, duplicates...)); err != nil {
+		return true, err
+	}
 
-	return true, result.ErrorOrNil()
+	// Publish the events right away; the background relay publishes any that aren't, e.g., if publishing fails.
+	if _, err := srv.SubmitServer.relayOutboxEvents(ctx); err != nil {
+		log.WithError(err).Warn("failed to publish events of submitted jobs; they will be published by the event outbox relay")
+	}
+	return true, nil
 }
 
 type CancelJobPayload struct {
@@ -647,14 +649,14 @@ func (srv *SubmitFromLog) ReprioritizeJobs(ctx *armadacontext.Context, userId st
 		}
 	}
 
-	err = reportJobsReprioritizing(srv.SubmitServer.eventStore, userId, jobs, float64(newPriority))
+	err = reportJobsReprioritizing(srv.SubmitServer.eventStore, userId, jobs, setPriority(float64(newPriority)))
 	if armadaerrors.IsNetworkError(err) {
 		return false, err
 	} else if err != nil {
 		return true, err
 	}
 
-	_, err = srv.SubmitServer.reprioritizeJobs(jobIds, float64(newPriority), userId)
+	_, err = srv.SubmitServer.reprioritizeJobs(jobIds, setPriority(float64(newPriority)), userId, false)
 	if armadaerrors.IsNetworkError(err) {
 		return false, err
 	} else if err != nil {
@@ -779,14 +781,14 @@ func (srv *SubmitFromLog) ReprioritizeJobSet(
 		return true, err
 	}
 
-	err = reportJobsReprioritizing(srv.SubmitServer.eventStore, userId, jobs, float64(e.Priority))
+	err = reportJobsReprioritizing(srv.SubmitServer.eventStore, userId, jobs, setPriority(float64(e.Priority)))
 	if armadaerrors.IsNetworkError(err) {
 		return false, err
 	} else if err != nil {
 		return true, err
 	}
 
-	_, err = srv.SubmitServer.reprioritizeJobs(jobIds, float64(e.Priority), userId)
+	_, err = srv.SubmitServer.reprioritizeJobs(jobIds, setPriority(float64(e.Priority)), userId, false)
 	if armadaerrors.IsNetworkError(err) {
 		return false, err
 	} else if err != nil {
@@ -5,10 +5,12 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/armadaproject/armada/internal/armada/repository"
 	"github.com/armadaproject/armada/internal/common/armadacontext"
 	"github.com/armadaproject/armada/internal/common/ingest/testfixtures"
+	"github.com/armadaproject/armada/pkg/api"
 	"github.com/armadaproject/armada/pkg/armadaevents"
 )
 
@@ -82,3 +84,29 @@ func TestUpdateJobStartTimes_RedisError(t *testing.T) {
 	_, exists := jobRepo.jobStartTimeInfos[testfixtures.JobIdString]
 	assert.False(t, exists)
 }
+
+func TestSubmitFromLog_SubmitJobs_RelaysQueuedEventsOfStoredJobs(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
+		injector := repository.NewFaultInjector()
+		injector.SetFaults([]*api.Fault{{Target: api.FaultTarget_EVENT_STORE, ErrorProbability: 1}})
+		s.eventStore = repository.NewFaultInjectingEventStore(events, injector)
+		srv := SubmitFromLog{SubmitServer: s}
+		submitJobs := []*armadaevents.SubmitJob{testfixtures.Submit.GetSubmitJob()}
+
+		ok, err := srv.SubmitJobs(armadacontext.Background(), "user", []string{}, "test", "jobSet", submitJobs)
+		require.NoError(t, err)
+		assert.True(t, ok)
+		assert.Empty(t, events.ReceivedEvents)
+
+		injector.Clear()
+		s.RelayOutboxEvents()
+		require.Len(t, events.ReceivedEvents, 1)
+		assert.Equal(t, testfixtures.JobIdString, events.ReceivedEvents[0].GetQueued().GetJobId())
+
+		// Jobs that were already stored aren't reported as queued again.
+		ok, err = srv.SubmitJobs(armadacontext.Background(), "user", []string{}, "test", "jobSet", submitJobs)
+		require.NoError(t, err)
+		assert.True(t, ok)
+		assert.Len(t, events.ReceivedEvents, 1)
+	})
+}
@@ -4,12 +4,15 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"sort"
+	"strings"
 	"testing"
 	"testing/quick"
 	"time"
 
 	"github.com/go-redis/redis"
 	"github.com/gogo/protobuf/types"
+	gogostatus "github.com/gogo/status"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
@@ -17,13 +20,18 @@ import (
 	"google.golang.org/grpc/status"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	clock "k8s.io/utils/clock/testing"
 	"k8s.io/utils/pointer"
 
 	"github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/internal/armada/imageresolver"
 	"github.com/armadaproject/armada/internal/armada/permissions"
 	"github.com/armadaproject/armada/internal/armada/repository"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
 	"github.com/armadaproject/armada/internal/common/auth/authorization"
 	"github.com/armadaproject/armada/internal/common/auth/permission"
+	"github.com/armadaproject/armada/internal/common/compress"
+	"github.com/armadaproject/armada/internal/common/replica"
 	armadaresource "github.com/armadaproject/armada/internal/common/resource"
 	schedulertypes "github.com/armadaproject/armada/internal/common/types"
 	"github.com/armadaproject/armada/internal/common/util"
@@ -41,6 +49,32 @@ func (s *queuesStreamMock) Send(m *api.StreamingQueueMessage) error {
 	return nil
 }
 
+// queueChangesStreamMock records the changes sent to it and cancels its context once it has received n of them.
+type queueChangesStreamMock struct {
+	grpc.ServerStream
+	ctx    context.Context
+	cancel context.CancelFunc
+	n      int
+	msgs   []*api.QueueChange
+}
+
+func newQueueChangesStreamMock(n int) *queueChangesStreamMock {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	return &queueChangesStreamMock{ctx: ctx, cancel: cancel, n: n}
+}
+
+func (s *queueChangesStreamMock) Context() context.Context {
+	return s.ctx
+}
+
+func (s *queueChangesStreamMock) Send(m *api.QueueChange) error {
+	s.msgs = append(s.msgs, m)
+	if len(s.msgs) >= s.n {
+		s.cancel()
+	}
+	return nil
+}
+
 func TestSubmitServer_HealthCheck(t *testing.T) {
 	withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
 		health, err := s.Health(context.Background(), &types.Empty{})
@@ -49,6 +83,54 @@ func TestSubmitServer_HealthCheck(t *testing.T) {
 	})
 }
 
+func TestSubmitServer_WatchQueues(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
+		_, err := s.CreateQueue(context.Background(), &api.Queue{Name: "a", PriorityFactor: 1})
+		require.NoError(t, err)
+		_, err = s.CreateQueue(context.Background(), &api.Queue{Name: "b", PriorityFactor: 1})
+		require.NoError(t, err)
+
+		// Watching from 0 reports the current queues, including the "test" queue every test starts with, first.
+		stream := newQueueChangesStreamMock(3)
+		require.NoError(t, s.WatchQueues(&api.WatchQueuesRequest{}, stream))
+		require.Len(t, stream.msgs, 3)
+		var names []string
+		for _, msg := range stream.msgs {
+			assert.Equal(t, api.QueueChangeType_QueueCreated, msg.Type)
+			names = append(names, msg.Queue.Name)
+		}
+		assert.ElementsMatch(t, []string{"a", "b", "test"}, names)
+		resourceVersion := stream.msgs[0].ResourceVersion
+
+		go func() {
+			time.Sleep(100 * time.Millisecond)
+			_, _ = s.UpdateQueue(context.Background(), &api.Queue{Name: "a", PriorityFactor: 2})
+			_, _ = s.DeleteQueue(context.Background(), &api.QueueDeleteRequest{Name: "b"})
+		}()
+		stream = newQueueChangesStreamMock(2)
+		require.NoError(t, s.WatchQueues(&api.WatchQueuesRequest{ResourceVersion: resourceVersion}, stream))
+		require.Len(t, stream.msgs, 2)
+		assert.Equal(t, api.QueueChangeType_QueueUpdated, stream.msgs[0].Type)
+		assert.Equal(t, "a", stream.msgs[0].Queue.Name)
+		assert.Equal(t, float64(2), stream.msgs[0].Queue.PriorityFactor)
+		assert.Equal(t, api.QueueChangeType_QueueDeleted, stream.msgs[1].Type)
+		assert.Equal(t, "b", stream.msgs[1].Queue.Name)
+		assert.Equal(t, resourceVersion+2, stream.msgs[1].ResourceVersion)
+	})
+}
+
+func TestPulsarSubmitServer_WatchQueues(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
+		srv := &PulsarSubmitServer{SubmitServer: s}
+
+		stream := newQueueChangesStreamMock(1)
+		require.NoError(t, srv.WatchQueues(&api.WatchQueuesRequest{}, stream))
+		require.Len(t, stream.msgs, 1)
+		assert.Equal(t, api.QueueChangeType_QueueCreated, stream.msgs[0].Type)
+		assert.Equal(t, "test", stream.msgs[0].Queue.Name)
+	})
+}
+
 func TestSubmitServer_CreateQueue_WithDefaultSettings_CanBeReadBack(t *testing.T) {
 	withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
 		const queueName = "myQueue"
@@ -60,7 +142,9 @@ func TestSubmitServer_CreateQueue_WithDefaultSettings_CanBeReadBack(t *testing.T
 		receivedQueue, err := s.GetQueue(context.Background(), &api.QueueGetRequest{Name: queueName})
 		assert.NoError(t, err)
 
-		defaultQueue := &api.Queue{Name: queueName, PriorityFactor: priority, UserOwners: []string{"anonymous"}, GroupOwners: nil, ResourceLimits: nil}
+		defaultQueue := &api.Queue{Name: queueName, PriorityFactor: priority, UserOwners: []string{"anonymous"}, GroupOwners: nil, ResourceLimits: nil, Revision: 1, ResourceVersion: 2}
+		assert.NotNil(t, receivedQueue.Created)
+		defaultQueue.Created = receivedQueue.Created
 
 		q1, err := queue.NewQueue(receivedQueue)
 		assert.NoError(t, err)
@@ -78,24 +162,140 @@ func TestSubmitServer_getQueues(t *testing.T) {
 
 		err := s.GetQueues(&api.StreamingQueueGetRequest{}, mockStream)
 		require.NoError(t, err)
-		expectedQueue := &api.Queue{Name: "test", PriorityFactor: 1.0, ResourceLimits: map[string]float64{}}
+		require.NotEmpty(t, mockStream.msgs)
+		created := mockStream.msgs[0].GetQueue().GetCreated()
+		assert.NotNil(t, created)
+		expectedQueue := &api.Queue{Name: "test", PriorityFactor: 1.0, ResourceLimits: map[string]float64{}, Revision: 1, ResourceVersion: 1, Created: created}
 
 		assert.Equal(t, mockStream.msgs, []*api.StreamingQueueMessage{
 			{Event: &api.StreamingQueueMessage_Queue{Queue: expectedQueue}},
-			{Event: &api.StreamingQueueMessage_End{End: &api.EndMarker{}}},
+			{Event: &api.StreamingQueueMessage_End{End: &api.EndMarker{ResourceVersion: 1}}},
 		})
 	})
 }
 
+func TestSubmitServer_GetQueues_ResourceVersions(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
+		listQueues := func() (map[string]uint64, uint64) {
+			mockStream := &queuesStreamMock{}
+			require.NoError(t, s.GetQueues(&api.StreamingQueueGetRequest{}, mockStream))
+			resourceVersions := make(map[string]uint64)
+			for _, msg := range mockStream.msgs[:len(mockStream.msgs)-1] {
+				resourceVersions[msg.GetQueue().Name] = msg.GetQueue().ResourceVersion
+			}
+			return resourceVersions, mockStream.msgs[len(mockStream.msgs)-1].GetEnd().ResourceVersion
+		}
+
+		_, err := s.CreateQueue(context.Background(), &api.Queue{Name: "a", PriorityFactor: 1})
+		require.NoError(t, err)
+		_, err = s.CreateQueue(context.Background(), &api.Queue{Name: "b", PriorityFactor: 1})
+		require.NoError(t, err)
+		resourceVersions, snapshot := listQueues()
+		assert.Equal(t, map[string]uint64{"test": 1, "a": 2, "b": 3}, resourceVersions)
+		assert.Equal(t, uint64(3), snapshot)
+
+		// Changes after the listing have greater resource versions than the snapshot, including deletions.
+		_, err = s.UpdateQueue(context.Background(), &api.Queue{Name: "a", PriorityFactor: 2})
+		require.NoError(t, err)
+		_, err = s.DeleteQueue(context.Background(), &api.QueueDeleteRequest{Name: "b"})
+		require.NoError(t, err)
+		resourceVersions, snapshot = listQueues()
+		assert.Equal(t, map[string]uint64{"test": 1, "a": 4}, resourceVersions)
+		assert.Equal(t, uint64(5), snapshot)
+
+		// Deleting queues that don't exist changes nothing.
+		_, err = s.DeleteQueue(context.Background(), &api.QueueDeleteRequest{Name: "b"})
+		require.NoError(t, err)
+		_, snapshot = listQueues()
+		assert.Equal(t, uint64(5), snapshot)
+	})
+}
+
+func TestSubmitServer_GetQueues_Filtered(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
+		for _, q := range []*api.Queue{
+			{Name: "ml-prod", PriorityFactor: 1, Labels: map[string]string{"team": "ml", "env": "prod"}},
+			{Name: "ml-dev", PriorityFactor: 1, Labels: map[string]string{"team": "ml", "env": "dev"}},
+			{
+				Name:           "infra-prod",
+				PriorityFactor: 1,
+				Labels:         map[string]string{"team": "infra", "env": "prod"},
+				Description:    "Production services run by the platform team",
+				Contact:        "infra@example.com",
+			},
+		} {
+			_, err := s.CreateQueue(context.Background(), q)
+			require.NoError(t, err)
+		}
+
+		tests := map[string]struct {
+			req      *api.StreamingQueueGetRequest
+			expected []string
+		}{
+			"labels": {
+				req:      &api.StreamingQueueGetRequest{Labels: map[string]string{"env": "prod"}},
+				expected: []string{"infra-prod", "ml-prod"},
+			},
+			"several labels": {
+				req:      &api.StreamingQueueGetRequest{Labels: map[string]string{"team": "ml", "env": "dev"}},
+				expected: []string{"ml-dev"},
+			},
+			"name prefix": {
+				req:      &api.StreamingQueueGetRequest{NamePrefix: "ml-"},
+				expected: []string{"ml-dev", "ml-prod"},
+			},
+			"labels and name prefix": {
+				req:      &api.StreamingQueueGetRequest{NamePrefix: "ml-", Labels: map[string]string{"env": "prod"}},
+				expected: []string{"ml-prod"},
+			},
+			"search by description": {
+				req:      &api.StreamingQueueGetRequest{Search: "Platform"},
+				expected: []string{"infra-prod"},
+			},
+			"search by name": {
+				req:      &api.StreamingQueueGetRequest{Search: "PROD"},
+				expected: []string{"infra-prod", "ml-prod"},
+			},
+			"no match": {
+				req:      &api.StreamingQueueGetRequest{Labels: map[string]string{"team": "finance"}},
+				expected: nil,
+			},
+		}
+		for name, tc := range tests {
+			t.Run(name, func(t *testing.T) {
+				mockStream := &queuesStreamMock{}
+				require.NoError(t, s.GetQueues(tc.req, mockStream))
+				require.NotEmpty(t, mockStream.msgs)
+				assert.NotNil(t, mockStream.msgs[len(mockStream.msgs)-1].GetEnd())
+				var actual []string
+				for _, msg := range mockStream.msgs[:len(mockStream.msgs)-1] {
+					actual = append(actual, msg.GetQueue().Name)
+				}
+				sort.Strings(actual)
+				assert.Equal(t, tc.expected, actual)
+			})
+		}
+
+		// The number of queues to return applies to matching queues only.
+		mockStream := &queuesStreamMock{}
+		require.NoError(t, s.GetQueues(&api.StreamingQueueGetRequest{Num: 1, Labels: map[string]string{"team": "ml"}}, mockStream))
+		require.Len(t, mockStream.msgs, 2)
+		assert.Equal(t, "ml", mockStream.msgs[0].GetQueue().Labels["team"])
+	})
+}
+
 func TestSubmitServer_CreateQueue_WithCustomSettings_CanBeReadBack(t *testing.T) {
 	withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
 		const queueName = "myQueue"
 		originalQueue := &api.Queue{
-			Name:           queueName,
-			PriorityFactor: 1.1,
-			UserOwners:     []string{"user-a", "user-b"},
-			GroupOwners:    []string{"group-a", "group-b"},
-			ResourceLimits: map[string]float64{"memory": 0.2, "cpu": 0.3},
+			Name:             queueName,
+			PriorityFactor:   1.1,
+			UserOwners:       []string{"user-a", "user-b"},
+			GroupOwners:      []string{"group-a", "group-b"},
+			ResourceLimits:   map[string]float64{"memory": 0.2, "cpu": 0.3},
+			Description:      "Training jobs of the ML team",
+			Contact:          "ml-team@example.com",
+			DocumentationUrl: "https://wiki.example.com/queues/ml",
 		}
 
 		_, err := s.CreateQueue(context.Background(), originalQueue)
@@ -104,6 +304,10 @@ func TestSubmitServer_CreateQueue_WithCustomSettings_CanBeReadBack(t *testing.T)
 		roundTrippedQueue, err := s.GetQueue(context.Background(), &api.QueueGetRequest{Name: queueName})
 		assert.NoError(t, err)
 
+		originalQueue.Revision = 1
+		originalQueue.ResourceVersion = 2
+		assert.NotNil(t, roundTrippedQueue.Created)
+		originalQueue.Created = roundTrippedQueue.Created
 		q1, err := queue.NewQueue(originalQueue)
 		assert.NoError(t, err)
 
@@ -114,6 +318,67 @@ func TestSubmitServer_CreateQueue_WithCustomSettings_CanBeReadBack(t *testing.T)
 	})
 }
 
+func TestSubmitServer_CreateQueues_Atomic(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
+		// An invalid queue prevents all queues from being created.
+		response, err := s.CreateQueues(context.Background(), &api.QueueList{
+			Queues: []*api.Queue{
+				{Name: "parent", PriorityFactor: 1},
+				{Name: "invalid", PriorityFactor: 0.5},
+			},
+			Atomic: true,
+		})
+		require.NoError(t, err)
+		require.Len(t, response.FailedQueues, 1)
+		assert.Equal(t, "invalid", response.FailedQueues[0].Queue.Name)
+		_, err = s.GetQueue(context.Background(), &api.QueueGetRequest{Name: "parent"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+
+		// As does a queue that already exists.
+		_, err = s.CreateQueues(context.Background(), &api.QueueList{
+			Queues: []*api.Queue{{Name: "parent", PriorityFactor: 1}, {Name: "test", PriorityFactor: 1}},
+			Atomic: true,
+		})
+		assert.Equal(t, codes.AlreadyExists, status.Code(err))
+		_, err = s.GetQueue(context.Background(), &api.QueueGetRequest{Name: "parent"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+
+		// Queues may have parents created along with them.
+		response, err = s.CreateQueues(context.Background(), &api.QueueList{
+			Queues: []*api.Queue{{Name: "child", Parent: "parent"}, {Name: "parent", PriorityFactor: 2}},
+			Atomic: true,
+		})
+		require.NoError(t, err)
+		assert.Empty(t, response.FailedQueues)
+		child, err := s.GetQueue(context.Background(), &api.QueueGetRequest{Name: "child"})
+		require.NoError(t, err)
+		assert.Equal(t, "parent", child.Parent)
+	})
+}
+
+func TestSubmitServer_UpdateQueues_Atomic(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
+		_, err := s.UpdateQueues(context.Background(), &api.QueueList{
+			Queues: []*api.Queue{{Name: "test", PriorityFactor: 2}, {Name: "missing", PriorityFactor: 2}},
+			Atomic: true,
+		})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		q, err := s.GetQueue(context.Background(), &api.QueueGetRequest{Name: "test"})
+		require.NoError(t, err)
+		assert.Equal(t, 1.0, q.PriorityFactor)
+
+		// Without atomic, the valid update is applied regardless.
+		response, err := s.UpdateQueues(context.Background(), &api.QueueList{
+			Queues: []*api.Queue{{Name: "test", PriorityFactor: 2}, {Name: "missing", PriorityFactor: 2}},
+		})
+		require.NoError(t, err)
+		require.Len(t, response.FailedQueues, 1)
+		q, err = s.GetQueue(context.Background(), &api.QueueGetRequest{Name: "test"})
+		require.NoError(t, err)
+		assert.Equal(t, 2.0, q.PriorityFactor)
+	})
+}
+
 func TestSubmitServer_CreateQueue_WhenQueueAlreadyExists_QueueIsNotChanged_AndReturnsAlreadyExists(t *testing.T) {
 	withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
 		const queueName = "myQueue"
@@ -143,6 +408,10 @@ func TestSubmitServer_CreateQueue_WhenQueueAlreadyExists_QueueIsNotChanged_AndRe
 		roundTrippedQueue, err := s.GetQueue(context.Background(), &api.QueueGetRequest{Name: queueName})
 		assert.NoError(t, err)
 
+		originalQueue.Revision = 1
+		originalQueue.ResourceVersion = 2
+		assert.NotNil(t, roundTrippedQueue.Created)
+		originalQueue.Created = roundTrippedQueue.Created
 		q1, err := queue.NewQueue(originalQueue)
 		assert.NoError(t, err)
 
@@ -192,6 +461,10 @@ func TestSubmitServer_UpdateQueue_WhenQueueExists_ReplacesQueue(t *testing.T) {
 		receivedQueue, err := s.GetQueue(context.Background(), &api.QueueGetRequest{Name: queueName})
 		assert.NoError(t, err)
 
+		updatedQueue.Revision = 2
+		updatedQueue.ResourceVersion = 3
+		assert.NotNil(t, receivedQueue.Created)
+		updatedQueue.Created = receivedQueue.Created
 		q1, err := queue.NewQueue(updatedQueue)
 		assert.NoError(t, err)
 
@@ -202,6 +475,233 @@ func TestSubmitServer_UpdateQueue_WhenQueueExists_ReplacesQueue(t *testing.T) {
 	})
 }
 
+func TestSubmitServer_UpdateQueue_WithStaleRevision_ReturnsAborted(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
+		const queueName = "myQueue"
+		_, err := s.CreateQueue(context.Background(), &api.Queue{Name: queueName, PriorityFactor: 1})
+		require.NoError(t, err)
+
+		_, err = s.UpdateQueue(context.Background(), &api.Queue{Name: queueName, PriorityFactor: 2, Revision: 1})
+		require.NoError(t, err)
+		_, err = s.UpdateQueue(context.Background(), &api.Queue{Name: queueName, PriorityFactor: 3, Revision: 1})
+		assert.Equal(t, codes.Aborted, status.Code(err))
+
+		receivedQueue, err := s.GetQueue(context.Background(), &api.QueueGetRequest{Name: queueName})
+		require.NoError(t, err)
+		assert.Equal(t, 2.0, receivedQueue.PriorityFactor)
+		assert.Equal(t, uint64(2), receivedQueue.Revision)
+	})
+}
+
+func TestSubmitServer_ArchiveAndRestoreQueue(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
+		_, err := s.ArchiveQueue(context.Background(), &api.QueueArchiveRequest{Name: "test", Reason: "team disbanded"})
+		require.NoError(t, err)
+
+		_, err = s.SubmitJobs(context.Background(), createJobRequest(util.NewULID(), 1))
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+		// The configuration of the queue is retained, together with who archived it and why.
+		receivedQueue, err := s.GetQueue(context.Background(), &api.QueueGetRequest{Name: "test"})
+		require.NoError(t, err)
+		assert.Equal(t, 1.0, receivedQueue.PriorityFactor)
+		require.NotNil(t, receivedQueue.Archival)
+		assert.Equal(t, "team disbanded", receivedQueue.Archival.Reason)
+		assert.Equal(t, "anonymous", receivedQueue.Archival.ArchivedBy)
+		assert.False(t, receivedQueue.Archival.ArchivedAt.IsZero())
+		assert.Equal(t, uint64(2), receivedQueue.Revision)
+
+		// Updating an archived queue doesn't restore it.
+		_, err = s.UpdateQueue(context.Background(), &api.Queue{Name: "test", PriorityFactor: 2})
+		require.NoError(t, err)
+		receivedQueue, err = s.GetQueue(context.Background(), &api.QueueGetRequest{Name: "test"})
+		require.NoError(t, err)
+		assert.Equal(t, 2.0, receivedQueue.PriorityFactor)
+		assert.NotNil(t, receivedQueue.Archival)
+
+		_, err = s.RestoreQueue(context.Background(), &api.QueueRestoreRequest{Name: "test"})
+		require.NoError(t, err)
+		receivedQueue, err = s.GetQueue(context.Background(), &api.QueueGetRequest{Name: "test"})
+		require.NoError(t, err)
+		assert.Nil(t, receivedQueue.Archival)
+		assert.Equal(t, 2.0, receivedQueue.PriorityFactor)
+
+		_, err = s.SubmitJobs(context.Background(), createJobRequest(util.NewULID(), 1))
+		assert.NoError(t, err)
+	})
+}
+
+func TestSubmitServer_ArchiveQueue_WhenQueueDoesNotExist(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
+		_, err := s.ArchiveQueue(context.Background(), &api.QueueArchiveRequest{Name: "does-not-exist"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		_, err = s.RestoreQueue(context.Background(), &api.QueueRestoreRequest{Name: "does-not-exist"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
+
+func TestSubmitServer_PatchQueue(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
+		const queueName = "myQueue"
+		_, err := s.CreateQueue(context.Background(), &api.Queue{
+			Name:           queueName,
+			PriorityFactor: 1,
+			UserOwners:     []string{"user-a"},
+			ResourceLimits: map[string]float64{"cpu": 0.5},
+			Labels:         map[string]string{"team": "ml"},
+		})
+		require.NoError(t, err)
+
+		// Only fields in the update mask are changed.
+		patchedQueue, err := s.PatchQueue(context.Background(), &api.QueuePatchRequest{
+			Queue:      &api.Queue{Name: queueName, PriorityFactor: 2, ResourceLimits: map[string]float64{"cpu": 0.1}},
+			UpdateMask: &types.FieldMask{Paths: []string{"priority_factor"}},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 2.0, patchedQueue.PriorityFactor)
+		assert.Equal(t, uint64(2), patchedQueue.Revision)
+
+		receivedQueue, err := s.GetQueue(context.Background(), &api.QueueGetRequest{Name: queueName})
+		require.NoError(t, err)
+		assert.Equal(t, patchedQueue, receivedQueue)
+		assert.Equal(t, map[string]float64{"cpu": 0.5}, receivedQueue.ResourceLimits)
+		assert.Equal(t, map[string]string{"team": "ml"}, receivedQueue.Labels)
+		require.Len(t, receivedQueue.Permissions, 1)
+		assert.Equal(t, "user-a", receivedQueue.Permissions[0].Subjects[0].Name)
+
+		// Patches based on a stale revision are rejected.
+		_, err = s.PatchQueue(context.Background(), &api.QueuePatchRequest{
+			Queue:      &api.Queue{Name: queueName, Labels: map[string]string{"team": "infra"}},
+			UpdateMask: &types.FieldMask{Paths: []string{"labels"}},
+			Revision:   1,
+		})
+		assert.Equal(t, codes.Aborted, status.Code(err))
+		patchedQueue, err = s.PatchQueue(context.Background(), &api.QueuePatchRequest{
+			Queue:      &api.Queue{Name: queueName, Labels: map[string]string{"team": "infra"}},
+			UpdateMask: &types.FieldMask{Paths: []string{"labels"}},
+			Revision:   2,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"team": "infra"}, patchedQueue.Labels)
+		assert.Equal(t, 2.0, patchedQueue.PriorityFactor)
+
+		// Resulting queues are validated.
+		_, err = s.PatchQueue(context.Background(), &api.QueuePatchRequest{
+			Queue:      &api.Queue{Name: queueName, PriorityFactor: -1},
+			UpdateMask: &types.FieldMask{Paths: []string{"priority_factor"}},
+		})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestSubmitServer_PatchQueue_InvalidRequests(t *testing.T) {
+	tests := map[string]struct {
+		req  *api.QueuePatchRequest
+		code codes.Code
+	}{
+		"no queue name": {
+			req:  &api.QueuePatchRequest{Queue: &api.Queue{}, UpdateMask: &types.FieldMask{Paths: []string{"priority_factor"}}},
+			code: codes.InvalidArgument,
+		},
+		"no update mask": {
+			req:  &api.QueuePatchRequest{Queue: &api.Queue{Name: "test"}},
+			code: codes.InvalidArgument,
+		},
+		"unknown field": {
+			req:  &api.QueuePatchRequest{Queue: &api.Queue{Name: "test"}, UpdateMask: &types.FieldMask{Paths: []string{"priorityFactor"}}},
+			code: codes.InvalidArgument,
+		},
+		"immutable field": {
+			req:  &api.QueuePatchRequest{Queue: &api.Queue{Name: "test"}, UpdateMask: &types.FieldMask{Paths: []string{"name"}}},
+			code: codes.InvalidArgument,
+		},
+		"owners": {
+			req:  &api.QueuePatchRequest{Queue: &api.Queue{Name: "test"}, UpdateMask: &types.FieldMask{Paths: []string{"user_owners"}}},
+			code: codes.InvalidArgument,
+		},
+		"missing queue": {
+			req:  &api.QueuePatchRequest{Queue: &api.Queue{Name: "missing"}, UpdateMask: &types.FieldMask{Paths: []string{"priority_factor"}}},
+			code: codes.NotFound,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
+				_, err := s.PatchQueue(context.Background(), tc.req)
+				assert.Equal(t, tc.code, status.Code(err))
+			})
+		})
+	}
+}
+
+func TestSubmitServer_CreateQueue_WithParent(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
+		_, err := s.CreateQueue(context.Background(), &api.Queue{Name: "project", PriorityFactor: 1, Parent: "team"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		_, err = s.CreateQueue(context.Background(), &api.Queue{Name: "team", PriorityFactor: 2})
+		require.NoError(t, err)
+		_, err = s.CreateQueue(context.Background(), &api.Queue{Name: "project", Parent: "team"})
+		require.NoError(t, err)
+
+		receivedQueue, err := s.GetQueue(context.Background(), &api.QueueGetRequest{Name: "project"})
+		require.NoError(t, err)
+		assert.Equal(t, "team", receivedQueue.Parent)
+		assert.Equal(t, float64(0), receivedQueue.PriorityFactor)
+
+		// Queues can't be their own ancestors.
+		_, err = s.UpdateQueue(context.Background(), &api.Queue{Name: "team", PriorityFactor: 2, Parent: "project"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		// Queues with children can't be deleted.
+		_, err = s.DeleteQueue(context.Background(), &api.QueueDeleteRequest{Name: "team"})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		_, err = s.DeleteQueue(context.Background(), &api.QueueDeleteRequest{Name: "project"})
+		require.NoError(t, err)
+		_, err = s.DeleteQueue(context.Background(), &api.QueueDeleteRequest{Name: "team"})
+		require.NoError(t, err)
+	})
+}
+
+func TestSubmitServer_CreateQueue_WithParent_WhenReplicaLags(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
+		// The replica hasn't replicated any queues yet, but is considered up to date.
+		readers := replica.NewRouter[repository.QueueRepository](
+			s.queueRepository,
+			&laggingQueueRepository{QueueRepository: s.queueRepository},
+			func(_ *armadacontext.Context) (time.Duration, error) { return 0, nil },
+			time.Second,
+			0,
+		)
+		s.queueRepository = repository.NewReplicaReadingQueueRepository(readers)
+
+		// Parents are validated against the primary.
+		_, err := s.CreateQueue(context.Background(), &api.Queue{Name: "team", PriorityFactor: 2})
+		require.NoError(t, err)
+		_, err = s.CreateQueue(context.Background(), &api.Queue{Name: "project", Parent: "team"})
+		require.NoError(t, err)
+		_, err = s.UpdateQueue(context.Background(), &api.Queue{Name: "team", PriorityFactor: 2, Parent: "project"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		// As are children of queues to delete.
+		_, err = s.DeleteQueue(context.Background(), &api.QueueDeleteRequest{Name: "team"})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+}
+
+// laggingQueueRepository is a QueueRepository of a replica that hasn't replicated any queues.
+type laggingQueueRepository struct {
+	repository.QueueRepository
+}
+
+func (r *laggingQueueRepository) GetAllQueues() ([]queue.Queue, error) {
+	return nil, nil
+}
+
+func (r *laggingQueueRepository) GetQueueSnapshot() ([]queue.Queue, uint64, error) {
+	return nil, 0, nil
+}
+
 func TestSubmitServer_CreateQueue_WhenPermissionsCheckFails_QueueIsNotCreated_AndReturnsPermissionDenied(t *testing.T) {
 	withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
 		const queueName = "myQueue"
@@ -219,7 +719,7 @@ func TestSubmitServer_CreateQueue_WhenPermissionsCheckFails_QueueIsNotCreated_An
 func TestSubmitServer_UpdateQueue_WhenPermissionsCheckFails_QueueIsNotUpdated_AndReturnsPermissionDenied(t *testing.T) {
 	withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
 		const queueName = "myQueue"
-		originalQueue := &api.Queue{Name: queueName, PriorityFactor: 1}
+		originalQueue := &api.Queue{Name: queueName, PriorityFactor: 1, Revision: 1, ResourceVersion: 2}
 
 		_, err := s.CreateQueue(context.Background(), originalQueue)
 		assert.NoError(t, err)
@@ -231,6 +731,8 @@ func TestSubmitServer_UpdateQueue_WhenPermissionsCheckFails_QueueIsNotUpdated_An
 
 		receivedQueue, err := s.GetQueue(context.Background(), &api.QueueGetRequest{Name: queueName})
 		assert.NoError(t, err)
+		assert.NotNil(t, receivedQueue.Created)
+		originalQueue.Created = receivedQueue.Created
 
 		q1, err := queue.NewQueue(originalQueue)
 		assert.NoError(t, err)
@@ -245,7 +747,7 @@ func TestSubmitServer_UpdateQueue_WhenPermissionsCheckFails_QueueIsNotUpdated_An
 func TestSubmitServer_DeleteQueue_WhenPermissionsCheckFails_QueueIsNotDelete_AndReturnsPermissionDenied(t *testing.T) {
 	withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
 		const queueName = "myQueue"
-		originalQueue := &api.Queue{Name: queueName, PriorityFactor: 1}
+		originalQueue := &api.Queue{Name: queueName, PriorityFactor: 1, Revision: 1, ResourceVersion: 2}
 
 		_, err := s.CreateQueue(context.Background(), originalQueue)
 		assert.NoError(t, err)
@@ -257,6 +759,8 @@ func TestSubmitServer_DeleteQueue_WhenPermissionsCheckFails_QueueIsNotDelete_And
 
 		receivedQueue, err := s.GetQueue(context.Background(), &api.QueueGetRequest{Name: queueName})
 		assert.NoError(t, err)
+		assert.NotNil(t, receivedQueue.Created)
+		originalQueue.Created = receivedQueue.Created
 
 		q1, err := queue.NewQueue(originalQueue)
 		assert.NoError(t, err)
@@ -267,6 +771,77 @@ func TestSubmitServer_DeleteQueue_WhenPermissionsCheckFails_QueueIsNotDelete_And
 	})
 }
 
+func TestSubmitServer_DeleteQueue_Cascade(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
+		_, err := s.SubmitJobs(context.Background(), createJobRequest(util.NewULID(), 3))
+		require.NoError(t, err)
+		_, err = s.SubmitJobs(context.Background(), createJobRequest(util.NewULID(), 2))
+		require.NoError(t, err)
+
+		_, err = s.DeleteQueue(context.Background(), &api.QueueDeleteRequest{Name: "test"})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+		_, err = s.DeleteQueue(context.Background(), &api.QueueDeleteRequest{Name: "test", Cascade: true})
+		require.NoError(t, err)
+
+		opName := api.QueueDeletionOperationName("test")
+		require.Eventually(t, func() bool {
+			op, err := s.GetOperation(context.Background(), &api.OperationGetRequest{Name: opName})
+			require.NoError(t, err)
+			return op.Done
+		}, 5*time.Second, 10*time.Millisecond)
+
+		op, err := s.GetOperation(context.Background(), &api.OperationGetRequest{Name: opName})
+		require.NoError(t, err)
+		assert.Empty(t, op.Error)
+		assert.Equal(t, "queue deleted", op.Progress)
+
+		_, err = s.GetQueue(context.Background(), &api.QueueGetRequest{Name: "test"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
+
+func TestSubmitServer_DeleteQueue_CascadeWhileInProgress(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
+		now := time.Now().UTC()
+		err := s.operationRepository.CreateOperation(&api.Operation{
+			Name:       api.QueueDeletionOperationName("test"),
+			CreateTime: now,
+			UpdateTime: now,
+		}, time.Minute)
+		require.NoError(t, err)
+
+		_, err = s.DeleteQueue(context.Background(), &api.QueueDeleteRequest{Name: "test", Cascade: true})
+		assert.Equal(t, codes.AlreadyExists, status.Code(err))
+
+		receivedQueue, err := s.GetQueue(context.Background(), &api.QueueGetRequest{Name: "test"})
+		require.NoError(t, err)
+		assert.Nil(t, receivedQueue.Archival)
+	})
+}
+
+func TestSubmitServer_GetOperation_RequiresPermissionToDeleteQueue(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
+		now := time.Now().UTC()
+		opName := api.QueueDeletionOperationName("test")
+		require.NoError(t, s.operationRepository.CreateOperation(&api.Operation{Name: opName, CreateTime: now, UpdateTime: now}, time.Minute))
+		s.authorizer = &FakeDenyAllActionAuthorizer{}
+
+		// Whether or not the operation exists.
+		for _, name := range []string{opName, api.QueueDeletionOperationName("does-not-exist"), "does-not-exist"} {
+			_, err := s.GetOperation(context.Background(), &api.OperationGetRequest{Name: name})
+			assert.Equal(t, codes.PermissionDenied, status.Code(err), name)
+		}
+	})
+}
+
+func TestSubmitServer_GetOperation_WhenOperationDoesNotExist(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
+		_, err := s.GetOperation(context.Background(), &api.OperationGetRequest{Name: "does-not-exist"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
+
 func TestSubmitServer_SubmitJob(t *testing.T) {
 	withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
 		jobSetId := util.NewULID()
@@ -381,6 +956,7 @@ func TestSubmitServer_SubmitJob_RejectTolerationsNotEqual(t *testing.T) {
 		}
 		_, err := s.SubmitJobs(context.Background(), jobRequest)
 		assert.NotEmpty(t, err)
+		assert.Equal(t, []api.JobSubmitError_Code{api.JobSubmitError_INVALID_POD_SPEC}, jobSubmitErrorCodes(err))
 	})
 }
 
@@ -432,6 +1008,99 @@ func TestSubmitServer_SubmitJob_WhenPodCannotBeScheduled(t *testing.T) {
 		_, err = s.SubmitJobs(context.Background(), jobRequest)
 
 		assert.Error(t, err)
+		assert.Equal(t, []api.JobSubmitError_Code{api.JobSubmitError_UNSCHEDULABLE}, jobSubmitErrorCodes(err))
+	})
+}
+
+func TestSubmitServer_SubmitJob_WhenSchedulingInfoIsStale(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
+		s.schedulingConfig.SchedulingInfoStaleAfter = time.Minute
+		fakeClock := clock.NewFakeClock(time.Now())
+		s.clock = fakeClock
+		reportCluster := func(reportTime time.Time) {
+			err := s.schedulingInfoRepository.UpdateClusterSchedulingInfo(&api.ClusterSchedulingInfoReport{
+				ClusterId:  "test-cluster",
+				ReportTime: reportTime,
+				NodeTypes: []*api.NodeType{{
+					AllocatableResources: armadaresource.ComputeResources{"cpu": resource.MustParse("500m"), "memory": resource.MustParse("1Gi")},
+				}},
+			})
+			require.NoError(t, err)
+		}
+
+		// Jobs that appear unschedulable while no cluster has reported recently are rejected such that they may be retried.
+		reportCluster(fakeClock.Now())
+		fakeClock.Step(2 * time.Minute)
+		_, err := s.SubmitJobs(context.Background(), createJobRequest(util.NewULID(), 1))
+		require.Error(t, err)
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+		assert.Equal(t, []api.JobSubmitError_Code{api.JobSubmitError_SCHEDULING_INFO_STALE}, jobSubmitErrorCodes(err))
+
+		// Or accepted with a warning, if so configured.
+		s.schedulingConfig.AcceptJobsWithStaleSchedulingInfo = true
+		response, err := s.SubmitJobs(context.Background(), createJobRequest(util.NewULID(), 1))
+		require.NoError(t, err)
+		require.Len(t, response.JobResponseItems, 1)
+		assert.False(t, response.JobResponseItems[0].Failed())
+		assert.Contains(t, response.JobResponseItems[0].Warning, "no cluster has reported scheduling info in the last 1m0s")
+
+		// Once clusters have reported recently, unschedulable jobs are rejected regardless.
+		reportCluster(fakeClock.Now())
+		_, err = s.SubmitJobs(context.Background(), createJobRequest(util.NewULID(), 1))
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Equal(t, []api.JobSubmitError_Code{api.JobSubmitError_UNSCHEDULABLE}, jobSubmitErrorCodes(err))
+	})
+}
+
+func TestSubmitServer_SubmitJob_ExplainsWhyPodCannotBeScheduled(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
+		err := s.schedulingInfoRepository.UpdateClusterSchedulingInfo(&api.ClusterSchedulingInfoReport{
+			ClusterId:  "test-cluster",
+			ReportTime: time.Now(),
+			NodeTypes: []*api.NodeType{{
+				AllocatableResources: armadaresource.ComputeResources{"cpu": resource.MustParse("500m"), "memory": resource.MustParse("1Gi")},
+			}},
+		})
+		require.NoError(t, err)
+		err = s.schedulingInfoRepository.UpdateClusterSchedulingInfo(&api.ClusterSchedulingInfoReport{
+			ClusterId:  "tainted-cluster",
+			ReportTime: time.Now(),
+			NodeTypes: []*api.NodeType{
+				{
+					Taints:               []v1.Taint{{Key: "gpu", Value: "true", Effect: v1.TaintEffectNoSchedule}},
+					AllocatableResources: armadaresource.ComputeResources{"cpu": resource.MustParse("4"), "memory": resource.MustParse("4Gi")},
+				},
+				{
+					Taints:               []v1.Taint{{Key: "gpu", Value: "true", Effect: v1.TaintEffectNoSchedule}},
+					AllocatableResources: armadaresource.ComputeResources{"cpu": resource.MustParse("8"), "memory": resource.MustParse("8Gi")},
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		_, err = s.SubmitJobs(context.Background(), createJobRequest(util.NewULID(), 1))
+		require.Error(t, err)
+		assert.Equal(t, []api.JobSubmitError_Code{api.JobSubmitError_UNSCHEDULABLE}, jobSubmitErrorCodes(err))
+
+		var reasons []*api.ClusterUnschedulableReasons
+		for _, detail := range gogostatus.Convert(err).Details() {
+			if response, ok := detail.(*api.JobSubmitResponse); ok {
+				for _, item := range response.JobResponseItems {
+					assert.Contains(t, item.ErrorDetails.Message, "on cluster tainted-cluster, 2 node type(s) excluded because")
+					reasons = append(reasons, item.UnschedulableReasons...)
+				}
+			}
+		}
+		require.Len(t, reasons, 2)
+		assert.Equal(t, "tainted-cluster", reasons[0].ClusterId)
+		require.Len(t, reasons[0].Reasons, 1)
+		assert.Equal(t, api.UnschedulableReason_UNTOLERATED_TAINT, reasons[0].Reasons[0].Code)
+		assert.Equal(t, int32(2), reasons[0].Reasons[0].NodeTypes)
+		assert.Equal(t, "test-cluster", reasons[1].ClusterId)
+		require.Len(t, reasons[1].Reasons, 1)
+		assert.Equal(t, api.UnschedulableReason_EXCEEDS_MAX_JOB_SIZE, reasons[1].Reasons[0].Code)
+		assert.Equal(t, "cpu", reasons[1].Reasons[0].Resource)
 	})
 }
 
@@ -457,10 +1126,157 @@ func TestSubmitServer_SubmitJob_AddsExpectedEventsInCorrectOrder(t *testing.T) {
 	})
 }
 
-func TestSubmitServer_SubmitJob_ReturnsJobItemsInTheSameOrderTheyWereSubmitted(t *testing.T) {
-	withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
-		jobSetId := util.NewULID()
-		jobRequest := createJobRequest(jobSetId, 5)
+func TestSubmitServer_SubmitJobs_StoresOwnershipGroupsOfEachSubmission(t *testing.T) {
+	withSubmitServerAndRepos(func(s *SubmitServer, jobRepo repository.JobRepository, events *repository.TestEventStore) {
+		submit := func(groups ...string) *api.Job {
+			ctx := authorization.WithPrincipal(context.Background(), authorization.NewStaticPrincipal("alice", groups))
+			response, err := s.SubmitJobs(ctx, createJobRequest(util.NewULID(), 1))
+			require.NoError(t, err)
+			jobs, err := jobRepo.GetExistingJobsByIds([]string{response.JobResponseItems[0].JobId})
+			require.NoError(t, err)
+			require.Len(t, jobs, 1)
+			return jobs[0]
+		}
+		decompressGroups := func(job *api.Job) []string {
+			compressedGroups, err := s.ownershipGroupsRepository.GetOwnershipGroups([]string{job.OwnershipGroupsHash})
+			require.NoError(t, err)
+			groups, err := compress.DecompressStringArray(compressedGroups[job.OwnershipGroupsHash], compress.NewZlibDecompressor())
+			require.NoError(t, err)
+			return groups
+		}
+
+		first := submit("a", "b")
+		second := submit("c")
+		third := submit("a", "b")
+
+		// Submitting jobs with other groups doesn't change the groups of jobs submitted before.
+		assert.NotEqual(t, first.OwnershipGroupsHash, second.OwnershipGroupsHash)
+		assert.Equal(t, first.OwnershipGroupsHash, third.OwnershipGroupsHash)
+		assert.Equal(t, []string{"a", "b", authorization.EveryoneGroup}, decompressGroups(first))
+		assert.Equal(t, []string{"c", authorization.EveryoneGroup}, decompressGroups(second))
+		assert.Empty(t, first.CompressedQueueOwnershipUserGroups)
+	})
+}
+
+func TestSubmitServer_SubmitJobs_ReportsNoEventsIfJobsAreNotStored(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
+		injector := repository.NewFaultInjector()
+		injector.SetFaults([]*api.Fault{{Target: api.FaultTarget_JOB_REPOSITORY, ErrorProbability: 1}})
+		s.jobRepository = repository.NewFaultInjectingJobRepository(s.jobRepository, injector)
+
+		_, err := s.SubmitJobs(context.Background(), createJobRequest(util.NewULID(), 2))
+		assert.Error(t, err)
+		assert.Empty(t, events.ReceivedEvents)
+	})
+}
+
+func TestSubmitServer_SubmitJobs_RelaysEventsThatFailedToPublish(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
+		injector := repository.NewFaultInjector()
+		injector.SetFaults([]*api.Fault{{Target: api.FaultTarget_EVENT_STORE, ErrorProbability: 1}})
+		s.eventStore = repository.NewFaultInjectingEventStore(events, injector)
+
+		response, err := s.SubmitJobs(context.Background(), createJobRequest(util.NewULID(), 2))
+		require.NoError(t, err)
+		assert.Empty(t, events.ReceivedEvents)
+
+		injector.Clear()
+		s.RelayOutboxEvents()
+		require.Len(t, events.ReceivedEvents, 4)
+		for i, item := range response.JobResponseItems {
+			assert.Equal(t, item.JobId, events.ReceivedEvents[2*i].GetSubmitted().GetJobId())
+			assert.Equal(t, item.JobId, events.ReceivedEvents[2*i+1].GetQueued().GetJobId())
+		}
+	})
+}
+
+func TestSubmitServer_SubmitJobs_NormalizesResources(t *testing.T) {
+	withSubmitServerAndRepos(func(s *SubmitServer, jobRepo repository.JobRepository, events *repository.TestEventStore) {
+		s.schedulingConfig.ResourceRequestIncrements = armadaresource.ComputeResources{
+			"cpu":    resource.MustParse("500m"),
+			"memory": resource.MustParse("256Mi"),
+		}
+		err := s.queueRepository.UpdateQueue(queue.Queue{
+			Name:           "test",
+			PriorityFactor: 1,
+			PodSpecPolicy:  queue.PodSpecPolicy{RequestsEqualLimits: true},
+		})
+		require.NoError(t, err)
+
+		request := createJobRequest(util.NewULID(), 2)
+		original := v1.ResourceRequirements{
+			Requests: v1.ResourceList{"cpu": resource.MustParse("1100m"), "memory": resource.MustParse("300Mi")},
+			Limits:   v1.ResourceList{"cpu": resource.MustParse("2"), "memory": resource.MustParse("300Mi")},
+		}
+		request.JobRequestItems[0].PodSpecs[0].Containers[0].Resources = *original.DeepCopy()
+		response, err := s.SubmitJobs(context.Background(), request)
+		require.NoError(t, err)
+		jobId := response.JobResponseItems[0].JobId
+
+		jobs, err := jobRepo.GetExistingJobsByIds([]string{jobId, response.JobResponseItems[1].JobId})
+		require.NoError(t, err)
+		require.Len(t, jobs, 2)
+		container := jobs[0].GetMainPodSpec().Containers[0]
+		expected := v1.ResourceList{"cpu": resource.MustParse("1500m"), "memory": resource.MustParse("512Mi")}
+		for _, resources := range []v1.ResourceList{container.Resources.Requests, container.Resources.Limits} {
+			for name, quantity := range expected {
+				assert.True(t, quantity.Equal(resources[name]), "unexpected %s", name)
+			}
+		}
+		assert.Contains(t, jobs[0].Annotations, configuration.OriginalResourcesAnnotation)
+		assert.NotContains(t, jobs[1].Annotations, configuration.OriginalResourcesAnnotation)
+
+		// Only the first job was normalized, hence its resources-normalized event follows its submitted event.
+		require.Len(t, events.ReceivedEvents, 5)
+		normalized := events.ReceivedEvents[1].GetResourcesNormalized()
+		require.NotNil(t, normalized)
+		assert.Equal(t, jobId, normalized.JobId)
+		assert.True(t, original.Requests["cpu"].Equal(normalized.OriginalResources[container.Name].Requests["cpu"]))
+		assert.True(t, original.Limits["cpu"].Equal(normalized.OriginalResources[container.Name].Limits["cpu"]))
+		assert.True(t, expected["cpu"].Equal(normalized.NormalizedResources[container.Name].Limits["cpu"]))
+	})
+}
+
+func TestSubmitServer_SubmitJobs_TargetsClusters(t *testing.T) {
+	withSubmitServerAndRepos(func(s *SubmitServer, jobRepo repository.JobRepository, events *repository.TestEventStore) {
+		request := createJobRequest(util.NewULID(), 1)
+		request.JobRequestItems[0].ClusterTargeting = &api.ClusterTargeting{Required: []string{"test-cluster"}}
+		response, err := s.SubmitJobs(context.Background(), request)
+		require.NoError(t, err)
+		jobId := response.JobResponseItems[0].JobId
+
+		adapter := &SchedulerJobRepositoryAdapter{r: jobRepo, clusterId: "test-cluster"}
+		jobs, err := adapter.GetExistingJobsByIds([]string{jobId})
+		require.NoError(t, err)
+		require.Len(t, jobs, 1)
+		assert.Equal(t, "test-cluster", jobs[0].GetAnnotations()[configuration.RequiredClustersAnnotation])
+		adapter = &SchedulerJobRepositoryAdapter{r: jobRepo, clusterId: "other-cluster"}
+		jobs, err = adapter.GetExistingJobsByIds([]string{jobId})
+		require.NoError(t, err)
+		assert.Empty(t, jobs)
+
+		request = createJobRequest(util.NewULID(), 1)
+		request.JobRequestItems[0].ClusterTargeting = &api.ClusterTargeting{Preferred: []string{"missing-cluster"}}
+		_, err = s.SubmitJobs(context.Background(), request)
+		assert.Equal(t, []api.JobSubmitError_Code{api.JobSubmitError_UNSCHEDULABLE}, jobSubmitErrorCodes(err))
+		assert.Contains(t, err.Error(), "missing-cluster")
+
+		// Jobs may exclude unknown clusters, but not every active cluster.
+		request = createJobRequest(util.NewULID(), 1)
+		request.JobRequestItems[0].ClusterTargeting = &api.ClusterTargeting{Excluded: []string{"missing-cluster"}}
+		_, err = s.SubmitJobs(context.Background(), request)
+		assert.NoError(t, err)
+		request = createJobRequest(util.NewULID(), 1)
+		request.JobRequestItems[0].ClusterTargeting = &api.ClusterTargeting{Excluded: []string{"test-cluster"}}
+		_, err = s.SubmitJobs(context.Background(), request)
+		assert.Equal(t, []api.JobSubmitError_Code{api.JobSubmitError_UNSCHEDULABLE}, jobSubmitErrorCodes(err))
+	})
+}
+
+func TestSubmitServer_SubmitJob_ReturnsJobItemsInTheSameOrderTheyWereSubmitted(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
+		jobSetId := util.NewULID()
+		jobRequest := createJobRequest(jobSetId, 5)
 
 		response, err := s.SubmitJobs(context.Background(), jobRequest)
 		assert.Empty(t, err)
@@ -511,6 +1327,959 @@ func TestSubmitServer_SubmitJobs_RejectsIfTooManyJobsAreQueued(t *testing.T) {
 
 		_, err := s.SubmitJobs(context.Background(), createJobRequest(jobSetId, 1))
 		assert.Error(t, err)
+		assert.Equal(t, []api.JobSubmitError_Code{api.JobSubmitError_EXCEEDS_QUEUE_LIMIT}, jobSubmitErrorCodes(err))
+	})
+}
+
+func TestSubmitServer_SubmitJobs_RejectsIfQueueQuotaWouldBeExceeded(t *testing.T) {
+	withSubmitServerAndRepos(func(s *SubmitServer, jobRepo repository.JobRepository, events *repository.TestEventStore) {
+		// Each job requests 1 cpu and 512Mi of memory.
+		err := s.queueRepository.UpdateQueue(queue.Queue{
+			Name:           "test",
+			PriorityFactor: 1,
+			ResourceQuotas: queue.ResourceQuotas{"cpu": resource.MustParse("3"), "nvidia.com/gpu": resource.MustParse("1")},
+		})
+		require.NoError(t, err)
+		jobSetId := util.NewULID()
+
+		result, err := s.SubmitJobs(context.Background(), createJobRequest(jobSetId, 2))
+		require.NoError(t, err)
+		_, err = jobRepo.TryLeaseJobs("cluster", map[string][]string{"test": {result.JobResponseItems[0].JobId}})
+		require.NoError(t, err)
+
+		// Leased jobs count towards the quota.
+		_, err = s.SubmitJobs(context.Background(), createJobRequest(jobSetId, 2))
+		if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), "currently have 2, would have 4 with new submission, quota is 3")
+		}
+		_, err = s.SubmitJobs(context.Background(), createJobRequest(jobSetId, 1))
+		assert.NoError(t, err)
+
+		// Finished jobs no longer count towards the quota.
+		_, err = jobRepo.DeleteJobs([]*api.Job{{Id: result.JobResponseItems[0].JobId, Queue: "test", JobSetId: jobSetId}})
+		require.NoError(t, err)
+		_, err = s.SubmitJobs(context.Background(), createJobRequest(jobSetId, 1))
+		assert.NoError(t, err)
+	})
+}
+
+func TestSubmitServer_SubmitJobs_BarrierHeldUntilComplete(t *testing.T) {
+	withSubmitServerAndRepos(func(s *SubmitServer, jobRepo repository.JobRepository, events *repository.TestEventStore) {
+		barrierRequest := func(numberOfJobs int) *api.JobSubmitRequest {
+			request := createJobRequest(util.NewULID(), numberOfJobs)
+			for _, item := range request.JobRequestItems {
+				item.Annotations = map[string]string{
+					configuration.BarrierIdAnnotation:          "fan-in",
+					configuration.BarrierCardinalityAnnotation: "3",
+				}
+			}
+			return request
+		}
+		adapter := &SchedulerJobRepositoryAdapter{r: jobRepo, barrierRepository: s.barrierRepository}
+		var jobIds []string
+
+		response, err := s.SubmitJobs(context.Background(), barrierRequest(2))
+		require.NoError(t, err)
+		for _, item := range response.JobResponseItems {
+			jobIds = append(jobIds, item.JobId)
+		}
+		barrier, err := s.GetBarrier(context.Background(), &api.BarrierGetRequest{Queue: "test", Id: "fan-in"})
+		require.NoError(t, err)
+		assert.False(t, barrier.Released)
+		jobs, err := adapter.GetExistingJobsByIds(jobIds)
+		require.NoError(t, err)
+		assert.Empty(t, jobs)
+
+		// Joining with a different cardinality is rejected.
+		request := barrierRequest(1)
+		request.JobRequestItems[0].Annotations[configuration.BarrierCardinalityAnnotation] = "4"
+		_, err = s.SubmitJobs(context.Background(), request)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		response, err = s.SubmitJobs(context.Background(), barrierRequest(1))
+		require.NoError(t, err)
+		jobIds = append(jobIds, response.JobResponseItems[0].JobId)
+		barrier, err = s.GetBarrier(context.Background(), &api.BarrierGetRequest{Queue: "test", Id: "fan-in"})
+		require.NoError(t, err)
+		assert.True(t, barrier.Released)
+		assert.ElementsMatch(t, jobIds, barrier.JobIds)
+		jobs, err = adapter.GetExistingJobsByIds(jobIds)
+		require.NoError(t, err)
+		assert.Len(t, jobs, 3)
+	})
+}
+
+func TestSubmitServer_SubmitJobs_UndoesBarrierMembershipOfJobsNotStored(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
+		barrierRequest := func(numberOfJobs int) *api.JobSubmitRequest {
+			request := createJobRequest(util.NewULID(), numberOfJobs)
+			for _, item := range request.JobRequestItems {
+				item.Annotations = map[string]string{
+					configuration.BarrierIdAnnotation:          "fan-in",
+					configuration.BarrierCardinalityAnnotation: "2",
+				}
+			}
+			return request
+		}
+		_, err := s.SubmitJobs(context.Background(), barrierRequest(1))
+		require.NoError(t, err)
+
+		injector := repository.NewFaultInjector()
+		injector.SetFaults([]*api.Fault{{Target: api.FaultTarget_JOB_REPOSITORY, ErrorProbability: 1}})
+		jobRepository := s.jobRepository
+		s.jobRepository = repository.NewFaultInjectingJobRepository(jobRepository, injector)
+		_, err = s.SubmitJobs(context.Background(), barrierRequest(1))
+		assert.Error(t, err)
+		barrier, err := s.GetBarrier(context.Background(), &api.BarrierGetRequest{Queue: "test", Id: "fan-in"})
+		require.NoError(t, err)
+		assert.False(t, barrier.Released)
+		assert.Len(t, barrier.JobIds, 1)
+
+		// The job that wasn't stored doesn't take the place of the job resubmitted in its stead.
+		s.jobRepository = jobRepository
+		_, err = s.SubmitJobs(context.Background(), barrierRequest(1))
+		require.NoError(t, err)
+		barrier, err = s.GetBarrier(context.Background(), &api.BarrierGetRequest{Queue: "test", Id: "fan-in"})
+		require.NoError(t, err)
+		assert.True(t, barrier.Released)
+	})
+}
+
+func TestSubmitServer_SubmitJobs_MaxConcurrentJobs(t *testing.T) {
+	withSubmitServerAndRepos(func(s *SubmitServer, jobRepo repository.JobRepository, events *repository.TestEventStore) {
+		request := createJobRequest(util.NewULID(), 3)
+		request.MaxConcurrentJobs = 2
+		response, err := s.SubmitJobs(context.Background(), request)
+		require.NoError(t, err)
+		var jobIds []string
+		for _, item := range response.JobResponseItems {
+			jobIds = append(jobIds, item.JobId)
+		}
+		unthrottled, err := s.SubmitJobs(context.Background(), createJobRequest(request.JobSetId, 1))
+		require.NoError(t, err)
+
+		adapter := &SchedulerJobRepositoryAdapter{r: jobRepo, throttler: newJobSetThrottler(jobRepo)}
+		jobs, err := adapter.GetExistingJobsByIds(append(jobIds, unthrottled.JobResponseItems[0].JobId))
+		require.NoError(t, err)
+		require.Len(t, jobs, 3)
+		assert.Equal(t, unthrottled.JobResponseItems[0].JobId, jobs[2].GetId())
+
+		// Once a job is leased, only one more job is admitted.
+		_, err = jobRepo.TryLeaseJobs("cluster", map[string][]string{"test": {jobs[0].GetId()}})
+		require.NoError(t, err)
+		adapter = &SchedulerJobRepositoryAdapter{r: jobRepo, throttler: newJobSetThrottler(jobRepo)}
+		jobs, err = adapter.GetExistingJobsByIds(jobIds)
+		require.NoError(t, err)
+		assert.Len(t, jobs, 2)
+
+		// Finished jobs no longer count towards the limit.
+		_, err = jobRepo.DeleteJobs([]*api.Job{jobs[0].(*api.Job)})
+		require.NoError(t, err)
+		adapter = &SchedulerJobRepositoryAdapter{r: jobRepo, throttler: newJobSetThrottler(jobRepo)}
+		jobs, err = adapter.GetExistingJobsByIds(jobIds[1:])
+		require.NoError(t, err)
+		assert.Len(t, jobs, 2)
+	})
+}
+
+func TestSubmitServer_SubmitJobs_JobSetResourceLimits(t *testing.T) {
+	withSubmitServerAndRepos(func(s *SubmitServer, jobRepo repository.JobRepository, events *repository.TestEventStore) {
+		// Each job requests 1 cpu.
+		request := createJobRequest(util.NewULID(), 3)
+		request.JobSetResourceLimits = map[string]resource.Quantity{"cpu": resource.MustParse("2")}
+		response, err := s.SubmitJobs(context.Background(), request)
+		require.NoError(t, err)
+		var jobIds []string
+		for _, item := range response.JobResponseItems {
+			jobIds = append(jobIds, item.JobId)
+		}
+
+		adapter := &SchedulerJobRepositoryAdapter{r: jobRepo, throttler: newJobSetThrottler(jobRepo)}
+		jobs, err := adapter.GetExistingJobsByIds(jobIds)
+		require.NoError(t, err)
+		require.Len(t, jobs, 2)
+		assert.Equal(t, "cpu=2", jobs[0].GetAnnotations()[configuration.JobSetResourceLimitsAnnotation])
+
+		// Leased jobs count towards the limit.
+		_, err = jobRepo.TryLeaseJobs("cluster", map[string][]string{"test": {jobs[0].GetId()}})
+		require.NoError(t, err)
+		adapter = &SchedulerJobRepositoryAdapter{r: jobRepo, throttler: newJobSetThrottler(jobRepo)}
+		jobs, err = adapter.GetExistingJobsByIds(jobIds[1:])
+		require.NoError(t, err)
+		assert.Len(t, jobs, 1)
+
+		// Jobs requesting more than the limit are rejected.
+		request = createJobRequest(util.NewULID(), 1)
+		request.JobSetResourceLimits = map[string]resource.Quantity{"cpu": resource.MustParse("500m")}
+		_, err = s.SubmitJobs(context.Background(), request)
+		assert.Error(t, err)
+	})
+}
+
+func TestSubmitServer_CreateJobs_RejectsOversizedJobs(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
+		s.schedulingConfig.MaxJobSizeBytes = 4096
+		err := s.queueRepository.UpdateQueue(queue.Queue{Name: "test", PriorityFactor: 1, MaxContainersPerJob: 1})
+		require.NoError(t, err)
+
+		request := createJobRequest(util.NewULID(), 2)
+		request.JobRequestItems[0].PodSpecs[0].Containers[0].Env = []v1.EnvVar{
+			{Name: "PAYLOAD", Value: strings.Repeat("a", 8192)},
+		}
+		request.JobRequestItems[1].PodSpecs[0].InitContainers = []v1.Container{
+			request.JobRequestItems[1].PodSpecs[0].Containers[0],
+		}
+
+		_, responseItems, err := s.createJobs(request, "owner")
+		assert.Error(t, err)
+		require.Len(t, responseItems, 2)
+		assert.Equal(t, "podSpecs[0].containers[0].env", responseItems[0].SizeLimitViolation.Field)
+		assert.Equal(t, uint64(4096), responseItems[0].SizeLimitViolation.Limit)
+		assert.Greater(t, responseItems[0].SizeLimitViolation.JobSize, uint64(8192))
+		assert.Equal(t, &api.JobSizeLimitViolation{Field: "podSpecs[0].containers", JobSize: 2, Limit: 1}, responseItems[1].SizeLimitViolation)
+		for _, item := range responseItems {
+			assert.Equal(t, api.JobSubmitError_EXCEEDS_SIZE_LIMIT, item.ErrorDetails.Code)
+			assert.Equal(t, item.SizeLimitViolation.Field, item.ErrorDetails.Field)
+		}
+	})
+}
+
+func TestSubmitServer_CreateJobs_AppliesQueuePodSpecPolicy(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
+		s.schedulingConfig.AllowedRestartPolicies = []v1.RestartPolicy{v1.RestartPolicyNever, v1.RestartPolicyOnFailure}
+		err := s.queueRepository.UpdateQueue(queue.Queue{
+			Name:           "test",
+			PriorityFactor: 1,
+			PodSpecPolicy: queue.PodSpecPolicy{
+				DefaultTerminationGracePeriodSeconds: 60,
+				MaxTerminationGracePeriodSeconds:     120,
+				DefaultRestartPolicy:                 v1.RestartPolicyOnFailure,
+				// Always isn't allowed server-wide and hence ignored.
+				AllowedRestartPolicies: []v1.RestartPolicy{v1.RestartPolicyOnFailure, v1.RestartPolicyAlways},
+			},
+		})
+		require.NoError(t, err)
+
+		jobs, _, err := s.createJobs(createJobRequest(util.NewULID(), 1), "owner")
+		require.NoError(t, err)
+		require.Len(t, jobs, 1)
+		assert.Equal(t, int64(60), *jobs[0].PodSpecs[0].TerminationGracePeriodSeconds)
+		assert.Equal(t, v1.RestartPolicyOnFailure, jobs[0].PodSpecs[0].RestartPolicy)
+
+		request := createJobRequest(util.NewULID(), 3)
+		request.JobRequestItems[0].PodSpecs[0].TerminationGracePeriodSeconds = pointer.Int64(180)
+		request.JobRequestItems[1].PodSpecs[0].RestartPolicy = v1.RestartPolicyNever
+		request.JobRequestItems[2].PodSpecs[0].RestartPolicy = v1.RestartPolicyAlways
+		_, responseItems, err := s.createJobs(request, "owner")
+		assert.Error(t, err)
+		require.Len(t, responseItems, 3)
+		assert.Contains(t, responseItems[0].Error, "terminationGracePeriodSeconds")
+		assert.Contains(t, responseItems[1].Error, "restartPolicy Never")
+		assert.Contains(t, responseItems[2].Error, "restartPolicy Always")
+	})
+}
+
+func TestSubmitServer_CreateJobs_AppliesQueueAllowedServiceAccounts(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
+		s.schedulingConfig.AllowedServiceAccounts = []string{"default", "pipeline-runner", "reader"}
+		err := s.queueRepository.UpdateQueue(queue.Queue{
+			Name:           "test",
+			PriorityFactor: 1,
+			PodSpecPolicy: queue.PodSpecPolicy{
+				// cluster-admin isn't allowed server-wide and hence ignored.
+				AllowedServiceAccounts: []string{"pipeline-runner", "cluster-admin"},
+			},
+		})
+		require.NoError(t, err)
+
+		request := createJobRequest(util.NewULID(), 4)
+		request.JobRequestItems[0].PodSpecs[0].ServiceAccountName = "pipeline-runner"
+		request.JobRequestItems[2].PodSpecs[0].ServiceAccountName = "reader"
+		request.JobRequestItems[3].PodSpecs[0].ServiceAccountName = "cluster-admin"
+		_, responseItems, err := s.createJobs(request, "owner")
+		assert.Error(t, err)
+		require.Len(t, responseItems, 3)
+		assert.Contains(t, responseItems[0].Error, "serviceAccountName default")
+		assert.Contains(t, responseItems[1].Error, "serviceAccountName reader")
+		assert.Contains(t, responseItems[2].Error, "serviceAccountName cluster-admin")
+	})
+}
+
+func TestSubmitServer_CreateJobs_DeniesServiceAccountsIfQueueAndServerAllowNoneInCommon(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
+		s.schedulingConfig.AllowedServiceAccounts = []string{"default", "reader"}
+		err := s.queueRepository.UpdateQueue(queue.Queue{
+			Name:           "test",
+			PriorityFactor: 1,
+			PodSpecPolicy:  queue.PodSpecPolicy{AllowedServiceAccounts: []string{"cluster-admin"}},
+		})
+		require.NoError(t, err)
+
+		request := createJobRequest(util.NewULID(), 3)
+		request.JobRequestItems[1].PodSpecs[0].ServiceAccountName = "reader"
+		request.JobRequestItems[2].PodSpecs[0].ServiceAccountName = "cluster-admin"
+		_, responseItems, err := s.createJobs(request, "owner")
+		assert.Error(t, err)
+		require.Len(t, responseItems, 3)
+		for _, item := range responseItems {
+			assert.Contains(t, item.Error, "none of the service accounts allowed for the queue is allowed server-wide")
+		}
+	})
+}
+
+func TestSubmitServer_CreateJobs_AppliesQueueServiceAccounts(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
+		s.schedulingConfig.AllowedServiceAccounts = []string{"default", "cluster-admin"}
+		s.schedulingConfig.QueueServiceAccounts = map[string]configuration.QueueServiceAccounts{
+			"test": {Allowed: []string{"reader"}, Default: "pipeline-runner"},
+		}
+
+		request := createJobRequest(util.NewULID(), 3)
+		request.JobRequestItems[1].PodSpecs[0].ServiceAccountName = "reader"
+		request.JobRequestItems[2].PodSpecs[0].ServiceAccountName = "cluster-admin"
+		_, responseItems, err := s.createJobs(request, "owner")
+		assert.Error(t, err)
+		require.Len(t, responseItems, 1)
+		assert.Contains(t, responseItems[0].Error, "serviceAccountName cluster-admin must be one of [reader pipeline-runner]")
+
+		request = createJobRequest(util.NewULID(), 2)
+		request.JobRequestItems[1].PodSpecs[0].ServiceAccountName = "reader"
+		jobs, _, err := s.createJobs(request, "owner")
+		require.NoError(t, err)
+		require.Len(t, jobs, 2)
+		assert.Equal(t, "pipeline-runner", jobs[0].PodSpecs[0].ServiceAccountName)
+		assert.Equal(t, "reader", jobs[1].PodSpecs[0].ServiceAccountName)
+	})
+}
+
+func TestSubmitServer_CreateJobs_RestrictsUnmappedQueuesToDefaultServiceAccount(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
+		s.schedulingConfig.AllowedServiceAccounts = []string{"default", "cluster-admin"}
+		s.schedulingConfig.DefaultServiceAccount = "restricted"
+		s.schedulingConfig.QueueServiceAccounts = map[string]configuration.QueueServiceAccounts{
+			"other": {Allowed: []string{"cluster-admin"}},
+		}
+
+		request := createJobRequest(util.NewULID(), 2)
+		request.JobRequestItems[1].PodSpecs[0].ServiceAccountName = "cluster-admin"
+		_, responseItems, err := s.createJobs(request, "owner")
+		assert.Error(t, err)
+		require.Len(t, responseItems, 1)
+		assert.Contains(t, responseItems[0].Error, "serviceAccountName cluster-admin must be one of [restricted]")
+
+		jobs, _, err := s.createJobs(createJobRequest(util.NewULID(), 1), "owner")
+		require.NoError(t, err)
+		require.Len(t, jobs, 1)
+		assert.Equal(t, "restricted", jobs[0].PodSpecs[0].ServiceAccountName)
+	})
+}
+
+func TestSubmitServer_CreateJobs_AppliesQueueExternalDnsDomains(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
+		s.schedulingConfig.ExternalDnsDomains = []string{"example.com"}
+		s.schedulingConfig.QueueExternalDnsDomains = map[string][]string{"test": {"team.example.net"}}
+
+		request := createJobRequest(util.NewULID(), 2)
+		request.JobRequestItems[0].Ingress = []*api.IngressConfig{{
+			Ports:       []uint32{8080},
+			ExternalDns: &api.ExternalDnsConfig{Hostnames: []string{"app.team.example.net"}},
+		}}
+		request.JobRequestItems[1].Ingress = []*api.IngressConfig{{
+			Ports:       []uint32{8080},
+			ExternalDns: &api.ExternalDnsConfig{Hostnames: []string{"app.example.com"}},
+		}}
+		_, responseItems, err := s.createJobs(request, "owner")
+		assert.Error(t, err)
+		require.Len(t, responseItems, 1)
+		assert.Contains(t, responseItems[0].Error, "external dns hostname app.example.com isn't within any of the allowed domains [team.example.net]")
+	})
+}
+
+func TestSubmitServer_CreateJobs_AppliesQueueNodeConstraints(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
+		s.schedulingConfig.RequiredJobNodeSelector = map[string]string{"region": "eu"}
+		toleration := v1.Toleration{Key: "pool", Operator: v1.TolerationOpEqual, Value: "team-a", Effect: v1.TaintEffectNoSchedule}
+		err := s.queueRepository.UpdateQueue(queue.Queue{
+			Name:           "test",
+			PriorityFactor: 1,
+			PodSpecPolicy: queue.PodSpecPolicy{
+				// The server-wide region takes precedence.
+				RequiredNodeSelector:        map[string]string{"pool": "team-a", "region": "us"},
+				RequiredTolerations:         []v1.Toleration{toleration},
+				ForbiddenNodeSelectorLabels: []string{"dedicated"},
+				ForbiddenTaints:             []v1.Taint{{Key: "pool", Value: "team-b", Effect: v1.TaintEffectNoSchedule}},
+			},
+		})
+		require.NoError(t, err)
+
+		request := createJobRequest(util.NewULID(), 1)
+		request.JobRequestItems[0].PodSpecs[0].NodeSelector = map[string]string{"pool": "team-b", "disk": "ssd"}
+		jobs, _, err := s.createJobs(request, "owner")
+		require.NoError(t, err)
+		require.Len(t, jobs, 1)
+		assert.Equal(t, map[string]string{"pool": "team-a", "region": "eu", "disk": "ssd"}, jobs[0].PodSpecs[0].NodeSelector)
+		assert.Contains(t, jobs[0].PodSpecs[0].Tolerations, toleration)
+
+		request = createJobRequest(util.NewULID(), 3)
+		request.JobRequestItems[0].PodSpecs[0].NodeSelector = map[string]string{"dedicated": "gpu"}
+		request.JobRequestItems[1].RequiredNodeLabels = map[string]string{"dedicated": "gpu"}
+		request.JobRequestItems[2].PodSpecs[0].Tolerations = []v1.Toleration{{Operator: v1.TolerationOpExists}}
+		_, responseItems, err := s.createJobs(request, "owner")
+		assert.Error(t, err)
+		require.Len(t, responseItems, 3)
+		assert.Contains(t, responseItems[0].Error, "label dedicated")
+		assert.Contains(t, responseItems[1].Error, "label dedicated")
+		assert.Contains(t, responseItems[2].Error, "must not tolerate taint pool=team-b:NoSchedule")
+	})
+}
+
+func TestSubmitServer_CreateJobs_ValidatesQueueRequiredAnnotations(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
+		err := s.queueRepository.UpdateQueue(queue.Queue{
+			Name:                "test",
+			PriorityFactor:      1,
+			RequiredAnnotations: queue.RequiredAnnotations{"cost-center": "[0-9]{4}", "team": ""},
+		})
+		require.NoError(t, err)
+
+		request := createJobRequest(util.NewULID(), 3)
+		request.JobRequestItems[0].Annotations = map[string]string{"cost-center": "1234", "team": "ml"}
+		request.JobRequestItems[1].Annotations = map[string]string{"cost-center": "12", "team": "ml"}
+		request.JobRequestItems[2].Annotations = map[string]string{"cost-center": "1234"}
+		_, responseItems, err := s.createJobs(request, "owner")
+		assert.Error(t, err)
+		require.Len(t, responseItems, 2)
+		assert.Contains(t, responseItems[0].Error, "1-th job")
+		assert.Contains(t, responseItems[0].Error, `value "12" of annotation cost-center doesn't match "[0-9]{4}"`)
+		assert.Contains(t, responseItems[1].Error, "2-th job")
+		assert.Contains(t, responseItems[1].Error, "annotation team is required")
+
+		request = createJobRequest(util.NewULID(), 1)
+		request.JobRequestItems[0].Annotations = map[string]string{"cost-center": "1234", "team": "ml"}
+		_, err = s.SubmitJobs(context.Background(), request)
+		assert.NoError(t, err)
+	})
+}
+
+func TestSubmitServer_CreateJobs_SubmissionWindows(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
+		err := s.queueRepository.UpdateQueue(queue.Queue{
+			Name:           "test",
+			PriorityFactor: 1,
+			SubmissionWindows: queue.SubmissionWindowPolicy{
+				Windows: []queue.SubmissionWindow{{Start: "0 18 * * *", DurationMinutes: 60}},
+			},
+		})
+		require.NoError(t, err)
+		at := func(hour int) func() time.Time {
+			return func() time.Time { return time.Date(2023, 5, 17, hour, 30, 0, 0, time.UTC) }
+		}
+
+		jobs, _, err := s.createJobsObjects(createJobRequest(util.NewULID(), 1), "owner", at(18), util.NewULID)
+		require.NoError(t, err)
+		assert.NotContains(t, jobs[0].Annotations, configuration.HeldUntilAnnotation)
+
+		_, _, err = s.createJobsObjects(createJobRequest(util.NewULID(), 1), "owner", at(12), util.NewULID)
+		assert.ErrorContains(t, err, "the next window starts at 2023-05-17T18:00:00Z")
+
+		err = s.queueRepository.UpdateQueue(queue.Queue{
+			Name:           "test",
+			PriorityFactor: 1,
+			SubmissionWindows: queue.SubmissionWindowPolicy{
+				Windows:            []queue.SubmissionWindow{{Start: "0 18 * * *", DurationMinutes: 60}},
+				HoldOutsideWindows: true,
+			},
+		})
+		require.NoError(t, err)
+		jobs, _, err = s.createJobsObjects(createJobRequest(util.NewULID(), 1), "owner", at(12), util.NewULID)
+		require.NoError(t, err)
+		assert.Equal(t, "2023-05-17T18:00:00Z", jobs[0].Annotations[configuration.HeldUntilAnnotation])
+	})
+}
+
+func TestSubmitServer_SubmitJobs_HeldOutsideSubmissionWindows(t *testing.T) {
+	withSubmitServerAndRepos(func(s *SubmitServer, jobRepo repository.JobRepository, events *repository.TestEventStore) {
+		// A window of one minute per year, such that submissions are all but certainly held.
+		err := s.queueRepository.UpdateQueue(queue.Queue{
+			Name:           "test",
+			PriorityFactor: 1,
+			SubmissionWindows: queue.SubmissionWindowPolicy{
+				Windows:            []queue.SubmissionWindow{{Start: "0 0 1 1 *", DurationMinutes: 1}},
+				HoldOutsideWindows: true,
+			},
+		})
+		require.NoError(t, err)
+		response, err := s.SubmitJobs(context.Background(), createJobRequest(util.NewULID(), 1))
+		require.NoError(t, err)
+		jobId := response.JobResponseItems[0].JobId
+
+		adapter := &SchedulerJobRepositoryAdapter{r: jobRepo}
+		jobs, err := adapter.GetExistingJobsByIds([]string{jobId})
+		require.NoError(t, err)
+		assert.Empty(t, jobs)
+
+		reasons, err := s.GetJobWaitReasons(context.Background(), &api.JobWaitReasonsRequest{JobId: jobId})
+		require.NoError(t, err)
+		require.Len(t, reasons.Reasons, 1)
+		assert.Equal(t, api.JobWaitReasonType_OutsideSubmissionWindow, reasons.Reasons[0].Type)
+	})
+}
+
+func TestSubmitServer_CreateJobs_ComposesPodSpecsFromOverlays(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
+		request := createJobRequest(util.NewULID(), 3)
+		request.BasePodSpec = request.JobRequestItems[0].PodSpecs[0]
+		for _, item := range request.JobRequestItems {
+			item.PodSpecs = nil
+		}
+		request.JobRequestItems[1].PodSpecOverlays = []*api.PodSpecOverlay{{
+			Type:  api.PodSpecOverlayType_StrategicMergePatch,
+			Patch: `{"containers": [{"name": "Container 0", "args": ["sleep", "20s"]}]}`,
+		}}
+		request.JobRequestItems[2].PodSpecOverlays = []*api.PodSpecOverlay{{
+			Type:  api.PodSpecOverlayType_JsonPatch,
+			Patch: `[{"op": "replace", "path": "/containers/0/image", "value": "index.docker.io/library/busybox:latest"}]`,
+		}}
+
+		jobs, _, err := s.createJobs(request, "owner")
+		require.NoError(t, err)
+		require.Len(t, jobs, 3)
+		assert.Equal(t, []string{"sleep", "10s"}, jobs[0].GetMainPodSpec().Containers[0].Args)
+		assert.Equal(t, []string{"sleep", "20s"}, jobs[1].GetMainPodSpec().Containers[0].Args)
+		assert.Equal(t, "index.docker.io/library/busybox:latest", jobs[2].GetMainPodSpec().Containers[0].Image)
+
+		request = createJobRequest(util.NewULID(), 1)
+		request.JobRequestItems[0].PodSpecOverlays = []*api.PodSpecOverlay{{Type: api.PodSpecOverlayType_JsonPatch, Patch: `[]`}}
+		_, responseItems, err := s.createJobs(request, "owner")
+		assert.Error(t, err)
+		require.Len(t, responseItems, 1)
+		assert.Contains(t, responseItems[0].Error, "error composing the pod spec of the 0-th job")
+	})
+}
+
+func TestSubmitServer_CreateJobs_AppliesPriorityClassName(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
+		request := createJobRequest(util.NewULID(), 2)
+		request.JobRequestItems[0].PriorityClassName = "high"
+		request.JobRequestItems[1].PriorityClassName = "high"
+		request.JobRequestItems[1].PodSpecs[0].PriorityClassName = "high"
+
+		jobs, responseItems, err := s.createJobs(request, "owner")
+		require.NoError(t, err)
+		require.Empty(t, responseItems)
+		require.Len(t, jobs, 2)
+		assert.Equal(t, "high", jobs[0].GetMainPodSpec().PriorityClassName)
+		assert.Equal(t, "high", jobs[1].GetMainPodSpec().PriorityClassName)
+
+		request = createJobRequest(util.NewULID(), 1)
+		request.JobRequestItems[0].PriorityClassName = "high"
+		request.JobRequestItems[0].PodSpecs[0].PriorityClassName = "low"
+		_, responseItems, err = s.createJobs(request, "owner")
+		assert.Error(t, err)
+		require.Len(t, responseItems, 1)
+		assert.Equal(t, "priorityClassName", responseItems[0].ErrorDetails.Field)
+	})
+}
+
+func TestSubmitServer_CreateJobs_SetsPreemptibleAnnotation(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
+		request := createJobRequest(util.NewULID(), 2)
+		request.JobRequestItems[0].IsPreemptible = true
+
+		jobs, responseItems, err := s.createJobs(request, "owner")
+		require.NoError(t, err)
+		require.Empty(t, responseItems)
+		require.Len(t, jobs, 2)
+		assert.Equal(t, "true", jobs[0].Annotations[configuration.PreemptibleAnnotation])
+		assert.NotContains(t, jobs[1].Annotations, configuration.PreemptibleAnnotation)
+
+		request = createJobRequest(util.NewULID(), 1)
+		request.JobRequestItems[0].IsPreemptible = true
+		request.JobRequestItems[0].Gang = &api.Gang{Id: "gang", Cardinality: 1}
+		_, responseItems, err = s.createJobs(request, "owner")
+		assert.Error(t, err)
+		require.Len(t, responseItems, 1)
+		assert.Equal(t, api.JobSubmitError_INVALID_JOB, responseItems[0].ErrorDetails.Code)
+		assert.Equal(t, "isPreemptible", responseItems[0].ErrorDetails.Field)
+	})
+}
+
+func TestSubmitServer_CreateJobs_SetsMaxRuntimeAnnotation(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
+		request := createJobRequest(util.NewULID(), 2)
+		request.JobRequestItems[0].MaxRuntimeSeconds = 60
+		jobs, responseItems, err := s.createJobs(request, "owner")
+		require.NoError(t, err)
+		require.Empty(t, responseItems)
+		assert.Equal(t, "60", jobs[0].Annotations[configuration.MaxRuntimeSecondsAnnotation])
+		assert.NotContains(t, jobs[1].Annotations, configuration.MaxRuntimeSecondsAnnotation)
+
+		// The maximum job runtime of the queue applies by default, and can't be exceeded.
+		err = s.queueRepository.UpdateQueue(queue.Queue{Name: "test", PriorityFactor: 1, MaxJobRuntimeSeconds: 120})
+		require.NoError(t, err)
+		request = createJobRequest(util.NewULID(), 2)
+		request.JobRequestItems[0].MaxRuntimeSeconds = 60
+		jobs, responseItems, err = s.createJobs(request, "owner")
+		require.NoError(t, err)
+		require.Empty(t, responseItems)
+		assert.Equal(t, "60", jobs[0].Annotations[configuration.MaxRuntimeSecondsAnnotation])
+		assert.Equal(t, "120", jobs[1].Annotations[configuration.MaxRuntimeSecondsAnnotation])
+
+		request = createJobRequest(util.NewULID(), 1)
+		request.JobRequestItems[0].MaxRuntimeSeconds = 121
+		_, responseItems, err = s.createJobs(request, "owner")
+		assert.Error(t, err)
+		require.Len(t, responseItems, 1)
+		assert.Equal(t, api.JobSubmitError_INVALID_JOB, responseItems[0].ErrorDetails.Code)
+		assert.Equal(t, "maxRuntimeSeconds", responseItems[0].ErrorDetails.Field)
+	})
+}
+
+func TestSubmitServer_CreateJobs_EnforcesIngressClassPolicy(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
+		err := s.queueRepository.UpdateQueue(queue.Queue{
+			Name:               "test",
+			PriorityFactor:     1,
+			IngressClassPolicy: queue.IngressClassPolicy{AllowedClasses: []string{"internal", "nginx"}, DefaultClass: "internal"},
+		})
+		require.NoError(t, err)
+
+		// Ingresses without a class use the default class of the queue.
+		request := createJobRequest(util.NewULID(), 1)
+		request.JobRequestItems[0].Ingress = []*api.IngressConfig{{Ports: []uint32{8080}}, {Ports: []uint32{8081}, IngressClassName: "nginx"}}
+		jobs, responseItems, err := s.createJobs(request, "owner")
+		require.NoError(t, err)
+		require.Empty(t, responseItems)
+		assert.Equal(t, "internal", jobs[0].Ingress[0].IngressClassName)
+		assert.Equal(t, "nginx", jobs[0].Ingress[1].IngressClassName)
+
+		request = createJobRequest(util.NewULID(), 1)
+		request.JobRequestItems[0].Ingress = []*api.IngressConfig{{Ports: []uint32{8080}, IngressClassName: "external"}}
+		_, responseItems, err = s.createJobs(request, "owner")
+		assert.Error(t, err)
+		require.Len(t, responseItems, 1)
+		assert.Equal(t, api.JobSubmitError_INVALID_JOB, responseItems[0].ErrorDetails.Code)
+		assert.Equal(t, "ingress", responseItems[0].ErrorDetails.Field)
+	})
+}
+
+func TestSubmitServer_CreateJobs_EnforcesAllowedNamespaces(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
+		err := s.queueRepository.UpdateQueue(queue.Queue{Name: "test", PriorityFactor: 1, AllowedNamespaces: queue.AllowedNamespaces{"team-a"}})
+		require.NoError(t, err)
+
+		request := createJobRequest(util.NewULID(), 1)
+		request.JobRequestItems[0].Namespace = "team-a"
+		jobs, responseItems, err := s.createJobs(request, "owner")
+		require.NoError(t, err)
+		require.Empty(t, responseItems)
+		assert.Equal(t, "team-a", jobs[0].Namespace)
+
+		// Jobs without a namespace are created in namespace "default", which isn't allowed.
+		for _, namespace := range []string{"team-b", ""} {
+			request = createJobRequest(util.NewULID(), 1)
+			request.JobRequestItems[0].Namespace = namespace
+			_, responseItems, err = s.createJobs(request, "owner")
+			assert.Error(t, err)
+			require.Len(t, responseItems, 1)
+			assert.Equal(t, api.JobSubmitError_INVALID_JOB, responseItems[0].ErrorDetails.Code)
+			assert.Equal(t, "namespace", responseItems[0].ErrorDetails.Field)
+		}
+	})
+}
+
+func TestSubmitServer_CreateJobs_AppliesQueueJobPriorityPolicy(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
+		err := s.queueRepository.UpdateQueue(queue.Queue{
+			Name:              "test",
+			PriorityFactor:    1,
+			JobPriorityPolicy: queue.JobPriorityPolicy{DefaultPriority: 5, MinPriority: 2, MaxPriority: 10},
+		})
+		require.NoError(t, err)
+
+		request := createJobRequest(util.NewULID(), 3)
+		request.JobRequestItems[0].Priority = 0
+		request.JobRequestItems[1].Priority = 8
+		request.JobRequestItems[2].Priority = 1
+		_, responseItems, err := s.createJobs(request, "owner")
+		assert.Error(t, err)
+		require.Len(t, responseItems, 1)
+		assert.Contains(t, responseItems[0].Error, "priority of the 2-th job")
+
+		request.JobRequestItems = request.JobRequestItems[:2]
+		jobs, _, err := s.createJobs(request, "owner")
+		require.NoError(t, err)
+		assert.Equal(t, float64(5), jobs[0].Priority)
+		assert.Equal(t, float64(8), jobs[1].Priority)
+	})
+}
+
+func TestSubmitServer_CreateJobs_SetsGangAnnotations(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
+		request := createJobRequest(util.NewULID(), 2)
+		for _, item := range request.JobRequestItems {
+			item.Gang = &api.Gang{Id: "gang", Cardinality: 2, MinCardinality: 1}
+		}
+		jobs, _, err := s.createJobs(request, "owner")
+		require.NoError(t, err)
+		require.Len(t, jobs, 2)
+		for _, job := range jobs {
+			assert.Equal(t, "gang", job.Annotations[configuration.GangIdAnnotation])
+			assert.Equal(t, "2", job.Annotations[configuration.GangCardinalityAnnotation])
+			assert.Equal(t, "1", job.Annotations[configuration.GangMinimumCardinalityAnnotation])
+			assert.Equal(t, s.schedulingConfig.DefaultGangNodeUniformityLabel, job.Annotations[configuration.GangNodeUniformityLabelAnnotation])
+		}
+	})
+}
+
+func TestSubmitServer_CreateJobs_ValidatesGangs(t *testing.T) {
+	tests := map[string]struct {
+		modify        func(items []*api.JobSubmitRequestItem)
+		expectedField string
+	}{
+		"missing member": {
+			modify: func(items []*api.JobSubmitRequestItem) {
+				items[0].Gang.Cardinality = 3
+				items[1].Gang.Cardinality = 3
+			},
+			expectedField: "gang.cardinality",
+		},
+		"inconsistent cardinality": {
+			modify:        func(items []*api.JobSubmitRequestItem) { items[1].Gang.Cardinality = 3 },
+			expectedField: "gang.cardinality",
+		},
+		"inconsistent priority": {
+			modify:        func(items []*api.JobSubmitRequestItem) { items[1].Priority = 1 },
+			expectedField: "priority",
+		},
+		"inconsistent resources": {
+			modify: func(items []*api.JobSubmitRequestItem) {
+				items[1].PodSpecs[0].Containers[0].Resources.Requests["cpu"] = resource.MustParse("2")
+				items[1].PodSpecs[0].Containers[0].Resources.Limits["cpu"] = resource.MustParse("2")
+			},
+			expectedField: "podSpecs[0].containers",
+		},
+		"minimum cardinality exceeds cardinality": {
+			modify: func(items []*api.JobSubmitRequestItem) {
+				for _, item := range items {
+					item.Gang.MinCardinality = 3
+				}
+			},
+			expectedField: "gang",
+		},
+		"gang annotations": {
+			modify: func(items []*api.JobSubmitRequestItem) {
+				items[0].Annotations = map[string]string{configuration.GangIdAnnotation: "gang"}
+			},
+			expectedField: "gang",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
+				request := createJobRequest(util.NewULID(), 2)
+				for _, item := range request.JobRequestItems {
+					item.Gang = &api.Gang{Id: "gang", Cardinality: 2}
+				}
+				tc.modify(request.JobRequestItems)
+
+				_, responseItems, err := s.createJobs(request, "owner")
+				assert.Error(t, err)
+				require.NotEmpty(t, responseItems)
+				assert.Equal(t, api.JobSubmitError_INVALID_GANG, responseItems[0].ErrorDetails.Code)
+				assert.Equal(t, tc.expectedField, responseItems[0].ErrorDetails.Field)
+			})
+		})
+	}
+}
+
+func TestSubmitServer_SubmitJob_WhenServiceAccountDoesNotExist(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
+		s.schedulingConfig.VerifyServiceAccountsExist = true
+		err := s.schedulingInfoRepository.UpdateClusterSchedulingInfo(&api.ClusterSchedulingInfoReport{
+			ClusterId:  "test-cluster",
+			ReportTime: time.Now(),
+			NodeTypes: []*api.NodeType{{
+				AllocatableResources: armadaresource.ComputeResources{"cpu": resource.MustParse("100"), "memory": resource.MustParse("100Gi")},
+			}},
+			ServiceAccounts: map[string]*api.ServiceAccounts{
+				"test": {Names: []string{"default", "pipeline-runner"}},
+			},
+		})
+		require.NoError(t, err)
+
+		request := createJobRequest(util.NewULID(), 1)
+		request.JobRequestItems[0].Namespace = "test"
+		request.JobRequestItems[0].PodSpecs[0].ServiceAccountName = "pipeline-runner"
+		_, err = s.SubmitJobs(context.Background(), request)
+		assert.NoError(t, err)
+
+		request = createJobRequest(util.NewULID(), 1)
+		request.JobRequestItems[0].Namespace = "test"
+		request.JobRequestItems[0].PodSpecs[0].ServiceAccountName = "reader"
+		_, err = s.SubmitJobs(context.Background(), request)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		// Clusters that don't report service accounts may have any service account.
+		err = s.schedulingInfoRepository.UpdateClusterSchedulingInfo(&api.ClusterSchedulingInfoReport{
+			ClusterId:  "test-cluster",
+			ReportTime: time.Now(),
+			NodeTypes: []*api.NodeType{{
+				AllocatableResources: armadaresource.ComputeResources{"cpu": resource.MustParse("100"), "memory": resource.MustParse("100Gi")},
+			}},
+		})
+		require.NoError(t, err)
+		_, err = s.SubmitJobs(context.Background(), request)
+		assert.NoError(t, err)
+	})
+}
+
+func TestSubmitServer_CreateJobs_AppliesAllowedSecretsAndConfigMaps(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
+		s.schedulingConfig.AllowedSecrets = []string{"credentials"}
+		s.schedulingConfig.AllowedConfigMaps = []string{"settings"}
+
+		request := createJobRequest(util.NewULID(), 3)
+		request.JobRequestItems[0].PodSpecs[0].Volumes = []v1.Volume{
+			{Name: "credentials", VolumeSource: v1.VolumeSource{Secret: &v1.SecretVolumeSource{SecretName: "credentials"}}},
+			{Name: "settings", VolumeSource: v1.VolumeSource{ConfigMap: &v1.ConfigMapVolumeSource{LocalObjectReference: v1.LocalObjectReference{Name: "settings"}}}},
+		}
+		request.JobRequestItems[1].PodSpecs[0].Volumes = []v1.Volume{
+			{Name: "token", VolumeSource: v1.VolumeSource{Secret: &v1.SecretVolumeSource{SecretName: "token"}}},
+		}
+		request.JobRequestItems[2].PodSpecs[0].Containers[0].EnvFrom = []v1.EnvFromSource{
+			{ConfigMapRef: &v1.ConfigMapEnvSource{LocalObjectReference: v1.LocalObjectReference{Name: "other"}}},
+		}
+		_, responseItems, err := s.createJobs(request, "owner")
+		assert.Error(t, err)
+		require.Len(t, responseItems, 2)
+		assert.Equal(t, api.JobSubmitError_INVALID_REFERENCE, responseItems[0].ErrorDetails.Code)
+		assert.Equal(t, "podSpecs[0].volumes[0].secret", responseItems[0].ErrorDetails.Field)
+		assert.Equal(t, api.JobSubmitError_INVALID_REFERENCE, responseItems[1].ErrorDetails.Code)
+		assert.Equal(t, "podSpecs[0].containers[0].envFrom[0].configMapRef", responseItems[1].ErrorDetails.Field)
+	})
+}
+
+func TestSubmitServer_SubmitJob_WhenSecretDoesNotExist(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
+		s.schedulingConfig.VerifySecretsAndConfigMapsExist = true
+		err := s.schedulingInfoRepository.UpdateClusterSchedulingInfo(&api.ClusterSchedulingInfoReport{
+			ClusterId:  "test-cluster",
+			ReportTime: time.Now(),
+			NodeTypes: []*api.NodeType{{
+				AllocatableResources: armadaresource.ComputeResources{"cpu": resource.MustParse("100"), "memory": resource.MustParse("100Gi")},
+			}},
+			SecretsAndConfigMaps: map[string]*api.SecretsAndConfigMaps{
+				"test": {SecretNames: []string{"credentials"}, ConfigMapNames: []string{"token"}},
+			},
+		})
+		require.NoError(t, err)
+
+		withSecret := func(name string, optional bool) *api.JobSubmitRequest {
+			request := createJobRequest(util.NewULID(), 1)
+			request.JobRequestItems[0].Namespace = "test"
+			request.JobRequestItems[0].PodSpecs[0].Containers[0].Env = []v1.EnvVar{{
+				Name: "TOKEN",
+				ValueFrom: &v1.EnvVarSource{SecretKeyRef: &v1.SecretKeySelector{
+					LocalObjectReference: v1.LocalObjectReference{Name: name},
+					Key:                  "token",
+					Optional:             &optional,
+				}},
+			}}
+			return request
+		}
+
+		_, err = s.SubmitJobs(context.Background(), withSecret("credentials", false))
+		assert.NoError(t, err)
+
+		// A config map of the same name isn't a secret.
+		_, err = s.SubmitJobs(context.Background(), withSecret("token", false))
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		// Pods start without optional secrets.
+		_, err = s.SubmitJobs(context.Background(), withSecret("token", true))
+		assert.NoError(t, err)
+	})
+}
+
+func TestStricterLimit(t *testing.T) {
+	assert.Equal(t, uint(0), stricterLimit(0, 0))
+	assert.Equal(t, uint(5), stricterLimit(0, 5))
+	assert.Equal(t, uint(5), stricterLimit(5, 0))
+	assert.Equal(t, uint(3), stricterLimit(5, 3))
+	assert.Equal(t, uint(3), stricterLimit(3, 5))
+}
+
+func TestSubmitServer_PreemptJobs(t *testing.T) {
+	withSubmitServerAndRepos(func(s *SubmitServer, jobRepo repository.JobRepository, events *repository.TestEventStore) {
+		jobSetId := util.NewULID()
+		request := createJobRequest(jobSetId, 4)
+		request.JobRequestItems[1].Labels = map[string]string{"team": "ml"}
+		result, err := s.SubmitJobs(context.Background(), request)
+		require.NoError(t, err)
+		jobIds := util.Map(result.JobResponseItems, func(item *api.JobSubmitResponseItem) string { return item.JobId })
+		_, err = jobRepo.TryLeaseJobs("cluster", map[string][]string{"test": jobIds[:3]})
+		require.NoError(t, err)
+		events.ReceivedEvents = nil
+
+		// Only leased jobs are preempted.
+		response, err := s.PreemptJobs(context.Background(), &api.JobPreemptRequest{
+			Queue:   "test",
+			JobIds:  []string{jobIds[0], jobIds[3]},
+			Requeue: true,
+			Reason:  "maintenance",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{jobIds[0]}, response.PreemptedIds)
+		require.Len(t, events.ReceivedEvents, 2)
+		preempted := events.ReceivedEvents[0].GetPreempted()
+		require.NotNil(t, preempted)
+		assert.Equal(t, jobIds[0], preempted.JobId)
+		assert.Equal(t, "cluster", preempted.ClusterId)
+		assert.Equal(t, "maintenance", preempted.Reason)
+		assert.Equal(t, jobIds[0], events.ReceivedEvents[1].GetQueued().GetJobId())
+		queuedIds, err := jobRepo.GetQueueJobIds("test")
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []string{jobIds[0], jobIds[3]}, queuedIds)
+
+		// Jobs preempted without being requeued fail.
+		events.ReceivedEvents = nil
+		response, err = s.PreemptJobs(context.Background(), &api.JobPreemptRequest{
+			Queue:         "test",
+			LabelSelector: map[string]string{"team": "ml"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{jobIds[1]}, response.PreemptedIds)
+		require.Len(t, events.ReceivedEvents, 2)
+		assert.Equal(t, jobIds[1], events.ReceivedEvents[0].GetPreempted().GetJobId())
+		assert.Equal(t, jobIds[1], events.ReceivedEvents[1].GetFailed().GetJobId())
+		jobs, err := jobRepo.GetExistingJobsByIds([]string{jobIds[1]})
+		require.NoError(t, err)
+		assert.Empty(t, jobs)
+
+		response, err = s.PreemptJobs(context.Background(), &api.JobPreemptRequest{Queue: "test", JobSetId: jobSetId})
+		require.NoError(t, err)
+		assert.Equal(t, []string{jobIds[2]}, response.PreemptedIds)
+		leasedIds, err := jobRepo.GetLeasedJobIds("test")
+		require.NoError(t, err)
+		assert.Empty(t, leasedIds)
+	})
+}
+
+func TestSubmitServer_PreemptJobs_InvalidRequest(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
+		_, err := s.PreemptJobs(context.Background(), &api.JobPreemptRequest{JobIds: []string{"job"}})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = s.PreemptJobs(context.Background(), &api.JobPreemptRequest{Queue: "test"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = s.PreemptJobs(context.Background(), &api.JobPreemptRequest{Queue: "test", JobIds: []string{"job"}, JobSetId: "set"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = s.PreemptJobs(context.Background(), &api.JobPreemptRequest{Queue: "missing", JobSetId: "set"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+
+		s.authorizer = &FakeDenyAllActionAuthorizer{}
+		_, err = s.PreemptJobs(context.Background(), &api.JobPreemptRequest{Queue: "test", JobSetId: "set"})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
 	})
 }
 
@@ -618,29 +2387,186 @@ func TestSubmitServer_ReprioritizeJobs(t *testing.T) {
 			assert.Equal(t, 1, len(leased))
 			assert.Equal(t, 1, len(leased["test"]))
 
-			reprioritizeResponse, err := s.ReprioritizeJobs(context.Background(), &api.JobReprioritizeRequest{
-				JobIds:      []string{jobId},
-				NewPriority: 123,
+			reprioritizeResponse, err := s.ReprioritizeJobs(context.Background(), &api.JobReprioritizeRequest{
+				JobIds:      []string{jobId},
+				NewPriority: 123,
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, 1, len(reprioritizeResponse.ReprioritizationResults))
+			errorString, ok := reprioritizeResponse.ReprioritizationResults[jobId]
+			assert.True(t, ok)
+			assert.Empty(t, errorString)
+
+			jobs, err = jobRepo.GetExistingJobsByIds([]string{jobId})
+			assert.NoError(t, err)
+			assert.Equal(t, float64(123), jobs[0].Priority)
+
+			messages := events.ReceivedEvents
+			assert.NoError(t, err)
+			assert.Equal(t, 5, len(messages))
+
+			assert.NotNil(t, messages[0].GetSubmitted())
+			assert.NotNil(t, messages[1].GetQueued())
+			assert.NotNil(t, messages[2].GetReprioritizing())
+			assert.NotNil(t, messages[3].GetUpdated())
+			assert.NotNil(t, messages[4].GetReprioritized())
+		})
+	})
+
+	t.Run("relative adjustment", func(t *testing.T) {
+		withSubmitServerAndRepos(func(s *SubmitServer, jobRepo repository.JobRepository, events *repository.TestEventStore) {
+			jobSetId := util.NewULID()
+			submitResult, err := s.SubmitJobs(context.Background(), createJobRequest(jobSetId, 2))
+			require.NoError(t, err)
+			jobIds := []string{submitResult.JobResponseItems[0].JobId, submitResult.JobResponseItems[1].JobId}
+
+			_, err = s.ReprioritizeJobs(context.Background(), &api.JobReprioritizeRequest{
+				JobIds:      jobIds[:1],
+				NewPriority: 10,
+			})
+			require.NoError(t, err)
+
+			// Each job is adjusted relative to its own priority.
+			reprioritizeResponse, err := s.ReprioritizeJobs(context.Background(), &api.JobReprioritizeRequest{
+				JobIds:             jobIds,
+				PriorityMultiplier: 2,
+				PriorityDelta:      5,
+			})
+			require.NoError(t, err)
+			assert.Equal(t, map[string]string{jobIds[0]: "", jobIds[1]: ""}, reprioritizeResponse.ReprioritizationResults)
+
+			jobs, err := jobRepo.GetExistingJobsByIds(jobIds)
+			require.NoError(t, err)
+			assert.Equal(t, float64(25), jobs[0].Priority)
+			assert.Equal(t, float64(5), jobs[1].Priority)
+
+			reprioritizedPriorities := make(map[string]float64)
+			for _, message := range events.ReceivedEvents {
+				if e := message.GetReprioritized(); e != nil {
+					reprioritizedPriorities[e.JobId] = e.NewPriority
+				}
+			}
+			assert.Equal(t, map[string]float64{jobIds[0]: 25, jobIds[1]: 5}, reprioritizedPriorities)
+
+			// Priorities don't become negative.
+			_, err = s.ReprioritizeJobs(context.Background(), &api.JobReprioritizeRequest{
+				JobIds:        jobIds,
+				PriorityDelta: -10,
+			})
+			require.NoError(t, err)
+			jobs, err = jobRepo.GetExistingJobsByIds(jobIds)
+			require.NoError(t, err)
+			assert.Equal(t, float64(15), jobs[0].Priority)
+			assert.Equal(t, float64(0), jobs[1].Priority)
+		})
+	})
+
+	t.Run("lost optimistic lock", func(t *testing.T) {
+		withSubmitServerAndRepos(func(s *SubmitServer, jobRepo repository.JobRepository, events *repository.TestEventStore) {
+			s.jobRepository = &retryingJobRepository{JobRepository: jobRepo}
+			submitResult, err := s.SubmitJobs(context.Background(), createJobRequest(util.NewULID(), 1))
+			require.NoError(t, err)
+			jobId := submitResult.JobResponseItems[0].JobId
+
+			_, err = s.ReprioritizeJobs(context.Background(), &api.JobReprioritizeRequest{
+				JobIds:        []string{jobId},
+				PriorityDelta: 1,
+			})
+			require.NoError(t, err)
+
+			// Only the attempt that was written is applied and reported.
+			jobs, err := jobRepo.GetExistingJobsByIds([]string{jobId})
+			require.NoError(t, err)
+			assert.Equal(t, float64(1), jobs[0].Priority)
+			var reprioritizedEvents []*api.JobReprioritizedEvent
+			for _, message := range events.ReceivedEvents {
+				if e := message.GetReprioritized(); e != nil {
+					reprioritizedEvents = append(reprioritizedEvents, e)
+				}
+			}
+			require.Len(t, reprioritizedEvents, 1)
+			assert.Equal(t, float64(1), reprioritizedEvents[0].NewPriority)
+		})
+	})
+
+	t.Run("concurrent update", func(t *testing.T) {
+		withSubmitServerAndRepos(func(s *SubmitServer, jobRepo repository.JobRepository, events *repository.TestEventStore) {
+			s.jobRepository = &concurrentlyReprioritizedJobRepository{JobRepository: jobRepo, priority: 10}
+			submitResult, err := s.SubmitJobs(context.Background(), createJobRequest(util.NewULID(), 1))
+			require.NoError(t, err)
+			jobId := submitResult.JobResponseItems[0].JobId
+
+			_, err = s.ReprioritizeJobs(context.Background(), &api.JobReprioritizeRequest{
+				JobIds:        []string{jobId},
+				PriorityDelta: 1,
+			})
+			require.NoError(t, err)
+
+			// The adjustment is applied to the priority set concurrently, which both events report.
+			jobs, err := jobRepo.GetExistingJobsByIds([]string{jobId})
+			require.NoError(t, err)
+			assert.Equal(t, float64(11), jobs[0].Priority)
+			var reprioritizingPriorities, reprioritizedPriorities []float64
+			for _, message := range events.ReceivedEvents {
+				if e := message.GetReprioritizing(); e != nil {
+					reprioritizingPriorities = append(reprioritizingPriorities, e.NewPriority)
+				}
+				if e := message.GetReprioritized(); e != nil {
+					reprioritizedPriorities = append(reprioritizedPriorities, e.NewPriority)
+				}
+			}
+			assert.Equal(t, []float64{11}, reprioritizingPriorities)
+			assert.Equal(t, []float64{11}, reprioritizedPriorities)
+		})
+	})
+
+	t.Run("invalid relative adjustment", func(t *testing.T) {
+		withSubmitServerAndRepos(func(s *SubmitServer, jobRepo repository.JobRepository, events *repository.TestEventStore) {
+			_, err := s.ReprioritizeJobs(context.Background(), &api.JobReprioritizeRequest{
+				JobIds:        []string{util.NewULID()},
+				NewPriority:   1,
+				PriorityDelta: 1,
+			})
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+			_, err = s.ReprioritizeJobs(context.Background(), &api.JobReprioritizeRequest{
+				JobIds:             []string{util.NewULID()},
+				PriorityMultiplier: -1,
 			})
-			assert.NoError(t, err)
-			assert.Equal(t, 1, len(reprioritizeResponse.ReprioritizationResults))
-			errorString, ok := reprioritizeResponse.ReprioritizationResults[jobId]
-			assert.True(t, ok)
-			assert.Empty(t, errorString)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	})
 
-			jobs, err = jobRepo.GetExistingJobsByIds([]string{jobId})
-			assert.NoError(t, err)
-			assert.Equal(t, float64(123), jobs[0].Priority)
+	t.Run("queue priority bounds", func(t *testing.T) {
+		withSubmitServerAndRepos(func(s *SubmitServer, jobRepo repository.JobRepository, events *repository.TestEventStore) {
+			err := s.queueRepository.UpdateQueue(queue.Queue{
+				Name:              "test",
+				PriorityFactor:    1,
+				JobPriorityPolicy: queue.JobPriorityPolicy{DefaultPriority: 5, MinPriority: 2, MaxPriority: 10},
+			})
+			require.NoError(t, err)
+			submitResult, err := s.SubmitJobs(context.Background(), createJobRequest(util.NewULID(), 2))
+			require.NoError(t, err)
+			jobIds := []string{submitResult.JobResponseItems[0].JobId, submitResult.JobResponseItems[1].JobId}
 
-			messages := events.ReceivedEvents
-			assert.NoError(t, err)
-			assert.Equal(t, 5, len(messages))
+			// Absolute priorities outside the bounds are rejected.
+			reprioritizeResponse, err := s.ReprioritizeJobs(context.Background(), &api.JobReprioritizeRequest{
+				JobIds:      jobIds[:1],
+				NewPriority: 1,
+			})
+			require.NoError(t, err)
+			assert.Contains(t, reprioritizeResponse.ReprioritizationResults[jobIds[0]], "outside the range [2, 10]")
 
-			assert.NotNil(t, messages[0].GetSubmitted())
-			assert.NotNil(t, messages[1].GetQueued())
-			assert.NotNil(t, messages[2].GetReprioritizing())
-			assert.NotNil(t, messages[3].GetUpdated())
-			assert.NotNil(t, messages[4].GetReprioritized())
+			// Relative adjustments are clamped to the bounds.
+			_, err = s.ReprioritizeJobs(context.Background(), &api.JobReprioritizeRequest{
+				JobIds:        jobIds,
+				PriorityDelta: 100,
+			})
+			require.NoError(t, err)
+			jobs, err := jobRepo.GetExistingJobsByIds(jobIds)
+			require.NoError(t, err)
+			assert.Equal(t, float64(10), jobs[0].Priority)
+			assert.Equal(t, float64(10), jobs[1].Priority)
 		})
 	})
 
@@ -712,7 +2638,7 @@ func TestSubmitServer_ReprioritizeJobs(t *testing.T) {
 
 			jobs, err = jobRepo.PeekQueue("test", 100)
 			assert.NoError(t, err)
-			assert.Equal(t, selectedJob.Id, jobs[2].Id)
+			assert.Equal(t, selectedJob.Id, jobs[2].GetId())
 			assert.Equal(t, float64(1000), jobs[2].Priority)
 		})
 	})
@@ -724,7 +2650,7 @@ func TestFillContainerRequestAndLimits(t *testing.T) {
 			for index := range containers {
 				containers[index].Resources.Limits = nil
 			}
-			fillContainerRequestsAndLimits(containers)
+			fillContainerRequestsAndLimits(containers, configuration.SchedulingConfig{})
 
 			for _, container := range containers {
 				resources := container.Resources
@@ -739,7 +2665,7 @@ func TestFillContainerRequestAndLimits(t *testing.T) {
 			for index := range containers {
 				containers[index].Resources.Requests = nil
 			}
-			fillContainerRequestsAndLimits(containers)
+			fillContainerRequestsAndLimits(containers, configuration.SchedulingConfig{})
 
 			for _, container := range containers {
 				resources := container.Resources
@@ -761,6 +2687,63 @@ func TestFillContainerRequestAndLimits(t *testing.T) {
 	}
 }
 
+func TestSubmitServer_GetQueueInfo_JobCounts(t *testing.T) {
+	withSubmitServerAndRepos(func(s *SubmitServer, jobRepo repository.JobRepository, events *repository.TestEventStore) {
+		// Each job requests 1 cpu and 512Mi of memory.
+		jobSetId1 := util.NewULID()
+		jobSetId2 := util.NewULID()
+		result1, err := s.SubmitJobs(context.Background(), createJobRequest(jobSetId1, 3))
+		require.NoError(t, err)
+		result2, err := s.SubmitJobs(context.Background(), createJobRequest(jobSetId2, 1))
+		require.NoError(t, err)
+		leasedJobIds := []string{result1.JobResponseItems[0].JobId, result1.JobResponseItems[1].JobId, result2.JobResponseItems[0].JobId}
+		_, err = jobRepo.TryLeaseJobs("cluster", map[string][]string{"test": leasedJobIds})
+		require.NoError(t, err)
+		_, err = jobRepo.UpdateStartTime([]*repository.JobStartInfo{{JobId: leasedJobIds[0], ClusterId: "cluster", StartTime: time.Now()}})
+		require.NoError(t, err)
+
+		info, err := s.GetQueueInfo(context.Background(), &api.QueueInfoRequest{Name: "test"})
+		require.NoError(t, err)
+		require.Len(t, info.ActiveJobSets, 2)
+		jobSetInfoByName := map[string]*api.JobSetInfo{}
+		for _, jobSetInfo := range info.ActiveJobSets {
+			jobSetInfoByName[jobSetInfo.Name] = jobSetInfo
+		}
+		assert.Equal(t, int32(1), jobSetInfoByName[jobSetId1].QueuedJobs)
+		assert.Equal(t, int32(1), jobSetInfoByName[jobSetId1].PendingJobs)
+		assert.Equal(t, int32(1), jobSetInfoByName[jobSetId1].RunningJobs)
+		assert.Equal(t, int32(0), jobSetInfoByName[jobSetId2].QueuedJobs)
+		assert.Equal(t, int32(1), jobSetInfoByName[jobSetId2].PendingJobs)
+
+		assert.Equal(t, int32(1), info.QueuedJobs)
+		assert.Equal(t, int32(3), info.LeasedJobs)
+		assert.Equal(t, int32(2), info.PendingJobs)
+		assert.Equal(t, int32(1), info.RunningJobs)
+		assert.Equal(t, "cpu: 3, memory: 1536Mi", armadaresource.ComputeResources(info.LeasedResources).String())
+	})
+}
+
+func TestSubmitServer_QueuesWithSuspendedJobsAreActive(t *testing.T) {
+	withSubmitServerAndRepos(func(s *SubmitServer, jobRepo repository.JobRepository, events *repository.TestEventStore) {
+		jobSetId := util.NewULID()
+		result, err := s.SubmitJobs(context.Background(), createJobRequest(jobSetId, 1))
+		require.NoError(t, err)
+		_, err = jobRepo.SuspendJobs("test", []string{result.JobResponseItems[0].JobId})
+		require.NoError(t, err)
+
+		info, err := s.GetQueueInfo(context.Background(), &api.QueueInfoRequest{Name: "test"})
+		require.NoError(t, err)
+		require.Len(t, info.ActiveJobSets, 1)
+		assert.Equal(t, jobSetId, info.ActiveJobSets[0].Name)
+		assert.Equal(t, int32(0), info.ActiveJobSets[0].QueuedJobs)
+		assert.Equal(t, int32(1), info.ActiveJobSets[0].SuspendedJobs)
+		assert.Equal(t, int32(1), info.SuspendedJobs)
+
+		_, err = s.DeleteQueue(context.Background(), &api.QueueDeleteRequest{Name: "test"})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+}
+
 func TestSubmitServer_GetQueueInfo_Permissions(t *testing.T) {
 	const watchEventsGroup = "watch-events-group"
 	const watchAllEventsGroup = "watch-all-events-group"
@@ -837,6 +2820,26 @@ func TestSubmitServer_GetQueueInfo_Permissions(t *testing.T) {
 			assert.Equal(t, codes.OK, e.Code())
 		})
 	})
+
+	t.Run("permission inherited from parent queue", func(t *testing.T) {
+		withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
+			s.authorizer = NewAuthorizer(authorization.NewPrincipalPermissionChecker(perms, emptyPerms, emptyPerms))
+			err := s.queueRepository.CreateQueue(q)
+			assert.NoError(t, err)
+			err = s.queueRepository.CreateQueue(queue.Queue{Name: "child-queue", Parent: q.Name})
+			assert.NoError(t, err)
+
+			principal := authorization.NewStaticPrincipal("alice", []string{watchQueueGroup})
+			ctx := authorization.WithPrincipal(context.Background(), principal)
+
+			_, err = s.GetQueueInfo(ctx, &api.QueueInfoRequest{
+				Name: "child-queue",
+			})
+			e, ok := status.FromError(err)
+			assert.True(t, ok)
+			assert.Equal(t, codes.OK, e.Code())
+		})
+	})
 }
 
 func TestSubmitServer_CreateQueue_Permissions(t *testing.T) {
@@ -1273,6 +3276,38 @@ func TestSubmitServer_CancelJobs_Permissions(t *testing.T) {
 			assert.Equal(t, codes.OK, e.Code())
 		})
 	})
+	t.Run("own jobs", func(t *testing.T) {
+		withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
+			s.authorizer = NewAuthorizer(authorization.NewPrincipalPermissionChecker(perms, emptyPerms, emptyPerms))
+			ownersQueue := q
+			ownersQueue.OwnersCanManageOwnJobs = true
+			err := s.queueRepository.CreateQueue(ownersQueue)
+			assert.NoError(t, err)
+			aliceJob := *job
+			aliceJob.Owner = "alice"
+			bobJob := *job
+			bobJob.Id = util.NewULID()
+			bobJob.JobSetId = "job-set-2"
+			bobJob.Owner = "bob"
+			_, err = s.jobRepository.AddJobs([]*api.Job{&aliceJob, &bobJob})
+			assert.NoError(t, err)
+
+			principal := authorization.NewStaticPrincipal("alice", []string{})
+			ctx := authorization.WithPrincipal(context.Background(), principal)
+
+			_, err = s.CancelJobs(ctx, &api.JobCancelRequest{
+				Queue:    "test-queue",
+				JobSetId: "job-set-1",
+			})
+			assert.Equal(t, codes.OK, status.Code(err))
+
+			_, err = s.CancelJobs(ctx, &api.JobCancelRequest{
+				Queue:    "test-queue",
+				JobSetId: "job-set-2",
+			})
+			assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		})
+	})
 }
 
 func TestSubmitServer_CancelJobSet_Permissions(t *testing.T) {
@@ -1365,6 +3400,294 @@ func TestSubmitServer_CancelJobSet_Permissions(t *testing.T) {
 	})
 }
 
+func TestSubmitServer_CancelJobSet_LabelSelector(t *testing.T) {
+	withSubmitServerAndRepos(func(s *SubmitServer, jobRepo repository.JobRepository, events *repository.TestEventStore) {
+		jobSetId := util.NewULID()
+		request := createJobRequest(jobSetId, 3)
+		request.JobRequestItems[0].Labels = map[string]string{"sweep": "a"}
+		request.JobRequestItems[1].Labels = map[string]string{"sweep": "a", "size": "large"}
+		request.JobRequestItems[2].Labels = map[string]string{"sweep": "b"}
+		response, err := s.SubmitJobs(context.Background(), request)
+		require.NoError(t, err)
+
+		_, err = s.CancelJobSet(context.Background(), &api.JobSetCancelRequest{
+			Queue:         "test",
+			JobSetId:      jobSetId,
+			LabelSelector: map[string]string{"sweep": "a"},
+		})
+		require.NoError(t, err)
+
+		activeIds, err := jobRepo.GetActiveJobIds("test", jobSetId)
+		require.NoError(t, err)
+		assert.Equal(t, []string{response.JobResponseItems[2].JobId}, activeIds)
+	})
+}
+
+func TestSubmitServer_CancelJobs_ByJobSet_ProcessesBatchesConcurrently(t *testing.T) {
+	withSubmitServerAndRepos(func(s *SubmitServer, jobRepo repository.JobRepository, events *repository.TestEventStore) {
+		s.cancelJobsBatchSize = 2
+		s.cancelJobsParallelism = 3
+
+		jobSetId := util.NewULID()
+		response, err := s.SubmitJobs(context.Background(), createJobRequest(jobSetId, 9))
+		require.NoError(t, err)
+		jobIds := make([]string, 0, len(response.JobResponseItems))
+		for _, item := range response.JobResponseItems {
+			jobIds = append(jobIds, item.JobId)
+		}
+		expectedIds, err := jobRepo.GetJobSetJobIds("test", jobSetId, nil)
+		require.NoError(t, err)
+
+		result, err := s.CancelJobs(context.Background(), &api.JobCancelRequest{Queue: "test", JobSetId: jobSetId})
+		require.NoError(t, err)
+		assert.ElementsMatch(t, jobIds, result.CancelledIds)
+		// Results are aggregated in batch order.
+		require.Len(t, result.CancelledIds, len(expectedIds))
+		for i, batch := range util.Batch(expectedIds, 2) {
+			assert.ElementsMatch(t, batch, result.CancelledIds[2*i:2*i+len(batch)])
+		}
+
+		activeIds, err := jobRepo.GetActiveJobIds("test", jobSetId)
+		require.NoError(t, err)
+		assert.Empty(t, activeIds)
+	})
+}
+
+func TestSubmitServer_CancelJobs_ByJobSet_StopsWhenContextCancelled(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
+		jobSetId := util.NewULID()
+		_, err := s.SubmitJobs(context.Background(), createJobRequest(jobSetId, 3))
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		result, err := s.CancelJobs(ctx, &api.JobCancelRequest{Queue: "test", JobSetId: jobSetId})
+		assert.Equal(t, codes.Canceled, status.Code(err))
+		assert.Empty(t, result.CancelledIds)
+	})
+}
+
+func TestSubmitServer_PauseAndResumeJobSet(t *testing.T) {
+	withSubmitServerAndRepos(func(s *SubmitServer, jobRepo repository.JobRepository, events *repository.TestEventStore) {
+		jobSetId := util.NewULID()
+		response, err := s.SubmitJobs(context.Background(), createJobRequest(jobSetId, 2))
+		require.NoError(t, err)
+		jobIds := []string{response.JobResponseItems[0].JobId, response.JobResponseItems[1].JobId}
+		_, err = jobRepo.TryLeaseJobs("cluster", map[string][]string{"test": {jobIds[0]}})
+		require.NoError(t, err)
+
+		_, err = s.PauseJobSet(context.Background(), &api.JobSetPauseRequest{Queue: "test", JobSetId: jobSetId})
+		require.NoError(t, err)
+		queuedIds, err := jobRepo.GetQueueJobIds("test")
+		require.NoError(t, err)
+		assert.Empty(t, queuedIds)
+		suspendedIds, err := jobRepo.GetJobSetJobIds("test", jobSetId, &repository.JobSetFilter{IncludeSuspended: true})
+		require.NoError(t, err)
+		assert.Equal(t, []string{jobIds[1]}, suspendedIds)
+
+		_, err = s.ResumeJobSet(context.Background(), &api.JobSetResumeRequest{Queue: "test", JobSetId: jobSetId})
+		require.NoError(t, err)
+		queuedIds, err = jobRepo.GetQueueJobIds("test")
+		require.NoError(t, err)
+		assert.Equal(t, []string{jobIds[1]}, queuedIds)
+
+		var suspendedEvents, resumedEvents []string
+		for _, event := range events.ReceivedEvents {
+			switch e := event.Events.(type) {
+			case *api.EventMessage_Suspended:
+				suspendedEvents = append(suspendedEvents, e.Suspended.JobId)
+			case *api.EventMessage_Resumed:
+				resumedEvents = append(resumedEvents, e.Resumed.JobId)
+			}
+		}
+		assert.Equal(t, []string{jobIds[1]}, suspendedEvents)
+		assert.Equal(t, []string{jobIds[1]}, resumedEvents)
+
+		_, err = s.PauseJobSet(context.Background(), &api.JobSetPauseRequest{Queue: "test"})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestSubmitServer_GetJobWaitReasons(t *testing.T) {
+	withSubmitServerAndRepos(func(s *SubmitServer, jobRepo repository.JobRepository, events *repository.TestEventStore) {
+		_, err := s.GetJobWaitReasons(context.Background(), &api.JobWaitReasonsRequest{JobId: "doesNotExist"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+
+		request := createJobRequest(util.NewULID(), 3)
+		request.MaxConcurrentJobs = 1
+		response, err := s.SubmitJobs(context.Background(), request)
+		require.NoError(t, err)
+		jobIds := []string{response.JobResponseItems[0].JobId, response.JobResponseItems[1].JobId, response.JobResponseItems[2].JobId}
+		_, err = jobRepo.TryLeaseJobs("cluster", map[string][]string{"test": {jobIds[0]}})
+		require.NoError(t, err)
+
+		// Leased jobs aren't waiting.
+		reasons, err := s.GetJobWaitReasons(context.Background(), &api.JobWaitReasonsRequest{JobId: jobIds[0]})
+		require.NoError(t, err)
+		assert.Equal(t, jobIds[0], reasons.JobId)
+		assert.Empty(t, reasons.Reasons)
+
+		reasons, err = s.GetJobWaitReasons(context.Background(), &api.JobWaitReasonsRequest{JobId: jobIds[1]})
+		require.NoError(t, err)
+		require.Len(t, reasons.Reasons, 1)
+		assert.Equal(t, api.JobWaitReasonType_QuotaExhausted, reasons.Reasons[0].Type)
+
+		_, err = s.PauseJobSet(context.Background(), &api.JobSetPauseRequest{Queue: "test", JobSetId: request.JobSetId})
+		require.NoError(t, err)
+		reasons, err = s.GetJobWaitReasons(context.Background(), &api.JobWaitReasonsRequest{JobId: jobIds[1]})
+		require.NoError(t, err)
+		require.Len(t, reasons.Reasons, 1)
+		assert.Equal(t, api.JobWaitReasonType_QueuePaused, reasons.Reasons[0].Type)
+
+		request = createJobRequest(util.NewULID(), 1)
+		request.JobRequestItems[0].Annotations = map[string]string{
+			configuration.BarrierIdAnnotation:          "fan-in",
+			configuration.BarrierCardinalityAnnotation: "2",
+		}
+		response, err = s.SubmitJobs(context.Background(), request)
+		require.NoError(t, err)
+		reasons, err = s.GetJobWaitReasons(context.Background(), &api.JobWaitReasonsRequest{JobId: response.JobResponseItems[0].JobId})
+		require.NoError(t, err)
+		require.Len(t, reasons.Reasons, 1)
+		assert.Equal(t, api.JobWaitReasonType_GangIncomplete, reasons.Reasons[0].Type)
+		assert.Equal(t, "1 of 2 members of barrier fan-in have been submitted", reasons.Reasons[0].Message)
+	})
+}
+
+func TestPulsarSubmitServer_GetJobWaitReasons(t *testing.T) {
+	withSubmitServerAndRepos(func(s *SubmitServer, jobRepo repository.JobRepository, events *repository.TestEventStore) {
+		srv := &PulsarSubmitServer{SubmitServer: s}
+		response, err := s.SubmitJobs(context.Background(), createJobRequest(util.NewULID(), 1))
+		require.NoError(t, err)
+		jobId := response.JobResponseItems[0].JobId
+
+		// Rounds are read from the repository shared by all replicas, rather than from the round of this replica.
+		err = s.SchedulingRoundRepository.StoreSchedulingRound(&repository.SchedulingRound{
+			ExecutorId:           "cluster",
+			Started:              time.Now(),
+			Queues:               []string{"test"},
+			UnschedulableReasons: map[string]string{jobId: "job does not fit on any node"},
+		})
+		require.NoError(t, err)
+
+		reasons, err := srv.GetJobWaitReasons(context.Background(), &api.JobWaitReasonsRequest{JobId: jobId})
+		require.NoError(t, err)
+		assert.Equal(
+			t,
+			[]*api.JobWaitReason{{
+				Type:       api.JobWaitReasonType_NoMatchingCluster,
+				Message:    "job does not fit on any node",
+				ExecutorId: "cluster",
+			}},
+			reasons.Reasons,
+		)
+	})
+}
+
+func TestJobWaitReasonsFromSchedulingRounds(t *testing.T) {
+	submitted := time.Now()
+	job := &api.Job{Id: "job", Queue: "A", Created: submitted}
+	rounds := []*repository.SchedulingRound{
+		// Round that considered the job but couldn't schedule it.
+		{
+			ExecutorId:           "bar",
+			Started:              submitted.Add(time.Minute),
+			Queues:               []string{"A"},
+			UnschedulableReasons: map[string]string{"job": "job does not fit on any node"},
+		},
+		// Round that considered the queue but ended before getting to the job.
+		{
+			ExecutorId:        "baz",
+			Started:           submitted.Add(time.Minute),
+			TerminationReason: "maximum number of jobs scheduled",
+			Queues:            []string{"A"},
+		},
+		// Round that didn't consider the queue of the job; ignored.
+		{
+			ExecutorId: "foo",
+			Started:    submitted.Add(time.Minute),
+			Queues:     []string{"B"},
+		},
+		// Round that started before the job was submitted; ignored.
+		{
+			ExecutorId:           "old",
+			Started:              submitted.Add(-time.Minute),
+			Queues:               []string{"A"},
+			UnschedulableReasons: map[string]string{"job": "job does not fit on any node"},
+		},
+	}
+	assert.Equal(
+		t,
+		[]*api.JobWaitReason{
+			{
+				Type:       api.JobWaitReasonType_NoMatchingCluster,
+				Message:    "job does not fit on any node",
+				ExecutorId: "bar",
+			},
+			{
+				Type:       api.JobWaitReasonType_FairShareDeficit,
+				Message:    "the scheduling round ended before the job was considered: maximum number of jobs scheduled",
+				ExecutorId: "baz",
+			},
+		},
+		jobWaitReasonsFromSchedulingRounds(rounds, job),
+	)
+
+	// Rounds that scheduled the job are ignored.
+	rounds[1].ScheduledJobIds = []string{"job"}
+	reasons := jobWaitReasonsFromSchedulingRounds(rounds, job)
+	require.Len(t, reasons, 1)
+	assert.Equal(t, "bar", reasons[0].ExecutorId)
+}
+
+func TestCreateJobSetFilter(t *testing.T) {
+	assert.Nil(t, createJobSetFilter(nil, nil))
+	assert.Equal(
+		t,
+		&repository.JobSetFilter{IncludeQueued: true, IncludeLeased: true, IncludeSuspended: true, Labels: map[string]string{"a": "b"}},
+		createJobSetFilter(nil, map[string]string{"a": "b"}),
+	)
+	assert.Equal(
+		t,
+		&repository.JobSetFilter{IncludeQueued: true, Labels: map[string]string{"a": "b"}},
+		createJobSetFilter(&api.JobSetFilter{States: []api.JobState{api.JobState_QUEUED}}, map[string]string{"a": "b"}),
+	)
+}
+
+// retryingJobRepository calls the mutator passed to UpdateJobs an extra time before updating jobs,
+// as happens if the optimistic lock is lost.
+type retryingJobRepository struct {
+	repository.JobRepository
+}
+
+func (r *retryingJobRepository) UpdateJobs(ids []string, mutator func([]*api.Job)) ([]repository.UpdateJobResult, error) {
+	jobs, err := r.GetExistingJobsByIds(ids)
+	if err != nil {
+		return nil, err
+	}
+	mutator(jobs)
+	return r.JobRepository.UpdateJobs(ids, mutator)
+}
+
+// concurrentlyReprioritizedJobRepository sets the priority of jobs to priority before each update,
+// as if they were reprioritized concurrently.
+type concurrentlyReprioritizedJobRepository struct {
+	repository.JobRepository
+	priority float64
+}
+
+func (r *concurrentlyReprioritizedJobRepository) UpdateJobs(ids []string, mutator func([]*api.Job)) ([]repository.UpdateJobResult, error) {
+	_, err := r.JobRepository.UpdateJobs(ids, func(jobs []*api.Job) {
+		for _, job := range jobs {
+			job.Priority = r.priority
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	return r.JobRepository.UpdateJobs(ids, mutator)
+}
+
 func TestSubmitServer_ReprioritizeJobs_Permissions(t *testing.T) {
 	emptyPerms := make(map[permission.Permission][]string)
 	perms := map[permission.Permission][]string{
@@ -1453,6 +3776,38 @@ func TestSubmitServer_ReprioritizeJobs_Permissions(t *testing.T) {
 			assert.Equal(t, codes.OK, e.Code())
 		})
 	})
+	t.Run("own jobs", func(t *testing.T) {
+		withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
+			s.authorizer = NewAuthorizer(authorization.NewPrincipalPermissionChecker(perms, emptyPerms, emptyPerms))
+			ownersQueue := q
+			ownersQueue.OwnersCanManageOwnJobs = true
+			err := s.queueRepository.CreateQueue(ownersQueue)
+			assert.NoError(t, err)
+			aliceJob := *job
+			aliceJob.Owner = "alice"
+			bobJob := *job
+			bobJob.Id = util.NewULID()
+			bobJob.JobSetId = "job-set-2"
+			bobJob.Owner = "bob"
+			_, err = s.jobRepository.AddJobs([]*api.Job{&aliceJob, &bobJob})
+			assert.NoError(t, err)
+
+			principal := authorization.NewStaticPrincipal("alice", []string{})
+			ctx := authorization.WithPrincipal(context.Background(), principal)
+
+			_, err = s.ReprioritizeJobs(ctx, &api.JobReprioritizeRequest{
+				Queue:    "test-queue",
+				JobSetId: "job-set-1",
+			})
+			assert.Equal(t, codes.OK, status.Code(err))
+
+			_, err = s.ReprioritizeJobs(ctx, &api.JobReprioritizeRequest{
+				Queue:    "test-queue",
+				JobSetId: "job-set-2",
+			})
+			assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		})
+	})
 }
 
 func createJobRequest(jobSetId string, numberOfJobs int) *api.JobSubmitRequest {
@@ -1508,9 +3863,14 @@ func withSubmitServerAndRepos(action func(s *SubmitServer, jobRepo repository.Jo
 	jobRepo := repository.NewRedisJobRepository(client)
 	queueRepo := repository.NewRedisQueueRepository(client)
 	schedulingInfoRepository := repository.NewRedisSchedulingInfoRepository(client)
+	barrierRepository := repository.NewRedisBarrierRepository(client)
 	eventStore := &repository.TestEventStore{}
 
-	queueConfig := configuration.QueueManagementConfig{DefaultPriorityFactor: 1}
+	queueConfig := configuration.QueueManagementConfig{
+		DefaultPriorityFactor:       1,
+		CascadingDeletePollInterval: 10 * time.Millisecond,
+		CascadingDeleteTimeout:      time.Minute,
+	}
 	schedulingConfig := configuration.SchedulingConfig{
 		DefaultJobTolerations: []v1.Toleration{
 			{
@@ -1539,9 +3899,22 @@ func withSubmitServerAndRepos(action func(s *SubmitServer, jobRepo repository.Jo
 		queueRepo,
 		eventStore,
 		schedulingInfoRepository,
+		barrierRepository,
+		repository.NewRedisOperationRepository(client),
+		repository.NewRedisSubmitFailureReportRepository(client),
+		repository.NewRedisOwnershipGroupsRepository(client),
 		200,
+		4,
 		&queueConfig,
-		&schedulingConfig)
+		&schedulingConfig,
+		&configuration.SubmitFailureConfig{MaxResponseItems: 5, ReportRetention: time.Hour},
+		&configuration.CompressionConfig{
+			Codec:           compress.CodecZlib,
+			MinCompressSize: 512,
+			CompressorPool:  configuration.ObjectPoolConfig{MaxTotal: 10, MaxIdle: 10, BlockWhenExhausted: true},
+		},
+	)
+	server.SchedulingRoundRepository = repository.NewRedisSchedulingRoundRepository(client)
 
 	_, _ = client.FlushDB().Result()
 
@@ -1622,9 +3995,7 @@ func TestSubmitServer_CreateJobs_WithJobIdReplacement(t *testing.T) {
 					PriorityClassName:             "high",
 				},
 			},
-			Owner:                              "test",
-			QueueOwnershipUserGroups:           nil,
-			CompressedQueueOwnershipUserGroups: []byte{},
+			Owner: "test",
 		},
 	}
 
@@ -1668,15 +4039,118 @@ func TestSubmitServer_CreateJobs_WithJobIdReplacement(t *testing.T) {
 			},
 		},
 	}
-	ownershipGroups := make([]string, 0)
 	withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
-		output, responseItems, err := s.createJobsObjects(request, "test", ownershipGroups, mockNow, mockNewULID)
+		output, responseItems, err := s.createJobsObjects(request, "test", mockNow, mockNewULID)
 		assert.NoError(t, err)
 		assert.Equal(t, expectedResponseItems, responseItems)
 		assert.Equal(t, expected, output)
 	})
 }
 
+func TestSubmitServer_SubmitJobs_StoresFailureReportIfTooManyJobsAreInvalid(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
+		s.submitFailureConfig.MaxResponseItems = 2
+		request := createJobRequest(util.NewULID(), 3)
+		for _, item := range request.JobRequestItems {
+			item.PodSpecs[0].Containers[0].Resources.Limits = v1.ResourceList{"cpu": resource.MustParse("100")}
+		}
+
+		_, err := s.SubmitJobs(context.Background(), request)
+		require.Error(t, err)
+		var details *api.JobSubmitResponse
+		for _, detail := range gogostatus.Convert(err).Details() {
+			details, _ = detail.(*api.JobSubmitResponse)
+		}
+		require.NotNil(t, details)
+		assert.Len(t, details.JobResponseItems, 2)
+		require.NotEmpty(t, details.FailureReportId)
+
+		report, err := s.GetSubmitFailureReport(context.Background(), &api.SubmitFailureReportRequest{Id: details.FailureReportId})
+		require.NoError(t, err)
+		assert.Len(t, report.JobResponseItems, 3)
+		assert.Equal(t, details.JobResponseItems, report.JobResponseItems[:2])
+
+		otherUserCtx := authorization.WithPrincipal(context.Background(), authorization.NewStaticPrincipal("other", nil))
+		_, err = s.GetSubmitFailureReport(otherUserCtx, &api.SubmitFailureReportRequest{Id: details.FailureReportId})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		_, err = s.GetSubmitFailureReport(context.Background(), &api.SubmitFailureReportRequest{Id: util.NewULID()})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
+
+func TestSubmitServer_SubmitJobs_StoresNoFailureReportIfAllErrorsAreIncluded(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
+		request := createJobRequest(util.NewULID(), 2)
+		for _, item := range request.JobRequestItems {
+			item.PodSpecs[0].Containers[0].Resources.Limits = v1.ResourceList{"cpu": resource.MustParse("100")}
+		}
+
+		_, err := s.SubmitJobs(context.Background(), request)
+		require.Error(t, err)
+		var details *api.JobSubmitResponse
+		for _, detail := range gogostatus.Convert(err).Details() {
+			details, _ = detail.(*api.JobSubmitResponse)
+		}
+		require.NotNil(t, details)
+		assert.Len(t, details.JobResponseItems, 2)
+		assert.Empty(t, details.FailureReportId)
+	})
+}
+
+func TestSubmitServer_SubmitJobs_RejectsJobsWithInvalidImages(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
+		resolver, err := imageresolver.New(configuration.ImageResolverConfig{AllowedRegistries: []string{"registry.example.com"}})
+		require.NoError(t, err)
+		s.ImageResolver = resolver
+
+		request := createJobRequest(util.NewULID(), 2)
+		request.JobRequestItems[0].PodSpecs[0].Containers[0].Image = "registry.example.com/image:1.0"
+		request.JobRequestItems[1].PodSpecs[0].Containers[0].Image = "other.example.com/image:1.0"
+		_, err = s.SubmitJobs(context.Background(), request)
+		require.Error(t, err)
+		var details *api.JobSubmitResponse
+		for _, detail := range gogostatus.Convert(err).Details() {
+			details, _ = detail.(*api.JobSubmitResponse)
+		}
+		require.NotNil(t, details)
+		require.Len(t, details.JobResponseItems, 1)
+		assert.Equal(t, api.JobSubmitError_INVALID_POD_SPEC, details.JobResponseItems[0].ErrorDetails.Code)
+		assert.Equal(t, "podSpecs[0].containers[0].image", details.JobResponseItems[0].ErrorDetails.Field)
+
+		request.JobRequestItems[1].PodSpecs[0].Containers[0].Image = "registry.example.com/image:1.0"
+		_, err = s.SubmitJobs(context.Background(), request)
+		assert.NoError(t, err)
+	})
+}
+
+func TestSubmitServer_SubmitJobs_ChecksImagesOnlyForAuthorizedSubmissions(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
+		resolver, err := imageresolver.New(configuration.ImageResolverConfig{AllowedRegistries: []string{"registry.example.com"}})
+		require.NoError(t, err)
+		s.ImageResolver = resolver
+		s.authorizer = &FakeDenyAllActionAuthorizer{}
+
+		request := createJobRequest(util.NewULID(), 1)
+		request.JobRequestItems[0].PodSpecs[0].Containers[0].Image = "other.example.com/image:1.0"
+		_, err = s.SubmitJobs(context.Background(), request)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+}
+
+// jobSubmitErrorCodes returns the codes of the job errors included in the status details of err.
+func jobSubmitErrorCodes(err error) []api.JobSubmitError_Code {
+	var errorCodes []api.JobSubmitError_Code
+	for _, detail := range gogostatus.Convert(err).Details() {
+		if response, ok := detail.(*api.JobSubmitResponse); ok {
+			for _, item := range response.JobResponseItems {
+				errorCodes = append(errorCodes, item.ErrorDetails.GetCode())
+			}
+		}
+	}
+	return errorCodes
+}
+
 func TestSubmitServer_CreateJobs_WithDuplicatePodSpec(t *testing.T) {
 	timeNow := time.Now()
 	mockNow := func() time.Time {
@@ -1687,10 +4161,12 @@ func TestSubmitServer_CreateJobs_WithDuplicatePodSpec(t *testing.T) {
 	}
 
 	expectedResponseItems := []*api.JobSubmitResponseItem{
-		{
-			JobId: "test-ulid",
-			Error: "[createJobs] job 0 in job set test-jobsetid contains both podSpec and podSpecs, but may only contain either",
-		},
+		api.NewFailedJobSubmitResponseItem(
+			"test-ulid",
+			api.JobSubmitError_INVALID_POD_SPEC,
+			"podSpecs",
+			"[createJobs] job 0 in job set test-jobsetid contains both podSpec and podSpecs, but may only contain either",
+		),
 	}
 	expectedError := "[createJobs] error creating jobs, check JobSubmitResponse for details"
 
@@ -1749,9 +4225,8 @@ func TestSubmitServer_CreateJobs_WithDuplicatePodSpec(t *testing.T) {
 			},
 		},
 	}
-	ownershipGroups := make([]string, 0)
 	withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
-		output, responseItems, err := s.createJobsObjects(request, "test", ownershipGroups, mockNow, mockNewULID)
+		output, responseItems, err := s.createJobsObjects(request, "test", mockNow, mockNewULID)
 		assert.Equal(t, expectedError, err.Error())
 		assert.Equal(t, expectedResponseItems, responseItems)
 		assert.Nil(t, output)
@@ -5,6 +5,7 @@ import (
 	"crypto/sha1"
 	"fmt"
 	"math/rand"
+	"sort"
 	"strings"
 	"time"
 
@@ -15,10 +16,14 @@ import (
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
 	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
 	"google.golang.org/grpc/codes"
 
+	armadaconfiguration "github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/internal/armada/metrics"
 	"github.com/armadaproject/armada/internal/armada/permissions"
 	"github.com/armadaproject/armada/internal/armada/repository"
+	"github.com/armadaproject/armada/internal/armada/scheduling"
 	"github.com/armadaproject/armada/internal/armada/validation"
 	"github.com/armadaproject/armada/internal/common/armadacontext"
 	"github.com/armadaproject/armada/internal/common/armadaerrors"
@@ -29,6 +34,7 @@ import (
 	"github.com/armadaproject/armada/internal/common/pointer"
 	"github.com/armadaproject/armada/internal/common/pulsarutils"
 	"github.com/armadaproject/armada/internal/common/schedulers"
+	armadaslices "github.com/armadaproject/armada/internal/common/slices"
 	"github.com/armadaproject/armada/internal/common/util"
 	commonvalidation "github.com/armadaproject/armada/internal/common/validation"
 	"github.com/armadaproject/armada/internal/executor/configuration"
@@ -66,14 +72,26 @@ type PulsarSubmitServer struct {
 	GangIdAnnotation string
 	// Temporary flag to stop us rejecting jobs as we switch over to new submit checks
 	IgnoreJobSubmitChecks bool
+	// Stores the deadlines of job sets submitted with a TTL.
+	JobSetExpiryRepository repository.JobSetExpiryRepository
+	// Stores which jobs were found to exceed their maximum runtime.
+	MaxRuntimeRepository repository.MaxRuntimeRepository
+	// Counts submitted, rejected, cancelled and reprioritized jobs. If nil, nothing is counted.
+	Metrics *metrics.SubmitMetrics
 }
 
 func (srv *PulsarSubmitServer) SubmitJobs(grpcCtx context.Context, req *api.JobSubmitRequest) (*api.JobSubmitResponse, error) {
 	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+	timer := srv.Metrics.NewSubmitStageTimer()
 	userId, groups, err := srv.Authorize(ctx, req.Queue, permissions.SubmitAnyJobs, queue.PermissionVerbSubmit)
 	if err != nil {
 		return nil, err
 	}
+	timer.Done(metrics.SubmitStageAuthorize)
+	if req.JobSetTtlSeconds < 0 {
+		srv.Metrics.RecordJobsRejected(req.Queue, userId, metrics.RejectionReasonValidation, len(req.JobRequestItems))
+		return nil, status.Errorf(codes.InvalidArgument, "[SubmitJobs] job set TTL must be non-negative, but is %d seconds", req.JobSetTtlSeconds)
+	}
 
 	// Prepare an event sequence to be submitted to the log
 	pulsarSchedulerEvents := &armadaevents.EventSequence{
@@ -94,11 +112,10 @@ func (srv *PulsarSubmitServer) SubmitJobs(grpcCtx context.Context, req *api.JobS
 
 	// Create legacy API jobs from the requests.
 	// We use the legacy code for the conversion to ensure that behaviour doesn't change.
-	apiJobs, responseItems, err := srv.SubmitServer.createJobs(req, userId, groups)
+	apiJobs, responseItems, err := srv.SubmitServer.createJobs(req, userId)
 	if err != nil {
-		details := &api.JobSubmitResponse{
-			JobResponseItems: responseItems,
-		}
+		srv.Metrics.RecordJobsRejected(req.Queue, userId, metrics.RejectionReasonValidation, len(req.JobRequestItems))
+		details := srv.SubmitServer.submitFailureDetails(ctx, responseItems)
 
 		st, e := status.Newf(codes.InvalidArgument, "[SubmitJobs] Failed to parse job request: %s", err.Error()).WithDetails(details)
 		if e != nil {
@@ -107,10 +124,10 @@ func (srv *PulsarSubmitServer) SubmitJobs(grpcCtx context.Context, req *api.JobS
 
 		return nil, st.Err()
 	}
+	annotateResubmittedJobs(ctx, apiJobs)
 	if responseItems, err := commonvalidation.ValidateApiJobs(apiJobs, *srv.SubmitServer.schedulingConfig); err != nil {
-		details := &api.JobSubmitResponse{
-			JobResponseItems: responseItems,
-		}
+		srv.Metrics.RecordJobsRejected(req.Queue, userId, metrics.RejectionReasonValidation, len(apiJobs))
+		details := srv.SubmitServer.submitFailureDetails(ctx, responseItems)
 
 		st, e := status.Newf(codes.InvalidArgument, "[SubmitJobs] Failed to parse job request: %s", err.Error()).WithDetails(details)
 		if e != nil {
@@ -118,11 +135,152 @@ func (srv *PulsarSubmitServer) SubmitJobs(grpcCtx context.Context, req *api.JobS
 		}
 		return nil, st.Err()
 	}
+	timer.Done(metrics.SubmitStageValidate)
+	if responseItems := validateImages(ctx, srv.SubmitServer.ImageResolver, req, apiJobs); len(responseItems) > 0 {
+		srv.Metrics.RecordJobsRejected(req.Queue, userId, metrics.RejectionReasonImage, len(apiJobs))
+		details := srv.SubmitServer.submitFailureDetails(ctx, responseItems)
+		st, e := status.Newf(codes.InvalidArgument, "[SubmitJobs] %d of %d job(s) have invalid images; first error: %s",
+			len(responseItems), len(apiJobs), responseItems[0].Error).WithDetails(details)
+		if e != nil {
+			return nil, status.Newf(codes.Internal, "[SubmitJobs] Failed to validate images: %s", e.Error()).Err()
+		}
+		return nil, st.Err()
+	}
+	timer.Done(metrics.SubmitStageCheckImages)
+	if responseItems, err := srv.SubmitServer.evaluateSubmissionPolicy(ctx, apiJobs, authorization.GetPrincipal(ctx)); err != nil {
+		return nil, status.Errorf(codes.Unavailable, "[SubmitJobs] error evaluating submission policy: %s", err)
+	} else if len(responseItems) > 0 {
+		srv.Metrics.RecordJobsRejected(req.Queue, userId, metrics.RejectionReasonPolicy, len(apiJobs))
+		details := srv.SubmitServer.submitFailureDetails(ctx, responseItems)
+		st, e := status.Newf(codes.InvalidArgument, "[SubmitJobs] %d of %d job(s) denied; first error: %s",
+			len(responseItems), len(apiJobs), responseItems[0].Error).WithDetails(details)
+		if e != nil {
+			return nil, status.Newf(codes.Internal, "[SubmitJobs] Failed to evaluate submission policy: %s", e.Error()).Err()
+		}
+		return nil, st.Err()
+	}
+	timer.Done(metrics.SubmitStageEvaluatePolicy)
+	if srv.SubmitServer.schedulingConfig.VerifyServiceAccountsExist {
+		allClusterSchedulingInfo, err := srv.SubmitServer.schedulingInfoRepository.GetClusterSchedulingInfo()
+		if err != nil {
+			return nil, status.Errorf(codes.Unavailable, "[SubmitJobs] error getting scheduling info: %s", err)
+		}
+		if ok, responseItems, err := validateServiceAccountsExist(apiJobs, allClusterSchedulingInfo); !ok {
+			srv.Metrics.RecordJobsRejected(req.Queue, userId, metrics.RejectionReasonValidation, len(apiJobs))
+			details := srv.SubmitServer.submitFailureDetails(ctx, responseItems)
 
-	schedulersByJobId, err := srv.assignScheduler(apiJobs)
+			st, e := status.Newf(codes.InvalidArgument, "[SubmitJobs] Failed to validate jobs: %s", err.Error()).WithDetails(details)
+			if e != nil {
+				return nil, status.Newf(codes.Internal, "[SubmitJobs] Failed to validate jobs: %s", e.Error()).Err()
+			}
+			return nil, st.Err()
+		}
+	}
+	if srv.SubmitServer.schedulingConfig.VerifySecretsAndConfigMapsExist {
+		allClusterSchedulingInfo, err := srv.SubmitServer.schedulingInfoRepository.GetClusterSchedulingInfo()
+		if err != nil {
+			return nil, status.Errorf(codes.Unavailable, "[SubmitJobs] error getting scheduling info: %s", err)
+		}
+		if ok, responseItems, err := validateReferencesExist(apiJobs, allClusterSchedulingInfo); !ok {
+			srv.Metrics.RecordJobsRejected(req.Queue, userId, metrics.RejectionReasonValidation, len(apiJobs))
+			details := srv.SubmitServer.submitFailureDetails(ctx, responseItems)
+
+			st, e := status.Newf(codes.InvalidArgument, "[SubmitJobs] Failed to validate jobs: %s", err.Error()).WithDetails(details)
+			if e != nil {
+				return nil, status.Newf(codes.Internal, "[SubmitJobs] Failed to validate jobs: %s", e.Error()).Err()
+			}
+			return nil, st.Err()
+		}
+	}
+
+	if slices.IndexFunc(apiJobs, isClusterTargetedJob) >= 0 {
+		allClusterSchedulingInfo, err := srv.SubmitServer.schedulingInfoRepository.GetClusterSchedulingInfo()
+		if err != nil {
+			return nil, status.Errorf(codes.Unavailable, "[SubmitJobs] error getting scheduling info: %s", err)
+		}
+		if ok, responseItems, err := validateClusterTargetsExist(apiJobs, allClusterSchedulingInfo); !ok {
+			srv.Metrics.RecordJobsRejected(req.Queue, userId, metrics.RejectionReasonValidation, len(apiJobs))
+			details := srv.SubmitServer.submitFailureDetails(ctx, responseItems)
+
+			st, e := status.Newf(codes.InvalidArgument, "[SubmitJobs] Failed to validate jobs: %s", err.Error()).WithDetails(details)
+			if e != nil {
+				return nil, status.Newf(codes.Internal, "[SubmitJobs] Failed to validate jobs: %s", e.Error()).Err()
+			}
+			return nil, st.Err()
+		}
+	}
+
+	// Resource quotas and budgets are only enforced by the legacy scheduler; jobs of queues with either are always assigned to it.
+	q, err := srv.QueueRepository.GetQueue(req.Queue)
+	if err != nil {
+		return nil, err
+	}
+	if q.IsArchived() {
+		srv.Metrics.RecordJobsRejected(req.Queue, userId, metrics.RejectionReasonValidation, len(apiJobs))
+		return nil, status.Errorf(codes.FailedPrecondition, "[SubmitJobs] queue %s is archived and doesn't accept new jobs", req.Queue)
+	}
+	if err := srv.SubmitServer.submittingJobsWouldSurpassQuotas(q, apiJobs); err != nil {
+		srv.Metrics.RecordJobsRejected(req.Queue, userId, metrics.RejectionReasonQuota, len(apiJobs))
+		return nil, status.Errorf(codes.InvalidArgument, "[SubmitJobs] error checking queue quotas: %s", err)
+	}
+	budgetResponseItems, warnings, err := srv.SubmitServer.applyBudgets(q, userId, apiJobs)
 	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "[SubmitJobs] error checking resource budgets: %s", err)
+	} else if len(budgetResponseItems) > 0 {
+		srv.Metrics.RecordJobsRejected(req.Queue, userId, metrics.RejectionReasonBudget, len(apiJobs))
+		details := srv.SubmitServer.submitFailureDetails(ctx, budgetResponseItems)
+		st, e := status.Newf(codes.ResourceExhausted, "[SubmitJobs] %d of %d job(s) exceed resource budgets; first error: %s",
+			len(budgetResponseItems), len(apiJobs), budgetResponseItems[0].Error).WithDetails(details)
+		if e != nil {
+			return nil, status.Newf(codes.Internal, "[SubmitJobs] Failed to check resource budgets: %s", e.Error()).Err()
+		}
+		return nil, st.Err()
+	}
+	schedulersByJobId, responseItems, err := srv.assignScheduler(apiJobs)
+	if err != nil && len(responseItems) == 0 {
+		srv.Metrics.RecordJobsRejected(req.Queue, userId, metrics.RejectionReasonUnschedulable, len(apiJobs))
 		return nil, err
+	} else if err != nil {
+		// Jobs may only appear unschedulable because clusters haven't reported recently, e.g., while executors restart.
+		// Depending on configuration, such jobs are then accepted with a warning or rejected such that clients may retry.
+		stale, e := srv.SubmitServer.schedulingInfoIsStale()
+		if e != nil {
+			return nil, status.Errorf(codes.Unavailable, "[SubmitJobs] error checking freshness of scheduling info: %s", e)
+		}
+		if stale && srv.SubmitServer.schedulingConfig.AcceptJobsWithStaleSchedulingInfo {
+			// The legacy scheduler keeps jobs queued until they fit, so it's assigned the jobs neither scheduler appears able to schedule.
+			if warnings == nil {
+				warnings = make(map[string]string, len(responseItems))
+			}
+			for _, item := range responseItems {
+				warnings[item.JobId] = joinWarnings(warnings[item.JobId], fmt.Sprintf("%s; accepted since %s", item.Error, srv.SubmitServer.staleSchedulingInfoReason()))
+				schedulersByJobId[item.JobId] = schedulers.Legacy
+			}
+		} else {
+			srv.Metrics.RecordJobsRejected(req.Queue, userId, metrics.RejectionReasonUnschedulable, len(apiJobs))
+			if e := srv.explainUnschedulableJobs(apiJobs, responseItems); e != nil {
+				return nil, status.Errorf(codes.Unavailable, "[SubmitJobs] error getting scheduling info: %s", e)
+			}
+			code := codes.InvalidArgument
+			if stale {
+				code = codes.Unavailable
+				responseItems = staleSchedulingInfoResponseItems(responseItems, srv.SubmitServer.staleSchedulingInfoReason())
+			}
+			details := srv.SubmitServer.submitFailureDetails(ctx, responseItems)
+			st, e := status.Newf(code, "[SubmitJobs] %d of %d job(s) can't be scheduled; first error: %s",
+				len(responseItems), len(apiJobs), responseItems[0].Error).WithDetails(details)
+			if e != nil {
+				return nil, status.Newf(codes.Internal, "[SubmitJobs] Failed to validate jobs can be scheduled: %s", e.Error()).Err()
+			}
+			return nil, st.Err()
+		}
 	}
+	if len(q.ResourceQuotas) > 0 || len(q.ResourceBudgets) > 0 {
+		for jobId := range schedulersByJobId {
+			schedulersByJobId[jobId] = schedulers.Legacy
+		}
+	}
+	timer.Done(metrics.SubmitStageCheckSchedulable)
 
 	jobsSubmitted := make([]*api.Job, 0, len(req.JobRequestItems))
 	responses := make([]*api.JobSubmitResponseItem, len(req.JobRequestItems))
@@ -132,8 +290,10 @@ func (srv *PulsarSubmitServer) SubmitJobs(grpcCtx context.Context, req *api.JobS
 		// Deduplication is best-effort, therefore this is not fatal
 		log.WithError(err).Warn("Error fetching original job ids, deduplication will not occur.")
 	}
+	timer.Done(metrics.SubmitStageDeduplicate)
 
 	pulsarJobDetails := make([]*schedulerobjects.PulsarSchedulerJobDetails, 0)
+	nonDuplicateJobs := make([]*api.Job, 0, len(apiJobs))
 
 	for i, apiJob := range apiJobs {
 		eventTime := time.Now()
@@ -146,9 +306,11 @@ func (srv *PulsarSubmitServer) SubmitJobs(grpcCtx context.Context, req *api.JobS
 		es := legacySchedulerEvents
 		if assignedScheduler == schedulers.Pulsar {
 			pulsarJobDetails = append(pulsarJobDetails, &schedulerobjects.PulsarSchedulerJobDetails{
-				JobId:  apiJob.Id,
-				Queue:  apiJob.Queue,
-				JobSet: apiJob.JobSetId,
+				JobId:     apiJob.Id,
+				Queue:     apiJob.Queue,
+				JobSet:    apiJob.JobSetId,
+				Owner:     apiJob.Owner,
+				Resources: apiJob.TotalResourceRequest().AsFloat(),
 			})
 			es = pulsarSchedulerEvents
 		}
@@ -166,7 +328,8 @@ func (srv *PulsarSubmitServer) SubmitJobs(grpcCtx context.Context, req *api.JobS
 		}
 
 		responses[i] = &api.JobSubmitResponseItem{
-			JobId: apiJob.GetId(),
+			JobId:   apiJob.GetId(),
+			Warning: warnings[apiJob.GetId()],
 		}
 
 		// The log accept a different type of job.
@@ -210,6 +373,7 @@ func (srv *PulsarSubmitServer) SubmitJobs(grpcCtx context.Context, req *api.JobS
 					},
 				})
 				responses[i].JobId = originalIds[apiJob.GetId()]
+				responses[i].ErrorDetails = duplicateJobError(apiJob)
 				// The job shouldn't be submitted twice. Move on to the next job.
 				continue
 			} else {
@@ -221,6 +385,35 @@ func (srv *PulsarSubmitServer) SubmitJobs(grpcCtx context.Context, req *api.JobS
 		} else {
 			jobsSubmitted = append(jobsSubmitted, apiJob)
 		}
+
+		normalized, err := resourcesNormalizedEvent(apiJob, eventTime)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "[SubmitJobs] error creating resources-normalized event: %s", err)
+		}
+		if normalized != nil {
+			es.Events = append(es.Events, &armadaevents.EventSequence_Event{
+				Created: &eventTime,
+				Event: &armadaevents.EventSequence_Event_JobResourcesNormalized{
+					JobResourcesNormalized: &armadaevents.JobResourcesNormalized{
+						JobId:               logJob.JobId,
+						OriginalResources:   normalized.OriginalResources,
+						NormalizedResources: normalized.NormalizedResources,
+					},
+				},
+			})
+		}
+		nonDuplicateJobs = append(nonDuplicateJobs, apiJob)
+	}
+	timer.Done(metrics.SubmitStageCreateEvents)
+
+	// The deadline is recorded before the jobs are published, such that no job of the job set outlives it.
+	if req.JobSetTtlSeconds > 0 {
+		ttl := time.Duration(req.JobSetTtlSeconds) * time.Second
+		err = srv.JobSetExpiryRepository.SetJobSetDeadline(req.Queue, req.JobSetId, time.Now().Add(ttl), ttl)
+		if err != nil {
+			log.WithError(err).Error("failed to store job set deadline")
+			return nil, status.Error(codes.Internal, "failed to store job set deadline")
+		}
 	}
 
 	if len(pulsarJobDetails) > 0 {
@@ -231,10 +424,18 @@ func (srv *PulsarSubmitServer) SubmitJobs(grpcCtx context.Context, req *api.JobS
 		}
 	}
 
+	// Barrier membership must be recorded before the jobs are published,
+	// since the legacy scheduler considers jobs of unknown barriers to be schedulable.
+	// It's undone for jobs that aren't published, such that they don't count towards their barriers.
+	if err := srv.SubmitServer.addBarrierMembers(nonDuplicateJobs); err != nil {
+		return nil, err
+	}
+
 	if len(pulsarSchedulerEvents.Events) > 0 {
 		err = srv.publishToPulsar(ctx, []*armadaevents.EventSequence{pulsarSchedulerEvents}, schedulers.Pulsar)
 		if err != nil {
 			log.WithError(err).Error("failed send pulsar scheduler events to Pulsar")
+			srv.SubmitServer.removeBarrierMembers(nonDuplicateJobs)
 			return nil, status.Error(codes.Internal, "Failed to send message")
 		}
 	}
@@ -243,6 +444,9 @@ func (srv *PulsarSubmitServer) SubmitJobs(grpcCtx context.Context, req *api.JobS
 		err = srv.publishToPulsar(ctx, []*armadaevents.EventSequence{legacySchedulerEvents}, schedulers.Legacy)
 		if err != nil {
 			log.WithError(err).Error("failed send legacy scheduler events to Pulsar")
+			srv.SubmitServer.removeBarrierMembers(armadaslices.Filter(nonDuplicateJobs, func(job *api.Job) bool {
+				return schedulersByJobId[job.Id] == schedulers.Legacy
+			}))
 			return nil, status.Error(codes.Internal, "Failed to send message")
 		}
 	}
@@ -255,6 +459,9 @@ func (srv *PulsarSubmitServer) SubmitJobs(grpcCtx context.Context, req *api.JobS
 	if err != nil {
 		log.WithError(err).Warn("failed to satore deduplicattion ids")
 	}
+	timer.Done(metrics.SubmitStagePublish)
+	srv.Metrics.RecordJobsSubmitted(req.Queue, userId, len(nonDuplicateJobs))
+	srv.Metrics.RecordJobsDuplicate(req.Queue, userId, len(apiJobs)-len(nonDuplicateJobs))
 	return &api.JobSubmitResponse{JobResponseItems: responses}, nil
 }
 
@@ -306,7 +513,7 @@ func (srv *PulsarSubmitServer) CancelJobs(grpcCtx context.Context, req *api.JobC
 		}
 	}
 
-	userId, groups, err := srv.Authorize(ctx, resolvedQueue, permissions.CancelAnyJobs, queue.PermissionVerbCancel)
+	userId, groups, err := srv.authorizeJobs(ctx, resolvedQueue, []string{req.JobId}, permissions.CancelAnyJobs, queue.PermissionVerbCancel)
 	if err != nil {
 		return nil, err
 	}
@@ -341,6 +548,7 @@ func (srv *PulsarSubmitServer) CancelJobs(grpcCtx context.Context, req *api.JobC
 		log.WithError(err).Error("failed send to Pulsar")
 		return nil, status.Error(codes.Internal, "Failed to send message")
 	}
+	srv.Metrics.RecordJobsCancelled(resolvedQueue, userId, 1)
 
 	return &api.CancellationResult{
 		CancelledIds: []string{req.JobId}, // indicates no error
@@ -364,7 +572,7 @@ func (srv *PulsarSubmitServer) cancelJobsByIdsQueueJobset(grpcCtx context.Contex
 			Message: "Jobset cannot be empty when cancelling multiple jobs",
 		}
 	}
-	userId, groups, err := srv.Authorize(ctx, q, permissions.CancelAnyJobs, queue.PermissionVerbCancel)
+	userId, groups, err := srv.authorizeJobs(ctx, q, jobIds, permissions.CancelAnyJobs, queue.PermissionVerbCancel)
 	if err != nil {
 		return nil, err
 	}
@@ -376,6 +584,7 @@ func (srv *PulsarSubmitServer) cancelJobsByIdsQueueJobset(grpcCtx context.Contex
 		log.WithError(err).Error("failed send to Pulsar")
 		return nil, status.Error(codes.Internal, "Failed to send message")
 	}
+	srv.Metrics.RecordJobsCancelled(q, userId, len(cancelledIds))
 	return &api.CancellationResult{
 		CancelledIds: cancelledIds,
 	}, nil
@@ -433,20 +642,56 @@ func (srv *PulsarSubmitServer) CancelJobSet(grpcCtx context.Context, req *api.Jo
 		return nil, err
 	}
 
-	userId, groups, err := srv.Authorize(ctx, req.Queue, permissions.CancelAnyJobs, queue.PermissionVerbCancel)
+	// We don't know if the jobs are allocated to the legacy scheduler or the new scheduler.  We therefore send messages to both
+	ids, err := srv.SubmitServer.jobRepository.GetJobSetJobIds(req.Queue, req.JobSetId, createJobSetFilter(req.Filter, req.LabelSelector))
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "error getting job IDs: %s", err)
+	}
+
+	// Owners may cancel job sets of which they own all jobs, if the queue lets them. The jobs of the Pulsar scheduler
+	// can't be listed by job set, so their owners can't be checked; job sets may then only be cancelled with permission
+	// on the queue.
+	var ownedJobIds []string
+	if !srv.PulsarSchedulerEnabled {
+		ownedJobIds = ids
+	}
+	userId, groups, err := srv.authorizeJobs(ctx, req.Queue, ownedJobIds, permissions.CancelAnyJobs, queue.PermissionVerbCancel)
 	if err != nil {
 		return nil, err
 	}
 
-	// We don't know if the jobs are allocated to the legacy scheduler or the new scheduler.  We therefore send messages to both
-	ids, err := srv.SubmitServer.jobRepository.GetJobSetJobIds(req.Queue, req.JobSetId, createJobSetFilter(req.Filter))
+	err = srv.cancelJobSet(ctx, req.Queue, req.JobSetId, ids, req.Filter, req.LabelSelector, req.Reason, userId, groups)
 	if err != nil {
-		return nil, status.Errorf(codes.Unavailable, "error getting job IDs: %s", err)
+		return nil, err
+	}
+	srv.Metrics.RecordJobSetCancelled(req.Queue, userId)
+	// The jobs of the Pulsar scheduler can't be selected by label; rather than having them appear cancelled,
+	// callers are told that only the jobs of the legacy scheduler were.
+	if srv.PulsarSchedulerEnabled && len(req.LabelSelector) > 0 {
+		return nil, status.Errorf(codes.Unimplemented,
+			"[CancelJobSet] cancelled the %d matching jobs of the legacy scheduler, but jobs of the Pulsar scheduler can't be cancelled by label selector and weren't cancelled",
+			len(ids))
 	}
+	return &types.Empty{}, nil
+}
 
+// cancelJobSet cancels the jobs of the job set matching filter and labelSelector.
+// ids are the ids of the jobs to cancel on the legacy scheduler, which cancels jobs by id.
+// The Pulsar scheduler doesn't support cancelling jobs by label; it's not sent a cancellation if labelSelector is non-empty.
+func (srv *PulsarSubmitServer) cancelJobSet(
+	ctx *armadacontext.Context,
+	queueName string,
+	jobSetId string,
+	ids []string,
+	filter *api.JobSetFilter,
+	labelSelector map[string]string,
+	reason string,
+	userId string,
+	groups []string,
+) error {
 	legacySchedulerSequence := &armadaevents.EventSequence{
-		Queue:      req.Queue,
-		JobSetName: req.JobSetId,
+		Queue:      queueName,
+		JobSetName: jobSetId,
 		UserId:     userId,
 		Groups:     groups,
 		Events:     make([]*armadaevents.EventSequence_Event, 0, len(ids)),
@@ -455,7 +700,7 @@ func (srv *PulsarSubmitServer) CancelJobSet(grpcCtx context.Context, req *api.Jo
 	for _, id := range ids {
 		jobId, err := armadaevents.ProtoUuidFromUlidString(id)
 		if err != nil {
-			return nil, err
+			return err
 		}
 
 		legacySchedulerSequence.Events = append(legacySchedulerSequence.Events, &armadaevents.EventSequence_Event{
@@ -463,33 +708,36 @@ func (srv *PulsarSubmitServer) CancelJobSet(grpcCtx context.Context, req *api.Jo
 			Event: &armadaevents.EventSequence_Event_CancelJob{
 				CancelJob: &armadaevents.CancelJob{
 					JobId:  jobId,
-					Reason: util.Truncate(req.Reason, 512),
+					Reason: util.Truncate(reason, 512),
 				},
 			},
 		})
 	}
 
-	err = srv.publishToPulsar(ctx, []*armadaevents.EventSequence{legacySchedulerSequence}, schedulers.Legacy)
+	err := srv.publishToPulsar(ctx, []*armadaevents.EventSequence{legacySchedulerSequence}, schedulers.Legacy)
 	if err != nil {
 		log.WithError(err).Error("failed to send cancel job messages to pulsar")
-		return nil, status.Error(codes.Internal, "failed to send cancel job messages to pulsar")
-	}
-
-	if srv.PulsarSchedulerEnabled {
-		states := make([]armadaevents.JobState, len(req.GetFilter().GetStates()))
-		for i := 0; i < len(states); i++ {
-			switch req.GetFilter().GetStates()[i] {
-			case api.JobState_PENDING:
-				states[i] = armadaevents.JobState_PENDING
-			case api.JobState_QUEUED:
-				states[i] = armadaevents.JobState_QUEUED
-			case api.JobState_RUNNING:
-				states[i] = armadaevents.JobState_RUNNING
-			}
+		return status.Error(codes.Internal, "failed to send cancel job messages to pulsar")
+	}
+
+	// Jobs of the Pulsar scheduler are never suspended, so only the remaining states are passed on.
+	states := make([]armadaevents.JobState, 0, len(filter.GetStates()))
+	for _, state := range filter.GetStates() {
+		switch state {
+		case api.JobState_PENDING:
+			states = append(states, armadaevents.JobState_PENDING)
+		case api.JobState_QUEUED:
+			states = append(states, armadaevents.JobState_QUEUED)
+		case api.JobState_RUNNING:
+			states = append(states, armadaevents.JobState_RUNNING)
 		}
+	}
+	// An empty list of states would cancel all jobs; skip cancelling if only suspended jobs were asked for.
+	onlySuspended := len(filter.GetStates()) > 0 && len(states) == 0
+	if srv.PulsarSchedulerEnabled && len(labelSelector) == 0 && !onlySuspended {
 		pulsarSchedulerSequence := &armadaevents.EventSequence{
-			Queue:      req.Queue,
-			JobSetName: req.JobSetId,
+			Queue:      queueName,
+			JobSetName: jobSetId,
 			UserId:     userId,
 			Groups:     groups,
 			Events: []*armadaevents.EventSequence_Event{
@@ -498,7 +746,7 @@ func (srv *PulsarSubmitServer) CancelJobSet(grpcCtx context.Context, req *api.Jo
 					Event: &armadaevents.EventSequence_Event_CancelJobSet{
 						CancelJobSet: &armadaevents.CancelJobSet{
 							States: states,
-							Reason: util.Truncate(req.Reason, 512),
+							Reason: util.Truncate(reason, 512),
 						},
 					},
 				},
@@ -507,14 +755,44 @@ func (srv *PulsarSubmitServer) CancelJobSet(grpcCtx context.Context, req *api.Jo
 		err = srv.publishToPulsar(ctx, []*armadaevents.EventSequence{pulsarSchedulerSequence}, schedulers.Pulsar)
 		if err != nil {
 			log.WithError(err).Error("failed to send cancel jobset message to pulsar")
-			return nil, status.Error(codes.Internal, "failed to send cancel jobset message to pulsar")
+			return status.Error(codes.Internal, "failed to send cancel jobset message to pulsar")
 		}
 	}
 
-	return &types.Empty{}, err
+	return nil
 }
 
+// relativeReprioritizationNotFoundMessage is the result of relatively reprioritizing a job the legacy scheduler doesn't know about.
+const relativeReprioritizationNotFoundMessage = "job not found; relative priority adjustments are only supported for jobs of the legacy scheduler"
+
 func (srv *PulsarSubmitServer) ReprioritizeJobs(grpcCtx context.Context, req *api.JobReprioritizeRequest) (*api.JobReprioritizeResponse, error) {
+	// Messages published to the log carry absolute priorities.
+	// Relative adjustments are hence applied directly, which is supported by the legacy scheduler only.
+	if isRelativeReprioritization(req) {
+		resp, err := srv.SubmitServer.ReprioritizeJobs(grpcCtx, req)
+		if err != nil {
+			return nil, err
+		}
+		// The legacy scheduler skips jobs it doesn't know about, e.g., jobs of the Pulsar scheduler,
+		// which are hence reported as not reprioritized.
+		for _, jobId := range req.JobIds {
+			if _, ok := resp.ReprioritizationResults[jobId]; !ok {
+				if resp.ReprioritizationResults == nil {
+					resp.ReprioritizationResults = make(map[string]string)
+				}
+				resp.ReprioritizationResults[jobId] = relativeReprioritizationNotFoundMessage
+			}
+		}
+		reprioritized := 0
+		for _, e := range resp.ReprioritizationResults {
+			if e == "" {
+				reprioritized++
+			}
+		}
+		srv.Metrics.RecordJobsReprioritized(req.Queue, authorization.GetPrincipal(grpcCtx).GetName(), reprioritized)
+		return resp, nil
+	}
+
 	ctx := armadacontext.FromGrpcCtx(grpcCtx)
 
 	// If either queue or jobSetId is missing, we get the job set and queue associated
@@ -552,7 +830,16 @@ func (srv *PulsarSubmitServer) ReprioritizeJobs(grpcCtx context.Context, req *ap
 	}
 
 	// TODO: this is incorrect we only validate the permissions on the first job but the other jobs may belong to different queues
-	userId, groups, err := srv.Authorize(ctx, req.Queue, permissions.ReprioritizeAnyJobs, queue.PermissionVerbReprioritize)
+	// As for CancelJobSet, owners may reprioritize entire job sets only if the Pulsar scheduler is disabled.
+	jobIds := req.JobIds
+	if len(jobIds) == 0 && !srv.PulsarSchedulerEnabled && req.Queue != "" && req.JobSetId != "" {
+		ids, err := srv.SubmitServer.jobRepository.GetJobSetJobIds(req.Queue, req.JobSetId, nil)
+		if err != nil {
+			return nil, status.Errorf(codes.Unavailable, "[ReprioritizeJobs] error getting job IDs: %s", err)
+		}
+		jobIds = ids
+	}
+	userId, groups, err := srv.authorizeJobs(ctx, req.Queue, jobIds, permissions.ReprioritizeAnyJobs, queue.PermissionVerbReprioritize)
 	if err != nil {
 		return nil, err
 	}
@@ -571,6 +858,19 @@ func (srv *PulsarSubmitServer) ReprioritizeJobs(grpcCtx context.Context, req *ap
 			Message: "JobSetId is empty",
 		}
 	}
+	q, err := srv.SubmitServer.getQueueIfExists(req.Queue)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "[ReprioritizeJobs] error getting queue %s: %s", req.Queue, err)
+	}
+	if q != nil {
+		if err := q.JobPriorityPolicy.Validate(req.NewPriority); err != nil {
+			return nil, &armadaerrors.ErrInvalidArgument{
+				Name:    "NewPriority",
+				Value:   req.NewPriority,
+				Message: err.Error(),
+			}
+		}
+	}
 	priority := eventutil.LogSubmitPriorityFromApiPriority(req.NewPriority)
 
 	// results maps job ids to strings containing error messages.
@@ -625,6 +925,17 @@ func (srv *PulsarSubmitServer) ReprioritizeJobs(grpcCtx context.Context, req *ap
 		log.WithError(err).Error("failed send to Pulsar")
 		return nil, status.Error(codes.Internal, "Failed to send message")
 	}
+	if len(req.JobIds) == 0 {
+		srv.Metrics.RecordJobSetReprioritized(req.Queue, userId)
+	} else {
+		reprioritized := 0
+		for _, e := range results {
+			if e == "" {
+				reprioritized++
+			}
+		}
+		srv.Metrics.RecordJobsReprioritized(req.Queue, userId, reprioritized)
+	}
 
 	return &api.JobReprioritizeResponse{
 		ReprioritizationResults: results,
@@ -652,6 +963,68 @@ func (srv *PulsarSubmitServer) Authorize(
 	return userId, groups, err
 }
 
+// authorizeJobs is like Authorize, but if the user lacks permission on the queue, nevertheless permits requests for the
+// jobs with the given ids if the queue lets owners manage their own jobs and the user owns all of them, like
+// checkJobPerms does for the legacy scheduler. Jobs that can't be found, or aren't in the queue, aren't owned by anyone.
+func (srv *PulsarSubmitServer) authorizeJobs(
+	ctx *armadacontext.Context,
+	queueName string,
+	jobIds []string,
+	anyPerm permission.Permission,
+	perm queue.PermissionVerb,
+) (string, []string, error) {
+	userId, groups, err := srv.Authorize(ctx, queueName, anyPerm, perm)
+	var permErr *armadaerrors.ErrUnauthorized
+	if !errors.As(err, &permErr) || len(jobIds) == 0 {
+		return userId, groups, err
+	}
+	jobIds = slices.Clone(jobIds)
+	slices.Sort(jobIds)
+	jobIds = slices.Compact(jobIds)
+	jobs, err := srv.getJobsOfBothSchedulers(jobIds)
+	if err != nil {
+		return userId, groups, err
+	}
+	if len(jobs) < len(jobIds) {
+		return userId, groups, permErr
+	}
+	for _, job := range jobs {
+		if job.Queue != queueName {
+			return userId, groups, permErr
+		}
+	}
+	return userId, groups, srv.SubmitServer.checkJobPerms(ctx, jobs, anyPerm, perm)
+}
+
+// getJobsOfBothSchedulers returns the jobs with the given ids, whether of the legacy or the Pulsar scheduler.
+// Jobs of the Pulsar scheduler only have their id, queue, job set and owner set. Jobs that can't be found are omitted.
+func (srv *PulsarSubmitServer) getJobsOfBothSchedulers(jobIds []string) ([]*api.Job, error) {
+	jobs, err := srv.SubmitServer.jobRepository.GetExistingJobsByIds(jobIds)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "error getting jobs: %s", err)
+	}
+	if !srv.PulsarSchedulerEnabled {
+		return jobs, nil
+	}
+	found := make(map[string]bool, len(jobs))
+	for _, job := range jobs {
+		found[job.Id] = true
+	}
+	for _, jobId := range jobIds {
+		if found[jobId] {
+			continue
+		}
+		details, err := srv.SubmitServer.jobRepository.GetPulsarSchedulerJobDetails(jobId)
+		if err != nil {
+			return nil, status.Errorf(codes.Unavailable, "error getting job %s: %s", jobId, err)
+		}
+		if details != nil {
+			jobs = append(jobs, &api.Job{Id: details.JobId, Queue: details.Queue, JobSetId: details.JobSet, Owner: details.Owner})
+		}
+	}
+	return jobs, nil
+}
+
 // Fallback methods. Calls into an embedded server.SubmitServer.
 func (srv *PulsarSubmitServer) CreateQueue(ctx context.Context, req *api.Queue) (*types.Empty, error) {
 	return srv.SubmitServer.CreateQueue(ctx, req)
@@ -665,12 +1038,54 @@ func (srv *PulsarSubmitServer) UpdateQueue(ctx context.Context, req *api.Queue)
 	return srv.SubmitServer.UpdateQueue(ctx, req)
 }
 
+func (srv *PulsarSubmitServer) PatchQueue(ctx context.Context, req *api.QueuePatchRequest) (*api.Queue, error) {
+	return srv.SubmitServer.PatchQueue(ctx, req)
+}
+
 func (srv *PulsarSubmitServer) UpdateQueues(ctx context.Context, req *api.QueueList) (*api.BatchQueueUpdateResponse, error) {
 	return srv.SubmitServer.UpdateQueues(ctx, req)
 }
 
 func (srv *PulsarSubmitServer) DeleteQueue(ctx context.Context, req *api.QueueDeleteRequest) (*types.Empty, error) {
-	return srv.SubmitServer.DeleteQueue(ctx, req)
+	return srv.SubmitServer.deleteQueue(armadacontext.FromGrpcCtx(ctx), req, srv.cancelJobSetOfDeletedQueue)
+}
+
+// cancelJobSetOfDeletedQueue publishes cancellations for all jobs of the job set,
+// in batches of at most cancelJobsBatchSize jobs.
+func (srv *PulsarSubmitServer) cancelJobSetOfDeletedQueue(ctx *armadacontext.Context, queueName string, jobSetId string, reason string) error {
+	ids, err := srv.SubmitServer.jobRepository.GetJobSetJobIds(queueName, jobSetId, nil)
+	if err != nil {
+		return err
+	}
+	principal := authorization.GetPrincipal(ctx)
+	batches := util.Batch(ids, srv.SubmitServer.cancelJobsBatchSize)
+	if len(batches) == 0 {
+		// The job set may still have jobs assigned to the Pulsar scheduler.
+		batches = [][]string{nil}
+	}
+	for _, batch := range batches {
+		err := srv.cancelJobSet(ctx, queueName, jobSetId, batch, nil, nil, reason, principal.GetName(), principal.GetGroupNames())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (srv *PulsarSubmitServer) GetOperation(ctx context.Context, req *api.OperationGetRequest) (*api.Operation, error) {
+	return srv.SubmitServer.GetOperation(ctx, req)
+}
+
+func (srv *PulsarSubmitServer) GetSubmitFailureReport(ctx context.Context, req *api.SubmitFailureReportRequest) (*api.JobSubmitResponse, error) {
+	return srv.SubmitServer.GetSubmitFailureReport(ctx, req)
+}
+
+func (srv *PulsarSubmitServer) ArchiveQueue(ctx context.Context, req *api.QueueArchiveRequest) (*types.Empty, error) {
+	return srv.SubmitServer.ArchiveQueue(ctx, req)
+}
+
+func (srv *PulsarSubmitServer) RestoreQueue(ctx context.Context, req *api.QueueRestoreRequest) (*types.Empty, error) {
+	return srv.SubmitServer.RestoreQueue(ctx, req)
 }
 
 func (srv *PulsarSubmitServer) GetQueue(ctx context.Context, req *api.QueueGetRequest) (*api.Queue, error) {
@@ -681,6 +1096,50 @@ func (srv *PulsarSubmitServer) GetQueues(req *api.StreamingQueueGetRequest, stre
 	return srv.SubmitServer.GetQueues(req, stream)
 }
 
+func (srv *PulsarSubmitServer) GetJobWaitReasons(ctx context.Context, req *api.JobWaitReasonsRequest) (*api.JobWaitReasons, error) {
+	return srv.SubmitServer.GetJobWaitReasons(ctx, req)
+}
+
+func (srv *PulsarSubmitServer) WatchQueues(req *api.WatchQueuesRequest, stream api.Submit_WatchQueuesServer) error {
+	return srv.SubmitServer.WatchQueues(req, stream)
+}
+
+func (srv *PulsarSubmitServer) ListQueues(ctx context.Context, req *api.QueueListRequest) (*api.QueuePage, error) {
+	return srv.SubmitServer.ListQueues(ctx, req)
+}
+
+func (srv *PulsarSubmitServer) ExportQueues(ctx context.Context, req *api.QueueExportRequest) (*api.QueueDocument, error) {
+	return srv.SubmitServer.ExportQueues(ctx, req)
+}
+
+func (srv *PulsarSubmitServer) ImportQueues(ctx context.Context, req *api.QueueImportRequest) (*api.QueueImportResponse, error) {
+	return srv.SubmitServer.ImportQueues(ctx, req)
+}
+
+func (srv *PulsarSubmitServer) GetBarrier(ctx context.Context, req *api.BarrierGetRequest) (*api.Barrier, error) {
+	return srv.SubmitServer.GetBarrier(ctx, req)
+}
+
+func (srv *PulsarSubmitServer) ResubmitJobs(ctx context.Context, req *api.JobResubmitRequest) (*api.JobResubmitResponse, error) {
+	return srv.SubmitServer.resubmitJobs(ctx, req, srv.SubmitJobs)
+}
+
+func (srv *PulsarSubmitServer) PreemptJobs(ctx context.Context, req *api.JobPreemptRequest) (*api.JobPreemptResponse, error) {
+	return srv.SubmitServer.PreemptJobs(ctx, req)
+}
+
+func (srv *PulsarSubmitServer) PauseJobSet(ctx context.Context, req *api.JobSetPauseRequest) (*types.Empty, error) {
+	return srv.SubmitServer.PauseJobSet(ctx, req)
+}
+
+func (srv *PulsarSubmitServer) ResumeJobSet(ctx context.Context, req *api.JobSetResumeRequest) (*types.Empty, error) {
+	return srv.SubmitServer.ResumeJobSet(ctx, req)
+}
+
+func (srv *PulsarSubmitServer) GetQueueBudgets(ctx context.Context, req *api.QueueBudgetsRequest) (*api.QueueBudgets, error) {
+	return srv.SubmitServer.GetQueueBudgets(ctx, req)
+}
+
 func (srv *PulsarSubmitServer) GetQueueInfo(ctx context.Context, req *api.QueueInfoRequest) (*api.QueueInfo, error) {
 	return srv.SubmitServer.GetQueueInfo(ctx, req)
 }
@@ -765,19 +1224,33 @@ func (srv *PulsarSubmitServer) storeOriginalJobIds(ctx *armadacontext.Context, a
 // All jobs in a gang (i.e., set of jobs to be gang-scheduled) are assigned to the same scheduler.
 // Gangs that could only be scheduled by one scheduler are assigned to that scheduler.
 // Gangs that could be scheduled by either are assigned to a randomly selected scheduler.
-// If any gang could not be scheduled by either scheduler, an error is returned.
+// If any gang could not be scheduled by either scheduler, an error is returned, along with a response item for each
+// job of such gangs explaining why.
 //
-// Returns a map from job id to the scheduler the job with that id is assigned to.
-func (srv *PulsarSubmitServer) assignScheduler(jobs []*api.Job) (map[string]schedulers.Scheduler, error) {
+// Returns a map from job id to the scheduler the job with that id is assigned to; jobs of gangs that could not be
+// scheduled by either scheduler aren't assigned one.
+func (srv *PulsarSubmitServer) assignScheduler(jobs []*api.Job) (map[string]schedulers.Scheduler, []*api.JobSubmitResponseItem, error) {
 	gangs := srv.groupJobsByGangId(jobs)
 	schedulerByGangId := make(map[string]schedulers.Scheduler, len(jobs))
+	var responseItems []*api.JobSubmitResponseItem
+	rejectGang := func(gangId string, gang []*api.Job, reason string) {
+		prefix := fmt.Sprintf("gang %s unschedulable: ", gangId)
+		if len(gang) == 1 {
+			prefix = fmt.Sprintf("job %s unschedulable: ", gang[0].Id)
+		}
+		for _, job := range gang {
+			responseItems = append(responseItems, api.NewFailedJobSubmitResponseItem(job.Id, api.JobSubmitError_UNSCHEDULABLE, "", prefix+reason))
+		}
+	}
+	// Scheduling info is only needed for cluster-targeted gangs.
+	var activeClusterSchedulingInfo map[string]*api.ClusterSchedulingInfoReport
 	for gangId, gang := range gangs {
 		if len(gang) == 0 {
 			continue
 		}
 		for i, job := range gang {
 			if job == nil {
-				return nil, &armadaerrors.ErrInvalidArgument{
+				return nil, nil, &armadaerrors.ErrInvalidArgument{
 					Name:    fmt.Sprintf("gang[%d}", i),
 					Value:   job,
 					Message: fmt.Sprintf("unexpected nil job in gang %s", gangId),
@@ -785,6 +1258,32 @@ func (srv *PulsarSubmitServer) assignScheduler(jobs []*api.Job) (map[string]sche
 			}
 		}
 
+		// Cluster targeting is only supported by the legacy scheduler, so targeted gangs must be schedulable by it,
+		// on the clusters they target.
+		if isClusterTargetedGang(gang) {
+			if activeClusterSchedulingInfo == nil && !srv.IgnoreJobSubmitChecks {
+				allClusterSchedulingInfo, err := srv.SubmitServer.schedulingInfoRepository.GetClusterSchedulingInfo()
+				if err != nil {
+					return nil, nil, status.Errorf(codes.Unavailable, "[SubmitJobs] error getting scheduling info: %s", err)
+				}
+				activeClusterSchedulingInfo = scheduling.FilterActiveClusterSchedulingInfoReports(allClusterSchedulingInfo)
+			}
+			if schedulable, message := srv.schedulableOnTargetedClusters(gang, activeClusterSchedulingInfo); !schedulable {
+				rejectGang(gangId, gang, fmt.Sprintf("failed to schedule onto legacy scheduler because %s", message))
+				continue
+			}
+			schedulerByGangId[gangId] = schedulers.Legacy
+			continue
+		}
+
+		// Barriers, job set concurrency limits, submission window holds, retry policies,
+		// requeueing evicted preemptible jobs, and maximum runtimes are only supported by the legacy scheduler.
+		if isBarrierGang(gang) || isThrottledGang(gang) || isHeldGang(gang) || isRetriedGang(gang) ||
+			isPreemptibleGang(gang) || isRuntimeLimitedGang(gang) {
+			schedulerByGangId[gangId] = schedulers.Legacy
+			continue
+		}
+
 		// If the first job in the gang explicitly targets either scheduler, assign to that scheduler.
 		if jobs[0].Scheduler == "pulsar" {
 			schedulerByGangId[gangId] = schedulers.Pulsar
@@ -820,14 +1319,9 @@ func (srv *PulsarSubmitServer) assignScheduler(jobs []*api.Job) (map[string]sche
 			schedulerByGangId[gangId] = secondaryScheduler
 			continue
 		} else {
-			// Not schedulable by either scheduler; return an error.
+			// Not schedulable by either scheduler; reject each job of the gang.
 			unschedulableReasonByScheduler[secondaryScheduler] = message
 			var sb strings.Builder
-			if len(gang) == 1 {
-				sb.WriteString(fmt.Sprintf("job %s unschedulable: ", gang[0].Id))
-			} else {
-				sb.WriteString(fmt.Sprintf("gang %s unschedulable: ", gangId))
-			}
 			sb.WriteString(fmt.Sprintf(
 				"failed to schedule onto legacy scheduler because %s",
 				unschedulableReasonByScheduler[schedulers.Legacy],
@@ -838,16 +1332,50 @@ func (srv *PulsarSubmitServer) assignScheduler(jobs []*api.Job) (map[string]sche
 					unschedulableReasonByScheduler[schedulers.Pulsar],
 				))
 			}
-			return nil, errors.New(sb.String())
+			rejectGang(gangId, gang, sb.String())
 		}
 	}
 	schedulerByJobId := make(map[string]schedulers.Scheduler, len(jobs))
 	for gangId, gang := range gangs {
-		for _, job := range gang {
-			schedulerByJobId[job.Id] = schedulerByGangId[gangId]
+		if scheduler, ok := schedulerByGangId[gangId]; ok {
+			for _, job := range gang {
+				schedulerByJobId[job.Id] = scheduler
+			}
+		}
+	}
+	if len(responseItems) > 0 {
+		// Gangs are visited in random order; rejections are reported in the order the jobs were submitted.
+		indexByJobId := make(map[string]int, len(jobs))
+		for i, job := range jobs {
+			indexByJobId[job.Id] = i
 		}
+		sort.Slice(responseItems, func(i, j int) bool {
+			return indexByJobId[responseItems[i].JobId] < indexByJobId[responseItems[j].JobId]
+		})
+		return schedulerByJobId, responseItems, errors.New(responseItems[0].Error)
 	}
-	return schedulerByJobId, nil
+	return schedulerByJobId, nil, nil
+}
+
+// explainUnschedulableJobs sets the unschedulable reasons of the response items of jobs no scheduler can schedule,
+// explaining for each active cluster why the job can't be scheduled on it, as the legacy submit path does.
+func (srv *PulsarSubmitServer) explainUnschedulableJobs(jobs []*api.Job, responseItems []*api.JobSubmitResponseItem) error {
+	allClusterSchedulingInfo, err := srv.SubmitServer.schedulingInfoRepository.GetClusterSchedulingInfo()
+	if err != nil {
+		return err
+	}
+	activeClusterSchedulingInfo := scheduling.FilterActiveClusterSchedulingInfoReports(allClusterSchedulingInfo)
+	activePoolByClusterId := poolByClusterId(activeClusterSchedulingInfo)
+	jobsById := make(map[string]*api.Job, len(jobs))
+	for _, job := range jobs {
+		jobsById[job.Id] = job
+	}
+	for _, item := range responseItems {
+		if job, ok := jobsById[item.JobId]; ok {
+			_, _, item.UnschedulableReasons = explainUnschedulableJob(job, activeClusterSchedulingInfo, activePoolByClusterId)
+		}
+	}
+	return nil
 }
 
 func (srv *PulsarSubmitServer) schedulableOnScheduler(scheduler schedulers.Scheduler, gang []*api.Job) (bool, string) {
@@ -867,6 +1395,21 @@ func (srv *PulsarSubmitServer) schedulableOnLegacyScheduler(gang []*api.Job) (bo
 	return srv.LegacySchedulerSubmitChecker.CheckApiJobs(gang)
 }
 
+// schedulableOnTargetedClusters returns true if each job of the cluster-targeted gang fits on an active cluster it
+// targets and the legacy scheduler could schedule the gang; otherwise, it also returns why not.
+func (srv *PulsarSubmitServer) schedulableOnTargetedClusters(gang []*api.Job, activeClusterSchedulingInfo map[string]*api.ClusterSchedulingInfoReport) (bool, string) {
+	if srv.IgnoreJobSubmitChecks {
+		return true, ""
+	}
+	activePoolByClusterId := poolByClusterId(activeClusterSchedulingInfo)
+	for i, job := range gang {
+		if _, explanation, _ := explainUnschedulableJob(job, activeClusterSchedulingInfo, activePoolByClusterId); explanation != "" {
+			return false, fmt.Sprintf("%d-th job can't be scheduled on the clusters it targets: %s", i, explanation)
+		}
+	}
+	return srv.schedulableOnLegacyScheduler(gang)
+}
+
 func (srv *PulsarSubmitServer) schedulableOnPulsarScheduler(gang []*api.Job) (bool, string) {
 	if !srv.PulsarSchedulerEnabled {
 		return false, "Pulsar scheduler disabled"
@@ -891,6 +1434,80 @@ func (srv *PulsarSubmitServer) groupJobsByGangId(jobs []*api.Job) map[string][]*
 	return jobsByGangId
 }
 
+// isBarrierGang returns true if any job in the gang is a member of a barrier.
+func isBarrierGang(gang []*api.Job) bool {
+	for _, job := range gang {
+		if _, ok := job.Annotations[armadaconfiguration.BarrierIdAnnotation]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// isThrottledGang returns true if any job in the gang belongs to a job set with a limit on
+// the number of, or resources of, concurrently running jobs.
+func isThrottledGang(gang []*api.Job) bool {
+	for _, job := range gang {
+		if _, ok := job.Annotations[armadaconfiguration.MaxConcurrentJobsAnnotation]; ok {
+			return true
+		}
+		if _, ok := job.Annotations[armadaconfiguration.JobSetResourceLimitsAnnotation]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// isHeldGang returns true if any job in the gang is held until the next submission window of its queue.
+func isHeldGang(gang []*api.Job) bool {
+	for _, job := range gang {
+		if _, ok := job.Annotations[armadaconfiguration.HeldUntilAnnotation]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// isRetriedGang returns true if any job in the gang has a retry policy.
+func isRetriedGang(gang []*api.Job) bool {
+	for _, job := range gang {
+		if _, ok := job.Annotations[armadaconfiguration.RetryMaxAttemptsAnnotation]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// isPreemptibleGang returns true if any job in the gang was submitted as preemptible.
+func isPreemptibleGang(gang []*api.Job) bool {
+	for _, job := range gang {
+		if job.Annotations[armadaconfiguration.PreemptibleAnnotation] == "true" {
+			return true
+		}
+	}
+	return false
+}
+
+// isRuntimeLimitedGang returns true if any job in the gang has a maximum runtime.
+func isRuntimeLimitedGang(gang []*api.Job) bool {
+	for _, job := range gang {
+		if _, ok := job.Annotations[armadaconfiguration.MaxRuntimeSecondsAnnotation]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// isClusterTargetedGang returns true if any job in the gang targets clusters.
+func isClusterTargetedGang(gang []*api.Job) bool {
+	return slices.IndexFunc(gang, isClusterTargetedJob) >= 0
+}
+
+// isClusterTargetedJob returns true if job targets any clusters.
+func isClusterTargetedJob(job *api.Job) bool {
+	return !clusterTargetsFromAnnotations(job.Annotations).isEmpty()
+}
+
 // resolveQueueAndJobsetForJob returns the queue and jobset for a job.
 // First we check the legacy scheduler jobs and then (if no job resolved and pulsar scheduler enabled) we check
 // the pulsar scheduler jobs.
@@ -921,3 +1538,59 @@ func (srv *PulsarSubmitServer) resolveQueueAndJobsetForJob(jobId string) (string
 		Value: jobId,
 	}
 }
+
+func (srv *PulsarSubmitServer) GetFairShareWeights(ctx context.Context, req *api.FairShareWeightsRequest) (*api.FairShareWeights, error) {
+	return srv.SubmitServer.GetFairShareWeights(ctx, req)
+}
+
+func (srv *PulsarSubmitServer) SetFairShareWeight(ctx context.Context, req *api.SetFairShareWeightRequest) (*api.QueueFairShareWeight, error) {
+	return srv.SubmitServer.SetFairShareWeight(ctx, req)
+}
+
+func (srv *PulsarSubmitServer) GetFairShares(ctx context.Context, req *api.FairSharesRequest) (*api.FairShares, error) {
+	return srv.SubmitServer.GetFairShares(ctx, req)
+}
+
+func (srv *PulsarSubmitServer) CordonQueue(ctx context.Context, req *api.CordonRequest) (*api.Cordon, error) {
+	return srv.SubmitServer.CordonQueue(ctx, req)
+}
+
+func (srv *PulsarSubmitServer) UncordonQueue(ctx context.Context, req *api.UncordonRequest) (*types.Empty, error) {
+	return srv.SubmitServer.UncordonQueue(ctx, req)
+}
+
+func (srv *PulsarSubmitServer) CordonExecutor(ctx context.Context, req *api.CordonRequest) (*api.Cordon, error) {
+	return srv.SubmitServer.CordonExecutor(ctx, req)
+}
+
+func (srv *PulsarSubmitServer) UncordonExecutor(ctx context.Context, req *api.UncordonRequest) (*types.Empty, error) {
+	return srv.SubmitServer.UncordonExecutor(ctx, req)
+}
+
+func (srv *PulsarSubmitServer) GetCordons(ctx context.Context, req *api.CordonListRequest) (*api.CordonList, error) {
+	return srv.SubmitServer.GetCordons(ctx, req)
+}
+
+func (srv *PulsarSubmitServer) CreateMaintenanceWindow(ctx context.Context, req *api.MaintenanceWindowCreateRequest) (*api.MaintenanceWindow, error) {
+	return srv.SubmitServer.CreateMaintenanceWindow(ctx, req)
+}
+
+func (srv *PulsarSubmitServer) GetMaintenanceWindows(ctx context.Context, req *api.MaintenanceWindowListRequest) (*api.MaintenanceWindowList, error) {
+	return srv.SubmitServer.GetMaintenanceWindows(ctx, req)
+}
+
+func (srv *PulsarSubmitServer) DeleteMaintenanceWindow(ctx context.Context, req *api.MaintenanceWindowDeleteRequest) (*types.Empty, error) {
+	return srv.SubmitServer.DeleteMaintenanceWindow(ctx, req)
+}
+
+func (srv *PulsarSubmitServer) GetExecutors(ctx context.Context, req *api.ExecutorListRequest) (*api.ExecutorList, error) {
+	return srv.SubmitServer.GetExecutors(ctx, req)
+}
+
+func (srv *PulsarSubmitServer) GetExecutor(ctx context.Context, req *api.ExecutorGetRequest) (*api.ExecutorStatus, error) {
+	return srv.SubmitServer.GetExecutor(ctx, req)
+}
+
+func (srv *PulsarSubmitServer) GetJobLogs(req *api.JobLogsRequest, stream api.Submit_GetJobLogsServer) error {
+	return srv.SubmitServer.GetJobLogs(req, stream)
+}
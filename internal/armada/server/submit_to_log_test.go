@@ -0,0 +1,336 @@
+package server
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/apache/pulsar-client-go/pulsar"
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/status"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"k8s.io/apimachinery/pkg/api/resource"
+	clock "k8s.io/utils/clock/testing"
+
+	"github.com/armadaproject/armada/internal/armada/repository"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/armadaerrors"
+	"github.com/armadaproject/armada/internal/common/auth/authorization"
+	"github.com/armadaproject/armada/internal/common/auth/permission"
+	"github.com/armadaproject/armada/internal/common/mocks"
+	"github.com/armadaproject/armada/internal/common/pulsarutils"
+	armadaresource "github.com/armadaproject/armada/internal/common/resource"
+	"github.com/armadaproject/armada/internal/common/util"
+	"github.com/armadaproject/armada/internal/scheduler"
+	schedulermocks "github.com/armadaproject/armada/internal/scheduler/mocks"
+	"github.com/armadaproject/armada/internal/scheduler/schedulerobjects"
+	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/armadaevents"
+	"github.com/armadaproject/armada/pkg/client/queue"
+)
+
+func TestPulsarSubmitServer_OwnersCanManageOwnJobs(t *testing.T) {
+	withPulsarSubmitServerOfOwnersQueue(t, func(srv *PulsarSubmitServer, published *[]*armadaevents.EventSequence) {
+		aliceJob := &api.Job{Id: util.NewULID(), Queue: "owners", JobSetId: "alice-set", Owner: "alice", Priority: 1}
+		bobJob := &api.Job{Id: util.NewULID(), Queue: "owners", JobSetId: "bob-set", Owner: "bob", Priority: 1}
+		_, err := srv.SubmitServer.jobRepository.AddJobs([]*api.Job{aliceJob, bobJob})
+		require.NoError(t, err)
+		alicePulsarJobId := util.NewULID()
+		bobPulsarJobId := util.NewULID()
+		err = srv.SubmitServer.jobRepository.StorePulsarSchedulerJobDetails([]*schedulerobjects.PulsarSchedulerJobDetails{
+			{JobId: alicePulsarJobId, Queue: "owners", JobSet: "alice-set", Owner: "alice"},
+			{JobId: bobPulsarJobId, Queue: "owners", JobSet: "bob-set", Owner: "bob"},
+		})
+		require.NoError(t, err)
+		ctx := authorization.WithPrincipal(context.Background(), authorization.NewStaticPrincipal("alice", nil))
+
+		// Jobs of both schedulers owned by the user may be cancelled and reprioritized.
+		_, err = srv.CancelJobs(ctx, &api.JobCancelRequest{JobId: aliceJob.Id})
+		assert.NoError(t, err)
+		_, err = srv.CancelJobs(ctx, &api.JobCancelRequest{Queue: "owners", JobSetId: "alice-set", JobIds: []string{aliceJob.Id, alicePulsarJobId}})
+		assert.NoError(t, err)
+		_, err = srv.ReprioritizeJobs(ctx, &api.JobReprioritizeRequest{Queue: "owners", JobSetId: "alice-set", JobIds: []string{aliceJob.Id, alicePulsarJobId}, NewPriority: 2})
+		assert.NoError(t, err)
+		assert.Len(t, *published, 3)
+
+		// Jobs of other users may not, including together with own jobs.
+		assertUnauthorized(t, func() error {
+			_, err := srv.CancelJobs(ctx, &api.JobCancelRequest{JobId: bobJob.Id})
+			return err
+		})
+		assertUnauthorized(t, func() error {
+			_, err := srv.CancelJobs(ctx, &api.JobCancelRequest{Queue: "owners", JobSetId: "bob-set", JobIds: []string{aliceJob.Id, bobPulsarJobId}})
+			return err
+		})
+		assertUnauthorized(t, func() error {
+			_, err := srv.ReprioritizeJobs(ctx, &api.JobReprioritizeRequest{JobIds: []string{bobPulsarJobId}, NewPriority: 2})
+			return err
+		})
+		// Jobs that don't exist aren't owned by anyone.
+		assertUnauthorized(t, func() error {
+			_, err := srv.CancelJobs(ctx, &api.JobCancelRequest{Queue: "owners", JobSetId: "alice-set", JobIds: []string{util.NewULID()}})
+			return err
+		})
+
+		// Entire job sets may not be managed, since the owners of the jobs of the Pulsar scheduler aren't known.
+		assertUnauthorized(t, func() error {
+			_, err := srv.CancelJobSet(ctx, &api.JobSetCancelRequest{Queue: "owners", JobSetId: "alice-set"})
+			return err
+		})
+		assertUnauthorized(t, func() error {
+			_, err := srv.ReprioritizeJobs(ctx, &api.JobReprioritizeRequest{Queue: "owners", JobSetId: "alice-set", NewPriority: 2})
+			return err
+		})
+		assert.Len(t, *published, 3)
+	})
+}
+
+func TestPulsarSubmitServer_OwnersCanManageOwnJobSets_WithoutPulsarScheduler(t *testing.T) {
+	withPulsarSubmitServerOfOwnersQueue(t, func(srv *PulsarSubmitServer, published *[]*armadaevents.EventSequence) {
+		srv.PulsarSchedulerEnabled = false
+		aliceJob := &api.Job{Id: util.NewULID(), Queue: "owners", JobSetId: "alice-set", Owner: "alice", Priority: 1}
+		bobJob := &api.Job{Id: util.NewULID(), Queue: "owners", JobSetId: "shared-set", Owner: "bob", Priority: 1}
+		otherAliceJob := &api.Job{Id: util.NewULID(), Queue: "owners", JobSetId: "shared-set", Owner: "alice", Priority: 1}
+		_, err := srv.SubmitServer.jobRepository.AddJobs([]*api.Job{aliceJob, bobJob, otherAliceJob})
+		require.NoError(t, err)
+		ctx := authorization.WithPrincipal(context.Background(), authorization.NewStaticPrincipal("alice", nil))
+
+		_, err = srv.ReprioritizeJobs(ctx, &api.JobReprioritizeRequest{Queue: "owners", JobSetId: "alice-set", NewPriority: 2})
+		assert.NoError(t, err)
+		_, err = srv.CancelJobSet(ctx, &api.JobSetCancelRequest{Queue: "owners", JobSetId: "alice-set"})
+		assert.NoError(t, err)
+		assert.Len(t, *published, 2)
+
+		assertUnauthorized(t, func() error {
+			_, err := srv.CancelJobSet(ctx, &api.JobSetCancelRequest{Queue: "owners", JobSetId: "shared-set"})
+			return err
+		})
+		assertUnauthorized(t, func() error {
+			_, err := srv.ReprioritizeJobs(ctx, &api.JobReprioritizeRequest{Queue: "owners", JobSetId: "shared-set", NewPriority: 2})
+			return err
+		})
+		assert.Len(t, *published, 2)
+	})
+}
+
+func TestPulsarSubmitServer_ReprioritizeJobs_RelativeAdjustmentOfPulsarSchedulerJobs(t *testing.T) {
+	withPulsarSubmitServerOfOwnersQueue(t, func(srv *PulsarSubmitServer, published *[]*armadaevents.EventSequence) {
+		legacyJob := &api.Job{Id: util.NewULID(), Queue: "owners", JobSetId: "set", Owner: "alice", Priority: 1}
+		_, err := srv.SubmitServer.jobRepository.AddJobs([]*api.Job{legacyJob})
+		require.NoError(t, err)
+		pulsarJobId := util.NewULID()
+		err = srv.SubmitServer.jobRepository.StorePulsarSchedulerJobDetails([]*schedulerobjects.PulsarSchedulerJobDetails{
+			{JobId: pulsarJobId, Queue: "owners", JobSet: "set", Owner: "alice"},
+		})
+		require.NoError(t, err)
+		ctx := authorization.WithPrincipal(context.Background(), authorization.NewStaticPrincipal("alice", nil))
+
+		// Jobs of the Pulsar scheduler aren't skipped silently, but reported as not reprioritized.
+		resp, err := srv.ReprioritizeJobs(ctx, &api.JobReprioritizeRequest{JobIds: []string{legacyJob.Id, pulsarJobId}, PriorityDelta: 1})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{
+			legacyJob.Id: "",
+			pulsarJobId:  relativeReprioritizationNotFoundMessage,
+		}, resp.ReprioritizationResults)
+		assert.Empty(t, *published)
+
+		jobs, err := srv.SubmitServer.jobRepository.GetExistingJobsByIds([]string{legacyJob.Id})
+		require.NoError(t, err)
+		require.Len(t, jobs, 1)
+		assert.Equal(t, float64(2), jobs[0].Priority)
+	})
+}
+
+func TestPulsarSubmitServer_CancelJobSet_LabelSelector(t *testing.T) {
+	withPulsarSubmitServerOfOwnersQueue(t, func(srv *PulsarSubmitServer, published *[]*armadaevents.EventSequence) {
+		srv.SubmitServer.authorizer = &FakeActionAuthorizer{}
+		sweepA := &api.Job{Id: util.NewULID(), Queue: "owners", JobSetId: "set", Labels: map[string]string{"sweep": "a"}}
+		sweepB := &api.Job{Id: util.NewULID(), Queue: "owners", JobSetId: "set", Labels: map[string]string{"sweep": "b"}}
+		_, err := srv.SubmitServer.jobRepository.AddJobs([]*api.Job{sweepA, sweepB})
+		require.NoError(t, err)
+		req := &api.JobSetCancelRequest{Queue: "owners", JobSetId: "set", LabelSelector: map[string]string{"sweep": "a"}}
+
+		// Matching jobs of the legacy scheduler are cancelled, but callers are told that jobs of the Pulsar scheduler aren't.
+		_, err = srv.CancelJobSet(context.Background(), req)
+		assert.Equal(t, codes.Unimplemented, status.Code(err))
+		require.Len(t, *published, 1)
+		require.Len(t, (*published)[0].Events, 1)
+		cancelledId, err := armadaevents.UlidStringFromProtoUuid((*published)[0].Events[0].GetCancelJob().JobId)
+		require.NoError(t, err)
+		assert.Equal(t, sweepA.Id, cancelledId)
+
+		// Without the Pulsar scheduler, all matching jobs are cancelled.
+		srv.PulsarSchedulerEnabled = false
+		_, err = srv.CancelJobSet(context.Background(), req)
+		assert.NoError(t, err)
+	})
+}
+
+func TestPulsarSubmitServer_SubmitJobs_ExplainsPerClusterWhyJobsCannotBeScheduled(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
+		err := s.schedulingInfoRepository.UpdateClusterSchedulingInfo(&api.ClusterSchedulingInfoReport{
+			ClusterId:  "test-cluster",
+			ReportTime: time.Now(),
+			NodeTypes: []*api.NodeType{{
+				AllocatableResources: armadaresource.ComputeResources{"cpu": resource.MustParse("500m"), "memory": resource.MustParse("1Gi")},
+			}},
+		})
+		require.NoError(t, err)
+		ctrl := gomock.NewController(t)
+		srv := &PulsarSubmitServer{
+			Producer:        mocks.NewMockProducer(ctrl),
+			QueueRepository: s.queueRepository,
+			SubmitServer:    s,
+			// No executor has reported to the scheduler, so no job is schedulable.
+			LegacySchedulerSubmitChecker: scheduler.NewSubmitChecker(time.Minute, *s.schedulingConfig, schedulermocks.NewMockExecutorRepository(ctrl)),
+			MaxAllowedMessageSize:        4 * 1024 * 1024,
+			Rand:                         rand.New(rand.NewSource(0)),
+		}
+
+		req := createJobRequest(util.NewULID(), 2)
+		_, err = srv.SubmitJobs(context.Background(), req)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Equal(t, []api.JobSubmitError_Code{api.JobSubmitError_UNSCHEDULABLE, api.JobSubmitError_UNSCHEDULABLE}, jobSubmitErrorCodes(err))
+		for _, detail := range status.Convert(err).Details() {
+			response, ok := detail.(*api.JobSubmitResponse)
+			require.True(t, ok)
+			for _, item := range response.JobResponseItems {
+				assert.Contains(t, item.Error, "failed to schedule onto legacy scheduler")
+				require.Len(t, item.UnschedulableReasons, 1)
+				assert.Equal(t, "test-cluster", item.UnschedulableReasons[0].ClusterId)
+				require.Len(t, item.UnschedulableReasons[0].Reasons, 1)
+				assert.Equal(t, api.UnschedulableReason_EXCEEDS_MAX_JOB_SIZE, item.UnschedulableReasons[0].Reasons[0].Code)
+			}
+		}
+	})
+}
+
+func TestPulsarSubmitServer_SubmitJobs_RejectsJobsUnschedulableOnTheClustersTheyTarget(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
+		err := s.schedulingInfoRepository.UpdateClusterSchedulingInfo(&api.ClusterSchedulingInfoReport{
+			ClusterId:  "small-cluster",
+			ReportTime: time.Now(),
+			NodeTypes: []*api.NodeType{{
+				AllocatableResources: armadaresource.ComputeResources{"cpu": resource.MustParse("500m"), "memory": resource.MustParse("1Gi")},
+			}},
+		})
+		require.NoError(t, err)
+		srv := &PulsarSubmitServer{
+			Producer:              mocks.NewMockProducer(gomock.NewController(t)),
+			QueueRepository:       s.queueRepository,
+			SubmitServer:          s,
+			MaxAllowedMessageSize: 4 * 1024 * 1024,
+			Rand:                  rand.New(rand.NewSource(0)),
+		}
+
+		// The job fits on test-cluster, but it may only run on small-cluster.
+		req := createJobRequest(util.NewULID(), 1)
+		req.JobRequestItems[0].ClusterTargeting = &api.ClusterTargeting{Required: []string{"small-cluster"}}
+		_, err = srv.SubmitJobs(context.Background(), req)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Equal(t, []api.JobSubmitError_Code{api.JobSubmitError_UNSCHEDULABLE}, jobSubmitErrorCodes(err))
+		for _, detail := range status.Convert(err).Details() {
+			response, ok := detail.(*api.JobSubmitResponse)
+			require.True(t, ok)
+			require.Len(t, response.JobResponseItems, 1)
+			assert.Contains(t, response.JobResponseItems[0].Error, "can't be scheduled on the clusters it targets")
+			require.Len(t, response.JobResponseItems[0].UnschedulableReasons, 1)
+			assert.Equal(t, "small-cluster", response.JobResponseItems[0].UnschedulableReasons[0].ClusterId)
+		}
+	})
+}
+
+func TestPulsarSubmitServer_SubmitJobs_WhenSchedulingInfoIsStale(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
+		s.schedulingConfig.SchedulingInfoStaleAfter = time.Minute
+		fakeClock := clock.NewFakeClock(time.Now())
+		s.clock = fakeClock
+		ctrl := gomock.NewController(t)
+		producer := mocks.NewMockProducer(ctrl)
+		var published []*armadaevents.EventSequence
+		producer.
+			EXPECT().
+			SendAsync(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ *armadacontext.Context, msg *pulsar.ProducerMessage, callback func(pulsar.MessageID, *pulsar.ProducerMessage, error)) {
+				es := &armadaevents.EventSequence{}
+				require.NoError(t, proto.Unmarshal(msg.Payload, es))
+				published = append(published, es)
+				callback(pulsarutils.NewMessageId(len(published)), msg, nil)
+			}).AnyTimes()
+		producer.EXPECT().Flush().Return(nil).AnyTimes()
+		srv := &PulsarSubmitServer{
+			Producer:        producer,
+			QueueRepository: s.queueRepository,
+			SubmitServer:    s,
+			// No executor has reported to the scheduler, so no job is schedulable.
+			LegacySchedulerSubmitChecker: scheduler.NewSubmitChecker(time.Minute, *s.schedulingConfig, schedulermocks.NewMockExecutorRepository(ctrl)),
+			MaxAllowedMessageSize:        4 * 1024 * 1024,
+			Rand:                         rand.New(rand.NewSource(0)),
+		}
+
+		// Jobs that appear unschedulable while no cluster has reported recently are rejected such that they may be retried.
+		fakeClock.Step(2 * time.Minute)
+		_, err := srv.SubmitJobs(context.Background(), createJobRequest(util.NewULID(), 1))
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+		assert.Equal(t, []api.JobSubmitError_Code{api.JobSubmitError_SCHEDULING_INFO_STALE}, jobSubmitErrorCodes(err))
+
+		// Or accepted with a warning by the legacy scheduler, if so configured.
+		s.schedulingConfig.AcceptJobsWithStaleSchedulingInfo = true
+		response, err := srv.SubmitJobs(context.Background(), createJobRequest(util.NewULID(), 1))
+		require.NoError(t, err)
+		require.Len(t, response.JobResponseItems, 1)
+		assert.False(t, response.JobResponseItems[0].Failed())
+		assert.Contains(t, response.JobResponseItems[0].Warning, "no cluster has reported scheduling info in the last 1m0s")
+		assert.NotEmpty(t, published)
+
+		// Once clusters have reported recently, unschedulable jobs are rejected regardless.
+		err = s.schedulingInfoRepository.UpdateClusterSchedulingInfo(&api.ClusterSchedulingInfoReport{ClusterId: "test-cluster", ReportTime: fakeClock.Now()})
+		require.NoError(t, err)
+		_, err = srv.SubmitJobs(context.Background(), createJobRequest(util.NewULID(), 1))
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Equal(t, []api.JobSubmitError_Code{api.JobSubmitError_UNSCHEDULABLE}, jobSubmitErrorCodes(err))
+	})
+}
+
+// withPulsarSubmitServerOfOwnersQueue calls action with a PulsarSubmitServer that denies all permissions, except that
+// owners may manage their own jobs of the queue "owners", and the event sequences it publishes.
+func withPulsarSubmitServerOfOwnersQueue(t *testing.T, action func(srv *PulsarSubmitServer, published *[]*armadaevents.EventSequence)) {
+	withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
+		emptyPerms := make(map[permission.Permission][]string)
+		s.authorizer = NewAuthorizer(authorization.NewPrincipalPermissionChecker(emptyPerms, emptyPerms, emptyPerms))
+		err := s.queueRepository.CreateQueue(queue.Queue{Name: "owners", PriorityFactor: 1, OwnersCanManageOwnJobs: true})
+		require.NoError(t, err)
+
+		ctrl := gomock.NewController(t)
+		producer := mocks.NewMockProducer(ctrl)
+		var published []*armadaevents.EventSequence
+		producer.
+			EXPECT().
+			SendAsync(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ *armadacontext.Context, msg *pulsar.ProducerMessage, callback func(pulsar.MessageID, *pulsar.ProducerMessage, error)) {
+				es := &armadaevents.EventSequence{}
+				require.NoError(t, proto.Unmarshal(msg.Payload, es))
+				published = append(published, es)
+				callback(pulsarutils.NewMessageId(len(published)), msg, nil)
+			}).AnyTimes()
+		producer.EXPECT().Flush().Return(nil).AnyTimes()
+		srv := &PulsarSubmitServer{
+			Producer:               producer,
+			QueueRepository:        s.queueRepository,
+			SubmitServer:           s,
+			MaxAllowedMessageSize:  4 * 1024 * 1024,
+			PulsarSchedulerEnabled: true,
+		}
+		action(srv, &published)
+	})
+}
+
+func assertUnauthorized(t *testing.T, call func() error) {
+	t.Helper()
+	var permErr *armadaerrors.ErrUnauthorized
+	assert.ErrorAs(t, call(), &permErr)
+}
@@ -0,0 +1,247 @@
+package server
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gogo/status"
+	"google.golang.org/grpc/codes"
+
+	"github.com/armadaproject/armada/internal/common/armadaerrors"
+	"github.com/armadaproject/armada/pkg/api"
+	apiv2 "github.com/armadaproject/armada/pkg/api/v2"
+)
+
+// SubmitServerV2 serves version 2 of the submit API by translating requests into calls to version 1 of the API,
+// such that both versions share a single implementation and behave identically.
+type SubmitServerV2 struct {
+	submitServer api.SubmitServer
+}
+
+func NewSubmitServerV2(submitServer api.SubmitServer) *SubmitServerV2 {
+	return &SubmitServerV2{submitServer: submitServer}
+}
+
+func (s *SubmitServerV2) SubmitJobs(ctx context.Context, req *apiv2.SubmitJobsRequest) (*apiv2.SubmitJobsResponse, error) {
+	if err := validateJobSetV2(req.Queue, req.JobSetId); err != nil {
+		return nil, err
+	}
+	if len(req.Jobs) == 0 {
+		return nil, invalidArgumentV2("jobs", "at least one job must be provided")
+	}
+	res, err := s.submitServer.SubmitJobs(ctx, submitRequestFromV2(req))
+	if err != nil {
+		return nil, errorV2(err)
+	}
+	jobIds := make([]string, len(res.JobResponseItems))
+	for i, item := range res.JobResponseItems {
+		jobIds[i] = item.JobId
+	}
+	return &apiv2.SubmitJobsResponse{JobIds: jobIds}, nil
+}
+
+func (s *SubmitServerV2) CancelJobs(ctx context.Context, req *apiv2.CancelJobsRequest) (*apiv2.CancelJobsResponse, error) {
+	if err := validateJobSetV2(req.Queue, req.JobSetId); err != nil {
+		return nil, err
+	}
+	if len(req.JobIds) == 0 {
+		return nil, invalidArgumentV2("job_ids", "at least one job id must be provided")
+	}
+	res, err := s.submitServer.CancelJobs(ctx, &api.JobCancelRequest{
+		Queue:    req.Queue,
+		JobSetId: req.JobSetId,
+		JobIds:   req.JobIds,
+		Reason:   req.Reason,
+	})
+	if err != nil {
+		return nil, errorV2(err)
+	}
+	return &apiv2.CancelJobsResponse{CancelledJobIds: res.CancelledIds}, nil
+}
+
+func (s *SubmitServerV2) CancelJobSet(ctx context.Context, req *apiv2.CancelJobSetRequest) (*apiv2.CancelJobSetResponse, error) {
+	if err := validateJobSetV2(req.Queue, req.JobSetId); err != nil {
+		return nil, err
+	}
+	_, err := s.submitServer.CancelJobSet(ctx, &api.JobSetCancelRequest{
+		Queue:    req.Queue,
+		JobSetId: req.JobSetId,
+		Reason:   req.Reason,
+	})
+	if err != nil {
+		return nil, errorV2(err)
+	}
+	return &apiv2.CancelJobSetResponse{}, nil
+}
+
+func (s *SubmitServerV2) ReprioritizeJobs(ctx context.Context, req *apiv2.ReprioritizeJobsRequest) (*apiv2.ReprioritizeJobsResponse, error) {
+	if err := validateJobSetV2(req.Queue, req.JobSetId); err != nil {
+		return nil, err
+	}
+	if len(req.JobIds) == 0 {
+		return nil, invalidArgumentV2("job_ids", "at least one job id must be provided")
+	}
+	v1Req := &api.JobReprioritizeRequest{
+		Queue:    req.Queue,
+		JobSetId: req.JobSetId,
+		JobIds:   req.JobIds,
+	}
+	switch update := req.PriorityUpdate.(type) {
+	case *apiv2.ReprioritizeJobsRequest_Priority:
+		v1Req.NewPriority = update.Priority
+	case *apiv2.ReprioritizeJobsRequest_PriorityDelta:
+		v1Req.PriorityDelta = update.PriorityDelta
+	case *apiv2.ReprioritizeJobsRequest_PriorityMultiplier:
+		v1Req.PriorityMultiplier = update.PriorityMultiplier
+	default:
+		return nil, invalidArgumentV2("priority_update", "one of priority, priority_delta, and priority_multiplier must be provided")
+	}
+	res, err := s.submitServer.ReprioritizeJobs(ctx, v1Req)
+	if err != nil {
+		return nil, errorV2(err)
+	}
+	results := make([]*apiv2.ReprioritizeJobsResult, len(req.JobIds))
+	for i, jobId := range req.JobIds {
+		result := &apiv2.ReprioritizeJobsResult{JobId: jobId}
+		if message, ok := res.ReprioritizationResults[jobId]; !ok {
+			result.Error = &apiv2.Error{
+				Code:    apiv2.ErrorCode_ERROR_CODE_NOT_FOUND,
+				Message: fmt.Sprintf("job %s not found", jobId),
+				Field:   fmt.Sprintf("job_ids[%d]", i),
+			}
+		} else if message != "" {
+			// Version 1 of the API reports failures of individual jobs as strings only.
+			result.Error = &apiv2.Error{
+				Code:    apiv2.ErrorCode_ERROR_CODE_UNKNOWN,
+				Message: message,
+				Field:   fmt.Sprintf("job_ids[%d]", i),
+			}
+		}
+		results[i] = result
+	}
+	return &apiv2.ReprioritizeJobsResponse{Results: results}, nil
+}
+
+func submitRequestFromV2(req *apiv2.SubmitJobsRequest) *api.JobSubmitRequest {
+	items := make([]*api.JobSubmitRequestItem, len(req.Jobs))
+	for i, job := range req.Jobs {
+		// Version 1 of the API deduplicates jobs by client id.
+		clientId := job.IdempotencyKey
+		if clientId == "" && req.IdempotencyKey != "" {
+			clientId = fmt.Sprintf("%s/%d", req.IdempotencyKey, i)
+		}
+		ingress := make([]*api.IngressConfig, len(job.Ingress))
+		for j, config := range job.Ingress {
+			ingress[j] = &api.IngressConfig{
+				Ports:            config.Ports,
+				Annotations:      config.Annotations,
+				TlsEnabled:       config.TlsEnabled,
+				CertName:         config.CertName,
+				UseClusterIP:     config.UseClusterIp,
+				IngressClassName: config.IngressClassName,
+			}
+			if externalDns := config.ExternalDns; externalDns != nil {
+				ingress[j].ExternalDns = &api.ExternalDnsConfig{
+					Hostnames:  externalDns.Hostnames,
+					TtlSeconds: externalDns.TtlSeconds,
+					Target:     externalDns.Target,
+				}
+			}
+		}
+		services := make([]*api.ServiceConfig, len(job.Services))
+		for j, config := range job.Services {
+			services[j] = &api.ServiceConfig{
+				Type:  serviceTypeFromV2(config.Type),
+				Ports: config.Ports,
+			}
+		}
+		items[i] = &api.JobSubmitRequestItem{
+			Priority:        job.Priority,
+			Namespace:       job.Namespace,
+			ClientId:        clientId,
+			Labels:          job.Labels,
+			Annotations:     job.Annotations,
+			PodSpecs:        job.PodSpecs,
+			Ingress:         ingress,
+			Services:        services,
+			Scheduler:       job.Scheduler,
+			QueueTtlSeconds: job.QueueTtlSeconds,
+		}
+	}
+	return &api.JobSubmitRequest{
+		Queue:           req.Queue,
+		JobSetId:        req.JobSetId,
+		JobRequestItems: items,
+	}
+}
+
+func serviceTypeFromV2(serviceType apiv2.ServiceType) api.ServiceType {
+	switch serviceType {
+	case apiv2.ServiceType_SERVICE_TYPE_HEADLESS:
+		return api.ServiceType_Headless
+	default:
+		return api.ServiceType_NodePort
+	}
+}
+
+func validateJobSetV2(queue string, jobSetId string) error {
+	if queue == "" {
+		return invalidArgumentV2("queue", "queue must be provided")
+	}
+	if jobSetId == "" {
+		return invalidArgumentV2("job_set_id", "job set id must be provided")
+	}
+	return nil
+}
+
+// invalidArgumentV2 returns the status of a v2 request with an invalid field.
+func invalidArgumentV2(field string, format string, args ...interface{}) error {
+	message := fmt.Sprintf(format, args...)
+	return statusV2(status.New(codes.InvalidArgument, message), &apiv2.Error{
+		Code:    apiv2.ErrorCode_ERROR_CODE_INVALID_ARGUMENT,
+		Message: message,
+		Field:   field,
+	})
+}
+
+// errorV2 converts err, e.g., as returned by version 1 of the API, into the status of a failed v2 request.
+// Errors of individual jobs included in the status details of failed v1 submissions are carried over.
+func errorV2(err error) error {
+	st, ok := status.FromError(err)
+	if !ok {
+		st = status.New(armadaerrors.CodeFromError(err), err.Error())
+	}
+	var errs []*apiv2.Error
+	for _, detail := range st.Details() {
+		if res, ok := detail.(*api.JobSubmitResponse); ok {
+			for _, item := range res.JobResponseItems {
+				if item.Error != "" {
+					errs = append(errs, &apiv2.Error{
+						Code:    apiv2.ErrorCode_ERROR_CODE_INVALID_ARGUMENT,
+						Message: item.Error,
+						Field:   "jobs",
+					})
+				}
+			}
+		}
+	}
+	if len(errs) == 0 {
+		errs = append(errs, &apiv2.Error{Code: errorCodeV2(st.Code()), Message: st.Message()})
+	}
+	return statusV2(st, errs...)
+}
+
+func statusV2(st *status.Status, errs ...*apiv2.Error) error {
+	stWithDetails, err := status.New(st.Code(), st.Message()).WithDetails(&apiv2.ErrorDetails{Errors: errs})
+	if err != nil {
+		return st.Err()
+	}
+	return stWithDetails.Err()
+}
+
+func errorCodeV2(code codes.Code) apiv2.ErrorCode {
+	if _, ok := apiv2.ErrorCode_name[int32(code)]; ok {
+		return apiv2.ErrorCode(code)
+	}
+	return apiv2.ErrorCode_ERROR_CODE_UNKNOWN
+}
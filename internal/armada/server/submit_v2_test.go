@@ -0,0 +1,172 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gogo/status"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/armadaproject/armada/internal/common/armadaerrors"
+	"github.com/armadaproject/armada/pkg/api"
+	apiv2 "github.com/armadaproject/armada/pkg/api/v2"
+)
+
+// fakeSubmitServer records the requests made to version 1 of the submit API.
+type fakeSubmitServer struct {
+	api.UnimplementedSubmitServer
+	submitRequest       *api.JobSubmitRequest
+	cancelRequest       *api.JobCancelRequest
+	reprioritizeRequest *api.JobReprioritizeRequest
+	reprioritizeResults map[string]string
+	err                 error
+}
+
+func (s *fakeSubmitServer) SubmitJobs(_ context.Context, req *api.JobSubmitRequest) (*api.JobSubmitResponse, error) {
+	s.submitRequest = req
+	if s.err != nil {
+		return nil, s.err
+	}
+	res := &api.JobSubmitResponse{}
+	for i := range req.JobRequestItems {
+		res.JobResponseItems = append(res.JobResponseItems, &api.JobSubmitResponseItem{JobId: string(rune('a' + i))})
+	}
+	return res, nil
+}
+
+func (s *fakeSubmitServer) CancelJobs(_ context.Context, req *api.JobCancelRequest) (*api.CancellationResult, error) {
+	s.cancelRequest = req
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &api.CancellationResult{CancelledIds: req.JobIds}, nil
+}
+
+func (s *fakeSubmitServer) ReprioritizeJobs(_ context.Context, req *api.JobReprioritizeRequest) (*api.JobReprioritizeResponse, error) {
+	s.reprioritizeRequest = req
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &api.JobReprioritizeResponse{ReprioritizationResults: s.reprioritizeResults}, nil
+}
+
+func TestSubmitServerV2_SubmitJobs(t *testing.T) {
+	v1Server := &fakeSubmitServer{}
+	s := NewSubmitServerV2(v1Server)
+	podSpec := &v1.PodSpec{Containers: []v1.Container{{Name: "container"}}}
+	res, err := s.SubmitJobs(context.Background(), &apiv2.SubmitJobsRequest{
+		Queue:          "queue",
+		JobSetId:       "jobSet",
+		IdempotencyKey: "request",
+		Jobs: []*apiv2.JobSpec{
+			{
+				Priority: 1,
+				PodSpecs: []*v1.PodSpec{podSpec},
+				Services: []*apiv2.ServiceConfig{{Type: apiv2.ServiceType_SERVICE_TYPE_HEADLESS, Ports: []uint32{8080}}},
+			},
+			{IdempotencyKey: "job", PodSpecs: []*v1.PodSpec{podSpec}},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, res.JobIds)
+
+	req := v1Server.submitRequest
+	assert.Equal(t, "queue", req.Queue)
+	assert.Equal(t, "jobSet", req.JobSetId)
+	require.Len(t, req.JobRequestItems, 2)
+	assert.Equal(t, "request/0", req.JobRequestItems[0].ClientId)
+	assert.Equal(t, "job", req.JobRequestItems[1].ClientId)
+	assert.Equal(t, float64(1), req.JobRequestItems[0].Priority)
+	assert.Equal(t, []*v1.PodSpec{podSpec}, req.JobRequestItems[0].PodSpecs)
+	assert.Equal(t, []*api.ServiceConfig{{Type: api.ServiceType_Headless, Ports: []uint32{8080}}}, req.JobRequestItems[0].Services)
+}
+
+func TestSubmitServerV2_SubmitJobs_Errors(t *testing.T) {
+	s := NewSubmitServerV2(&fakeSubmitServer{})
+	_, err := s.SubmitJobs(context.Background(), &apiv2.SubmitJobsRequest{Queue: "queue", JobSetId: "jobSet"})
+	assertErrorV2(t, err, codes.InvalidArgument, &apiv2.Error{
+		Code:    apiv2.ErrorCode_ERROR_CODE_INVALID_ARGUMENT,
+		Message: "at least one job must be provided",
+		Field:   "jobs",
+	})
+
+	// Errors of individual jobs are carried over from the details of v1 errors.
+	st, err := status.New(codes.InvalidArgument, "invalid jobs").WithDetails(&api.JobSubmitResponse{
+		JobResponseItems: []*api.JobSubmitResponseItem{{Error: "invalid pod spec"}},
+	})
+	require.NoError(t, err)
+	s = NewSubmitServerV2(&fakeSubmitServer{err: st.Err()})
+	_, err = s.SubmitJobs(context.Background(), &apiv2.SubmitJobsRequest{Queue: "queue", JobSetId: "jobSet", Jobs: []*apiv2.JobSpec{{}}})
+	assertErrorV2(t, err, codes.InvalidArgument, &apiv2.Error{
+		Code:    apiv2.ErrorCode_ERROR_CODE_INVALID_ARGUMENT,
+		Message: "invalid pod spec",
+		Field:   "jobs",
+	})
+
+	// Errors that aren't gRPC statuses are mapped to the corresponding code.
+	s = NewSubmitServerV2(&fakeSubmitServer{err: &armadaerrors.ErrNotFound{Type: "queue", Value: "queue"}})
+	_, err = s.SubmitJobs(context.Background(), &apiv2.SubmitJobsRequest{Queue: "queue", JobSetId: "jobSet", Jobs: []*apiv2.JobSpec{{}}})
+	assertErrorV2(t, err, codes.NotFound, &apiv2.Error{
+		Code:    apiv2.ErrorCode_ERROR_CODE_NOT_FOUND,
+		Message: `resource "queue" of type "queue" does not exist`,
+	})
+}
+
+func TestSubmitServerV2_CancelJobs(t *testing.T) {
+	v1Server := &fakeSubmitServer{}
+	s := NewSubmitServerV2(v1Server)
+	res, err := s.CancelJobs(context.Background(), &apiv2.CancelJobsRequest{
+		Queue:    "queue",
+		JobSetId: "jobSet",
+		JobIds:   []string{"a", "b"},
+		Reason:   "reason",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, res.CancelledJobIds)
+	assert.Equal(t, &api.JobCancelRequest{Queue: "queue", JobSetId: "jobSet", JobIds: []string{"a", "b"}, Reason: "reason"}, v1Server.cancelRequest)
+
+	_, err = s.CancelJobs(context.Background(), &apiv2.CancelJobsRequest{JobSetId: "jobSet", JobIds: []string{"a"}})
+	assertErrorV2(t, err, codes.InvalidArgument, &apiv2.Error{
+		Code:    apiv2.ErrorCode_ERROR_CODE_INVALID_ARGUMENT,
+		Message: "queue must be provided",
+		Field:   "queue",
+	})
+}
+
+func TestSubmitServerV2_ReprioritizeJobs(t *testing.T) {
+	v1Server := &fakeSubmitServer{reprioritizeResults: map[string]string{"a": "", "b": "lost lock"}}
+	s := NewSubmitServerV2(v1Server)
+	res, err := s.ReprioritizeJobs(context.Background(), &apiv2.ReprioritizeJobsRequest{
+		Queue:          "queue",
+		JobSetId:       "jobSet",
+		JobIds:         []string{"a", "b", "c"},
+		PriorityUpdate: &apiv2.ReprioritizeJobsRequest_PriorityDelta{PriorityDelta: 2},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, float64(2), v1Server.reprioritizeRequest.PriorityDelta)
+	assert.Equal(t, float64(0), v1Server.reprioritizeRequest.NewPriority)
+	assert.Equal(t, []*apiv2.ReprioritizeJobsResult{
+		{JobId: "a"},
+		{JobId: "b", Error: &apiv2.Error{Code: apiv2.ErrorCode_ERROR_CODE_UNKNOWN, Message: "lost lock", Field: "job_ids[1]"}},
+		{JobId: "c", Error: &apiv2.Error{Code: apiv2.ErrorCode_ERROR_CODE_NOT_FOUND, Message: "job c not found", Field: "job_ids[2]"}},
+	}, res.Results)
+
+	_, err = s.ReprioritizeJobs(context.Background(), &apiv2.ReprioritizeJobsRequest{Queue: "queue", JobSetId: "jobSet", JobIds: []string{"a"}})
+	assertErrorV2(t, err, codes.InvalidArgument, &apiv2.Error{
+		Code:    apiv2.ErrorCode_ERROR_CODE_INVALID_ARGUMENT,
+		Message: "one of priority, priority_delta, and priority_multiplier must be provided",
+		Field:   "priority_update",
+	})
+}
+
+func assertErrorV2(t *testing.T, err error, expectedCode codes.Code, expectedErrors ...*apiv2.Error) {
+	st := status.Convert(err)
+	assert.Equal(t, expectedCode, st.Code())
+	require.Len(t, st.Details(), 1)
+	details, ok := st.Details()[0].(*apiv2.ErrorDetails)
+	require.True(t, ok)
+	assert.Equal(t, expectedErrors, details.Errors)
+}
@@ -0,0 +1,239 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/gogo/status"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/internal/armada/permissions"
+	"github.com/armadaproject/armada/internal/armada/repository"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/armadaerrors"
+	armadaresource "github.com/armadaproject/armada/internal/common/resource"
+	"github.com/armadaproject/armada/internal/common/util"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+// Maximum number of submit path errors included in a SyntheticLoadReport.
+const maxReportedLoadErrors = 10
+
+// Maximum number of jobs submitted per SubmitJobs call of GenerateLoad,
+// such that the items of a call are never allocated for an entire run at once.
+const maxSyntheticLoadBatchSize = 1000
+
+// TestModeServer generates synthetic load against a sandbox queue and injects faults into the server repositories.
+// Load is submitted through the same SubmitServer that serves users,
+// such that the submit path is exercised exactly as it would be by tenants.
+type TestModeServer struct {
+	authorizer       ActionAuthorizer
+	submitServer     api.SubmitServer
+	faultInjector    *repository.FaultInjector
+	config           *configuration.TestModeConfig
+	defaultJobLimits armadaresource.ComputeResources
+}
+
+func NewTestModeServer(
+	authorizer ActionAuthorizer,
+	submitServer api.SubmitServer,
+	faultInjector *repository.FaultInjector,
+	config *configuration.TestModeConfig,
+	defaultJobLimits armadaresource.ComputeResources,
+) *TestModeServer {
+	return &TestModeServer{
+		authorizer:       authorizer,
+		submitServer:     submitServer,
+		faultInjector:    faultInjector,
+		config:           config,
+		defaultJobLimits: defaultJobLimits,
+	}
+}
+
+func (s *TestModeServer) GenerateLoad(grpcCtx context.Context, req *api.SyntheticLoadRequest) (*api.SyntheticLoadReport, error) {
+	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+	if err := s.authorize(ctx, "GenerateLoad"); err != nil {
+		return nil, err
+	}
+
+	queueName := req.Queue
+	if queueName == "" {
+		queueName = s.config.SandboxQueue
+	}
+	if queueName == "" || queueName != s.config.SandboxQueue {
+		return nil, status.Errorf(codes.InvalidArgument, "[GenerateLoad] synthetic load may only be submitted to the sandbox queue %q", s.config.SandboxQueue)
+	}
+	if req.NumJobs == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "[GenerateLoad] numJobs must be positive")
+	}
+	if req.NumJobs > s.config.MaxJobsPerRun {
+		return nil, status.Errorf(codes.InvalidArgument, "[GenerateLoad] numJobs %d exceeds the limit of %d jobs per run", req.NumJobs, s.config.MaxJobsPerRun)
+	}
+	if req.BatchSize > maxSyntheticLoadBatchSize {
+		return nil, status.Errorf(codes.InvalidArgument, "[GenerateLoad] batchSize %d exceeds the limit of %d jobs per call", req.BatchSize, maxSyntheticLoadBatchSize)
+	}
+	if req.CancelFraction < 0 || req.CancelFraction > 1 {
+		return nil, status.Errorf(codes.InvalidArgument, "[GenerateLoad] cancelFraction must be in [0, 1], but is %f", req.CancelFraction)
+	}
+
+	jobSetId := req.JobSetId
+	if jobSetId == "" {
+		jobSetId = fmt.Sprintf("synthetic-load-%s", util.NewULID())
+	}
+	batchSize := uint64(req.BatchSize)
+	if batchSize == 0 {
+		batchSize = uint64(req.NumJobs)
+		if batchSize > maxSyntheticLoadBatchSize {
+			batchSize = maxSyntheticLoadBatchSize
+		}
+	}
+	resources := armadaresource.ComputeResources(req.JobResources)
+	if len(resources) == 0 {
+		resources = s.defaultJobLimits
+	}
+
+	report := &api.SyntheticLoadReport{
+		Queue:    queueName,
+		JobSetId: jobSetId,
+	}
+	start := time.Now()
+	var submittedIds []string
+	var totalLatency time.Duration
+	numCalls := 0
+	// Processed jobs are counted in 64 bits, such that the count can't overflow past the last batch.
+	for numProcessed := uint64(0); numProcessed < uint64(req.NumJobs) && ctx.Err() == nil; numProcessed += batchSize {
+		n := batchSize
+		if remaining := uint64(req.NumJobs) - numProcessed; remaining < n {
+			n = remaining
+		}
+
+		callStart := time.Now()
+		resp, err := s.submitServer.SubmitJobs(ctx, &api.JobSubmitRequest{
+			Queue:           queueName,
+			JobSetId:        jobSetId,
+			JobRequestItems: syntheticJobRequestItems(int(n), resources),
+		})
+		latency := time.Since(callStart)
+		totalLatency += latency
+		numCalls++
+		if latency > report.MaxSubmitLatency {
+			report.MaxSubmitLatency = latency
+		}
+
+		if err != nil {
+			report.JobsFailed += uint32(n)
+			report.Errors = appendLoadError(report.Errors, err.Error())
+			continue
+		}
+		for _, item := range resp.JobResponseItems {
+			if item.Error != "" {
+				report.JobsFailed++
+				report.Errors = appendLoadError(report.Errors, item.Error)
+			} else {
+				report.JobsSubmitted++
+				submittedIds = append(submittedIds, item.JobId)
+			}
+		}
+	}
+
+	numToCancel := int(math.Round(float64(len(submittedIds)) * req.CancelFraction))
+	for _, jobId := range submittedIds[:numToCancel] {
+		if ctx.Err() != nil {
+			break
+		}
+		result, err := s.submitServer.CancelJobs(ctx, &api.JobCancelRequest{
+			JobId:  jobId,
+			Reason: "cancelled by synthetic load generator",
+		})
+		if err != nil {
+			report.Errors = appendLoadError(report.Errors, err.Error())
+			continue
+		}
+		report.JobsCancelled += uint32(len(result.CancelledIds))
+	}
+
+	report.Elapsed = time.Since(start)
+	if numCalls > 0 {
+		report.MeanSubmitLatency = totalLatency / time.Duration(numCalls)
+	}
+	return report, nil
+}
+
+func (s *TestModeServer) InjectFaults(grpcCtx context.Context, req *api.FaultInjectionRequest) (*types.Empty, error) {
+	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+	if err := s.authorize(ctx, "InjectFaults"); err != nil {
+		return nil, err
+	}
+	for _, fault := range req.Faults {
+		if fault.ErrorProbability < 0 || fault.ErrorProbability > 1 {
+			return nil, status.Errorf(codes.InvalidArgument, "[InjectFaults] errorProbability for %s must be in [0, 1], but is %f", fault.Target, fault.ErrorProbability)
+		}
+		if fault.Latency < 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "[InjectFaults] latency for %s must be non-negative, but is %s", fault.Target, fault.Latency)
+		}
+	}
+	s.faultInjector.SetFaults(req.Faults)
+	return &types.Empty{}, nil
+}
+
+func (s *TestModeServer) ClearFaults(grpcCtx context.Context, _ *types.Empty) (*types.Empty, error) {
+	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+	if err := s.authorize(ctx, "ClearFaults"); err != nil {
+		return nil, err
+	}
+	s.faultInjector.Clear()
+	return &types.Empty{}, nil
+}
+
+func (s *TestModeServer) authorize(ctx *armadacontext.Context, method string) error {
+	if !s.config.Enabled {
+		return status.Errorf(codes.FailedPrecondition, "[%s] test mode is not enabled", method)
+	}
+	err := s.authorizer.AuthorizeAction(ctx, permissions.RunTestMode)
+	var permErr *armadaerrors.ErrUnauthorized
+	if errors.As(err, &permErr) {
+		return status.Errorf(codes.PermissionDenied, "[%s] error: %s", method, permErr)
+	} else if err != nil {
+		return status.Errorf(codes.Unavailable, "[%s] error checking permissions: %s", method, err)
+	}
+	return nil
+}
+
+func syntheticJobRequestItems(n int, resources armadaresource.ComputeResources) []*api.JobSubmitRequestItem {
+	items := make([]*api.JobSubmitRequestItem, n)
+	for i := range items {
+		resourceList := make(v1.ResourceList, len(resources))
+		for name, quantity := range resources {
+			resourceList[v1.ResourceName(name)] = quantity.DeepCopy()
+		}
+		items[i] = &api.JobSubmitRequestItem{
+			Labels: map[string]string{"armadaproject.io/synthetic-load": "true"},
+			PodSpecs: []*v1.PodSpec{{
+				RestartPolicy: v1.RestartPolicyNever,
+				Containers: []v1.Container{{
+					Name:    "synthetic",
+					Image:   "alpine:3.18",
+					Command: []string{"sh", "-c", "sleep 1"},
+					Resources: v1.ResourceRequirements{
+						Requests: resourceList,
+						Limits:   resourceList.DeepCopy(),
+					},
+				}},
+			}},
+		}
+	}
+	return items
+}
+
+func appendLoadError(loadErrors []string, loadError string) []string {
+	if len(loadErrors) >= maxReportedLoadErrors {
+		return loadErrors
+	}
+	return append(loadErrors, loadError)
+}
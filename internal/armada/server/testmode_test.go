@@ -0,0 +1,108 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/internal/armada/repository"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+func TestTestModeServer_GenerateLoad(t *testing.T) {
+	withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
+		testModeServer := NewTestModeServer(
+			&FakeActionAuthorizer{},
+			s,
+			repository.NewFaultInjector(),
+			&configuration.TestModeConfig{Enabled: true, SandboxQueue: "test", MaxJobsPerRun: 10},
+			s.schedulingConfig.DefaultJobLimits,
+		)
+
+		report, err := testModeServer.GenerateLoad(context.Background(), &api.SyntheticLoadRequest{
+			NumJobs:        5,
+			BatchSize:      2,
+			CancelFraction: 0.4,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "test", report.Queue)
+		assert.NotEmpty(t, report.JobSetId)
+		assert.Equal(t, uint32(5), report.JobsSubmitted)
+		assert.Equal(t, uint32(0), report.JobsFailed)
+		assert.Equal(t, uint32(2), report.JobsCancelled)
+		assert.Empty(t, report.Errors)
+		assert.LessOrEqual(t, report.MeanSubmitLatency, report.MaxSubmitLatency)
+	})
+}
+
+func TestTestModeServer_GenerateLoad_RejectsInvalidRequests(t *testing.T) {
+	tests := map[string]struct {
+		config  configuration.TestModeConfig
+		request *api.SyntheticLoadRequest
+		code    codes.Code
+	}{
+		"test mode disabled": {
+			config:  configuration.TestModeConfig{Enabled: false, SandboxQueue: "test"},
+			request: &api.SyntheticLoadRequest{NumJobs: 1},
+			code:    codes.FailedPrecondition,
+		},
+		"queue other than the sandbox": {
+			config:  configuration.TestModeConfig{Enabled: true, SandboxQueue: "test"},
+			request: &api.SyntheticLoadRequest{Queue: "tenant", NumJobs: 1},
+			code:    codes.InvalidArgument,
+		},
+		"no sandbox configured": {
+			config:  configuration.TestModeConfig{Enabled: true},
+			request: &api.SyntheticLoadRequest{NumJobs: 1},
+			code:    codes.InvalidArgument,
+		},
+		"too many jobs": {
+			config:  configuration.TestModeConfig{Enabled: true, SandboxQueue: "test", MaxJobsPerRun: 10},
+			request: &api.SyntheticLoadRequest{NumJobs: 11},
+			code:    codes.InvalidArgument,
+		},
+		"no limit of jobs per run configured": {
+			config:  configuration.TestModeConfig{Enabled: true, SandboxQueue: "test"},
+			request: &api.SyntheticLoadRequest{NumJobs: 1},
+			code:    codes.InvalidArgument,
+		},
+		"batch too large": {
+			config:  configuration.TestModeConfig{Enabled: true, SandboxQueue: "test", MaxJobsPerRun: 10000},
+			request: &api.SyntheticLoadRequest{NumJobs: 10000, BatchSize: maxSyntheticLoadBatchSize + 1},
+			code:    codes.InvalidArgument,
+		},
+		"invalid cancel fraction": {
+			config:  configuration.TestModeConfig{Enabled: true, SandboxQueue: "test", MaxJobsPerRun: 10},
+			request: &api.SyntheticLoadRequest{NumJobs: 1, CancelFraction: 1.5},
+			code:    codes.InvalidArgument,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			withSubmitServer(func(s *SubmitServer, events *repository.TestEventStore) {
+				config := tc.config
+				testModeServer := NewTestModeServer(&FakeActionAuthorizer{}, s, repository.NewFaultInjector(), &config, nil)
+				_, err := testModeServer.GenerateLoad(context.Background(), tc.request)
+				assert.Equal(t, tc.code, status.Code(err))
+			})
+		})
+	}
+}
+
+func TestTestModeServer_InjectFaults_Permissions(t *testing.T) {
+	config := &configuration.TestModeConfig{Enabled: true, SandboxQueue: "test"}
+	faults := &api.FaultInjectionRequest{Faults: []*api.Fault{{Target: api.FaultTarget_EVENT_STORE, ErrorProbability: 1}}}
+
+	testModeServer := NewTestModeServer(&FakeDenyAllActionAuthorizer{}, nil, repository.NewFaultInjector(), config, nil)
+	_, err := testModeServer.InjectFaults(context.Background(), faults)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	testModeServer = NewTestModeServer(&FakeActionAuthorizer{}, nil, repository.NewFaultInjector(), config, nil)
+	_, err = testModeServer.InjectFaults(context.Background(), faults)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,146 @@
+package server
+
+import (
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/armadaproject/armada/internal/armada/repository"
+	"github.com/armadaproject/armada/internal/armada/scheduling"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+const (
+	// How many queued jobs of each queue are checked per run.
+	unschedulableJobsBatchSize = 10000
+	// How long jobs stay marked as reported without being checked again. It must exceed the time it takes to check all
+	// queued jobs of a queue, one batch per run, since jobs still unschedulable are otherwise reported again.
+	unschedulableJobReportTtl = 24 * time.Hour
+)
+
+// UnschedulableJobReporter periodically re-checks queued jobs against the scheduling info reported by clusters,
+// and reports a JobUnschedulable event for each job that can no longer be scheduled on any active cluster,
+// e.g., because the nodes it fits on were removed. Such jobs stay queued.
+// Each job is reported once, and again only if it became schedulable in between. Which jobs were reported is stored
+// in Redis, and only one server checks jobs at a time, such that jobs aren't reported by each server or after restarts.
+// Each run checks the next batch of the queued jobs of each queue, starting over once all have been checked.
+// Only the legacy scheduler stores queued jobs, hence only its jobs are considered.
+type UnschedulableJobReporter struct {
+	queueRepository            repository.QueueRepository
+	jobRepository              repository.JobRepository
+	schedulingInfoRepository   repository.SchedulingInfoRepository
+	unschedulableJobRepository repository.UnschedulableJobRepository
+	eventStore                 repository.EventStore
+}
+
+func NewUnschedulableJobReporter(
+	queueRepository repository.QueueRepository,
+	jobRepository repository.JobRepository,
+	schedulingInfoRepository repository.SchedulingInfoRepository,
+	unschedulableJobRepository repository.UnschedulableJobRepository,
+	eventStore repository.EventStore,
+) *UnschedulableJobReporter {
+	return &UnschedulableJobReporter{
+		queueRepository:            queueRepository,
+		jobRepository:              jobRepository,
+		schedulingInfoRepository:   schedulingInfoRepository,
+		unschedulableJobRepository: unschedulableJobRepository,
+		eventStore:                 eventStore,
+	}
+}
+
+// ReportUnschedulableJobs re-checks the next batch of queued jobs of each queue, unless another server is doing so.
+// While no cluster is active, e.g., because executors are restarting, nothing is reported, since jobs would otherwise
+// be reported as unschedulable en masse.
+func (r *UnschedulableJobReporter) ReportUnschedulableJobs() {
+	_, err := r.unschedulableJobRepository.ProcessUnschedulableJobs(r.reportUnschedulableJobs)
+	if err != nil {
+		log.WithError(err).Error("failed to check unschedulable jobs")
+	}
+}
+
+func (r *UnschedulableJobReporter) reportUnschedulableJobs() {
+	allClusterSchedulingInfo, err := r.schedulingInfoRepository.GetClusterSchedulingInfo()
+	if err != nil {
+		log.WithError(err).Error("failed to get cluster scheduling info")
+		return
+	}
+	activeClusterSchedulingInfo := scheduling.FilterActiveClusterSchedulingInfoReports(allClusterSchedulingInfo)
+	if len(activeClusterSchedulingInfo) == 0 {
+		return
+	}
+	queues, err := r.queueRepository.GetAllQueues()
+	if err != nil {
+		log.WithError(err).Error("failed to get queues")
+		return
+	}
+	for _, q := range queues {
+		if err := r.reportUnschedulableJobsOfQueue(q.Name, activeClusterSchedulingInfo); err != nil {
+			log.WithError(err).Errorf("failed to report unschedulable jobs of queue %s", q.Name)
+		}
+	}
+}
+
+// reportUnschedulableJobsOfQueue checks the next batch of queued jobs of the queue, reports those that can't be
+// scheduled, other than those reported before, and advances the cursor of the queue past the batch.
+// Since jobs leave and join the queue between runs, some jobs may be checked twice or skipped in a pass over the queue.
+func (r *UnschedulableJobReporter) reportUnschedulableJobsOfQueue(
+	queue string,
+	activeClusterSchedulingInfo map[string]*api.ClusterSchedulingInfoReport,
+) error {
+	offset, err := r.unschedulableJobRepository.GetUnschedulableJobsCursor(queue)
+	if err != nil {
+		return err
+	}
+	queuedIds, err := r.jobRepository.GetQueueJobIdsPage(queue, offset, unschedulableJobsBatchSize)
+	if err != nil {
+		return fmt.Errorf("failed to get queued jobs: %w", err)
+	}
+	jobs, err := r.jobRepository.GetExistingJobsByIds(queuedIds)
+	if err != nil {
+		return fmt.Errorf("failed to load jobs: %w", err)
+	}
+	reportedBefore, err := r.unschedulableJobRepository.GetReportedUnschedulableJobs(queuedIds)
+	if err != nil {
+		return err
+	}
+
+	activePoolByClusterId := poolByClusterId(activeClusterSchedulingInfo)
+	var unschedulable []*api.Job
+	var unschedulableIds, schedulableIds []string
+	reasons := make(map[string]string)
+	for _, job := range jobs {
+		_, explanation, _ := explainUnschedulableJob(job, activeClusterSchedulingInfo, activePoolByClusterId)
+		if explanation == "" {
+			if reportedBefore[job.Id] {
+				schedulableIds = append(schedulableIds, job.Id)
+			}
+			continue
+		}
+		unschedulableIds = append(unschedulableIds, job.Id)
+		if !reportedBefore[job.Id] {
+			unschedulable = append(unschedulable, job)
+			reasons[job.Id] = fmt.Sprintf("job can no longer be scheduled: %s", explanation)
+		}
+	}
+	if len(unschedulable) > 0 {
+		if err := reportJobsUnschedulable(r.eventStore, unschedulable, reasons); err != nil {
+			return err
+		}
+		log.Infof("reported %d job(s) of queue %s as unschedulable", len(unschedulable), queue)
+	}
+	// Jobs reported before are marked again, such that their marks don't expire while they remain unschedulable.
+	if err := r.unschedulableJobRepository.MarkUnschedulableJobsReported(unschedulableIds, unschedulableJobReportTtl); err != nil {
+		return err
+	}
+	if err := r.unschedulableJobRepository.UnmarkUnschedulableJobsReported(schedulableIds); err != nil {
+		return err
+	}
+
+	nextOffset := offset + int64(len(queuedIds))
+	if len(queuedIds) < unschedulableJobsBatchSize {
+		nextOffset = 0
+	}
+	return r.unschedulableJobRepository.SetUnschedulableJobsCursor(queue, nextOffset)
+}
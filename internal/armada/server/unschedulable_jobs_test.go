@@ -0,0 +1,88 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/armadaproject/armada/internal/armada/repository"
+	armadaresource "github.com/armadaproject/armada/internal/common/resource"
+	"github.com/armadaproject/armada/internal/common/util"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+func TestUnschedulableJobReporter_ReportUnschedulableJobs(t *testing.T) {
+	withSubmitServerAndRepos(func(s *SubmitServer, jobRepo repository.JobRepository, events *repository.TestEventStore) {
+		client := redis.NewClient(&redis.Options{Addr: "localhost:6379", DB: 10})
+		defer client.Close()
+		unschedulableJobRepo := repository.NewRedisUnschedulableJobRepository(client)
+		reporter := NewUnschedulableJobReporter(s.queueRepository, jobRepo, s.schedulingInfoRepository, unschedulableJobRepo, events)
+		reportCluster := func(cpu string) {
+			err := s.schedulingInfoRepository.UpdateClusterSchedulingInfo(&api.ClusterSchedulingInfoReport{
+				ClusterId:  "test-cluster",
+				ReportTime: time.Now(),
+				NodeTypes: []*api.NodeType{{
+					AllocatableResources: armadaresource.ComputeResources{"cpu": resource.MustParse(cpu), "memory": resource.MustParse("100Gi")},
+				}},
+			})
+			require.NoError(t, err)
+		}
+		unschedulableJobIds := func() []string {
+			var jobIds []string
+			for _, event := range events.ReceivedEvents {
+				if e, ok := event.Events.(*api.EventMessage_Unschedulable); ok {
+					assert.Contains(t, e.Unschedulable.Reason, "test-cluster")
+					jobIds = append(jobIds, e.Unschedulable.JobId)
+				}
+			}
+			return jobIds
+		}
+
+		response, err := s.SubmitJobs(context.Background(), createJobRequest(util.NewULID(), 1))
+		require.NoError(t, err)
+		jobId := response.JobResponseItems[0].JobId
+
+		reporter.ReportUnschedulableJobs()
+		assert.Empty(t, unschedulableJobIds())
+
+		// Jobs are reported once after the nodes they fit on are removed, also by other servers or after restarts.
+		reportCluster("500m")
+		reporter.ReportUnschedulableJobs()
+		reporter.ReportUnschedulableJobs()
+		NewUnschedulableJobReporter(s.queueRepository, jobRepo, s.schedulingInfoRepository, unschedulableJobRepo, events).ReportUnschedulableJobs()
+		assert.Equal(t, []string{jobId}, unschedulableJobIds())
+
+		// Nothing is checked while another server is checking jobs.
+		acquired, err := unschedulableJobRepo.ProcessUnschedulableJobs(func() {
+			reportCluster("100")
+			reporter.ReportUnschedulableJobs()
+			reportCluster("500m")
+			reporter.ReportUnschedulableJobs()
+		})
+		require.NoError(t, err)
+		assert.True(t, acquired)
+		assert.Equal(t, []string{jobId}, unschedulableJobIds())
+
+		// And again if they become unschedulable after having been schedulable.
+		reportCluster("100")
+		reporter.ReportUnschedulableJobs()
+		reportCluster("500m")
+		reporter.ReportUnschedulableJobs()
+		assert.Equal(t, []string{jobId, jobId}, unschedulableJobIds())
+
+		// Nothing is reported while no cluster is active.
+		err = s.schedulingInfoRepository.UpdateClusterSchedulingInfo(&api.ClusterSchedulingInfoReport{
+			ClusterId:  "test-cluster",
+			ReportTime: time.Now().Add(-time.Hour),
+		})
+		require.NoError(t, err)
+		require.NoError(t, unschedulableJobRepo.UnmarkUnschedulableJobsReported([]string{jobId}))
+		reporter.ReportUnschedulableJobs()
+		assert.Equal(t, []string{jobId, jobId}, unschedulableJobIds())
+	})
+}
@@ -46,6 +46,9 @@ func (s *UsageServer) ReportUsage(grpcCtx context.Context, report *api.ClusterUs
 	if err := s.authorizer.AuthorizeAction(ctx, permissions.ExecuteJobs); err != nil {
 		return nil, status.Errorf(codes.PermissionDenied, "[ReportUsage] error: %s", err)
 	}
+	if err := authorizeExecutor(ctx, report.ClusterId); err != nil {
+		return nil, status.Errorf(codes.PermissionDenied, "[ReportUsage] error: %s", err)
+	}
 
 	queues, err := s.queueRepository.GetAllQueues()
 	if err != nil {
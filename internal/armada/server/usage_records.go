@@ -0,0 +1,159 @@
+package server
+
+import (
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/exp/maps"
+	"k8s.io/utils/clock"
+
+	"github.com/armadaproject/armada/internal/armada/repository"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+// UsageRecorder records the resource-seconds used by the runs of jobs into daily usage records per queue and owner,
+// from which usage reports are produced. A run starts once its job is reported running and finishes once it's reported
+// done, or once the job is no longer leased. The events of jobs of the Pulsar scheduler, which aren't leased through
+// the server, are read from Pulsar, and their runs finish once the job is done.
+type UsageRecorder struct {
+	jobRepository         repository.JobRepository
+	usageRecordRepository repository.UsageRecordRepository
+	clock                 clock.Clock
+}
+
+func NewUsageRecorder(jobRepository repository.JobRepository, usageRecordRepository repository.UsageRecordRepository) *UsageRecorder {
+	return &UsageRecorder{
+		jobRepository:         jobRepository,
+		usageRecordRepository: usageRecordRepository,
+		clock:                 clock.RealClock{},
+	}
+}
+
+// Mirror records the usage of the runs of events read from Pulsar, such that the usage of jobs of the Pulsar scheduler
+// is recorded by using UsageRecorder as the EventMirror of an EventMirrorFromLog.
+func (r *UsageRecorder) Mirror(_ *armadacontext.Context, events []*api.EventMessage) error {
+	return r.HandleEvents(events)
+}
+
+// HandleEvents starts recording the usage of a run for each running event, and records the usage of the run
+// of each event that ends a run until the event was created. Events are handled in order. Handling the same events
+// again has no further effect, since neither runs in progress nor runs that finished since are started again.
+func (r *UsageRecorder) HandleEvents(events []*api.EventMessage) error {
+	var runEvents []api.Event
+	runningJobIds := make(map[string]bool)
+	for _, message := range events {
+		switch message.Events.(type) {
+		case *api.EventMessage_Running,
+			*api.EventMessage_Succeeded,
+			*api.EventMessage_Failed,
+			*api.EventMessage_LeaseReturned,
+			*api.EventMessage_LeaseExpired,
+			*api.EventMessage_Preempted,
+			*api.EventMessage_Cancelled,
+			*api.EventMessage_Terminated:
+			event, err := api.UnwrapEvent(message)
+			if err != nil {
+				return errors.WithMessage(err, "[UsageRecorder.HandleEvents] error unwrapping event")
+			}
+			runEvents = append(runEvents, event)
+			if _, ok := event.(*api.JobRunningEvent); ok {
+				runningJobIds[event.GetJobId()] = true
+			}
+		}
+	}
+	if len(runEvents) == 0 {
+		return nil
+	}
+
+	jobsById := make(map[string]*api.Job)
+	if len(runningJobIds) > 0 {
+		jobs, err := r.jobRepository.GetExistingJobsByIds(maps.Keys(runningJobIds))
+		if err != nil {
+			return errors.WithMessage(err, "[UsageRecorder.HandleEvents] error getting jobs of running events")
+		}
+		for _, job := range jobs {
+			jobsById[job.Id] = job
+		}
+	}
+
+	for _, event := range runEvents {
+		if _, ok := event.(*api.JobRunningEvent); !ok {
+			if err := r.usageRecordRepository.AccrueRun(event.GetJobId(), event.GetCreated(), true); err != nil {
+				return err
+			}
+			continue
+		}
+		var run *repository.AccruingRun
+		if job, ok := jobsById[event.GetJobId()]; ok {
+			run = &repository.AccruingRun{
+				JobId:        job.Id,
+				Queue:        job.Queue,
+				Owner:        job.Owner,
+				Resources:    job.TotalResourceRequest().AsFloat(),
+				AccruedUntil: event.GetCreated(),
+			}
+		} else {
+			details, err := r.jobRepository.GetPulsarSchedulerJobDetails(event.GetJobId())
+			if err != nil {
+				return errors.WithMessage(err, "[UsageRecorder.HandleEvents] error getting job details of running event")
+			}
+			if details == nil {
+				// The job is already done; there's nothing to record.
+				continue
+			}
+			run = &repository.AccruingRun{
+				JobId:           details.JobId,
+				Queue:           details.Queue,
+				Owner:           details.Owner,
+				Resources:       details.Resources,
+				AccruedUntil:    event.GetCreated(),
+				PulsarScheduler: true,
+			}
+		}
+		if err := r.usageRecordRepository.StartRun(run); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AccrueUsage records the usage of runs in progress until now, such that usage reports include jobs that are still
+// running, and finishes the runs of jobs that are no longer leased, e.g., because they were cancelled, or, for jobs of
+// the Pulsar scheduler, the runs of jobs that are done, in case the events ending them were missed.
+func (r *UsageRecorder) AccrueUsage() {
+	runs, err := r.usageRecordRepository.GetRunsInProgress()
+	if err != nil {
+		log.WithError(err).Error("[UsageRecorder.AccrueUsage] error getting runs in progress")
+		return
+	}
+	if len(runs) == 0 {
+		return
+	}
+	var jobIds []string
+	for _, run := range runs {
+		if !run.PulsarScheduler {
+			jobIds = append(jobIds, run.JobId)
+		}
+	}
+	clusterIds, err := r.jobRepository.GetLeasedJobClusterIds(jobIds)
+	if err != nil {
+		log.WithError(err).Error("[UsageRecorder.AccrueUsage] error getting leased jobs")
+		return
+	}
+	now := r.clock.Now()
+	for _, run := range runs {
+		_, running := clusterIds[run.JobId]
+		if run.PulsarScheduler {
+			// The details of jobs of the Pulsar scheduler are removed within an hour of them being done.
+			details, err := r.jobRepository.GetPulsarSchedulerJobDetails(run.JobId)
+			if err != nil {
+				log.WithError(err).Errorf("[UsageRecorder.AccrueUsage] error getting job details of job %s", run.JobId)
+				continue
+			}
+			running = details != nil
+		}
+		if err := r.usageRecordRepository.AccrueRun(run.JobId, now, !running); err != nil {
+			log.WithError(err).Errorf("[UsageRecorder.AccrueUsage] error recording usage of job %s", run.JobId)
+		}
+	}
+}
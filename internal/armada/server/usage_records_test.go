@@ -0,0 +1,155 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-redis/redis"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	clock "k8s.io/utils/clock/testing"
+
+	"github.com/armadaproject/armada/internal/armada/repository"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/util"
+	"github.com/armadaproject/armada/internal/scheduler/schedulerobjects"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+func TestUsageRecorder_HandleEvents(t *testing.T) {
+	withUsageRecorder(func(s *SubmitServer, r *UsageRecorder, fakeClock *clock.FakeClock) {
+		response, err := s.SubmitJobs(context.Background(), createJobRequest(util.NewULID(), 1))
+		require.NoError(t, err)
+		jobId := response.JobResponseItems[0].JobId
+		jobs, err := s.jobRepository.GetExistingJobsByIds([]string{jobId})
+		require.NoError(t, err)
+
+		started := fakeClock.Now().UTC().Truncate(24 * time.Hour)
+		require.NoError(t, r.HandleEvents(wrapEvents(t,
+			&api.JobRunningEvent{JobId: jobId, Queue: "test", Created: started},
+			&api.JobSucceededEvent{JobId: jobId, Queue: "test", Created: started.Add(time.Minute)},
+		)))
+
+		records, err := r.usageRecordRepository.GetUsageRecords(started, started)
+		require.NoError(t, err)
+		date := started.Format("2006-01-02")
+		assert.Equal(t, []*api.UsageRecord{
+			{Date: date, Queue: "test", Owner: jobs[0].Owner, Resource: "cpu", ResourceSeconds: 60},
+			{Date: date, Queue: "test", Owner: jobs[0].Owner, Resource: "memory", ResourceSeconds: 512 * 1024 * 1024 * 60},
+		}, records)
+		runs, err := r.usageRecordRepository.GetRunsInProgress()
+		require.NoError(t, err)
+		assert.Empty(t, runs)
+	})
+}
+
+func TestUsageRecorder_HandleEvents_HandlesEventsReportedAgain(t *testing.T) {
+	withUsageRecorder(func(s *SubmitServer, r *UsageRecorder, fakeClock *clock.FakeClock) {
+		response, err := s.SubmitJobs(context.Background(), createJobRequest(util.NewULID(), 1))
+		require.NoError(t, err)
+		jobId := response.JobResponseItems[0].JobId
+
+		// The events are reported again, e.g., because storing them failed once they were handled.
+		started := fakeClock.Now().UTC().Truncate(24 * time.Hour)
+		for i := 0; i < 2; i++ {
+			require.NoError(t, r.HandleEvents(wrapEvents(t,
+				&api.JobRunningEvent{JobId: jobId, Queue: "test", Created: started},
+				&api.JobFailedEvent{JobId: jobId, Queue: "test", Created: started.Add(time.Minute)},
+			)))
+		}
+
+		records, err := r.usageRecordRepository.GetUsageRecords(started, started)
+		require.NoError(t, err)
+		for _, record := range records {
+			if record.Resource == "cpu" {
+				assert.Equal(t, 60.0, record.ResourceSeconds)
+			}
+		}
+		runs, err := r.usageRecordRepository.GetRunsInProgress()
+		require.NoError(t, err)
+		assert.Empty(t, runs)
+	})
+}
+
+func TestUsageRecorder_AccrueUsage(t *testing.T) {
+	withUsageRecorder(func(s *SubmitServer, r *UsageRecorder, fakeClock *clock.FakeClock) {
+		response, err := s.SubmitJobs(context.Background(), createJobRequest(util.NewULID(), 2))
+		require.NoError(t, err)
+		leasedJobId := response.JobResponseItems[0].JobId
+		unleasedJobId := response.JobResponseItems[1].JobId
+		_, err = s.jobRepository.TryLeaseJobs("test-cluster", map[string][]string{"test": {leasedJobId}})
+		require.NoError(t, err)
+
+		started := fakeClock.Now().Add(-time.Minute)
+		require.NoError(t, r.HandleEvents(wrapEvents(t,
+			&api.JobRunningEvent{JobId: leasedJobId, Queue: "test", Created: started},
+			&api.JobRunningEvent{JobId: unleasedJobId, Queue: "test", Created: started},
+		)))
+
+		// Usage of runs in progress is accrued, and runs of jobs that are no longer leased are finished.
+		r.AccrueUsage()
+		runs, err := r.usageRecordRepository.GetRunsInProgress()
+		require.NoError(t, err)
+		require.Len(t, runs, 1)
+		assert.Equal(t, leasedJobId, runs[0].JobId)
+		assert.True(t, fakeClock.Now().Equal(runs[0].AccruedUntil))
+
+		records, err := r.usageRecordRepository.GetUsageRecords(started, fakeClock.Now())
+		require.NoError(t, err)
+		var cpuSeconds float64
+		for _, record := range records {
+			if record.Resource == "cpu" {
+				cpuSeconds += record.ResourceSeconds
+			}
+		}
+		assert.InDelta(t, 120.0, cpuSeconds, 0.001)
+	})
+}
+
+func TestUsageRecorder_RecordsJobsOfPulsarScheduler(t *testing.T) {
+	withUsageRecorder(func(s *SubmitServer, r *UsageRecorder, fakeClock *clock.FakeClock) {
+		runningJobId := util.NewULID()
+		doneJobId := util.NewULID()
+		require.NoError(t, s.jobRepository.StorePulsarSchedulerJobDetails([]*schedulerobjects.PulsarSchedulerJobDetails{
+			{JobId: runningJobId, Queue: "test", JobSet: "set", Owner: "owner", Resources: map[string]float64{"cpu": 2}},
+			{JobId: doneJobId, Queue: "test", JobSet: "set", Owner: "owner", Resources: map[string]float64{"cpu": 1}},
+		}))
+
+		started := fakeClock.Now().Add(-time.Minute)
+		require.NoError(t, r.Mirror(armadacontext.Background(), wrapEvents(t,
+			&api.JobRunningEvent{JobId: runningJobId, Queue: "test", Created: started},
+			&api.JobRunningEvent{JobId: doneJobId, Queue: "test", Created: started},
+			&api.JobSucceededEvent{JobId: doneJobId, Queue: "test", Created: started.Add(30 * time.Second)},
+		)))
+
+		// Runs of jobs of the Pulsar scheduler aren't finished for not being leased, but once their details are removed.
+		r.AccrueUsage()
+		runs, err := r.usageRecordRepository.GetRunsInProgress()
+		require.NoError(t, err)
+		require.Len(t, runs, 1)
+		assert.Equal(t, runningJobId, runs[0].JobId)
+		assert.True(t, runs[0].PulsarScheduler)
+
+		records, err := r.usageRecordRepository.GetUsageRecords(started, fakeClock.Now())
+		require.NoError(t, err)
+		var cpuSeconds float64
+		for _, record := range records {
+			assert.Equal(t, "owner", record.Owner)
+			cpuSeconds += record.ResourceSeconds
+		}
+		assert.InDelta(t, 150.0, cpuSeconds, 0.001)
+	})
+}
+
+func withUsageRecorder(action func(s *SubmitServer, r *UsageRecorder, fakeClock *clock.FakeClock)) {
+	withSubmitServerAndRepos(func(s *SubmitServer, jobRepo repository.JobRepository, events *repository.TestEventStore) {
+		client := redis.NewClient(&redis.Options{Addr: "localhost:6379", DB: 10})
+		defer client.Close()
+
+		fakeClock := clock.NewFakeClock(time.Now())
+		r := NewUsageRecorder(jobRepo, repository.NewRedisUsageRecordRepository(client, 24*time.Hour))
+		r.clock = fakeClock
+		action(s, r, fakeClock)
+	})
+}
@@ -30,6 +30,8 @@ type App struct {
 	// Source of randomness. Tests can use a mocked random source in order to provide
 	// deterministic testing behaviour.
 	Random io.Reader
+	// Options of the client.Submitter jobs are submitted with. By default, submissions aren't retried.
+	SubmitterOptions []client.SubmitterOption
 }
 
 // Params struct holds all user-customizable parameters.
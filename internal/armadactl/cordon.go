@@ -0,0 +1,95 @@
+package armadactl
+
+import (
+	"fmt"
+	"text/tabwriter"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/internal/common"
+	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/client"
+)
+
+// CordonQueue stops new leases from being given to jobs of the queue with the given name.
+func (a *App) CordonQueue(name string, reason string) error {
+	return client.WithSubmitClient(a.Params.ApiConnectionDetails, func(c api.SubmitClient) error {
+		ctx, cancel := common.ContextWithDefaultTimeout()
+		defer cancel()
+
+		if _, err := c.CordonQueue(ctx, &api.CordonRequest{Name: name, Reason: reason}); err != nil {
+			return errors.Errorf("[armadactl.CordonQueue] error cordoning queue %s: %s", name, err)
+		}
+		fmt.Fprintf(a.Out, "Cordoned queue %s\n", name)
+		return nil
+	})
+}
+
+// UncordonQueue allows new leases to be given to jobs of the queue with the given name again.
+func (a *App) UncordonQueue(name string) error {
+	return client.WithSubmitClient(a.Params.ApiConnectionDetails, func(c api.SubmitClient) error {
+		ctx, cancel := common.ContextWithDefaultTimeout()
+		defer cancel()
+
+		if _, err := c.UncordonQueue(ctx, &api.UncordonRequest{Name: name}); err != nil {
+			return errors.Errorf("[armadactl.UncordonQueue] error uncordoning queue %s: %s", name, err)
+		}
+		fmt.Fprintf(a.Out, "Uncordoned queue %s\n", name)
+		return nil
+	})
+}
+
+// CordonExecutor stops new leases from being given to the executor with the given id.
+func (a *App) CordonExecutor(name string, reason string) error {
+	return client.WithSubmitClient(a.Params.ApiConnectionDetails, func(c api.SubmitClient) error {
+		ctx, cancel := common.ContextWithDefaultTimeout()
+		defer cancel()
+
+		if _, err := c.CordonExecutor(ctx, &api.CordonRequest{Name: name, Reason: reason}); err != nil {
+			return errors.Errorf("[armadactl.CordonExecutor] error cordoning executor %s: %s", name, err)
+		}
+		fmt.Fprintf(a.Out, "Cordoned executor %s\n", name)
+		return nil
+	})
+}
+
+// UncordonExecutor allows new leases to be given to the executor with the given id again.
+func (a *App) UncordonExecutor(name string) error {
+	return client.WithSubmitClient(a.Params.ApiConnectionDetails, func(c api.SubmitClient) error {
+		ctx, cancel := common.ContextWithDefaultTimeout()
+		defer cancel()
+
+		if _, err := c.UncordonExecutor(ctx, &api.UncordonRequest{Name: name}); err != nil {
+			return errors.Errorf("[armadactl.UncordonExecutor] error uncordoning executor %s: %s", name, err)
+		}
+		fmt.Fprintf(a.Out, "Uncordoned executor %s\n", name)
+		return nil
+	})
+}
+
+// GetCordons prints the cordoned queues and executors.
+func (a *App) GetCordons() error {
+	return client.WithSubmitClient(a.Params.ApiConnectionDetails, func(c api.SubmitClient) error {
+		ctx, cancel := common.ContextWithDefaultTimeout()
+		defer cancel()
+
+		cordons, err := c.GetCordons(ctx, &api.CordonListRequest{})
+		if err != nil {
+			return errors.Errorf("[armadactl.GetCordons] error getting cordons: %s", err)
+		}
+		if len(cordons.Queues) == 0 && len(cordons.Executors) == 0 {
+			fmt.Fprintln(a.Out, "Nothing is cordoned")
+			return nil
+		}
+		w := tabwriter.NewWriter(a.Out, 1, 1, 2, ' ', 0)
+		fmt.Fprintln(w, "KIND\tNAME\tCORDONED BY\tCORDONED\tREASON")
+		for _, cordon := range cordons.Queues {
+			fmt.Fprintf(w, "queue\t%s\t%s\t%s\t%s\n", cordon.Name, cordon.CordonedBy, cordon.Cordoned.Format(time.RFC3339), cordon.Reason)
+		}
+		for _, cordon := range cordons.Executors {
+			fmt.Fprintf(w, "executor\t%s\t%s\t%s\t%s\n", cordon.Name, cordon.CordonedBy, cordon.Cordoned.Format(time.RFC3339), cordon.Reason)
+		}
+		return w.Flush()
+	})
+}
@@ -0,0 +1,58 @@
+package armadactl
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/armadaproject/armada/internal/common"
+	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/client"
+)
+
+// GetExecutors prints the status of each executor that has heartbeated.
+func (a *App) GetExecutors() error {
+	return client.WithSubmitClient(a.Params.ApiConnectionDetails, func(c api.SubmitClient) error {
+		ctx, cancel := common.ContextWithDefaultTimeout()
+		defer cancel()
+
+		executors, err := c.GetExecutors(ctx, &api.ExecutorListRequest{})
+		if err != nil {
+			return errors.Errorf("[armadactl.GetExecutors] error getting executors: %s", err)
+		}
+		if len(executors.Executors) == 0 {
+			fmt.Fprintln(a.Out, "No executors have heartbeated")
+			return nil
+		}
+		w := tabwriter.NewWriter(a.Out, 1, 1, 2, ' ', 0)
+		fmt.Fprintln(w, "EXECUTOR\tPOOL\tVERSION\tLAST HEARTBEAT\tHEALTHY\tALLOCATED/CAPACITY")
+		for _, executor := range executors.Executors {
+			fmt.Fprintf(
+				w, "%s\t%s\t%s\t%s\t%t\t%s\n",
+				executor.Id, executor.Pool, executor.Version, executor.LastHeartbeat.Format(time.RFC3339), executor.Healthy,
+				formatAllocation(executor.Allocated, executor.Capacity),
+			)
+		}
+		return w.Flush()
+	})
+}
+
+// formatAllocation formats the allocated and total amount of each resource, e.g., "cpu: 4/10, memory: 1Gi/64Gi".
+func formatAllocation(allocated map[string]resource.Quantity, capacity map[string]resource.Quantity) string {
+	resourceTypes := make([]string, 0, len(capacity))
+	for t := range capacity {
+		resourceTypes = append(resourceTypes, t)
+	}
+	sort.Strings(resourceTypes)
+	parts := make([]string, len(resourceTypes))
+	for i, t := range resourceTypes {
+		used, total := allocated[t], capacity[t]
+		parts[i] = fmt.Sprintf("%s: %s/%s", t, used.String(), total.String())
+	}
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,74 @@
+package armadactl
+
+import (
+	"fmt"
+	"text/tabwriter"
+
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/internal/common"
+	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/client"
+)
+
+// GetFairShareWeights prints the fair-share weight of each queue.
+func (a *App) GetFairShareWeights() error {
+	return client.WithSubmitClient(a.Params.ApiConnectionDetails, func(c api.SubmitClient) error {
+		ctx, cancel := common.ContextWithDefaultTimeout()
+		defer cancel()
+
+		weights, err := c.GetFairShareWeights(ctx, &api.FairShareWeightsRequest{})
+		if err != nil {
+			return errors.Errorf("[armadactl.GetFairShareWeights] error getting fair-share weights: %s", err)
+		}
+		w := tabwriter.NewWriter(a.Out, 1, 1, 2, ' ', 0)
+		fmt.Fprintln(w, "QUEUE\tWEIGHT\tEFFECTIVE WEIGHT")
+		for _, weight := range weights.Weights {
+			fmt.Fprintf(w, "%s\t%.4g\t%.4g\n", weight.Queue, weight.Weight, weight.EffectiveWeight)
+		}
+		return w.Flush()
+	})
+}
+
+// SetFairShareWeight sets the fair-share weight of the queue with the given name.
+func (a *App) SetFairShareWeight(queueName string, weight float64) error {
+	return client.WithSubmitClient(a.Params.ApiConnectionDetails, func(c api.SubmitClient) error {
+		ctx, cancel := common.ContextWithDefaultTimeout()
+		defer cancel()
+
+		updated, err := c.SetFairShareWeight(ctx, &api.SetFairShareWeightRequest{Queue: queueName, Weight: weight})
+		if err != nil {
+			return errors.Errorf("[armadactl.SetFairShareWeight] error setting fair-share weight of queue %s: %s", queueName, err)
+		}
+		fmt.Fprintf(a.Out, "Set fair-share weight of queue %s to %.4g (effective weight %.4g)\n", updated.Queue, updated.Weight, updated.EffectiveWeight)
+		return nil
+	})
+}
+
+// GetFairShares prints the fair share and dominant resource share of each queue, per executor.
+func (a *App) GetFairShares() error {
+	return client.WithSubmitClient(a.Params.ApiConnectionDetails, func(c api.SubmitClient) error {
+		ctx, cancel := common.ContextWithDefaultTimeout()
+		defer cancel()
+
+		shares, err := c.GetFairShares(ctx, &api.FairSharesRequest{})
+		if err != nil {
+			return errors.Errorf("[armadactl.GetFairShares] error getting fair shares: %s", err)
+		}
+		if len(shares.Executors) == 0 {
+			fmt.Fprintln(a.Out, "No fair shares have been computed")
+			return nil
+		}
+		w := tabwriter.NewWriter(a.Out, 1, 1, 2, ' ', 0)
+		fmt.Fprintln(w, "EXECUTOR\tPOOL\tQUEUE\tWEIGHT\tFAIR SHARE\tDOMINANT RESOURCE SHARE\tDOMINANT RESOURCE")
+		for _, executor := range shares.Executors {
+			for _, share := range executor.Shares {
+				fmt.Fprintf(
+					w, "%s\t%s\t%s\t%.4g\t%.4f\t%.4f\t%s\n",
+					executor.ExecutorId, executor.Pool, share.Queue, share.Weight, share.FairShare, share.DominantResourceShare, share.DominantResource,
+				)
+			}
+		}
+		return w.Flush()
+	})
+}
@@ -0,0 +1,48 @@
+package armadactl
+
+import (
+	"fmt"
+	"text/tabwriter"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/internal/common"
+	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/client"
+)
+
+// GetJobPlacements prints the cluster, pool, and node each run of a job was placed on.
+func (a *App) GetJobPlacements(queue string, jobSetId string, jobId string) error {
+	return client.WithQueryClient(a.Params.ApiConnectionDetails, func(c api.QueryClient) error {
+		ctx, cancel := common.ContextWithDefaultTimeout()
+		defer cancel()
+
+		placements, err := c.GetJobPlacements(ctx, &api.JobPlacementsRequest{Queue: queue, JobSetId: jobSetId, JobId: jobId})
+		if err != nil {
+			return errors.Errorf("[armadactl.GetJobPlacements] error getting placements of job %s: %s", jobId, err)
+		}
+		if len(placements.Placements) == 0 {
+			fmt.Fprintf(a.Out, "Job %s has never been leased\n", jobId)
+			return nil
+		}
+		w := tabwriter.NewWriter(a.Out, 1, 1, 2, ' ', 0)
+		fmt.Fprintln(w, "LEASED\tCLUSTER\tPOOL\tNODE\tNODE LABELS\tSTATE\tREASON")
+		for _, placement := range placements.Placements {
+			fmt.Fprintf(
+				w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+				formatOptionalTime(placement.Leased), placement.ClusterId, placement.Pool, placement.NodeName,
+				formatLabels(placement.NodeLabels), placement.State, placement.Reason,
+			)
+		}
+		return w.Flush()
+	})
+}
+
+// formatOptionalTime formats t as RFC 3339, or as "-" if it's unset.
+func formatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return "-"
+	}
+	return t.Format(time.RFC3339)
+}
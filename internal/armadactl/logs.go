@@ -0,0 +1,43 @@
+package armadactl
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/internal/common"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/client"
+)
+
+// GetJobLogs prints the container logs of the most recent run of a pod of a job.
+// If follow is true, new logs are printed until the run ends.
+func (a *App) GetJobLogs(req *api.JobLogsRequest) error {
+	return client.WithSubmitClient(a.Params.ApiConnectionDetails, func(c api.SubmitClient) error {
+		// Followed logs are streamed for as long as the job runs.
+		ctx, cancel := common.ContextWithDefaultTimeout()
+		if req.Follow {
+			cancel()
+			ctx, cancel = armadacontext.WithCancel(armadacontext.Background())
+		}
+		defer cancel()
+
+		stream, err := c.GetJobLogs(ctx, req)
+		if err != nil {
+			return errors.Errorf("[armadactl.GetJobLogs] error getting logs of job %s: %s", req.JobId, err)
+		}
+		for {
+			logs, err := stream.Recv()
+			if err == io.EOF {
+				return nil
+			} else if err != nil {
+				return errors.Errorf("[armadactl.GetJobLogs] error getting logs of job %s: %s", req.JobId, err)
+			}
+			for _, line := range logs.Lines {
+				fmt.Fprintln(a.Out, line.Line)
+			}
+		}
+	})
+}
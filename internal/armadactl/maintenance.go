@@ -0,0 +1,69 @@
+package armadactl
+
+import (
+	"fmt"
+	"text/tabwriter"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/internal/common"
+	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/client"
+)
+
+// CreateMaintenanceWindow schedules the maintenance window described by request.
+func (a *App) CreateMaintenanceWindow(request *api.MaintenanceWindowCreateRequest) error {
+	return client.WithSubmitClient(a.Params.ApiConnectionDetails, func(c api.SubmitClient) error {
+		ctx, cancel := common.ContextWithDefaultTimeout()
+		defer cancel()
+
+		window, err := c.CreateMaintenanceWindow(ctx, request)
+		if err != nil {
+			return errors.Errorf("[armadactl.CreateMaintenanceWindow] error creating maintenance window: %s", err)
+		}
+		fmt.Fprintf(a.Out, "Created maintenance window %s from %s to %s\n", window.Id, window.Start.Format(time.RFC3339), window.End.Format(time.RFC3339))
+		return nil
+	})
+}
+
+// GetMaintenanceWindows prints the maintenance windows that haven't ended yet.
+func (a *App) GetMaintenanceWindows() error {
+	return client.WithSubmitClient(a.Params.ApiConnectionDetails, func(c api.SubmitClient) error {
+		ctx, cancel := common.ContextWithDefaultTimeout()
+		defer cancel()
+
+		windows, err := c.GetMaintenanceWindows(ctx, &api.MaintenanceWindowListRequest{})
+		if err != nil {
+			return errors.Errorf("[armadactl.GetMaintenanceWindows] error getting maintenance windows: %s", err)
+		}
+		if len(windows.Windows) == 0 {
+			fmt.Fprintln(a.Out, "No maintenance windows scheduled")
+			return nil
+		}
+		w := tabwriter.NewWriter(a.Out, 1, 1, 2, ' ', 0)
+		fmt.Fprintln(w, "ID\tEXECUTOR\tQUEUE\tSTART\tEND\tDRAIN\tSTARTED\tREASON")
+		for _, window := range windows.Windows {
+			fmt.Fprintf(
+				w, "%s\t%s\t%s\t%s\t%s\t%t\t%t\t%s\n",
+				window.Id, window.Executor, window.Queue, window.Start.Format(time.RFC3339), window.End.Format(time.RFC3339),
+				window.Drain, window.Started, window.Reason,
+			)
+		}
+		return w.Flush()
+	})
+}
+
+// DeleteMaintenanceWindow deletes the maintenance window with the given id.
+func (a *App) DeleteMaintenanceWindow(id string) error {
+	return client.WithSubmitClient(a.Params.ApiConnectionDetails, func(c api.SubmitClient) error {
+		ctx, cancel := common.ContextWithDefaultTimeout()
+		defer cancel()
+
+		if _, err := c.DeleteMaintenanceWindow(ctx, &api.MaintenanceWindowDeleteRequest{Id: id}); err != nil {
+			return errors.Errorf("[armadactl.DeleteMaintenanceWindow] error deleting maintenance window %s: %s", id, err)
+		}
+		fmt.Fprintf(a.Out, "Deleted maintenance window %s\n", id)
+		return nil
+	})
+}
@@ -0,0 +1,73 @@
+package armadactl
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/armadaproject/armada/internal/common"
+	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/client"
+)
+
+// GetNodeTypes prints the types of nodes of each cluster matching request.
+func (a *App) GetNodeTypes(request *api.NodeTypesRequest) error {
+	return client.WithQueryClient(a.Params.ApiConnectionDetails, func(c api.QueryClient) error {
+		ctx, cancel := common.ContextWithDefaultTimeout()
+		defer cancel()
+
+		response, err := c.GetNodeTypes(ctx, request)
+		if err != nil {
+			return errors.Errorf("[armadactl.GetNodeTypes] error getting node types: %s", err)
+		}
+		if len(response.Clusters) == 0 {
+			fmt.Fprintln(a.Out, "No matching nodes")
+			return nil
+		}
+		w := tabwriter.NewWriter(a.Out, 1, 1, 2, ' ', 0)
+		fmt.Fprintln(w, "CLUSTER\tPOOL\tNODES\tALLOCATABLE PER NODE\tLABELS\tTAINTS")
+		for _, cluster := range response.Clusters {
+			for _, nodeType := range cluster.NodeTypes {
+				taints := make([]string, len(nodeType.Taints))
+				for i, taint := range nodeType.Taints {
+					taints[i] = taint.ToString()
+				}
+				fmt.Fprintf(
+					w, "%s\t%s\t%d\t%s\t%s\t%s\n",
+					cluster.ClusterId, cluster.Pool, nodeType.NodeCount, formatResources(nodeType.AllocatableResources),
+					formatLabels(nodeType.Labels), strings.Join(taints, ","),
+				)
+			}
+		}
+		return w.Flush()
+	})
+}
+
+// formatResources formats the amount of each resource, e.g., "cpu: 64, memory: 256Gi".
+func formatResources(resources map[string]resource.Quantity) string {
+	resourceTypes := make([]string, 0, len(resources))
+	for t := range resources {
+		resourceTypes = append(resourceTypes, t)
+	}
+	sort.Strings(resourceTypes)
+	parts := make([]string, len(resourceTypes))
+	for i, t := range resourceTypes {
+		q := resources[t]
+		parts[i] = fmt.Sprintf("%s: %s", t, q.String())
+	}
+	return strings.Join(parts, ", ")
+}
+
+// formatLabels formats labels ordered by key, e.g., "gpu=a100,zone=a".
+func formatLabels(labels map[string]string) string {
+	parts := make([]string, 0, len(labels))
+	for k, v := range labels {
+		parts = append(parts, k+"="+v)
+	}
+	sort.Strings(parts)
+	return strings.Join(parts, ",")
+}
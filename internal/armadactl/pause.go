@@ -0,0 +1,51 @@
+package armadactl
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/internal/common"
+	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/client"
+)
+
+// Pause suspends the queued jobs of a job set, such that they aren't scheduled until the job set is resumed.
+func (a *App) Pause(queue string, jobSetId string) error {
+	fmt.Fprintf(a.Out, "Requesting pause of job set %s in queue %s\n", jobSetId, queue)
+	return client.WithSubmitClient(a.Params.ApiConnectionDetails, func(c api.SubmitClient) error {
+		ctx, cancel := common.ContextWithDefaultTimeout()
+		defer cancel()
+
+		_, err := c.PauseJobSet(ctx, &api.JobSetPauseRequest{
+			JobSetId: jobSetId,
+			Queue:    queue,
+		})
+		if err != nil {
+			return errors.Wrapf(err, "error pausing job set %s in queue %s", jobSetId, queue)
+		}
+
+		fmt.Fprintf(a.Out, "Paused job set %s in queue %s\n", jobSetId, queue)
+		return nil
+	})
+}
+
+// Resume returns the suspended jobs of a job set to the queue.
+func (a *App) Resume(queue string, jobSetId string) error {
+	fmt.Fprintf(a.Out, "Requesting resumption of job set %s in queue %s\n", jobSetId, queue)
+	return client.WithSubmitClient(a.Params.ApiConnectionDetails, func(c api.SubmitClient) error {
+		ctx, cancel := common.ContextWithDefaultTimeout()
+		defer cancel()
+
+		_, err := c.ResumeJobSet(ctx, &api.JobSetResumeRequest{
+			JobSetId: jobSetId,
+			Queue:    queue,
+		})
+		if err != nil {
+			return errors.Wrapf(err, "error resuming job set %s in queue %s", jobSetId, queue)
+		}
+
+		fmt.Fprintf(a.Out, "Resumed job set %s in queue %s\n", jobSetId, queue)
+		return nil
+	})
+}
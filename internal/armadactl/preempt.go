@@ -0,0 +1,31 @@
+package armadactl
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/internal/common"
+	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/client"
+)
+
+// Preempt evicts the leased jobs of a queue selected by request, either requeuing or failing them.
+func (a *App) Preempt(request *api.JobPreemptRequest) error {
+	fmt.Fprintf(a.Out, "Requesting preemption of jobs in queue %s\n", request.Queue)
+	return client.WithSubmitClient(a.Params.ApiConnectionDetails, func(c api.SubmitClient) error {
+		ctx, cancel := common.ContextWithDefaultTimeout()
+		defer cancel()
+
+		result, err := c.PreemptJobs(ctx, request)
+		if err != nil {
+			return errors.Wrapf(err, "error preempting jobs in queue %s", request.Queue)
+		}
+
+		for _, jobId := range result.PreemptedIds {
+			fmt.Fprintf(a.Out, "Preempted job %s\n", jobId)
+		}
+		fmt.Fprintf(a.Out, "Preempted %d jobs in queue %s\n", len(result.PreemptedIds), request.Queue)
+		return nil
+	})
+}
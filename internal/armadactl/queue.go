@@ -2,11 +2,16 @@ package armadactl
 
 import (
 	"fmt"
+	"os"
 	"sort"
+	"strings"
 
 	"github.com/pkg/errors"
 	"gopkg.in/yaml.v3"
 
+	"github.com/armadaproject/armada/internal/common"
+	armadaresource "github.com/armadaproject/armada/internal/common/resource"
+	"github.com/armadaproject/armada/pkg/api"
 	"github.com/armadaproject/armada/pkg/client"
 	"github.com/armadaproject/armada/pkg/client/queue"
 	"github.com/armadaproject/armada/pkg/client/util"
@@ -39,6 +44,8 @@ func (a *App) CreateResource(fileName string, dryRun bool) error {
 		if !dryRun {
 			return a.Params.QueueAPI.Create(queue)
 		}
+	case client.ResourceKindQueueList:
+		return errors.Errorf("file %s is a document of queues; use armadactl import queues -f %s instead", fileName, fileName)
 	default:
 		return errors.Errorf("invalid resource kind: %s", resource.Kind)
 	}
@@ -46,6 +53,73 @@ func (a *App) CreateResource(fileName string, dryRun bool) error {
 	return nil
 }
 
+// ExportQueues prints a YAML document of the queues whose names start with namePrefix.
+func (a *App) ExportQueues(namePrefix string) error {
+	return client.WithSubmitClient(a.Params.ApiConnectionDetails, func(c api.SubmitClient) error {
+		ctx, cancel := common.ContextWithDefaultTimeout()
+		defer cancel()
+
+		document, err := c.ExportQueues(ctx, &api.QueueExportRequest{NamePrefix: namePrefix})
+		if err != nil {
+			return errors.Errorf("[armadactl.ExportQueues] error exporting queues: %s", err)
+		}
+		fmt.Fprint(a.Out, document.Yaml)
+		return nil
+	})
+}
+
+// ImportQueues creates and updates queues such that they match the document in the file with the given name and, if
+// prune is set, deletes queues whose names start with namePrefix that aren't in it. Each change is printed.
+// If dryRun is set, the changes are only printed, e.g., to detect drift.
+func (a *App) ImportQueues(fileName string, prune bool, namePrefix string, dryRun bool) error {
+	data, err := os.ReadFile(fileName)
+	if err != nil {
+		return errors.Errorf("[armadactl.ImportQueues] error reading file %s: %s", fileName, err)
+	}
+	return client.WithSubmitClient(a.Params.ApiConnectionDetails, func(c api.SubmitClient) error {
+		ctx, cancel := common.ContextWithDefaultTimeout()
+		defer cancel()
+
+		response, err := c.ImportQueues(ctx, &api.QueueImportRequest{
+			Yaml:       string(data),
+			Prune:      prune,
+			NamePrefix: namePrefix,
+			DryRun:     dryRun,
+		})
+		if err != nil {
+			return errors.Errorf("[armadactl.ImportQueues] error importing queues: %s", err)
+		}
+		if len(response.Changes) == 0 {
+			fmt.Fprintf(a.Out, "Queues match %s\n", fileName)
+			return nil
+		}
+		failed := 0
+		for _, change := range response.Changes {
+			action := map[api.QueueChangeType]string{
+				api.QueueChangeType_QueueCreated: "create",
+				api.QueueChangeType_QueueUpdated: "update",
+				api.QueueChangeType_QueueDeleted: "delete",
+			}[change.Type]
+			line := fmt.Sprintf("Queue %s: %s", change.Name, action)
+			if len(change.Fields) > 0 {
+				line += " " + strings.Join(change.Fields, ", ")
+			}
+			if change.Error != "" {
+				line += " failed: " + change.Error
+				failed++
+			}
+			fmt.Fprintln(a.Out, line)
+		}
+		if failed > 0 {
+			return errors.Errorf("[armadactl.ImportQueues] %d of %d changes failed", failed, len(response.Changes))
+		}
+		if dryRun {
+			fmt.Fprintf(a.Out, "Dry run; %d changes not applied\n", len(response.Changes))
+		}
+		return nil
+	})
+}
+
 // DeleteQueue calls app.QueueAPI.Delete with the provided parameters.
 func (a *App) DeleteQueue(name string) error {
 	if err := a.Params.QueueAPI.Delete(name); err != nil {
@@ -55,6 +129,49 @@ func (a *App) DeleteQueue(name string) error {
 	return nil
 }
 
+// CascadeDeleteQueue starts deleting the queue with the given name in the background,
+// after cancelling all of its jobs.
+func (a *App) CascadeDeleteQueue(name string) error {
+	return client.WithSubmitClient(a.Params.ApiConnectionDetails, func(c api.SubmitClient) error {
+		ctx, cancel := common.ContextWithDefaultTimeout()
+		defer cancel()
+
+		if _, err := c.DeleteQueue(ctx, &api.QueueDeleteRequest{Name: name, Cascade: true}); err != nil {
+			return errors.Errorf("[armadactl.CascadeDeleteQueue] error deleting queue %s: %s", name, err)
+		}
+		fmt.Fprintf(a.Out, "Deleting queue %s in the background; see operation %s for progress\n", name, api.QueueDeletionOperationName(name))
+		return nil
+	})
+}
+
+// ArchiveQueue archives the queue with the given name, such that it rejects new jobs.
+func (a *App) ArchiveQueue(name string, reason string) error {
+	return client.WithSubmitClient(a.Params.ApiConnectionDetails, func(c api.SubmitClient) error {
+		ctx, cancel := common.ContextWithDefaultTimeout()
+		defer cancel()
+
+		if _, err := c.ArchiveQueue(ctx, &api.QueueArchiveRequest{Name: name, Reason: reason}); err != nil {
+			return errors.Errorf("[armadactl.ArchiveQueue] error archiving queue %s: %s", name, err)
+		}
+		fmt.Fprintf(a.Out, "Archived queue %s\n", name)
+		return nil
+	})
+}
+
+// RestoreQueue restores the archived queue with the given name, such that it accepts new jobs again.
+func (a *App) RestoreQueue(name string) error {
+	return client.WithSubmitClient(a.Params.ApiConnectionDetails, func(c api.SubmitClient) error {
+		ctx, cancel := common.ContextWithDefaultTimeout()
+		defer cancel()
+
+		if _, err := c.RestoreQueue(ctx, &api.QueueRestoreRequest{Name: name}); err != nil {
+			return errors.Errorf("[armadactl.RestoreQueue] error restoring queue %s: %s", name, err)
+		}
+		fmt.Fprintf(a.Out, "Restored queue %s\n", name)
+		return nil
+	})
+}
+
 // DescribeQueue calls app.QueueAPI.Describe with the provided parameters.
 func (a *App) DescribeQueue(name string) error {
 	fmt.Fprintf(a.Out, "Queue: %s\n", name)
@@ -72,13 +189,52 @@ func (a *App) DescribeQueue(name string) error {
 		fmt.Fprintf(a.Out, "No queued or running jobs\n")
 	} else {
 		for _, jobSet := range jobSets {
-			fmt.Fprintf(a.Out, "[job set: %s] Running: %d, Queued: %d\n", jobSet.Name, jobSet.LeasedJobs, jobSet.QueuedJobs)
+			fmt.Fprintf(
+				a.Out, "[job set: %s] Running: %d, Pending: %d, Queued: %d, Suspended: %d, Leased resources: %s\n",
+				jobSet.Name, jobSet.RunningJobs, jobSet.PendingJobs, jobSet.QueuedJobs, jobSet.SuspendedJobs,
+				armadaresource.ComputeResources(jobSet.LeasedResources).String(),
+			)
 		}
+		fmt.Fprintf(
+			a.Out, "[total] Running: %d, Pending: %d, Queued: %d, Suspended: %d, Leased resources: %s\n",
+			queueInfo.RunningJobs, queueInfo.PendingJobs, queueInfo.QueuedJobs, queueInfo.SuspendedJobs,
+			armadaresource.ComputeResources(queueInfo.LeasedResources).String(),
+		)
 	}
 
 	return nil
 }
 
+// GetQueueBudgets prints the usage of the resource budgets of the queue with the given name.
+// Budgets that apply to each owner separately are reported for owner, or for the caller if owner is empty.
+func (a *App) GetQueueBudgets(name string, owner string) error {
+	return client.WithSubmitClient(a.Params.ApiConnectionDetails, func(c api.SubmitClient) error {
+		ctx, cancel := common.ContextWithDefaultTimeout()
+		defer cancel()
+
+		budgets, err := c.GetQueueBudgets(ctx, &api.QueueBudgetsRequest{Queue: name, Owner: owner})
+		if err != nil {
+			return errors.Errorf("[armadactl.GetQueueBudgets] error getting budgets of queue %s: %s", name, err)
+		}
+		if len(budgets.Budgets) == 0 {
+			fmt.Fprintf(a.Out, "Queue %s has no resource budgets\n", name)
+			return nil
+		}
+		for _, status := range budgets.Budgets {
+			scope := "queue"
+			if status.Budget.PerOwner {
+				scope = "owner " + status.Owner
+			}
+			fmt.Fprintf(
+				a.Out, "[%s over %dh, %s] Used: %.2f of %g hours, Remaining: %.2f hours, Once exhausted: %s\n",
+				status.Budget.Resource, status.Budget.WindowHours, scope,
+				status.UsedHours, status.Budget.Hours, status.RemainingHours, strings.ToLower(status.Budget.Action.String()),
+			)
+		}
+		return nil
+	})
+}
+
 // GetQueue calls app.QueueAPI.Get with the provided parameters.
 func (a *App) GetQueue(name string) error {
 	queue, err := a.Params.QueueAPI.Get(name)
@@ -0,0 +1,209 @@
+package armadactl
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/term"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/client"
+)
+
+// ExecJob runs a command in a pod of a running job, relaying stdin to it and its output to a.Out and stderr,
+// and returns the exit code of the command. If the command runs in a terminal and stdin is one, it's put in raw mode.
+func (a *App) ExecJob(req *api.JobSessionOpenRequest, stdin io.Reader, stderr io.Writer) (int32, error) {
+	var exitCode int32
+	err := client.WithJobSessionsClient(a.Params.ApiConnectionDetails, func(c api.JobSessionsClient) error {
+		ctx, cancel := armadacontext.WithCancel(armadacontext.Background())
+		defer cancel()
+		stream, err := openJobSession(ctx, c, req)
+		if err != nil {
+			return err
+		}
+		sender := &jobSessionSender{stream: stream}
+
+		if file, ok := stdin.(*os.File); ok && req.GetExec().GetTty() && term.IsTerminal(int(file.Fd())) {
+			state, err := term.MakeRaw(int(file.Fd()))
+			if err != nil {
+				return errors.Wrap(err, "error putting terminal in raw mode")
+			}
+			defer func() { _ = term.Restore(int(file.Fd()), state) }()
+			watchTerminalSize(ctx, int(file.Fd()), func(width, height int) {
+				_ = sender.send(&api.JobSessionFrame{Frame: &api.JobSessionFrame_Resize{
+					Resize: &api.TerminalSize{Width: uint32(width), Height: uint32(height)},
+				}})
+			})
+		}
+		// The command keeps running after stdin is closed, e.g., to write the output of piped input.
+		go func() {
+			if err := sender.relay(stdin); err == io.EOF {
+				_ = sender.closeSend()
+			}
+		}()
+
+		for {
+			frame, err := stream.Recv()
+			if err != nil {
+				return errors.Errorf("[armadactl.ExecJob] session into job %s ended unexpectedly: %s", req.JobId, err)
+			}
+			switch f := frame.Frame.(type) {
+			case *api.JobSessionFrame_Stdout:
+				_, err = a.Out.Write(f.Stdout)
+			case *api.JobSessionFrame_Stderr:
+				_, err = stderr.Write(f.Stderr)
+			case *api.JobSessionFrame_End:
+				if f.End.Error != "" {
+					return errors.Errorf("[armadactl.ExecJob] error running command in job %s: %s", req.JobId, f.End.Error)
+				}
+				exitCode = f.End.ExitCode
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+		}
+	})
+	return exitCode, err
+}
+
+// PortForwardJob forwards connections to localPort to a port of a pod of a running job, opening a session for each,
+// until it fails to accept connections.
+func (a *App) PortForwardJob(req *api.JobSessionOpenRequest, localPort uint16) error {
+	listener, err := net.Listen("tcp", fmt.Sprintf("localhost:%d", localPort))
+	if err != nil {
+		return errors.Wrapf(err, "[armadactl.PortForwardJob] error listening on port %d", localPort)
+	}
+	defer listener.Close()
+	fmt.Fprintf(a.Out, "Forwarding from %s to port %d of job %s\n", listener.Addr(), req.GetPortForward().GetPort(), req.JobId)
+
+	return client.WithJobSessionsClient(a.Params.ApiConnectionDetails, func(c api.JobSessionsClient) error {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return errors.Wrap(err, "[armadactl.PortForwardJob] error accepting connection")
+			}
+			go func() {
+				if err := forwardConnection(c, req, conn); err != nil {
+					fmt.Fprintf(a.Out, "Error forwarding connection from %s: %s\n", conn.RemoteAddr(), err)
+				}
+			}()
+		}
+	})
+}
+
+// forwardConnection relays a connection to the port of a job until either end closes it.
+func forwardConnection(c api.JobSessionsClient, req *api.JobSessionOpenRequest, conn net.Conn) error {
+	defer conn.Close()
+	ctx, cancel := armadacontext.WithCancel(armadacontext.Background())
+	defer cancel()
+	stream, err := openJobSession(ctx, c, req)
+	if err != nil {
+		return err
+	}
+	go func() {
+		// Closing the connection ends the session.
+		_ = (&jobSessionSender{stream: stream}).relay(conn)
+		cancel()
+	}()
+
+	for {
+		frame, err := stream.Recv()
+		if ctx.Err() != nil {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		if end := frame.GetEnd(); end != nil {
+			if end.Error != "" {
+				return errors.New(end.Error)
+			}
+			return nil
+		}
+		if _, err := conn.Write(frame.GetStdout()); err != nil {
+			return err
+		}
+	}
+}
+
+func openJobSession(ctx *armadacontext.Context, c api.JobSessionsClient, req *api.JobSessionOpenRequest) (api.JobSessions_OpenJobSessionClient, error) {
+	stream, err := c.OpenJobSession(ctx)
+	if err != nil {
+		return nil, errors.Errorf("[armadactl.openJobSession] error opening session into job %s: %s", req.JobId, err)
+	}
+	if err := stream.Send(&api.JobSessionClientMessage{Message: &api.JobSessionClientMessage_Open{Open: req}}); err != nil {
+		return nil, errors.Errorf("[armadactl.openJobSession] error opening session into job %s: %s", req.JobId, err)
+	}
+	return stream, nil
+}
+
+// jobSessionSender serializes sending frames of a session from multiple goroutines.
+type jobSessionSender struct {
+	stream api.JobSessions_OpenJobSessionClient
+	mu     sync.Mutex
+}
+
+func (s *jobSessionSender) send(frame *api.JobSessionFrame) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.stream.Send(&api.JobSessionClientMessage{Message: &api.JobSessionClientMessage_Frame{Frame: frame}})
+}
+
+// closeSend tells the server no more frames follow, such that stdin of the session is closed.
+func (s *jobSessionSender) closeSend() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.stream.CloseSend()
+}
+
+// relay sends the data read from r as stdin frames until r is closed.
+func (s *jobSessionSender) relay(r io.Reader) error {
+	buf := make([]byte, 32*1024)
+	for {
+		n, err := r.Read(buf)
+		if n > 0 {
+			if err := s.send(&api.JobSessionFrame{Frame: &api.JobSessionFrame_Stdin{Stdin: append([]byte(nil), buf[:n]...)}}); err != nil {
+				return err
+			}
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// terminalSizePollInterval is how often the size of the local terminal is checked for changes while exec'ing.
+const terminalSizePollInterval = 250 * time.Millisecond
+
+// watchTerminalSize calls onResize with the size of the terminal fd refers to, and then each time it changes,
+// until ctx is cancelled. The size is polled, as not every platform signals resizes.
+func watchTerminalSize(ctx *armadacontext.Context, fd int, onResize func(width, height int)) {
+	width, height, err := term.GetSize(fd)
+	if err != nil {
+		return
+	}
+	onResize(width, height)
+	go func() {
+		ticker := time.NewTicker(terminalSizePollInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+			w, h, err := term.GetSize(fd)
+			if err != nil || (w == width && h == height) {
+				continue
+			}
+			width, height = w, h
+			onResize(width, height)
+		}
+	}()
+}
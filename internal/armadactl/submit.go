@@ -1,9 +1,18 @@
 package armadactl
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
 
 	"github.com/pkg/errors"
+	"golang.org/x/sync/errgroup"
 
 	"github.com/armadaproject/armada/pkg/api"
 	"github.com/armadaproject/armada/pkg/client"
@@ -12,49 +21,299 @@ import (
 	"github.com/armadaproject/armada/pkg/client/validation"
 )
 
+// Extensions of the files of a directory submitted by SubmitFiles.
+var submitFileExtensions = []string{".yaml", ".yml", ".json"}
+
 // Submit a job, represented by a file, to the Armada server.
 // If dry-run is true, the job file is validated but not submitted.
 func (a *App) Submit(path string, dryRun bool) error {
-	ok, err := validation.ValidateSubmitFile(path)
-	if !ok {
+	requests, err := submitFileRequests(path)
+	if err != nil {
 		return err
 	}
 
-	submitFile := &domain.JobSubmitFile{}
-	err = util.BindJsonOrYaml(path, submitFile)
+	if dryRun {
+		return nil
+	}
+
+	return client.WithSubmitClient(a.Params.ApiConnectionDetails, func(originalClient api.SubmitClient) error {
+		c := api.CustomSubmitClient{Inner: originalClient}
+		_, err := submitRequests(c, requests, a.SubmitterOptions, a.Out, func(int) error { return nil })
+		return err
+	})
+}
+
+// ExpandSubmitPath returns the files to submit for path, which may be a file, a directory, whose files with the
+// extension .yaml, .yml or .json are submitted, or a glob pattern, e.g., "jobs/*.yaml". Files are returned in order.
+func ExpandSubmitPath(path string) ([]string, error) {
+	if info, err := os.Stat(path); err == nil {
+		if !info.IsDir() {
+			return []string{path}, nil
+		}
+		entries, err := os.ReadDir(path)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		var files []string
+		for _, entry := range entries {
+			if !entry.IsDir() && isSubmitFile(entry.Name()) {
+				files = append(files, filepath.Join(path, entry.Name()))
+			}
+		}
+		if len(files) == 0 {
+			return nil, fmt.Errorf("directory %s contains no files with extension %s", path, strings.Join(submitFileExtensions, ", "))
+		}
+		return files, nil
+	}
+
+	matches, err := filepath.Glob(path)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	var files []string
+	for _, match := range matches {
+		if info, err := os.Stat(match); err == nil && !info.IsDir() {
+			files = append(files, match)
+		}
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no files match %s", path)
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+func isSubmitFile(name string) bool {
+	for _, extension := range submitFileExtensions {
+		if strings.EqualFold(filepath.Ext(name), extension) {
+			return true
+		}
+	}
+	return false
+}
+
+// SubmitFiles submits the jobs of files, submitting up to parallelism files at a time, and prints a report of the
+// files that were submitted and those that failed. Failing files don't stop the others from being submitted.
+//
+// If resumeFile is set, the requests submitted for each file are recorded in it, and requests it records are skipped,
+// such that rerunning SubmitFiles with the same resumeFile after failures submits only what wasn't submitted before.
+// If dry-run is true, the files are validated but not submitted.
+func (a *App) SubmitFiles(files []string, dryRun bool, parallelism int, resumeFile string) error {
+	progress, err := loadSubmitProgress(resumeFile)
+	if err != nil {
+		return err
+	}
+
+	results := make([]submitFileResult, len(files))
+	err = client.WithSubmitClient(a.Params.ApiConnectionDetails, func(originalClient api.SubmitClient) error {
+		c := api.CustomSubmitClient{Inner: originalClient}
+		mutex := sync.Mutex{}
+		g := errgroup.Group{}
+		g.SetLimit(parallelism)
+		for i, file := range files {
+			i, file := i, file
+			g.Go(func() error {
+				out := &bytes.Buffer{}
+				results[i] = progress.submitFile(c, file, dryRun, a.SubmitterOptions, out)
+				mutex.Lock()
+				defer mutex.Unlock()
+				if out.Len() > 0 {
+					fmt.Fprintf(a.Out, "[%s]\n%s", file, out)
+				}
+				return nil
+			})
+		}
+		return g.Wait()
+	})
 	if err != nil {
 		return err
 	}
 
+	var submittedJobs, submittedFiles, skippedFiles int
+	var failed []submitFileResult
+	for _, result := range results {
+		switch {
+		case result.err != nil:
+			failed = append(failed, result)
+		case result.skipped:
+			skippedFiles++
+		default:
+			submittedFiles++
+			submittedJobs += result.jobs
+		}
+	}
 	if dryRun {
+		fmt.Fprintf(a.Out, "Validated %d files\n", submittedFiles)
+	} else {
+		fmt.Fprintf(a.Out, "Submitted %d jobs from %d files\n", submittedJobs, submittedFiles)
+	}
+	if skippedFiles > 0 {
+		fmt.Fprintf(a.Out, "Skipped %d files submitted before, as recorded in %s\n", skippedFiles, resumeFile)
+	}
+	if len(failed) == 0 {
+		return nil
+	}
+	fmt.Fprintf(a.Out, "Failed to submit %d files:\n", len(failed))
+	for _, result := range failed {
+		fmt.Fprintf(a.Out, "  %s: %s\n", result.file, result.err)
+	}
+	if resumeFile != "" && !dryRun {
+		fmt.Fprintf(a.Out, "Rerun with --resume-file %s to submit only the jobs that weren't submitted\n", resumeFile)
+	}
+	return fmt.Errorf("failed to submit %d of %d files", len(failed), len(files))
+}
+
+type submitFileResult struct {
+	file string
+	// Number of jobs submitted.
+	jobs int
+	// True if all requests of the file were submitted before.
+	skipped bool
+	err     error
+}
+
+// submitProgress is the number of requests submitted for each file, by absolute path, as recorded in a resume file.
+type submitProgress struct {
+	path    string
+	mutex   sync.Mutex
+	Entries map[string]int `json:"submittedRequests"`
+}
+
+func loadSubmitProgress(path string) (*submitProgress, error) {
+	progress := &submitProgress{path: path, Entries: make(map[string]int)}
+	if path == "" {
+		return progress, nil
+	}
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return progress, nil
+	} else if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if err := json.Unmarshal(data, progress); err != nil {
+		return nil, fmt.Errorf("failed to parse resume file %s: %s", path, err)
+	}
+	if progress.Entries == nil {
+		progress.Entries = make(map[string]int)
+	}
+	return progress, nil
+}
+
+func (progress *submitProgress) submitted(file string) int {
+	progress.mutex.Lock()
+	defer progress.mutex.Unlock()
+	return progress.Entries[file]
+}
+
+// record records that the first n requests of file were submitted. It's a no-op if there is no resume file.
+func (progress *submitProgress) record(file string, n int) error {
+	if progress.path == "" {
 		return nil
 	}
+	progress.mutex.Lock()
+	defer progress.mutex.Unlock()
+	progress.Entries[file] = n
+	data, err := json.MarshalIndent(progress, "", "  ")
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	// The file is replaced atomically, such that it isn't corrupted if armadactl is interrupted.
+	tmp := progress.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return errors.WithStack(err)
+	}
+	return errors.WithStack(os.Rename(tmp, progress.path))
+}
+
+// submitFile submits the requests of file not submitted before, writing its output to out.
+func (progress *submitProgress) submitFile(c api.CustomSubmitClient, file string, dryRun bool, opts []client.SubmitterOption, out io.Writer) submitFileResult {
+	result := submitFileResult{file: file}
+	requests, err := submitFileRequests(file)
+	if err != nil {
+		result.err = err
+		return result
+	}
+	if dryRun {
+		return result
+	}
+	key, err := filepath.Abs(file)
+	if err != nil {
+		result.err = errors.WithStack(err)
+		return result
+	}
+	submitted := progress.submitted(key)
+	if submitted >= len(requests) {
+		result.skipped = true
+		return result
+	}
+	result.jobs, result.err = submitRequests(c, requests[submitted:], opts, out, func(i int) error {
+		return progress.record(key, submitted+i+1)
+	})
+	return result
+}
+
+// submitFileRequests validates the submit file at path and returns the requests submitting its jobs.
+func submitFileRequests(path string) ([]*api.JobSubmitRequest, error) {
+	ok, err := validation.ValidateSubmitFile(path)
+	if !ok {
+		return nil, err
+	}
+
+	submitFile := &domain.JobSubmitFile{}
+	err = util.BindJsonOrYaml(path, submitFile)
+	if err != nil {
+		return nil, err
+	}
 
 	requests := client.CreateChunkedSubmitRequests(submitFile.Queue, submitFile.JobSetId, submitFile.Jobs)
-	return client.WithSubmitClient(a.Params.ApiConnectionDetails, func(originalClient api.SubmitClient) error {
-		c := api.CustomSubmitClient{Inner: originalClient}
+	for _, jobArray := range submitFile.JobArrays {
+		requests = append(requests, &api.JobSubmitRequest{
+			Queue:     submitFile.Queue,
+			JobSetId:  submitFile.JobSetId,
+			JobArrays: []*api.JobArray{jobArray},
+		})
+	}
+	for _, request := range requests {
+		request.BasePodSpec = submitFile.BasePodSpec
+	}
+	return requests, nil
+}
 
-		for _, request := range requests {
-			response, err := client.CustomClientSubmitJobs(c, request)
-			if err != nil {
-				if response != nil {
-					fmt.Fprintln(a.Out, "[JobSubmitResponse]")
-					for _, jobResponseItem := range response.JobResponseItems {
-						fmt.Fprintf(a.Out, "Error submitting job with id %s, details: %s\n", jobResponseItem.JobId, jobResponseItem.Error)
-					}
+// submitRequests submits requests in order with the given submitter options, writing the outcome of each to out and
+// calling onSubmitted with the index of each request submitted. It stops at the first request that fails, and returns
+// the number of jobs submitted.
+func submitRequests(c api.CustomSubmitClient, requests []*api.JobSubmitRequest, opts []client.SubmitterOption, out io.Writer, onSubmitted func(int) error) (int, error) {
+	jobs := 0
+	for i, request := range requests {
+		response, err := client.CustomClientSubmitJobs(c, request, opts...)
+		if err != nil {
+			if response != nil {
+				fmt.Fprintln(out, "[JobSubmitResponse]")
+				for _, jobResponseItem := range response.JobResponseItems {
+					fmt.Fprintf(out, "Error submitting job with id %s, details: %s\n", jobResponseItem.JobId, jobResponseItem.ErrorString())
+				}
+				if response.FailureReportId != "" {
+					fmt.Fprintf(out, "Only some errors are shown; all can be retrieved using GetSubmitFailureReport with id %s\n", response.FailureReportId)
 				}
-				fmt.Fprintln(a.Out, "[Error]")
-				return errors.WithMessagef(err, "error submitting request %#v", request)
 			}
+			fmt.Fprintln(out, "[Error]")
+			return jobs, errors.WithMessagef(err, "error submitting request %#v", request)
+		}
 
-			for _, jobResponseItem := range response.JobResponseItems {
-				if jobResponseItem.Error != "" {
-					fmt.Fprintf(a.Out, "Error submitting job: %s\n", jobResponseItem.Error)
-				} else {
-					fmt.Fprintf(a.Out, "Submitted job with id %s to job set %s\n", jobResponseItem.JobId, request.JobSetId)
+		for _, jobResponseItem := range response.JobResponseItems {
+			if jobResponseItem.Failed() {
+				fmt.Fprintf(out, "Error submitting job: %s\n", jobResponseItem.ErrorString())
+			} else {
+				jobs++
+				fmt.Fprintf(out, "Submitted job with id %s to job set %s\n", jobResponseItem.JobId, request.JobSetId)
+				if jobResponseItem.Warning != "" {
+					fmt.Fprintf(out, "Warning for job with id %s: %s\n", jobResponseItem.JobId, jobResponseItem.Warning)
 				}
 			}
 		}
-		return nil
-	})
+		if err := onSubmitted(i); err != nil {
+			return jobs, errors.WithMessage(err, "error recording submitted request")
+		}
+	}
+	return jobs, nil
 }
@@ -0,0 +1,68 @@
+package armadactl
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpandSubmitPath(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{"b.yaml", "a.yml", "c.json", "notes.txt"} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), nil, 0o644))
+	}
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "nested.yaml"), 0o755))
+
+	tests := map[string]struct {
+		path     string
+		expected []string
+		err      bool
+	}{
+		"file":               {path: filepath.Join(dir, "notes.txt"), expected: []string{"notes.txt"}},
+		"directory":          {path: dir, expected: []string{"a.yml", "b.yaml", "c.json"}},
+		"glob":               {path: filepath.Join(dir, "*.y*ml"), expected: []string{"a.yml", "b.yaml"}},
+		"glob matching none": {path: filepath.Join(dir, "*.csv"), err: true},
+		"missing file":       {path: filepath.Join(dir, "missing.yaml"), err: true},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			files, err := ExpandSubmitPath(tc.path)
+			if tc.err {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			var expected []string
+			for _, name := range tc.expected {
+				expected = append(expected, filepath.Join(dir, name))
+			}
+			assert.Equal(t, expected, files)
+		})
+	}
+}
+
+func TestSubmitProgress_RecordsSubmittedRequests(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "resume.json")
+
+	progress, err := loadSubmitProgress(path)
+	require.NoError(t, err)
+	assert.Equal(t, 0, progress.submitted("/jobs/a.yaml"))
+	require.NoError(t, progress.record("/jobs/a.yaml", 1))
+	require.NoError(t, progress.record("/jobs/a.yaml", 2))
+	require.NoError(t, progress.record("/jobs/b.yaml", 1))
+
+	progress, err = loadSubmitProgress(path)
+	require.NoError(t, err)
+	assert.Equal(t, 2, progress.submitted("/jobs/a.yaml"))
+	assert.Equal(t, 1, progress.submitted("/jobs/b.yaml"))
+}
+
+func TestSubmitProgress_WithoutResumeFile(t *testing.T) {
+	progress, err := loadSubmitProgress("")
+	require.NoError(t, err)
+	require.NoError(t, progress.record("/jobs/a.yaml", 1))
+	assert.Equal(t, 0, progress.submitted("/jobs/a.yaml"))
+}
@@ -0,0 +1,103 @@
+package armadactl
+
+import (
+	"encoding/csv"
+	"fmt"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/pkg/errors"
+	parquetWriter "github.com/xitongsys/parquet-go/writer"
+
+	"github.com/armadaproject/armada/internal/common"
+	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/client"
+)
+
+// Formats usage reports may be printed in.
+const (
+	UsageReportFormatTable   = "table"
+	UsageReportFormatCsv     = "csv"
+	UsageReportFormatParquet = "parquet"
+)
+
+// usageReportRow is a usage record as written to parquet files.
+type usageReportRow struct {
+	Date            string  `parquet:"name=date, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY"`
+	Queue           string  `parquet:"name=queue, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY"`
+	Owner           string  `parquet:"name=owner, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY"`
+	Resource        string  `parquet:"name=resource, type=BYTE_ARRAY, convertedtype=UTF8, encoding=PLAIN_DICTIONARY"`
+	ResourceSeconds float64 `parquet:"name=resource_seconds, type=DOUBLE"`
+}
+
+// GetUsageReport prints the daily resource usage matching request in the given format.
+func (a *App) GetUsageReport(request *api.UsageReportRequest, format string) error {
+	switch format {
+	case UsageReportFormatTable, UsageReportFormatCsv, UsageReportFormatParquet:
+	default:
+		return errors.Errorf("[armadactl.GetUsageReport] unknown format %q: must be one of table, csv, or parquet", format)
+	}
+	return client.WithQueryClient(a.Params.ApiConnectionDetails, func(c api.QueryClient) error {
+		ctx, cancel := common.ContextWithDefaultTimeout()
+		defer cancel()
+
+		report, err := c.GetUsageReport(ctx, request)
+		if err != nil {
+			return errors.Errorf("[armadactl.GetUsageReport] error getting usage report: %s", err)
+		}
+		switch format {
+		case UsageReportFormatCsv:
+			return a.writeUsageReportCsv(report)
+		case UsageReportFormatParquet:
+			return a.writeUsageReportParquet(report)
+		}
+		if len(report.Records) == 0 {
+			fmt.Fprintln(a.Out, "No usage recorded")
+			return nil
+		}
+		w := tabwriter.NewWriter(a.Out, 1, 1, 2, ' ', 0)
+		fmt.Fprintln(w, "DATE\tQUEUE\tOWNER\tRESOURCE\tRESOURCE-HOURS")
+		for _, record := range report.Records {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%.2f\n", record.Date, record.Queue, record.Owner, record.Resource, record.ResourceSeconds/3600)
+		}
+		return w.Flush()
+	})
+}
+
+func (a *App) writeUsageReportCsv(report *api.UsageReport) error {
+	w := csv.NewWriter(a.Out)
+	if err := w.Write([]string{"date", "queue", "owner", "resource", "resource_seconds"}); err != nil {
+		return errors.WithStack(err)
+	}
+	for _, record := range report.Records {
+		row := []string{
+			record.Date, record.Queue, record.Owner, record.Resource,
+			strconv.FormatFloat(record.ResourceSeconds, 'f', -1, 64),
+		}
+		if err := w.Write(row); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+	w.Flush()
+	return errors.WithStack(w.Error())
+}
+
+func (a *App) writeUsageReportParquet(report *api.UsageReport) error {
+	pw, err := parquetWriter.NewParquetWriterFromWriter(a.Out, new(usageReportRow), 1)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	for _, record := range report.Records {
+		row := usageReportRow{
+			Date:            record.Date,
+			Queue:           record.Queue,
+			Owner:           record.Owner,
+			Resource:        record.Resource,
+			ResourceSeconds: record.ResourceSeconds,
+		}
+		if err := pw.Write(row); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+	return errors.WithStack(pw.WriteStop())
+}
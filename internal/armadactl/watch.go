@@ -3,9 +3,17 @@ package armadactl
 import (
 	"encoding/json"
 	"fmt"
+	"io"
+	"os"
 	"reflect"
+	"strings"
+	"sync"
 	"time"
 
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/term"
+
 	"github.com/armadaproject/armada/internal/common/armadacontext"
 	"github.com/armadaproject/armada/pkg/api"
 	"github.com/armadaproject/armada/pkg/client"
@@ -16,7 +24,7 @@ import (
 func (a *App) Watch(queue string, jobSetId string, raw bool, exitOnInactive bool, forceNewEvents bool, forceLegacyEvents bool) error {
 	fmt.Fprintf(a.Out, "Watching job set %s\n", jobSetId)
 	return client.WithEventClient(a.Params.ApiConnectionDetails, func(c api.EventClient) error {
-		client.WatchJobSet(c, queue, jobSetId, true, true, forceNewEvents, forceLegacyEvents, armadacontext.Background(), func(state *domain.WatchContext, event api.Event) bool {
+		return watchJobSet(armadacontext.Background(), c, queue, jobSetId, forceNewEvents, forceLegacyEvents, func(state *domain.WatchContext, event api.Event) bool {
 			if raw {
 				data, err := json.Marshal(event)
 				if err != nil {
@@ -48,7 +56,38 @@ func (a *App) Watch(queue string, jobSetId string, raw bool, exitOnInactive bool
 			}
 			return false
 		})
-		return nil
+	})
+}
+
+// watchJobSet calls onUpdate with each event of the job set, and the state of the job set after it, until onUpdate
+// returns true or ctx is done. Unlike client.WatchJobSet, errors, e.g., if the job set doesn't exist, are returned.
+func watchJobSet(
+	ctx *armadacontext.Context,
+	c api.EventClient,
+	queue string,
+	jobSetId string,
+	forceNewEvents bool,
+	forceLegacyEvents bool,
+	onUpdate func(*domain.WatchContext, api.Event) bool,
+) error {
+	state := domain.NewWatchContext()
+	request := &api.JobSetRequest{
+		Queue:          queue,
+		Id:             jobSetId,
+		Watch:          true,
+		ErrorIfMissing: true,
+		ForceNew:       forceNewEvents,
+		ForceLegacy:    forceLegacyEvents,
+	}
+	return client.WatchJobSetEvents(ctx, c, request, func(msg *api.EventStreamMessage) bool {
+		event, err := api.UnwrapEvent(msg.Message)
+		if err != nil {
+			// The event may be of a type unknown to this version of armadactl.
+			log.Error(err)
+			return false
+		}
+		state.ProcessEvent(event)
+		return onUpdate(state, event)
 	})
 }
 
@@ -62,3 +101,148 @@ func (a *App) printSummary(state *domain.WatchContext, e api.Event) {
 	}
 	fmt.Fprintf(a.Out, "%s\n", summary)
 }
+
+// Width of the progress bar printed by WatchProgress, in characters.
+const progressBarWidth = 30
+
+// Number of failure reasons printed by WatchProgress; the rest are summarised as a count.
+const maxPrintedFailureReasons = 5
+
+// WatchProgress prints the progress of a job set, i.e., the number of its jobs in each state, why jobs failed, and
+// when its jobs are expected to finish, at most every interval while it changes. If the output is a terminal, the
+// progress is redrawn in place. If jsonOutput is set, the progress is instead printed as a JSON object per line.
+func (a *App) WatchProgress(
+	queue string,
+	jobSetId string,
+	jsonOutput bool,
+	interval time.Duration,
+	exitOnInactive bool,
+	forceNewEvents bool,
+	forceLegacyEvents bool,
+) error {
+	printer := &progressPrinter{out: a.Out, jobSetId: jobSetId, json: jsonOutput}
+	if file, ok := a.Out.(*os.File); ok && !jsonOutput {
+		printer.terminal = term.IsTerminal(int(file.Fd()))
+	}
+	tracker := domain.NewProgressTracker()
+	// The progress as of the last event, snapshotted as events are processed, since the state of the job set is
+	// updated while events are processed, outside of the lock.
+	var progress *domain.JobSetProgress
+	mutex := sync.Mutex{}
+	printProgress := func() error {
+		mutex.Lock()
+		snapshot := progress
+		mutex.Unlock()
+		if snapshot == nil {
+			return nil
+		}
+		return printer.print(snapshot)
+	}
+
+	return client.WithEventClient(a.Params.ApiConnectionDetails, func(c api.EventClient) error {
+		ctx, cancel := armadacontext.WithCancel(armadacontext.Background())
+		defer cancel()
+		g, ctx := armadacontext.ErrGroup(ctx)
+		g.Go(func() error {
+			ticker := time.NewTicker(interval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return nil
+				case <-ticker.C:
+					if err := printProgress(); err != nil {
+						return err
+					}
+				}
+			}
+		})
+		g.Go(func() error {
+			defer cancel()
+			return watchJobSet(ctx, c, queue, jobSetId, forceNewEvents, forceLegacyEvents, func(state *domain.WatchContext, event api.Event) bool {
+				tracker.ProcessEvent(event)
+				snapshot := tracker.Progress(state)
+				mutex.Lock()
+				progress = snapshot
+				mutex.Unlock()
+				return exitOnInactive && state.GetNumberOfJobs() == state.GetNumberOfFinishedJobs()
+			})
+		})
+		if err := g.Wait(); err != nil {
+			return err
+		}
+		return printProgress()
+	})
+}
+
+// progressPrinter prints the progress of a job set each time it changes.
+type progressPrinter struct {
+	out      io.Writer
+	jobSetId string
+	json     bool
+	// If set, the progress previously printed is overwritten.
+	terminal bool
+	last     *domain.JobSetProgress
+	// Number of lines previously printed.
+	lastLines int
+}
+
+func (p *progressPrinter) print(progress *domain.JobSetProgress) error {
+	if reflect.DeepEqual(progress, p.last) {
+		return nil
+	}
+	p.last = progress
+	if p.json {
+		data, err := json.Marshal(progress)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		_, err = fmt.Fprintf(p.out, "%s\n", data)
+		return errors.WithStack(err)
+	}
+
+	lines := formatProgress(p.jobSetId, progress)
+	if p.terminal && p.lastLines > 0 {
+		// Move the cursor to the start of the progress previously printed and clear it.
+		fmt.Fprintf(p.out, "\033[%dA\033[J", p.lastLines)
+	}
+	p.lastLines = len(lines)
+	_, err := fmt.Fprintf(p.out, "%s\n", strings.Join(lines, "\n"))
+	return errors.WithStack(err)
+}
+
+// formatProgress returns the lines printed for the progress of a job set, e.g.,
+//
+//	Oct 15 12:00:00 | my-job-set [###############---------------]  50% 5/10 jobs finished, ETA 2m30s
+//	Queued: 2, Suspended: 0, Leased: 0, Pending: 1, Running: 2, Succeeded: 4, Failed: 1, Cancelled: 0
+//	Failed jobs by reason:
+//	      1 OOMKilled
+func formatProgress(jobSetId string, progress *domain.JobSetProgress) []string {
+	filled, percent := 0, 0
+	if progress.Jobs > 0 {
+		filled = progressBarWidth * progress.Finished / progress.Jobs
+		percent = 100 * progress.Finished / progress.Jobs
+	}
+	bar := strings.Repeat("#", filled) + strings.Repeat("-", progressBarWidth-filled)
+	summary := fmt.Sprintf(
+		"%s | %s [%s] %3d%% %d/%d jobs finished",
+		progress.Time.Format(time.Stamp), jobSetId, bar, percent, progress.Finished, progress.Jobs,
+	)
+	if progress.EstimatedSecondsRemaining != nil && progress.Finished < progress.Jobs {
+		eta := time.Duration(*progress.EstimatedSecondsRemaining * float64(time.Second)).Round(time.Second)
+		summary += fmt.Sprintf(", ETA %s", eta)
+	}
+
+	lines := []string{summary, progress.StateSummary()}
+	if len(progress.FailureReasons) > 0 {
+		lines = append(lines, "Failed jobs by reason:")
+		for i, reason := range progress.FailureReasons {
+			if i == maxPrintedFailureReasons {
+				lines = append(lines, fmt.Sprintf("%7s %d more reasons", "...", len(progress.FailureReasons)-i))
+				break
+			}
+			lines = append(lines, fmt.Sprintf("%7d %s", reason.Jobs, reason.Reason))
+		}
+	}
+	return lines
+}
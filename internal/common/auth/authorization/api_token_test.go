@@ -0,0 +1,63 @@
+package authorization
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/armadaproject/armada/internal/common/armadaerrors"
+)
+
+type fakeApiTokenVerifier map[string]*ApiTokenPrincipal
+
+func (v fakeApiTokenVerifier) VerifyApiToken(_ context.Context, tokenId string, secret string) (*ApiTokenPrincipal, error) {
+	if secret != "secret" {
+		return nil, errors.New("invalid secret")
+	}
+	principal, ok := v[tokenId]
+	if !ok {
+		return nil, errors.New("unknown token")
+	}
+	return principal, nil
+}
+
+func TestApiTokenAuthService(t *testing.T) {
+	service := NewApiTokenAuthService(
+		fakeApiTokenVerifier{
+			"token-1": NewApiTokenPrincipal("token-1", "alice", []string{"ml-team"}, []ApiTokenScope{{Queue: "ml-training", Verbs: []string{"submit"}}}),
+		},
+		[]string{"/api.Submit/SubmitJobs"},
+	)
+	contextFor := func(method string, authorization string) context.Context {
+		ctx := grpc.NewContextWithServerTransportStream(context.Background(), &fakeServerTransportStream{method: method})
+		return metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", authorization))
+	}
+
+	principal, err := service.Authenticate(contextFor("/api.Submit/SubmitJobs", "Token token-1.secret"))
+	require.NoError(t, err)
+	tokenPrincipal, ok := principal.(*ApiTokenPrincipal)
+	require.True(t, ok)
+	assert.Equal(t, "alice", principal.GetName())
+	assert.Equal(t, "token-1", tokenPrincipal.TokenId)
+	assert.True(t, principal.IsInGroup("ml-team"))
+	assert.True(t, tokenPrincipal.AllowsQueueAction("ml-training", "submit"))
+	assert.False(t, tokenPrincipal.AllowsQueueAction("ml-training", "cancel"))
+	assert.False(t, tokenPrincipal.AllowsQueueAction("batch", "submit"))
+
+	var invalidCredsErr *armadaerrors.ErrInvalidCredentials
+	_, err = service.Authenticate(contextFor("/api.Submit/SubmitJobs", "Token token-1.wrong"))
+	assert.ErrorAs(t, err, &invalidCredsErr)
+	_, err = service.Authenticate(contextFor("/api.Submit/SubmitJobs", "Token token-1"))
+	assert.ErrorAs(t, err, &invalidCredsErr)
+	_, err = service.Authenticate(contextFor("/api.Submit/CreateQueue", "Token token-1.secret"))
+	assert.ErrorAs(t, err, &invalidCredsErr)
+
+	var missingCredsErr *armadaerrors.ErrMissingCredentials
+	_, err = service.Authenticate(contextFor("/api.Submit/SubmitJobs", "Basic cm9vdDp0b29y"))
+	assert.ErrorAs(t, err, &missingCredsErr)
+}
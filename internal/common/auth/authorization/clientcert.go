@@ -0,0 +1,190 @@
+package authorization
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+
+	"github.com/armadaproject/armada/internal/common/armadaerrors"
+	"github.com/armadaproject/armada/internal/common/auth/configuration"
+)
+
+// The revocation list is checked for modifications at most this often.
+const crlRefreshInterval = time.Minute
+
+// ClientCertAuthService authenticates requests made over TLS connections on which the client presented a certificate
+// verified against the client CAs of the server. The principal name is taken from the subject or a SAN of the certificate.
+type ClientCertAuthService struct {
+	usernameField                 string
+	groupsFromOrganizationalUnits bool
+	groups                        map[string][]string
+	crl                           *revocationList
+}
+
+func NewClientCertAuthService(config configuration.ClientCertAuthConfig) (*ClientCertAuthService, error) {
+	switch config.UsernameField {
+	case "", "commonName", "email", "dns", "uri":
+	default:
+		return nil, errors.Errorf("unknown username field %q; must be one of commonName, email, dns, or uri", config.UsernameField)
+	}
+	authService := &ClientCertAuthService{
+		usernameField:                 config.UsernameField,
+		groupsFromOrganizationalUnits: config.GroupsFromOrganizationalUnits,
+		groups:                        config.Groups,
+	}
+	if config.CRLPath != "" {
+		authService.crl = &revocationList{path: config.CRLPath}
+		if err := authService.crl.refresh(); err != nil {
+			return nil, err
+		}
+	}
+	return authService, nil
+}
+
+func (authService *ClientCertAuthService) Name() string {
+	return "ClientCert"
+}
+
+func (authService *ClientCertAuthService) Authenticate(ctx context.Context) (Principal, error) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil, &armadaerrors.ErrMissingCredentials{AuthService: authService.Name()}
+	}
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	// Verified chains are only set if the client presented a certificate signed by a client CA.
+	if !ok || len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.VerifiedChains[0]) == 0 {
+		return nil, &armadaerrors.ErrMissingCredentials{AuthService: authService.Name()}
+	}
+	chain := tlsInfo.State.VerifiedChains[0]
+	cert := chain[0]
+	if authService.crl != nil {
+		if err := authService.crl.check(chain); err != nil {
+			return nil, &armadaerrors.ErrInvalidCredentials{
+				AuthService: authService.Name(),
+				Message:     err.Error(),
+			}
+		}
+	}
+	name := authService.username(cert)
+	if name == "" {
+		return nil, &armadaerrors.ErrInvalidCredentials{
+			AuthService: authService.Name(),
+			Message:     "client certificate has no " + authService.usernameField,
+		}
+	}
+	var groups []string
+	if authService.groupsFromOrganizationalUnits {
+		groups = append(groups, cert.Subject.OrganizationalUnit...)
+	}
+	groups = append(groups, authService.groups[name]...)
+	return NewStaticPrincipal(name, groups), nil
+}
+
+func (authService *ClientCertAuthService) username(cert *x509.Certificate) string {
+	switch authService.usernameField {
+	case "email":
+		if len(cert.EmailAddresses) > 0 {
+			return cert.EmailAddresses[0]
+		}
+	case "dns":
+		if len(cert.DNSNames) > 0 {
+			return cert.DNSNames[0]
+		}
+	case "uri":
+		if len(cert.URIs) > 0 {
+			return cert.URIs[0].String()
+		}
+	default:
+		return cert.Subject.CommonName
+	}
+	return ""
+}
+
+// revocationList caches a certificate revocation list read from a file, reading it again when it's modified.
+type revocationList struct {
+	path string
+
+	// Mutex guards the fields below.
+	mu        sync.Mutex
+	list      *x509.RevocationList
+	revoked   map[string]bool
+	modTime   time.Time
+	lastCheck time.Time
+	// Error with which the list was last read, if any.
+	err error
+}
+
+// check returns an error if the leaf of chain is revoked by the list, or if it can't be told whether it is, in which
+// case the certificate is rejected too: if the list can't be read, is past its next update, isn't signed by the
+// issuer of the certificate, or applies to certificates of another issuer.
+func (r *revocationList) check(chain []*x509.Certificate) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if time.Since(r.lastCheck) >= crlRefreshInterval {
+		if r.err = r.refreshLocked(); r.err != nil {
+			log.WithError(r.err).Errorf("failed to refresh certificate revocation list %s", r.path)
+		}
+	}
+	if r.err != nil {
+		return errors.WithMessagef(r.err, "certificate revocation list %s can't be read", r.path)
+	}
+	if !r.list.NextUpdate.IsZero() && time.Now().After(r.list.NextUpdate) {
+		return errors.Errorf("certificate revocation list %s is stale; it was due to be updated at %s", r.path, r.list.NextUpdate)
+	}
+	cert := chain[0]
+	if len(chain) < 2 || !bytes.Equal(r.list.RawIssuer, cert.RawIssuer) {
+		return errors.Errorf("certificate revocation list %s doesn't apply to certificates of issuer %s", r.path, cert.Issuer)
+	}
+	if err := r.list.CheckSignatureFrom(chain[1]); err != nil {
+		return errors.Wrapf(err, "certificate revocation list %s isn't signed by the issuer of the client certificate", r.path)
+	}
+	if r.revoked[cert.SerialNumber.String()] {
+		return errors.Errorf("client certificate with serial number %s is revoked", cert.SerialNumber)
+	}
+	return nil
+}
+
+func (r *revocationList) refresh() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.refreshLocked()
+}
+
+func (r *revocationList) refreshLocked() error {
+	r.lastCheck = time.Now()
+	info, err := os.Stat(r.path)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if r.list != nil && info.ModTime().Equal(r.modTime) {
+		return nil
+	}
+	data, err := os.ReadFile(r.path)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if block, _ := pem.Decode(data); block != nil {
+		data = block.Bytes
+	}
+	list, err := x509.ParseRevocationList(data)
+	if err != nil {
+		return errors.Wrapf(err, "error parsing certificate revocation list %s", r.path)
+	}
+	revoked := make(map[string]bool, len(list.RevokedCertificates))
+	for _, entry := range list.RevokedCertificates {
+		revoked[entry.SerialNumber.String()] = true
+	}
+	r.list = list
+	r.revoked = revoked
+	r.modTime = info.ModTime()
+	return nil
+}
@@ -0,0 +1,174 @@
+package authorization
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+
+	"github.com/armadaproject/armada/internal/common/armadaerrors"
+	"github.com/armadaproject/armada/internal/common/auth/configuration"
+)
+
+func TestClientCertAuthService(t *testing.T) {
+	ca, caKey := newTestCertificate(t, nil, nil, &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "client-ca"},
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+	})
+	alice, _ := newTestCertificate(t, ca, caKey, &x509.Certificate{
+		SerialNumber:   big.NewInt(2),
+		Subject:        pkix.Name{CommonName: "alice", OrganizationalUnit: []string{"ml-team"}},
+		EmailAddresses: []string{"alice@example.com"},
+	})
+	bob, _ := newTestCertificate(t, ca, caKey, &x509.Certificate{
+		SerialNumber: big.NewInt(3),
+		Subject:      pkix.Name{CommonName: "bob"},
+	})
+
+	crl, err := x509.CreateRevocationList(rand.Reader, &x509.RevocationList{
+		Number:              big.NewInt(1),
+		ThisUpdate:          time.Now(),
+		NextUpdate:          time.Now().Add(time.Hour),
+		RevokedCertificates: []pkix.RevokedCertificate{{SerialNumber: bob.SerialNumber, RevocationTime: time.Now()}},
+	}, ca, caKey)
+	require.NoError(t, err)
+	crlPath := filepath.Join(t.TempDir(), "crl.pem")
+	require.NoError(t, os.WriteFile(crlPath, pem.EncodeToMemory(&pem.Block{Type: "X509 CRL", Bytes: crl}), 0o600))
+
+	service, err := NewClientCertAuthService(configuration.ClientCertAuthConfig{
+		Enabled:                       true,
+		GroupsFromOrganizationalUnits: true,
+		Groups:                        map[string][]string{"alice": {"admins"}},
+		CRLPath:                       crlPath,
+	})
+	require.NoError(t, err)
+	contextFor := func(chain ...*x509.Certificate) context.Context {
+		state := tls.ConnectionState{}
+		if len(chain) > 0 {
+			state.VerifiedChains = [][]*x509.Certificate{chain}
+		}
+		return peer.NewContext(context.Background(), &peer.Peer{AuthInfo: credentials.TLSInfo{State: state}})
+	}
+
+	principal, err := service.Authenticate(contextFor(alice, ca))
+	require.NoError(t, err)
+	assert.Equal(t, "alice", principal.GetName())
+	assert.True(t, principal.IsInGroup("ml-team"))
+	assert.True(t, principal.IsInGroup("admins"))
+
+	var invalidCredsErr *armadaerrors.ErrInvalidCredentials
+	_, err = service.Authenticate(contextFor(bob, ca))
+	assert.ErrorAs(t, err, &invalidCredsErr)
+
+	// Certificates of other issuers aren't covered by the list.
+	otherCa, otherCaKey := newTestCertificate(t, nil, nil, &x509.Certificate{
+		SerialNumber:          big.NewInt(4),
+		Subject:               pkix.Name{CommonName: "other-ca"},
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	})
+	carol, _ := newTestCertificate(t, otherCa, otherCaKey, &x509.Certificate{
+		SerialNumber: big.NewInt(5),
+		Subject:      pkix.Name{CommonName: "carol"},
+	})
+	_, err = service.Authenticate(contextFor(carol, otherCa))
+	assert.ErrorAs(t, err, &invalidCredsErr)
+
+	var missingCredsErr *armadaerrors.ErrMissingCredentials
+	_, err = service.Authenticate(contextFor())
+	assert.ErrorAs(t, err, &missingCredsErr)
+	_, err = service.Authenticate(context.Background())
+	assert.ErrorAs(t, err, &missingCredsErr)
+
+	emailService, err := NewClientCertAuthService(configuration.ClientCertAuthConfig{Enabled: true, UsernameField: "email"})
+	require.NoError(t, err)
+	principal, err = emailService.Authenticate(contextFor(alice, ca))
+	require.NoError(t, err)
+	assert.Equal(t, "alice@example.com", principal.GetName())
+	assert.False(t, principal.IsInGroup("ml-team"))
+	_, err = emailService.Authenticate(contextFor(bob, ca))
+	assert.ErrorAs(t, err, &invalidCredsErr)
+
+	_, err = NewClientCertAuthService(configuration.ClientCertAuthConfig{Enabled: true, UsernameField: "serialNumber"})
+	assert.Error(t, err)
+}
+
+func TestClientCertAuthService_RejectsCertificatesIfRevocationListIsStaleOrUnreadable(t *testing.T) {
+	ca, caKey := newTestCertificate(t, nil, nil, &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "client-ca"},
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+	})
+	alice, _ := newTestCertificate(t, ca, caKey, &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "alice"},
+	})
+	state := tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{alice, ca}}}
+	ctx := peer.NewContext(context.Background(), &peer.Peer{AuthInfo: credentials.TLSInfo{State: state}})
+	var invalidCredsErr *armadaerrors.ErrInvalidCredentials
+
+	staleCrl, err := x509.CreateRevocationList(rand.Reader, &x509.RevocationList{
+		Number:     big.NewInt(1),
+		ThisUpdate: time.Now().Add(-2 * time.Hour),
+		NextUpdate: time.Now().Add(-time.Hour),
+	}, ca, caKey)
+	require.NoError(t, err)
+	crlPath := filepath.Join(t.TempDir(), "crl.pem")
+	require.NoError(t, os.WriteFile(crlPath, staleCrl, 0o600))
+	service, err := NewClientCertAuthService(configuration.ClientCertAuthConfig{Enabled: true, CRLPath: crlPath})
+	require.NoError(t, err)
+	_, err = service.Authenticate(ctx)
+	assert.ErrorAs(t, err, &invalidCredsErr)
+
+	crl, err := x509.CreateRevocationList(rand.Reader, &x509.RevocationList{
+		Number:     big.NewInt(2),
+		ThisUpdate: time.Now(),
+		NextUpdate: time.Now().Add(time.Hour),
+	}, ca, caKey)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(crlPath, crl, 0o600))
+	service.crl.lastCheck = time.Time{}
+	_, err = service.Authenticate(ctx)
+	require.NoError(t, err)
+
+	// Certificates are rejected once the list can't be read, rather than checked against the list read before.
+	require.NoError(t, os.Remove(crlPath))
+	service.crl.lastCheck = time.Time{}
+	_, err = service.Authenticate(ctx)
+	assert.ErrorAs(t, err, &invalidCredsErr)
+}
+
+func newTestCertificate(t *testing.T, parent *x509.Certificate, parentKey *ecdsa.PrivateKey, template *x509.Certificate) (*x509.Certificate, *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template.NotBefore = time.Now().Add(-time.Hour)
+	template.NotAfter = time.Now().Add(time.Hour)
+	if parent == nil {
+		parent, parentKey = template, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return cert, key
+}
@@ -0,0 +1,89 @@
+package authorization
+
+import (
+	"context"
+	"strings"
+
+	grpc_auth "github.com/grpc-ecosystem/go-grpc-middleware/auth"
+	"google.golang.org/grpc"
+
+	"github.com/armadaproject/armada/internal/common/armadaerrors"
+)
+
+// ExecutorPrincipal is the principal of requests authenticated with a credential minted for a particular executor.
+// It may only act on behalf of that executor.
+type ExecutorPrincipal struct {
+	*StaticPrincipal
+	CredentialId string
+	ExecutorId   string
+}
+
+func NewExecutorPrincipal(credentialId string, executorId string, groups []string) *ExecutorPrincipal {
+	return &ExecutorPrincipal{
+		StaticPrincipal: NewStaticPrincipal(executorId, groups),
+		CredentialId:    credentialId,
+		ExecutorId:      executorId,
+	}
+}
+
+// ExecutorCredentialVerifier checks executor credentials.
+type ExecutorCredentialVerifier interface {
+	// VerifyExecutorCredential returns the id of the executor the credential with the given id was minted for,
+	// or an error if secret doesn't match the credential or if the credential is expired or revoked.
+	VerifyExecutorCredential(ctx context.Context, credentialId string, secret string) (executorId string, err error)
+}
+
+// ExecutorAuthService authenticates requests carrying an "authorization: Executor <credential id>.<secret>" header.
+// Such requests may only call the methods the service is created with.
+type ExecutorAuthService struct {
+	verifier       ExecutorCredentialVerifier
+	groups         []string
+	allowedMethods map[string]bool
+}
+
+func NewExecutorAuthService(verifier ExecutorCredentialVerifier, groups []string, allowedMethods []string) *ExecutorAuthService {
+	allowed := make(map[string]bool, len(allowedMethods))
+	for _, method := range allowedMethods {
+		allowed[method] = true
+	}
+	return &ExecutorAuthService{
+		verifier:       verifier,
+		groups:         groups,
+		allowedMethods: allowed,
+	}
+}
+
+func (authService *ExecutorAuthService) Name() string {
+	return "Executor"
+}
+
+func (authService *ExecutorAuthService) Authenticate(ctx context.Context) (Principal, error) {
+	token, err := grpc_auth.AuthFromMD(ctx, "executor")
+	if err != nil {
+		return nil, &armadaerrors.ErrMissingCredentials{
+			AuthService: authService.Name(),
+		}
+	}
+	credentialId, secret, ok := strings.Cut(token, ".")
+	if !ok {
+		return nil, &armadaerrors.ErrInvalidCredentials{
+			AuthService: authService.Name(),
+			Message:     "malformed executor credential",
+		}
+	}
+	if method, ok := grpc.Method(ctx); !ok || !authService.allowedMethods[method] {
+		return nil, &armadaerrors.ErrInvalidCredentials{
+			AuthService: authService.Name(),
+			Message:     "executor credentials may only be used for executor methods",
+			Action:      method,
+		}
+	}
+	executorId, err := authService.verifier.VerifyExecutorCredential(ctx, credentialId, secret)
+	if err != nil {
+		return nil, &armadaerrors.ErrInvalidCredentials{
+			AuthService: authService.Name(),
+			Message:     err.Error(),
+		}
+	}
+	return NewExecutorPrincipal(credentialId, executorId, authService.groups), nil
+}
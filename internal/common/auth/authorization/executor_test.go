@@ -0,0 +1,68 @@
+package authorization
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/armadaproject/armada/internal/common/armadaerrors"
+)
+
+type fakeExecutorCredentialVerifier map[string]string
+
+func (v fakeExecutorCredentialVerifier) VerifyExecutorCredential(_ context.Context, credentialId string, secret string) (string, error) {
+	if secret != "secret" {
+		return "", errors.New("invalid secret")
+	}
+	executorId, ok := v[credentialId]
+	if !ok {
+		return "", errors.New("unknown credential")
+	}
+	return executorId, nil
+}
+
+type fakeServerTransportStream struct {
+	grpc.ServerTransportStream
+	method string
+}
+
+func (s *fakeServerTransportStream) Method() string {
+	return s.method
+}
+
+func TestExecutorAuthService(t *testing.T) {
+	service := NewExecutorAuthService(
+		fakeExecutorCredentialVerifier{"cred-1": "cluster-1"},
+		[]string{"executors"},
+		[]string{"/api.Usage/ReportUsage"},
+	)
+	contextFor := func(method string, authorization string) context.Context {
+		ctx := grpc.NewContextWithServerTransportStream(context.Background(), &fakeServerTransportStream{method: method})
+		return metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", authorization))
+	}
+
+	principal, err := service.Authenticate(contextFor("/api.Usage/ReportUsage", "Executor cred-1.secret"))
+	require.NoError(t, err)
+	executorPrincipal, ok := principal.(*ExecutorPrincipal)
+	require.True(t, ok)
+	assert.Equal(t, "cluster-1", executorPrincipal.ExecutorId)
+	assert.Equal(t, "cred-1", executorPrincipal.CredentialId)
+	assert.True(t, principal.IsInGroup("executors"))
+
+	var invalidCredsErr *armadaerrors.ErrInvalidCredentials
+	_, err = service.Authenticate(contextFor("/api.Usage/ReportUsage", "Executor cred-1.wrong"))
+	assert.ErrorAs(t, err, &invalidCredsErr)
+	_, err = service.Authenticate(contextFor("/api.Usage/ReportUsage", "Executor cred-1"))
+	assert.ErrorAs(t, err, &invalidCredsErr)
+	_, err = service.Authenticate(contextFor("/api.Submit/SubmitJobs", "Executor cred-1.secret"))
+	assert.ErrorAs(t, err, &invalidCredsErr)
+
+	var missingCredsErr *armadaerrors.ErrMissingCredentials
+	_, err = service.Authenticate(contextFor("/api.Usage/ReportUsage", "Basic cm9vdDp0b29y"))
+	assert.ErrorAs(t, err, &missingCredsErr)
+}
@@ -0,0 +1,121 @@
+package authorization
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/armadaproject/armada/internal/common/armadaerrors"
+	"github.com/armadaproject/armada/internal/common/auth/permission"
+)
+
+// Metadata key of the name of the user a request is made on behalf of.
+const ActAsHeader = "act-as"
+
+// UserGroupLookup returns the groups of users by name, as known to an identity source of the server.
+type UserGroupLookup interface {
+	GetUserGroups(user string) ([]string, error)
+}
+
+// StaticUserGroupLookup looks up the groups of users in a map from user name to groups, e.g., that of the users of
+// basic auth. Users not in the map are in no groups.
+type StaticUserGroupLookup map[string][]string
+
+func (lookup StaticUserGroupLookup) GetUserGroups(user string) ([]string, error) {
+	return lookup[user], nil
+}
+
+// ImpersonatedPrincipal is the principal of a request a trusted principal, e.g., a workflow engine, makes on behalf of
+// a user. The request is authorized and attributed as if the user made it; the impersonator is kept for auditing.
+type ImpersonatedPrincipal struct {
+	*StaticPrincipal
+	Impersonator Principal
+}
+
+func NewImpersonatedPrincipal(name string, groups []string, impersonator Principal) *ImpersonatedPrincipal {
+	return &ImpersonatedPrincipal{
+		StaticPrincipal: NewStaticPrincipal(name, groups),
+		Impersonator:    impersonator,
+	}
+}
+
+// GetImpersonator returns the principal that made the request of ctx on behalf of the principal of ctx, if any.
+func GetImpersonator(ctx context.Context) (Principal, bool) {
+	impersonated, ok := GetPrincipal(ctx).(*ImpersonatedPrincipal)
+	if !ok {
+		return nil, false
+	}
+	return impersonated.Impersonator, true
+}
+
+// ImpersonationUnaryServerInterceptor returns an interceptor replacing the principal of requests carrying an act-as
+// header by the user named by it, in the groups groupLookup returns for the user; groups asserted by the caller aren't
+// trusted. Only requests to allowedMethods may be made on behalf of other users, and only by principals with perm;
+// other requests carrying the header are rejected. It must come after the authentication interceptor.
+func ImpersonationUnaryServerInterceptor(
+	checker PermissionChecker,
+	perm permission.Permission,
+	groupLookup UserGroupLookup,
+	allowedMethods []string,
+) grpc.UnaryServerInterceptor {
+	allowed := make(map[string]bool, len(allowedMethods))
+	for _, method := range allowedMethods {
+		allowed[method] = true
+	}
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := impersonate(ctx, checker, perm, groupLookup, allowed, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+func impersonate(
+	ctx context.Context,
+	checker PermissionChecker,
+	perm permission.Permission,
+	groupLookup UserGroupLookup,
+	allowedMethods map[string]bool,
+	method string,
+) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	names := md.Get(ActAsHeader)
+	if len(names) == 0 {
+		return ctx, nil
+	}
+	impersonator := GetPrincipal(ctx)
+	if len(names) > 1 || names[0] == "" {
+		return nil, &armadaerrors.ErrInvalidArgument{
+			Name:    ActAsHeader,
+			Value:   names,
+			Message: "exactly one user must be given to act as",
+		}
+	}
+	if !allowedMethods[method] {
+		return nil, &armadaerrors.ErrInvalidArgument{
+			Name:    ActAsHeader,
+			Value:   names[0],
+			Message: method + " may not be called on behalf of other users",
+		}
+	}
+	// API tokens are restricted to the queue actions they're scoped to, which acting as another user would escape.
+	if _, isApiToken := impersonator.(*ApiTokenPrincipal); isApiToken || !checker.UserHasPermission(ctx, perm) {
+		log.WithField("principal", impersonator.GetName()).
+			Warnf("%s attempted to act as %s without permission %s", impersonator.GetName(), names[0], perm)
+		return nil, &armadaerrors.ErrUnauthorized{
+			Principal:  impersonator.GetName(),
+			Permission: string(perm),
+			Action:     method,
+			Message:    "acting as " + names[0],
+		}
+	}
+	groups, err := groupLookup.GetUserGroups(names[0])
+	if err != nil {
+		return nil, errors.WithMessagef(err, "error looking up groups of %s", names[0])
+	}
+	return WithPrincipal(ctx, NewImpersonatedPrincipal(names[0], groups, impersonator)), nil
+}
@@ -0,0 +1,75 @@
+package authorization
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/armadaproject/armada/internal/common/armadaerrors"
+	"github.com/armadaproject/armada/internal/common/auth/permission"
+)
+
+func TestImpersonationUnaryServerInterceptor(t *testing.T) {
+	const impersonate permission.Permission = "impersonate_users"
+	checker := NewPrincipalPermissionChecker(
+		map[permission.Permission][]string{impersonate: {"workflow-engines"}},
+		map[permission.Permission][]string{},
+		map[permission.Permission][]string{},
+	)
+	groupLookup := StaticUserGroupLookup{"alice": {"ml-team", "ops"}}
+	interceptor := ImpersonationUnaryServerInterceptor(checker, impersonate, groupLookup, []string{"/api.Submit/SubmitJobs"})
+	info := &grpc.UnaryServerInfo{FullMethod: "/api.Submit/SubmitJobs"}
+	contextFor := func(principal Principal, kv ...string) context.Context {
+		return metadata.NewIncomingContext(WithPrincipal(context.Background(), principal), metadata.Pairs(kv...))
+	}
+	var handled Principal
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		handled = GetPrincipal(ctx)
+		return nil, nil
+	}
+
+	engine := NewStaticPrincipal("engine", []string{"workflow-engines"})
+	_, err := interceptor(contextFor(engine, ActAsHeader, "alice"), nil, info, handler)
+	require.NoError(t, err)
+	assert.Equal(t, "alice", handled.GetName())
+	assert.True(t, handled.IsInGroup("ml-team"))
+	assert.True(t, handled.IsInGroup("ops"))
+	assert.False(t, handled.IsInGroup("workflow-engines"))
+
+	// Groups asserted by the caller aren't trusted; users unknown to the lookup are in no groups.
+	_, err = interceptor(contextFor(engine, ActAsHeader, "carol", "act-as-group", "admins"), nil, info, handler)
+	require.NoError(t, err)
+	assert.Equal(t, "carol", handled.GetName())
+	assert.False(t, handled.IsInGroup("admins"))
+	impersonator, ok := GetImpersonator(WithPrincipal(context.Background(), handled))
+	require.True(t, ok)
+	assert.Equal(t, "engine", impersonator.GetName())
+
+	// Requests without an act-as header are left as they are.
+	_, err = interceptor(contextFor(engine), nil, info, handler)
+	require.NoError(t, err)
+	assert.Equal(t, engine, handled)
+	_, ok = GetImpersonator(WithPrincipal(context.Background(), handled))
+	assert.False(t, ok)
+
+	var permErr *armadaerrors.ErrUnauthorized
+	_, err = interceptor(contextFor(NewStaticPrincipal("bob", nil), ActAsHeader, "alice"), nil, info, handler)
+	assert.ErrorAs(t, err, &permErr)
+	// API tokens may not act as other users, even if their owner may.
+	token := NewApiTokenPrincipal("token-1", "engine", []string{"workflow-engines"}, nil)
+	_, err = interceptor(contextFor(token, ActAsHeader, "alice"), nil, info, handler)
+	assert.ErrorAs(t, err, &permErr)
+
+	var invalidErr *armadaerrors.ErrInvalidArgument
+	// Only the allowed methods may be called on behalf of other users.
+	_, err = interceptor(contextFor(engine, ActAsHeader, "alice"), nil, &grpc.UnaryServerInfo{FullMethod: "/api.Submit/CreateQueue"}, handler)
+	assert.ErrorAs(t, err, &invalidErr)
+	_, err = interceptor(contextFor(engine, ActAsHeader, "alice", ActAsHeader, "carol"), nil, info, handler)
+	assert.ErrorAs(t, err, &invalidErr)
+	_, err = interceptor(contextFor(engine, ActAsHeader, ""), nil, info, handler)
+	assert.ErrorAs(t, err, &invalidErr)
+}
@@ -13,6 +13,7 @@ type AuthConfig struct {
 	KubernetesAuth KubernetesAuthConfig
 	OpenIdAuth     OpenIdAuthenticationConfig
 	Kerberos       KerberosAuthenticationConfig
+	ClientCertAuth ClientCertAuthConfig
 
 	PermissionGroupMapping map[permission.Permission][]string
 	PermissionScopeMapping map[permission.Permission][]string
@@ -59,3 +60,21 @@ type KubernetesAuthConfig struct {
 	KidMappingFileLocation string
 	InvalidTokenExpiry     int64
 }
+
+// ClientCertAuthConfig authenticates requests by the TLS client certificate they're made with,
+// which must be verified against the client CAs of the gRPC server (grpc.tls.clientCAPath).
+type ClientCertAuthConfig struct {
+	Enabled bool
+	// Certificate field the principal name is taken from: "commonName" (the default), or the first SAN of type
+	// "email", "dns", or "uri".
+	UsernameField string
+	// If true, principals are members of the organizational units of the subject of their certificate.
+	GroupsFromOrganizationalUnits bool
+	// Additional groups principals are members of, by principal name.
+	Groups map[string][]string
+	// Optional path to a PEM or DER encoded certificate revocation list issued by a client CA.
+	// Certificates listed in it are rejected. The file is read again when it's modified.
+	// Certificates are also rejected if the file can't be read, the list is past its next update, or the list
+	// wasn't issued by the issuer of the certificate, so the list must be renewed before its next update.
+	CRLPath string
+}
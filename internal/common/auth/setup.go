@@ -31,6 +31,14 @@ func ConfigureAuth(config configuration.AuthConfig) ([]authorization.AuthService
 		authServices = append(authServices, openIdAuthService)
 	}
 
+	if config.ClientCertAuth.Enabled {
+		clientCertAuthService, err := authorization.NewClientCertAuthService(config.ClientCertAuth)
+		if err != nil {
+			return nil, errors.WithMessage(err, "error initialising client certificate auth")
+		}
+		authServices = append(authServices, clientCertAuthService)
+	}
+
 	if config.AnonymousAuth {
 		authServices = append(authServices, &authorization.AnonymousAuthService{})
 	}
@@ -55,3 +63,19 @@ func ConfigureAuth(config configuration.AuthConfig) ([]authorization.AuthService
 
 	return authServices, nil
 }
+
+// ConfigureUserGroupLookup returns a lookup of the groups of users as configured for basic and client certificate
+// auth, the identity sources of the server that know the groups of users by name. Users configured for neither are
+// in no groups.
+func ConfigureUserGroupLookup(config configuration.AuthConfig) authorization.StaticUserGroupLookup {
+	lookup := make(authorization.StaticUserGroupLookup)
+	for user, info := range config.BasicAuth.Users {
+		lookup[user] = append(lookup[user], info.Groups...)
+	}
+	if config.ClientCertAuth.Enabled {
+		for user, groups := range config.ClientCertAuth.Groups {
+			lookup[user] = append(lookup[user], groups...)
+		}
+	}
+	return lookup
+}
@@ -0,0 +1,105 @@
+package compress
+
+import (
+	"github.com/golang/snappy"
+	"github.com/klauspost/compress/zstd"
+	"github.com/pkg/errors"
+)
+
+const (
+	CodecZlib   = "zlib"
+	CodecZstd   = "zstd"
+	CodecSnappy = "snappy"
+)
+
+// Identifiers of codecs, stored as the first byte of data compressed with any codec other than zlib.
+// Zlib data is stored as is, such that it can be read by decompressors unaware of codecs.
+// The low 4 bits of the first byte of zlib data are always 8, identifying the deflate method,
+// so zlib data never starts with an identifier.
+const (
+	zstdCodecId   byte = 1
+	snappyCodecId byte = 2
+)
+
+// NewCompressor returns a compressor for codec, which is either "zlib", "zstd" or "snappy".
+// Only zlib stores data up to minCompressSize bytes uncompressed. Data compressed with any codec can be decompressed
+// with a CodecDecompressor.
+func NewCompressor(codec string, minCompressSize int) (Compressor, error) {
+	switch codec {
+	case "", CodecZlib:
+		return NewZlibCompressor(minCompressSize)
+	case CodecZstd:
+		return NewZstdCompressor()
+	case CodecSnappy:
+		return &SnappyCompressor{}, nil
+	default:
+		return nil, errors.Errorf("unknown compression codec %q", codec)
+	}
+}
+
+// ZstdCompressor compresses to zstd, which is considerably cheaper than zlib for payloads of more than a few KB.
+type ZstdCompressor struct {
+	encoder *zstd.Encoder
+}
+
+func NewZstdCompressor() (*ZstdCompressor, error) {
+	encoder, err := zstd.NewWriter(nil, zstd.WithEncoderLevel(zstd.SpeedFastest), zstd.WithEncoderConcurrency(1))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &ZstdCompressor{encoder: encoder}, nil
+}
+
+func (c *ZstdCompressor) Compress(b []byte) ([]byte, error) {
+	return c.encoder.EncodeAll(b, []byte{zstdCodecId}), nil
+}
+
+// SnappyCompressor compresses to snappy, which trades compression ratio for speed.
+type SnappyCompressor struct{}
+
+func (c *SnappyCompressor) Compress(b []byte) ([]byte, error) {
+	compressed := make([]byte, 1, 1+snappy.MaxEncodedLen(len(b)))
+	compressed[0] = snappyCodecId
+	return append(compressed, snappy.Encode(nil, b)...), nil
+}
+
+// CodecDecompressor decompresses data compressed by any of the compressors returned by NewCompressor,
+// as identified by the first byte of the data.
+type CodecDecompressor struct {
+	zlibDecompressor *ZlibDecompressor
+	// Created once zstd data is decompressed.
+	zstdDecoder *zstd.Decoder
+}
+
+func NewCodecDecompressor() *CodecDecompressor {
+	return &CodecDecompressor{zlibDecompressor: NewZlibDecompressor()}
+}
+
+func (d *CodecDecompressor) Decompress(b []byte) ([]byte, error) {
+	if len(b) == 0 {
+		return d.zlibDecompressor.Decompress(b)
+	}
+	switch b[0] {
+	case zstdCodecId:
+		if d.zstdDecoder == nil {
+			decoder, err := zstd.NewReader(nil, zstd.WithDecoderConcurrency(1))
+			if err != nil {
+				return nil, errors.WithStack(err)
+			}
+			d.zstdDecoder = decoder
+		}
+		decompressed, err := d.zstdDecoder.DecodeAll(b[1:], nil)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		return decompressed, nil
+	case snappyCodecId:
+		decompressed, err := snappy.Decode(nil, b[1:])
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		return decompressed, nil
+	default:
+		return d.zlibDecompressor.Decompress(b)
+	}
+}
@@ -0,0 +1,47 @@
+package compress
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCodecs(t *testing.T) {
+	input := []byte(strings.Repeat("The quick brown fox jumps over the lazy dog. ", 100))
+	decompressor := NewCodecDecompressor()
+	for _, codec := range []string{CodecZlib, CodecZstd, CodecSnappy} {
+		t.Run(codec, func(t *testing.T) {
+			compressor, err := NewCompressor(codec, 0)
+			require.NoError(t, err)
+			// Compress twice, to check that compressors can be reused.
+			for i := 0; i < 2; i++ {
+				compressed, err := compressor.Compress(input)
+				require.NoError(t, err)
+				assert.Less(t, len(compressed), len(input))
+				decompressed, err := decompressor.Decompress(compressed)
+				require.NoError(t, err)
+				assert.Equal(t, input, decompressed)
+			}
+		})
+	}
+}
+
+func TestCodecDecompressor_DecompressesZlibWithoutIdentifier(t *testing.T) {
+	// Data compressed before codecs were introduced, whether compressed or not, is read as zlib.
+	for _, minCompressSize := range []int{0, 1024 * 1024} {
+		compressor, err := NewZlibCompressor(minCompressSize)
+		require.NoError(t, err)
+		compressed, err := compressor.Compress([]byte("hello world"))
+		require.NoError(t, err)
+		decompressed, err := NewCodecDecompressor().Decompress(compressed)
+		require.NoError(t, err)
+		assert.Equal(t, "hello world", string(decompressed))
+	}
+}
+
+func TestNewCompressor_UnknownCodec(t *testing.T) {
+	_, err := NewCompressor("lz4", 0)
+	assert.Error(t, err)
+}
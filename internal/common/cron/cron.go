@@ -0,0 +1,162 @@
+// Package cron parses cron expressions and computes the times they match.
+package cron
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Schedule is a parsed cron expression. Schedules match times at minute granularity.
+type Schedule struct {
+	minutes     uint64
+	hours       uint64
+	daysOfMonth uint64
+	months      uint64
+	daysOfWeek  uint64
+	// Set if the day-of-month or day-of-week field is "*", respectively.
+	// If both fields are restricted, a day matches if it matches either of them.
+	anyDayOfMonth bool
+	anyDayOfWeek  bool
+}
+
+type field struct {
+	name string
+	min  int
+	max  int
+}
+
+var fields = []field{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12},
+	{name: "day of week", min: 0, max: 7},
+}
+
+// Parse parses a cron expression made up of five space-separated fields: minute, hour, day of month, month,
+// and day of week, where both 0 and 7 denote Sunday. Each field is a comma-separated list of "*", values,
+// and ranges of values, e.g., "1-5", each of which may be followed by a step, e.g., "*/15".
+func Parse(expr string) (*Schedule, error) {
+	parts := strings.Fields(expr)
+	if len(parts) != len(fields) {
+		return nil, errors.Errorf("cron expression %q has %d fields, but should have %d", expr, len(parts), len(fields))
+	}
+	bits := make([]uint64, len(fields))
+	for i, part := range parts {
+		var err error
+		if bits[i], err = parseField(part, fields[i]); err != nil {
+			return nil, errors.WithMessagef(err, "invalid cron expression %q", expr)
+		}
+	}
+	// Sunday may be given as either 0 or 7.
+	if bits[4]&(1<<7) != 0 {
+		bits[4] |= 1
+	}
+	return &Schedule{
+		minutes:       bits[0],
+		hours:         bits[1],
+		daysOfMonth:   bits[2],
+		months:        bits[3],
+		daysOfWeek:    bits[4],
+		anyDayOfMonth: parts[2] == "*",
+		anyDayOfWeek:  parts[4] == "*",
+	}, nil
+}
+
+func parseField(s string, f field) (uint64, error) {
+	var bits uint64
+	for _, term := range strings.Split(s, ",") {
+		rangeExpr, stepExpr, hasStep := strings.Cut(term, "/")
+		step := 1
+		if hasStep {
+			var err error
+			step, err = strconv.Atoi(stepExpr)
+			if err != nil || step <= 0 {
+				return 0, errors.Errorf("invalid step %q in %s field", stepExpr, f.name)
+			}
+		}
+		lo, hi := f.min, f.max
+		if rangeExpr != "*" {
+			loExpr, hiExpr, isRange := strings.Cut(rangeExpr, "-")
+			var err error
+			if lo, err = parseValue(loExpr, f); err != nil {
+				return 0, err
+			}
+			hi = lo
+			if isRange {
+				if hi, err = parseValue(hiExpr, f); err != nil {
+					return 0, err
+				}
+			} else if hasStep {
+				hi = f.max
+			}
+			if lo > hi {
+				return 0, errors.Errorf("invalid range %q in %s field", rangeExpr, f.name)
+			}
+		}
+		for v := lo; v <= hi; v += step {
+			bits |= 1 << v
+		}
+	}
+	return bits, nil
+}
+
+func parseValue(s string, f field) (int, error) {
+	v, err := strconv.Atoi(s)
+	if err != nil || v < f.min || v > f.max {
+		return 0, errors.Errorf("invalid value %q in %s field; must be an integer between %d and %d", s, f.name, f.min, f.max)
+	}
+	return v, nil
+}
+
+// Matches returns true if the minute containing t matches s, interpreted in the location of t.
+func (s *Schedule) Matches(t time.Time) bool {
+	return s.months&(1<<int(t.Month())) != 0 &&
+		s.matchesDay(t) &&
+		s.hours&(1<<t.Hour()) != 0 &&
+		s.minutes&(1<<t.Minute()) != 0
+}
+
+func (s *Schedule) matchesDay(t time.Time) bool {
+	matchesDayOfMonth := s.daysOfMonth&(1<<t.Day()) != 0
+	matchesDayOfWeek := s.daysOfWeek&(1<<int(t.Weekday())) != 0
+	if s.anyDayOfMonth || s.anyDayOfWeek {
+		return matchesDayOfMonth && matchesDayOfWeek
+	}
+	return matchesDayOfMonth || matchesDayOfWeek
+}
+
+// maxSearchYears bounds the search for the next match, such that expressions that never match,
+// e.g., "0 0 31 2 *", don't cause an infinite loop.
+const maxSearchYears = 5
+
+// Next returns the start of the first minute after t matching s, interpreted in the location of t.
+// Returns the zero time if no such minute exists within the next few years.
+func (s *Schedule) Next(t time.Time) time.Time {
+	loc := t.Location()
+	t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), 0, 0, loc).Add(time.Minute)
+	limit := t.AddDate(maxSearchYears, 0, 0)
+	for t.Before(limit) {
+		if s.months&(1<<int(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, loc)
+			continue
+		}
+		if !s.matchesDay(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, loc)
+			continue
+		}
+		if s.hours&(1<<t.Hour()) == 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, loc)
+			continue
+		}
+		if s.minutes&(1<<t.Minute()) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
@@ -0,0 +1,104 @@
+package cron
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse_Invalid(t *testing.T) {
+	tests := map[string]string{
+		"too few fields":    "* * * *",
+		"too many fields":   "* * * * * *",
+		"out of range":      "60 * * * *",
+		"not a number":      "* mon * * *",
+		"empty range":       "* 5-1 * * *",
+		"zero step":         "*/0 * * * *",
+		"invalid weekday":   "* * * * 8",
+		"invalid month":     "* * * 0 *",
+		"missing range end": "* 1- * * *",
+	}
+	for name, expr := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := Parse(expr)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestSchedule_Next(t *testing.T) {
+	// A Wednesday.
+	now := time.Date(2023, 5, 17, 10, 30, 20, 0, time.UTC)
+	tests := map[string]struct {
+		expr     string
+		expected time.Time
+	}{
+		"every minute": {
+			expr:     "* * * * *",
+			expected: time.Date(2023, 5, 17, 10, 31, 0, 0, time.UTC),
+		},
+		"step": {
+			expr:     "*/15 * * * *",
+			expected: time.Date(2023, 5, 17, 10, 45, 0, 0, time.UTC),
+		},
+		"later today": {
+			expr:     "0 18 * * *",
+			expected: time.Date(2023, 5, 17, 18, 0, 0, 0, time.UTC),
+		},
+		"tomorrow": {
+			expr:     "0 9 * * *",
+			expected: time.Date(2023, 5, 18, 9, 0, 0, 0, time.UTC),
+		},
+		"weekdays": {
+			expr:     "0 9 * * 1-5",
+			expected: time.Date(2023, 5, 18, 9, 0, 0, 0, time.UTC),
+		},
+		"weekend": {
+			expr:     "0 0 * * 6,0",
+			expected: time.Date(2023, 5, 20, 0, 0, 0, 0, time.UTC),
+		},
+		"sunday as 7": {
+			expr:     "0 0 * * 7",
+			expected: time.Date(2023, 5, 21, 0, 0, 0, 0, time.UTC),
+		},
+		"day of month or day of week": {
+			expr:     "0 0 1 * 5",
+			expected: time.Date(2023, 5, 19, 0, 0, 0, 0, time.UTC),
+		},
+		"next year": {
+			expr:     "0 0 1 1 *",
+			expected: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		"leap day": {
+			expr:     "0 0 29 2 *",
+			expected: time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC),
+		},
+		"never": {
+			expr:     "0 0 31 2 *",
+			expected: time.Time{},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			schedule, err := Parse(tc.expr)
+			require.NoError(t, err)
+			next := schedule.Next(now)
+			assert.Equal(t, tc.expected, next)
+			if !next.IsZero() {
+				assert.True(t, schedule.Matches(next))
+			}
+		})
+	}
+}
+
+func TestSchedule_Next_Location(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+	schedule, err := Parse("30 9 * * *")
+	require.NoError(t, err)
+	now := time.Date(2023, 5, 17, 12, 0, 0, 0, time.UTC)
+	assert.Equal(t, time.Date(2023, 5, 17, 9, 30, 0, 0, loc), schedule.Next(now.In(loc)))
+	assert.Equal(t, time.Date(2023, 5, 18, 9, 30, 0, 0, time.UTC), schedule.Next(now))
+}
@@ -43,6 +43,22 @@ func OpenPgxPool(config configuration.PostgresConfig) (*pgxpool.Pool, error) {
 	return db, err
 }
 
+// ReplicationLag returns how far the Postgres instance db connects to lags behind its primary.
+// Returns 0 for primaries and for replicas that have replayed all WAL received,
+// such that replicas of idle primaries aren't considered stale.
+func ReplicationLag(ctx *armadacontext.Context, db *pgxpool.Pool) (time.Duration, error) {
+	var lagSeconds float64
+	err := db.QueryRow(ctx, `
+		SELECT CASE
+			WHEN NOT pg_is_in_recovery() OR pg_last_wal_receive_lsn() = pg_last_wal_replay_lsn() THEN 0
+			ELSE COALESCE(EXTRACT(EPOCH FROM now() - pg_last_xact_replay_timestamp()), 0)
+		END::float8`).Scan(&lagSeconds)
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+	return time.Duration(lagSeconds * float64(time.Second)), nil
+}
+
 func UniqueTableName(table string) string {
 	suffix := strings.ReplaceAll(uuid.New().String(), "-", "")
 	return fmt.Sprintf("%s_tmp_%s", table, suffix)
@@ -0,0 +1,90 @@
+// Package eventschema translates events between versions of their schema, such that events stored by earlier
+// versions of Armada can be served as events of the current version, and current events can be served to clients
+// that only understand earlier versions.
+package eventschema
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+// MinVersion is the earliest version of the schema. Clients not requesting a version are served events of it.
+const MinVersion uint32 = 1
+
+// Migration translates events between a version of the schema and the version before it.
+type Migration struct {
+	// Version the migration upgrades events to and downgrades events from.
+	Version uint32
+	// Upgrade translates an event of Version-1 to Version.
+	Upgrade func(event *api.EventMessage) (*api.EventMessage, error)
+	// Downgrade translates an event of Version to Version-1.
+	// It returns nil if the event has no equivalent in Version-1, in which case the event is omitted.
+	Downgrade func(event *api.EventMessage) (*api.EventMessage, error)
+}
+
+// Translator translates events between any versions of the schema up to the latest one it has migrations for.
+type Translator struct {
+	// Migrations to each version after MinVersion, in order.
+	migrations []Migration
+}
+
+// NewTranslator returns a Translator for migrations, which must translate to each version after MinVersion in order.
+func NewTranslator(migrations []Migration) (*Translator, error) {
+	for i, migration := range migrations {
+		if expected := MinVersion + uint32(i) + 1; migration.Version != expected {
+			return nil, errors.Errorf("expected migration %d to be to version %d, but it's to version %d", i, expected, migration.Version)
+		}
+		if migration.Upgrade == nil || migration.Downgrade == nil {
+			return nil, errors.Errorf("migration to version %d must both upgrade and downgrade events", migration.Version)
+		}
+	}
+	return &Translator{migrations: migrations}, nil
+}
+
+// LatestVersion returns the latest version events can be translated to.
+func (t *Translator) LatestVersion() uint32 {
+	return MinVersion + uint32(len(t.migrations))
+}
+
+// ResolveVersion returns the version events requested for version are served as,
+// i.e., MinVersion if no version is requested and at most the latest version.
+func (t *Translator) ResolveVersion(version uint32) uint32 {
+	if version < MinVersion {
+		return MinVersion
+	}
+	if latest := t.LatestVersion(); version > latest {
+		return latest
+	}
+	return version
+}
+
+// Translate translates event of version from to version to, returning nil if event has no equivalent in version to.
+// Since events of versions after the latest one can't be translated, they're returned unchanged.
+func (t *Translator) Translate(event *api.EventMessage, from uint32, to uint32) (*api.EventMessage, error) {
+	if from < MinVersion {
+		from = MinVersion
+	}
+	if from > t.LatestVersion() {
+		return event, nil
+	}
+	to = t.ResolveVersion(to)
+	var err error
+	for version := from; version < to && event != nil; version++ {
+		event, err = t.migration(version + 1).Upgrade(event)
+		if err != nil {
+			return nil, errors.WithMessagef(err, "error upgrading event to version %d", version+1)
+		}
+	}
+	for version := from; version > to && event != nil; version-- {
+		event, err = t.migration(version).Downgrade(event)
+		if err != nil {
+			return nil, errors.WithMessagef(err, "error downgrading event to version %d", version-1)
+		}
+	}
+	return event, nil
+}
+
+func (t *Translator) migration(version uint32) Migration {
+	return t.migrations[version-MinVersion-1]
+}
@@ -0,0 +1,216 @@
+package eventschema
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+// testMigrations introduce preempted events in version 2, which have no equivalent in version 1,
+// and prefix the reasons of failed events with the version in version 3.
+var testMigrations = []Migration{
+	{
+		Version: 2,
+		Upgrade: func(event *api.EventMessage) (*api.EventMessage, error) {
+			return event, nil
+		},
+		Downgrade: func(event *api.EventMessage) (*api.EventMessage, error) {
+			if event.GetPreempted() != nil {
+				return nil, nil
+			}
+			return event, nil
+		},
+	},
+	{
+		Version: 3,
+		Upgrade: func(event *api.EventMessage) (*api.EventMessage, error) {
+			if failed := event.GetFailed(); failed != nil {
+				return failedEvent("v3:" + failed.Reason), nil
+			}
+			return event, nil
+		},
+		Downgrade: func(event *api.EventMessage) (*api.EventMessage, error) {
+			if failed := event.GetFailed(); failed != nil {
+				return failedEvent(failed.Reason[len("v3:"):]), nil
+			}
+			return event, nil
+		},
+	},
+}
+
+func TestTranslate(t *testing.T) {
+	translator, err := NewTranslator(testMigrations)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(3), translator.LatestVersion())
+
+	preempted := &api.EventMessage{Events: &api.EventMessage_Preempted{Preempted: &api.JobPreemptedEvent{JobId: "job"}}}
+	tests := map[string]struct {
+		event    *api.EventMessage
+		from     uint32
+		to       uint32
+		expected *api.EventMessage
+	}{
+		"upgrade": {
+			event:    failedEvent("oom"),
+			from:     1,
+			to:       3,
+			expected: failedEvent("v3:oom"),
+		},
+		"downgrade": {
+			event:    failedEvent("v3:oom"),
+			from:     3,
+			to:       1,
+			expected: failedEvent("oom"),
+		},
+		"same version": {
+			event:    failedEvent("v3:oom"),
+			from:     3,
+			to:       3,
+			expected: failedEvent("v3:oom"),
+		},
+		"no equivalent in earlier version": {
+			event:    preempted,
+			from:     3,
+			to:       1,
+			expected: nil,
+		},
+		"no version requested": {
+			event:    failedEvent("v3:oom"),
+			from:     3,
+			to:       0,
+			expected: failedEvent("oom"),
+		},
+		"version after latest requested": {
+			event:    failedEvent("oom"),
+			from:     1,
+			to:       4,
+			expected: failedEvent("v3:oom"),
+		},
+		"stored by later version": {
+			event:    failedEvent("v4:oom"),
+			from:     4,
+			to:       1,
+			expected: failedEvent("v4:oom"),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			translated, err := translator.Translate(tc.event, tc.from, tc.to)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, translated)
+		})
+	}
+}
+
+func TestNewTranslator_InvalidMigrations(t *testing.T) {
+	_, err := NewTranslator(testMigrations[1:])
+	assert.Error(t, err)
+
+	_, err = NewTranslator([]Migration{{Version: 2}})
+	assert.Error(t, err)
+}
+
+func TestDefault(t *testing.T) {
+	assert.Equal(t, api.EventSchemaVersion, Default.LatestVersion())
+}
+
+func TestDefault_DowngradesEvictedForCapacity(t *testing.T) {
+	created := time.Now()
+	evicted := &api.EventMessage{Events: &api.EventMessage_EvictedForCapacity{EvictedForCapacity: &api.JobEvictedForCapacityEvent{
+		JobId:     "job",
+		JobSetId:  "set",
+		Queue:     "queue",
+		Created:   created,
+		ClusterId: "cluster",
+	}}}
+
+	translated, err := Default.Translate(evicted, 2, 2)
+	require.NoError(t, err)
+	assert.Equal(t, evicted, translated)
+
+	translated, err = Default.Translate(evicted, 2, 1)
+	require.NoError(t, err)
+	assert.Equal(t, &api.EventMessage{Events: &api.EventMessage_LeaseReturned{LeaseReturned: &api.JobLeaseReturnedEvent{
+		JobId:        "job",
+		JobSetId:     "set",
+		Queue:        "queue",
+		Created:      created,
+		ClusterId:    "cluster",
+		Reason:       evictedForCapacityReason,
+		RunAttempted: true,
+	}}}, translated)
+}
+
+func TestDefault_OmitsRuntimeExceeded(t *testing.T) {
+	exceeded := &api.EventMessage{Events: &api.EventMessage_RuntimeExceeded{RuntimeExceeded: &api.JobRuntimeExceededEvent{JobId: "job"}}}
+
+	translated, err := Default.Translate(exceeded, 3, 3)
+	require.NoError(t, err)
+	assert.Equal(t, exceeded, translated)
+
+	translated, err = Default.Translate(exceeded, 3, 2)
+	require.NoError(t, err)
+	assert.Nil(t, translated)
+}
+
+func TestDefault_OmitsResourcesNormalized(t *testing.T) {
+	normalized := &api.EventMessage{Events: &api.EventMessage_ResourcesNormalized{ResourcesNormalized: &api.JobResourcesNormalizedEvent{JobId: "job"}}}
+
+	translated, err := Default.Translate(normalized, 4, 4)
+	require.NoError(t, err)
+	assert.Equal(t, normalized, translated)
+
+	translated, err = Default.Translate(normalized, 4, 3)
+	require.NoError(t, err)
+	assert.Nil(t, translated)
+}
+
+func TestDefault_OmitsUnschedulable(t *testing.T) {
+	unschedulable := &api.EventMessage{Events: &api.EventMessage_Unschedulable{Unschedulable: &api.JobUnschedulableEvent{JobId: "job"}}}
+
+	translated, err := Default.Translate(unschedulable, 5, 5)
+	require.NoError(t, err)
+	assert.Equal(t, unschedulable, translated)
+
+	translated, err = Default.Translate(unschedulable, 5, 4)
+	require.NoError(t, err)
+	assert.Nil(t, translated)
+}
+
+func TestDefault_OmitsMaintenanceWindows(t *testing.T) {
+	started := &api.EventMessage{Events: &api.EventMessage_MaintenanceWindowStarted{MaintenanceWindowStarted: &api.JobMaintenanceWindowStartedEvent{JobId: "job"}}}
+	ended := &api.EventMessage{Events: &api.EventMessage_MaintenanceWindowEnded{MaintenanceWindowEnded: &api.JobMaintenanceWindowEndedEvent{JobId: "job"}}}
+
+	for _, event := range []*api.EventMessage{started, ended} {
+		translated, err := Default.Translate(event, 6, 6)
+		require.NoError(t, err)
+		assert.Equal(t, event, translated)
+
+		translated, err = Default.Translate(event, 6, 5)
+		require.NoError(t, err)
+		assert.Nil(t, translated)
+	}
+}
+
+func TestDefault_OmitsCordons(t *testing.T) {
+	cordoned := &api.EventMessage{Events: &api.EventMessage_Cordoned{Cordoned: &api.JobCordonedEvent{JobId: "job"}}}
+	uncordoned := &api.EventMessage{Events: &api.EventMessage_Uncordoned{Uncordoned: &api.JobUncordonedEvent{JobId: "job"}}}
+
+	for _, event := range []*api.EventMessage{cordoned, uncordoned} {
+		translated, err := Default.Translate(event, 7, 7)
+		require.NoError(t, err)
+		assert.Equal(t, event, translated)
+
+		translated, err = Default.Translate(event, 7, 6)
+		require.NoError(t, err)
+		assert.Nil(t, translated)
+	}
+}
+
+func failedEvent(reason string) *api.EventMessage {
+	return &api.EventMessage{Events: &api.EventMessage_Failed{Failed: &api.JobFailedEvent{JobId: "job", Reason: reason}}}
+}
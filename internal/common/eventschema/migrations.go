@@ -0,0 +1,122 @@
+package eventschema
+
+import "github.com/armadaproject/armada/pkg/api"
+
+// migrations translate events between the versions of the schema defined by api. Changing the schema in a way that
+// clients of earlier versions may not handle requires incrementing api.EventSchemaVersion and adding a migration to it.
+var migrations = []Migration{
+	{
+		// Version 2 introduces evicted-for-capacity events, which clients of version 1 are served as lease returns,
+		// the event by which they already learn that a job was returned to the queue.
+		Version: 2,
+		Upgrade: func(event *api.EventMessage) (*api.EventMessage, error) {
+			return event, nil
+		},
+		Downgrade: func(event *api.EventMessage) (*api.EventMessage, error) {
+			evicted := event.GetEvictedForCapacity()
+			if evicted == nil {
+				return event, nil
+			}
+			return &api.EventMessage{
+				Events: &api.EventMessage_LeaseReturned{
+					LeaseReturned: &api.JobLeaseReturnedEvent{
+						JobId:        evicted.JobId,
+						JobSetId:     evicted.JobSetId,
+						Queue:        evicted.Queue,
+						Created:      evicted.Created,
+						ClusterId:    evicted.ClusterId,
+						Reason:       evictedForCapacityReason,
+						RunAttempted: true,
+					},
+				},
+			}, nil
+		},
+	},
+	{
+		// Version 3 introduces runtime-exceeded events. Clients of version 2 learn that such jobs are cancelled from the
+		// cancellation events that follow, so runtime-exceeded events are omitted.
+		Version: 3,
+		Upgrade: func(event *api.EventMessage) (*api.EventMessage, error) {
+			return event, nil
+		},
+		Downgrade: func(event *api.EventMessage) (*api.EventMessage, error) {
+			if event.GetRuntimeExceeded() != nil {
+				return nil, nil
+			}
+			return event, nil
+		},
+	},
+	{
+		// Version 4 introduces resources-normalized events. The normalized resources are part of the jobs clients of
+		// version 3 receive with submitted events, so resources-normalized events are omitted.
+		Version: 4,
+		Upgrade: func(event *api.EventMessage) (*api.EventMessage, error) {
+			return event, nil
+		},
+		Downgrade: func(event *api.EventMessage) (*api.EventMessage, error) {
+			if event.GetResourcesNormalized() != nil {
+				return nil, nil
+			}
+			return event, nil
+		},
+	},
+	{
+		// Version 5 introduces unschedulable events, which only warn about queued jobs, so they're omitted for clients
+		// of version 4.
+		Version: 5,
+		Upgrade: func(event *api.EventMessage) (*api.EventMessage, error) {
+			return event, nil
+		},
+		Downgrade: func(event *api.EventMessage) (*api.EventMessage, error) {
+			if event.GetUnschedulable() != nil {
+				return nil, nil
+			}
+			return event, nil
+		},
+	},
+	{
+		// Version 6 introduces maintenance-window events. Clients of version 5 learn that jobs are drained by a window
+		// from the preemption events that follow, so maintenance-window events are omitted.
+		Version: 6,
+		Upgrade: func(event *api.EventMessage) (*api.EventMessage, error) {
+			return event, nil
+		},
+		Downgrade: func(event *api.EventMessage) (*api.EventMessage, error) {
+			if event.GetMaintenanceWindowStarted() != nil || event.GetMaintenanceWindowEnded() != nil {
+				return nil, nil
+			}
+			return event, nil
+		},
+	},
+	{
+		// Version 7 introduces cordon events. Cordons don't change the state of jobs, so they're omitted for clients of version 6.
+		Version: 7,
+		Upgrade: func(event *api.EventMessage) (*api.EventMessage, error) {
+			return event, nil
+		},
+		Downgrade: func(event *api.EventMessage) (*api.EventMessage, error) {
+			if event.GetCordoned() != nil || event.GetUncordoned() != nil {
+				return nil, nil
+			}
+			return event, nil
+		},
+	},
+}
+
+// evictedForCapacityReason is the reason of the lease returns evicted-for-capacity events are served as to clients
+// of version 1.
+const evictedForCapacityReason = "evicted to make room for higher-priority jobs"
+
+// Default translates events between the versions of the schema defined by api.
+var Default = mustNewTranslator(migrations)
+
+func mustNewTranslator(migrations []Migration) *Translator {
+	translator, err := NewTranslator(migrations)
+	if err != nil {
+		panic(err)
+	}
+	if translator.LatestVersion() != api.EventSchemaVersion {
+		panic("api.EventSchemaVersion must be the version of the latest migration")
+	}
+	return translator
+}
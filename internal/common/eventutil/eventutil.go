@@ -191,6 +191,9 @@ func ApiJobFromLogSubmitJob(ownerId string, groups []string, queueName string, j
 		Owner:                    ownerId,
 		QueueOwnershipUserGroups: groups,
 		QueueTtlSeconds:          e.QueueTtlSeconds,
+
+		JobArrayId:    e.JobArrayId,
+		JobArrayIndex: e.JobArrayIndex,
 	}, nil
 }
 
@@ -229,6 +232,8 @@ func LogSubmitJobFromApiJob(job *api.Job) (*armadaevents.SubmitJob, error) {
 		Objects:         objects,
 		Scheduler:       job.Scheduler,
 		QueueTtlSeconds: job.QueueTtlSeconds,
+		JobArrayId:      job.JobArrayId,
+		JobArrayIndex:   job.JobArrayIndex,
 	}, nil
 }
 
@@ -569,6 +574,7 @@ func EventSequenceFromApiEvent(msg *api.EventMessage) (sequence *armadaevents.Ev
 					RunId:      LegacyJobRunId(),
 					JobId:      jobId,
 					ExecutorId: m.Leased.ClusterId,
+					Pool:       m.Leased.Pool,
 				},
 			},
 		})
@@ -711,8 +717,10 @@ func EventSequenceFromApiEvent(msg *api.EventMessage) (sequence *armadaevents.Ev
 							},
 							Info: &armadaevents.KubernetesResourceInfo_PodInfo{
 								PodInfo: &armadaevents.PodInfo{
-									NodeName:  m.Running.NodeName,
-									PodNumber: m.Running.PodNumber,
+									NodeName:   m.Running.NodeName,
+									PodNumber:  m.Running.PodNumber,
+									Pool:       m.Running.Pool,
+									NodeLabels: m.Running.NodeLabels,
 								},
 							},
 						},
@@ -856,23 +864,25 @@ func EventSequenceFromApiEvent(msg *api.EventMessage) (sequence *armadaevents.Ev
 			},
 		})
 
-		// Event indicating that the job as a whole failed.
-		sequence.Events = append(sequence.Events, &armadaevents.EventSequence_Event{
-			Created: &m.Failed.Created,
-			Event: &armadaevents.EventSequence_Event_JobErrors{
-				JobErrors: &armadaevents.JobErrors{
-					JobId: jobId,
-					Errors: []*armadaevents.Error{
-						{
-							Terminal: true,
-							Reason: &armadaevents.Error_PodError{
-								PodError: podError,
+		// Event indicating that the job as a whole failed, unless the failed run is to be retried.
+		if !m.Failed.WillRetry {
+			sequence.Events = append(sequence.Events, &armadaevents.EventSequence_Event{
+				Created: &m.Failed.Created,
+				Event: &armadaevents.EventSequence_Event_JobErrors{
+					JobErrors: &armadaevents.JobErrors{
+						JobId: jobId,
+						Errors: []*armadaevents.Error{
+							{
+								Terminal: true,
+								Reason: &armadaevents.Error_PodError{
+									PodError: podError,
+								},
 							},
 						},
 					},
 				},
-			},
-		})
+			})
+		}
 	case *api.EventMessage_Succeeded:
 		sequence.Queue = m.Succeeded.Queue
 		sequence.JobSetName = m.Succeeded.JobSetId
@@ -1139,6 +1149,214 @@ func EventSequenceFromApiEvent(msg *api.EventMessage) (sequence *armadaevents.Ev
 			Event:   event,
 		}
 		sequence.Events = append(sequence.Events, sequenceEvent)
+	case *api.EventMessage_JobSetExpired:
+		sequence.Queue = m.JobSetExpired.Queue
+		sequence.JobSetName = m.JobSetExpired.JobSetId
+
+		jobId, err := armadaevents.ProtoUuidFromUlidString(m.JobSetExpired.JobId)
+		if err != nil {
+			return nil, err
+		}
+
+		sequence.Events = append(sequence.Events, &armadaevents.EventSequence_Event{
+			Created: &m.JobSetExpired.Created,
+			Event: &armadaevents.EventSequence_Event_JobSetExpired{
+				JobSetExpired: &armadaevents.JobSetExpired{
+					JobId:            jobId,
+					JobSetTtlSeconds: m.JobSetExpired.JobSetTtlSeconds,
+				},
+			},
+		})
+	case *api.EventMessage_Suspended:
+		sequence.Queue = m.Suspended.Queue
+		sequence.JobSetName = m.Suspended.JobSetId
+		sequence.UserId = m.Suspended.Requestor
+
+		jobId, err := armadaevents.ProtoUuidFromUlidString(m.Suspended.JobId)
+		if err != nil {
+			return nil, err
+		}
+
+		sequence.Events = append(sequence.Events, &armadaevents.EventSequence_Event{
+			Created: &m.Suspended.Created,
+			Event: &armadaevents.EventSequence_Event_JobSuspended{
+				JobSuspended: &armadaevents.JobSuspended{
+					JobId: jobId,
+				},
+			},
+		})
+	case *api.EventMessage_Resumed:
+		sequence.Queue = m.Resumed.Queue
+		sequence.JobSetName = m.Resumed.JobSetId
+		sequence.UserId = m.Resumed.Requestor
+
+		jobId, err := armadaevents.ProtoUuidFromUlidString(m.Resumed.JobId)
+		if err != nil {
+			return nil, err
+		}
+
+		sequence.Events = append(sequence.Events, &armadaevents.EventSequence_Event{
+			Created: &m.Resumed.Created,
+			Event: &armadaevents.EventSequence_Event_JobResumed{
+				JobResumed: &armadaevents.JobResumed{
+					JobId: jobId,
+				},
+			},
+		})
+	case *api.EventMessage_EvictedForCapacity:
+		sequence.Queue = m.EvictedForCapacity.Queue
+		sequence.JobSetName = m.EvictedForCapacity.JobSetId
+
+		jobId, err := armadaevents.ProtoUuidFromUlidString(m.EvictedForCapacity.JobId)
+		if err != nil {
+			return nil, err
+		}
+
+		sequence.Events = append(sequence.Events, &armadaevents.EventSequence_Event{
+			Created: &m.EvictedForCapacity.Created,
+			Event: &armadaevents.EventSequence_Event_JobEvictedForCapacity{
+				JobEvictedForCapacity: &armadaevents.JobEvictedForCapacity{
+					JobId:      jobId,
+					ExecutorId: m.EvictedForCapacity.ClusterId,
+				},
+			},
+		})
+	case *api.EventMessage_RuntimeExceeded:
+		sequence.Queue = m.RuntimeExceeded.Queue
+		sequence.JobSetName = m.RuntimeExceeded.JobSetId
+
+		jobId, err := armadaevents.ProtoUuidFromUlidString(m.RuntimeExceeded.JobId)
+		if err != nil {
+			return nil, err
+		}
+
+		sequence.Events = append(sequence.Events, &armadaevents.EventSequence_Event{
+			Created: &m.RuntimeExceeded.Created,
+			Event: &armadaevents.EventSequence_Event_JobRuntimeExceeded{
+				JobRuntimeExceeded: &armadaevents.JobRuntimeExceeded{
+					JobId:             jobId,
+					ExecutorId:        m.RuntimeExceeded.ClusterId,
+					MaxRuntimeSeconds: m.RuntimeExceeded.MaxRuntimeSeconds,
+				},
+			},
+		})
+	case *api.EventMessage_ResourcesNormalized:
+		sequence.Queue = m.ResourcesNormalized.Queue
+		sequence.JobSetName = m.ResourcesNormalized.JobSetId
+
+		jobId, err := armadaevents.ProtoUuidFromUlidString(m.ResourcesNormalized.JobId)
+		if err != nil {
+			return nil, err
+		}
+
+		sequence.Events = append(sequence.Events, &armadaevents.EventSequence_Event{
+			Created: &m.ResourcesNormalized.Created,
+			Event: &armadaevents.EventSequence_Event_JobResourcesNormalized{
+				JobResourcesNormalized: &armadaevents.JobResourcesNormalized{
+					JobId:               jobId,
+					OriginalResources:   m.ResourcesNormalized.OriginalResources,
+					NormalizedResources: m.ResourcesNormalized.NormalizedResources,
+				},
+			},
+		})
+	case *api.EventMessage_Unschedulable:
+		sequence.Queue = m.Unschedulable.Queue
+		sequence.JobSetName = m.Unschedulable.JobSetId
+
+		jobId, err := armadaevents.ProtoUuidFromUlidString(m.Unschedulable.JobId)
+		if err != nil {
+			return nil, err
+		}
+
+		sequence.Events = append(sequence.Events, &armadaevents.EventSequence_Event{
+			Created: &m.Unschedulable.Created,
+			Event: &armadaevents.EventSequence_Event_JobUnschedulable{
+				JobUnschedulable: &armadaevents.JobUnschedulable{
+					JobId:  jobId,
+					Reason: m.Unschedulable.Reason,
+				},
+			},
+		})
+	case *api.EventMessage_MaintenanceWindowStarted:
+		sequence.Queue = m.MaintenanceWindowStarted.Queue
+		sequence.JobSetName = m.MaintenanceWindowStarted.JobSetId
+
+		jobId, err := armadaevents.ProtoUuidFromUlidString(m.MaintenanceWindowStarted.JobId)
+		if err != nil {
+			return nil, err
+		}
+
+		sequence.Events = append(sequence.Events, &armadaevents.EventSequence_Event{
+			Created: &m.MaintenanceWindowStarted.Created,
+			Event: &armadaevents.EventSequence_Event_JobMaintenanceWindowStarted{
+				JobMaintenanceWindowStarted: &armadaevents.JobMaintenanceWindowStarted{
+					JobId:               jobId,
+					MaintenanceWindowId: m.MaintenanceWindowStarted.MaintenanceWindowId,
+					Executor:            m.MaintenanceWindowStarted.Executor,
+					End:                 m.MaintenanceWindowStarted.End,
+					Drain:               m.MaintenanceWindowStarted.Drain,
+					Reason:              m.MaintenanceWindowStarted.Reason,
+				},
+			},
+		})
+	case *api.EventMessage_MaintenanceWindowEnded:
+		sequence.Queue = m.MaintenanceWindowEnded.Queue
+		sequence.JobSetName = m.MaintenanceWindowEnded.JobSetId
+
+		jobId, err := armadaevents.ProtoUuidFromUlidString(m.MaintenanceWindowEnded.JobId)
+		if err != nil {
+			return nil, err
+		}
+
+		sequence.Events = append(sequence.Events, &armadaevents.EventSequence_Event{
+			Created: &m.MaintenanceWindowEnded.Created,
+			Event: &armadaevents.EventSequence_Event_JobMaintenanceWindowEnded{
+				JobMaintenanceWindowEnded: &armadaevents.JobMaintenanceWindowEnded{
+					JobId:               jobId,
+					MaintenanceWindowId: m.MaintenanceWindowEnded.MaintenanceWindowId,
+					Executor:            m.MaintenanceWindowEnded.Executor,
+				},
+			},
+		})
+	case *api.EventMessage_Cordoned:
+		sequence.Queue = m.Cordoned.Queue
+		sequence.JobSetName = m.Cordoned.JobSetId
+
+		jobId, err := armadaevents.ProtoUuidFromUlidString(m.Cordoned.JobId)
+		if err != nil {
+			return nil, err
+		}
+
+		sequence.Events = append(sequence.Events, &armadaevents.EventSequence_Event{
+			Created: &m.Cordoned.Created,
+			Event: &armadaevents.EventSequence_Event_JobCordoned{
+				JobCordoned: &armadaevents.JobCordoned{
+					JobId:      jobId,
+					Executor:   m.Cordoned.Executor,
+					CordonedBy: m.Cordoned.CordonedBy,
+					Reason:     m.Cordoned.Reason,
+				},
+			},
+		})
+	case *api.EventMessage_Uncordoned:
+		sequence.Queue = m.Uncordoned.Queue
+		sequence.JobSetName = m.Uncordoned.JobSetId
+
+		jobId, err := armadaevents.ProtoUuidFromUlidString(m.Uncordoned.JobId)
+		if err != nil {
+			return nil, err
+		}
+
+		sequence.Events = append(sequence.Events, &armadaevents.EventSequence_Event{
+			Created: &m.Uncordoned.Created,
+			Event: &armadaevents.EventSequence_Event_JobUncordoned{
+				JobUncordoned: &armadaevents.JobUncordoned{
+					JobId:        jobId,
+					Executor:     m.Uncordoned.Executor,
+					UncordonedBy: m.Uncordoned.UncordonedBy,
+				},
+			},
+		})
 	default:
 		err = &armadaerrors.ErrInvalidArgument{
 			Name:    "msg",
@@ -409,6 +409,24 @@ func TestConvertJobMultiplePodSpecs(t *testing.T) {
 	assert.Equal(t, expected, actual)
 }
 
+func TestConvertJobArrayMember(t *testing.T) {
+	expected := testJob(false)
+	expected.Services = nil
+	expected.Ingress = nil
+	expected.JobArrayId = util.NewULID()
+	expected.JobArrayIndex = 3
+
+	logJob, err := LogSubmitJobFromApiJob(expected)
+	require.NoError(t, err)
+	assert.Equal(t, expected.JobArrayId, logJob.JobArrayId)
+	assert.Equal(t, expected.JobArrayIndex, logJob.JobArrayIndex)
+
+	actual, err := ApiJobFromLogSubmitJob(expected.Owner, expected.QueueOwnershipUserGroups, expected.Queue, expected.JobSetId, expected.Created, logJob)
+	require.NoError(t, err)
+	assert.Equal(t, expected.JobArrayId, actual.JobArrayId)
+	assert.Equal(t, expected.JobArrayIndex, actual.JobArrayIndex)
+}
+
 func testJob(multiplePodSpecs bool) *api.Job {
 	var mainPodSpec *v1.PodSpec
 	var podSpec *v1.PodSpec
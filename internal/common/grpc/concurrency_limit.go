@@ -0,0 +1,105 @@
+package grpc
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/armadaproject/armada/internal/common/grpc/configuration"
+)
+
+// concurrencyLimit is a limit on the number of calls handled at once, with a bounded number of calls waiting.
+type concurrencyLimit struct {
+	// Holds a token per call being handled.
+	slots chan struct{}
+	// Number of calls waiting for a slot.
+	queued         atomic.Int32
+	maxQueuedCalls int32
+}
+
+func newConcurrencyLimit(maxConcurrentCalls int, maxQueuedCalls int) *concurrencyLimit {
+	return &concurrencyLimit{
+		slots:          make(chan struct{}, maxConcurrentCalls),
+		maxQueuedCalls: int32(maxQueuedCalls),
+	}
+}
+
+// acquire returns true once a slot is acquired, and false if none is acquired within maxQueueTime,
+// if maxQueuedCalls calls are waiting already, or if ctx is done.
+func (l *concurrencyLimit) acquire(ctx context.Context, maxQueueTime time.Duration) bool {
+	select {
+	case l.slots <- struct{}{}:
+		return true
+	default:
+	}
+	if maxQueueTime <= 0 {
+		return false
+	}
+	defer l.queued.Add(-1)
+	if queued := l.queued.Add(1); l.maxQueuedCalls > 0 && queued > l.maxQueuedCalls {
+		return false
+	}
+	timer := time.NewTimer(maxQueueTime)
+	defer timer.Stop()
+	select {
+	case l.slots <- struct{}{}:
+		return true
+	case <-timer.C:
+		return false
+	case <-ctx.Done():
+		return false
+	}
+}
+
+func (l *concurrencyLimit) release() {
+	<-l.slots
+}
+
+// ConcurrencyLimitingUnaryServerInterceptor returns an interceptor that limits the number of unary calls handled at
+// once as per config, such that bursts of calls, e.g., submit storms, don't overload the databases calls depend on.
+// Rejected calls fail with ResourceExhausted, with RetryInfo details advising when to retry.
+func ConcurrencyLimitingUnaryServerInterceptor(config configuration.ConcurrencyLimitConfig) grpc.UnaryServerInterceptor {
+	var globalLimit *concurrencyLimit
+	if config.MaxConcurrentCalls > 0 {
+		globalLimit = newConcurrencyLimit(config.MaxConcurrentCalls, config.MaxQueuedCalls)
+	}
+	limitsByMethod := make(map[string]*concurrencyLimit, len(config.Methods))
+	for _, methodLimit := range config.Methods {
+		if methodLimit.MaxConcurrentCalls > 0 {
+			limitsByMethod[methodLimit.Method] = newConcurrencyLimit(methodLimit.MaxConcurrentCalls, config.MaxQueuedCalls)
+		}
+	}
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		// The method limit is acquired first, such that calls waiting for it don't hold slots of the global limit.
+		if methodLimit, ok := limitsByMethod[info.FullMethod]; ok {
+			if !methodLimit.acquire(ctx, config.MaxQueueTime) {
+				return nil, concurrencyLimitError(ctx, config.RetryAfter, "too many concurrent calls of %s", info.FullMethod)
+			}
+			defer methodLimit.release()
+		}
+		if globalLimit != nil {
+			if !globalLimit.acquire(ctx, config.MaxQueueTime) {
+				return nil, concurrencyLimitError(ctx, config.RetryAfter, "too many concurrent calls")
+			}
+			defer globalLimit.release()
+		}
+		return handler(ctx, req)
+	}
+}
+
+func concurrencyLimitError(ctx context.Context, retryAfter time.Duration, format string, args ...interface{}) error {
+	if err := ctx.Err(); err != nil {
+		return status.FromContextError(err).Err()
+	}
+	st := status.Newf(codes.ResourceExhausted, format+"; retry after %s", append(args, retryAfter)...)
+	if stWithDetails, err := st.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(retryAfter)}); err == nil {
+		st = stWithDetails
+	}
+	return st.Err()
+}
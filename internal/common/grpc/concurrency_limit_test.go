@@ -0,0 +1,90 @@
+package grpc
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/armadaproject/armada/internal/common/grpc/configuration"
+)
+
+func TestConcurrencyLimitingUnaryServerInterceptor(t *testing.T) {
+	interceptor := ConcurrencyLimitingUnaryServerInterceptor(configuration.ConcurrencyLimitConfig{
+		MaxConcurrentCalls: 3,
+		Methods:            []configuration.MethodConcurrencyLimit{{Method: "/api.Submit/SubmitJobs", MaxConcurrentCalls: 1}},
+		MaxQueueTime:       50 * time.Millisecond,
+		MaxQueuedCalls:     1,
+		RetryAfter:         5 * time.Second,
+	})
+	submitJobs := &grpc.UnaryServerInfo{FullMethod: "/api.Submit/SubmitJobs"}
+	getQueue := &grpc.UnaryServerInfo{FullMethod: "/api.Submit/GetQueue"}
+
+	// Block a call of SubmitJobs until released.
+	release := make(chan struct{})
+	started := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		_, err := interceptor(context.Background(), nil, submitJobs, func(ctx context.Context, req interface{}) (interface{}, error) {
+			close(started)
+			<-release
+			return nil, nil
+		})
+		assert.NoError(t, err)
+	}()
+	<-started
+
+	// Another call of SubmitJobs waits for MaxQueueTime, and is then rejected with a retry hint.
+	start := time.Now()
+	_, err := interceptor(context.Background(), nil, submitJobs, okHandler)
+	assert.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond)
+	st := status.Convert(err)
+	assert.Equal(t, codes.ResourceExhausted, st.Code())
+	require.Len(t, st.Details(), 1)
+	assert.Equal(t, 5*time.Second, st.Details()[0].(*errdetails.RetryInfo).RetryDelay.AsDuration())
+
+	// Other methods are only subject to the global limit.
+	_, err = interceptor(context.Background(), nil, getQueue, okHandler)
+	assert.NoError(t, err)
+
+	// A waiting call of SubmitJobs is handled once the blocked call finishes.
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		close(release)
+	}()
+	_, err = interceptor(context.Background(), nil, submitJobs, okHandler)
+	assert.NoError(t, err)
+	wg.Wait()
+}
+
+func TestConcurrencyLimit_ShedsLoadBeyondMaxQueuedCalls(t *testing.T) {
+	limit := newConcurrencyLimit(1, 1)
+	require.True(t, limit.acquire(context.Background(), time.Second))
+
+	waiting := make(chan bool)
+	go func() {
+		waiting <- limit.acquire(context.Background(), time.Second)
+	}()
+	assert.Eventually(t, func() bool { return limit.queued.Load() == 1 }, time.Second, time.Millisecond)
+
+	// The queue is full, so the call is rejected without waiting.
+	start := time.Now()
+	assert.False(t, limit.acquire(context.Background(), time.Second))
+	assert.Less(t, time.Since(start), 500*time.Millisecond)
+
+	limit.release()
+	assert.True(t, <-waiting)
+}
+
+func okHandler(ctx context.Context, req interface{}) (interface{}, error) {
+	return nil, nil
+}
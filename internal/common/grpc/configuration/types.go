@@ -1,6 +1,8 @@
 package configuration
 
 import (
+	"time"
+
 	"google.golang.org/grpc/keepalive"
 )
 
@@ -9,6 +11,7 @@ type GrpcConfig struct {
 	KeepaliveParams            keepalive.ServerParameters
 	KeepaliveEnforcementPolicy keepalive.EnforcementPolicy
 	Tls                        TlsConfig
+	ConcurrencyLimits          ConcurrencyLimitConfig
 }
 
 type GrpcPoolConfig struct {
@@ -20,4 +23,29 @@ type TlsConfig struct {
 	Enabled  bool
 	KeyPath  string
 	CertPath string
+	// Optional path to PEM encoded CAs client certificates are verified against.
+	// If set, clients may authenticate with a certificate; clients without one are still accepted.
+	ClientCAPath string
+}
+
+// ConcurrencyLimitConfig limits the number of unary calls a server handles at once, both overall and per method.
+// Calls beyond a limit wait for a call to finish, up to MaxQueueTime; calls that can't be handled in time, or that
+// find MaxQueuedCalls calls already waiting, are rejected with ResourceExhausted and advised to retry after RetryAfter.
+type ConcurrencyLimitConfig struct {
+	// Maximum number of calls handled at once across all methods. If zero, the number of calls isn't limited overall.
+	MaxConcurrentCalls int
+	// Limits of individual methods, which apply in addition to MaxConcurrentCalls.
+	Methods []MethodConcurrencyLimit
+	// Maximum time calls wait for a call to finish when a limit is reached. If zero, such calls are rejected right away.
+	MaxQueueTime time.Duration
+	// Maximum number of calls waiting per limit. If zero, the number of waiting calls isn't limited.
+	MaxQueuedCalls int
+	// Delay after which clients are advised to retry rejected calls.
+	RetryAfter time.Duration
+}
+
+type MethodConcurrencyLimit struct {
+	// Full name of the method, e.g., "/api.Submit/SubmitJobs".
+	Method             string
+	MaxConcurrentCalls int
 }
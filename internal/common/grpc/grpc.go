@@ -2,8 +2,10 @@ package grpc
 
 import (
 	"crypto/tls"
+	"crypto/x509"
 	"fmt"
 	"net"
+	"os"
 	"runtime/debug"
 	"sync"
 	"time"
@@ -14,6 +16,7 @@ import (
 	grpc_recovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
 	grpc_ctxtags "github.com/grpc-ecosystem/go-grpc-middleware/tags"
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
+	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -32,11 +35,38 @@ import (
 
 // CreateGrpcServer creates a gRPC server (by calling grpc.NewServer) with settings specific to
 // this project, and registers services for, e.g., logging and authentication.
+// Any additional unary interceptors are called after authentication, in the order given.
 func CreateGrpcServer(
 	keepaliveParams keepalive.ServerParameters,
 	keepaliveEnforcementPolicy keepalive.EnforcementPolicy,
 	authServices []authorization.AuthService,
 	tlsConfig configuration.TlsConfig,
+	additionalUnaryInterceptors ...grpc.UnaryServerInterceptor,
+) *grpc.Server {
+	return CreateGrpcServerWithInterceptors(
+		keepaliveParams, keepaliveEnforcementPolicy, authServices, tlsConfig,
+		AdditionalInterceptors{Unary: additionalUnaryInterceptors},
+	)
+}
+
+// AdditionalInterceptors are interceptors added to those every server has.
+type AdditionalInterceptors struct {
+	// Called before authentication, e.g., to observe calls that fail to authenticate.
+	UnauthenticatedUnary  []grpc.UnaryServerInterceptor
+	UnauthenticatedStream []grpc.StreamServerInterceptor
+	// Called after authentication, such that the principal making the call is known.
+	Unary  []grpc.UnaryServerInterceptor
+	Stream []grpc.StreamServerInterceptor
+}
+
+// CreateGrpcServerWithInterceptors is CreateGrpcServer, also adding stream interceptors and interceptors called
+// before authentication.
+func CreateGrpcServerWithInterceptors(
+	keepaliveParams keepalive.ServerParameters,
+	keepaliveEnforcementPolicy keepalive.EnforcementPolicy,
+	authServices []authorization.AuthService,
+	tlsConfig configuration.TlsConfig,
+	additionalInterceptors AdditionalInterceptors,
 ) *grpc.Server {
 	// Logging, authentication, etc. are implemented via gRPC interceptors
 	// (i.e., via functions that are called before handling the actual request).
@@ -73,8 +103,12 @@ func CreateGrpcServer(
 	// The provided authServices represents a list of services that can be used to authenticate
 	// the client (e.g., username/password and OpenId). authFunction is a combination of these.
 	authFunction := authorization.CreateMiddlewareAuthFunction(authServices)
+	unaryInterceptors = append(unaryInterceptors, additionalInterceptors.UnauthenticatedUnary...)
+	streamInterceptors = append(streamInterceptors, additionalInterceptors.UnauthenticatedStream...)
 	unaryInterceptors = append(unaryInterceptors, grpc_auth.UnaryServerInterceptor(authFunction))
 	streamInterceptors = append(streamInterceptors, grpc_auth.StreamServerInterceptor(authFunction))
+	unaryInterceptors = append(unaryInterceptors, additionalInterceptors.Unary...)
+	streamInterceptors = append(streamInterceptors, additionalInterceptors.Stream...)
 
 	// Prometheus timeseries collection integration
 	grpc_prometheus.EnableHandlingTimeHistogram()
@@ -93,7 +127,7 @@ func CreateGrpcServer(
 		go func() {
 			cachedCertificateService.Run(armadacontext.Background())
 		}()
-		tlsCreds := credentials.NewTLS(&tls.Config{
+		serverTlsConfig := &tls.Config{
 			GetCertificate: func(info *tls.ClientHelloInfo) (*tls.Certificate, error) {
 				cert := cachedCertificateService.GetCertificate()
 				if cert == nil {
@@ -101,7 +135,18 @@ func CreateGrpcServer(
 				}
 				return cert, nil
 			},
-		})
+		}
+		if tlsConfig.ClientCAPath != "" {
+			// Client certificates are optional, since clients may authenticate otherwise.
+			// The ClientCert auth service authenticates the clients that present one.
+			clientCAs, err := loadCertPool(tlsConfig.ClientCAPath)
+			if err != nil {
+				panic(err)
+			}
+			serverTlsConfig.ClientCAs = clientCAs
+			serverTlsConfig.ClientAuth = tls.VerifyClientCertIfGiven
+		}
+		tlsCreds := credentials.NewTLS(serverTlsConfig)
 		serverOptions = append(serverOptions, grpc.Creds(tlsCreds))
 	}
 
@@ -109,6 +154,18 @@ func CreateGrpcServer(
 	return grpc.NewServer(serverOptions...)
 }
 
+func loadCertPool(path string) (*x509.CertPool, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(data) {
+		return nil, errors.Errorf("no PEM encoded certificates found in %s", path)
+	}
+	return pool, nil
+}
+
 // TODO We don't need this function. Just do this at the caller.
 func Listen(port uint16, grpcServer *grpc.Server, wg *sync.WaitGroup) {
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", port))
@@ -0,0 +1,53 @@
+package profiling
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"runtime/pprof"
+
+	"github.com/pkg/errors"
+)
+
+// StartFileProfiles starts a CPU profile written to dir/cpu.pprof and returns a function that stops it
+// and writes heap and allocation profiles to dir/heap.pprof and dir/allocs.pprof.
+// Only one CPU profile may be active per process.
+func StartFileProfiles(dir string) (func() error, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	cpuFile, err := os.Create(filepath.Join(dir, "cpu.pprof"))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if err := pprof.StartCPUProfile(cpuFile); err != nil {
+		_ = cpuFile.Close()
+		return nil, errors.WithStack(err)
+	}
+	return func() error {
+		pprof.StopCPUProfile()
+		if err := cpuFile.Close(); err != nil {
+			return errors.WithStack(err)
+		}
+		// Heap profiles reflect the state as of the most recent garbage collection.
+		runtime.GC()
+		for _, name := range []string{"heap", "allocs"} {
+			if err := writeProfile(name, filepath.Join(dir, name+".pprof")); err != nil {
+				return err
+			}
+		}
+		return nil
+	}, nil
+}
+
+func writeProfile(name string, path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if err := pprof.Lookup(name).WriteTo(f, 0); err != nil {
+		_ = f.Close()
+		return errors.WithStack(err)
+	}
+	return errors.WithStack(f.Close())
+}
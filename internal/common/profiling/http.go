@@ -17,8 +17,11 @@ import (
 func SetupPprofHttpServer(port *uint16) *http.Server {
 	pprofMux := http.DefaultServeMux
 	http.DefaultServeMux = http.NewServeMux()
+	if port == nil {
+		return nil
+	}
 	return &http.Server{
-		Addr:    fmt.Sprintf("localhost:%d", port),
+		Addr:    fmt.Sprintf("localhost:%d", *port),
 		Handler: pprofMux,
 	}
 }
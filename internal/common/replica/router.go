@@ -0,0 +1,97 @@
+package replica
+
+import (
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/util/clock"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+)
+
+// Maximum time spent checking the replication lag of a replica.
+const lagCheckTimeout = time.Second
+
+// LagFunc returns how far a read replica lags behind its primary.
+type LagFunc func(ctx *armadacontext.Context) (time.Duration, error)
+
+// Router routes reads to a read replica while it lags behind its primary by no more than maxStaleness,
+// and to the primary otherwise, e.g., because replication is falling behind or the replica is unavailable.
+// Writes should always go to the primary.
+//
+// Replication lag is checked at most once per checkInterval, when reading.
+type Router[T any] struct {
+	primary      T
+	replica      T
+	hasReplica   bool
+	lag          LagFunc
+	maxStaleness time.Duration
+	// Replication lag is checked at most once per checkInterval.
+	checkInterval time.Duration
+	// Time at which replication lag was last checked.
+	lastCheck time.Time
+	// If true, reads are routed to the replica.
+	useReplica bool
+	clock      clock.Clock
+	mu         sync.Mutex
+}
+
+// NewPrimaryRouter returns a Router that routes all reads to primary.
+func NewPrimaryRouter[T any](primary T) *Router[T] {
+	return &Router[T]{primary: primary}
+}
+
+// NewRouter returns a Router that routes reads to replica while lag reports
+// that it lags behind primary by no more than maxStaleness.
+func NewRouter[T any](primary T, replica T, lag LagFunc, maxStaleness time.Duration, checkInterval time.Duration) *Router[T] {
+	return &Router[T]{
+		primary:       primary,
+		replica:       replica,
+		hasReplica:    true,
+		lag:           lag,
+		maxStaleness:  maxStaleness,
+		checkInterval: checkInterval,
+		clock:         clock.RealClock{},
+	}
+}
+
+// Primary returns the primary, which should be used for writes and reads that must observe all previous writes.
+func (r *Router[T]) Primary() T {
+	return r.primary
+}
+
+// Reader returns the replica if it's sufficiently up to date and the primary otherwise.
+func (r *Router[T]) Reader() T {
+	if !r.hasReplica {
+		return r.primary
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if now := r.clock.Now(); r.lastCheck.IsZero() || now.Sub(r.lastCheck) >= r.checkInterval {
+		r.lastCheck = now
+		r.updateUseReplica()
+	}
+	if r.useReplica {
+		return r.replica
+	}
+	return r.primary
+}
+
+func (r *Router[T]) updateUseReplica() {
+	ctx, cancel := armadacontext.WithTimeout(armadacontext.Background(), lagCheckTimeout)
+	defer cancel()
+	lag, err := r.lag(ctx)
+	useReplica := err == nil && lag <= r.maxStaleness
+	if useReplica == r.useReplica {
+		return
+	}
+	r.useReplica = useReplica
+	if useReplica {
+		log.Infof("read replica lags behind by %s; routing reads to replica", lag)
+	} else if err != nil {
+		log.WithError(err).Warn("failed to get replication lag of read replica; routing reads to primary")
+	} else {
+		log.Warnf("read replica lags behind by %s, more than the allowed %s; routing reads to primary", lag, r.maxStaleness)
+	}
+}
@@ -0,0 +1,52 @@
+package replica
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/util/clock"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+)
+
+func TestRouter(t *testing.T) {
+	var lag time.Duration
+	var lagErr error
+	numChecks := 0
+	testClock := clock.NewFakeClock(time.Now())
+	router := NewRouter("primary", "replica", func(_ *armadacontext.Context) (time.Duration, error) {
+		numChecks++
+		return lag, lagErr
+	}, 5*time.Second, time.Minute)
+	router.clock = testClock
+
+	assert.Equal(t, "replica", router.Reader())
+	assert.Equal(t, "primary", router.Primary())
+	assert.Equal(t, 1, numChecks)
+
+	// Lag is only checked once per interval.
+	lag = 10 * time.Second
+	assert.Equal(t, "replica", router.Reader())
+	assert.Equal(t, 1, numChecks)
+
+	testClock.Step(time.Minute)
+	assert.Equal(t, "primary", router.Reader())
+	assert.Equal(t, 2, numChecks)
+
+	lag = time.Second
+	testClock.Step(time.Minute)
+	assert.Equal(t, "replica", router.Reader())
+
+	// Reads go to the primary if the lag of the replica is unknown.
+	lagErr = errors.New("replica unavailable")
+	testClock.Step(time.Minute)
+	assert.Equal(t, "primary", router.Reader())
+}
+
+func TestPrimaryRouter(t *testing.T) {
+	router := NewPrimaryRouter("primary")
+	assert.Equal(t, "primary", router.Reader())
+	assert.Equal(t, "primary", router.Primary())
+}
@@ -1,28 +1,40 @@
 package validation
 
 import (
+	"net"
+	"strings"
+
 	"github.com/pkg/errors"
+	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
 
 	"github.com/armadaproject/armada/internal/scheduler"
 
 	"github.com/armadaproject/armada/internal/armada/configuration"
 	"github.com/armadaproject/armada/internal/common/armadaerrors"
 	"github.com/armadaproject/armada/internal/common/util"
+	"github.com/armadaproject/armada/internal/executor/domain"
 	"github.com/armadaproject/armada/pkg/api"
 )
 
 func ValidateApiJobs(jobs []*api.Job, config configuration.SchedulingConfig) ([]*api.JobSubmitResponseItem, error) {
-	if _, err := validateGangs(jobs); err != nil {
+	if _, err := validateBarriers(jobs); err != nil {
+		return nil, err
+	}
+	gangDetailsByGangId, err := validateGangs(jobs)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateMaxConcurrentJobs(jobs, gangDetailsByGangId); err != nil {
+		return nil, err
+	}
+	if err := validateJobSetResourceLimits(jobs); err != nil {
 		return nil, err
 	}
 
 	responseItems := make([]*api.JobSubmitResponseItem, 0, len(jobs))
 	for _, job := range jobs {
 		if err := ValidateApiJob(job, config); err != nil {
-			response := &api.JobSubmitResponseItem{
-				JobId: job.Id,
-				Error: err.Error(),
-			}
+			response := api.NewFailedJobSubmitResponseItem(job.Id, api.JobSubmitError_INVALID_POD_SPEC, "", err.Error())
 			responseItems = append(responseItems, response)
 		}
 	}
@@ -33,6 +45,90 @@ func ValidateApiJobs(jobs []*api.Job, config configuration.SchedulingConfig) ([]
 	return nil, nil
 }
 
+// validateBarriers checks that the barrier annotations of each job are well-formed
+// and that all jobs in the same barrier agree on its cardinality.
+// Returns a map from barrier id to barrier cardinality.
+func validateBarriers(jobs []*api.Job) (map[string]int, error) {
+	cardinalityByBarrierId := make(map[string]int)
+	numMembersByBarrierId := make(map[string]int)
+	for i, job := range jobs {
+		barrierId, barrierCardinality, isBarrierJob, err := scheduler.BarrierIdAndCardinalityFromAnnotations(job.Annotations)
+		if err != nil {
+			return nil, errors.WithMessagef(err, "%d-th job with id %s in barrier %s", i, job.Id, barrierId)
+		}
+		if !isBarrierJob {
+			continue
+		}
+		if barrierId == "" {
+			return nil, errors.Errorf("empty barrier id for %d-th job with id %s", i, job.Id)
+		}
+		if expectedCardinality, ok := cardinalityByBarrierId[barrierId]; ok && expectedCardinality != barrierCardinality {
+			return nil, errors.Errorf(
+				"inconsistent barrier cardinality for %d-th job with id %s in barrier %s: expected %d but got %d",
+				i, job.Id, barrierId, expectedCardinality, barrierCardinality,
+			)
+		}
+		cardinalityByBarrierId[barrierId] = barrierCardinality
+		numMembersByBarrierId[barrierId]++
+		if numMembersByBarrierId[barrierId] > barrierCardinality {
+			return nil, errors.Errorf(
+				"more than %d jobs submitted for barrier %s of cardinality %d",
+				barrierCardinality, barrierId, barrierCardinality,
+			)
+		}
+	}
+	return cardinalityByBarrierId, nil
+}
+
+// validateMaxConcurrentJobs checks that the max concurrent jobs annotation of each job is well-formed
+// and that no throttled job is part of a gang too large to ever be scheduled under its limit.
+func validateMaxConcurrentJobs(jobs []*api.Job, gangDetailsByGangId map[string]gangDetails) error {
+	for i, job := range jobs {
+		maxConcurrentJobs, isThrottledJob, err := scheduler.MaxConcurrentJobsFromAnnotations(job.Annotations)
+		if err != nil {
+			return errors.WithMessagef(err, "%d-th job with id %s", i, job.Id)
+		}
+		if !isThrottledJob {
+			continue
+		}
+		gangId, ok := job.Annotations[configuration.GangIdAnnotation]
+		if !ok {
+			continue
+		}
+		if details := gangDetailsByGangId[gangId]; details.expectedCardinality > maxConcurrentJobs {
+			return errors.Errorf(
+				"%d-th job with id %s is in gang %s of cardinality %d, which exceeds the max concurrent jobs %d of its job set",
+				i, job.Id, gangId, details.expectedCardinality, maxConcurrentJobs,
+			)
+		}
+	}
+	return nil
+}
+
+// validateJobSetResourceLimits checks that the job set resource limits annotation of each job is well-formed
+// and that no job requests more of a resource than its job set is limited to, since it could never be scheduled.
+func validateJobSetResourceLimits(jobs []*api.Job) error {
+	for i, job := range jobs {
+		limits, isLimitedJob, err := scheduler.JobSetResourceLimitsFromAnnotations(job.Annotations)
+		if err != nil {
+			return errors.WithMessagef(err, "%d-th job with id %s", i, job.Id)
+		}
+		if !isLimitedJob {
+			continue
+		}
+		request := job.TotalResourceRequest()
+		for name, limit := range limits {
+			if quantity, ok := request[name]; ok && quantity.Cmp(limit) > 0 {
+				return errors.Errorf(
+					"%d-th job with id %s requests %s of %s, which exceeds the limit %s of its job set",
+					i, job.Id, quantity.String(), name, limit.String(),
+				)
+			}
+		}
+	}
+	return nil
+}
+
 type gangDetails = struct {
 	expectedCardinality         int
 	expectedMinimumCardinality  int
@@ -152,11 +248,13 @@ func ValidateApiJobPodSpecs(j *api.Job) error {
 	return nil
 }
 
-func ValidateJobSubmitRequestItem(request *api.JobSubmitRequestItem) error {
-	return validateIngressConfigs(request)
+// ValidateJobSubmitRequestItem validates the ingresses of request. External-dns hostnames, and targets that aren't
+// IP addresses, must be within one of externalDnsDomains.
+func ValidateJobSubmitRequestItem(request *api.JobSubmitRequestItem, externalDnsDomains []string) error {
+	return validateIngressConfigs(request, externalDnsDomains)
 }
 
-func validateIngressConfigs(item *api.JobSubmitRequestItem) error {
+func validateIngressConfigs(item *api.JobSubmitRequestItem, externalDnsDomains []string) error {
 	existingPortSet := make(map[uint32]int)
 
 	for index, portConfig := range item.Ingress {
@@ -176,6 +274,71 @@ func validateIngressConfigs(item *api.JobSubmitRequestItem) error {
 				existingPortSet[port] = index
 			}
 		}
+
+		if err := validateIngressConfig(portConfig, externalDnsDomains); err != nil {
+			return errors.WithMessagef(err, "ingress config with index %d is invalid", index)
+		}
+	}
+	return nil
+}
+
+// validateIngressConfig validates the TLS secret, ingress class and external-dns options of an ingress.
+// Names must be valid Kubernetes names, such that the executor can tell them apart from the placeholders
+// for the hostnames and TLS secret of its cluster, which end with "." and "-", respectively.
+// External-dns records may only be created within externalDnsDomains, such that jobs can't take over other names.
+func validateIngressConfig(config *api.IngressConfig, externalDnsDomains []string) error {
+	if config.CertName != "" {
+		if !config.TlsEnabled {
+			return errors.Errorf("cert name %s is set but TLS isn't enabled", config.CertName)
+		}
+		if errs := k8svalidation.IsDNS1123Subdomain(config.CertName); len(errs) > 0 {
+			return errors.Errorf("invalid cert name %q: %s", config.CertName, strings.Join(errs, "; "))
+		}
+	}
+	if config.IngressClassName != "" {
+		if errs := k8svalidation.IsDNS1123Subdomain(config.IngressClassName); len(errs) > 0 {
+			return errors.Errorf("invalid ingress class %q: %s", config.IngressClassName, strings.Join(errs, "; "))
+		}
+	}
+	// External-dns annotations would bypass the domains records may be created in.
+	for key := range config.Annotations {
+		if strings.HasPrefix(key, domain.ExternalDnsAnnotationPrefix) {
+			return errors.Errorf("annotation %s may not be set; use the external dns options instead", key)
+		}
+	}
+	externalDns := config.ExternalDns
+	if externalDns == nil {
+		return nil
+	}
+	if len(externalDns.Hostnames) > 0 && len(config.Ports) != 1 {
+		return errors.Errorf("external dns hostnames may only be set for ingresses exposing a single port, but %d ports are exposed", len(config.Ports))
+	}
+	for _, hostname := range externalDns.Hostnames {
+		if errs := k8svalidation.IsDNS1123Subdomain(hostname); len(errs) > 0 {
+			return errors.Errorf("invalid external dns hostname %q: %s", hostname, strings.Join(errs, "; "))
+		}
+		if !isInDomains(hostname, externalDnsDomains) {
+			return errors.Errorf("external dns hostname %s isn't within any of the allowed domains %v", hostname, externalDnsDomains)
+		}
+	}
+	if target := externalDns.Target; target != "" && net.ParseIP(target) == nil {
+		if errs := k8svalidation.IsDNS1123Subdomain(target); len(errs) > 0 {
+			return errors.Errorf("invalid external dns target %q: must be an IP address or hostname", target)
+		}
+		if !isInDomains(target, externalDnsDomains) {
+			return errors.Errorf("external dns target %s isn't within any of the allowed domains %v", target, externalDnsDomains)
+		}
 	}
 	return nil
 }
+
+// isInDomains returns true if hostname is one of domains or a subdomain of one of them.
+func isInDomains(hostname string, domains []string) bool {
+	for _, d := range domains {
+		d = strings.TrimSuffix(d, ".")
+		if d != "" && (hostname == d || strings.HasSuffix(hostname, "."+d)) {
+			return true
+		}
+	}
+	return false
+}
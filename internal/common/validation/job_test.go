@@ -7,6 +7,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 
 	"github.com/armadaproject/armada/internal/armada/configuration"
 	"github.com/armadaproject/armada/internal/common/armadaerrors"
@@ -24,7 +25,7 @@ func Test_ValidateJobSubmitRequestItem(t *testing.T) {
 			},
 		},
 	}
-	assert.NoError(t, ValidateJobSubmitRequestItem(validIngressConfig))
+	assert.NoError(t, ValidateJobSubmitRequestItem(validIngressConfig, nil))
 }
 
 func Test_ValidateApiJobPodSpecs(t *testing.T) {
@@ -82,7 +83,7 @@ func Test_ValidateJobSubmitRequestItem_WithPortRepeatedInSingleConfig(t *testing
 			},
 		},
 	}
-	assert.Error(t, ValidateJobSubmitRequestItem(validIngressConfig))
+	assert.Error(t, ValidateJobSubmitRequestItem(validIngressConfig, nil))
 }
 
 func Test_ValidateJobSubmitRequestItem_WithPortRepeatedInSeperateConfig(t *testing.T) {
@@ -102,7 +103,102 @@ func Test_ValidateJobSubmitRequestItem_WithPortRepeatedInSeperateConfig(t *testi
 			},
 		},
 	}
-	assert.Error(t, ValidateJobSubmitRequestItem(validIngressConfig))
+	assert.Error(t, ValidateJobSubmitRequestItem(validIngressConfig, nil))
+}
+
+func Test_ValidateJobSubmitRequestItem_ExternalDnsWithoutAllowedDomains(t *testing.T) {
+	item := &api.JobSubmitRequestItem{Ingress: []*api.IngressConfig{{
+		Ports:       []uint32{5},
+		ExternalDns: &api.ExternalDnsConfig{Hostnames: []string{"app.example.com"}},
+	}}}
+	assert.Error(t, ValidateJobSubmitRequestItem(item, nil))
+
+	// IP targets are allowed regardless of domains.
+	item.Ingress[0].ExternalDns = &api.ExternalDnsConfig{TtlSeconds: 60, Target: "10.0.0.1"}
+	assert.NoError(t, ValidateJobSubmitRequestItem(item, nil))
+}
+
+func Test_ValidateJobSubmitRequestItem_IngressOptions(t *testing.T) {
+	tests := map[string]struct {
+		config *api.IngressConfig
+		valid  bool
+	}{
+		"cert name": {
+			config: &api.IngressConfig{Ports: []uint32{5}, TlsEnabled: true, CertName: "app-certificate"},
+			valid:  true,
+		},
+		"cert name without TLS": {
+			config: &api.IngressConfig{Ports: []uint32{5}, CertName: "app-certificate"},
+		},
+		"invalid cert name": {
+			config: &api.IngressConfig{Ports: []uint32{5}, TlsEnabled: true, CertName: "namespace-"},
+		},
+		"ingress class": {
+			config: &api.IngressConfig{Ports: []uint32{5}, IngressClassName: "nginx"},
+			valid:  true,
+		},
+		"invalid ingress class": {
+			config: &api.IngressConfig{Ports: []uint32{5}, IngressClassName: "Not_A_Class"},
+		},
+		"external dns": {
+			config: &api.IngressConfig{Ports: []uint32{5}, ExternalDns: &api.ExternalDnsConfig{
+				Hostnames: []string{"app.example.com"}, TtlSeconds: 60, Target: "10.0.0.1",
+			}},
+			valid: true,
+		},
+		"external dns hostnames with several ports": {
+			config: &api.IngressConfig{Ports: []uint32{5, 6}, ExternalDns: &api.ExternalDnsConfig{Hostnames: []string{"app.example.com"}}},
+		},
+		"invalid external dns hostname": {
+			config: &api.IngressConfig{Ports: []uint32{5}, ExternalDns: &api.ExternalDnsConfig{Hostnames: []string{"app.example.com."}}},
+		},
+		"invalid external dns target": {
+			config: &api.IngressConfig{Ports: []uint32{5}, ExternalDns: &api.ExternalDnsConfig{Target: "http://lb"}},
+		},
+		"external dns annotation with external dns options": {
+			config: &api.IngressConfig{
+				Ports:       []uint32{5},
+				Annotations: map[string]string{"external-dns.alpha.kubernetes.io/ttl": "60"},
+				ExternalDns: &api.ExternalDnsConfig{},
+			},
+		},
+		"external dns annotation without external dns options": {
+			config: &api.IngressConfig{
+				Ports:       []uint32{5},
+				Annotations: map[string]string{"external-dns.alpha.kubernetes.io/hostname": "app.example.org"},
+			},
+		},
+		"external dns hostname equal to allowed domain": {
+			config: &api.IngressConfig{Ports: []uint32{5}, ExternalDns: &api.ExternalDnsConfig{Hostnames: []string{"apps.example.net"}}},
+			valid:  true,
+		},
+		"external dns hostname outside allowed domains": {
+			config: &api.IngressConfig{Ports: []uint32{5}, ExternalDns: &api.ExternalDnsConfig{Hostnames: []string{"app.example.org"}}},
+		},
+		"external dns hostname with allowed domain as non-domain suffix": {
+			config: &api.IngressConfig{Ports: []uint32{5}, ExternalDns: &api.ExternalDnsConfig{Hostnames: []string{"appexample.com"}}},
+		},
+		"external dns target hostname within allowed domains": {
+			config: &api.IngressConfig{Ports: []uint32{5}, ExternalDns: &api.ExternalDnsConfig{Target: "lb.apps.example.net"}},
+			valid:  true,
+		},
+		"external dns target hostname outside allowed domains": {
+			config: &api.IngressConfig{Ports: []uint32{5}, ExternalDns: &api.ExternalDnsConfig{Target: "lb.example.org"}},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := ValidateJobSubmitRequestItem(
+				&api.JobSubmitRequestItem{Ingress: []*api.IngressConfig{tc.config}},
+				[]string{"example.com", "apps.example.net."},
+			)
+			if tc.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
 }
 
 func TestValidateGangs(t *testing.T) {
@@ -398,3 +494,172 @@ func TestValidateGangs(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateBarriers(t *testing.T) {
+	barrierJob := func(barrierId string, cardinality string) *api.Job {
+		return &api.Job{
+			Annotations: map[string]string{
+				configuration.BarrierIdAnnotation:          barrierId,
+				configuration.BarrierCardinalityAnnotation: cardinality,
+			},
+		}
+	}
+	tests := map[string]struct {
+		Jobs                           []*api.Job
+		ExpectSuccess                  bool
+		ExpectedCardinalityByBarrierId map[string]int
+	}{
+		"no barrier jobs": {
+			Jobs:          []*api.Job{{}, {}},
+			ExpectSuccess: true,
+		},
+		"partial barriers": {
+			Jobs:                           []*api.Job{barrierJob("foo", "3"), barrierJob("bar", "2"), barrierJob("foo", "3")},
+			ExpectSuccess:                  true,
+			ExpectedCardinalityByBarrierId: map[string]int{"foo": 3, "bar": 2},
+		},
+		"missing cardinality": {
+			Jobs: []*api.Job{
+				{Annotations: map[string]string{configuration.BarrierIdAnnotation: "foo"}},
+			},
+			ExpectSuccess: false,
+		},
+		"non-positive cardinality": {
+			Jobs:          []*api.Job{barrierJob("foo", "0")},
+			ExpectSuccess: false,
+		},
+		"empty barrier id": {
+			Jobs:          []*api.Job{barrierJob("", "1")},
+			ExpectSuccess: false,
+		},
+		"inconsistent cardinality": {
+			Jobs:          []*api.Job{barrierJob("foo", "2"), barrierJob("foo", "3")},
+			ExpectSuccess: false,
+		},
+		"too many members": {
+			Jobs:          []*api.Job{barrierJob("foo", "1"), barrierJob("foo", "1")},
+			ExpectSuccess: false,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			cardinalityByBarrierId, err := validateBarriers(tc.Jobs)
+			if tc.ExpectSuccess {
+				if assert.NoError(t, err) {
+					for id, expected := range tc.ExpectedCardinalityByBarrierId {
+						assert.Equal(t, expected, cardinalityByBarrierId[id])
+					}
+				}
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateMaxConcurrentJobs(t *testing.T) {
+	throttledJob := func(maxConcurrentJobs string, gangCardinality string) *api.Job {
+		annotations := map[string]string{configuration.MaxConcurrentJobsAnnotation: maxConcurrentJobs}
+		if gangCardinality != "" {
+			annotations[configuration.GangIdAnnotation] = "gang"
+			annotations[configuration.GangCardinalityAnnotation] = gangCardinality
+			annotations[configuration.GangMinimumCardinalityAnnotation] = gangCardinality
+		}
+		return &api.Job{Annotations: annotations}
+	}
+	tests := map[string]struct {
+		Jobs          []*api.Job
+		ExpectSuccess bool
+	}{
+		"no throttled jobs": {
+			Jobs:          []*api.Job{{}, {}},
+			ExpectSuccess: true,
+		},
+		"throttled jobs": {
+			Jobs:          []*api.Job{throttledJob("1", ""), throttledJob("1", "")},
+			ExpectSuccess: true,
+		},
+		"non-positive limit": {
+			Jobs:          []*api.Job{throttledJob("0", "")},
+			ExpectSuccess: false,
+		},
+		"invalid limit": {
+			Jobs:          []*api.Job{throttledJob("foo", "")},
+			ExpectSuccess: false,
+		},
+		"gang within limit": {
+			Jobs:          []*api.Job{throttledJob("2", "2"), throttledJob("2", "2")},
+			ExpectSuccess: true,
+		},
+		"gang exceeding limit": {
+			Jobs:          []*api.Job{throttledJob("1", "2"), throttledJob("1", "2")},
+			ExpectSuccess: false,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			gangDetailsByGangId, err := validateGangs(tc.Jobs)
+			assert.NoError(t, err)
+			err = validateMaxConcurrentJobs(tc.Jobs, gangDetailsByGangId)
+			if tc.ExpectSuccess {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateJobSetResourceLimits(t *testing.T) {
+	limitedJob := func(limits string, cpu string) *api.Job {
+		return &api.Job{
+			Annotations: map[string]string{configuration.JobSetResourceLimitsAnnotation: limits},
+			PodSpec: &v1.PodSpec{
+				Containers: []v1.Container{{
+					Resources: v1.ResourceRequirements{
+						Requests: v1.ResourceList{"cpu": resource.MustParse(cpu)},
+					},
+				}},
+			},
+		}
+	}
+	tests := map[string]struct {
+		Jobs          []*api.Job
+		ExpectSuccess bool
+	}{
+		"no limited jobs": {
+			Jobs:          []*api.Job{{}, {}},
+			ExpectSuccess: true,
+		},
+		"jobs within limit": {
+			Jobs:          []*api.Job{limitedJob("cpu=2", "2"), limitedJob("cpu=2", "1")},
+			ExpectSuccess: true,
+		},
+		"unconstrained resource": {
+			Jobs:          []*api.Job{limitedJob("memory=1Gi", "8")},
+			ExpectSuccess: true,
+		},
+		"job exceeding limit": {
+			Jobs:          []*api.Job{limitedJob("cpu=2", "3")},
+			ExpectSuccess: false,
+		},
+		"invalid limit": {
+			Jobs:          []*api.Job{limitedJob("cpu", "1")},
+			ExpectSuccess: false,
+		},
+		"negative limit": {
+			Jobs:          []*api.Job{limitedJob("cpu=-1", "1")},
+			ExpectSuccess: false,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := validateJobSetResourceLimits(tc.Jobs)
+			if tc.ExpectSuccess {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
@@ -1,12 +1,15 @@
 package validation
 
 import (
+	"fmt"
+
 	"github.com/pkg/errors"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/component-helpers/scheduling/corev1/nodeaffinity"
 
 	"github.com/armadaproject/armada/internal/armada/configuration"
 	"github.com/armadaproject/armada/internal/common/armadaerrors"
+	armadaresource "github.com/armadaproject/armada/internal/common/resource"
 	"github.com/armadaproject/armada/internal/common/types"
 )
 
@@ -33,6 +36,26 @@ func ValidatePodSpec(spec *v1.PodSpec, schedulingConfig *configuration.Schedulin
 		return err
 	}
 
+	err = validateRestartPolicy(spec, schedulingConfig)
+	if err != nil {
+		return err
+	}
+
+	err = validateServiceAccount(spec, schedulingConfig)
+	if err != nil {
+		return err
+	}
+
+	err = validateNodeSelectorLabels(spec, schedulingConfig)
+	if err != nil {
+		return err
+	}
+
+	err = validateTolerations(spec, schedulingConfig)
+	if err != nil {
+		return err
+	}
+
 	for _, container := range spec.Containers {
 		if len(container.Resources.Limits) == 0 {
 			return errors.Errorf("container %v has no resource limits specified", container.Name)
@@ -74,6 +97,81 @@ func validateTerminationGracePeriod(spec *v1.PodSpec, config *configuration.Sche
 	return nil
 }
 
+func validateRestartPolicy(spec *v1.PodSpec, config *configuration.SchedulingConfig) error {
+	if len(config.AllowedRestartPolicies) == 0 {
+		return nil
+	}
+	restartPolicy := spec.RestartPolicy
+	if restartPolicy == "" {
+		// Executors run pods that don't set a restart policy with restart policy Never.
+		restartPolicy = v1.RestartPolicyNever
+	}
+	for _, allowed := range config.AllowedRestartPolicies {
+		if restartPolicy == allowed {
+			return nil
+		}
+	}
+	return errors.Errorf("restartPolicy %s must be one of %v", restartPolicy, config.AllowedRestartPolicies)
+}
+
+func validateServiceAccount(spec *v1.PodSpec, config *configuration.SchedulingConfig) error {
+	if config.DenyAllServiceAccounts {
+		return errors.Errorf("serviceAccountName %s isn't allowed: none of the service accounts allowed for the queue is allowed server-wide", ServiceAccountName(spec))
+	}
+	if len(config.AllowedServiceAccounts) == 0 {
+		return nil
+	}
+	serviceAccount := ServiceAccountName(spec)
+	for _, allowed := range config.AllowedServiceAccounts {
+		if serviceAccount == allowed {
+			return nil
+		}
+	}
+	return errors.Errorf("serviceAccountName %s must be one of %v", serviceAccount, config.AllowedServiceAccounts)
+}
+
+func validateNodeSelectorLabels(spec *v1.PodSpec, config *configuration.SchedulingConfig) error {
+	for _, label := range config.ForbiddenJobNodeSelectorLabels {
+		if _, ok := spec.NodeSelector[label]; ok {
+			return errors.Errorf("nodeSelector must not select nodes by label %s", label)
+		}
+		if spec.Affinity == nil || spec.Affinity.NodeAffinity == nil || spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution == nil {
+			continue
+		}
+		for _, term := range spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms {
+			for _, requirement := range term.MatchExpressions {
+				if requirement.Key == label {
+					return errors.Errorf("nodeAffinity must not select nodes by label %s", label)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func validateTolerations(spec *v1.PodSpec, config *configuration.SchedulingConfig) error {
+	for i := range config.ForbiddenJobTaints {
+		taint := &config.ForbiddenJobTaints[i]
+		for _, toleration := range spec.Tolerations {
+			if toleration.ToleratesTaint(taint) {
+				return errors.Errorf("toleration of %s must not tolerate taint %s", toleration.Key, taint.ToString())
+			}
+		}
+	}
+	return nil
+}
+
+// ServiceAccountName returns the name of the service account pods created from spec run as.
+func ServiceAccountName(spec *v1.PodSpec) string {
+	if spec.ServiceAccountName != "" {
+		return spec.ServiceAccountName
+	}
+	if spec.DeprecatedServiceAccount != "" {
+		return spec.DeprecatedServiceAccount
+	}
+	return "default"
+}
+
 func validateContainerResource(
 	resourceSpec v1.ResourceList,
 	minJobResources v1.ResourceList,
@@ -171,14 +269,39 @@ func validatePodSpecPriorityClass(podSpec *v1.PodSpec, preemptionEnabled bool, a
 				Message: "Preemption is disabled in Server config",
 			})
 		}
-		if _, exists := allowedPriorityClasses[priorityClassName]; !exists {
+		priorityClass, exists := allowedPriorityClasses[priorityClassName]
+		if !exists {
 			return errors.WithStack(&armadaerrors.ErrInvalidArgument{
 				Name:    "PriorityClassName",
 				Value:   podSpec.PriorityClassName,
 				Message: "Specified Priority Class is not supported in Server config",
 			})
 		}
+		for resourceName, quantity := range armadaresource.TotalPodResourceRequest(podSpec) {
+			if quantity.Sign() > 0 && priorityClassForbidsResource(priorityClass, resourceName) {
+				return errors.WithStack(&armadaerrors.ErrInvalidArgument{
+					Name:    "PriorityClassName",
+					Value:   podSpec.PriorityClassName,
+					Message: fmt.Sprintf("Specified Priority Class doesn't allow any %s per queue in any pool", resourceName),
+				})
+			}
+		}
 	}
 
 	return nil
 }
+
+// priorityClassForbidsResource returns true if priorityClass limits the fraction of resourceName assigned to each queue
+// to 0 in every pool, such that jobs of the class requesting resourceName can never be scheduled.
+func priorityClassForbidsResource(priorityClass types.PriorityClass, resourceName string) bool {
+	if fraction, ok := priorityClass.MaximumResourceFractionPerQueue[resourceName]; !ok || fraction > 0 {
+		return false
+	}
+	// Per-pool limits replace, rather than extend, the default limits.
+	for _, fractionByResourceName := range priorityClass.MaximumResourceFractionPerQueueByPool {
+		if fraction, ok := fractionByResourceName[resourceName]; !ok || fraction > 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -85,6 +85,66 @@ func Test_ValidatePodSpec_terminationGracePeriod(t *testing.T) {
 	assert.NoError(t, validateTerminationGracePeriod(podspecNoSetting, schedulingConfig))
 }
 
+func Test_ValidatePodSpec_restartPolicy(t *testing.T) {
+	schedulingConfig := &configuration.SchedulingConfig{
+		AllowedRestartPolicies: []v1.RestartPolicy{v1.RestartPolicyNever},
+	}
+
+	assert.NoError(t, validateRestartPolicy(&v1.PodSpec{RestartPolicy: v1.RestartPolicyNever}, schedulingConfig))
+	assert.NoError(t, validateRestartPolicy(&v1.PodSpec{}, schedulingConfig))
+	assert.Error(t, validateRestartPolicy(&v1.PodSpec{RestartPolicy: v1.RestartPolicyAlways}, schedulingConfig))
+	assert.NoError(t, validateRestartPolicy(&v1.PodSpec{RestartPolicy: v1.RestartPolicyAlways}, &configuration.SchedulingConfig{}))
+}
+
+func Test_ValidatePodSpec_serviceAccount(t *testing.T) {
+	schedulingConfig := &configuration.SchedulingConfig{
+		AllowedServiceAccounts: []string{"default", "pipeline-runner"},
+	}
+
+	assert.NoError(t, validateServiceAccount(&v1.PodSpec{ServiceAccountName: "pipeline-runner"}, schedulingConfig))
+	assert.NoError(t, validateServiceAccount(&v1.PodSpec{}, schedulingConfig))
+	assert.Error(t, validateServiceAccount(&v1.PodSpec{ServiceAccountName: "cluster-admin"}, schedulingConfig))
+	assert.Error(t, validateServiceAccount(&v1.PodSpec{DeprecatedServiceAccount: "cluster-admin"}, schedulingConfig))
+	assert.NoError(t, validateServiceAccount(&v1.PodSpec{ServiceAccountName: "cluster-admin"}, &configuration.SchedulingConfig{}))
+	assert.Error(t, validateServiceAccount(&v1.PodSpec{}, &configuration.SchedulingConfig{DenyAllServiceAccounts: true}))
+}
+
+func Test_ValidatePodSpec_nodeSelectorLabels(t *testing.T) {
+	schedulingConfig := &configuration.SchedulingConfig{
+		ForbiddenJobNodeSelectorLabels: []string{"dedicated"},
+	}
+	affinity := func(label string) *v1.Affinity {
+		return &v1.Affinity{NodeAffinity: &v1.NodeAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution: &v1.NodeSelector{
+				NodeSelectorTerms: []v1.NodeSelectorTerm{{
+					MatchExpressions: []v1.NodeSelectorRequirement{{Key: label, Operator: v1.NodeSelectorOpExists}},
+				}},
+			},
+		}}
+	}
+
+	assert.NoError(t, validateNodeSelectorLabels(&v1.PodSpec{NodeSelector: map[string]string{"pool": "a"}}, schedulingConfig))
+	assert.NoError(t, validateNodeSelectorLabels(&v1.PodSpec{Affinity: affinity("pool")}, schedulingConfig))
+	assert.Error(t, validateNodeSelectorLabels(&v1.PodSpec{NodeSelector: map[string]string{"dedicated": "a"}}, schedulingConfig))
+	assert.Error(t, validateNodeSelectorLabels(&v1.PodSpec{Affinity: affinity("dedicated")}, schedulingConfig))
+}
+
+func Test_ValidatePodSpec_tolerations(t *testing.T) {
+	schedulingConfig := &configuration.SchedulingConfig{
+		ForbiddenJobTaints: []v1.Taint{{Key: "pool", Value: "team-b", Effect: v1.TaintEffectNoSchedule}},
+	}
+	podSpec := func(toleration v1.Toleration) *v1.PodSpec {
+		return &v1.PodSpec{Tolerations: []v1.Toleration{toleration}}
+	}
+
+	assert.NoError(t, validateTolerations(podSpec(v1.Toleration{Key: "pool", Operator: v1.TolerationOpEqual, Value: "team-a"}), schedulingConfig))
+	assert.NoError(t, validateTolerations(podSpec(v1.Toleration{Key: "pool", Value: "team-b", Effect: v1.TaintEffectNoExecute}), schedulingConfig))
+	assert.Error(t, validateTolerations(podSpec(v1.Toleration{Key: "pool", Operator: v1.TolerationOpEqual, Value: "team-b"}), schedulingConfig))
+	assert.Error(t, validateTolerations(podSpec(v1.Toleration{Key: "pool", Operator: v1.TolerationOpExists}), schedulingConfig))
+	assert.Error(t, validateTolerations(podSpec(v1.Toleration{Operator: v1.TolerationOpExists}), schedulingConfig))
+	assert.NoError(t, validateTolerations(podSpec(v1.Toleration{Operator: v1.TolerationOpExists}), &configuration.SchedulingConfig{}))
+}
+
 func Test_ValidatePodSpec_checkForPortConfiguration(t *testing.T) {
 	schedulingConfig := &configuration.SchedulingConfig{
 		MinJobResources:     v1.ResourceList{},
@@ -311,3 +371,58 @@ func Test_ValidatePodSpecPriorityClass(t *testing.T) {
 	)
 	validateInvalidArgumentErrorMessage(t, err, "Specified Priority Class is not supported in Server config")
 }
+
+func Test_ValidatePodSpecPriorityClass_ResourceLimits(t *testing.T) {
+	podSpec := minimalValidPodSpec()
+	podSpec.Containers[0].Resources.Requests["nvidia.com/gpu"] = resource.MustParse("1")
+	podSpec.PriorityClassName = "cpu-only"
+
+	tests := map[string]struct {
+		priorityClass types.PriorityClass
+		expectError   bool
+	}{
+		"no limits": {
+			priorityClass: types.PriorityClass{Priority: 10},
+		},
+		"limited": {
+			priorityClass: types.PriorityClass{Priority: 10, MaximumResourceFractionPerQueue: map[string]float64{"nvidia.com/gpu": 0.1}},
+		},
+		"forbidden": {
+			priorityClass: types.PriorityClass{Priority: 10, MaximumResourceFractionPerQueue: map[string]float64{"nvidia.com/gpu": 0}},
+			expectError:   true,
+		},
+		"forbidden in some pools": {
+			priorityClass: types.PriorityClass{
+				Priority:                              10,
+				MaximumResourceFractionPerQueue:       map[string]float64{"nvidia.com/gpu": 0},
+				MaximumResourceFractionPerQueueByPool: map[string]map[string]float64{"gpu": {"nvidia.com/gpu": 0.5}},
+			},
+		},
+		"not limited in some pools": {
+			priorityClass: types.PriorityClass{
+				Priority:                              10,
+				MaximumResourceFractionPerQueue:       map[string]float64{"nvidia.com/gpu": 0},
+				MaximumResourceFractionPerQueueByPool: map[string]map[string]float64{"gpu": {"cpu": 0.5}},
+			},
+		},
+		"forbidden in all pools": {
+			priorityClass: types.PriorityClass{
+				Priority:                              10,
+				MaximumResourceFractionPerQueue:       map[string]float64{"nvidia.com/gpu": 0},
+				MaximumResourceFractionPerQueueByPool: map[string]map[string]float64{"gpu": {"nvidia.com/gpu": 0}},
+			},
+			expectError: true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := validatePodSpecPriorityClass(podSpec, true, map[string]types.PriorityClass{"cpu-only": tc.priorityClass})
+			if tc.expectError {
+				assert.Error(t, err)
+				validateInvalidArgumentErrorMessage(t, err, "Specified Priority Class doesn't allow any nvidia.com/gpu per queue in any pool")
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
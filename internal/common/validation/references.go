@@ -0,0 +1,132 @@
+package validation
+
+import (
+	"fmt"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+type ReferenceKind string
+
+const (
+	SecretReference    ReferenceKind = "secret"
+	ConfigMapReference ReferenceKind = "configMap"
+)
+
+// Reference is a reference of a pod spec to a secret or config map in the namespace of the pod.
+type Reference struct {
+	Kind ReferenceKind
+	Name string
+	// Path of the field of the pod spec holding the reference, e.g., "containers[0].env[1]".
+	Field string
+	// Pods start even if optional references don't exist.
+	Optional bool
+}
+
+func (r Reference) String() string {
+	return fmt.Sprintf("%s %s referenced by %s", r.Kind, r.Name, r.Field)
+}
+
+// SecretAndConfigMapReferences returns the references of spec to secrets and config maps by its volumes
+// and the environment of its containers, without which pods created from spec fail to start.
+// Image pull secrets aren't included, since pods start pulling images without them.
+func SecretAndConfigMapReferences(spec *v1.PodSpec) []Reference {
+	var references []Reference
+	for i, volume := range spec.Volumes {
+		field := fmt.Sprintf("volumes[%d]", i)
+		if secret := volume.Secret; secret != nil {
+			references = append(references, Reference{
+				Kind:     SecretReference,
+				Name:     secret.SecretName,
+				Field:    field + ".secret",
+				Optional: isOptional(secret.Optional),
+			})
+		}
+		if configMap := volume.ConfigMap; configMap != nil {
+			references = append(references, Reference{
+				Kind:     ConfigMapReference,
+				Name:     configMap.Name,
+				Field:    field + ".configMap",
+				Optional: isOptional(configMap.Optional),
+			})
+		}
+		if projected := volume.Projected; projected != nil {
+			for j, source := range projected.Sources {
+				sourceField := fmt.Sprintf("%s.projected.sources[%d]", field, j)
+				if secret := source.Secret; secret != nil {
+					references = append(references, Reference{
+						Kind:     SecretReference,
+						Name:     secret.Name,
+						Field:    sourceField + ".secret",
+						Optional: isOptional(secret.Optional),
+					})
+				}
+				if configMap := source.ConfigMap; configMap != nil {
+					references = append(references, Reference{
+						Kind:     ConfigMapReference,
+						Name:     configMap.Name,
+						Field:    sourceField + ".configMap",
+						Optional: isOptional(configMap.Optional),
+					})
+				}
+			}
+		}
+	}
+	for i := range spec.InitContainers {
+		references = append(references, containerReferences(fmt.Sprintf("initContainers[%d]", i), &spec.InitContainers[i])...)
+	}
+	for i := range spec.Containers {
+		references = append(references, containerReferences(fmt.Sprintf("containers[%d]", i), &spec.Containers[i])...)
+	}
+	return references
+}
+
+func containerReferences(field string, container *v1.Container) []Reference {
+	var references []Reference
+	for i, envFrom := range container.EnvFrom {
+		envFromField := fmt.Sprintf("%s.envFrom[%d]", field, i)
+		if secret := envFrom.SecretRef; secret != nil {
+			references = append(references, Reference{
+				Kind:     SecretReference,
+				Name:     secret.Name,
+				Field:    envFromField + ".secretRef",
+				Optional: isOptional(secret.Optional),
+			})
+		}
+		if configMap := envFrom.ConfigMapRef; configMap != nil {
+			references = append(references, Reference{
+				Kind:     ConfigMapReference,
+				Name:     configMap.Name,
+				Field:    envFromField + ".configMapRef",
+				Optional: isOptional(configMap.Optional),
+			})
+		}
+	}
+	for i, env := range container.Env {
+		if env.ValueFrom == nil {
+			continue
+		}
+		envField := fmt.Sprintf("%s.env[%d].valueFrom", field, i)
+		if secret := env.ValueFrom.SecretKeyRef; secret != nil {
+			references = append(references, Reference{
+				Kind:     SecretReference,
+				Name:     secret.Name,
+				Field:    envField + ".secretKeyRef",
+				Optional: isOptional(secret.Optional),
+			})
+		}
+		if configMap := env.ValueFrom.ConfigMapKeyRef; configMap != nil {
+			references = append(references, Reference{
+				Kind:     ConfigMapReference,
+				Name:     configMap.Name,
+				Field:    envField + ".configMapKeyRef",
+				Optional: isOptional(configMap.Optional),
+			})
+		}
+	}
+	return references
+}
+
+func isOptional(optional *bool) bool {
+	return optional != nil && *optional
+}
@@ -0,0 +1,57 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/utils/pointer"
+)
+
+func TestSecretAndConfigMapReferences(t *testing.T) {
+	spec := &v1.PodSpec{
+		Volumes: []v1.Volume{
+			{Name: "empty", VolumeSource: v1.VolumeSource{EmptyDir: &v1.EmptyDirVolumeSource{}}},
+			{Name: "credentials", VolumeSource: v1.VolumeSource{Secret: &v1.SecretVolumeSource{SecretName: "credentials"}}},
+			{Name: "settings", VolumeSource: v1.VolumeSource{ConfigMap: &v1.ConfigMapVolumeSource{
+				LocalObjectReference: v1.LocalObjectReference{Name: "settings"},
+				Optional:             pointer.Bool(true),
+			}}},
+			{Name: "projected", VolumeSource: v1.VolumeSource{Projected: &v1.ProjectedVolumeSource{Sources: []v1.VolumeProjection{
+				{Secret: &v1.SecretProjection{LocalObjectReference: v1.LocalObjectReference{Name: "tls"}}},
+				{ConfigMap: &v1.ConfigMapProjection{LocalObjectReference: v1.LocalObjectReference{Name: "ca"}}},
+			}}}},
+		},
+		InitContainers: []v1.Container{{
+			EnvFrom: []v1.EnvFromSource{{ConfigMapRef: &v1.ConfigMapEnvSource{LocalObjectReference: v1.LocalObjectReference{Name: "init"}}}},
+		}},
+		Containers: []v1.Container{{
+			EnvFrom: []v1.EnvFromSource{{SecretRef: &v1.SecretEnvSource{LocalObjectReference: v1.LocalObjectReference{Name: "env"}}}},
+			Env: []v1.EnvVar{
+				{Name: "PLAIN", Value: "value"},
+				{Name: "TOKEN", ValueFrom: &v1.EnvVarSource{SecretKeyRef: &v1.SecretKeySelector{
+					LocalObjectReference: v1.LocalObjectReference{Name: "token"},
+					Key:                  "token",
+				}}},
+				{Name: "MODE", ValueFrom: &v1.EnvVarSource{ConfigMapKeyRef: &v1.ConfigMapKeySelector{
+					LocalObjectReference: v1.LocalObjectReference{Name: "mode"},
+					Key:                  "mode",
+					Optional:             pointer.Bool(false),
+				}}},
+			},
+		}},
+		ImagePullSecrets: []v1.LocalObjectReference{{Name: "registry"}},
+	}
+
+	assert.Equal(t, []Reference{
+		{Kind: SecretReference, Name: "credentials", Field: "volumes[1].secret"},
+		{Kind: ConfigMapReference, Name: "settings", Field: "volumes[2].configMap", Optional: true},
+		{Kind: SecretReference, Name: "tls", Field: "volumes[3].projected.sources[0].secret"},
+		{Kind: ConfigMapReference, Name: "ca", Field: "volumes[3].projected.sources[1].configMap"},
+		{Kind: ConfigMapReference, Name: "init", Field: "initContainers[0].envFrom[0].configMapRef"},
+		{Kind: SecretReference, Name: "env", Field: "containers[0].envFrom[0].secretRef"},
+		{Kind: SecretReference, Name: "token", Field: "containers[0].env[1].valueFrom.secretKeyRef"},
+		{Kind: ConfigMapReference, Name: "mode", Field: "containers[0].env[2].valueFrom.configMapKeyRef"},
+	}, SecretAndConfigMapReferences(spec))
+	assert.Empty(t, SecretAndConfigMapReferences(&v1.PodSpec{Containers: []v1.Container{{}}}))
+}
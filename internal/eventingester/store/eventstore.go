@@ -12,11 +12,14 @@ import (
 	"github.com/armadaproject/armada/internal/common/ingest"
 	"github.com/armadaproject/armada/internal/eventingester/configuration"
 	"github.com/armadaproject/armada/internal/eventingester/model"
+	"github.com/armadaproject/armada/pkg/api"
 )
 
 const (
 	eventStreamPrefix = "Events:"
 	dataKey           = "message"
+	// Version of the event schema the events of an entry were stored with.
+	schemaVersionKey = "schemaVersion"
 )
 
 type RedisEventStore struct {
@@ -93,7 +96,8 @@ func (repo *RedisEventStore) doStore(update []*model.Event) error {
 			pipe.XAdd(&redis.XAddArgs{
 				Stream: e.key,
 				Values: map[string]interface{}{
-					dataKey: e.data,
+					dataKey:          e.data,
+					schemaVersionKey: api.EventSchemaVersion,
 				},
 			})
 		}
@@ -15,6 +15,7 @@ import (
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/keepalive"
+	"k8s.io/client-go/rest"
 
 	"github.com/armadaproject/armada/internal/common/armadacontext"
 	"github.com/armadaproject/armada/internal/common/cluster"
@@ -34,9 +35,11 @@ import (
 	"github.com/armadaproject/armada/internal/executor/podchecks"
 	"github.com/armadaproject/armada/internal/executor/reporter"
 	"github.com/armadaproject/armada/internal/executor/service"
+	"github.com/armadaproject/armada/internal/executor/session"
 	"github.com/armadaproject/armada/internal/executor/utilisation"
 	"github.com/armadaproject/armada/pkg/api"
 	"github.com/armadaproject/armada/pkg/client"
+	executorauth "github.com/armadaproject/armada/pkg/client/auth/executor"
 	"github.com/armadaproject/armada/pkg/executorapi"
 )
 
@@ -114,7 +117,41 @@ func StartUp(ctx *armadacontext.Context, log *logrus.Entry, config configuration
 	taskManager := task.NewBackgroundTaskManager(metrics.ArmadaExecutorMetricsPrefix)
 	taskManager.Register(clusterContext.ProcessPodsToDelete, config.Task.PodDeletionInterval, "pod_deletion")
 
-	return StartUpWithContext(log, config, clusterContext, etcdClustersHealthMonitoring, taskManager, wg)
+	stopJobSessionGateway := setupJobSessionGateway(ctx, config, clusterContext, kubernetesClientProvider.ClientConfig())
+	shutdown, wg := StartUpWithContext(log, config, clusterContext, etcdClustersHealthMonitoring, taskManager, wg)
+	return func() {
+		stopJobSessionGateway()
+		shutdown()
+	}, wg
+}
+
+// setupJobSessionGateway starts relaying the job sessions assigned to this cluster, if enabled.
+func setupJobSessionGateway(
+	ctx *armadacontext.Context,
+	config configuration.ExecutorConfiguration,
+	clusterContext executor_context.ClusterContext,
+	restConfig *rest.Config,
+) func() {
+	if !config.JobSessions.Enabled {
+		return func() {}
+	}
+	conn, err := createConnectionToApi(config.JobSessions.GatewayConnection, config.Client.MaxMessageSizeBytes, config.GRPC)
+	if err != nil {
+		log.Errorf("Failed to connect to job session gateway because: %s", err)
+		os.Exit(-1)
+	}
+	gateway := session.NewGateway(
+		clusterContext,
+		api.NewJobSessionsClient(conn),
+		session.NewKubernetesPodStreamer(restConfig),
+		config.JobSessions.ReconnectInterval,
+	)
+	ctx, cancel := armadacontext.WithCancel(ctx)
+	go gateway.Run(ctx)
+	return func() {
+		cancel()
+		conn.Close()
+	}
 }
 
 func StartUpWithContext(
@@ -201,7 +238,8 @@ func setupExecutorApiComponents(
 	eventReporter, stopReporter := reporter.NewJobEventReporter(
 		clusterContext,
 		jobRunState,
-		eventSender)
+		eventSender,
+		config.Kubernetes.TrackedNodeLabels)
 
 	submitter := job.NewSubmitter(
 		clusterContext,
@@ -296,7 +334,8 @@ func setupServerApiComponents(
 	eventReporter, stopReporter := reporter.NewJobEventReporter(
 		clusterContext,
 		nil,
-		eventSender)
+		eventSender,
+		config.Kubernetes.TrackedNodeLabels)
 
 	jobContext := job.NewClusterJobContext(
 		clusterContext,
@@ -321,6 +360,8 @@ func setupServerApiComponents(
 		config.Kubernetes.MinimumJobSize,
 		config.Kubernetes.AvoidNodeLabelsOnRetry,
 		config.Application.JobLeaseRequestTimeout,
+		config.Application.ReportServiceAccounts,
+		config.Application.ReportSecretsAndConfigMaps,
 	)
 
 	submitter := job.NewSubmitter(
@@ -348,6 +389,11 @@ func setupServerApiComponents(
 	taskManager.Register(clusterUtilisationService.ReportClusterUtilisation, config.Task.UtilisationReportingInterval, "utilisation_reporting")
 	taskManager.Register(eventReporter.ReportMissingJobEvents, config.Task.MissingJobEventReconciliationInterval, "event_reconciliation_legacy")
 
+	if credentialDetails := config.ApiConnection.ExecutorCredentialAuth; credentialDetails.TokenFile != "" && credentialDetails.RotateAfter > 0 {
+		rotator := executorauth.NewRotator(api.NewExecutorCredentialsClient(conn), credentialDetails)
+		taskManager.Register(rotator.RotateIfDue, time.Minute, "executor_credential_rotation")
+	}
+
 	if config.Metric.ExposeQueueUsageMetrics && config.Task.UtilisationEventReportingInterval > 0 {
 		podUtilisationReporter := utilisation.NewUtilisationEventReporter(
 			clusterContext,
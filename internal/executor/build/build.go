@@ -0,0 +1,4 @@
+package build
+
+// ReleaseVersion is the version of the executor, set at build time, and reported to the server with each lease request.
+var ReleaseVersion string
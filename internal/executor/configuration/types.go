@@ -22,6 +22,14 @@ type ApplicationConfiguration struct {
 	// MaxLeasedJobs is the maximum jobs the executor should have in Leased state ay any one time (i.e jobs not submitted to kubernetes)
 	// It is largely used to calculate how many new jobs to request from the scheduler
 	MaxLeasedJobs int
+	// If true, the executor reports the service accounts of the cluster to the server,
+	// so that jobs using service accounts that don't exist can be rejected at submission.
+	// Requires permission to list and watch service accounts.
+	ReportServiceAccounts bool
+	// If true, the executor reports the names of the secrets and config maps of the cluster to the server,
+	// so that jobs referencing secrets or config maps that don't exist can be rejected at submission.
+	// Only their metadata is watched, but doing so requires permission to list and watch secrets and config maps.
+	ReportSecretsAndConfigMaps bool
 }
 
 type PodDefaults struct {
@@ -169,6 +177,18 @@ type ExecutorConfiguration struct {
 	Client                ClientConfiguration
 	GRPC                  keepalive.ClientParameters
 
-	Kubernetes KubernetesConfiguration
-	Task       TaskConfiguration
+	Kubernetes  KubernetesConfiguration
+	Task        TaskConfiguration
+	JobSessions JobSessionsConfiguration
+}
+
+// JobSessionsConfiguration configures relaying the exec and port-forward sessions brokered by the server into the pods
+// of this cluster, such that users can debug their jobs without credentials for the cluster.
+type JobSessionsConfiguration struct {
+	// If true, the executor watches for the sessions the server assigns to this cluster.
+	Enabled bool
+	// Connection to the server brokering sessions, i.e., the replica with jobSessions.enabled.
+	GatewayConnection client.ApiConnectionDetails
+	// How long to wait before watching for sessions again after the connection to the server is lost.
+	ReconnectInterval time.Duration
 }
@@ -21,6 +21,9 @@ import (
 	discovery_informer "k8s.io/client-go/informers/discovery/v1"
 	network_informer "k8s.io/client-go/informers/networking/v1"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/metadata"
+	"k8s.io/client-go/metadata/metadatainformer"
+	"k8s.io/client-go/metadata/metadatalister"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/kubelet/pkg/apis/stats/v1alpha1"
 	"k8s.io/utils/pointer"
@@ -55,6 +58,9 @@ type ClusterContext interface {
 	GetServices(pod *v1.Pod) ([]*v1.Service, error)
 	GetIngresses(pod *v1.Pod) ([]*networking.Ingress, error)
 	GetEndpointSlices(namespace string, labelName string, labelValue string) ([]*discovery.EndpointSlice, error)
+	GetServiceAccounts() ([]*v1.ServiceAccount, error)
+	GetSecrets() ([]*metav1.PartialObjectMetadata, error)
+	GetConfigMaps() ([]*metav1.PartialObjectMetadata, error)
 
 	SubmitPod(pod *v1.Pod, owner string, ownerGroups []string) (*v1.Pod, error)
 	SubmitService(service *v1.Service) (*v1.Service, error)
@@ -81,6 +87,9 @@ type KubernetesClusterContext struct {
 	serviceInformer          informer.ServiceInformer
 	ingressInformer          network_informer.IngressInformer
 	endpointSliceInformer    discovery_informer.EndpointSliceInformer
+	serviceAccountInformer   informer.ServiceAccountInformer
+	secretLister             metadatalister.Lister
+	configMapLister          metadatalister.Lister
 	stopper                  chan struct{}
 	kubernetesClient         kubernetes.Interface
 	kubernetesClientProvider cluster.KubernetesClientProvider
@@ -142,6 +151,19 @@ func NewClusterContext(
 	context.serviceInformer.Lister()
 	context.ingressInformer.Lister()
 	context.endpointSliceInformer.Lister()
+	if configuration.ReportServiceAccounts {
+		context.serviceAccountInformer = factory.Core().V1().ServiceAccounts()
+		context.serviceAccountInformer.Lister()
+	}
+	// Only the metadata of secrets and config maps is watched, such that their data is never held by the executor.
+	var metadataFactory metadatainformer.SharedInformerFactory
+	if configuration.ReportSecretsAndConfigMaps {
+		metadataFactory = metadatainformer.NewSharedInformerFactory(metadata.NewForConfigOrDie(kubernetesClientProvider.ClientConfig()), 0)
+		secrets := v1.SchemeGroupVersion.WithResource("secrets")
+		context.secretLister = metadatalister.New(metadataFactory.ForResource(secrets).Informer().GetIndexer(), secrets)
+		configMaps := v1.SchemeGroupVersion.WithResource("configmaps")
+		context.configMapLister = metadatalister.New(metadataFactory.ForResource(configMaps).Informer().GetIndexer(), configMaps)
+	}
 
 	err := context.eventInformer.Informer().AddIndexers(cache.Indexers{podByUIDIndex: indexPodByUID})
 	if err != nil {
@@ -150,6 +172,10 @@ func NewClusterContext(
 
 	factory.Start(context.stopper)
 	factory.WaitForCacheSync(context.stopper)
+	if metadataFactory != nil {
+		metadataFactory.Start(context.stopper)
+		metadataFactory.WaitForCacheSync(context.stopper)
+	}
 
 	return context
 }
@@ -496,6 +522,33 @@ func (c *KubernetesClusterContext) GetEndpointSlices(namespace string, labelName
 	return endpointSlices, nil
 }
 
+// GetServiceAccounts returns all service accounts of the cluster.
+// Returns an error if the executor isn't configured to report service accounts.
+func (c *KubernetesClusterContext) GetServiceAccounts() ([]*v1.ServiceAccount, error) {
+	if c.serviceAccountInformer == nil {
+		return nil, errors.Errorf("service accounts aren't watched since reporting service accounts is disabled")
+	}
+	return c.serviceAccountInformer.Lister().List(labels.Everything())
+}
+
+// GetSecrets returns the metadata of all secrets of the cluster.
+// Returns an error if the executor isn't configured to report secrets and config maps.
+func (c *KubernetesClusterContext) GetSecrets() ([]*metav1.PartialObjectMetadata, error) {
+	if c.secretLister == nil {
+		return nil, errors.Errorf("secrets aren't watched since reporting secrets and config maps is disabled")
+	}
+	return c.secretLister.List(labels.Everything())
+}
+
+// GetConfigMaps returns the metadata of all config maps of the cluster.
+// Returns an error if the executor isn't configured to report secrets and config maps.
+func (c *KubernetesClusterContext) GetConfigMaps() ([]*metav1.PartialObjectMetadata, error) {
+	if c.configMapLister == nil {
+		return nil, errors.Errorf("config maps aren't watched since reporting secrets and config maps is disabled")
+	}
+	return c.configMapLister.List(labels.Everything())
+}
+
 func createPodAssociationSelector(pod *v1.Pod) (*labels.Selector, error) {
 	jobId, jobIdPresent := pod.Labels[domain.JobId]
 	queue, queuePresent := pod.Labels[domain.Queue]
@@ -1,12 +1,12 @@
 package fake
 
 import (
-	"errors"
 	"fmt"
 
 	v1 "k8s.io/api/core/v1"
 	discovery "k8s.io/api/discovery/v1"
 	networking "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/kubelet/pkg/apis/stats/v1alpha1"
 
@@ -17,6 +17,7 @@ import (
 
 type SyncFakeClusterContext struct {
 	Pods                 map[string]*v1.Pod
+	Nodes                []*v1.Node
 	AnnotationsAdded     map[string]map[string]string
 	podEventHandlers     []*cache.ResourceEventHandlerFuncs
 	clusterEventHandlers []*cache.ResourceEventHandlerFuncs
@@ -54,11 +55,16 @@ func (c *SyncFakeClusterContext) GetActiveBatchPods() ([]*v1.Pod, error) {
 }
 
 func (c *SyncFakeClusterContext) GetNodes() ([]*v1.Node, error) {
-	return make([]*v1.Node, 0), nil
+	return append(make([]*v1.Node, 0, len(c.Nodes)), c.Nodes...), nil
 }
 
 func (c *SyncFakeClusterContext) GetNode(nodeName string) (*v1.Node, error) {
-	return nil, errors.New("GetNode Not implemented")
+	for _, node := range c.Nodes {
+		if node.Name == nodeName {
+			return node, nil
+		}
+	}
+	return nil, fmt.Errorf("node %s not found", nodeName)
 }
 
 func (c *SyncFakeClusterContext) GetPodEvents(pod *v1.Pod) ([]*v1.Event, error) {
@@ -89,6 +95,18 @@ func (c *SyncFakeClusterContext) GetEndpointSlices(namespace string, labelName s
 	return nil, fmt.Errorf("EndpointSlices not implemented in SyncFakeClusterContext")
 }
 
+func (c *SyncFakeClusterContext) GetServiceAccounts() ([]*v1.ServiceAccount, error) {
+	return nil, fmt.Errorf("ServiceAccounts not implemented in SyncFakeClusterContext")
+}
+
+func (c *SyncFakeClusterContext) GetSecrets() ([]*metav1.PartialObjectMetadata, error) {
+	return nil, fmt.Errorf("Secrets not implemented in SyncFakeClusterContext")
+}
+
+func (c *SyncFakeClusterContext) GetConfigMaps() ([]*metav1.PartialObjectMetadata, error) {
+	return nil, fmt.Errorf("ConfigMaps not implemented in SyncFakeClusterContext")
+}
+
 func (c *SyncFakeClusterContext) DeleteIngress(ingress *networking.Ingress) error {
 	return fmt.Errorf("Ingresses not implemented in SyncFakeClusterContext")
 }
@@ -16,3 +16,10 @@ const (
 	JobDoneAnnotation        = "reported_done"
 	JobPreemptedAnnotation   = "reported_preempted"
 )
+
+// Annotations of ingresses read by external-dns, which creates DNS records for the hosts of their rules.
+const (
+	ExternalDnsAnnotationPrefix = "external-dns.alpha.kubernetes.io/"
+	ExternalDnsTtl              = ExternalDnsAnnotationPrefix + "ttl"
+	ExternalDnsTarget           = ExternalDnsAnnotationPrefix + "target"
+)
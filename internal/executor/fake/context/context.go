@@ -209,6 +209,18 @@ func (c *FakeClusterContext) GetEndpointSlices(namespace string, labelName strin
 	return nil, fmt.Errorf("EndpointSlices not implemented in SyncFakeClusterContext")
 }
 
+func (c *FakeClusterContext) GetServiceAccounts() ([]*v1.ServiceAccount, error) {
+	return nil, errors.Errorf("ServiceAccounts not implemented in FakeClusterContext")
+}
+
+func (c *FakeClusterContext) GetSecrets() ([]*metav1.PartialObjectMetadata, error) {
+	return nil, errors.Errorf("Secrets not implemented in FakeClusterContext")
+}
+
+func (c *FakeClusterContext) GetConfigMaps() ([]*metav1.PartialObjectMetadata, error) {
+	return nil, errors.Errorf("ConfigMaps not implemented in FakeClusterContext")
+}
+
 func (c *FakeClusterContext) DeleteIngress(ingress *networking.Ingress) error {
 	return errors.Errorf("Ingresses not implemented in FakeClusterContext")
 }
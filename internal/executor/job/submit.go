@@ -3,6 +3,7 @@ package job
 import (
 	"fmt"
 	"regexp"
+	"strings"
 	"sync"
 
 	"github.com/pkg/errors"
@@ -157,6 +158,8 @@ func (submitService *SubmitService) submitPod(job *SubmitJob) (*v1.Pod, error) {
 // applyExecutorSpecificIngressDetails populates the executor specific details on ingresses
 // These objects are mostly created server side however there will be details that are not known until submit time
 // So the executor must fill them in before it creates the objects in kubernetes
+// Only the generated hosts and TLS secret, which end with "." and "-" respectively, are completed by the suffixes of
+// the cluster; hostnames and secrets given by the user are valid Kubernetes names, which never do.
 func (submitService *SubmitService) applyExecutorSpecificIngressDetails(job *SubmitJob) {
 	for _, ingress := range job.Ingresses {
 		ingress.Annotations = util.MergeMaps(
@@ -166,19 +169,28 @@ func (submitService *SubmitService) applyExecutorSpecificIngressDetails(job *Sub
 
 		// We need to use indexing here since Spec.Rules isn't pointers.
 		for i := range ingress.Spec.Rules {
-			ingress.Spec.Rules[i].Host += submitService.podDefaults.Ingress.HostnameSuffix
+			ingress.Spec.Rules[i].Host = submitService.completeHost(ingress.Spec.Rules[i].Host)
 		}
 
 		// We need to use indexing here since Spec.TLS isn't pointers.
 		for i := range ingress.Spec.TLS {
-			ingress.Spec.TLS[i].SecretName += submitService.podDefaults.Ingress.CertNameSuffix
+			if strings.HasSuffix(ingress.Spec.TLS[i].SecretName, "-") {
+				ingress.Spec.TLS[i].SecretName += submitService.podDefaults.Ingress.CertNameSuffix
+			}
 			for j := range ingress.Spec.TLS[i].Hosts {
-				ingress.Spec.TLS[i].Hosts[j] += submitService.podDefaults.Ingress.HostnameSuffix
+				ingress.Spec.TLS[i].Hosts[j] = submitService.completeHost(ingress.Spec.TLS[i].Hosts[j])
 			}
 		}
 	}
 }
 
+func (submitService *SubmitService) completeHost(host string) string {
+	if strings.HasSuffix(host, ".") {
+		return host + submitService.podDefaults.Ingress.HostnameSuffix
+	}
+	return host
+}
+
 func (submitService *SubmitService) isRecoverable(err error) bool {
 	if apiStatus, ok := err.(k8s_errors.APIStatus); ok {
 		status := apiStatus.Status()
@@ -5,6 +5,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
+	networking "k8s.io/api/networking/v1"
 	k8s_errors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -78,6 +79,31 @@ func TestIsRecoverable_ArmadaErrCreateResourceIsRecoverable(t *testing.T) {
 	assert.True(t, recoverable)
 }
 
+func TestApplyExecutorSpecificIngressDetails_CompletesOnlyGeneratedNames(t *testing.T) {
+	clusterContext := context.NewFakeClusterContext(testAppConfig, "kubernetes.io/hostname", []*context.NodeSpec{})
+	submitter := NewSubmitter(clusterContext, &configuration.PodDefaults{
+		Ingress: &configuration.IngressConfiguration{HostnameSuffix: "cluster.example.com", CertNameSuffix: "ingress-tls"},
+	}, 1, []string{})
+
+	ingress := &networking.Ingress{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "namespace"},
+		Spec: networking.IngressSpec{
+			Rules: []networking.IngressRule{{Host: "port-pod.namespace."}, {Host: "app.example.com"}},
+			TLS: []networking.IngressTLS{
+				{SecretName: "namespace-", Hosts: []string{"port-pod.namespace."}},
+				{SecretName: "app-certificate", Hosts: []string{"app.example.com"}},
+			},
+		},
+	}
+	submitter.applyExecutorSpecificIngressDetails(&SubmitJob{Ingresses: []*networking.Ingress{ingress}})
+
+	assert.Equal(t, []networking.IngressRule{{Host: "port-pod.namespace.cluster.example.com"}, {Host: "app.example.com"}}, ingress.Spec.Rules)
+	assert.Equal(t, []networking.IngressTLS{
+		{SecretName: "namespace-ingress-tls", Hosts: []string{"port-pod.namespace.cluster.example.com"}},
+		{SecretName: "app-certificate", Hosts: []string{"app.example.com"}},
+	}, ingress.Spec.TLS)
+}
+
 func newK8sApiError(message string, reason metav1.StatusReason) *k8s_errors.StatusError {
 	return &k8s_errors.StatusError{
 		ErrStatus: metav1.Status{
@@ -12,6 +12,7 @@ import (
 	domain2 "github.com/armadaproject/armada/internal/executor/domain"
 	"github.com/armadaproject/armada/internal/executor/job"
 	"github.com/armadaproject/armada/internal/executor/util"
+	"github.com/armadaproject/armada/pkg/api"
 )
 
 const batchSize = 200
@@ -35,18 +36,26 @@ type JobEventReporter struct {
 	legacyMode       bool
 	jobRunStateStore *job.JobRunStateStore
 	clusterContext   clusterContext.ClusterContext
+	// Labels of nodes included in the running events of pods on them.
+	trackedNodeLabels []string
 }
 
-func NewJobEventReporter(clusterContext clusterContext.ClusterContext, jobRunState *job.JobRunStateStore, eventSender EventSender) (*JobEventReporter, chan bool) {
+func NewJobEventReporter(
+	clusterContext clusterContext.ClusterContext,
+	jobRunState *job.JobRunStateStore,
+	eventSender EventSender,
+	trackedNodeLabels []string,
+) (*JobEventReporter, chan bool) {
 	stop := make(chan bool)
 	reporter := &JobEventReporter{
-		eventSender:      eventSender,
-		clusterContext:   clusterContext,
-		jobRunStateStore: jobRunState,
-		eventBuffer:      make(chan *queuedEvent, 1000000),
-		eventQueued:      map[string]uint8{},
-		eventQueuedMutex: sync.Mutex{},
-		legacyMode:       jobRunState == nil,
+		eventSender:       eventSender,
+		clusterContext:    clusterContext,
+		jobRunStateStore:  jobRunState,
+		eventBuffer:       make(chan *queuedEvent, 1000000),
+		eventQueued:       map[string]uint8{},
+		eventQueuedMutex:  sync.Mutex{},
+		legacyMode:        jobRunState == nil,
+		trackedNodeLabels: trackedNodeLabels,
 	}
 
 	clusterContext.AddPodEventHandler(reporter.podEventHandler())
@@ -188,6 +197,9 @@ func (eventReporter *JobEventReporter) reportCurrentStatus(pod *v1.Pod) {
 		log.Errorf("Failed to report event: %v", err)
 		return
 	}
+	if runningEvent, ok := event.(*api.JobRunningEvent); ok {
+		eventReporter.addPlacement(runningEvent, pod)
+	}
 
 	eventReporter.QueueEvent(EventMessage{Event: event, JobRunId: util.ExtractJobRunId(pod)}, func(err error) {
 		if err != nil {
@@ -209,6 +221,25 @@ func (eventReporter *JobEventReporter) reportCurrentStatus(pod *v1.Pod) {
 	}
 }
 
+// addPlacement adds the pool of the cluster and the tracked labels of the node pod is running on to event.
+func (eventReporter *JobEventReporter) addPlacement(event *api.JobRunningEvent, pod *v1.Pod) {
+	event.Pool = eventReporter.clusterContext.GetClusterPool()
+	if len(eventReporter.trackedNodeLabels) == 0 || pod.Spec.NodeName == "" {
+		return
+	}
+	node, err := eventReporter.clusterContext.GetNode(pod.Spec.NodeName)
+	if err != nil {
+		log.Warnf("Failed to get node %s of pod %s, reporting it running without node labels: %v", pod.Spec.NodeName, pod.Name, err)
+		return
+	}
+	event.NodeLabels = make(map[string]string, len(eventReporter.trackedNodeLabels))
+	for _, key := range eventReporter.trackedNodeLabels {
+		if value, ok := node.Labels[key]; ok {
+			event.NodeLabels[key] = value
+		}
+	}
+}
+
 func (eventReporter *JobEventReporter) QueueEvent(event EventMessage, callback func(error)) {
 	eventReporter.eventQueuedMutex.Lock()
 	defer eventReporter.eventQueuedMutex.Unlock()
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -104,6 +105,27 @@ func TestJobEventReporter_SendsPreemptionEvent(t *testing.T) {
 	}
 }
 
+func TestJobEventReporter_ReportsPlacementOfRunningPods(t *testing.T) {
+	_, executorContext, _, eventSender := setupTest(t, []*v1.Pod{})
+	executorContext.Nodes = []*v1.Node{{
+		ObjectMeta: metav1.ObjectMeta{Name: "node-1", Labels: map[string]string{"zone": "a", "untracked": "b"}},
+	}}
+	pod := createPod(1)
+	pod.Status.Phase = v1.PodRunning
+	executorContext.SimulatePodAddEvent(pod)
+	// Event processing is async, sleep shortly to give it time to process
+	time.Sleep(time.Millisecond * 100)
+
+	require.Equal(t, 1, eventSender.GetNumberOfSendEventCalls())
+	sentMessages := eventSender.GetSentEvents(0)
+	require.Len(t, sentMessages, 1)
+	running, ok := sentMessages[0].Event.(*api.JobRunningEvent)
+	require.True(t, ok)
+	assert.Equal(t, "node-1", running.NodeName)
+	assert.Equal(t, "pool", running.Pool)
+	assert.Equal(t, map[string]string{"zone": "a"}, running.NodeLabels)
+}
+
 func setupTest(t *testing.T, existingPods []*v1.Pod) (EventReporter, *fakecontext.SyncFakeClusterContext, *job.JobRunStateStore, *FakeEventSender) {
 	executorContext := fakecontext.NewSyncFakeClusterContext()
 	for _, pod := range existingPods {
@@ -113,7 +135,7 @@ func setupTest(t *testing.T, existingPods []*v1.Pod) (EventReporter, *fakecontex
 
 	eventSender := NewFakeEventSender()
 	jobRunState := job.NewJobRunStateStore(executorContext)
-	jobEventReporter, _ := NewJobEventReporter(executorContext, jobRunState, eventSender)
+	jobEventReporter, _ := NewJobEventReporter(executorContext, jobRunState, eventSender, []string{"zone"})
 
 	return jobEventReporter, executorContext, jobRunState, eventSender
 }
@@ -21,6 +21,7 @@ import (
 	armadamaps "github.com/armadaproject/armada/internal/common/maps"
 	armadaresource "github.com/armadaproject/armada/internal/common/resource"
 	commonUtil "github.com/armadaproject/armada/internal/common/util"
+	"github.com/armadaproject/armada/internal/executor/build"
 	context2 "github.com/armadaproject/armada/internal/executor/context"
 	"github.com/armadaproject/armada/internal/executor/job"
 	"github.com/armadaproject/armada/internal/executor/util"
@@ -40,11 +41,13 @@ type LeaseService interface {
 }
 
 type JobLeaseService struct {
-	clusterContext         context2.ClusterContext
-	queueClient            api.AggregatedQueueClient
-	minimumJobSize         armadaresource.ComputeResources
-	avoidNodeLabelsOnRetry []string
-	jobLeaseRequestTimeout time.Duration
+	clusterContext             context2.ClusterContext
+	queueClient                api.AggregatedQueueClient
+	minimumJobSize             armadaresource.ComputeResources
+	avoidNodeLabelsOnRetry     []string
+	jobLeaseRequestTimeout     time.Duration
+	reportServiceAccounts      bool
+	reportSecretsAndConfigMaps bool
 }
 
 func NewJobLeaseService(
@@ -53,13 +56,17 @@ func NewJobLeaseService(
 	minimumJobSize armadaresource.ComputeResources,
 	avoidNodeLabelsOnRetry []string,
 	jobLeaseRequestTimeout time.Duration,
+	reportServiceAccounts bool,
+	reportSecretsAndConfigMaps bool,
 ) *JobLeaseService {
 	return &JobLeaseService{
-		clusterContext:         clusterContext,
-		queueClient:            queueClient,
-		minimumJobSize:         minimumJobSize,
-		avoidNodeLabelsOnRetry: avoidNodeLabelsOnRetry,
-		jobLeaseRequestTimeout: jobLeaseRequestTimeout,
+		clusterContext:             clusterContext,
+		queueClient:                queueClient,
+		minimumJobSize:             minimumJobSize,
+		avoidNodeLabelsOnRetry:     avoidNodeLabelsOnRetry,
+		jobLeaseRequestTimeout:     jobLeaseRequestTimeout,
+		reportServiceAccounts:      reportServiceAccounts,
+		reportSecretsAndConfigMaps: reportSecretsAndConfigMaps,
 	}
 }
 
@@ -102,11 +109,72 @@ func (jobLeaseService *JobLeaseService) RequestJobLeases(
 		ClusterLeasedReport: clusterLeasedReport,
 		Nodes:               nodes,
 		MinimumJobSize:      jobLeaseService.minimumJobSize,
+		ExecutorVersion:     build.ReleaseVersion,
+	}
+	if jobLeaseService.reportServiceAccounts {
+		serviceAccounts, err := jobLeaseService.getServiceAccountsByNamespace()
+		if err != nil {
+			return nil, err
+		}
+		leaseRequest.ServiceAccounts = serviceAccounts
+	}
+	if jobLeaseService.reportSecretsAndConfigMaps {
+		secretsAndConfigMaps, err := jobLeaseService.getSecretsAndConfigMapsByNamespace()
+		if err != nil {
+			return nil, err
+		}
+		leaseRequest.SecretsAndConfigMaps = secretsAndConfigMaps
 	}
 
 	return jobLeaseService.requestJobLeases(leaseRequest)
 }
 
+func (jobLeaseService *JobLeaseService) getServiceAccountsByNamespace() (map[string]*api.ServiceAccounts, error) {
+	serviceAccounts, err := jobLeaseService.clusterContext.GetServiceAccounts()
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to get service accounts")
+	}
+	serviceAccountsByNamespace := make(map[string]*api.ServiceAccounts)
+	for _, serviceAccount := range serviceAccounts {
+		namespaceServiceAccounts, ok := serviceAccountsByNamespace[serviceAccount.Namespace]
+		if !ok {
+			namespaceServiceAccounts = &api.ServiceAccounts{}
+			serviceAccountsByNamespace[serviceAccount.Namespace] = namespaceServiceAccounts
+		}
+		namespaceServiceAccounts.Names = append(namespaceServiceAccounts.Names, serviceAccount.Name)
+	}
+	return serviceAccountsByNamespace, nil
+}
+
+func (jobLeaseService *JobLeaseService) getSecretsAndConfigMapsByNamespace() (map[string]*api.SecretsAndConfigMaps, error) {
+	secrets, err := jobLeaseService.clusterContext.GetSecrets()
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to get secrets")
+	}
+	configMaps, err := jobLeaseService.clusterContext.GetConfigMaps()
+	if err != nil {
+		return nil, errors.WithMessage(err, "failed to get config maps")
+	}
+	objectsByNamespace := make(map[string]*api.SecretsAndConfigMaps)
+	getNamespaceObjects := func(namespace string) *api.SecretsAndConfigMaps {
+		namespaceObjects, ok := objectsByNamespace[namespace]
+		if !ok {
+			namespaceObjects = &api.SecretsAndConfigMaps{}
+			objectsByNamespace[namespace] = namespaceObjects
+		}
+		return namespaceObjects
+	}
+	for _, secret := range secrets {
+		namespaceObjects := getNamespaceObjects(secret.Namespace)
+		namespaceObjects.SecretNames = append(namespaceObjects.SecretNames, secret.Name)
+	}
+	for _, configMap := range configMaps {
+		namespaceObjects := getNamespaceObjects(configMap.Namespace)
+		namespaceObjects.ConfigMapNames = append(namespaceObjects.ConfigMapNames, configMap.Name)
+	}
+	return objectsByNamespace, nil
+}
+
 func (jobLeaseService *JobLeaseService) requestJobLeases(leaseRequest *api.StreamingLeaseRequest) ([]*api.Job, error) {
 	// Setup a bidirectional gRPC stream.
 	// The server sends jobs over this stream.
@@ -0,0 +1,224 @@
+package session
+
+import (
+	"io"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/executor/context"
+	"github.com/armadaproject/armada/internal/executor/util"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+// PodStreamer opens streams into the containers and ports of pods.
+type PodStreamer interface {
+	// Exec runs a command in a container of pod, relaying its streams, and returns its exit code once it exits.
+	Exec(ctx *armadacontext.Context, pod *v1.Pod, options *api.JobExecOptions, streams ExecStreams) (int32, error)
+	// PortForward relays data between in and out and a port of pod until either end closes the connection.
+	PortForward(ctx *armadacontext.Context, pod *v1.Pod, port uint32, in io.Reader, out io.Writer) error
+}
+
+type ExecStreams struct {
+	Stdin  io.Reader
+	Stdout io.Writer
+	Stderr io.Writer
+	// Receives the size of the terminal each time it changes, if the command runs in one.
+	Resize <-chan *api.TerminalSize
+}
+
+// Gateway serves the exec and port-forward sessions the server assigns to this cluster, relaying each between the
+// server and a pod, such that users can debug their jobs without credentials for the cluster.
+type Gateway struct {
+	clusterContext    context.ClusterContext
+	client            api.JobSessionsClient
+	streamer          PodStreamer
+	reconnectInterval time.Duration
+}
+
+func NewGateway(
+	clusterContext context.ClusterContext,
+	client api.JobSessionsClient,
+	streamer PodStreamer,
+	reconnectInterval time.Duration,
+) *Gateway {
+	return &Gateway{
+		clusterContext:    clusterContext,
+		client:            client,
+		streamer:          streamer,
+		reconnectInterval: reconnectInterval,
+	}
+}
+
+// Run watches for the sessions assigned to this cluster, serving each in the background, until ctx is cancelled.
+func (g *Gateway) Run(ctx *armadacontext.Context) {
+	for {
+		if err := g.watch(ctx); err != nil {
+			ctx.Warnf("error watching for job sessions: %s", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(g.reconnectInterval):
+		}
+	}
+}
+
+func (g *Gateway) watch(ctx *armadacontext.Context) error {
+	stream, err := g.client.WatchJobSessions(ctx, &api.JobSessionWatchRequest{ClusterId: g.clusterContext.GetClusterId()})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	for {
+		assignment, err := stream.Recv()
+		if ctx.Err() != nil {
+			return nil
+		} else if err != nil {
+			return errors.WithStack(err)
+		}
+		go g.serve(ctx, assignment)
+	}
+}
+
+// serve attaches to a session and relays it to the pod it was opened into, until either end closes it.
+func (g *Gateway) serve(ctx *armadacontext.Context, assignment *api.JobSessionAssignment) {
+	ctx, cancel := armadacontext.WithCancel(ctx)
+	defer cancel()
+	ctx = armadacontext.WithLogField(ctx, "sessionId", assignment.SessionId)
+	stream, err := g.client.ServeJobSession(ctx)
+	if err != nil {
+		ctx.Warnf("error attaching to job session: %s", err)
+		return
+	}
+	if err := stream.Send(&api.JobSessionExecutorMessage{Message: &api.JobSessionExecutorMessage_Attach{
+		Attach: &api.JobSessionAttach{SessionId: assignment.SessionId},
+	}}); err != nil {
+		ctx.Warnf("error attaching to job session: %s", err)
+		return
+	}
+
+	// Frames received from the client are relayed to the pod until the client closes the session.
+	stdin, stdinWriter := io.Pipe()
+	resize := make(chan *api.TerminalSize, 1)
+	received := make(chan struct{})
+	go func() {
+		defer close(received)
+		// The server closes the stream once the client has gone, which ends the stream into the pod.
+		defer cancel()
+		defer close(resize)
+		defer stdinWriter.Close()
+		for {
+			frame, err := stream.Recv()
+			if err != nil {
+				return
+			}
+			switch f := frame.Frame.(type) {
+			case *api.JobSessionFrame_Stdin:
+				if _, err := stdinWriter.Write(f.Stdin); err != nil {
+					return
+				}
+			case *api.JobSessionFrame_StdinClosed:
+				// Frames, e.g., resizes, are still relayed until the client closes the session.
+				_ = stdinWriter.Close()
+			case *api.JobSessionFrame_Resize:
+				select {
+				case resize <- f.Resize:
+				default:
+				}
+			}
+		}
+	}()
+
+	sender := &frameSender{stream: stream}
+	end := &api.JobSessionEnd{}
+	pod, err := g.findPod(assignment)
+	if err == nil {
+		switch session := assignment.Session.(type) {
+		case *api.JobSessionAssignment_Exec:
+			end.ExitCode, err = g.streamer.Exec(ctx, pod, session.Exec, ExecStreams{
+				Stdin:  stdin,
+				Stdout: sender.writer(stdoutFrame),
+				Stderr: sender.writer(stderrFrame),
+				Resize: resize,
+			})
+		case *api.JobSessionAssignment_PortForward:
+			err = g.streamer.PortForward(ctx, pod, session.PortForward.Port, stdin, sender.writer(stdoutFrame))
+		default:
+			err = errors.Errorf("session %s has neither exec nor port-forward options", assignment.SessionId)
+		}
+	}
+	// Unblocks relaying frames received after the pod closed the session.
+	stdin.Close()
+	if err != nil {
+		end.Error = err.Error()
+	}
+	if err := sender.send(&api.JobSessionFrame{Frame: &api.JobSessionFrame_End{End: end}}); err != nil {
+		ctx.Warnf("error ending job session: %s", err)
+		return
+	}
+	if err := stream.CloseSend(); err != nil {
+		return
+	}
+	// The server closes the stream once the end of the session has been relayed to the client.
+	<-received
+}
+
+// findPod returns the running pod a session was opened into.
+func (g *Gateway) findPod(assignment *api.JobSessionAssignment) (*v1.Pod, error) {
+	pods, err := g.clusterContext.GetActiveBatchPods()
+	if err != nil {
+		return nil, err
+	}
+	for _, pod := range pods {
+		if util.ExtractJobId(pod) != assignment.JobId || util.ExtractPodNumber(pod) != int(assignment.PodNumber) {
+			continue
+		}
+		if string(pod.UID) != assignment.KubernetesId {
+			return nil, errors.Errorf("pod %d of job %s has been replaced by another run", assignment.PodNumber, assignment.JobId)
+		}
+		if pod.Status.Phase != v1.PodRunning {
+			return nil, errors.Errorf("pod %d of job %s is %s", assignment.PodNumber, assignment.JobId, pod.Status.Phase)
+		}
+		return pod, nil
+	}
+	return nil, errors.Errorf("pod %d of job %s doesn't exist on cluster %s", assignment.PodNumber, assignment.JobId, g.clusterContext.GetClusterId())
+}
+
+// frameSender serializes sending frames from concurrently written streams.
+type frameSender struct {
+	stream api.JobSessions_ServeJobSessionClient
+	mu     sync.Mutex
+}
+
+func (s *frameSender) send(frame *api.JobSessionFrame) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.stream.Send(&api.JobSessionExecutorMessage{Message: &api.JobSessionExecutorMessage_Frame{Frame: frame}})
+}
+
+// writer returns a writer sending the data written to it in the frames returned by toFrame.
+func (s *frameSender) writer(toFrame func(data []byte) *api.JobSessionFrame) io.Writer {
+	return frameWriter(func(data []byte) (int, error) {
+		if err := s.send(toFrame(data)); err != nil {
+			return 0, err
+		}
+		return len(data), nil
+	})
+}
+
+type frameWriter func(data []byte) (int, error)
+
+func (w frameWriter) Write(data []byte) (int, error) {
+	return w(data)
+}
+
+func stdoutFrame(data []byte) *api.JobSessionFrame {
+	return &api.JobSessionFrame{Frame: &api.JobSessionFrame_Stdout{Stdout: data}}
+}
+
+func stderrFrame(data []byte) *api.JobSessionFrame {
+	return &api.JobSessionFrame{Frame: &api.JobSessionFrame_Stderr{Stderr: data}}
+}
@@ -0,0 +1,206 @@
+package session
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	fakecontext "github.com/armadaproject/armada/internal/executor/context/fake"
+	"github.com/armadaproject/armada/internal/executor/domain"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+func TestGateway_RelaysExecSessions(t *testing.T) {
+	client, streamer := runTestGateway(t)
+	exec := &api.JobExecOptions{Command: []string{"sh"}, Tty: true}
+	client.assignments <- &api.JobSessionAssignment{
+		SessionId: "session-1", JobId: "job-1", KubernetesId: "run-1", Session: &api.JobSessionAssignment_Exec{Exec: exec},
+	}
+
+	session := <-client.sessions
+	assert.Equal(t, "session-1", (<-session.sent).GetAttach().SessionId)
+	session.recv <- &api.JobSessionFrame{Frame: &api.JobSessionFrame_Resize{Resize: &api.TerminalSize{Width: 80, Height: 24}}}
+	session.recv <- &api.JobSessionFrame{Frame: &api.JobSessionFrame_Stdin{Stdin: []byte("hello\n")}}
+	assert.Equal(t, stdoutFrame([]byte("hello\n")), (<-session.sent).GetFrame())
+	assert.Equal(t, stderrFrame([]byte("80x24")), (<-session.sent).GetFrame())
+	assert.Equal(t, &api.JobSessionEnd{ExitCode: 3}, (<-session.sent).GetFrame().GetEnd())
+	close(session.recv)
+	assert.Equal(t, exec, <-streamer.execs)
+}
+
+func TestGateway_RelaysPortForwardSessions(t *testing.T) {
+	client, _ := runTestGateway(t)
+	client.assignments <- &api.JobSessionAssignment{
+		SessionId:    "session-1",
+		JobId:        "job-1",
+		KubernetesId: "run-1",
+		Session:      &api.JobSessionAssignment_PortForward{PortForward: &api.JobPortForwardOptions{Port: 8080}},
+	}
+
+	session := <-client.sessions
+	<-session.sent
+	session.recv <- &api.JobSessionFrame{Frame: &api.JobSessionFrame_Stdin{Stdin: []byte("GET /\n")}}
+	assert.Equal(t, stdoutFrame([]byte("8080: GET /\n")), (<-session.sent).GetFrame())
+	assert.Equal(t, &api.JobSessionEnd{}, (<-session.sent).GetFrame().GetEnd())
+	close(session.recv)
+}
+
+func TestGateway_ClosesStdinOfSessions(t *testing.T) {
+	client, _ := runTestGateway(t)
+	client.assignments <- &api.JobSessionAssignment{
+		SessionId:    "session-1",
+		JobId:        "job-1",
+		KubernetesId: "run-1",
+		Session:      &api.JobSessionAssignment_PortForward{PortForward: &api.JobPortForwardOptions{Port: 8080}},
+	}
+
+	session := <-client.sessions
+	<-session.sent
+	session.recv <- &api.JobSessionFrame{Frame: &api.JobSessionFrame_Stdin{Stdin: []byte("GET /")}}
+	session.recv <- &api.JobSessionFrame{Frame: &api.JobSessionFrame_StdinClosed{StdinClosed: true}}
+	// Without a trailing newline, the fake pod reads stdin until it's closed.
+	assert.Equal(t, "EOF", (<-session.sent).GetFrame().GetEnd().Error)
+	close(session.recv)
+}
+
+func TestGateway_EndsSessionsIntoMissingPods(t *testing.T) {
+	client, _ := runTestGateway(t)
+	exec := &api.JobSessionAssignment_Exec{Exec: &api.JobExecOptions{Command: []string{"sh"}}}
+	tests := map[string]*api.JobSessionAssignment{
+		"pod 0 of job job-1 has been replaced by another run":      {JobId: "job-1", KubernetesId: "run-0", Session: exec},
+		"pod 0 of job job-2 doesn't exist on cluster cluster-id-1": {JobId: "job-2", KubernetesId: "run-2", Session: exec},
+	}
+	for expected, assignment := range tests {
+		client.assignments <- assignment
+		session := <-client.sessions
+		<-session.sent
+		assert.Equal(t, expected, (<-session.sent).GetFrame().GetEnd().Error)
+		close(session.recv)
+	}
+}
+
+func runTestGateway(t *testing.T) (*fakeJobSessionsClient, *fakePodStreamer) {
+	clusterContext := fakecontext.NewSyncFakeClusterContext()
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "armada-job-1-0",
+			UID:    "run-1",
+			Labels: map[string]string{domain.JobId: "job-1", domain.PodNumber: "0"},
+		},
+		Status: v1.PodStatus{Phase: v1.PodRunning},
+	}
+	_, err := clusterContext.SubmitPod(pod, "user", nil)
+	require.NoError(t, err)
+
+	client := &fakeJobSessionsClient{
+		assignments: make(chan *api.JobSessionAssignment),
+		sessions:    make(chan *fakeServeJobSessionClient, 2),
+	}
+	streamer := &fakePodStreamer{execs: make(chan *api.JobExecOptions, 1)}
+	ctx, cancel := armadacontext.WithCancel(armadacontext.Background())
+	t.Cleanup(cancel)
+	go NewGateway(clusterContext, client, streamer, time.Millisecond).Run(ctx)
+	return client, streamer
+}
+
+// fakePodStreamer echoes the first line of stdin to stdout.
+type fakePodStreamer struct {
+	execs chan *api.JobExecOptions
+}
+
+func (s *fakePodStreamer) Exec(_ *armadacontext.Context, _ *v1.Pod, options *api.JobExecOptions, streams ExecStreams) (int32, error) {
+	line, err := bufio.NewReader(streams.Stdin).ReadString('\n')
+	if err != nil {
+		return 0, err
+	}
+	if _, err := streams.Stdout.Write([]byte(line)); err != nil {
+		return 0, err
+	}
+	size := <-streams.Resize
+	if _, err := fmt.Fprintf(streams.Stderr, "%dx%d", size.Width, size.Height); err != nil {
+		return 0, err
+	}
+	s.execs <- options
+	return 3, nil
+}
+
+func (s *fakePodStreamer) PortForward(_ *armadacontext.Context, _ *v1.Pod, port uint32, in io.Reader, out io.Writer) error {
+	line, err := bufio.NewReader(in).ReadString('\n')
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(out, "%d: %s", port, line)
+	return err
+}
+
+type fakeJobSessionsClient struct {
+	assignments chan *api.JobSessionAssignment
+	sessions    chan *fakeServeJobSessionClient
+}
+
+func (c *fakeJobSessionsClient) OpenJobSession(context.Context, ...grpc.CallOption) (api.JobSessions_OpenJobSessionClient, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (c *fakeJobSessionsClient) WatchJobSessions(ctx context.Context, _ *api.JobSessionWatchRequest, _ ...grpc.CallOption) (api.JobSessions_WatchJobSessionsClient, error) {
+	return &fakeWatchJobSessionsClient{ctx: ctx, assignments: c.assignments}, nil
+}
+
+func (c *fakeJobSessionsClient) ServeJobSession(context.Context, ...grpc.CallOption) (api.JobSessions_ServeJobSessionClient, error) {
+	session := &fakeServeJobSessionClient{
+		sent: make(chan *api.JobSessionExecutorMessage, 4),
+		recv: make(chan *api.JobSessionFrame, 2),
+	}
+	c.sessions <- session
+	return session, nil
+}
+
+type fakeWatchJobSessionsClient struct {
+	grpc.ClientStream
+	ctx         context.Context
+	assignments chan *api.JobSessionAssignment
+}
+
+func (c *fakeWatchJobSessionsClient) Recv() (*api.JobSessionAssignment, error) {
+	select {
+	case assignment := <-c.assignments:
+		return assignment, nil
+	case <-c.ctx.Done():
+		return nil, c.ctx.Err()
+	}
+}
+
+// fakeServeJobSessionClient returns the frames sent on recv until it's closed.
+type fakeServeJobSessionClient struct {
+	grpc.ClientStream
+	sent chan *api.JobSessionExecutorMessage
+	recv chan *api.JobSessionFrame
+}
+
+func (c *fakeServeJobSessionClient) Send(msg *api.JobSessionExecutorMessage) error {
+	c.sent <- msg
+	return nil
+}
+
+func (c *fakeServeJobSessionClient) Recv() (*api.JobSessionFrame, error) {
+	frame, ok := <-c.recv
+	if !ok {
+		return nil, io.EOF
+	}
+	return frame, nil
+}
+
+func (c *fakeServeJobSessionClient) CloseSend() error {
+	return nil
+}
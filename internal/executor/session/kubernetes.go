@@ -0,0 +1,274 @@
+package session
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/net/websocket"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/remotecommand"
+	"k8s.io/client-go/rest"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+// Version 4 of the Kubernetes channel protocol, in which each websocket message is prefixed by the channel it's for,
+// and the status of exec'd commands is returned on the error channel.
+const channelProtocol = "v4.channel.k8s.io"
+
+// Channels of exec streams.
+const (
+	stdinChannel byte = iota
+	stdoutChannel
+	stderrChannel
+	errorChannel
+	resizeChannel
+)
+
+// Channels of port-forward streams of a single port.
+const (
+	portDataChannel byte = iota
+	portErrorChannel
+)
+
+const dialTimeout = 30 * time.Second
+
+// KubernetesPodStreamer opens streams into pods via the exec and port-forward subresources of the Kubernetes API,
+// using the websocket variant of the Kubernetes channel protocol.
+type KubernetesPodStreamer struct {
+	config *rest.Config
+}
+
+func NewKubernetesPodStreamer(config *rest.Config) *KubernetesPodStreamer {
+	return &KubernetesPodStreamer{config: config}
+}
+
+func (s *KubernetesPodStreamer) Exec(ctx *armadacontext.Context, pod *v1.Pod, options *api.JobExecOptions, streams ExecStreams) (int32, error) {
+	query := url.Values{}
+	if options.Container != "" {
+		query.Set("container", options.Container)
+	}
+	for _, arg := range options.Command {
+		query.Add("command", arg)
+	}
+	query.Set("stdin", "true")
+	query.Set("stdout", "true")
+	// Terminals combine stdout and stderr.
+	query.Set("stderr", strconv.FormatBool(!options.Tty))
+	query.Set("tty", strconv.FormatBool(options.Tty))
+	conn, err := s.dial(pod, "exec", query)
+	if err != nil {
+		return 0, err
+	}
+	defer closeWhenDone(ctx, conn)()
+
+	go sendChannel(conn, stdinChannel, streams.Stdin)
+	go func() {
+		for size := range streams.Resize {
+			data, err := json.Marshal(struct{ Width, Height uint16 }{uint16(size.Width), uint16(size.Height)})
+			if err != nil {
+				return
+			}
+			if err := websocket.Message.Send(conn, append([]byte{resizeChannel}, data...)); err != nil {
+				return
+			}
+		}
+	}()
+
+	for {
+		var data []byte
+		if err := websocket.Message.Receive(conn, &data); err != nil {
+			return 0, errors.Wrap(err, "connection closed before the command exited")
+		}
+		if len(data) == 0 {
+			continue
+		}
+		switch data[0] {
+		case stdoutChannel:
+			if _, err := streams.Stdout.Write(data[1:]); err != nil {
+				return 0, err
+			}
+		case stderrChannel:
+			if _, err := streams.Stderr.Write(data[1:]); err != nil {
+				return 0, err
+			}
+		case errorChannel:
+			return exitCodeFromStatus(data[1:])
+		}
+	}
+}
+
+func (s *KubernetesPodStreamer) PortForward(ctx *armadacontext.Context, pod *v1.Pod, port uint32, in io.Reader, out io.Writer) error {
+	query := url.Values{}
+	query.Set("ports", strconv.Itoa(int(port)))
+	conn, err := s.dial(pod, "portforward", query)
+	if err != nil {
+		return err
+	}
+	defer closeWhenDone(ctx, conn)()
+
+	go sendChannel(conn, portDataChannel, in)
+	// The first message of each channel is the port it's for.
+	receivedPort := map[byte]bool{}
+	for {
+		var data []byte
+		if err := websocket.Message.Receive(conn, &data); err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		if len(data) == 0 {
+			continue
+		}
+		channel, payload := data[0], data[1:]
+		if !receivedPort[channel] {
+			if len(payload) < 2 || binary.LittleEndian.Uint16(payload) != uint16(port) {
+				return errors.Errorf("unexpected first message on channel %d of port %d", channel, port)
+			}
+			receivedPort[channel] = true
+			payload = payload[2:]
+		}
+		if len(payload) == 0 {
+			continue
+		}
+		switch channel {
+		case portDataChannel:
+			if _, err := out.Write(payload); err != nil {
+				return err
+			}
+		case portErrorChannel:
+			return errors.Errorf("error forwarding port %d: %s", port, payload)
+		}
+	}
+}
+
+// dial opens a websocket connection to a subresource of pod.
+func (s *KubernetesPodStreamer) dial(pod *v1.Pod, subresource string, query url.Values) (*websocket.Conn, error) {
+	host := s.config.Host
+	if !strings.Contains(host, "://") {
+		host = "https://" + host
+	}
+	location, err := url.Parse(host)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	switch location.Scheme {
+	case "https":
+		location.Scheme = "wss"
+	case "http":
+		location.Scheme = "ws"
+	}
+	location.Path = path.Join(location.Path, "/api/v1/namespaces", pod.Namespace, "pods", pod.Name, subresource)
+	location.RawQuery = query.Encode()
+
+	config, err := websocket.NewConfig(location.String(), "http://localhost")
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	config.Protocol = []string{channelProtocol}
+	config.Dialer = &net.Dialer{Timeout: dialTimeout}
+	if config.TlsConfig, err = rest.TLSConfigFor(s.config); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if config.Header, err = s.authenticationHeader(); err != nil {
+		return nil, err
+	}
+	conn, err := websocket.DialConfig(config)
+	if err != nil {
+		return nil, errors.WithMessagef(err, "error opening %s stream into pod %s/%s", subresource, pod.Namespace, pod.Name)
+	}
+	return conn, nil
+}
+
+// authenticationHeader returns the headers with which the rest config authenticates requests,
+// e.g., bearer tokens, since websocket handshakes can't be sent via its transport.
+func (s *KubernetesPodStreamer) authenticationHeader() (http.Header, error) {
+	recorder := &headerRecorder{}
+	transport, err := rest.HTTPWrappersForConfig(s.config, recorder)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	req, err := http.NewRequest(http.MethodGet, s.config.Host, nil)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	resp.Body.Close()
+	return recorder.header, nil
+}
+
+// headerRecorder records the headers of requests instead of sending them.
+type headerRecorder struct {
+	header http.Header
+}
+
+func (r *headerRecorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	r.header = req.Header.Clone()
+	return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody, Request: req}, nil
+}
+
+// sendChannel sends the data read from r on a channel of conn until either is closed.
+func sendChannel(conn *websocket.Conn, channel byte, r io.Reader) {
+	buf := make([]byte, 32*1024)
+	for {
+		n, err := r.Read(buf)
+		if n > 0 {
+			if err := websocket.Message.Send(conn, append([]byte{channel}, buf[:n]...)); err != nil {
+				return
+			}
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+// closeWhenDone closes conn once ctx is done or the returned function is called.
+func closeWhenDone(ctx *armadacontext.Context, conn *websocket.Conn) func() {
+	stop := make(chan struct{})
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-stop:
+		}
+		conn.Close()
+	}()
+	return func() { close(stop) }
+}
+
+// exitCodeFromStatus returns the exit code of an exec'd command from the status returned on the error channel.
+func exitCodeFromStatus(data []byte) (int32, error) {
+	var status metav1.Status
+	if err := json.Unmarshal(data, &status); err != nil {
+		return 0, errors.Errorf("error parsing status of command: %s", data)
+	}
+	if status.Status == metav1.StatusSuccess {
+		return 0, nil
+	}
+	if status.Reason == remotecommand.NonZeroExitCodeReason && status.Details != nil {
+		for _, cause := range status.Details.Causes {
+			if cause.Type == remotecommand.ExitCodeCauseType {
+				exitCode, err := strconv.Atoi(cause.Message)
+				if err != nil {
+					return 0, errors.Errorf("error parsing exit code %s of command", cause.Message)
+				}
+				return int32(exitCode), nil
+			}
+		}
+	}
+	return 0, errors.New(status.Message)
+}
@@ -0,0 +1,149 @@
+package session
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/websocket"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/remotecommand"
+	"k8s.io/client-go/rest"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+var testPod = &v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "namespace", Name: "pod"}}
+
+func TestKubernetesPodStreamer_Exec(t *testing.T) {
+	requests := make(chan *http.Request, 1)
+	server := httptest.NewServer(websocket.Server{
+		Handshake: func(_ *websocket.Config, req *http.Request) error {
+			requests <- req
+			return nil
+		},
+		Handler: func(conn *websocket.Conn) {
+			// Echoes stdin and the size of the terminal, and exits with code 2.
+			for i := 0; i < 2; i++ {
+				var data []byte
+				if err := websocket.Message.Receive(conn, &data); err != nil {
+					return
+				}
+				switch data[0] {
+				case stdinChannel:
+					_ = websocket.Message.Send(conn, append([]byte{stdoutChannel}, data[1:]...))
+				case resizeChannel:
+					_ = websocket.Message.Send(conn, append([]byte{stderrChannel}, data[1:]...))
+				}
+			}
+			status, _ := json.Marshal(nonZeroExitCodeStatus("2"))
+			_ = websocket.Message.Send(conn, append([]byte{errorChannel}, status...))
+		},
+	})
+	defer server.Close()
+
+	resize := make(chan *api.TerminalSize, 1)
+	resize <- &api.TerminalSize{Width: 80, Height: 24}
+	close(resize)
+	var stdout, stderr bytes.Buffer
+	streamer := NewKubernetesPodStreamer(&rest.Config{Host: server.URL, BearerToken: "token"})
+	exitCode, err := streamer.Exec(
+		armadacontext.Background(),
+		testPod,
+		&api.JobExecOptions{Container: "main", Command: []string{"sh", "-c", "exit 2"}},
+		ExecStreams{Stdin: strings.NewReader("hello"), Stdout: &stdout, Stderr: &stderr, Resize: resize},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), exitCode)
+	assert.Equal(t, "hello", stdout.String())
+	assert.Equal(t, `{"Width":80,"Height":24}`, stderr.String())
+
+	req := <-requests
+	assert.Equal(t, "/api/v1/namespaces/namespace/pods/pod/exec", req.URL.Path)
+	assert.Equal(t, []string{"sh", "-c", "exit 2"}, req.URL.Query()["command"])
+	assert.Equal(t, "main", req.URL.Query().Get("container"))
+	assert.Equal(t, "false", req.URL.Query().Get("tty"))
+	assert.Equal(t, "Bearer token", req.Header.Get("Authorization"))
+	assert.Equal(t, channelProtocol, req.Header.Get("Sec-WebSocket-Protocol"))
+}
+
+func TestKubernetesPodStreamer_PortForward(t *testing.T) {
+	requests := make(chan *http.Request, 1)
+	server := httptest.NewServer(websocket.Server{
+		Handshake: func(_ *websocket.Config, req *http.Request) error {
+			requests <- req
+			return nil
+		},
+		Handler: func(conn *websocket.Conn) {
+			// Announces the port on both channels, then replies to a single message and closes the connection.
+			_ = websocket.Message.Send(conn, []byte{portDataChannel, 0x90, 0x1f})
+			_ = websocket.Message.Send(conn, []byte{portErrorChannel, 0x90, 0x1f})
+			var data []byte
+			if err := websocket.Message.Receive(conn, &data); err != nil {
+				return
+			}
+			_ = websocket.Message.Send(conn, append(data, []byte(" pong")...))
+		},
+	})
+	defer server.Close()
+
+	var out bytes.Buffer
+	streamer := NewKubernetesPodStreamer(&rest.Config{Host: server.URL})
+	err := streamer.PortForward(armadacontext.Background(), testPod, 8080, strings.NewReader("ping"), &out)
+	require.NoError(t, err)
+	assert.Equal(t, "ping pong", out.String())
+
+	req := <-requests
+	assert.Equal(t, "/api/v1/namespaces/namespace/pods/pod/portforward", req.URL.Path)
+	assert.Equal(t, "8080", req.URL.Query().Get("ports"))
+}
+
+func TestExitCodeFromStatus(t *testing.T) {
+	tests := map[string]struct {
+		status   metav1.Status
+		exitCode int32
+		err      string
+	}{
+		"success": {
+			status: metav1.Status{Status: metav1.StatusSuccess},
+		},
+		"non-zero exit code": {
+			status:   nonZeroExitCodeStatus("137"),
+			exitCode: 137,
+		},
+		"failure": {
+			status: metav1.Status{Status: metav1.StatusFailure, Message: "container not found"},
+			err:    "container not found",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			data, err := json.Marshal(tc.status)
+			require.NoError(t, err)
+			exitCode, err := exitCodeFromStatus(data)
+			if tc.err != "" {
+				assert.EqualError(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.exitCode, exitCode)
+		})
+	}
+}
+
+func nonZeroExitCodeStatus(exitCode string) metav1.Status {
+	return metav1.Status{
+		Status: metav1.StatusFailure,
+		Reason: remotecommand.NonZeroExitCodeReason,
+		Details: &metav1.StatusDetails{
+			Causes: []metav1.StatusCause{{Type: remotecommand.ExitCodeCauseType, Message: exitCode}},
+		},
+	}
+}
@@ -18,22 +18,27 @@ func (st IngressServiceType) String() string {
 }
 
 type IngressServiceConfig struct {
-	Type         IngressServiceType
-	Ports        []uint32
-	Annotations  map[string]string
-	TlsEnabled   bool
-	CertName     string
-	UseClusterIp bool
+	Type             IngressServiceType
+	Ports            []uint32
+	Annotations      map[string]string
+	TlsEnabled       bool
+	CertName         string
+	UseClusterIp     bool
+	IngressClassName string
+	ExternalDns      *api.ExternalDnsConfig
 }
 
 func deepCopy(config *IngressServiceConfig) *IngressServiceConfig {
 	return &IngressServiceConfig{
-		Type:         config.Type,
-		Ports:        util.DeepCopyListUint32(config.Ports),
-		Annotations:  util.DeepCopy(config.Annotations),
-		TlsEnabled:   config.TlsEnabled,
-		CertName:     config.CertName,
-		UseClusterIp: config.UseClusterIp,
+		Type:             config.Type,
+		Ports:            util.DeepCopyListUint32(config.Ports),
+		Annotations:      util.DeepCopy(config.Annotations),
+		TlsEnabled:       config.TlsEnabled,
+		CertName:         config.CertName,
+		UseClusterIp:     config.UseClusterIp,
+		IngressClassName: config.IngressClassName,
+		// Never modified, hence shared.
+		ExternalDns: config.ExternalDns,
 	}
 }
 
@@ -44,12 +49,14 @@ func CombineIngressService(ingresses []*api.IngressConfig, services []*api.Servi
 		result = append(
 			result,
 			&IngressServiceConfig{
-				Type:         Ingress,
-				Ports:        util.DeepCopyListUint32(ing.Ports),
-				Annotations:  util.DeepCopy(ing.Annotations),
-				TlsEnabled:   ing.TlsEnabled,
-				CertName:     ing.CertName,
-				UseClusterIp: ing.UseClusterIP,
+				Type:             Ingress,
+				Ports:            util.DeepCopyListUint32(ing.Ports),
+				Annotations:      util.DeepCopy(ing.Annotations),
+				TlsEnabled:       ing.TlsEnabled,
+				CertName:         ing.CertName,
+				UseClusterIp:     ing.UseClusterIP,
+				IngressClassName: ing.IngressClassName,
+				ExternalDns:      ing.ExternalDns,
 			},
 		)
 	}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/gogo/protobuf/proto"
 	v1 "k8s.io/api/core/v1"
 	networking "k8s.io/api/networking/v1"
 
@@ -68,7 +69,7 @@ func mergeOnAnnotations(configs []*IngressServiceConfig) []*IngressServiceConfig
 		matchFound := false
 
 		for _, existingConfig := range result {
-			if util.Equal(config.Annotations, existingConfig.Annotations) {
+			if util.Equal(config.Annotations, existingConfig.Annotations) && sameIngressOptions(config, existingConfig) {
 				existingConfig.Ports = append(existingConfig.Ports, config.Ports...)
 				matchFound = true
 			}
@@ -81,6 +82,16 @@ func mergeOnAnnotations(configs []*IngressServiceConfig) []*IngressServiceConfig
 	return result
 }
 
+// sameIngressOptions returns true if ingresses for a and b would differ only in their ports, such that they can be merged.
+// Ingresses with external dns hostnames are never merged, as their hostnames route to their single port.
+func sameIngressOptions(a *IngressServiceConfig, b *IngressServiceConfig) bool {
+	return len(a.ExternalDns.GetHostnames()) == 0 &&
+		a.TlsEnabled == b.TlsEnabled &&
+		a.CertName == b.CertName &&
+		a.IngressClassName == b.IngressClassName &&
+		proto.Equal(a.ExternalDns, b.ExternalDns)
+}
+
 func GetServicePorts(svcConfigs []*IngressServiceConfig, podSpec *v1.PodSpec) []v1.ServicePort {
 	var servicePorts []v1.ServicePort
 
@@ -81,6 +81,7 @@ func CreateIngress(
 	})
 	annotations := util.MergeMaps(job.Annotations, executorIngressConfig.Annotations)
 	annotations = util.MergeMaps(annotations, jobConfig.Annotations)
+	annotations = util.MergeMaps(annotations, externalDnsAnnotations(jobConfig.ExternalDns))
 	annotations = util.MergeMaps(annotations, map[string]string{
 		domain.JobSetId: job.JobSetId,
 		domain.Owner:    job.Owner,
@@ -123,6 +124,16 @@ func CreateIngress(
 		rules = append(rules, path)
 	}
 
+	// Hostnames of external dns route to the single port of the ingress, as enforced when the job was submitted.
+	if len(rules) > 0 {
+		for _, hostname := range jobConfig.ExternalDns.GetHostnames() {
+			rule := *rules[0].DeepCopy()
+			rule.Host = hostname
+			rules = append(rules, rule)
+			tlsHosts = append(tlsHosts, hostname)
+		}
+	}
+
 	tls := make([]networking.IngressTLS, 0, 1)
 
 	if jobConfig.TlsEnabled {
@@ -149,9 +160,25 @@ func CreateIngress(
 			TLS:   tls,
 		},
 	}
+	if jobConfig.IngressClassName != "" {
+		ingressClassName := jobConfig.IngressClassName
+		ingress.Spec.IngressClassName = &ingressClassName
+	}
 	return ingress
 }
 
+// externalDnsAnnotations returns the annotations instructing external-dns to create records as per config, if any.
+func externalDnsAnnotations(config *api.ExternalDnsConfig) map[string]string {
+	annotations := map[string]string{}
+	if config.GetTtlSeconds() > 0 {
+		annotations[domain.ExternalDnsTtl] = strconv.FormatUint(uint64(config.TtlSeconds), 10)
+	}
+	if config.GetTarget() != "" {
+		annotations[domain.ExternalDnsTarget] = config.Target
+	}
+	return annotations
+}
+
 func CreateOwnerReference(pod *v1.Pod) metav1.OwnerReference {
 	return metav1.OwnerReference{
 		APIVersion: "v1",
@@ -262,7 +289,7 @@ func CreatePodFromExecutorApiJob(job *executorapi.JobRunLease, defaults *configu
 	})
 
 	applyDefaults(podSpec, defaults)
-	setRestartPolicyNever(podSpec)
+	applyDefaultRestartPolicy(podSpec)
 
 	pod := &v1.Pod{
 		ObjectMeta: metav1.ObjectMeta{
@@ -302,7 +329,7 @@ func CreatePod(job *api.Job, defaults *configuration.PodDefaults) *v1.Pod {
 		domain.Owner:    job.Owner,
 	})
 
-	setRestartPolicyNever(podSpec)
+	applyDefaultRestartPolicy(podSpec)
 
 	pod := &v1.Pod{
 		ObjectMeta: metav1.ObjectMeta{
@@ -326,6 +353,10 @@ func applyDefaults(spec *v1.PodSpec, defaults *configuration.PodDefaults) {
 	}
 }
 
-func setRestartPolicyNever(podSpec *v1.PodSpec) {
-	podSpec.RestartPolicy = v1.RestartPolicyNever
+// applyDefaultRestartPolicy sets the restart policy of pods that don't set one to Never.
+// The server only accepts pods with a restart policy allowed for their queue.
+func applyDefaultRestartPolicy(podSpec *v1.PodSpec) {
+	if podSpec.RestartPolicy == "" {
+		podSpec.RestartPolicy = v1.RestartPolicyNever
+	}
 }
@@ -6,6 +6,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	v1 "k8s.io/api/core/v1"
 	networking "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -45,14 +46,20 @@ func TestCreateLabels_CreatesExpectedLabels(t *testing.T) {
 	assert.Equal(t, result.Annotations, expectedAnnotations)
 }
 
-func TestSetRestartPolicyNever_OverwritesExistingValue(t *testing.T) {
+func TestApplyDefaultRestartPolicy_SetsNeverIfUnset(t *testing.T) {
 	podSpec := makePodSpec()
+	podSpec.RestartPolicy = ""
 
-	podSpec.RestartPolicy = v1.RestartPolicyAlways
-	assert.Equal(t, podSpec.RestartPolicy, v1.RestartPolicyAlways)
+	applyDefaultRestartPolicy(podSpec)
+	assert.Equal(t, v1.RestartPolicyNever, podSpec.RestartPolicy)
+}
+
+func TestApplyDefaultRestartPolicy_KeepsExistingValue(t *testing.T) {
+	podSpec := makePodSpec()
+	podSpec.RestartPolicy = v1.RestartPolicyOnFailure
 
-	setRestartPolicyNever(podSpec)
-	assert.Equal(t, podSpec.RestartPolicy, v1.RestartPolicyNever)
+	applyDefaultRestartPolicy(podSpec)
+	assert.Equal(t, v1.RestartPolicyOnFailure, podSpec.RestartPolicy)
 }
 
 func TestCreatePod_CreatesExpectedPod(t *testing.T) {
@@ -266,6 +273,42 @@ func TestCreateIngress_TLS(t *testing.T) {
 	assert.Equal(t, result.Spec, expectedIngressSpec)
 }
 
+func TestCreateIngress_ClassAndExternalDns(t *testing.T) {
+	job := makeTestJob()
+	service := makeTestService()
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "testPod", Namespace: "testNamespace"}}
+	ingressConfig := &configuration.IngressConfiguration{
+		HostnameSuffix: "testSuffix",
+	}
+
+	jobConfig := &IngressServiceConfig{
+		TlsEnabled:       true,
+		CertName:         "app-certificate",
+		Ports:            []uint32{8080},
+		IngressClassName: "internal",
+		ExternalDns: &api.ExternalDnsConfig{
+			Hostnames:  []string{"app.example.com"},
+			TtlSeconds: 60,
+			Target:     "lb.example.com",
+		},
+	}
+
+	result := CreateIngress("testIngress", job, pod, service, ingressConfig, jobConfig)
+
+	require.NotNil(t, result.Spec.IngressClassName)
+	assert.Equal(t, "internal", *result.Spec.IngressClassName)
+	require.Len(t, result.Spec.Rules, 2)
+	assert.Equal(t, "testPort-testPod.testNamespace.testSuffix", result.Spec.Rules[0].Host)
+	assert.Equal(t, "app.example.com", result.Spec.Rules[1].Host)
+	assert.Equal(t, result.Spec.Rules[0].HTTP, result.Spec.Rules[1].HTTP)
+	assert.Equal(t, []networking.IngressTLS{{
+		Hosts:      []string{"testPort-testPod.testNamespace.testSuffix", "app.example.com"},
+		SecretName: "app-certificate",
+	}}, result.Spec.TLS)
+	assert.Equal(t, "60", result.Annotations[domain.ExternalDnsTtl])
+	assert.Equal(t, "lb.example.com", result.Annotations[domain.ExternalDnsTarget])
+}
+
 func TestCreateService_Ingress_Headless(t *testing.T) {
 	job := makeTestJob()
 	pod := &v1.Pod{
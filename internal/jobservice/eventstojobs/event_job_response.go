@@ -1,6 +1,7 @@
 package eventstojobs
 
 import (
+	"github.com/armadaproject/armada/internal/jobservice/repository"
 	"github.com/armadaproject/armada/pkg/api"
 	js "github.com/armadaproject/armada/pkg/api/jobservice"
 )
@@ -16,7 +17,13 @@ func EventsToJobResponse(message api.EventMessage) *js.JobServiceResponse {
 	case *api.EventMessage_Running:
 		return &js.JobServiceResponse{State: js.JobServiceResponse_RUNNING}
 	case *api.EventMessage_Failed:
+		if message.GetFailed().WillRetry {
+			return &js.JobServiceResponse{State: js.JobServiceResponse_SUBMITTED}
+		}
 		return &js.JobServiceResponse{State: js.JobServiceResponse_FAILED, Error: message.GetFailed().Reason}
+	case *api.EventMessage_EvictedForCapacity:
+		// Evicted jobs are returned to the queue.
+		return &js.JobServiceResponse{State: js.JobServiceResponse_SUBMITTED}
 	case *api.EventMessage_Succeeded:
 		return &js.JobServiceResponse{State: js.JobServiceResponse_SUCCEEDED}
 	case *api.EventMessage_Cancelled:
@@ -26,11 +33,91 @@ func EventsToJobResponse(message api.EventMessage) *js.JobServiceResponse {
 	return nil
 }
 
+// Translates api.EventMessage to a change to the run history of its job.
+// Nil if api.EventMessage doesn't start, run or end an attempt at running the job.
+func EventsToJobRunEvent(message api.EventMessage) *repository.JobRunEvent {
+	var event *repository.JobRunEvent
+	switch m := message.Events.(type) {
+	case *api.EventMessage_Leased:
+		event = &repository.JobRunEvent{
+			Type:      repository.JobRunLeased,
+			ClusterId: m.Leased.ClusterId,
+			Time:      m.Leased.Created,
+		}
+	case *api.EventMessage_Running:
+		event = &repository.JobRunEvent{
+			Type:      repository.JobRunStarted,
+			ClusterId: m.Running.ClusterId,
+			NodeName:  m.Running.NodeName,
+			Time:      m.Running.Created,
+		}
+	case *api.EventMessage_Succeeded:
+		event = &repository.JobRunEvent{
+			Type:      repository.JobRunFinished,
+			ClusterId: m.Succeeded.ClusterId,
+			NodeName:  m.Succeeded.NodeName,
+			Time:      m.Succeeded.Created,
+			Outcome:   js.JobRunAttempt_SUCCEEDED,
+		}
+	case *api.EventMessage_Failed:
+		event = &repository.JobRunEvent{
+			Type:       repository.JobRunFinished,
+			ClusterId:  m.Failed.ClusterId,
+			NodeName:   m.Failed.NodeName,
+			Time:       m.Failed.Created,
+			Outcome:    js.JobRunAttempt_FAILED,
+			ExitReason: m.Failed.Reason,
+		}
+	case *api.EventMessage_LeaseReturned:
+		event = &repository.JobRunEvent{
+			Type:       repository.JobRunFinished,
+			ClusterId:  m.LeaseReturned.ClusterId,
+			Time:       m.LeaseReturned.Created,
+			Outcome:    js.JobRunAttempt_LEASE_RETURNED,
+			ExitReason: m.LeaseReturned.Reason,
+		}
+	case *api.EventMessage_LeaseExpired:
+		event = &repository.JobRunEvent{
+			Type:    repository.JobRunFinished,
+			Time:    m.LeaseExpired.Created,
+			Outcome: js.JobRunAttempt_LEASE_EXPIRED,
+		}
+	case *api.EventMessage_Preempted:
+		event = &repository.JobRunEvent{
+			Type:      repository.JobRunFinished,
+			ClusterId: m.Preempted.ClusterId,
+			Time:      m.Preempted.Created,
+			Outcome:   js.JobRunAttempt_PREEMPTED,
+		}
+	case *api.EventMessage_EvictedForCapacity:
+		event = &repository.JobRunEvent{
+			Type:      repository.JobRunFinished,
+			ClusterId: m.EvictedForCapacity.ClusterId,
+			Time:      m.EvictedForCapacity.Created,
+			Outcome:   js.JobRunAttempt_PREEMPTED,
+		}
+	case *api.EventMessage_Cancelled:
+		event = &repository.JobRunEvent{
+			Type:       repository.JobRunFinished,
+			Time:       m.Cancelled.Created,
+			Outcome:    js.JobRunAttempt_CANCELLED,
+			ExitReason: m.Cancelled.Reason,
+		}
+	default:
+		return nil
+	}
+	event.JobId = api.JobIdFromApiEvent(&message)
+	return event
+}
+
 // Check if api.EventMessage is terminal event
 func IsEventTerminal(message api.EventMessage) bool {
 	switch message.Events.(type) {
-	case *api.EventMessage_DuplicateFound, *api.EventMessage_Cancelled, *api.EventMessage_Succeeded, *api.EventMessage_Failed:
+	case *api.EventMessage_DuplicateFound, *api.EventMessage_Cancelled, *api.EventMessage_Succeeded:
 		return true
+	case *api.EventMessage_Failed:
+		// Failed runs that are retried don't end the job.
+		return !message.GetFailed().WillRetry
 	default:
 		return false
 	}
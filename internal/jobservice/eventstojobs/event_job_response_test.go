@@ -2,9 +2,11 @@ package eventstojobs
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/armadaproject/armada/internal/jobservice/repository"
 	"github.com/armadaproject/armada/pkg/api"
 	"github.com/armadaproject/armada/pkg/api/jobservice"
 )
@@ -37,6 +39,10 @@ func TestIsEventResponse(t *testing.T) {
 			eventMessage: api.EventMessage{Events: &api.EventMessage_Failed{Failed: &api.JobFailedEvent{Reason: "Failed Test"}}},
 			jobResponse:  &jobservice.JobServiceResponse{State: jobservice.JobServiceResponse_FAILED, Error: "Failed Test"},
 		},
+		{
+			eventMessage: api.EventMessage{Events: &api.EventMessage_Failed{Failed: &api.JobFailedEvent{Reason: "Failed Test", WillRetry: true}}},
+			jobResponse:  &jobservice.JobServiceResponse{State: jobservice.JobServiceResponse_SUBMITTED},
+		},
 		{
 			eventMessage: api.EventMessage{Events: &api.EventMessage_Succeeded{}},
 			jobResponse:  &jobservice.JobServiceResponse{State: jobservice.JobServiceResponse_SUCCEEDED},
@@ -99,13 +105,74 @@ func TestIsEventResponse(t *testing.T) {
 		},
 	}
 	length := len(eventMessages)
-	assert.Equal(t, length, 19)
+	assert.Equal(t, length, 20)
 	for i := range eventMessages {
 		jobResponse := EventsToJobResponse(eventMessages[i].eventMessage)
 		assert.Equal(t, jobResponse, eventMessages[i].jobResponse)
 	}
 }
 
+func TestEventsToJobRunEvent(t *testing.T) {
+	created := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	tests := map[string]struct {
+		eventMessage api.EventMessage
+		jobRunEvent  *repository.JobRunEvent
+	}{
+		"leased": {
+			eventMessage: api.EventMessage{Events: &api.EventMessage_Leased{Leased: &api.JobLeasedEvent{
+				JobId: "job-id", ClusterId: "cluster", Created: created,
+			}}},
+			jobRunEvent: &repository.JobRunEvent{
+				JobId: "job-id", Type: repository.JobRunLeased, ClusterId: "cluster", Time: created,
+			},
+		},
+		"running": {
+			eventMessage: api.EventMessage{Events: &api.EventMessage_Running{Running: &api.JobRunningEvent{
+				JobId: "job-id", ClusterId: "cluster", NodeName: "node", Created: created,
+			}}},
+			jobRunEvent: &repository.JobRunEvent{
+				JobId: "job-id", Type: repository.JobRunStarted, ClusterId: "cluster", NodeName: "node", Time: created,
+			},
+		},
+		"failed": {
+			eventMessage: api.EventMessage{Events: &api.EventMessage_Failed{Failed: &api.JobFailedEvent{
+				JobId: "job-id", ClusterId: "cluster", NodeName: "node", Reason: "oom", Created: created,
+			}}},
+			jobRunEvent: &repository.JobRunEvent{
+				JobId: "job-id", Type: repository.JobRunFinished, ClusterId: "cluster", NodeName: "node", Time: created,
+				Outcome: jobservice.JobRunAttempt_FAILED, ExitReason: "oom",
+			},
+		},
+		"lease returned": {
+			eventMessage: api.EventMessage{Events: &api.EventMessage_LeaseReturned{LeaseReturned: &api.JobLeaseReturnedEvent{
+				JobId: "job-id", ClusterId: "cluster", Reason: "pod error", Created: created,
+			}}},
+			jobRunEvent: &repository.JobRunEvent{
+				JobId: "job-id", Type: repository.JobRunFinished, ClusterId: "cluster", Time: created,
+				Outcome: jobservice.JobRunAttempt_LEASE_RETURNED, ExitReason: "pod error",
+			},
+		},
+		"preempted": {
+			eventMessage: api.EventMessage{Events: &api.EventMessage_Preempted{Preempted: &api.JobPreemptedEvent{
+				JobId: "job-id", ClusterId: "cluster", Created: created,
+			}}},
+			jobRunEvent: &repository.JobRunEvent{
+				JobId: "job-id", Type: repository.JobRunFinished, ClusterId: "cluster", Time: created,
+				Outcome: jobservice.JobRunAttempt_PREEMPTED,
+			},
+		},
+		"submitted": {
+			eventMessage: api.EventMessage{Events: &api.EventMessage_Submitted{Submitted: &api.JobSubmittedEvent{JobId: "job-id"}}},
+			jobRunEvent:  nil,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.jobRunEvent, EventsToJobRunEvent(tc.eventMessage))
+		})
+	}
+}
+
 func TestIsTerminalEvent(t *testing.T) {
 	eventMessages := []eventResponse{
 		{
@@ -124,6 +191,10 @@ func TestIsTerminalEvent(t *testing.T) {
 			eventMessage:    api.EventMessage{Events: &api.EventMessage_Failed{Failed: &api.JobFailedEvent{Reason: "Failed Test"}}},
 			jobServiceEvent: true,
 		},
+		{
+			eventMessage:    api.EventMessage{Events: &api.EventMessage_Failed{Failed: &api.JobFailedEvent{Reason: "Failed Test", WillRetry: true}}},
+			jobServiceEvent: false,
+		},
 		{
 			eventMessage:    api.EventMessage{Events: &api.EventMessage_Succeeded{}},
 			jobServiceEvent: true,
@@ -186,7 +257,7 @@ func TestIsTerminalEvent(t *testing.T) {
 		},
 	}
 	length := len(eventMessages)
-	assert.Equal(t, length, 19)
+	assert.Equal(t, length, 20)
 	for i := range eventMessages {
 		jobResponse := IsEventTerminal(eventMessages[i].eventMessage)
 		assert.Equal(t, jobResponse, eventMessages[i].jobServiceEvent)
@@ -232,6 +232,7 @@ func (js *JobSetSubscription) Subscribe() error {
 		Queue:         js.Queue,
 		Watch:         true,
 		FromMessageId: js.fromMessageId,
+		SchemaVersion: api.EventSchemaVersion,
 	})
 	if err != nil {
 		log.WithFields(requestFields).WithError(err).Error("error from GetJobEventMessage")
@@ -328,6 +329,16 @@ func (js *JobSetSubscription) Subscribe() error {
 						"message": msg.Message,
 					}).Debug("Got non-status event")
 				}
+				if jobRunEvent := EventsToJobRunEvent(*msg.Message); jobRunEvent != nil {
+					jobRunEvent.Queue = js.Queue
+					jobRunEvent.JobSetId = js.JobSetId
+					err := js.sqlJobService.UpdateJobRunHistory(js.ctx, jobRunEvent)
+					if err != nil {
+						log.WithFields(requestFields).WithError(err).Error("could not update job run history, retrying")
+						nextRecv = time.After(5 * time.Second)
+						continue
+					}
+				}
 				// advance the message id for next loop
 				js.fromMessageId = msg.GetId()
 				requestFields["from_message_id"] = js.fromMessageId
@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/gogo/protobuf/types"
+
+	js "github.com/armadaproject/armada/pkg/api/jobservice"
+)
+
+type JobRunEventType int
+
+const (
+	// JobRunLeased starts a new attempt on the cluster the job was leased to.
+	JobRunLeased JobRunEventType = iota
+	// JobRunStarted marks the latest attempt as running on a node.
+	JobRunStarted
+	// JobRunFinished ends the latest attempt with an outcome.
+	JobRunFinished
+)
+
+// JobRunEvent represents a change to the run history of a job
+type JobRunEvent struct {
+	Queue      string
+	JobSetId   string
+	JobId      string
+	Type       JobRunEventType
+	ClusterId  string
+	NodeName   string
+	Time       time.Time
+	Outcome    js.JobRunAttempt_Outcome
+	ExitReason string
+}
+
+// jobRunAttemptFromRow converts a row of the job_runs table into a JobRunAttempt.
+// Times are stored as Unix nanoseconds, with zero meaning the time isn't known.
+func jobRunAttemptFromRow(clusterId, nodeName string, leased, started, finished int64, outcome, exitReason string) *js.JobRunAttempt {
+	return &js.JobRunAttempt{
+		ClusterId:  clusterId,
+		NodeName:   nodeName,
+		Leased:     timestampFromUnixNano(leased),
+		Started:    timestampFromUnixNano(started),
+		Finished:   timestampFromUnixNano(finished),
+		Outcome:    js.JobRunAttempt_Outcome(js.JobRunAttempt_Outcome_value[outcome]),
+		ExitReason: exitReason,
+	}
+}
+
+func timestampFromUnixNano(t int64) *types.Timestamp {
+	if t == 0 {
+		return nil
+	}
+	return &types.Timestamp{Seconds: t / int64(time.Second), Nanos: int32(t % int64(time.Second))}
+}
+
+func unixNano(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.UnixNano()
+}
+
+type jobRunChange int
+
+const (
+	jobRunChangeNone jobRunChange = iota
+	jobRunChangeInsert
+	jobRunChangeUpdate
+)
+
+// change returns how the event affects the run history of its job, given whether the job has an attempt in progress
+// and whether that attempt has already started running.
+func (e *JobRunEvent) change(inProgress bool, started bool) jobRunChange {
+	switch e.Type {
+	case JobRunLeased:
+		return jobRunChangeInsert
+	case JobRunStarted:
+		if inProgress && !started {
+			return jobRunChangeUpdate
+		}
+		// We didn't see the job being leased, e.g., because the subscription started after it was.
+		return jobRunChangeInsert
+	case JobRunFinished:
+		if inProgress {
+			return jobRunChangeUpdate
+		}
+		// Events without a cluster, e.g., cancelling a queued job, end the job rather than an attempt.
+		if e.ClusterId != "" {
+			return jobRunChangeInsert
+		}
+	}
+	return jobRunChangeNone
+}
+
+// newAttemptTimes returns the leased, started and finished times of an attempt created by the event.
+func (e *JobRunEvent) newAttemptTimes() (int64, int64, int64) {
+	switch e.Type {
+	case JobRunLeased:
+		return unixNano(e.Time), 0, 0
+	case JobRunStarted:
+		return 0, unixNano(e.Time), 0
+	default:
+		return 0, 0, unixNano(e.Time)
+	}
+}
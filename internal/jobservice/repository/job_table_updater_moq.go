@@ -39,6 +39,9 @@ var _ JobTableUpdater = &JobTableUpdaterMock{}
 //			UnsubscribeJobSetFunc: func(ctx context.Context, queue string, jobSet string) (int64, error) {
 //				panic("mock out the UnsubscribeJobSet method")
 //			},
+//			UpdateJobRunHistoryFunc: func(ctx context.Context, event *JobRunEvent) error {
+//				panic("mock out the UpdateJobRunHistory method")
+//			},
 //			UpdateJobServiceDbFunc: func(ctx context.Context, jobTable *JobStatus) error {
 //				panic("mock out the UpdateJobServiceDb method")
 //			},
@@ -73,6 +76,9 @@ type JobTableUpdaterMock struct {
 	// UnsubscribeJobSetFunc mocks the UnsubscribeJobSet method.
 	UnsubscribeJobSetFunc func(ctx context.Context, queue string, jobSet string) (int64, error)
 
+	// UpdateJobRunHistoryFunc mocks the UpdateJobRunHistory method.
+	UpdateJobRunHistoryFunc func(ctx context.Context, event *JobRunEvent) error
+
 	// UpdateJobServiceDbFunc mocks the UpdateJobServiceDb method.
 	UpdateJobServiceDbFunc func(ctx context.Context, jobTable *JobStatus) error
 
@@ -148,6 +154,13 @@ type JobTableUpdaterMock struct {
 			// JobSet is the jobSet argument value.
 			JobSet string
 		}
+		// UpdateJobRunHistory holds details about calls to the UpdateJobRunHistory method.
+		UpdateJobRunHistory []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Event is the event argument value.
+			Event *JobRunEvent
+		}
 		// UpdateJobServiceDb holds details about calls to the UpdateJobServiceDb method.
 		UpdateJobServiceDb []struct {
 			// Ctx is the ctx argument value.
@@ -174,6 +187,7 @@ type JobTableUpdaterMock struct {
 	lockSetSubscriptionError                  sync.RWMutex
 	lockSubscribeJobSet                       sync.RWMutex
 	lockUnsubscribeJobSet                     sync.RWMutex
+	lockUpdateJobRunHistory                   sync.RWMutex
 	lockUpdateJobServiceDb                    sync.RWMutex
 	lockUpdateJobSetDb                        sync.RWMutex
 }
@@ -466,6 +480,42 @@ func (mock *JobTableUpdaterMock) UnsubscribeJobSetCalls() []struct {
 	return calls
 }
 
+// UpdateJobRunHistory calls UpdateJobRunHistoryFunc.
+func (mock *JobTableUpdaterMock) UpdateJobRunHistory(ctx context.Context, event *JobRunEvent) error {
+	if mock.UpdateJobRunHistoryFunc == nil {
+		panic("JobTableUpdaterMock.UpdateJobRunHistoryFunc: method is nil but JobTableUpdater.UpdateJobRunHistory was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Event *JobRunEvent
+	}{
+		Ctx:   ctx,
+		Event: event,
+	}
+	mock.lockUpdateJobRunHistory.Lock()
+	mock.calls.UpdateJobRunHistory = append(mock.calls.UpdateJobRunHistory, callInfo)
+	mock.lockUpdateJobRunHistory.Unlock()
+	return mock.UpdateJobRunHistoryFunc(ctx, event)
+}
+
+// UpdateJobRunHistoryCalls gets all the calls that were made to UpdateJobRunHistory.
+// Check the length with:
+//
+//	len(mockedJobTableUpdater.UpdateJobRunHistoryCalls())
+func (mock *JobTableUpdaterMock) UpdateJobRunHistoryCalls() []struct {
+	Ctx   context.Context
+	Event *JobRunEvent
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Event *JobRunEvent
+	}
+	mock.lockUpdateJobRunHistory.RLock()
+	calls = mock.calls.UpdateJobRunHistory
+	mock.lockUpdateJobRunHistory.RUnlock()
+	return calls
+}
+
 // UpdateJobServiceDb calls UpdateJobServiceDbFunc.
 func (mock *JobTableUpdaterMock) UpdateJobServiceDb(ctx context.Context, jobTable *JobStatus) error {
 	if mock.UpdateJobServiceDbFunc == nil {
@@ -39,6 +39,7 @@ func NewJSRepoPostgres(cfg *configuration.JobServiceConfiguration, log *log.Entr
 // Set up the DB for use, create tables
 func (s *JSRepoPostgres) Setup(ctx context.Context) {
 	setupStmts := []string{
+		`DROP TABLE IF EXISTS job_runs`,
 		`DROP TABLE IF EXISTS jobs`,
 		`DROP INDEX IF EXISTS idx_job_set_queue`,
 		`DROP TABLE IF EXISTS jobsets`,
@@ -61,6 +62,22 @@ func (s *JSRepoPostgres) Setup(ctx context.Context) {
 			PRIMARY KEY(Id))`,
 		`CREATE INDEX idx_job_set_queue ON jobs (Queue, JobSetId)`,
 		`CREATE INDEX idx_jobs_timestamp ON jobs (Timestamp)`,
+		`CREATE TABLE job_runs (
+			Queue TEXT,
+			JobSetId TEXT,
+			JobId TEXT,
+			Attempt INTEGER,
+			ClusterId TEXT,
+			NodeName TEXT,
+			Leased BIGINT,
+			Started BIGINT,
+			Finished BIGINT,
+			Outcome TEXT,
+			ExitReason TEXT,
+			Timestamp INTEGER,
+			PRIMARY KEY(JobId, Attempt))`,
+		`CREATE INDEX idx_job_runs_job_set_queue ON job_runs (Queue, JobSetId)`,
+		`CREATE INDEX idx_job_runs_timestamp ON job_runs (Timestamp)`,
 		`DROP TRIGGER IF EXISTS trigger_delete_expired_jobsets ON jobsets`,
 		`DROP FUNCTION IF EXISTS delete_expired_jobsets`,
 	}
@@ -105,12 +122,40 @@ func (s *JSRepoPostgres) GetJobStatus(ctx context.Context, jobId string) (*js.Jo
 		return nil, err
 	}
 
+	runHistory, err := s.getJobRunHistory(ctx, jobId)
+	if err != nil {
+		return nil, err
+	}
+
 	return &js.JobServiceResponse{
-		Error: jobError,
-		State: jobJSRState,
+		Error:      jobError,
+		State:      jobJSRState,
+		RunHistory: runHistory,
 	}, nil
 }
 
+func (s *JSRepoPostgres) getJobRunHistory(ctx context.Context, jobId string) ([]*js.JobRunAttempt, error) {
+	sqlStmt := `SELECT ClusterId, NodeName, Leased, Started, Finished, Outcome, ExitReason
+		FROM job_runs WHERE JobId = $1 ORDER BY Attempt`
+
+	rows, err := s.dbpool.Query(ctx, sqlStmt, jobId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var runHistory []*js.JobRunAttempt
+	for rows.Next() {
+		var clusterId, nodeName, outcome, exitReason string
+		var leased, started, finished int64
+		if err := rows.Scan(&clusterId, &nodeName, &leased, &started, &finished, &outcome, &exitReason); err != nil {
+			return nil, err
+		}
+		runHistory = append(runHistory, jobRunAttemptFromRow(clusterId, nodeName, leased, started, finished, outcome, exitReason))
+	}
+	return runHistory, rows.Err()
+}
+
 // Update database with JobTable.
 func (s *JSRepoPostgres) UpdateJobServiceDb(ctx context.Context, jobTable *JobStatus) error {
 	sqlStmt := `INSERT INTO jobs (Queue, JobSetId, Id, JobResponseState, JobResponseError, Timestamp)
@@ -123,6 +168,56 @@ func (s *JSRepoPostgres) UpdateJobServiceDb(ctx context.Context, jobTable *JobSt
 	return errExec
 }
 
+// Record a change to the run history of a job.
+func (s *JSRepoPostgres) UpdateJobRunHistory(ctx context.Context, event *JobRunEvent) error {
+	tx, err := s.dbpool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	// The latest attempt that's still in progress, if any.
+	var attempt, started int64
+	inProgress := true
+	row := tx.QueryRow(ctx, `SELECT Attempt, Started FROM job_runs WHERE JobId = $1 AND Outcome = $2
+		ORDER BY Attempt DESC LIMIT 1 FOR UPDATE`, event.JobId, js.JobRunAttempt_IN_PROGRESS.String())
+	if err := row.Scan(&attempt, &started); err == pgx.ErrNoRows {
+		inProgress = false
+	} else if err != nil {
+		return err
+	}
+
+	now := time.Now().Unix()
+	switch event.change(inProgress, started != 0) {
+	case jobRunChangeNone:
+		return nil
+	case jobRunChangeInsert:
+		leasedTime, startedTime, finishedTime := event.newAttemptTimes()
+		_, err = tx.Exec(ctx, `INSERT INTO job_runs (Queue, JobSetId, JobId, Attempt, ClusterId, NodeName,
+			Leased, Started, Finished, Outcome, ExitReason, Timestamp)
+			SELECT $1, $2, $3, COALESCE(MAX(Attempt), 0) + 1, $4, $5, $6, $7, $8, $9, $10, $11
+			FROM job_runs WHERE JobId = $3`,
+			event.Queue, event.JobSetId, event.JobId, event.ClusterId, event.NodeName,
+			leasedTime, startedTime, finishedTime, event.Outcome.String(), event.ExitReason, now)
+	case jobRunChangeUpdate:
+		if event.Type == JobRunStarted {
+			_, err = tx.Exec(ctx, `UPDATE job_runs SET ClusterId = COALESCE(NULLIF(ClusterId, ''), $1), NodeName = $2,
+				Started = $3, Timestamp = $4 WHERE JobId = $5 AND Attempt = $6`,
+				event.ClusterId, event.NodeName, unixNano(event.Time), now, event.JobId, attempt)
+		} else {
+			_, err = tx.Exec(ctx, `UPDATE job_runs SET ClusterId = COALESCE(NULLIF(ClusterId, ''), $1),
+				NodeName = COALESCE(NULLIF(NodeName, ''), $2), Finished = $3, Outcome = $4, ExitReason = $5, Timestamp = $6
+				WHERE JobId = $7 AND Attempt = $8`,
+				event.ClusterId, event.NodeName, unixNano(event.Time), event.Outcome.String(), event.ExitReason, now,
+				event.JobId, attempt)
+		}
+	}
+	if err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
+
 // We should check if a JobSet exists first before updating the database and return an error if it doesn't exist
 // However, The only caller of this function, in jobservice/server/server.go, does this check before calling.
 // Adding the check here will be redundant and a performance botteneck.
@@ -274,6 +369,10 @@ func (s *JSRepoPostgres) UnsubscribeJobSet(ctx context.Context, queue, jobSet st
 
 // Delete Jobs in the database
 func (s *JSRepoPostgres) DeleteJobsInJobSet(ctx context.Context, queue string, jobSet string) (int64, error) {
+	if _, err := s.dbpool.Exec(ctx, "DELETE FROM job_runs WHERE Queue = $1 AND JobSetId = $2", queue, jobSet); err != nil {
+		return 0, err
+	}
+
 	sqlStmt := "DELETE FROM jobs WHERE Queue = $1 AND JobSetId = $2"
 
 	result, err := s.dbpool.Exec(ctx, sqlStmt, queue, jobSet)
@@ -311,6 +410,7 @@ func (s *JSRepoPostgres) GetSubscribedJobSets(ctx context.Context) ([]Subscribed
 func (s *JSRepoPostgres) PurgeExpiredJobSets(ctx context.Context) {
 	jobSetStmt := fmt.Sprintf(`DELETE FROM jobsets WHERE Timestamp < (extract(epoch from now()) - %d);`, s.jobServiceConfig.PurgeJobSetTime)
 	jobStmt := fmt.Sprintf(`DELETE FROM jobs WHERE Timestamp < (extract(epoch from now()) - %d);`, s.jobServiceConfig.PurgeJobSetTime)
+	jobRunStmt := fmt.Sprintf(`DELETE FROM job_runs WHERE Timestamp < (extract(epoch from now()) - %d);`, s.jobServiceConfig.PurgeJobSetTime)
 	ticker := time.NewTicker(time.Duration(s.jobServiceConfig.PurgeJobSetTime) * time.Second)
 	log := log.WithField("JobService", "ExpiredJobSetsPurge")
 
@@ -332,6 +432,12 @@ func (s *JSRepoPostgres) PurgeExpiredJobSets(ctx context.Context) {
 			} else {
 				log.Debugf("Deleted %d expired jobs", result.RowsAffected())
 			}
+			result, err = s.dbpool.Exec(ctx, jobRunStmt)
+			if err != nil {
+				log.Error("error deleting expired job runs: ", err)
+			} else {
+				log.Debugf("Deleted %d expired job runs", result.RowsAffected())
+			}
 		}
 	}
 }
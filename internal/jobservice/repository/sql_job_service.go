@@ -17,6 +17,7 @@ type JobTableUpdater interface {
 	SubscribeJobSet(ctx context.Context, queue string, jobSet string, fromMessageId string) error
 	IsJobSetSubscribed(ctx context.Context, queue string, jobSet string) (bool, string, error)
 	UpdateJobServiceDb(ctx context.Context, jobTable *JobStatus) error
+	UpdateJobRunHistory(ctx context.Context, event *JobRunEvent) error
 	UpdateJobSetDb(ctx context.Context, queue string, jobSet string, fromMessageId string) error
 	SetSubscriptionError(ctx context.Context, queue string, jobSet string, err string, fromMessageId string) error
 	GetSubscriptionError(ctx context.Context, queue string, jobSet string) (string, error)
@@ -42,6 +43,7 @@ type SQLJobService interface {
 	SubscribeJobSet(ctx context.Context, queue string, jobSet string, fromMessageId string) error
 	UnsubscribeJobSet(ctx context.Context, queue, jobSet string) (int64, error)
 	UpdateJobServiceDb(ctx context.Context, jobTable *JobStatus) error
+	UpdateJobRunHistory(ctx context.Context, event *JobRunEvent) error
 	UpdateJobSetDb(ctx context.Context, queue string, jobSet string, fromMessageId string) error
 	PurgeExpiredJobSets(ctx context.Context)
 }
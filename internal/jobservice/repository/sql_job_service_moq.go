@@ -59,6 +59,9 @@ var _ SQLJobService = &SQLJobServiceMock{}
 //			UnsubscribeJobSetFunc: func(ctx context.Context, queue string, jobSet string) (int64, error) {
 //				panic("mock out the UnsubscribeJobSet method")
 //			},
+//			UpdateJobRunHistoryFunc: func(ctx context.Context, event *JobRunEvent) error {
+//				panic("mock out the UpdateJobRunHistory method")
+//			},
 //			UpdateJobServiceDbFunc: func(ctx context.Context, jobTable *JobStatus) error {
 //				panic("mock out the UpdateJobServiceDb method")
 //			},
@@ -111,6 +114,9 @@ type SQLJobServiceMock struct {
 	// UnsubscribeJobSetFunc mocks the UnsubscribeJobSet method.
 	UnsubscribeJobSetFunc func(ctx context.Context, queue string, jobSet string) (int64, error)
 
+	// UpdateJobRunHistoryFunc mocks the UpdateJobRunHistory method.
+	UpdateJobRunHistoryFunc func(ctx context.Context, event *JobRunEvent) error
+
 	// UpdateJobServiceDbFunc mocks the UpdateJobServiceDb method.
 	UpdateJobServiceDbFunc func(ctx context.Context, jobTable *JobStatus) error
 
@@ -228,6 +234,13 @@ type SQLJobServiceMock struct {
 			// JobSet is the jobSet argument value.
 			JobSet string
 		}
+		// UpdateJobRunHistory holds details about calls to the UpdateJobRunHistory method.
+		UpdateJobRunHistory []struct {
+			// Ctx is the ctx argument value.
+			Ctx context.Context
+			// Event is the event argument value.
+			Event *JobRunEvent
+		}
 		// UpdateJobServiceDb holds details about calls to the UpdateJobServiceDb method.
 		UpdateJobServiceDb []struct {
 			// Ctx is the ctx argument value.
@@ -260,6 +273,7 @@ type SQLJobServiceMock struct {
 	lockSetup                                 sync.RWMutex
 	lockSubscribeJobSet                       sync.RWMutex
 	lockUnsubscribeJobSet                     sync.RWMutex
+	lockUpdateJobRunHistory                   sync.RWMutex
 	lockUpdateJobServiceDb                    sync.RWMutex
 	lockUpdateJobSetDb                        sync.RWMutex
 }
@@ -768,6 +782,42 @@ func (mock *SQLJobServiceMock) UnsubscribeJobSetCalls() []struct {
 	return calls
 }
 
+// UpdateJobRunHistory calls UpdateJobRunHistoryFunc.
+func (mock *SQLJobServiceMock) UpdateJobRunHistory(ctx context.Context, event *JobRunEvent) error {
+	if mock.UpdateJobRunHistoryFunc == nil {
+		panic("SQLJobServiceMock.UpdateJobRunHistoryFunc: method is nil but SQLJobService.UpdateJobRunHistory was just called")
+	}
+	callInfo := struct {
+		Ctx   context.Context
+		Event *JobRunEvent
+	}{
+		Ctx:   ctx,
+		Event: event,
+	}
+	mock.lockUpdateJobRunHistory.Lock()
+	mock.calls.UpdateJobRunHistory = append(mock.calls.UpdateJobRunHistory, callInfo)
+	mock.lockUpdateJobRunHistory.Unlock()
+	return mock.UpdateJobRunHistoryFunc(ctx, event)
+}
+
+// UpdateJobRunHistoryCalls gets all the calls that were made to UpdateJobRunHistory.
+// Check the length with:
+//
+//	len(mockedSQLJobService.UpdateJobRunHistoryCalls())
+func (mock *SQLJobServiceMock) UpdateJobRunHistoryCalls() []struct {
+	Ctx   context.Context
+	Event *JobRunEvent
+} {
+	var calls []struct {
+		Ctx   context.Context
+		Event *JobRunEvent
+	}
+	mock.lockUpdateJobRunHistory.RLock()
+	calls = mock.calls.UpdateJobRunHistory
+	mock.lockUpdateJobRunHistory.RUnlock()
+	return calls
+}
+
 // UpdateJobServiceDb calls UpdateJobServiceDbFunc.
 func (mock *SQLJobServiceMock) UpdateJobServiceDb(ctx context.Context, jobTable *JobStatus) error {
 	if mock.UpdateJobServiceDbFunc == nil {
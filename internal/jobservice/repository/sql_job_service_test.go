@@ -8,6 +8,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/gogo/protobuf/types"
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -449,6 +450,82 @@ func TestConcurrentJobStatusUpdating(t *testing.T) {
 	})
 }
 
+func TestJobRunHistory(t *testing.T) {
+	WithSqlServiceRepo(purgeTime, func(r SQLJobService) {
+		ctx := context.Background()
+		err := r.SubscribeJobSet(ctx, "test", "job-set-1", "test")
+		require.NoError(t, err)
+		err = r.UpdateJobServiceDb(ctx, NewJobStatus("test", "job-set-1", "job-id",
+			jobservice.JobServiceResponse{State: jobservice.JobServiceResponse_RUNNING}))
+		require.NoError(t, err)
+
+		baseTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+		events := []*JobRunEvent{
+			{Type: JobRunLeased, ClusterId: "cluster-1", Time: baseTime},
+			{Type: JobRunStarted, ClusterId: "cluster-1", NodeName: "node-1", Time: baseTime.Add(time.Second)},
+			{Type: JobRunFinished, ClusterId: "cluster-1", Outcome: jobservice.JobRunAttempt_PREEMPTED, Time: baseTime.Add(2 * time.Second)},
+			{Type: JobRunLeased, ClusterId: "cluster-2", Time: baseTime.Add(3 * time.Second)},
+			{Type: JobRunFinished, ClusterId: "cluster-2", Outcome: jobservice.JobRunAttempt_LEASE_RETURNED, ExitReason: "pod error", Time: baseTime.Add(4 * time.Second)},
+			// Started without having seen the lease.
+			{Type: JobRunStarted, ClusterId: "cluster-1", NodeName: "node-2", Time: baseTime.Add(5 * time.Second)},
+		}
+		for _, event := range events {
+			event.Queue = "test"
+			event.JobSetId = "job-set-1"
+			event.JobId = "job-id"
+			require.NoError(t, r.UpdateJobRunHistory(ctx, event))
+		}
+
+		resp, err := r.GetJobStatus(ctx, "job-id")
+		require.NoError(t, err)
+		assert.Equal(t, []*jobservice.JobRunAttempt{
+			{
+				ClusterId: "cluster-1",
+				NodeName:  "node-1",
+				Leased:    &types.Timestamp{Seconds: baseTime.Unix()},
+				Started:   &types.Timestamp{Seconds: baseTime.Unix() + 1},
+				Finished:  &types.Timestamp{Seconds: baseTime.Unix() + 2},
+				Outcome:   jobservice.JobRunAttempt_PREEMPTED,
+			},
+			{
+				ClusterId:  "cluster-2",
+				Leased:     &types.Timestamp{Seconds: baseTime.Unix() + 3},
+				Finished:   &types.Timestamp{Seconds: baseTime.Unix() + 4},
+				Outcome:    jobservice.JobRunAttempt_LEASE_RETURNED,
+				ExitReason: "pod error",
+			},
+			{
+				ClusterId: "cluster-1",
+				NodeName:  "node-2",
+				Started:   &types.Timestamp{Seconds: baseTime.Unix() + 5},
+				Outcome:   jobservice.JobRunAttempt_IN_PROGRESS,
+			},
+		}, resp.RunHistory)
+
+		// Cancelling a job ends its attempt in progress.
+		err = r.UpdateJobRunHistory(ctx, &JobRunEvent{
+			Queue: "test", JobSetId: "job-set-1", JobId: "job-id",
+			Type: JobRunFinished, Outcome: jobservice.JobRunAttempt_CANCELLED, Time: baseTime.Add(6 * time.Second),
+		})
+		require.NoError(t, err)
+		resp, err = r.GetJobStatus(ctx, "job-id")
+		require.NoError(t, err)
+		require.Len(t, resp.RunHistory, 3)
+		assert.Equal(t, jobservice.JobRunAttempt_CANCELLED, resp.RunHistory[2].Outcome)
+		assert.Equal(t, "node-2", resp.RunHistory[2].NodeName)
+
+		// Job runs are deleted along with the jobs of their job set.
+		_, err = r.DeleteJobsInJobSet(ctx, "test", "job-set-1")
+		require.NoError(t, err)
+		err = r.UpdateJobServiceDb(ctx, NewJobStatus("test", "job-set-1", "job-id",
+			jobservice.JobServiceResponse{State: jobservice.JobServiceResponse_SUBMITTED}))
+		require.NoError(t, err)
+		resp, err = r.GetJobStatus(ctx, "job-id")
+		require.NoError(t, err)
+		assert.Empty(t, resp.RunHistory)
+	})
+}
+
 func WithSqlServiceRepo(purgeTime int64, action func(r SQLJobService)) {
 	var repo SQLJobService
 	config := &configuration.JobServiceConfiguration{
@@ -57,6 +57,7 @@ func (s *JSRepoSQLite) Setup(ctx context.Context) {
 	setupStmts := []string{
 		"PRAGMA journal_mode=WAL",
 		`PRAGMA foreign_keys = ON`,
+		`DROP TABLE IF EXISTS job_runs`,
 		`DROP TABLE IF EXISTS jobs`,
 		`DROP TABLE IF EXISTS jobsets`,
 		`CREATE TABLE jobsets (
@@ -81,6 +82,24 @@ func (s *JSRepoSQLite) Setup(ctx context.Context) {
 		)`,
 		`CREATE INDEX idx_job_set_queue ON jobs (Queue, JobSetId)`,
 		`CREATE INDEX idx_jobs_timestamp ON jobs (Timestamp)`,
+		`CREATE TABLE job_runs (
+			Queue TEXT,
+			JobSetId TEXT,
+			JobId TEXT,
+			Attempt INT,
+			ClusterId TEXT,
+			NodeName TEXT,
+			Leased INT,
+			Started INT,
+			Finished INT,
+			Outcome TEXT,
+			ExitReason TEXT,
+			Timestamp INT,
+			PRIMARY KEY(JobId, Attempt),
+			FOREIGN KEY(JobSetId) REFERENCES jobsets(Id) ON DELETE CASCADE
+		)`,
+		`CREATE INDEX idx_job_runs_job_set_queue ON job_runs (Queue, JobSetId)`,
+		`CREATE INDEX idx_job_runs_timestamp ON job_runs (Timestamp)`,
 		`DROP TRIGGER IF EXISTS trigger_delete_expired_jobsets`,
 	}
 
@@ -126,7 +145,34 @@ func (s *JSRepoSQLite) GetJobStatus(ctx context.Context, jobId string) (*js.JobS
 		return nil, err
 	}
 
-	return &js.JobServiceResponse{Error: jobError, State: jobJSRState}, nil
+	runHistory, err := s.getJobRunHistory(jobId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &js.JobServiceResponse{Error: jobError, State: jobJSRState, RunHistory: runHistory}, nil
+}
+
+func (s *JSRepoSQLite) getJobRunHistory(jobId string) ([]*js.JobRunAttempt, error) {
+	sqlStmt := `SELECT ClusterId, NodeName, Leased, Started, Finished, Outcome, ExitReason
+		FROM job_runs WHERE JobId = ? ORDER BY Attempt`
+
+	rows, err := s.db.Query(sqlStmt, jobId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var runHistory []*js.JobRunAttempt
+	for rows.Next() {
+		var clusterId, nodeName, outcome, exitReason string
+		var leased, started, finished int64
+		if err := rows.Scan(&clusterId, &nodeName, &leased, &started, &finished, &outcome, &exitReason); err != nil {
+			return nil, err
+		}
+		runHistory = append(runHistory, jobRunAttemptFromRow(clusterId, nodeName, leased, started, finished, outcome, exitReason))
+	}
+	return runHistory, rows.Err()
 }
 
 // Update database with JobTable.
@@ -147,6 +193,57 @@ func (s *JSRepoSQLite) UpdateJobServiceDb(ctx context.Context, jobTable *JobStat
 	return errExec
 }
 
+// Record a change to the run history of a job.
+func (s *JSRepoSQLite) UpdateJobRunHistory(ctx context.Context, event *JobRunEvent) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// The latest attempt that's still in progress, if any.
+	var attempt, started int64
+	inProgress := true
+	row := tx.QueryRow(`SELECT Attempt, Started FROM job_runs WHERE JobId = ? AND Outcome = ?
+		ORDER BY Attempt DESC LIMIT 1`, event.JobId, js.JobRunAttempt_IN_PROGRESS.String())
+	if err := row.Scan(&attempt, &started); err == sql.ErrNoRows {
+		inProgress = false
+	} else if err != nil {
+		return err
+	}
+
+	now := time.Now().Unix()
+	switch event.change(inProgress, started != 0) {
+	case jobRunChangeNone:
+		return nil
+	case jobRunChangeInsert:
+		leasedTime, startedTime, finishedTime := event.newAttemptTimes()
+		_, err = tx.Exec(`INSERT INTO job_runs SELECT ?, ?, ?, COALESCE(MAX(Attempt), 0) + 1, ?, ?, ?, ?, ?, ?, ?, ?
+			FROM job_runs WHERE JobId = ?`,
+			event.Queue, event.JobSetId, event.JobId, event.ClusterId, event.NodeName,
+			leasedTime, startedTime, finishedTime, event.Outcome.String(), event.ExitReason, now, event.JobId)
+	case jobRunChangeUpdate:
+		if event.Type == JobRunStarted {
+			_, err = tx.Exec(`UPDATE job_runs SET ClusterId = COALESCE(NULLIF(ClusterId, ''), ?), NodeName = ?,
+				Started = ?, Timestamp = ? WHERE JobId = ? AND Attempt = ?`,
+				event.ClusterId, event.NodeName, unixNano(event.Time), now, event.JobId, attempt)
+		} else {
+			_, err = tx.Exec(`UPDATE job_runs SET ClusterId = COALESCE(NULLIF(ClusterId, ''), ?),
+				NodeName = COALESCE(NULLIF(NodeName, ''), ?), Finished = ?, Outcome = ?, ExitReason = ?, Timestamp = ?
+				WHERE JobId = ? AND Attempt = ?`,
+				event.ClusterId, event.NodeName, unixNano(event.Time), event.Outcome.String(), event.ExitReason, now,
+				event.JobId, attempt)
+		}
+	}
+	if err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
 // We should check if a JobSet exists first before updating the database and return an error if it doesn't exist.
 // However, The only caller of this function, in jobservice/server/server.go, does this check before calling.
 // Adding the check here will be redundant and a performance botteneck.
@@ -326,6 +423,10 @@ func (s *JSRepoSQLite) DeleteJobsInJobSet(ctx context.Context, queue string, job
 	s.lock.Lock()
 	defer s.lock.Unlock()
 
+	if _, err := s.db.Exec("DELETE FROM job_runs WHERE Queue = ? AND JobSetId = ?", queue, jobSet); err != nil {
+		return 0, err
+	}
+
 	sqlStmt := "DELETE FROM jobs WHERE Queue = ? AND JobSetId = ?"
 
 	result, err := s.db.Exec(sqlStmt, queue, jobSet)
@@ -366,6 +467,7 @@ func (s *JSRepoSQLite) GetSubscribedJobSets(ctx context.Context) ([]SubscribedTu
 func (s *JSRepoSQLite) PurgeExpiredJobSets(ctx context.Context) {
 	jobSetStmt := fmt.Sprintf(`DELETE FROM jobsets WHERE Timestamp < (UNIXEPOCH() - %d);`, s.jobServiceConfig.PurgeJobSetTime)
 	jobStmt := fmt.Sprintf(`DELETE FROM jobs WHERE Timestamp < (UNIXEPOCH() - %d);`, s.jobServiceConfig.PurgeJobSetTime)
+	jobRunStmt := fmt.Sprintf(`DELETE FROM job_runs WHERE Timestamp < (UNIXEPOCH() - %d);`, s.jobServiceConfig.PurgeJobSetTime)
 	ticker := time.NewTicker(time.Duration(s.jobServiceConfig.PurgeJobSetTime) * time.Second)
 	log := log.WithField("JobService", "ExpiredJobSetsPurge")
 
@@ -392,6 +494,16 @@ func (s *JSRepoSQLite) PurgeExpiredJobSets(ctx context.Context) {
 			}
 			log.Debugf("Deleted %d expired jobs", count)
 		}
+		result, jobRunErr := s.db.Exec(jobRunStmt)
+		if jobRunErr != nil {
+			log.Error("error deleting expired job runs: ", jobRunErr)
+		} else {
+			count, err := result.RowsAffected()
+			if err != nil {
+				log.Error("error getting affected rows for expired job runs delete operation: ", err)
+			}
+			log.Debugf("Deleted %d expired job runs", count)
+		}
 		s.lock.Unlock()
 	}
 }
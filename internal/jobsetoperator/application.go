@@ -0,0 +1,33 @@
+package jobsetoperator
+
+import (
+	"github.com/pkg/errors"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/cluster"
+	"github.com/armadaproject/armada/internal/jobsetoperator/configuration"
+	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/client"
+)
+
+// Run reconciles JobSets with Armada until ctx is cancelled.
+func Run(ctx *armadacontext.Context, config *configuration.JobSetOperatorConfig) error {
+	kubernetesClientProvider, err := cluster.NewKubernetesClientProvider(false, config.Kubernetes.QPS, config.Kubernetes.Burst)
+	if err != nil {
+		return errors.WithMessage(err, "failed to connect to kubernetes")
+	}
+	kubernetesClient, err := dynamic.NewForConfig(kubernetesClientProvider.ClientConfig())
+	if err != nil {
+		return errors.WithMessage(err, "failed to connect to kubernetes")
+	}
+
+	conn, err := client.CreateApiConnection(&config.ApiConnection)
+	if err != nil {
+		return errors.WithMessage(err, "failed to connect to armada")
+	}
+	defer conn.Close()
+
+	controller := NewController(kubernetesClient, api.NewSubmitClient(conn), api.NewQueryClient(conn), config.AllowedQueues)
+	return controller.Run(ctx, config.Namespace, config.ResyncPeriod, config.Workers)
+}
@@ -0,0 +1,29 @@
+package configuration
+
+import (
+	"time"
+
+	"github.com/armadaproject/armada/pkg/client"
+)
+
+type JobSetOperatorConfig struct {
+	MetricsPort uint16
+	// Connection details of the Armada server the jobs of JobSets are submitted to.
+	// Jobs are submitted with these credentials, so access to JobSets should be restricted with Kubernetes RBAC.
+	ApiConnection client.ApiConnectionDetails
+	// Queues the JobSets of each namespace may submit to, by namespace. Since the jobs of all JobSets are submitted
+	// with the same credentials, JobSets submitting to queues not listed for their namespace are marked invalid.
+	AllowedQueues map[string][]string
+	// Namespace whose JobSets are reconciled; JobSets of all namespaces are reconciled if empty.
+	Namespace string
+	// Interval at which JobSets are reconciled even if they didn't change, which refreshes the states of their jobs.
+	ResyncPeriod time.Duration
+	// Number of JobSets reconciled concurrently.
+	Workers    int
+	Kubernetes KubernetesConfiguration
+}
+
+type KubernetesConfiguration struct {
+	Burst int
+	QPS   float32
+}
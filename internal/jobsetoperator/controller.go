@@ -0,0 +1,287 @@
+package jobsetoperator
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/exp/slices"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/client"
+)
+
+// Time after which reconciling a JobSet is abandoned and retried, e.g., because Armada is unavailable.
+const reconcileTimeout = time.Minute
+
+// Controller submits the jobs of JobSets to Armada, cancels them when JobSets are changed or deleted, and reflects
+// their states in the status of JobSets.
+type Controller struct {
+	client       dynamic.Interface
+	submitClient api.SubmitClient
+	queryClient  api.QueryClient
+	// Queues the JobSets of each namespace may submit to, by namespace.
+	allowedQueues map[string][]string
+	queue         workqueue.RateLimitingInterface
+}
+
+func NewController(client dynamic.Interface, submitClient api.SubmitClient, queryClient api.QueryClient, allowedQueues map[string][]string) *Controller {
+	return &Controller{
+		client:        client,
+		submitClient:  submitClient,
+		queryClient:   queryClient,
+		allowedQueues: allowedQueues,
+		queue:         workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "jobsets"),
+	}
+}
+
+// Run reconciles the JobSets of namespace, or of all namespaces if empty, with the given number of workers until ctx
+// is cancelled. Besides when they change, JobSets are reconciled every resyncPeriod, which refreshes the states of
+// their jobs.
+func (c *Controller) Run(ctx *armadacontext.Context, namespace string, resyncPeriod time.Duration, workers int) error {
+	defer c.queue.ShutDown()
+	factory := dynamicinformer.NewFilteredDynamicSharedInformerFactory(c.client, resyncPeriod, namespace, nil)
+	informer := factory.ForResource(JobSetResource).Informer()
+	informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.enqueue,
+		UpdateFunc: func(_, obj interface{}) { c.enqueue(obj) },
+	})
+	factory.Start(ctx.Done())
+	if !cache.WaitForCacheSync(ctx.Done(), informer.HasSynced) {
+		return errors.New("failed to sync JobSets")
+	}
+	log.Infof("Reconciling JobSets with %d workers", workers)
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for c.processNextJobSet(ctx) {
+			}
+		}()
+	}
+	<-ctx.Done()
+	c.queue.ShutDown()
+	wg.Wait()
+	return nil
+}
+
+func (c *Controller) enqueue(obj interface{}) {
+	key, err := cache.MetaNamespaceKeyFunc(obj)
+	if err != nil {
+		log.WithError(err).Error("failed to get key of JobSet")
+		return
+	}
+	c.queue.Add(key)
+}
+
+func (c *Controller) processNextJobSet(ctx *armadacontext.Context) bool {
+	key, shutdown := c.queue.Get()
+	if shutdown {
+		return false
+	}
+	defer c.queue.Done(key)
+	ctx, cancel := armadacontext.WithTimeout(ctx, reconcileTimeout)
+	defer cancel()
+	if err := c.reconcile(ctx, key.(string)); err != nil {
+		log.WithError(err).Warnf("failed to reconcile JobSet %s; retrying", key)
+		c.queue.AddRateLimited(key)
+		return true
+	}
+	c.queue.Forget(key)
+	return true
+}
+
+// reconcile brings the jobs of the JobSet with the given key in line with its spec and updates its status.
+func (c *Controller) reconcile(ctx *armadacontext.Context, key string) error {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	resource := c.client.Resource(JobSetResource).Namespace(namespace)
+	obj, err := resource.Get(ctx, name, metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return errors.WithStack(err)
+	}
+	jobSet, err := jobSetFromUnstructured(obj)
+	if err != nil {
+		return err
+	}
+
+	if jobSet.DeletionTimestamp != nil {
+		if !slices.Contains(obj.GetFinalizers(), jobSetFinalizer) {
+			return nil
+		}
+		if err := c.cancelJobs(ctx, jobSet.Status, fmt.Sprintf("JobSet %s was deleted", key)); err != nil {
+			return err
+		}
+		var finalizers []string
+		for _, finalizer := range obj.GetFinalizers() {
+			if finalizer != jobSetFinalizer {
+				finalizers = append(finalizers, finalizer)
+			}
+		}
+		obj.SetFinalizers(finalizers)
+		_, err := resource.Update(ctx, obj, metav1.UpdateOptions{})
+		return errors.WithStack(err)
+	}
+	if !slices.Contains(obj.GetFinalizers(), jobSetFinalizer) {
+		obj.SetFinalizers(append(obj.GetFinalizers(), jobSetFinalizer))
+		if obj, err = resource.Update(ctx, obj, metav1.UpdateOptions{}); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+
+	jobSetStatus, reconcileErr := c.reconcileJobs(ctx, key, jobSet)
+	if !reflect.DeepEqual(jobSetStatus, jobSet.Status) {
+		if err := setStatus(obj, jobSetStatus); err != nil {
+			return err
+		}
+		if _, err := resource.UpdateStatus(ctx, obj, metav1.UpdateOptions{}); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+	return reconcileErr
+}
+
+// reconcileJobs submits the jobs of the spec of jobSet if they weren't submitted yet, cancelling those of a previous
+// spec, or otherwise refreshes the states of its jobs. It returns the resulting status of jobSet.
+func (c *Controller) reconcileJobs(ctx *armadacontext.Context, key string, jobSet *JobSet) (JobSetStatus, error) {
+	if jobSet.Status.ObservedGeneration != jobSet.Generation {
+		// The jobs submitted by a failed submission of this generation are kept, as they're deduplicated when it's retried.
+		if jobSet.Status.SubmittingGeneration == jobSet.Generation {
+			return c.submitJobs(ctx, key, jobSet)
+		}
+		if err := c.cancelJobs(ctx, jobSet.Status, fmt.Sprintf("JobSet %s was changed", key)); err != nil {
+			jobSetStatus := jobSet.Status
+			jobSetStatus.Message = fmt.Sprintf("failed to cancel jobs of previous spec: %s", err)
+			return jobSetStatus, err
+		}
+		return c.submitJobs(ctx, key, jobSet)
+	}
+
+	jobSetStatus := jobSet.Status
+	jobIds := jobSetStatus.activeJobIds()
+	if len(jobIds) == 0 {
+		return jobSetStatus, nil
+	}
+	response, err := c.queryClient.GetJobStatus(ctx, &api.JobStatusRequest{
+		Queue:    jobSetStatus.Queue,
+		JobSetId: jobSetStatus.JobSetId,
+		JobIds:   jobIds,
+	})
+	if err != nil {
+		return jobSetStatus, errors.WithStack(err)
+	}
+	states := make(map[string]string, len(response.JobStatuses))
+	for _, jobStatus := range response.JobStatuses {
+		// Jobs whose events weren't found yet, e.g., because they were only just submitted, keep their state.
+		if state, ok := jobStateNames[jobStatus.State]; ok {
+			states[jobStatus.JobId] = state
+		}
+	}
+	jobSetStatus.Jobs = slices.Clone(jobSetStatus.Jobs)
+	for i, job := range jobSetStatus.Jobs {
+		if state, ok := states[job.JobId]; ok {
+			jobSetStatus.Jobs[i].State = state
+		}
+	}
+	jobSetStatus.Phase = jobSetStatus.jobsPhase()
+	return jobSetStatus, nil
+}
+
+// submitJobs submits the jobs of jobSet. Jobs are submitted all or none: if some are rejected, those already
+// submitted are cancelled and the JobSet is marked invalid until its spec changes. Other errors are returned, such
+// that submission is retried; jobs submitted before are kept in the status, such that they're cancelled if the
+// JobSet changes or is deleted in the meantime, and are otherwise deduplicated by their client ids.
+func (c *Controller) submitJobs(ctx *armadacontext.Context, key string, jobSet *JobSet) (JobSetStatus, error) {
+	jobSetStatus := JobSetStatus{
+		ObservedGeneration: jobSet.Generation,
+		Queue:              jobSet.Spec.Queue,
+		JobSetId:           jobSet.jobSetId(),
+	}
+	if err := jobSet.validate(); err != nil {
+		jobSetStatus.Phase = PhaseInvalid
+		jobSetStatus.Message = err.Error()
+		return jobSetStatus, nil
+	}
+	if !slices.Contains(c.allowedQueues[jobSet.Namespace], jobSet.Spec.Queue) {
+		jobSetStatus.Phase = PhaseInvalid
+		jobSetStatus.Message = fmt.Sprintf("JobSets of namespace %s may not submit to queue %s", jobSet.Namespace, jobSet.Spec.Queue)
+		return jobSetStatus, nil
+	}
+
+	for i, item := range jobSet.Spec.Jobs {
+		item.ClientId = jobSet.clientId(i)
+	}
+	for _, request := range client.CreateChunkedSubmitRequests(jobSetStatus.Queue, jobSetStatus.JobSetId, jobSet.Spec.Jobs) {
+		response, err := c.submitClient.SubmitJobs(ctx, request)
+		if err != nil {
+			message := fmt.Sprintf("failed to submit jobs: %s", err)
+			if response != nil {
+				for _, item := range response.JobResponseItems {
+					if item.Failed() {
+						message = fmt.Sprintf("failed to submit jobs: %s", item.ErrorString())
+						break
+					}
+				}
+			}
+			if status.Code(err) == codes.InvalidArgument {
+				if err := c.cancelJobs(ctx, jobSetStatus, fmt.Sprintf("other jobs of JobSet %s were rejected", key)); err == nil {
+					jobSetStatus.Phase = PhaseInvalid
+					jobSetStatus.Message = message
+					jobSetStatus.Jobs = nil
+					return jobSetStatus, nil
+				}
+			}
+			jobSetStatus.ObservedGeneration = jobSet.Status.ObservedGeneration
+			jobSetStatus.SubmittingGeneration = jobSet.Generation
+			jobSetStatus.Phase = PhaseSubmitting
+			jobSetStatus.Message = message
+			return jobSetStatus, errors.WithStack(err)
+		}
+		for _, item := range response.JobResponseItems {
+			jobSetStatus.Jobs = append(jobSetStatus.Jobs, JobStatus{JobId: item.JobId, State: jobStateNames[api.JobState_QUEUED]})
+		}
+	}
+	log.Infof(
+		"Submitted %d jobs of JobSet %s to job set %s of queue %s",
+		len(jobSetStatus.Jobs), key, jobSetStatus.JobSetId, jobSetStatus.Queue,
+	)
+	jobSetStatus.Phase = jobSetStatus.jobsPhase()
+	return jobSetStatus, nil
+}
+
+// cancelJobs cancels the jobs of jobSetStatus that haven't finished.
+func (c *Controller) cancelJobs(ctx *armadacontext.Context, jobSetStatus JobSetStatus, reason string) error {
+	jobIds := jobSetStatus.activeJobIds()
+	if len(jobIds) == 0 {
+		return nil
+	}
+	_, err := c.submitClient.CancelJobs(ctx, &api.JobCancelRequest{
+		Queue:    jobSetStatus.Queue,
+		JobSetId: jobSetStatus.JobSetId,
+		JobIds:   jobIds,
+		Reason:   reason,
+	})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	log.Infof("Cancelled %d jobs of job set %s of queue %s: %s", len(jobIds), jobSetStatus.JobSetId, jobSetStatus.Queue, reason)
+	return nil
+}
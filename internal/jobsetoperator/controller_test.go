@@ -0,0 +1,299 @@
+package jobsetoperator
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/fake"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/client"
+)
+
+func TestReconcile_SubmitsJobsAndReflectsTheirStates(t *testing.T) {
+	c, submitClient, queryClient := newTestController(newJobSet(1, "queue-a", 2))
+
+	require.NoError(t, c.reconcile(armadacontext.Background(), "ns/training"))
+	obj, jobSet := getJobSet(t, c)
+	assert.Equal(t, []string{jobSetFinalizer}, obj.GetFinalizers())
+	assert.Equal(t, JobSetStatus{
+		ObservedGeneration: 1,
+		Phase:              PhaseQueued,
+		Queue:              "queue-a",
+		JobSetId:           "training",
+		Jobs:               []JobStatus{{JobId: "job-0", State: "Queued"}, {JobId: "job-1", State: "Queued"}},
+	}, jobSet.Status)
+	assert.Equal(t, []string{"uid-1-0", "uid-1-1"}, submitClient.clientIds())
+
+	queryClient.states = map[string]api.JobState{"job-0": api.JobState_RUNNING, "job-1": api.JobState_UNKNOWN}
+	require.NoError(t, c.reconcile(armadacontext.Background(), "ns/training"))
+	_, jobSet = getJobSet(t, c)
+	assert.Equal(t, PhaseRunning, jobSet.Status.Phase)
+	assert.Equal(t, []JobStatus{{JobId: "job-0", State: "Running"}, {JobId: "job-1", State: "Queued"}}, jobSet.Status.Jobs)
+
+	queryClient.states = map[string]api.JobState{"job-0": api.JobState_SUCCEEDED, "job-1": api.JobState_FAILED}
+	require.NoError(t, c.reconcile(armadacontext.Background(), "ns/training"))
+	_, jobSet = getJobSet(t, c)
+	assert.Equal(t, PhaseFailed, jobSet.Status.Phase)
+
+	// JobSets whose jobs finished are left alone.
+	queryClient.requests = 0
+	require.NoError(t, c.reconcile(armadacontext.Background(), "ns/training"))
+	assert.Equal(t, 0, queryClient.requests)
+	assert.Len(t, submitClient.submitted, 2)
+	assert.Empty(t, submitClient.cancelled)
+}
+
+func TestReconcile_ChangedSpecReplacesJobs(t *testing.T) {
+	c, submitClient, _ := newTestController(newJobSet(1, "queue-a", 1))
+	require.NoError(t, c.reconcile(armadacontext.Background(), "ns/training"))
+
+	obj, _ := getJobSet(t, c)
+	obj.SetGeneration(2)
+	require.NoError(t, unstructured.SetNestedSlice(obj.Object, []interface{}{
+		map[string]interface{}{"priority": int64(1)},
+		map[string]interface{}{"priority": int64(2)},
+	}, "spec", "jobs"))
+	_, err := c.client.Resource(JobSetResource).Namespace("ns").Update(context.Background(), obj, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	require.NoError(t, c.reconcile(armadacontext.Background(), "ns/training"))
+	_, jobSet := getJobSet(t, c)
+	assert.Equal(t, []*api.JobCancelRequest{{
+		Queue:    "queue-a",
+		JobSetId: "training",
+		JobIds:   []string{"job-0"},
+		Reason:   "JobSet ns/training was changed",
+	}}, submitClient.cancelled)
+	assert.Equal(t, []string{"uid-1-0", "uid-2-0", "uid-2-1"}, submitClient.clientIds())
+	assert.Equal(t, int64(2), jobSet.Status.ObservedGeneration)
+	assert.Equal(t, []JobStatus{{JobId: "job-1", State: "Queued"}, {JobId: "job-2", State: "Queued"}}, jobSet.Status.Jobs)
+}
+
+func TestReconcile_DeletedJobSetCancelsJobs(t *testing.T) {
+	c, submitClient, _ := newTestController(newJobSet(1, "queue-a", 1))
+	require.NoError(t, c.reconcile(armadacontext.Background(), "ns/training"))
+
+	obj, _ := getJobSet(t, c)
+	now := metav1.Now()
+	obj.SetDeletionTimestamp(&now)
+	_, err := c.client.Resource(JobSetResource).Namespace("ns").Update(context.Background(), obj, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	require.NoError(t, c.reconcile(armadacontext.Background(), "ns/training"))
+	obj, _ = getJobSet(t, c)
+	assert.Empty(t, obj.GetFinalizers())
+	require.Len(t, submitClient.cancelled, 1)
+	assert.Equal(t, []string{"job-0"}, submitClient.cancelled[0].JobIds)
+}
+
+func TestReconcile_InvalidJobSets(t *testing.T) {
+	tests := map[string]struct {
+		jobSet    *unstructured.Unstructured
+		submitErr error
+		message   string
+	}{
+		"no queue": {
+			jobSet:  newJobSet(1, "", 1),
+			message: "spec.queue is required",
+		},
+		"malformed jobs": {
+			jobSet: func() *unstructured.Unstructured {
+				obj := newJobSet(1, "queue-a", 1)
+				obj.Object["spec"].(map[string]interface{})["jobs"] = "not a list"
+				return obj
+			}(),
+			message: "invalid spec: json: cannot unmarshal string into Go struct field JobSetSpec.jobs of type []*api.JobSubmitRequestItem",
+		},
+		"queue not allowed for namespace": {
+			jobSet:  newJobSet(1, "queue-b", 1),
+			message: "JobSets of namespace ns may not submit to queue queue-b",
+		},
+		"rejected jobs": {
+			jobSet:    newJobSet(1, "queue-a", 1),
+			submitErr: status.Error(codes.InvalidArgument, "[SubmitJobs] error validating jobs"),
+			message:   "failed to submit jobs: rpc error: code = InvalidArgument desc = [SubmitJobs] error validating jobs",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			c, submitClient, _ := newTestController(tc.jobSet)
+			submitClient.err = tc.submitErr
+
+			require.NoError(t, c.reconcile(armadacontext.Background(), "ns/training"))
+			_, jobSet := getJobSet(t, c)
+			assert.Equal(t, PhaseInvalid, jobSet.Status.Phase)
+			assert.Equal(t, tc.message, jobSet.Status.Message)
+			assert.Equal(t, int64(1), jobSet.Status.ObservedGeneration)
+		})
+	}
+}
+
+func TestReconcile_RetriesFailedSubmissions(t *testing.T) {
+	c, submitClient, _ := newTestController(newJobSet(1, "queue-a", 1))
+	submitClient.err = status.Error(codes.Unavailable, "connection refused")
+
+	assert.Error(t, c.reconcile(armadacontext.Background(), "ns/training"))
+	_, jobSet := getJobSet(t, c)
+	assert.Equal(t, PhaseSubmitting, jobSet.Status.Phase)
+	assert.Equal(t, "failed to submit jobs: rpc error: code = Unavailable desc = connection refused", jobSet.Status.Message)
+
+	submitClient.err = nil
+	require.NoError(t, c.reconcile(armadacontext.Background(), "ns/training"))
+	_, jobSet = getJobSet(t, c)
+	assert.Equal(t, PhaseQueued, jobSet.Status.Phase)
+	assert.Empty(t, jobSet.Status.Message)
+}
+
+func TestReconcile_RetriesPartiallyFailedSubmissions(t *testing.T) {
+	c, submitClient, _ := newTestController(newJobSet(1, "queue-a", client.MaxJobsPerRequest+1))
+	submitClient.err = status.Error(codes.Unavailable, "connection refused")
+	submitClient.requestsBeforeErr = 1
+
+	// The jobs submitted by the first request are kept, such that they're cancelled if the JobSet changes.
+	assert.Error(t, c.reconcile(armadacontext.Background(), "ns/training"))
+	_, jobSet := getJobSet(t, c)
+	assert.Equal(t, PhaseSubmitting, jobSet.Status.Phase)
+	assert.Equal(t, int64(1), jobSet.Status.SubmittingGeneration)
+	assert.Len(t, jobSet.Status.Jobs, client.MaxJobsPerRequest)
+
+	// Retrying doesn't cancel them, but resubmits them, which is deduplicated.
+	submitClient.err = nil
+	require.NoError(t, c.reconcile(armadacontext.Background(), "ns/training"))
+	_, jobSet = getJobSet(t, c)
+	assert.Equal(t, PhaseQueued, jobSet.Status.Phase)
+	assert.Equal(t, int64(1), jobSet.Status.ObservedGeneration)
+	assert.Zero(t, jobSet.Status.SubmittingGeneration)
+	assert.Len(t, jobSet.Status.Jobs, client.MaxJobsPerRequest+1)
+	assert.Len(t, submitClient.submitted, client.MaxJobsPerRequest+1)
+	assert.Empty(t, submitClient.cancelled)
+}
+
+func TestReconcile_ChangedSpecCancelsPartiallySubmittedJobs(t *testing.T) {
+	c, submitClient, _ := newTestController(newJobSet(1, "queue-a", client.MaxJobsPerRequest+1))
+	submitClient.err = status.Error(codes.Unavailable, "connection refused")
+	submitClient.requestsBeforeErr = 1
+	assert.Error(t, c.reconcile(armadacontext.Background(), "ns/training"))
+
+	obj, _ := getJobSet(t, c)
+	obj.SetGeneration(2)
+	_, err := c.client.Resource(JobSetResource).Namespace("ns").Update(context.Background(), obj, metav1.UpdateOptions{})
+	require.NoError(t, err)
+	submitClient.err = nil
+	require.NoError(t, c.reconcile(armadacontext.Background(), "ns/training"))
+	require.Len(t, submitClient.cancelled, 1)
+	assert.Len(t, submitClient.cancelled[0].JobIds, client.MaxJobsPerRequest)
+}
+
+func newTestController(objects ...runtime.Object) (*Controller, *fakeSubmitClient, *fakeQueryClient) {
+	kubernetesClient := fake.NewSimpleDynamicClientWithCustomListKinds(
+		runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{JobSetResource: "JobSetList"},
+		objects...,
+	)
+	submitClient := &fakeSubmitClient{jobIds: make(map[string]string)}
+	queryClient := &fakeQueryClient{}
+	return NewController(kubernetesClient, submitClient, queryClient, map[string][]string{"ns": {"queue-a"}}), submitClient, queryClient
+}
+
+func newJobSet(generation int64, queue string, numJobs int) *unstructured.Unstructured {
+	jobs := make([]interface{}, numJobs)
+	for i := range jobs {
+		jobs[i] = map[string]interface{}{"priority": int64(i), "namespace": "ns"}
+	}
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "armadaproject.io/v1beta1",
+		"kind":       "JobSet",
+		"metadata": map[string]interface{}{
+			"name":       "training",
+			"namespace":  "ns",
+			"uid":        "uid",
+			"generation": generation,
+		},
+		"spec": map[string]interface{}{
+			"queue": queue,
+			"jobs":  jobs,
+		},
+	}}
+	return obj
+}
+
+func getJobSet(t *testing.T, c *Controller) (*unstructured.Unstructured, *JobSet) {
+	obj, err := c.client.Resource(JobSetResource).Namespace("ns").Get(context.Background(), "training", metav1.GetOptions{})
+	require.NoError(t, err)
+	jobSet, err := jobSetFromUnstructured(obj)
+	require.NoError(t, err)
+	return obj, jobSet
+}
+
+type fakeSubmitClient struct {
+	api.SubmitClient
+	err error
+	// Number of requests that succeed before err is returned.
+	requestsBeforeErr int
+	// Ids of the jobs submitted, by client id.
+	jobIds    map[string]string
+	submitted []*api.JobSubmitRequestItem
+	cancelled []*api.JobCancelRequest
+}
+
+func (c *fakeSubmitClient) SubmitJobs(_ context.Context, req *api.JobSubmitRequest, _ ...grpc.CallOption) (*api.JobSubmitResponse, error) {
+	if c.err != nil && c.requestsBeforeErr == 0 {
+		return nil, c.err
+	}
+	c.requestsBeforeErr--
+	response := &api.JobSubmitResponse{}
+	for _, item := range req.JobRequestItems {
+		jobId, ok := c.jobIds[item.ClientId]
+		if !ok {
+			jobId = fmt.Sprintf("job-%d", len(c.jobIds))
+			c.jobIds[item.ClientId] = jobId
+			c.submitted = append(c.submitted, item)
+		}
+		response.JobResponseItems = append(response.JobResponseItems, &api.JobSubmitResponseItem{JobId: jobId})
+	}
+	return response, nil
+}
+
+func (c *fakeSubmitClient) CancelJobs(_ context.Context, req *api.JobCancelRequest, _ ...grpc.CallOption) (*api.CancellationResult, error) {
+	c.cancelled = append(c.cancelled, req)
+	return &api.CancellationResult{CancelledIds: req.JobIds}, nil
+}
+
+func (c *fakeSubmitClient) clientIds() []string {
+	var clientIds []string
+	for _, item := range c.submitted {
+		clientIds = append(clientIds, item.ClientId)
+	}
+	return clientIds
+}
+
+type fakeQueryClient struct {
+	api.QueryClient
+	states   map[string]api.JobState
+	requests int
+}
+
+func (c *fakeQueryClient) GetJobStatus(_ context.Context, req *api.JobStatusRequest, _ ...grpc.CallOption) (*api.JobStatusResponse, error) {
+	c.requests++
+	response := &api.JobStatusResponse{}
+	for _, jobId := range req.JobIds {
+		state, ok := c.states[jobId]
+		if !ok {
+			state = api.JobState_UNKNOWN
+		}
+		response.JobStatuses = append(response.JobStatuses, &api.JobStatus{JobId: jobId, State: state})
+	}
+	return response, nil
+}
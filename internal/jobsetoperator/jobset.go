@@ -0,0 +1,178 @@
+package jobsetoperator
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+// JobSetResource is the custom resource reconciled by the operator, as defined by
+// deployment/jobset-operator/crds/jobsets.yaml.
+var JobSetResource = schema.GroupVersionResource{Group: "armadaproject.io", Version: "v1beta1", Resource: "jobsets"}
+
+// Finalizer of JobSets, such that their jobs are cancelled before they're deleted.
+const jobSetFinalizer = "armadaproject.io/jobset-operator"
+
+// Phases of JobSets.
+const (
+	// The jobs of the JobSet are being submitted, or couldn't be submitted yet; see the message of the status.
+	PhaseSubmitting = "Submitting"
+	// The JobSet was rejected, e.g., because its jobs are invalid; see the message of the status.
+	PhaseInvalid   = "Invalid"
+	PhaseQueued    = "Queued"
+	PhaseRunning   = "Running"
+	PhaseSucceeded = "Succeeded"
+	PhaseFailed    = "Failed"
+	PhaseCancelled = "Cancelled"
+)
+
+// JobSet is a set of jobs declared as a Kubernetes resource, which the operator submits to Armada.
+type JobSet struct {
+	metav1.ObjectMeta
+	Spec   JobSetSpec
+	Status JobSetStatus
+	// Error parsing the spec, if any, such that JobSets with invalid specs can still be reported on and deleted.
+	specError error
+}
+
+// JobSetSpec is the jobs of a JobSet, in the format of the submit files of armadactl.
+// Changing the spec of a JobSet cancels its jobs and submits the jobs of the new spec.
+type JobSetSpec struct {
+	Queue string `json:"queue"`
+	// Defaults to the name of the JobSet.
+	JobSetId string                      `json:"jobSetId,omitempty"`
+	Jobs     []*api.JobSubmitRequestItem `json:"jobs"`
+}
+
+// JobSetStatus is the status of the jobs submitted for a JobSet.
+type JobSetStatus struct {
+	// Generation of the JobSet whose jobs were submitted.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Generation of the JobSet whose jobs are being submitted, if submitting them failed partway;
+	// Jobs then lists the jobs submitted so far.
+	SubmittingGeneration int64  `json:"submittingGeneration,omitempty"`
+	Phase                string `json:"phase,omitempty"`
+	Message              string `json:"message,omitempty"`
+	// Queue and job set the jobs were submitted to.
+	Queue    string      `json:"queue,omitempty"`
+	JobSetId string      `json:"jobSetId,omitempty"`
+	Jobs     []JobStatus `json:"jobs,omitempty"`
+}
+
+// JobStatus is the status of a job of a JobSet, in the order of the jobs of its spec.
+type JobStatus struct {
+	JobId string `json:"jobId"`
+	State string `json:"state"`
+}
+
+var jobStateNames = map[api.JobState]string{
+	api.JobState_QUEUED:    "Queued",
+	api.JobState_PENDING:   "Pending",
+	api.JobState_RUNNING:   "Running",
+	api.JobState_SUCCEEDED: "Succeeded",
+	api.JobState_FAILED:    "Failed",
+	api.JobState_SUSPENDED: "Suspended",
+	api.JobState_CANCELLED: "Cancelled",
+}
+
+func jobSetFromUnstructured(obj *unstructured.Unstructured) (*JobSet, error) {
+	data, err := obj.MarshalJSON()
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	var fields struct {
+		Metadata metav1.ObjectMeta `json:"metadata"`
+		Spec     json.RawMessage   `json:"spec"`
+		Status   JobSetStatus      `json:"status"`
+	}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, errors.Wrapf(err, "invalid JobSet %s/%s", obj.GetNamespace(), obj.GetName())
+	}
+	jobSet := &JobSet{ObjectMeta: fields.Metadata, Status: fields.Status}
+	if err := json.Unmarshal(fields.Spec, &jobSet.Spec); err != nil {
+		jobSet.specError = fmt.Errorf("invalid spec: %s", err)
+	}
+	return jobSet, nil
+}
+
+func setStatus(obj *unstructured.Unstructured, status JobSetStatus) error {
+	fields, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&status)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	obj.Object["status"] = fields
+	return nil
+}
+
+// validate returns an error if the spec of jobSet can't be submitted.
+func (jobSet *JobSet) validate() error {
+	if jobSet.specError != nil {
+		return jobSet.specError
+	}
+	if jobSet.Spec.Queue == "" {
+		return fmt.Errorf("spec.queue is required")
+	}
+	if len(jobSet.Spec.Jobs) == 0 {
+		return fmt.Errorf("spec.jobs must contain at least one job")
+	}
+	return nil
+}
+
+func (jobSet *JobSet) jobSetId() string {
+	if jobSet.Spec.JobSetId != "" {
+		return jobSet.Spec.JobSetId
+	}
+	return jobSet.Name
+}
+
+// clientId returns the client id of the job of the current generation of jobSet at index i, such that resubmitting
+// the job, e.g., after a partially failed submission, doesn't create another job.
+func (jobSet *JobSet) clientId(i int) string {
+	return fmt.Sprintf("%s-%d-%d", jobSet.UID, jobSet.Generation, i)
+}
+
+func isTerminal(state string) bool {
+	return state == jobStateNames[api.JobState_SUCCEEDED] ||
+		state == jobStateNames[api.JobState_FAILED] ||
+		state == jobStateNames[api.JobState_CANCELLED]
+}
+
+// activeJobIds returns the ids of the jobs of status that haven't finished.
+func (status *JobSetStatus) activeJobIds() []string {
+	var jobIds []string
+	for _, job := range status.Jobs {
+		if !isTerminal(job.State) {
+			jobIds = append(jobIds, job.JobId)
+		}
+	}
+	return jobIds
+}
+
+// jobsPhase returns the phase of a JobSet with the jobs of status: Running if any job is leased or running, Queued if
+// any other job hasn't finished, and otherwise Failed if any job failed, Cancelled if any job was cancelled, or
+// Succeeded.
+func (status *JobSetStatus) jobsPhase() string {
+	counts := make(map[string]int)
+	for _, job := range status.Jobs {
+		counts[job.State]++
+	}
+	switch {
+	case counts[jobStateNames[api.JobState_RUNNING]] > 0 || counts[jobStateNames[api.JobState_PENDING]] > 0:
+		return PhaseRunning
+	case len(status.activeJobIds()) > 0:
+		return PhaseQueued
+	case counts[jobStateNames[api.JobState_FAILED]] > 0:
+		return PhaseFailed
+	case counts[jobStateNames[api.JobState_CANCELLED]] > 0:
+		return PhaseCancelled
+	default:
+		return PhaseSucceeded
+	}
+}
@@ -132,6 +132,17 @@ func (c *InstructionConverter) convertSequence(
 		case *armadaevents.EventSequence_Event_CancelJobSet:
 		case *armadaevents.EventSequence_Event_ResourceUtilisation:
 		case *armadaevents.EventSequence_Event_StandaloneIngressInfo:
+		case *armadaevents.EventSequence_Event_JobSetExpired:
+		case *armadaevents.EventSequence_Event_JobSuspended:
+		case *armadaevents.EventSequence_Event_JobResumed:
+		case *armadaevents.EventSequence_Event_JobEvictedForCapacity:
+		case *armadaevents.EventSequence_Event_JobRuntimeExceeded:
+		case *armadaevents.EventSequence_Event_JobResourcesNormalized:
+		case *armadaevents.EventSequence_Event_JobUnschedulable:
+		case *armadaevents.EventSequence_Event_JobMaintenanceWindowStarted:
+		case *armadaevents.EventSequence_Event_JobMaintenanceWindowEnded:
+		case *armadaevents.EventSequence_Event_JobCordoned:
+		case *armadaevents.EventSequence_Event_JobUncordoned:
 		case *armadaevents.EventSequence_Event_PartitionMarker:
 			log.Debugf("Ignoring event type %T", event.GetEvent())
 		default:
@@ -3,15 +3,19 @@
 package lookoutv2
 
 import (
+	"time"
+
 	"github.com/caarlos0/log"
 	"github.com/go-openapi/loads"
 	"github.com/go-openapi/runtime/middleware"
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/jessevdk/go-flags"
 	"github.com/sirupsen/logrus"
 
 	"github.com/armadaproject/armada/internal/common/armadacontext"
 	"github.com/armadaproject/armada/internal/common/compress"
 	"github.com/armadaproject/armada/internal/common/database"
+	"github.com/armadaproject/armada/internal/common/replica"
 	"github.com/armadaproject/armada/internal/common/util"
 	"github.com/armadaproject/armada/internal/lookoutv2/configuration"
 	"github.com/armadaproject/armada/internal/lookoutv2/conversions"
@@ -32,11 +36,23 @@ func Serve(configuration configuration.LookoutV2Config) error {
 		return err
 	}
 
-	getJobsRepo := repository.NewSqlGetJobsRepository(db)
-	groupJobsRepo := repository.NewSqlGroupJobsRepository(db)
 	decompressor := compress.NewThreadSafeZlibDecompressor()
-	getJobRunErrorRepo := repository.NewSqlGetJobRunErrorRepository(db, decompressor)
-	getJobSpecRepo := repository.NewSqlGetJobSpecRepository(db, decompressor)
+	readers := replica.NewPrimaryRouter(newRepositories(db, decompressor))
+	if len(configuration.PostgresReadReplica.Connection) > 0 {
+		replicaDb, err := database.OpenPgxPool(configuration.PostgresReadReplica)
+		if err != nil {
+			return err
+		}
+		readers = replica.NewRouter(
+			readers.Primary(),
+			newRepositories(replicaDb, decompressor),
+			func(ctx *armadacontext.Context) (time.Duration, error) {
+				return database.ReplicationLag(ctx, replicaDb)
+			},
+			configuration.ReadReplicaMaxStaleness,
+			configuration.ReadReplicaStalenessCheckInterval,
+		)
+	}
 
 	// create new service API
 	api := operations.NewLookoutAPI(swaggerSpec)
@@ -53,7 +69,7 @@ func Serve(configuration configuration.LookoutV2Config) error {
 		func(params operations.GetJobsParams) middleware.Responder {
 			filters := util.Map(params.GetJobsRequest.Filters, conversions.FromSwaggerFilter)
 			order := conversions.FromSwaggerOrder(params.GetJobsRequest.Order)
-			result, err := getJobsRepo.GetJobs(
+			result, err := readers.Reader().getJobs.GetJobs(
 				armadacontext.New(params.HTTPRequest.Context(), logger),
 				filters,
 				params.GetJobsRequest.ActiveJobSets,
@@ -75,7 +91,7 @@ func Serve(configuration configuration.LookoutV2Config) error {
 		func(params operations.GroupJobsParams) middleware.Responder {
 			filters := util.Map(params.GroupJobsRequest.Filters, conversions.FromSwaggerFilter)
 			order := conversions.FromSwaggerOrder(params.GroupJobsRequest.Order)
-			result, err := groupJobsRepo.GroupBy(
+			result, err := readers.Reader().groupJobs.GroupBy(
 				armadacontext.New(params.HTTPRequest.Context(), logger),
 				filters,
 				params.GroupJobsRequest.ActiveJobSets,
@@ -98,7 +114,7 @@ func Serve(configuration configuration.LookoutV2Config) error {
 	api.GetJobRunErrorHandler = operations.GetJobRunErrorHandlerFunc(
 		func(params operations.GetJobRunErrorParams) middleware.Responder {
 			ctx := armadacontext.New(params.HTTPRequest.Context(), logger)
-			result, err := getJobRunErrorRepo.GetJobRunError(ctx, params.GetJobRunErrorRequest.RunID)
+			result, err := readers.Reader().getJobRunError.GetJobRunError(ctx, params.GetJobRunErrorRequest.RunID)
 			if err != nil {
 				return operations.NewGetJobRunErrorBadRequest().WithPayload(conversions.ToSwaggerError(err.Error()))
 			}
@@ -111,7 +127,7 @@ func Serve(configuration configuration.LookoutV2Config) error {
 	api.GetJobSpecHandler = operations.GetJobSpecHandlerFunc(
 		func(params operations.GetJobSpecParams) middleware.Responder {
 			ctx := armadacontext.New(params.HTTPRequest.Context(), logger)
-			result, err := getJobSpecRepo.GetJobSpec(ctx, params.GetJobSpecRequest.JobID)
+			result, err := readers.Reader().getJobSpec.GetJobSpec(ctx, params.GetJobSpecRequest.JobID)
 			if err != nil {
 				return operations.NewGetJobSpecBadRequest().WithPayload(conversions.ToSwaggerError(err.Error()))
 			}
@@ -146,3 +162,20 @@ func Serve(configuration configuration.LookoutV2Config) error {
 
 	return err
 }
+
+// repositories are the repositories used to serve queries, all of which read from the same database.
+type repositories struct {
+	getJobs        *repository.SqlGetJobsRepository
+	groupJobs      *repository.SqlGroupJobsRepository
+	getJobRunError *repository.SqlGetJobRunErrorRepository
+	getJobSpec     *repository.SqlGetJobSpecRepository
+}
+
+func newRepositories(db *pgxpool.Pool, decompressor compress.Decompressor) *repositories {
+	return &repositories{
+		getJobs:        repository.NewSqlGetJobsRepository(db),
+		groupJobs:      repository.NewSqlGroupJobsRepository(db),
+		getJobRunError: repository.NewSqlGetJobRunErrorRepository(db, decompressor),
+		getJobSpec:     repository.NewSqlGetJobSpecRepository(db, decompressor),
+	}
+}
@@ -15,6 +15,13 @@ type LookoutV2Config struct {
 	Tls                TlsConfig
 
 	Postgres configuration.PostgresConfig
+	// Optional read replica of Postgres. If provided, queries go to the replica
+	// while it lags behind the primary by no more than ReadReplicaMaxStaleness.
+	PostgresReadReplica configuration.PostgresConfig
+	// Queries go to the primary while the read replica lags behind it by more than ReadReplicaMaxStaleness.
+	ReadReplicaMaxStaleness time.Duration
+	// How often the replication lag of the read replica is checked.
+	ReadReplicaStalenessCheckInterval time.Duration
 
 	PrunerConfig PrunerConfig
 
@@ -9,9 +9,14 @@ import (
 	"github.com/gogo/protobuf/types"
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/clock"
 
+	"github.com/armadaproject/armada/internal/armada/configuration"
 	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/auth/authorization"
 	"github.com/armadaproject/armada/internal/common/compress"
 	"github.com/armadaproject/armada/internal/common/logging"
 	"github.com/armadaproject/armada/internal/common/pulsarutils"
@@ -24,6 +29,12 @@ import (
 	"github.com/armadaproject/armada/pkg/executorapi"
 )
 
+// ExecutorCredentialMethods are the methods requests authenticated with executor credentials may call.
+var ExecutorCredentialMethods = []string{
+	"/executorapi.ExecutorApi/LeaseJobRuns",
+	"/executorapi.ExecutorApi/ReportEvents",
+}
+
 // ExecutorApi is the gRPC service executors use to synchronise their state with that of the scheduler.
 type ExecutorApi struct {
 	// Used to send Pulsar messages when, e.g., executors report a job has finished.
@@ -42,7 +53,9 @@ type ExecutorApi struct {
 	nodeIdLabel string
 	// See scheduling schedulingConfig.
 	priorityClassNameOverride *string
-	clock                     clock.Clock
+	// See scheduling schedulingConfig.
+	poolTemplates map[string]configuration.PoolTemplate
+	clock         clock.Clock
 }
 
 func NewExecutorApi(producer pulsar.Producer,
@@ -52,6 +65,7 @@ func NewExecutorApi(producer pulsar.Producer,
 	allowedPriorities []int32,
 	nodeIdLabel string,
 	priorityClassNameOverride *string,
+	poolTemplates map[string]configuration.PoolTemplate,
 	maxPulsarMessageSizeBytes uint,
 ) (*ExecutorApi, error) {
 	if len(allowedPriorities) == 0 {
@@ -66,6 +80,7 @@ func NewExecutorApi(producer pulsar.Producer,
 		maxPulsarMessageSizeBytes: maxPulsarMessageSizeBytes,
 		nodeIdLabel:               nodeIdLabel,
 		priorityClassNameOverride: priorityClassNameOverride,
+		poolTemplates:             poolTemplates,
 		clock:                     clock.RealClock{},
 	}, nil
 }
@@ -82,6 +97,9 @@ func (srv *ExecutorApi) LeaseJobRuns(stream executorapi.ExecutorApi_LeaseJobRuns
 	}
 
 	ctx := armadacontext.WithLogField(armadacontext.FromGrpcCtx(stream.Context()), "executor", req.ExecutorId)
+	if err := authorizeExecutor(ctx, req.ExecutorId); err != nil {
+		return err
+	}
 
 	executor := srv.executorFromLeaseRequest(ctx, req)
 	if err := srv.executorRepository.StoreExecutor(ctx, executor); err != nil {
@@ -134,6 +152,9 @@ func (srv *ExecutorApi) LeaseJobRuns(stream executorapi.ExecutorApi_LeaseJobRuns
 			srv.setPriorityClassName(submitMsg, *srv.priorityClassNameOverride)
 		}
 		srv.addNodeIdSelector(submitMsg, lease.Node)
+		if poolTemplate, ok := srv.poolTemplates[req.Pool]; ok {
+			applyPoolTemplate(submitMsg, poolTemplate)
+		}
 
 		var groups []string
 		if len(lease.Groups) > 0 {
@@ -195,6 +216,25 @@ func (srv *ExecutorApi) addNodeIdSelector(job *armadaevents.SubmitJob, nodeId st
 	}
 }
 
+func applyPoolTemplate(job *armadaevents.SubmitJob, template configuration.PoolTemplate) {
+	if job == nil {
+		return
+	}
+	var podSpec *v1.PodSpec
+	if job.MainObject != nil {
+		switch typed := job.MainObject.Object.(type) {
+		case *armadaevents.KubernetesMainObject_PodSpec:
+			if typed.PodSpec != nil {
+				podSpec = typed.PodSpec.PodSpec
+			}
+		}
+	}
+	if job.ObjectMeta == nil && len(template.Labels) > 0 {
+		job.ObjectMeta = &armadaevents.ObjectMeta{}
+	}
+	job.ObjectMeta.Labels = ApplyPoolTemplate(template, podSpec, job.ObjectMeta.Labels)
+}
+
 func addNodeSelector(podSpec *armadaevents.PodSpecWithAvoidList, key string, value string) {
 	if podSpec == nil || podSpec.PodSpec == nil || key == "" || value == "" {
 		return
@@ -216,15 +256,89 @@ func setPriorityClassName(podSpec *armadaevents.PodSpecWithAvoidList, priorityCl
 // ReportEvents publishes all events to Pulsar. The events are compacted for more efficient publishing.
 func (srv *ExecutorApi) ReportEvents(grpcCtx context.Context, list *executorapi.EventList) (*types.Empty, error) {
 	ctx := armadacontext.FromGrpcCtx(grpcCtx)
+	if err := srv.authorizeExecutorEvents(ctx, list.Events); err != nil {
+		return nil, err
+	}
 	err := pulsarutils.CompactAndPublishSequences(ctx, list.Events, srv.producer, srv.maxPulsarMessageSizeBytes, schedulers.Pulsar)
 	return &types.Empty{}, err
 }
 
+// authorizeExecutor returns a PermissionDenied error if the request is authenticated with an executor credential
+// minted for an executor other than executorId. Requests authenticated otherwise may act on behalf of any executor.
+func authorizeExecutor(ctx *armadacontext.Context, executorId string) error {
+	principal, ok := authorization.GetPrincipal(ctx).(*authorization.ExecutorPrincipal)
+	if !ok || principal.ExecutorId == executorId {
+		return nil
+	}
+	return status.Errorf(
+		codes.PermissionDenied,
+		"credential %s may only act on behalf of executor %s, not %s", principal.CredentialId, principal.ExecutorId, executorId,
+	)
+}
+
+// authorizeExecutorEvents returns a PermissionDenied error if the request is authenticated with an executor credential
+// and any of the events isn't of a run leased to its executor, since executors may only report on their own runs.
+func (srv *ExecutorApi) authorizeExecutorEvents(ctx *armadacontext.Context, sequences []*armadaevents.EventSequence) error {
+	principal, ok := authorization.GetPrincipal(ctx).(*authorization.ExecutorPrincipal)
+	if !ok {
+		return nil
+	}
+	var runIds []uuid.UUID
+	for _, sequence := range sequences {
+		for _, event := range sequence.Events {
+			runId := runIdOfExecutorEvent(event)
+			if runId == nil {
+				return status.Errorf(codes.PermissionDenied, "executors may not report events of type %T", event.Event)
+			}
+			runIds = append(runIds, armadaevents.UuidFromProtoUuid(runId))
+		}
+	}
+	if len(runIds) == 0 {
+		return nil
+	}
+	executors, err := srv.jobRepository.FindRunExecutors(ctx, runIds)
+	if err != nil {
+		return err
+	}
+	for _, runId := range runIds {
+		if executors[runId] != principal.ExecutorId {
+			return status.Errorf(codes.PermissionDenied, "run %s isn't leased to executor %s", runId, principal.ExecutorId)
+		}
+	}
+	return nil
+}
+
+// runIdOfExecutorEvent returns the id of the run of an event reported by executors, or nil if executors don't report
+// events of its type.
+func runIdOfExecutorEvent(event *armadaevents.EventSequence_Event) *armadaevents.Uuid {
+	switch e := event.GetEvent().(type) {
+	case *armadaevents.EventSequence_Event_JobRunAssigned:
+		return e.JobRunAssigned.GetRunId()
+	case *armadaevents.EventSequence_Event_JobRunRunning:
+		return e.JobRunRunning.GetRunId()
+	case *armadaevents.EventSequence_Event_JobRunSucceeded:
+		return e.JobRunSucceeded.GetRunId()
+	case *armadaevents.EventSequence_Event_JobRunErrors:
+		return e.JobRunErrors.GetRunId()
+	case *armadaevents.EventSequence_Event_JobRunPreempted:
+		return e.JobRunPreempted.GetPreemptedRunId()
+	case *armadaevents.EventSequence_Event_StandaloneIngressInfo:
+		return e.StandaloneIngressInfo.GetRunId()
+	case *armadaevents.EventSequence_Event_ResourceUtilisation:
+		return e.ResourceUtilisation.GetRunId()
+	default:
+		return nil
+	}
+}
+
 // executorFromLeaseRequest extracts a schedulerobjects.Executor from the request.
 func (srv *ExecutorApi) executorFromLeaseRequest(ctx *armadacontext.Context, req *executorapi.LeaseRequest) *schedulerobjects.Executor {
 	nodes := make([]*schedulerobjects.Node, 0, len(req.Nodes))
 	now := srv.clock.Now().UTC()
+	poolTemplate := srv.poolTemplates[req.Pool]
 	for _, nodeInfo := range req.Nodes {
+		// Taints tolerated by the template of the pool don't prevent jobs from being scheduled on the node.
+		RemovePoolTemplateTaints(nodeInfo, poolTemplate)
 		if node, err := api.NewNodeFromNodeInfo(nodeInfo, req.ExecutorId, srv.allowedPriorities, now); err != nil {
 			logging.WithStacktrace(ctx, err).Warnf(
 				"skipping node %s from executor %s", nodeInfo.GetName(), req.GetExecutorId(),
@@ -11,10 +11,13 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/clock"
 
 	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/auth/authorization"
 	"github.com/armadaproject/armada/internal/common/compress"
 	"github.com/armadaproject/armada/internal/common/mocks"
 	"github.com/armadaproject/armada/internal/common/pulsarutils"
@@ -206,6 +209,7 @@ func TestExecutorApi_LeaseJobRuns(t *testing.T) {
 				[]int32{1000, 2000},
 				"kubernetes.io/hostname",
 				nil,
+				nil,
 				4*1024*1024,
 			)
 			require.NoError(t, err)
@@ -333,6 +337,7 @@ func TestExecutorApi_Publish(t *testing.T) {
 				[]int32{1000, 2000},
 				"kubernetes.io/hostname",
 				nil,
+				nil,
 				4*1024*1024,
 			)
 
@@ -347,6 +352,67 @@ func TestExecutorApi_Publish(t *testing.T) {
 	}
 }
 
+func TestExecutorApi_ExecutorCredentialsAreBoundToTheirExecutor(t *testing.T) {
+	ctx := armadacontext.FromGrpcCtx(
+		authorization.WithPrincipal(armadacontext.Background(), authorization.NewExecutorPrincipal("cred-1", "test-executor", nil)),
+	)
+	ctrl := gomock.NewController(t)
+	mockPulsarProducer := mocks.NewMockProducer(ctrl)
+	mockJobRepository := schedulermocks.NewMockJobRepository(ctrl)
+	mockStream := schedulermocks.NewMockExecutorApi_LeaseJobRunsServer(ctrl)
+	server, err := NewExecutorApi(
+		mockPulsarProducer,
+		mockJobRepository,
+		schedulermocks.NewMockExecutorRepository(ctrl),
+		schedulermocks.NewMockExecutorRepository(ctrl),
+		[]int32{1000, 2000},
+		"kubernetes.io/hostname",
+		nil,
+		nil,
+		4*1024*1024,
+	)
+	require.NoError(t, err)
+
+	// Runs may not be leased on behalf of other executors.
+	mockStream.EXPECT().Context().Return(ctx).AnyTimes()
+	mockStream.EXPECT().Recv().Return(&executorapi.LeaseRequest{ExecutorId: "other-executor"}, nil).Times(1)
+	err = server.LeaseJobRuns(mockStream)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// Events may only be reported of runs leased to the executor.
+	ownRunId := uuid.New()
+	otherRunId := uuid.New()
+	mockJobRepository.
+		EXPECT().
+		FindRunExecutors(gomock.Any(), gomock.Any()).
+		Return(map[uuid.UUID]string{ownRunId: "test-executor", otherRunId: "other-executor"}, nil).
+		AnyTimes()
+	running := func(runId uuid.UUID) *armadaevents.EventSequence_Event {
+		return &armadaevents.EventSequence_Event{Event: &armadaevents.EventSequence_Event_JobRunRunning{
+			JobRunRunning: &armadaevents.JobRunRunning{RunId: armadaevents.ProtoUuidFromUuid(runId)},
+		}}
+	}
+	for name, events := range map[string][]*armadaevents.EventSequence_Event{
+		"run of other executor": {running(ownRunId), running(otherRunId)},
+		"unknown run":           {running(uuid.New())},
+		"non-executor event": {{Event: &armadaevents.EventSequence_Event_CancelJob{
+			CancelJob: &armadaevents.CancelJob{JobId: armadaevents.ProtoUuidFromUuid(uuid.New())},
+		}}},
+	} {
+		_, err = server.ReportEvents(ctx, &executorapi.EventList{Events: []*armadaevents.EventSequence{{Queue: "queue", JobSetName: "set", Events: events}}})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err), name)
+	}
+
+	mockPulsarProducer.
+		EXPECT().
+		SendAsync(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, msg *pulsar.ProducerMessage, callback func(pulsar.MessageID, *pulsar.ProducerMessage, error)) {
+			callback(pulsarutils.NewMessageId(1), msg, nil)
+		}).Times(1)
+	_, err = server.ReportEvents(ctx, &executorapi.EventList{Events: []*armadaevents.EventSequence{{Queue: "queue", JobSetName: "set", Events: []*armadaevents.EventSequence_Event{running(ownRunId)}}}})
+	assert.NoError(t, err)
+}
+
 func submitMsg(t *testing.T, nodeName string) (*armadaevents.SubmitJob, []byte) {
 	podSpec := &v1.PodSpec{}
 	if nodeName != "" {
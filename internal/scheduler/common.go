@@ -3,13 +3,18 @@ package scheduler
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/pkg/errors"
 	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+	"k8s.io/apimachinery/pkg/api/resource"
 
 	"github.com/armadaproject/armada/internal/armada/configuration"
 	armadamaps "github.com/armadaproject/armada/internal/common/maps"
+	armadaresource "github.com/armadaproject/armada/internal/common/resource"
 	armadaslices "github.com/armadaproject/armada/internal/common/slices"
+	"github.com/armadaproject/armada/internal/common/types"
 	schedulercontext "github.com/armadaproject/armada/internal/scheduler/context"
 	"github.com/armadaproject/armada/internal/scheduler/interfaces"
 	"github.com/armadaproject/armada/internal/scheduler/schedulerobjects"
@@ -63,6 +68,20 @@ func JobsSummary(jctxs []*schedulercontext.JobSchedulingContext) string {
 	)
 }
 
+// IsPreemptibleFromAnnotations returns true if the job with the provided annotations was submitted as preemptible.
+func IsPreemptibleFromAnnotations(annotations map[string]string) bool {
+	return annotations[configuration.PreemptibleAnnotation] == "true"
+}
+
+// isPreemptible returns true if job may be evicted to make room for higher-priority work,
+// i.e., if its priority class is preemptible or it was submitted as preemptible.
+func isPreemptible(priorityClasses map[string]types.PriorityClass, defaultPriorityClassName string, job interfaces.LegacySchedulerJob) bool {
+	if IsPreemptibleFromAnnotations(job.GetAnnotations()) {
+		return true
+	}
+	return interfaces.PriorityClassFromLegacySchedulerJob(priorityClasses, defaultPriorityClassName, job).Preemptible
+}
+
 // GangIdAndCardinalityFromLegacySchedulerJob returns a tuple (gangId, gangCardinality, gangMinimumCardinality, isGangJob, error).
 func GangIdAndCardinalityFromLegacySchedulerJob(job interfaces.LegacySchedulerJob) (string, int, int, bool, error) {
 	return GangIdAndCardinalityFromAnnotations(job.GetAnnotations())
@@ -106,3 +125,87 @@ func GangIdAndCardinalityFromAnnotations(annotations map[string]string) (string,
 		return gangId, gangCardinality, gangMinimumCardinality, true, nil
 	}
 }
+
+// BarrierIdAndCardinalityFromAnnotations returns a tuple (barrierId, barrierCardinality, isBarrierJob, error).
+func BarrierIdAndCardinalityFromAnnotations(annotations map[string]string) (string, int, bool, error) {
+	if annotations == nil {
+		return "", 0, false, nil
+	}
+	barrierId, ok := annotations[configuration.BarrierIdAnnotation]
+	if !ok {
+		return "", 0, false, nil
+	}
+	barrierCardinalityString, ok := annotations[configuration.BarrierCardinalityAnnotation]
+	if !ok {
+		return "", 0, false, errors.Errorf("missing annotation %s", configuration.BarrierCardinalityAnnotation)
+	}
+	barrierCardinality, err := strconv.Atoi(barrierCardinalityString)
+	if err != nil {
+		return "", 0, false, errors.WithStack(err)
+	}
+	if barrierCardinality <= 0 {
+		return "", 0, false, errors.Errorf("barrier cardinality is non-positive %d", barrierCardinality)
+	}
+	return barrierId, barrierCardinality, true, nil
+}
+
+// MaxConcurrentJobsFromAnnotations returns a tuple (maxConcurrentJobs, isThrottledJob, error).
+func MaxConcurrentJobsFromAnnotations(annotations map[string]string) (int, bool, error) {
+	if annotations == nil {
+		return 0, false, nil
+	}
+	maxConcurrentJobsString, ok := annotations[configuration.MaxConcurrentJobsAnnotation]
+	if !ok {
+		return 0, false, nil
+	}
+	maxConcurrentJobs, err := strconv.Atoi(maxConcurrentJobsString)
+	if err != nil {
+		return 0, false, errors.WithStack(err)
+	}
+	if maxConcurrentJobs <= 0 {
+		return 0, false, errors.Errorf("max concurrent jobs is non-positive %d", maxConcurrentJobs)
+	}
+	return maxConcurrentJobs, true, nil
+}
+
+// JobSetResourceLimitsFromAnnotations returns a tuple (jobSetResourceLimits, isLimitedJob, error).
+func JobSetResourceLimitsFromAnnotations(annotations map[string]string) (armadaresource.ComputeResources, bool, error) {
+	if annotations == nil {
+		return nil, false, nil
+	}
+	limitsString, ok := annotations[configuration.JobSetResourceLimitsAnnotation]
+	if !ok {
+		return nil, false, nil
+	}
+	limits := make(armadaresource.ComputeResources)
+	for _, pair := range strings.Split(limitsString, ",") {
+		name, quantityString, ok := strings.Cut(pair, "=")
+		if !ok || name == "" {
+			return nil, false, errors.Errorf("job set resource limit %q is not of the form resource=quantity", pair)
+		}
+		if _, ok := limits[name]; ok {
+			return nil, false, errors.Errorf("job set resource limit for %s is given more than once", name)
+		}
+		quantity, err := resource.ParseQuantity(quantityString)
+		if err != nil {
+			return nil, false, errors.WithMessagef(err, "invalid job set resource limit for %s", name)
+		}
+		if quantity.Sign() < 0 {
+			return nil, false, errors.Errorf("job set resource limit for %s is negative %s", name, quantityString)
+		}
+		limits[name] = quantity
+	}
+	return limits, true, nil
+}
+
+// JobSetResourceLimitsAnnotationValue returns the value of the job set resource limits annotation for limits.
+func JobSetResourceLimitsAnnotationValue(limits map[string]resource.Quantity) string {
+	names := maps.Keys(limits)
+	slices.Sort(names)
+	pairs := make([]string, len(names))
+	for i, name := range names {
+		quantity := limits[name]
+		pairs[i] = name + "=" + quantity.String()
+	}
+	return strings.Join(pairs, ",")
+}
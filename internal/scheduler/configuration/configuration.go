@@ -24,6 +24,8 @@ type Configuration struct {
 	Postgres configuration.PostgresConfig
 	// Redis Comnfig
 	Redis config.RedisConfig
+	// Where queues are read from; must match the configuration of the Armada server.
+	QueueRepository configuration.QueueRepositoryConfig
 	// General Pulsar configuration
 	Pulsar configuration.PulsarConfig
 	// Configuration controlling leader election
@@ -36,8 +38,10 @@ type Configuration struct {
 	// Scheduler configuration (this is shared with the old scheduler)
 	Scheduling configuration.SchedulingConfig
 	Auth       authconfig.AuthConfig
-	Grpc       grpcconfig.GrpcConfig
-	Http       HttpConfig
+	// Executor credentials minted by the Armada server are accepted in addition to the auth services of Auth.
+	ExecutorCredentials ExecutorCredentialsConfig
+	Grpc                grpcconfig.GrpcConfig
+	Http                HttpConfig
 	// If non-nil, net/http/pprof endpoints are exposed on localhost on this port.
 	PprofPort *uint16
 	// Maximum number of strings that should be cached at any one time
@@ -97,6 +101,14 @@ type PrometheusSummaryConfig struct {
 	MaxAge time.Duration
 }
 
+// ExecutorCredentialsConfig controls whether executors may authenticate with the credentials minted for them by the
+// Armada server, which are read from Redis. Executors authenticated this way may only lease and report on their own runs.
+type ExecutorCredentialsConfig struct {
+	Enabled bool
+	// Groups executors authenticating with executor credentials are members of.
+	Groups []string
+}
+
 type LeaderConfig struct {
 	// Valid modes are "standalone" or "kubernetes"
 	Mode string `validate:"required"`
@@ -51,6 +51,10 @@ type JobRepository interface {
 	// Runs are inactive if they don't exist or if they have succeeded, failed or been cancelled
 	FindInactiveRuns(ctx *armadacontext.Context, runIds []uuid.UUID) ([]uuid.UUID, error)
 
+	// FindRunExecutors returns the executor each of the provided runs is leased to, keyed by run id.
+	// Runs that don't exist are absent from the map.
+	FindRunExecutors(ctx *armadacontext.Context, runIds []uuid.UUID) (map[uuid.UUID]string, error)
+
 	// FetchJobRunLeases fetches new job runs for a given executor.  A maximum of maxResults rows will be returned, while run
 	// in excludedRunIds will be excluded
 	FetchJobRunLeases(ctx *armadacontext.Context, executor string, maxResults uint, excludedRunIds []uuid.UUID) ([]*JobRunLease, error)
@@ -225,6 +229,43 @@ func (r *PostgresJobRepository) FindInactiveRuns(ctx *armadacontext.Context, run
 	return inactiveRuns, err
 }
 
+// FindRunExecutors returns the executor each of the provided runs is leased to, keyed by run id.
+// Runs that don't exist are absent from the map.
+func (r *PostgresJobRepository) FindRunExecutors(ctx *armadacontext.Context, runIds []uuid.UUID) (map[uuid.UUID]string, error) {
+	executors := make(map[uuid.UUID]string, len(runIds))
+	err := pgx.BeginTxFunc(ctx, r.db, pgx.TxOptions{
+		IsoLevel:       pgx.ReadCommitted,
+		AccessMode:     pgx.ReadWrite,
+		DeferrableMode: pgx.Deferrable,
+	}, func(tx pgx.Tx) error {
+		tmpTable, err := insertRunIdsToTmpTable(ctx, tx, runIds)
+		if err != nil {
+			return err
+		}
+
+		query := `
+		SELECT runs.run_id, runs.executor
+		FROM %s as tmp
+		JOIN runs ON (tmp.run_id = runs.run_id);`
+
+		rows, err := tx.Query(ctx, fmt.Sprintf(query, tmpTable))
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+		for rows.Next() {
+			runId := uuid.UUID{}
+			executor := ""
+			if err := rows.Scan(&runId, &executor); err != nil {
+				return errors.WithStack(err)
+			}
+			executors[runId] = executor
+		}
+		return nil
+	})
+	return executors, err
+}
+
 // FetchJobRunLeases fetches new job runs for a given executor.  A maximum of maxResults rows will be returned, while run
 // in excludedRunIds will be excluded
 func (r *PostgresJobRepository) FetchJobRunLeases(ctx *armadacontext.Context, executor string, maxResults uint, excludedRunIds []uuid.UUID) ([]*JobRunLease, error) {
@@ -377,6 +377,25 @@ func TestFindInactiveRuns(t *testing.T) {
 	}
 }
 
+func TestFindRunExecutors(t *testing.T) {
+	runIds := []uuid.UUID{uuid.New(), uuid.New(), uuid.New()}
+	err := withJobRepository(func(repo *PostgresJobRepository) error {
+		ctx, cancel := armadacontext.WithTimeout(armadacontext.Background(), 5*time.Second)
+		defer cancel()
+		err := database.UpsertWithTransaction(ctx, repo.db, "runs", []Run{
+			{RunID: runIds[0], Executor: "executor-1"},
+			{RunID: runIds[1], Executor: "executor-2", Succeeded: true},
+		})
+		require.NoError(t, err)
+
+		executors, err := repo.FindRunExecutors(ctx, runIds)
+		require.NoError(t, err)
+		assert.Equal(t, map[uuid.UUID]string{runIds[0]: "executor-1", runIds[1]: "executor-2"}, executors)
+		return nil
+	})
+	require.NoError(t, err)
+}
+
 func TestFetchJobRunLeases(t *testing.T) {
 	const executorName = "testExecutor"
 	dbJobs, _ := createTestJobs(5)
@@ -1,9 +1,8 @@
 package database
 
 import (
-	"github.com/go-redis/redis"
-
 	legacyrepository "github.com/armadaproject/armada/internal/armada/repository"
+	clientQueue "github.com/armadaproject/armada/pkg/client/queue"
 )
 
 // QueueRepository is an interface to be implemented by structs which provide queue information
@@ -11,14 +10,14 @@ type QueueRepository interface {
 	GetAllQueues() ([]*Queue, error)
 }
 
-// LegacyQueueRepository is a QueueRepository which is backed by Armada's redis store
+// LegacyQueueRepository is a QueueRepository which is backed by Armada's queue store
 type LegacyQueueRepository struct {
 	backingRepo legacyrepository.QueueRepository
 }
 
-func NewLegacyQueueRepository(db redis.UniversalClient) *LegacyQueueRepository {
+func NewLegacyQueueRepository(backingRepo legacyrepository.QueueRepository) *LegacyQueueRepository {
 	return &LegacyQueueRepository{
-		backingRepo: legacyrepository.NewRedisQueueRepository(db),
+		backingRepo: backingRepo,
 	}
 }
 
@@ -27,11 +26,13 @@ func (r *LegacyQueueRepository) GetAllQueues() ([]*Queue, error) {
 	if err != nil {
 		return nil, err
 	}
+	// Fair share is divided hierarchically among all queues, since which queues are active isn't known here.
+	priorityFactorByQueue := clientQueue.EffectivePriorityFactors(legacyQueues, nil)
 	queues := make([]*Queue, len(legacyQueues))
 	for i, legacyQueue := range legacyQueues {
 		queues[i] = &Queue{
 			Name:   legacyQueue.Name,
-			Weight: float64(legacyQueue.PriorityFactor),
+			Weight: priorityFactorByQueue[legacyQueue.Name],
 		}
 	}
 	return queues, nil
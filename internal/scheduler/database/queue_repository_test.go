@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"golang.org/x/exp/slices"
 
+	legacyrepository "github.com/armadaproject/armada/internal/armada/repository"
 	clientQueue "github.com/armadaproject/armada/pkg/client/queue"
 )
 
@@ -38,6 +39,36 @@ func TestLegacyQueueRepository_GetAllQueues(t *testing.T) {
 				},
 			},
 		},
+		"Hierarchical": {
+			queues: []clientQueue.Queue{
+				{
+					Name:           "team",
+					PriorityFactor: 2,
+				},
+				{
+					Name:   "project-1",
+					Parent: "team",
+				},
+				{
+					Name:   "project-2",
+					Parent: "team",
+				},
+			},
+			expectedQueues: []*Queue{
+				{
+					Name:   "team",
+					Weight: 6,
+				},
+				{
+					Name:   "project-1",
+					Weight: 6,
+				},
+				{
+					Name:   "project-2",
+					Weight: 6,
+				},
+			},
+		},
 		"Empty": {
 			queues:         []clientQueue.Queue{},
 			expectedQueues: []*Queue{},
@@ -51,7 +82,7 @@ func TestLegacyQueueRepository_GetAllQueues(t *testing.T) {
 				rc.FlushDB()
 				_ = rc.Close()
 			}()
-			repo := NewLegacyQueueRepository(rc)
+			repo := NewLegacyQueueRepository(legacyrepository.NewRedisQueueRepository(rc))
 			for _, queue := range tc.queues {
 				err := repo.backingRepo.CreateQueue(queue)
 				require.NoError(t, err)
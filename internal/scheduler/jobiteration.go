@@ -5,6 +5,7 @@ import (
 
 	"golang.org/x/exp/slices"
 
+	"github.com/armadaproject/armada/internal/armada/configuration"
 	"github.com/armadaproject/armada/internal/common/armadacontext"
 	"github.com/armadaproject/armada/internal/common/types"
 	"github.com/armadaproject/armada/internal/common/util"
@@ -21,6 +22,14 @@ type JobRepository interface {
 	GetExistingJobsByIds(ids []string) ([]interfaces.LegacySchedulerJob, error)
 }
 
+// OwnerIndexedJobRepository is a JobRepository that also indexes queued jobs by owner.
+type OwnerIndexedJobRepository interface {
+	JobRepository
+	// GetQueueJobIdsByOwner returns the ids of the queued jobs of a queue indexed by owner,
+	// where the ids of each owner are in the order in which they should be scheduled.
+	GetQueueJobIdsByOwner(queueName string) (map[string][]string, error)
+}
+
 type InMemoryJobIterator struct {
 	i     int
 	jctxs []*schedulercontext.JobSchedulingContext
@@ -117,6 +126,33 @@ type QueuedJobsIterator struct {
 }
 
 func NewQueuedJobsIterator(ctx *armadacontext.Context, queue string, repo JobRepository, priorityClasses map[string]types.PriorityClass) (*QueuedJobsIterator, error) {
+	jobIds, err := repo.GetQueueJobIds(queue)
+	if err != nil {
+		return nil, err
+	}
+	return newQueuedJobsIterator(ctx, jobIds, repo, priorityClasses), nil
+}
+
+// NewUserFairShareJobsIterator returns a QueuedJobsIterator over all jobs in a queue,
+// where jobs of different owners are interleaved according to the weights of their owners.
+func NewUserFairShareJobsIterator(
+	ctx *armadacontext.Context,
+	queue string,
+	repo OwnerIndexedJobRepository,
+	config configuration.UserFairShareConfig,
+	priorityClasses map[string]types.PriorityClass,
+) (*QueuedJobsIterator, error) {
+	jobIdsByOwner, err := repo.GetQueueJobIdsByOwner(queue)
+	if err != nil {
+		return nil, err
+	}
+	jobIds := InterleaveJobIdsByOwner(jobIdsByOwner, func(owner string) float64 {
+		return UserWeight(config, owner)
+	})
+	return newQueuedJobsIterator(ctx, jobIds, repo, priorityClasses), nil
+}
+
+func newQueuedJobsIterator(ctx *armadacontext.Context, jobIds []string, repo JobRepository, priorityClasses map[string]types.PriorityClass) *QueuedJobsIterator {
 	batchSize := 16
 	g, ctx := armadacontext.ErrGroup(ctx)
 	it := &QueuedJobsIterator{
@@ -124,15 +160,8 @@ func NewQueuedJobsIterator(ctx *armadacontext.Context, queue string, repo JobRep
 		c:               make(chan interfaces.LegacySchedulerJob, 2*batchSize), // 2x batchSize to load one batch async.
 		priorityClasses: priorityClasses,
 	}
-
-	jobIds, err := repo.GetQueueJobIds(queue)
-	if err != nil {
-		it.err = err
-		return nil, err
-	}
 	g.Go(func() error { return queuedJobsIteratorLoader(ctx, jobIds, it.c, batchSize, repo) })
-
-	return it, nil
+	return it
 }
 
 func (it *QueuedJobsIterator) Next() (*schedulercontext.JobSchedulingContext, error) {
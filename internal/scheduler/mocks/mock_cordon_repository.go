@@ -0,0 +1,123 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/armadaproject/armada/internal/armada/repository (interfaces: CordonRepository)
+
+// Package schedulermocks is a generated GoMock package.
+package schedulermocks
+
+import (
+	reflect "reflect"
+
+	api "github.com/armadaproject/armada/pkg/api"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockCordonRepository is a mock of CordonRepository interface.
+type MockCordonRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockCordonRepositoryMockRecorder
+}
+
+// MockCordonRepositoryMockRecorder is the mock recorder for MockCordonRepository.
+type MockCordonRepositoryMockRecorder struct {
+	mock *MockCordonRepository
+}
+
+// NewMockCordonRepository creates a new mock instance.
+func NewMockCordonRepository(ctrl *gomock.Controller) *MockCordonRepository {
+	mock := &MockCordonRepository{ctrl: ctrl}
+	mock.recorder = &MockCordonRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCordonRepository) EXPECT() *MockCordonRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CordonExecutor mocks base method.
+func (m *MockCordonRepository) CordonExecutor(arg0 *api.Cordon) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CordonExecutor", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CordonExecutor indicates an expected call of CordonExecutor.
+func (mr *MockCordonRepositoryMockRecorder) CordonExecutor(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CordonExecutor", reflect.TypeOf((*MockCordonRepository)(nil).CordonExecutor), arg0)
+}
+
+// CordonQueue mocks base method.
+func (m *MockCordonRepository) CordonQueue(arg0 *api.Cordon) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CordonQueue", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CordonQueue indicates an expected call of CordonQueue.
+func (mr *MockCordonRepositoryMockRecorder) CordonQueue(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CordonQueue", reflect.TypeOf((*MockCordonRepository)(nil).CordonQueue), arg0)
+}
+
+// GetCordonedExecutors mocks base method.
+func (m *MockCordonRepository) GetCordonedExecutors() (map[string]*api.Cordon, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCordonedExecutors")
+	ret0, _ := ret[0].(map[string]*api.Cordon)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCordonedExecutors indicates an expected call of GetCordonedExecutors.
+func (mr *MockCordonRepositoryMockRecorder) GetCordonedExecutors() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCordonedExecutors", reflect.TypeOf((*MockCordonRepository)(nil).GetCordonedExecutors))
+}
+
+// GetCordonedQueues mocks base method.
+func (m *MockCordonRepository) GetCordonedQueues() (map[string]*api.Cordon, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCordonedQueues")
+	ret0, _ := ret[0].(map[string]*api.Cordon)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCordonedQueues indicates an expected call of GetCordonedQueues.
+func (mr *MockCordonRepositoryMockRecorder) GetCordonedQueues() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCordonedQueues", reflect.TypeOf((*MockCordonRepository)(nil).GetCordonedQueues))
+}
+
+// UncordonExecutor mocks base method.
+func (m *MockCordonRepository) UncordonExecutor(arg0 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UncordonExecutor", arg0)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UncordonExecutor indicates an expected call of UncordonExecutor.
+func (mr *MockCordonRepositoryMockRecorder) UncordonExecutor(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UncordonExecutor", reflect.TypeOf((*MockCordonRepository)(nil).UncordonExecutor), arg0)
+}
+
+// UncordonQueue mocks base method.
+func (m *MockCordonRepository) UncordonQueue(arg0 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UncordonQueue", arg0)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UncordonQueue indicates an expected call of UncordonQueue.
+func (mr *MockCordonRepositoryMockRecorder) UncordonQueue(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UncordonQueue", reflect.TypeOf((*MockCordonRepository)(nil).UncordonQueue), arg0)
+}
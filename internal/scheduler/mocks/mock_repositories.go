@@ -219,3 +219,18 @@ func (mr *MockJobRepositoryMockRecorder) FindInactiveRuns(arg0, arg1 interface{}
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindInactiveRuns", reflect.TypeOf((*MockJobRepository)(nil).FindInactiveRuns), arg0, arg1)
 }
+
+// FindRunExecutors mocks base method.
+func (m *MockJobRepository) FindRunExecutors(arg0 *armadacontext.Context, arg1 []uuid.UUID) (map[uuid.UUID]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindRunExecutors", arg0, arg1)
+	ret0, _ := ret[0].(map[uuid.UUID]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindRunExecutors indicates an expected call of FindRunExecutors.
+func (mr *MockJobRepositoryMockRecorder) FindRunExecutors(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindRunExecutors", reflect.TypeOf((*MockJobRepository)(nil).FindRunExecutors), arg0, arg1)
+}
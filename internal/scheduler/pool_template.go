@@ -0,0 +1,68 @@
+package scheduler
+
+import (
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+// RemovePoolTemplateTaints removes from node any taints tolerated by the template of its pool.
+// Since the tolerations of the template are added to all pods leased to the pool,
+// such taints never prevent jobs from being scheduled and are ignored when checking if jobs can be scheduled.
+func RemovePoolTemplateTaints(node *api.NodeInfo, template configuration.PoolTemplate) {
+	if node == nil || len(template.Tolerations) == 0 {
+		return
+	}
+	node.Taints = removeToleratedTaints(node.Taints, template.Tolerations)
+}
+
+func removeToleratedTaints(taints []v1.Taint, tolerations []v1.Toleration) []v1.Taint {
+	var result []v1.Taint
+	for _, taint := range taints {
+		if !toleratesTaint(tolerations, taint) {
+			result = append(result, taint)
+		}
+	}
+	return result
+}
+
+func toleratesTaint(tolerations []v1.Toleration, taint v1.Taint) bool {
+	for _, toleration := range tolerations {
+		if toleration.ToleratesTaint(&taint) {
+			return true
+		}
+	}
+	return false
+}
+
+// ApplyPoolTemplate adds the tolerations of template to podSpec and its labels to labels.
+// Tolerations already present on podSpec aren't duplicated and labels already set aren't overwritten.
+// Returns labels, which is allocated if nil and template has labels.
+func ApplyPoolTemplate(template configuration.PoolTemplate, podSpec *v1.PodSpec, labels map[string]string) map[string]string {
+	if podSpec != nil {
+		for _, toleration := range template.Tolerations {
+			if !hasToleration(podSpec.Tolerations, toleration) {
+				podSpec.Tolerations = append(podSpec.Tolerations, toleration)
+			}
+		}
+	}
+	for k, v := range template.Labels {
+		if labels == nil {
+			labels = make(map[string]string, len(template.Labels))
+		}
+		if _, ok := labels[k]; !ok {
+			labels[k] = v
+		}
+	}
+	return labels
+}
+
+func hasToleration(tolerations []v1.Toleration, toleration v1.Toleration) bool {
+	for _, t := range tolerations {
+		if t.MatchToleration(&toleration) {
+			return true
+		}
+	}
+	return false
+}
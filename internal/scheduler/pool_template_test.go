@@ -0,0 +1,57 @@
+package scheduler
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+var testPoolTemplate = configuration.PoolTemplate{
+	Tolerations: []v1.Toleration{
+		{Key: "generation", Operator: v1.TolerationOpEqual, Value: "gen2", Effect: v1.TaintEffectNoSchedule},
+	},
+	Labels: map[string]string{"pool": "gen2"},
+}
+
+func TestRemovePoolTemplateTaints(t *testing.T) {
+	node := &api.NodeInfo{
+		Name: "node",
+		Taints: []v1.Taint{
+			{Key: "generation", Value: "gen2", Effect: v1.TaintEffectNoSchedule},
+			{Key: "generation", Value: "gen3", Effect: v1.TaintEffectNoSchedule},
+			{Key: "gpu", Value: "true", Effect: v1.TaintEffectNoSchedule},
+		},
+	}
+	RemovePoolTemplateTaints(node, testPoolTemplate)
+	assert.Equal(t, []v1.Taint{
+		{Key: "generation", Value: "gen3", Effect: v1.TaintEffectNoSchedule},
+		{Key: "gpu", Value: "true", Effect: v1.TaintEffectNoSchedule},
+	}, node.Taints)
+
+	// Nodes are unaffected by empty templates.
+	RemovePoolTemplateTaints(node, configuration.PoolTemplate{})
+	assert.Len(t, node.Taints, 2)
+}
+
+func TestApplyPoolTemplate(t *testing.T) {
+	podSpec := &v1.PodSpec{
+		Tolerations: []v1.Toleration{
+			{Key: "gpu", Operator: v1.TolerationOpExists},
+		},
+	}
+	labels := ApplyPoolTemplate(testPoolTemplate, podSpec, nil)
+	assert.Equal(t, map[string]string{"pool": "gen2"}, labels)
+	assert.Equal(t, []v1.Toleration{
+		{Key: "gpu", Operator: v1.TolerationOpExists},
+		{Key: "generation", Operator: v1.TolerationOpEqual, Value: "gen2", Effect: v1.TaintEffectNoSchedule},
+	}, podSpec.Tolerations)
+
+	// Applying a template more than once doesn't duplicate tolerations and labels of the job take precedence.
+	labels = ApplyPoolTemplate(testPoolTemplate, podSpec, map[string]string{"pool": "mine"})
+	assert.Equal(t, map[string]string{"pool": "mine"}, labels)
+	assert.Len(t, podSpec.Tolerations, 2)
+}
@@ -11,6 +11,7 @@ import (
 	"golang.org/x/exp/slices"
 	"k8s.io/apimachinery/pkg/api/resource"
 
+	"github.com/armadaproject/armada/internal/armada/configuration"
 	"github.com/armadaproject/armada/internal/common/armadacontext"
 	armadamaps "github.com/armadaproject/armada/internal/common/maps"
 	armadaslices "github.com/armadaproject/armada/internal/common/slices"
@@ -49,6 +50,9 @@ type PreemptingQueueScheduler struct {
 	enableAssertions bool
 	// If true, a newer preemption strategy is used.
 	enableNewPreemptionStrategy bool
+	// If non-nil and the job repository indexes jobs by owner,
+	// queued jobs of different owners are interleaved according to the weights of their owners.
+	userFairShare *configuration.UserFairShareConfig
 }
 
 func NewPreemptingQueueScheduler(
@@ -103,6 +107,10 @@ func (sch *PreemptingQueueScheduler) EnableNewPreemptionStrategy() {
 	sch.nodeDb.EnableNewPreemptionStrategy()
 }
 
+func (sch *PreemptingQueueScheduler) EnableUserFairShare(config configuration.UserFairShareConfig) {
+	sch.userFairShare = &config
+}
+
 // Schedule
 // - preempts jobs belonging to queues with total allocation above their fair share and
 // - schedules new jobs belonging to queues with total allocation less than their fair share.
@@ -144,8 +152,7 @@ func (sch *PreemptingQueueScheduler) Schedule(ctx *armadacontext.Context) (*Sche
 						return false
 					}
 				}
-				priorityClass := interfaces.PriorityClassFromLegacySchedulerJob(sch.schedulingContext.PriorityClasses, sch.schedulingContext.DefaultPriorityClass, job)
-				return priorityClass.Preemptible
+				return isPreemptible(sch.schedulingContext.PriorityClasses, sch.schedulingContext.DefaultPriorityClass, job)
 			},
 			nil,
 		),
@@ -532,7 +539,13 @@ func (sch *PreemptingQueueScheduler) schedule(ctx *armadacontext.Context, inMemo
 		if jobRepo == nil || reflect.ValueOf(jobRepo).IsNil() {
 			jobIteratorByQueue[qctx.Queue] = evictedIt
 		} else {
-			queueIt, err := NewQueuedJobsIterator(ctx, qctx.Queue, jobRepo, sch.schedulingContext.PriorityClasses)
+			var queueIt *QueuedJobsIterator
+			var err error
+			if ownerIndexedJobRepo, ok := jobRepo.(OwnerIndexedJobRepository); ok && sch.userFairShare != nil {
+				queueIt, err = NewUserFairShareJobsIterator(ctx, qctx.Queue, ownerIndexedJobRepo, *sch.userFairShare, sch.schedulingContext.PriorityClasses)
+			} else {
+				queueIt, err = NewQueuedJobsIterator(ctx, qctx.Queue, jobRepo, sch.schedulingContext.PriorityClasses)
+			}
 			if err != nil {
 				return nil, err
 			}
@@ -802,8 +815,7 @@ func NewOversubscribedEvictor(
 			return len(overSubscribedPriorities) > 0 && random.Float64() < perNodeEvictionProbability
 		},
 		jobFilter: func(ctx *armadacontext.Context, job interfaces.LegacySchedulerJob) bool {
-			priorityClass := interfaces.PriorityClassFromLegacySchedulerJob(priorityClasses, defaultPriorityClassName, job)
-			if !priorityClass.Preemptible {
+			if !isPreemptible(priorityClasses, defaultPriorityClassName, job) {
 				return false
 			}
 			priority, ok := nodeDb.GetScheduledAtPriority(job.GetId())
@@ -1105,6 +1105,11 @@ func (t *testJobRepository) FindInactiveRuns(ctx *armadacontext.Context, runIds
 	panic("implement me")
 }
 
+func (t *testJobRepository) FindRunExecutors(ctx *armadacontext.Context, runIds []uuid.UUID) (map[uuid.UUID]string, error) {
+	// TODO implement me
+	panic("implement me")
+}
+
 func (t *testJobRepository) FetchJobRunLeases(ctx *armadacontext.Context, executor string, maxResults uint, excludedRunIds []uuid.UUID) ([]*database.JobRunLease, error) {
 	// TODO implement me
 	panic("implement me")
@@ -17,10 +17,14 @@ import (
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 
+	armadaconfig "github.com/armadaproject/armada/internal/armada/configuration"
+	legacyrepository "github.com/armadaproject/armada/internal/armada/repository"
+	"github.com/armadaproject/armada/internal/armada/repository/pgqueue"
 	"github.com/armadaproject/armada/internal/common"
 	"github.com/armadaproject/armada/internal/common/app"
 	"github.com/armadaproject/armada/internal/common/armadacontext"
 	"github.com/armadaproject/armada/internal/common/auth"
+	"github.com/armadaproject/armada/internal/common/auth/authorization"
 	dbcommon "github.com/armadaproject/armada/internal/common/database"
 	grpcCommon "github.com/armadaproject/armada/internal/common/grpc"
 	"github.com/armadaproject/armada/internal/common/health"
@@ -86,7 +90,21 @@ func Run(config schedulerconfig.Configuration) error {
 				Warnf("Redis client didn't close down cleanly")
 		}
 	}()
-	queueRepository := database.NewLegacyQueueRepository(redisClient)
+	var queueRepository *database.LegacyQueueRepository
+	switch config.QueueRepository.Backend {
+	case "", armadaconfig.QueueRepositoryBackendRedis:
+		queueRepository = database.NewLegacyQueueRepository(legacyrepository.NewRedisQueueRepository(redisClient))
+	case armadaconfig.QueueRepositoryBackendPostgres:
+		// The schema of the queue database is migrated by the Armada server.
+		queueDb, err := dbcommon.OpenPgxPool(config.QueueRepository.Postgres)
+		if err != nil {
+			return errors.WithMessage(err, "Error opening connection to queue database")
+		}
+		defer queueDb.Close()
+		queueRepository = database.NewLegacyQueueRepository(pgqueue.NewPostgresQueueRepository(queueDb))
+	default:
+		return errors.Errorf("unknown queue repository backend %q", config.QueueRepository.Backend)
+	}
 	legacyExecutorRepository := database.NewRedisExecutorRepository(redisClient, "pulsar")
 
 	// ////////////////////////////////////////////////////////////////////////
@@ -137,6 +155,13 @@ func Run(config schedulerconfig.Configuration) error {
 	if err != nil {
 		return errors.WithMessage(err, "error creating auth services")
 	}
+	// As on the Armada server, executor credentials are tried first, since Kerberos must remain the last auth service.
+	if config.ExecutorCredentials.Enabled {
+		verifier := legacyrepository.NewExecutorCredentialVerifier(legacyrepository.NewRedisExecutorCredentialRepository(redisClient))
+		authServices = append([]authorization.AuthService{
+			authorization.NewExecutorAuthService(verifier, config.ExecutorCredentials.Groups, ExecutorCredentialMethods),
+		}, authServices...)
+	}
 	grpcServer := grpcCommon.CreateGrpcServer(config.Grpc.KeepaliveParams, config.Grpc.KeepaliveEnforcementPolicy, authServices, config.Grpc.Tls)
 	defer grpcServer.GracefulStop()
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", config.Grpc.Port))
@@ -151,6 +176,7 @@ func Run(config schedulerconfig.Configuration) error {
 		types.AllowedPriorities(config.Scheduling.Preemption.PriorityClasses),
 		config.Scheduling.Preemption.NodeIdLabel,
 		config.Scheduling.Preemption.PriorityClassNameOverride,
+		config.Scheduling.PoolTemplates,
 		config.Pulsar.MaxAllowedMessageSize,
 	)
 	if err != nil {
@@ -194,6 +220,7 @@ func Run(config schedulerconfig.Configuration) error {
 		config.MaxSchedulingDuration,
 		executorRepository,
 		queueRepository,
+		legacyrepository.NewRedisCordonRepository(redisClient),
 		schedulingContextRepository,
 	)
 	if err != nil {
@@ -4,6 +4,7 @@
 package schedulerobjects
 
 import (
+	encoding_binary "encoding/binary"
 	fmt "fmt"
 	io "io"
 	math "math"
@@ -932,6 +933,10 @@ type PulsarSchedulerJobDetails struct {
 	JobId  string `protobuf:"bytes,1,opt,name=JobId,proto3" json:"JobId,omitempty"`
 	Queue  string `protobuf:"bytes,2,opt,name=Queue,proto3" json:"Queue,omitempty"`
 	JobSet string `protobuf:"bytes,3,opt,name=JobSet,proto3" json:"JobSet,omitempty"`
+	// Used to let owners manage their own jobs, if their queue permits it.
+	Owner string `protobuf:"bytes,4,opt,name=Owner,proto3" json:"Owner,omitempty"`
+	// Total resources requested by the job, in units of each resource, used to record its usage.
+	Resources map[string]float64 `protobuf:"bytes,5,rep,name=Resources,proto3" json:"Resources,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
 }
 
 func (m *PulsarSchedulerJobDetails) Reset()         { *m = PulsarSchedulerJobDetails{} }
@@ -988,6 +993,20 @@ func (m *PulsarSchedulerJobDetails) GetJobSet() string {
 	return ""
 }
 
+func (m *PulsarSchedulerJobDetails) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *PulsarSchedulerJobDetails) GetResources() map[string]float64 {
+	if m != nil {
+		return m.Resources
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("schedulerobjects.JobRunState", JobRunState_name, JobRunState_value)
 	proto.RegisterType((*Executor)(nil), "schedulerobjects.Executor")
@@ -1016,6 +1035,7 @@ func init() {
 	proto.RegisterMapType((map[string]string)(nil), "schedulerobjects.PodRequirements.AnnotationsEntry")
 	proto.RegisterMapType((map[string]string)(nil), "schedulerobjects.PodRequirements.NodeSelectorEntry")
 	proto.RegisterType((*PulsarSchedulerJobDetails)(nil), "schedulerobjects.PulsarSchedulerJobDetails")
+	proto.RegisterMapType((map[string]float64)(nil), "schedulerobjects.PulsarSchedulerJobDetails.ResourcesEntry")
 }
 
 func init() {
@@ -1023,145 +1043,147 @@ func init() {
 }
 
 var fileDescriptor_97dadc5fbd620721 = []byte{
-	// 2197 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x19, 0x4d, 0x6f, 0x1b, 0xc7,
-	0x55, 0x2b, 0x52, 0x14, 0x39, 0x94, 0x25, 0x6a, 0xe4, 0x8f, 0x15, 0x63, 0x73, 0x19, 0xc6, 0x0d,
-	0x94, 0xc6, 0x21, 0x1b, 0xa5, 0x40, 0x0d, 0xb7, 0x17, 0xd1, 0x52, 0x6b, 0x2a, 0x36, 0x25, 0x2f,
-	0xa5, 0x16, 0x2d, 0xd0, 0x2c, 0x96, 0xdc, 0x11, 0xbd, 0xd1, 0x72, 0x86, 0xde, 0x9d, 0x55, 0xc3,
-	0x9c, 0xdb, 0x43, 0x11, 0x20, 0x0d, 0x8a, 0x7e, 0x04, 0x28, 0xd0, 0x22, 0xb7, 0xfc, 0x82, 0xf6,
-	0xd0, 0x3f, 0xe0, 0x63, 0x8e, 0x3d, 0x31, 0x85, 0x7d, 0xe3, 0xb5, 0x7f, 0xa0, 0x98, 0x99, 0x5d,
-	0xee, 0x70, 0x97, 0x14, 0xe5, 0xa4, 0xae, 0x4e, 0xe4, 0xbc, 0xef, 0x79, 0xef, 0xcd, 0x9b, 0xf7,
-	0x66, 0xc1, 0x3d, 0x1b, 0x53, 0xe4, 0x62, 0xd3, 0xa9, 0x79, 0x9d, 0x27, 0xc8, 0xf2, 0x1d, 0xe4,
-	0x46, 0xff, 0x48, 0xfb, 0x43, 0xd4, 0xa1, 0x5e, 0x02, 0x50, 0xed, 0xbb, 0x84, 0x12, 0x58, 0x88,
-	0xc3, 0x8b, 0x5a, 0x97, 0x90, 0xae, 0x83, 0x6a, 0x1c, 0xdf, 0xf6, 0x4f, 0x6a, 0xd4, 0xee, 0x21,
-	0x8f, 0x9a, 0xbd, 0xbe, 0x60, 0x29, 0x56, 0x4e, 0xef, 0x7a, 0x55, 0x9b, 0xd4, 0xcc, 0xbe, 0x5d,
-	0xeb, 0x10, 0x17, 0xd5, 0xce, 0xde, 0xad, 0x75, 0x11, 0x46, 0xae, 0x49, 0x91, 0x15, 0xd0, 0x7c,
-	0x3f, 0xa2, 0xe9, 0x99, 0x9d, 0x27, 0x36, 0x46, 0xee, 0xa0, 0xd6, 0x3f, 0xed, 0x72, 0x26, 0x17,
-	0x79, 0xc4, 0x77, 0x3b, 0x28, 0xc1, 0xf5, 0x4e, 0xd7, 0xa6, 0x4f, 0xfc, 0x76, 0xb5, 0x43, 0x7a,
-	0xb5, 0x2e, 0xe9, 0x92, 0xc8, 0x06, 0xb6, 0xe2, 0x0b, 0xfe, 0x4f, 0x90, 0x57, 0xbe, 0x4c, 0x81,
-	0xec, 0xde, 0x47, 0xa8, 0xe3, 0x53, 0xe2, 0xc2, 0x32, 0x58, 0xb4, 0x2d, 0x55, 0x29, 0x2b, 0x5b,
-	0xb9, 0x7a, 0x61, 0x34, 0xd4, 0x56, 0x6c, 0xeb, 0x0e, 0xe9, 0xd9, 0x14, 0xf5, 0xfa, 0x74, 0xa0,
-	0x2f, 0xda, 0x16, 0x7c, 0x13, 0xa4, 0xfb, 0x84, 0x38, 0xea, 0x22, 0xa7, 0x81, 0xa3, 0xa1, 0xb6,
-	0xca, 0xd6, 0x12, 0x15, 0xc7, 0xc3, 0x1d, 0xb0, 0x84, 0x89, 0x85, 0x3c, 0x35, 0x55, 0x4e, 0x6d,
-	0xe5, 0xb7, 0xaf, 0x57, 0x13, 0xae, 0x6b, 0x12, 0x0b, 0xd5, 0x37, 0x46, 0x43, 0x6d, 0x8d, 0x13,
-	0x4a, 0x12, 0x04, 0x27, 0xfc, 0x00, 0xac, 0xf6, 0x6c, 0x6c, 0xf7, 0xfc, 0xde, 0x3e, 0x69, 0xb7,
-	0xec, 0x8f, 0x91, 0x9a, 0x2e, 0x2b, 0x5b, 0xf9, 0xed, 0x52, 0x52, 0x96, 0x1e, 0x38, 0xe3, 0xa1,
-	0xed, 0xd1, 0xfa, 0xf5, 0x67, 0x43, 0x6d, 0x81, 0x19, 0x36, 0xc9, 0xad, 0xc7, 0xd6, 0x4c, 0xbe,
-	0x63, 0x7a, 0xf4, 0xb8, 0x6f, 0x99, 0x14, 0x1d, 0xd9, 0x3d, 0xa4, 0x2e, 0x71, 0xf9, 0xc5, 0xaa,
-	0x08, 0x5e, 0x35, 0x74, 0x5c, 0xf5, 0x28, 0x0c, 0x5e, 0xbd, 0x18, 0xca, 0x9e, 0xe4, 0xfc, 0xec,
-	0x6b, 0x4d, 0xd1, 0x63, 0x30, 0x78, 0x00, 0x36, 0x7c, 0x6c, 0x7a, 0x9e, 0xdd, 0xc5, 0xc8, 0x32,
-	0x3e, 0x24, 0x6d, 0xc3, 0xf5, 0xb1, 0xa7, 0xe6, 0xca, 0xa9, 0xad, 0x5c, 0x5d, 0x1b, 0x0d, 0xb5,
-	0xd7, 0x22, 0xf4, 0x3e, 0x69, 0xeb, 0x3e, 0x96, 0x9d, 0xb0, 0x9e, 0x40, 0x56, 0xbe, 0xbc, 0x0e,
-	0xd2, 0xcc, 0x6b, 0x17, 0x0b, 0x13, 0x36, 0x7b, 0x48, 0x5d, 0x89, 0xc2, 0xc4, 0xd6, 0x72, 0x98,
-	0xd8, 0x1a, 0x6e, 0x83, 0x2c, 0x0a, 0x82, 0xaf, 0x6e, 0x70, 0xda, 0xeb, 0xa3, 0xa1, 0x06, 0x43,
-	0x98, 0x44, 0x3f, 0xa6, 0x83, 0x77, 0x01, 0x60, 0x01, 0xda, 0x6d, 0xbf, 0x8f, 0x06, 0x9e, 0x0a,
-	0xcb, 0xa9, 0xad, 0x95, 0xba, 0x3a, 0x1a, 0x6a, 0x57, 0x23, 0xa8, 0xc4, 0x27, 0xd1, 0xc2, 0x47,
-	0x20, 0xc7, 0x7c, 0x64, 0x78, 0x08, 0x61, 0x9e, 0x41, 0xe7, 0x3b, 0xfb, 0x6a, 0xe0, 0xec, 0x2c,
-	0x63, 0x6a, 0x21, 0x84, 0xb9, 0x9b, 0xc7, 0x2b, 0x78, 0x00, 0x72, 0x4c, 0xb8, 0x41, 0x07, 0x7d,
-	0xa4, 0xa6, 0x02, 0x71, 0x53, 0xf3, 0xec, 0x68, 0xd0, 0x47, 0x62, 0x67, 0x38, 0x58, 0xc9, 0x3b,
-	0x0b, 0x61, 0xf0, 0x1e, 0x58, 0x19, 0x0b, 0x34, 0x6c, 0x8b, 0xe7, 0x5b, 0x3a, 0xda, 0x1b, 0xa3,
-	0x69, 0x58, 0xf1, 0xbd, 0x09, 0x28, 0xdc, 0x01, 0x19, 0x6a, 0xda, 0x98, 0x7a, 0xea, 0x12, 0xcf,
-	0xf8, 0xcd, 0xaa, 0x38, 0xbd, 0x55, 0xb3, 0x6f, 0x57, 0xd9, 0x09, 0xaf, 0x9e, 0xbd, 0x5b, 0x3d,
-	0x62, 0x14, 0xf5, 0xd5, 0x60, 0x5f, 0x01, 0x83, 0x1e, 0xfc, 0xc2, 0x43, 0x90, 0x71, 0xcc, 0x36,
-	0x72, 0x3c, 0x35, 0xc3, 0x45, 0x54, 0xa6, 0x6f, 0xa6, 0xfa, 0x90, 0x13, 0xed, 0x61, 0xea, 0x0e,
-	0xea, 0x57, 0x47, 0x43, 0xad, 0x20, 0xb8, 0x24, 0xc3, 0x02, 0x39, 0xd0, 0x00, 0x6b, 0x94, 0x50,
-	0xd3, 0x31, 0xc2, 0x6a, 0xe1, 0xa9, 0xcb, 0x2f, 0x77, 0x86, 0x38, 0x7b, 0x88, 0xf2, 0xf4, 0xd8,
-	0x1a, 0xfe, 0x5d, 0x01, 0xb7, 0x4d, 0xc7, 0x21, 0x1d, 0x93, 0x9a, 0x6d, 0x07, 0x19, 0xed, 0x81,
-	0xd1, 0x77, 0x6d, 0xe2, 0xda, 0x74, 0x60, 0x98, 0xd8, 0x1a, 0xeb, 0x55, 0xb3, 0x7c, 0x47, 0x3f,
-	0x9a, 0xb1, 0xa3, 0x9d, 0x48, 0x44, 0x7d, 0x70, 0x18, 0x08, 0xd8, 0xc1, 0x56, 0xa8, 0x48, 0xec,
-	0x75, 0x2b, 0x30, 0xaa, 0x6c, 0xce, 0x21, 0xd7, 0xe7, 0x52, 0x40, 0x17, 0x6c, 0x78, 0xd4, 0xa4,
-	0xdc, 0xe2, 0xe0, 0x68, 0xb2, 0x88, 0xe7, 0xb8, 0x99, 0x6f, 0xcf, 0x30, 0xb3, 0xc5, 0x38, 0xea,
-	0x03, 0x71, 0x1e, 0x1b, 0x96, 0xb0, 0xea, 0x46, 0x60, 0xd5, 0x9a, 0x37, 0x89, 0xd5, 0xe3, 0x00,
-	0xe8, 0x83, 0x8d, 0xc0, 0x2e, 0x64, 0x85, 0x7a, 0x6d, 0x4b, 0x05, 0x5c, 0xe7, 0x9d, 0xf3, 0x5d,
-	0x83, 0x2c, 0x2e, 0x28, 0x54, 0xaa, 0x06, 0x4a, 0x0b, 0x66, 0x0c, 0xad, 0x27, 0x20, 0x90, 0x02,
-	0x38, 0xa1, 0xf6, 0xa9, 0x8f, 0x7c, 0xa4, 0xe6, 0x2f, 0xaa, 0xf5, 0x31, 0x23, 0x9f, 0xad, 0x95,
-	0xa3, 0xf5, 0x04, 0x84, 0x6d, 0x16, 0x9d, 0xd9, 0x1d, 0x1a, 0x95, 0x3e, 0xc3, 0xb6, 0x3c, 0x75,
-	0xf5, 0x5c, 0xb5, 0x7b, 0x82, 0x23, 0xf4, 0x98, 0x17, 0x53, 0x8b, 0x62, 0x68, 0x3d, 0x01, 0x81,
-	0x5f, 0x28, 0xa0, 0x84, 0x09, 0x36, 0x4c, 0xb7, 0x67, 0x5a, 0xa6, 0x11, 0x6d, 0x3c, 0x3a, 0x01,
-	0x57, 0xb8, 0x09, 0x3f, 0x98, 0x61, 0x42, 0x93, 0xe0, 0x1d, 0xce, 0x3b, 0x76, 0xc1, 0x38, 0xdb,
-	0x85, 0x35, 0x6f, 0x04, 0xd6, 0xbc, 0x86, 0x67, 0x53, 0xea, 0xe7, 0x21, 0xe1, 0x0e, 0xb8, 0xe2,
-	0xe3, 0x40, 0x3b, 0xcb, 0x50, 0x75, 0xad, 0xac, 0x6c, 0x65, 0xeb, 0xaf, 0x8d, 0x86, 0xda, 0x8d,
-	0x09, 0x84, 0x74, 0xa2, 0x27, 0x39, 0xe0, 0x27, 0x0a, 0xb8, 0x11, 0xee, 0xc8, 0xf0, 0x3d, 0xb3,
-	0x8b, 0xa2, 0xc8, 0x16, 0xf8, 0xfe, 0xbe, 0x37, 0x63, 0x7f, 0xa1, 0x19, 0xc7, 0x8c, 0x69, 0x22,
-	0xba, 0x95, 0xd1, 0x50, 0x2b, 0xb9, 0x53, 0xd0, 0x92, 0x19, 0x57, 0xa7, 0xe1, 0xd9, 0x4d, 0xe7,
-	0xa2, 0x3e, 0x71, 0xa9, 0x8d, 0xbb, 0x46, 0x54, 0x92, 0xd7, 0xf9, 0x85, 0xc2, 0x6f, 0xba, 0x31,
-	0xba, 0x99, 0xac, 0xbf, 0xeb, 0x09, 0x64, 0xd1, 0x04, 0x79, 0xa9, 0xc8, 0xc1, 0x37, 0x40, 0xea,
-	0x14, 0x0d, 0x82, 0x0b, 0x6f, 0x7d, 0x34, 0xd4, 0xae, 0x9c, 0xa2, 0x81, 0x24, 0x81, 0x61, 0xe1,
-	0x5b, 0x60, 0xe9, 0xcc, 0x74, 0x7c, 0x14, 0xb4, 0x26, 0xbc, 0xb3, 0xe0, 0x00, 0xb9, 0xb3, 0xe0,
-	0x80, 0x7b, 0x8b, 0x77, 0x95, 0xe2, 0x5f, 0x14, 0xf0, 0x9d, 0x0b, 0x95, 0x1d, 0x59, 0xfb, 0xd2,
-	0x4c, 0xed, 0x0d, 0x59, 0xfb, 0xfc, 0xfa, 0x3a, 0xcf, 0xba, 0xdf, 0x2a, 0xe0, 0xea, 0xb4, 0x6a,
-	0x73, 0x31, 0x57, 0x3c, 0x90, 0x8d, 0x59, 0xdd, 0xbe, 0x95, 0x34, 0x46, 0x08, 0x15, 0x1a, 0xe6,
-	0xd9, 0xf2, 0x89, 0x02, 0xae, 0x4d, 0xad, 0x42, 0x17, 0x33, 0xe6, 0x7f, 0xec, 0x99, 0x98, 0x35,
-	0x51, 0xfe, 0x5e, 0x8a, 0x35, 0xa7, 0xe0, 0xda, 0xd4, 0x9a, 0xf5, 0x0d, 0x52, 0x36, 0x3b, 0x57,
-	0xd9, 0x9f, 0x14, 0x50, 0x9e, 0x57, 0x9e, 0x2e, 0x25, 0x5b, 0x7f, 0xa7, 0x80, 0xcd, 0x99, 0x75,
-	0xe5, 0x32, 0xe2, 0x52, 0xf9, 0x6b, 0x1a, 0x64, 0xc3, 0x6a, 0xc2, 0xda, 0xe5, 0x86, 0x68, 0x97,
-	0xd3, 0xa2, 0x5d, 0x9e, 0x68, 0xe2, 0x16, 0x27, 0x9a, 0xb7, 0xc5, 0x6f, 0xda, 0xbc, 0x1d, 0x8d,
-	0x9b, 0x37, 0x31, 0xf1, 0xbc, 0x39, 0xbb, 0x13, 0x7d, 0x89, 0x06, 0xee, 0xd7, 0x0a, 0x80, 0x3e,
-	0xf6, 0x10, 0x6d, 0x60, 0x0b, 0x7d, 0x84, 0x2c, 0xc1, 0xa9, 0xa6, 0xb9, 0x8a, 0xed, 0x73, 0x54,
-	0x1c, 0x27, 0x98, 0x84, 0xba, 0xf2, 0x68, 0xa8, 0xdd, 0x4c, 0x4a, 0x94, 0x54, 0x4f, 0xd1, 0xf7,
-	0xff, 0xa8, 0xc7, 0x3d, 0x70, 0x63, 0x86, 0xcd, 0xaf, 0x42, 0x5d, 0xe5, 0x59, 0x06, 0x6c, 0xf2,
-	0x1c, 0xbd, 0xef, 0xf8, 0x1e, 0x45, 0xee, 0x44, 0xfa, 0xc2, 0x06, 0x58, 0xee, 0xb8, 0x88, 0x9d,
-	0x2e, 0xae, 0xf5, 0xfc, 0x31, 0x65, 0x23, 0xc8, 0x88, 0x90, 0x85, 0x4f, 0x29, 0xe1, 0x82, 0xd9,
-	0x25, 0xae, 0x65, 0xc9, 0xae, 0xa7, 0xb1, 0x5b, 0x55, 0x50, 0xb0, 0xc1, 0x2a, 0x1c, 0xb2, 0x1a,
-	0x16, 0x1f, 0x68, 0x72, 0x62, 0xf8, 0x88, 0xa0, 0xf2, 0xf0, 0x11, 0x41, 0xe1, 0x1f, 0x15, 0x76,
-	0x03, 0x07, 0x75, 0x20, 0xba, 0xca, 0x82, 0x3c, 0xd9, 0x4d, 0xe6, 0xc9, 0xcc, 0xad, 0x8f, 0x8f,
-	0x99, 0x24, 0x46, 0x64, 0xce, 0xad, 0x60, 0x9b, 0x53, 0x15, 0x29, 0xfa, 0x34, 0x30, 0xfc, 0x87,
-	0x02, 0x6e, 0x4e, 0x81, 0xdf, 0x77, 0x4c, 0xcf, 0x6b, 0x9a, 0x7c, 0xe2, 0x66, 0x06, 0x3e, 0xfa,
-	0x96, 0x06, 0x8e, 0xe5, 0x09, 0x4b, 0x6f, 0x07, 0x96, 0x9e, 0xab, 0x5a, 0x3f, 0x17, 0x5b, 0xfc,
-	0x54, 0x01, 0xea, 0x2c, 0x57, 0x5c, 0x4a, 0x8d, 0xfd, 0xb3, 0x02, 0x5e, 0x9f, 0xbb, 0xf5, 0x4b,
-	0xa9, 0xb5, 0xff, 0x4c, 0x81, 0xe2, 0xb4, 0x48, 0xe9, 0xbc, 0xad, 0x1b, 0xbf, 0x18, 0x29, 0x73,
-	0x5e, 0x8c, 0xa4, 0x33, 0xb7, 0xf8, 0x2d, 0xcf, 0xdc, 0xa7, 0x0a, 0x28, 0x48, 0xd1, 0xe5, 0xb9,
-	0x14, 0x94, 0xe5, 0x7a, 0x72, 0xb3, 0xb3, 0x6d, 0x97, 0x73, 0x4d, 0x6a, 0x94, 0x4b, 0xa3, 0xa1,
-	0x56, 0x8c, 0xcb, 0x97, 0xf6, 0x93, 0xd0, 0x5d, 0xfc, 0x5c, 0x01, 0xd7, 0xa6, 0xca, 0xba, 0x58,
-	0xc0, 0x7e, 0x3a, 0x19, 0xb0, 0xb7, 0x5f, 0xe2, 0xb8, 0xcc, 0x8d, 0xde, 0x6f, 0x16, 0xc1, 0x8a,
-	0x1c, 0x6e, 0xf8, 0x01, 0xc8, 0x45, 0xb3, 0x92, 0xc2, 0x9d, 0xf6, 0xce, 0xf9, 0x19, 0x52, 0x8d,
-	0x4d, 0x48, 0xeb, 0x41, 0x70, 0x22, 0x39, 0x7a, 0xf4, 0xb7, 0xf8, 0x07, 0x05, 0xac, 0xce, 0xee,
-	0x59, 0x66, 0x3b, 0xe1, 0xe7, 0x93, 0x4e, 0xa8, 0x4a, 0x57, 0xf4, 0xf8, 0x75, 0xb4, 0xda, 0x3f,
-	0xed, 0xf2, 0x3b, 0x3b, 0x54, 0x57, 0x7d, 0xec, 0x9b, 0x98, 0xda, 0x74, 0x30, 0xd7, 0x0f, 0x5f,
-	0x2f, 0x81, 0xf5, 0x7d, 0xd2, 0x6e, 0x89, 0x8d, 0xda, 0xb8, 0xdb, 0xc0, 0x27, 0x04, 0x6e, 0x83,
-	0xac, 0x63, 0x9f, 0x20, 0x6a, 0xf7, 0x10, 0x37, 0xef, 0x8a, 0x78, 0x45, 0x0a, 0x61, 0xf2, 0x2b,
-	0x52, 0x08, 0x83, 0xf7, 0xc0, 0x8a, 0x49, 0x8d, 0x1e, 0xf1, 0xa8, 0x41, 0x70, 0x27, 0x6c, 0xee,
-	0x78, 0x21, 0x37, 0xe9, 0x23, 0xe2, 0xd1, 0x03, 0xdc, 0x91, 0x39, 0x41, 0x04, 0x85, 0x3f, 0x04,
-	0xf9, 0xbe, 0x8b, 0x18, 0xdc, 0x66, 0x83, 0x61, 0x8a, 0xb3, 0x6e, 0x8e, 0x86, 0xda, 0x35, 0x09,
-	0x2c, 0xf1, 0xca, 0xd4, 0xf0, 0x01, 0x28, 0x74, 0x08, 0xee, 0xf8, 0xae, 0x8b, 0x70, 0x67, 0x60,
-	0x78, 0xe6, 0x89, 0x78, 0x32, 0xcd, 0xd6, 0x6f, 0x8d, 0x86, 0xda, 0xa6, 0x84, 0x6b, 0x99, 0x27,
-	0xb2, 0x94, 0xb5, 0x18, 0x8a, 0x0d, 0x74, 0xe3, 0x67, 0x9c, 0x0e, 0xab, 0x30, 0x06, 0x7f, 0x4d,
-	0xcc, 0x44, 0x03, 0x5d, 0x3f, 0x5e, 0x7f, 0xe4, 0x81, 0x2e, 0x81, 0x84, 0x2d, 0x90, 0xf7, 0xfc,
-	0x76, 0xcf, 0xa6, 0x06, 0x77, 0xe5, 0xf2, 0xdc, 0x03, 0x1e, 0x3e, 0x40, 0x01, 0xc1, 0x36, 0x7e,
-	0x64, 0x95, 0xd6, 0x2c, 0x38, 0xa1, 0x26, 0x35, 0x1b, 0x05, 0x27, 0x84, 0xc9, 0xc1, 0x09, 0x61,
-	0xf0, 0x57, 0x60, 0x43, 0xa4, 0xb0, 0xe1, 0xa2, 0xa7, 0xbe, 0xed, 0xa2, 0x1e, 0x8a, 0xde, 0xec,
-	0x6e, 0x27, 0xf3, 0xfc, 0x80, 0xff, 0xea, 0x12, 0xad, 0x68, 0xa1, 0x48, 0x02, 0x2e, 0xb7, 0x50,
-	0x49, 0x2c, 0xac, 0x81, 0xe5, 0x33, 0xe4, 0x7a, 0x36, 0xc1, 0x6a, 0x8e, 0xdb, 0x7a, 0x6d, 0x34,
-	0xd4, 0xd6, 0x03, 0x90, 0xc4, 0x1b, 0x52, 0xc1, 0x06, 0x58, 0xe7, 0x6d, 0x81, 0x41, 0xa9, 0x63,
-	0x78, 0xa8, 0x43, 0xb0, 0xe5, 0xa9, 0xa0, 0xac, 0x6c, 0xa5, 0x44, 0x38, 0x39, 0xf2, 0x88, 0x3a,
-	0x2d, 0x81, 0x92, 0xc3, 0x19, 0x43, 0xdd, 0x4b, 0x7f, 0xfe, 0x85, 0xa6, 0x54, 0x7e, 0xaf, 0x00,
-	0x98, 0xdc, 0x0e, 0x74, 0xc0, 0x5a, 0x9f, 0x58, 0x32, 0x28, 0xe8, 0x79, 0x5e, 0x4f, 0x7a, 0xe3,
-	0x70, 0x92, 0x50, 0x18, 0x12, 0xe3, 0x8e, 0x0c, 0x79, 0xb0, 0xa0, 0xc7, 0x45, 0xd7, 0x57, 0xc1,
-	0x8a, 0xec, 0xf8, 0xca, 0x7f, 0x32, 0x60, 0x2d, 0x26, 0x15, 0x7a, 0xe2, 0x19, 0xb6, 0x85, 0x1c,
-	0xd4, 0xa1, 0xc4, 0x0d, 0x8a, 0xd0, 0x7b, 0x73, 0xcd, 0xe1, 0xdd, 0x6f, 0xc8, 0x25, 0x4a, 0x51,
-	0x71, 0x34, 0xd4, 0xae, 0xcb, 0xc2, 0x24, 0x37, 0x4d, 0x28, 0x81, 0x87, 0x20, 0x6b, 0x9e, 0x9c,
-	0xd8, 0x98, 0x25, 0x93, 0xa8, 0x30, 0x37, 0xa7, 0x0d, 0x01, 0x3b, 0x01, 0x8d, 0x48, 0xb5, 0x90,
-	0x43, 0x4e, 0xb5, 0x10, 0x06, 0x8f, 0x41, 0x9e, 0x12, 0x07, 0xb9, 0x26, 0xb5, 0x09, 0x0e, 0xc7,
-	0x82, 0xd2, 0xd4, 0xc9, 0x62, 0x4c, 0x36, 0xbe, 0xd8, 0x64, 0x56, 0x5d, 0x5e, 0x40, 0x02, 0xf2,
-	0x26, 0xc6, 0x84, 0x06, 0x62, 0x97, 0x67, 0x8d, 0x02, 0x71, 0xe7, 0xec, 0x44, 0x4c, 0xc2, 0x37,
-	0xbc, 0xac, 0x48, 0xa2, 0xe4, 0xb2, 0x22, 0x81, 0x27, 0x8e, 0x59, 0x9a, 0xb7, 0x3c, 0xf3, 0x8f,
-	0xd9, 0x3e, 0x28, 0x84, 0x95, 0x89, 0xe0, 0x43, 0xe2, 0xd8, 0x9d, 0x01, 0xff, 0xba, 0x92, 0x13,
-	0x97, 0x67, 0x1c, 0x27, 0x5f, 0x9e, 0x71, 0x1c, 0xfc, 0x18, 0x8c, 0x5f, 0x9d, 0x26, 0xb2, 0x34,
-	0xc3, 0xa3, 0xb4, 0x35, 0xcd, 0xa1, 0xfa, 0x14, 0xfa, 0xfa, 0xcd, 0xc0, 0xb5, 0x53, 0xa5, 0xe9,
-	0x53, 0xa1, 0xc5, 0x2e, 0x58, 0x4f, 0x24, 0xd5, 0x2b, 0x19, 0x7f, 0x4e, 0x40, 0x21, 0x1e, 0xa0,
-	0x57, 0xa1, 0x67, 0x3f, 0x9d, 0xcd, 0x16, 0x72, 0x95, 0xbf, 0x29, 0x60, 0xf3, 0xd0, 0x77, 0x3c,
-	0xd3, 0x6d, 0x85, 0x69, 0xb3, 0x4f, 0xda, 0xbb, 0x88, 0x9a, 0xb6, 0xe3, 0x31, 0x91, 0xfc, 0x91,
-	0x27, 0xd0, 0xcc, 0x45, 0x72, 0x80, 0x2c, 0x52, 0xbc, 0x2d, 0xbf, 0x05, 0x96, 0x1e, 0xc7, 0xa7,
-	0x9b, 0x78, 0x3b, 0x24, 0x28, 0xe0, 0x1d, 0x90, 0x61, 0xf7, 0x2b, 0xa2, 0xc1, 0x64, 0xc3, 0x07,
-	0x5f, 0x01, 0x91, 0x07, 0x5f, 0x01, 0xf9, 0xee, 0x01, 0xc8, 0x4b, 0x6f, 0x54, 0x30, 0x0f, 0x96,
-	0x8f, 0x9b, 0xef, 0x37, 0x0f, 0x7e, 0xd6, 0x2c, 0x2c, 0xb0, 0xc5, 0xe1, 0x5e, 0x73, 0xb7, 0xd1,
-	0xfc, 0x49, 0x41, 0x61, 0x0b, 0xfd, 0xb8, 0xd9, 0x64, 0x8b, 0x45, 0x78, 0x05, 0xe4, 0x5a, 0xc7,
-	0xf7, 0xef, 0xef, 0xed, 0xed, 0xee, 0xed, 0x16, 0x52, 0x10, 0x80, 0xcc, 0x8f, 0x77, 0x1a, 0x0f,
-	0xf7, 0x76, 0x0b, 0xe9, 0xfa, 0x2f, 0x9f, 0x3d, 0x2f, 0x29, 0x5f, 0x3d, 0x2f, 0x29, 0xff, 0x7e,
-	0x5e, 0x52, 0x3e, 0x7b, 0x51, 0x5a, 0xf8, 0xea, 0x45, 0x69, 0xe1, 0x5f, 0x2f, 0x4a, 0x0b, 0xbf,
-	0xb8, 0x2f, 0x7d, 0x30, 0x15, 0xcf, 0xc6, 0x7d, 0x97, 0xb0, 0x33, 0x14, 0xac, 0x6a, 0x17, 0xf8,
-	0x32, 0xdc, 0xce, 0xf0, 0x3b, 0xec, 0xbd, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x3e, 0xd0, 0x23,
-	0xda, 0x47, 0x1e, 0x00, 0x00,
+	// 2240 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x19, 0x4b, 0x6f, 0x1b, 0xc7,
+	0x59, 0x2b, 0x52, 0x12, 0x39, 0x92, 0x25, 0x6a, 0xe4, 0xc7, 0x8a, 0xb6, 0xb9, 0x0c, 0xe3, 0x06,
+	0x6a, 0xe3, 0x2c, 0x1b, 0xa7, 0x40, 0x0d, 0xb7, 0x17, 0xd1, 0x52, 0x6b, 0x3a, 0x36, 0x25, 0xaf,
+	0xa4, 0x16, 0x2d, 0xd0, 0x2c, 0x96, 0xdc, 0x11, 0xbd, 0xd1, 0x72, 0x86, 0xde, 0x9d, 0x75, 0xc2,
+	0x9c, 0xdb, 0x43, 0x11, 0x20, 0x0d, 0x8a, 0x3e, 0x02, 0x14, 0x28, 0x10, 0xf4, 0x92, 0x5f, 0xd0,
+	0x1e, 0xfa, 0x07, 0x7c, 0xcc, 0xb1, 0x27, 0xa6, 0xb0, 0x6f, 0xbc, 0xf6, 0x0f, 0x14, 0x33, 0xb3,
+	0xcb, 0x1d, 0xee, 0x2e, 0x45, 0xd9, 0xa9, 0xab, 0x93, 0x34, 0xdf, 0xfb, 0x35, 0xdf, 0x7e, 0xdf,
+	0x10, 0xdc, 0x71, 0x30, 0x45, 0x1e, 0xb6, 0xdc, 0xba, 0xdf, 0x79, 0x8c, 0xec, 0xc0, 0x45, 0x5e,
+	0xfc, 0x1f, 0x69, 0x7f, 0x88, 0x3a, 0xd4, 0x4f, 0x01, 0xf4, 0xbe, 0x47, 0x28, 0x81, 0xa5, 0x24,
+	0xbc, 0xac, 0x75, 0x09, 0xe9, 0xba, 0xa8, 0xce, 0xf1, 0xed, 0xe0, 0xb8, 0x4e, 0x9d, 0x1e, 0xf2,
+	0xa9, 0xd5, 0xeb, 0x0b, 0x96, 0x72, 0xed, 0xe4, 0xb6, 0xaf, 0x3b, 0xa4, 0x6e, 0xf5, 0x9d, 0x7a,
+	0x87, 0x78, 0xa8, 0xfe, 0xf4, 0xdd, 0x7a, 0x17, 0x61, 0xe4, 0x59, 0x14, 0xd9, 0x21, 0xcd, 0x0f,
+	0x62, 0x9a, 0x9e, 0xd5, 0x79, 0xec, 0x60, 0xe4, 0x0d, 0xea, 0xfd, 0x93, 0x2e, 0x67, 0xf2, 0x90,
+	0x4f, 0x02, 0xaf, 0x83, 0x52, 0x5c, 0xef, 0x74, 0x1d, 0xfa, 0x38, 0x68, 0xeb, 0x1d, 0xd2, 0xab,
+	0x77, 0x49, 0x97, 0xc4, 0x36, 0xb0, 0x13, 0x3f, 0xf0, 0xff, 0x04, 0x79, 0xed, 0xab, 0x1c, 0x28,
+	0xec, 0x7e, 0x8c, 0x3a, 0x01, 0x25, 0x1e, 0xac, 0x82, 0x79, 0xc7, 0x56, 0x95, 0xaa, 0xb2, 0x55,
+	0x6c, 0x94, 0x46, 0x43, 0x6d, 0xc5, 0xb1, 0x6f, 0x92, 0x9e, 0x43, 0x51, 0xaf, 0x4f, 0x07, 0xc6,
+	0xbc, 0x63, 0xc3, 0xb7, 0x40, 0xbe, 0x4f, 0x88, 0xab, 0xce, 0x73, 0x1a, 0x38, 0x1a, 0x6a, 0xab,
+	0xec, 0x2c, 0x51, 0x71, 0x3c, 0xdc, 0x06, 0x0b, 0x98, 0xd8, 0xc8, 0x57, 0x73, 0xd5, 0xdc, 0xd6,
+	0xf2, 0xad, 0xcb, 0x7a, 0x2a, 0x74, 0x2d, 0x62, 0xa3, 0xc6, 0xc6, 0x68, 0xa8, 0xad, 0x71, 0x42,
+	0x49, 0x82, 0xe0, 0x84, 0x1f, 0x80, 0xd5, 0x9e, 0x83, 0x9d, 0x5e, 0xd0, 0xbb, 0x4f, 0xda, 0x07,
+	0xce, 0x27, 0x48, 0xcd, 0x57, 0x95, 0xad, 0xe5, 0x5b, 0x95, 0xb4, 0x2c, 0x23, 0x0c, 0xc6, 0x03,
+	0xc7, 0xa7, 0x8d, 0xcb, 0xcf, 0x86, 0xda, 0x1c, 0x33, 0x6c, 0x92, 0xdb, 0x48, 0x9c, 0x99, 0x7c,
+	0xd7, 0xf2, 0xe9, 0x51, 0xdf, 0xb6, 0x28, 0x3a, 0x74, 0x7a, 0x48, 0x5d, 0xe0, 0xf2, 0xcb, 0xba,
+	0x48, 0x9e, 0x1e, 0x05, 0x4e, 0x3f, 0x8c, 0x92, 0xd7, 0x28, 0x47, 0xb2, 0x27, 0x39, 0x3f, 0xff,
+	0x46, 0x53, 0x8c, 0x04, 0x0c, 0xee, 0x81, 0x8d, 0x00, 0x5b, 0xbe, 0xef, 0x74, 0x31, 0xb2, 0xcd,
+	0x0f, 0x49, 0xdb, 0xf4, 0x02, 0xec, 0xab, 0xc5, 0x6a, 0x6e, 0xab, 0xd8, 0xd0, 0x46, 0x43, 0xed,
+	0x6a, 0x8c, 0xbe, 0x4f, 0xda, 0x46, 0x80, 0xe5, 0x20, 0xac, 0xa7, 0x90, 0xb5, 0xaf, 0x2e, 0x83,
+	0x3c, 0x8b, 0xda, 0xd9, 0xd2, 0x84, 0xad, 0x1e, 0x52, 0x57, 0xe2, 0x34, 0xb1, 0xb3, 0x9c, 0x26,
+	0x76, 0x86, 0xb7, 0x40, 0x01, 0x85, 0xc9, 0x57, 0x37, 0x38, 0xed, 0xe5, 0xd1, 0x50, 0x83, 0x11,
+	0x4c, 0xa2, 0x1f, 0xd3, 0xc1, 0xdb, 0x00, 0xb0, 0x04, 0xed, 0xb4, 0xdf, 0x47, 0x03, 0x5f, 0x85,
+	0xd5, 0xdc, 0xd6, 0x4a, 0x43, 0x1d, 0x0d, 0xb5, 0x8b, 0x31, 0x54, 0xe2, 0x93, 0x68, 0xe1, 0x43,
+	0x50, 0x64, 0x31, 0x32, 0x7d, 0x84, 0xb0, 0x3a, 0x3f, 0x33, 0xd8, 0x17, 0xc3, 0x60, 0x17, 0x18,
+	0xd3, 0x01, 0x42, 0x98, 0x87, 0x79, 0x7c, 0x82, 0x7b, 0xa0, 0xc8, 0x84, 0x9b, 0x74, 0xd0, 0x47,
+	0x6a, 0x2e, 0x14, 0x97, 0x59, 0x67, 0x87, 0x83, 0x3e, 0x12, 0x9e, 0xe1, 0xf0, 0x24, 0x7b, 0x16,
+	0xc1, 0xe0, 0x1d, 0xb0, 0x32, 0x16, 0x68, 0x3a, 0x36, 0xaf, 0xb7, 0x7c, 0xec, 0x1b, 0xa3, 0x69,
+	0xda, 0x49, 0xdf, 0x04, 0x14, 0x6e, 0x83, 0x45, 0x6a, 0x39, 0x98, 0xfa, 0xea, 0x02, 0xaf, 0xf8,
+	0x4d, 0x5d, 0xdc, 0x5e, 0xdd, 0xea, 0x3b, 0x3a, 0xbb, 0xe1, 0xfa, 0xd3, 0x77, 0xf5, 0x43, 0x46,
+	0xd1, 0x58, 0x0d, 0xfd, 0x0a, 0x19, 0x8c, 0xf0, 0x2f, 0xdc, 0x07, 0x8b, 0xae, 0xd5, 0x46, 0xae,
+	0xaf, 0x2e, 0x72, 0x11, 0xb5, 0x6c, 0x67, 0xf4, 0x07, 0x9c, 0x68, 0x17, 0x53, 0x6f, 0xd0, 0xb8,
+	0x38, 0x1a, 0x6a, 0x25, 0xc1, 0x25, 0x19, 0x16, 0xca, 0x81, 0x26, 0x58, 0xa3, 0x84, 0x5a, 0xae,
+	0x19, 0x75, 0x0b, 0x5f, 0x5d, 0x7a, 0xb9, 0x3b, 0xc4, 0xd9, 0x23, 0x94, 0x6f, 0x24, 0xce, 0xf0,
+	0xef, 0x0a, 0xb8, 0x61, 0xb9, 0x2e, 0xe9, 0x58, 0xd4, 0x6a, 0xbb, 0xc8, 0x6c, 0x0f, 0xcc, 0xbe,
+	0xe7, 0x10, 0xcf, 0xa1, 0x03, 0xd3, 0xc2, 0xf6, 0x58, 0xaf, 0x5a, 0xe0, 0x1e, 0xfd, 0x78, 0x8a,
+	0x47, 0xdb, 0xb1, 0x88, 0xc6, 0x60, 0x3f, 0x14, 0xb0, 0x8d, 0xed, 0x48, 0x91, 0xf0, 0x75, 0x2b,
+	0x34, 0xaa, 0x6a, 0xcd, 0x20, 0x37, 0x66, 0x52, 0x40, 0x0f, 0x6c, 0xf8, 0xd4, 0xa2, 0xdc, 0xe2,
+	0xf0, 0x6a, 0xb2, 0x8c, 0x17, 0xb9, 0x99, 0x6f, 0x4f, 0x31, 0xf3, 0x80, 0x71, 0x34, 0x06, 0xe2,
+	0x3e, 0x36, 0x6d, 0x61, 0xd5, 0x95, 0xd0, 0xaa, 0x35, 0x7f, 0x12, 0x6b, 0x24, 0x01, 0x30, 0x00,
+	0x1b, 0xa1, 0x5d, 0xc8, 0x8e, 0xf4, 0x3a, 0xb6, 0x0a, 0xb8, 0xce, 0x9b, 0xa7, 0x87, 0x06, 0xd9,
+	0x5c, 0x50, 0xa4, 0x54, 0x0d, 0x95, 0x96, 0xac, 0x04, 0xda, 0x48, 0x41, 0x20, 0x05, 0x70, 0x42,
+	0xed, 0x93, 0x00, 0x05, 0x48, 0x5d, 0x3e, 0xab, 0xd6, 0x47, 0x8c, 0x7c, 0xba, 0x56, 0x8e, 0x36,
+	0x52, 0x10, 0xe6, 0x2c, 0x7a, 0xea, 0x74, 0x68, 0xdc, 0xfa, 0x4c, 0xc7, 0xf6, 0xd5, 0xd5, 0x53,
+	0xd5, 0xee, 0x0a, 0x8e, 0x28, 0x62, 0x7e, 0x42, 0x2d, 0x4a, 0xa0, 0x8d, 0x14, 0x04, 0x7e, 0xa9,
+	0x80, 0x0a, 0x26, 0xd8, 0xb4, 0xbc, 0x9e, 0x65, 0x5b, 0x66, 0xec, 0x78, 0x7c, 0x03, 0x2e, 0x70,
+	0x13, 0x7e, 0x38, 0xc5, 0x84, 0x16, 0xc1, 0xdb, 0x9c, 0x77, 0x1c, 0x82, 0x71, 0xb5, 0x0b, 0x6b,
+	0xde, 0x0c, 0xad, 0xb9, 0x8a, 0xa7, 0x53, 0x1a, 0xa7, 0x21, 0xe1, 0x36, 0xb8, 0x10, 0xe0, 0x50,
+	0x3b, 0xab, 0x50, 0x75, 0xad, 0xaa, 0x6c, 0x15, 0x1a, 0x57, 0x47, 0x43, 0xed, 0xca, 0x04, 0x42,
+	0xba, 0xd1, 0x93, 0x1c, 0xf0, 0x53, 0x05, 0x5c, 0x89, 0x3c, 0x32, 0x03, 0xdf, 0xea, 0xa2, 0x38,
+	0xb3, 0x25, 0xee, 0xdf, 0xf7, 0xa7, 0xf8, 0x17, 0x99, 0x71, 0xc4, 0x98, 0x26, 0xb2, 0x5b, 0x1b,
+	0x0d, 0xb5, 0x8a, 0x97, 0x81, 0x96, 0xcc, 0xb8, 0x98, 0x85, 0x67, 0x5f, 0x3a, 0x0f, 0xf5, 0x89,
+	0x47, 0x1d, 0xdc, 0x35, 0xe3, 0x96, 0xbc, 0x5e, 0x55, 0xa2, 0x2f, 0xdd, 0x18, 0xdd, 0x4a, 0xf7,
+	0xdf, 0xf5, 0x14, 0xb2, 0x6c, 0x81, 0x65, 0xa9, 0xc9, 0xc1, 0x37, 0x41, 0xee, 0x04, 0x0d, 0xc2,
+	0x0f, 0xde, 0xfa, 0x68, 0xa8, 0x5d, 0x38, 0x41, 0x03, 0x49, 0x02, 0xc3, 0xc2, 0xef, 0x82, 0x85,
+	0xa7, 0x96, 0x1b, 0xa0, 0x70, 0x34, 0xe1, 0x93, 0x05, 0x07, 0xc8, 0x93, 0x05, 0x07, 0xdc, 0x99,
+	0xbf, 0xad, 0x94, 0xff, 0xa2, 0x80, 0xef, 0x9c, 0xa9, 0xed, 0xc8, 0xda, 0x17, 0xa6, 0x6a, 0x6f,
+	0xca, 0xda, 0x67, 0xf7, 0xd7, 0x59, 0xd6, 0xfd, 0x56, 0x01, 0x17, 0xb3, 0xba, 0xcd, 0xd9, 0x42,
+	0x71, 0x4f, 0x36, 0x66, 0xf5, 0xd6, 0xf5, 0xb4, 0x31, 0x42, 0xa8, 0xd0, 0x30, 0xcb, 0x96, 0x4f,
+	0x15, 0x70, 0x29, 0xb3, 0x0b, 0x9d, 0xcd, 0x98, 0xff, 0x71, 0x64, 0x12, 0xd6, 0xc4, 0xf5, 0x7b,
+	0x2e, 0xd6, 0x9c, 0x80, 0x4b, 0x99, 0x3d, 0xeb, 0x15, 0x4a, 0xb6, 0x30, 0x53, 0xd9, 0x9f, 0x14,
+	0x50, 0x9d, 0xd5, 0x9e, 0xce, 0xa5, 0x5a, 0x7f, 0xa7, 0x80, 0xcd, 0xa9, 0x7d, 0xe5, 0x3c, 0xf2,
+	0x52, 0xfb, 0x6b, 0x1e, 0x14, 0xa2, 0x6e, 0xc2, 0xc6, 0xe5, 0xa6, 0x18, 0x97, 0xf3, 0x62, 0x5c,
+	0x9e, 0x18, 0xe2, 0xe6, 0x27, 0x86, 0xb7, 0xf9, 0x57, 0x1d, 0xde, 0x0e, 0xc7, 0xc3, 0x9b, 0xd8,
+	0x78, 0xde, 0x9a, 0x3e, 0x89, 0xbe, 0xc4, 0x00, 0xf7, 0x6b, 0x05, 0xc0, 0x00, 0xfb, 0x88, 0x36,
+	0xb1, 0x8d, 0x3e, 0x46, 0xb6, 0xe0, 0x54, 0xf3, 0x5c, 0xc5, 0xad, 0x53, 0x54, 0x1c, 0xa5, 0x98,
+	0x84, 0xba, 0xea, 0x68, 0xa8, 0x5d, 0x4b, 0x4b, 0x94, 0x54, 0x67, 0xe8, 0xfb, 0x7f, 0xf4, 0xe3,
+	0x1e, 0xb8, 0x32, 0xc5, 0xe6, 0xd7, 0xa1, 0xae, 0xf6, 0x6c, 0x11, 0x6c, 0xf2, 0x1a, 0xbd, 0xeb,
+	0x06, 0x3e, 0x45, 0xde, 0x44, 0xf9, 0xc2, 0x26, 0x58, 0xea, 0x78, 0x88, 0xdd, 0x2e, 0x55, 0x09,
+	0xf7, 0x8a, 0xe9, 0x6b, 0xca, 0x46, 0x58, 0x11, 0x11, 0x0b, 0xdf, 0x52, 0xa2, 0x03, 0xb3, 0x4b,
+	0x7c, 0x96, 0x25, 0xbb, 0x9e, 0x24, 0xbe, 0xaa, 0x82, 0x82, 0x2d, 0x56, 0xd1, 0x92, 0xd5, 0xb4,
+	0xf9, 0x42, 0x53, 0x14, 0xcb, 0x47, 0x0c, 0x95, 0x98, 0x24, 0x5a, 0xf8, 0x47, 0x85, 0x7d, 0x81,
+	0xc3, 0x3e, 0x10, 0x7f, 0xca, 0xc2, 0x3a, 0xd9, 0x49, 0xd7, 0xc9, 0x54, 0xd7, 0x75, 0x23, 0x2d,
+	0x46, 0x54, 0xce, 0xf5, 0xd0, 0xcd, 0x4c, 0x45, 0x8a, 0x91, 0x05, 0x86, 0xff, 0x50, 0xc0, 0xb5,
+	0x0c, 0xf8, 0x5d, 0xd7, 0xf2, 0xfd, 0x96, 0xc5, 0x37, 0x6e, 0x66, 0xe0, 0xc3, 0x6f, 0x69, 0xe0,
+	0x58, 0x9e, 0xb0, 0xf4, 0x46, 0x68, 0xe9, 0xa9, 0xaa, 0x8d, 0x53, 0xb1, 0xe5, 0xcf, 0x14, 0xa0,
+	0x4e, 0x0b, 0xc5, 0xb9, 0xf4, 0xd8, 0x3f, 0x2b, 0xe0, 0x8d, 0x99, 0xae, 0x9f, 0x4b, 0xaf, 0xfd,
+	0x67, 0x0e, 0x94, 0xb3, 0x32, 0x65, 0xf0, 0xb1, 0x6e, 0xfc, 0x62, 0xa4, 0xcc, 0x78, 0x31, 0x92,
+	0xee, 0xdc, 0xfc, 0xb7, 0xbc, 0x73, 0x9f, 0x29, 0xa0, 0x24, 0x65, 0x97, 0xd7, 0x52, 0xd8, 0x96,
+	0x1b, 0x69, 0x67, 0xa7, 0xdb, 0xae, 0x1b, 0x09, 0x21, 0xa2, 0xbe, 0x2a, 0xa3, 0xa1, 0x56, 0x4e,
+	0xca, 0x97, 0xfc, 0x49, 0xe9, 0x2e, 0x7f, 0xa1, 0x80, 0x4b, 0x99, 0xb2, 0xce, 0x96, 0xb0, 0x9f,
+	0x4d, 0x26, 0xec, 0xed, 0x97, 0xb8, 0x2e, 0x33, 0xb3, 0xf7, 0x9b, 0x79, 0xb0, 0x22, 0xa7, 0x1b,
+	0x7e, 0x00, 0x8a, 0xf1, 0xae, 0xa4, 0xf0, 0xa0, 0xbd, 0x73, 0x7a, 0x85, 0xe8, 0x89, 0x0d, 0x69,
+	0x3d, 0x4c, 0x4e, 0x2c, 0xc7, 0x88, 0xff, 0x2d, 0xff, 0x41, 0x01, 0xab, 0xd3, 0x67, 0x96, 0xe9,
+	0x41, 0xf8, 0xc5, 0x64, 0x10, 0x74, 0xe9, 0x13, 0x3d, 0x7e, 0x1d, 0xd5, 0xfb, 0x27, 0x5d, 0x06,
+	0xd0, 0x23, 0x75, 0xfa, 0xa3, 0xc0, 0xc2, 0xd4, 0xa1, 0x83, 0x99, 0x71, 0xf8, 0x66, 0x01, 0xac,
+	0xb3, 0x97, 0x41, 0xe1, 0xa8, 0x83, 0xbb, 0x4d, 0x7c, 0x4c, 0xd8, 0xfb, 0x98, 0xeb, 0x1c, 0x23,
+	0xca, 0x5e, 0x07, 0x99, 0x79, 0x17, 0xc4, 0x2b, 0x52, 0x04, 0x93, 0x5f, 0x91, 0x22, 0x18, 0x7b,
+	0x45, 0xb2, 0xa8, 0xd9, 0x23, 0x3e, 0x35, 0x09, 0xee, 0x44, 0xc3, 0x1d, 0x6f, 0xe4, 0x16, 0x7d,
+	0x48, 0x7c, 0xba, 0x87, 0x3b, 0x32, 0x27, 0x88, 0xa1, 0xf0, 0x47, 0x60, 0xb9, 0xef, 0x21, 0x06,
+	0x77, 0xd8, 0x62, 0x98, 0xe3, 0xac, 0x9b, 0xa3, 0xa1, 0x76, 0x49, 0x02, 0x4b, 0xbc, 0x32, 0x35,
+	0xbc, 0x07, 0x4a, 0x1d, 0x82, 0x3b, 0x81, 0xe7, 0x21, 0xdc, 0x19, 0x98, 0xbe, 0x75, 0x2c, 0x9e,
+	0x4c, 0x0b, 0x8d, 0xeb, 0xa3, 0xa1, 0xb6, 0x29, 0xe1, 0x0e, 0xac, 0x63, 0x59, 0xca, 0x5a, 0x02,
+	0xc5, 0x16, 0xba, 0xf1, 0x33, 0x4e, 0x87, 0x75, 0x18, 0x93, 0xbf, 0x26, 0x2e, 0xc6, 0x0b, 0x5d,
+	0x3f, 0xd9, 0x7f, 0xe4, 0x85, 0x2e, 0x85, 0x84, 0x07, 0x60, 0xd9, 0x0f, 0xda, 0x3d, 0x87, 0x9a,
+	0x3c, 0x94, 0x4b, 0x33, 0x2f, 0x78, 0xf4, 0x00, 0x05, 0x04, 0xdb, 0xf8, 0x91, 0x55, 0x3a, 0xb3,
+	0xe4, 0x44, 0x9a, 0xd4, 0x42, 0x9c, 0x9c, 0x08, 0x26, 0x27, 0x27, 0x82, 0xc1, 0x8f, 0xc0, 0x86,
+	0x28, 0x61, 0xd3, 0x43, 0x4f, 0x02, 0xc7, 0x43, 0x3d, 0x14, 0xbf, 0xd9, 0xdd, 0x48, 0xd7, 0xf9,
+	0x1e, 0xff, 0x6b, 0x48, 0xb4, 0x62, 0x84, 0x22, 0x29, 0xb8, 0x3c, 0x42, 0xa5, 0xb1, 0xb0, 0x0e,
+	0x96, 0x9e, 0x22, 0xcf, 0x77, 0x08, 0x56, 0x8b, 0xdc, 0xd6, 0x4b, 0xa3, 0xa1, 0xb6, 0x1e, 0x82,
+	0x24, 0xde, 0x88, 0x0a, 0x36, 0xc1, 0x3a, 0x1f, 0x0b, 0x4c, 0x4a, 0x5d, 0xd3, 0x47, 0x1d, 0x82,
+	0x6d, 0x5f, 0x05, 0x55, 0x65, 0x2b, 0x27, 0xd2, 0xc9, 0x91, 0x87, 0xd4, 0x3d, 0x10, 0x28, 0x39,
+	0x9d, 0x09, 0xd4, 0x9d, 0xfc, 0x17, 0x5f, 0x6a, 0x4a, 0xed, 0xf7, 0x0a, 0x80, 0x69, 0x77, 0xa0,
+	0x0b, 0xd6, 0xfa, 0xc4, 0x96, 0x41, 0xe1, 0xcc, 0xf3, 0x46, 0x3a, 0x1a, 0xfb, 0x93, 0x84, 0xc2,
+	0x90, 0x04, 0x77, 0x6c, 0xc8, 0xbd, 0x39, 0x23, 0x29, 0xba, 0xb1, 0x0a, 0x56, 0xe4, 0xc0, 0xd7,
+	0xfe, 0xb3, 0x08, 0xd6, 0x12, 0x52, 0xa1, 0x2f, 0x9e, 0x61, 0x0f, 0x90, 0x8b, 0x3a, 0xec, 0x61,
+	0x5a, 0x34, 0xa1, 0xf7, 0x66, 0x9a, 0xa3, 0xb7, 0x24, 0x2e, 0xd1, 0x8a, 0xca, 0xa3, 0xa1, 0x76,
+	0x59, 0x16, 0x26, 0x85, 0x69, 0x42, 0x09, 0xdc, 0x07, 0x05, 0xeb, 0xf8, 0xd8, 0xc1, 0xac, 0x98,
+	0x44, 0x87, 0xb9, 0x96, 0xb5, 0x04, 0x6c, 0x87, 0x34, 0xa2, 0xd4, 0x22, 0x0e, 0xb9, 0xd4, 0x22,
+	0x18, 0x3c, 0x02, 0xcb, 0x94, 0xb8, 0xc8, 0xb3, 0xa8, 0x43, 0x70, 0xb4, 0x16, 0x54, 0x32, 0x37,
+	0x8b, 0x31, 0xd9, 0xf8, 0xc3, 0x26, 0xb3, 0x1a, 0xf2, 0x01, 0x12, 0xb0, 0x6c, 0x61, 0x4c, 0x68,
+	0x28, 0x76, 0x69, 0xda, 0x2a, 0x90, 0x0c, 0xce, 0x76, 0xcc, 0x24, 0x62, 0xc3, 0xdb, 0x8a, 0x24,
+	0x4a, 0x6e, 0x2b, 0x12, 0x78, 0xe2, 0x9a, 0xe5, 0xf9, 0xc8, 0x33, 0xfb, 0x9a, 0xdd, 0x07, 0xa5,
+	0xa8, 0x33, 0x11, 0xbc, 0x4f, 0x5c, 0xa7, 0x33, 0xe0, 0xbf, 0xae, 0x14, 0xc5, 0xc7, 0x33, 0x89,
+	0x93, 0x3f, 0x9e, 0x49, 0x1c, 0xfc, 0x04, 0x8c, 0x5f, 0x9d, 0x26, 0xaa, 0x74, 0x91, 0x67, 0x69,
+	0x2b, 0x2b, 0xa0, 0x46, 0x06, 0x7d, 0xe3, 0x5a, 0x18, 0xda, 0x4c, 0x69, 0x46, 0x26, 0xb4, 0xdc,
+	0x05, 0xeb, 0xa9, 0xa2, 0x7a, 0x2d, 0xeb, 0xcf, 0x31, 0x28, 0x25, 0x13, 0xf4, 0x3a, 0xf4, 0xdc,
+	0xcf, 0x17, 0x0a, 0xa5, 0x62, 0xed, 0x6f, 0x39, 0xb0, 0xb9, 0x1f, 0xb8, 0xbe, 0xe5, 0x1d, 0x44,
+	0x65, 0x73, 0x9f, 0xb4, 0x77, 0x10, 0xb5, 0x1c, 0xd7, 0x67, 0x22, 0xf9, 0x23, 0x8f, 0xaa, 0xc4,
+	0x22, 0x39, 0x40, 0x16, 0xc9, 0x01, 0x8c, 0xf4, 0x51, 0x72, 0xbb, 0x49, 0x8e, 0x43, 0x82, 0x02,
+	0xde, 0x04, 0x8b, 0xec, 0xfb, 0x8a, 0x68, 0xb8, 0xd9, 0xf0, 0xc5, 0x57, 0x40, 0xe4, 0xc5, 0x57,
+	0x40, 0x98, 0xe0, 0xbd, 0x8f, 0x30, 0xf2, 0xd4, 0x7c, 0x2c, 0x98, 0x03, 0x64, 0xc1, 0x1c, 0x00,
+	0x9f, 0x80, 0xe2, 0x78, 0x9e, 0x08, 0x1b, 0xf9, 0x9d, 0x8c, 0xeb, 0x30, 0xcd, 0xdd, 0xe4, 0xf4,
+	0x72, 0x85, 0xed, 0x38, 0x63, 0x98, 0xa4, 0x2e, 0xd6, 0x52, 0xb6, 0x5f, 0x6d, 0x84, 0x99, 0xc8,
+	0x95, 0x32, 0x2b, 0x57, 0xdf, 0xdb, 0x03, 0xcb, 0xd2, 0x3b, 0x1d, 0x5c, 0x06, 0x4b, 0x47, 0xad,
+	0xf7, 0x5b, 0x7b, 0x3f, 0x6f, 0x95, 0xe6, 0xd8, 0x61, 0x7f, 0xb7, 0xb5, 0xd3, 0x6c, 0xfd, 0xb4,
+	0xa4, 0xb0, 0x83, 0x71, 0xd4, 0x6a, 0xb1, 0xc3, 0x3c, 0xbc, 0x00, 0x8a, 0x07, 0x47, 0x77, 0xef,
+	0xee, 0xee, 0xee, 0xec, 0xee, 0x94, 0x72, 0x10, 0x80, 0xc5, 0x9f, 0x6c, 0x37, 0x1f, 0xec, 0xee,
+	0x94, 0xf2, 0x8d, 0x5f, 0x3d, 0x7b, 0x5e, 0x51, 0xbe, 0x7e, 0x5e, 0x51, 0xfe, 0xfd, 0xbc, 0xa2,
+	0x7c, 0xfe, 0xa2, 0x32, 0xf7, 0xf5, 0x8b, 0xca, 0xdc, 0xbf, 0x5e, 0x54, 0xe6, 0x7e, 0x79, 0x57,
+	0xfa, 0xd1, 0x58, 0x3c, 0x9d, 0xf7, 0x3d, 0xc2, 0x02, 0x17, 0x9e, 0xea, 0x67, 0xf8, 0x75, 0xbc,
+	0xbd, 0xc8, 0xbf, 0xe3, 0xef, 0xfd, 0x77, 0x00, 0x31, 0xd9, 0xec, 0x5d, 0x4b, 0x1f, 0x00, 0x00,
 }
 
 func (m *Executor) Marshal() (dAtA []byte, err error) {
@@ -2111,6 +2133,31 @@ func (m *PulsarSchedulerJobDetails) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if len(m.Resources) > 0 {
+		for k := range m.Resources {
+			v := m.Resources[k]
+			baseI := i
+			i -= 8
+			encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(v))))
+			i--
+			dAtA[i] = 0x11
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintSchedulerobjects(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintSchedulerobjects(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintSchedulerobjects(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.JobSet) > 0 {
 		i -= len(m.JobSet)
 		copy(dAtA[i:], m.JobSet)
@@ -2548,6 +2595,18 @@ func (m *PulsarSchedulerJobDetails) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovSchedulerobjects(uint64(l))
 	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovSchedulerobjects(uint64(l))
+	}
+	if len(m.Resources) > 0 {
+		for k, v := range m.Resources {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovSchedulerobjects(uint64(len(k))) + 1 + 8
+			n += mapEntrySize + 1 + sovSchedulerobjects(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
@@ -6284,6 +6343,144 @@ func (m *PulsarSchedulerJobDetails) Unmarshal(dAtA []byte) error {
 			}
 			m.JobSet = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerobjects
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedulerobjects
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedulerobjects
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedulerobjects
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedulerobjects
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedulerobjects
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Resources == nil {
+				m.Resources = make(map[string]float64)
+			}
+			var mapkey string
+			var mapvalue float64
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowSchedulerobjects
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowSchedulerobjects
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthSchedulerobjects
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthSchedulerobjects
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapvaluetemp uint64
+					if (iNdEx + 8) > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvaluetemp = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+					iNdEx += 8
+					mapvalue = math.Float64frombits(mapvaluetemp)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipSchedulerobjects(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthSchedulerobjects
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Resources[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSchedulerobjects(dAtA[iNdEx:])
@@ -15,6 +15,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/clock"
 
 	"github.com/armadaproject/armada/internal/armada/configuration"
+	legacyrepository "github.com/armadaproject/armada/internal/armada/repository"
 	"github.com/armadaproject/armada/internal/common/armadacontext"
 	"github.com/armadaproject/armada/internal/common/logging"
 	armadaslices "github.com/armadaproject/armada/internal/common/slices"
@@ -39,9 +40,12 @@ type SchedulingAlgo interface {
 
 // FairSchedulingAlgo is a SchedulingAlgo based on PreemptingQueueScheduler.
 type FairSchedulingAlgo struct {
-	schedulingConfig            configuration.SchedulingConfig
-	executorRepository          database.ExecutorRepository
-	queueRepository             database.QueueRepository
+	schedulingConfig   configuration.SchedulingConfig
+	executorRepository database.ExecutorRepository
+	queueRepository    database.QueueRepository
+	// Cordoned queues and executors, which are stored by the Armada server and to which no new leases are given.
+	// If nil, nothing is cordoned.
+	cordonRepository            legacyrepository.CordonRepository
 	schedulingContextRepository *SchedulingContextRepository
 	// Global job scheduling rate-limiter.
 	limiter *rate.Limiter
@@ -64,6 +68,7 @@ func NewFairSchedulingAlgo(
 	maxSchedulingDuration time.Duration,
 	executorRepository database.ExecutorRepository,
 	queueRepository database.QueueRepository,
+	cordonRepository legacyrepository.CordonRepository,
 	schedulingContextRepository *SchedulingContextRepository,
 ) (*FairSchedulingAlgo, error) {
 	if _, ok := config.Preemption.PriorityClasses[config.Preemption.DefaultPriorityClass]; !ok {
@@ -73,6 +78,7 @@ func NewFairSchedulingAlgo(
 		schedulingConfig:            config,
 		executorRepository:          executorRepository,
 		queueRepository:             queueRepository,
+		cordonRepository:            cordonRepository,
 		schedulingContextRepository: schedulingContextRepository,
 		limiter:                     rate.NewLimiter(rate.Limit(config.MaximumSchedulingRate), config.MaximumSchedulingBurst),
 		limiterByQueue:              make(map[string]*rate.Limiter),
@@ -237,7 +243,9 @@ type fairSchedulingAlgoContext struct {
 	gangIdByJobId                            map[string]string
 	allocationByPoolAndQueueAndPriorityClass map[string]map[string]schedulerobjects.QuantityByTAndResourceType[string]
 	executors                                []*schedulerobjects.Executor
-	txn                                      *jobdb.Txn
+	// Queues whose queued jobs aren't scheduled, since they're cordoned.
+	cordonedQueues map[string]bool
+	txn            *jobdb.Txn
 }
 
 func (l *FairSchedulingAlgo) newFairSchedulingAlgoContext(ctx *armadacontext.Context, txn *jobdb.Txn) (*fairSchedulingAlgoContext, error) {
@@ -314,6 +322,14 @@ func (l *FairSchedulingAlgo) newFairSchedulingAlgoContext(ctx *armadacontext.Con
 	// Note that we do this after aggregating allocation across clusters for fair share.
 	executors = l.filterLaggingExecutors(ctx, executors, jobsByExecutorId)
 
+	// Cordoned executors are likewise filtered out after aggregating allocation, such that jobs running on them
+	// count towards fair share, but aren't preempted, and no new jobs are scheduled onto them.
+	cordonedQueues, cordonedExecutors, err := l.getCordons()
+	if err != nil {
+		return nil, err
+	}
+	executors = l.filterCordonedExecutors(executors, cordonedExecutors)
+
 	return &fairSchedulingAlgoContext{
 		priorityFactorByQueue:                    priorityFactorByQueue,
 		isActiveByQueueName:                      isActiveByQueueName,
@@ -324,6 +340,7 @@ func (l *FairSchedulingAlgo) newFairSchedulingAlgoContext(ctx *armadacontext.Con
 		gangIdByJobId:                            gangIdByJobId,
 		allocationByPoolAndQueueAndPriorityClass: totalAllocationByPoolAndQueue,
 		executors:                                executors,
+		cordonedQueues:                           cordonedQueues,
 		txn:                                      txn,
 	}, nil
 }
@@ -422,7 +439,7 @@ func (l *FairSchedulingAlgo) scheduleOnExecutors(
 		l.schedulingConfig.Preemption.NodeEvictionProbability,
 		l.schedulingConfig.Preemption.NodeOversubscriptionEvictionProbability,
 		l.schedulingConfig.Preemption.ProtectedFractionOfFairShare,
-		NewSchedulerJobRepositoryAdapter(fsctx.txn),
+		&SchedulerJobRepositoryAdapter{txn: fsctx.txn, cordonedQueues: fsctx.cordonedQueues},
 		nodeDb,
 		fsctx.nodeIdByJobId,
 		fsctx.jobIdsByGangId,
@@ -482,6 +499,9 @@ func (l *FairSchedulingAlgo) scheduleOnExecutors(
 // TODO: Pass JobDb into the scheduler instead of using this shim to convert to a JobRepo.
 type SchedulerJobRepositoryAdapter struct {
 	txn *jobdb.Txn
+	// No queued jobs are returned for cordoned queues, such that they aren't scheduled.
+	// Running jobs of cordoned queues are unaffected.
+	cordonedQueues map[string]bool
 }
 
 func NewSchedulerJobRepositoryAdapter(txn *jobdb.Txn) *SchedulerJobRepositoryAdapter {
@@ -494,6 +514,9 @@ func NewSchedulerJobRepositoryAdapter(txn *jobdb.Txn) *SchedulerJobRepositoryAda
 // to new scheduler.
 func (repo *SchedulerJobRepositoryAdapter) GetQueueJobIds(queue string) ([]string, error) {
 	rv := make([]string, 0)
+	if repo.cordonedQueues[queue] {
+		return rv, nil
+	}
 	it := repo.txn.QueuedJobs(queue)
 	for v, _ := it.Next(); v != nil; v, _ = it.Next() {
 		rv = append(rv, v.Id())
@@ -560,6 +583,41 @@ func (l *FairSchedulingAlgo) filterStaleExecutors(executors []*schedulerobjects.
 	return activeExecutors
 }
 
+// getCordons returns the names of cordoned queues and the ids of cordoned executors.
+func (l *FairSchedulingAlgo) getCordons() (map[string]bool, map[string]bool, error) {
+	if l.cordonRepository == nil {
+		return nil, nil, nil
+	}
+	queueCordons, err := l.cordonRepository.GetCordonedQueues()
+	if err != nil {
+		return nil, nil, err
+	}
+	executorCordons, err := l.cordonRepository.GetCordonedExecutors()
+	if err != nil {
+		return nil, nil, err
+	}
+	cordonedQueues := make(map[string]bool, len(queueCordons))
+	for queue := range queueCordons {
+		cordonedQueues[queue] = true
+	}
+	cordonedExecutors := make(map[string]bool, len(executorCordons))
+	for executorId := range executorCordons {
+		cordonedExecutors[executorId] = true
+	}
+	return cordonedQueues, cordonedExecutors, nil
+}
+
+// filterCordonedExecutors returns all executors that aren't cordoned.
+func (l *FairSchedulingAlgo) filterCordonedExecutors(executors []*schedulerobjects.Executor, cordonedExecutors map[string]bool) []*schedulerobjects.Executor {
+	return armadaslices.Filter(executors, func(executor *schedulerobjects.Executor) bool {
+		if cordonedExecutors[executor.Id] {
+			logrus.Debugf("Ignoring executor %s because it's cordoned", executor.Id)
+			return false
+		}
+		return true
+	})
+}
+
 // filterLaggingExecutors returns all executors with <= l.schedulingConfig.MaxUnacknowledgedJobsPerExecutor unacknowledged jobs,
 // where unacknowledged means the executor has not echoed the job since it was scheduled.
 //
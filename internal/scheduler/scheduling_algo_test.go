@@ -13,6 +13,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/clock"
 
 	"github.com/armadaproject/armada/internal/armada/configuration"
+	legacyrepository "github.com/armadaproject/armada/internal/armada/repository"
 	"github.com/armadaproject/armada/internal/common/armadacontext"
 	armadaslices "github.com/armadaproject/armada/internal/common/slices"
 	"github.com/armadaproject/armada/internal/scheduler/database"
@@ -21,6 +22,7 @@ import (
 	"github.com/armadaproject/armada/internal/scheduler/nodedb"
 	"github.com/armadaproject/armada/internal/scheduler/schedulerobjects"
 	"github.com/armadaproject/armada/internal/scheduler/testfixtures"
+	"github.com/armadaproject/armada/pkg/api"
 )
 
 func TestSchedule(t *testing.T) {
@@ -49,6 +51,10 @@ func TestSchedule(t *testing.T) {
 
 		// Count of jobs expected to fail
 		expectedFailedJobCount int
+
+		// Names of cordoned queues and ids of cordoned executors.
+		cordonedQueues    []string
+		cordonedExecutors []string
 	}{
 		"scheduling": {
 			schedulingConfig: testfixtures.TestSchedulingConfig(),
@@ -338,6 +344,46 @@ func TestSchedule(t *testing.T) {
 			},
 			expectedScheduledIndices: []int{0},
 		},
+		"do not schedule onto cordoned executors": {
+			schedulingConfig: testfixtures.TestSchedulingConfig(),
+			executors: []*schedulerobjects.Executor{
+				testfixtures.Test1Node32CoreExecutor("executor1"),
+				testfixtures.Test1Node32CoreExecutor("executor2"),
+			},
+			queues:                   []*database.Queue{testfixtures.TestDbQueue()},
+			queuedJobs:               testfixtures.N16Cpu128GiJobs(testfixtures.TestQueue, testfixtures.PriorityClass3, 10),
+			cordonedExecutors:        []string{"executor2"},
+			expectedScheduledIndices: []int{0, 1},
+		},
+		"do not schedule jobs of cordoned queues": {
+			schedulingConfig: testfixtures.TestSchedulingConfig(),
+			executors: []*schedulerobjects.Executor{
+				testfixtures.Test1Node32CoreExecutor("executor1"),
+				testfixtures.Test1Node32CoreExecutor("executor2"),
+			},
+			queues:         []*database.Queue{testfixtures.TestDbQueue()},
+			queuedJobs:     testfixtures.N16Cpu128GiJobs(testfixtures.TestQueue, testfixtures.PriorityClass3, 10),
+			cordonedQueues: []string{testfixtures.TestQueue},
+		},
+		"do not preempt jobs running on cordoned executors": {
+			schedulingConfig: testfixtures.TestSchedulingConfig(),
+			executors: []*schedulerobjects.Executor{
+				testfixtures.Test1Node32CoreExecutor("executor1"),
+				testfixtures.Test1Node32CoreExecutor("executor2"),
+			},
+			queues:     []*database.Queue{testfixtures.TestDbQueue()},
+			queuedJobs: testfixtures.N16Cpu128GiJobs(testfixtures.TestQueue, testfixtures.PriorityClass3, 10),
+			scheduledJobsByExecutorIndexAndNodeIndex: map[int]map[int]scheduledJobs{
+				0: {
+					0: scheduledJobs{
+						jobs:         testfixtures.N16Cpu128GiJobs(testfixtures.TestQueue, testfixtures.PriorityClass3, 2),
+						acknowledged: true,
+					},
+				},
+			},
+			cordonedExecutors:        []string{"executor1"},
+			expectedScheduledIndices: []int{0, 1},
+		},
 		"UnifiedSchedulingByPool": {
 			schedulingConfig: testfixtures.WithUnifiedSchedulingByPoolConfig(testfixtures.TestSchedulingConfig()),
 			executors: []*schedulerobjects.Executor{
@@ -369,6 +415,22 @@ func TestSchedule(t *testing.T) {
 			mockQueueRepo := schedulermocks.NewMockQueueRepository(ctrl)
 			mockQueueRepo.EXPECT().GetAllQueues().Return(tc.queues, nil).AnyTimes()
 
+			var cordonRepo legacyrepository.CordonRepository
+			if len(tc.cordonedQueues) > 0 || len(tc.cordonedExecutors) > 0 {
+				cordonedQueues := make(map[string]*api.Cordon)
+				for _, queue := range tc.cordonedQueues {
+					cordonedQueues[queue] = &api.Cordon{Name: queue}
+				}
+				cordonedExecutors := make(map[string]*api.Cordon)
+				for _, executorId := range tc.cordonedExecutors {
+					cordonedExecutors[executorId] = &api.Cordon{Name: executorId}
+				}
+				mockCordonRepo := schedulermocks.NewMockCordonRepository(ctrl)
+				mockCordonRepo.EXPECT().GetCordonedQueues().Return(cordonedQueues, nil).AnyTimes()
+				mockCordonRepo.EXPECT().GetCordonedExecutors().Return(cordonedExecutors, nil).AnyTimes()
+				cordonRepo = mockCordonRepo
+			}
+
 			schedulingContextRepo, err := NewSchedulingContextRepository(1024)
 			require.NoError(t, err)
 			sch, err := NewFairSchedulingAlgo(
@@ -376,6 +438,7 @@ func TestSchedule(t *testing.T) {
 				0,
 				mockExecutorRepo,
 				mockQueueRepo,
+				cordonRepo,
 				schedulingContextRepo,
 			)
 			require.NoError(t, err)
@@ -531,6 +594,7 @@ func BenchmarkNodeDbConstruction(b *testing.B) {
 					nil,
 					nil,
 					nil,
+					nil,
 				)
 				require.NoError(b, err)
 				b.StartTimer()
@@ -0,0 +1,117 @@
+package scheduler
+
+import (
+	"container/heap"
+
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+
+	"github.com/armadaproject/armada/internal/armada/configuration"
+)
+
+// UserWeight returns the weight of owner according to config.
+func UserWeight(config configuration.UserFairShareConfig, owner string) float64 {
+	if weight, ok := config.UserWeights[owner]; ok {
+		return weight
+	}
+	return config.DefaultUserWeight
+}
+
+// InterleaveJobIdsByOwner merges the job ids of all owners into a single slice indicating the order in which jobs
+// should be scheduled. The relative order of the jobs of each owner is preserved. Otherwise, jobs are interleaved
+// such that any prefix of the returned slice contains a number of jobs of each owner approximately proportional
+// to the weight of that owner, as long as that owner has jobs left. Ties are broken by owner name.
+//
+// Jobs of owners with non-positive weight are placed after those of all other owners.
+func InterleaveJobIdsByOwner(jobIdsByOwner map[string][]string, weightOf func(owner string) float64) []string {
+	owners := maps.Keys(jobIdsByOwner)
+	slices.Sort(owners)
+	n := 0
+	pq := make(ownerJobIdsPQ, 0, len(owners))
+	var unweighted []string
+	for _, owner := range owners {
+		jobIds := jobIdsByOwner[owner]
+		if len(jobIds) == 0 {
+			continue
+		}
+		n += len(jobIds)
+		weight := weightOf(owner)
+		if weight <= 0 {
+			unweighted = append(unweighted, owner)
+			continue
+		}
+		pq = append(pq, &ownerJobIdsPQItem{
+			owner:       owner,
+			jobIds:      jobIds,
+			weight:      weight,
+			virtualTime: 1 / weight,
+			index:       len(pq),
+		})
+	}
+	heap.Init(&pq)
+
+	rv := make([]string, 0, n)
+	for pq.Len() > 0 {
+		item := pq[0]
+		rv = append(rv, item.jobIds[0])
+		item.jobIds = item.jobIds[1:]
+		if len(item.jobIds) == 0 {
+			heap.Pop(&pq)
+			continue
+		}
+		// An owner that has been offered k jobs is next offered a job at virtual time (k+1)/weight.
+		item.virtualTime += 1 / item.weight
+		heap.Fix(&pq, item.index)
+	}
+	for _, owner := range unweighted {
+		rv = append(rv, jobIdsByOwner[owner]...)
+	}
+	return rv
+}
+
+type ownerJobIdsPQItem struct {
+	owner string
+	// Ids of jobs of this owner not yet added to the interleaved order.
+	jobIds []string
+	weight float64
+	// The next job of this owner is added to the interleaved order at this virtual time.
+	virtualTime float64
+	// The index of the item in the heap.
+	// maintained by the heap.Interface methods.
+	index int
+}
+
+type ownerJobIdsPQ []*ownerJobIdsPQItem
+
+func (pq ownerJobIdsPQ) Len() int { return len(pq) }
+
+func (pq ownerJobIdsPQ) Less(i, j int) bool {
+	// Tie-break by owner name.
+	if pq[i].virtualTime == pq[j].virtualTime {
+		return pq[i].owner < pq[j].owner
+	}
+	return pq[i].virtualTime < pq[j].virtualTime
+}
+
+func (pq ownerJobIdsPQ) Swap(i, j int) {
+	pq[i], pq[j] = pq[j], pq[i]
+	pq[i].index = i
+	pq[j].index = j
+}
+
+func (pq *ownerJobIdsPQ) Push(x any) {
+	n := len(*pq)
+	item := x.(*ownerJobIdsPQItem)
+	item.index = n
+	*pq = append(*pq, item)
+}
+
+func (pq *ownerJobIdsPQ) Pop() any {
+	old := *pq
+	n := len(old)
+	item := old[n-1]
+	old[n-1] = nil // avoid memory leak
+	item.index = -1
+	*pq = old[0 : n-1]
+	return item
+}
@@ -0,0 +1,53 @@
+package scheduler
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/armadaproject/armada/internal/armada/configuration"
+)
+
+func TestInterleaveJobIdsByOwner(t *testing.T) {
+	tests := map[string]struct {
+		jobIdsByOwner map[string][]string
+		weights       map[string]float64
+		expected      []string
+	}{
+		"no jobs": {
+			jobIdsByOwner: map[string][]string{},
+			expected:      []string{},
+		},
+		"one owner": {
+			jobIdsByOwner: map[string][]string{"alice": {"a1", "a2", "a3"}},
+			expected:      []string{"a1", "a2", "a3"},
+		},
+		"equal weights": {
+			jobIdsByOwner: map[string][]string{"alice": {"a1", "a2", "a3"}, "bob": {"b1", "b2"}},
+			expected:      []string{"a1", "b1", "a2", "b2", "a3"},
+		},
+		"unequal weights": {
+			jobIdsByOwner: map[string][]string{"alice": {"a1", "a2", "a3", "a4"}, "bob": {"b1", "b2", "b3"}},
+			weights:       map[string]float64{"alice": 2},
+			expected:      []string{"a1", "a2", "b1", "a3", "a4", "b2", "b3"},
+		},
+		"zero weight": {
+			jobIdsByOwner: map[string][]string{"alice": {"a1", "a2"}, "bob": {"b1", "b2"}, "carol": {"c1"}},
+			weights:       map[string]float64{"alice": 0},
+			expected:      []string{"b1", "c1", "b2", "a1", "a2"},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			config := configuration.UserFairShareConfig{
+				Enabled:           true,
+				DefaultUserWeight: 1,
+				UserWeights:       tc.weights,
+			}
+			actual := InterleaveJobIdsByOwner(tc.jobIdsByOwner, func(owner string) float64 {
+				return UserWeight(config, owner)
+			})
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package scheduler
+
+import (
+	"strings"
+
+	schedulerconstraints "github.com/armadaproject/armada/internal/scheduler/constraints"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+// JobWaitReasonTypeFromUnschedulableReason classifies the reason a job couldn't be scheduled in a scheduling round.
+func JobWaitReasonTypeFromUnschedulableReason(reason string) api.JobWaitReasonType {
+	switch {
+	case reason == schedulerconstraints.MaximumResourcesPerQueueExceededUnschedulableReason:
+		return api.JobWaitReasonType_QuotaExhausted
+	case strings.Contains(reason, "does not fit on any node"), strings.HasPrefix(reason, "job requests "):
+		return api.JobWaitReasonType_NoMatchingCluster
+	case strings.Contains(reason, "minimum cardinality not met"):
+		return api.JobWaitReasonType_GangIncomplete
+	default:
+		return api.JobWaitReasonType_OtherWaitReason
+	}
+}
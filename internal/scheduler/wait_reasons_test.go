@@ -0,0 +1,25 @@
+package scheduler
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	schedulerconstraints "github.com/armadaproject/armada/internal/scheduler/constraints"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+func TestJobWaitReasonTypeFromUnschedulableReason(t *testing.T) {
+	tests := map[string]api.JobWaitReasonType{
+		schedulerconstraints.MaximumResourcesPerQueueExceededUnschedulableReason: api.JobWaitReasonType_QuotaExhausted,
+		"job does not fit on any node":                                           api.JobWaitReasonType_NoMatchingCluster,
+		"job requests 2 gpu, but the largest node has 1":                         api.JobWaitReasonType_NoMatchingCluster,
+		"gang minimum cardinality not met":                                       api.JobWaitReasonType_GangIncomplete,
+		"unknown":                                                                api.JobWaitReasonType_OtherWaitReason,
+	}
+	for reason, expected := range tests {
+		t.Run(reason, func(t *testing.T) {
+			assert.Equal(t, expected, JobWaitReasonTypeFromUnschedulableReason(reason))
+		})
+	}
+}
@@ -108,7 +108,18 @@ func (c *InstructionConverter) dbOperationsFromEventSequence(es *armadaevents.Ev
 			*armadaevents.EventSequence_Event_ResourceUtilisation,
 			*armadaevents.EventSequence_Event_StandaloneIngressInfo,
 			*armadaevents.EventSequence_Event_JobRunPreempted,
-			*armadaevents.EventSequence_Event_JobRunAssigned:
+			*armadaevents.EventSequence_Event_JobRunAssigned,
+			*armadaevents.EventSequence_Event_JobSetExpired,
+			*armadaevents.EventSequence_Event_JobSuspended,
+			*armadaevents.EventSequence_Event_JobResumed,
+			*armadaevents.EventSequence_Event_JobEvictedForCapacity,
+			*armadaevents.EventSequence_Event_JobRuntimeExceeded,
+			*armadaevents.EventSequence_Event_JobResourcesNormalized,
+			*armadaevents.EventSequence_Event_JobUnschedulable,
+			*armadaevents.EventSequence_Event_JobMaintenanceWindowStarted,
+			*armadaevents.EventSequence_Event_JobMaintenanceWindowEnded,
+			*armadaevents.EventSequence_Event_JobCordoned,
+			*armadaevents.EventSequence_Event_JobUncordoned:
 			// These events can all be safely ignored
 			log.Debugf("Ignoring event type %T", event)
 		default:
@@ -67,6 +67,7 @@ func (srv *EventWatcher) Run(ctx context.Context) error {
 				Queue:         srv.Queue,
 				FromMessageId: fromMessageId,
 				Watch:         true,
+				SchemaVersion: api.EventSchemaVersion,
 			})
 			if err != nil {
 				return err
@@ -226,7 +227,7 @@ func assertEventErrorString(expected []*api.EventMessage, indexByJobId map[strin
 func isTerminalEvent(msg *api.EventMessage) bool {
 	switch msg.Events.(type) {
 	case *api.EventMessage_Failed:
-		return true
+		return !msg.GetFailed().WillRetry
 	case *api.EventMessage_Succeeded:
 		return true
 	case *api.EventMessage_Cancelled:
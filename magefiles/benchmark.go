@@ -0,0 +1,38 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/magefile/mage/sh"
+)
+
+// BenchmarkSubmit drives SubmitJobs and CancelJobs against a throwaway Redis and writes a report and profiles
+// to benchmark-results. Flags for cmd/armada-bench, e.g., "--rate 100 --duration 10m --baseline report.json",
+// may be passed via ARMADA_BENCH_ARGS.
+func BenchmarkSubmit() error {
+	docker_Net, err := dockerNet()
+	if err != nil {
+		return err
+	}
+	if err := dockerRun("run", "-d", "--name=redis-benchmark", docker_Net, "-p=6379:6379", "redis:6.2.6"); err != nil {
+		return err
+	}
+	defer func() {
+		if err := dockerRun("rm", "-f", "redis-benchmark"); err != nil {
+			fmt.Println(err)
+		}
+	}()
+	if err := sh.Run("sleep", "3"); err != nil {
+		return err
+	}
+
+	args := []string{
+		"run", "./cmd/armada-bench",
+		"--config", "./developer/config/benchmark.yaml",
+		"--output", "benchmark-results",
+	}
+	args = append(args, strings.Fields(os.Getenv("ARMADA_BENCH_ARGS"))...)
+	return sh.RunV("go", args...)
+}
@@ -99,6 +99,7 @@ func protoGenerate() error {
 		"internal/scheduler/simulator/*.proto",
 		"pkg/api/binoculars/*.proto",
 		"pkg/api/jobservice/*.proto",
+		"pkg/api/v2/*.proto",
 		"pkg/executorapi/*.proto",
 	}
 	for _, pattern := range patterns {
@@ -112,7 +113,7 @@ func protoGenerate() error {
 		}
 	}
 
-	err := protoProtocRun(false, true, "./pkg/api/api", "pkg/api/event.proto", "pkg/api/submit.proto")
+	err := protoProtocRun(false, true, "./pkg/api/api", "pkg/api/event.proto", "pkg/api/submit.proto", "pkg/api/query.proto")
 	if err != nil {
 		return err
 	}
@@ -170,6 +171,7 @@ func protoProtocRun(armada, grpcGateway bool, swaggerFileName string, paths ...s
 		"Mgoogle/protobuf/duration.proto=github.com/gogo/protobuf/types," +
 		"Mgoogle/protobuf/struct.proto=github.com/gogo/protobuf/types," +
 		"Mgoogle/protobuf/empty.proto=github.com/gogo/protobuf/types," +
+		"Mgoogle/protobuf/field_mask.proto=github.com/gogo/protobuf/types," +
 		"Mgoogle/protobuf/timestamp.proto=github.com/gogo/protobuf/types," +
 		"Mgoogle/protobuf/wrappers.proto=github.com/gogo/protobuf/types"
 
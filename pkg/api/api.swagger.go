@@ -66,6 +66,18 @@ func SwaggerJsonTemplate() string {
 		"            \"format\": \"int64\",\n" +
 		"            \"name\": \"num\",\n" +
 		"            \"in\": \"query\"\n" +
+		"          },\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"description\": \"If provided, only queues whose names start with this prefix are returned.\",\n" +
+		"            \"name\": \"namePrefix\",\n" +
+		"            \"in\": \"query\"\n" +
+		"          },\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"description\": \"If provided, only queues whose name, description, or contact contain this text, ignoring case, are returned.\",\n" +
+		"            \"name\": \"search\",\n" +
+		"            \"in\": \"query\"\n" +
 		"          }\n" +
 		"        ],\n" +
 		"        \"responses\": {\n" +
@@ -125,51 +137,47 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
-		"    \"/v1/job-set/{queue}/{id}\": {\n" +
-		"      \"post\": {\n" +
-		"        \"produces\": [\n" +
-		"          \"application/ndjson-stream\"\n" +
+		"    \"/v1/cordons\": {\n" +
+		"      \"get\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Submit\"\n" +
 		"        ],\n" +
+		"        \"operationId\": \"GetCordons\",\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiCordonList\"\n" +
+		"            }\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"/v1/executor/{id}\": {\n" +
+		"      \"get\": {\n" +
 		"        \"tags\": [\n" +
-		"          \"Event\"\n" +
+		"          \"Submit\"\n" +
 		"        ],\n" +
-		"        \"operationId\": \"GetJobSetEvents\",\n" +
+		"        \"operationId\": \"GetExecutor\",\n" +
 		"        \"parameters\": [\n" +
 		"          {\n" +
 		"            \"type\": \"string\",\n" +
-		"            \"name\": \"queue\",\n" +
-		"            \"in\": \"path\",\n" +
-		"            \"required\": true\n" +
-		"          },\n" +
-		"          {\n" +
-		"            \"type\": \"string\",\n" +
 		"            \"name\": \"id\",\n" +
 		"            \"in\": \"path\",\n" +
 		"            \"required\": true\n" +
-		"          },\n" +
-		"          {\n" +
-		"            \"name\": \"body\",\n" +
-		"            \"in\": \"body\",\n" +
-		"            \"required\": true,\n" +
-		"            \"schema\": {\n" +
-		"              \"$ref\": \"#/definitions/apiJobSetRequest\"\n" +
-		"            }\n" +
 		"          }\n" +
 		"        ],\n" +
 		"        \"responses\": {\n" +
 		"          \"200\": {\n" +
-		"            \"description\": \"A successful response.(streaming responses)\",\n" +
+		"            \"description\": \"A successful response.\",\n" +
 		"            \"schema\": {\n" +
-		"              \"type\": \"file\",\n" +
-		"              \"title\": \"Stream result of apiEventStreamMessage\",\n" +
-		"              \"properties\": {\n" +
-		"                \"error\": {\n" +
-		"                  \"$ref\": \"#/definitions/runtimeStreamError\"\n" +
-		"                },\n" +
-		"                \"result\": {\n" +
-		"                  \"$ref\": \"#/definitions/apiEventStreamMessage\"\n" +
-		"                }\n" +
-		"              }\n" +
+		"              \"$ref\": \"#/definitions/apiExecutorStatus\"\n" +
 		"            }\n" +
 		"          },\n" +
 		"          \"default\": {\n" +
@@ -181,19 +189,27 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
-		"    \"/v1/job/cancel\": {\n" +
+		"    \"/v1/executor/{name}/cordon\": {\n" +
 		"      \"post\": {\n" +
 		"        \"tags\": [\n" +
 		"          \"Submit\"\n" +
 		"        ],\n" +
-		"        \"operationId\": \"CancelJobs\",\n" +
+		"        \"summary\": \"Stops new leases from being given to an executor. Requires the cordon_executors permission.\",\n" +
+		"        \"operationId\": \"CordonExecutor\",\n" +
 		"        \"parameters\": [\n" +
 		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"description\": \"Name of the queue or id of the executor to cordon.\",\n" +
+		"            \"name\": \"name\",\n" +
+		"            \"in\": \"path\",\n" +
+		"            \"required\": true\n" +
+		"          },\n" +
+		"          {\n" +
 		"            \"name\": \"body\",\n" +
 		"            \"in\": \"body\",\n" +
 		"            \"required\": true,\n" +
 		"            \"schema\": {\n" +
-		"              \"$ref\": \"#/definitions/apiJobCancelRequest\"\n" +
+		"              \"$ref\": \"#/definitions/apiCordonRequest\"\n" +
 		"            }\n" +
 		"          }\n" +
 		"        ],\n" +
@@ -201,7 +217,7 @@ func SwaggerJsonTemplate() string {
 		"          \"200\": {\n" +
 		"            \"description\": \"A successful response.\",\n" +
 		"            \"schema\": {\n" +
-		"              \"$ref\": \"#/definitions/apiCancellationResult\"\n" +
+		"              \"$ref\": \"#/definitions/apiCordon\"\n" +
 		"            }\n" +
 		"          },\n" +
 		"          \"default\": {\n" +
@@ -211,30 +227,24 @@ func SwaggerJsonTemplate() string {
 		"            }\n" +
 		"          }\n" +
 		"        }\n" +
-		"      }\n" +
-		"    },\n" +
-		"    \"/v1/job/reprioritize\": {\n" +
-		"      \"post\": {\n" +
+		"      },\n" +
+		"      \"delete\": {\n" +
 		"        \"tags\": [\n" +
 		"          \"Submit\"\n" +
 		"        ],\n" +
-		"        \"operationId\": \"ReprioritizeJobs\",\n" +
+		"        \"operationId\": \"UncordonExecutor\",\n" +
 		"        \"parameters\": [\n" +
 		"          {\n" +
-		"            \"name\": \"body\",\n" +
-		"            \"in\": \"body\",\n" +
-		"            \"required\": true,\n" +
-		"            \"schema\": {\n" +
-		"              \"$ref\": \"#/definitions/apiJobReprioritizeRequest\"\n" +
-		"            }\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"name\": \"name\",\n" +
+		"            \"in\": \"path\",\n" +
+		"            \"required\": true\n" +
 		"          }\n" +
 		"        ],\n" +
 		"        \"responses\": {\n" +
 		"          \"200\": {\n" +
 		"            \"description\": \"A successful response.\",\n" +
-		"            \"schema\": {\n" +
-		"              \"$ref\": \"#/definitions/apiJobReprioritizeResponse\"\n" +
-		"            }\n" +
+		"            \"schema\": {}\n" +
 		"          },\n" +
 		"          \"default\": {\n" +
 		"            \"description\": \"An unexpected error response.\",\n" +
@@ -245,27 +255,18 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
-		"    \"/v1/job/submit\": {\n" +
-		"      \"post\": {\n" +
+		"    \"/v1/executors\": {\n" +
+		"      \"get\": {\n" +
 		"        \"tags\": [\n" +
 		"          \"Submit\"\n" +
 		"        ],\n" +
-		"        \"operationId\": \"SubmitJobs\",\n" +
-		"        \"parameters\": [\n" +
-		"          {\n" +
-		"            \"name\": \"body\",\n" +
-		"            \"in\": \"body\",\n" +
-		"            \"required\": true,\n" +
-		"            \"schema\": {\n" +
-		"              \"$ref\": \"#/definitions/apiJobSubmitRequest\"\n" +
-		"            }\n" +
-		"          }\n" +
-		"        ],\n" +
+		"        \"summary\": \"Returns the executors that have heartbeated, along with their health.\",\n" +
+		"        \"operationId\": \"GetExecutors\",\n" +
 		"        \"responses\": {\n" +
 		"          \"200\": {\n" +
 		"            \"description\": \"A successful response.\",\n" +
 		"            \"schema\": {\n" +
-		"              \"$ref\": \"#/definitions/apiJobSubmitResponse\"\n" +
+		"              \"$ref\": \"#/definitions/apiExecutorList\"\n" +
 		"            }\n" +
 		"          },\n" +
 		"          \"default\": {\n" +
@@ -277,26 +278,19 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
-		"    \"/v1/jobset/cancel\": {\n" +
-		"      \"post\": {\n" +
+		"    \"/v1/fair-share/shares\": {\n" +
+		"      \"get\": {\n" +
 		"        \"tags\": [\n" +
 		"          \"Submit\"\n" +
 		"        ],\n" +
-		"        \"operationId\": \"CancelJobSet\",\n" +
-		"        \"parameters\": [\n" +
-		"          {\n" +
-		"            \"name\": \"body\",\n" +
-		"            \"in\": \"body\",\n" +
-		"            \"required\": true,\n" +
-		"            \"schema\": {\n" +
-		"              \"$ref\": \"#/definitions/apiJobSetCancelRequest\"\n" +
-		"            }\n" +
-		"          }\n" +
-		"        ],\n" +
+		"        \"summary\": \"Returns the fair shares and dominant resource shares of queues as computed by the legacy scheduler.\",\n" +
+		"        \"operationId\": \"GetFairShares\",\n" +
 		"        \"responses\": {\n" +
 		"          \"200\": {\n" +
 		"            \"description\": \"A successful response.\",\n" +
-		"            \"schema\": {}\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiFairShares\"\n" +
+		"            }\n" +
 		"          },\n" +
 		"          \"default\": {\n" +
 		"            \"description\": \"An unexpected error response.\",\n" +
@@ -307,26 +301,18 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
-		"    \"/v1/queue\": {\n" +
-		"      \"post\": {\n" +
+		"    \"/v1/fair-share/weights\": {\n" +
+		"      \"get\": {\n" +
 		"        \"tags\": [\n" +
 		"          \"Submit\"\n" +
 		"        ],\n" +
-		"        \"operationId\": \"CreateQueue\",\n" +
-		"        \"parameters\": [\n" +
-		"          {\n" +
-		"            \"name\": \"body\",\n" +
-		"            \"in\": \"body\",\n" +
-		"            \"required\": true,\n" +
-		"            \"schema\": {\n" +
-		"              \"$ref\": \"#/definitions/apiQueue\"\n" +
-		"            }\n" +
-		"          }\n" +
-		"        ],\n" +
+		"        \"operationId\": \"GetFairShareWeights\",\n" +
 		"        \"responses\": {\n" +
 		"          \"200\": {\n" +
 		"            \"description\": \"A successful response.\",\n" +
-		"            \"schema\": {}\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiFairShareWeights\"\n" +
+		"            }\n" +
 		"          },\n" +
 		"          \"default\": {\n" +
 		"            \"description\": \"An unexpected error response.\",\n" +
@@ -337,25 +323,34 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
-		"    \"/v1/queue/{name}\": {\n" +
-		"      \"get\": {\n" +
+		"    \"/v1/fair-share/weights/{queue}\": {\n" +
+		"      \"put\": {\n" +
 		"        \"tags\": [\n" +
 		"          \"Submit\"\n" +
 		"        ],\n" +
-		"        \"operationId\": \"GetQueue\",\n" +
+		"        \"summary\": \"Requires the set_fair_share_weights permission.\",\n" +
+		"        \"operationId\": \"SetFairShareWeight\",\n" +
 		"        \"parameters\": [\n" +
 		"          {\n" +
 		"            \"type\": \"string\",\n" +
-		"            \"name\": \"name\",\n" +
+		"            \"name\": \"queue\",\n" +
 		"            \"in\": \"path\",\n" +
 		"            \"required\": true\n" +
+		"          },\n" +
+		"          {\n" +
+		"            \"name\": \"body\",\n" +
+		"            \"in\": \"body\",\n" +
+		"            \"required\": true,\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiSetFairShareWeightRequest\"\n" +
+		"            }\n" +
 		"          }\n" +
 		"        ],\n" +
 		"        \"responses\": {\n" +
 		"          \"200\": {\n" +
 		"            \"description\": \"A successful response.\",\n" +
 		"            \"schema\": {\n" +
-		"              \"$ref\": \"#/definitions/apiQueue\"\n" +
+		"              \"$ref\": \"#/definitions/apiQueueFairShareWeight\"\n" +
 		"            }\n" +
 		"          },\n" +
 		"          \"default\": {\n" +
@@ -365,16 +360,27 @@ func SwaggerJsonTemplate() string {
 		"            }\n" +
 		"          }\n" +
 		"        }\n" +
-		"      },\n" +
-		"      \"put\": {\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"/v1/job-set/{queue}/{id}\": {\n" +
+		"      \"post\": {\n" +
+		"        \"produces\": [\n" +
+		"          \"application/ndjson-stream\"\n" +
+		"        ],\n" +
 		"        \"tags\": [\n" +
-		"          \"Submit\"\n" +
+		"          \"Event\"\n" +
 		"        ],\n" +
-		"        \"operationId\": \"UpdateQueue\",\n" +
+		"        \"operationId\": \"GetJobSetEvents\",\n" +
 		"        \"parameters\": [\n" +
 		"          {\n" +
 		"            \"type\": \"string\",\n" +
-		"            \"name\": \"name\",\n" +
+		"            \"name\": \"queue\",\n" +
+		"            \"in\": \"path\",\n" +
+		"            \"required\": true\n" +
+		"          },\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"name\": \"id\",\n" +
 		"            \"in\": \"path\",\n" +
 		"            \"required\": true\n" +
 		"          },\n" +
@@ -383,14 +389,25 @@ func SwaggerJsonTemplate() string {
 		"            \"in\": \"body\",\n" +
 		"            \"required\": true,\n" +
 		"            \"schema\": {\n" +
-		"              \"$ref\": \"#/definitions/apiQueue\"\n" +
+		"              \"$ref\": \"#/definitions/apiJobSetRequest\"\n" +
 		"            }\n" +
 		"          }\n" +
 		"        ],\n" +
 		"        \"responses\": {\n" +
 		"          \"200\": {\n" +
-		"            \"description\": \"A successful response.\",\n" +
-		"            \"schema\": {}\n" +
+		"            \"description\": \"A successful response.(streaming responses)\",\n" +
+		"            \"schema\": {\n" +
+		"              \"type\": \"file\",\n" +
+		"              \"title\": \"Stream result of apiEventStreamMessage\",\n" +
+		"              \"properties\": {\n" +
+		"                \"error\": {\n" +
+		"                  \"$ref\": \"#/definitions/runtimeStreamError\"\n" +
+		"                },\n" +
+		"                \"result\": {\n" +
+		"                  \"$ref\": \"#/definitions/apiEventStreamMessage\"\n" +
+		"                }\n" +
+		"              }\n" +
+		"            }\n" +
 		"          },\n" +
 		"          \"default\": {\n" +
 		"            \"description\": \"An unexpected error response.\",\n" +
@@ -399,24 +416,76 @@ func SwaggerJsonTemplate() string {
 		"            }\n" +
 		"          }\n" +
 		"        }\n" +
-		"      },\n" +
-		"      \"delete\": {\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"/v1/job-set/{queue}/{jobSetId}/job/{jobId}/logs\": {\n" +
+		"      \"get\": {\n" +
 		"        \"tags\": [\n" +
 		"          \"Submit\"\n" +
 		"        ],\n" +
-		"        \"operationId\": \"DeleteQueue\",\n" +
+		"        \"summary\": \"Streams the container logs of the most recent run of a job, proxied from the cluster it ran on.\",\n" +
+		"        \"operationId\": \"GetJobLogs\",\n" +
 		"        \"parameters\": [\n" +
 		"          {\n" +
 		"            \"type\": \"string\",\n" +
-		"            \"name\": \"name\",\n" +
+		"            \"name\": \"queue\",\n" +
+		"            \"in\": \"path\",\n" +
+		"            \"required\": true\n" +
+		"          },\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"name\": \"jobSetId\",\n" +
+		"            \"in\": \"path\",\n" +
+		"            \"required\": true\n" +
+		"          },\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"name\": \"jobId\",\n" +
 		"            \"in\": \"path\",\n" +
 		"            \"required\": true\n" +
+		"          },\n" +
+		"          {\n" +
+		"            \"type\": \"integer\",\n" +
+		"            \"format\": \"int32\",\n" +
+		"            \"description\": \"Index of the pod of the job, for jobs with several pods.\",\n" +
+		"            \"name\": \"podNumber\",\n" +
+		"            \"in\": \"query\"\n" +
+		"          },\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"description\": \"Container to return the logs of. May be omitted if the pod has a single container.\",\n" +
+		"            \"name\": \"container\",\n" +
+		"            \"in\": \"query\"\n" +
+		"          },\n" +
+		"          {\n" +
+		"            \"type\": \"boolean\",\n" +
+		"            \"description\": \"If set, logs are streamed as they're written until the run of the job ends.\",\n" +
+		"            \"name\": \"follow\",\n" +
+		"            \"in\": \"query\"\n" +
+		"          },\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"format\": \"int64\",\n" +
+		"            \"description\": \"If positive, only this many of the most recent lines are returned initially.\",\n" +
+		"            \"name\": \"tailLines\",\n" +
+		"            \"in\": \"query\"\n" +
 		"          }\n" +
 		"        ],\n" +
 		"        \"responses\": {\n" +
 		"          \"200\": {\n" +
-		"            \"description\": \"A successful response.\",\n" +
-		"            \"schema\": {}\n" +
+		"            \"description\": \"A successful response.(streaming responses)\",\n" +
+		"            \"schema\": {\n" +
+		"              \"type\": \"object\",\n" +
+		"              \"title\": \"Stream result of apiJobLogs\",\n" +
+		"              \"properties\": {\n" +
+		"                \"error\": {\n" +
+		"                  \"$ref\": \"#/definitions/runtimeStreamError\"\n" +
+		"                },\n" +
+		"                \"result\": {\n" +
+		"                  \"$ref\": \"#/definitions/apiJobLogs\"\n" +
+		"                }\n" +
+		"              }\n" +
+		"            }\n" +
 		"          },\n" +
 		"          \"default\": {\n" +
 		"            \"description\": \"An unexpected error response.\",\n" +
@@ -427,16 +496,29 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
-		"    \"/v1/queue/{name}/info\": {\n" +
+		"    \"/v1/job-set/{queue}/{jobSetId}/job/{jobId}/placements\": {\n" +
 		"      \"get\": {\n" +
 		"        \"tags\": [\n" +
-		"          \"Submit\"\n" +
+		"          \"Query\"\n" +
 		"        ],\n" +
-		"        \"operationId\": \"GetQueueInfo\",\n" +
+		"        \"summary\": \"Returns the cluster, pool, and node each run of a job was placed on, e.g., to find failures correlated with hardware.\",\n" +
+		"        \"operationId\": \"GetJobPlacements\",\n" +
 		"        \"parameters\": [\n" +
 		"          {\n" +
 		"            \"type\": \"string\",\n" +
-		"            \"name\": \"name\",\n" +
+		"            \"name\": \"queue\",\n" +
+		"            \"in\": \"path\",\n" +
+		"            \"required\": true\n" +
+		"          },\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"name\": \"jobSetId\",\n" +
+		"            \"in\": \"path\",\n" +
+		"            \"required\": true\n" +
+		"          },\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"name\": \"jobId\",\n" +
 		"            \"in\": \"path\",\n" +
 		"            \"required\": true\n" +
 		"          }\n" +
@@ -445,7 +527,7 @@ func SwaggerJsonTemplate() string {
 		"          \"200\": {\n" +
 		"            \"description\": \"A successful response.\",\n" +
 		"            \"schema\": {\n" +
-		"              \"$ref\": \"#/definitions/apiQueueInfo\"\n" +
+		"              \"$ref\": \"#/definitions/apiJobPlacements\"\n" +
 		"            }\n" +
 		"          },\n" +
 		"          \"default\": {\n" +
@@ -456,225 +538,3307 @@ func SwaggerJsonTemplate() string {
 		"          }\n" +
 		"        }\n" +
 		"      }\n" +
-		"    }\n" +
+		"    },\n" +
+		"    \"/v1/job-set/{queue}/{jobSetId}/status\": {\n" +
+		"      \"post\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Query\"\n" +
+		"        ],\n" +
+		"        \"operationId\": \"GetJobStatus\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"name\": \"queue\",\n" +
+		"            \"in\": \"path\",\n" +
+		"            \"required\": true\n" +
+		"          },\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"name\": \"jobSetId\",\n" +
+		"            \"in\": \"path\",\n" +
+		"            \"required\": true\n" +
+		"          },\n" +
+		"          {\n" +
+		"            \"name\": \"body\",\n" +
+		"            \"in\": \"body\",\n" +
+		"            \"required\": true,\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiJobStatusRequest\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiJobStatusResponse\"\n" +
+		"            }\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"/v1/job-set/{queue}/{jobSetId}/watch\": {\n" +
+		"      \"post\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Query\"\n" +
+		"        ],\n" +
+		"        \"summary\": \"WatchJobs streams the status of each requested job, followed by its new status each time it changes.\\nThe stream ends once every job has succeeded, failed, or been cancelled.\",\n" +
+		"        \"operationId\": \"WatchJobs\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"name\": \"queue\",\n" +
+		"            \"in\": \"path\",\n" +
+		"            \"required\": true\n" +
+		"          },\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"name\": \"jobSetId\",\n" +
+		"            \"in\": \"path\",\n" +
+		"            \"required\": true\n" +
+		"          },\n" +
+		"          {\n" +
+		"            \"name\": \"body\",\n" +
+		"            \"in\": \"body\",\n" +
+		"            \"required\": true,\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiJobStatusRequest\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.(streaming responses)\",\n" +
+		"            \"schema\": {\n" +
+		"              \"type\": \"object\",\n" +
+		"              \"title\": \"Stream result of apiJobStatus\",\n" +
+		"              \"properties\": {\n" +
+		"                \"error\": {\n" +
+		"                  \"$ref\": \"#/definitions/runtimeStreamError\"\n" +
+		"                },\n" +
+		"                \"result\": {\n" +
+		"                  \"$ref\": \"#/definitions/apiJobStatus\"\n" +
+		"                }\n" +
+		"              }\n" +
+		"            }\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"/v1/job/cancel\": {\n" +
+		"      \"post\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Submit\"\n" +
+		"        ],\n" +
+		"        \"operationId\": \"CancelJobs\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"name\": \"body\",\n" +
+		"            \"in\": \"body\",\n" +
+		"            \"required\": true,\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiJobCancelRequest\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiCancellationResult\"\n" +
+		"            }\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"/v1/job/preempt\": {\n" +
+		"      \"post\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Submit\"\n" +
+		"        ],\n" +
+		"        \"summary\": \"Evicts leased jobs of a queue, e.g., to urgently reclaim capacity, and either requeues or fails them.\",\n" +
+		"        \"operationId\": \"PreemptJobs\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"name\": \"body\",\n" +
+		"            \"in\": \"body\",\n" +
+		"            \"required\": true,\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiJobPreemptRequest\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiJobPreemptResponse\"\n" +
+		"            }\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"/v1/job/reprioritize\": {\n" +
+		"      \"post\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Submit\"\n" +
+		"        ],\n" +
+		"        \"operationId\": \"ReprioritizeJobs\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"name\": \"body\",\n" +
+		"            \"in\": \"body\",\n" +
+		"            \"required\": true,\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiJobReprioritizeRequest\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiJobReprioritizeResponse\"\n" +
+		"            }\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"/v1/job/resubmit\": {\n" +
+		"      \"post\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Submit\"\n" +
+		"        ],\n" +
+		"        \"summary\": \"Submits jobs again as new jobs, optionally with changes to their specs. Each new job is annotated with the id of\\nthe job it was resubmitted from (armadaproject.io/resubmittedFrom), which is included in its submitted event.\",\n" +
+		"        \"operationId\": \"ResubmitJobs\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"name\": \"body\",\n" +
+		"            \"in\": \"body\",\n" +
+		"            \"required\": true,\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiJobResubmitRequest\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiJobResubmitResponse\"\n" +
+		"            }\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"/v1/job/submit\": {\n" +
+		"      \"post\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Submit\"\n" +
+		"        ],\n" +
+		"        \"operationId\": \"SubmitJobs\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"name\": \"body\",\n" +
+		"            \"in\": \"body\",\n" +
+		"            \"required\": true,\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiJobSubmitRequest\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiJobSubmitResponse\"\n" +
+		"            }\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"/v1/job/{jobId}/details\": {\n" +
+		"      \"get\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Query\"\n" +
+		"        ],\n" +
+		"        \"summary\": \"Returns the spec and state of a job that hasn't completed, provided the caller may watch its queue.\",\n" +
+		"        \"operationId\": \"GetJobDetails\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"name\": \"jobId\",\n" +
+		"            \"in\": \"path\",\n" +
+		"            \"required\": true\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiJobDetails\"\n" +
+		"            }\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"/v1/job/{jobId}/wait-reasons\": {\n" +
+		"      \"get\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Submit\"\n" +
+		"        ],\n" +
+		"        \"summary\": \"Returns the current reasons a queued job hasn't been scheduled, as observed by the most recent scheduling rounds.\",\n" +
+		"        \"operationId\": \"GetJobWaitReasons\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"name\": \"jobId\",\n" +
+		"            \"in\": \"path\",\n" +
+		"            \"required\": true\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiJobWaitReasons\"\n" +
+		"            }\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"/v1/jobset/cancel\": {\n" +
+		"      \"post\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Submit\"\n" +
+		"        ],\n" +
+		"        \"operationId\": \"CancelJobSet\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"name\": \"body\",\n" +
+		"            \"in\": \"body\",\n" +
+		"            \"required\": true,\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiJobSetCancelRequest\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {}\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"/v1/jobset/pause\": {\n" +
+		"      \"post\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Submit\"\n" +
+		"        ],\n" +
+		"        \"summary\": \"Suspends all queued jobs of a job set, such that they aren't leased until the job set is resumed.\\nOnly supported for jobs of the legacy scheduler.\",\n" +
+		"        \"operationId\": \"PauseJobSet\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"name\": \"body\",\n" +
+		"            \"in\": \"body\",\n" +
+		"            \"required\": true,\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiJobSetPauseRequest\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {}\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"/v1/jobset/resume\": {\n" +
+		"      \"post\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Submit\"\n" +
+		"        ],\n" +
+		"        \"summary\": \"Returns the suspended jobs of a job set to the queue.\",\n" +
+		"        \"operationId\": \"ResumeJobSet\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"name\": \"body\",\n" +
+		"            \"in\": \"body\",\n" +
+		"            \"required\": true,\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiJobSetResumeRequest\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {}\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"/v1/maintenance-windows\": {\n" +
+		"      \"get\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Submit\"\n" +
+		"        ],\n" +
+		"        \"operationId\": \"GetMaintenanceWindows\",\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiMaintenanceWindowList\"\n" +
+		"            }\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      },\n" +
+		"      \"post\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Submit\"\n" +
+		"        ],\n" +
+		"        \"summary\": \"Schedules a maintenance window of an executor or queue. Requires the manage_maintenance_windows permission.\",\n" +
+		"        \"operationId\": \"CreateMaintenanceWindow\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"name\": \"body\",\n" +
+		"            \"in\": \"body\",\n" +
+		"            \"required\": true,\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiMaintenanceWindowCreateRequest\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiMaintenanceWindow\"\n" +
+		"            }\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"/v1/maintenance-windows/{id}\": {\n" +
+		"      \"delete\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Submit\"\n" +
+		"        ],\n" +
+		"        \"summary\": \"Deletes a maintenance window, ending it if it has started. Requires the manage_maintenance_windows permission.\",\n" +
+		"        \"operationId\": \"DeleteMaintenanceWindow\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"name\": \"id\",\n" +
+		"            \"in\": \"path\",\n" +
+		"            \"required\": true\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {}\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"/v1/node-types\": {\n" +
+		"      \"get\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Query\"\n" +
+		"        ],\n" +
+		"        \"summary\": \"Returns the types of nodes of each cluster, e.g., to check whether any cluster has nodes with 8 GPUs.\",\n" +
+		"        \"operationId\": \"GetNodeTypes\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"description\": \"If set, only node types of this cluster are returned.\",\n" +
+		"            \"name\": \"clusterId\",\n" +
+		"            \"in\": \"query\"\n" +
+		"          },\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"description\": \"If set, only node types of clusters of this pool are returned.\",\n" +
+		"            \"name\": \"pool\",\n" +
+		"            \"in\": \"query\"\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiNodeTypesResponse\"\n" +
+		"            }\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"/v1/operation/{name}\": {\n" +
+		"      \"get\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Submit\"\n" +
+		"        ],\n" +
+		"        \"summary\": \"Returns the current state of a long-running operation, e.g., a cascading queue deletion.\",\n" +
+		"        \"operationId\": \"GetOperation\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"name\": \"name\",\n" +
+		"            \"in\": \"path\",\n" +
+		"            \"required\": true\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiOperation\"\n" +
+		"            }\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"/v1/quarantined-job/{jobId}\": {\n" +
+		"      \"get\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Query\"\n" +
+		"        ],\n" +
+		"        \"summary\": \"Returns the diagnostic record of a quarantined job, until the record expires.\",\n" +
+		"        \"operationId\": \"GetQuarantinedJob\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"name\": \"jobId\",\n" +
+		"            \"in\": \"path\",\n" +
+		"            \"required\": true\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiQuarantinedJob\"\n" +
+		"            }\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"/v1/queue\": {\n" +
+		"      \"post\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Submit\"\n" +
+		"        ],\n" +
+		"        \"operationId\": \"CreateQueue\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"name\": \"body\",\n" +
+		"            \"in\": \"body\",\n" +
+		"            \"required\": true,\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiQueue\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {}\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"/v1/queue/{name}\": {\n" +
+		"      \"get\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Submit\"\n" +
+		"        ],\n" +
+		"        \"operationId\": \"GetQueue\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"name\": \"name\",\n" +
+		"            \"in\": \"path\",\n" +
+		"            \"required\": true\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiQueue\"\n" +
+		"            }\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      },\n" +
+		"      \"put\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Submit\"\n" +
+		"        ],\n" +
+		"        \"operationId\": \"UpdateQueue\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"name\": \"name\",\n" +
+		"            \"in\": \"path\",\n" +
+		"            \"required\": true\n" +
+		"          },\n" +
+		"          {\n" +
+		"            \"name\": \"body\",\n" +
+		"            \"in\": \"body\",\n" +
+		"            \"required\": true,\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiQueue\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {}\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      },\n" +
+		"      \"delete\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Submit\"\n" +
+		"        ],\n" +
+		"        \"operationId\": \"DeleteQueue\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"name\": \"name\",\n" +
+		"            \"in\": \"path\",\n" +
+		"            \"required\": true\n" +
+		"          },\n" +
+		"          {\n" +
+		"            \"type\": \"boolean\",\n" +
+		"            \"description\": \"If true, a queue with active job sets is deleted rather than the request failing: the queue is archived,\\nits jobs are cancelled, and it's deleted once all of them are gone. This happens in the background;\\nits progress is reported by the operation named \\\"delete-queue-\\u003cname\\u003e\\\", see GetOperation.\",\n" +
+		"            \"name\": \"cascade\",\n" +
+		"            \"in\": \"query\"\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {}\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"/v1/queue/{name}/archive\": {\n" +
+		"      \"post\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Submit\"\n" +
+		"        ],\n" +
+		"        \"summary\": \"Archives a queue, such that it rejects new submissions while retaining its configuration and jobs.\",\n" +
+		"        \"operationId\": \"ArchiveQueue\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"name\": \"name\",\n" +
+		"            \"in\": \"path\",\n" +
+		"            \"required\": true\n" +
+		"          },\n" +
+		"          {\n" +
+		"            \"name\": \"body\",\n" +
+		"            \"in\": \"body\",\n" +
+		"            \"required\": true,\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiQueueArchiveRequest\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {}\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"/v1/queue/{name}/cordon\": {\n" +
+		"      \"post\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Submit\"\n" +
+		"        ],\n" +
+		"        \"summary\": \"Stops new leases from being given to the jobs of a queue. Requires the cordon_queues permission.\",\n" +
+		"        \"operationId\": \"CordonQueue\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"description\": \"Name of the queue or id of the executor to cordon.\",\n" +
+		"            \"name\": \"name\",\n" +
+		"            \"in\": \"path\",\n" +
+		"            \"required\": true\n" +
+		"          },\n" +
+		"          {\n" +
+		"            \"name\": \"body\",\n" +
+		"            \"in\": \"body\",\n" +
+		"            \"required\": true,\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiCordonRequest\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiCordon\"\n" +
+		"            }\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      },\n" +
+		"      \"delete\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Submit\"\n" +
+		"        ],\n" +
+		"        \"operationId\": \"UncordonQueue\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"name\": \"name\",\n" +
+		"            \"in\": \"path\",\n" +
+		"            \"required\": true\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {}\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"/v1/queue/{name}/info\": {\n" +
+		"      \"get\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Submit\"\n" +
+		"        ],\n" +
+		"        \"operationId\": \"GetQueueInfo\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"name\": \"name\",\n" +
+		"            \"in\": \"path\",\n" +
+		"            \"required\": true\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiQueueInfo\"\n" +
+		"            }\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"/v1/queue/{name}/restore\": {\n" +
+		"      \"post\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Submit\"\n" +
+		"        ],\n" +
+		"        \"summary\": \"Restores an archived queue, such that it accepts submissions again.\",\n" +
+		"        \"operationId\": \"RestoreQueue\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"name\": \"name\",\n" +
+		"            \"in\": \"path\",\n" +
+		"            \"required\": true\n" +
+		"          },\n" +
+		"          {\n" +
+		"            \"name\": \"body\",\n" +
+		"            \"in\": \"body\",\n" +
+		"            \"required\": true,\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiQueueRestoreRequest\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {}\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"/v1/queue/{queue.name}\": {\n" +
+		"      \"patch\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Submit\"\n" +
+		"        ],\n" +
+		"        \"summary\": \"Updates only the fields of a queue given by a field mask and returns the updated queue.\",\n" +
+		"        \"operationId\": \"PatchQueue\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"name\": \"queue.name\",\n" +
+		"            \"in\": \"path\",\n" +
+		"            \"required\": true\n" +
+		"          },\n" +
+		"          {\n" +
+		"            \"name\": \"body\",\n" +
+		"            \"in\": \"body\",\n" +
+		"            \"required\": true,\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiQueuePatchRequest\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiQueue\"\n" +
+		"            }\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"/v1/queue/{queue}/barrier/{id}\": {\n" +
+		"      \"get\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Submit\"\n" +
+		"        ],\n" +
+		"        \"operationId\": \"GetBarrier\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"name\": \"queue\",\n" +
+		"            \"in\": \"path\",\n" +
+		"            \"required\": true\n" +
+		"          },\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"name\": \"id\",\n" +
+		"            \"in\": \"path\",\n" +
+		"            \"required\": true\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiBarrier\"\n" +
+		"            }\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"/v1/queue/{queue}/budgets\": {\n" +
+		"      \"get\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Submit\"\n" +
+		"        ],\n" +
+		"        \"operationId\": \"GetQueueBudgets\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"name\": \"queue\",\n" +
+		"            \"in\": \"path\",\n" +
+		"            \"required\": true\n" +
+		"          },\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"description\": \"Owner whose usage of budgets that apply to each owner separately is returned. Defaults to the caller.\",\n" +
+		"            \"name\": \"owner\",\n" +
+		"            \"in\": \"query\"\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiQueueBudgets\"\n" +
+		"            }\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"/v1/queues\": {\n" +
+		"      \"get\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Submit\"\n" +
+		"        ],\n" +
+		"        \"summary\": \"Returns a page of queues, sorted as requested. Unlike GetQueues, this doesn't send all queues at once.\",\n" +
+		"        \"operationId\": \"ListQueues\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"type\": \"integer\",\n" +
+		"            \"format\": \"int64\",\n" +
+		"            \"description\": \"Maximum number of queues to return. If 0, the default page size of the server is used; larger page sizes\\nare capped to the maximum page size of the server.\",\n" +
+		"            \"name\": \"pageSize\",\n" +
+		"            \"in\": \"query\"\n" +
+		"          },\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"description\": \"The next_page_token of the previous page, to continue listing after it. Must be used with the same sorting as\\nthe previous page. Queues created or changed in between are listed according to their current position.\",\n" +
+		"            \"name\": \"pageToken\",\n" +
+		"            \"in\": \"query\"\n" +
+		"          },\n" +
+		"          {\n" +
+		"            \"enum\": [\n" +
+		"              \"NAME\",\n" +
+		"              \"CREATED\",\n" +
+		"              \"PRIORITY\"\n" +
+		"            ],\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"default\": \"NAME\",\n" +
+		"            \"description\": \" - PRIORITY: By priority factor; queues with the same priority factor are sorted by name.\",\n" +
+		"            \"name\": \"sortBy\",\n" +
+		"            \"in\": \"query\"\n" +
+		"          },\n" +
+		"          {\n" +
+		"            \"type\": \"boolean\",\n" +
+		"            \"name\": \"descending\",\n" +
+		"            \"in\": \"query\"\n" +
+		"          },\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"description\": \"If provided, only queues whose names start with this prefix are returned.\",\n" +
+		"            \"name\": \"namePrefix\",\n" +
+		"            \"in\": \"query\"\n" +
+		"          },\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"description\": \"If provided, only queues whose name, description, or contact contain this text, ignoring case, are returned.\",\n" +
+		"            \"name\": \"search\",\n" +
+		"            \"in\": \"query\"\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiQueuePage\"\n" +
+		"            }\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"/v1/queues/export\": {\n" +
+		"      \"get\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Submit\"\n" +
+		"        ],\n" +
+		"        \"summary\": \"Returns a YAML document of the queues, including their permissions and limits, but not the fields managed by Armada,\\nsuch as revisions or archivals.\",\n" +
+		"        \"operationId\": \"ExportQueues\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"description\": \"If provided, only queues whose names start with this prefix are exported.\",\n" +
+		"            \"name\": \"namePrefix\",\n" +
+		"            \"in\": \"query\"\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiQueueDocument\"\n" +
+		"            }\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"/v1/queues/import\": {\n" +
+		"      \"post\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Submit\"\n" +
+		"        ],\n" +
+		"        \"summary\": \"Creates and updates queues such that they match a YAML document produced by ExportQueues, e.g., one kept in Git,\\nand optionally deletes queues not in it. Creations and updates are each applied atomically.\",\n" +
+		"        \"operationId\": \"ImportQueues\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"name\": \"body\",\n" +
+		"            \"in\": \"body\",\n" +
+		"            \"required\": true,\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiQueueImportRequest\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiQueueImportResponse\"\n" +
+		"            }\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"/v1/queues/watch\": {\n" +
+		"      \"get\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Submit\"\n" +
+		"        ],\n" +
+		"        \"summary\": \"Streams changes to queues until the client disconnects. Fails with OUT_OF_RANGE if the changes after\\nthe requested resource version are no longer retained, in which case the client should watch from 0.\",\n" +
+		"        \"operationId\": \"WatchQueues\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"format\": \"uint64\",\n" +
+		"            \"description\": \"Resource version after which changes are reported, e.g., that of the EndMarker of GetQueues\\nor of the last change received, to resume watching. If 0, the current queues are first reported as created.\",\n" +
+		"            \"name\": \"resourceVersion\",\n" +
+		"            \"in\": \"query\"\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.(streaming responses)\",\n" +
+		"            \"schema\": {\n" +
+		"              \"type\": \"object\",\n" +
+		"              \"title\": \"Stream result of apiQueueChange\",\n" +
+		"              \"properties\": {\n" +
+		"                \"error\": {\n" +
+		"                  \"$ref\": \"#/definitions/runtimeStreamError\"\n" +
+		"                },\n" +
+		"                \"result\": {\n" +
+		"                  \"$ref\": \"#/definitions/apiQueueChange\"\n" +
+		"                }\n" +
+		"              }\n" +
+		"            }\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"/v1/submit-failure-report/{id}\": {\n" +
+		"      \"get\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Submit\"\n" +
+		"        ],\n" +
+		"        \"summary\": \"Returns the errors of all jobs of a rejected submission, the status of which included only some of them.\\nOnly the principal that made the submission may retrieve its failure report.\",\n" +
+		"        \"operationId\": \"GetSubmitFailureReport\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"name\": \"id\",\n" +
+		"            \"in\": \"path\",\n" +
+		"            \"required\": true\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiJobSubmitResponse\"\n" +
+		"            }\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"/v1/usage-report\": {\n" +
+		"      \"get\": {\n" +
+		"        \"tags\": [\n" +
+		"          \"Query\"\n" +
+		"        ],\n" +
+		"        \"summary\": \"Returns the daily resource usage of queues and their owners, e.g., for chargeback.\",\n" +
+		"        \"operationId\": \"GetUsageReport\",\n" +
+		"        \"parameters\": [\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"description\": \"First day of the report, as YYYY-MM-DD in UTC.\",\n" +
+		"            \"name\": \"startDate\",\n" +
+		"            \"in\": \"query\"\n" +
+		"          },\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"description\": \"Last day of the report, as YYYY-MM-DD in UTC. Defaults to start_date.\",\n" +
+		"            \"name\": \"endDate\",\n" +
+		"            \"in\": \"query\"\n" +
+		"          },\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"description\": \"If set, only usage of this queue is reported.\",\n" +
+		"            \"name\": \"queue\",\n" +
+		"            \"in\": \"query\"\n" +
+		"          },\n" +
+		"          {\n" +
+		"            \"type\": \"string\",\n" +
+		"            \"description\": \"If set, only usage of jobs submitted by this owner is reported.\",\n" +
+		"            \"name\": \"owner\",\n" +
+		"            \"in\": \"query\"\n" +
+		"          }\n" +
+		"        ],\n" +
+		"        \"responses\": {\n" +
+		"          \"200\": {\n" +
+		"            \"description\": \"A successful response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/apiUsageReport\"\n" +
+		"            }\n" +
+		"          },\n" +
+		"          \"default\": {\n" +
+		"            \"description\": \"An unexpected error response.\",\n" +
+		"            \"schema\": {\n" +
+		"              \"$ref\": \"#/definitions/runtimeError\"\n" +
+		"            }\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    }\n" +
 		"  },\n" +
 		"  \"definitions\": {\n" +
 		"    \"PermissionsSubject\": {\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"properties\": {\n" +
-		"        \"kind\": {\n" +
+		"        \"kind\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"name\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"QueueListRequestSortField\": {\n" +
+		"      \"description\": \" - PRIORITY: By priority factor; queues with the same priority factor are sorted by name.\",\n" +
+		"      \"type\": \"string\",\n" +
+		"      \"default\": \"NAME\",\n" +
+		"      \"enum\": [\n" +
+		"        \"NAME\",\n" +
+		"        \"CREATED\",\n" +
+		"        \"PRIORITY\"\n" +
+		"      ]\n" +
+		"    },\n" +
+		"    \"QueuePermissions\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"subjects\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/PermissionsSubject\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"verbs\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"ResourceBudgetAction\": {\n" +
+		"      \"description\": \"What happens to jobs requesting the resource that are submitted while the budget is exhausted.\\n\\n - REJECT: Such jobs are rejected.\\n - DEPRIORITIZE: Such jobs are accepted, but with a priority of at least deprioritized_priority.\",\n" +
+		"      \"type\": \"string\",\n" +
+		"      \"default\": \"REJECT\",\n" +
+		"      \"enum\": [\n" +
+		"        \"REJECT\",\n" +
+		"        \"DEPRIORITIZE\"\n" +
+		"      ]\n" +
+		"    },\n" +
+		"    \"apiBarrier\": {\n" +
+		"      \"description\": \"Jobs declare membership of a barrier via annotations.\\nNone of the members of a barrier are scheduled until all members have been submitted, at which point the barrier is released.\",\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"cardinality\": {\n" +
+		"          \"description\": \"Total number of jobs in the barrier.\",\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int64\"\n" +
+		"        },\n" +
+		"        \"id\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"jobIds\": {\n" +
+		"          \"description\": \"Ids of the jobs submitted to the barrier so far.\",\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"released\": {\n" +
+		"          \"type\": \"boolean\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiBatchQueueCreateResponse\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"failedQueues\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/apiQueueCreateResponse\"\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiBatchQueueUpdateResponse\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"failedQueues\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/apiQueueUpdateResponse\"\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiCancellationResult\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
+		"      \"properties\": {\n" +
+		"        \"cancelledIds\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiCause\": {\n" +
+		"      \"type\": \"string\",\n" +
+		"      \"default\": \"Error\",\n" +
+		"      \"enum\": [\n" +
+		"        \"Error\",\n" +
+		"        \"Evicted\",\n" +
+		"        \"OOM\",\n" +
+		"        \"DeadlineExceeded\"\n" +
+		"      ]\n" +
+		"    },\n" +
+		"    \"apiClusterNodeTypes\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"clusterId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"nodeTypes\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/apiNodeType\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"pool\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"reportTime\": {\n" +
+		"          \"description\": \"When the cluster last reported its nodes.\",\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiClusterTargeting\": {\n" +
+		"      \"description\": \"Clusters a job is required or preferred to run on, or excluded from. Each cluster is given either by its id,\\ni.e., the name of its executor, or by the name of its pool, which matches all clusters of the pool.\\nRequired and preferred clusters must be known to the server.\",\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"excluded\": {\n" +
+		"          \"description\": \"The job doesn't run on clusters matching any of these.\",\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"preferred\": {\n" +
+		"          \"description\": \"If non-empty, the job only runs on clusters matching one of these while any of them is active,\\nand on any other cluster otherwise.\",\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"required\": {\n" +
+		"          \"description\": \"If non-empty, the job only runs on clusters matching one of these.\",\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiClusterUnschedulableReasons\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"clusterId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"pool\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"reasons\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/apiUnschedulableReason\"\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiContainerStatus\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"cause\": {\n" +
+		"          \"$ref\": \"#/definitions/apiCause\"\n" +
+		"        },\n" +
+		"        \"exitCode\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int32\"\n" +
+		"        },\n" +
+		"        \"message\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"name\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"reason\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiCordon\": {\n" +
+		"      \"description\": \"Cordon records that no new leases are given to a queue or an executor. Leased jobs aren't affected.\",\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"cordoned\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"cordonedBy\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"name\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"reason\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiCordonList\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"executors\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/apiCordon\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"queues\": {\n" +
+		"          \"description\": \"Cordoned queues and executors, each ordered by name.\",\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/apiCordon\"\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiCordonRequest\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"name\": {\n" +
+		"          \"description\": \"Name of the queue or id of the executor to cordon.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"reason\": {\n" +
+		"          \"description\": \"Why new leases aren't given, e.g., \\\"node pool maintenance\\\".\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiEndMarker\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"Indicates the end of streams\",\n" +
+		"      \"properties\": {\n" +
+		"        \"resourceVersion\": {\n" +
+		"          \"description\": \"Set at the end of GetQueues to the version of the queue repository the listed queues are a consistent snapshot of.\\nQueues changed after the listing have a greater resource version, whereas the listed queues have at most this one.\",\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"uint64\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiEventMessage\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"cancelled\": {\n" +
+		"          \"$ref\": \"#/definitions/apiJobCancelledEvent\"\n" +
+		"        },\n" +
+		"        \"cancelling\": {\n" +
+		"          \"$ref\": \"#/definitions/apiJobCancellingEvent\"\n" +
+		"        },\n" +
+		"        \"cordoned\": {\n" +
+		"          \"$ref\": \"#/definitions/apiJobCordonedEvent\"\n" +
+		"        },\n" +
+		"        \"duplicateFound\": {\n" +
+		"          \"$ref\": \"#/definitions/apiJobDuplicateFoundEvent\"\n" +
+		"        },\n" +
+		"        \"evictedForCapacity\": {\n" +
+		"          \"$ref\": \"#/definitions/apiJobEvictedForCapacityEvent\"\n" +
+		"        },\n" +
+		"        \"failed\": {\n" +
+		"          \"$ref\": \"#/definitions/apiJobFailedEvent\"\n" +
+		"        },\n" +
+		"        \"failedCompressed\": {\n" +
+		"          \"$ref\": \"#/definitions/apiJobFailedEventCompressed\"\n" +
+		"        },\n" +
+		"        \"ingressInfo\": {\n" +
+		"          \"$ref\": \"#/definitions/apiJobIngressInfoEvent\"\n" +
+		"        },\n" +
+		"        \"jobSetExpired\": {\n" +
+		"          \"$ref\": \"#/definitions/apiJobSetExpiredEvent\"\n" +
+		"        },\n" +
+		"        \"leaseExpired\": {\n" +
+		"          \"$ref\": \"#/definitions/apiJobLeaseExpiredEvent\"\n" +
+		"        },\n" +
+		"        \"leaseReturned\": {\n" +
+		"          \"$ref\": \"#/definitions/apiJobLeaseReturnedEvent\"\n" +
+		"        },\n" +
+		"        \"leased\": {\n" +
+		"          \"$ref\": \"#/definitions/apiJobLeasedEvent\"\n" +
+		"        },\n" +
+		"        \"maintenanceWindowEnded\": {\n" +
+		"          \"$ref\": \"#/definitions/apiJobMaintenanceWindowEndedEvent\"\n" +
+		"        },\n" +
+		"        \"maintenanceWindowStarted\": {\n" +
+		"          \"$ref\": \"#/definitions/apiJobMaintenanceWindowStartedEvent\"\n" +
+		"        },\n" +
+		"        \"pending\": {\n" +
+		"          \"$ref\": \"#/definitions/apiJobPendingEvent\"\n" +
+		"        },\n" +
+		"        \"preempted\": {\n" +
+		"          \"$ref\": \"#/definitions/apiJobPreemptedEvent\"\n" +
+		"        },\n" +
+		"        \"queued\": {\n" +
+		"          \"$ref\": \"#/definitions/apiJobQueuedEvent\"\n" +
+		"        },\n" +
+		"        \"reprioritized\": {\n" +
+		"          \"$ref\": \"#/definitions/apiJobReprioritizedEvent\"\n" +
+		"        },\n" +
+		"        \"reprioritizing\": {\n" +
+		"          \"$ref\": \"#/definitions/apiJobReprioritizingEvent\"\n" +
+		"        },\n" +
+		"        \"resourcesNormalized\": {\n" +
+		"          \"$ref\": \"#/definitions/apiJobResourcesNormalizedEvent\"\n" +
+		"        },\n" +
+		"        \"resumed\": {\n" +
+		"          \"$ref\": \"#/definitions/apiJobResumedEvent\"\n" +
+		"        },\n" +
+		"        \"running\": {\n" +
+		"          \"$ref\": \"#/definitions/apiJobRunningEvent\"\n" +
+		"        },\n" +
+		"        \"runtimeExceeded\": {\n" +
+		"          \"$ref\": \"#/definitions/apiJobRuntimeExceededEvent\"\n" +
+		"        },\n" +
+		"        \"submitted\": {\n" +
+		"          \"$ref\": \"#/definitions/apiJobSubmittedEvent\"\n" +
+		"        },\n" +
+		"        \"succeeded\": {\n" +
+		"          \"$ref\": \"#/definitions/apiJobSucceededEvent\"\n" +
+		"        },\n" +
+		"        \"suspended\": {\n" +
+		"          \"$ref\": \"#/definitions/apiJobSuspendedEvent\"\n" +
+		"        },\n" +
+		"        \"terminated\": {\n" +
+		"          \"$ref\": \"#/definitions/apiJobTerminatedEvent\"\n" +
+		"        },\n" +
+		"        \"unableToSchedule\": {\n" +
+		"          \"$ref\": \"#/definitions/apiJobUnableToScheduleEvent\"\n" +
+		"        },\n" +
+		"        \"uncordoned\": {\n" +
+		"          \"$ref\": \"#/definitions/apiJobUncordonedEvent\"\n" +
+		"        },\n" +
+		"        \"unschedulable\": {\n" +
+		"          \"$ref\": \"#/definitions/apiJobUnschedulableEvent\"\n" +
+		"        },\n" +
+		"        \"updated\": {\n" +
+		"          \"$ref\": \"#/definitions/apiJobUpdatedEvent\"\n" +
+		"        },\n" +
+		"        \"utilisation\": {\n" +
+		"          \"$ref\": \"#/definitions/apiJobUtilisationEvent\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiEventStreamMessage\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
+		"      \"properties\": {\n" +
+		"        \"id\": {\n" +
+		"          \"description\": \"Sequence id of the event, unique and strictly increasing within its job set. Ids are stable, such that clients\\nmay use them to deduplicate events and pass the id of the last event they processed as from_message_id\\nto resume watching a job set where they left off.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"message\": {\n" +
+		"          \"$ref\": \"#/definitions/apiEventMessage\"\n" +
+		"        },\n" +
+		"        \"schemaVersion\": {\n" +
+		"          \"description\": \"Version of the event schema message conforms to.\",\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int64\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiExecutorFairShares\": {\n" +
+		"      \"description\": \"ExecutorFairShares are the shares computed by the most recent scheduling round of an executor.\",\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"computed\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"executorId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"pool\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"shares\": {\n" +
+		"          \"description\": \"Shares ordered by queue name.\",\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/apiQueueFairShare\"\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiExecutorList\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"executors\": {\n" +
+		"          \"description\": \"Executors ordered by id.\",\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/apiExecutorStatus\"\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiExecutorStatus\": {\n" +
+		"      \"description\": \"ExecutorStatus is the state of an executor as of its most recent heartbeat, i.e., lease request.\",\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"allocated\": {\n" +
+		"          \"description\": \"Resources allocated to jobs leased from Armada.\",\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"$ref\": \"#/definitions/resourceQuantity\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"capacity\": {\n" +
+		"          \"description\": \"Resources of the executor available to Armada jobs.\",\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"$ref\": \"#/definitions/resourceQuantity\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"healthy\": {\n" +
+		"          \"description\": \"False if the executor hasn't heartbeated recently.\",\n" +
+		"          \"type\": \"boolean\"\n" +
+		"        },\n" +
+		"        \"id\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"lastHeartbeat\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"pool\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"version\": {\n" +
+		"          \"description\": \"Release version of the executor, empty if not reported.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiExternalDnsConfig\": {\n" +
+		"      \"description\": \"DNS records managed by external-dns for the hosts of an ingress.\",\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"hostnames\": {\n" +
+		"          \"description\": \"Hostnames, e.g., \\\"app.example.com\\\", served by the ingress in addition to those generated for the cluster.\\nMay only be set if the ingress exposes a single port.\",\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"target\": {\n" +
+		"          \"description\": \"Hostname or IP address the records point to, e.g., that of a load balancer. If empty, the addresses of the ingress.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"ttlSeconds\": {\n" +
+		"          \"description\": \"Time-to-live in seconds of the records. If 0, that configured for external-dns.\",\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int64\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiFairShareWeights\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"weights\": {\n" +
+		"          \"description\": \"Weights ordered by queue name.\",\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/apiQueueFairShareWeight\"\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiFairShares\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"executors\": {\n" +
+		"          \"description\": \"Shares ordered by executor id.\",\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/apiExecutorFairShares\"\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiGang\": {\n" +
+		"      \"description\": \"All members of a gang must be submitted in the same request, and with equal priority and resource requirements.\",\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"cardinality\": {\n" +
+		"          \"description\": \"Number of jobs in the gang.\",\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int64\"\n" +
+		"        },\n" +
+		"        \"id\": {\n" +
+		"          \"description\": \"Jobs with equal gang id make up a gang.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"minCardinality\": {\n" +
+		"          \"description\": \"Minimum number of members that must be scheduled for the gang to be scheduled. Defaults to cardinality.\",\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int64\"\n" +
+		"        },\n" +
+		"        \"nodeUniformityLabel\": {\n" +
+		"          \"description\": \"If set, all members of the gang are scheduled onto nodes with equal value for this node label.\\nDefaults to the gang node uniformity label configured by the server.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiIngressClassPolicy\": {\n" +
+		"      \"description\": \"Ingress classes the ingresses of jobs submitted to a queue may use.\",\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"allowedClasses\": {\n" +
+		"          \"description\": \"Classes ingresses may use, e.g., [\\\"internal\\\"]. If empty, any class is allowed.\",\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"defaultClass\": {\n" +
+		"          \"description\": \"Class of ingresses submitted without one. Must be allowed, if set. If empty, such ingresses use the default class\\nof the cluster, unless classes are restricted, in which case they're rejected.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiIngressConfig\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"annotations\": {\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"certName\": {\n" +
+		"          \"description\": \"Name of a secret in the namespace of the job holding the TLS certificate of the ingress. May only be set if\\ntls_enabled is set. If empty, the certificate configured for the cluster is used.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"externalDns\": {\n" +
+		"          \"description\": \"If set, the ingress is annotated for external-dns to manage DNS records of its hosts.\",\n" +
+		"          \"$ref\": \"#/definitions/apiExternalDnsConfig\"\n" +
+		"        },\n" +
+		"        \"ingressClassName\": {\n" +
+		"          \"description\": \"Ingress class of the ingress, e.g., \\\"nginx\\\". If empty, the default class of the queue of the job, if any,\\nor else the default class of the cluster.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"ports\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"integer\",\n" +
+		"            \"format\": \"int64\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"tlsEnabled\": {\n" +
+		"          \"type\": \"boolean\"\n" +
+		"        },\n" +
+		"        \"type\": {\n" +
+		"          \"$ref\": \"#/definitions/apiIngressType\"\n" +
+		"        },\n" +
+		"        \"useClusterIP\": {\n" +
+		"          \"type\": \"boolean\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiIngressType\": {\n" +
+		"      \"description\": \"Ingress type is being kept here to maintain backwards compatibility for a while.\",\n" +
+		"      \"type\": \"string\",\n" +
+		"      \"default\": \"Ingress\",\n" +
+		"      \"enum\": [\n" +
+		"        \"Ingress\"\n" +
+		"      ]\n" +
+		"    },\n" +
+		"    \"apiJob\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"annotations\": {\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"clientId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"compressedQueueOwnershipUserGroups\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"byte\"\n" +
+		"        },\n" +
+		"        \"created\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"failedAttempts\": {\n" +
+		"          \"description\": \"Number of failed runs of this job that were retried as per its retry policy.\",\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int64\"\n" +
+		"        },\n" +
+		"        \"id\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"ingress\": {\n" +
+		"          \"description\": \"Services can be provided either as Armada-specific config objects or as proper k8s objects.\\nThese options are exclusive, i.e., if either ingress or services is provided,\\nthen neither of k8s_ingress or k8s_service can be provided, and vice versa.\",\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/apiIngressConfig\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"jobArrayId\": {\n" +
+		"          \"description\": \"If set, this job is a member of the job array with this id. Set by the server for jobs submitted as part of a JobArray.\\nThe pod specs of the members of an array are stored once, shared by all members.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"jobArrayIndex\": {\n" +
+		"          \"description\": \"Index of this job in its job array.\",\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int64\"\n" +
+		"        },\n" +
+		"        \"jobSetId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"k8sIngress\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/v1Ingress\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"k8sService\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/v1Service\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"labels\": {\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"namespace\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"owner\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"ownershipGroupsHash\": {\n" +
+		"          \"description\": \"If set, the compressed ownership groups of this job are stored once for all jobs with identical groups, under this hash,\\nrather than with the job. Set by the server when storing the job; groups are restored when the job is leased.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"podSpec\": {\n" +
+		"          \"$ref\": \"#/definitions/v1PodSpec\"\n" +
+		"        },\n" +
+		"        \"podSpecs\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/v1PodSpec\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"podSpecsHash\": {\n" +
+		"          \"description\": \"If set, the pod specs of this job are stored once for all jobs with identical pod specs, under this hash,\\nrather than with the job. Set by the server when storing the job.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"podSpecsObjectKey\": {\n" +
+		"          \"description\": \"If set, the pod specs of this job are too large to be stored with the job and are instead stored in object storage under this key.\\nSet by the server when storing the job; pod specs are restored when the job is read.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"priority\": {\n" +
+		"          \"type\": \"number\",\n" +
+		"          \"format\": \"double\"\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"queueOwnershipUserGroups\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"queueTtlSeconds\": {\n" +
+		"          \"description\": \"Queuing TTL for this job in seconds. If this job queues for more than this duration it will be cancelled. Zero indicates an infinite lifetime.\",\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"int64\"\n" +
+		"        },\n" +
+		"        \"requiredNodeLabels\": {\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"retryPending\": {\n" +
+		"          \"description\": \"Set by the server once a run of this job failed and is to be retried, until the job is returned to the queue.\",\n" +
+		"          \"type\": \"boolean\"\n" +
+		"        },\n" +
+		"        \"scheduler\": {\n" +
+		"          \"description\": \"Indicates which scheduler should manage this job.\\nIf empty, the default scheduler is used.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"schedulingResourceRequirements\": {\n" +
+		"          \"description\": \"max(\\n\\n\\tsum across all containers,\\n\\tmax over all init containers,\\n\\n)\\n\\nThis is because containers run in parallel, whereas initContainers run serially.\\nThis field is populated automatically at submission.\\nSubmitting a job with this field already populated results in an error.\",\n" +
+		"          \"title\": \"Resource requests and limits necessary for scheduling the main pod of this job.\\nThe requests and limits herein are set to:\",\n" +
+		"          \"$ref\": \"#/definitions/v1ResourceRequirements\"\n" +
+		"        },\n" +
+		"        \"services\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/apiServiceConfig\"\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobArray\": {\n" +
+		"      \"description\": \"A job array expands into count jobs created from the same template, which differ only in their index,\\nfrom 0 to count - 1. The index of each job is set as the ARMADA_JOB_ARRAY_INDEX environment variable\\nof each container and as the armadaproject.io/jobArrayIndex label.\",\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"count\": {\n" +
+		"          \"description\": \"Number of jobs of the array. Must be positive.\",\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int64\"\n" +
+		"        },\n" +
+		"        \"template\": {\n" +
+		"          \"description\": \"Template of the jobs of the array. If set, its client id is suffixed with \\\"-\\u003cindex\\u003e\\\" for each job.\",\n" +
+		"          \"$ref\": \"#/definitions/apiJobSubmitRequestItem\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobCancelRequest\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
+		"      \"properties\": {\n" +
+		"        \"jobId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"jobIds\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"jobSetId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"reason\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobCancelledEvent\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"created\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"jobId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"jobSetId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"reason\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"requestor\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobCancellingEvent\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"created\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"jobId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"jobSetId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"reason\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"requestor\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobCordonedEvent\": {\n" +
+		"      \"description\": \"Indicates that no new leases are given to the queue of the job, or to the executor it's leased to, since it was\\ncordoned. Running jobs are unaffected.\",\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"cordonedBy\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"created\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"executor\": {\n" +
+		"          \"description\": \"Set for cordons of executors.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"jobId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"jobSetId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"reason\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobDetails\": {\n" +
+		"      \"description\": \"JobDetails is a job as stored by the server, together with its current state. Only jobs that haven't completed\\nare stored, and only by the legacy scheduler.\",\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"clusterId\": {\n" +
+		"          \"description\": \"Cluster the job is leased to. Empty if the job isn't leased.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"job\": {\n" +
+		"          \"description\": \"The job, with its pod specs and queue ownership user groups decompressed.\",\n" +
+		"          \"$ref\": \"#/definitions/apiJob\"\n" +
+		"        },\n" +
+		"        \"started\": {\n" +
+		"          \"description\": \"Time at which the job started running. Unset if the job isn't running.\",\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"state\": {\n" +
+		"          \"description\": \"One of QUEUED, SUSPENDED, PENDING, or RUNNING.\",\n" +
+		"          \"$ref\": \"#/definitions/apiJobState\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobDuplicateFoundEvent\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"created\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"jobId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"jobSetId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"originalJobId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobEvictedForCapacityEvent\": {\n" +
+		"      \"description\": \"Indicates that a preemptible job was evicted to make room for higher-priority work.\\nThe job is returned to the queue, as indicated by a subsequent queued event.\",\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"clusterId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"created\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"jobId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"jobSetId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobFailedEvent\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"attempt\": {\n" +
+		"          \"description\": \"Number of the failed attempt, starting at 1. Only set for jobs with a retry policy.\",\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int64\"\n" +
+		"        },\n" +
+		"        \"cause\": {\n" +
+		"          \"$ref\": \"#/definitions/apiCause\"\n" +
+		"        },\n" +
+		"        \"clusterId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"containerStatuses\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/apiContainerStatus\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"created\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"exitCodes\": {\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"type\": \"integer\",\n" +
+		"            \"format\": \"int32\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"jobId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"jobSetId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"kubernetesId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"nodeName\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"podName\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"podNamespace\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"podNumber\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int32\"\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"reason\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"willRetry\": {\n" +
+		"          \"description\": \"True if the failed run is retried as per the retry policy of the job, in which case the job isn't finished.\",\n" +
+		"          \"type\": \"boolean\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobFailedEventCompressed\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"Only used internally by Armada\",\n" +
+		"      \"properties\": {\n" +
+		"        \"event\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"byte\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobIngressInfoEvent\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"clusterId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"created\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"ingressAddresses\": {\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"jobId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"jobSetId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"kubernetesId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"nodeName\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"podName\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"podNamespace\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"podNumber\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int32\"\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobLeaseExpiredEvent\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"created\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"jobId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"jobSetId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobLeaseReturnedEvent\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"clusterId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"created\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"jobId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"jobSetId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"kubernetesId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"podNumber\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int32\"\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"reason\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"runAttempted\": {\n" +
+		"          \"type\": \"boolean\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobLeasedEvent\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"clusterId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"created\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"jobId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"jobSetId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"pool\": {\n" +
+		"          \"description\": \"Pool of the cluster the job was leased to.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobLogLine\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"line\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"timestamp\": {\n" +
+		"          \"description\": \"Time at which the line was written, as RFC 3339 with nanoseconds.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobLogs\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"lines\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/apiJobLogLine\"\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobMaintenanceWindowEndedEvent\": {\n" +
+		"      \"description\": \"Indicates that a maintenance window the job was affected by ended, or was deleted after it started.\",\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"created\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"executor\": {\n" +
+		"          \"description\": \"Set for windows of executors.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"jobId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"jobSetId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"maintenanceWindowId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobMaintenanceWindowStartedEvent\": {\n" +
+		"      \"description\": \"Indicates that a maintenance window affecting the job started, i.e., a window of its queue, or of the executor it's\\nleased to. No new leases are given to the queue or executor until the window ends, and the job is preempted and\\nrequeued if the window drains jobs.\",\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"created\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"drain\": {\n" +
+		"          \"type\": \"boolean\"\n" +
+		"        },\n" +
+		"        \"end\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"executor\": {\n" +
+		"          \"description\": \"Set for windows of executors.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"jobId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"jobSetId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"maintenanceWindowId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"reason\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobPendingEvent\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"clusterId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"created\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"jobId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"jobSetId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"kubernetesId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"podName\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"podNamespace\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"podNumber\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int32\"\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobPlacement\": {\n" +
+		"      \"description\": \"JobPlacement is where one run of a job was placed, as reconstructed from the events of its job set.\",\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"clusterId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"finished\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"kubernetesId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"leased\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"nodeLabels\": {\n" +
+		"          \"description\": \"Labels of the node tracked by the executor.\",\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"nodeName\": {\n" +
+		"          \"description\": \"Node the run was placed on. Empty if the run never started running.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"pool\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"reason\": {\n" +
+		"          \"description\": \"Why the run failed or was returned to the queue, if it did.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"started\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"state\": {\n" +
+		"          \"description\": \"PENDING or RUNNING while the run is active. Once it ended, SUCCEEDED, FAILED, CANCELLED,\\nor QUEUED if the job was returned to the queue, e.g., because its lease expired.\",\n" +
+		"          \"$ref\": \"#/definitions/apiJobState\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobPlacements\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"jobId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"placements\": {\n" +
+		"          \"description\": \"Runs of the job, in the order they were placed.\",\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/apiJobPlacement\"\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobPreemptRequest\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"Selects leased jobs of a queue to preempt: either the jobs with the given ids or, if none are given,\\nthe jobs of the given job set and with all labels of label_selector, either of which may be omitted.\\nswagger:model\",\n" +
+		"      \"properties\": {\n" +
+		"        \"jobIds\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"jobSetId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"labelSelector\": {\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"reason\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"requeue\": {\n" +
+		"          \"description\": \"If true, preempted jobs are returned to the queue to be scheduled again; otherwise, they fail.\",\n" +
+		"          \"type\": \"boolean\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobPreemptResponse\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
+		"      \"properties\": {\n" +
+		"        \"preemptedIds\": {\n" +
+		"          \"description\": \"Ids of the jobs that were preempted; selected jobs that weren't leased are omitted.\",\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobPreemptedEvent\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"clusterId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"created\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"jobId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"jobSetId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"preemptiveJobId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"preemptiveRunId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"reason\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"requestor\": {\n" +
+		"          \"description\": \"Set if the job was preempted on request, e.g., using PreemptJobs, rather than by the scheduler.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"runId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobPriorityPolicy\": {\n" +
+		"      \"description\": \"Default and bounds of the priorities of jobs submitted to a queue.\",\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"defaultPriority\": {\n" +
+		"          \"description\": \"Priority of jobs submitted with priority 0. If 0, such jobs keep priority 0, which must then be allowed.\",\n" +
+		"          \"type\": \"number\",\n" +
+		"          \"format\": \"double\"\n" +
+		"        },\n" +
+		"        \"maxPriority\": {\n" +
+		"          \"description\": \"Maximum priority jobs may be submitted or reprioritised with. If 0, priorities are unbounded.\",\n" +
+		"          \"type\": \"number\",\n" +
+		"          \"format\": \"double\"\n" +
+		"        },\n" +
+		"        \"minPriority\": {\n" +
+		"          \"description\": \"Minimum priority jobs may be submitted or reprioritised with. If 0, priorities are unbounded from below.\",\n" +
+		"          \"type\": \"number\",\n" +
+		"          \"format\": \"double\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobQueuedEvent\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"attempt\": {\n" +
+		"          \"description\": \"If the job was returned to the queue to retry a failed run, the number of the new attempt, starting at 2.\",\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int64\"\n" +
+		"        },\n" +
+		"        \"created\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"jobId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"jobSetId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobReprioritizeRequest\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
+		"      \"properties\": {\n" +
+		"        \"jobIds\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"jobSetId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"newPriority\": {\n" +
+		"          \"type\": \"number\",\n" +
+		"          \"format\": \"double\"\n" +
+		"        },\n" +
+		"        \"priorityDelta\": {\n" +
+		"          \"description\": \"If non-zero, the priority of each job is adjusted relative to its current priority instead of being set to new_priority,\\nwhich must then be zero. The new priority is the current priority multiplied by priority_multiplier (if non-zero)\\nplus priority_delta, but at least zero and within the priority bounds of the queue of the job (see JobPriorityPolicy).\\nRelative adjustments are applied atomically with respect to concurrent updates.\\nThey are only supported for jobs of the legacy scheduler; jobs of the Pulsar scheduler are reported as not found.\",\n" +
+		"          \"type\": \"number\",\n" +
+		"          \"format\": \"double\"\n" +
+		"        },\n" +
+		"        \"priorityMultiplier\": {\n" +
+		"          \"description\": \"See priority_delta. Must not be negative.\",\n" +
+		"          \"type\": \"number\",\n" +
+		"          \"format\": \"double\"\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobReprioritizeResponse\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
+		"      \"properties\": {\n" +
+		"        \"reprioritizationResults\": {\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobReprioritizedEvent\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"created\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"jobId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"jobSetId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"newPriority\": {\n" +
+		"          \"type\": \"number\",\n" +
+		"          \"format\": \"double\"\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"requestor\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobReprioritizingEvent\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"created\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"jobId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"jobSetId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"newPriority\": {\n" +
+		"          \"type\": \"number\",\n" +
+		"          \"format\": \"double\"\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"requestor\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobResourcesNormalizedEvent\": {\n" +
+		"      \"description\": \"Indicates that the resource requests and limits of the containers of a job were normalized at submission,\\ne.g., rounded up to the increments configured for the server. Requests and limits are given by container name.\",\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"created\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"jobId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"jobSetId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"normalizedResources\": {\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"$ref\": \"#/definitions/v1ResourceRequirements\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"originalResources\": {\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"$ref\": \"#/definitions/v1ResourceRequirements\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobResubmitRequest\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"Selects jobs of a job set to submit again as new jobs, e.g., after they failed. The specs of the jobs are read from\\nthe events of the job set, such that jobs that have completed or been cancelled can be resubmitted.\\nswagger:model\",\n" +
+		"      \"properties\": {\n" +
+		"        \"jobIds\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"jobSetId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"newJobSetId\": {\n" +
+		"          \"description\": \"Job set of the queue the new jobs are submitted to. Defaults to job_set_id.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"overrides\": {\n" +
+		"          \"description\": \"Applied to the specs of the jobs before they're submitted; the new jobs are otherwise identical to the originals.\",\n" +
+		"          \"$ref\": \"#/definitions/apiJobSpecOverrides\"\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobResubmitResponse\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
+		"      \"properties\": {\n" +
+		"        \"jobIds\": {\n" +
+		"          \"description\": \"Ids of the new jobs, by the ids of the jobs they were resubmitted from.\",\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobResumedEvent\": {\n" +
+		"      \"description\": \"Indicates that a suspended job was returned to the queue because its job set was resumed.\",\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"created\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"jobId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"jobSetId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"requestor\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobRunningEvent\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"clusterId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"created\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"jobId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"jobSetId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"kubernetesId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"nodeLabels\": {\n" +
+		"          \"description\": \"Labels of the node the job is running on. Only labels tracked by the executor are included.\",\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"nodeName\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"podName\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"podNamespace\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"podNumber\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int32\"\n" +
+		"        },\n" +
+		"        \"pool\": {\n" +
+		"          \"description\": \"Pool of the cluster the job is running in.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobRuntimeExceededEvent\": {\n" +
+		"      \"description\": \"Indicates that a run of a job exceeded the maximum runtime of the job, and that the job is therefore cancelled.\",\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"clusterId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"created\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"jobId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"jobSetId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"maxRuntimeSeconds\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int64\"\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobSetCancelRequest\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
+		"      \"properties\": {\n" +
+		"        \"filter\": {\n" +
+		"          \"$ref\": \"#/definitions/apiJobSetFilter\"\n" +
+		"        },\n" +
+		"        \"jobSetId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"labelSelector\": {\n" +
+		"          \"description\": \"If provided, only jobs with all of these labels are cancelled.\\nOnly supported for jobs of the legacy scheduler; jobs of the Pulsar scheduler are left untouched if provided,\\nand if it's enabled, the call fails with UNIMPLEMENTED once the matching jobs of the legacy scheduler are cancelled.\",\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"reason\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobSetExpiredEvent\": {\n" +
+		"      \"description\": \"Indicates that a job was cancelled because its job set didn't complete within its TTL.\",\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"created\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"jobId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"jobSetId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"jobSetTtlSeconds\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"int64\"\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
 		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobSetFilter\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
+		"      \"properties\": {\n" +
+		"        \"states\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/apiJobState\"\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobSetInfo\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"leasedJobs\": {\n" +
+		"          \"description\": \"Number of jobs leased to an executor, i.e., pending_jobs + running_jobs.\",\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int32\"\n" +
+		"        },\n" +
+		"        \"leasedResources\": {\n" +
+		"          \"description\": \"Total resource requests of all leased jobs, e.g., {\\\"cpu\\\": \\\"16\\\", \\\"memory\\\": \\\"64Gi\\\"}.\",\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"$ref\": \"#/definitions/resourceQuantity\"\n" +
+		"          }\n" +
 		"        },\n" +
 		"        \"name\": {\n" +
 		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"pendingJobs\": {\n" +
+		"          \"description\": \"Number of leased jobs that haven't started running yet.\",\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int32\"\n" +
+		"        },\n" +
+		"        \"queuedJobs\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int32\"\n" +
+		"        },\n" +
+		"        \"runningJobs\": {\n" +
+		"          \"description\": \"Number of leased jobs that have started running.\",\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int32\"\n" +
+		"        },\n" +
+		"        \"suspendedJobs\": {\n" +
+		"          \"description\": \"Number of jobs suspended while queued, which aren't counted in queued_jobs.\",\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int32\"\n" +
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
-		"    \"QueuePermissions\": {\n" +
+		"    \"apiJobSetPauseRequest\": {\n" +
 		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
 		"      \"properties\": {\n" +
-		"        \"subjects\": {\n" +
+		"        \"jobSetId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobSetRequest\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
+		"      \"properties\": {\n" +
+		"        \"errorIfMissing\": {\n" +
+		"          \"type\": \"boolean\"\n" +
+		"        },\n" +
+		"        \"eventTypes\": {\n" +
+		"          \"description\": \"If not empty, only events of these types are sent. Types are named as the fields of EventMessage,\\ne.g., \\\"submitted\\\" or \\\"duplicate_found\\\".\",\n" +
 		"          \"type\": \"array\",\n" +
 		"          \"items\": {\n" +
-		"            \"$ref\": \"#/definitions/PermissionsSubject\"\n" +
+		"            \"type\": \"string\"\n" +
 		"          }\n" +
 		"        },\n" +
-		"        \"verbs\": {\n" +
-		"          \"type\": \"array\",\n" +
-		"          \"items\": {\n" +
-		"            \"type\": \"string\"\n" +
-		"          }\n" +
+		"        \"forceLegacy\": {\n" +
+		"          \"type\": \"boolean\"\n" +
+		"        },\n" +
+		"        \"forceNew\": {\n" +
+		"          \"type\": \"boolean\"\n" +
+		"        },\n" +
+		"        \"fromMessageId\": {\n" +
+		"          \"description\": \"If set, only events after the event with this id are sent, i.e., the id of an EventStreamMessage.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"fromTime\": {\n" +
+		"          \"description\": \"If set, only events created at or after this time are sent.\",\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"id\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"schemaVersion\": {\n" +
+		"          \"description\": \"Latest version of the event schema the client understands, usually api.EventSchemaVersion of the client it was\\nbuilt with. Events of later versions are translated to it. If unset, events are translated to version 1.\",\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int64\"\n" +
+		"        },\n" +
+		"        \"terminalOnly\": {\n" +
+		"          \"description\": \"If set, only events ending jobs are sent, i.e., succeeded and cancelled events and failed events of jobs that\\nwon't be retried.\",\n" +
+		"          \"type\": \"boolean\"\n" +
+		"        },\n" +
+		"        \"watch\": {\n" +
+		"          \"type\": \"boolean\"\n" +
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
-		"    \"apiBatchQueueCreateResponse\": {\n" +
+		"    \"apiJobSetResumeRequest\": {\n" +
 		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
 		"      \"properties\": {\n" +
-		"        \"failedQueues\": {\n" +
-		"          \"type\": \"array\",\n" +
-		"          \"items\": {\n" +
-		"            \"$ref\": \"#/definitions/apiQueueCreateResponse\"\n" +
-		"          }\n" +
+		"        \"jobSetId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
-		"    \"apiBatchQueueUpdateResponse\": {\n" +
+		"    \"apiJobSizeLimitViolation\": {\n" +
+		"      \"description\": \"Identifies the part of a job that exceeds a size limit.\",\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"properties\": {\n" +
-		"        \"failedQueues\": {\n" +
-		"          \"type\": \"array\",\n" +
-		"          \"items\": {\n" +
-		"            \"$ref\": \"#/definitions/apiQueueUpdateResponse\"\n" +
-		"          }\n" +
+		"        \"field\": {\n" +
+		"          \"description\": \"Path of the field within the job submit request item contributing most to the job exceeding the limit,\\ne.g., \\\"podSpecs[0].containers[1].env\\\".\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"jobSize\": {\n" +
+		"          \"description\": \"Size of the job, in bytes for the size limit or as a number of containers for the container limit.\",\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"uint64\"\n" +
+		"        },\n" +
+		"        \"limit\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"uint64\"\n" +
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
-		"    \"apiCancellationResult\": {\n" +
+		"    \"apiJobSpecOverrides\": {\n" +
+		"      \"description\": \"Changes applied to the specs of resubmitted jobs. Unset fields leave the specs unchanged.\",\n" +
 		"      \"type\": \"object\",\n" +
-		"      \"title\": \"swagger:model\",\n" +
 		"      \"properties\": {\n" +
-		"        \"cancelledIds\": {\n" +
-		"          \"type\": \"array\",\n" +
-		"          \"items\": {\n" +
+		"        \"env\": {\n" +
+		"          \"description\": \"Environment variables set for every container, replacing any variables of the same name.\",\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
 		"            \"type\": \"string\"\n" +
 		"          }\n" +
+		"        },\n" +
+		"        \"imageTag\": {\n" +
+		"          \"description\": \"Replaces the tag, or digest, of the image of every container.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"priority\": {\n" +
+		"          \"type\": \"number\",\n" +
+		"          \"format\": \"double\"\n" +
+		"        },\n" +
+		"        \"resources\": {\n" +
+		"          \"description\": \"Requests and limits of these resources are replaced for every container, e.g., {\\\"requests\\\": {\\\"memory\\\": \\\"8Gi\\\"}}.\\nUnless both are given, the limit of a resource is set to its request and vice versa, as they must be equal.\",\n" +
+		"          \"$ref\": \"#/definitions/v1ResourceRequirements\"\n" +
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
-		"    \"apiCause\": {\n" +
+		"    \"apiJobState\": {\n" +
+		"      \"description\": \"- SUSPENDED: Queued jobs of a paused job set; these aren't considered for scheduling until the job set is resumed.\",\n" +
 		"      \"type\": \"string\",\n" +
-		"      \"default\": \"Error\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
+		"      \"default\": \"QUEUED\",\n" +
 		"      \"enum\": [\n" +
-		"        \"Error\",\n" +
-		"        \"Evicted\",\n" +
-		"        \"OOM\",\n" +
-		"        \"DeadlineExceeded\"\n" +
+		"        \"QUEUED\",\n" +
+		"        \"PENDING\",\n" +
+		"        \"RUNNING\",\n" +
+		"        \"SUCCEEDED\",\n" +
+		"        \"FAILED\",\n" +
+		"        \"UNKNOWN\",\n" +
+		"        \"SUSPENDED\",\n" +
+		"        \"CANCELLED\"\n" +
 		"      ]\n" +
 		"    },\n" +
-		"    \"apiContainerStatus\": {\n" +
+		"    \"apiJobStatus\": {\n" +
+		"      \"description\": \"JobStatus is the status of a job as reconstructed from the events of its job set.\",\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"properties\": {\n" +
-		"        \"cause\": {\n" +
-		"          \"$ref\": \"#/definitions/apiCause\"\n" +
-		"        },\n" +
-		"        \"exitCode\": {\n" +
-		"          \"type\": \"integer\",\n" +
-		"          \"format\": \"int32\"\n" +
+		"        \"clusterId\": {\n" +
+		"          \"description\": \"Cluster the job is leased to, or last ran on if it's finished. Empty if the job isn't leased.\",\n" +
+		"          \"type\": \"string\"\n" +
 		"        },\n" +
-		"        \"message\": {\n" +
+		"        \"jobId\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
-		"        \"name\": {\n" +
+		"        \"lastEvent\": {\n" +
+		"          \"description\": \"Most recent event of the job. Unset if no events of the job were found.\",\n" +
+		"          \"$ref\": \"#/definitions/apiEventMessage\"\n" +
+		"        },\n" +
+		"        \"lastEventId\": {\n" +
+		"          \"description\": \"Id of last_event in the event stream of the job set, from which events of the job set can be read on.\",\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
-		"        \"reason\": {\n" +
+		"        \"nodeName\": {\n" +
+		"          \"description\": \"Node the job is running on, or last ran on if it's finished. Empty if the job isn't running.\",\n" +
 		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"state\": {\n" +
+		"          \"description\": \"UNKNOWN if no events of the job were found, e.g., because it isn't part of the job set.\",\n" +
+		"          \"$ref\": \"#/definitions/apiJobState\"\n" +
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
-		"    \"apiEndMarker\": {\n" +
-		"      \"type\": \"object\",\n" +
-		"      \"title\": \"Indicates the end of streams\"\n" +
-		"    },\n" +
-		"    \"apiEventMessage\": {\n" +
+		"    \"apiJobStatusRequest\": {\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"properties\": {\n" +
-		"        \"cancelled\": {\n" +
-		"          \"$ref\": \"#/definitions/apiJobCancelledEvent\"\n" +
-		"        },\n" +
-		"        \"cancelling\": {\n" +
-		"          \"$ref\": \"#/definitions/apiJobCancellingEvent\"\n" +
-		"        },\n" +
-		"        \"duplicateFound\": {\n" +
-		"          \"$ref\": \"#/definitions/apiJobDuplicateFoundEvent\"\n" +
-		"        },\n" +
-		"        \"failed\": {\n" +
-		"          \"$ref\": \"#/definitions/apiJobFailedEvent\"\n" +
-		"        },\n" +
-		"        \"failedCompressed\": {\n" +
-		"          \"$ref\": \"#/definitions/apiJobFailedEventCompressed\"\n" +
-		"        },\n" +
-		"        \"ingressInfo\": {\n" +
-		"          \"$ref\": \"#/definitions/apiJobIngressInfoEvent\"\n" +
-		"        },\n" +
-		"        \"leaseExpired\": {\n" +
-		"          \"$ref\": \"#/definitions/apiJobLeaseExpiredEvent\"\n" +
-		"        },\n" +
-		"        \"leaseReturned\": {\n" +
-		"          \"$ref\": \"#/definitions/apiJobLeaseReturnedEvent\"\n" +
-		"        },\n" +
-		"        \"leased\": {\n" +
-		"          \"$ref\": \"#/definitions/apiJobLeasedEvent\"\n" +
-		"        },\n" +
-		"        \"pending\": {\n" +
-		"          \"$ref\": \"#/definitions/apiJobPendingEvent\"\n" +
-		"        },\n" +
-		"        \"preempted\": {\n" +
-		"          \"$ref\": \"#/definitions/apiJobPreemptedEvent\"\n" +
-		"        },\n" +
-		"        \"queued\": {\n" +
-		"          \"$ref\": \"#/definitions/apiJobQueuedEvent\"\n" +
-		"        },\n" +
-		"        \"reprioritized\": {\n" +
-		"          \"$ref\": \"#/definitions/apiJobReprioritizedEvent\"\n" +
-		"        },\n" +
-		"        \"reprioritizing\": {\n" +
-		"          \"$ref\": \"#/definitions/apiJobReprioritizingEvent\"\n" +
-		"        },\n" +
-		"        \"running\": {\n" +
-		"          \"$ref\": \"#/definitions/apiJobRunningEvent\"\n" +
-		"        },\n" +
-		"        \"submitted\": {\n" +
-		"          \"$ref\": \"#/definitions/apiJobSubmittedEvent\"\n" +
-		"        },\n" +
-		"        \"succeeded\": {\n" +
-		"          \"$ref\": \"#/definitions/apiJobSucceededEvent\"\n" +
-		"        },\n" +
-		"        \"terminated\": {\n" +
-		"          \"$ref\": \"#/definitions/apiJobTerminatedEvent\"\n" +
-		"        },\n" +
-		"        \"unableToSchedule\": {\n" +
-		"          \"$ref\": \"#/definitions/apiJobUnableToScheduleEvent\"\n" +
+		"        \"jobIds\": {\n" +
+		"          \"description\": \"Ids of jobs of the job set to get the status of.\",\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
 		"        },\n" +
-		"        \"updated\": {\n" +
-		"          \"$ref\": \"#/definitions/apiJobUpdatedEvent\"\n" +
+		"        \"jobSetId\": {\n" +
+		"          \"type\": \"string\"\n" +
 		"        },\n" +
-		"        \"utilisation\": {\n" +
-		"          \"$ref\": \"#/definitions/apiJobUtilisationEvent\"\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
-		"    \"apiEventStreamMessage\": {\n" +
+		"    \"apiJobStatusResponse\": {\n" +
 		"      \"type\": \"object\",\n" +
-		"      \"title\": \"swagger:model\",\n" +
 		"      \"properties\": {\n" +
-		"        \"id\": {\n" +
+		"        \"jobStatuses\": {\n" +
+		"          \"description\": \"Status of each job, in the order of the requested job ids.\",\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/apiJobStatus\"\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobSubmitError\": {\n" +
+		"      \"description\": \"Describes why a job was rejected, such that clients needn't parse error messages.\",\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"code\": {\n" +
+		"          \"$ref\": \"#/definitions/apiJobSubmitErrorCode\"\n" +
+		"        },\n" +
+		"        \"field\": {\n" +
+		"          \"description\": \"Path of the field of the job submit request item the error relates to, if any, e.g., \\\"podSpecs[0].containers[1]\\\".\",\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
 		"        \"message\": {\n" +
-		"          \"$ref\": \"#/definitions/apiEventMessage\"\n" +
+		"          \"type\": \"string\"\n" +
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
-		"    \"apiIngressConfig\": {\n" +
+		"    \"apiJobSubmitErrorCode\": {\n" +
+		"      \"description\": \" - INVALID_POD_SPEC: The pod spec of the job is missing or invalid, e.g., because its resource requests and limits differ.\\n - INVALID_JOB: A field of the job other than its pod spec is invalid, e.g., its annotations, priority, or ingress.\\n - EXCEEDS_SIZE_LIMIT: The job exceeds a limit on the size of jobs, in which case size_limit_violation of the response item is set.\\n - EXCEEDS_QUEUE_LIMIT: Submitting the job would exceed a limit of its queue, e.g., on the number of queued jobs or a resource quota.\\n - UNSCHEDULABLE: The job can't be scheduled on any cluster.\\n - DUPLICATE: A job with the same client id was submitted before. The job isn't a failure: the job id of the response item\\nis that of the job submitted before.\\n - INTERNAL: The job couldn't be stored.\\n - INVALID_GANG: The job is a member of a gang that's inconsistent, e.g., because its members have different priorities.\\n - POLICY_VIOLATION: The job is denied by a submission policy of the operators, as per the message of the error.\\n - SCHEDULING_INFO_STALE: It can't be verified that the job can be scheduled, since no cluster has reported recently,\\ne.g., because executors are restarting. The submission may be retried later.\\n - INVALID_REFERENCE: The pod spec of the job references a secret or config map that isn't allowed, or that doesn't exist in the\\nnamespace of the job on any cluster reporting its secrets and config maps. The field is that of the reference.\",\n" +
+		"      \"type\": \"string\",\n" +
+		"      \"default\": \"UNSPECIFIED\",\n" +
+		"      \"enum\": [\n" +
+		"        \"UNSPECIFIED\",\n" +
+		"        \"INVALID_POD_SPEC\",\n" +
+		"        \"INVALID_JOB\",\n" +
+		"        \"EXCEEDS_SIZE_LIMIT\",\n" +
+		"        \"EXCEEDS_QUEUE_LIMIT\",\n" +
+		"        \"UNSCHEDULABLE\",\n" +
+		"        \"DUPLICATE\",\n" +
+		"        \"INTERNAL\",\n" +
+		"        \"INVALID_GANG\",\n" +
+		"        \"POLICY_VIOLATION\",\n" +
+		"        \"SCHEDULING_INFO_STALE\",\n" +
+		"        \"INVALID_REFERENCE\"\n" +
+		"      ]\n" +
+		"    },\n" +
+		"    \"apiJobSubmitRequest\": {\n" +
 		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
 		"      \"properties\": {\n" +
-		"        \"annotations\": {\n" +
-		"          \"type\": \"object\",\n" +
-		"          \"additionalProperties\": {\n" +
-		"            \"type\": \"string\"\n" +
-		"          }\n" +
+		"        \"basePodSpec\": {\n" +
+		"          \"description\": \"If set, items that set neither pod_spec nor pod_specs use this pod spec after applying their pod_spec_overlays.\",\n" +
+		"          \"$ref\": \"#/definitions/v1PodSpec\"\n" +
 		"        },\n" +
-		"        \"certName\": {\n" +
-		"          \"type\": \"string\"\n" +
+		"        \"jobArrays\": {\n" +
+		"          \"description\": \"Jobs of these arrays are submitted after job_request_items, in order of their arrays and indices.\",\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/apiJobArray\"\n" +
+		"          }\n" +
 		"        },\n" +
-		"        \"ports\": {\n" +
+		"        \"jobRequestItems\": {\n" +
 		"          \"type\": \"array\",\n" +
 		"          \"items\": {\n" +
-		"            \"type\": \"integer\",\n" +
-		"            \"format\": \"int64\"\n" +
+		"            \"$ref\": \"#/definitions/apiJobSubmitRequestItem\"\n" +
 		"          }\n" +
 		"        },\n" +
-		"        \"tlsEnabled\": {\n" +
-		"          \"type\": \"boolean\"\n" +
+		"        \"jobSetId\": {\n" +
+		"          \"type\": \"string\"\n" +
 		"        },\n" +
-		"        \"type\": {\n" +
-		"          \"$ref\": \"#/definitions/apiIngressType\"\n" +
+		"        \"jobSetResourceLimits\": {\n" +
+		"          \"description\": \"If set, jobs in this request are only scheduled while the total resource requests of the running jobs\\nof the job set, including the job to be scheduled, are within these limits, e.g., {\\\"cpu\\\": \\\"2000\\\"}.\\nOnly enforced by the legacy scheduler, to which such jobs are always assigned.\",\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"$ref\": \"#/definitions/resourceQuantity\"\n" +
+		"          }\n" +
 		"        },\n" +
-		"        \"useClusterIP\": {\n" +
-		"          \"type\": \"boolean\"\n" +
+		"        \"jobSetTtlSeconds\": {\n" +
+		"          \"description\": \"If set, all jobs in the job set that haven't completed this many seconds after the job set was first submitted\\nwith a TTL are cancelled. Submitting more jobs to the job set doesn't extend its deadline.\",\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"int64\"\n" +
+		"        },\n" +
+		"        \"maxConcurrentJobs\": {\n" +
+		"          \"description\": \"If set, at most this many of the jobs in this request run at a time, counting all running jobs of the job set;\\nfurther jobs are held back and admitted for scheduling as earlier ones finish.\\nOnly enforced by the legacy scheduler, to which such jobs are always assigned.\",\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int64\"\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
-		"    \"apiIngressType\": {\n" +
-		"      \"description\": \"Ingress type is being kept here to maintain backwards compatibility for a while.\",\n" +
-		"      \"type\": \"string\",\n" +
-		"      \"default\": \"Ingress\",\n" +
-		"      \"enum\": [\n" +
-		"        \"Ingress\"\n" +
-		"      ]\n" +
-		"    },\n" +
-		"    \"apiJob\": {\n" +
+		"    \"apiJobSubmitRequestItem\": {\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"properties\": {\n" +
 		"        \"annotations\": {\n" +
@@ -686,38 +3850,23 @@ func SwaggerJsonTemplate() string {
 		"        \"clientId\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
-		"        \"compressedQueueOwnershipUserGroups\": {\n" +
-		"          \"type\": \"string\",\n" +
-		"          \"format\": \"byte\"\n" +
-		"        },\n" +
-		"        \"created\": {\n" +
-		"          \"type\": \"string\",\n" +
-		"          \"format\": \"date-time\"\n" +
+		"        \"clusterTargeting\": {\n" +
+		"          \"description\": \"If set, the clusters the job may run on, e.g., to run it close to the data it processes. Only supported for jobs\\nof the legacy scheduler, to which jobs targeting clusters are submitted.\",\n" +
+		"          \"$ref\": \"#/definitions/apiClusterTargeting\"\n" +
 		"        },\n" +
-		"        \"id\": {\n" +
-		"          \"type\": \"string\"\n" +
+		"        \"gang\": {\n" +
+		"          \"description\": \"If set, the job is a member of a gang, all members of which are scheduled at once or not at all.\\nMay not be set together with the gang annotations, e.g., armadaproject.io/gangId, which it replaces.\",\n" +
+		"          \"$ref\": \"#/definitions/apiGang\"\n" +
 		"        },\n" +
 		"        \"ingress\": {\n" +
-		"          \"description\": \"Services can be provided either as Armada-specific config objects or as proper k8s objects.\\nThese options are exclusive, i.e., if either ingress or services is provided,\\nthen neither of k8s_ingress or k8s_service can be provided, and vice versa.\",\n" +
 		"          \"type\": \"array\",\n" +
 		"          \"items\": {\n" +
 		"            \"$ref\": \"#/definitions/apiIngressConfig\"\n" +
 		"          }\n" +
 		"        },\n" +
-		"        \"jobSetId\": {\n" +
-		"          \"type\": \"string\"\n" +
-		"        },\n" +
-		"        \"k8sIngress\": {\n" +
-		"          \"type\": \"array\",\n" +
-		"          \"items\": {\n" +
-		"            \"$ref\": \"#/definitions/v1Ingress\"\n" +
-		"          }\n" +
-		"        },\n" +
-		"        \"k8sService\": {\n" +
-		"          \"type\": \"array\",\n" +
-		"          \"items\": {\n" +
-		"            \"$ref\": \"#/definitions/v1Service\"\n" +
-		"          }\n" +
+		"        \"isPreemptible\": {\n" +
+		"          \"description\": \"If set, the job may be evicted whenever higher-priority work needs its resources, regardless of its priority\\nclass, in which case it's returned to the queue rather than failed. Only supported for jobs of the legacy\\nscheduler, to which preemptible jobs are submitted. May not be set for members of gangs.\",\n" +
+		"          \"type\": \"boolean\"\n" +
 		"        },\n" +
 		"        \"labels\": {\n" +
 		"          \"type\": \"object\",\n" +
@@ -725,15 +3874,24 @@ func SwaggerJsonTemplate() string {
 		"            \"type\": \"string\"\n" +
 		"          }\n" +
 		"        },\n" +
-		"        \"namespace\": {\n" +
-		"          \"type\": \"string\"\n" +
+		"        \"maxRuntimeSeconds\": {\n" +
+		"          \"description\": \"If set, runs of the job are cancelled once they've been running for this many seconds. Defaults to, and may not\\nexceed, the max_job_runtime_seconds of the queue, if any. Only enforced for jobs of the legacy scheduler,\\nto which jobs with a maximum runtime are submitted.\",\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int64\"\n" +
 		"        },\n" +
-		"        \"owner\": {\n" +
+		"        \"namespace\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
 		"        \"podSpec\": {\n" +
 		"          \"$ref\": \"#/definitions/v1PodSpec\"\n" +
 		"        },\n" +
+		"        \"podSpecOverlays\": {\n" +
+		"          \"description\": \"Patches applied in order to the base_pod_spec of the request to obtain the pod spec of this job.\\nMay only be set if the request has a base_pod_spec and this item sets neither pod_spec nor pod_specs.\",\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/apiPodSpecOverlay\"\n" +
+		"          }\n" +
+		"        },\n" +
 		"        \"podSpecs\": {\n" +
 		"          \"type\": \"array\",\n" +
 		"          \"items\": {\n" +
@@ -744,15 +3902,10 @@ func SwaggerJsonTemplate() string {
 		"          \"type\": \"number\",\n" +
 		"          \"format\": \"double\"\n" +
 		"        },\n" +
-		"        \"queue\": {\n" +
+		"        \"priorityClassName\": {\n" +
+		"          \"description\": \"Name of the priority class of the job, one of those configured by the server, which determine the priority of\\nthe job and how many resources each queue may be assigned for jobs of the class. Set as the priority class of\\neach pod spec of the job; may only be set if the pod specs don't set a different priority class.\",\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
-		"        \"queueOwnershipUserGroups\": {\n" +
-		"          \"type\": \"array\",\n" +
-		"          \"items\": {\n" +
-		"            \"type\": \"string\"\n" +
-		"          }\n" +
-		"        },\n" +
 		"        \"queueTtlSeconds\": {\n" +
 		"          \"description\": \"Queuing TTL for this job in seconds. If this job queues for more than this duration it will be cancelled. Zero indicates an infinite lifetime.\",\n" +
 		"          \"type\": \"string\",\n" +
@@ -764,15 +3917,14 @@ func SwaggerJsonTemplate() string {
 		"            \"type\": \"string\"\n" +
 		"          }\n" +
 		"        },\n" +
+		"        \"retryPolicy\": {\n" +
+		"          \"description\": \"If set, failed runs of the job are retried as per this policy. Only supported for jobs of the legacy scheduler,\\nto which jobs with a retry policy are submitted. May not be set for members of gangs.\",\n" +
+		"          \"$ref\": \"#/definitions/apiRetryPolicy\"\n" +
+		"        },\n" +
 		"        \"scheduler\": {\n" +
 		"          \"description\": \"Indicates which scheduler should manage this job.\\nIf empty, the default scheduler is used.\",\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
-		"        \"schedulingResourceRequirements\": {\n" +
-		"          \"description\": \"max(\\n\\n\\tsum across all containers,\\n\\tmax over all init containers,\\n\\n)\\n\\nThis is because containers run in parallel, whereas initContainers run serially.\\nThis field is populated automatically at submission.\\nSubmitting a job with this field already populated results in an error.\",\n" +
-		"          \"title\": \"Resource requests and limits necessary for scheduling the main pod of this job.\\nThe requests and limits herein are set to:\",\n" +
-		"          \"$ref\": \"#/definitions/v1ResourceRequirements\"\n" +
-		"        },\n" +
 		"        \"services\": {\n" +
 		"          \"type\": \"array\",\n" +
 		"          \"items\": {\n" +
@@ -781,125 +3933,84 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
-		"    \"apiJobCancelRequest\": {\n" +
+		"    \"apiJobSubmitResponse\": {\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"title\": \"swagger:model\",\n" +
 		"      \"properties\": {\n" +
-		"        \"jobId\": {\n" +
+		"        \"failureReportId\": {\n" +
+		"          \"description\": \"Set in the details of the status of a rejected submission if only some of the job errors are included.\\nAll of them can be retrieved with GetSubmitFailureReport using this id, until the report expires.\",\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
-		"        \"jobIds\": {\n" +
+		"        \"jobResponseItems\": {\n" +
 		"          \"type\": \"array\",\n" +
 		"          \"items\": {\n" +
-		"            \"type\": \"string\"\n" +
+		"            \"$ref\": \"#/definitions/apiJobSubmitResponseItem\"\n" +
 		"          }\n" +
-		"        },\n" +
-		"        \"jobSetId\": {\n" +
-		"          \"type\": \"string\"\n" +
-		"        },\n" +
-		"        \"queue\": {\n" +
-		"          \"type\": \"string\"\n" +
-		"        },\n" +
-		"        \"reason\": {\n" +
-		"          \"type\": \"string\"\n" +
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
-		"    \"apiJobCancelledEvent\": {\n" +
+		"    \"apiJobSubmitResponseItem\": {\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"properties\": {\n" +
-		"        \"created\": {\n" +
-		"          \"type\": \"string\",\n" +
-		"          \"format\": \"date-time\"\n" +
-		"        },\n" +
-		"        \"jobId\": {\n" +
-		"          \"type\": \"string\"\n" +
-		"        },\n" +
-		"        \"jobSetId\": {\n" +
-		"          \"type\": \"string\"\n" +
-		"        },\n" +
-		"        \"queue\": {\n" +
-		"          \"type\": \"string\"\n" +
-		"        },\n" +
-		"        \"reason\": {\n" +
+		"        \"error\": {\n" +
+		"          \"description\": \"Deprecated: use error_details, the message of which is equal to error.\",\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
-		"        \"requestor\": {\n" +
-		"          \"type\": \"string\"\n" +
-		"        }\n" +
-		"      }\n" +
-		"    },\n" +
-		"    \"apiJobCancellingEvent\": {\n" +
-		"      \"type\": \"object\",\n" +
-		"      \"properties\": {\n" +
-		"        \"created\": {\n" +
-		"          \"type\": \"string\",\n" +
-		"          \"format\": \"date-time\"\n" +
+		"        \"errorDetails\": {\n" +
+		"          \"description\": \"Set if the job was rejected, or if it's a duplicate of a job submitted before.\",\n" +
+		"          \"$ref\": \"#/definitions/apiJobSubmitError\"\n" +
 		"        },\n" +
 		"        \"jobId\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
-		"        \"jobSetId\": {\n" +
-		"          \"type\": \"string\"\n" +
-		"        },\n" +
-		"        \"queue\": {\n" +
-		"          \"type\": \"string\"\n" +
+		"        \"sizeLimitViolation\": {\n" +
+		"          \"description\": \"Set if the job was rejected because it exceeds a job size limit.\",\n" +
+		"          \"$ref\": \"#/definitions/apiJobSizeLimitViolation\"\n" +
 		"        },\n" +
-		"        \"reason\": {\n" +
-		"          \"type\": \"string\"\n" +
+		"        \"unschedulableReasons\": {\n" +
+		"          \"description\": \"Set if the job was rejected as unschedulable, explaining for each cluster it may run on why it can't.\",\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/apiClusterUnschedulableReasons\"\n" +
+		"          }\n" +
 		"        },\n" +
-		"        \"requestor\": {\n" +
+		"        \"warning\": {\n" +
+		"          \"description\": \"Set if the job was accepted despite a possible problem, e.g., if it couldn't be verified that it can be scheduled.\",\n" +
 		"          \"type\": \"string\"\n" +
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
-		"    \"apiJobDuplicateFoundEvent\": {\n" +
+		"    \"apiJobSubmittedEvent\": {\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"properties\": {\n" +
 		"        \"created\": {\n" +
 		"          \"type\": \"string\",\n" +
 		"          \"format\": \"date-time\"\n" +
 		"        },\n" +
+		"        \"job\": {\n" +
+		"          \"$ref\": \"#/definitions/apiJob\"\n" +
+		"        },\n" +
 		"        \"jobId\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
 		"        \"jobSetId\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
-		"        \"originalJobId\": {\n" +
-		"          \"type\": \"string\"\n" +
-		"        },\n" +
 		"        \"queue\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
-		"    \"apiJobFailedEvent\": {\n" +
+		"    \"apiJobSucceededEvent\": {\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"properties\": {\n" +
-		"        \"cause\": {\n" +
-		"          \"$ref\": \"#/definitions/apiCause\"\n" +
-		"        },\n" +
 		"        \"clusterId\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
-		"        \"containerStatuses\": {\n" +
-		"          \"type\": \"array\",\n" +
-		"          \"items\": {\n" +
-		"            \"$ref\": \"#/definitions/apiContainerStatus\"\n" +
-		"          }\n" +
-		"        },\n" +
 		"        \"created\": {\n" +
 		"          \"type\": \"string\",\n" +
 		"          \"format\": \"date-time\"\n" +
 		"        },\n" +
-		"        \"exitCodes\": {\n" +
-		"          \"type\": \"object\",\n" +
-		"          \"additionalProperties\": {\n" +
-		"            \"type\": \"integer\",\n" +
-		"            \"format\": \"int32\"\n" +
-		"          }\n" +
-		"        },\n" +
 		"        \"jobId\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
@@ -924,84 +4035,32 @@ func SwaggerJsonTemplate() string {
 		"        },\n" +
 		"        \"queue\": {\n" +
 		"          \"type\": \"string\"\n" +
-		"        },\n" +
-		"        \"reason\": {\n" +
-		"          \"type\": \"string\"\n" +
-		"        }\n" +
-		"      }\n" +
-		"    },\n" +
-		"    \"apiJobFailedEventCompressed\": {\n" +
-		"      \"type\": \"object\",\n" +
-		"      \"title\": \"Only used internally by Armada\",\n" +
-		"      \"properties\": {\n" +
-		"        \"event\": {\n" +
-		"          \"type\": \"string\",\n" +
-		"          \"format\": \"byte\"\n" +
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
-		"    \"apiJobIngressInfoEvent\": {\n" +
+		"    \"apiJobSuspendedEvent\": {\n" +
+		"      \"description\": \"Indicates that a queued job was suspended because its job set was paused.\",\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"properties\": {\n" +
-		"        \"clusterId\": {\n" +
-		"          \"type\": \"string\"\n" +
-		"        },\n" +
 		"        \"created\": {\n" +
 		"          \"type\": \"string\",\n" +
 		"          \"format\": \"date-time\"\n" +
 		"        },\n" +
-		"        \"ingressAddresses\": {\n" +
-		"          \"type\": \"object\",\n" +
-		"          \"additionalProperties\": {\n" +
-		"            \"type\": \"string\"\n" +
-		"          }\n" +
-		"        },\n" +
 		"        \"jobId\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
 		"        \"jobSetId\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
-		"        \"kubernetesId\": {\n" +
-		"          \"type\": \"string\"\n" +
-		"        },\n" +
-		"        \"nodeName\": {\n" +
-		"          \"type\": \"string\"\n" +
-		"        },\n" +
-		"        \"podName\": {\n" +
-		"          \"type\": \"string\"\n" +
-		"        },\n" +
-		"        \"podNamespace\": {\n" +
-		"          \"type\": \"string\"\n" +
-		"        },\n" +
-		"        \"podNumber\": {\n" +
-		"          \"type\": \"integer\",\n" +
-		"          \"format\": \"int32\"\n" +
-		"        },\n" +
 		"        \"queue\": {\n" +
 		"          \"type\": \"string\"\n" +
-		"        }\n" +
-		"      }\n" +
-		"    },\n" +
-		"    \"apiJobLeaseExpiredEvent\": {\n" +
-		"      \"type\": \"object\",\n" +
-		"      \"properties\": {\n" +
-		"        \"created\": {\n" +
-		"          \"type\": \"string\",\n" +
-		"          \"format\": \"date-time\"\n" +
-		"        },\n" +
-		"        \"jobId\": {\n" +
-		"          \"type\": \"string\"\n" +
-		"        },\n" +
-		"        \"jobSetId\": {\n" +
-		"          \"type\": \"string\"\n" +
 		"        },\n" +
-		"        \"queue\": {\n" +
+		"        \"requestor\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
-		"    \"apiJobLeaseReturnedEvent\": {\n" +
+		"    \"apiJobTerminatedEvent\": {\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"properties\": {\n" +
 		"        \"clusterId\": {\n" +
@@ -1020,6 +4079,12 @@ func SwaggerJsonTemplate() string {
 		"        \"kubernetesId\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
+		"        \"podName\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"podNamespace\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
 		"        \"podNumber\": {\n" +
 		"          \"type\": \"integer\",\n" +
 		"          \"format\": \"int32\"\n" +
@@ -1029,13 +4094,10 @@ func SwaggerJsonTemplate() string {
 		"        },\n" +
 		"        \"reason\": {\n" +
 		"          \"type\": \"string\"\n" +
-		"        },\n" +
-		"        \"runAttempted\": {\n" +
-		"          \"type\": \"boolean\"\n" +
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
-		"    \"apiJobLeasedEvent\": {\n" +
+		"    \"apiJobUnableToScheduleEvent\": {\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"properties\": {\n" +
 		"        \"clusterId\": {\n" +
@@ -1051,28 +4113,10 @@ func SwaggerJsonTemplate() string {
 		"        \"jobSetId\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
-		"        \"queue\": {\n" +
-		"          \"type\": \"string\"\n" +
-		"        }\n" +
-		"      }\n" +
-		"    },\n" +
-		"    \"apiJobPendingEvent\": {\n" +
-		"      \"type\": \"object\",\n" +
-		"      \"properties\": {\n" +
-		"        \"clusterId\": {\n" +
-		"          \"type\": \"string\"\n" +
-		"        },\n" +
-		"        \"created\": {\n" +
-		"          \"type\": \"string\",\n" +
-		"          \"format\": \"date-time\"\n" +
-		"        },\n" +
-		"        \"jobId\": {\n" +
-		"          \"type\": \"string\"\n" +
-		"        },\n" +
-		"        \"jobSetId\": {\n" +
+		"        \"kubernetesId\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
-		"        \"kubernetesId\": {\n" +
+		"        \"nodeName\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
 		"        \"podName\": {\n" +
@@ -1087,92 +4131,40 @@ func SwaggerJsonTemplate() string {
 		"        },\n" +
 		"        \"queue\": {\n" +
 		"          \"type\": \"string\"\n" +
-		"        }\n" +
-		"      }\n" +
-		"    },\n" +
-		"    \"apiJobPreemptedEvent\": {\n" +
-		"      \"type\": \"object\",\n" +
-		"      \"properties\": {\n" +
-		"        \"clusterId\": {\n" +
-		"          \"type\": \"string\"\n" +
-		"        },\n" +
-		"        \"created\": {\n" +
-		"          \"type\": \"string\",\n" +
-		"          \"format\": \"date-time\"\n" +
-		"        },\n" +
-		"        \"jobId\": {\n" +
-		"          \"type\": \"string\"\n" +
-		"        },\n" +
-		"        \"jobSetId\": {\n" +
-		"          \"type\": \"string\"\n" +
-		"        },\n" +
-		"        \"preemptiveJobId\": {\n" +
-		"          \"type\": \"string\"\n" +
-		"        },\n" +
-		"        \"preemptiveRunId\": {\n" +
-		"          \"type\": \"string\"\n" +
-		"        },\n" +
-		"        \"queue\": {\n" +
-		"          \"type\": \"string\"\n" +
 		"        },\n" +
-		"        \"runId\": {\n" +
+		"        \"reason\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
-		"    \"apiJobQueuedEvent\": {\n" +
+		"    \"apiJobUncordonedEvent\": {\n" +
+		"      \"description\": \"Indicates that the queue of the job, or the executor it's leased to, was uncordoned.\",\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"properties\": {\n" +
 		"        \"created\": {\n" +
 		"          \"type\": \"string\",\n" +
 		"          \"format\": \"date-time\"\n" +
 		"        },\n" +
-		"        \"jobId\": {\n" +
-		"          \"type\": \"string\"\n" +
-		"        },\n" +
-		"        \"jobSetId\": {\n" +
-		"          \"type\": \"string\"\n" +
-		"        },\n" +
-		"        \"queue\": {\n" +
-		"          \"type\": \"string\"\n" +
-		"        }\n" +
-		"      }\n" +
-		"    },\n" +
-		"    \"apiJobReprioritizeRequest\": {\n" +
-		"      \"type\": \"object\",\n" +
-		"      \"title\": \"swagger:model\",\n" +
-		"      \"properties\": {\n" +
-		"        \"jobIds\": {\n" +
-		"          \"type\": \"array\",\n" +
-		"          \"items\": {\n" +
-		"            \"type\": \"string\"\n" +
-		"          }\n" +
-		"        },\n" +
-		"        \"jobSetId\": {\n" +
+		"        \"executor\": {\n" +
+		"          \"description\": \"Set for cordons of executors.\",\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
-		"        \"newPriority\": {\n" +
-		"          \"type\": \"number\",\n" +
-		"          \"format\": \"double\"\n" +
-		"        },\n" +
-		"        \"queue\": {\n" +
+		"        \"jobId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"jobSetId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"uncordonedBy\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
-		"    \"apiJobReprioritizeResponse\": {\n" +
-		"      \"type\": \"object\",\n" +
-		"      \"title\": \"swagger:model\",\n" +
-		"      \"properties\": {\n" +
-		"        \"reprioritizationResults\": {\n" +
-		"          \"type\": \"object\",\n" +
-		"          \"additionalProperties\": {\n" +
-		"            \"type\": \"string\"\n" +
-		"          }\n" +
-		"        }\n" +
-		"      }\n" +
-		"    },\n" +
-		"    \"apiJobReprioritizedEvent\": {\n" +
+		"    \"apiJobUnschedulableEvent\": {\n" +
+		"      \"description\": \"Warns that a queued job can no longer be scheduled on any active cluster, e.g., because the nodes it fits on\\nwere removed. The job stays queued, and is reported again only if it becomes schedulable in between.\",\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"properties\": {\n" +
 		"        \"created\": {\n" +
@@ -1185,35 +4177,30 @@ func SwaggerJsonTemplate() string {
 		"        \"jobSetId\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
-		"        \"newPriority\": {\n" +
-		"          \"type\": \"number\",\n" +
-		"          \"format\": \"double\"\n" +
-		"        },\n" +
 		"        \"queue\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
-		"        \"requestor\": {\n" +
+		"        \"reason\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
-		"    \"apiJobReprioritizingEvent\": {\n" +
+		"    \"apiJobUpdatedEvent\": {\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"properties\": {\n" +
 		"        \"created\": {\n" +
 		"          \"type\": \"string\",\n" +
 		"          \"format\": \"date-time\"\n" +
 		"        },\n" +
+		"        \"job\": {\n" +
+		"          \"$ref\": \"#/definitions/apiJob\"\n" +
+		"        },\n" +
 		"        \"jobId\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
 		"        \"jobSetId\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
-		"        \"newPriority\": {\n" +
-		"          \"type\": \"number\",\n" +
-		"          \"format\": \"double\"\n" +
-		"        },\n" +
 		"        \"queue\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
@@ -1222,9 +4209,15 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
-		"    \"apiJobRunningEvent\": {\n" +
+		"    \"apiJobUtilisationEvent\": {\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"properties\": {\n" +
+		"        \"MaxResourcesForPeriod\": {\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"$ref\": \"#/definitions/resourceQuantity\"\n" +
+		"          }\n" +
+		"        },\n" +
 		"        \"clusterId\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
@@ -1256,17 +4249,80 @@ func SwaggerJsonTemplate() string {
 		"        },\n" +
 		"        \"queue\": {\n" +
 		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"totalCumulativeUsage\": {\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"$ref\": \"#/definitions/resourceQuantity\"\n" +
+		"          }\n" +
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
-		"    \"apiJobSetCancelRequest\": {\n" +
+		"    \"apiJobWaitReason\": {\n" +
 		"      \"type\": \"object\",\n" +
-		"      \"title\": \"swagger:model\",\n" +
 		"      \"properties\": {\n" +
-		"        \"filter\": {\n" +
-		"          \"$ref\": \"#/definitions/apiJobSetFilter\"\n" +
+		"        \"executorId\": {\n" +
+		"          \"description\": \"The executor in whose most recent scheduling round the job wasn't scheduled for this reason.\\nEmpty for reasons that apply to all executors.\",\n" +
+		"          \"type\": \"string\"\n" +
 		"        },\n" +
-		"        \"jobSetId\": {\n" +
+		"        \"message\": {\n" +
+		"          \"description\": \"Details of the reason, e.g., the reason reported by the scheduler.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"type\": {\n" +
+		"          \"$ref\": \"#/definitions/apiJobWaitReasonType\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiJobWaitReasonType\": {\n" +
+		"      \"description\": \" - OtherWaitReason: A reason not covered by any other type; see the message of the reason.\\n - QuotaExhausted: The queue or job set has reached a limit on the resources or number of jobs it may run concurrently.\\n - NoMatchingCluster: The job doesn't fit on any node of the cluster.\\n - FairShareDeficit: Jobs of other queues, or other jobs of this queue, were scheduled first to maintain fair share.\\n - GangIncomplete: The job is waiting for the other members of its gang or barrier to be submitted.\\n - QueuePaused: The job isn't considered for scheduling since its job set is paused.\\n - OutsideSubmissionWindow: The job was submitted outside of the submission windows of its queue and is held until the next window starts.\",\n" +
+		"      \"type\": \"string\",\n" +
+		"      \"default\": \"OtherWaitReason\",\n" +
+		"      \"enum\": [\n" +
+		"        \"OtherWaitReason\",\n" +
+		"        \"QuotaExhausted\",\n" +
+		"        \"NoMatchingCluster\",\n" +
+		"        \"FairShareDeficit\",\n" +
+		"        \"GangIncomplete\",\n" +
+		"        \"QueuePaused\",\n" +
+		"        \"OutsideSubmissionWindow\"\n" +
+		"      ]\n" +
+		"    },\n" +
+		"    \"apiJobWaitReasons\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"jobId\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"reasons\": {\n" +
+		"          \"description\": \"The reasons the job hasn't been scheduled yet. Empty if the job isn't queued.\",\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/apiJobWaitReason\"\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiMaintenanceWindow\": {\n" +
+		"      \"description\": \"MaintenanceWindow is a period during which no new leases are given to an executor or to the jobs of a queue.\",\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"createdBy\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"drain\": {\n" +
+		"          \"description\": \"If true, jobs leased to the executor, or jobs of the queue, are preempted and requeued when the window starts.\",\n" +
+		"          \"type\": \"boolean\"\n" +
+		"        },\n" +
+		"        \"end\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"executor\": {\n" +
+		"          \"description\": \"Exactly one of executor and queue is set.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"id\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
 		"        \"queue\": {\n" +
@@ -1274,428 +4330,699 @@ func SwaggerJsonTemplate() string {
 		"        },\n" +
 		"        \"reason\": {\n" +
 		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"start\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"started\": {\n" +
+		"          \"description\": \"Whether the start of the window has been announced, and jobs drained if requested.\",\n" +
+		"          \"type\": \"boolean\"\n" +
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
-		"    \"apiJobSetFilter\": {\n" +
+		"    \"apiMaintenanceWindowCreateRequest\": {\n" +
 		"      \"type\": \"object\",\n" +
-		"      \"title\": \"swagger:model\",\n" +
 		"      \"properties\": {\n" +
-		"        \"states\": {\n" +
+		"        \"drain\": {\n" +
+		"          \"type\": \"boolean\"\n" +
+		"        },\n" +
+		"        \"end\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"executor\": {\n" +
+		"          \"description\": \"Exactly one of executor and queue must be set.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"reason\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"start\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiMaintenanceWindowList\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"windows\": {\n" +
+		"          \"description\": \"Windows ordered by start.\",\n" +
 		"          \"type\": \"array\",\n" +
 		"          \"items\": {\n" +
-		"            \"$ref\": \"#/definitions/apiJobState\"\n" +
+		"            \"$ref\": \"#/definitions/apiMaintenanceWindow\"\n" +
 		"          }\n" +
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
-		"    \"apiJobSetInfo\": {\n" +
+		"    \"apiNodeType\": {\n" +
+		"      \"description\": \"The Armada scheduler must account for taints, labels, and available resources.\\nThese together make up the NodeType of a particular node.\\nNodes with equal NodeType are considered as equivalent for scheduling and accounting.\",\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"properties\": {\n" +
-		"        \"leasedJobs\": {\n" +
-		"          \"type\": \"integer\",\n" +
-		"          \"format\": \"int32\"\n" +
+		"        \"allocatableResources\": {\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"$ref\": \"#/definitions/resourceQuantity\"\n" +
+		"          }\n" +
 		"        },\n" +
-		"        \"name\": {\n" +
-		"          \"type\": \"string\"\n" +
+		"        \"capacity\": {\n" +
+		"          \"description\": \"Total resources of all nodes of this type.\",\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"$ref\": \"#/definitions/resourceQuantity\"\n" +
+		"          }\n" +
 		"        },\n" +
-		"        \"queuedJobs\": {\n" +
+		"        \"labels\": {\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"nodeCount\": {\n" +
+		"          \"description\": \"Number of nodes of this type.\",\n" +
 		"          \"type\": \"integer\",\n" +
 		"          \"format\": \"int32\"\n" +
+		"        },\n" +
+		"        \"taints\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/v1Taint\"\n" +
+		"          }\n" +
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
-		"    \"apiJobSetRequest\": {\n" +
+		"    \"apiNodeTypesResponse\": {\n" +
 		"      \"type\": \"object\",\n" +
-		"      \"title\": \"swagger:model\",\n" +
 		"      \"properties\": {\n" +
-		"        \"errorIfMissing\": {\n" +
-		"          \"type\": \"boolean\"\n" +
+		"        \"clusters\": {\n" +
+		"          \"description\": \"Clusters that have recently reported, and have any matching node types, ordered by id.\",\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/apiClusterNodeTypes\"\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiOperation\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"A long-running operation carried out in the background, e.g., a cascading queue deletion.\\nswagger:model\",\n" +
+		"      \"properties\": {\n" +
+		"        \"createTime\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
 		"        },\n" +
-		"        \"forceLegacy\": {\n" +
+		"        \"done\": {\n" +
+		"          \"description\": \"True once the operation has either completed or failed.\",\n" +
 		"          \"type\": \"boolean\"\n" +
 		"        },\n" +
-		"        \"forceNew\": {\n" +
-		"          \"type\": \"boolean\"\n" +
+		"        \"error\": {\n" +
+		"          \"description\": \"Set if the operation failed.\",\n" +
+		"          \"type\": \"string\"\n" +
 		"        },\n" +
-		"        \"fromMessageId\": {\n" +
+		"        \"name\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
-		"        \"id\": {\n" +
+		"        \"progress\": {\n" +
+		"          \"description\": \"Describes what the operation is currently doing, or what it did once done.\",\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
-		"        \"queue\": {\n" +
+		"        \"updateTime\": {\n" +
+		"          \"description\": \"Updated whenever the operation makes progress.\",\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiPodSpecOverlay\": {\n" +
+		"      \"description\": \"A patch applied server-side to a pod spec.\",\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"patch\": {\n" +
+		"          \"description\": \"The patch encoded as JSON; either a strategic merge patch of a PodSpec or a JSON patch (RFC 6902),\\ndepending on type.\",\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
-		"        \"watch\": {\n" +
-		"          \"type\": \"boolean\"\n" +
+		"        \"type\": {\n" +
+		"          \"$ref\": \"#/definitions/apiPodSpecOverlayType\"\n" +
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
-		"    \"apiJobState\": {\n" +
+		"    \"apiPodSpecOverlayType\": {\n" +
 		"      \"type\": \"string\",\n" +
-		"      \"title\": \"swagger:model\",\n" +
-		"      \"default\": \"QUEUED\",\n" +
+		"      \"default\": \"StrategicMergePatch\",\n" +
 		"      \"enum\": [\n" +
-		"        \"QUEUED\",\n" +
-		"        \"PENDING\",\n" +
-		"        \"RUNNING\",\n" +
-		"        \"SUCCEEDED\",\n" +
-		"        \"FAILED\",\n" +
-		"        \"UNKNOWN\"\n" +
+		"        \"StrategicMergePatch\",\n" +
+		"        \"JsonPatch\"\n" +
 		"      ]\n" +
 		"    },\n" +
-		"    \"apiJobSubmitRequest\": {\n" +
+		"    \"apiPodSpecPolicy\": {\n" +
+		"      \"description\": \"Defaults and limits applied to the pod specs of jobs submitted to a queue.\\nThese only narrow the corresponding server-wide settings; settings outside the server-wide limits are ignored.\",\n" +
 		"      \"type\": \"object\",\n" +
-		"      \"title\": \"swagger:model\",\n" +
 		"      \"properties\": {\n" +
-		"        \"jobRequestItems\": {\n" +
+		"        \"allowedRestartPolicies\": {\n" +
+		"          \"description\": \"Restart policies pods may set. If empty, only the server-wide allowed restart policies apply.\",\n" +
 		"          \"type\": \"array\",\n" +
 		"          \"items\": {\n" +
-		"            \"$ref\": \"#/definitions/apiJobSubmitRequestItem\"\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"allowedServiceAccounts\": {\n" +
+		"          \"description\": \"Service accounts pods may use; pods that don't set one use the \\\"default\\\" service account.\\nIf empty, only the server-wide allowed service accounts apply.\",\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"defaultRestartPolicy\": {\n" +
+		"          \"description\": \"Restart policy of pods that don't set one, e.g., \\\"Never\\\". If empty, the server-wide default applies.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"defaultTerminationGracePeriodSeconds\": {\n" +
+		"          \"description\": \"Termination grace period of pods that don't set one. If 0, the server-wide default applies.\",\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int64\"\n" +
+		"        },\n" +
+		"        \"forbiddenNodeSelectorLabels\": {\n" +
+		"          \"description\": \"Labels pods may not select nodes by, neither in their node selector nor in the node affinity they require.\",\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"forbiddenTaints\": {\n" +
+		"          \"description\": \"Taints pods may not tolerate, e.g., that of the node pool of another queue.\",\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/v1Taint\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"maxTerminationGracePeriodSeconds\": {\n" +
+		"          \"description\": \"Maximum termination grace period pods may set. If 0, only the server-wide maximum applies.\",\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int64\"\n" +
+		"        },\n" +
+		"        \"minTerminationGracePeriodSeconds\": {\n" +
+		"          \"description\": \"Minimum termination grace period pods may set. If 0, only the server-wide minimum applies.\",\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int64\"\n" +
+		"        },\n" +
+		"        \"requestsEqualLimits\": {\n" +
+		"          \"description\": \"If true, the resource limits of containers are lowered to their requests, such that pods can't use more\\nresources than they're scheduled for.\",\n" +
+		"          \"type\": \"boolean\"\n" +
+		"        },\n" +
+		"        \"requiredNodeSelector\": {\n" +
+		"          \"description\": \"Node selector labels set on every pod, replacing any values pods set for them, e.g., {\\\"pool\\\": \\\"team-a\\\"},\\nsuch that jobs of the queue only run on the nodes it's entitled to.\",\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"requiredTolerations\": {\n" +
+		"          \"description\": \"Tolerations added to every pod, e.g., to tolerate the taint of the node pool of the queue.\",\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/v1Toleration\"\n" +
 		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiQuarantinedJob\": {\n" +
+		"      \"description\": \"QuarantinedJob is the diagnostic record of a quarantined job, i.e., a job that was failed rather than retried\\nbecause its lease was returned too many times, e.g., because its pod could never be created.\",\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"jobId\": {\n" +
+		"          \"type\": \"string\"\n" +
 		"        },\n" +
 		"        \"jobSetId\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
+		"        \"leaseReturns\": {\n" +
+		"          \"description\": \"Number of times the lease of the job was returned before it was quarantined.\",\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int64\"\n" +
+		"        },\n" +
+		"        \"quarantined\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
 		"        \"queue\": {\n" +
 		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"recentLeaseReturns\": {\n" +
+		"          \"description\": \"Most recent lease returns of the job, oldest first, including the reasons they were returned for.\",\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/apiJobLeaseReturnedEvent\"\n" +
+		"          }\n" +
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
-		"    \"apiJobSubmitRequestItem\": {\n" +
+		"    \"apiQueue\": {\n" +
 		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
 		"      \"properties\": {\n" +
-		"        \"annotations\": {\n" +
-		"          \"type\": \"object\",\n" +
-		"          \"additionalProperties\": {\n" +
+		"        \"allowedNamespaces\": {\n" +
+		"          \"description\": \"Namespaces jobs submitted to this queue may be created in, e.g., [\\\"team-a\\\"]. Jobs submitted without a namespace\\nare created in namespace \\\"default\\\", which must then be included. If empty, any namespace is allowed.\",\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
 		"            \"type\": \"string\"\n" +
 		"          }\n" +
 		"        },\n" +
-		"        \"clientId\": {\n" +
+		"        \"archival\": {\n" +
+		"          \"description\": \"Set if the queue is archived. Archived queues reject new submissions but retain their configuration and jobs.\\nOnly changed by archiving and restoring the queue; ignored when creating or updating queues.\",\n" +
+		"          \"$ref\": \"#/definitions/apiQueueArchival\"\n" +
+		"        },\n" +
+		"        \"contact\": {\n" +
+		"          \"description\": \"Whom to contact about the queue, e.g., an email address or chat channel, in at most 256 characters.\",\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
-		"        \"ingress\": {\n" +
+		"        \"created\": {\n" +
+		"          \"description\": \"Time at which the queue was created, assigned by the server. Unset for queues created before this was recorded.\\nIgnored when creating or updating queues.\",\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"description\": {\n" +
+		"          \"description\": \"What the queue is for, in at most 1024 characters.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"documentationUrl\": {\n" +
+		"          \"description\": \"Absolute http(s) URL of documentation about the queue.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"groupOwners\": {\n" +
 		"          \"type\": \"array\",\n" +
 		"          \"items\": {\n" +
-		"            \"$ref\": \"#/definitions/apiIngressConfig\"\n" +
+		"            \"type\": \"string\"\n" +
 		"          }\n" +
 		"        },\n" +
+		"        \"ingressClassPolicy\": {\n" +
+		"          \"description\": \"Ingress classes the ingresses of jobs submitted to this queue may use, and their default.\",\n" +
+		"          \"$ref\": \"#/definitions/apiIngressClassPolicy\"\n" +
+		"        },\n" +
+		"        \"jobPriorityPolicy\": {\n" +
+		"          \"description\": \"Default and bounds of the priorities of jobs submitted to this queue.\",\n" +
+		"          \"$ref\": \"#/definitions/apiJobPriorityPolicy\"\n" +
+		"        },\n" +
 		"        \"labels\": {\n" +
+		"          \"description\": \"Arbitrary labels, e.g., {\\\"team\\\": \\\"ml\\\"}, by which queues can be selected when listing queues.\",\n" +
 		"          \"type\": \"object\",\n" +
 		"          \"additionalProperties\": {\n" +
 		"            \"type\": \"string\"\n" +
 		"          }\n" +
 		"        },\n" +
-		"        \"namespace\": {\n" +
+		"        \"maxContainersPerJob\": {\n" +
+		"          \"description\": \"Maximum number of containers, including init containers, of a job submitted to this queue.\\nApplies in addition to the server-wide limit. If 0, only the server-wide limit applies.\",\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int64\"\n" +
+		"        },\n" +
+		"        \"maxJobRuntimeSeconds\": {\n" +
+		"          \"description\": \"Maximum runtime in seconds of jobs submitted to this queue, which is also the runtime of jobs submitted without one.\\nIf 0, runtimes are unbounded.\",\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int64\"\n" +
+		"        },\n" +
+		"        \"maxJobSizeBytes\": {\n" +
+		"          \"description\": \"Maximum size in bytes of a serialized job submitted to this queue.\\nApplies in addition to the server-wide limit. If 0, only the server-wide limit applies.\",\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int64\"\n" +
+		"        },\n" +
+		"        \"name\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
-		"        \"podSpec\": {\n" +
-		"          \"$ref\": \"#/definitions/v1PodSpec\"\n" +
+		"        \"ownersCanManageOwnJobs\": {\n" +
+		"          \"description\": \"If true, users may cancel and reprioritize the jobs they submitted to this queue themselves,\\neven if they aren't permitted to cancel or reprioritize the jobs of the queue.\",\n" +
+		"          \"type\": \"boolean\"\n" +
 		"        },\n" +
-		"        \"podSpecs\": {\n" +
+		"        \"parent\": {\n" +
+		"          \"description\": \"Name of the parent of this queue, if any. Queues inherit the permissions of their ancestors\\nand, if their priority factor is 0, the priority factor of their parent.\\nThe fair share of a queue is divided among its children.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"permissions\": {\n" +
 		"          \"type\": \"array\",\n" +
 		"          \"items\": {\n" +
-		"            \"$ref\": \"#/definitions/v1PodSpec\"\n" +
+		"            \"$ref\": \"#/definitions/QueuePermissions\"\n" +
 		"          }\n" +
 		"        },\n" +
-		"        \"priority\": {\n" +
+		"        \"podSpecPolicy\": {\n" +
+		"          \"description\": \"Defaults and limits applied to the pod specs of jobs submitted to this queue.\",\n" +
+		"          \"$ref\": \"#/definitions/apiPodSpecPolicy\"\n" +
+		"        },\n" +
+		"        \"priorityFactor\": {\n" +
 		"          \"type\": \"number\",\n" +
 		"          \"format\": \"double\"\n" +
 		"        },\n" +
-		"        \"queueTtlSeconds\": {\n" +
-		"          \"description\": \"Queuing TTL for this job in seconds. If this job queues for more than this duration it will be cancelled. Zero indicates an infinite lifetime.\",\n" +
-		"          \"type\": \"string\",\n" +
-		"          \"format\": \"int64\"\n" +
-		"        },\n" +
-		"        \"requiredNodeLabels\": {\n" +
+		"        \"requiredAnnotations\": {\n" +
+		"          \"description\": \"Annotations, e.g., {\\\"cost-center\\\": \\\"[0-9]{4}\\\"}, that jobs submitted to this queue must have, mapped to regular\\nexpressions their values must match in full. An empty expression allows any value.\",\n" +
 		"          \"type\": \"object\",\n" +
 		"          \"additionalProperties\": {\n" +
 		"            \"type\": \"string\"\n" +
 		"          }\n" +
 		"        },\n" +
-		"        \"scheduler\": {\n" +
-		"          \"description\": \"Indicates which scheduler should manage this job.\\nIf empty, the default scheduler is used.\",\n" +
-		"          \"type\": \"string\"\n" +
-		"        },\n" +
-		"        \"services\": {\n" +
+		"        \"resourceBudgets\": {\n" +
+		"          \"description\": \"Limits on the resource-hours, e.g., GPU-hours, used by the jobs of this queue over rolling time windows.\\nUsage is accounted from the runs of jobs of the legacy scheduler, to which all jobs of queues with budgets are assigned.\",\n" +
 		"          \"type\": \"array\",\n" +
 		"          \"items\": {\n" +
-		"            \"$ref\": \"#/definitions/apiServiceConfig\"\n" +
+		"            \"$ref\": \"#/definitions/apiResourceBudget\"\n" +
 		"          }\n" +
-		"        }\n" +
-		"      }\n" +
-		"    },\n" +
-		"    \"apiJobSubmitResponse\": {\n" +
-		"      \"type\": \"object\",\n" +
-		"      \"title\": \"swagger:model\",\n" +
-		"      \"properties\": {\n" +
-		"        \"jobResponseItems\": {\n" +
+		"        },\n" +
+		"        \"resourceLimits\": {\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"type\": \"number\",\n" +
+		"            \"format\": \"double\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"resourceQuotas\": {\n" +
+		"          \"description\": \"Maximum total resources, e.g., {\\\"cpu\\\": \\\"1000\\\", \\\"nvidia.com/gpu\\\": \\\"16\\\"}, requested by the queued and running jobs of the queue.\\nSubmissions that would exceed a quota are rejected. Resources without a quota are unconstrained.\\nOnly enforced by the legacy scheduler, to which all jobs of queues with quotas are assigned.\",\n" +
+		"          \"type\": \"object\",\n" +
+		"          \"additionalProperties\": {\n" +
+		"            \"$ref\": \"#/definitions/resourceQuantity\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"resourceVersion\": {\n" +
+		"          \"description\": \"Version of the queue repository at which the queue was last changed, assigned by the server.\\nUnlike revisions, resource versions are ordered across queues; see EndMarker.\\nIgnored when creating or updating queues.\",\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"uint64\"\n" +
+		"        },\n" +
+		"        \"revision\": {\n" +
+		"          \"description\": \"Incremented by the server whenever the queue is changed. If non-zero when updating a queue,\\nthe update is rejected if the queue has been changed since this revision.\",\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"uint64\"\n" +
+		"        },\n" +
+		"        \"submissionWindows\": {\n" +
+		"          \"description\": \"Periods during which jobs may be submitted to this queue.\",\n" +
+		"          \"$ref\": \"#/definitions/apiSubmissionWindowPolicy\"\n" +
+		"        },\n" +
+		"        \"userOwners\": {\n" +
 		"          \"type\": \"array\",\n" +
 		"          \"items\": {\n" +
-		"            \"$ref\": \"#/definitions/apiJobSubmitResponseItem\"\n" +
+		"            \"type\": \"string\"\n" +
 		"          }\n" +
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
-		"    \"apiJobSubmitResponseItem\": {\n" +
+		"    \"apiQueueArchival\": {\n" +
+		"      \"description\": \"Records who archived a queue, when, and why.\",\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"properties\": {\n" +
-		"        \"error\": {\n" +
+		"        \"archivedAt\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"date-time\"\n" +
+		"        },\n" +
+		"        \"archivedBy\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
-		"        \"jobId\": {\n" +
+		"        \"reason\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
-		"    \"apiJobSubmittedEvent\": {\n" +
+		"    \"apiQueueArchiveRequest\": {\n" +
 		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
 		"      \"properties\": {\n" +
-		"        \"created\": {\n" +
-		"          \"type\": \"string\",\n" +
-		"          \"format\": \"date-time\"\n" +
-		"        },\n" +
-		"        \"job\": {\n" +
-		"          \"$ref\": \"#/definitions/apiJob\"\n" +
-		"        },\n" +
-		"        \"jobId\": {\n" +
+		"        \"name\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
-		"        \"jobSetId\": {\n" +
+		"        \"reason\": {\n" +
+		"          \"description\": \"Why the queue is archived, recorded in the archival of the queue.\",\n" +
 		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiQueueBudgets\": {\n" +
+		"      \"description\": \"Usage and remaining hours of the resource budgets of a queue.\",\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"budgets\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/apiResourceBudgetStatus\"\n" +
+		"          }\n" +
 		"        },\n" +
 		"        \"queue\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
-		"    \"apiJobSucceededEvent\": {\n" +
+		"    \"apiQueueChange\": {\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"properties\": {\n" +
-		"        \"clusterId\": {\n" +
-		"          \"type\": \"string\"\n" +
+		"        \"queue\": {\n" +
+		"          \"description\": \"The queue after the change or, if it was deleted, before it.\",\n" +
+		"          \"$ref\": \"#/definitions/apiQueue\"\n" +
 		"        },\n" +
-		"        \"created\": {\n" +
+		"        \"resourceVersion\": {\n" +
+		"          \"description\": \"Resource version of the queue repository at the change, after which to resume watching.\",\n" +
 		"          \"type\": \"string\",\n" +
-		"          \"format\": \"date-time\"\n" +
-		"        },\n" +
-		"        \"jobId\": {\n" +
-		"          \"type\": \"string\"\n" +
-		"        },\n" +
-		"        \"jobSetId\": {\n" +
-		"          \"type\": \"string\"\n" +
-		"        },\n" +
-		"        \"kubernetesId\": {\n" +
-		"          \"type\": \"string\"\n" +
-		"        },\n" +
-		"        \"nodeName\": {\n" +
-		"          \"type\": \"string\"\n" +
+		"          \"format\": \"uint64\"\n" +
 		"        },\n" +
-		"        \"podName\": {\n" +
-		"          \"type\": \"string\"\n" +
-		"        },\n" +
-		"        \"podNamespace\": {\n" +
+		"        \"type\": {\n" +
+		"          \"$ref\": \"#/definitions/apiQueueChangeType\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiQueueChangeType\": {\n" +
+		"      \"type\": \"string\",\n" +
+		"      \"default\": \"QueueCreated\",\n" +
+		"      \"enum\": [\n" +
+		"        \"QueueCreated\",\n" +
+		"        \"QueueUpdated\",\n" +
+		"        \"QueueDeleted\"\n" +
+		"      ]\n" +
+		"    },\n" +
+		"    \"apiQueueCreateResponse\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"error\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
-		"        \"podNumber\": {\n" +
-		"          \"type\": \"integer\",\n" +
-		"          \"format\": \"int32\"\n" +
-		"        },\n" +
 		"        \"queue\": {\n" +
-		"          \"type\": \"string\"\n" +
+		"          \"$ref\": \"#/definitions/apiQueue\"\n" +
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
-		"    \"apiJobTerminatedEvent\": {\n" +
+		"    \"apiQueueDiff\": {\n" +
+		"      \"description\": \"A change needed for a queue to match a document imported by ImportQueues.\",\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"properties\": {\n" +
-		"        \"clusterId\": {\n" +
+		"        \"error\": {\n" +
+		"          \"description\": \"Set if the change was attempted but failed.\",\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
-		"        \"created\": {\n" +
-		"          \"type\": \"string\",\n" +
-		"          \"format\": \"date-time\"\n" +
-		"        },\n" +
-		"        \"jobId\": {\n" +
-		"          \"type\": \"string\"\n" +
+		"        \"fields\": {\n" +
+		"          \"description\": \"Fields of updated queues that differ from the document, e.g., \\\"priorityFactor\\\".\",\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
 		"        },\n" +
-		"        \"jobSetId\": {\n" +
+		"        \"name\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
-		"        \"kubernetesId\": {\n" +
+		"        \"type\": {\n" +
+		"          \"$ref\": \"#/definitions/apiQueueChangeType\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiQueueDocument\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"Declarative YAML document of queues, as produced by ExportQueues and consumed by ImportQueues.\\nswagger:model\",\n" +
+		"      \"properties\": {\n" +
+		"        \"yaml\": {\n" +
 		"          \"type\": \"string\"\n" +
-		"        },\n" +
-		"        \"podName\": {\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiQueueFairShare\": {\n" +
+		"      \"description\": \"QueueFairShare is the share of the resources of a pool a queue is entitled to and the share it's allocated.\",\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"dominantResource\": {\n" +
+		"          \"description\": \"Resource the dominant resource share is of.\",\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
-		"        \"podNamespace\": {\n" +
-		"          \"type\": \"string\"\n" +
+		"        \"dominantResourceShare\": {\n" +
+		"          \"description\": \"Largest fraction of any resource of the pool allocated to the queue.\",\n" +
+		"          \"type\": \"number\",\n" +
+		"          \"format\": \"double\"\n" +
 		"        },\n" +
-		"        \"podNumber\": {\n" +
-		"          \"type\": \"integer\",\n" +
-		"          \"format\": \"int32\"\n" +
+		"        \"fairShare\": {\n" +
+		"          \"description\": \"Fraction of the resources of the pool the queue is entitled to, i.e., its weight over the sum of the\\nweights of all active queues.\",\n" +
+		"          \"type\": \"number\",\n" +
+		"          \"format\": \"double\"\n" +
 		"        },\n" +
 		"        \"queue\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
-		"        \"reason\": {\n" +
-		"          \"type\": \"string\"\n" +
+		"        \"weight\": {\n" +
+		"          \"type\": \"number\",\n" +
+		"          \"format\": \"double\"\n" +
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
-		"    \"apiJobUnableToScheduleEvent\": {\n" +
+		"    \"apiQueueFairShareWeight\": {\n" +
+		"      \"description\": \"QueueFairShareWeight is the weight of a queue in fair-share scheduling, i.e., the inverse of its priority factor.\\nQueues are allocated resources in proportion to their weights.\",\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"properties\": {\n" +
-		"        \"clusterId\": {\n" +
-		"          \"type\": \"string\"\n" +
-		"        },\n" +
-		"        \"created\": {\n" +
-		"          \"type\": \"string\",\n" +
-		"          \"format\": \"date-time\"\n" +
-		"        },\n" +
-		"        \"jobId\": {\n" +
-		"          \"type\": \"string\"\n" +
-		"        },\n" +
-		"        \"jobSetId\": {\n" +
-		"          \"type\": \"string\"\n" +
-		"        },\n" +
-		"        \"kubernetesId\": {\n" +
-		"          \"type\": \"string\"\n" +
-		"        },\n" +
-		"        \"nodeName\": {\n" +
-		"          \"type\": \"string\"\n" +
-		"        },\n" +
-		"        \"podName\": {\n" +
-		"          \"type\": \"string\"\n" +
-		"        },\n" +
-		"        \"podNamespace\": {\n" +
-		"          \"type\": \"string\"\n" +
-		"        },\n" +
-		"        \"podNumber\": {\n" +
-		"          \"type\": \"integer\",\n" +
-		"          \"format\": \"int32\"\n" +
+		"        \"effectiveWeight\": {\n" +
+		"          \"description\": \"Weight of the queue once divided among the queues of its hierarchy, assuming all queues are active.\",\n" +
+		"          \"type\": \"number\",\n" +
+		"          \"format\": \"double\"\n" +
 		"        },\n" +
 		"        \"queue\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
-		"        \"reason\": {\n" +
-		"          \"type\": \"string\"\n" +
+		"        \"weight\": {\n" +
+		"          \"type\": \"number\",\n" +
+		"          \"format\": \"double\"\n" +
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
-		"    \"apiJobUpdatedEvent\": {\n" +
+		"    \"apiQueueImportRequest\": {\n" +
 		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
 		"      \"properties\": {\n" +
-		"        \"created\": {\n" +
-		"          \"type\": \"string\",\n" +
-		"          \"format\": \"date-time\"\n" +
-		"        },\n" +
-		"        \"job\": {\n" +
-		"          \"$ref\": \"#/definitions/apiJob\"\n" +
+		"        \"dryRun\": {\n" +
+		"          \"description\": \"If true, the changes needed for the queues to match the document are returned but not applied, e.g., to detect drift.\",\n" +
+		"          \"type\": \"boolean\"\n" +
 		"        },\n" +
-		"        \"jobId\": {\n" +
+		"        \"namePrefix\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
-		"        \"jobSetId\": {\n" +
-		"          \"type\": \"string\"\n" +
+		"        \"prune\": {\n" +
+		"          \"description\": \"If true, queues not in the document are deleted, such that the queues match the document exactly.\\nOnly queues whose names start with name_prefix are deleted, and only if they have no active job sets.\",\n" +
+		"          \"type\": \"boolean\"\n" +
 		"        },\n" +
-		"        \"queue\": {\n" +
+		"        \"yaml\": {\n" +
+		"          \"description\": \"YAML document of the desired queues, in the format produced by ExportQueues.\",\n" +
 		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiQueueImportResponse\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
+		"      \"properties\": {\n" +
+		"        \"changes\": {\n" +
+		"          \"description\": \"Ordered by queue name. Queues that already match the document are omitted.\",\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/apiQueueDiff\"\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiQueueInfo\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
+		"      \"properties\": {\n" +
+		"        \"activeJobSets\": {\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/apiJobSetInfo\"\n" +
+		"          }\n" +
+		"        },\n" +
+		"        \"leasedJobs\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int32\"\n" +
 		"        },\n" +
-		"        \"requestor\": {\n" +
-		"          \"type\": \"string\"\n" +
-		"        }\n" +
-		"      }\n" +
-		"    },\n" +
-		"    \"apiJobUtilisationEvent\": {\n" +
-		"      \"type\": \"object\",\n" +
-		"      \"properties\": {\n" +
-		"        \"MaxResourcesForPeriod\": {\n" +
+		"        \"leasedResources\": {\n" +
 		"          \"type\": \"object\",\n" +
 		"          \"additionalProperties\": {\n" +
 		"            \"$ref\": \"#/definitions/resourceQuantity\"\n" +
 		"          }\n" +
 		"        },\n" +
-		"        \"clusterId\": {\n" +
-		"          \"type\": \"string\"\n" +
-		"        },\n" +
-		"        \"created\": {\n" +
-		"          \"type\": \"string\",\n" +
-		"          \"format\": \"date-time\"\n" +
-		"        },\n" +
-		"        \"jobId\": {\n" +
-		"          \"type\": \"string\"\n" +
-		"        },\n" +
-		"        \"jobSetId\": {\n" +
-		"          \"type\": \"string\"\n" +
-		"        },\n" +
-		"        \"kubernetesId\": {\n" +
-		"          \"type\": \"string\"\n" +
-		"        },\n" +
-		"        \"nodeName\": {\n" +
-		"          \"type\": \"string\"\n" +
-		"        },\n" +
-		"        \"podName\": {\n" +
+		"        \"name\": {\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
-		"        \"podNamespace\": {\n" +
-		"          \"type\": \"string\"\n" +
+		"        \"pendingJobs\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int32\"\n" +
 		"        },\n" +
-		"        \"podNumber\": {\n" +
+		"        \"queuedJobs\": {\n" +
+		"          \"description\": \"Totals over all active job sets of the queue.\",\n" +
 		"          \"type\": \"integer\",\n" +
 		"          \"format\": \"int32\"\n" +
 		"        },\n" +
-		"        \"queue\": {\n" +
-		"          \"type\": \"string\"\n" +
+		"        \"runningJobs\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int32\"\n" +
 		"        },\n" +
-		"        \"totalCumulativeUsage\": {\n" +
-		"          \"type\": \"object\",\n" +
-		"          \"additionalProperties\": {\n" +
-		"            \"$ref\": \"#/definitions/resourceQuantity\"\n" +
-		"          }\n" +
+		"        \"suspendedJobs\": {\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int32\"\n" +
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
-		"    \"apiQueue\": {\n" +
+		"    \"apiQueueList\": {\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"title\": \"swagger:model\",\n" +
 		"      \"properties\": {\n" +
-		"        \"groupOwners\": {\n" +
+		"        \"atomic\": {\n" +
+		"          \"description\": \"If true, CreateQueues and UpdateQueues either apply to all queues or, if any of them fails, to none of them.\",\n" +
+		"          \"type\": \"boolean\"\n" +
+		"        },\n" +
+		"        \"queues\": {\n" +
 		"          \"type\": \"array\",\n" +
 		"          \"items\": {\n" +
-		"            \"type\": \"string\"\n" +
+		"            \"$ref\": \"#/definitions/apiQueue\"\n" +
 		"          }\n" +
-		"        },\n" +
-		"        \"name\": {\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiQueuePage\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
+		"      \"properties\": {\n" +
+		"        \"nextPageToken\": {\n" +
+		"          \"description\": \"Set if there are further queues; pass it as page_token to get them.\",\n" +
 		"          \"type\": \"string\"\n" +
 		"        },\n" +
-		"        \"permissions\": {\n" +
+		"        \"queues\": {\n" +
 		"          \"type\": \"array\",\n" +
 		"          \"items\": {\n" +
-		"            \"$ref\": \"#/definitions/QueuePermissions\"\n" +
+		"            \"$ref\": \"#/definitions/apiQueue\"\n" +
 		"          }\n" +
 		"        },\n" +
-		"        \"priorityFactor\": {\n" +
-		"          \"type\": \"number\",\n" +
-		"          \"format\": \"double\"\n" +
+		"        \"totalCount\": {\n" +
+		"          \"description\": \"Number of queues matching the filters across all pages. Also sent as the x-total-count header.\",\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int64\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiQueuePatchRequest\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
+		"      \"properties\": {\n" +
+		"        \"queue\": {\n" +
+		"          \"description\": \"The queue to patch, identified by its name, and the new values of the fields in update_mask.\",\n" +
+		"          \"$ref\": \"#/definitions/apiQueue\"\n" +
 		"        },\n" +
-		"        \"resourceLimits\": {\n" +
-		"          \"type\": \"object\",\n" +
-		"          \"additionalProperties\": {\n" +
-		"            \"type\": \"number\",\n" +
-		"            \"format\": \"double\"\n" +
-		"          }\n" +
+		"        \"revision\": {\n" +
+		"          \"description\": \"If non-zero, the patch is rejected if the queue has been changed since this revision.\",\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"uint64\"\n" +
 		"        },\n" +
-		"        \"userOwners\": {\n" +
-		"          \"type\": \"array\",\n" +
-		"          \"items\": {\n" +
-		"            \"type\": \"string\"\n" +
-		"          }\n" +
+		"        \"updateMask\": {\n" +
+		"          \"description\": \"Fields of queue to update, e.g., \\\"permissions\\\" or \\\"priority_factor\\\". All other fields keep their current values.\",\n" +
+		"          \"$ref\": \"#/definitions/protobufFieldMask\"\n" +
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
-		"    \"apiQueueCreateResponse\": {\n" +
+		"    \"apiQueueRestoreRequest\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"swagger:model\",\n" +
+		"      \"properties\": {\n" +
+		"        \"name\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiQueueUpdateResponse\": {\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"properties\": {\n" +
 		"        \"error\": {\n" +
@@ -1706,41 +5033,81 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
-		"    \"apiQueueInfo\": {\n" +
+		"    \"apiResourceBudget\": {\n" +
+		"      \"description\": \"Limit on the resource-hours used by the jobs of a queue, or of each of its owners, over a rolling time window.\\nE.g., a budget of 100 hours of resource \\\"nvidia.com/gpu\\\" over 24 hours allows running 10 jobs with one GPU each for 10 hours per day.\",\n" +
 		"      \"type\": \"object\",\n" +
-		"      \"title\": \"swagger:model\",\n" +
 		"      \"properties\": {\n" +
-		"        \"activeJobSets\": {\n" +
-		"          \"type\": \"array\",\n" +
-		"          \"items\": {\n" +
-		"            \"$ref\": \"#/definitions/apiJobSetInfo\"\n" +
-		"          }\n" +
+		"        \"action\": {\n" +
+		"          \"$ref\": \"#/definitions/ResourceBudgetAction\"\n" +
 		"        },\n" +
-		"        \"name\": {\n" +
+		"        \"deprioritizedPriority\": {\n" +
+		"          \"description\": \"Minimum priority of jobs deprioritized by the budget. Since jobs with a greater priority value are scheduled later,\\nthis should be greater than the priorities jobs are usually submitted with. Must be positive for action DEPRIORITIZE.\",\n" +
+		"          \"type\": \"number\",\n" +
+		"          \"format\": \"double\"\n" +
+		"        },\n" +
+		"        \"hours\": {\n" +
+		"          \"description\": \"Number of hours of one unit of the resource, e.g., of one CPU core, that may be used within the window.\",\n" +
+		"          \"type\": \"number\",\n" +
+		"          \"format\": \"double\"\n" +
+		"        },\n" +
+		"        \"perOwner\": {\n" +
+		"          \"description\": \"If true, the budget applies to each owner, i.e., submitting user, of jobs of the queue separately.\\nOtherwise, it applies to all jobs of the queue together.\",\n" +
+		"          \"type\": \"boolean\"\n" +
+		"        },\n" +
+		"        \"resource\": {\n" +
+		"          \"description\": \"Name of the budgeted resource, e.g., \\\"cpu\\\" or \\\"nvidia.com/gpu\\\".\",\n" +
 		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"windowHours\": {\n" +
+		"          \"description\": \"Length in hours of the rolling window over which usage is accounted. Must be positive.\",\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int64\"\n" +
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
-		"    \"apiQueueList\": {\n" +
+		"    \"apiResourceBudgetStatus\": {\n" +
 		"      \"type\": \"object\",\n" +
-		"      \"title\": \"swagger:model\",\n" +
 		"      \"properties\": {\n" +
-		"        \"queues\": {\n" +
-		"          \"type\": \"array\",\n" +
-		"          \"items\": {\n" +
-		"            \"$ref\": \"#/definitions/apiQueue\"\n" +
-		"          }\n" +
+		"        \"budget\": {\n" +
+		"          \"$ref\": \"#/definitions/apiResourceBudget\"\n" +
+		"        },\n" +
+		"        \"owner\": {\n" +
+		"          \"description\": \"Owner the usage is accounted for, if the budget applies to each owner separately.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"remainingHours\": {\n" +
+		"          \"description\": \"Resource-hours left within the window. 0 if the budget is exhausted.\",\n" +
+		"          \"type\": \"number\",\n" +
+		"          \"format\": \"double\"\n" +
+		"        },\n" +
+		"        \"usedHours\": {\n" +
+		"          \"description\": \"Resource-hours used within the window of the budget, including by runs that haven't finished.\",\n" +
+		"          \"type\": \"number\",\n" +
+		"          \"format\": \"double\"\n" +
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
-		"    \"apiQueueUpdateResponse\": {\n" +
+		"    \"apiRetryPolicy\": {\n" +
+		"      \"description\": \"Each retry of a job is a new run of the same job. Failed events of runs that are retried have will_retry set,\\nand the queued event reported when the job is returned to the queue has the number of the new attempt.\",\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"properties\": {\n" +
-		"        \"error\": {\n" +
-		"          \"type\": \"string\"\n" +
+		"        \"backoffSeconds\": {\n" +
+		"          \"description\": \"Time to wait after a run failed before the job may be scheduled again.\",\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"format\": \"int64\"\n" +
 		"        },\n" +
-		"        \"queue\": {\n" +
-		"          \"$ref\": \"#/definitions/apiQueue\"\n" +
+		"        \"maxAttempts\": {\n" +
+		"          \"description\": \"Maximum number of runs of the job, including the first. Must be positive.\",\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int64\"\n" +
+		"        },\n" +
+		"        \"retryOnExitCodes\": {\n" +
+		"          \"description\": \"If provided, failed runs are only retried if a container exited with one of these exit codes.\",\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"integer\",\n" +
+		"            \"format\": \"int32\"\n" +
+		"          }\n" +
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
@@ -1767,6 +5134,19 @@ func SwaggerJsonTemplate() string {
 		"        \"Headless\"\n" +
 		"      ]\n" +
 		"    },\n" +
+		"    \"apiSetFairShareWeightRequest\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"weight\": {\n" +
+		"          \"description\": \"New weight of the queue, in (0, 1]. Sets the priority factor of the queue to 1/weight.\",\n" +
+		"          \"type\": \"number\",\n" +
+		"          \"format\": \"double\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
 		"    \"apiStreamingQueueMessage\": {\n" +
 		"      \"type\": \"object\",\n" +
 		"      \"properties\": {\n" +
@@ -1778,6 +5158,113 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
+		"    \"apiSubmissionWindow\": {\n" +
+		"      \"description\": \"A recurring period of time, e.g., from 6pm for 14 hours on weekdays.\",\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"durationMinutes\": {\n" +
+		"          \"description\": \"Number of minutes the window lasts from each start time. Must be positive.\",\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int64\"\n" +
+		"        },\n" +
+		"        \"start\": {\n" +
+		"          \"description\": \"Cron expression, e.g., \\\"0 18 * * 1-5\\\", giving the times at which the window starts.\\nMade up of the fields minute, hour, day of month, month, and day of week.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"timeZone\": {\n" +
+		"          \"description\": \"IANA time zone, e.g., \\\"Europe/London\\\", in which start is interpreted. Defaults to UTC.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiSubmissionWindowPolicy\": {\n" +
+		"      \"description\": \"Periods during which jobs may be submitted to a queue.\",\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"holdOutsideWindows\": {\n" +
+		"          \"description\": \"If true, jobs submitted outside of all windows are accepted but held, i.e., not scheduled,\\nuntil the next window starts. Otherwise, such submissions are rejected.\",\n" +
+		"          \"type\": \"boolean\"\n" +
+		"        },\n" +
+		"        \"windows\": {\n" +
+		"          \"description\": \"Recurring periods during which submissions are allowed. If empty, submissions are allowed at any time.\",\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/apiSubmissionWindow\"\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiUnschedulableReason\": {\n" +
+		"      \"description\": \"Explains why a job can't be scheduled on the node types of a cluster.\",\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"code\": {\n" +
+		"          \"$ref\": \"#/definitions/apiUnschedulableReasonCode\"\n" +
+		"        },\n" +
+		"        \"message\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"nodeTypes\": {\n" +
+		"          \"description\": \"Number of node types of the cluster excluded for this reason.\",\n" +
+		"          \"type\": \"integer\",\n" +
+		"          \"format\": \"int32\"\n" +
+		"        },\n" +
+		"        \"resource\": {\n" +
+		"          \"description\": \"Resource the reason relates to, e.g., \\\"nvidia.com/gpu\\\", if any.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiUnschedulableReasonCode\": {\n" +
+		"      \"description\": \" - INSUFFICIENT_RESOURCE: Node types have less of a resource than the job requests, or none of it, e.g., no GPUs.\\n - UNTOLERATED_TAINT: Node types have a taint not tolerated by the job.\\n - NODE_SELECTOR_MISMATCH: The node selector or required node affinity of the job doesn't match the labels of node types.\\n - BELOW_MINIMUM_JOB_SIZE: The job requests less of a resource than the minimum job size of the cluster.\\n - EXCEEDS_MAX_JOB_SIZE: The job requests more of a resource than the largest node type of the cluster has.\\n - NO_NODE_TYPES: The cluster reports no node types.\",\n" +
+		"      \"type\": \"string\",\n" +
+		"      \"default\": \"UNSPECIFIED\",\n" +
+		"      \"enum\": [\n" +
+		"        \"UNSPECIFIED\",\n" +
+		"        \"INSUFFICIENT_RESOURCE\",\n" +
+		"        \"UNTOLERATED_TAINT\",\n" +
+		"        \"NODE_SELECTOR_MISMATCH\",\n" +
+		"        \"BELOW_MINIMUM_JOB_SIZE\",\n" +
+		"        \"EXCEEDS_MAX_JOB_SIZE\",\n" +
+		"        \"NO_NODE_TYPES\"\n" +
+		"      ]\n" +
+		"    },\n" +
+		"    \"apiUsageRecord\": {\n" +
+		"      \"description\": \"UsageRecord is the usage of one resource by the jobs of one owner of a queue on one day.\",\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"date\": {\n" +
+		"          \"description\": \"Day of the usage, as YYYY-MM-DD in UTC.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"owner\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"queue\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"resource\": {\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"resourceSeconds\": {\n" +
+		"          \"description\": \"Units of the resource requested by running jobs times the seconds they ran for, e.g., CPU-seconds for cpu.\",\n" +
+		"          \"type\": \"number\",\n" +
+		"          \"format\": \"double\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
+		"    \"apiUsageReport\": {\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"records\": {\n" +
+		"          \"description\": \"Records ordered by date, queue, owner, and resource.\",\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"$ref\": \"#/definitions/apiUsageRecord\"\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
 		"    \"intstrIntOrString\": {\n" +
 		"      \"description\": \"+protobuf=true\\n+protobuf.options.(gogoproto.goproto_stringer)=false\\n+k8s:openapi-gen=true\",\n" +
 		"      \"type\": \"object\",\n" +
@@ -1814,6 +5301,20 @@ func SwaggerJsonTemplate() string {
 		"        }\n" +
 		"      }\n" +
 		"    },\n" +
+		"    \"protobufFieldMask\": {\n" +
+		"      \"description\": \"paths: \\\"f.a\\\"\\n    paths: \\\"f.b.d\\\"\\n\\nHere `f` represents a field in some root message, `a` and `b`\\nfields in the message found in `f`, and `d` a field found in the\\nmessage in `f.b`.\\n\\nField masks are used to specify a subset of fields that should be\\nreturned by a get operation or modified by an update operation.\\nField masks also have a custom JSON encoding (see below).\\n\\n# Field Masks in Projections\\n\\nWhen used in the context of a projection, a response message or\\nsub-message is filtered by the API to only contain those fields as\\nspecified in the mask. For example, if the mask in the previous\\nexample is applied to a response message as follows:\\n\\n    f {\\n      a : 22\\n      b {\\n        d : 1\\n        x : 2\\n      }\\n      y : 13\\n    }\\n    z: 8\\n\\nThe result will not contain specific values for fields x,y and z\\n(their value will be set to the default, and omitted in proto text\\noutput):\\n\\n\\n    f {\\n      a : 22\\n      b {\\n        d : 1\\n      }\\n    }\\n\\nA repeated field is not allowed except at the last position of a\\npaths string.\\n\\nIf a FieldMask object is not present in a get operation, the\\noperation applies to all fields (as if a FieldMask of all fields\\nhad been specified).\\n\\nNote that a field mask does not necessarily apply to the\\ntop-level response message. In case of a REST get operation, the\\nfield mask applies directly to the response, but in case of a REST\\nlist operation, the mask instead applies to each individual message\\nin the returned resource list. In case of a REST custom method,\\nother definitions may be used. Where the mask applies will be\\nclearly documented together with its declaration in the API.  In\\nany case, the effect on the returned resource/resources is required\\nbehavior for APIs.\\n\\n# Field Masks in Update Operations\\n\\nA field mask in update operations specifies which fields of the\\ntargeted resource are going to be updated. The API is required\\nto only change the values of the fields as specified in the mask\\nand leave the others untouched. If a resource is passed in to\\ndescribe the updated values, the API ignores the values of all\\nfields not covered by the mask.\\n\\nIf a repeated field is specified for an update operation, new values will\\nbe appended to the existing repeated field in the target resource. Note that\\na repeated field is only allowed in the last position of a `paths` string.\\n\\nIf a sub-message is specified in the last position of the field mask for an\\nupdate operation, then new value will be merged into the existing sub-message\\nin the target resource.\\n\\nFor example, given the target message:\\n\\n    f {\\n      b {\\n        d: 1\\n        x: 2\\n      }\\n      c: [1]\\n    }\\n\\nAnd an update message:\\n\\n    f {\\n      b {\\n        d: 10\\n      }\\n      c: [2]\\n    }\\n\\nthen if the field mask is:\\n\\n paths: [\\\"f.b\\\", \\\"f.c\\\"]\\n\\nthen the result will be:\\n\\n    f {\\n      b {\\n        d: 10\\n        x: 2\\n      }\\n      c: [1, 2]\\n    }\\n\\nAn implementation may provide options to override this default behavior for\\nrepeated and message fields.\\n\\nIn order to reset a field's value to the default, the field must\\nbe in the mask and set to the default value in the provided resource.\\nHence, in order to reset all fields of a resource, provide a default\\ninstance of the resource and set all fields in the mask, or do\\nnot provide a mask as described below.\\n\\nIf a field mask is not present on update, the operation applies to\\nall fields (as if a field mask of all fields has been specified).\\nNote that in the presence of schema evolution, this may mean that\\nfields the client does not know and has therefore not filled into\\nthe request will be reset to their default. If this is unwanted\\nbehavior, a specific service may require a client to always specify\\na field mask, producing an error if not.\\n\\nAs with get operations, the location of the resource which\\ndescribes the updated values in the request message depends on the\\noperation kind. In any case, the effect of the field mask is\\nrequired to be honored by the API.\\n\\n## Considerations for HTTP REST\\n\\nThe HTTP kind of an update operation which uses a field mask must\\nbe set to PATCH instead of PUT in order to satisfy HTTP semantics\\n(PUT must only be used for full updates).\\n\\n# JSON Encoding of Field Masks\\n\\nIn JSON, a field mask is encoded as a single string where paths are\\nseparated by a comma. Fields name in each path are converted\\nto/from lower-camel naming conventions.\\n\\nAs an example, consider the following message declarations:\\n\\n    message Profile {\\n      User user = 1;\\n      Photo photo = 2;\\n    }\\n    message User {\\n      string display_name = 1;\\n      string address = 2;\\n    }\\n\\nIn proto a field mask for `Profile` may look as such:\\n\\n    mask {\\n      paths: \\\"user.display_name\\\"\\n      paths: \\\"photo\\\"\\n    }\\n\\nIn JSON, the same mask is represented as below:\\n\\n    {\\n      mask: \\\"user.displayName,photo\\\"\\n    }\\n\\n# Field Masks and Oneof Fields\\n\\nField masks treat fields in oneofs just as regular fields. Consider the\\nfollowing message:\\n\\n    message SampleMessage {\\n      oneof test_oneof {\\n        string name = 4;\\n        SubMessage sub_message = 9;\\n      }\\n    }\\n\\nThe field mask can be:\\n\\n    mask {\\n      paths: \\\"name\\\"\\n    }\\n\\nOr:\\n\\n    mask {\\n      paths: \\\"sub_message\\\"\\n    }\\n\\nNote that oneof type names (\\\"test_oneof\\\" in this case) cannot be used in\\npaths.\\n\\n## Field Mask Verification\\n\\nThe implementation of any API method which has a FieldMask type field in the\\nrequest should verify the included field paths, and return an\\n`INVALID_ARGUMENT` error if any path is duplicated or unmappable.\",\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"title\": \"`FieldMask` represents a set of symbolic field paths, for example:\",\n" +
+		"      \"properties\": {\n" +
+		"        \"paths\": {\n" +
+		"          \"description\": \"The set of field mask paths.\",\n" +
+		"          \"type\": \"array\",\n" +
+		"          \"items\": {\n" +
+		"            \"type\": \"string\"\n" +
+		"          }\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
 		"    \"resourceQuantity\": {\n" +
 		"      \"description\": \"The serialization format is:\\n\\n\\u003cquantity\\u003e        ::= \\u003csignedNumber\\u003e\\u003csuffix\\u003e\\n(Note that \\u003csuffix\\u003e may be empty, from the \\\"\\\" case in \\u003cdecimalSI\\u003e.)\\n\\u003cdigit\\u003e           ::= 0 | 1 | ... | 9\\n\\u003cdigits\\u003e          ::= \\u003cdigit\\u003e | \\u003cdigit\\u003e\\u003cdigits\\u003e\\n\\u003cnumber\\u003e          ::= \\u003cdigits\\u003e | \\u003cdigits\\u003e.\\u003cdigits\\u003e | \\u003cdigits\\u003e. | .\\u003cdigits\\u003e\\n\\u003csign\\u003e            ::= \\\"+\\\" | \\\"-\\\"\\n\\u003csignedNumber\\u003e    ::= \\u003cnumber\\u003e | \\u003csign\\u003e\\u003cnumber\\u003e\\n\\u003csuffix\\u003e          ::= \\u003cbinarySI\\u003e | \\u003cdecimalExponent\\u003e | \\u003cdecimalSI\\u003e\\n\\u003cbinarySI\\u003e        ::= Ki | Mi | Gi | Ti | Pi | Ei\\n(International System of units; See: http://physics.nist.gov/cuu/Units/binary.html)\\n\\u003cdecimalSI\\u003e       ::= m | \\\"\\\" | k | M | G | T | P | E\\n(Note that 1024 = 1Ki but 1000 = 1k; I didn't choose the capitalization.)\\n\\u003cdecimalExponent\\u003e ::= \\\"e\\\" \\u003csignedNumber\\u003e | \\\"E\\\" \\u003csignedNumber\\u003e\\n\\nNo matter which of the three exponent forms is used, no quantity may represent\\na number greater than 2^63-1 in magnitude, nor may it have more than 3 decimal\\nplaces. Numbers larger or more precise will be capped or rounded up.\\n(E.g.: 0.1m will rounded up to 1m.)\\nThis may be extended in the future if we require larger or smaller quantities.\\n\\nWhen a Quantity is parsed from a string, it will remember the type of suffix\\nit had, and will use the same type again when it is serialized.\\n\\nBefore serializing, Quantity will be put in \\\"canonical form\\\".\\nThis means that Exponent/suffix will be adjusted up or down (with a\\ncorresponding increase or decrease in Mantissa) such that:\\na. No precision is lost\\nb. No fractional digits will be emitted\\nc. The exponent (or suffix) is as large as possible.\\nThe sign will be omitted unless the number is negative.\\n\\nExamples:\\n1.5 will be serialized as \\\"1500m\\\"\\n1.5Gi will be serialized as \\\"1536Mi\\\"\\n\\nNote that the quantity will NEVER be internally represented by a\\nfloating point number. That is the whole point of this exercise.\\n\\nNon-canonical values will still parse as long as they are well formed,\\nbut will be re-emitted in their canonical form. (So always use canonical\\nform, or don't diff.)\\n\\nThis format is intended to make it difficult to use these numbers without\\nwriting some sort of special handling code in the hopes that that will\\ncause implementors to also use a fixed point implementation.\\n\\n+protobuf=true\\n+protobuf.embed=string\\n+protobuf.options.marshal=false\\n+protobuf.options.(gogoproto.goproto_stringer)=false\\n+k8s:deepcopy-gen=true\\n+k8s:openapi-gen=true\",\n" +
 		"      \"type\": \"string\",\n" +
@@ -5032,6 +8533,28 @@ func SwaggerJsonTemplate() string {
 		"      },\n" +
 		"      \"x-go-package\": \"k8s.io/api/core/v1\"\n" +
 		"    },\n" +
+		"    \"v1Taint\": {\n" +
+		"      \"description\": \"The node this Taint is attached to has the \\\"effect\\\" on\\nany pod that does not tolerate the Taint.\",\n" +
+		"      \"type\": \"object\",\n" +
+		"      \"properties\": {\n" +
+		"        \"effect\": {\n" +
+		"          \"description\": \"Required. The effect of the taint on pods\\nthat do not tolerate the taint.\\nValid effects are NoSchedule, PreferNoSchedule and NoExecute.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"key\": {\n" +
+		"          \"description\": \"Required. The taint key to be applied to a node.\",\n" +
+		"          \"type\": \"string\"\n" +
+		"        },\n" +
+		"        \"timeAdded\": {\n" +
+		"          \"title\": \"TimeAdded represents the time at which the taint was added.\\nIt is only written for NoExecute taints.\\n+optional\",\n" +
+		"          \"$ref\": \"#/definitions/v1Time\"\n" +
+		"        },\n" +
+		"        \"value\": {\n" +
+		"          \"type\": \"string\",\n" +
+		"          \"title\": \"The taint value corresponding to the taint key.\\n+optional\"\n" +
+		"        }\n" +
+		"      }\n" +
+		"    },\n" +
 		"    \"v1TaintEffect\": {\n" +
 		"      \"type\": \"string\",\n" +
 		"      \"x-go-package\": \"k8s.io/api/core/v1\"\n" +
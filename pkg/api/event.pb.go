@@ -23,6 +23,7 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	v1 "k8s.io/api/core/v1"
 	resource "k8s.io/apimachinery/pkg/api/resource"
 )
 
@@ -149,6 +150,8 @@ type JobQueuedEvent struct {
 	JobSetId string    `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
 	Queue    string    `protobuf:"bytes,3,opt,name=queue,proto3" json:"queue,omitempty"`
 	Created  time.Time `protobuf:"bytes,4,opt,name=created,proto3,stdtime" json:"created"`
+	// If the job was returned to the queue to retry a failed run, the number of the new attempt, starting at 2.
+	Attempt uint32 `protobuf:"varint,5,opt,name=attempt,proto3" json:"attempt,omitempty"`
 }
 
 func (m *JobQueuedEvent) Reset()      { *m = JobQueuedEvent{} }
@@ -211,6 +214,13 @@ func (m *JobQueuedEvent) GetCreated() time.Time {
 	return time.Time{}
 }
 
+func (m *JobQueuedEvent) GetAttempt() uint32 {
+	if m != nil {
+		return m.Attempt
+	}
+	return 0
+}
+
 type JobDuplicateFoundEvent struct {
 	JobId         string    `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
 	JobSetId      string    `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
@@ -292,6 +302,8 @@ type JobLeasedEvent struct {
 	Queue     string    `protobuf:"bytes,3,opt,name=queue,proto3" json:"queue,omitempty"`
 	Created   time.Time `protobuf:"bytes,4,opt,name=created,proto3,stdtime" json:"created"`
 	ClusterId string    `protobuf:"bytes,5,opt,name=cluster_id,json=clusterId,proto3" json:"clusterId,omitempty"`
+	// Pool of the cluster the job was leased to.
+	Pool string `protobuf:"bytes,6,opt,name=pool,proto3" json:"pool,omitempty"`
 }
 
 func (m *JobLeasedEvent) Reset()      { *m = JobLeasedEvent{} }
@@ -361,6 +373,13 @@ func (m *JobLeasedEvent) GetClusterId() string {
 	return ""
 }
 
+func (m *JobLeasedEvent) GetPool() string {
+	if m != nil {
+		return m.Pool
+	}
+	return ""
+}
+
 type JobLeaseReturnedEvent struct {
 	JobId        string    `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
 	JobSetId     string    `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
@@ -653,6 +672,10 @@ type JobRunningEvent struct {
 	PodNumber    int32     `protobuf:"varint,8,opt,name=pod_number,json=podNumber,proto3" json:"podNumber,omitempty"`
 	PodName      string    `protobuf:"bytes,9,opt,name=pod_name,json=podName,proto3" json:"podName,omitempty"`
 	PodNamespace string    `protobuf:"bytes,10,opt,name=pod_namespace,json=podNamespace,proto3" json:"podNamespace,omitempty"`
+	// Pool of the cluster the job is running in.
+	Pool string `protobuf:"bytes,11,opt,name=pool,proto3" json:"pool,omitempty"`
+	// Labels of the node the job is running on. Only labels tracked by the executor are included.
+	NodeLabels map[string]string `protobuf:"bytes,12,rep,name=node_labels,json=nodeLabels,proto3" json:"nodeLabels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (m *JobRunningEvent) Reset()      { *m = JobRunningEvent{} }
@@ -757,6 +780,20 @@ func (m *JobRunningEvent) GetPodNamespace() string {
 	return ""
 }
 
+func (m *JobRunningEvent) GetPool() string {
+	if m != nil {
+		return m.Pool
+	}
+	return ""
+}
+
+func (m *JobRunningEvent) GetNodeLabels() map[string]string {
+	if m != nil {
+		return m.NodeLabels
+	}
+	return nil
+}
+
 type JobIngressInfoEvent struct {
 	JobId            string           `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
 	JobSetId         string           `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
@@ -1018,6 +1055,10 @@ type JobFailedEvent struct {
 	PodNamespace      string             `protobuf:"bytes,14,opt,name=pod_namespace,json=podNamespace,proto3" json:"podNamespace,omitempty"`
 	ContainerStatuses []*ContainerStatus `protobuf:"bytes,11,rep,name=container_statuses,json=containerStatuses,proto3" json:"containerStatuses,omitempty"`
 	Cause             Cause              `protobuf:"varint,12,opt,name=cause,proto3,enum=api.Cause" json:"cause,omitempty"`
+	// True if the failed run is retried as per the retry policy of the job, in which case the job isn't finished.
+	WillRetry bool `protobuf:"varint,15,opt,name=will_retry,json=willRetry,proto3" json:"willRetry,omitempty"`
+	// Number of the failed attempt, starting at 1. Only set for jobs with a retry policy.
+	Attempt uint32 `protobuf:"varint,16,opt,name=attempt,proto3" json:"attempt,omitempty"`
 }
 
 func (m *JobFailedEvent) Reset()      { *m = JobFailedEvent{} }
@@ -1151,28 +1192,40 @@ func (m *JobFailedEvent) GetCause() Cause {
 	return Cause_Error
 }
 
-type JobPreemptedEvent struct {
-	JobId           string    `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
-	JobSetId        string    `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
-	Queue           string    `protobuf:"bytes,3,opt,name=queue,proto3" json:"queue,omitempty"`
-	Created         time.Time `protobuf:"bytes,4,opt,name=created,proto3,stdtime" json:"created"`
-	ClusterId       string    `protobuf:"bytes,5,opt,name=cluster_id,json=clusterId,proto3" json:"clusterId,omitempty"`
-	RunId           string    `protobuf:"bytes,6,opt,name=run_id,json=runId,proto3" json:"runId,omitempty"`
-	PreemptiveJobId string    `protobuf:"bytes,7,opt,name=preemptive_job_id,json=preemptiveJobId,proto3" json:"preemptiveJobId,omitempty"`
-	PreemptiveRunId string    `protobuf:"bytes,8,opt,name=preemptive_run_id,json=preemptiveRunId,proto3" json:"preemptiveRunId,omitempty"`
+func (m *JobFailedEvent) GetWillRetry() bool {
+	if m != nil {
+		return m.WillRetry
+	}
+	return false
 }
 
-func (m *JobPreemptedEvent) Reset()      { *m = JobPreemptedEvent{} }
-func (*JobPreemptedEvent) ProtoMessage() {}
-func (*JobPreemptedEvent) Descriptor() ([]byte, []int) {
+func (m *JobFailedEvent) GetAttempt() uint32 {
+	if m != nil {
+		return m.Attempt
+	}
+	return 0
+}
+
+// Indicates that a job was cancelled because its job set didn't complete within its TTL.
+type JobSetExpiredEvent struct {
+	JobId            string    `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+	JobSetId         string    `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
+	Queue            string    `protobuf:"bytes,3,opt,name=queue,proto3" json:"queue,omitempty"`
+	Created          time.Time `protobuf:"bytes,4,opt,name=created,proto3,stdtime" json:"created"`
+	JobSetTtlSeconds int64     `protobuf:"varint,5,opt,name=job_set_ttl_seconds,json=jobSetTtlSeconds,proto3" json:"jobSetTtlSeconds,omitempty"`
+}
+
+func (m *JobSetExpiredEvent) Reset()      { *m = JobSetExpiredEvent{} }
+func (*JobSetExpiredEvent) ProtoMessage() {}
+func (*JobSetExpiredEvent) Descriptor() ([]byte, []int) {
 	return fileDescriptor_7758595c3bb8cf56, []int{11}
 }
-func (m *JobPreemptedEvent) XXX_Unmarshal(b []byte) error {
+func (m *JobSetExpiredEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *JobPreemptedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *JobSetExpiredEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_JobPreemptedEvent.Marshal(b, m, deterministic)
+		return xxx_messageInfo_JobSetExpiredEvent.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1182,90 +1235,73 @@ func (m *JobPreemptedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *JobPreemptedEvent) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_JobPreemptedEvent.Merge(m, src)
+func (m *JobSetExpiredEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobSetExpiredEvent.Merge(m, src)
 }
-func (m *JobPreemptedEvent) XXX_Size() int {
+func (m *JobSetExpiredEvent) XXX_Size() int {
 	return m.Size()
 }
-func (m *JobPreemptedEvent) XXX_DiscardUnknown() {
-	xxx_messageInfo_JobPreemptedEvent.DiscardUnknown(m)
+func (m *JobSetExpiredEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobSetExpiredEvent.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_JobPreemptedEvent proto.InternalMessageInfo
+var xxx_messageInfo_JobSetExpiredEvent proto.InternalMessageInfo
 
-func (m *JobPreemptedEvent) GetJobId() string {
+func (m *JobSetExpiredEvent) GetJobId() string {
 	if m != nil {
 		return m.JobId
 	}
 	return ""
 }
 
-func (m *JobPreemptedEvent) GetJobSetId() string {
+func (m *JobSetExpiredEvent) GetJobSetId() string {
 	if m != nil {
 		return m.JobSetId
 	}
 	return ""
 }
 
-func (m *JobPreemptedEvent) GetQueue() string {
+func (m *JobSetExpiredEvent) GetQueue() string {
 	if m != nil {
 		return m.Queue
 	}
 	return ""
 }
 
-func (m *JobPreemptedEvent) GetCreated() time.Time {
+func (m *JobSetExpiredEvent) GetCreated() time.Time {
 	if m != nil {
 		return m.Created
 	}
 	return time.Time{}
 }
 
-func (m *JobPreemptedEvent) GetClusterId() string {
-	if m != nil {
-		return m.ClusterId
-	}
-	return ""
-}
-
-func (m *JobPreemptedEvent) GetRunId() string {
-	if m != nil {
-		return m.RunId
-	}
-	return ""
-}
-
-func (m *JobPreemptedEvent) GetPreemptiveJobId() string {
-	if m != nil {
-		return m.PreemptiveJobId
-	}
-	return ""
-}
-
-func (m *JobPreemptedEvent) GetPreemptiveRunId() string {
+func (m *JobSetExpiredEvent) GetJobSetTtlSeconds() int64 {
 	if m != nil {
-		return m.PreemptiveRunId
+		return m.JobSetTtlSeconds
 	}
-	return ""
+	return 0
 }
 
-// Only used internally by Armada
-type JobFailedEventCompressed struct {
-	Event []byte `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+// Indicates that a queued job was suspended because its job set was paused.
+type JobSuspendedEvent struct {
+	JobId     string    `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+	JobSetId  string    `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
+	Queue     string    `protobuf:"bytes,3,opt,name=queue,proto3" json:"queue,omitempty"`
+	Created   time.Time `protobuf:"bytes,4,opt,name=created,proto3,stdtime" json:"created"`
+	Requestor string    `protobuf:"bytes,5,opt,name=requestor,proto3" json:"requestor,omitempty"`
 }
 
-func (m *JobFailedEventCompressed) Reset()      { *m = JobFailedEventCompressed{} }
-func (*JobFailedEventCompressed) ProtoMessage() {}
-func (*JobFailedEventCompressed) Descriptor() ([]byte, []int) {
+func (m *JobSuspendedEvent) Reset()      { *m = JobSuspendedEvent{} }
+func (*JobSuspendedEvent) ProtoMessage() {}
+func (*JobSuspendedEvent) Descriptor() ([]byte, []int) {
 	return fileDescriptor_7758595c3bb8cf56, []int{12}
 }
-func (m *JobFailedEventCompressed) XXX_Unmarshal(b []byte) error {
+func (m *JobSuspendedEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *JobFailedEventCompressed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *JobSuspendedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_JobFailedEventCompressed.Marshal(b, m, deterministic)
+		return xxx_messageInfo_JobSuspendedEvent.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1275,49 +1311,73 @@ func (m *JobFailedEventCompressed) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *JobFailedEventCompressed) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_JobFailedEventCompressed.Merge(m, src)
+func (m *JobSuspendedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobSuspendedEvent.Merge(m, src)
 }
-func (m *JobFailedEventCompressed) XXX_Size() int {
+func (m *JobSuspendedEvent) XXX_Size() int {
 	return m.Size()
 }
-func (m *JobFailedEventCompressed) XXX_DiscardUnknown() {
-	xxx_messageInfo_JobFailedEventCompressed.DiscardUnknown(m)
+func (m *JobSuspendedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobSuspendedEvent.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_JobFailedEventCompressed proto.InternalMessageInfo
+var xxx_messageInfo_JobSuspendedEvent proto.InternalMessageInfo
 
-func (m *JobFailedEventCompressed) GetEvent() []byte {
+func (m *JobSuspendedEvent) GetJobId() string {
 	if m != nil {
-		return m.Event
+		return m.JobId
 	}
-	return nil
+	return ""
 }
 
-type JobSucceededEvent struct {
-	JobId        string    `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
-	JobSetId     string    `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
-	Queue        string    `protobuf:"bytes,3,opt,name=queue,proto3" json:"queue,omitempty"`
-	Created      time.Time `protobuf:"bytes,4,opt,name=created,proto3,stdtime" json:"created"`
-	ClusterId    string    `protobuf:"bytes,5,opt,name=cluster_id,json=clusterId,proto3" json:"clusterId,omitempty"`
-	KubernetesId string    `protobuf:"bytes,6,opt,name=kubernetes_id,json=kubernetesId,proto3" json:"kubernetesId,omitempty"`
-	NodeName     string    `protobuf:"bytes,7,opt,name=node_name,json=nodeName,proto3" json:"nodeName,omitempty"`
-	PodNumber    int32     `protobuf:"varint,8,opt,name=pod_number,json=podNumber,proto3" json:"podNumber,omitempty"`
-	PodName      string    `protobuf:"bytes,9,opt,name=pod_name,json=podName,proto3" json:"podName,omitempty"`
-	PodNamespace string    `protobuf:"bytes,10,opt,name=pod_namespace,json=podNamespace,proto3" json:"podNamespace,omitempty"`
+func (m *JobSuspendedEvent) GetJobSetId() string {
+	if m != nil {
+		return m.JobSetId
+	}
+	return ""
 }
 
-func (m *JobSucceededEvent) Reset()      { *m = JobSucceededEvent{} }
-func (*JobSucceededEvent) ProtoMessage() {}
-func (*JobSucceededEvent) Descriptor() ([]byte, []int) {
+func (m *JobSuspendedEvent) GetQueue() string {
+	if m != nil {
+		return m.Queue
+	}
+	return ""
+}
+
+func (m *JobSuspendedEvent) GetCreated() time.Time {
+	if m != nil {
+		return m.Created
+	}
+	return time.Time{}
+}
+
+func (m *JobSuspendedEvent) GetRequestor() string {
+	if m != nil {
+		return m.Requestor
+	}
+	return ""
+}
+
+// Indicates that a suspended job was returned to the queue because its job set was resumed.
+type JobResumedEvent struct {
+	JobId     string    `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+	JobSetId  string    `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
+	Queue     string    `protobuf:"bytes,3,opt,name=queue,proto3" json:"queue,omitempty"`
+	Created   time.Time `protobuf:"bytes,4,opt,name=created,proto3,stdtime" json:"created"`
+	Requestor string    `protobuf:"bytes,5,opt,name=requestor,proto3" json:"requestor,omitempty"`
+}
+
+func (m *JobResumedEvent) Reset()      { *m = JobResumedEvent{} }
+func (*JobResumedEvent) ProtoMessage() {}
+func (*JobResumedEvent) Descriptor() ([]byte, []int) {
 	return fileDescriptor_7758595c3bb8cf56, []int{13}
 }
-func (m *JobSucceededEvent) XXX_Unmarshal(b []byte) error {
+func (m *JobResumedEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *JobSucceededEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *JobResumedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_JobSucceededEvent.Marshal(b, m, deterministic)
+		return xxx_messageInfo_JobResumedEvent.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1327,114 +1387,151 @@ func (m *JobSucceededEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *JobSucceededEvent) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_JobSucceededEvent.Merge(m, src)
+func (m *JobResumedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobResumedEvent.Merge(m, src)
 }
-func (m *JobSucceededEvent) XXX_Size() int {
+func (m *JobResumedEvent) XXX_Size() int {
 	return m.Size()
 }
-func (m *JobSucceededEvent) XXX_DiscardUnknown() {
-	xxx_messageInfo_JobSucceededEvent.DiscardUnknown(m)
+func (m *JobResumedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobResumedEvent.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_JobSucceededEvent proto.InternalMessageInfo
+var xxx_messageInfo_JobResumedEvent proto.InternalMessageInfo
 
-func (m *JobSucceededEvent) GetJobId() string {
+func (m *JobResumedEvent) GetJobId() string {
 	if m != nil {
 		return m.JobId
 	}
 	return ""
 }
 
-func (m *JobSucceededEvent) GetJobSetId() string {
+func (m *JobResumedEvent) GetJobSetId() string {
 	if m != nil {
 		return m.JobSetId
 	}
 	return ""
 }
 
-func (m *JobSucceededEvent) GetQueue() string {
+func (m *JobResumedEvent) GetQueue() string {
 	if m != nil {
 		return m.Queue
 	}
 	return ""
 }
 
-func (m *JobSucceededEvent) GetCreated() time.Time {
+func (m *JobResumedEvent) GetCreated() time.Time {
 	if m != nil {
 		return m.Created
 	}
 	return time.Time{}
 }
 
-func (m *JobSucceededEvent) GetClusterId() string {
+func (m *JobResumedEvent) GetRequestor() string {
 	if m != nil {
-		return m.ClusterId
+		return m.Requestor
 	}
 	return ""
 }
 
-func (m *JobSucceededEvent) GetKubernetesId() string {
+// Indicates that a preemptible job was evicted to make room for higher-priority work.
+// The job is returned to the queue, as indicated by a subsequent queued event.
+type JobEvictedForCapacityEvent struct {
+	JobId     string    `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+	JobSetId  string    `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
+	Queue     string    `protobuf:"bytes,3,opt,name=queue,proto3" json:"queue,omitempty"`
+	Created   time.Time `protobuf:"bytes,4,opt,name=created,proto3,stdtime" json:"created"`
+	ClusterId string    `protobuf:"bytes,5,opt,name=cluster_id,json=clusterId,proto3" json:"clusterId,omitempty"`
+}
+
+func (m *JobEvictedForCapacityEvent) Reset()      { *m = JobEvictedForCapacityEvent{} }
+func (*JobEvictedForCapacityEvent) ProtoMessage() {}
+func (*JobEvictedForCapacityEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7758595c3bb8cf56, []int{14}
+}
+func (m *JobEvictedForCapacityEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobEvictedForCapacityEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobEvictedForCapacityEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JobEvictedForCapacityEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobEvictedForCapacityEvent.Merge(m, src)
+}
+func (m *JobEvictedForCapacityEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobEvictedForCapacityEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobEvictedForCapacityEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobEvictedForCapacityEvent proto.InternalMessageInfo
+
+func (m *JobEvictedForCapacityEvent) GetJobId() string {
 	if m != nil {
-		return m.KubernetesId
+		return m.JobId
 	}
 	return ""
 }
 
-func (m *JobSucceededEvent) GetNodeName() string {
+func (m *JobEvictedForCapacityEvent) GetJobSetId() string {
 	if m != nil {
-		return m.NodeName
+		return m.JobSetId
 	}
 	return ""
 }
 
-func (m *JobSucceededEvent) GetPodNumber() int32 {
+func (m *JobEvictedForCapacityEvent) GetQueue() string {
 	if m != nil {
-		return m.PodNumber
+		return m.Queue
 	}
-	return 0
+	return ""
 }
 
-func (m *JobSucceededEvent) GetPodName() string {
+func (m *JobEvictedForCapacityEvent) GetCreated() time.Time {
 	if m != nil {
-		return m.PodName
+		return m.Created
 	}
-	return ""
+	return time.Time{}
 }
 
-func (m *JobSucceededEvent) GetPodNamespace() string {
+func (m *JobEvictedForCapacityEvent) GetClusterId() string {
 	if m != nil {
-		return m.PodNamespace
+		return m.ClusterId
 	}
 	return ""
 }
 
-type JobUtilisationEvent struct {
-	JobId                 string                       `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
-	JobSetId              string                       `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
-	Queue                 string                       `protobuf:"bytes,3,opt,name=queue,proto3" json:"queue,omitempty"`
-	Created               time.Time                    `protobuf:"bytes,4,opt,name=created,proto3,stdtime" json:"created"`
-	ClusterId             string                       `protobuf:"bytes,5,opt,name=cluster_id,json=clusterId,proto3" json:"clusterId,omitempty"`
-	KubernetesId          string                       `protobuf:"bytes,6,opt,name=kubernetes_id,json=kubernetesId,proto3" json:"kubernetesId,omitempty"`
-	MaxResourcesForPeriod map[string]resource.Quantity `protobuf:"bytes,7,rep,name=MaxResourcesForPeriod,proto3" json:"MaxResourcesForPeriod" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	NodeName              string                       `protobuf:"bytes,8,opt,name=node_name,json=nodeName,proto3" json:"nodeName,omitempty"`
-	PodNumber             int32                        `protobuf:"varint,9,opt,name=pod_number,json=podNumber,proto3" json:"podNumber,omitempty"`
-	PodName               string                       `protobuf:"bytes,10,opt,name=pod_name,json=podName,proto3" json:"podName,omitempty"`
-	PodNamespace          string                       `protobuf:"bytes,11,opt,name=pod_namespace,json=podNamespace,proto3" json:"podNamespace,omitempty"`
-	TotalCumulativeUsage  map[string]resource.Quantity `protobuf:"bytes,12,rep,name=total_cumulative_usage,json=totalCumulativeUsage,proto3" json:"totalCumulativeUsage" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+// Indicates that a run of a job exceeded the maximum runtime of the job, and that the job is therefore cancelled.
+type JobRuntimeExceededEvent struct {
+	JobId             string    `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+	JobSetId          string    `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
+	Queue             string    `protobuf:"bytes,3,opt,name=queue,proto3" json:"queue,omitempty"`
+	Created           time.Time `protobuf:"bytes,4,opt,name=created,proto3,stdtime" json:"created"`
+	ClusterId         string    `protobuf:"bytes,5,opt,name=cluster_id,json=clusterId,proto3" json:"clusterId,omitempty"`
+	MaxRuntimeSeconds uint32    `protobuf:"varint,6,opt,name=max_runtime_seconds,json=maxRuntimeSeconds,proto3" json:"maxRuntimeSeconds,omitempty"`
 }
 
-func (m *JobUtilisationEvent) Reset()      { *m = JobUtilisationEvent{} }
-func (*JobUtilisationEvent) ProtoMessage() {}
-func (*JobUtilisationEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7758595c3bb8cf56, []int{14}
+func (m *JobRuntimeExceededEvent) Reset()      { *m = JobRuntimeExceededEvent{} }
+func (*JobRuntimeExceededEvent) ProtoMessage() {}
+func (*JobRuntimeExceededEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7758595c3bb8cf56, []int{15}
 }
-func (m *JobUtilisationEvent) XXX_Unmarshal(b []byte) error {
+func (m *JobRuntimeExceededEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *JobUtilisationEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *JobRuntimeExceededEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_JobUtilisationEvent.Marshal(b, m, deterministic)
+		return xxx_messageInfo_JobRuntimeExceededEvent.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1444,122 +1541,166 @@ func (m *JobUtilisationEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *JobUtilisationEvent) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_JobUtilisationEvent.Merge(m, src)
+func (m *JobRuntimeExceededEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobRuntimeExceededEvent.Merge(m, src)
 }
-func (m *JobUtilisationEvent) XXX_Size() int {
+func (m *JobRuntimeExceededEvent) XXX_Size() int {
 	return m.Size()
 }
-func (m *JobUtilisationEvent) XXX_DiscardUnknown() {
-	xxx_messageInfo_JobUtilisationEvent.DiscardUnknown(m)
+func (m *JobRuntimeExceededEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobRuntimeExceededEvent.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_JobUtilisationEvent proto.InternalMessageInfo
+var xxx_messageInfo_JobRuntimeExceededEvent proto.InternalMessageInfo
 
-func (m *JobUtilisationEvent) GetJobId() string {
+func (m *JobRuntimeExceededEvent) GetJobId() string {
 	if m != nil {
 		return m.JobId
 	}
 	return ""
 }
 
-func (m *JobUtilisationEvent) GetJobSetId() string {
+func (m *JobRuntimeExceededEvent) GetJobSetId() string {
 	if m != nil {
 		return m.JobSetId
 	}
 	return ""
 }
 
-func (m *JobUtilisationEvent) GetQueue() string {
+func (m *JobRuntimeExceededEvent) GetQueue() string {
 	if m != nil {
 		return m.Queue
 	}
 	return ""
 }
 
-func (m *JobUtilisationEvent) GetCreated() time.Time {
+func (m *JobRuntimeExceededEvent) GetCreated() time.Time {
 	if m != nil {
 		return m.Created
 	}
 	return time.Time{}
 }
 
-func (m *JobUtilisationEvent) GetClusterId() string {
+func (m *JobRuntimeExceededEvent) GetClusterId() string {
 	if m != nil {
 		return m.ClusterId
 	}
 	return ""
 }
 
-func (m *JobUtilisationEvent) GetKubernetesId() string {
+func (m *JobRuntimeExceededEvent) GetMaxRuntimeSeconds() uint32 {
 	if m != nil {
-		return m.KubernetesId
+		return m.MaxRuntimeSeconds
 	}
-	return ""
+	return 0
 }
 
-func (m *JobUtilisationEvent) GetMaxResourcesForPeriod() map[string]resource.Quantity {
-	if m != nil {
-		return m.MaxResourcesForPeriod
-	}
-	return nil
+// Indicates that the resource requests and limits of the containers of a job were normalized at submission,
+// e.g., rounded up to the increments configured for the server. Requests and limits are given by container name.
+type JobResourcesNormalizedEvent struct {
+	JobId               string                             `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+	JobSetId            string                             `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
+	Queue               string                             `protobuf:"bytes,3,opt,name=queue,proto3" json:"queue,omitempty"`
+	Created             time.Time                          `protobuf:"bytes,4,opt,name=created,proto3,stdtime" json:"created"`
+	OriginalResources   map[string]v1.ResourceRequirements `protobuf:"bytes,5,rep,name=original_resources,json=originalResources,proto3" json:"originalResources" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	NormalizedResources map[string]v1.ResourceRequirements `protobuf:"bytes,6,rep,name=normalized_resources,json=normalizedResources,proto3" json:"normalizedResources" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (m *JobUtilisationEvent) GetNodeName() string {
-	if m != nil {
-		return m.NodeName
-	}
-	return ""
+func (m *JobResourcesNormalizedEvent) Reset()      { *m = JobResourcesNormalizedEvent{} }
+func (*JobResourcesNormalizedEvent) ProtoMessage() {}
+func (*JobResourcesNormalizedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7758595c3bb8cf56, []int{16}
+}
+func (m *JobResourcesNormalizedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobResourcesNormalizedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobResourcesNormalizedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JobResourcesNormalizedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobResourcesNormalizedEvent.Merge(m, src)
+}
+func (m *JobResourcesNormalizedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobResourcesNormalizedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobResourcesNormalizedEvent.DiscardUnknown(m)
 }
 
-func (m *JobUtilisationEvent) GetPodNumber() int32 {
+var xxx_messageInfo_JobResourcesNormalizedEvent proto.InternalMessageInfo
+
+func (m *JobResourcesNormalizedEvent) GetJobId() string {
 	if m != nil {
-		return m.PodNumber
+		return m.JobId
 	}
-	return 0
+	return ""
 }
 
-func (m *JobUtilisationEvent) GetPodName() string {
+func (m *JobResourcesNormalizedEvent) GetJobSetId() string {
 	if m != nil {
-		return m.PodName
+		return m.JobSetId
 	}
 	return ""
 }
 
-func (m *JobUtilisationEvent) GetPodNamespace() string {
+func (m *JobResourcesNormalizedEvent) GetQueue() string {
 	if m != nil {
-		return m.PodNamespace
+		return m.Queue
 	}
 	return ""
 }
 
-func (m *JobUtilisationEvent) GetTotalCumulativeUsage() map[string]resource.Quantity {
+func (m *JobResourcesNormalizedEvent) GetCreated() time.Time {
 	if m != nil {
-		return m.TotalCumulativeUsage
+		return m.Created
+	}
+	return time.Time{}
+}
+
+func (m *JobResourcesNormalizedEvent) GetOriginalResources() map[string]v1.ResourceRequirements {
+	if m != nil {
+		return m.OriginalResources
 	}
 	return nil
 }
 
-type JobReprioritizingEvent struct {
-	JobId       string    `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
-	JobSetId    string    `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
-	Queue       string    `protobuf:"bytes,3,opt,name=queue,proto3" json:"queue,omitempty"`
-	Created     time.Time `protobuf:"bytes,4,opt,name=created,proto3,stdtime" json:"created"`
-	NewPriority float64   `protobuf:"fixed64,5,opt,name=new_priority,json=newPriority,proto3" json:"newPriority,omitempty"`
-	Requestor   string    `protobuf:"bytes,6,opt,name=requestor,proto3" json:"requestor,omitempty"`
+func (m *JobResourcesNormalizedEvent) GetNormalizedResources() map[string]v1.ResourceRequirements {
+	if m != nil {
+		return m.NormalizedResources
+	}
+	return nil
 }
 
-func (m *JobReprioritizingEvent) Reset()      { *m = JobReprioritizingEvent{} }
-func (*JobReprioritizingEvent) ProtoMessage() {}
-func (*JobReprioritizingEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7758595c3bb8cf56, []int{15}
+// Warns that a queued job can no longer be scheduled on any active cluster, e.g., because the nodes it fits on
+// were removed. The job stays queued, and is reported again only if it becomes schedulable in between.
+type JobUnschedulableEvent struct {
+	JobId    string    `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+	JobSetId string    `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
+	Queue    string    `protobuf:"bytes,3,opt,name=queue,proto3" json:"queue,omitempty"`
+	Created  time.Time `protobuf:"bytes,4,opt,name=created,proto3,stdtime" json:"created"`
+	Reason   string    `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
 }
-func (m *JobReprioritizingEvent) XXX_Unmarshal(b []byte) error {
+
+func (m *JobUnschedulableEvent) Reset()      { *m = JobUnschedulableEvent{} }
+func (*JobUnschedulableEvent) ProtoMessage() {}
+func (*JobUnschedulableEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7758595c3bb8cf56, []int{17}
+}
+func (m *JobUnschedulableEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *JobReprioritizingEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *JobUnschedulableEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_JobReprioritizingEvent.Marshal(b, m, deterministic)
+		return xxx_messageInfo_JobUnschedulableEvent.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1569,80 +1710,80 @@ func (m *JobReprioritizingEvent) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *JobReprioritizingEvent) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_JobReprioritizingEvent.Merge(m, src)
+func (m *JobUnschedulableEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobUnschedulableEvent.Merge(m, src)
 }
-func (m *JobReprioritizingEvent) XXX_Size() int {
+func (m *JobUnschedulableEvent) XXX_Size() int {
 	return m.Size()
 }
-func (m *JobReprioritizingEvent) XXX_DiscardUnknown() {
-	xxx_messageInfo_JobReprioritizingEvent.DiscardUnknown(m)
+func (m *JobUnschedulableEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobUnschedulableEvent.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_JobReprioritizingEvent proto.InternalMessageInfo
+var xxx_messageInfo_JobUnschedulableEvent proto.InternalMessageInfo
 
-func (m *JobReprioritizingEvent) GetJobId() string {
+func (m *JobUnschedulableEvent) GetJobId() string {
 	if m != nil {
 		return m.JobId
 	}
 	return ""
 }
 
-func (m *JobReprioritizingEvent) GetJobSetId() string {
+func (m *JobUnschedulableEvent) GetJobSetId() string {
 	if m != nil {
 		return m.JobSetId
 	}
 	return ""
 }
 
-func (m *JobReprioritizingEvent) GetQueue() string {
+func (m *JobUnschedulableEvent) GetQueue() string {
 	if m != nil {
 		return m.Queue
 	}
 	return ""
 }
 
-func (m *JobReprioritizingEvent) GetCreated() time.Time {
+func (m *JobUnschedulableEvent) GetCreated() time.Time {
 	if m != nil {
 		return m.Created
 	}
 	return time.Time{}
 }
 
-func (m *JobReprioritizingEvent) GetNewPriority() float64 {
-	if m != nil {
-		return m.NewPriority
-	}
-	return 0
-}
-
-func (m *JobReprioritizingEvent) GetRequestor() string {
+func (m *JobUnschedulableEvent) GetReason() string {
 	if m != nil {
-		return m.Requestor
+		return m.Reason
 	}
 	return ""
 }
 
-type JobReprioritizedEvent struct {
-	JobId       string    `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
-	JobSetId    string    `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
-	Queue       string    `protobuf:"bytes,3,opt,name=queue,proto3" json:"queue,omitempty"`
-	Created     time.Time `protobuf:"bytes,4,opt,name=created,proto3,stdtime" json:"created"`
-	NewPriority float64   `protobuf:"fixed64,5,opt,name=new_priority,json=newPriority,proto3" json:"newPriority,omitempty"`
-	Requestor   string    `protobuf:"bytes,6,opt,name=requestor,proto3" json:"requestor,omitempty"`
-}
-
-func (m *JobReprioritizedEvent) Reset()      { *m = JobReprioritizedEvent{} }
-func (*JobReprioritizedEvent) ProtoMessage() {}
-func (*JobReprioritizedEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7758595c3bb8cf56, []int{16}
+// Indicates that a maintenance window affecting the job started, i.e., a window of its queue, or of the executor it's
+// leased to. No new leases are given to the queue or executor until the window ends, and the job is preempted and
+// requeued if the window drains jobs.
+type JobMaintenanceWindowStartedEvent struct {
+	JobId               string    `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+	JobSetId            string    `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
+	Queue               string    `protobuf:"bytes,3,opt,name=queue,proto3" json:"queue,omitempty"`
+	Created             time.Time `protobuf:"bytes,4,opt,name=created,proto3,stdtime" json:"created"`
+	MaintenanceWindowId string    `protobuf:"bytes,5,opt,name=maintenance_window_id,json=maintenanceWindowId,proto3" json:"maintenanceWindowId,omitempty"`
+	// Set for windows of executors.
+	Executor string    `protobuf:"bytes,6,opt,name=executor,proto3" json:"executor,omitempty"`
+	End      time.Time `protobuf:"bytes,7,opt,name=end,proto3,stdtime" json:"end"`
+	Drain    bool      `protobuf:"varint,8,opt,name=drain,proto3" json:"drain,omitempty"`
+	Reason   string    `protobuf:"bytes,9,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *JobMaintenanceWindowStartedEvent) Reset()      { *m = JobMaintenanceWindowStartedEvent{} }
+func (*JobMaintenanceWindowStartedEvent) ProtoMessage() {}
+func (*JobMaintenanceWindowStartedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7758595c3bb8cf56, []int{18}
 }
-func (m *JobReprioritizedEvent) XXX_Unmarshal(b []byte) error {
+func (m *JobMaintenanceWindowStartedEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *JobReprioritizedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *JobMaintenanceWindowStartedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_JobReprioritizedEvent.Marshal(b, m, deterministic)
+		return xxx_messageInfo_JobMaintenanceWindowStartedEvent.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1652,80 +1793,103 @@ func (m *JobReprioritizedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *JobReprioritizedEvent) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_JobReprioritizedEvent.Merge(m, src)
+func (m *JobMaintenanceWindowStartedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobMaintenanceWindowStartedEvent.Merge(m, src)
 }
-func (m *JobReprioritizedEvent) XXX_Size() int {
+func (m *JobMaintenanceWindowStartedEvent) XXX_Size() int {
 	return m.Size()
 }
-func (m *JobReprioritizedEvent) XXX_DiscardUnknown() {
-	xxx_messageInfo_JobReprioritizedEvent.DiscardUnknown(m)
+func (m *JobMaintenanceWindowStartedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobMaintenanceWindowStartedEvent.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_JobReprioritizedEvent proto.InternalMessageInfo
+var xxx_messageInfo_JobMaintenanceWindowStartedEvent proto.InternalMessageInfo
 
-func (m *JobReprioritizedEvent) GetJobId() string {
+func (m *JobMaintenanceWindowStartedEvent) GetJobId() string {
 	if m != nil {
 		return m.JobId
 	}
 	return ""
 }
 
-func (m *JobReprioritizedEvent) GetJobSetId() string {
+func (m *JobMaintenanceWindowStartedEvent) GetJobSetId() string {
 	if m != nil {
 		return m.JobSetId
 	}
 	return ""
 }
 
-func (m *JobReprioritizedEvent) GetQueue() string {
+func (m *JobMaintenanceWindowStartedEvent) GetQueue() string {
 	if m != nil {
 		return m.Queue
 	}
 	return ""
 }
 
-func (m *JobReprioritizedEvent) GetCreated() time.Time {
+func (m *JobMaintenanceWindowStartedEvent) GetCreated() time.Time {
 	if m != nil {
 		return m.Created
 	}
 	return time.Time{}
 }
 
-func (m *JobReprioritizedEvent) GetNewPriority() float64 {
+func (m *JobMaintenanceWindowStartedEvent) GetMaintenanceWindowId() string {
 	if m != nil {
-		return m.NewPriority
+		return m.MaintenanceWindowId
 	}
-	return 0
+	return ""
 }
 
-func (m *JobReprioritizedEvent) GetRequestor() string {
+func (m *JobMaintenanceWindowStartedEvent) GetExecutor() string {
 	if m != nil {
-		return m.Requestor
+		return m.Executor
 	}
 	return ""
 }
 
-type JobCancellingEvent struct {
-	JobId     string    `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
-	JobSetId  string    `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
-	Queue     string    `protobuf:"bytes,3,opt,name=queue,proto3" json:"queue,omitempty"`
-	Created   time.Time `protobuf:"bytes,4,opt,name=created,proto3,stdtime" json:"created"`
-	Requestor string    `protobuf:"bytes,5,opt,name=requestor,proto3" json:"requestor,omitempty"`
-	Reason    string    `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+func (m *JobMaintenanceWindowStartedEvent) GetEnd() time.Time {
+	if m != nil {
+		return m.End
+	}
+	return time.Time{}
 }
 
-func (m *JobCancellingEvent) Reset()      { *m = JobCancellingEvent{} }
-func (*JobCancellingEvent) ProtoMessage() {}
-func (*JobCancellingEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7758595c3bb8cf56, []int{17}
+func (m *JobMaintenanceWindowStartedEvent) GetDrain() bool {
+	if m != nil {
+		return m.Drain
+	}
+	return false
 }
-func (m *JobCancellingEvent) XXX_Unmarshal(b []byte) error {
+
+func (m *JobMaintenanceWindowStartedEvent) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// Indicates that a maintenance window the job was affected by ended, or was deleted after it started.
+type JobMaintenanceWindowEndedEvent struct {
+	JobId               string    `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+	JobSetId            string    `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
+	Queue               string    `protobuf:"bytes,3,opt,name=queue,proto3" json:"queue,omitempty"`
+	Created             time.Time `protobuf:"bytes,4,opt,name=created,proto3,stdtime" json:"created"`
+	MaintenanceWindowId string    `protobuf:"bytes,5,opt,name=maintenance_window_id,json=maintenanceWindowId,proto3" json:"maintenanceWindowId,omitempty"`
+	// Set for windows of executors.
+	Executor string `protobuf:"bytes,6,opt,name=executor,proto3" json:"executor,omitempty"`
+}
+
+func (m *JobMaintenanceWindowEndedEvent) Reset()      { *m = JobMaintenanceWindowEndedEvent{} }
+func (*JobMaintenanceWindowEndedEvent) ProtoMessage() {}
+func (*JobMaintenanceWindowEndedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7758595c3bb8cf56, []int{19}
+}
+func (m *JobMaintenanceWindowEndedEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *JobCancellingEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *JobMaintenanceWindowEndedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_JobCancellingEvent.Marshal(b, m, deterministic)
+		return xxx_messageInfo_JobMaintenanceWindowEndedEvent.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1735,80 +1899,84 @@ func (m *JobCancellingEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *JobCancellingEvent) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_JobCancellingEvent.Merge(m, src)
+func (m *JobMaintenanceWindowEndedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobMaintenanceWindowEndedEvent.Merge(m, src)
 }
-func (m *JobCancellingEvent) XXX_Size() int {
+func (m *JobMaintenanceWindowEndedEvent) XXX_Size() int {
 	return m.Size()
 }
-func (m *JobCancellingEvent) XXX_DiscardUnknown() {
-	xxx_messageInfo_JobCancellingEvent.DiscardUnknown(m)
+func (m *JobMaintenanceWindowEndedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobMaintenanceWindowEndedEvent.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_JobCancellingEvent proto.InternalMessageInfo
+var xxx_messageInfo_JobMaintenanceWindowEndedEvent proto.InternalMessageInfo
 
-func (m *JobCancellingEvent) GetJobId() string {
+func (m *JobMaintenanceWindowEndedEvent) GetJobId() string {
 	if m != nil {
 		return m.JobId
 	}
 	return ""
 }
 
-func (m *JobCancellingEvent) GetJobSetId() string {
+func (m *JobMaintenanceWindowEndedEvent) GetJobSetId() string {
 	if m != nil {
 		return m.JobSetId
 	}
 	return ""
 }
 
-func (m *JobCancellingEvent) GetQueue() string {
+func (m *JobMaintenanceWindowEndedEvent) GetQueue() string {
 	if m != nil {
 		return m.Queue
 	}
 	return ""
 }
 
-func (m *JobCancellingEvent) GetCreated() time.Time {
+func (m *JobMaintenanceWindowEndedEvent) GetCreated() time.Time {
 	if m != nil {
 		return m.Created
 	}
 	return time.Time{}
 }
 
-func (m *JobCancellingEvent) GetRequestor() string {
+func (m *JobMaintenanceWindowEndedEvent) GetMaintenanceWindowId() string {
 	if m != nil {
-		return m.Requestor
+		return m.MaintenanceWindowId
 	}
 	return ""
 }
 
-func (m *JobCancellingEvent) GetReason() string {
+func (m *JobMaintenanceWindowEndedEvent) GetExecutor() string {
 	if m != nil {
-		return m.Reason
+		return m.Executor
 	}
 	return ""
 }
 
-type JobCancelledEvent struct {
-	JobId     string    `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
-	JobSetId  string    `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
-	Queue     string    `protobuf:"bytes,3,opt,name=queue,proto3" json:"queue,omitempty"`
-	Created   time.Time `protobuf:"bytes,4,opt,name=created,proto3,stdtime" json:"created"`
-	Requestor string    `protobuf:"bytes,5,opt,name=requestor,proto3" json:"requestor,omitempty"`
-	Reason    string    `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+// Indicates that no new leases are given to the queue of the job, or to the executor it's leased to, since it was
+// cordoned. Running jobs are unaffected.
+type JobCordonedEvent struct {
+	JobId    string    `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+	JobSetId string    `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
+	Queue    string    `protobuf:"bytes,3,opt,name=queue,proto3" json:"queue,omitempty"`
+	Created  time.Time `protobuf:"bytes,4,opt,name=created,proto3,stdtime" json:"created"`
+	// Set for cordons of executors.
+	Executor   string `protobuf:"bytes,5,opt,name=executor,proto3" json:"executor,omitempty"`
+	CordonedBy string `protobuf:"bytes,6,opt,name=cordoned_by,json=cordonedBy,proto3" json:"cordonedBy,omitempty"`
+	Reason     string `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (m *JobCancelledEvent) Reset()      { *m = JobCancelledEvent{} }
-func (*JobCancelledEvent) ProtoMessage() {}
-func (*JobCancelledEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7758595c3bb8cf56, []int{18}
+func (m *JobCordonedEvent) Reset()      { *m = JobCordonedEvent{} }
+func (*JobCordonedEvent) ProtoMessage() {}
+func (*JobCordonedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7758595c3bb8cf56, []int{20}
 }
-func (m *JobCancelledEvent) XXX_Unmarshal(b []byte) error {
+func (m *JobCordonedEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *JobCancelledEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *JobCordonedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_JobCancelledEvent.Marshal(b, m, deterministic)
+		return xxx_messageInfo_JobCordonedEvent.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1818,84 +1986,89 @@ func (m *JobCancelledEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *JobCancelledEvent) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_JobCancelledEvent.Merge(m, src)
+func (m *JobCordonedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobCordonedEvent.Merge(m, src)
 }
-func (m *JobCancelledEvent) XXX_Size() int {
+func (m *JobCordonedEvent) XXX_Size() int {
 	return m.Size()
 }
-func (m *JobCancelledEvent) XXX_DiscardUnknown() {
-	xxx_messageInfo_JobCancelledEvent.DiscardUnknown(m)
+func (m *JobCordonedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobCordonedEvent.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_JobCancelledEvent proto.InternalMessageInfo
+var xxx_messageInfo_JobCordonedEvent proto.InternalMessageInfo
 
-func (m *JobCancelledEvent) GetJobId() string {
+func (m *JobCordonedEvent) GetJobId() string {
 	if m != nil {
 		return m.JobId
 	}
 	return ""
 }
 
-func (m *JobCancelledEvent) GetJobSetId() string {
+func (m *JobCordonedEvent) GetJobSetId() string {
 	if m != nil {
 		return m.JobSetId
 	}
 	return ""
 }
 
-func (m *JobCancelledEvent) GetQueue() string {
+func (m *JobCordonedEvent) GetQueue() string {
 	if m != nil {
 		return m.Queue
 	}
 	return ""
 }
 
-func (m *JobCancelledEvent) GetCreated() time.Time {
+func (m *JobCordonedEvent) GetCreated() time.Time {
 	if m != nil {
 		return m.Created
 	}
 	return time.Time{}
 }
 
-func (m *JobCancelledEvent) GetRequestor() string {
+func (m *JobCordonedEvent) GetExecutor() string {
 	if m != nil {
-		return m.Requestor
+		return m.Executor
 	}
 	return ""
 }
 
-func (m *JobCancelledEvent) GetReason() string {
+func (m *JobCordonedEvent) GetCordonedBy() string {
+	if m != nil {
+		return m.CordonedBy
+	}
+	return ""
+}
+
+func (m *JobCordonedEvent) GetReason() string {
 	if m != nil {
 		return m.Reason
 	}
 	return ""
 }
 
-type JobTerminatedEvent struct {
-	JobId        string    `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
-	JobSetId     string    `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
-	Queue        string    `protobuf:"bytes,3,opt,name=queue,proto3" json:"queue,omitempty"`
-	Created      time.Time `protobuf:"bytes,4,opt,name=created,proto3,stdtime" json:"created"`
-	ClusterId    string    `protobuf:"bytes,5,opt,name=cluster_id,json=clusterId,proto3" json:"clusterId,omitempty"`
-	KubernetesId string    `protobuf:"bytes,6,opt,name=kubernetes_id,json=kubernetesId,proto3" json:"kubernetesId,omitempty"`
-	PodNumber    int32     `protobuf:"varint,7,opt,name=pod_number,json=podNumber,proto3" json:"podNumber,omitempty"`
-	PodName      string    `protobuf:"bytes,9,opt,name=pod_name,json=podName,proto3" json:"podName,omitempty"`
-	PodNamespace string    `protobuf:"bytes,10,opt,name=pod_namespace,json=podNamespace,proto3" json:"podNamespace,omitempty"`
-	Reason       string    `protobuf:"bytes,8,opt,name=reason,proto3" json:"reason,omitempty"`
+// Indicates that the queue of the job, or the executor it's leased to, was uncordoned.
+type JobUncordonedEvent struct {
+	JobId    string    `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+	JobSetId string    `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
+	Queue    string    `protobuf:"bytes,3,opt,name=queue,proto3" json:"queue,omitempty"`
+	Created  time.Time `protobuf:"bytes,4,opt,name=created,proto3,stdtime" json:"created"`
+	// Set for cordons of executors.
+	Executor     string `protobuf:"bytes,5,opt,name=executor,proto3" json:"executor,omitempty"`
+	UncordonedBy string `protobuf:"bytes,6,opt,name=uncordoned_by,json=uncordonedBy,proto3" json:"uncordonedBy,omitempty"`
 }
 
-func (m *JobTerminatedEvent) Reset()      { *m = JobTerminatedEvent{} }
-func (*JobTerminatedEvent) ProtoMessage() {}
-func (*JobTerminatedEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7758595c3bb8cf56, []int{19}
+func (m *JobUncordonedEvent) Reset()      { *m = JobUncordonedEvent{} }
+func (*JobUncordonedEvent) ProtoMessage() {}
+func (*JobUncordonedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7758595c3bb8cf56, []int{21}
 }
-func (m *JobTerminatedEvent) XXX_Unmarshal(b []byte) error {
+func (m *JobUncordonedEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *JobTerminatedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *JobUncordonedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_JobTerminatedEvent.Marshal(b, m, deterministic)
+		return xxx_messageInfo_JobUncordonedEvent.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1905,108 +2078,85 @@ func (m *JobTerminatedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *JobTerminatedEvent) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_JobTerminatedEvent.Merge(m, src)
+func (m *JobUncordonedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobUncordonedEvent.Merge(m, src)
 }
-func (m *JobTerminatedEvent) XXX_Size() int {
+func (m *JobUncordonedEvent) XXX_Size() int {
 	return m.Size()
 }
-func (m *JobTerminatedEvent) XXX_DiscardUnknown() {
-	xxx_messageInfo_JobTerminatedEvent.DiscardUnknown(m)
+func (m *JobUncordonedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobUncordonedEvent.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_JobTerminatedEvent proto.InternalMessageInfo
+var xxx_messageInfo_JobUncordonedEvent proto.InternalMessageInfo
 
-func (m *JobTerminatedEvent) GetJobId() string {
+func (m *JobUncordonedEvent) GetJobId() string {
 	if m != nil {
 		return m.JobId
 	}
 	return ""
 }
 
-func (m *JobTerminatedEvent) GetJobSetId() string {
+func (m *JobUncordonedEvent) GetJobSetId() string {
 	if m != nil {
 		return m.JobSetId
 	}
 	return ""
 }
 
-func (m *JobTerminatedEvent) GetQueue() string {
+func (m *JobUncordonedEvent) GetQueue() string {
 	if m != nil {
 		return m.Queue
 	}
 	return ""
 }
 
-func (m *JobTerminatedEvent) GetCreated() time.Time {
+func (m *JobUncordonedEvent) GetCreated() time.Time {
 	if m != nil {
 		return m.Created
 	}
 	return time.Time{}
 }
 
-func (m *JobTerminatedEvent) GetClusterId() string {
+func (m *JobUncordonedEvent) GetExecutor() string {
 	if m != nil {
-		return m.ClusterId
+		return m.Executor
 	}
 	return ""
 }
 
-func (m *JobTerminatedEvent) GetKubernetesId() string {
+func (m *JobUncordonedEvent) GetUncordonedBy() string {
 	if m != nil {
-		return m.KubernetesId
+		return m.UncordonedBy
 	}
 	return ""
 }
 
-func (m *JobTerminatedEvent) GetPodNumber() int32 {
-	if m != nil {
-		return m.PodNumber
-	}
-	return 0
+type JobPreemptedEvent struct {
+	JobId           string    `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+	JobSetId        string    `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
+	Queue           string    `protobuf:"bytes,3,opt,name=queue,proto3" json:"queue,omitempty"`
+	Created         time.Time `protobuf:"bytes,4,opt,name=created,proto3,stdtime" json:"created"`
+	ClusterId       string    `protobuf:"bytes,5,opt,name=cluster_id,json=clusterId,proto3" json:"clusterId,omitempty"`
+	RunId           string    `protobuf:"bytes,6,opt,name=run_id,json=runId,proto3" json:"runId,omitempty"`
+	PreemptiveJobId string    `protobuf:"bytes,7,opt,name=preemptive_job_id,json=preemptiveJobId,proto3" json:"preemptiveJobId,omitempty"`
+	PreemptiveRunId string    `protobuf:"bytes,8,opt,name=preemptive_run_id,json=preemptiveRunId,proto3" json:"preemptiveRunId,omitempty"`
+	// Set if the job was preempted on request, e.g., using PreemptJobs, rather than by the scheduler.
+	Requestor string `protobuf:"bytes,9,opt,name=requestor,proto3" json:"requestor,omitempty"`
+	Reason    string `protobuf:"bytes,10,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (m *JobTerminatedEvent) GetPodName() string {
-	if m != nil {
-		return m.PodName
-	}
-	return ""
+func (m *JobPreemptedEvent) Reset()      { *m = JobPreemptedEvent{} }
+func (*JobPreemptedEvent) ProtoMessage() {}
+func (*JobPreemptedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7758595c3bb8cf56, []int{22}
 }
-
-func (m *JobTerminatedEvent) GetPodNamespace() string {
-	if m != nil {
-		return m.PodNamespace
-	}
-	return ""
-}
-
-func (m *JobTerminatedEvent) GetReason() string {
-	if m != nil {
-		return m.Reason
-	}
-	return ""
-}
-
-type JobUpdatedEvent struct {
-	JobId     string    `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
-	JobSetId  string    `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
-	Queue     string    `protobuf:"bytes,3,opt,name=queue,proto3" json:"queue,omitempty"`
-	Created   time.Time `protobuf:"bytes,4,opt,name=created,proto3,stdtime" json:"created"`
-	Requestor string    `protobuf:"bytes,5,opt,name=requestor,proto3" json:"requestor,omitempty"`
-	Job       Job       `protobuf:"bytes,6,opt,name=job,proto3" json:"job"`
-}
-
-func (m *JobUpdatedEvent) Reset()      { *m = JobUpdatedEvent{} }
-func (*JobUpdatedEvent) ProtoMessage() {}
-func (*JobUpdatedEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7758595c3bb8cf56, []int{20}
-}
-func (m *JobUpdatedEvent) XXX_Unmarshal(b []byte) error {
+func (m *JobPreemptedEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *JobUpdatedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *JobPreemptedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_JobUpdatedEvent.Marshal(b, m, deterministic)
+		return xxx_messageInfo_JobPreemptedEvent.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2016,97 +2166,104 @@ func (m *JobUpdatedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *JobUpdatedEvent) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_JobUpdatedEvent.Merge(m, src)
+func (m *JobPreemptedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobPreemptedEvent.Merge(m, src)
 }
-func (m *JobUpdatedEvent) XXX_Size() int {
+func (m *JobPreemptedEvent) XXX_Size() int {
 	return m.Size()
 }
-func (m *JobUpdatedEvent) XXX_DiscardUnknown() {
-	xxx_messageInfo_JobUpdatedEvent.DiscardUnknown(m)
+func (m *JobPreemptedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobPreemptedEvent.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_JobUpdatedEvent proto.InternalMessageInfo
+var xxx_messageInfo_JobPreemptedEvent proto.InternalMessageInfo
 
-func (m *JobUpdatedEvent) GetJobId() string {
+func (m *JobPreemptedEvent) GetJobId() string {
 	if m != nil {
 		return m.JobId
 	}
 	return ""
 }
 
-func (m *JobUpdatedEvent) GetJobSetId() string {
+func (m *JobPreemptedEvent) GetJobSetId() string {
 	if m != nil {
 		return m.JobSetId
 	}
 	return ""
 }
 
-func (m *JobUpdatedEvent) GetQueue() string {
+func (m *JobPreemptedEvent) GetQueue() string {
 	if m != nil {
 		return m.Queue
 	}
 	return ""
 }
 
-func (m *JobUpdatedEvent) GetCreated() time.Time {
+func (m *JobPreemptedEvent) GetCreated() time.Time {
 	if m != nil {
 		return m.Created
 	}
 	return time.Time{}
 }
 
-func (m *JobUpdatedEvent) GetRequestor() string {
+func (m *JobPreemptedEvent) GetClusterId() string {
+	if m != nil {
+		return m.ClusterId
+	}
+	return ""
+}
+
+func (m *JobPreemptedEvent) GetRunId() string {
+	if m != nil {
+		return m.RunId
+	}
+	return ""
+}
+
+func (m *JobPreemptedEvent) GetPreemptiveJobId() string {
+	if m != nil {
+		return m.PreemptiveJobId
+	}
+	return ""
+}
+
+func (m *JobPreemptedEvent) GetPreemptiveRunId() string {
+	if m != nil {
+		return m.PreemptiveRunId
+	}
+	return ""
+}
+
+func (m *JobPreemptedEvent) GetRequestor() string {
 	if m != nil {
 		return m.Requestor
 	}
 	return ""
 }
 
-func (m *JobUpdatedEvent) GetJob() Job {
+func (m *JobPreemptedEvent) GetReason() string {
 	if m != nil {
-		return m.Job
+		return m.Reason
 	}
-	return Job{}
+	return ""
 }
 
-type EventMessage struct {
-	// Types that are valid to be assigned to Events:
-	//	*EventMessage_Submitted
-	//	*EventMessage_Queued
-	//	*EventMessage_DuplicateFound
-	//	*EventMessage_Leased
-	//	*EventMessage_LeaseReturned
-	//	*EventMessage_LeaseExpired
-	//	*EventMessage_Pending
-	//	*EventMessage_Running
-	//	*EventMessage_UnableToSchedule
-	//	*EventMessage_Failed
-	//	*EventMessage_Succeeded
-	//	*EventMessage_Reprioritized
-	//	*EventMessage_Cancelling
-	//	*EventMessage_Cancelled
-	//	*EventMessage_Terminated
-	//	*EventMessage_Utilisation
-	//	*EventMessage_IngressInfo
-	//	*EventMessage_Reprioritizing
-	//	*EventMessage_Updated
-	//	*EventMessage_FailedCompressed
-	//	*EventMessage_Preempted
-	Events isEventMessage_Events `protobuf_oneof:"events"`
+// Only used internally by Armada
+type JobFailedEventCompressed struct {
+	Event []byte `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
 }
 
-func (m *EventMessage) Reset()      { *m = EventMessage{} }
-func (*EventMessage) ProtoMessage() {}
-func (*EventMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7758595c3bb8cf56, []int{21}
+func (m *JobFailedEventCompressed) Reset()      { *m = JobFailedEventCompressed{} }
+func (*JobFailedEventCompressed) ProtoMessage() {}
+func (*JobFailedEventCompressed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7758595c3bb8cf56, []int{23}
 }
-func (m *EventMessage) XXX_Unmarshal(b []byte) error {
+func (m *JobFailedEventCompressed) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *JobFailedEventCompressed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventMessage.Marshal(b, m, deterministic)
+		return xxx_messageInfo_JobFailedEventCompressed.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2116,310 +2273,291 @@ func (m *EventMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *EventMessage) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventMessage.Merge(m, src)
+func (m *JobFailedEventCompressed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobFailedEventCompressed.Merge(m, src)
 }
-func (m *EventMessage) XXX_Size() int {
+func (m *JobFailedEventCompressed) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventMessage) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventMessage.DiscardUnknown(m)
+func (m *JobFailedEventCompressed) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobFailedEventCompressed.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventMessage proto.InternalMessageInfo
+var xxx_messageInfo_JobFailedEventCompressed proto.InternalMessageInfo
 
-type isEventMessage_Events interface {
-	isEventMessage_Events()
-	MarshalTo([]byte) (int, error)
-	Size() int
+func (m *JobFailedEventCompressed) GetEvent() []byte {
+	if m != nil {
+		return m.Event
+	}
+	return nil
 }
 
-type EventMessage_Submitted struct {
-	Submitted *JobSubmittedEvent `protobuf:"bytes,1,opt,name=submitted,proto3,oneof" json:"submitted,omitempty"`
-}
-type EventMessage_Queued struct {
-	Queued *JobQueuedEvent `protobuf:"bytes,2,opt,name=queued,proto3,oneof" json:"queued,omitempty"`
-}
-type EventMessage_DuplicateFound struct {
-	DuplicateFound *JobDuplicateFoundEvent `protobuf:"bytes,16,opt,name=duplicate_found,json=duplicateFound,proto3,oneof" json:"duplicateFound,omitempty"`
-}
-type EventMessage_Leased struct {
-	Leased *JobLeasedEvent `protobuf:"bytes,3,opt,name=leased,proto3,oneof" json:"leased,omitempty"`
-}
-type EventMessage_LeaseReturned struct {
-	LeaseReturned *JobLeaseReturnedEvent `protobuf:"bytes,4,opt,name=lease_returned,json=leaseReturned,proto3,oneof" json:"leaseReturned,omitempty"`
-}
-type EventMessage_LeaseExpired struct {
-	LeaseExpired *JobLeaseExpiredEvent `protobuf:"bytes,5,opt,name=lease_expired,json=leaseExpired,proto3,oneof" json:"leaseExpired,omitempty"`
-}
-type EventMessage_Pending struct {
-	Pending *JobPendingEvent `protobuf:"bytes,6,opt,name=pending,proto3,oneof" json:"pending,omitempty"`
-}
-type EventMessage_Running struct {
-	Running *JobRunningEvent `protobuf:"bytes,7,opt,name=running,proto3,oneof" json:"running,omitempty"`
-}
-type EventMessage_UnableToSchedule struct {
-	UnableToSchedule *JobUnableToScheduleEvent `protobuf:"bytes,8,opt,name=unable_to_schedule,json=unableToSchedule,proto3,oneof" json:"unableToSchedule,omitempty"`
-}
-type EventMessage_Failed struct {
-	Failed *JobFailedEvent `protobuf:"bytes,9,opt,name=failed,proto3,oneof" json:"failed,omitempty"`
-}
-type EventMessage_Succeeded struct {
-	Succeeded *JobSucceededEvent `protobuf:"bytes,10,opt,name=succeeded,proto3,oneof" json:"succeeded,omitempty"`
-}
-type EventMessage_Reprioritized struct {
-	Reprioritized *JobReprioritizedEvent `protobuf:"bytes,11,opt,name=reprioritized,proto3,oneof" json:"reprioritized,omitempty"`
-}
-type EventMessage_Cancelling struct {
-	Cancelling *JobCancellingEvent `protobuf:"bytes,12,opt,name=cancelling,proto3,oneof" json:"cancelling,omitempty"`
-}
-type EventMessage_Cancelled struct {
-	Cancelled *JobCancelledEvent `protobuf:"bytes,13,opt,name=cancelled,proto3,oneof" json:"cancelled,omitempty"`
-}
-type EventMessage_Terminated struct {
-	Terminated *JobTerminatedEvent `protobuf:"bytes,14,opt,name=terminated,proto3,oneof" json:"terminated,omitempty"`
+type JobSucceededEvent struct {
+	JobId        string    `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+	JobSetId     string    `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
+	Queue        string    `protobuf:"bytes,3,opt,name=queue,proto3" json:"queue,omitempty"`
+	Created      time.Time `protobuf:"bytes,4,opt,name=created,proto3,stdtime" json:"created"`
+	ClusterId    string    `protobuf:"bytes,5,opt,name=cluster_id,json=clusterId,proto3" json:"clusterId,omitempty"`
+	KubernetesId string    `protobuf:"bytes,6,opt,name=kubernetes_id,json=kubernetesId,proto3" json:"kubernetesId,omitempty"`
+	NodeName     string    `protobuf:"bytes,7,opt,name=node_name,json=nodeName,proto3" json:"nodeName,omitempty"`
+	PodNumber    int32     `protobuf:"varint,8,opt,name=pod_number,json=podNumber,proto3" json:"podNumber,omitempty"`
+	PodName      string    `protobuf:"bytes,9,opt,name=pod_name,json=podName,proto3" json:"podName,omitempty"`
+	PodNamespace string    `protobuf:"bytes,10,opt,name=pod_namespace,json=podNamespace,proto3" json:"podNamespace,omitempty"`
 }
-type EventMessage_Utilisation struct {
-	Utilisation *JobUtilisationEvent `protobuf:"bytes,15,opt,name=utilisation,proto3,oneof" json:"utilisation,omitempty"`
+
+func (m *JobSucceededEvent) Reset()      { *m = JobSucceededEvent{} }
+func (*JobSucceededEvent) ProtoMessage() {}
+func (*JobSucceededEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7758595c3bb8cf56, []int{24}
 }
-type EventMessage_IngressInfo struct {
-	IngressInfo *JobIngressInfoEvent `protobuf:"bytes,17,opt,name=ingress_info,json=ingressInfo,proto3,oneof" json:"ingressInfo,omitempty"`
+func (m *JobSucceededEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-type EventMessage_Reprioritizing struct {
-	Reprioritizing *JobReprioritizingEvent `protobuf:"bytes,18,opt,name=reprioritizing,proto3,oneof" json:"reprioritizing,omitempty"`
+func (m *JobSucceededEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobSucceededEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-type EventMessage_Updated struct {
-	Updated *JobUpdatedEvent `protobuf:"bytes,19,opt,name=updated,proto3,oneof" json:"updated,omitempty"`
+func (m *JobSucceededEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobSucceededEvent.Merge(m, src)
 }
-type EventMessage_FailedCompressed struct {
-	FailedCompressed *JobFailedEventCompressed `protobuf:"bytes,20,opt,name=failedCompressed,proto3,oneof" json:"failedCompressed,omitempty"`
+func (m *JobSucceededEvent) XXX_Size() int {
+	return m.Size()
 }
-type EventMessage_Preempted struct {
-	Preempted *JobPreemptedEvent `protobuf:"bytes,21,opt,name=preempted,proto3,oneof" json:"preempted,omitempty"`
+func (m *JobSucceededEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobSucceededEvent.DiscardUnknown(m)
 }
 
-func (*EventMessage_Submitted) isEventMessage_Events()        {}
-func (*EventMessage_Queued) isEventMessage_Events()           {}
-func (*EventMessage_DuplicateFound) isEventMessage_Events()   {}
-func (*EventMessage_Leased) isEventMessage_Events()           {}
-func (*EventMessage_LeaseReturned) isEventMessage_Events()    {}
-func (*EventMessage_LeaseExpired) isEventMessage_Events()     {}
-func (*EventMessage_Pending) isEventMessage_Events()          {}
-func (*EventMessage_Running) isEventMessage_Events()          {}
-func (*EventMessage_UnableToSchedule) isEventMessage_Events() {}
-func (*EventMessage_Failed) isEventMessage_Events()           {}
-func (*EventMessage_Succeeded) isEventMessage_Events()        {}
-func (*EventMessage_Reprioritized) isEventMessage_Events()    {}
-func (*EventMessage_Cancelling) isEventMessage_Events()       {}
-func (*EventMessage_Cancelled) isEventMessage_Events()        {}
-func (*EventMessage_Terminated) isEventMessage_Events()       {}
-func (*EventMessage_Utilisation) isEventMessage_Events()      {}
-func (*EventMessage_IngressInfo) isEventMessage_Events()      {}
-func (*EventMessage_Reprioritizing) isEventMessage_Events()   {}
-func (*EventMessage_Updated) isEventMessage_Events()          {}
-func (*EventMessage_FailedCompressed) isEventMessage_Events() {}
-func (*EventMessage_Preempted) isEventMessage_Events()        {}
+var xxx_messageInfo_JobSucceededEvent proto.InternalMessageInfo
 
-func (m *EventMessage) GetEvents() isEventMessage_Events {
+func (m *JobSucceededEvent) GetJobId() string {
 	if m != nil {
-		return m.Events
+		return m.JobId
 	}
-	return nil
+	return ""
 }
 
-func (m *EventMessage) GetSubmitted() *JobSubmittedEvent {
-	if x, ok := m.GetEvents().(*EventMessage_Submitted); ok {
-		return x.Submitted
+func (m *JobSucceededEvent) GetJobSetId() string {
+	if m != nil {
+		return m.JobSetId
 	}
-	return nil
+	return ""
 }
 
-func (m *EventMessage) GetQueued() *JobQueuedEvent {
-	if x, ok := m.GetEvents().(*EventMessage_Queued); ok {
-		return x.Queued
+func (m *JobSucceededEvent) GetQueue() string {
+	if m != nil {
+		return m.Queue
 	}
-	return nil
+	return ""
 }
 
-func (m *EventMessage) GetDuplicateFound() *JobDuplicateFoundEvent {
-	if x, ok := m.GetEvents().(*EventMessage_DuplicateFound); ok {
-		return x.DuplicateFound
+func (m *JobSucceededEvent) GetCreated() time.Time {
+	if m != nil {
+		return m.Created
 	}
-	return nil
+	return time.Time{}
 }
 
-func (m *EventMessage) GetLeased() *JobLeasedEvent {
-	if x, ok := m.GetEvents().(*EventMessage_Leased); ok {
-		return x.Leased
+func (m *JobSucceededEvent) GetClusterId() string {
+	if m != nil {
+		return m.ClusterId
 	}
-	return nil
+	return ""
 }
 
-func (m *EventMessage) GetLeaseReturned() *JobLeaseReturnedEvent {
-	if x, ok := m.GetEvents().(*EventMessage_LeaseReturned); ok {
-		return x.LeaseReturned
+func (m *JobSucceededEvent) GetKubernetesId() string {
+	if m != nil {
+		return m.KubernetesId
 	}
-	return nil
+	return ""
 }
 
-func (m *EventMessage) GetLeaseExpired() *JobLeaseExpiredEvent {
-	if x, ok := m.GetEvents().(*EventMessage_LeaseExpired); ok {
-		return x.LeaseExpired
+func (m *JobSucceededEvent) GetNodeName() string {
+	if m != nil {
+		return m.NodeName
 	}
-	return nil
+	return ""
 }
 
-func (m *EventMessage) GetPending() *JobPendingEvent {
-	if x, ok := m.GetEvents().(*EventMessage_Pending); ok {
-		return x.Pending
+func (m *JobSucceededEvent) GetPodNumber() int32 {
+	if m != nil {
+		return m.PodNumber
 	}
-	return nil
+	return 0
 }
 
-func (m *EventMessage) GetRunning() *JobRunningEvent {
-	if x, ok := m.GetEvents().(*EventMessage_Running); ok {
-		return x.Running
+func (m *JobSucceededEvent) GetPodName() string {
+	if m != nil {
+		return m.PodName
 	}
-	return nil
+	return ""
 }
 
-func (m *EventMessage) GetUnableToSchedule() *JobUnableToScheduleEvent {
-	if x, ok := m.GetEvents().(*EventMessage_UnableToSchedule); ok {
-		return x.UnableToSchedule
+func (m *JobSucceededEvent) GetPodNamespace() string {
+	if m != nil {
+		return m.PodNamespace
 	}
-	return nil
+	return ""
 }
 
-func (m *EventMessage) GetFailed() *JobFailedEvent {
-	if x, ok := m.GetEvents().(*EventMessage_Failed); ok {
-		return x.Failed
+type JobUtilisationEvent struct {
+	JobId                 string                       `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+	JobSetId              string                       `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
+	Queue                 string                       `protobuf:"bytes,3,opt,name=queue,proto3" json:"queue,omitempty"`
+	Created               time.Time                    `protobuf:"bytes,4,opt,name=created,proto3,stdtime" json:"created"`
+	ClusterId             string                       `protobuf:"bytes,5,opt,name=cluster_id,json=clusterId,proto3" json:"clusterId,omitempty"`
+	KubernetesId          string                       `protobuf:"bytes,6,opt,name=kubernetes_id,json=kubernetesId,proto3" json:"kubernetesId,omitempty"`
+	MaxResourcesForPeriod map[string]resource.Quantity `protobuf:"bytes,7,rep,name=MaxResourcesForPeriod,proto3" json:"MaxResourcesForPeriod" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	NodeName              string                       `protobuf:"bytes,8,opt,name=node_name,json=nodeName,proto3" json:"nodeName,omitempty"`
+	PodNumber             int32                        `protobuf:"varint,9,opt,name=pod_number,json=podNumber,proto3" json:"podNumber,omitempty"`
+	PodName               string                       `protobuf:"bytes,10,opt,name=pod_name,json=podName,proto3" json:"podName,omitempty"`
+	PodNamespace          string                       `protobuf:"bytes,11,opt,name=pod_namespace,json=podNamespace,proto3" json:"podNamespace,omitempty"`
+	TotalCumulativeUsage  map[string]resource.Quantity `protobuf:"bytes,12,rep,name=total_cumulative_usage,json=totalCumulativeUsage,proto3" json:"totalCumulativeUsage" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (m *JobUtilisationEvent) Reset()      { *m = JobUtilisationEvent{} }
+func (*JobUtilisationEvent) ProtoMessage() {}
+func (*JobUtilisationEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7758595c3bb8cf56, []int{25}
+}
+func (m *JobUtilisationEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobUtilisationEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobUtilisationEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *JobUtilisationEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobUtilisationEvent.Merge(m, src)
+}
+func (m *JobUtilisationEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobUtilisationEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobUtilisationEvent.DiscardUnknown(m)
 }
 
-func (m *EventMessage) GetSucceeded() *JobSucceededEvent {
-	if x, ok := m.GetEvents().(*EventMessage_Succeeded); ok {
-		return x.Succeeded
+var xxx_messageInfo_JobUtilisationEvent proto.InternalMessageInfo
+
+func (m *JobUtilisationEvent) GetJobId() string {
+	if m != nil {
+		return m.JobId
 	}
-	return nil
+	return ""
 }
 
-func (m *EventMessage) GetReprioritized() *JobReprioritizedEvent {
-	if x, ok := m.GetEvents().(*EventMessage_Reprioritized); ok {
-		return x.Reprioritized
+func (m *JobUtilisationEvent) GetJobSetId() string {
+	if m != nil {
+		return m.JobSetId
 	}
-	return nil
+	return ""
 }
 
-func (m *EventMessage) GetCancelling() *JobCancellingEvent {
-	if x, ok := m.GetEvents().(*EventMessage_Cancelling); ok {
-		return x.Cancelling
+func (m *JobUtilisationEvent) GetQueue() string {
+	if m != nil {
+		return m.Queue
 	}
-	return nil
+	return ""
 }
 
-func (m *EventMessage) GetCancelled() *JobCancelledEvent {
-	if x, ok := m.GetEvents().(*EventMessage_Cancelled); ok {
-		return x.Cancelled
+func (m *JobUtilisationEvent) GetCreated() time.Time {
+	if m != nil {
+		return m.Created
 	}
-	return nil
+	return time.Time{}
 }
 
-func (m *EventMessage) GetTerminated() *JobTerminatedEvent {
-	if x, ok := m.GetEvents().(*EventMessage_Terminated); ok {
-		return x.Terminated
+func (m *JobUtilisationEvent) GetClusterId() string {
+	if m != nil {
+		return m.ClusterId
 	}
-	return nil
+	return ""
 }
 
-func (m *EventMessage) GetUtilisation() *JobUtilisationEvent {
-	if x, ok := m.GetEvents().(*EventMessage_Utilisation); ok {
-		return x.Utilisation
+func (m *JobUtilisationEvent) GetKubernetesId() string {
+	if m != nil {
+		return m.KubernetesId
 	}
-	return nil
+	return ""
 }
 
-func (m *EventMessage) GetIngressInfo() *JobIngressInfoEvent {
-	if x, ok := m.GetEvents().(*EventMessage_IngressInfo); ok {
-		return x.IngressInfo
+func (m *JobUtilisationEvent) GetMaxResourcesForPeriod() map[string]resource.Quantity {
+	if m != nil {
+		return m.MaxResourcesForPeriod
 	}
 	return nil
 }
 
-func (m *EventMessage) GetReprioritizing() *JobReprioritizingEvent {
-	if x, ok := m.GetEvents().(*EventMessage_Reprioritizing); ok {
-		return x.Reprioritizing
+func (m *JobUtilisationEvent) GetNodeName() string {
+	if m != nil {
+		return m.NodeName
 	}
-	return nil
+	return ""
 }
 
-func (m *EventMessage) GetUpdated() *JobUpdatedEvent {
-	if x, ok := m.GetEvents().(*EventMessage_Updated); ok {
-		return x.Updated
+func (m *JobUtilisationEvent) GetPodNumber() int32 {
+	if m != nil {
+		return m.PodNumber
 	}
-	return nil
+	return 0
 }
 
-func (m *EventMessage) GetFailedCompressed() *JobFailedEventCompressed {
-	if x, ok := m.GetEvents().(*EventMessage_FailedCompressed); ok {
-		return x.FailedCompressed
+func (m *JobUtilisationEvent) GetPodName() string {
+	if m != nil {
+		return m.PodName
 	}
-	return nil
+	return ""
 }
 
-func (m *EventMessage) GetPreempted() *JobPreemptedEvent {
-	if x, ok := m.GetEvents().(*EventMessage_Preempted); ok {
-		return x.Preempted
+func (m *JobUtilisationEvent) GetPodNamespace() string {
+	if m != nil {
+		return m.PodNamespace
 	}
-	return nil
+	return ""
 }
 
-// XXX_OneofWrappers is for the internal use of the proto package.
-func (*EventMessage) XXX_OneofWrappers() []interface{} {
-	return []interface{}{
-		(*EventMessage_Submitted)(nil),
-		(*EventMessage_Queued)(nil),
-		(*EventMessage_DuplicateFound)(nil),
-		(*EventMessage_Leased)(nil),
-		(*EventMessage_LeaseReturned)(nil),
-		(*EventMessage_LeaseExpired)(nil),
-		(*EventMessage_Pending)(nil),
-		(*EventMessage_Running)(nil),
-		(*EventMessage_UnableToSchedule)(nil),
-		(*EventMessage_Failed)(nil),
-		(*EventMessage_Succeeded)(nil),
-		(*EventMessage_Reprioritized)(nil),
-		(*EventMessage_Cancelling)(nil),
-		(*EventMessage_Cancelled)(nil),
-		(*EventMessage_Terminated)(nil),
-		(*EventMessage_Utilisation)(nil),
-		(*EventMessage_IngressInfo)(nil),
-		(*EventMessage_Reprioritizing)(nil),
-		(*EventMessage_Updated)(nil),
-		(*EventMessage_FailedCompressed)(nil),
-		(*EventMessage_Preempted)(nil),
+func (m *JobUtilisationEvent) GetTotalCumulativeUsage() map[string]resource.Quantity {
+	if m != nil {
+		return m.TotalCumulativeUsage
 	}
+	return nil
 }
 
-type ContainerStatus struct {
-	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	ExitCode int32  `protobuf:"varint,2,opt,name=exitCode,proto3" json:"exitCode,omitempty"`
-	Message  string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
-	Reason   string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
-	Cause    Cause  `protobuf:"varint,5,opt,name=cause,proto3,enum=api.Cause" json:"cause,omitempty"`
+type JobReprioritizingEvent struct {
+	JobId       string    `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+	JobSetId    string    `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
+	Queue       string    `protobuf:"bytes,3,opt,name=queue,proto3" json:"queue,omitempty"`
+	Created     time.Time `protobuf:"bytes,4,opt,name=created,proto3,stdtime" json:"created"`
+	NewPriority float64   `protobuf:"fixed64,5,opt,name=new_priority,json=newPriority,proto3" json:"newPriority,omitempty"`
+	Requestor   string    `protobuf:"bytes,6,opt,name=requestor,proto3" json:"requestor,omitempty"`
 }
 
-func (m *ContainerStatus) Reset()      { *m = ContainerStatus{} }
-func (*ContainerStatus) ProtoMessage() {}
-func (*ContainerStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7758595c3bb8cf56, []int{22}
+func (m *JobReprioritizingEvent) Reset()      { *m = JobReprioritizingEvent{} }
+func (*JobReprioritizingEvent) ProtoMessage() {}
+func (*JobReprioritizingEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7758595c3bb8cf56, []int{26}
 }
-func (m *ContainerStatus) XXX_Unmarshal(b []byte) error {
+func (m *JobReprioritizingEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ContainerStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *JobReprioritizingEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ContainerStatus.Marshal(b, m, deterministic)
+		return xxx_messageInfo_JobReprioritizingEvent.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2429,68 +2567,80 @@ func (m *ContainerStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *ContainerStatus) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ContainerStatus.Merge(m, src)
+func (m *JobReprioritizingEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobReprioritizingEvent.Merge(m, src)
 }
-func (m *ContainerStatus) XXX_Size() int {
+func (m *JobReprioritizingEvent) XXX_Size() int {
 	return m.Size()
 }
-func (m *ContainerStatus) XXX_DiscardUnknown() {
-	xxx_messageInfo_ContainerStatus.DiscardUnknown(m)
+func (m *JobReprioritizingEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobReprioritizingEvent.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ContainerStatus proto.InternalMessageInfo
+var xxx_messageInfo_JobReprioritizingEvent proto.InternalMessageInfo
 
-func (m *ContainerStatus) GetName() string {
+func (m *JobReprioritizingEvent) GetJobId() string {
 	if m != nil {
-		return m.Name
+		return m.JobId
 	}
 	return ""
 }
 
-func (m *ContainerStatus) GetExitCode() int32 {
+func (m *JobReprioritizingEvent) GetJobSetId() string {
 	if m != nil {
-		return m.ExitCode
+		return m.JobSetId
 	}
-	return 0
+	return ""
 }
 
-func (m *ContainerStatus) GetMessage() string {
+func (m *JobReprioritizingEvent) GetQueue() string {
 	if m != nil {
-		return m.Message
+		return m.Queue
 	}
 	return ""
 }
 
-func (m *ContainerStatus) GetReason() string {
+func (m *JobReprioritizingEvent) GetCreated() time.Time {
 	if m != nil {
-		return m.Reason
+		return m.Created
 	}
-	return ""
+	return time.Time{}
 }
 
-func (m *ContainerStatus) GetCause() Cause {
+func (m *JobReprioritizingEvent) GetNewPriority() float64 {
 	if m != nil {
-		return m.Cause
+		return m.NewPriority
 	}
-	return Cause_Error
+	return 0
 }
 
-type EventList struct {
-	Events []*EventMessage `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+func (m *JobReprioritizingEvent) GetRequestor() string {
+	if m != nil {
+		return m.Requestor
+	}
+	return ""
 }
 
-func (m *EventList) Reset()      { *m = EventList{} }
-func (*EventList) ProtoMessage() {}
-func (*EventList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7758595c3bb8cf56, []int{23}
+type JobReprioritizedEvent struct {
+	JobId       string    `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+	JobSetId    string    `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
+	Queue       string    `protobuf:"bytes,3,opt,name=queue,proto3" json:"queue,omitempty"`
+	Created     time.Time `protobuf:"bytes,4,opt,name=created,proto3,stdtime" json:"created"`
+	NewPriority float64   `protobuf:"fixed64,5,opt,name=new_priority,json=newPriority,proto3" json:"newPriority,omitempty"`
+	Requestor   string    `protobuf:"bytes,6,opt,name=requestor,proto3" json:"requestor,omitempty"`
 }
-func (m *EventList) XXX_Unmarshal(b []byte) error {
+
+func (m *JobReprioritizedEvent) Reset()      { *m = JobReprioritizedEvent{} }
+func (*JobReprioritizedEvent) ProtoMessage() {}
+func (*JobReprioritizedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7758595c3bb8cf56, []int{27}
+}
+func (m *JobReprioritizedEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *JobReprioritizedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventList.Marshal(b, m, deterministic)
+		return xxx_messageInfo_JobReprioritizedEvent.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2500,99 +2650,80 @@ func (m *EventList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *EventList) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventList.Merge(m, src)
+func (m *JobReprioritizedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobReprioritizedEvent.Merge(m, src)
 }
-func (m *EventList) XXX_Size() int {
+func (m *JobReprioritizedEvent) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventList) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventList.DiscardUnknown(m)
+func (m *JobReprioritizedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobReprioritizedEvent.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventList proto.InternalMessageInfo
+var xxx_messageInfo_JobReprioritizedEvent proto.InternalMessageInfo
 
-func (m *EventList) GetEvents() []*EventMessage {
+func (m *JobReprioritizedEvent) GetJobId() string {
 	if m != nil {
-		return m.Events
+		return m.JobId
 	}
-	return nil
+	return ""
 }
 
-// swagger:model
-type EventStreamMessage struct {
-	Id      string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Message *EventMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+func (m *JobReprioritizedEvent) GetJobSetId() string {
+	if m != nil {
+		return m.JobSetId
+	}
+	return ""
 }
 
-func (m *EventStreamMessage) Reset()      { *m = EventStreamMessage{} }
-func (*EventStreamMessage) ProtoMessage() {}
-func (*EventStreamMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7758595c3bb8cf56, []int{24}
-}
-func (m *EventStreamMessage) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *EventStreamMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_EventStreamMessage.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *JobReprioritizedEvent) GetQueue() string {
+	if m != nil {
+		return m.Queue
 	}
-}
-func (m *EventStreamMessage) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventStreamMessage.Merge(m, src)
-}
-func (m *EventStreamMessage) XXX_Size() int {
-	return m.Size()
-}
-func (m *EventStreamMessage) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventStreamMessage.DiscardUnknown(m)
+	return ""
 }
 
-var xxx_messageInfo_EventStreamMessage proto.InternalMessageInfo
+func (m *JobReprioritizedEvent) GetCreated() time.Time {
+	if m != nil {
+		return m.Created
+	}
+	return time.Time{}
+}
 
-func (m *EventStreamMessage) GetId() string {
+func (m *JobReprioritizedEvent) GetNewPriority() float64 {
 	if m != nil {
-		return m.Id
+		return m.NewPriority
 	}
-	return ""
+	return 0
 }
 
-func (m *EventStreamMessage) GetMessage() *EventMessage {
+func (m *JobReprioritizedEvent) GetRequestor() string {
 	if m != nil {
-		return m.Message
+		return m.Requestor
 	}
-	return nil
+	return ""
 }
 
-// swagger:model
-type JobSetRequest struct {
-	Id             string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Watch          bool   `protobuf:"varint,2,opt,name=watch,proto3" json:"watch,omitempty"`
-	FromMessageId  string `protobuf:"bytes,3,opt,name=from_message_id,json=fromMessageId,proto3" json:"fromMessageId,omitempty"`
-	Queue          string `protobuf:"bytes,4,opt,name=queue,proto3" json:"queue,omitempty"`
-	ErrorIfMissing bool   `protobuf:"varint,5,opt,name=errorIfMissing,proto3" json:"errorIfMissing,omitempty"`
-	ForceLegacy    bool   `protobuf:"varint,6,opt,name=force_legacy,json=forceLegacy,proto3" json:"forceLegacy,omitempty"`
-	ForceNew       bool   `protobuf:"varint,7,opt,name=force_new,json=forceNew,proto3" json:"forceNew,omitempty"`
+type JobCancellingEvent struct {
+	JobId     string    `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+	JobSetId  string    `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
+	Queue     string    `protobuf:"bytes,3,opt,name=queue,proto3" json:"queue,omitempty"`
+	Created   time.Time `protobuf:"bytes,4,opt,name=created,proto3,stdtime" json:"created"`
+	Requestor string    `protobuf:"bytes,5,opt,name=requestor,proto3" json:"requestor,omitempty"`
+	Reason    string    `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (m *JobSetRequest) Reset()      { *m = JobSetRequest{} }
-func (*JobSetRequest) ProtoMessage() {}
-func (*JobSetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7758595c3bb8cf56, []int{25}
+func (m *JobCancellingEvent) Reset()      { *m = JobCancellingEvent{} }
+func (*JobCancellingEvent) ProtoMessage() {}
+func (*JobCancellingEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7758595c3bb8cf56, []int{28}
 }
-func (m *JobSetRequest) XXX_Unmarshal(b []byte) error {
+func (m *JobCancellingEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *JobSetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *JobCancellingEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_JobSetRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_JobCancellingEvent.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2602,86 +2733,80 @@ func (m *JobSetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *JobSetRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_JobSetRequest.Merge(m, src)
+func (m *JobCancellingEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobCancellingEvent.Merge(m, src)
 }
-func (m *JobSetRequest) XXX_Size() int {
+func (m *JobCancellingEvent) XXX_Size() int {
 	return m.Size()
 }
-func (m *JobSetRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_JobSetRequest.DiscardUnknown(m)
+func (m *JobCancellingEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobCancellingEvent.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_JobSetRequest proto.InternalMessageInfo
+var xxx_messageInfo_JobCancellingEvent proto.InternalMessageInfo
 
-func (m *JobSetRequest) GetId() string {
+func (m *JobCancellingEvent) GetJobId() string {
 	if m != nil {
-		return m.Id
+		return m.JobId
 	}
 	return ""
 }
 
-func (m *JobSetRequest) GetWatch() bool {
-	if m != nil {
-		return m.Watch
-	}
-	return false
-}
-
-func (m *JobSetRequest) GetFromMessageId() string {
+func (m *JobCancellingEvent) GetJobSetId() string {
 	if m != nil {
-		return m.FromMessageId
+		return m.JobSetId
 	}
 	return ""
 }
 
-func (m *JobSetRequest) GetQueue() string {
+func (m *JobCancellingEvent) GetQueue() string {
 	if m != nil {
 		return m.Queue
 	}
 	return ""
 }
 
-func (m *JobSetRequest) GetErrorIfMissing() bool {
+func (m *JobCancellingEvent) GetCreated() time.Time {
 	if m != nil {
-		return m.ErrorIfMissing
+		return m.Created
 	}
-	return false
+	return time.Time{}
 }
 
-func (m *JobSetRequest) GetForceLegacy() bool {
+func (m *JobCancellingEvent) GetRequestor() string {
 	if m != nil {
-		return m.ForceLegacy
+		return m.Requestor
 	}
-	return false
+	return ""
 }
 
-func (m *JobSetRequest) GetForceNew() bool {
+func (m *JobCancellingEvent) GetReason() string {
 	if m != nil {
-		return m.ForceNew
+		return m.Reason
 	}
-	return false
+	return ""
 }
 
-type WatchRequest struct {
-	Queue       string `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
-	JobSetId    string `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
-	FromId      string `protobuf:"bytes,3,opt,name=from_id,json=fromId,proto3" json:"fromId,omitempty"`
-	ForceLegacy bool   `protobuf:"varint,4,opt,name=force_legacy,json=forceLegacy,proto3" json:"forceLegacy,omitempty"`
-	ForceNew    bool   `protobuf:"varint,5,opt,name=force_new,json=forceNew,proto3" json:"forceNew,omitempty"`
+type JobCancelledEvent struct {
+	JobId     string    `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+	JobSetId  string    `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
+	Queue     string    `protobuf:"bytes,3,opt,name=queue,proto3" json:"queue,omitempty"`
+	Created   time.Time `protobuf:"bytes,4,opt,name=created,proto3,stdtime" json:"created"`
+	Requestor string    `protobuf:"bytes,5,opt,name=requestor,proto3" json:"requestor,omitempty"`
+	Reason    string    `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (m *WatchRequest) Reset()      { *m = WatchRequest{} }
-func (*WatchRequest) ProtoMessage() {}
-func (*WatchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7758595c3bb8cf56, []int{26}
+func (m *JobCancelledEvent) Reset()      { *m = JobCancelledEvent{} }
+func (*JobCancelledEvent) ProtoMessage() {}
+func (*JobCancelledEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7758595c3bb8cf56, []int{29}
 }
-func (m *WatchRequest) XXX_Unmarshal(b []byte) error {
+func (m *JobCancelledEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *WatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *JobCancelledEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_WatchRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_JobCancelledEvent.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2691,1874 +2816,1728 @@ func (m *WatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *WatchRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_WatchRequest.Merge(m, src)
+func (m *JobCancelledEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobCancelledEvent.Merge(m, src)
 }
-func (m *WatchRequest) XXX_Size() int {
+func (m *JobCancelledEvent) XXX_Size() int {
 	return m.Size()
 }
-func (m *WatchRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_WatchRequest.DiscardUnknown(m)
+func (m *JobCancelledEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobCancelledEvent.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_WatchRequest proto.InternalMessageInfo
+var xxx_messageInfo_JobCancelledEvent proto.InternalMessageInfo
 
-func (m *WatchRequest) GetQueue() string {
+func (m *JobCancelledEvent) GetJobId() string {
 	if m != nil {
-		return m.Queue
+		return m.JobId
 	}
 	return ""
 }
 
-func (m *WatchRequest) GetJobSetId() string {
+func (m *JobCancelledEvent) GetJobSetId() string {
 	if m != nil {
 		return m.JobSetId
 	}
 	return ""
 }
 
-func (m *WatchRequest) GetFromId() string {
+func (m *JobCancelledEvent) GetQueue() string {
 	if m != nil {
-		return m.FromId
+		return m.Queue
 	}
 	return ""
 }
 
-func (m *WatchRequest) GetForceLegacy() bool {
+func (m *JobCancelledEvent) GetCreated() time.Time {
 	if m != nil {
-		return m.ForceLegacy
+		return m.Created
 	}
-	return false
+	return time.Time{}
 }
 
-func (m *WatchRequest) GetForceNew() bool {
+func (m *JobCancelledEvent) GetRequestor() string {
 	if m != nil {
-		return m.ForceNew
+		return m.Requestor
 	}
-	return false
-}
-
-func init() {
-	proto.RegisterEnum("api.Cause", Cause_name, Cause_value)
-	proto.RegisterType((*JobSubmittedEvent)(nil), "api.JobSubmittedEvent")
-	proto.RegisterType((*JobQueuedEvent)(nil), "api.JobQueuedEvent")
-	proto.RegisterType((*JobDuplicateFoundEvent)(nil), "api.JobDuplicateFoundEvent")
-	proto.RegisterType((*JobLeasedEvent)(nil), "api.JobLeasedEvent")
-	proto.RegisterType((*JobLeaseReturnedEvent)(nil), "api.JobLeaseReturnedEvent")
-	proto.RegisterType((*JobLeaseExpiredEvent)(nil), "api.JobLeaseExpiredEvent")
-	proto.RegisterType((*JobPendingEvent)(nil), "api.JobPendingEvent")
-	proto.RegisterType((*JobRunningEvent)(nil), "api.JobRunningEvent")
-	proto.RegisterType((*JobIngressInfoEvent)(nil), "api.JobIngressInfoEvent")
-	proto.RegisterMapType((map[int32]string)(nil), "api.JobIngressInfoEvent.IngressAddressesEntry")
-	proto.RegisterType((*JobUnableToScheduleEvent)(nil), "api.JobUnableToScheduleEvent")
-	proto.RegisterType((*JobFailedEvent)(nil), "api.JobFailedEvent")
-	proto.RegisterMapType((map[string]int32)(nil), "api.JobFailedEvent.ExitCodesEntry")
-	proto.RegisterType((*JobPreemptedEvent)(nil), "api.JobPreemptedEvent")
-	proto.RegisterType((*JobFailedEventCompressed)(nil), "api.JobFailedEventCompressed")
-	proto.RegisterType((*JobSucceededEvent)(nil), "api.JobSucceededEvent")
-	proto.RegisterType((*JobUtilisationEvent)(nil), "api.JobUtilisationEvent")
-	proto.RegisterMapType((map[string]resource.Quantity)(nil), "api.JobUtilisationEvent.MaxResourcesForPeriodEntry")
-	proto.RegisterMapType((map[string]resource.Quantity)(nil), "api.JobUtilisationEvent.TotalCumulativeUsageEntry")
-	proto.RegisterType((*JobReprioritizingEvent)(nil), "api.JobReprioritizingEvent")
-	proto.RegisterType((*JobReprioritizedEvent)(nil), "api.JobReprioritizedEvent")
-	proto.RegisterType((*JobCancellingEvent)(nil), "api.JobCancellingEvent")
-	proto.RegisterType((*JobCancelledEvent)(nil), "api.JobCancelledEvent")
-	proto.RegisterType((*JobTerminatedEvent)(nil), "api.JobTerminatedEvent")
-	proto.RegisterType((*JobUpdatedEvent)(nil), "api.JobUpdatedEvent")
-	proto.RegisterType((*EventMessage)(nil), "api.EventMessage")
-	proto.RegisterType((*ContainerStatus)(nil), "api.ContainerStatus")
-	proto.RegisterType((*EventList)(nil), "api.EventList")
-	proto.RegisterType((*EventStreamMessage)(nil), "api.EventStreamMessage")
-	proto.RegisterType((*JobSetRequest)(nil), "api.JobSetRequest")
-	proto.RegisterType((*WatchRequest)(nil), "api.WatchRequest")
+	return ""
 }
 
-func init() { proto.RegisterFile("pkg/api/event.proto", fileDescriptor_7758595c3bb8cf56) }
-
-var fileDescriptor_7758595c3bb8cf56 = []byte{
-	// 2578 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5b, 0x4d, 0x6c, 0x1b, 0xc7,
-	0xf5, 0xd7, 0x52, 0xe2, 0xd7, 0x48, 0xa2, 0xa4, 0xd1, 0x87, 0xd7, 0xb4, 0x2d, 0x0a, 0x0c, 0xf0,
-	0x8f, 0x62, 0xc4, 0x64, 0xfe, 0x72, 0x52, 0x18, 0x46, 0xd0, 0xc0, 0x94, 0xe5, 0x44, 0x82, 0x1d,
-	0x3b, 0x94, 0x8d, 0xb4, 0x45, 0x00, 0x66, 0xb9, 0x3b, 0xa2, 0x56, 0x22, 0x77, 0x36, 0xbb, 0xb3,
-	0xb6, 0x15, 0x23, 0x40, 0xd1, 0xa2, 0x45, 0x2e, 0x45, 0x53, 0xb4, 0xf7, 0xe4, 0xdc, 0x5e, 0x7a,
-	0xe9, 0xb5, 0x87, 0xa2, 0x87, 0xf4, 0xe6, 0xa2, 0x28, 0x90, 0x13, 0xdb, 0xda, 0x09, 0x50, 0xf0,
-	0xd0, 0x7b, 0x6f, 0xc5, 0xbc, 0x99, 0x25, 0x67, 0x56, 0x14, 0x24, 0x2b, 0x4d, 0x6a, 0x08, 0xba,
-	0x24, 0xe6, 0xef, 0xcd, 0x7b, 0xf3, 0xf6, 0xcd, 0xef, 0xcd, 0xbc, 0xf9, 0x10, 0x9a, 0xf5, 0x77,
-	0x5b, 0x55, 0xcb, 0x77, 0xab, 0xe4, 0x3e, 0xf1, 0x58, 0xc5, 0x0f, 0x28, 0xa3, 0x78, 0xd4, 0xf2,
-	0xdd, 0x62, 0xa9, 0x45, 0x69, 0xab, 0x4d, 0xaa, 0x00, 0x35, 0xa3, 0xad, 0x2a, 0x73, 0x3b, 0x24,
-	0x64, 0x56, 0xc7, 0x17, 0xad, 0x8a, 0x7d, 0xd5, 0x0f, 0x22, 0x12, 0x11, 0x09, 0xce, 0xc5, 0xe0,
-	0x36, 0xb1, 0xda, 0x6c, 0x5b, 0xa2, 0xe7, 0x92, 0xb6, 0x48, 0xc7, 0x67, 0x7b, 0x52, 0x78, 0xa9,
-	0xe5, 0xb2, 0xed, 0xa8, 0x59, 0xb1, 0x69, 0xa7, 0xda, 0xa2, 0x2d, 0x3a, 0x68, 0xc5, 0x7f, 0xc1,
-	0x0f, 0xf8, 0x97, 0x6c, 0x7e, 0x5e, 0xda, 0xe2, 0x9d, 0x58, 0x9e, 0x47, 0x99, 0xc5, 0x5c, 0xea,
-	0x85, 0x52, 0xfa, 0xea, 0xee, 0x95, 0xb0, 0xe2, 0x52, 0x2e, 0xed, 0x58, 0xf6, 0xb6, 0xeb, 0x91,
-	0x60, 0xaf, 0x1a, 0xfb, 0x14, 0x90, 0x90, 0x46, 0x81, 0x4d, 0xaa, 0x2d, 0xe2, 0x91, 0xc0, 0x62,
-	0xc4, 0x11, 0x5a, 0xe5, 0x5f, 0xa5, 0xd0, 0xcc, 0x06, 0x6d, 0x6e, 0x46, 0xcd, 0x8e, 0xcb, 0x18,
-	0x71, 0xd6, 0x78, 0x30, 0xf0, 0x45, 0x94, 0xd9, 0xa1, 0xcd, 0x86, 0xeb, 0x98, 0xc6, 0x92, 0xb1,
-	0x9c, 0xaf, 0xcd, 0xf6, 0xba, 0xa5, 0xa9, 0x1d, 0xda, 0x5c, 0x77, 0x5e, 0xa6, 0x1d, 0x97, 0xc1,
-	0x37, 0xd4, 0xd3, 0x00, 0xe0, 0x57, 0x11, 0xe2, 0x6d, 0x43, 0xc2, 0x78, 0xfb, 0x14, 0xb4, 0x5f,
-	0xe8, 0x75, 0x4b, 0x78, 0x87, 0x36, 0x37, 0x09, 0xd3, 0x54, 0x72, 0x31, 0x86, 0x5f, 0x42, 0x69,
-	0x08, 0x9e, 0x39, 0x3a, 0xe8, 0x00, 0x00, 0xb5, 0x03, 0x00, 0xf0, 0x3a, 0xca, 0xda, 0x01, 0xe1,
-	0x3e, 0x9b, 0x63, 0x4b, 0xc6, 0xf2, 0xf8, 0x4a, 0xb1, 0x22, 0x02, 0x51, 0x89, 0xc3, 0x55, 0xb9,
-	0x1b, 0x0f, 0x50, 0x6d, 0xf6, 0xf3, 0x6e, 0x69, 0xa4, 0xd7, 0x2d, 0xc5, 0x2a, 0x9f, 0xfc, 0xad,
-	0x64, 0xd4, 0xe3, 0x1f, 0xf8, 0x45, 0x34, 0xba, 0x43, 0x9b, 0x66, 0x1a, 0xcc, 0xe4, 0x2a, 0x96,
-	0xef, 0x56, 0x36, 0x68, 0xb3, 0x36, 0x2e, 0x95, 0xb8, 0xb0, 0xce, 0xff, 0x53, 0xfe, 0xa7, 0x81,
-	0x0a, 0x1b, 0xb4, 0xf9, 0x0e, 0x77, 0xe0, 0x64, 0xc7, 0xa4, 0xfc, 0xbb, 0x14, 0x5a, 0xd8, 0xa0,
-	0xcd, 0xeb, 0x91, 0xdf, 0x76, 0x6d, 0x8b, 0x91, 0x1b, 0x34, 0xf2, 0x4e, 0x38, 0x0d, 0x56, 0xd1,
-	0x14, 0x0d, 0xdc, 0x96, 0xeb, 0x59, 0xed, 0x86, 0xfc, 0xc0, 0x34, 0xf4, 0x7f, 0xae, 0xd7, 0x2d,
-	0x9d, 0x89, 0x45, 0x1b, 0x89, 0x0f, 0x9d, 0xd4, 0x04, 0xe5, 0xcf, 0x52, 0x40, 0x91, 0x9b, 0xc4,
-	0x0a, 0x4f, 0x7a, 0xda, 0x7c, 0x07, 0x21, 0xbb, 0x1d, 0x85, 0x8c, 0x04, 0x83, 0x50, 0x9d, 0xe9,
-	0x75, 0x4b, 0xb3, 0x12, 0xd5, 0x9c, 0xcd, 0xf7, 0xc1, 0xf2, 0xcf, 0xc7, 0xd0, 0x7c, 0x1c, 0xa2,
-	0x3a, 0x61, 0x51, 0xe0, 0x9d, 0x46, 0x6a, 0x68, 0xa4, 0xf0, 0xcb, 0x28, 0x13, 0x10, 0x2b, 0xa4,
-	0x9e, 0x99, 0x01, 0x9d, 0xb9, 0x5e, 0xb7, 0x34, 0x2d, 0x10, 0x45, 0x41, 0xb6, 0xc1, 0x6f, 0xa0,
-	0xc9, 0xdd, 0xa8, 0x49, 0x02, 0x8f, 0x30, 0x12, 0xf2, 0x8e, 0xb2, 0xa0, 0x54, 0xec, 0x75, 0x4b,
-	0x0b, 0x03, 0x81, 0xd6, 0xd7, 0x84, 0x8a, 0x73, 0x37, 0x7d, 0xea, 0x34, 0xbc, 0xa8, 0xd3, 0x24,
-	0x81, 0x99, 0x5b, 0x32, 0x96, 0xd3, 0xc2, 0x4d, 0x9f, 0x3a, 0x6f, 0x03, 0xa8, 0xba, 0xd9, 0x07,
-	0x79, 0xc7, 0x41, 0xe4, 0x35, 0x2c, 0x06, 0x22, 0xe2, 0x98, 0xf9, 0x25, 0x63, 0x39, 0x27, 0x3a,
-	0x0e, 0x22, 0xef, 0x5a, 0x8c, 0xab, 0x1d, 0xab, 0x78, 0xf9, 0x5f, 0x06, 0x9a, 0x8b, 0x19, 0xb1,
-	0xf6, 0xd0, 0x77, 0x83, 0x93, 0x3e, 0xbb, 0xfe, 0x6c, 0x0c, 0x4d, 0x6d, 0xd0, 0xe6, 0x1d, 0xe2,
-	0x39, 0xae, 0xd7, 0x3a, 0x25, 0xff, 0x30, 0xf2, 0xef, 0xa3, 0x73, 0xe6, 0x6b, 0xd1, 0x39, 0x7b,
-	0x64, 0x3a, 0xbf, 0x82, 0x72, 0xa0, 0x67, 0x75, 0x08, 0x24, 0x41, 0xbe, 0x36, 0xdf, 0xeb, 0x96,
-	0x66, 0x78, 0x03, 0xab, 0xa3, 0xc6, 0x2a, 0x2b, 0x21, 0xee, 0x6a, 0xac, 0x11, 0xfa, 0x96, 0x4d,
-	0x20, 0x01, 0xa4, 0xab, 0xb2, 0x0d, 0xe0, 0xaa, 0xab, 0x2a, 0x5e, 0xfe, 0x83, 0xe0, 0x43, 0x3d,
-	0xf2, 0xbc, 0x53, 0x3e, 0x7c, 0x53, 0x7c, 0xb8, 0x8c, 0xf2, 0x1e, 0x75, 0x88, 0x18, 0xd8, 0xec,
-	0x20, 0x46, 0x1c, 0x4c, 0x8c, 0x6c, 0x2e, 0xc6, 0x8e, 0x3d, 0x27, 0xaa, 0x24, 0xca, 0x1f, 0x8f,
-	0x44, 0xe8, 0x19, 0x49, 0xf4, 0xdb, 0x0c, 0x9a, 0xe5, 0x45, 0x88, 0xd7, 0x0a, 0x48, 0x18, 0xae,
-	0x7b, 0x5b, 0xf4, 0x94, 0x48, 0x27, 0x8b, 0x48, 0xe8, 0x78, 0x44, 0x1a, 0x7f, 0x36, 0x22, 0xe1,
-	0x47, 0x68, 0xc6, 0x15, 0x24, 0x6a, 0x58, 0x8e, 0xc3, 0xff, 0x4f, 0x42, 0x33, 0xbf, 0x34, 0xba,
-	0x3c, 0xbe, 0x52, 0x89, 0x77, 0x47, 0x49, 0x96, 0x55, 0x24, 0x70, 0x2d, 0x56, 0x58, 0xf3, 0x58,
-	0xb0, 0x57, 0x5b, 0xec, 0x75, 0x4b, 0x45, 0x37, 0x21, 0x52, 0x3a, 0x9e, 0x4e, 0xca, 0x8a, 0xbb,
-	0x68, 0x7e, 0xa8, 0x29, 0xfc, 0x02, 0x1a, 0xdd, 0x25, 0x7b, 0xc0, 0xe1, 0x74, 0x6d, 0xa6, 0xd7,
-	0x2d, 0x4d, 0xee, 0x92, 0x3d, 0xc5, 0x14, 0x97, 0x72, 0x26, 0xde, 0xb7, 0xda, 0x11, 0x91, 0xd4,
-	0x05, 0x26, 0x02, 0xa0, 0x32, 0x11, 0x80, 0xab, 0xa9, 0x2b, 0x46, 0xf9, 0xdf, 0x63, 0xc8, 0xdc,
-	0xa0, 0xcd, 0x7b, 0x9e, 0xd5, 0x6c, 0x93, 0xbb, 0x74, 0xd3, 0xde, 0x26, 0x4e, 0xd4, 0x26, 0xa7,
-	0x79, 0xf3, 0x1c, 0x54, 0xa3, 0x5a, 0x96, 0xe5, 0x8e, 0x95, 0x65, 0xf9, 0xe7, 0x38, 0xcb, 0xca,
-	0x8f, 0xb3, 0xb0, 0x53, 0xbc, 0x61, 0xb9, 0xed, 0xd3, 0xfd, 0xcf, 0x7f, 0x83, 0x71, 0xef, 0x21,
-	0x44, 0x1e, 0xba, 0xac, 0x61, 0x53, 0x87, 0x84, 0x66, 0x16, 0xe6, 0xab, 0x72, 0x3c, 0x5f, 0x29,
-	0x61, 0xae, 0xac, 0x3d, 0x74, 0xd9, 0x2a, 0x6f, 0x24, 0xe6, 0xa8, 0xb3, 0xdc, 0x13, 0x12, 0x63,
-	0x03, 0xc3, 0xa6, 0x51, 0xcf, 0xf7, 0xe1, 0xfd, 0x7c, 0xce, 0x7d, 0x1d, 0x3e, 0xe7, 0x8f, 0xc5,
-	0x67, 0x74, 0x2c, 0x3e, 0x4f, 0x1e, 0x8f, 0xcf, 0x85, 0x67, 0x5c, 0x35, 0x1c, 0x84, 0x6d, 0xea,
-	0x31, 0xcb, 0xf5, 0x48, 0xd0, 0x08, 0x99, 0xc5, 0x22, 0xbe, 0x6c, 0x8c, 0xc3, 0x30, 0xcc, 0xc1,
-	0x30, 0xac, 0xc6, 0xe2, 0x4d, 0x90, 0xd6, 0x4a, 0xbd, 0x6e, 0xe9, 0x9c, 0xad, 0x83, 0xda, 0xea,
-	0x30, 0xb3, 0x4f, 0x88, 0x5f, 0x43, 0x69, 0xdb, 0x8a, 0x42, 0x62, 0x4e, 0x2c, 0x19, 0xcb, 0x85,
-	0x15, 0x24, 0x0c, 0x73, 0x44, 0x90, 0x19, 0x84, 0x2a, 0x99, 0x01, 0x28, 0x3a, 0xa8, 0xa0, 0x8f,
-	0xba, 0xba, 0x9c, 0xe4, 0x8f, 0xb6, 0x9c, 0xa4, 0x0f, 0x5d, 0x4e, 0xbe, 0x1a, 0x85, 0x63, 0xd3,
-	0x3b, 0x01, 0x11, 0x1b, 0xdb, 0xd3, 0xac, 0x1e, 0x96, 0xd5, 0x17, 0x51, 0x26, 0x88, 0xbc, 0x41,
-	0xe1, 0x05, 0xee, 0x06, 0x91, 0xa7, 0xc7, 0x03, 0x00, 0xbc, 0x8e, 0x66, 0x7c, 0x11, 0x4d, 0xf7,
-	0x3e, 0x89, 0x4f, 0xe5, 0xc4, 0x4a, 0x72, 0xa1, 0xd7, 0x2d, 0x9d, 0x1d, 0x08, 0x93, 0xe7, 0x72,
-	0x53, 0x09, 0x51, 0xc2, 0x94, 0xf4, 0x20, 0x37, 0xcc, 0x54, 0x3d, 0xe1, 0xcb, 0x54, 0x42, 0x54,
-	0x5e, 0x83, 0xaa, 0x41, 0x99, 0x52, 0x56, 0x69, 0xc7, 0x87, 0x5a, 0x05, 0xc6, 0x02, 0xae, 0x0e,
-	0x60, 0xb0, 0x27, 0xc4, 0xc7, 0x01, 0xa0, 0x7e, 0x1c, 0x00, 0xe5, 0x3f, 0x8e, 0xc9, 0x53, 0x76,
-	0xdb, 0x26, 0xc4, 0x39, 0xa5, 0xcb, 0xe9, 0xbe, 0xef, 0x58, 0xfb, 0xbe, 0x4f, 0xf3, 0xb0, 0xef,
-	0xbb, 0xc7, 0xdc, 0xb6, 0x1b, 0xc2, 0xe5, 0xcf, 0x29, 0x91, 0xbe, 0x11, 0x22, 0x7d, 0x6c, 0xa0,
-	0xf9, 0x5b, 0xd6, 0xc3, 0xba, 0xbc, 0x35, 0x0b, 0x6f, 0xd0, 0xe0, 0x0e, 0x09, 0x5c, 0xea, 0xc8,
-	0x62, 0xe3, 0x72, 0x5c, 0x6c, 0x24, 0x87, 0xa2, 0x32, 0x54, 0x4b, 0x54, 0x1f, 0x17, 0xe4, 0xb7,
-	0x0e, 0xb7, 0x5c, 0x1f, 0x0e, 0x9f, 0xf4, 0xe2, 0x18, 0xff, 0xd4, 0x40, 0x0b, 0x8c, 0x32, 0xab,
-	0xdd, 0xb0, 0xa3, 0x4e, 0xd4, 0xb6, 0x60, 0xce, 0x8e, 0x42, 0xab, 0xc5, 0x17, 0x7e, 0x1e, 0xeb,
-	0x95, 0x03, 0x63, 0x7d, 0x97, 0xab, 0xad, 0xf6, 0xb5, 0xee, 0x71, 0x25, 0x11, 0xea, 0xf3, 0x32,
-	0xd4, 0x73, 0x6c, 0x48, 0x93, 0xfa, 0x50, 0xb4, 0xf8, 0x99, 0x81, 0x8a, 0x07, 0x8f, 0xde, 0xd1,
-	0xaa, 0x88, 0xef, 0xab, 0x55, 0x04, 0xdf, 0x43, 0x8b, 0x3b, 0xd9, 0x8a, 0x7a, 0x27, 0x5b, 0xf1,
-	0x77, 0x5b, 0xf0, 0x49, 0xf1, 0x9d, 0x6c, 0xe5, 0x9d, 0xc8, 0xf2, 0x98, 0xcb, 0xf6, 0x0e, 0xab,
-	0x3a, 0x8a, 0x9f, 0x1a, 0xe8, 0xec, 0x81, 0x1f, 0xfd, 0x3c, 0x78, 0x58, 0xfe, 0x4a, 0x5c, 0x26,
-	0xd6, 0x89, 0x1f, 0xb8, 0x34, 0x70, 0x99, 0xfb, 0xe1, 0x89, 0x3f, 0xe5, 0x7c, 0x1d, 0x4d, 0x78,
-	0xe4, 0x41, 0x43, 0x7e, 0xf0, 0x1e, 0x4c, 0x53, 0x06, 0x6c, 0x35, 0xe6, 0x3d, 0xf2, 0xe0, 0x8e,
-	0x84, 0x15, 0x17, 0xc6, 0x15, 0x18, 0xbf, 0x86, 0xf2, 0x01, 0xf9, 0x20, 0x22, 0x21, 0xa3, 0x81,
-	0x9c, 0xa6, 0x20, 0x51, 0xfb, 0xa0, 0x9a, 0xa8, 0x7d, 0xb0, 0xfc, 0x65, 0x0a, 0x6e, 0xd6, 0x94,
-	0x38, 0x9f, 0xf4, 0xa2, 0xe2, 0x7f, 0x12, 0xe6, 0x3f, 0xa7, 0x10, 0xde, 0xa0, 0xcd, 0x55, 0xcb,
-	0xb3, 0x49, 0xbb, 0x7d, 0xe2, 0xa9, 0xac, 0x45, 0x29, 0x7d, 0xd4, 0x28, 0x3d, 0xdb, 0xe6, 0xbd,
-	0xfc, 0x58, 0xbc, 0x38, 0x91, 0x31, 0x3d, 0xe9, 0xb4, 0xfd, 0x56, 0x42, 0xfa, 0xfb, 0x31, 0xa0,
-	0xe9, 0x5d, 0x12, 0x74, 0x5c, 0xcf, 0x3a, 0xdd, 0x8e, 0x3e, 0xcf, 0xf7, 0x8c, 0xdf, 0xce, 0x56,
-	0x41, 0x21, 0x50, 0xee, 0x08, 0x04, 0xfa, 0x53, 0x0a, 0x6e, 0x25, 0xef, 0xf9, 0xce, 0xc9, 0x67,
-	0xcf, 0x31, 0x33, 0x52, 0x3e, 0x1d, 0xcb, 0x1c, 0xfa, 0x74, 0xec, 0x37, 0x05, 0x34, 0x01, 0x11,
-	0xbc, 0x45, 0x42, 0x5e, 0x9c, 0xe1, 0xdb, 0x28, 0x1f, 0xc6, 0xcf, 0xeb, 0x20, 0x96, 0xe3, 0x2b,
-	0x0b, 0xb1, 0xbe, 0xfe, 0xee, 0x4e, 0x38, 0xd2, 0x6f, 0x3c, 0x70, 0xe4, 0xad, 0x91, 0xfa, 0xc0,
-	0x06, 0x5e, 0x45, 0x19, 0x88, 0x8a, 0x23, 0x8b, 0xb8, 0xd9, 0xd8, 0x9a, 0xf2, 0x5c, 0x4d, 0x0c,
-	0xb8, 0x68, 0xa6, 0xd9, 0x91, 0xaa, 0xd8, 0x41, 0x53, 0x4e, 0xfc, 0xe4, 0xab, 0xb1, 0x45, 0x23,
-	0xcf, 0x31, 0xa7, 0xc1, 0xda, 0xb9, 0xd8, 0xda, 0x90, 0x17, 0x61, 0xb5, 0xf3, 0xbd, 0x6e, 0xc9,
-	0x74, 0x34, 0x81, 0x66, 0xbd, 0xa0, 0xcb, 0xb8, 0xab, 0x6d, 0x78, 0x20, 0x05, 0x63, 0xac, 0xb8,
-	0xaa, 0x3c, 0x9b, 0x12, 0xae, 0x8a, 0x66, 0xba, 0xab, 0x02, 0xc3, 0xef, 0xa3, 0x02, 0xfc, 0xab,
-	0x11, 0xc8, 0x37, 0x44, 0x7d, 0x0e, 0xa8, 0xc6, 0xb4, 0x07, 0x46, 0xe2, 0x25, 0x57, 0x5b, 0xc5,
-	0x35, 0xd3, 0x93, 0x9a, 0x08, 0xbf, 0x87, 0x04, 0xd0, 0x20, 0xe2, 0x4d, 0x8a, 0x7c, 0x21, 0x78,
-	0x56, 0xeb, 0x40, 0x7d, 0xaf, 0x22, 0x32, 0xb1, 0xad, 0xc0, 0x9a, 0xf9, 0x09, 0x55, 0x82, 0xdf,
-	0x44, 0x59, 0x5f, 0xbc, 0xff, 0x90, 0xf4, 0x99, 0x8b, 0xed, 0xaa, 0xcf, 0x42, 0xe4, 0x9c, 0x20,
-	0x10, 0xcd, 0x5a, 0xac, 0xcd, 0x0d, 0x05, 0xe2, 0xe1, 0x00, 0x4c, 0x3e, 0x8a, 0x21, 0xf5, 0x3d,
-	0x81, 0x30, 0x24, 0x1b, 0xea, 0x86, 0x24, 0x88, 0x3b, 0x08, 0x47, 0x70, 0x13, 0xd6, 0x60, 0xb4,
-	0x11, 0xca, 0xbb, 0x30, 0x98, 0x29, 0xc6, 0x57, 0x2e, 0xf4, 0xf7, 0x5b, 0xc3, 0xee, 0xca, 0xc4,
-	0x3d, 0x5f, 0x94, 0x10, 0x69, 0xbd, 0x4c, 0x27, 0xa5, 0x9c, 0x05, 0x5b, 0x70, 0x84, 0x06, 0xb3,
-	0x9f, 0xc2, 0x02, 0xe5, 0x60, 0x4d, 0xb0, 0x40, 0x34, 0xd3, 0x59, 0x20, 0x30, 0x91, 0x46, 0xf2,
-	0xfc, 0x0c, 0xa6, 0x43, 0x2d, 0x8d, 0xd4, 0x83, 0xb5, 0x38, 0x8d, 0x24, 0x96, 0x4c, 0x23, 0x09,
-	0xe3, 0x06, 0x9a, 0x0c, 0xd4, 0xfa, 0x19, 0xb6, 0xae, 0x0a, 0xab, 0xf6, 0x17, 0xd7, 0x82, 0x55,
-	0x9a, 0x92, 0xce, 0x2a, 0x4d, 0x84, 0x37, 0x11, 0xb2, 0xfb, 0x95, 0x23, 0x1c, 0x63, 0x8f, 0xaf,
-	0x9c, 0x89, 0xad, 0x27, 0x6a, 0xca, 0x9a, 0xc9, 0xb7, 0xab, 0x83, 0xe6, 0x9a, 0x5d, 0xc5, 0x0c,
-	0x0f, 0x83, 0x1d, 0x97, 0x4e, 0x70, 0xe0, 0xaf, 0x84, 0x41, 0xaf, 0xa9, 0xe4, 0x9a, 0x18, 0x63,
-	0x7a, 0x18, 0xfa, 0x30, 0xf7, 0x92, 0xf5, 0x0b, 0x07, 0xb8, 0x0b, 0x50, 0xbc, 0x4c, 0x94, 0x14,
-	0xc2, 0xcb, 0x41, 0x73, 0xdd, 0xcb, 0x01, 0x8e, 0xdf, 0x45, 0xe3, 0xd1, 0x60, 0xbb, 0x6e, 0x4e,
-	0x81, 0x55, 0xf3, 0xa0, 0x9d, 0xbc, 0x28, 0xe3, 0x15, 0x05, 0xcd, 0xae, 0x6a, 0x09, 0x7f, 0x0f,
-	0x4d, 0xc4, 0x37, 0xd6, 0xae, 0xb7, 0x45, 0xcd, 0x19, 0xdd, 0x72, 0xf2, 0xb2, 0x5a, 0x58, 0x76,
-	0x07, 0xa8, 0x6e, 0x59, 0x11, 0x60, 0x1b, 0x15, 0x02, 0x6d, 0xdb, 0x6a, 0x62, 0x7d, 0x3e, 0x1c,
-	0xb2, 0xa9, 0x15, 0xf3, 0xa1, 0xae, 0xa6, 0xcf, 0x87, 0xba, 0x8c, 0x67, 0x70, 0x24, 0x16, 0x59,
-	0x73, 0x56, 0xcf, 0x60, 0x75, 0xed, 0x15, 0x19, 0x2c, 0x1b, 0xea, 0x19, 0x2c, 0x41, 0xbc, 0x8b,
-	0x64, 0xae, 0x0c, 0x0e, 0xa4, 0xcd, 0x39, 0x3d, 0x7f, 0x87, 0x9e, 0x5a, 0x8b, 0xfc, 0x4d, 0xaa,
-	0xea, 0xf9, 0x9b, 0x94, 0x72, 0xce, 0xf9, 0xf1, 0x4d, 0x87, 0x39, 0xaf, 0x73, 0x4e, 0xbf, 0x02,
-	0x91, 0xe5, 0x50, 0x8c, 0xe9, 0x9c, 0xeb, 0xc3, 0xb5, 0x1c, 0xca, 0xc0, 0xc1, 0x78, 0x58, 0xfe,
-	0x71, 0x0a, 0x4d, 0x25, 0x6e, 0x8b, 0xf0, 0xff, 0xa1, 0x31, 0x28, 0x95, 0x44, 0xdd, 0x81, 0x7b,
-	0xdd, 0x52, 0xc1, 0xd3, 0xeb, 0x24, 0x90, 0xe3, 0x15, 0x94, 0x8b, 0x6f, 0xed, 0xe4, 0xb5, 0x0d,
-	0xd4, 0x1c, 0x31, 0xa6, 0xd6, 0x1c, 0x31, 0x86, 0xab, 0x28, 0xdb, 0x11, 0xeb, 0xb2, 0xac, 0x3a,
-	0x20, 0xd4, 0x12, 0x52, 0x2b, 0x31, 0x09, 0x29, 0x85, 0xd4, 0xd8, 0x11, 0x6e, 0x26, 0xfb, 0x97,
-	0x56, 0xe9, 0x67, 0xb9, 0xb4, 0x2a, 0xdf, 0x44, 0x79, 0x08, 0xdf, 0x4d, 0x37, 0x64, 0xf8, 0x8d,
-	0x38, 0x38, 0xa6, 0x01, 0x07, 0x60, 0x33, 0x60, 0x44, 0x2d, 0x29, 0x84, 0x13, 0xa2, 0x91, 0xea,
-	0x84, 0x8c, 0xe9, 0x87, 0x08, 0x43, 0xeb, 0x4d, 0x16, 0x10, 0xab, 0x13, 0x97, 0x21, 0x4b, 0x28,
-	0xd5, 0xaf, 0xe5, 0xa6, 0x7b, 0xdd, 0xd2, 0x84, 0xab, 0x56, 0x65, 0x29, 0xd7, 0xc1, 0xb5, 0x41,
-	0x6c, 0x44, 0x61, 0x31, 0xa4, 0xe7, 0x43, 0xc2, 0x55, 0xfe, 0xc9, 0x28, 0x9a, 0xdc, 0x80, 0x02,
-	0xaf, 0x2e, 0x4a, 0xa7, 0x23, 0xf4, 0xfb, 0x12, 0x4a, 0x3f, 0xb0, 0x98, 0xbd, 0x0d, 0xbd, 0xe6,
-	0x44, 0xa0, 0x00, 0x50, 0x03, 0x05, 0x00, 0x5e, 0x45, 0x53, 0x5b, 0x01, 0xed, 0x34, 0x64, 0x77,
-	0xbc, 0xda, 0x1c, 0x1d, 0xbc, 0xdc, 0xe6, 0x22, 0xe9, 0xa8, 0xfe, 0x72, 0x5b, 0x13, 0x0c, 0xea,
-	0xce, 0xb1, 0x43, 0xeb, 0xce, 0xeb, 0xa8, 0x40, 0x82, 0x80, 0x06, 0xeb, 0x5b, 0xb7, 0xdc, 0x30,
-	0xe4, 0x93, 0x42, 0x1a, 0x7c, 0x84, 0xbc, 0xd7, 0x25, 0x8a, 0x72, 0x42, 0x07, 0xbf, 0x8e, 0x26,
-	0xb6, 0x68, 0x60, 0x93, 0x46, 0x9b, 0xb4, 0x2c, 0x7b, 0x0f, 0xaa, 0x80, 0x9c, 0x98, 0x9a, 0x00,
-	0xbf, 0x09, 0xb0, 0x7a, 0x76, 0xa1, 0xc0, 0xf8, 0x32, 0xca, 0x0b, 0x6d, 0x8f, 0x3c, 0x80, 0x75,
-	0x3f, 0x27, 0x78, 0x0e, 0xe0, 0xdb, 0xe4, 0x81, 0xca, 0xf3, 0x18, 0x2b, 0xff, 0x22, 0x85, 0x26,
-	0xde, 0xe5, 0x21, 0x8b, 0x87, 0xa1, 0xff, 0xd1, 0xc6, 0xa1, 0x1f, 0x7d, 0xbc, 0x6a, 0xfe, 0x12,
-	0xca, 0xc2, 0xd0, 0xf4, 0x87, 0x44, 0x2c, 0xe8, 0x01, 0xed, 0x68, 0x0a, 0x19, 0x81, 0xec, 0x8b,
-	0xc9, 0xd8, 0xf1, 0x63, 0x92, 0x3e, 0x5a, 0x4c, 0x2e, 0x7e, 0x17, 0xa5, 0x21, 0x15, 0x71, 0x1e,
-	0xa5, 0xd7, 0xf8, 0x08, 0x4d, 0x8f, 0xe0, 0x71, 0x94, 0x5d, 0xbb, 0xef, 0xda, 0x8c, 0x38, 0xd3,
-	0x06, 0xce, 0xa2, 0xd1, 0xdb, 0xb7, 0x6f, 0x4d, 0xa7, 0xf0, 0x1c, 0x9a, 0xbe, 0x4e, 0x2c, 0xa7,
-	0xed, 0x7a, 0x64, 0xed, 0xa1, 0x28, 0x17, 0xa6, 0x47, 0x57, 0xfe, 0x9a, 0x42, 0x69, 0xb1, 0x37,
-	0xba, 0x82, 0x0a, 0x75, 0xe2, 0xd3, 0x80, 0xdd, 0x8a, 0xda, 0xcc, 0xf5, 0xdb, 0x04, 0x17, 0x06,
-	0xa9, 0xc2, 0x93, 0xb8, 0xb8, 0xb0, 0x6f, 0x7f, 0xb2, 0xc6, 0xbd, 0xc1, 0x97, 0x51, 0x46, 0x68,
-	0xe2, 0xfd, 0xc9, 0x75, 0xa0, 0x12, 0x41, 0x53, 0x6f, 0x12, 0x26, 0xd2, 0x0a, 0x14, 0x42, 0x8c,
-	0xfb, 0xa5, 0x4f, 0x3f, 0xd3, 0x8a, 0x67, 0x06, 0x16, 0xb5, 0xd4, 0x2f, 0xbf, 0xf0, 0xa3, 0xbf,
-	0x7c, 0xf9, 0xcb, 0xd4, 0x85, 0xb2, 0x59, 0xbd, 0xff, 0xff, 0xd5, 0x1d, 0xda, 0xbc, 0x14, 0x12,
-	0x56, 0x7d, 0x04, 0x83, 0xfd, 0x51, 0xf5, 0x91, 0xeb, 0x7c, 0x74, 0xd5, 0xb8, 0xf8, 0x8a, 0x81,
-	0xaf, 0xa2, 0x34, 0x50, 0x46, 0xba, 0xa6, 0xd2, 0xe7, 0x60, 0xdb, 0xa3, 0x1f, 0xa7, 0x0c, 0xd0,
-	0xcd, 0xbc, 0x05, 0x7f, 0xf7, 0x84, 0x0f, 0xf8, 0x88, 0xa2, 0x58, 0xa3, 0x45, 0xa3, 0xd5, 0x6d,
-	0x62, 0xef, 0xd6, 0x49, 0xe8, 0x53, 0x2f, 0x24, 0xb5, 0xf7, 0xbf, 0xf8, 0xc7, 0xe2, 0xc8, 0x0f,
-	0x9f, 0x2c, 0x1a, 0x9f, 0x3f, 0x59, 0x34, 0x1e, 0x3f, 0x59, 0x34, 0xfe, 0xfe, 0x64, 0xd1, 0xf8,
-	0xe4, 0xe9, 0xe2, 0xc8, 0xe3, 0xa7, 0x8b, 0x23, 0x5f, 0x3c, 0x5d, 0x1c, 0xf9, 0xc1, 0x8b, 0xca,
-	0x1f, 0x4a, 0x59, 0x41, 0xc7, 0x72, 0x2c, 0x3f, 0xa0, 0x3b, 0xc4, 0x66, 0xf2, 0x57, 0xfc, 0x77,
-	0x4e, 0xbf, 0x4e, 0xcd, 0x5d, 0x03, 0xe0, 0x8e, 0x10, 0x57, 0xd6, 0x69, 0xe5, 0x9a, 0xef, 0x36,
-	0x33, 0xe0, 0xcb, 0xe5, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0xec, 0x60, 0x34, 0x80, 0xf4, 0x35,
-	0x00, 0x00,
+func (m *JobCancelledEvent) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// EventClient is the client API for Event service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type EventClient interface {
-	ReportMultiple(ctx context.Context, in *EventList, opts ...grpc.CallOption) (*types.Empty, error)
-	Report(ctx context.Context, in *EventMessage, opts ...grpc.CallOption) (*types.Empty, error)
-	GetJobSetEvents(ctx context.Context, in *JobSetRequest, opts ...grpc.CallOption) (Event_GetJobSetEventsClient, error)
-	Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (Event_WatchClient, error)
-	Health(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*HealthCheckResponse, error)
+type JobTerminatedEvent struct {
+	JobId        string    `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+	JobSetId     string    `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
+	Queue        string    `protobuf:"bytes,3,opt,name=queue,proto3" json:"queue,omitempty"`
+	Created      time.Time `protobuf:"bytes,4,opt,name=created,proto3,stdtime" json:"created"`
+	ClusterId    string    `protobuf:"bytes,5,opt,name=cluster_id,json=clusterId,proto3" json:"clusterId,omitempty"`
+	KubernetesId string    `protobuf:"bytes,6,opt,name=kubernetes_id,json=kubernetesId,proto3" json:"kubernetesId,omitempty"`
+	PodNumber    int32     `protobuf:"varint,7,opt,name=pod_number,json=podNumber,proto3" json:"podNumber,omitempty"`
+	PodName      string    `protobuf:"bytes,9,opt,name=pod_name,json=podName,proto3" json:"podName,omitempty"`
+	PodNamespace string    `protobuf:"bytes,10,opt,name=pod_namespace,json=podNamespace,proto3" json:"podNamespace,omitempty"`
+	Reason       string    `protobuf:"bytes,8,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-type eventClient struct {
-	cc *grpc.ClientConn
+func (m *JobTerminatedEvent) Reset()      { *m = JobTerminatedEvent{} }
+func (*JobTerminatedEvent) ProtoMessage() {}
+func (*JobTerminatedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7758595c3bb8cf56, []int{30}
 }
-
-func NewEventClient(cc *grpc.ClientConn) EventClient {
-	return &eventClient{cc}
+func (m *JobTerminatedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *eventClient) ReportMultiple(ctx context.Context, in *EventList, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/api.Event/ReportMultiple", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *JobTerminatedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobTerminatedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *eventClient) Report(ctx context.Context, in *EventMessage, opts ...grpc.CallOption) (*types.Empty, error) {
-	out := new(types.Empty)
-	err := c.cc.Invoke(ctx, "/api.Event/Report", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *JobTerminatedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobTerminatedEvent.Merge(m, src)
+}
+func (m *JobTerminatedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobTerminatedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobTerminatedEvent.DiscardUnknown(m)
 }
 
-func (c *eventClient) GetJobSetEvents(ctx context.Context, in *JobSetRequest, opts ...grpc.CallOption) (Event_GetJobSetEventsClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Event_serviceDesc.Streams[0], "/api.Event/GetJobSetEvents", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &eventGetJobSetEventsClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+var xxx_messageInfo_JobTerminatedEvent proto.InternalMessageInfo
+
+func (m *JobTerminatedEvent) GetJobId() string {
+	if m != nil {
+		return m.JobId
 	}
-	return x, nil
+	return ""
 }
 
-type Event_GetJobSetEventsClient interface {
-	Recv() (*EventStreamMessage, error)
-	grpc.ClientStream
+func (m *JobTerminatedEvent) GetJobSetId() string {
+	if m != nil {
+		return m.JobSetId
+	}
+	return ""
 }
 
-type eventGetJobSetEventsClient struct {
-	grpc.ClientStream
+func (m *JobTerminatedEvent) GetQueue() string {
+	if m != nil {
+		return m.Queue
+	}
+	return ""
 }
 
-func (x *eventGetJobSetEventsClient) Recv() (*EventStreamMessage, error) {
-	m := new(EventStreamMessage)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *JobTerminatedEvent) GetCreated() time.Time {
+	if m != nil {
+		return m.Created
 	}
-	return m, nil
+	return time.Time{}
 }
 
-// Deprecated: Do not use.
-func (c *eventClient) Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (Event_WatchClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_Event_serviceDesc.Streams[1], "/api.Event/Watch", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &eventWatchClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *JobTerminatedEvent) GetClusterId() string {
+	if m != nil {
+		return m.ClusterId
 	}
-	return x, nil
+	return ""
 }
 
-type Event_WatchClient interface {
-	Recv() (*EventStreamMessage, error)
-	grpc.ClientStream
+func (m *JobTerminatedEvent) GetKubernetesId() string {
+	if m != nil {
+		return m.KubernetesId
+	}
+	return ""
 }
 
-type eventWatchClient struct {
-	grpc.ClientStream
+func (m *JobTerminatedEvent) GetPodNumber() int32 {
+	if m != nil {
+		return m.PodNumber
+	}
+	return 0
 }
 
-func (x *eventWatchClient) Recv() (*EventStreamMessage, error) {
-	m := new(EventStreamMessage)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *JobTerminatedEvent) GetPodName() string {
+	if m != nil {
+		return m.PodName
 	}
-	return m, nil
+	return ""
 }
 
-func (c *eventClient) Health(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*HealthCheckResponse, error) {
-	out := new(HealthCheckResponse)
-	err := c.cc.Invoke(ctx, "/api.Event/Health", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *JobTerminatedEvent) GetPodNamespace() string {
+	if m != nil {
+		return m.PodNamespace
 	}
-	return out, nil
+	return ""
 }
 
-// EventServer is the server API for Event service.
-type EventServer interface {
-	ReportMultiple(context.Context, *EventList) (*types.Empty, error)
-	Report(context.Context, *EventMessage) (*types.Empty, error)
-	GetJobSetEvents(*JobSetRequest, Event_GetJobSetEventsServer) error
-	Watch(*WatchRequest, Event_WatchServer) error
-	Health(context.Context, *types.Empty) (*HealthCheckResponse, error)
+func (m *JobTerminatedEvent) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
 }
 
-// UnimplementedEventServer can be embedded to have forward compatible implementations.
-type UnimplementedEventServer struct {
+type JobUpdatedEvent struct {
+	JobId     string    `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+	JobSetId  string    `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
+	Queue     string    `protobuf:"bytes,3,opt,name=queue,proto3" json:"queue,omitempty"`
+	Created   time.Time `protobuf:"bytes,4,opt,name=created,proto3,stdtime" json:"created"`
+	Requestor string    `protobuf:"bytes,5,opt,name=requestor,proto3" json:"requestor,omitempty"`
+	Job       Job       `protobuf:"bytes,6,opt,name=job,proto3" json:"job"`
 }
 
-func (*UnimplementedEventServer) ReportMultiple(ctx context.Context, req *EventList) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ReportMultiple not implemented")
+func (m *JobUpdatedEvent) Reset()      { *m = JobUpdatedEvent{} }
+func (*JobUpdatedEvent) ProtoMessage() {}
+func (*JobUpdatedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7758595c3bb8cf56, []int{31}
 }
-func (*UnimplementedEventServer) Report(ctx context.Context, req *EventMessage) (*types.Empty, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Report not implemented")
+func (m *JobUpdatedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedEventServer) GetJobSetEvents(req *JobSetRequest, srv Event_GetJobSetEventsServer) error {
-	return status.Errorf(codes.Unimplemented, "method GetJobSetEvents not implemented")
+func (m *JobUpdatedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobUpdatedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedEventServer) Watch(req *WatchRequest, srv Event_WatchServer) error {
-	return status.Errorf(codes.Unimplemented, "method Watch not implemented")
+func (m *JobUpdatedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobUpdatedEvent.Merge(m, src)
 }
-func (*UnimplementedEventServer) Health(ctx context.Context, req *types.Empty) (*HealthCheckResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Health not implemented")
+func (m *JobUpdatedEvent) XXX_Size() int {
+	return m.Size()
 }
-
-func RegisterEventServer(s *grpc.Server, srv EventServer) {
-	s.RegisterService(&_Event_serviceDesc, srv)
+func (m *JobUpdatedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobUpdatedEvent.DiscardUnknown(m)
 }
 
-func _Event_ReportMultiple_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EventList)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(EventServer).ReportMultiple(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.Event/ReportMultiple",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EventServer).ReportMultiple(ctx, req.(*EventList))
+var xxx_messageInfo_JobUpdatedEvent proto.InternalMessageInfo
+
+func (m *JobUpdatedEvent) GetJobId() string {
+	if m != nil {
+		return m.JobId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Event_Report_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EventMessage)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(EventServer).Report(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.Event/Report",
+func (m *JobUpdatedEvent) GetJobSetId() string {
+	if m != nil {
+		return m.JobSetId
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EventServer).Report(ctx, req.(*EventMessage))
+	return ""
+}
+
+func (m *JobUpdatedEvent) GetQueue() string {
+	if m != nil {
+		return m.Queue
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Event_GetJobSetEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(JobSetRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (m *JobUpdatedEvent) GetCreated() time.Time {
+	if m != nil {
+		return m.Created
 	}
-	return srv.(EventServer).GetJobSetEvents(m, &eventGetJobSetEventsServer{stream})
+	return time.Time{}
 }
 
-type Event_GetJobSetEventsServer interface {
-	Send(*EventStreamMessage) error
-	grpc.ServerStream
+func (m *JobUpdatedEvent) GetRequestor() string {
+	if m != nil {
+		return m.Requestor
+	}
+	return ""
 }
 
-type eventGetJobSetEventsServer struct {
-	grpc.ServerStream
+func (m *JobUpdatedEvent) GetJob() Job {
+	if m != nil {
+		return m.Job
+	}
+	return Job{}
 }
 
-func (x *eventGetJobSetEventsServer) Send(m *EventStreamMessage) error {
-	return x.ServerStream.SendMsg(m)
+type EventMessage struct {
+	// Types that are valid to be assigned to Events:
+	//	*EventMessage_Submitted
+	//	*EventMessage_Queued
+	//	*EventMessage_DuplicateFound
+	//	*EventMessage_Leased
+	//	*EventMessage_LeaseReturned
+	//	*EventMessage_LeaseExpired
+	//	*EventMessage_Pending
+	//	*EventMessage_Running
+	//	*EventMessage_UnableToSchedule
+	//	*EventMessage_Failed
+	//	*EventMessage_Succeeded
+	//	*EventMessage_Reprioritized
+	//	*EventMessage_Cancelling
+	//	*EventMessage_Cancelled
+	//	*EventMessage_Terminated
+	//	*EventMessage_Utilisation
+	//	*EventMessage_IngressInfo
+	//	*EventMessage_Reprioritizing
+	//	*EventMessage_Updated
+	//	*EventMessage_FailedCompressed
+	//	*EventMessage_Preempted
+	//	*EventMessage_JobSetExpired
+	//	*EventMessage_Suspended
+	//	*EventMessage_Resumed
+	//	*EventMessage_EvictedForCapacity
+	//	*EventMessage_RuntimeExceeded
+	//	*EventMessage_ResourcesNormalized
+	//	*EventMessage_Unschedulable
+	//	*EventMessage_MaintenanceWindowStarted
+	//	*EventMessage_MaintenanceWindowEnded
+	//	*EventMessage_Cordoned
+	//	*EventMessage_Uncordoned
+	Events isEventMessage_Events `protobuf_oneof:"events"`
 }
 
-func _Event_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(WatchRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func (m *EventMessage) Reset()      { *m = EventMessage{} }
+func (*EventMessage) ProtoMessage() {}
+func (*EventMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7758595c3bb8cf56, []int{32}
+}
+func (m *EventMessage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventMessage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return srv.(EventServer).Watch(m, &eventWatchServer{stream})
 }
-
-type Event_WatchServer interface {
-	Send(*EventStreamMessage) error
-	grpc.ServerStream
+func (m *EventMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventMessage.Merge(m, src)
+}
+func (m *EventMessage) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventMessage.DiscardUnknown(m)
 }
 
-type eventWatchServer struct {
-	grpc.ServerStream
+var xxx_messageInfo_EventMessage proto.InternalMessageInfo
+
+type isEventMessage_Events interface {
+	isEventMessage_Events()
+	MarshalTo([]byte) (int, error)
+	Size() int
 }
 
-func (x *eventWatchServer) Send(m *EventStreamMessage) error {
-	return x.ServerStream.SendMsg(m)
+type EventMessage_Submitted struct {
+	Submitted *JobSubmittedEvent `protobuf:"bytes,1,opt,name=submitted,proto3,oneof" json:"submitted,omitempty"`
+}
+type EventMessage_Queued struct {
+	Queued *JobQueuedEvent `protobuf:"bytes,2,opt,name=queued,proto3,oneof" json:"queued,omitempty"`
+}
+type EventMessage_DuplicateFound struct {
+	DuplicateFound *JobDuplicateFoundEvent `protobuf:"bytes,16,opt,name=duplicate_found,json=duplicateFound,proto3,oneof" json:"duplicateFound,omitempty"`
+}
+type EventMessage_Leased struct {
+	Leased *JobLeasedEvent `protobuf:"bytes,3,opt,name=leased,proto3,oneof" json:"leased,omitempty"`
+}
+type EventMessage_LeaseReturned struct {
+	LeaseReturned *JobLeaseReturnedEvent `protobuf:"bytes,4,opt,name=lease_returned,json=leaseReturned,proto3,oneof" json:"leaseReturned,omitempty"`
+}
+type EventMessage_LeaseExpired struct {
+	LeaseExpired *JobLeaseExpiredEvent `protobuf:"bytes,5,opt,name=lease_expired,json=leaseExpired,proto3,oneof" json:"leaseExpired,omitempty"`
+}
+type EventMessage_Pending struct {
+	Pending *JobPendingEvent `protobuf:"bytes,6,opt,name=pending,proto3,oneof" json:"pending,omitempty"`
+}
+type EventMessage_Running struct {
+	Running *JobRunningEvent `protobuf:"bytes,7,opt,name=running,proto3,oneof" json:"running,omitempty"`
+}
+type EventMessage_UnableToSchedule struct {
+	UnableToSchedule *JobUnableToScheduleEvent `protobuf:"bytes,8,opt,name=unable_to_schedule,json=unableToSchedule,proto3,oneof" json:"unableToSchedule,omitempty"`
+}
+type EventMessage_Failed struct {
+	Failed *JobFailedEvent `protobuf:"bytes,9,opt,name=failed,proto3,oneof" json:"failed,omitempty"`
+}
+type EventMessage_Succeeded struct {
+	Succeeded *JobSucceededEvent `protobuf:"bytes,10,opt,name=succeeded,proto3,oneof" json:"succeeded,omitempty"`
+}
+type EventMessage_Reprioritized struct {
+	Reprioritized *JobReprioritizedEvent `protobuf:"bytes,11,opt,name=reprioritized,proto3,oneof" json:"reprioritized,omitempty"`
+}
+type EventMessage_Cancelling struct {
+	Cancelling *JobCancellingEvent `protobuf:"bytes,12,opt,name=cancelling,proto3,oneof" json:"cancelling,omitempty"`
+}
+type EventMessage_Cancelled struct {
+	Cancelled *JobCancelledEvent `protobuf:"bytes,13,opt,name=cancelled,proto3,oneof" json:"cancelled,omitempty"`
+}
+type EventMessage_Terminated struct {
+	Terminated *JobTerminatedEvent `protobuf:"bytes,14,opt,name=terminated,proto3,oneof" json:"terminated,omitempty"`
+}
+type EventMessage_Utilisation struct {
+	Utilisation *JobUtilisationEvent `protobuf:"bytes,15,opt,name=utilisation,proto3,oneof" json:"utilisation,omitempty"`
+}
+type EventMessage_IngressInfo struct {
+	IngressInfo *JobIngressInfoEvent `protobuf:"bytes,17,opt,name=ingress_info,json=ingressInfo,proto3,oneof" json:"ingressInfo,omitempty"`
+}
+type EventMessage_Reprioritizing struct {
+	Reprioritizing *JobReprioritizingEvent `protobuf:"bytes,18,opt,name=reprioritizing,proto3,oneof" json:"reprioritizing,omitempty"`
+}
+type EventMessage_Updated struct {
+	Updated *JobUpdatedEvent `protobuf:"bytes,19,opt,name=updated,proto3,oneof" json:"updated,omitempty"`
+}
+type EventMessage_FailedCompressed struct {
+	FailedCompressed *JobFailedEventCompressed `protobuf:"bytes,20,opt,name=failedCompressed,proto3,oneof" json:"failedCompressed,omitempty"`
+}
+type EventMessage_Preempted struct {
+	Preempted *JobPreemptedEvent `protobuf:"bytes,21,opt,name=preempted,proto3,oneof" json:"preempted,omitempty"`
 }
+type EventMessage_JobSetExpired struct {
+	JobSetExpired *JobSetExpiredEvent `protobuf:"bytes,22,opt,name=job_set_expired,json=jobSetExpired,proto3,oneof" json:"jobSetExpired,omitempty"`
+}
+type EventMessage_Suspended struct {
+	Suspended *JobSuspendedEvent `protobuf:"bytes,23,opt,name=suspended,proto3,oneof" json:"suspended,omitempty"`
+}
+type EventMessage_Resumed struct {
+	Resumed *JobResumedEvent `protobuf:"bytes,24,opt,name=resumed,proto3,oneof" json:"resumed,omitempty"`
+}
+type EventMessage_EvictedForCapacity struct {
+	EvictedForCapacity *JobEvictedForCapacityEvent `protobuf:"bytes,25,opt,name=evicted_for_capacity,json=evictedForCapacity,proto3,oneof" json:"evictedForCapacity,omitempty"`
+}
+type EventMessage_RuntimeExceeded struct {
+	RuntimeExceeded *JobRuntimeExceededEvent `protobuf:"bytes,26,opt,name=runtime_exceeded,json=runtimeExceeded,proto3,oneof" json:"runtimeExceeded,omitempty"`
+}
+type EventMessage_ResourcesNormalized struct {
+	ResourcesNormalized *JobResourcesNormalizedEvent `protobuf:"bytes,27,opt,name=resources_normalized,json=resourcesNormalized,proto3,oneof" json:"resourcesNormalized,omitempty"`
+}
+type EventMessage_Unschedulable struct {
+	Unschedulable *JobUnschedulableEvent `protobuf:"bytes,28,opt,name=unschedulable,proto3,oneof" json:"unschedulable,omitempty"`
+}
+type EventMessage_MaintenanceWindowStarted struct {
+	MaintenanceWindowStarted *JobMaintenanceWindowStartedEvent `protobuf:"bytes,29,opt,name=maintenance_window_started,json=maintenanceWindowStarted,proto3,oneof" json:"maintenanceWindowStarted,omitempty"`
+}
+type EventMessage_MaintenanceWindowEnded struct {
+	MaintenanceWindowEnded *JobMaintenanceWindowEndedEvent `protobuf:"bytes,30,opt,name=maintenance_window_ended,json=maintenanceWindowEnded,proto3,oneof" json:"maintenanceWindowEnded,omitempty"`
+}
+type EventMessage_Cordoned struct {
+	Cordoned *JobCordonedEvent `protobuf:"bytes,31,opt,name=cordoned,proto3,oneof" json:"cordoned,omitempty"`
+}
+type EventMessage_Uncordoned struct {
+	Uncordoned *JobUncordonedEvent `protobuf:"bytes,32,opt,name=uncordoned,proto3,oneof" json:"uncordoned,omitempty"`
+}
+
+func (*EventMessage_Submitted) isEventMessage_Events()                {}
+func (*EventMessage_Queued) isEventMessage_Events()                   {}
+func (*EventMessage_DuplicateFound) isEventMessage_Events()           {}
+func (*EventMessage_Leased) isEventMessage_Events()                   {}
+func (*EventMessage_LeaseReturned) isEventMessage_Events()            {}
+func (*EventMessage_LeaseExpired) isEventMessage_Events()             {}
+func (*EventMessage_Pending) isEventMessage_Events()                  {}
+func (*EventMessage_Running) isEventMessage_Events()                  {}
+func (*EventMessage_UnableToSchedule) isEventMessage_Events()         {}
+func (*EventMessage_Failed) isEventMessage_Events()                   {}
+func (*EventMessage_Succeeded) isEventMessage_Events()                {}
+func (*EventMessage_Reprioritized) isEventMessage_Events()            {}
+func (*EventMessage_Cancelling) isEventMessage_Events()               {}
+func (*EventMessage_Cancelled) isEventMessage_Events()                {}
+func (*EventMessage_Terminated) isEventMessage_Events()               {}
+func (*EventMessage_Utilisation) isEventMessage_Events()              {}
+func (*EventMessage_IngressInfo) isEventMessage_Events()              {}
+func (*EventMessage_Reprioritizing) isEventMessage_Events()           {}
+func (*EventMessage_Updated) isEventMessage_Events()                  {}
+func (*EventMessage_FailedCompressed) isEventMessage_Events()         {}
+func (*EventMessage_Preempted) isEventMessage_Events()                {}
+func (*EventMessage_JobSetExpired) isEventMessage_Events()            {}
+func (*EventMessage_Suspended) isEventMessage_Events()                {}
+func (*EventMessage_Resumed) isEventMessage_Events()                  {}
+func (*EventMessage_EvictedForCapacity) isEventMessage_Events()       {}
+func (*EventMessage_RuntimeExceeded) isEventMessage_Events()          {}
+func (*EventMessage_ResourcesNormalized) isEventMessage_Events()      {}
+func (*EventMessage_Unschedulable) isEventMessage_Events()            {}
+func (*EventMessage_MaintenanceWindowStarted) isEventMessage_Events() {}
+func (*EventMessage_MaintenanceWindowEnded) isEventMessage_Events()   {}
+func (*EventMessage_Cordoned) isEventMessage_Events()                 {}
+func (*EventMessage_Uncordoned) isEventMessage_Events()               {}
 
-func _Event_Health_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(types.Empty)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(EventServer).Health(ctx, in)
+func (m *EventMessage) GetEvents() isEventMessage_Events {
+	if m != nil {
+		return m.Events
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/api.Event/Health",
+	return nil
+}
+
+func (m *EventMessage) GetSubmitted() *JobSubmittedEvent {
+	if x, ok := m.GetEvents().(*EventMessage_Submitted); ok {
+		return x.Submitted
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(EventServer).Health(ctx, req.(*types.Empty))
+	return nil
+}
+
+func (m *EventMessage) GetQueued() *JobQueuedEvent {
+	if x, ok := m.GetEvents().(*EventMessage_Queued); ok {
+		return x.Queued
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Event_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "api.Event",
-	HandlerType: (*EventServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "ReportMultiple",
-			Handler:    _Event_ReportMultiple_Handler,
-		},
-		{
-			MethodName: "Report",
-			Handler:    _Event_Report_Handler,
-		},
-		{
-			MethodName: "Health",
-			Handler:    _Event_Health_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "GetJobSetEvents",
-			Handler:       _Event_GetJobSetEvents_Handler,
-			ServerStreams: true,
-		},
-		{
-			StreamName:    "Watch",
-			Handler:       _Event_Watch_Handler,
-			ServerStreams: true,
-		},
-	},
-	Metadata: "pkg/api/event.proto",
+func (m *EventMessage) GetDuplicateFound() *JobDuplicateFoundEvent {
+	if x, ok := m.GetEvents().(*EventMessage_DuplicateFound); ok {
+		return x.DuplicateFound
+	}
+	return nil
 }
 
-func (m *JobSubmittedEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMessage) GetLeased() *JobLeasedEvent {
+	if x, ok := m.GetEvents().(*EventMessage_Leased); ok {
+		return x.Leased
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *JobSubmittedEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventMessage) GetLeaseReturned() *JobLeaseReturnedEvent {
+	if x, ok := m.GetEvents().(*EventMessage_LeaseReturned); ok {
+		return x.LeaseReturned
+	}
+	return nil
 }
 
-func (m *JobSubmittedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Job.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintEvent(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
-	if err2 != nil {
-		return 0, err2
-	}
-	i -= n2
-	i = encodeVarintEvent(dAtA, i, uint64(n2))
-	i--
-	dAtA[i] = 0x22
-	if len(m.Queue) > 0 {
-		i -= len(m.Queue)
-		copy(dAtA[i:], m.Queue)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.JobSetId) > 0 {
-		i -= len(m.JobSetId)
-		copy(dAtA[i:], m.JobSetId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.JobId) > 0 {
-		i -= len(m.JobId)
-		copy(dAtA[i:], m.JobId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
-		i--
-		dAtA[i] = 0xa
+func (m *EventMessage) GetLeaseExpired() *JobLeaseExpiredEvent {
+	if x, ok := m.GetEvents().(*EventMessage_LeaseExpired); ok {
+		return x.LeaseExpired
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *JobQueuedEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMessage) GetPending() *JobPendingEvent {
+	if x, ok := m.GetEvents().(*EventMessage_Pending); ok {
+		return x.Pending
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *JobQueuedEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventMessage) GetRunning() *JobRunningEvent {
+	if x, ok := m.GetEvents().(*EventMessage_Running); ok {
+		return x.Running
+	}
+	return nil
 }
 
-func (m *JobQueuedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
-	if err3 != nil {
-		return 0, err3
-	}
-	i -= n3
-	i = encodeVarintEvent(dAtA, i, uint64(n3))
-	i--
-	dAtA[i] = 0x22
-	if len(m.Queue) > 0 {
-		i -= len(m.Queue)
-		copy(dAtA[i:], m.Queue)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.JobSetId) > 0 {
-		i -= len(m.JobSetId)
-		copy(dAtA[i:], m.JobSetId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.JobId) > 0 {
-		i -= len(m.JobId)
-		copy(dAtA[i:], m.JobId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
-		i--
-		dAtA[i] = 0xa
+func (m *EventMessage) GetUnableToSchedule() *JobUnableToScheduleEvent {
+	if x, ok := m.GetEvents().(*EventMessage_UnableToSchedule); ok {
+		return x.UnableToSchedule
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *JobDuplicateFoundEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMessage) GetFailed() *JobFailedEvent {
+	if x, ok := m.GetEvents().(*EventMessage_Failed); ok {
+		return x.Failed
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *JobDuplicateFoundEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventMessage) GetSucceeded() *JobSucceededEvent {
+	if x, ok := m.GetEvents().(*EventMessage_Succeeded); ok {
+		return x.Succeeded
+	}
+	return nil
 }
 
-func (m *JobDuplicateFoundEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.OriginalJobId) > 0 {
-		i -= len(m.OriginalJobId)
-		copy(dAtA[i:], m.OriginalJobId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.OriginalJobId)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
-	if err4 != nil {
-		return 0, err4
+func (m *EventMessage) GetReprioritized() *JobReprioritizedEvent {
+	if x, ok := m.GetEvents().(*EventMessage_Reprioritized); ok {
+		return x.Reprioritized
 	}
-	i -= n4
-	i = encodeVarintEvent(dAtA, i, uint64(n4))
-	i--
-	dAtA[i] = 0x22
-	if len(m.Queue) > 0 {
-		i -= len(m.Queue)
-		copy(dAtA[i:], m.Queue)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
-		i--
-		dAtA[i] = 0x1a
+	return nil
+}
+
+func (m *EventMessage) GetCancelling() *JobCancellingEvent {
+	if x, ok := m.GetEvents().(*EventMessage_Cancelling); ok {
+		return x.Cancelling
 	}
-	if len(m.JobSetId) > 0 {
-		i -= len(m.JobSetId)
-		copy(dAtA[i:], m.JobSetId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
-		i--
-		dAtA[i] = 0x12
+	return nil
+}
+
+func (m *EventMessage) GetCancelled() *JobCancelledEvent {
+	if x, ok := m.GetEvents().(*EventMessage_Cancelled); ok {
+		return x.Cancelled
 	}
-	if len(m.JobId) > 0 {
-		i -= len(m.JobId)
-		copy(dAtA[i:], m.JobId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *EventMessage) GetTerminated() *JobTerminatedEvent {
+	if x, ok := m.GetEvents().(*EventMessage_Terminated); ok {
+		return x.Terminated
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *JobLeasedEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMessage) GetUtilisation() *JobUtilisationEvent {
+	if x, ok := m.GetEvents().(*EventMessage_Utilisation); ok {
+		return x.Utilisation
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *JobLeasedEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventMessage) GetIngressInfo() *JobIngressInfoEvent {
+	if x, ok := m.GetEvents().(*EventMessage_IngressInfo); ok {
+		return x.IngressInfo
+	}
+	return nil
 }
 
-func (m *JobLeasedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ClusterId) > 0 {
-		i -= len(m.ClusterId)
-		copy(dAtA[i:], m.ClusterId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.ClusterId)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
-	if err5 != nil {
-		return 0, err5
-	}
-	i -= n5
-	i = encodeVarintEvent(dAtA, i, uint64(n5))
-	i--
-	dAtA[i] = 0x22
-	if len(m.Queue) > 0 {
-		i -= len(m.Queue)
-		copy(dAtA[i:], m.Queue)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.JobSetId) > 0 {
-		i -= len(m.JobSetId)
-		copy(dAtA[i:], m.JobSetId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.JobId) > 0 {
-		i -= len(m.JobId)
-		copy(dAtA[i:], m.JobId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
-		i--
-		dAtA[i] = 0xa
+func (m *EventMessage) GetReprioritizing() *JobReprioritizingEvent {
+	if x, ok := m.GetEvents().(*EventMessage_Reprioritizing); ok {
+		return x.Reprioritizing
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *JobLeaseReturnedEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMessage) GetUpdated() *JobUpdatedEvent {
+	if x, ok := m.GetEvents().(*EventMessage_Updated); ok {
+		return x.Updated
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *JobLeaseReturnedEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventMessage) GetFailedCompressed() *JobFailedEventCompressed {
+	if x, ok := m.GetEvents().(*EventMessage_FailedCompressed); ok {
+		return x.FailedCompressed
+	}
+	return nil
 }
 
-func (m *JobLeaseReturnedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.RunAttempted {
-		i--
-		if m.RunAttempted {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x48
-	}
-	if m.PodNumber != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.PodNumber))
-		i--
-		dAtA[i] = 0x40
-	}
-	if len(m.KubernetesId) > 0 {
-		i -= len(m.KubernetesId)
-		copy(dAtA[i:], m.KubernetesId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.KubernetesId)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.Reason) > 0 {
-		i -= len(m.Reason)
-		copy(dAtA[i:], m.Reason)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Reason)))
-		i--
-		dAtA[i] = 0x32
+func (m *EventMessage) GetPreempted() *JobPreemptedEvent {
+	if x, ok := m.GetEvents().(*EventMessage_Preempted); ok {
+		return x.Preempted
 	}
-	if len(m.ClusterId) > 0 {
-		i -= len(m.ClusterId)
-		copy(dAtA[i:], m.ClusterId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.ClusterId)))
-		i--
-		dAtA[i] = 0x2a
+	return nil
+}
+
+func (m *EventMessage) GetJobSetExpired() *JobSetExpiredEvent {
+	if x, ok := m.GetEvents().(*EventMessage_JobSetExpired); ok {
+		return x.JobSetExpired
 	}
-	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
-	if err6 != nil {
-		return 0, err6
+	return nil
+}
+
+func (m *EventMessage) GetSuspended() *JobSuspendedEvent {
+	if x, ok := m.GetEvents().(*EventMessage_Suspended); ok {
+		return x.Suspended
 	}
-	i -= n6
-	i = encodeVarintEvent(dAtA, i, uint64(n6))
-	i--
-	dAtA[i] = 0x22
-	if len(m.Queue) > 0 {
-		i -= len(m.Queue)
-		copy(dAtA[i:], m.Queue)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
-		i--
-		dAtA[i] = 0x1a
+	return nil
+}
+
+func (m *EventMessage) GetResumed() *JobResumedEvent {
+	if x, ok := m.GetEvents().(*EventMessage_Resumed); ok {
+		return x.Resumed
 	}
-	if len(m.JobSetId) > 0 {
-		i -= len(m.JobSetId)
-		copy(dAtA[i:], m.JobSetId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
-		i--
-		dAtA[i] = 0x12
+	return nil
+}
+
+func (m *EventMessage) GetEvictedForCapacity() *JobEvictedForCapacityEvent {
+	if x, ok := m.GetEvents().(*EventMessage_EvictedForCapacity); ok {
+		return x.EvictedForCapacity
 	}
-	if len(m.JobId) > 0 {
-		i -= len(m.JobId)
-		copy(dAtA[i:], m.JobId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *EventMessage) GetRuntimeExceeded() *JobRuntimeExceededEvent {
+	if x, ok := m.GetEvents().(*EventMessage_RuntimeExceeded); ok {
+		return x.RuntimeExceeded
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *JobLeaseExpiredEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventMessage) GetResourcesNormalized() *JobResourcesNormalizedEvent {
+	if x, ok := m.GetEvents().(*EventMessage_ResourcesNormalized); ok {
+		return x.ResourcesNormalized
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *JobLeaseExpiredEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventMessage) GetUnschedulable() *JobUnschedulableEvent {
+	if x, ok := m.GetEvents().(*EventMessage_Unschedulable); ok {
+		return x.Unschedulable
+	}
+	return nil
 }
 
-func (m *JobLeaseExpiredEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
-	if err7 != nil {
-		return 0, err7
+func (m *EventMessage) GetMaintenanceWindowStarted() *JobMaintenanceWindowStartedEvent {
+	if x, ok := m.GetEvents().(*EventMessage_MaintenanceWindowStarted); ok {
+		return x.MaintenanceWindowStarted
 	}
-	i -= n7
-	i = encodeVarintEvent(dAtA, i, uint64(n7))
-	i--
-	dAtA[i] = 0x22
-	if len(m.Queue) > 0 {
-		i -= len(m.Queue)
-		copy(dAtA[i:], m.Queue)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
-		i--
-		dAtA[i] = 0x1a
+	return nil
+}
+
+func (m *EventMessage) GetMaintenanceWindowEnded() *JobMaintenanceWindowEndedEvent {
+	if x, ok := m.GetEvents().(*EventMessage_MaintenanceWindowEnded); ok {
+		return x.MaintenanceWindowEnded
 	}
-	if len(m.JobSetId) > 0 {
-		i -= len(m.JobSetId)
-		copy(dAtA[i:], m.JobSetId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
-		i--
-		dAtA[i] = 0x12
+	return nil
+}
+
+func (m *EventMessage) GetCordoned() *JobCordonedEvent {
+	if x, ok := m.GetEvents().(*EventMessage_Cordoned); ok {
+		return x.Cordoned
 	}
-	if len(m.JobId) > 0 {
-		i -= len(m.JobId)
-		copy(dAtA[i:], m.JobId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *EventMessage) GetUncordoned() *JobUncordonedEvent {
+	if x, ok := m.GetEvents().(*EventMessage_Uncordoned); ok {
+		return x.Uncordoned
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *JobPendingEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*EventMessage) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*EventMessage_Submitted)(nil),
+		(*EventMessage_Queued)(nil),
+		(*EventMessage_DuplicateFound)(nil),
+		(*EventMessage_Leased)(nil),
+		(*EventMessage_LeaseReturned)(nil),
+		(*EventMessage_LeaseExpired)(nil),
+		(*EventMessage_Pending)(nil),
+		(*EventMessage_Running)(nil),
+		(*EventMessage_UnableToSchedule)(nil),
+		(*EventMessage_Failed)(nil),
+		(*EventMessage_Succeeded)(nil),
+		(*EventMessage_Reprioritized)(nil),
+		(*EventMessage_Cancelling)(nil),
+		(*EventMessage_Cancelled)(nil),
+		(*EventMessage_Terminated)(nil),
+		(*EventMessage_Utilisation)(nil),
+		(*EventMessage_IngressInfo)(nil),
+		(*EventMessage_Reprioritizing)(nil),
+		(*EventMessage_Updated)(nil),
+		(*EventMessage_FailedCompressed)(nil),
+		(*EventMessage_Preempted)(nil),
+		(*EventMessage_JobSetExpired)(nil),
+		(*EventMessage_Suspended)(nil),
+		(*EventMessage_Resumed)(nil),
+		(*EventMessage_EvictedForCapacity)(nil),
+		(*EventMessage_RuntimeExceeded)(nil),
+		(*EventMessage_ResourcesNormalized)(nil),
+		(*EventMessage_Unschedulable)(nil),
+		(*EventMessage_MaintenanceWindowStarted)(nil),
+		(*EventMessage_MaintenanceWindowEnded)(nil),
+		(*EventMessage_Cordoned)(nil),
+		(*EventMessage_Uncordoned)(nil),
 	}
-	return dAtA[:n], nil
 }
 
-func (m *JobPendingEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ContainerStatus struct {
+	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ExitCode int32  `protobuf:"varint,2,opt,name=exitCode,proto3" json:"exitCode,omitempty"`
+	Message  string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Reason   string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	Cause    Cause  `protobuf:"varint,5,opt,name=cause,proto3,enum=api.Cause" json:"cause,omitempty"`
 }
 
-func (m *JobPendingEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PodNamespace) > 0 {
-		i -= len(m.PodNamespace)
-		copy(dAtA[i:], m.PodNamespace)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.PodNamespace)))
-		i--
-		dAtA[i] = 0x4a
-	}
-	if len(m.PodName) > 0 {
-		i -= len(m.PodName)
-		copy(dAtA[i:], m.PodName)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.PodName)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if m.PodNumber != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.PodNumber))
-		i--
-		dAtA[i] = 0x38
-	}
-	if len(m.KubernetesId) > 0 {
-		i -= len(m.KubernetesId)
-		copy(dAtA[i:], m.KubernetesId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.KubernetesId)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.ClusterId) > 0 {
-		i -= len(m.ClusterId)
-		copy(dAtA[i:], m.ClusterId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.ClusterId)))
-		i--
-		dAtA[i] = 0x2a
+func (m *ContainerStatus) Reset()      { *m = ContainerStatus{} }
+func (*ContainerStatus) ProtoMessage() {}
+func (*ContainerStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7758595c3bb8cf56, []int{33}
+}
+func (m *ContainerStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContainerStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContainerStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
-	if err8 != nil {
-		return 0, err8
+}
+func (m *ContainerStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContainerStatus.Merge(m, src)
+}
+func (m *ContainerStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContainerStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContainerStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContainerStatus proto.InternalMessageInfo
+
+func (m *ContainerStatus) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	i -= n8
-	i = encodeVarintEvent(dAtA, i, uint64(n8))
-	i--
-	dAtA[i] = 0x22
-	if len(m.Queue) > 0 {
-		i -= len(m.Queue)
-		copy(dAtA[i:], m.Queue)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
-		i--
-		dAtA[i] = 0x1a
+	return ""
+}
+
+func (m *ContainerStatus) GetExitCode() int32 {
+	if m != nil {
+		return m.ExitCode
 	}
-	if len(m.JobSetId) > 0 {
-		i -= len(m.JobSetId)
-		copy(dAtA[i:], m.JobSetId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
-		i--
-		dAtA[i] = 0x12
+	return 0
+}
+
+func (m *ContainerStatus) GetMessage() string {
+	if m != nil {
+		return m.Message
 	}
-	if len(m.JobId) > 0 {
-		i -= len(m.JobId)
-		copy(dAtA[i:], m.JobId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *ContainerStatus) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *JobRunningEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ContainerStatus) GetCause() Cause {
+	if m != nil {
+		return m.Cause
 	}
-	return dAtA[:n], nil
+	return Cause_Error
 }
 
-func (m *JobRunningEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type EventList struct {
+	Events []*EventMessage `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
 }
 
-func (m *JobRunningEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PodNamespace) > 0 {
-		i -= len(m.PodNamespace)
-		copy(dAtA[i:], m.PodNamespace)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.PodNamespace)))
-		i--
-		dAtA[i] = 0x52
-	}
-	if len(m.PodName) > 0 {
-		i -= len(m.PodName)
-		copy(dAtA[i:], m.PodName)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.PodName)))
-		i--
-		dAtA[i] = 0x4a
-	}
-	if m.PodNumber != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.PodNumber))
-		i--
-		dAtA[i] = 0x40
-	}
-	if len(m.NodeName) > 0 {
-		i -= len(m.NodeName)
-		copy(dAtA[i:], m.NodeName)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.NodeName)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.KubernetesId) > 0 {
-		i -= len(m.KubernetesId)
-		copy(dAtA[i:], m.KubernetesId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.KubernetesId)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.ClusterId) > 0 {
-		i -= len(m.ClusterId)
-		copy(dAtA[i:], m.ClusterId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.ClusterId)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
-	if err9 != nil {
-		return 0, err9
-	}
-	i -= n9
-	i = encodeVarintEvent(dAtA, i, uint64(n9))
-	i--
-	dAtA[i] = 0x22
-	if len(m.Queue) > 0 {
-		i -= len(m.Queue)
-		copy(dAtA[i:], m.Queue)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.JobSetId) > 0 {
-		i -= len(m.JobSetId)
-		copy(dAtA[i:], m.JobSetId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.JobId) > 0 {
-		i -= len(m.JobId)
-		copy(dAtA[i:], m.JobId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
-		i--
-		dAtA[i] = 0xa
+func (m *EventList) Reset()      { *m = EventList{} }
+func (*EventList) ProtoMessage() {}
+func (*EventList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7758595c3bb8cf56, []int{34}
+}
+func (m *EventList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventList.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EventList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventList.Merge(m, src)
+}
+func (m *EventList) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventList) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventList.DiscardUnknown(m)
 }
 
-func (m *JobIngressInfoEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventList proto.InternalMessageInfo
+
+func (m *EventList) GetEvents() []*EventMessage {
+	if m != nil {
+		return m.Events
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *JobIngressInfoEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// swagger:model
+type EventStreamMessage struct {
+	// Sequence id of the event, unique and strictly increasing within its job set. Ids are stable, such that clients
+	// may use them to deduplicate events and pass the id of the last event they processed as from_message_id
+	// to resume watching a job set where they left off.
+	Id      string        `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Message *EventMessage `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// Version of the event schema message conforms to.
+	SchemaVersion uint32 `protobuf:"varint,3,opt,name=schema_version,json=schemaVersion,proto3" json:"schemaVersion,omitempty"`
 }
 
-func (m *JobIngressInfoEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PodNamespace) > 0 {
-		i -= len(m.PodNamespace)
-		copy(dAtA[i:], m.PodNamespace)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.PodNamespace)))
-		i--
-		dAtA[i] = 0x5a
-	}
-	if len(m.PodName) > 0 {
-		i -= len(m.PodName)
-		copy(dAtA[i:], m.PodName)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.PodName)))
-		i--
-		dAtA[i] = 0x52
-	}
-	if len(m.IngressAddresses) > 0 {
-		for k := range m.IngressAddresses {
-			v := m.IngressAddresses[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintEvent(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i = encodeVarintEvent(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarintEvent(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x4a
+func (m *EventStreamMessage) Reset()      { *m = EventStreamMessage{} }
+func (*EventStreamMessage) ProtoMessage() {}
+func (*EventStreamMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7758595c3bb8cf56, []int{35}
+}
+func (m *EventStreamMessage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventStreamMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventStreamMessage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.PodNumber != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.PodNumber))
-		i--
-		dAtA[i] = 0x40
-	}
-	if len(m.NodeName) > 0 {
-		i -= len(m.NodeName)
-		copy(dAtA[i:], m.NodeName)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.NodeName)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.KubernetesId) > 0 {
-		i -= len(m.KubernetesId)
-		copy(dAtA[i:], m.KubernetesId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.KubernetesId)))
-		i--
-		dAtA[i] = 0x32
+}
+func (m *EventStreamMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventStreamMessage.Merge(m, src)
+}
+func (m *EventStreamMessage) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventStreamMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventStreamMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventStreamMessage proto.InternalMessageInfo
+
+func (m *EventStreamMessage) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	if len(m.ClusterId) > 0 {
-		i -= len(m.ClusterId)
-		copy(dAtA[i:], m.ClusterId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.ClusterId)))
-		i--
-		dAtA[i] = 0x2a
+	return ""
+}
+
+func (m *EventStreamMessage) GetMessage() *EventMessage {
+	if m != nil {
+		return m.Message
 	}
-	n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
-	if err10 != nil {
-		return 0, err10
+	return nil
+}
+
+func (m *EventStreamMessage) GetSchemaVersion() uint32 {
+	if m != nil {
+		return m.SchemaVersion
 	}
-	i -= n10
-	i = encodeVarintEvent(dAtA, i, uint64(n10))
-	i--
-	dAtA[i] = 0x22
-	if len(m.Queue) > 0 {
-		i -= len(m.Queue)
-		copy(dAtA[i:], m.Queue)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
-		i--
-		dAtA[i] = 0x1a
+	return 0
+}
+
+// swagger:model
+type JobSetRequest struct {
+	Id    string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Watch bool   `protobuf:"varint,2,opt,name=watch,proto3" json:"watch,omitempty"`
+	// If set, only events after the event with this id are sent, i.e., the id of an EventStreamMessage.
+	FromMessageId  string `protobuf:"bytes,3,opt,name=from_message_id,json=fromMessageId,proto3" json:"fromMessageId,omitempty"`
+	Queue          string `protobuf:"bytes,4,opt,name=queue,proto3" json:"queue,omitempty"`
+	ErrorIfMissing bool   `protobuf:"varint,5,opt,name=errorIfMissing,proto3" json:"errorIfMissing,omitempty"`
+	ForceLegacy    bool   `protobuf:"varint,6,opt,name=force_legacy,json=forceLegacy,proto3" json:"forceLegacy,omitempty"`
+	ForceNew       bool   `protobuf:"varint,7,opt,name=force_new,json=forceNew,proto3" json:"forceNew,omitempty"`
+	// Latest version of the event schema the client understands, usually api.EventSchemaVersion of the client it was
+	// built with. Events of later versions are translated to it. If unset, events are translated to version 1.
+	SchemaVersion uint32 `protobuf:"varint,8,opt,name=schema_version,json=schemaVersion,proto3" json:"schemaVersion,omitempty"`
+	// If set, only events created at or after this time are sent.
+	FromTime *time.Time `protobuf:"bytes,9,opt,name=from_time,json=fromTime,proto3,stdtime" json:"fromTime,omitempty"`
+	// If set, only events ending jobs are sent, i.e., succeeded and cancelled events and failed events of jobs that
+	// won't be retried.
+	TerminalOnly bool `protobuf:"varint,10,opt,name=terminal_only,json=terminalOnly,proto3" json:"terminalOnly,omitempty"`
+	// If not empty, only events of these types are sent. Types are named as the fields of EventMessage,
+	// e.g., "submitted" or "duplicate_found".
+	EventTypes []string `protobuf:"bytes,11,rep,name=event_types,json=eventTypes,proto3" json:"eventTypes,omitempty"`
+}
+
+func (m *JobSetRequest) Reset()      { *m = JobSetRequest{} }
+func (*JobSetRequest) ProtoMessage() {}
+func (*JobSetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7758595c3bb8cf56, []int{36}
+}
+func (m *JobSetRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobSetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobSetRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.JobSetId) > 0 {
-		i -= len(m.JobSetId)
-		copy(dAtA[i:], m.JobSetId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *JobSetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobSetRequest.Merge(m, src)
+}
+func (m *JobSetRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobSetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobSetRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobSetRequest proto.InternalMessageInfo
+
+func (m *JobSetRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	if len(m.JobId) > 0 {
-		i -= len(m.JobId)
-		copy(dAtA[i:], m.JobId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *JobSetRequest) GetWatch() bool {
+	if m != nil {
+		return m.Watch
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *JobUnableToScheduleEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *JobSetRequest) GetFromMessageId() string {
+	if m != nil {
+		return m.FromMessageId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *JobUnableToScheduleEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *JobSetRequest) GetQueue() string {
+	if m != nil {
+		return m.Queue
+	}
+	return ""
 }
 
-func (m *JobUnableToScheduleEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PodNamespace) > 0 {
-		i -= len(m.PodNamespace)
-		copy(dAtA[i:], m.PodNamespace)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.PodNamespace)))
-		i--
-		dAtA[i] = 0x5a
+func (m *JobSetRequest) GetErrorIfMissing() bool {
+	if m != nil {
+		return m.ErrorIfMissing
 	}
-	if len(m.PodName) > 0 {
-		i -= len(m.PodName)
-		copy(dAtA[i:], m.PodName)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.PodName)))
-		i--
-		dAtA[i] = 0x52
+	return false
+}
+
+func (m *JobSetRequest) GetForceLegacy() bool {
+	if m != nil {
+		return m.ForceLegacy
 	}
-	if m.PodNumber != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.PodNumber))
-		i--
-		dAtA[i] = 0x48
+	return false
+}
+
+func (m *JobSetRequest) GetForceNew() bool {
+	if m != nil {
+		return m.ForceNew
 	}
-	if len(m.NodeName) > 0 {
-		i -= len(m.NodeName)
-		copy(dAtA[i:], m.NodeName)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.NodeName)))
-		i--
-		dAtA[i] = 0x42
+	return false
+}
+
+func (m *JobSetRequest) GetSchemaVersion() uint32 {
+	if m != nil {
+		return m.SchemaVersion
 	}
-	if len(m.KubernetesId) > 0 {
-		i -= len(m.KubernetesId)
-		copy(dAtA[i:], m.KubernetesId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.KubernetesId)))
-		i--
-		dAtA[i] = 0x3a
+	return 0
+}
+
+func (m *JobSetRequest) GetFromTime() *time.Time {
+	if m != nil {
+		return m.FromTime
 	}
-	if len(m.Reason) > 0 {
-		i -= len(m.Reason)
-		copy(dAtA[i:], m.Reason)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Reason)))
-		i--
-		dAtA[i] = 0x32
+	return nil
+}
+
+func (m *JobSetRequest) GetTerminalOnly() bool {
+	if m != nil {
+		return m.TerminalOnly
 	}
-	if len(m.ClusterId) > 0 {
-		i -= len(m.ClusterId)
-		copy(dAtA[i:], m.ClusterId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.ClusterId)))
-		i--
-		dAtA[i] = 0x2a
+	return false
+}
+
+func (m *JobSetRequest) GetEventTypes() []string {
+	if m != nil {
+		return m.EventTypes
 	}
-	n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
-	if err11 != nil {
-		return 0, err11
-	}
-	i -= n11
-	i = encodeVarintEvent(dAtA, i, uint64(n11))
-	i--
-	dAtA[i] = 0x22
-	if len(m.Queue) > 0 {
-		i -= len(m.Queue)
-		copy(dAtA[i:], m.Queue)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.JobSetId) > 0 {
-		i -= len(m.JobSetId)
-		copy(dAtA[i:], m.JobSetId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.JobId) > 0 {
-		i -= len(m.JobId)
-		copy(dAtA[i:], m.JobId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *JobFailedEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type WatchRequest struct {
+	Queue    string `protobuf:"bytes,1,opt,name=queue,proto3" json:"queue,omitempty"`
+	JobSetId string `protobuf:"bytes,2,opt,name=job_set_id,json=jobSetId,proto3" json:"jobSetId,omitempty"`
+	// If set, only events after the event with this id are sent, as for JobSetRequest.from_message_id.
+	FromId      string `protobuf:"bytes,3,opt,name=from_id,json=fromId,proto3" json:"fromId,omitempty"`
+	ForceLegacy bool   `protobuf:"varint,4,opt,name=force_legacy,json=forceLegacy,proto3" json:"forceLegacy,omitempty"`
+	ForceNew    bool   `protobuf:"varint,5,opt,name=force_new,json=forceNew,proto3" json:"forceNew,omitempty"`
+	// As for JobSetRequest.
+	SchemaVersion uint32 `protobuf:"varint,6,opt,name=schema_version,json=schemaVersion,proto3" json:"schemaVersion,omitempty"`
 }
 
-func (m *JobFailedEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *WatchRequest) Reset()      { *m = WatchRequest{} }
+func (*WatchRequest) ProtoMessage() {}
+func (*WatchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7758595c3bb8cf56, []int{37}
 }
-
-func (m *JobFailedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PodNamespace) > 0 {
-		i -= len(m.PodNamespace)
-		copy(dAtA[i:], m.PodNamespace)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.PodNamespace)))
-		i--
-		dAtA[i] = 0x72
-	}
-	if len(m.PodName) > 0 {
-		i -= len(m.PodName)
-		copy(dAtA[i:], m.PodName)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.PodName)))
-		i--
-		dAtA[i] = 0x6a
-	}
-	if m.Cause != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.Cause))
-		i--
-		dAtA[i] = 0x60
-	}
-	if len(m.ContainerStatuses) > 0 {
-		for iNdEx := len(m.ContainerStatuses) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ContainerStatuses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEvent(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x5a
-		}
-	}
-	if m.PodNumber != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.PodNumber))
-		i--
-		dAtA[i] = 0x50
-	}
-	if len(m.NodeName) > 0 {
-		i -= len(m.NodeName)
-		copy(dAtA[i:], m.NodeName)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.NodeName)))
-		i--
-		dAtA[i] = 0x4a
-	}
-	if len(m.KubernetesId) > 0 {
-		i -= len(m.KubernetesId)
-		copy(dAtA[i:], m.KubernetesId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.KubernetesId)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.ExitCodes) > 0 {
-		for k := range m.ExitCodes {
-			v := m.ExitCodes[k]
-			baseI := i
-			i = encodeVarintEvent(dAtA, i, uint64(v))
-			i--
-			dAtA[i] = 0x10
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintEvent(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintEvent(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x3a
+func (m *WatchRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WatchRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Reason) > 0 {
-		i -= len(m.Reason)
-		copy(dAtA[i:], m.Reason)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Reason)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.ClusterId) > 0 {
-		i -= len(m.ClusterId)
-		copy(dAtA[i:], m.ClusterId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.ClusterId)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
-	if err12 != nil {
-		return 0, err12
-	}
-	i -= n12
-	i = encodeVarintEvent(dAtA, i, uint64(n12))
-	i--
-	dAtA[i] = 0x22
-	if len(m.Queue) > 0 {
-		i -= len(m.Queue)
-		copy(dAtA[i:], m.Queue)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.JobSetId) > 0 {
-		i -= len(m.JobSetId)
-		copy(dAtA[i:], m.JobSetId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.JobId) > 0 {
-		i -= len(m.JobId)
-		copy(dAtA[i:], m.JobId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *WatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchRequest.Merge(m, src)
+}
+func (m *WatchRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *WatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchRequest.DiscardUnknown(m)
 }
 
-func (m *JobPreemptedEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_WatchRequest proto.InternalMessageInfo
+
+func (m *WatchRequest) GetQueue() string {
+	if m != nil {
+		return m.Queue
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *JobPreemptedEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *WatchRequest) GetJobSetId() string {
+	if m != nil {
+		return m.JobSetId
+	}
+	return ""
 }
 
-func (m *JobPreemptedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PreemptiveRunId) > 0 {
-		i -= len(m.PreemptiveRunId)
-		copy(dAtA[i:], m.PreemptiveRunId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.PreemptiveRunId)))
-		i--
-		dAtA[i] = 0x42
+func (m *WatchRequest) GetFromId() string {
+	if m != nil {
+		return m.FromId
 	}
-	if len(m.PreemptiveJobId) > 0 {
-		i -= len(m.PreemptiveJobId)
-		copy(dAtA[i:], m.PreemptiveJobId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.PreemptiveJobId)))
-		i--
-		dAtA[i] = 0x3a
+	return ""
+}
+
+func (m *WatchRequest) GetForceLegacy() bool {
+	if m != nil {
+		return m.ForceLegacy
 	}
-	if len(m.RunId) > 0 {
-		i -= len(m.RunId)
-		copy(dAtA[i:], m.RunId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.RunId)))
-		i--
-		dAtA[i] = 0x32
+	return false
+}
+
+func (m *WatchRequest) GetForceNew() bool {
+	if m != nil {
+		return m.ForceNew
 	}
-	if len(m.ClusterId) > 0 {
-		i -= len(m.ClusterId)
-		copy(dAtA[i:], m.ClusterId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.ClusterId)))
-		i--
-		dAtA[i] = 0x2a
+	return false
+}
+
+func (m *WatchRequest) GetSchemaVersion() uint32 {
+	if m != nil {
+		return m.SchemaVersion
 	}
-	n13, err13 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
-	if err13 != nil {
-		return 0, err13
-	}
-	i -= n13
-	i = encodeVarintEvent(dAtA, i, uint64(n13))
-	i--
-	dAtA[i] = 0x22
-	if len(m.Queue) > 0 {
-		i -= len(m.Queue)
-		copy(dAtA[i:], m.Queue)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.JobSetId) > 0 {
-		i -= len(m.JobSetId)
-		copy(dAtA[i:], m.JobSetId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.JobId) > 0 {
-		i -= len(m.JobId)
-		copy(dAtA[i:], m.JobId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *JobFailedEventCompressed) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func init() {
+	proto.RegisterEnum("api.Cause", Cause_name, Cause_value)
+	proto.RegisterType((*JobSubmittedEvent)(nil), "api.JobSubmittedEvent")
+	proto.RegisterType((*JobQueuedEvent)(nil), "api.JobQueuedEvent")
+	proto.RegisterType((*JobDuplicateFoundEvent)(nil), "api.JobDuplicateFoundEvent")
+	proto.RegisterType((*JobLeasedEvent)(nil), "api.JobLeasedEvent")
+	proto.RegisterType((*JobLeaseReturnedEvent)(nil), "api.JobLeaseReturnedEvent")
+	proto.RegisterType((*JobLeaseExpiredEvent)(nil), "api.JobLeaseExpiredEvent")
+	proto.RegisterType((*JobPendingEvent)(nil), "api.JobPendingEvent")
+	proto.RegisterType((*JobRunningEvent)(nil), "api.JobRunningEvent")
+	proto.RegisterMapType((map[string]string)(nil), "api.JobRunningEvent.NodeLabelsEntry")
+	proto.RegisterType((*JobIngressInfoEvent)(nil), "api.JobIngressInfoEvent")
+	proto.RegisterMapType((map[int32]string)(nil), "api.JobIngressInfoEvent.IngressAddressesEntry")
+	proto.RegisterType((*JobUnableToScheduleEvent)(nil), "api.JobUnableToScheduleEvent")
+	proto.RegisterType((*JobFailedEvent)(nil), "api.JobFailedEvent")
+	proto.RegisterMapType((map[string]int32)(nil), "api.JobFailedEvent.ExitCodesEntry")
+	proto.RegisterType((*JobSetExpiredEvent)(nil), "api.JobSetExpiredEvent")
+	proto.RegisterType((*JobSuspendedEvent)(nil), "api.JobSuspendedEvent")
+	proto.RegisterType((*JobResumedEvent)(nil), "api.JobResumedEvent")
+	proto.RegisterType((*JobEvictedForCapacityEvent)(nil), "api.JobEvictedForCapacityEvent")
+	proto.RegisterType((*JobRuntimeExceededEvent)(nil), "api.JobRuntimeExceededEvent")
+	proto.RegisterType((*JobResourcesNormalizedEvent)(nil), "api.JobResourcesNormalizedEvent")
+	proto.RegisterMapType((map[string]v1.ResourceRequirements)(nil), "api.JobResourcesNormalizedEvent.NormalizedResourcesEntry")
+	proto.RegisterMapType((map[string]v1.ResourceRequirements)(nil), "api.JobResourcesNormalizedEvent.OriginalResourcesEntry")
+	proto.RegisterType((*JobUnschedulableEvent)(nil), "api.JobUnschedulableEvent")
+	proto.RegisterType((*JobMaintenanceWindowStartedEvent)(nil), "api.JobMaintenanceWindowStartedEvent")
+	proto.RegisterType((*JobMaintenanceWindowEndedEvent)(nil), "api.JobMaintenanceWindowEndedEvent")
+	proto.RegisterType((*JobCordonedEvent)(nil), "api.JobCordonedEvent")
+	proto.RegisterType((*JobUncordonedEvent)(nil), "api.JobUncordonedEvent")
+	proto.RegisterType((*JobPreemptedEvent)(nil), "api.JobPreemptedEvent")
+	proto.RegisterType((*JobFailedEventCompressed)(nil), "api.JobFailedEventCompressed")
+	proto.RegisterType((*JobSucceededEvent)(nil), "api.JobSucceededEvent")
+	proto.RegisterType((*JobUtilisationEvent)(nil), "api.JobUtilisationEvent")
+	proto.RegisterMapType((map[string]resource.Quantity)(nil), "api.JobUtilisationEvent.MaxResourcesForPeriodEntry")
+	proto.RegisterMapType((map[string]resource.Quantity)(nil), "api.JobUtilisationEvent.TotalCumulativeUsageEntry")
+	proto.RegisterType((*JobReprioritizingEvent)(nil), "api.JobReprioritizingEvent")
+	proto.RegisterType((*JobReprioritizedEvent)(nil), "api.JobReprioritizedEvent")
+	proto.RegisterType((*JobCancellingEvent)(nil), "api.JobCancellingEvent")
+	proto.RegisterType((*JobCancelledEvent)(nil), "api.JobCancelledEvent")
+	proto.RegisterType((*JobTerminatedEvent)(nil), "api.JobTerminatedEvent")
+	proto.RegisterType((*JobUpdatedEvent)(nil), "api.JobUpdatedEvent")
+	proto.RegisterType((*EventMessage)(nil), "api.EventMessage")
+	proto.RegisterType((*ContainerStatus)(nil), "api.ContainerStatus")
+	proto.RegisterType((*EventList)(nil), "api.EventList")
+	proto.RegisterType((*EventStreamMessage)(nil), "api.EventStreamMessage")
+	proto.RegisterType((*JobSetRequest)(nil), "api.JobSetRequest")
+	proto.RegisterType((*WatchRequest)(nil), "api.WatchRequest")
 }
 
-func (m *JobFailedEventCompressed) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() { proto.RegisterFile("pkg/api/event.proto", fileDescriptor_7758595c3bb8cf56) }
+
+var fileDescriptor_7758595c3bb8cf56 = []byte{
+	// 3611 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5c, 0x4d, 0x6c, 0x24, 0xc5,
+	0xf5, 0xdf, 0x1e, 0x7b, 0xc6, 0x33, 0x35, 0xfe, 0x2c, 0x7f, 0x6c, 0xef, 0xec, 0xae, 0xc7, 0xff,
+	0x86, 0x3f, 0x2c, 0x2b, 0x18, 0x83, 0x17, 0x12, 0x40, 0x49, 0xd0, 0x8e, 0xf1, 0xc2, 0x3a, 0xfb,
+	0xc5, 0x78, 0x37, 0x24, 0x11, 0xca, 0xd0, 0xd3, 0x5d, 0xb6, 0x7b, 0xdd, 0xdd, 0x35, 0xf4, 0xc7,
+	0xda, 0x06, 0x21, 0x25, 0x41, 0x49, 0xc8, 0x01, 0x05, 0x29, 0x51, 0x94, 0x0f, 0x21, 0x50, 0x8e,
+	0x51, 0x0e, 0x51, 0xa4, 0x1c, 0x50, 0x24, 0x4e, 0x39, 0x90, 0x9c, 0x88, 0x22, 0x24, 0x4e, 0x93,
+	0xb0, 0x90, 0xcb, 0x1c, 0x72, 0x4f, 0x4e, 0x51, 0x7d, 0x74, 0x77, 0x55, 0x4f, 0x0f, 0xf6, 0x9a,
+	0x85, 0xac, 0x9c, 0xb9, 0xec, 0x7a, 0x7e, 0xaf, 0xde, 0xab, 0xd7, 0xaf, 0xdf, 0xab, 0xaa, 0x57,
+	0xf5, 0xaa, 0xc1, 0x74, 0x7b, 0x6b, 0x63, 0x51, 0x6f, 0x5b, 0x8b, 0xe8, 0x06, 0x72, 0x83, 0x5a,
+	0xdb, 0xc3, 0x01, 0x86, 0x43, 0x7a, 0xdb, 0xaa, 0x54, 0x37, 0x30, 0xde, 0xb0, 0xd1, 0x22, 0x85,
+	0x5a, 0xe1, 0xfa, 0x62, 0x60, 0x39, 0xc8, 0x0f, 0x74, 0xa7, 0xcd, 0x5a, 0x55, 0x62, 0xd6, 0x17,
+	0x42, 0x14, 0x22, 0x0e, 0xce, 0x44, 0xe0, 0x26, 0xd2, 0xed, 0x60, 0x93, 0xa3, 0xc7, 0xd3, 0xb2,
+	0x90, 0xd3, 0x0e, 0x76, 0x39, 0xf1, 0x81, 0x0d, 0x2b, 0xd8, 0x0c, 0x5b, 0x35, 0x03, 0x3b, 0x8b,
+	0x1b, 0x78, 0x03, 0x27, 0xad, 0xc8, 0x2f, 0xfa, 0x83, 0xfe, 0xc5, 0x9b, 0x9f, 0xe0, 0xb2, 0x48,
+	0x27, 0xba, 0xeb, 0xe2, 0x40, 0x0f, 0x2c, 0xec, 0xfa, 0x9c, 0xfa, 0xf0, 0xd6, 0xa3, 0x7e, 0xcd,
+	0xc2, 0x84, 0xea, 0xe8, 0xc6, 0xa6, 0xe5, 0x22, 0x6f, 0x77, 0x31, 0xd2, 0xc9, 0x43, 0x3e, 0x0e,
+	0x3d, 0x03, 0x2d, 0x6e, 0x20, 0x17, 0x79, 0x7a, 0x80, 0x4c, 0xce, 0xa5, 0x25, 0x5c, 0x8b, 0x06,
+	0xf6, 0xd0, 0xe2, 0x8d, 0x87, 0xd2, 0x6d, 0xb4, 0x9f, 0xe4, 0xc0, 0xd4, 0x2a, 0x6e, 0xad, 0x85,
+	0x2d, 0xc7, 0x0a, 0x02, 0x64, 0xae, 0x10, 0x83, 0xc1, 0xd3, 0xa0, 0x70, 0x1d, 0xb7, 0x9a, 0x96,
+	0xa9, 0x2a, 0x0b, 0xca, 0xa9, 0x52, 0x7d, 0xba, 0xdb, 0xa9, 0x4e, 0x5c, 0xc7, 0xad, 0xf3, 0xe6,
+	0xfd, 0xd8, 0xb1, 0x02, 0xfa, 0x9c, 0x8d, 0x3c, 0x05, 0xe0, 0xc3, 0x00, 0x90, 0xb6, 0x3e, 0x0a,
+	0x48, 0xfb, 0x1c, 0x6d, 0x3f, 0xd7, 0xed, 0x54, 0xe1, 0x75, 0xdc, 0x5a, 0x43, 0x81, 0xc4, 0x52,
+	0x8c, 0x30, 0x78, 0x1f, 0xc8, 0x53, 0x03, 0xab, 0x43, 0x49, 0x07, 0x14, 0x10, 0x3b, 0xa0, 0x00,
+	0x3c, 0x0f, 0x46, 0x0c, 0x0f, 0x11, 0x9d, 0xd5, 0xe1, 0x05, 0xe5, 0x54, 0x79, 0xa9, 0x52, 0x63,
+	0xc6, 0xaa, 0x45, 0x26, 0xad, 0x5d, 0x8d, 0x5e, 0x62, 0x7d, 0xfa, 0xdd, 0x4e, 0xf5, 0x48, 0xb7,
+	0x53, 0x8d, 0x58, 0x5e, 0xff, 0x5b, 0x55, 0x69, 0x44, 0x3f, 0xe0, 0xbd, 0x60, 0xe8, 0x3a, 0x6e,
+	0xa9, 0x79, 0x2a, 0xa6, 0x58, 0xd3, 0xdb, 0x56, 0x6d, 0x15, 0xb7, 0xea, 0x65, 0xce, 0x44, 0x88,
+	0x0d, 0xf2, 0x8f, 0xf6, 0xf3, 0x1c, 0x18, 0x5f, 0xc5, 0xad, 0x67, 0x88, 0x02, 0x87, 0xdc, 0x26,
+	0x8b, 0x60, 0x44, 0x0f, 0xa8, 0x74, 0x6a, 0x97, 0xb1, 0xfa, 0x6c, 0xb7, 0x53, 0x9d, 0xe2, 0x90,
+	0xd0, 0x73, 0xd4, 0x4a, 0xfb, 0x7d, 0x0e, 0xcc, 0xad, 0xe2, 0xd6, 0x93, 0x61, 0xdb, 0xb6, 0x0c,
+	0x3d, 0x40, 0xe7, 0x70, 0xe8, 0x1e, 0x72, 0x1b, 0x2d, 0x83, 0x09, 0xec, 0x59, 0x1b, 0x96, 0xab,
+	0xdb, 0x4d, 0xfe, 0x80, 0x79, 0xda, 0xff, 0xf1, 0x6e, 0xa7, 0x7a, 0x34, 0x22, 0xad, 0xa6, 0x1e,
+	0x74, 0x4c, 0x22, 0x68, 0xef, 0x32, 0x9f, 0xba, 0x80, 0x74, 0xff, 0xb0, 0xfb, 0xd4, 0x17, 0x00,
+	0x30, 0xec, 0xd0, 0x0f, 0x90, 0x97, 0x98, 0xea, 0x68, 0xb7, 0x53, 0x9d, 0xe6, 0xa8, 0xa4, 0x6c,
+	0x29, 0x06, 0xe1, 0x3d, 0x60, 0xb8, 0x8d, 0xb1, 0xad, 0x16, 0x28, 0x07, 0xec, 0x76, 0xaa, 0xe3,
+	0xe4, 0xb7, 0xd0, 0x98, 0xd2, 0xb5, 0x1f, 0x0d, 0x83, 0xd9, 0xc8, 0x94, 0x0d, 0x14, 0x84, 0x9e,
+	0x3b, 0xb0, 0x68, 0xb6, 0x45, 0xef, 0x07, 0x05, 0x0f, 0xe9, 0x3e, 0x76, 0xb9, 0x4d, 0x67, 0xba,
+	0x9d, 0xea, 0x24, 0x43, 0x04, 0x06, 0xde, 0x06, 0x3e, 0x01, 0xc6, 0xb6, 0xc2, 0x16, 0xf2, 0x5c,
+	0x14, 0x20, 0x9f, 0x74, 0x34, 0x42, 0x99, 0x2a, 0xdd, 0x4e, 0x75, 0x2e, 0x21, 0x48, 0x7d, 0x8d,
+	0x8a, 0x38, 0x51, 0xb3, 0x8d, 0xcd, 0xa6, 0x1b, 0x3a, 0x2d, 0xe4, 0xa9, 0xc5, 0x05, 0xe5, 0x54,
+	0x9e, 0xa9, 0xd9, 0xc6, 0xe6, 0x25, 0x0a, 0x8a, 0x6a, 0xc6, 0x20, 0xe9, 0xd8, 0x0b, 0xdd, 0x26,
+	0x1f, 0x62, 0x90, 0xa9, 0x96, 0x16, 0x94, 0x53, 0x45, 0xd6, 0xb1, 0x17, 0xba, 0x67, 0x23, 0x5c,
+	0xec, 0x58, 0xc4, 0xb5, 0x7f, 0x2a, 0x60, 0x26, 0xf2, 0x88, 0x95, 0x9d, 0xb6, 0xe5, 0x1d, 0x72,
+	0x87, 0xd0, 0x5e, 0x1b, 0x06, 0x13, 0xab, 0xb8, 0x75, 0x05, 0xb9, 0xa6, 0xe5, 0x6e, 0x0c, 0x9c,
+	0x3f, 0xcb, 0xf9, 0x7b, 0xdc, 0xb9, 0xf0, 0xa9, 0xdc, 0x79, 0x64, 0xdf, 0xee, 0xfc, 0x20, 0x28,
+	0x52, 0x3e, 0xdd, 0x41, 0x34, 0x08, 0x4a, 0x6c, 0x52, 0x25, 0x0d, 0x74, 0x47, 0xb4, 0xd5, 0x08,
+	0x87, 0x88, 0xaa, 0x11, 0x87, 0xdf, 0xd6, 0x0d, 0xa4, 0x96, 0x12, 0x55, 0x79, 0x1b, 0x8a, 0x8b,
+	0xaa, 0x8a, 0xb8, 0xf6, 0xbb, 0x02, 0xf5, 0x87, 0x46, 0xe8, 0xba, 0x03, 0x7f, 0xf8, 0xac, 0xfc,
+	0xe1, 0x0c, 0x28, 0xb9, 0xd8, 0x44, 0xec, 0xc5, 0x8e, 0x24, 0x36, 0x22, 0x60, 0xea, 0xcd, 0x16,
+	0x23, 0xec, 0xc0, 0x63, 0xa2, 0xe8, 0x44, 0xa5, 0x83, 0x39, 0x11, 0xb8, 0x35, 0x27, 0x8a, 0xe7,
+	0xdf, 0xf2, 0x27, 0xcf, 0xbf, 0xb0, 0x09, 0xca, 0xd4, 0x0e, 0xb6, 0xde, 0x42, 0xb6, 0xaf, 0x8e,
+	0x2e, 0x0c, 0x9d, 0x2a, 0x2f, 0xdd, 0x1d, 0xad, 0xa7, 0x45, 0x1f, 0xac, 0x5d, 0xc2, 0x26, 0xba,
+	0x40, 0x9b, 0xad, 0xb8, 0x81, 0xb7, 0x5b, 0x57, 0xbb, 0x9d, 0xea, 0x8c, 0x1b, 0x83, 0x82, 0x68,
+	0x90, 0xa0, 0x15, 0x04, 0x26, 0x52, 0x8c, 0xf0, 0x2e, 0x30, 0xb4, 0x85, 0x76, 0xb9, 0x27, 0x4f,
+	0x75, 0x3b, 0xd5, 0xb1, 0x2d, 0xb4, 0x2b, 0xb0, 0x13, 0x2a, 0xf1, 0xc7, 0x1b, 0xba, 0x1d, 0x22,
+	0x35, 0x97, 0xf8, 0x23, 0x05, 0x44, 0x7f, 0xa4, 0xc0, 0xe3, 0xb9, 0x47, 0x15, 0xed, 0xb7, 0x05,
+	0x30, 0x4d, 0x16, 0x67, 0xee, 0x86, 0x87, 0x7c, 0xff, 0xbc, 0xbb, 0x8e, 0x07, 0x81, 0x73, 0xb8,
+	0x02, 0x07, 0x1c, 0x2c, 0x70, 0xca, 0xb7, 0x18, 0x38, 0x2f, 0x81, 0x29, 0x8b, 0x39, 0x51, 0x53,
+	0x37, 0x4d, 0xf2, 0x3f, 0xf2, 0xd5, 0x12, 0x0d, 0x8b, 0x5a, 0x14, 0x16, 0x69, 0x2f, 0xab, 0x71,
+	0xe0, 0x6c, 0xc4, 0xc0, 0x02, 0x64, 0xbe, 0xdb, 0xa9, 0x56, 0xac, 0x14, 0x49, 0xe8, 0x78, 0x32,
+	0x4d, 0xab, 0x6c, 0x81, 0xd9, 0x4c, 0x51, 0x62, 0xc8, 0xe4, 0x6f, 0x57, 0xc8, 0xfc, 0x6b, 0x18,
+	0xa8, 0xab, 0xb8, 0x75, 0xcd, 0xd5, 0x5b, 0x36, 0xba, 0x8a, 0xd7, 0x8c, 0x4d, 0x64, 0x86, 0x36,
+	0x1a, 0xc4, 0xcd, 0x1d, 0xb0, 0xfa, 0x96, 0xa2, 0xac, 0x78, 0xa0, 0x28, 0x2b, 0xdd, 0xc1, 0x51,
+	0xa6, 0xbd, 0x5d, 0xa4, 0x19, 0xf4, 0x39, 0xdd, 0xb2, 0x07, 0xf9, 0xde, 0xed, 0xf0, 0xb8, 0xe7,
+	0x00, 0x40, 0x3b, 0x56, 0xd0, 0x34, 0xb0, 0x89, 0x7c, 0x75, 0x84, 0x8e, 0x57, 0x5a, 0x34, 0x5e,
+	0x09, 0x66, 0xae, 0xad, 0xec, 0x58, 0xc1, 0x32, 0x36, 0xf9, 0xc0, 0x52, 0x3f, 0x46, 0x34, 0x41,
+	0x11, 0x96, 0x08, 0x56, 0x95, 0x46, 0x29, 0x86, 0x7b, 0xfd, 0xb9, 0xf8, 0x69, 0xfc, 0xb9, 0x74,
+	0x20, 0x7f, 0x06, 0x07, 0xf2, 0xe7, 0xb1, 0x83, 0xf9, 0xf3, 0xf8, 0x2d, 0xce, 0x1a, 0x26, 0x80,
+	0x06, 0x76, 0x03, 0xdd, 0x72, 0x91, 0xd7, 0xf4, 0x03, 0x3d, 0x08, 0xc9, 0xb4, 0x51, 0xa6, 0xaf,
+	0x61, 0x86, 0xbe, 0x86, 0xe5, 0x88, 0xbc, 0x46, 0xa9, 0xf5, 0x6a, 0xb7, 0x53, 0x3d, 0x6e, 0xc8,
+	0xa0, 0x34, 0x3b, 0x4c, 0xf5, 0x10, 0xe1, 0x23, 0x20, 0x6f, 0xe8, 0xa1, 0x8f, 0xd4, 0xd1, 0x05,
+	0xe5, 0xd4, 0xf8, 0x12, 0x60, 0x82, 0x09, 0xc2, 0x9c, 0x99, 0x12, 0x45, 0x67, 0xa6, 0x00, 0xb1,
+	0xe3, 0xb6, 0x65, 0xdb, 0x4d, 0x0f, 0x05, 0xde, 0xae, 0x3a, 0x41, 0xf3, 0x71, 0x6a, 0x47, 0x82,
+	0x36, 0x08, 0x28, 0xda, 0x31, 0x06, 0xc5, 0xfd, 0xc4, 0xc9, 0xfd, 0xec, 0x27, 0x56, 0x4c, 0x30,
+	0x2e, 0xbb, 0xd7, 0x01, 0x96, 0x7a, 0xf9, 0x3d, 0xe7, 0xad, 0x3f, 0xe4, 0x00, 0x5c, 0xa5, 0x31,
+	0xfd, 0xbf, 0xb0, 0x3d, 0x00, 0x2f, 0x82, 0xe9, 0x48, 0xd7, 0x20, 0xb0, 0x9b, 0x3e, 0x32, 0xb0,
+	0x6b, 0xfa, 0x74, 0x20, 0x19, 0x62, 0x4b, 0x0c, 0xa6, 0xe0, 0xd5, 0xc0, 0x5e, 0x63, 0x34, 0x71,
+	0x89, 0x91, 0xa6, 0x69, 0xbf, 0x8a, 0x8e, 0x09, 0xfc, 0x36, 0x72, 0xcd, 0xc3, 0x6e, 0xbc, 0x47,
+	0x40, 0xc9, 0x43, 0x2f, 0x84, 0xc8, 0x0f, 0xb0, 0x27, 0x8e, 0xbd, 0x31, 0x28, 0x7a, 0x7e, 0x0c,
+	0x6a, 0x6f, 0xe5, 0x58, 0x0a, 0x8e, 0xfc, 0xd0, 0x19, 0x98, 0x28, 0xd3, 0x44, 0xbf, 0xc9, 0x81,
+	0xca, 0x2a, 0x6e, 0xad, 0xdc, 0xb0, 0x8c, 0x00, 0x99, 0xe7, 0xb0, 0xb7, 0xac, 0xb7, 0x75, 0xc3,
+	0x0a, 0x76, 0x07, 0xb3, 0x79, 0xc6, 0x6c, 0xae, 0xfd, 0x3b, 0x07, 0x8e, 0xb2, 0x84, 0x3a, 0xb0,
+	0x1c, 0xb4, 0xb2, 0x63, 0x20, 0x64, 0x0e, 0x56, 0x3e, 0xd9, 0x2b, 0x9f, 0xcb, 0x60, 0xda, 0xd1,
+	0x77, 0x9a, 0x1e, 0xb3, 0x55, 0x3c, 0xe2, 0x15, 0xe8, 0x1c, 0x44, 0xe7, 0x4d, 0x47, 0xdf, 0xe1,
+	0x96, 0xec, 0x1d, 0xf2, 0xa6, 0x7a, 0x88, 0xda, 0xdb, 0x05, 0x70, 0x9c, 0x85, 0x33, 0x3d, 0x5e,
+	0xf5, 0x2f, 0x61, 0xcf, 0xd1, 0x6d, 0xeb, 0xc5, 0xc3, 0xfe, 0x02, 0xbe, 0xa3, 0x00, 0x18, 0x9f,
+	0x76, 0x45, 0x87, 0xcb, 0x64, 0xea, 0x20, 0xcb, 0x92, 0x2f, 0xc6, 0x9b, 0x3c, 0x7d, 0xcc, 0x52,
+	0xbb, 0xcc, 0x59, 0xe3, 0x06, 0x7c, 0xc9, 0xc8, 0xfb, 0x9c, 0xc2, 0x69, 0x7a, 0xa3, 0x17, 0x82,
+	0x3f, 0x54, 0xc0, 0x8c, 0x1b, 0x0b, 0x16, 0xb4, 0x28, 0x50, 0x2d, 0x1e, 0xdb, 0x53, 0x8b, 0xe4,
+	0x77, 0x4a, 0x8f, 0xe3, 0x5c, 0x8f, 0x69, 0xb7, 0xb7, 0x45, 0x23, 0x0b, 0xac, 0xfc, 0x54, 0x01,
+	0x73, 0xd9, 0x0f, 0xb5, 0xbf, 0x85, 0xca, 0x9a, 0xb8, 0x50, 0x29, 0x2f, 0x9d, 0xaa, 0xb1, 0x63,
+	0x79, 0xfa, 0x08, 0x06, 0xf6, 0x50, 0xed, 0xc6, 0x43, 0xb5, 0x48, 0x6e, 0x03, 0xbd, 0x10, 0x5a,
+	0x1e, 0x72, 0x90, 0x1b, 0xf8, 0x7b, 0x2d, 0x69, 0x2a, 0x3f, 0x53, 0x80, 0xda, 0xef, 0x39, 0xff,
+	0xbb, 0xaa, 0x69, 0x6f, 0xe4, 0xe8, 0x01, 0xdd, 0x35, 0xd7, 0x67, 0xfb, 0x03, 0x64, 0xb3, 0xe0,
+	0x70, 0x47, 0x4d, 0x92, 0x78, 0xe5, 0xf7, 0x4e, 0xbc, 0xb4, 0x37, 0x86, 0xc1, 0xc2, 0x2a, 0x6e,
+	0x5d, 0xd4, 0x2d, 0x37, 0x40, 0xae, 0xee, 0x1a, 0xe8, 0x59, 0xcb, 0x35, 0xf1, 0xf6, 0x5a, 0xa0,
+	0x7b, 0x87, 0xbe, 0x0a, 0xe3, 0x1a, 0x98, 0x75, 0x92, 0x07, 0x6f, 0x6e, 0xd3, 0x27, 0x4f, 0x06,
+	0xfb, 0xff, 0xeb, 0x76, 0xaa, 0x27, 0x9d, 0xb4, 0x65, 0xa4, 0x27, 0x98, 0xce, 0x20, 0xc3, 0x25,
+	0x50, 0x44, 0x3b, 0xc8, 0x08, 0xc9, 0x8a, 0xa4, 0x90, 0x18, 0x20, 0xc2, 0x44, 0x03, 0x44, 0x18,
+	0xfc, 0x32, 0x18, 0x42, 0x2e, 0xdb, 0x68, 0xf9, 0xe4, 0x27, 0x9a, 0x88, 0x4a, 0x44, 0x90, 0xcb,
+	0x9e, 0x86, 0xfc, 0x41, 0xec, 0x67, 0x7a, 0xba, 0xe5, 0xd2, 0xcc, 0xb6, 0xc8, 0xec, 0x47, 0x01,
+	0xd1, 0x7e, 0x14, 0x10, 0xfc, 0xa3, 0xb4, 0x0f, 0xff, 0x78, 0x65, 0x08, 0xcc, 0x67, 0xf9, 0xc7,
+	0x8a, 0x6b, 0x0e, 0xbc, 0xe3, 0xf3, 0xf2, 0x0e, 0xed, 0x07, 0x43, 0x60, 0x72, 0x15, 0xb7, 0x96,
+	0xb1, 0x67, 0xe2, 0x43, 0x5f, 0x61, 0x20, 0x1a, 0x28, 0xbf, 0xcf, 0xf0, 0x79, 0x0c, 0x94, 0x0d,
+	0x6e, 0x9c, 0x66, 0x6b, 0x97, 0xdb, 0x95, 0x9e, 0xf0, 0x44, 0x70, 0x5d, 0x9c, 0x73, 0x40, 0x82,
+	0x0a, 0xf1, 0x30, 0xb2, 0x8f, 0x78, 0xf8, 0x90, 0x65, 0xef, 0xd7, 0x5c, 0x63, 0xf0, 0x2e, 0xfa,
+	0xbc, 0x8b, 0x27, 0xc0, 0x58, 0xe8, 0xf6, 0xbe, 0x0d, 0xba, 0x1b, 0x15, 0xba, 0x99, 0xef, 0x63,
+	0x54, 0xc4, 0xb5, 0x3f, 0x0f, 0xd3, 0x1c, 0xff, 0x8a, 0x87, 0x10, 0xad, 0xa9, 0x18, 0xa4, 0x19,
+	0x59, 0x69, 0xc6, 0x69, 0x50, 0x20, 0x95, 0x2a, 0xf1, 0x19, 0x18, 0x55, 0xd7, 0x0b, 0x5d, 0xd9,
+	0x1e, 0x14, 0x80, 0xe7, 0xc1, 0x54, 0x9b, 0x59, 0xd3, 0xba, 0x81, 0xa2, 0xc2, 0x31, 0xe6, 0xee,
+	0x27, 0xbb, 0x9d, 0xea, 0xb1, 0x84, 0x98, 0x2e, 0x1d, 0x9b, 0x48, 0x91, 0x52, 0xa2, 0xb8, 0x06,
+	0xc5, 0x2c, 0x51, 0x8d, 0xd0, 0xed, 0x27, 0x8a, 0x92, 0xe4, 0xd4, 0xbd, 0xb4, 0xdf, 0xd4, 0x5d,
+	0x08, 0x58, 0xb0, 0x8f, 0x80, 0x5d, 0x01, 0xaa, 0xbc, 0x85, 0xbc, 0x8c, 0x9d, 0x36, 0x3d, 0x9b,
+	0xa2, 0x2f, 0x9c, 0xd6, 0xe5, 0x52, 0x8f, 0x1a, 0x65, 0x16, 0xa4, 0x80, 0x68, 0x41, 0x0a, 0x68,
+	0x7f, 0x1c, 0xe6, 0xfb, 0x4e, 0xc6, 0x20, 0xf5, 0x1d, 0xd4, 0x35, 0x1c, 0xb4, 0xae, 0x41, 0x7b,
+	0xb3, 0x44, 0xcf, 0xf9, 0xaf, 0x05, 0x96, 0x6d, 0xf9, 0xb4, 0xb2, 0x7a, 0xe0, 0x48, 0x9f, 0x89,
+	0x23, 0xbd, 0xaa, 0x80, 0xd9, 0x8b, 0xfa, 0x4e, 0x9c, 0x8b, 0x9e, 0xc3, 0xde, 0x15, 0xe4, 0x59,
+	0xd8, 0xe4, 0x87, 0x4b, 0x67, 0xa2, 0xc4, 0x3d, 0xfd, 0x2a, 0x6a, 0x99, 0x5c, 0x2c, 0x65, 0x3f,
+	0xc9, 0x9f, 0x35, 0x5b, 0x72, 0x23, 0x1b, 0x3e, 0xec, 0x87, 0xa1, 0xf0, 0xfb, 0x0a, 0x98, 0x0b,
+	0x70, 0xa0, 0xdb, 0x4d, 0x23, 0x74, 0x42, 0x5b, 0xa7, 0x13, 0x43, 0xe8, 0xeb, 0x1b, 0x88, 0xd7,
+	0xe3, 0x2c, 0xf5, 0xb5, 0xf5, 0x55, 0xc2, 0xb6, 0x1c, 0x73, 0x5d, 0x23, 0x4c, 0xcc, 0xd4, 0x27,
+	0xb8, 0xa9, 0x67, 0x82, 0x8c, 0x26, 0x8d, 0x4c, 0xb4, 0xf2, 0x96, 0x02, 0x2a, 0xfd, 0xdf, 0xde,
+	0xfe, 0x36, 0x22, 0xbe, 0x21, 0x6f, 0x44, 0xd4, 0x84, 0x8d, 0x88, 0xf8, 0xc2, 0x43, 0xad, 0xbd,
+	0xb5, 0x41, 0x1f, 0x29, 0xda, 0x0d, 0xaa, 0x3d, 0x13, 0xea, 0x6e, 0x60, 0x05, 0xbb, 0x7b, 0xee,
+	0x94, 0xbc, 0xa9, 0x80, 0x63, 0x7d, 0x1f, 0xfa, 0x4e, 0xd0, 0x50, 0xfb, 0x07, 0x2b, 0xaa, 0x6f,
+	0xa0, 0xb6, 0x67, 0x61, 0xcf, 0x0a, 0xac, 0x17, 0x0f, 0x7d, 0x15, 0xdf, 0x97, 0xc0, 0xa8, 0x8b,
+	0xb6, 0x9b, 0xfc, 0x81, 0x77, 0xe9, 0x30, 0xa5, 0xd0, 0xa3, 0xe5, 0x59, 0x17, 0x6d, 0x5f, 0xe1,
+	0xb0, 0xa0, 0x42, 0x59, 0x80, 0xe5, 0x55, 0x4c, 0x61, 0xdf, 0x07, 0x10, 0x1f, 0xb3, 0x8d, 0x29,
+	0xc1, 0xce, 0xc8, 0x1c, 0x98, 0xf9, 0xb6, 0x9b, 0xf9, 0x2f, 0x2c, 0x5f, 0x5b, 0x26, 0x29, 0xb8,
+	0x6d, 0x1f, 0x7a, 0x57, 0x3e, 0xd8, 0x69, 0xd8, 0xad, 0x15, 0x6b, 0x68, 0xef, 0xb1, 0x33, 0x58,
+	0x6e, 0xd3, 0xc1, 0x01, 0xe3, 0x6d, 0x30, 0xe9, 0x3b, 0xc3, 0xd4, 0x4d, 0xaf, 0x22, 0xcf, 0xb1,
+	0x5c, 0x7d, 0x90, 0xf3, 0xde, 0xc9, 0x75, 0xf4, 0x9f, 0x53, 0x09, 0x74, 0xe2, 0x40, 0xc5, 0x7d,
+	0x38, 0xd0, 0x9f, 0xd8, 0x91, 0xff, 0xb5, 0xb6, 0xa9, 0x07, 0x83, 0x88, 0xcc, 0x8c, 0x48, 0x7e,
+	0xe7, 0xb2, 0xb0, 0xe7, 0x9d, 0xcb, 0x5f, 0x1e, 0x05, 0xa3, 0xd4, 0x82, 0x17, 0x91, 0x4f, 0x16,
+	0x67, 0xf0, 0x32, 0x28, 0xf9, 0xd1, 0xbd, 0x54, 0x6a, 0xcb, 0xf2, 0xd2, 0x5c, 0xc4, 0x2f, 0x5f,
+	0x58, 0x65, 0x8a, 0xc4, 0x8d, 0x13, 0x45, 0x9e, 0x3e, 0xd2, 0x48, 0x64, 0xc0, 0x65, 0x50, 0xa0,
+	0x56, 0x31, 0xf9, 0x22, 0x6e, 0x3a, 0x92, 0x26, 0xdc, 0xf3, 0x64, 0x2f, 0x9c, 0x35, 0x93, 0xe4,
+	0x70, 0x56, 0x68, 0x82, 0x09, 0x33, 0xba, 0xfa, 0xd8, 0x5c, 0xc7, 0xa1, 0x6b, 0xd2, 0x3a, 0xa7,
+	0xf2, 0xd2, 0xf1, 0x48, 0x5a, 0xc6, 0xcd, 0xc8, 0xfa, 0x89, 0x6e, 0xa7, 0xaa, 0x9a, 0x12, 0x41,
+	0x92, 0x3e, 0x2e, 0xd3, 0x88, 0xaa, 0x36, 0xbd, 0x28, 0xa8, 0x0e, 0xc9, 0xaa, 0x0a, 0xd7, 0x07,
+	0x99, 0xaa, 0xac, 0x99, 0xac, 0x2a, 0xc3, 0xe0, 0xf3, 0x60, 0x9c, 0xfe, 0xd5, 0xf4, 0xf8, 0x1d,
+	0xb9, 0xd8, 0x07, 0x44, 0x61, 0xd2, 0x05, 0x3a, 0x76, 0xa3, 0xd1, 0x16, 0x71, 0x49, 0xf4, 0x98,
+	0x44, 0x82, 0xcf, 0x01, 0x06, 0x34, 0x11, 0x2b, 0xaa, 0xe2, 0x57, 0x6b, 0x8f, 0x49, 0x1d, 0x88,
+	0x05, 0x57, 0x2c, 0x12, 0x6d, 0x01, 0x96, 0xc4, 0x8f, 0x8a, 0x14, 0xf8, 0x14, 0x18, 0x69, 0xb3,
+	0xfb, 0x4d, 0xdc, 0x7d, 0x66, 0x22, 0xb9, 0xe2, 0xb5, 0x27, 0x3e, 0x26, 0x30, 0x44, 0x92, 0x16,
+	0x71, 0x13, 0x41, 0x1e, 0xbb, 0x94, 0xa0, 0x8e, 0xc8, 0x82, 0xc4, 0xbb, 0x0a, 0x4c, 0x10, 0x6f,
+	0x28, 0x0b, 0xe2, 0x20, 0x74, 0x00, 0x0c, 0x69, 0xe5, 0x73, 0x33, 0xc0, 0x4d, 0x7e, 0xb6, 0xc9,
+	0xb2, 0xcb, 0xf2, 0xd2, 0xc9, 0x38, 0xdf, 0xca, 0xaa, 0x8d, 0x66, 0x45, 0x57, 0x61, 0x8a, 0x24,
+	0xf5, 0x32, 0x99, 0xa6, 0x12, 0x2f, 0x58, 0xa7, 0x5b, 0x68, 0x6a, 0x49, 0xf6, 0x02, 0x61, 0x63,
+	0x8d, 0x79, 0x01, 0x6b, 0x26, 0x7b, 0x01, 0xc3, 0x58, 0x18, 0xf1, 0xfd, 0x33, 0x15, 0xa4, 0xc3,
+	0x48, 0xdc, 0x58, 0x8b, 0xc2, 0x88, 0x63, 0xe9, 0x30, 0xe2, 0x30, 0x6c, 0x82, 0x31, 0x4f, 0x5c,
+	0x3f, 0xab, 0x65, 0xd9, 0xab, 0x7a, 0x17, 0xd7, 0xcc, 0xab, 0x24, 0x26, 0xd9, 0xab, 0x24, 0x12,
+	0x5c, 0x03, 0xc0, 0x88, 0x57, 0x8e, 0xb4, 0x6c, 0xb1, 0xbc, 0x74, 0x34, 0x92, 0x9e, 0x5a, 0x53,
+	0xf2, 0xe3, 0x86, 0x18, 0x94, 0xe4, 0x0a, 0x62, 0x88, 0x19, 0xf8, 0x2f, 0x64, 0xaa, 0x63, 0xb2,
+	0x19, 0xe4, 0x35, 0x15, 0x9f, 0x13, 0x23, 0x4c, 0x36, 0x43, 0x0c, 0x13, 0x2d, 0x83, 0x78, 0xe1,
+	0xa0, 0x8e, 0xcb, 0x5a, 0xa6, 0x96, 0x14, 0x4c, 0xcb, 0xa4, 0xb9, 0xac, 0x65, 0x82, 0xc3, 0x67,
+	0x41, 0x39, 0x4c, 0xd2, 0x75, 0x5a, 0x76, 0x59, 0x5e, 0x52, 0xfb, 0x65, 0xf2, 0x6c, 0x19, 0x2f,
+	0x30, 0x48, 0x72, 0x45, 0x49, 0xf0, 0xeb, 0x60, 0x34, 0xba, 0xa1, 0x60, 0xb9, 0xeb, 0x58, 0x9d,
+	0x92, 0x25, 0xa7, 0x2f, 0x27, 0x30, 0xc9, 0x56, 0x82, 0xca, 0x92, 0x05, 0x02, 0x34, 0xc0, 0xb8,
+	0x27, 0xa5, 0xad, 0x2a, 0x94, 0xc7, 0xc3, 0x8c, 0xa4, 0x96, 0x8d, 0x87, 0x32, 0x9b, 0x3c, 0x1e,
+	0xca, 0x34, 0x12, 0xc1, 0x21, 0x9b, 0x64, 0xd5, 0x69, 0x39, 0x82, 0xc5, 0xb9, 0x97, 0x45, 0x30,
+	0x6f, 0x28, 0x47, 0x30, 0x07, 0xe1, 0x16, 0xe0, 0xb1, 0x92, 0x6c, 0x48, 0xab, 0x33, 0x72, 0xfc,
+	0x66, 0xee, 0x5a, 0xb3, 0xf8, 0x4d, 0xb3, 0xca, 0xf1, 0x9b, 0xa6, 0x12, 0x9f, 0x6b, 0x47, 0xc7,
+	0x29, 0xea, 0xac, 0xec, 0x73, 0xf2, 0x39, 0x0b, 0x5f, 0x0e, 0x45, 0x98, 0xec, 0x73, 0x31, 0x0c,
+	0xbf, 0x05, 0x26, 0xa2, 0xf5, 0x42, 0x34, 0xe2, 0xce, 0xc9, 0x8e, 0x97, 0x2a, 0x70, 0x65, 0x91,
+	0x77, 0x5d, 0xc4, 0xe5, 0xc8, 0x93, 0x48, 0x6c, 0xac, 0xe0, 0x35, 0x9e, 0xea, 0xd1, 0xf4, 0x58,
+	0x21, 0x16, 0x7f, 0x46, 0x63, 0x05, 0xc7, 0xd2, 0x63, 0x05, 0x87, 0xe9, 0xc8, 0xcb, 0xea, 0x21,
+	0x55, 0x35, 0x35, 0xf2, 0x0a, 0x65, 0x92, 0x7c, 0xe4, 0x65, 0x48, 0x6a, 0xe4, 0x65, 0x20, 0x0c,
+	0xc1, 0x0c, 0x62, 0x55, 0x83, 0xcd, 0x75, 0xec, 0x35, 0x0d, 0x5e, 0x37, 0xa8, 0x1e, 0xa3, 0x52,
+	0xab, 0x91, 0xd4, 0x3e, 0x95, 0x85, 0xf5, 0x85, 0x6e, 0xa7, 0x7a, 0x02, 0xf5, 0x10, 0xa5, 0xbe,
+	0x60, 0x2f, 0x1d, 0x6e, 0x82, 0xc9, 0xa8, 0xa2, 0x0c, 0xf1, 0xf2, 0x3b, 0xb5, 0x42, 0xbb, 0x3c,
+	0x21, 0x4c, 0x21, 0x3d, 0xd5, 0x79, 0xec, 0x50, 0xc6, 0x93, 0x29, 0x52, 0x67, 0x13, 0x29, 0x22,
+	0xdc, 0x01, 0x33, 0x71, 0x99, 0x53, 0x33, 0xa9, 0x43, 0x52, 0x8f, 0xd3, 0xde, 0x16, 0xf6, 0xaa,
+	0x78, 0x62, 0x07, 0xe3, 0x5e, 0x2f, 0x55, 0xea, 0x75, 0x3a, 0xa3, 0x01, 0x19, 0xcf, 0x43, 0xb1,
+	0x50, 0x47, 0x3d, 0x21, 0x8f, 0xe7, 0xbd, 0x55, 0x3c, 0xcc, 0xab, 0x24, 0x26, 0xd9, 0xab, 0x24,
+	0x12, 0x7c, 0x4d, 0x01, 0x95, 0x8c, 0x33, 0x7d, 0x9f, 0x15, 0xbb, 0xa8, 0x27, 0x69, 0x77, 0xff,
+	0x1f, 0x75, 0xf7, 0x89, 0x45, 0x31, 0xf5, 0x7b, 0xba, 0x9d, 0xaa, 0xe6, 0xf4, 0x69, 0x22, 0x29,
+	0xa1, 0xf6, 0x6b, 0x05, 0xbf, 0xa7, 0x00, 0x35, 0x43, 0x1f, 0xe6, 0xf5, 0xf3, 0x54, 0x9b, 0xbb,
+	0xfa, 0x6a, 0x93, 0x94, 0x60, 0xd4, 0xef, 0xee, 0x76, 0xaa, 0x0b, 0x4e, 0x66, 0x03, 0x49, 0x93,
+	0xb9, 0xec, 0x36, 0xf0, 0xab, 0xa0, 0x18, 0x9d, 0xbf, 0xaa, 0x55, 0xda, 0xed, 0x6c, 0x3c, 0x23,
+	0x89, 0xe7, 0xdc, 0x2c, 0x25, 0x88, 0x9a, 0x4a, 0xa2, 0x63, 0x01, 0x64, 0x3a, 0x4a, 0x8e, 0x73,
+	0xd5, 0x05, 0x79, 0x54, 0x48, 0x1d, 0x9c, 0xb3, 0xe9, 0x28, 0x74, 0x33, 0x45, 0x0a, 0x62, 0xea,
+	0x45, 0x50, 0xa0, 0x07, 0x71, 0xbe, 0xf6, 0x4a, 0x0e, 0x4c, 0xa4, 0x6e, 0x23, 0x90, 0xeb, 0xa2,
+	0x34, 0x35, 0x53, 0x92, 0xeb, 0xa2, 0xae, 0x9c, 0x97, 0x51, 0x3a, 0x3b, 0xce, 0x66, 0x15, 0xfe,
+	0xbc, 0x5a, 0x9f, 0x1f, 0x67, 0x33, 0x4c, 0x3e, 0xce, 0x66, 0x18, 0xb9, 0x46, 0xe0, 0xb0, 0x3c,
+	0x80, 0x67, 0x39, 0x74, 0x88, 0xe0, 0x90, 0x98, 0xf9, 0x71, 0x48, 0x48, 0xdc, 0x86, 0xf7, 0x71,
+	0xf3, 0x25, 0xbe, 0x14, 0x91, 0xbf, 0x95, 0x4b, 0x11, 0xda, 0x05, 0x50, 0xa2, 0x06, 0xbc, 0x60,
+	0xf9, 0x01, 0x7c, 0x22, 0x32, 0x8e, 0xaa, 0xd0, 0x0d, 0xf7, 0x29, 0x2a, 0x44, 0x4c, 0x61, 0x98,
+	0x12, 0xac, 0x91, 0xa8, 0x04, 0xb7, 0xe9, 0x3b, 0x0a, 0x80, 0xb4, 0xf9, 0x5a, 0xe0, 0x21, 0xdd,
+	0xe1, 0x4c, 0x70, 0x01, 0xe4, 0xe2, 0xe4, 0x71, 0xb2, 0xdb, 0xa9, 0x8e, 0x5a, 0x62, 0x1a, 0x98,
+	0xb3, 0x4c, 0x58, 0x4f, 0x8c, 0xc3, 0x32, 0x99, 0x8c, 0xae, 0xf7, 0xb2, 0x57, 0x1d, 0x8c, 0x93,
+	0x18, 0x75, 0xf4, 0xe6, 0x0d, 0xe4, 0xf9, 0x64, 0xb1, 0x31, 0x44, 0x4b, 0x65, 0x69, 0x68, 0x33,
+	0xca, 0xd7, 0x18, 0x41, 0xe0, 0x1e, 0x93, 0x08, 0xda, 0x2f, 0xf2, 0x60, 0x8c, 0xcd, 0x39, 0x0d,
+	0x96, 0xef, 0xed, 0x43, 0xf7, 0xfb, 0x40, 0x7e, 0x5b, 0x0f, 0x8c, 0x4d, 0x35, 0x97, 0xd4, 0x4c,
+	0x51, 0x40, 0xb4, 0x36, 0x05, 0xc8, 0x67, 0x57, 0xd6, 0x3d, 0xec, 0x34, 0xb9, 0xca, 0x24, 0x45,
+	0x1e, 0x4a, 0x3e, 0xbb, 0x42, 0x48, 0xfc, 0x61, 0xe5, 0xcf, 0xae, 0x48, 0x84, 0x24, 0x59, 0x1e,
+	0xde, 0x33, 0x59, 0x7e, 0x12, 0x8c, 0x23, 0xcf, 0xc3, 0xde, 0xf9, 0xf5, 0x8b, 0x96, 0xef, 0x93,
+	0x95, 0x4c, 0x9e, 0xea, 0x48, 0x17, 0x2b, 0x32, 0x45, 0x60, 0x4e, 0xf1, 0x90, 0x0d, 0xd7, 0x75,
+	0xec, 0x19, 0xa8, 0x69, 0xa3, 0x0d, 0xdd, 0x60, 0x75, 0x18, 0x45, 0xb6, 0x9e, 0xa2, 0xf8, 0x05,
+	0x0a, 0x8b, 0x1b, 0xae, 0x02, 0x4c, 0x8e, 0xad, 0x18, 0xb7, 0x8b, 0xb6, 0x69, 0xb2, 0x52, 0x64,
+	0xc1, 0x42, 0xc1, 0x4b, 0x68, 0x5b, 0x0c, 0x96, 0x08, 0xcb, 0x78, 0x97, 0xc5, 0x5b, 0x7d, 0x97,
+	0x70, 0x0d, 0x94, 0xa8, 0xb1, 0xc9, 0xa4, 0xa4, 0x96, 0xf6, 0xdc, 0x2b, 0xa8, 0x50, 0xa5, 0x3c,
+	0xec, 0x10, 0x28, 0x91, 0x4a, 0xb7, 0x0c, 0x8a, 0x11, 0x4e, 0xb6, 0x63, 0xf8, 0xe2, 0xd6, 0x6e,
+	0x62, 0xd7, 0xde, 0x55, 0x41, 0xf2, 0x5d, 0x8f, 0x88, 0x70, 0xd9, 0xb5, 0xa5, 0xa2, 0x14, 0x11,
+	0x27, 0x15, 0x46, 0x34, 0x58, 0x9a, 0xc1, 0x6e, 0x9b, 0xdf, 0x8d, 0xe2, 0x15, 0x46, 0x14, 0xbe,
+	0x4a, 0x50, 0x81, 0x19, 0x24, 0xa8, 0xf6, 0x7e, 0x0e, 0x8c, 0x3e, 0x4b, 0xfc, 0x28, 0xf2, 0xcd,
+	0xd8, 0x13, 0x94, 0x3d, 0x3d, 0xe1, 0x60, 0xfb, 0x32, 0x0f, 0x80, 0x11, 0x6a, 0xc2, 0xd8, 0x4f,
+	0x59, 0x6a, 0xe6, 0x61, 0x47, 0x62, 0x28, 0x30, 0xa4, 0xc7, 0x51, 0x86, 0x0f, 0xee, 0x28, 0xf9,
+	0x03, 0x3b, 0x4a, 0xe1, 0x56, 0x1d, 0xe5, 0xf4, 0x57, 0x40, 0x9e, 0x0e, 0x94, 0xb0, 0x04, 0xf2,
+	0x2b, 0xc4, 0xf5, 0x27, 0x8f, 0xc0, 0x32, 0x18, 0xe1, 0x2b, 0xaf, 0x49, 0x05, 0x8e, 0x80, 0xa1,
+	0xcb, 0x97, 0x2f, 0x4e, 0xe6, 0xe0, 0x0c, 0x98, 0x7c, 0x12, 0xe9, 0xa6, 0x6d, 0xb9, 0xf1, 0x32,
+	0x67, 0x72, 0x68, 0xe9, 0xfd, 0x1c, 0xc8, 0xb3, 0x9d, 0xb2, 0x47, 0xc1, 0x78, 0x03, 0xb5, 0xb1,
+	0x17, 0x5c, 0x0c, 0xed, 0xc0, 0x6a, 0xdb, 0x08, 0x8e, 0x27, 0xe3, 0x18, 0x19, 0x62, 0x2b, 0x73,
+	0x3d, 0x1e, 0xb8, 0x42, 0x54, 0x82, 0x67, 0x40, 0x81, 0x71, 0xc2, 0xde, 0x91, 0xaf, 0x2f, 0x13,
+	0x02, 0x13, 0x4f, 0xa1, 0x80, 0xaf, 0x91, 0xe9, 0x08, 0x0c, 0xa1, 0xb0, 0x6c, 0xe6, 0x6e, 0x52,
+	0x39, 0x9a, 0x48, 0x94, 0xc6, 0x65, 0xed, 0xae, 0xef, 0xfe, 0xf5, 0xe3, 0x1f, 0xe7, 0x4e, 0x3e,
+	0xae, 0x9c, 0xd6, 0x54, 0xf2, 0x2d, 0xb5, 0xeb, 0xb8, 0xf5, 0x80, 0x8f, 0x82, 0xc5, 0x97, 0xa8,
+	0xcf, 0xbc, 0xbc, 0xf8, 0x92, 0x65, 0xbe, 0xfc, 0xa0, 0x02, 0x1f, 0x07, 0x79, 0xea, 0x76, 0x5c,
+	0x35, 0xd1, 0x05, 0xfb, 0xcb, 0x1e, 0x7a, 0x35, 0xa7, 0x50, 0xde, 0xc2, 0xd3, 0xf4, 0x13, 0x73,
+	0xb0, 0xcf, 0x43, 0x54, 0x58, 0xc6, 0xc6, 0x1a, 0x2d, 0x6f, 0x22, 0x63, 0xab, 0x81, 0xfc, 0x36,
+	0x76, 0x7d, 0x54, 0x7f, 0xfe, 0x83, 0x0f, 0xe7, 0x8f, 0x7c, 0xfb, 0xe6, 0xbc, 0xf2, 0xee, 0xcd,
+	0x79, 0xe5, 0xbd, 0x9b, 0xf3, 0xca, 0xdf, 0x6f, 0xce, 0x2b, 0xaf, 0x7f, 0x34, 0x7f, 0xe4, 0xbd,
+	0x8f, 0xe6, 0x8f, 0x7c, 0xf0, 0xd1, 0xfc, 0x91, 0x6f, 0xde, 0x2b, 0x7c, 0x93, 0x4e, 0xf7, 0x1c,
+	0xdd, 0xd4, 0xdb, 0x1e, 0xbe, 0x8e, 0x8c, 0x80, 0xff, 0x8a, 0x3e, 0x29, 0xf7, 0xeb, 0xdc, 0xcc,
+	0x59, 0x0a, 0x5c, 0x61, 0xe4, 0xda, 0x79, 0x5c, 0x3b, 0xdb, 0xb6, 0x5a, 0x05, 0xaa, 0xcb, 0x99,
+	0xff, 0x0c, 0x00, 0x1f, 0xea, 0xed, 0xb2, 0x5f, 0x4f, 0x00, 0x00,
 }
 
-func (m *JobFailedEventCompressed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Event) > 0 {
-		i -= len(m.Event)
-		copy(dAtA[i:], m.Event)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Event)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// EventClient is the client API for Event service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type EventClient interface {
+	ReportMultiple(ctx context.Context, in *EventList, opts ...grpc.CallOption) (*types.Empty, error)
+	Report(ctx context.Context, in *EventMessage, opts ...grpc.CallOption) (*types.Empty, error)
+	GetJobSetEvents(ctx context.Context, in *JobSetRequest, opts ...grpc.CallOption) (Event_GetJobSetEventsClient, error)
+	Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (Event_WatchClient, error)
+	Health(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*HealthCheckResponse, error)
 }
 
-func (m *JobSucceededEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+type eventClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewEventClient(cc *grpc.ClientConn) EventClient {
+	return &eventClient{cc}
+}
+
+func (c *eventClient) ReportMultiple(ctx context.Context, in *EventList, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/api.Event/ReportMultiple", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *JobSucceededEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *eventClient) Report(ctx context.Context, in *EventMessage, opts ...grpc.CallOption) (*types.Empty, error) {
+	out := new(types.Empty)
+	err := c.cc.Invoke(ctx, "/api.Event/Report", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *JobSucceededEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PodNamespace) > 0 {
-		i -= len(m.PodNamespace)
-		copy(dAtA[i:], m.PodNamespace)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.PodNamespace)))
-		i--
-		dAtA[i] = 0x52
-	}
-	if len(m.PodName) > 0 {
-		i -= len(m.PodName)
-		copy(dAtA[i:], m.PodName)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.PodName)))
-		i--
-		dAtA[i] = 0x4a
-	}
-	if m.PodNumber != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.PodNumber))
-		i--
-		dAtA[i] = 0x40
-	}
-	if len(m.NodeName) > 0 {
-		i -= len(m.NodeName)
-		copy(dAtA[i:], m.NodeName)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.NodeName)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.KubernetesId) > 0 {
-		i -= len(m.KubernetesId)
-		copy(dAtA[i:], m.KubernetesId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.KubernetesId)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.ClusterId) > 0 {
-		i -= len(m.ClusterId)
-		copy(dAtA[i:], m.ClusterId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.ClusterId)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	n14, err14 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
-	if err14 != nil {
-		return 0, err14
+func (c *eventClient) GetJobSetEvents(ctx context.Context, in *JobSetRequest, opts ...grpc.CallOption) (Event_GetJobSetEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Event_serviceDesc.Streams[0], "/api.Event/GetJobSetEvents", opts...)
+	if err != nil {
+		return nil, err
 	}
-	i -= n14
-	i = encodeVarintEvent(dAtA, i, uint64(n14))
-	i--
-	dAtA[i] = 0x22
-	if len(m.Queue) > 0 {
-		i -= len(m.Queue)
-		copy(dAtA[i:], m.Queue)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
-		i--
-		dAtA[i] = 0x1a
+	x := &eventGetJobSetEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	if len(m.JobSetId) > 0 {
-		i -= len(m.JobSetId)
-		copy(dAtA[i:], m.JobSetId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
-		i--
-		dAtA[i] = 0x12
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	if len(m.JobId) > 0 {
-		i -= len(m.JobId)
-		copy(dAtA[i:], m.JobId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
-		i--
-		dAtA[i] = 0xa
+	return x, nil
+}
+
+type Event_GetJobSetEventsClient interface {
+	Recv() (*EventStreamMessage, error)
+	grpc.ClientStream
+}
+
+type eventGetJobSetEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *eventGetJobSetEventsClient) Recv() (*EventStreamMessage, error) {
+	m := new(EventStreamMessage)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return m, nil
 }
 
-func (m *JobUtilisationEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+// Deprecated: Do not use.
+func (c *eventClient) Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (Event_WatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Event_serviceDesc.Streams[1], "/api.Event/Watch", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	x := &eventWatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (m *JobUtilisationEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Event_WatchClient interface {
+	Recv() (*EventStreamMessage, error)
+	grpc.ClientStream
 }
 
-func (m *JobUtilisationEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TotalCumulativeUsage) > 0 {
-		for k := range m.TotalCumulativeUsage {
-			v := m.TotalCumulativeUsage[k]
-			baseI := i
-			{
-				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEvent(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintEvent(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintEvent(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x62
-		}
-	}
-	if len(m.PodNamespace) > 0 {
-		i -= len(m.PodNamespace)
-		copy(dAtA[i:], m.PodNamespace)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.PodNamespace)))
-		i--
-		dAtA[i] = 0x5a
-	}
-	if len(m.PodName) > 0 {
-		i -= len(m.PodName)
-		copy(dAtA[i:], m.PodName)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.PodName)))
-		i--
-		dAtA[i] = 0x52
-	}
-	if m.PodNumber != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.PodNumber))
-		i--
-		dAtA[i] = 0x48
-	}
-	if len(m.NodeName) > 0 {
-		i -= len(m.NodeName)
-		copy(dAtA[i:], m.NodeName)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.NodeName)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.MaxResourcesForPeriod) > 0 {
-		for k := range m.MaxResourcesForPeriod {
-			v := m.MaxResourcesForPeriod[k]
-			baseI := i
-			{
-				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEvent(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintEvent(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintEvent(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x3a
-		}
-	}
-	if len(m.KubernetesId) > 0 {
-		i -= len(m.KubernetesId)
-		copy(dAtA[i:], m.KubernetesId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.KubernetesId)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.ClusterId) > 0 {
-		i -= len(m.ClusterId)
-		copy(dAtA[i:], m.ClusterId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.ClusterId)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	n17, err17 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
-	if err17 != nil {
-		return 0, err17
-	}
-	i -= n17
-	i = encodeVarintEvent(dAtA, i, uint64(n17))
-	i--
-	dAtA[i] = 0x22
-	if len(m.Queue) > 0 {
-		i -= len(m.Queue)
-		copy(dAtA[i:], m.Queue)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.JobSetId) > 0 {
-		i -= len(m.JobSetId)
-		copy(dAtA[i:], m.JobSetId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.JobId) > 0 {
-		i -= len(m.JobId)
-		copy(dAtA[i:], m.JobId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
-		i--
-		dAtA[i] = 0xa
+type eventWatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *eventWatchClient) Recv() (*EventStreamMessage, error) {
+	m := new(EventStreamMessage)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return m, nil
 }
 
-func (m *JobReprioritizingEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *eventClient) Health(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*HealthCheckResponse, error) {
+	out := new(HealthCheckResponse)
+	err := c.cc.Invoke(ctx, "/api.Event/Health", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *JobReprioritizingEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventServer is the server API for Event service.
+type EventServer interface {
+	ReportMultiple(context.Context, *EventList) (*types.Empty, error)
+	Report(context.Context, *EventMessage) (*types.Empty, error)
+	GetJobSetEvents(*JobSetRequest, Event_GetJobSetEventsServer) error
+	Watch(*WatchRequest, Event_WatchServer) error
+	Health(context.Context, *types.Empty) (*HealthCheckResponse, error)
 }
 
-func (m *JobReprioritizingEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Requestor) > 0 {
-		i -= len(m.Requestor)
-		copy(dAtA[i:], m.Requestor)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Requestor)))
-		i--
-		dAtA[i] = 0x32
+// UnimplementedEventServer can be embedded to have forward compatible implementations.
+type UnimplementedEventServer struct {
+}
+
+func (*UnimplementedEventServer) ReportMultiple(ctx context.Context, req *EventList) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportMultiple not implemented")
+}
+func (*UnimplementedEventServer) Report(ctx context.Context, req *EventMessage) (*types.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Report not implemented")
+}
+func (*UnimplementedEventServer) GetJobSetEvents(req *JobSetRequest, srv Event_GetJobSetEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetJobSetEvents not implemented")
+}
+func (*UnimplementedEventServer) Watch(req *WatchRequest, srv Event_WatchServer) error {
+	return status.Errorf(codes.Unimplemented, "method Watch not implemented")
+}
+func (*UnimplementedEventServer) Health(ctx context.Context, req *types.Empty) (*HealthCheckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Health not implemented")
+}
+
+func RegisterEventServer(s *grpc.Server, srv EventServer) {
+	s.RegisterService(&_Event_serviceDesc, srv)
+}
+
+func _Event_ReportMultiple_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EventList)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.NewPriority != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.NewPriority))))
-		i--
-		dAtA[i] = 0x29
+	if interceptor == nil {
+		return srv.(EventServer).ReportMultiple(ctx, in)
 	}
-	n18, err18 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
-	if err18 != nil {
-		return 0, err18
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Event/ReportMultiple",
 	}
-	i -= n18
-	i = encodeVarintEvent(dAtA, i, uint64(n18))
-	i--
-	dAtA[i] = 0x22
-	if len(m.Queue) > 0 {
-		i -= len(m.Queue)
-		copy(dAtA[i:], m.Queue)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
-		i--
-		dAtA[i] = 0x1a
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServer).ReportMultiple(ctx, req.(*EventList))
 	}
-	if len(m.JobSetId) > 0 {
-		i -= len(m.JobSetId)
-		copy(dAtA[i:], m.JobSetId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
-		i--
-		dAtA[i] = 0x12
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Event_Report_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EventMessage)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.JobId) > 0 {
-		i -= len(m.JobId)
-		copy(dAtA[i:], m.JobId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(EventServer).Report(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Event/Report",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServer).Report(ctx, req.(*EventMessage))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *JobReprioritizedEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func _Event_GetJobSetEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(JobSetRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return dAtA[:n], nil
+	return srv.(EventServer).GetJobSetEvents(m, &eventGetJobSetEventsServer{stream})
 }
 
-func (m *JobReprioritizedEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type Event_GetJobSetEventsServer interface {
+	Send(*EventStreamMessage) error
+	grpc.ServerStream
 }
 
-func (m *JobReprioritizedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
+type eventGetJobSetEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *eventGetJobSetEventsServer) Send(m *EventStreamMessage) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Event_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(EventServer).Watch(m, &eventWatchServer{stream})
+}
+
+type Event_WatchServer interface {
+	Send(*EventStreamMessage) error
+	grpc.ServerStream
+}
+
+type eventWatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *eventWatchServer) Send(m *EventStreamMessage) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Event_Health_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventServer).Health(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Event/Health",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventServer).Health(ctx, req.(*types.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Event_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.Event",
+	HandlerType: (*EventServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ReportMultiple",
+			Handler:    _Event_ReportMultiple_Handler,
+		},
+		{
+			MethodName: "Report",
+			Handler:    _Event_Report_Handler,
+		},
+		{
+			MethodName: "Health",
+			Handler:    _Event_Health_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GetJobSetEvents",
+			Handler:       _Event_GetJobSetEvents_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Watch",
+			Handler:       _Event_Watch_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "pkg/api/event.proto",
+}
+
+func (m *JobSubmittedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobSubmittedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobSubmittedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Requestor) > 0 {
-		i -= len(m.Requestor)
-		copy(dAtA[i:], m.Requestor)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Requestor)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.NewPriority != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.NewPriority))))
-		i--
-		dAtA[i] = 0x29
+	{
+		size, err := m.Job.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
 	}
-	n19, err19 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
-	if err19 != nil {
-		return 0, err19
+	i--
+	dAtA[i] = 0x2a
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err2 != nil {
+		return 0, err2
 	}
-	i -= n19
-	i = encodeVarintEvent(dAtA, i, uint64(n19))
+	i -= n2
+	i = encodeVarintEvent(dAtA, i, uint64(n2))
 	i--
 	dAtA[i] = 0x22
 	if len(m.Queue) > 0 {
@@ -4585,7 +4564,7 @@ func (m *JobReprioritizedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *JobCancellingEvent) Marshal() (dAtA []byte, err error) {
+func (m *JobQueuedEvent) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4595,36 +4574,27 @@ func (m *JobCancellingEvent) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *JobCancellingEvent) MarshalTo(dAtA []byte) (int, error) {
+func (m *JobQueuedEvent) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *JobCancellingEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JobQueuedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Reason) > 0 {
-		i -= len(m.Reason)
-		copy(dAtA[i:], m.Reason)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Reason)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Requestor) > 0 {
-		i -= len(m.Requestor)
-		copy(dAtA[i:], m.Requestor)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Requestor)))
+	if m.Attempt != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Attempt))
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x28
 	}
-	n20, err20 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
-	if err20 != nil {
-		return 0, err20
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err3 != nil {
+		return 0, err3
 	}
-	i -= n20
-	i = encodeVarintEvent(dAtA, i, uint64(n20))
+	i -= n3
+	i = encodeVarintEvent(dAtA, i, uint64(n3))
 	i--
 	dAtA[i] = 0x22
 	if len(m.Queue) > 0 {
@@ -4651,7 +4621,7 @@ func (m *JobCancellingEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *JobCancelledEvent) Marshal() (dAtA []byte, err error) {
+func (m *JobDuplicateFoundEvent) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4661,36 +4631,29 @@ func (m *JobCancelledEvent) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *JobCancelledEvent) MarshalTo(dAtA []byte) (int, error) {
+func (m *JobDuplicateFoundEvent) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *JobCancelledEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JobDuplicateFoundEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Reason) > 0 {
-		i -= len(m.Reason)
-		copy(dAtA[i:], m.Reason)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Reason)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Requestor) > 0 {
-		i -= len(m.Requestor)
-		copy(dAtA[i:], m.Requestor)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Requestor)))
+	if len(m.OriginalJobId) > 0 {
+		i -= len(m.OriginalJobId)
+		copy(dAtA[i:], m.OriginalJobId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.OriginalJobId)))
 		i--
 		dAtA[i] = 0x2a
 	}
-	n21, err21 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
-	if err21 != nil {
-		return 0, err21
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err4 != nil {
+		return 0, err4
 	}
-	i -= n21
-	i = encodeVarintEvent(dAtA, i, uint64(n21))
+	i -= n4
+	i = encodeVarintEvent(dAtA, i, uint64(n4))
 	i--
 	dAtA[i] = 0x22
 	if len(m.Queue) > 0 {
@@ -4717,7 +4680,7 @@ func (m *JobCancelledEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *JobTerminatedEvent) Marshal() (dAtA []byte, err error) {
+func (m *JobLeasedEvent) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4727,46 +4690,20 @@ func (m *JobTerminatedEvent) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *JobTerminatedEvent) MarshalTo(dAtA []byte) (int, error) {
+func (m *JobLeasedEvent) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *JobTerminatedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JobLeasedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.PodNamespace) > 0 {
-		i -= len(m.PodNamespace)
-		copy(dAtA[i:], m.PodNamespace)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.PodNamespace)))
-		i--
-		dAtA[i] = 0x52
-	}
-	if len(m.PodName) > 0 {
-		i -= len(m.PodName)
-		copy(dAtA[i:], m.PodName)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.PodName)))
-		i--
-		dAtA[i] = 0x4a
-	}
-	if len(m.Reason) > 0 {
-		i -= len(m.Reason)
-		copy(dAtA[i:], m.Reason)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Reason)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if m.PodNumber != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.PodNumber))
-		i--
-		dAtA[i] = 0x38
-	}
-	if len(m.KubernetesId) > 0 {
-		i -= len(m.KubernetesId)
-		copy(dAtA[i:], m.KubernetesId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.KubernetesId)))
+	if len(m.Pool) > 0 {
+		i -= len(m.Pool)
+		copy(dAtA[i:], m.Pool)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Pool)))
 		i--
 		dAtA[i] = 0x32
 	}
@@ -4777,12 +4714,12 @@ func (m *JobTerminatedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x2a
 	}
-	n22, err22 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
-	if err22 != nil {
-		return 0, err22
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err5 != nil {
+		return 0, err5
 	}
-	i -= n22
-	i = encodeVarintEvent(dAtA, i, uint64(n22))
+	i -= n5
+	i = encodeVarintEvent(dAtA, i, uint64(n5))
 	i--
 	dAtA[i] = 0x22
 	if len(m.Queue) > 0 {
@@ -4809,7 +4746,7 @@ func (m *JobTerminatedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *JobUpdatedEvent) Marshal() (dAtA []byte, err error) {
+func (m *JobLeaseReturnedEvent) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4819,39 +4756,58 @@ func (m *JobUpdatedEvent) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *JobUpdatedEvent) MarshalTo(dAtA []byte) (int, error) {
+func (m *JobLeaseReturnedEvent) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *JobUpdatedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JobLeaseReturnedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Job.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.RunAttempted {
+		i--
+		if m.RunAttempted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		i -= size
-		i = encodeVarintEvent(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	if len(m.Requestor) > 0 {
-		i -= len(m.Requestor)
-		copy(dAtA[i:], m.Requestor)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Requestor)))
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x48
 	}
-	n24, err24 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
-	if err24 != nil {
-		return 0, err24
+	if m.PodNumber != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.PodNumber))
+		i--
+		dAtA[i] = 0x40
 	}
-	i -= n24
-	i = encodeVarintEvent(dAtA, i, uint64(n24))
+	if len(m.KubernetesId) > 0 {
+		i -= len(m.KubernetesId)
+		copy(dAtA[i:], m.KubernetesId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.KubernetesId)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ClusterId) > 0 {
+		i -= len(m.ClusterId)
+		copy(dAtA[i:], m.ClusterId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ClusterId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintEvent(dAtA, i, uint64(n6))
 	i--
 	dAtA[i] = 0x22
 	if len(m.Queue) > 0 {
@@ -4878,7 +4834,7 @@ func (m *JobUpdatedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *EventMessage) Marshal() (dAtA []byte, err error) {
+func (m *JobLeaseExpiredEvent) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4888,482 +4844,361 @@ func (m *EventMessage) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventMessage) MarshalTo(dAtA []byte) (int, error) {
+func (m *JobLeaseExpiredEvent) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JobLeaseExpiredEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Events != nil {
-		{
-			size := m.Events.Size()
-			i -= size
-			if _, err := m.Events.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
+	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err7 != nil {
+		return 0, err7
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *EventMessage_Submitted) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *EventMessage_Submitted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Submitted != nil {
-		{
-			size, err := m.Submitted.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvent(dAtA, i, uint64(size))
-		}
+	i -= n7
+	i = encodeVarintEvent(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x1a
 	}
-	return len(dAtA) - i, nil
-}
-func (m *EventMessage_Queued) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *EventMessage_Queued) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Queued != nil {
-		{
-			size, err := m.Queued.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvent(dAtA, i, uint64(size))
-		}
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
 		i--
 		dAtA[i] = 0x12
 	}
-	return len(dAtA) - i, nil
-}
-func (m *EventMessage_Leased) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *EventMessage_Leased) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Leased != nil {
-		{
-			size, err := m.Leased.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvent(dAtA, i, uint64(size))
-		}
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
-func (m *EventMessage_LeaseReturned) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *EventMessage_LeaseReturned) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.LeaseReturned != nil {
-		{
-			size, err := m.LeaseReturned.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvent(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
+func (m *JobPendingEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return dAtA[:n], nil
 }
-func (m *EventMessage_LeaseExpired) MarshalTo(dAtA []byte) (int, error) {
+
+func (m *JobPendingEvent) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventMessage_LeaseExpired) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JobPendingEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.LeaseExpired != nil {
-		{
-			size, err := m.LeaseExpired.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvent(dAtA, i, uint64(size))
-		}
+	_ = i
+	var l int
+	_ = l
+	if len(m.PodNamespace) > 0 {
+		i -= len(m.PodNamespace)
+		copy(dAtA[i:], m.PodNamespace)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.PodNamespace)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.PodName) > 0 {
+		i -= len(m.PodName)
+		copy(dAtA[i:], m.PodName)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.PodName)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.PodNumber != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.PodNumber))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.KubernetesId) > 0 {
+		i -= len(m.KubernetesId)
+		copy(dAtA[i:], m.KubernetesId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.KubernetesId)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ClusterId) > 0 {
+		i -= len(m.ClusterId)
+		copy(dAtA[i:], m.ClusterId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ClusterId)))
 		i--
 		dAtA[i] = 0x2a
 	}
+	n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintEvent(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
-func (m *EventMessage_Pending) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *EventMessage_Pending) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Pending != nil {
-		{
-			size, err := m.Pending.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvent(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
+func (m *JobRunningEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return dAtA[:n], nil
 }
-func (m *EventMessage_Running) MarshalTo(dAtA []byte) (int, error) {
+
+func (m *JobRunningEvent) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventMessage_Running) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JobRunningEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.Running != nil {
-		{
-			size, err := m.Running.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvent(dAtA, i, uint64(size))
+	_ = i
+	var l int
+	_ = l
+	if len(m.NodeLabels) > 0 {
+		for k := range m.NodeLabels {
+			v := m.NodeLabels[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintEvent(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintEvent(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintEvent(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x62
 		}
+	}
+	if len(m.Pool) > 0 {
+		i -= len(m.Pool)
+		copy(dAtA[i:], m.Pool)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Pool)))
 		i--
-		dAtA[i] = 0x3a
+		dAtA[i] = 0x5a
 	}
-	return len(dAtA) - i, nil
-}
-func (m *EventMessage_UnableToSchedule) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *EventMessage_UnableToSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.UnableToSchedule != nil {
-		{
-			size, err := m.UnableToSchedule.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvent(dAtA, i, uint64(size))
-		}
+	if len(m.PodNamespace) > 0 {
+		i -= len(m.PodNamespace)
+		copy(dAtA[i:], m.PodNamespace)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.PodNamespace)))
 		i--
-		dAtA[i] = 0x42
+		dAtA[i] = 0x52
 	}
-	return len(dAtA) - i, nil
-}
-func (m *EventMessage_Failed) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *EventMessage_Failed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Failed != nil {
-		{
-			size, err := m.Failed.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvent(dAtA, i, uint64(size))
-		}
+	if len(m.PodName) > 0 {
+		i -= len(m.PodName)
+		copy(dAtA[i:], m.PodName)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.PodName)))
 		i--
 		dAtA[i] = 0x4a
 	}
-	return len(dAtA) - i, nil
-}
-func (m *EventMessage_Succeeded) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *EventMessage_Succeeded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Succeeded != nil {
-		{
-			size, err := m.Succeeded.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvent(dAtA, i, uint64(size))
-		}
+	if m.PodNumber != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.PodNumber))
 		i--
-		dAtA[i] = 0x52
+		dAtA[i] = 0x40
 	}
-	return len(dAtA) - i, nil
-}
-func (m *EventMessage_Reprioritized) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *EventMessage_Reprioritized) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Reprioritized != nil {
-		{
-			size, err := m.Reprioritized.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvent(dAtA, i, uint64(size))
-		}
+	if len(m.NodeName) > 0 {
+		i -= len(m.NodeName)
+		copy(dAtA[i:], m.NodeName)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.NodeName)))
 		i--
-		dAtA[i] = 0x5a
+		dAtA[i] = 0x3a
 	}
-	return len(dAtA) - i, nil
-}
-func (m *EventMessage_Cancelling) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *EventMessage_Cancelling) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Cancelling != nil {
-		{
-			size, err := m.Cancelling.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvent(dAtA, i, uint64(size))
-		}
+	if len(m.KubernetesId) > 0 {
+		i -= len(m.KubernetesId)
+		copy(dAtA[i:], m.KubernetesId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.KubernetesId)))
 		i--
-		dAtA[i] = 0x62
+		dAtA[i] = 0x32
 	}
-	return len(dAtA) - i, nil
-}
-func (m *EventMessage_Cancelled) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *EventMessage_Cancelled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Cancelled != nil {
-		{
-			size, err := m.Cancelled.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvent(dAtA, i, uint64(size))
-		}
+	if len(m.ClusterId) > 0 {
+		i -= len(m.ClusterId)
+		copy(dAtA[i:], m.ClusterId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ClusterId)))
 		i--
-		dAtA[i] = 0x6a
+		dAtA[i] = 0x2a
 	}
-	return len(dAtA) - i, nil
-}
-func (m *EventMessage_Terminated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *EventMessage_Terminated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Terminated != nil {
-		{
-			size, err := m.Terminated.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvent(dAtA, i, uint64(size))
-		}
+	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintEvent(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
 		i--
-		dAtA[i] = 0x72
+		dAtA[i] = 0x1a
+	}
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
-func (m *EventMessage_Utilisation) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *EventMessage_Utilisation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Utilisation != nil {
-		{
-			size, err := m.Utilisation.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvent(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x7a
+func (m *JobIngressInfoEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return dAtA[:n], nil
 }
-func (m *EventMessage_DuplicateFound) MarshalTo(dAtA []byte) (int, error) {
+
+func (m *JobIngressInfoEvent) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventMessage_DuplicateFound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JobIngressInfoEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.DuplicateFound != nil {
-		{
-			size, err := m.DuplicateFound.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvent(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
+	_ = i
+	var l int
+	_ = l
+	if len(m.PodNamespace) > 0 {
+		i -= len(m.PodNamespace)
+		copy(dAtA[i:], m.PodNamespace)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.PodNamespace)))
 		i--
-		dAtA[i] = 0x82
+		dAtA[i] = 0x5a
 	}
-	return len(dAtA) - i, nil
-}
-func (m *EventMessage_IngressInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *EventMessage_IngressInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.IngressInfo != nil {
-		{
-			size, err := m.IngressInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvent(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
+	if len(m.PodName) > 0 {
+		i -= len(m.PodName)
+		copy(dAtA[i:], m.PodName)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.PodName)))
 		i--
-		dAtA[i] = 0x8a
+		dAtA[i] = 0x52
 	}
-	return len(dAtA) - i, nil
-}
-func (m *EventMessage_Reprioritizing) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *EventMessage_Reprioritizing) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Reprioritizing != nil {
-		{
-			size, err := m.Reprioritizing.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvent(dAtA, i, uint64(size))
+	if len(m.IngressAddresses) > 0 {
+		for k := range m.IngressAddresses {
+			v := m.IngressAddresses[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintEvent(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i = encodeVarintEvent(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintEvent(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4a
 		}
+	}
+	if m.PodNumber != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.PodNumber))
 		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x92
+		dAtA[i] = 0x40
 	}
-	return len(dAtA) - i, nil
-}
-func (m *EventMessage_Updated) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *EventMessage_Updated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Updated != nil {
-		{
-			size, err := m.Updated.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvent(dAtA, i, uint64(size))
-		}
+	if len(m.NodeName) > 0 {
+		i -= len(m.NodeName)
+		copy(dAtA[i:], m.NodeName)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.NodeName)))
 		i--
-		dAtA[i] = 0x1
+		dAtA[i] = 0x3a
+	}
+	if len(m.KubernetesId) > 0 {
+		i -= len(m.KubernetesId)
+		copy(dAtA[i:], m.KubernetesId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.KubernetesId)))
 		i--
-		dAtA[i] = 0x9a
+		dAtA[i] = 0x32
 	}
-	return len(dAtA) - i, nil
-}
-func (m *EventMessage_FailedCompressed) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *EventMessage_FailedCompressed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.FailedCompressed != nil {
-		{
-			size, err := m.FailedCompressed.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvent(dAtA, i, uint64(size))
-		}
+	if len(m.ClusterId) > 0 {
+		i -= len(m.ClusterId)
+		copy(dAtA[i:], m.ClusterId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ClusterId)))
 		i--
-		dAtA[i] = 0x1
+		dAtA[i] = 0x2a
+	}
+	n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintEvent(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
 		i--
-		dAtA[i] = 0xa2
+		dAtA[i] = 0x1a
 	}
-	return len(dAtA) - i, nil
-}
-func (m *EventMessage_Preempted) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *EventMessage_Preempted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Preempted != nil {
-		{
-			size, err := m.Preempted.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvent(dAtA, i, uint64(size))
-		}
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
 		i--
-		dAtA[i] = 0x1
+		dAtA[i] = 0x12
+	}
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
 		i--
-		dAtA[i] = 0xaa
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
-func (m *ContainerStatus) Marshal() (dAtA []byte, err error) {
+
+func (m *JobUnableToScheduleEvent) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5373,51 +5208,96 @@ func (m *ContainerStatus) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ContainerStatus) MarshalTo(dAtA []byte) (int, error) {
+func (m *JobUnableToScheduleEvent) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ContainerStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JobUnableToScheduleEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Cause != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.Cause))
+	if len(m.PodNamespace) > 0 {
+		i -= len(m.PodNamespace)
+		copy(dAtA[i:], m.PodNamespace)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.PodNamespace)))
 		i--
-		dAtA[i] = 0x28
+		dAtA[i] = 0x5a
+	}
+	if len(m.PodName) > 0 {
+		i -= len(m.PodName)
+		copy(dAtA[i:], m.PodName)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.PodName)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.PodNumber != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.PodNumber))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.NodeName) > 0 {
+		i -= len(m.NodeName)
+		copy(dAtA[i:], m.NodeName)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.NodeName)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.KubernetesId) > 0 {
+		i -= len(m.KubernetesId)
+		copy(dAtA[i:], m.KubernetesId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.KubernetesId)))
+		i--
+		dAtA[i] = 0x3a
 	}
 	if len(m.Reason) > 0 {
 		i -= len(m.Reason)
 		copy(dAtA[i:], m.Reason)
 		i = encodeVarintEvent(dAtA, i, uint64(len(m.Reason)))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x32
 	}
-	if len(m.Message) > 0 {
-		i -= len(m.Message)
-		copy(dAtA[i:], m.Message)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Message)))
+	if len(m.ClusterId) > 0 {
+		i -= len(m.ClusterId)
+		copy(dAtA[i:], m.ClusterId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ClusterId)))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x2a
 	}
-	if m.ExitCode != 0 {
-		i = encodeVarintEvent(dAtA, i, uint64(m.ExitCode))
+	n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintEvent(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x1a
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Name)))
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
 	}
-	return len(dAtA) - i, nil
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *EventList) Marshal() (dAtA []byte, err error) {
+func (m *JobFailedEvent) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5427,20 +5307,56 @@ func (m *EventList) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventList) MarshalTo(dAtA []byte) (int, error) {
+func (m *JobFailedEvent) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JobFailedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Events) > 0 {
-		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
+	if m.Attempt != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Attempt))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.WillRetry {
+		i--
+		if m.WillRetry {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.PodNamespace) > 0 {
+		i -= len(m.PodNamespace)
+		copy(dAtA[i:], m.PodNamespace)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.PodNamespace)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.PodName) > 0 {
+		i -= len(m.PodName)
+		copy(dAtA[i:], m.PodName)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.PodName)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.Cause != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Cause))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.ContainerStatuses) > 0 {
+		for iNdEx := len(m.ContainerStatuses) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Events[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ContainerStatuses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -5448,13 +5364,92 @@ func (m *EventList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintEvent(dAtA, i, uint64(size))
 			}
 			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.PodNumber != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.PodNumber))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.NodeName) > 0 {
+		i -= len(m.NodeName)
+		copy(dAtA[i:], m.NodeName)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.NodeName)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.KubernetesId) > 0 {
+		i -= len(m.KubernetesId)
+		copy(dAtA[i:], m.KubernetesId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.KubernetesId)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ExitCodes) > 0 {
+		for k := range m.ExitCodes {
+			v := m.ExitCodes[k]
+			baseI := i
+			i = encodeVarintEvent(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintEvent(dAtA, i, uint64(len(k)))
+			i--
 			dAtA[i] = 0xa
+			i = encodeVarintEvent(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x3a
 		}
 	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ClusterId) > 0 {
+		i -= len(m.ClusterId)
+		copy(dAtA[i:], m.ClusterId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ClusterId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintEvent(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventStreamMessage) Marshal() (dAtA []byte, err error) {
+func (m *JobSetExpiredEvent) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5464,39 +5459,54 @@ func (m *EventStreamMessage) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventStreamMessage) MarshalTo(dAtA []byte) (int, error) {
+func (m *JobSetExpiredEvent) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventStreamMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JobSetExpiredEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Message != nil {
-		{
-			size, err := m.Message.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvent(dAtA, i, uint64(size))
-		}
+	if m.JobSetTtlSeconds != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.JobSetTtlSeconds))
+		i--
+		dAtA[i] = 0x28
+	}
+	n13, err13 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintEvent(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Id)))
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *JobSetRequest) Marshal() (dAtA []byte, err error) {
+func (m *JobSuspendedEvent) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5506,81 +5516,56 @@ func (m *JobSetRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *JobSetRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *JobSuspendedEvent) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *JobSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JobSuspendedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ForceNew {
-		i--
-		if m.ForceNew {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.ForceLegacy {
-		i--
-		if m.ForceLegacy {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if len(m.Requestor) > 0 {
+		i -= len(m.Requestor)
+		copy(dAtA[i:], m.Requestor)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Requestor)))
 		i--
-		dAtA[i] = 0x30
+		dAtA[i] = 0x2a
 	}
-	if m.ErrorIfMissing {
-		i--
-		if m.ErrorIfMissing {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x28
+	n14, err14 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err14 != nil {
+		return 0, err14
 	}
+	i -= n14
+	i = encodeVarintEvent(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0x22
 	if len(m.Queue) > 0 {
 		i -= len(m.Queue)
 		copy(dAtA[i:], m.Queue)
 		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
 		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.FromMessageId) > 0 {
-		i -= len(m.FromMessageId)
-		copy(dAtA[i:], m.FromMessageId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.FromMessageId)))
-		i--
 		dAtA[i] = 0x1a
 	}
-	if m.Watch {
-		i--
-		if m.Watch {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Id)))
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *WatchRequest) Marshal() (dAtA []byte, err error) {
+func (m *JobResumedEvent) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5590,40 +5575,35 @@ func (m *WatchRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *WatchRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *JobResumedEvent) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *WatchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JobResumedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ForceNew {
-		i--
-		if m.ForceNew {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if len(m.Requestor) > 0 {
+		i -= len(m.Requestor)
+		copy(dAtA[i:], m.Requestor)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Requestor)))
 		i--
-		dAtA[i] = 0x28
+		dAtA[i] = 0x2a
 	}
-	if m.ForceLegacy {
-		i--
-		if m.ForceLegacy {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
+	n15, err15 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err15 != nil {
+		return 0, err15
 	}
-	if len(m.FromId) > 0 {
-		i -= len(m.FromId)
-		copy(dAtA[i:], m.FromId)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.FromId)))
+	i -= n15
+	i = encodeVarintEvent(dAtA, i, uint64(n15))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -5634,1891 +5614,7829 @@ func (m *WatchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Queue) > 0 {
-		i -= len(m.Queue)
-		copy(dAtA[i:], m.Queue)
-		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvent(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *JobSubmittedEvent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.JobId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.JobSetId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.Queue)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+func (m *JobEvictedForCapacityEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
-	n += 1 + l + sovEvent(uint64(l))
-	l = m.Job.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *JobQueuedEvent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.JobId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.JobSetId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.Queue)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
-	n += 1 + l + sovEvent(uint64(l))
-	return n
+func (m *JobEvictedForCapacityEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *JobDuplicateFoundEvent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *JobEvictedForCapacityEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.JobId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.ClusterId) > 0 {
+		i -= len(m.ClusterId)
+		copy(dAtA[i:], m.ClusterId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ClusterId)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.JobSetId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	n16, err16 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err16 != nil {
+		return 0, err16
 	}
-	l = len(m.Queue)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	i -= n16
+	i = encodeVarintEvent(dAtA, i, uint64(n16))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
-	n += 1 + l + sovEvent(uint64(l))
-	l = len(m.OriginalJobId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *JobLeasedEvent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.JobId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.JobSetId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.Queue)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
-	n += 1 + l + sovEvent(uint64(l))
-	l = len(m.ClusterId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+func (m *JobRuntimeExceededEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *JobLeaseReturnedEvent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *JobRuntimeExceededEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobRuntimeExceededEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.JobId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.JobSetId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.Queue)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if m.MaxRuntimeSeconds != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.MaxRuntimeSeconds))
+		i--
+		dAtA[i] = 0x30
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
-	n += 1 + l + sovEvent(uint64(l))
-	l = len(m.ClusterId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.ClusterId) > 0 {
+		i -= len(m.ClusterId)
+		copy(dAtA[i:], m.ClusterId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ClusterId)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.Reason)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	n17, err17 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err17 != nil {
+		return 0, err17
 	}
-	l = len(m.KubernetesId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	i -= n17
+	i = encodeVarintEvent(dAtA, i, uint64(n17))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.PodNumber != 0 {
-		n += 1 + sovEvent(uint64(m.PodNumber))
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.RunAttempted {
-		n += 2
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *JobLeaseExpiredEvent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.JobId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+func (m *JobResourcesNormalizedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.JobSetId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.Queue)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
-	n += 1 + l + sovEvent(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *JobPendingEvent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *JobResourcesNormalizedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobResourcesNormalizedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.JobId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.JobSetId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.Queue)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.NormalizedResources) > 0 {
+		for k := range m.NormalizedResources {
+			v := m.NormalizedResources[k]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintEvent(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintEvent(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x32
+		}
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
-	n += 1 + l + sovEvent(uint64(l))
-	l = len(m.ClusterId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.OriginalResources) > 0 {
+		for k := range m.OriginalResources {
+			v := m.OriginalResources[k]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintEvent(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintEvent(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	l = len(m.KubernetesId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	n20, err20 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err20 != nil {
+		return 0, err20
 	}
-	if m.PodNumber != 0 {
-		n += 1 + sovEvent(uint64(m.PodNumber))
+	i -= n20
+	i = encodeVarintEvent(dAtA, i, uint64(n20))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.PodName)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.PodNamespace)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *JobRunningEvent) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *JobUnschedulableEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *JobUnschedulableEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobUnschedulableEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.JobId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.JobSetId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.Queue)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
-	n += 1 + l + sovEvent(uint64(l))
-	l = len(m.ClusterId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.KubernetesId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.NodeName)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	n21, err21 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err21 != nil {
+		return 0, err21
 	}
-	if m.PodNumber != 0 {
-		n += 1 + sovEvent(uint64(m.PodNumber))
+	i -= n21
+	i = encodeVarintEvent(dAtA, i, uint64(n21))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.PodName)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.PodNamespace)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *JobIngressInfoEvent) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *JobMaintenanceWindowStartedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *JobMaintenanceWindowStartedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobMaintenanceWindowStartedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.JobId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.JobSetId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x4a
 	}
-	l = len(m.Queue)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if m.Drain {
+		i--
+		if m.Drain {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
-	n += 1 + l + sovEvent(uint64(l))
-	l = len(m.ClusterId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	n22, err22 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.End, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.End):])
+	if err22 != nil {
+		return 0, err22
 	}
-	l = len(m.KubernetesId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	i -= n22
+	i = encodeVarintEvent(dAtA, i, uint64(n22))
+	i--
+	dAtA[i] = 0x3a
+	if len(m.Executor) > 0 {
+		i -= len(m.Executor)
+		copy(dAtA[i:], m.Executor)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Executor)))
+		i--
+		dAtA[i] = 0x32
 	}
-	l = len(m.NodeName)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.MaintenanceWindowId) > 0 {
+		i -= len(m.MaintenanceWindowId)
+		copy(dAtA[i:], m.MaintenanceWindowId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.MaintenanceWindowId)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.PodNumber != 0 {
-		n += 1 + sovEvent(uint64(m.PodNumber))
+	n23, err23 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err23 != nil {
+		return 0, err23
 	}
-	if len(m.IngressAddresses) > 0 {
-		for k, v := range m.IngressAddresses {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + sovEvent(uint64(k)) + 1 + len(v) + sovEvent(uint64(len(v)))
-			n += mapEntrySize + 1 + sovEvent(uint64(mapEntrySize))
-		}
+	i -= n23
+	i = encodeVarintEvent(dAtA, i, uint64(n23))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.PodName)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.PodNamespace)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *JobUnableToScheduleEvent) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *JobMaintenanceWindowEndedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *JobMaintenanceWindowEndedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobMaintenanceWindowEndedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.JobId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.JobSetId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.Queue)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
-	n += 1 + l + sovEvent(uint64(l))
-	l = len(m.ClusterId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.Reason)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.Executor) > 0 {
+		i -= len(m.Executor)
+		copy(dAtA[i:], m.Executor)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Executor)))
+		i--
+		dAtA[i] = 0x32
 	}
-	l = len(m.KubernetesId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.MaintenanceWindowId) > 0 {
+		i -= len(m.MaintenanceWindowId)
+		copy(dAtA[i:], m.MaintenanceWindowId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.MaintenanceWindowId)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.NodeName)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	n24, err24 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err24 != nil {
+		return 0, err24
 	}
-	if m.PodNumber != 0 {
-		n += 1 + sovEvent(uint64(m.PodNumber))
+	i -= n24
+	i = encodeVarintEvent(dAtA, i, uint64(n24))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.PodName)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.PodNamespace)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *JobFailedEvent) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *JobCordonedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *JobCordonedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobCordonedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.JobId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.JobSetId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.Queue)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
-	n += 1 + l + sovEvent(uint64(l))
-	l = len(m.ClusterId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.Reason)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	if len(m.ExitCodes) > 0 {
-		for k, v := range m.ExitCodes {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovEvent(uint64(len(k))) + 1 + sovEvent(uint64(v))
-			n += mapEntrySize + 1 + sovEvent(uint64(mapEntrySize))
-		}
-	}
-	l = len(m.KubernetesId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	l = len(m.NodeName)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.CordonedBy) > 0 {
+		i -= len(m.CordonedBy)
+		copy(dAtA[i:], m.CordonedBy)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.CordonedBy)))
+		i--
+		dAtA[i] = 0x32
 	}
-	if m.PodNumber != 0 {
-		n += 1 + sovEvent(uint64(m.PodNumber))
+	if len(m.Executor) > 0 {
+		i -= len(m.Executor)
+		copy(dAtA[i:], m.Executor)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Executor)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if len(m.ContainerStatuses) > 0 {
-		for _, e := range m.ContainerStatuses {
-			l = e.Size()
-			n += 1 + l + sovEvent(uint64(l))
-		}
+	n25, err25 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err25 != nil {
+		return 0, err25
 	}
-	if m.Cause != 0 {
-		n += 1 + sovEvent(uint64(m.Cause))
+	i -= n25
+	i = encodeVarintEvent(dAtA, i, uint64(n25))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.PodName)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.PodNamespace)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *JobPreemptedEvent) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *JobUncordonedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *JobUncordonedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobUncordonedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.JobId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.JobSetId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.UncordonedBy) > 0 {
+		i -= len(m.UncordonedBy)
+		copy(dAtA[i:], m.UncordonedBy)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.UncordonedBy)))
+		i--
+		dAtA[i] = 0x32
 	}
-	l = len(m.Queue)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.Executor) > 0 {
+		i -= len(m.Executor)
+		copy(dAtA[i:], m.Executor)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Executor)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
-	n += 1 + l + sovEvent(uint64(l))
-	l = len(m.ClusterId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	n26, err26 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err26 != nil {
+		return 0, err26
 	}
-	l = len(m.RunId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	i -= n26
+	i = encodeVarintEvent(dAtA, i, uint64(n26))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.PreemptiveJobId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.PreemptiveRunId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *JobFailedEventCompressed) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Event)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+func (m *JobPreemptedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *JobSucceededEvent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *JobPreemptedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobPreemptedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.JobId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x52
 	}
-	l = len(m.JobSetId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.Requestor) > 0 {
+		i -= len(m.Requestor)
+		copy(dAtA[i:], m.Requestor)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Requestor)))
+		i--
+		dAtA[i] = 0x4a
 	}
-	l = len(m.Queue)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.PreemptiveRunId) > 0 {
+		i -= len(m.PreemptiveRunId)
+		copy(dAtA[i:], m.PreemptiveRunId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.PreemptiveRunId)))
+		i--
+		dAtA[i] = 0x42
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
-	n += 1 + l + sovEvent(uint64(l))
-	l = len(m.ClusterId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.PreemptiveJobId) > 0 {
+		i -= len(m.PreemptiveJobId)
+		copy(dAtA[i:], m.PreemptiveJobId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.PreemptiveJobId)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	l = len(m.KubernetesId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.RunId) > 0 {
+		i -= len(m.RunId)
+		copy(dAtA[i:], m.RunId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.RunId)))
+		i--
+		dAtA[i] = 0x32
 	}
-	l = len(m.NodeName)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.ClusterId) > 0 {
+		i -= len(m.ClusterId)
+		copy(dAtA[i:], m.ClusterId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ClusterId)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.PodNumber != 0 {
-		n += 1 + sovEvent(uint64(m.PodNumber))
+	n27, err27 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err27 != nil {
+		return 0, err27
 	}
-	l = len(m.PodName)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	i -= n27
+	i = encodeVarintEvent(dAtA, i, uint64(n27))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.PodNamespace)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *JobUtilisationEvent) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *JobFailedEventCompressed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *JobFailedEventCompressed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobFailedEventCompressed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.JobId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.JobSetId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.Queue)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
-	n += 1 + l + sovEvent(uint64(l))
-	l = len(m.ClusterId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.KubernetesId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	if len(m.MaxResourcesForPeriod) > 0 {
-		for k, v := range m.MaxResourcesForPeriod {
-			_ = k
-			_ = v
-			l = v.Size()
-			mapEntrySize := 1 + len(k) + sovEvent(uint64(len(k))) + 1 + l + sovEvent(uint64(l))
-			n += mapEntrySize + 1 + sovEvent(uint64(mapEntrySize))
-		}
-	}
-	l = len(m.NodeName)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	if m.PodNumber != 0 {
-		n += 1 + sovEvent(uint64(m.PodNumber))
-	}
-	l = len(m.PodName)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.PodNamespace)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	if len(m.TotalCumulativeUsage) > 0 {
-		for k, v := range m.TotalCumulativeUsage {
-			_ = k
-			_ = v
-			l = v.Size()
-			mapEntrySize := 1 + len(k) + sovEvent(uint64(len(k))) + 1 + l + sovEvent(uint64(l))
-			n += mapEntrySize + 1 + sovEvent(uint64(mapEntrySize))
-		}
+	if len(m.Event) > 0 {
+		i -= len(m.Event)
+		copy(dAtA[i:], m.Event)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Event)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *JobReprioritizingEvent) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *JobSucceededEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *JobSucceededEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobSucceededEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.JobId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.PodNamespace) > 0 {
+		i -= len(m.PodNamespace)
+		copy(dAtA[i:], m.PodNamespace)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.PodNamespace)))
+		i--
+		dAtA[i] = 0x52
 	}
-	l = len(m.JobSetId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.Queue)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.PodName) > 0 {
+		i -= len(m.PodName)
+		copy(dAtA[i:], m.PodName)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.PodName)))
+		i--
+		dAtA[i] = 0x4a
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
-	n += 1 + l + sovEvent(uint64(l))
-	if m.NewPriority != 0 {
-		n += 9
+	if m.PodNumber != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.PodNumber))
+		i--
+		dAtA[i] = 0x40
 	}
-	l = len(m.Requestor)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.NodeName) > 0 {
+		i -= len(m.NodeName)
+		copy(dAtA[i:], m.NodeName)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.NodeName)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	return n
-}
-
-func (m *JobReprioritizedEvent) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.KubernetesId) > 0 {
+		i -= len(m.KubernetesId)
+		copy(dAtA[i:], m.KubernetesId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.KubernetesId)))
+		i--
+		dAtA[i] = 0x32
 	}
-	var l int
-	_ = l
-	l = len(m.JobId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.ClusterId) > 0 {
+		i -= len(m.ClusterId)
+		copy(dAtA[i:], m.ClusterId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ClusterId)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.JobSetId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	n28, err28 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err28 != nil {
+		return 0, err28
 	}
-	l = len(m.Queue)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	i -= n28
+	i = encodeVarintEvent(dAtA, i, uint64(n28))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
-	n += 1 + l + sovEvent(uint64(l))
-	if m.NewPriority != 0 {
-		n += 9
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Requestor)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *JobCancellingEvent) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *JobUtilisationEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *JobUtilisationEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobUtilisationEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.JobId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.TotalCumulativeUsage) > 0 {
+		for k := range m.TotalCumulativeUsage {
+			v := m.TotalCumulativeUsage[k]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintEvent(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintEvent(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x62
+		}
 	}
-	l = len(m.JobSetId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.PodNamespace) > 0 {
+		i -= len(m.PodNamespace)
+		copy(dAtA[i:], m.PodNamespace)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.PodNamespace)))
+		i--
+		dAtA[i] = 0x5a
 	}
-	l = len(m.Queue)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.PodName) > 0 {
+		i -= len(m.PodName)
+		copy(dAtA[i:], m.PodName)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.PodName)))
+		i--
+		dAtA[i] = 0x52
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
-	n += 1 + l + sovEvent(uint64(l))
-	l = len(m.Requestor)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if m.PodNumber != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.PodNumber))
+		i--
+		dAtA[i] = 0x48
 	}
-	l = len(m.Reason)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.NodeName) > 0 {
+		i -= len(m.NodeName)
+		copy(dAtA[i:], m.NodeName)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.NodeName)))
+		i--
+		dAtA[i] = 0x42
 	}
-	return n
-}
-
-func (m *JobCancelledEvent) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.MaxResourcesForPeriod) > 0 {
+		for k := range m.MaxResourcesForPeriod {
+			v := m.MaxResourcesForPeriod[k]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintEvent(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintEvent(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x3a
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.JobId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.KubernetesId) > 0 {
+		i -= len(m.KubernetesId)
+		copy(dAtA[i:], m.KubernetesId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.KubernetesId)))
+		i--
+		dAtA[i] = 0x32
 	}
-	l = len(m.JobSetId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.ClusterId) > 0 {
+		i -= len(m.ClusterId)
+		copy(dAtA[i:], m.ClusterId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ClusterId)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.Queue)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	n31, err31 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err31 != nil {
+		return 0, err31
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
-	n += 1 + l + sovEvent(uint64(l))
-	l = len(m.Requestor)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	i -= n31
+	i = encodeVarintEvent(dAtA, i, uint64(n31))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Reason)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *JobTerminatedEvent) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *JobReprioritizingEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *JobReprioritizingEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobReprioritizingEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.JobId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.JobSetId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.Queue)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
-	n += 1 + l + sovEvent(uint64(l))
-	l = len(m.ClusterId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.Requestor) > 0 {
+		i -= len(m.Requestor)
+		copy(dAtA[i:], m.Requestor)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Requestor)))
+		i--
+		dAtA[i] = 0x32
 	}
-	l = len(m.KubernetesId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if m.NewPriority != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.NewPriority))))
+		i--
+		dAtA[i] = 0x29
 	}
-	if m.PodNumber != 0 {
-		n += 1 + sovEvent(uint64(m.PodNumber))
+	n32, err32 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err32 != nil {
+		return 0, err32
 	}
-	l = len(m.Reason)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	i -= n32
+	i = encodeVarintEvent(dAtA, i, uint64(n32))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.PodName)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.PodNamespace)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *JobUpdatedEvent) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *JobReprioritizedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *JobReprioritizedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobReprioritizedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.JobId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.Requestor) > 0 {
+		i -= len(m.Requestor)
+		copy(dAtA[i:], m.Requestor)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Requestor)))
+		i--
+		dAtA[i] = 0x32
 	}
-	l = len(m.JobSetId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	if m.NewPriority != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.NewPriority))))
+		i--
+		dAtA[i] = 0x29
 	}
-	l = len(m.Queue)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	n33, err33 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err33 != nil {
+		return 0, err33
 	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
-	n += 1 + l + sovEvent(uint64(l))
-	l = len(m.Requestor)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
+	i -= n33
+	i = encodeVarintEvent(dAtA, i, uint64(n33))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = m.Job.Size()
-	n += 1 + l + sovEvent(uint64(l))
-	return n
-}
-
-func (m *EventMessage) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	var l int
-	_ = l
-	if m.Events != nil {
-		n += m.Events.Size()
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventMessage_Submitted) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *JobCancellingEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *JobCancellingEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobCancellingEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Submitted != nil {
-		l = m.Submitted.Size()
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x32
 	}
-	return n
-}
-func (m *EventMessage_Queued) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Requestor) > 0 {
+		i -= len(m.Requestor)
+		copy(dAtA[i:], m.Requestor)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Requestor)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	var l int
-	_ = l
-	if m.Queued != nil {
-		l = m.Queued.Size()
-		n += 1 + l + sovEvent(uint64(l))
+	n34, err34 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err34 != nil {
+		return 0, err34
 	}
-	return n
-}
-func (m *EventMessage_Leased) Size() (n int) {
-	if m == nil {
-		return 0
+	i -= n34
+	i = encodeVarintEvent(dAtA, i, uint64(n34))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	var l int
-	_ = l
-	if m.Leased != nil {
-		l = m.Leased.Size()
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
-}
-func (m *EventMessage_LeaseReturned) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	var l int
-	_ = l
-	if m.LeaseReturned != nil {
-		l = m.LeaseReturned.Size()
-		n += 1 + l + sovEvent(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *JobCancelledEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
-func (m *EventMessage_LeaseExpired) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.LeaseExpired != nil {
-		l = m.LeaseExpired.Size()
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	return n
+
+func (m *JobCancelledEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *EventMessage_Pending) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *JobCancelledEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pending != nil {
-		l = m.Pending.Size()
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	return n
-}
-func (m *EventMessage_Running) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x32
 	}
-	var l int
-	_ = l
-	if m.Running != nil {
-		l = m.Running.Size()
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.Requestor) > 0 {
+		i -= len(m.Requestor)
+		copy(dAtA[i:], m.Requestor)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Requestor)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	return n
-}
-func (m *EventMessage_UnableToSchedule) Size() (n int) {
-	if m == nil {
-		return 0
+	n35, err35 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err35 != nil {
+		return 0, err35
 	}
-	var l int
-	_ = l
-	if m.UnableToSchedule != nil {
-		l = m.UnableToSchedule.Size()
-		n += 1 + l + sovEvent(uint64(l))
+	i -= n35
+	i = encodeVarintEvent(dAtA, i, uint64(n35))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
-}
-func (m *EventMessage_Failed) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	var l int
-	_ = l
-	if m.Failed != nil {
-		l = m.Failed.Size()
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *EventMessage_Succeeded) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Succeeded != nil {
-		l = m.Succeeded.Size()
-		n += 1 + l + sovEvent(uint64(l))
+
+func (m *JobTerminatedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
-func (m *EventMessage_Reprioritized) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Reprioritized != nil {
-		l = m.Reprioritized.Size()
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	return n
+
+func (m *JobTerminatedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *EventMessage_Cancelling) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *JobTerminatedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Cancelling != nil {
-		l = m.Cancelling.Size()
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.PodNamespace) > 0 {
+		i -= len(m.PodNamespace)
+		copy(dAtA[i:], m.PodNamespace)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.PodNamespace)))
+		i--
+		dAtA[i] = 0x52
 	}
-	return n
-}
-func (m *EventMessage_Cancelled) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.PodName) > 0 {
+		i -= len(m.PodName)
+		copy(dAtA[i:], m.PodName)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.PodName)))
+		i--
+		dAtA[i] = 0x4a
 	}
-	var l int
-	_ = l
-	if m.Cancelled != nil {
-		l = m.Cancelled.Size()
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x42
 	}
-	return n
-}
-func (m *EventMessage_Terminated) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.PodNumber != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.PodNumber))
+		i--
+		dAtA[i] = 0x38
 	}
-	var l int
-	_ = l
-	if m.Terminated != nil {
-		l = m.Terminated.Size()
-		n += 1 + l + sovEvent(uint64(l))
+	if len(m.KubernetesId) > 0 {
+		i -= len(m.KubernetesId)
+		copy(dAtA[i:], m.KubernetesId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.KubernetesId)))
+		i--
+		dAtA[i] = 0x32
 	}
-	return n
-}
-func (m *EventMessage_Utilisation) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.ClusterId) > 0 {
+		i -= len(m.ClusterId)
+		copy(dAtA[i:], m.ClusterId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.ClusterId)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	var l int
-	_ = l
-	if m.Utilisation != nil {
-		l = m.Utilisation.Size()
-		n += 1 + l + sovEvent(uint64(l))
+	n36, err36 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err36 != nil {
+		return 0, err36
 	}
-	return n
-}
-func (m *EventMessage_DuplicateFound) Size() (n int) {
-	if m == nil {
-		return 0
+	i -= n36
+	i = encodeVarintEvent(dAtA, i, uint64(n36))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	var l int
-	_ = l
-	if m.DuplicateFound != nil {
-		l = m.DuplicateFound.Size()
-		n += 2 + l + sovEvent(uint64(l))
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
-func (m *EventMessage_IngressInfo) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *JobUpdatedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *JobUpdatedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobUpdatedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.IngressInfo != nil {
-		l = m.IngressInfo.Size()
-		n += 2 + l + sovEvent(uint64(l))
-	}
-	return n
-}
-func (m *EventMessage_Reprioritizing) Size() (n int) {
-	if m == nil {
-		return 0
+	{
+		size, err := m.Job.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvent(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	if m.Reprioritizing != nil {
-		l = m.Reprioritizing.Size()
-		n += 2 + l + sovEvent(uint64(l))
+	i--
+	dAtA[i] = 0x32
+	if len(m.Requestor) > 0 {
+		i -= len(m.Requestor)
+		copy(dAtA[i:], m.Requestor)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Requestor)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	return n
-}
-func (m *EventMessage_Updated) Size() (n int) {
-	if m == nil {
-		return 0
+	n38, err38 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Created):])
+	if err38 != nil {
+		return 0, err38
 	}
-	var l int
-	_ = l
-	if m.Updated != nil {
-		l = m.Updated.Size()
-		n += 2 + l + sovEvent(uint64(l))
+	i -= n38
+	i = encodeVarintEvent(dAtA, i, uint64(n38))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
-}
-func (m *EventMessage_FailedCompressed) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	var l int
-	_ = l
-	if m.FailedCompressed != nil {
-		l = m.FailedCompressed.Size()
-		n += 2 + l + sovEvent(uint64(l))
+	if len(m.JobId) > 0 {
+		i -= len(m.JobId)
+		copy(dAtA[i:], m.JobId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *EventMessage_Preempted) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Preempted != nil {
-		l = m.Preempted.Size()
-		n += 2 + l + sovEvent(uint64(l))
+
+func (m *EventMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
-func (m *ContainerStatus) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	if m.ExitCode != 0 {
-		n += 1 + sovEvent(uint64(m.ExitCode))
-	}
-	l = len(m.Message)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.Reason)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	if m.Cause != 0 {
-		n += 1 + sovEvent(uint64(m.Cause))
-	}
-	return n
+
+func (m *EventMessage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventList) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Events) > 0 {
-		for _, e := range m.Events {
-			l = e.Size()
-			n += 1 + l + sovEvent(uint64(l))
+	if m.Events != nil {
+		{
+			size := m.Events.Size()
+			i -= size
+			if _, err := m.Events.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventStreamMessage) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	if m.Message != nil {
-		l = m.Message.Size()
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	return n
+func (m *EventMessage_Submitted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *JobSetRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	if m.Watch {
-		n += 2
-	}
-	l = len(m.FromMessageId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.Queue)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	if m.ErrorIfMissing {
-		n += 2
-	}
-	if m.ForceLegacy {
-		n += 2
-	}
-	if m.ForceNew {
-		n += 2
+func (m *EventMessage_Submitted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Submitted != nil {
+		{
+			size, err := m.Submitted.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-
-func (m *WatchRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Queue)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.JobSetId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	l = len(m.FromId)
-	if l > 0 {
-		n += 1 + l + sovEvent(uint64(l))
-	}
-	if m.ForceLegacy {
-		n += 2
-	}
-	if m.ForceNew {
-		n += 2
-	}
-	return n
+func (m *EventMessage_Queued) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func sovEvent(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *EventMessage_Queued) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Queued != nil {
+		{
+			size, err := m.Queued.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
 }
-func sozEvent(x uint64) (n int) {
-	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *EventMessage_Leased) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *JobSubmittedEvent) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&JobSubmittedEvent{`,
-		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
-		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
-		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
-		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
-		`Job:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Job), "Job", "Job", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *JobQueuedEvent) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *EventMessage_Leased) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Leased != nil {
+		{
+			size, err := m.Leased.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	s := strings.Join([]string{`&JobQueuedEvent{`,
-		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
-		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
-		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
-		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *JobDuplicateFoundEvent) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&JobDuplicateFoundEvent{`,
-		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
-		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
-		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
-		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
-		`OriginalJobId:` + fmt.Sprintf("%v", this.OriginalJobId) + `,`,
-		`}`,
-	}, "")
-	return s
+func (m *EventMessage_LeaseReturned) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *JobLeasedEvent) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *EventMessage_LeaseReturned) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.LeaseReturned != nil {
+		{
+			size, err := m.LeaseReturned.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	s := strings.Join([]string{`&JobLeasedEvent{`,
-		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
-		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
-		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
-		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
-		`ClusterId:` + fmt.Sprintf("%v", this.ClusterId) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *JobLeaseReturnedEvent) String() string {
-	if this == nil {
-		return "nil"
+func (m *EventMessage_LeaseExpired) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMessage_LeaseExpired) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.LeaseExpired != nil {
+		{
+			size, err := m.LeaseExpired.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	s := strings.Join([]string{`&JobLeaseReturnedEvent{`,
-		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
-		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
-		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
-		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
-		`ClusterId:` + fmt.Sprintf("%v", this.ClusterId) + `,`,
-		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
-		`KubernetesId:` + fmt.Sprintf("%v", this.KubernetesId) + `,`,
-		`PodNumber:` + fmt.Sprintf("%v", this.PodNumber) + `,`,
-		`RunAttempted:` + fmt.Sprintf("%v", this.RunAttempted) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *JobLeaseExpiredEvent) String() string {
-	if this == nil {
-		return "nil"
+func (m *EventMessage_Pending) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMessage_Pending) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Pending != nil {
+		{
+			size, err := m.Pending.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
 	}
-	s := strings.Join([]string{`&JobLeaseExpiredEvent{`,
-		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
-		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
-		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
-		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *JobPendingEvent) String() string {
-	if this == nil {
-		return "nil"
+func (m *EventMessage_Running) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMessage_Running) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Running != nil {
+		{
+			size, err := m.Running.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
 	}
-	s := strings.Join([]string{`&JobPendingEvent{`,
-		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
-		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
-		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
-		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
-		`ClusterId:` + fmt.Sprintf("%v", this.ClusterId) + `,`,
-		`KubernetesId:` + fmt.Sprintf("%v", this.KubernetesId) + `,`,
-		`PodNumber:` + fmt.Sprintf("%v", this.PodNumber) + `,`,
-		`PodName:` + fmt.Sprintf("%v", this.PodName) + `,`,
-		`PodNamespace:` + fmt.Sprintf("%v", this.PodNamespace) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *JobRunningEvent) String() string {
-	if this == nil {
-		return "nil"
+func (m *EventMessage_UnableToSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMessage_UnableToSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.UnableToSchedule != nil {
+		{
+			size, err := m.UnableToSchedule.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
 	}
-	s := strings.Join([]string{`&JobRunningEvent{`,
-		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
-		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
-		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
-		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
-		`ClusterId:` + fmt.Sprintf("%v", this.ClusterId) + `,`,
-		`KubernetesId:` + fmt.Sprintf("%v", this.KubernetesId) + `,`,
-		`NodeName:` + fmt.Sprintf("%v", this.NodeName) + `,`,
-		`PodNumber:` + fmt.Sprintf("%v", this.PodNumber) + `,`,
-		`PodName:` + fmt.Sprintf("%v", this.PodName) + `,`,
-		`PodNamespace:` + fmt.Sprintf("%v", this.PodNamespace) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *JobIngressInfoEvent) String() string {
-	if this == nil {
-		return "nil"
+func (m *EventMessage_Failed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMessage_Failed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Failed != nil {
+		{
+			size, err := m.Failed.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
 	}
-	keysForIngressAddresses := make([]int32, 0, len(this.IngressAddresses))
-	for k, _ := range this.IngressAddresses {
-		keysForIngressAddresses = append(keysForIngressAddresses, k)
+	return len(dAtA) - i, nil
+}
+func (m *EventMessage_Succeeded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMessage_Succeeded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Succeeded != nil {
+		{
+			size, err := m.Succeeded.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
 	}
-	github_com_gogo_protobuf_sortkeys.Int32s(keysForIngressAddresses)
-	mapStringForIngressAddresses := "map[int32]string{"
-	for _, k := range keysForIngressAddresses {
-		mapStringForIngressAddresses += fmt.Sprintf("%v: %v,", k, this.IngressAddresses[k])
-	}
-	mapStringForIngressAddresses += "}"
-	s := strings.Join([]string{`&JobIngressInfoEvent{`,
-		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
-		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
-		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
-		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
-		`ClusterId:` + fmt.Sprintf("%v", this.ClusterId) + `,`,
-		`KubernetesId:` + fmt.Sprintf("%v", this.KubernetesId) + `,`,
-		`NodeName:` + fmt.Sprintf("%v", this.NodeName) + `,`,
-		`PodNumber:` + fmt.Sprintf("%v", this.PodNumber) + `,`,
-		`IngressAddresses:` + mapStringForIngressAddresses + `,`,
-		`PodName:` + fmt.Sprintf("%v", this.PodName) + `,`,
-		`PodNamespace:` + fmt.Sprintf("%v", this.PodNamespace) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *JobUnableToScheduleEvent) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&JobUnableToScheduleEvent{`,
-		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
-		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
-		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
-		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
-		`ClusterId:` + fmt.Sprintf("%v", this.ClusterId) + `,`,
-		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
-		`KubernetesId:` + fmt.Sprintf("%v", this.KubernetesId) + `,`,
-		`NodeName:` + fmt.Sprintf("%v", this.NodeName) + `,`,
-		`PodNumber:` + fmt.Sprintf("%v", this.PodNumber) + `,`,
-		`PodName:` + fmt.Sprintf("%v", this.PodName) + `,`,
-		`PodNamespace:` + fmt.Sprintf("%v", this.PodNamespace) + `,`,
-		`}`,
-	}, "")
-	return s
+func (m *EventMessage_Reprioritized) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *JobFailedEvent) String() string {
-	if this == nil {
-		return "nil"
-	}
-	repeatedStringForContainerStatuses := "[]*ContainerStatus{"
-	for _, f := range this.ContainerStatuses {
-		repeatedStringForContainerStatuses += strings.Replace(f.String(), "ContainerStatus", "ContainerStatus", 1) + ","
-	}
-	repeatedStringForContainerStatuses += "}"
-	keysForExitCodes := make([]string, 0, len(this.ExitCodes))
-	for k, _ := range this.ExitCodes {
-		keysForExitCodes = append(keysForExitCodes, k)
-	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForExitCodes)
-	mapStringForExitCodes := "map[string]int32{"
-	for _, k := range keysForExitCodes {
-		mapStringForExitCodes += fmt.Sprintf("%v: %v,", k, this.ExitCodes[k])
+
+func (m *EventMessage_Reprioritized) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Reprioritized != nil {
+		{
+			size, err := m.Reprioritized.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
 	}
-	mapStringForExitCodes += "}"
-	s := strings.Join([]string{`&JobFailedEvent{`,
-		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
-		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
-		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
-		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
-		`ClusterId:` + fmt.Sprintf("%v", this.ClusterId) + `,`,
-		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
-		`ExitCodes:` + mapStringForExitCodes + `,`,
-		`KubernetesId:` + fmt.Sprintf("%v", this.KubernetesId) + `,`,
-		`NodeName:` + fmt.Sprintf("%v", this.NodeName) + `,`,
-		`PodNumber:` + fmt.Sprintf("%v", this.PodNumber) + `,`,
-		`ContainerStatuses:` + repeatedStringForContainerStatuses + `,`,
-		`Cause:` + fmt.Sprintf("%v", this.Cause) + `,`,
-		`PodName:` + fmt.Sprintf("%v", this.PodName) + `,`,
-		`PodNamespace:` + fmt.Sprintf("%v", this.PodNamespace) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *JobPreemptedEvent) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&JobPreemptedEvent{`,
-		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
-		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
-		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
-		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
-		`ClusterId:` + fmt.Sprintf("%v", this.ClusterId) + `,`,
-		`RunId:` + fmt.Sprintf("%v", this.RunId) + `,`,
-		`PreemptiveJobId:` + fmt.Sprintf("%v", this.PreemptiveJobId) + `,`,
-		`PreemptiveRunId:` + fmt.Sprintf("%v", this.PreemptiveRunId) + `,`,
-		`}`,
-	}, "")
-	return s
+func (m *EventMessage_Cancelling) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *JobFailedEventCompressed) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *EventMessage_Cancelling) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Cancelling != nil {
+		{
+			size, err := m.Cancelling.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
 	}
-	s := strings.Join([]string{`&JobFailedEventCompressed{`,
-		`Event:` + fmt.Sprintf("%v", this.Event) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *JobSucceededEvent) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&JobSucceededEvent{`,
-		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
-		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
-		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
-		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
-		`ClusterId:` + fmt.Sprintf("%v", this.ClusterId) + `,`,
-		`KubernetesId:` + fmt.Sprintf("%v", this.KubernetesId) + `,`,
-		`NodeName:` + fmt.Sprintf("%v", this.NodeName) + `,`,
-		`PodNumber:` + fmt.Sprintf("%v", this.PodNumber) + `,`,
-		`PodName:` + fmt.Sprintf("%v", this.PodName) + `,`,
-		`PodNamespace:` + fmt.Sprintf("%v", this.PodNamespace) + `,`,
-		`}`,
-	}, "")
-	return s
+func (m *EventMessage_Cancelled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *JobUtilisationEvent) String() string {
-	if this == nil {
-		return "nil"
-	}
-	keysForMaxResourcesForPeriod := make([]string, 0, len(this.MaxResourcesForPeriod))
-	for k, _ := range this.MaxResourcesForPeriod {
-		keysForMaxResourcesForPeriod = append(keysForMaxResourcesForPeriod, k)
-	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForMaxResourcesForPeriod)
-	mapStringForMaxResourcesForPeriod := "map[string]resource.Quantity{"
-	for _, k := range keysForMaxResourcesForPeriod {
-		mapStringForMaxResourcesForPeriod += fmt.Sprintf("%v: %v,", k, this.MaxResourcesForPeriod[k])
-	}
-	mapStringForMaxResourcesForPeriod += "}"
-	keysForTotalCumulativeUsage := make([]string, 0, len(this.TotalCumulativeUsage))
-	for k, _ := range this.TotalCumulativeUsage {
-		keysForTotalCumulativeUsage = append(keysForTotalCumulativeUsage, k)
-	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForTotalCumulativeUsage)
-	mapStringForTotalCumulativeUsage := "map[string]resource.Quantity{"
-	for _, k := range keysForTotalCumulativeUsage {
-		mapStringForTotalCumulativeUsage += fmt.Sprintf("%v: %v,", k, this.TotalCumulativeUsage[k])
+
+func (m *EventMessage_Cancelled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Cancelled != nil {
+		{
+			size, err := m.Cancelled.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
 	}
-	mapStringForTotalCumulativeUsage += "}"
-	s := strings.Join([]string{`&JobUtilisationEvent{`,
-		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
-		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
-		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
-		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
-		`ClusterId:` + fmt.Sprintf("%v", this.ClusterId) + `,`,
-		`KubernetesId:` + fmt.Sprintf("%v", this.KubernetesId) + `,`,
-		`MaxResourcesForPeriod:` + mapStringForMaxResourcesForPeriod + `,`,
-		`NodeName:` + fmt.Sprintf("%v", this.NodeName) + `,`,
-		`PodNumber:` + fmt.Sprintf("%v", this.PodNumber) + `,`,
-		`PodName:` + fmt.Sprintf("%v", this.PodName) + `,`,
-		`PodNamespace:` + fmt.Sprintf("%v", this.PodNamespace) + `,`,
-		`TotalCumulativeUsage:` + mapStringForTotalCumulativeUsage + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *JobReprioritizingEvent) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&JobReprioritizingEvent{`,
-		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
-		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
-		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
-		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
-		`NewPriority:` + fmt.Sprintf("%v", this.NewPriority) + `,`,
-		`Requestor:` + fmt.Sprintf("%v", this.Requestor) + `,`,
-		`}`,
-	}, "")
-	return s
+func (m *EventMessage_Terminated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *JobReprioritizedEvent) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *EventMessage_Terminated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Terminated != nil {
+		{
+			size, err := m.Terminated.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
 	}
-	s := strings.Join([]string{`&JobReprioritizedEvent{`,
-		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
-		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
-		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
-		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
-		`NewPriority:` + fmt.Sprintf("%v", this.NewPriority) + `,`,
-		`Requestor:` + fmt.Sprintf("%v", this.Requestor) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *JobCancellingEvent) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&JobCancellingEvent{`,
-		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
-		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
-		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
-		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
-		`Requestor:` + fmt.Sprintf("%v", this.Requestor) + `,`,
-		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
-		`}`,
-	}, "")
-	return s
+func (m *EventMessage_Utilisation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *JobCancelledEvent) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *EventMessage_Utilisation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Utilisation != nil {
+		{
+			size, err := m.Utilisation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
 	}
-	s := strings.Join([]string{`&JobCancelledEvent{`,
-		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
-		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
-		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
-		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
-		`Requestor:` + fmt.Sprintf("%v", this.Requestor) + `,`,
-		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *JobTerminatedEvent) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&JobTerminatedEvent{`,
-		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
-		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
-		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
-		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
-		`ClusterId:` + fmt.Sprintf("%v", this.ClusterId) + `,`,
-		`KubernetesId:` + fmt.Sprintf("%v", this.KubernetesId) + `,`,
-		`PodNumber:` + fmt.Sprintf("%v", this.PodNumber) + `,`,
-		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
-		`PodName:` + fmt.Sprintf("%v", this.PodName) + `,`,
-		`PodNamespace:` + fmt.Sprintf("%v", this.PodNamespace) + `,`,
-		`}`,
-	}, "")
-	return s
+func (m *EventMessage_DuplicateFound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *JobUpdatedEvent) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *EventMessage_DuplicateFound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.DuplicateFound != nil {
+		{
+			size, err := m.DuplicateFound.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
 	}
-	s := strings.Join([]string{`&JobUpdatedEvent{`,
-		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
-		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
-		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
-		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
-		`Requestor:` + fmt.Sprintf("%v", this.Requestor) + `,`,
-		`Job:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Job), "Job", "Job", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *EventMessage) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&EventMessage{`,
-		`Events:` + fmt.Sprintf("%v", this.Events) + `,`,
-		`}`,
-	}, "")
-	return s
+func (m *EventMessage_IngressInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *EventMessage_Submitted) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *EventMessage_IngressInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.IngressInfo != nil {
+		{
+			size, err := m.IngressInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
 	}
-	s := strings.Join([]string{`&EventMessage_Submitted{`,
-		`Submitted:` + strings.Replace(fmt.Sprintf("%v", this.Submitted), "JobSubmittedEvent", "JobSubmittedEvent", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *EventMessage_Queued) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&EventMessage_Queued{`,
-		`Queued:` + strings.Replace(fmt.Sprintf("%v", this.Queued), "JobQueuedEvent", "JobQueuedEvent", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+func (m *EventMessage_Reprioritizing) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *EventMessage_Leased) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *EventMessage_Reprioritizing) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Reprioritizing != nil {
+		{
+			size, err := m.Reprioritizing.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
 	}
-	s := strings.Join([]string{`&EventMessage_Leased{`,
-		`Leased:` + strings.Replace(fmt.Sprintf("%v", this.Leased), "JobLeasedEvent", "JobLeasedEvent", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *EventMessage_LeaseReturned) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&EventMessage_LeaseReturned{`,
-		`LeaseReturned:` + strings.Replace(fmt.Sprintf("%v", this.LeaseReturned), "JobLeaseReturnedEvent", "JobLeaseReturnedEvent", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+func (m *EventMessage_Updated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *EventMessage_LeaseExpired) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *EventMessage_Updated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Updated != nil {
+		{
+			size, err := m.Updated.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
 	}
-	s := strings.Join([]string{`&EventMessage_LeaseExpired{`,
-		`LeaseExpired:` + strings.Replace(fmt.Sprintf("%v", this.LeaseExpired), "JobLeaseExpiredEvent", "JobLeaseExpiredEvent", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *EventMessage_Pending) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&EventMessage_Pending{`,
-		`Pending:` + strings.Replace(fmt.Sprintf("%v", this.Pending), "JobPendingEvent", "JobPendingEvent", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+func (m *EventMessage_FailedCompressed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *EventMessage_Running) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *EventMessage_FailedCompressed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.FailedCompressed != nil {
+		{
+			size, err := m.FailedCompressed.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
 	}
-	s := strings.Join([]string{`&EventMessage_Running{`,
-		`Running:` + strings.Replace(fmt.Sprintf("%v", this.Running), "JobRunningEvent", "JobRunningEvent", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *EventMessage_UnableToSchedule) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&EventMessage_UnableToSchedule{`,
-		`UnableToSchedule:` + strings.Replace(fmt.Sprintf("%v", this.UnableToSchedule), "JobUnableToScheduleEvent", "JobUnableToScheduleEvent", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+func (m *EventMessage_Preempted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *EventMessage_Failed) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *EventMessage_Preempted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Preempted != nil {
+		{
+			size, err := m.Preempted.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
 	}
-	s := strings.Join([]string{`&EventMessage_Failed{`,
-		`Failed:` + strings.Replace(fmt.Sprintf("%v", this.Failed), "JobFailedEvent", "JobFailedEvent", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *EventMessage_Succeeded) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&EventMessage_Succeeded{`,
-		`Succeeded:` + strings.Replace(fmt.Sprintf("%v", this.Succeeded), "JobSucceededEvent", "JobSucceededEvent", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+func (m *EventMessage_JobSetExpired) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *EventMessage_Reprioritized) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *EventMessage_JobSetExpired) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.JobSetExpired != nil {
+		{
+			size, err := m.JobSetExpired.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
 	}
-	s := strings.Join([]string{`&EventMessage_Reprioritized{`,
-		`Reprioritized:` + strings.Replace(fmt.Sprintf("%v", this.Reprioritized), "JobReprioritizedEvent", "JobReprioritizedEvent", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *EventMessage_Cancelling) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&EventMessage_Cancelling{`,
-		`Cancelling:` + strings.Replace(fmt.Sprintf("%v", this.Cancelling), "JobCancellingEvent", "JobCancellingEvent", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+func (m *EventMessage_Suspended) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *EventMessage_Cancelled) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *EventMessage_Suspended) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Suspended != nil {
+		{
+			size, err := m.Suspended.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
 	}
-	s := strings.Join([]string{`&EventMessage_Cancelled{`,
-		`Cancelled:` + strings.Replace(fmt.Sprintf("%v", this.Cancelled), "JobCancelledEvent", "JobCancelledEvent", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *EventMessage_Terminated) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&EventMessage_Terminated{`,
-		`Terminated:` + strings.Replace(fmt.Sprintf("%v", this.Terminated), "JobTerminatedEvent", "JobTerminatedEvent", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+func (m *EventMessage_Resumed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *EventMessage_Utilisation) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *EventMessage_Resumed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Resumed != nil {
+		{
+			size, err := m.Resumed.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
 	}
-	s := strings.Join([]string{`&EventMessage_Utilisation{`,
-		`Utilisation:` + strings.Replace(fmt.Sprintf("%v", this.Utilisation), "JobUtilisationEvent", "JobUtilisationEvent", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *EventMessage_DuplicateFound) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&EventMessage_DuplicateFound{`,
-		`DuplicateFound:` + strings.Replace(fmt.Sprintf("%v", this.DuplicateFound), "JobDuplicateFoundEvent", "JobDuplicateFoundEvent", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+func (m *EventMessage_EvictedForCapacity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *EventMessage_IngressInfo) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *EventMessage_EvictedForCapacity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.EvictedForCapacity != nil {
+		{
+			size, err := m.EvictedForCapacity.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
 	}
-	s := strings.Join([]string{`&EventMessage_IngressInfo{`,
-		`IngressInfo:` + strings.Replace(fmt.Sprintf("%v", this.IngressInfo), "JobIngressInfoEvent", "JobIngressInfoEvent", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *EventMessage_Reprioritizing) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&EventMessage_Reprioritizing{`,
-		`Reprioritizing:` + strings.Replace(fmt.Sprintf("%v", this.Reprioritizing), "JobReprioritizingEvent", "JobReprioritizingEvent", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+func (m *EventMessage_RuntimeExceeded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *EventMessage_Updated) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *EventMessage_RuntimeExceeded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RuntimeExceeded != nil {
+		{
+			size, err := m.RuntimeExceeded.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd2
 	}
-	s := strings.Join([]string{`&EventMessage_Updated{`,
-		`Updated:` + strings.Replace(fmt.Sprintf("%v", this.Updated), "JobUpdatedEvent", "JobUpdatedEvent", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *EventMessage_FailedCompressed) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&EventMessage_FailedCompressed{`,
-		`FailedCompressed:` + strings.Replace(fmt.Sprintf("%v", this.FailedCompressed), "JobFailedEventCompressed", "JobFailedEventCompressed", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+func (m *EventMessage_ResourcesNormalized) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *EventMessage_Preempted) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *EventMessage_ResourcesNormalized) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ResourcesNormalized != nil {
+		{
+			size, err := m.ResourcesNormalized.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xda
 	}
-	s := strings.Join([]string{`&EventMessage_Preempted{`,
-		`Preempted:` + strings.Replace(fmt.Sprintf("%v", this.Preempted), "JobPreemptedEvent", "JobPreemptedEvent", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *ContainerStatus) String() string {
-	if this == nil {
-		return "nil"
-	}
-	s := strings.Join([]string{`&ContainerStatus{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`ExitCode:` + fmt.Sprintf("%v", this.ExitCode) + `,`,
-		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
-		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
-		`Cause:` + fmt.Sprintf("%v", this.Cause) + `,`,
-		`}`,
-	}, "")
-	return s
+func (m *EventMessage_Unschedulable) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (this *EventList) String() string {
-	if this == nil {
-		return "nil"
-	}
-	repeatedStringForEvents := "[]*EventMessage{"
-	for _, f := range this.Events {
-		repeatedStringForEvents += strings.Replace(f.String(), "EventMessage", "EventMessage", 1) + ","
+
+func (m *EventMessage_Unschedulable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Unschedulable != nil {
+		{
+			size, err := m.Unschedulable.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe2
 	}
-	repeatedStringForEvents += "}"
-	s := strings.Join([]string{`&EventList{`,
-		`Events:` + repeatedStringForEvents + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *EventStreamMessage) String() string {
-	if this == nil {
-		return "nil"
+func (m *EventMessage_MaintenanceWindowStarted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMessage_MaintenanceWindowStarted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.MaintenanceWindowStarted != nil {
+		{
+			size, err := m.MaintenanceWindowStarted.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xea
 	}
-	s := strings.Join([]string{`&EventStreamMessage{`,
-		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
-		`Message:` + strings.Replace(this.Message.String(), "EventMessage", "EventMessage", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *JobSetRequest) String() string {
-	if this == nil {
-		return "nil"
+func (m *EventMessage_MaintenanceWindowEnded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMessage_MaintenanceWindowEnded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.MaintenanceWindowEnded != nil {
+		{
+			size, err := m.MaintenanceWindowEnded.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf2
 	}
-	s := strings.Join([]string{`&JobSetRequest{`,
-		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
-		`Watch:` + fmt.Sprintf("%v", this.Watch) + `,`,
-		`FromMessageId:` + fmt.Sprintf("%v", this.FromMessageId) + `,`,
-		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
-		`ErrorIfMissing:` + fmt.Sprintf("%v", this.ErrorIfMissing) + `,`,
-		`ForceLegacy:` + fmt.Sprintf("%v", this.ForceLegacy) + `,`,
-		`ForceNew:` + fmt.Sprintf("%v", this.ForceNew) + `,`,
-		`}`,
-	}, "")
-	return s
+	return len(dAtA) - i, nil
 }
-func (this *WatchRequest) String() string {
-	if this == nil {
-		return "nil"
+func (m *EventMessage_Cordoned) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMessage_Cordoned) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Cordoned != nil {
+		{
+			size, err := m.Cordoned.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xfa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *EventMessage_Uncordoned) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventMessage_Uncordoned) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Uncordoned != nil {
+		{
+			size, err := m.Uncordoned.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x82
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ContainerStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContainerStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContainerStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Cause != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Cause))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ExitCode != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.ExitCode))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Events) > 0 {
+		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Events[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventStreamMessage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventStreamMessage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventStreamMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SchemaVersion != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.SchemaVersion))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Message != nil {
+		{
+			size, err := m.Message.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobSetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobSetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobSetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EventTypes) > 0 {
+		for iNdEx := len(m.EventTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.EventTypes[iNdEx])
+			copy(dAtA[i:], m.EventTypes[iNdEx])
+			i = encodeVarintEvent(dAtA, i, uint64(len(m.EventTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.TerminalOnly {
+		i--
+		if m.TerminalOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.FromTime != nil {
+		n72, err72 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.FromTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.FromTime):])
+		if err72 != nil {
+			return 0, err72
+		}
+		i -= n72
+		i = encodeVarintEvent(dAtA, i, uint64(n72))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.SchemaVersion != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.SchemaVersion))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ForceNew {
+		i--
+		if m.ForceNew {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ForceLegacy {
+		i--
+		if m.ForceLegacy {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ErrorIfMissing {
+		i--
+		if m.ErrorIfMissing {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.FromMessageId) > 0 {
+		i -= len(m.FromMessageId)
+		copy(dAtA[i:], m.FromMessageId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.FromMessageId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Watch {
+		i--
+		if m.Watch {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WatchRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WatchRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WatchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SchemaVersion != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.SchemaVersion))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ForceNew {
+		i--
+		if m.ForceNew {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ForceLegacy {
+		i--
+		if m.ForceLegacy {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.FromId) > 0 {
+		i -= len(m.FromId)
+		copy(dAtA[i:], m.FromId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.FromId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.JobSetId) > 0 {
+		i -= len(m.JobSetId)
+		copy(dAtA[i:], m.JobSetId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.JobSetId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Queue)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvent(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *JobSubmittedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovEvent(uint64(l))
+	l = m.Job.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *JobQueuedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovEvent(uint64(l))
+	if m.Attempt != 0 {
+		n += 1 + sovEvent(uint64(m.Attempt))
+	}
+	return n
+}
+
+func (m *JobDuplicateFoundEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.OriginalJobId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *JobLeasedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.ClusterId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Pool)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *JobLeaseReturnedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.ClusterId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.KubernetesId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.PodNumber != 0 {
+		n += 1 + sovEvent(uint64(m.PodNumber))
+	}
+	if m.RunAttempted {
+		n += 2
+	}
+	return n
+}
+
+func (m *JobLeaseExpiredEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *JobPendingEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.ClusterId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.KubernetesId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.PodNumber != 0 {
+		n += 1 + sovEvent(uint64(m.PodNumber))
+	}
+	l = len(m.PodName)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.PodNamespace)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *JobRunningEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.ClusterId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.KubernetesId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.NodeName)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.PodNumber != 0 {
+		n += 1 + sovEvent(uint64(m.PodNumber))
+	}
+	l = len(m.PodName)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.PodNamespace)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Pool)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if len(m.NodeLabels) > 0 {
+		for k, v := range m.NodeLabels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovEvent(uint64(len(k))) + 1 + len(v) + sovEvent(uint64(len(v)))
+			n += mapEntrySize + 1 + sovEvent(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *JobIngressInfoEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.ClusterId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.KubernetesId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.NodeName)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.PodNumber != 0 {
+		n += 1 + sovEvent(uint64(m.PodNumber))
+	}
+	if len(m.IngressAddresses) > 0 {
+		for k, v := range m.IngressAddresses {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovEvent(uint64(k)) + 1 + len(v) + sovEvent(uint64(len(v)))
+			n += mapEntrySize + 1 + sovEvent(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.PodName)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.PodNamespace)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *JobUnableToScheduleEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.ClusterId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.KubernetesId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.NodeName)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.PodNumber != 0 {
+		n += 1 + sovEvent(uint64(m.PodNumber))
+	}
+	l = len(m.PodName)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.PodNamespace)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *JobFailedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.ClusterId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if len(m.ExitCodes) > 0 {
+		for k, v := range m.ExitCodes {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovEvent(uint64(len(k))) + 1 + sovEvent(uint64(v))
+			n += mapEntrySize + 1 + sovEvent(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.KubernetesId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.NodeName)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.PodNumber != 0 {
+		n += 1 + sovEvent(uint64(m.PodNumber))
+	}
+	if len(m.ContainerStatuses) > 0 {
+		for _, e := range m.ContainerStatuses {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	if m.Cause != 0 {
+		n += 1 + sovEvent(uint64(m.Cause))
+	}
+	l = len(m.PodName)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.PodNamespace)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.WillRetry {
+		n += 2
+	}
+	if m.Attempt != 0 {
+		n += 2 + sovEvent(uint64(m.Attempt))
+	}
+	return n
+}
+
+func (m *JobSetExpiredEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovEvent(uint64(l))
+	if m.JobSetTtlSeconds != 0 {
+		n += 1 + sovEvent(uint64(m.JobSetTtlSeconds))
+	}
+	return n
+}
+
+func (m *JobSuspendedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.Requestor)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *JobResumedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.Requestor)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *JobEvictedForCapacityEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.ClusterId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *JobRuntimeExceededEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.ClusterId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.MaxRuntimeSeconds != 0 {
+		n += 1 + sovEvent(uint64(m.MaxRuntimeSeconds))
+	}
+	return n
+}
+
+func (m *JobResourcesNormalizedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovEvent(uint64(l))
+	if len(m.OriginalResources) > 0 {
+		for k, v := range m.OriginalResources {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovEvent(uint64(len(k))) + 1 + l + sovEvent(uint64(l))
+			n += mapEntrySize + 1 + sovEvent(uint64(mapEntrySize))
+		}
+	}
+	if len(m.NormalizedResources) > 0 {
+		for k, v := range m.NormalizedResources {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovEvent(uint64(len(k))) + 1 + l + sovEvent(uint64(l))
+			n += mapEntrySize + 1 + sovEvent(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *JobUnschedulableEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *JobMaintenanceWindowStartedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.MaintenanceWindowId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Executor)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.End)
+	n += 1 + l + sovEvent(uint64(l))
+	if m.Drain {
+		n += 2
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *JobMaintenanceWindowEndedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.MaintenanceWindowId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Executor)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *JobCordonedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.Executor)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.CordonedBy)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *JobUncordonedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.Executor)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.UncordonedBy)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *JobPreemptedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.ClusterId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.RunId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.PreemptiveJobId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.PreemptiveRunId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Requestor)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *JobFailedEventCompressed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Event)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *JobSucceededEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.ClusterId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.KubernetesId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.NodeName)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.PodNumber != 0 {
+		n += 1 + sovEvent(uint64(m.PodNumber))
+	}
+	l = len(m.PodName)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.PodNamespace)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *JobUtilisationEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.ClusterId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.KubernetesId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if len(m.MaxResourcesForPeriod) > 0 {
+		for k, v := range m.MaxResourcesForPeriod {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovEvent(uint64(len(k))) + 1 + l + sovEvent(uint64(l))
+			n += mapEntrySize + 1 + sovEvent(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.NodeName)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.PodNumber != 0 {
+		n += 1 + sovEvent(uint64(m.PodNumber))
+	}
+	l = len(m.PodName)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.PodNamespace)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if len(m.TotalCumulativeUsage) > 0 {
+		for k, v := range m.TotalCumulativeUsage {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovEvent(uint64(len(k))) + 1 + l + sovEvent(uint64(l))
+			n += mapEntrySize + 1 + sovEvent(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *JobReprioritizingEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovEvent(uint64(l))
+	if m.NewPriority != 0 {
+		n += 9
+	}
+	l = len(m.Requestor)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *JobReprioritizedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovEvent(uint64(l))
+	if m.NewPriority != 0 {
+		n += 9
+	}
+	l = len(m.Requestor)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *JobCancellingEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.Requestor)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *JobCancelledEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.Requestor)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *JobTerminatedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.ClusterId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.KubernetesId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.PodNumber != 0 {
+		n += 1 + sovEvent(uint64(m.PodNumber))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.PodName)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.PodNamespace)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+
+func (m *JobUpdatedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.JobId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Created)
+	n += 1 + l + sovEvent(uint64(l))
+	l = len(m.Requestor)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = m.Job.Size()
+	n += 1 + l + sovEvent(uint64(l))
+	return n
+}
+
+func (m *EventMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Events != nil {
+		n += m.Events.Size()
+	}
+	return n
+}
+
+func (m *EventMessage_Submitted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Submitted != nil {
+		l = m.Submitted.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+func (m *EventMessage_Queued) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Queued != nil {
+		l = m.Queued.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+func (m *EventMessage_Leased) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Leased != nil {
+		l = m.Leased.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+func (m *EventMessage_LeaseReturned) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LeaseReturned != nil {
+		l = m.LeaseReturned.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+func (m *EventMessage_LeaseExpired) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LeaseExpired != nil {
+		l = m.LeaseExpired.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+func (m *EventMessage_Pending) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pending != nil {
+		l = m.Pending.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+func (m *EventMessage_Running) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Running != nil {
+		l = m.Running.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+func (m *EventMessage_UnableToSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UnableToSchedule != nil {
+		l = m.UnableToSchedule.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+func (m *EventMessage_Failed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Failed != nil {
+		l = m.Failed.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+func (m *EventMessage_Succeeded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Succeeded != nil {
+		l = m.Succeeded.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+func (m *EventMessage_Reprioritized) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Reprioritized != nil {
+		l = m.Reprioritized.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+func (m *EventMessage_Cancelling) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Cancelling != nil {
+		l = m.Cancelling.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+func (m *EventMessage_Cancelled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Cancelled != nil {
+		l = m.Cancelled.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+func (m *EventMessage_Terminated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Terminated != nil {
+		l = m.Terminated.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+func (m *EventMessage_Utilisation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Utilisation != nil {
+		l = m.Utilisation.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+func (m *EventMessage_DuplicateFound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DuplicateFound != nil {
+		l = m.DuplicateFound.Size()
+		n += 2 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+func (m *EventMessage_IngressInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IngressInfo != nil {
+		l = m.IngressInfo.Size()
+		n += 2 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+func (m *EventMessage_Reprioritizing) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Reprioritizing != nil {
+		l = m.Reprioritizing.Size()
+		n += 2 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+func (m *EventMessage_Updated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Updated != nil {
+		l = m.Updated.Size()
+		n += 2 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+func (m *EventMessage_FailedCompressed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FailedCompressed != nil {
+		l = m.FailedCompressed.Size()
+		n += 2 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+func (m *EventMessage_Preempted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Preempted != nil {
+		l = m.Preempted.Size()
+		n += 2 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+func (m *EventMessage_JobSetExpired) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.JobSetExpired != nil {
+		l = m.JobSetExpired.Size()
+		n += 2 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+func (m *EventMessage_Suspended) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Suspended != nil {
+		l = m.Suspended.Size()
+		n += 2 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+func (m *EventMessage_Resumed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Resumed != nil {
+		l = m.Resumed.Size()
+		n += 2 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+func (m *EventMessage_EvictedForCapacity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EvictedForCapacity != nil {
+		l = m.EvictedForCapacity.Size()
+		n += 2 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+func (m *EventMessage_RuntimeExceeded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RuntimeExceeded != nil {
+		l = m.RuntimeExceeded.Size()
+		n += 2 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+func (m *EventMessage_ResourcesNormalized) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ResourcesNormalized != nil {
+		l = m.ResourcesNormalized.Size()
+		n += 2 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+func (m *EventMessage_Unschedulable) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Unschedulable != nil {
+		l = m.Unschedulable.Size()
+		n += 2 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+func (m *EventMessage_MaintenanceWindowStarted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaintenanceWindowStarted != nil {
+		l = m.MaintenanceWindowStarted.Size()
+		n += 2 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+func (m *EventMessage_MaintenanceWindowEnded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaintenanceWindowEnded != nil {
+		l = m.MaintenanceWindowEnded.Size()
+		n += 2 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+func (m *EventMessage_Cordoned) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Cordoned != nil {
+		l = m.Cordoned.Size()
+		n += 2 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+func (m *EventMessage_Uncordoned) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Uncordoned != nil {
+		l = m.Uncordoned.Size()
+		n += 2 + l + sovEvent(uint64(l))
+	}
+	return n
+}
+func (m *ContainerStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.ExitCode != 0 {
+		n += 1 + sovEvent(uint64(m.ExitCode))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Cause != 0 {
+		n += 1 + sovEvent(uint64(m.Cause))
+	}
+	return n
+}
+
+func (m *EventList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Events) > 0 {
+		for _, e := range m.Events {
+			l = e.Size()
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventStreamMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Message != nil {
+		l = m.Message.Size()
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.SchemaVersion != 0 {
+		n += 1 + sovEvent(uint64(m.SchemaVersion))
+	}
+	return n
+}
+
+func (m *JobSetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Watch {
+		n += 2
+	}
+	l = len(m.FromMessageId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.ErrorIfMissing {
+		n += 2
+	}
+	if m.ForceLegacy {
+		n += 2
+	}
+	if m.ForceNew {
+		n += 2
+	}
+	if m.SchemaVersion != 0 {
+		n += 1 + sovEvent(uint64(m.SchemaVersion))
+	}
+	if m.FromTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.FromTime)
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.TerminalOnly {
+		n += 2
+	}
+	if len(m.EventTypes) > 0 {
+		for _, s := range m.EventTypes {
+			l = len(s)
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *WatchRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.JobSetId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.FromId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.ForceLegacy {
+		n += 2
+	}
+	if m.ForceNew {
+		n += 2
+	}
+	if m.SchemaVersion != 0 {
+		n += 1 + sovEvent(uint64(m.SchemaVersion))
+	}
+	return n
+}
+
+func sovEvent(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvent(x uint64) (n int) {
+	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *JobSubmittedEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobSubmittedEvent{`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`Job:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Job), "Job", "Job", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobQueuedEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobQueuedEvent{`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`Attempt:` + fmt.Sprintf("%v", this.Attempt) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobDuplicateFoundEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobDuplicateFoundEvent{`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`OriginalJobId:` + fmt.Sprintf("%v", this.OriginalJobId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobLeasedEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobLeasedEvent{`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`ClusterId:` + fmt.Sprintf("%v", this.ClusterId) + `,`,
+		`Pool:` + fmt.Sprintf("%v", this.Pool) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobLeaseReturnedEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobLeaseReturnedEvent{`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`ClusterId:` + fmt.Sprintf("%v", this.ClusterId) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`KubernetesId:` + fmt.Sprintf("%v", this.KubernetesId) + `,`,
+		`PodNumber:` + fmt.Sprintf("%v", this.PodNumber) + `,`,
+		`RunAttempted:` + fmt.Sprintf("%v", this.RunAttempted) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobLeaseExpiredEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobLeaseExpiredEvent{`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobPendingEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobPendingEvent{`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`ClusterId:` + fmt.Sprintf("%v", this.ClusterId) + `,`,
+		`KubernetesId:` + fmt.Sprintf("%v", this.KubernetesId) + `,`,
+		`PodNumber:` + fmt.Sprintf("%v", this.PodNumber) + `,`,
+		`PodName:` + fmt.Sprintf("%v", this.PodName) + `,`,
+		`PodNamespace:` + fmt.Sprintf("%v", this.PodNamespace) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobRunningEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForNodeLabels := make([]string, 0, len(this.NodeLabels))
+	for k, _ := range this.NodeLabels {
+		keysForNodeLabels = append(keysForNodeLabels, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForNodeLabels)
+	mapStringForNodeLabels := "map[string]string{"
+	for _, k := range keysForNodeLabels {
+		mapStringForNodeLabels += fmt.Sprintf("%v: %v,", k, this.NodeLabels[k])
+	}
+	mapStringForNodeLabels += "}"
+	s := strings.Join([]string{`&JobRunningEvent{`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`ClusterId:` + fmt.Sprintf("%v", this.ClusterId) + `,`,
+		`KubernetesId:` + fmt.Sprintf("%v", this.KubernetesId) + `,`,
+		`NodeName:` + fmt.Sprintf("%v", this.NodeName) + `,`,
+		`PodNumber:` + fmt.Sprintf("%v", this.PodNumber) + `,`,
+		`PodName:` + fmt.Sprintf("%v", this.PodName) + `,`,
+		`PodNamespace:` + fmt.Sprintf("%v", this.PodNamespace) + `,`,
+		`Pool:` + fmt.Sprintf("%v", this.Pool) + `,`,
+		`NodeLabels:` + mapStringForNodeLabels + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobIngressInfoEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForIngressAddresses := make([]int32, 0, len(this.IngressAddresses))
+	for k, _ := range this.IngressAddresses {
+		keysForIngressAddresses = append(keysForIngressAddresses, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Int32s(keysForIngressAddresses)
+	mapStringForIngressAddresses := "map[int32]string{"
+	for _, k := range keysForIngressAddresses {
+		mapStringForIngressAddresses += fmt.Sprintf("%v: %v,", k, this.IngressAddresses[k])
+	}
+	mapStringForIngressAddresses += "}"
+	s := strings.Join([]string{`&JobIngressInfoEvent{`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`ClusterId:` + fmt.Sprintf("%v", this.ClusterId) + `,`,
+		`KubernetesId:` + fmt.Sprintf("%v", this.KubernetesId) + `,`,
+		`NodeName:` + fmt.Sprintf("%v", this.NodeName) + `,`,
+		`PodNumber:` + fmt.Sprintf("%v", this.PodNumber) + `,`,
+		`IngressAddresses:` + mapStringForIngressAddresses + `,`,
+		`PodName:` + fmt.Sprintf("%v", this.PodName) + `,`,
+		`PodNamespace:` + fmt.Sprintf("%v", this.PodNamespace) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobUnableToScheduleEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobUnableToScheduleEvent{`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`ClusterId:` + fmt.Sprintf("%v", this.ClusterId) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`KubernetesId:` + fmt.Sprintf("%v", this.KubernetesId) + `,`,
+		`NodeName:` + fmt.Sprintf("%v", this.NodeName) + `,`,
+		`PodNumber:` + fmt.Sprintf("%v", this.PodNumber) + `,`,
+		`PodName:` + fmt.Sprintf("%v", this.PodName) + `,`,
+		`PodNamespace:` + fmt.Sprintf("%v", this.PodNamespace) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobFailedEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForContainerStatuses := "[]*ContainerStatus{"
+	for _, f := range this.ContainerStatuses {
+		repeatedStringForContainerStatuses += strings.Replace(f.String(), "ContainerStatus", "ContainerStatus", 1) + ","
+	}
+	repeatedStringForContainerStatuses += "}"
+	keysForExitCodes := make([]string, 0, len(this.ExitCodes))
+	for k, _ := range this.ExitCodes {
+		keysForExitCodes = append(keysForExitCodes, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForExitCodes)
+	mapStringForExitCodes := "map[string]int32{"
+	for _, k := range keysForExitCodes {
+		mapStringForExitCodes += fmt.Sprintf("%v: %v,", k, this.ExitCodes[k])
+	}
+	mapStringForExitCodes += "}"
+	s := strings.Join([]string{`&JobFailedEvent{`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`ClusterId:` + fmt.Sprintf("%v", this.ClusterId) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`ExitCodes:` + mapStringForExitCodes + `,`,
+		`KubernetesId:` + fmt.Sprintf("%v", this.KubernetesId) + `,`,
+		`NodeName:` + fmt.Sprintf("%v", this.NodeName) + `,`,
+		`PodNumber:` + fmt.Sprintf("%v", this.PodNumber) + `,`,
+		`ContainerStatuses:` + repeatedStringForContainerStatuses + `,`,
+		`Cause:` + fmt.Sprintf("%v", this.Cause) + `,`,
+		`PodName:` + fmt.Sprintf("%v", this.PodName) + `,`,
+		`PodNamespace:` + fmt.Sprintf("%v", this.PodNamespace) + `,`,
+		`WillRetry:` + fmt.Sprintf("%v", this.WillRetry) + `,`,
+		`Attempt:` + fmt.Sprintf("%v", this.Attempt) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobSetExpiredEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobSetExpiredEvent{`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`JobSetTtlSeconds:` + fmt.Sprintf("%v", this.JobSetTtlSeconds) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobSuspendedEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobSuspendedEvent{`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`Requestor:` + fmt.Sprintf("%v", this.Requestor) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobResumedEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobResumedEvent{`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`Requestor:` + fmt.Sprintf("%v", this.Requestor) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobEvictedForCapacityEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobEvictedForCapacityEvent{`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`ClusterId:` + fmt.Sprintf("%v", this.ClusterId) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobRuntimeExceededEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobRuntimeExceededEvent{`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`ClusterId:` + fmt.Sprintf("%v", this.ClusterId) + `,`,
+		`MaxRuntimeSeconds:` + fmt.Sprintf("%v", this.MaxRuntimeSeconds) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobResourcesNormalizedEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForOriginalResources := make([]string, 0, len(this.OriginalResources))
+	for k, _ := range this.OriginalResources {
+		keysForOriginalResources = append(keysForOriginalResources, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForOriginalResources)
+	mapStringForOriginalResources := "map[string]v1.ResourceRequirements{"
+	for _, k := range keysForOriginalResources {
+		mapStringForOriginalResources += fmt.Sprintf("%v: %v,", k, this.OriginalResources[k])
+	}
+	mapStringForOriginalResources += "}"
+	keysForNormalizedResources := make([]string, 0, len(this.NormalizedResources))
+	for k, _ := range this.NormalizedResources {
+		keysForNormalizedResources = append(keysForNormalizedResources, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForNormalizedResources)
+	mapStringForNormalizedResources := "map[string]v1.ResourceRequirements{"
+	for _, k := range keysForNormalizedResources {
+		mapStringForNormalizedResources += fmt.Sprintf("%v: %v,", k, this.NormalizedResources[k])
+	}
+	mapStringForNormalizedResources += "}"
+	s := strings.Join([]string{`&JobResourcesNormalizedEvent{`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`OriginalResources:` + mapStringForOriginalResources + `,`,
+		`NormalizedResources:` + mapStringForNormalizedResources + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobUnschedulableEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobUnschedulableEvent{`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobMaintenanceWindowStartedEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobMaintenanceWindowStartedEvent{`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`MaintenanceWindowId:` + fmt.Sprintf("%v", this.MaintenanceWindowId) + `,`,
+		`Executor:` + fmt.Sprintf("%v", this.Executor) + `,`,
+		`End:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.End), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`Drain:` + fmt.Sprintf("%v", this.Drain) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobMaintenanceWindowEndedEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobMaintenanceWindowEndedEvent{`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`MaintenanceWindowId:` + fmt.Sprintf("%v", this.MaintenanceWindowId) + `,`,
+		`Executor:` + fmt.Sprintf("%v", this.Executor) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobCordonedEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobCordonedEvent{`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`Executor:` + fmt.Sprintf("%v", this.Executor) + `,`,
+		`CordonedBy:` + fmt.Sprintf("%v", this.CordonedBy) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobUncordonedEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobUncordonedEvent{`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`Executor:` + fmt.Sprintf("%v", this.Executor) + `,`,
+		`UncordonedBy:` + fmt.Sprintf("%v", this.UncordonedBy) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobPreemptedEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobPreemptedEvent{`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`ClusterId:` + fmt.Sprintf("%v", this.ClusterId) + `,`,
+		`RunId:` + fmt.Sprintf("%v", this.RunId) + `,`,
+		`PreemptiveJobId:` + fmt.Sprintf("%v", this.PreemptiveJobId) + `,`,
+		`PreemptiveRunId:` + fmt.Sprintf("%v", this.PreemptiveRunId) + `,`,
+		`Requestor:` + fmt.Sprintf("%v", this.Requestor) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobFailedEventCompressed) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobFailedEventCompressed{`,
+		`Event:` + fmt.Sprintf("%v", this.Event) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobSucceededEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobSucceededEvent{`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`ClusterId:` + fmt.Sprintf("%v", this.ClusterId) + `,`,
+		`KubernetesId:` + fmt.Sprintf("%v", this.KubernetesId) + `,`,
+		`NodeName:` + fmt.Sprintf("%v", this.NodeName) + `,`,
+		`PodNumber:` + fmt.Sprintf("%v", this.PodNumber) + `,`,
+		`PodName:` + fmt.Sprintf("%v", this.PodName) + `,`,
+		`PodNamespace:` + fmt.Sprintf("%v", this.PodNamespace) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobUtilisationEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForMaxResourcesForPeriod := make([]string, 0, len(this.MaxResourcesForPeriod))
+	for k, _ := range this.MaxResourcesForPeriod {
+		keysForMaxResourcesForPeriod = append(keysForMaxResourcesForPeriod, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForMaxResourcesForPeriod)
+	mapStringForMaxResourcesForPeriod := "map[string]resource.Quantity{"
+	for _, k := range keysForMaxResourcesForPeriod {
+		mapStringForMaxResourcesForPeriod += fmt.Sprintf("%v: %v,", k, this.MaxResourcesForPeriod[k])
+	}
+	mapStringForMaxResourcesForPeriod += "}"
+	keysForTotalCumulativeUsage := make([]string, 0, len(this.TotalCumulativeUsage))
+	for k, _ := range this.TotalCumulativeUsage {
+		keysForTotalCumulativeUsage = append(keysForTotalCumulativeUsage, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForTotalCumulativeUsage)
+	mapStringForTotalCumulativeUsage := "map[string]resource.Quantity{"
+	for _, k := range keysForTotalCumulativeUsage {
+		mapStringForTotalCumulativeUsage += fmt.Sprintf("%v: %v,", k, this.TotalCumulativeUsage[k])
+	}
+	mapStringForTotalCumulativeUsage += "}"
+	s := strings.Join([]string{`&JobUtilisationEvent{`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`ClusterId:` + fmt.Sprintf("%v", this.ClusterId) + `,`,
+		`KubernetesId:` + fmt.Sprintf("%v", this.KubernetesId) + `,`,
+		`MaxResourcesForPeriod:` + mapStringForMaxResourcesForPeriod + `,`,
+		`NodeName:` + fmt.Sprintf("%v", this.NodeName) + `,`,
+		`PodNumber:` + fmt.Sprintf("%v", this.PodNumber) + `,`,
+		`PodName:` + fmt.Sprintf("%v", this.PodName) + `,`,
+		`PodNamespace:` + fmt.Sprintf("%v", this.PodNamespace) + `,`,
+		`TotalCumulativeUsage:` + mapStringForTotalCumulativeUsage + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobReprioritizingEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobReprioritizingEvent{`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`NewPriority:` + fmt.Sprintf("%v", this.NewPriority) + `,`,
+		`Requestor:` + fmt.Sprintf("%v", this.Requestor) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobReprioritizedEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobReprioritizedEvent{`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`NewPriority:` + fmt.Sprintf("%v", this.NewPriority) + `,`,
+		`Requestor:` + fmt.Sprintf("%v", this.Requestor) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobCancellingEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobCancellingEvent{`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`Requestor:` + fmt.Sprintf("%v", this.Requestor) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobCancelledEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobCancelledEvent{`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`Requestor:` + fmt.Sprintf("%v", this.Requestor) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobTerminatedEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobTerminatedEvent{`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`ClusterId:` + fmt.Sprintf("%v", this.ClusterId) + `,`,
+		`KubernetesId:` + fmt.Sprintf("%v", this.KubernetesId) + `,`,
+		`PodNumber:` + fmt.Sprintf("%v", this.PodNumber) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`PodName:` + fmt.Sprintf("%v", this.PodName) + `,`,
+		`PodNamespace:` + fmt.Sprintf("%v", this.PodNamespace) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobUpdatedEvent) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobUpdatedEvent{`,
+		`JobId:` + fmt.Sprintf("%v", this.JobId) + `,`,
+		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`Created:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Created), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
+		`Requestor:` + fmt.Sprintf("%v", this.Requestor) + `,`,
+		`Job:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Job), "Job", "Job", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventMessage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventMessage{`,
+		`Events:` + fmt.Sprintf("%v", this.Events) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventMessage_Submitted) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventMessage_Submitted{`,
+		`Submitted:` + strings.Replace(fmt.Sprintf("%v", this.Submitted), "JobSubmittedEvent", "JobSubmittedEvent", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventMessage_Queued) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventMessage_Queued{`,
+		`Queued:` + strings.Replace(fmt.Sprintf("%v", this.Queued), "JobQueuedEvent", "JobQueuedEvent", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventMessage_Leased) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventMessage_Leased{`,
+		`Leased:` + strings.Replace(fmt.Sprintf("%v", this.Leased), "JobLeasedEvent", "JobLeasedEvent", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventMessage_LeaseReturned) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventMessage_LeaseReturned{`,
+		`LeaseReturned:` + strings.Replace(fmt.Sprintf("%v", this.LeaseReturned), "JobLeaseReturnedEvent", "JobLeaseReturnedEvent", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventMessage_LeaseExpired) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventMessage_LeaseExpired{`,
+		`LeaseExpired:` + strings.Replace(fmt.Sprintf("%v", this.LeaseExpired), "JobLeaseExpiredEvent", "JobLeaseExpiredEvent", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventMessage_Pending) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventMessage_Pending{`,
+		`Pending:` + strings.Replace(fmt.Sprintf("%v", this.Pending), "JobPendingEvent", "JobPendingEvent", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventMessage_Running) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventMessage_Running{`,
+		`Running:` + strings.Replace(fmt.Sprintf("%v", this.Running), "JobRunningEvent", "JobRunningEvent", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventMessage_UnableToSchedule) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventMessage_UnableToSchedule{`,
+		`UnableToSchedule:` + strings.Replace(fmt.Sprintf("%v", this.UnableToSchedule), "JobUnableToScheduleEvent", "JobUnableToScheduleEvent", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventMessage_Failed) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventMessage_Failed{`,
+		`Failed:` + strings.Replace(fmt.Sprintf("%v", this.Failed), "JobFailedEvent", "JobFailedEvent", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventMessage_Succeeded) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventMessage_Succeeded{`,
+		`Succeeded:` + strings.Replace(fmt.Sprintf("%v", this.Succeeded), "JobSucceededEvent", "JobSucceededEvent", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventMessage_Reprioritized) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventMessage_Reprioritized{`,
+		`Reprioritized:` + strings.Replace(fmt.Sprintf("%v", this.Reprioritized), "JobReprioritizedEvent", "JobReprioritizedEvent", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventMessage_Cancelling) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventMessage_Cancelling{`,
+		`Cancelling:` + strings.Replace(fmt.Sprintf("%v", this.Cancelling), "JobCancellingEvent", "JobCancellingEvent", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventMessage_Cancelled) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventMessage_Cancelled{`,
+		`Cancelled:` + strings.Replace(fmt.Sprintf("%v", this.Cancelled), "JobCancelledEvent", "JobCancelledEvent", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventMessage_Terminated) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventMessage_Terminated{`,
+		`Terminated:` + strings.Replace(fmt.Sprintf("%v", this.Terminated), "JobTerminatedEvent", "JobTerminatedEvent", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventMessage_Utilisation) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventMessage_Utilisation{`,
+		`Utilisation:` + strings.Replace(fmt.Sprintf("%v", this.Utilisation), "JobUtilisationEvent", "JobUtilisationEvent", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventMessage_DuplicateFound) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventMessage_DuplicateFound{`,
+		`DuplicateFound:` + strings.Replace(fmt.Sprintf("%v", this.DuplicateFound), "JobDuplicateFoundEvent", "JobDuplicateFoundEvent", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventMessage_IngressInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventMessage_IngressInfo{`,
+		`IngressInfo:` + strings.Replace(fmt.Sprintf("%v", this.IngressInfo), "JobIngressInfoEvent", "JobIngressInfoEvent", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventMessage_Reprioritizing) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventMessage_Reprioritizing{`,
+		`Reprioritizing:` + strings.Replace(fmt.Sprintf("%v", this.Reprioritizing), "JobReprioritizingEvent", "JobReprioritizingEvent", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventMessage_Updated) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventMessage_Updated{`,
+		`Updated:` + strings.Replace(fmt.Sprintf("%v", this.Updated), "JobUpdatedEvent", "JobUpdatedEvent", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventMessage_FailedCompressed) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventMessage_FailedCompressed{`,
+		`FailedCompressed:` + strings.Replace(fmt.Sprintf("%v", this.FailedCompressed), "JobFailedEventCompressed", "JobFailedEventCompressed", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventMessage_Preempted) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventMessage_Preempted{`,
+		`Preempted:` + strings.Replace(fmt.Sprintf("%v", this.Preempted), "JobPreemptedEvent", "JobPreemptedEvent", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventMessage_JobSetExpired) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventMessage_JobSetExpired{`,
+		`JobSetExpired:` + strings.Replace(fmt.Sprintf("%v", this.JobSetExpired), "JobSetExpiredEvent", "JobSetExpiredEvent", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventMessage_Suspended) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventMessage_Suspended{`,
+		`Suspended:` + strings.Replace(fmt.Sprintf("%v", this.Suspended), "JobSuspendedEvent", "JobSuspendedEvent", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventMessage_Resumed) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventMessage_Resumed{`,
+		`Resumed:` + strings.Replace(fmt.Sprintf("%v", this.Resumed), "JobResumedEvent", "JobResumedEvent", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventMessage_EvictedForCapacity) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventMessage_EvictedForCapacity{`,
+		`EvictedForCapacity:` + strings.Replace(fmt.Sprintf("%v", this.EvictedForCapacity), "JobEvictedForCapacityEvent", "JobEvictedForCapacityEvent", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventMessage_RuntimeExceeded) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventMessage_RuntimeExceeded{`,
+		`RuntimeExceeded:` + strings.Replace(fmt.Sprintf("%v", this.RuntimeExceeded), "JobRuntimeExceededEvent", "JobRuntimeExceededEvent", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventMessage_ResourcesNormalized) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventMessage_ResourcesNormalized{`,
+		`ResourcesNormalized:` + strings.Replace(fmt.Sprintf("%v", this.ResourcesNormalized), "JobResourcesNormalizedEvent", "JobResourcesNormalizedEvent", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventMessage_Unschedulable) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventMessage_Unschedulable{`,
+		`Unschedulable:` + strings.Replace(fmt.Sprintf("%v", this.Unschedulable), "JobUnschedulableEvent", "JobUnschedulableEvent", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventMessage_MaintenanceWindowStarted) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventMessage_MaintenanceWindowStarted{`,
+		`MaintenanceWindowStarted:` + strings.Replace(fmt.Sprintf("%v", this.MaintenanceWindowStarted), "JobMaintenanceWindowStartedEvent", "JobMaintenanceWindowStartedEvent", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventMessage_MaintenanceWindowEnded) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventMessage_MaintenanceWindowEnded{`,
+		`MaintenanceWindowEnded:` + strings.Replace(fmt.Sprintf("%v", this.MaintenanceWindowEnded), "JobMaintenanceWindowEndedEvent", "JobMaintenanceWindowEndedEvent", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventMessage_Cordoned) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventMessage_Cordoned{`,
+		`Cordoned:` + strings.Replace(fmt.Sprintf("%v", this.Cordoned), "JobCordonedEvent", "JobCordonedEvent", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventMessage_Uncordoned) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventMessage_Uncordoned{`,
+		`Uncordoned:` + strings.Replace(fmt.Sprintf("%v", this.Uncordoned), "JobUncordonedEvent", "JobUncordonedEvent", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ContainerStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ContainerStatus{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`ExitCode:` + fmt.Sprintf("%v", this.ExitCode) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`Cause:` + fmt.Sprintf("%v", this.Cause) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForEvents := "[]*EventMessage{"
+	for _, f := range this.Events {
+		repeatedStringForEvents += strings.Replace(f.String(), "EventMessage", "EventMessage", 1) + ","
+	}
+	repeatedStringForEvents += "}"
+	s := strings.Join([]string{`&EventList{`,
+		`Events:` + repeatedStringForEvents + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventStreamMessage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventStreamMessage{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`Message:` + strings.Replace(this.Message.String(), "EventMessage", "EventMessage", 1) + `,`,
+		`SchemaVersion:` + fmt.Sprintf("%v", this.SchemaVersion) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobSetRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobSetRequest{`,
+		`Id:` + fmt.Sprintf("%v", this.Id) + `,`,
+		`Watch:` + fmt.Sprintf("%v", this.Watch) + `,`,
+		`FromMessageId:` + fmt.Sprintf("%v", this.FromMessageId) + `,`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`ErrorIfMissing:` + fmt.Sprintf("%v", this.ErrorIfMissing) + `,`,
+		`ForceLegacy:` + fmt.Sprintf("%v", this.ForceLegacy) + `,`,
+		`ForceNew:` + fmt.Sprintf("%v", this.ForceNew) + `,`,
+		`SchemaVersion:` + fmt.Sprintf("%v", this.SchemaVersion) + `,`,
+		`FromTime:` + strings.Replace(fmt.Sprintf("%v", this.FromTime), "Timestamp", "types.Timestamp", 1) + `,`,
+		`TerminalOnly:` + fmt.Sprintf("%v", this.TerminalOnly) + `,`,
+		`EventTypes:` + fmt.Sprintf("%v", this.EventTypes) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WatchRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WatchRequest{`,
+		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
+		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
+		`FromId:` + fmt.Sprintf("%v", this.FromId) + `,`,
+		`ForceLegacy:` + fmt.Sprintf("%v", this.ForceLegacy) + `,`,
+		`ForceNew:` + fmt.Sprintf("%v", this.ForceNew) + `,`,
+		`SchemaVersion:` + fmt.Sprintf("%v", this.SchemaVersion) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringEvent(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *JobSubmittedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobSubmittedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobSubmittedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobQueuedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobQueuedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobQueuedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+			}
+			m.Attempt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempt |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobDuplicateFoundEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobDuplicateFoundEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobDuplicateFoundEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalJobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginalJobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobLeasedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobLeasedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobLeasedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pool = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobLeaseReturnedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobLeaseReturnedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobLeaseReturnedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KubernetesId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KubernetesId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodNumber", wireType)
+			}
+			m.PodNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PodNumber |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunAttempted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RunAttempted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobLeaseExpiredEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobLeaseExpiredEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobLeaseExpiredEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobPendingEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobPendingEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobPendingEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KubernetesId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KubernetesId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodNumber", wireType)
+			}
+			m.PodNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PodNumber |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PodName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodNamespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PodNamespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobRunningEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobRunningEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobRunningEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KubernetesId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KubernetesId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodNumber", wireType)
+			}
+			m.PodNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PodNumber |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PodName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodNamespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PodNamespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pool = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeLabels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NodeLabels == nil {
+				m.NodeLabels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvent
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvent
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthEvent
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthEvent
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvent
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthEvent
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthEvent
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipEvent(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthEvent
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.NodeLabels[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobIngressInfoEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobIngressInfoEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobIngressInfoEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KubernetesId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KubernetesId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodNumber", wireType)
+			}
+			m.PodNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PodNumber |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IngressAddresses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.IngressAddresses == nil {
+				m.IngressAddresses = make(map[int32]string)
+			}
+			var mapkey int32
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvent
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvent
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapkey |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvent
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthEvent
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthEvent
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipEvent(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthEvent
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.IngressAddresses[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PodName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodNamespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PodNamespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	s := strings.Join([]string{`&WatchRequest{`,
-		`Queue:` + fmt.Sprintf("%v", this.Queue) + `,`,
-		`JobSetId:` + fmt.Sprintf("%v", this.JobSetId) + `,`,
-		`FromId:` + fmt.Sprintf("%v", this.FromId) + `,`,
-		`ForceLegacy:` + fmt.Sprintf("%v", this.ForceLegacy) + `,`,
-		`ForceNew:` + fmt.Sprintf("%v", this.ForceNew) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func valueToStringEvent(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	return nil
 }
-func (m *JobSubmittedEvent) Unmarshal(dAtA []byte) error {
+func (m *JobUnableToScheduleEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7541,10 +13459,10 @@ func (m *JobSubmittedEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobSubmittedEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobUnableToScheduleEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobSubmittedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobUnableToScheduleEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7678,9 +13596,9 @@ func (m *JobSubmittedEvent) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -7690,78 +13608,59 @@ func (m *JobSubmittedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ClusterId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvent(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvent
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *JobQueuedEvent) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvent
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: JobQueuedEvent: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobQueuedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KubernetesId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7789,11 +13688,11 @@ func (m *JobQueuedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobId = string(dAtA[iNdEx:postIndex])
+			m.KubernetesId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7821,11 +13720,30 @@ func (m *JobQueuedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			m.NodeName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodNumber", wireType)
+			}
+			m.PodNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PodNumber |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PodName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7853,13 +13771,13 @@ func (m *JobQueuedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Queue = string(dAtA[iNdEx:postIndex])
+			m.PodName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PodNamespace", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -7869,24 +13787,23 @@ func (m *JobQueuedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PodNamespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7909,7 +13826,7 @@ func (m *JobQueuedEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobDuplicateFoundEvent) Unmarshal(dAtA []byte) error {
+func (m *JobFailedEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7932,10 +13849,10 @@ func (m *JobDuplicateFoundEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobDuplicateFoundEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobFailedEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobDuplicateFoundEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobFailedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8069,7 +13986,7 @@ func (m *JobDuplicateFoundEvent) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginalJobId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8097,63 +14014,45 @@ func (m *JobDuplicateFoundEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OriginalJobId = string(dAtA[iNdEx:postIndex])
+			m.ClusterId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvent(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *JobLeasedEvent) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvent
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: JobLeasedEvent: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobLeasedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitCodes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -8163,27 +14062,108 @@ func (m *JobLeasedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobId = string(dAtA[iNdEx:postIndex])
+			if m.ExitCodes == nil {
+				m.ExitCodes = make(map[string]int32)
+			}
+			var mapkey string
+			var mapvalue int32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvent
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvent
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthEvent
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthEvent
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvent
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= int32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipEvent(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthEvent
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ExitCodes[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KubernetesId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8211,11 +14191,11 @@ func (m *JobLeasedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			m.KubernetesId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8243,11 +14223,30 @@ func (m *JobLeasedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Queue = string(dAtA[iNdEx:postIndex])
+			m.NodeName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodNumber", wireType)
+			}
+			m.PodNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PodNumber |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContainerStatuses", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8274,15 +14273,16 @@ func (m *JobLeasedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
+			m.ContainerStatuses = append(m.ContainerStatuses, &ContainerStatus{})
+			if err := m.ContainerStatuses[len(m.ContainerStatuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cause", wireType)
 			}
-			var stringLen uint64
+			m.Cause = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -8292,77 +14292,14 @@ func (m *JobLeasedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Cause |= Cause(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ClusterId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvent(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *JobLeaseReturnedEvent) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvent
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: JobLeaseReturnedEvent: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobLeaseReturnedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PodName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8390,11 +14327,11 @@ func (m *JobLeaseReturnedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobId = string(dAtA[iNdEx:postIndex])
+			m.PodName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PodNamespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8422,13 +14359,13 @@ func (m *JobLeaseReturnedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			m.PodNamespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WillRetry", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -8438,29 +14375,17 @@ func (m *JobLeaseReturnedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Queue = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
-			}
-			var msglen int
+			m.WillRetry = bool(v != 0)
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+			}
+			m.Attempt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -8470,28 +14395,64 @@ func (m *JobLeaseReturnedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Attempt |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvent
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEvent
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobSetExpiredEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
 			}
-			iNdEx = postIndex
-		case 5:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobSetExpiredEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobSetExpiredEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8519,11 +14480,11 @@ func (m *JobLeaseReturnedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClusterId = string(dAtA[iNdEx:postIndex])
+			m.JobId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8551,11 +14512,11 @@ func (m *JobLeaseReturnedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KubernetesId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8583,13 +14544,13 @@ func (m *JobLeaseReturnedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KubernetesId = string(dAtA[iNdEx:postIndex])
+			m.Queue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodNumber", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
 			}
-			m.PodNumber = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -8599,16 +14560,30 @@ func (m *JobLeaseReturnedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PodNumber |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RunAttempted", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetTtlSeconds", wireType)
 			}
-			var v int
+			m.JobSetTtlSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -8618,12 +14593,11 @@ func (m *JobLeaseReturnedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.JobSetTtlSeconds |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.RunAttempted = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -8645,7 +14619,7 @@ func (m *JobLeaseReturnedEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobLeaseExpiredEvent) Unmarshal(dAtA []byte) error {
+func (m *JobSuspendedEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8668,10 +14642,10 @@ func (m *JobLeaseExpiredEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobLeaseExpiredEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobSuspendedEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobLeaseExpiredEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobSuspendedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8803,6 +14777,38 @@ func (m *JobLeaseExpiredEvent) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requestor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requestor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -8824,7 +14830,7 @@ func (m *JobLeaseExpiredEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobPendingEvent) Unmarshal(dAtA []byte) error {
+func (m *JobResumedEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8847,10 +14853,10 @@ func (m *JobPendingEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobPendingEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobResumedEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobPendingEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobResumedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8984,7 +14990,7 @@ func (m *JobPendingEvent) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Requestor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9012,11 +15018,61 @@ func (m *JobPendingEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClusterId = string(dAtA[iNdEx:postIndex])
+			m.Requestor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobEvictedForCapacityEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobEvictedForCapacityEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobEvictedForCapacityEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KubernetesId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9044,13 +15100,13 @@ func (m *JobPendingEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KubernetesId = string(dAtA[iNdEx:postIndex])
+			m.JobId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodNumber", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
 			}
-			m.PodNumber = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -9060,14 +15116,27 @@ func (m *JobPendingEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PodNumber |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9079,27 +15148,60 @@ func (m *JobPendingEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PodName = string(dAtA[iNdEx:postIndex])
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 9:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodNamespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9127,7 +15229,7 @@ func (m *JobPendingEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PodNamespace = string(dAtA[iNdEx:postIndex])
+			m.ClusterId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9150,7 +15252,7 @@ func (m *JobPendingEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobRunningEvent) Unmarshal(dAtA []byte) error {
+func (m *JobRuntimeExceededEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9173,10 +15275,10 @@ func (m *JobRunningEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobRunningEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobRuntimeExceededEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobRunningEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobRuntimeExceededEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9341,8 +15443,77 @@ func (m *JobRunningEvent) Unmarshal(dAtA []byte) error {
 			m.ClusterId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRuntimeSeconds", wireType)
+			}
+			m.MaxRuntimeSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRuntimeSeconds |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobResourcesNormalizedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobResourcesNormalizedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobResourcesNormalizedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KubernetesId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9370,11 +15541,11 @@ func (m *JobRunningEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KubernetesId = string(dAtA[iNdEx:postIndex])
+			m.JobId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9402,13 +15573,13 @@ func (m *JobRunningEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NodeName = string(dAtA[iNdEx:postIndex])
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodNumber", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
 			}
-			m.PodNumber = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -9418,16 +15589,29 @@ func (m *JobRunningEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PodNumber |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -9437,29 +15621,159 @@ func (m *JobRunningEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalResources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PodName = string(dAtA[iNdEx:postIndex])
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OriginalResources == nil {
+				m.OriginalResources = make(map[string]v1.ResourceRequirements)
+			}
+			var mapkey string
+			mapvalue := &v1.ResourceRequirements{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvent
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvent
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthEvent
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthEvent
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvent
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthEvent
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthEvent
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &v1.ResourceRequirements{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipEvent(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthEvent
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.OriginalResources[mapkey] = *mapvalue
 			iNdEx = postIndex
-		case 10:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodNamespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NormalizedResources", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -9469,23 +15783,120 @@ func (m *JobRunningEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PodNamespace = string(dAtA[iNdEx:postIndex])
+			if m.NormalizedResources == nil {
+				m.NormalizedResources = make(map[string]v1.ResourceRequirements)
+			}
+			var mapkey string
+			mapvalue := &v1.ResourceRequirements{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvent
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvent
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthEvent
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthEvent
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvent
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthEvent
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthEvent
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &v1.ResourceRequirements{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipEvent(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthEvent
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.NormalizedResources[mapkey] = *mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9508,7 +15919,7 @@ func (m *JobRunningEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobIngressInfoEvent) Unmarshal(dAtA []byte) error {
+func (m *JobUnschedulableEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9531,144 +15942,15 @@ func (m *JobIngressInfoEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobIngressInfoEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobUnschedulableEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobIngressInfoEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobUnschedulableEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.JobId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.JobSetId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Queue = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9696,11 +15978,11 @@ func (m *JobIngressInfoEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClusterId = string(dAtA[iNdEx:postIndex])
+			m.JobId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KubernetesId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9728,11 +16010,11 @@ func (m *JobIngressInfoEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KubernetesId = string(dAtA[iNdEx:postIndex])
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9760,30 +16042,11 @@ func (m *JobIngressInfoEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NodeName = string(dAtA[iNdEx:postIndex])
+			m.Queue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodNumber", wireType)
-			}
-			m.PodNumber = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PodNumber |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 9:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IngressAddresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9810,125 +16073,13 @@ func (m *JobIngressInfoEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.IngressAddresses == nil {
-				m.IngressAddresses = make(map[int32]string)
-			}
-			var mapkey int32
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowEvent
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowEvent
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapkey |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowEvent
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthEvent
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthEvent
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipEvent(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthEvent
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.IngressAddresses[mapkey] = mapvalue
-			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.PodName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodNamespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9956,7 +16107,7 @@ func (m *JobIngressInfoEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PodNamespace = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9979,7 +16130,7 @@ func (m *JobIngressInfoEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobUnableToScheduleEvent) Unmarshal(dAtA []byte) error {
+func (m *JobMaintenanceWindowStartedEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10002,17 +16153,113 @@ func (m *JobUnableToScheduleEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobUnableToScheduleEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobMaintenanceWindowStartedEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobUnableToScheduleEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobMaintenanceWindowStartedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -10022,27 +16269,28 @@ func (m *JobUnableToScheduleEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobId = string(dAtA[iNdEx:postIndex])
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceWindowId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10070,11 +16318,11 @@ func (m *JobUnableToScheduleEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			m.MaintenanceWindowId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Executor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10102,11 +16350,11 @@ func (m *JobUnableToScheduleEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Queue = string(dAtA[iNdEx:postIndex])
+			m.Executor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10133,13 +16381,33 @@ func (m *JobUnableToScheduleEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.End, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Drain", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Drain = bool(v != 0)
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10167,11 +16435,61 @@ func (m *JobUnableToScheduleEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClusterId = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobMaintenanceWindowEndedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobMaintenanceWindowEndedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobMaintenanceWindowEndedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10199,11 +16517,11 @@ func (m *JobUnableToScheduleEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			m.JobId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KubernetesId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10231,11 +16549,11 @@ func (m *JobUnableToScheduleEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KubernetesId = string(dAtA[iNdEx:postIndex])
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10263,13 +16581,13 @@ func (m *JobUnableToScheduleEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NodeName = string(dAtA[iNdEx:postIndex])
+			m.Queue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodNumber", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
 			}
-			m.PodNumber = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -10279,14 +16597,28 @@ func (m *JobUnableToScheduleEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PodNumber |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceWindowId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10314,11 +16646,11 @@ func (m *JobUnableToScheduleEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PodName = string(dAtA[iNdEx:postIndex])
+			m.MaintenanceWindowId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodNamespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Executor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10346,7 +16678,7 @@ func (m *JobUnableToScheduleEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PodNamespace = string(dAtA[iNdEx:postIndex])
+			m.Executor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10369,7 +16701,7 @@ func (m *JobUnableToScheduleEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobFailedEvent) Unmarshal(dAtA []byte) error {
+func (m *JobCordonedEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10392,10 +16724,10 @@ func (m *JobFailedEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobFailedEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobCordonedEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobFailedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobCordonedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10529,7 +16861,7 @@ func (m *JobFailedEvent) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Executor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10557,11 +16889,125 @@ func (m *JobFailedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClusterId = string(dAtA[iNdEx:postIndex])
+			m.Executor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CordonedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CordonedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobUncordonedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobUncordonedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobUncordonedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10589,124 +17035,11 @@ func (m *JobFailedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExitCodes", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ExitCodes == nil {
-				m.ExitCodes = make(map[string]int32)
-			}
-			var mapkey string
-			var mapvalue int32
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowEvent
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowEvent
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthEvent
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthEvent
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowEvent
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapvalue |= int32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipEvent(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthEvent
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.ExitCodes[mapkey] = mapvalue
+			m.JobId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KubernetesId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10734,11 +17067,11 @@ func (m *JobFailedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KubernetesId = string(dAtA[iNdEx:postIndex])
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10766,30 +17099,11 @@ func (m *JobFailedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NodeName = string(dAtA[iNdEx:postIndex])
+			m.Queue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodNumber", wireType)
-			}
-			m.PodNumber = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PodNumber |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 11:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContainerStatuses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10816,33 +17130,13 @@ func (m *JobFailedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContainerStatuses = append(m.ContainerStatuses, &ContainerStatus{})
-			if err := m.ContainerStatuses[len(m.ContainerStatuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cause", wireType)
-			}
-			m.Cause = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Cause |= Cause(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 13:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Executor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10870,11 +17164,11 @@ func (m *JobFailedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PodName = string(dAtA[iNdEx:postIndex])
+			m.Executor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 14:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodNamespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UncordonedBy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10902,7 +17196,7 @@ func (m *JobFailedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PodNamespace = string(dAtA[iNdEx:postIndex])
+			m.UncordonedBy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11147,9 +17441,73 @@ func (m *JobPreemptedEvent) Unmarshal(dAtA []byte) error {
 			}
 			m.RunId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreemptiveJobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreemptiveJobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreemptiveRunId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreemptiveRunId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreemptiveJobId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Requestor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11177,11 +17535,11 @@ func (m *JobPreemptedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PreemptiveJobId = string(dAtA[iNdEx:postIndex])
+			m.Requestor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreemptiveRunId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11209,7 +17567,7 @@ func (m *JobPreemptedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PreemptiveRunId = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12760,7 +19118,250 @@ func (m *JobCancellingEvent) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: JobCancellingEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobCancellingEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobCancellingEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requestor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requestor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobCancelledEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobCancelledEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobCancelledEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -12977,7 +19578,7 @@ func (m *JobCancellingEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobCancelledEvent) Unmarshal(dAtA []byte) error {
+func (m *JobTerminatedEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13000,10 +19601,10 @@ func (m *JobCancelledEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobCancelledEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobTerminatedEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobCancelledEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobTerminatedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -13137,7 +19738,7 @@ func (m *JobCancelledEvent) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Requestor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13165,9 +19766,60 @@ func (m *JobCancelledEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Requestor = string(dAtA[iNdEx:postIndex])
+			m.ClusterId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KubernetesId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KubernetesId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodNumber", wireType)
+			}
+			m.PodNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PodNumber |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
@@ -13199,6 +19851,70 @@ func (m *JobCancelledEvent) Unmarshal(dAtA []byte) error {
 			}
 			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PodName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodNamespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PodNamespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -13220,7 +19936,7 @@ func (m *JobCancelledEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobTerminatedEvent) Unmarshal(dAtA []byte) error {
+func (m *JobUpdatedEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13243,10 +19959,10 @@ func (m *JobTerminatedEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobTerminatedEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobUpdatedEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobTerminatedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobUpdatedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -13380,7 +20096,7 @@ func (m *JobTerminatedEvent) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Requestor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13408,13 +20124,13 @@ func (m *JobTerminatedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClusterId = string(dAtA[iNdEx:postIndex])
+			m.Requestor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KubernetesId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -13424,29 +20140,80 @@ func (m *JobTerminatedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KubernetesId = string(dAtA[iNdEx:postIndex])
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodNumber", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.PodNumber = 0
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMessage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMessage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitted", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -13456,16 +20223,67 @@ func (m *JobTerminatedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PodNumber |= int32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &JobSubmittedEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Events = &EventMessage_Submitted{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queued", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &JobQueuedEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Events = &EventMessage_Queued{v}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Leased", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -13475,29 +20293,32 @@ func (m *JobTerminatedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			v := &JobLeasedEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Events = &EventMessage_Leased{v}
 			iNdEx = postIndex
-		case 9:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaseReturned", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -13507,29 +20328,32 @@ func (m *JobTerminatedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PodName = string(dAtA[iNdEx:postIndex])
+			v := &JobLeaseReturnedEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Events = &EventMessage_LeaseReturned{v}
 			iNdEx = postIndex
-		case 10:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodNamespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaseExpired", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -13539,79 +20363,32 @@ func (m *JobTerminatedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PodNamespace = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvent(dAtA[iNdEx:])
-			if err != nil {
+			v := &JobLeaseExpiredEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *JobUpdatedEvent) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvent
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: JobUpdatedEvent: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobUpdatedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Events = &EventMessage_LeaseExpired{v}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -13621,29 +20398,32 @@ func (m *JobUpdatedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobId = string(dAtA[iNdEx:postIndex])
+			v := &JobPendingEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Events = &EventMessage_Pending{v}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobSetId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Running", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -13653,29 +20433,32 @@ func (m *JobUpdatedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobSetId = string(dAtA[iNdEx:postIndex])
+			v := &JobRunningEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Events = &EventMessage_Running{v}
 			iNdEx = postIndex
-		case 3:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnableToSchedule", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -13685,27 +20468,30 @@ func (m *JobUpdatedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Queue = string(dAtA[iNdEx:postIndex])
+			v := &JobUnableToScheduleEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Events = &EventMessage_UnableToSchedule{v}
 			iNdEx = postIndex
-		case 4:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13732,15 +20518,17 @@ func (m *JobUpdatedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Created, dAtA[iNdEx:postIndex]); err != nil {
+			v := &JobFailedEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Events = &EventMessage_Failed{v}
 			iNdEx = postIndex
-		case 5:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Requestor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Succeeded", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvent
@@ -13750,27 +20538,30 @@ func (m *JobUpdatedEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Requestor = string(dAtA[iNdEx:postIndex])
+			v := &JobSucceededEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Events = &EventMessage_Succeeded{v}
 			iNdEx = postIndex
-		case 6:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reprioritized", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13797,63 +20588,15 @@ func (m *JobUpdatedEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvent(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvent
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventMessage) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvent
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventMessage: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMessage: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			v := &JobReprioritizedEvent{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Events = &EventMessage_Reprioritized{v}
+			iNdEx = postIndex
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Submitted", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cancelling", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13880,15 +20623,15 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JobSubmittedEvent{}
+			v := &JobCancellingEvent{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Events = &EventMessage_Submitted{v}
+			m.Events = &EventMessage_Cancelling{v}
 			iNdEx = postIndex
-		case 2:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Queued", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cancelled", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13915,15 +20658,15 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JobQueuedEvent{}
+			v := &JobCancelledEvent{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Events = &EventMessage_Queued{v}
+			m.Events = &EventMessage_Cancelled{v}
 			iNdEx = postIndex
-		case 3:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Leased", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Terminated", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13950,15 +20693,15 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JobLeasedEvent{}
+			v := &JobTerminatedEvent{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Events = &EventMessage_Leased{v}
+			m.Events = &EventMessage_Terminated{v}
 			iNdEx = postIndex
-		case 4:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LeaseReturned", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Utilisation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13985,15 +20728,15 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JobLeaseReturnedEvent{}
+			v := &JobUtilisationEvent{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Events = &EventMessage_LeaseReturned{v}
+			m.Events = &EventMessage_Utilisation{v}
 			iNdEx = postIndex
-		case 5:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LeaseExpired", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DuplicateFound", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14020,15 +20763,15 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JobLeaseExpiredEvent{}
+			v := &JobDuplicateFoundEvent{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Events = &EventMessage_LeaseExpired{v}
+			m.Events = &EventMessage_DuplicateFound{v}
 			iNdEx = postIndex
-		case 6:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IngressInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14055,15 +20798,15 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JobPendingEvent{}
+			v := &JobIngressInfoEvent{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Events = &EventMessage_Pending{v}
+			m.Events = &EventMessage_IngressInfo{v}
 			iNdEx = postIndex
-		case 7:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Running", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reprioritizing", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14090,15 +20833,15 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JobRunningEvent{}
+			v := &JobReprioritizingEvent{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Events = &EventMessage_Running{v}
+			m.Events = &EventMessage_Reprioritizing{v}
 			iNdEx = postIndex
-		case 8:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnableToSchedule", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Updated", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14125,15 +20868,15 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JobUnableToScheduleEvent{}
+			v := &JobUpdatedEvent{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Events = &EventMessage_UnableToSchedule{v}
+			m.Events = &EventMessage_Updated{v}
 			iNdEx = postIndex
-		case 9:
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedCompressed", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14160,15 +20903,15 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JobFailedEvent{}
+			v := &JobFailedEventCompressed{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Events = &EventMessage_Failed{v}
+			m.Events = &EventMessage_FailedCompressed{v}
 			iNdEx = postIndex
-		case 10:
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Succeeded", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Preempted", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14195,15 +20938,15 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JobSucceededEvent{}
+			v := &JobPreemptedEvent{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Events = &EventMessage_Succeeded{v}
+			m.Events = &EventMessage_Preempted{v}
 			iNdEx = postIndex
-		case 11:
+		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reprioritized", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetExpired", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14230,15 +20973,15 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JobReprioritizedEvent{}
+			v := &JobSetExpiredEvent{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Events = &EventMessage_Reprioritized{v}
+			m.Events = &EventMessage_JobSetExpired{v}
 			iNdEx = postIndex
-		case 12:
+		case 23:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cancelling", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Suspended", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14265,15 +21008,15 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JobCancellingEvent{}
+			v := &JobSuspendedEvent{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Events = &EventMessage_Cancelling{v}
+			m.Events = &EventMessage_Suspended{v}
 			iNdEx = postIndex
-		case 13:
+		case 24:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cancelled", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resumed", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14300,15 +21043,15 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JobCancelledEvent{}
+			v := &JobResumedEvent{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Events = &EventMessage_Cancelled{v}
+			m.Events = &EventMessage_Resumed{v}
 			iNdEx = postIndex
-		case 14:
+		case 25:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Terminated", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EvictedForCapacity", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14335,15 +21078,15 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JobTerminatedEvent{}
+			v := &JobEvictedForCapacityEvent{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Events = &EventMessage_Terminated{v}
+			m.Events = &EventMessage_EvictedForCapacity{v}
 			iNdEx = postIndex
-		case 15:
+		case 26:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Utilisation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RuntimeExceeded", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14370,15 +21113,15 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JobUtilisationEvent{}
+			v := &JobRuntimeExceededEvent{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Events = &EventMessage_Utilisation{v}
+			m.Events = &EventMessage_RuntimeExceeded{v}
 			iNdEx = postIndex
-		case 16:
+		case 27:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DuplicateFound", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourcesNormalized", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14405,15 +21148,15 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JobDuplicateFoundEvent{}
+			v := &JobResourcesNormalizedEvent{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Events = &EventMessage_DuplicateFound{v}
+			m.Events = &EventMessage_ResourcesNormalized{v}
 			iNdEx = postIndex
-		case 17:
+		case 28:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IngressInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Unschedulable", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14440,15 +21183,15 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JobIngressInfoEvent{}
+			v := &JobUnschedulableEvent{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Events = &EventMessage_IngressInfo{v}
+			m.Events = &EventMessage_Unschedulable{v}
 			iNdEx = postIndex
-		case 18:
+		case 29:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reprioritizing", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceWindowStarted", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14475,15 +21218,15 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JobReprioritizingEvent{}
+			v := &JobMaintenanceWindowStartedEvent{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Events = &EventMessage_Reprioritizing{v}
+			m.Events = &EventMessage_MaintenanceWindowStarted{v}
 			iNdEx = postIndex
-		case 19:
+		case 30:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Updated", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceWindowEnded", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14510,15 +21253,15 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JobUpdatedEvent{}
+			v := &JobMaintenanceWindowEndedEvent{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Events = &EventMessage_Updated{v}
+			m.Events = &EventMessage_MaintenanceWindowEnded{v}
 			iNdEx = postIndex
-		case 20:
+		case 31:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailedCompressed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cordoned", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14545,15 +21288,15 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JobFailedEventCompressed{}
+			v := &JobCordonedEvent{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Events = &EventMessage_FailedCompressed{v}
+			m.Events = &EventMessage_Cordoned{v}
 			iNdEx = postIndex
-		case 21:
+		case 32:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Preempted", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Uncordoned", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14580,11 +21323,11 @@ func (m *EventMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JobPreemptedEvent{}
+			v := &JobUncordonedEvent{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Events = &EventMessage_Preempted{v}
+			m.Events = &EventMessage_Uncordoned{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -14972,6 +21715,25 @@ func (m *EventStreamMessage) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SchemaVersion", wireType)
+			}
+			m.SchemaVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SchemaVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -15198,6 +21960,113 @@ func (m *JobSetRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.ForceNew = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SchemaVersion", wireType)
+			}
+			m.SchemaVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SchemaVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FromTime == nil {
+				m.FromTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.FromTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TerminalOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TerminalOnly = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EventTypes = append(m.EventTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
@@ -15384,6 +22253,25 @@ func (m *WatchRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.ForceNew = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SchemaVersion", wireType)
+			}
+			m.SchemaVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SchemaVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvent(dAtA[iNdEx:])
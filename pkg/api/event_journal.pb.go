@@ -0,0 +1,1060 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: pkg/api/event_journal.proto
+
+package api
+
+import (
+	context "context"
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	types "github.com/gogo/protobuf/types"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EventJournalInfo describes the events currently retained by the event journal.
+// Offsets are of the form <milliseconds>-<sequence number>, and are increasing in the order events were reported.
+type EventJournalInfo struct {
+	// Offset of the oldest retained event. Empty if the journal is empty.
+	FirstOffset string `protobuf:"bytes,1,opt,name=first_offset,json=firstOffset,proto3" json:"firstOffset,omitempty"`
+	// Offset of the most recently reported event. Empty if the journal is empty.
+	LastOffset string `protobuf:"bytes,2,opt,name=last_offset,json=lastOffset,proto3" json:"lastOffset,omitempty"`
+	NumEvents  int64  `protobuf:"varint,3,opt,name=num_events,json=numEvents,proto3" json:"numEvents,omitempty"`
+}
+
+func (m *EventJournalInfo) Reset()      { *m = EventJournalInfo{} }
+func (*EventJournalInfo) ProtoMessage() {}
+func (*EventJournalInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f334984294d65460, []int{0}
+}
+func (m *EventJournalInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventJournalInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventJournalInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventJournalInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventJournalInfo.Merge(m, src)
+}
+func (m *EventJournalInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventJournalInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventJournalInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventJournalInfo proto.InternalMessageInfo
+
+func (m *EventJournalInfo) GetFirstOffset() string {
+	if m != nil {
+		return m.FirstOffset
+	}
+	return ""
+}
+
+func (m *EventJournalInfo) GetLastOffset() string {
+	if m != nil {
+		return m.LastOffset
+	}
+	return ""
+}
+
+func (m *EventJournalInfo) GetNumEvents() int64 {
+	if m != nil {
+		return m.NumEvents
+	}
+	return 0
+}
+
+type EventReplayRequest struct {
+	// Offset of the first event to replay. If empty, events are replayed from the oldest retained event.
+	// A bare <milliseconds> offset selects the first event reported at or after that time.
+	FromOffset string `protobuf:"bytes,1,opt,name=from_offset,json=fromOffset,proto3" json:"fromOffset,omitempty"`
+	// Offset of the last event to replay. If empty, events are replayed up to the most recently reported event.
+	ToOffset string `protobuf:"bytes,2,opt,name=to_offset,json=toOffset,proto3" json:"toOffset,omitempty"`
+	// Maximum number of events to replay. If zero, all selected events are replayed.
+	MaxEvents uint32 `protobuf:"varint,3,opt,name=max_events,json=maxEvents,proto3" json:"maxEvents,omitempty"`
+}
+
+func (m *EventReplayRequest) Reset()      { *m = EventReplayRequest{} }
+func (*EventReplayRequest) ProtoMessage() {}
+func (*EventReplayRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f334984294d65460, []int{1}
+}
+func (m *EventReplayRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventReplayRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventReplayRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventReplayRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventReplayRequest.Merge(m, src)
+}
+func (m *EventReplayRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventReplayRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventReplayRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventReplayRequest proto.InternalMessageInfo
+
+func (m *EventReplayRequest) GetFromOffset() string {
+	if m != nil {
+		return m.FromOffset
+	}
+	return ""
+}
+
+func (m *EventReplayRequest) GetToOffset() string {
+	if m != nil {
+		return m.ToOffset
+	}
+	return ""
+}
+
+func (m *EventReplayRequest) GetMaxEvents() uint32 {
+	if m != nil {
+		return m.MaxEvents
+	}
+	return 0
+}
+
+type EventReplayResponse struct {
+	EventsReplayed uint32 `protobuf:"varint,1,opt,name=events_replayed,json=eventsReplayed,proto3" json:"eventsReplayed,omitempty"`
+	// Offset of the last event replayed. Empty if no events were replayed.
+	// Replay can be resumed from the offset after this one if it was interrupted or limited by max_events.
+	LastOffset string `protobuf:"bytes,2,opt,name=last_offset,json=lastOffset,proto3" json:"lastOffset,omitempty"`
+}
+
+func (m *EventReplayResponse) Reset()      { *m = EventReplayResponse{} }
+func (*EventReplayResponse) ProtoMessage() {}
+func (*EventReplayResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f334984294d65460, []int{2}
+}
+func (m *EventReplayResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventReplayResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventReplayResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventReplayResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventReplayResponse.Merge(m, src)
+}
+func (m *EventReplayResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventReplayResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventReplayResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventReplayResponse proto.InternalMessageInfo
+
+func (m *EventReplayResponse) GetEventsReplayed() uint32 {
+	if m != nil {
+		return m.EventsReplayed
+	}
+	return 0
+}
+
+func (m *EventReplayResponse) GetLastOffset() string {
+	if m != nil {
+		return m.LastOffset
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*EventJournalInfo)(nil), "api.EventJournalInfo")
+	proto.RegisterType((*EventReplayRequest)(nil), "api.EventReplayRequest")
+	proto.RegisterType((*EventReplayResponse)(nil), "api.EventReplayResponse")
+}
+
+func init() { proto.RegisterFile("pkg/api/event_journal.proto", fileDescriptor_f334984294d65460) }
+
+var fileDescriptor_f334984294d65460 = []byte{
+	// 469 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xce, 0x36, 0x12, 0x22, 0xdb, 0x14, 0xd0, 0xa6, 0x3f, 0x21, 0x45, 0x76, 0x95, 0x0b, 0x3d,
+	0xc0, 0x5a, 0xa2, 0x12, 0x12, 0x12, 0x97, 0x44, 0x44, 0xa8, 0x5c, 0x40, 0x39, 0x72, 0x09, 0x9b,
+	0x76, 0x6d, 0x5c, 0xbc, 0x9e, 0xc5, 0x5e, 0xa3, 0xf6, 0xc6, 0x23, 0x70, 0xeb, 0x3b, 0xf0, 0x0c,
+	0xdc, 0xb8, 0x70, 0xec, 0xb1, 0x27, 0x0b, 0x92, 0x9b, 0x9f, 0x02, 0x65, 0x36, 0xad, 0x6d, 0xf9,
+	0xd8, 0xdb, 0xce, 0xf7, 0x7d, 0x33, 0xf3, 0xcd, 0xee, 0x2c, 0xdd, 0xd7, 0x5f, 0x02, 0x4f, 0xe8,
+	0xd0, 0x93, 0xdf, 0x64, 0x6c, 0x66, 0x67, 0x90, 0x25, 0xb1, 0x88, 0xb8, 0x4e, 0xc0, 0x00, 0x6b,
+	0x0b, 0x1d, 0x0e, 0xf6, 0x03, 0x80, 0x20, 0x92, 0x1e, 0x42, 0xf3, 0xcc, 0xf7, 0xa4, 0xd2, 0xe6,
+	0xc2, 0x2a, 0x06, 0xcf, 0x83, 0xd0, 0x7c, 0xce, 0xe6, 0xfc, 0x04, 0x94, 0x17, 0x40, 0x00, 0xa5,
+	0x6a, 0x15, 0x61, 0x80, 0x27, 0x2b, 0x1f, 0xfe, 0x26, 0xf4, 0xd1, 0x64, 0xd5, 0xe8, 0x9d, 0xed,
+	0x73, 0x1c, 0xfb, 0xc0, 0x5e, 0xd3, 0xae, 0x1f, 0x26, 0xa9, 0x99, 0x81, 0xef, 0xa7, 0xd2, 0xf4,
+	0xc9, 0x01, 0x39, 0xec, 0x8c, 0x1f, 0x17, 0xb9, 0xbb, 0x83, 0xf8, 0x7b, 0x84, 0x9f, 0x81, 0x0a,
+	0x0d, 0xb6, 0x9e, 0x6e, 0x56, 0x60, 0xf6, 0x8a, 0x6e, 0x46, 0xa2, 0x4c, 0xde, 0xc0, 0xe4, 0x7e,
+	0x91, 0xbb, 0xdb, 0x91, 0xb8, 0x11, 0x55, 0x72, 0x69, 0x89, 0xb2, 0x97, 0x94, 0xc6, 0x99, 0x9a,
+	0xe1, 0xe4, 0x69, 0xbf, 0x7d, 0x40, 0x0e, 0xdb, 0xe3, 0xbd, 0x22, 0x77, 0x7b, 0x71, 0xa6, 0xd0,
+	0x65, 0x5a, 0x49, 0xec, 0xdc, 0x82, 0xc3, 0x5f, 0x84, 0x32, 0x3c, 0x4e, 0xa5, 0x8e, 0xc4, 0xc5,
+	0x54, 0x7e, 0xcd, 0x64, 0x8a, 0x4e, 0xfc, 0x04, 0x54, 0x7d, 0x0c, 0x74, 0xb2, 0x82, 0x9b, 0x4e,
+	0x4a, 0x94, 0x1d, 0xd1, 0x8e, 0x81, 0xfa, 0x08, 0xbb, 0x45, 0xee, 0x32, 0x03, 0x8d, 0xb4, 0xfb,
+	0x06, 0x4a, 0xfb, 0x4a, 0x9c, 0x57, 0xed, 0x6f, 0x59, 0xfb, 0x4a, 0x9c, 0x37, 0xed, 0xdf, 0x82,
+	0xc3, 0x4b, 0x42, 0x7b, 0x35, 0xfb, 0xa9, 0x86, 0x38, 0x95, 0x6c, 0x42, 0x1f, 0xda, 0x5a, 0xb3,
+	0x04, 0x09, 0x79, 0x8a, 0x33, 0x6c, 0x8d, 0x9f, 0x14, 0xb9, 0xdb, 0xb7, 0xd4, 0x74, 0xcd, 0x54,
+	0x2a, 0x3f, 0xa8, 0x33, 0x77, 0x78, 0x90, 0x17, 0x97, 0x84, 0x76, 0xab, 0xeb, 0xc1, 0xde, 0xd0,
+	0xde, 0x5b, 0x69, 0x1a, 0x1b, 0xb3, 0xcb, 0xed, 0x4e, 0xf2, 0x9b, 0x6d, 0xe3, 0x93, 0x55, 0xad,
+	0xc1, 0x0e, 0x17, 0x3a, 0xe4, 0x0d, 0xf9, 0x88, 0x76, 0xad, 0x3b, 0x64, 0x52, 0xb6, 0x57, 0xca,
+	0x6a, 0x2f, 0x38, 0xe8, 0x37, 0x09, 0x7b, 0x37, 0xe3, 0x4f, 0xd7, 0xff, 0x9c, 0xd6, 0xf7, 0x85,
+	0x43, 0xfe, 0x2c, 0x1c, 0x72, 0xb5, 0x70, 0xc8, 0xdf, 0x85, 0x43, 0x7e, 0x2c, 0x9d, 0xd6, 0xd5,
+	0xd2, 0x69, 0x5d, 0x2f, 0x9d, 0xd6, 0xc7, 0xa7, 0x95, 0x9f, 0x20, 0x12, 0x25, 0x4e, 0x85, 0x4e,
+	0xe0, 0x4c, 0x9e, 0x98, 0x75, 0xe4, 0xad, 0x3f, 0xda, 0xcf, 0x8d, 0xed, 0x11, 0x02, 0x1f, 0x2c,
+	0xcd, 0x8f, 0x81, 0x8f, 0x74, 0x38, 0xbf, 0x87, 0xb3, 0x1c, 0xfd, 0x1f, 0x00, 0xe6, 0x16, 0x75,
+	0x43, 0x91, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// EventJournalClient is the client API for EventJournal service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type EventJournalClient interface {
+	GetEventJournalInfo(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*EventJournalInfo, error)
+	// ReplayEvents republishes journaled events, in the order they were reported, to the event stream.
+	// Consumers will receive replayed events again even if they had received them before.
+	ReplayEvents(ctx context.Context, in *EventReplayRequest, opts ...grpc.CallOption) (*EventReplayResponse, error)
+}
+
+type eventJournalClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewEventJournalClient(cc *grpc.ClientConn) EventJournalClient {
+	return &eventJournalClient{cc}
+}
+
+func (c *eventJournalClient) GetEventJournalInfo(ctx context.Context, in *types.Empty, opts ...grpc.CallOption) (*EventJournalInfo, error) {
+	out := new(EventJournalInfo)
+	err := c.cc.Invoke(ctx, "/api.EventJournal/GetEventJournalInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventJournalClient) ReplayEvents(ctx context.Context, in *EventReplayRequest, opts ...grpc.CallOption) (*EventReplayResponse, error) {
+	out := new(EventReplayResponse)
+	err := c.cc.Invoke(ctx, "/api.EventJournal/ReplayEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EventJournalServer is the server API for EventJournal service.
+type EventJournalServer interface {
+	GetEventJournalInfo(context.Context, *types.Empty) (*EventJournalInfo, error)
+	// ReplayEvents republishes journaled events, in the order they were reported, to the event stream.
+	// Consumers will receive replayed events again even if they had received them before.
+	ReplayEvents(context.Context, *EventReplayRequest) (*EventReplayResponse, error)
+}
+
+// UnimplementedEventJournalServer can be embedded to have forward compatible implementations.
+type UnimplementedEventJournalServer struct {
+}
+
+func (*UnimplementedEventJournalServer) GetEventJournalInfo(ctx context.Context, req *types.Empty) (*EventJournalInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEventJournalInfo not implemented")
+}
+func (*UnimplementedEventJournalServer) ReplayEvents(ctx context.Context, req *EventReplayRequest) (*EventReplayResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayEvents not implemented")
+}
+
+func RegisterEventJournalServer(s *grpc.Server, srv EventJournalServer) {
+	s.RegisterService(&_EventJournal_serviceDesc, srv)
+}
+
+func _EventJournal_GetEventJournalInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(types.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventJournalServer).GetEventJournalInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.EventJournal/GetEventJournalInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventJournalServer).GetEventJournalInfo(ctx, req.(*types.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventJournal_ReplayEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EventReplayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventJournalServer).ReplayEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.EventJournal/ReplayEvents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventJournalServer).ReplayEvents(ctx, req.(*EventReplayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _EventJournal_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.EventJournal",
+	HandlerType: (*EventJournalServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetEventJournalInfo",
+			Handler:    _EventJournal_GetEventJournalInfo_Handler,
+		},
+		{
+			MethodName: "ReplayEvents",
+			Handler:    _EventJournal_ReplayEvents_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pkg/api/event_journal.proto",
+}
+
+func (m *EventJournalInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventJournalInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventJournalInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumEvents != 0 {
+		i = encodeVarintEventJournal(dAtA, i, uint64(m.NumEvents))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.LastOffset) > 0 {
+		i -= len(m.LastOffset)
+		copy(dAtA[i:], m.LastOffset)
+		i = encodeVarintEventJournal(dAtA, i, uint64(len(m.LastOffset)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FirstOffset) > 0 {
+		i -= len(m.FirstOffset)
+		copy(dAtA[i:], m.FirstOffset)
+		i = encodeVarintEventJournal(dAtA, i, uint64(len(m.FirstOffset)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventReplayRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventReplayRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventReplayRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxEvents != 0 {
+		i = encodeVarintEventJournal(dAtA, i, uint64(m.MaxEvents))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ToOffset) > 0 {
+		i -= len(m.ToOffset)
+		copy(dAtA[i:], m.ToOffset)
+		i = encodeVarintEventJournal(dAtA, i, uint64(len(m.ToOffset)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromOffset) > 0 {
+		i -= len(m.FromOffset)
+		copy(dAtA[i:], m.FromOffset)
+		i = encodeVarintEventJournal(dAtA, i, uint64(len(m.FromOffset)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventReplayResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventReplayResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventReplayResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LastOffset) > 0 {
+		i -= len(m.LastOffset)
+		copy(dAtA[i:], m.LastOffset)
+		i = encodeVarintEventJournal(dAtA, i, uint64(len(m.LastOffset)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.EventsReplayed != 0 {
+		i = encodeVarintEventJournal(dAtA, i, uint64(m.EventsReplayed))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEventJournal(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEventJournal(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventJournalInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FirstOffset)
+	if l > 0 {
+		n += 1 + l + sovEventJournal(uint64(l))
+	}
+	l = len(m.LastOffset)
+	if l > 0 {
+		n += 1 + l + sovEventJournal(uint64(l))
+	}
+	if m.NumEvents != 0 {
+		n += 1 + sovEventJournal(uint64(m.NumEvents))
+	}
+	return n
+}
+
+func (m *EventReplayRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromOffset)
+	if l > 0 {
+		n += 1 + l + sovEventJournal(uint64(l))
+	}
+	l = len(m.ToOffset)
+	if l > 0 {
+		n += 1 + l + sovEventJournal(uint64(l))
+	}
+	if m.MaxEvents != 0 {
+		n += 1 + sovEventJournal(uint64(m.MaxEvents))
+	}
+	return n
+}
+
+func (m *EventReplayResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EventsReplayed != 0 {
+		n += 1 + sovEventJournal(uint64(m.EventsReplayed))
+	}
+	l = len(m.LastOffset)
+	if l > 0 {
+		n += 1 + l + sovEventJournal(uint64(l))
+	}
+	return n
+}
+
+func sovEventJournal(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEventJournal(x uint64) (n int) {
+	return sovEventJournal(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *EventJournalInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventJournalInfo{`,
+		`FirstOffset:` + fmt.Sprintf("%v", this.FirstOffset) + `,`,
+		`LastOffset:` + fmt.Sprintf("%v", this.LastOffset) + `,`,
+		`NumEvents:` + fmt.Sprintf("%v", this.NumEvents) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventReplayRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventReplayRequest{`,
+		`FromOffset:` + fmt.Sprintf("%v", this.FromOffset) + `,`,
+		`ToOffset:` + fmt.Sprintf("%v", this.ToOffset) + `,`,
+		`MaxEvents:` + fmt.Sprintf("%v", this.MaxEvents) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *EventReplayResponse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&EventReplayResponse{`,
+		`EventsReplayed:` + fmt.Sprintf("%v", this.EventsReplayed) + `,`,
+		`LastOffset:` + fmt.Sprintf("%v", this.LastOffset) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringEventJournal(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *EventJournalInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEventJournal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventJournalInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventJournalInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstOffset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventJournal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEventJournal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEventJournal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FirstOffset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastOffset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventJournal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEventJournal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEventJournal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastOffset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumEvents", wireType)
+			}
+			m.NumEvents = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventJournal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumEvents |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEventJournal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEventJournal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventReplayRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEventJournal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventReplayRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventReplayRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromOffset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventJournal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEventJournal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEventJournal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromOffset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToOffset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventJournal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEventJournal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEventJournal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToOffset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEvents", wireType)
+			}
+			m.MaxEvents = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventJournal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxEvents |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEventJournal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEventJournal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventReplayResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEventJournal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventReplayResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventReplayResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventsReplayed", wireType)
+			}
+			m.EventsReplayed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventJournal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventsReplayed |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastOffset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEventJournal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEventJournal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEventJournal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastOffset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEventJournal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEventJournal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEventJournal(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEventJournal
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEventJournal
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEventJournal
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEventJournal
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEventJournal
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEventJournal
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEventJournal        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEventJournal          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEventJournal = fmt.Errorf("proto: unexpected end of group")
+)
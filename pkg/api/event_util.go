@@ -5,9 +5,15 @@ import (
 	"reflect"
 	"time"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/pkg/errors"
 )
 
+// EventSchemaVersion is the version of the schema of events defined by this package. It's incremented with each change
+// to the schema that clients of an earlier version may not handle, e.g., a new type of event.
+// Clients should request events of this version, i.e., set it as the SchemaVersion of JobSetRequests.
+const EventSchemaVersion uint32 = 7
+
 type Event interface {
 	GetJobId() string
 	GetJobSetId() string
@@ -33,6 +39,35 @@ func (message *EventMessage) UnmarshalJSON(data []byte) error {
 	return json.Unmarshal(data, message.Events)
 }
 
+// eventTypeByWrapperType maps the types wrapping each type of event in the events oneof of EventMessage
+// to the name of the field of the oneof.
+var eventTypeByWrapperType = func() map[reflect.Type]string {
+	eventTypes := make(map[reflect.Type]string)
+	for name, oneof := range proto.GetProperties(reflect.TypeOf(EventMessage{})).OneofTypes {
+		eventTypes[oneof.Type] = name
+	}
+	return eventTypes
+}()
+
+// EventType returns the type of event message is, named as the field of the events oneof set, e.g., "submitted" or
+// "duplicate_found". It returns an empty string if no event is set.
+func EventType(message *EventMessage) string {
+	if message.Events == nil {
+		return ""
+	}
+	return eventTypeByWrapperType[reflect.TypeOf(message.Events)]
+}
+
+// IsEventType returns true if eventType names a type of event, as returned by EventType.
+func IsEventType(eventType string) bool {
+	for _, name := range eventTypeByWrapperType {
+		if name == eventType {
+			return true
+		}
+	}
+	return false
+}
+
 func UnwrapEvent(message *EventMessage) (Event, error) {
 	switch event := message.Events.(type) {
 	case *EventMessage_Submitted:
@@ -75,6 +110,28 @@ func UnwrapEvent(message *EventMessage) (Event, error) {
 		return event.Updated, nil
 	case *EventMessage_Preempted:
 		return event.Preempted, nil
+	case *EventMessage_JobSetExpired:
+		return event.JobSetExpired, nil
+	case *EventMessage_Suspended:
+		return event.Suspended, nil
+	case *EventMessage_Resumed:
+		return event.Resumed, nil
+	case *EventMessage_EvictedForCapacity:
+		return event.EvictedForCapacity, nil
+	case *EventMessage_RuntimeExceeded:
+		return event.RuntimeExceeded, nil
+	case *EventMessage_ResourcesNormalized:
+		return event.ResourcesNormalized, nil
+	case *EventMessage_Unschedulable:
+		return event.Unschedulable, nil
+	case *EventMessage_MaintenanceWindowStarted:
+		return event.MaintenanceWindowStarted, nil
+	case *EventMessage_MaintenanceWindowEnded:
+		return event.MaintenanceWindowEnded, nil
+	case *EventMessage_Cordoned:
+		return event.Cordoned, nil
+	case *EventMessage_Uncordoned:
+		return event.Uncordoned, nil
 	}
 	return nil, errors.Errorf("unknown event type: %s", reflect.TypeOf(message.Events))
 }
@@ -201,6 +258,72 @@ func Wrap(event Event) (*EventMessage, error) {
 				Preempted: typed,
 			},
 		}, nil
+	case *JobSetExpiredEvent:
+		return &EventMessage{
+			Events: &EventMessage_JobSetExpired{
+				JobSetExpired: typed,
+			},
+		}, nil
+	case *JobSuspendedEvent:
+		return &EventMessage{
+			Events: &EventMessage_Suspended{
+				Suspended: typed,
+			},
+		}, nil
+	case *JobResumedEvent:
+		return &EventMessage{
+			Events: &EventMessage_Resumed{
+				Resumed: typed,
+			},
+		}, nil
+	case *JobEvictedForCapacityEvent:
+		return &EventMessage{
+			Events: &EventMessage_EvictedForCapacity{
+				EvictedForCapacity: typed,
+			},
+		}, nil
+	case *JobRuntimeExceededEvent:
+		return &EventMessage{
+			Events: &EventMessage_RuntimeExceeded{
+				RuntimeExceeded: typed,
+			},
+		}, nil
+	case *JobResourcesNormalizedEvent:
+		return &EventMessage{
+			Events: &EventMessage_ResourcesNormalized{
+				ResourcesNormalized: typed,
+			},
+		}, nil
+	case *JobUnschedulableEvent:
+		return &EventMessage{
+			Events: &EventMessage_Unschedulable{
+				Unschedulable: typed,
+			},
+		}, nil
+	case *JobMaintenanceWindowStartedEvent:
+		return &EventMessage{
+			Events: &EventMessage_MaintenanceWindowStarted{
+				MaintenanceWindowStarted: typed,
+			},
+		}, nil
+	case *JobMaintenanceWindowEndedEvent:
+		return &EventMessage{
+			Events: &EventMessage_MaintenanceWindowEnded{
+				MaintenanceWindowEnded: typed,
+			},
+		}, nil
+	case *JobCordonedEvent:
+		return &EventMessage{
+			Events: &EventMessage_Cordoned{
+				Cordoned: typed,
+			},
+		}, nil
+	case *JobUncordonedEvent:
+		return &EventMessage{
+			Events: &EventMessage_Uncordoned{
+				Uncordoned: typed,
+			},
+		}, nil
 	}
 	return nil, errors.Errorf("unknown event type: %s", reflect.TypeOf(event))
 }
@@ -0,0 +1,20 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEventType(t *testing.T) {
+	assert.Equal(t, "submitted", EventType(&EventMessage{Events: &EventMessage_Submitted{Submitted: &JobSubmittedEvent{}}}))
+	assert.Equal(t, "duplicate_found", EventType(&EventMessage{Events: &EventMessage_DuplicateFound{DuplicateFound: &JobDuplicateFoundEvent{}}}))
+	assert.Equal(t, "", EventType(&EventMessage{}))
+}
+
+func TestIsEventType(t *testing.T) {
+	assert.True(t, IsEventType("failed"))
+	assert.True(t, IsEventType("job_set_expired"))
+	assert.False(t, IsEventType("jobSetExpired"))
+	assert.False(t, IsEventType(""))
+}
@@ -101,6 +101,46 @@ func (JobServiceResponse_State) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_acaf6279d0169157, []int{2, 0}
 }
 
+type JobRunAttempt_Outcome int32
+
+const (
+	JobRunAttempt_IN_PROGRESS    JobRunAttempt_Outcome = 0
+	JobRunAttempt_SUCCEEDED      JobRunAttempt_Outcome = 1
+	JobRunAttempt_FAILED         JobRunAttempt_Outcome = 2
+	JobRunAttempt_LEASE_RETURNED JobRunAttempt_Outcome = 3
+	JobRunAttempt_LEASE_EXPIRED  JobRunAttempt_Outcome = 4
+	JobRunAttempt_PREEMPTED      JobRunAttempt_Outcome = 5
+	JobRunAttempt_CANCELLED      JobRunAttempt_Outcome = 6
+)
+
+var JobRunAttempt_Outcome_name = map[int32]string{
+	0: "IN_PROGRESS",
+	1: "SUCCEEDED",
+	2: "FAILED",
+	3: "LEASE_RETURNED",
+	4: "LEASE_EXPIRED",
+	5: "PREEMPTED",
+	6: "CANCELLED",
+}
+
+var JobRunAttempt_Outcome_value = map[string]int32{
+	"IN_PROGRESS":    0,
+	"SUCCEEDED":      1,
+	"FAILED":         2,
+	"LEASE_RETURNED": 3,
+	"LEASE_EXPIRED":  4,
+	"PREEMPTED":      5,
+	"CANCELLED":      6,
+}
+
+func (x JobRunAttempt_Outcome) String() string {
+	return proto.EnumName(JobRunAttempt_Outcome_name, int32(x))
+}
+
+func (JobRunAttempt_Outcome) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_acaf6279d0169157, []int{3, 0}
+}
+
 type HealthCheckResponse struct {
 	Status HealthCheckResponse_ServingStatus `protobuf:"varint,1,opt,name=status,proto3,enum=jobservice.HealthCheckResponse_ServingStatus" json:"status,omitempty"`
 }
@@ -209,6 +249,8 @@ type JobServiceResponse struct {
 	State JobServiceResponse_State `protobuf:"varint,1,opt,name=state,proto3,enum=jobservice.JobServiceResponse_State" json:"state,omitempty"`
 	// For failed jobs, this will contain a reason why the job failed
 	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	// Every attempt at running the job, oldest first
+	RunHistory []*JobRunAttempt `protobuf:"bytes,3,rep,name=run_history,json=runHistory,proto3" json:"runHistory,omitempty"`
 }
 
 func (m *JobServiceResponse) Reset()         { *m = JobServiceResponse{} }
@@ -258,12 +300,116 @@ func (m *JobServiceResponse) GetError() string {
 	return ""
 }
 
+func (m *JobServiceResponse) GetRunHistory() []*JobRunAttempt {
+	if m != nil {
+		return m.RunHistory
+	}
+	return nil
+}
+
+// A single attempt at running a job, from being leased to a cluster until it stopped running there.
+// Jobs may be attempted several times, e.g., if they are preempted or their lease is returned.
+type JobRunAttempt struct {
+	ClusterId string                `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"clusterId,omitempty"`
+	NodeName  string                `protobuf:"bytes,2,opt,name=node_name,json=nodeName,proto3" json:"nodeName,omitempty"`
+	Leased    *types.Timestamp      `protobuf:"bytes,3,opt,name=leased,proto3" json:"leased,omitempty"`
+	Started   *types.Timestamp      `protobuf:"bytes,4,opt,name=started,proto3" json:"started,omitempty"`
+	Finished  *types.Timestamp      `protobuf:"bytes,5,opt,name=finished,proto3" json:"finished,omitempty"`
+	Outcome   JobRunAttempt_Outcome `protobuf:"varint,6,opt,name=outcome,proto3,enum=jobservice.JobRunAttempt_Outcome" json:"outcome,omitempty"`
+	// Why the attempt stopped, e.g., the reason for a failure or for a returned lease
+	ExitReason string `protobuf:"bytes,7,opt,name=exit_reason,json=exitReason,proto3" json:"exitReason,omitempty"`
+}
+
+func (m *JobRunAttempt) Reset()         { *m = JobRunAttempt{} }
+func (m *JobRunAttempt) String() string { return proto.CompactTextString(m) }
+func (*JobRunAttempt) ProtoMessage()    {}
+func (*JobRunAttempt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_acaf6279d0169157, []int{3}
+}
+func (m *JobRunAttempt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobRunAttempt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobRunAttempt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *JobRunAttempt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobRunAttempt.Merge(m, src)
+}
+func (m *JobRunAttempt) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobRunAttempt) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobRunAttempt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobRunAttempt proto.InternalMessageInfo
+
+func (m *JobRunAttempt) GetClusterId() string {
+	if m != nil {
+		return m.ClusterId
+	}
+	return ""
+}
+
+func (m *JobRunAttempt) GetNodeName() string {
+	if m != nil {
+		return m.NodeName
+	}
+	return ""
+}
+
+func (m *JobRunAttempt) GetLeased() *types.Timestamp {
+	if m != nil {
+		return m.Leased
+	}
+	return nil
+}
+
+func (m *JobRunAttempt) GetStarted() *types.Timestamp {
+	if m != nil {
+		return m.Started
+	}
+	return nil
+}
+
+func (m *JobRunAttempt) GetFinished() *types.Timestamp {
+	if m != nil {
+		return m.Finished
+	}
+	return nil
+}
+
+func (m *JobRunAttempt) GetOutcome() JobRunAttempt_Outcome {
+	if m != nil {
+		return m.Outcome
+	}
+	return JobRunAttempt_IN_PROGRESS
+}
+
+func (m *JobRunAttempt) GetExitReason() string {
+	if m != nil {
+		return m.ExitReason
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("jobservice.HealthCheckResponse_ServingStatus", HealthCheckResponse_ServingStatus_name, HealthCheckResponse_ServingStatus_value)
 	proto.RegisterEnum("jobservice.JobServiceResponse_State", JobServiceResponse_State_name, JobServiceResponse_State_value)
+	proto.RegisterEnum("jobservice.JobRunAttempt_Outcome", JobRunAttempt_Outcome_name, JobRunAttempt_Outcome_value)
 	proto.RegisterType((*HealthCheckResponse)(nil), "jobservice.HealthCheckResponse")
 	proto.RegisterType((*JobServiceRequest)(nil), "jobservice.JobServiceRequest")
 	proto.RegisterType((*JobServiceResponse)(nil), "jobservice.JobServiceResponse")
+	proto.RegisterType((*JobRunAttempt)(nil), "jobservice.JobRunAttempt")
 }
 
 func init() {
@@ -271,43 +417,62 @@ func init() {
 }
 
 var fileDescriptor_acaf6279d0169157 = []byte{
-	// 574 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0xcd, 0x6e, 0xd3, 0x4c,
-	0x14, 0x8d, 0xd3, 0x2f, 0xee, 0xd7, 0x5b, 0xda, 0x9a, 0x69, 0x55, 0x55, 0x45, 0x38, 0x28, 0xb0,
-	0x00, 0x04, 0xb6, 0x54, 0x58, 0xb1, 0x73, 0x6c, 0xb7, 0xb8, 0xa4, 0xe3, 0xca, 0x49, 0x40, 0x82,
-	0x85, 0x65, 0x27, 0x43, 0xe2, 0xb4, 0xc9, 0xb8, 0xf6, 0x18, 0x89, 0x77, 0x60, 0xc1, 0x13, 0x00,
-	0x6b, 0x16, 0x3c, 0x07, 0xcb, 0x2e, 0x59, 0x45, 0x28, 0xd9, 0xe5, 0x29, 0xd0, 0x8c, 0x13, 0xc5,
-	0x55, 0xf9, 0xd9, 0x79, 0xce, 0x3d, 0xf7, 0x9e, 0x33, 0x67, 0xae, 0xe1, 0x6e, 0x7c, 0xd6, 0xd3,
-	0x83, 0x38, 0xd2, 0x07, 0x34, 0x4c, 0x49, 0xf2, 0x2e, 0xea, 0x90, 0xc2, 0xa7, 0x16, 0x27, 0x94,
-	0x51, 0x04, 0x4b, 0x64, 0xff, 0x56, 0x8f, 0xd2, 0xde, 0x39, 0xd1, 0x45, 0x25, 0xcc, 0xde, 0xea,
-	0x64, 0x18, 0xb3, 0xf7, 0x39, 0xb1, 0xf6, 0x4d, 0x82, 0xed, 0xe7, 0x24, 0x38, 0x67, 0x7d, 0xb3,
-	0x4f, 0x3a, 0x67, 0x1e, 0x49, 0x63, 0x3a, 0x4a, 0x09, 0x7a, 0x03, 0x72, 0xca, 0x02, 0x96, 0xa5,
-	0x7b, 0xd2, 0x1d, 0xe9, 0xfe, 0xe6, 0xc1, 0x63, 0xad, 0xa0, 0xf1, 0x9b, 0x06, 0xad, 0xc9, 0x6b,
-	0xa3, 0x5e, 0x53, 0x34, 0xd5, 0x77, 0x66, 0xe3, 0xaa, 0x92, 0x0f, 0x78, 0x44, 0x87, 0x11, 0x13,
-	0x92, 0xde, 0x7c, 0x64, 0xed, 0x19, 0x6c, 0x5c, 0xa1, 0xa3, 0x75, 0x58, 0x6d, 0xe3, 0x17, 0xd8,
-	0x7d, 0x85, 0x95, 0x12, 0x3f, 0x34, 0x6d, 0xef, 0xa5, 0x83, 0x8f, 0x14, 0x09, 0x6d, 0xc1, 0x3a,
-	0x76, 0x5b, 0xfe, 0x02, 0x28, 0xd7, 0xbe, 0x48, 0x70, 0xf3, 0x98, 0x86, 0xcd, 0xdc, 0x8a, 0x47,
-	0x2e, 0x32, 0x92, 0x32, 0xf4, 0x10, 0xe4, 0x01, 0x0d, 0xfd, 0xa8, 0x2b, 0xec, 0xae, 0xd5, 0xb7,
-	0x67, 0xe3, 0xea, 0xd6, 0x80, 0x86, 0x4e, 0xb7, 0x20, 0x5f, 0x11, 0x00, 0x7a, 0x0a, 0x3c, 0x1d,
-	0x3f, 0x25, 0x8c, 0xf3, 0xcb, 0x82, 0xbf, 0x3b, 0x1b, 0x57, 0xd1, 0x80, 0x8f, 0x65, 0x57, 0x5a,
-	0xfe, 0x5f, 0x60, 0xe8, 0x01, 0x54, 0x2e, 0x32, 0x92, 0x91, 0xbd, 0x95, 0xa5, 0x80, 0x00, 0x8a,
-	0x02, 0x02, 0xa8, 0x7d, 0x2e, 0x03, 0x2a, 0x5a, 0x9c, 0x47, 0xea, 0x42, 0x85, 0xdf, 0x9f, 0xcc,
-	0x13, 0xbd, 0x57, 0x4c, 0xf4, 0x3a, 0x5d, 0xe3, 0xd1, 0x90, 0x5c, 0x47, 0xb4, 0x15, 0x75, 0x04,
-	0xc0, 0x2d, 0x91, 0x24, 0xa1, 0xc9, 0xfc, 0x0e, 0x82, 0x2a, 0x80, 0x22, 0x55, 0x00, 0xb5, 0x0f,
-	0x12, 0x54, 0xc4, 0x40, 0xb4, 0x01, 0x6b, 0xcd, 0x76, 0xfd, 0xc4, 0x69, 0xb5, 0x6c, 0x4b, 0x29,
-	0xa1, 0x6d, 0xd8, 0xb2, 0xda, 0xa7, 0x0d, 0xc7, 0x34, 0x5a, 0xb6, 0x7f, 0xe8, 0xb6, 0xb1, 0xa5,
-	0x48, 0xfc, 0x05, 0xbc, 0x36, 0xc6, 0x22, 0x70, 0x04, 0x20, 0x1f, 0x1a, 0x4e, 0xc3, 0xb6, 0x94,
-	0x95, 0xbc, 0xd9, 0x34, 0x6d, 0xdb, 0xb2, 0x2d, 0xe5, 0x3f, 0x7e, 0x34, 0x0d, 0x6c, 0xda, 0x0d,
-	0x5e, 0xad, 0xa0, 0x1d, 0x50, 0x8e, 0xdd, 0xba, 0xef, 0x58, 0x3e, 0x7f, 0xb2, 0x7c, 0x98, 0x8c,
-	0x10, 0x6c, 0x9a, 0x2e, 0xc6, 0xb6, 0xd9, 0x72, 0x5c, 0xec, 0xdb, 0x9e, 0xa7, 0xac, 0x1e, 0x7c,
-	0x92, 0x00, 0x96, 0x57, 0x46, 0x27, 0x70, 0xe3, 0x88, 0x30, 0x0e, 0xe4, 0xeb, 0x70, 0xfb, 0x4f,
-	0xd1, 0x88, 0xc7, 0xde, 0x57, 0xff, 0x9e, 0x1c, 0x32, 0x40, 0xce, 0x37, 0x14, 0xed, 0x6a, 0xf9,
-	0xee, 0x6b, 0x8b, 0xdd, 0xd7, 0x6c, 0x9e, 0xca, 0x7e, 0xf5, 0x1f, 0xdb, 0x5c, 0x1f, 0x7d, 0x9f,
-	0xa8, 0xd2, 0xe5, 0x44, 0x95, 0x7e, 0x4e, 0x54, 0xe9, 0xe3, 0x54, 0x2d, 0x5d, 0x4e, 0xd5, 0xd2,
-	0x8f, 0xa9, 0x5a, 0x7a, 0x7d, 0xd0, 0x8b, 0x58, 0x3f, 0x0b, 0xb5, 0x0e, 0x1d, 0xea, 0x41, 0x32,
-	0x0c, 0xba, 0x41, 0x9c, 0xd0, 0x01, 0xe9, 0xb0, 0xf9, 0x49, 0xbf, 0xfe, 0x7b, 0x7e, 0x2d, 0x57,
-	0x0d, 0x51, 0x3b, 0xcd, 0x99, 0x9a, 0x43, 0x35, 0x23, 0x8e, 0x0a, 0xd6, 0x43, 0x59, 0x18, 0x7c,
-	0xf2, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x26, 0xf4, 0x4c, 0x08, 0xdd, 0x03, 0x00, 0x00,
+	// 868 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x55, 0xcf, 0x6f, 0xe3, 0x44,
+	0x14, 0x8e, 0xd3, 0x26, 0x69, 0x5f, 0xe8, 0xd6, 0x9d, 0x96, 0x25, 0x04, 0x91, 0x2c, 0x81, 0xc3,
+	0x82, 0xc0, 0x91, 0xb2, 0x08, 0x09, 0x6e, 0xf9, 0x31, 0xdb, 0x7a, 0xb7, 0x75, 0x22, 0xc7, 0x01,
+	0x04, 0x07, 0xcb, 0x49, 0x66, 0x13, 0x67, 0x6b, 0x4f, 0xd6, 0x1e, 0x23, 0x96, 0xbf, 0x81, 0x03,
+	0x7f, 0x01, 0xe2, 0xcc, 0x81, 0xbf, 0x83, 0xe3, 0x1e, 0x39, 0x45, 0xa8, 0xbd, 0xe5, 0xc0, 0x8d,
+	0x3b, 0x9a, 0x19, 0x9b, 0x4c, 0xb6, 0x94, 0xde, 0xe2, 0x6f, 0xbe, 0xf7, 0xde, 0x37, 0x6f, 0xbe,
+	0xf7, 0x02, 0xef, 0x2f, 0x9f, 0xcf, 0x9a, 0xde, 0xd2, 0x6f, 0x2e, 0xe8, 0x38, 0x26, 0xd1, 0x77,
+	0xfe, 0x84, 0x28, 0x3f, 0x8d, 0x65, 0x44, 0x19, 0x45, 0xb0, 0x41, 0xaa, 0xef, 0xcc, 0x28, 0x9d,
+	0x5d, 0x92, 0xa6, 0x38, 0x19, 0x27, 0xcf, 0x9a, 0x24, 0x58, 0xb2, 0x97, 0x92, 0x58, 0xad, 0xbf,
+	0x7e, 0xc8, 0xfc, 0x80, 0xc4, 0xcc, 0x0b, 0x96, 0x92, 0xd0, 0xf8, 0x4d, 0x83, 0xe3, 0x33, 0xe2,
+	0x5d, 0xb2, 0x79, 0x77, 0x4e, 0x26, 0xcf, 0x6d, 0x12, 0x2f, 0x69, 0x18, 0x13, 0xf4, 0x2d, 0x14,
+	0x63, 0xe6, 0xb1, 0x24, 0xae, 0x68, 0x0f, 0xb4, 0x87, 0xf7, 0x5a, 0x9f, 0x18, 0x8a, 0x88, 0xff,
+	0x08, 0x30, 0x86, 0xfc, 0x2c, 0x9c, 0x0d, 0x45, 0x50, 0xe7, 0x64, 0xbd, 0xaa, 0xeb, 0x32, 0xc1,
+	0xc7, 0x34, 0xf0, 0x99, 0xd0, 0x64, 0xa7, 0x29, 0x1b, 0x5f, 0xc0, 0xc1, 0x16, 0x1d, 0x95, 0xa1,
+	0x34, 0xb2, 0x9e, 0x5a, 0xfd, 0xaf, 0x2c, 0x3d, 0xc7, 0x3f, 0x86, 0xd8, 0xfe, 0xd2, 0xb4, 0x4e,
+	0x75, 0x0d, 0x1d, 0x42, 0xd9, 0xea, 0x3b, 0x6e, 0x06, 0xe4, 0x1b, 0xbf, 0x68, 0x70, 0xf4, 0x84,
+	0x8e, 0x87, 0x52, 0x8a, 0x4d, 0x5e, 0x24, 0x24, 0x66, 0xe8, 0x23, 0x28, 0x2e, 0xe8, 0xd8, 0xf5,
+	0xa7, 0x42, 0xee, 0x7e, 0xe7, 0x78, 0xbd, 0xaa, 0x1f, 0x2e, 0xe8, 0xd8, 0x9c, 0x2a, 0xe5, 0x0b,
+	0x02, 0x40, 0x9f, 0x02, 0x6f, 0x9f, 0x1b, 0x13, 0xc6, 0xf9, 0x79, 0xc1, 0xbf, 0xbf, 0x5e, 0xd5,
+	0xd1, 0x82, 0xa7, 0x65, 0x5b, 0x21, 0x7b, 0x19, 0x86, 0x3e, 0x84, 0xc2, 0x8b, 0x84, 0x24, 0xa4,
+	0xb2, 0xb3, 0x29, 0x20, 0x00, 0xb5, 0x80, 0x00, 0x1a, 0x7f, 0xe7, 0x01, 0xa9, 0x12, 0xd3, 0x96,
+	0xf6, 0xa1, 0xc0, 0xef, 0x4f, 0xd2, 0x8e, 0x7e, 0xa0, 0x76, 0xf4, 0x26, 0xdd, 0xe0, 0xad, 0x21,
+	0xb2, 0x8e, 0x08, 0x53, 0xeb, 0x08, 0x80, 0x4b, 0x22, 0x51, 0x44, 0xa3, 0xf4, 0x0e, 0x82, 0x2a,
+	0x00, 0x95, 0x2a, 0x00, 0xe4, 0x40, 0x39, 0x4a, 0x42, 0x77, 0xee, 0xc7, 0x8c, 0x46, 0x2f, 0x2b,
+	0x3b, 0x0f, 0x76, 0x1e, 0x96, 0x5b, 0x6f, 0xbf, 0xa6, 0xc0, 0x4e, 0xc2, 0x36, 0x13, 0x71, 0x9d,
+	0xca, 0x7a, 0x55, 0x3f, 0x89, 0x92, 0xf0, 0x4c, 0x06, 0x28, 0x09, 0x61, 0x83, 0x36, 0x7e, 0xd4,
+	0xa0, 0x20, 0x64, 0xa2, 0x03, 0xd8, 0x1f, 0x8e, 0x3a, 0x17, 0xa6, 0xe3, 0xe0, 0x9e, 0x9e, 0x43,
+	0xc7, 0x70, 0xd8, 0x1b, 0x0d, 0xce, 0xcd, 0x6e, 0xdb, 0xc1, 0xee, 0xe3, 0xfe, 0xc8, 0xea, 0xe9,
+	0x1a, 0x7f, 0x57, 0x7b, 0x64, 0x59, 0xe2, 0x19, 0x11, 0x40, 0xf1, 0x71, 0xdb, 0x3c, 0xc7, 0x3d,
+	0x7d, 0x47, 0x06, 0x77, 0xbb, 0x18, 0xf7, 0x70, 0x4f, 0xdf, 0xe5, 0x9f, 0xdd, 0xb6, 0xd5, 0xc5,
+	0xe7, 0xfc, 0xb4, 0x80, 0x4e, 0x40, 0x7f, 0xd2, 0xef, 0xb8, 0x66, 0xcf, 0xe5, 0x46, 0x90, 0xc9,
+	0x8a, 0x08, 0xc1, 0xbd, 0x6e, 0xdf, 0xb2, 0x70, 0xd7, 0x31, 0xfb, 0x96, 0x8b, 0x6d, 0x5b, 0x2f,
+	0x35, 0xfe, 0xda, 0x85, 0x83, 0xad, 0x6b, 0xa0, 0xcf, 0x00, 0x26, 0x97, 0x49, 0xcc, 0x48, 0xb4,
+	0xb1, 0xc6, 0x5b, 0xeb, 0x55, 0xfd, 0x38, 0x45, 0xb7, 0xde, 0x7a, 0xff, 0x5f, 0x10, 0x3d, 0x82,
+	0xfd, 0x90, 0x4e, 0x89, 0x1b, 0x7a, 0x01, 0x51, 0x1d, 0xc2, 0x41, 0xcb, 0x0b, 0xd4, 0xb7, 0xd8,
+	0xcb, 0x30, 0x74, 0x06, 0xc5, 0x4b, 0xe2, 0xc5, 0x64, 0x2a, 0x2c, 0x52, 0x6e, 0x55, 0x0d, 0x39,
+	0x7c, 0x46, 0x36, 0x7c, 0x86, 0x93, 0x0d, 0x9f, 0x9c, 0x0f, 0xc9, 0x56, 0xe7, 0x43, 0x22, 0xe8,
+	0x29, 0x94, 0x62, 0xe6, 0x45, 0x8c, 0x4c, 0x2b, 0xbb, 0x77, 0xa6, 0x7a, 0x73, 0xbd, 0xaa, 0x1f,
+	0xa5, 0x74, 0x25, 0x57, 0x96, 0x01, 0x59, 0xb0, 0xf7, 0xcc, 0x0f, 0xfd, 0x78, 0x4e, 0xa6, 0x95,
+	0xc2, 0x9d, 0xd9, 0xc4, 0x35, 0x33, 0xbe, 0x7a, 0xcd, 0x0c, 0x43, 0x36, 0x94, 0x68, 0xc2, 0x26,
+	0x34, 0x20, 0x95, 0xa2, 0x30, 0xf2, 0x7b, 0xb7, 0xda, 0xc8, 0xe8, 0x4b, 0xa2, 0xd4, 0x98, 0x46,
+	0xa9, 0x1a, 0x53, 0x08, 0x7d, 0x0e, 0x65, 0xf2, 0xbd, 0xcf, 0xdc, 0x88, 0x78, 0x31, 0x0d, 0x2b,
+	0x25, 0xd1, 0x71, 0xe1, 0x41, 0x0e, 0xdb, 0x02, 0x55, 0x3d, 0xb8, 0x41, 0x1b, 0x3f, 0x40, 0x29,
+	0xad, 0xc2, 0x77, 0x85, 0x69, 0xb9, 0x03, 0xbb, 0x7f, 0x6a, 0xe3, 0xe1, 0x50, 0xcf, 0x6d, 0x1b,
+	0x4b, 0x53, 0x3c, 0x97, 0xe7, 0xfe, 0x39, 0xc7, 0xed, 0x21, 0x76, 0x6d, 0xec, 0x8c, 0x6c, 0x4b,
+	0xf8, 0xf0, 0x08, 0x0e, 0x24, 0x86, 0xbf, 0x1e, 0x98, 0x76, 0xe6, 0xc5, 0x81, 0x8d, 0xf1, 0xc5,
+	0xc0, 0x11, 0x5e, 0xdc, 0xb2, 0x66, 0xb1, 0xf5, 0xb3, 0x06, 0xb0, 0x99, 0x5c, 0x74, 0x01, 0x6f,
+	0x9c, 0x12, 0xc6, 0x01, 0xb9, 0xd5, 0xde, 0xbd, 0x6d, 0xc2, 0xc5, 0xce, 0xaa, 0xd6, 0xfe, 0x7f,
+	0x01, 0xa0, 0x36, 0x14, 0xe5, 0xa2, 0x45, 0xf7, 0x6f, 0x3c, 0x18, 0xe6, 0x7d, 0xa8, 0xd6, 0xef,
+	0x58, 0xca, 0x9d, 0xf0, 0xf7, 0xab, 0x9a, 0xf6, 0xea, 0xaa, 0xa6, 0xfd, 0x79, 0x55, 0xd3, 0x7e,
+	0xba, 0xae, 0xe5, 0x5e, 0x5d, 0xd7, 0x72, 0x7f, 0x5c, 0xd7, 0x72, 0xdf, 0xb4, 0x66, 0x3e, 0x9b,
+	0x27, 0x63, 0x63, 0x42, 0x83, 0xa6, 0x17, 0x05, 0xde, 0xd4, 0x5b, 0x46, 0x74, 0x41, 0x26, 0x2c,
+	0xfd, 0x6a, 0xde, 0xfc, 0x1b, 0xfa, 0x35, 0x5f, 0x6f, 0x8b, 0xb3, 0x81, 0x64, 0x1a, 0x26, 0x35,
+	0xda, 0x4b, 0x5f, 0x91, 0x3e, 0x2e, 0x0a, 0x81, 0x8f, 0xfe, 0x19, 0x00, 0xc2, 0x92, 0x24, 0xfc,
+	0xc5, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -518,6 +683,20 @@ func (m *JobServiceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RunHistory) > 0 {
+		for iNdEx := len(m.RunHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RunHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintJobservice(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Error) > 0 {
 		i -= len(m.Error)
 		copy(dAtA[i:], m.Error)
@@ -533,6 +712,91 @@ func (m *JobServiceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *JobRunAttempt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobRunAttempt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobRunAttempt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExitReason) > 0 {
+		i -= len(m.ExitReason)
+		copy(dAtA[i:], m.ExitReason)
+		i = encodeVarintJobservice(dAtA, i, uint64(len(m.ExitReason)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Outcome != 0 {
+		i = encodeVarintJobservice(dAtA, i, uint64(m.Outcome))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Finished != nil {
+		{
+			size, err := m.Finished.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintJobservice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Started != nil {
+		{
+			size, err := m.Started.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintJobservice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Leased != nil {
+		{
+			size, err := m.Leased.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintJobservice(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.NodeName) > 0 {
+		i -= len(m.NodeName)
+		copy(dAtA[i:], m.NodeName)
+		i = encodeVarintJobservice(dAtA, i, uint64(len(m.NodeName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClusterId) > 0 {
+		i -= len(m.ClusterId)
+		copy(dAtA[i:], m.ClusterId)
+		i = encodeVarintJobservice(dAtA, i, uint64(len(m.ClusterId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintJobservice(dAtA []byte, offset int, v uint64) int {
 	offset -= sovJobservice(v)
 	base := offset
@@ -590,6 +854,48 @@ func (m *JobServiceResponse) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovJobservice(uint64(l))
 	}
+	if len(m.RunHistory) > 0 {
+		for _, e := range m.RunHistory {
+			l = e.Size()
+			n += 1 + l + sovJobservice(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *JobRunAttempt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClusterId)
+	if l > 0 {
+		n += 1 + l + sovJobservice(uint64(l))
+	}
+	l = len(m.NodeName)
+	if l > 0 {
+		n += 1 + l + sovJobservice(uint64(l))
+	}
+	if m.Leased != nil {
+		l = m.Leased.Size()
+		n += 1 + l + sovJobservice(uint64(l))
+	}
+	if m.Started != nil {
+		l = m.Started.Size()
+		n += 1 + l + sovJobservice(uint64(l))
+	}
+	if m.Finished != nil {
+		l = m.Finished.Size()
+		n += 1 + l + sovJobservice(uint64(l))
+	}
+	if m.Outcome != 0 {
+		n += 1 + sovJobservice(uint64(m.Outcome))
+	}
+	l = len(m.ExitReason)
+	if l > 0 {
+		n += 1 + l + sovJobservice(uint64(l))
+	}
 	return n
 }
 
@@ -894,6 +1200,313 @@ func (m *JobServiceResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthJobservice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RunHistory = append(m.RunHistory, &JobRunAttempt{})
+			if err := m.RunHistory[len(m.RunHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipJobservice(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthJobservice
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobRunAttempt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowJobservice
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobRunAttempt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobRunAttempt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJobservice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJobservice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Leased", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthJobservice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Leased == nil {
+				m.Leased = &types.Timestamp{}
+			}
+			if err := m.Leased.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Started", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthJobservice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Started == nil {
+				m.Started = &types.Timestamp{}
+			}
+			if err := m.Started.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finished", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthJobservice
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Finished == nil {
+				m.Finished = &types.Timestamp{}
+			}
+			if err := m.Finished.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outcome", wireType)
+			}
+			m.Outcome = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Outcome |= JobRunAttempt_Outcome(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowJobservice
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthJobservice
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthJobservice
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExitReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipJobservice(dAtA[iNdEx:])
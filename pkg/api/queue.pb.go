@@ -81,6 +81,24 @@ type Job struct {
 	Scheduler string `protobuf:"bytes,20,opt,name=scheduler,proto3" json:"scheduler,omitempty"`
 	// Queuing TTL for this job in seconds. If this job queues for more than this duration it will be cancelled. Zero indicates an infinite lifetime.
 	QueueTtlSeconds int64 `protobuf:"varint,22,opt,name=queue_ttl_seconds,json=queueTtlSeconds,proto3" json:"queueTtlSeconds,omitempty"`
+	// If set, the pod specs of this job are too large to be stored with the job and are instead stored in object storage under this key.
+	// Set by the server when storing the job; pod specs are restored when the job is read.
+	PodSpecsObjectKey string `protobuf:"bytes,23,opt,name=pod_specs_object_key,json=podSpecsObjectKey,proto3" json:"podSpecsObjectKey,omitempty"`
+	// Number of failed runs of this job that were retried as per its retry policy.
+	FailedAttempts uint32 `protobuf:"varint,24,opt,name=failed_attempts,json=failedAttempts,proto3" json:"failedAttempts,omitempty"`
+	// Set by the server once a run of this job failed and is to be retried, until the job is returned to the queue.
+	RetryPending bool `protobuf:"varint,25,opt,name=retry_pending,json=retryPending,proto3" json:"retryPending,omitempty"`
+	// If set, this job is a member of the job array with this id. Set by the server for jobs submitted as part of a JobArray.
+	// The pod specs of the members of an array are stored once, shared by all members.
+	JobArrayId string `protobuf:"bytes,26,opt,name=job_array_id,json=jobArrayId,proto3" json:"jobArrayId,omitempty"`
+	// Index of this job in its job array.
+	JobArrayIndex uint32 `protobuf:"varint,27,opt,name=job_array_index,json=jobArrayIndex,proto3" json:"jobArrayIndex,omitempty"`
+	// If set, the pod specs of this job are stored once for all jobs with identical pod specs, under this hash,
+	// rather than with the job. Set by the server when storing the job.
+	PodSpecsHash string `protobuf:"bytes,28,opt,name=pod_specs_hash,json=podSpecsHash,proto3" json:"podSpecsHash,omitempty"`
+	// If set, the compressed ownership groups of this job are stored once for all jobs with identical groups, under this hash,
+	// rather than with the job. Set by the server when storing the job; groups are restored when the job is leased.
+	OwnershipGroupsHash string `protobuf:"bytes,29,opt,name=ownership_groups_hash,json=ownershipGroupsHash,proto3" json:"ownershipGroupsHash,omitempty"`
 }
 
 func (m *Job) Reset()      { *m = Job{} }
@@ -271,6 +289,55 @@ func (m *Job) GetQueueTtlSeconds() int64 {
 	return 0
 }
 
+func (m *Job) GetPodSpecsObjectKey() string {
+	if m != nil {
+		return m.PodSpecsObjectKey
+	}
+	return ""
+}
+
+func (m *Job) GetFailedAttempts() uint32 {
+	if m != nil {
+		return m.FailedAttempts
+	}
+	return 0
+}
+
+func (m *Job) GetRetryPending() bool {
+	if m != nil {
+		return m.RetryPending
+	}
+	return false
+}
+
+func (m *Job) GetJobArrayId() string {
+	if m != nil {
+		return m.JobArrayId
+	}
+	return ""
+}
+
+func (m *Job) GetJobArrayIndex() uint32 {
+	if m != nil {
+		return m.JobArrayIndex
+	}
+	return 0
+}
+
+func (m *Job) GetPodSpecsHash() string {
+	if m != nil {
+		return m.PodSpecsHash
+	}
+	return ""
+}
+
+func (m *Job) GetOwnershipGroupsHash() string {
+	if m != nil {
+		return m.OwnershipGroupsHash
+	}
+	return ""
+}
+
 // For the bidirectional streaming job lease request service.
 // For the first message, populate all fields except SubmittedJobs, which should be empty.
 // For subsequent messages, these fields may be left empty, in which case the last non-zero value received is used.
@@ -293,6 +360,12 @@ type StreamingLeaseRequest struct {
 	Nodes []NodeInfo `protobuf:"bytes,6,rep,name=nodes,proto3" json:"nodes"`
 	// Ids of received jobs. Used to ack received jobs.
 	ReceivedJobIds []string `protobuf:"bytes,7,rep,name=ReceivedJobIds,proto3" json:"ReceivedJobIds,omitempty"`
+	// Service accounts of the cluster, indexed by namespace. Only reported by executors configured to do so.
+	ServiceAccounts map[string]*ServiceAccounts `protobuf:"bytes,8,rep,name=service_accounts,json=serviceAccounts,proto3" json:"serviceAccounts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Release version of the executor, if known.
+	ExecutorVersion string `protobuf:"bytes,9,opt,name=executor_version,json=executorVersion,proto3" json:"executorVersion,omitempty"`
+	// Names of the secrets and config maps of the cluster, indexed by namespace. Only reported by executors configured to do so.
+	SecretsAndConfigMaps map[string]*SecretsAndConfigMaps `protobuf:"bytes,10,rep,name=secrets_and_config_maps,json=secretsAndConfigMaps,proto3" json:"secretsAndConfigMaps,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (m *StreamingLeaseRequest) Reset()      { *m = StreamingLeaseRequest{} }
@@ -376,6 +449,121 @@ func (m *StreamingLeaseRequest) GetReceivedJobIds() []string {
 	return nil
 }
 
+func (m *StreamingLeaseRequest) GetServiceAccounts() map[string]*ServiceAccounts {
+	if m != nil {
+		return m.ServiceAccounts
+	}
+	return nil
+}
+
+func (m *StreamingLeaseRequest) GetExecutorVersion() string {
+	if m != nil {
+		return m.ExecutorVersion
+	}
+	return ""
+}
+
+func (m *StreamingLeaseRequest) GetSecretsAndConfigMaps() map[string]*SecretsAndConfigMaps {
+	if m != nil {
+		return m.SecretsAndConfigMaps
+	}
+	return nil
+}
+
+type ServiceAccounts struct {
+	Names []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+}
+
+func (m *ServiceAccounts) Reset()      { *m = ServiceAccounts{} }
+func (*ServiceAccounts) ProtoMessage() {}
+func (*ServiceAccounts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d92c0c680df9617a, []int{2}
+}
+func (m *ServiceAccounts) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ServiceAccounts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ServiceAccounts.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ServiceAccounts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ServiceAccounts.Merge(m, src)
+}
+func (m *ServiceAccounts) XXX_Size() int {
+	return m.Size()
+}
+func (m *ServiceAccounts) XXX_DiscardUnknown() {
+	xxx_messageInfo_ServiceAccounts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ServiceAccounts proto.InternalMessageInfo
+
+func (m *ServiceAccounts) GetNames() []string {
+	if m != nil {
+		return m.Names
+	}
+	return nil
+}
+
+type SecretsAndConfigMaps struct {
+	SecretNames    []string `protobuf:"bytes,1,rep,name=secret_names,json=secretNames,proto3" json:"secretNames,omitempty"`
+	ConfigMapNames []string `protobuf:"bytes,2,rep,name=config_map_names,json=configMapNames,proto3" json:"configMapNames,omitempty"`
+}
+
+func (m *SecretsAndConfigMaps) Reset()      { *m = SecretsAndConfigMaps{} }
+func (*SecretsAndConfigMaps) ProtoMessage() {}
+func (*SecretsAndConfigMaps) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d92c0c680df9617a, []int{3}
+}
+func (m *SecretsAndConfigMaps) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SecretsAndConfigMaps) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SecretsAndConfigMaps.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SecretsAndConfigMaps) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SecretsAndConfigMaps.Merge(m, src)
+}
+func (m *SecretsAndConfigMaps) XXX_Size() int {
+	return m.Size()
+}
+func (m *SecretsAndConfigMaps) XXX_DiscardUnknown() {
+	xxx_messageInfo_SecretsAndConfigMaps.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SecretsAndConfigMaps proto.InternalMessageInfo
+
+func (m *SecretsAndConfigMaps) GetSecretNames() []string {
+	if m != nil {
+		return m.SecretNames
+	}
+	return nil
+}
+
+func (m *SecretsAndConfigMaps) GetConfigMapNames() []string {
+	if m != nil {
+		return m.ConfigMapNames
+	}
+	return nil
+}
+
 // Used by the scheduler when allocating jobs to executors.
 type NodeInfo struct {
 	Name   string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -412,7 +600,7 @@ type NodeInfo struct {
 func (m *NodeInfo) Reset()      { *m = NodeInfo{} }
 func (*NodeInfo) ProtoMessage() {}
 func (*NodeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d92c0c680df9617a, []int{2}
+	return fileDescriptor_d92c0c680df9617a, []int{4}
 }
 func (m *NodeInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -532,12 +720,16 @@ type NodeType struct {
 	Taints               []v1.Taint                   `protobuf:"bytes,1,rep,name=taints,proto3" json:"taints"`
 	Labels               map[string]string            `protobuf:"bytes,2,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	AllocatableResources map[string]resource.Quantity `protobuf:"bytes,3,rep,name=allocatable_resources,json=allocatableResources,proto3" json:"allocatableResources" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Total resources of all nodes of this type.
+	Capacity map[string]resource.Quantity `protobuf:"bytes,4,rep,name=capacity,proto3" json:"capacity" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Number of nodes of this type.
+	NodeCount int32 `protobuf:"varint,5,opt,name=node_count,json=nodeCount,proto3" json:"nodeCount,omitempty"`
 }
 
 func (m *NodeType) Reset()      { *m = NodeType{} }
 func (*NodeType) ProtoMessage() {}
 func (*NodeType) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d92c0c680df9617a, []int{3}
+	return fileDescriptor_d92c0c680df9617a, []int{5}
 }
 func (m *NodeType) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -587,6 +779,20 @@ func (m *NodeType) GetAllocatableResources() map[string]resource.Quantity {
 	return nil
 }
 
+func (m *NodeType) GetCapacity() map[string]resource.Quantity {
+	if m != nil {
+		return m.Capacity
+	}
+	return nil
+}
+
+func (m *NodeType) GetNodeCount() int32 {
+	if m != nil {
+		return m.NodeCount
+	}
+	return 0
+}
+
 // Used to store last info in Redis
 type ClusterSchedulingInfoReport struct {
 	ClusterId      string                       `protobuf:"bytes,1,opt,name=cluster_id,json=clusterId,proto3" json:"clusterId,omitempty"`
@@ -594,12 +800,16 @@ type ClusterSchedulingInfoReport struct {
 	ReportTime     time.Time                    `protobuf:"bytes,2,opt,name=report_time,json=reportTime,proto3,stdtime" json:"reportTime"`
 	NodeTypes      []*NodeType                  `protobuf:"bytes,5,rep,name=node_types,json=nodeTypes,proto3" json:"nodeTypes,omitempty"`
 	MinimumJobSize map[string]resource.Quantity `protobuf:"bytes,6,rep,name=minimum_job_size,json=minimumJobSize,proto3" json:"minimumJobSize" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Service accounts of the cluster, indexed by namespace. Empty if the executor doesn't report service accounts.
+	ServiceAccounts map[string]*ServiceAccounts `protobuf:"bytes,8,rep,name=service_accounts,json=serviceAccounts,proto3" json:"serviceAccounts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Names of the secrets and config maps of the cluster, indexed by namespace. Empty if the executor doesn't report them.
+	SecretsAndConfigMaps map[string]*SecretsAndConfigMaps `protobuf:"bytes,9,rep,name=secrets_and_config_maps,json=secretsAndConfigMaps,proto3" json:"secretsAndConfigMaps,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (m *ClusterSchedulingInfoReport) Reset()      { *m = ClusterSchedulingInfoReport{} }
 func (*ClusterSchedulingInfoReport) ProtoMessage() {}
 func (*ClusterSchedulingInfoReport) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d92c0c680df9617a, []int{4}
+	return fileDescriptor_d92c0c680df9617a, []int{6}
 }
 func (m *ClusterSchedulingInfoReport) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -663,6 +873,20 @@ func (m *ClusterSchedulingInfoReport) GetMinimumJobSize() map[string]resource.Qu
 	return nil
 }
 
+func (m *ClusterSchedulingInfoReport) GetServiceAccounts() map[string]*ServiceAccounts {
+	if m != nil {
+		return m.ServiceAccounts
+	}
+	return nil
+}
+
+func (m *ClusterSchedulingInfoReport) GetSecretsAndConfigMaps() map[string]*SecretsAndConfigMaps {
+	if m != nil {
+		return m.SecretsAndConfigMaps
+	}
+	return nil
+}
+
 type QueueLeasedReport struct {
 	// Queue name.
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -676,7 +900,7 @@ type QueueLeasedReport struct {
 func (m *QueueLeasedReport) Reset()      { *m = QueueLeasedReport{} }
 func (*QueueLeasedReport) ProtoMessage() {}
 func (*QueueLeasedReport) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d92c0c680df9617a, []int{5}
+	return fileDescriptor_d92c0c680df9617a, []int{7}
 }
 func (m *QueueLeasedReport) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -736,7 +960,7 @@ type ClusterLeasedReport struct {
 func (m *ClusterLeasedReport) Reset()      { *m = ClusterLeasedReport{} }
 func (*ClusterLeasedReport) ProtoMessage() {}
 func (*ClusterLeasedReport) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d92c0c680df9617a, []int{6}
+	return fileDescriptor_d92c0c680df9617a, []int{8}
 }
 func (m *ClusterLeasedReport) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -793,7 +1017,7 @@ type ComputeResource struct {
 func (m *ComputeResource) Reset()      { *m = ComputeResource{} }
 func (*ComputeResource) ProtoMessage() {}
 func (*ComputeResource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d92c0c680df9617a, []int{7}
+	return fileDescriptor_d92c0c680df9617a, []int{9}
 }
 func (m *ComputeResource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -836,7 +1060,7 @@ type NodeLabeling struct {
 func (m *NodeLabeling) Reset()      { *m = NodeLabeling{} }
 func (*NodeLabeling) ProtoMessage() {}
 func (*NodeLabeling) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d92c0c680df9617a, []int{8}
+	return fileDescriptor_d92c0c680df9617a, []int{10}
 }
 func (m *NodeLabeling) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -879,7 +1103,7 @@ type JobLease struct {
 func (m *JobLease) Reset()      { *m = JobLease{} }
 func (*JobLease) ProtoMessage() {}
 func (*JobLease) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d92c0c680df9617a, []int{9}
+	return fileDescriptor_d92c0c680df9617a, []int{11}
 }
 func (m *JobLease) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -927,7 +1151,7 @@ type StreamingJobLease struct {
 func (m *StreamingJobLease) Reset()      { *m = StreamingJobLease{} }
 func (*StreamingJobLease) ProtoMessage() {}
 func (*StreamingJobLease) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d92c0c680df9617a, []int{10}
+	return fileDescriptor_d92c0c680df9617a, []int{12}
 }
 func (m *StreamingJobLease) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -984,7 +1208,7 @@ type IdList struct {
 func (m *IdList) Reset()      { *m = IdList{} }
 func (*IdList) ProtoMessage() {}
 func (*IdList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d92c0c680df9617a, []int{11}
+	return fileDescriptor_d92c0c680df9617a, []int{13}
 }
 func (m *IdList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1028,7 +1252,7 @@ type RenewLeaseRequest struct {
 func (m *RenewLeaseRequest) Reset()      { *m = RenewLeaseRequest{} }
 func (*RenewLeaseRequest) ProtoMessage() {}
 func (*RenewLeaseRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d92c0c680df9617a, []int{12}
+	return fileDescriptor_d92c0c680df9617a, []int{14}
 }
 func (m *RenewLeaseRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1085,7 +1309,7 @@ type ReturnLeaseRequest struct {
 func (m *ReturnLeaseRequest) Reset()      { *m = ReturnLeaseRequest{} }
 func (*ReturnLeaseRequest) ProtoMessage() {}
 func (*ReturnLeaseRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d92c0c680df9617a, []int{13}
+	return fileDescriptor_d92c0c680df9617a, []int{15}
 }
 func (m *ReturnLeaseRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1171,7 +1395,7 @@ type StringKeyValuePair struct {
 func (m *StringKeyValuePair) Reset()      { *m = StringKeyValuePair{} }
 func (*StringKeyValuePair) ProtoMessage() {}
 func (*StringKeyValuePair) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d92c0c680df9617a, []int{14}
+	return fileDescriptor_d92c0c680df9617a, []int{16}
 }
 func (m *StringKeyValuePair) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1221,7 +1445,7 @@ type OrderedStringMap struct {
 func (m *OrderedStringMap) Reset()      { *m = OrderedStringMap{} }
 func (*OrderedStringMap) ProtoMessage() {}
 func (*OrderedStringMap) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d92c0c680df9617a, []int{15}
+	return fileDescriptor_d92c0c680df9617a, []int{17}
 }
 func (m *OrderedStringMap) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1265,6 +1489,10 @@ func init() {
 	proto.RegisterType((*StreamingLeaseRequest)(nil), "api.StreamingLeaseRequest")
 	proto.RegisterMapType((map[string]resource.Quantity)(nil), "api.StreamingLeaseRequest.MinimumJobSizeEntry")
 	proto.RegisterMapType((map[string]resource.Quantity)(nil), "api.StreamingLeaseRequest.ResourcesEntry")
+	proto.RegisterMapType((map[string]*SecretsAndConfigMaps)(nil), "api.StreamingLeaseRequest.SecretsAndConfigMapsEntry")
+	proto.RegisterMapType((map[string]*ServiceAccounts)(nil), "api.StreamingLeaseRequest.ServiceAccountsEntry")
+	proto.RegisterType((*ServiceAccounts)(nil), "api.ServiceAccounts")
+	proto.RegisterType((*SecretsAndConfigMaps)(nil), "api.SecretsAndConfigMaps")
 	proto.RegisterType((*NodeInfo)(nil), "api.NodeInfo")
 	proto.RegisterMapType((map[string]resource.Quantity)(nil), "api.NodeInfo.AllocatableResourcesEntry")
 	proto.RegisterMapType((map[int32]ComputeResource)(nil), "api.NodeInfo.AllocatedResourcesEntry")
@@ -1276,9 +1504,12 @@ func init() {
 	proto.RegisterMapType((map[string]resource.Quantity)(nil), "api.NodeInfo.TotalResourcesEntry")
 	proto.RegisterType((*NodeType)(nil), "api.NodeType")
 	proto.RegisterMapType((map[string]resource.Quantity)(nil), "api.NodeType.AllocatableResourcesEntry")
+	proto.RegisterMapType((map[string]resource.Quantity)(nil), "api.NodeType.CapacityEntry")
 	proto.RegisterMapType((map[string]string)(nil), "api.NodeType.LabelsEntry")
 	proto.RegisterType((*ClusterSchedulingInfoReport)(nil), "api.ClusterSchedulingInfoReport")
 	proto.RegisterMapType((map[string]resource.Quantity)(nil), "api.ClusterSchedulingInfoReport.MinimumJobSizeEntry")
+	proto.RegisterMapType((map[string]*SecretsAndConfigMaps)(nil), "api.ClusterSchedulingInfoReport.SecretsAndConfigMapsEntry")
+	proto.RegisterMapType((map[string]*ServiceAccounts)(nil), "api.ClusterSchedulingInfoReport.ServiceAccountsEntry")
 	proto.RegisterType((*QueueLeasedReport)(nil), "api.QueueLeasedReport")
 	proto.RegisterMapType((map[int32]ComputeResource)(nil), "api.QueueLeasedReport.ResourcesLeasedByPriorityEntry")
 	proto.RegisterMapType((map[string]resource.Quantity)(nil), "api.QueueLeasedReport.ResourcesLeasedEntry")
@@ -1300,163 +1531,193 @@ func init() {
 func init() { proto.RegisterFile("pkg/api/queue.proto", fileDescriptor_d92c0c680df9617a) }
 
 var fileDescriptor_d92c0c680df9617a = []byte{
-	// 2489 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0xcb, 0x6f, 0x1c, 0xc7,
-	0xd1, 0xd7, 0xf0, 0xb1, 0x5c, 0x16, 0xdf, 0xcd, 0xd7, 0x70, 0x29, 0xef, 0xae, 0xd7, 0xf8, 0x64,
-	0xfa, 0x8b, 0xb4, 0xb4, 0x68, 0x39, 0x50, 0x72, 0x88, 0xc1, 0x95, 0x14, 0x67, 0x65, 0xd9, 0x92,
-	0x87, 0xb4, 0x81, 0x18, 0x06, 0x46, 0xb3, 0x3b, 0xad, 0x55, 0x93, 0xbb, 0xd3, 0xe3, 0x79, 0x50,
-	0x58, 0x9d, 0x02, 0x27, 0x01, 0x82, 0x20, 0x07, 0x1f, 0x02, 0x24, 0x36, 0x10, 0xe4, 0x18, 0x20,
-	0x40, 0xfe, 0x85, 0x9c, 0x7d, 0xf4, 0x2d, 0xbe, 0x64, 0x9d, 0x48, 0x97, 0x60, 0x8f, 0x39, 0xe6,
-	0x10, 0x04, 0xfd, 0x98, 0xd9, 0x9e, 0xd9, 0x59, 0x92, 0x8e, 0x28, 0x81, 0x87, 0x9c, 0xc8, 0xae,
-	0xaa, 0xae, 0xaa, 0xae, 0xee, 0xfe, 0x55, 0x55, 0xcf, 0xc2, 0xb2, 0x7b, 0xd8, 0xda, 0xb6, 0x5c,
-	0xb2, 0xfd, 0x49, 0x88, 0x43, 0x5c, 0x75, 0x3d, 0x1a, 0x50, 0x34, 0x6e, 0xb9, 0xa4, 0x50, 0x6a,
-	0x51, 0xda, 0x6a, 0xe3, 0x6d, 0x4e, 0x6a, 0x84, 0x0f, 0xb6, 0x03, 0xd2, 0xc1, 0x7e, 0x60, 0x75,
-	0x5c, 0x21, 0x55, 0xa8, 0x1c, 0x5e, 0xf7, 0xab, 0x84, 0xf2, 0xd9, 0x4d, 0xea, 0xe1, 0xed, 0xa3,
-	0xab, 0xdb, 0x2d, 0xec, 0x60, 0xcf, 0x0a, 0xb0, 0x2d, 0x65, 0xb6, 0x14, 0x19, 0x07, 0x07, 0x8f,
-	0xa8, 0x77, 0x48, 0x9c, 0x56, 0x96, 0xe4, 0xb5, 0x81, 0x64, 0xc7, 0x6a, 0x3e, 0x24, 0x0e, 0xf6,
-	0xba, 0xdb, 0x91, 0x73, 0x1e, 0xf6, 0x69, 0xe8, 0x35, 0xf1, 0xd0, 0xac, 0x2b, 0x2d, 0x12, 0x3c,
-	0x0c, 0x1b, 0xd5, 0x26, 0xed, 0x6c, 0xb7, 0x68, 0x8b, 0x0e, 0xbc, 0x65, 0x23, 0x3e, 0xe0, 0xff,
-	0x49, 0xf1, 0xcd, 0xf4, 0x9a, 0x70, 0xc7, 0x0d, 0xba, 0x92, 0xb9, 0x12, 0x59, 0xf3, 0xc3, 0x46,
-	0x87, 0x04, 0x82, 0x5a, 0xf9, 0x66, 0x01, 0xc6, 0x6f, 0xd3, 0x06, 0x2a, 0xc3, 0x18, 0xb1, 0x75,
-	0xad, 0xac, 0x6d, 0x4d, 0xd7, 0x16, 0xfb, 0xbd, 0xd2, 0x2c, 0xb1, 0x2f, 0xd3, 0x0e, 0x09, 0xb8,
-	0x06, 0x63, 0x8c, 0xd8, 0xe8, 0x0d, 0x98, 0x6e, 0xb6, 0x09, 0x76, 0x02, 0x93, 0xd8, 0xfa, 0x1c,
-	0x17, 0x5c, 0xeb, 0xf7, 0x4a, 0x48, 0x10, 0xeb, 0xaa, 0x78, 0x3e, 0xa2, 0xa1, 0x6b, 0x00, 0x07,
-	0xb4, 0x61, 0xfa, 0x98, 0xcf, 0x1a, 0x1b, 0xcc, 0x3a, 0xa0, 0x8d, 0x3d, 0x9c, 0x9a, 0x15, 0xd1,
-	0xd0, 0x6b, 0x30, 0xc9, 0xf7, 0x4b, 0x1f, 0xe7, 0x13, 0x96, 0xfb, 0xbd, 0xd2, 0x02, 0x27, 0x28,
-	0xd2, 0x42, 0x02, 0xbd, 0x09, 0xd3, 0x8e, 0xd5, 0xc1, 0xbe, 0x6b, 0x35, 0xb1, 0x3e, 0xc5, 0xc5,
-	0xd7, 0xfb, 0xbd, 0xd2, 0x72, 0x4c, 0x54, 0xa6, 0x0c, 0x24, 0x51, 0x0d, 0x72, 0x6d, 0xab, 0x81,
-	0xdb, 0xbe, 0x3e, 0x5d, 0x1e, 0xdf, 0x9a, 0xd9, 0x59, 0xa9, 0x5a, 0x2e, 0xa9, 0xde, 0xa6, 0x8d,
-	0xea, 0x1d, 0x4e, 0xbe, 0xe5, 0x04, 0x5e, 0xb7, 0xb6, 0xd2, 0xef, 0x95, 0x16, 0x85, 0x9c, 0xa2,
-	0x46, 0xce, 0x44, 0x1f, 0xc2, 0x8c, 0xe5, 0x38, 0x34, 0xb0, 0x02, 0x42, 0x1d, 0x5f, 0x07, 0xae,
-	0x68, 0x23, 0x56, 0xb4, 0x3b, 0xe0, 0x09, 0x6d, 0x1b, 0xfd, 0x5e, 0x69, 0x55, 0x99, 0xa1, 0xa8,
-	0x54, 0x15, 0xa1, 0x23, 0x58, 0xf1, 0xf0, 0x27, 0x21, 0xf1, 0xb0, 0x6d, 0x3a, 0xd4, 0xc6, 0xa6,
-	0xf4, 0x74, 0x86, 0x1b, 0x28, 0xc7, 0x06, 0x0c, 0x29, 0xf4, 0x1e, 0xb5, 0xb1, 0xea, 0x75, 0xa5,
-	0xdf, 0x2b, 0x5d, 0xf4, 0x86, 0x98, 0x03, 0x73, 0xba, 0x66, 0xa0, 0x61, 0x3e, 0x8b, 0x3a, 0x7d,
-	0xe4, 0x60, 0x4f, 0xcf, 0x0f, 0xa2, 0xce, 0x09, 0x6a, 0xd4, 0x39, 0x01, 0x61, 0xd8, 0xe4, 0xe1,
-	0x37, 0xf9, 0xd0, 0x7f, 0x48, 0x5c, 0x33, 0xf4, 0xb1, 0x67, 0xb6, 0x3c, 0x1a, 0xba, 0xbe, 0xbe,
-	0x50, 0x1e, 0xdf, 0x9a, 0xae, 0x5d, 0xea, 0xf7, 0x4a, 0x15, 0x2e, 0x76, 0x37, 0x92, 0xfa, 0xc0,
-	0xc7, 0xde, 0xdb, 0x5c, 0x46, 0xd1, 0xa9, 0x8f, 0x92, 0x41, 0x3f, 0xd3, 0xe0, 0x52, 0x93, 0x76,
-	0x5c, 0x0f, 0xfb, 0x3e, 0xb6, 0xcd, 0xe3, 0x4c, 0x2e, 0x97, 0xb5, 0xad, 0xd9, 0xda, 0xeb, 0xfd,
-	0x5e, 0xe9, 0xf2, 0x60, 0xc6, 0xfb, 0x27, 0x1b, 0xaf, 0x9c, 0x2c, 0x8d, 0x76, 0x20, 0xef, 0x7a,
-	0x84, 0x7a, 0x24, 0xe8, 0xea, 0x13, 0x65, 0x6d, 0x4b, 0x13, 0x47, 0x38, 0xa2, 0xa9, 0x47, 0x38,
-	0xa2, 0xa1, 0xbb, 0x90, 0x77, 0xa9, 0x6d, 0xfa, 0x2e, 0x6e, 0xea, 0x93, 0x65, 0x6d, 0x6b, 0x66,
-	0x67, 0xb3, 0x2a, 0x20, 0x80, 0xef, 0x1f, 0x03, 0x94, 0xea, 0xd1, 0xd5, 0xea, 0x3d, 0x6a, 0xef,
-	0xb9, 0xb8, 0xc9, 0xcf, 0xec, 0x92, 0x2b, 0x06, 0x89, 0x8d, 0x9a, 0x92, 0x44, 0x74, 0x0f, 0xa6,
-	0x23, 0x85, 0xbe, 0x3e, 0xcb, 0x8f, 0xc2, 0xb1, 0x1a, 0x85, 0x8b, 0x62, 0xe0, 0x27, 0x5c, 0x94,
-	0x34, 0xf4, 0x85, 0x06, 0x65, 0xbf, 0xf9, 0x10, 0xdb, 0x61, 0x9b, 0x38, 0x2d, 0x33, 0x02, 0x21,
-	0x53, 0x1e, 0x8d, 0x0e, 0x76, 0x02, 0x5f, 0x5f, 0xe5, 0xbe, 0x6f, 0x65, 0x59, 0x32, 0xe4, 0x04,
-	0x43, 0x91, 0xaf, 0x5d, 0xfa, 0xb2, 0x57, 0xba, 0xd0, 0xef, 0x95, 0x8a, 0x03, 0xcd, 0x59, 0x72,
-	0xc6, 0x09, 0x7c, 0x54, 0x87, 0xa9, 0xa6, 0x87, 0x19, 0x14, 0xea, 0x39, 0xee, 0x42, 0xa1, 0x2a,
-	0xc0, 0xad, 0x1a, 0x81, 0x5b, 0x75, 0x3f, 0x02, 0xec, 0xda, 0xb2, 0x34, 0x1a, 0x4d, 0xf9, 0xec,
-	0x9b, 0x92, 0x66, 0x44, 0x03, 0x74, 0x03, 0xa6, 0x88, 0xd3, 0x62, 0x7b, 0xac, 0xcf, 0xf3, 0xb8,
-	0x21, 0xbe, 0x8c, 0xba, 0xa0, 0xdd, 0xa0, 0xce, 0x03, 0xd2, 0xaa, 0xad, 0xb2, 0x0d, 0x90, 0x62,
-	0x4a, 0xb4, 0xa2, 0x99, 0xe8, 0x87, 0x90, 0xf7, 0xb1, 0x77, 0x44, 0x9a, 0xd8, 0xd7, 0x17, 0x15,
-	0x2d, 0x7b, 0x82, 0x28, 0xb5, 0xf0, 0xa0, 0x47, 0x72, 0x6a, 0xd0, 0x23, 0x1a, 0xfa, 0x18, 0x66,
-	0x0e, 0xaf, 0xfb, 0x66, 0xe4, 0xd0, 0x12, 0x57, 0xf5, 0xb2, 0x1a, 0xde, 0x41, 0x1e, 0x61, 0x41,
-	0x96, 0x5e, 0xd6, 0xf4, 0x7e, 0xaf, 0xb4, 0x72, 0x78, 0xdd, 0xaf, 0x0f, 0xb9, 0x08, 0x03, 0x2a,
-	0x83, 0x24, 0xa6, 0x5d, 0x5a, 0xd3, 0xd1, 0xe8, 0x63, 0x22, 0xfd, 0x8e, 0xf5, 0xca, 0x71, 0x4a,
-	0xaf, 0xa4, 0x32, 0x94, 0x95, 0xfb, 0x85, 0x3d, 0x7d, 0x65, 0x80, 0xb2, 0x31, 0x51, 0x45, 0xd9,
-	0x98, 0x88, 0xea, 0xb0, 0x24, 0xee, 0x6c, 0x10, 0xb4, 0x4d, 0x1f, 0x37, 0xa9, 0x63, 0xfb, 0xfa,
-	0x5a, 0x59, 0xdb, 0x1a, 0xaf, 0xbd, 0xd4, 0xef, 0x95, 0x36, 0x38, 0x73, 0x3f, 0x68, 0xef, 0x09,
-	0x96, 0xa2, 0x64, 0x21, 0xc5, 0x2a, 0x58, 0x30, 0xa3, 0x60, 0x1c, 0x7a, 0x05, 0xc6, 0x0f, 0x71,
-	0x57, 0xe6, 0xab, 0xa5, 0x7e, 0xaf, 0x34, 0x77, 0x88, 0xd5, 0x8b, 0xc8, 0xb8, 0x0c, 0xd0, 0x8e,
-	0xac, 0x76, 0x88, 0x65, 0xde, 0xe1, 0x80, 0xc6, 0x09, 0x2a, 0xa0, 0x71, 0xc2, 0xf7, 0xc7, 0xae,
-	0x6b, 0x85, 0x07, 0xb0, 0x98, 0xc6, 0xec, 0xe7, 0x62, 0xa7, 0x03, 0xeb, 0x23, 0xa0, 0xfb, 0x79,
-	0x98, 0xab, 0xfc, 0x35, 0x07, 0xab, 0x7b, 0x81, 0x87, 0xad, 0x0e, 0x71, 0x5a, 0x77, 0xb0, 0xe5,
-	0xf3, 0x8b, 0x86, 0xfd, 0x00, 0x7d, 0x17, 0xa0, 0xd9, 0x0e, 0xfd, 0x00, 0x7b, 0x66, 0x9c, 0xfb,
-	0xf9, 0xb6, 0x4a, 0x6a, 0x22, 0x3b, 0x4f, 0xc7, 0x44, 0x74, 0x09, 0x26, 0x5c, 0x4a, 0xdb, 0xd2,
-	0x3e, 0xea, 0xf7, 0x4a, 0xf3, 0x6c, 0xac, 0x08, 0x73, 0x3e, 0xfa, 0x08, 0xa6, 0x23, 0x50, 0xf1,
-	0xf5, 0x71, 0x7e, 0x16, 0x5f, 0x13, 0x97, 0x26, 0xcb, 0x9d, 0x18, 0x4f, 0x64, 0x1a, 0x5b, 0x92,
-	0x97, 0x7a, 0xa0, 0xc3, 0x18, 0xfc, 0x8b, 0x08, 0xac, 0x46, 0xbe, 0xb7, 0x99, 0x12, 0xdb, 0xf4,
-	0xb0, 0x4b, 0xbd, 0x80, 0x03, 0xf4, 0xcc, 0x8e, 0xce, 0xed, 0xdc, 0x10, 0x12, 0xdc, 0x8a, 0x6d,
-	0x70, 0x7e, 0x6d, 0x53, 0xaa, 0x8d, 0x16, 0xa9, 0x32, 0x8d, 0x2c, 0x22, 0x72, 0x61, 0xb1, 0x43,
-	0x1c, 0xd2, 0x09, 0x3b, 0x26, 0xaf, 0x65, 0xc8, 0x63, 0xac, 0x4f, 0xf2, 0xd5, 0x54, 0x8f, 0x59,
-	0xcd, 0xbb, 0x62, 0xca, 0x6d, 0xda, 0xd8, 0x23, 0x8f, 0xb1, 0x58, 0xd2, 0x9a, 0xb4, 0x3d, 0xdf,
-	0x49, 0x30, 0x8d, 0xd4, 0x18, 0xed, 0xc0, 0x24, 0x4b, 0xfc, 0xbe, 0x9e, 0xe3, 0x66, 0xe6, 0xb8,
-	0x19, 0x76, 0x56, 0xea, 0xce, 0x03, 0x5a, 0x9b, 0x93, 0x5a, 0x84, 0x8c, 0x21, 0xfe, 0xa0, 0x9b,
-	0x30, 0x6f, 0xe0, 0x26, 0x26, 0x47, 0xd8, 0xbe, 0x4d, 0x1b, 0x75, 0xdb, 0xd7, 0xa7, 0x78, 0x16,
-	0xbe, 0xd8, 0xef, 0x95, 0xf4, 0x24, 0x47, 0xd9, 0xa8, 0xd4, 0x9c, 0xc2, 0xaf, 0x35, 0xa6, 0x46,
-	0xdd, 0x87, 0xd3, 0x9d, 0xc9, 0x1f, 0xab, 0x67, 0x92, 0x05, 0x66, 0x00, 0x39, 0x71, 0xb9, 0x5b,
-	0x75, 0x0f, 0x5b, 0x7c, 0x25, 0xd1, 0x2e, 0x56, 0xdf, 0x0f, 0x2d, 0x27, 0x20, 0x41, 0xf7, 0xc4,
-	0x2b, 0xf3, 0xb9, 0x06, 0xcb, 0x19, 0x01, 0x3d, 0x0f, 0xbe, 0x55, 0xfe, 0xb2, 0x04, 0xf9, 0x68,
-	0x6f, 0xd8, 0xd5, 0x60, 0x45, 0xa6, 0xf4, 0x88, 0x5f, 0x0d, 0x36, 0x56, 0xaf, 0x06, 0x1b, 0xa3,
-	0x5d, 0xc8, 0x05, 0x16, 0x61, 0x09, 0x76, 0x4c, 0x96, 0x8d, 0x19, 0x18, 0xbd, 0xcf, 0x24, 0x6a,
-	0xf3, 0x72, 0xbb, 0xe5, 0x04, 0x43, 0xfe, 0x45, 0x6f, 0xc7, 0x25, 0xec, 0xb8, 0x52, 0x79, 0x46,
-	0x9e, 0x7c, 0x8b, 0x3a, 0xf6, 0x31, 0xac, 0x5a, 0xed, 0x36, 0x6d, 0x5a, 0x81, 0xd5, 0x68, 0x63,
-	0x73, 0x70, 0x65, 0x27, 0xb8, 0xde, 0x57, 0x93, 0x7a, 0x77, 0x07, 0xa2, 0xa9, 0x0b, 0x7b, 0x51,
-	0x3a, 0xba, 0x62, 0x65, 0x88, 0x18, 0x99, 0x54, 0xe4, 0xc1, 0xb2, 0x75, 0x64, 0x91, 0x76, 0xca,
-	0xb2, 0xb8, 0x5e, 0xff, 0x97, 0xb2, 0x1c, 0x09, 0xa6, 0xec, 0x16, 0xa4, 0x5d, 0x64, 0x0d, 0x09,
-	0x18, 0x19, 0x34, 0xd4, 0x80, 0x85, 0x80, 0x06, 0x56, 0x5b, 0xb1, 0x97, 0x93, 0x69, 0x38, 0x61,
-	0x6f, 0x9f, 0x09, 0xa5, 0x6c, 0xc5, 0x37, 0x38, 0x48, 0x30, 0x8d, 0xd4, 0x98, 0xaf, 0x4b, 0xac,
-	0x97, 0x23, 0x53, 0x64, 0x67, 0x2a, 0x73, 0x5d, 0x91, 0xe0, 0xc8, 0x75, 0x0d, 0x09, 0x18, 0x19,
-	0x34, 0x74, 0x1f, 0x16, 0xbd, 0xd0, 0x31, 0x89, 0xed, 0x9b, 0x8d, 0xae, 0xe9, 0x07, 0x56, 0x80,
-	0xf5, 0xbc, 0xd2, 0x33, 0xc4, 0x06, 0x8d, 0xd0, 0xa9, 0xdb, 0x7e, 0xad, 0xbb, 0xc7, 0x44, 0x84,
-	0xad, 0x55, 0x69, 0x6b, 0xce, 0x53, 0x79, 0x46, 0x72, 0x88, 0x7e, 0xab, 0x41, 0xd1, 0xa1, 0x8e,
-	0x69, 0x79, 0x1d, 0xcb, 0xb6, 0xcc, 0xac, 0x15, 0x4e, 0x2b, 0xc0, 0x18, 0x1b, 0x7c, 0x8f, 0x3a,
-	0xbb, 0x7c, 0xca, 0xa8, 0xa5, 0xbe, 0x22, 0xcd, 0x6f, 0x3a, 0xa3, 0x25, 0x8d, 0xe3, 0x98, 0x68,
-	0x17, 0xe6, 0x42, 0x47, 0x56, 0x1e, 0x6c, 0xbb, 0x75, 0x28, 0x6b, 0x5b, 0xf9, 0xda, 0x66, 0xbf,
-	0x57, 0x5a, 0x4f, 0x30, 0x94, 0x0b, 0x90, 0x9c, 0x81, 0x3e, 0xd5, 0x60, 0x3d, 0x2e, 0x82, 0x43,
-	0xdf, 0x6a, 0x61, 0x16, 0x47, 0xd1, 0x88, 0xce, 0x64, 0x5d, 0x85, 0xc8, 0xfa, 0x07, 0x4c, 0xb6,
-	0xd6, 0xe5, 0xfd, 0xc3, 0xa0, 0x05, 0x2b, 0x7a, 0x19, 0x6c, 0xc5, 0xfa, 0x4a, 0x16, 0x9f, 0x75,
-	0xd9, 0xbc, 0xe7, 0x0b, 0xba, 0x2e, 0xd6, 0x67, 0x07, 0xfd, 0x32, 0x23, 0xee, 0x77, 0x5d, 0x55,
-	0x41, 0x3e, 0xa2, 0xbd, 0x88, 0xe2, 0xe8, 0xf7, 0x1a, 0x6c, 0x8c, 0xbc, 0xfa, 0xe7, 0x22, 0x47,
-	0xfc, 0x4e, 0x83, 0xf5, 0x11, 0x10, 0x71, 0x6e, 0x72, 0x58, 0x06, 0xa4, 0x9c, 0x0b, 0xdf, 0x7e,
-	0xca, 0x62, 0x97, 0x7d, 0x37, 0x55, 0xff, 0x26, 0x47, 0xfa, 0xf7, 0x56, 0xd2, 0x3f, 0xf1, 0x9c,
-	0x72, 0x83, 0x76, 0xdc, 0x30, 0x88, 0xf7, 0xe2, 0x44, 0x2f, 0x1e, 0x01, 0x1a, 0x86, 0xa6, 0xd3,
-	0xc5, 0xe7, 0xba, 0x6a, 0x7f, 0x5e, 0x56, 0x4c, 0xac, 0x54, 0x60, 0x7a, 0x4e, 0x34, 0xfc, 0x2b,
-	0x0d, 0xca, 0x27, 0x61, 0xd4, 0x0b, 0x8c, 0xc3, 0xcf, 0x35, 0xd8, 0x18, 0x89, 0x2d, 0xa7, 0x8b,
-	0xc7, 0x59, 0xf8, 0x51, 0xf9, 0xcd, 0x84, 0xa8, 0x6c, 0x18, 0xc6, 0x28, 0x15, 0x8b, 0xf6, 0xec,
-	0x15, 0xcb, 0x58, 0xaa, 0x62, 0x61, 0x16, 0xce, 0xa2, 0x62, 0x19, 0x4f, 0xc1, 0x34, 0xd7, 0x7b,
-	0xa6, 0x15, 0xcb, 0xff, 0xb0, 0x96, 0x9d, 0x8c, 0x3f, 0x4d, 0xc0, 0xa6, 0x6c, 0xae, 0xf6, 0xe2,
-	0x77, 0x1c, 0x96, 0x13, 0x65, 0xcb, 0xf4, 0xac, 0x9d, 0xe5, 0xd4, 0x09, 0x9d, 0xe5, 0x1e, 0xcc,
-	0x88, 0x76, 0xcf, 0x0c, 0x48, 0x27, 0x5a, 0xe4, 0x71, 0x2f, 0x44, 0x51, 0xdd, 0x06, 0x62, 0x1a,
-	0x63, 0xf0, 0x47, 0x22, 0x65, 0x8c, 0x6e, 0x01, 0xc4, 0xa9, 0x37, 0x2a, 0x41, 0xe7, 0x12, 0x47,
-	0x49, 0x3e, 0x2d, 0xcb, 0x91, 0x9f, 0x78, 0x5a, 0x8e, 0x88, 0xe8, 0x28, 0xa3, 0x5d, 0x14, 0xf5,
-	0xe5, 0x35, 0xb5, 0x29, 0xcd, 0x8a, 0xdb, 0xb3, 0x34, 0x8d, 0xe7, 0xba, 0x47, 0xfa, 0xe7, 0x04,
-	0x2c, 0x71, 0x08, 0x4b, 0x34, 0xd6, 0xa7, 0x6d, 0x96, 0x28, 0x2c, 0xc6, 0x57, 0x5c, 0x76, 0xfb,
-	0x12, 0x41, 0xbe, 0xc3, 0xfd, 0x19, 0xd2, 0x3c, 0x78, 0x4a, 0x10, 0x54, 0x11, 0xc8, 0x75, 0x19,
-	0xc8, 0x05, 0x2f, 0xc9, 0x35, 0xd2, 0x04, 0xf4, 0xb9, 0x06, 0x17, 0xd3, 0x16, 0x59, 0x2d, 0x18,
-	0xbf, 0x02, 0x0b, 0x9c, 0x79, 0xf3, 0x74, 0xd6, 0x6b, 0xdd, 0x7b, 0x72, 0x9e, 0xf0, 0xe3, 0x65,
-	0xe9, 0xc7, 0x86, 0x37, 0x4a, 0xce, 0x18, 0xcd, 0x2a, 0x7c, 0xa1, 0xc1, 0x4a, 0xd6, 0xf2, 0xce,
-	0x45, 0x1d, 0xf1, 0x4b, 0x0d, 0x8a, 0xc7, 0xaf, 0xfe, 0xc5, 0xa5, 0xd1, 0xca, 0x3f, 0x34, 0x58,
-	0xce, 0x78, 0x01, 0xfa, 0xaf, 0xc1, 0xe9, 0xb9, 0x80, 0xce, 0x4d, 0xc8, 0xf1, 0x0e, 0x23, 0xca,
-	0x5d, 0x6b, 0xd9, 0x67, 0x4a, 0x24, 0x44, 0x21, 0xa9, 0x26, 0x44, 0x41, 0xa9, 0xfc, 0x5b, 0x83,
-	0x85, 0x54, 0x78, 0xd0, 0xbe, 0xfa, 0xfa, 0x26, 0x72, 0xf6, 0x2b, 0x59, 0x71, 0xfc, 0x56, 0xef,
-	0x6e, 0xe7, 0xf4, 0x81, 0xa8, 0xf2, 0x67, 0x0d, 0x66, 0xe3, 0xc7, 0x54, 0xe2, 0xb4, 0xd0, 0x3b,
-	0xa9, 0xd7, 0x91, 0x97, 0x62, 0x20, 0x8f, 0x44, 0x4e, 0x5f, 0x6f, 0xbc, 0x80, 0x9c, 0x5f, 0xf9,
-	0x1e, 0xe4, 0x6f, 0xd3, 0x06, 0xdf, 0x72, 0x74, 0x05, 0xc6, 0x0f, 0x68, 0x43, 0xee, 0x59, 0x3e,
-	0x2a, 0x65, 0x85, 0xa5, 0x03, 0xda, 0x50, 0x2d, 0x1d, 0xd0, 0x46, 0xe5, 0x0f, 0x1a, 0x2c, 0xc5,
-	0x6f, 0x90, 0xc3, 0x4a, 0xb4, 0xd3, 0x28, 0x41, 0xdb, 0x30, 0xe5, 0xf0, 0xc4, 0xe1, 0x73, 0x87,
-	0xe7, 0xc4, 0x07, 0x11, 0x49, 0x52, 0x3f, 0x88, 0x48, 0x12, 0xda, 0x81, 0xbc, 0x13, 0x76, 0x76,
-	0x9b, 0x87, 0xd8, 0xe6, 0x9f, 0x69, 0xe7, 0x64, 0x9f, 0x2a, 0x69, 0x89, 0x3e, 0x55, 0xd2, 0x2a,
-	0x57, 0x20, 0x57, 0xb7, 0xef, 0x10, 0x3f, 0x60, 0x21, 0x24, 0xb6, 0x38, 0x96, 0x32, 0x84, 0x24,
-	0xf1, 0x2e, 0xc9, 0xb8, 0x15, 0x17, 0x96, 0x0c, 0xec, 0xe0, 0x47, 0x67, 0xf2, 0x68, 0x2d, 0x2d,
-	0x8e, 0x1d, 0x6b, 0xf1, 0x17, 0x93, 0x80, 0x0c, 0x1c, 0x84, 0x9e, 0x73, 0x26, 0x36, 0xff, 0x1f,
-	0x72, 0xac, 0x04, 0x88, 0xbf, 0x7c, 0xf3, 0x43, 0x70, 0x40, 0x1b, 0x09, 0xf9, 0x49, 0x4e, 0x40,
-	0xf7, 0x61, 0xc9, 0x3a, 0xa2, 0x24, 0xf9, 0xc9, 0x57, 0x3c, 0x66, 0xaf, 0xf2, 0xdd, 0xbb, 0xeb,
-	0xd9, 0xd8, 0xc3, 0xf6, 0x5e, 0xe0, 0x11, 0xa7, 0xf5, 0xae, 0xe5, 0x8a, 0x4f, 0x28, 0x7c, 0x4e,
-	0xd6, 0x47, 0x5e, 0x63, 0x21, 0xc5, 0x42, 0x97, 0x21, 0xe7, 0x61, 0xcb, 0xa7, 0x0e, 0xff, 0x20,
-	0x39, 0x2d, 0xce, 0xbc, 0xa0, 0xa8, 0x67, 0x5e, 0x50, 0xd0, 0x5b, 0x30, 0x77, 0x18, 0x36, 0xb0,
-	0xe7, 0xe0, 0x00, 0xfb, 0x6c, 0x09, 0x39, 0x3e, 0xa9, 0xd0, 0xef, 0x95, 0xd6, 0x06, 0x8c, 0xc4,
-	0x4a, 0x66, 0x55, 0x3a, 0xaa, 0xc3, 0x12, 0x5b, 0xbc, 0x17, 0x3a, 0xa6, 0x15, 0x70, 0x09, 0x6c,
-	0xf3, 0xc2, 0x2e, 0x2f, 0x3c, 0x3f, 0xa0, 0x0d, 0x23, 0x74, 0x76, 0x23, 0x96, 0xea, 0x79, 0x8a,
-	0x85, 0x3e, 0xd5, 0x60, 0x39, 0xf0, 0x2c, 0x76, 0x86, 0x4c, 0xf5, 0x93, 0xbb, 0x78, 0xdd, 0xda,
-	0xe6, 0xe1, 0x19, 0xde, 0xb6, 0xea, 0xbe, 0x98, 0x32, 0xf4, 0x21, 0xbe, 0xdc, 0xef, 0x95, 0x2e,
-	0x06, 0x43, 0x4c, 0xc5, 0x03, 0x34, 0xcc, 0x2d, 0x74, 0x60, 0x7d, 0x84, 0xc2, 0xe7, 0x02, 0x08,
-	0x36, 0x20, 0xb1, 0xd5, 0xef, 0xe0, 0xee, 0x87, 0x8c, 0x7a, 0xcf, 0x22, 0xde, 0x59, 0x5b, 0xaa,
-	0x7c, 0x0c, 0x8b, 0xe9, 0x73, 0x85, 0x7e, 0x04, 0x53, 0xd8, 0x09, 0x3c, 0x12, 0xa7, 0x8d, 0xf5,
-	0xe8, 0x33, 0x47, 0xca, 0x1b, 0x81, 0x11, 0x52, 0x56, 0xc5, 0x08, 0x49, 0xda, 0xf9, 0x97, 0x06,
-	0x0b, 0xbb, 0xad, 0x96, 0x87, 0x5b, 0xac, 0x9f, 0x16, 0x0f, 0x5c, 0x77, 0xf8, 0xba, 0x94, 0x0f,
-	0x26, 0x1c, 0x4d, 0x0a, 0xa3, 0xbf, 0xa4, 0x14, 0xd6, 0x92, 0xbc, 0x08, 0xe1, 0xb6, 0xb4, 0xd7,
-	0x35, 0x74, 0x15, 0x60, 0x00, 0x11, 0x68, 0x4d, 0x9e, 0x84, 0x14, 0x66, 0x14, 0x66, 0xc4, 0x07,
-	0x5f, 0x01, 0x3d, 0x3f, 0x80, 0x19, 0xe5, 0xac, 0xa0, 0xf5, 0x11, 0xa7, 0xa7, 0xb0, 0x36, 0x94,
-	0xd9, 0x6f, 0xb1, 0xd5, 0xa1, 0x4b, 0xcc, 0x24, 0xcb, 0xc9, 0x37, 0xa9, 0x83, 0x91, 0xaa, 0x3a,
-	0x61, 0xa7, 0x76, 0xff, 0xeb, 0xbf, 0x17, 0x2f, 0xfc, 0xe4, 0x49, 0x51, 0xfb, 0xf2, 0x49, 0x51,
-	0xfb, 0xea, 0x49, 0x51, 0xfb, 0xdb, 0x93, 0xa2, 0xf6, 0xd9, 0xd3, 0xe2, 0x85, 0xaf, 0x9e, 0x16,
-	0x2f, 0x7c, 0xfd, 0xb4, 0x78, 0xe1, 0xa3, 0x57, 0x95, 0x5f, 0xf7, 0x88, 0x27, 0x55, 0xd7, 0xa3,
-	0x07, 0xb8, 0x19, 0xc8, 0x51, 0xf4, 0xfb, 0xa0, 0x3f, 0x8e, 0xad, 0x88, 0xa7, 0x89, 0x7b, 0x82,
-	0x5d, 0xad, 0xd3, 0xea, 0xae, 0x4b, 0x1a, 0x39, 0xee, 0xd9, 0x1b, 0xff, 0x09, 0x00, 0x00, 0xff,
-	0xff, 0xdc, 0x5e, 0xd7, 0xc8, 0xe5, 0x24, 0x00, 0x00,
+	// 2968 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0xcf, 0x6f, 0x1b, 0xc7,
+	0xf5, 0xf7, 0x4a, 0x96, 0x44, 0x3d, 0x89, 0xfa, 0x31, 0xa2, 0xa4, 0x15, 0x65, 0x8b, 0x0c, 0x83,
+	0xaf, 0xa3, 0x7c, 0x9b, 0x50, 0x89, 0x93, 0x14, 0x6e, 0x10, 0x34, 0x25, 0x6d, 0x27, 0x91, 0xe3,
+	0xc4, 0xca, 0x4a, 0x31, 0xd0, 0x20, 0xc0, 0x66, 0xb9, 0x3b, 0xa6, 0x56, 0x22, 0x77, 0x36, 0xbb,
+	0x4b, 0x39, 0xcc, 0xa5, 0x41, 0x7f, 0x00, 0x45, 0x50, 0xa0, 0x01, 0xda, 0x02, 0x4d, 0xd0, 0xa2,
+	0xc7, 0x02, 0x3d, 0xf5, 0x2f, 0xe8, 0xa9, 0x87, 0x1c, 0x03, 0x14, 0x68, 0x73, 0x62, 0x5b, 0xe7,
+	0x52, 0xf0, 0xd8, 0x63, 0x0f, 0x45, 0x31, 0x3f, 0x76, 0x77, 0x76, 0xb9, 0x14, 0xe5, 0x5a, 0x36,
+	0x54, 0x20, 0x27, 0x72, 0x3e, 0xef, 0xcd, 0x7b, 0x6f, 0x7e, 0xec, 0x7b, 0x6f, 0xde, 0x0c, 0x2c,
+	0xb9, 0x87, 0xcd, 0x2d, 0xc3, 0xb5, 0xb7, 0xde, 0xef, 0xe0, 0x0e, 0xae, 0xba, 0x1e, 0x09, 0x08,
+	0x1a, 0x37, 0x5c, 0xbb, 0x58, 0x6a, 0x12, 0xd2, 0x6c, 0xe1, 0x2d, 0x06, 0x35, 0x3a, 0x77, 0xb6,
+	0x02, 0xbb, 0x8d, 0xfd, 0xc0, 0x68, 0xbb, 0x9c, 0xab, 0x58, 0x39, 0xbc, 0xe2, 0x57, 0x6d, 0xc2,
+	0x7a, 0x9b, 0xc4, 0xc3, 0x5b, 0x47, 0xcf, 0x6e, 0x35, 0xb1, 0x83, 0x3d, 0x23, 0xc0, 0x96, 0xe0,
+	0xd9, 0x94, 0x78, 0x1c, 0x1c, 0xdc, 0x25, 0xde, 0xa1, 0xed, 0x34, 0xb3, 0x38, 0x9f, 0x8f, 0x39,
+	0xdb, 0x86, 0xb9, 0x6f, 0x3b, 0xd8, 0xeb, 0x6e, 0x85, 0xc6, 0x79, 0xd8, 0x27, 0x1d, 0xcf, 0xc4,
+	0x03, 0xbd, 0x9e, 0x6e, 0xda, 0xc1, 0x7e, 0xa7, 0x51, 0x35, 0x49, 0x7b, 0xab, 0x49, 0x9a, 0x24,
+	0xb6, 0x96, 0xb6, 0x58, 0x83, 0xfd, 0x13, 0xec, 0xeb, 0xe9, 0x31, 0xe1, 0xb6, 0x1b, 0x74, 0x05,
+	0xb1, 0x10, 0x6a, 0xf3, 0x3b, 0x8d, 0xb6, 0x1d, 0x70, 0xb4, 0xf2, 0xa7, 0x25, 0x18, 0xbf, 0x41,
+	0x1a, 0xa8, 0x0c, 0x63, 0xb6, 0xa5, 0x2a, 0x65, 0x65, 0x73, 0xba, 0xbe, 0xd0, 0xef, 0x95, 0x66,
+	0x6d, 0xeb, 0x29, 0xd2, 0xb6, 0x03, 0x26, 0x41, 0x1b, 0xb3, 0x2d, 0xf4, 0x1c, 0x4c, 0x9b, 0x2d,
+	0x1b, 0x3b, 0x81, 0x6e, 0x5b, 0x6a, 0x9e, 0x31, 0xae, 0xf4, 0x7b, 0x25, 0xc4, 0xc1, 0x6d, 0x99,
+	0x3d, 0x17, 0x62, 0xe8, 0x79, 0x80, 0x03, 0xd2, 0xd0, 0x7d, 0xcc, 0x7a, 0x8d, 0xc5, 0xbd, 0x0e,
+	0x48, 0x63, 0x17, 0xa7, 0x7a, 0x85, 0x18, 0x7a, 0x12, 0x26, 0xd8, 0x7a, 0xa9, 0xe3, 0xac, 0xc3,
+	0x52, 0xbf, 0x57, 0x9a, 0x67, 0x80, 0xc4, 0xcd, 0x39, 0xd0, 0x0b, 0x30, 0xed, 0x18, 0x6d, 0xec,
+	0xbb, 0x86, 0x89, 0xd5, 0x29, 0xc6, 0xbe, 0xda, 0xef, 0x95, 0x96, 0x22, 0x50, 0xea, 0x12, 0x73,
+	0xa2, 0x3a, 0x4c, 0xb6, 0x8c, 0x06, 0x6e, 0xf9, 0xea, 0x74, 0x79, 0x7c, 0x73, 0xe6, 0x72, 0xa1,
+	0x6a, 0xb8, 0x76, 0xf5, 0x06, 0x69, 0x54, 0x6f, 0x32, 0xf8, 0xba, 0x13, 0x78, 0xdd, 0x7a, 0xa1,
+	0xdf, 0x2b, 0x2d, 0x70, 0x3e, 0x49, 0x8c, 0xe8, 0x89, 0x6e, 0xc3, 0x8c, 0xe1, 0x38, 0x24, 0x30,
+	0x02, 0x9b, 0x38, 0xbe, 0x0a, 0x4c, 0xd0, 0x5a, 0x24, 0xa8, 0x16, 0xd3, 0xb8, 0xb4, 0xb5, 0x7e,
+	0xaf, 0xb4, 0x2c, 0xf5, 0x90, 0x44, 0xca, 0x82, 0xd0, 0x11, 0x14, 0x3c, 0xfc, 0x7e, 0xc7, 0xf6,
+	0xb0, 0xa5, 0x3b, 0xc4, 0xc2, 0xba, 0xb0, 0x74, 0x86, 0x29, 0x28, 0x47, 0x0a, 0x34, 0xc1, 0xf4,
+	0x26, 0xb1, 0xb0, 0x6c, 0x75, 0xa5, 0xdf, 0x2b, 0x5d, 0xf0, 0x06, 0x88, 0xb1, 0x3a, 0x55, 0xd1,
+	0xd0, 0x20, 0x9d, 0xce, 0x3a, 0xb9, 0xeb, 0x60, 0x4f, 0xcd, 0xc5, 0xb3, 0xce, 0x00, 0x79, 0xd6,
+	0x19, 0x80, 0x30, 0xac, 0xb3, 0xe9, 0xd7, 0x59, 0xd3, 0xdf, 0xb7, 0x5d, 0xbd, 0xe3, 0x63, 0x4f,
+	0x6f, 0x7a, 0xa4, 0xe3, 0xfa, 0xea, 0x7c, 0x79, 0x7c, 0x73, 0xba, 0x7e, 0xa9, 0xdf, 0x2b, 0x55,
+	0x18, 0xdb, 0xad, 0x90, 0xeb, 0x6d, 0x1f, 0x7b, 0xaf, 0x32, 0x1e, 0x49, 0xa6, 0x3a, 0x8c, 0x07,
+	0xfd, 0x50, 0x81, 0x4b, 0x26, 0x69, 0xbb, 0x1e, 0xf6, 0x7d, 0x6c, 0xe9, 0xc7, 0xa9, 0x5c, 0x2a,
+	0x2b, 0x9b, 0xb3, 0xf5, 0x67, 0xfa, 0xbd, 0xd2, 0x53, 0x71, 0x8f, 0xb7, 0x46, 0x2b, 0xaf, 0x8c,
+	0xe6, 0x46, 0x97, 0x21, 0xe7, 0x7a, 0x36, 0xf1, 0xec, 0xa0, 0xab, 0x9e, 0x2f, 0x2b, 0x9b, 0x0a,
+	0xdf, 0xc2, 0x21, 0x26, 0x6f, 0xe1, 0x10, 0x43, 0xb7, 0x20, 0xe7, 0x12, 0x4b, 0xf7, 0x5d, 0x6c,
+	0xaa, 0x13, 0x65, 0x65, 0x73, 0xe6, 0xf2, 0x7a, 0x95, 0xbb, 0x00, 0xb6, 0x7e, 0xd4, 0xa1, 0x54,
+	0x8f, 0x9e, 0xad, 0xee, 0x10, 0x6b, 0xd7, 0xc5, 0x26, 0xdb, 0xb3, 0x8b, 0x2e, 0x6f, 0x24, 0x16,
+	0x6a, 0x4a, 0x80, 0x68, 0x07, 0xa6, 0x43, 0x81, 0xbe, 0x3a, 0x5b, 0x1e, 0x1f, 0x25, 0x91, 0x9b,
+	0xc8, 0x1b, 0x7e, 0xc2, 0x44, 0x81, 0xa1, 0xcf, 0x14, 0x28, 0xfb, 0xe6, 0x3e, 0xb6, 0x3a, 0x2d,
+	0xdb, 0x69, 0xea, 0xa1, 0x13, 0xd2, 0xc5, 0xd6, 0x68, 0x63, 0x27, 0xf0, 0xd5, 0x65, 0x66, 0xfb,
+	0x66, 0x96, 0x26, 0x4d, 0x74, 0xd0, 0x24, 0xfe, 0xfa, 0xa5, 0xcf, 0x7b, 0xa5, 0x73, 0xfd, 0x5e,
+	0x69, 0x23, 0x96, 0x9c, 0xc5, 0xa7, 0x8d, 0xa0, 0xa3, 0x6d, 0x98, 0x32, 0x3d, 0x4c, 0x5d, 0xa1,
+	0x3a, 0xc9, 0x4c, 0x28, 0x56, 0xb9, 0x73, 0xab, 0x86, 0xce, 0xad, 0xba, 0x17, 0x3a, 0xec, 0xfa,
+	0x92, 0x50, 0x1a, 0x76, 0xf9, 0xe4, 0xaf, 0x25, 0x45, 0x0b, 0x1b, 0xe8, 0x2a, 0x4c, 0xd9, 0x4e,
+	0x93, 0xae, 0xb1, 0x3a, 0xc7, 0xe6, 0x0d, 0xb1, 0x61, 0x6c, 0x73, 0xec, 0x2a, 0x71, 0xee, 0xd8,
+	0xcd, 0xfa, 0x32, 0x5d, 0x00, 0xc1, 0x26, 0xcd, 0x56, 0xd8, 0x13, 0xbd, 0x02, 0x39, 0x1f, 0x7b,
+	0x47, 0xb6, 0x89, 0x7d, 0x75, 0x41, 0x92, 0xb2, 0xcb, 0x41, 0x21, 0x85, 0x4d, 0x7a, 0xc8, 0x27,
+	0x4f, 0x7a, 0x88, 0xa1, 0x77, 0x61, 0xe6, 0xf0, 0x8a, 0xaf, 0x87, 0x06, 0x2d, 0x32, 0x51, 0x8f,
+	0xc9, 0xd3, 0x1b, 0xc7, 0x11, 0x3a, 0xc9, 0xc2, 0xca, 0xba, 0xda, 0xef, 0x95, 0x0a, 0x87, 0x57,
+	0xfc, 0xed, 0x01, 0x13, 0x21, 0x46, 0xd1, 0x6d, 0x2e, 0x5d, 0x68, 0x53, 0xd1, 0xf0, 0x6d, 0x22,
+	0xec, 0x8e, 0xe4, 0x8a, 0x76, 0x4a, 0xae, 0x40, 0xa9, 0x97, 0x15, 0xeb, 0x85, 0x3d, 0xb5, 0x10,
+	0x7b, 0xd9, 0x08, 0x94, 0xbd, 0x6c, 0x04, 0xa2, 0x6d, 0x58, 0xe4, 0xdf, 0x6c, 0x10, 0xb4, 0x74,
+	0x1f, 0x9b, 0xc4, 0xb1, 0x7c, 0x75, 0xa5, 0xac, 0x6c, 0x8e, 0xd7, 0x2f, 0xf6, 0x7b, 0xa5, 0x35,
+	0x46, 0xdc, 0x0b, 0x5a, 0xbb, 0x9c, 0x24, 0x09, 0x99, 0x4f, 0x91, 0xd0, 0x0e, 0x14, 0xa2, 0xed,
+	0xaf, 0x93, 0xc6, 0x01, 0x36, 0x03, 0xfd, 0x10, 0x77, 0xd5, 0x55, 0x66, 0x4c, 0xa9, 0xdf, 0x2b,
+	0xad, 0x87, 0x1b, 0xfb, 0x16, 0xa3, 0xbe, 0x8e, 0xe5, 0x0f, 0x73, 0x71, 0x80, 0x88, 0xae, 0xc3,
+	0xfc, 0x1d, 0xc3, 0x6e, 0x61, 0x4b, 0x37, 0x02, 0xc6, 0xe5, 0xab, 0x6a, 0x59, 0xd9, 0xcc, 0xd7,
+	0x2f, 0xf4, 0x7b, 0x25, 0x95, 0x93, 0x6a, 0x82, 0x22, 0x49, 0x9a, 0x4b, 0x52, 0xd0, 0xcb, 0x90,
+	0xf7, 0x70, 0xe0, 0x75, 0x75, 0x17, 0x3b, 0x96, 0xed, 0x34, 0xd5, 0xb5, 0xb2, 0xb2, 0x99, 0xab,
+	0x17, 0xfb, 0xbd, 0xd2, 0x0a, 0x23, 0xec, 0x70, 0x5c, 0x12, 0x31, 0x2b, 0xe3, 0xe8, 0x45, 0x98,
+	0xa5, 0x21, 0xd2, 0xf0, 0x3c, 0xa3, 0x4b, 0x83, 0x64, 0x91, 0x8d, 0x88, 0xad, 0xcb, 0x01, 0x69,
+	0xd4, 0x28, 0x9c, 0x08, 0x93, 0x10, 0xa3, 0xe8, 0x2a, 0xcc, 0x4b, 0x7d, 0x1d, 0x0b, 0x7f, 0xa0,
+	0xae, 0xb3, 0x31, 0xac, 0xf7, 0x7b, 0xa5, 0xd5, 0x88, 0x91, 0x12, 0x24, 0x09, 0xf9, 0x04, 0x01,
+	0x7d, 0x07, 0xe6, 0xe2, 0xa9, 0xdd, 0x37, 0xfc, 0x7d, 0xf5, 0x02, 0x33, 0x81, 0x0d, 0x21, 0x9c,
+	0xb7, 0xd7, 0x0c, 0x7f, 0x5f, 0x1e, 0x82, 0x8c, 0xa3, 0xb7, 0x61, 0x39, 0xf6, 0xca, 0xdc, 0x21,
+	0x73, 0x41, 0x17, 0x99, 0xa0, 0xc7, 0xfa, 0xbd, 0xd2, 0xc5, 0x88, 0x81, 0x3b, 0xd5, 0x94, 0xbc,
+	0xa5, 0x0c, 0x72, 0xd1, 0x80, 0x19, 0x29, 0xae, 0xa1, 0xc7, 0x61, 0x9c, 0xae, 0x38, 0xcf, 0x51,
+	0x16, 0xfb, 0xbd, 0x52, 0xfe, 0x30, 0xb1, 0xc6, 0x94, 0x4a, 0x83, 0xd8, 0x91, 0xd1, 0xea, 0x60,
+	0x75, 0x2c, 0x0e, 0x62, 0x0c, 0x90, 0x83, 0x18, 0x03, 0x5e, 0x1c, 0xbb, 0xa2, 0x14, 0xef, 0xc0,
+	0x42, 0x3a, 0x4e, 0x3f, 0x14, 0x3d, 0x6d, 0x58, 0x1d, 0x12, 0xae, 0x1f, 0x86, 0xba, 0xca, 0x1f,
+	0x67, 0x60, 0x79, 0x37, 0xf0, 0xb0, 0xd1, 0xb6, 0x9d, 0xe6, 0x4d, 0x6c, 0xf8, 0xcc, 0xb9, 0x62,
+	0x3f, 0x40, 0xdf, 0x04, 0x30, 0x5b, 0x1d, 0x3f, 0xc0, 0x9e, 0x1e, 0xe5, 0x7b, 0xec, 0x53, 0x16,
+	0x68, 0x62, 0xab, 0x4d, 0x47, 0x20, 0xba, 0x04, 0xe7, 0x5d, 0x42, 0x5a, 0x42, 0x3f, 0xea, 0xf7,
+	0x4a, 0x73, 0xb4, 0x2d, 0x31, 0x33, 0x3a, 0x7a, 0x07, 0xa6, 0xc3, 0x40, 0xe2, 0xab, 0xe3, 0xcc,
+	0xff, 0x3c, 0xc9, 0x1d, 0x65, 0x96, 0x39, 0x51, 0x0c, 0x11, 0xa9, 0xcb, 0xa2, 0x70, 0xe4, 0xb1,
+	0x0c, 0x2d, 0xfe, 0x8b, 0x6c, 0x58, 0x0e, 0x6d, 0x6f, 0x51, 0x21, 0x96, 0xee, 0x61, 0x97, 0x78,
+	0x01, 0x0b, 0xca, 0x33, 0x97, 0x55, 0xa6, 0xe7, 0x2a, 0xe7, 0x60, 0x5a, 0x2c, 0x8d, 0xd1, 0xeb,
+	0xeb, 0x42, 0xec, 0x92, 0x39, 0x48, 0xd4, 0xb2, 0x40, 0xe4, 0xc2, 0x42, 0xdb, 0x76, 0xec, 0x76,
+	0xa7, 0xad, 0xb3, 0xfc, 0xd5, 0xfe, 0x10, 0xab, 0x13, 0x6c, 0x34, 0xd5, 0x63, 0x46, 0xf3, 0x06,
+	0xef, 0x72, 0x83, 0x34, 0x76, 0xed, 0x0f, 0x31, 0x1f, 0xd2, 0x8a, 0xd0, 0x3d, 0xd7, 0x4e, 0x10,
+	0xb5, 0x54, 0x1b, 0x5d, 0x86, 0x09, 0x9a, 0xec, 0xf9, 0xea, 0x24, 0x53, 0x93, 0x67, 0x6a, 0xe8,
+	0x5e, 0xd9, 0x76, 0xee, 0x90, 0x7a, 0x5e, 0x48, 0xe1, 0x3c, 0x1a, 0xff, 0x41, 0xd7, 0x60, 0x4e,
+	0xc3, 0x26, 0xb6, 0x8f, 0xb0, 0x75, 0x83, 0x34, 0xb6, 0x2d, 0x5f, 0x9d, 0x62, 0x99, 0x17, 0xf3,
+	0x60, 0x49, 0x8a, 0xec, 0xc1, 0x92, 0x14, 0xd4, 0x85, 0x05, 0x11, 0x30, 0x74, 0xc3, 0x34, 0x49,
+	0x87, 0x86, 0xfd, 0x1c, 0x33, 0x62, 0xeb, 0x98, 0xb1, 0x8a, 0xd0, 0x50, 0x13, 0x3d, 0xf8, 0x60,
+	0x99, 0x57, 0xf7, 0x93, 0x14, 0xd9, 0xab, 0xa7, 0x48, 0xe8, 0x35, 0x58, 0xc0, 0x1f, 0x60, 0xb3,
+	0x13, 0x10, 0x4f, 0x3f, 0xc2, 0x9e, 0x6f, 0x13, 0x47, 0x9d, 0x66, 0x3b, 0x8c, 0x49, 0x0a, 0x69,
+	0xb7, 0x39, 0x49, 0x96, 0x94, 0x22, 0xa1, 0x9f, 0x2a, 0xb0, 0xea, 0x63, 0xd3, 0xc3, 0x81, 0xaf,
+	0x1b, 0x8e, 0xa5, 0x9b, 0x2c, 0x20, 0xeb, 0x6d, 0xc3, 0x0d, 0x33, 0xf3, 0xe7, 0x8f, 0x1d, 0x0c,
+	0xeb, 0x59, 0x73, 0x2c, 0x1e, 0xc8, 0xdf, 0x30, 0x5c, 0x29, 0x99, 0xde, 0xf0, 0x33, 0xc8, 0x92,
+	0x31, 0x85, 0x2c, 0x7a, 0xf1, 0xe7, 0x0a, 0x5d, 0x1d, 0x79, 0x7b, 0x9f, 0xec, 0x53, 0xff, 0xae,
+	0xfc, 0xa9, 0xd3, 0xfd, 0x16, 0x47, 0xef, 0xe8, 0xe4, 0x58, 0x75, 0x0f, 0x9b, 0x6c, 0x38, 0xe1,
+	0xc7, 0x51, 0x7d, 0xab, 0x63, 0x38, 0x81, 0x1d, 0x74, 0x47, 0x7a, 0xa2, 0x4f, 0x15, 0x58, 0xca,
+	0xd8, 0xa7, 0x67, 0xc2, 0xb6, 0x8f, 0x14, 0x28, 0x64, 0xed, 0xab, 0x93, 0x19, 0xf7, 0x72, 0xd2,
+	0xb8, 0x82, 0x9c, 0x9f, 0x85, 0xe2, 0x46, 0x9a, 0xf0, 0xb1, 0x02, 0x6b, 0x43, 0x77, 0xc3, 0xc9,
+	0xec, 0xb8, 0x96, 0xb4, 0x63, 0x4d, 0xd8, 0x31, 0x28, 0x73, 0xa4, 0x1b, 0x7f, 0x09, 0xe6, 0x53,
+	0xf6, 0xd3, 0x40, 0xc0, 0x4e, 0xb1, 0xaa, 0xc2, 0xbe, 0x74, 0x26, 0x81, 0x01, 0xb2, 0x04, 0x06,
+	0x54, 0x7e, 0xc5, 0x66, 0x73, 0x50, 0x2d, 0x7a, 0x09, 0x66, 0xf9, 0x8e, 0xd5, 0x65, 0x51, 0xec,
+	0x78, 0xca, 0xf1, 0x37, 0x53, 0x02, 0x67, 0x24, 0x18, 0xbd, 0x02, 0x0b, 0xf1, 0xc7, 0x25, 0x24,
+	0x8c, 0xc5, 0x6e, 0xc7, 0x0c, 0xf5, 0xa4, 0x85, 0xcc, 0x25, 0x29, 0x95, 0xbf, 0x2c, 0x42, 0x2e,
+	0xf4, 0x6f, 0x34, 0xbc, 0x50, 0x49, 0xaa, 0x12, 0x87, 0x17, 0xda, 0x96, 0xc3, 0x0b, 0x6d, 0xa3,
+	0x1a, 0x4c, 0x06, 0x86, 0xed, 0x04, 0x5c, 0x25, 0x9d, 0xdc, 0x8c, 0xdc, 0x76, 0x8f, 0x72, 0xd4,
+	0xe7, 0x84, 0xcb, 0x14, 0x1d, 0x34, 0xf1, 0x8b, 0x5e, 0x8d, 0x8e, 0xfe, 0xe3, 0xd2, 0x89, 0x3d,
+	0xb4, 0xe4, 0x3e, 0xce, 0xff, 0x1f, 0xc2, 0xb2, 0xd1, 0x6a, 0x11, 0xd3, 0x08, 0x8c, 0x46, 0x0b,
+	0xeb, 0x71, 0xd8, 0x3b, 0xcf, 0xe4, 0x3e, 0x91, 0x94, 0x5b, 0x8b, 0x59, 0x53, 0x41, 0xef, 0x82,
+	0x30, 0xb4, 0x60, 0x64, 0xb0, 0x68, 0x99, 0x28, 0xf2, 0x60, 0xc9, 0x38, 0x32, 0xec, 0x56, 0x4a,
+	0x33, 0x0f, 0x51, 0xff, 0x97, 0xd2, 0x1c, 0x32, 0xa6, 0xf4, 0x16, 0x85, 0x5e, 0x64, 0x0c, 0x30,
+	0x68, 0x19, 0x18, 0x6a, 0xc0, 0x7c, 0x40, 0x02, 0xa3, 0x25, 0xe9, 0x9b, 0x14, 0xc7, 0x97, 0x84,
+	0xbe, 0x3d, 0xca, 0x94, 0xd2, 0x15, 0x45, 0xc1, 0x20, 0x41, 0xd4, 0x52, 0x6d, 0x36, 0x2e, 0x3e,
+	0x5e, 0x16, 0xdd, 0x43, 0x3d, 0x53, 0x99, 0xe3, 0x0a, 0x19, 0x87, 0x8e, 0x6b, 0x80, 0x41, 0xcb,
+	0xc0, 0xd0, 0x7b, 0xb0, 0xe0, 0x75, 0x1c, 0xdd, 0xb6, 0x7c, 0xbd, 0xd1, 0xd5, 0xfd, 0xc0, 0x08,
+	0xb0, 0x9a, 0x93, 0x6a, 0x2d, 0x91, 0x42, 0xad, 0xe3, 0x6c, 0x5b, 0x7e, 0xbd, 0xbb, 0x4b, 0x59,
+	0xb8, 0xae, 0x65, 0xa1, 0x2b, 0xef, 0xc9, 0x34, 0x2d, 0xd9, 0x44, 0xbf, 0x54, 0x60, 0xc3, 0x21,
+	0x8e, 0x6e, 0x78, 0x6d, 0xc3, 0x32, 0xf4, 0xac, 0x11, 0x4e, 0x4b, 0xc9, 0x45, 0xa4, 0xf0, 0x4d,
+	0xe2, 0xd4, 0x58, 0x97, 0x61, 0x43, 0x7d, 0x5c, 0xa8, 0x5f, 0x77, 0x86, 0x73, 0x6a, 0xc7, 0x11,
+	0x51, 0x0d, 0xf2, 0x1d, 0x47, 0x9c, 0xd8, 0xe8, 0x72, 0xab, 0xc0, 0x8e, 0x2f, 0xec, 0xfc, 0x90,
+	0x20, 0xc8, 0xe7, 0x87, 0x04, 0x01, 0x7d, 0x5f, 0x81, 0xd5, 0xa8, 0x78, 0xd0, 0xf1, 0x8d, 0x26,
+	0xa6, 0xf3, 0xc8, 0x0b, 0x78, 0x33, 0x59, 0x9f, 0x42, 0xa8, 0xfd, 0x6d, 0xca, 0x5b, 0xef, 0xb2,
+	0xba, 0x8b, 0x14, 0x6d, 0xbd, 0x0c, 0xb2, 0x1c, 0x6d, 0xb3, 0xe8, 0xb4, 0x3a, 0xc9, 0x6a, 0x65,
+	0x41, 0xd7, 0xc5, 0xea, 0x6c, 0x5c, 0x67, 0xa4, 0xe0, 0x5e, 0xd7, 0x95, 0x05, 0xe4, 0x42, 0xec,
+	0x51, 0x1c, 0x30, 0x7e, 0xa3, 0xc0, 0xda, 0xd0, 0x4f, 0xff, 0x4c, 0x04, 0xdd, 0x5f, 0x2b, 0xb0,
+	0x3a, 0xc4, 0x45, 0x9c, 0x99, 0x84, 0x25, 0xc3, 0xa5, 0x9c, 0x09, 0xdb, 0x7e, 0x40, 0xe7, 0x2e,
+	0xfb, 0xdb, 0x94, 0xed, 0x9b, 0xb8, 0xbf, 0x9c, 0xe5, 0x2a, 0x69, 0xbb, 0x9d, 0x20, 0x5a, 0x8b,
+	0x91, 0x56, 0xdc, 0x05, 0x34, 0xe8, 0x9a, 0x4e, 0x36, 0x3f, 0x57, 0x64, 0xfd, 0x73, 0xe2, 0xd4,
+	0x41, 0xf3, 0x42, 0x2a, 0x67, 0xa4, 0xe2, 0x9f, 0x28, 0x50, 0x1e, 0xe5, 0xa3, 0x1e, 0xe1, 0x3c,
+	0xfc, 0x48, 0x81, 0xb5, 0xa1, 0xbe, 0xe5, 0x01, 0x72, 0xc8, 0xfb, 0xb4, 0xa3, 0xf2, 0xfb, 0x49,
+	0x9e, 0xd9, 0x50, 0x1f, 0x23, 0x65, 0x2c, 0xca, 0x83, 0x67, 0x2c, 0x63, 0xa9, 0x8c, 0x85, 0x6a,
+	0x38, 0x8d, 0x8c, 0x65, 0x3c, 0xe5, 0xa6, 0x99, 0xdc, 0xd3, 0xcd, 0x58, 0xb6, 0x21, 0x67, 0x1a,
+	0xae, 0x61, 0xf2, 0x22, 0x3a, 0xaf, 0x4b, 0x26, 0xd4, 0x5d, 0x15, 0x54, 0xae, 0x62, 0x41, 0xa8,
+	0x88, 0x3a, 0x69, 0xd1, 0x3f, 0x5a, 0xc3, 0x60, 0xbe, 0x9e, 0xa5, 0xc4, 0xac, 0xba, 0x3e, 0x21,
+	0x2e, 0x7d, 0x88, 0x85, 0xaf, 0x52, 0x30, 0x71, 0xe9, 0x13, 0x82, 0x5f, 0xbb, 0x7b, 0x6a, 0xe1,
+	0xcf, 0x14, 0xc8, 0x27, 0xa6, 0xfa, 0x2c, 0x58, 0x55, 0xf9, 0x73, 0x0e, 0xd6, 0x45, 0xe5, 0x66,
+	0x37, 0xba, 0x18, 0xa0, 0xc9, 0x82, 0xa8, 0xc7, 0x3c, 0x68, 0xd9, 0x6a, 0x6a, 0x44, 0xd9, 0x6a,
+	0x17, 0x66, 0x78, 0x2d, 0x49, 0x0f, 0xec, 0x76, 0x38, 0xc8, 0xe3, 0xae, 0x1c, 0xc2, 0x84, 0x16,
+	0x78, 0x37, 0x4a, 0x60, 0xb7, 0x0e, 0x52, 0x1b, 0x5d, 0x07, 0x88, 0x72, 0x92, 0x30, 0x37, 0xcf,
+	0x27, 0x36, 0x7d, 0xbc, 0x6d, 0x69, 0xcb, 0x4f, 0x6f, 0x5b, 0x06, 0xa2, 0xa3, 0x8c, 0x5a, 0xd4,
+	0xa4, 0x54, 0xd2, 0x38, 0x66, 0xde, 0x1e, 0xa8, 0x22, 0xf5, 0xbd, 0xa1, 0x75, 0xa1, 0x17, 0x46,
+	0xea, 0x3d, 0x95, 0xea, 0xd0, 0x2f, 0x8e, 0xa9, 0xe9, 0xf0, 0x7c, 0xf9, 0xc5, 0x13, 0x18, 0x72,
+	0xfa, 0x95, 0x9d, 0xaf, 0x4b, 0x28, 0xff, 0x3b, 0x25, 0x94, 0x7f, 0x9e, 0x87, 0x45, 0x96, 0x04,
+	0x24, 0xca, 0xbb, 0x27, 0x2d, 0x37, 0x10, 0x58, 0x88, 0x82, 0xa4, 0xa8, 0x39, 0x8b, 0x18, 0xfc,
+	0x0d, 0x66, 0xd2, 0x80, 0xe4, 0xb8, 0xa0, 0xcd, 0x51, 0xbe, 0xd5, 0x56, 0xc5, 0x17, 0x37, 0xef,
+	0x25, 0xa9, 0x5a, 0x1a, 0x40, 0x9f, 0x2a, 0x70, 0x21, 0xad, 0x91, 0x9e, 0xa6, 0xa2, 0xfb, 0xe7,
+	0x71, 0xe9, 0x03, 0x1c, 0xa9, 0xbd, 0xde, 0xdd, 0x11, 0xfd, 0xb8, 0x1d, 0x8f, 0x09, 0x3b, 0xd6,
+	0xbc, 0x61, 0x7c, 0xda, 0x70, 0x52, 0xf1, 0x33, 0x05, 0x0a, 0x59, 0xc3, 0x3b, 0x13, 0xfb, 0xfe,
+	0x63, 0x05, 0x36, 0x8e, 0x1f, 0xfd, 0xa3, 0x4b, 0x44, 0x2b, 0xff, 0x50, 0x60, 0x29, 0xe3, 0x1e,
+	0xe2, 0xbf, 0x8e, 0x62, 0x0f, 0x25, 0x3a, 0x5d, 0x83, 0x49, 0x76, 0x46, 0x0f, 0xb3, 0xbf, 0x95,
+	0xec, 0x3d, 0xc5, 0x53, 0x4a, 0xce, 0x29, 0xa7, 0x94, 0x1c, 0xa9, 0xfc, 0x5b, 0x81, 0xf9, 0xd4,
+	0xf4, 0xa0, 0x3d, 0xf9, 0x0e, 0x88, 0x67, 0xbd, 0x8f, 0x67, 0xcd, 0xe3, 0x7d, 0xdd, 0xfe, 0x9c,
+	0xd1, 0x7a, 0x7a, 0xe5, 0x0f, 0x0a, 0xcc, 0x46, 0x57, 0x7a, 0xf4, 0x3e, 0xf7, 0xf5, 0x54, 0x7d,
+	0xf1, 0x62, 0x14, 0xf1, 0x43, 0x96, 0x93, 0x67, 0xec, 0x8f, 0x20, 0x65, 0xad, 0x7c, 0x0b, 0x72,
+	0x37, 0x48, 0x83, 0x2d, 0x39, 0x7a, 0x1a, 0xc6, 0x0f, 0x48, 0x43, 0xac, 0x59, 0x2e, 0x3c, 0x0c,
+	0x72, 0x4d, 0x07, 0xa4, 0x21, 0x6b, 0x3a, 0x20, 0x8d, 0xca, 0x6f, 0x15, 0x58, 0x8c, 0x2e, 0x54,
+	0x06, 0x85, 0x28, 0x27, 0x11, 0x82, 0xb6, 0x60, 0xca, 0x61, 0x81, 0xd4, 0x67, 0x06, 0xe7, 0xf9,
+	0x53, 0x0c, 0x01, 0xc9, 0x4f, 0x31, 0x04, 0x44, 0x9f, 0xe3, 0x38, 0x9d, 0x76, 0xcd, 0x3c, 0xc4,
+	0x16, 0x7b, 0x20, 0x96, 0x17, 0x95, 0x1e, 0x81, 0x25, 0x2a, 0x3d, 0x02, 0xab, 0x3c, 0x0d, 0x93,
+	0xdb, 0xd6, 0x4d, 0xdb, 0x0f, 0xe8, 0x14, 0xda, 0x56, 0x58, 0xf3, 0x66, 0x36, 0xd9, 0x89, 0xdb,
+	0x31, 0x4a, 0xad, 0xb8, 0xb0, 0xa8, 0x61, 0x07, 0xdf, 0x3d, 0x95, 0xab, 0x53, 0xa1, 0x71, 0xec,
+	0x58, 0x8d, 0x3f, 0x9e, 0x00, 0xa4, 0xe1, 0xa0, 0xe3, 0x39, 0xa7, 0xa2, 0xf3, 0xff, 0x61, 0x92,
+	0xe6, 0x8a, 0xb6, 0x25, 0x6f, 0x82, 0x03, 0xd2, 0x48, 0xf0, 0x4f, 0x30, 0x00, 0xbd, 0x07, 0x8b,
+	0xc6, 0x11, 0xb1, 0x93, 0x8f, 0xcd, 0xf8, 0x95, 0xea, 0x32, 0x5b, 0xbd, 0x5b, 0x9e, 0x85, 0x3d,
+	0x6c, 0xed, 0x06, 0x9e, 0xed, 0xd0, 0xa8, 0xcb, 0x13, 0x39, 0xd6, 0x27, 0xeb, 0x79, 0x99, 0x36,
+	0x9f, 0x22, 0xa1, 0xa7, 0x60, 0xd2, 0xc3, 0x86, 0x4f, 0x1c, 0x76, 0x58, 0x9b, 0xe6, 0x7b, 0x9e,
+	0x23, 0xf2, 0x9e, 0xe7, 0x08, 0x7d, 0x51, 0x71, 0xd8, 0x69, 0x60, 0xcf, 0xc1, 0x01, 0xf6, 0x75,
+	0x9b, 0x3f, 0x00, 0x12, 0xcf, 0x11, 0x62, 0x42, 0x62, 0x24, 0xb3, 0x32, 0x4e, 0x9f, 0x9d, 0xd0,
+	0xc1, 0xd3, 0xa2, 0xae, 0x78, 0xda, 0x81, 0x2d, 0x76, 0x02, 0xc8, 0x71, 0xcb, 0x0f, 0x48, 0x43,
+	0xeb, 0x38, 0xb5, 0x90, 0x24, 0x5b, 0x9e, 0x22, 0xd1, 0xda, 0xe6, 0x52, 0xe0, 0x19, 0x74, 0x0f,
+	0xe9, 0xf2, 0x63, 0x3f, 0xf9, 0x7e, 0x74, 0x70, 0xd9, 0xaa, 0x7b, 0xbc, 0xcb, 0xc0, 0x13, 0xc0,
+	0x32, 0x7d, 0x9a, 0x17, 0x0c, 0x10, 0x25, 0x0b, 0xd0, 0x20, 0x95, 0x3e, 0x1e, 0x18, 0x22, 0xf0,
+	0xa1, 0x38, 0x04, 0x0b, 0x10, 0x5f, 0xea, 0xd7, 0x71, 0xf7, 0x36, 0x45, 0x77, 0x0c, 0xdb, 0x3b,
+	0x6d, 0x4d, 0x95, 0x77, 0x61, 0x21, 0xbd, 0xaf, 0xd0, 0x6b, 0x30, 0x85, 0x9d, 0xc0, 0xb3, 0xa3,
+	0xb0, 0xb1, 0x1a, 0xde, 0xd9, 0xa6, 0xac, 0xe1, 0x3e, 0x42, 0xf0, 0xca, 0x3e, 0x42, 0x40, 0x97,
+	0xff, 0xa5, 0xc0, 0x7c, 0xad, 0xd9, 0xf4, 0x70, 0xd3, 0x08, 0xc4, 0xcb, 0x3e, 0x74, 0x13, 0x50,
+	0xe4, 0xac, 0xd8, 0x6a, 0x31, 0x6f, 0x52, 0x1c, 0x7e, 0x2d, 0x5c, 0x5c, 0x49, 0xd2, 0x42, 0x0f,
+	0xb7, 0xa9, 0x3c, 0xa3, 0xa0, 0x67, 0x01, 0x62, 0x17, 0x81, 0x56, 0xc4, 0x4e, 0x48, 0xf9, 0x8c,
+	0xe2, 0x0c, 0xc3, 0x85, 0xeb, 0xf9, 0x36, 0xcc, 0x48, 0x7b, 0x05, 0xad, 0x0e, 0xd9, 0x3d, 0xc5,
+	0x95, 0x81, 0xc8, 0x7e, 0x9d, 0x8e, 0x0e, 0x5d, 0x02, 0xe0, 0x31, 0xf9, 0x1a, 0x71, 0x30, 0x92,
+	0x45, 0x27, 0xf4, 0xd4, 0xdf, 0xfb, 0xf2, 0xef, 0x1b, 0xe7, 0x3e, 0xba, 0xb7, 0xa1, 0x7c, 0x7e,
+	0x6f, 0x43, 0xf9, 0xe2, 0xde, 0x86, 0xf2, 0xb7, 0x7b, 0x1b, 0xca, 0x27, 0x5f, 0x6d, 0x9c, 0xfb,
+	0xe2, 0xab, 0x8d, 0x73, 0x5f, 0x7e, 0xb5, 0x71, 0xee, 0x9d, 0x27, 0xa4, 0x77, 0xc5, 0xfc, 0x52,
+	0xc2, 0xf5, 0x08, 0x7d, 0x18, 0x25, 0x5a, 0xe1, 0xcb, 0xe4, 0xdf, 0x8d, 0x15, 0x78, 0x71, 0x6f,
+	0x87, 0x93, 0xab, 0xdb, 0xa4, 0x5a, 0x73, 0xed, 0xc6, 0x24, 0xb3, 0xec, 0xb9, 0xff, 0x0c, 0x00,
+	0x37, 0x76, 0xdb, 0x25, 0x5f, 0x2d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1700,6 +1961,68 @@ func (m *Job) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.OwnershipGroupsHash) > 0 {
+		i -= len(m.OwnershipGroupsHash)
+		copy(dAtA[i:], m.OwnershipGroupsHash)
+		i = encodeVarintQueue(dAtA, i, uint64(len(m.OwnershipGroupsHash)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xea
+	}
+	if len(m.PodSpecsHash) > 0 {
+		i -= len(m.PodSpecsHash)
+		copy(dAtA[i:], m.PodSpecsHash)
+		i = encodeVarintQueue(dAtA, i, uint64(len(m.PodSpecsHash)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe2
+	}
+	if m.JobArrayIndex != 0 {
+		i = encodeVarintQueue(dAtA, i, uint64(m.JobArrayIndex))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd8
+	}
+	if len(m.JobArrayId) > 0 {
+		i -= len(m.JobArrayId)
+		copy(dAtA[i:], m.JobArrayId)
+		i = encodeVarintQueue(dAtA, i, uint64(len(m.JobArrayId)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd2
+	}
+	if m.RetryPending {
+		i--
+		if m.RetryPending {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc8
+	}
+	if m.FailedAttempts != 0 {
+		i = encodeVarintQueue(dAtA, i, uint64(m.FailedAttempts))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	if len(m.PodSpecsObjectKey) > 0 {
+		i -= len(m.PodSpecsObjectKey)
+		copy(dAtA[i:], m.PodSpecsObjectKey)
+		i = encodeVarintQueue(dAtA, i, uint64(len(m.PodSpecsObjectKey)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
 	if m.QueueTtlSeconds != 0 {
 		i = encodeVarintQueue(dAtA, i, uint64(m.QueueTtlSeconds))
 		i--
@@ -1970,6 +2293,65 @@ func (m *StreamingLeaseRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SecretsAndConfigMaps) > 0 {
+		for k := range m.SecretsAndConfigMaps {
+			v := m.SecretsAndConfigMaps[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintQueue(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintQueue(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintQueue(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.ExecutorVersion) > 0 {
+		i -= len(m.ExecutorVersion)
+		copy(dAtA[i:], m.ExecutorVersion)
+		i = encodeVarintQueue(dAtA, i, uint64(len(m.ExecutorVersion)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.ServiceAccounts) > 0 {
+		for k := range m.ServiceAccounts {
+			v := m.ServiceAccounts[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintQueue(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintQueue(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintQueue(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if len(m.ReceivedJobIds) > 0 {
 		for iNdEx := len(m.ReceivedJobIds) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.ReceivedJobIds[iNdEx])
@@ -2068,7 +2450,7 @@ func (m *StreamingLeaseRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *NodeInfo) Marshal() (dAtA []byte, err error) {
+func (m *ServiceAccounts) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2078,31 +2460,104 @@ func (m *NodeInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *NodeInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *ServiceAccounts) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *NodeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ServiceAccounts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.NodeType) > 0 {
-		i -= len(m.NodeType)
-		copy(dAtA[i:], m.NodeType)
-		i = encodeVarintQueue(dAtA, i, uint64(len(m.NodeType)))
-		i--
-		dAtA[i] = 0x62
+	if len(m.Names) > 0 {
+		for iNdEx := len(m.Names) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Names[iNdEx])
+			copy(dAtA[i:], m.Names[iNdEx])
+			i = encodeVarintQueue(dAtA, i, uint64(len(m.Names[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	if len(m.ResourceUsageByQueue) > 0 {
-		for k := range m.ResourceUsageByQueue {
-			v := m.ResourceUsageByQueue[k]
-			baseI := i
-			if v != nil {
-				{
-					size, err := v.MarshalToSizedBuffer(dAtA[:i])
-					if err != nil {
+	return len(dAtA) - i, nil
+}
+
+func (m *SecretsAndConfigMaps) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SecretsAndConfigMaps) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SecretsAndConfigMaps) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConfigMapNames) > 0 {
+		for iNdEx := len(m.ConfigMapNames) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ConfigMapNames[iNdEx])
+			copy(dAtA[i:], m.ConfigMapNames[iNdEx])
+			i = encodeVarintQueue(dAtA, i, uint64(len(m.ConfigMapNames[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.SecretNames) > 0 {
+		for iNdEx := len(m.SecretNames) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SecretNames[iNdEx])
+			copy(dAtA[i:], m.SecretNames[iNdEx])
+			i = encodeVarintQueue(dAtA, i, uint64(len(m.SecretNames[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NodeInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NodeInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NodeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NodeType) > 0 {
+		i -= len(m.NodeType)
+		copy(dAtA[i:], m.NodeType)
+		i = encodeVarintQueue(dAtA, i, uint64(len(m.NodeType)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.ResourceUsageByQueue) > 0 {
+		for k := range m.ResourceUsageByQueue {
+			v := m.ResourceUsageByQueue[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
 						return 0, err
 					}
 					i -= size
@@ -2327,6 +2782,35 @@ func (m *NodeType) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.NodeCount != 0 {
+		i = encodeVarintQueue(dAtA, i, uint64(m.NodeCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Capacity) > 0 {
+		for k := range m.Capacity {
+			v := m.Capacity[k]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQueue(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintQueue(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintQueue(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.AllocatableResources) > 0 {
 		for k := range m.AllocatableResources {
 			v := m.AllocatableResources[k]
@@ -2407,6 +2891,58 @@ func (m *ClusterSchedulingInfoReport) MarshalToSizedBuffer(dAtA []byte) (int, er
 	_ = i
 	var l int
 	_ = l
+	if len(m.SecretsAndConfigMaps) > 0 {
+		for k := range m.SecretsAndConfigMaps {
+			v := m.SecretsAndConfigMaps[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintQueue(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintQueue(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintQueue(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.ServiceAccounts) > 0 {
+		for k := range m.ServiceAccounts {
+			v := m.ServiceAccounts[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintQueue(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintQueue(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintQueue(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if len(m.Pool) > 0 {
 		i -= len(m.Pool)
 		copy(dAtA[i:], m.Pool)
@@ -2452,12 +2988,12 @@ func (m *ClusterSchedulingInfoReport) MarshalToSizedBuffer(dAtA []byte) (int, er
 			dAtA[i] = 0x2a
 		}
 	}
-	n15, err15 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ReportTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ReportTime):])
-	if err15 != nil {
-		return 0, err15
+	n20, err20 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ReportTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ReportTime):])
+	if err20 != nil {
+		return 0, err20
 	}
-	i -= n15
-	i = encodeVarintQueue(dAtA, i, uint64(n15))
+	i -= n20
+	i = encodeVarintQueue(dAtA, i, uint64(n20))
 	i--
 	dAtA[i] = 0x12
 	if len(m.ClusterId) > 0 {
@@ -2580,12 +3116,12 @@ func (m *ClusterLeasedReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x1a
 		}
 	}
-	n18, err18 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ReportTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ReportTime):])
-	if err18 != nil {
-		return 0, err18
+	n23, err23 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ReportTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ReportTime):])
+	if err23 != nil {
+		return 0, err23
 	}
-	i -= n18
-	i = encodeVarintQueue(dAtA, i, uint64(n18))
+	i -= n23
+	i = encodeVarintQueue(dAtA, i, uint64(n23))
 	i--
 	dAtA[i] = 0x12
 	if len(m.ClusterId) > 0 {
@@ -3129,6 +3665,31 @@ func (m *Job) Size() (n int) {
 	if m.QueueTtlSeconds != 0 {
 		n += 2 + sovQueue(uint64(m.QueueTtlSeconds))
 	}
+	l = len(m.PodSpecsObjectKey)
+	if l > 0 {
+		n += 2 + l + sovQueue(uint64(l))
+	}
+	if m.FailedAttempts != 0 {
+		n += 2 + sovQueue(uint64(m.FailedAttempts))
+	}
+	if m.RetryPending {
+		n += 3
+	}
+	l = len(m.JobArrayId)
+	if l > 0 {
+		n += 2 + l + sovQueue(uint64(l))
+	}
+	if m.JobArrayIndex != 0 {
+		n += 2 + sovQueue(uint64(m.JobArrayIndex))
+	}
+	l = len(m.PodSpecsHash)
+	if l > 0 {
+		n += 2 + l + sovQueue(uint64(l))
+	}
+	l = len(m.OwnershipGroupsHash)
+	if l > 0 {
+		n += 2 + l + sovQueue(uint64(l))
+	}
 	return n
 }
 
@@ -3178,6 +3739,72 @@ func (m *StreamingLeaseRequest) Size() (n int) {
 			n += 1 + l + sovQueue(uint64(l))
 		}
 	}
+	if len(m.ServiceAccounts) > 0 {
+		for k, v := range m.ServiceAccounts {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovQueue(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovQueue(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovQueue(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.ExecutorVersion)
+	if l > 0 {
+		n += 1 + l + sovQueue(uint64(l))
+	}
+	if len(m.SecretsAndConfigMaps) > 0 {
+		for k, v := range m.SecretsAndConfigMaps {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovQueue(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovQueue(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovQueue(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *ServiceAccounts) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Names) > 0 {
+		for _, s := range m.Names {
+			l = len(s)
+			n += 1 + l + sovQueue(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SecretsAndConfigMaps) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SecretNames) > 0 {
+		for _, s := range m.SecretNames {
+			l = len(s)
+			n += 1 + l + sovQueue(uint64(l))
+		}
+	}
+	if len(m.ConfigMapNames) > 0 {
+		for _, s := range m.ConfigMapNames {
+			l = len(s)
+			n += 1 + l + sovQueue(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -3310,6 +3937,18 @@ func (m *NodeType) Size() (n int) {
 			n += mapEntrySize + 1 + sovQueue(uint64(mapEntrySize))
 		}
 	}
+	if len(m.Capacity) > 0 {
+		for k, v := range m.Capacity {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovQueue(uint64(len(k))) + 1 + l + sovQueue(uint64(l))
+			n += mapEntrySize + 1 + sovQueue(uint64(mapEntrySize))
+		}
+	}
+	if m.NodeCount != 0 {
+		n += 1 + sovQueue(uint64(m.NodeCount))
+	}
 	return n
 }
 
@@ -3344,6 +3983,32 @@ func (m *ClusterSchedulingInfoReport) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQueue(uint64(l))
 	}
+	if len(m.ServiceAccounts) > 0 {
+		for k, v := range m.ServiceAccounts {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovQueue(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovQueue(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovQueue(uint64(mapEntrySize))
+		}
+	}
+	if len(m.SecretsAndConfigMaps) > 0 {
+		for k, v := range m.SecretsAndConfigMaps {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovQueue(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovQueue(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovQueue(uint64(mapEntrySize))
+		}
+	}
 	return n
 }
 
@@ -3662,6 +4327,13 @@ func (this *Job) String() string {
 		`Scheduler:` + fmt.Sprintf("%v", this.Scheduler) + `,`,
 		`SchedulingResourceRequirements:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.SchedulingResourceRequirements), "ResourceRequirements", "v1.ResourceRequirements", 1), `&`, ``, 1) + `,`,
 		`QueueTtlSeconds:` + fmt.Sprintf("%v", this.QueueTtlSeconds) + `,`,
+		`PodSpecsObjectKey:` + fmt.Sprintf("%v", this.PodSpecsObjectKey) + `,`,
+		`FailedAttempts:` + fmt.Sprintf("%v", this.FailedAttempts) + `,`,
+		`RetryPending:` + fmt.Sprintf("%v", this.RetryPending) + `,`,
+		`JobArrayId:` + fmt.Sprintf("%v", this.JobArrayId) + `,`,
+		`JobArrayIndex:` + fmt.Sprintf("%v", this.JobArrayIndex) + `,`,
+		`PodSpecsHash:` + fmt.Sprintf("%v", this.PodSpecsHash) + `,`,
+		`OwnershipGroupsHash:` + fmt.Sprintf("%v", this.OwnershipGroupsHash) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3695,6 +4367,26 @@ func (this *StreamingLeaseRequest) String() string {
 		mapStringForMinimumJobSize += fmt.Sprintf("%v: %v,", k, this.MinimumJobSize[k])
 	}
 	mapStringForMinimumJobSize += "}"
+	keysForServiceAccounts := make([]string, 0, len(this.ServiceAccounts))
+	for k, _ := range this.ServiceAccounts {
+		keysForServiceAccounts = append(keysForServiceAccounts, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForServiceAccounts)
+	mapStringForServiceAccounts := "map[string]*ServiceAccounts{"
+	for _, k := range keysForServiceAccounts {
+		mapStringForServiceAccounts += fmt.Sprintf("%v: %v,", k, this.ServiceAccounts[k])
+	}
+	mapStringForServiceAccounts += "}"
+	keysForSecretsAndConfigMaps := make([]string, 0, len(this.SecretsAndConfigMaps))
+	for k, _ := range this.SecretsAndConfigMaps {
+		keysForSecretsAndConfigMaps = append(keysForSecretsAndConfigMaps, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForSecretsAndConfigMaps)
+	mapStringForSecretsAndConfigMaps := "map[string]*SecretsAndConfigMaps{"
+	for _, k := range keysForSecretsAndConfigMaps {
+		mapStringForSecretsAndConfigMaps += fmt.Sprintf("%v: %v,", k, this.SecretsAndConfigMaps[k])
+	}
+	mapStringForSecretsAndConfigMaps += "}"
 	s := strings.Join([]string{`&StreamingLeaseRequest{`,
 		`ClusterId:` + fmt.Sprintf("%v", this.ClusterId) + `,`,
 		`Pool:` + fmt.Sprintf("%v", this.Pool) + `,`,
@@ -3703,6 +4395,30 @@ func (this *StreamingLeaseRequest) String() string {
 		`MinimumJobSize:` + mapStringForMinimumJobSize + `,`,
 		`Nodes:` + repeatedStringForNodes + `,`,
 		`ReceivedJobIds:` + fmt.Sprintf("%v", this.ReceivedJobIds) + `,`,
+		`ServiceAccounts:` + mapStringForServiceAccounts + `,`,
+		`ExecutorVersion:` + fmt.Sprintf("%v", this.ExecutorVersion) + `,`,
+		`SecretsAndConfigMaps:` + mapStringForSecretsAndConfigMaps + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ServiceAccounts) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ServiceAccounts{`,
+		`Names:` + fmt.Sprintf("%v", this.Names) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *SecretsAndConfigMaps) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&SecretsAndConfigMaps{`,
+		`SecretNames:` + fmt.Sprintf("%v", this.SecretNames) + `,`,
+		`ConfigMapNames:` + fmt.Sprintf("%v", this.ConfigMapNames) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3842,10 +4558,22 @@ func (this *NodeType) String() string {
 		mapStringForAllocatableResources += fmt.Sprintf("%v: %v,", k, this.AllocatableResources[k])
 	}
 	mapStringForAllocatableResources += "}"
+	keysForCapacity := make([]string, 0, len(this.Capacity))
+	for k, _ := range this.Capacity {
+		keysForCapacity = append(keysForCapacity, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForCapacity)
+	mapStringForCapacity := "map[string]resource.Quantity{"
+	for _, k := range keysForCapacity {
+		mapStringForCapacity += fmt.Sprintf("%v: %v,", k, this.Capacity[k])
+	}
+	mapStringForCapacity += "}"
 	s := strings.Join([]string{`&NodeType{`,
 		`Taints:` + repeatedStringForTaints + `,`,
 		`Labels:` + mapStringForLabels + `,`,
 		`AllocatableResources:` + mapStringForAllocatableResources + `,`,
+		`Capacity:` + mapStringForCapacity + `,`,
+		`NodeCount:` + fmt.Sprintf("%v", this.NodeCount) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3869,12 +4597,34 @@ func (this *ClusterSchedulingInfoReport) String() string {
 		mapStringForMinimumJobSize += fmt.Sprintf("%v: %v,", k, this.MinimumJobSize[k])
 	}
 	mapStringForMinimumJobSize += "}"
+	keysForServiceAccounts := make([]string, 0, len(this.ServiceAccounts))
+	for k, _ := range this.ServiceAccounts {
+		keysForServiceAccounts = append(keysForServiceAccounts, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForServiceAccounts)
+	mapStringForServiceAccounts := "map[string]*ServiceAccounts{"
+	for _, k := range keysForServiceAccounts {
+		mapStringForServiceAccounts += fmt.Sprintf("%v: %v,", k, this.ServiceAccounts[k])
+	}
+	mapStringForServiceAccounts += "}"
+	keysForSecretsAndConfigMaps := make([]string, 0, len(this.SecretsAndConfigMaps))
+	for k, _ := range this.SecretsAndConfigMaps {
+		keysForSecretsAndConfigMaps = append(keysForSecretsAndConfigMaps, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForSecretsAndConfigMaps)
+	mapStringForSecretsAndConfigMaps := "map[string]*SecretsAndConfigMaps{"
+	for _, k := range keysForSecretsAndConfigMaps {
+		mapStringForSecretsAndConfigMaps += fmt.Sprintf("%v: %v,", k, this.SecretsAndConfigMaps[k])
+	}
+	mapStringForSecretsAndConfigMaps += "}"
 	s := strings.Join([]string{`&ClusterSchedulingInfoReport{`,
 		`ClusterId:` + fmt.Sprintf("%v", this.ClusterId) + `,`,
 		`ReportTime:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ReportTime), "Timestamp", "types.Timestamp", 1), `&`, ``, 1) + `,`,
 		`NodeTypes:` + repeatedStringForNodeTypes + `,`,
 		`MinimumJobSize:` + mapStringForMinimumJobSize + `,`,
 		`Pool:` + fmt.Sprintf("%v", this.Pool) + `,`,
+		`ServiceAccounts:` + mapStringForServiceAccounts + `,`,
+		`SecretsAndConfigMaps:` + mapStringForSecretsAndConfigMaps + `,`,
 		`}`,
 	}, "")
 	return s
@@ -5078,13 +5828,199 @@ func (m *Job) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQueue(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodSpecsObjectKey", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PodSpecsObjectKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedAttempts", wireType)
+			}
+			m.FailedAttempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailedAttempts |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 25:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryPending", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RetryPending = bool(v != 0)
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobArrayId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobArrayId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 27:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobArrayIndex", wireType)
+			}
+			m.JobArrayIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JobArrayIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodSpecsHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PodSpecsHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnershipGroupsHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnershipGroupsHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueue(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQueue
 			}
 			if (iNdEx + skippy) > l {
@@ -5549,91 +6485,9 @@ func (m *StreamingLeaseRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.ReceivedJobIds = append(m.ReceivedJobIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQueue(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQueue
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *NodeInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQueue
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: NodeInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NodeInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQueue
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQueue
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQueue
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Taints", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccounts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5660,14 +6514,967 @@ func (m *NodeInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Taints = append(m.Taints, v1.Taint{})
-			if err := m.Taints[len(m.Taints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.ServiceAccounts == nil {
+				m.ServiceAccounts = make(map[string]*ServiceAccounts)
 			}
+			var mapkey string
+			var mapvalue *ServiceAccounts
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQueue
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQueue
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthQueue
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthQueue
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQueue
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthQueue
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthQueue
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &ServiceAccounts{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipQueue(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthQueue
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ServiceAccounts[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutorVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExecutorVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretsAndConfigMaps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueue
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SecretsAndConfigMaps == nil {
+				m.SecretsAndConfigMaps = make(map[string]*SecretsAndConfigMaps)
+			}
+			var mapkey string
+			var mapvalue *SecretsAndConfigMaps
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQueue
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQueue
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthQueue
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthQueue
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQueue
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthQueue
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthQueue
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &SecretsAndConfigMaps{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipQueue(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthQueue
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.SecretsAndConfigMaps[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueue(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ServiceAccounts) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueue
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ServiceAccounts: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ServiceAccounts: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Names", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Names = append(m.Names, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueue(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SecretsAndConfigMaps) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueue
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SecretsAndConfigMaps: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SecretsAndConfigMaps: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretNames", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecretNames = append(m.SecretNames, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMapNames", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConfigMapNames = append(m.ConfigMapNames, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueue(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NodeInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueue
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NodeInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NodeInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Taints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueue
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Taints = append(m.Taints, v1.Taint{})
+			if err := m.Taints[len(m.Taints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueue
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQueue
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQueue
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthQueue
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthQueue
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQueue
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthQueue
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthQueue
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipQueue(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthQueue
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllocatableResources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueue
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AllocatableResources == nil {
+				m.AllocatableResources = make(map[string]resource.Quantity)
+			}
+			var mapkey string
+			mapvalue := &resource.Quantity{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQueue
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQueue
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthQueue
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthQueue
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQueue
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthQueue
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthQueue
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipQueue(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthQueue
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.AllocatableResources[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AvailableResources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueue
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AvailableResources == nil {
+				m.AvailableResources = make(map[string]resource.Quantity)
+			}
+			var mapkey string
+			mapvalue := &resource.Quantity{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQueue
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQueue
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthQueue
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthQueue
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQueue
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthQueue
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthQueue
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipQueue(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthQueue
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.AvailableResources[mapkey] = *mapvalue
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalResources", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5694,11 +7501,11 @@ func (m *NodeInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
+			if m.TotalResources == nil {
+				m.TotalResources = make(map[string]resource.Quantity)
 			}
 			var mapkey string
-			var mapvalue string
+			mapvalue := &resource.Quantity{}
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -5747,7 +7554,7 @@ func (m *NodeInfo) Unmarshal(dAtA []byte) error {
 					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
+					var mapmsglen int
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowQueue
@@ -5757,24 +7564,26 @@ func (m *NodeInfo) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
+						mapmsglen |= int(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
+					if mapmsglen < 0 {
 						return ErrInvalidLengthQueue
 					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
 						return ErrInvalidLengthQueue
 					}
-					if postStringIndexmapvalue > l {
+					if postmsgIndex > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
 				} else {
 					iNdEx = entryPreIndex
 					skippy, err := skipQueue(dAtA[iNdEx:])
@@ -5790,11 +7599,11 @@ func (m *NodeInfo) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Labels[mapkey] = mapvalue
+			m.TotalResources[mapkey] = *mapvalue
 			iNdEx = postIndex
-		case 4:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllocatableResources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllocatedResources", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5821,11 +7630,11 @@ func (m *NodeInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AllocatableResources == nil {
-				m.AllocatableResources = make(map[string]resource.Quantity)
+			if m.AllocatedResources == nil {
+				m.AllocatedResources = make(map[int32]ComputeResource)
 			}
-			var mapkey string
-			mapvalue := &resource.Quantity{}
+			var mapkey int32
+			mapvalue := &ComputeResource{}
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -5845,7 +7654,6 @@ func (m *NodeInfo) Unmarshal(dAtA []byte) error {
 				}
 				fieldNum := int32(wire >> 3)
 				if fieldNum == 1 {
-					var stringLenmapkey uint64
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowQueue
@@ -5855,24 +7663,11 @@ func (m *NodeInfo) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
+						mapkey |= int32(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthQueue
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthQueue
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
 					var mapmsglen int
 					for shift := uint(0); ; shift += 7 {
@@ -5899,7 +7694,7 @@ func (m *NodeInfo) Unmarshal(dAtA []byte) error {
 					if postmsgIndex > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapvalue = &resource.Quantity{}
+					mapvalue = &ComputeResource{}
 					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
 						return err
 					}
@@ -5919,11 +7714,11 @@ func (m *NodeInfo) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.AllocatableResources[mapkey] = *mapvalue
+			m.AllocatedResources[mapkey] = *mapvalue
 			iNdEx = postIndex
-		case 5:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AvailableResources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RunIdsByState", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5950,11 +7745,11 @@ func (m *NodeInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AvailableResources == nil {
-				m.AvailableResources = make(map[string]resource.Quantity)
+			if m.RunIdsByState == nil {
+				m.RunIdsByState = make(map[string]JobState)
 			}
 			var mapkey string
-			mapvalue := &resource.Quantity{}
+			var mapvalue JobState
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -6003,7 +7798,6 @@ func (m *NodeInfo) Unmarshal(dAtA []byte) error {
 					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
-					var mapmsglen int
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowQueue
@@ -6013,26 +7807,11 @@ func (m *NodeInfo) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
+						mapvalue |= JobState(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthQueue
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthQueue
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &resource.Quantity{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
 				} else {
 					iNdEx = entryPreIndex
 					skippy, err := skipQueue(dAtA[iNdEx:])
@@ -6048,11 +7827,11 @@ func (m *NodeInfo) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.AvailableResources[mapkey] = *mapvalue
+			m.RunIdsByState[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 6:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalResources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NonArmadaAllocatedResources", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6079,11 +7858,11 @@ func (m *NodeInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TotalResources == nil {
-				m.TotalResources = make(map[string]resource.Quantity)
+			if m.NonArmadaAllocatedResources == nil {
+				m.NonArmadaAllocatedResources = make(map[int32]ComputeResource)
 			}
-			var mapkey string
-			mapvalue := &resource.Quantity{}
+			var mapkey int32
+			mapvalue := &ComputeResource{}
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -6103,7 +7882,6 @@ func (m *NodeInfo) Unmarshal(dAtA []byte) error {
 				}
 				fieldNum := int32(wire >> 3)
 				if fieldNum == 1 {
-					var stringLenmapkey uint64
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowQueue
@@ -6113,24 +7891,11 @@ func (m *NodeInfo) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
+						mapkey |= int32(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthQueue
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthQueue
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
 					var mapmsglen int
 					for shift := uint(0); ; shift += 7 {
@@ -6157,7 +7922,7 @@ func (m *NodeInfo) Unmarshal(dAtA []byte) error {
 					if postmsgIndex > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapvalue = &resource.Quantity{}
+					mapvalue = &ComputeResource{}
 					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
 						return err
 					}
@@ -6177,11 +7942,31 @@ func (m *NodeInfo) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.TotalResources[mapkey] = *mapvalue
+			m.NonArmadaAllocatedResources[mapkey] = *mapvalue
 			iNdEx = postIndex
-		case 7:
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unschedulable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Unschedulable = bool(v != 0)
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllocatedResources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceUsageByQueue", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6208,11 +7993,11 @@ func (m *NodeInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AllocatedResources == nil {
-				m.AllocatedResources = make(map[int32]ComputeResource)
+			if m.ResourceUsageByQueue == nil {
+				m.ResourceUsageByQueue = make(map[string]*ComputeResource)
 			}
-			var mapkey int32
-			mapvalue := &ComputeResource{}
+			var mapkey string
+			var mapvalue *ComputeResource
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -6232,6 +8017,7 @@ func (m *NodeInfo) Unmarshal(dAtA []byte) error {
 				}
 				fieldNum := int32(wire >> 3)
 				if fieldNum == 1 {
+					var stringLenmapkey uint64
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowQueue
@@ -6241,11 +8027,24 @@ func (m *NodeInfo) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						mapkey |= int32(b&0x7F) << shift
+						stringLenmapkey |= uint64(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthQueue
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthQueue
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
 					var mapmsglen int
 					for shift := uint(0); ; shift += 7 {
@@ -6292,11 +8091,127 @@ func (m *NodeInfo) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.AllocatedResources[mapkey] = *mapvalue
+			m.ResourceUsageByQueue[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueue(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NodeType) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueue
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NodeType: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NodeType: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Taints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueue
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Taints = append(m.Taints, v1.Taint{})
+			if err := m.Taints[len(m.Taints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RunIdsByState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6323,11 +8238,11 @@ func (m *NodeInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RunIdsByState == nil {
-				m.RunIdsByState = make(map[string]JobState)
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
 			}
 			var mapkey string
-			var mapvalue JobState
+			var mapvalue string
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -6376,6 +8291,7 @@ func (m *NodeInfo) Unmarshal(dAtA []byte) error {
 					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowQueue
@@ -6385,11 +8301,24 @@ func (m *NodeInfo) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						mapvalue |= JobState(b&0x7F) << shift
+						stringLenmapvalue |= uint64(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthQueue
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthQueue
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
 				} else {
 					iNdEx = entryPreIndex
 					skippy, err := skipQueue(dAtA[iNdEx:])
@@ -6405,11 +8334,11 @@ func (m *NodeInfo) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.RunIdsByState[mapkey] = mapvalue
+			m.Labels[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NonArmadaAllocatedResources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllocatableResources", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6436,11 +8365,11 @@ func (m *NodeInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.NonArmadaAllocatedResources == nil {
-				m.NonArmadaAllocatedResources = make(map[int32]ComputeResource)
+			if m.AllocatableResources == nil {
+				m.AllocatableResources = make(map[string]resource.Quantity)
 			}
-			var mapkey int32
-			mapvalue := &ComputeResource{}
+			var mapkey string
+			mapvalue := &resource.Quantity{}
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -6460,6 +8389,7 @@ func (m *NodeInfo) Unmarshal(dAtA []byte) error {
 				}
 				fieldNum := int32(wire >> 3)
 				if fieldNum == 1 {
+					var stringLenmapkey uint64
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowQueue
@@ -6469,11 +8399,24 @@ func (m *NodeInfo) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						mapkey |= int32(b&0x7F) << shift
+						stringLenmapkey |= uint64(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthQueue
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthQueue
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
 					var mapmsglen int
 					for shift := uint(0); ; shift += 7 {
@@ -6500,7 +8443,7 @@ func (m *NodeInfo) Unmarshal(dAtA []byte) error {
 					if postmsgIndex > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapvalue = &ComputeResource{}
+					mapvalue = &resource.Quantity{}
 					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
 						return err
 					}
@@ -6520,31 +8463,11 @@ func (m *NodeInfo) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.NonArmadaAllocatedResources[mapkey] = *mapvalue
+			m.AllocatableResources[mapkey] = *mapvalue
 			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Unschedulable", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQueue
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Unschedulable = bool(v != 0)
-		case 11:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceUsageByQueue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6571,11 +8494,11 @@ func (m *NodeInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ResourceUsageByQueue == nil {
-				m.ResourceUsageByQueue = make(map[string]*ComputeResource)
+			if m.Capacity == nil {
+				m.Capacity = make(map[string]resource.Quantity)
 			}
 			var mapkey string
-			var mapvalue *ComputeResource
+			mapvalue := &resource.Quantity{}
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -6649,7 +8572,7 @@ func (m *NodeInfo) Unmarshal(dAtA []byte) error {
 					if postmsgIndex > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapvalue = &ComputeResource{}
+					mapvalue = &resource.Quantity{}
 					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
 						return err
 					}
@@ -6669,13 +8592,13 @@ func (m *NodeInfo) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.ResourceUsageByQueue[mapkey] = mapvalue
+			m.Capacity[mapkey] = *mapvalue
 			iNdEx = postIndex
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeType", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeCount", wireType)
 			}
-			var stringLen uint64
+			m.NodeCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQueue
@@ -6685,24 +8608,11 @@ func (m *NodeInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.NodeCount |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQueue
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQueue
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NodeType = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQueue(dAtA[iNdEx:])
@@ -6724,7 +8634,7 @@ func (m *NodeInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NodeType) Unmarshal(dAtA []byte) error {
+func (m *ClusterSchedulingInfoReport) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6747,15 +8657,47 @@ func (m *NodeType) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NodeType: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterSchedulingInfoReport: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NodeType: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterSchedulingInfoReport: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Taints", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReportTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6782,14 +8724,13 @@ func (m *NodeType) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Taints = append(m.Taints, v1.Taint{})
-			if err := m.Taints[len(m.Taints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ReportTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeTypes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6816,11 +8757,45 @@ func (m *NodeType) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
+			m.NodeTypes = append(m.NodeTypes, &NodeType{})
+			if err := m.NodeTypes[len(m.NodeTypes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinimumJobSize", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueue
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MinimumJobSize == nil {
+				m.MinimumJobSize = make(map[string]resource.Quantity)
 			}
 			var mapkey string
-			var mapvalue string
+			mapvalue := &resource.Quantity{}
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -6869,7 +8844,7 @@ func (m *NodeType) Unmarshal(dAtA []byte) error {
 					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
+					var mapmsglen int
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowQueue
@@ -6879,24 +8854,26 @@ func (m *NodeType) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
+						mapmsglen |= int(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
+					if mapmsglen < 0 {
 						return ErrInvalidLengthQueue
 					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
 						return ErrInvalidLengthQueue
 					}
-					if postStringIndexmapvalue > l {
+					if postmsgIndex > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
 				} else {
 					iNdEx = entryPreIndex
 					skippy, err := skipQueue(dAtA[iNdEx:])
@@ -6912,11 +8889,43 @@ func (m *NodeType) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Labels[mapkey] = mapvalue
+			m.MinimumJobSize[mapkey] = *mapvalue
 			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllocatableResources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pool = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceAccounts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6943,11 +8952,11 @@ func (m *NodeType) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AllocatableResources == nil {
-				m.AllocatableResources = make(map[string]resource.Quantity)
+			if m.ServiceAccounts == nil {
+				m.ServiceAccounts = make(map[string]*ServiceAccounts)
 			}
 			var mapkey string
-			mapvalue := &resource.Quantity{}
+			var mapvalue *ServiceAccounts
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -7021,7 +9030,7 @@ func (m *NodeType) Unmarshal(dAtA []byte) error {
 					if postmsgIndex > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapvalue = &resource.Quantity{}
+					mapvalue = &ServiceAccounts{}
 					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
 						return err
 					}
@@ -7041,160 +9050,11 @@ func (m *NodeType) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.AllocatableResources[mapkey] = *mapvalue
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQueue(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQueue
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ClusterSchedulingInfoReport) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQueue
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterSchedulingInfoReport: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterSchedulingInfoReport: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQueue
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQueue
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQueue
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ClusterId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReportTime", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQueue
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQueue
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQueue
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ReportTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeTypes", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQueue
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQueue
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQueue
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NodeTypes = append(m.NodeTypes, &NodeType{})
-			if err := m.NodeTypes[len(m.NodeTypes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ServiceAccounts[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 6:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinimumJobSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretsAndConfigMaps", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7221,11 +9081,11 @@ func (m *ClusterSchedulingInfoReport) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MinimumJobSize == nil {
-				m.MinimumJobSize = make(map[string]resource.Quantity)
+			if m.SecretsAndConfigMaps == nil {
+				m.SecretsAndConfigMaps = make(map[string]*SecretsAndConfigMaps)
 			}
 			var mapkey string
-			mapvalue := &resource.Quantity{}
+			var mapvalue *SecretsAndConfigMaps
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -7299,7 +9159,7 @@ func (m *ClusterSchedulingInfoReport) Unmarshal(dAtA []byte) error {
 					if postmsgIndex > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapvalue = &resource.Quantity{}
+					mapvalue = &SecretsAndConfigMaps{}
 					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
 						return err
 					}
@@ -7319,39 +9179,7 @@ func (m *ClusterSchedulingInfoReport) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.MinimumJobSize[mapkey] = *mapvalue
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQueue
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQueue
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQueue
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Pool = string(dAtA[iNdEx:postIndex])
+			m.SecretsAndConfigMaps[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2,6 +2,7 @@ package api
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/gogo/status"
 	"google.golang.org/grpc"
@@ -25,3 +26,30 @@ func (c *CustomSubmitClient) SubmitJobs(ctx context.Context, in *JobSubmitReques
 	}
 	return out, nil
 }
+
+// NewFailedJobSubmitResponseItem returns a response item reporting that the job with id jobId was rejected.
+// Error is set to the message of the structured error, for clients that still read it.
+func NewFailedJobSubmitResponseItem(jobId string, code JobSubmitError_Code, field string, message string) *JobSubmitResponseItem {
+	return &JobSubmitResponseItem{
+		JobId:        jobId,
+		Error:        message,
+		ErrorDetails: &JobSubmitError{Code: code, Field: field, Message: message},
+	}
+}
+
+// Failed returns true if the job of item was rejected. Duplicate jobs aren't failures.
+func (item *JobSubmitResponseItem) Failed() bool {
+	return item.Error != "" || (item.ErrorDetails != nil && item.ErrorDetails.Code != JobSubmitError_DUPLICATE)
+}
+
+// ErrorString describes why the job of item was rejected, including the error code and field if known.
+func (item *JobSubmitResponseItem) ErrorString() string {
+	details := item.ErrorDetails
+	if details == nil {
+		return item.Error
+	}
+	if details.Field != "" {
+		return fmt.Sprintf("%s: %s (field %s)", details.Code, details.Message, details.Field)
+	}
+	return fmt.Sprintf("%s: %s", details.Code, details.Message)
+}
@@ -0,0 +1,28 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJobSubmitResponseItem_Failed(t *testing.T) {
+	assert.False(t, (&JobSubmitResponseItem{JobId: "job"}).Failed())
+	assert.True(t, (&JobSubmitResponseItem{JobId: "job", Error: "invalid"}).Failed())
+	assert.True(t, NewFailedJobSubmitResponseItem("job", JobSubmitError_UNSCHEDULABLE, "", "unschedulable").Failed())
+	assert.False(t, (&JobSubmitResponseItem{JobId: "job", ErrorDetails: &JobSubmitError{Code: JobSubmitError_DUPLICATE}}).Failed())
+}
+
+func TestJobSubmitResponseItem_ErrorString(t *testing.T) {
+	assert.Equal(t, "invalid", (&JobSubmitResponseItem{Error: "invalid"}).ErrorString())
+	assert.Equal(
+		t,
+		"INVALID_JOB: invalid priority (field priority)",
+		NewFailedJobSubmitResponseItem("job", JobSubmitError_INVALID_JOB, "priority", "invalid priority").ErrorString(),
+	)
+	assert.Equal(
+		t,
+		"UNSCHEDULABLE: can't be scheduled",
+		NewFailedJobSubmitResponseItem("job", JobSubmitError_UNSCHEDULABLE, "", "can't be scheduled").ErrorString(),
+	)
+}
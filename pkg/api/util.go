@@ -3,6 +3,7 @@ package api
 import (
 	"fmt"
 	math "math"
+	"strconv"
 	"strings"
 	time "time"
 
@@ -326,6 +327,34 @@ func (job *JobSubmitRequestItem) GetMainPodSpec() *v1.PodSpec {
 	return nil
 }
 
+const (
+	// JobArrayIndexLabel is set to the index of each job of a job array.
+	JobArrayIndexLabel = "armadaproject.io/jobArrayIndex"
+	// JobArrayIndexEnvVar is set to the index of each job of a job array in each of its containers.
+	JobArrayIndexEnvVar = "ARMADA_JOB_ARRAY_INDEX"
+)
+
+// SetJobArrayIndexEnvVar sets JobArrayIndexEnvVar to index in each container of podSpec,
+// replacing any value it was set to before.
+func SetJobArrayIndexEnvVar(podSpec *v1.PodSpec, index uint32) {
+	value := strconv.FormatUint(uint64(index), 10)
+	for _, containers := range [][]v1.Container{podSpec.InitContainers, podSpec.Containers} {
+		for i := range containers {
+			container := &containers[i]
+			found := false
+			for j := range container.Env {
+				if container.Env[j].Name == JobArrayIndexEnvVar {
+					container.Env[j] = v1.EnvVar{Name: JobArrayIndexEnvVar, Value: value}
+					found = true
+				}
+			}
+			if !found {
+				container.Env = append(container.Env, v1.EnvVar{Name: JobArrayIndexEnvVar, Value: value})
+			}
+		}
+	}
+}
+
 func (job *Job) TotalResourceRequest() armadaresource.ComputeResources {
 	podSpec := job.GetMainPodSpec()
 	return armadaresource.TotalPodResourceRequest(podSpec)
@@ -408,6 +437,28 @@ func JobIdFromApiEvent(msg *EventMessage) string {
 		return e.Updated.JobId
 	case *EventMessage_Preempted:
 		return e.Preempted.JobId
+	case *EventMessage_JobSetExpired:
+		return e.JobSetExpired.JobId
+	case *EventMessage_Suspended:
+		return e.Suspended.JobId
+	case *EventMessage_Resumed:
+		return e.Resumed.JobId
+	case *EventMessage_EvictedForCapacity:
+		return e.EvictedForCapacity.JobId
+	case *EventMessage_RuntimeExceeded:
+		return e.RuntimeExceeded.JobId
+	case *EventMessage_ResourcesNormalized:
+		return e.ResourcesNormalized.JobId
+	case *EventMessage_Unschedulable:
+		return e.Unschedulable.JobId
+	case *EventMessage_MaintenanceWindowStarted:
+		return e.MaintenanceWindowStarted.JobId
+	case *EventMessage_MaintenanceWindowEnded:
+		return e.MaintenanceWindowEnded.JobId
+	case *EventMessage_Cordoned:
+		return e.Cordoned.JobId
+	case *EventMessage_Uncordoned:
+		return e.Uncordoned.JobId
 	}
 	return ""
 }
@@ -454,6 +505,41 @@ func JobSetIdFromApiEvent(msg *EventMessage) string {
 		return e.Updated.JobSetId
 	case *EventMessage_Preempted:
 		return e.Preempted.JobSetId
+	case *EventMessage_JobSetExpired:
+		return e.JobSetExpired.JobSetId
+	case *EventMessage_Suspended:
+		return e.Suspended.JobSetId
+	case *EventMessage_Resumed:
+		return e.Resumed.JobSetId
+	case *EventMessage_EvictedForCapacity:
+		return e.EvictedForCapacity.JobSetId
+	case *EventMessage_RuntimeExceeded:
+		return e.RuntimeExceeded.JobSetId
+	case *EventMessage_ResourcesNormalized:
+		return e.ResourcesNormalized.JobSetId
+	case *EventMessage_Unschedulable:
+		return e.Unschedulable.JobSetId
+	case *EventMessage_MaintenanceWindowStarted:
+		return e.MaintenanceWindowStarted.JobSetId
+	case *EventMessage_MaintenanceWindowEnded:
+		return e.MaintenanceWindowEnded.JobSetId
+	case *EventMessage_Cordoned:
+		return e.Cordoned.JobSetId
+	case *EventMessage_Uncordoned:
+		return e.Uncordoned.JobSetId
 	}
 	return ""
 }
+
+const queueDeletionOperationPrefix = "delete-queue-"
+
+// QueueDeletionOperationName returns the name of the operation reporting the progress of a cascading deletion of queue.
+func QueueDeletionOperationName(queue string) string {
+	return queueDeletionOperationPrefix + queue
+}
+
+// QueueOfDeletionOperation returns the queue deleted by the operation with the given name,
+// and false if the name isn't that of a queue deletion.
+func QueueOfDeletionOperation(name string) (string, bool) {
+	return strings.CutPrefix(name, queueDeletionOperationPrefix)
+}
@@ -204,6 +204,17 @@ type EventSequence_Event struct {
 	//	*EventSequence_Event_PartitionMarker
 	//	*EventSequence_Event_JobRunPreemptionRequested
 	//	*EventSequence_Event_JobRequeued
+	//	*EventSequence_Event_JobSetExpired
+	//	*EventSequence_Event_JobSuspended
+	//	*EventSequence_Event_JobResumed
+	//	*EventSequence_Event_JobEvictedForCapacity
+	//	*EventSequence_Event_JobRuntimeExceeded
+	//	*EventSequence_Event_JobResourcesNormalized
+	//	*EventSequence_Event_JobUnschedulable
+	//	*EventSequence_Event_JobMaintenanceWindowStarted
+	//	*EventSequence_Event_JobMaintenanceWindowEnded
+	//	*EventSequence_Event_JobCordoned
+	//	*EventSequence_Event_JobUncordoned
 	Event isEventSequence_Event_Event `protobuf_oneof:"event"`
 }
 
@@ -309,28 +320,72 @@ type EventSequence_Event_JobRunPreemptionRequested struct {
 type EventSequence_Event_JobRequeued struct {
 	JobRequeued *JobRequeued `protobuf:"bytes,22,opt,name=jobRequeued,proto3,oneof" json:"jobRequeued,omitempty"`
 }
-
-func (*EventSequence_Event_SubmitJob) isEventSequence_Event_Event()                 {}
-func (*EventSequence_Event_ReprioritiseJob) isEventSequence_Event_Event()           {}
-func (*EventSequence_Event_ReprioritiseJobSet) isEventSequence_Event_Event()        {}
-func (*EventSequence_Event_ReprioritisedJob) isEventSequence_Event_Event()          {}
-func (*EventSequence_Event_CancelJob) isEventSequence_Event_Event()                 {}
-func (*EventSequence_Event_CancelJobSet) isEventSequence_Event_Event()              {}
-func (*EventSequence_Event_CancelledJob) isEventSequence_Event_Event()              {}
-func (*EventSequence_Event_JobSucceeded) isEventSequence_Event_Event()              {}
-func (*EventSequence_Event_JobErrors) isEventSequence_Event_Event()                 {}
-func (*EventSequence_Event_JobRunLeased) isEventSequence_Event_Event()              {}
-func (*EventSequence_Event_JobRunAssigned) isEventSequence_Event_Event()            {}
-func (*EventSequence_Event_JobRunRunning) isEventSequence_Event_Event()             {}
-func (*EventSequence_Event_JobRunSucceeded) isEventSequence_Event_Event()           {}
-func (*EventSequence_Event_JobRunErrors) isEventSequence_Event_Event()              {}
-func (*EventSequence_Event_JobDuplicateDetected) isEventSequence_Event_Event()      {}
-func (*EventSequence_Event_StandaloneIngressInfo) isEventSequence_Event_Event()     {}
-func (*EventSequence_Event_ResourceUtilisation) isEventSequence_Event_Event()       {}
-func (*EventSequence_Event_JobRunPreempted) isEventSequence_Event_Event()           {}
-func (*EventSequence_Event_PartitionMarker) isEventSequence_Event_Event()           {}
-func (*EventSequence_Event_JobRunPreemptionRequested) isEventSequence_Event_Event() {}
-func (*EventSequence_Event_JobRequeued) isEventSequence_Event_Event()               {}
+type EventSequence_Event_JobSetExpired struct {
+	JobSetExpired *JobSetExpired `protobuf:"bytes,23,opt,name=jobSetExpired,proto3,oneof" json:"jobSetExpired,omitempty"`
+}
+type EventSequence_Event_JobSuspended struct {
+	JobSuspended *JobSuspended `protobuf:"bytes,24,opt,name=jobSuspended,proto3,oneof" json:"jobSuspended,omitempty"`
+}
+type EventSequence_Event_JobResumed struct {
+	JobResumed *JobResumed `protobuf:"bytes,25,opt,name=jobResumed,proto3,oneof" json:"jobResumed,omitempty"`
+}
+type EventSequence_Event_JobEvictedForCapacity struct {
+	JobEvictedForCapacity *JobEvictedForCapacity `protobuf:"bytes,26,opt,name=jobEvictedForCapacity,proto3,oneof" json:"jobEvictedForCapacity,omitempty"`
+}
+type EventSequence_Event_JobRuntimeExceeded struct {
+	JobRuntimeExceeded *JobRuntimeExceeded `protobuf:"bytes,27,opt,name=jobRuntimeExceeded,proto3,oneof" json:"jobRuntimeExceeded,omitempty"`
+}
+type EventSequence_Event_JobResourcesNormalized struct {
+	JobResourcesNormalized *JobResourcesNormalized `protobuf:"bytes,28,opt,name=jobResourcesNormalized,proto3,oneof" json:"jobResourcesNormalized,omitempty"`
+}
+type EventSequence_Event_JobUnschedulable struct {
+	JobUnschedulable *JobUnschedulable `protobuf:"bytes,29,opt,name=jobUnschedulable,proto3,oneof" json:"jobUnschedulable,omitempty"`
+}
+type EventSequence_Event_JobMaintenanceWindowStarted struct {
+	JobMaintenanceWindowStarted *JobMaintenanceWindowStarted `protobuf:"bytes,30,opt,name=jobMaintenanceWindowStarted,proto3,oneof" json:"jobMaintenanceWindowStarted,omitempty"`
+}
+type EventSequence_Event_JobMaintenanceWindowEnded struct {
+	JobMaintenanceWindowEnded *JobMaintenanceWindowEnded `protobuf:"bytes,31,opt,name=jobMaintenanceWindowEnded,proto3,oneof" json:"jobMaintenanceWindowEnded,omitempty"`
+}
+type EventSequence_Event_JobCordoned struct {
+	JobCordoned *JobCordoned `protobuf:"bytes,32,opt,name=jobCordoned,proto3,oneof" json:"jobCordoned,omitempty"`
+}
+type EventSequence_Event_JobUncordoned struct {
+	JobUncordoned *JobUncordoned `protobuf:"bytes,33,opt,name=jobUncordoned,proto3,oneof" json:"jobUncordoned,omitempty"`
+}
+
+func (*EventSequence_Event_SubmitJob) isEventSequence_Event_Event()                   {}
+func (*EventSequence_Event_ReprioritiseJob) isEventSequence_Event_Event()             {}
+func (*EventSequence_Event_ReprioritiseJobSet) isEventSequence_Event_Event()          {}
+func (*EventSequence_Event_ReprioritisedJob) isEventSequence_Event_Event()            {}
+func (*EventSequence_Event_CancelJob) isEventSequence_Event_Event()                   {}
+func (*EventSequence_Event_CancelJobSet) isEventSequence_Event_Event()                {}
+func (*EventSequence_Event_CancelledJob) isEventSequence_Event_Event()                {}
+func (*EventSequence_Event_JobSucceeded) isEventSequence_Event_Event()                {}
+func (*EventSequence_Event_JobErrors) isEventSequence_Event_Event()                   {}
+func (*EventSequence_Event_JobRunLeased) isEventSequence_Event_Event()                {}
+func (*EventSequence_Event_JobRunAssigned) isEventSequence_Event_Event()              {}
+func (*EventSequence_Event_JobRunRunning) isEventSequence_Event_Event()               {}
+func (*EventSequence_Event_JobRunSucceeded) isEventSequence_Event_Event()             {}
+func (*EventSequence_Event_JobRunErrors) isEventSequence_Event_Event()                {}
+func (*EventSequence_Event_JobDuplicateDetected) isEventSequence_Event_Event()        {}
+func (*EventSequence_Event_StandaloneIngressInfo) isEventSequence_Event_Event()       {}
+func (*EventSequence_Event_ResourceUtilisation) isEventSequence_Event_Event()         {}
+func (*EventSequence_Event_JobRunPreempted) isEventSequence_Event_Event()             {}
+func (*EventSequence_Event_PartitionMarker) isEventSequence_Event_Event()             {}
+func (*EventSequence_Event_JobRunPreemptionRequested) isEventSequence_Event_Event()   {}
+func (*EventSequence_Event_JobRequeued) isEventSequence_Event_Event()                 {}
+func (*EventSequence_Event_JobSetExpired) isEventSequence_Event_Event()               {}
+func (*EventSequence_Event_JobSuspended) isEventSequence_Event_Event()                {}
+func (*EventSequence_Event_JobResumed) isEventSequence_Event_Event()                  {}
+func (*EventSequence_Event_JobEvictedForCapacity) isEventSequence_Event_Event()       {}
+func (*EventSequence_Event_JobRuntimeExceeded) isEventSequence_Event_Event()          {}
+func (*EventSequence_Event_JobResourcesNormalized) isEventSequence_Event_Event()      {}
+func (*EventSequence_Event_JobUnschedulable) isEventSequence_Event_Event()            {}
+func (*EventSequence_Event_JobMaintenanceWindowStarted) isEventSequence_Event_Event() {}
+func (*EventSequence_Event_JobMaintenanceWindowEnded) isEventSequence_Event_Event()   {}
+func (*EventSequence_Event_JobCordoned) isEventSequence_Event_Event()                 {}
+func (*EventSequence_Event_JobUncordoned) isEventSequence_Event_Event()               {}
 
 func (m *EventSequence_Event) GetEvent() isEventSequence_Event_Event {
 	if m != nil {
@@ -493,6 +548,83 @@ func (m *EventSequence_Event) GetJobRequeued() *JobRequeued {
 	return nil
 }
 
+func (m *EventSequence_Event) GetJobSetExpired() *JobSetExpired {
+	if x, ok := m.GetEvent().(*EventSequence_Event_JobSetExpired); ok {
+		return x.JobSetExpired
+	}
+	return nil
+}
+
+func (m *EventSequence_Event) GetJobSuspended() *JobSuspended {
+	if x, ok := m.GetEvent().(*EventSequence_Event_JobSuspended); ok {
+		return x.JobSuspended
+	}
+	return nil
+}
+
+func (m *EventSequence_Event) GetJobResumed() *JobResumed {
+	if x, ok := m.GetEvent().(*EventSequence_Event_JobResumed); ok {
+		return x.JobResumed
+	}
+	return nil
+}
+
+func (m *EventSequence_Event) GetJobEvictedForCapacity() *JobEvictedForCapacity {
+	if x, ok := m.GetEvent().(*EventSequence_Event_JobEvictedForCapacity); ok {
+		return x.JobEvictedForCapacity
+	}
+	return nil
+}
+
+func (m *EventSequence_Event) GetJobRuntimeExceeded() *JobRuntimeExceeded {
+	if x, ok := m.GetEvent().(*EventSequence_Event_JobRuntimeExceeded); ok {
+		return x.JobRuntimeExceeded
+	}
+	return nil
+}
+
+func (m *EventSequence_Event) GetJobResourcesNormalized() *JobResourcesNormalized {
+	if x, ok := m.GetEvent().(*EventSequence_Event_JobResourcesNormalized); ok {
+		return x.JobResourcesNormalized
+	}
+	return nil
+}
+
+func (m *EventSequence_Event) GetJobUnschedulable() *JobUnschedulable {
+	if x, ok := m.GetEvent().(*EventSequence_Event_JobUnschedulable); ok {
+		return x.JobUnschedulable
+	}
+	return nil
+}
+
+func (m *EventSequence_Event) GetJobMaintenanceWindowStarted() *JobMaintenanceWindowStarted {
+	if x, ok := m.GetEvent().(*EventSequence_Event_JobMaintenanceWindowStarted); ok {
+		return x.JobMaintenanceWindowStarted
+	}
+	return nil
+}
+
+func (m *EventSequence_Event) GetJobMaintenanceWindowEnded() *JobMaintenanceWindowEnded {
+	if x, ok := m.GetEvent().(*EventSequence_Event_JobMaintenanceWindowEnded); ok {
+		return x.JobMaintenanceWindowEnded
+	}
+	return nil
+}
+
+func (m *EventSequence_Event) GetJobCordoned() *JobCordoned {
+	if x, ok := m.GetEvent().(*EventSequence_Event_JobCordoned); ok {
+		return x.JobCordoned
+	}
+	return nil
+}
+
+func (m *EventSequence_Event) GetJobUncordoned() *JobUncordoned {
+	if x, ok := m.GetEvent().(*EventSequence_Event_JobUncordoned); ok {
+		return x.JobUncordoned
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*EventSequence_Event) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -517,6 +649,17 @@ func (*EventSequence_Event) XXX_OneofWrappers() []interface{} {
 		(*EventSequence_Event_PartitionMarker)(nil),
 		(*EventSequence_Event_JobRunPreemptionRequested)(nil),
 		(*EventSequence_Event_JobRequeued)(nil),
+		(*EventSequence_Event_JobSetExpired)(nil),
+		(*EventSequence_Event_JobSuspended)(nil),
+		(*EventSequence_Event_JobResumed)(nil),
+		(*EventSequence_Event_JobEvictedForCapacity)(nil),
+		(*EventSequence_Event_JobRuntimeExceeded)(nil),
+		(*EventSequence_Event_JobResourcesNormalized)(nil),
+		(*EventSequence_Event_JobUnschedulable)(nil),
+		(*EventSequence_Event_JobMaintenanceWindowStarted)(nil),
+		(*EventSequence_Event_JobMaintenanceWindowEnded)(nil),
+		(*EventSequence_Event_JobCordoned)(nil),
+		(*EventSequence_Event_JobUncordoned)(nil),
 	}
 }
 
@@ -695,6 +838,10 @@ type SubmitJob struct {
 	IsDuplicate bool `protobuf:"varint,12,opt,name=isDuplicate,proto3" json:"isDuplicate,omitempty"`
 	// Queuing TTL for this job in seconds. If this job queues for more than this duration it will be cancelled. Zero indicates an infinite lifetime.
 	QueueTtlSeconds int64 `protobuf:"varint,13,opt,name=queue_ttl_seconds,json=queueTtlSeconds,proto3" json:"queueTtlSeconds,omitempty"`
+	// Id of the job array the job is a member of, if any.
+	JobArrayId string `protobuf:"bytes,14,opt,name=job_array_id,json=jobArrayId,proto3" json:"jobArrayId,omitempty"`
+	// Index of the job within its job array.
+	JobArrayIndex uint32 `protobuf:"varint,15,opt,name=job_array_index,json=jobArrayIndex,proto3" json:"jobArrayIndex,omitempty"`
 }
 
 func (m *SubmitJob) Reset()         { *m = SubmitJob{} }
@@ -821,6 +968,20 @@ func (m *SubmitJob) GetQueueTtlSeconds() int64 {
 	return 0
 }
 
+func (m *SubmitJob) GetJobArrayId() string {
+	if m != nil {
+		return m.JobArrayId
+	}
+	return ""
+}
+
+func (m *SubmitJob) GetJobArrayIndex() uint32 {
+	if m != nil {
+		return m.JobArrayIndex
+	}
+	return 0
+}
+
 // Kubernetes objects that can serve as main objects for an Armada job.
 type KubernetesMainObject struct {
 	ObjectMeta *ObjectMeta `protobuf:"bytes,1,opt,name=objectMeta,proto3" json:"objectMeta,omitempty"`
@@ -1593,26 +1754,25 @@ func (m *CancelledJob) GetReason() string {
 	return ""
 }
 
-type JobSucceeded struct {
-	JobId *Uuid `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
-	// Runtime information, e.g., which node the job is running on, its IP address etc,
-	// for each resource created for the job run.
-	// TODO: remove this once we have fixed the external api
-	ResourceInfos []*KubernetesResourceInfo `protobuf:"bytes,2,rep,name=resourceInfos,proto3" json:"resourceInfos,omitempty"`
+// Generated by the server when a job is cancelled because its job set didn't complete within its TTL.
+// One such message is generated per job that was cancelled.
+type JobSetExpired struct {
+	JobId            *Uuid `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+	JobSetTtlSeconds int64 `protobuf:"varint,2,opt,name=job_set_ttl_seconds,json=jobSetTtlSeconds,proto3" json:"jobSetTtlSeconds,omitempty"`
 }
 
-func (m *JobSucceeded) Reset()         { *m = JobSucceeded{} }
-func (m *JobSucceeded) String() string { return proto.CompactTextString(m) }
-func (*JobSucceeded) ProtoMessage()    {}
-func (*JobSucceeded) Descriptor() ([]byte, []int) {
+func (m *JobSetExpired) Reset()         { *m = JobSetExpired{} }
+func (m *JobSetExpired) String() string { return proto.CompactTextString(m) }
+func (*JobSetExpired) ProtoMessage()    {}
+func (*JobSetExpired) Descriptor() ([]byte, []int) {
 	return fileDescriptor_6aab92ca59e015f8, []int{16}
 }
-func (m *JobSucceeded) XXX_Unmarshal(b []byte) error {
+func (m *JobSetExpired) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *JobSucceeded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *JobSetExpired) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_JobSucceeded.Marshal(b, m, deterministic)
+		return xxx_messageInfo_JobSetExpired.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1622,66 +1782,49 @@ func (m *JobSucceeded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *JobSucceeded) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_JobSucceeded.Merge(m, src)
+func (m *JobSetExpired) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobSetExpired.Merge(m, src)
 }
-func (m *JobSucceeded) XXX_Size() int {
+func (m *JobSetExpired) XXX_Size() int {
 	return m.Size()
 }
-func (m *JobSucceeded) XXX_DiscardUnknown() {
-	xxx_messageInfo_JobSucceeded.DiscardUnknown(m)
+func (m *JobSetExpired) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobSetExpired.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_JobSucceeded proto.InternalMessageInfo
+var xxx_messageInfo_JobSetExpired proto.InternalMessageInfo
 
-func (m *JobSucceeded) GetJobId() *Uuid {
+func (m *JobSetExpired) GetJobId() *Uuid {
 	if m != nil {
 		return m.JobId
 	}
 	return nil
 }
 
-func (m *JobSucceeded) GetResourceInfos() []*KubernetesResourceInfo {
+func (m *JobSetExpired) GetJobSetTtlSeconds() int64 {
 	if m != nil {
-		return m.ResourceInfos
+		return m.JobSetTtlSeconds
 	}
-	return nil
+	return 0
 }
 
-// Indicates that a job has been leased to a cluster by the Armada scheduler.
-type JobRunLeased struct {
-	RunId *Uuid `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"runId,omitempty"`
-	JobId *Uuid `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
-	// Each cluster is represented by an executor.
-	ExecutorId string `protobuf:"bytes,3,opt,name=executor_id,json=executorId,proto3" json:"executorId,omitempty"`
-	NodeId     string `protobuf:"bytes,4,opt,name=node_id,json=nodeId,proto3" json:"nodeId,omitempty"`
-	// Used by the scheduler to maintain a consistent state
-	UpdateSequenceNumber int32 `protobuf:"varint,5,opt,name=update_sequence_number,json=updateSequenceNumber,proto3" json:"updateSequenceNumber,omitempty"`
-	// This bool is here because it is the most ergonomic way of distinguishing
-	// the case where `scheduled_at_priority` was explicitly set to zero from
-	// the case where it was not set at all.
-	HasScheduledAtPriority bool `protobuf:"varint,6,opt,name=has_scheduled_at_priority,json=hasScheduledAtPriority,proto3" json:"hasScheduledAtPriority,omitempty"`
-	// Priority class priority that this job was scheduled at.
-	//
-	// This is usually equal to the priority of the job's priority class, except
-	// in the case where the job was scheduled as an away job; this field is
-	// used to distinguish this case from the case where the job was scheduled
-	// as a home job.
-	ScheduledAtPriority int32 `protobuf:"varint,7,opt,name=scheduled_at_priority,json=scheduledAtPriority,proto3" json:"scheduledAtPriority,omitempty"`
+// Generated by the server when a queued job is suspended because its job set was paused.
+type JobSuspended struct {
+	JobId *Uuid `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
 }
 
-func (m *JobRunLeased) Reset()         { *m = JobRunLeased{} }
-func (m *JobRunLeased) String() string { return proto.CompactTextString(m) }
-func (*JobRunLeased) ProtoMessage()    {}
-func (*JobRunLeased) Descriptor() ([]byte, []int) {
+func (m *JobSuspended) Reset()         { *m = JobSuspended{} }
+func (m *JobSuspended) String() string { return proto.CompactTextString(m) }
+func (*JobSuspended) ProtoMessage()    {}
+func (*JobSuspended) Descriptor() ([]byte, []int) {
 	return fileDescriptor_6aab92ca59e015f8, []int{17}
 }
-func (m *JobRunLeased) XXX_Unmarshal(b []byte) error {
+func (m *JobSuspended) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *JobRunLeased) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *JobSuspended) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_JobRunLeased.Marshal(b, m, deterministic)
+		return xxx_messageInfo_JobSuspended.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1691,89 +1834,89 @@ func (m *JobRunLeased) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *JobRunLeased) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_JobRunLeased.Merge(m, src)
+func (m *JobSuspended) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobSuspended.Merge(m, src)
 }
-func (m *JobRunLeased) XXX_Size() int {
+func (m *JobSuspended) XXX_Size() int {
 	return m.Size()
 }
-func (m *JobRunLeased) XXX_DiscardUnknown() {
-	xxx_messageInfo_JobRunLeased.DiscardUnknown(m)
+func (m *JobSuspended) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobSuspended.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_JobRunLeased proto.InternalMessageInfo
-
-func (m *JobRunLeased) GetRunId() *Uuid {
-	if m != nil {
-		return m.RunId
-	}
-	return nil
-}
+var xxx_messageInfo_JobSuspended proto.InternalMessageInfo
 
-func (m *JobRunLeased) GetJobId() *Uuid {
+func (m *JobSuspended) GetJobId() *Uuid {
 	if m != nil {
 		return m.JobId
 	}
 	return nil
 }
 
-func (m *JobRunLeased) GetExecutorId() string {
-	if m != nil {
-		return m.ExecutorId
-	}
-	return ""
+// Generated by the server when a suspended job is returned to the queue because its job set was resumed.
+type JobResumed struct {
+	JobId *Uuid `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
 }
 
-func (m *JobRunLeased) GetNodeId() string {
-	if m != nil {
-		return m.NodeId
-	}
-	return ""
+func (m *JobResumed) Reset()         { *m = JobResumed{} }
+func (m *JobResumed) String() string { return proto.CompactTextString(m) }
+func (*JobResumed) ProtoMessage()    {}
+func (*JobResumed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aab92ca59e015f8, []int{18}
 }
-
-func (m *JobRunLeased) GetUpdateSequenceNumber() int32 {
-	if m != nil {
-		return m.UpdateSequenceNumber
-	}
-	return 0
+func (m *JobResumed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *JobRunLeased) GetHasScheduledAtPriority() bool {
-	if m != nil {
-		return m.HasScheduledAtPriority
+func (m *JobResumed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobResumed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return false
+}
+func (m *JobResumed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobResumed.Merge(m, src)
+}
+func (m *JobResumed) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobResumed) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobResumed.DiscardUnknown(m)
 }
 
-func (m *JobRunLeased) GetScheduledAtPriority() int32 {
+var xxx_messageInfo_JobResumed proto.InternalMessageInfo
+
+func (m *JobResumed) GetJobId() *Uuid {
 	if m != nil {
-		return m.ScheduledAtPriority
+		return m.JobId
 	}
-	return 0
+	return nil
 }
 
-// Indicates that a job has been assigned to nodes by Kubernetes.
-type JobRunAssigned struct {
-	RunId *Uuid `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"runId,omitempty"`
-	JobId *Uuid `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
-	// Runtime information, e.g., which node the job is running on, its IP address etc,
-	// for each resource created for the job run.
-	// Included here and in JobRunRunning for compatibility with legacy messages.
-	ResourceInfos []*KubernetesResourceInfo `protobuf:"bytes,3,rep,name=resourceInfos,proto3" json:"resourceInfos,omitempty"`
+// Generated by the legacy scheduler when a preemptible job is evicted to make room for higher-priority work
+// and returned to the queue.
+type JobEvictedForCapacity struct {
+	JobId      *Uuid  `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+	ExecutorId string `protobuf:"bytes,2,opt,name=executor_id,json=executorId,proto3" json:"executorId,omitempty"`
 }
 
-func (m *JobRunAssigned) Reset()         { *m = JobRunAssigned{} }
-func (m *JobRunAssigned) String() string { return proto.CompactTextString(m) }
-func (*JobRunAssigned) ProtoMessage()    {}
-func (*JobRunAssigned) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{18}
+func (m *JobEvictedForCapacity) Reset()         { *m = JobEvictedForCapacity{} }
+func (m *JobEvictedForCapacity) String() string { return proto.CompactTextString(m) }
+func (*JobEvictedForCapacity) ProtoMessage()    {}
+func (*JobEvictedForCapacity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aab92ca59e015f8, []int{19}
 }
-func (m *JobRunAssigned) XXX_Unmarshal(b []byte) error {
+func (m *JobEvictedForCapacity) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *JobRunAssigned) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *JobEvictedForCapacity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_JobRunAssigned.Marshal(b, m, deterministic)
+		return xxx_messageInfo_JobEvictedForCapacity.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1783,60 +1926,51 @@ func (m *JobRunAssigned) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *JobRunAssigned) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_JobRunAssigned.Merge(m, src)
+func (m *JobEvictedForCapacity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobEvictedForCapacity.Merge(m, src)
 }
-func (m *JobRunAssigned) XXX_Size() int {
+func (m *JobEvictedForCapacity) XXX_Size() int {
 	return m.Size()
 }
-func (m *JobRunAssigned) XXX_DiscardUnknown() {
-	xxx_messageInfo_JobRunAssigned.DiscardUnknown(m)
+func (m *JobEvictedForCapacity) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobEvictedForCapacity.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_JobRunAssigned proto.InternalMessageInfo
-
-func (m *JobRunAssigned) GetRunId() *Uuid {
-	if m != nil {
-		return m.RunId
-	}
-	return nil
-}
+var xxx_messageInfo_JobEvictedForCapacity proto.InternalMessageInfo
 
-func (m *JobRunAssigned) GetJobId() *Uuid {
+func (m *JobEvictedForCapacity) GetJobId() *Uuid {
 	if m != nil {
 		return m.JobId
 	}
 	return nil
 }
 
-func (m *JobRunAssigned) GetResourceInfos() []*KubernetesResourceInfo {
+func (m *JobEvictedForCapacity) GetExecutorId() string {
 	if m != nil {
-		return m.ResourceInfos
+		return m.ExecutorId
 	}
-	return nil
+	return ""
 }
 
-// Indicates that the resources required by the job have been created and that the job is now running.
-type JobRunRunning struct {
-	RunId *Uuid `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"runId,omitempty"`
-	JobId *Uuid `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
-	// Runtime information, e.g., which node the job is running on, its IP address etc,
-	// for each resource created for the job run.
-	ResourceInfos []*KubernetesResourceInfo `protobuf:"bytes,3,rep,name=resourceInfos,proto3" json:"resourceInfos,omitempty"`
+// Generated by the server when a run of a job exceeds the maximum runtime of the job, before the job is cancelled.
+type JobRuntimeExceeded struct {
+	JobId             *Uuid  `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+	ExecutorId        string `protobuf:"bytes,2,opt,name=executor_id,json=executorId,proto3" json:"executorId,omitempty"`
+	MaxRuntimeSeconds uint32 `protobuf:"varint,3,opt,name=max_runtime_seconds,json=maxRuntimeSeconds,proto3" json:"maxRuntimeSeconds,omitempty"`
 }
 
-func (m *JobRunRunning) Reset()         { *m = JobRunRunning{} }
-func (m *JobRunRunning) String() string { return proto.CompactTextString(m) }
-func (*JobRunRunning) ProtoMessage()    {}
-func (*JobRunRunning) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{19}
+func (m *JobRuntimeExceeded) Reset()         { *m = JobRuntimeExceeded{} }
+func (m *JobRuntimeExceeded) String() string { return proto.CompactTextString(m) }
+func (*JobRuntimeExceeded) ProtoMessage()    {}
+func (*JobRuntimeExceeded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aab92ca59e015f8, []int{20}
 }
-func (m *JobRunRunning) XXX_Unmarshal(b []byte) error {
+func (m *JobRuntimeExceeded) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *JobRunRunning) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *JobRuntimeExceeded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_JobRunRunning.Marshal(b, m, deterministic)
+		return xxx_messageInfo_JobRuntimeExceeded.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1846,60 +1980,59 @@ func (m *JobRunRunning) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *JobRunRunning) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_JobRunRunning.Merge(m, src)
+func (m *JobRuntimeExceeded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobRuntimeExceeded.Merge(m, src)
 }
-func (m *JobRunRunning) XXX_Size() int {
+func (m *JobRuntimeExceeded) XXX_Size() int {
 	return m.Size()
 }
-func (m *JobRunRunning) XXX_DiscardUnknown() {
-	xxx_messageInfo_JobRunRunning.DiscardUnknown(m)
+func (m *JobRuntimeExceeded) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobRuntimeExceeded.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_JobRunRunning proto.InternalMessageInfo
+var xxx_messageInfo_JobRuntimeExceeded proto.InternalMessageInfo
 
-func (m *JobRunRunning) GetRunId() *Uuid {
+func (m *JobRuntimeExceeded) GetJobId() *Uuid {
 	if m != nil {
-		return m.RunId
+		return m.JobId
 	}
 	return nil
 }
 
-func (m *JobRunRunning) GetJobId() *Uuid {
+func (m *JobRuntimeExceeded) GetExecutorId() string {
 	if m != nil {
-		return m.JobId
+		return m.ExecutorId
 	}
-	return nil
+	return ""
 }
 
-func (m *JobRunRunning) GetResourceInfos() []*KubernetesResourceInfo {
+func (m *JobRuntimeExceeded) GetMaxRuntimeSeconds() uint32 {
 	if m != nil {
-		return m.ResourceInfos
+		return m.MaxRuntimeSeconds
 	}
-	return nil
+	return 0
 }
 
-// Message containing runtime information about some resource created for a job.
-type KubernetesResourceInfo struct {
-	ObjectMeta *ObjectMeta `protobuf:"bytes,1,opt,name=objectMeta,proto3" json:"objectMeta,omitempty"`
-	// Types that are valid to be assigned to Info:
-	//	*KubernetesResourceInfo_PodInfo
-	//	*KubernetesResourceInfo_IngressInfo
-	Info isKubernetesResourceInfo_Info `protobuf_oneof:"info"`
+// Generated by the server when the resource requests and limits of the containers of a job are normalized at submission.
+// Requests and limits are given by container name.
+type JobResourcesNormalized struct {
+	JobId               *Uuid                               `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+	OriginalResources   map[string]v11.ResourceRequirements `protobuf:"bytes,2,rep,name=original_resources,json=originalResources,proto3" json:"originalResources" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	NormalizedResources map[string]v11.ResourceRequirements `protobuf:"bytes,3,rep,name=normalized_resources,json=normalizedResources,proto3" json:"normalizedResources" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (m *KubernetesResourceInfo) Reset()         { *m = KubernetesResourceInfo{} }
-func (m *KubernetesResourceInfo) String() string { return proto.CompactTextString(m) }
-func (*KubernetesResourceInfo) ProtoMessage()    {}
-func (*KubernetesResourceInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{20}
+func (m *JobResourcesNormalized) Reset()         { *m = JobResourcesNormalized{} }
+func (m *JobResourcesNormalized) String() string { return proto.CompactTextString(m) }
+func (*JobResourcesNormalized) ProtoMessage()    {}
+func (*JobResourcesNormalized) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aab92ca59e015f8, []int{21}
 }
-func (m *KubernetesResourceInfo) XXX_Unmarshal(b []byte) error {
+func (m *JobResourcesNormalized) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *KubernetesResourceInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *JobResourcesNormalized) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_KubernetesResourceInfo.Marshal(b, m, deterministic)
+		return xxx_messageInfo_JobResourcesNormalized.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1909,88 +2042,57 @@ func (m *KubernetesResourceInfo) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *KubernetesResourceInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_KubernetesResourceInfo.Merge(m, src)
+func (m *JobResourcesNormalized) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobResourcesNormalized.Merge(m, src)
 }
-func (m *KubernetesResourceInfo) XXX_Size() int {
+func (m *JobResourcesNormalized) XXX_Size() int {
 	return m.Size()
 }
-func (m *KubernetesResourceInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_KubernetesResourceInfo.DiscardUnknown(m)
+func (m *JobResourcesNormalized) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobResourcesNormalized.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_KubernetesResourceInfo proto.InternalMessageInfo
+var xxx_messageInfo_JobResourcesNormalized proto.InternalMessageInfo
 
-type isKubernetesResourceInfo_Info interface {
-	isKubernetesResourceInfo_Info()
-	MarshalTo([]byte) (int, error)
-	Size() int
-}
-
-type KubernetesResourceInfo_PodInfo struct {
-	PodInfo *PodInfo `protobuf:"bytes,2,opt,name=podInfo,proto3,oneof" json:"podInfo,omitempty"`
-}
-type KubernetesResourceInfo_IngressInfo struct {
-	IngressInfo *IngressInfo `protobuf:"bytes,3,opt,name=ingressInfo,proto3,oneof" json:"ingressInfo,omitempty"`
-}
-
-func (*KubernetesResourceInfo_PodInfo) isKubernetesResourceInfo_Info()     {}
-func (*KubernetesResourceInfo_IngressInfo) isKubernetesResourceInfo_Info() {}
-
-func (m *KubernetesResourceInfo) GetInfo() isKubernetesResourceInfo_Info {
+func (m *JobResourcesNormalized) GetJobId() *Uuid {
 	if m != nil {
-		return m.Info
+		return m.JobId
 	}
 	return nil
 }
 
-func (m *KubernetesResourceInfo) GetObjectMeta() *ObjectMeta {
+func (m *JobResourcesNormalized) GetOriginalResources() map[string]v11.ResourceRequirements {
 	if m != nil {
-		return m.ObjectMeta
-	}
-	return nil
-}
-
-func (m *KubernetesResourceInfo) GetPodInfo() *PodInfo {
-	if x, ok := m.GetInfo().(*KubernetesResourceInfo_PodInfo); ok {
-		return x.PodInfo
+		return m.OriginalResources
 	}
 	return nil
 }
 
-func (m *KubernetesResourceInfo) GetIngressInfo() *IngressInfo {
-	if x, ok := m.GetInfo().(*KubernetesResourceInfo_IngressInfo); ok {
-		return x.IngressInfo
+func (m *JobResourcesNormalized) GetNormalizedResources() map[string]v11.ResourceRequirements {
+	if m != nil {
+		return m.NormalizedResources
 	}
 	return nil
 }
 
-// XXX_OneofWrappers is for the internal use of the proto package.
-func (*KubernetesResourceInfo) XXX_OneofWrappers() []interface{} {
-	return []interface{}{
-		(*KubernetesResourceInfo_PodInfo)(nil),
-		(*KubernetesResourceInfo_IngressInfo)(nil),
-	}
-}
-
-// Runtime information of a pod.
-type PodInfo struct {
-	NodeName  string `protobuf:"bytes,1,opt,name=node_name,json=nodeName,proto3" json:"nodeName,omitempty"`
-	PodNumber int32  `protobuf:"varint,2,opt,name=pod_number,json=podNumber,proto3" json:"podNumber,omitempty"`
+// Generated by the server when a queued job can no longer be scheduled on any active cluster.
+type JobUnschedulable struct {
+	JobId  *Uuid  `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (m *PodInfo) Reset()         { *m = PodInfo{} }
-func (m *PodInfo) String() string { return proto.CompactTextString(m) }
-func (*PodInfo) ProtoMessage()    {}
-func (*PodInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{21}
+func (m *JobUnschedulable) Reset()         { *m = JobUnschedulable{} }
+func (m *JobUnschedulable) String() string { return proto.CompactTextString(m) }
+func (*JobUnschedulable) ProtoMessage()    {}
+func (*JobUnschedulable) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aab92ca59e015f8, []int{22}
 }
-func (m *PodInfo) XXX_Unmarshal(b []byte) error {
+func (m *JobUnschedulable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PodInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *JobUnschedulable) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PodInfo.Marshal(b, m, deterministic)
+		return xxx_messageInfo_JobUnschedulable.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2000,52 +2102,55 @@ func (m *PodInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *PodInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PodInfo.Merge(m, src)
+func (m *JobUnschedulable) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobUnschedulable.Merge(m, src)
 }
-func (m *PodInfo) XXX_Size() int {
+func (m *JobUnschedulable) XXX_Size() int {
 	return m.Size()
 }
-func (m *PodInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_PodInfo.DiscardUnknown(m)
+func (m *JobUnschedulable) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobUnschedulable.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PodInfo proto.InternalMessageInfo
+var xxx_messageInfo_JobUnschedulable proto.InternalMessageInfo
 
-func (m *PodInfo) GetNodeName() string {
+func (m *JobUnschedulable) GetJobId() *Uuid {
 	if m != nil {
-		return m.NodeName
+		return m.JobId
 	}
-	return ""
+	return nil
 }
 
-func (m *PodInfo) GetPodNumber() int32 {
+func (m *JobUnschedulable) GetReason() string {
 	if m != nil {
-		return m.PodNumber
+		return m.Reason
 	}
-	return 0
+	return ""
 }
 
-// Runtime information of an ingress.
-type IngressInfo struct {
-	// TODO: Why a node name?
-	NodeName string `protobuf:"bytes,1,opt,name=node_name,json=nodeName,proto3" json:"nodeName,omitempty"`
-	// TODO: Why a map?
-	IngressAddresses map[int32]string `protobuf:"bytes,2,rep,name=ingress_addresses,json=ingressAddresses,proto3" json:"ingressAddresses,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+// Generated by the server when a maintenance window affecting a job, i.e., of its queue or of the executor it's leased to,
+// starts. One such message is generated per affected job.
+type JobMaintenanceWindowStarted struct {
+	JobId               *Uuid     `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+	MaintenanceWindowId string    `protobuf:"bytes,2,opt,name=maintenance_window_id,json=maintenanceWindowId,proto3" json:"maintenanceWindowId,omitempty"`
+	Executor            string    `protobuf:"bytes,3,opt,name=executor,proto3" json:"executor,omitempty"`
+	End                 time.Time `protobuf:"bytes,4,opt,name=end,proto3,stdtime" json:"end"`
+	Drain               bool      `protobuf:"varint,5,opt,name=drain,proto3" json:"drain,omitempty"`
+	Reason              string    `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (m *IngressInfo) Reset()         { *m = IngressInfo{} }
-func (m *IngressInfo) String() string { return proto.CompactTextString(m) }
-func (*IngressInfo) ProtoMessage()    {}
-func (*IngressInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{22}
+func (m *JobMaintenanceWindowStarted) Reset()         { *m = JobMaintenanceWindowStarted{} }
+func (m *JobMaintenanceWindowStarted) String() string { return proto.CompactTextString(m) }
+func (*JobMaintenanceWindowStarted) ProtoMessage()    {}
+func (*JobMaintenanceWindowStarted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aab92ca59e015f8, []int{23}
 }
-func (m *IngressInfo) XXX_Unmarshal(b []byte) error {
+func (m *JobMaintenanceWindowStarted) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *IngressInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *JobMaintenanceWindowStarted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_IngressInfo.Marshal(b, m, deterministic)
+		return xxx_messageInfo_JobMaintenanceWindowStarted.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2055,60 +2160,79 @@ func (m *IngressInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *IngressInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_IngressInfo.Merge(m, src)
+func (m *JobMaintenanceWindowStarted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobMaintenanceWindowStarted.Merge(m, src)
 }
-func (m *IngressInfo) XXX_Size() int {
+func (m *JobMaintenanceWindowStarted) XXX_Size() int {
 	return m.Size()
 }
-func (m *IngressInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_IngressInfo.DiscardUnknown(m)
+func (m *JobMaintenanceWindowStarted) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobMaintenanceWindowStarted.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_IngressInfo proto.InternalMessageInfo
+var xxx_messageInfo_JobMaintenanceWindowStarted proto.InternalMessageInfo
 
-func (m *IngressInfo) GetNodeName() string {
+func (m *JobMaintenanceWindowStarted) GetJobId() *Uuid {
 	if m != nil {
-		return m.NodeName
+		return m.JobId
+	}
+	return nil
+}
+
+func (m *JobMaintenanceWindowStarted) GetMaintenanceWindowId() string {
+	if m != nil {
+		return m.MaintenanceWindowId
 	}
 	return ""
 }
 
-func (m *IngressInfo) GetIngressAddresses() map[int32]string {
+func (m *JobMaintenanceWindowStarted) GetExecutor() string {
 	if m != nil {
-		return m.IngressAddresses
+		return m.Executor
 	}
-	return nil
+	return ""
 }
 
-// For sending details associated with a created ingress.
-// Used for compatibility with legacy messages, which send ingress info as its own message.
-// Later, this info should be bundled with the JobRunRunning message.
-type StandaloneIngressInfo struct {
-	RunId *Uuid `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"runId,omitempty"`
-	JobId *Uuid `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
-	// ObjectMeta associated with the ingress.
-	ObjectMeta       *ObjectMeta      `protobuf:"bytes,3,opt,name=objectMeta,proto3" json:"objectMeta,omitempty"`
-	IngressAddresses map[int32]string `protobuf:"bytes,4,rep,name=ingress_addresses,json=ingressAddresses,proto3" json:"ingressAddresses,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	// The legacy message bundles info associated with the pod.
-	NodeName     string `protobuf:"bytes,5,opt,name=node_name,json=nodeName,proto3" json:"nodeName,omitempty"`
-	PodNumber    int32  `protobuf:"varint,6,opt,name=pod_number,json=podNumber,proto3" json:"podNumber,omitempty"`
-	PodName      string `protobuf:"bytes,7,opt,name=pod_name,json=podName,proto3" json:"podName,omitempty"`
-	PodNamespace string `protobuf:"bytes,8,opt,name=pod_namespace,json=podNamespace,proto3" json:"podNamespace,omitempty"`
+func (m *JobMaintenanceWindowStarted) GetEnd() time.Time {
+	if m != nil {
+		return m.End
+	}
+	return time.Time{}
 }
 
-func (m *StandaloneIngressInfo) Reset()         { *m = StandaloneIngressInfo{} }
-func (m *StandaloneIngressInfo) String() string { return proto.CompactTextString(m) }
-func (*StandaloneIngressInfo) ProtoMessage()    {}
-func (*StandaloneIngressInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{23}
+func (m *JobMaintenanceWindowStarted) GetDrain() bool {
+	if m != nil {
+		return m.Drain
+	}
+	return false
 }
-func (m *StandaloneIngressInfo) XXX_Unmarshal(b []byte) error {
+
+func (m *JobMaintenanceWindowStarted) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// Generated by the server when a maintenance window a job was affected by ends.
+type JobMaintenanceWindowEnded struct {
+	JobId               *Uuid  `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+	MaintenanceWindowId string `protobuf:"bytes,2,opt,name=maintenance_window_id,json=maintenanceWindowId,proto3" json:"maintenanceWindowId,omitempty"`
+	Executor            string `protobuf:"bytes,3,opt,name=executor,proto3" json:"executor,omitempty"`
+}
+
+func (m *JobMaintenanceWindowEnded) Reset()         { *m = JobMaintenanceWindowEnded{} }
+func (m *JobMaintenanceWindowEnded) String() string { return proto.CompactTextString(m) }
+func (*JobMaintenanceWindowEnded) ProtoMessage()    {}
+func (*JobMaintenanceWindowEnded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aab92ca59e015f8, []int{24}
+}
+func (m *JobMaintenanceWindowEnded) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *StandaloneIngressInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *JobMaintenanceWindowEnded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_StandaloneIngressInfo.Marshal(b, m, deterministic)
+		return xxx_messageInfo_JobMaintenanceWindowEnded.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2118,96 +2242,60 @@ func (m *StandaloneIngressInfo) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *StandaloneIngressInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StandaloneIngressInfo.Merge(m, src)
+func (m *JobMaintenanceWindowEnded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobMaintenanceWindowEnded.Merge(m, src)
 }
-func (m *StandaloneIngressInfo) XXX_Size() int {
+func (m *JobMaintenanceWindowEnded) XXX_Size() int {
 	return m.Size()
 }
-func (m *StandaloneIngressInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_StandaloneIngressInfo.DiscardUnknown(m)
+func (m *JobMaintenanceWindowEnded) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobMaintenanceWindowEnded.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_StandaloneIngressInfo proto.InternalMessageInfo
-
-func (m *StandaloneIngressInfo) GetRunId() *Uuid {
-	if m != nil {
-		return m.RunId
-	}
-	return nil
-}
+var xxx_messageInfo_JobMaintenanceWindowEnded proto.InternalMessageInfo
 
-func (m *StandaloneIngressInfo) GetJobId() *Uuid {
+func (m *JobMaintenanceWindowEnded) GetJobId() *Uuid {
 	if m != nil {
 		return m.JobId
 	}
 	return nil
 }
 
-func (m *StandaloneIngressInfo) GetObjectMeta() *ObjectMeta {
-	if m != nil {
-		return m.ObjectMeta
-	}
-	return nil
-}
-
-func (m *StandaloneIngressInfo) GetIngressAddresses() map[int32]string {
-	if m != nil {
-		return m.IngressAddresses
-	}
-	return nil
-}
-
-func (m *StandaloneIngressInfo) GetNodeName() string {
-	if m != nil {
-		return m.NodeName
-	}
-	return ""
-}
-
-func (m *StandaloneIngressInfo) GetPodNumber() int32 {
-	if m != nil {
-		return m.PodNumber
-	}
-	return 0
-}
-
-func (m *StandaloneIngressInfo) GetPodName() string {
+func (m *JobMaintenanceWindowEnded) GetMaintenanceWindowId() string {
 	if m != nil {
-		return m.PodName
+		return m.MaintenanceWindowId
 	}
 	return ""
 }
 
-func (m *StandaloneIngressInfo) GetPodNamespace() string {
+func (m *JobMaintenanceWindowEnded) GetExecutor() string {
 	if m != nil {
-		return m.PodNamespace
+		return m.Executor
 	}
 	return ""
 }
 
-// Indicates that the job finished successfully (i.e., in the expected manner).
-type JobRunSucceeded struct {
-	RunId *Uuid `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"runId,omitempty"`
-	JobId *Uuid `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
-	// Runtime information, e.g., which node the job is running on, its IP address etc,
-	// for each resource created for the job run.
-	// TODO: remove this once we have fixed the external api
-	ResourceInfos []*KubernetesResourceInfo `protobuf:"bytes,3,rep,name=resourceInfos,proto3" json:"resourceInfos,omitempty"`
+// Generated by the server when the queue of a job, or the executor it's leased to, is cordoned.
+// One such message is generated per affected job.
+type JobCordoned struct {
+	JobId      *Uuid  `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+	Executor   string `protobuf:"bytes,2,opt,name=executor,proto3" json:"executor,omitempty"`
+	CordonedBy string `protobuf:"bytes,3,opt,name=cordoned_by,json=cordonedBy,proto3" json:"cordonedBy,omitempty"`
+	Reason     string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (m *JobRunSucceeded) Reset()         { *m = JobRunSucceeded{} }
-func (m *JobRunSucceeded) String() string { return proto.CompactTextString(m) }
-func (*JobRunSucceeded) ProtoMessage()    {}
-func (*JobRunSucceeded) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{24}
+func (m *JobCordoned) Reset()         { *m = JobCordoned{} }
+func (m *JobCordoned) String() string { return proto.CompactTextString(m) }
+func (*JobCordoned) ProtoMessage()    {}
+func (*JobCordoned) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aab92ca59e015f8, []int{25}
 }
-func (m *JobRunSucceeded) XXX_Unmarshal(b []byte) error {
+func (m *JobCordoned) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *JobRunSucceeded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *JobCordoned) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_JobRunSucceeded.Marshal(b, m, deterministic)
+		return xxx_messageInfo_JobCordoned.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2217,60 +2305,65 @@ func (m *JobRunSucceeded) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *JobRunSucceeded) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_JobRunSucceeded.Merge(m, src)
+func (m *JobCordoned) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobCordoned.Merge(m, src)
 }
-func (m *JobRunSucceeded) XXX_Size() int {
+func (m *JobCordoned) XXX_Size() int {
 	return m.Size()
 }
-func (m *JobRunSucceeded) XXX_DiscardUnknown() {
-	xxx_messageInfo_JobRunSucceeded.DiscardUnknown(m)
+func (m *JobCordoned) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobCordoned.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_JobRunSucceeded proto.InternalMessageInfo
+var xxx_messageInfo_JobCordoned proto.InternalMessageInfo
 
-func (m *JobRunSucceeded) GetRunId() *Uuid {
+func (m *JobCordoned) GetJobId() *Uuid {
 	if m != nil {
-		return m.RunId
+		return m.JobId
 	}
 	return nil
 }
 
-func (m *JobRunSucceeded) GetJobId() *Uuid {
+func (m *JobCordoned) GetExecutor() string {
 	if m != nil {
-		return m.JobId
+		return m.Executor
 	}
-	return nil
+	return ""
 }
 
-func (m *JobRunSucceeded) GetResourceInfos() []*KubernetesResourceInfo {
+func (m *JobCordoned) GetCordonedBy() string {
 	if m != nil {
-		return m.ResourceInfos
+		return m.CordonedBy
 	}
-	return nil
+	return ""
 }
 
-// Message containing a set of errors associated with a particular job.
-// Contains a flag, which if set to true indicates that the job has failed.
-// Otherwise, this message represents a set of errors from which the system has recovered.
-type JobErrors struct {
-	JobId *Uuid `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
-	// A set of errors that occurred within some context.
-	Errors []*Error `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+func (m *JobCordoned) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
 }
 
-func (m *JobErrors) Reset()         { *m = JobErrors{} }
-func (m *JobErrors) String() string { return proto.CompactTextString(m) }
-func (*JobErrors) ProtoMessage()    {}
-func (*JobErrors) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{25}
+// Generated by the server when the queue of a job, or the executor it's leased to, is uncordoned.
+type JobUncordoned struct {
+	JobId        *Uuid  `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+	Executor     string `protobuf:"bytes,2,opt,name=executor,proto3" json:"executor,omitempty"`
+	UncordonedBy string `protobuf:"bytes,3,opt,name=uncordoned_by,json=uncordonedBy,proto3" json:"uncordonedBy,omitempty"`
 }
-func (m *JobErrors) XXX_Unmarshal(b []byte) error {
+
+func (m *JobUncordoned) Reset()         { *m = JobUncordoned{} }
+func (m *JobUncordoned) String() string { return proto.CompactTextString(m) }
+func (*JobUncordoned) ProtoMessage()    {}
+func (*JobUncordoned) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aab92ca59e015f8, []int{26}
+}
+func (m *JobUncordoned) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *JobErrors) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *JobUncordoned) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_JobErrors.Marshal(b, m, deterministic)
+		return xxx_messageInfo_JobUncordoned.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2280,58 +2373,59 @@ func (m *JobErrors) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *JobErrors) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_JobErrors.Merge(m, src)
+func (m *JobUncordoned) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobUncordoned.Merge(m, src)
 }
-func (m *JobErrors) XXX_Size() int {
+func (m *JobUncordoned) XXX_Size() int {
 	return m.Size()
 }
-func (m *JobErrors) XXX_DiscardUnknown() {
-	xxx_messageInfo_JobErrors.DiscardUnknown(m)
+func (m *JobUncordoned) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobUncordoned.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_JobErrors proto.InternalMessageInfo
+var xxx_messageInfo_JobUncordoned proto.InternalMessageInfo
 
-func (m *JobErrors) GetJobId() *Uuid {
+func (m *JobUncordoned) GetJobId() *Uuid {
 	if m != nil {
 		return m.JobId
 	}
 	return nil
 }
 
-func (m *JobErrors) GetErrors() []*Error {
+func (m *JobUncordoned) GetExecutor() string {
 	if m != nil {
-		return m.Errors
+		return m.Executor
 	}
-	return nil
+	return ""
 }
 
-// Message containing a set of errors associated with a particular job run.
-// Contains a flag, which if set to true indicates that the job run has failed.
-// Otherwise, this message represents a set of errors from which the system has recovered.
-//
-// Because each error may specify a parent error, the set of errors form a tree.
-//
-// Services may listen for these messages to obtain realtime information about errors associated with jobs.
-type JobRunErrors struct {
-	RunId *Uuid `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"runId,omitempty"`
-	JobId *Uuid `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
-	// A set of errors that occurred within some context.
-	Errors []*Error `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"`
+func (m *JobUncordoned) GetUncordonedBy() string {
+	if m != nil {
+		return m.UncordonedBy
+	}
+	return ""
 }
 
-func (m *JobRunErrors) Reset()         { *m = JobRunErrors{} }
-func (m *JobRunErrors) String() string { return proto.CompactTextString(m) }
-func (*JobRunErrors) ProtoMessage()    {}
-func (*JobRunErrors) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{26}
+type JobSucceeded struct {
+	JobId *Uuid `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+	// Runtime information, e.g., which node the job is running on, its IP address etc,
+	// for each resource created for the job run.
+	// TODO: remove this once we have fixed the external api
+	ResourceInfos []*KubernetesResourceInfo `protobuf:"bytes,2,rep,name=resourceInfos,proto3" json:"resourceInfos,omitempty"`
 }
-func (m *JobRunErrors) XXX_Unmarshal(b []byte) error {
+
+func (m *JobSucceeded) Reset()         { *m = JobSucceeded{} }
+func (m *JobSucceeded) String() string { return proto.CompactTextString(m) }
+func (*JobSucceeded) ProtoMessage()    {}
+func (*JobSucceeded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aab92ca59e015f8, []int{27}
+}
+func (m *JobSucceeded) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *JobRunErrors) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *JobSucceeded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_JobRunErrors.Marshal(b, m, deterministic)
+		return xxx_messageInfo_JobSucceeded.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2341,79 +2435,68 @@ func (m *JobRunErrors) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *JobRunErrors) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_JobRunErrors.Merge(m, src)
+func (m *JobSucceeded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobSucceeded.Merge(m, src)
 }
-func (m *JobRunErrors) XXX_Size() int {
+func (m *JobSucceeded) XXX_Size() int {
 	return m.Size()
 }
-func (m *JobRunErrors) XXX_DiscardUnknown() {
-	xxx_messageInfo_JobRunErrors.DiscardUnknown(m)
+func (m *JobSucceeded) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobSucceeded.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_JobRunErrors proto.InternalMessageInfo
-
-func (m *JobRunErrors) GetRunId() *Uuid {
-	if m != nil {
-		return m.RunId
-	}
-	return nil
-}
+var xxx_messageInfo_JobSucceeded proto.InternalMessageInfo
 
-func (m *JobRunErrors) GetJobId() *Uuid {
+func (m *JobSucceeded) GetJobId() *Uuid {
 	if m != nil {
 		return m.JobId
 	}
 	return nil
 }
 
-func (m *JobRunErrors) GetErrors() []*Error {
+func (m *JobSucceeded) GetResourceInfos() []*KubernetesResourceInfo {
 	if m != nil {
-		return m.Errors
+		return m.ResourceInfos
 	}
 	return nil
 }
 
-// Represents a failure that took place in the course of a job run (i.e., an attempt to run a job).
-// Each failure is either a resource failure or a system-level failure.
-// Resource failures correspond to a problem with a specific Kubernetes resource
-// (e.g., if a container terminates with a non-zero exit code).
-// System-level failure messages are created by the executor (e.g., a failure by the executor to write to Kubernetes).
-//
-// This message may only be published to the log as part of a JobRunError message.
-// TODO: Check if the container status contained any useful info for non-failed containers.
-type Error struct {
-	// If true, this error represents an unrecoverable failure and Armada has given up on this job or job run.
-	Terminal bool `protobuf:"varint,1,opt,name=terminal,proto3" json:"terminal,omitempty"`
-	// Additional information for this particular combination of component and error. May be set to nil.
+// Indicates that a job has been leased to a cluster by the Armada scheduler.
+type JobRunLeased struct {
+	RunId *Uuid `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"runId,omitempty"`
+	JobId *Uuid `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+	// Each cluster is represented by an executor.
+	ExecutorId string `protobuf:"bytes,3,opt,name=executor_id,json=executorId,proto3" json:"executorId,omitempty"`
+	NodeId     string `protobuf:"bytes,4,opt,name=node_id,json=nodeId,proto3" json:"nodeId,omitempty"`
+	// Used by the scheduler to maintain a consistent state
+	UpdateSequenceNumber int32 `protobuf:"varint,5,opt,name=update_sequence_number,json=updateSequenceNumber,proto3" json:"updateSequenceNumber,omitempty"`
+	// This bool is here because it is the most ergonomic way of distinguishing
+	// the case where `scheduled_at_priority` was explicitly set to zero from
+	// the case where it was not set at all.
+	HasScheduledAtPriority bool `protobuf:"varint,6,opt,name=has_scheduled_at_priority,json=hasScheduledAtPriority,proto3" json:"hasScheduledAtPriority,omitempty"`
+	// Priority class priority that this job was scheduled at.
 	//
-	// Types that are valid to be assigned to Reason:
-	//	*Error_KubernetesError
-	//	*Error_ContainerError
-	//	*Error_ExecutorError
-	//	*Error_PodUnschedulable
-	//	*Error_LeaseExpired
-	//	*Error_MaxRunsExceeded
-	//	*Error_PodError
-	//	*Error_PodLeaseReturned
-	//	*Error_PodTerminated
-	//	*Error_JobRunPreemptedError
-	//	*Error_GangJobUnschedulable
-	Reason isError_Reason `protobuf_oneof:"reason"`
+	// This is usually equal to the priority of the job's priority class, except
+	// in the case where the job was scheduled as an away job; this field is
+	// used to distinguish this case from the case where the job was scheduled
+	// as a home job.
+	ScheduledAtPriority int32 `protobuf:"varint,7,opt,name=scheduled_at_priority,json=scheduledAtPriority,proto3" json:"scheduledAtPriority,omitempty"`
+	// Pool of the executor. Only set by the legacy scheduler.
+	Pool string `protobuf:"bytes,8,opt,name=pool,proto3" json:"pool,omitempty"`
 }
 
-func (m *Error) Reset()         { *m = Error{} }
-func (m *Error) String() string { return proto.CompactTextString(m) }
-func (*Error) ProtoMessage()    {}
-func (*Error) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{27}
+func (m *JobRunLeased) Reset()         { *m = JobRunLeased{} }
+func (m *JobRunLeased) String() string { return proto.CompactTextString(m) }
+func (*JobRunLeased) ProtoMessage()    {}
+func (*JobRunLeased) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aab92ca59e015f8, []int{28}
 }
-func (m *Error) XXX_Unmarshal(b []byte) error {
+func (m *JobRunLeased) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Error) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *JobRunLeased) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Error.Marshal(b, m, deterministic)
+		return xxx_messageInfo_JobRunLeased.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2423,198 +2506,159 @@ func (m *Error) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Error) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Error.Merge(m, src)
+func (m *JobRunLeased) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobRunLeased.Merge(m, src)
 }
-func (m *Error) XXX_Size() int {
+func (m *JobRunLeased) XXX_Size() int {
 	return m.Size()
 }
-func (m *Error) XXX_DiscardUnknown() {
-	xxx_messageInfo_Error.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_Error proto.InternalMessageInfo
-
-type isError_Reason interface {
-	isError_Reason()
-	MarshalTo([]byte) (int, error)
-	Size() int
-}
-
-type Error_KubernetesError struct {
-	KubernetesError *KubernetesError `protobuf:"bytes,2,opt,name=kubernetesError,proto3,oneof" json:"kubernetesError,omitempty"`
-}
-type Error_ContainerError struct {
-	ContainerError *ContainerError `protobuf:"bytes,3,opt,name=containerError,proto3,oneof" json:"containerError,omitempty"`
-}
-type Error_ExecutorError struct {
-	ExecutorError *ExecutorError `protobuf:"bytes,4,opt,name=executorError,proto3,oneof" json:"executorError,omitempty"`
-}
-type Error_PodUnschedulable struct {
-	PodUnschedulable *PodUnschedulable `protobuf:"bytes,5,opt,name=podUnschedulable,proto3,oneof" json:"podUnschedulable,omitempty"`
-}
-type Error_LeaseExpired struct {
-	LeaseExpired *LeaseExpired `protobuf:"bytes,6,opt,name=leaseExpired,proto3,oneof" json:"leaseExpired,omitempty"`
-}
-type Error_MaxRunsExceeded struct {
-	MaxRunsExceeded *MaxRunsExceeded `protobuf:"bytes,7,opt,name=maxRunsExceeded,proto3,oneof" json:"maxRunsExceeded,omitempty"`
-}
-type Error_PodError struct {
-	PodError *PodError `protobuf:"bytes,8,opt,name=podError,proto3,oneof" json:"podError,omitempty"`
-}
-type Error_PodLeaseReturned struct {
-	PodLeaseReturned *PodLeaseReturned `protobuf:"bytes,9,opt,name=podLeaseReturned,proto3,oneof" json:"podLeaseReturned,omitempty"`
-}
-type Error_PodTerminated struct {
-	PodTerminated *PodTerminated `protobuf:"bytes,10,opt,name=podTerminated,proto3,oneof" json:"podTerminated,omitempty"`
-}
-type Error_JobRunPreemptedError struct {
-	JobRunPreemptedError *JobRunPreemptedError `protobuf:"bytes,11,opt,name=jobRunPreemptedError,proto3,oneof" json:"jobRunPreemptedError,omitempty"`
-}
-type Error_GangJobUnschedulable struct {
-	GangJobUnschedulable *GangJobUnschedulable `protobuf:"bytes,12,opt,name=gangJobUnschedulable,proto3,oneof" json:"gangJobUnschedulable,omitempty"`
+func (m *JobRunLeased) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobRunLeased.DiscardUnknown(m)
 }
 
-func (*Error_KubernetesError) isError_Reason()      {}
-func (*Error_ContainerError) isError_Reason()       {}
-func (*Error_ExecutorError) isError_Reason()        {}
-func (*Error_PodUnschedulable) isError_Reason()     {}
-func (*Error_LeaseExpired) isError_Reason()         {}
-func (*Error_MaxRunsExceeded) isError_Reason()      {}
-func (*Error_PodError) isError_Reason()             {}
-func (*Error_PodLeaseReturned) isError_Reason()     {}
-func (*Error_PodTerminated) isError_Reason()        {}
-func (*Error_JobRunPreemptedError) isError_Reason() {}
-func (*Error_GangJobUnschedulable) isError_Reason() {}
+var xxx_messageInfo_JobRunLeased proto.InternalMessageInfo
 
-func (m *Error) GetReason() isError_Reason {
+func (m *JobRunLeased) GetRunId() *Uuid {
 	if m != nil {
-		return m.Reason
+		return m.RunId
 	}
 	return nil
 }
 
-func (m *Error) GetTerminal() bool {
+func (m *JobRunLeased) GetJobId() *Uuid {
 	if m != nil {
-		return m.Terminal
+		return m.JobId
 	}
-	return false
+	return nil
 }
 
-func (m *Error) GetKubernetesError() *KubernetesError {
-	if x, ok := m.GetReason().(*Error_KubernetesError); ok {
-		return x.KubernetesError
+func (m *JobRunLeased) GetExecutorId() string {
+	if m != nil {
+		return m.ExecutorId
 	}
-	return nil
+	return ""
 }
 
-func (m *Error) GetContainerError() *ContainerError {
-	if x, ok := m.GetReason().(*Error_ContainerError); ok {
-		return x.ContainerError
+func (m *JobRunLeased) GetNodeId() string {
+	if m != nil {
+		return m.NodeId
 	}
-	return nil
+	return ""
 }
 
-func (m *Error) GetExecutorError() *ExecutorError {
-	if x, ok := m.GetReason().(*Error_ExecutorError); ok {
-		return x.ExecutorError
+func (m *JobRunLeased) GetUpdateSequenceNumber() int32 {
+	if m != nil {
+		return m.UpdateSequenceNumber
 	}
-	return nil
+	return 0
 }
 
-func (m *Error) GetPodUnschedulable() *PodUnschedulable {
-	if x, ok := m.GetReason().(*Error_PodUnschedulable); ok {
-		return x.PodUnschedulable
+func (m *JobRunLeased) GetHasScheduledAtPriority() bool {
+	if m != nil {
+		return m.HasScheduledAtPriority
 	}
-	return nil
+	return false
 }
 
-func (m *Error) GetLeaseExpired() *LeaseExpired {
-	if x, ok := m.GetReason().(*Error_LeaseExpired); ok {
-		return x.LeaseExpired
+func (m *JobRunLeased) GetScheduledAtPriority() int32 {
+	if m != nil {
+		return m.ScheduledAtPriority
 	}
-	return nil
+	return 0
 }
 
-func (m *Error) GetMaxRunsExceeded() *MaxRunsExceeded {
-	if x, ok := m.GetReason().(*Error_MaxRunsExceeded); ok {
-		return x.MaxRunsExceeded
+func (m *JobRunLeased) GetPool() string {
+	if m != nil {
+		return m.Pool
 	}
-	return nil
+	return ""
 }
 
-func (m *Error) GetPodError() *PodError {
-	if x, ok := m.GetReason().(*Error_PodError); ok {
-		return x.PodError
-	}
-	return nil
+// Indicates that a job has been assigned to nodes by Kubernetes.
+type JobRunAssigned struct {
+	RunId *Uuid `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"runId,omitempty"`
+	JobId *Uuid `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+	// Runtime information, e.g., which node the job is running on, its IP address etc,
+	// for each resource created for the job run.
+	// Included here and in JobRunRunning for compatibility with legacy messages.
+	ResourceInfos []*KubernetesResourceInfo `protobuf:"bytes,3,rep,name=resourceInfos,proto3" json:"resourceInfos,omitempty"`
 }
 
-func (m *Error) GetPodLeaseReturned() *PodLeaseReturned {
-	if x, ok := m.GetReason().(*Error_PodLeaseReturned); ok {
-		return x.PodLeaseReturned
-	}
-	return nil
+func (m *JobRunAssigned) Reset()         { *m = JobRunAssigned{} }
+func (m *JobRunAssigned) String() string { return proto.CompactTextString(m) }
+func (*JobRunAssigned) ProtoMessage()    {}
+func (*JobRunAssigned) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aab92ca59e015f8, []int{29}
 }
-
-func (m *Error) GetPodTerminated() *PodTerminated {
-	if x, ok := m.GetReason().(*Error_PodTerminated); ok {
-		return x.PodTerminated
+func (m *JobRunAssigned) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobRunAssigned) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobRunAssigned.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *JobRunAssigned) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobRunAssigned.Merge(m, src)
+}
+func (m *JobRunAssigned) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobRunAssigned) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobRunAssigned.DiscardUnknown(m)
 }
 
-func (m *Error) GetJobRunPreemptedError() *JobRunPreemptedError {
-	if x, ok := m.GetReason().(*Error_JobRunPreemptedError); ok {
-		return x.JobRunPreemptedError
+var xxx_messageInfo_JobRunAssigned proto.InternalMessageInfo
+
+func (m *JobRunAssigned) GetRunId() *Uuid {
+	if m != nil {
+		return m.RunId
 	}
 	return nil
 }
 
-func (m *Error) GetGangJobUnschedulable() *GangJobUnschedulable {
-	if x, ok := m.GetReason().(*Error_GangJobUnschedulable); ok {
-		return x.GangJobUnschedulable
+func (m *JobRunAssigned) GetJobId() *Uuid {
+	if m != nil {
+		return m.JobId
 	}
 	return nil
 }
 
-// XXX_OneofWrappers is for the internal use of the proto package.
-func (*Error) XXX_OneofWrappers() []interface{} {
-	return []interface{}{
-		(*Error_KubernetesError)(nil),
-		(*Error_ContainerError)(nil),
-		(*Error_ExecutorError)(nil),
-		(*Error_PodUnschedulable)(nil),
-		(*Error_LeaseExpired)(nil),
-		(*Error_MaxRunsExceeded)(nil),
-		(*Error_PodError)(nil),
-		(*Error_PodLeaseReturned)(nil),
-		(*Error_PodTerminated)(nil),
-		(*Error_JobRunPreemptedError)(nil),
-		(*Error_GangJobUnschedulable)(nil),
+func (m *JobRunAssigned) GetResourceInfos() []*KubernetesResourceInfo {
+	if m != nil {
+		return m.ResourceInfos
 	}
+	return nil
 }
 
-// Represents an error associated with a particular Kubernetes resource.
-type KubernetesError struct {
-	// Type of Kubernetes resource (e.g., ingress).
-	ResourceType string `protobuf:"bytes,1,opt,name=resourceType,proto3" json:"resourceType,omitempty"`
-	// Id assigned to the resource by Kubernetes.
-	ResourceId *Uuid `protobuf:"bytes,2,opt,name=resourceId,proto3" json:"resourceId,omitempty"`
+// Indicates that the resources required by the job have been created and that the job is now running.
+type JobRunRunning struct {
+	RunId *Uuid `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"runId,omitempty"`
+	JobId *Uuid `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+	// Runtime information, e.g., which node the job is running on, its IP address etc,
+	// for each resource created for the job run.
+	ResourceInfos []*KubernetesResourceInfo `protobuf:"bytes,3,rep,name=resourceInfos,proto3" json:"resourceInfos,omitempty"`
 }
 
-func (m *KubernetesError) Reset()         { *m = KubernetesError{} }
-func (m *KubernetesError) String() string { return proto.CompactTextString(m) }
-func (*KubernetesError) ProtoMessage()    {}
-func (*KubernetesError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{28}
+func (m *JobRunRunning) Reset()         { *m = JobRunRunning{} }
+func (m *JobRunRunning) String() string { return proto.CompactTextString(m) }
+func (*JobRunRunning) ProtoMessage()    {}
+func (*JobRunRunning) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aab92ca59e015f8, []int{30}
 }
-func (m *KubernetesError) XXX_Unmarshal(b []byte) error {
+func (m *JobRunRunning) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *KubernetesError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *JobRunRunning) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_KubernetesError.Marshal(b, m, deterministic)
+		return xxx_messageInfo_JobRunRunning.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2624,55 +2668,60 @@ func (m *KubernetesError) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *KubernetesError) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_KubernetesError.Merge(m, src)
+func (m *JobRunRunning) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobRunRunning.Merge(m, src)
 }
-func (m *KubernetesError) XXX_Size() int {
+func (m *JobRunRunning) XXX_Size() int {
 	return m.Size()
 }
-func (m *KubernetesError) XXX_DiscardUnknown() {
-	xxx_messageInfo_KubernetesError.DiscardUnknown(m)
+func (m *JobRunRunning) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobRunRunning.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_KubernetesError proto.InternalMessageInfo
+var xxx_messageInfo_JobRunRunning proto.InternalMessageInfo
 
-func (m *KubernetesError) GetResourceType() string {
+func (m *JobRunRunning) GetRunId() *Uuid {
 	if m != nil {
-		return m.ResourceType
+		return m.RunId
 	}
-	return ""
+	return nil
 }
 
-func (m *KubernetesError) GetResourceId() *Uuid {
+func (m *JobRunRunning) GetJobId() *Uuid {
 	if m != nil {
-		return m.ResourceId
+		return m.JobId
 	}
 	return nil
 }
 
-// Indicates one or more of the containers in the pod failed.
-type PodError struct {
-	// This ObjectMeta identifies the Pod.
-	ObjectMeta       *ObjectMeta       `protobuf:"bytes,1,opt,name=objectMeta,proto3" json:"objectMeta,omitempty"`
-	Message          string            `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	NodeName         string            `protobuf:"bytes,3,opt,name=node_name,json=nodeName,proto3" json:"nodeName,omitempty"`
-	PodNumber        int32             `protobuf:"varint,4,opt,name=pod_number,json=podNumber,proto3" json:"podNumber,omitempty"`
-	ContainerErrors  []*ContainerError `protobuf:"bytes,5,rep,name=containerErrors,proto3" json:"containerErrors,omitempty"`
-	KubernetesReason KubernetesReason  `protobuf:"varint,6,opt,name=kubernetes_reason,json=kubernetesReason,proto3,enum=armadaevents.KubernetesReason" json:"kubernetesReason,omitempty"`
+func (m *JobRunRunning) GetResourceInfos() []*KubernetesResourceInfo {
+	if m != nil {
+		return m.ResourceInfos
+	}
+	return nil
 }
 
-func (m *PodError) Reset()         { *m = PodError{} }
-func (m *PodError) String() string { return proto.CompactTextString(m) }
-func (*PodError) ProtoMessage()    {}
-func (*PodError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{29}
+// Message containing runtime information about some resource created for a job.
+type KubernetesResourceInfo struct {
+	ObjectMeta *ObjectMeta `protobuf:"bytes,1,opt,name=objectMeta,proto3" json:"objectMeta,omitempty"`
+	// Types that are valid to be assigned to Info:
+	//	*KubernetesResourceInfo_PodInfo
+	//	*KubernetesResourceInfo_IngressInfo
+	Info isKubernetesResourceInfo_Info `protobuf_oneof:"info"`
 }
-func (m *PodError) XXX_Unmarshal(b []byte) error {
+
+func (m *KubernetesResourceInfo) Reset()         { *m = KubernetesResourceInfo{} }
+func (m *KubernetesResourceInfo) String() string { return proto.CompactTextString(m) }
+func (*KubernetesResourceInfo) ProtoMessage()    {}
+func (*KubernetesResourceInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aab92ca59e015f8, []int{31}
+}
+func (m *KubernetesResourceInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PodError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *KubernetesResourceInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PodError.Marshal(b, m, deterministic)
+		return xxx_messageInfo_KubernetesResourceInfo.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2682,85 +2731,92 @@ func (m *PodError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *PodError) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PodError.Merge(m, src)
+func (m *KubernetesResourceInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KubernetesResourceInfo.Merge(m, src)
 }
-func (m *PodError) XXX_Size() int {
+func (m *KubernetesResourceInfo) XXX_Size() int {
 	return m.Size()
 }
-func (m *PodError) XXX_DiscardUnknown() {
-	xxx_messageInfo_PodError.DiscardUnknown(m)
+func (m *KubernetesResourceInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_KubernetesResourceInfo.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PodError proto.InternalMessageInfo
+var xxx_messageInfo_KubernetesResourceInfo proto.InternalMessageInfo
 
-func (m *PodError) GetObjectMeta() *ObjectMeta {
-	if m != nil {
-		return m.ObjectMeta
-	}
-	return nil
+type isKubernetesResourceInfo_Info interface {
+	isKubernetesResourceInfo_Info()
+	MarshalTo([]byte) (int, error)
+	Size() int
 }
 
-func (m *PodError) GetMessage() string {
+type KubernetesResourceInfo_PodInfo struct {
+	PodInfo *PodInfo `protobuf:"bytes,2,opt,name=podInfo,proto3,oneof" json:"podInfo,omitempty"`
+}
+type KubernetesResourceInfo_IngressInfo struct {
+	IngressInfo *IngressInfo `protobuf:"bytes,3,opt,name=ingressInfo,proto3,oneof" json:"ingressInfo,omitempty"`
+}
+
+func (*KubernetesResourceInfo_PodInfo) isKubernetesResourceInfo_Info()     {}
+func (*KubernetesResourceInfo_IngressInfo) isKubernetesResourceInfo_Info() {}
+
+func (m *KubernetesResourceInfo) GetInfo() isKubernetesResourceInfo_Info {
 	if m != nil {
-		return m.Message
+		return m.Info
 	}
-	return ""
+	return nil
 }
 
-func (m *PodError) GetNodeName() string {
+func (m *KubernetesResourceInfo) GetObjectMeta() *ObjectMeta {
 	if m != nil {
-		return m.NodeName
+		return m.ObjectMeta
 	}
-	return ""
+	return nil
 }
 
-func (m *PodError) GetPodNumber() int32 {
-	if m != nil {
-		return m.PodNumber
+func (m *KubernetesResourceInfo) GetPodInfo() *PodInfo {
+	if x, ok := m.GetInfo().(*KubernetesResourceInfo_PodInfo); ok {
+		return x.PodInfo
 	}
-	return 0
+	return nil
 }
 
-func (m *PodError) GetContainerErrors() []*ContainerError {
-	if m != nil {
-		return m.ContainerErrors
+func (m *KubernetesResourceInfo) GetIngressInfo() *IngressInfo {
+	if x, ok := m.GetInfo().(*KubernetesResourceInfo_IngressInfo); ok {
+		return x.IngressInfo
 	}
 	return nil
 }
 
-func (m *PodError) GetKubernetesReason() KubernetesReason {
-	if m != nil {
-		return m.KubernetesReason
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*KubernetesResourceInfo) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*KubernetesResourceInfo_PodInfo)(nil),
+		(*KubernetesResourceInfo_IngressInfo)(nil),
 	}
-	return KubernetesReason_AppError
 }
 
-type ContainerError struct {
-	// this ObjectMeta identifies the container
-	ObjectMeta *ObjectMeta `protobuf:"bytes,1,opt,name=objectMeta,proto3" json:"objectMeta,omitempty"`
-	// Exit code of the application running in the container.
-	ExitCode int32 `protobuf:"varint,2,opt,name=exit_code,json=exitCode,proto3" json:"exitCode,omitempty"`
-	// Corresponds to v1.ContainerStateTerminated.Message
-	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
-	// Corresponds to v1.ContainerStateTerminated.Reason
-	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
-	// Reason reported by Kubernetes.
-	KubernetesReason KubernetesReason `protobuf:"varint,9,opt,name=kubernetes_reason,json=kubernetesReason,proto3,enum=armadaevents.KubernetesReason" json:"kubernetesReason,omitempty"`
+// Runtime information of a pod.
+type PodInfo struct {
+	NodeName  string `protobuf:"bytes,1,opt,name=node_name,json=nodeName,proto3" json:"nodeName,omitempty"`
+	PodNumber int32  `protobuf:"varint,2,opt,name=pod_number,json=podNumber,proto3" json:"podNumber,omitempty"`
+	// Pool of the executor running the pod.
+	Pool string `protobuf:"bytes,3,opt,name=pool,proto3" json:"pool,omitempty"`
+	// Labels of the node the pod is running on, as tracked by the executor.
+	NodeLabels map[string]string `protobuf:"bytes,4,rep,name=node_labels,json=nodeLabels,proto3" json:"nodeLabels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (m *ContainerError) Reset()         { *m = ContainerError{} }
-func (m *ContainerError) String() string { return proto.CompactTextString(m) }
-func (*ContainerError) ProtoMessage()    {}
-func (*ContainerError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{30}
+func (m *PodInfo) Reset()         { *m = PodInfo{} }
+func (m *PodInfo) String() string { return proto.CompactTextString(m) }
+func (*PodInfo) ProtoMessage()    {}
+func (*PodInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aab92ca59e015f8, []int{32}
 }
-func (m *ContainerError) XXX_Unmarshal(b []byte) error {
+func (m *PodInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ContainerError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PodInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ContainerError.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PodInfo.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2770,73 +2826,66 @@ func (m *ContainerError) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *ContainerError) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ContainerError.Merge(m, src)
+func (m *PodInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PodInfo.Merge(m, src)
 }
-func (m *ContainerError) XXX_Size() int {
+func (m *PodInfo) XXX_Size() int {
 	return m.Size()
 }
-func (m *ContainerError) XXX_DiscardUnknown() {
-	xxx_messageInfo_ContainerError.DiscardUnknown(m)
+func (m *PodInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_PodInfo.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ContainerError proto.InternalMessageInfo
+var xxx_messageInfo_PodInfo proto.InternalMessageInfo
 
-func (m *ContainerError) GetObjectMeta() *ObjectMeta {
+func (m *PodInfo) GetNodeName() string {
 	if m != nil {
-		return m.ObjectMeta
+		return m.NodeName
 	}
-	return nil
+	return ""
 }
 
-func (m *ContainerError) GetExitCode() int32 {
+func (m *PodInfo) GetPodNumber() int32 {
 	if m != nil {
-		return m.ExitCode
+		return m.PodNumber
 	}
 	return 0
 }
 
-func (m *ContainerError) GetMessage() string {
-	if m != nil {
-		return m.Message
-	}
-	return ""
-}
-
-func (m *ContainerError) GetReason() string {
+func (m *PodInfo) GetPool() string {
 	if m != nil {
-		return m.Reason
+		return m.Pool
 	}
 	return ""
 }
 
-func (m *ContainerError) GetKubernetesReason() KubernetesReason {
+func (m *PodInfo) GetNodeLabels() map[string]string {
 	if m != nil {
-		return m.KubernetesReason
+		return m.NodeLabels
 	}
-	return KubernetesReason_AppError
+	return nil
 }
 
-// Indicates that the pod could not be scheduled for some reason.
-type PodLeaseReturned struct {
-	ObjectMeta   *ObjectMeta `protobuf:"bytes,1,opt,name=objectMeta,proto3" json:"objectMeta,omitempty"`
-	Message      string      `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	PodNumber    int32       `protobuf:"varint,3,opt,name=pod_number,json=podNumber,proto3" json:"podNumber,omitempty"`
-	RunAttempted bool        `protobuf:"varint,4,opt,name=run_attempted,json=runAttempted,proto3" json:"runAttempted,omitempty"`
+// Runtime information of an ingress.
+type IngressInfo struct {
+	// TODO: Why a node name?
+	NodeName string `protobuf:"bytes,1,opt,name=node_name,json=nodeName,proto3" json:"nodeName,omitempty"`
+	// TODO: Why a map?
+	IngressAddresses map[int32]string `protobuf:"bytes,2,rep,name=ingress_addresses,json=ingressAddresses,proto3" json:"ingressAddresses,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (m *PodLeaseReturned) Reset()         { *m = PodLeaseReturned{} }
-func (m *PodLeaseReturned) String() string { return proto.CompactTextString(m) }
-func (*PodLeaseReturned) ProtoMessage()    {}
-func (*PodLeaseReturned) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{31}
+func (m *IngressInfo) Reset()         { *m = IngressInfo{} }
+func (m *IngressInfo) String() string { return proto.CompactTextString(m) }
+func (*IngressInfo) ProtoMessage()    {}
+func (*IngressInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aab92ca59e015f8, []int{33}
 }
-func (m *PodLeaseReturned) XXX_Unmarshal(b []byte) error {
+func (m *IngressInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PodLeaseReturned) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *IngressInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PodLeaseReturned.Marshal(b, m, deterministic)
+		return xxx_messageInfo_IngressInfo.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2846,68 +2895,60 @@ func (m *PodLeaseReturned) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *PodLeaseReturned) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PodLeaseReturned.Merge(m, src)
+func (m *IngressInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IngressInfo.Merge(m, src)
 }
-func (m *PodLeaseReturned) XXX_Size() int {
+func (m *IngressInfo) XXX_Size() int {
 	return m.Size()
 }
-func (m *PodLeaseReturned) XXX_DiscardUnknown() {
-	xxx_messageInfo_PodLeaseReturned.DiscardUnknown(m)
+func (m *IngressInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_IngressInfo.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PodLeaseReturned proto.InternalMessageInfo
-
-func (m *PodLeaseReturned) GetObjectMeta() *ObjectMeta {
-	if m != nil {
-		return m.ObjectMeta
-	}
-	return nil
-}
+var xxx_messageInfo_IngressInfo proto.InternalMessageInfo
 
-func (m *PodLeaseReturned) GetMessage() string {
+func (m *IngressInfo) GetNodeName() string {
 	if m != nil {
-		return m.Message
+		return m.NodeName
 	}
 	return ""
 }
 
-func (m *PodLeaseReturned) GetPodNumber() int32 {
-	if m != nil {
-		return m.PodNumber
-	}
-	return 0
-}
-
-func (m *PodLeaseReturned) GetRunAttempted() bool {
+func (m *IngressInfo) GetIngressAddresses() map[int32]string {
 	if m != nil {
-		return m.RunAttempted
+		return m.IngressAddresses
 	}
-	return false
+	return nil
 }
 
-// Indicates that the lease on the job that the pod was part of could not be renewed.
-// If this happens, the executor deletes the pod and generates a JobRunError with this message as the reason.
-type PodTerminated struct {
-	// This ObjectMeta identifies the Pod.
-	ObjectMeta *ObjectMeta `protobuf:"bytes,1,opt,name=objectMeta,proto3" json:"objectMeta,omitempty"`
-	Message    string      `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	NodeName   string      `protobuf:"bytes,3,opt,name=node_name,json=nodeName,proto3" json:"nodeName,omitempty"`
-	PodNumber  int32       `protobuf:"varint,4,opt,name=pod_number,json=podNumber,proto3" json:"podNumber,omitempty"`
+// For sending details associated with a created ingress.
+// Used for compatibility with legacy messages, which send ingress info as its own message.
+// Later, this info should be bundled with the JobRunRunning message.
+type StandaloneIngressInfo struct {
+	RunId *Uuid `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"runId,omitempty"`
+	JobId *Uuid `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+	// ObjectMeta associated with the ingress.
+	ObjectMeta       *ObjectMeta      `protobuf:"bytes,3,opt,name=objectMeta,proto3" json:"objectMeta,omitempty"`
+	IngressAddresses map[int32]string `protobuf:"bytes,4,rep,name=ingress_addresses,json=ingressAddresses,proto3" json:"ingressAddresses,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// The legacy message bundles info associated with the pod.
+	NodeName     string `protobuf:"bytes,5,opt,name=node_name,json=nodeName,proto3" json:"nodeName,omitempty"`
+	PodNumber    int32  `protobuf:"varint,6,opt,name=pod_number,json=podNumber,proto3" json:"podNumber,omitempty"`
+	PodName      string `protobuf:"bytes,7,opt,name=pod_name,json=podName,proto3" json:"podName,omitempty"`
+	PodNamespace string `protobuf:"bytes,8,opt,name=pod_namespace,json=podNamespace,proto3" json:"podNamespace,omitempty"`
 }
 
-func (m *PodTerminated) Reset()         { *m = PodTerminated{} }
-func (m *PodTerminated) String() string { return proto.CompactTextString(m) }
-func (*PodTerminated) ProtoMessage()    {}
-func (*PodTerminated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{32}
+func (m *StandaloneIngressInfo) Reset()         { *m = StandaloneIngressInfo{} }
+func (m *StandaloneIngressInfo) String() string { return proto.CompactTextString(m) }
+func (*StandaloneIngressInfo) ProtoMessage()    {}
+func (*StandaloneIngressInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aab92ca59e015f8, []int{34}
 }
-func (m *PodTerminated) XXX_Unmarshal(b []byte) error {
+func (m *StandaloneIngressInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PodTerminated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *StandaloneIngressInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PodTerminated.Marshal(b, m, deterministic)
+		return xxx_messageInfo_StandaloneIngressInfo.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2917,102 +2958,96 @@ func (m *PodTerminated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *PodTerminated) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PodTerminated.Merge(m, src)
+func (m *StandaloneIngressInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StandaloneIngressInfo.Merge(m, src)
 }
-func (m *PodTerminated) XXX_Size() int {
+func (m *StandaloneIngressInfo) XXX_Size() int {
 	return m.Size()
 }
-func (m *PodTerminated) XXX_DiscardUnknown() {
-	xxx_messageInfo_PodTerminated.DiscardUnknown(m)
+func (m *StandaloneIngressInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_StandaloneIngressInfo.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PodTerminated proto.InternalMessageInfo
+var xxx_messageInfo_StandaloneIngressInfo proto.InternalMessageInfo
 
-func (m *PodTerminated) GetObjectMeta() *ObjectMeta {
+func (m *StandaloneIngressInfo) GetRunId() *Uuid {
+	if m != nil {
+		return m.RunId
+	}
+	return nil
+}
+
+func (m *StandaloneIngressInfo) GetJobId() *Uuid {
+	if m != nil {
+		return m.JobId
+	}
+	return nil
+}
+
+func (m *StandaloneIngressInfo) GetObjectMeta() *ObjectMeta {
 	if m != nil {
 		return m.ObjectMeta
 	}
 	return nil
 }
 
-func (m *PodTerminated) GetMessage() string {
+func (m *StandaloneIngressInfo) GetIngressAddresses() map[int32]string {
 	if m != nil {
-		return m.Message
+		return m.IngressAddresses
 	}
-	return ""
+	return nil
 }
 
-func (m *PodTerminated) GetNodeName() string {
+func (m *StandaloneIngressInfo) GetNodeName() string {
 	if m != nil {
 		return m.NodeName
 	}
 	return ""
 }
 
-func (m *PodTerminated) GetPodNumber() int32 {
+func (m *StandaloneIngressInfo) GetPodNumber() int32 {
 	if m != nil {
 		return m.PodNumber
 	}
 	return 0
 }
 
-type ExecutorError struct {
+func (m *StandaloneIngressInfo) GetPodName() string {
+	if m != nil {
+		return m.PodName
+	}
+	return ""
 }
 
-func (m *ExecutorError) Reset()         { *m = ExecutorError{} }
-func (m *ExecutorError) String() string { return proto.CompactTextString(m) }
-func (*ExecutorError) ProtoMessage()    {}
-func (*ExecutorError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{33}
-}
-func (m *ExecutorError) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *ExecutorError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_ExecutorError.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *StandaloneIngressInfo) GetPodNamespace() string {
+	if m != nil {
+		return m.PodNamespace
 	}
+	return ""
 }
-func (m *ExecutorError) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ExecutorError.Merge(m, src)
-}
-func (m *ExecutorError) XXX_Size() int {
-	return m.Size()
-}
-func (m *ExecutorError) XXX_DiscardUnknown() {
-	xxx_messageInfo_ExecutorError.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_ExecutorError proto.InternalMessageInfo
 
-type PodUnschedulable struct {
-	// this ObjectMeta identifies the Pod
-	ObjectMeta *ObjectMeta `protobuf:"bytes,1,opt,name=objectMeta,proto3" json:"objectMeta,omitempty"`
-	Message    string      `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	NodeName   string      `protobuf:"bytes,3,opt,name=node_name,json=nodeName,proto3" json:"nodeName,omitempty"`
-	PodNumber  int32       `protobuf:"varint,4,opt,name=pod_number,json=podNumber,proto3" json:"podNumber,omitempty"`
+// Indicates that the job finished successfully (i.e., in the expected manner).
+type JobRunSucceeded struct {
+	RunId *Uuid `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"runId,omitempty"`
+	JobId *Uuid `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+	// Runtime information, e.g., which node the job is running on, its IP address etc,
+	// for each resource created for the job run.
+	// TODO: remove this once we have fixed the external api
+	ResourceInfos []*KubernetesResourceInfo `protobuf:"bytes,3,rep,name=resourceInfos,proto3" json:"resourceInfos,omitempty"`
 }
 
-func (m *PodUnschedulable) Reset()         { *m = PodUnschedulable{} }
-func (m *PodUnschedulable) String() string { return proto.CompactTextString(m) }
-func (*PodUnschedulable) ProtoMessage()    {}
-func (*PodUnschedulable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{34}
+func (m *JobRunSucceeded) Reset()         { *m = JobRunSucceeded{} }
+func (m *JobRunSucceeded) String() string { return proto.CompactTextString(m) }
+func (*JobRunSucceeded) ProtoMessage()    {}
+func (*JobRunSucceeded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aab92ca59e015f8, []int{35}
 }
-func (m *PodUnschedulable) XXX_Unmarshal(b []byte) error {
+func (m *JobRunSucceeded) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *PodUnschedulable) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *JobRunSucceeded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_PodUnschedulable.Marshal(b, m, deterministic)
+		return xxx_messageInfo_JobRunSucceeded.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3022,61 +3057,60 @@ func (m *PodUnschedulable) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *PodUnschedulable) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PodUnschedulable.Merge(m, src)
+func (m *JobRunSucceeded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobRunSucceeded.Merge(m, src)
 }
-func (m *PodUnschedulable) XXX_Size() int {
+func (m *JobRunSucceeded) XXX_Size() int {
 	return m.Size()
 }
-func (m *PodUnschedulable) XXX_DiscardUnknown() {
-	xxx_messageInfo_PodUnschedulable.DiscardUnknown(m)
+func (m *JobRunSucceeded) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobRunSucceeded.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PodUnschedulable proto.InternalMessageInfo
+var xxx_messageInfo_JobRunSucceeded proto.InternalMessageInfo
 
-func (m *PodUnschedulable) GetObjectMeta() *ObjectMeta {
+func (m *JobRunSucceeded) GetRunId() *Uuid {
 	if m != nil {
-		return m.ObjectMeta
+		return m.RunId
 	}
 	return nil
 }
 
-func (m *PodUnschedulable) GetMessage() string {
-	if m != nil {
-		return m.Message
-	}
-	return ""
-}
-
-func (m *PodUnschedulable) GetNodeName() string {
+func (m *JobRunSucceeded) GetJobId() *Uuid {
 	if m != nil {
-		return m.NodeName
+		return m.JobId
 	}
-	return ""
+	return nil
 }
 
-func (m *PodUnschedulable) GetPodNumber() int32 {
+func (m *JobRunSucceeded) GetResourceInfos() []*KubernetesResourceInfo {
 	if m != nil {
-		return m.PodNumber
+		return m.ResourceInfos
 	}
-	return 0
+	return nil
 }
 
-type LeaseExpired struct {
+// Message containing a set of errors associated with a particular job.
+// Contains a flag, which if set to true indicates that the job has failed.
+// Otherwise, this message represents a set of errors from which the system has recovered.
+type JobErrors struct {
+	JobId *Uuid `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+	// A set of errors that occurred within some context.
+	Errors []*Error `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
 }
 
-func (m *LeaseExpired) Reset()         { *m = LeaseExpired{} }
-func (m *LeaseExpired) String() string { return proto.CompactTextString(m) }
-func (*LeaseExpired) ProtoMessage()    {}
-func (*LeaseExpired) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{35}
+func (m *JobErrors) Reset()         { *m = JobErrors{} }
+func (m *JobErrors) String() string { return proto.CompactTextString(m) }
+func (*JobErrors) ProtoMessage()    {}
+func (*JobErrors) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aab92ca59e015f8, []int{36}
 }
-func (m *LeaseExpired) XXX_Unmarshal(b []byte) error {
+func (m *JobErrors) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *LeaseExpired) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *JobErrors) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_LeaseExpired.Marshal(b, m, deterministic)
+		return xxx_messageInfo_JobErrors.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3086,77 +3120,58 @@ func (m *LeaseExpired) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *LeaseExpired) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LeaseExpired.Merge(m, src)
+func (m *JobErrors) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobErrors.Merge(m, src)
 }
-func (m *LeaseExpired) XXX_Size() int {
+func (m *JobErrors) XXX_Size() int {
 	return m.Size()
 }
-func (m *LeaseExpired) XXX_DiscardUnknown() {
-	xxx_messageInfo_LeaseExpired.DiscardUnknown(m)
+func (m *JobErrors) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobErrors.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LeaseExpired proto.InternalMessageInfo
-
-type MaxRunsExceeded struct {
-	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
-}
+var xxx_messageInfo_JobErrors proto.InternalMessageInfo
 
-func (m *MaxRunsExceeded) Reset()         { *m = MaxRunsExceeded{} }
-func (m *MaxRunsExceeded) String() string { return proto.CompactTextString(m) }
-func (*MaxRunsExceeded) ProtoMessage()    {}
-func (*MaxRunsExceeded) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{36}
-}
-func (m *MaxRunsExceeded) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *MaxRunsExceeded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_MaxRunsExceeded.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *JobErrors) GetJobId() *Uuid {
+	if m != nil {
+		return m.JobId
 	}
-}
-func (m *MaxRunsExceeded) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MaxRunsExceeded.Merge(m, src)
-}
-func (m *MaxRunsExceeded) XXX_Size() int {
-	return m.Size()
-}
-func (m *MaxRunsExceeded) XXX_DiscardUnknown() {
-	xxx_messageInfo_MaxRunsExceeded.DiscardUnknown(m)
+	return nil
 }
 
-var xxx_messageInfo_MaxRunsExceeded proto.InternalMessageInfo
-
-func (m *MaxRunsExceeded) GetMessage() string {
+func (m *JobErrors) GetErrors() []*Error {
 	if m != nil {
-		return m.Message
+		return m.Errors
 	}
-	return ""
+	return nil
 }
 
-type JobRunPreemptedError struct {
+// Message containing a set of errors associated with a particular job run.
+// Contains a flag, which if set to true indicates that the job run has failed.
+// Otherwise, this message represents a set of errors from which the system has recovered.
+//
+// Because each error may specify a parent error, the set of errors form a tree.
+//
+// Services may listen for these messages to obtain realtime information about errors associated with jobs.
+type JobRunErrors struct {
+	RunId *Uuid `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"runId,omitempty"`
+	JobId *Uuid `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+	// A set of errors that occurred within some context.
+	Errors []*Error `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"`
 }
 
-func (m *JobRunPreemptedError) Reset()         { *m = JobRunPreemptedError{} }
-func (m *JobRunPreemptedError) String() string { return proto.CompactTextString(m) }
-func (*JobRunPreemptedError) ProtoMessage()    {}
-func (*JobRunPreemptedError) Descriptor() ([]byte, []int) {
+func (m *JobRunErrors) Reset()         { *m = JobRunErrors{} }
+func (m *JobRunErrors) String() string { return proto.CompactTextString(m) }
+func (*JobRunErrors) ProtoMessage()    {}
+func (*JobRunErrors) Descriptor() ([]byte, []int) {
 	return fileDescriptor_6aab92ca59e015f8, []int{37}
 }
-func (m *JobRunPreemptedError) XXX_Unmarshal(b []byte) error {
+func (m *JobRunErrors) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *JobRunPreemptedError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *JobRunErrors) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_JobRunPreemptedError.Marshal(b, m, deterministic)
+		return xxx_messageInfo_JobRunErrors.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3166,81 +3181,79 @@ func (m *JobRunPreemptedError) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *JobRunPreemptedError) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_JobRunPreemptedError.Merge(m, src)
+func (m *JobRunErrors) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobRunErrors.Merge(m, src)
 }
-func (m *JobRunPreemptedError) XXX_Size() int {
+func (m *JobRunErrors) XXX_Size() int {
 	return m.Size()
 }
-func (m *JobRunPreemptedError) XXX_DiscardUnknown() {
-	xxx_messageInfo_JobRunPreemptedError.DiscardUnknown(m)
+func (m *JobRunErrors) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobRunErrors.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_JobRunPreemptedError proto.InternalMessageInfo
+var xxx_messageInfo_JobRunErrors proto.InternalMessageInfo
 
-type GangJobUnschedulable struct {
-	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+func (m *JobRunErrors) GetRunId() *Uuid {
+	if m != nil {
+		return m.RunId
+	}
+	return nil
 }
 
-func (m *GangJobUnschedulable) Reset()         { *m = GangJobUnschedulable{} }
-func (m *GangJobUnschedulable) String() string { return proto.CompactTextString(m) }
-func (*GangJobUnschedulable) ProtoMessage()    {}
-func (*GangJobUnschedulable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{38}
-}
-func (m *GangJobUnschedulable) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *GangJobUnschedulable) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_GangJobUnschedulable.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *JobRunErrors) GetJobId() *Uuid {
+	if m != nil {
+		return m.JobId
 	}
+	return nil
 }
-func (m *GangJobUnschedulable) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GangJobUnschedulable.Merge(m, src)
-}
-func (m *GangJobUnschedulable) XXX_Size() int {
-	return m.Size()
-}
-func (m *GangJobUnschedulable) XXX_DiscardUnknown() {
-	xxx_messageInfo_GangJobUnschedulable.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_GangJobUnschedulable proto.InternalMessageInfo
 
-func (m *GangJobUnschedulable) GetMessage() string {
+func (m *JobRunErrors) GetErrors() []*Error {
 	if m != nil {
-		return m.Message
+		return m.Errors
 	}
-	return ""
+	return nil
 }
 
-// Generated by the scheduler whenever it detects a SubmitJob message that includes a previously used deduplication id
-// (i.e., when it detects a duplicate job submission).
-type JobDuplicateDetected struct {
-	NewJobId *Uuid `protobuf:"bytes,1,opt,name=new_job_id,json=newJobId,proto3" json:"newJobId,omitempty"`
-	OldJobId *Uuid `protobuf:"bytes,2,opt,name=old_job_id,json=oldJobId,proto3" json:"oldJobId,omitempty"`
+// Represents a failure that took place in the course of a job run (i.e., an attempt to run a job).
+// Each failure is either a resource failure or a system-level failure.
+// Resource failures correspond to a problem with a specific Kubernetes resource
+// (e.g., if a container terminates with a non-zero exit code).
+// System-level failure messages are created by the executor (e.g., a failure by the executor to write to Kubernetes).
+//
+// This message may only be published to the log as part of a JobRunError message.
+// TODO: Check if the container status contained any useful info for non-failed containers.
+type Error struct {
+	// If true, this error represents an unrecoverable failure and Armada has given up on this job or job run.
+	Terminal bool `protobuf:"varint,1,opt,name=terminal,proto3" json:"terminal,omitempty"`
+	// Additional information for this particular combination of component and error. May be set to nil.
+	//
+	// Types that are valid to be assigned to Reason:
+	//	*Error_KubernetesError
+	//	*Error_ContainerError
+	//	*Error_ExecutorError
+	//	*Error_PodUnschedulable
+	//	*Error_LeaseExpired
+	//	*Error_MaxRunsExceeded
+	//	*Error_PodError
+	//	*Error_PodLeaseReturned
+	//	*Error_PodTerminated
+	//	*Error_JobRunPreemptedError
+	//	*Error_GangJobUnschedulable
+	Reason isError_Reason `protobuf_oneof:"reason"`
 }
 
-func (m *JobDuplicateDetected) Reset()         { *m = JobDuplicateDetected{} }
-func (m *JobDuplicateDetected) String() string { return proto.CompactTextString(m) }
-func (*JobDuplicateDetected) ProtoMessage()    {}
-func (*JobDuplicateDetected) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{39}
+func (m *Error) Reset()         { *m = Error{} }
+func (m *Error) String() string { return proto.CompactTextString(m) }
+func (*Error) ProtoMessage()    {}
+func (*Error) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aab92ca59e015f8, []int{38}
 }
-func (m *JobDuplicateDetected) XXX_Unmarshal(b []byte) error {
+func (m *Error) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *JobDuplicateDetected) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Error) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_JobDuplicateDetected.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Error.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3250,178 +3263,198 @@ func (m *JobDuplicateDetected) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *JobDuplicateDetected) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_JobDuplicateDetected.Merge(m, src)
+func (m *Error) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Error.Merge(m, src)
 }
-func (m *JobDuplicateDetected) XXX_Size() int {
+func (m *Error) XXX_Size() int {
 	return m.Size()
 }
-func (m *JobDuplicateDetected) XXX_DiscardUnknown() {
-	xxx_messageInfo_JobDuplicateDetected.DiscardUnknown(m)
+func (m *Error) XXX_DiscardUnknown() {
+	xxx_messageInfo_Error.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_JobDuplicateDetected proto.InternalMessageInfo
-
-func (m *JobDuplicateDetected) GetNewJobId() *Uuid {
-	if m != nil {
-		return m.NewJobId
-	}
-	return nil
-}
+var xxx_messageInfo_Error proto.InternalMessageInfo
 
-func (m *JobDuplicateDetected) GetOldJobId() *Uuid {
-	if m != nil {
-		return m.OldJobId
-	}
-	return nil
+type isError_Reason interface {
+	isError_Reason()
+	MarshalTo([]byte) (int, error)
+	Size() int
 }
 
-// Message to indicate that a JobRun has been preempted.
-type JobRunPreempted struct {
-	// Uuid of the job that was preempted
-	PreemptedJobId *Uuid `protobuf:"bytes,1,opt,name=preempted_job_id,json=preemptedJobId,proto3" json:"preemptedJobId,omitempty"`
-	// Uuid of the job run that was preempted.
-	PreemptedRunId *Uuid `protobuf:"bytes,2,opt,name=preempted_run_id,json=preemptedRunId,proto3" json:"preemptedRunId,omitempty"`
-	// Uuid of the job that caused the preemption.
-	PreemptiveJobId *Uuid `protobuf:"bytes,3,opt,name=preemptive_job_id,json=preemptiveJobId,proto3" json:"preemptiveJobId,omitempty"`
-	// Uuid of the job run that caused the preemption.
-	PreemptiveRunId *Uuid `protobuf:"bytes,4,opt,name=preemptive_run_id,json=preemptiveRunId,proto3" json:"preemptiveRunId,omitempty"`
+type Error_KubernetesError struct {
+	KubernetesError *KubernetesError `protobuf:"bytes,2,opt,name=kubernetesError,proto3,oneof" json:"kubernetesError,omitempty"`
 }
-
-func (m *JobRunPreempted) Reset()         { *m = JobRunPreempted{} }
-func (m *JobRunPreempted) String() string { return proto.CompactTextString(m) }
-func (*JobRunPreempted) ProtoMessage()    {}
-func (*JobRunPreempted) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{40}
+type Error_ContainerError struct {
+	ContainerError *ContainerError `protobuf:"bytes,3,opt,name=containerError,proto3,oneof" json:"containerError,omitempty"`
 }
-func (m *JobRunPreempted) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+type Error_ExecutorError struct {
+	ExecutorError *ExecutorError `protobuf:"bytes,4,opt,name=executorError,proto3,oneof" json:"executorError,omitempty"`
 }
-func (m *JobRunPreempted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_JobRunPreempted.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
-	}
+type Error_PodUnschedulable struct {
+	PodUnschedulable *PodUnschedulable `protobuf:"bytes,5,opt,name=podUnschedulable,proto3,oneof" json:"podUnschedulable,omitempty"`
 }
-func (m *JobRunPreempted) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_JobRunPreempted.Merge(m, src)
+type Error_LeaseExpired struct {
+	LeaseExpired *LeaseExpired `protobuf:"bytes,6,opt,name=leaseExpired,proto3,oneof" json:"leaseExpired,omitempty"`
 }
-func (m *JobRunPreempted) XXX_Size() int {
-	return m.Size()
+type Error_MaxRunsExceeded struct {
+	MaxRunsExceeded *MaxRunsExceeded `protobuf:"bytes,7,opt,name=maxRunsExceeded,proto3,oneof" json:"maxRunsExceeded,omitempty"`
 }
-func (m *JobRunPreempted) XXX_DiscardUnknown() {
-	xxx_messageInfo_JobRunPreempted.DiscardUnknown(m)
+type Error_PodError struct {
+	PodError *PodError `protobuf:"bytes,8,opt,name=podError,proto3,oneof" json:"podError,omitempty"`
+}
+type Error_PodLeaseReturned struct {
+	PodLeaseReturned *PodLeaseReturned `protobuf:"bytes,9,opt,name=podLeaseReturned,proto3,oneof" json:"podLeaseReturned,omitempty"`
+}
+type Error_PodTerminated struct {
+	PodTerminated *PodTerminated `protobuf:"bytes,10,opt,name=podTerminated,proto3,oneof" json:"podTerminated,omitempty"`
+}
+type Error_JobRunPreemptedError struct {
+	JobRunPreemptedError *JobRunPreemptedError `protobuf:"bytes,11,opt,name=jobRunPreemptedError,proto3,oneof" json:"jobRunPreemptedError,omitempty"`
+}
+type Error_GangJobUnschedulable struct {
+	GangJobUnschedulable *GangJobUnschedulable `protobuf:"bytes,12,opt,name=gangJobUnschedulable,proto3,oneof" json:"gangJobUnschedulable,omitempty"`
 }
 
-var xxx_messageInfo_JobRunPreempted proto.InternalMessageInfo
+func (*Error_KubernetesError) isError_Reason()      {}
+func (*Error_ContainerError) isError_Reason()       {}
+func (*Error_ExecutorError) isError_Reason()        {}
+func (*Error_PodUnschedulable) isError_Reason()     {}
+func (*Error_LeaseExpired) isError_Reason()         {}
+func (*Error_MaxRunsExceeded) isError_Reason()      {}
+func (*Error_PodError) isError_Reason()             {}
+func (*Error_PodLeaseReturned) isError_Reason()     {}
+func (*Error_PodTerminated) isError_Reason()        {}
+func (*Error_JobRunPreemptedError) isError_Reason() {}
+func (*Error_GangJobUnschedulable) isError_Reason() {}
 
-func (m *JobRunPreempted) GetPreemptedJobId() *Uuid {
+func (m *Error) GetReason() isError_Reason {
 	if m != nil {
-		return m.PreemptedJobId
+		return m.Reason
 	}
 	return nil
 }
 
-func (m *JobRunPreempted) GetPreemptedRunId() *Uuid {
+func (m *Error) GetTerminal() bool {
 	if m != nil {
-		return m.PreemptedRunId
+		return m.Terminal
 	}
-	return nil
+	return false
 }
 
-func (m *JobRunPreempted) GetPreemptiveJobId() *Uuid {
-	if m != nil {
-		return m.PreemptiveJobId
+func (m *Error) GetKubernetesError() *KubernetesError {
+	if x, ok := m.GetReason().(*Error_KubernetesError); ok {
+		return x.KubernetesError
 	}
 	return nil
 }
 
-func (m *JobRunPreempted) GetPreemptiveRunId() *Uuid {
-	if m != nil {
-		return m.PreemptiveRunId
+func (m *Error) GetContainerError() *ContainerError {
+	if x, ok := m.GetReason().(*Error_ContainerError); ok {
+		return x.ContainerError
 	}
 	return nil
 }
 
-// Message used internally by Armada to see if messages can be propagated through a pulsar partition
-type PartitionMarker struct {
-	// group id ties together multiple messages across different partitions
-	GroupId *Uuid `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"groupId,omitempty"`
-	// The pulsar partition on which this message was sent
-	Partition uint32 `protobuf:"varint,2,opt,name=partition,proto3" json:"partition,omitempty"`
+func (m *Error) GetExecutorError() *ExecutorError {
+	if x, ok := m.GetReason().(*Error_ExecutorError); ok {
+		return x.ExecutorError
+	}
+	return nil
 }
 
-func (m *PartitionMarker) Reset()         { *m = PartitionMarker{} }
-func (m *PartitionMarker) String() string { return proto.CompactTextString(m) }
-func (*PartitionMarker) ProtoMessage()    {}
-func (*PartitionMarker) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{41}
+func (m *Error) GetPodUnschedulable() *PodUnschedulable {
+	if x, ok := m.GetReason().(*Error_PodUnschedulable); ok {
+		return x.PodUnschedulable
+	}
+	return nil
 }
-func (m *PartitionMarker) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+
+func (m *Error) GetLeaseExpired() *LeaseExpired {
+	if x, ok := m.GetReason().(*Error_LeaseExpired); ok {
+		return x.LeaseExpired
+	}
+	return nil
 }
-func (m *PartitionMarker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_PartitionMarker.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+
+func (m *Error) GetMaxRunsExceeded() *MaxRunsExceeded {
+	if x, ok := m.GetReason().(*Error_MaxRunsExceeded); ok {
+		return x.MaxRunsExceeded
 	}
+	return nil
 }
-func (m *PartitionMarker) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PartitionMarker.Merge(m, src)
+
+func (m *Error) GetPodError() *PodError {
+	if x, ok := m.GetReason().(*Error_PodError); ok {
+		return x.PodError
+	}
+	return nil
 }
-func (m *PartitionMarker) XXX_Size() int {
-	return m.Size()
+
+func (m *Error) GetPodLeaseReturned() *PodLeaseReturned {
+	if x, ok := m.GetReason().(*Error_PodLeaseReturned); ok {
+		return x.PodLeaseReturned
+	}
+	return nil
 }
-func (m *PartitionMarker) XXX_DiscardUnknown() {
-	xxx_messageInfo_PartitionMarker.DiscardUnknown(m)
+
+func (m *Error) GetPodTerminated() *PodTerminated {
+	if x, ok := m.GetReason().(*Error_PodTerminated); ok {
+		return x.PodTerminated
+	}
+	return nil
 }
 
-var xxx_messageInfo_PartitionMarker proto.InternalMessageInfo
+func (m *Error) GetJobRunPreemptedError() *JobRunPreemptedError {
+	if x, ok := m.GetReason().(*Error_JobRunPreemptedError); ok {
+		return x.JobRunPreemptedError
+	}
+	return nil
+}
 
-func (m *PartitionMarker) GetGroupId() *Uuid {
-	if m != nil {
-		return m.GroupId
+func (m *Error) GetGangJobUnschedulable() *GangJobUnschedulable {
+	if x, ok := m.GetReason().(*Error_GangJobUnschedulable); ok {
+		return x.GangJobUnschedulable
 	}
 	return nil
 }
 
-func (m *PartitionMarker) GetPartition() uint32 {
-	if m != nil {
-		return m.Partition
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*Error) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*Error_KubernetesError)(nil),
+		(*Error_ContainerError)(nil),
+		(*Error_ExecutorError)(nil),
+		(*Error_PodUnschedulable)(nil),
+		(*Error_LeaseExpired)(nil),
+		(*Error_MaxRunsExceeded)(nil),
+		(*Error_PodError)(nil),
+		(*Error_PodLeaseReturned)(nil),
+		(*Error_PodTerminated)(nil),
+		(*Error_JobRunPreemptedError)(nil),
+		(*Error_GangJobUnschedulable)(nil),
 	}
-	return 0
 }
 
-// Indicates that the scheduler has requested for the job run to be pre-empted.
-type JobRunPreemptionRequested struct {
-	RunId *Uuid `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"runId,omitempty"`
-	JobId *Uuid `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+// Represents an error associated with a particular Kubernetes resource.
+type KubernetesError struct {
+	// Type of Kubernetes resource (e.g., ingress).
+	ResourceType string `protobuf:"bytes,1,opt,name=resourceType,proto3" json:"resourceType,omitempty"`
+	// Id assigned to the resource by Kubernetes.
+	ResourceId *Uuid `protobuf:"bytes,2,opt,name=resourceId,proto3" json:"resourceId,omitempty"`
 }
 
-func (m *JobRunPreemptionRequested) Reset()         { *m = JobRunPreemptionRequested{} }
-func (m *JobRunPreemptionRequested) String() string { return proto.CompactTextString(m) }
-func (*JobRunPreemptionRequested) ProtoMessage()    {}
-func (*JobRunPreemptionRequested) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6aab92ca59e015f8, []int{42}
+func (m *KubernetesError) Reset()         { *m = KubernetesError{} }
+func (m *KubernetesError) String() string { return proto.CompactTextString(m) }
+func (*KubernetesError) ProtoMessage()    {}
+func (*KubernetesError) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aab92ca59e015f8, []int{39}
 }
-func (m *JobRunPreemptionRequested) XXX_Unmarshal(b []byte) error {
+func (m *KubernetesError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *JobRunPreemptionRequested) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *KubernetesError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_JobRunPreemptionRequested.Marshal(b, m, deterministic)
+		return xxx_messageInfo_KubernetesError.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3431,1447 +3464,1187 @@ func (m *JobRunPreemptionRequested) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *JobRunPreemptionRequested) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_JobRunPreemptionRequested.Merge(m, src)
+func (m *KubernetesError) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KubernetesError.Merge(m, src)
 }
-func (m *JobRunPreemptionRequested) XXX_Size() int {
+func (m *KubernetesError) XXX_Size() int {
 	return m.Size()
 }
-func (m *JobRunPreemptionRequested) XXX_DiscardUnknown() {
-	xxx_messageInfo_JobRunPreemptionRequested.DiscardUnknown(m)
+func (m *KubernetesError) XXX_DiscardUnknown() {
+	xxx_messageInfo_KubernetesError.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_JobRunPreemptionRequested proto.InternalMessageInfo
+var xxx_messageInfo_KubernetesError proto.InternalMessageInfo
 
-func (m *JobRunPreemptionRequested) GetRunId() *Uuid {
+func (m *KubernetesError) GetResourceType() string {
 	if m != nil {
-		return m.RunId
+		return m.ResourceType
 	}
-	return nil
+	return ""
 }
 
-func (m *JobRunPreemptionRequested) GetJobId() *Uuid {
+func (m *KubernetesError) GetResourceId() *Uuid {
 	if m != nil {
-		return m.JobId
+		return m.ResourceId
 	}
 	return nil
 }
 
-func init() {
-	proto.RegisterEnum("armadaevents.JobState", JobState_name, JobState_value)
-	proto.RegisterEnum("armadaevents.KubernetesReason", KubernetesReason_name, KubernetesReason_value)
-	proto.RegisterType((*EventSequence)(nil), "armadaevents.EventSequence")
-	proto.RegisterType((*EventSequence_Event)(nil), "armadaevents.EventSequence.Event")
-	proto.RegisterType((*ResourceUtilisation)(nil), "armadaevents.ResourceUtilisation")
-	proto.RegisterMapType((map[string]resource.Quantity)(nil), "armadaevents.ResourceUtilisation.MaxResourcesForPeriodEntry")
-	proto.RegisterMapType((map[string]resource.Quantity)(nil), "armadaevents.ResourceUtilisation.TotalCumulativeUsageEntry")
-	proto.RegisterType((*Uuid)(nil), "armadaevents.Uuid")
-	proto.RegisterType((*SubmitJob)(nil), "armadaevents.SubmitJob")
-	proto.RegisterType((*KubernetesMainObject)(nil), "armadaevents.KubernetesMainObject")
-	proto.RegisterType((*KubernetesObject)(nil), "armadaevents.KubernetesObject")
-	proto.RegisterType((*ObjectMeta)(nil), "armadaevents.ObjectMeta")
-	proto.RegisterMapType((map[string]string)(nil), "armadaevents.ObjectMeta.AnnotationsEntry")
-	proto.RegisterMapType((map[string]string)(nil), "armadaevents.ObjectMeta.LabelsEntry")
-	proto.RegisterType((*PodSpecWithAvoidList)(nil), "armadaevents.PodSpecWithAvoidList")
-	proto.RegisterType((*ReprioritiseJob)(nil), "armadaevents.ReprioritiseJob")
-	proto.RegisterType((*JobRequeued)(nil), "armadaevents.JobRequeued")
-	proto.RegisterType((*ReprioritiseJobSet)(nil), "armadaevents.ReprioritiseJobSet")
-	proto.RegisterType((*ReprioritisedJob)(nil), "armadaevents.ReprioritisedJob")
-	proto.RegisterType((*CancelJob)(nil), "armadaevents.CancelJob")
-	proto.RegisterType((*JobSetFilter)(nil), "armadaevents.JobSetFilter")
-	proto.RegisterType((*CancelJobSet)(nil), "armadaevents.CancelJobSet")
-	proto.RegisterType((*CancelledJob)(nil), "armadaevents.CancelledJob")
-	proto.RegisterType((*JobSucceeded)(nil), "armadaevents.JobSucceeded")
-	proto.RegisterType((*JobRunLeased)(nil), "armadaevents.JobRunLeased")
-	proto.RegisterType((*JobRunAssigned)(nil), "armadaevents.JobRunAssigned")
-	proto.RegisterType((*JobRunRunning)(nil), "armadaevents.JobRunRunning")
-	proto.RegisterType((*KubernetesResourceInfo)(nil), "armadaevents.KubernetesResourceInfo")
-	proto.RegisterType((*PodInfo)(nil), "armadaevents.PodInfo")
-	proto.RegisterType((*IngressInfo)(nil), "armadaevents.IngressInfo")
-	proto.RegisterMapType((map[int32]string)(nil), "armadaevents.IngressInfo.IngressAddressesEntry")
-	proto.RegisterType((*StandaloneIngressInfo)(nil), "armadaevents.StandaloneIngressInfo")
-	proto.RegisterMapType((map[int32]string)(nil), "armadaevents.StandaloneIngressInfo.IngressAddressesEntry")
-	proto.RegisterType((*JobRunSucceeded)(nil), "armadaevents.JobRunSucceeded")
-	proto.RegisterType((*JobErrors)(nil), "armadaevents.JobErrors")
-	proto.RegisterType((*JobRunErrors)(nil), "armadaevents.JobRunErrors")
-	proto.RegisterType((*Error)(nil), "armadaevents.Error")
-	proto.RegisterType((*KubernetesError)(nil), "armadaevents.KubernetesError")
-	proto.RegisterType((*PodError)(nil), "armadaevents.PodError")
-	proto.RegisterType((*ContainerError)(nil), "armadaevents.ContainerError")
-	proto.RegisterType((*PodLeaseReturned)(nil), "armadaevents.PodLeaseReturned")
-	proto.RegisterType((*PodTerminated)(nil), "armadaevents.PodTerminated")
-	proto.RegisterType((*ExecutorError)(nil), "armadaevents.ExecutorError")
-	proto.RegisterType((*PodUnschedulable)(nil), "armadaevents.PodUnschedulable")
-	proto.RegisterType((*LeaseExpired)(nil), "armadaevents.LeaseExpired")
-	proto.RegisterType((*MaxRunsExceeded)(nil), "armadaevents.MaxRunsExceeded")
-	proto.RegisterType((*JobRunPreemptedError)(nil), "armadaevents.JobRunPreemptedError")
-	proto.RegisterType((*GangJobUnschedulable)(nil), "armadaevents.GangJobUnschedulable")
-	proto.RegisterType((*JobDuplicateDetected)(nil), "armadaevents.JobDuplicateDetected")
-	proto.RegisterType((*JobRunPreempted)(nil), "armadaevents.JobRunPreempted")
-	proto.RegisterType((*PartitionMarker)(nil), "armadaevents.PartitionMarker")
-	proto.RegisterType((*JobRunPreemptionRequested)(nil), "armadaevents.JobRunPreemptionRequested")
-}
-
-func init() { proto.RegisterFile("pkg/armadaevents/events.proto", fileDescriptor_6aab92ca59e015f8) }
-
-var fileDescriptor_6aab92ca59e015f8 = []byte{
-	// 3567 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5b, 0x49, 0x6c, 0x1c, 0xd7,
-	0x99, 0x56, 0x75, 0x93, 0xbd, 0xfc, 0xcd, 0xa5, 0xf5, 0x44, 0xd2, 0x2d, 0x5a, 0x62, 0xd3, 0x25,
-	0xcf, 0x58, 0x36, 0xec, 0xa6, 0x2d, 0x2f, 0xf0, 0x32, 0xb0, 0xc1, 0x96, 0x68, 0x2d, 0x16, 0x25,
-	0xba, 0x29, 0x7a, 0x34, 0x86, 0x07, 0xed, 0xea, 0xae, 0xc7, 0x66, 0x89, 0xd5, 0x55, 0xe5, 0x5a,
-	0x28, 0x12, 0xf0, 0x61, 0x66, 0x30, 0xe3, 0x01, 0x72, 0x70, 0x04, 0x24, 0x87, 0x00, 0x39, 0x38,
-	0xd7, 0x18, 0xc8, 0x39, 0xe7, 0x9c, 0xe2, 0x43, 0x10, 0x38, 0xb7, 0x9c, 0x3a, 0x81, 0x8d, 0x1c,
-	0xd2, 0x87, 0x9c, 0x93, 0x5c, 0x12, 0xbc, 0xad, 0xea, 0xbd, 0xea, 0x6a, 0x89, 0xda, 0x22, 0x07,
-	0x3a, 0x91, 0xf5, 0xfd, 0x6b, 0xbd, 0xe5, 0xaf, 0xff, 0xff, 0xdf, 0x6b, 0x38, 0xe9, 0xed, 0xf6,
-	0x56, 0x0c, 0xbf, 0x6f, 0x98, 0x06, 0xde, 0xc3, 0x4e, 0x18, 0xac, 0xb0, 0x3f, 0x0d, 0xcf, 0x77,
-	0x43, 0x17, 0x4d, 0xc9, 0xa4, 0x45, 0x7d, 0xf7, 0xf5, 0xa0, 0x61, 0xb9, 0x2b, 0x86, 0x67, 0xad,
-	0x74, 0x5d, 0x1f, 0xaf, 0xec, 0xbd, 0xb4, 0xd2, 0xc3, 0x0e, 0xf6, 0x8d, 0x10, 0x9b, 0x4c, 0x62,
-	0xf1, 0xb4, 0xc4, 0xe3, 0xe0, 0xf0, 0xa6, 0xeb, 0xef, 0x5a, 0x4e, 0x2f, 0x8b, 0xb3, 0xde, 0x73,
-	0xdd, 0x9e, 0x8d, 0x57, 0xe8, 0x53, 0x27, 0xda, 0x5e, 0x09, 0xad, 0x3e, 0x0e, 0x42, 0xa3, 0xef,
-	0x71, 0x86, 0xa5, 0x34, 0xc3, 0x4d, 0xdf, 0xf0, 0x3c, 0xec, 0x73, 0xe7, 0x16, 0x5f, 0x49, 0x4c,
-	0xf5, 0x8d, 0xee, 0x8e, 0xe5, 0x60, 0xff, 0x60, 0x85, 0xbe, 0x8f, 0x67, 0xad, 0xf8, 0x38, 0x70,
-	0x23, 0xbf, 0x8b, 0x47, 0xcc, 0xbe, 0xd0, 0xb3, 0xc2, 0x9d, 0xa8, 0xd3, 0xe8, 0xba, 0xfd, 0x95,
-	0x9e, 0xdb, 0x73, 0x13, 0xf5, 0xe4, 0x89, 0x3e, 0xd0, 0xff, 0x38, 0xfb, 0x9b, 0x96, 0x13, 0x62,
-	0xdf, 0x31, 0xec, 0x95, 0xa0, 0xbb, 0x83, 0xcd, 0xc8, 0xc6, 0x7e, 0xf2, 0x9f, 0xdb, 0xb9, 0x81,
-	0xbb, 0x61, 0x30, 0x02, 0x30, 0x59, 0xfd, 0xd6, 0x1c, 0x4c, 0xaf, 0x91, 0xa1, 0xdb, 0xc4, 0x9f,
-	0x44, 0xd8, 0xe9, 0x62, 0xf4, 0x2c, 0x4c, 0x7e, 0x12, 0xe1, 0x08, 0xd7, 0xb4, 0x65, 0xed, 0x74,
-	0xb9, 0x79, 0x6c, 0x38, 0xa8, 0xcf, 0x52, 0xe0, 0x79, 0xb7, 0x6f, 0x85, 0xb8, 0xef, 0x85, 0x07,
-	0x2d, 0xc6, 0x81, 0xde, 0x84, 0xa9, 0x1b, 0x6e, 0xa7, 0x1d, 0xe0, 0xb0, 0xed, 0x18, 0x7d, 0x5c,
-	0xcb, 0x51, 0x89, 0xda, 0x70, 0x50, 0x9f, 0xbb, 0xe1, 0x76, 0x36, 0x71, 0x78, 0xc5, 0xe8, 0xcb,
-	0x62, 0x90, 0xa0, 0xe8, 0x05, 0x28, 0x46, 0x01, 0xf6, 0xdb, 0x96, 0x59, 0xcb, 0x53, 0xb1, 0xb9,
-	0xe1, 0xa0, 0x5e, 0x25, 0xd0, 0x45, 0x53, 0x12, 0x29, 0x30, 0x04, 0x3d, 0x0f, 0x85, 0x9e, 0xef,
-	0x46, 0x5e, 0x50, 0x9b, 0x58, 0xce, 0x0b, 0x6e, 0x86, 0xc8, 0xdc, 0x0c, 0x41, 0x57, 0xa1, 0xc0,
-	0xd6, 0x43, 0x6d, 0x72, 0x39, 0x7f, 0xba, 0x72, 0xe6, 0xa9, 0x86, 0xbc, 0x48, 0x1a, 0xca, 0x0b,
-	0xb3, 0x27, 0xa6, 0x90, 0xd1, 0x65, 0x85, 0x7c, 0x59, 0xfd, 0xf1, 0x28, 0x4c, 0x52, 0x3e, 0x74,
-	0x15, 0x8a, 0x5d, 0x1f, 0x93, 0xc9, 0xaa, 0xa1, 0x65, 0xed, 0x74, 0xe5, 0xcc, 0x62, 0x83, 0xad,
-	0x81, 0x86, 0x98, 0xa4, 0xc6, 0x35, 0xb1, 0x48, 0x9a, 0xc7, 0x87, 0x83, 0xfa, 0x51, 0xce, 0x9e,
-	0x68, 0xbd, 0xf5, 0xbb, 0xba, 0xd6, 0x12, 0x5a, 0xd0, 0x06, 0x94, 0x83, 0xa8, 0xd3, 0xb7, 0xc2,
-	0x4b, 0x6e, 0x87, 0x8e, 0x79, 0xe5, 0xcc, 0x13, 0xaa, 0xbb, 0x9b, 0x82, 0xdc, 0x7c, 0x62, 0x38,
-	0xa8, 0x1f, 0x8b, 0xb9, 0x13, 0x8d, 0x17, 0x8e, 0xb4, 0x12, 0x25, 0x68, 0x07, 0x66, 0x7d, 0xec,
-	0xf9, 0x96, 0xeb, 0x5b, 0xa1, 0x15, 0x60, 0xa2, 0x37, 0x47, 0xf5, 0x9e, 0x54, 0xf5, 0xb6, 0x54,
-	0xa6, 0xe6, 0xc9, 0xe1, 0xa0, 0x7e, 0x3c, 0x25, 0xa9, 0xd8, 0x48, 0xab, 0x45, 0x21, 0xa0, 0x14,
-	0xb4, 0x89, 0x43, 0x3a, 0x9f, 0x95, 0x33, 0xcb, 0xb7, 0x35, 0xb6, 0x89, 0xc3, 0xe6, 0xf2, 0x70,
-	0x50, 0x3f, 0x31, 0x2a, 0xaf, 0x98, 0xcc, 0xd0, 0x8f, 0x6c, 0xa8, 0xca, 0xa8, 0x49, 0x5e, 0x70,
-	0x82, 0xda, 0x5c, 0x1a, 0x6f, 0x93, 0x70, 0x35, 0x97, 0x86, 0x83, 0xfa, 0x62, 0x5a, 0x56, 0xb1,
-	0x37, 0xa2, 0x99, 0xcc, 0x4f, 0xd7, 0x70, 0xba, 0xd8, 0x26, 0x66, 0x26, 0xb3, 0xe6, 0xe7, 0xac,
-	0x20, 0xb3, 0xf9, 0x89, 0xb9, 0xd5, 0xf9, 0x89, 0x61, 0xf4, 0x11, 0x4c, 0xc5, 0x0f, 0x64, 0xbc,
-	0x0a, 0x7c, 0x1d, 0x65, 0x2b, 0x25, 0x23, 0xb5, 0x38, 0x1c, 0xd4, 0x17, 0x64, 0x19, 0x45, 0xb5,
-	0xa2, 0x2d, 0xd1, 0x6e, 0xb3, 0x91, 0x29, 0x8e, 0xd7, 0xce, 0x38, 0x64, 0xed, 0xf6, 0xe8, 0x88,
-	0x28, 0xda, 0x88, 0x76, 0xb2, 0x89, 0xa3, 0x6e, 0x17, 0x63, 0x13, 0x9b, 0xb5, 0x52, 0x96, 0xf6,
-	0x4b, 0x12, 0x07, 0xd3, 0x2e, 0xcb, 0xa8, 0xda, 0x65, 0x0a, 0x19, 0xeb, 0x1b, 0x6e, 0x67, 0xcd,
-	0xf7, 0x5d, 0x3f, 0xa8, 0x95, 0xb3, 0xc6, 0xfa, 0x92, 0x20, 0xb3, 0xb1, 0x8e, 0xb9, 0xd5, 0xb1,
-	0x8e, 0x61, 0xee, 0x6f, 0x2b, 0x72, 0x2e, 0x63, 0x23, 0xc0, 0x66, 0x0d, 0xc6, 0xf8, 0x1b, 0x73,
-	0xc4, 0xfe, 0xc6, 0xc8, 0x88, 0xbf, 0x31, 0x05, 0x99, 0x30, 0xc3, 0x9e, 0x57, 0x83, 0xc0, 0xea,
-	0x39, 0xd8, 0xac, 0x55, 0xa8, 0xfe, 0x13, 0x59, 0xfa, 0x05, 0x4f, 0xf3, 0xc4, 0x70, 0x50, 0xaf,
-	0xa9, 0x72, 0x8a, 0x8d, 0x94, 0x4e, 0xf4, 0x31, 0x4c, 0x33, 0xa4, 0x15, 0x39, 0x8e, 0xe5, 0xf4,
-	0x6a, 0x53, 0xd4, 0xc8, 0x93, 0x59, 0x46, 0x38, 0x4b, 0xf3, 0xc9, 0xe1, 0xa0, 0xfe, 0x84, 0x22,
-	0xa5, 0x98, 0x50, 0x15, 0x92, 0x88, 0xc1, 0x80, 0x64, 0x62, 0xa7, 0xb3, 0x22, 0xc6, 0x25, 0x95,
-	0x89, 0x45, 0x8c, 0x94, 0xa4, 0x1a, 0x31, 0x52, 0xc4, 0x64, 0x3e, 0xf8, 0x24, 0xcf, 0x8c, 0x9f,
-	0x0f, 0x3e, 0xcf, 0xd2, 0x7c, 0x64, 0x4c, 0xb5, 0xa2, 0x0d, 0x7d, 0x0a, 0xe4, 0xc3, 0x73, 0x2e,
-	0xf2, 0x6c, 0xab, 0x6b, 0x84, 0xf8, 0x1c, 0x0e, 0x71, 0x97, 0x44, 0xea, 0x59, 0x6a, 0x45, 0x1f,
-	0xb1, 0x32, 0xc2, 0xd9, 0xd4, 0x87, 0x83, 0xfa, 0x52, 0x96, 0x0e, 0xc5, 0x6a, 0xa6, 0x15, 0xf4,
-	0x5f, 0x1a, 0xcc, 0x07, 0xa1, 0xe1, 0x98, 0x86, 0xed, 0x3a, 0xf8, 0xa2, 0xd3, 0xf3, 0x71, 0x10,
-	0x5c, 0x74, 0xb6, 0xdd, 0x5a, 0x95, 0xda, 0x3f, 0x95, 0x0a, 0xeb, 0x59, 0xac, 0xcd, 0x53, 0xc3,
-	0x41, 0xbd, 0x9e, 0xa9, 0x45, 0xf1, 0x20, 0xdb, 0x10, 0xda, 0x87, 0x63, 0x22, 0xab, 0xd8, 0x0a,
-	0x2d, 0xdb, 0x0a, 0x8c, 0xd0, 0x72, 0x9d, 0xda, 0x51, 0x6a, 0xff, 0xa9, 0x74, 0x74, 0x1c, 0x61,
-	0x6c, 0x3e, 0x35, 0x1c, 0xd4, 0x4f, 0x66, 0x68, 0x50, 0x6c, 0x67, 0x99, 0x48, 0x96, 0xd0, 0x86,
-	0x8f, 0x09, 0x23, 0x36, 0x6b, 0xc7, 0xc6, 0x2f, 0xa1, 0x98, 0x49, 0x5e, 0x42, 0x31, 0x98, 0xb5,
-	0x84, 0x62, 0x22, 0xb1, 0xe4, 0x19, 0x7e, 0x68, 0x11, 0xb3, 0xeb, 0x86, 0xbf, 0x8b, 0xfd, 0xda,
-	0x5c, 0x96, 0xa5, 0x0d, 0x95, 0x89, 0x59, 0x4a, 0x49, 0xaa, 0x96, 0x52, 0x44, 0x74, 0x4b, 0x03,
-	0xd5, 0x35, 0xcb, 0x75, 0x5a, 0x24, 0x6d, 0x08, 0xc8, 0xeb, 0xcd, 0x53, 0xa3, 0xcf, 0xdc, 0xe6,
-	0xf5, 0x64, 0xf6, 0xe6, 0x33, 0xc3, 0x41, 0xfd, 0xd4, 0x58, 0x6d, 0x8a, 0x23, 0xe3, 0x8d, 0xa2,
-	0xeb, 0x50, 0x21, 0x44, 0x4c, 0x13, 0x30, 0xb3, 0xb6, 0x40, 0x7d, 0x38, 0x3e, 0xea, 0x03, 0x67,
-	0xa0, 0x19, 0xc8, 0xbc, 0x24, 0xa1, 0xd8, 0x91, 0x55, 0x35, 0x8b, 0x30, 0x49, 0xe5, 0xf5, 0x61,
-	0x01, 0x8e, 0x65, 0xac, 0x0d, 0xf4, 0x36, 0x14, 0xfc, 0xc8, 0x21, 0x09, 0x1b, 0xcb, 0x52, 0x90,
-	0x6a, 0x75, 0x2b, 0xb2, 0x4c, 0x96, 0x2d, 0xfa, 0x91, 0xa3, 0xe4, 0x70, 0x93, 0x14, 0x20, 0xf2,
-	0x24, 0x5b, 0xb4, 0x4c, 0x9e, 0x8d, 0x8c, 0x95, 0xbf, 0xe1, 0x76, 0x54, 0x79, 0x0a, 0x20, 0x0c,
-	0xd3, 0x62, 0xe1, 0xb5, 0x2d, 0xb2, 0xab, 0x58, 0x9e, 0xf1, 0xb4, 0xaa, 0xe6, 0xbd, 0xa8, 0x83,
-	0x7d, 0x07, 0x87, 0x38, 0x10, 0xef, 0x40, 0xb7, 0x15, 0x8d, 0x22, 0xbe, 0x84, 0x48, 0xfa, 0xa7,
-	0x64, 0x1c, 0xfd, 0x50, 0x83, 0x5a, 0xdf, 0xd8, 0x6f, 0x0b, 0x30, 0x68, 0x6f, 0xbb, 0x7e, 0xdb,
-	0xc3, 0xbe, 0xe5, 0x9a, 0x34, 0xf9, 0xac, 0x9c, 0xf9, 0xb7, 0x3b, 0x6e, 0xa4, 0xc6, 0xba, 0xb1,
-	0x2f, 0xe0, 0xe0, 0x5d, 0xd7, 0xdf, 0xa0, 0xe2, 0x6b, 0x4e, 0xe8, 0x1f, 0x34, 0x4f, 0x7e, 0x35,
-	0xa8, 0x1f, 0x21, 0xd3, 0xd2, 0xcf, 0xe2, 0x69, 0x65, 0xc3, 0xe8, 0xfb, 0x1a, 0x2c, 0x84, 0x6e,
-	0x68, 0xd8, 0xed, 0x6e, 0xd4, 0x8f, 0x6c, 0x23, 0xb4, 0xf6, 0x70, 0x3b, 0x0a, 0x8c, 0x1e, 0xe6,
-	0x39, 0xee, 0x5b, 0x77, 0x76, 0xea, 0x1a, 0x91, 0x3f, 0x1b, 0x8b, 0x6f, 0x11, 0x69, 0xe6, 0xd3,
-	0x09, 0xee, 0xd3, 0x5c, 0x98, 0xc1, 0xd2, 0xca, 0x44, 0x17, 0x7f, 0xa2, 0xc1, 0xe2, 0xf8, 0xd7,
-	0x44, 0xa7, 0x20, 0xbf, 0x8b, 0x0f, 0x78, 0x15, 0x71, 0x74, 0x38, 0xa8, 0x4f, 0xef, 0xe2, 0x03,
-	0x69, 0xd4, 0x09, 0x15, 0xfd, 0x07, 0x4c, 0xee, 0x19, 0x76, 0x84, 0xf9, 0x92, 0x68, 0x34, 0x58,
-	0xbd, 0xd4, 0x90, 0xeb, 0xa5, 0x86, 0xb7, 0xdb, 0x23, 0x40, 0x43, 0xcc, 0x48, 0xe3, 0xfd, 0xc8,
-	0x70, 0x42, 0x2b, 0x3c, 0x60, 0xcb, 0x85, 0x2a, 0x90, 0x97, 0x0b, 0x05, 0xde, 0xcc, 0xbd, 0xae,
-	0x2d, 0x7e, 0xa1, 0xc1, 0xf1, 0xb1, 0x2f, 0xfd, 0x5d, 0xf0, 0x50, 0x6f, 0xc3, 0x04, 0x59, 0xf8,
-	0xa4, 0xbe, 0xd9, 0xb1, 0x7a, 0x3b, 0xaf, 0xbd, 0x42, 0xdd, 0x29, 0xb0, 0x72, 0x84, 0x21, 0x72,
-	0x39, 0xc2, 0x10, 0x52, 0xa3, 0xd9, 0xee, 0xcd, 0xd7, 0x5e, 0xa1, 0x4e, 0x15, 0x98, 0x11, 0x0a,
-	0xc8, 0x46, 0x28, 0xa0, 0xff, 0xad, 0x00, 0xe5, 0xb8, 0x80, 0x90, 0xf6, 0xa0, 0x76, 0x4f, 0x7b,
-	0xf0, 0x02, 0x54, 0x4d, 0x6c, 0xf2, 0x2f, 0x9f, 0xe5, 0x3a, 0x62, 0x37, 0x97, 0x59, 0x74, 0x55,
-	0x68, 0x8a, 0xfc, 0x6c, 0x8a, 0x84, 0xce, 0x40, 0x89, 0x27, 0xda, 0x07, 0x74, 0x23, 0x4f, 0x37,
-	0x17, 0x86, 0x83, 0x3a, 0x12, 0x98, 0x24, 0x1a, 0xf3, 0xa1, 0x16, 0x00, 0xab, 0x5e, 0xd7, 0x71,
-	0x68, 0xf0, 0x94, 0xbf, 0xa6, 0xbe, 0xc1, 0xd5, 0x98, 0xce, 0xea, 0xd0, 0x84, 0x5f, 0xae, 0x43,
-	0x13, 0x14, 0x7d, 0x04, 0xd0, 0x37, 0x2c, 0x87, 0xc9, 0xf1, 0xfc, 0x5e, 0x1f, 0x17, 0x52, 0xd6,
-	0x63, 0x4e, 0xa6, 0x3d, 0x91, 0x94, 0xb5, 0x27, 0x28, 0xa9, 0x16, 0x79, 0xbd, 0x5d, 0x2b, 0xd0,
-	0x5d, 0xba, 0x34, 0x4e, 0x35, 0x57, 0x3b, 0x4f, 0x2a, 0x46, 0x2e, 0x22, 0xe9, 0x14, 0x5a, 0xc8,
-	0xb0, 0xd9, 0xd6, 0x36, 0x0e, 0xad, 0x3e, 0xa6, 0x99, 0x3d, 0x1f, 0x36, 0x81, 0xc9, 0xc3, 0x26,
-	0x30, 0xf4, 0x3a, 0x80, 0x11, 0xae, 0xbb, 0x41, 0x78, 0xd5, 0xe9, 0x62, 0x9a, 0xb1, 0x97, 0x98,
-	0xfb, 0x09, 0x2a, 0xbb, 0x9f, 0xa0, 0xe8, 0x2d, 0xa8, 0x78, 0xfc, 0x23, 0xd4, 0xb1, 0x31, 0xcd,
-	0xc8, 0x4b, 0xec, 0x93, 0x22, 0xc1, 0x92, 0xac, 0xcc, 0x8d, 0xce, 0xc3, 0x6c, 0xd7, 0x75, 0xba,
-	0x91, 0xef, 0x63, 0xa7, 0x7b, 0xb0, 0x69, 0x6c, 0x63, 0x9a, 0x7d, 0x97, 0xd8, 0x52, 0x49, 0x91,
-	0xe4, 0xa5, 0x92, 0x22, 0xa1, 0x57, 0xa1, 0x1c, 0x77, 0x2f, 0x68, 0x82, 0x5d, 0xe6, 0x85, 0xb0,
-	0x00, 0x25, 0xe1, 0x84, 0x93, 0x38, 0x6f, 0x05, 0x71, 0x96, 0x46, 0x93, 0x66, 0xee, 0xbc, 0x04,
-	0xcb, 0xce, 0x4b, 0x30, 0xba, 0x08, 0x47, 0xe9, 0x77, 0xb1, 0x1d, 0x86, 0x76, 0x3b, 0xc0, 0x5d,
-	0xd7, 0x31, 0x03, 0x9a, 0x13, 0xe7, 0x99, 0xfb, 0x94, 0x78, 0x2d, 0xb4, 0x37, 0x19, 0x49, 0x76,
-	0x3f, 0x45, 0xd2, 0x7f, 0xa5, 0xc1, 0x5c, 0xd6, 0x12, 0x4a, 0x2d, 0x67, 0xed, 0x81, 0x2c, 0xe7,
-	0x0f, 0xa0, 0xe4, 0xb9, 0x66, 0x3b, 0xf0, 0x70, 0x97, 0x47, 0xac, 0xd4, 0x62, 0xde, 0x70, 0xcd,
-	0x4d, 0x0f, 0x77, 0xff, 0xdd, 0x0a, 0x77, 0x56, 0xf7, 0x5c, 0xcb, 0xbc, 0x6c, 0x05, 0x7c, 0xd5,
-	0x79, 0x8c, 0xa2, 0x64, 0x08, 0x45, 0x0e, 0x36, 0x4b, 0x50, 0x60, 0x56, 0xf4, 0x5f, 0xe7, 0xa1,
-	0x9a, 0x5e, 0xb6, 0xff, 0x4c, 0xaf, 0x82, 0xae, 0x43, 0xd1, 0x62, 0x29, 0x33, 0xcf, 0x20, 0xfe,
-	0x45, 0x8a, 0xe9, 0x8d, 0xa4, 0x21, 0xd8, 0xd8, 0x7b, 0xa9, 0xc1, 0x73, 0x6b, 0x3a, 0x04, 0x54,
-	0x33, 0x97, 0x54, 0x35, 0x73, 0x10, 0xb5, 0xa0, 0x18, 0x60, 0x7f, 0xcf, 0xea, 0x62, 0x1e, 0x9c,
-	0xea, 0xb2, 0xe6, 0xae, 0xeb, 0x63, 0xa2, 0x73, 0x93, 0xb1, 0x24, 0x3a, 0xb9, 0x8c, 0xaa, 0x93,
-	0x83, 0xe8, 0x03, 0x28, 0x77, 0x5d, 0x67, 0xdb, 0xea, 0xad, 0x1b, 0x1e, 0x0f, 0x4f, 0x27, 0xb3,
-	0xb4, 0x9e, 0x15, 0x4c, 0xbc, 0x09, 0x21, 0x1e, 0x53, 0x4d, 0x88, 0x98, 0x2b, 0x99, 0xd0, 0x3f,
-	0x4d, 0x00, 0x24, 0x93, 0x83, 0xde, 0x80, 0x0a, 0xde, 0xc7, 0xdd, 0x28, 0x74, 0x7d, 0xf1, 0x9d,
-	0xe0, 0x3d, 0x3d, 0x01, 0x2b, 0x81, 0x1d, 0x12, 0x94, 0x6c, 0x54, 0xc7, 0xe8, 0xe3, 0xc0, 0x33,
-	0xba, 0xa2, 0x19, 0x48, 0x9d, 0x89, 0x41, 0x79, 0xa3, 0xc6, 0x20, 0xfa, 0x57, 0x98, 0xa0, 0xed,
-	0x43, 0xd6, 0x07, 0x44, 0xc3, 0x41, 0x7d, 0xc6, 0x51, 0x1b, 0x87, 0x94, 0x8e, 0xde, 0x81, 0xe9,
-	0xdd, 0x78, 0xe1, 0x11, 0xdf, 0x26, 0xa8, 0x00, 0x4d, 0xed, 0x12, 0x82, 0xe2, 0xdd, 0x94, 0x8c,
-	0xa3, 0x6d, 0xa8, 0x18, 0x8e, 0xe3, 0x86, 0xf4, 0x1b, 0x24, 0x7a, 0x83, 0xcf, 0x8e, 0x5b, 0xa6,
-	0x8d, 0xd5, 0x84, 0x97, 0x65, 0x49, 0x34, 0x78, 0x48, 0x1a, 0xe4, 0xe0, 0x21, 0xc1, 0xa8, 0x05,
-	0x05, 0xdb, 0xe8, 0x60, 0x5b, 0x04, 0xfd, 0xa7, 0xc7, 0x9a, 0xb8, 0x4c, 0xd9, 0x98, 0x76, 0xfa,
-	0xc9, 0x67, 0x72, 0xf2, 0x27, 0x9f, 0x21, 0x8b, 0xdb, 0x50, 0x4d, 0xfb, 0x73, 0xb8, 0x04, 0xe6,
-	0x59, 0x39, 0x81, 0x29, 0xdf, 0x31, 0x65, 0x32, 0xa0, 0x22, 0x39, 0xf5, 0x30, 0x4c, 0xe8, 0x3f,
-	0xd5, 0x60, 0x2e, 0x6b, 0xef, 0xa2, 0x75, 0x69, 0xc7, 0x6b, 0xbc, 0xc7, 0x91, 0xb1, 0xd4, 0xb9,
-	0xec, 0x98, 0xad, 0x9e, 0x6c, 0xf4, 0x26, 0xcc, 0x38, 0xae, 0x89, 0xdb, 0x06, 0x31, 0x60, 0x5b,
-	0x41, 0x58, 0xcb, 0xd1, 0xde, 0x31, 0xed, 0x8d, 0x10, 0xca, 0xaa, 0x20, 0x48, 0xd2, 0xd3, 0x0a,
-	0x41, 0xff, 0x3f, 0x0d, 0x66, 0x53, 0xad, 0xcb, 0xfb, 0x4e, 0xa2, 0xe4, 0xd4, 0x27, 0x77, 0xb8,
-	0xd4, 0x47, 0xff, 0x41, 0x0e, 0x2a, 0x52, 0x5d, 0x77, 0xdf, 0x3e, 0xdc, 0x80, 0x59, 0xfe, 0xa5,
-	0xb4, 0x9c, 0x1e, 0x2b, 0xa7, 0x72, 0xbc, 0x49, 0x31, 0x72, 0x52, 0x70, 0xc9, 0xed, 0x6c, 0xc6,
-	0xbc, 0xb4, 0x9a, 0xa2, 0x1d, 0xac, 0x40, 0xc1, 0x24, 0x13, 0x33, 0x2a, 0x05, 0x5d, 0x87, 0x85,
-	0xc8, 0x33, 0x8d, 0x10, 0xb7, 0x03, 0xde, 0x73, 0x6f, 0x3b, 0x51, 0xbf, 0x83, 0x7d, 0xba, 0xe3,
-	0x27, 0x59, 0xcf, 0x85, 0x71, 0x88, 0xa6, 0xfc, 0x15, 0x4a, 0x97, 0x74, 0xce, 0x65, 0xd1, 0xf5,
-	0x0b, 0x80, 0x46, 0xfb, 0xca, 0xca, 0xf8, 0x6a, 0x87, 0x1c, 0xdf, 0xcf, 0x34, 0xa8, 0xa6, 0xdb,
-	0xc5, 0x8f, 0x64, 0xa2, 0x0f, 0xa0, 0x1c, 0xb7, 0x7e, 0xef, 0xdb, 0x81, 0xe7, 0xa1, 0xe0, 0x63,
-	0x23, 0x70, 0x1d, 0xbe, 0x33, 0x69, 0x88, 0x61, 0x88, 0x1c, 0x62, 0x18, 0xa2, 0x5f, 0x83, 0x29,
-	0x36, 0x82, 0xef, 0x5a, 0x76, 0x88, 0x7d, 0x74, 0x0e, 0x0a, 0x41, 0x68, 0x84, 0x38, 0xa8, 0x69,
-	0xcb, 0xf9, 0xd3, 0x33, 0x67, 0x16, 0x46, 0xbb, 0xbc, 0x84, 0xcc, 0xb4, 0x32, 0x4e, 0x59, 0x2b,
-	0x43, 0xf4, 0xff, 0xd1, 0x60, 0x4a, 0x6e, 0x66, 0x3f, 0x18, 0xb5, 0x77, 0xf9, 0x6a, 0x9f, 0x0a,
-	0x1f, 0xec, 0x07, 0x33, 0xb3, 0x77, 0x67, 0xfd, 0xe7, 0x1a, 0x1b, 0xd9, 0xb8, 0x0b, 0x7a, 0xbf,
-	0xe6, 0x7b, 0x49, 0x2b, 0x84, 0xec, 0xb0, 0x80, 0x06, 0xb6, 0xc3, 0xb6, 0x42, 0x68, 0xf8, 0x53,
-	0xc4, 0xe5, 0xf0, 0xa7, 0x10, 0xf4, 0xef, 0x4d, 0x50, 0xcf, 0x93, 0x8e, 0xf7, 0xa3, 0x6e, 0x02,
-	0xa5, 0xb2, 0x93, 0xfc, 0x5d, 0x64, 0x27, 0x2f, 0x40, 0x91, 0x7e, 0x0e, 0xe2, 0xc4, 0x81, 0x4e,
-	0x1a, 0x81, 0xd4, 0x13, 0x47, 0x86, 0xdc, 0x26, 0x6a, 0x4d, 0xde, 0x5f, 0xd4, 0x42, 0x6d, 0x38,
-	0xbe, 0x63, 0x04, 0x6d, 0x11, 0x67, 0xcd, 0xb6, 0x11, 0xb6, 0xe3, 0x38, 0x51, 0xa0, 0x65, 0xca,
-	0xd3, 0xc3, 0x41, 0x7d, 0x79, 0xc7, 0x08, 0x36, 0x05, 0xcf, 0x6a, 0xb8, 0x31, 0x1a, 0x35, 0x16,
-	0xb2, 0x39, 0xd0, 0x16, 0xcc, 0x67, 0x2b, 0x2f, 0x52, 0xcf, 0x69, 0x93, 0x37, 0xb8, 0xad, 0xe6,
-	0x63, 0x19, 0x64, 0xfd, 0x2f, 0x1a, 0xcc, 0xa8, 0x47, 0x19, 0x8f, 0x7c, 0x39, 0x8c, 0x6c, 0x84,
-	0xfc, 0x43, 0xda, 0x08, 0x7f, 0xd6, 0x60, 0x5a, 0x39, 0x61, 0x79, 0x7c, 0x5e, 0xfd, 0x47, 0x39,
-	0x58, 0xc8, 0x56, 0xf3, 0x50, 0xca, 0xbe, 0x0b, 0x40, 0x12, 0xb8, 0x8b, 0x49, 0x46, 0x32, 0x3f,
-	0x52, 0xf5, 0xd1, 0x57, 0x10, 0xd9, 0xdf, 0xc8, 0xd1, 0x88, 0x10, 0x47, 0xd7, 0xa1, 0x62, 0x49,
-	0x87, 0x30, 0xf9, 0xac, 0x5e, 0xb9, 0x7c, 0xf4, 0xc2, 0x7a, 0x03, 0x63, 0x0e, 0x5c, 0x64, 0x55,
-	0xcd, 0x02, 0x4c, 0x90, 0x94, 0x49, 0xdf, 0x83, 0x22, 0x77, 0x07, 0xbd, 0x0c, 0x65, 0x1a, 0x5d,
-	0x68, 0x25, 0xc3, 0xd2, 0x65, 0xfa, 0xb1, 0x27, 0x60, 0xea, 0x1a, 0x44, 0x49, 0x60, 0xe8, 0x35,
-	0x00, 0x92, 0xf0, 0xf2, 0xb8, 0x92, 0xa3, 0xbb, 0x93, 0x56, 0x4c, 0x9e, 0x6b, 0x8e, 0x04, 0x93,
-	0x72, 0x0c, 0xea, 0x3f, 0xcb, 0x41, 0x45, 0x3e, 0xf6, 0xb9, 0x27, 0xe3, 0x9f, 0x82, 0xa8, 0x66,
-	0xdb, 0x86, 0x69, 0x92, 0xbf, 0x58, 0x7c, 0x48, 0x56, 0xc6, 0x0e, 0x92, 0xf8, 0x7f, 0x55, 0x48,
-	0xb0, 0xda, 0x85, 0x1e, 0xac, 0x5b, 0x29, 0x92, 0x64, 0xb5, 0x9a, 0xa6, 0x2d, 0xee, 0xc2, 0x7c,
-	0xa6, 0x2a, 0xb9, 0xe2, 0x98, 0x7c, 0x50, 0x15, 0xc7, 0x2f, 0x26, 0x61, 0x3e, 0xf3, 0xb8, 0xed,
-	0x91, 0xef, 0x62, 0x75, 0x07, 0xe5, 0x1f, 0xc8, 0x0e, 0xfa, 0x4c, 0xcb, 0x9a, 0x59, 0x76, 0x74,
-	0xf1, 0xc6, 0x21, 0xce, 0x20, 0x1f, 0xd4, 0x1c, 0xab, 0xcb, 0x72, 0xf2, 0x9e, 0xf6, 0x44, 0xe1,
-	0xb0, 0x7b, 0x02, 0xbd, 0xc8, 0x8a, 0x47, 0x6a, 0xab, 0x48, 0x6d, 0x89, 0x08, 0x91, 0x32, 0x55,
-	0xe4, 0x10, 0x7a, 0x07, 0xa6, 0x85, 0x04, 0x6b, 0x59, 0x94, 0x92, 0x7e, 0x02, 0xe7, 0x49, 0x77,
-	0x2d, 0xa6, 0x64, 0xfc, 0x1f, 0xbb, 0x86, 0xff, 0xaa, 0xc1, 0x6c, 0xea, 0xfc, 0xfd, 0xf1, 0xf9,
-	0x06, 0x7d, 0xae, 0x41, 0x39, 0xbe, 0xfa, 0x71, 0xdf, 0xe9, 0xf3, 0x2a, 0x14, 0x30, 0xbb, 0x7e,
-	0xc0, 0xc2, 0xdd, 0xb1, 0xd4, 0xf5, 0x30, 0x42, 0xe3, 0x17, 0xc2, 0x52, 0x37, 0x0e, 0x5a, 0x5c,
-	0x50, 0xff, 0x8d, 0x26, 0x12, 0xe3, 0xc4, 0xa7, 0x47, 0x3a, 0x15, 0xc9, 0x3b, 0xe5, 0xef, 0xf5,
-	0x9d, 0x7e, 0x59, 0x86, 0x49, 0xca, 0x47, 0x0a, 0xd7, 0x10, 0xfb, 0x7d, 0xcb, 0x31, 0x6c, 0xfa,
-	0x3a, 0x25, 0xb6, 0x6f, 0x05, 0x26, 0xef, 0x5b, 0x81, 0xa1, 0x1d, 0x98, 0x4d, 0x9a, 0x6d, 0x54,
-	0x4d, 0xf6, 0xad, 0xb3, 0xf7, 0x54, 0x26, 0xd6, 0x4e, 0x4f, 0x49, 0xaa, 0xc7, 0xf2, 0x29, 0x22,
-	0x32, 0x61, 0xa6, 0xeb, 0x3a, 0xa1, 0x61, 0x39, 0xd8, 0x67, 0x86, 0xf2, 0x59, 0xb7, 0x6e, 0xce,
-	0x2a, 0x3c, 0xac, 0x67, 0xa1, 0xca, 0xa9, 0xb7, 0x6e, 0x54, 0x1a, 0xfa, 0x18, 0xa6, 0x45, 0xf1,
-	0xc0, 0x8c, 0x4c, 0x64, 0xdd, 0xba, 0x59, 0x93, 0x59, 0xd8, 0x92, 0x56, 0xa4, 0xd4, 0x5b, 0x37,
-	0x0a, 0x09, 0xd9, 0x50, 0xf5, 0x5c, 0x73, 0xcb, 0xe1, 0xb9, 0xb6, 0xd1, 0xb1, 0x31, 0xef, 0xf0,
-	0x2e, 0x8d, 0xa4, 0x3c, 0x0a, 0x17, 0x0b, 0xc5, 0x69, 0x59, 0xf5, 0x1e, 0x5b, 0x9a, 0x8a, 0x3e,
-	0x82, 0x29, 0x9b, 0xd4, 0x70, 0x6b, 0xfb, 0x9e, 0xe5, 0x63, 0x33, 0xfb, 0xd6, 0xd9, 0x65, 0x89,
-	0x83, 0x05, 0x42, 0x59, 0x46, 0xbd, 0x79, 0x23, 0x53, 0xc8, 0xec, 0xf7, 0x8d, 0xfd, 0x56, 0xe4,
-	0x04, 0x6b, 0xfb, 0xfc, 0x06, 0x51, 0x31, 0x6b, 0xf6, 0xd7, 0x55, 0x26, 0x36, 0xfb, 0x29, 0x49,
-	0x75, 0xf6, 0x53, 0x44, 0x74, 0x99, 0xc6, 0x79, 0x36, 0x25, 0xec, 0xf6, 0xd9, 0xc2, 0xc8, 0x68,
-	0xb1, 0xd9, 0x60, 0xcd, 0x16, 0xfe, 0xa4, 0x28, 0x8d, 0x35, 0xf0, 0x39, 0xa0, 0xaf, 0xdd, 0xc2,
-	0x61, 0xe4, 0x3b, 0xd8, 0xe4, 0x17, 0xcf, 0x46, 0xe7, 0x40, 0xe1, 0x8a, 0xe7, 0x40, 0x41, 0x47,
-	0xe6, 0x40, 0xa1, 0x92, 0x35, 0xe5, 0xb9, 0xe6, 0x35, 0xb6, 0x65, 0xc2, 0xf8, 0x3a, 0xda, 0x93,
-	0x23, 0xa6, 0x12, 0x16, 0xb6, 0xa6, 0x14, 0x29, 0x75, 0x4d, 0x29, 0x24, 0x7e, 0x03, 0x4a, 0xbe,
-	0x2f, 0xc3, 0x46, 0xaa, 0x32, 0xe6, 0x06, 0xd4, 0x08, 0x67, 0x7c, 0x03, 0x6a, 0x84, 0x32, 0x72,
-	0x03, 0x6a, 0x84, 0x83, 0x58, 0xef, 0x19, 0x4e, 0xef, 0x92, 0xdb, 0x51, 0x57, 0xf5, 0x54, 0x96,
-	0xf5, 0xf3, 0x19, 0x9c, 0xcc, 0x7a, 0x96, 0x0e, 0xd5, 0x7a, 0x16, 0x47, 0xb3, 0x24, 0x9a, 0x32,
-	0xfa, 0x17, 0x1a, 0xcc, 0xa6, 0xe2, 0x0c, 0x7a, 0x1b, 0xe2, 0x7b, 0x1e, 0xd7, 0x0e, 0x3c, 0x91,
-	0x26, 0x2b, 0xf7, 0x42, 0x08, 0x9e, 0x75, 0x2f, 0x84, 0xe0, 0xe8, 0x32, 0x40, 0xfc, 0x4d, 0xba,
-	0x5d, 0x90, 0xa6, 0x39, 0x5a, 0xc2, 0x29, 0xe7, 0x68, 0x09, 0xaa, 0x7f, 0x9d, 0x87, 0x92, 0x58,
-	0xa8, 0x0f, 0xa5, 0x8c, 0x5a, 0x81, 0x62, 0x1f, 0x07, 0xf4, 0x7e, 0x48, 0x2e, 0xc9, 0x86, 0x38,
-	0x24, 0x67, 0x43, 0x1c, 0x52, 0x93, 0xb5, 0xfc, 0x3d, 0x25, 0x6b, 0x13, 0x87, 0x4e, 0xd6, 0x30,
-	0x3d, 0x1b, 0x96, 0xc2, 0xad, 0x38, 0x8d, 0xb9, 0x7d, 0x0c, 0x17, 0x27, 0xc7, 0xb2, 0x60, 0xea,
-	0xe4, 0x58, 0x26, 0xa1, 0x5d, 0x38, 0x2a, 0x9d, 0x18, 0xf1, 0x8e, 0x1d, 0x09, 0x7c, 0x33, 0xe3,
-	0x0f, 0xe2, 0x5b, 0x94, 0x8b, 0x6d, 0xef, 0xdd, 0x14, 0x2a, 0x67, 0xbb, 0x69, 0x9a, 0xfe, 0x87,
-	0x1c, 0xcc, 0xa8, 0xfe, 0x3e, 0x94, 0x89, 0x7d, 0x19, 0xca, 0x78, 0xdf, 0x0a, 0xdb, 0x5d, 0xd7,
-	0xc4, 0xbc, 0x64, 0xa4, 0xf3, 0x44, 0xc0, 0xb3, 0xae, 0xa9, 0xcc, 0x93, 0xc0, 0xe4, 0xd5, 0x90,
-	0x3f, 0xd4, 0x6a, 0x48, 0x1a, 0x9c, 0x13, 0x77, 0x6e, 0x70, 0x66, 0x8f, 0x73, 0xf9, 0x21, 0x8d,
-	0xf3, 0xad, 0x1c, 0x54, 0xd3, 0xd1, 0xf8, 0xbb, 0xb1, 0x85, 0xd4, 0xdd, 0x90, 0x3f, 0xf4, 0x6e,
-	0x78, 0x07, 0xa6, 0x49, 0xee, 0x68, 0x84, 0x21, 0xbf, 0x39, 0x39, 0x41, 0x73, 0x2e, 0x16, 0x9b,
-	0x22, 0x67, 0x55, 0xe0, 0x4a, 0x6c, 0x92, 0x70, 0xfd, 0xbf, 0x73, 0x30, 0xad, 0x7c, 0x35, 0x1e,
-	0xbf, 0x90, 0xa2, 0xcf, 0xc2, 0xb4, 0x92, 0x8c, 0xe9, 0xff, 0xcb, 0xd6, 0x89, 0x9a, 0x05, 0x3d,
-	0x7e, 0xe3, 0x32, 0x03, 0x53, 0x72, 0x56, 0xa7, 0x37, 0x61, 0x36, 0x95, 0x84, 0xc9, 0x2f, 0xa0,
-	0x1d, 0xe6, 0x05, 0xf4, 0x05, 0x98, 0xcb, 0xca, 0x1d, 0xf4, 0xf3, 0x30, 0x97, 0xf5, 0x55, 0xbf,
-	0x7b, 0x03, 0x5f, 0x6a, 0xd4, 0xc2, 0xe8, 0x1d, 0xeb, 0x0b, 0x00, 0x0e, 0xbe, 0xd9, 0xbe, 0x63,
-	0xf9, 0xc7, 0xc6, 0x13, 0xdf, 0xbc, 0x94, 0xaa, 0x96, 0x4a, 0x02, 0x23, 0x9a, 0x5c, 0xdb, 0x6c,
-	0xdf, 0xb1, 0xe8, 0xa2, 0x9a, 0x5c, 0xdb, 0x1c, 0xd1, 0x24, 0x30, 0xfd, 0xff, 0xf3, 0xa2, 0x32,
-	0x4f, 0x2e, 0x29, 0x7f, 0x08, 0x55, 0x4f, 0x3c, 0xdc, 0xd9, 0x5b, 0x5a, 0x9b, 0xc4, 0xfc, 0x69,
-	0x4b, 0x33, 0x2a, 0x45, 0xd5, 0xcd, 0x8b, 0xce, 0xdc, 0x21, 0x75, 0xb7, 0x52, 0xd5, 0xe7, 0x8c,
-	0x4a, 0x41, 0xff, 0x09, 0x47, 0xc5, 0x1d, 0xae, 0x3d, 0x2c, 0x1c, 0xcf, 0x8f, 0x55, 0xce, 0xee,
-	0x54, 0xc7, 0x02, 0x69, 0xcf, 0x67, 0x53, 0xa4, 0x94, 0x7a, 0xee, 0xfb, 0xc4, 0x61, 0xd5, 0xa7,
-	0x9d, 0x9f, 0x4d, 0x91, 0xf4, 0xcf, 0x35, 0x98, 0x4d, 0x5d, 0xfb, 0x46, 0xe7, 0xa0, 0x44, 0x7f,
-	0x15, 0x76, 0xfb, 0x19, 0xa0, 0x0b, 0x92, 0xf2, 0x29, 0x16, 0x8a, 0x1c, 0x42, 0xaf, 0x42, 0x39,
-	0xbe, 0x1d, 0xce, 0xcf, 0x72, 0xd9, 0xe6, 0x13, 0xa0, 0xb2, 0xf9, 0x04, 0xa8, 0xff, 0x58, 0x83,
-	0xe3, 0x63, 0xaf, 0x84, 0x3f, 0xea, 0x9e, 0xc1, 0x73, 0x2f, 0x42, 0x49, 0x9c, 0xb6, 0x22, 0x80,
-	0xc2, 0xfb, 0x5b, 0x6b, 0x5b, 0x6b, 0xe7, 0xaa, 0x47, 0x50, 0x05, 0x8a, 0x1b, 0x6b, 0x57, 0xce,
-	0x5d, 0xbc, 0x72, 0xbe, 0xaa, 0x91, 0x87, 0xd6, 0xd6, 0x95, 0x2b, 0xe4, 0x21, 0xf7, 0xdc, 0x65,
-	0xf9, 0xee, 0x17, 0xfb, 0x1e, 0xa3, 0x29, 0x28, 0xad, 0x7a, 0x1e, 0x0d, 0x00, 0x4c, 0x76, 0x6d,
-	0xcf, 0x22, 0x7b, 0xb5, 0xaa, 0xa1, 0x22, 0xe4, 0xaf, 0x5e, 0x5d, 0xaf, 0xe6, 0xd0, 0x1c, 0x54,
-	0xcf, 0x61, 0xc3, 0xb4, 0x2d, 0x07, 0x8b, 0xa8, 0x53, 0xcd, 0x37, 0x6f, 0x7c, 0xf5, 0xcd, 0x92,
-	0xf6, 0xf5, 0x37, 0x4b, 0xda, 0xef, 0xbf, 0x59, 0xd2, 0x6e, 0x7d, 0xbb, 0x74, 0xe4, 0xeb, 0x6f,
-	0x97, 0x8e, 0xfc, 0xf6, 0xdb, 0xa5, 0x23, 0x1f, 0xbe, 0x28, 0xfd, 0x02, 0x92, 0xbd, 0x93, 0xe7,
-	0xbb, 0x24, 0xe0, 0xf2, 0xa7, 0x95, 0xf4, 0x6f, 0x42, 0xbf, 0xcc, 0x9d, 0x5c, 0xa5, 0x8f, 0x1b,
-	0x8c, 0xaf, 0x71, 0xd1, 0x6d, 0x30, 0x80, 0xfe, 0x6c, 0x2f, 0xe8, 0x14, 0xe8, 0xcf, 0xf3, 0x5e,
-	0xfe, 0x7b, 0x00, 0x00, 0x00, 0xff, 0xff, 0x65, 0xc1, 0xed, 0xaa, 0x4e, 0x3a, 0x00, 0x00,
+// Indicates one or more of the containers in the pod failed.
+type PodError struct {
+	// This ObjectMeta identifies the Pod.
+	ObjectMeta       *ObjectMeta       `protobuf:"bytes,1,opt,name=objectMeta,proto3" json:"objectMeta,omitempty"`
+	Message          string            `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	NodeName         string            `protobuf:"bytes,3,opt,name=node_name,json=nodeName,proto3" json:"nodeName,omitempty"`
+	PodNumber        int32             `protobuf:"varint,4,opt,name=pod_number,json=podNumber,proto3" json:"podNumber,omitempty"`
+	ContainerErrors  []*ContainerError `protobuf:"bytes,5,rep,name=containerErrors,proto3" json:"containerErrors,omitempty"`
+	KubernetesReason KubernetesReason  `protobuf:"varint,6,opt,name=kubernetes_reason,json=kubernetesReason,proto3,enum=armadaevents.KubernetesReason" json:"kubernetesReason,omitempty"`
 }
 
-func (m *EventSequence) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *PodError) Reset()         { *m = PodError{} }
+func (m *PodError) String() string { return proto.CompactTextString(m) }
+func (*PodError) ProtoMessage()    {}
+func (*PodError) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aab92ca59e015f8, []int{40}
 }
-
-func (m *EventSequence) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PodError) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *EventSequence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Events) > 0 {
-		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Events[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEvents(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.Groups) > 0 {
-		for iNdEx := len(m.Groups) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Groups[iNdEx])
-			copy(dAtA[i:], m.Groups[iNdEx])
-			i = encodeVarintEvents(dAtA, i, uint64(len(m.Groups[iNdEx])))
-			i--
-			dAtA[i] = 0x22
+func (m *PodError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PodError.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.UserId) > 0 {
-		i -= len(m.UserId)
-		copy(dAtA[i:], m.UserId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.UserId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.JobSetName) > 0 {
-		i -= len(m.JobSetName)
-		copy(dAtA[i:], m.JobSetName)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.JobSetName)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Queue) > 0 {
-		i -= len(m.Queue)
-		copy(dAtA[i:], m.Queue)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Queue)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *PodError) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PodError.Merge(m, src)
+}
+func (m *PodError) XXX_Size() int {
+	return m.Size()
+}
+func (m *PodError) XXX_DiscardUnknown() {
+	xxx_messageInfo_PodError.DiscardUnknown(m)
 }
 
-func (m *EventSequence_Event) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PodError proto.InternalMessageInfo
+
+func (m *PodError) GetObjectMeta() *ObjectMeta {
+	if m != nil {
+		return m.ObjectMeta
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *EventSequence_Event) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PodError) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
 }
 
-func (m *EventSequence_Event) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Event != nil {
-		{
-			size := m.Event.Size()
-			i -= size
-			if _, err := m.Event.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
-	}
-	if m.Created != nil {
-		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Created):])
-		if err1 != nil {
-			return 0, err1
-		}
-		i -= n1
-		i = encodeVarintEvents(dAtA, i, uint64(n1))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x92
+func (m *PodError) GetNodeName() string {
+	if m != nil {
+		return m.NodeName
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventSequence_Event_SubmitJob) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PodError) GetPodNumber() int32 {
+	if m != nil {
+		return m.PodNumber
+	}
+	return 0
 }
 
-func (m *EventSequence_Event_SubmitJob) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.SubmitJob != nil {
-		{
-			size, err := m.SubmitJob.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *PodError) GetContainerErrors() []*ContainerError {
+	if m != nil {
+		return m.ContainerErrors
 	}
-	return len(dAtA) - i, nil
-}
-func (m *EventSequence_Event_ReprioritiseJob) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return nil
 }
 
-func (m *EventSequence_Event_ReprioritiseJob) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.ReprioritiseJob != nil {
-		{
-			size, err := m.ReprioritiseJob.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (m *PodError) GetKubernetesReason() KubernetesReason {
+	if m != nil {
+		return m.KubernetesReason
 	}
-	return len(dAtA) - i, nil
-}
-func (m *EventSequence_Event_ReprioritiseJobSet) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return KubernetesReason_AppError
 }
 
-func (m *EventSequence_Event_ReprioritiseJobSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.ReprioritiseJobSet != nil {
-		{
-			size, err := m.ReprioritiseJobSet.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	return len(dAtA) - i, nil
-}
-func (m *EventSequence_Event_ReprioritisedJob) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ContainerError struct {
+	// this ObjectMeta identifies the container
+	ObjectMeta *ObjectMeta `protobuf:"bytes,1,opt,name=objectMeta,proto3" json:"objectMeta,omitempty"`
+	// Exit code of the application running in the container.
+	ExitCode int32 `protobuf:"varint,2,opt,name=exit_code,json=exitCode,proto3" json:"exitCode,omitempty"`
+	// Corresponds to v1.ContainerStateTerminated.Message
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	// Corresponds to v1.ContainerStateTerminated.Reason
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Reason reported by Kubernetes.
+	KubernetesReason KubernetesReason `protobuf:"varint,9,opt,name=kubernetes_reason,json=kubernetesReason,proto3,enum=armadaevents.KubernetesReason" json:"kubernetesReason,omitempty"`
 }
 
-func (m *EventSequence_Event_ReprioritisedJob) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.ReprioritisedJob != nil {
-		{
-			size, err := m.ReprioritisedJob.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	return len(dAtA) - i, nil
+func (m *ContainerError) Reset()         { *m = ContainerError{} }
+func (m *ContainerError) String() string { return proto.CompactTextString(m) }
+func (*ContainerError) ProtoMessage()    {}
+func (*ContainerError) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aab92ca59e015f8, []int{41}
 }
-func (m *EventSequence_Event_CancelJob) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ContainerError) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *EventSequence_Event_CancelJob) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.CancelJob != nil {
-		{
-			size, err := m.CancelJob.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
+func (m *ContainerError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContainerError.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x2a
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-func (m *EventSequence_Event_CancelJobSet) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ContainerError) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContainerError.Merge(m, src)
 }
-
-func (m *EventSequence_Event_CancelJobSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.CancelJobSet != nil {
-		{
-			size, err := m.CancelJobSet.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
-	}
-	return len(dAtA) - i, nil
+func (m *ContainerError) XXX_Size() int {
+	return m.Size()
 }
-func (m *EventSequence_Event_CancelledJob) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ContainerError) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContainerError.DiscardUnknown(m)
 }
 
-func (m *EventSequence_Event_CancelledJob) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.CancelledJob != nil {
-		{
-			size, err := m.CancelledJob.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
+var xxx_messageInfo_ContainerError proto.InternalMessageInfo
+
+func (m *ContainerError) GetObjectMeta() *ObjectMeta {
+	if m != nil {
+		return m.ObjectMeta
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
-func (m *EventSequence_Event_JobSucceeded) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+func (m *ContainerError) GetExitCode() int32 {
+	if m != nil {
+		return m.ExitCode
+	}
+	return 0
 }
 
-func (m *EventSequence_Event_JobSucceeded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.JobSucceeded != nil {
-		{
-			size, err := m.JobSucceeded.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x42
+func (m *ContainerError) GetMessage() string {
+	if m != nil {
+		return m.Message
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
-func (m *EventSequence_Event_JobErrors) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+func (m *ContainerError) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
 }
 
-func (m *EventSequence_Event_JobErrors) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.JobErrors != nil {
-		{
-			size, err := m.JobErrors.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x4a
+func (m *ContainerError) GetKubernetesReason() KubernetesReason {
+	if m != nil {
+		return m.KubernetesReason
 	}
-	return len(dAtA) - i, nil
+	return KubernetesReason_AppError
 }
-func (m *EventSequence_Event_JobRunLeased) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+// Indicates that the pod could not be scheduled for some reason.
+type PodLeaseReturned struct {
+	ObjectMeta   *ObjectMeta `protobuf:"bytes,1,opt,name=objectMeta,proto3" json:"objectMeta,omitempty"`
+	Message      string      `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	PodNumber    int32       `protobuf:"varint,3,opt,name=pod_number,json=podNumber,proto3" json:"podNumber,omitempty"`
+	RunAttempted bool        `protobuf:"varint,4,opt,name=run_attempted,json=runAttempted,proto3" json:"runAttempted,omitempty"`
 }
 
-func (m *EventSequence_Event_JobRunLeased) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.JobRunLeased != nil {
-		{
-			size, err := m.JobRunLeased.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
+func (m *PodLeaseReturned) Reset()         { *m = PodLeaseReturned{} }
+func (m *PodLeaseReturned) String() string { return proto.CompactTextString(m) }
+func (*PodLeaseReturned) ProtoMessage()    {}
+func (*PodLeaseReturned) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aab92ca59e015f8, []int{42}
+}
+func (m *PodLeaseReturned) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PodLeaseReturned) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PodLeaseReturned.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x52
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-func (m *EventSequence_Event_JobRunAssigned) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PodLeaseReturned) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PodLeaseReturned.Merge(m, src)
+}
+func (m *PodLeaseReturned) XXX_Size() int {
+	return m.Size()
+}
+func (m *PodLeaseReturned) XXX_DiscardUnknown() {
+	xxx_messageInfo_PodLeaseReturned.DiscardUnknown(m)
 }
 
-func (m *EventSequence_Event_JobRunAssigned) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.JobRunAssigned != nil {
-		{
-			size, err := m.JobRunAssigned.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x5a
+var xxx_messageInfo_PodLeaseReturned proto.InternalMessageInfo
+
+func (m *PodLeaseReturned) GetObjectMeta() *ObjectMeta {
+	if m != nil {
+		return m.ObjectMeta
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
-func (m *EventSequence_Event_JobRunRunning) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+func (m *PodLeaseReturned) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
 }
 
-func (m *EventSequence_Event_JobRunRunning) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.JobRunRunning != nil {
-		{
-			size, err := m.JobRunRunning.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x62
+func (m *PodLeaseReturned) GetPodNumber() int32 {
+	if m != nil {
+		return m.PodNumber
 	}
-	return len(dAtA) - i, nil
-}
-func (m *EventSequence_Event_JobRunSucceeded) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return 0
 }
 
-func (m *EventSequence_Event_JobRunSucceeded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.JobRunSucceeded != nil {
-		{
-			size, err := m.JobRunSucceeded.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x6a
+func (m *PodLeaseReturned) GetRunAttempted() bool {
+	if m != nil {
+		return m.RunAttempted
 	}
-	return len(dAtA) - i, nil
+	return false
 }
-func (m *EventSequence_Event_JobRunErrors) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+// Indicates that the lease on the job that the pod was part of could not be renewed.
+// If this happens, the executor deletes the pod and generates a JobRunError with this message as the reason.
+type PodTerminated struct {
+	// This ObjectMeta identifies the Pod.
+	ObjectMeta *ObjectMeta `protobuf:"bytes,1,opt,name=objectMeta,proto3" json:"objectMeta,omitempty"`
+	Message    string      `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	NodeName   string      `protobuf:"bytes,3,opt,name=node_name,json=nodeName,proto3" json:"nodeName,omitempty"`
+	PodNumber  int32       `protobuf:"varint,4,opt,name=pod_number,json=podNumber,proto3" json:"podNumber,omitempty"`
 }
 
-func (m *EventSequence_Event_JobRunErrors) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.JobRunErrors != nil {
-		{
-			size, err := m.JobRunErrors.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x72
-	}
-	return len(dAtA) - i, nil
+func (m *PodTerminated) Reset()         { *m = PodTerminated{} }
+func (m *PodTerminated) String() string { return proto.CompactTextString(m) }
+func (*PodTerminated) ProtoMessage()    {}
+func (*PodTerminated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aab92ca59e015f8, []int{43}
 }
-func (m *EventSequence_Event_JobDuplicateDetected) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PodTerminated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *EventSequence_Event_JobDuplicateDetected) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.JobDuplicateDetected != nil {
-		{
-			size, err := m.JobDuplicateDetected.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
+func (m *PodTerminated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PodTerminated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x7a
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-func (m *EventSequence_Event_StandaloneIngressInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PodTerminated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PodTerminated.Merge(m, src)
 }
-
-func (m *EventSequence_Event_StandaloneIngressInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.StandaloneIngressInfo != nil {
-		{
-			size, err := m.StandaloneIngressInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x82
-	}
-	return len(dAtA) - i, nil
+func (m *PodTerminated) XXX_Size() int {
+	return m.Size()
 }
-func (m *EventSequence_Event_ResourceUtilisation) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PodTerminated) XXX_DiscardUnknown() {
+	xxx_messageInfo_PodTerminated.DiscardUnknown(m)
 }
 
-func (m *EventSequence_Event_ResourceUtilisation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.ResourceUtilisation != nil {
-		{
-			size, err := m.ResourceUtilisation.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x8a
+var xxx_messageInfo_PodTerminated proto.InternalMessageInfo
+
+func (m *PodTerminated) GetObjectMeta() *ObjectMeta {
+	if m != nil {
+		return m.ObjectMeta
 	}
-	return len(dAtA) - i, nil
-}
-func (m *EventSequence_Event_JobRunPreempted) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return nil
 }
 
-func (m *EventSequence_Event_JobRunPreempted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.JobRunPreempted != nil {
-		{
-			size, err := m.JobRunPreempted.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x9a
+func (m *PodTerminated) GetMessage() string {
+	if m != nil {
+		return m.Message
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
-func (m *EventSequence_Event_PartitionMarker) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+func (m *PodTerminated) GetNodeName() string {
+	if m != nil {
+		return m.NodeName
+	}
+	return ""
 }
 
-func (m *EventSequence_Event_PartitionMarker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.PartitionMarker != nil {
-		{
-			size, err := m.PartitionMarker.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xa2
+func (m *PodTerminated) GetPodNumber() int32 {
+	if m != nil {
+		return m.PodNumber
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
-func (m *EventSequence_Event_JobRunPreemptionRequested) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+type ExecutorError struct {
 }
 
-func (m *EventSequence_Event_JobRunPreemptionRequested) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.JobRunPreemptionRequested != nil {
-		{
-			size, err := m.JobRunPreemptionRequested.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
+func (m *ExecutorError) Reset()         { *m = ExecutorError{} }
+func (m *ExecutorError) String() string { return proto.CompactTextString(m) }
+func (*ExecutorError) ProtoMessage()    {}
+func (*ExecutorError) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aab92ca59e015f8, []int{44}
+}
+func (m *ExecutorError) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExecutorError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExecutorError.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xaa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-func (m *EventSequence_Event_JobRequeued) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ExecutorError) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExecutorError.Merge(m, src)
+}
+func (m *ExecutorError) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExecutorError) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExecutorError.DiscardUnknown(m)
 }
 
-func (m *EventSequence_Event_JobRequeued) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.JobRequeued != nil {
-		{
-			size, err := m.JobRequeued.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xb2
-	}
-	return len(dAtA) - i, nil
-}
-func (m *ResourceUtilisation) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_ExecutorError proto.InternalMessageInfo
 
-func (m *ResourceUtilisation) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type PodUnschedulable struct {
+	// this ObjectMeta identifies the Pod
+	ObjectMeta *ObjectMeta `protobuf:"bytes,1,opt,name=objectMeta,proto3" json:"objectMeta,omitempty"`
+	Message    string      `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	NodeName   string      `protobuf:"bytes,3,opt,name=node_name,json=nodeName,proto3" json:"nodeName,omitempty"`
+	PodNumber  int32       `protobuf:"varint,4,opt,name=pod_number,json=podNumber,proto3" json:"podNumber,omitempty"`
 }
 
-func (m *ResourceUtilisation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TotalCumulativeUsage) > 0 {
-		for k := range m.TotalCumulativeUsage {
-			v := m.TotalCumulativeUsage[k]
-			baseI := i
-			{
-				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEvents(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintEvents(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintEvents(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.MaxResourcesForPeriod) > 0 {
-		for k := range m.MaxResourcesForPeriod {
-			v := m.MaxResourcesForPeriod[k]
-			baseI := i
-			{
-				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEvents(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintEvents(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintEvents(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if m.ResourceInfo != nil {
-		{
-			size, err := m.ResourceInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.JobId != nil {
-		{
-			size, err := m.JobId.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.RunId != nil {
-		{
-			size, err := m.RunId.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
+func (m *PodUnschedulable) Reset()         { *m = PodUnschedulable{} }
+func (m *PodUnschedulable) String() string { return proto.CompactTextString(m) }
+func (*PodUnschedulable) ProtoMessage()    {}
+func (*PodUnschedulable) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aab92ca59e015f8, []int{45}
+}
+func (m *PodUnschedulable) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PodUnschedulable) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PodUnschedulable.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *PodUnschedulable) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PodUnschedulable.Merge(m, src)
+}
+func (m *PodUnschedulable) XXX_Size() int {
+	return m.Size()
+}
+func (m *PodUnschedulable) XXX_DiscardUnknown() {
+	xxx_messageInfo_PodUnschedulable.DiscardUnknown(m)
 }
 
-func (m *Uuid) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PodUnschedulable proto.InternalMessageInfo
+
+func (m *PodUnschedulable) GetObjectMeta() *ObjectMeta {
+	if m != nil {
+		return m.ObjectMeta
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Uuid) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PodUnschedulable) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
 }
 
-func (m *Uuid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Low64 != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.Low64))
-		i--
-		dAtA[i] = 0x11
-	}
-	if m.High64 != 0 {
-		i -= 8
-		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.High64))
-		i--
-		dAtA[i] = 0x9
+func (m *PodUnschedulable) GetNodeName() string {
+	if m != nil {
+		return m.NodeName
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *SubmitJob) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PodUnschedulable) GetPodNumber() int32 {
+	if m != nil {
+		return m.PodNumber
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *SubmitJob) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type LeaseExpired struct {
 }
 
-func (m *SubmitJob) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.QueueTtlSeconds != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.QueueTtlSeconds))
-		i--
-		dAtA[i] = 0x68
-	}
-	if m.IsDuplicate {
-		i--
-		if m.IsDuplicate {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x60
-	}
-	if len(m.Scheduler) > 0 {
-		i -= len(m.Scheduler)
-		copy(dAtA[i:], m.Scheduler)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Scheduler)))
-		i--
-		dAtA[i] = 0x5a
-	}
-	if m.ConcurrencySafe {
-		i--
-		if m.ConcurrencySafe {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *LeaseExpired) Reset()         { *m = LeaseExpired{} }
+func (m *LeaseExpired) String() string { return proto.CompactTextString(m) }
+func (*LeaseExpired) ProtoMessage()    {}
+func (*LeaseExpired) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aab92ca59e015f8, []int{46}
+}
+func (m *LeaseExpired) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LeaseExpired) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LeaseExpired.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x50
+		return b[:n], nil
 	}
-	if m.Preemptible {
-		i--
-		if m.Preemptible {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x48
-	}
-	if m.AtMostOnce {
-		i--
-		if m.AtMostOnce {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x40
-	}
-	if m.Lifetime != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Lifetime))
-		i--
-		dAtA[i] = 0x38
-	}
-	if len(m.Objects) > 0 {
-		for iNdEx := len(m.Objects) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Objects[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEvents(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x32
-		}
-	}
-	if m.MainObject != nil {
-		{
-			size, err := m.MainObject.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.ObjectMeta != nil {
-		{
-			size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Priority != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Priority))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.DeduplicationId) > 0 {
-		i -= len(m.DeduplicationId)
-		copy(dAtA[i:], m.DeduplicationId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.DeduplicationId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.JobId != nil {
-		{
-			size, err := m.JobId.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *KubernetesMainObject) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *LeaseExpired) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LeaseExpired.Merge(m, src)
+}
+func (m *LeaseExpired) XXX_Size() int {
+	return m.Size()
+}
+func (m *LeaseExpired) XXX_DiscardUnknown() {
+	xxx_messageInfo_LeaseExpired.DiscardUnknown(m)
 }
 
-func (m *KubernetesMainObject) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_LeaseExpired proto.InternalMessageInfo
+
+type MaxRunsExceeded struct {
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (m *KubernetesMainObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Object != nil {
-		{
-			size := m.Object.Size()
-			i -= size
-			if _, err := m.Object.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
+func (m *MaxRunsExceeded) Reset()         { *m = MaxRunsExceeded{} }
+func (m *MaxRunsExceeded) String() string { return proto.CompactTextString(m) }
+func (*MaxRunsExceeded) ProtoMessage()    {}
+func (*MaxRunsExceeded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aab92ca59e015f8, []int{47}
+}
+func (m *MaxRunsExceeded) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MaxRunsExceeded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MaxRunsExceeded.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.ObjectMeta != nil {
-		{
-			size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MaxRunsExceeded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MaxRunsExceeded.Merge(m, src)
+}
+func (m *MaxRunsExceeded) XXX_Size() int {
+	return m.Size()
+}
+func (m *MaxRunsExceeded) XXX_DiscardUnknown() {
+	xxx_messageInfo_MaxRunsExceeded.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MaxRunsExceeded proto.InternalMessageInfo
+
+func (m *MaxRunsExceeded) GetMessage() string {
+	if m != nil {
+		return m.Message
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *KubernetesMainObject_PodSpec) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type JobRunPreemptedError struct {
 }
 
-func (m *KubernetesMainObject_PodSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.PodSpec != nil {
-		{
-			size, err := m.PodSpec.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
+func (m *JobRunPreemptedError) Reset()         { *m = JobRunPreemptedError{} }
+func (m *JobRunPreemptedError) String() string { return proto.CompactTextString(m) }
+func (*JobRunPreemptedError) ProtoMessage()    {}
+func (*JobRunPreemptedError) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aab92ca59e015f8, []int{48}
+}
+func (m *JobRunPreemptedError) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobRunPreemptedError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobRunPreemptedError.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-func (m *KubernetesObject) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *JobRunPreemptedError) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobRunPreemptedError.Merge(m, src)
+}
+func (m *JobRunPreemptedError) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobRunPreemptedError) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobRunPreemptedError.DiscardUnknown(m)
 }
 
-func (m *KubernetesObject) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_JobRunPreemptedError proto.InternalMessageInfo
+
+type GangJobUnschedulable struct {
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (m *KubernetesObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Object != nil {
-		{
-			size := m.Object.Size()
-			i -= size
-			if _, err := m.Object.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
+func (m *GangJobUnschedulable) Reset()         { *m = GangJobUnschedulable{} }
+func (m *GangJobUnschedulable) String() string { return proto.CompactTextString(m) }
+func (*GangJobUnschedulable) ProtoMessage()    {}
+func (*GangJobUnschedulable) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aab92ca59e015f8, []int{49}
+}
+func (m *GangJobUnschedulable) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GangJobUnschedulable) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GangJobUnschedulable.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.ObjectMeta != nil {
-		{
-			size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+}
+func (m *GangJobUnschedulable) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GangJobUnschedulable.Merge(m, src)
+}
+func (m *GangJobUnschedulable) XXX_Size() int {
+	return m.Size()
+}
+func (m *GangJobUnschedulable) XXX_DiscardUnknown() {
+	xxx_messageInfo_GangJobUnschedulable.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GangJobUnschedulable proto.InternalMessageInfo
+
+func (m *GangJobUnschedulable) GetMessage() string {
+	if m != nil {
+		return m.Message
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *KubernetesObject_PodSpec) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Generated by the scheduler whenever it detects a SubmitJob message that includes a previously used deduplication id
+// (i.e., when it detects a duplicate job submission).
+type JobDuplicateDetected struct {
+	NewJobId *Uuid `protobuf:"bytes,1,opt,name=new_job_id,json=newJobId,proto3" json:"newJobId,omitempty"`
+	OldJobId *Uuid `protobuf:"bytes,2,opt,name=old_job_id,json=oldJobId,proto3" json:"oldJobId,omitempty"`
 }
 
-func (m *KubernetesObject_PodSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.PodSpec != nil {
-		{
-			size, err := m.PodSpec.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
+func (m *JobDuplicateDetected) Reset()         { *m = JobDuplicateDetected{} }
+func (m *JobDuplicateDetected) String() string { return proto.CompactTextString(m) }
+func (*JobDuplicateDetected) ProtoMessage()    {}
+func (*JobDuplicateDetected) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aab92ca59e015f8, []int{50}
+}
+func (m *JobDuplicateDetected) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobDuplicateDetected) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobDuplicateDetected.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-func (m *KubernetesObject_Ingress) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *JobDuplicateDetected) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobDuplicateDetected.Merge(m, src)
+}
+func (m *JobDuplicateDetected) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobDuplicateDetected) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobDuplicateDetected.DiscardUnknown(m)
 }
 
-func (m *KubernetesObject_Ingress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Ingress != nil {
-		{
-			size, err := m.Ingress.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+var xxx_messageInfo_JobDuplicateDetected proto.InternalMessageInfo
+
+func (m *JobDuplicateDetected) GetNewJobId() *Uuid {
+	if m != nil {
+		return m.NewJobId
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
-func (m *KubernetesObject_Service) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+
+func (m *JobDuplicateDetected) GetOldJobId() *Uuid {
+	if m != nil {
+		return m.OldJobId
+	}
+	return nil
 }
 
-func (m *KubernetesObject_Service) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Service != nil {
-		{
-			size, err := m.Service.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
+// Message to indicate that a JobRun has been preempted.
+type JobRunPreempted struct {
+	// Uuid of the job that was preempted
+	PreemptedJobId *Uuid `protobuf:"bytes,1,opt,name=preempted_job_id,json=preemptedJobId,proto3" json:"preemptedJobId,omitempty"`
+	// Uuid of the job run that was preempted.
+	PreemptedRunId *Uuid `protobuf:"bytes,2,opt,name=preempted_run_id,json=preemptedRunId,proto3" json:"preemptedRunId,omitempty"`
+	// Uuid of the job that caused the preemption.
+	PreemptiveJobId *Uuid `protobuf:"bytes,3,opt,name=preemptive_job_id,json=preemptiveJobId,proto3" json:"preemptiveJobId,omitempty"`
+	// Uuid of the job run that caused the preemption.
+	PreemptiveRunId *Uuid `protobuf:"bytes,4,opt,name=preemptive_run_id,json=preemptiveRunId,proto3" json:"preemptiveRunId,omitempty"`
+}
+
+func (m *JobRunPreempted) Reset()         { *m = JobRunPreempted{} }
+func (m *JobRunPreempted) String() string { return proto.CompactTextString(m) }
+func (*JobRunPreempted) ProtoMessage()    {}
+func (*JobRunPreempted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aab92ca59e015f8, []int{51}
+}
+func (m *JobRunPreempted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobRunPreempted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobRunPreempted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x22
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-func (m *KubernetesObject_ConfigMap) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *JobRunPreempted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobRunPreempted.Merge(m, src)
+}
+func (m *JobRunPreempted) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobRunPreempted) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobRunPreempted.DiscardUnknown(m)
 }
 
-func (m *KubernetesObject_ConfigMap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.ConfigMap != nil {
-		{
-			size, err := m.ConfigMap.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
+var xxx_messageInfo_JobRunPreempted proto.InternalMessageInfo
+
+func (m *JobRunPreempted) GetPreemptedJobId() *Uuid {
+	if m != nil {
+		return m.PreemptedJobId
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
-func (m *ObjectMeta) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+
+func (m *JobRunPreempted) GetPreemptedRunId() *Uuid {
+	if m != nil {
+		return m.PreemptedRunId
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ObjectMeta) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *JobRunPreempted) GetPreemptiveJobId() *Uuid {
+	if m != nil {
+		return m.PreemptiveJobId
+	}
+	return nil
 }
 
-func (m *ObjectMeta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Labels) > 0 {
-		for k := range m.Labels {
-			v := m.Labels[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintEvents(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintEvents(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintEvents(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x32
+func (m *JobRunPreempted) GetPreemptiveRunId() *Uuid {
+	if m != nil {
+		return m.PreemptiveRunId
+	}
+	return nil
+}
+
+// Message used internally by Armada to see if messages can be propagated through a pulsar partition
+type PartitionMarker struct {
+	// group id ties together multiple messages across different partitions
+	GroupId *Uuid `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"groupId,omitempty"`
+	// The pulsar partition on which this message was sent
+	Partition uint32 `protobuf:"varint,2,opt,name=partition,proto3" json:"partition,omitempty"`
+}
+
+func (m *PartitionMarker) Reset()         { *m = PartitionMarker{} }
+func (m *PartitionMarker) String() string { return proto.CompactTextString(m) }
+func (*PartitionMarker) ProtoMessage()    {}
+func (*PartitionMarker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aab92ca59e015f8, []int{52}
+}
+func (m *PartitionMarker) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PartitionMarker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PartitionMarker.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Annotations) > 0 {
-		for k := range m.Annotations {
-			v := m.Annotations[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintEvents(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarintEvents(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarintEvents(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x2a
-		}
+}
+func (m *PartitionMarker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PartitionMarker.Merge(m, src)
+}
+func (m *PartitionMarker) XXX_Size() int {
+	return m.Size()
+}
+func (m *PartitionMarker) XXX_DiscardUnknown() {
+	xxx_messageInfo_PartitionMarker.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PartitionMarker proto.InternalMessageInfo
+
+func (m *PartitionMarker) GetGroupId() *Uuid {
+	if m != nil {
+		return m.GroupId
 	}
-	if len(m.KubernetesId) > 0 {
-		i -= len(m.KubernetesId)
-		copy(dAtA[i:], m.KubernetesId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.KubernetesId)))
-		i--
-		dAtA[i] = 0x22
+	return nil
+}
+
+func (m *PartitionMarker) GetPartition() uint32 {
+	if m != nil {
+		return m.Partition
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x1a
+	return 0
+}
+
+// Indicates that the scheduler has requested for the job run to be pre-empted.
+type JobRunPreemptionRequested struct {
+	RunId *Uuid `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"runId,omitempty"`
+	JobId *Uuid `protobuf:"bytes,2,opt,name=job_id,json=jobId,proto3" json:"jobId,omitempty"`
+}
+
+func (m *JobRunPreemptionRequested) Reset()         { *m = JobRunPreemptionRequested{} }
+func (m *JobRunPreemptionRequested) String() string { return proto.CompactTextString(m) }
+func (*JobRunPreemptionRequested) ProtoMessage()    {}
+func (*JobRunPreemptionRequested) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6aab92ca59e015f8, []int{53}
+}
+func (m *JobRunPreemptionRequested) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *JobRunPreemptionRequested) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JobRunPreemptionRequested.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Namespace) > 0 {
-		i -= len(m.Namespace)
-		copy(dAtA[i:], m.Namespace)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Namespace)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *JobRunPreemptionRequested) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobRunPreemptionRequested.Merge(m, src)
+}
+func (m *JobRunPreemptionRequested) XXX_Size() int {
+	return m.Size()
+}
+func (m *JobRunPreemptionRequested) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobRunPreemptionRequested.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JobRunPreemptionRequested proto.InternalMessageInfo
+
+func (m *JobRunPreemptionRequested) GetRunId() *Uuid {
+	if m != nil {
+		return m.RunId
 	}
-	if len(m.ExecutorId) > 0 {
-		i -= len(m.ExecutorId)
-		copy(dAtA[i:], m.ExecutorId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ExecutorId)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *JobRunPreemptionRequested) GetJobId() *Uuid {
+	if m != nil {
+		return m.JobId
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *PodSpecWithAvoidList) Marshal() (dAtA []byte, err error) {
+func init() {
+	proto.RegisterEnum("armadaevents.JobState", JobState_name, JobState_value)
+	proto.RegisterEnum("armadaevents.KubernetesReason", KubernetesReason_name, KubernetesReason_value)
+	proto.RegisterType((*EventSequence)(nil), "armadaevents.EventSequence")
+	proto.RegisterType((*EventSequence_Event)(nil), "armadaevents.EventSequence.Event")
+	proto.RegisterType((*ResourceUtilisation)(nil), "armadaevents.ResourceUtilisation")
+	proto.RegisterMapType((map[string]resource.Quantity)(nil), "armadaevents.ResourceUtilisation.MaxResourcesForPeriodEntry")
+	proto.RegisterMapType((map[string]resource.Quantity)(nil), "armadaevents.ResourceUtilisation.TotalCumulativeUsageEntry")
+	proto.RegisterType((*Uuid)(nil), "armadaevents.Uuid")
+	proto.RegisterType((*SubmitJob)(nil), "armadaevents.SubmitJob")
+	proto.RegisterType((*KubernetesMainObject)(nil), "armadaevents.KubernetesMainObject")
+	proto.RegisterType((*KubernetesObject)(nil), "armadaevents.KubernetesObject")
+	proto.RegisterType((*ObjectMeta)(nil), "armadaevents.ObjectMeta")
+	proto.RegisterMapType((map[string]string)(nil), "armadaevents.ObjectMeta.AnnotationsEntry")
+	proto.RegisterMapType((map[string]string)(nil), "armadaevents.ObjectMeta.LabelsEntry")
+	proto.RegisterType((*PodSpecWithAvoidList)(nil), "armadaevents.PodSpecWithAvoidList")
+	proto.RegisterType((*ReprioritiseJob)(nil), "armadaevents.ReprioritiseJob")
+	proto.RegisterType((*JobRequeued)(nil), "armadaevents.JobRequeued")
+	proto.RegisterType((*ReprioritiseJobSet)(nil), "armadaevents.ReprioritiseJobSet")
+	proto.RegisterType((*ReprioritisedJob)(nil), "armadaevents.ReprioritisedJob")
+	proto.RegisterType((*CancelJob)(nil), "armadaevents.CancelJob")
+	proto.RegisterType((*JobSetFilter)(nil), "armadaevents.JobSetFilter")
+	proto.RegisterType((*CancelJobSet)(nil), "armadaevents.CancelJobSet")
+	proto.RegisterType((*CancelledJob)(nil), "armadaevents.CancelledJob")
+	proto.RegisterType((*JobSetExpired)(nil), "armadaevents.JobSetExpired")
+	proto.RegisterType((*JobSuspended)(nil), "armadaevents.JobSuspended")
+	proto.RegisterType((*JobResumed)(nil), "armadaevents.JobResumed")
+	proto.RegisterType((*JobEvictedForCapacity)(nil), "armadaevents.JobEvictedForCapacity")
+	proto.RegisterType((*JobRuntimeExceeded)(nil), "armadaevents.JobRuntimeExceeded")
+	proto.RegisterType((*JobResourcesNormalized)(nil), "armadaevents.JobResourcesNormalized")
+	proto.RegisterMapType((map[string]v11.ResourceRequirements)(nil), "armadaevents.JobResourcesNormalized.NormalizedResourcesEntry")
+	proto.RegisterMapType((map[string]v11.ResourceRequirements)(nil), "armadaevents.JobResourcesNormalized.OriginalResourcesEntry")
+	proto.RegisterType((*JobUnschedulable)(nil), "armadaevents.JobUnschedulable")
+	proto.RegisterType((*JobMaintenanceWindowStarted)(nil), "armadaevents.JobMaintenanceWindowStarted")
+	proto.RegisterType((*JobMaintenanceWindowEnded)(nil), "armadaevents.JobMaintenanceWindowEnded")
+	proto.RegisterType((*JobCordoned)(nil), "armadaevents.JobCordoned")
+	proto.RegisterType((*JobUncordoned)(nil), "armadaevents.JobUncordoned")
+	proto.RegisterType((*JobSucceeded)(nil), "armadaevents.JobSucceeded")
+	proto.RegisterType((*JobRunLeased)(nil), "armadaevents.JobRunLeased")
+	proto.RegisterType((*JobRunAssigned)(nil), "armadaevents.JobRunAssigned")
+	proto.RegisterType((*JobRunRunning)(nil), "armadaevents.JobRunRunning")
+	proto.RegisterType((*KubernetesResourceInfo)(nil), "armadaevents.KubernetesResourceInfo")
+	proto.RegisterType((*PodInfo)(nil), "armadaevents.PodInfo")
+	proto.RegisterMapType((map[string]string)(nil), "armadaevents.PodInfo.NodeLabelsEntry")
+	proto.RegisterType((*IngressInfo)(nil), "armadaevents.IngressInfo")
+	proto.RegisterMapType((map[int32]string)(nil), "armadaevents.IngressInfo.IngressAddressesEntry")
+	proto.RegisterType((*StandaloneIngressInfo)(nil), "armadaevents.StandaloneIngressInfo")
+	proto.RegisterMapType((map[int32]string)(nil), "armadaevents.StandaloneIngressInfo.IngressAddressesEntry")
+	proto.RegisterType((*JobRunSucceeded)(nil), "armadaevents.JobRunSucceeded")
+	proto.RegisterType((*JobErrors)(nil), "armadaevents.JobErrors")
+	proto.RegisterType((*JobRunErrors)(nil), "armadaevents.JobRunErrors")
+	proto.RegisterType((*Error)(nil), "armadaevents.Error")
+	proto.RegisterType((*KubernetesError)(nil), "armadaevents.KubernetesError")
+	proto.RegisterType((*PodError)(nil), "armadaevents.PodError")
+	proto.RegisterType((*ContainerError)(nil), "armadaevents.ContainerError")
+	proto.RegisterType((*PodLeaseReturned)(nil), "armadaevents.PodLeaseReturned")
+	proto.RegisterType((*PodTerminated)(nil), "armadaevents.PodTerminated")
+	proto.RegisterType((*ExecutorError)(nil), "armadaevents.ExecutorError")
+	proto.RegisterType((*PodUnschedulable)(nil), "armadaevents.PodUnschedulable")
+	proto.RegisterType((*LeaseExpired)(nil), "armadaevents.LeaseExpired")
+	proto.RegisterType((*MaxRunsExceeded)(nil), "armadaevents.MaxRunsExceeded")
+	proto.RegisterType((*JobRunPreemptedError)(nil), "armadaevents.JobRunPreemptedError")
+	proto.RegisterType((*GangJobUnschedulable)(nil), "armadaevents.GangJobUnschedulable")
+	proto.RegisterType((*JobDuplicateDetected)(nil), "armadaevents.JobDuplicateDetected")
+	proto.RegisterType((*JobRunPreempted)(nil), "armadaevents.JobRunPreempted")
+	proto.RegisterType((*PartitionMarker)(nil), "armadaevents.PartitionMarker")
+	proto.RegisterType((*JobRunPreemptionRequested)(nil), "armadaevents.JobRunPreemptionRequested")
+}
+
+func init() { proto.RegisterFile("pkg/armadaevents/events.proto", fileDescriptor_6aab92ca59e015f8) }
+
+var fileDescriptor_6aab92ca59e015f8 = []byte{
+	// 4345 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x3c, 0x4d, 0x6c, 0x1c, 0xd7,
+	0x79, 0x9a, 0x5d, 0x72, 0x97, 0xfb, 0xf1, 0x67, 0xa9, 0xc7, 0x1f, 0x8f, 0x48, 0x91, 0xcb, 0x8c,
+	0xed, 0x46, 0x0a, 0xec, 0xa5, 0x23, 0xbb, 0x86, 0xed, 0x34, 0x31, 0xb8, 0x12, 0x6d, 0x49, 0x11,
+	0x29, 0x66, 0x29, 0x26, 0x6e, 0x90, 0x62, 0x33, 0xbb, 0xf3, 0xb4, 0x1c, 0x72, 0x77, 0x66, 0x33,
+	0x33, 0x2b, 0x89, 0x85, 0x0f, 0x6e, 0x91, 0xa4, 0xa7, 0xb6, 0x46, 0x5b, 0x14, 0x06, 0x0a, 0x34,
+	0x3d, 0xf4, 0xd2, 0x00, 0xbd, 0xf4, 0xd2, 0x73, 0x81, 0x02, 0xcd, 0xa1, 0x28, 0xdc, 0x53, 0x7b,
+	0xda, 0x06, 0x36, 0xda, 0x02, 0x7b, 0xe8, 0xb9, 0xed, 0xa1, 0x28, 0xde, 0xdf, 0xcc, 0x7b, 0x33,
+	0x6f, 0xa9, 0x95, 0x28, 0x85, 0x69, 0x7d, 0xe2, 0xce, 0xf7, 0xff, 0x7e, 0xbe, 0xef, 0x7d, 0xef,
+	0x7b, 0xef, 0x11, 0xd6, 0x7a, 0xc7, 0xed, 0x4d, 0x3b, 0xe8, 0xda, 0x8e, 0x8d, 0x1f, 0x60, 0x2f,
+	0x0a, 0x37, 0xd9, 0x9f, 0x6a, 0x2f, 0xf0, 0x23, 0x1f, 0xcd, 0xc8, 0xa8, 0x15, 0xeb, 0xf8, 0xad,
+	0xb0, 0xea, 0xfa, 0x9b, 0x76, 0xcf, 0xdd, 0x6c, 0xf9, 0x01, 0xde, 0x7c, 0xf0, 0xd5, 0xcd, 0x36,
+	0xf6, 0x70, 0x60, 0x47, 0xd8, 0x61, 0x1c, 0x2b, 0x57, 0x24, 0x1a, 0x0f, 0x47, 0x0f, 0xfd, 0xe0,
+	0xd8, 0xf5, 0xda, 0x3a, 0xca, 0x4a, 0xdb, 0xf7, 0xdb, 0x1d, 0xbc, 0x49, 0xbf, 0x9a, 0xfd, 0xfb,
+	0x9b, 0x91, 0xdb, 0xc5, 0x61, 0x64, 0x77, 0x7b, 0x9c, 0x60, 0x3d, 0x4d, 0xf0, 0x30, 0xb0, 0x7b,
+	0x3d, 0x1c, 0x70, 0xe3, 0x56, 0xde, 0x48, 0x54, 0x75, 0xed, 0xd6, 0xa1, 0xeb, 0xe1, 0xe0, 0x64,
+	0x93, 0xb6, 0xa7, 0xe7, 0x6e, 0x06, 0x38, 0xf4, 0xfb, 0x41, 0x0b, 0x67, 0xd4, 0xbe, 0xda, 0x76,
+	0xa3, 0xc3, 0x7e, 0xb3, 0xda, 0xf2, 0xbb, 0x9b, 0x6d, 0xbf, 0xed, 0x27, 0xe2, 0xc9, 0x17, 0xfd,
+	0xa0, 0xbf, 0x38, 0xf9, 0x3b, 0xae, 0x17, 0xe1, 0xc0, 0xb3, 0x3b, 0x9b, 0x61, 0xeb, 0x10, 0x3b,
+	0xfd, 0x0e, 0x0e, 0x92, 0x5f, 0x7e, 0xf3, 0x08, 0xb7, 0xa2, 0x30, 0x03, 0x60, 0xbc, 0xd6, 0xff,
+	0xac, 0xc1, 0xec, 0x36, 0xe9, 0xba, 0x7d, 0xfc, 0x83, 0x3e, 0xf6, 0x5a, 0x18, 0x5d, 0x85, 0xc9,
+	0x1f, 0xf4, 0x71, 0x1f, 0x9b, 0xc6, 0x86, 0x71, 0xa5, 0x54, 0x5b, 0x18, 0x0e, 0x2a, 0x65, 0x0a,
+	0x78, 0xc5, 0xef, 0xba, 0x11, 0xee, 0xf6, 0xa2, 0x93, 0x3a, 0xa3, 0x40, 0xef, 0xc0, 0xcc, 0x91,
+	0xdf, 0x6c, 0x84, 0x38, 0x6a, 0x78, 0x76, 0x17, 0x9b, 0x39, 0xca, 0x61, 0x0e, 0x07, 0x95, 0xc5,
+	0x23, 0xbf, 0xb9, 0x8f, 0xa3, 0x5d, 0xbb, 0x2b, 0xb3, 0x41, 0x02, 0x45, 0xaf, 0x42, 0xb1, 0x1f,
+	0xe2, 0xa0, 0xe1, 0x3a, 0x66, 0x9e, 0xb2, 0x2d, 0x0e, 0x07, 0x95, 0x79, 0x02, 0xba, 0xe5, 0x48,
+	0x2c, 0x05, 0x06, 0x41, 0xaf, 0x40, 0xa1, 0x1d, 0xf8, 0xfd, 0x5e, 0x68, 0x4e, 0x6c, 0xe4, 0x05,
+	0x35, 0x83, 0xc8, 0xd4, 0x0c, 0x82, 0xee, 0x42, 0x81, 0xcd, 0x07, 0x73, 0x72, 0x23, 0x7f, 0x65,
+	0xfa, 0xda, 0x97, 0xaa, 0xf2, 0x24, 0xa9, 0x2a, 0x0d, 0x66, 0x5f, 0x4c, 0x20, 0xc3, 0xcb, 0x02,
+	0xf9, 0xb4, 0xfa, 0xa7, 0x55, 0x98, 0xa4, 0x74, 0xe8, 0x2e, 0x14, 0x5b, 0x01, 0x26, 0x83, 0x65,
+	0xa2, 0x0d, 0xe3, 0xca, 0xf4, 0xb5, 0x95, 0x2a, 0x9b, 0x03, 0x55, 0x31, 0x48, 0xd5, 0x7b, 0x62,
+	0x92, 0xd4, 0x2e, 0x0d, 0x07, 0x95, 0x8b, 0x9c, 0x3c, 0x91, 0xfa, 0xf1, 0xbf, 0x54, 0x8c, 0xba,
+	0x90, 0x82, 0xf6, 0xa0, 0x14, 0xf6, 0x9b, 0x5d, 0x37, 0xba, 0xed, 0x37, 0x69, 0x9f, 0x4f, 0x5f,
+	0x7b, 0x41, 0x35, 0x77, 0x5f, 0xa0, 0x6b, 0x2f, 0x0c, 0x07, 0x95, 0x85, 0x98, 0x3a, 0x91, 0x78,
+	0xf3, 0x42, 0x3d, 0x11, 0x82, 0x0e, 0xa1, 0x1c, 0xe0, 0x5e, 0xe0, 0xfa, 0x81, 0x1b, 0xb9, 0x21,
+	0x26, 0x72, 0x73, 0x54, 0xee, 0x9a, 0x2a, 0xb7, 0xae, 0x12, 0xd5, 0xd6, 0x86, 0x83, 0xca, 0xa5,
+	0x14, 0xa7, 0xa2, 0x23, 0x2d, 0x16, 0x45, 0x80, 0x52, 0xa0, 0x7d, 0x1c, 0xd1, 0xf1, 0x9c, 0xbe,
+	0xb6, 0x71, 0xaa, 0xb2, 0x7d, 0x1c, 0xd5, 0x36, 0x86, 0x83, 0xca, 0xe5, 0x2c, 0xbf, 0xa2, 0x52,
+	0x23, 0x1f, 0x75, 0x60, 0x5e, 0x86, 0x3a, 0xa4, 0x81, 0x13, 0x54, 0xe7, 0xfa, 0x68, 0x9d, 0x84,
+	0xaa, 0xb6, 0x3e, 0x1c, 0x54, 0x56, 0xd2, 0xbc, 0x8a, 0xbe, 0x8c, 0x64, 0x32, 0x3e, 0x2d, 0xdb,
+	0x6b, 0xe1, 0x0e, 0x51, 0x33, 0xa9, 0x1b, 0x9f, 0xeb, 0x02, 0xcd, 0xc6, 0x27, 0xa6, 0x56, 0xc7,
+	0x27, 0x06, 0xa3, 0xef, 0xc1, 0x4c, 0xfc, 0x41, 0xfa, 0xab, 0xc0, 0xe7, 0x91, 0x5e, 0x28, 0xe9,
+	0xa9, 0x95, 0xe1, 0xa0, 0xb2, 0x2c, 0xf3, 0x28, 0xa2, 0x15, 0x69, 0x89, 0xf4, 0x0e, 0xeb, 0x99,
+	0xe2, 0x68, 0xe9, 0x8c, 0x42, 0x96, 0xde, 0xc9, 0xf6, 0x88, 0x22, 0x8d, 0x48, 0x27, 0x4e, 0xdc,
+	0x6f, 0xb5, 0x30, 0x76, 0xb0, 0x63, 0x4e, 0xe9, 0xa4, 0xdf, 0x96, 0x28, 0x98, 0x74, 0x99, 0x47,
+	0x95, 0x2e, 0x63, 0x48, 0x5f, 0x1f, 0xf9, 0xcd, 0xed, 0x20, 0xf0, 0x83, 0xd0, 0x2c, 0xe9, 0xfa,
+	0xfa, 0xb6, 0x40, 0xb3, 0xbe, 0x8e, 0xa9, 0xd5, 0xbe, 0x8e, 0xc1, 0xdc, 0xde, 0x7a, 0xdf, 0xbb,
+	0x83, 0xed, 0x10, 0x3b, 0x26, 0x8c, 0xb0, 0x37, 0xa6, 0x88, 0xed, 0x8d, 0x21, 0x19, 0x7b, 0x63,
+	0x0c, 0x72, 0x60, 0x8e, 0x7d, 0x6f, 0x85, 0xa1, 0xdb, 0xf6, 0xb0, 0x63, 0x4e, 0x53, 0xf9, 0x97,
+	0x75, 0xf2, 0x05, 0x4d, 0xed, 0xf2, 0x70, 0x50, 0x31, 0x55, 0x3e, 0x45, 0x47, 0x4a, 0x26, 0xfa,
+	0x3e, 0xcc, 0x32, 0x48, 0xbd, 0xef, 0x79, 0xae, 0xd7, 0x36, 0x67, 0xa8, 0x92, 0x55, 0x9d, 0x12,
+	0x4e, 0x52, 0x5b, 0x1d, 0x0e, 0x2a, 0x2f, 0x28, 0x5c, 0x8a, 0x0a, 0x55, 0x20, 0x89, 0x18, 0x0c,
+	0x90, 0x0c, 0xec, 0xac, 0x2e, 0x62, 0xdc, 0x56, 0x89, 0x58, 0xc4, 0x48, 0x71, 0xaa, 0x11, 0x23,
+	0x85, 0x4c, 0xc6, 0x83, 0x0f, 0xf2, 0xdc, 0xe8, 0xf1, 0xe0, 0xe3, 0x2c, 0x8d, 0x87, 0x66, 0xa8,
+	0x15, 0x69, 0xe8, 0x43, 0x20, 0x0b, 0xcf, 0x8d, 0x7e, 0xaf, 0xe3, 0xb6, 0xec, 0x08, 0xdf, 0xc0,
+	0x11, 0x6e, 0x91, 0x48, 0x5d, 0xa6, 0x5a, 0xac, 0x8c, 0x96, 0x0c, 0x65, 0xcd, 0x1a, 0x0e, 0x2a,
+	0xeb, 0x3a, 0x19, 0x8a, 0x56, 0xad, 0x16, 0xf4, 0x91, 0x01, 0x4b, 0x61, 0x64, 0x7b, 0x8e, 0xdd,
+	0xf1, 0x3d, 0x7c, 0xcb, 0x6b, 0x07, 0x38, 0x0c, 0x6f, 0x79, 0xf7, 0x7d, 0x73, 0x9e, 0xea, 0x7f,
+	0x31, 0x15, 0xd6, 0x75, 0xa4, 0xb5, 0x17, 0x87, 0x83, 0x4a, 0x45, 0x2b, 0x45, 0xb1, 0x40, 0xaf,
+	0x08, 0x3d, 0x82, 0x05, 0x91, 0x55, 0x1c, 0x44, 0x6e, 0xc7, 0x0d, 0xed, 0xc8, 0xf5, 0x3d, 0xf3,
+	0xe2, 0x86, 0x91, 0x5d, 0x05, 0xeb, 0x59, 0xc2, 0xda, 0x97, 0x86, 0x83, 0xca, 0x9a, 0x46, 0x82,
+	0xa2, 0x5b, 0xa7, 0x22, 0x99, 0x42, 0x7b, 0x01, 0x26, 0x84, 0xd8, 0x31, 0x17, 0x46, 0x4f, 0xa1,
+	0x98, 0x48, 0x9e, 0x42, 0x31, 0x50, 0x37, 0x85, 0x62, 0x24, 0xd1, 0xd4, 0xb3, 0x83, 0xc8, 0x25,
+	0x6a, 0x77, 0xec, 0xe0, 0x18, 0x07, 0xe6, 0xa2, 0x4e, 0xd3, 0x9e, 0x4a, 0xc4, 0x34, 0xa5, 0x38,
+	0x55, 0x4d, 0x29, 0x24, 0xfa, 0xd8, 0x00, 0xd5, 0x34, 0xd7, 0xf7, 0xea, 0x24, 0x6d, 0x08, 0x49,
+	0xf3, 0x96, 0xa8, 0xd2, 0x2f, 0x9f, 0xd2, 0x3c, 0x99, 0xbc, 0xf6, 0xe5, 0xe1, 0xa0, 0xf2, 0xe2,
+	0x48, 0x69, 0x8a, 0x21, 0xa3, 0x95, 0xa2, 0x0f, 0x60, 0x9a, 0x20, 0x31, 0x4d, 0xc0, 0x1c, 0x73,
+	0x99, 0xda, 0x70, 0x29, 0x6b, 0x03, 0x27, 0xa0, 0x19, 0xc8, 0x92, 0xc4, 0xa1, 0xe8, 0x91, 0x45,
+	0xf1, 0x28, 0xb3, 0x8f, 0xa3, 0xed, 0x47, 0x3d, 0x37, 0xc0, 0x8e, 0xf9, 0xc2, 0x88, 0x28, 0x93,
+	0x90, 0xc4, 0x51, 0x26, 0x01, 0x65, 0xa2, 0x4c, 0x82, 0x8a, 0xd7, 0x8e, 0xb0, 0x87, 0x3d, 0x12,
+	0x62, 0xcc, 0x91, 0x6b, 0x07, 0xa7, 0x90, 0xd6, 0x0e, 0x0e, 0xd1, 0xac, 0x1d, 0x1c, 0x83, 0xee,
+	0x01, 0xd0, 0xe6, 0x84, 0xfd, 0x2e, 0x76, 0xcc, 0x4b, 0x54, 0xb6, 0xa9, 0xe9, 0x18, 0x8a, 0x8f,
+	0x93, 0x54, 0xfe, 0xad, 0xc8, 0x95, 0xe4, 0x50, 0x9f, 0x26, 0xab, 0xc9, 0x03, 0x97, 0xb8, 0xf8,
+	0x7b, 0x7e, 0x70, 0xdd, 0xee, 0xd9, 0x2d, 0x37, 0x3a, 0x31, 0x57, 0x74, 0x3e, 0x7d, 0x5b, 0x47,
+	0xca, 0x7c, 0x5a, 0x2b, 0x45, 0xf5, 0x69, 0x2d, 0x09, 0x49, 0xb2, 0xd8, 0x7c, 0x88, 0xdc, 0x2e,
+	0xde, 0x7e, 0xc4, 0xe3, 0xf3, 0xaa, 0x2e, 0xc9, 0xba, 0x9d, 0xa1, 0x63, 0x49, 0x56, 0x96, 0x5f,
+	0x4d, 0xb2, 0xb2, 0x78, 0xf4, 0x43, 0x03, 0x96, 0x59, 0x3f, 0x50, 0x57, 0x0f, 0x77, 0xfd, 0xa0,
+	0x6b, 0x77, 0xdc, 0xdf, 0xc4, 0x8e, 0x79, 0x99, 0xaa, 0x7e, 0x49, 0xd7, 0xb7, 0x69, 0xda, 0xda,
+	0x4b, 0xc3, 0x41, 0x65, 0x43, 0x2f, 0x47, 0x31, 0x61, 0x84, 0x2e, 0x92, 0xeb, 0x1d, 0xf9, 0xcd,
+	0x03, 0x8f, 0x6f, 0x5f, 0xec, 0x66, 0x07, 0x9b, 0x6b, 0xba, 0x5c, 0xef, 0x76, 0x8a, 0x8a, 0xe5,
+	0x7a, 0x69, 0x5e, 0x35, 0xd7, 0x4b, 0x63, 0xd1, 0x27, 0x06, 0xac, 0x1e, 0xf9, 0xcd, 0x1d, 0xdb,
+	0xf5, 0x22, 0xec, 0x91, 0xbc, 0xe7, 0x3b, 0xae, 0xe7, 0xf8, 0x0f, 0xf7, 0x23, 0x3b, 0x20, 0x2e,
+	0xbf, 0x4e, 0x35, 0x5f, 0xcd, 0x68, 0x1e, 0xc5, 0x50, 0xbb, 0x3a, 0x1c, 0x54, 0x5e, 0x3e, 0x45,
+	0xa2, 0x62, 0xcf, 0x69, 0xaa, 0x45, 0x2c, 0xca, 0xe0, 0xb7, 0xa9, 0x2b, 0x55, 0x46, 0xc4, 0x22,
+	0x3d, 0x79, 0x1c, 0x8b, 0xf4, 0xe8, 0x4c, 0x2c, 0xd2, 0x93, 0xf1, 0x58, 0x74, 0xdd, 0x0f, 0x1c,
+	0x9f, 0xa4, 0x3e, 0x1b, 0x23, 0x62, 0x91, 0x20, 0x88, 0x63, 0x91, 0x00, 0x64, 0x62, 0x91, 0x40,
+	0xf0, 0x58, 0x74, 0xe0, 0xb5, 0x84, 0xec, 0x2f, 0x8d, 0x88, 0x45, 0x09, 0x49, 0x1c, 0x8b, 0x12,
+	0x50, 0x26, 0x16, 0x49, 0xd4, 0x45, 0x98, 0xa4, 0x52, 0xac, 0x61, 0x01, 0x16, 0x34, 0x2b, 0x21,
+	0xfa, 0x06, 0x14, 0x82, 0xbe, 0x47, 0xb6, 0xa7, 0x6c, 0x4f, 0x86, 0x54, 0xdd, 0x07, 0x7d, 0xd7,
+	0x61, 0x7b, 0xe3, 0xa0, 0xef, 0x29, 0x3b, 0xd6, 0x49, 0x0a, 0x20, 0xfc, 0x64, 0x6f, 0xec, 0x3a,
+	0x66, 0xee, 0x74, 0xfe, 0x23, 0xbf, 0xa9, 0xf2, 0x53, 0x00, 0xc2, 0x30, 0x2b, 0x96, 0xd9, 0x86,
+	0x4b, 0x72, 0x88, 0xbc, 0xce, 0xeb, 0xbe, 0xd9, 0x6f, 0xe2, 0xc0, 0xc3, 0x11, 0x0e, 0x45, 0x1b,
+	0x68, 0x12, 0x41, 0xe3, 0x66, 0x20, 0x41, 0x24, 0xf9, 0x33, 0x32, 0x1c, 0xfd, 0x91, 0x01, 0x66,
+	0xd7, 0x7e, 0xd4, 0x10, 0xc0, 0xb0, 0x71, 0xdf, 0x0f, 0x1a, 0x3d, 0x1c, 0xb8, 0xbe, 0x43, 0xb7,
+	0xda, 0xd3, 0xd7, 0x7e, 0xed, 0xb1, 0x69, 0x43, 0x75, 0xc7, 0x7e, 0x24, 0xc0, 0xe1, 0x7b, 0x7e,
+	0xb0, 0x47, 0xd9, 0xb7, 0xbd, 0x28, 0x38, 0xa9, 0xad, 0xfd, 0x6c, 0x50, 0xb9, 0x40, 0x06, 0xbe,
+	0xab, 0xa3, 0xa9, 0xeb, 0xc1, 0xe8, 0xf7, 0x0d, 0x58, 0x8e, 0xfc, 0xc8, 0xee, 0x34, 0x5a, 0xfd,
+	0x6e, 0xbf, 0x63, 0x47, 0xee, 0x03, 0xdc, 0xe8, 0x87, 0x76, 0x1b, 0xf3, 0x1d, 0xfd, 0xd7, 0x1e,
+	0x6f, 0xd4, 0x3d, 0xc2, 0x7f, 0x3d, 0x66, 0x3f, 0x20, 0xdc, 0xcc, 0xa6, 0xcb, 0xdc, 0xa6, 0xc5,
+	0x48, 0x43, 0x52, 0xd7, 0x42, 0x57, 0xfe, 0xcc, 0x80, 0x95, 0xd1, 0xcd, 0x44, 0x2f, 0x42, 0xfe,
+	0x18, 0x9f, 0xf0, 0x9a, 0xc9, 0xc5, 0xe1, 0xa0, 0x32, 0x7b, 0x8c, 0xa5, 0xe8, 0x5e, 0x27, 0x58,
+	0xf4, 0xeb, 0x30, 0xf9, 0xc0, 0xee, 0xf4, 0x31, 0x9f, 0x12, 0xd5, 0x2a, 0xab, 0x0e, 0x55, 0xe5,
+	0xea, 0x50, 0xb5, 0x77, 0xdc, 0x26, 0x80, 0xaa, 0x18, 0x91, 0xea, 0xb7, 0xfa, 0xb6, 0x17, 0x91,
+	0x65, 0x84, 0x4e, 0x17, 0x2a, 0x40, 0x9e, 0x2e, 0x14, 0xf0, 0x4e, 0xee, 0x2d, 0x63, 0xe5, 0x27,
+	0x06, 0x5c, 0x1a, 0xd9, 0xe8, 0x5f, 0x06, 0x0b, 0xad, 0x06, 0x4c, 0x90, 0x89, 0x4f, 0xaa, 0x39,
+	0x87, 0x6e, 0xfb, 0xf0, 0xcd, 0x37, 0xa8, 0x39, 0x05, 0x56, 0x7c, 0x61, 0x10, 0xb9, 0xf8, 0xc2,
+	0x20, 0xa4, 0x22, 0xd5, 0xf1, 0x1f, 0xbe, 0xf9, 0x06, 0x35, 0xaa, 0xc0, 0x94, 0x50, 0x80, 0xac,
+	0x84, 0x02, 0xac, 0x8f, 0xa6, 0xa0, 0x14, 0x97, 0x4b, 0x24, 0x1f, 0x34, 0x9e, 0xca, 0x07, 0x6f,
+	0xc2, 0xbc, 0x83, 0x1d, 0x9e, 0xe7, 0xbb, 0xbe, 0x27, 0xbc, 0xb9, 0xc4, 0x72, 0x49, 0x05, 0xa7,
+	0xf0, 0x97, 0x53, 0x28, 0x74, 0x0d, 0xa6, 0x78, 0x59, 0xe1, 0x84, 0x3a, 0xf2, 0x6c, 0x6d, 0x79,
+	0x38, 0xa8, 0x20, 0x01, 0x93, 0x58, 0x63, 0x3a, 0x54, 0x07, 0x60, 0xb5, 0xba, 0x1d, 0x1c, 0xd9,
+	0xe6, 0x84, 0x2e, 0xa1, 0xb9, 0x1b, 0xe3, 0x59, 0x42, 0x93, 0xd0, 0x4b, 0x12, 0x25, 0x29, 0xe8,
+	0x7b, 0x00, 0x5d, 0xdb, 0xf5, 0x18, 0x9f, 0x39, 0xa9, 0xdb, 0x16, 0x25, 0x21, 0x65, 0x27, 0xa6,
+	0x64, 0xd2, 0x13, 0x4e, 0x59, 0x7a, 0x02, 0x25, 0xb5, 0x31, 0xa6, 0x2b, 0x34, 0x0b, 0x1b, 0xf9,
+	0xec, 0x1a, 0x9d, 0x88, 0xe6, 0x62, 0x97, 0x48, 0x7d, 0x8c, 0xb3, 0x48, 0x32, 0x85, 0x14, 0xd2,
+	0x6d, 0x1d, 0xf7, 0x3e, 0x8e, 0xdc, 0x2e, 0x36, 0x8b, 0x49, 0xb7, 0x09, 0x98, 0xdc, 0x6d, 0x02,
+	0x86, 0xde, 0x02, 0xb0, 0xa3, 0x1d, 0x3f, 0x8c, 0xee, 0x7a, 0x2d, 0x4c, 0xeb, 0x13, 0x53, 0xcc,
+	0xfc, 0x04, 0x2a, 0x9b, 0x9f, 0x40, 0xd1, 0xd7, 0x60, 0xba, 0xc7, 0x53, 0x6e, 0x92, 0x66, 0x94,
+	0x28, 0x2b, 0x5d, 0xb4, 0x24, 0xb0, 0xc4, 0x2b, 0x53, 0xa3, 0xf7, 0xa1, 0xdc, 0xf2, 0xbd, 0x56,
+	0x3f, 0x08, 0xb0, 0xd7, 0x3a, 0xd9, 0xb7, 0xef, 0x63, 0x5a, 0x6b, 0x98, 0x62, 0x53, 0x25, 0x85,
+	0x92, 0xa7, 0x4a, 0x0a, 0x85, 0x7e, 0x15, 0x4a, 0x71, 0xad, 0x96, 0x96, 0x13, 0x4a, 0xbc, 0xec,
+	0x27, 0x80, 0x12, 0x73, 0x42, 0x49, 0x8c, 0x77, 0xc3, 0x78, 0x4f, 0x6a, 0xce, 0x24, 0xc6, 0x4b,
+	0x60, 0xd9, 0x78, 0x09, 0x8c, 0x6e, 0xc1, 0x45, 0xba, 0x0b, 0x68, 0x44, 0x51, 0xa7, 0x11, 0xe2,
+	0x96, 0xef, 0x39, 0x21, 0xad, 0x00, 0xe4, 0x99, 0xf9, 0x14, 0x79, 0x2f, 0xea, 0xec, 0x33, 0x94,
+	0x6c, 0x7e, 0x0a, 0x25, 0x6a, 0xc2, 0x76, 0x10, 0xd8, 0x27, 0xc4, 0x5f, 0xe6, 0x94, 0x9a, 0xf0,
+	0x16, 0x01, 0x2b, 0xae, 0x02, 0x09, 0x14, 0x5d, 0x87, 0xb2, 0xc4, 0xeb, 0x39, 0xf8, 0x11, 0xdd,
+	0xb9, 0xcf, 0xc6, 0x6b, 0x3b, 0x23, 0x24, 0x08, 0x49, 0xc2, 0xac, 0x82, 0xb0, 0xfe, 0xde, 0x80,
+	0x45, 0xdd, 0x1c, 0x4e, 0xf9, 0x93, 0xf1, 0x4c, 0xfc, 0xe9, 0xdb, 0x30, 0xd5, 0xf3, 0x9d, 0x46,
+	0xd8, 0xc3, 0x2d, 0x33, 0xa7, 0xf3, 0xa6, 0x3d, 0xdf, 0xd9, 0xef, 0xe1, 0xd6, 0x77, 0xdc, 0xe8,
+	0x70, 0xeb, 0x81, 0xef, 0x3a, 0x77, 0xdc, 0x90, 0x4f, 0xfb, 0x1e, 0xc3, 0x28, 0x49, 0x4a, 0x91,
+	0x03, 0x6b, 0x53, 0x50, 0x60, 0x5a, 0xac, 0x7f, 0xc8, 0xc3, 0x7c, 0xda, 0x6f, 0xfe, 0x2f, 0x35,
+	0x05, 0x7d, 0x00, 0x45, 0x97, 0x55, 0x28, 0x78, 0x0a, 0xf3, 0xb2, 0xb4, 0xa8, 0x54, 0x93, 0xf3,
+	0x97, 0xea, 0x83, 0xaf, 0x56, 0x79, 0x29, 0x83, 0x76, 0x01, 0x95, 0xcc, 0x39, 0x55, 0xc9, 0x1c,
+	0x88, 0xea, 0x50, 0x0c, 0x71, 0xf0, 0xc0, 0x6d, 0x61, 0x1e, 0x1d, 0x2b, 0xb2, 0xe4, 0x96, 0x1f,
+	0x60, 0x22, 0x73, 0x9f, 0x91, 0x24, 0x32, 0x39, 0x8f, 0x2a, 0x93, 0x03, 0xd1, 0xb7, 0xa1, 0xd4,
+	0xf2, 0xbd, 0xfb, 0x6e, 0x7b, 0xc7, 0xee, 0xf1, 0xf8, 0xb8, 0xa6, 0x93, 0x7a, 0x5d, 0x10, 0xf1,
+	0x9a, 0xaf, 0xf8, 0x4c, 0xd5, 0x7c, 0x63, 0xaa, 0x64, 0x40, 0xff, 0x63, 0x02, 0x20, 0x19, 0x1c,
+	0xf4, 0x36, 0x4c, 0xe3, 0x47, 0xb8, 0xd5, 0x8f, 0xfc, 0x40, 0x2c, 0x54, 0xdc, 0x5d, 0x04, 0x58,
+	0x75, 0x97, 0x04, 0x4a, 0x22, 0x85, 0x67, 0x77, 0x71, 0xd8, 0xb3, 0x5b, 0xe2, 0xec, 0x85, 0x1a,
+	0x13, 0x03, 0xe5, 0x48, 0x11, 0x03, 0xd1, 0xaf, 0xc0, 0x04, 0xf9, 0xe0, 0xc7, 0x2e, 0x68, 0x38,
+	0xa8, 0xcc, 0x79, 0xea, 0x39, 0x0d, 0xc5, 0xa3, 0x77, 0x61, 0xf6, 0x38, 0x9e, 0x78, 0xc4, 0xb6,
+	0x09, 0xca, 0x40, 0x73, 0xcb, 0x04, 0xa1, 0x58, 0x37, 0x23, 0xc3, 0xd1, 0x7d, 0x98, 0xb6, 0x3d,
+	0xcf, 0x8f, 0xe8, 0x22, 0x28, 0x8e, 0x62, 0xae, 0x8e, 0x9a, 0xa6, 0xd5, 0xad, 0x84, 0x96, 0xa5,
+	0x69, 0x34, 0x7a, 0x49, 0x12, 0xe4, 0xe8, 0x25, 0x81, 0x51, 0x1d, 0x0a, 0x1d, 0xbb, 0x89, 0x3b,
+	0x62, 0xd5, 0x79, 0x69, 0xa4, 0x8a, 0x3b, 0x94, 0x8c, 0x49, 0xa7, 0x39, 0x07, 0xe3, 0x93, 0x73,
+	0x0e, 0x06, 0x59, 0xb9, 0x0f, 0xf3, 0x69, 0x7b, 0xc6, 0xcb, 0xa0, 0xae, 0xca, 0x19, 0x54, 0xe9,
+	0xb1, 0x39, 0x9b, 0x0d, 0xd3, 0x92, 0x51, 0xcf, 0x43, 0x85, 0xf5, 0x17, 0x06, 0x2c, 0xea, 0x7c,
+	0x17, 0xed, 0x48, 0x1e, 0x6f, 0xf0, 0x0d, 0x96, 0x66, 0xaa, 0x73, 0xde, 0x11, 0xae, 0x9e, 0x38,
+	0x7a, 0x0d, 0xe6, 0x3c, 0xdf, 0xc1, 0x0d, 0x9b, 0x28, 0xe8, 0xb8, 0x61, 0x64, 0xe6, 0xe8, 0x51,
+	0x1d, 0x0d, 0xde, 0x04, 0xb3, 0x25, 0x10, 0x72, 0xf0, 0x56, 0x10, 0xd6, 0x8f, 0x0c, 0x28, 0xa7,
+	0x4e, 0x8a, 0xce, 0x9c, 0xc5, 0xc9, 0xb9, 0x57, 0x6e, 0xbc, 0xdc, 0xcb, 0xfa, 0xc3, 0x1c, 0x4c,
+	0x4b, 0x65, 0xb4, 0x33, 0xdb, 0x70, 0x04, 0x65, 0xbe, 0x54, 0xbb, 0x5e, 0x9b, 0xed, 0xe7, 0x72,
+	0xbc, 0x7e, 0x94, 0x39, 0x98, 0x25, 0x15, 0xb0, 0x98, 0x96, 0x6e, 0xe7, 0xe8, 0x81, 0x41, 0xa8,
+	0xc0, 0x24, 0x15, 0x73, 0x2a, 0x06, 0x7d, 0x00, 0xcb, 0xfd, 0x9e, 0x63, 0x47, 0xb8, 0x11, 0xf2,
+	0x23, 0xce, 0x86, 0xd7, 0xef, 0x36, 0x71, 0x40, 0x3d, 0x7e, 0x92, 0x95, 0xb8, 0x19, 0x85, 0x38,
+	0x03, 0xdd, 0xa5, 0x78, 0x49, 0xe6, 0xa2, 0x0e, 0x6f, 0xdd, 0x04, 0x94, 0x3d, 0xc6, 0x53, 0xfa,
+	0xd7, 0x18, 0xb3, 0x7f, 0x7f, 0x6c, 0xc0, 0x7c, 0xfa, 0x74, 0xee, 0x5c, 0x06, 0xfa, 0x04, 0x4a,
+	0xf1, 0x49, 0xdb, 0x99, 0x0d, 0x78, 0x05, 0x0a, 0x01, 0xb6, 0x43, 0xdf, 0xe3, 0x9e, 0x49, 0x43,
+	0x0c, 0x83, 0xc8, 0x21, 0x86, 0x41, 0xac, 0x7b, 0x30, 0xc3, 0x7a, 0xf0, 0x3d, 0xb7, 0x13, 0xe1,
+	0x00, 0xdd, 0x80, 0x42, 0x18, 0xd9, 0x11, 0x0e, 0x4d, 0x63, 0x23, 0x7f, 0x65, 0xee, 0xda, 0x72,
+	0xb6, 0x30, 0x4a, 0xd0, 0x4c, 0x2a, 0xa3, 0x94, 0xa5, 0x32, 0x88, 0xf5, 0xdb, 0x06, 0xcc, 0xc8,
+	0x67, 0x87, 0xcf, 0x46, 0xec, 0x13, 0x36, 0xed, 0x43, 0x61, 0x43, 0xe7, 0xd9, 0x8c, 0xec, 0x93,
+	0x69, 0xff, 0x53, 0x03, 0x66, 0x95, 0x3a, 0xf5, 0x99, 0xf5, 0xef, 0xc0, 0x82, 0xb8, 0xe8, 0x20,
+	0x67, 0xc8, 0x39, 0x9a, 0x21, 0x8b, 0x42, 0xe3, 0x3e, 0x8e, 0xb4, 0x29, 0xf2, 0x7c, 0x1a, 0x67,
+	0xed, 0xb2, 0x91, 0x8f, 0x4b, 0xd7, 0x67, 0x34, 0xcf, 0xba, 0x03, 0x90, 0x94, 0xb6, 0xcf, 0x2c,
+	0xed, 0x0f, 0x0c, 0x58, 0xd2, 0xd6, 0xb1, 0xcf, 0xdc, 0x8d, 0xa9, 0x5c, 0x27, 0x37, 0x7e, 0xae,
+	0x63, 0xfd, 0xdc, 0x00, 0x94, 0xad, 0x6e, 0x9f, 0xa3, 0x45, 0xe8, 0x2e, 0x2c, 0xd0, 0xc2, 0x19,
+	0xb3, 0x28, 0x9e, 0x13, 0x6c, 0x77, 0x5f, 0x19, 0x0e, 0x2a, 0xab, 0xa4, 0xb4, 0xc5, 0xb0, 0xd9,
+	0x49, 0x71, 0x31, 0x83, 0xb4, 0xfe, 0x7c, 0x12, 0x96, 0xf5, 0x55, 0xf4, 0x33, 0x37, 0xf3, 0x47,
+	0x06, 0x20, 0x3f, 0x70, 0xdb, 0xae, 0x67, 0x77, 0x92, 0x52, 0x9f, 0x99, 0xd3, 0x95, 0xd2, 0xf4,
+	0x26, 0x54, 0xef, 0x72, 0xf6, 0x18, 0xc7, 0x73, 0x34, 0x5e, 0x4a, 0xbb, 0xe8, 0xa7, 0xf1, 0xf5,
+	0x2c, 0x08, 0xfd, 0xae, 0x01, 0x8b, 0x5e, 0x2c, 0x53, 0xb2, 0x24, 0x4f, 0x2d, 0xf9, 0xfa, 0x58,
+	0x96, 0x24, 0x3f, 0x53, 0xb6, 0xac, 0x72, 0x5b, 0x16, 0xbc, 0x2c, 0x45, 0x5d, 0x07, 0x5c, 0xf9,
+	0x63, 0x03, 0x96, 0xf5, 0x0d, 0x1b, 0x2f, 0x13, 0xdb, 0x57, 0xcb, 0x65, 0x57, 0x74, 0xe9, 0x93,
+	0x90, 0x4b, 0x72, 0x09, 0x37, 0xc0, 0x5d, 0xd2, 0xb4, 0xc7, 0xa6, 0x85, 0x9f, 0x18, 0x60, 0x8e,
+	0x6a, 0xe7, 0xf9, 0x9a, 0x66, 0x7d, 0x64, 0xc0, 0x7c, 0xfa, 0xb0, 0xe5, 0x17, 0x1c, 0xe0, 0x3f,
+	0xca, 0xc3, 0xea, 0x29, 0xa7, 0x2e, 0x67, 0xb6, 0xe6, 0x00, 0x96, 0xba, 0x89, 0xec, 0xc6, 0x43,
+	0x2a, 0x3c, 0x89, 0x0f, 0xf4, 0x90, 0xbc, 0x9b, 0x56, 0xae, 0x08, 0x5a, 0xd0, 0xa0, 0x49, 0x7e,
+	0x22, 0xe2, 0x07, 0xdf, 0x7c, 0xd1, 0xfc, 0x44, 0xc0, 0xe4, 0xfc, 0x44, 0xc0, 0xd0, 0xd7, 0x21,
+	0x8f, 0x3d, 0xc7, 0x9c, 0x78, 0xec, 0x55, 0xb3, 0x32, 0x9f, 0xfc, 0x84, 0x9c, 0x5e, 0x30, 0x23,
+	0x3f, 0xc8, 0x56, 0xc1, 0x09, 0x6c, 0xd7, 0xa3, 0x5b, 0xd9, 0x29, 0xd6, 0x68, 0x0a, 0x90, 0x1b,
+	0x4d, 0x01, 0xd2, 0x10, 0x14, 0xc6, 0x18, 0x82, 0x7f, 0x33, 0xe0, 0xd2, 0xc8, 0xf3, 0xa5, 0xff,
+	0x47, 0x03, 0x60, 0x0d, 0x0d, 0xba, 0x13, 0x88, 0x8f, 0xa6, 0x9e, 0x41, 0x92, 0x1a, 0xdb, 0x90,
+	0x1b, 0x73, 0x12, 0xbc, 0x0d, 0xd3, 0xe2, 0xe0, 0xaa, 0xd1, 0x3c, 0x31, 0xf3, 0xc9, 0x2a, 0x25,
+	0xc0, 0x35, 0xd9, 0xff, 0x21, 0x81, 0x4a, 0xa3, 0x3a, 0x31, 0xc6, 0xa8, 0xfe, 0x2d, 0xcb, 0x9c,
+	0x92, 0x73, 0xb2, 0x73, 0x69, 0xee, 0xbb, 0x30, 0xdb, 0xf7, 0xb2, 0x0d, 0xa6, 0x85, 0x87, 0xbe,
+	0xa7, 0x6d, 0xf2, 0x8c, 0x0c, 0xb7, 0xfe, 0xda, 0xe0, 0x09, 0x56, 0xeb, 0x19, 0xa5, 0x09, 0xed,
+	0xe4, 0x30, 0x8e, 0x6c, 0xb1, 0xc4, 0xca, 0x39, 0xde, 0x61, 0x1c, 0xdd, 0xff, 0x2a, 0xec, 0xf2,
+	0xfe, 0x57, 0x41, 0x58, 0x7f, 0x35, 0x41, 0x2d, 0x4f, 0x6e, 0x98, 0x9d, 0xf7, 0x31, 0x64, 0x2a,
+	0x41, 0xca, 0x3f, 0x41, 0x82, 0xf4, 0x2a, 0x14, 0x69, 0x3d, 0xc0, 0x75, 0xe4, 0xb9, 0x47, 0x40,
+	0x0a, 0x4b, 0x81, 0x41, 0x4e, 0xd9, 0xb6, 0x4e, 0x9e, 0x6d, 0xdb, 0x8a, 0x1a, 0x70, 0xe9, 0xd0,
+	0x0e, 0x1b, 0x62, 0xa3, 0xed, 0x34, 0xec, 0xa8, 0x11, 0x6f, 0x14, 0x0b, 0x34, 0x30, 0xd2, 0x6b,
+	0x0a, 0x87, 0x76, 0xb8, 0x2f, 0x68, 0xb6, 0xa2, 0xbd, 0xec, 0xb6, 0x71, 0x59, 0x4f, 0x41, 0xc2,
+	0x95, 0x5e, 0x78, 0x91, 0x5a, 0x4e, 0xc3, 0x55, 0x78, 0xaa, 0xe4, 0x05, 0x0d, 0x9a, 0x14, 0xea,
+	0x7a, 0xbe, 0xdf, 0x31, 0xa7, 0x92, 0x42, 0x1d, 0xf9, 0x96, 0x0b, 0x75, 0xe4, 0xdb, 0xfa, 0x2f,
+	0x03, 0xe6, 0xd4, 0x2b, 0x86, 0xe7, 0x3e, 0x6d, 0x32, 0x0e, 0x93, 0x7f, 0x4e, 0x0e, 0xf3, 0x9f,
+	0x2c, 0x62, 0x49, 0x77, 0x19, 0xbf, 0x30, 0x4d, 0xff, 0x24, 0x07, 0xcb, 0x7a, 0x31, 0xcf, 0xe5,
+	0x7c, 0xe0, 0x26, 0x90, 0x4a, 0xdf, 0xad, 0xa4, 0x74, 0xb5, 0x94, 0x39, 0x1e, 0xa0, 0x4d, 0x10,
+	0x65, 0xc2, 0xcc, 0x95, 0x45, 0xc1, 0x4e, 0xee, 0x8d, 0xb8, 0xd2, 0xe5, 0xc8, 0xbc, 0xee, 0xde,
+	0x88, 0x7c, 0x25, 0x92, 0x9d, 0x62, 0x8d, 0xb8, 0x08, 0x29, 0x8b, 0xaa, 0x15, 0x60, 0x82, 0xd4,
+	0xd6, 0xac, 0x7f, 0xcf, 0x41, 0x91, 0xdb, 0x83, 0x5e, 0x87, 0x12, 0x0d, 0x43, 0xb4, 0xe6, 0x6d,
+	0x24, 0x4b, 0x10, 0x01, 0xa6, 0xde, 0x27, 0x4c, 0x09, 0x18, 0x7a, 0x13, 0x80, 0x94, 0x46, 0x79,
+	0x00, 0xca, 0x51, 0x37, 0xa6, 0xb5, 0xf5, 0x9e, 0xef, 0x64, 0xa2, 0x4e, 0x29, 0x06, 0xc6, 0x2e,
+	0x9b, 0x3f, 0xdd, 0x65, 0xd1, 0xf7, 0x61, 0x9a, 0x1a, 0xc5, 0xeb, 0xd6, 0xec, 0xa2, 0xc5, 0xcb,
+	0xda, 0x0e, 0xad, 0xee, 0xfa, 0x0e, 0x96, 0x0b, 0xd7, 0x74, 0xb8, 0xbc, 0x18, 0x28, 0x0f, 0x57,
+	0x02, 0x5d, 0xc1, 0x50, 0x4e, 0x31, 0x3e, 0x97, 0xe2, 0xf2, 0x5f, 0xe6, 0x60, 0x5a, 0xbe, 0x80,
+	0xfa, 0x54, 0xbd, 0xfd, 0x21, 0x88, 0x83, 0x9e, 0x86, 0xed, 0x38, 0xe4, 0x6f, 0xbc, 0x39, 0xdd,
+	0x1c, 0x39, 0x2d, 0xc4, 0xef, 0x2d, 0xc1, 0xc1, 0x7a, 0x87, 0x56, 0x63, 0xdc, 0x14, 0x4a, 0xae,
+	0xc6, 0xa4, 0x71, 0x2b, 0xc7, 0xb0, 0xa4, 0x15, 0x25, 0xf7, 0xd7, 0xe4, 0xb3, 0xea, 0xaf, 0xbf,
+	0x99, 0x84, 0x25, 0xed, 0xc5, 0xdf, 0x73, 0x8f, 0x5b, 0x6a, 0xcc, 0xc8, 0x3f, 0x93, 0x98, 0xf1,
+	0x63, 0x43, 0x37, 0xb2, 0x6c, 0xb6, 0xbf, 0x3d, 0xc6, 0x6d, 0xe8, 0x67, 0x35, 0xc6, 0xea, 0xb4,
+	0x9c, 0x7c, 0xaa, 0x20, 0x50, 0x18, 0x3b, 0x08, 0xbc, 0xc6, 0xce, 0x55, 0xa8, 0xae, 0x22, 0xd5,
+	0x25, 0x62, 0x62, 0x4a, 0x55, 0x91, 0x83, 0x48, 0xc6, 0x2b, 0x38, 0xd8, 0x69, 0xde, 0x54, 0x92,
+	0xf1, 0x72, 0x9a, 0xf4, 0x81, 0xde, 0x8c, 0x0c, 0xff, 0xc5, 0xce, 0xe1, 0xff, 0x36, 0xa0, 0x9c,
+	0x7a, 0x09, 0xf0, 0xc5, 0x59, 0x75, 0x7f, 0xcf, 0x80, 0x52, 0xfc, 0x08, 0xe5, 0xcc, 0x1b, 0x8b,
+	0x2d, 0x28, 0x60, 0x2a, 0x89, 0x87, 0xbb, 0x85, 0xd4, 0x43, 0x35, 0x82, 0xe3, 0x4f, 0xd3, 0x52,
+	0x6f, 0x1f, 0xea, 0x9c, 0xd1, 0xfa, 0x47, 0x43, 0x6c, 0x19, 0x12, 0x9b, 0xce, 0x75, 0x28, 0x92,
+	0x36, 0xe5, 0x9f, 0xb6, 0x4d, 0x7f, 0x57, 0x82, 0x49, 0x4a, 0x47, 0xf6, 0x8f, 0x11, 0x0e, 0xba,
+	0xa4, 0x40, 0x47, 0x9b, 0x33, 0xc5, 0xfc, 0x56, 0xc0, 0x64, 0xbf, 0x15, 0x30, 0xf2, 0x40, 0x20,
+	0x39, 0x87, 0xa6, 0x62, 0xf4, 0xef, 0xdf, 0xbe, 0xa9, 0x12, 0xb1, 0xab, 0x2e, 0x29, 0x4e, 0xf5,
+	0x81, 0x40, 0x0a, 0x49, 0xde, 0xff, 0xb4, 0x7c, 0x2f, 0xb2, 0x5d, 0x0f, 0x07, 0x4c, 0x51, 0x5e,
+	0xf7, 0xfe, 0xe7, 0xba, 0x42, 0xc3, 0x8e, 0xf3, 0x54, 0x3e, 0xf5, 0xfd, 0x8f, 0x8a, 0x23, 0xb7,
+	0x61, 0xc5, 0xb6, 0x8a, 0x29, 0x99, 0xd0, 0xdd, 0x86, 0xdd, 0x96, 0x49, 0xd8, 0x94, 0x56, 0xb8,
+	0xd4, 0xdb, 0xb0, 0x0a, 0x8a, 0xdc, 0xb2, 0xee, 0xf9, 0x8e, 0x7a, 0xcb, 0x7a, 0x52, 0x77, 0xcb,
+	0x7a, 0x2f, 0x45, 0xc5, 0x42, 0x71, 0x9a, 0x57, 0xbd, 0x65, 0x9d, 0xc6, 0x92, 0x77, 0x00, 0x1d,
+	0x6c, 0x87, 0x58, 0x3c, 0x34, 0xd0, 0xbe, 0x7f, 0xbb, 0x23, 0x51, 0xb0, 0x40, 0x28, 0xf3, 0xa8,
+	0xef, 0x00, 0x64, 0x0c, 0x19, 0x7d, 0x56, 0x5b, 0x0f, 0xe3, 0xbb, 0xf2, 0x45, 0xdd, 0xe8, 0xef,
+	0xa8, 0x44, 0x6c, 0xf4, 0x53, 0x9c, 0xea, 0xe8, 0xa7, 0x90, 0xe8, 0x0e, 0x8d, 0xf3, 0x6c, 0x48,
+	0xd8, 0x3b, 0xb8, 0xe5, 0x4c, 0x6f, 0xb1, 0xd1, 0x60, 0xe7, 0x90, 0xfc, 0x4b, 0x11, 0x1a, 0x4b,
+	0xe0, 0x63, 0x40, 0x9b, 0x5d, 0xc7, 0x51, 0x3f, 0xf0, 0xb0, 0x63, 0x96, 0x46, 0x8c, 0x81, 0x42,
+	0x15, 0x8f, 0x81, 0x02, 0xcd, 0x8c, 0x81, 0x82, 0x25, 0x73, 0xaa, 0xe7, 0x3b, 0xf7, 0x98, 0xcb,
+	0x44, 0xf1, 0xc3, 0xb8, 0xd5, 0x8c, 0xaa, 0x84, 0x84, 0xcd, 0x29, 0x85, 0x4b, 0x9d, 0x53, 0x0a,
+	0x8a, 0xbf, 0xc5, 0x92, 0x5f, 0xee, 0xb0, 0x9e, 0x9a, 0x1e, 0xf1, 0x16, 0x2b, 0x43, 0x19, 0xbf,
+	0xc5, 0xca, 0x60, 0x32, 0x6f, 0xb1, 0x32, 0x14, 0x44, 0x7b, 0xdb, 0xf6, 0xda, 0xe9, 0x42, 0xb5,
+	0x39, 0xa3, 0xd3, 0xfe, 0xbe, 0x86, 0x92, 0x69, 0xd7, 0xc9, 0x50, 0xb5, 0xeb, 0x28, 0xc8, 0x6d,
+	0x1f, 0x5e, 0x51, 0xfb, 0x89, 0x01, 0xe5, 0x54, 0x9c, 0x41, 0xdf, 0x80, 0xf8, 0x0e, 0xf6, 0xbd,
+	0x93, 0x9e, 0x48, 0x93, 0x95, 0x3b, 0xdb, 0x04, 0xae, 0xbb, 0xb3, 0x4d, 0xe0, 0xe8, 0x0e, 0x40,
+	0xbc, 0x26, 0x9d, 0x16, 0xa4, 0x69, 0x8e, 0x96, 0x50, 0xca, 0x39, 0x5a, 0x02, 0xb5, 0x3e, 0xcd,
+	0xc3, 0x94, 0x98, 0xa8, 0xcf, 0x65, 0xe3, 0xb8, 0x09, 0xc5, 0x2e, 0x0e, 0xe9, 0xdd, 0xed, 0x5c,
+	0x92, 0x0d, 0x71, 0x90, 0x9c, 0x0d, 0x71, 0x90, 0x9a, 0xac, 0xe5, 0x9f, 0x2a, 0x59, 0x9b, 0x18,
+	0x3b, 0x59, 0xc3, 0x50, 0x56, 0xc3, 0xad, 0xb8, 0xa8, 0x74, 0x7a, 0x0c, 0x17, 0xb7, 0x3a, 0x65,
+	0xc6, 0xd4, 0xad, 0x4e, 0x19, 0x85, 0x8e, 0xe1, 0xa2, 0x74, 0x99, 0x4a, 0x2a, 0xb4, 0xcf, 0x8d,
+	0xbe, 0x24, 0x5b, 0xa7, 0x54, 0xcc, 0xbd, 0x8f, 0x53, 0x50, 0x39, 0xdb, 0x4d, 0xe3, 0xac, 0x7f,
+	0xcd, 0xc1, 0x9c, 0x6a, 0xef, 0x73, 0x19, 0xd8, 0xd7, 0xa1, 0x84, 0x1f, 0xb9, 0x51, 0xa3, 0xe5,
+	0x3b, 0x98, 0xef, 0x91, 0x79, 0x71, 0xd7, 0x8d, 0xae, 0xfb, 0x0e, 0x56, 0x8b, 0xbb, 0x0c, 0x26,
+	0xcf, 0x86, 0xfc, 0x58, 0xb3, 0xe1, 0x89, 0x2a, 0xd8, 0xfa, 0x7e, 0x2e, 0x3d, 0xa7, 0x7e, 0xfe,
+	0x38, 0x07, 0xf3, 0xe9, 0x68, 0xfc, 0xcb, 0xe1, 0x42, 0xaa, 0x37, 0xe4, 0xc7, 0xf6, 0x86, 0x77,
+	0x61, 0x96, 0xe4, 0x8e, 0x76, 0x14, 0xf1, 0x37, 0x9c, 0x13, 0x34, 0xe7, 0x62, 0xb1, 0xa9, 0xef,
+	0x6d, 0x09, 0xb8, 0x12, 0x9b, 0x24, 0xb8, 0xf5, 0x5b, 0x39, 0x98, 0x55, 0x56, 0x8d, 0x2f, 0x5e,
+	0x48, 0xb1, 0xca, 0x30, 0xab, 0x24, 0x63, 0xd6, 0x0f, 0xd9, 0x3c, 0x51, 0xb3, 0xa0, 0x2f, 0x5e,
+	0xbf, 0xcc, 0xc1, 0x8c, 0x9c, 0xd5, 0x59, 0x35, 0x28, 0xa7, 0x92, 0x30, 0xb9, 0x01, 0xc6, 0x38,
+	0x0d, 0xb0, 0x96, 0x61, 0x51, 0x97, 0x3b, 0x58, 0xef, 0xc3, 0xa2, 0x6e, 0x55, 0x7f, 0x72, 0x05,
+	0x3f, 0x35, 0xa8, 0x86, 0xec, 0x6b, 0xef, 0x9b, 0x00, 0x1e, 0x7e, 0xd8, 0x78, 0xec, 0xf6, 0x8f,
+	0xf5, 0x27, 0x7e, 0x78, 0x3b, 0xb5, 0x5b, 0x9a, 0x12, 0x30, 0x22, 0xc9, 0xef, 0x38, 0x8d, 0xc7,
+	0x6e, 0xba, 0xa8, 0x24, 0xbf, 0xe3, 0x64, 0x24, 0x09, 0x98, 0xf5, 0x3b, 0x79, 0x28, 0xa7, 0xba,
+	0x03, 0x7d, 0x17, 0xe6, 0x7b, 0xe2, 0xe3, 0xf1, 0xd6, 0xd2, 0xbd, 0x49, 0x4c, 0x9f, 0xd6, 0x34,
+	0xa7, 0x62, 0x54, 0xd9, 0x7c, 0xd3, 0x99, 0x1b, 0x53, 0x76, 0xbd, 0xef, 0x8d, 0x90, 0x4d, 0x31,
+	0xe8, 0x37, 0xe0, 0x22, 0x87, 0x90, 0xb7, 0x5f, 0xdc, 0xf0, 0xfc, 0x48, 0xe1, 0xec, 0x75, 0x77,
+	0xcc, 0x90, 0xb6, 0xbc, 0x9c, 0x42, 0xa5, 0xc4, 0x73, 0xdb, 0x27, 0xc6, 0x15, 0x9f, 0x36, 0xbe,
+	0x9c, 0x42, 0x91, 0x32, 0x41, 0x39, 0xf5, 0x00, 0x1d, 0xdd, 0x80, 0x29, 0xfa, 0xff, 0x69, 0x4e,
+	0x1f, 0x01, 0x3a, 0x21, 0x29, 0x9d, 0xa2, 0xa1, 0xc8, 0x41, 0xe4, 0xd6, 0x77, 0xfc, 0x4e, 0x9d,
+	0x5f, 0x73, 0x64, 0xce, 0x27, 0x80, 0x8a, 0xf3, 0x09, 0xa0, 0xf5, 0x27, 0xec, 0xc0, 0x7e, 0xc4,
+	0xb3, 0xf2, 0x73, 0xae, 0x19, 0x7c, 0xe5, 0x35, 0x98, 0x12, 0x17, 0x11, 0x11, 0x40, 0xe1, 0x5b,
+	0x07, 0xdb, 0x07, 0xdb, 0x37, 0xe6, 0x2f, 0xa0, 0x69, 0x28, 0xee, 0x6d, 0xef, 0xde, 0xb8, 0xb5,
+	0xfb, 0xfe, 0xbc, 0x41, 0x3e, 0xea, 0x07, 0xbb, 0xbb, 0xe4, 0x23, 0xf7, 0x95, 0x3b, 0xf2, 0xb3,
+	0x08, 0xb6, 0x1e, 0xa3, 0x19, 0x98, 0xda, 0xea, 0xf5, 0x68, 0x00, 0x60, 0xbc, 0xfc, 0x0e, 0xdb,
+	0xbc, 0x81, 0x8a, 0x90, 0xbf, 0x7b, 0x77, 0x67, 0x3e, 0x87, 0x16, 0x61, 0xfe, 0x06, 0xb6, 0x9d,
+	0x8e, 0xeb, 0xc5, 0xb7, 0xc8, 0xe6, 0xf3, 0xb5, 0xa3, 0x9f, 0x7d, 0xb6, 0x6e, 0x7c, 0xfa, 0xd9,
+	0xba, 0xf1, 0xf3, 0xcf, 0xd6, 0x8d, 0x8f, 0x3f, 0x5f, 0xbf, 0xf0, 0xe9, 0xe7, 0xeb, 0x17, 0xfe,
+	0xf9, 0xf3, 0xf5, 0x0b, 0xdf, 0x7d, 0x4d, 0xfa, 0x5f, 0x4c, 0xac, 0x4d, 0xbd, 0xc0, 0x27, 0x01,
+	0x97, 0x7f, 0x6d, 0xa6, 0xff, 0x3b, 0xd5, 0x4f, 0x73, 0x6b, 0x5b, 0xf4, 0x73, 0x8f, 0xd1, 0x55,
+	0x6f, 0xf9, 0x55, 0x06, 0xa0, 0xff, 0x40, 0x28, 0x6c, 0x16, 0xe8, 0xed, 0x8d, 0xd7, 0xff, 0x77,
+	0x00, 0xf1, 0x92, 0xb2, 0x9e, 0xd8, 0x4a, 0x00, 0x00,
+}
+
+func (m *EventSequence) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4881,41 +4654,64 @@ func (m *PodSpecWithAvoidList) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PodSpecWithAvoidList) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventSequence) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PodSpecWithAvoidList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventSequence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.NodeAvoidlist) > 0 {
-		for iNdEx := len(m.NodeAvoidlist) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.NodeAvoidlist[iNdEx])
-			copy(dAtA[i:], m.NodeAvoidlist[iNdEx])
-			i = encodeVarintEvents(dAtA, i, uint64(len(m.NodeAvoidlist[iNdEx])))
+	if len(m.Events) > 0 {
+		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Events[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x2a
 		}
 	}
-	if m.PodSpec != nil {
-		{
-			size, err := m.PodSpec.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
+	if len(m.Groups) > 0 {
+		for iNdEx := len(m.Groups) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Groups[iNdEx])
+			copy(dAtA[i:], m.Groups[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.Groups[iNdEx])))
+			i--
+			dAtA[i] = 0x22
 		}
+	}
+	if len(m.UserId) > 0 {
+		i -= len(m.UserId)
+		copy(dAtA[i:], m.UserId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.UserId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.JobSetName) > 0 {
+		i -= len(m.JobSetName)
+		copy(dAtA[i:], m.JobSetName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.JobSetName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Queue) > 0 {
+		i -= len(m.Queue)
+		copy(dAtA[i:], m.Queue)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Queue)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ReprioritiseJob) Marshal() (dAtA []byte, err error) {
+func (m *EventSequence_Event) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4925,64 +4721,50 @@ func (m *ReprioritiseJob) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ReprioritiseJob) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventSequence_Event) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ReprioritiseJob) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventSequence_Event) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Priority != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Priority))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.JobId != nil {
+	if m.Event != nil {
 		{
-			size, err := m.JobId.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
+			size := m.Event.Size()
+			i -= size
+			if _, err := m.Event.MarshalTo(dAtA[i:]); err != nil {
 				return 0, err
 			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
 		}
-		i--
-		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *JobRequeued) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.Created != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Created, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Created):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintEvents(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
 	}
-	return dAtA[:n], nil
+	return len(dAtA) - i, nil
 }
 
-func (m *JobRequeued) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventSequence_Event_SubmitJob) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *JobRequeued) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventSequence_Event_SubmitJob) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.UpdateSequenceNumber != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.UpdateSequenceNumber))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.SchedulingInfo != nil {
+	if m.SubmitJob != nil {
 		{
-			size, err := m.SchedulingInfo.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.SubmitJob.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4990,11 +4772,20 @@ func (m *JobRequeued) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintEvents(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0xa
 	}
-	if m.JobId != nil {
+	return len(dAtA) - i, nil
+}
+func (m *EventSequence_Event_ReprioritiseJob) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSequence_Event_ReprioritiseJob) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ReprioritiseJob != nil {
 		{
-			size, err := m.JobId.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ReprioritiseJob.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5002,67 +4793,41 @@ func (m *JobRequeued) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintEvents(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *ReprioritiseJobSet) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *ReprioritiseJobSet) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventSequence_Event_ReprioritiseJobSet) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ReprioritiseJobSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventSequence_Event_ReprioritiseJobSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Priority != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Priority))
+	if m.ReprioritiseJobSet != nil {
+		{
+			size, err := m.ReprioritiseJobSet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x1a
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *ReprioritisedJob) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *ReprioritisedJob) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventSequence_Event_ReprioritisedJob) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ReprioritisedJob) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventSequence_Event_ReprioritisedJob) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Priority != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Priority))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.JobId != nil {
+	if m.ReprioritisedJob != nil {
 		{
-			size, err := m.JobId.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ReprioritisedJob.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5070,41 +4835,20 @@ func (m *ReprioritisedJob) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintEvents(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x22
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *CancelJob) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *CancelJob) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventSequence_Event_CancelJob) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CancelJob) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventSequence_Event_CancelJob) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Reason) > 0 {
-		i -= len(m.Reason)
-		copy(dAtA[i:], m.Reason)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.JobId != nil {
+	if m.CancelJob != nil {
 		{
-			size, err := m.JobId.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.CancelJob.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5112,130 +4856,62 @@ func (m *CancelJob) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintEvents(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x2a
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *JobSetFilter) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *JobSetFilter) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventSequence_Event_CancelJobSet) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *JobSetFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventSequence_Event_CancelJobSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.States) > 0 {
-		dAtA45 := make([]byte, len(m.States)*10)
-		var j44 int
-		for _, num := range m.States {
-			for num >= 1<<7 {
-				dAtA45[j44] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j44++
+	if m.CancelJobSet != nil {
+		{
+			size, err := m.CancelJobSet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			dAtA45[j44] = uint8(num)
-			j44++
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
 		}
-		i -= j44
-		copy(dAtA[i:], dAtA45[:j44])
-		i = encodeVarintEvents(dAtA, i, uint64(j44))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x32
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *CancelJobSet) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *CancelJobSet) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventSequence_Event_CancelledJob) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CancelJobSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventSequence_Event_CancelledJob) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Reason) > 0 {
-		i -= len(m.Reason)
-		copy(dAtA[i:], m.Reason)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.States) > 0 {
-		dAtA47 := make([]byte, len(m.States)*10)
-		var j46 int
-		for _, num := range m.States {
-			for num >= 1<<7 {
-				dAtA47[j46] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j46++
+	if m.CancelledJob != nil {
+		{
+			size, err := m.CancelledJob.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			dAtA47[j46] = uint8(num)
-			j46++
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
 		}
-		i -= j46
-		copy(dAtA[i:], dAtA47[:j46])
-		i = encodeVarintEvents(dAtA, i, uint64(j46))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x3a
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *CancelledJob) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *CancelledJob) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventSequence_Event_JobSucceeded) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CancelledJob) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventSequence_Event_JobSucceeded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Reason) > 0 {
-		i -= len(m.Reason)
-		copy(dAtA[i:], m.Reason)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.JobId != nil {
+	if m.JobSucceeded != nil {
 		{
-			size, err := m.JobId.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.JobSucceeded.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5243,48 +4919,20 @@ func (m *CancelledJob) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintEvents(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x42
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *JobSucceeded) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *JobSucceeded) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventSequence_Event_JobErrors) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *JobSucceeded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventSequence_Event_JobErrors) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ResourceInfos) > 0 {
-		for iNdEx := len(m.ResourceInfos) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ResourceInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEvents(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if m.JobId != nil {
+	if m.JobErrors != nil {
 		{
-			size, err := m.JobId.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.JobErrors.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5292,68 +4940,20 @@ func (m *JobSucceeded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintEvents(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x4a
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *JobRunLeased) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *JobRunLeased) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventSequence_Event_JobRunLeased) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *JobRunLeased) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventSequence_Event_JobRunLeased) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ScheduledAtPriority != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.ScheduledAtPriority))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.HasScheduledAtPriority {
-		i--
-		if m.HasScheduledAtPriority {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.UpdateSequenceNumber != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.UpdateSequenceNumber))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.NodeId) > 0 {
-		i -= len(m.NodeId)
-		copy(dAtA[i:], m.NodeId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.NodeId)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.ExecutorId) > 0 {
-		i -= len(m.ExecutorId)
-		copy(dAtA[i:], m.ExecutorId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ExecutorId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.JobId != nil {
+	if m.JobRunLeased != nil {
 		{
-			size, err := m.JobId.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.JobRunLeased.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5361,11 +4961,20 @@ func (m *JobRunLeased) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintEvents(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x52
 	}
-	if m.RunId != nil {
+	return len(dAtA) - i, nil
+}
+func (m *EventSequence_Event_JobRunAssigned) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSequence_Event_JobRunAssigned) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.JobRunAssigned != nil {
 		{
-			size, err := m.RunId.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.JobRunAssigned.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5373,48 +4982,20 @@ func (m *JobRunLeased) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintEvents(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x5a
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *JobRunAssigned) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *JobRunAssigned) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventSequence_Event_JobRunRunning) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *JobRunAssigned) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventSequence_Event_JobRunRunning) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ResourceInfos) > 0 {
-		for iNdEx := len(m.ResourceInfos) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ResourceInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEvents(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if m.JobId != nil {
+	if m.JobRunRunning != nil {
 		{
-			size, err := m.JobId.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.JobRunRunning.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5422,11 +5003,20 @@ func (m *JobRunAssigned) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintEvents(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x62
 	}
-	if m.RunId != nil {
+	return len(dAtA) - i, nil
+}
+func (m *EventSequence_Event_JobRunSucceeded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSequence_Event_JobRunSucceeded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.JobRunSucceeded != nil {
 		{
-			size, err := m.RunId.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.JobRunSucceeded.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5434,48 +5024,20 @@ func (m *JobRunAssigned) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintEvents(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x6a
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *JobRunRunning) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *JobRunRunning) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventSequence_Event_JobRunErrors) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *JobRunRunning) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventSequence_Event_JobRunErrors) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ResourceInfos) > 0 {
-		for iNdEx := len(m.ResourceInfos) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ResourceInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEvents(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if m.JobId != nil {
+	if m.JobRunErrors != nil {
 		{
-			size, err := m.JobId.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.JobRunErrors.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5483,11 +5045,20 @@ func (m *JobRunRunning) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintEvents(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x72
 	}
-	if m.RunId != nil {
+	return len(dAtA) - i, nil
+}
+func (m *EventSequence_Event_JobDuplicateDetected) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSequence_Event_JobDuplicateDetected) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.JobDuplicateDetected != nil {
 		{
-			size, err := m.RunId.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.JobDuplicateDetected.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5495,43 +5066,20 @@ func (m *JobRunRunning) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintEvents(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x7a
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *KubernetesResourceInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *KubernetesResourceInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventSequence_Event_StandaloneIngressInfo) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *KubernetesResourceInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventSequence_Event_StandaloneIngressInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Info != nil {
-		{
-			size := m.Info.Size()
-			i -= size
-			if _, err := m.Info.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
-	}
-	if m.ObjectMeta != nil {
+	if m.StandaloneIngressInfo != nil {
 		{
-			size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.StandaloneIngressInfo.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5539,21 +5087,22 @@ func (m *KubernetesResourceInfo) MarshalToSizedBuffer(dAtA []byte) (int, error)
 			i = encodeVarintEvents(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *KubernetesResourceInfo_PodInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventSequence_Event_ResourceUtilisation) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *KubernetesResourceInfo_PodInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventSequence_Event_ResourceUtilisation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.PodInfo != nil {
+	if m.ResourceUtilisation != nil {
 		{
-			size, err := m.PodInfo.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ResourceUtilisation.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5561,20 +5110,22 @@ func (m *KubernetesResourceInfo_PodInfo) MarshalToSizedBuffer(dAtA []byte) (int,
 			i = encodeVarintEvents(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
 	}
 	return len(dAtA) - i, nil
 }
-func (m *KubernetesResourceInfo_IngressInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventSequence_Event_JobRunPreempted) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *KubernetesResourceInfo_IngressInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventSequence_Event_JobRunPreempted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.IngressInfo != nil {
+	if m.JobRunPreempted != nil {
 		{
-			size, err := m.IngressInfo.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.JobRunPreempted.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5582,158 +5133,68 @@ func (m *KubernetesResourceInfo_IngressInfo) MarshalToSizedBuffer(dAtA []byte) (
 			i = encodeVarintEvents(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
 	}
 	return len(dAtA) - i, nil
 }
-func (m *PodInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *PodInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventSequence_Event_PartitionMarker) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PodInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventSequence_Event_PartitionMarker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PodNumber != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.PodNumber))
+	if m.PartitionMarker != nil {
+		{
+			size, err := m.PartitionMarker.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.NodeName) > 0 {
-		i -= len(m.NodeName)
-		copy(dAtA[i:], m.NodeName)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.NodeName)))
+		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0xa2
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *IngressInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *IngressInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventSequence_Event_JobRunPreemptionRequested) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *IngressInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventSequence_Event_JobRunPreemptionRequested) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.IngressAddresses) > 0 {
-		for k := range m.IngressAddresses {
-			v := m.IngressAddresses[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintEvents(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i = encodeVarintEvents(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarintEvents(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x12
+	if m.JobRunPreemptionRequested != nil {
+		{
+			size, err := m.JobRunPreemptionRequested.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
 		}
-	}
-	if len(m.NodeName) > 0 {
-		i -= len(m.NodeName)
-		copy(dAtA[i:], m.NodeName)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.NodeName)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *StandaloneIngressInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *StandaloneIngressInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventSequence_Event_JobRequeued) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *StandaloneIngressInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventSequence_Event_JobRequeued) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PodNamespace) > 0 {
-		i -= len(m.PodNamespace)
-		copy(dAtA[i:], m.PodNamespace)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.PodNamespace)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.PodName) > 0 {
-		i -= len(m.PodName)
-		copy(dAtA[i:], m.PodName)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.PodName)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.PodNumber != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.PodNumber))
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.NodeName) > 0 {
-		i -= len(m.NodeName)
-		copy(dAtA[i:], m.NodeName)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.NodeName)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.IngressAddresses) > 0 {
-		for k := range m.IngressAddresses {
-			v := m.IngressAddresses[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarintEvents(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i = encodeVarintEvents(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarintEvents(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if m.ObjectMeta != nil {
+	if m.JobRequeued != nil {
 		{
-			size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.JobRequeued.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5741,11 +5202,22 @@ func (m *StandaloneIngressInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintEvents(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
 	}
-	if m.JobId != nil {
+	return len(dAtA) - i, nil
+}
+func (m *EventSequence_Event_JobSetExpired) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSequence_Event_JobSetExpired) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.JobSetExpired != nil {
 		{
-			size, err := m.JobId.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.JobSetExpired.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5753,11 +5225,22 @@ func (m *StandaloneIngressInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintEvents(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
 	}
-	if m.RunId != nil {
+	return len(dAtA) - i, nil
+}
+func (m *EventSequence_Event_JobSuspended) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSequence_Event_JobSuspended) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.JobSuspended != nil {
 		{
-			size, err := m.RunId.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.JobSuspended.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5765,48 +5248,45 @@ func (m *StandaloneIngressInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintEvents(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *JobRunSucceeded) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *JobRunSucceeded) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventSequence_Event_JobResumed) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *JobRunSucceeded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventSequence_Event_JobResumed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ResourceInfos) > 0 {
-		for iNdEx := len(m.ResourceInfos) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ResourceInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEvents(dAtA, i, uint64(size))
+	if m.JobResumed != nil {
+		{
+			size, err := m.JobResumed.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0x1a
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
 	}
-	if m.JobId != nil {
+	return len(dAtA) - i, nil
+}
+func (m *EventSequence_Event_JobEvictedForCapacity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSequence_Event_JobEvictedForCapacity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.JobEvictedForCapacity != nil {
 		{
-			size, err := m.JobId.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.JobEvictedForCapacity.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5814,11 +5294,22 @@ func (m *JobRunSucceeded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintEvents(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd2
 	}
-	if m.RunId != nil {
+	return len(dAtA) - i, nil
+}
+func (m *EventSequence_Event_JobRuntimeExceeded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSequence_Event_JobRuntimeExceeded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.JobRuntimeExceeded != nil {
 		{
-			size, err := m.RunId.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.JobRuntimeExceeded.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5826,48 +5317,22 @@ func (m *JobRunSucceeded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintEvents(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xda
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *JobErrors) Marshal() (dAtA []byte, err error) {
+func (m *EventSequence_Event_JobResourcesNormalized) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *JobErrors) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *JobErrors) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventSequence_Event_JobResourcesNormalized) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Errors) > 0 {
-		for iNdEx := len(m.Errors) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Errors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEvents(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if m.JobId != nil {
+	if m.JobResourcesNormalized != nil {
 		{
-			size, err := m.JobId.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.JobResourcesNormalized.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5875,48 +5340,22 @@ func (m *JobErrors) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintEvents(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe2
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *JobRunErrors) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *JobRunErrors) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventSequence_Event_JobUnschedulable) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *JobRunErrors) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventSequence_Event_JobUnschedulable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Errors) > 0 {
-		for iNdEx := len(m.Errors) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Errors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEvents(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if m.JobId != nil {
+	if m.JobUnschedulable != nil {
 		{
-			size, err := m.JobId.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.JobUnschedulable.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5924,75 +5363,45 @@ func (m *JobRunErrors) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintEvents(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
-	}
-	if m.RunId != nil {
-		{
-			size, err := m.RunId.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
-		}
+		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0xea
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *Error) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *Error) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventSequence_Event_JobMaintenanceWindowStarted) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Error) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventSequence_Event_JobMaintenanceWindowStarted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Reason != nil {
+	if m.JobMaintenanceWindowStarted != nil {
 		{
-			size := m.Reason.Size()
-			i -= size
-			if _, err := m.Reason.MarshalTo(dAtA[i:]); err != nil {
+			size, err := m.JobMaintenanceWindowStarted.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
 				return 0, err
 			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
 		}
-	}
-	if m.Terminal {
 		i--
-		if m.Terminal {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+		dAtA[i] = 0x1
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xf2
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *Error_KubernetesError) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventSequence_Event_JobMaintenanceWindowEnded) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Error_KubernetesError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventSequence_Event_JobMaintenanceWindowEnded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.KubernetesError != nil {
+	if m.JobMaintenanceWindowEnded != nil {
 		{
-			size, err := m.KubernetesError.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.JobMaintenanceWindowEnded.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6000,20 +5409,22 @@ func (m *Error_KubernetesError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintEvents(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xfa
 	}
 	return len(dAtA) - i, nil
 }
-func (m *Error_ContainerError) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventSequence_Event_JobCordoned) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Error_ContainerError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventSequence_Event_JobCordoned) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.ContainerError != nil {
+	if m.JobCordoned != nil {
 		{
-			size, err := m.ContainerError.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.JobCordoned.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6021,20 +5432,22 @@ func (m *Error_ContainerError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintEvents(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x82
 	}
 	return len(dAtA) - i, nil
 }
-func (m *Error_ExecutorError) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventSequence_Event_JobUncordoned) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Error_ExecutorError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventSequence_Event_JobUncordoned) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.ExecutorError != nil {
+	if m.JobUncordoned != nil {
 		{
-			size, err := m.ExecutorError.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.JobUncordoned.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6042,41 +5455,83 @@ func (m *Error_ExecutorError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintEvents(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x8a
 	}
 	return len(dAtA) - i, nil
 }
-func (m *Error_PodUnschedulable) MarshalTo(dAtA []byte) (int, error) {
+func (m *ResourceUtilisation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Error_PodUnschedulable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.PodUnschedulable != nil {
-		{
-			size, err := m.PodUnschedulable.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return dAtA[:n], nil
 }
-func (m *Error_LeaseExpired) MarshalTo(dAtA []byte) (int, error) {
+
+func (m *ResourceUtilisation) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Error_LeaseExpired) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ResourceUtilisation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.LeaseExpired != nil {
+	_ = i
+	var l int
+	_ = l
+	if len(m.TotalCumulativeUsage) > 0 {
+		for k := range m.TotalCumulativeUsage {
+			v := m.TotalCumulativeUsage[k]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintEvents(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintEvents(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.MaxResourcesForPeriod) > 0 {
+		for k := range m.MaxResourcesForPeriod {
+			v := m.MaxResourcesForPeriod[k]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintEvents(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintEvents(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.ResourceInfo != nil {
 		{
-			size, err := m.LeaseExpired.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ResourceInfo.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6084,20 +5539,11 @@ func (m *Error_LeaseExpired) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintEvents(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x1a
 	}
-	return len(dAtA) - i, nil
-}
-func (m *Error_MaxRunsExceeded) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Error_MaxRunsExceeded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.MaxRunsExceeded != nil {
+	if m.JobId != nil {
 		{
-			size, err := m.MaxRunsExceeded.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.JobId.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6105,20 +5551,11 @@ func (m *Error_MaxRunsExceeded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintEvents(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x3a
+		dAtA[i] = 0x12
 	}
-	return len(dAtA) - i, nil
-}
-func (m *Error_PodError) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Error_PodError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.PodError != nil {
+	if m.RunId != nil {
 		{
-			size, err := m.PodError.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.RunId.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6126,41 +5563,152 @@ func (m *Error_PodError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintEvents(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x42
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
-func (m *Error_PodLeaseReturned) MarshalTo(dAtA []byte) (int, error) {
+
+func (m *Uuid) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Uuid) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Error_PodLeaseReturned) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Uuid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.PodLeaseReturned != nil {
-		{
-			size, err := m.PodLeaseReturned.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
-		}
+	_ = i
+	var l int
+	_ = l
+	if m.Low64 != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.Low64))
 		i--
-		dAtA[i] = 0x4a
+		dAtA[i] = 0x11
+	}
+	if m.High64 != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(m.High64))
+		i--
+		dAtA[i] = 0x9
 	}
 	return len(dAtA) - i, nil
 }
-func (m *Error_PodTerminated) MarshalTo(dAtA []byte) (int, error) {
+
+func (m *SubmitJob) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubmitJob) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Error_PodTerminated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SubmitJob) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.PodTerminated != nil {
+	_ = i
+	var l int
+	_ = l
+	if m.JobArrayIndex != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.JobArrayIndex))
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.JobArrayId) > 0 {
+		i -= len(m.JobArrayId)
+		copy(dAtA[i:], m.JobArrayId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.JobArrayId)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.QueueTtlSeconds != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.QueueTtlSeconds))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.IsDuplicate {
+		i--
+		if m.IsDuplicate {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.Scheduler) > 0 {
+		i -= len(m.Scheduler)
+		copy(dAtA[i:], m.Scheduler)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Scheduler)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.ConcurrencySafe {
+		i--
+		if m.ConcurrencySafe {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Preemptible {
+		i--
+		if m.Preemptible {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.AtMostOnce {
+		i--
+		if m.AtMostOnce {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Lifetime != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Lifetime))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Objects) > 0 {
+		for iNdEx := len(m.Objects) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Objects[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.MainObject != nil {
 		{
-			size, err := m.PodTerminated.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.MainObject.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6168,20 +5716,11 @@ func (m *Error_PodTerminated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintEvents(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x52
+		dAtA[i] = 0x2a
 	}
-	return len(dAtA) - i, nil
-}
-func (m *Error_JobRunPreemptedError) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Error_JobRunPreemptedError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.JobRunPreemptedError != nil {
+	if m.ObjectMeta != nil {
 		{
-			size, err := m.JobRunPreemptedError.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6189,20 +5728,23 @@ func (m *Error_JobRunPreemptedError) MarshalToSizedBuffer(dAtA []byte) (int, err
 			i = encodeVarintEvents(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x5a
+		dAtA[i] = 0x22
 	}
-	return len(dAtA) - i, nil
-}
-func (m *Error_GangJobUnschedulable) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Error_GangJobUnschedulable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.GangJobUnschedulable != nil {
+	if m.Priority != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.DeduplicationId) > 0 {
+		i -= len(m.DeduplicationId)
+		copy(dAtA[i:], m.DeduplicationId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.DeduplicationId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.JobId != nil {
 		{
-			size, err := m.GangJobUnschedulable.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.JobId.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6210,11 +5752,12 @@ func (m *Error_GangJobUnschedulable) MarshalToSizedBuffer(dAtA []byte) (int, err
 			i = encodeVarintEvents(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x62
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
-func (m *KubernetesError) Marshal() (dAtA []byte, err error) {
+
+func (m *KubernetesMainObject) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6224,19 +5767,28 @@ func (m *KubernetesError) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *KubernetesError) MarshalTo(dAtA []byte) (int, error) {
+func (m *KubernetesMainObject) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *KubernetesError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *KubernetesMainObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ResourceId != nil {
+	if m.Object != nil {
 		{
-			size, err := m.ResourceId.MarshalToSizedBuffer(dAtA[:i])
+			size := m.Object.Size()
+			i -= size
+			if _, err := m.Object.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if m.ObjectMeta != nil {
+		{
+			size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6244,19 +5796,33 @@ func (m *KubernetesError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintEvents(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ResourceType) > 0 {
-		i -= len(m.ResourceType)
-		copy(dAtA[i:], m.ResourceType)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ResourceType)))
-		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *PodError) Marshal() (dAtA []byte, err error) {
+func (m *KubernetesMainObject_PodSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KubernetesMainObject_PodSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.PodSpec != nil {
+		{
+			size, err := m.PodSpec.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *KubernetesObject) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6266,57 +5832,92 @@ func (m *PodError) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PodError) MarshalTo(dAtA []byte) (int, error) {
+func (m *KubernetesObject) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PodError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *KubernetesObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.KubernetesReason != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.KubernetesReason))
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.ContainerErrors) > 0 {
-		for iNdEx := len(m.ContainerErrors) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ContainerErrors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEvents(dAtA, i, uint64(size))
+	if m.Object != nil {
+		{
+			size := m.Object.Size()
+			i -= size
+			if _, err := m.Object.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0x2a
 		}
 	}
-	if m.PodNumber != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.PodNumber))
+	if m.ObjectMeta != nil {
+		{
+			size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0xa
 	}
-	if len(m.NodeName) > 0 {
-		i -= len(m.NodeName)
-		copy(dAtA[i:], m.NodeName)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.NodeName)))
+	return len(dAtA) - i, nil
+}
+
+func (m *KubernetesObject_PodSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KubernetesObject_PodSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.PodSpec != nil {
+		{
+			size, err := m.PodSpec.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x12
 	}
-	if len(m.Message) > 0 {
-		i -= len(m.Message)
-		copy(dAtA[i:], m.Message)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Message)))
+	return len(dAtA) - i, nil
+}
+func (m *KubernetesObject_Ingress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KubernetesObject_Ingress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Ingress != nil {
+		{
+			size, err := m.Ingress.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x1a
 	}
-	if m.ObjectMeta != nil {
+	return len(dAtA) - i, nil
+}
+func (m *KubernetesObject_Service) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KubernetesObject_Service) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Service != nil {
 		{
-			size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Service.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6324,12 +5925,32 @@ func (m *PodError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintEvents(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x22
 	}
 	return len(dAtA) - i, nil
 }
+func (m *KubernetesObject_ConfigMap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
 
-func (m *ContainerError) Marshal() (dAtA []byte, err error) {
+func (m *KubernetesObject_ConfigMap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ConfigMap != nil {
+		{
+			size, err := m.ConfigMap.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ObjectMeta) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6339,56 +5960,86 @@ func (m *ContainerError) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ContainerError) MarshalTo(dAtA []byte) (int, error) {
+func (m *ObjectMeta) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ContainerError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ObjectMeta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.KubernetesReason != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.KubernetesReason))
-		i--
-		dAtA[i] = 0x48
+	if len(m.Labels) > 0 {
+		for k := range m.Labels {
+			v := m.Labels[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintEvents(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintEvents(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintEvents(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x32
+		}
 	}
-	if len(m.Reason) > 0 {
-		i -= len(m.Reason)
-		copy(dAtA[i:], m.Reason)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
+	if len(m.Annotations) > 0 {
+		for k := range m.Annotations {
+			v := m.Annotations[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintEvents(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintEvents(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintEvents(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.KubernetesId) > 0 {
+		i -= len(m.KubernetesId)
+		copy(dAtA[i:], m.KubernetesId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.KubernetesId)))
 		i--
 		dAtA[i] = 0x22
 	}
-	if len(m.Message) > 0 {
-		i -= len(m.Message)
-		copy(dAtA[i:], m.Message)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Message)))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Name)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if m.ExitCode != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.ExitCode))
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Namespace)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if m.ObjectMeta != nil {
-		{
-			size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
-		}
+	if len(m.ExecutorId) > 0 {
+		i -= len(m.ExecutorId)
+		copy(dAtA[i:], m.ExecutorId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ExecutorId)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *PodLeaseReturned) Marshal() (dAtA []byte, err error) {
+func (m *PodSpecWithAvoidList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6398,41 +6049,28 @@ func (m *PodLeaseReturned) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PodLeaseReturned) MarshalTo(dAtA []byte) (int, error) {
+func (m *PodSpecWithAvoidList) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PodLeaseReturned) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PodSpecWithAvoidList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.RunAttempted {
-		i--
-		if m.RunAttempted {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if len(m.NodeAvoidlist) > 0 {
+		for iNdEx := len(m.NodeAvoidlist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.NodeAvoidlist[iNdEx])
+			copy(dAtA[i:], m.NodeAvoidlist[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.NodeAvoidlist[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.PodNumber != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.PodNumber))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Message) > 0 {
-		i -= len(m.Message)
-		copy(dAtA[i:], m.Message)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Message)))
-		i--
-		dAtA[i] = 0x12
 	}
-	if m.ObjectMeta != nil {
+	if m.PodSpec != nil {
 		{
-			size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.PodSpec.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6445,7 +6083,7 @@ func (m *PodLeaseReturned) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *PodTerminated) Marshal() (dAtA []byte, err error) {
+func (m *ReprioritiseJob) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6455,38 +6093,24 @@ func (m *PodTerminated) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PodTerminated) MarshalTo(dAtA []byte) (int, error) {
+func (m *ReprioritiseJob) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PodTerminated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ReprioritiseJob) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.PodNumber != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.PodNumber))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.NodeName) > 0 {
-		i -= len(m.NodeName)
-		copy(dAtA[i:], m.NodeName)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.NodeName)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Message) > 0 {
-		i -= len(m.Message)
-		copy(dAtA[i:], m.Message)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Message)))
+	if m.Priority != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Priority))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
-	if m.ObjectMeta != nil {
+	if m.JobId != nil {
 		{
-			size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.JobId.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6499,7 +6123,7 @@ func (m *PodTerminated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ExecutorError) Marshal() (dAtA []byte, err error) {
+func (m *JobRequeued) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6509,61 +6133,36 @@ func (m *ExecutorError) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ExecutorError) MarshalTo(dAtA []byte) (int, error) {
+func (m *JobRequeued) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ExecutorError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JobRequeued) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *PodUnschedulable) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.UpdateSequenceNumber != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.UpdateSequenceNumber))
+		i--
+		dAtA[i] = 0x18
 	}
-	return dAtA[:n], nil
-}
-
-func (m *PodUnschedulable) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *PodUnschedulable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PodNumber != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.PodNumber))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.NodeName) > 0 {
-		i -= len(m.NodeName)
-		copy(dAtA[i:], m.NodeName)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.NodeName)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Message) > 0 {
-		i -= len(m.Message)
-		copy(dAtA[i:], m.Message)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Message)))
+	if m.SchedulingInfo != nil {
+		{
+			size, err := m.SchedulingInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.ObjectMeta != nil {
+	if m.JobId != nil {
 		{
-			size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.JobId.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6576,7 +6175,7 @@ func (m *PodUnschedulable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *LeaseExpired) Marshal() (dAtA []byte, err error) {
+func (m *ReprioritiseJobSet) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6586,20 +6185,25 @@ func (m *LeaseExpired) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *LeaseExpired) MarshalTo(dAtA []byte) (int, error) {
+func (m *ReprioritiseJobSet) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *LeaseExpired) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ReprioritiseJobSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Priority != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MaxRunsExceeded) Marshal() (dAtA []byte, err error) {
+func (m *ReprioritisedJob) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6609,27 +6213,37 @@ func (m *MaxRunsExceeded) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MaxRunsExceeded) MarshalTo(dAtA []byte) (int, error) {
+func (m *ReprioritisedJob) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MaxRunsExceeded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ReprioritisedJob) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Message) > 0 {
-		i -= len(m.Message)
-		copy(dAtA[i:], m.Message)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Message)))
+	if m.Priority != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.JobId != nil {
+		{
+			size, err := m.JobId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *JobRunPreemptedError) Marshal() (dAtA []byte, err error) {
+func (m *CancelJob) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6639,20 +6253,39 @@ func (m *JobRunPreemptedError) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *JobRunPreemptedError) MarshalTo(dAtA []byte) (int, error) {
+func (m *CancelJob) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *JobRunPreemptedError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CancelJob) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.JobId != nil {
+		{
+			size, err := m.JobId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GangJobUnschedulable) Marshal() (dAtA []byte, err error) {
+func (m *JobSetFilter) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6662,27 +6295,38 @@ func (m *GangJobUnschedulable) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GangJobUnschedulable) MarshalTo(dAtA []byte) (int, error) {
+func (m *JobSetFilter) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GangJobUnschedulable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JobSetFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Message) > 0 {
-		i -= len(m.Message)
-		copy(dAtA[i:], m.Message)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Message)))
+	if len(m.States) > 0 {
+		dAtA56 := make([]byte, len(m.States)*10)
+		var j55 int
+		for _, num := range m.States {
+			for num >= 1<<7 {
+				dAtA56[j55] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j55++
+			}
+			dAtA56[j55] = uint8(num)
+			j55++
+		}
+		i -= j55
+		copy(dAtA[i:], dAtA56[:j55])
+		i = encodeVarintEvents(dAtA, i, uint64(j55))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *JobDuplicateDetected) Marshal() (dAtA []byte, err error) {
+func (m *CancelJobSet) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6692,44 +6336,45 @@ func (m *JobDuplicateDetected) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *JobDuplicateDetected) MarshalTo(dAtA []byte) (int, error) {
+func (m *CancelJobSet) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *JobDuplicateDetected) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CancelJobSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.OldJobId != nil {
-		{
-			size, err := m.OldJobId.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
-		}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.NewJobId != nil {
-		{
-			size, err := m.NewJobId.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.States) > 0 {
+		dAtA58 := make([]byte, len(m.States)*10)
+		var j57 int
+		for _, num := range m.States {
+			for num >= 1<<7 {
+				dAtA58[j57] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j57++
 			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
+			dAtA58[j57] = uint8(num)
+			j57++
 		}
+		i -= j57
+		copy(dAtA[i:], dAtA58[:j57])
+		i = encodeVarintEvents(dAtA, i, uint64(j57))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *JobRunPreempted) Marshal() (dAtA []byte, err error) {
+func (m *CancelledJob) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6739,31 +6384,26 @@ func (m *JobRunPreempted) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *JobRunPreempted) MarshalTo(dAtA []byte) (int, error) {
+func (m *CancelledJob) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *JobRunPreempted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CancelledJob) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.PreemptiveRunId != nil {
-		{
-			size, err := m.PreemptiveRunId.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
-		}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x12
 	}
-	if m.PreemptiveJobId != nil {
+	if m.JobId != nil {
 		{
-			size, err := m.PreemptiveJobId.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.JobId.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6771,23 +6411,39 @@ func (m *JobRunPreempted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintEvents(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0xa
 	}
-	if m.PreemptedRunId != nil {
-		{
-			size, err := m.PreemptedRunId.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobSetExpired) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobSetExpired) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobSetExpired) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.JobSetTtlSeconds != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.JobSetTtlSeconds))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
-	if m.PreemptedJobId != nil {
+	if m.JobId != nil {
 		{
-			size, err := m.PreemptedJobId.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.JobId.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6800,7 +6456,7 @@ func (m *JobRunPreempted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *PartitionMarker) Marshal() (dAtA []byte, err error) {
+func (m *JobSuspended) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6810,24 +6466,19 @@ func (m *PartitionMarker) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PartitionMarker) MarshalTo(dAtA []byte) (int, error) {
+func (m *JobSuspended) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PartitionMarker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JobSuspended) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Partition != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Partition))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.GroupId != nil {
+	if m.JobId != nil {
 		{
-			size, err := m.GroupId.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.JobId.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6840,7 +6491,7 @@ func (m *PartitionMarker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *JobRunPreemptionRequested) Marshal() (dAtA []byte, err error) {
+func (m *JobResumed) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -6850,12 +6501,12 @@ func (m *JobRunPreemptionRequested) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *JobRunPreemptionRequested) MarshalTo(dAtA []byte) (int, error) {
+func (m *JobResumed) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *JobRunPreemptionRequested) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JobResumed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -6870,11 +6521,41 @@ func (m *JobRunPreemptionRequested) MarshalToSizedBuffer(dAtA []byte) (int, erro
 			i = encodeVarintEvents(dAtA, i, uint64(size))
 		}
 		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobEvictedForCapacity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobEvictedForCapacity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobEvictedForCapacity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExecutorId) > 0 {
+		i -= len(m.ExecutorId)
+		copy(dAtA[i:], m.ExecutorId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ExecutorId)))
+		i--
 		dAtA[i] = 0x12
 	}
-	if m.RunId != nil {
+	if m.JobId != nil {
 		{
-			size, err := m.RunId.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.JobId.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -6887,1429 +6568,5714 @@ func (m *JobRunPreemptionRequested) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *JobRuntimeExceeded) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *EventSequence) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *JobRuntimeExceeded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobRuntimeExceeded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Queue)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.JobSetName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.UserId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.MaxRuntimeSeconds != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MaxRuntimeSeconds))
+		i--
+		dAtA[i] = 0x18
 	}
-	if len(m.Groups) > 0 {
-		for _, s := range m.Groups {
-			l = len(s)
-			n += 1 + l + sovEvents(uint64(l))
-		}
+	if len(m.ExecutorId) > 0 {
+		i -= len(m.ExecutorId)
+		copy(dAtA[i:], m.ExecutorId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ExecutorId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Events) > 0 {
-		for _, e := range m.Events {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
+	if m.JobId != nil {
+		{
+			size, err := m.JobId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventSequence_Event) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Event != nil {
-		n += m.Event.Size()
-	}
-	if m.Created != nil {
-		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Created)
-		n += 2 + l + sovEvents(uint64(l))
+func (m *JobResourcesNormalized) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *EventSequence_Event_SubmitJob) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.SubmitJob != nil {
-		l = m.SubmitJob.Size()
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	return n
+func (m *JobResourcesNormalized) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *EventSequence_Event_ReprioritiseJob) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *JobResourcesNormalized) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ReprioritiseJob != nil {
-		l = m.ReprioritiseJob.Size()
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.NormalizedResources) > 0 {
+		for k := range m.NormalizedResources {
+			v := m.NormalizedResources[k]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintEvents(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintEvents(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	return n
-}
-func (m *EventSequence_Event_ReprioritiseJobSet) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.OriginalResources) > 0 {
+		for k := range m.OriginalResources {
+			v := m.OriginalResources[k]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintEvents(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintEvents(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	var l int
-	_ = l
-	if m.ReprioritiseJobSet != nil {
-		l = m.ReprioritiseJobSet.Size()
-		n += 1 + l + sovEvents(uint64(l))
+	if m.JobId != nil {
+		{
+			size, err := m.JobId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *EventSequence_Event_ReprioritisedJob) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ReprioritisedJob != nil {
-		l = m.ReprioritisedJob.Size()
-		n += 1 + l + sovEvents(uint64(l))
+
+func (m *JobUnschedulable) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
-func (m *EventSequence_Event_CancelJob) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.CancelJob != nil {
-		l = m.CancelJob.Size()
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	return n
+
+func (m *JobUnschedulable) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *EventSequence_Event_CancelJobSet) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *JobUnschedulable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.CancelJobSet != nil {
-		l = m.CancelJobSet.Size()
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if m.JobId != nil {
+		{
+			size, err := m.JobId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
-func (m *EventSequence_Event_CancelledJob) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *JobMaintenanceWindowStarted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *JobMaintenanceWindowStarted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobMaintenanceWindowStarted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.CancelledJob != nil {
-		l = m.CancelledJob.Size()
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x32
 	}
-	return n
-}
-func (m *EventSequence_Event_JobSucceeded) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.Drain {
+		i--
+		if m.Drain {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
 	}
-	var l int
-	_ = l
-	if m.JobSucceeded != nil {
-		l = m.JobSucceeded.Size()
-		n += 1 + l + sovEvents(uint64(l))
+	n69, err69 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.End, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.End):])
+	if err69 != nil {
+		return 0, err69
+	}
+	i -= n69
+	i = encodeVarintEvents(dAtA, i, uint64(n69))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Executor) > 0 {
+		i -= len(m.Executor)
+		copy(dAtA[i:], m.Executor)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Executor)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
-}
-func (m *EventSequence_Event_JobErrors) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.MaintenanceWindowId) > 0 {
+		i -= len(m.MaintenanceWindowId)
+		copy(dAtA[i:], m.MaintenanceWindowId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MaintenanceWindowId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	var l int
-	_ = l
-	if m.JobErrors != nil {
-		l = m.JobErrors.Size()
-		n += 1 + l + sovEvents(uint64(l))
+	if m.JobId != nil {
+		{
+			size, err := m.JobId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *EventSequence_Event_JobRunLeased) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.JobRunLeased != nil {
-		l = m.JobRunLeased.Size()
-		n += 1 + l + sovEvents(uint64(l))
+
+func (m *JobMaintenanceWindowEnded) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
-func (m *EventSequence_Event_JobRunAssigned) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.JobRunAssigned != nil {
-		l = m.JobRunAssigned.Size()
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	return n
+
+func (m *JobMaintenanceWindowEnded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *EventSequence_Event_JobRunRunning) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *JobMaintenanceWindowEnded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.JobRunRunning != nil {
-		l = m.JobRunRunning.Size()
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Executor) > 0 {
+		i -= len(m.Executor)
+		copy(dAtA[i:], m.Executor)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Executor)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
-}
-func (m *EventSequence_Event_JobRunSucceeded) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.MaintenanceWindowId) > 0 {
+		i -= len(m.MaintenanceWindowId)
+		copy(dAtA[i:], m.MaintenanceWindowId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MaintenanceWindowId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	var l int
-	_ = l
-	if m.JobRunSucceeded != nil {
-		l = m.JobRunSucceeded.Size()
-		n += 1 + l + sovEvents(uint64(l))
+	if m.JobId != nil {
+		{
+			size, err := m.JobId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *EventSequence_Event_JobRunErrors) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.JobRunErrors != nil {
-		l = m.JobRunErrors.Size()
-		n += 1 + l + sovEvents(uint64(l))
+
+func (m *JobCordoned) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
-func (m *EventSequence_Event_JobDuplicateDetected) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.JobDuplicateDetected != nil {
-		l = m.JobDuplicateDetected.Size()
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	return n
+
+func (m *JobCordoned) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *EventSequence_Event_StandaloneIngressInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *JobCordoned) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.StandaloneIngressInfo != nil {
-		l = m.StandaloneIngressInfo.Size()
-		n += 2 + l + sovEvents(uint64(l))
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
 	}
-	return n
-}
-func (m *EventSequence_Event_ResourceUtilisation) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.CordonedBy) > 0 {
+		i -= len(m.CordonedBy)
+		copy(dAtA[i:], m.CordonedBy)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.CordonedBy)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	var l int
-	_ = l
-	if m.ResourceUtilisation != nil {
-		l = m.ResourceUtilisation.Size()
-		n += 2 + l + sovEvents(uint64(l))
+	if len(m.Executor) > 0 {
+		i -= len(m.Executor)
+		copy(dAtA[i:], m.Executor)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Executor)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if m.JobId != nil {
+		{
+			size, err := m.JobId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
-func (m *EventSequence_Event_JobRunPreempted) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *JobUncordoned) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *JobUncordoned) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobUncordoned) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.JobRunPreempted != nil {
-		l = m.JobRunPreempted.Size()
-		n += 2 + l + sovEvents(uint64(l))
+	if len(m.UncordonedBy) > 0 {
+		i -= len(m.UncordonedBy)
+		copy(dAtA[i:], m.UncordonedBy)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.UncordonedBy)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
-}
-func (m *EventSequence_Event_PartitionMarker) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Executor) > 0 {
+		i -= len(m.Executor)
+		copy(dAtA[i:], m.Executor)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Executor)))
+		i--
+		dAtA[i] = 0x12
 	}
-	var l int
-	_ = l
-	if m.PartitionMarker != nil {
-		l = m.PartitionMarker.Size()
-		n += 2 + l + sovEvents(uint64(l))
+	if m.JobId != nil {
+		{
+			size, err := m.JobId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *EventSequence_Event_JobRunPreemptionRequested) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *JobSucceeded) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *JobSucceeded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobSucceeded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.JobRunPreemptionRequested != nil {
-		l = m.JobRunPreemptionRequested.Size()
-		n += 2 + l + sovEvents(uint64(l))
-	}
-	return n
-}
-func (m *EventSequence_Event_JobRequeued) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.JobRequeued != nil {
-		l = m.JobRequeued.Size()
-		n += 2 + l + sovEvents(uint64(l))
-	}
-	return n
-}
-func (m *ResourceUtilisation) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.RunId != nil {
-		l = m.RunId.Size()
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.JobId != nil {
-		l = m.JobId.Size()
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.ResourceInfo != nil {
-		l = m.ResourceInfo.Size()
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if len(m.MaxResourcesForPeriod) > 0 {
-		for k, v := range m.MaxResourcesForPeriod {
-			_ = k
-			_ = v
-			l = v.Size()
-			mapEntrySize := 1 + len(k) + sovEvents(uint64(len(k))) + 1 + l + sovEvents(uint64(l))
-			n += mapEntrySize + 1 + sovEvents(uint64(mapEntrySize))
+	if len(m.ResourceInfos) > 0 {
+		for iNdEx := len(m.ResourceInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ResourceInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if len(m.TotalCumulativeUsage) > 0 {
-		for k, v := range m.TotalCumulativeUsage {
-			_ = k
-			_ = v
-			l = v.Size()
-			mapEntrySize := 1 + len(k) + sovEvents(uint64(len(k))) + 1 + l + sovEvents(uint64(l))
-			n += mapEntrySize + 1 + sovEvents(uint64(mapEntrySize))
+	if m.JobId != nil {
+		{
+			size, err := m.JobId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Uuid) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.High64 != 0 {
-		n += 9
-	}
-	if m.Low64 != 0 {
-		n += 9
+func (m *JobRunLeased) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *SubmitJob) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *JobRunLeased) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobRunLeased) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.JobId != nil {
-		l = m.JobId.Size()
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.DeduplicationId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.Priority != 0 {
-		n += 1 + sovEvents(uint64(m.Priority))
-	}
-	if m.ObjectMeta != nil {
-		l = m.ObjectMeta.Size()
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Pool) > 0 {
+		i -= len(m.Pool)
+		copy(dAtA[i:], m.Pool)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Pool)))
+		i--
+		dAtA[i] = 0x42
 	}
-	if m.MainObject != nil {
-		l = m.MainObject.Size()
-		n += 1 + l + sovEvents(uint64(l))
+	if m.ScheduledAtPriority != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ScheduledAtPriority))
+		i--
+		dAtA[i] = 0x38
 	}
-	if len(m.Objects) > 0 {
-		for _, e := range m.Objects {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
+	if m.HasScheduledAtPriority {
+		i--
+		if m.HasScheduledAtPriority {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.Lifetime != 0 {
-		n += 1 + sovEvents(uint64(m.Lifetime))
-	}
-	if m.AtMostOnce {
-		n += 2
-	}
-	if m.Preemptible {
-		n += 2
+	if m.UpdateSequenceNumber != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.UpdateSequenceNumber))
+		i--
+		dAtA[i] = 0x28
 	}
-	if m.ConcurrencySafe {
-		n += 2
+	if len(m.NodeId) > 0 {
+		i -= len(m.NodeId)
+		copy(dAtA[i:], m.NodeId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.NodeId)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.Scheduler)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.ExecutorId) > 0 {
+		i -= len(m.ExecutorId)
+		copy(dAtA[i:], m.ExecutorId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ExecutorId)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.IsDuplicate {
-		n += 2
+	if m.JobId != nil {
+		{
+			size, err := m.JobId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.QueueTtlSeconds != 0 {
-		n += 1 + sovEvents(uint64(m.QueueTtlSeconds))
+	if m.RunId != nil {
+		{
+			size, err := m.RunId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *KubernetesMainObject) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ObjectMeta != nil {
-		l = m.ObjectMeta.Size()
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.Object != nil {
-		n += m.Object.Size()
+func (m *JobRunAssigned) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *KubernetesMainObject_PodSpec) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PodSpec != nil {
-		l = m.PodSpec.Size()
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	return n
-}
-func (m *KubernetesObject) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ObjectMeta != nil {
-		l = m.ObjectMeta.Size()
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.Object != nil {
-		n += m.Object.Size()
-	}
-	return n
+func (m *JobRunAssigned) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *KubernetesObject_PodSpec) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *JobRunAssigned) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.PodSpec != nil {
-		l = m.PodSpec.Size()
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.ResourceInfos) > 0 {
+		for iNdEx := len(m.ResourceInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ResourceInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	return n
-}
-func (m *KubernetesObject_Ingress) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.JobId != nil {
+		{
+			size, err := m.JobId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	var l int
-	_ = l
-	if m.Ingress != nil {
-		l = m.Ingress.Size()
-		n += 1 + l + sovEvents(uint64(l))
+	if m.RunId != nil {
+		{
+			size, err := m.RunId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *KubernetesObject_Service) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Service != nil {
-		l = m.Service.Size()
-		n += 1 + l + sovEvents(uint64(l))
+
+func (m *JobRunRunning) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
-func (m *KubernetesObject_ConfigMap) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ConfigMap != nil {
-		l = m.ConfigMap.Size()
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	return n
+
+func (m *JobRunRunning) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *ObjectMeta) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *JobRunRunning) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ExecutorId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.KubernetesId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.ResourceInfos) > 0 {
+		for iNdEx := len(m.ResourceInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ResourceInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	if len(m.Annotations) > 0 {
-		for k, v := range m.Annotations {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovEvents(uint64(len(k))) + 1 + len(v) + sovEvents(uint64(len(v)))
-			n += mapEntrySize + 1 + sovEvents(uint64(mapEntrySize))
+	if m.JobId != nil {
+		{
+			size, err := m.JobId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Labels) > 0 {
-		for k, v := range m.Labels {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovEvents(uint64(len(k))) + 1 + len(v) + sovEvents(uint64(len(v)))
-			n += mapEntrySize + 1 + sovEvents(uint64(mapEntrySize))
+	if m.RunId != nil {
+		{
+			size, err := m.RunId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *PodSpecWithAvoidList) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PodSpec != nil {
-		l = m.PodSpec.Size()
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if len(m.NodeAvoidlist) > 0 {
-		for _, s := range m.NodeAvoidlist {
-			l = len(s)
-			n += 1 + l + sovEvents(uint64(l))
-		}
+func (m *KubernetesResourceInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ReprioritiseJob) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.JobId != nil {
-		l = m.JobId.Size()
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.Priority != 0 {
-		n += 1 + sovEvents(uint64(m.Priority))
-	}
-	return n
+func (m *KubernetesResourceInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *JobRequeued) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *KubernetesResourceInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.JobId != nil {
-		l = m.JobId.Size()
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.SchedulingInfo != nil {
-		l = m.SchedulingInfo.Size()
-		n += 1 + l + sovEvents(uint64(l))
+	if m.Info != nil {
+		{
+			size := m.Info.Size()
+			i -= size
+			if _, err := m.Info.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
 	}
-	if m.UpdateSequenceNumber != 0 {
-		n += 1 + sovEvents(uint64(m.UpdateSequenceNumber))
+	if m.ObjectMeta != nil {
+		{
+			size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ReprioritiseJobSet) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Priority != 0 {
-		n += 1 + sovEvents(uint64(m.Priority))
-	}
-	return n
+func (m *KubernetesResourceInfo_PodInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ReprioritisedJob) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *KubernetesResourceInfo_PodInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.PodInfo != nil {
+		{
+			size, err := m.PodInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	var l int
-	_ = l
-	if m.JobId != nil {
-		l = m.JobId.Size()
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.Priority != 0 {
-		n += 1 + sovEvents(uint64(m.Priority))
-	}
-	return n
+	return len(dAtA) - i, nil
+}
+func (m *KubernetesResourceInfo_IngressInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CancelJob) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.JobId != nil {
-		l = m.JobId.Size()
-		n += 1 + l + sovEvents(uint64(l))
+func (m *KubernetesResourceInfo_IngressInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.IngressInfo != nil {
+		{
+			size, err := m.IngressInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Reason)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return len(dAtA) - i, nil
+}
+func (m *PodInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *JobSetFilter) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.States) > 0 {
-		l = 0
-		for _, e := range m.States {
-			l += sovEvents(uint64(e))
-		}
-		n += 1 + sovEvents(uint64(l)) + l
-	}
-	return n
+func (m *PodInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *CancelJobSet) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *PodInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.States) > 0 {
-		l = 0
-		for _, e := range m.States {
-			l += sovEvents(uint64(e))
+	if len(m.NodeLabels) > 0 {
+		for k := range m.NodeLabels {
+			v := m.NodeLabels[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintEvents(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintEvents(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintEvents(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
 		}
-		n += 1 + sovEvents(uint64(l)) + l
-	}
-	l = len(m.Reason)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
 	}
-	return n
-}
-
-func (m *CancelledJob) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Pool) > 0 {
+		i -= len(m.Pool)
+		copy(dAtA[i:], m.Pool)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Pool)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	var l int
-	_ = l
-	if m.JobId != nil {
-		l = m.JobId.Size()
-		n += 1 + l + sovEvents(uint64(l))
+	if m.PodNumber != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PodNumber))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.Reason)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.NodeName) > 0 {
+		i -= len(m.NodeName)
+		copy(dAtA[i:], m.NodeName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.NodeName)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *JobSucceeded) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.JobId != nil {
-		l = m.JobId.Size()
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if len(m.ResourceInfos) > 0 {
-		for _, e := range m.ResourceInfos {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
-		}
+func (m *IngressInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *JobRunLeased) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.RunId != nil {
-		l = m.RunId.Size()
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.JobId != nil {
-		l = m.JobId.Size()
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.ExecutorId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.NodeId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.UpdateSequenceNumber != 0 {
-		n += 1 + sovEvents(uint64(m.UpdateSequenceNumber))
-	}
-	if m.HasScheduledAtPriority {
-		n += 2
-	}
-	if m.ScheduledAtPriority != 0 {
-		n += 1 + sovEvents(uint64(m.ScheduledAtPriority))
-	}
-	return n
+func (m *IngressInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *JobRunAssigned) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *IngressInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.RunId != nil {
-		l = m.RunId.Size()
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.JobId != nil {
-		l = m.JobId.Size()
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if len(m.ResourceInfos) > 0 {
-		for _, e := range m.ResourceInfos {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
+	if len(m.IngressAddresses) > 0 {
+		for k := range m.IngressAddresses {
+			v := m.IngressAddresses[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintEvents(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i = encodeVarintEvents(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintEvents(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return n
+	if len(m.NodeName) > 0 {
+		i -= len(m.NodeName)
+		copy(dAtA[i:], m.NodeName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.NodeName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *JobRunRunning) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *StandaloneIngressInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *StandaloneIngressInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StandaloneIngressInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.RunId != nil {
-		l = m.RunId.Size()
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.PodNamespace) > 0 {
+		i -= len(m.PodNamespace)
+		copy(dAtA[i:], m.PodNamespace)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PodNamespace)))
+		i--
+		dAtA[i] = 0x42
 	}
-	if m.JobId != nil {
-		l = m.JobId.Size()
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.PodName) > 0 {
+		i -= len(m.PodName)
+		copy(dAtA[i:], m.PodName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PodName)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	if len(m.ResourceInfos) > 0 {
-		for _, e := range m.ResourceInfos {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
-		}
+	if m.PodNumber != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PodNumber))
+		i--
+		dAtA[i] = 0x30
 	}
-	return n
-}
-
-func (m *KubernetesResourceInfo) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.NodeName) > 0 {
+		i -= len(m.NodeName)
+		copy(dAtA[i:], m.NodeName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.NodeName)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.IngressAddresses) > 0 {
+		for k := range m.IngressAddresses {
+			v := m.IngressAddresses[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintEvents(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i = encodeVarintEvents(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarintEvents(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	var l int
-	_ = l
 	if m.ObjectMeta != nil {
-		l = m.ObjectMeta.Size()
-		n += 1 + l + sovEvents(uint64(l))
+		{
+			size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.Info != nil {
-		n += m.Info.Size()
+	if m.JobId != nil {
+		{
+			size, err := m.JobId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if m.RunId != nil {
+		{
+			size, err := m.RunId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *KubernetesResourceInfo_PodInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PodInfo != nil {
-		l = m.PodInfo.Size()
-		n += 1 + l + sovEvents(uint64(l))
+func (m *JobRunSucceeded) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
-func (m *KubernetesResourceInfo_IngressInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.IngressInfo != nil {
-		l = m.IngressInfo.Size()
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	return n
+
+func (m *JobRunSucceeded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *PodInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *JobRunSucceeded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.NodeName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.ResourceInfos) > 0 {
+		for iNdEx := len(m.ResourceInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ResourceInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	if m.PodNumber != 0 {
-		n += 1 + sovEvents(uint64(m.PodNumber))
+	if m.JobId != nil {
+		{
+			size, err := m.JobId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if m.RunId != nil {
+		{
+			size, err := m.RunId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *IngressInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *JobErrors) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *JobErrors) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobErrors) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.NodeName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Errors) > 0 {
+		for iNdEx := len(m.Errors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Errors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if len(m.IngressAddresses) > 0 {
-		for k, v := range m.IngressAddresses {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + sovEvents(uint64(k)) + 1 + len(v) + sovEvents(uint64(len(v)))
-			n += mapEntrySize + 1 + sovEvents(uint64(mapEntrySize))
+	if m.JobId != nil {
+		{
+			size, err := m.JobId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *StandaloneIngressInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *JobRunErrors) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *JobRunErrors) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobRunErrors) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.RunId != nil {
-		l = m.RunId.Size()
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.JobId != nil {
-		l = m.JobId.Size()
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.ObjectMeta != nil {
-		l = m.ObjectMeta.Size()
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if len(m.IngressAddresses) > 0 {
-		for k, v := range m.IngressAddresses {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + sovEvents(uint64(k)) + 1 + len(v) + sovEvents(uint64(len(v)))
-			n += mapEntrySize + 1 + sovEvents(uint64(mapEntrySize))
+	if len(m.Errors) > 0 {
+		for iNdEx := len(m.Errors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Errors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	l = len(m.NodeName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.PodNumber != 0 {
-		n += 1 + sovEvents(uint64(m.PodNumber))
-	}
-	l = len(m.PodName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.PodNamespace)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	return n
-}
-
-func (m *JobRunSucceeded) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.RunId != nil {
-		l = m.RunId.Size()
-		n += 1 + l + sovEvents(uint64(l))
-	}
 	if m.JobId != nil {
-		l = m.JobId.Size()
-		n += 1 + l + sovEvents(uint64(l))
+		{
+			size, err := m.JobId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.ResourceInfos) > 0 {
-		for _, e := range m.ResourceInfos {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
+	if m.RunId != nil {
+		{
+			size, err := m.RunId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *JobErrors) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.JobId != nil {
-		l = m.JobId.Size()
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if len(m.Errors) > 0 {
-		for _, e := range m.Errors {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
-		}
+func (m *Error) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *JobRunErrors) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *Error) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Error) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.RunId != nil {
-		l = m.RunId.Size()
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.JobId != nil {
-		l = m.JobId.Size()
-		n += 1 + l + sovEvents(uint64(l))
+	if m.Reason != nil {
+		{
+			size := m.Reason.Size()
+			i -= size
+			if _, err := m.Reason.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
 	}
-	if len(m.Errors) > 0 {
-		for _, e := range m.Errors {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
+	if m.Terminal {
+		i--
+		if m.Terminal {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Error) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Terminal {
-		n += 2
-	}
-	if m.Reason != nil {
-		n += m.Reason.Size()
-	}
-	return n
+func (m *Error_KubernetesError) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Error_KubernetesError) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+func (m *Error_KubernetesError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	if m.KubernetesError != nil {
-		l = m.KubernetesError.Size()
-		n += 1 + l + sovEvents(uint64(l))
+		{
+			size, err := m.KubernetesError.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *Error_ContainerError) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+func (m *Error_ContainerError) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Error_ContainerError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	if m.ContainerError != nil {
-		l = m.ContainerError.Size()
-		n += 1 + l + sovEvents(uint64(l))
+		{
+			size, err := m.ContainerError.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *Error_ExecutorError) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+func (m *Error_ExecutorError) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Error_ExecutorError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	if m.ExecutorError != nil {
-		l = m.ExecutorError.Size()
-		n += 1 + l + sovEvents(uint64(l))
+		{
+			size, err := m.ExecutorError.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *Error_PodUnschedulable) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+func (m *Error_PodUnschedulable) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Error_PodUnschedulable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	if m.PodUnschedulable != nil {
-		l = m.PodUnschedulable.Size()
-		n += 1 + l + sovEvents(uint64(l))
+		{
+			size, err := m.PodUnschedulable.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *Error_LeaseExpired) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+func (m *Error_LeaseExpired) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Error_LeaseExpired) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	if m.LeaseExpired != nil {
-		l = m.LeaseExpired.Size()
-		n += 1 + l + sovEvents(uint64(l))
+		{
+			size, err := m.LeaseExpired.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *Error_MaxRunsExceeded) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+func (m *Error_MaxRunsExceeded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Error_MaxRunsExceeded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	if m.MaxRunsExceeded != nil {
-		l = m.MaxRunsExceeded.Size()
-		n += 1 + l + sovEvents(uint64(l))
+		{
+			size, err := m.MaxRunsExceeded.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *Error_PodError) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+func (m *Error_PodError) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Error_PodError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	if m.PodError != nil {
-		l = m.PodError.Size()
-		n += 1 + l + sovEvents(uint64(l))
+		{
+			size, err := m.PodError.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *Error_PodLeaseReturned) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+func (m *Error_PodLeaseReturned) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Error_PodLeaseReturned) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	if m.PodLeaseReturned != nil {
-		l = m.PodLeaseReturned.Size()
-		n += 1 + l + sovEvents(uint64(l))
+		{
+			size, err := m.PodLeaseReturned.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *Error_PodTerminated) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+func (m *Error_PodTerminated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Error_PodTerminated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	if m.PodTerminated != nil {
-		l = m.PodTerminated.Size()
-		n += 1 + l + sovEvents(uint64(l))
+		{
+			size, err := m.PodTerminated.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *Error_JobRunPreemptedError) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+func (m *Error_JobRunPreemptedError) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Error_JobRunPreemptedError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	if m.JobRunPreemptedError != nil {
-		l = m.JobRunPreemptedError.Size()
-		n += 1 + l + sovEvents(uint64(l))
+		{
+			size, err := m.JobRunPreemptedError.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *Error_GangJobUnschedulable) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+func (m *Error_GangJobUnschedulable) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Error_GangJobUnschedulable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	if m.GangJobUnschedulable != nil {
-		l = m.GangJobUnschedulable.Size()
-		n += 1 + l + sovEvents(uint64(l))
+		{
+			size, err := m.GangJobUnschedulable.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *KubernetesError) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *KubernetesError) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *KubernetesError) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KubernetesError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ResourceType)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
 	if m.ResourceId != nil {
-		l = m.ResourceId.Size()
-		n += 1 + l + sovEvents(uint64(l))
+		{
+			size, err := m.ResourceId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.ResourceType) > 0 {
+		i -= len(m.ResourceType)
+		copy(dAtA[i:], m.ResourceType)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ResourceType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *PodError) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PodError) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PodError) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PodError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ObjectMeta != nil {
-		l = m.ObjectMeta.Size()
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.Message)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.KubernetesReason != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.KubernetesReason))
+		i--
+		dAtA[i] = 0x30
 	}
-	l = len(m.NodeName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.ContainerErrors) > 0 {
+		for iNdEx := len(m.ContainerErrors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContainerErrors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
 	if m.PodNumber != 0 {
-		n += 1 + sovEvents(uint64(m.PodNumber))
+		i = encodeVarintEvents(dAtA, i, uint64(m.PodNumber))
+		i--
+		dAtA[i] = 0x20
 	}
-	if len(m.ContainerErrors) > 0 {
-		for _, e := range m.ContainerErrors {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
-		}
+	if len(m.NodeName) > 0 {
+		i -= len(m.NodeName)
+		copy(dAtA[i:], m.NodeName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.NodeName)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.KubernetesReason != 0 {
-		n += 1 + sovEvents(uint64(m.KubernetesReason))
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if m.ObjectMeta != nil {
+		{
+			size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ContainerError) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ContainerError) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ContainerError) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContainerError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ObjectMeta != nil {
-		l = m.ObjectMeta.Size()
-		n += 1 + l + sovEvents(uint64(l))
+	if m.KubernetesReason != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.KubernetesReason))
+		i--
+		dAtA[i] = 0x48
 	}
-	if m.ExitCode != 0 {
-		n += 1 + sovEvents(uint64(m.ExitCode))
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.Message)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Reason)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.ExitCode != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ExitCode))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.KubernetesReason != 0 {
-		n += 1 + sovEvents(uint64(m.KubernetesReason))
+	if m.ObjectMeta != nil {
+		{
+			size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *PodLeaseReturned) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ObjectMeta != nil {
-		l = m.ObjectMeta.Size()
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.Message)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.PodNumber != 0 {
-		n += 1 + sovEvents(uint64(m.PodNumber))
-	}
-	if m.RunAttempted {
-		n += 2
+func (m *PodLeaseReturned) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *PodTerminated) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *PodLeaseReturned) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PodLeaseReturned) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ObjectMeta != nil {
-		l = m.ObjectMeta.Size()
-		n += 1 + l + sovEvents(uint64(l))
+	if m.RunAttempted {
+		i--
+		if m.RunAttempted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.Message)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.PodNumber != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PodNumber))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.NodeName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.PodNumber != 0 {
-		n += 1 + sovEvents(uint64(m.PodNumber))
+	if m.ObjectMeta != nil {
+		{
+			size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ExecutorError) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PodTerminated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *PodUnschedulable) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ObjectMeta != nil {
-		l = m.ObjectMeta.Size()
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.Message)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.NodeName)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.PodNumber != 0 {
-		n += 1 + sovEvents(uint64(m.PodNumber))
-	}
-	return n
+func (m *PodTerminated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *LeaseExpired) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *PodTerminated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if m.PodNumber != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PodNumber))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.NodeName) > 0 {
+		i -= len(m.NodeName)
+		copy(dAtA[i:], m.NodeName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.NodeName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ObjectMeta != nil {
+		{
+			size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MaxRunsExceeded) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ExecutorError) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecutorError) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecutorError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Message)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *JobRunPreemptedError) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PodUnschedulable) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PodUnschedulable) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PodUnschedulable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if m.PodNumber != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PodNumber))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.NodeName) > 0 {
+		i -= len(m.NodeName)
+		copy(dAtA[i:], m.NodeName)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.NodeName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ObjectMeta != nil {
+		{
+			size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *GangJobUnschedulable) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *LeaseExpired) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *LeaseExpired) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LeaseExpired) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Message)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *JobDuplicateDetected) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MaxRunsExceeded) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MaxRunsExceeded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MaxRunsExceeded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.NewJobId != nil {
-		l = m.NewJobId.Size()
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.OldJobId != nil {
-		l = m.OldJobId.Size()
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *JobRunPreempted) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *JobRunPreemptedError) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *JobRunPreemptedError) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobRunPreemptedError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.PreemptedJobId != nil {
-		l = m.PreemptedJobId.Size()
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.PreemptedRunId != nil {
-		l = m.PreemptedRunId.Size()
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.PreemptiveJobId != nil {
-		l = m.PreemptiveJobId.Size()
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.PreemptiveRunId != nil {
-		l = m.PreemptiveRunId.Size()
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *PartitionMarker) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GangJobUnschedulable) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GangJobUnschedulable) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GangJobUnschedulable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.GroupId != nil {
-		l = m.GroupId.Size()
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.Partition != 0 {
-		n += 1 + sovEvents(uint64(m.Partition))
+	return len(dAtA) - i, nil
+}
+
+func (m *JobDuplicateDetected) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobDuplicateDetected) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobDuplicateDetected) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OldJobId != nil {
+		{
+			size, err := m.OldJobId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.NewJobId != nil {
+		{
+			size, err := m.NewJobId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobRunPreempted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobRunPreempted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobRunPreempted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PreemptiveRunId != nil {
+		{
+			size, err := m.PreemptiveRunId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.PreemptiveJobId != nil {
+		{
+			size, err := m.PreemptiveJobId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PreemptedRunId != nil {
+		{
+			size, err := m.PreemptedRunId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PreemptedJobId != nil {
+		{
+			size, err := m.PreemptedJobId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PartitionMarker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PartitionMarker) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PartitionMarker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Partition != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Partition))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.GroupId != nil {
+		{
+			size, err := m.GroupId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JobRunPreemptionRequested) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JobRunPreemptionRequested) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JobRunPreemptionRequested) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.JobId != nil {
+		{
+			size, err := m.JobId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.RunId != nil {
+		{
+			size, err := m.RunId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventSequence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Queue)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.JobSetName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.UserId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Groups) > 0 {
+		for _, s := range m.Groups {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.Events) > 0 {
+		for _, e := range m.Events {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventSequence_Event) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Event != nil {
+		n += m.Event.Size()
+	}
+	if m.Created != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Created)
+		n += 2 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventSequence_Event_SubmitJob) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SubmitJob != nil {
+		l = m.SubmitJob.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *EventSequence_Event_ReprioritiseJob) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ReprioritiseJob != nil {
+		l = m.ReprioritiseJob.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *EventSequence_Event_ReprioritiseJobSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ReprioritiseJobSet != nil {
+		l = m.ReprioritiseJobSet.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *EventSequence_Event_ReprioritisedJob) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ReprioritisedJob != nil {
+		l = m.ReprioritisedJob.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *EventSequence_Event_CancelJob) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CancelJob != nil {
+		l = m.CancelJob.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *EventSequence_Event_CancelJobSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CancelJobSet != nil {
+		l = m.CancelJobSet.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *EventSequence_Event_CancelledJob) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CancelledJob != nil {
+		l = m.CancelledJob.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *EventSequence_Event_JobSucceeded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.JobSucceeded != nil {
+		l = m.JobSucceeded.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *EventSequence_Event_JobErrors) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.JobErrors != nil {
+		l = m.JobErrors.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *EventSequence_Event_JobRunLeased) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.JobRunLeased != nil {
+		l = m.JobRunLeased.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *EventSequence_Event_JobRunAssigned) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.JobRunAssigned != nil {
+		l = m.JobRunAssigned.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *EventSequence_Event_JobRunRunning) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.JobRunRunning != nil {
+		l = m.JobRunRunning.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *EventSequence_Event_JobRunSucceeded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.JobRunSucceeded != nil {
+		l = m.JobRunSucceeded.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *EventSequence_Event_JobRunErrors) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.JobRunErrors != nil {
+		l = m.JobRunErrors.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *EventSequence_Event_JobDuplicateDetected) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.JobDuplicateDetected != nil {
+		l = m.JobDuplicateDetected.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *EventSequence_Event_StandaloneIngressInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StandaloneIngressInfo != nil {
+		l = m.StandaloneIngressInfo.Size()
+		n += 2 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *EventSequence_Event_ResourceUtilisation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ResourceUtilisation != nil {
+		l = m.ResourceUtilisation.Size()
+		n += 2 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *EventSequence_Event_JobRunPreempted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.JobRunPreempted != nil {
+		l = m.JobRunPreempted.Size()
+		n += 2 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *EventSequence_Event_PartitionMarker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PartitionMarker != nil {
+		l = m.PartitionMarker.Size()
+		n += 2 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *EventSequence_Event_JobRunPreemptionRequested) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.JobRunPreemptionRequested != nil {
+		l = m.JobRunPreemptionRequested.Size()
+		n += 2 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *EventSequence_Event_JobRequeued) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.JobRequeued != nil {
+		l = m.JobRequeued.Size()
+		n += 2 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *EventSequence_Event_JobSetExpired) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.JobSetExpired != nil {
+		l = m.JobSetExpired.Size()
+		n += 2 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *EventSequence_Event_JobSuspended) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.JobSuspended != nil {
+		l = m.JobSuspended.Size()
+		n += 2 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *EventSequence_Event_JobResumed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.JobResumed != nil {
+		l = m.JobResumed.Size()
+		n += 2 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *EventSequence_Event_JobEvictedForCapacity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.JobEvictedForCapacity != nil {
+		l = m.JobEvictedForCapacity.Size()
+		n += 2 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *EventSequence_Event_JobRuntimeExceeded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.JobRuntimeExceeded != nil {
+		l = m.JobRuntimeExceeded.Size()
+		n += 2 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *EventSequence_Event_JobResourcesNormalized) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.JobResourcesNormalized != nil {
+		l = m.JobResourcesNormalized.Size()
+		n += 2 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *EventSequence_Event_JobUnschedulable) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.JobUnschedulable != nil {
+		l = m.JobUnschedulable.Size()
+		n += 2 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *EventSequence_Event_JobMaintenanceWindowStarted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.JobMaintenanceWindowStarted != nil {
+		l = m.JobMaintenanceWindowStarted.Size()
+		n += 2 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *EventSequence_Event_JobMaintenanceWindowEnded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.JobMaintenanceWindowEnded != nil {
+		l = m.JobMaintenanceWindowEnded.Size()
+		n += 2 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *EventSequence_Event_JobCordoned) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.JobCordoned != nil {
+		l = m.JobCordoned.Size()
+		n += 2 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *EventSequence_Event_JobUncordoned) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.JobUncordoned != nil {
+		l = m.JobUncordoned.Size()
+		n += 2 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *ResourceUtilisation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RunId != nil {
+		l = m.RunId.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.JobId != nil {
+		l = m.JobId.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ResourceInfo != nil {
+		l = m.ResourceInfo.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.MaxResourcesForPeriod) > 0 {
+		for k, v := range m.MaxResourcesForPeriod {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovEvents(uint64(len(k))) + 1 + l + sovEvents(uint64(l))
+			n += mapEntrySize + 1 + sovEvents(uint64(mapEntrySize))
+		}
+	}
+	if len(m.TotalCumulativeUsage) > 0 {
+		for k, v := range m.TotalCumulativeUsage {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovEvents(uint64(len(k))) + 1 + l + sovEvents(uint64(l))
+			n += mapEntrySize + 1 + sovEvents(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *Uuid) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.High64 != 0 {
+		n += 9
+	}
+	if m.Low64 != 0 {
+		n += 9
+	}
+	return n
+}
+
+func (m *SubmitJob) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.JobId != nil {
+		l = m.JobId.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.DeduplicationId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Priority != 0 {
+		n += 1 + sovEvents(uint64(m.Priority))
+	}
+	if m.ObjectMeta != nil {
+		l = m.ObjectMeta.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.MainObject != nil {
+		l = m.MainObject.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Objects) > 0 {
+		for _, e := range m.Objects {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if m.Lifetime != 0 {
+		n += 1 + sovEvents(uint64(m.Lifetime))
+	}
+	if m.AtMostOnce {
+		n += 2
+	}
+	if m.Preemptible {
+		n += 2
+	}
+	if m.ConcurrencySafe {
+		n += 2
+	}
+	l = len(m.Scheduler)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.IsDuplicate {
+		n += 2
+	}
+	if m.QueueTtlSeconds != 0 {
+		n += 1 + sovEvents(uint64(m.QueueTtlSeconds))
+	}
+	l = len(m.JobArrayId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.JobArrayIndex != 0 {
+		n += 1 + sovEvents(uint64(m.JobArrayIndex))
+	}
+	return n
+}
+
+func (m *KubernetesMainObject) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ObjectMeta != nil {
+		l = m.ObjectMeta.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Object != nil {
+		n += m.Object.Size()
+	}
+	return n
+}
+
+func (m *KubernetesMainObject_PodSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PodSpec != nil {
+		l = m.PodSpec.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *KubernetesObject) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ObjectMeta != nil {
+		l = m.ObjectMeta.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Object != nil {
+		n += m.Object.Size()
+	}
+	return n
+}
+
+func (m *KubernetesObject_PodSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PodSpec != nil {
+		l = m.PodSpec.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *KubernetesObject_Ingress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Ingress != nil {
+		l = m.Ingress.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *KubernetesObject_Service) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Service != nil {
+		l = m.Service.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *KubernetesObject_ConfigMap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ConfigMap != nil {
+		l = m.ConfigMap.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *ObjectMeta) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ExecutorId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.KubernetesId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Annotations) > 0 {
+		for k, v := range m.Annotations {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovEvents(uint64(len(k))) + 1 + len(v) + sovEvents(uint64(len(v)))
+			n += mapEntrySize + 1 + sovEvents(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovEvents(uint64(len(k))) + 1 + len(v) + sovEvents(uint64(len(v)))
+			n += mapEntrySize + 1 + sovEvents(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *PodSpecWithAvoidList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PodSpec != nil {
+		l = m.PodSpec.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.NodeAvoidlist) > 0 {
+		for _, s := range m.NodeAvoidlist {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ReprioritiseJob) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.JobId != nil {
+		l = m.JobId.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Priority != 0 {
+		n += 1 + sovEvents(uint64(m.Priority))
+	}
+	return n
+}
+
+func (m *JobRequeued) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.JobId != nil {
+		l = m.JobId.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.SchedulingInfo != nil {
+		l = m.SchedulingInfo.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.UpdateSequenceNumber != 0 {
+		n += 1 + sovEvents(uint64(m.UpdateSequenceNumber))
+	}
+	return n
+}
+
+func (m *ReprioritiseJobSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Priority != 0 {
+		n += 1 + sovEvents(uint64(m.Priority))
+	}
+	return n
+}
+
+func (m *ReprioritisedJob) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.JobId != nil {
+		l = m.JobId.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Priority != 0 {
+		n += 1 + sovEvents(uint64(m.Priority))
+	}
+	return n
+}
+
+func (m *CancelJob) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.JobId != nil {
+		l = m.JobId.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *JobSetFilter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.States) > 0 {
+		l = 0
+		for _, e := range m.States {
+			l += sovEvents(uint64(e))
+		}
+		n += 1 + sovEvents(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *CancelJobSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.States) > 0 {
+		l = 0
+		for _, e := range m.States {
+			l += sovEvents(uint64(e))
+		}
+		n += 1 + sovEvents(uint64(l)) + l
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *CancelledJob) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.JobId != nil {
+		l = m.JobId.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *JobSetExpired) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.JobId != nil {
+		l = m.JobId.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.JobSetTtlSeconds != 0 {
+		n += 1 + sovEvents(uint64(m.JobSetTtlSeconds))
+	}
+	return n
+}
+
+func (m *JobSuspended) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.JobId != nil {
+		l = m.JobId.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *JobResumed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.JobId != nil {
+		l = m.JobId.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *JobEvictedForCapacity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.JobId != nil {
+		l = m.JobId.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ExecutorId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *JobRuntimeExceeded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.JobId != nil {
+		l = m.JobId.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ExecutorId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.MaxRuntimeSeconds != 0 {
+		n += 1 + sovEvents(uint64(m.MaxRuntimeSeconds))
+	}
+	return n
+}
+
+func (m *JobResourcesNormalized) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.JobId != nil {
+		l = m.JobId.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.OriginalResources) > 0 {
+		for k, v := range m.OriginalResources {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovEvents(uint64(len(k))) + 1 + l + sovEvents(uint64(l))
+			n += mapEntrySize + 1 + sovEvents(uint64(mapEntrySize))
+		}
+	}
+	if len(m.NormalizedResources) > 0 {
+		for k, v := range m.NormalizedResources {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovEvents(uint64(len(k))) + 1 + l + sovEvents(uint64(l))
+			n += mapEntrySize + 1 + sovEvents(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *JobUnschedulable) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.JobId != nil {
+		l = m.JobId.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *JobMaintenanceWindowStarted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.JobId != nil {
+		l = m.JobId.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.MaintenanceWindowId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Executor)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.End)
+	n += 1 + l + sovEvents(uint64(l))
+	if m.Drain {
+		n += 2
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *JobMaintenanceWindowEnded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.JobId != nil {
+		l = m.JobId.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.MaintenanceWindowId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Executor)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *JobCordoned) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.JobId != nil {
+		l = m.JobId.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Executor)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.CordonedBy)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *JobUncordoned) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.JobId != nil {
+		l = m.JobId.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Executor)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.UncordonedBy)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *JobSucceeded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.JobId != nil {
+		l = m.JobId.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.ResourceInfos) > 0 {
+		for _, e := range m.ResourceInfos {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *JobRunLeased) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RunId != nil {
+		l = m.RunId.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.JobId != nil {
+		l = m.JobId.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.ExecutorId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.NodeId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.UpdateSequenceNumber != 0 {
+		n += 1 + sovEvents(uint64(m.UpdateSequenceNumber))
+	}
+	if m.HasScheduledAtPriority {
+		n += 2
+	}
+	if m.ScheduledAtPriority != 0 {
+		n += 1 + sovEvents(uint64(m.ScheduledAtPriority))
+	}
+	l = len(m.Pool)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *JobRunAssigned) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RunId != nil {
+		l = m.RunId.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.JobId != nil {
+		l = m.JobId.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.ResourceInfos) > 0 {
+		for _, e := range m.ResourceInfos {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *JobRunRunning) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RunId != nil {
+		l = m.RunId.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.JobId != nil {
+		l = m.JobId.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.ResourceInfos) > 0 {
+		for _, e := range m.ResourceInfos {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *KubernetesResourceInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ObjectMeta != nil {
+		l = m.ObjectMeta.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Info != nil {
+		n += m.Info.Size()
+	}
+	return n
+}
+
+func (m *KubernetesResourceInfo_PodInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PodInfo != nil {
+		l = m.PodInfo.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *KubernetesResourceInfo_IngressInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IngressInfo != nil {
+		l = m.IngressInfo.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *PodInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NodeName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.PodNumber != 0 {
+		n += 1 + sovEvents(uint64(m.PodNumber))
+	}
+	l = len(m.Pool)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.NodeLabels) > 0 {
+		for k, v := range m.NodeLabels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovEvents(uint64(len(k))) + 1 + len(v) + sovEvents(uint64(len(v)))
+			n += mapEntrySize + 1 + sovEvents(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *IngressInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NodeName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.IngressAddresses) > 0 {
+		for k, v := range m.IngressAddresses {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovEvents(uint64(k)) + 1 + len(v) + sovEvents(uint64(len(v)))
+			n += mapEntrySize + 1 + sovEvents(uint64(mapEntrySize))
+		}
+	}
+	return n
+}
+
+func (m *StandaloneIngressInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RunId != nil {
+		l = m.RunId.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.JobId != nil {
+		l = m.JobId.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ObjectMeta != nil {
+		l = m.ObjectMeta.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.IngressAddresses) > 0 {
+		for k, v := range m.IngressAddresses {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sovEvents(uint64(k)) + 1 + len(v) + sovEvents(uint64(len(v)))
+			n += mapEntrySize + 1 + sovEvents(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.NodeName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.PodNumber != 0 {
+		n += 1 + sovEvents(uint64(m.PodNumber))
+	}
+	l = len(m.PodName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.PodNamespace)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *JobRunSucceeded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RunId != nil {
+		l = m.RunId.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.JobId != nil {
+		l = m.JobId.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.ResourceInfos) > 0 {
+		for _, e := range m.ResourceInfos {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *JobErrors) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.JobId != nil {
+		l = m.JobId.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Errors) > 0 {
+		for _, e := range m.Errors {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *JobRunErrors) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RunId != nil {
+		l = m.RunId.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.JobId != nil {
+		l = m.JobId.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Errors) > 0 {
+		for _, e := range m.Errors {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Error) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Terminal {
+		n += 2
+	}
+	if m.Reason != nil {
+		n += m.Reason.Size()
+	}
+	return n
+}
+
+func (m *Error_KubernetesError) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.KubernetesError != nil {
+		l = m.KubernetesError.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *Error_ContainerError) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ContainerError != nil {
+		l = m.ContainerError.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *Error_ExecutorError) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExecutorError != nil {
+		l = m.ExecutorError.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *Error_PodUnschedulable) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PodUnschedulable != nil {
+		l = m.PodUnschedulable.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *Error_LeaseExpired) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LeaseExpired != nil {
+		l = m.LeaseExpired.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *Error_MaxRunsExceeded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxRunsExceeded != nil {
+		l = m.MaxRunsExceeded.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *Error_PodError) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PodError != nil {
+		l = m.PodError.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *Error_PodLeaseReturned) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PodLeaseReturned != nil {
+		l = m.PodLeaseReturned.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *Error_PodTerminated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PodTerminated != nil {
+		l = m.PodTerminated.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *Error_JobRunPreemptedError) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.JobRunPreemptedError != nil {
+		l = m.JobRunPreemptedError.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *Error_GangJobUnschedulable) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GangJobUnschedulable != nil {
+		l = m.GangJobUnschedulable.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+func (m *KubernetesError) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ResourceType)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ResourceId != nil {
+		l = m.ResourceId.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *PodError) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ObjectMeta != nil {
+		l = m.ObjectMeta.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.NodeName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.PodNumber != 0 {
+		n += 1 + sovEvents(uint64(m.PodNumber))
+	}
+	if len(m.ContainerErrors) > 0 {
+		for _, e := range m.ContainerErrors {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if m.KubernetesReason != 0 {
+		n += 1 + sovEvents(uint64(m.KubernetesReason))
+	}
+	return n
+}
+
+func (m *ContainerError) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ObjectMeta != nil {
+		l = m.ObjectMeta.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ExitCode != 0 {
+		n += 1 + sovEvents(uint64(m.ExitCode))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.KubernetesReason != 0 {
+		n += 1 + sovEvents(uint64(m.KubernetesReason))
+	}
+	return n
+}
+
+func (m *PodLeaseReturned) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ObjectMeta != nil {
+		l = m.ObjectMeta.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.PodNumber != 0 {
+		n += 1 + sovEvents(uint64(m.PodNumber))
+	}
+	if m.RunAttempted {
+		n += 2
+	}
+	return n
+}
+
+func (m *PodTerminated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ObjectMeta != nil {
+		l = m.ObjectMeta.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.NodeName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.PodNumber != 0 {
+		n += 1 + sovEvents(uint64(m.PodNumber))
+	}
+	return n
+}
+
+func (m *ExecutorError) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *PodUnschedulable) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ObjectMeta != nil {
+		l = m.ObjectMeta.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.NodeName)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.PodNumber != 0 {
+		n += 1 + sovEvents(uint64(m.PodNumber))
+	}
+	return n
+}
+
+func (m *LeaseExpired) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MaxRunsExceeded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *JobRunPreemptedError) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GangJobUnschedulable) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *JobDuplicateDetected) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NewJobId != nil {
+		l = m.NewJobId.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.OldJobId != nil {
+		l = m.OldJobId.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *JobRunPreempted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PreemptedJobId != nil {
+		l = m.PreemptedJobId.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.PreemptedRunId != nil {
+		l = m.PreemptedRunId.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.PreemptiveJobId != nil {
+		l = m.PreemptiveJobId.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.PreemptiveRunId != nil {
+		l = m.PreemptiveRunId.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *PartitionMarker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GroupId != nil {
+		l = m.GroupId.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Partition != 0 {
+		n += 1 + sovEvents(uint64(m.Partition))
+	}
+	return n
+}
+
+func (m *JobRunPreemptionRequested) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RunId != nil {
+		l = m.RunId.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.JobId != nil {
+		l = m.JobId.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventSequence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSequence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSequence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobSetName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Groups = append(m.Groups, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Events = append(m.Events, &EventSequence_Event{})
+			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSequence_Event) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Event: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Event: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitJob", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &SubmitJob{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &EventSequence_Event_SubmitJob{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReprioritiseJob", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ReprioritiseJob{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &EventSequence_Event_ReprioritiseJob{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReprioritiseJobSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ReprioritiseJobSet{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &EventSequence_Event_ReprioritiseJobSet{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReprioritisedJob", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ReprioritisedJob{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &EventSequence_Event_ReprioritisedJob{v}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelJob", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &CancelJob{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &EventSequence_Event_CancelJob{v}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelJobSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &CancelJobSet{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &EventSequence_Event_CancelJobSet{v}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelledJob", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &CancelledJob{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &EventSequence_Event_CancelledJob{v}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSucceeded", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &JobSucceeded{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &EventSequence_Event_JobSucceeded{v}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobErrors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &JobErrors{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &EventSequence_Event_JobErrors{v}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobRunLeased", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &JobRunLeased{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &EventSequence_Event_JobRunLeased{v}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobRunAssigned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &JobRunAssigned{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &EventSequence_Event_JobRunAssigned{v}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobRunRunning", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &JobRunRunning{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &EventSequence_Event_JobRunRunning{v}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobRunSucceeded", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &JobRunSucceeded{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &EventSequence_Event_JobRunSucceeded{v}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobRunErrors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &JobRunErrors{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &EventSequence_Event_JobRunErrors{v}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobDuplicateDetected", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &JobDuplicateDetected{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &EventSequence_Event_JobDuplicateDetected{v}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StandaloneIngressInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &StandaloneIngressInfo{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &EventSequence_Event_StandaloneIngressInfo{v}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceUtilisation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ResourceUtilisation{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &EventSequence_Event_ResourceUtilisation{v}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Created == nil {
+				m.Created = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Created, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobRunPreempted", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &JobRunPreempted{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &EventSequence_Event_JobRunPreempted{v}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartitionMarker", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &PartitionMarker{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &EventSequence_Event_PartitionMarker{v}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobRunPreemptionRequested", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &JobRunPreemptionRequested{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &EventSequence_Event_JobRunPreemptionRequested{v}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobRequeued", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &JobRequeued{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &EventSequence_Event_JobRequeued{v}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetExpired", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &JobSetExpired{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &EventSequence_Event_JobSetExpired{v}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSuspended", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &JobSuspended{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &EventSequence_Event_JobSuspended{v}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobResumed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &JobResumed{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &EventSequence_Event_JobResumed{v}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobEvictedForCapacity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &JobEvictedForCapacity{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &EventSequence_Event_JobEvictedForCapacity{v}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobRuntimeExceeded", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &JobRuntimeExceeded{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &EventSequence_Event_JobRuntimeExceeded{v}
+			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobResourcesNormalized", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &JobResourcesNormalized{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &EventSequence_Event_JobResourcesNormalized{v}
+			iNdEx = postIndex
+		case 29:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobUnschedulable", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &JobUnschedulable{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &EventSequence_Event_JobUnschedulable{v}
+			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobMaintenanceWindowStarted", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &JobMaintenanceWindowStarted{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &EventSequence_Event_JobMaintenanceWindowStarted{v}
+			iNdEx = postIndex
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobMaintenanceWindowEnded", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &JobMaintenanceWindowEnded{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &EventSequence_Event_JobMaintenanceWindowEnded{v}
+			iNdEx = postIndex
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobCordoned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &JobCordoned{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &EventSequence_Event_JobCordoned{v}
+			iNdEx = postIndex
+		case 33:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobUncordoned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &JobUncordoned{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Event = &EventSequence_Event_JobUncordoned{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ResourceUtilisation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ResourceUtilisation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ResourceUtilisation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RunId == nil {
+				m.RunId = &Uuid{}
+			}
+			if err := m.RunId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.JobId == nil {
+				m.JobId = &Uuid{}
+			}
+			if err := m.JobId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ResourceInfo == nil {
+				m.ResourceInfo = &KubernetesResourceInfo{}
+			}
+			if err := m.ResourceInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxResourcesForPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaxResourcesForPeriod == nil {
+				m.MaxResourcesForPeriod = make(map[string]resource.Quantity)
+			}
+			var mapkey string
+			mapvalue := &resource.Quantity{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvents
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthEvents
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthEvents
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvents
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthEvents
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthEvents
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipEvents(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthEvents
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.MaxResourcesForPeriod[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalCumulativeUsage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TotalCumulativeUsage == nil {
+				m.TotalCumulativeUsage = make(map[string]resource.Quantity)
+			}
+			var mapkey string
+			mapvalue := &resource.Quantity{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvents
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthEvents
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthEvents
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvents
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthEvents
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthEvents
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipEvents(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthEvents
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.TotalCumulativeUsage[mapkey] = *mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *JobRunPreemptionRequested) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.RunId != nil {
-		l = m.RunId.Size()
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.JobId != nil {
-		l = m.JobId.Size()
-		n += 1 + l + sovEvents(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *EventSequence) Unmarshal(dAtA []byte) error {
+func (m *Uuid) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8332,81 +12298,87 @@ func (m *EventSequence) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSequence: wiretype end group for non-group")
+			return fmt.Errorf("proto: Uuid: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSequence: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Uuid: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Queue", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field High64", wireType)
 			}
-			if postIndex > l {
+			m.High64 = 0
+			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Queue = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.High64 = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobSetName", wireType)
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Low64", wireType)
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Low64 = 0
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
+			m.Low64 = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEvents
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobSetName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubmitJob) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubmitJob: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubmitJob: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -8416,27 +12388,31 @@ func (m *EventSequence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UserId = string(dAtA[iNdEx:postIndex])
+			if m.JobId == nil {
+				m.JobId = &Uuid{}
+			}
+			if err := m.JobId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Groups", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeduplicationId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8464,95 +12440,30 @@ func (m *EventSequence) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Groups = append(m.Groups, string(dAtA[iNdEx:postIndex]))
+			m.DeduplicationId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
 			}
-			var msglen int
+			m.Priority = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
 				}
 				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Events = append(m.Events, &EventSequence_Event{})
-			if err := m.Events[len(m.Events)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventSequence_Event) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Event: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Event: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubmitJob", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8579,15 +12490,16 @@ func (m *EventSequence_Event) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &SubmitJob{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ObjectMeta == nil {
+				m.ObjectMeta = &ObjectMeta{}
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Event = &EventSequence_Event_SubmitJob{v}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReprioritiseJob", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MainObject", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8614,15 +12526,16 @@ func (m *EventSequence_Event) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &ReprioritiseJob{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.MainObject == nil {
+				m.MainObject = &KubernetesMainObject{}
+			}
+			if err := m.MainObject.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Event = &EventSequence_Event_ReprioritiseJob{v}
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReprioritiseJobSet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Objects", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8649,17 +12562,95 @@ func (m *EventSequence_Event) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &ReprioritiseJobSet{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Objects = append(m.Objects, &KubernetesObject{})
+			if err := m.Objects[len(m.Objects)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Event = &EventSequence_Event_ReprioritiseJobSet{v}
 			iNdEx = postIndex
-		case 4:
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lifetime", wireType)
+			}
+			m.Lifetime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Lifetime |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AtMostOnce", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AtMostOnce = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Preemptible", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Preemptible = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConcurrencySafe", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ConcurrencySafe = bool(v != 0)
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReprioritisedJob", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Scheduler", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -8669,32 +12660,68 @@ func (m *EventSequence_Event) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &ReprioritisedJob{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Event = &EventSequence_Event_ReprioritisedJob{v}
+			m.Scheduler = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsDuplicate", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsDuplicate = bool(v != 0)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueueTtlSeconds", wireType)
+			}
+			m.QueueTtlSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueueTtlSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CancelJob", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobArrayId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -8704,32 +12731,29 @@ func (m *EventSequence_Event) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &CancelJob{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Event = &EventSequence_Event_CancelJob{v}
+			m.JobArrayId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CancelJobSet", wireType)
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobArrayIndex", wireType)
 			}
-			var msglen int
+			m.JobArrayIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -8739,30 +12763,64 @@ func (m *EventSequence_Event) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.JobArrayIndex |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvents
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEvents
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &CancelJobSet{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KubernetesMainObject) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
 			}
-			m.Event = &EventSequence_Event_CancelJobSet{v}
-			iNdEx = postIndex
-		case 7:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KubernetesMainObject: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KubernetesMainObject: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CancelledJob", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8789,15 +12847,16 @@ func (m *EventSequence_Event) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &CancelledJob{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ObjectMeta == nil {
+				m.ObjectMeta = &ObjectMeta{}
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Event = &EventSequence_Event_CancelledJob{v}
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobSucceeded", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PodSpec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8824,50 +12883,65 @@ func (m *EventSequence_Event) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JobSucceeded{}
+			v := &PodSpecWithAvoidList{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Event = &EventSequence_Event_JobSucceeded{v}
+			m.Object = &KubernetesMainObject_PodSpec{v}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobErrors", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KubernetesObject) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JobErrors{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.Event = &EventSequence_Event_JobErrors{v}
-			iNdEx = postIndex
-		case 10:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KubernetesObject: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KubernetesObject: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobRunLeased", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8894,15 +12968,16 @@ func (m *EventSequence_Event) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JobRunLeased{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ObjectMeta == nil {
+				m.ObjectMeta = &ObjectMeta{}
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Event = &EventSequence_Event_JobRunLeased{v}
 			iNdEx = postIndex
-		case 11:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobRunAssigned", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PodSpec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8929,15 +13004,15 @@ func (m *EventSequence_Event) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JobRunAssigned{}
+			v := &PodSpecWithAvoidList{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Event = &EventSequence_Event_JobRunAssigned{v}
+			m.Object = &KubernetesObject_PodSpec{v}
 			iNdEx = postIndex
-		case 12:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobRunRunning", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ingress", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8964,15 +13039,15 @@ func (m *EventSequence_Event) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JobRunRunning{}
+			v := &v1.IngressSpec{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Event = &EventSequence_Event_JobRunRunning{v}
+			m.Object = &KubernetesObject_Ingress{v}
 			iNdEx = postIndex
-		case 13:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobRunSucceeded", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8999,15 +13074,15 @@ func (m *EventSequence_Event) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JobRunSucceeded{}
+			v := &v11.ServiceSpec{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Event = &EventSequence_Event_JobRunSucceeded{v}
+			m.Object = &KubernetesObject_Service{v}
 			iNdEx = postIndex
-		case 14:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobRunErrors", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMap", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9034,17 +13109,67 @@ func (m *EventSequence_Event) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JobRunErrors{}
+			v := &v11.ConfigMap{}
 			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Event = &EventSequence_Event_JobRunErrors{v}
+			m.Object = &KubernetesObject_ConfigMap{v}
 			iNdEx = postIndex
-		case 15:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ObjectMeta) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ObjectMeta: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ObjectMeta: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobDuplicateDetected", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutorId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -9054,32 +13179,29 @@ func (m *EventSequence_Event) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JobDuplicateDetected{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Event = &EventSequence_Event_JobDuplicateDetected{v}
+			m.ExecutorId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 16:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StandaloneIngressInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -9089,32 +13211,29 @@ func (m *EventSequence_Event) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &StandaloneIngressInfo{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Event = &EventSequence_Event_StandaloneIngressInfo{v}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 17:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceUtilisation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -9124,32 +13243,29 @@ func (m *EventSequence_Event) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &ResourceUtilisation{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Event = &EventSequence_Event_ResourceUtilisation{v}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 18:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Created", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KubernetesId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -9159,31 +13275,27 @@ func (m *EventSequence_Event) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Created == nil {
-				m.Created = new(time.Time)
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Created, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.KubernetesId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 19:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobRunPreempted", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9210,15 +13322,107 @@ func (m *EventSequence_Event) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JobRunPreempted{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
 			}
-			m.Event = &EventSequence_Event_JobRunPreempted{v}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvents
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthEvents
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthEvents
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvents
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthEvents
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthEvents
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipEvents(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthEvents
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Annotations[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 20:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PartitionMarker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9245,15 +13449,157 @@ func (m *EventSequence_Event) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &PartitionMarker{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
 			}
-			m.Event = &EventSequence_Event_PartitionMarker{v}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvents
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthEvents
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthEvents
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvents
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthEvents
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthEvents
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipEvents(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthEvents
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 21:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PodSpecWithAvoidList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PodSpecWithAvoidList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PodSpecWithAvoidList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobRunPreemptionRequested", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PodSpec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9280,17 +13626,18 @@ func (m *EventSequence_Event) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JobRunPreemptionRequested{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.PodSpec == nil {
+				m.PodSpec = &v11.PodSpec{}
+			}
+			if err := m.PodSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Event = &EventSequence_Event_JobRunPreemptionRequested{v}
 			iNdEx = postIndex
-		case 22:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobRequeued", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeAvoidlist", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -9300,26 +13647,23 @@ func (m *EventSequence_Event) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &JobRequeued{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Event = &EventSequence_Event_JobRequeued{v}
+			m.NodeAvoidlist = append(m.NodeAvoidlist, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9342,7 +13686,7 @@ func (m *EventSequence_Event) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ResourceUtilisation) Unmarshal(dAtA []byte) error {
+func (m *ReprioritiseJob) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9365,49 +13709,13 @@ func (m *ResourceUtilisation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ResourceUtilisation: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReprioritiseJob: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ResourceUtilisation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReprioritiseJob: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RunId", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.RunId == nil {
-				m.RunId = &Uuid{}
-			}
-			if err := m.RunId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
 			}
@@ -9443,11 +13751,11 @@ func (m *ResourceUtilisation) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResourceInfo", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
 			}
-			var msglen int
+			m.Priority = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -9457,31 +13765,64 @@ func (m *ResourceUtilisation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Priority |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvents
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEvents
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ResourceInfo == nil {
-				m.ResourceInfo = &KubernetesResourceInfo{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobRequeued) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
 			}
-			if err := m.ResourceInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 4:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobRequeued: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobRequeued: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxResourcesForPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9491,126 +13832,33 @@ func (m *ResourceUtilisation) Unmarshal(dAtA []byte) error {
 				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.MaxResourcesForPeriod == nil {
-				m.MaxResourcesForPeriod = make(map[string]resource.Quantity)
-			}
-			var mapkey string
-			mapvalue := &resource.Quantity{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowEvents
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowEvents
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthEvents
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthEvents
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowEvents
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthEvents
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthEvents
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &resource.Quantity{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipEvents(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthEvents
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.JobId == nil {
+				m.JobId = &Uuid{}
+			}
+			if err := m.JobId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.MaxResourcesForPeriod[mapkey] = *mapvalue
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalCumulativeUsage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SchedulingInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9637,106 +13885,32 @@ func (m *ResourceUtilisation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TotalCumulativeUsage == nil {
-				m.TotalCumulativeUsage = make(map[string]resource.Quantity)
+			if m.SchedulingInfo == nil {
+				m.SchedulingInfo = &schedulerobjects.JobSchedulingInfo{}
 			}
-			var mapkey string
-			mapvalue := &resource.Quantity{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowEvents
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if err := m.SchedulingInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateSequenceNumber", wireType)
+			}
+			m.UpdateSequenceNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowEvents
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthEvents
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthEvents
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowEvents
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthEvents
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthEvents
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &resource.Quantity{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipEvents(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthEvents
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdateSequenceNumber |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.TotalCumulativeUsage[mapkey] = *mapvalue
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -9758,7 +13932,7 @@ func (m *ResourceUtilisation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Uuid) Unmarshal(dAtA []byte) error {
+func (m *ReprioritiseJobSet) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9781,32 +13955,136 @@ func (m *Uuid) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Uuid: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReprioritiseJobSet: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Uuid: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReprioritiseJobSet: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field High64", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
 			}
-			m.High64 = 0
-			if (iNdEx + 8) > l {
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.High64 = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReprioritisedJob) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReprioritisedJob: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReprioritisedJob: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.JobId == nil {
+				m.JobId = &Uuid{}
+			}
+			if err := m.JobId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Low64", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
 			}
-			m.Low64 = 0
-			if (iNdEx + 8) > l {
-				return io.ErrUnexpectedEOF
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Low64 = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -9828,7 +14106,7 @@ func (m *Uuid) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SubmitJob) Unmarshal(dAtA []byte) error {
+func (m *CancelJob) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9851,10 +14129,10 @@ func (m *SubmitJob) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SubmitJob: wiretype end group for non-group")
+			return fmt.Errorf("proto: CancelJob: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SubmitJob: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CancelJob: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9895,7 +14173,7 @@ func (m *SubmitJob) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeduplicationId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9923,32 +14201,251 @@ func (m *SubmitJob) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DeduplicationId = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.Priority = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobSetFilter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobSetFilter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobSetFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v JobState
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= JobState(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				m.States = append(m.States, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthEvents
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthEvents
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.States) == 0 {
+					m.States = make([]JobState, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v JobState
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvents
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= JobState(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.States = append(m.States, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field States", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CancelJobSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CancelJobSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CancelJobSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v JobState
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= JobState(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.States = append(m.States, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthEvents
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthEvents
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Priority |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				if elementCount != 0 && len(m.States) == 0 {
+					m.States = make([]JobState, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v JobState
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvents
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= JobState(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.States = append(m.States, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field States", wireType)
 			}
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -9958,67 +14455,77 @@ func (m *SubmitJob) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ObjectMeta == nil {
-				m.ObjectMeta = &ObjectMeta{}
-			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MainObject", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvents
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEvents
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MainObject == nil {
-				m.MainObject = &KubernetesMainObject{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CancelledJob) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
 			}
-			if err := m.MainObject.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 6:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CancelledJob: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CancelledJob: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Objects", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10045,147 +14552,18 @@ func (m *SubmitJob) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Objects = append(m.Objects, &KubernetesObject{})
-			if err := m.Objects[len(m.Objects)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lifetime", wireType)
-			}
-			m.Lifetime = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Lifetime |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AtMostOnce", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.AtMostOnce = bool(v != 0)
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Preemptible", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Preemptible = bool(v != 0)
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConcurrencySafe", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.ConcurrencySafe = bool(v != 0)
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scheduler", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Scheduler = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsDuplicate", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if m.JobId == nil {
+				m.JobId = &Uuid{}
 			}
-			m.IsDuplicate = bool(v != 0)
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QueueTtlSeconds", wireType)
+			if err := m.JobId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.QueueTtlSeconds = 0
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -10195,11 +14573,24 @@ func (m *SubmitJob) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.QueueTtlSeconds |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -10221,7 +14612,7 @@ func (m *SubmitJob) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KubernetesMainObject) Unmarshal(dAtA []byte) error {
+func (m *JobSetExpired) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10244,15 +14635,15 @@ func (m *KubernetesMainObject) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KubernetesMainObject: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobSetExpired: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KubernetesMainObject: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobSetExpired: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10279,18 +14670,18 @@ func (m *KubernetesMainObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ObjectMeta == nil {
-				m.ObjectMeta = &ObjectMeta{}
+			if m.JobId == nil {
+				m.JobId = &Uuid{}
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.JobId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodSpec", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobSetTtlSeconds", wireType)
 			}
-			var msglen int
+			m.JobSetTtlSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -10300,27 +14691,11 @@ func (m *KubernetesMainObject) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.JobSetTtlSeconds |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &PodSpecWithAvoidList{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Object = &KubernetesMainObject_PodSpec{v}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -10342,7 +14717,7 @@ func (m *KubernetesMainObject) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KubernetesObject) Unmarshal(dAtA []byte) error {
+func (m *JobSuspended) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10365,15 +14740,15 @@ func (m *KubernetesObject) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KubernetesObject: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobSuspended: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KubernetesObject: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobSuspended: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10400,51 +14775,66 @@ func (m *KubernetesObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ObjectMeta == nil {
-				m.ObjectMeta = &ObjectMeta{}
+			if m.JobId == nil {
+				m.JobId = &Uuid{}
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.JobId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodSpec", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobResumed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &PodSpecWithAvoidList{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.Object = &KubernetesObject_PodSpec{v}
-			iNdEx = postIndex
-		case 3:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobResumed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobResumed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ingress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10471,15 +14861,66 @@ func (m *KubernetesObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &v1.IngressSpec{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.JobId == nil {
+				m.JobId = &Uuid{}
+			}
+			if err := m.JobId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Object = &KubernetesObject_Ingress{v}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobEvictedForCapacity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobEvictedForCapacity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobEvictedForCapacity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Service", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10506,17 +14947,18 @@ func (m *KubernetesObject) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &v11.ServiceSpec{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.JobId == nil {
+				m.JobId = &Uuid{}
+			}
+			if err := m.JobId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Object = &KubernetesObject_Service{v}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfigMap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutorId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -10526,26 +14968,23 @@ func (m *KubernetesObject) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &v11.ConfigMap{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Object = &KubernetesObject_ConfigMap{v}
+			m.ExecutorId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10568,7 +15007,7 @@ func (m *KubernetesObject) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ObjectMeta) Unmarshal(dAtA []byte) error {
+func (m *JobRuntimeExceeded) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10591,17 +15030,17 @@ func (m *ObjectMeta) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ObjectMeta: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobRuntimeExceeded: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ObjectMeta: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobRuntimeExceeded: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExecutorId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -10611,27 +15050,31 @@ func (m *ObjectMeta) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExecutorId = string(dAtA[iNdEx:postIndex])
+			if m.JobId == nil {
+				m.JobId = &Uuid{}
+			}
+			if err := m.JobId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutorId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10659,13 +15102,13 @@ func (m *ObjectMeta) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.ExecutorId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRuntimeSeconds", wireType)
 			}
-			var stringLen uint64
+			m.MaxRuntimeSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -10675,29 +15118,66 @@ func (m *ObjectMeta) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MaxRuntimeSeconds |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEvents
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JobResourcesNormalized) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JobResourcesNormalized: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JobResourcesNormalized: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KubernetesId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -10707,27 +15187,31 @@ func (m *ObjectMeta) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KubernetesId = string(dAtA[iNdEx:postIndex])
+			if m.JobId == nil {
+				m.JobId = &Uuid{}
+			}
+			if err := m.JobId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalResources", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10754,11 +15238,11 @@ func (m *ObjectMeta) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Annotations == nil {
-				m.Annotations = make(map[string]string)
+			if m.OriginalResources == nil {
+				m.OriginalResources = make(map[string]v11.ResourceRequirements)
 			}
 			var mapkey string
-			var mapvalue string
+			mapvalue := &v11.ResourceRequirements{}
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -10807,7 +15291,7 @@ func (m *ObjectMeta) Unmarshal(dAtA []byte) error {
 					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
+					var mapmsglen int
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowEvents
@@ -10817,24 +15301,26 @@ func (m *ObjectMeta) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
+						mapmsglen |= int(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
+					if mapmsglen < 0 {
 						return ErrInvalidLengthEvents
 					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
 						return ErrInvalidLengthEvents
 					}
-					if postStringIndexmapvalue > l {
+					if postmsgIndex > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
+					mapvalue = &v11.ResourceRequirements{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
 				} else {
 					iNdEx = entryPreIndex
 					skippy, err := skipEvents(dAtA[iNdEx:])
@@ -10850,11 +15336,11 @@ func (m *ObjectMeta) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Annotations[mapkey] = mapvalue
+			m.OriginalResources[mapkey] = *mapvalue
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NormalizedResources", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10881,11 +15367,11 @@ func (m *ObjectMeta) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
+			if m.NormalizedResources == nil {
+				m.NormalizedResources = make(map[string]v11.ResourceRequirements)
 			}
 			var mapkey string
-			var mapvalue string
+			mapvalue := &v11.ResourceRequirements{}
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -10929,173 +15415,57 @@ func (m *ObjectMeta) Unmarshal(dAtA []byte) error {
 						return ErrInvalidLengthEvents
 					}
 					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowEvents
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthEvents
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthEvents
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipEvents(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthEvents
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Labels[mapkey] = mapvalue
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PodSpecWithAvoidList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PodSpecWithAvoidList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PodSpecWithAvoidList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodSpec", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.PodSpec == nil {
-				m.PodSpec = &v11.PodSpec{}
-			}
-			if err := m.PodSpec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeAvoidlist", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvents
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthEvents
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthEvents
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &v11.ResourceRequirements{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipEvents(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthEvents
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NodeAvoidlist = append(m.NodeAvoidlist, string(dAtA[iNdEx:postIndex]))
+			m.NormalizedResources[mapkey] = *mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11118,7 +15488,7 @@ func (m *PodSpecWithAvoidList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReprioritiseJob) Unmarshal(dAtA []byte) error {
+func (m *JobUnschedulable) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11141,10 +15511,10 @@ func (m *ReprioritiseJob) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReprioritiseJob: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobUnschedulable: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReprioritiseJob: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobUnschedulable: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -11184,10 +15554,10 @@ func (m *ReprioritiseJob) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			m.Priority = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -11197,11 +15567,24 @@ func (m *ReprioritiseJob) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Priority |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -11223,7 +15606,7 @@ func (m *ReprioritiseJob) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobRequeued) Unmarshal(dAtA []byte) error {
+func (m *JobMaintenanceWindowStarted) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11246,10 +15629,10 @@ func (m *JobRequeued) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobRequeued: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobMaintenanceWindowStarted: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobRequeued: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobMaintenanceWindowStarted: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -11290,9 +15673,9 @@ func (m *JobRequeued) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SchedulingInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceWindowId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -11302,33 +15685,29 @@ func (m *JobRequeued) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SchedulingInfo == nil {
-				m.SchedulingInfo = &schedulerobjects.JobSchedulingInfo{}
-			}
-			if err := m.SchedulingInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MaintenanceWindowId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdateSequenceNumber", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executor", wireType)
 			}
-			m.UpdateSequenceNumber = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -11338,66 +15717,29 @@ func (m *JobRequeued) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UpdateSequenceNumber |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ReprioritiseJobSet) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ReprioritiseJobSet: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReprioritiseJobSet: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			m.Executor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
 			}
-			m.Priority = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -11407,66 +15749,30 @@ func (m *ReprioritiseJobSet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Priority |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ReprioritisedJob) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.End, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ReprioritisedJob: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReprioritisedJob: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Drain", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -11476,33 +15782,17 @@ func (m *ReprioritisedJob) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.JobId == nil {
-				m.JobId = &Uuid{}
-			}
-			if err := m.JobId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			m.Drain = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			m.Priority = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -11512,11 +15802,24 @@ func (m *ReprioritisedJob) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Priority |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -11538,7 +15841,7 @@ func (m *ReprioritisedJob) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CancelJob) Unmarshal(dAtA []byte) error {
+func (m *JobMaintenanceWindowEnded) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11561,10 +15864,10 @@ func (m *CancelJob) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CancelJob: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobMaintenanceWindowEnded: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CancelJob: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobMaintenanceWindowEnded: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -11605,7 +15908,7 @@ func (m *CancelJob) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceWindowId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11633,7 +15936,39 @@ func (m *CancelJob) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			m.MaintenanceWindowId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Executor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11656,7 +15991,7 @@ func (m *CancelJob) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *JobSetFilter) Unmarshal(dAtA []byte) error {
+func (m *JobCordoned) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11679,201 +16014,113 @@ func (m *JobSetFilter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: JobSetFilter: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobCordoned: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: JobSetFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobCordoned: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType == 0 {
-				var v JobState
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowEvents
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= JobState(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.States = append(m.States, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowEvents
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthEvents
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthEvents
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				if elementCount != 0 && len(m.States) == 0 {
-					m.States = make([]JobState, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v JobState
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowEvents
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= JobState(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.States = append(m.States, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field States", wireType)
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CancelJobSet) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
+			if m.JobId == nil {
+				m.JobId = &Uuid{}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if err := m.JobId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executor", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CancelJobSet: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CancelJobSet: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType == 0 {
-				var v JobState
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowEvents
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= JobState(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
 				}
-				m.States = append(m.States, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowEvents
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthEvents
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthEvents
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Executor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CordonedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				if elementCount != 0 && len(m.States) == 0 {
-					m.States = make([]JobState, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v JobState
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowEvents
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= JobState(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.States = append(m.States, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field States", wireType)
 			}
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CordonedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
@@ -11926,7 +16173,7 @@ func (m *CancelJobSet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CancelledJob) Unmarshal(dAtA []byte) error {
+func (m *JobUncordoned) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11949,10 +16196,10 @@ func (m *CancelledJob) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CancelledJob: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobUncordoned: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CancelledJob: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobUncordoned: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -11993,7 +16240,7 @@ func (m *CancelledJob) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Executor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12021,7 +16268,39 @@ func (m *CancelledJob) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			m.Executor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UncordonedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UncordonedBy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12387,6 +16666,38 @@ func (m *JobRunLeased) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pool = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -12956,6 +17267,165 @@ func (m *PodInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pool", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pool = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeLabels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NodeLabels == nil {
+				m.NodeLabels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvents
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthEvents
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthEvents
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvents
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthEvents
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthEvents
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipEvents(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthEvents
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.NodeLabels[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -111,6 +111,72 @@ func (ev *EventSequence_Event) UnmarshalJSON(data []byte) error {
 				return err
 			}
 			ev.Event = &jobRequeued
+		case "jobSetExpired":
+			var jobSetExpired EventSequence_Event_JobSetExpired
+			if err = json.Unmarshal(rawEvent.EventBytes, &jobSetExpired); err != nil {
+				return err
+			}
+			ev.Event = &jobSetExpired
+		case "jobSuspended":
+			var jobSuspended EventSequence_Event_JobSuspended
+			if err = json.Unmarshal(rawEvent.EventBytes, &jobSuspended); err != nil {
+				return err
+			}
+			ev.Event = &jobSuspended
+		case "jobResumed":
+			var jobResumed EventSequence_Event_JobResumed
+			if err = json.Unmarshal(rawEvent.EventBytes, &jobResumed); err != nil {
+				return err
+			}
+			ev.Event = &jobResumed
+		case "jobEvictedForCapacity":
+			var jobEvictedForCapacity EventSequence_Event_JobEvictedForCapacity
+			if err = json.Unmarshal(rawEvent.EventBytes, &jobEvictedForCapacity); err != nil {
+				return err
+			}
+			ev.Event = &jobEvictedForCapacity
+		case "jobRuntimeExceeded":
+			var jobRuntimeExceeded EventSequence_Event_JobRuntimeExceeded
+			if err = json.Unmarshal(rawEvent.EventBytes, &jobRuntimeExceeded); err != nil {
+				return err
+			}
+			ev.Event = &jobRuntimeExceeded
+		case "jobResourcesNormalized":
+			var jobResourcesNormalized EventSequence_Event_JobResourcesNormalized
+			if err = json.Unmarshal(rawEvent.EventBytes, &jobResourcesNormalized); err != nil {
+				return err
+			}
+			ev.Event = &jobResourcesNormalized
+		case "jobUnschedulable":
+			var jobUnschedulable EventSequence_Event_JobUnschedulable
+			if err = json.Unmarshal(rawEvent.EventBytes, &jobUnschedulable); err != nil {
+				return err
+			}
+			ev.Event = &jobUnschedulable
+		case "jobMaintenanceWindowStarted":
+			var jobMaintenanceWindowStarted EventSequence_Event_JobMaintenanceWindowStarted
+			if err = json.Unmarshal(rawEvent.EventBytes, &jobMaintenanceWindowStarted); err != nil {
+				return err
+			}
+			ev.Event = &jobMaintenanceWindowStarted
+		case "jobMaintenanceWindowEnded":
+			var jobMaintenanceWindowEnded EventSequence_Event_JobMaintenanceWindowEnded
+			if err = json.Unmarshal(rawEvent.EventBytes, &jobMaintenanceWindowEnded); err != nil {
+				return err
+			}
+			ev.Event = &jobMaintenanceWindowEnded
+		case "jobCordoned":
+			var jobCordoned EventSequence_Event_JobCordoned
+			if err = json.Unmarshal(rawEvent.EventBytes, &jobCordoned); err != nil {
+				return err
+			}
+			ev.Event = &jobCordoned
+		case "jobUncordoned":
+			var jobUncordoned EventSequence_Event_JobUncordoned
+			if err = json.Unmarshal(rawEvent.EventBytes, &jobUncordoned); err != nil {
+				return err
+			}
+			ev.Event = &jobUncordoned
 		case "jobRunLeased":
 			var jobRunLeased EventSequence_Event_JobRunLeased
 			if err = json.Unmarshal(rawEvent.EventBytes, &jobRunLeased); err != nil {
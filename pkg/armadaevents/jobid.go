@@ -40,6 +40,28 @@ func JobIdFromEvent(event *EventSequence_Event) (*Uuid, error) {
 		return e.JobRunPreempted.PreemptedJobId, nil
 	case *EventSequence_Event_JobRequeued:
 		return e.JobRequeued.JobId, nil
+	case *EventSequence_Event_JobSetExpired:
+		return e.JobSetExpired.JobId, nil
+	case *EventSequence_Event_JobSuspended:
+		return e.JobSuspended.JobId, nil
+	case *EventSequence_Event_JobResumed:
+		return e.JobResumed.JobId, nil
+	case *EventSequence_Event_JobEvictedForCapacity:
+		return e.JobEvictedForCapacity.JobId, nil
+	case *EventSequence_Event_JobRuntimeExceeded:
+		return e.JobRuntimeExceeded.JobId, nil
+	case *EventSequence_Event_JobResourcesNormalized:
+		return e.JobResourcesNormalized.JobId, nil
+	case *EventSequence_Event_JobUnschedulable:
+		return e.JobUnschedulable.JobId, nil
+	case *EventSequence_Event_JobMaintenanceWindowStarted:
+		return e.JobMaintenanceWindowStarted.JobId, nil
+	case *EventSequence_Event_JobMaintenanceWindowEnded:
+		return e.JobMaintenanceWindowEnded.JobId, nil
+	case *EventSequence_Event_JobCordoned:
+		return e.JobCordoned.JobId, nil
+	case *EventSequence_Event_JobUncordoned:
+		return e.JobUncordoned.JobId, nil
 	default:
 		err := errors.WithStack(&armadaerrors.ErrInvalidArgument{
 			Name:    "event.Event",
@@ -0,0 +1,28 @@
+package apitoken
+
+import "context"
+
+// TokenDetails configures authentication with an API token issued via the ApiTokens service, e.g., from a CI system.
+type TokenDetails struct {
+	// Token as returned by CreateToken.
+	Token string
+}
+
+// Credentials sends an API token with each request.
+type Credentials struct {
+	token string
+}
+
+func NewCredentials(token string) *Credentials {
+	return &Credentials{token: token}
+}
+
+func (c *Credentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{
+		"authorization": "Token " + c.token,
+	}, nil
+}
+
+func (c *Credentials) RequireTransportSecurity() bool {
+	return false
+}
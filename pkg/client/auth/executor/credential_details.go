@@ -0,0 +1,12 @@
+package executor
+
+import "time"
+
+// CredentialDetails configures authentication with a credential minted by the Armada server for a single executor.
+type CredentialDetails struct {
+	// File containing the token of the credential. Rotated credentials are written back to this file,
+	// so it must be writable by the executor if RotateAfter is set.
+	TokenFile string
+	// If non-zero, the credential is rotated once the token file is older than this.
+	RotateAfter time.Duration
+}
@@ -0,0 +1,62 @@
+package executor
+
+import (
+	"context"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// FileCredentials sends the executor credential token stored in a file with each request.
+// The file is read again whenever it's modified or replaced, e.g., because the credential was rotated.
+type FileCredentials struct {
+	path string
+
+	// Mutex guards the cached token.
+	mu    sync.Mutex
+	token string
+	info  os.FileInfo
+}
+
+func NewFileCredentials(path string) *FileCredentials {
+	return &FileCredentials{path: path}
+}
+
+func (c *FileCredentials) getToken() (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	info, err := os.Stat(c.path)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+	if c.info != nil && os.SameFile(c.info, info) && info.ModTime().Equal(c.info.ModTime()) {
+		return c.token, nil
+	}
+	data, err := os.ReadFile(c.path)
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+	token := strings.TrimSpace(string(data))
+	if token == "" {
+		return "", errors.Errorf("executor credential file %s is empty", c.path)
+	}
+	c.token = token
+	c.info = info
+	return token, nil
+}
+
+func (c *FileCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	token, err := c.getToken()
+	if err != nil {
+		return nil, err
+	}
+	return map[string]string{
+		"authorization": "Executor " + token,
+	}, nil
+}
+
+func (c *FileCredentials) RequireTransportSecurity() bool {
+	return false
+}
@@ -0,0 +1,79 @@
+package executor
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+// Rotator periodically exchanges the credential stored in a token file for a freshly minted one.
+type Rotator struct {
+	client  api.ExecutorCredentialsClient
+	details CredentialDetails
+	timeout time.Duration
+}
+
+func NewRotator(client api.ExecutorCredentialsClient, details CredentialDetails) *Rotator {
+	return &Rotator{
+		client:  client,
+		details: details,
+		timeout: 30 * time.Second,
+	}
+}
+
+// RotateIfDue rotates the credential if the token file is older than the configured rotation interval,
+// logging rather than returning errors such that it can be run as a background task.
+func (r *Rotator) RotateIfDue() {
+	ctx, cancel := context.WithTimeout(context.Background(), r.timeout)
+	defer cancel()
+	if err := r.rotateIfDue(ctx, time.Now()); err != nil {
+		log.WithError(err).Errorf("error rotating executor credential stored in %s", r.details.TokenFile)
+	}
+}
+
+func (r *Rotator) rotateIfDue(ctx context.Context, now time.Time) error {
+	info, err := os.Stat(r.details.TokenFile)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if now.Sub(info.ModTime()) < r.details.RotateAfter {
+		return nil
+	}
+	secret, err := r.client.RotateExecutorCredential(ctx, &types.Empty{})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if err := writeFileAtomically(r.details.TokenFile, []byte(secret.Token+"\n")); err != nil {
+		return err
+	}
+	log.Infof("rotated executor credential; new credential %s expires at %s", secret.Credential.Id, secret.Credential.Expires)
+	return nil
+}
+
+// writeFileAtomically replaces the file at path with data, such that concurrent readers see either the old or the new contents.
+func writeFileAtomically(path string, data []byte) error {
+	f, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer os.Remove(f.Name())
+	if err := f.Chmod(0o600); err != nil {
+		f.Close()
+		return errors.WithStack(err)
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return errors.WithStack(err)
+	}
+	if err := f.Close(); err != nil {
+		return errors.WithStack(err)
+	}
+	return errors.WithStack(os.Rename(f.Name(), path))
+}
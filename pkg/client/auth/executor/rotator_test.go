@@ -0,0 +1,52 @@
+package executor
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+type fakeExecutorCredentialsClient struct {
+	api.ExecutorCredentialsClient
+	rotations int
+}
+
+func (c *fakeExecutorCredentialsClient) RotateExecutorCredential(_ context.Context, _ *types.Empty, _ ...grpc.CallOption) (*api.ExecutorCredentialSecret, error) {
+	c.rotations++
+	return &api.ExecutorCredentialSecret{
+		Credential: &api.ExecutorCredential{Id: "cred-2", ExecutorId: "cluster-1"},
+		Token:      "cred-2.secret",
+	}, nil
+}
+
+func TestRotator(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(path, []byte("cred-1.secret\n"), 0o600))
+	credentials := NewFileCredentials(path)
+	md, err := credentials.GetRequestMetadata(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "Executor cred-1.secret", md["authorization"])
+
+	client := &fakeExecutorCredentialsClient{}
+	rotator := NewRotator(client, CredentialDetails{TokenFile: path, RotateAfter: time.Hour})
+
+	// The credential is only rotated once it's old enough.
+	require.NoError(t, rotator.rotateIfDue(context.Background(), time.Now()))
+	assert.Equal(t, 0, client.rotations)
+	require.NoError(t, rotator.rotateIfDue(context.Background(), time.Now().Add(2*time.Hour)))
+	assert.Equal(t, 1, client.rotations)
+
+	// Credentials pick up the rotated token.
+	md, err = credentials.GetRequestMetadata(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "Executor cred-2.secret", md["authorization"])
+}
@@ -1,6 +1,7 @@
 package client
 
 import (
+	"crypto/tls"
 	"fmt"
 	"net/http"
 	"strings"
@@ -15,7 +16,9 @@ import (
 	"google.golang.org/grpc/keepalive"
 
 	"github.com/armadaproject/armada/internal/common"
+	"github.com/armadaproject/armada/pkg/client/auth/apitoken"
 	"github.com/armadaproject/armada/pkg/client/auth/exec"
+	"github.com/armadaproject/armada/pkg/client/auth/executor"
 	"github.com/armadaproject/armada/pkg/client/auth/kerberos"
 	"github.com/armadaproject/armada/pkg/client/auth/kubernetes"
 	"github.com/armadaproject/armada/pkg/client/auth/oidc"
@@ -47,6 +50,16 @@ type ApiConnectionDetails struct {
 	KerberosAuth                kerberos.ClientConfig
 	ForceNoTls                  bool
 	ExecAuth                    exec.CommandDetails
+	ExecutorCredentialAuth      executor.CredentialDetails
+	ApiTokenAuth                apitoken.TokenDetails
+	ClientCertAuth              ClientCertDetails
+}
+
+// ClientCertDetails configures the TLS client certificate presented to servers verifying client certificates.
+// The files are read again on each connection, such that rotated certificates are picked up.
+type ClientCertDetails struct {
+	CertFile string
+	KeyFile  string
 }
 
 type ConnectionDetails func() *ApiConnectionDetails
@@ -116,13 +129,27 @@ func perRpcCredentials(config *ApiConnectionDetails) (credentials.PerRPCCredenti
 		return kerberos.NewSPNEGOCredentials(config.ArmadaUrl, config.KerberosAuth)
 	} else if config.ExecAuth.Cmd != "" {
 		return exec.NewAuthenticator(config.ExecAuth), nil
+	} else if config.ExecutorCredentialAuth.TokenFile != "" {
+		return executor.NewFileCredentials(config.ExecutorCredentialAuth.TokenFile), nil
+	} else if config.ApiTokenAuth.Token != "" {
+		return apitoken.NewCredentials(config.ApiTokenAuth.Token), nil
 	}
 	return nil, nil
 }
 
 func transportCredentials(config *ApiConnectionDetails) grpc.DialOption {
 	if !config.ForceNoTls && !strings.Contains(config.ArmadaUrl, "localhost") {
-		return grpc.WithTransportCredentials(credentials.NewClientTLSFromCert(nil, ""))
+		tlsConfig := &tls.Config{}
+		if certDetails := config.ClientCertAuth; certDetails.CertFile != "" {
+			tlsConfig.GetClientCertificate = func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+				cert, err := tls.LoadX509KeyPair(certDetails.CertFile, certDetails.KeyFile)
+				if err != nil {
+					return nil, err
+				}
+				return &cert, nil
+			}
+		}
+		return grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig))
 	}
 	return grpc.WithTransportCredentials(insecure.NewCredentials())
 }
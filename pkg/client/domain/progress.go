@@ -0,0 +1,118 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+// Failure reasons longer than this are truncated, such that similar reasons are counted together.
+const maxFailureReasonLength = 100
+
+// JobSetProgress is the progress of the jobs of a job set, as reconstructed from its events.
+type JobSetProgress struct {
+	// Time of the most recent event of the job set.
+	Time     time.Time         `json:"time"`
+	Jobs     int               `json:"jobs"`
+	Finished int               `json:"finished"`
+	States   map[JobStatus]int `json:"states"`
+	// Reasons jobs failed for, with the number of jobs that failed for each, most common first.
+	FailureReasons []FailureReasonCount `json:"failureReasons,omitempty"`
+	// Estimated time until all jobs have finished, at the rate jobs have finished so far.
+	// Unset if no jobs have finished yet.
+	EstimatedSecondsRemaining *float64 `json:"estimatedSecondsRemaining,omitempty"`
+}
+
+// StateSummary returns the number of jobs in each state, e.g., "Queued: 2, Running: 1, ...".
+func (progress *JobSetProgress) StateSummary() string {
+	summary := make([]string, len(statesToIncludeInSummary))
+	for i, jobStatus := range statesToIncludeInSummary {
+		summary[i] = fmt.Sprintf("%s: %d", jobStatus, progress.States[jobStatus])
+	}
+	return strings.Join(summary, ", ")
+}
+
+type FailureReasonCount struct {
+	Reason string `json:"reason"`
+	Jobs   int    `json:"jobs"`
+}
+
+// ProgressTracker computes the progress of a job set from the events of the job set.
+// Like WatchContext, it's not threadsafe.
+type ProgressTracker struct {
+	firstEvent time.Time
+	lastEvent  time.Time
+	// Reason each job last failed for, by job id.
+	failureReasons map[string]string
+}
+
+func NewProgressTracker() *ProgressTracker {
+	return &ProgressTracker{failureReasons: make(map[string]string)}
+}
+
+func (tracker *ProgressTracker) ProcessEvent(event api.Event) {
+	created := event.GetCreated()
+	if tracker.firstEvent.IsZero() || created.Before(tracker.firstEvent) {
+		tracker.firstEvent = created
+	}
+	if created.After(tracker.lastEvent) {
+		tracker.lastEvent = created
+	}
+	if failed, ok := event.(*api.JobFailedEvent); ok {
+		tracker.failureReasons[failed.JobId] = failureReason(failed)
+	}
+}
+
+// Progress returns the progress of the job set whose events were processed by both tracker and state.
+func (tracker *ProgressTracker) Progress(state *WatchContext) *JobSetProgress {
+	progress := &JobSetProgress{
+		Time:     tracker.lastEvent,
+		Jobs:     state.GetNumberOfJobs(),
+		Finished: state.GetNumberOfFinishedJobs(),
+		States:   make(map[JobStatus]int, len(statesToIncludeInSummary)),
+	}
+	for _, jobStatus := range statesToIncludeInSummary {
+		progress.States[jobStatus] = state.stateSummary[jobStatus]
+	}
+
+	counts := make(map[string]int)
+	for jobId, reason := range tracker.failureReasons {
+		if info := state.GetJobInfo(jobId); info != nil && info.Status == Failed {
+			counts[reason]++
+		}
+	}
+	for reason, count := range counts {
+		progress.FailureReasons = append(progress.FailureReasons, FailureReasonCount{Reason: reason, Jobs: count})
+	}
+	sort.Slice(progress.FailureReasons, func(i, j int) bool {
+		a, b := progress.FailureReasons[i], progress.FailureReasons[j]
+		if a.Jobs != b.Jobs {
+			return a.Jobs > b.Jobs
+		}
+		return a.Reason < b.Reason
+	})
+
+	if elapsed := tracker.lastEvent.Sub(tracker.firstEvent); progress.Finished > 0 && elapsed > 0 {
+		remaining := elapsed.Seconds() * float64(progress.Jobs-progress.Finished) / float64(progress.Finished)
+		progress.EstimatedSecondsRemaining = &remaining
+	}
+	return progress
+}
+
+// failureReason returns the first line of the reason of event, or its cause if it has no reason.
+func failureReason(event *api.JobFailedEvent) string {
+	reason := strings.TrimSpace(event.Reason)
+	if i := strings.IndexByte(reason, '\n'); i >= 0 {
+		reason = strings.TrimSpace(reason[:i])
+	}
+	if runes := []rune(reason); len(runes) > maxFailureReasonLength {
+		reason = string(runes[:maxFailureReasonLength]) + "..."
+	}
+	if reason == "" {
+		return event.Cause.String()
+	}
+	return reason
+}
@@ -0,0 +1,74 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+func TestProgressTracker_Progress(t *testing.T) {
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	watchContext := NewWatchContext()
+	tracker := NewProgressTracker()
+	for _, event := range []api.Event{
+		&api.JobQueuedEvent{JobId: "a", Created: start},
+		&api.JobQueuedEvent{JobId: "b", Created: start},
+		&api.JobQueuedEvent{JobId: "c", Created: start},
+		&api.JobQueuedEvent{JobId: "d", Created: start},
+		&api.JobRunningEvent{JobId: "a", Created: start.Add(time.Minute)},
+		&api.JobRunningEvent{JobId: "b", Created: start.Add(time.Minute)},
+		&api.JobFailedEvent{JobId: "a", Created: start.Add(2 * time.Minute), Reason: "OOMKilled\ncontainer main used 2Gi"},
+		&api.JobFailedEvent{JobId: "b", Created: start.Add(2 * time.Minute), Cause: api.Cause_DeadlineExceeded},
+	} {
+		watchContext.ProcessEvent(event)
+		tracker.ProcessEvent(event)
+	}
+
+	progress := tracker.Progress(watchContext)
+	assert.Equal(t, start.Add(2*time.Minute), progress.Time)
+	assert.Equal(t, 4, progress.Jobs)
+	assert.Equal(t, 2, progress.Finished)
+	assert.Equal(t, 2, progress.States[Queued])
+	assert.Equal(t, 2, progress.States[Failed])
+	assert.Equal(t, 0, progress.States[Running])
+	assert.Equal(t, []FailureReasonCount{{Reason: "DeadlineExceeded", Jobs: 1}, {Reason: "OOMKilled", Jobs: 1}}, progress.FailureReasons)
+	// 2 of 4 jobs finished in 2 minutes.
+	require.NotNil(t, progress.EstimatedSecondsRemaining)
+	assert.Equal(t, 120.0, *progress.EstimatedSecondsRemaining)
+	assert.Equal(t, "Queued: 2, Suspended: 0, Leased: 0, Pending: 0, Running: 0, Succeeded: 0, Failed: 2, Cancelled: 0", progress.StateSummary())
+}
+
+func TestProgressTracker_Progress_NoFinishedJobs(t *testing.T) {
+	watchContext := NewWatchContext()
+	tracker := NewProgressTracker()
+	event := &api.JobQueuedEvent{JobId: "a", Created: time.Now()}
+	watchContext.ProcessEvent(event)
+	tracker.ProcessEvent(event)
+
+	progress := tracker.Progress(watchContext)
+	assert.Equal(t, 1, progress.Jobs)
+	assert.Nil(t, progress.EstimatedSecondsRemaining)
+	assert.Empty(t, progress.FailureReasons)
+}
+
+func TestProgressTracker_Progress_RetriedJobsAreNotCountedAsFailed(t *testing.T) {
+	start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	watchContext := NewWatchContext()
+	tracker := NewProgressTracker()
+	for _, event := range []api.Event{
+		&api.JobFailedEvent{JobId: "a", Created: start, Reason: strings.Repeat("x", 200)},
+		&api.JobQueuedEvent{JobId: "a", Created: start.Add(time.Minute)},
+		&api.JobFailedEvent{JobId: "b", Created: start, Reason: strings.Repeat("x", 200)},
+	} {
+		watchContext.ProcessEvent(event)
+		tracker.ProcessEvent(event)
+	}
+
+	progress := tracker.Progress(watchContext)
+	assert.Equal(t, []FailureReasonCount{{Reason: strings.Repeat("x", maxFailureReasonLength) + "...", Jobs: 1}}, progress.FailureReasons)
+}
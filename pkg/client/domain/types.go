@@ -36,7 +36,11 @@ type JobSubmissionDescription struct {
 type JobSubmitFile struct {
 	Queue    string
 	JobSetId string
-	Jobs     []*api.JobSubmitRequestItem `json:"jobs"`
+	// If set, jobs that don't specify a pod spec use this pod spec with their podSpecOverlays applied.
+	BasePodSpec *v1.PodSpec                 `json:"basePodSpec"`
+	Jobs        []*api.JobSubmitRequestItem `json:"jobs"`
+	// Each job array is submitted in a request of its own, after the jobs.
+	JobArrays []*api.JobArray `json:"jobArrays"`
 }
 
 type LoadTestSummary struct {
@@ -18,6 +18,7 @@ const (
 	Submitted = "Submitted"
 	Duplicate = "Duplicate"
 	Queued    = "Queued"
+	Suspended = "Suspended"
 	Leased    = "Leased"
 	Pending   = "Pending"
 	Running   = "Running"
@@ -44,6 +45,7 @@ var inactiveStates []JobStatus
 func init() {
 	statesToIncludeInSummary = []JobStatus{
 		Queued,
+		Suspended,
 		Leased,
 		Pending,
 		Running,
@@ -189,6 +191,10 @@ func updateJobInfo(info *JobInfo, event api.Event) {
 		info.Status = Duplicate
 	case *api.JobQueuedEvent:
 		info.Status = Queued
+	case *api.JobSuspendedEvent:
+		info.Status = Suspended
+	case *api.JobResumedEvent:
+		info.Status = Queued
 	case *api.JobLeasedEvent:
 		info.Status = Leased
 		info.ClusterId = typed.ClusterId
@@ -198,6 +204,9 @@ func updateJobInfo(info *JobInfo, event api.Event) {
 	case *api.JobLeaseExpiredEvent:
 		info.Status = Queued
 		resetPodStatus(info)
+	case *api.JobEvictedForCapacityEvent:
+		info.Status = Queued
+		resetPodStatus(info)
 	case *api.JobCancelledEvent:
 		info.Status = Cancelled
 
@@ -289,12 +298,18 @@ func isLifeCycleEvent(event api.Event) bool {
 		return true
 	case *api.JobDuplicateFoundEvent:
 		return true
+	case *api.JobSuspendedEvent:
+		return true
+	case *api.JobResumedEvent:
+		return true
 	case *api.JobLeasedEvent:
 		return true
 	case *api.JobLeaseReturnedEvent:
 		return true
 	case *api.JobLeaseExpiredEvent:
 		return true
+	case *api.JobEvictedForCapacityEvent:
+		return true
 
 	case *api.JobPendingEvent:
 		return true
@@ -88,7 +88,7 @@ func TestWatchContext_GetCurrentStateSummary(t *testing.T) {
 	watchContext.ProcessEvent(&api.JobPendingEvent{JobId: "2"})
 	watchContext.ProcessEvent(&api.JobRunningEvent{JobId: "3"})
 
-	expected := "Queued:   1, Leased:   0, Pending:   1, Running:   1, Succeeded:   0, Failed:   0, Cancelled:   0"
+	expected := "Queued:   1, Suspended:   0, Leased:   0, Pending:   1, Running:   1, Succeeded:   0, Failed:   0, Cancelled:   0"
 	result := watchContext.GetCurrentStateSummary()
 
 	assert.Equal(t, expected, result)
@@ -99,11 +99,35 @@ func TestWatchContext_GetCurrentStateSummary_IsCorrectlyAlteredOnUpdateToExistin
 
 	watchContext.ProcessEvent(&api.JobQueuedEvent{JobId: "1"})
 	watchContext.ProcessEvent(&api.JobPendingEvent{JobId: "1"})
-	expected := "Queued:   0, Leased:   0, Pending:   1, Running:   0, Succeeded:   0, Failed:   0, Cancelled:   0"
+	expected := "Queued:   0, Suspended:   0, Leased:   0, Pending:   1, Running:   0, Succeeded:   0, Failed:   0, Cancelled:   0"
 	result := watchContext.GetCurrentStateSummary()
 	assert.Equal(t, result, expected)
 }
 
+func TestWatchContext_SuspendedJobs(t *testing.T) {
+	watchContext := NewWatchContext()
+	now := time.Now()
+
+	watchContext.ProcessEvent(&api.JobQueuedEvent{JobId: "1", Created: now})
+	watchContext.ProcessEvent(&api.JobSuspendedEvent{JobId: "1", Created: now.Add(time.Second)})
+	assert.Equal(t, JobStatus(Suspended), watchContext.GetJobInfo("1").Status)
+	assert.Equal(t, 1, watchContext.GetNumberOfJobsInStates([]JobStatus{Suspended}))
+
+	watchContext.ProcessEvent(&api.JobResumedEvent{JobId: "1", Created: now.Add(2 * time.Second)})
+	assert.Equal(t, JobStatus(Queued), watchContext.GetJobInfo("1").Status)
+	assert.Equal(t, 0, watchContext.GetNumberOfJobsInStates([]JobStatus{Suspended}))
+}
+
+func TestWatchContext_EvictedForCapacityJobs(t *testing.T) {
+	watchContext := NewWatchContext()
+	now := time.Now()
+
+	watchContext.ProcessEvent(&api.JobRunningEvent{JobId: "1", Created: now})
+	watchContext.ProcessEvent(&api.JobEvictedForCapacityEvent{JobId: "1", Created: now.Add(time.Second)})
+	assert.Equal(t, JobStatus(Queued), watchContext.GetJobInfo("1").Status)
+	assert.Equal(t, 0, watchContext.GetNumberOfJobsInStates([]JobStatus{Running}))
+}
+
 func TestWatchContext_GetNumberOfJobsInStates(t *testing.T) {
 	watchContext := NewWatchContext()
 
@@ -165,7 +165,7 @@ func (apiLoadTester ArmadaLoadTester) runSubmission(
 					failedJobs := 0
 
 					for _, jobSubmitResponse := range response.JobResponseItems {
-						if jobSubmitResponse.Error != "" {
+						if jobSubmitResponse.Failed() {
 							failedJobs++
 						} else {
 							jobIds <- jobSubmitResponse.JobId
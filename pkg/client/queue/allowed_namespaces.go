@@ -0,0 +1,57 @@
+package queue
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"strings"
+
+	"golang.org/x/exp/slices"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+var allowedNamespaceNames = []string{"default", "ml", "infra", "team-a"}
+
+// AllowedNamespaces are the namespaces jobs submitted to a queue may be created in.
+// If empty, jobs may be created in any namespace.
+type AllowedNamespaces []string
+
+// NewAllowedNamespaces returns AllowedNamespaces using the value of in. An error is returned if any namespace
+// isn't a valid Kubernetes namespace name or is given more than once.
+func NewAllowedNamespaces(in []string) (AllowedNamespaces, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+	out := make(AllowedNamespaces, 0, len(in))
+	for _, namespace := range in {
+		if errs := validation.IsDNS1123Label(namespace); len(errs) > 0 {
+			return nil, fmt.Errorf("invalid namespace %q: %s", namespace, strings.Join(errs, "; "))
+		}
+		if slices.Contains(out, namespace) {
+			return nil, fmt.Errorf("namespace %s is given more than once", namespace)
+		}
+		out = append(out, namespace)
+	}
+	return out, nil
+}
+
+// Validate returns an error if jobs may not be created in namespace, or nil otherwise.
+// Jobs submitted without a namespace are created in namespace "default", which must then be allowed.
+func (a AllowedNamespaces) Validate(namespace string) error {
+	if len(a) == 0 || slices.Contains(a, namespace) {
+		return nil
+	}
+	return fmt.Errorf("namespace %s isn't one of the namespaces allowed by the queue: %s", namespace, strings.Join(a, ", "))
+}
+
+// Generate is implementation of https://pkg.go.dev/testing/quick#Generator interface.
+// This method is used for writing tests usign https://pkg.go.dev/testing/quick package
+func (AllowedNamespaces) Generate(rand *rand.Rand, size int) reflect.Value {
+	var allowedNamespaces AllowedNamespaces
+	for _, namespace := range allowedNamespaceNames {
+		if rand.Intn(2) == 0 {
+			allowedNamespaces = append(allowedNamespaces, namespace)
+		}
+	}
+	return reflect.ValueOf(allowedNamespaces)
+}
@@ -0,0 +1,55 @@
+package queue
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewAllowedNamespaces(t *testing.T) {
+	tests := map[string]struct {
+		in       []string
+		expected AllowedNamespaces
+		valid    bool
+	}{
+		"nil": {
+			in:    nil,
+			valid: true,
+		},
+		"empty": {
+			in:    []string{},
+			valid: true,
+		},
+		"valid": {
+			in:       []string{"ml", "team-a"},
+			expected: AllowedNamespaces{"ml", "team-a"},
+			valid:    true,
+		},
+		"invalid namespace": {
+			in:    []string{"Not_A_Namespace"},
+			valid: false,
+		},
+		"duplicate namespace": {
+			in:    []string{"ml", "ml"},
+			valid: false,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			allowedNamespaces, err := NewAllowedNamespaces(tc.in)
+			if tc.valid {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expected, allowedNamespaces)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestAllowedNamespaces_Validate(t *testing.T) {
+	allowed := AllowedNamespaces{"ml", "team-a"}
+	assert.NoError(t, allowed.Validate("ml"))
+	assert.EqualError(t, allowed.Validate("default"), "namespace default isn't one of the namespaces allowed by the queue: ml, team-a")
+	assert.NoError(t, AllowedNamespaces(nil).Validate("default"))
+}
@@ -0,0 +1,57 @@
+package queue
+
+import (
+	"math/rand"
+	"reflect"
+	"time"
+
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+var archivalUsers = []string{"alice", "bob", "armada-admin"}
+
+// Archival records who archived a queue, when, and why. Archived queues reject new submissions
+// but retain their configuration and jobs.
+type Archival struct {
+	ArchivedAt time.Time `json:"archivedAt"`
+	ArchivedBy string    `json:"archivedBy"`
+	Reason     string    `json:"reason"`
+}
+
+// NewArchival returns the Archival represented by in, or nil if in is nil, i.e., if the queue isn't archived.
+func NewArchival(in *api.QueueArchival) *Archival {
+	if in == nil {
+		return nil
+	}
+	return &Archival{
+		ArchivedAt: in.ArchivedAt.UTC(),
+		ArchivedBy: in.ArchivedBy,
+		Reason:     in.Reason,
+	}
+}
+
+// ToAPI transforms Archival to *api.QueueArchival structure. Returns nil if a is nil.
+func (a *Archival) ToAPI() *api.QueueArchival {
+	if a == nil {
+		return nil
+	}
+	return &api.QueueArchival{
+		ArchivedAt: a.ArchivedAt,
+		ArchivedBy: a.ArchivedBy,
+		Reason:     a.Reason,
+	}
+}
+
+// Generate is implementation of https://pkg.go.dev/testing/quick#Generator interface.
+// This method is used for writing tests usign https://pkg.go.dev/testing/quick package
+// It generates archived and non-archived queues with equal probability.
+func (*Archival) Generate(rand *rand.Rand, size int) reflect.Value {
+	if rand.Intn(2) == 0 {
+		return reflect.ValueOf((*Archival)(nil))
+	}
+	return reflect.ValueOf(&Archival{
+		ArchivedAt: time.Unix(rand.Int63n(1<<32), 0).UTC(),
+		ArchivedBy: archivalUsers[rand.Intn(len(archivalUsers))],
+		Reason:     "decommissioned",
+	})
+}
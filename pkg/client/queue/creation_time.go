@@ -0,0 +1,39 @@
+package queue
+
+import (
+	"math/rand"
+	"reflect"
+	"time"
+)
+
+// CreationTime is the time at which a queue was created, as assigned by the queue repository.
+// Queues created before creation times were recorded have none.
+type CreationTime struct {
+	time.Time
+}
+
+// NewCreationTime returns the CreationTime represented by in, or nil if in is nil.
+func NewCreationTime(in *time.Time) *CreationTime {
+	if in == nil {
+		return nil
+	}
+	return &CreationTime{Time: in.UTC()}
+}
+
+// ToAPI returns the time of c, or nil if c is nil.
+func (c *CreationTime) ToAPI() *time.Time {
+	if c == nil {
+		return nil
+	}
+	t := c.Time
+	return &t
+}
+
+// Generate is implementation of https://pkg.go.dev/testing/quick#Generator interface.
+// This method is used for writing tests usign https://pkg.go.dev/testing/quick package
+func (*CreationTime) Generate(rand *rand.Rand, size int) reflect.Value {
+	if rand.Intn(2) == 0 {
+		return reflect.ValueOf((*CreationTime)(nil))
+	}
+	return reflect.ValueOf(&CreationTime{Time: time.Unix(rand.Int63n(1<<32), 0).UTC()})
+}
@@ -0,0 +1,115 @@
+package queue
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/armadaproject/armada/pkg/client"
+)
+
+// Fields of queues managed by Armada rather than declared by documents. They're omitted from exported documents
+// and ignored when importing documents.
+var documentManagedFields = []string{"revision", "resourceVersion", "archival", "created"}
+
+// Document is the declarative form of a set of queues, e.g., kept in Git and reconciled with the queues of Armada.
+type Document struct {
+	client.Resource
+	Queues []Queue `json:"queues"`
+}
+
+// MarshalDocument returns a YAML document of queues, without the fields managed by Armada.
+func MarshalDocument(queues []Queue) ([]byte, error) {
+	declared := make([]map[string]interface{}, len(queues))
+	for i, q := range queues {
+		var err error
+		if declared[i], err = declaredFields(q); err != nil {
+			return nil, err
+		}
+	}
+	return yaml.Marshal(map[string]interface{}{
+		"apiVersion": client.APIVersionV1,
+		"kind":       client.ResourceKindQueueList,
+		"queues":     declared,
+	})
+}
+
+// UnmarshalDocument returns the queues of a YAML or JSON document, with the fields managed by Armada unset.
+// Error is returned if the document isn't a list of queues, has unknown fields, or declares a queue more than once.
+func UnmarshalDocument(data []byte) ([]Queue, error) {
+	var document Document
+	if err := yaml.UnmarshalStrict(data, &document); err != nil {
+		return nil, fmt.Errorf("failed to parse queue document: %s", err)
+	}
+	if document.Version != client.APIVersionV1 {
+		return nil, fmt.Errorf("invalid field 'apiVersion' of queue document: %q", document.Version)
+	}
+	if document.Kind != client.ResourceKindQueueList {
+		return nil, fmt.Errorf("invalid field 'kind' of queue document: %q; must be %s", document.Kind, client.ResourceKindQueueList)
+	}
+	names := make(map[string]bool, len(document.Queues))
+	for i, q := range document.Queues {
+		if q.Name == "" {
+			return nil, fmt.Errorf("queue %d of queue document has no name", i)
+		}
+		if names[q.Name] {
+			return nil, fmt.Errorf("queue %s is declared more than once", q.Name)
+		}
+		names[q.Name] = true
+		document.Queues[i].Revision = 0
+		document.Queues[i].ResourceVersion = 0
+		document.Queues[i].Archival = nil
+		document.Queues[i].Created = nil
+	}
+	return document.Queues, nil
+}
+
+// ChangedFields returns the names of the fields of queues declared by documents that differ between from and to,
+// e.g., "priorityFactor", in alphabetical order.
+func ChangedFields(from Queue, to Queue) ([]string, error) {
+	fromFields, err := declaredFields(from)
+	if err != nil {
+		return nil, err
+	}
+	toFields, err := declaredFields(to)
+	if err != nil {
+		return nil, err
+	}
+	var changed []string
+	for field, value := range toFields {
+		if !reflect.DeepEqual(value, fromFields[field]) {
+			changed = append(changed, field)
+		}
+	}
+	for field := range fromFields {
+		if _, ok := toFields[field]; !ok {
+			changed = append(changed, field)
+		}
+	}
+	sort.Strings(changed)
+	return changed, nil
+}
+
+// declaredFields returns the fields of q as represented in documents, keyed by their names.
+func declaredFields(q Queue) (map[string]interface{}, error) {
+	data, err := json.Marshal(q)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal queue %s: %s", q.Name, err)
+	}
+	var fields map[string]interface{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, fmt.Errorf("failed to marshal queue %s: %s", q.Name, err)
+	}
+	for _, field := range documentManagedFields {
+		delete(fields, field)
+	}
+	// Queues with a parent may leave their priority factor unset to inherit that of the parent, which isn't a valid
+	// priority factor when declared.
+	if q.PriorityFactor == 0 {
+		delete(fields, "priorityFactor")
+	}
+	return fields, nil
+}
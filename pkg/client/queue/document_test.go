@@ -0,0 +1,78 @@
+package queue
+
+import (
+	"testing"
+	"testing/quick"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDocument_RoundTrip(t *testing.T) {
+	testCase := func(queue1 Queue, queue2 Queue) bool {
+		// Generated names may contain characters YAML doesn't allow.
+		queue1.Name, queue1.Parent = "a", ""
+		queue2.Name, queue2.Parent = "b", "a"
+		data, err := MarshalDocument([]Queue{queue1, queue2})
+		if err != nil {
+			t.Error(err)
+			return false
+		}
+		queues, err := UnmarshalDocument(data)
+		if err != nil {
+			t.Error(err)
+			return false
+		}
+		// Fields managed by Armada aren't part of documents.
+		for _, q := range []*Queue{&queue1, &queue2} {
+			q.Revision = 0
+			q.ResourceVersion = 0
+			q.Archival = nil
+			q.Created = nil
+		}
+		return assert.Equal(t, []Queue{queue1, queue2}, queues)
+	}
+
+	if err := quick.Check(testCase, nil); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMarshalDocument_OmitsManagedFields(t *testing.T) {
+	data, err := MarshalDocument([]Queue{{Name: "a", PriorityFactor: 1, Revision: 3, ResourceVersion: 7}})
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "apiVersion: armadaproject.io/v1beta1\nkind: QueueList\n")
+	assert.NotContains(t, string(data), "revision")
+	assert.NotContains(t, string(data), "resourceVersion")
+}
+
+func TestUnmarshalDocument_Invalid(t *testing.T) {
+	tests := map[string]string{
+		"not a document": "- a\n- b\n",
+		"wrong kind":     "apiVersion: armadaproject.io/v1beta1\nkind: Queue\nqueues: []\n",
+		"no api version": "kind: QueueList\nqueues: []\n",
+		"unknown field":  "apiVersion: armadaproject.io/v1beta1\nkind: QueueList\nqueues:\n- name: a\n  priority: 1\n",
+		"unnamed queue":  "apiVersion: armadaproject.io/v1beta1\nkind: QueueList\nqueues:\n- priorityFactor: 1\n",
+		"duplicate queue": "apiVersion: armadaproject.io/v1beta1\nkind: QueueList\nqueues:\n" +
+			"- name: a\n  priorityFactor: 1\n- name: a\n  priorityFactor: 2\n",
+	}
+	for name, document := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := UnmarshalDocument([]byte(document))
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestChangedFields(t *testing.T) {
+	from := Queue{Name: "a", PriorityFactor: 1, Labels: Labels{"team": "ml"}, Revision: 1}
+	to := Queue{Name: "a", PriorityFactor: 2, Revision: 2}
+
+	fields, err := ChangedFields(from, to)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"labels", "priorityFactor"}, fields)
+
+	fields, err = ChangedFields(from, from)
+	require.NoError(t, err)
+	assert.Empty(t, fields)
+}
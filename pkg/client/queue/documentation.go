@@ -0,0 +1,80 @@
+package queue
+
+import (
+	"fmt"
+	"math/rand"
+	"net/url"
+	"reflect"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+const (
+	MaxDescriptionLength      = 1024
+	MaxContactLength          = 256
+	MaxDocumentationURLLength = 2048
+)
+
+var (
+	documentationDescriptions = []string{"", "Training jobs of the ML team", "Nightly batch reports"}
+	documentationContacts     = []string{"", "ml-team@example.com", "#batch-support"}
+	documentationURLs         = []string{"", "https://wiki.example.com/queues/ml", "http://docs.example.com/batch"}
+)
+
+// Documentation tells users discovering a queue what the queue is for and whom to contact about it.
+type Documentation struct {
+	Description string `json:"description"`
+	Contact     string `json:"contact"`
+	URL         string `json:"url"`
+}
+
+// NewDocumentation returns the Documentation of in. An error is returned if the description or contact is too long,
+// or if the documentation URL isn't an absolute http(s) URL.
+func NewDocumentation(in *api.Queue) (Documentation, error) {
+	if n := utf8.RuneCountInString(in.Description); n > MaxDescriptionLength {
+		return Documentation{}, fmt.Errorf("description is %d characters long, but may be at most %d", n, MaxDescriptionLength)
+	}
+	if n := utf8.RuneCountInString(in.Contact); n > MaxContactLength {
+		return Documentation{}, fmt.Errorf("contact is %d characters long, but may be at most %d", n, MaxContactLength)
+	}
+	if in.DocumentationUrl != "" {
+		if n := len(in.DocumentationUrl); n > MaxDocumentationURLLength {
+			return Documentation{}, fmt.Errorf("documentation URL is %d bytes long, but may be at most %d", n, MaxDocumentationURLLength)
+		}
+		u, err := url.Parse(in.DocumentationUrl)
+		if err != nil {
+			return Documentation{}, fmt.Errorf("invalid documentation URL %q: %s", in.DocumentationUrl, err)
+		}
+		if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return Documentation{}, fmt.Errorf("documentation URL %q isn't an absolute http(s) URL", in.DocumentationUrl)
+		}
+	}
+	return Documentation{
+		Description: in.Description,
+		Contact:     in.Contact,
+		URL:         in.DocumentationUrl,
+	}, nil
+}
+
+// MatchesSearch returns true if the name, description, or contact of q contain text, ignoring case.
+func (q Queue) MatchesSearch(text string) bool {
+	text = strings.ToLower(text)
+	for _, field := range []string{q.Name, q.Documentation.Description, q.Documentation.Contact} {
+		if strings.Contains(strings.ToLower(field), text) {
+			return true
+		}
+	}
+	return false
+}
+
+// Generate is implementation of https://pkg.go.dev/testing/quick#Generator interface.
+// This method is used for writing tests usign https://pkg.go.dev/testing/quick package
+func (Documentation) Generate(rand *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(Documentation{
+		Description: documentationDescriptions[rand.Intn(len(documentationDescriptions))],
+		Contact:     documentationContacts[rand.Intn(len(documentationContacts))],
+		URL:         documentationURLs[rand.Intn(len(documentationURLs))],
+	})
+}
@@ -0,0 +1,69 @@
+package queue
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+func TestNewDocumentation(t *testing.T) {
+	tests := map[string]struct {
+		in    *api.Queue
+		valid bool
+	}{
+		"empty": {
+			in:    &api.Queue{},
+			valid: true,
+		},
+		"valid": {
+			in:    &api.Queue{Description: "Training jobs", Contact: "ml-team@example.com", DocumentationUrl: "https://wiki.example.com/ml"},
+			valid: true,
+		},
+		"longest description": {
+			in:    &api.Queue{Description: strings.Repeat("é", MaxDescriptionLength)},
+			valid: true,
+		},
+		"description too long": {
+			in:    &api.Queue{Description: strings.Repeat("a", MaxDescriptionLength+1)},
+			valid: false,
+		},
+		"contact too long": {
+			in:    &api.Queue{Contact: strings.Repeat("a", MaxContactLength+1)},
+			valid: false,
+		},
+		"relative URL": {
+			in:    &api.Queue{DocumentationUrl: "/wiki/ml"},
+			valid: false,
+		},
+		"non-http URL": {
+			in:    &api.Queue{DocumentationUrl: "ftp://example.com/ml"},
+			valid: false,
+		},
+		"URL too long": {
+			in:    &api.Queue{DocumentationUrl: "https://example.com/" + strings.Repeat("a", MaxDocumentationURLLength)},
+			valid: false,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := NewDocumentation(tc.in)
+			if tc.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestQueueMatchesSearch(t *testing.T) {
+	q := Queue{Name: "ml-training", Documentation: Documentation{Description: "GPU jobs", Contact: "ML-Team@example.com"}}
+	assert.True(t, q.MatchesSearch(""))
+	assert.True(t, q.MatchesSearch("Training"))
+	assert.True(t, q.MatchesSearch("gpu"))
+	assert.True(t, q.MatchesSearch("ml-team"))
+	assert.False(t, q.MatchesSearch("cpu"))
+}
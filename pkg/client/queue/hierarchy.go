@@ -0,0 +1,134 @@
+package queue
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+)
+
+// InheritedPermissions are the permissions a queue inherits from its ancestors.
+type InheritedPermissions []Permissions
+
+// Generate is implementation of https://pkg.go.dev/testing/quick#Generator interface.
+// Inherited permissions are resolved by the queue repository rather than being part of a queue, so none are generated.
+func (InheritedPermissions) Generate(rand *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(InheritedPermissions(nil))
+}
+
+// Ancestors returns the ancestors among queueByName of the queue with the given name, starting with its parent.
+// The returned ancestors end at the first missing queue or, if the parents of queues form a cycle, before any queue repeats.
+func Ancestors(name string, queueByName map[string]Queue) []Queue {
+	var ancestors []Queue
+	seen := map[string]bool{name: true}
+	for q, ok := queueByName[name]; ok && q.Parent != "" && !seen[q.Parent]; {
+		q, ok = queueByName[q.Parent]
+		if ok {
+			ancestors = append(ancestors, q)
+			seen[q.Name] = true
+		}
+	}
+	return ancestors
+}
+
+// EffectivePriorityFactors returns the priority factor of each active queue to be used for fair-share scheduling,
+// such that fair share is computed hierarchically.
+//
+// Each root queue is assigned a share proportional to its weight, i.e., the inverse of its priority factor.
+// The share of each queue is divided among the queue itself, if it's active, and its children with active queues in
+// their subtree, in proportion to their weights. The returned priority factor of each queue is the inverse of the part
+// of its share not given to its children; for queues without a parent or children, this is their own priority factor.
+// Queues with priority factor 0 inherit the priority factor of their closest ancestor with a non-zero one.
+//
+// Queues with a missing parent, or whose parents form a cycle, are treated as root queues.
+// If isActive is nil, all queues are considered active.
+func EffectivePriorityFactors(queues []Queue, isActive func(queueName string) bool) map[string]float64 {
+	if isActive == nil {
+		isActive = func(string) bool { return true }
+	}
+	queueByName := make(map[string]Queue, len(queues))
+	for _, q := range queues {
+		queueByName[q.Name] = q
+	}
+
+	// The resolved priority factor and weight, i.e., its inverse, of each queue.
+	resolvedPriorityFactorByName := make(map[string]float64, len(queues))
+	weightByName := make(map[string]float64, len(queues))
+	childrenByName := make(map[string][]string)
+	var roots []string
+	for _, q := range queues {
+		ancestors := Ancestors(q.Name, queueByName)
+		priorityFactor := float64(q.PriorityFactor)
+		for _, ancestor := range ancestors {
+			if priorityFactor > 0 {
+				break
+			}
+			priorityFactor = float64(ancestor.PriorityFactor)
+		}
+		if priorityFactor <= 0 {
+			priorityFactor = 1
+		}
+		resolvedPriorityFactorByName[q.Name] = priorityFactor
+		weightByName[q.Name] = 1 / priorityFactor
+		if len(ancestors) > 0 && ancestors[0].Name == q.Parent && !isInCycle(q.Name, ancestors, queueByName) {
+			childrenByName[q.Parent] = append(childrenByName[q.Parent], q.Name)
+		} else {
+			roots = append(roots, q.Name)
+		}
+	}
+
+	// A subtree is active if any of its queues is active.
+	isSubtreeActiveByName := make(map[string]bool, len(queues))
+	var isSubtreeActive func(name string) bool
+	isSubtreeActive = func(name string) bool {
+		if active, ok := isSubtreeActiveByName[name]; ok {
+			return active
+		}
+		active := isActive(name)
+		for _, child := range childrenByName[name] {
+			// Evaluate all children such that their activity is recorded.
+			active = isSubtreeActive(child) || active
+		}
+		isSubtreeActiveByName[name] = active
+		return active
+	}
+
+	// Shares are represented by their inverse, such that the priority factors of queues without a parent or children
+	// are returned exactly.
+	priorityFactorByName := make(map[string]float64, len(queues))
+	var divideShare func(name string, sharePriorityFactor float64)
+	divideShare = func(name string, sharePriorityFactor float64) {
+		children := childrenByName[name]
+		sort.Strings(children)
+		totalWeight := 0.0
+		if isActive(name) {
+			totalWeight += weightByName[name]
+		}
+		for _, child := range children {
+			if isSubtreeActive(child) {
+				totalWeight += weightByName[child]
+			}
+		}
+		if isActive(name) {
+			priorityFactorByName[name] = sharePriorityFactor * (totalWeight / weightByName[name])
+		}
+		for _, child := range children {
+			if isSubtreeActive(child) {
+				divideShare(child, sharePriorityFactor*(totalWeight/weightByName[child]))
+			}
+		}
+	}
+	for _, root := range roots {
+		if isSubtreeActive(root) {
+			divideShare(root, resolvedPriorityFactorByName[root])
+		}
+	}
+	return priorityFactorByName
+}
+
+// isInCycle returns true if the queue with the given name is its own ancestor.
+func isInCycle(name string, ancestors []Queue, queueByName map[string]Queue) bool {
+	if len(ancestors) == 0 {
+		return false
+	}
+	return queueByName[ancestors[len(ancestors)-1].Name].Parent == name
+}
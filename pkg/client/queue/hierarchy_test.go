@@ -0,0 +1,125 @@
+package queue
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAncestors(t *testing.T) {
+	queueByName := map[string]Queue{
+		"team":    {Name: "team"},
+		"project": {Name: "project", Parent: "team"},
+		"job":     {Name: "job", Parent: "project"},
+		"orphan":  {Name: "orphan", Parent: "missing"},
+		"a":       {Name: "a", Parent: "b"},
+		"b":       {Name: "b", Parent: "a"},
+	}
+	names := func(queues []Queue) []string {
+		var names []string
+		for _, q := range queues {
+			names = append(names, q.Name)
+		}
+		return names
+	}
+	assert.Equal(t, []string{"project", "team"}, names(Ancestors("job", queueByName)))
+	assert.Empty(t, Ancestors("team", queueByName))
+	assert.Empty(t, Ancestors("orphan", queueByName))
+	assert.Equal(t, []string{"b"}, names(Ancestors("a", queueByName)))
+}
+
+func TestQueueHasInheritedPermission(t *testing.T) {
+	subject := PermissionSubject{Kind: PermissionSubjectKindGroup, Name: "team"}
+	q := Queue{
+		Name:   "project",
+		Parent: "team",
+		InheritedPermissions: InheritedPermissions{
+			{Subjects: []PermissionSubject{subject}, Verbs: []PermissionVerb{PermissionVerbSubmit}},
+		},
+	}
+	assert.True(t, q.HasPermission(subject, PermissionVerbSubmit))
+	assert.False(t, q.HasPermission(subject, PermissionVerbCancel))
+}
+
+func TestEffectivePriorityFactors(t *testing.T) {
+	tests := map[string]struct {
+		queues                        []Queue
+		activeQueues                  []string
+		expectedPriorityFactorByQueue map[string]float64
+	}{
+		"flat": {
+			queues:                        []Queue{{Name: "a", PriorityFactor: 1}, {Name: "b", PriorityFactor: 3}},
+			expectedPriorityFactorByQueue: map[string]float64{"a": 1, "b": 3},
+		},
+		"children divide the share of their parent": {
+			queues: []Queue{
+				{Name: "team", PriorityFactor: 1},
+				{Name: "project-1", PriorityFactor: 1, Parent: "team"},
+				{Name: "project-2", PriorityFactor: 1, Parent: "team"},
+				{Name: "other-team", PriorityFactor: 1},
+			},
+			activeQueues:                  []string{"project-1", "project-2", "other-team"},
+			expectedPriorityFactorByQueue: map[string]float64{"project-1": 2, "project-2": 2, "other-team": 1},
+		},
+		"inactive children don't take part of the share of their parent": {
+			queues: []Queue{
+				{Name: "team", PriorityFactor: 1},
+				{Name: "project-1", PriorityFactor: 1, Parent: "team"},
+				{Name: "project-2", PriorityFactor: 1, Parent: "team"},
+				{Name: "other-team", PriorityFactor: 1},
+			},
+			activeQueues:                  []string{"project-1", "other-team"},
+			expectedPriorityFactorByQueue: map[string]float64{"project-1": 1, "other-team": 1},
+		},
+		"active parents share with their children": {
+			queues: []Queue{
+				{Name: "team", PriorityFactor: 1},
+				{Name: "project", PriorityFactor: 1, Parent: "team"},
+			},
+			expectedPriorityFactorByQueue: map[string]float64{"team": 2, "project": 2},
+		},
+		"children weighted by priority factor": {
+			queues: []Queue{
+				{Name: "team", PriorityFactor: 2},
+				{Name: "project-1", PriorityFactor: 1, Parent: "team"},
+				{Name: "project-2", PriorityFactor: 3, Parent: "team"},
+			},
+			activeQueues:                  []string{"project-1", "project-2"},
+			expectedPriorityFactorByQueue: map[string]float64{"project-1": 8.0 / 3, "project-2": 8},
+		},
+		"priority factor inherited": {
+			queues: []Queue{
+				{Name: "team", PriorityFactor: 2},
+				{Name: "project", Parent: "team"},
+			},
+			activeQueues:                  []string{"project"},
+			expectedPriorityFactorByQueue: map[string]float64{"project": 2},
+		},
+		"missing parents and cycles": {
+			queues: []Queue{
+				{Name: "orphan", PriorityFactor: 3, Parent: "missing"},
+				{Name: "a", PriorityFactor: 1, Parent: "b"},
+				{Name: "b", PriorityFactor: 2, Parent: "a"},
+			},
+			expectedPriorityFactorByQueue: map[string]float64{"orphan": 3, "a": 1, "b": 2},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			var isActive func(string) bool
+			if tc.activeQueues != nil {
+				isActive = func(queueName string) bool {
+					for _, activeQueue := range tc.activeQueues {
+						if activeQueue == queueName {
+							return true
+						}
+					}
+					return false
+				}
+			}
+			priorityFactorByQueue := EffectivePriorityFactors(tc.queues, isActive)
+			assert.Len(t, priorityFactorByQueue, len(tc.expectedPriorityFactorByQueue))
+			assert.InDeltaMapValues(t, tc.expectedPriorityFactorByQueue, priorityFactorByQueue, 1e-9)
+		})
+	}
+}
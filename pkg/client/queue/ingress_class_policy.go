@@ -0,0 +1,100 @@
+package queue
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"strings"
+
+	"golang.org/x/exp/slices"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+var ingressClassNames = []string{"nginx", "internal", "external"}
+
+// IngressClassPolicy specifies the ingress classes the ingresses of jobs submitted to a queue may use.
+// If AllowedClasses is empty, any class is allowed. Ingresses submitted without a class use DefaultClass, if set.
+type IngressClassPolicy struct {
+	AllowedClasses []string `json:"allowedClasses"`
+	DefaultClass   string   `json:"defaultClass"`
+}
+
+// NewIngressClassPolicy returns IngressClassPolicy using the value of in. An error is returned if any class isn't
+// a valid ingress class name or is given more than once, or if the default class isn't allowed.
+func NewIngressClassPolicy(in *api.IngressClassPolicy) (IngressClassPolicy, error) {
+	if in == nil {
+		return IngressClassPolicy{}, nil
+	}
+	policy := IngressClassPolicy{DefaultClass: in.DefaultClass}
+	for _, class := range in.AllowedClasses {
+		if errs := validation.IsDNS1123Subdomain(class); len(errs) > 0 {
+			return IngressClassPolicy{}, fmt.Errorf("invalid ingress class %q: %s", class, strings.Join(errs, "; "))
+		}
+		if slices.Contains(policy.AllowedClasses, class) {
+			return IngressClassPolicy{}, fmt.Errorf("ingress class %s is given more than once", class)
+		}
+		policy.AllowedClasses = append(policy.AllowedClasses, class)
+	}
+	if policy.DefaultClass != "" {
+		if errs := validation.IsDNS1123Subdomain(policy.DefaultClass); len(errs) > 0 {
+			return IngressClassPolicy{}, fmt.Errorf("invalid default ingress class %q: %s", policy.DefaultClass, strings.Join(errs, "; "))
+		}
+		if err := policy.Validate(policy.DefaultClass); err != nil {
+			return IngressClassPolicy{}, fmt.Errorf("invalid default ingress class: %s", err)
+		}
+	}
+	return policy, nil
+}
+
+// ToAPI transforms IngressClassPolicy to *api.IngressClassPolicy structure.
+// Returns nil if p neither restricts classes nor sets a default.
+func (p IngressClassPolicy) ToAPI() *api.IngressClassPolicy {
+	if len(p.AllowedClasses) == 0 && p.DefaultClass == "" {
+		return nil
+	}
+	return &api.IngressClassPolicy{
+		AllowedClasses: p.AllowedClasses,
+		DefaultClass:   p.DefaultClass,
+	}
+}
+
+// ClassOrDefault returns the default class if class is empty, and class otherwise.
+func (p IngressClassPolicy) ClassOrDefault(class string) string {
+	if class == "" {
+		return p.DefaultClass
+	}
+	return class
+}
+
+// Validate returns an error if ingresses of jobs of the queue may not use class, or nil otherwise.
+// The empty class, i.e., the default class of the cluster, is only allowed if classes aren't restricted.
+func (p IngressClassPolicy) Validate(class string) error {
+	if len(p.AllowedClasses) == 0 || slices.Contains(p.AllowedClasses, class) {
+		return nil
+	}
+	if class == "" {
+		return fmt.Errorf("ingresses must use one of the ingress classes allowed by the queue: %s", strings.Join(p.AllowedClasses, ", "))
+	}
+	return fmt.Errorf("ingress class %s isn't one of the ingress classes allowed by the queue: %s", class, strings.Join(p.AllowedClasses, ", "))
+}
+
+// Generate is implementation of https://pkg.go.dev/testing/quick#Generator interface.
+// This method is used for writing tests usign https://pkg.go.dev/testing/quick package
+func (IngressClassPolicy) Generate(rand *rand.Rand, size int) reflect.Value {
+	var policy IngressClassPolicy
+	for _, class := range ingressClassNames {
+		if rand.Intn(2) == 0 {
+			policy.AllowedClasses = append(policy.AllowedClasses, class)
+		}
+	}
+	if rand.Intn(2) == 0 {
+		if len(policy.AllowedClasses) > 0 {
+			policy.DefaultClass = policy.AllowedClasses[0]
+		} else {
+			policy.DefaultClass = ingressClassNames[0]
+		}
+	}
+	return reflect.ValueOf(policy)
+}
@@ -0,0 +1,66 @@
+package queue
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+func TestNewIngressClassPolicy(t *testing.T) {
+	tests := map[string]struct {
+		in    *api.IngressClassPolicy
+		valid bool
+	}{
+		"nil": {
+			in:    nil,
+			valid: true,
+		},
+		"valid": {
+			in:    &api.IngressClassPolicy{AllowedClasses: []string{"internal", "nginx"}, DefaultClass: "internal"},
+			valid: true,
+		},
+		"only default": {
+			in:    &api.IngressClassPolicy{DefaultClass: "nginx"},
+			valid: true,
+		},
+		"invalid class": {
+			in:    &api.IngressClassPolicy{AllowedClasses: []string{"Not_A_Class"}},
+			valid: false,
+		},
+		"duplicate class": {
+			in:    &api.IngressClassPolicy{AllowedClasses: []string{"nginx", "nginx"}},
+			valid: false,
+		},
+		"default not allowed": {
+			in:    &api.IngressClassPolicy{AllowedClasses: []string{"internal"}, DefaultClass: "nginx"},
+			valid: false,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := NewIngressClassPolicy(tc.in)
+			if tc.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestIngressClassPolicy_Validate(t *testing.T) {
+	policy := IngressClassPolicy{AllowedClasses: []string{"internal", "nginx"}}
+	assert.NoError(t, policy.Validate("nginx"))
+	assert.EqualError(t, policy.Validate("external"), "ingress class external isn't one of the ingress classes allowed by the queue: internal, nginx")
+	assert.EqualError(t, policy.Validate(""), "ingresses must use one of the ingress classes allowed by the queue: internal, nginx")
+	assert.NoError(t, IngressClassPolicy{}.Validate(""))
+}
+
+func TestIngressClassPolicy_ClassOrDefault(t *testing.T) {
+	policy := IngressClassPolicy{DefaultClass: "internal"}
+	assert.Equal(t, "internal", policy.ClassOrDefault(""))
+	assert.Equal(t, "nginx", policy.ClassOrDefault("nginx"))
+	assert.Equal(t, "", IngressClassPolicy{}.ClassOrDefault(""))
+}
@@ -0,0 +1,108 @@
+package queue
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+// JobPriorityPolicy specifies the default and bounds of the priorities of jobs submitted to a queue.
+// A MinPriority or MaxPriority of 0 means priorities are unbounded from below or above, respectively.
+type JobPriorityPolicy struct {
+	DefaultPriority float64 `json:"defaultPriority"`
+	MinPriority     float64 `json:"minPriority"`
+	MaxPriority     float64 `json:"maxPriority"`
+}
+
+// NewJobPriorityPolicy returns JobPriorityPolicy using the value of in. An error is returned if any priority
+// is negative, if the priority range is empty, or if the default priority is outside of it.
+func NewJobPriorityPolicy(in *api.JobPriorityPolicy) (JobPriorityPolicy, error) {
+	if in == nil {
+		return JobPriorityPolicy{}, nil
+	}
+	policy := JobPriorityPolicy{
+		DefaultPriority: in.DefaultPriority,
+		MinPriority:     in.MinPriority,
+		MaxPriority:     in.MaxPriority,
+	}
+	if policy.DefaultPriority < 0 || policy.MinPriority < 0 || policy.MaxPriority < 0 {
+		return JobPriorityPolicy{}, fmt.Errorf("priorities must not be negative")
+	}
+	if policy.MaxPriority != 0 && policy.MinPriority > policy.MaxPriority {
+		return JobPriorityPolicy{}, fmt.Errorf(
+			"min priority %g is greater than max priority %g", policy.MinPriority, policy.MaxPriority,
+		)
+	}
+	if policy.DefaultPriority != 0 && !policy.Allows(policy.DefaultPriority) {
+		return JobPriorityPolicy{}, fmt.Errorf("default priority %g is outside the allowed range", policy.DefaultPriority)
+	}
+	return policy, nil
+}
+
+// ToAPI transforms JobPriorityPolicy to *api.JobPriorityPolicy structure.
+// Returns nil if p neither sets a default nor bounds priorities.
+func (p JobPriorityPolicy) ToAPI() *api.JobPriorityPolicy {
+	if p == (JobPriorityPolicy{}) {
+		return nil
+	}
+	return &api.JobPriorityPolicy{
+		DefaultPriority: p.DefaultPriority,
+		MinPriority:     p.MinPriority,
+		MaxPriority:     p.MaxPriority,
+	}
+}
+
+// Allows returns true if jobs of the queue may have the given priority.
+func (p JobPriorityPolicy) Allows(priority float64) bool {
+	return (p.MinPriority == 0 || priority >= p.MinPriority) && (p.MaxPriority == 0 || priority <= p.MaxPriority)
+}
+
+// Validate returns an error if jobs of the queue may not have the given priority.
+func (p JobPriorityPolicy) Validate(priority float64) error {
+	if p.Allows(priority) {
+		return nil
+	}
+	if p.MaxPriority == 0 {
+		return fmt.Errorf("priority %g is less than the minimum priority %g of the queue", priority, p.MinPriority)
+	}
+	if p.MinPriority == 0 {
+		return fmt.Errorf("priority %g is greater than the maximum priority %g of the queue", priority, p.MaxPriority)
+	}
+	return fmt.Errorf("priority %g is outside the range [%g, %g] of priorities allowed by the queue", priority, p.MinPriority, p.MaxPriority)
+}
+
+// Clamp returns the allowed priority closest to priority.
+func (p JobPriorityPolicy) Clamp(priority float64) float64 {
+	if p.MinPriority != 0 && priority < p.MinPriority {
+		return p.MinPriority
+	}
+	if p.MaxPriority != 0 && priority > p.MaxPriority {
+		return p.MaxPriority
+	}
+	return priority
+}
+
+// PriorityOrDefault returns the default priority if priority is 0 and a default priority is set, and priority otherwise.
+func (p JobPriorityPolicy) PriorityOrDefault(priority float64) float64 {
+	if priority == 0 && p.DefaultPriority != 0 {
+		return p.DefaultPriority
+	}
+	return priority
+}
+
+// Generate is implementation of https://pkg.go.dev/testing/quick#Generator interface.
+// This method is used for writing tests usign https://pkg.go.dev/testing/quick package
+func (JobPriorityPolicy) Generate(rand *rand.Rand, size int) reflect.Value {
+	policy := JobPriorityPolicy{
+		MinPriority: float64(rand.Intn(10)),
+	}
+	if rand.Intn(2) == 0 {
+		policy.MaxPriority = policy.MinPriority + float64(rand.Intn(100))
+	}
+	if rand.Intn(2) == 0 {
+		policy.DefaultPriority = policy.MinPriority
+	}
+	return reflect.ValueOf(policy)
+}
@@ -0,0 +1,69 @@
+package queue
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+func TestNewJobPriorityPolicy(t *testing.T) {
+	tests := map[string]struct {
+		in    *api.JobPriorityPolicy
+		valid bool
+	}{
+		"nil": {
+			in:    nil,
+			valid: true,
+		},
+		"valid": {
+			in:    &api.JobPriorityPolicy{DefaultPriority: 5, MinPriority: 1, MaxPriority: 10},
+			valid: true,
+		},
+		"only min": {
+			in:    &api.JobPriorityPolicy{MinPriority: 1},
+			valid: true,
+		},
+		"negative": {
+			in:    &api.JobPriorityPolicy{MinPriority: -1},
+			valid: false,
+		},
+		"min greater than max": {
+			in:    &api.JobPriorityPolicy{MinPriority: 10, MaxPriority: 1},
+			valid: false,
+		},
+		"default outside range": {
+			in:    &api.JobPriorityPolicy{DefaultPriority: 20, MinPriority: 1, MaxPriority: 10},
+			valid: false,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := NewJobPriorityPolicy(tc.in)
+			if tc.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestJobPriorityPolicy_Bounds(t *testing.T) {
+	policy := JobPriorityPolicy{DefaultPriority: 5, MinPriority: 1, MaxPriority: 10}
+	assert.NoError(t, policy.Validate(1))
+	assert.NoError(t, policy.Validate(10))
+	assert.Error(t, policy.Validate(0.5))
+	assert.Error(t, policy.Validate(11))
+	assert.Equal(t, 1.0, policy.Clamp(0))
+	assert.Equal(t, 10.0, policy.Clamp(100))
+	assert.Equal(t, 5.0, policy.Clamp(5))
+	assert.Equal(t, 5.0, policy.PriorityOrDefault(0))
+	assert.Equal(t, 3.0, policy.PriorityOrDefault(3))
+
+	// The zero policy doesn't bound priorities.
+	assert.NoError(t, JobPriorityPolicy{}.Validate(1000))
+	assert.Equal(t, 0.0, JobPriorityPolicy{}.PriorityOrDefault(0))
+	assert.NoError(t, JobPriorityPolicy{MaxPriority: 10}.Validate(0))
+}
@@ -0,0 +1,62 @@
+package queue
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+var (
+	labelKeys   = []string{"team", "env", "armadaproject.io/tier"}
+	labelValues = []string{"ml", "prod", "dev", ""}
+)
+
+// Labels are arbitrary key/value pairs by which queues can be selected. Keys and values follow the syntax of Kubernetes labels.
+type Labels map[string]string
+
+// NewLabels returns Labels using the value of in. An error is returned if any key or value isn't a valid Kubernetes label key or value.
+func NewLabels(in map[string]string) (Labels, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+	out := make(Labels, len(in))
+	for key, value := range in {
+		if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+			return nil, fmt.Errorf("invalid label key %q: %s", key, strings.Join(errs, "; "))
+		}
+		if errs := validation.IsValidLabelValue(value); len(errs) > 0 {
+			return nil, fmt.Errorf("invalid value %q of label %s: %s", value, key, strings.Join(errs, "; "))
+		}
+		out[key] = value
+	}
+	return out, nil
+}
+
+// Matches returns true if l has all labels in selector.
+func (l Labels) Matches(selector map[string]string) bool {
+	for key, value := range selector {
+		if actualValue, ok := l[key]; !ok || actualValue != value {
+			return false
+		}
+	}
+	return true
+}
+
+// Generate is implementation of https://pkg.go.dev/testing/quick#Generator interface.
+// This method is used for writing tests usign https://pkg.go.dev/testing/quick package
+func (Labels) Generate(rand *rand.Rand, size int) reflect.Value {
+	var labels Labels
+	for _, key := range labelKeys {
+		if rand.Intn(2) == 0 {
+			continue
+		}
+		if labels == nil {
+			labels = make(Labels)
+		}
+		labels[key] = labelValues[rand.Intn(len(labelValues))]
+	}
+	return reflect.ValueOf(labels)
+}
@@ -0,0 +1,51 @@
+package queue
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewLabels(t *testing.T) {
+	tests := map[string]struct {
+		in    map[string]string
+		valid bool
+	}{
+		"nil": {
+			in:    nil,
+			valid: true,
+		},
+		"valid": {
+			in:    map[string]string{"team": "ml", "armadaproject.io/env": "prod", "empty": ""},
+			valid: true,
+		},
+		"invalid key": {
+			in:    map[string]string{"not a key": "ml"},
+			valid: false,
+		},
+		"invalid value": {
+			in:    map[string]string{"team": "not a value"},
+			valid: false,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := NewLabels(tc.in)
+			if tc.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestLabels_Matches(t *testing.T) {
+	labels := Labels{"team": "ml", "env": "prod"}
+	assert.True(t, labels.Matches(nil))
+	assert.True(t, labels.Matches(map[string]string{"team": "ml"}))
+	assert.True(t, labels.Matches(map[string]string{"team": "ml", "env": "prod"}))
+	assert.False(t, labels.Matches(map[string]string{"team": "infra"}))
+	assert.False(t, labels.Matches(map[string]string{"team": "ml", "tier": "gold"}))
+	assert.False(t, Labels(nil).Matches(map[string]string{"team": "ml"}))
+}
@@ -14,13 +14,15 @@ const (
 	PermissionVerbCancel       PermissionVerb = "cancel"
 	PermissionVerbReprioritize PermissionVerb = "reprioritize"
 	PermissionVerbWatch        PermissionVerb = "watch"
+	PermissionVerbPreempt      PermissionVerb = "preempt"
+	PermissionVerbExec         PermissionVerb = "exec"
 )
 
 // NewPermissionVerb returns PermissionVerb from input string. If input string doesn't match
-// one of allowed verb values ["submit", "cancel", "reprioritize", "watch"], and error is returned.
+// one of allowed verb values ["submit", "cancel", "reprioritize", "watch", "preempt", "exec"], and error is returned.
 func NewPermissionVerb(in string) (PermissionVerb, error) {
 	switch verb := PermissionVerb(in); verb {
-	case PermissionVerbSubmit, PermissionVerbCancel, PermissionVerbReprioritize, PermissionVerbWatch:
+	case PermissionVerbSubmit, PermissionVerbCancel, PermissionVerbReprioritize, PermissionVerbWatch, PermissionVerbPreempt, PermissionVerbExec:
 		return verb, nil
 	default:
 		return "", fmt.Errorf("invalid queue permission verb: %s", in)
@@ -70,6 +72,18 @@ func NewPermissionVerbs(verbs []string) (PermissionVerbs, error) {
 	return result, nil
 }
 
+// OwnerPermissionVerbs returns the PermissionVerbs granted to the owners of queues, i.e., all PermissionVerb values
+// except PermissionVerbExec, which gives access to the pods of jobs and hence must be granted explicitly.
+func OwnerPermissionVerbs() PermissionVerbs {
+	return []PermissionVerb{
+		PermissionVerbSubmit,
+		PermissionVerbCancel,
+		PermissionVerbReprioritize,
+		PermissionVerbWatch,
+		PermissionVerbPreempt,
+	}
+}
+
 // AllPermissionVerbs returns PermissionsVerbs containing all PermissionVerb values
 func AllPermissionVerbs() PermissionVerbs {
 	return []PermissionVerb{
@@ -77,5 +91,7 @@ func AllPermissionVerbs() PermissionVerbs {
 		PermissionVerbCancel,
 		PermissionVerbReprioritize,
 		PermissionVerbWatch,
+		PermissionVerbPreempt,
+		PermissionVerbExec,
 	}
 }
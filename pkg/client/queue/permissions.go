@@ -15,14 +15,14 @@ type Permissions struct {
 
 // NewPermissionsFromOwners creates Permissions from user and group owners. Permissions will
 // have User subjects that contains all users specified in users parameter and Group subjects
-// that contains all groups specified in groups parameter. Permissions will also include all
-// currently supported verbs (effectively emulating old user's and group's owner permissions).
+// that contains all groups specified in groups parameter. Permissions will also include the
+// verbs returned by OwnerPermissionVerbs (effectively emulating old user's and group's owner permissions).
 // This function is used for backward compatibility when permissions didn't exist and only user
 // and group owners could perform queue operations.
 func NewPermissionsFromOwners(users, groups []string) Permissions {
 	return Permissions{
 		Subjects: NewPermissionSubjectsFromOwners(users, groups),
-		Verbs:    AllPermissionVerbs(),
+		Verbs:    OwnerPermissionVerbs(),
 	}
 }
 
@@ -35,8 +35,8 @@ func TestNewPermissionsFromOwners(t *testing.T) {
 			}
 		}
 
-		if !reflect.DeepEqual(permissions.Verbs, AllPermissionVerbs()) {
-			t.Errorf("Invalid permission verbs: %v. Expected: %v", permissions.Verbs, AllPermissionVerbs())
+		if !reflect.DeepEqual(permissions.Verbs, OwnerPermissionVerbs()) {
+			t.Errorf("Invalid permission verbs: %v. Expected: %v", permissions.Verbs, OwnerPermissionVerbs())
 			return false
 		}
 
@@ -48,6 +48,14 @@ func TestNewPermissionsFromOwners(t *testing.T) {
 	}
 }
 
+func TestNewPermissionsFromOwners_DoesNotGrantExec(t *testing.T) {
+	for _, verb := range NewPermissionsFromOwners([]string{"user"}, []string{"group"}).Verbs {
+		if verb == PermissionVerbExec {
+			t.Fatalf("owners are granted %s", verb)
+		}
+	}
+}
+
 func TestPermissionsToAPI(t *testing.T) {
 	testCase := func(permissions1 Permissions) bool {
 		permissions2, err := NewPermissions(permissions1.ToAPI())
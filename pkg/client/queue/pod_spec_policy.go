@@ -0,0 +1,276 @@
+package queue
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+var (
+	restartPolicies = []v1.RestartPolicy{v1.RestartPolicyAlways, v1.RestartPolicyOnFailure, v1.RestartPolicyNever}
+	serviceAccounts = []string{"default", "pipeline-runner", "armada.io-reader"}
+	taintEffects    = []v1.TaintEffect{v1.TaintEffectNoSchedule, v1.TaintEffectPreferNoSchedule, v1.TaintEffectNoExecute}
+)
+
+// PodSpecPolicy specifies defaults and limits applied to the pod specs of jobs submitted to a queue.
+// Zero values mean the corresponding server-wide setting applies.
+type PodSpecPolicy struct {
+	DefaultTerminationGracePeriodSeconds uint32             `json:"defaultTerminationGracePeriodSeconds"`
+	MinTerminationGracePeriodSeconds     uint32             `json:"minTerminationGracePeriodSeconds"`
+	MaxTerminationGracePeriodSeconds     uint32             `json:"maxTerminationGracePeriodSeconds"`
+	DefaultRestartPolicy                 v1.RestartPolicy   `json:"defaultRestartPolicy"`
+	AllowedRestartPolicies               []v1.RestartPolicy `json:"allowedRestartPolicies"`
+	AllowedServiceAccounts               []string           `json:"allowedServiceAccounts"`
+	RequiredNodeSelector                 map[string]string  `json:"requiredNodeSelector"`
+	RequiredTolerations                  []v1.Toleration    `json:"requiredTolerations"`
+	ForbiddenNodeSelectorLabels          []string           `json:"forbiddenNodeSelectorLabels"`
+	ForbiddenTaints                      []v1.Taint         `json:"forbiddenTaints"`
+	RequestsEqualLimits                  bool               `json:"requestsEqualLimits"`
+}
+
+// NewPodSpecPolicy returns PodSpecPolicy using the value of in. An error is returned if in contains
+// an unknown restart policy, an invalid service account name, node selector, toleration, or taint, if the termination
+// grace period range is empty, if the defaults aren't allowed, or if the required node selector or tolerations
+// contradict the forbidden node selector labels or taints.
+func NewPodSpecPolicy(in *api.PodSpecPolicy) (PodSpecPolicy, error) {
+	if in == nil {
+		return PodSpecPolicy{}, nil
+	}
+
+	policy := PodSpecPolicy{
+		DefaultTerminationGracePeriodSeconds: in.DefaultTerminationGracePeriodSeconds,
+		MinTerminationGracePeriodSeconds:     in.MinTerminationGracePeriodSeconds,
+		MaxTerminationGracePeriodSeconds:     in.MaxTerminationGracePeriodSeconds,
+		DefaultRestartPolicy:                 v1.RestartPolicy(in.DefaultRestartPolicy),
+		AllowedServiceAccounts:               in.AllowedServiceAccounts,
+		RequiredNodeSelector:                 in.RequiredNodeSelector,
+		RequiredTolerations:                  in.RequiredTolerations,
+		ForbiddenNodeSelectorLabels:          in.ForbiddenNodeSelectorLabels,
+		ForbiddenTaints:                      in.ForbiddenTaints,
+		RequestsEqualLimits:                  in.RequestsEqualLimits,
+	}
+	for _, restartPolicy := range in.AllowedRestartPolicies {
+		policy.AllowedRestartPolicies = append(policy.AllowedRestartPolicies, v1.RestartPolicy(restartPolicy))
+	}
+
+	maxSeconds := policy.MaxTerminationGracePeriodSeconds
+	if maxSeconds != 0 && policy.MinTerminationGracePeriodSeconds > maxSeconds {
+		return PodSpecPolicy{}, fmt.Errorf(
+			"min termination grace period of %ds is greater than max termination grace period of %ds",
+			policy.MinTerminationGracePeriodSeconds, maxSeconds,
+		)
+	}
+	if defaultSeconds := policy.DefaultTerminationGracePeriodSeconds; defaultSeconds != 0 {
+		if defaultSeconds < policy.MinTerminationGracePeriodSeconds || (maxSeconds != 0 && defaultSeconds > maxSeconds) {
+			return PodSpecPolicy{}, fmt.Errorf("default termination grace period of %ds is outside the allowed range", defaultSeconds)
+		}
+	}
+	for _, restartPolicy := range policy.AllowedRestartPolicies {
+		if !isRestartPolicy(restartPolicy) {
+			return PodSpecPolicy{}, fmt.Errorf("restart policy %s is invalid. Must be one of values: %v", restartPolicy, restartPolicies)
+		}
+	}
+	for _, serviceAccount := range policy.AllowedServiceAccounts {
+		if errs := validation.IsDNS1123Subdomain(serviceAccount); len(errs) > 0 {
+			return PodSpecPolicy{}, fmt.Errorf("service account name %q is invalid: %s", serviceAccount, strings.Join(errs, "; "))
+		}
+	}
+	if policy.DefaultRestartPolicy != "" {
+		if !isRestartPolicy(policy.DefaultRestartPolicy) {
+			return PodSpecPolicy{}, fmt.Errorf("restart policy %s is invalid. Must be one of values: %v", policy.DefaultRestartPolicy, restartPolicies)
+		}
+		if !policy.AllowsRestartPolicy(policy.DefaultRestartPolicy) {
+			return PodSpecPolicy{}, fmt.Errorf("default restart policy %s is not one of the allowed restart policies %v", policy.DefaultRestartPolicy, policy.AllowedRestartPolicies)
+		}
+	}
+	if err := policy.validateNodeConstraints(); err != nil {
+		return PodSpecPolicy{}, err
+	}
+
+	return policy, nil
+}
+
+// ToAPI transforms PodSpecPolicy to *api.PodSpecPolicy structure.
+// Returns nil if p doesn't override any server-wide setting.
+func (p PodSpecPolicy) ToAPI() *api.PodSpecPolicy {
+	if reflect.DeepEqual(p, PodSpecPolicy{}) {
+		return nil
+	}
+	result := &api.PodSpecPolicy{
+		DefaultTerminationGracePeriodSeconds: p.DefaultTerminationGracePeriodSeconds,
+		MinTerminationGracePeriodSeconds:     p.MinTerminationGracePeriodSeconds,
+		MaxTerminationGracePeriodSeconds:     p.MaxTerminationGracePeriodSeconds,
+		DefaultRestartPolicy:                 string(p.DefaultRestartPolicy),
+		AllowedServiceAccounts:               p.AllowedServiceAccounts,
+		RequiredNodeSelector:                 p.RequiredNodeSelector,
+		RequiredTolerations:                  p.RequiredTolerations,
+		ForbiddenNodeSelectorLabels:          p.ForbiddenNodeSelectorLabels,
+		ForbiddenTaints:                      p.ForbiddenTaints,
+		RequestsEqualLimits:                  p.RequestsEqualLimits,
+	}
+	for _, restartPolicy := range p.AllowedRestartPolicies {
+		result.AllowedRestartPolicies = append(result.AllowedRestartPolicies, string(restartPolicy))
+	}
+	return result
+}
+
+// AllowsRestartPolicy returns true if pods of the queue may set restartPolicy.
+func (p PodSpecPolicy) AllowsRestartPolicy(restartPolicy v1.RestartPolicy) bool {
+	if len(p.AllowedRestartPolicies) == 0 {
+		return true
+	}
+	for _, allowed := range p.AllowedRestartPolicies {
+		if allowed == restartPolicy {
+			return true
+		}
+	}
+	return false
+}
+
+// AllowsServiceAccount returns true if pods of the queue may run as serviceAccount.
+func (p PodSpecPolicy) AllowsServiceAccount(serviceAccount string) bool {
+	if len(p.AllowedServiceAccounts) == 0 {
+		return true
+	}
+	for _, allowed := range p.AllowedServiceAccounts {
+		if allowed == serviceAccount {
+			return true
+		}
+	}
+	return false
+}
+
+// validateNodeConstraints returns an error if the node selectors, tolerations, or taints of p are invalid,
+// or if pods would be rejected for the node selector or tolerations p itself requires.
+func (p PodSpecPolicy) validateNodeConstraints() error {
+	for label, value := range p.RequiredNodeSelector {
+		if errs := validation.IsQualifiedName(label); len(errs) > 0 {
+			return fmt.Errorf("node selector label %q is invalid: %s", label, strings.Join(errs, "; "))
+		}
+		if errs := validation.IsValidLabelValue(value); len(errs) > 0 {
+			return fmt.Errorf("node selector value %q of label %s is invalid: %s", value, label, strings.Join(errs, "; "))
+		}
+	}
+	for _, label := range p.ForbiddenNodeSelectorLabels {
+		if errs := validation.IsQualifiedName(label); len(errs) > 0 {
+			return fmt.Errorf("node selector label %q is invalid: %s", label, strings.Join(errs, "; "))
+		}
+		if _, ok := p.RequiredNodeSelector[label]; ok {
+			return fmt.Errorf("node selector label %s is both required and forbidden", label)
+		}
+	}
+	for _, toleration := range p.RequiredTolerations {
+		if err := validateToleration(toleration); err != nil {
+			return err
+		}
+	}
+	for i := range p.ForbiddenTaints {
+		taint := &p.ForbiddenTaints[i]
+		if errs := validation.IsQualifiedName(taint.Key); len(errs) > 0 {
+			return fmt.Errorf("taint key %q is invalid: %s", taint.Key, strings.Join(errs, "; "))
+		}
+		if taint.Value != "" {
+			if errs := validation.IsValidLabelValue(taint.Value); len(errs) > 0 {
+				return fmt.Errorf("value %q of taint %s is invalid: %s", taint.Value, taint.Key, strings.Join(errs, "; "))
+			}
+		}
+		if !isTaintEffect(taint.Effect) {
+			return fmt.Errorf("effect %s of taint %s is invalid. Must be one of values: %v", taint.Effect, taint.Key, taintEffects)
+		}
+		for _, toleration := range p.RequiredTolerations {
+			if toleration.ToleratesTaint(taint) {
+				return fmt.Errorf("required toleration of %s tolerates the forbidden taint %s", toleration.Key, taint.ToString())
+			}
+		}
+	}
+	return nil
+}
+
+// Generate is implementation of https://pkg.go.dev/testing/quick#Generator interface.
+// This method is used for writing tests usign https://pkg.go.dev/testing/quick package
+func (PodSpecPolicy) Generate(rand *rand.Rand, size int) reflect.Value {
+	policy := PodSpecPolicy{
+		MinTerminationGracePeriodSeconds: uint32(rand.Intn(60)),
+	}
+	policy.MaxTerminationGracePeriodSeconds = policy.MinTerminationGracePeriodSeconds + uint32(rand.Intn(600))
+	policy.DefaultTerminationGracePeriodSeconds = policy.MinTerminationGracePeriodSeconds
+	for _, restartPolicy := range restartPolicies {
+		if rand.Intn(2) == 0 {
+			policy.AllowedRestartPolicies = append(policy.AllowedRestartPolicies, restartPolicy)
+		}
+	}
+	if len(policy.AllowedRestartPolicies) > 0 {
+		policy.DefaultRestartPolicy = policy.AllowedRestartPolicies[rand.Intn(len(policy.AllowedRestartPolicies))]
+	}
+	for _, serviceAccount := range serviceAccounts {
+		if rand.Intn(2) == 0 {
+			policy.AllowedServiceAccounts = append(policy.AllowedServiceAccounts, serviceAccount)
+		}
+	}
+	if rand.Intn(2) == 0 {
+		policy.RequiredNodeSelector = map[string]string{"armadaproject.io/pool": fmt.Sprintf("pool-%d", rand.Intn(10))}
+		policy.RequiredTolerations = []v1.Toleration{{
+			Key:      "armadaproject.io/pool",
+			Operator: v1.TolerationOpEqual,
+			Value:    policy.RequiredNodeSelector["armadaproject.io/pool"],
+			Effect:   v1.TaintEffectNoSchedule,
+		}}
+	}
+	if rand.Intn(2) == 0 {
+		policy.ForbiddenNodeSelectorLabels = []string{"armadaproject.io/dedicated"}
+		policy.ForbiddenTaints = []v1.Taint{{Key: "armadaproject.io/dedicated", Effect: taintEffects[rand.Intn(len(taintEffects))]}}
+	}
+	policy.RequestsEqualLimits = rand.Intn(2) == 0
+	return reflect.ValueOf(policy)
+}
+
+func validateToleration(toleration v1.Toleration) error {
+	if toleration.Key != "" {
+		if errs := validation.IsQualifiedName(toleration.Key); len(errs) > 0 {
+			return fmt.Errorf("toleration key %q is invalid: %s", toleration.Key, strings.Join(errs, "; "))
+		}
+	}
+	switch toleration.Operator {
+	case v1.TolerationOpExists:
+		if toleration.Value != "" {
+			return fmt.Errorf("toleration of %s must not have a value, as its operator is %s", toleration.Key, toleration.Operator)
+		}
+	case v1.TolerationOpEqual, "":
+		if toleration.Key == "" {
+			return fmt.Errorf("toleration without key must have operator %s", v1.TolerationOpExists)
+		}
+		if errs := validation.IsValidLabelValue(toleration.Value); len(errs) > 0 {
+			return fmt.Errorf("value %q of toleration of %s is invalid: %s", toleration.Value, toleration.Key, strings.Join(errs, "; "))
+		}
+	default:
+		return fmt.Errorf("operator %s of toleration of %s is invalid", toleration.Operator, toleration.Key)
+	}
+	if toleration.Effect != "" && !isTaintEffect(toleration.Effect) {
+		return fmt.Errorf("effect %s of toleration of %s is invalid. Must be one of values: %v", toleration.Effect, toleration.Key, taintEffects)
+	}
+	return nil
+}
+
+func isTaintEffect(effect v1.TaintEffect) bool {
+	for _, valid := range taintEffects {
+		if effect == valid {
+			return true
+		}
+	}
+	return false
+}
+
+func isRestartPolicy(restartPolicy v1.RestartPolicy) bool {
+	for _, valid := range restartPolicies {
+		if restartPolicy == valid {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,98 @@
+package queue
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+func TestNewPodSpecPolicy(t *testing.T) {
+	tests := map[string]struct {
+		in    *api.PodSpecPolicy
+		valid bool
+	}{
+		"nil": {
+			in:    nil,
+			valid: true,
+		},
+		"valid": {
+			in: &api.PodSpecPolicy{
+				DefaultTerminationGracePeriodSeconds: 30,
+				MinTerminationGracePeriodSeconds:     10,
+				MaxTerminationGracePeriodSeconds:     60,
+				DefaultRestartPolicy:                 "Never",
+				AllowedRestartPolicies:               []string{"Never", "OnFailure"},
+				AllowedServiceAccounts:               []string{"default", "pipeline-runner"},
+			},
+			valid: true,
+		},
+		"min greater than max": {
+			in:    &api.PodSpecPolicy{MinTerminationGracePeriodSeconds: 60, MaxTerminationGracePeriodSeconds: 10},
+			valid: false,
+		},
+		"default outside range": {
+			in:    &api.PodSpecPolicy{DefaultTerminationGracePeriodSeconds: 5, MinTerminationGracePeriodSeconds: 10},
+			valid: false,
+		},
+		"unknown restart policy": {
+			in:    &api.PodSpecPolicy{AllowedRestartPolicies: []string{"Sometimes"}},
+			valid: false,
+		},
+		"invalid service account": {
+			in:    &api.PodSpecPolicy{AllowedServiceAccounts: []string{"Pipeline Runner"}},
+			valid: false,
+		},
+		"default restart policy not allowed": {
+			in:    &api.PodSpecPolicy{DefaultRestartPolicy: "Always", AllowedRestartPolicies: []string{"Never"}},
+			valid: false,
+		},
+		"node constraints": {
+			in: &api.PodSpecPolicy{
+				RequiredNodeSelector:        map[string]string{"pool": "team-a"},
+				RequiredTolerations:         []v1.Toleration{{Key: "pool", Operator: v1.TolerationOpEqual, Value: "team-a"}},
+				ForbiddenNodeSelectorLabels: []string{"dedicated"},
+				ForbiddenTaints:             []v1.Taint{{Key: "pool", Value: "team-b", Effect: v1.TaintEffectNoSchedule}},
+			},
+			valid: true,
+		},
+		"invalid node selector label": {
+			in:    &api.PodSpecPolicy{RequiredNodeSelector: map[string]string{"team pool": "a"}},
+			valid: false,
+		},
+		"invalid toleration": {
+			in:    &api.PodSpecPolicy{RequiredTolerations: []v1.Toleration{{Key: "pool", Operator: v1.TolerationOpExists, Value: "a"}}},
+			valid: false,
+		},
+		"invalid taint effect": {
+			in:    &api.PodSpecPolicy{ForbiddenTaints: []v1.Taint{{Key: "pool", Effect: "Sometimes"}}},
+			valid: false,
+		},
+		"required node selector label forbidden": {
+			in: &api.PodSpecPolicy{
+				RequiredNodeSelector:        map[string]string{"pool": "team-a"},
+				ForbiddenNodeSelectorLabels: []string{"pool"},
+			},
+			valid: false,
+		},
+		"required toleration tolerates forbidden taint": {
+			in: &api.PodSpecPolicy{
+				RequiredTolerations: []v1.Toleration{{Key: "pool", Operator: v1.TolerationOpExists}},
+				ForbiddenTaints:     []v1.Taint{{Key: "pool", Value: "team-b", Effect: v1.TaintEffectNoSchedule}},
+			},
+			valid: false,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := NewPodSpecPolicy(tc.in)
+			if tc.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
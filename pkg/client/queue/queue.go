@@ -7,10 +7,43 @@ import (
 )
 
 type Queue struct {
-	Name           string         `json:"name"`
-	Permissions    []Permissions  `json:"permissions"`
-	PriorityFactor PriorityFactor `json:"priorityFactor"`
-	ResourceLimits ResourceLimits `json:"resourceLimits"`
+	Name                string              `json:"name"`
+	Permissions         []Permissions       `json:"permissions"`
+	PriorityFactor      PriorityFactor      `json:"priorityFactor"`
+	ResourceLimits      ResourceLimits      `json:"resourceLimits"`
+	MaxJobSizeBytes     uint32              `json:"maxJobSizeBytes"`
+	MaxContainersPerJob uint32              `json:"maxContainersPerJob"`
+	PodSpecPolicy       PodSpecPolicy       `json:"podSpecPolicy"`
+	ResourceQuotas      ResourceQuotas      `json:"resourceQuotas"`
+	Parent              string              `json:"parent"`
+	Labels              Labels              `json:"labels"`
+	RequiredAnnotations RequiredAnnotations `json:"requiredAnnotations"`
+	JobPriorityPolicy   JobPriorityPolicy   `json:"jobPriorityPolicy"`
+	// Periods during which jobs may be submitted to the queue.
+	SubmissionWindows SubmissionWindowPolicy `json:"submissionWindows"`
+	// What the queue is for and whom to contact about it.
+	Documentation Documentation `json:"documentation"`
+	// Maximum runtime in seconds of jobs submitted to the queue. If 0, runtimes are unbounded.
+	MaxJobRuntimeSeconds uint32 `json:"maxJobRuntimeSeconds"`
+	// Namespaces jobs submitted to the queue may be created in. If empty, any namespace is allowed.
+	AllowedNamespaces AllowedNamespaces `json:"allowedNamespaces"`
+	// Limits on the resource-hours used by the jobs of the queue over rolling windows.
+	ResourceBudgets ResourceBudgets `json:"resourceBudgets"`
+	// Ingress classes the ingresses of jobs submitted to the queue may use, and their default.
+	IngressClassPolicy IngressClassPolicy `json:"ingressClassPolicy"`
+	// If true, users may cancel and reprioritize their own jobs without being permitted to do so for all jobs of the queue.
+	OwnersCanManageOwnJobs bool `json:"ownersCanManageOwnJobs"`
+	// Incremented by the queue repository whenever the queue is changed.
+	Revision uint64 `json:"revision"`
+	// Version of the queue repository at which the queue was last changed. Ordered across queues.
+	ResourceVersion uint64 `json:"resourceVersion"`
+	// Set if the queue is archived. Only changed by archiving and restoring the queue.
+	Archival *Archival `json:"archival,omitempty"`
+	// Set by the queue repository when the queue is created.
+	Created *CreationTime `json:"created,omitempty"`
+	// Permissions inherited from the ancestors of the queue, as resolved by the queue repository.
+	// These aren't part of the queue itself and are therefore neither serialized nor converted to the API representation.
+	InheritedPermissions InheritedPermissions `json:"-"`
 }
 
 // NewQueue returnes new Queue using the in parameter. Error is returned if
@@ -20,9 +53,18 @@ func NewQueue(in *api.Queue) (Queue, error) {
 		return Queue{}, fmt.Errorf("queue is nil")
 	}
 
-	priorityFactor, err := NewPriorityFactor(in.PriorityFactor)
-	if err != nil {
-		return Queue{}, fmt.Errorf("failed to map priority factor. %s", err)
+	// Queues with a parent inherit the priority factor of their parent if theirs is 0.
+	var priorityFactor PriorityFactor
+	if in.Parent == "" || in.PriorityFactor != 0 {
+		var err error
+		priorityFactor, err = NewPriorityFactor(in.PriorityFactor)
+		if err != nil {
+			return Queue{}, fmt.Errorf("failed to map priority factor. %s", err)
+		}
+	}
+
+	if in.Parent == in.Name && in.Parent != "" {
+		return Queue{}, fmt.Errorf("queue %s cannot be its own parent", in.Name)
 	}
 
 	resourceLimits, err := NewResourceLimits(in.ResourceLimits)
@@ -30,6 +72,56 @@ func NewQueue(in *api.Queue) (Queue, error) {
 		return Queue{}, fmt.Errorf("failed to map resource limits: %v. %s", in.ResourceLimits, err)
 	}
 
+	podSpecPolicy, err := NewPodSpecPolicy(in.PodSpecPolicy)
+	if err != nil {
+		return Queue{}, fmt.Errorf("failed to map pod spec policy. %s", err)
+	}
+
+	resourceQuotas, err := NewResourceQuotas(in.ResourceQuotas)
+	if err != nil {
+		return Queue{}, fmt.Errorf("failed to map resource quotas. %s", err)
+	}
+
+	labels, err := NewLabels(in.Labels)
+	if err != nil {
+		return Queue{}, fmt.Errorf("failed to map labels. %s", err)
+	}
+
+	requiredAnnotations, err := NewRequiredAnnotations(in.RequiredAnnotations)
+	if err != nil {
+		return Queue{}, fmt.Errorf("failed to map required annotations. %s", err)
+	}
+
+	allowedNamespaces, err := NewAllowedNamespaces(in.AllowedNamespaces)
+	if err != nil {
+		return Queue{}, fmt.Errorf("failed to map allowed namespaces. %s", err)
+	}
+
+	resourceBudgets, err := NewResourceBudgets(in.ResourceBudgets)
+	if err != nil {
+		return Queue{}, fmt.Errorf("failed to map resource budgets. %s", err)
+	}
+
+	ingressClassPolicy, err := NewIngressClassPolicy(in.IngressClassPolicy)
+	if err != nil {
+		return Queue{}, fmt.Errorf("failed to map ingress class policy. %s", err)
+	}
+
+	jobPriorityPolicy, err := NewJobPriorityPolicy(in.JobPriorityPolicy)
+	if err != nil {
+		return Queue{}, fmt.Errorf("failed to map job priority policy. %s", err)
+	}
+
+	submissionWindows, err := NewSubmissionWindowPolicy(in.SubmissionWindows)
+	if err != nil {
+		return Queue{}, fmt.Errorf("failed to map submission windows. %s", err)
+	}
+
+	documentation, err := NewDocumentation(in)
+	if err != nil {
+		return Queue{}, fmt.Errorf("failed to map documentation. %s", err)
+	}
+
 	permissions := []Permissions{}
 	if len(in.GroupOwners) != 0 || len(in.UserOwners) != 0 {
 		permissions = append(permissions, NewPermissionsFromOwners(in.UserOwners, in.GroupOwners))
@@ -46,9 +138,28 @@ func NewQueue(in *api.Queue) (Queue, error) {
 	return Queue{
 		Name: in.Name,
 		// Kind:           "Queue",
-		PriorityFactor: priorityFactor,
-		ResourceLimits: resourceLimits,
-		Permissions:    permissions,
+		PriorityFactor:         priorityFactor,
+		ResourceLimits:         resourceLimits,
+		Permissions:            permissions,
+		MaxJobSizeBytes:        in.MaxJobSizeBytes,
+		MaxContainersPerJob:    in.MaxContainersPerJob,
+		PodSpecPolicy:          podSpecPolicy,
+		ResourceQuotas:         resourceQuotas,
+		Parent:                 in.Parent,
+		Labels:                 labels,
+		RequiredAnnotations:    requiredAnnotations,
+		JobPriorityPolicy:      jobPriorityPolicy,
+		SubmissionWindows:      submissionWindows,
+		Documentation:          documentation,
+		MaxJobRuntimeSeconds:   in.MaxJobRuntimeSeconds,
+		AllowedNamespaces:      allowedNamespaces,
+		ResourceBudgets:        resourceBudgets,
+		IngressClassPolicy:     ingressClassPolicy,
+		OwnersCanManageOwnJobs: in.OwnersCanManageOwnJobs,
+		Revision:               in.Revision,
+		ResourceVersion:        in.ResourceVersion,
+		Archival:               NewArchival(in.Archival),
+		Created:                NewCreationTime(in.Created),
 	}, nil
 }
 
@@ -57,8 +168,29 @@ func (q Queue) ToAPI() *api.Queue {
 	result := &api.Queue{
 		Name: q.Name,
 		// Kind:           q.Kind,
-		PriorityFactor: float64(q.PriorityFactor),
-		ResourceLimits: map[string]float64{},
+		PriorityFactor:         float64(q.PriorityFactor),
+		ResourceLimits:         map[string]float64{},
+		MaxJobSizeBytes:        q.MaxJobSizeBytes,
+		MaxContainersPerJob:    q.MaxContainersPerJob,
+		PodSpecPolicy:          q.PodSpecPolicy.ToAPI(),
+		ResourceQuotas:         q.ResourceQuotas,
+		Parent:                 q.Parent,
+		Labels:                 q.Labels,
+		RequiredAnnotations:    q.RequiredAnnotations,
+		JobPriorityPolicy:      q.JobPriorityPolicy.ToAPI(),
+		SubmissionWindows:      q.SubmissionWindows.ToAPI(),
+		Description:            q.Documentation.Description,
+		Contact:                q.Documentation.Contact,
+		DocumentationUrl:       q.Documentation.URL,
+		MaxJobRuntimeSeconds:   q.MaxJobRuntimeSeconds,
+		AllowedNamespaces:      q.AllowedNamespaces,
+		ResourceBudgets:        q.ResourceBudgets.ToAPI(),
+		IngressClassPolicy:     q.IngressClassPolicy.ToAPI(),
+		OwnersCanManageOwnJobs: q.OwnersCanManageOwnJobs,
+		Revision:               q.Revision,
+		ResourceVersion:        q.ResourceVersion,
+		Archival:               q.Archival.ToAPI(),
+		Created:                q.Created.ToAPI(),
 	}
 
 	for resourceName, resourceLimit := range q.ResourceLimits {
@@ -73,9 +205,20 @@ func (q Queue) ToAPI() *api.Queue {
 }
 
 // HasPermission returns true if the inputSubject is allowed to peform a queue operation
-// specified by inputVerb parameter, otherwise returns false
+// specified by inputVerb parameter, either directly or through permissions inherited from
+// the ancestors of the queue, otherwise returns false
 func (q Queue) HasPermission(inputSubject PermissionSubject, inputVerb PermissionVerb) bool {
-	for _, permission := range q.Permissions {
+	return hasPermission(q.Permissions, inputSubject, inputVerb) ||
+		hasPermission(q.InheritedPermissions, inputSubject, inputVerb)
+}
+
+// IsArchived returns true if the queue is archived and hence rejects new submissions.
+func (q Queue) IsArchived() bool {
+	return q.Archival != nil
+}
+
+func hasPermission(permissions []Permissions, inputSubject PermissionSubject, inputVerb PermissionVerb) bool {
+	for _, permission := range permissions {
 		for _, subject := range permission.Subjects {
 			if subject == inputSubject {
 				for _, verb := range permission.Verbs {
@@ -0,0 +1,90 @@
+package queue
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+var (
+	requiredAnnotationKeys     = []string{"cost-center", "team", "armadaproject.io/project"}
+	requiredAnnotationPatterns = []string{"", "[0-9]{4}", "ml|infra"}
+)
+
+// RequiredAnnotations maps the keys of annotations that jobs submitted to a queue must have to regular expressions
+// their values must match in full. An empty expression allows any value.
+type RequiredAnnotations map[string]string
+
+// NewRequiredAnnotations returns RequiredAnnotations using the value of in. An error is returned if any key
+// isn't a valid Kubernetes annotation key or any expression isn't a valid regular expression.
+func NewRequiredAnnotations(in map[string]string) (RequiredAnnotations, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+	out := make(RequiredAnnotations, len(in))
+	for key, pattern := range in {
+		if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+			return nil, fmt.Errorf("invalid annotation key %q: %s", key, strings.Join(errs, "; "))
+		}
+		if _, err := compileRequiredAnnotationPattern(pattern); err != nil {
+			return nil, fmt.Errorf("invalid pattern %q of annotation %s: %s", pattern, key, err)
+		}
+		out[key] = pattern
+	}
+	return out, nil
+}
+
+// Validate returns an error listing the required annotations that are missing from annotations
+// or whose values don't match their expression, or nil if there are none.
+func (r RequiredAnnotations) Validate(annotations map[string]string) error {
+	var problems []string
+	for key, pattern := range r {
+		value, ok := annotations[key]
+		if !ok {
+			problems = append(problems, fmt.Sprintf("annotation %s is required", key))
+			continue
+		}
+		if pattern == "" {
+			continue
+		}
+		re, err := compileRequiredAnnotationPattern(pattern)
+		if err != nil {
+			return fmt.Errorf("invalid pattern %q of annotation %s: %s", pattern, key, err)
+		}
+		if !re.MatchString(value) {
+			problems = append(problems, fmt.Sprintf("value %q of annotation %s doesn't match %q", value, key, pattern))
+		}
+	}
+	if len(problems) == 0 {
+		return nil
+	}
+	sort.Strings(problems)
+	return errors.New(strings.Join(problems, "; "))
+}
+
+// compileRequiredAnnotationPattern compiles pattern such that it only matches values in full.
+func compileRequiredAnnotationPattern(pattern string) (*regexp.Regexp, error) {
+	return regexp.Compile("^(?:" + pattern + ")$")
+}
+
+// Generate is implementation of https://pkg.go.dev/testing/quick#Generator interface.
+// This method is used for writing tests usign https://pkg.go.dev/testing/quick package
+func (RequiredAnnotations) Generate(rand *rand.Rand, size int) reflect.Value {
+	var requiredAnnotations RequiredAnnotations
+	for _, key := range requiredAnnotationKeys {
+		if rand.Intn(2) == 0 {
+			continue
+		}
+		if requiredAnnotations == nil {
+			requiredAnnotations = make(RequiredAnnotations)
+		}
+		requiredAnnotations[key] = requiredAnnotationPatterns[rand.Intn(len(requiredAnnotationPatterns))]
+	}
+	return reflect.ValueOf(requiredAnnotations)
+}
@@ -0,0 +1,79 @@
+package queue
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewRequiredAnnotations(t *testing.T) {
+	tests := map[string]struct {
+		in    map[string]string
+		valid bool
+	}{
+		"nil": {
+			in:    nil,
+			valid: true,
+		},
+		"valid": {
+			in:    map[string]string{"cost-center": "[0-9]{4}", "armadaproject.io/team": "ml|infra", "owner": ""},
+			valid: true,
+		},
+		"invalid key": {
+			in:    map[string]string{"not a key": ""},
+			valid: false,
+		},
+		"invalid pattern": {
+			in:    map[string]string{"cost-center": "[0-9"},
+			valid: false,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := NewRequiredAnnotations(tc.in)
+			if tc.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestRequiredAnnotations_Validate(t *testing.T) {
+	required := RequiredAnnotations{"cost-center": "[0-9]{4}", "owner": ""}
+	tests := map[string]struct {
+		annotations map[string]string
+		expectedErr string
+	}{
+		"all present": {
+			annotations: map[string]string{"cost-center": "1234", "owner": "alice", "other": "x"},
+		},
+		"empty value allowed by empty pattern": {
+			annotations: map[string]string{"cost-center": "1234", "owner": ""},
+		},
+		"missing": {
+			annotations: map[string]string{"cost-center": "1234"},
+			expectedErr: "annotation owner is required",
+		},
+		"partial match": {
+			annotations: map[string]string{"cost-center": "12345", "owner": "alice"},
+			expectedErr: `value "12345" of annotation cost-center doesn't match "[0-9]{4}"`,
+		},
+		"several problems": {
+			annotations: nil,
+			expectedErr: "annotation cost-center is required; annotation owner is required",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := required.Validate(tc.annotations)
+			if tc.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.expectedErr)
+			}
+		})
+	}
+	assert.NoError(t, RequiredAnnotations(nil).Validate(nil))
+}
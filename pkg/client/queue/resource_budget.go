@@ -0,0 +1,155 @@
+package queue
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"time"
+
+	"golang.org/x/exp/slices"
+
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+var budgetResourceNames = []string{"cpu", "nvidia.com/gpu"}
+
+// ResourceBudgetAction is what happens to jobs requesting the resource of a budget that are submitted while it's exhausted.
+type ResourceBudgetAction string
+
+const (
+	// Jobs are rejected.
+	ResourceBudgetActionReject ResourceBudgetAction = "reject"
+	// Jobs are accepted with a priority of at least the deprioritized priority of the budget.
+	ResourceBudgetActionDeprioritize ResourceBudgetAction = "deprioritize"
+)
+
+// ResourceBudget limits the number of hours of one unit of Resource, e.g., of one GPU, used by the jobs of a queue,
+// or by the jobs of each of its owners if PerOwner is set, within a rolling window of WindowHours hours.
+type ResourceBudget struct {
+	Resource    string               `json:"resource"`
+	Hours       float64              `json:"hours"`
+	WindowHours uint32               `json:"windowHours"`
+	PerOwner    bool                 `json:"perOwner"`
+	Action      ResourceBudgetAction `json:"action"`
+	// Minimum priority of jobs deprioritized by the budget. Only set if Action is ResourceBudgetActionDeprioritize.
+	DeprioritizedPriority float64 `json:"deprioritizedPriority"`
+}
+
+// ResourceBudgets are the resource budgets of a queue.
+type ResourceBudgets []ResourceBudget
+
+// NewResourceBudgets returns ResourceBudgets using the value of in. An error is returned if any budget has no resource,
+// hours or window that aren't positive, or an unknown action, if a budget that deprioritizes jobs doesn't have
+// a positive deprioritized priority, or if there's more than one budget for the same resource, window and scope.
+func NewResourceBudgets(in []*api.ResourceBudget) (ResourceBudgets, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+	out := make(ResourceBudgets, 0, len(in))
+	for i, budget := range in {
+		if budget == nil {
+			return nil, fmt.Errorf("resource budget %d is nil", i)
+		}
+		b := ResourceBudget{
+			Resource:    budget.Resource,
+			Hours:       budget.Hours,
+			WindowHours: budget.WindowHours,
+			PerOwner:    budget.PerOwner,
+		}
+		switch budget.Action {
+		case api.ResourceBudget_REJECT:
+			b.Action = ResourceBudgetActionReject
+		case api.ResourceBudget_DEPRIORITIZE:
+			b.Action = ResourceBudgetActionDeprioritize
+			b.DeprioritizedPriority = budget.DeprioritizedPriority
+		default:
+			return nil, fmt.Errorf("resource budget %d has unknown action %d", i, budget.Action)
+		}
+		if b.Resource == "" {
+			return nil, fmt.Errorf("resource budget %d has no resource", i)
+		}
+		if b.Hours <= 0 {
+			return nil, fmt.Errorf("hours of resource budget %d must be positive, but are %g", i, b.Hours)
+		}
+		if b.WindowHours == 0 {
+			return nil, fmt.Errorf("window of resource budget %d must be positive", i)
+		}
+		if b.Action == ResourceBudgetActionDeprioritize && b.DeprioritizedPriority <= 0 {
+			return nil, fmt.Errorf("deprioritized priority of resource budget %d must be positive, but is %g", i, b.DeprioritizedPriority)
+		}
+		if slices.IndexFunc(out, func(other ResourceBudget) bool {
+			return other.Resource == b.Resource && other.WindowHours == b.WindowHours && other.PerOwner == b.PerOwner
+		}) >= 0 {
+			return nil, fmt.Errorf("resource budget %d has the same resource, window and scope as another budget", i)
+		}
+		out = append(out, b)
+	}
+	return out, nil
+}
+
+// ToAPI transforms ResourceBudgets to []*api.ResourceBudget.
+func (b ResourceBudgets) ToAPI() []*api.ResourceBudget {
+	if len(b) == 0 {
+		return nil
+	}
+	result := make([]*api.ResourceBudget, len(b))
+	for i, budget := range b {
+		result[i] = budget.ToAPI()
+	}
+	return result
+}
+
+// ToAPI transforms ResourceBudget to *api.ResourceBudget.
+func (b ResourceBudget) ToAPI() *api.ResourceBudget {
+	result := &api.ResourceBudget{
+		Resource:              b.Resource,
+		Hours:                 b.Hours,
+		WindowHours:           b.WindowHours,
+		PerOwner:              b.PerOwner,
+		DeprioritizedPriority: b.DeprioritizedPriority,
+	}
+	if b.Action == ResourceBudgetActionDeprioritize {
+		result.Action = api.ResourceBudget_DEPRIORITIZE
+	}
+	return result
+}
+
+// Window returns the length of the rolling window over which usage is accounted.
+func (b ResourceBudget) Window() time.Duration {
+	return time.Duration(b.WindowHours) * time.Hour
+}
+
+// LongestWindow returns the length of the longest window of any budget, or 0 if there are no budgets.
+func (b ResourceBudgets) LongestWindow() time.Duration {
+	var rv time.Duration
+	for _, budget := range b {
+		if window := budget.Window(); window > rv {
+			rv = window
+		}
+	}
+	return rv
+}
+
+// Generate is implementation of https://pkg.go.dev/testing/quick#Generator interface.
+// This method is used for writing tests usign https://pkg.go.dev/testing/quick package
+func (ResourceBudgets) Generate(rand *rand.Rand, size int) reflect.Value {
+	var budgets ResourceBudgets
+	for _, resourceName := range budgetResourceNames {
+		if rand.Intn(2) == 0 {
+			continue
+		}
+		budget := ResourceBudget{
+			Resource:    resourceName,
+			Hours:       float64(rand.Intn(1000) + 1),
+			WindowHours: uint32(rand.Intn(168) + 1),
+			PerOwner:    rand.Intn(2) == 0,
+			Action:      ResourceBudgetActionReject,
+		}
+		if rand.Intn(2) == 0 {
+			budget.Action = ResourceBudgetActionDeprioritize
+			budget.DeprioritizedPriority = float64(rand.Intn(100) + 1)
+		}
+		budgets = append(budgets, budget)
+	}
+	return reflect.ValueOf(budgets)
+}
@@ -0,0 +1,86 @@
+package queue
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+func TestNewResourceBudgets(t *testing.T) {
+	tests := map[string]struct {
+		in       []*api.ResourceBudget
+		expected ResourceBudgets
+		valid    bool
+	}{
+		"nil": {
+			in:    nil,
+			valid: true,
+		},
+		"valid": {
+			in: []*api.ResourceBudget{
+				{Resource: "nvidia.com/gpu", Hours: 100, WindowHours: 24},
+				{Resource: "nvidia.com/gpu", Hours: 10, WindowHours: 24, PerOwner: true, Action: api.ResourceBudget_DEPRIORITIZE, DeprioritizedPriority: 100},
+			},
+			expected: ResourceBudgets{
+				{Resource: "nvidia.com/gpu", Hours: 100, WindowHours: 24, Action: ResourceBudgetActionReject},
+				{Resource: "nvidia.com/gpu", Hours: 10, WindowHours: 24, PerOwner: true, Action: ResourceBudgetActionDeprioritize, DeprioritizedPriority: 100},
+			},
+			valid: true,
+		},
+		"deprioritized priority of rejecting budget is ignored": {
+			in:       []*api.ResourceBudget{{Resource: "cpu", Hours: 100, WindowHours: 24, DeprioritizedPriority: 100}},
+			expected: ResourceBudgets{{Resource: "cpu", Hours: 100, WindowHours: 24, Action: ResourceBudgetActionReject}},
+			valid:    true,
+		},
+		"no resource": {
+			in:    []*api.ResourceBudget{{Hours: 100, WindowHours: 24}},
+			valid: false,
+		},
+		"zero hours": {
+			in:    []*api.ResourceBudget{{Resource: "cpu", WindowHours: 24}},
+			valid: false,
+		},
+		"zero window": {
+			in:    []*api.ResourceBudget{{Resource: "cpu", Hours: 100}},
+			valid: false,
+		},
+		"deprioritize without priority": {
+			in:    []*api.ResourceBudget{{Resource: "cpu", Hours: 100, WindowHours: 24, Action: api.ResourceBudget_DEPRIORITIZE}},
+			valid: false,
+		},
+		"unknown action": {
+			in:    []*api.ResourceBudget{{Resource: "cpu", Hours: 100, WindowHours: 24, Action: 7}},
+			valid: false,
+		},
+		"duplicate budget": {
+			in: []*api.ResourceBudget{
+				{Resource: "cpu", Hours: 100, WindowHours: 24},
+				{Resource: "cpu", Hours: 50, WindowHours: 24, Action: api.ResourceBudget_DEPRIORITIZE, DeprioritizedPriority: 100},
+			},
+			valid: false,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			budgets, err := NewResourceBudgets(tc.in)
+			if tc.valid {
+				assert.NoError(t, err)
+				assert.Equal(t, tc.expected, budgets)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestResourceBudgets_LongestWindow(t *testing.T) {
+	budgets := ResourceBudgets{
+		{Resource: "cpu", Hours: 100, WindowHours: 24},
+		{Resource: "cpu", Hours: 500, WindowHours: 168},
+	}
+	assert.Equal(t, 168*time.Hour, budgets.LongestWindow())
+	assert.Equal(t, time.Duration(0), ResourceBudgets(nil).LongestWindow())
+}
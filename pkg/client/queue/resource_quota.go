@@ -0,0 +1,45 @@
+package queue
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+var quotaResourceNames = []string{"cpu", "memory", "nvidia.com/gpu", "ephemeral-storage"}
+
+// ResourceQuotas limits the total resources requested by the queued and running jobs of a queue, indexed by resource name.
+type ResourceQuotas map[string]resource.Quantity
+
+// NewResourceQuotas returns ResourceQuotas using the value of in. An error is returned if any quota is negative.
+func NewResourceQuotas(in map[string]resource.Quantity) (ResourceQuotas, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+	out := make(ResourceQuotas, len(in))
+	for resourceName, quota := range in {
+		if quota.Sign() < 0 {
+			return nil, fmt.Errorf("quota of resource %s must not be negative, but is %s", resourceName, quota.String())
+		}
+		out[resourceName] = quota
+	}
+	return out, nil
+}
+
+// Generate is implementation of https://pkg.go.dev/testing/quick#Generator interface.
+// This method is used for writing tests usign https://pkg.go.dev/testing/quick package
+func (ResourceQuotas) Generate(rand *rand.Rand, size int) reflect.Value {
+	var quotas ResourceQuotas
+	for _, resourceName := range quotaResourceNames {
+		if rand.Intn(2) == 0 {
+			continue
+		}
+		if quotas == nil {
+			quotas = make(ResourceQuotas)
+		}
+		quotas[resourceName] = resource.MustParse(fmt.Sprintf("%d", rand.Intn(1000)))
+	}
+	return reflect.ValueOf(quotas)
+}
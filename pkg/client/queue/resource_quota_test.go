@@ -0,0 +1,43 @@
+package queue
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func TestNewResourceQuotas(t *testing.T) {
+	tests := map[string]struct {
+		in    map[string]resource.Quantity
+		valid bool
+	}{
+		"nil": {
+			in:    nil,
+			valid: true,
+		},
+		"valid": {
+			in: map[string]resource.Quantity{
+				"cpu":               resource.MustParse("1000"),
+				"memory":            resource.MustParse("4Ti"),
+				"nvidia.com/gpu":    resource.MustParse("16"),
+				"ephemeral-storage": resource.MustParse("0"),
+			},
+			valid: true,
+		},
+		"negative quota": {
+			in:    map[string]resource.Quantity{"nvidia.com/gpu": resource.MustParse("-1")},
+			valid: false,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := NewResourceQuotas(tc.in)
+			if tc.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
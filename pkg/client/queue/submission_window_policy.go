@@ -0,0 +1,151 @@
+package queue
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"time"
+
+	"github.com/armadaproject/armada/internal/common/cron"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+// SubmissionWindowPolicy specifies the periods during which jobs may be submitted to a queue.
+// If there are no windows, jobs may be submitted at any time.
+type SubmissionWindowPolicy struct {
+	Windows []SubmissionWindow `json:"windows"`
+	// If true, jobs submitted outside of all windows are held until the next window starts instead of being rejected.
+	HoldOutsideWindows bool `json:"holdOutsideWindows"`
+}
+
+// SubmissionWindow is a period of DurationMinutes minutes starting at each time matching the cron expression Start,
+// interpreted in TimeZone, or UTC if TimeZone is empty.
+type SubmissionWindow struct {
+	Start           string `json:"start"`
+	DurationMinutes uint32 `json:"durationMinutes"`
+	TimeZone        string `json:"timeZone"`
+}
+
+// NewSubmissionWindowPolicy returns SubmissionWindowPolicy using the value of in. An error is returned if the start
+// of any window isn't a valid cron expression, if its duration is zero, or if its time zone is unknown.
+func NewSubmissionWindowPolicy(in *api.SubmissionWindowPolicy) (SubmissionWindowPolicy, error) {
+	if in == nil {
+		return SubmissionWindowPolicy{}, nil
+	}
+	policy := SubmissionWindowPolicy{HoldOutsideWindows: in.HoldOutsideWindows}
+	for i, window := range in.Windows {
+		w := SubmissionWindow{
+			Start:           window.Start,
+			DurationMinutes: window.DurationMinutes,
+			TimeZone:        window.TimeZone,
+		}
+		if _, _, err := w.parse(); err != nil {
+			return SubmissionWindowPolicy{}, fmt.Errorf("invalid submission window %d: %s", i, err)
+		}
+		policy.Windows = append(policy.Windows, w)
+	}
+	return policy, nil
+}
+
+// ToAPI transforms SubmissionWindowPolicy to *api.SubmissionWindowPolicy structure.
+// Returns nil if p has no windows and doesn't hold jobs.
+func (p SubmissionWindowPolicy) ToAPI() *api.SubmissionWindowPolicy {
+	if len(p.Windows) == 0 && !p.HoldOutsideWindows {
+		return nil
+	}
+	result := &api.SubmissionWindowPolicy{HoldOutsideWindows: p.HoldOutsideWindows}
+	for _, w := range p.Windows {
+		result.Windows = append(result.Windows, &api.SubmissionWindow{
+			Start:           w.Start,
+			DurationMinutes: w.DurationMinutes,
+			TimeZone:        w.TimeZone,
+		})
+	}
+	return result
+}
+
+// Allows returns true if jobs may be submitted at time t.
+func (p SubmissionWindowPolicy) Allows(t time.Time) bool {
+	if len(p.Windows) == 0 {
+		return true
+	}
+	for _, w := range p.Windows {
+		if w.contains(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// NextWindowStart returns the earliest time after t at which a window starts,
+// or the zero time if no window starts within the next few years.
+func (p SubmissionWindowPolicy) NextWindowStart(t time.Time) time.Time {
+	var rv time.Time
+	for _, w := range p.Windows {
+		schedule, loc, err := w.parse()
+		if err != nil {
+			continue
+		}
+		start := schedule.Next(t.In(loc))
+		if !start.IsZero() && (rv.IsZero() || start.Before(rv)) {
+			rv = start
+		}
+	}
+	return rv
+}
+
+// Admit returns the zero time if jobs may be submitted at time t. Otherwise, if jobs submitted outside of windows are
+// held, it returns the time at which jobs submitted at time t are released, i.e., the start of the next window.
+// An error is returned if jobs submitted at time t are to be rejected.
+func (p SubmissionWindowPolicy) Admit(t time.Time) (time.Time, error) {
+	if p.Allows(t) {
+		return time.Time{}, nil
+	}
+	next := p.NextWindowStart(t)
+	if next.IsZero() {
+		return time.Time{}, fmt.Errorf("the queue only accepts submissions during its submission windows, none of which starts within the next few years")
+	}
+	if !p.HoldOutsideWindows {
+		return time.Time{}, fmt.Errorf("the queue only accepts submissions during its submission windows; the next window starts at %s", next.Format(time.RFC3339))
+	}
+	return next, nil
+}
+
+func (w SubmissionWindow) parse() (*cron.Schedule, *time.Location, error) {
+	if w.DurationMinutes == 0 {
+		return nil, nil, fmt.Errorf("duration must be positive")
+	}
+	schedule, err := cron.Parse(w.Start)
+	if err != nil {
+		return nil, nil, err
+	}
+	loc, err := time.LoadLocation(w.TimeZone)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unknown time zone %q: %s", w.TimeZone, err)
+	}
+	return schedule, loc, nil
+}
+
+// contains returns true if t is within the window, i.e., if the window started in the DurationMinutes minutes up to t.
+func (w SubmissionWindow) contains(t time.Time) bool {
+	schedule, loc, err := w.parse()
+	if err != nil {
+		return false
+	}
+	t = t.In(loc)
+	start := schedule.Next(t.Add(-time.Duration(w.DurationMinutes) * time.Minute))
+	return !start.IsZero() && !start.After(t)
+}
+
+// Generate is implementation of https://pkg.go.dev/testing/quick#Generator interface.
+// This method is used for writing tests usign https://pkg.go.dev/testing/quick package
+func (SubmissionWindowPolicy) Generate(rand *rand.Rand, size int) reflect.Value {
+	policy := SubmissionWindowPolicy{HoldOutsideWindows: rand.Intn(2) == 0}
+	for i := rand.Intn(3); i > 0; i-- {
+		policy.Windows = append(policy.Windows, SubmissionWindow{
+			Start:           fmt.Sprintf("%d %d * * *", rand.Intn(60), rand.Intn(24)),
+			DurationMinutes: uint32(1 + rand.Intn(24*60)),
+		})
+	}
+	return reflect.ValueOf(policy)
+}
@@ -0,0 +1,101 @@
+package queue
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+func TestNewSubmissionWindowPolicy(t *testing.T) {
+	tests := map[string]struct {
+		in    *api.SubmissionWindowPolicy
+		valid bool
+	}{
+		"nil": {
+			in:    nil,
+			valid: true,
+		},
+		"valid": {
+			in: &api.SubmissionWindowPolicy{
+				Windows: []*api.SubmissionWindow{{Start: "0 18 * * 1-5", DurationMinutes: 840, TimeZone: "Europe/London"}},
+			},
+			valid: true,
+		},
+		"invalid cron expression": {
+			in: &api.SubmissionWindowPolicy{
+				Windows: []*api.SubmissionWindow{{Start: "0 18 * *", DurationMinutes: 840}},
+			},
+			valid: false,
+		},
+		"zero duration": {
+			in: &api.SubmissionWindowPolicy{
+				Windows: []*api.SubmissionWindow{{Start: "0 18 * * *"}},
+			},
+			valid: false,
+		},
+		"unknown time zone": {
+			in: &api.SubmissionWindowPolicy{
+				Windows: []*api.SubmissionWindow{{Start: "0 18 * * *", DurationMinutes: 60, TimeZone: "Nowhere/Special"}},
+			},
+			valid: false,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := NewSubmissionWindowPolicy(tc.in)
+			if tc.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestSubmissionWindowPolicy_Admit(t *testing.T) {
+	// Outside trading hours, i.e., from 4pm until 9am on weekdays and all weekend.
+	policy := SubmissionWindowPolicy{
+		Windows: []SubmissionWindow{
+			{Start: "0 16 * * 1-5", DurationMinutes: 17 * 60, TimeZone: "America/New_York"},
+			{Start: "0 0 * * 6", DurationMinutes: 48 * 60, TimeZone: "America/New_York"},
+		},
+	}
+	loc, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	// Wednesday evening.
+	heldUntil, err := policy.Admit(time.Date(2023, 5, 17, 20, 0, 0, 0, loc))
+	assert.NoError(t, err)
+	assert.True(t, heldUntil.IsZero())
+
+	// Saturday afternoon.
+	heldUntil, err = policy.Admit(time.Date(2023, 5, 20, 13, 0, 0, 0, loc))
+	assert.NoError(t, err)
+	assert.True(t, heldUntil.IsZero())
+
+	// Wednesday noon.
+	_, err = policy.Admit(time.Date(2023, 5, 17, 12, 0, 0, 0, loc))
+	assert.Error(t, err)
+
+	// The window ends exactly at 9am.
+	_, err = policy.Admit(time.Date(2023, 5, 18, 9, 0, 0, 0, loc))
+	assert.Error(t, err)
+	heldUntil, err = policy.Admit(time.Date(2023, 5, 18, 8, 59, 59, 0, loc))
+	assert.NoError(t, err)
+	assert.True(t, heldUntil.IsZero())
+
+	policy.HoldOutsideWindows = true
+	heldUntil, err = policy.Admit(time.Date(2023, 5, 17, 12, 0, 0, 0, loc))
+	assert.NoError(t, err)
+	assert.True(t, time.Date(2023, 5, 17, 16, 0, 0, 0, loc).Equal(heldUntil))
+}
+
+func TestSubmissionWindowPolicy_NoWindows(t *testing.T) {
+	heldUntil, err := SubmissionWindowPolicy{HoldOutsideWindows: true}.Admit(time.Now())
+	assert.NoError(t, err)
+	assert.True(t, heldUntil.IsZero())
+}
@@ -14,11 +14,13 @@ type ResourceKind string
 
 const (
 	ResourceKindQueue ResourceKind = "Queue"
+	// A document of several queues, as exported and imported by armadactl.
+	ResourceKindQueueList ResourceKind = "QueueList"
 )
 
 func NewResourceKind(in string) (ResourceKind, error) {
-	validValues := []ResourceKind{ResourceKindQueue}
-	if in != string(ResourceKindQueue) {
+	validValues := []ResourceKind{ResourceKindQueue, ResourceKindQueueList}
+	if in != string(ResourceKindQueue) && in != string(ResourceKindQueueList) {
 		return "", fmt.Errorf("invalid kind: %s. Valid values: %v", in, validValues)
 	}
 
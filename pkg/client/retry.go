@@ -0,0 +1,69 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Attempts are at least this far apart, such that policies without a backoff don't retry in a tight loop.
+const minRetryBackoff = 100 * time.Millisecond
+
+// RetryPolicy determines which failed calls are retried, and how long is waited between attempts.
+// Calls are retried until they succeed, MaxAttempts attempts were made, or their context is done.
+type RetryPolicy struct {
+	// Number of attempts made at each call, including the first. 0 means calls are retried until their context is done.
+	MaxAttempts int
+	// Time waited before the first retry, which doubles with each retry up to MaxBackoff. Attempts are at least
+	// 100ms apart.
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	// gRPC codes of the errors calls are retried for.
+	RetryableCodes []codes.Code
+}
+
+// DefaultRetryPolicy retries calls failing with codes Unavailable and Aborted up to 5 times, backing off
+// exponentially from 1s up to 10s between attempts.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts:    5,
+	InitialBackoff: time.Second,
+	MaxBackoff:     10 * time.Second,
+	RetryableCodes: []codes.Code{codes.Unavailable, codes.Aborted},
+}
+
+// Retry calls call until it succeeds, fails with an error that isn't retryable as per policy, policy.MaxAttempts
+// attempts were made, or ctx is done. Returns the result of the last attempt.
+func Retry[T any](ctx context.Context, policy RetryPolicy, call func(context.Context) (T, error)) (T, error) {
+	backoff := policy.InitialBackoff
+	for attempt := 1; ; attempt++ {
+		result, err := call(ctx)
+		if err == nil || !policy.retryable(err) || (policy.MaxAttempts > 0 && attempt >= policy.MaxAttempts) {
+			return result, err
+		}
+		if backoff < minRetryBackoff {
+			backoff = minRetryBackoff
+		}
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return result, err
+		case <-timer.C:
+		}
+		if backoff *= 2; backoff > policy.MaxBackoff {
+			backoff = policy.MaxBackoff
+		}
+	}
+}
+
+func (policy RetryPolicy) retryable(err error) bool {
+	code := status.Code(err)
+	for _, retryableCode := range policy.RetryableCodes {
+		if code == retryableCode {
+			return true
+		}
+	}
+	return false
+}
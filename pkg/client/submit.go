@@ -1,6 +1,11 @@
 package client
 
 import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+
 	"github.com/armadaproject/armada/internal/common"
 	"github.com/armadaproject/armada/internal/common/util"
 	"github.com/armadaproject/armada/pkg/api"
@@ -8,6 +13,9 @@ import (
 
 const MaxJobsPerRequest = 200
 
+// Each attempt at a submission times out after this long.
+const submitAttemptTimeout = 10 * time.Second
+
 func CreateQueue(submitClient api.SubmitClient, queue *api.Queue) error {
 	ctx, cancel := common.ContextWithDefaultTimeout()
 	defer cancel()
@@ -27,22 +35,124 @@ func UpdateQueue(submitClient api.SubmitClient, queue *api.Queue) error {
 func DeleteQueue(submitClient api.SubmitClient, name string) error {
 	ctx, cancel := common.ContextWithDefaultTimeout()
 	defer cancel()
-	_, e := submitClient.DeleteQueue(ctx, &api.QueueDeleteRequest{name})
+	_, e := submitClient.DeleteQueue(ctx, &api.QueueDeleteRequest{Name: name})
 	return e
 }
 
-func SubmitJobs(submitClient api.SubmitClient, request *api.JobSubmitRequest) (*api.JobSubmitResponse, error) {
-	AddClientIds(request.JobRequestItems)
-	ctx, cancel := common.ContextWithDefaultTimeout()
-	defer cancel()
-	return submitClient.SubmitJobs(ctx, request)
+// SubmitJobs submits request. It's submitted once, unless options of a Submitter retrying it are given,
+// e.g., WithMaxAttempts(5).
+func SubmitJobs(submitClient api.SubmitClient, request *api.JobSubmitRequest, opts ...SubmitterOption) (*api.JobSubmitResponse, error) {
+	opts = append([]SubmitterOption{WithMaxAttempts(1)}, opts...)
+	return NewSubmitter(submitClient, opts...).SubmitJobs(context.Background(), request)
+}
+
+// CustomClientSubmitJobs is SubmitJobs for a CustomSubmitClient.
+func CustomClientSubmitJobs(submitClient api.CustomSubmitClient, request *api.JobSubmitRequest, opts ...SubmitterOption) (*api.JobSubmitResponse, error) {
+	return SubmitJobs(submitClient.Inner, request, opts...)
+}
+
+type SubmitterOption func(submitter *Submitter)
+
+// Submitter submits jobs, retrying submissions that fail with transient errors.
+//
+// Since a submission failing with a transient error may have been applied by the server, e.g., if the connection
+// was lost before the response was received, retries are deduplicated using the client ids of the jobs: the client
+// ids are assigned before the first attempt, such that the server recognises the jobs created by an earlier attempt
+// and returns their ids instead of creating them again.
+type Submitter struct {
+	submitClient api.CustomSubmitClient
+	retryPolicy  RetryPolicy
 }
 
-func CustomClientSubmitJobs(submitClient api.CustomSubmitClient, request *api.JobSubmitRequest) (*api.JobSubmitResponse, error) {
+// NewSubmitter returns a Submitter retrying submissions as per DefaultRetryPolicy, i.e., making up to 5 attempts at
+// each submission, backing off exponentially from 1s up to 10s between attempts, and retrying submissions failing
+// with codes Unavailable and Aborted.
+func NewSubmitter(submitClient api.SubmitClient, opts ...SubmitterOption) *Submitter {
+	submitter := &Submitter{
+		submitClient: api.CustomSubmitClient{Inner: submitClient},
+		retryPolicy:  DefaultRetryPolicy,
+	}
+	for _, opt := range opts {
+		opt(submitter)
+	}
+	return submitter
+}
+
+// WithRetryPolicy sets the policy submissions are retried according to.
+func WithRetryPolicy(retryPolicy RetryPolicy) SubmitterOption {
+	return func(submitter *Submitter) {
+		submitter.retryPolicy = retryPolicy
+	}
+}
+
+// WithMaxAttempts sets the number of attempts made at each submission, including the first. 1 disables retries.
+func WithMaxAttempts(maxAttempts int) SubmitterOption {
+	return func(submitter *Submitter) {
+		if maxAttempts < 1 {
+			maxAttempts = 1
+		}
+		submitter.retryPolicy.MaxAttempts = maxAttempts
+	}
+}
+
+// WithBackoff sets the time waited before the first retry, which doubles with each retry up to maxBackoff.
+func WithBackoff(initialBackoff time.Duration, maxBackoff time.Duration) SubmitterOption {
+	return func(submitter *Submitter) {
+		submitter.retryPolicy.InitialBackoff = initialBackoff
+		submitter.retryPolicy.MaxBackoff = maxBackoff
+	}
+}
+
+// WithRetryableCodes sets the gRPC codes of the errors submissions are retried for.
+func WithRetryableCodes(retryableCodes ...codes.Code) SubmitterOption {
+	return func(submitter *Submitter) {
+		submitter.retryPolicy.RetryableCodes = retryableCodes
+	}
+}
+
+// SubmitJobs submits request, retrying it as per the retry policy of the submitter, until ctx is done. Each attempt
+// times out after 10s. If the submission fails, the response, if not nil, reports the jobs that were rejected.
+func (submitter *Submitter) SubmitJobs(ctx context.Context, request *api.JobSubmitRequest) (*api.JobSubmitResponse, error) {
+	// Client ids assigned here rather than by the caller identify jobs that can only have been created by an earlier
+	// attempt at this submission.
+	assignedClientIds := make(map[int]bool)
+	for i, item := range request.JobRequestItems {
+		if item.ClientId == "" {
+			assignedClientIds[i] = true
+		}
+	}
 	AddClientIds(request.JobRequestItems)
-	ctx, cancel := common.ContextWithDefaultTimeout()
+
+	attempts := 0
+	response, err := Retry(ctx, submitter.retryPolicy, func(ctx context.Context) (*api.JobSubmitResponse, error) {
+		attempts++
+		return submitter.submit(ctx, request)
+	})
+	if err == nil && attempts > 1 {
+		reconcileRetriedSubmission(request, response, assignedClientIds)
+	}
+	return response, err
+}
+
+func (submitter *Submitter) submit(ctx context.Context, request *api.JobSubmitRequest) (*api.JobSubmitResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, submitAttemptTimeout)
 	defer cancel()
-	return submitClient.SubmitJobs(ctx, request)
+	return submitter.submitClient.SubmitJobs(ctx, request)
+}
+
+// reconcileRetriedSubmission clears the duplicate errors the server reported for jobs created by an earlier attempt
+// at request, such that they're reported as submitted by this one. Jobs whose client id was set by the caller may
+// have been submitted before, so are left as reported.
+func reconcileRetriedSubmission(request *api.JobSubmitRequest, response *api.JobSubmitResponse, assignedClientIds map[int]bool) {
+	// Response items are in the order of the jobs of the request.
+	if response == nil || len(response.JobResponseItems) != len(request.JobRequestItems) {
+		return
+	}
+	for i, item := range response.JobResponseItems {
+		if assignedClientIds[i] && item.ErrorDetails != nil && item.ErrorDetails.Code == api.JobSubmitError_DUPLICATE {
+			item.ErrorDetails = nil
+		}
+	}
 }
 
 func CreateChunkedSubmitRequests(queue string, jobSetId string, jobs []*api.JobSubmitRequestItem) []*api.JobSubmitRequest {
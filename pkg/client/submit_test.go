@@ -1,10 +1,16 @@
 package client
 
 import (
+	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	v1 "k8s.io/api/core/v1"
 
 	"github.com/armadaproject/armada/pkg/api"
@@ -34,6 +40,113 @@ func TestCreateChunkedSubmitRequests_MaintainsOrderOfJobs(t *testing.T) {
 	}
 }
 
+func TestSubmitter_RetriesTransientErrorsWithoutDuplicatingJobs(t *testing.T) {
+	// The first attempt is applied by the server, but fails as the connection is lost.
+	submitClient := &fakeSubmitClient{
+		errs:   []error{status.Error(codes.Unavailable, "connection lost"), status.Error(codes.Aborted, "aborted")},
+		jobIds: make(map[string]string),
+	}
+	submitter := NewSubmitter(submitClient, WithBackoff(0, 0))
+	request := &api.JobSubmitRequest{
+		Queue:           "queue",
+		JobSetId:        "jobset",
+		JobRequestItems: []*api.JobSubmitRequestItem{{}, {ClientId: "submitted-before"}},
+	}
+	submitClient.jobIds["submitted-before"] = "job-0"
+
+	response, err := submitter.SubmitJobs(context.Background(), request)
+	require.NoError(t, err)
+	assert.Equal(t, 3, submitClient.attempts)
+	assert.Len(t, submitClient.jobIds, 2)
+	require.Len(t, response.JobResponseItems, 2)
+	// The job created by the first attempt is reported as submitted.
+	assert.Equal(t, &api.JobSubmitResponseItem{JobId: "job-1"}, response.JobResponseItems[0])
+	// The job whose client id was set by the caller was submitted before, so is still reported as a duplicate.
+	assert.Equal(t, "job-0", response.JobResponseItems[1].JobId)
+	assert.Equal(t, api.JobSubmitError_DUPLICATE, response.JobResponseItems[1].ErrorDetails.Code)
+}
+
+func TestSubmitter_DoesNotRetryOtherErrors(t *testing.T) {
+	submitClient := &fakeSubmitClient{
+		errs:   []error{status.Error(codes.InvalidArgument, "invalid")},
+		jobIds: make(map[string]string),
+	}
+
+	_, err := NewSubmitter(submitClient, WithBackoff(0, 0)).SubmitJobs(context.Background(), &api.JobSubmitRequest{JobRequestItems: []*api.JobSubmitRequestItem{{}}})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Equal(t, 1, submitClient.attempts)
+}
+
+func TestSubmitter_GivesUpAfterMaxAttempts(t *testing.T) {
+	submitClient := &fakeSubmitClient{
+		errs:   []error{status.Error(codes.Unavailable, "unavailable"), status.Error(codes.Unavailable, "unavailable")},
+		jobIds: make(map[string]string),
+	}
+
+	submitter := NewSubmitter(submitClient, WithMaxAttempts(2), WithBackoff(0, 0), WithRetryableCodes(codes.Unavailable))
+	_, err := submitter.SubmitJobs(context.Background(), &api.JobSubmitRequest{JobRequestItems: []*api.JobSubmitRequestItem{{}}})
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Equal(t, 2, submitClient.attempts)
+}
+
+func TestSubmitter_StopsRetryingWhenContextIsDone(t *testing.T) {
+	submitClient := &fakeSubmitClient{
+		errs:   []error{status.Error(codes.Unavailable, "unavailable")},
+		jobIds: make(map[string]string),
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err := NewSubmitter(submitClient, WithBackoff(time.Hour, time.Hour)).SubmitJobs(ctx, &api.JobSubmitRequest{JobRequestItems: []*api.JobSubmitRequestItem{{}}})
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Equal(t, 1, submitClient.attempts)
+}
+
+func TestSubmitJobs_SubmitsOnceUnlessRetriesAreEnabled(t *testing.T) {
+	submitClient := &fakeSubmitClient{
+		errs:   []error{status.Error(codes.Unavailable, "unavailable")},
+		jobIds: make(map[string]string),
+	}
+	_, err := SubmitJobs(submitClient, &api.JobSubmitRequest{JobRequestItems: []*api.JobSubmitRequestItem{{}}})
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Equal(t, 1, submitClient.attempts)
+
+	submitClient.attempts = 0
+	_, err = SubmitJobs(submitClient, &api.JobSubmitRequest{JobRequestItems: []*api.JobSubmitRequestItem{{}}}, WithMaxAttempts(2), WithBackoff(0, 0))
+	require.NoError(t, err)
+	assert.Equal(t, 2, submitClient.attempts)
+}
+
+// fakeSubmitClient deduplicates jobs by client id, like the server, and fails the first attempts with errs after
+// creating their jobs.
+type fakeSubmitClient struct {
+	api.SubmitClient
+	errs     []error
+	attempts int
+	// Ids of the jobs created, by client id.
+	jobIds map[string]string
+}
+
+func (c *fakeSubmitClient) SubmitJobs(_ context.Context, req *api.JobSubmitRequest, _ ...grpc.CallOption) (*api.JobSubmitResponse, error) {
+	c.attempts++
+	response := &api.JobSubmitResponse{}
+	for _, item := range req.JobRequestItems {
+		responseItem := &api.JobSubmitResponseItem{}
+		if jobId, ok := c.jobIds[item.ClientId]; ok {
+			responseItem.JobId = jobId
+			responseItem.ErrorDetails = &api.JobSubmitError{Code: api.JobSubmitError_DUPLICATE, Field: "clientId"}
+		} else {
+			responseItem.JobId = fmt.Sprintf("job-%d", len(c.jobIds))
+			c.jobIds[item.ClientId] = responseItem.JobId
+		}
+		response.JobResponseItems = append(response.JobResponseItems, responseItem)
+	}
+	if c.attempts <= len(c.errs) {
+		return nil, c.errs[c.attempts-1]
+	}
+	return response, nil
+}
+
 func createJobRequestItems(numberOfItems int) []*api.JobSubmitRequestItem {
 	requestItems := make([]*api.JobSubmitRequestItem, 0, numberOfItems)
 
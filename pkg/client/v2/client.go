@@ -0,0 +1,117 @@
+// Package client is a higher-level Go SDK for Armada, wrapping the generated gRPC clients of pkg/api.
+//
+// Listing calls return iterators fetching pages lazily, calls failing with transient errors are retried as per a
+// RetryPolicy while their context isn't done, and PodSpec and NewJob build jobs from options structs:
+//
+//	c, err := client.New(&armadaclient.ApiConnectionDetails{ArmadaUrl: "localhost:50051"})
+//	if err != nil {
+//		return err
+//	}
+//	defer c.Close()
+//	job, err := client.NewJob(client.JobOptions{
+//		Namespace: "default",
+//		PodSpec:   client.PodSpecOptions{Image: "busybox", Command: []string{"sleep", "10"}, Cpu: "1", Memory: "1Gi"},
+//	})
+//	if err != nil {
+//		return err
+//	}
+//	jobIds, err := c.SubmitJobs(ctx, "queue", "job-set", job)
+package client
+
+import (
+	"io"
+
+	"google.golang.org/grpc"
+
+	"github.com/armadaproject/armada/pkg/api"
+	armadaclient "github.com/armadaproject/armada/pkg/client"
+)
+
+type Option func(client *Client)
+
+// Client is a client of an Armada server. It's safe for concurrent use.
+type Client struct {
+	submitClient api.SubmitClient
+	queryClient  api.QueryClient
+	eventClient  api.EventClient
+	// Closed by Close. Nil if the connection is owned by the caller.
+	conn        io.Closer
+	retryPolicy RetryPolicy
+	pageSize    uint32
+}
+
+// RetryPolicy determines which failed calls are retried, and how long is waited between attempts.
+// Submissions are retried by a Submitter of pkg/client with the same policy.
+type RetryPolicy = armadaclient.RetryPolicy
+
+// DefaultRetryPolicy retries calls failing with codes Unavailable and Aborted up to 5 times, backing off
+// exponentially from 1s up to 10s between attempts.
+var DefaultRetryPolicy = armadaclient.DefaultRetryPolicy
+
+// New returns a client of the Armada server of config, with its own connection, which Close closes.
+func New(config *armadaclient.ApiConnectionDetails, opts ...Option) (*Client, error) {
+	conn, err := armadaclient.CreateApiConnection(config)
+	if err != nil {
+		return nil, err
+	}
+	c := NewFromConn(conn, opts...)
+	c.conn = conn
+	return c, nil
+}
+
+// NewFromConn returns a client of the Armada server conn is a connection to. Close doesn't close conn.
+func NewFromConn(conn *grpc.ClientConn, opts ...Option) *Client {
+	return newClient(api.NewSubmitClient(conn), api.NewQueryClient(conn), api.NewEventClient(conn), opts...)
+}
+
+func newClient(submitClient api.SubmitClient, queryClient api.QueryClient, eventClient api.EventClient, opts ...Option) *Client {
+	c := &Client{
+		submitClient: submitClient,
+		queryClient:  queryClient,
+		eventClient:  eventClient,
+		retryPolicy:  DefaultRetryPolicy,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// WithRetryPolicy sets the policy failed calls are retried according to. Use RetryPolicy{MaxAttempts: 1} to
+// disable retries.
+func WithRetryPolicy(retryPolicy RetryPolicy) Option {
+	return func(client *Client) {
+		client.retryPolicy = retryPolicy
+	}
+}
+
+// WithPageSize sets the number of items fetched at a time by iterators. If 0, the default page size of the server
+// is used.
+func WithPageSize(pageSize uint32) Option {
+	return func(client *Client) {
+		client.pageSize = pageSize
+	}
+}
+
+// Close closes the connection of the client, if created by New.
+func (c *Client) Close() error {
+	if c.conn == nil {
+		return nil
+	}
+	return c.conn.Close()
+}
+
+// SubmitClient returns the generated client the client wraps, for calls the client doesn't provide.
+func (c *Client) SubmitClient() api.SubmitClient {
+	return c.submitClient
+}
+
+// QueryClient returns the generated client the client wraps, for calls the client doesn't provide.
+func (c *Client) QueryClient() api.QueryClient {
+	return c.queryClient
+}
+
+// EventClient returns the generated client the client wraps, for calls the client doesn't provide.
+func (c *Client) EventClient() api.EventClient {
+	return c.eventClient
+}
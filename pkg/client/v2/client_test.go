@@ -0,0 +1,345 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+var noBackoff = RetryPolicy{MaxAttempts: 3, RetryableCodes: []codes.Code{codes.Unavailable}}
+
+func TestQueues_FetchesPagesLazily(t *testing.T) {
+	submitClient := &fakeSubmitClient{queues: []string{"a", "b", "c"}}
+	c := newClient(submitClient, nil, nil, WithPageSize(2), WithRetryPolicy(noBackoff))
+
+	it := c.Queues(QueueFilter{NamePrefix: "prefix"})
+	require.True(t, it.Next(context.Background()))
+	assert.Equal(t, "a", it.Value().Name)
+	assert.Len(t, submitClient.listRequests, 1)
+
+	queues, err := Collect[*api.Queue](context.Background(), it)
+	require.NoError(t, err)
+	require.Len(t, queues, 2)
+	assert.Equal(t, "c", queues[1].Name)
+	require.Len(t, submitClient.listRequests, 2)
+	assert.Equal(t, "prefix", submitClient.listRequests[1].NamePrefix)
+	assert.Equal(t, "2", submitClient.listRequests[1].PageToken)
+}
+
+func TestQueues_RetriesTransientErrors(t *testing.T) {
+	submitClient := &fakeSubmitClient{
+		queues: []string{"a"},
+		errs:   []error{status.Error(codes.Unavailable, "unavailable")},
+	}
+	c := newClient(submitClient, nil, nil, WithRetryPolicy(noBackoff))
+
+	queues, err := Collect(context.Background(), c.Queues(QueueFilter{}))
+	require.NoError(t, err)
+	assert.Len(t, queues, 1)
+	assert.Len(t, submitClient.listRequests, 2)
+}
+
+func TestQueues_StopsRetryingWhenContextIsDone(t *testing.T) {
+	submitClient := &fakeSubmitClient{errs: []error{status.Error(codes.Unavailable, "unavailable")}}
+	c := newClient(submitClient, nil, nil, WithRetryPolicy(RetryPolicy{InitialBackoff: time.Hour, RetryableCodes: []codes.Code{codes.Unavailable}}))
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	_, err := Collect(ctx, c.Queues(QueueFilter{}))
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.Len(t, submitClient.listRequests, 1)
+}
+
+func TestRetryPolicy_WithoutBackoffDoesNotSpin(t *testing.T) {
+	submitClient := &fakeSubmitClient{errs: []error{
+		status.Error(codes.Unavailable, "unavailable"),
+		status.Error(codes.Unavailable, "unavailable"),
+		status.Error(codes.Unavailable, "unavailable"),
+		status.Error(codes.Unavailable, "unavailable"),
+		status.Error(codes.Unavailable, "unavailable"),
+	}}
+	c := newClient(submitClient, nil, nil, WithRetryPolicy(RetryPolicy{RetryableCodes: []codes.Code{codes.Unavailable}}))
+	ctx, cancel := context.WithTimeout(context.Background(), 250*time.Millisecond)
+	defer cancel()
+
+	_, err := Collect(ctx, c.Queues(QueueFilter{}))
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.LessOrEqual(t, len(submitClient.listRequests), 3)
+}
+
+func TestSubmitJobs(t *testing.T) {
+	submitClient := &fakeSubmitClient{errs: []error{status.Error(codes.Unavailable, "connection lost")}}
+	c := newClient(submitClient, nil, nil, WithRetryPolicy(noBackoff))
+	jobs := make([]*api.JobSubmitRequestItem, 201)
+	for i := range jobs {
+		jobs[i] = &api.JobSubmitRequestItem{}
+	}
+
+	jobIds, err := c.SubmitJobs(context.Background(), "queue", "job-set", jobs...)
+	require.NoError(t, err)
+	assert.Len(t, jobIds, 201)
+	// The jobs created by the failed attempt aren't created again.
+	assert.Len(t, submitClient.jobIds, 201)
+	assert.Equal(t, "job-0", jobIds[0])
+	assert.Equal(t, "job-200", jobIds[200])
+}
+
+func TestSubmitJobs_RejectedJobs(t *testing.T) {
+	rejected := api.NewFailedJobSubmitResponseItem("", api.JobSubmitError_INVALID_POD_SPEC, "podSpecs", "invalid")
+	submitClient := &fakeSubmitClient{rejected: rejected}
+	c := newClient(submitClient, nil, nil, WithRetryPolicy(noBackoff))
+
+	_, err := c.SubmitJobs(context.Background(), "queue", "job-set", &api.JobSubmitRequestItem{})
+	var rejectedErr *JobsRejectedError
+	require.ErrorAs(t, err, &rejectedErr)
+	assert.Equal(t, []*api.JobSubmitResponseItem{rejected}, rejectedErr.Rejected)
+	assert.Len(t, submitClient.submitRequests, 1)
+}
+
+func TestJobStatuses_FetchesPagesOfJobIds(t *testing.T) {
+	queryClient := &fakeQueryClient{}
+	c := newClient(nil, queryClient, nil, WithPageSize(2))
+
+	statuses, err := Collect(context.Background(), c.JobStatuses("queue", "job-set", []string{"a", "b", "c"}))
+	require.NoError(t, err)
+	assert.Equal(t, []*api.JobStatus{{JobId: "a"}, {JobId: "b"}, {JobId: "c"}}, statuses)
+	assert.Equal(t, [][]string{{"a", "b"}, {"c"}}, queryClient.requests)
+
+	statuses, err = Collect(context.Background(), c.JobStatuses("queue", "job-set", nil))
+	require.NoError(t, err)
+	assert.Empty(t, statuses)
+}
+
+func TestJobSetStatuses_ReadsJobIdsLazily(t *testing.T) {
+	queryClient := &fakeQueryClient{}
+	eventClient := &fakeEventClient{ids: []string{"a", "b", "c"}, submitted: true}
+	c := newClient(nil, queryClient, eventClient, WithPageSize(2))
+
+	it := c.JobSetStatuses("queue", "job-set")
+	require.True(t, it.Next(context.Background()))
+	assert.Equal(t, "a", it.Value().JobId)
+	assert.Equal(t, [][]string{{"a", "b"}}, queryClient.requests)
+	assert.Equal(t, []string{"submitted"}, eventClient.request.EventTypes)
+
+	statuses, err := Collect[*api.JobStatus](context.Background(), it)
+	require.NoError(t, err)
+	assert.Equal(t, []*api.JobStatus{{JobId: "b"}, {JobId: "c"}}, statuses)
+	assert.Equal(t, [][]string{{"a", "b"}, {"c"}}, queryClient.requests)
+}
+
+func TestJobs(t *testing.T) {
+	eventClient := &fakeEventClient{ids: []string{"a", "b"}, submitted: true}
+	c := newClient(nil, nil, eventClient)
+
+	jobs, err := Collect(context.Background(), c.Jobs("queue", "job-set"))
+	require.NoError(t, err)
+	require.Len(t, jobs, 2)
+	assert.Equal(t, "b", jobs[1].Id)
+}
+
+func TestEvents(t *testing.T) {
+	eventClient := &fakeEventClient{ids: []string{"1", "2", "3"}}
+	c := newClient(nil, nil, eventClient)
+
+	it := c.Events("queue", "job-set", EventOptions{FromMessageId: "0"})
+	var ids []string
+	for it.Next(context.Background()) {
+		ids = append(ids, it.Value().Id)
+	}
+	require.NoError(t, it.Err())
+	assert.Equal(t, []string{"1", "2", "3"}, ids)
+	assert.Equal(t, "0", eventClient.request.FromMessageId)
+	assert.True(t, eventClient.request.ErrorIfMissing)
+}
+
+func TestEvents_Close(t *testing.T) {
+	eventClient := &fakeEventClient{ids: []string{"1", "2", "3"}}
+	c := newClient(nil, nil, eventClient)
+
+	it := c.Events("queue", "job-set", EventOptions{})
+	require.True(t, it.Next(context.Background()))
+	it.Close()
+	assert.False(t, it.Next(context.Background()))
+	assert.NoError(t, it.Err())
+}
+
+func TestNewJob(t *testing.T) {
+	job, err := NewJob(JobOptions{
+		Namespace: "ns",
+		Priority:  1,
+		PodSpec: PodSpecOptions{
+			Image:   "busybox",
+			Command: []string{"sleep", "10"},
+			Env:     map[string]string{"B": "2", "A": "1"},
+			Cpu:     "500m",
+			Memory:  "1Gi",
+		},
+	})
+	require.NoError(t, err)
+	resources := v1.ResourceList{v1.ResourceCPU: resource.MustParse("500m"), v1.ResourceMemory: resource.MustParse("1Gi")}
+	assert.Equal(t, &api.JobSubmitRequestItem{
+		Namespace: "ns",
+		Priority:  1,
+		PodSpecs: []*v1.PodSpec{{
+			Containers: []v1.Container{{
+				Name:      "main",
+				Image:     "busybox",
+				Command:   []string{"sleep", "10"},
+				Env:       []v1.EnvVar{{Name: "A", Value: "1"}, {Name: "B", Value: "2"}},
+				Resources: v1.ResourceRequirements{Requests: resources, Limits: resources},
+			}},
+			RestartPolicy: v1.RestartPolicyNever,
+		}},
+	}, job)
+}
+
+func TestPodSpec_Invalid(t *testing.T) {
+	tests := map[string]PodSpecOptions{
+		"no image":         {Cpu: "1"},
+		"invalid quantity": {Image: "busybox", Memory: "lots"},
+	}
+	for name, opts := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := PodSpec(opts)
+			assert.Error(t, err)
+		})
+	}
+}
+
+// fakeSubmitClient lists the given queues, and creates jobs deduplicating them by client id, like the server.
+// Calls fail with errs in order, after creating their jobs.
+type fakeSubmitClient struct {
+	api.SubmitClient
+	queues         []string
+	errs           []error
+	listRequests   []*api.QueueListRequest
+	submitRequests []*api.JobSubmitRequest
+	// Ids of the jobs created, by client id.
+	jobIds map[string]string
+	// If set, submitted jobs are rejected with this response item.
+	rejected *api.JobSubmitResponseItem
+}
+
+func (c *fakeSubmitClient) nextErr() error {
+	if len(c.errs) == 0 {
+		return nil
+	}
+	err := c.errs[0]
+	c.errs = c.errs[1:]
+	return err
+}
+
+func (c *fakeSubmitClient) ListQueues(_ context.Context, req *api.QueueListRequest, _ ...grpc.CallOption) (*api.QueuePage, error) {
+	c.listRequests = append(c.listRequests, req)
+	if err := c.nextErr(); err != nil {
+		return nil, err
+	}
+	start := 0
+	if req.PageToken != "" {
+		start, _ = strconv.Atoi(req.PageToken)
+	}
+	end := len(c.queues)
+	page := &api.QueuePage{}
+	if req.PageSize > 0 && start+int(req.PageSize) < end {
+		end = start + int(req.PageSize)
+		page.NextPageToken = strconv.Itoa(end)
+	}
+	for _, name := range c.queues[start:end] {
+		page.Queues = append(page.Queues, &api.Queue{Name: name})
+	}
+	return page, nil
+}
+
+func (c *fakeSubmitClient) SubmitJobs(_ context.Context, req *api.JobSubmitRequest, _ ...grpc.CallOption) (*api.JobSubmitResponse, error) {
+	c.submitRequests = append(c.submitRequests, req)
+	if c.jobIds == nil {
+		c.jobIds = make(map[string]string)
+	}
+	response := &api.JobSubmitResponse{}
+	for _, item := range req.JobRequestItems {
+		if c.rejected != nil {
+			response.JobResponseItems = append(response.JobResponseItems, c.rejected)
+			continue
+		}
+		responseItem := &api.JobSubmitResponseItem{}
+		if jobId, ok := c.jobIds[item.ClientId]; ok {
+			responseItem.JobId = jobId
+			responseItem.ErrorDetails = &api.JobSubmitError{Code: api.JobSubmitError_DUPLICATE}
+		} else {
+			responseItem.JobId = fmt.Sprintf("job-%d", len(c.jobIds))
+			c.jobIds[item.ClientId] = responseItem.JobId
+		}
+		response.JobResponseItems = append(response.JobResponseItems, responseItem)
+	}
+	if err := c.nextErr(); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// fakeQueryClient returns statuses of the requested jobs, recording the job ids of each request.
+type fakeQueryClient struct {
+	api.QueryClient
+	requests [][]string
+}
+
+func (c *fakeQueryClient) GetJobStatus(_ context.Context, req *api.JobStatusRequest, _ ...grpc.CallOption) (*api.JobStatusResponse, error) {
+	c.requests = append(c.requests, req.JobIds)
+	response := &api.JobStatusResponse{}
+	for _, jobId := range req.JobIds {
+		response.JobStatuses = append(response.JobStatuses, &api.JobStatus{JobId: jobId})
+	}
+	return response, nil
+}
+
+// fakeEventClient returns a stream sending events with the given ids, which are running events of the jobs with those
+// ids, or submitted events if submitted is set.
+type fakeEventClient struct {
+	api.EventClient
+	ids       []string
+	submitted bool
+	request   *api.JobSetRequest
+}
+
+func (c *fakeEventClient) GetJobSetEvents(_ context.Context, request *api.JobSetRequest, _ ...grpc.CallOption) (api.Event_GetJobSetEventsClient, error) {
+	c.request = proto.Clone(request).(*api.JobSetRequest)
+	return &fakeEventStream{ids: c.ids, submitted: c.submitted}, nil
+}
+
+type fakeEventStream struct {
+	grpc.ClientStream
+	ids       []string
+	submitted bool
+}
+
+func (s *fakeEventStream) Recv() (*api.EventStreamMessage, error) {
+	if len(s.ids) == 0 {
+		return nil, io.EOF
+	}
+	id := s.ids[0]
+	s.ids = s.ids[1:]
+	if s.submitted {
+		return &api.EventStreamMessage{
+			Id:      id,
+			Message: &api.EventMessage{Events: &api.EventMessage_Submitted{Submitted: &api.JobSubmittedEvent{JobId: id, Job: api.Job{Id: id}}}},
+		}, nil
+	}
+	return &api.EventStreamMessage{
+		Id:      id,
+		Message: &api.EventMessage{Events: &api.EventMessage_Running{Running: &api.JobRunningEvent{JobId: id}}},
+	}, nil
+}
@@ -0,0 +1,102 @@
+package client
+
+import (
+	"context"
+	"sync"
+
+	"github.com/armadaproject/armada/pkg/api"
+	armadaclient "github.com/armadaproject/armada/pkg/client"
+)
+
+// EventOptions selects the events iterated over by Events.
+type EventOptions struct {
+	// If set, iteration continues with new events as they happen, rather than ending after the last event.
+	Watch bool
+	// If set, only events after the event with this id, e.g., the id of the last event processed before, are
+	// iterated over.
+	FromMessageId string
+	// If provided, only events of these types, e.g., "succeeded", are iterated over.
+	EventTypes []string
+}
+
+// Events returns an iterator over the events of the job set jobSetId of queue, in order.
+// Broken event streams are reconnected to, resuming after the last event received.
+func (c *Client) Events(queue string, jobSetId string, opts EventOptions) Iterator[*api.EventStreamMessage] {
+	request := &api.JobSetRequest{
+		Queue:          queue,
+		Id:             jobSetId,
+		Watch:          opts.Watch,
+		FromMessageId:  opts.FromMessageId,
+		EventTypes:     opts.EventTypes,
+		ErrorIfMissing: true,
+	}
+	return &eventIterator{
+		watch: func(ctx context.Context, onMessage func(*api.EventStreamMessage) bool) error {
+			return armadaclient.WatchJobSetEvents(ctx, c.eventClient, request, onMessage)
+		},
+	}
+}
+
+// eventIterator iterates over the events received by watch, which it calls in a goroutine started by the first call
+// to Next, and stopped by Close.
+type eventIterator struct {
+	watch    func(ctx context.Context, onMessage func(*api.EventStreamMessage) bool) error
+	start    sync.Once
+	cancel   context.CancelFunc
+	messages chan *api.EventStreamMessage
+	// Closed once watch returned, after which watchErr is set.
+	done     chan struct{}
+	watchErr error
+	value    *api.EventStreamMessage
+	err      error
+	closed   bool
+}
+
+func (it *eventIterator) Next(ctx context.Context) bool {
+	if it.closed || it.err != nil {
+		return false
+	}
+	it.start.Do(func() {
+		var watchCtx context.Context
+		watchCtx, it.cancel = context.WithCancel(context.Background())
+		it.messages = make(chan *api.EventStreamMessage)
+		it.done = make(chan struct{})
+		go func() {
+			defer close(it.done)
+			it.watchErr = it.watch(watchCtx, func(message *api.EventStreamMessage) bool {
+				select {
+				case it.messages <- message:
+					return false
+				case <-watchCtx.Done():
+					return true
+				}
+			})
+		}()
+	})
+	select {
+	case it.value = <-it.messages:
+		return true
+	case <-it.done:
+		it.err = it.watchErr
+		it.closed = true
+		return false
+	case <-ctx.Done():
+		it.err = ctx.Err()
+		return false
+	}
+}
+
+func (it *eventIterator) Value() *api.EventStreamMessage {
+	return it.value
+}
+
+func (it *eventIterator) Err() error {
+	return it.err
+}
+
+func (it *eventIterator) Close() {
+	it.closed = true
+	if it.cancel != nil {
+		it.cancel()
+	}
+}
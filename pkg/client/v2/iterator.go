@@ -0,0 +1,87 @@
+package client
+
+import (
+	"context"
+)
+
+// Iterator iterates over values fetched from the server as they're needed:
+//
+//	it := c.Queues(client.QueueFilter{})
+//	defer it.Close()
+//	for it.Next(ctx) {
+//		fmt.Println(it.Value().Name)
+//	}
+//	if err := it.Err(); err != nil {
+//		return err
+//	}
+type Iterator[T any] interface {
+	// Next advances to the next value, fetching it if needed, and returns true if there is one.
+	// Returns false once all values were iterated over, ctx is done, or fetching values failed.
+	Next(ctx context.Context) bool
+	// Value returns the current value, i.e., the one Next last advanced to.
+	Value() T
+	// Err returns the error that ended iteration, if any.
+	Err() error
+	// Close releases the resources of the iterator. Next returns false once it's closed.
+	Close()
+}
+
+// Collect returns the remaining values of it, and closes it.
+func Collect[T any](ctx context.Context, it Iterator[T]) ([]T, error) {
+	defer it.Close()
+	var values []T
+	for it.Next(ctx) {
+		values = append(values, it.Value())
+	}
+	return values, it.Err()
+}
+
+// pageIterator iterates over values fetched a page at a time by fetch, which returns the values of the page with
+// token, and the token of the next page, or "" if it's the last.
+type pageIterator[T any] struct {
+	fetch   func(ctx context.Context, token string) ([]T, string, error)
+	page    []T
+	token   string
+	started bool
+	value   T
+	err     error
+	closed  bool
+	// Called by Close, if set.
+	onClose func()
+}
+
+func newPageIterator[T any](fetch func(ctx context.Context, token string) ([]T, string, error)) *pageIterator[T] {
+	return &pageIterator[T]{fetch: fetch}
+}
+
+func (it *pageIterator[T]) Next(ctx context.Context) bool {
+	for len(it.page) == 0 {
+		if it.closed || it.err != nil || (it.started && it.token == "") {
+			return false
+		}
+		if err := ctx.Err(); err != nil {
+			it.err = err
+			return false
+		}
+		it.page, it.token, it.err = it.fetch(ctx, it.token)
+		it.started = true
+	}
+	it.value, it.page = it.page[0], it.page[1:]
+	return true
+}
+
+func (it *pageIterator[T]) Value() T {
+	return it.value
+}
+
+func (it *pageIterator[T]) Err() error {
+	return it.err
+}
+
+func (it *pageIterator[T]) Close() {
+	it.closed = true
+	it.page = nil
+	if it.onClose != nil {
+		it.onClose()
+	}
+}
@@ -0,0 +1,212 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/gogo/protobuf/types"
+
+	"github.com/armadaproject/armada/pkg/api"
+	armadaclient "github.com/armadaproject/armada/pkg/client"
+)
+
+// Maximum number of jobs whose status the server returns at once.
+const maxJobStatusJobIds = 1000
+
+// JobsRejectedError is returned by SubmitJobs if the server rejected some of the jobs submitted.
+type JobsRejectedError struct {
+	// Response items of the rejected jobs.
+	Rejected []*api.JobSubmitResponseItem
+	// Error returned by the server, if any.
+	Err error
+}
+
+func (e *JobsRejectedError) Error() string {
+	message := fmt.Sprintf("%d jobs were rejected", len(e.Rejected))
+	if len(e.Rejected) > 0 {
+		message += fmt.Sprintf(", e.g., job %s: %s", e.Rejected[0].JobId, e.Rejected[0].ErrorString())
+	}
+	if e.Err != nil {
+		message += fmt.Sprintf(": %s", e.Err)
+	}
+	return message
+}
+
+func (e *JobsRejectedError) Unwrap() error {
+	return e.Err
+}
+
+// SubmitJobs submits jobs to the job set jobSetId of queue, and returns their ids, in order.
+//
+// Jobs are submitted by a Submitter of pkg/client, such that they aren't created twice if a submission that was
+// applied by the server fails with a transient error and is retried. Jobs are submitted in requests of up to
+// MaxJobsPerRequest jobs; if a request fails, the ids of the jobs of the requests submitted before are returned with
+// the error, which is a *JobsRejectedError if the server rejected some of the jobs.
+func (c *Client) SubmitJobs(ctx context.Context, queue string, jobSetId string, jobs ...*api.JobSubmitRequestItem) ([]string, error) {
+	submitter := armadaclient.NewSubmitter(c.submitClient, armadaclient.WithRetryPolicy(c.retryPolicy))
+	jobIds := make([]string, 0, len(jobs))
+	for _, request := range armadaclient.CreateChunkedSubmitRequests(queue, jobSetId, jobs) {
+		response, err := submitter.SubmitJobs(ctx, request)
+		var rejected []*api.JobSubmitResponseItem
+		if response != nil {
+			for _, item := range response.JobResponseItems {
+				if item.Failed() {
+					rejected = append(rejected, item)
+				}
+			}
+		}
+		if len(rejected) > 0 {
+			return jobIds, &JobsRejectedError{Rejected: rejected, Err: err}
+		} else if err != nil {
+			return jobIds, err
+		}
+		// Jobs the server reports as duplicates were created by an earlier attempt, or submission with the same
+		// client id, and are reported with the id of the job created then.
+		for _, item := range response.JobResponseItems {
+			jobIds = append(jobIds, item.JobId)
+		}
+	}
+	return jobIds, nil
+}
+
+// JobStatuses returns an iterator over the statuses of the jobs with jobIds of the job set jobSetId of queue,
+// in order.
+func (c *Client) JobStatuses(queue string, jobSetId string, jobIds []string) Iterator[*api.JobStatus] {
+	pageSize := c.jobStatusPageSize()
+	return newPageIterator(func(ctx context.Context, token string) ([]*api.JobStatus, string, error) {
+		start := 0
+		if token != "" {
+			var err error
+			if start, err = strconv.Atoi(token); err != nil {
+				return nil, "", err
+			}
+		}
+		end := start + pageSize
+		nextToken := strconv.Itoa(end)
+		if end >= len(jobIds) {
+			end = len(jobIds)
+			nextToken = ""
+		}
+		if start >= end {
+			return nil, "", nil
+		}
+		statuses, err := c.getJobStatuses(ctx, queue, jobSetId, jobIds[start:end])
+		return statuses, nextToken, err
+	})
+}
+
+// Jobs returns an iterator over the jobs submitted to the job set jobSetId of queue, in the order they were
+// submitted. Jobs are read from their submitted events as they're needed.
+func (c *Client) Jobs(queue string, jobSetId string) Iterator[*api.Job] {
+	return &jobIterator{events: c.Events(queue, jobSetId, EventOptions{EventTypes: []string{"submitted"}})}
+}
+
+// JobSetStatuses returns an iterator over the statuses of the jobs of the job set jobSetId of queue, in the order
+// they were submitted. The ids of the jobs are read as for Jobs, and their statuses fetched a page at a time, as
+// they're needed.
+func (c *Client) JobSetStatuses(queue string, jobSetId string) Iterator[*api.JobStatus] {
+	pageSize := c.jobStatusPageSize()
+	jobs := c.Jobs(queue, jobSetId)
+	it := newPageIterator(func(ctx context.Context, _ string) ([]*api.JobStatus, string, error) {
+		jobIds := make([]string, 0, pageSize)
+		for len(jobIds) < pageSize && jobs.Next(ctx) {
+			jobIds = append(jobIds, jobs.Value().Id)
+		}
+		if err := jobs.Err(); err != nil {
+			return nil, "", err
+		}
+		// There may be more jobs only if the page is full; the token itself isn't used.
+		nextToken := ""
+		if len(jobIds) == pageSize {
+			nextToken = "next"
+		}
+		if len(jobIds) == 0 {
+			return nil, "", nil
+		}
+		statuses, err := c.getJobStatuses(ctx, queue, jobSetId, jobIds)
+		return statuses, nextToken, err
+	})
+	it.onClose = jobs.Close
+	return it
+}
+
+func (c *Client) getJobStatuses(ctx context.Context, queue string, jobSetId string, jobIds []string) ([]*api.JobStatus, error) {
+	response, err := armadaclient.Retry(ctx, c.retryPolicy, func(ctx context.Context) (*api.JobStatusResponse, error) {
+		return c.queryClient.GetJobStatus(ctx, &api.JobStatusRequest{
+			Queue:    queue,
+			JobSetId: jobSetId,
+			JobIds:   jobIds,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response.JobStatuses, nil
+}
+
+// jobStatusPageSize returns the number of jobs whose statuses are fetched at a time.
+func (c *Client) jobStatusPageSize() int {
+	pageSize := int(c.pageSize)
+	if pageSize == 0 || pageSize > maxJobStatusJobIds {
+		pageSize = maxJobStatusJobIds
+	}
+	return pageSize
+}
+
+// jobIterator iterates over the jobs of the submitted events of events.
+type jobIterator struct {
+	events Iterator[*api.EventStreamMessage]
+	value  *api.Job
+}
+
+func (it *jobIterator) Next(ctx context.Context) bool {
+	for it.events.Next(ctx) {
+		if submitted := it.events.Value().GetMessage().GetSubmitted(); submitted != nil {
+			job := submitted.Job
+			it.value = &job
+			return true
+		}
+	}
+	return false
+}
+
+func (it *jobIterator) Value() *api.Job {
+	return it.value
+}
+
+func (it *jobIterator) Err() error {
+	return it.events.Err()
+}
+
+func (it *jobIterator) Close() {
+	it.events.Close()
+}
+
+// CancelJobs cancels the jobs with jobIds of the job set jobSetId of queue, and returns the ids of those cancelled.
+func (c *Client) CancelJobs(ctx context.Context, queue string, jobSetId string, reason string, jobIds ...string) ([]string, error) {
+	result, err := armadaclient.Retry(ctx, c.retryPolicy, func(ctx context.Context) (*api.CancellationResult, error) {
+		return c.submitClient.CancelJobs(ctx, &api.JobCancelRequest{
+			Queue:    queue,
+			JobSetId: jobSetId,
+			JobIds:   jobIds,
+			Reason:   reason,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.CancelledIds, nil
+}
+
+// CancelJobSet cancels the jobs of the job set jobSetId of queue.
+func (c *Client) CancelJobSet(ctx context.Context, queue string, jobSetId string, reason string) error {
+	_, err := armadaclient.Retry(ctx, c.retryPolicy, func(ctx context.Context) (*types.Empty, error) {
+		return c.submitClient.CancelJobSet(ctx, &api.JobSetCancelRequest{
+			Queue:    queue,
+			JobSetId: jobSetId,
+			Reason:   reason,
+		})
+	})
+	return err
+}
@@ -0,0 +1,117 @@
+package client
+
+import (
+	"fmt"
+	"sort"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+// PodSpecOptions describes a pod with a single container, from which PodSpec builds a pod spec.
+type PodSpecOptions struct {
+	Image   string
+	Command []string
+	Args    []string
+	Env     map[string]string
+	// Resources of the container, e.g., "500m" cpu, "1Gi" memory. Both requests and limits are set to them, as
+	// Armada requires. Empty resources are left unset.
+	Cpu              string
+	Memory           string
+	Gpu              string
+	EphemeralStorage string
+	NodeSelector     map[string]string
+	Tolerations      []v1.Toleration
+	// Name of the container. Defaults to "main".
+	ContainerName string
+	// Defaults to Never.
+	RestartPolicy v1.RestartPolicy
+}
+
+// JobOptions describes a job, from which NewJob builds a job to submit.
+type JobOptions struct {
+	Namespace   string
+	Priority    float64
+	Labels      map[string]string
+	Annotations map[string]string
+	// If set, the server doesn't create the job if a job with this client id was submitted to the same queue before.
+	// Assigned by SubmitJobs if empty.
+	ClientId string
+	PodSpec  PodSpecOptions
+}
+
+// PodSpec returns the pod spec described by opts.
+func PodSpec(opts PodSpecOptions) (*v1.PodSpec, error) {
+	if opts.Image == "" {
+		return nil, fmt.Errorf("image is required")
+	}
+	resources := v1.ResourceList{}
+	for name, quantity := range map[v1.ResourceName]string{
+		v1.ResourceCPU:              opts.Cpu,
+		v1.ResourceMemory:           opts.Memory,
+		"nvidia.com/gpu":            opts.Gpu,
+		v1.ResourceEphemeralStorage: opts.EphemeralStorage,
+	} {
+		if quantity == "" {
+			continue
+		}
+		q, err := resource.ParseQuantity(quantity)
+		if err != nil {
+			return nil, fmt.Errorf("invalid quantity %q of resource %s: %s", quantity, name, err)
+		}
+		resources[name] = q
+	}
+
+	envNames := make([]string, 0, len(opts.Env))
+	for name := range opts.Env {
+		envNames = append(envNames, name)
+	}
+	sort.Strings(envNames)
+	env := make([]v1.EnvVar, len(envNames))
+	for i, name := range envNames {
+		env[i] = v1.EnvVar{Name: name, Value: opts.Env[name]}
+	}
+
+	containerName := opts.ContainerName
+	if containerName == "" {
+		containerName = "main"
+	}
+	restartPolicy := opts.RestartPolicy
+	if restartPolicy == "" {
+		restartPolicy = v1.RestartPolicyNever
+	}
+	return &v1.PodSpec{
+		Containers: []v1.Container{{
+			Name:    containerName,
+			Image:   opts.Image,
+			Command: opts.Command,
+			Args:    opts.Args,
+			Env:     env,
+			Resources: v1.ResourceRequirements{
+				Requests: resources,
+				Limits:   resources.DeepCopy(),
+			},
+		}},
+		NodeSelector:  opts.NodeSelector,
+		Tolerations:   opts.Tolerations,
+		RestartPolicy: restartPolicy,
+	}, nil
+}
+
+// NewJob returns the job described by opts.
+func NewJob(opts JobOptions) (*api.JobSubmitRequestItem, error) {
+	podSpec, err := PodSpec(opts.PodSpec)
+	if err != nil {
+		return nil, err
+	}
+	return &api.JobSubmitRequestItem{
+		Namespace:   opts.Namespace,
+		Priority:    opts.Priority,
+		Labels:      opts.Labels,
+		Annotations: opts.Annotations,
+		ClientId:    opts.ClientId,
+		PodSpecs:    []*v1.PodSpec{podSpec},
+	}, nil
+}
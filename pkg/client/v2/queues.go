@@ -0,0 +1,70 @@
+package client
+
+import (
+	"context"
+
+	"github.com/gogo/protobuf/types"
+
+	"github.com/armadaproject/armada/pkg/api"
+	armadaclient "github.com/armadaproject/armada/pkg/client"
+)
+
+// QueueFilter selects the queues listed by Queues, and their order.
+type QueueFilter struct {
+	// If provided, only queues with all of these labels are listed.
+	Labels map[string]string
+	// If provided, only queues whose names start with this prefix are listed.
+	NamePrefix string
+	// If provided, only queues whose name, description, or contact contain this text, ignoring case, are listed.
+	Search     string
+	SortBy     api.QueueListRequest_SortField
+	Descending bool
+}
+
+// Queues returns an iterator over the queues matching filter.
+func (c *Client) Queues(filter QueueFilter) Iterator[*api.Queue] {
+	return newPageIterator(func(ctx context.Context, token string) ([]*api.Queue, string, error) {
+		page, err := armadaclient.Retry(ctx, c.retryPolicy, func(ctx context.Context) (*api.QueuePage, error) {
+			return c.submitClient.ListQueues(ctx, &api.QueueListRequest{
+				PageSize:   c.pageSize,
+				PageToken:  token,
+				SortBy:     filter.SortBy,
+				Descending: filter.Descending,
+				Labels:     filter.Labels,
+				NamePrefix: filter.NamePrefix,
+				Search:     filter.Search,
+			})
+		})
+		if err != nil {
+			return nil, "", err
+		}
+		return page.Queues, page.NextPageToken, nil
+	})
+}
+
+func (c *Client) GetQueue(ctx context.Context, name string) (*api.Queue, error) {
+	return armadaclient.Retry(ctx, c.retryPolicy, func(ctx context.Context) (*api.Queue, error) {
+		return c.submitClient.GetQueue(ctx, &api.QueueGetRequest{Name: name})
+	})
+}
+
+func (c *Client) CreateQueue(ctx context.Context, queue *api.Queue) error {
+	_, err := armadaclient.Retry(ctx, c.retryPolicy, func(ctx context.Context) (*types.Empty, error) {
+		return c.submitClient.CreateQueue(ctx, queue)
+	})
+	return err
+}
+
+func (c *Client) UpdateQueue(ctx context.Context, queue *api.Queue) error {
+	_, err := armadaclient.Retry(ctx, c.retryPolicy, func(ctx context.Context) (*types.Empty, error) {
+		return c.submitClient.UpdateQueue(ctx, queue)
+	})
+	return err
+}
+
+func (c *Client) DeleteQueue(ctx context.Context, name string) error {
+	_, err := armadaclient.Retry(ctx, c.retryPolicy, func(ctx context.Context) (*types.Empty, error) {
+		return c.submitClient.DeleteQueue(ctx, &api.QueueDeleteRequest{Name: name})
+	})
+	return err
+}
@@ -13,7 +13,12 @@ import (
 )
 
 type rawJobSubmitFile struct {
-	Jobs []*rawJobRequest
+	Jobs      []*rawJobRequest
+	JobArrays []*rawJobArray `json:"jobArrays,omitempty"`
+}
+
+type rawJobArray struct {
+	Template *rawJobRequest `json:"template,omitempty"`
 }
 
 type rawJobRequest struct {
@@ -42,11 +47,25 @@ func ValidateSubmitFile(filePath string) (bool, error) {
 		return false, err
 	}
 
-	if len(submitFile.Jobs) <= 0 {
+	if len(submitFile.Jobs) <= 0 && len(submitFile.JobArrays) <= 0 {
 		return false, errors.New("Warning: You have provided no jobs to submit.")
 	}
 
+	// The templates of job arrays are validated like jobs.
+	jobs := make([]*rawJobRequest, 0, len(submitFile.Jobs)+len(submitFile.JobArrays))
+	names := make([]string, 0, cap(jobs))
 	for i, job := range submitFile.Jobs {
+		jobs = append(jobs, job)
+		names = append(names, fmt.Sprintf("job[%d]", i))
+	}
+	for i, array := range submitFile.JobArrays {
+		if array.Template != nil {
+			jobs = append(jobs, array.Template)
+			names = append(names, fmt.Sprintf("jobArrays[%d].template", i))
+		}
+	}
+
+	for i, job := range jobs {
 		rawPod := rawPod(job.PodSpec)
 		result, err := validate(rawPod)
 
@@ -60,7 +79,7 @@ func ValidateSubmitFile(filePath string) (bool, error) {
 		}
 
 		if len(result[0].Errors) > 0 {
-			return false, fmt.Errorf("Validation error in job[%d]: %s", i, result[0].Errors[0].Description())
+			return false, fmt.Errorf("Validation error in %s: %s", names[i], result[0].Errors[0].Description())
 		}
 	}
 
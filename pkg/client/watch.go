@@ -5,15 +5,20 @@ import (
 	"io"
 	"time"
 
+	"github.com/gogo/protobuf/proto"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"github.com/armadaproject/armada/internal/common/util"
 	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/api/sequence"
 	"github.com/armadaproject/armada/pkg/client/domain"
 )
 
+// Time waited before reconnecting to a broken event stream.
+var reconnectInterval = 5 * time.Second
+
 func GetJobSetState(client api.EventClient, queue, jobSetId string, context context.Context, errorOnNotExists bool, forceNew bool, forceLegacy bool) *domain.WatchContext {
 	latestState := domain.NewWatchContext()
 	WatchJobSet(client, queue, jobSetId, false, errorOnNotExists, forceNew, forceLegacy, context, func(state *domain.WatchContext, _ api.Event) bool {
@@ -51,74 +56,102 @@ func WatchJobSetWithJobIdsFilter(
 
 	jobIdsSet := util.StringListToSet(jobIds)
 	filterOnJobId := len(jobIdsSet) > 0
-	lastMessageId := ""
+
+	request := &api.JobSetRequest{
+		Queue:          queue,
+		Id:             jobSetId,
+		Watch:          waitForNew,
+		ErrorIfMissing: errorOnNotExists,
+		ForceNew:       forceNew,
+		ForceLegacy:    forceLegacy,
+	}
+	err := WatchJobSetEvents(context, client, request, func(msg *api.EventStreamMessage) bool {
+		event, e := api.UnwrapEvent(msg.Message)
+		if e != nil {
+			// This can mean that the event type reported from server is unknown to the client
+			log.Error(e)
+			return false
+		}
+
+		if filterOnJobId && !jobIdsSet[event.GetJobId()] {
+			return false
+		}
+
+		state.ProcessEvent(event)
+		return onUpdate(state, event)
+	})
+	if err != nil {
+		log.Error(err)
+	}
+	return state
+}
+
+// WatchJobSetEvents streams the events of the job set of request to onMessage until onMessage returns true,
+// context is done, or, unless request.Watch is set, all events have been received.
+//
+// If the stream breaks, WatchJobSetEvents reconnects and resumes after the last event received, such that onMessage
+// is called exactly once for each event, in order. Set request.FromMessageId to resume after an event received by
+// an earlier call. An error is returned if the job set or queue doesn't exist, or the request isn't permitted or valid.
+func WatchJobSetEvents(
+	context context.Context,
+	client api.EventClient,
+	request *api.JobSetRequest,
+	onMessage func(*api.EventStreamMessage) bool,
+) error {
+	request = proto.Clone(request).(*api.JobSetRequest)
+	if request.SchemaVersion == 0 {
+		request.SchemaVersion = api.EventSchemaVersion
+	}
+	lastSeqNo, err := sequence.Parse(request.FromMessageId)
+	if err != nil {
+		// Legacy ids are converted by the server, and can't be used for deduplication.
+		lastSeqNo = nil
+	}
 
 	for {
 		select {
 		case <-context.Done():
-			return state
+			return nil
 		default:
 		}
 
-		clientStream, e := client.GetJobSetEvents(context,
-			&api.JobSetRequest{
-				Queue:          queue,
-				Id:             jobSetId,
-				FromMessageId:  lastMessageId,
-				Watch:          waitForNew,
-				ErrorIfMissing: errorOnNotExists,
-				ForceNew:       forceNew,
-				ForceLegacy:    forceLegacy,
-			},
-		)
-
+		clientStream, e := client.GetJobSetEvents(context, request)
 		if e != nil {
 			log.Error(e)
-			time.Sleep(5 * time.Second)
+			time.Sleep(reconnectInterval)
 			continue
 		}
 
 		for {
-
 			msg, e := clientStream.Recv()
 			if e != nil {
 				if err, ok := status.FromError(e); ok {
 					switch err.Code() {
-					case codes.NotFound:
-						log.Error(err.Message())
-						return state
-					case codes.PermissionDenied:
-						log.Error(err.Message())
-						return state
+					case codes.NotFound, codes.PermissionDenied, codes.InvalidArgument:
+						return e
 					}
 				}
 				if e == io.EOF {
-					return state
+					return nil
 				}
 				if !isTransportClosingError(e) {
 					log.Error(e)
 				}
-				time.Sleep(5 * time.Second)
+				time.Sleep(reconnectInterval)
 				break
 			}
-			lastMessageId = msg.Id
-
-			event, e := api.UnwrapEvent(msg.Message)
-			if e != nil {
-				// This can mean that the event type reported from server is unknown to the client
-				log.Error(e)
-				continue
-			}
 
-			if filterOnJobId && !jobIdsSet[event.GetJobId()] {
-				continue
+			if seqNo, err := sequence.Parse(msg.Id); err == nil {
+				if lastSeqNo != nil && !seqNo.IsAfter(lastSeqNo) {
+					// Already received before the stream broke.
+					continue
+				}
+				lastSeqNo = seqNo
 			}
+			request.FromMessageId = msg.Id
 
-			state.ProcessEvent(event)
-
-			shouldExit := onUpdate(state, event)
-			if shouldExit {
-				return state
+			if onMessage(msg) {
+				return nil
 			}
 		}
 	}
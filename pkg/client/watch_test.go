@@ -0,0 +1,112 @@
+package client
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+func TestWatchJobSetEvents_ResumesBrokenStreams(t *testing.T) {
+	reconnectInterval = 0
+	client := &fakeEventClient{
+		streams: []*fakeEventStream{
+			{ids: []string{"1:0:0:0", "1:0:1:1"}, err: status.Error(codes.Unavailable, "transport is closing")},
+			// Events already received may be sent again, e.g., if the server resumes from an earlier id.
+			{ids: []string{"1:0:1:1", "2:0:0:1"}, err: io.EOF},
+		},
+	}
+
+	var received []string
+	err := WatchJobSetEvents(context.Background(), client, &api.JobSetRequest{Queue: "queue", Id: "set"}, func(msg *api.EventStreamMessage) bool {
+		received = append(received, msg.Id)
+		return false
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1:0:0:0", "1:0:1:1", "2:0:0:1"}, received)
+
+	require.Len(t, client.requests, 2)
+	assert.Equal(t, "", client.requests[0].FromMessageId)
+	assert.Equal(t, "1:0:1:1", client.requests[1].FromMessageId)
+	assert.Equal(t, api.EventSchemaVersion, client.requests[1].SchemaVersion)
+}
+
+func TestWatchJobSetEvents_SkipsEventsBeforeFromMessageId(t *testing.T) {
+	client := &fakeEventClient{
+		streams: []*fakeEventStream{{ids: []string{"1:0:0:1", "2:0:0:1"}, err: io.EOF}},
+	}
+
+	var received []string
+	err := WatchJobSetEvents(context.Background(), client, &api.JobSetRequest{FromMessageId: "1:0:0:1"}, func(msg *api.EventStreamMessage) bool {
+		received = append(received, msg.Id)
+		return false
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"2:0:0:1"}, received)
+}
+
+func TestWatchJobSetEvents_StopsWhenRequested(t *testing.T) {
+	client := &fakeEventClient{
+		streams: []*fakeEventStream{{ids: []string{"1:0:0:1", "2:0:0:1"}, err: io.EOF}},
+	}
+
+	var received []string
+	err := WatchJobSetEvents(context.Background(), client, &api.JobSetRequest{}, func(msg *api.EventStreamMessage) bool {
+		received = append(received, msg.Id)
+		return true
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1:0:0:1"}, received)
+}
+
+func TestWatchJobSetEvents_ReturnsNonRetryableErrors(t *testing.T) {
+	client := &fakeEventClient{
+		streams: []*fakeEventStream{{err: status.Error(codes.NotFound, "job set does not exist")}},
+	}
+
+	err := WatchJobSetEvents(context.Background(), client, &api.JobSetRequest{}, func(*api.EventStreamMessage) bool {
+		return false
+	})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+// fakeEventClient returns streams in order, recording the requests they're returned for.
+type fakeEventClient struct {
+	api.EventClient
+	streams  []*fakeEventStream
+	requests []*api.JobSetRequest
+}
+
+func (c *fakeEventClient) GetJobSetEvents(_ context.Context, request *api.JobSetRequest, _ ...grpc.CallOption) (api.Event_GetJobSetEventsClient, error) {
+	c.requests = append(c.requests, proto.Clone(request).(*api.JobSetRequest))
+	stream := c.streams[0]
+	c.streams = c.streams[1:]
+	return stream, nil
+}
+
+// fakeEventStream sends events with the given ids and then fails with err.
+type fakeEventStream struct {
+	grpc.ClientStream
+	ids []string
+	err error
+}
+
+func (s *fakeEventStream) Recv() (*api.EventStreamMessage, error) {
+	if len(s.ids) == 0 {
+		return nil, s.err
+	}
+	id := s.ids[0]
+	s.ids = s.ids[1:]
+	return &api.EventStreamMessage{
+		Id:      id,
+		Message: &api.EventMessage{Events: &api.EventMessage_Running{Running: &api.JobRunningEvent{JobId: id}}},
+	}, nil
+}
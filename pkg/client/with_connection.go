@@ -36,3 +36,17 @@ func WithSchedulerReportingClient(apiConnectionDetails *ApiConnectionDetails, ac
 		return action(client)
 	})
 }
+
+func WithQueryClient(apiConnectionDetails *ApiConnectionDetails, action func(api.QueryClient) error) error {
+	return WithConnection(apiConnectionDetails, func(cc *grpc.ClientConn) error {
+		client := api.NewQueryClient(cc)
+		return action(client)
+	})
+}
+
+func WithJobSessionsClient(apiConnectionDetails *ApiConnectionDetails, action func(api.JobSessionsClient) error) error {
+	return WithConnection(apiConnectionDetails, func(cc *grpc.ClientConn) error {
+		client := api.NewJobSessionsClient(cc)
+		return action(client)
+	})
+}
@@ -0,0 +1,111 @@
+package armadatesting
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/armadaproject/armada/internal/armada/repository"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+// InMemoryBarrierRepository is a repository.BarrierRepository storing barriers in memory.
+// Unlike in the Redis-backed repository, released barriers are kept indefinitely.
+type InMemoryBarrierRepository struct {
+	// Barriers, indexed by queue and barrier id.
+	barriers map[[2]string]*inMemoryBarrier
+	mu       sync.Mutex
+}
+
+type inMemoryBarrier struct {
+	cardinality int
+	jobIds      map[string]bool
+}
+
+func NewInMemoryBarrierRepository() *InMemoryBarrierRepository {
+	return &InMemoryBarrierRepository{barriers: make(map[[2]string]*inMemoryBarrier)}
+}
+
+func (r *InMemoryBarrierRepository) AddBarrierMembers(queue string, barrierId string, cardinality int, jobIds []string) (*api.Barrier, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	key := [2]string{queue, barrierId}
+	barrier, ok := r.barriers[key]
+	if ok && barrier.cardinality != cardinality {
+		return nil, &repository.ErrBarrierMembership{Queue: queue, BarrierId: barrierId, Cardinality: cardinality, Message: "barrier exists with a different cardinality"}
+	} else if !ok {
+		barrier = &inMemoryBarrier{cardinality: cardinality, jobIds: make(map[string]bool)}
+	}
+	numMembers := len(barrier.jobIds)
+	for _, jobId := range jobIds {
+		if !barrier.jobIds[jobId] {
+			numMembers++
+		}
+	}
+	if numMembers > cardinality {
+		return nil, &repository.ErrBarrierMembership{Queue: queue, BarrierId: barrierId, Cardinality: cardinality, Message: "barrier would have more members than its cardinality"}
+	}
+	for _, jobId := range jobIds {
+		barrier.jobIds[jobId] = true
+	}
+	r.barriers[key] = barrier
+	return barrier.toAPI(queue, barrierId), nil
+}
+
+func (r *InMemoryBarrierRepository) RemoveBarrierMembers(queue string, barrierId string, jobIds []string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	key := [2]string{queue, barrierId}
+	barrier, ok := r.barriers[key]
+	if !ok {
+		return nil
+	}
+	for _, jobId := range jobIds {
+		delete(barrier.jobIds, jobId)
+	}
+	if len(barrier.jobIds) == 0 {
+		delete(r.barriers, key)
+	}
+	return nil
+}
+
+func (r *InMemoryBarrierRepository) GetBarrier(queue string, barrierId string) (*api.Barrier, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	barrier, ok := r.barriers[[2]string{queue, barrierId}]
+	if !ok {
+		return nil, &repository.ErrBarrierNotFound{Queue: queue, BarrierId: barrierId}
+	}
+	return barrier.toAPI(queue, barrierId), nil
+}
+
+func (r *InMemoryBarrierRepository) GetReleasedBarriers(queue string, barrierIds []string) (map[string]bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	released := make(map[string]bool, len(barrierIds))
+	for _, barrierId := range barrierIds {
+		// Barriers that don't exist are considered released.
+		if barrier, ok := r.barriers[[2]string{queue, barrierId}]; !ok || barrier.isReleased() {
+			released[barrierId] = true
+		}
+	}
+	return released, nil
+}
+
+func (b *inMemoryBarrier) isReleased() bool {
+	return len(b.jobIds) == b.cardinality
+}
+
+func (b *inMemoryBarrier) toAPI(queue string, barrierId string) *api.Barrier {
+	jobIds := make([]string, 0, len(b.jobIds))
+	for jobId := range b.jobIds {
+		jobIds = append(jobIds, jobId)
+	}
+	sort.Strings(jobIds)
+	return &api.Barrier{
+		Queue:       queue,
+		Id:          barrierId,
+		Cardinality: uint32(b.cardinality),
+		JobIds:      jobIds,
+		Released:    b.isReleased(),
+	}
+}
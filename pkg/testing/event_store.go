@@ -0,0 +1,32 @@
+package armadatesting
+
+import (
+	"sync"
+
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+// InMemoryEventStore is a repository.EventStore recording the events reported to it.
+type InMemoryEventStore struct {
+	events []*api.EventMessage
+	mu     sync.Mutex
+}
+
+func NewInMemoryEventStore() *InMemoryEventStore {
+	return &InMemoryEventStore{}
+}
+
+func (es *InMemoryEventStore) ReportEvents(_ *armadacontext.Context, events []*api.EventMessage) error {
+	es.mu.Lock()
+	defer es.mu.Unlock()
+	es.events = append(es.events, events...)
+	return nil
+}
+
+// Events returns all events reported so far, in the order they were reported.
+func (es *InMemoryEventStore) Events() []*api.EventMessage {
+	es.mu.Lock()
+	defer es.mu.Unlock()
+	return append([]*api.EventMessage(nil), es.events...)
+}
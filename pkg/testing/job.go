@@ -0,0 +1,695 @@
+package armadatesting
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+	"golang.org/x/exp/maps"
+
+	"github.com/armadaproject/armada/internal/armada/repository"
+	"github.com/armadaproject/armada/internal/common/armadaerrors"
+	armadaresource "github.com/armadaproject/armada/internal/common/resource"
+	"github.com/armadaproject/armada/internal/scheduler/schedulerobjects"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+// InMemoryJobRepository is a repository.JobRepository storing jobs in memory, which behaves like the Redis-backed
+// repository used by the Armada server. Jobs are copied when written and read, such that callers can't modify stored jobs.
+type InMemoryJobRepository struct {
+	// Serialized jobs, indexed by job id.
+	jobs map[string][]byte
+	// Ids of all jobs ever added, used to ignore jobs submitted more than once.
+	addedJobIds map[string]bool
+	// Priority of each queued and suspended job, indexed by queue and job id.
+	queuedJobs    map[string]map[string]float64
+	suspendedJobs map[string]map[string]float64
+	// Time each leased job was last leased or had its lease renewed, indexed by queue and job id.
+	leasedJobs map[string]map[string]time.Time
+	// Cluster each leased job is leased to, indexed by job id.
+	clusterIdByJobId map[string]string
+	// Start time of each job on each cluster it ran on, indexed by job id and cluster id.
+	startTimes       map[string]map[string]time.Time
+	retryAttempts    map[string]int
+	pulsarJobDetails map[string][]byte
+	// Events written to the event outbox and not yet relayed, in the order they were written.
+	outboxEvents []*api.EventMessage
+	mu           sync.Mutex
+	// Held while relaying outbox events.
+	relayMu sync.Mutex
+}
+
+func NewInMemoryJobRepository() *InMemoryJobRepository {
+	return &InMemoryJobRepository{
+		jobs:             make(map[string][]byte),
+		addedJobIds:      make(map[string]bool),
+		queuedJobs:       make(map[string]map[string]float64),
+		suspendedJobs:    make(map[string]map[string]float64),
+		leasedJobs:       make(map[string]map[string]time.Time),
+		clusterIdByJobId: make(map[string]string),
+		startTimes:       make(map[string]map[string]time.Time),
+		retryAttempts:    make(map[string]int),
+		pulsarJobDetails: make(map[string][]byte),
+	}
+}
+
+func (repo *InMemoryJobRepository) AddJobs(jobs []*api.Job) ([]*repository.SubmitJobResult, error) {
+	return repo.AddJobsWithEvents(jobs, nil)
+}
+
+func (repo *InMemoryJobRepository) AddJobsWithEvents(jobs []*api.Job, events [][]*api.EventMessage) ([]*repository.SubmitJobResult, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	results := make([]*repository.SubmitJobResult, len(jobs))
+	for i, job := range jobs {
+		if repo.addedJobIds[job.Id] {
+			results[i] = &repository.SubmitJobResult{JobId: "-1", SubmittedJob: job, AlreadyProcessed: true}
+			continue
+		}
+		if err := repo.writeJob(job); err != nil {
+			return nil, err
+		}
+		repo.addedJobIds[job.Id] = true
+		setScore(repo.queuedJobs, job.Queue, job.Id, job.Priority)
+		if events != nil {
+			repo.outboxEvents = append(repo.outboxEvents, events[i]...)
+		}
+		results[i] = &repository.SubmitJobResult{JobId: job.Id, SubmittedJob: job}
+	}
+	return results, nil
+}
+
+func (repo *InMemoryJobRepository) AddOutboxEvents(events []*api.EventMessage) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	repo.outboxEvents = append(repo.outboxEvents, events...)
+	return nil
+}
+
+func (repo *InMemoryJobRepository) RelayOutboxEvents(limit int, publish func([]*api.EventMessage) error) (int, error) {
+	if !repo.relayMu.TryLock() {
+		return 0, nil
+	}
+	defer repo.relayMu.Unlock()
+	repo.mu.Lock()
+	events := repo.outboxEvents
+	if len(events) > limit {
+		events = events[:limit]
+	}
+	events = append([]*api.EventMessage(nil), events...)
+	repo.mu.Unlock()
+	if len(events) == 0 {
+		return 0, nil
+	}
+	if err := publish(events); err != nil {
+		return 0, err
+	}
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	repo.outboxEvents = repo.outboxEvents[len(events):]
+	return len(events), nil
+}
+
+func (repo *InMemoryJobRepository) PeekQueue(queue string, limit int64) ([]*api.Job, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	jobIds := sortedByScore(repo.queuedJobs[queue])
+	if int64(len(jobIds)) > limit {
+		jobIds = jobIds[:limit]
+	}
+	return repo.getExistingJobsByIds(jobIds)
+}
+
+func (repo *InMemoryJobRepository) TryLeaseJobs(clusterId string, jobIdsByQueue map[string][]string) (map[string][]string, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	return repo.leaseJobs(clusterId, jobIdsByQueue), nil
+}
+
+func (repo *InMemoryJobRepository) GetJobsByIds(ids []string) ([]*repository.JobResult, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	return repo.getJobsByIds(ids)
+}
+
+func (repo *InMemoryJobRepository) GetExistingJobsByIds(ids []string) ([]*api.Job, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	return repo.getExistingJobsByIds(ids)
+}
+
+func (repo *InMemoryJobRepository) FilterActiveQueues(queues []*api.Queue) ([]*api.Queue, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	var active []*api.Queue
+	for _, queue := range queues {
+		if len(repo.queuedJobs[queue.Name]) > 0 {
+			active = append(active, queue)
+		}
+	}
+	return active, nil
+}
+
+func (repo *InMemoryJobRepository) GetQueueSizes(queues []*api.Queue) ([]int64, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	sizes := make([]int64, len(queues))
+	for i, queue := range queues {
+		sizes[i] = int64(len(repo.queuedJobs[queue.Name]))
+	}
+	return sizes, nil
+}
+
+func (repo *InMemoryJobRepository) GetQueueJobIds(queueName string) ([]string, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	return sortedByScore(repo.queuedJobs[queueName]), nil
+}
+
+func (repo *InMemoryJobRepository) GetQueueJobIdsPage(queueName string, offset int64, limit int64) ([]string, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	jobIds := sortedByScore(repo.queuedJobs[queueName])
+	if offset >= int64(len(jobIds)) || limit <= 0 {
+		return nil, nil
+	}
+	end := offset + limit
+	if end > int64(len(jobIds)) {
+		end = int64(len(jobIds))
+	}
+	return jobIds[offset:end], nil
+}
+
+func (repo *InMemoryJobRepository) GetQueueJobIdsByOwner(queueName string) (map[string][]string, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	jobs, err := repo.getExistingJobsByIds(sortedByScore(repo.queuedJobs[queueName]))
+	if err != nil {
+		return nil, err
+	}
+	jobIdsByOwner := make(map[string][]string)
+	for _, job := range jobs {
+		jobIdsByOwner[job.Owner] = append(jobIdsByOwner[job.Owner], job.Id)
+	}
+	return jobIdsByOwner, nil
+}
+
+func (repo *InMemoryJobRepository) GetQueueResources(queueName string) (armadaresource.ComputeResources, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	jobIds := append(maps.Keys(repo.queuedJobs[queueName]), maps.Keys(repo.leasedJobs[queueName])...)
+	jobs, err := repo.getExistingJobsByIds(jobIds)
+	if err != nil {
+		return nil, err
+	}
+	milliValues := make(map[string]int64)
+	for _, job := range jobs {
+		for name, milliValue := range repository.JobQueueResources(job) {
+			milliValues[name] += milliValue
+		}
+	}
+	return repository.QueueResourcesFromMilliValues(milliValues), nil
+}
+
+func (repo *InMemoryJobRepository) RenewLease(clusterId string, jobIds []string) ([]string, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	jobs, err := repo.getExistingJobsByIds(jobIds)
+	if err != nil {
+		return nil, err
+	}
+	jobIdsByQueue := make(map[string][]string)
+	for _, job := range jobs {
+		jobIdsByQueue[job.Queue] = append(jobIdsByQueue[job.Queue], job.Id)
+	}
+	var renewedJobIds []string
+	for _, jobIds := range repo.leaseJobs(clusterId, jobIdsByQueue) {
+		renewedJobIds = append(renewedJobIds, jobIds...)
+	}
+	return renewedJobIds, nil
+}
+
+func (repo *InMemoryJobRepository) ExpireLeases(queue string, deadline time.Time) ([]*api.Job, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	var jobIds []string
+	for jobId, leaseTime := range repo.leasedJobs[queue] {
+		if !leaseTime.After(deadline) {
+			jobIds = append(jobIds, jobId)
+		}
+	}
+	sort.Strings(jobIds)
+	return repo.expireLeasesById(jobIds, deadline)
+}
+
+func (repo *InMemoryJobRepository) ExpireLeasesById(jobIds []string, deadline time.Time) ([]*api.Job, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	return repo.expireLeasesById(jobIds, deadline)
+}
+
+func (repo *InMemoryJobRepository) ReturnLease(clusterId string, jobId string) (*api.Job, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	jobs, err := repo.getExistingJobsByIds([]string{jobId})
+	if err != nil || len(jobs) == 0 {
+		// Job has already been deleted; no more changes necessary.
+		return nil, err
+	}
+	job := jobs[0]
+	if repo.clusterIdByJobId[job.Id] != clusterId {
+		return nil, nil
+	}
+	delete(repo.clusterIdByJobId, job.Id)
+	if _, ok := repo.leasedJobs[job.Queue][job.Id]; !ok {
+		return nil, nil
+	}
+	delete(repo.leasedJobs[job.Queue], job.Id)
+	setScore(repo.queuedJobs, job.Queue, job.Id, job.Priority)
+	return job, nil
+}
+
+func (repo *InMemoryJobRepository) DeleteJobs(jobs []*api.Job) (map[*api.Job]error, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	deletedJobs := make(map[*api.Job]error)
+	for _, job := range jobs {
+		deleted := false
+		if _, ok := repo.queuedJobs[job.Queue][job.Id]; ok {
+			delete(repo.queuedJobs[job.Queue], job.Id)
+			deleted = true
+		}
+		if _, ok := repo.leasedJobs[job.Queue][job.Id]; ok {
+			delete(repo.leasedJobs[job.Queue], job.Id)
+			deleted = true
+		}
+		if _, ok := repo.suspendedJobs[job.Queue][job.Id]; ok {
+			delete(repo.suspendedJobs[job.Queue], job.Id)
+			deleted = true
+		}
+		if _, ok := repo.clusterIdByJobId[job.Id]; ok {
+			delete(repo.clusterIdByJobId, job.Id)
+			deleted = true
+		}
+		if _, ok := repo.startTimes[job.Id]; ok {
+			delete(repo.startTimes, job.Id)
+			deleted = true
+		}
+		if _, ok := repo.retryAttempts[job.Id]; ok {
+			delete(repo.retryAttempts, job.Id)
+			deleted = true
+		}
+		if _, ok := repo.jobs[job.Id]; ok {
+			delete(repo.jobs, job.Id)
+			deleted = true
+		}
+		if deleted {
+			deletedJobs[job] = nil
+		}
+	}
+	return deletedJobs, nil
+}
+
+func (repo *InMemoryJobRepository) SuspendJobs(queue string, jobIds []string) ([]string, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	return moveJobs(repo.queuedJobs, repo.suspendedJobs, queue, jobIds), nil
+}
+
+func (repo *InMemoryJobRepository) ResumeJobs(queue string, jobIds []string) ([]string, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	return moveJobs(repo.suspendedJobs, repo.queuedJobs, queue, jobIds), nil
+}
+
+func (repo *InMemoryJobRepository) GetActiveJobIds(queue string, jobSetId string) ([]string, error) {
+	return repo.GetJobSetJobIds(queue, jobSetId, &repository.JobSetFilter{
+		IncludeLeased:    true,
+		IncludeQueued:    true,
+		IncludeSuspended: true,
+	})
+}
+
+func (repo *InMemoryJobRepository) GetJobSetJobIds(queue string, jobSetId string, filter *repository.JobSetFilter) ([]string, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	var jobIds []string
+	if filter == nil || filter.IncludeQueued {
+		jobIds = append(jobIds, sortedByScore(repo.queuedJobs[queue])...)
+	}
+	if filter == nil || filter.IncludeLeased {
+		jobIds = append(jobIds, sortedByLeaseTime(repo.leasedJobs[queue])...)
+	}
+	if filter == nil || filter.IncludeSuspended {
+		jobIds = append(jobIds, sortedByScore(repo.suspendedJobs[queue])...)
+	}
+	jobs, err := repo.getExistingJobsByIds(jobIds)
+	if err != nil {
+		return nil, err
+	}
+	jobSetJobIds := []string{}
+	for _, job := range jobs {
+		if job.JobSetId != jobSetId {
+			continue
+		}
+		if filter != nil && !hasLabels(job, filter.Labels) {
+			continue
+		}
+		jobSetJobIds = append(jobSetJobIds, job.Id)
+	}
+	return jobSetJobIds, nil
+}
+
+func (repo *InMemoryJobRepository) GetLeasedJobIds(queue string) ([]string, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	return sortedByLeaseTime(repo.leasedJobs[queue]), nil
+}
+
+func (repo *InMemoryJobRepository) UpdateStartTime(jobStartInfos []*repository.JobStartInfo) ([]error, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	jobErrors := make([]error, len(jobStartInfos))
+	for i, jobStartInfo := range jobStartInfos {
+		if _, ok := repo.jobs[jobStartInfo.JobId]; !ok {
+			jobErrors[i] = &repository.ErrJobNotFound{JobId: jobStartInfo.JobId, ClusterId: jobStartInfo.ClusterId}
+			continue
+		}
+		startTimeByClusterId, ok := repo.startTimes[jobStartInfo.JobId]
+		if !ok {
+			startTimeByClusterId = make(map[string]time.Time)
+			repo.startTimes[jobStartInfo.JobId] = startTimeByClusterId
+		}
+		// Keep the earliest start time reported for each cluster.
+		if startTime, ok := startTimeByClusterId[jobStartInfo.ClusterId]; ok && startTime.Before(jobStartInfo.StartTime) {
+			continue
+		}
+		startTimeByClusterId[jobStartInfo.ClusterId] = jobStartInfo.StartTime.UTC()
+	}
+	return jobErrors, nil
+}
+
+func (repo *InMemoryJobRepository) UpdateJobs(ids []string, mutator func([]*api.Job)) ([]repository.UpdateJobResult, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	jobs, err := repo.getExistingJobsByIds(ids)
+	if err != nil {
+		return nil, err
+	}
+	mutator(jobs)
+	results := make([]repository.UpdateJobResult, len(jobs))
+	for i, job := range jobs {
+		if err := repo.writeJob(job); err != nil {
+			results[i] = repository.UpdateJobResult{JobId: job.Id, Error: err}
+			continue
+		}
+		if _, ok := repo.queuedJobs[job.Queue][job.Id]; ok {
+			setScore(repo.queuedJobs, job.Queue, job.Id, job.Priority)
+		}
+		if _, ok := repo.suspendedJobs[job.Queue][job.Id]; ok {
+			setScore(repo.suspendedJobs, job.Queue, job.Id, job.Priority)
+		}
+		results[i] = repository.UpdateJobResult{JobId: job.Id, Job: job}
+	}
+	return results, nil
+}
+
+func (repo *InMemoryJobRepository) GetJobRunInfos(jobIds []string) (map[string]*repository.RunInfo, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	runInfos := make(map[string]*repository.RunInfo, len(jobIds))
+	for _, jobId := range jobIds {
+		clusterId, ok := repo.clusterIdByJobId[jobId]
+		if !ok {
+			continue
+		}
+		if startTime, ok := repo.startTimes[jobId][clusterId]; ok {
+			runInfos[jobId] = &repository.RunInfo{StartTime: startTime, CurrentClusterId: clusterId}
+		}
+	}
+	return runInfos, nil
+}
+
+func (repo *InMemoryJobRepository) GetLeasedJobClusterIds(jobIds []string) (map[string]string, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	clusterIds := make(map[string]string, len(jobIds))
+	for _, jobId := range jobIds {
+		if clusterId, ok := repo.clusterIdByJobId[jobId]; ok {
+			clusterIds[jobId] = clusterId
+		}
+	}
+	return clusterIds, nil
+}
+
+func (repo *InMemoryJobRepository) GetJobStates(queue string, jobIds []string) (map[string]repository.JobState, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	states := make(map[string]repository.JobState, len(jobIds))
+	for _, jobId := range jobIds {
+		if _, ok := repo.queuedJobs[queue][jobId]; ok {
+			states[jobId] = repository.JobStateQueued
+		} else if _, ok := repo.leasedJobs[queue][jobId]; ok {
+			states[jobId] = repository.JobStateLeased
+		} else if _, ok := repo.suspendedJobs[queue][jobId]; ok {
+			states[jobId] = repository.JobStateSuspended
+		}
+	}
+	return states, nil
+}
+
+func (repo *InMemoryJobRepository) GetQueueActiveJobSets(queue string) ([]*api.JobSetInfo, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	leasedJobs, err := repo.getExistingJobsByIds(sortedByLeaseTime(repo.leasedJobs[queue]))
+	if err != nil {
+		return nil, err
+	}
+	queuedJobs, err := repo.getExistingJobsByIds(sortedByScore(repo.queuedJobs[queue]))
+	if err != nil {
+		return nil, err
+	}
+	suspendedJobs, err := repo.getExistingJobsByIds(sortedByScore(repo.suspendedJobs[queue]))
+	if err != nil {
+		return nil, err
+	}
+	runInfos := make(map[string]*repository.RunInfo)
+	for _, job := range leasedJobs {
+		clusterId := repo.clusterIdByJobId[job.Id]
+		if startTime, ok := repo.startTimes[job.Id][clusterId]; ok {
+			runInfos[job.Id] = &repository.RunInfo{StartTime: startTime, CurrentClusterId: clusterId}
+		}
+	}
+	return repository.JobSetInfos(queuedJobs, suspendedJobs, leasedJobs, runInfos), nil
+}
+
+func (repo *InMemoryJobRepository) AddRetryAttempt(jobId string) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	repo.retryAttempts[jobId]++
+	return nil
+}
+
+func (repo *InMemoryJobRepository) GetNumberOfRetryAttempts(jobId string) (int, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	return repo.retryAttempts[jobId], nil
+}
+
+func (repo *InMemoryJobRepository) StorePulsarSchedulerJobDetails(jobDetails []*schedulerobjects.PulsarSchedulerJobDetails) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	for _, details := range jobDetails {
+		data, err := proto.Marshal(details)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		repo.pulsarJobDetails[details.JobId] = data
+	}
+	return nil
+}
+
+func (repo *InMemoryJobRepository) GetPulsarSchedulerJobDetails(jobId string) (*schedulerobjects.PulsarSchedulerJobDetails, error) {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	data, ok := repo.pulsarJobDetails[jobId]
+	if !ok {
+		return nil, nil
+	}
+	details := &schedulerobjects.PulsarSchedulerJobDetails{}
+	if err := proto.Unmarshal(data, details); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return details, nil
+}
+
+// ExpirePulsarSchedulerJobDetails deletes the details of the given jobs immediately,
+// whereas the Redis-backed repository expires them after an hour.
+func (repo *InMemoryJobRepository) ExpirePulsarSchedulerJobDetails(jobIds []string) error {
+	repo.mu.Lock()
+	defer repo.mu.Unlock()
+	for _, jobId := range jobIds {
+		delete(repo.pulsarJobDetails, jobId)
+	}
+	return nil
+}
+
+func (repo *InMemoryJobRepository) writeJob(job *api.Job) error {
+	data, err := proto.Marshal(job)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	repo.jobs[job.Id] = data
+	return nil
+}
+
+func (repo *InMemoryJobRepository) getJobsByIds(ids []string) ([]*repository.JobResult, error) {
+	results := make([]*repository.JobResult, len(ids))
+	for i, id := range ids {
+		result := &repository.JobResult{JobId: id}
+		results[i] = result
+		data, ok := repo.jobs[id]
+		if !ok {
+			result.Error = &armadaerrors.ErrNotFound{Type: "job", Value: id}
+			continue
+		}
+		result.Job = &api.Job{}
+		if err := proto.Unmarshal(data, result.Job); err != nil {
+			return nil, errors.WithStack(err)
+		}
+		podSpec := result.Job.GetMainPodSpec()
+		for k, v := range result.Job.RequiredNodeLabels {
+			if podSpec.NodeSelector == nil {
+				podSpec.NodeSelector = map[string]string{}
+			}
+			podSpec.NodeSelector[k] = v
+		}
+	}
+	return results, nil
+}
+
+func (repo *InMemoryJobRepository) getExistingJobsByIds(ids []string) ([]*api.Job, error) {
+	jobResults, err := repo.getJobsByIds(ids)
+	if err != nil {
+		return nil, err
+	}
+	jobs := make([]*api.Job, 0, len(jobResults))
+	for _, jobResult := range jobResults {
+		if jobResult.Error != nil {
+			continue
+		}
+		job := jobResult.Job
+		if job.Annotations == nil {
+			job.Annotations = make(map[string]string)
+		}
+		if job.PodSpec != nil && job.PodSpec.NodeSelector == nil {
+			job.PodSpec.NodeSelector = make(map[string]string)
+		}
+		for _, podSpec := range job.PodSpecs {
+			if podSpec != nil && podSpec.NodeSelector == nil {
+				podSpec.NodeSelector = make(map[string]string)
+			}
+		}
+		jobs = append(jobs, job)
+	}
+	return jobs, nil
+}
+
+// leaseJobs leases queued jobs to the cluster and renews the leases of jobs already leased to it.
+func (repo *InMemoryJobRepository) leaseJobs(clusterId string, jobIdsByQueue map[string][]string) map[string][]string {
+	now := time.Now()
+	leasedJobIdsByQueue := make(map[string][]string, len(jobIdsByQueue))
+	for queue, jobIds := range jobIdsByQueue {
+		for _, jobId := range jobIds {
+			if _, ok := repo.queuedJobs[queue][jobId]; ok {
+				delete(repo.queuedJobs[queue], jobId)
+			} else if repo.clusterIdByJobId[jobId] != clusterId {
+				continue
+			} else if _, ok := repo.leasedJobs[queue][jobId]; !ok {
+				continue
+			}
+			repo.clusterIdByJobId[jobId] = clusterId
+			if repo.leasedJobs[queue] == nil {
+				repo.leasedJobs[queue] = make(map[string]time.Time)
+			}
+			repo.leasedJobs[queue][jobId] = now
+			leasedJobIdsByQueue[queue] = append(leasedJobIdsByQueue[queue], jobId)
+		}
+	}
+	return leasedJobIdsByQueue
+}
+
+func (repo *InMemoryJobRepository) expireLeasesById(jobIds []string, deadline time.Time) ([]*api.Job, error) {
+	jobs, err := repo.getExistingJobsByIds(jobIds)
+	if err != nil {
+		return nil, err
+	}
+	expired := make([]*api.Job, 0)
+	for _, job := range jobs {
+		leaseTime, ok := repo.leasedJobs[job.Queue][job.Id]
+		if !ok || !leaseTime.Before(deadline) {
+			continue
+		}
+		delete(repo.clusterIdByJobId, job.Id)
+		delete(repo.leasedJobs[job.Queue], job.Id)
+		setScore(repo.queuedJobs, job.Queue, job.Id, job.Priority)
+		expired = append(expired, job)
+	}
+	return expired, nil
+}
+
+func setScore(scoresByQueue map[string]map[string]float64, queue string, jobId string, score float64) {
+	if scoresByQueue[queue] == nil {
+		scoresByQueue[queue] = make(map[string]float64)
+	}
+	scoresByQueue[queue][jobId] = score
+}
+
+// moveJobs moves jobs of queue between from and to, preserving their priority, and returns the ids of the moved jobs.
+func moveJobs(from map[string]map[string]float64, to map[string]map[string]float64, queue string, jobIds []string) []string {
+	var movedJobIds []string
+	for _, jobId := range jobIds {
+		priority, ok := from[queue][jobId]
+		if !ok {
+			continue
+		}
+		delete(from[queue], jobId)
+		setScore(to, queue, jobId, priority)
+		movedJobIds = append(movedJobIds, jobId)
+	}
+	return movedJobIds
+}
+
+// sortedByScore returns job ids ordered like the members of a Redis sorted set, i.e., by score and then by id.
+func sortedByScore(scoreByJobId map[string]float64) []string {
+	jobIds := make([]string, 0, len(scoreByJobId))
+	for jobId := range scoreByJobId {
+		jobIds = append(jobIds, jobId)
+	}
+	sort.Slice(jobIds, func(i, j int) bool {
+		if scoreByJobId[jobIds[i]] != scoreByJobId[jobIds[j]] {
+			return scoreByJobId[jobIds[i]] < scoreByJobId[jobIds[j]]
+		}
+		return jobIds[i] < jobIds[j]
+	})
+	return jobIds
+}
+
+func sortedByLeaseTime(leaseTimeByJobId map[string]time.Time) []string {
+	scoreByJobId := make(map[string]float64, len(leaseTimeByJobId))
+	for jobId, leaseTime := range leaseTimeByJobId {
+		scoreByJobId[jobId] = float64(leaseTime.UnixNano())
+	}
+	return sortedByScore(scoreByJobId)
+}
+
+func hasLabels(job *api.Job, labels map[string]string) bool {
+	for key, value := range labels {
+		if jobValue, ok := job.Labels[key]; !ok || jobValue != value {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,101 @@
+package armadatesting
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/armadaproject/armada/internal/armada/repository"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+func TestInMemoryJobRepository_JobLifecycle(t *testing.T) {
+	repo := NewInMemoryJobRepository()
+	jobs := []*api.Job{
+		{Id: "a", Queue: "queue", JobSetId: "jobSet", Priority: 2},
+		{Id: "b", Queue: "queue", JobSetId: "jobSet", Priority: 1, Labels: map[string]string{"team": "ml"}},
+		{Id: "c", Queue: "queue", JobSetId: "otherJobSet", Priority: 3},
+	}
+	results, err := repo.AddJobs(jobs)
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+	assert.Equal(t, "a", results[0].JobId)
+
+	// Jobs are only added once.
+	results, err = repo.AddJobs(jobs[:1])
+	require.NoError(t, err)
+	assert.True(t, results[0].AlreadyProcessed)
+
+	// Queued jobs are ordered by priority.
+	queuedJobIds, err := repo.GetQueueJobIds("queue")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"b", "a", "c"}, queuedJobIds)
+	peekedJobs, err := repo.PeekQueue("queue", 1)
+	require.NoError(t, err)
+	require.Len(t, peekedJobs, 1)
+	assert.Equal(t, "b", peekedJobs[0].Id)
+
+	// Stored jobs can't be modified by callers.
+	peekedJobs[0].Priority = 100
+	storedJobs, err := repo.GetExistingJobsByIds([]string{"b", "missing"})
+	require.NoError(t, err)
+	require.Len(t, storedJobs, 1)
+	assert.Equal(t, float64(1), storedJobs[0].Priority)
+
+	leasedJobIds, err := repo.TryLeaseJobs("cluster", map[string][]string{"queue": {"a", "b"}})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"a", "b"}, leasedJobIds["queue"])
+	leasedJobIds, err = repo.TryLeaseJobs("otherCluster", map[string][]string{"queue": {"a"}})
+	require.NoError(t, err)
+	assert.Empty(t, leasedJobIds)
+	renewedJobIds, err := repo.RenewLease("cluster", []string{"a"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a"}, renewedJobIds)
+
+	jobSetJobIds, err := repo.GetJobSetJobIds("queue", "jobSet", &repository.JobSetFilter{IncludeLeased: true})
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"a", "b"}, jobSetJobIds)
+	jobSetJobIds, err = repo.GetJobSetJobIds("queue", "jobSet", &repository.JobSetFilter{IncludeLeased: true, Labels: map[string]string{"team": "ml"}})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"b"}, jobSetJobIds)
+
+	returnedJob, err := repo.ReturnLease("cluster", "a")
+	require.NoError(t, err)
+	require.NotNil(t, returnedJob)
+	assert.Equal(t, "a", returnedJob.Id)
+	expiredJobs, err := repo.ExpireLeases("queue", time.Now().Add(time.Second))
+	require.NoError(t, err)
+	require.Len(t, expiredJobs, 1)
+	assert.Equal(t, "b", expiredJobs[0].Id)
+
+	suspendedJobIds, err := repo.SuspendJobs("queue", []string{"a", "missing"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a"}, suspendedJobIds)
+	queuedJobIds, err = repo.GetQueueJobIds("queue")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"b", "c"}, queuedJobIds)
+	resumedJobIds, err := repo.ResumeJobs("queue", []string{"a"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a"}, resumedJobIds)
+
+	updateResults, err := repo.UpdateJobs([]string{"a"}, func(jobs []*api.Job) {
+		for _, job := range jobs {
+			job.Priority = 0
+		}
+	})
+	require.NoError(t, err)
+	require.Len(t, updateResults, 1)
+	require.NoError(t, updateResults[0].Error)
+	queuedJobIds, err = repo.GetQueueJobIds("queue")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"a", "b", "c"}, queuedJobIds)
+
+	deletedJobs, err := repo.DeleteJobs(jobs)
+	require.NoError(t, err)
+	assert.Len(t, deletedJobs, 3)
+	activeJobSets, err := repo.GetQueueActiveJobSets("queue")
+	require.NoError(t, err)
+	assert.Empty(t, activeJobSets)
+}
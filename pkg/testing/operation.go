@@ -0,0 +1,49 @@
+package armadatesting
+
+import (
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/armadaproject/armada/internal/armada/repository"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+// InMemoryOperationRepository is a repository.OperationRepository storing operations in memory.
+// Unlike in the Redis-backed repository, done operations are kept indefinitely.
+type InMemoryOperationRepository struct {
+	operations map[string]*api.Operation
+	mu         sync.Mutex
+}
+
+func NewInMemoryOperationRepository() *InMemoryOperationRepository {
+	return &InMemoryOperationRepository{operations: make(map[string]*api.Operation)}
+}
+
+func (r *InMemoryOperationRepository) CreateOperation(op *api.Operation, abandonedAfter time.Duration) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if existing, ok := r.operations[op.Name]; ok && !existing.Done && op.UpdateTime.Sub(existing.UpdateTime) < abandonedAfter {
+		return &repository.ErrOperationInProgress{Name: op.Name}
+	}
+	r.operations[op.Name] = proto.Clone(op).(*api.Operation)
+	return nil
+}
+
+func (r *InMemoryOperationRepository) UpdateOperation(op *api.Operation) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.operations[op.Name] = proto.Clone(op).(*api.Operation)
+	return nil
+}
+
+func (r *InMemoryOperationRepository) GetOperation(name string) (*api.Operation, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	op, ok := r.operations[name]
+	if !ok {
+		return nil, &repository.ErrOperationNotFound{Name: name}
+	}
+	return proto.Clone(op).(*api.Operation), nil
+}
@@ -0,0 +1,39 @@
+package armadatesting
+
+import (
+	"sync"
+
+	"github.com/armadaproject/armada/internal/armada/repository"
+)
+
+// InMemoryOwnershipGroupsRepository is a repository.OwnershipGroupsRepository storing groups in memory.
+type InMemoryOwnershipGroupsRepository struct {
+	groups map[string][]byte
+	mu     sync.Mutex
+}
+
+func NewInMemoryOwnershipGroupsRepository() *InMemoryOwnershipGroupsRepository {
+	return &InMemoryOwnershipGroupsRepository{groups: make(map[string][]byte)}
+}
+
+func (r *InMemoryOwnershipGroupsRepository) StoreOwnershipGroups(compressedGroups []byte) (string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	hash := repository.OwnershipGroupsHash(compressedGroups)
+	if _, ok := r.groups[hash]; !ok {
+		r.groups[hash] = append([]byte(nil), compressedGroups...)
+	}
+	return hash, nil
+}
+
+func (r *InMemoryOwnershipGroupsRepository) GetOwnershipGroups(hashes []string) (map[string][]byte, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	groups := make(map[string][]byte, len(hashes))
+	for _, hash := range hashes {
+		if compressedGroups, ok := r.groups[hash]; ok {
+			groups[hash] = append([]byte(nil), compressedGroups...)
+		}
+	}
+	return groups, nil
+}
@@ -0,0 +1,258 @@
+package armadatesting
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/pkg/errors"
+
+	"github.com/armadaproject/armada/internal/armada/repository"
+	"github.com/armadaproject/armada/pkg/api"
+	"github.com/armadaproject/armada/pkg/client/queue"
+)
+
+// InMemoryQueueRepository is a repository.QueueRepository storing queues in memory.
+// Like the Redis-backed repository, queues read from it include the permissions inherited from their ancestors.
+type InMemoryQueueRepository struct {
+	// Serialized queues, indexed by name.
+	queues map[string][]byte
+	// Incremented whenever any queue is created, changed, or deleted.
+	resourceVersion uint64
+	// All changes to queues, ordered by resource version.
+	changes []repository.QueueChange
+	// If non-nil, changes are added here rather than recorded, until the writes in progress are known to succeed.
+	pending []repository.QueueChange
+	// Closed and replaced whenever a change is recorded, to wake up readers waiting for changes.
+	changed chan struct{}
+	mu      sync.Mutex
+}
+
+func NewInMemoryQueueRepository() *InMemoryQueueRepository {
+	return &InMemoryQueueRepository{queues: make(map[string][]byte), changed: make(chan struct{})}
+}
+
+// GetAllQueues returns all queues, ordered by name.
+func (r *InMemoryQueueRepository) GetAllQueues() ([]queue.Queue, error) {
+	queues, _, err := r.GetQueueSnapshot()
+	return queues, err
+}
+
+// GetQueueSnapshot returns all queues, ordered by name, and the resource version of the repository.
+func (r *InMemoryQueueRepository) GetQueueSnapshot() ([]queue.Queue, uint64, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	queueByName, err := r.getQueues()
+	if err != nil {
+		return nil, 0, err
+	}
+	queues := make([]queue.Queue, 0, len(queueByName))
+	for name := range queueByName {
+		queues = append(queues, withInheritedPermissions(queueByName[name], queueByName))
+	}
+	sort.Slice(queues, func(i, j int) bool { return queues[i].Name < queues[j].Name })
+	return queues, r.resourceVersion, nil
+}
+
+func (r *InMemoryQueueRepository) GetQueue(name string) (queue.Queue, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	queueByName, err := r.getQueues()
+	if err != nil {
+		return queue.Queue{}, err
+	}
+	q, ok := queueByName[name]
+	if !ok {
+		return queue.Queue{}, &repository.ErrQueueNotFound{QueueName: name}
+	}
+	return withInheritedPermissions(q, queueByName), nil
+}
+
+func (r *InMemoryQueueRepository) CreateQueue(q queue.Queue) error {
+	return r.CreateQueues([]queue.Queue{q})
+}
+
+// CreateQueues creates all of the provided queues or, if any of them can't be created, none of them.
+func (r *InMemoryQueueRepository) CreateQueues(queues []queue.Queue) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.atomically(func() error {
+		for _, q := range queues {
+			if _, ok := r.queues[q.Name]; ok {
+				return &repository.ErrQueueAlreadyExists{QueueName: q.Name}
+			}
+			q.Revision = 1
+			if err := r.writeQueue(q); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (r *InMemoryQueueRepository) UpdateQueue(q queue.Queue) error {
+	return r.UpdateQueues([]queue.Queue{q})
+}
+
+// UpdateQueues updates all of the provided queues or, if any of them can't be updated, none of them.
+func (r *InMemoryQueueRepository) UpdateQueues(queues []queue.Queue) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.atomically(func() error {
+		for _, q := range queues {
+			existing, err := r.getQueue(q.Name)
+			if err != nil {
+				return err
+			}
+			if q.Revision != 0 && q.Revision != existing.Revision {
+				return &repository.ErrQueueRevisionMismatch{QueueName: q.Name, ExpectedRevision: q.Revision, ActualRevision: existing.Revision}
+			}
+			q.Revision = existing.Revision + 1
+			q.Archival = existing.Archival
+			if err := r.writeQueue(q); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// atomically calls f and, if it returns an error, reverts the queues and resource version to their state before.
+// Changes are only recorded once f succeeds, such that readers never observe changes that are reverted.
+func (r *InMemoryQueueRepository) atomically(f func() error) error {
+	queues := make(map[string][]byte, len(r.queues))
+	for name, data := range r.queues {
+		queues[name] = data
+	}
+	resourceVersion := r.resourceVersion
+	r.pending = []repository.QueueChange{}
+	defer func() { r.pending = nil }()
+	if err := f(); err != nil {
+		r.queues = queues
+		r.resourceVersion = resourceVersion
+		return err
+	}
+	for _, change := range r.pending {
+		r.recordChange(change)
+	}
+	return nil
+}
+
+func (r *InMemoryQueueRepository) SetQueueArchival(name string, archival *queue.Archival) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	existing, err := r.getQueue(name)
+	if err != nil {
+		return err
+	}
+	existing.Revision++
+	existing.Archival = archival
+	return r.writeQueue(existing)
+}
+
+func (r *InMemoryQueueRepository) DeleteQueue(name string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	existing, err := r.getQueue(name)
+	if _, ok := err.(*repository.ErrQueueNotFound); ok {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	delete(r.queues, name)
+	r.resourceVersion++
+	r.recordChange(repository.QueueChange{Type: api.QueueChangeType_QueueDeleted, Queue: existing, ResourceVersion: r.resourceVersion})
+	return nil
+}
+
+// GetQueueChanges returns up to limit changes made after fromResourceVersion, waiting up to block for one if there are none.
+// All changes are retained.
+func (r *InMemoryQueueRepository) GetQueueChanges(fromResourceVersion uint64, limit int64, block time.Duration) ([]repository.QueueChange, error) {
+	r.mu.Lock()
+	changes := r.getChanges(fromResourceVersion, limit)
+	changed := r.changed
+	r.mu.Unlock()
+	if len(changes) > 0 || block <= 0 {
+		return changes, nil
+	}
+	select {
+	case <-changed:
+	case <-time.After(block):
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.getChanges(fromResourceVersion, limit), nil
+}
+
+func (r *InMemoryQueueRepository) getChanges(fromResourceVersion uint64, limit int64) []repository.QueueChange {
+	i := sort.Search(len(r.changes), func(i int) bool { return r.changes[i].ResourceVersion > fromResourceVersion })
+	changes := r.changes[i:]
+	if limit > 0 && int64(len(changes)) > limit {
+		changes = changes[:limit]
+	}
+	return append([]repository.QueueChange(nil), changes...)
+}
+
+func (r *InMemoryQueueRepository) writeQueue(q queue.Queue) error {
+	q.ResourceVersion = r.resourceVersion + 1
+	data, err := proto.Marshal(q.ToAPI())
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	changeType := api.QueueChangeType_QueueUpdated
+	if _, ok := r.queues[q.Name]; !ok {
+		changeType = api.QueueChangeType_QueueCreated
+	}
+	r.queues[q.Name] = data
+	r.resourceVersion++
+	q.InheritedPermissions = nil
+	change := repository.QueueChange{Type: changeType, Queue: q, ResourceVersion: r.resourceVersion}
+	if r.pending != nil {
+		r.pending = append(r.pending, change)
+		return nil
+	}
+	r.recordChange(change)
+	return nil
+}
+
+func (r *InMemoryQueueRepository) recordChange(change repository.QueueChange) {
+	r.changes = append(r.changes, change)
+	close(r.changed)
+	r.changed = make(chan struct{})
+}
+
+func (r *InMemoryQueueRepository) getQueue(name string) (queue.Queue, error) {
+	data, ok := r.queues[name]
+	if !ok {
+		return queue.Queue{}, &repository.ErrQueueNotFound{QueueName: name}
+	}
+	apiQueue := &api.Queue{}
+	if err := proto.Unmarshal(data, apiQueue); err != nil {
+		return queue.Queue{}, errors.WithStack(err)
+	}
+	return queue.NewQueue(apiQueue)
+}
+
+func (r *InMemoryQueueRepository) getQueues() (map[string]queue.Queue, error) {
+	queueByName := make(map[string]queue.Queue, len(r.queues))
+	for name, data := range r.queues {
+		apiQueue := &api.Queue{}
+		if err := proto.Unmarshal(data, apiQueue); err != nil {
+			return nil, errors.WithStack(err)
+		}
+		q, err := queue.NewQueue(apiQueue)
+		if err != nil {
+			return nil, err
+		}
+		queueByName[name] = q
+	}
+	return queueByName, nil
+}
+
+func withInheritedPermissions(q queue.Queue, queueByName map[string]queue.Queue) queue.Queue {
+	for _, ancestor := range queue.Ancestors(q.Name, queueByName) {
+		q.InheritedPermissions = append(q.InheritedPermissions, ancestor.Permissions...)
+	}
+	return q
+}
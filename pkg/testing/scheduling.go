@@ -0,0 +1,43 @@
+package armadatesting
+
+import (
+	"sync"
+	"time"
+
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+// InMemorySchedulingInfoRepository is a repository.SchedulingInfoRepository storing the reports of clusters in memory.
+type InMemorySchedulingInfoRepository struct {
+	reports    map[string]*api.ClusterSchedulingInfoReport
+	lastUpdate time.Time
+	mu         sync.Mutex
+}
+
+func NewInMemorySchedulingInfoRepository() *InMemorySchedulingInfoRepository {
+	return &InMemorySchedulingInfoRepository{reports: make(map[string]*api.ClusterSchedulingInfoReport)}
+}
+
+func (r *InMemorySchedulingInfoRepository) GetClusterSchedulingInfo() (map[string]*api.ClusterSchedulingInfoReport, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	reports := make(map[string]*api.ClusterSchedulingInfoReport, len(r.reports))
+	for clusterId, report := range r.reports {
+		reports[clusterId] = report
+	}
+	return reports, nil
+}
+
+func (r *InMemorySchedulingInfoRepository) UpdateClusterSchedulingInfo(report *api.ClusterSchedulingInfoReport) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.reports[report.ClusterId] = report
+	r.lastUpdate = report.ReportTime
+	return nil
+}
+
+func (r *InMemorySchedulingInfoRepository) GetLastUpdateTime() (time.Time, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.lastUpdate, nil
+}
@@ -0,0 +1,160 @@
+// Package armadatesting provides in-memory implementations of the repositories of the Armada server and a test server
+// backed by them, such that clients of the submit API can be tested without Redis or Pulsar.
+package armadatesting
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/armadaproject/armada/internal/armada/configuration"
+	"github.com/armadaproject/armada/internal/armada/server"
+	"github.com/armadaproject/armada/internal/common/armadacontext"
+	"github.com/armadaproject/armada/internal/common/auth/permission"
+	"github.com/armadaproject/armada/internal/common/compress"
+	armadaresource "github.com/armadaproject/armada/internal/common/resource"
+	"github.com/armadaproject/armada/internal/common/types"
+	"github.com/armadaproject/armada/pkg/api"
+	apiv2 "github.com/armadaproject/armada/pkg/api/v2"
+	"github.com/armadaproject/armada/pkg/client/queue"
+)
+
+// TestClusterId is the id of the cluster the test server reports as available for scheduling.
+const TestClusterId = "test-cluster"
+
+// TestServer serves the submit API, i.e., version 1 and 2 of it, of the legacy scheduler, backed by in-memory repositories.
+// All requests are authorized. Submitted jobs are stored but never scheduled; tests may act as an executor,
+// e.g., by leasing jobs using Jobs, to drive jobs through their lifecycle.
+type TestServer struct {
+	// Address the server listens on, e.g., for use as the ArmadaUrl of client.ApiConnectionDetails.
+	Address string
+	// Connection to the server, which is closed when the server is stopped.
+	Conn           *grpc.ClientConn
+	SubmitClient   api.SubmitClient
+	Jobs           *InMemoryJobRepository
+	Queues         *InMemoryQueueRepository
+	Events         *InMemoryEventStore
+	SchedulingInfo *InMemorySchedulingInfoRepository
+	Barriers       *InMemoryBarrierRepository
+	Operations     *InMemoryOperationRepository
+	FailureReports *InMemorySubmitFailureReportRepository
+	// Ownership groups of submitted jobs, which are stored once for all jobs with identical groups rather than with the jobs.
+	OwnershipGroups *InMemoryOwnershipGroupsRepository
+	grpcServer      *grpc.Server
+}
+
+// StartTestServer starts a TestServer listening on a random local port, which is stopped at the end of the test.
+// The server reports a single cluster, TestClusterId, with 100 cores and 100Gi of memory, such that jobs requesting
+// no more than that can be submitted.
+func StartTestServer(t testing.TB) *TestServer {
+	t.Helper()
+	s := &TestServer{
+		Jobs:            NewInMemoryJobRepository(),
+		Queues:          NewInMemoryQueueRepository(),
+		Events:          NewInMemoryEventStore(),
+		SchedulingInfo:  NewInMemorySchedulingInfoRepository(),
+		Barriers:        NewInMemoryBarrierRepository(),
+		Operations:      NewInMemoryOperationRepository(),
+		FailureReports:  NewInMemorySubmitFailureReportRepository(),
+		OwnershipGroups: NewInMemoryOwnershipGroupsRepository(),
+	}
+	err := s.SchedulingInfo.UpdateClusterSchedulingInfo(&api.ClusterSchedulingInfoReport{
+		ClusterId:  TestClusterId,
+		ReportTime: time.Now(),
+		NodeTypes: []*api.NodeType{{
+			AllocatableResources: armadaresource.ComputeResources{
+				"cpu":    resource.MustParse("100"),
+				"memory": resource.MustParse("100Gi"),
+			},
+		}},
+	})
+	if err != nil {
+		t.Fatalf("error reporting test cluster: %s", err)
+	}
+
+	authorizer := &allowAllAuthorizer{}
+	submitServer := server.NewSubmitServer(
+		authorizer,
+		s.Jobs,
+		s.Queues,
+		s.Events,
+		s.SchedulingInfo,
+		s.Barriers,
+		s.Operations,
+		s.FailureReports,
+		s.OwnershipGroups,
+		200,
+		4,
+		&configuration.QueueManagementConfig{
+			DefaultPriorityFactor:       1,
+			CascadingDeletePollInterval: 10 * time.Millisecond,
+			CascadingDeleteTimeout:      time.Minute,
+		},
+		testSchedulingConfig(),
+		&configuration.SubmitFailureConfig{MaxResponseItems: 5, ReportRetention: time.Hour},
+		&configuration.CompressionConfig{
+			Codec:          compress.CodecZlib,
+			CompressorPool: configuration.ObjectPoolConfig{MaxTotal: 10, MaxIdle: 10, BlockWhenExhausted: true},
+		},
+	)
+	s.grpcServer = grpc.NewServer()
+	api.RegisterSubmitServer(s.grpcServer, submitServer)
+	apiv2.RegisterSubmitServer(s.grpcServer, server.NewSubmitServerV2(submitServer))
+	apiv2.RegisterJobsServer(s.grpcServer, server.NewJobsServerV2(authorizer, s.Queues, s.Jobs))
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("error starting test server: %s", err)
+	}
+	s.Address = listener.Addr().String()
+	go func() {
+		_ = s.grpcServer.Serve(listener)
+	}()
+	s.Conn, err = grpc.Dial(s.Address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		s.grpcServer.Stop()
+		t.Fatalf("error connecting to test server: %s", err)
+	}
+	s.SubmitClient = api.NewSubmitClient(s.Conn)
+	t.Cleanup(s.Stop)
+	return s
+}
+
+// Stop closes the connection to the server and stops it.
+func (s *TestServer) Stop() {
+	_ = s.Conn.Close()
+	s.grpcServer.Stop()
+}
+
+func testSchedulingConfig() *configuration.SchedulingConfig {
+	return &configuration.SchedulingConfig{
+		DefaultJobLimits: armadaresource.ComputeResources{
+			"cpu":    resource.MustParse("1"),
+			"memory": resource.MustParse("1Gi"),
+		},
+		DefaultJobTolerations: []v1.Toleration{},
+		MaxPodSpecSizeBytes:   65535,
+		Preemption: configuration.PreemptionConfig{
+			DefaultPriorityClass: "default",
+			PriorityClasses:      map[string]types.PriorityClass{"default": {Priority: 0, Preemptible: false}},
+		},
+		MinTerminationGracePeriod: 30 * time.Second,
+		MaxTerminationGracePeriod: 300 * time.Second,
+	}
+}
+
+// allowAllAuthorizer authorizes all actions.
+type allowAllAuthorizer struct{}
+
+func (*allowAllAuthorizer) AuthorizeAction(_ *armadacontext.Context, _ permission.Permission) error {
+	return nil
+}
+
+func (*allowAllAuthorizer) AuthorizeQueueAction(_ *armadacontext.Context, _ queue.Queue, _ permission.Permission, _ queue.PermissionVerb) error {
+	return nil
+}
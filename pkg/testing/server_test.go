@@ -0,0 +1,61 @@
+package armadatesting
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/armadaproject/armada/pkg/api"
+	apiv2 "github.com/armadaproject/armada/pkg/api/v2"
+)
+
+func TestStartTestServer(t *testing.T) {
+	s := StartTestServer(t)
+	ctx := context.Background()
+
+	_, err := s.SubmitClient.CreateQueue(ctx, &api.Queue{Name: "queue", PriorityFactor: 1})
+	require.NoError(t, err)
+
+	res, err := s.SubmitClient.SubmitJobs(ctx, &api.JobSubmitRequest{
+		Queue:    "queue",
+		JobSetId: "jobSet",
+		JobRequestItems: []*api.JobSubmitRequestItem{{
+			PodSpec: &v1.PodSpec{
+				Containers: []v1.Container{{
+					Name:  "container",
+					Image: "image",
+					Resources: v1.ResourceRequirements{
+						Limits:   v1.ResourceList{"cpu": resource.MustParse("1"), "memory": resource.MustParse("1Gi")},
+						Requests: v1.ResourceList{"cpu": resource.MustParse("1"), "memory": resource.MustParse("1Gi")},
+					},
+				}},
+			},
+		}},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.JobResponseItems, 1)
+	jobId := res.JobResponseItems[0].JobId
+
+	queuedJobIds, err := s.Jobs.GetQueueJobIds("queue")
+	require.NoError(t, err)
+	assert.Equal(t, []string{jobId}, queuedJobIds)
+	require.NotEmpty(t, s.Events.Events())
+	assert.Equal(t, jobId, s.Events.Events()[0].GetSubmitted().GetJobId())
+
+	// Version 2 of the API is served too.
+	listRes, err := apiv2.NewJobsClient(s.Conn).ListJobs(ctx, &apiv2.ListJobsRequest{Queue: "queue", JobSetId: "jobSet"})
+	require.NoError(t, err)
+	require.Len(t, listRes.Jobs, 1)
+	assert.Equal(t, jobId, listRes.Jobs[0].Id)
+	assert.Equal(t, apiv2.JobState_JOB_STATE_QUEUED, listRes.Jobs[0].State)
+
+	_, err = s.SubmitClient.CancelJobs(ctx, &api.JobCancelRequest{Queue: "queue", JobSetId: "jobSet", JobIds: []string{jobId}})
+	require.NoError(t, err)
+	queuedJobIds, err = s.Jobs.GetQueueJobIds("queue")
+	require.NoError(t, err)
+	assert.Empty(t, queuedJobIds)
+}
@@ -0,0 +1,44 @@
+package armadatesting
+
+import (
+	"sync"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/armadaproject/armada/internal/armada/repository"
+	"github.com/armadaproject/armada/pkg/api"
+)
+
+type submitFailureReport struct {
+	report *api.JobSubmitResponse
+	owner  string
+}
+
+// InMemorySubmitFailureReportRepository is a repository.SubmitFailureReportRepository storing reports in memory.
+// Unlike in the Redis-backed repository, reports never expire.
+type InMemorySubmitFailureReportRepository struct {
+	reports map[string]submitFailureReport
+	mu      sync.Mutex
+}
+
+func NewInMemorySubmitFailureReportRepository() *InMemorySubmitFailureReportRepository {
+	return &InMemorySubmitFailureReportRepository{reports: make(map[string]submitFailureReport)}
+}
+
+func (r *InMemorySubmitFailureReportRepository) StoreSubmitFailureReport(id string, owner string, report *api.JobSubmitResponse, _ time.Duration) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.reports[id] = submitFailureReport{report: proto.Clone(report).(*api.JobSubmitResponse), owner: owner}
+	return nil
+}
+
+func (r *InMemorySubmitFailureReportRepository) GetSubmitFailureReport(id string) (*api.JobSubmitResponse, string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	stored, ok := r.reports[id]
+	if !ok {
+		return nil, "", &repository.ErrSubmitFailureReportNotFound{Id: id}
+	}
+	return proto.Clone(stored.report).(*api.JobSubmitResponse), stored.owner, nil
+}